@@ -41,18 +41,36 @@ import (
 
 // Interface is a client for the AdminService service.
 type Interface interface {
+	AddRemoteCluster(
+		ctx context.Context,
+		Request *admin.AddRemoteClusterRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	AddSearchAttribute(
 		ctx context.Context,
 		Request *admin.AddSearchAttributeRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	CloneDomain(
+		ctx context.Context,
+		Request *admin.CloneDomainRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	CloseShard(
 		ctx context.Context,
 		Request *shared.CloseShardRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	DeleteDomain(
+		ctx context.Context,
+		Request *admin.DeleteDomainRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	DescribeCluster(
 		ctx context.Context,
 		opts ...yarpc.CallOption,
@@ -70,6 +88,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeQueueResponse, error)
 
+	DescribeReplicationStatus(
+		ctx context.Context,
+		Request *shared.DescribeReplicationStatusRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeReplicationStatusResponse, error)
+
 	DescribeShardDistribution(
 		ctx context.Context,
 		Request *shared.DescribeShardDistributionRequest,
@@ -112,24 +136,60 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*admin.GetWorkflowExecutionRawHistoryV2Response, error)
 
+	ListDomainChanges(
+		ctx context.Context,
+		Request *admin.ListDomainChangesRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ListDomainChangesResponse, error)
+
 	MergeDLQMessages(
 		ctx context.Context,
 		Request *replicator.MergeDLQMessagesRequest,
 		opts ...yarpc.CallOption,
 	) (*replicator.MergeDLQMessagesResponse, error)
 
+	MigrateWorkflowExecution(
+		ctx context.Context,
+		Request *admin.MigrateWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.MigrateWorkflowExecutionResponse, error)
+
+	MoveTaskListBacklog(
+		ctx context.Context,
+		Request *admin.MoveTaskListBacklogRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.MoveTaskListBacklogResponse, error)
+
+	PauseTaskList(
+		ctx context.Context,
+		Request *admin.PauseTaskListRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	PurgeDLQMessages(
 		ctx context.Context,
 		Request *replicator.PurgeDLQMessagesRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	PurgeHistoryTaskDLQ(
+		ctx context.Context,
+		Request *shared.PurgeHistoryTaskDLQRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ReadDLQMessages(
 		ctx context.Context,
 		Request *replicator.ReadDLQMessagesRequest,
 		opts ...yarpc.CallOption,
 	) (*replicator.ReadDLQMessagesResponse, error)
 
+	ReadHistoryTaskDLQ(
+		ctx context.Context,
+		Request *shared.ReadHistoryTaskDLQRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ReadHistoryTaskDLQResponse, error)
+
 	ReapplyEvents(
 		ctx context.Context,
 		ReapplyEventsRequest *shared.ReapplyEventsRequest,
@@ -142,12 +202,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RemoveRemoteCluster(
+		ctx context.Context,
+		Request *admin.RemoveRemoteClusterRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RemoveTask(
 		ctx context.Context,
 		Request *shared.RemoveTaskRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	RenameDomain(
+		ctx context.Context,
+		Request *admin.RenameDomainRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ResendReplicationTasks(
 		ctx context.Context,
 		Request *admin.ResendReplicationTasksRequest,
@@ -159,6 +231,18 @@ type Interface interface {
 		Request *shared.ResetQueueRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	ResumeTaskList(
+		ctx context.Context,
+		Request *admin.ResumeTaskListRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	RetryHistoryTaskDLQ(
+		ctx context.Context,
+		Request *shared.RetryHistoryTaskDLQRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.RetryHistoryTaskDLQResponse, error)
 }
 
 // New builds a new client for the AdminService service.
@@ -185,6 +269,29 @@ type client struct {
 	c thrift.Client
 }
 
+func (c client) AddRemoteCluster(
+	ctx context.Context,
+	_Request *admin.AddRemoteClusterRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_AddRemoteCluster_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_AddRemoteCluster_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_AddRemoteCluster_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) AddSearchAttribute(
 	ctx context.Context,
 	_Request *admin.AddSearchAttributeRequest,
@@ -208,6 +315,29 @@ func (c client) AddSearchAttribute(
 	return
 }
 
+func (c client) CloneDomain(
+	ctx context.Context,
+	_Request *admin.CloneDomainRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_CloneDomain_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_CloneDomain_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_CloneDomain_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) CloseShard(
 	ctx context.Context,
 	_Request *shared.CloseShardRequest,
@@ -231,6 +361,29 @@ func (c client) CloseShard(
 	return
 }
 
+func (c client) DeleteDomain(
+	ctx context.Context,
+	_Request *admin.DeleteDomainRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_DeleteDomain_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DeleteDomain_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_DeleteDomain_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeCluster(
 	ctx context.Context,
 	opts ...yarpc.CallOption,
@@ -299,6 +452,29 @@ func (c client) DescribeQueue(
 	return
 }
 
+func (c client) DescribeReplicationStatus(
+	ctx context.Context,
+	_Request *shared.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeReplicationStatusResponse, err error) {
+
+	args := admin.AdminService_DescribeReplicationStatus_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeReplicationStatus_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeReplicationStatus_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeShardDistribution(
 	ctx context.Context,
 	_Request *shared.DescribeShardDistributionRequest,
@@ -460,6 +636,29 @@ func (c client) GetWorkflowExecutionRawHistoryV2(
 	return
 }
 
+func (c client) ListDomainChanges(
+	ctx context.Context,
+	_Request *admin.ListDomainChangesRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListDomainChangesResponse, err error) {
+
+	args := admin.AdminService_ListDomainChanges_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ListDomainChanges_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ListDomainChanges_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) MergeDLQMessages(
 	ctx context.Context,
 	_Request *replicator.MergeDLQMessagesRequest,
@@ -483,6 +682,75 @@ func (c client) MergeDLQMessages(
 	return
 }
 
+func (c client) MigrateWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.MigrateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.MigrateWorkflowExecutionResponse, err error) {
+
+	args := admin.AdminService_MigrateWorkflowExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_MigrateWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_MigrateWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) MoveTaskListBacklog(
+	ctx context.Context,
+	_Request *admin.MoveTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.MoveTaskListBacklogResponse, err error) {
+
+	args := admin.AdminService_MoveTaskListBacklog_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_MoveTaskListBacklog_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_MoveTaskListBacklog_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PauseTaskList(
+	ctx context.Context,
+	_Request *admin.PauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_PauseTaskList_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_PauseTaskList_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_PauseTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PurgeDLQMessages(
 	ctx context.Context,
 	_Request *replicator.PurgeDLQMessagesRequest,
@@ -506,6 +774,29 @@ func (c client) PurgeDLQMessages(
 	return
 }
 
+func (c client) PurgeHistoryTaskDLQ(
+	ctx context.Context,
+	_Request *shared.PurgeHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_PurgeHistoryTaskDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_PurgeHistoryTaskDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_PurgeHistoryTaskDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ReadDLQMessages(
 	ctx context.Context,
 	_Request *replicator.ReadDLQMessagesRequest,
@@ -529,6 +820,29 @@ func (c client) ReadDLQMessages(
 	return
 }
 
+func (c client) ReadHistoryTaskDLQ(
+	ctx context.Context,
+	_Request *shared.ReadHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ReadHistoryTaskDLQResponse, err error) {
+
+	args := admin.AdminService_ReadHistoryTaskDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ReadHistoryTaskDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ReadHistoryTaskDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ReapplyEvents(
 	ctx context.Context,
 	_ReapplyEventsRequest *shared.ReapplyEventsRequest,
@@ -575,6 +889,29 @@ func (c client) RefreshWorkflowTasks(
 	return
 }
 
+func (c client) RemoveRemoteCluster(
+	ctx context.Context,
+	_Request *admin.RemoveRemoteClusterRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RemoveRemoteCluster_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RemoveRemoteCluster_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RemoveRemoteCluster_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RemoveTask(
 	ctx context.Context,
 	_Request *shared.RemoveTaskRequest,
@@ -598,6 +935,29 @@ func (c client) RemoveTask(
 	return
 }
 
+func (c client) RenameDomain(
+	ctx context.Context,
+	_Request *admin.RenameDomainRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RenameDomain_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RenameDomain_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RenameDomain_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ResendReplicationTasks(
 	ctx context.Context,
 	_Request *admin.ResendReplicationTasksRequest,
@@ -643,3 +1003,49 @@ func (c client) ResetQueue(
 	err = admin.AdminService_ResetQueue_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) ResumeTaskList(
+	ctx context.Context,
+	_Request *admin.ResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_ResumeTaskList_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ResumeTaskList_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_ResumeTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RetryHistoryTaskDLQ(
+	ctx context.Context,
+	_Request *shared.RetryHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.RetryHistoryTaskDLQResponse, err error) {
+
+	args := admin.AdminService_RetryHistoryTaskDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RetryHistoryTaskDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_RetryHistoryTaskDLQ_Helper.UnwrapResponse(&result)
+	return
+}
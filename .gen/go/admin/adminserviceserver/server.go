@@ -40,16 +40,31 @@ import (
 
 // Interface is the server-side interface for the AdminService service.
 type Interface interface {
+	AddRemoteCluster(
+		ctx context.Context,
+		Request *admin.AddRemoteClusterRequest,
+	) error
+
 	AddSearchAttribute(
 		ctx context.Context,
 		Request *admin.AddSearchAttributeRequest,
 	) error
 
+	CloneDomain(
+		ctx context.Context,
+		Request *admin.CloneDomainRequest,
+	) error
+
 	CloseShard(
 		ctx context.Context,
 		Request *shared.CloseShardRequest,
 	) error
 
+	DeleteDomain(
+		ctx context.Context,
+		Request *admin.DeleteDomainRequest,
+	) error
+
 	DescribeCluster(
 		ctx context.Context,
 	) (*admin.DescribeClusterResponse, error)
@@ -64,6 +79,11 @@ type Interface interface {
 		Request *shared.DescribeQueueRequest,
 	) (*shared.DescribeQueueResponse, error)
 
+	DescribeReplicationStatus(
+		ctx context.Context,
+		Request *shared.DescribeReplicationStatusRequest,
+	) (*shared.DescribeReplicationStatusResponse, error)
+
 	DescribeShardDistribution(
 		ctx context.Context,
 		Request *shared.DescribeShardDistributionRequest,
@@ -99,21 +119,51 @@ type Interface interface {
 		GetRequest *admin.GetWorkflowExecutionRawHistoryV2Request,
 	) (*admin.GetWorkflowExecutionRawHistoryV2Response, error)
 
+	ListDomainChanges(
+		ctx context.Context,
+		Request *admin.ListDomainChangesRequest,
+	) (*admin.ListDomainChangesResponse, error)
+
 	MergeDLQMessages(
 		ctx context.Context,
 		Request *replicator.MergeDLQMessagesRequest,
 	) (*replicator.MergeDLQMessagesResponse, error)
 
+	MigrateWorkflowExecution(
+		ctx context.Context,
+		Request *admin.MigrateWorkflowExecutionRequest,
+	) (*admin.MigrateWorkflowExecutionResponse, error)
+
+	MoveTaskListBacklog(
+		ctx context.Context,
+		Request *admin.MoveTaskListBacklogRequest,
+	) (*shared.MoveTaskListBacklogResponse, error)
+
+	PauseTaskList(
+		ctx context.Context,
+		Request *admin.PauseTaskListRequest,
+	) error
+
 	PurgeDLQMessages(
 		ctx context.Context,
 		Request *replicator.PurgeDLQMessagesRequest,
 	) error
 
+	PurgeHistoryTaskDLQ(
+		ctx context.Context,
+		Request *shared.PurgeHistoryTaskDLQRequest,
+	) error
+
 	ReadDLQMessages(
 		ctx context.Context,
 		Request *replicator.ReadDLQMessagesRequest,
 	) (*replicator.ReadDLQMessagesResponse, error)
 
+	ReadHistoryTaskDLQ(
+		ctx context.Context,
+		Request *shared.ReadHistoryTaskDLQRequest,
+	) (*shared.ReadHistoryTaskDLQResponse, error)
+
 	ReapplyEvents(
 		ctx context.Context,
 		ReapplyEventsRequest *shared.ReapplyEventsRequest,
@@ -124,11 +174,21 @@ type Interface interface {
 		Request *shared.RefreshWorkflowTasksRequest,
 	) error
 
+	RemoveRemoteCluster(
+		ctx context.Context,
+		Request *admin.RemoveRemoteClusterRequest,
+	) error
+
 	RemoveTask(
 		ctx context.Context,
 		Request *shared.RemoveTaskRequest,
 	) error
 
+	RenameDomain(
+		ctx context.Context,
+		Request *admin.RenameDomainRequest,
+	) error
+
 	ResendReplicationTasks(
 		ctx context.Context,
 		Request *admin.ResendReplicationTasksRequest,
@@ -138,6 +198,16 @@ type Interface interface {
 		ctx context.Context,
 		Request *shared.ResetQueueRequest,
 	) error
+
+	ResumeTaskList(
+		ctx context.Context,
+		Request *admin.ResumeTaskListRequest,
+	) error
+
+	RetryHistoryTaskDLQ(
+		ctx context.Context,
+		Request *shared.RetryHistoryTaskDLQRequest,
+	) (*shared.RetryHistoryTaskDLQResponse, error)
 }
 
 // New prepares an implementation of the AdminService service for
@@ -151,6 +221,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		Name: "AdminService",
 		Methods: []thrift.Method{
 
+			thrift.Method{
+				Name: "AddRemoteCluster",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.AddRemoteCluster),
+				},
+				Signature:    "AddRemoteCluster(Request *admin.AddRemoteClusterRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "AddSearchAttribute",
 				HandlerSpec: thrift.HandlerSpec{
@@ -162,6 +243,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "CloneDomain",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.CloneDomain),
+				},
+				Signature:    "CloneDomain(Request *admin.CloneDomainRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "CloseShard",
 				HandlerSpec: thrift.HandlerSpec{
@@ -173,6 +265,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DeleteDomain",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DeleteDomain),
+				},
+				Signature:    "DeleteDomain(Request *admin.DeleteDomainRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeCluster",
 				HandlerSpec: thrift.HandlerSpec{
@@ -206,6 +309,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeReplicationStatus",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeReplicationStatus),
+				},
+				Signature:    "DescribeReplicationStatus(Request *shared.DescribeReplicationStatusRequest) (*shared.DescribeReplicationStatusResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeShardDistribution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -283,6 +397,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListDomainChanges",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListDomainChanges),
+				},
+				Signature:    "ListDomainChanges(Request *admin.ListDomainChangesRequest) (*admin.ListDomainChangesResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "MergeDLQMessages",
 				HandlerSpec: thrift.HandlerSpec{
@@ -294,6 +419,39 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "MigrateWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.MigrateWorkflowExecution),
+				},
+				Signature:    "MigrateWorkflowExecution(Request *admin.MigrateWorkflowExecutionRequest) (*admin.MigrateWorkflowExecutionResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "MoveTaskListBacklog",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.MoveTaskListBacklog),
+				},
+				Signature:    "MoveTaskListBacklog(Request *admin.MoveTaskListBacklogRequest) (*shared.MoveTaskListBacklogResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PauseTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PauseTaskList),
+				},
+				Signature:    "PauseTaskList(Request *admin.PauseTaskListRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PurgeDLQMessages",
 				HandlerSpec: thrift.HandlerSpec{
@@ -305,6 +463,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "PurgeHistoryTaskDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PurgeHistoryTaskDLQ),
+				},
+				Signature:    "PurgeHistoryTaskDLQ(Request *shared.PurgeHistoryTaskDLQRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ReadDLQMessages",
 				HandlerSpec: thrift.HandlerSpec{
@@ -316,6 +485,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ReadHistoryTaskDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ReadHistoryTaskDLQ),
+				},
+				Signature:    "ReadHistoryTaskDLQ(Request *shared.ReadHistoryTaskDLQRequest) (*shared.ReadHistoryTaskDLQResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ReapplyEvents",
 				HandlerSpec: thrift.HandlerSpec{
@@ -338,6 +518,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RemoveRemoteCluster",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RemoveRemoteCluster),
+				},
+				Signature:    "RemoveRemoteCluster(Request *admin.RemoveRemoteClusterRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RemoveTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -349,6 +540,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RenameDomain",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RenameDomain),
+				},
+				Signature:    "RenameDomain(Request *admin.RenameDomainRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ResendReplicationTasks",
 				HandlerSpec: thrift.HandlerSpec{
@@ -370,10 +572,32 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "ResetQueue(Request *shared.ResetQueueRequest)",
 				ThriftModule: admin.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "ResumeTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ResumeTaskList),
+				},
+				Signature:    "ResumeTaskList(Request *admin.ResumeTaskListRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "RetryHistoryTaskDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RetryHistoryTaskDLQ),
+				},
+				Signature:    "RetryHistoryTaskDLQ(Request *shared.RetryHistoryTaskDLQRequest) (*shared.RetryHistoryTaskDLQResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 20)
+	procedures := make([]transport.Procedure, 0, 34)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -384,6 +608,36 @@ type yarpcErrorNamer interface{ YARPCErrorName() string }
 
 type yarpcErrorCoder interface{ YARPCErrorCode() *yarpcerrors.Code }
 
+func (h handler) AddRemoteCluster(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_AddRemoteCluster_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'AddRemoteCluster': %w", err)
+	}
+
+	appErr := h.impl.AddRemoteCluster(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_AddRemoteCluster_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) AddSearchAttribute(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_AddSearchAttribute_Args
 	if err := args.FromWire(body); err != nil {
@@ -414,6 +668,36 @@ func (h handler) AddSearchAttribute(ctx context.Context, body wire.Value) (thrif
 	return response, err
 }
 
+func (h handler) CloneDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_CloneDomain_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'CloneDomain': %w", err)
+	}
+
+	appErr := h.impl.CloneDomain(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_CloneDomain_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_CloseShard_Args
 	if err := args.FromWire(body); err != nil {
@@ -444,6 +728,36 @@ func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
+func (h handler) DeleteDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DeleteDomain_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'DeleteDomain': %w", err)
+	}
+
+	appErr := h.impl.DeleteDomain(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DeleteDomain_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DescribeCluster(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DescribeCluster_Args
 	if err := args.FromWire(body); err != nil {
@@ -534,6 +848,36 @@ func (h handler) DescribeQueue(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
+func (h handler) DescribeReplicationStatus(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeReplicationStatus_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'DescribeReplicationStatus': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeReplicationStatus(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeReplicationStatus_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DescribeShardDistribution(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DescribeShardDistribution_Args
 	if err := args.FromWire(body); err != nil {
@@ -744,6 +1088,36 @@ func (h handler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, body wire
 	return response, err
 }
 
+func (h handler) ListDomainChanges(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListDomainChanges_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ListDomainChanges': %w", err)
+	}
+
+	success, appErr := h.impl.ListDomainChanges(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ListDomainChanges_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_MergeDLQMessages_Args
 	if err := args.FromWire(body); err != nil {
@@ -774,6 +1148,96 @@ func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
+func (h handler) MigrateWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_MigrateWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'MigrateWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.MigrateWorkflowExecution(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_MigrateWorkflowExecution_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) MoveTaskListBacklog(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_MoveTaskListBacklog_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'MoveTaskListBacklog': %w", err)
+	}
+
+	success, appErr := h.impl.MoveTaskListBacklog(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_MoveTaskListBacklog_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) PauseTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PauseTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'PauseTaskList': %w", err)
+	}
+
+	appErr := h.impl.PauseTaskList(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_PauseTaskList_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_PurgeDLQMessages_Args
 	if err := args.FromWire(body); err != nil {
@@ -804,6 +1268,36 @@ func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
+func (h handler) PurgeHistoryTaskDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PurgeHistoryTaskDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'PurgeHistoryTaskDLQ': %w", err)
+	}
+
+	appErr := h.impl.PurgeHistoryTaskDLQ(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_PurgeHistoryTaskDLQ_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ReadDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_ReadDLQMessages_Args
 	if err := args.FromWire(body); err != nil {
@@ -834,6 +1328,36 @@ func (h handler) ReadDLQMessages(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
+func (h handler) ReadHistoryTaskDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReadHistoryTaskDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ReadHistoryTaskDLQ': %w", err)
+	}
+
+	success, appErr := h.impl.ReadHistoryTaskDLQ(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ReadHistoryTaskDLQ_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ReapplyEvents(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_ReapplyEvents_Args
 	if err := args.FromWire(body); err != nil {
@@ -894,6 +1418,36 @@ func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
+func (h handler) RemoveRemoteCluster(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RemoveRemoteCluster_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RemoveRemoteCluster': %w", err)
+	}
+
+	appErr := h.impl.RemoveRemoteCluster(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RemoveRemoteCluster_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_RemoveTask_Args
 	if err := args.FromWire(body); err != nil {
@@ -924,6 +1478,36 @@ func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
+func (h handler) RenameDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RenameDomain_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RenameDomain': %w", err)
+	}
+
+	appErr := h.impl.RenameDomain(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RenameDomain_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ResendReplicationTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_ResendReplicationTasks_Args
 	if err := args.FromWire(body); err != nil {
@@ -983,3 +1567,63 @@ func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Respon
 
 	return response, err
 }
+
+func (h handler) ResumeTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResumeTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ResumeTaskList': %w", err)
+	}
+
+	appErr := h.impl.ResumeTaskList(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ResumeTaskList_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RetryHistoryTaskDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RetryHistoryTaskDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RetryHistoryTaskDLQ': %w", err)
+	}
+
+	success, appErr := h.impl.RetryHistoryTaskDLQ(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RetryHistoryTaskDLQ_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
@@ -68,6 +68,37 @@ func (m *MockClient) EXPECT() *_MockClientRecorder {
 	return m.recorder
 }
 
+// AddRemoteCluster responds to a AddRemoteCluster call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().AddRemoteCluster(gomock.Any(), ...).Return(...)
+// 	... := client.AddRemoteCluster(...)
+func (m *MockClient) AddRemoteCluster(
+	ctx context.Context,
+	_Request *admin.AddRemoteClusterRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "AddRemoteCluster", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) AddRemoteCluster(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "AddRemoteCluster", args...)
+}
+
 // AddSearchAttribute responds to a AddSearchAttribute call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -99,6 +130,37 @@ func (mr *_MockClientRecorder) AddSearchAttribute(
 	return mr.mock.ctrl.RecordCall(mr.mock, "AddSearchAttribute", args...)
 }
 
+// CloneDomain responds to a CloneDomain call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().CloneDomain(gomock.Any(), ...).Return(...)
+// 	... := client.CloneDomain(...)
+func (m *MockClient) CloneDomain(
+	ctx context.Context,
+	_Request *admin.CloneDomainRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "CloneDomain", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) CloneDomain(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "CloneDomain", args...)
+}
+
 // CloseShard responds to a CloseShard call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -130,6 +192,37 @@ func (mr *_MockClientRecorder) CloseShard(
 	return mr.mock.ctrl.RecordCall(mr.mock, "CloseShard", args...)
 }
 
+// DeleteDomain responds to a DeleteDomain call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().DeleteDomain(gomock.Any(), ...).Return(...)
+// 	... := client.DeleteDomain(...)
+func (m *MockClient) DeleteDomain(
+	ctx context.Context,
+	_Request *admin.DeleteDomainRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DeleteDomain", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DeleteDomain(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DeleteDomain", args...)
+}
+
 // DescribeCluster responds to a DescribeCluster call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -227,6 +320,39 @@ func (mr *_MockClientRecorder) DescribeQueue(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeQueue", args...)
 }
 
+// DescribeReplicationStatus responds to a DescribeReplicationStatus call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().DescribeReplicationStatus(gomock.Any(), ...).Return(...)
+// 	... := client.DescribeReplicationStatus(...)
+func (m *MockClient) DescribeReplicationStatus(
+	ctx context.Context,
+	_Request *shared.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeReplicationStatusResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeReplicationStatus", args...)
+	success, _ = ret[i].(*shared.DescribeReplicationStatusResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeReplicationStatus(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeReplicationStatus", args...)
+}
+
 // DescribeShardDistribution responds to a DescribeShardDistribution call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -458,6 +584,39 @@ func (mr *_MockClientRecorder) GetWorkflowExecutionRawHistoryV2(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetWorkflowExecutionRawHistoryV2", args...)
 }
 
+// ListDomainChanges responds to a ListDomainChanges call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ListDomainChanges(gomock.Any(), ...).Return(...)
+// 	... := client.ListDomainChanges(...)
+func (m *MockClient) ListDomainChanges(
+	ctx context.Context,
+	_Request *admin.ListDomainChangesRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListDomainChangesResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListDomainChanges", args...)
+	success, _ = ret[i].(*admin.ListDomainChangesResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListDomainChanges(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListDomainChanges", args...)
+}
+
 // MergeDLQMessages responds to a MergeDLQMessages call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -491,6 +650,103 @@ func (mr *_MockClientRecorder) MergeDLQMessages(
 	return mr.mock.ctrl.RecordCall(mr.mock, "MergeDLQMessages", args...)
 }
 
+// MigrateWorkflowExecution responds to a MigrateWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().MigrateWorkflowExecution(gomock.Any(), ...).Return(...)
+// 	... := client.MigrateWorkflowExecution(...)
+func (m *MockClient) MigrateWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.MigrateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.MigrateWorkflowExecutionResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "MigrateWorkflowExecution", args...)
+	success, _ = ret[i].(*admin.MigrateWorkflowExecutionResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) MigrateWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "MigrateWorkflowExecution", args...)
+}
+
+// MoveTaskListBacklog responds to a MoveTaskListBacklog call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().MoveTaskListBacklog(gomock.Any(), ...).Return(...)
+// 	... := client.MoveTaskListBacklog(...)
+func (m *MockClient) MoveTaskListBacklog(
+	ctx context.Context,
+	_Request *admin.MoveTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.MoveTaskListBacklogResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "MoveTaskListBacklog", args...)
+	success, _ = ret[i].(*shared.MoveTaskListBacklogResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) MoveTaskListBacklog(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "MoveTaskListBacklog", args...)
+}
+
+// PauseTaskList responds to a PauseTaskList call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().PauseTaskList(gomock.Any(), ...).Return(...)
+// 	... := client.PauseTaskList(...)
+func (m *MockClient) PauseTaskList(
+	ctx context.Context,
+	_Request *admin.PauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PauseTaskList", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PauseTaskList(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PauseTaskList", args...)
+}
+
 // PurgeDLQMessages responds to a PurgeDLQMessages call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -522,6 +778,37 @@ func (mr *_MockClientRecorder) PurgeDLQMessages(
 	return mr.mock.ctrl.RecordCall(mr.mock, "PurgeDLQMessages", args...)
 }
 
+// PurgeHistoryTaskDLQ responds to a PurgeHistoryTaskDLQ call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().PurgeHistoryTaskDLQ(gomock.Any(), ...).Return(...)
+// 	... := client.PurgeHistoryTaskDLQ(...)
+func (m *MockClient) PurgeHistoryTaskDLQ(
+	ctx context.Context,
+	_Request *shared.PurgeHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PurgeHistoryTaskDLQ", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PurgeHistoryTaskDLQ(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PurgeHistoryTaskDLQ", args...)
+}
+
 // ReadDLQMessages responds to a ReadDLQMessages call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -555,6 +842,39 @@ func (mr *_MockClientRecorder) ReadDLQMessages(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ReadDLQMessages", args...)
 }
 
+// ReadHistoryTaskDLQ responds to a ReadHistoryTaskDLQ call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ReadHistoryTaskDLQ(gomock.Any(), ...).Return(...)
+// 	... := client.ReadHistoryTaskDLQ(...)
+func (m *MockClient) ReadHistoryTaskDLQ(
+	ctx context.Context,
+	_Request *shared.ReadHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ReadHistoryTaskDLQResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReadHistoryTaskDLQ", args...)
+	success, _ = ret[i].(*shared.ReadHistoryTaskDLQResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReadHistoryTaskDLQ(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReadHistoryTaskDLQ", args...)
+}
+
 // ReapplyEvents responds to a ReapplyEvents call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -617,6 +937,37 @@ func (mr *_MockClientRecorder) RefreshWorkflowTasks(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RefreshWorkflowTasks", args...)
 }
 
+// RemoveRemoteCluster responds to a RemoveRemoteCluster call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().RemoveRemoteCluster(gomock.Any(), ...).Return(...)
+// 	... := client.RemoveRemoteCluster(...)
+func (m *MockClient) RemoveRemoteCluster(
+	ctx context.Context,
+	_Request *admin.RemoveRemoteClusterRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RemoveRemoteCluster", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RemoveRemoteCluster(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RemoveRemoteCluster", args...)
+}
+
 // RemoveTask responds to a RemoveTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -648,6 +999,37 @@ func (mr *_MockClientRecorder) RemoveTask(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RemoveTask", args...)
 }
 
+// RenameDomain responds to a RenameDomain call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().RenameDomain(gomock.Any(), ...).Return(...)
+// 	... := client.RenameDomain(...)
+func (m *MockClient) RenameDomain(
+	ctx context.Context,
+	_Request *admin.RenameDomainRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RenameDomain", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RenameDomain(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RenameDomain", args...)
+}
+
 // ResendReplicationTasks responds to a ResendReplicationTasks call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -709,3 +1091,67 @@ func (mr *_MockClientRecorder) ResetQueue(
 	args := append([]interface{}{ctx, _Request}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "ResetQueue", args...)
 }
+
+// ResumeTaskList responds to a ResumeTaskList call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ResumeTaskList(gomock.Any(), ...).Return(...)
+// 	... := client.ResumeTaskList(...)
+func (m *MockClient) ResumeTaskList(
+	ctx context.Context,
+	_Request *admin.ResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResumeTaskList", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResumeTaskList(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResumeTaskList", args...)
+}
+
+// RetryHistoryTaskDLQ responds to a RetryHistoryTaskDLQ call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().RetryHistoryTaskDLQ(gomock.Any(), ...).Return(...)
+// 	... := client.RetryHistoryTaskDLQ(...)
+func (m *MockClient) RetryHistoryTaskDLQ(
+	ctx context.Context,
+	_Request *shared.RetryHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.RetryHistoryTaskDLQResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RetryHistoryTaskDLQ", args...)
+	success, _ = ret[i].(*shared.RetryHistoryTaskDLQResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RetryHistoryTaskDLQ(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RetryHistoryTaskDLQ", args...)
+}
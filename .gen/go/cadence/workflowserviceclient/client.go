@@ -40,12 +40,30 @@ import (
 
 // Interface is a client for the WorkflowService service.
 type Interface interface {
+	BackfillSchedule(
+		ctx context.Context,
+		BackfillRequest *shared.BackfillScheduleRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	CountWorkflowExecutions(
 		ctx context.Context,
 		CountRequest *shared.CountWorkflowExecutionsRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.CountWorkflowExecutionsResponse, error)
 
+	CreateSchedule(
+		ctx context.Context,
+		CreateRequest *shared.CreateScheduleRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	DeleteWorkflowExecution(
+		ctx context.Context,
+		DeleteRequest *shared.DeleteWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	DeprecateDomain(
 		ctx context.Context,
 		DeprecateRequest *shared.DeprecateDomainRequest,
@@ -58,6 +76,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeDomainResponse, error)
 
+	DescribeSchedule(
+		ctx context.Context,
+		DescribeRequest *shared.DescribeScheduleRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeScheduleResponse, error)
+
 	DescribeTaskList(
 		ctx context.Context,
 		Request *shared.DescribeTaskListRequest,
@@ -116,6 +140,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.ListOpenWorkflowExecutionsResponse, error)
 
+	ListSchedules(
+		ctx context.Context,
+		ListRequest *shared.ListSchedulesRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListSchedulesResponse, error)
+
 	ListTaskListPartitions(
 		ctx context.Context,
 		Request *shared.ListTaskListPartitionsRequest,
@@ -128,6 +158,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.ListWorkflowExecutionsResponse, error)
 
+	PauseActivity(
+		ctx context.Context,
+		PauseRequest *shared.PauseActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	PauseSchedule(
+		ctx context.Context,
+		PauseRequest *shared.PauseScheduleRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *shared.PauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *shared.PollForActivityTaskRequest,
@@ -170,6 +218,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ResetActivity(
+		ctx context.Context,
+		ResetRequest *shared.ResetActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ResetStickyTaskList(
 		ctx context.Context,
 		ResetRequest *shared.ResetStickyTaskListRequest,
@@ -236,6 +290,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RestartWorkflowExecution(
+		ctx context.Context,
+		RestartRequest *shared.RestartWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.RestartWorkflowExecutionResponse, error)
+
 	ScanWorkflowExecutions(
 		ctx context.Context,
 		ListRequest *shared.ListWorkflowExecutionsRequest,
@@ -266,11 +326,41 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	UnpauseActivity(
+		ctx context.Context,
+		UnpauseRequest *shared.UnpauseActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UnpauseSchedule(
+		ctx context.Context,
+		UnpauseRequest *shared.UnpauseScheduleRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UnpauseWorkflowExecution(
+		ctx context.Context,
+		UnpauseRequest *shared.UnpauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	UpdateDomain(
 		ctx context.Context,
 		UpdateRequest *shared.UpdateDomainRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.UpdateDomainResponse, error)
+
+	UpdateSchedule(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateScheduleRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.UpdateWorkflowExecutionResponse, error)
 }
 
 // New builds a new client for the WorkflowService service.
@@ -297,6 +387,29 @@ type client struct {
 	c thrift.Client
 }
 
+func (c client) BackfillSchedule(
+	ctx context.Context,
+	_BackfillRequest *shared.BackfillScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_BackfillSchedule_Helper.Args(_BackfillRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_BackfillSchedule_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_BackfillSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) CountWorkflowExecutions(
 	ctx context.Context,
 	_CountRequest *shared.CountWorkflowExecutionsRequest,
@@ -320,6 +433,52 @@ func (c client) CountWorkflowExecutions(
 	return
 }
 
+func (c client) CreateSchedule(
+	ctx context.Context,
+	_CreateRequest *shared.CreateScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_CreateSchedule_Helper.Args(_CreateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_CreateSchedule_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_CreateSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) DeleteWorkflowExecution(
+	ctx context.Context,
+	_DeleteRequest *shared.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_DeleteWorkflowExecution_Helper.Args(_DeleteRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_DeleteWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_DeleteWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DeprecateDomain(
 	ctx context.Context,
 	_DeprecateRequest *shared.DeprecateDomainRequest,
@@ -366,6 +525,29 @@ func (c client) DescribeDomain(
 	return
 }
 
+func (c client) DescribeSchedule(
+	ctx context.Context,
+	_DescribeRequest *shared.DescribeScheduleRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeScheduleResponse, err error) {
+
+	args := cadence.WorkflowService_DescribeSchedule_Helper.Args(_DescribeRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_DescribeSchedule_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_DescribeSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeTaskList(
 	ctx context.Context,
 	_Request *shared.DescribeTaskListRequest,
@@ -594,6 +776,29 @@ func (c client) ListOpenWorkflowExecutions(
 	return
 }
 
+func (c client) ListSchedules(
+	ctx context.Context,
+	_ListRequest *shared.ListSchedulesRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListSchedulesResponse, err error) {
+
+	args := cadence.WorkflowService_ListSchedules_Helper.Args(_ListRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_ListSchedules_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_ListSchedules_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ListTaskListPartitions(
 	ctx context.Context,
 	_Request *shared.ListTaskListPartitionsRequest,
@@ -640,6 +845,75 @@ func (c client) ListWorkflowExecutions(
 	return
 }
 
+func (c client) PauseActivity(
+	ctx context.Context,
+	_PauseRequest *shared.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_PauseActivity_Helper.Args(_PauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_PauseActivity_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_PauseActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PauseSchedule(
+	ctx context.Context,
+	_PauseRequest *shared.PauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_PauseSchedule_Helper.Args(_PauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_PauseSchedule_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_PauseSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PauseWorkflowExecution(
+	ctx context.Context,
+	_PauseRequest *shared.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_PauseWorkflowExecution_Helper.Args(_PauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_PauseWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_PauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollForActivityTask(
 	ctx context.Context,
 	_PollRequest *shared.PollForActivityTaskRequest,
@@ -801,6 +1075,29 @@ func (c client) RequestCancelWorkflowExecution(
 	return
 }
 
+func (c client) ResetActivity(
+	ctx context.Context,
+	_ResetRequest *shared.ResetActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_ResetActivity_Helper.Args(_ResetRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_ResetActivity_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_ResetActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ResetStickyTaskList(
 	ctx context.Context,
 	_ResetRequest *shared.ResetStickyTaskListRequest,
@@ -1054,6 +1351,29 @@ func (c client) RespondQueryTaskCompleted(
 	return
 }
 
+func (c client) RestartWorkflowExecution(
+	ctx context.Context,
+	_RestartRequest *shared.RestartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.RestartWorkflowExecutionResponse, err error) {
+
+	args := cadence.WorkflowService_RestartWorkflowExecution_Helper.Args(_RestartRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_RestartWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_RestartWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ScanWorkflowExecutions(
 	ctx context.Context,
 	_ListRequest *shared.ListWorkflowExecutionsRequest,
@@ -1169,6 +1489,75 @@ func (c client) TerminateWorkflowExecution(
 	return
 }
 
+func (c client) UnpauseActivity(
+	ctx context.Context,
+	_UnpauseRequest *shared.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_UnpauseActivity_Helper.Args(_UnpauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_UnpauseActivity_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_UnpauseActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UnpauseSchedule(
+	ctx context.Context,
+	_UnpauseRequest *shared.UnpauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_UnpauseSchedule_Helper.Args(_UnpauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_UnpauseSchedule_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_UnpauseSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UnpauseWorkflowExecution(
+	ctx context.Context,
+	_UnpauseRequest *shared.UnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_UnpauseWorkflowExecution_Helper.Args(_UnpauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_UnpauseWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_UnpauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) UpdateDomain(
 	ctx context.Context,
 	_UpdateRequest *shared.UpdateDomainRequest,
@@ -1191,3 +1580,49 @@ func (c client) UpdateDomain(
 	success, err = cadence.WorkflowService_UpdateDomain_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateSchedule(
+	ctx context.Context,
+	_UpdateRequest *shared.UpdateScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_UpdateSchedule_Helper.Args(_UpdateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_UpdateSchedule_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_UpdateSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateWorkflowExecution(
+	ctx context.Context,
+	_UpdateRequest *shared.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateWorkflowExecutionResponse, err error) {
+
+	args := cadence.WorkflowService_UpdateWorkflowExecution_Helper.Args(_UpdateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_UpdateWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_UpdateWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
@@ -39,11 +39,26 @@ import (
 
 // Interface is the server-side interface for the WorkflowService service.
 type Interface interface {
+	BackfillSchedule(
+		ctx context.Context,
+		BackfillRequest *shared.BackfillScheduleRequest,
+	) error
+
 	CountWorkflowExecutions(
 		ctx context.Context,
 		CountRequest *shared.CountWorkflowExecutionsRequest,
 	) (*shared.CountWorkflowExecutionsResponse, error)
 
+	CreateSchedule(
+		ctx context.Context,
+		CreateRequest *shared.CreateScheduleRequest,
+	) error
+
+	DeleteWorkflowExecution(
+		ctx context.Context,
+		DeleteRequest *shared.DeleteWorkflowExecutionRequest,
+	) error
+
 	DeprecateDomain(
 		ctx context.Context,
 		DeprecateRequest *shared.DeprecateDomainRequest,
@@ -54,6 +69,11 @@ type Interface interface {
 		DescribeRequest *shared.DescribeDomainRequest,
 	) (*shared.DescribeDomainResponse, error)
 
+	DescribeSchedule(
+		ctx context.Context,
+		DescribeRequest *shared.DescribeScheduleRequest,
+	) (*shared.DescribeScheduleResponse, error)
+
 	DescribeTaskList(
 		ctx context.Context,
 		Request *shared.DescribeTaskListRequest,
@@ -102,6 +122,11 @@ type Interface interface {
 		ListRequest *shared.ListOpenWorkflowExecutionsRequest,
 	) (*shared.ListOpenWorkflowExecutionsResponse, error)
 
+	ListSchedules(
+		ctx context.Context,
+		ListRequest *shared.ListSchedulesRequest,
+	) (*shared.ListSchedulesResponse, error)
+
 	ListTaskListPartitions(
 		ctx context.Context,
 		Request *shared.ListTaskListPartitionsRequest,
@@ -112,6 +137,21 @@ type Interface interface {
 		ListRequest *shared.ListWorkflowExecutionsRequest,
 	) (*shared.ListWorkflowExecutionsResponse, error)
 
+	PauseActivity(
+		ctx context.Context,
+		PauseRequest *shared.PauseActivityRequest,
+	) error
+
+	PauseSchedule(
+		ctx context.Context,
+		PauseRequest *shared.PauseScheduleRequest,
+	) error
+
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *shared.PauseWorkflowExecutionRequest,
+	) error
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *shared.PollForActivityTaskRequest,
@@ -147,6 +187,11 @@ type Interface interface {
 		CancelRequest *shared.RequestCancelWorkflowExecutionRequest,
 	) error
 
+	ResetActivity(
+		ctx context.Context,
+		ResetRequest *shared.ResetActivityRequest,
+	) error
+
 	ResetStickyTaskList(
 		ctx context.Context,
 		ResetRequest *shared.ResetStickyTaskListRequest,
@@ -202,6 +247,11 @@ type Interface interface {
 		CompleteRequest *shared.RespondQueryTaskCompletedRequest,
 	) error
 
+	RestartWorkflowExecution(
+		ctx context.Context,
+		RestartRequest *shared.RestartWorkflowExecutionRequest,
+	) (*shared.RestartWorkflowExecutionResponse, error)
+
 	ScanWorkflowExecutions(
 		ctx context.Context,
 		ListRequest *shared.ListWorkflowExecutionsRequest,
@@ -227,10 +277,35 @@ type Interface interface {
 		TerminateRequest *shared.TerminateWorkflowExecutionRequest,
 	) error
 
+	UnpauseActivity(
+		ctx context.Context,
+		UnpauseRequest *shared.UnpauseActivityRequest,
+	) error
+
+	UnpauseSchedule(
+		ctx context.Context,
+		UnpauseRequest *shared.UnpauseScheduleRequest,
+	) error
+
+	UnpauseWorkflowExecution(
+		ctx context.Context,
+		UnpauseRequest *shared.UnpauseWorkflowExecutionRequest,
+	) error
+
 	UpdateDomain(
 		ctx context.Context,
 		UpdateRequest *shared.UpdateDomainRequest,
 	) (*shared.UpdateDomainResponse, error)
+
+	UpdateSchedule(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateScheduleRequest,
+	) error
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateWorkflowExecutionRequest,
+	) (*shared.UpdateWorkflowExecutionResponse, error)
 }
 
 // New prepares an implementation of the WorkflowService service for
@@ -244,6 +319,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		Name: "WorkflowService",
 		Methods: []thrift.Method{
 
+			thrift.Method{
+				Name: "BackfillSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.BackfillSchedule),
+				},
+				Signature:    "BackfillSchedule(BackfillRequest *shared.BackfillScheduleRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "CountWorkflowExecutions",
 				HandlerSpec: thrift.HandlerSpec{
@@ -255,6 +341,28 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "CreateSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.CreateSchedule),
+				},
+				Signature:    "CreateSchedule(CreateRequest *shared.CreateScheduleRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "DeleteWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DeleteWorkflowExecution),
+				},
+				Signature:    "DeleteWorkflowExecution(DeleteRequest *shared.DeleteWorkflowExecutionRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DeprecateDomain",
 				HandlerSpec: thrift.HandlerSpec{
@@ -277,6 +385,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeSchedule),
+				},
+				Signature:    "DescribeSchedule(DescribeRequest *shared.DescribeScheduleRequest) (*shared.DescribeScheduleResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeTaskList",
 				HandlerSpec: thrift.HandlerSpec{
@@ -387,6 +506,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListSchedules",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListSchedules),
+				},
+				Signature:    "ListSchedules(ListRequest *shared.ListSchedulesRequest) (*shared.ListSchedulesResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ListTaskListPartitions",
 				HandlerSpec: thrift.HandlerSpec{
@@ -409,6 +539,39 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "PauseActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PauseActivity),
+				},
+				Signature:    "PauseActivity(PauseRequest *shared.PauseActivityRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PauseSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PauseSchedule),
+				},
+				Signature:    "PauseSchedule(PauseRequest *shared.PauseScheduleRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PauseWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PauseWorkflowExecution),
+				},
+				Signature:    "PauseWorkflowExecution(PauseRequest *shared.PauseWorkflowExecutionRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PollForActivityTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -486,6 +649,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ResetActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ResetActivity),
+				},
+				Signature:    "ResetActivity(ResetRequest *shared.ResetActivityRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ResetStickyTaskList",
 				HandlerSpec: thrift.HandlerSpec{
@@ -607,6 +781,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RestartWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RestartWorkflowExecution),
+				},
+				Signature:    "RestartWorkflowExecution(RestartRequest *shared.RestartWorkflowExecutionRequest) (*shared.RestartWorkflowExecutionResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ScanWorkflowExecutions",
 				HandlerSpec: thrift.HandlerSpec{
@@ -662,6 +847,39 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "UnpauseActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UnpauseActivity),
+				},
+				Signature:    "UnpauseActivity(UnpauseRequest *shared.UnpauseActivityRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UnpauseSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UnpauseSchedule),
+				},
+				Signature:    "UnpauseSchedule(UnpauseRequest *shared.UnpauseScheduleRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UnpauseWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UnpauseWorkflowExecution),
+				},
+				Signature:    "UnpauseWorkflowExecution(UnpauseRequest *shared.UnpauseWorkflowExecutionRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "UpdateDomain",
 				HandlerSpec: thrift.HandlerSpec{
@@ -672,10 +890,32 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "UpdateDomain(UpdateRequest *shared.UpdateDomainRequest) (*shared.UpdateDomainResponse)",
 				ThriftModule: cadence.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UpdateSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateSchedule),
+				},
+				Signature:    "UpdateSchedule(UpdateRequest *shared.UpdateScheduleRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateWorkflowExecution),
+				},
+				Signature:    "UpdateWorkflowExecution(UpdateRequest *shared.UpdateWorkflowExecutionRequest) (*shared.UpdateWorkflowExecutionResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 39)
+	procedures := make([]transport.Procedure, 0, 54)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -686,6 +926,36 @@ type yarpcErrorNamer interface{ YARPCErrorName() string }
 
 type yarpcErrorCoder interface{ YARPCErrorCode() *yarpcerrors.Code }
 
+func (h handler) BackfillSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_BackfillSchedule_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'BackfillSchedule': %w", err)
+	}
+
+	appErr := h.impl.BackfillSchedule(ctx, args.BackfillRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_BackfillSchedule_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) CountWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_CountWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
@@ -716,6 +986,66 @@ func (h handler) CountWorkflowExecutions(ctx context.Context, body wire.Value) (
 	return response, err
 }
 
+func (h handler) CreateSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_CreateSchedule_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'CreateSchedule': %w", err)
+	}
+
+	appErr := h.impl.CreateSchedule(ctx, args.CreateRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_CreateSchedule_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) DeleteWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_DeleteWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'DeleteWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.DeleteWorkflowExecution(ctx, args.DeleteRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_DeleteWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DeprecateDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_DeprecateDomain_Args
 	if err := args.FromWire(body); err != nil {
@@ -776,6 +1106,36 @@ func (h handler) DescribeDomain(ctx context.Context, body wire.Value) (thrift.Re
 	return response, err
 }
 
+func (h handler) DescribeSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_DescribeSchedule_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'DescribeSchedule': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeSchedule(ctx, args.DescribeRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_DescribeSchedule_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DescribeTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_DescribeTaskList_Args
 	if err := args.FromWire(body); err != nil {
@@ -836,17 +1196,137 @@ func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) GetClusterInfo(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_GetClusterInfo_Args
+func (h handler) GetClusterInfo(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetClusterInfo_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetClusterInfo': %w", err)
+	}
+
+	success, appErr := h.impl.GetClusterInfo(ctx)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_GetClusterInfo_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) GetSearchAttributes(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetSearchAttributes_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetSearchAttributes': %w", err)
+	}
+
+	success, appErr := h.impl.GetSearchAttributes(ctx)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_GetSearchAttributes_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) GetTaskListsByDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetTaskListsByDomain_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetTaskListsByDomain': %w", err)
+	}
+
+	success, appErr := h.impl.GetTaskListsByDomain(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_GetTaskListsByDomain_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) GetWorkflowExecutionHistory(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetWorkflowExecutionHistory_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetWorkflowExecutionHistory': %w", err)
+	}
+
+	success, appErr := h.impl.GetWorkflowExecutionHistory(ctx, args.GetRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_GetWorkflowExecutionHistory_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) ListArchivedWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListArchivedWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'GetClusterInfo': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListArchivedWorkflowExecutions': %w", err)
 	}
 
-	success, appErr := h.impl.GetClusterInfo(ctx)
+	success, appErr := h.impl.ListArchivedWorkflowExecutions(ctx, args.ListRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_GetClusterInfo_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListArchivedWorkflowExecutions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -866,17 +1346,17 @@ func (h handler) GetClusterInfo(ctx context.Context, body wire.Value) (thrift.Re
 	return response, err
 }
 
-func (h handler) GetSearchAttributes(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_GetSearchAttributes_Args
+func (h handler) ListClosedWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListClosedWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'GetSearchAttributes': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListClosedWorkflowExecutions': %w", err)
 	}
 
-	success, appErr := h.impl.GetSearchAttributes(ctx)
+	success, appErr := h.impl.ListClosedWorkflowExecutions(ctx, args.ListRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_GetSearchAttributes_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListClosedWorkflowExecutions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -896,17 +1376,17 @@ func (h handler) GetSearchAttributes(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
-func (h handler) GetTaskListsByDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_GetTaskListsByDomain_Args
+func (h handler) ListDomains(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListDomains_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'GetTaskListsByDomain': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListDomains': %w", err)
 	}
 
-	success, appErr := h.impl.GetTaskListsByDomain(ctx, args.Request)
+	success, appErr := h.impl.ListDomains(ctx, args.ListRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_GetTaskListsByDomain_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListDomains_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -926,17 +1406,17 @@ func (h handler) GetTaskListsByDomain(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
-func (h handler) GetWorkflowExecutionHistory(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_GetWorkflowExecutionHistory_Args
+func (h handler) ListOpenWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListOpenWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'GetWorkflowExecutionHistory': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListOpenWorkflowExecutions': %w", err)
 	}
 
-	success, appErr := h.impl.GetWorkflowExecutionHistory(ctx, args.GetRequest)
+	success, appErr := h.impl.ListOpenWorkflowExecutions(ctx, args.ListRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_GetWorkflowExecutionHistory_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListOpenWorkflowExecutions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -956,17 +1436,17 @@ func (h handler) GetWorkflowExecutionHistory(ctx context.Context, body wire.Valu
 	return response, err
 }
 
-func (h handler) ListArchivedWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListArchivedWorkflowExecutions_Args
+func (h handler) ListSchedules(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListSchedules_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListArchivedWorkflowExecutions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListSchedules': %w", err)
 	}
 
-	success, appErr := h.impl.ListArchivedWorkflowExecutions(ctx, args.ListRequest)
+	success, appErr := h.impl.ListSchedules(ctx, args.ListRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListArchivedWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListSchedules_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -986,17 +1466,17 @@ func (h handler) ListArchivedWorkflowExecutions(ctx context.Context, body wire.V
 	return response, err
 }
 
-func (h handler) ListClosedWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListClosedWorkflowExecutions_Args
+func (h handler) ListTaskListPartitions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListTaskListPartitions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListClosedWorkflowExecutions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListTaskListPartitions': %w", err)
 	}
 
-	success, appErr := h.impl.ListClosedWorkflowExecutions(ctx, args.ListRequest)
+	success, appErr := h.impl.ListTaskListPartitions(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListClosedWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListTaskListPartitions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1016,17 +1496,17 @@ func (h handler) ListClosedWorkflowExecutions(ctx context.Context, body wire.Val
 	return response, err
 }
 
-func (h handler) ListDomains(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListDomains_Args
+func (h handler) ListWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListDomains': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListWorkflowExecutions': %w", err)
 	}
 
-	success, appErr := h.impl.ListDomains(ctx, args.ListRequest)
+	success, appErr := h.impl.ListWorkflowExecutions(ctx, args.ListRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListDomains_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListWorkflowExecutions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1046,17 +1526,17 @@ func (h handler) ListDomains(ctx context.Context, body wire.Value) (thrift.Respo
 	return response, err
 }
 
-func (h handler) ListOpenWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListOpenWorkflowExecutions_Args
+func (h handler) PauseActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_PauseActivity_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListOpenWorkflowExecutions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'PauseActivity': %w", err)
 	}
 
-	success, appErr := h.impl.ListOpenWorkflowExecutions(ctx, args.ListRequest)
+	appErr := h.impl.PauseActivity(ctx, args.PauseRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListOpenWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_PauseActivity_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1076,17 +1556,17 @@ func (h handler) ListOpenWorkflowExecutions(ctx context.Context, body wire.Value
 	return response, err
 }
 
-func (h handler) ListTaskListPartitions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListTaskListPartitions_Args
+func (h handler) PauseSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_PauseSchedule_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListTaskListPartitions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'PauseSchedule': %w", err)
 	}
 
-	success, appErr := h.impl.ListTaskListPartitions(ctx, args.Request)
+	appErr := h.impl.PauseSchedule(ctx, args.PauseRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListTaskListPartitions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_PauseSchedule_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1106,17 +1586,17 @@ func (h handler) ListTaskListPartitions(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) ListWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListWorkflowExecutions_Args
+func (h handler) PauseWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_PauseWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListWorkflowExecutions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'PauseWorkflowExecution': %w", err)
 	}
 
-	success, appErr := h.impl.ListWorkflowExecutions(ctx, args.ListRequest)
+	appErr := h.impl.PauseWorkflowExecution(ctx, args.PauseRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_PauseWorkflowExecution_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1346,6 +1826,36 @@ func (h handler) RequestCancelWorkflowExecution(ctx context.Context, body wire.V
 	return response, err
 }
 
+func (h handler) ResetActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ResetActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ResetActivity': %w", err)
+	}
+
+	appErr := h.impl.ResetActivity(ctx, args.ResetRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ResetActivity_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ResetStickyTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_ResetStickyTaskList_Args
 	if err := args.FromWire(body); err != nil {
@@ -1676,6 +2186,36 @@ func (h handler) RespondQueryTaskCompleted(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) RestartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RestartWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RestartWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.RestartWorkflowExecution(ctx, args.RestartRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_RestartWorkflowExecution_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ScanWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_ScanWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
@@ -1826,6 +2366,96 @@ func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value
 	return response, err
 }
 
+func (h handler) UnpauseActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UnpauseActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'UnpauseActivity': %w", err)
+	}
+
+	appErr := h.impl.UnpauseActivity(ctx, args.UnpauseRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UnpauseActivity_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UnpauseSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UnpauseSchedule_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'UnpauseSchedule': %w", err)
+	}
+
+	appErr := h.impl.UnpauseSchedule(ctx, args.UnpauseRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UnpauseSchedule_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UnpauseWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UnpauseWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'UnpauseWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.UnpauseWorkflowExecution(ctx, args.UnpauseRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UnpauseWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) UpdateDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_UpdateDomain_Args
 	if err := args.FromWire(body); err != nil {
@@ -1855,3 +2485,63 @@ func (h handler) UpdateDomain(ctx context.Context, body wire.Value) (thrift.Resp
 
 	return response, err
 }
+
+func (h handler) UpdateSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UpdateSchedule_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'UpdateSchedule': %w", err)
+	}
+
+	appErr := h.impl.UpdateSchedule(ctx, args.UpdateRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UpdateSchedule_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UpdateWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UpdateWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'UpdateWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.UpdateWorkflowExecution(ctx, args.UpdateRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UpdateWorkflowExecution_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
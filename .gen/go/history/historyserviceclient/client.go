@@ -47,6 +47,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	DeleteWorkflowExecution(
+		ctx context.Context,
+		DeleteRequest *history.DeleteWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
@@ -65,6 +71,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeQueueResponse, error)
 
+	DescribeReplicationStatus(
+		ctx context.Context,
+		Request *shared.DescribeReplicationStatusRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeReplicationStatusResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		DescribeRequest *history.DescribeWorkflowExecutionRequest,
@@ -101,12 +113,30 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*replicator.MergeDLQMessagesResponse, error)
 
+	MigrateWorkflowExecution(
+		ctx context.Context,
+		MigrateRequest *history.MigrateWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*history.MigrateWorkflowExecutionResponse, error)
+
 	NotifyFailoverMarkers(
 		ctx context.Context,
 		Request *history.NotifyFailoverMarkersRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	PauseActivity(
+		ctx context.Context,
+		PauseRequest *history.PauseActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *history.PauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	PollMutableState(
 		ctx context.Context,
 		PollRequest *history.PollMutableStateRequest,
@@ -119,6 +149,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	PurgeHistoryTaskDLQ(
+		ctx context.Context,
+		Request *shared.PurgeHistoryTaskDLQRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	QueryWorkflow(
 		ctx context.Context,
 		QueryRequest *history.QueryWorkflowRequest,
@@ -131,6 +167,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*replicator.ReadDLQMessagesResponse, error)
 
+	ReadHistoryTaskDLQ(
+		ctx context.Context,
+		Request *shared.ReadHistoryTaskDLQRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ReadHistoryTaskDLQResponse, error)
+
 	ReapplyEvents(
 		ctx context.Context,
 		ReapplyEventsRequest *history.ReapplyEventsRequest,
@@ -191,6 +233,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ResetActivity(
+		ctx context.Context,
+		ResetRequest *history.ResetActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ResetQueue(
 		ctx context.Context,
 		Request *shared.ResetQueueRequest,
@@ -245,6 +293,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RetryHistoryTaskDLQ(
+		ctx context.Context,
+		Request *shared.RetryHistoryTaskDLQRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.RetryHistoryTaskDLQResponse, error)
+
 	ScheduleDecisionTask(
 		ctx context.Context,
 		ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -286,6 +340,24 @@ type Interface interface {
 		TerminateRequest *history.TerminateWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	UnpauseActivity(
+		ctx context.Context,
+		UnpauseRequest *history.UnpauseActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UnpauseWorkflowExecution(
+		ctx context.Context,
+		UnpauseRequest *history.UnpauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *history.UpdateWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*history.UpdateWorkflowExecutionResponse, error)
 }
 
 // New builds a new client for the HistoryService service.
@@ -335,6 +407,29 @@ func (c client) CloseShard(
 	return
 }
 
+func (c client) DeleteWorkflowExecution(
+	ctx context.Context,
+	_DeleteRequest *history.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_DeleteWorkflowExecution_Helper.Args(_DeleteRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DeleteWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_DeleteWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -404,6 +499,29 @@ func (c client) DescribeQueue(
 	return
 }
 
+func (c client) DescribeReplicationStatus(
+	ctx context.Context,
+	_Request *shared.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeReplicationStatusResponse, err error) {
+
+	args := history.HistoryService_DescribeReplicationStatus_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DescribeReplicationStatus_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_DescribeReplicationStatus_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_DescribeRequest *history.DescribeWorkflowExecutionRequest,
@@ -542,6 +660,29 @@ func (c client) MergeDLQMessages(
 	return
 }
 
+func (c client) MigrateWorkflowExecution(
+	ctx context.Context,
+	_MigrateRequest *history.MigrateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *history.MigrateWorkflowExecutionResponse, err error) {
+
+	args := history.HistoryService_MigrateWorkflowExecution_Helper.Args(_MigrateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_MigrateWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_MigrateWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) NotifyFailoverMarkers(
 	ctx context.Context,
 	_Request *history.NotifyFailoverMarkersRequest,
@@ -565,6 +706,52 @@ func (c client) NotifyFailoverMarkers(
 	return
 }
 
+func (c client) PauseActivity(
+	ctx context.Context,
+	_PauseRequest *history.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_PauseActivity_Helper.Args(_PauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_PauseActivity_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_PauseActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PauseWorkflowExecution(
+	ctx context.Context,
+	_PauseRequest *history.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_PauseWorkflowExecution_Helper.Args(_PauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_PauseWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_PauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollMutableState(
 	ctx context.Context,
 	_PollRequest *history.PollMutableStateRequest,
@@ -611,6 +798,29 @@ func (c client) PurgeDLQMessages(
 	return
 }
 
+func (c client) PurgeHistoryTaskDLQ(
+	ctx context.Context,
+	_Request *shared.PurgeHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_PurgeHistoryTaskDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_PurgeHistoryTaskDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_PurgeHistoryTaskDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) QueryWorkflow(
 	ctx context.Context,
 	_QueryRequest *history.QueryWorkflowRequest,
@@ -657,6 +867,29 @@ func (c client) ReadDLQMessages(
 	return
 }
 
+func (c client) ReadHistoryTaskDLQ(
+	ctx context.Context,
+	_Request *shared.ReadHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ReadHistoryTaskDLQResponse, err error) {
+
+	args := history.HistoryService_ReadHistoryTaskDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ReadHistoryTaskDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_ReadHistoryTaskDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ReapplyEvents(
 	ctx context.Context,
 	_ReapplyEventsRequest *history.ReapplyEventsRequest,
@@ -887,6 +1120,29 @@ func (c client) RequestCancelWorkflowExecution(
 	return
 }
 
+func (c client) ResetActivity(
+	ctx context.Context,
+	_ResetRequest *history.ResetActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_ResetActivity_Helper.Args(_ResetRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ResetActivity_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_ResetActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ResetQueue(
 	ctx context.Context,
 	_Request *shared.ResetQueueRequest,
@@ -1094,6 +1350,29 @@ func (c client) RespondDecisionTaskFailed(
 	return
 }
 
+func (c client) RetryHistoryTaskDLQ(
+	ctx context.Context,
+	_Request *shared.RetryHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.RetryHistoryTaskDLQResponse, err error) {
+
+	args := history.HistoryService_RetryHistoryTaskDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_RetryHistoryTaskDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_RetryHistoryTaskDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ScheduleDecisionTask(
 	ctx context.Context,
 	_ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -1254,3 +1533,72 @@ func (c client) TerminateWorkflowExecution(
 	err = history.HistoryService_TerminateWorkflowExecution_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UnpauseActivity(
+	ctx context.Context,
+	_UnpauseRequest *history.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_UnpauseActivity_Helper.Args(_UnpauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_UnpauseActivity_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_UnpauseActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UnpauseWorkflowExecution(
+	ctx context.Context,
+	_UnpauseRequest *history.UnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_UnpauseWorkflowExecution_Helper.Args(_UnpauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_UnpauseWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_UnpauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateWorkflowExecution(
+	ctx context.Context,
+	_UpdateRequest *history.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *history.UpdateWorkflowExecutionResponse, err error) {
+
+	args := history.HistoryService_UpdateWorkflowExecution_Helper.Args(_UpdateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_UpdateWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_UpdateWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
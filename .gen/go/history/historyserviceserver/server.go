@@ -45,6 +45,11 @@ type Interface interface {
 		Request *shared.CloseShardRequest,
 	) error
 
+	DeleteWorkflowExecution(
+		ctx context.Context,
+		DeleteRequest *history.DeleteWorkflowExecutionRequest,
+	) error
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
@@ -60,6 +65,11 @@ type Interface interface {
 		Request *shared.DescribeQueueRequest,
 	) (*shared.DescribeQueueResponse, error)
 
+	DescribeReplicationStatus(
+		ctx context.Context,
+		Request *shared.DescribeReplicationStatusRequest,
+	) (*shared.DescribeReplicationStatusResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		DescribeRequest *history.DescribeWorkflowExecutionRequest,
@@ -90,11 +100,26 @@ type Interface interface {
 		Request *replicator.MergeDLQMessagesRequest,
 	) (*replicator.MergeDLQMessagesResponse, error)
 
+	MigrateWorkflowExecution(
+		ctx context.Context,
+		MigrateRequest *history.MigrateWorkflowExecutionRequest,
+	) (*history.MigrateWorkflowExecutionResponse, error)
+
 	NotifyFailoverMarkers(
 		ctx context.Context,
 		Request *history.NotifyFailoverMarkersRequest,
 	) error
 
+	PauseActivity(
+		ctx context.Context,
+		PauseRequest *history.PauseActivityRequest,
+	) error
+
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *history.PauseWorkflowExecutionRequest,
+	) error
+
 	PollMutableState(
 		ctx context.Context,
 		PollRequest *history.PollMutableStateRequest,
@@ -105,6 +130,11 @@ type Interface interface {
 		Request *replicator.PurgeDLQMessagesRequest,
 	) error
 
+	PurgeHistoryTaskDLQ(
+		ctx context.Context,
+		Request *shared.PurgeHistoryTaskDLQRequest,
+	) error
+
 	QueryWorkflow(
 		ctx context.Context,
 		QueryRequest *history.QueryWorkflowRequest,
@@ -115,6 +145,11 @@ type Interface interface {
 		Request *replicator.ReadDLQMessagesRequest,
 	) (*replicator.ReadDLQMessagesResponse, error)
 
+	ReadHistoryTaskDLQ(
+		ctx context.Context,
+		Request *shared.ReadHistoryTaskDLQRequest,
+	) (*shared.ReadHistoryTaskDLQResponse, error)
+
 	ReapplyEvents(
 		ctx context.Context,
 		ReapplyEventsRequest *history.ReapplyEventsRequest,
@@ -165,6 +200,11 @@ type Interface interface {
 		CancelRequest *history.RequestCancelWorkflowExecutionRequest,
 	) error
 
+	ResetActivity(
+		ctx context.Context,
+		ResetRequest *history.ResetActivityRequest,
+	) error
+
 	ResetQueue(
 		ctx context.Context,
 		Request *shared.ResetQueueRequest,
@@ -210,6 +250,11 @@ type Interface interface {
 		FailedRequest *history.RespondDecisionTaskFailedRequest,
 	) error
 
+	RetryHistoryTaskDLQ(
+		ctx context.Context,
+		Request *shared.RetryHistoryTaskDLQRequest,
+	) (*shared.RetryHistoryTaskDLQResponse, error)
+
 	ScheduleDecisionTask(
 		ctx context.Context,
 		ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -244,6 +289,21 @@ type Interface interface {
 		ctx context.Context,
 		TerminateRequest *history.TerminateWorkflowExecutionRequest,
 	) error
+
+	UnpauseActivity(
+		ctx context.Context,
+		UnpauseRequest *history.UnpauseActivityRequest,
+	) error
+
+	UnpauseWorkflowExecution(
+		ctx context.Context,
+		UnpauseRequest *history.UnpauseWorkflowExecutionRequest,
+	) error
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *history.UpdateWorkflowExecutionRequest,
+	) (*history.UpdateWorkflowExecutionResponse, error)
 }
 
 // New prepares an implementation of the HistoryService service for
@@ -268,6 +328,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DeleteWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DeleteWorkflowExecution),
+				},
+				Signature:    "DeleteWorkflowExecution(DeleteRequest *history.DeleteWorkflowExecutionRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeHistoryHost",
 				HandlerSpec: thrift.HandlerSpec{
@@ -301,6 +372,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeReplicationStatus",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeReplicationStatus),
+				},
+				Signature:    "DescribeReplicationStatus(Request *shared.DescribeReplicationStatusRequest) (*shared.DescribeReplicationStatusResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -367,6 +449,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "MigrateWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.MigrateWorkflowExecution),
+				},
+				Signature:    "MigrateWorkflowExecution(MigrateRequest *history.MigrateWorkflowExecutionRequest) (*history.MigrateWorkflowExecutionResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "NotifyFailoverMarkers",
 				HandlerSpec: thrift.HandlerSpec{
@@ -378,6 +471,28 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "PauseActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PauseActivity),
+				},
+				Signature:    "PauseActivity(PauseRequest *history.PauseActivityRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PauseWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PauseWorkflowExecution),
+				},
+				Signature:    "PauseWorkflowExecution(PauseRequest *history.PauseWorkflowExecutionRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PollMutableState",
 				HandlerSpec: thrift.HandlerSpec{
@@ -400,6 +515,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "PurgeHistoryTaskDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PurgeHistoryTaskDLQ),
+				},
+				Signature:    "PurgeHistoryTaskDLQ(Request *shared.PurgeHistoryTaskDLQRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "QueryWorkflow",
 				HandlerSpec: thrift.HandlerSpec{
@@ -422,6 +548,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ReadHistoryTaskDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ReadHistoryTaskDLQ),
+				},
+				Signature:    "ReadHistoryTaskDLQ(Request *shared.ReadHistoryTaskDLQRequest) (*shared.ReadHistoryTaskDLQResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ReapplyEvents",
 				HandlerSpec: thrift.HandlerSpec{
@@ -532,6 +669,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ResetActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ResetActivity),
+				},
+				Signature:    "ResetActivity(ResetRequest *history.ResetActivityRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ResetQueue",
 				HandlerSpec: thrift.HandlerSpec{
@@ -631,6 +779,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RetryHistoryTaskDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RetryHistoryTaskDLQ),
+				},
+				Signature:    "RetryHistoryTaskDLQ(Request *shared.RetryHistoryTaskDLQRequest) (*shared.RetryHistoryTaskDLQResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ScheduleDecisionTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -707,10 +866,43 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "TerminateWorkflowExecution(TerminateRequest *history.TerminateWorkflowExecutionRequest)",
 				ThriftModule: history.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UnpauseActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UnpauseActivity),
+				},
+				Signature:    "UnpauseActivity(UnpauseRequest *history.UnpauseActivityRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UnpauseWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UnpauseWorkflowExecution),
+				},
+				Signature:    "UnpauseWorkflowExecution(UnpauseRequest *history.UnpauseWorkflowExecutionRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateWorkflowExecution),
+				},
+				Signature:    "UpdateWorkflowExecution(UpdateRequest *history.UpdateWorkflowExecutionRequest) (*history.UpdateWorkflowExecutionResponse)",
+				ThriftModule: history.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 41)
+	procedures := make([]transport.Procedure, 0, 53)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -751,6 +943,36 @@ func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
+func (h handler) DeleteWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_DeleteWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'DeleteWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.DeleteWorkflowExecution(ctx, args.DeleteRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_DeleteWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_DescribeHistoryHost_Args
 	if err := args.FromWire(body); err != nil {
@@ -841,6 +1063,36 @@ func (h handler) DescribeQueue(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
+func (h handler) DescribeReplicationStatus(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_DescribeReplicationStatus_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'DescribeReplicationStatus': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeReplicationStatus(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_DescribeReplicationStatus_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_DescribeWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
@@ -1021,6 +1273,36 @@ func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
+func (h handler) MigrateWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_MigrateWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'MigrateWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.MigrateWorkflowExecution(ctx, args.MigrateRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_MigrateWorkflowExecution_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) NotifyFailoverMarkers(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_NotifyFailoverMarkers_Args
 	if err := args.FromWire(body); err != nil {
@@ -1051,6 +1333,66 @@ func (h handler) NotifyFailoverMarkers(ctx context.Context, body wire.Value) (th
 	return response, err
 }
 
+func (h handler) PauseActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_PauseActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'PauseActivity': %w", err)
+	}
+
+	appErr := h.impl.PauseActivity(ctx, args.PauseRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_PauseActivity_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) PauseWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_PauseWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'PauseWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.PauseWorkflowExecution(ctx, args.PauseRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_PauseWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) PollMutableState(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_PollMutableState_Args
 	if err := args.FromWire(body); err != nil {
@@ -1111,6 +1453,36 @@ func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
+func (h handler) PurgeHistoryTaskDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_PurgeHistoryTaskDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'PurgeHistoryTaskDLQ': %w", err)
+	}
+
+	appErr := h.impl.PurgeHistoryTaskDLQ(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_PurgeHistoryTaskDLQ_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) QueryWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_QueryWorkflow_Args
 	if err := args.FromWire(body); err != nil {
@@ -1171,6 +1543,36 @@ func (h handler) ReadDLQMessages(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
+func (h handler) ReadHistoryTaskDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ReadHistoryTaskDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'ReadHistoryTaskDLQ': %w", err)
+	}
+
+	success, appErr := h.impl.ReadHistoryTaskDLQ(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_ReadHistoryTaskDLQ_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ReapplyEvents(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_ReapplyEvents_Args
 	if err := args.FromWire(body); err != nil {
@@ -1471,6 +1873,36 @@ func (h handler) RequestCancelWorkflowExecution(ctx context.Context, body wire.V
 	return response, err
 }
 
+func (h handler) ResetActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ResetActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'ResetActivity': %w", err)
+	}
+
+	appErr := h.impl.ResetActivity(ctx, args.ResetRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_ResetActivity_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_ResetQueue_Args
 	if err := args.FromWire(body); err != nil {
@@ -1741,6 +2173,36 @@ func (h handler) RespondDecisionTaskFailed(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) RetryHistoryTaskDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RetryHistoryTaskDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'RetryHistoryTaskDLQ': %w", err)
+	}
+
+	success, appErr := h.impl.RetryHistoryTaskDLQ(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RetryHistoryTaskDLQ_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ScheduleDecisionTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_ScheduleDecisionTask_Args
 	if err := args.FromWire(body); err != nil {
@@ -1950,3 +2412,93 @@ func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value
 
 	return response, err
 }
+
+func (h handler) UnpauseActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_UnpauseActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'UnpauseActivity': %w", err)
+	}
+
+	appErr := h.impl.UnpauseActivity(ctx, args.UnpauseRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_UnpauseActivity_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UnpauseWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_UnpauseWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'UnpauseWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.UnpauseWorkflowExecution(ctx, args.UnpauseRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_UnpauseWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UpdateWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_UpdateWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'UpdateWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.UpdateWorkflowExecution(ctx, args.UpdateRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_UpdateWorkflowExecution_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
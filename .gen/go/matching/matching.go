@@ -1575,14 +1575,17 @@ func (v *ListTaskListPartitionsRequest) IsSetTaskList() bool {
 	return v != nil && v.TaskList != nil
 }
 
-type PollForActivityTaskRequest struct {
-	DomainUUID    *string                            `json:"domainUUID,omitempty"`
-	PollerID      *string                            `json:"pollerID,omitempty"`
-	PollRequest   *shared.PollForActivityTaskRequest `json:"pollRequest,omitempty"`
-	ForwardedFrom *string                            `json:"forwardedFrom,omitempty"`
+type MoveTaskListBacklogRequest struct {
+	DomainUUID     *string              `json:"domainUUID,omitempty"`
+	SourceTaskList *shared.TaskList     `json:"sourceTaskList,omitempty"`
+	TargetTaskList *shared.TaskList     `json:"targetTaskList,omitempty"`
+	TaskListType   *shared.TaskListType `json:"taskListType,omitempty"`
+	BatchSize      *int32               `json:"batchSize,omitempty"`
+	AfterTaskID    *int64               `json:"afterTaskID,omitempty"`
+	DryRun         *bool                `json:"dryRun,omitempty"`
 }
 
-// ToWire translates a PollForActivityTaskRequest struct into a Thrift-level intermediate
+// ToWire translates a MoveTaskListBacklogRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1597,9 +1600,9 @@ type PollForActivityTaskRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
+func (v *MoveTaskListBacklogRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -1613,45 +1616,69 @@ func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.PollerID != nil {
-		w, err = wire.NewValueString(*(v.PollerID)), error(nil)
+	if v.SourceTaskList != nil {
+		w, err = v.SourceTaskList.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 15, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.PollRequest != nil {
-		w, err = v.PollRequest.ToWire()
+	if v.TargetTaskList != nil {
+		w, err = v.TargetTaskList.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.ForwardedFrom != nil {
-		w, err = wire.NewValueString(*(v.ForwardedFrom)), error(nil)
+	if v.TaskListType != nil {
+		w, err = v.TaskListType.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.BatchSize != nil {
+		w, err = wire.NewValueI32(*(v.BatchSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.AfterTaskID != nil {
+		w, err = wire.NewValueI64(*(v.AfterTaskID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.DryRun != nil {
+		w, err = wire.NewValueBool(*(v.DryRun)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForActivityTaskRequest_Read(w wire.Value) (*shared.PollForActivityTaskRequest, error) {
-	var v shared.PollForActivityTaskRequest
+func _TaskListType_Read(w wire.Value) (shared.TaskListType, error) {
+	var v shared.TaskListType
 	err := v.FromWire(w)
-	return &v, err
+	return v, err
 }
 
-// FromWire deserializes a PollForActivityTaskRequest struct from its Thrift-level
+// FromWire deserializes a MoveTaskListBacklogRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PollForActivityTaskRequest struct
+// An error is returned if we were unable to build a MoveTaskListBacklogRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1659,12 +1686,12 @@ func _PollForActivityTaskRequest_Read(w wire.Value) (*shared.PollForActivityTask
 //     return nil, err
 //   }
 //
-//   var v PollForActivityTaskRequest
+//   var v MoveTaskListBacklogRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
+func (v *MoveTaskListBacklogRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -1679,29 +1706,57 @@ func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
 				}
 
 			}
-		case 15:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.PollerID = &x
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.SourceTaskList, err = _TaskList_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 20:
+		case 30:
 			if field.Value.Type() == wire.TStruct {
-				v.PollRequest, err = _PollForActivityTaskRequest_Read(field.Value)
+				v.TargetTaskList, err = _TaskList_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ForwardedFrom = &x
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x shared.TaskListType
+				x, err = _TaskListType_Read(field.Value)
+				v.TaskListType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.BatchSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.AfterTaskID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.DryRun = &x
 				if err != nil {
 					return err
 				}
@@ -1713,40 +1768,72 @@ func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a PollForActivityTaskRequest
+// String returns a readable string representation of a MoveTaskListBacklogRequest
 // struct.
-func (v *PollForActivityTaskRequest) String() string {
+func (v *MoveTaskListBacklogRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [7]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.PollerID != nil {
-		fields[i] = fmt.Sprintf("PollerID: %v", *(v.PollerID))
+	if v.SourceTaskList != nil {
+		fields[i] = fmt.Sprintf("SourceTaskList: %v", v.SourceTaskList)
 		i++
 	}
-	if v.PollRequest != nil {
-		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
+	if v.TargetTaskList != nil {
+		fields[i] = fmt.Sprintf("TargetTaskList: %v", v.TargetTaskList)
 		i++
 	}
-	if v.ForwardedFrom != nil {
-		fields[i] = fmt.Sprintf("ForwardedFrom: %v", *(v.ForwardedFrom))
+	if v.TaskListType != nil {
+		fields[i] = fmt.Sprintf("TaskListType: %v", *(v.TaskListType))
+		i++
+	}
+	if v.BatchSize != nil {
+		fields[i] = fmt.Sprintf("BatchSize: %v", *(v.BatchSize))
+		i++
+	}
+	if v.AfterTaskID != nil {
+		fields[i] = fmt.Sprintf("AfterTaskID: %v", *(v.AfterTaskID))
+		i++
+	}
+	if v.DryRun != nil {
+		fields[i] = fmt.Sprintf("DryRun: %v", *(v.DryRun))
 		i++
 	}
 
-	return fmt.Sprintf("PollForActivityTaskRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MoveTaskListBacklogRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PollForActivityTaskRequest match the
-// provided PollForActivityTaskRequest.
+func _TaskListType_EqualsPtr(lhs, rhs *shared.TaskListType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this MoveTaskListBacklogRequest match the
+// provided MoveTaskListBacklogRequest.
 //
 // This function performs a deep comparison.
-func (v *PollForActivityTaskRequest) Equals(rhs *PollForActivityTaskRequest) bool {
+func (v *MoveTaskListBacklogRequest) Equals(rhs *MoveTaskListBacklogRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -1755,13 +1842,22 @@ func (v *PollForActivityTaskRequest) Equals(rhs *PollForActivityTaskRequest) boo
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !_String_EqualsPtr(v.PollerID, rhs.PollerID) {
+	if !((v.SourceTaskList == nil && rhs.SourceTaskList == nil) || (v.SourceTaskList != nil && rhs.SourceTaskList != nil && v.SourceTaskList.Equals(rhs.SourceTaskList))) {
 		return false
 	}
-	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
+	if !((v.TargetTaskList == nil && rhs.TargetTaskList == nil) || (v.TargetTaskList != nil && rhs.TargetTaskList != nil && v.TargetTaskList.Equals(rhs.TargetTaskList))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.ForwardedFrom, rhs.ForwardedFrom) {
+	if !_TaskListType_EqualsPtr(v.TaskListType, rhs.TaskListType) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.BatchSize, rhs.BatchSize) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.AfterTaskID, rhs.AfterTaskID) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.DryRun, rhs.DryRun) {
 		return false
 	}
 
@@ -1769,29 +1865,38 @@ func (v *PollForActivityTaskRequest) Equals(rhs *PollForActivityTaskRequest) boo
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PollForActivityTaskRequest.
-func (v *PollForActivityTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MoveTaskListBacklogRequest.
+func (v *MoveTaskListBacklogRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.PollerID != nil {
-		enc.AddString("pollerID", *v.PollerID)
+	if v.SourceTaskList != nil {
+		err = multierr.Append(err, enc.AddObject("sourceTaskList", v.SourceTaskList))
 	}
-	if v.PollRequest != nil {
-		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
+	if v.TargetTaskList != nil {
+		err = multierr.Append(err, enc.AddObject("targetTaskList", v.TargetTaskList))
 	}
-	if v.ForwardedFrom != nil {
-		enc.AddString("forwardedFrom", *v.ForwardedFrom)
+	if v.TaskListType != nil {
+		err = multierr.Append(err, enc.AddObject("taskListType", *v.TaskListType))
+	}
+	if v.BatchSize != nil {
+		enc.AddInt32("batchSize", *v.BatchSize)
+	}
+	if v.AfterTaskID != nil {
+		enc.AddInt64("afterTaskID", *v.AfterTaskID)
+	}
+	if v.DryRun != nil {
+		enc.AddBool("dryRun", *v.DryRun)
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *PollForActivityTaskRequest) GetDomainUUID() (o string) {
+func (v *MoveTaskListBacklogRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -1800,63 +1905,107 @@ func (v *PollForActivityTaskRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *PollForActivityTaskRequest) IsSetDomainUUID() bool {
+func (v *MoveTaskListBacklogRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
-// GetPollerID returns the value of PollerID if it is set or its
+// GetSourceTaskList returns the value of SourceTaskList if it is set or its
 // zero value if it is unset.
-func (v *PollForActivityTaskRequest) GetPollerID() (o string) {
-	if v != nil && v.PollerID != nil {
-		return *v.PollerID
+func (v *MoveTaskListBacklogRequest) GetSourceTaskList() (o *shared.TaskList) {
+	if v != nil && v.SourceTaskList != nil {
+		return v.SourceTaskList
 	}
 
 	return
 }
 
-// IsSetPollerID returns true if PollerID is not nil.
-func (v *PollForActivityTaskRequest) IsSetPollerID() bool {
-	return v != nil && v.PollerID != nil
+// IsSetSourceTaskList returns true if SourceTaskList is not nil.
+func (v *MoveTaskListBacklogRequest) IsSetSourceTaskList() bool {
+	return v != nil && v.SourceTaskList != nil
 }
 
-// GetPollRequest returns the value of PollRequest if it is set or its
+// GetTargetTaskList returns the value of TargetTaskList if it is set or its
 // zero value if it is unset.
-func (v *PollForActivityTaskRequest) GetPollRequest() (o *shared.PollForActivityTaskRequest) {
-	if v != nil && v.PollRequest != nil {
-		return v.PollRequest
+func (v *MoveTaskListBacklogRequest) GetTargetTaskList() (o *shared.TaskList) {
+	if v != nil && v.TargetTaskList != nil {
+		return v.TargetTaskList
 	}
 
 	return
 }
 
-// IsSetPollRequest returns true if PollRequest is not nil.
-func (v *PollForActivityTaskRequest) IsSetPollRequest() bool {
-	return v != nil && v.PollRequest != nil
+// IsSetTargetTaskList returns true if TargetTaskList is not nil.
+func (v *MoveTaskListBacklogRequest) IsSetTargetTaskList() bool {
+	return v != nil && v.TargetTaskList != nil
 }
 
-// GetForwardedFrom returns the value of ForwardedFrom if it is set or its
+// GetTaskListType returns the value of TaskListType if it is set or its
 // zero value if it is unset.
-func (v *PollForActivityTaskRequest) GetForwardedFrom() (o string) {
-	if v != nil && v.ForwardedFrom != nil {
-		return *v.ForwardedFrom
+func (v *MoveTaskListBacklogRequest) GetTaskListType() (o shared.TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
 	}
 
 	return
 }
 
-// IsSetForwardedFrom returns true if ForwardedFrom is not nil.
-func (v *PollForActivityTaskRequest) IsSetForwardedFrom() bool {
-	return v != nil && v.ForwardedFrom != nil
+// IsSetTaskListType returns true if TaskListType is not nil.
+func (v *MoveTaskListBacklogRequest) IsSetTaskListType() bool {
+	return v != nil && v.TaskListType != nil
 }
 
-type PollForDecisionTaskRequest struct {
-	DomainUUID    *string                            `json:"domainUUID,omitempty"`
-	PollerID      *string                            `json:"pollerID,omitempty"`
-	PollRequest   *shared.PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
-	ForwardedFrom *string                            `json:"forwardedFrom,omitempty"`
+// GetBatchSize returns the value of BatchSize if it is set or its
+// zero value if it is unset.
+func (v *MoveTaskListBacklogRequest) GetBatchSize() (o int32) {
+	if v != nil && v.BatchSize != nil {
+		return *v.BatchSize
+	}
+
+	return
 }
 
-// ToWire translates a PollForDecisionTaskRequest struct into a Thrift-level intermediate
+// IsSetBatchSize returns true if BatchSize is not nil.
+func (v *MoveTaskListBacklogRequest) IsSetBatchSize() bool {
+	return v != nil && v.BatchSize != nil
+}
+
+// GetAfterTaskID returns the value of AfterTaskID if it is set or its
+// zero value if it is unset.
+func (v *MoveTaskListBacklogRequest) GetAfterTaskID() (o int64) {
+	if v != nil && v.AfterTaskID != nil {
+		return *v.AfterTaskID
+	}
+
+	return
+}
+
+// IsSetAfterTaskID returns true if AfterTaskID is not nil.
+func (v *MoveTaskListBacklogRequest) IsSetAfterTaskID() bool {
+	return v != nil && v.AfterTaskID != nil
+}
+
+// GetDryRun returns the value of DryRun if it is set or its
+// zero value if it is unset.
+func (v *MoveTaskListBacklogRequest) GetDryRun() (o bool) {
+	if v != nil && v.DryRun != nil {
+		return *v.DryRun
+	}
+
+	return
+}
+
+// IsSetDryRun returns true if DryRun is not nil.
+func (v *MoveTaskListBacklogRequest) IsSetDryRun() bool {
+	return v != nil && v.DryRun != nil
+}
+
+type PauseTaskListRequest struct {
+	DomainUUID   *string              `json:"domainUUID,omitempty"`
+	TaskList     *shared.TaskList     `json:"taskList,omitempty"`
+	TaskListType *shared.TaskListType `json:"taskListType,omitempty"`
+}
+
+// ToWire translates a PauseTaskListRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1871,9 +2020,9 @@ type PollForDecisionTaskRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
+func (v *PauseTaskListRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -1887,24 +2036,16 @@ func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.PollerID != nil {
-		w, err = wire.NewValueString(*(v.PollerID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 15, Value: w}
-		i++
-	}
-	if v.PollRequest != nil {
-		w, err = v.PollRequest.ToWire()
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ForwardedFrom != nil {
-		w, err = wire.NewValueString(*(v.ForwardedFrom)), error(nil)
+	if v.TaskListType != nil {
+		w, err = v.TaskListType.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -1915,17 +2056,11 @@ func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForDecisionTaskRequest_Read(w wire.Value) (*shared.PollForDecisionTaskRequest, error) {
-	var v shared.PollForDecisionTaskRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a PollForDecisionTaskRequest struct from its Thrift-level
+// FromWire deserializes a PauseTaskListRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PollForDecisionTaskRequest struct
+// An error is returned if we were unable to build a PauseTaskListRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1933,12 +2068,12 @@ func _PollForDecisionTaskRequest_Read(w wire.Value) (*shared.PollForDecisionTask
 //     return nil, err
 //   }
 //
-//   var v PollForDecisionTaskRequest
+//   var v PauseTaskListRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
+func (v *PauseTaskListRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -1953,25 +2088,264 @@ func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
 				}
 
 			}
-		case 15:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.PollerID = &x
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.PollRequest, err = _PollForDecisionTaskRequest_Read(field.Value)
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x shared.TaskListType
+				x, err = _TaskListType_Read(field.Value)
+				v.TaskListType = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PauseTaskListRequest
+// struct.
+func (v *PauseTaskListRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.TaskListType != nil {
+		fields[i] = fmt.Sprintf("TaskListType: %v", *(v.TaskListType))
+		i++
+	}
+
+	return fmt.Sprintf("PauseTaskListRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PauseTaskListRequest match the
+// provided PauseTaskListRequest.
+//
+// This function performs a deep comparison.
+func (v *PauseTaskListRequest) Equals(rhs *PauseTaskListRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_TaskListType_EqualsPtr(v.TaskListType, rhs.TaskListType) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PauseTaskListRequest.
+func (v *PauseTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.TaskListType != nil {
+		err = multierr.Append(err, enc.AddObject("taskListType", *v.TaskListType))
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *PauseTaskListRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *PauseTaskListRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *PauseTaskListRequest) GetTaskList() (o *shared.TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *PauseTaskListRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+// GetTaskListType returns the value of TaskListType if it is set or its
+// zero value if it is unset.
+func (v *PauseTaskListRequest) GetTaskListType() (o shared.TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+
+	return
+}
+
+// IsSetTaskListType returns true if TaskListType is not nil.
+func (v *PauseTaskListRequest) IsSetTaskListType() bool {
+	return v != nil && v.TaskListType != nil
+}
+
+type PollForActivityTaskRequest struct {
+	DomainUUID    *string                            `json:"domainUUID,omitempty"`
+	PollerID      *string                            `json:"pollerID,omitempty"`
+	PollRequest   *shared.PollForActivityTaskRequest `json:"pollRequest,omitempty"`
+	ForwardedFrom *string                            `json:"forwardedFrom,omitempty"`
+}
+
+// ToWire translates a PollForActivityTaskRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.PollerID != nil {
+		w, err = wire.NewValueString(*(v.PollerID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 15, Value: w}
+		i++
+	}
+	if v.PollRequest != nil {
+		w, err = v.PollRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ForwardedFrom != nil {
+		w, err = wire.NewValueString(*(v.ForwardedFrom)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _PollForActivityTaskRequest_Read(w wire.Value) (*shared.PollForActivityTaskRequest, error) {
+	var v shared.PollForActivityTaskRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a PollForActivityTaskRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PollForActivityTaskRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PollForActivityTaskRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 15:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.PollerID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.PollRequest, err = _PollForActivityTaskRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
@@ -1987,9 +2361,9 @@ func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a PollForDecisionTaskRequest
+// String returns a readable string representation of a PollForActivityTaskRequest
 // struct.
-func (v *PollForDecisionTaskRequest) String() string {
+func (v *PollForActivityTaskRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -2013,14 +2387,14 @@ func (v *PollForDecisionTaskRequest) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("PollForDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("PollForActivityTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PollForDecisionTaskRequest match the
-// provided PollForDecisionTaskRequest.
+// Equals returns true if all the fields of this PollForActivityTaskRequest match the
+// provided PollForActivityTaskRequest.
 //
 // This function performs a deep comparison.
-func (v *PollForDecisionTaskRequest) Equals(rhs *PollForDecisionTaskRequest) bool {
+func (v *PollForActivityTaskRequest) Equals(rhs *PollForActivityTaskRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -2043,8 +2417,8 @@ func (v *PollForDecisionTaskRequest) Equals(rhs *PollForDecisionTaskRequest) boo
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PollForDecisionTaskRequest.
-func (v *PollForDecisionTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of PollForActivityTaskRequest.
+func (v *PollForActivityTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -2065,7 +2439,7 @@ func (v *PollForDecisionTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder)
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskRequest) GetDomainUUID() (o string) {
+func (v *PollForActivityTaskRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -2074,13 +2448,13 @@ func (v *PollForDecisionTaskRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *PollForDecisionTaskRequest) IsSetDomainUUID() bool {
+func (v *PollForActivityTaskRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
 // GetPollerID returns the value of PollerID if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskRequest) GetPollerID() (o string) {
+func (v *PollForActivityTaskRequest) GetPollerID() (o string) {
 	if v != nil && v.PollerID != nil {
 		return *v.PollerID
 	}
@@ -2089,13 +2463,13 @@ func (v *PollForDecisionTaskRequest) GetPollerID() (o string) {
 }
 
 // IsSetPollerID returns true if PollerID is not nil.
-func (v *PollForDecisionTaskRequest) IsSetPollerID() bool {
+func (v *PollForActivityTaskRequest) IsSetPollerID() bool {
 	return v != nil && v.PollerID != nil
 }
 
 // GetPollRequest returns the value of PollRequest if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskRequest) GetPollRequest() (o *shared.PollForDecisionTaskRequest) {
+func (v *PollForActivityTaskRequest) GetPollRequest() (o *shared.PollForActivityTaskRequest) {
 	if v != nil && v.PollRequest != nil {
 		return v.PollRequest
 	}
@@ -2104,13 +2478,13 @@ func (v *PollForDecisionTaskRequest) GetPollRequest() (o *shared.PollForDecision
 }
 
 // IsSetPollRequest returns true if PollRequest is not nil.
-func (v *PollForDecisionTaskRequest) IsSetPollRequest() bool {
+func (v *PollForActivityTaskRequest) IsSetPollRequest() bool {
 	return v != nil && v.PollRequest != nil
 }
 
 // GetForwardedFrom returns the value of ForwardedFrom if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskRequest) GetForwardedFrom() (o string) {
+func (v *PollForActivityTaskRequest) GetForwardedFrom() (o string) {
 	if v != nil && v.ForwardedFrom != nil {
 		return *v.ForwardedFrom
 	}
@@ -2119,69 +2493,18 @@ func (v *PollForDecisionTaskRequest) GetForwardedFrom() (o string) {
 }
 
 // IsSetForwardedFrom returns true if ForwardedFrom is not nil.
-func (v *PollForDecisionTaskRequest) IsSetForwardedFrom() bool {
+func (v *PollForActivityTaskRequest) IsSetForwardedFrom() bool {
 	return v != nil && v.ForwardedFrom != nil
 }
 
-type PollForDecisionTaskResponse struct {
-	TaskToken                 []byte                           `json:"taskToken,omitempty"`
-	WorkflowExecution         *shared.WorkflowExecution        `json:"workflowExecution,omitempty"`
-	WorkflowType              *shared.WorkflowType             `json:"workflowType,omitempty"`
-	PreviousStartedEventId    *int64                           `json:"previousStartedEventId,omitempty"`
-	StartedEventId            *int64                           `json:"startedEventId,omitempty"`
-	Attempt                   *int64                           `json:"attempt,omitempty"`
-	NextEventId               *int64                           `json:"nextEventId,omitempty"`
-	BacklogCountHint          *int64                           `json:"backlogCountHint,omitempty"`
-	StickyExecutionEnabled    *bool                            `json:"stickyExecutionEnabled,omitempty"`
-	Query                     *shared.WorkflowQuery            `json:"query,omitempty"`
-	DecisionInfo              *shared.TransientDecisionInfo    `json:"decisionInfo,omitempty"`
-	WorkflowExecutionTaskList *shared.TaskList                 `json:"WorkflowExecutionTaskList,omitempty"`
-	EventStoreVersion         *int32                           `json:"eventStoreVersion,omitempty"`
-	BranchToken               []byte                           `json:"branchToken,omitempty"`
-	ScheduledTimestamp        *int64                           `json:"scheduledTimestamp,omitempty"`
-	StartedTimestamp          *int64                           `json:"startedTimestamp,omitempty"`
-	Queries                   map[string]*shared.WorkflowQuery `json:"queries,omitempty"`
-}
-
-type _Map_String_WorkflowQuery_MapItemList map[string]*shared.WorkflowQuery
-
-func (m _Map_String_WorkflowQuery_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", k)
-		}
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
-
-		vw, err := v.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (m _Map_String_WorkflowQuery_MapItemList) Size() int {
-	return len(m)
-}
-
-func (_Map_String_WorkflowQuery_MapItemList) KeyType() wire.Type {
-	return wire.TBinary
-}
-
-func (_Map_String_WorkflowQuery_MapItemList) ValueType() wire.Type {
-	return wire.TStruct
+type PollForDecisionTaskRequest struct {
+	DomainUUID    *string                            `json:"domainUUID,omitempty"`
+	PollerID      *string                            `json:"pollerID,omitempty"`
+	PollRequest   *shared.PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
+	ForwardedFrom *string                            `json:"forwardedFrom,omitempty"`
 }
 
-func (_Map_String_WorkflowQuery_MapItemList) Close() {}
-
-// ToWire translates a PollForDecisionTaskResponse struct into a Thrift-level intermediate
+// ToWire translates a PollForDecisionTaskRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2196,205 +2519,61 @@ func (_Map_String_WorkflowQuery_MapItemList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *PollForDecisionTaskResponse) ToWire() (wire.Value, error) {
+func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [17]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TaskToken != nil {
-		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.WorkflowType != nil {
-		w, err = v.WorkflowType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.PreviousStartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.PreviousStartedEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.StartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.Attempt != nil {
-		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 51, Value: w}
-		i++
-	}
-	if v.NextEventId != nil {
-		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.BacklogCountHint != nil {
-		w, err = wire.NewValueI64(*(v.BacklogCountHint)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 65, Value: w}
-		i++
-	}
-	if v.StickyExecutionEnabled != nil {
-		w, err = wire.NewValueBool(*(v.StickyExecutionEnabled)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.Query != nil {
-		w, err = v.Query.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
-	if v.DecisionInfo != nil {
-		w, err = v.DecisionInfo.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
-	}
-	if v.WorkflowExecutionTaskList != nil {
-		w, err = v.WorkflowExecutionTaskList.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 100, Value: w}
-		i++
-	}
-	if v.EventStoreVersion != nil {
-		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 110, Value: w}
-		i++
-	}
-	if v.BranchToken != nil {
-		w, err = wire.NewValueBinary(v.BranchToken), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 120, Value: w}
-		i++
-	}
-	if v.ScheduledTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledTimestamp)), error(nil)
+	if v.PollerID != nil {
+		w, err = wire.NewValueString(*(v.PollerID)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 130, Value: w}
+		fields[i] = wire.Field{ID: 15, Value: w}
 		i++
 	}
-	if v.StartedTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.StartedTimestamp)), error(nil)
+	if v.PollRequest != nil {
+		w, err = v.PollRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 140, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Queries != nil {
-		w, err = wire.NewValueMap(_Map_String_WorkflowQuery_MapItemList(v.Queries)), error(nil)
+	if v.ForwardedFrom != nil {
+		w, err = wire.NewValueString(*(v.ForwardedFrom)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 150, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _WorkflowType_Read(w wire.Value) (*shared.WorkflowType, error) {
-	var v shared.WorkflowType
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _WorkflowQuery_Read(w wire.Value) (*shared.WorkflowQuery, error) {
-	var v shared.WorkflowQuery
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _TransientDecisionInfo_Read(w wire.Value) (*shared.TransientDecisionInfo, error) {
-	var v shared.TransientDecisionInfo
+func _PollForDecisionTaskRequest_Read(w wire.Value) (*shared.PollForDecisionTaskRequest, error) {
+	var v shared.PollForDecisionTaskRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-func _Map_String_WorkflowQuery_Read(m wire.MapItemList) (map[string]*shared.WorkflowQuery, error) {
-	if m.KeyType() != wire.TBinary {
-		return nil, nil
-	}
-
-	if m.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make(map[string]*shared.WorkflowQuery, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-
-		v, err := _WorkflowQuery_Read(x.Value)
-		if err != nil {
-			return err
-		}
-
-		o[k] = v
-		return nil
-	})
-	m.Close()
-	return o, err
-}
-
-// FromWire deserializes a PollForDecisionTaskResponse struct from its Thrift-level
+// FromWire deserializes a PollForDecisionTaskRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PollForDecisionTaskResponse struct
+// An error is returned if we were unable to build a PollForDecisionTaskRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2402,165 +2581,49 @@ func _Map_String_WorkflowQuery_Read(m wire.MapItemList) (map[string]*shared.Work
 //     return nil, err
 //   }
 //
-//   var v PollForDecisionTaskResponse
+//   var v PollForDecisionTaskRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
+func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				v.TaskToken, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+		case 15:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.PollerID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowType, err = _WorkflowType_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.PreviousStartedEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 51:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.Attempt = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.NextEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 65:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.BacklogCountHint = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.StickyExecutionEnabled = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 80:
-			if field.Value.Type() == wire.TStruct {
-				v.Query, err = _WorkflowQuery_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 90:
-			if field.Value.Type() == wire.TStruct {
-				v.DecisionInfo, err = _TransientDecisionInfo_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 100:
+		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecutionTaskList, err = _TaskList_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 110:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.EventStoreVersion = &x
+				v.PollRequest, err = _PollForDecisionTaskRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 120:
+		case 30:
 			if field.Value.Type() == wire.TBinary {
-				v.BranchToken, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 130:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledTimestamp = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 140:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedTimestamp = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 150:
-			if field.Value.Type() == wire.TMap {
-				v.Queries, err = _Map_String_WorkflowQuery_Read(field.Value.GetMap())
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ForwardedFrom = &x
 				if err != nil {
 					return err
 				}
@@ -2572,513 +2635,3599 @@ func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a PollForDecisionTaskResponse
+// String returns a readable string representation of a PollForDecisionTaskRequest
 // struct.
-func (v *PollForDecisionTaskResponse) String() string {
+func (v *PollForDecisionTaskRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [17]string
+	var fields [4]string
 	i := 0
-	if v.TaskToken != nil {
-		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
-		i++
-	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
-		i++
-	}
-	if v.WorkflowType != nil {
-		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
-		i++
-	}
-	if v.PreviousStartedEventId != nil {
-		fields[i] = fmt.Sprintf("PreviousStartedEventId: %v", *(v.PreviousStartedEventId))
-		i++
-	}
-	if v.StartedEventId != nil {
-		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
-		i++
-	}
-	if v.Attempt != nil {
-		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
-		i++
-	}
-	if v.NextEventId != nil {
-		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
-		i++
-	}
-	if v.BacklogCountHint != nil {
-		fields[i] = fmt.Sprintf("BacklogCountHint: %v", *(v.BacklogCountHint))
-		i++
-	}
-	if v.StickyExecutionEnabled != nil {
-		fields[i] = fmt.Sprintf("StickyExecutionEnabled: %v", *(v.StickyExecutionEnabled))
-		i++
-	}
-	if v.Query != nil {
-		fields[i] = fmt.Sprintf("Query: %v", v.Query)
-		i++
-	}
-	if v.DecisionInfo != nil {
-		fields[i] = fmt.Sprintf("DecisionInfo: %v", v.DecisionInfo)
-		i++
-	}
-	if v.WorkflowExecutionTaskList != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionTaskList: %v", v.WorkflowExecutionTaskList)
-		i++
-	}
-	if v.EventStoreVersion != nil {
-		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
-		i++
-	}
-	if v.BranchToken != nil {
-		fields[i] = fmt.Sprintf("BranchToken: %v", v.BranchToken)
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.ScheduledTimestamp != nil {
-		fields[i] = fmt.Sprintf("ScheduledTimestamp: %v", *(v.ScheduledTimestamp))
+	if v.PollerID != nil {
+		fields[i] = fmt.Sprintf("PollerID: %v", *(v.PollerID))
 		i++
 	}
-	if v.StartedTimestamp != nil {
-		fields[i] = fmt.Sprintf("StartedTimestamp: %v", *(v.StartedTimestamp))
+	if v.PollRequest != nil {
+		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
 		i++
 	}
-	if v.Queries != nil {
-		fields[i] = fmt.Sprintf("Queries: %v", v.Queries)
+	if v.ForwardedFrom != nil {
+		fields[i] = fmt.Sprintf("ForwardedFrom: %v", *(v.ForwardedFrom))
 		i++
 	}
 
-	return fmt.Sprintf("PollForDecisionTaskResponse{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
-func _Map_String_WorkflowQuery_Equals(lhs, rhs map[string]*shared.WorkflowQuery) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-	return true
+	return fmt.Sprintf("PollForDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PollForDecisionTaskResponse match the
-// provided PollForDecisionTaskResponse.
+// Equals returns true if all the fields of this PollForDecisionTaskRequest match the
+// provided PollForDecisionTaskRequest.
 //
 // This function performs a deep comparison.
-func (v *PollForDecisionTaskResponse) Equals(rhs *PollForDecisionTaskResponse) bool {
+func (v *PollForDecisionTaskRequest) Equals(rhs *PollForDecisionTaskRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
-		return false
-	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
-		return false
-	}
-	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.PreviousStartedEventId, rhs.PreviousStartedEventId) {
+	if !_String_EqualsPtr(v.PollerID, rhs.PollerID) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
+	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.BacklogCountHint, rhs.BacklogCountHint) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.StickyExecutionEnabled, rhs.StickyExecutionEnabled) {
-		return false
-	}
-	if !((v.Query == nil && rhs.Query == nil) || (v.Query != nil && rhs.Query != nil && v.Query.Equals(rhs.Query))) {
-		return false
-	}
-	if !((v.DecisionInfo == nil && rhs.DecisionInfo == nil) || (v.DecisionInfo != nil && rhs.DecisionInfo != nil && v.DecisionInfo.Equals(rhs.DecisionInfo))) {
-		return false
-	}
-	if !((v.WorkflowExecutionTaskList == nil && rhs.WorkflowExecutionTaskList == nil) || (v.WorkflowExecutionTaskList != nil && rhs.WorkflowExecutionTaskList != nil && v.WorkflowExecutionTaskList.Equals(rhs.WorkflowExecutionTaskList))) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
-		return false
-	}
-	if !((v.BranchToken == nil && rhs.BranchToken == nil) || (v.BranchToken != nil && rhs.BranchToken != nil && bytes.Equal(v.BranchToken, rhs.BranchToken))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ScheduledTimestamp, rhs.ScheduledTimestamp) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartedTimestamp, rhs.StartedTimestamp) {
-		return false
-	}
-	if !((v.Queries == nil && rhs.Queries == nil) || (v.Queries != nil && rhs.Queries != nil && _Map_String_WorkflowQuery_Equals(v.Queries, rhs.Queries))) {
+	if !_String_EqualsPtr(v.ForwardedFrom, rhs.ForwardedFrom) {
 		return false
 	}
 
 	return true
 }
 
-type _Map_String_WorkflowQuery_Zapper map[string]*shared.WorkflowQuery
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of _Map_String_WorkflowQuery_Zapper.
-func (m _Map_String_WorkflowQuery_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	for k, v := range m {
-		err = multierr.Append(err, enc.AddObject((string)(k), v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PollForDecisionTaskResponse.
-func (v *PollForDecisionTaskResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of PollForDecisionTaskRequest.
+func (v *PollForDecisionTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TaskToken != nil {
-		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
-	}
-	if v.WorkflowExecution != nil {
-		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
-	}
-	if v.WorkflowType != nil {
-		err = multierr.Append(err, enc.AddObject("workflowType", v.WorkflowType))
-	}
-	if v.PreviousStartedEventId != nil {
-		enc.AddInt64("previousStartedEventId", *v.PreviousStartedEventId)
-	}
-	if v.StartedEventId != nil {
-		enc.AddInt64("startedEventId", *v.StartedEventId)
-	}
-	if v.Attempt != nil {
-		enc.AddInt64("attempt", *v.Attempt)
-	}
-	if v.NextEventId != nil {
-		enc.AddInt64("nextEventId", *v.NextEventId)
-	}
-	if v.BacklogCountHint != nil {
-		enc.AddInt64("backlogCountHint", *v.BacklogCountHint)
-	}
-	if v.StickyExecutionEnabled != nil {
-		enc.AddBool("stickyExecutionEnabled", *v.StickyExecutionEnabled)
-	}
-	if v.Query != nil {
-		err = multierr.Append(err, enc.AddObject("query", v.Query))
-	}
-	if v.DecisionInfo != nil {
-		err = multierr.Append(err, enc.AddObject("decisionInfo", v.DecisionInfo))
-	}
-	if v.WorkflowExecutionTaskList != nil {
-		err = multierr.Append(err, enc.AddObject("WorkflowExecutionTaskList", v.WorkflowExecutionTaskList))
-	}
-	if v.EventStoreVersion != nil {
-		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
-	}
-	if v.BranchToken != nil {
-		enc.AddString("branchToken", base64.StdEncoding.EncodeToString(v.BranchToken))
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.ScheduledTimestamp != nil {
-		enc.AddInt64("scheduledTimestamp", *v.ScheduledTimestamp)
+	if v.PollerID != nil {
+		enc.AddString("pollerID", *v.PollerID)
 	}
-	if v.StartedTimestamp != nil {
-		enc.AddInt64("startedTimestamp", *v.StartedTimestamp)
+	if v.PollRequest != nil {
+		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
 	}
-	if v.Queries != nil {
-		err = multierr.Append(err, enc.AddObject("queries", (_Map_String_WorkflowQuery_Zapper)(v.Queries)))
+	if v.ForwardedFrom != nil {
+		enc.AddString("forwardedFrom", *v.ForwardedFrom)
 	}
 	return err
 }
 
-// GetTaskToken returns the value of TaskToken if it is set or its
+// GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetTaskToken() (o []byte) {
-	if v != nil && v.TaskToken != nil {
-		return v.TaskToken
+func (v *PollForDecisionTaskRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
 	}
 
 	return
 }
 
-// IsSetTaskToken returns true if TaskToken is not nil.
-func (v *PollForDecisionTaskResponse) IsSetTaskToken() bool {
-	return v != nil && v.TaskToken != nil
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *PollForDecisionTaskRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
 }
 
-// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// GetPollerID returns the value of PollerID if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetWorkflowExecution() (o *shared.WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
+func (v *PollForDecisionTaskRequest) GetPollerID() (o string) {
+	if v != nil && v.PollerID != nil {
+		return *v.PollerID
 	}
 
 	return
 }
 
-// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
-func (v *PollForDecisionTaskResponse) IsSetWorkflowExecution() bool {
-	return v != nil && v.WorkflowExecution != nil
+// IsSetPollerID returns true if PollerID is not nil.
+func (v *PollForDecisionTaskRequest) IsSetPollerID() bool {
+	return v != nil && v.PollerID != nil
 }
 
-// GetWorkflowType returns the value of WorkflowType if it is set or its
+// GetPollRequest returns the value of PollRequest if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetWorkflowType() (o *shared.WorkflowType) {
-	if v != nil && v.WorkflowType != nil {
-		return v.WorkflowType
+func (v *PollForDecisionTaskRequest) GetPollRequest() (o *shared.PollForDecisionTaskRequest) {
+	if v != nil && v.PollRequest != nil {
+		return v.PollRequest
 	}
 
 	return
 }
 
-// IsSetWorkflowType returns true if WorkflowType is not nil.
-func (v *PollForDecisionTaskResponse) IsSetWorkflowType() bool {
-	return v != nil && v.WorkflowType != nil
+// IsSetPollRequest returns true if PollRequest is not nil.
+func (v *PollForDecisionTaskRequest) IsSetPollRequest() bool {
+	return v != nil && v.PollRequest != nil
 }
 
-// GetPreviousStartedEventId returns the value of PreviousStartedEventId if it is set or its
+// GetForwardedFrom returns the value of ForwardedFrom if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetPreviousStartedEventId() (o int64) {
-	if v != nil && v.PreviousStartedEventId != nil {
-		return *v.PreviousStartedEventId
+func (v *PollForDecisionTaskRequest) GetForwardedFrom() (o string) {
+	if v != nil && v.ForwardedFrom != nil {
+		return *v.ForwardedFrom
 	}
 
 	return
 }
 
-// IsSetPreviousStartedEventId returns true if PreviousStartedEventId is not nil.
-func (v *PollForDecisionTaskResponse) IsSetPreviousStartedEventId() bool {
-	return v != nil && v.PreviousStartedEventId != nil
+// IsSetForwardedFrom returns true if ForwardedFrom is not nil.
+func (v *PollForDecisionTaskRequest) IsSetForwardedFrom() bool {
+	return v != nil && v.ForwardedFrom != nil
 }
 
-// GetStartedEventId returns the value of StartedEventId if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetStartedEventId() (o int64) {
-	if v != nil && v.StartedEventId != nil {
-		return *v.StartedEventId
-	}
-
-	return
+type PollForDecisionTaskResponse struct {
+	TaskToken                 []byte                           `json:"taskToken,omitempty"`
+	WorkflowExecution         *shared.WorkflowExecution        `json:"workflowExecution,omitempty"`
+	WorkflowType              *shared.WorkflowType             `json:"workflowType,omitempty"`
+	PreviousStartedEventId    *int64                           `json:"previousStartedEventId,omitempty"`
+	StartedEventId            *int64                           `json:"startedEventId,omitempty"`
+	Attempt                   *int64                           `json:"attempt,omitempty"`
+	NextEventId               *int64                           `json:"nextEventId,omitempty"`
+	BacklogCountHint          *int64                           `json:"backlogCountHint,omitempty"`
+	StickyExecutionEnabled    *bool                            `json:"stickyExecutionEnabled,omitempty"`
+	Query                     *shared.WorkflowQuery            `json:"query,omitempty"`
+	DecisionInfo              *shared.TransientDecisionInfo    `json:"decisionInfo,omitempty"`
+	WorkflowExecutionTaskList *shared.TaskList                 `json:"WorkflowExecutionTaskList,omitempty"`
+	EventStoreVersion         *int32                           `json:"eventStoreVersion,omitempty"`
+	BranchToken               []byte                           `json:"branchToken,omitempty"`
+	ScheduledTimestamp        *int64                           `json:"scheduledTimestamp,omitempty"`
+	StartedTimestamp          *int64                           `json:"startedTimestamp,omitempty"`
+	Queries                   map[string]*shared.WorkflowQuery `json:"queries,omitempty"`
+	HistorySize               *int64                           `json:"historySize,omitempty"`
+	HistoryCount              *int64                           `json:"historyCount,omitempty"`
+	SuggestContinueAsNew      *bool                            `json:"suggestContinueAsNew,omitempty"`
 }
 
-// IsSetStartedEventId returns true if StartedEventId is not nil.
-func (v *PollForDecisionTaskResponse) IsSetStartedEventId() bool {
-	return v != nil && v.StartedEventId != nil
-}
+type _Map_String_WorkflowQuery_MapItemList map[string]*shared.WorkflowQuery
 
-// GetAttempt returns the value of Attempt if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetAttempt() (o int64) {
-	if v != nil && v.Attempt != nil {
-		return *v.Attempt
-	}
+func (m _Map_String_WorkflowQuery_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
 
-	return
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// IsSetAttempt returns true if Attempt is not nil.
-func (v *PollForDecisionTaskResponse) IsSetAttempt() bool {
-	return v != nil && v.Attempt != nil
+func (m _Map_String_WorkflowQuery_MapItemList) Size() int {
+	return len(m)
 }
 
-// GetNextEventId returns the value of NextEventId if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetNextEventId() (o int64) {
-	if v != nil && v.NextEventId != nil {
-		return *v.NextEventId
-	}
+func (_Map_String_WorkflowQuery_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
 
-	return
+func (_Map_String_WorkflowQuery_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
-// IsSetNextEventId returns true if NextEventId is not nil.
-func (v *PollForDecisionTaskResponse) IsSetNextEventId() bool {
-	return v != nil && v.NextEventId != nil
-}
+func (_Map_String_WorkflowQuery_MapItemList) Close() {}
+
+// ToWire translates a PollForDecisionTaskResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PollForDecisionTaskResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [20]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TaskToken != nil {
+		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.WorkflowType != nil {
+		w, err = v.WorkflowType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.PreviousStartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.PreviousStartedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.StartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 51, Value: w}
+		i++
+	}
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.BacklogCountHint != nil {
+		w, err = wire.NewValueI64(*(v.BacklogCountHint)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 65, Value: w}
+		i++
+	}
+	if v.StickyExecutionEnabled != nil {
+		w, err = wire.NewValueBool(*(v.StickyExecutionEnabled)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.Query != nil {
+		w, err = v.Query.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.DecisionInfo != nil {
+		w, err = v.DecisionInfo.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionTaskList != nil {
+		w, err = v.WorkflowExecutionTaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.BranchToken != nil {
+		w, err = wire.NewValueBinary(v.BranchToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.ScheduledTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
+	if v.StartedTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.StartedTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
+	if v.Queries != nil {
+		w, err = wire.NewValueMap(_Map_String_WorkflowQuery_MapItemList(v.Queries)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 150, Value: w}
+		i++
+	}
+	if v.HistorySize != nil {
+		w, err = wire.NewValueI64(*(v.HistorySize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 160, Value: w}
+		i++
+	}
+	if v.HistoryCount != nil {
+		w, err = wire.NewValueI64(*(v.HistoryCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 170, Value: w}
+		i++
+	}
+	if v.SuggestContinueAsNew != nil {
+		w, err = wire.NewValueBool(*(v.SuggestContinueAsNew)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 180, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _WorkflowType_Read(w wire.Value) (*shared.WorkflowType, error) {
+	var v shared.WorkflowType
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowQuery_Read(w wire.Value) (*shared.WorkflowQuery, error) {
+	var v shared.WorkflowQuery
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _TransientDecisionInfo_Read(w wire.Value) (*shared.TransientDecisionInfo, error) {
+	var v shared.TransientDecisionInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_WorkflowQuery_Read(m wire.MapItemList) (map[string]*shared.WorkflowQuery, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*shared.WorkflowQuery, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _WorkflowQuery_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a PollForDecisionTaskResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PollForDecisionTaskResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PollForDecisionTaskResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.TaskToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowType, err = _WorkflowType_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.PreviousStartedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 51:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 65:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.BacklogCountHint = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.StickyExecutionEnabled = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TStruct {
+				v.Query, err = _WorkflowQuery_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TStruct {
+				v.DecisionInfo, err = _TransientDecisionInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionTaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.EventStoreVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TBinary {
+				v.BranchToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 140:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 150:
+			if field.Value.Type() == wire.TMap {
+				v.Queries, err = _Map_String_WorkflowQuery_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 160:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.HistorySize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 170:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.HistoryCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 180:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.SuggestContinueAsNew = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PollForDecisionTaskResponse
+// struct.
+func (v *PollForDecisionTaskResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [20]string
+	i := 0
+	if v.TaskToken != nil {
+		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.WorkflowType != nil {
+		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
+		i++
+	}
+	if v.PreviousStartedEventId != nil {
+		fields[i] = fmt.Sprintf("PreviousStartedEventId: %v", *(v.PreviousStartedEventId))
+		i++
+	}
+	if v.StartedEventId != nil {
+		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
+		i++
+	}
+	if v.BacklogCountHint != nil {
+		fields[i] = fmt.Sprintf("BacklogCountHint: %v", *(v.BacklogCountHint))
+		i++
+	}
+	if v.StickyExecutionEnabled != nil {
+		fields[i] = fmt.Sprintf("StickyExecutionEnabled: %v", *(v.StickyExecutionEnabled))
+		i++
+	}
+	if v.Query != nil {
+		fields[i] = fmt.Sprintf("Query: %v", v.Query)
+		i++
+	}
+	if v.DecisionInfo != nil {
+		fields[i] = fmt.Sprintf("DecisionInfo: %v", v.DecisionInfo)
+		i++
+	}
+	if v.WorkflowExecutionTaskList != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionTaskList: %v", v.WorkflowExecutionTaskList)
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
+		i++
+	}
+	if v.BranchToken != nil {
+		fields[i] = fmt.Sprintf("BranchToken: %v", v.BranchToken)
+		i++
+	}
+	if v.ScheduledTimestamp != nil {
+		fields[i] = fmt.Sprintf("ScheduledTimestamp: %v", *(v.ScheduledTimestamp))
+		i++
+	}
+	if v.StartedTimestamp != nil {
+		fields[i] = fmt.Sprintf("StartedTimestamp: %v", *(v.StartedTimestamp))
+		i++
+	}
+	if v.Queries != nil {
+		fields[i] = fmt.Sprintf("Queries: %v", v.Queries)
+		i++
+	}
+	if v.HistorySize != nil {
+		fields[i] = fmt.Sprintf("HistorySize: %v", *(v.HistorySize))
+		i++
+	}
+	if v.HistoryCount != nil {
+		fields[i] = fmt.Sprintf("HistoryCount: %v", *(v.HistoryCount))
+		i++
+	}
+	if v.SuggestContinueAsNew != nil {
+		fields[i] = fmt.Sprintf("SuggestContinueAsNew: %v", *(v.SuggestContinueAsNew))
+		i++
+	}
+
+	return fmt.Sprintf("PollForDecisionTaskResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_String_WorkflowQuery_Equals(lhs, rhs map[string]*shared.WorkflowQuery) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this PollForDecisionTaskResponse match the
+// provided PollForDecisionTaskResponse.
+//
+// This function performs a deep comparison.
+func (v *PollForDecisionTaskResponse) Equals(rhs *PollForDecisionTaskResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.PreviousStartedEventId, rhs.PreviousStartedEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.BacklogCountHint, rhs.BacklogCountHint) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.StickyExecutionEnabled, rhs.StickyExecutionEnabled) {
+		return false
+	}
+	if !((v.Query == nil && rhs.Query == nil) || (v.Query != nil && rhs.Query != nil && v.Query.Equals(rhs.Query))) {
+		return false
+	}
+	if !((v.DecisionInfo == nil && rhs.DecisionInfo == nil) || (v.DecisionInfo != nil && rhs.DecisionInfo != nil && v.DecisionInfo.Equals(rhs.DecisionInfo))) {
+		return false
+	}
+	if !((v.WorkflowExecutionTaskList == nil && rhs.WorkflowExecutionTaskList == nil) || (v.WorkflowExecutionTaskList != nil && rhs.WorkflowExecutionTaskList != nil && v.WorkflowExecutionTaskList.Equals(rhs.WorkflowExecutionTaskList))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
+		return false
+	}
+	if !((v.BranchToken == nil && rhs.BranchToken == nil) || (v.BranchToken != nil && rhs.BranchToken != nil && bytes.Equal(v.BranchToken, rhs.BranchToken))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduledTimestamp, rhs.ScheduledTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedTimestamp, rhs.StartedTimestamp) {
+		return false
+	}
+	if !((v.Queries == nil && rhs.Queries == nil) || (v.Queries != nil && rhs.Queries != nil && _Map_String_WorkflowQuery_Equals(v.Queries, rhs.Queries))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.HistorySize, rhs.HistorySize) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.HistoryCount, rhs.HistoryCount) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.SuggestContinueAsNew, rhs.SuggestContinueAsNew) {
+		return false
+	}
+
+	return true
+}
+
+type _Map_String_WorkflowQuery_Zapper map[string]*shared.WorkflowQuery
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_WorkflowQuery_Zapper.
+func (m _Map_String_WorkflowQuery_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PollForDecisionTaskResponse.
+func (v *PollForDecisionTaskResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TaskToken != nil {
+		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
+	}
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.WorkflowType != nil {
+		err = multierr.Append(err, enc.AddObject("workflowType", v.WorkflowType))
+	}
+	if v.PreviousStartedEventId != nil {
+		enc.AddInt64("previousStartedEventId", *v.PreviousStartedEventId)
+	}
+	if v.StartedEventId != nil {
+		enc.AddInt64("startedEventId", *v.StartedEventId)
+	}
+	if v.Attempt != nil {
+		enc.AddInt64("attempt", *v.Attempt)
+	}
+	if v.NextEventId != nil {
+		enc.AddInt64("nextEventId", *v.NextEventId)
+	}
+	if v.BacklogCountHint != nil {
+		enc.AddInt64("backlogCountHint", *v.BacklogCountHint)
+	}
+	if v.StickyExecutionEnabled != nil {
+		enc.AddBool("stickyExecutionEnabled", *v.StickyExecutionEnabled)
+	}
+	if v.Query != nil {
+		err = multierr.Append(err, enc.AddObject("query", v.Query))
+	}
+	if v.DecisionInfo != nil {
+		err = multierr.Append(err, enc.AddObject("decisionInfo", v.DecisionInfo))
+	}
+	if v.WorkflowExecutionTaskList != nil {
+		err = multierr.Append(err, enc.AddObject("WorkflowExecutionTaskList", v.WorkflowExecutionTaskList))
+	}
+	if v.EventStoreVersion != nil {
+		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	}
+	if v.BranchToken != nil {
+		enc.AddString("branchToken", base64.StdEncoding.EncodeToString(v.BranchToken))
+	}
+	if v.ScheduledTimestamp != nil {
+		enc.AddInt64("scheduledTimestamp", *v.ScheduledTimestamp)
+	}
+	if v.StartedTimestamp != nil {
+		enc.AddInt64("startedTimestamp", *v.StartedTimestamp)
+	}
+	if v.Queries != nil {
+		err = multierr.Append(err, enc.AddObject("queries", (_Map_String_WorkflowQuery_Zapper)(v.Queries)))
+	}
+	if v.HistorySize != nil {
+		enc.AddInt64("historySize", *v.HistorySize)
+	}
+	if v.HistoryCount != nil {
+		enc.AddInt64("historyCount", *v.HistoryCount)
+	}
+	if v.SuggestContinueAsNew != nil {
+		enc.AddBool("suggestContinueAsNew", *v.SuggestContinueAsNew)
+	}
+	return err
+}
+
+// GetTaskToken returns the value of TaskToken if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetTaskToken() (o []byte) {
+	if v != nil && v.TaskToken != nil {
+		return v.TaskToken
+	}
+
+	return
+}
+
+// IsSetTaskToken returns true if TaskToken is not nil.
+func (v *PollForDecisionTaskResponse) IsSetTaskToken() bool {
+	return v != nil && v.TaskToken != nil
+}
+
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetWorkflowExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+
+	return
+}
+
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *PollForDecisionTaskResponse) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
+}
+
+// GetWorkflowType returns the value of WorkflowType if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetWorkflowType() (o *shared.WorkflowType) {
+	if v != nil && v.WorkflowType != nil {
+		return v.WorkflowType
+	}
+
+	return
+}
+
+// IsSetWorkflowType returns true if WorkflowType is not nil.
+func (v *PollForDecisionTaskResponse) IsSetWorkflowType() bool {
+	return v != nil && v.WorkflowType != nil
+}
+
+// GetPreviousStartedEventId returns the value of PreviousStartedEventId if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetPreviousStartedEventId() (o int64) {
+	if v != nil && v.PreviousStartedEventId != nil {
+		return *v.PreviousStartedEventId
+	}
+
+	return
+}
+
+// IsSetPreviousStartedEventId returns true if PreviousStartedEventId is not nil.
+func (v *PollForDecisionTaskResponse) IsSetPreviousStartedEventId() bool {
+	return v != nil && v.PreviousStartedEventId != nil
+}
+
+// GetStartedEventId returns the value of StartedEventId if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetStartedEventId() (o int64) {
+	if v != nil && v.StartedEventId != nil {
+		return *v.StartedEventId
+	}
+
+	return
+}
+
+// IsSetStartedEventId returns true if StartedEventId is not nil.
+func (v *PollForDecisionTaskResponse) IsSetStartedEventId() bool {
+	return v != nil && v.StartedEventId != nil
+}
+
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetAttempt() (o int64) {
+	if v != nil && v.Attempt != nil {
+		return *v.Attempt
+	}
+
+	return
+}
+
+// IsSetAttempt returns true if Attempt is not nil.
+func (v *PollForDecisionTaskResponse) IsSetAttempt() bool {
+	return v != nil && v.Attempt != nil
+}
+
+// GetNextEventId returns the value of NextEventId if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetNextEventId() (o int64) {
+	if v != nil && v.NextEventId != nil {
+		return *v.NextEventId
+	}
+
+	return
+}
+
+// IsSetNextEventId returns true if NextEventId is not nil.
+func (v *PollForDecisionTaskResponse) IsSetNextEventId() bool {
+	return v != nil && v.NextEventId != nil
+}
+
+// GetBacklogCountHint returns the value of BacklogCountHint if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetBacklogCountHint() (o int64) {
+	if v != nil && v.BacklogCountHint != nil {
+		return *v.BacklogCountHint
+	}
+
+	return
+}
+
+// IsSetBacklogCountHint returns true if BacklogCountHint is not nil.
+func (v *PollForDecisionTaskResponse) IsSetBacklogCountHint() bool {
+	return v != nil && v.BacklogCountHint != nil
+}
+
+// GetStickyExecutionEnabled returns the value of StickyExecutionEnabled if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetStickyExecutionEnabled() (o bool) {
+	if v != nil && v.StickyExecutionEnabled != nil {
+		return *v.StickyExecutionEnabled
+	}
+
+	return
+}
+
+// IsSetStickyExecutionEnabled returns true if StickyExecutionEnabled is not nil.
+func (v *PollForDecisionTaskResponse) IsSetStickyExecutionEnabled() bool {
+	return v != nil && v.StickyExecutionEnabled != nil
+}
+
+// GetQuery returns the value of Query if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetQuery() (o *shared.WorkflowQuery) {
+	if v != nil && v.Query != nil {
+		return v.Query
+	}
+
+	return
+}
+
+// IsSetQuery returns true if Query is not nil.
+func (v *PollForDecisionTaskResponse) IsSetQuery() bool {
+	return v != nil && v.Query != nil
+}
+
+// GetDecisionInfo returns the value of DecisionInfo if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetDecisionInfo() (o *shared.TransientDecisionInfo) {
+	if v != nil && v.DecisionInfo != nil {
+		return v.DecisionInfo
+	}
+
+	return
+}
+
+// IsSetDecisionInfo returns true if DecisionInfo is not nil.
+func (v *PollForDecisionTaskResponse) IsSetDecisionInfo() bool {
+	return v != nil && v.DecisionInfo != nil
+}
+
+// GetWorkflowExecutionTaskList returns the value of WorkflowExecutionTaskList if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetWorkflowExecutionTaskList() (o *shared.TaskList) {
+	if v != nil && v.WorkflowExecutionTaskList != nil {
+		return v.WorkflowExecutionTaskList
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionTaskList returns true if WorkflowExecutionTaskList is not nil.
+func (v *PollForDecisionTaskResponse) IsSetWorkflowExecutionTaskList() bool {
+	return v != nil && v.WorkflowExecutionTaskList != nil
+}
+
+// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetEventStoreVersion() (o int32) {
+	if v != nil && v.EventStoreVersion != nil {
+		return *v.EventStoreVersion
+	}
+
+	return
+}
+
+// IsSetEventStoreVersion returns true if EventStoreVersion is not nil.
+func (v *PollForDecisionTaskResponse) IsSetEventStoreVersion() bool {
+	return v != nil && v.EventStoreVersion != nil
+}
+
+// GetBranchToken returns the value of BranchToken if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetBranchToken() (o []byte) {
+	if v != nil && v.BranchToken != nil {
+		return v.BranchToken
+	}
+
+	return
+}
+
+// IsSetBranchToken returns true if BranchToken is not nil.
+func (v *PollForDecisionTaskResponse) IsSetBranchToken() bool {
+	return v != nil && v.BranchToken != nil
+}
+
+// GetScheduledTimestamp returns the value of ScheduledTimestamp if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetScheduledTimestamp() (o int64) {
+	if v != nil && v.ScheduledTimestamp != nil {
+		return *v.ScheduledTimestamp
+	}
+
+	return
+}
+
+// IsSetScheduledTimestamp returns true if ScheduledTimestamp is not nil.
+func (v *PollForDecisionTaskResponse) IsSetScheduledTimestamp() bool {
+	return v != nil && v.ScheduledTimestamp != nil
+}
+
+// GetStartedTimestamp returns the value of StartedTimestamp if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetStartedTimestamp() (o int64) {
+	if v != nil && v.StartedTimestamp != nil {
+		return *v.StartedTimestamp
+	}
+
+	return
+}
+
+// IsSetStartedTimestamp returns true if StartedTimestamp is not nil.
+func (v *PollForDecisionTaskResponse) IsSetStartedTimestamp() bool {
+	return v != nil && v.StartedTimestamp != nil
+}
+
+// GetQueries returns the value of Queries if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetQueries() (o map[string]*shared.WorkflowQuery) {
+	if v != nil && v.Queries != nil {
+		return v.Queries
+	}
+
+	return
+}
+
+// IsSetQueries returns true if Queries is not nil.
+func (v *PollForDecisionTaskResponse) IsSetQueries() bool {
+	return v != nil && v.Queries != nil
+}
+
+// GetHistorySize returns the value of HistorySize if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetHistorySize() (o int64) {
+	if v != nil && v.HistorySize != nil {
+		return *v.HistorySize
+	}
+
+	return
+}
+
+// IsSetHistorySize returns true if HistorySize is not nil.
+func (v *PollForDecisionTaskResponse) IsSetHistorySize() bool {
+	return v != nil && v.HistorySize != nil
+}
+
+// GetHistoryCount returns the value of HistoryCount if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetHistoryCount() (o int64) {
+	if v != nil && v.HistoryCount != nil {
+		return *v.HistoryCount
+	}
+
+	return
+}
+
+// IsSetHistoryCount returns true if HistoryCount is not nil.
+func (v *PollForDecisionTaskResponse) IsSetHistoryCount() bool {
+	return v != nil && v.HistoryCount != nil
+}
+
+// GetSuggestContinueAsNew returns the value of SuggestContinueAsNew if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetSuggestContinueAsNew() (o bool) {
+	if v != nil && v.SuggestContinueAsNew != nil {
+		return *v.SuggestContinueAsNew
+	}
+
+	return
+}
+
+// IsSetSuggestContinueAsNew returns true if SuggestContinueAsNew is not nil.
+func (v *PollForDecisionTaskResponse) IsSetSuggestContinueAsNew() bool {
+	return v != nil && v.SuggestContinueAsNew != nil
+}
+
+type QueryWorkflowRequest struct {
+	DomainUUID    *string                      `json:"domainUUID,omitempty"`
+	TaskList      *shared.TaskList             `json:"taskList,omitempty"`
+	QueryRequest  *shared.QueryWorkflowRequest `json:"queryRequest,omitempty"`
+	ForwardedFrom *string                      `json:"forwardedFrom,omitempty"`
+}
+
+// ToWire translates a QueryWorkflowRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *QueryWorkflowRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.QueryRequest != nil {
+		w, err = v.QueryRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ForwardedFrom != nil {
+		w, err = wire.NewValueString(*(v.ForwardedFrom)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _QueryWorkflowRequest_Read(w wire.Value) (*shared.QueryWorkflowRequest, error) {
+	var v shared.QueryWorkflowRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a QueryWorkflowRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a QueryWorkflowRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v QueryWorkflowRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *QueryWorkflowRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.QueryRequest, err = _QueryWorkflowRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ForwardedFrom = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a QueryWorkflowRequest
+// struct.
+func (v *QueryWorkflowRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.QueryRequest != nil {
+		fields[i] = fmt.Sprintf("QueryRequest: %v", v.QueryRequest)
+		i++
+	}
+	if v.ForwardedFrom != nil {
+		fields[i] = fmt.Sprintf("ForwardedFrom: %v", *(v.ForwardedFrom))
+		i++
+	}
+
+	return fmt.Sprintf("QueryWorkflowRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this QueryWorkflowRequest match the
+// provided QueryWorkflowRequest.
+//
+// This function performs a deep comparison.
+func (v *QueryWorkflowRequest) Equals(rhs *QueryWorkflowRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !((v.QueryRequest == nil && rhs.QueryRequest == nil) || (v.QueryRequest != nil && rhs.QueryRequest != nil && v.QueryRequest.Equals(rhs.QueryRequest))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ForwardedFrom, rhs.ForwardedFrom) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of QueryWorkflowRequest.
+func (v *QueryWorkflowRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.QueryRequest != nil {
+		err = multierr.Append(err, enc.AddObject("queryRequest", v.QueryRequest))
+	}
+	if v.ForwardedFrom != nil {
+		enc.AddString("forwardedFrom", *v.ForwardedFrom)
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *QueryWorkflowRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *QueryWorkflowRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *QueryWorkflowRequest) GetTaskList() (o *shared.TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *QueryWorkflowRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+// GetQueryRequest returns the value of QueryRequest if it is set or its
+// zero value if it is unset.
+func (v *QueryWorkflowRequest) GetQueryRequest() (o *shared.QueryWorkflowRequest) {
+	if v != nil && v.QueryRequest != nil {
+		return v.QueryRequest
+	}
+
+	return
+}
+
+// IsSetQueryRequest returns true if QueryRequest is not nil.
+func (v *QueryWorkflowRequest) IsSetQueryRequest() bool {
+	return v != nil && v.QueryRequest != nil
+}
+
+// GetForwardedFrom returns the value of ForwardedFrom if it is set or its
+// zero value if it is unset.
+func (v *QueryWorkflowRequest) GetForwardedFrom() (o string) {
+	if v != nil && v.ForwardedFrom != nil {
+		return *v.ForwardedFrom
+	}
+
+	return
+}
+
+// IsSetForwardedFrom returns true if ForwardedFrom is not nil.
+func (v *QueryWorkflowRequest) IsSetForwardedFrom() bool {
+	return v != nil && v.ForwardedFrom != nil
+}
+
+type RespondQueryTaskCompletedRequest struct {
+	DomainUUID       *string                                  `json:"domainUUID,omitempty"`
+	TaskList         *shared.TaskList                         `json:"taskList,omitempty"`
+	TaskID           *string                                  `json:"taskID,omitempty"`
+	CompletedRequest *shared.RespondQueryTaskCompletedRequest `json:"completedRequest,omitempty"`
+}
+
+// ToWire translates a RespondQueryTaskCompletedRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RespondQueryTaskCompletedRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.TaskID != nil {
+		w, err = wire.NewValueString(*(v.TaskID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.CompletedRequest != nil {
+		w, err = v.CompletedRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _RespondQueryTaskCompletedRequest_Read(w wire.Value) (*shared.RespondQueryTaskCompletedRequest, error) {
+	var v shared.RespondQueryTaskCompletedRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RespondQueryTaskCompletedRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RespondQueryTaskCompletedRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RespondQueryTaskCompletedRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TaskID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.CompletedRequest, err = _RespondQueryTaskCompletedRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RespondQueryTaskCompletedRequest
+// struct.
+func (v *RespondQueryTaskCompletedRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.TaskID != nil {
+		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
+		i++
+	}
+	if v.CompletedRequest != nil {
+		fields[i] = fmt.Sprintf("CompletedRequest: %v", v.CompletedRequest)
+		i++
+	}
+
+	return fmt.Sprintf("RespondQueryTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RespondQueryTaskCompletedRequest match the
+// provided RespondQueryTaskCompletedRequest.
+//
+// This function performs a deep comparison.
+func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompletedRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.TaskID, rhs.TaskID) {
+		return false
+	}
+	if !((v.CompletedRequest == nil && rhs.CompletedRequest == nil) || (v.CompletedRequest != nil && rhs.CompletedRequest != nil && v.CompletedRequest.Equals(rhs.CompletedRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RespondQueryTaskCompletedRequest.
+func (v *RespondQueryTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.TaskID != nil {
+		enc.AddString("taskID", *v.TaskID)
+	}
+	if v.CompletedRequest != nil {
+		err = multierr.Append(err, enc.AddObject("completedRequest", v.CompletedRequest))
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *RespondQueryTaskCompletedRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *RespondQueryTaskCompletedRequest) GetTaskList() (o *shared.TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+// GetTaskID returns the value of TaskID if it is set or its
+// zero value if it is unset.
+func (v *RespondQueryTaskCompletedRequest) GetTaskID() (o string) {
+	if v != nil && v.TaskID != nil {
+		return *v.TaskID
+	}
+
+	return
+}
+
+// IsSetTaskID returns true if TaskID is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetTaskID() bool {
+	return v != nil && v.TaskID != nil
+}
+
+// GetCompletedRequest returns the value of CompletedRequest if it is set or its
+// zero value if it is unset.
+func (v *RespondQueryTaskCompletedRequest) GetCompletedRequest() (o *shared.RespondQueryTaskCompletedRequest) {
+	if v != nil && v.CompletedRequest != nil {
+		return v.CompletedRequest
+	}
+
+	return
+}
+
+// IsSetCompletedRequest returns true if CompletedRequest is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetCompletedRequest() bool {
+	return v != nil && v.CompletedRequest != nil
+}
+
+type ResumeTaskListRequest struct {
+	DomainUUID   *string              `json:"domainUUID,omitempty"`
+	TaskList     *shared.TaskList     `json:"taskList,omitempty"`
+	TaskListType *shared.TaskListType `json:"taskListType,omitempty"`
+}
+
+// ToWire translates a ResumeTaskListRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ResumeTaskListRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.TaskListType != nil {
+		w, err = v.TaskListType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ResumeTaskListRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ResumeTaskListRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ResumeTaskListRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ResumeTaskListRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x shared.TaskListType
+				x, err = _TaskListType_Read(field.Value)
+				v.TaskListType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ResumeTaskListRequest
+// struct.
+func (v *ResumeTaskListRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.TaskListType != nil {
+		fields[i] = fmt.Sprintf("TaskListType: %v", *(v.TaskListType))
+		i++
+	}
+
+	return fmt.Sprintf("ResumeTaskListRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ResumeTaskListRequest match the
+// provided ResumeTaskListRequest.
+//
+// This function performs a deep comparison.
+func (v *ResumeTaskListRequest) Equals(rhs *ResumeTaskListRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_TaskListType_EqualsPtr(v.TaskListType, rhs.TaskListType) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ResumeTaskListRequest.
+func (v *ResumeTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.TaskListType != nil {
+		err = multierr.Append(err, enc.AddObject("taskListType", *v.TaskListType))
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *ResumeTaskListRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *ResumeTaskListRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *ResumeTaskListRequest) GetTaskList() (o *shared.TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *ResumeTaskListRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+// GetTaskListType returns the value of TaskListType if it is set or its
+// zero value if it is unset.
+func (v *ResumeTaskListRequest) GetTaskListType() (o shared.TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+
+	return
+}
+
+// IsSetTaskListType returns true if TaskListType is not nil.
+func (v *ResumeTaskListRequest) IsSetTaskListType() bool {
+	return v != nil && v.TaskListType != nil
+}
+
+type TaskSource int32
+
+const (
+	TaskSourceHistory   TaskSource = 0
+	TaskSourceDbBacklog TaskSource = 1
+)
+
+// TaskSource_Values returns all recognized values of TaskSource.
+func TaskSource_Values() []TaskSource {
+	return []TaskSource{
+		TaskSourceHistory,
+		TaskSourceDbBacklog,
+	}
+}
+
+// UnmarshalText tries to decode TaskSource from a byte slice
+// containing its name.
+//
+//   var v TaskSource
+//   err := v.UnmarshalText([]byte("HISTORY"))
+func (v *TaskSource) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "HISTORY":
+		*v = TaskSourceHistory
+		return nil
+	case "DB_BACKLOG":
+		*v = TaskSourceDbBacklog
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "TaskSource", err)
+		}
+		*v = TaskSource(val)
+		return nil
+	}
+}
+
+// MarshalText encodes TaskSource to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v TaskSource) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("HISTORY"), nil
+	case 1:
+		return []byte("DB_BACKLOG"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TaskSource.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v TaskSource) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "HISTORY")
+	case 1:
+		enc.AddString("name", "DB_BACKLOG")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v TaskSource) Ptr() *TaskSource {
+	return &v
+}
+
+// ToWire translates TaskSource into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v TaskSource) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes TaskSource from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return TaskSource(0), err
+//   }
+//
+//   var v TaskSource
+//   if err := v.FromWire(x); err != nil {
+//     return TaskSource(0), err
+//   }
+//   return v, nil
+func (v *TaskSource) FromWire(w wire.Value) error {
+	*v = (TaskSource)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of TaskSource.
+func (v TaskSource) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "HISTORY"
+	case 1:
+		return "DB_BACKLOG"
+	}
+	return fmt.Sprintf("TaskSource(%d)", w)
+}
+
+// Equals returns true if this TaskSource value matches the provided
+// value.
+func (v TaskSource) Equals(rhs TaskSource) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes TaskSource into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v TaskSource) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"HISTORY\""), nil
+	case 1:
+		return ([]byte)("\"DB_BACKLOG\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode TaskSource from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *TaskSource) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "TaskSource")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "TaskSource")
+		}
+		*v = (TaskSource)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "TaskSource")
+	}
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "matching",
+	Package:  "github.com/uber/cadence/.gen/go/matching",
+	FilePath: "matching.thrift",
+	SHA1:     "7bb2c620e63fbbf4c4031307d511b151401fe717",
+	Includes: []*thriftreflect.ThriftModule{
+		shared.ThriftModule,
+	},
+	Raw: rawIDL,
+}
+
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\ninclude \"shared.thrift\"\n\nnamespace java com.uber.cadence.matching\n\n// TaskSource is the source from which a task was produced\nenum TaskSource {\n    HISTORY,    // Task produced by history service\n    DB_BACKLOG // Task produced from matching db backlog\n}\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domainUUID\n  15: optional string pollerID\n  20: optional shared.PollForDecisionTaskRequest pollRequest\n  30: optional string forwardedFrom\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional shared.WorkflowExecution workflowExecution\n  30: optional shared.WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = \"Long\") attempt\n  60: optional i64 (js.type = \"Long\") nextEventId\n  65: optional i64 (js.type = \"Long\") backlogCountHint\n  70: optional bool stickyExecutionEnabled\n  80: optional shared.WorkflowQuery query\n  90: optional shared.TransientDecisionInfo decisionInfo\n  100: optional shared.TaskList WorkflowExecutionTaskList\n  110: optional i32 eventStoreVersion\n  120: optional binary branchToken\n  130: optional i64 (js.type = \"Long\") scheduledTimestamp\n  140: optional i64 (js.type = \"Long\") startedTimestamp\n  150: optional map<string, shared.WorkflowQuery> queries\n  160: optional i64 (js.type = \"Long\") historySize\n  170: optional i64 (js.type = \"Long\") historyCount\n  180: optional bool suggestContinueAsNew\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domainUUID\n  15: optional string pollerID\n  20: optional shared.PollForActivityTaskRequest pollRequest\n  30: optional string forwardedFrom\n}\n\nstruct AddDecisionTaskRequest {\n  10: optional string domainUUID\n  20: optional shared.WorkflowExecution execution\n  30: optional shared.TaskList taskList\n  40: optional i64 (js.type = \"Long\") scheduleId\n  50: optional i32 scheduleToStartTimeoutSeconds\n  59: optional TaskSource source\n  60: optional string forwardedFrom\n}\n\nstruct AddActivityTaskRequest {\n  10: optional string domainUUID\n  20: optional shared.WorkflowExecution execution\n  30: optional string sourceDomainUUID\n  40: optional shared.TaskList taskList\n  50: optional i64 (js.type = \"Long\") scheduleId\n  60: optional i32 scheduleToStartTimeoutSeconds\n  69: optional TaskSource source\n  70: optional string forwardedFrom\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional shared.QueryWorkflowRequest queryRequest\n  40: optional string forwardedFrom\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional string taskID\n  40: optional shared.RespondQueryTaskCompletedRequest completedRequest\n}\n\nstruct CancelOutstandingPollRequest {\n  10: optional string domainUUID\n  20: optional i32 taskListType\n  30: optional shared.TaskList taskList\n  40: optional string pollerID\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domainUUID\n  20: optional shared.DescribeTaskListRequest descRequest\n}\n\nstruct ListTaskListPartitionsRequest {\n  10: optional string domain\n  20: optional shared.TaskList taskList\n}\n\nstruct PauseTaskListRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional shared.TaskListType taskListType\n}\n\nstruct ResumeTaskListRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional shared.TaskListType taskListType\n}\n\nstruct MoveTaskListBacklogRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList sourceTaskList\n  30: optional shared.TaskList targetTaskList\n  40: optional shared.TaskListType taskListType\n  50: optional i32 batchSize\n  60: optional i64 (js.type = \"Long\") afterTaskID\n  70: optional bool dryRun\n}\n\n/**\n* MatchingService API is exposed to provide support for polling from long running applications.\n* Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each\n* DecisionTask, application is expected to process the history of events for that session and respond back with next\n* decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back\n* with completion or failure.\n**/\nservice MatchingService {\n  /**\n  * PollForDecisionTask is called by frontend to process DecisionTask from a specific taskList.  A\n  * DecisionTask is dispatched to callers for active workflow executions, with pending decisions.\n  **/\n  PollForDecisionTaskResponse PollForDecisionTask(1: PollForDecisionTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * PollForActivityTask is called by frontend to process ActivityTask from a specific taskList.  ActivityTask\n  * is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.\n  **/\n  shared.PollForActivityTaskResponse PollForActivityTask(1: PollForActivityTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * AddDecisionTask is called by the history service when a decision task is scheduled, so that it can be dispatched\n  * by the MatchingEngine.\n  **/\n  void AddDecisionTask(1: AddDecisionTaskRequest addRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.RemoteSyncMatchedError remoteSyncMatchedError,\n    )\n\n  /**\n  * AddActivityTask is called by the history service when a decision task is scheduled, so that it can be dispatched\n  * by the MatchingEngine.\n  **/\n  void AddActivityTask(1: AddActivityTaskRequest addRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.RemoteSyncMatchedError remoteSyncMatchedError,\n    )\n\n  /**\n  * QueryWorkflow is called by frontend to query a workflow.\n  **/\n  shared.QueryWorkflowResponse QueryWorkflow(1: QueryWorkflowRequest queryRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.QueryFailedError queryFailedError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * RespondQueryTaskCompleted is called by frontend to respond query completed.\n  **/\n  void RespondQueryTaskCompleted(1: RespondQueryTaskCompletedRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n    * CancelOutstandingPoll is called by frontend to unblock long polls on matching for zombie pollers.\n    * Our rpc stack does not support context propagation, so when a client connection goes away frontend sees\n    * cancellation of context for that handler, but any corresponding calls (long-poll) to matching service does not\n    * see the cancellation propagated so it can unblock corresponding long-polls on its end.  This results is tasks\n    * being dispatched to zombie pollers in this situation.  This API is added so everytime frontend makes a long-poll\n    * api call to matching it passes in a pollerID and then calls this API when it detects client connection is closed\n    * to unblock long polls for this poller and prevent tasks being sent to these zombie pollers.\n    **/\n  void CancelOutstandingPoll(1: CancelOutstandingPollRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * DescribeTaskList returns information about the target tasklist, right now this API returns the\n  * pollers which polled this tasklist in last few minutes.\n  **/\n  shared.DescribeTaskListResponse DescribeTaskList(1: DescribeTaskListRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        3: shared.EntityNotExistsError entityNotExistError,\n        4: shared.ServiceBusyError serviceBusyError,\n      )\n\n  /**\n  * GetTaskListsByDomain returns the list of all the task lists for a domainName.\n  **/\n  shared.GetTaskListsByDomainResponse GetTaskListsByDomain(1: shared.GetTaskListsByDomainRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        3: shared.EntityNotExistsError entityNotExistError,\n        4: shared.ServiceBusyError serviceBusyError,\n      )\n\n  /**\n  * ListTaskListPartitions returns a map of partitionKey and hostAddress for a taskList\n  **/\n  shared.ListTaskListPartitionsResponse ListTaskListPartitions(1: ListTaskListPartitionsRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * PauseTaskList stops dispatching tasks from a task list partition owned by this host.\n  * The paused state is persisted with the task list so it survives ownership changes.\n  **/\n  void PauseTaskList(1: PauseTaskListRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * ResumeTaskList resumes dispatching tasks from a task list partition paused by PauseTaskList.\n  **/\n  void ResumeTaskList(1: ResumeTaskListRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * MoveTaskListBacklog reads a batch of persisted tasks from a task list partition owned by this host,\n  * re-adds them to the target task list and deletes them from the source.\n  **/\n  shared.MoveTaskListBacklogResponse MoveTaskListBacklog(1: MoveTaskListBacklogRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        4: shared.ServiceBusyError serviceBusyError,\n    )\n}\n"
+
+// MatchingService_AddActivityTask_Args represents the arguments for the MatchingService.AddActivityTask function.
+//
+// The arguments for AddActivityTask are sent and received over the wire as this struct.
+type MatchingService_AddActivityTask_Args struct {
+	AddRequest *AddActivityTaskRequest `json:"addRequest,omitempty"`
+}
+
+// ToWire translates a MatchingService_AddActivityTask_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MatchingService_AddActivityTask_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.AddRequest != nil {
+		w, err = v.AddRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _AddActivityTaskRequest_Read(w wire.Value) (*AddActivityTaskRequest, error) {
+	var v AddActivityTaskRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MatchingService_AddActivityTask_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MatchingService_AddActivityTask_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MatchingService_AddActivityTask_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MatchingService_AddActivityTask_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.AddRequest, err = _AddActivityTaskRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MatchingService_AddActivityTask_Args
+// struct.
+func (v *MatchingService_AddActivityTask_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.AddRequest != nil {
+		fields[i] = fmt.Sprintf("AddRequest: %v", v.AddRequest)
+		i++
+	}
+
+	return fmt.Sprintf("MatchingService_AddActivityTask_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this MatchingService_AddActivityTask_Args match the
+// provided MatchingService_AddActivityTask_Args.
+//
+// This function performs a deep comparison.
+func (v *MatchingService_AddActivityTask_Args) Equals(rhs *MatchingService_AddActivityTask_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.AddRequest == nil && rhs.AddRequest == nil) || (v.AddRequest != nil && rhs.AddRequest != nil && v.AddRequest.Equals(rhs.AddRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MatchingService_AddActivityTask_Args.
+func (v *MatchingService_AddActivityTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.AddRequest != nil {
+		err = multierr.Append(err, enc.AddObject("addRequest", v.AddRequest))
+	}
+	return err
+}
+
+// GetAddRequest returns the value of AddRequest if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Args) GetAddRequest() (o *AddActivityTaskRequest) {
+	if v != nil && v.AddRequest != nil {
+		return v.AddRequest
+	}
+
+	return
+}
+
+// IsSetAddRequest returns true if AddRequest is not nil.
+func (v *MatchingService_AddActivityTask_Args) IsSetAddRequest() bool {
+	return v != nil && v.AddRequest != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "AddActivityTask" for this struct.
+func (v *MatchingService_AddActivityTask_Args) MethodName() string {
+	return "AddActivityTask"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *MatchingService_AddActivityTask_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// MatchingService_AddActivityTask_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.AddActivityTask
+// function.
+var MatchingService_AddActivityTask_Helper = struct {
+	// Args accepts the parameters of AddActivityTask in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		addRequest *AddActivityTaskRequest,
+	) *MatchingService_AddActivityTask_Args
+
+	// IsException returns true if the given error can be thrown
+	// by AddActivityTask.
+	//
+	// An error can be thrown by AddActivityTask only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for AddActivityTask
+	// given the error returned by it. The provided error may
+	// be nil if AddActivityTask did not fail.
+	//
+	// This allows mapping errors returned by AddActivityTask into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// AddActivityTask
+	//
+	//   err := AddActivityTask(args)
+	//   result, err := MatchingService_AddActivityTask_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from AddActivityTask: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*MatchingService_AddActivityTask_Result, error)
+
+	// UnwrapResponse takes the result struct for AddActivityTask
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if AddActivityTask threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := MatchingService_AddActivityTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_AddActivityTask_Result) error
+}{}
+
+func init() {
+	MatchingService_AddActivityTask_Helper.Args = func(
+		addRequest *AddActivityTaskRequest,
+	) *MatchingService_AddActivityTask_Args {
+		return &MatchingService_AddActivityTask_Args{
+			AddRequest: addRequest,
+		}
+	}
+
+	MatchingService_AddActivityTask_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.LimitExceededError:
+			return true
+		case *shared.DomainNotActiveError:
+			return true
+		case *shared.RemoteSyncMatchedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	MatchingService_AddActivityTask_Helper.WrapResponse = func(err error) (*MatchingService_AddActivityTask_Result, error) {
+		if err == nil {
+			return &MatchingService_AddActivityTask_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.BadRequestError")
+			}
+			return &MatchingService_AddActivityTask_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.InternalServiceError")
+			}
+			return &MatchingService_AddActivityTask_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.ServiceBusyError")
+			}
+			return &MatchingService_AddActivityTask_Result{ServiceBusyError: e}, nil
+		case *shared.LimitExceededError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.LimitExceededError")
+			}
+			return &MatchingService_AddActivityTask_Result{LimitExceededError: e}, nil
+		case *shared.DomainNotActiveError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.DomainNotActiveError")
+			}
+			return &MatchingService_AddActivityTask_Result{DomainNotActiveError: e}, nil
+		case *shared.RemoteSyncMatchedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.RemoteSyncMatchedError")
+			}
+			return &MatchingService_AddActivityTask_Result{RemoteSyncMatchedError: e}, nil
+		}
+
+		return nil, err
+	}
+	MatchingService_AddActivityTask_Helper.UnwrapResponse = func(result *MatchingService_AddActivityTask_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.LimitExceededError != nil {
+			err = result.LimitExceededError
+			return
+		}
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
+			return
+		}
+		if result.RemoteSyncMatchedError != nil {
+			err = result.RemoteSyncMatchedError
+			return
+		}
+		return
+	}
+
+}
+
+// MatchingService_AddActivityTask_Result represents the result of a MatchingService.AddActivityTask function call.
+//
+// The result of a AddActivityTask execution is sent and received over the wire as this struct.
+type MatchingService_AddActivityTask_Result struct {
+	BadRequestError        *shared.BadRequestError        `json:"badRequestError,omitempty"`
+	InternalServiceError   *shared.InternalServiceError   `json:"internalServiceError,omitempty"`
+	ServiceBusyError       *shared.ServiceBusyError       `json:"serviceBusyError,omitempty"`
+	LimitExceededError     *shared.LimitExceededError     `json:"limitExceededError,omitempty"`
+	DomainNotActiveError   *shared.DomainNotActiveError   `json:"domainNotActiveError,omitempty"`
+	RemoteSyncMatchedError *shared.RemoteSyncMatchedError `json:"remoteSyncMatchedError,omitempty"`
+}
+
+// ToWire translates a MatchingService_AddActivityTask_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MatchingService_AddActivityTask_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.LimitExceededError != nil {
+		w, err = v.LimitExceededError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.RemoteSyncMatchedError != nil {
+		w, err = v.RemoteSyncMatchedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _BadRequestError_Read(w wire.Value) (*shared.BadRequestError, error) {
+	var v shared.BadRequestError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _InternalServiceError_Read(w wire.Value) (*shared.InternalServiceError, error) {
+	var v shared.InternalServiceError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
+	var v shared.ServiceBusyError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _LimitExceededError_Read(w wire.Value) (*shared.LimitExceededError, error) {
+	var v shared.LimitExceededError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _DomainNotActiveError_Read(w wire.Value) (*shared.DomainNotActiveError, error) {
+	var v shared.DomainNotActiveError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _RemoteSyncMatchedError_Read(w wire.Value) (*shared.RemoteSyncMatchedError, error) {
+	var v shared.RemoteSyncMatchedError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MatchingService_AddActivityTask_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MatchingService_AddActivityTask_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MatchingService_AddActivityTask_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MatchingService_AddActivityTask_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.RemoteSyncMatchedError, err = _RemoteSyncMatchedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.RemoteSyncMatchedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MatchingService_AddActivityTask_Result
+// struct.
+func (v *MatchingService_AddActivityTask_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.LimitExceededError != nil {
+		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+		i++
+	}
+	if v.RemoteSyncMatchedError != nil {
+		fields[i] = fmt.Sprintf("RemoteSyncMatchedError: %v", v.RemoteSyncMatchedError)
+		i++
+	}
+
+	return fmt.Sprintf("MatchingService_AddActivityTask_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this MatchingService_AddActivityTask_Result match the
+// provided MatchingService_AddActivityTask_Result.
+//
+// This function performs a deep comparison.
+func (v *MatchingService_AddActivityTask_Result) Equals(rhs *MatchingService_AddActivityTask_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+		return false
+	}
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
+		return false
+	}
+	if !((v.RemoteSyncMatchedError == nil && rhs.RemoteSyncMatchedError == nil) || (v.RemoteSyncMatchedError != nil && rhs.RemoteSyncMatchedError != nil && v.RemoteSyncMatchedError.Equals(rhs.RemoteSyncMatchedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MatchingService_AddActivityTask_Result.
+func (v *MatchingService_AddActivityTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.LimitExceededError != nil {
+		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
+	}
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
+	}
+	if v.RemoteSyncMatchedError != nil {
+		err = multierr.Append(err, enc.AddObject("remoteSyncMatchedError", v.RemoteSyncMatchedError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetLimitExceededError returns the value of LimitExceededError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+	if v != nil && v.LimitExceededError != nil {
+		return v.LimitExceededError
+	}
+
+	return
+}
+
+// IsSetLimitExceededError returns true if LimitExceededError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetLimitExceededError() bool {
+	return v != nil && v.LimitExceededError != nil
+}
+
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
+	}
+
+	return
+}
+
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
+}
+
+// GetRemoteSyncMatchedError returns the value of RemoteSyncMatchedError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetRemoteSyncMatchedError() (o *shared.RemoteSyncMatchedError) {
+	if v != nil && v.RemoteSyncMatchedError != nil {
+		return v.RemoteSyncMatchedError
+	}
+
+	return
+}
+
+// IsSetRemoteSyncMatchedError returns true if RemoteSyncMatchedError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetRemoteSyncMatchedError() bool {
+	return v != nil && v.RemoteSyncMatchedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "AddActivityTask" for this struct.
+func (v *MatchingService_AddActivityTask_Result) MethodName() string {
+	return "AddActivityTask"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *MatchingService_AddActivityTask_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// MatchingService_AddDecisionTask_Args represents the arguments for the MatchingService.AddDecisionTask function.
+//
+// The arguments for AddDecisionTask are sent and received over the wire as this struct.
+type MatchingService_AddDecisionTask_Args struct {
+	AddRequest *AddDecisionTaskRequest `json:"addRequest,omitempty"`
+}
+
+// ToWire translates a MatchingService_AddDecisionTask_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MatchingService_AddDecisionTask_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.AddRequest != nil {
+		w, err = v.AddRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _AddDecisionTaskRequest_Read(w wire.Value) (*AddDecisionTaskRequest, error) {
+	var v AddDecisionTaskRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MatchingService_AddDecisionTask_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MatchingService_AddDecisionTask_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MatchingService_AddDecisionTask_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MatchingService_AddDecisionTask_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.AddRequest, err = _AddDecisionTaskRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MatchingService_AddDecisionTask_Args
+// struct.
+func (v *MatchingService_AddDecisionTask_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.AddRequest != nil {
+		fields[i] = fmt.Sprintf("AddRequest: %v", v.AddRequest)
+		i++
+	}
+
+	return fmt.Sprintf("MatchingService_AddDecisionTask_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this MatchingService_AddDecisionTask_Args match the
+// provided MatchingService_AddDecisionTask_Args.
+//
+// This function performs a deep comparison.
+func (v *MatchingService_AddDecisionTask_Args) Equals(rhs *MatchingService_AddDecisionTask_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.AddRequest == nil && rhs.AddRequest == nil) || (v.AddRequest != nil && rhs.AddRequest != nil && v.AddRequest.Equals(rhs.AddRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MatchingService_AddDecisionTask_Args.
+func (v *MatchingService_AddDecisionTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.AddRequest != nil {
+		err = multierr.Append(err, enc.AddObject("addRequest", v.AddRequest))
+	}
+	return err
+}
+
+// GetAddRequest returns the value of AddRequest if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddDecisionTask_Args) GetAddRequest() (o *AddDecisionTaskRequest) {
+	if v != nil && v.AddRequest != nil {
+		return v.AddRequest
+	}
+
+	return
+}
+
+// IsSetAddRequest returns true if AddRequest is not nil.
+func (v *MatchingService_AddDecisionTask_Args) IsSetAddRequest() bool {
+	return v != nil && v.AddRequest != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "AddDecisionTask" for this struct.
+func (v *MatchingService_AddDecisionTask_Args) MethodName() string {
+	return "AddDecisionTask"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *MatchingService_AddDecisionTask_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// MatchingService_AddDecisionTask_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.AddDecisionTask
+// function.
+var MatchingService_AddDecisionTask_Helper = struct {
+	// Args accepts the parameters of AddDecisionTask in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		addRequest *AddDecisionTaskRequest,
+	) *MatchingService_AddDecisionTask_Args
+
+	// IsException returns true if the given error can be thrown
+	// by AddDecisionTask.
+	//
+	// An error can be thrown by AddDecisionTask only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for AddDecisionTask
+	// given the error returned by it. The provided error may
+	// be nil if AddDecisionTask did not fail.
+	//
+	// This allows mapping errors returned by AddDecisionTask into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// AddDecisionTask
+	//
+	//   err := AddDecisionTask(args)
+	//   result, err := MatchingService_AddDecisionTask_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from AddDecisionTask: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*MatchingService_AddDecisionTask_Result, error)
+
+	// UnwrapResponse takes the result struct for AddDecisionTask
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if AddDecisionTask threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := MatchingService_AddDecisionTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_AddDecisionTask_Result) error
+}{}
+
+func init() {
+	MatchingService_AddDecisionTask_Helper.Args = func(
+		addRequest *AddDecisionTaskRequest,
+	) *MatchingService_AddDecisionTask_Args {
+		return &MatchingService_AddDecisionTask_Args{
+			AddRequest: addRequest,
+		}
+	}
+
+	MatchingService_AddDecisionTask_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.LimitExceededError:
+			return true
+		case *shared.DomainNotActiveError:
+			return true
+		case *shared.RemoteSyncMatchedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	MatchingService_AddDecisionTask_Helper.WrapResponse = func(err error) (*MatchingService_AddDecisionTask_Result, error) {
+		if err == nil {
+			return &MatchingService_AddDecisionTask_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.BadRequestError")
+			}
+			return &MatchingService_AddDecisionTask_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.InternalServiceError")
+			}
+			return &MatchingService_AddDecisionTask_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.ServiceBusyError")
+			}
+			return &MatchingService_AddDecisionTask_Result{ServiceBusyError: e}, nil
+		case *shared.LimitExceededError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.LimitExceededError")
+			}
+			return &MatchingService_AddDecisionTask_Result{LimitExceededError: e}, nil
+		case *shared.DomainNotActiveError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.DomainNotActiveError")
+			}
+			return &MatchingService_AddDecisionTask_Result{DomainNotActiveError: e}, nil
+		case *shared.RemoteSyncMatchedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.RemoteSyncMatchedError")
+			}
+			return &MatchingService_AddDecisionTask_Result{RemoteSyncMatchedError: e}, nil
+		}
+
+		return nil, err
+	}
+	MatchingService_AddDecisionTask_Helper.UnwrapResponse = func(result *MatchingService_AddDecisionTask_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.LimitExceededError != nil {
+			err = result.LimitExceededError
+			return
+		}
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
+			return
+		}
+		if result.RemoteSyncMatchedError != nil {
+			err = result.RemoteSyncMatchedError
+			return
+		}
+		return
+	}
+
+}
+
+// MatchingService_AddDecisionTask_Result represents the result of a MatchingService.AddDecisionTask function call.
+//
+// The result of a AddDecisionTask execution is sent and received over the wire as this struct.
+type MatchingService_AddDecisionTask_Result struct {
+	BadRequestError        *shared.BadRequestError        `json:"badRequestError,omitempty"`
+	InternalServiceError   *shared.InternalServiceError   `json:"internalServiceError,omitempty"`
+	ServiceBusyError       *shared.ServiceBusyError       `json:"serviceBusyError,omitempty"`
+	LimitExceededError     *shared.LimitExceededError     `json:"limitExceededError,omitempty"`
+	DomainNotActiveError   *shared.DomainNotActiveError   `json:"domainNotActiveError,omitempty"`
+	RemoteSyncMatchedError *shared.RemoteSyncMatchedError `json:"remoteSyncMatchedError,omitempty"`
+}
+
+// ToWire translates a MatchingService_AddDecisionTask_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MatchingService_AddDecisionTask_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.LimitExceededError != nil {
+		w, err = v.LimitExceededError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.RemoteSyncMatchedError != nil {
+		w, err = v.RemoteSyncMatchedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a MatchingService_AddDecisionTask_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MatchingService_AddDecisionTask_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MatchingService_AddDecisionTask_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MatchingService_AddDecisionTask_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.RemoteSyncMatchedError, err = _RemoteSyncMatchedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
 
-// GetBacklogCountHint returns the value of BacklogCountHint if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetBacklogCountHint() (o int64) {
-	if v != nil && v.BacklogCountHint != nil {
-		return *v.BacklogCountHint
+			}
+		}
 	}
 
-	return
-}
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.RemoteSyncMatchedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", count)
+	}
 
-// IsSetBacklogCountHint returns true if BacklogCountHint is not nil.
-func (v *PollForDecisionTaskResponse) IsSetBacklogCountHint() bool {
-	return v != nil && v.BacklogCountHint != nil
+	return nil
 }
 
-// GetStickyExecutionEnabled returns the value of StickyExecutionEnabled if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetStickyExecutionEnabled() (o bool) {
-	if v != nil && v.StickyExecutionEnabled != nil {
-		return *v.StickyExecutionEnabled
+// String returns a readable string representation of a MatchingService_AddDecisionTask_Result
+// struct.
+func (v *MatchingService_AddDecisionTask_Result) String() string {
+	if v == nil {
+		return "<nil>"
 	}
 
-	return
-}
+	var fields [6]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.LimitExceededError != nil {
+		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+		i++
+	}
+	if v.RemoteSyncMatchedError != nil {
+		fields[i] = fmt.Sprintf("RemoteSyncMatchedError: %v", v.RemoteSyncMatchedError)
+		i++
+	}
 
-// IsSetStickyExecutionEnabled returns true if StickyExecutionEnabled is not nil.
-func (v *PollForDecisionTaskResponse) IsSetStickyExecutionEnabled() bool {
-	return v != nil && v.StickyExecutionEnabled != nil
+	return fmt.Sprintf("MatchingService_AddDecisionTask_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// GetQuery returns the value of Query if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetQuery() (o *shared.WorkflowQuery) {
-	if v != nil && v.Query != nil {
-		return v.Query
+// Equals returns true if all the fields of this MatchingService_AddDecisionTask_Result match the
+// provided MatchingService_AddDecisionTask_Result.
+//
+// This function performs a deep comparison.
+func (v *MatchingService_AddDecisionTask_Result) Equals(rhs *MatchingService_AddDecisionTask_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+		return false
+	}
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
+		return false
+	}
+	if !((v.RemoteSyncMatchedError == nil && rhs.RemoteSyncMatchedError == nil) || (v.RemoteSyncMatchedError != nil && rhs.RemoteSyncMatchedError != nil && v.RemoteSyncMatchedError.Equals(rhs.RemoteSyncMatchedError))) {
+		return false
 	}
 
-	return
+	return true
 }
 
-// IsSetQuery returns true if Query is not nil.
-func (v *PollForDecisionTaskResponse) IsSetQuery() bool {
-	return v != nil && v.Query != nil
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MatchingService_AddDecisionTask_Result.
+func (v *MatchingService_AddDecisionTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.LimitExceededError != nil {
+		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
+	}
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
+	}
+	if v.RemoteSyncMatchedError != nil {
+		err = multierr.Append(err, enc.AddObject("remoteSyncMatchedError", v.RemoteSyncMatchedError))
+	}
+	return err
 }
 
-// GetDecisionInfo returns the value of DecisionInfo if it is set or its
+// GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetDecisionInfo() (o *shared.TransientDecisionInfo) {
-	if v != nil && v.DecisionInfo != nil {
-		return v.DecisionInfo
+func (v *MatchingService_AddDecisionTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
 	}
 
 	return
 }
 
-// IsSetDecisionInfo returns true if DecisionInfo is not nil.
-func (v *PollForDecisionTaskResponse) IsSetDecisionInfo() bool {
-	return v != nil && v.DecisionInfo != nil
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *MatchingService_AddDecisionTask_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
 }
 
-// GetWorkflowExecutionTaskList returns the value of WorkflowExecutionTaskList if it is set or its
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetWorkflowExecutionTaskList() (o *shared.TaskList) {
-	if v != nil && v.WorkflowExecutionTaskList != nil {
-		return v.WorkflowExecutionTaskList
+func (v *MatchingService_AddDecisionTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
 	}
 
 	return
 }
 
-// IsSetWorkflowExecutionTaskList returns true if WorkflowExecutionTaskList is not nil.
-func (v *PollForDecisionTaskResponse) IsSetWorkflowExecutionTaskList() bool {
-	return v != nil && v.WorkflowExecutionTaskList != nil
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *MatchingService_AddDecisionTask_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
 }
 
-// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetEventStoreVersion() (o int32) {
-	if v != nil && v.EventStoreVersion != nil {
-		return *v.EventStoreVersion
+func (v *MatchingService_AddDecisionTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
 	}
 
 	return
 }
 
-// IsSetEventStoreVersion returns true if EventStoreVersion is not nil.
-func (v *PollForDecisionTaskResponse) IsSetEventStoreVersion() bool {
-	return v != nil && v.EventStoreVersion != nil
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *MatchingService_AddDecisionTask_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
 }
 
-// GetBranchToken returns the value of BranchToken if it is set or its
+// GetLimitExceededError returns the value of LimitExceededError if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetBranchToken() (o []byte) {
-	if v != nil && v.BranchToken != nil {
-		return v.BranchToken
+func (v *MatchingService_AddDecisionTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+	if v != nil && v.LimitExceededError != nil {
+		return v.LimitExceededError
 	}
 
 	return
 }
 
-// IsSetBranchToken returns true if BranchToken is not nil.
-func (v *PollForDecisionTaskResponse) IsSetBranchToken() bool {
-	return v != nil && v.BranchToken != nil
+// IsSetLimitExceededError returns true if LimitExceededError is not nil.
+func (v *MatchingService_AddDecisionTask_Result) IsSetLimitExceededError() bool {
+	return v != nil && v.LimitExceededError != nil
 }
 
-// GetScheduledTimestamp returns the value of ScheduledTimestamp if it is set or its
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetScheduledTimestamp() (o int64) {
-	if v != nil && v.ScheduledTimestamp != nil {
-		return *v.ScheduledTimestamp
+func (v *MatchingService_AddDecisionTask_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
 	}
 
 	return
 }
 
-// IsSetScheduledTimestamp returns true if ScheduledTimestamp is not nil.
-func (v *PollForDecisionTaskResponse) IsSetScheduledTimestamp() bool {
-	return v != nil && v.ScheduledTimestamp != nil
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *MatchingService_AddDecisionTask_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
 }
 
-// GetStartedTimestamp returns the value of StartedTimestamp if it is set or its
+// GetRemoteSyncMatchedError returns the value of RemoteSyncMatchedError if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetStartedTimestamp() (o int64) {
-	if v != nil && v.StartedTimestamp != nil {
-		return *v.StartedTimestamp
+func (v *MatchingService_AddDecisionTask_Result) GetRemoteSyncMatchedError() (o *shared.RemoteSyncMatchedError) {
+	if v != nil && v.RemoteSyncMatchedError != nil {
+		return v.RemoteSyncMatchedError
 	}
 
 	return
 }
 
-// IsSetStartedTimestamp returns true if StartedTimestamp is not nil.
-func (v *PollForDecisionTaskResponse) IsSetStartedTimestamp() bool {
-	return v != nil && v.StartedTimestamp != nil
+// IsSetRemoteSyncMatchedError returns true if RemoteSyncMatchedError is not nil.
+func (v *MatchingService_AddDecisionTask_Result) IsSetRemoteSyncMatchedError() bool {
+	return v != nil && v.RemoteSyncMatchedError != nil
 }
 
-// GetQueries returns the value of Queries if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetQueries() (o map[string]*shared.WorkflowQuery) {
-	if v != nil && v.Queries != nil {
-		return v.Queries
-	}
-
-	return
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "AddDecisionTask" for this struct.
+func (v *MatchingService_AddDecisionTask_Result) MethodName() string {
+	return "AddDecisionTask"
 }
 
-// IsSetQueries returns true if Queries is not nil.
-func (v *PollForDecisionTaskResponse) IsSetQueries() bool {
-	return v != nil && v.Queries != nil
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *MatchingService_AddDecisionTask_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
 }
 
-type QueryWorkflowRequest struct {
-	DomainUUID    *string                      `json:"domainUUID,omitempty"`
-	TaskList      *shared.TaskList             `json:"taskList,omitempty"`
-	QueryRequest  *shared.QueryWorkflowRequest `json:"queryRequest,omitempty"`
-	ForwardedFrom *string                      `json:"forwardedFrom,omitempty"`
+// MatchingService_CancelOutstandingPoll_Args represents the arguments for the MatchingService.CancelOutstandingPoll function.
+//
+// The arguments for CancelOutstandingPoll are sent and received over the wire as this struct.
+type MatchingService_CancelOutstandingPoll_Args struct {
+	Request *CancelOutstandingPollRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a QueryWorkflowRequest struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_CancelOutstandingPoll_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3093,61 +6242,37 @@ type QueryWorkflowRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *QueryWorkflowRequest) ToWire() (wire.Value, error) {
+func (v *MatchingService_CancelOutstandingPoll_Args) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.QueryRequest != nil {
-		w, err = v.QueryRequest.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.ForwardedFrom != nil {
-		w, err = wire.NewValueString(*(v.ForwardedFrom)), error(nil)
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
+		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _QueryWorkflowRequest_Read(w wire.Value) (*shared.QueryWorkflowRequest, error) {
-	var v shared.QueryWorkflowRequest
+func _CancelOutstandingPollRequest_Read(w wire.Value) (*CancelOutstandingPollRequest, error) {
+	var v CancelOutstandingPollRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a QueryWorkflowRequest struct from its Thrift-level
+// FromWire deserializes a MatchingService_CancelOutstandingPoll_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a QueryWorkflowRequest struct
+// An error is returned if we were unable to build a MatchingService_CancelOutstandingPoll_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3155,47 +6280,19 @@ func _QueryWorkflowRequest_Read(w wire.Value) (*shared.QueryWorkflowRequest, err
 //     return nil, err
 //   }
 //
-//   var v QueryWorkflowRequest
+//   var v MatchingService_CancelOutstandingPoll_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *QueryWorkflowRequest) FromWire(w wire.Value) error {
+func (v *MatchingService_CancelOutstandingPoll_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
+		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.QueryRequest, err = _QueryWorkflowRequest_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ForwardedFrom = &x
+				v.Request, err = _CancelOutstandingPollRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3207,55 +6304,34 @@ func (v *QueryWorkflowRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a QueryWorkflowRequest
+// String returns a readable string representation of a MatchingService_CancelOutstandingPoll_Args
 // struct.
-func (v *QueryWorkflowRequest) String() string {
+func (v *MatchingService_CancelOutstandingPoll_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [1]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
-		i++
-	}
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
-		i++
-	}
-	if v.QueryRequest != nil {
-		fields[i] = fmt.Sprintf("QueryRequest: %v", v.QueryRequest)
-		i++
-	}
-	if v.ForwardedFrom != nil {
-		fields[i] = fmt.Sprintf("ForwardedFrom: %v", *(v.ForwardedFrom))
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("QueryWorkflowRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_CancelOutstandingPoll_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this QueryWorkflowRequest match the
-// provided QueryWorkflowRequest.
+// Equals returns true if all the fields of this MatchingService_CancelOutstandingPoll_Args match the
+// provided MatchingService_CancelOutstandingPoll_Args.
 //
 // This function performs a deep comparison.
-func (v *QueryWorkflowRequest) Equals(rhs *QueryWorkflowRequest) bool {
+func (v *MatchingService_CancelOutstandingPoll_Args) Equals(rhs *MatchingService_CancelOutstandingPoll_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
-		return false
-	}
-	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
-		return false
-	}
-	if !((v.QueryRequest == nil && rhs.QueryRequest == nil) || (v.QueryRequest != nil && rhs.QueryRequest != nil && v.QueryRequest.Equals(rhs.QueryRequest))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.ForwardedFrom, rhs.ForwardedFrom) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -3263,94 +6339,168 @@ func (v *QueryWorkflowRequest) Equals(rhs *QueryWorkflowRequest) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of QueryWorkflowRequest.
-func (v *QueryWorkflowRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_CancelOutstandingPoll_Args.
+func (v *MatchingService_CancelOutstandingPoll_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.DomainUUID != nil {
-		enc.AddString("domainUUID", *v.DomainUUID)
-	}
-	if v.TaskList != nil {
-		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
-	}
-	if v.QueryRequest != nil {
-		err = multierr.Append(err, enc.AddObject("queryRequest", v.QueryRequest))
-	}
-	if v.ForwardedFrom != nil {
-		enc.AddString("forwardedFrom", *v.ForwardedFrom)
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *QueryWorkflowRequest) GetDomainUUID() (o string) {
-	if v != nil && v.DomainUUID != nil {
-		return *v.DomainUUID
+func (v *MatchingService_CancelOutstandingPoll_Args) GetRequest() (o *CancelOutstandingPollRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *QueryWorkflowRequest) IsSetDomainUUID() bool {
-	return v != nil && v.DomainUUID != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *MatchingService_CancelOutstandingPoll_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
-// GetTaskList returns the value of TaskList if it is set or its
-// zero value if it is unset.
-func (v *QueryWorkflowRequest) GetTaskList() (o *shared.TaskList) {
-	if v != nil && v.TaskList != nil {
-		return v.TaskList
-	}
-
-	return
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "CancelOutstandingPoll" for this struct.
+func (v *MatchingService_CancelOutstandingPoll_Args) MethodName() string {
+	return "CancelOutstandingPoll"
 }
 
-// IsSetTaskList returns true if TaskList is not nil.
-func (v *QueryWorkflowRequest) IsSetTaskList() bool {
-	return v != nil && v.TaskList != nil
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *MatchingService_CancelOutstandingPoll_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
 }
 
-// GetQueryRequest returns the value of QueryRequest if it is set or its
-// zero value if it is unset.
-func (v *QueryWorkflowRequest) GetQueryRequest() (o *shared.QueryWorkflowRequest) {
-	if v != nil && v.QueryRequest != nil {
-		return v.QueryRequest
+// MatchingService_CancelOutstandingPoll_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.CancelOutstandingPoll
+// function.
+var MatchingService_CancelOutstandingPoll_Helper = struct {
+	// Args accepts the parameters of CancelOutstandingPoll in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *CancelOutstandingPollRequest,
+	) *MatchingService_CancelOutstandingPoll_Args
+
+	// IsException returns true if the given error can be thrown
+	// by CancelOutstandingPoll.
+	//
+	// An error can be thrown by CancelOutstandingPoll only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for CancelOutstandingPoll
+	// given the error returned by it. The provided error may
+	// be nil if CancelOutstandingPoll did not fail.
+	//
+	// This allows mapping errors returned by CancelOutstandingPoll into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// CancelOutstandingPoll
+	//
+	//   err := CancelOutstandingPoll(args)
+	//   result, err := MatchingService_CancelOutstandingPoll_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from CancelOutstandingPoll: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*MatchingService_CancelOutstandingPoll_Result, error)
+
+	// UnwrapResponse takes the result struct for CancelOutstandingPoll
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if CancelOutstandingPoll threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := MatchingService_CancelOutstandingPoll_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_CancelOutstandingPoll_Result) error
+}{}
+
+func init() {
+	MatchingService_CancelOutstandingPoll_Helper.Args = func(
+		request *CancelOutstandingPollRequest,
+	) *MatchingService_CancelOutstandingPoll_Args {
+		return &MatchingService_CancelOutstandingPoll_Args{
+			Request: request,
+		}
+	}
+
+	MatchingService_CancelOutstandingPoll_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		default:
+			return false
+		}
 	}
 
-	return
-}
+	MatchingService_CancelOutstandingPoll_Helper.WrapResponse = func(err error) (*MatchingService_CancelOutstandingPoll_Result, error) {
+		if err == nil {
+			return &MatchingService_CancelOutstandingPoll_Result{}, nil
+		}
 
-// IsSetQueryRequest returns true if QueryRequest is not nil.
-func (v *QueryWorkflowRequest) IsSetQueryRequest() bool {
-	return v != nil && v.QueryRequest != nil
-}
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.BadRequestError")
+			}
+			return &MatchingService_CancelOutstandingPoll_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.InternalServiceError")
+			}
+			return &MatchingService_CancelOutstandingPoll_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.ServiceBusyError")
+			}
+			return &MatchingService_CancelOutstandingPoll_Result{ServiceBusyError: e}, nil
+		}
 
-// GetForwardedFrom returns the value of ForwardedFrom if it is set or its
-// zero value if it is unset.
-func (v *QueryWorkflowRequest) GetForwardedFrom() (o string) {
-	if v != nil && v.ForwardedFrom != nil {
-		return *v.ForwardedFrom
+		return nil, err
+	}
+	MatchingService_CancelOutstandingPoll_Helper.UnwrapResponse = func(result *MatchingService_CancelOutstandingPoll_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		return
 	}
 
-	return
-}
-
-// IsSetForwardedFrom returns true if ForwardedFrom is not nil.
-func (v *QueryWorkflowRequest) IsSetForwardedFrom() bool {
-	return v != nil && v.ForwardedFrom != nil
 }
 
-type RespondQueryTaskCompletedRequest struct {
-	DomainUUID       *string                                  `json:"domainUUID,omitempty"`
-	TaskList         *shared.TaskList                         `json:"taskList,omitempty"`
-	TaskID           *string                                  `json:"taskID,omitempty"`
-	CompletedRequest *shared.RespondQueryTaskCompletedRequest `json:"completedRequest,omitempty"`
+// MatchingService_CancelOutstandingPoll_Result represents the result of a MatchingService.CancelOutstandingPoll function call.
+//
+// The result of a CancelOutstandingPoll execution is sent and received over the wire as this struct.
+type MatchingService_CancelOutstandingPoll_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a RespondQueryTaskCompletedRequest struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_CancelOutstandingPoll_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3365,61 +6515,51 @@ type RespondQueryTaskCompletedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondQueryTaskCompletedRequest) ToWire() (wire.Value, error) {
+func (v *MatchingService_CancelOutstandingPoll_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.TaskID != nil {
-		w, err = wire.NewValueString(*(v.TaskID)), error(nil)
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
-	if v.CompletedRequest != nil {
-		w, err = v.CompletedRequest.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RespondQueryTaskCompletedRequest_Read(w wire.Value) (*shared.RespondQueryTaskCompletedRequest, error) {
-	var v shared.RespondQueryTaskCompletedRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a RespondQueryTaskCompletedRequest struct from its Thrift-level
+// FromWire deserializes a MatchingService_CancelOutstandingPoll_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondQueryTaskCompletedRequest struct
+// An error is returned if we were unable to build a MatchingService_CancelOutstandingPoll_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3427,47 +6567,35 @@ func _RespondQueryTaskCompletedRequest_Read(w wire.Value) (*shared.RespondQueryT
 //     return nil, err
 //   }
 //
-//   var v RespondQueryTaskCompletedRequest
+//   var v MatchingService_CancelOutstandingPoll_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
+func (v *MatchingService_CancelOutstandingPoll_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
+		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.TaskID = &x
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 40:
+		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.CompletedRequest, err = _RespondQueryTaskCompletedRequest_Read(field.Value)
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3476,338 +6604,157 @@ func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
 		}
 	}
 
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", count)
+	}
+
 	return nil
 }
 
-// String returns a readable string representation of a RespondQueryTaskCompletedRequest
+// String returns a readable string representation of a MatchingService_CancelOutstandingPoll_Result
 // struct.
-func (v *RespondQueryTaskCompletedRequest) String() string {
+func (v *MatchingService_CancelOutstandingPoll_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [3]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
-		i++
-	}
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
-	if v.TaskID != nil {
-		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.CompletedRequest != nil {
-		fields[i] = fmt.Sprintf("CompletedRequest: %v", v.CompletedRequest)
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("RespondQueryTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_CancelOutstandingPoll_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondQueryTaskCompletedRequest match the
-// provided RespondQueryTaskCompletedRequest.
+// Equals returns true if all the fields of this MatchingService_CancelOutstandingPoll_Result match the
+// provided MatchingService_CancelOutstandingPoll_Result.
 //
 // This function performs a deep comparison.
-func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompletedRequest) bool {
+func (v *MatchingService_CancelOutstandingPoll_Result) Equals(rhs *MatchingService_CancelOutstandingPoll_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
-		return false
-	}
-	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.TaskID, rhs.TaskID) {
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.CompletedRequest == nil && rhs.CompletedRequest == nil) || (v.CompletedRequest != nil && rhs.CompletedRequest != nil && v.CompletedRequest.Equals(rhs.CompletedRequest))) {
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-
-	return true
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondQueryTaskCompletedRequest.
-func (v *RespondQueryTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	if v.DomainUUID != nil {
-		enc.AddString("domainUUID", *v.DomainUUID)
-	}
-	if v.TaskList != nil {
-		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
-	}
-	if v.TaskID != nil {
-		enc.AddString("taskID", *v.TaskID)
-	}
-	if v.CompletedRequest != nil {
-		err = multierr.Append(err, enc.AddObject("completedRequest", v.CompletedRequest))
-	}
-	return err
-}
-
-// GetDomainUUID returns the value of DomainUUID if it is set or its
-// zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetDomainUUID() (o string) {
-	if v != nil && v.DomainUUID != nil {
-		return *v.DomainUUID
-	}
-
-	return
-}
-
-// IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetDomainUUID() bool {
-	return v != nil && v.DomainUUID != nil
-}
-
-// GetTaskList returns the value of TaskList if it is set or its
-// zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetTaskList() (o *shared.TaskList) {
-	if v != nil && v.TaskList != nil {
-		return v.TaskList
-	}
-
-	return
-}
-
-// IsSetTaskList returns true if TaskList is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetTaskList() bool {
-	return v != nil && v.TaskList != nil
-}
-
-// GetTaskID returns the value of TaskID if it is set or its
-// zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetTaskID() (o string) {
-	if v != nil && v.TaskID != nil {
-		return *v.TaskID
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
 	}
 
-	return
+	return true
 }
 
-// IsSetTaskID returns true if TaskID is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetTaskID() bool {
-	return v != nil && v.TaskID != nil
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MatchingService_CancelOutstandingPoll_Result.
+func (v *MatchingService_CancelOutstandingPoll_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	return err
 }
 
-// GetCompletedRequest returns the value of CompletedRequest if it is set or its
+// GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetCompletedRequest() (o *shared.RespondQueryTaskCompletedRequest) {
-	if v != nil && v.CompletedRequest != nil {
-		return v.CompletedRequest
+func (v *MatchingService_CancelOutstandingPoll_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
 	}
 
 	return
 }
 
-// IsSetCompletedRequest returns true if CompletedRequest is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetCompletedRequest() bool {
-	return v != nil && v.CompletedRequest != nil
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *MatchingService_CancelOutstandingPoll_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
 }
 
-type TaskSource int32
-
-const (
-	TaskSourceHistory   TaskSource = 0
-	TaskSourceDbBacklog TaskSource = 1
-)
-
-// TaskSource_Values returns all recognized values of TaskSource.
-func TaskSource_Values() []TaskSource {
-	return []TaskSource{
-		TaskSourceHistory,
-		TaskSourceDbBacklog,
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_CancelOutstandingPoll_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
 	}
-}
 
-// UnmarshalText tries to decode TaskSource from a byte slice
-// containing its name.
-//
-//   var v TaskSource
-//   err := v.UnmarshalText([]byte("HISTORY"))
-func (v *TaskSource) UnmarshalText(value []byte) error {
-	switch s := string(value); s {
-	case "HISTORY":
-		*v = TaskSourceHistory
-		return nil
-	case "DB_BACKLOG":
-		*v = TaskSourceDbBacklog
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "TaskSource", err)
-		}
-		*v = TaskSource(val)
-		return nil
-	}
+	return
 }
 
-// MarshalText encodes TaskSource to text.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
-//
-// This implements the TextMarshaler interface.
-func (v TaskSource) MarshalText() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return []byte("HISTORY"), nil
-	case 1:
-		return []byte("DB_BACKLOG"), nil
-	}
-	return []byte(strconv.FormatInt(int64(v), 10)), nil
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *MatchingService_CancelOutstandingPoll_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of TaskSource.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v TaskSource) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 0:
-		enc.AddString("name", "HISTORY")
-	case 1:
-		enc.AddString("name", "DB_BACKLOG")
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_CancelOutstandingPoll_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
 	}
-	return nil
-}
-
-// Ptr returns a pointer to this enum value.
-func (v TaskSource) Ptr() *TaskSource {
-	return &v
-}
-
-// ToWire translates TaskSource into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// Enums are represented as 32-bit integers over the wire.
-func (v TaskSource) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
-}
-
-// FromWire deserializes TaskSource from its Thrift-level
-// representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
-//   if err != nil {
-//     return TaskSource(0), err
-//   }
-//
-//   var v TaskSource
-//   if err := v.FromWire(x); err != nil {
-//     return TaskSource(0), err
-//   }
-//   return v, nil
-func (v *TaskSource) FromWire(w wire.Value) error {
-	*v = (TaskSource)(w.GetI32())
-	return nil
-}
 
-// String returns a readable string representation of TaskSource.
-func (v TaskSource) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "HISTORY"
-	case 1:
-		return "DB_BACKLOG"
-	}
-	return fmt.Sprintf("TaskSource(%d)", w)
+	return
 }
 
-// Equals returns true if this TaskSource value matches the provided
-// value.
-func (v TaskSource) Equals(rhs TaskSource) bool {
-	return v == rhs
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *MatchingService_CancelOutstandingPoll_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
 }
 
-// MarshalJSON serializes TaskSource into JSON.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
 //
-// This implements json.Marshaler.
-func (v TaskSource) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"HISTORY\""), nil
-	case 1:
-		return ([]byte)("\"DB_BACKLOG\""), nil
-	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+// This will always be "CancelOutstandingPoll" for this struct.
+func (v *MatchingService_CancelOutstandingPoll_Result) MethodName() string {
+	return "CancelOutstandingPoll"
 }
 
-// UnmarshalJSON attempts to decode TaskSource from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
+// EnvelopeType returns the kind of value inside this struct.
 //
-// This implements json.Unmarshaler.
-func (v *TaskSource) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
-	}
-
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "TaskSource")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "TaskSource")
-		}
-		*v = (TaskSource)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "TaskSource")
-	}
-}
-
-// ThriftModule represents the IDL file used to generate this package.
-var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "matching",
-	Package:  "github.com/uber/cadence/.gen/go/matching",
-	FilePath: "matching.thrift",
-	SHA1:     "dfeeaa9142345924cb1a3a7e18106dc72465efdf",
-	Includes: []*thriftreflect.ThriftModule{
-		shared.ThriftModule,
-	},
-	Raw: rawIDL,
+// This will always be Reply for this struct.
+func (v *MatchingService_CancelOutstandingPoll_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\ninclude \"shared.thrift\"\n\nnamespace java com.uber.cadence.matching\n\n// TaskSource is the source from which a task was produced\nenum TaskSource {\n    HISTORY,    // Task produced by history service\n    DB_BACKLOG // Task produced from matching db backlog\n}\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domainUUID\n  15: optional string pollerID\n  20: optional shared.PollForDecisionTaskRequest pollRequest\n  30: optional string forwardedFrom\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional shared.WorkflowExecution workflowExecution\n  30: optional shared.WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = \"Long\") attempt\n  60: optional i64 (js.type = \"Long\") nextEventId\n  65: optional i64 (js.type = \"Long\") backlogCountHint\n  70: optional bool stickyExecutionEnabled\n  80: optional shared.WorkflowQuery query\n  90: optional shared.TransientDecisionInfo decisionInfo\n  100: optional shared.TaskList WorkflowExecutionTaskList\n  110: optional i32 eventStoreVersion\n  120: optional binary branchToken\n  130: optional i64 (js.type = \"Long\") scheduledTimestamp\n  140: optional i64 (js.type = \"Long\") startedTimestamp\n  150: optional map<string, shared.WorkflowQuery> queries\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domainUUID\n  15: optional string pollerID\n  20: optional shared.PollForActivityTaskRequest pollRequest\n  30: optional string forwardedFrom\n}\n\nstruct AddDecisionTaskRequest {\n  10: optional string domainUUID\n  20: optional shared.WorkflowExecution execution\n  30: optional shared.TaskList taskList\n  40: optional i64 (js.type = \"Long\") scheduleId\n  50: optional i32 scheduleToStartTimeoutSeconds\n  59: optional TaskSource source\n  60: optional string forwardedFrom\n}\n\nstruct AddActivityTaskRequest {\n  10: optional string domainUUID\n  20: optional shared.WorkflowExecution execution\n  30: optional string sourceDomainUUID\n  40: optional shared.TaskList taskList\n  50: optional i64 (js.type = \"Long\") scheduleId\n  60: optional i32 scheduleToStartTimeoutSeconds\n  69: optional TaskSource source\n  70: optional string forwardedFrom\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional shared.QueryWorkflowRequest queryRequest\n  40: optional string forwardedFrom\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional string taskID\n  40: optional shared.RespondQueryTaskCompletedRequest completedRequest\n}\n\nstruct CancelOutstandingPollRequest {\n  10: optional string domainUUID\n  20: optional i32 taskListType\n  30: optional shared.TaskList taskList\n  40: optional string pollerID\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domainUUID\n  20: optional shared.DescribeTaskListRequest descRequest\n}\n\nstruct ListTaskListPartitionsRequest {\n  10: optional string domain\n  20: optional shared.TaskList taskList\n}\n\n/**\n* MatchingService API is exposed to provide support for polling from long running applications.\n* Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each\n* DecisionTask, application is expected to process the history of events for that session and respond back with next\n* decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back\n* with completion or failure.\n**/\nservice MatchingService {\n  /**\n  * PollForDecisionTask is called by frontend to process DecisionTask from a specific taskList.  A\n  * DecisionTask is dispatched to callers for active workflow executions, with pending decisions.\n  **/\n  PollForDecisionTaskResponse PollForDecisionTask(1: PollForDecisionTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * PollForActivityTask is called by frontend to process ActivityTask from a specific taskList.  ActivityTask\n  * is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.\n  **/\n  shared.PollForActivityTaskResponse PollForActivityTask(1: PollForActivityTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * AddDecisionTask is called by the history service when a decision task is scheduled, so that it can be dispatched\n  * by the MatchingEngine.\n  **/\n  void AddDecisionTask(1: AddDecisionTaskRequest addRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.RemoteSyncMatchedError remoteSyncMatchedError,\n    )\n\n  /**\n  * AddActivityTask is called by the history service when a decision task is scheduled, so that it can be dispatched\n  * by the MatchingEngine.\n  **/\n  void AddActivityTask(1: AddActivityTaskRequest addRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.RemoteSyncMatchedError remoteSyncMatchedError,\n    )\n\n  /**\n  * QueryWorkflow is called by frontend to query a workflow.\n  **/\n  shared.QueryWorkflowResponse QueryWorkflow(1: QueryWorkflowRequest queryRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.QueryFailedError queryFailedError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * RespondQueryTaskCompleted is called by frontend to respond query completed.\n  **/\n  void RespondQueryTaskCompleted(1: RespondQueryTaskCompletedRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n    * CancelOutstandingPoll is called by frontend to unblock long polls on matching for zombie pollers.\n    * Our rpc stack does not support context propagation, so when a client connection goes away frontend sees\n    * cancellation of context for that handler, but any corresponding calls (long-poll) to matching service does not\n    * see the cancellation propagated so it can unblock corresponding long-polls on its end.  This results is tasks\n    * being dispatched to zombie pollers in this situation.  This API is added so everytime frontend makes a long-poll\n    * api call to matching it passes in a pollerID and then calls this API when it detects client connection is closed\n    * to unblock long polls for this poller and prevent tasks being sent to these zombie pollers.\n    **/\n  void CancelOutstandingPoll(1: CancelOutstandingPollRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * DescribeTaskList returns information about the target tasklist, right now this API returns the\n  * pollers which polled this tasklist in last few minutes.\n  **/\n  shared.DescribeTaskListResponse DescribeTaskList(1: DescribeTaskListRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        3: shared.EntityNotExistsError entityNotExistError,\n        4: shared.ServiceBusyError serviceBusyError,\n      )\n\n  /**\n  * GetTaskListsByDomain returns the list of all the task lists for a domainName.\n  **/\n  shared.GetTaskListsByDomainResponse GetTaskListsByDomain(1: shared.GetTaskListsByDomainRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        3: shared.EntityNotExistsError entityNotExistError,\n        4: shared.ServiceBusyError serviceBusyError,\n      )\n\n  /**\n  * ListTaskListPartitions returns a map of partitionKey and hostAddress for a taskList\n  **/\n  shared.ListTaskListPartitionsResponse ListTaskListPartitions(1: ListTaskListPartitionsRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        4: shared.ServiceBusyError serviceBusyError,\n    )\n}\n"
-
-// MatchingService_AddActivityTask_Args represents the arguments for the MatchingService.AddActivityTask function.
+// MatchingService_DescribeTaskList_Args represents the arguments for the MatchingService.DescribeTaskList function.
 //
-// The arguments for AddActivityTask are sent and received over the wire as this struct.
-type MatchingService_AddActivityTask_Args struct {
-	AddRequest *AddActivityTaskRequest `json:"addRequest,omitempty"`
+// The arguments for DescribeTaskList are sent and received over the wire as this struct.
+type MatchingService_DescribeTaskList_Args struct {
+	Request *DescribeTaskListRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a MatchingService_AddActivityTask_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_DescribeTaskList_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3822,7 +6769,7 @@ type MatchingService_AddActivityTask_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_AddActivityTask_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_DescribeTaskList_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -3830,8 +6777,8 @@ func (v *MatchingService_AddActivityTask_Args) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.AddRequest != nil {
-		w, err = v.AddRequest.ToWire()
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -3842,17 +6789,17 @@ func (v *MatchingService_AddActivityTask_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _AddActivityTaskRequest_Read(w wire.Value) (*AddActivityTaskRequest, error) {
-	var v AddActivityTaskRequest
+func _DescribeTaskListRequest_1_Read(w wire.Value) (*DescribeTaskListRequest, error) {
+	var v DescribeTaskListRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_AddActivityTask_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_DescribeTaskList_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_AddActivityTask_Args struct
+// An error is returned if we were unable to build a MatchingService_DescribeTaskList_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3860,19 +6807,19 @@ func _AddActivityTaskRequest_Read(w wire.Value) (*AddActivityTaskRequest, error)
 //     return nil, err
 //   }
 //
-//   var v MatchingService_AddActivityTask_Args
+//   var v MatchingService_DescribeTaskList_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_AddActivityTask_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_DescribeTaskList_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.AddRequest, err = _AddActivityTaskRequest_Read(field.Value)
+				v.Request, err = _DescribeTaskListRequest_1_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3884,34 +6831,34 @@ func (v *MatchingService_AddActivityTask_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_AddActivityTask_Args
+// String returns a readable string representation of a MatchingService_DescribeTaskList_Args
 // struct.
-func (v *MatchingService_AddActivityTask_Args) String() string {
+func (v *MatchingService_DescribeTaskList_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.AddRequest != nil {
-		fields[i] = fmt.Sprintf("AddRequest: %v", v.AddRequest)
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_AddActivityTask_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_DescribeTaskList_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_AddActivityTask_Args match the
-// provided MatchingService_AddActivityTask_Args.
+// Equals returns true if all the fields of this MatchingService_DescribeTaskList_Args match the
+// provided MatchingService_DescribeTaskList_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_AddActivityTask_Args) Equals(rhs *MatchingService_AddActivityTask_Args) bool {
+func (v *MatchingService_DescribeTaskList_Args) Equals(rhs *MatchingService_DescribeTaskList_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.AddRequest == nil && rhs.AddRequest == nil) || (v.AddRequest != nil && rhs.AddRequest != nil && v.AddRequest.Equals(rhs.AddRequest))) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -3919,162 +6866,147 @@ func (v *MatchingService_AddActivityTask_Args) Equals(rhs *MatchingService_AddAc
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_AddActivityTask_Args.
-func (v *MatchingService_AddActivityTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_DescribeTaskList_Args.
+func (v *MatchingService_DescribeTaskList_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.AddRequest != nil {
-		err = multierr.Append(err, enc.AddObject("addRequest", v.AddRequest))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetAddRequest returns the value of AddRequest if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Args) GetAddRequest() (o *AddActivityTaskRequest) {
-	if v != nil && v.AddRequest != nil {
-		return v.AddRequest
+func (v *MatchingService_DescribeTaskList_Args) GetRequest() (o *DescribeTaskListRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetAddRequest returns true if AddRequest is not nil.
-func (v *MatchingService_AddActivityTask_Args) IsSetAddRequest() bool {
-	return v != nil && v.AddRequest != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *MatchingService_DescribeTaskList_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "AddActivityTask" for this struct.
-func (v *MatchingService_AddActivityTask_Args) MethodName() string {
-	return "AddActivityTask"
+// This will always be "DescribeTaskList" for this struct.
+func (v *MatchingService_DescribeTaskList_Args) MethodName() string {
+	return "DescribeTaskList"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_AddActivityTask_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_DescribeTaskList_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_AddActivityTask_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.AddActivityTask
+// MatchingService_DescribeTaskList_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.DescribeTaskList
 // function.
-var MatchingService_AddActivityTask_Helper = struct {
-	// Args accepts the parameters of AddActivityTask in-order and returns
+var MatchingService_DescribeTaskList_Helper = struct {
+	// Args accepts the parameters of DescribeTaskList in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		addRequest *AddActivityTaskRequest,
-	) *MatchingService_AddActivityTask_Args
+		request *DescribeTaskListRequest,
+	) *MatchingService_DescribeTaskList_Args
 
 	// IsException returns true if the given error can be thrown
-	// by AddActivityTask.
+	// by DescribeTaskList.
 	//
-	// An error can be thrown by AddActivityTask only if the
+	// An error can be thrown by DescribeTaskList only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for AddActivityTask
-	// given the error returned by it. The provided error may
-	// be nil if AddActivityTask did not fail.
+	// WrapResponse returns the result struct for DescribeTaskList
+	// given its return value and error.
 	//
-	// This allows mapping errors returned by AddActivityTask into a
-	// serializable result struct. WrapResponse returns a
-	// non-nil error if the provided error cannot be thrown by
-	// AddActivityTask
+	// This allows mapping values and errors returned by
+	// DescribeTaskList into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeTaskList
 	//
-	//   err := AddActivityTask(args)
-	//   result, err := MatchingService_AddActivityTask_Helper.WrapResponse(err)
+	//   value, err := DescribeTaskList(args)
+	//   result, err := MatchingService_DescribeTaskList_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from AddActivityTask: %v", err)
+	//     return fmt.Errorf("unexpected error from DescribeTaskList: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*MatchingService_AddActivityTask_Result, error)
+	WrapResponse func(*shared.DescribeTaskListResponse, error) (*MatchingService_DescribeTaskList_Result, error)
 
-	// UnwrapResponse takes the result struct for AddActivityTask
-	// and returns the erorr returned by it (if any).
+	// UnwrapResponse takes the result struct for DescribeTaskList
+	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if AddActivityTask threw an
+	// The error is non-nil only if DescribeTaskList threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := MatchingService_AddActivityTask_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_AddActivityTask_Result) error
+	//   value, err := MatchingService_DescribeTaskList_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_DescribeTaskList_Result) (*shared.DescribeTaskListResponse, error)
 }{}
 
 func init() {
-	MatchingService_AddActivityTask_Helper.Args = func(
-		addRequest *AddActivityTaskRequest,
-	) *MatchingService_AddActivityTask_Args {
-		return &MatchingService_AddActivityTask_Args{
-			AddRequest: addRequest,
+	MatchingService_DescribeTaskList_Helper.Args = func(
+		request *DescribeTaskListRequest,
+	) *MatchingService_DescribeTaskList_Args {
+		return &MatchingService_DescribeTaskList_Args{
+			Request: request,
 		}
 	}
 
-	MatchingService_AddActivityTask_Helper.IsException = func(err error) bool {
+	MatchingService_DescribeTaskList_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.ServiceBusyError:
-			return true
-		case *shared.LimitExceededError:
-			return true
-		case *shared.DomainNotActiveError:
+		case *shared.EntityNotExistsError:
 			return true
-		case *shared.RemoteSyncMatchedError:
+		case *shared.ServiceBusyError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	MatchingService_AddActivityTask_Helper.WrapResponse = func(err error) (*MatchingService_AddActivityTask_Result, error) {
+	MatchingService_DescribeTaskList_Helper.WrapResponse = func(success *shared.DescribeTaskListResponse, err error) (*MatchingService_DescribeTaskList_Result, error) {
 		if err == nil {
-			return &MatchingService_AddActivityTask_Result{}, nil
+			return &MatchingService_DescribeTaskList_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.BadRequestError")
 			}
-			return &MatchingService_AddActivityTask_Result{BadRequestError: e}, nil
+			return &MatchingService_DescribeTaskList_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.InternalServiceError")
-			}
-			return &MatchingService_AddActivityTask_Result{InternalServiceError: e}, nil
-		case *shared.ServiceBusyError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.ServiceBusyError")
-			}
-			return &MatchingService_AddActivityTask_Result{ServiceBusyError: e}, nil
-		case *shared.LimitExceededError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.InternalServiceError")
 			}
-			return &MatchingService_AddActivityTask_Result{LimitExceededError: e}, nil
-		case *shared.DomainNotActiveError:
+			return &MatchingService_DescribeTaskList_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.DomainNotActiveError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.EntityNotExistError")
 			}
-			return &MatchingService_AddActivityTask_Result{DomainNotActiveError: e}, nil
-		case *shared.RemoteSyncMatchedError:
+			return &MatchingService_DescribeTaskList_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.RemoteSyncMatchedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.ServiceBusyError")
 			}
-			return &MatchingService_AddActivityTask_Result{RemoteSyncMatchedError: e}, nil
+			return &MatchingService_DescribeTaskList_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_AddActivityTask_Helper.UnwrapResponse = func(result *MatchingService_AddActivityTask_Result) (err error) {
+	MatchingService_DescribeTaskList_Helper.UnwrapResponse = func(result *MatchingService_DescribeTaskList_Result) (success *shared.DescribeTaskListResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -4083,40 +7015,41 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.ServiceBusyError != nil {
-			err = result.ServiceBusyError
-			return
-		}
-		if result.LimitExceededError != nil {
-			err = result.LimitExceededError
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
 			return
 		}
-		if result.DomainNotActiveError != nil {
-			err = result.DomainNotActiveError
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
 			return
 		}
-		if result.RemoteSyncMatchedError != nil {
-			err = result.RemoteSyncMatchedError
+
+		if result.Success != nil {
+			success = result.Success
 			return
 		}
+
+		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// MatchingService_AddActivityTask_Result represents the result of a MatchingService.AddActivityTask function call.
+// MatchingService_DescribeTaskList_Result represents the result of a MatchingService.DescribeTaskList function call.
 //
-// The result of a AddActivityTask execution is sent and received over the wire as this struct.
-type MatchingService_AddActivityTask_Result struct {
-	BadRequestError        *shared.BadRequestError        `json:"badRequestError,omitempty"`
-	InternalServiceError   *shared.InternalServiceError   `json:"internalServiceError,omitempty"`
-	ServiceBusyError       *shared.ServiceBusyError       `json:"serviceBusyError,omitempty"`
-	LimitExceededError     *shared.LimitExceededError     `json:"limitExceededError,omitempty"`
-	DomainNotActiveError   *shared.DomainNotActiveError   `json:"domainNotActiveError,omitempty"`
-	RemoteSyncMatchedError *shared.RemoteSyncMatchedError `json:"remoteSyncMatchedError,omitempty"`
+// The result of a DescribeTaskList execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type MatchingService_DescribeTaskList_Result struct {
+	// Value returned by DescribeTaskList after a successful execution.
+	Success              *shared.DescribeTaskListResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError          `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError     `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError     `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError         `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_AddActivityTask_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_DescribeTaskList_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4131,14 +7064,22 @@ type MatchingService_AddActivityTask_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_AddActivityTask_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_DescribeTaskList_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -4155,87 +7096,47 @@ func (v *MatchingService_AddActivityTask_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		w, err = v.ServiceBusyError.ToWire()
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
-	if v.LimitExceededError != nil {
-		w, err = v.LimitExceededError.ToWire()
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
-	}
-	if v.DomainNotActiveError != nil {
-		w, err = v.DomainNotActiveError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
-	if v.RemoteSyncMatchedError != nil {
-		w, err = v.RemoteSyncMatchedError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 6, Value: w}
-		i++
-	}
-
-	if i > 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", i)
-	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _BadRequestError_Read(w wire.Value) (*shared.BadRequestError, error) {
-	var v shared.BadRequestError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _InternalServiceError_Read(w wire.Value) (*shared.InternalServiceError, error) {
-	var v shared.InternalServiceError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
-	var v shared.ServiceBusyError
-	err := v.FromWire(w)
-	return &v, err
-}
+	}
 
-func _LimitExceededError_Read(w wire.Value) (*shared.LimitExceededError, error) {
-	var v shared.LimitExceededError
-	err := v.FromWire(w)
-	return &v, err
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DomainNotActiveError_Read(w wire.Value) (*shared.DomainNotActiveError, error) {
-	var v shared.DomainNotActiveError
+func _DescribeTaskListResponse_Read(w wire.Value) (*shared.DescribeTaskListResponse, error) {
+	var v shared.DescribeTaskListResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-func _RemoteSyncMatchedError_Read(w wire.Value) (*shared.RemoteSyncMatchedError, error) {
-	var v shared.RemoteSyncMatchedError
+func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, error) {
+	var v shared.EntityNotExistsError
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_AddActivityTask_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_DescribeTaskList_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_AddActivityTask_Result struct
+// An error is returned if we were unable to build a MatchingService_DescribeTaskList_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4243,59 +7144,51 @@ func _RemoteSyncMatchedError_Read(w wire.Value) (*shared.RemoteSyncMatchedError,
 //     return nil, err
 //   }
 //
-//   var v MatchingService_AddActivityTask_Result
+//   var v MatchingService_DescribeTaskList_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_AddActivityTask_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_DescribeTaskList_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TStruct {
-				v.BadRequestError, err = _BadRequestError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 2:
+		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				v.Success, err = _DescribeTaskListResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 3:
+		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 4:
+		case 2:
 			if field.Value.Type() == wire.TStruct {
-				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 5:
+		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 6:
+		case 4:
 			if field.Value.Type() == wire.TStruct {
-				v.RemoteSyncMatchedError, err = _RemoteSyncMatchedError_Read(field.Value)
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -4305,40 +7198,41 @@ func (v *MatchingService_AddActivityTask_Result) FromWire(w wire.Value) error {
 	}
 
 	count := 0
-	if v.BadRequestError != nil {
-		count++
-	}
-	if v.InternalServiceError != nil {
+	if v.Success != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.BadRequestError != nil {
 		count++
 	}
-	if v.LimitExceededError != nil {
+	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.DomainNotActiveError != nil {
+	if v.EntityNotExistError != nil {
 		count++
 	}
-	if v.RemoteSyncMatchedError != nil {
+	if v.ServiceBusyError != nil {
 		count++
 	}
-	if count > 1 {
-		return fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_AddActivityTask_Result
+// String returns a readable string representation of a MatchingService_DescribeTaskList_Result
 // struct.
-func (v *MatchingService_AddActivityTask_Result) String() string {
+func (v *MatchingService_DescribeTaskList_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [5]string
 	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -4347,52 +7241,41 @@ func (v *MatchingService_AddActivityTask_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
-		i++
-	}
-	if v.LimitExceededError != nil {
-		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
-		i++
-	}
-	if v.DomainNotActiveError != nil {
-		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
 		i++
 	}
-	if v.RemoteSyncMatchedError != nil {
-		fields[i] = fmt.Sprintf("RemoteSyncMatchedError: %v", v.RemoteSyncMatchedError)
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_AddActivityTask_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_DescribeTaskList_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_AddActivityTask_Result match the
-// provided MatchingService_AddActivityTask_Result.
+// Equals returns true if all the fields of this MatchingService_DescribeTaskList_Result match the
+// provided MatchingService_DescribeTaskList_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_AddActivityTask_Result) Equals(rhs *MatchingService_AddActivityTask_Result) bool {
+func (v *MatchingService_DescribeTaskList_Result) Equals(rhs *MatchingService_DescribeTaskList_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
-		return false
-	}
-	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
 		return false
 	}
-	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
 		return false
 	}
-	if !((v.RemoteSyncMatchedError == nil && rhs.RemoteSyncMatchedError == nil) || (v.RemoteSyncMatchedError != nil && rhs.RemoteSyncMatchedError != nil && v.RemoteSyncMatchedError.Equals(rhs.RemoteSyncMatchedError))) {
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
 
@@ -4400,35 +7283,47 @@ func (v *MatchingService_AddActivityTask_Result) Equals(rhs *MatchingService_Add
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_AddActivityTask_Result.
-func (v *MatchingService_AddActivityTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_DescribeTaskList_Result.
+func (v *MatchingService_DescribeTaskList_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
-	if v.LimitExceededError != nil {
-		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
-	}
-	if v.DomainNotActiveError != nil {
-		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
-	}
-	if v.RemoteSyncMatchedError != nil {
-		err = multierr.Append(err, enc.AddObject("remoteSyncMatchedError", v.RemoteSyncMatchedError))
-	}
 	return err
 }
 
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_DescribeTaskList_Result) GetSuccess() (o *shared.DescribeTaskListResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *MatchingService_DescribeTaskList_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_DescribeTaskList_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -4437,13 +7332,13 @@ func (v *MatchingService_AddActivityTask_Result) GetBadRequestError() (o *shared
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_DescribeTaskList_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_DescribeTaskList_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -4452,93 +7347,63 @@ func (v *MatchingService_AddActivityTask_Result) GetInternalServiceError() (o *s
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_DescribeTaskList_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
-	if v != nil && v.ServiceBusyError != nil {
-		return v.ServiceBusyError
-	}
-
-	return
-}
-
-// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetServiceBusyError() bool {
-	return v != nil && v.ServiceBusyError != nil
-}
-
-// GetLimitExceededError returns the value of LimitExceededError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
-	if v != nil && v.LimitExceededError != nil {
-		return v.LimitExceededError
-	}
-
-	return
-}
-
-// IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetLimitExceededError() bool {
-	return v != nil && v.LimitExceededError != nil
-}
-
-// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
-	if v != nil && v.DomainNotActiveError != nil {
-		return v.DomainNotActiveError
+func (v *MatchingService_DescribeTaskList_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
 	}
 
 	return
 }
 
-// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetDomainNotActiveError() bool {
-	return v != nil && v.DomainNotActiveError != nil
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *MatchingService_DescribeTaskList_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
 }
 
-// GetRemoteSyncMatchedError returns the value of RemoteSyncMatchedError if it is set or its
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetRemoteSyncMatchedError() (o *shared.RemoteSyncMatchedError) {
-	if v != nil && v.RemoteSyncMatchedError != nil {
-		return v.RemoteSyncMatchedError
+func (v *MatchingService_DescribeTaskList_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
 	}
 
 	return
 }
 
-// IsSetRemoteSyncMatchedError returns true if RemoteSyncMatchedError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetRemoteSyncMatchedError() bool {
-	return v != nil && v.RemoteSyncMatchedError != nil
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *MatchingService_DescribeTaskList_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "AddActivityTask" for this struct.
-func (v *MatchingService_AddActivityTask_Result) MethodName() string {
-	return "AddActivityTask"
+// This will always be "DescribeTaskList" for this struct.
+func (v *MatchingService_DescribeTaskList_Result) MethodName() string {
+	return "DescribeTaskList"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_AddActivityTask_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_DescribeTaskList_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_AddDecisionTask_Args represents the arguments for the MatchingService.AddDecisionTask function.
+// MatchingService_GetTaskListsByDomain_Args represents the arguments for the MatchingService.GetTaskListsByDomain function.
 //
-// The arguments for AddDecisionTask are sent and received over the wire as this struct.
-type MatchingService_AddDecisionTask_Args struct {
-	AddRequest *AddDecisionTaskRequest `json:"addRequest,omitempty"`
+// The arguments for GetTaskListsByDomain are sent and received over the wire as this struct.
+type MatchingService_GetTaskListsByDomain_Args struct {
+	Request *shared.GetTaskListsByDomainRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a MatchingService_AddDecisionTask_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_GetTaskListsByDomain_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4553,7 +7418,7 @@ type MatchingService_AddDecisionTask_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_AddDecisionTask_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_GetTaskListsByDomain_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -4561,8 +7426,8 @@ func (v *MatchingService_AddDecisionTask_Args) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.AddRequest != nil {
-		w, err = v.AddRequest.ToWire()
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -4573,17 +7438,17 @@ func (v *MatchingService_AddDecisionTask_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _AddDecisionTaskRequest_Read(w wire.Value) (*AddDecisionTaskRequest, error) {
-	var v AddDecisionTaskRequest
+func _GetTaskListsByDomainRequest_Read(w wire.Value) (*shared.GetTaskListsByDomainRequest, error) {
+	var v shared.GetTaskListsByDomainRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_AddDecisionTask_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_GetTaskListsByDomain_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_AddDecisionTask_Args struct
+// An error is returned if we were unable to build a MatchingService_GetTaskListsByDomain_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4591,19 +7456,19 @@ func _AddDecisionTaskRequest_Read(w wire.Value) (*AddDecisionTaskRequest, error)
 //     return nil, err
 //   }
 //
-//   var v MatchingService_AddDecisionTask_Args
+//   var v MatchingService_GetTaskListsByDomain_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_AddDecisionTask_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_GetTaskListsByDomain_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.AddRequest, err = _AddDecisionTaskRequest_Read(field.Value)
+				v.Request, err = _GetTaskListsByDomainRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -4615,34 +7480,34 @@ func (v *MatchingService_AddDecisionTask_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_AddDecisionTask_Args
+// String returns a readable string representation of a MatchingService_GetTaskListsByDomain_Args
 // struct.
-func (v *MatchingService_AddDecisionTask_Args) String() string {
+func (v *MatchingService_GetTaskListsByDomain_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.AddRequest != nil {
-		fields[i] = fmt.Sprintf("AddRequest: %v", v.AddRequest)
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_AddDecisionTask_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_GetTaskListsByDomain_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_AddDecisionTask_Args match the
-// provided MatchingService_AddDecisionTask_Args.
+// Equals returns true if all the fields of this MatchingService_GetTaskListsByDomain_Args match the
+// provided MatchingService_GetTaskListsByDomain_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_AddDecisionTask_Args) Equals(rhs *MatchingService_AddDecisionTask_Args) bool {
+func (v *MatchingService_GetTaskListsByDomain_Args) Equals(rhs *MatchingService_GetTaskListsByDomain_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.AddRequest == nil && rhs.AddRequest == nil) || (v.AddRequest != nil && rhs.AddRequest != nil && v.AddRequest.Equals(rhs.AddRequest))) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -4650,162 +7515,147 @@ func (v *MatchingService_AddDecisionTask_Args) Equals(rhs *MatchingService_AddDe
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_AddDecisionTask_Args.
-func (v *MatchingService_AddDecisionTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_GetTaskListsByDomain_Args.
+func (v *MatchingService_GetTaskListsByDomain_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.AddRequest != nil {
-		err = multierr.Append(err, enc.AddObject("addRequest", v.AddRequest))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetAddRequest returns the value of AddRequest if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Args) GetAddRequest() (o *AddDecisionTaskRequest) {
-	if v != nil && v.AddRequest != nil {
-		return v.AddRequest
+func (v *MatchingService_GetTaskListsByDomain_Args) GetRequest() (o *shared.GetTaskListsByDomainRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetAddRequest returns true if AddRequest is not nil.
-func (v *MatchingService_AddDecisionTask_Args) IsSetAddRequest() bool {
-	return v != nil && v.AddRequest != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *MatchingService_GetTaskListsByDomain_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "AddDecisionTask" for this struct.
-func (v *MatchingService_AddDecisionTask_Args) MethodName() string {
-	return "AddDecisionTask"
+// This will always be "GetTaskListsByDomain" for this struct.
+func (v *MatchingService_GetTaskListsByDomain_Args) MethodName() string {
+	return "GetTaskListsByDomain"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_AddDecisionTask_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_GetTaskListsByDomain_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_AddDecisionTask_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.AddDecisionTask
+// MatchingService_GetTaskListsByDomain_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.GetTaskListsByDomain
 // function.
-var MatchingService_AddDecisionTask_Helper = struct {
-	// Args accepts the parameters of AddDecisionTask in-order and returns
+var MatchingService_GetTaskListsByDomain_Helper = struct {
+	// Args accepts the parameters of GetTaskListsByDomain in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		addRequest *AddDecisionTaskRequest,
-	) *MatchingService_AddDecisionTask_Args
+		request *shared.GetTaskListsByDomainRequest,
+	) *MatchingService_GetTaskListsByDomain_Args
 
 	// IsException returns true if the given error can be thrown
-	// by AddDecisionTask.
+	// by GetTaskListsByDomain.
 	//
-	// An error can be thrown by AddDecisionTask only if the
+	// An error can be thrown by GetTaskListsByDomain only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for AddDecisionTask
-	// given the error returned by it. The provided error may
-	// be nil if AddDecisionTask did not fail.
+	// WrapResponse returns the result struct for GetTaskListsByDomain
+	// given its return value and error.
 	//
-	// This allows mapping errors returned by AddDecisionTask into a
-	// serializable result struct. WrapResponse returns a
-	// non-nil error if the provided error cannot be thrown by
-	// AddDecisionTask
+	// This allows mapping values and errors returned by
+	// GetTaskListsByDomain into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by GetTaskListsByDomain
 	//
-	//   err := AddDecisionTask(args)
-	//   result, err := MatchingService_AddDecisionTask_Helper.WrapResponse(err)
+	//   value, err := GetTaskListsByDomain(args)
+	//   result, err := MatchingService_GetTaskListsByDomain_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from AddDecisionTask: %v", err)
+	//     return fmt.Errorf("unexpected error from GetTaskListsByDomain: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*MatchingService_AddDecisionTask_Result, error)
+	WrapResponse func(*shared.GetTaskListsByDomainResponse, error) (*MatchingService_GetTaskListsByDomain_Result, error)
 
-	// UnwrapResponse takes the result struct for AddDecisionTask
-	// and returns the erorr returned by it (if any).
+	// UnwrapResponse takes the result struct for GetTaskListsByDomain
+	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if AddDecisionTask threw an
+	// The error is non-nil only if GetTaskListsByDomain threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := MatchingService_AddDecisionTask_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_AddDecisionTask_Result) error
+	//   value, err := MatchingService_GetTaskListsByDomain_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_GetTaskListsByDomain_Result) (*shared.GetTaskListsByDomainResponse, error)
 }{}
 
 func init() {
-	MatchingService_AddDecisionTask_Helper.Args = func(
-		addRequest *AddDecisionTaskRequest,
-	) *MatchingService_AddDecisionTask_Args {
-		return &MatchingService_AddDecisionTask_Args{
-			AddRequest: addRequest,
+	MatchingService_GetTaskListsByDomain_Helper.Args = func(
+		request *shared.GetTaskListsByDomainRequest,
+	) *MatchingService_GetTaskListsByDomain_Args {
+		return &MatchingService_GetTaskListsByDomain_Args{
+			Request: request,
 		}
 	}
 
-	MatchingService_AddDecisionTask_Helper.IsException = func(err error) bool {
+	MatchingService_GetTaskListsByDomain_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.ServiceBusyError:
-			return true
-		case *shared.LimitExceededError:
-			return true
-		case *shared.DomainNotActiveError:
+		case *shared.EntityNotExistsError:
 			return true
-		case *shared.RemoteSyncMatchedError:
+		case *shared.ServiceBusyError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	MatchingService_AddDecisionTask_Helper.WrapResponse = func(err error) (*MatchingService_AddDecisionTask_Result, error) {
+	MatchingService_GetTaskListsByDomain_Helper.WrapResponse = func(success *shared.GetTaskListsByDomainResponse, err error) (*MatchingService_GetTaskListsByDomain_Result, error) {
 		if err == nil {
-			return &MatchingService_AddDecisionTask_Result{}, nil
+			return &MatchingService_GetTaskListsByDomain_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_GetTaskListsByDomain_Result.BadRequestError")
 			}
-			return &MatchingService_AddDecisionTask_Result{BadRequestError: e}, nil
+			return &MatchingService_GetTaskListsByDomain_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.InternalServiceError")
-			}
-			return &MatchingService_AddDecisionTask_Result{InternalServiceError: e}, nil
-		case *shared.ServiceBusyError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.ServiceBusyError")
-			}
-			return &MatchingService_AddDecisionTask_Result{ServiceBusyError: e}, nil
-		case *shared.LimitExceededError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_GetTaskListsByDomain_Result.InternalServiceError")
 			}
-			return &MatchingService_AddDecisionTask_Result{LimitExceededError: e}, nil
-		case *shared.DomainNotActiveError:
+			return &MatchingService_GetTaskListsByDomain_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.DomainNotActiveError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_GetTaskListsByDomain_Result.EntityNotExistError")
 			}
-			return &MatchingService_AddDecisionTask_Result{DomainNotActiveError: e}, nil
-		case *shared.RemoteSyncMatchedError:
+			return &MatchingService_GetTaskListsByDomain_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.RemoteSyncMatchedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_GetTaskListsByDomain_Result.ServiceBusyError")
 			}
-			return &MatchingService_AddDecisionTask_Result{RemoteSyncMatchedError: e}, nil
+			return &MatchingService_GetTaskListsByDomain_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_AddDecisionTask_Helper.UnwrapResponse = func(result *MatchingService_AddDecisionTask_Result) (err error) {
+	MatchingService_GetTaskListsByDomain_Helper.UnwrapResponse = func(result *MatchingService_GetTaskListsByDomain_Result) (success *shared.GetTaskListsByDomainResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -4814,40 +7664,41 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.ServiceBusyError != nil {
-			err = result.ServiceBusyError
-			return
-		}
-		if result.LimitExceededError != nil {
-			err = result.LimitExceededError
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
 			return
 		}
-		if result.DomainNotActiveError != nil {
-			err = result.DomainNotActiveError
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
 			return
 		}
-		if result.RemoteSyncMatchedError != nil {
-			err = result.RemoteSyncMatchedError
+
+		if result.Success != nil {
+			success = result.Success
 			return
 		}
+
+		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// MatchingService_AddDecisionTask_Result represents the result of a MatchingService.AddDecisionTask function call.
+// MatchingService_GetTaskListsByDomain_Result represents the result of a MatchingService.GetTaskListsByDomain function call.
 //
-// The result of a AddDecisionTask execution is sent and received over the wire as this struct.
-type MatchingService_AddDecisionTask_Result struct {
-	BadRequestError        *shared.BadRequestError        `json:"badRequestError,omitempty"`
-	InternalServiceError   *shared.InternalServiceError   `json:"internalServiceError,omitempty"`
-	ServiceBusyError       *shared.ServiceBusyError       `json:"serviceBusyError,omitempty"`
-	LimitExceededError     *shared.LimitExceededError     `json:"limitExceededError,omitempty"`
-	DomainNotActiveError   *shared.DomainNotActiveError   `json:"domainNotActiveError,omitempty"`
-	RemoteSyncMatchedError *shared.RemoteSyncMatchedError `json:"remoteSyncMatchedError,omitempty"`
+// The result of a GetTaskListsByDomain execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type MatchingService_GetTaskListsByDomain_Result struct {
+	// Value returned by GetTaskListsByDomain after a successful execution.
+	Success              *shared.GetTaskListsByDomainResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError              `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError         `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError         `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError             `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_AddDecisionTask_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_GetTaskListsByDomain_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4862,14 +7713,22 @@ type MatchingService_AddDecisionTask_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_AddDecisionTask_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_GetTaskListsByDomain_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -4886,51 +7745,41 @@ func (v *MatchingService_AddDecisionTask_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		w, err = v.ServiceBusyError.ToWire()
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
-	if v.LimitExceededError != nil {
-		w, err = v.LimitExceededError.ToWire()
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
-	if v.DomainNotActiveError != nil {
-		w, err = v.DomainNotActiveError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
-	if v.RemoteSyncMatchedError != nil {
-		w, err = v.RemoteSyncMatchedError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 6, Value: w}
-		i++
-	}
 
-	if i > 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", i)
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("MatchingService_GetTaskListsByDomain_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a MatchingService_AddDecisionTask_Result struct from its Thrift-level
+func _GetTaskListsByDomainResponse_Read(w wire.Value) (*shared.GetTaskListsByDomainResponse, error) {
+	var v shared.GetTaskListsByDomainResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MatchingService_GetTaskListsByDomain_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_AddDecisionTask_Result struct
+// An error is returned if we were unable to build a MatchingService_GetTaskListsByDomain_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4938,59 +7787,51 @@ func (v *MatchingService_AddDecisionTask_Result) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v MatchingService_AddDecisionTask_Result
+//   var v MatchingService_GetTaskListsByDomain_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_AddDecisionTask_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_GetTaskListsByDomain_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TStruct {
-				v.BadRequestError, err = _BadRequestError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 2:
+		switch field.ID {
+		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				v.Success, err = _GetTaskListsByDomainResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 3:
+		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 4:
+		case 2:
 			if field.Value.Type() == wire.TStruct {
-				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 5:
+		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 6:
+		case 4:
 			if field.Value.Type() == wire.TStruct {
-				v.RemoteSyncMatchedError, err = _RemoteSyncMatchedError_Read(field.Value)
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -5000,40 +7841,41 @@ func (v *MatchingService_AddDecisionTask_Result) FromWire(w wire.Value) error {
 	}
 
 	count := 0
-	if v.BadRequestError != nil {
-		count++
-	}
-	if v.InternalServiceError != nil {
+	if v.Success != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.BadRequestError != nil {
 		count++
 	}
-	if v.LimitExceededError != nil {
+	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.DomainNotActiveError != nil {
+	if v.EntityNotExistError != nil {
 		count++
 	}
-	if v.RemoteSyncMatchedError != nil {
+	if v.ServiceBusyError != nil {
 		count++
 	}
-	if count > 1 {
-		return fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("MatchingService_GetTaskListsByDomain_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_AddDecisionTask_Result
+// String returns a readable string representation of a MatchingService_GetTaskListsByDomain_Result
 // struct.
-func (v *MatchingService_AddDecisionTask_Result) String() string {
+func (v *MatchingService_GetTaskListsByDomain_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [5]string
 	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -5042,52 +7884,41 @@ func (v *MatchingService_AddDecisionTask_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
-		i++
-	}
-	if v.LimitExceededError != nil {
-		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
-		i++
-	}
-	if v.DomainNotActiveError != nil {
-		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
 		i++
 	}
-	if v.RemoteSyncMatchedError != nil {
-		fields[i] = fmt.Sprintf("RemoteSyncMatchedError: %v", v.RemoteSyncMatchedError)
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_AddDecisionTask_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_GetTaskListsByDomain_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_AddDecisionTask_Result match the
-// provided MatchingService_AddDecisionTask_Result.
+// Equals returns true if all the fields of this MatchingService_GetTaskListsByDomain_Result match the
+// provided MatchingService_GetTaskListsByDomain_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_AddDecisionTask_Result) Equals(rhs *MatchingService_AddDecisionTask_Result) bool {
+func (v *MatchingService_GetTaskListsByDomain_Result) Equals(rhs *MatchingService_GetTaskListsByDomain_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
-		return false
-	}
-	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
 		return false
 	}
-	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
 		return false
 	}
-	if !((v.RemoteSyncMatchedError == nil && rhs.RemoteSyncMatchedError == nil) || (v.RemoteSyncMatchedError != nil && rhs.RemoteSyncMatchedError != nil && v.RemoteSyncMatchedError.Equals(rhs.RemoteSyncMatchedError))) {
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
 
@@ -5095,35 +7926,47 @@ func (v *MatchingService_AddDecisionTask_Result) Equals(rhs *MatchingService_Add
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_AddDecisionTask_Result.
-func (v *MatchingService_AddDecisionTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_GetTaskListsByDomain_Result.
+func (v *MatchingService_GetTaskListsByDomain_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
-	if v.LimitExceededError != nil {
-		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
-	}
-	if v.DomainNotActiveError != nil {
-		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
-	}
-	if v.RemoteSyncMatchedError != nil {
-		err = multierr.Append(err, enc.AddObject("remoteSyncMatchedError", v.RemoteSyncMatchedError))
-	}
 	return err
 }
 
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_GetTaskListsByDomain_Result) GetSuccess() (o *shared.GetTaskListsByDomainResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *MatchingService_GetTaskListsByDomain_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_GetTaskListsByDomain_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -5132,13 +7975,13 @@ func (v *MatchingService_AddDecisionTask_Result) GetBadRequestError() (o *shared
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_GetTaskListsByDomain_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_GetTaskListsByDomain_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -5147,93 +7990,63 @@ func (v *MatchingService_AddDecisionTask_Result) GetInternalServiceError() (o *s
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_GetTaskListsByDomain_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
-	if v != nil && v.ServiceBusyError != nil {
-		return v.ServiceBusyError
-	}
-
-	return
-}
-
-// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetServiceBusyError() bool {
-	return v != nil && v.ServiceBusyError != nil
-}
-
-// GetLimitExceededError returns the value of LimitExceededError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
-	if v != nil && v.LimitExceededError != nil {
-		return v.LimitExceededError
-	}
-
-	return
-}
-
-// IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetLimitExceededError() bool {
-	return v != nil && v.LimitExceededError != nil
-}
-
-// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
-	if v != nil && v.DomainNotActiveError != nil {
-		return v.DomainNotActiveError
+func (v *MatchingService_GetTaskListsByDomain_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
 	}
 
 	return
 }
 
-// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetDomainNotActiveError() bool {
-	return v != nil && v.DomainNotActiveError != nil
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *MatchingService_GetTaskListsByDomain_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
 }
 
-// GetRemoteSyncMatchedError returns the value of RemoteSyncMatchedError if it is set or its
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetRemoteSyncMatchedError() (o *shared.RemoteSyncMatchedError) {
-	if v != nil && v.RemoteSyncMatchedError != nil {
-		return v.RemoteSyncMatchedError
+func (v *MatchingService_GetTaskListsByDomain_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
 	}
 
 	return
 }
 
-// IsSetRemoteSyncMatchedError returns true if RemoteSyncMatchedError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetRemoteSyncMatchedError() bool {
-	return v != nil && v.RemoteSyncMatchedError != nil
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *MatchingService_GetTaskListsByDomain_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "AddDecisionTask" for this struct.
-func (v *MatchingService_AddDecisionTask_Result) MethodName() string {
-	return "AddDecisionTask"
+// This will always be "GetTaskListsByDomain" for this struct.
+func (v *MatchingService_GetTaskListsByDomain_Result) MethodName() string {
+	return "GetTaskListsByDomain"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_AddDecisionTask_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_GetTaskListsByDomain_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_CancelOutstandingPoll_Args represents the arguments for the MatchingService.CancelOutstandingPoll function.
+// MatchingService_ListTaskListPartitions_Args represents the arguments for the MatchingService.ListTaskListPartitions function.
 //
-// The arguments for CancelOutstandingPoll are sent and received over the wire as this struct.
-type MatchingService_CancelOutstandingPoll_Args struct {
-	Request *CancelOutstandingPollRequest `json:"request,omitempty"`
+// The arguments for ListTaskListPartitions are sent and received over the wire as this struct.
+type MatchingService_ListTaskListPartitions_Args struct {
+	Request *ListTaskListPartitionsRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a MatchingService_CancelOutstandingPoll_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_ListTaskListPartitions_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -5248,7 +8061,7 @@ type MatchingService_CancelOutstandingPoll_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_CancelOutstandingPoll_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_ListTaskListPartitions_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -5268,17 +8081,17 @@ func (v *MatchingService_CancelOutstandingPoll_Args) ToWire() (wire.Value, error
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _CancelOutstandingPollRequest_Read(w wire.Value) (*CancelOutstandingPollRequest, error) {
-	var v CancelOutstandingPollRequest
+func _ListTaskListPartitionsRequest_Read(w wire.Value) (*ListTaskListPartitionsRequest, error) {
+	var v ListTaskListPartitionsRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_CancelOutstandingPoll_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_ListTaskListPartitions_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_CancelOutstandingPoll_Args struct
+// An error is returned if we were unable to build a MatchingService_ListTaskListPartitions_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5286,19 +8099,19 @@ func _CancelOutstandingPollRequest_Read(w wire.Value) (*CancelOutstandingPollReq
 //     return nil, err
 //   }
 //
-//   var v MatchingService_CancelOutstandingPoll_Args
+//   var v MatchingService_ListTaskListPartitions_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_CancelOutstandingPoll_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_ListTaskListPartitions_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _CancelOutstandingPollRequest_Read(field.Value)
+				v.Request, err = _ListTaskListPartitionsRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -5310,9 +8123,9 @@ func (v *MatchingService_CancelOutstandingPoll_Args) FromWire(w wire.Value) erro
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_CancelOutstandingPoll_Args
+// String returns a readable string representation of a MatchingService_ListTaskListPartitions_Args
 // struct.
-func (v *MatchingService_CancelOutstandingPoll_Args) String() string {
+func (v *MatchingService_ListTaskListPartitions_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -5324,14 +8137,14 @@ func (v *MatchingService_CancelOutstandingPoll_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_CancelOutstandingPoll_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_ListTaskListPartitions_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_CancelOutstandingPoll_Args match the
-// provided MatchingService_CancelOutstandingPoll_Args.
+// Equals returns true if all the fields of this MatchingService_ListTaskListPartitions_Args match the
+// provided MatchingService_ListTaskListPartitions_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_CancelOutstandingPoll_Args) Equals(rhs *MatchingService_CancelOutstandingPoll_Args) bool {
+func (v *MatchingService_ListTaskListPartitions_Args) Equals(rhs *MatchingService_ListTaskListPartitions_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -5345,8 +8158,8 @@ func (v *MatchingService_CancelOutstandingPoll_Args) Equals(rhs *MatchingService
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_CancelOutstandingPoll_Args.
-func (v *MatchingService_CancelOutstandingPoll_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_ListTaskListPartitions_Args.
+func (v *MatchingService_ListTaskListPartitions_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -5358,7 +8171,7 @@ func (v *MatchingService_CancelOutstandingPoll_Args) MarshalLogObject(enc zapcor
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_CancelOutstandingPoll_Args) GetRequest() (o *CancelOutstandingPollRequest) {
+func (v *MatchingService_ListTaskListPartitions_Args) GetRequest() (o *ListTaskListPartitionsRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -5367,81 +8180,80 @@ func (v *MatchingService_CancelOutstandingPoll_Args) GetRequest() (o *CancelOuts
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *MatchingService_CancelOutstandingPoll_Args) IsSetRequest() bool {
+func (v *MatchingService_ListTaskListPartitions_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "CancelOutstandingPoll" for this struct.
-func (v *MatchingService_CancelOutstandingPoll_Args) MethodName() string {
-	return "CancelOutstandingPoll"
+// This will always be "ListTaskListPartitions" for this struct.
+func (v *MatchingService_ListTaskListPartitions_Args) MethodName() string {
+	return "ListTaskListPartitions"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_CancelOutstandingPoll_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_ListTaskListPartitions_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_CancelOutstandingPoll_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.CancelOutstandingPoll
+// MatchingService_ListTaskListPartitions_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.ListTaskListPartitions
 // function.
-var MatchingService_CancelOutstandingPoll_Helper = struct {
-	// Args accepts the parameters of CancelOutstandingPoll in-order and returns
+var MatchingService_ListTaskListPartitions_Helper = struct {
+	// Args accepts the parameters of ListTaskListPartitions in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *CancelOutstandingPollRequest,
-	) *MatchingService_CancelOutstandingPoll_Args
+		request *ListTaskListPartitionsRequest,
+	) *MatchingService_ListTaskListPartitions_Args
 
 	// IsException returns true if the given error can be thrown
-	// by CancelOutstandingPoll.
+	// by ListTaskListPartitions.
 	//
-	// An error can be thrown by CancelOutstandingPoll only if the
+	// An error can be thrown by ListTaskListPartitions only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for CancelOutstandingPoll
-	// given the error returned by it. The provided error may
-	// be nil if CancelOutstandingPoll did not fail.
+	// WrapResponse returns the result struct for ListTaskListPartitions
+	// given its return value and error.
 	//
-	// This allows mapping errors returned by CancelOutstandingPoll into a
-	// serializable result struct. WrapResponse returns a
-	// non-nil error if the provided error cannot be thrown by
-	// CancelOutstandingPoll
+	// This allows mapping values and errors returned by
+	// ListTaskListPartitions into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by ListTaskListPartitions
 	//
-	//   err := CancelOutstandingPoll(args)
-	//   result, err := MatchingService_CancelOutstandingPoll_Helper.WrapResponse(err)
+	//   value, err := ListTaskListPartitions(args)
+	//   result, err := MatchingService_ListTaskListPartitions_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from CancelOutstandingPoll: %v", err)
+	//     return fmt.Errorf("unexpected error from ListTaskListPartitions: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*MatchingService_CancelOutstandingPoll_Result, error)
+	WrapResponse func(*shared.ListTaskListPartitionsResponse, error) (*MatchingService_ListTaskListPartitions_Result, error)
 
-	// UnwrapResponse takes the result struct for CancelOutstandingPoll
-	// and returns the erorr returned by it (if any).
+	// UnwrapResponse takes the result struct for ListTaskListPartitions
+	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if CancelOutstandingPoll threw an
+	// The error is non-nil only if ListTaskListPartitions threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := MatchingService_CancelOutstandingPoll_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_CancelOutstandingPoll_Result) error
+	//   value, err := MatchingService_ListTaskListPartitions_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_ListTaskListPartitions_Result) (*shared.ListTaskListPartitionsResponse, error)
 }{}
 
 func init() {
-	MatchingService_CancelOutstandingPoll_Helper.Args = func(
-		request *CancelOutstandingPollRequest,
-	) *MatchingService_CancelOutstandingPoll_Args {
-		return &MatchingService_CancelOutstandingPoll_Args{
+	MatchingService_ListTaskListPartitions_Helper.Args = func(
+		request *ListTaskListPartitionsRequest,
+	) *MatchingService_ListTaskListPartitions_Args {
+		return &MatchingService_ListTaskListPartitions_Args{
 			Request: request,
 		}
 	}
 
-	MatchingService_CancelOutstandingPoll_Helper.IsException = func(err error) bool {
+	MatchingService_ListTaskListPartitions_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -5454,32 +8266,32 @@ func init() {
 		}
 	}
 
-	MatchingService_CancelOutstandingPoll_Helper.WrapResponse = func(err error) (*MatchingService_CancelOutstandingPoll_Result, error) {
+	MatchingService_ListTaskListPartitions_Helper.WrapResponse = func(success *shared.ListTaskListPartitionsResponse, err error) (*MatchingService_ListTaskListPartitions_Result, error) {
 		if err == nil {
-			return &MatchingService_CancelOutstandingPoll_Result{}, nil
+			return &MatchingService_ListTaskListPartitions_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_ListTaskListPartitions_Result.BadRequestError")
 			}
-			return &MatchingService_CancelOutstandingPoll_Result{BadRequestError: e}, nil
+			return &MatchingService_ListTaskListPartitions_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_ListTaskListPartitions_Result.InternalServiceError")
 			}
-			return &MatchingService_CancelOutstandingPoll_Result{InternalServiceError: e}, nil
+			return &MatchingService_ListTaskListPartitions_Result{InternalServiceError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_ListTaskListPartitions_Result.ServiceBusyError")
 			}
-			return &MatchingService_CancelOutstandingPoll_Result{ServiceBusyError: e}, nil
+			return &MatchingService_ListTaskListPartitions_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_CancelOutstandingPoll_Helper.UnwrapResponse = func(result *MatchingService_CancelOutstandingPoll_Result) (err error) {
+	MatchingService_ListTaskListPartitions_Helper.UnwrapResponse = func(result *MatchingService_ListTaskListPartitions_Result) (success *shared.ListTaskListPartitionsResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -5492,21 +8304,32 @@ func init() {
 			err = result.ServiceBusyError
 			return
 		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// MatchingService_CancelOutstandingPoll_Result represents the result of a MatchingService.CancelOutstandingPoll function call.
+// MatchingService_ListTaskListPartitions_Result represents the result of a MatchingService.ListTaskListPartitions function call.
 //
-// The result of a CancelOutstandingPoll execution is sent and received over the wire as this struct.
-type MatchingService_CancelOutstandingPoll_Result struct {
-	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+// The result of a ListTaskListPartitions execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type MatchingService_ListTaskListPartitions_Result struct {
+	// Value returned by ListTaskListPartitions after a successful execution.
+	Success              *shared.ListTaskListPartitionsResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError                `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError           `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError               `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_CancelOutstandingPoll_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_ListTaskListPartitions_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -5521,14 +8344,22 @@ type MatchingService_CancelOutstandingPoll_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_CancelOutstandingPoll_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_ListTaskListPartitions_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -5550,22 +8381,28 @@ func (v *MatchingService_CancelOutstandingPoll_Result) ToWire() (wire.Value, err
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
 
-	if i > 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", i)
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("MatchingService_ListTaskListPartitions_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a MatchingService_CancelOutstandingPoll_Result struct from its Thrift-level
+func _ListTaskListPartitionsResponse_Read(w wire.Value) (*shared.ListTaskListPartitionsResponse, error) {
+	var v shared.ListTaskListPartitionsResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MatchingService_ListTaskListPartitions_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_CancelOutstandingPoll_Result struct
+// An error is returned if we were unable to build a MatchingService_ListTaskListPartitions_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5573,16 +8410,24 @@ func (v *MatchingService_CancelOutstandingPoll_Result) ToWire() (wire.Value, err
 //     return nil, err
 //   }
 //
-//   var v MatchingService_CancelOutstandingPoll_Result
+//   var v MatchingService_ListTaskListPartitions_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_CancelOutstandingPoll_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_ListTaskListPartitions_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _ListTaskListPartitionsResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -5599,7 +8444,7 @@ func (v *MatchingService_CancelOutstandingPoll_Result) FromWire(w wire.Value) er
 				}
 
 			}
-		case 3:
+		case 4:
 			if field.Value.Type() == wire.TStruct {
 				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
@@ -5611,6 +8456,9 @@ func (v *MatchingService_CancelOutstandingPoll_Result) FromWire(w wire.Value) er
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
@@ -5620,22 +8468,26 @@ func (v *MatchingService_CancelOutstandingPoll_Result) FromWire(w wire.Value) er
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if count > 1 {
-		return fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("MatchingService_ListTaskListPartitions_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_CancelOutstandingPoll_Result
+// String returns a readable string representation of a MatchingService_ListTaskListPartitions_Result
 // struct.
-func (v *MatchingService_CancelOutstandingPoll_Result) String() string {
+func (v *MatchingService_ListTaskListPartitions_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -5649,19 +8501,22 @@ func (v *MatchingService_CancelOutstandingPoll_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_CancelOutstandingPoll_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_ListTaskListPartitions_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_CancelOutstandingPoll_Result match the
-// provided MatchingService_CancelOutstandingPoll_Result.
+// Equals returns true if all the fields of this MatchingService_ListTaskListPartitions_Result match the
+// provided MatchingService_ListTaskListPartitions_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_CancelOutstandingPoll_Result) Equals(rhs *MatchingService_CancelOutstandingPoll_Result) bool {
+func (v *MatchingService_ListTaskListPartitions_Result) Equals(rhs *MatchingService_ListTaskListPartitions_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
@@ -5676,11 +8531,14 @@ func (v *MatchingService_CancelOutstandingPoll_Result) Equals(rhs *MatchingServi
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_CancelOutstandingPoll_Result.
-func (v *MatchingService_CancelOutstandingPoll_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_ListTaskListPartitions_Result.
+func (v *MatchingService_ListTaskListPartitions_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
@@ -5693,9 +8551,24 @@ func (v *MatchingService_CancelOutstandingPoll_Result) MarshalLogObject(enc zapc
 	return err
 }
 
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_ListTaskListPartitions_Result) GetSuccess() (o *shared.ListTaskListPartitionsResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *MatchingService_ListTaskListPartitions_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_CancelOutstandingPoll_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_ListTaskListPartitions_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -5704,13 +8577,13 @@ func (v *MatchingService_CancelOutstandingPoll_Result) GetBadRequestError() (o *
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_CancelOutstandingPoll_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_ListTaskListPartitions_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_CancelOutstandingPoll_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_ListTaskListPartitions_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -5719,13 +8592,13 @@ func (v *MatchingService_CancelOutstandingPoll_Result) GetInternalServiceError()
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_CancelOutstandingPoll_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_ListTaskListPartitions_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_CancelOutstandingPoll_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_ListTaskListPartitions_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -5734,33 +8607,33 @@ func (v *MatchingService_CancelOutstandingPoll_Result) GetServiceBusyError() (o
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_CancelOutstandingPoll_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_ListTaskListPartitions_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "CancelOutstandingPoll" for this struct.
-func (v *MatchingService_CancelOutstandingPoll_Result) MethodName() string {
-	return "CancelOutstandingPoll"
+// This will always be "ListTaskListPartitions" for this struct.
+func (v *MatchingService_ListTaskListPartitions_Result) MethodName() string {
+	return "ListTaskListPartitions"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_CancelOutstandingPoll_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_ListTaskListPartitions_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_DescribeTaskList_Args represents the arguments for the MatchingService.DescribeTaskList function.
+// MatchingService_MoveTaskListBacklog_Args represents the arguments for the MatchingService.MoveTaskListBacklog function.
 //
-// The arguments for DescribeTaskList are sent and received over the wire as this struct.
-type MatchingService_DescribeTaskList_Args struct {
-	Request *DescribeTaskListRequest `json:"request,omitempty"`
+// The arguments for MoveTaskListBacklog are sent and received over the wire as this struct.
+type MatchingService_MoveTaskListBacklog_Args struct {
+	Request *MoveTaskListBacklogRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a MatchingService_DescribeTaskList_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_MoveTaskListBacklog_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -5775,7 +8648,7 @@ type MatchingService_DescribeTaskList_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_DescribeTaskList_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_MoveTaskListBacklog_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -5795,17 +8668,17 @@ func (v *MatchingService_DescribeTaskList_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeTaskListRequest_1_Read(w wire.Value) (*DescribeTaskListRequest, error) {
-	var v DescribeTaskListRequest
+func _MoveTaskListBacklogRequest_Read(w wire.Value) (*MoveTaskListBacklogRequest, error) {
+	var v MoveTaskListBacklogRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_DescribeTaskList_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_MoveTaskListBacklog_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_DescribeTaskList_Args struct
+// An error is returned if we were unable to build a MatchingService_MoveTaskListBacklog_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5813,19 +8686,19 @@ func _DescribeTaskListRequest_1_Read(w wire.Value) (*DescribeTaskListRequest, er
 //     return nil, err
 //   }
 //
-//   var v MatchingService_DescribeTaskList_Args
+//   var v MatchingService_MoveTaskListBacklog_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_DescribeTaskList_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_MoveTaskListBacklog_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _DescribeTaskListRequest_1_Read(field.Value)
+				v.Request, err = _MoveTaskListBacklogRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -5837,9 +8710,9 @@ func (v *MatchingService_DescribeTaskList_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_DescribeTaskList_Args
+// String returns a readable string representation of a MatchingService_MoveTaskListBacklog_Args
 // struct.
-func (v *MatchingService_DescribeTaskList_Args) String() string {
+func (v *MatchingService_MoveTaskListBacklog_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -5851,14 +8724,14 @@ func (v *MatchingService_DescribeTaskList_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_DescribeTaskList_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_MoveTaskListBacklog_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_DescribeTaskList_Args match the
-// provided MatchingService_DescribeTaskList_Args.
+// Equals returns true if all the fields of this MatchingService_MoveTaskListBacklog_Args match the
+// provided MatchingService_MoveTaskListBacklog_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_DescribeTaskList_Args) Equals(rhs *MatchingService_DescribeTaskList_Args) bool {
+func (v *MatchingService_MoveTaskListBacklog_Args) Equals(rhs *MatchingService_MoveTaskListBacklog_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -5872,8 +8745,8 @@ func (v *MatchingService_DescribeTaskList_Args) Equals(rhs *MatchingService_Desc
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_DescribeTaskList_Args.
-func (v *MatchingService_DescribeTaskList_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_MoveTaskListBacklog_Args.
+func (v *MatchingService_MoveTaskListBacklog_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -5885,7 +8758,7 @@ func (v *MatchingService_DescribeTaskList_Args) MarshalLogObject(enc zapcore.Obj
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Args) GetRequest() (o *DescribeTaskListRequest) {
+func (v *MatchingService_MoveTaskListBacklog_Args) GetRequest() (o *MoveTaskListBacklogRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -5894,87 +8767,85 @@ func (v *MatchingService_DescribeTaskList_Args) GetRequest() (o *DescribeTaskLis
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *MatchingService_DescribeTaskList_Args) IsSetRequest() bool {
+func (v *MatchingService_MoveTaskListBacklog_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "DescribeTaskList" for this struct.
-func (v *MatchingService_DescribeTaskList_Args) MethodName() string {
-	return "DescribeTaskList"
+// This will always be "MoveTaskListBacklog" for this struct.
+func (v *MatchingService_MoveTaskListBacklog_Args) MethodName() string {
+	return "MoveTaskListBacklog"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_DescribeTaskList_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_MoveTaskListBacklog_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_DescribeTaskList_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.DescribeTaskList
+// MatchingService_MoveTaskListBacklog_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.MoveTaskListBacklog
 // function.
-var MatchingService_DescribeTaskList_Helper = struct {
-	// Args accepts the parameters of DescribeTaskList in-order and returns
+var MatchingService_MoveTaskListBacklog_Helper = struct {
+	// Args accepts the parameters of MoveTaskListBacklog in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *DescribeTaskListRequest,
-	) *MatchingService_DescribeTaskList_Args
+		request *MoveTaskListBacklogRequest,
+	) *MatchingService_MoveTaskListBacklog_Args
 
 	// IsException returns true if the given error can be thrown
-	// by DescribeTaskList.
+	// by MoveTaskListBacklog.
 	//
-	// An error can be thrown by DescribeTaskList only if the
+	// An error can be thrown by MoveTaskListBacklog only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for DescribeTaskList
+	// WrapResponse returns the result struct for MoveTaskListBacklog
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// DescribeTaskList into a serializable result struct.
+	// MoveTaskListBacklog into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by DescribeTaskList
+	// error cannot be thrown by MoveTaskListBacklog
 	//
-	//   value, err := DescribeTaskList(args)
-	//   result, err := MatchingService_DescribeTaskList_Helper.WrapResponse(value, err)
+	//   value, err := MoveTaskListBacklog(args)
+	//   result, err := MatchingService_MoveTaskListBacklog_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from DescribeTaskList: %v", err)
+	//     return fmt.Errorf("unexpected error from MoveTaskListBacklog: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.DescribeTaskListResponse, error) (*MatchingService_DescribeTaskList_Result, error)
+	WrapResponse func(*shared.MoveTaskListBacklogResponse, error) (*MatchingService_MoveTaskListBacklog_Result, error)
 
-	// UnwrapResponse takes the result struct for DescribeTaskList
+	// UnwrapResponse takes the result struct for MoveTaskListBacklog
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if DescribeTaskList threw an
+	// The error is non-nil only if MoveTaskListBacklog threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := MatchingService_DescribeTaskList_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_DescribeTaskList_Result) (*shared.DescribeTaskListResponse, error)
+	//   value, err := MatchingService_MoveTaskListBacklog_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_MoveTaskListBacklog_Result) (*shared.MoveTaskListBacklogResponse, error)
 }{}
 
 func init() {
-	MatchingService_DescribeTaskList_Helper.Args = func(
-		request *DescribeTaskListRequest,
-	) *MatchingService_DescribeTaskList_Args {
-		return &MatchingService_DescribeTaskList_Args{
+	MatchingService_MoveTaskListBacklog_Helper.Args = func(
+		request *MoveTaskListBacklogRequest,
+	) *MatchingService_MoveTaskListBacklog_Args {
+		return &MatchingService_MoveTaskListBacklog_Args{
 			Request: request,
 		}
 	}
 
-	MatchingService_DescribeTaskList_Helper.IsException = func(err error) bool {
+	MatchingService_MoveTaskListBacklog_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.EntityNotExistsError:
-			return true
 		case *shared.ServiceBusyError:
 			return true
 		default:
@@ -5982,37 +8853,32 @@ func init() {
 		}
 	}
 
-	MatchingService_DescribeTaskList_Helper.WrapResponse = func(success *shared.DescribeTaskListResponse, err error) (*MatchingService_DescribeTaskList_Result, error) {
+	MatchingService_MoveTaskListBacklog_Helper.WrapResponse = func(success *shared.MoveTaskListBacklogResponse, err error) (*MatchingService_MoveTaskListBacklog_Result, error) {
 		if err == nil {
-			return &MatchingService_DescribeTaskList_Result{Success: success}, nil
+			return &MatchingService_MoveTaskListBacklog_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_MoveTaskListBacklog_Result.BadRequestError")
 			}
-			return &MatchingService_DescribeTaskList_Result{BadRequestError: e}, nil
+			return &MatchingService_MoveTaskListBacklog_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.InternalServiceError")
-			}
-			return &MatchingService_DescribeTaskList_Result{InternalServiceError: e}, nil
-		case *shared.EntityNotExistsError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_MoveTaskListBacklog_Result.InternalServiceError")
 			}
-			return &MatchingService_DescribeTaskList_Result{EntityNotExistError: e}, nil
+			return &MatchingService_MoveTaskListBacklog_Result{InternalServiceError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_MoveTaskListBacklog_Result.ServiceBusyError")
 			}
-			return &MatchingService_DescribeTaskList_Result{ServiceBusyError: e}, nil
+			return &MatchingService_MoveTaskListBacklog_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_DescribeTaskList_Helper.UnwrapResponse = func(result *MatchingService_DescribeTaskList_Result) (success *shared.DescribeTaskListResponse, err error) {
+	MatchingService_MoveTaskListBacklog_Helper.UnwrapResponse = func(result *MatchingService_MoveTaskListBacklog_Result) (success *shared.MoveTaskListBacklogResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -6021,10 +8887,6 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
-			return
-		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
@@ -6041,21 +8903,20 @@ func init() {
 
 }
 
-// MatchingService_DescribeTaskList_Result represents the result of a MatchingService.DescribeTaskList function call.
+// MatchingService_MoveTaskListBacklog_Result represents the result of a MatchingService.MoveTaskListBacklog function call.
 //
-// The result of a DescribeTaskList execution is sent and received over the wire as this struct.
+// The result of a MoveTaskListBacklog execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type MatchingService_DescribeTaskList_Result struct {
-	// Value returned by DescribeTaskList after a successful execution.
-	Success              *shared.DescribeTaskListResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError          `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError     `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError     `json:"entityNotExistError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError         `json:"serviceBusyError,omitempty"`
+type MatchingService_MoveTaskListBacklog_Result struct {
+	// Value returned by MoveTaskListBacklog after a successful execution.
+	Success              *shared.MoveTaskListBacklogResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError            `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_DescribeTaskList_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_MoveTaskListBacklog_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6070,9 +8931,9 @@ type MatchingService_DescribeTaskList_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_DescribeTaskList_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_MoveTaskListBacklog_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -6102,14 +8963,6 @@ func (v *MatchingService_DescribeTaskList_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		w, err = v.EntityNotExistError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 3, Value: w}
-		i++
-	}
 	if v.ServiceBusyError != nil {
 		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
@@ -6120,29 +8973,23 @@ func (v *MatchingService_DescribeTaskList_Result) ToWire() (wire.Value, error) {
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("MatchingService_MoveTaskListBacklog_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeTaskListResponse_Read(w wire.Value) (*shared.DescribeTaskListResponse, error) {
-	var v shared.DescribeTaskListResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, error) {
-	var v shared.EntityNotExistsError
+func _MoveTaskListBacklogResponse_Read(w wire.Value) (*shared.MoveTaskListBacklogResponse, error) {
+	var v shared.MoveTaskListBacklogResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_DescribeTaskList_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_MoveTaskListBacklog_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_DescribeTaskList_Result struct
+// An error is returned if we were unable to build a MatchingService_MoveTaskListBacklog_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6150,19 +8997,19 @@ func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, err
 //     return nil, err
 //   }
 //
-//   var v MatchingService_DescribeTaskList_Result
+//   var v MatchingService_MoveTaskListBacklog_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_DescribeTaskList_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_MoveTaskListBacklog_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _DescribeTaskListResponse_Read(field.Value)
+				v.Success, err = _MoveTaskListBacklogResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -6183,14 +9030,6 @@ func (v *MatchingService_DescribeTaskList_Result) FromWire(w wire.Value) error {
 					return err
 				}
 
-			}
-		case 3:
-			if field.Value.Type() == wire.TStruct {
-				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
 			}
 		case 4:
 			if field.Value.Type() == wire.TStruct {
@@ -6213,27 +9052,24 @@ func (v *MatchingService_DescribeTaskList_Result) FromWire(w wire.Value) error {
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
-		count++
-	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_MoveTaskListBacklog_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_DescribeTaskList_Result
+// String returns a readable string representation of a MatchingService_MoveTaskListBacklog_Result
 // struct.
-func (v *MatchingService_DescribeTaskList_Result) String() string {
+func (v *MatchingService_MoveTaskListBacklog_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [4]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -6247,23 +9083,19 @@ func (v *MatchingService_DescribeTaskList_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
-		i++
-	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_DescribeTaskList_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_MoveTaskListBacklog_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_DescribeTaskList_Result match the
-// provided MatchingService_DescribeTaskList_Result.
+// Equals returns true if all the fields of this MatchingService_MoveTaskListBacklog_Result match the
+// provided MatchingService_MoveTaskListBacklog_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_DescribeTaskList_Result) Equals(rhs *MatchingService_DescribeTaskList_Result) bool {
+func (v *MatchingService_MoveTaskListBacklog_Result) Equals(rhs *MatchingService_MoveTaskListBacklog_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -6278,9 +9110,6 @@ func (v *MatchingService_DescribeTaskList_Result) Equals(rhs *MatchingService_De
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
-		return false
-	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
@@ -6289,8 +9118,8 @@ func (v *MatchingService_DescribeTaskList_Result) Equals(rhs *MatchingService_De
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_DescribeTaskList_Result.
-func (v *MatchingService_DescribeTaskList_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_MoveTaskListBacklog_Result.
+func (v *MatchingService_MoveTaskListBacklog_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -6303,9 +9132,6 @@ func (v *MatchingService_DescribeTaskList_Result) MarshalLogObject(enc zapcore.O
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.EntityNotExistError != nil {
-		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
-	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
@@ -6314,7 +9140,7 @@ func (v *MatchingService_DescribeTaskList_Result) MarshalLogObject(enc zapcore.O
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetSuccess() (o *shared.DescribeTaskListResponse) {
+func (v *MatchingService_MoveTaskListBacklog_Result) GetSuccess() (o *shared.MoveTaskListBacklogResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -6323,13 +9149,13 @@ func (v *MatchingService_DescribeTaskList_Result) GetSuccess() (o *shared.Descri
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetSuccess() bool {
+func (v *MatchingService_MoveTaskListBacklog_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_MoveTaskListBacklog_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -6338,13 +9164,13 @@ func (v *MatchingService_DescribeTaskList_Result) GetBadRequestError() (o *share
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_MoveTaskListBacklog_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_MoveTaskListBacklog_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -6353,28 +9179,13 @@ func (v *MatchingService_DescribeTaskList_Result) GetInternalServiceError() (o *
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_MoveTaskListBacklog_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
-	if v != nil && v.EntityNotExistError != nil {
-		return v.EntityNotExistError
-	}
-
-	return
-}
-
-// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetEntityNotExistError() bool {
-	return v != nil && v.EntityNotExistError != nil
-}
-
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_MoveTaskListBacklog_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -6383,33 +9194,33 @@ func (v *MatchingService_DescribeTaskList_Result) GetServiceBusyError() (o *shar
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_MoveTaskListBacklog_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "DescribeTaskList" for this struct.
-func (v *MatchingService_DescribeTaskList_Result) MethodName() string {
-	return "DescribeTaskList"
+// This will always be "MoveTaskListBacklog" for this struct.
+func (v *MatchingService_MoveTaskListBacklog_Result) MethodName() string {
+	return "MoveTaskListBacklog"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_DescribeTaskList_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_MoveTaskListBacklog_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_GetTaskListsByDomain_Args represents the arguments for the MatchingService.GetTaskListsByDomain function.
+// MatchingService_PauseTaskList_Args represents the arguments for the MatchingService.PauseTaskList function.
 //
-// The arguments for GetTaskListsByDomain are sent and received over the wire as this struct.
-type MatchingService_GetTaskListsByDomain_Args struct {
-	Request *shared.GetTaskListsByDomainRequest `json:"request,omitempty"`
+// The arguments for PauseTaskList are sent and received over the wire as this struct.
+type MatchingService_PauseTaskList_Args struct {
+	Request *PauseTaskListRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a MatchingService_GetTaskListsByDomain_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_PauseTaskList_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6424,7 +9235,7 @@ type MatchingService_GetTaskListsByDomain_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_GetTaskListsByDomain_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_PauseTaskList_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -6444,17 +9255,17 @@ func (v *MatchingService_GetTaskListsByDomain_Args) ToWire() (wire.Value, error)
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetTaskListsByDomainRequest_Read(w wire.Value) (*shared.GetTaskListsByDomainRequest, error) {
-	var v shared.GetTaskListsByDomainRequest
+func _PauseTaskListRequest_Read(w wire.Value) (*PauseTaskListRequest, error) {
+	var v PauseTaskListRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_GetTaskListsByDomain_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_PauseTaskList_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_GetTaskListsByDomain_Args struct
+// An error is returned if we were unable to build a MatchingService_PauseTaskList_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6462,19 +9273,19 @@ func _GetTaskListsByDomainRequest_Read(w wire.Value) (*shared.GetTaskListsByDoma
 //     return nil, err
 //   }
 //
-//   var v MatchingService_GetTaskListsByDomain_Args
+//   var v MatchingService_PauseTaskList_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_GetTaskListsByDomain_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_PauseTaskList_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _GetTaskListsByDomainRequest_Read(field.Value)
+				v.Request, err = _PauseTaskListRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -6486,9 +9297,9 @@ func (v *MatchingService_GetTaskListsByDomain_Args) FromWire(w wire.Value) error
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_GetTaskListsByDomain_Args
+// String returns a readable string representation of a MatchingService_PauseTaskList_Args
 // struct.
-func (v *MatchingService_GetTaskListsByDomain_Args) String() string {
+func (v *MatchingService_PauseTaskList_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -6500,14 +9311,14 @@ func (v *MatchingService_GetTaskListsByDomain_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_GetTaskListsByDomain_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_PauseTaskList_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_GetTaskListsByDomain_Args match the
-// provided MatchingService_GetTaskListsByDomain_Args.
+// Equals returns true if all the fields of this MatchingService_PauseTaskList_Args match the
+// provided MatchingService_PauseTaskList_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_GetTaskListsByDomain_Args) Equals(rhs *MatchingService_GetTaskListsByDomain_Args) bool {
+func (v *MatchingService_PauseTaskList_Args) Equals(rhs *MatchingService_PauseTaskList_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -6521,8 +9332,8 @@ func (v *MatchingService_GetTaskListsByDomain_Args) Equals(rhs *MatchingService_
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_GetTaskListsByDomain_Args.
-func (v *MatchingService_GetTaskListsByDomain_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_PauseTaskList_Args.
+func (v *MatchingService_PauseTaskList_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -6534,7 +9345,7 @@ func (v *MatchingService_GetTaskListsByDomain_Args) MarshalLogObject(enc zapcore
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_GetTaskListsByDomain_Args) GetRequest() (o *shared.GetTaskListsByDomainRequest) {
+func (v *MatchingService_PauseTaskList_Args) GetRequest() (o *PauseTaskListRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -6543,87 +9354,86 @@ func (v *MatchingService_GetTaskListsByDomain_Args) GetRequest() (o *shared.GetT
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *MatchingService_GetTaskListsByDomain_Args) IsSetRequest() bool {
+func (v *MatchingService_PauseTaskList_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "GetTaskListsByDomain" for this struct.
-func (v *MatchingService_GetTaskListsByDomain_Args) MethodName() string {
-	return "GetTaskListsByDomain"
+// This will always be "PauseTaskList" for this struct.
+func (v *MatchingService_PauseTaskList_Args) MethodName() string {
+	return "PauseTaskList"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_GetTaskListsByDomain_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_PauseTaskList_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_GetTaskListsByDomain_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.GetTaskListsByDomain
+// MatchingService_PauseTaskList_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.PauseTaskList
 // function.
-var MatchingService_GetTaskListsByDomain_Helper = struct {
-	// Args accepts the parameters of GetTaskListsByDomain in-order and returns
+var MatchingService_PauseTaskList_Helper = struct {
+	// Args accepts the parameters of PauseTaskList in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *shared.GetTaskListsByDomainRequest,
-	) *MatchingService_GetTaskListsByDomain_Args
+		request *PauseTaskListRequest,
+	) *MatchingService_PauseTaskList_Args
 
 	// IsException returns true if the given error can be thrown
-	// by GetTaskListsByDomain.
+	// by PauseTaskList.
 	//
-	// An error can be thrown by GetTaskListsByDomain only if the
+	// An error can be thrown by PauseTaskList only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for GetTaskListsByDomain
-	// given its return value and error.
+	// WrapResponse returns the result struct for PauseTaskList
+	// given the error returned by it. The provided error may
+	// be nil if PauseTaskList did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// GetTaskListsByDomain into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by GetTaskListsByDomain
+	// This allows mapping errors returned by PauseTaskList into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// PauseTaskList
 	//
-	//   value, err := GetTaskListsByDomain(args)
-	//   result, err := MatchingService_GetTaskListsByDomain_Helper.WrapResponse(value, err)
+	//   err := PauseTaskList(args)
+	//   result, err := MatchingService_PauseTaskList_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from GetTaskListsByDomain: %v", err)
+	//     return fmt.Errorf("unexpected error from PauseTaskList: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.GetTaskListsByDomainResponse, error) (*MatchingService_GetTaskListsByDomain_Result, error)
+	WrapResponse func(error) (*MatchingService_PauseTaskList_Result, error)
 
-	// UnwrapResponse takes the result struct for GetTaskListsByDomain
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for PauseTaskList
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if GetTaskListsByDomain threw an
+	// The error is non-nil only if PauseTaskList threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := MatchingService_GetTaskListsByDomain_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_GetTaskListsByDomain_Result) (*shared.GetTaskListsByDomainResponse, error)
+	//   err := MatchingService_PauseTaskList_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_PauseTaskList_Result) error
 }{}
 
 func init() {
-	MatchingService_GetTaskListsByDomain_Helper.Args = func(
-		request *shared.GetTaskListsByDomainRequest,
-	) *MatchingService_GetTaskListsByDomain_Args {
-		return &MatchingService_GetTaskListsByDomain_Args{
+	MatchingService_PauseTaskList_Helper.Args = func(
+		request *PauseTaskListRequest,
+	) *MatchingService_PauseTaskList_Args {
+		return &MatchingService_PauseTaskList_Args{
 			Request: request,
 		}
 	}
 
-	MatchingService_GetTaskListsByDomain_Helper.IsException = func(err error) bool {
+	MatchingService_PauseTaskList_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.EntityNotExistsError:
-			return true
 		case *shared.ServiceBusyError:
 			return true
 		default:
@@ -6631,37 +9441,32 @@ func init() {
 		}
 	}
 
-	MatchingService_GetTaskListsByDomain_Helper.WrapResponse = func(success *shared.GetTaskListsByDomainResponse, err error) (*MatchingService_GetTaskListsByDomain_Result, error) {
+	MatchingService_PauseTaskList_Helper.WrapResponse = func(err error) (*MatchingService_PauseTaskList_Result, error) {
 		if err == nil {
-			return &MatchingService_GetTaskListsByDomain_Result{Success: success}, nil
+			return &MatchingService_PauseTaskList_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_GetTaskListsByDomain_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PauseTaskList_Result.BadRequestError")
 			}
-			return &MatchingService_GetTaskListsByDomain_Result{BadRequestError: e}, nil
+			return &MatchingService_PauseTaskList_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_GetTaskListsByDomain_Result.InternalServiceError")
-			}
-			return &MatchingService_GetTaskListsByDomain_Result{InternalServiceError: e}, nil
-		case *shared.EntityNotExistsError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_GetTaskListsByDomain_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PauseTaskList_Result.InternalServiceError")
 			}
-			return &MatchingService_GetTaskListsByDomain_Result{EntityNotExistError: e}, nil
+			return &MatchingService_PauseTaskList_Result{InternalServiceError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_GetTaskListsByDomain_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PauseTaskList_Result.ServiceBusyError")
 			}
-			return &MatchingService_GetTaskListsByDomain_Result{ServiceBusyError: e}, nil
+			return &MatchingService_PauseTaskList_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_GetTaskListsByDomain_Helper.UnwrapResponse = func(result *MatchingService_GetTaskListsByDomain_Result) (success *shared.GetTaskListsByDomainResponse, err error) {
+	MatchingService_PauseTaskList_Helper.UnwrapResponse = func(result *MatchingService_PauseTaskList_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -6670,41 +9475,25 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
-			return
-		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
-			return
-		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// MatchingService_GetTaskListsByDomain_Result represents the result of a MatchingService.GetTaskListsByDomain function call.
-//
-// The result of a GetTaskListsByDomain execution is sent and received over the wire as this struct.
+// MatchingService_PauseTaskList_Result represents the result of a MatchingService.PauseTaskList function call.
 //
-// Success is set only if the function did not throw an exception.
-type MatchingService_GetTaskListsByDomain_Result struct {
-	// Value returned by GetTaskListsByDomain after a successful execution.
-	Success              *shared.GetTaskListsByDomainResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError              `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError         `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError         `json:"entityNotExistError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError             `json:"serviceBusyError,omitempty"`
+// The result of a PauseTaskList execution is sent and received over the wire as this struct.
+type MatchingService_PauseTaskList_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_GetTaskListsByDomain_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_PauseTaskList_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6719,22 +9508,14 @@ type MatchingService_GetTaskListsByDomain_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_GetTaskListsByDomain_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_PauseTaskList_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -6751,14 +9532,6 @@ func (v *MatchingService_GetTaskListsByDomain_Result) ToWire() (wire.Value, erro
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		w, err = v.EntityNotExistError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 3, Value: w}
-		i++
-	}
 	if v.ServiceBusyError != nil {
 		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
@@ -6768,24 +9541,18 @@ func (v *MatchingService_GetTaskListsByDomain_Result) ToWire() (wire.Value, erro
 		i++
 	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_GetTaskListsByDomain_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("MatchingService_PauseTaskList_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetTaskListsByDomainResponse_Read(w wire.Value) (*shared.GetTaskListsByDomainResponse, error) {
-	var v shared.GetTaskListsByDomainResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a MatchingService_GetTaskListsByDomain_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_PauseTaskList_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_GetTaskListsByDomain_Result struct
+// An error is returned if we were unable to build a MatchingService_PauseTaskList_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6793,24 +9560,16 @@ func _GetTaskListsByDomainResponse_Read(w wire.Value) (*shared.GetTaskListsByDom
 //     return nil, err
 //   }
 //
-//   var v MatchingService_GetTaskListsByDomain_Result
+//   var v MatchingService_PauseTaskList_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_GetTaskListsByDomain_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_PauseTaskList_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 0:
-			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _GetTaskListsByDomainResponse_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
+		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -6826,14 +9585,6 @@ func (v *MatchingService_GetTaskListsByDomain_Result) FromWire(w wire.Value) err
 					return err
 				}
 
-			}
-		case 3:
-			if field.Value.Type() == wire.TStruct {
-				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
 			}
 		case 4:
 			if field.Value.Type() == wire.TStruct {
@@ -6847,41 +9598,31 @@ func (v *MatchingService_GetTaskListsByDomain_Result) FromWire(w wire.Value) err
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
-		count++
-	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("MatchingService_GetTaskListsByDomain_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("MatchingService_PauseTaskList_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_GetTaskListsByDomain_Result
+// String returns a readable string representation of a MatchingService_PauseTaskList_Result
 // struct.
-func (v *MatchingService_GetTaskListsByDomain_Result) String() string {
+func (v *MatchingService_PauseTaskList_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [3]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -6890,40 +9631,30 @@ func (v *MatchingService_GetTaskListsByDomain_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
-		i++
-	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_GetTaskListsByDomain_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_PauseTaskList_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_GetTaskListsByDomain_Result match the
-// provided MatchingService_GetTaskListsByDomain_Result.
+// Equals returns true if all the fields of this MatchingService_PauseTaskList_Result match the
+// provided MatchingService_PauseTaskList_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_GetTaskListsByDomain_Result) Equals(rhs *MatchingService_GetTaskListsByDomain_Result) bool {
+func (v *MatchingService_PauseTaskList_Result) Equals(rhs *MatchingService_PauseTaskList_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
-		return false
-	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
@@ -6932,47 +9663,26 @@ func (v *MatchingService_GetTaskListsByDomain_Result) Equals(rhs *MatchingServic
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_GetTaskListsByDomain_Result.
-func (v *MatchingService_GetTaskListsByDomain_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_PauseTaskList_Result.
+func (v *MatchingService_PauseTaskList_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.EntityNotExistError != nil {
-		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
-	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
 	return err
 }
 
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_GetTaskListsByDomain_Result) GetSuccess() (o *shared.GetTaskListsByDomainResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
-	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *MatchingService_GetTaskListsByDomain_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
-}
-
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_GetTaskListsByDomain_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_PauseTaskList_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -6981,13 +9691,13 @@ func (v *MatchingService_GetTaskListsByDomain_Result) GetBadRequestError() (o *s
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_GetTaskListsByDomain_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_PauseTaskList_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_GetTaskListsByDomain_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_PauseTaskList_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -6996,28 +9706,13 @@ func (v *MatchingService_GetTaskListsByDomain_Result) GetInternalServiceError()
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_GetTaskListsByDomain_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_PauseTaskList_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_GetTaskListsByDomain_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
-	if v != nil && v.EntityNotExistError != nil {
-		return v.EntityNotExistError
-	}
-
-	return
-}
-
-// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *MatchingService_GetTaskListsByDomain_Result) IsSetEntityNotExistError() bool {
-	return v != nil && v.EntityNotExistError != nil
-}
-
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_GetTaskListsByDomain_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_PauseTaskList_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -7026,33 +9721,33 @@ func (v *MatchingService_GetTaskListsByDomain_Result) GetServiceBusyError() (o *
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_GetTaskListsByDomain_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_PauseTaskList_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "GetTaskListsByDomain" for this struct.
-func (v *MatchingService_GetTaskListsByDomain_Result) MethodName() string {
-	return "GetTaskListsByDomain"
+// This will always be "PauseTaskList" for this struct.
+func (v *MatchingService_PauseTaskList_Result) MethodName() string {
+	return "PauseTaskList"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_GetTaskListsByDomain_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_PauseTaskList_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_ListTaskListPartitions_Args represents the arguments for the MatchingService.ListTaskListPartitions function.
+// MatchingService_PollForActivityTask_Args represents the arguments for the MatchingService.PollForActivityTask function.
 //
-// The arguments for ListTaskListPartitions are sent and received over the wire as this struct.
-type MatchingService_ListTaskListPartitions_Args struct {
-	Request *ListTaskListPartitionsRequest `json:"request,omitempty"`
+// The arguments for PollForActivityTask are sent and received over the wire as this struct.
+type MatchingService_PollForActivityTask_Args struct {
+	PollRequest *PollForActivityTaskRequest `json:"pollRequest,omitempty"`
 }
 
-// ToWire translates a MatchingService_ListTaskListPartitions_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_PollForActivityTask_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7067,7 +9762,7 @@ type MatchingService_ListTaskListPartitions_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_ListTaskListPartitions_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_PollForActivityTask_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -7075,8 +9770,8 @@ func (v *MatchingService_ListTaskListPartitions_Args) ToWire() (wire.Value, erro
 		err    error
 	)
 
-	if v.Request != nil {
-		w, err = v.Request.ToWire()
+	if v.PollRequest != nil {
+		w, err = v.PollRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -7087,17 +9782,17 @@ func (v *MatchingService_ListTaskListPartitions_Args) ToWire() (wire.Value, erro
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ListTaskListPartitionsRequest_Read(w wire.Value) (*ListTaskListPartitionsRequest, error) {
-	var v ListTaskListPartitionsRequest
+func _PollForActivityTaskRequest_1_Read(w wire.Value) (*PollForActivityTaskRequest, error) {
+	var v PollForActivityTaskRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_ListTaskListPartitions_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_PollForActivityTask_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_ListTaskListPartitions_Args struct
+// An error is returned if we were unable to build a MatchingService_PollForActivityTask_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7105,19 +9800,19 @@ func _ListTaskListPartitionsRequest_Read(w wire.Value) (*ListTaskListPartitionsR
 //     return nil, err
 //   }
 //
-//   var v MatchingService_ListTaskListPartitions_Args
+//   var v MatchingService_PollForActivityTask_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_ListTaskListPartitions_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_PollForActivityTask_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _ListTaskListPartitionsRequest_Read(field.Value)
+				v.PollRequest, err = _PollForActivityTaskRequest_1_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -7129,34 +9824,34 @@ func (v *MatchingService_ListTaskListPartitions_Args) FromWire(w wire.Value) err
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_ListTaskListPartitions_Args
+// String returns a readable string representation of a MatchingService_PollForActivityTask_Args
 // struct.
-func (v *MatchingService_ListTaskListPartitions_Args) String() string {
+func (v *MatchingService_PollForActivityTask_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.Request != nil {
-		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+	if v.PollRequest != nil {
+		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_ListTaskListPartitions_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_PollForActivityTask_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_ListTaskListPartitions_Args match the
-// provided MatchingService_ListTaskListPartitions_Args.
+// Equals returns true if all the fields of this MatchingService_PollForActivityTask_Args match the
+// provided MatchingService_PollForActivityTask_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_ListTaskListPartitions_Args) Equals(rhs *MatchingService_ListTaskListPartitions_Args) bool {
+func (v *MatchingService_PollForActivityTask_Args) Equals(rhs *MatchingService_PollForActivityTask_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
 		return false
 	}
 
@@ -7164,107 +9859,109 @@ func (v *MatchingService_ListTaskListPartitions_Args) Equals(rhs *MatchingServic
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_ListTaskListPartitions_Args.
-func (v *MatchingService_ListTaskListPartitions_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_PollForActivityTask_Args.
+func (v *MatchingService_PollForActivityTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Request != nil {
-		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	if v.PollRequest != nil {
+		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
 	}
 	return err
 }
 
-// GetRequest returns the value of Request if it is set or its
+// GetPollRequest returns the value of PollRequest if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_ListTaskListPartitions_Args) GetRequest() (o *ListTaskListPartitionsRequest) {
-	if v != nil && v.Request != nil {
-		return v.Request
+func (v *MatchingService_PollForActivityTask_Args) GetPollRequest() (o *PollForActivityTaskRequest) {
+	if v != nil && v.PollRequest != nil {
+		return v.PollRequest
 	}
 
 	return
 }
 
-// IsSetRequest returns true if Request is not nil.
-func (v *MatchingService_ListTaskListPartitions_Args) IsSetRequest() bool {
-	return v != nil && v.Request != nil
+// IsSetPollRequest returns true if PollRequest is not nil.
+func (v *MatchingService_PollForActivityTask_Args) IsSetPollRequest() bool {
+	return v != nil && v.PollRequest != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "ListTaskListPartitions" for this struct.
-func (v *MatchingService_ListTaskListPartitions_Args) MethodName() string {
-	return "ListTaskListPartitions"
+// This will always be "PollForActivityTask" for this struct.
+func (v *MatchingService_PollForActivityTask_Args) MethodName() string {
+	return "PollForActivityTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_ListTaskListPartitions_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_PollForActivityTask_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_ListTaskListPartitions_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.ListTaskListPartitions
+// MatchingService_PollForActivityTask_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.PollForActivityTask
 // function.
-var MatchingService_ListTaskListPartitions_Helper = struct {
-	// Args accepts the parameters of ListTaskListPartitions in-order and returns
+var MatchingService_PollForActivityTask_Helper = struct {
+	// Args accepts the parameters of PollForActivityTask in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *ListTaskListPartitionsRequest,
-	) *MatchingService_ListTaskListPartitions_Args
+		pollRequest *PollForActivityTaskRequest,
+	) *MatchingService_PollForActivityTask_Args
 
 	// IsException returns true if the given error can be thrown
-	// by ListTaskListPartitions.
+	// by PollForActivityTask.
 	//
-	// An error can be thrown by ListTaskListPartitions only if the
+	// An error can be thrown by PollForActivityTask only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for ListTaskListPartitions
+	// WrapResponse returns the result struct for PollForActivityTask
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// ListTaskListPartitions into a serializable result struct.
+	// PollForActivityTask into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by ListTaskListPartitions
+	// error cannot be thrown by PollForActivityTask
 	//
-	//   value, err := ListTaskListPartitions(args)
-	//   result, err := MatchingService_ListTaskListPartitions_Helper.WrapResponse(value, err)
+	//   value, err := PollForActivityTask(args)
+	//   result, err := MatchingService_PollForActivityTask_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from ListTaskListPartitions: %v", err)
+	//     return fmt.Errorf("unexpected error from PollForActivityTask: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.ListTaskListPartitionsResponse, error) (*MatchingService_ListTaskListPartitions_Result, error)
+	WrapResponse func(*shared.PollForActivityTaskResponse, error) (*MatchingService_PollForActivityTask_Result, error)
 
-	// UnwrapResponse takes the result struct for ListTaskListPartitions
+	// UnwrapResponse takes the result struct for PollForActivityTask
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if ListTaskListPartitions threw an
+	// The error is non-nil only if PollForActivityTask threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := MatchingService_ListTaskListPartitions_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_ListTaskListPartitions_Result) (*shared.ListTaskListPartitionsResponse, error)
+	//   value, err := MatchingService_PollForActivityTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_PollForActivityTask_Result) (*shared.PollForActivityTaskResponse, error)
 }{}
 
 func init() {
-	MatchingService_ListTaskListPartitions_Helper.Args = func(
-		request *ListTaskListPartitionsRequest,
-	) *MatchingService_ListTaskListPartitions_Args {
-		return &MatchingService_ListTaskListPartitions_Args{
-			Request: request,
+	MatchingService_PollForActivityTask_Helper.Args = func(
+		pollRequest *PollForActivityTaskRequest,
+	) *MatchingService_PollForActivityTask_Args {
+		return &MatchingService_PollForActivityTask_Args{
+			PollRequest: pollRequest,
 		}
 	}
 
-	MatchingService_ListTaskListPartitions_Helper.IsException = func(err error) bool {
+	MatchingService_PollForActivityTask_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
+		case *shared.LimitExceededError:
+			return true
 		case *shared.ServiceBusyError:
 			return true
 		default:
@@ -7272,32 +9969,37 @@ func init() {
 		}
 	}
 
-	MatchingService_ListTaskListPartitions_Helper.WrapResponse = func(success *shared.ListTaskListPartitionsResponse, err error) (*MatchingService_ListTaskListPartitions_Result, error) {
+	MatchingService_PollForActivityTask_Helper.WrapResponse = func(success *shared.PollForActivityTaskResponse, err error) (*MatchingService_PollForActivityTask_Result, error) {
 		if err == nil {
-			return &MatchingService_ListTaskListPartitions_Result{Success: success}, nil
+			return &MatchingService_PollForActivityTask_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_ListTaskListPartitions_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.BadRequestError")
 			}
-			return &MatchingService_ListTaskListPartitions_Result{BadRequestError: e}, nil
+			return &MatchingService_PollForActivityTask_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_ListTaskListPartitions_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.InternalServiceError")
 			}
-			return &MatchingService_ListTaskListPartitions_Result{InternalServiceError: e}, nil
+			return &MatchingService_PollForActivityTask_Result{InternalServiceError: e}, nil
+		case *shared.LimitExceededError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.LimitExceededError")
+			}
+			return &MatchingService_PollForActivityTask_Result{LimitExceededError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_ListTaskListPartitions_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.ServiceBusyError")
 			}
-			return &MatchingService_ListTaskListPartitions_Result{ServiceBusyError: e}, nil
+			return &MatchingService_PollForActivityTask_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_ListTaskListPartitions_Helper.UnwrapResponse = func(result *MatchingService_ListTaskListPartitions_Result) (success *shared.ListTaskListPartitionsResponse, err error) {
+	MatchingService_PollForActivityTask_Helper.UnwrapResponse = func(result *MatchingService_PollForActivityTask_Result) (success *shared.PollForActivityTaskResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -7306,6 +10008,10 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
+		if result.LimitExceededError != nil {
+			err = result.LimitExceededError
+			return
+		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
@@ -7322,20 +10028,21 @@ func init() {
 
 }
 
-// MatchingService_ListTaskListPartitions_Result represents the result of a MatchingService.ListTaskListPartitions function call.
+// MatchingService_PollForActivityTask_Result represents the result of a MatchingService.PollForActivityTask function call.
 //
-// The result of a ListTaskListPartitions execution is sent and received over the wire as this struct.
+// The result of a PollForActivityTask execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type MatchingService_ListTaskListPartitions_Result struct {
-	// Value returned by ListTaskListPartitions after a successful execution.
-	Success              *shared.ListTaskListPartitionsResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError                `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError           `json:"internalServiceError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError               `json:"serviceBusyError,omitempty"`
+type MatchingService_PollForActivityTask_Result struct {
+	// Value returned by PollForActivityTask after a successful execution.
+	Success              *shared.PollForActivityTaskResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
+	LimitExceededError   *shared.LimitExceededError          `json:"limitExceededError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError            `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_ListTaskListPartitions_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_PollForActivityTask_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7350,9 +10057,9 @@ type MatchingService_ListTaskListPartitions_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_ListTaskListPartitions_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_PollForActivityTask_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -7382,6 +10089,14 @@ func (v *MatchingService_ListTaskListPartitions_Result) ToWire() (wire.Value, er
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.LimitExceededError != nil {
+		w, err = v.LimitExceededError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
 	if v.ServiceBusyError != nil {
 		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
@@ -7392,23 +10107,23 @@ func (v *MatchingService_ListTaskListPartitions_Result) ToWire() (wire.Value, er
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_ListTaskListPartitions_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("MatchingService_PollForActivityTask_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ListTaskListPartitionsResponse_Read(w wire.Value) (*shared.ListTaskListPartitionsResponse, error) {
-	var v shared.ListTaskListPartitionsResponse
+func _PollForActivityTaskResponse_Read(w wire.Value) (*shared.PollForActivityTaskResponse, error) {
+	var v shared.PollForActivityTaskResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_ListTaskListPartitions_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_PollForActivityTask_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_ListTaskListPartitions_Result struct
+// An error is returned if we were unable to build a MatchingService_PollForActivityTask_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7416,19 +10131,19 @@ func _ListTaskListPartitionsResponse_Read(w wire.Value) (*shared.ListTaskListPar
 //     return nil, err
 //   }
 //
-//   var v MatchingService_ListTaskListPartitions_Result
+//   var v MatchingService_PollForActivityTask_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_ListTaskListPartitions_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_PollForActivityTask_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _ListTaskListPartitionsResponse_Read(field.Value)
+				v.Success, err = _PollForActivityTaskResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -7449,6 +10164,14 @@ func (v *MatchingService_ListTaskListPartitions_Result) FromWire(w wire.Value) e
 					return err
 				}
 
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		case 4:
 			if field.Value.Type() == wire.TStruct {
@@ -7471,24 +10194,27 @@ func (v *MatchingService_ListTaskListPartitions_Result) FromWire(w wire.Value) e
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.LimitExceededError != nil {
+		count++
+	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("MatchingService_ListTaskListPartitions_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_PollForActivityTask_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_ListTaskListPartitions_Result
+// String returns a readable string representation of a MatchingService_PollForActivityTask_Result
 // struct.
-func (v *MatchingService_ListTaskListPartitions_Result) String() string {
+func (v *MatchingService_PollForActivityTask_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -7502,19 +10228,23 @@ func (v *MatchingService_ListTaskListPartitions_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
+	if v.LimitExceededError != nil {
+		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
+		i++
+	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_ListTaskListPartitions_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_PollForActivityTask_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_ListTaskListPartitions_Result match the
-// provided MatchingService_ListTaskListPartitions_Result.
+// Equals returns true if all the fields of this MatchingService_PollForActivityTask_Result match the
+// provided MatchingService_PollForActivityTask_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_ListTaskListPartitions_Result) Equals(rhs *MatchingService_ListTaskListPartitions_Result) bool {
+func (v *MatchingService_PollForActivityTask_Result) Equals(rhs *MatchingService_PollForActivityTask_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -7529,6 +10259,9 @@ func (v *MatchingService_ListTaskListPartitions_Result) Equals(rhs *MatchingServ
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
+	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+		return false
+	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
@@ -7537,8 +10270,8 @@ func (v *MatchingService_ListTaskListPartitions_Result) Equals(rhs *MatchingServ
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_ListTaskListPartitions_Result.
-func (v *MatchingService_ListTaskListPartitions_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_PollForActivityTask_Result.
+func (v *MatchingService_PollForActivityTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -7551,6 +10284,9 @@ func (v *MatchingService_ListTaskListPartitions_Result) MarshalLogObject(enc zap
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.LimitExceededError != nil {
+		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
+	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
@@ -7559,7 +10295,7 @@ func (v *MatchingService_ListTaskListPartitions_Result) MarshalLogObject(enc zap
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_ListTaskListPartitions_Result) GetSuccess() (o *shared.ListTaskListPartitionsResponse) {
+func (v *MatchingService_PollForActivityTask_Result) GetSuccess() (o *shared.PollForActivityTaskResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -7568,13 +10304,13 @@ func (v *MatchingService_ListTaskListPartitions_Result) GetSuccess() (o *shared.
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *MatchingService_ListTaskListPartitions_Result) IsSetSuccess() bool {
+func (v *MatchingService_PollForActivityTask_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_ListTaskListPartitions_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_PollForActivityTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -7583,13 +10319,13 @@ func (v *MatchingService_ListTaskListPartitions_Result) GetBadRequestError() (o
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_ListTaskListPartitions_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_PollForActivityTask_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_ListTaskListPartitions_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_PollForActivityTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -7598,13 +10334,28 @@ func (v *MatchingService_ListTaskListPartitions_Result) GetInternalServiceError(
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_ListTaskListPartitions_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_PollForActivityTask_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
+// GetLimitExceededError returns the value of LimitExceededError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_PollForActivityTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+	if v != nil && v.LimitExceededError != nil {
+		return v.LimitExceededError
+	}
+
+	return
+}
+
+// IsSetLimitExceededError returns true if LimitExceededError is not nil.
+func (v *MatchingService_PollForActivityTask_Result) IsSetLimitExceededError() bool {
+	return v != nil && v.LimitExceededError != nil
+}
+
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_ListTaskListPartitions_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_PollForActivityTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -7613,33 +10364,33 @@ func (v *MatchingService_ListTaskListPartitions_Result) GetServiceBusyError() (o
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_ListTaskListPartitions_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_PollForActivityTask_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "ListTaskListPartitions" for this struct.
-func (v *MatchingService_ListTaskListPartitions_Result) MethodName() string {
-	return "ListTaskListPartitions"
+// This will always be "PollForActivityTask" for this struct.
+func (v *MatchingService_PollForActivityTask_Result) MethodName() string {
+	return "PollForActivityTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_ListTaskListPartitions_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_PollForActivityTask_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_PollForActivityTask_Args represents the arguments for the MatchingService.PollForActivityTask function.
+// MatchingService_PollForDecisionTask_Args represents the arguments for the MatchingService.PollForDecisionTask function.
 //
-// The arguments for PollForActivityTask are sent and received over the wire as this struct.
-type MatchingService_PollForActivityTask_Args struct {
-	PollRequest *PollForActivityTaskRequest `json:"pollRequest,omitempty"`
+// The arguments for PollForDecisionTask are sent and received over the wire as this struct.
+type MatchingService_PollForDecisionTask_Args struct {
+	PollRequest *PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
 }
 
-// ToWire translates a MatchingService_PollForActivityTask_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_PollForDecisionTask_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7654,7 +10405,7 @@ type MatchingService_PollForActivityTask_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_PollForActivityTask_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_PollForDecisionTask_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -7674,17 +10425,17 @@ func (v *MatchingService_PollForActivityTask_Args) ToWire() (wire.Value, error)
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForActivityTaskRequest_1_Read(w wire.Value) (*PollForActivityTaskRequest, error) {
-	var v PollForActivityTaskRequest
+func _PollForDecisionTaskRequest_1_Read(w wire.Value) (*PollForDecisionTaskRequest, error) {
+	var v PollForDecisionTaskRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_PollForActivityTask_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_PollForDecisionTask_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_PollForActivityTask_Args struct
+// An error is returned if we were unable to build a MatchingService_PollForDecisionTask_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7692,19 +10443,19 @@ func _PollForActivityTaskRequest_1_Read(w wire.Value) (*PollForActivityTaskReque
 //     return nil, err
 //   }
 //
-//   var v MatchingService_PollForActivityTask_Args
+//   var v MatchingService_PollForDecisionTask_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_PollForActivityTask_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_PollForDecisionTask_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.PollRequest, err = _PollForActivityTaskRequest_1_Read(field.Value)
+				v.PollRequest, err = _PollForDecisionTaskRequest_1_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -7716,9 +10467,9 @@ func (v *MatchingService_PollForActivityTask_Args) FromWire(w wire.Value) error
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_PollForActivityTask_Args
+// String returns a readable string representation of a MatchingService_PollForDecisionTask_Args
 // struct.
-func (v *MatchingService_PollForActivityTask_Args) String() string {
+func (v *MatchingService_PollForDecisionTask_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -7730,14 +10481,14 @@ func (v *MatchingService_PollForActivityTask_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_PollForActivityTask_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_PollForDecisionTask_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_PollForActivityTask_Args match the
-// provided MatchingService_PollForActivityTask_Args.
+// Equals returns true if all the fields of this MatchingService_PollForDecisionTask_Args match the
+// provided MatchingService_PollForDecisionTask_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_PollForActivityTask_Args) Equals(rhs *MatchingService_PollForActivityTask_Args) bool {
+func (v *MatchingService_PollForDecisionTask_Args) Equals(rhs *MatchingService_PollForDecisionTask_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -7751,8 +10502,8 @@ func (v *MatchingService_PollForActivityTask_Args) Equals(rhs *MatchingService_P
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_PollForActivityTask_Args.
-func (v *MatchingService_PollForActivityTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_PollForDecisionTask_Args.
+func (v *MatchingService_PollForDecisionTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -7764,7 +10515,7 @@ func (v *MatchingService_PollForActivityTask_Args) MarshalLogObject(enc zapcore.
 
 // GetPollRequest returns the value of PollRequest if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForActivityTask_Args) GetPollRequest() (o *PollForActivityTaskRequest) {
+func (v *MatchingService_PollForDecisionTask_Args) GetPollRequest() (o *PollForDecisionTaskRequest) {
 	if v != nil && v.PollRequest != nil {
 		return v.PollRequest
 	}
@@ -7773,80 +10524,80 @@ func (v *MatchingService_PollForActivityTask_Args) GetPollRequest() (o *PollForA
 }
 
 // IsSetPollRequest returns true if PollRequest is not nil.
-func (v *MatchingService_PollForActivityTask_Args) IsSetPollRequest() bool {
+func (v *MatchingService_PollForDecisionTask_Args) IsSetPollRequest() bool {
 	return v != nil && v.PollRequest != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "PollForActivityTask" for this struct.
-func (v *MatchingService_PollForActivityTask_Args) MethodName() string {
-	return "PollForActivityTask"
+// This will always be "PollForDecisionTask" for this struct.
+func (v *MatchingService_PollForDecisionTask_Args) MethodName() string {
+	return "PollForDecisionTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_PollForActivityTask_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_PollForDecisionTask_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_PollForActivityTask_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.PollForActivityTask
+// MatchingService_PollForDecisionTask_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.PollForDecisionTask
 // function.
-var MatchingService_PollForActivityTask_Helper = struct {
-	// Args accepts the parameters of PollForActivityTask in-order and returns
+var MatchingService_PollForDecisionTask_Helper = struct {
+	// Args accepts the parameters of PollForDecisionTask in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		pollRequest *PollForActivityTaskRequest,
-	) *MatchingService_PollForActivityTask_Args
+		pollRequest *PollForDecisionTaskRequest,
+	) *MatchingService_PollForDecisionTask_Args
 
 	// IsException returns true if the given error can be thrown
-	// by PollForActivityTask.
+	// by PollForDecisionTask.
 	//
-	// An error can be thrown by PollForActivityTask only if the
+	// An error can be thrown by PollForDecisionTask only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for PollForActivityTask
+	// WrapResponse returns the result struct for PollForDecisionTask
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// PollForActivityTask into a serializable result struct.
+	// PollForDecisionTask into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by PollForActivityTask
+	// error cannot be thrown by PollForDecisionTask
 	//
-	//   value, err := PollForActivityTask(args)
-	//   result, err := MatchingService_PollForActivityTask_Helper.WrapResponse(value, err)
+	//   value, err := PollForDecisionTask(args)
+	//   result, err := MatchingService_PollForDecisionTask_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from PollForActivityTask: %v", err)
+	//     return fmt.Errorf("unexpected error from PollForDecisionTask: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.PollForActivityTaskResponse, error) (*MatchingService_PollForActivityTask_Result, error)
+	WrapResponse func(*PollForDecisionTaskResponse, error) (*MatchingService_PollForDecisionTask_Result, error)
 
-	// UnwrapResponse takes the result struct for PollForActivityTask
+	// UnwrapResponse takes the result struct for PollForDecisionTask
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if PollForActivityTask threw an
+	// The error is non-nil only if PollForDecisionTask threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := MatchingService_PollForActivityTask_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_PollForActivityTask_Result) (*shared.PollForActivityTaskResponse, error)
+	//   value, err := MatchingService_PollForDecisionTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_PollForDecisionTask_Result) (*PollForDecisionTaskResponse, error)
 }{}
 
 func init() {
-	MatchingService_PollForActivityTask_Helper.Args = func(
-		pollRequest *PollForActivityTaskRequest,
-	) *MatchingService_PollForActivityTask_Args {
-		return &MatchingService_PollForActivityTask_Args{
+	MatchingService_PollForDecisionTask_Helper.Args = func(
+		pollRequest *PollForDecisionTaskRequest,
+	) *MatchingService_PollForDecisionTask_Args {
+		return &MatchingService_PollForDecisionTask_Args{
 			PollRequest: pollRequest,
 		}
 	}
 
-	MatchingService_PollForActivityTask_Helper.IsException = func(err error) bool {
+	MatchingService_PollForDecisionTask_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -7861,37 +10612,37 @@ func init() {
 		}
 	}
 
-	MatchingService_PollForActivityTask_Helper.WrapResponse = func(success *shared.PollForActivityTaskResponse, err error) (*MatchingService_PollForActivityTask_Result, error) {
+	MatchingService_PollForDecisionTask_Helper.WrapResponse = func(success *PollForDecisionTaskResponse, err error) (*MatchingService_PollForDecisionTask_Result, error) {
 		if err == nil {
-			return &MatchingService_PollForActivityTask_Result{Success: success}, nil
+			return &MatchingService_PollForDecisionTask_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.BadRequestError")
 			}
-			return &MatchingService_PollForActivityTask_Result{BadRequestError: e}, nil
+			return &MatchingService_PollForDecisionTask_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.InternalServiceError")
 			}
-			return &MatchingService_PollForActivityTask_Result{InternalServiceError: e}, nil
+			return &MatchingService_PollForDecisionTask_Result{InternalServiceError: e}, nil
 		case *shared.LimitExceededError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.LimitExceededError")
 			}
-			return &MatchingService_PollForActivityTask_Result{LimitExceededError: e}, nil
+			return &MatchingService_PollForDecisionTask_Result{LimitExceededError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.ServiceBusyError")
 			}
-			return &MatchingService_PollForActivityTask_Result{ServiceBusyError: e}, nil
+			return &MatchingService_PollForDecisionTask_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_PollForActivityTask_Helper.UnwrapResponse = func(result *MatchingService_PollForActivityTask_Result) (success *shared.PollForActivityTaskResponse, err error) {
+	MatchingService_PollForDecisionTask_Helper.UnwrapResponse = func(result *MatchingService_PollForDecisionTask_Result) (success *PollForDecisionTaskResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -7920,21 +10671,21 @@ func init() {
 
 }
 
-// MatchingService_PollForActivityTask_Result represents the result of a MatchingService.PollForActivityTask function call.
+// MatchingService_PollForDecisionTask_Result represents the result of a MatchingService.PollForDecisionTask function call.
 //
-// The result of a PollForActivityTask execution is sent and received over the wire as this struct.
+// The result of a PollForDecisionTask execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type MatchingService_PollForActivityTask_Result struct {
-	// Value returned by PollForActivityTask after a successful execution.
-	Success              *shared.PollForActivityTaskResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
-	LimitExceededError   *shared.LimitExceededError          `json:"limitExceededError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError            `json:"serviceBusyError,omitempty"`
+type MatchingService_PollForDecisionTask_Result struct {
+	// Value returned by PollForDecisionTask after a successful execution.
+	Success              *PollForDecisionTaskResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	LimitExceededError   *shared.LimitExceededError   `json:"limitExceededError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_PollForActivityTask_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_PollForDecisionTask_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7949,7 +10700,7 @@ type MatchingService_PollForActivityTask_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_PollForActivityTask_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_PollForDecisionTask_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [5]wire.Field
 		i      int = 0
@@ -7999,23 +10750,23 @@ func (v *MatchingService_PollForActivityTask_Result) ToWire() (wire.Value, error
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_PollForActivityTask_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("MatchingService_PollForDecisionTask_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForActivityTaskResponse_Read(w wire.Value) (*shared.PollForActivityTaskResponse, error) {
-	var v shared.PollForActivityTaskResponse
+func _PollForDecisionTaskResponse_Read(w wire.Value) (*PollForDecisionTaskResponse, error) {
+	var v PollForDecisionTaskResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_PollForActivityTask_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_PollForDecisionTask_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_PollForActivityTask_Result struct
+// An error is returned if we were unable to build a MatchingService_PollForDecisionTask_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -8023,19 +10774,19 @@ func _PollForActivityTaskResponse_Read(w wire.Value) (*shared.PollForActivityTas
 //     return nil, err
 //   }
 //
-//   var v MatchingService_PollForActivityTask_Result
+//   var v MatchingService_PollForDecisionTask_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_PollForActivityTask_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_PollForDecisionTask_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _PollForActivityTaskResponse_Read(field.Value)
+				v.Success, err = _PollForDecisionTaskResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -8093,15 +10844,15 @@ func (v *MatchingService_PollForActivityTask_Result) FromWire(w wire.Value) erro
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("MatchingService_PollForActivityTask_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_PollForDecisionTask_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_PollForActivityTask_Result
+// String returns a readable string representation of a MatchingService_PollForDecisionTask_Result
 // struct.
-func (v *MatchingService_PollForActivityTask_Result) String() string {
+func (v *MatchingService_PollForDecisionTask_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -8129,14 +10880,14 @@ func (v *MatchingService_PollForActivityTask_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_PollForActivityTask_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_PollForDecisionTask_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_PollForActivityTask_Result match the
-// provided MatchingService_PollForActivityTask_Result.
+// Equals returns true if all the fields of this MatchingService_PollForDecisionTask_Result match the
+// provided MatchingService_PollForDecisionTask_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_PollForActivityTask_Result) Equals(rhs *MatchingService_PollForActivityTask_Result) bool {
+func (v *MatchingService_PollForDecisionTask_Result) Equals(rhs *MatchingService_PollForDecisionTask_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -8162,8 +10913,8 @@ func (v *MatchingService_PollForActivityTask_Result) Equals(rhs *MatchingService
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_PollForActivityTask_Result.
-func (v *MatchingService_PollForActivityTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_PollForDecisionTask_Result.
+func (v *MatchingService_PollForDecisionTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -8187,7 +10938,7 @@ func (v *MatchingService_PollForActivityTask_Result) MarshalLogObject(enc zapcor
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForActivityTask_Result) GetSuccess() (o *shared.PollForActivityTaskResponse) {
+func (v *MatchingService_PollForDecisionTask_Result) GetSuccess() (o *PollForDecisionTaskResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -8196,13 +10947,13 @@ func (v *MatchingService_PollForActivityTask_Result) GetSuccess() (o *shared.Pol
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *MatchingService_PollForActivityTask_Result) IsSetSuccess() bool {
+func (v *MatchingService_PollForDecisionTask_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForActivityTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_PollForDecisionTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -8211,13 +10962,13 @@ func (v *MatchingService_PollForActivityTask_Result) GetBadRequestError() (o *sh
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_PollForActivityTask_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_PollForDecisionTask_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForActivityTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_PollForDecisionTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -8226,13 +10977,13 @@ func (v *MatchingService_PollForActivityTask_Result) GetInternalServiceError() (
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_PollForActivityTask_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_PollForDecisionTask_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetLimitExceededError returns the value of LimitExceededError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForActivityTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+func (v *MatchingService_PollForDecisionTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
 	if v != nil && v.LimitExceededError != nil {
 		return v.LimitExceededError
 	}
@@ -8241,13 +10992,13 @@ func (v *MatchingService_PollForActivityTask_Result) GetLimitExceededError() (o
 }
 
 // IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *MatchingService_PollForActivityTask_Result) IsSetLimitExceededError() bool {
+func (v *MatchingService_PollForDecisionTask_Result) IsSetLimitExceededError() bool {
 	return v != nil && v.LimitExceededError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForActivityTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_PollForDecisionTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -8256,33 +11007,33 @@ func (v *MatchingService_PollForActivityTask_Result) GetServiceBusyError() (o *s
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_PollForActivityTask_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_PollForDecisionTask_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "PollForActivityTask" for this struct.
-func (v *MatchingService_PollForActivityTask_Result) MethodName() string {
-	return "PollForActivityTask"
+// This will always be "PollForDecisionTask" for this struct.
+func (v *MatchingService_PollForDecisionTask_Result) MethodName() string {
+	return "PollForDecisionTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_PollForActivityTask_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_PollForDecisionTask_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_PollForDecisionTask_Args represents the arguments for the MatchingService.PollForDecisionTask function.
+// MatchingService_QueryWorkflow_Args represents the arguments for the MatchingService.QueryWorkflow function.
 //
-// The arguments for PollForDecisionTask are sent and received over the wire as this struct.
-type MatchingService_PollForDecisionTask_Args struct {
-	PollRequest *PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
+// The arguments for QueryWorkflow are sent and received over the wire as this struct.
+type MatchingService_QueryWorkflow_Args struct {
+	QueryRequest *QueryWorkflowRequest `json:"queryRequest,omitempty"`
 }
 
-// ToWire translates a MatchingService_PollForDecisionTask_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_QueryWorkflow_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -8297,7 +11048,7 @@ type MatchingService_PollForDecisionTask_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_PollForDecisionTask_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_QueryWorkflow_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -8305,8 +11056,8 @@ func (v *MatchingService_PollForDecisionTask_Args) ToWire() (wire.Value, error)
 		err    error
 	)
 
-	if v.PollRequest != nil {
-		w, err = v.PollRequest.ToWire()
+	if v.QueryRequest != nil {
+		w, err = v.QueryRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -8317,17 +11068,17 @@ func (v *MatchingService_PollForDecisionTask_Args) ToWire() (wire.Value, error)
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForDecisionTaskRequest_1_Read(w wire.Value) (*PollForDecisionTaskRequest, error) {
-	var v PollForDecisionTaskRequest
+func _QueryWorkflowRequest_1_Read(w wire.Value) (*QueryWorkflowRequest, error) {
+	var v QueryWorkflowRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_PollForDecisionTask_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_QueryWorkflow_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_PollForDecisionTask_Args struct
+// An error is returned if we were unable to build a MatchingService_QueryWorkflow_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -8335,19 +11086,19 @@ func _PollForDecisionTaskRequest_1_Read(w wire.Value) (*PollForDecisionTaskReque
 //     return nil, err
 //   }
 //
-//   var v MatchingService_PollForDecisionTask_Args
+//   var v MatchingService_QueryWorkflow_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_PollForDecisionTask_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_QueryWorkflow_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.PollRequest, err = _PollForDecisionTaskRequest_1_Read(field.Value)
+				v.QueryRequest, err = _QueryWorkflowRequest_1_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -8359,34 +11110,34 @@ func (v *MatchingService_PollForDecisionTask_Args) FromWire(w wire.Value) error
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_PollForDecisionTask_Args
+// String returns a readable string representation of a MatchingService_QueryWorkflow_Args
 // struct.
-func (v *MatchingService_PollForDecisionTask_Args) String() string {
+func (v *MatchingService_QueryWorkflow_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.PollRequest != nil {
-		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
+	if v.QueryRequest != nil {
+		fields[i] = fmt.Sprintf("QueryRequest: %v", v.QueryRequest)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_PollForDecisionTask_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_QueryWorkflow_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_PollForDecisionTask_Args match the
-// provided MatchingService_PollForDecisionTask_Args.
+// Equals returns true if all the fields of this MatchingService_QueryWorkflow_Args match the
+// provided MatchingService_QueryWorkflow_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_PollForDecisionTask_Args) Equals(rhs *MatchingService_PollForDecisionTask_Args) bool {
+func (v *MatchingService_QueryWorkflow_Args) Equals(rhs *MatchingService_QueryWorkflow_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
+	if !((v.QueryRequest == nil && rhs.QueryRequest == nil) || (v.QueryRequest != nil && rhs.QueryRequest != nil && v.QueryRequest.Equals(rhs.QueryRequest))) {
 		return false
 	}
 
@@ -8394,107 +11145,111 @@ func (v *MatchingService_PollForDecisionTask_Args) Equals(rhs *MatchingService_P
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_PollForDecisionTask_Args.
-func (v *MatchingService_PollForDecisionTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_QueryWorkflow_Args.
+func (v *MatchingService_QueryWorkflow_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.PollRequest != nil {
-		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
+	if v.QueryRequest != nil {
+		err = multierr.Append(err, enc.AddObject("queryRequest", v.QueryRequest))
 	}
 	return err
 }
 
-// GetPollRequest returns the value of PollRequest if it is set or its
+// GetQueryRequest returns the value of QueryRequest if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForDecisionTask_Args) GetPollRequest() (o *PollForDecisionTaskRequest) {
-	if v != nil && v.PollRequest != nil {
-		return v.PollRequest
+func (v *MatchingService_QueryWorkflow_Args) GetQueryRequest() (o *QueryWorkflowRequest) {
+	if v != nil && v.QueryRequest != nil {
+		return v.QueryRequest
 	}
 
 	return
 }
 
-// IsSetPollRequest returns true if PollRequest is not nil.
-func (v *MatchingService_PollForDecisionTask_Args) IsSetPollRequest() bool {
-	return v != nil && v.PollRequest != nil
+// IsSetQueryRequest returns true if QueryRequest is not nil.
+func (v *MatchingService_QueryWorkflow_Args) IsSetQueryRequest() bool {
+	return v != nil && v.QueryRequest != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "PollForDecisionTask" for this struct.
-func (v *MatchingService_PollForDecisionTask_Args) MethodName() string {
-	return "PollForDecisionTask"
+// This will always be "QueryWorkflow" for this struct.
+func (v *MatchingService_QueryWorkflow_Args) MethodName() string {
+	return "QueryWorkflow"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_PollForDecisionTask_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_QueryWorkflow_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_PollForDecisionTask_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.PollForDecisionTask
+// MatchingService_QueryWorkflow_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.QueryWorkflow
 // function.
-var MatchingService_PollForDecisionTask_Helper = struct {
-	// Args accepts the parameters of PollForDecisionTask in-order and returns
+var MatchingService_QueryWorkflow_Helper = struct {
+	// Args accepts the parameters of QueryWorkflow in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		pollRequest *PollForDecisionTaskRequest,
-	) *MatchingService_PollForDecisionTask_Args
+		queryRequest *QueryWorkflowRequest,
+	) *MatchingService_QueryWorkflow_Args
 
 	// IsException returns true if the given error can be thrown
-	// by PollForDecisionTask.
+	// by QueryWorkflow.
 	//
-	// An error can be thrown by PollForDecisionTask only if the
+	// An error can be thrown by QueryWorkflow only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for PollForDecisionTask
+	// WrapResponse returns the result struct for QueryWorkflow
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// PollForDecisionTask into a serializable result struct.
+	// QueryWorkflow into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by PollForDecisionTask
+	// error cannot be thrown by QueryWorkflow
 	//
-	//   value, err := PollForDecisionTask(args)
-	//   result, err := MatchingService_PollForDecisionTask_Helper.WrapResponse(value, err)
+	//   value, err := QueryWorkflow(args)
+	//   result, err := MatchingService_QueryWorkflow_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from PollForDecisionTask: %v", err)
+	//     return fmt.Errorf("unexpected error from QueryWorkflow: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*PollForDecisionTaskResponse, error) (*MatchingService_PollForDecisionTask_Result, error)
+	WrapResponse func(*shared.QueryWorkflowResponse, error) (*MatchingService_QueryWorkflow_Result, error)
 
-	// UnwrapResponse takes the result struct for PollForDecisionTask
+	// UnwrapResponse takes the result struct for QueryWorkflow
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if PollForDecisionTask threw an
+	// The error is non-nil only if QueryWorkflow threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := MatchingService_PollForDecisionTask_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_PollForDecisionTask_Result) (*PollForDecisionTaskResponse, error)
+	//   value, err := MatchingService_QueryWorkflow_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_QueryWorkflow_Result) (*shared.QueryWorkflowResponse, error)
 }{}
 
 func init() {
-	MatchingService_PollForDecisionTask_Helper.Args = func(
-		pollRequest *PollForDecisionTaskRequest,
-	) *MatchingService_PollForDecisionTask_Args {
-		return &MatchingService_PollForDecisionTask_Args{
-			PollRequest: pollRequest,
+	MatchingService_QueryWorkflow_Helper.Args = func(
+		queryRequest *QueryWorkflowRequest,
+	) *MatchingService_QueryWorkflow_Args {
+		return &MatchingService_QueryWorkflow_Args{
+			QueryRequest: queryRequest,
 		}
 	}
 
-	MatchingService_PollForDecisionTask_Helper.IsException = func(err error) bool {
+	MatchingService_QueryWorkflow_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.QueryFailedError:
+			return true
 		case *shared.LimitExceededError:
 			return true
 		case *shared.ServiceBusyError:
@@ -8504,37 +11259,47 @@ func init() {
 		}
 	}
 
-	MatchingService_PollForDecisionTask_Helper.WrapResponse = func(success *PollForDecisionTaskResponse, err error) (*MatchingService_PollForDecisionTask_Result, error) {
+	MatchingService_QueryWorkflow_Helper.WrapResponse = func(success *shared.QueryWorkflowResponse, err error) (*MatchingService_QueryWorkflow_Result, error) {
 		if err == nil {
-			return &MatchingService_PollForDecisionTask_Result{Success: success}, nil
+			return &MatchingService_QueryWorkflow_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.BadRequestError")
 			}
-			return &MatchingService_PollForDecisionTask_Result{BadRequestError: e}, nil
+			return &MatchingService_QueryWorkflow_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.InternalServiceError")
 			}
-			return &MatchingService_PollForDecisionTask_Result{InternalServiceError: e}, nil
+			return &MatchingService_QueryWorkflow_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.EntityNotExistError")
+			}
+			return &MatchingService_QueryWorkflow_Result{EntityNotExistError: e}, nil
+		case *shared.QueryFailedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.QueryFailedError")
+			}
+			return &MatchingService_QueryWorkflow_Result{QueryFailedError: e}, nil
 		case *shared.LimitExceededError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.LimitExceededError")
 			}
-			return &MatchingService_PollForDecisionTask_Result{LimitExceededError: e}, nil
+			return &MatchingService_QueryWorkflow_Result{LimitExceededError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.ServiceBusyError")
 			}
-			return &MatchingService_PollForDecisionTask_Result{ServiceBusyError: e}, nil
+			return &MatchingService_QueryWorkflow_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_PollForDecisionTask_Helper.UnwrapResponse = func(result *MatchingService_PollForDecisionTask_Result) (success *PollForDecisionTaskResponse, err error) {
+	MatchingService_QueryWorkflow_Helper.UnwrapResponse = func(result *MatchingService_QueryWorkflow_Result) (success *shared.QueryWorkflowResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -8543,6 +11308,14 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.QueryFailedError != nil {
+			err = result.QueryFailedError
+			return
+		}
 		if result.LimitExceededError != nil {
 			err = result.LimitExceededError
 			return
@@ -8563,21 +11336,23 @@ func init() {
 
 }
 
-// MatchingService_PollForDecisionTask_Result represents the result of a MatchingService.PollForDecisionTask function call.
+// MatchingService_QueryWorkflow_Result represents the result of a MatchingService.QueryWorkflow function call.
 //
-// The result of a PollForDecisionTask execution is sent and received over the wire as this struct.
+// The result of a QueryWorkflow execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type MatchingService_PollForDecisionTask_Result struct {
-	// Value returned by PollForDecisionTask after a successful execution.
-	Success              *PollForDecisionTaskResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	LimitExceededError   *shared.LimitExceededError   `json:"limitExceededError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+type MatchingService_QueryWorkflow_Result struct {
+	// Value returned by QueryWorkflow after a successful execution.
+	Success              *shared.QueryWorkflowResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError       `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError  `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError  `json:"entityNotExistError,omitempty"`
+	QueryFailedError     *shared.QueryFailedError      `json:"queryFailedError,omitempty"`
+	LimitExceededError   *shared.LimitExceededError    `json:"limitExceededError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError      `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_PollForDecisionTask_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_QueryWorkflow_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -8592,9 +11367,9 @@ type MatchingService_PollForDecisionTask_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_PollForDecisionTask_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_QueryWorkflow_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -8624,12 +11399,28 @@ func (v *MatchingService_PollForDecisionTask_Result) ToWire() (wire.Value, error
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.QueryFailedError != nil {
+		w, err = v.QueryFailedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
 	if v.LimitExceededError != nil {
 		w, err = v.LimitExceededError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
 	if v.ServiceBusyError != nil {
@@ -8637,28 +11428,34 @@ func (v *MatchingService_PollForDecisionTask_Result) ToWire() (wire.Value, error
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 4, Value: w}
+		fields[i] = wire.Field{ID: 6, Value: w}
 		i++
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_PollForDecisionTask_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("MatchingService_QueryWorkflow_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForDecisionTaskResponse_Read(w wire.Value) (*PollForDecisionTaskResponse, error) {
-	var v PollForDecisionTaskResponse
+func _QueryWorkflowResponse_Read(w wire.Value) (*shared.QueryWorkflowResponse, error) {
+	var v shared.QueryWorkflowResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_PollForDecisionTask_Result struct from its Thrift-level
+func _QueryFailedError_Read(w wire.Value) (*shared.QueryFailedError, error) {
+	var v shared.QueryFailedError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MatchingService_QueryWorkflow_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_PollForDecisionTask_Result struct
+// An error is returned if we were unable to build a MatchingService_QueryWorkflow_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -8666,19 +11463,19 @@ func _PollForDecisionTaskResponse_Read(w wire.Value) (*PollForDecisionTaskRespon
 //     return nil, err
 //   }
 //
-//   var v MatchingService_PollForDecisionTask_Result
+//   var v MatchingService_QueryWorkflow_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_PollForDecisionTask_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_QueryWorkflow_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _PollForDecisionTaskResponse_Read(field.Value)
+				v.Success, err = _QueryWorkflowResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -8702,13 +11499,29 @@ func (v *MatchingService_PollForDecisionTask_Result) FromWire(w wire.Value) erro
 			}
 		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.QueryFailedError, err = _QueryFailedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
 			if field.Value.Type() == wire.TStruct {
 				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
@@ -8729,6 +11542,12 @@ func (v *MatchingService_PollForDecisionTask_Result) FromWire(w wire.Value) erro
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.QueryFailedError != nil {
+		count++
+	}
 	if v.LimitExceededError != nil {
 		count++
 	}
@@ -8736,20 +11555,20 @@ func (v *MatchingService_PollForDecisionTask_Result) FromWire(w wire.Value) erro
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("MatchingService_PollForDecisionTask_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_QueryWorkflow_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_PollForDecisionTask_Result
+// String returns a readable string representation of a MatchingService_QueryWorkflow_Result
 // struct.
-func (v *MatchingService_PollForDecisionTask_Result) String() string {
+func (v *MatchingService_QueryWorkflow_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [7]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -8763,6 +11582,14 @@ func (v *MatchingService_PollForDecisionTask_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.QueryFailedError != nil {
+		fields[i] = fmt.Sprintf("QueryFailedError: %v", v.QueryFailedError)
+		i++
+	}
 	if v.LimitExceededError != nil {
 		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
 		i++
@@ -8772,14 +11599,14 @@ func (v *MatchingService_PollForDecisionTask_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_PollForDecisionTask_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_QueryWorkflow_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_PollForDecisionTask_Result match the
-// provided MatchingService_PollForDecisionTask_Result.
+// Equals returns true if all the fields of this MatchingService_QueryWorkflow_Result match the
+// provided MatchingService_QueryWorkflow_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_PollForDecisionTask_Result) Equals(rhs *MatchingService_PollForDecisionTask_Result) bool {
+func (v *MatchingService_QueryWorkflow_Result) Equals(rhs *MatchingService_QueryWorkflow_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -8794,6 +11621,12 @@ func (v *MatchingService_PollForDecisionTask_Result) Equals(rhs *MatchingService
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.QueryFailedError == nil && rhs.QueryFailedError == nil) || (v.QueryFailedError != nil && rhs.QueryFailedError != nil && v.QueryFailedError.Equals(rhs.QueryFailedError))) {
+		return false
+	}
 	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
 		return false
 	}
@@ -8805,8 +11638,8 @@ func (v *MatchingService_PollForDecisionTask_Result) Equals(rhs *MatchingService
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_PollForDecisionTask_Result.
-func (v *MatchingService_PollForDecisionTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_QueryWorkflow_Result.
+func (v *MatchingService_QueryWorkflow_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -8819,6 +11652,12 @@ func (v *MatchingService_PollForDecisionTask_Result) MarshalLogObject(enc zapcor
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.QueryFailedError != nil {
+		err = multierr.Append(err, enc.AddObject("queryFailedError", v.QueryFailedError))
+	}
 	if v.LimitExceededError != nil {
 		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
 	}
@@ -8830,7 +11669,7 @@ func (v *MatchingService_PollForDecisionTask_Result) MarshalLogObject(enc zapcor
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForDecisionTask_Result) GetSuccess() (o *PollForDecisionTaskResponse) {
+func (v *MatchingService_QueryWorkflow_Result) GetSuccess() (o *shared.QueryWorkflowResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -8839,13 +11678,13 @@ func (v *MatchingService_PollForDecisionTask_Result) GetSuccess() (o *PollForDec
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *MatchingService_PollForDecisionTask_Result) IsSetSuccess() bool {
+func (v *MatchingService_QueryWorkflow_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForDecisionTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_QueryWorkflow_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -8854,13 +11693,13 @@ func (v *MatchingService_PollForDecisionTask_Result) GetBadRequestError() (o *sh
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_PollForDecisionTask_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_QueryWorkflow_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForDecisionTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_QueryWorkflow_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -8869,13 +11708,43 @@ func (v *MatchingService_PollForDecisionTask_Result) GetInternalServiceError() (
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_PollForDecisionTask_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_QueryWorkflow_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_QueryWorkflow_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *MatchingService_QueryWorkflow_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetQueryFailedError returns the value of QueryFailedError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_QueryWorkflow_Result) GetQueryFailedError() (o *shared.QueryFailedError) {
+	if v != nil && v.QueryFailedError != nil {
+		return v.QueryFailedError
+	}
+
+	return
+}
+
+// IsSetQueryFailedError returns true if QueryFailedError is not nil.
+func (v *MatchingService_QueryWorkflow_Result) IsSetQueryFailedError() bool {
+	return v != nil && v.QueryFailedError != nil
+}
+
 // GetLimitExceededError returns the value of LimitExceededError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForDecisionTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+func (v *MatchingService_QueryWorkflow_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
 	if v != nil && v.LimitExceededError != nil {
 		return v.LimitExceededError
 	}
@@ -8884,13 +11753,13 @@ func (v *MatchingService_PollForDecisionTask_Result) GetLimitExceededError() (o
 }
 
 // IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *MatchingService_PollForDecisionTask_Result) IsSetLimitExceededError() bool {
+func (v *MatchingService_QueryWorkflow_Result) IsSetLimitExceededError() bool {
 	return v != nil && v.LimitExceededError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForDecisionTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_QueryWorkflow_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -8899,33 +11768,33 @@ func (v *MatchingService_PollForDecisionTask_Result) GetServiceBusyError() (o *s
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_PollForDecisionTask_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_QueryWorkflow_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "PollForDecisionTask" for this struct.
-func (v *MatchingService_PollForDecisionTask_Result) MethodName() string {
-	return "PollForDecisionTask"
+// This will always be "QueryWorkflow" for this struct.
+func (v *MatchingService_QueryWorkflow_Result) MethodName() string {
+	return "QueryWorkflow"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_PollForDecisionTask_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_QueryWorkflow_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_QueryWorkflow_Args represents the arguments for the MatchingService.QueryWorkflow function.
+// MatchingService_RespondQueryTaskCompleted_Args represents the arguments for the MatchingService.RespondQueryTaskCompleted function.
 //
-// The arguments for QueryWorkflow are sent and received over the wire as this struct.
-type MatchingService_QueryWorkflow_Args struct {
-	QueryRequest *QueryWorkflowRequest `json:"queryRequest,omitempty"`
+// The arguments for RespondQueryTaskCompleted are sent and received over the wire as this struct.
+type MatchingService_RespondQueryTaskCompleted_Args struct {
+	Request *RespondQueryTaskCompletedRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a MatchingService_QueryWorkflow_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_RespondQueryTaskCompleted_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -8940,7 +11809,7 @@ type MatchingService_QueryWorkflow_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_QueryWorkflow_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_RespondQueryTaskCompleted_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -8948,8 +11817,8 @@ func (v *MatchingService_QueryWorkflow_Args) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.QueryRequest != nil {
-		w, err = v.QueryRequest.ToWire()
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -8960,17 +11829,17 @@ func (v *MatchingService_QueryWorkflow_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _QueryWorkflowRequest_1_Read(w wire.Value) (*QueryWorkflowRequest, error) {
-	var v QueryWorkflowRequest
+func _RespondQueryTaskCompletedRequest_1_Read(w wire.Value) (*RespondQueryTaskCompletedRequest, error) {
+	var v RespondQueryTaskCompletedRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_QueryWorkflow_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_RespondQueryTaskCompleted_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_QueryWorkflow_Args struct
+// An error is returned if we were unable to build a MatchingService_RespondQueryTaskCompleted_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -8978,19 +11847,19 @@ func _QueryWorkflowRequest_1_Read(w wire.Value) (*QueryWorkflowRequest, error) {
 //     return nil, err
 //   }
 //
-//   var v MatchingService_QueryWorkflow_Args
+//   var v MatchingService_RespondQueryTaskCompleted_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_QueryWorkflow_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_RespondQueryTaskCompleted_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.QueryRequest, err = _QueryWorkflowRequest_1_Read(field.Value)
+				v.Request, err = _RespondQueryTaskCompletedRequest_1_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -9002,34 +11871,34 @@ func (v *MatchingService_QueryWorkflow_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_QueryWorkflow_Args
+// String returns a readable string representation of a MatchingService_RespondQueryTaskCompleted_Args
 // struct.
-func (v *MatchingService_QueryWorkflow_Args) String() string {
+func (v *MatchingService_RespondQueryTaskCompleted_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.QueryRequest != nil {
-		fields[i] = fmt.Sprintf("QueryRequest: %v", v.QueryRequest)
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_QueryWorkflow_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_RespondQueryTaskCompleted_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_QueryWorkflow_Args match the
-// provided MatchingService_QueryWorkflow_Args.
+// Equals returns true if all the fields of this MatchingService_RespondQueryTaskCompleted_Args match the
+// provided MatchingService_RespondQueryTaskCompleted_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_QueryWorkflow_Args) Equals(rhs *MatchingService_QueryWorkflow_Args) bool {
+func (v *MatchingService_RespondQueryTaskCompleted_Args) Equals(rhs *MatchingService_RespondQueryTaskCompleted_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.QueryRequest == nil && rhs.QueryRequest == nil) || (v.QueryRequest != nil && rhs.QueryRequest != nil && v.QueryRequest.Equals(rhs.QueryRequest))) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -9037,102 +11906,103 @@ func (v *MatchingService_QueryWorkflow_Args) Equals(rhs *MatchingService_QueryWo
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_QueryWorkflow_Args.
-func (v *MatchingService_QueryWorkflow_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_RespondQueryTaskCompleted_Args.
+func (v *MatchingService_RespondQueryTaskCompleted_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.QueryRequest != nil {
-		err = multierr.Append(err, enc.AddObject("queryRequest", v.QueryRequest))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetQueryRequest returns the value of QueryRequest if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Args) GetQueryRequest() (o *QueryWorkflowRequest) {
-	if v != nil && v.QueryRequest != nil {
-		return v.QueryRequest
+func (v *MatchingService_RespondQueryTaskCompleted_Args) GetRequest() (o *RespondQueryTaskCompletedRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetQueryRequest returns true if QueryRequest is not nil.
-func (v *MatchingService_QueryWorkflow_Args) IsSetQueryRequest() bool {
-	return v != nil && v.QueryRequest != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *MatchingService_RespondQueryTaskCompleted_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "QueryWorkflow" for this struct.
-func (v *MatchingService_QueryWorkflow_Args) MethodName() string {
-	return "QueryWorkflow"
+// This will always be "RespondQueryTaskCompleted" for this struct.
+func (v *MatchingService_RespondQueryTaskCompleted_Args) MethodName() string {
+	return "RespondQueryTaskCompleted"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_QueryWorkflow_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_RespondQueryTaskCompleted_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_QueryWorkflow_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.QueryWorkflow
+// MatchingService_RespondQueryTaskCompleted_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.RespondQueryTaskCompleted
 // function.
-var MatchingService_QueryWorkflow_Helper = struct {
-	// Args accepts the parameters of QueryWorkflow in-order and returns
+var MatchingService_RespondQueryTaskCompleted_Helper = struct {
+	// Args accepts the parameters of RespondQueryTaskCompleted in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		queryRequest *QueryWorkflowRequest,
-	) *MatchingService_QueryWorkflow_Args
+		request *RespondQueryTaskCompletedRequest,
+	) *MatchingService_RespondQueryTaskCompleted_Args
 
 	// IsException returns true if the given error can be thrown
-	// by QueryWorkflow.
+	// by RespondQueryTaskCompleted.
 	//
-	// An error can be thrown by QueryWorkflow only if the
+	// An error can be thrown by RespondQueryTaskCompleted only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for QueryWorkflow
-	// given its return value and error.
+	// WrapResponse returns the result struct for RespondQueryTaskCompleted
+	// given the error returned by it. The provided error may
+	// be nil if RespondQueryTaskCompleted did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// QueryWorkflow into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by QueryWorkflow
+	// This allows mapping errors returned by RespondQueryTaskCompleted into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// RespondQueryTaskCompleted
 	//
-	//   value, err := QueryWorkflow(args)
-	//   result, err := MatchingService_QueryWorkflow_Helper.WrapResponse(value, err)
+	//   err := RespondQueryTaskCompleted(args)
+	//   result, err := MatchingService_RespondQueryTaskCompleted_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from QueryWorkflow: %v", err)
+	//     return fmt.Errorf("unexpected error from RespondQueryTaskCompleted: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.QueryWorkflowResponse, error) (*MatchingService_QueryWorkflow_Result, error)
+	WrapResponse func(error) (*MatchingService_RespondQueryTaskCompleted_Result, error)
 
-	// UnwrapResponse takes the result struct for QueryWorkflow
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for RespondQueryTaskCompleted
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if QueryWorkflow threw an
+	// The error is non-nil only if RespondQueryTaskCompleted threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := MatchingService_QueryWorkflow_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_QueryWorkflow_Result) (*shared.QueryWorkflowResponse, error)
+	//   err := MatchingService_RespondQueryTaskCompleted_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_RespondQueryTaskCompleted_Result) error
 }{}
 
 func init() {
-	MatchingService_QueryWorkflow_Helper.Args = func(
-		queryRequest *QueryWorkflowRequest,
-	) *MatchingService_QueryWorkflow_Args {
-		return &MatchingService_QueryWorkflow_Args{
-			QueryRequest: queryRequest,
+	MatchingService_RespondQueryTaskCompleted_Helper.Args = func(
+		request *RespondQueryTaskCompletedRequest,
+	) *MatchingService_RespondQueryTaskCompleted_Args {
+		return &MatchingService_RespondQueryTaskCompleted_Args{
+			Request: request,
 		}
 	}
 
-	MatchingService_QueryWorkflow_Helper.IsException = func(err error) bool {
+	MatchingService_RespondQueryTaskCompleted_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -9140,8 +12010,6 @@ func init() {
 			return true
 		case *shared.EntityNotExistsError:
 			return true
-		case *shared.QueryFailedError:
-			return true
 		case *shared.LimitExceededError:
 			return true
 		case *shared.ServiceBusyError:
@@ -9151,47 +12019,42 @@ func init() {
 		}
 	}
 
-	MatchingService_QueryWorkflow_Helper.WrapResponse = func(success *shared.QueryWorkflowResponse, err error) (*MatchingService_QueryWorkflow_Result, error) {
+	MatchingService_RespondQueryTaskCompleted_Helper.WrapResponse = func(err error) (*MatchingService_RespondQueryTaskCompleted_Result, error) {
 		if err == nil {
-			return &MatchingService_QueryWorkflow_Result{Success: success}, nil
+			return &MatchingService_RespondQueryTaskCompleted_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.BadRequestError")
 			}
-			return &MatchingService_QueryWorkflow_Result{BadRequestError: e}, nil
+			return &MatchingService_RespondQueryTaskCompleted_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.InternalServiceError")
 			}
-			return &MatchingService_QueryWorkflow_Result{InternalServiceError: e}, nil
+			return &MatchingService_RespondQueryTaskCompleted_Result{InternalServiceError: e}, nil
 		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.EntityNotExistError")
-			}
-			return &MatchingService_QueryWorkflow_Result{EntityNotExistError: e}, nil
-		case *shared.QueryFailedError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.QueryFailedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.EntityNotExistError")
 			}
-			return &MatchingService_QueryWorkflow_Result{QueryFailedError: e}, nil
+			return &MatchingService_RespondQueryTaskCompleted_Result{EntityNotExistError: e}, nil
 		case *shared.LimitExceededError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.LimitExceededError")
 			}
-			return &MatchingService_QueryWorkflow_Result{LimitExceededError: e}, nil
+			return &MatchingService_RespondQueryTaskCompleted_Result{LimitExceededError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.ServiceBusyError")
 			}
-			return &MatchingService_QueryWorkflow_Result{ServiceBusyError: e}, nil
+			return &MatchingService_RespondQueryTaskCompleted_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_QueryWorkflow_Helper.UnwrapResponse = func(result *MatchingService_QueryWorkflow_Result) (success *shared.QueryWorkflowResponse, err error) {
+	MatchingService_RespondQueryTaskCompleted_Helper.UnwrapResponse = func(result *MatchingService_RespondQueryTaskCompleted_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -9204,10 +12067,6 @@ func init() {
 			err = result.EntityNotExistError
 			return
 		}
-		if result.QueryFailedError != nil {
-			err = result.QueryFailedError
-			return
-		}
 		if result.LimitExceededError != nil {
 			err = result.LimitExceededError
 			return
@@ -9216,35 +12075,23 @@ func init() {
 			err = result.ServiceBusyError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
-			return
-		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// MatchingService_QueryWorkflow_Result represents the result of a MatchingService.QueryWorkflow function call.
-//
-// The result of a QueryWorkflow execution is sent and received over the wire as this struct.
+// MatchingService_RespondQueryTaskCompleted_Result represents the result of a MatchingService.RespondQueryTaskCompleted function call.
 //
-// Success is set only if the function did not throw an exception.
-type MatchingService_QueryWorkflow_Result struct {
-	// Value returned by QueryWorkflow after a successful execution.
-	Success              *shared.QueryWorkflowResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError       `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError  `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError  `json:"entityNotExistError,omitempty"`
-	QueryFailedError     *shared.QueryFailedError      `json:"queryFailedError,omitempty"`
-	LimitExceededError   *shared.LimitExceededError    `json:"limitExceededError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError      `json:"serviceBusyError,omitempty"`
+// The result of a RespondQueryTaskCompleted execution is sent and received over the wire as this struct.
+type MatchingService_RespondQueryTaskCompleted_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+	LimitExceededError   *shared.LimitExceededError   `json:"limitExceededError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_QueryWorkflow_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_RespondQueryTaskCompleted_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -9259,22 +12106,14 @@ type MatchingService_QueryWorkflow_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_QueryWorkflow_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -9299,20 +12138,12 @@ func (v *MatchingService_QueryWorkflow_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
-	if v.QueryFailedError != nil {
-		w, err = v.QueryFailedError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
 	if v.LimitExceededError != nil {
 		w, err = v.LimitExceededError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 5, Value: w}
+		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
 	if v.ServiceBusyError != nil {
@@ -9320,34 +12151,22 @@ func (v *MatchingService_QueryWorkflow_Result) ToWire() (wire.Value, error) {
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 6, Value: w}
+		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_QueryWorkflow_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("MatchingService_RespondQueryTaskCompleted_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _QueryWorkflowResponse_Read(w wire.Value) (*shared.QueryWorkflowResponse, error) {
-	var v shared.QueryWorkflowResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _QueryFailedError_Read(w wire.Value) (*shared.QueryFailedError, error) {
-	var v shared.QueryFailedError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a MatchingService_QueryWorkflow_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_RespondQueryTaskCompleted_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_QueryWorkflow_Result struct
+// An error is returned if we were unable to build a MatchingService_RespondQueryTaskCompleted_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -9355,24 +12174,16 @@ func _QueryFailedError_Read(w wire.Value) (*shared.QueryFailedError, error) {
 //     return nil, err
 //   }
 //
-//   var v MatchingService_QueryWorkflow_Result
+//   var v MatchingService_RespondQueryTaskCompleted_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_QueryWorkflow_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
-			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _QueryWorkflowResponse_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -9398,14 +12209,6 @@ func (v *MatchingService_QueryWorkflow_Result) FromWire(w wire.Value) error {
 
 			}
 		case 4:
-			if field.Value.Type() == wire.TStruct {
-				v.QueryFailedError, err = _QueryFailedError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 5:
 			if field.Value.Type() == wire.TStruct {
 				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
 				if err != nil {
@@ -9413,7 +12216,7 @@ func (v *MatchingService_QueryWorkflow_Result) FromWire(w wire.Value) error {
 				}
 
 			}
-		case 6:
+		case 5:
 			if field.Value.Type() == wire.TStruct {
 				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
@@ -9425,9 +12228,6 @@ func (v *MatchingService_QueryWorkflow_Result) FromWire(w wire.Value) error {
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
@@ -9437,35 +12237,28 @@ func (v *MatchingService_QueryWorkflow_Result) FromWire(w wire.Value) error {
 	if v.EntityNotExistError != nil {
 		count++
 	}
-	if v.QueryFailedError != nil {
-		count++
-	}
 	if v.LimitExceededError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("MatchingService_QueryWorkflow_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("MatchingService_RespondQueryTaskCompleted_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_QueryWorkflow_Result
+// String returns a readable string representation of a MatchingService_RespondQueryTaskCompleted_Result
 // struct.
-func (v *MatchingService_QueryWorkflow_Result) String() string {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [5]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -9478,10 +12271,6 @@ func (v *MatchingService_QueryWorkflow_Result) String() string {
 		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
 		i++
 	}
-	if v.QueryFailedError != nil {
-		fields[i] = fmt.Sprintf("QueryFailedError: %v", v.QueryFailedError)
-		i++
-	}
 	if v.LimitExceededError != nil {
 		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
 		i++
@@ -9491,22 +12280,19 @@ func (v *MatchingService_QueryWorkflow_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_QueryWorkflow_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_RespondQueryTaskCompleted_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_QueryWorkflow_Result match the
-// provided MatchingService_QueryWorkflow_Result.
+// Equals returns true if all the fields of this MatchingService_RespondQueryTaskCompleted_Result match the
+// provided MatchingService_RespondQueryTaskCompleted_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_QueryWorkflow_Result) Equals(rhs *MatchingService_QueryWorkflow_Result) bool {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) Equals(rhs *MatchingService_RespondQueryTaskCompleted_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
@@ -9516,9 +12302,6 @@ func (v *MatchingService_QueryWorkflow_Result) Equals(rhs *MatchingService_Query
 	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
 		return false
 	}
-	if !((v.QueryFailedError == nil && rhs.QueryFailedError == nil) || (v.QueryFailedError != nil && rhs.QueryFailedError != nil && v.QueryFailedError.Equals(rhs.QueryFailedError))) {
-		return false
-	}
 	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
 		return false
 	}
@@ -9530,14 +12313,11 @@ func (v *MatchingService_QueryWorkflow_Result) Equals(rhs *MatchingService_Query
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_QueryWorkflow_Result.
-func (v *MatchingService_QueryWorkflow_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_RespondQueryTaskCompleted_Result.
+func (v *MatchingService_RespondQueryTaskCompleted_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
@@ -9547,36 +12327,18 @@ func (v *MatchingService_QueryWorkflow_Result) MarshalLogObject(enc zapcore.Obje
 	if v.EntityNotExistError != nil {
 		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
 	}
-	if v.QueryFailedError != nil {
-		err = multierr.Append(err, enc.AddObject("queryFailedError", v.QueryFailedError))
-	}
 	if v.LimitExceededError != nil {
 		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
-	}
-	if v.ServiceBusyError != nil {
-		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
-	}
-	return err
-}
-
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetSuccess() (o *shared.QueryWorkflowResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
-	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	return err
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -9585,13 +12347,13 @@ func (v *MatchingService_QueryWorkflow_Result) GetBadRequestError() (o *shared.B
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -9600,13 +12362,13 @@ func (v *MatchingService_QueryWorkflow_Result) GetInternalServiceError() (o *sha
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
 	if v != nil && v.EntityNotExistError != nil {
 		return v.EntityNotExistError
 	}
@@ -9615,28 +12377,13 @@ func (v *MatchingService_QueryWorkflow_Result) GetEntityNotExistError() (o *shar
 }
 
 // IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetEntityNotExistError() bool {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetEntityNotExistError() bool {
 	return v != nil && v.EntityNotExistError != nil
 }
 
-// GetQueryFailedError returns the value of QueryFailedError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetQueryFailedError() (o *shared.QueryFailedError) {
-	if v != nil && v.QueryFailedError != nil {
-		return v.QueryFailedError
-	}
-
-	return
-}
-
-// IsSetQueryFailedError returns true if QueryFailedError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetQueryFailedError() bool {
-	return v != nil && v.QueryFailedError != nil
-}
-
 // GetLimitExceededError returns the value of LimitExceededError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
 	if v != nil && v.LimitExceededError != nil {
 		return v.LimitExceededError
 	}
@@ -9645,13 +12392,13 @@ func (v *MatchingService_QueryWorkflow_Result) GetLimitExceededError() (o *share
 }
 
 // IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetLimitExceededError() bool {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetLimitExceededError() bool {
 	return v != nil && v.LimitExceededError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -9660,33 +12407,33 @@ func (v *MatchingService_QueryWorkflow_Result) GetServiceBusyError() (o *shared.
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "QueryWorkflow" for this struct.
-func (v *MatchingService_QueryWorkflow_Result) MethodName() string {
-	return "QueryWorkflow"
+// This will always be "RespondQueryTaskCompleted" for this struct.
+func (v *MatchingService_RespondQueryTaskCompleted_Result) MethodName() string {
+	return "RespondQueryTaskCompleted"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_QueryWorkflow_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_RespondQueryTaskCompleted_Args represents the arguments for the MatchingService.RespondQueryTaskCompleted function.
+// MatchingService_ResumeTaskList_Args represents the arguments for the MatchingService.ResumeTaskList function.
 //
-// The arguments for RespondQueryTaskCompleted are sent and received over the wire as this struct.
-type MatchingService_RespondQueryTaskCompleted_Args struct {
-	Request *RespondQueryTaskCompletedRequest `json:"request,omitempty"`
+// The arguments for ResumeTaskList are sent and received over the wire as this struct.
+type MatchingService_ResumeTaskList_Args struct {
+	Request *ResumeTaskListRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a MatchingService_RespondQueryTaskCompleted_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_ResumeTaskList_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -9701,7 +12448,7 @@ type MatchingService_RespondQueryTaskCompleted_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_RespondQueryTaskCompleted_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_ResumeTaskList_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -9721,17 +12468,17 @@ func (v *MatchingService_RespondQueryTaskCompleted_Args) ToWire() (wire.Value, e
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RespondQueryTaskCompletedRequest_1_Read(w wire.Value) (*RespondQueryTaskCompletedRequest, error) {
-	var v RespondQueryTaskCompletedRequest
+func _ResumeTaskListRequest_Read(w wire.Value) (*ResumeTaskListRequest, error) {
+	var v ResumeTaskListRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_RespondQueryTaskCompleted_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_ResumeTaskList_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_RespondQueryTaskCompleted_Args struct
+// An error is returned if we were unable to build a MatchingService_ResumeTaskList_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -9739,19 +12486,19 @@ func _RespondQueryTaskCompletedRequest_1_Read(w wire.Value) (*RespondQueryTaskCo
 //     return nil, err
 //   }
 //
-//   var v MatchingService_RespondQueryTaskCompleted_Args
+//   var v MatchingService_ResumeTaskList_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_RespondQueryTaskCompleted_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_ResumeTaskList_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _RespondQueryTaskCompletedRequest_1_Read(field.Value)
+				v.Request, err = _ResumeTaskListRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -9763,9 +12510,9 @@ func (v *MatchingService_RespondQueryTaskCompleted_Args) FromWire(w wire.Value)
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_RespondQueryTaskCompleted_Args
+// String returns a readable string representation of a MatchingService_ResumeTaskList_Args
 // struct.
-func (v *MatchingService_RespondQueryTaskCompleted_Args) String() string {
+func (v *MatchingService_ResumeTaskList_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -9777,14 +12524,14 @@ func (v *MatchingService_RespondQueryTaskCompleted_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_RespondQueryTaskCompleted_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_ResumeTaskList_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_RespondQueryTaskCompleted_Args match the
-// provided MatchingService_RespondQueryTaskCompleted_Args.
+// Equals returns true if all the fields of this MatchingService_ResumeTaskList_Args match the
+// provided MatchingService_ResumeTaskList_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_RespondQueryTaskCompleted_Args) Equals(rhs *MatchingService_RespondQueryTaskCompleted_Args) bool {
+func (v *MatchingService_ResumeTaskList_Args) Equals(rhs *MatchingService_ResumeTaskList_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -9798,8 +12545,8 @@ func (v *MatchingService_RespondQueryTaskCompleted_Args) Equals(rhs *MatchingSer
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_RespondQueryTaskCompleted_Args.
-func (v *MatchingService_RespondQueryTaskCompleted_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_ResumeTaskList_Args.
+func (v *MatchingService_ResumeTaskList_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -9811,7 +12558,7 @@ func (v *MatchingService_RespondQueryTaskCompleted_Args) MarshalLogObject(enc za
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_RespondQueryTaskCompleted_Args) GetRequest() (o *RespondQueryTaskCompletedRequest) {
+func (v *MatchingService_ResumeTaskList_Args) GetRequest() (o *ResumeTaskListRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -9820,90 +12567,86 @@ func (v *MatchingService_RespondQueryTaskCompleted_Args) GetRequest() (o *Respon
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *MatchingService_RespondQueryTaskCompleted_Args) IsSetRequest() bool {
+func (v *MatchingService_ResumeTaskList_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "RespondQueryTaskCompleted" for this struct.
-func (v *MatchingService_RespondQueryTaskCompleted_Args) MethodName() string {
-	return "RespondQueryTaskCompleted"
+// This will always be "ResumeTaskList" for this struct.
+func (v *MatchingService_ResumeTaskList_Args) MethodName() string {
+	return "ResumeTaskList"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_RespondQueryTaskCompleted_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_ResumeTaskList_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_RespondQueryTaskCompleted_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.RespondQueryTaskCompleted
+// MatchingService_ResumeTaskList_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.ResumeTaskList
 // function.
-var MatchingService_RespondQueryTaskCompleted_Helper = struct {
-	// Args accepts the parameters of RespondQueryTaskCompleted in-order and returns
+var MatchingService_ResumeTaskList_Helper = struct {
+	// Args accepts the parameters of ResumeTaskList in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *RespondQueryTaskCompletedRequest,
-	) *MatchingService_RespondQueryTaskCompleted_Args
+		request *ResumeTaskListRequest,
+	) *MatchingService_ResumeTaskList_Args
 
 	// IsException returns true if the given error can be thrown
-	// by RespondQueryTaskCompleted.
+	// by ResumeTaskList.
 	//
-	// An error can be thrown by RespondQueryTaskCompleted only if the
+	// An error can be thrown by ResumeTaskList only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for RespondQueryTaskCompleted
+	// WrapResponse returns the result struct for ResumeTaskList
 	// given the error returned by it. The provided error may
-	// be nil if RespondQueryTaskCompleted did not fail.
+	// be nil if ResumeTaskList did not fail.
 	//
-	// This allows mapping errors returned by RespondQueryTaskCompleted into a
+	// This allows mapping errors returned by ResumeTaskList into a
 	// serializable result struct. WrapResponse returns a
 	// non-nil error if the provided error cannot be thrown by
-	// RespondQueryTaskCompleted
+	// ResumeTaskList
 	//
-	//   err := RespondQueryTaskCompleted(args)
-	//   result, err := MatchingService_RespondQueryTaskCompleted_Helper.WrapResponse(err)
+	//   err := ResumeTaskList(args)
+	//   result, err := MatchingService_ResumeTaskList_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from RespondQueryTaskCompleted: %v", err)
+	//     return fmt.Errorf("unexpected error from ResumeTaskList: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*MatchingService_RespondQueryTaskCompleted_Result, error)
+	WrapResponse func(error) (*MatchingService_ResumeTaskList_Result, error)
 
-	// UnwrapResponse takes the result struct for RespondQueryTaskCompleted
+	// UnwrapResponse takes the result struct for ResumeTaskList
 	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if RespondQueryTaskCompleted threw an
+	// The error is non-nil only if ResumeTaskList threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := MatchingService_RespondQueryTaskCompleted_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_RespondQueryTaskCompleted_Result) error
+	//   err := MatchingService_ResumeTaskList_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_ResumeTaskList_Result) error
 }{}
 
 func init() {
-	MatchingService_RespondQueryTaskCompleted_Helper.Args = func(
-		request *RespondQueryTaskCompletedRequest,
-	) *MatchingService_RespondQueryTaskCompleted_Args {
-		return &MatchingService_RespondQueryTaskCompleted_Args{
+	MatchingService_ResumeTaskList_Helper.Args = func(
+		request *ResumeTaskListRequest,
+	) *MatchingService_ResumeTaskList_Args {
+		return &MatchingService_ResumeTaskList_Args{
 			Request: request,
 		}
 	}
 
-	MatchingService_RespondQueryTaskCompleted_Helper.IsException = func(err error) bool {
+	MatchingService_ResumeTaskList_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.EntityNotExistsError:
-			return true
-		case *shared.LimitExceededError:
-			return true
 		case *shared.ServiceBusyError:
 			return true
 		default:
@@ -9911,42 +12654,32 @@ func init() {
 		}
 	}
 
-	MatchingService_RespondQueryTaskCompleted_Helper.WrapResponse = func(err error) (*MatchingService_RespondQueryTaskCompleted_Result, error) {
+	MatchingService_ResumeTaskList_Helper.WrapResponse = func(err error) (*MatchingService_ResumeTaskList_Result, error) {
 		if err == nil {
-			return &MatchingService_RespondQueryTaskCompleted_Result{}, nil
+			return &MatchingService_ResumeTaskList_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_ResumeTaskList_Result.BadRequestError")
 			}
-			return &MatchingService_RespondQueryTaskCompleted_Result{BadRequestError: e}, nil
+			return &MatchingService_ResumeTaskList_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.InternalServiceError")
-			}
-			return &MatchingService_RespondQueryTaskCompleted_Result{InternalServiceError: e}, nil
-		case *shared.EntityNotExistsError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.EntityNotExistError")
-			}
-			return &MatchingService_RespondQueryTaskCompleted_Result{EntityNotExistError: e}, nil
-		case *shared.LimitExceededError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_ResumeTaskList_Result.InternalServiceError")
 			}
-			return &MatchingService_RespondQueryTaskCompleted_Result{LimitExceededError: e}, nil
+			return &MatchingService_ResumeTaskList_Result{InternalServiceError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_ResumeTaskList_Result.ServiceBusyError")
 			}
-			return &MatchingService_RespondQueryTaskCompleted_Result{ServiceBusyError: e}, nil
+			return &MatchingService_ResumeTaskList_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_RespondQueryTaskCompleted_Helper.UnwrapResponse = func(result *MatchingService_RespondQueryTaskCompleted_Result) (err error) {
+	MatchingService_ResumeTaskList_Helper.UnwrapResponse = func(result *MatchingService_ResumeTaskList_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -9955,14 +12688,6 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
-			return
-		}
-		if result.LimitExceededError != nil {
-			err = result.LimitExceededError
-			return
-		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
@@ -9972,18 +12697,16 @@ func init() {
 
 }
 
-// MatchingService_RespondQueryTaskCompleted_Result represents the result of a MatchingService.RespondQueryTaskCompleted function call.
+// MatchingService_ResumeTaskList_Result represents the result of a MatchingService.ResumeTaskList function call.
 //
-// The result of a RespondQueryTaskCompleted execution is sent and received over the wire as this struct.
-type MatchingService_RespondQueryTaskCompleted_Result struct {
+// The result of a ResumeTaskList execution is sent and received over the wire as this struct.
+type MatchingService_ResumeTaskList_Result struct {
 	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
 	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
-	LimitExceededError   *shared.LimitExceededError   `json:"limitExceededError,omitempty"`
 	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_RespondQueryTaskCompleted_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_ResumeTaskList_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -9998,9 +12721,9 @@ type MatchingService_RespondQueryTaskCompleted_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_RespondQueryTaskCompleted_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_ResumeTaskList_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -10022,43 +12745,27 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) ToWire() (wire.Value,
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		w, err = v.EntityNotExistError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 3, Value: w}
-		i++
-	}
-	if v.LimitExceededError != nil {
-		w, err = v.LimitExceededError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
 	if v.ServiceBusyError != nil {
 		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 5, Value: w}
+		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
 
 	if i > 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_RespondQueryTaskCompleted_Result should have at most one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("MatchingService_ResumeTaskList_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a MatchingService_RespondQueryTaskCompleted_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_ResumeTaskList_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_RespondQueryTaskCompleted_Result struct
+// An error is returned if we were unable to build a MatchingService_ResumeTaskList_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -10066,12 +12773,12 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) ToWire() (wire.Value,
 //     return nil, err
 //   }
 //
-//   var v MatchingService_RespondQueryTaskCompleted_Result
+//   var v MatchingService_ResumeTaskList_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_RespondQueryTaskCompleted_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_ResumeTaskList_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -10091,24 +12798,8 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) FromWire(w wire.Value
 					return err
 				}
 
-			}
-		case 3:
-			if field.Value.Type() == wire.TStruct {
-				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
 			}
 		case 4:
-			if field.Value.Type() == wire.TStruct {
-				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 5:
 			if field.Value.Type() == wire.TStruct {
 				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
@@ -10126,30 +12817,24 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) FromWire(w wire.Value
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
-		count++
-	}
-	if v.LimitExceededError != nil {
-		count++
-	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("MatchingService_RespondQueryTaskCompleted_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_ResumeTaskList_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_RespondQueryTaskCompleted_Result
+// String returns a readable string representation of a MatchingService_ResumeTaskList_Result
 // struct.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) String() string {
+func (v *MatchingService_ResumeTaskList_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [3]string
 	i := 0
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
@@ -10159,27 +12844,19 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
-		i++
-	}
-	if v.LimitExceededError != nil {
-		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
-		i++
-	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_RespondQueryTaskCompleted_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_ResumeTaskList_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_RespondQueryTaskCompleted_Result match the
-// provided MatchingService_RespondQueryTaskCompleted_Result.
+// Equals returns true if all the fields of this MatchingService_ResumeTaskList_Result match the
+// provided MatchingService_ResumeTaskList_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) Equals(rhs *MatchingService_RespondQueryTaskCompleted_Result) bool {
+func (v *MatchingService_ResumeTaskList_Result) Equals(rhs *MatchingService_ResumeTaskList_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -10191,12 +12868,6 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) Equals(rhs *MatchingS
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
-		return false
-	}
-	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
-		return false
-	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
@@ -10205,8 +12876,8 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) Equals(rhs *MatchingS
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_RespondQueryTaskCompleted_Result.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_ResumeTaskList_Result.
+func (v *MatchingService_ResumeTaskList_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -10216,12 +12887,6 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) MarshalLogObject(enc
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.EntityNotExistError != nil {
-		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
-	}
-	if v.LimitExceededError != nil {
-		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
-	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
@@ -10230,7 +12895,7 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) MarshalLogObject(enc
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_ResumeTaskList_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -10239,13 +12904,13 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) GetBadRequestError()
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_ResumeTaskList_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_ResumeTaskList_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -10254,43 +12919,13 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) GetInternalServiceErr
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_ResumeTaskList_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
-	if v != nil && v.EntityNotExistError != nil {
-		return v.EntityNotExistError
-	}
-
-	return
-}
-
-// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetEntityNotExistError() bool {
-	return v != nil && v.EntityNotExistError != nil
-}
-
-// GetLimitExceededError returns the value of LimitExceededError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
-	if v != nil && v.LimitExceededError != nil {
-		return v.LimitExceededError
-	}
-
-	return
-}
-
-// IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetLimitExceededError() bool {
-	return v != nil && v.LimitExceededError != nil
-}
-
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_ResumeTaskList_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -10299,21 +12934,21 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) GetServiceBusyError()
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_ResumeTaskList_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "RespondQueryTaskCompleted" for this struct.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) MethodName() string {
-	return "RespondQueryTaskCompleted"
+// This will always be "ResumeTaskList" for this struct.
+func (v *MatchingService_ResumeTaskList_Result) MethodName() string {
+	return "ResumeTaskList"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_ResumeTaskList_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
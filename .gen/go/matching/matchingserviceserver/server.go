@@ -69,6 +69,16 @@ type Interface interface {
 		Request *matching.ListTaskListPartitionsRequest,
 	) (*shared.ListTaskListPartitionsResponse, error)
 
+	MoveTaskListBacklog(
+		ctx context.Context,
+		Request *matching.MoveTaskListBacklogRequest,
+	) (*shared.MoveTaskListBacklogResponse, error)
+
+	PauseTaskList(
+		ctx context.Context,
+		Request *matching.PauseTaskListRequest,
+	) error
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *matching.PollForActivityTaskRequest,
@@ -88,6 +98,11 @@ type Interface interface {
 		ctx context.Context,
 		Request *matching.RespondQueryTaskCompletedRequest,
 	) error
+
+	ResumeTaskList(
+		ctx context.Context,
+		Request *matching.ResumeTaskListRequest,
+	) error
 }
 
 // New prepares an implementation of the MatchingService service for
@@ -167,6 +182,28 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: matching.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "MoveTaskListBacklog",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.MoveTaskListBacklog),
+				},
+				Signature:    "MoveTaskListBacklog(Request *matching.MoveTaskListBacklogRequest) (*shared.MoveTaskListBacklogResponse)",
+				ThriftModule: matching.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PauseTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PauseTaskList),
+				},
+				Signature:    "PauseTaskList(Request *matching.PauseTaskListRequest)",
+				ThriftModule: matching.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PollForActivityTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -210,10 +247,21 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "RespondQueryTaskCompleted(Request *matching.RespondQueryTaskCompletedRequest)",
 				ThriftModule: matching.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "ResumeTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ResumeTaskList),
+				},
+				Signature:    "ResumeTaskList(Request *matching.ResumeTaskListRequest)",
+				ThriftModule: matching.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 10)
+	procedures := make([]transport.Procedure, 0, 13)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -404,6 +452,66 @@ func (h handler) ListTaskListPartitions(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
+func (h handler) MoveTaskListBacklog(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_MoveTaskListBacklog_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'MatchingService' procedure 'MoveTaskListBacklog': %w", err)
+	}
+
+	success, appErr := h.impl.MoveTaskListBacklog(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_MoveTaskListBacklog_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) PauseTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_PauseTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'MatchingService' procedure 'PauseTaskList': %w", err)
+	}
+
+	appErr := h.impl.PauseTaskList(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_PauseTaskList_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) PollForActivityTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args matching.MatchingService_PollForActivityTask_Args
 	if err := args.FromWire(body); err != nil {
@@ -523,3 +631,33 @@ func (h handler) RespondQueryTaskCompleted(ctx context.Context, body wire.Value)
 
 	return response, err
 }
+
+func (h handler) ResumeTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_ResumeTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'MatchingService' procedure 'ResumeTaskList': %w", err)
+	}
+
+	appErr := h.impl.ResumeTaskList(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_ResumeTaskList_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
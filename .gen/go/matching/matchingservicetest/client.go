@@ -259,6 +259,70 @@ func (mr *_MockClientRecorder) ListTaskListPartitions(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ListTaskListPartitions", args...)
 }
 
+// MoveTaskListBacklog responds to a MoveTaskListBacklog call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().MoveTaskListBacklog(gomock.Any(), ...).Return(...)
+// 	... := client.MoveTaskListBacklog(...)
+func (m *MockClient) MoveTaskListBacklog(
+	ctx context.Context,
+	_Request *matching.MoveTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.MoveTaskListBacklogResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "MoveTaskListBacklog", args...)
+	success, _ = ret[i].(*shared.MoveTaskListBacklogResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) MoveTaskListBacklog(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "MoveTaskListBacklog", args...)
+}
+
+// PauseTaskList responds to a PauseTaskList call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().PauseTaskList(gomock.Any(), ...).Return(...)
+// 	... := client.PauseTaskList(...)
+func (m *MockClient) PauseTaskList(
+	ctx context.Context,
+	_Request *matching.PauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PauseTaskList", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PauseTaskList(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PauseTaskList", args...)
+}
+
 // PollForActivityTask responds to a PollForActivityTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -388,3 +452,34 @@ func (mr *_MockClientRecorder) RespondQueryTaskCompleted(
 	args := append([]interface{}{ctx, _Request}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "RespondQueryTaskCompleted", args...)
 }
+
+// ResumeTaskList responds to a ResumeTaskList call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ResumeTaskList(gomock.Any(), ...).Return(...)
+// 	... := client.ResumeTaskList(...)
+func (m *MockClient) ResumeTaskList(
+	ctx context.Context,
+	_Request *matching.ResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResumeTaskList", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResumeTaskList(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResumeTaskList", args...)
+}
@@ -71,6 +71,7 @@ type ActivityInfo struct {
 	RetryLastFailureReason        *string  `json:"retryLastFailureReason,omitempty"`
 	RetryLastWorkerIdentity       *string  `json:"retryLastWorkerIdentity,omitempty"`
 	RetryLastFailureDetails       []byte   `json:"retryLastFailureDetails,omitempty"`
+	Paused                        *bool    `json:"paused,omitempty"`
 }
 
 type _List_String_ValueList []string
@@ -116,7 +117,7 @@ func (_List_String_ValueList) Close() {}
 //   }
 func (v *ActivityInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [31]wire.Field
+		fields [32]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -370,6 +371,14 @@ func (v *ActivityInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
+	if v.Paused != nil {
+		w, err = wire.NewValueBool(*(v.Paused)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 72, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -715,6 +724,16 @@ func (v *ActivityInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 72:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Paused = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -729,7 +748,7 @@ func (v *ActivityInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [31]string
+	var fields [32]string
 	i := 0
 	if v.Version != nil {
 		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
@@ -855,6 +874,10 @@ func (v *ActivityInfo) String() string {
 		fields[i] = fmt.Sprintf("RetryLastFailureDetails: %v", v.RetryLastFailureDetails)
 		i++
 	}
+	if v.Paused != nil {
+		fields[i] = fmt.Sprintf("Paused: %v", *(v.Paused))
+		i++
+	}
 
 	return fmt.Sprintf("ActivityInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -1027,6 +1050,9 @@ func (v *ActivityInfo) Equals(rhs *ActivityInfo) bool {
 	if !((v.RetryLastFailureDetails == nil && rhs.RetryLastFailureDetails == nil) || (v.RetryLastFailureDetails != nil && rhs.RetryLastFailureDetails != nil && bytes.Equal(v.RetryLastFailureDetails, rhs.RetryLastFailureDetails))) {
 		return false
 	}
+	if !_Bool_EqualsPtr(v.Paused, rhs.Paused) {
+		return false
+	}
 
 	return true
 }
@@ -1141,6 +1167,9 @@ func (v *ActivityInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.RetryLastFailureDetails != nil {
 		enc.AddString("retryLastFailureDetails", base64.StdEncoding.EncodeToString(v.RetryLastFailureDetails))
 	}
+	if v.Paused != nil {
+		enc.AddBool("paused", *v.Paused)
+	}
 	return err
 }
 
@@ -1609,6 +1638,21 @@ func (v *ActivityInfo) IsSetRetryLastFailureDetails() bool {
 	return v != nil && v.RetryLastFailureDetails != nil
 }
 
+// GetPaused returns the value of Paused if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetPaused() (o bool) {
+	if v != nil && v.Paused != nil {
+		return *v.Paused
+	}
+
+	return
+}
+
+// IsSetPaused returns true if Paused is not nil.
+func (v *ActivityInfo) IsSetPaused() bool {
+	return v != nil && v.Paused != nil
+}
+
 type ChildExecutionInfo struct {
 	Version                *int64  `json:"version,omitempty"`
 	InitiatedEventBatchID  *int64  `json:"initiatedEventBatchID,omitempty"`
@@ -2294,6 +2338,10 @@ type DomainInfo struct {
 	FailoverEndTime             *int64            `json:"failoverEndTime,omitempty"`
 	PreviousFailoverVersion     *int64            `json:"previousFailoverVersion,omitempty"`
 	LastUpdatedTime             *int64            `json:"lastUpdatedTime,omitempty"`
+	RequestsPerSecond           *int32            `json:"requestsPerSecond,omitempty"`
+	MaxConcurrentWorkflows      *int32            `json:"maxConcurrentWorkflows,omitempty"`
+	MaxPendingActivities        *int32            `json:"maxPendingActivities,omitempty"`
+	MaxHistorySize              *int64            `json:"maxHistorySize,omitempty"`
 }
 
 type _Map_String_String_MapItemList map[string]string
@@ -2348,7 +2396,7 @@ func (_Map_String_String_MapItemList) Close() {}
 //   }
 func (v *DomainInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [24]wire.Field
+		fields [28]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -2546,6 +2594,38 @@ func (v *DomainInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 54, Value: w}
 		i++
 	}
+	if v.RequestsPerSecond != nil {
+		w, err = wire.NewValueI32(*(v.RequestsPerSecond)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 56, Value: w}
+		i++
+	}
+	if v.MaxConcurrentWorkflows != nil {
+		w, err = wire.NewValueI32(*(v.MaxConcurrentWorkflows)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 58, Value: w}
+		i++
+	}
+	if v.MaxPendingActivities != nil {
+		w, err = wire.NewValueI32(*(v.MaxPendingActivities)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.MaxHistorySize != nil {
+		w, err = wire.NewValueI64(*(v.MaxHistorySize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 62, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -2833,6 +2913,46 @@ func (v *DomainInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 56:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.RequestsPerSecond = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 58:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaxConcurrentWorkflows = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaxPendingActivities = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 62:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.MaxHistorySize = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -2847,7 +2967,7 @@ func (v *DomainInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [24]string
+	var fields [28]string
 	i := 0
 	if v.Name != nil {
 		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
@@ -2945,6 +3065,22 @@ func (v *DomainInfo) String() string {
 		fields[i] = fmt.Sprintf("LastUpdatedTime: %v", *(v.LastUpdatedTime))
 		i++
 	}
+	if v.RequestsPerSecond != nil {
+		fields[i] = fmt.Sprintf("RequestsPerSecond: %v", *(v.RequestsPerSecond))
+		i++
+	}
+	if v.MaxConcurrentWorkflows != nil {
+		fields[i] = fmt.Sprintf("MaxConcurrentWorkflows: %v", *(v.MaxConcurrentWorkflows))
+		i++
+	}
+	if v.MaxPendingActivities != nil {
+		fields[i] = fmt.Sprintf("MaxPendingActivities: %v", *(v.MaxPendingActivities))
+		i++
+	}
+	if v.MaxHistorySize != nil {
+		fields[i] = fmt.Sprintf("MaxHistorySize: %v", *(v.MaxHistorySize))
+		i++
+	}
 
 	return fmt.Sprintf("DomainInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -3058,6 +3194,18 @@ func (v *DomainInfo) Equals(rhs *DomainInfo) bool {
 	if !_I64_EqualsPtr(v.LastUpdatedTime, rhs.LastUpdatedTime) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.RequestsPerSecond, rhs.RequestsPerSecond) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaxConcurrentWorkflows, rhs.MaxConcurrentWorkflows) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaxPendingActivities, rhs.MaxPendingActivities) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.MaxHistorySize, rhs.MaxHistorySize) {
+		return false
+	}
 
 	return true
 }
@@ -3151,6 +3299,18 @@ func (v *DomainInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.LastUpdatedTime != nil {
 		enc.AddInt64("lastUpdatedTime", *v.LastUpdatedTime)
 	}
+	if v.RequestsPerSecond != nil {
+		enc.AddInt32("requestsPerSecond", *v.RequestsPerSecond)
+	}
+	if v.MaxConcurrentWorkflows != nil {
+		enc.AddInt32("maxConcurrentWorkflows", *v.MaxConcurrentWorkflows)
+	}
+	if v.MaxPendingActivities != nil {
+		enc.AddInt32("maxPendingActivities", *v.MaxPendingActivities)
+	}
+	if v.MaxHistorySize != nil {
+		enc.AddInt64("maxHistorySize", *v.MaxHistorySize)
+	}
 	return err
 }
 
@@ -3514,6 +3674,66 @@ func (v *DomainInfo) IsSetLastUpdatedTime() bool {
 	return v != nil && v.LastUpdatedTime != nil
 }
 
+// GetRequestsPerSecond returns the value of RequestsPerSecond if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetRequestsPerSecond() (o int32) {
+	if v != nil && v.RequestsPerSecond != nil {
+		return *v.RequestsPerSecond
+	}
+
+	return
+}
+
+// IsSetRequestsPerSecond returns true if RequestsPerSecond is not nil.
+func (v *DomainInfo) IsSetRequestsPerSecond() bool {
+	return v != nil && v.RequestsPerSecond != nil
+}
+
+// GetMaxConcurrentWorkflows returns the value of MaxConcurrentWorkflows if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetMaxConcurrentWorkflows() (o int32) {
+	if v != nil && v.MaxConcurrentWorkflows != nil {
+		return *v.MaxConcurrentWorkflows
+	}
+
+	return
+}
+
+// IsSetMaxConcurrentWorkflows returns true if MaxConcurrentWorkflows is not nil.
+func (v *DomainInfo) IsSetMaxConcurrentWorkflows() bool {
+	return v != nil && v.MaxConcurrentWorkflows != nil
+}
+
+// GetMaxPendingActivities returns the value of MaxPendingActivities if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetMaxPendingActivities() (o int32) {
+	if v != nil && v.MaxPendingActivities != nil {
+		return *v.MaxPendingActivities
+	}
+
+	return
+}
+
+// IsSetMaxPendingActivities returns true if MaxPendingActivities is not nil.
+func (v *DomainInfo) IsSetMaxPendingActivities() bool {
+	return v != nil && v.MaxPendingActivities != nil
+}
+
+// GetMaxHistorySize returns the value of MaxHistorySize if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetMaxHistorySize() (o int64) {
+	if v != nil && v.MaxHistorySize != nil {
+		return *v.MaxHistorySize
+	}
+
+	return
+}
+
+// IsSetMaxHistorySize returns true if MaxHistorySize is not nil.
+func (v *DomainInfo) IsSetMaxHistorySize() bool {
+	return v != nil && v.MaxHistorySize != nil
+}
+
 type HistoryTreeInfo struct {
 	CreatedTimeNanos *int64                       `json:"createdTimeNanos,omitempty"`
 	Ancestors        []*shared.HistoryBranchRange `json:"ancestors,omitempty"`
@@ -6377,6 +6597,7 @@ type TaskListInfo struct {
 	AckLevel         *int64 `json:"ackLevel,omitempty"`
 	ExpiryTimeNanos  *int64 `json:"expiryTimeNanos,omitempty"`
 	LastUpdatedNanos *int64 `json:"lastUpdatedNanos,omitempty"`
+	Paused           *bool  `json:"paused,omitempty"`
 }
 
 // ToWire translates a TaskListInfo struct into a Thrift-level intermediate
@@ -6396,7 +6617,7 @@ type TaskListInfo struct {
 //   }
 func (v *TaskListInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -6434,6 +6655,14 @@ func (v *TaskListInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 16, Value: w}
 		i++
 	}
+	if v.Paused != nil {
+		w, err = wire.NewValueBool(*(v.Paused)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 18, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -6499,6 +6728,16 @@ func (v *TaskListInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 18:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Paused = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -6513,7 +6752,7 @@ func (v *TaskListInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
 	if v.Kind != nil {
 		fields[i] = fmt.Sprintf("Kind: %v", *(v.Kind))
@@ -6531,6 +6770,10 @@ func (v *TaskListInfo) String() string {
 		fields[i] = fmt.Sprintf("LastUpdatedNanos: %v", *(v.LastUpdatedNanos))
 		i++
 	}
+	if v.Paused != nil {
+		fields[i] = fmt.Sprintf("Paused: %v", *(v.Paused))
+		i++
+	}
 
 	return fmt.Sprintf("TaskListInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -6557,6 +6800,9 @@ func (v *TaskListInfo) Equals(rhs *TaskListInfo) bool {
 	if !_I64_EqualsPtr(v.LastUpdatedNanos, rhs.LastUpdatedNanos) {
 		return false
 	}
+	if !_Bool_EqualsPtr(v.Paused, rhs.Paused) {
+		return false
+	}
 
 	return true
 }
@@ -6579,6 +6825,9 @@ func (v *TaskListInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.LastUpdatedNanos != nil {
 		enc.AddInt64("lastUpdatedNanos", *v.LastUpdatedNanos)
 	}
+	if v.Paused != nil {
+		enc.AddBool("paused", *v.Paused)
+	}
 	return err
 }
 
@@ -6642,6 +6891,21 @@ func (v *TaskListInfo) IsSetLastUpdatedNanos() bool {
 	return v != nil && v.LastUpdatedNanos != nil
 }
 
+// GetPaused returns the value of Paused if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetPaused() (o bool) {
+	if v != nil && v.Paused != nil {
+		return *v.Paused
+	}
+
+	return
+}
+
+// IsSetPaused returns true if Paused is not nil.
+func (v *TaskListInfo) IsSetPaused() bool {
+	return v != nil && v.Paused != nil
+}
+
 type TimerInfo struct {
 	Version         *int64 `json:"version,omitempty"`
 	StartedID       *int64 `json:"startedID,omitempty"`
@@ -8027,6 +8291,9 @@ type WorkflowExecutionInfo struct {
 	Memo                                    map[string][]byte `json:"memo,omitempty"`
 	VersionHistories                        []byte            `json:"versionHistories,omitempty"`
 	VersionHistoriesEncoding                *string           `json:"versionHistoriesEncoding,omitempty"`
+	Paused                                  *bool             `json:"paused,omitempty"`
+	SignalRequestedTimestamps               map[string]int64  `json:"signalRequestedTimestamps,omitempty"`
+	AcceptedUpdates                         map[string]int64  `json:"acceptedUpdates,omitempty"`
 }
 
 type _Map_String_Binary_MapItemList map[string][]byte
@@ -8084,7 +8351,7 @@ func (_Map_String_Binary_MapItemList) Close() {}
 //   }
 func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [58]wire.Field
+		fields [61]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -8554,6 +8821,30 @@ func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 124, Value: w}
 		i++
 	}
+	if v.Paused != nil {
+		w, err = wire.NewValueBool(*(v.Paused)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 126, Value: w}
+		i++
+	}
+	if v.SignalRequestedTimestamps != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.SignalRequestedTimestamps)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 128, Value: w}
+		i++
+	}
+	if v.AcceptedUpdates != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.AcceptedUpdates)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -9167,6 +9458,32 @@ func (v *WorkflowExecutionInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 126:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Paused = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 128:
+			if field.Value.Type() == wire.TMap {
+				v.SignalRequestedTimestamps, err = _Map_String_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TMap {
+				v.AcceptedUpdates, err = _Map_String_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -9181,7 +9498,7 @@ func (v *WorkflowExecutionInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [58]string
+	var fields [61]string
 	i := 0
 	if v.ParentDomainID != nil {
 		fields[i] = fmt.Sprintf("ParentDomainID: %v", v.ParentDomainID)
@@ -9415,6 +9732,18 @@ func (v *WorkflowExecutionInfo) String() string {
 		fields[i] = fmt.Sprintf("VersionHistoriesEncoding: %v", *(v.VersionHistoriesEncoding))
 		i++
 	}
+	if v.Paused != nil {
+		fields[i] = fmt.Sprintf("Paused: %v", *(v.Paused))
+		i++
+	}
+	if v.SignalRequestedTimestamps != nil {
+		fields[i] = fmt.Sprintf("SignalRequestedTimestamps: %v", v.SignalRequestedTimestamps)
+		i++
+	}
+	if v.AcceptedUpdates != nil {
+		fields[i] = fmt.Sprintf("AcceptedUpdates: %v", v.AcceptedUpdates)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -9620,6 +9949,15 @@ func (v *WorkflowExecutionInfo) Equals(rhs *WorkflowExecutionInfo) bool {
 	if !_String_EqualsPtr(v.VersionHistoriesEncoding, rhs.VersionHistoriesEncoding) {
 		return false
 	}
+	if !_Bool_EqualsPtr(v.Paused, rhs.Paused) {
+		return false
+	}
+	if !((v.SignalRequestedTimestamps == nil && rhs.SignalRequestedTimestamps == nil) || (v.SignalRequestedTimestamps != nil && rhs.SignalRequestedTimestamps != nil && _Map_String_I64_Equals(v.SignalRequestedTimestamps, rhs.SignalRequestedTimestamps))) {
+		return false
+	}
+	if !((v.AcceptedUpdates == nil && rhs.AcceptedUpdates == nil) || (v.AcceptedUpdates != nil && rhs.AcceptedUpdates != nil && _Map_String_I64_Equals(v.AcceptedUpdates, rhs.AcceptedUpdates))) {
+		return false
+	}
 
 	return true
 }
@@ -9815,6 +10153,15 @@ func (v *WorkflowExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	if v.VersionHistoriesEncoding != nil {
 		enc.AddString("versionHistoriesEncoding", *v.VersionHistoriesEncoding)
 	}
+	if v.Paused != nil {
+		enc.AddBool("paused", *v.Paused)
+	}
+	if v.SignalRequestedTimestamps != nil {
+		err = multierr.Append(err, enc.AddObject("signalRequestedTimestamps", (_Map_String_I64_Zapper)(v.SignalRequestedTimestamps)))
+	}
+	if v.AcceptedUpdates != nil {
+		err = multierr.Append(err, enc.AddObject("acceptedUpdates", (_Map_String_I64_Zapper)(v.AcceptedUpdates)))
+	}
 	return err
 }
 
@@ -10688,16 +11035,61 @@ func (v *WorkflowExecutionInfo) IsSetVersionHistoriesEncoding() bool {
 	return v != nil && v.VersionHistoriesEncoding != nil
 }
 
+// GetPaused returns the value of Paused if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetPaused() (o bool) {
+	if v != nil && v.Paused != nil {
+		return *v.Paused
+	}
+
+	return
+}
+
+// IsSetPaused returns true if Paused is not nil.
+func (v *WorkflowExecutionInfo) IsSetPaused() bool {
+	return v != nil && v.Paused != nil
+}
+
+// GetSignalRequestedTimestamps returns the value of SignalRequestedTimestamps if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetSignalRequestedTimestamps() (o map[string]int64) {
+	if v != nil && v.SignalRequestedTimestamps != nil {
+		return v.SignalRequestedTimestamps
+	}
+
+	return
+}
+
+// IsSetSignalRequestedTimestamps returns true if SignalRequestedTimestamps is not nil.
+func (v *WorkflowExecutionInfo) IsSetSignalRequestedTimestamps() bool {
+	return v != nil && v.SignalRequestedTimestamps != nil
+}
+
+// GetAcceptedUpdates returns the value of AcceptedUpdates if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetAcceptedUpdates() (o map[string]int64) {
+	if v != nil && v.AcceptedUpdates != nil {
+		return v.AcceptedUpdates
+	}
+
+	return
+}
+
+// IsSetAcceptedUpdates returns true if AcceptedUpdates is not nil.
+func (v *WorkflowExecutionInfo) IsSetAcceptedUpdates() bool {
+	return v != nil && v.AcceptedUpdates != nil
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "sqlblobs",
 	Package:  "github.com/uber/cadence/.gen/go/sqlblobs",
 	FilePath: "sqlblobs.thrift",
-	SHA1:     "cc9d9ebd900b26264bd1f303605fdd1d3eabb6a4",
+	SHA1:     "dc702ab382976f95c434c28c47fe6bd55262318d",
 	Includes: []*thriftreflect.ThriftModule{
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n  42: optional binary pendingFailoverMarkers\n  44: optional string pendingFailoverMarkersEncoding\n  46: optional map<string, i64> replicationDlqAckLevel\n  50: optional binary transferProcessingQueueStates\n  51: optional string transferProcessingQueueStatesEncoding\n  55: optional binary timerProcessingQueueStates\n  56: optional string timerProcessingQueueStatesEncoding\n  60: optional binary crossClusterProcessingQueueStates\n  61: optional string crossClusterProcessingQueueStatesEncoding\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n  50: optional i64 (js.type = \"Long\") failoverEndTime\n  52: optional i64 (js.type = \"Long\") previousFailoverVersion\n  54: optional i64 (js.type = \"Long\") lastUpdatedTime\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n  122: optional binary versionHistories\n  124: optional string versionHistoriesEncoding\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  30: optional string domainName\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  // TaskID is a misleading variable, it actually serves\n  // the purpose of indicating whether a timer task is\n  // generated for this timer info\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  34: optional binary newRunBranchToken\n  38: optional i64 (js.type = \"Long\") creationTime\n}"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n  42: optional binary pendingFailoverMarkers\n  44: optional string pendingFailoverMarkersEncoding\n  46: optional map<string, i64> replicationDlqAckLevel\n  50: optional binary transferProcessingQueueStates\n  51: optional string transferProcessingQueueStatesEncoding\n  55: optional binary timerProcessingQueueStates\n  56: optional string timerProcessingQueueStatesEncoding\n  60: optional binary crossClusterProcessingQueueStates\n  61: optional string crossClusterProcessingQueueStatesEncoding\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n  50: optional i64 (js.type = \"Long\") failoverEndTime\n  52: optional i64 (js.type = \"Long\") previousFailoverVersion\n  54: optional i64 (js.type = \"Long\") lastUpdatedTime\n  56: optional i32 requestsPerSecond\n  58: optional i32 maxConcurrentWorkflows\n  60: optional i32 maxPendingActivities\n  62: optional i64 (js.type = \"Long\") maxHistorySize\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n  122: optional binary versionHistories\n  124: optional string versionHistoriesEncoding\n  126: optional bool paused\n  128: optional map<string, i64> signalRequestedTimestamps\n  130: optional map<string, i64> acceptedUpdates\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n  72: optional bool paused\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  30: optional string domainName\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  // TaskID is a misleading variable, it actually serves\n  // the purpose of indicating whether a timer task is\n  // generated for this timer info\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n  18: optional bool paused\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  34: optional binary newRunBranchToken\n  38: optional i64 (js.type = \"Long\") creationTime\n}"
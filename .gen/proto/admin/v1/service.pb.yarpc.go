@@ -64,6 +64,20 @@ type AdminAPIYARPCClient interface {
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest, ...yarpc.CallOption) (*RefreshWorkflowTasksResponse, error)
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest, ...yarpc.CallOption) (*ResendReplicationTasksResponse, error)
 	GetCrossClusterTasks(context.Context, *GetCrossClusterTasksRequest, ...yarpc.CallOption) (*GetCrossClusterTasksResponse, error)
+	PauseTaskList(context.Context, *PauseTaskListRequest, ...yarpc.CallOption) (*PauseTaskListResponse, error)
+	ResumeTaskList(context.Context, *ResumeTaskListRequest, ...yarpc.CallOption) (*ResumeTaskListResponse, error)
+	MoveTaskListBacklog(context.Context, *MoveTaskListBacklogRequest, ...yarpc.CallOption) (*MoveTaskListBacklogResponse, error)
+	ReadHistoryTaskDLQ(context.Context, *ReadHistoryTaskDLQRequest, ...yarpc.CallOption) (*ReadHistoryTaskDLQResponse, error)
+	PurgeHistoryTaskDLQ(context.Context, *PurgeHistoryTaskDLQRequest, ...yarpc.CallOption) (*PurgeHistoryTaskDLQResponse, error)
+	RetryHistoryTaskDLQ(context.Context, *RetryHistoryTaskDLQRequest, ...yarpc.CallOption) (*RetryHistoryTaskDLQResponse, error)
+	DescribeReplicationStatus(context.Context, *DescribeReplicationStatusRequest, ...yarpc.CallOption) (*DescribeReplicationStatusResponse, error)
+	MigrateWorkflowExecution(context.Context, *MigrateWorkflowExecutionRequest, ...yarpc.CallOption) (*MigrateWorkflowExecutionResponse, error)
+	AddRemoteCluster(context.Context, *AddRemoteClusterRequest, ...yarpc.CallOption) (*AddRemoteClusterResponse, error)
+	RemoveRemoteCluster(context.Context, *RemoveRemoteClusterRequest, ...yarpc.CallOption) (*RemoveRemoteClusterResponse, error)
+	ListDomainChanges(context.Context, *ListDomainChangesRequest, ...yarpc.CallOption) (*ListDomainChangesResponse, error)
+	CloneDomain(context.Context, *CloneDomainRequest, ...yarpc.CallOption) (*CloneDomainResponse, error)
+	RenameDomain(context.Context, *RenameDomainRequest, ...yarpc.CallOption) (*RenameDomainResponse, error)
+	DeleteDomain(context.Context, *DeleteDomainRequest, ...yarpc.CallOption) (*DeleteDomainResponse, error)
 }
 
 func newAdminAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) AdminAPIYARPCClient {
@@ -104,6 +118,20 @@ type AdminAPIYARPCServer interface {
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
 	GetCrossClusterTasks(context.Context, *GetCrossClusterTasksRequest) (*GetCrossClusterTasksResponse, error)
+	PauseTaskList(context.Context, *PauseTaskListRequest) (*PauseTaskListResponse, error)
+	ResumeTaskList(context.Context, *ResumeTaskListRequest) (*ResumeTaskListResponse, error)
+	MoveTaskListBacklog(context.Context, *MoveTaskListBacklogRequest) (*MoveTaskListBacklogResponse, error)
+	ReadHistoryTaskDLQ(context.Context, *ReadHistoryTaskDLQRequest) (*ReadHistoryTaskDLQResponse, error)
+	PurgeHistoryTaskDLQ(context.Context, *PurgeHistoryTaskDLQRequest) (*PurgeHistoryTaskDLQResponse, error)
+	RetryHistoryTaskDLQ(context.Context, *RetryHistoryTaskDLQRequest) (*RetryHistoryTaskDLQResponse, error)
+	DescribeReplicationStatus(context.Context, *DescribeReplicationStatusRequest) (*DescribeReplicationStatusResponse, error)
+	MigrateWorkflowExecution(context.Context, *MigrateWorkflowExecutionRequest) (*MigrateWorkflowExecutionResponse, error)
+	AddRemoteCluster(context.Context, *AddRemoteClusterRequest) (*AddRemoteClusterResponse, error)
+	RemoveRemoteCluster(context.Context, *RemoveRemoteClusterRequest) (*RemoveRemoteClusterResponse, error)
+	ListDomainChanges(context.Context, *ListDomainChangesRequest) (*ListDomainChangesResponse, error)
+	CloneDomain(context.Context, *CloneDomainRequest) (*CloneDomainResponse, error)
+	RenameDomain(context.Context, *RenameDomainRequest) (*RenameDomainResponse, error)
+	DeleteDomain(context.Context, *DeleteDomainRequest) (*DeleteDomainResponse, error)
 }
 
 type buildAdminAPIYARPCProceduresParams struct {
@@ -317,6 +345,146 @@ func buildAdminAPIYARPCProcedures(params buildAdminAPIYARPCProceduresParams) []t
 						},
 					),
 				},
+				{
+					MethodName: "PauseTaskList",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.PauseTaskList,
+							NewRequest:  newAdminAPIServicePauseTaskListYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ResumeTaskList",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ResumeTaskList,
+							NewRequest:  newAdminAPIServiceResumeTaskListYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "MoveTaskListBacklog",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.MoveTaskListBacklog,
+							NewRequest:  newAdminAPIServiceMoveTaskListBacklogYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ReadHistoryTaskDLQ",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ReadHistoryTaskDLQ,
+							NewRequest:  newAdminAPIServiceReadHistoryTaskDLQYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "PurgeHistoryTaskDLQ",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.PurgeHistoryTaskDLQ,
+							NewRequest:  newAdminAPIServicePurgeHistoryTaskDLQYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RetryHistoryTaskDLQ",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RetryHistoryTaskDLQ,
+							NewRequest:  newAdminAPIServiceRetryHistoryTaskDLQYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeReplicationStatus",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeReplicationStatus,
+							NewRequest:  newAdminAPIServiceDescribeReplicationStatusYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "MigrateWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.MigrateWorkflowExecution,
+							NewRequest:  newAdminAPIServiceMigrateWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "AddRemoteCluster",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.AddRemoteCluster,
+							NewRequest:  newAdminAPIServiceAddRemoteClusterYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RemoveRemoteCluster",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RemoveRemoteCluster,
+							NewRequest:  newAdminAPIServiceRemoveRemoteClusterYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListDomainChanges",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListDomainChanges,
+							NewRequest:  newAdminAPIServiceListDomainChangesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "CloneDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.CloneDomain,
+							NewRequest:  newAdminAPIServiceCloneDomainYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RenameDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RenameDomain,
+							NewRequest:  newAdminAPIServiceRenameDomainYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DeleteDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DeleteDomain,
+							NewRequest:  newAdminAPIServiceDeleteDomainYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
@@ -357,10 +525,10 @@ type FxAdminAPIYARPCClientResult struct {
 // NewFxAdminAPIYARPCClient provides a AdminAPIYARPCClient
 // to an Fx application using the given name for routing.
 //
-//  fx.Provide(
-//    adminv1.NewFxAdminAPIYARPCClient("service-name"),
-//    ...
-//  )
+//	fx.Provide(
+//	  adminv1.NewFxAdminAPIYARPCClient("service-name"),
+//	  ...
+//	)
 func NewFxAdminAPIYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
 	return func(params FxAdminAPIYARPCClientParams) FxAdminAPIYARPCClientResult {
 		cc := params.Provider.ClientConfig(name)
@@ -406,10 +574,10 @@ type FxAdminAPIYARPCProceduresResult struct {
 // NewFxAdminAPIYARPCProcedures provides AdminAPIYARPCServer procedures to an Fx application.
 // It expects a AdminAPIYARPCServer to be present in the container.
 //
-//  fx.Provide(
-//    adminv1.NewFxAdminAPIYARPCProcedures(),
-//    ...
-//  )
+//	fx.Provide(
+//	  adminv1.NewFxAdminAPIYARPCProcedures(),
+//	  ...
+//	)
 func NewFxAdminAPIYARPCProcedures() interface{} {
 	return func(params FxAdminAPIYARPCProceduresParams) FxAdminAPIYARPCProceduresResult {
 		return FxAdminAPIYARPCProceduresResult{
@@ -669,6 +837,174 @@ func (c *_AdminAPIYARPCCaller) GetCrossClusterTasks(ctx context.Context, request
 	return response, err
 }
 
+func (c *_AdminAPIYARPCCaller) PauseTaskList(ctx context.Context, request *PauseTaskListRequest, options ...yarpc.CallOption) (*PauseTaskListResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PauseTaskList", request, newAdminAPIServicePauseTaskListYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PauseTaskListResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServicePauseTaskListYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) ResumeTaskList(ctx context.Context, request *ResumeTaskListRequest, options ...yarpc.CallOption) (*ResumeTaskListResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResumeTaskList", request, newAdminAPIServiceResumeTaskListYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResumeTaskListResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceResumeTaskListYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) MoveTaskListBacklog(ctx context.Context, request *MoveTaskListBacklogRequest, options ...yarpc.CallOption) (*MoveTaskListBacklogResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "MoveTaskListBacklog", request, newAdminAPIServiceMoveTaskListBacklogYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*MoveTaskListBacklogResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceMoveTaskListBacklogYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) ReadHistoryTaskDLQ(ctx context.Context, request *ReadHistoryTaskDLQRequest, options ...yarpc.CallOption) (*ReadHistoryTaskDLQResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ReadHistoryTaskDLQ", request, newAdminAPIServiceReadHistoryTaskDLQYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ReadHistoryTaskDLQResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceReadHistoryTaskDLQYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) PurgeHistoryTaskDLQ(ctx context.Context, request *PurgeHistoryTaskDLQRequest, options ...yarpc.CallOption) (*PurgeHistoryTaskDLQResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PurgeHistoryTaskDLQ", request, newAdminAPIServicePurgeHistoryTaskDLQYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PurgeHistoryTaskDLQResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServicePurgeHistoryTaskDLQYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) RetryHistoryTaskDLQ(ctx context.Context, request *RetryHistoryTaskDLQRequest, options ...yarpc.CallOption) (*RetryHistoryTaskDLQResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RetryHistoryTaskDLQ", request, newAdminAPIServiceRetryHistoryTaskDLQYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RetryHistoryTaskDLQResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceRetryHistoryTaskDLQYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) DescribeReplicationStatus(ctx context.Context, request *DescribeReplicationStatusRequest, options ...yarpc.CallOption) (*DescribeReplicationStatusResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeReplicationStatus", request, newAdminAPIServiceDescribeReplicationStatusYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeReplicationStatusResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceDescribeReplicationStatusYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) MigrateWorkflowExecution(ctx context.Context, request *MigrateWorkflowExecutionRequest, options ...yarpc.CallOption) (*MigrateWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "MigrateWorkflowExecution", request, newAdminAPIServiceMigrateWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*MigrateWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceMigrateWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) AddRemoteCluster(ctx context.Context, request *AddRemoteClusterRequest, options ...yarpc.CallOption) (*AddRemoteClusterResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "AddRemoteCluster", request, newAdminAPIServiceAddRemoteClusterYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*AddRemoteClusterResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceAddRemoteClusterYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) RemoveRemoteCluster(ctx context.Context, request *RemoveRemoteClusterRequest, options ...yarpc.CallOption) (*RemoveRemoteClusterResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RemoveRemoteCluster", request, newAdminAPIServiceRemoveRemoteClusterYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RemoveRemoteClusterResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceRemoveRemoteClusterYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) ListDomainChanges(ctx context.Context, request *ListDomainChangesRequest, options ...yarpc.CallOption) (*ListDomainChangesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListDomainChanges", request, newAdminAPIServiceListDomainChangesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListDomainChangesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceListDomainChangesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) CloneDomain(ctx context.Context, request *CloneDomainRequest, options ...yarpc.CallOption) (*CloneDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "CloneDomain", request, newAdminAPIServiceCloneDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*CloneDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceCloneDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) RenameDomain(ctx context.Context, request *RenameDomainRequest, options ...yarpc.CallOption) (*RenameDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RenameDomain", request, newAdminAPIServiceRenameDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RenameDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceRenameDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) DeleteDomain(ctx context.Context, request *DeleteDomainRequest, options ...yarpc.CallOption) (*DeleteDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DeleteDomain", request, newAdminAPIServiceDeleteDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DeleteDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceDeleteDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 type _AdminAPIYARPCHandler struct {
 	server AdminAPIYARPCServer
 }
@@ -993,6 +1329,230 @@ func (h *_AdminAPIYARPCHandler) GetCrossClusterTasks(ctx context.Context, reques
 	return response, err
 }
 
+func (h *_AdminAPIYARPCHandler) PauseTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PauseTaskListRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PauseTaskListRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServicePauseTaskListYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PauseTaskList(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) ResumeTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResumeTaskListRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResumeTaskListRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceResumeTaskListYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResumeTaskList(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) MoveTaskListBacklog(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *MoveTaskListBacklogRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*MoveTaskListBacklogRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceMoveTaskListBacklogYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.MoveTaskListBacklog(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) ReadHistoryTaskDLQ(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ReadHistoryTaskDLQRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ReadHistoryTaskDLQRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceReadHistoryTaskDLQYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ReadHistoryTaskDLQ(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) PurgeHistoryTaskDLQ(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PurgeHistoryTaskDLQRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PurgeHistoryTaskDLQRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServicePurgeHistoryTaskDLQYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PurgeHistoryTaskDLQ(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) RetryHistoryTaskDLQ(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RetryHistoryTaskDLQRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RetryHistoryTaskDLQRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceRetryHistoryTaskDLQYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RetryHistoryTaskDLQ(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) DescribeReplicationStatus(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeReplicationStatusRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeReplicationStatusRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceDescribeReplicationStatusYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeReplicationStatus(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) MigrateWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *MigrateWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*MigrateWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceMigrateWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.MigrateWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) AddRemoteCluster(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *AddRemoteClusterRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*AddRemoteClusterRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceAddRemoteClusterYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.AddRemoteCluster(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) RemoveRemoteCluster(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RemoveRemoteClusterRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RemoveRemoteClusterRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceRemoveRemoteClusterYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RemoveRemoteCluster(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) ListDomainChanges(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListDomainChangesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListDomainChangesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceListDomainChangesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListDomainChanges(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) CloneDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *CloneDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*CloneDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceCloneDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.CloneDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) RenameDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RenameDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RenameDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceRenameDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RenameDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) DeleteDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DeleteDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DeleteDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceDeleteDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DeleteDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func newAdminAPIServiceDescribeWorkflowExecutionYARPCRequest() proto.Message {
 	return &DescribeWorkflowExecutionRequest{}
 }
@@ -1153,6 +1713,118 @@ func newAdminAPIServiceGetCrossClusterTasksYARPCResponse() proto.Message {
 	return &GetCrossClusterTasksResponse{}
 }
 
+func newAdminAPIServicePauseTaskListYARPCRequest() proto.Message {
+	return &PauseTaskListRequest{}
+}
+
+func newAdminAPIServicePauseTaskListYARPCResponse() proto.Message {
+	return &PauseTaskListResponse{}
+}
+
+func newAdminAPIServiceResumeTaskListYARPCRequest() proto.Message {
+	return &ResumeTaskListRequest{}
+}
+
+func newAdminAPIServiceResumeTaskListYARPCResponse() proto.Message {
+	return &ResumeTaskListResponse{}
+}
+
+func newAdminAPIServiceMoveTaskListBacklogYARPCRequest() proto.Message {
+	return &MoveTaskListBacklogRequest{}
+}
+
+func newAdminAPIServiceMoveTaskListBacklogYARPCResponse() proto.Message {
+	return &MoveTaskListBacklogResponse{}
+}
+
+func newAdminAPIServiceReadHistoryTaskDLQYARPCRequest() proto.Message {
+	return &ReadHistoryTaskDLQRequest{}
+}
+
+func newAdminAPIServiceReadHistoryTaskDLQYARPCResponse() proto.Message {
+	return &ReadHistoryTaskDLQResponse{}
+}
+
+func newAdminAPIServicePurgeHistoryTaskDLQYARPCRequest() proto.Message {
+	return &PurgeHistoryTaskDLQRequest{}
+}
+
+func newAdminAPIServicePurgeHistoryTaskDLQYARPCResponse() proto.Message {
+	return &PurgeHistoryTaskDLQResponse{}
+}
+
+func newAdminAPIServiceRetryHistoryTaskDLQYARPCRequest() proto.Message {
+	return &RetryHistoryTaskDLQRequest{}
+}
+
+func newAdminAPIServiceRetryHistoryTaskDLQYARPCResponse() proto.Message {
+	return &RetryHistoryTaskDLQResponse{}
+}
+
+func newAdminAPIServiceDescribeReplicationStatusYARPCRequest() proto.Message {
+	return &DescribeReplicationStatusRequest{}
+}
+
+func newAdminAPIServiceDescribeReplicationStatusYARPCResponse() proto.Message {
+	return &DescribeReplicationStatusResponse{}
+}
+
+func newAdminAPIServiceMigrateWorkflowExecutionYARPCRequest() proto.Message {
+	return &MigrateWorkflowExecutionRequest{}
+}
+
+func newAdminAPIServiceMigrateWorkflowExecutionYARPCResponse() proto.Message {
+	return &MigrateWorkflowExecutionResponse{}
+}
+
+func newAdminAPIServiceAddRemoteClusterYARPCRequest() proto.Message {
+	return &AddRemoteClusterRequest{}
+}
+
+func newAdminAPIServiceAddRemoteClusterYARPCResponse() proto.Message {
+	return &AddRemoteClusterResponse{}
+}
+
+func newAdminAPIServiceRemoveRemoteClusterYARPCRequest() proto.Message {
+	return &RemoveRemoteClusterRequest{}
+}
+
+func newAdminAPIServiceRemoveRemoteClusterYARPCResponse() proto.Message {
+	return &RemoveRemoteClusterResponse{}
+}
+
+func newAdminAPIServiceListDomainChangesYARPCRequest() proto.Message {
+	return &ListDomainChangesRequest{}
+}
+
+func newAdminAPIServiceListDomainChangesYARPCResponse() proto.Message {
+	return &ListDomainChangesResponse{}
+}
+
+func newAdminAPIServiceCloneDomainYARPCRequest() proto.Message {
+	return &CloneDomainRequest{}
+}
+
+func newAdminAPIServiceCloneDomainYARPCResponse() proto.Message {
+	return &CloneDomainResponse{}
+}
+
+func newAdminAPIServiceRenameDomainYARPCRequest() proto.Message {
+	return &RenameDomainRequest{}
+}
+
+func newAdminAPIServiceRenameDomainYARPCResponse() proto.Message {
+	return &RenameDomainResponse{}
+}
+
+func newAdminAPIServiceDeleteDomainYARPCRequest() proto.Message {
+	return &DeleteDomainRequest{}
+}
+
+func newAdminAPIServiceDeleteDomainYARPCResponse() proto.Message {
+	return &DeleteDomainResponse{}
+}
+
 var (
 	emptyAdminAPIServiceDescribeWorkflowExecutionYARPCRequest         = &DescribeWorkflowExecutionRequest{}
 	emptyAdminAPIServiceDescribeWorkflowExecutionYARPCResponse        = &DescribeWorkflowExecutionResponse{}
@@ -1194,158 +1866,256 @@ var (
 	emptyAdminAPIServiceResendReplicationTasksYARPCResponse           = &ResendReplicationTasksResponse{}
 	emptyAdminAPIServiceGetCrossClusterTasksYARPCRequest              = &GetCrossClusterTasksRequest{}
 	emptyAdminAPIServiceGetCrossClusterTasksYARPCResponse             = &GetCrossClusterTasksResponse{}
+	emptyAdminAPIServicePauseTaskListYARPCRequest                     = &PauseTaskListRequest{}
+	emptyAdminAPIServicePauseTaskListYARPCResponse                    = &PauseTaskListResponse{}
+	emptyAdminAPIServiceResumeTaskListYARPCRequest                    = &ResumeTaskListRequest{}
+	emptyAdminAPIServiceResumeTaskListYARPCResponse                   = &ResumeTaskListResponse{}
+	emptyAdminAPIServiceMoveTaskListBacklogYARPCRequest               = &MoveTaskListBacklogRequest{}
+	emptyAdminAPIServiceMoveTaskListBacklogYARPCResponse              = &MoveTaskListBacklogResponse{}
+	emptyAdminAPIServiceReadHistoryTaskDLQYARPCRequest                = &ReadHistoryTaskDLQRequest{}
+	emptyAdminAPIServiceReadHistoryTaskDLQYARPCResponse               = &ReadHistoryTaskDLQResponse{}
+	emptyAdminAPIServicePurgeHistoryTaskDLQYARPCRequest               = &PurgeHistoryTaskDLQRequest{}
+	emptyAdminAPIServicePurgeHistoryTaskDLQYARPCResponse              = &PurgeHistoryTaskDLQResponse{}
+	emptyAdminAPIServiceRetryHistoryTaskDLQYARPCRequest               = &RetryHistoryTaskDLQRequest{}
+	emptyAdminAPIServiceRetryHistoryTaskDLQYARPCResponse              = &RetryHistoryTaskDLQResponse{}
+	emptyAdminAPIServiceDescribeReplicationStatusYARPCRequest         = &DescribeReplicationStatusRequest{}
+	emptyAdminAPIServiceDescribeReplicationStatusYARPCResponse        = &DescribeReplicationStatusResponse{}
+	emptyAdminAPIServiceMigrateWorkflowExecutionYARPCRequest          = &MigrateWorkflowExecutionRequest{}
+	emptyAdminAPIServiceMigrateWorkflowExecutionYARPCResponse         = &MigrateWorkflowExecutionResponse{}
+	emptyAdminAPIServiceAddRemoteClusterYARPCRequest                  = &AddRemoteClusterRequest{}
+	emptyAdminAPIServiceAddRemoteClusterYARPCResponse                 = &AddRemoteClusterResponse{}
+	emptyAdminAPIServiceRemoveRemoteClusterYARPCRequest               = &RemoveRemoteClusterRequest{}
+	emptyAdminAPIServiceRemoveRemoteClusterYARPCResponse              = &RemoveRemoteClusterResponse{}
+	emptyAdminAPIServiceListDomainChangesYARPCRequest                 = &ListDomainChangesRequest{}
+	emptyAdminAPIServiceListDomainChangesYARPCResponse                = &ListDomainChangesResponse{}
+	emptyAdminAPIServiceCloneDomainYARPCRequest                       = &CloneDomainRequest{}
+	emptyAdminAPIServiceCloneDomainYARPCResponse                      = &CloneDomainResponse{}
+	emptyAdminAPIServiceRenameDomainYARPCRequest                      = &RenameDomainRequest{}
+	emptyAdminAPIServiceRenameDomainYARPCResponse                     = &RenameDomainResponse{}
+	emptyAdminAPIServiceDeleteDomainYARPCRequest                      = &DeleteDomainRequest{}
+	emptyAdminAPIServiceDeleteDomainYARPCResponse                     = &DeleteDomainResponse{}
 )
 
 var yarpcFileDescriptorClosurec6fc96d64a8b67fd = [][]byte{
 	// uber/cadence/admin/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcb, 0x6f, 0x1c, 0x59,
-		0xd5, 0x4f, 0x75, 0xdb, 0x8e, 0x7d, 0x3a, 0x6e, 0x3b, 0x37, 0x7e, 0x96, 0x93, 0x8c, 0x53, 0x99,
-		0x4c, 0xfc, 0x7d, 0x33, 0xb4, 0xc7, 0x9d, 0x49, 0x98, 0x99, 0x68, 0x60, 0xfc, 0x48, 0xec, 0x9e,
-		0x49, 0x48, 0x52, 0x36, 0x19, 0x84, 0x90, 0x4a, 0xd5, 0x5d, 0xd7, 0x76, 0xe1, 0xee, 0xaa, 0x4a,
-		0xdd, 0xdb, 0xed, 0xf4, 0x08, 0x01, 0x42, 0xb0, 0x43, 0x08, 0xc4, 0x82, 0x25, 0x0b, 0x76, 0xb0,
-		0x40, 0xec, 0x59, 0xb3, 0x86, 0x15, 0xff, 0xc1, 0x6c, 0x90, 0x90, 0x10, 0x1b, 0x96, 0xe8, 0x3e,
-		0xca, 0x5d, 0xaf, 0xdb, 0x0f, 0x13, 0x14, 0x34, 0x3b, 0xf7, 0xb9, 0xe7, 0x75, 0x7f, 0xf7, 0xdc,
-		0x73, 0xce, 0x3d, 0x65, 0xb8, 0xd9, 0xae, 0xe3, 0x70, 0xbd, 0x61, 0x3b, 0xd8, 0x6b, 0xe0, 0x75,
-		0xdb, 0x69, 0xb9, 0xde, 0x7a, 0x67, 0x63, 0x9d, 0xe0, 0xb0, 0xe3, 0x36, 0x70, 0x25, 0x08, 0x7d,
-		0xea, 0xa3, 0x79, 0xc6, 0x54, 0x91, 0x4c, 0x15, 0xce, 0x54, 0xe9, 0x6c, 0xe8, 0x6f, 0x1c, 0xf9,
-		0xfe, 0x51, 0x13, 0xaf, 0x73, 0xa6, 0x7a, 0xfb, 0x70, 0x9d, 0xba, 0x2d, 0x4c, 0xa8, 0xdd, 0x0a,
-		0x84, 0x9c, 0x7e, 0x3d, 0xcd, 0x70, 0x1a, 0xda, 0x41, 0x80, 0x43, 0x22, 0xd7, 0x57, 0x93, 0xc6,
-		0x03, 0x97, 0x99, 0x6e, 0xf8, 0xad, 0x96, 0xef, 0x49, 0x8e, 0x37, 0xf3, 0x38, 0x3a, 0x2e, 0x71,
-		0xeb, 0x6e, 0xd3, 0xa5, 0xdd, 0x5c, 0x2e, 0x72, 0x6c, 0x87, 0xd8, 0xe1, 0xaa, 0x9a, 0x6d, 0x42,
-		0x71, 0x38, 0x80, 0xeb, 0xd8, 0x25, 0xd4, 0x0f, 0x23, 0x5d, 0x86, 0x82, 0xeb, 0x45, 0x1b, 0xb7,
-		0x25, 0x1e, 0xfa, 0x9a, 0x82, 0x27, 0xc4, 0x41, 0xd3, 0x6d, 0xd8, 0xd4, 0x8d, 0xfc, 0x37, 0x7e,
-		0xa1, 0xc1, 0xea, 0x0e, 0x26, 0x8d, 0xd0, 0xad, 0xe3, 0xcf, 0xfc, 0xf0, 0xe4, 0xb0, 0xe9, 0x9f,
-		0x3e, 0x78, 0x89, 0x1b, 0x6d, 0xc6, 0x63, 0xe2, 0x17, 0x6d, 0x4c, 0x28, 0x5a, 0x80, 0x09, 0xc7,
-		0x6f, 0xd9, 0xae, 0xb7, 0xa4, 0xad, 0x6a, 0x6b, 0x53, 0xa6, 0xfc, 0x85, 0xbe, 0x09, 0xe8, 0x54,
-		0xca, 0x58, 0x38, 0x12, 0x5a, 0x2a, 0xac, 0x6a, 0x6b, 0xa5, 0xea, 0x5b, 0x95, 0xe4, 0x99, 0x04,
-		0x6e, 0xa5, 0xb3, 0x51, 0xc9, 0x9a, 0xb8, 0x7c, 0x9a, 0x26, 0x19, 0x7f, 0xd1, 0xe0, 0x46, 0x1f,
-		0x9f, 0x48, 0xe0, 0x7b, 0x04, 0xa3, 0x65, 0x98, 0x64, 0x1b, 0x73, 0x2c, 0xd7, 0xe1, 0x6e, 0x8d,
-		0x9b, 0x17, 0xf9, 0xef, 0x9a, 0x83, 0x6e, 0xc0, 0x25, 0x89, 0x99, 0x65, 0x3b, 0x4e, 0xc8, 0x3d,
-		0x9a, 0x32, 0x4b, 0x92, 0xb6, 0xe9, 0x38, 0x21, 0xba, 0x03, 0x0b, 0xad, 0x36, 0xb5, 0xeb, 0x4d,
-		0x6c, 0x11, 0x6a, 0x53, 0x6c, 0xb9, 0x9e, 0xd5, 0xb0, 0x1b, 0xc7, 0x78, 0xa9, 0xc8, 0x99, 0xaf,
-		0xc8, 0xd5, 0x7d, 0xb6, 0x58, 0xf3, 0xb6, 0xd9, 0x12, 0xfa, 0x00, 0x96, 0x33, 0x42, 0x8e, 0x4d,
-		0xed, 0xba, 0x4d, 0xf0, 0xd2, 0x18, 0x97, 0x5b, 0x48, 0xca, 0xed, 0xc8, 0x55, 0xe3, 0x4f, 0x1a,
-		0xe8, 0xd1, 0x9e, 0xf6, 0x84, 0x1f, 0x7b, 0x3e, 0xa1, 0x11, 0xc2, 0x37, 0xe1, 0xd2, 0xb1, 0x4f,
-		0x28, 0x77, 0x17, 0x13, 0x22, 0x70, 0xde, 0xbb, 0x60, 0x96, 0x18, 0x75, 0x53, 0x10, 0xd1, 0x4a,
-		0x6c, 0xc7, 0x6c, 0x4b, 0xe3, 0x7b, 0x17, 0x7a, 0x7b, 0xfe, 0x2c, 0xf7, 0x2c, 0x8a, 0xa3, 0x9c,
-		0xc5, 0xde, 0x85, 0x9c, 0xd3, 0xd8, 0x9a, 0x86, 0x92, 0x23, 0x1d, 0xb7, 0xea, 0x5d, 0xe3, 0x5b,
-		0xbd, 0x78, 0xd9, 0x67, 0xa6, 0x77, 0x5c, 0x42, 0x43, 0xb7, 0x9e, 0x88, 0x97, 0x15, 0x98, 0x0a,
-		0xec, 0x23, 0x6c, 0x11, 0xf7, 0x73, 0x2c, 0xcf, 0x66, 0x92, 0x11, 0xf6, 0xdd, 0xcf, 0x31, 0x5a,
-		0x84, 0x8b, 0x7c, 0x31, 0xda, 0x84, 0x39, 0xc1, 0x7e, 0xd6, 0x1c, 0xe3, 0x8b, 0xd8, 0xb1, 0xe7,
-		0xa8, 0x96, 0xc7, 0xbe, 0x06, 0xb3, 0x5e, 0xbb, 0x55, 0xc7, 0xa1, 0xe5, 0x1f, 0x5a, 0x7c, 0xf3,
-		0x44, 0x9a, 0x28, 0x0b, 0xfa, 0x93, 0x43, 0x2e, 0x4c, 0xd0, 0x77, 0x60, 0x42, 0xae, 0x17, 0x56,
-		0x8b, 0x6b, 0xa5, 0xea, 0x4e, 0x25, 0x37, 0x4b, 0x54, 0x06, 0xda, 0xac, 0x08, 0x85, 0x0f, 0x3c,
-		0x1a, 0x76, 0x4d, 0xa9, 0x53, 0xff, 0x00, 0x4a, 0x31, 0x32, 0x9a, 0x85, 0xe2, 0x09, 0xee, 0x4a,
-		0x4f, 0xd8, 0x9f, 0x68, 0x0e, 0xc6, 0x3b, 0x76, 0xb3, 0x8d, 0x65, 0xf4, 0x89, 0x1f, 0x1f, 0x16,
-		0xde, 0xd7, 0x8c, 0x1f, 0x15, 0x60, 0x25, 0x37, 0x16, 0x46, 0xde, 0xe2, 0x0a, 0x4c, 0x45, 0x11,
-		0x21, 0x76, 0x39, 0x6e, 0x4e, 0xca, 0x80, 0x20, 0xe8, 0x13, 0xb8, 0x24, 0xee, 0x69, 0x2c, 0xb0,
-		0x4b, 0xd5, 0xdb, 0x49, 0x14, 0x44, 0x6e, 0xe0, 0x30, 0x70, 0x5e, 0x1e, 0xe8, 0x35, 0xef, 0xd0,
-		0x37, 0x4b, 0x4e, 0x8f, 0x80, 0xee, 0xc1, 0xa2, 0x30, 0xd4, 0xf0, 0x3d, 0x1a, 0xfa, 0xcd, 0x26,
-		0x0e, 0xf9, 0x15, 0x68, 0x13, 0x19, 0xf7, 0xf3, 0x7c, 0x79, 0xfb, 0x6c, 0x75, 0x9f, 0x2f, 0xa2,
-		0x25, 0xb8, 0x18, 0x85, 0xf4, 0x38, 0xe7, 0x8b, 0x7e, 0x1a, 0x15, 0xb8, 0xbc, 0xdd, 0xf4, 0x89,
-		0x40, 0x3d, 0x0a, 0x1c, 0xf5, 0x9d, 0x36, 0xe6, 0x00, 0xc5, 0xf9, 0x05, 0x54, 0xc6, 0xdf, 0x35,
-		0xb8, 0x6c, 0xe2, 0x96, 0xdf, 0xc1, 0x07, 0x36, 0x39, 0x19, 0xac, 0x06, 0x7d, 0x04, 0x53, 0xd4,
-		0x26, 0x27, 0x16, 0xed, 0x06, 0xe2, 0x64, 0xca, 0xd5, 0x55, 0x15, 0x22, 0x4c, 0xe5, 0x41, 0x37,
-		0xc0, 0xe6, 0x24, 0x95, 0x7f, 0xb1, 0xe0, 0xe5, 0xe2, 0xae, 0xc3, 0xe1, 0x2c, 0x9a, 0x13, 0xec,
-		0x67, 0xcd, 0x41, 0xdb, 0x30, 0xd3, 0xcb, 0xfa, 0x16, 0xab, 0x33, 0x1c, 0x98, 0x52, 0x55, 0xaf,
-		0x88, 0x1a, 0x53, 0x89, 0x6a, 0x4c, 0xe5, 0x20, 0x2a, 0x42, 0x66, 0xb9, 0x27, 0xc2, 0x88, 0x2c,
-		0x6f, 0xc9, 0x8a, 0x60, 0x79, 0x76, 0x0b, 0x4b, 0xc8, 0x4a, 0x92, 0xf6, 0x0d, 0xbb, 0x85, 0x19,
-		0x0c, 0xf1, 0xfd, 0x4a, 0x18, 0x7e, 0xce, 0x61, 0x20, 0x98, 0x3e, 0x63, 0x45, 0x60, 0x08, 0x18,
-		0xd2, 0x96, 0x0a, 0x19, 0x4b, 0x49, 0xa4, 0x8a, 0xa3, 0x22, 0x25, 0x1c, 0xed, 0x79, 0x24, 0x1d,
-		0xfd, 0xa5, 0x06, 0x73, 0x51, 0xe8, 0xff, 0xef, 0xf8, 0xfa, 0x04, 0xe6, 0x53, 0x4e, 0xc9, 0x9b,
-		0x78, 0x0f, 0x16, 0x83, 0xd0, 0x6f, 0x60, 0x42, 0x5c, 0xef, 0xc8, 0xe2, 0x15, 0x56, 0x64, 0x7e,
-		0x76, 0x21, 0x8b, 0x2c, 0xec, 0x7b, 0xcb, 0x5c, 0x92, 0xa7, 0x7d, 0x62, 0xfc, 0xb3, 0x00, 0xb7,
-		0x77, 0x31, 0xcd, 0x16, 0x2f, 0xfb, 0x54, 0x5e, 0xf8, 0xe7, 0xd5, 0xd7, 0x53, 0x5c, 0xd1, 0xa7,
-		0x50, 0x22, 0xd4, 0x0e, 0xa9, 0x85, 0x3b, 0xd8, 0xa3, 0x32, 0x29, 0xfc, 0xbf, 0x0a, 0xac, 0xe7,
-		0x38, 0x24, 0xac, 0x32, 0x08, 0xa7, 0x6b, 0x14, 0xb7, 0x4c, 0xe0, 0xe2, 0x0f, 0x98, 0x34, 0xda,
-		0x85, 0x29, 0xec, 0x39, 0x52, 0xd5, 0xd8, 0xc8, 0xaa, 0x26, 0xb1, 0xe7, 0x08, 0x45, 0x89, 0x8a,
-		0x31, 0x9e, 0xaa, 0x18, 0x6f, 0xc1, 0x8c, 0x87, 0x5f, 0x52, 0x8b, 0x73, 0x50, 0xff, 0x04, 0x7b,
-		0x4b, 0x13, 0xab, 0xda, 0xda, 0x25, 0x73, 0x9a, 0x91, 0x9f, 0xda, 0x47, 0xf8, 0x80, 0x11, 0x8d,
-		0xbf, 0x69, 0xb0, 0x36, 0x18, 0x75, 0x79, 0xb4, 0x39, 0x4a, 0xb5, 0x1c, 0xa5, 0xe8, 0x21, 0xcc,
-		0x44, 0xbd, 0x44, 0xdd, 0xa6, 0x8d, 0x63, 0x1c, 0x95, 0x93, 0x6b, 0xb9, 0x67, 0xc0, 0x0a, 0xfe,
-		0x56, 0xd3, 0xaf, 0x9b, 0x65, 0x29, 0xb5, 0x25, 0x84, 0xd0, 0x13, 0x98, 0xe9, 0x08, 0x04, 0x2c,
-		0xb9, 0x92, 0x5f, 0x9c, 0x55, 0x80, 0x99, 0xe5, 0x4e, 0xe2, 0xb7, 0xf1, 0x63, 0x0d, 0xae, 0xed,
-		0x62, 0x6a, 0xf6, 0x5a, 0xba, 0xc7, 0x98, 0x10, 0xfb, 0x08, 0x93, 0x28, 0xb2, 0x3e, 0x86, 0x09,
-		0xbe, 0x31, 0x11, 0xac, 0xa5, 0xea, 0x9a, 0xca, 0x52, 0x4c, 0x07, 0xdf, 0xb4, 0x29, 0xe5, 0x86,
-		0xb8, 0x7a, 0xc6, 0x0f, 0x0b, 0x70, 0x5d, 0xe5, 0x86, 0x84, 0xda, 0x87, 0xb2, 0xb8, 0xdb, 0x2d,
-		0xb9, 0x22, 0xfd, 0xd9, 0x53, 0x14, 0xe4, 0xfe, 0xea, 0x44, 0x35, 0x8e, 0xa8, 0xa2, 0x28, 0x4f,
-		0x93, 0x38, 0x4d, 0x6f, 0x01, 0xca, 0x32, 0xe5, 0x94, 0xe8, 0xcd, 0x78, 0x89, 0x2e, 0x55, 0xdf,
-		0x1e, 0x02, 0x9f, 0x33, 0x6f, 0x62, 0xf5, 0xdc, 0x83, 0xd5, 0x5d, 0x4c, 0x77, 0x1e, 0x3d, 0xeb,
-		0x73, 0x16, 0x9f, 0x00, 0x88, 0xc2, 0xe1, 0x1d, 0xfa, 0xd1, 0xfe, 0x87, 0xb1, 0xc7, 0xb2, 0x15,
-		0x2f, 0xc7, 0x3c, 0xc1, 0xb1, 0xbf, 0x88, 0xd1, 0x85, 0x1b, 0x7d, 0xec, 0x49, 0xd0, 0x0f, 0xe0,
-		0x72, 0xac, 0xdb, 0xb7, 0x98, 0x74, 0x64, 0xf7, 0xf6, 0x90, 0x76, 0xcd, 0xd9, 0x30, 0x49, 0x20,
-		0xc6, 0xbf, 0x34, 0xb8, 0xc9, 0x6c, 0xf3, 0x14, 0xd5, 0x67, 0xbb, 0xcf, 0x61, 0xb9, 0x69, 0x13,
-		0x6a, 0x85, 0x98, 0x86, 0x2e, 0xee, 0xe0, 0xb3, 0xb3, 0x8f, 0xf2, 0x7b, 0xa9, 0xba, 0x92, 0x29,
-		0x8c, 0x35, 0x8f, 0xde, 0x7b, 0xef, 0x39, 0x83, 0xd5, 0x5c, 0x60, 0xd2, 0x66, 0x24, 0x2c, 0xb5,
-		0xd7, 0x9c, 0x33, 0xbd, 0x32, 0xed, 0x26, 0xf5, 0x16, 0x86, 0xd4, 0xfb, 0x34, 0x12, 0xee, 0xe9,
-		0x4d, 0x07, 0x7a, 0x31, 0x1b, 0xe8, 0x3e, 0xbc, 0xd9, 0x7f, 0xe7, 0x12, 0xf8, 0x5d, 0x98, 0x8c,
-		0xc5, 0xf9, 0xc8, 0x71, 0x75, 0x26, 0x6c, 0xfc, 0x51, 0x83, 0x39, 0x13, 0xdb, 0x41, 0xd0, 0xec,
-		0xf2, 0x24, 0x49, 0x5e, 0x53, 0xc5, 0xb8, 0x0b, 0x13, 0x3c, 0xc1, 0x13, 0x99, 0xb0, 0x06, 0x24,
-		0x3e, 0xc9, 0x6c, 0x2c, 0xc2, 0x7c, 0xca, 0x7b, 0xd9, 0x03, 0xfc, 0xba, 0x00, 0xcb, 0x9b, 0x8e,
-		0xb3, 0x8f, 0xed, 0xb0, 0x71, 0xbc, 0x49, 0x45, 0xbb, 0x7d, 0xd6, 0x08, 0x04, 0x30, 0x4b, 0xf8,
-		0x8a, 0x65, 0x47, 0x4b, 0x32, 0x6c, 0x1f, 0x28, 0xd2, 0x85, 0x52, 0x57, 0x25, 0x45, 0x16, 0xb9,
-		0x62, 0x86, 0x24, 0xa9, 0xe8, 0x16, 0x94, 0x09, 0x6e, 0xb4, 0x43, 0xde, 0xb8, 0xf1, 0x42, 0x20,
-		0xd2, 0xdc, 0x74, 0x44, 0xe5, 0x39, 0x51, 0x77, 0x61, 0x2e, 0x4f, 0x5f, 0x3c, 0xad, 0x4c, 0x89,
-		0xb4, 0x72, 0x3f, 0x9e, 0x56, 0xca, 0xd5, 0x5b, 0xb9, 0x78, 0xd5, 0x3c, 0x07, 0xbf, 0xc4, 0x0e,
-		0x0f, 0x4b, 0xde, 0x8e, 0xc4, 0x12, 0xca, 0x55, 0xd0, 0xf3, 0x36, 0x25, 0xf1, 0x5b, 0x82, 0x85,
-		0xa8, 0x5b, 0xd9, 0x16, 0xf1, 0x29, 0xf7, 0x6b, 0xfc, 0xa1, 0x08, 0x8b, 0x99, 0x25, 0x19, 0x96,
-		0xc7, 0xb0, 0x4c, 0xda, 0x41, 0xe0, 0x87, 0x14, 0x3b, 0x56, 0xa3, 0xe9, 0x62, 0x8f, 0x5a, 0xb2,
-		0xa2, 0x44, 0x71, 0xfa, 0x4e, 0xae, 0xa3, 0xfb, 0x91, 0xd4, 0x36, 0x17, 0x92, 0x55, 0x89, 0x98,
-		0x8b, 0x24, 0x7f, 0x81, 0x55, 0xba, 0x16, 0x66, 0xcf, 0x14, 0x72, 0xec, 0x06, 0x3c, 0xe1, 0xe5,
-		0xc7, 0x60, 0xef, 0x1e, 0x3c, 0x3e, 0x63, 0xe7, 0xa9, 0xae, 0xdc, 0x4a, 0xfc, 0x46, 0x1e, 0xcc,
-		0x06, 0x4c, 0x39, 0xa1, 0x4c, 0x4e, 0x68, 0x2c, 0xf2, 0x90, 0xd8, 0x1e, 0xf0, 0xa4, 0x4b, 0x81,
-		0x50, 0x79, 0xda, 0x53, 0xc3, 0x34, 0xcb, 0x80, 0x08, 0x92, 0x54, 0xfd, 0x04, 0xe6, 0xf2, 0x18,
-		0x73, 0x4e, 0xfa, 0xa3, 0x64, 0x01, 0x51, 0x26, 0xd6, 0x94, 0xba, 0xf8, 0x59, 0xff, 0xb6, 0x00,
-		0x0b, 0x26, 0xb6, 0x9d, 0x9d, 0x47, 0xcf, 0xd2, 0x49, 0xf4, 0x0e, 0x8c, 0xf1, 0x86, 0x56, 0xe3,
-		0x61, 0xf4, 0x86, 0xf2, 0xe1, 0xf6, 0xe8, 0x19, 0x0f, 0x20, 0xce, 0x9c, 0x68, 0xa4, 0x0b, 0xc9,
-		0x46, 0x9a, 0x05, 0xba, 0xdf, 0x0e, 0x1b, 0xd8, 0x92, 0x79, 0x4d, 0xa6, 0xb9, 0x69, 0x41, 0x95,
-		0x60, 0xa1, 0x03, 0x58, 0x72, 0x3d, 0xc6, 0xe1, 0x76, 0xb0, 0xc5, 0xda, 0xbb, 0x58, 0x8a, 0x1d,
-		0x1b, 0x9c, 0x62, 0xe7, 0xcf, 0x84, 0x1f, 0x78, 0xb1, 0x0c, 0xfb, 0x4a, 0x3a, 0xbc, 0xdf, 0x17,
-		0x60, 0x31, 0x03, 0x96, 0x0c, 0xf0, 0x73, 0xa1, 0x95, 0x5b, 0x25, 0x0b, 0xff, 0x61, 0x95, 0x44,
-		0x36, 0x2c, 0x64, 0xb4, 0xc6, 0xc3, 0x76, 0xa4, 0xc2, 0x3f, 0x97, 0x56, 0xcf, 0xef, 0x44, 0x0e,
-		0x62, 0x63, 0x79, 0x88, 0x7d, 0xa1, 0xc1, 0xe2, 0xd3, 0x76, 0x78, 0x84, 0xbf, 0xe4, 0xf1, 0x65,
-		0xe8, 0xb0, 0x94, 0xdd, 0xa7, 0xcc, 0x98, 0xbf, 0x2b, 0xc0, 0xe2, 0x63, 0xfc, 0xe5, 0x07, 0xe1,
-		0xd5, 0x5c, 0xb2, 0x2d, 0x58, 0xca, 0x82, 0x35, 0xda, 0xab, 0xc9, 0xf8, 0xa9, 0x06, 0x2b, 0x26,
-		0x3e, 0x0c, 0x31, 0x39, 0x8e, 0x7a, 0x0c, 0x1e, 0xbb, 0xaf, 0x69, 0xa2, 0x7c, 0x1d, 0xae, 0xe6,
-		0x7b, 0x23, 0x03, 0xe4, 0xcf, 0x05, 0xb8, 0x66, 0x62, 0x82, 0x3d, 0x27, 0x75, 0x03, 0x49, 0x6c,
-		0xa4, 0x29, 0x87, 0x69, 0xb2, 0x81, 0x9d, 0x32, 0x27, 0x05, 0xa1, 0xe6, 0xfc, 0xb7, 0x1a, 0xaf,
-		0x5b, 0x50, 0x0e, 0x71, 0xcb, 0xa7, 0x99, 0x50, 0x12, 0xd4, 0x28, 0x94, 0x52, 0x2f, 0xfa, 0xb1,
-		0x57, 0xf7, 0xa2, 0x1f, 0x3f, 0xff, 0x8b, 0xde, 0x58, 0x85, 0xeb, 0x2a, 0x44, 0x25, 0xe8, 0x36,
-		0xac, 0xec, 0x62, 0xba, 0x1d, 0xfa, 0x84, 0xc8, 0xad, 0xa4, 0x11, 0xef, 0xcd, 0x36, 0xb5, 0xd4,
-		0x6c, 0xf3, 0x16, 0x94, 0xa9, 0x1d, 0x1e, 0x61, 0x7a, 0x06, 0x8d, 0xec, 0xd9, 0x04, 0x55, 0xea,
-		0x33, 0xfe, 0x51, 0x84, 0xab, 0xf9, 0x36, 0x64, 0x3c, 0x9f, 0x30, 0x3d, 0x2c, 0x3b, 0xd7, 0xbb,
-		0x62, 0xd2, 0x3a, 0xa0, 0xd7, 0xec, 0xa7, 0x8c, 0x4f, 0x96, 0xc8, 0x56, 0x97, 0x3f, 0x3d, 0x45,
-		0x6b, 0x71, 0x89, 0xc6, 0x48, 0xe8, 0xfb, 0x30, 0x7f, 0x68, 0xbb, 0x4d, 0xd6, 0x7f, 0xd9, 0x6d,
-		0x82, 0x7b, 0x36, 0x45, 0xc1, 0xf9, 0xf4, 0x3c, 0x36, 0x1f, 0x72, 0x85, 0xdb, 0x4c, 0x5f, 0xc2,
-		0x32, 0x3a, 0xcc, 0x2c, 0xe8, 0x2f, 0xe0, 0x72, 0xc6, 0xc5, 0x9c, 0x57, 0xf1, 0xc3, 0x64, 0x53,
-		0xf3, 0xae, 0xea, 0xf8, 0xd3, 0x4e, 0xc9, 0x83, 0x8b, 0x3f, 0x8d, 0xf5, 0x17, 0xb0, 0xa8, 0xf0,
-		0x30, 0xc7, 0xf0, 0xc7, 0xc9, 0xbe, 0x59, 0x19, 0x77, 0xbb, 0x98, 0x32, 0x7b, 0x31, 0xc5, 0x31,
-		0x93, 0xd5, 0xbf, 0x5e, 0x81, 0xc9, 0x4d, 0x86, 0xdd, 0xe6, 0xd3, 0x1a, 0xfa, 0x99, 0x06, 0xcb,
-		0xca, 0x4f, 0x49, 0xe8, 0xab, 0x03, 0xda, 0x47, 0xd5, 0x07, 0x31, 0xfd, 0xfd, 0xd1, 0x05, 0x65,
-		0xc0, 0x7d, 0x0f, 0xae, 0xe4, 0x8c, 0xfe, 0xd1, 0xc6, 0x00, 0x85, 0xd9, 0x4f, 0x46, 0x7a, 0x75,
-		0x14, 0x11, 0x69, 0x3d, 0x0e, 0x47, 0xe6, 0x73, 0xc7, 0x40, 0x38, 0x54, 0xdf, 0x7b, 0x06, 0xc2,
-		0xa1, 0xfe, 0x9a, 0x63, 0x03, 0xf4, 0xa6, 0xfa, 0x68, 0x4d, 0xa1, 0x27, 0xf3, 0xa1, 0x40, 0xff,
-		0xbf, 0x21, 0x38, 0x7b, 0x26, 0x7a, 0x13, 0x73, 0xa5, 0x89, 0xcc, 0x47, 0x04, 0xa5, 0x89, 0xec,
-		0xf8, 0x5d, 0x98, 0x88, 0x66, 0xdd, 0x7d, 0x4c, 0xa4, 0x06, 0xf4, 0x7d, 0x4c, 0xa4, 0x07, 0xe7,
-		0xe8, 0xbb, 0x30, 0x9d, 0x18, 0x51, 0xa3, 0xb7, 0x07, 0x60, 0x9e, 0x30, 0xf4, 0xce, 0x70, 0xcc,
-		0xd2, 0xd6, 0x6f, 0x34, 0x3e, 0xd0, 0xea, 0x3b, 0x47, 0x45, 0x5f, 0x53, 0x67, 0xab, 0x61, 0xc6,
-		0xde, 0xfa, 0xd7, 0xcf, 0x2d, 0x2f, 0xbd, 0xfc, 0x89, 0x06, 0x0b, 0xf9, 0x93, 0x42, 0xf4, 0xde,
-		0x88, 0x83, 0x45, 0xe1, 0xd1, 0xdd, 0x73, 0x8d, 0x23, 0xf9, 0x9d, 0x52, 0x8e, 0xe3, 0x94, 0x77,
-		0x6a, 0xd0, 0xc0, 0x50, 0x79, 0xa7, 0x06, 0x4f, 0xfe, 0x7e, 0xa5, 0xf1, 0xa2, 0xa7, 0x9c, 0x54,
-		0xa1, 0x0f, 0xfb, 0xa8, 0x1e, 0x30, 0xd8, 0xd3, 0xef, 0x9f, 0x4b, 0xb6, 0x17, 0xc4, 0x89, 0x91,
-		0x90, 0x32, 0x88, 0xf3, 0xc6, 0x5e, 0xca, 0x20, 0xce, 0x9d, 0x32, 0xa1, 0x2e, 0xa0, 0xec, 0x0c,
-		0x05, 0xbd, 0x3b, 0xea, 0x0c, 0x49, 0xdf, 0x18, 0x41, 0x42, 0x9a, 0x0e, 0x60, 0x26, 0x35, 0x80,
-		0x40, 0x5f, 0x19, 0x76, 0x50, 0x21, 0x8c, 0x56, 0x46, 0x9b, 0x6b, 0x30, 0x8b, 0xa9, 0x67, 0xb1,
-		0xd2, 0x62, 0xfe, 0xac, 0x41, 0x69, 0x51, 0xf5, 0xda, 0x26, 0x30, 0x9b, 0x7e, 0x6e, 0x21, 0x95,
-		0x0e, 0xc5, 0xfb, 0x53, 0x5f, 0x1f, 0x9a, 0xbf, 0x67, 0x34, 0xfd, 0x32, 0x51, 0x1a, 0x55, 0xbc,
-		0xf7, 0x94, 0x46, 0x95, 0x4f, 0x9e, 0x1f, 0xc0, 0x5c, 0xde, 0xdb, 0x01, 0x55, 0x95, 0x88, 0x29,
-		0x9f, 0x3d, 0xfa, 0x9d, 0x91, 0x64, 0x62, 0x89, 0x2e, 0xbf, 0x95, 0x56, 0x26, 0xba, 0xbe, 0x6f,
-		0x19, 0x65, 0xa2, 0xeb, 0xdf, 0xaf, 0x33, 0x20, 0xf2, 0x5a, 0x51, 0x25, 0x10, 0x7d, 0x9a, 0x7b,
-		0x25, 0x10, 0xfd, 0x7a, 0xdd, 0xad, 0xbb, 0xdf, 0xbe, 0x73, 0xe4, 0xd2, 0xe3, 0x76, 0xbd, 0xd2,
-		0xf0, 0x5b, 0xeb, 0x89, 0x7f, 0x71, 0xaa, 0x1c, 0x61, 0x4f, 0xfc, 0x17, 0xd7, 0xd9, 0xbf, 0x88,
-		0xdd, 0xe7, 0x7f, 0x74, 0x36, 0xea, 0x13, 0x9c, 0x7e, 0xe7, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-		0x75, 0x68, 0xdf, 0xd7, 0x4a, 0x26, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4b, 0x8c, 0x1b, 0xc7,
+		0xb1, 0x1e, 0x72, 0x3f, 0xdc, 0xe2, 0x2e, 0xb5, 0x1a, 0xed, 0x87, 0x3b, 0xab, 0xcf, 0x6a, 0x64,
+		0xd9, 0x6b, 0x4b, 0xe6, 0x5a, 0x94, 0x25, 0xcb, 0x36, 0xfc, 0xec, 0xfd, 0x69, 0xb5, 0xb6, 0xd6,
+		0x96, 0x46, 0xfb, 0xe4, 0x87, 0x87, 0xf7, 0x30, 0x18, 0x72, 0x7a, 0xc9, 0xc9, 0x92, 0x33, 0xd4,
+		0xf4, 0x90, 0x2b, 0x1a, 0x41, 0x12, 0x18, 0xc9, 0xc9, 0x41, 0x90, 0x20, 0x87, 0x1c, 0x13, 0x20,
+		0xb7, 0xe4, 0x10, 0x38, 0x09, 0x72, 0xca, 0x39, 0xe7, 0xe4, 0x9c, 0x9c, 0x0d, 0x04, 0x06, 0x02,
+		0x04, 0xb9, 0xe4, 0x18, 0x74, 0x77, 0x0d, 0x67, 0x86, 0x9c, 0x21, 0x87, 0x1b, 0x07, 0x76, 0x8c,
+		0xdc, 0x38, 0xdd, 0xf5, 0xe9, 0xaa, 0xae, 0xae, 0xaa, 0xae, 0x2e, 0xc2, 0x95, 0x76, 0x85, 0xb8,
+		0x1b, 0x55, 0xc3, 0x24, 0x76, 0x95, 0x6c, 0x18, 0x66, 0xd3, 0xb2, 0x37, 0x3a, 0x37, 0x36, 0x28,
+		0x71, 0x3b, 0x56, 0x95, 0x94, 0x5a, 0xae, 0xe3, 0x39, 0xf2, 0x22, 0x03, 0x2a, 0x21, 0x50, 0x89,
+		0x03, 0x95, 0x3a, 0x37, 0x94, 0x4b, 0x35, 0xc7, 0xa9, 0x35, 0xc8, 0x06, 0x07, 0xaa, 0xb4, 0x8f,
+		0x36, 0x3c, 0xab, 0x49, 0xa8, 0x67, 0x34, 0x5b, 0x02, 0x4f, 0xb9, 0xd8, 0x0f, 0x70, 0xe2, 0x1a,
+		0xad, 0x16, 0x71, 0x29, 0xce, 0xaf, 0x45, 0x99, 0xb7, 0x2c, 0xc6, 0xba, 0xea, 0x34, 0x9b, 0x8e,
+		0x8d, 0x10, 0x6a, 0x1c, 0x84, 0x67, 0xd0, 0xe3, 0x86, 0x45, 0x3d, 0x84, 0x79, 0x36, 0x0e, 0xa6,
+		0x63, 0x51, 0xab, 0x62, 0x35, 0x2c, 0xaf, 0x1b, 0x0b, 0x45, 0xeb, 0x86, 0x4b, 0x4c, 0xce, 0xae,
+		0xd1, 0xa6, 0x1e, 0x71, 0x47, 0x40, 0xd5, 0x2d, 0xea, 0x39, 0x6e, 0x37, 0x76, 0x55, 0x01, 0xd4,
+		0x93, 0x36, 0x69, 0xa3, 0xce, 0x94, 0xf5, 0x04, 0x18, 0x97, 0xb4, 0x1a, 0x56, 0xd5, 0xf0, 0x2c,
+		0x5f, 0x46, 0xf5, 0x07, 0x12, 0xac, 0xed, 0x10, 0x5a, 0x75, 0xad, 0x0a, 0xf9, 0xc0, 0x71, 0x8f,
+		0x8f, 0x1a, 0xce, 0xc9, 0xee, 0x53, 0x52, 0x6d, 0x33, 0x18, 0x8d, 0x3c, 0x69, 0x13, 0xea, 0xc9,
+		0x4b, 0x30, 0x65, 0x3a, 0x4d, 0xc3, 0xb2, 0x8b, 0xd2, 0x9a, 0xb4, 0x3e, 0xa3, 0xe1, 0x97, 0xfc,
+		0xdf, 0x20, 0x9f, 0x20, 0x8e, 0x4e, 0x7c, 0xa4, 0x62, 0x66, 0x4d, 0x5a, 0xcf, 0x97, 0x9f, 0x2b,
+		0x45, 0xf7, 0xad, 0x65, 0x95, 0x3a, 0x37, 0x4a, 0x83, 0x2c, 0xce, 0x9e, 0xf4, 0x0f, 0xa9, 0x7f,
+		0x90, 0xe0, 0xf2, 0x90, 0x35, 0xd1, 0x96, 0x63, 0x53, 0x22, 0xaf, 0x40, 0x8e, 0x09, 0x66, 0xea,
+		0x96, 0xc9, 0x97, 0x35, 0xa9, 0x4d, 0xf3, 0xef, 0x7d, 0x53, 0xbe, 0x0c, 0xb3, 0xa8, 0x33, 0xdd,
+		0x30, 0x4d, 0x97, 0xaf, 0x68, 0x46, 0xcb, 0xe3, 0xd8, 0xa6, 0x69, 0xba, 0xf2, 0x4d, 0x58, 0x6a,
+		0xb6, 0x3d, 0xa3, 0xd2, 0x20, 0x3a, 0xf5, 0x0c, 0x8f, 0xe8, 0x96, 0xad, 0x57, 0x8d, 0x6a, 0x9d,
+		0x14, 0xb3, 0x1c, 0xf8, 0x1c, 0xce, 0x3e, 0x62, 0x93, 0xfb, 0xf6, 0x36, 0x9b, 0x92, 0x5f, 0x83,
+		0x95, 0x01, 0x24, 0xd3, 0xf0, 0x8c, 0x8a, 0x41, 0x49, 0x71, 0x82, 0xe3, 0x2d, 0x45, 0xf1, 0x76,
+		0x70, 0x56, 0xfd, 0x9d, 0x04, 0x8a, 0x2f, 0xd3, 0x3d, 0xb1, 0x8e, 0x7b, 0x0e, 0xf5, 0x7c, 0x0d,
+		0x5f, 0x81, 0xd9, 0xba, 0x43, 0x3d, 0xbe, 0x5c, 0x42, 0xa9, 0xd0, 0xf3, 0xbd, 0x67, 0xb4, 0x3c,
+		0x1b, 0xdd, 0x14, 0x83, 0xf2, 0x6a, 0x48, 0x62, 0x26, 0xd2, 0xe4, 0xbd, 0x67, 0x02, 0x99, 0x3f,
+		0x88, 0xdd, 0x8b, 0xec, 0x38, 0x7b, 0x71, 0xef, 0x99, 0x98, 0xdd, 0xd8, 0x9a, 0x83, 0xbc, 0x89,
+		0x0b, 0xd7, 0x2b, 0x5d, 0xf5, 0x7f, 0x02, 0x7b, 0x79, 0xc4, 0x58, 0xef, 0x58, 0xd4, 0x73, 0xad,
+		0x4a, 0xc4, 0x5e, 0x56, 0x61, 0xa6, 0x65, 0xd4, 0x88, 0x4e, 0xad, 0x0f, 0x09, 0xee, 0x4d, 0x8e,
+		0x0d, 0x3c, 0xb2, 0x3e, 0x24, 0xf2, 0x32, 0x4c, 0xf3, 0x49, 0x5f, 0x08, 0x6d, 0x8a, 0x7d, 0xee,
+		0x9b, 0xea, 0xa7, 0xa1, 0x6d, 0x8f, 0x21, 0x8d, 0xdb, 0xbe, 0x0e, 0xf3, 0x76, 0xbb, 0x59, 0x21,
+		0xae, 0xee, 0x1c, 0xe9, 0x5c, 0x78, 0x8a, 0x2c, 0x0a, 0x62, 0xfc, 0xfd, 0x23, 0x8e, 0x4c, 0xe5,
+		0xff, 0x83, 0x29, 0x9c, 0xcf, 0xac, 0x65, 0xd7, 0xf3, 0xe5, 0x9d, 0x52, 0xac, 0x27, 0x29, 0x8d,
+		0xe4, 0x59, 0x12, 0x04, 0x77, 0x6d, 0xcf, 0xed, 0x6a, 0x48, 0x53, 0x79, 0x0d, 0xf2, 0xa1, 0x61,
+		0x79, 0x1e, 0xb2, 0xc7, 0xa4, 0x8b, 0x2b, 0x61, 0x3f, 0xe5, 0x05, 0x98, 0xec, 0x18, 0x8d, 0x36,
+		0x41, 0xeb, 0x13, 0x1f, 0xaf, 0x67, 0xee, 0x48, 0xea, 0x47, 0x19, 0x58, 0x8d, 0xb5, 0x85, 0xb1,
+		0x45, 0x5c, 0x85, 0x19, 0xdf, 0x22, 0x84, 0x94, 0x93, 0x5a, 0x0e, 0x0d, 0x82, 0xca, 0xef, 0xc0,
+		0xac, 0x38, 0xa7, 0x21, 0xc3, 0xce, 0x97, 0x9f, 0x8f, 0x6a, 0x41, 0xf8, 0x06, 0xae, 0x06, 0x0e,
+		0xcb, 0x0d, 0x7d, 0xdf, 0x3e, 0x72, 0xb4, 0xbc, 0x19, 0x0c, 0xc8, 0xb7, 0x61, 0x59, 0x30, 0xaa,
+		0x3a, 0xb6, 0xe7, 0x3a, 0x8d, 0x06, 0x71, 0xf9, 0x11, 0x68, 0x53, 0xb4, 0xfb, 0x45, 0x3e, 0xbd,
+		0xdd, 0x9b, 0x7d, 0xc4, 0x27, 0xe5, 0x22, 0x4c, 0xfb, 0x26, 0x3d, 0xc9, 0xe1, 0xfc, 0x4f, 0xb5,
+		0x04, 0x67, 0xb7, 0x1b, 0x0e, 0x15, 0x5a, 0xf7, 0x0d, 0x27, 0xf9, 0x4c, 0xab, 0x0b, 0x20, 0x87,
+		0xe1, 0x85, 0xaa, 0xd4, 0xbf, 0x48, 0x70, 0x56, 0x23, 0x4d, 0xa7, 0x43, 0x0e, 0x0d, 0x7a, 0x3c,
+		0x9a, 0x8c, 0xfc, 0x26, 0xcc, 0x30, 0x0f, 0xae, 0x7b, 0xdd, 0x96, 0xd8, 0x99, 0x42, 0x79, 0x2d,
+		0x49, 0x23, 0x8c, 0xe4, 0x61, 0xb7, 0x45, 0xb4, 0x9c, 0x87, 0xbf, 0x98, 0xf1, 0x72, 0x74, 0xcb,
+		0xe4, 0xea, 0xcc, 0x6a, 0x53, 0xec, 0x73, 0xdf, 0x94, 0xb7, 0xe1, 0x4c, 0xe0, 0xf5, 0x75, 0x16,
+		0x8b, 0xb8, 0x62, 0xf2, 0x65, 0xa5, 0x24, 0xe2, 0x50, 0xc9, 0x8f, 0x43, 0xa5, 0x43, 0x3f, 0x50,
+		0x69, 0x85, 0x00, 0x85, 0x0d, 0x32, 0xbf, 0x85, 0x11, 0x41, 0xb7, 0x8d, 0x26, 0x41, 0x95, 0xe5,
+		0x71, 0xec, 0x3d, 0xa3, 0x49, 0x98, 0x1a, 0xc2, 0xf2, 0xa2, 0x1a, 0xbe, 0xcf, 0xd5, 0x40, 0x89,
+		0xf7, 0xb0, 0x4d, 0xda, 0x24, 0x85, 0x1a, 0xfa, 0x39, 0x65, 0x06, 0x38, 0x45, 0x35, 0x95, 0x1d,
+		0x57, 0x53, 0x62, 0xa1, 0xc1, 0x8a, 0x70, 0xa1, 0x3f, 0x94, 0x60, 0xc1, 0x37, 0xfd, 0x2f, 0xcf,
+		0x5a, 0xdf, 0x87, 0xc5, 0xbe, 0x45, 0xe1, 0x49, 0xbc, 0x0d, 0xcb, 0x2d, 0xd7, 0xa9, 0x12, 0x4a,
+		0x2d, 0xbb, 0xa6, 0xf3, 0x08, 0x2b, 0x3c, 0x3f, 0x3b, 0x90, 0x59, 0x66, 0xf6, 0xc1, 0x34, 0xc7,
+		0xe4, 0x6e, 0x9f, 0xaa, 0x7f, 0xcb, 0xc0, 0xf3, 0x7b, 0xc4, 0x1b, 0x0c, 0x5e, 0xc6, 0x09, 0x1e,
+		0xf8, 0xc7, 0xe5, 0x2f, 0x26, 0xb8, 0xca, 0xef, 0x42, 0x9e, 0x7a, 0x86, 0xeb, 0xe9, 0xa4, 0x43,
+		0x6c, 0x0f, 0x9d, 0xc2, 0x8b, 0x49, 0xca, 0x7a, 0x4c, 0x5c, 0xca, 0x22, 0x83, 0x58, 0xf4, 0xbe,
+		0x47, 0x9a, 0x1a, 0x70, 0xf4, 0x5d, 0x86, 0x2d, 0xef, 0xc1, 0x0c, 0xb1, 0x4d, 0x24, 0x35, 0x31,
+		0x36, 0xa9, 0x1c, 0xb1, 0x4d, 0x41, 0x28, 0x12, 0x31, 0x26, 0xfb, 0x22, 0xc6, 0x73, 0x70, 0xc6,
+		0x26, 0x4f, 0x3d, 0x9d, 0x43, 0x78, 0xce, 0x31, 0xb1, 0x8b, 0x53, 0x6b, 0xd2, 0xfa, 0xac, 0x36,
+		0xc7, 0x86, 0x1f, 0x18, 0x35, 0x72, 0xc8, 0x06, 0xd5, 0xcf, 0x24, 0x58, 0x1f, 0xad, 0x75, 0xdc,
+		0xda, 0x18, 0xa2, 0x52, 0x0c, 0x51, 0xf9, 0x2e, 0x9c, 0xf1, 0x73, 0x89, 0x8a, 0xe1, 0x55, 0xeb,
+		0xc4, 0x0f, 0x27, 0x17, 0x62, 0xf7, 0x80, 0x05, 0xfc, 0xad, 0x86, 0x53, 0xd1, 0x0a, 0x88, 0xb5,
+		0x25, 0x90, 0xe4, 0xf7, 0xe1, 0x4c, 0x47, 0x68, 0x40, 0xc7, 0x99, 0xf8, 0xe0, 0x9c, 0xa4, 0x30,
+		0xad, 0xd0, 0x89, 0x7c, 0xab, 0xdf, 0x96, 0xe0, 0xc2, 0x1e, 0xf1, 0xb4, 0x20, 0xa5, 0x3b, 0x20,
+		0x94, 0x1a, 0x35, 0x42, 0x7d, 0xcb, 0x7a, 0x1b, 0xa6, 0xb8, 0x60, 0xc2, 0x58, 0xf3, 0xe5, 0xf5,
+		0x24, 0x4e, 0x21, 0x1a, 0x5c, 0x68, 0x0d, 0xf1, 0x52, 0x1c, 0x3d, 0xf5, 0x5b, 0x19, 0xb8, 0x98,
+		0xb4, 0x0c, 0x54, 0xb5, 0x03, 0x05, 0x71, 0xb6, 0x9b, 0x38, 0x83, 0xeb, 0xb9, 0x97, 0x10, 0x90,
+		0x87, 0x93, 0x13, 0xd1, 0xd8, 0x1f, 0x15, 0x41, 0x79, 0x8e, 0x86, 0xc7, 0x94, 0x26, 0xc8, 0x83,
+		0x40, 0x31, 0x21, 0x7a, 0x33, 0x1c, 0xa2, 0xf3, 0xe5, 0x6b, 0x29, 0xf4, 0xd3, 0x5b, 0x4d, 0x28,
+		0x9e, 0xdb, 0xb0, 0xb6, 0x47, 0xbc, 0x9d, 0xfb, 0x0f, 0x87, 0xec, 0xc5, 0x3b, 0x00, 0x22, 0x70,
+		0xd8, 0x47, 0x8e, 0x2f, 0x7f, 0x1a, 0x7e, 0xcc, 0x5b, 0xf1, 0x70, 0x3c, 0xe3, 0xe1, 0x2f, 0xaa,
+		0x76, 0xe1, 0xf2, 0x10, 0x7e, 0xa8, 0xf4, 0x43, 0x38, 0x1b, 0xca, 0xf6, 0x75, 0x86, 0xed, 0xf3,
+		0x7d, 0x3e, 0x25, 0x5f, 0x6d, 0xde, 0x8d, 0x0e, 0x50, 0xf5, 0xef, 0x12, 0x5c, 0x61, 0xbc, 0xb9,
+		0x8b, 0x1a, 0x22, 0xee, 0x63, 0x58, 0x69, 0x18, 0xd4, 0xd3, 0x5d, 0xe2, 0xb9, 0x16, 0xe9, 0x90,
+		0xde, 0xde, 0xfb, 0xfe, 0x3d, 0x5f, 0x5e, 0x1d, 0x08, 0x8c, 0xfb, 0xb6, 0x77, 0xfb, 0x95, 0xc7,
+		0x4c, 0xad, 0xda, 0x12, 0xc3, 0xd6, 0x7c, 0x64, 0xa4, 0xbe, 0x6f, 0xf6, 0xe8, 0xa2, 0xdb, 0x8d,
+		0xd2, 0xcd, 0xa4, 0xa4, 0xfb, 0xc0, 0x47, 0x0e, 0xe8, 0xf6, 0x1b, 0x7a, 0x76, 0xd0, 0xd0, 0x1d,
+		0x78, 0x76, 0xb8, 0xe4, 0xa8, 0xf8, 0x3d, 0xc8, 0x85, 0xec, 0x7c, 0x6c, 0xbb, 0xea, 0x21, 0xab,
+		0xbf, 0x95, 0x60, 0x41, 0x23, 0x46, 0xab, 0xd5, 0xe8, 0x72, 0x27, 0x49, 0xbf, 0xa0, 0x88, 0x71,
+		0x0b, 0xa6, 0xb8, 0x83, 0xa7, 0xe8, 0xb0, 0x46, 0x38, 0x3e, 0x04, 0x56, 0x97, 0x61, 0xb1, 0x6f,
+		0xf5, 0x98, 0x03, 0xfc, 0x38, 0x03, 0x2b, 0x9b, 0xa6, 0xf9, 0x88, 0x18, 0x6e, 0xb5, 0xbe, 0xe9,
+		0x89, 0x74, 0xbb, 0x97, 0x08, 0xb4, 0x60, 0x9e, 0xf2, 0x19, 0xdd, 0xf0, 0xa7, 0xd0, 0x6c, 0x77,
+		0x13, 0xdc, 0x45, 0x22, 0xad, 0x52, 0xdf, 0xb0, 0xf0, 0x15, 0x67, 0x68, 0x74, 0x54, 0xbe, 0x0a,
+		0x05, 0x4a, 0xaa, 0x6d, 0x97, 0x27, 0x6e, 0x3c, 0x10, 0x08, 0x37, 0x37, 0xe7, 0x8f, 0x72, 0x9f,
+		0xa8, 0x58, 0xb0, 0x10, 0x47, 0x2f, 0xec, 0x56, 0x66, 0x84, 0x5b, 0x79, 0x23, 0xec, 0x56, 0x0a,
+		0xe5, 0xab, 0xb1, 0xfa, 0xda, 0xb7, 0x4d, 0xf2, 0x94, 0x98, 0xdc, 0x2c, 0x79, 0x3a, 0x12, 0x72,
+		0x28, 0xe7, 0x41, 0x89, 0x13, 0x0a, 0xf5, 0x57, 0x84, 0x25, 0x3f, 0x5b, 0xd9, 0x16, 0xf6, 0x89,
+		0xf2, 0xaa, 0x9f, 0x64, 0x61, 0x79, 0x60, 0x0a, 0xcd, 0xb2, 0x0e, 0x2b, 0xb4, 0xdd, 0x6a, 0x39,
+		0xae, 0x47, 0x4c, 0xbd, 0xda, 0xb0, 0x88, 0xed, 0xe9, 0x18, 0x51, 0x7c, 0x3b, 0xbd, 0x1e, 0xbb,
+		0xd0, 0x47, 0x3e, 0xd6, 0x36, 0x47, 0xc2, 0xa8, 0x44, 0xb5, 0x65, 0x1a, 0x3f, 0xc1, 0x22, 0x5d,
+		0x93, 0xb0, 0x6b, 0x0a, 0xad, 0x5b, 0x2d, 0xee, 0xf0, 0xe2, 0x6d, 0x30, 0x38, 0x07, 0x07, 0x3d,
+		0x70, 0xee, 0xea, 0x0a, 0xcd, 0xc8, 0xb7, 0x6c, 0xc3, 0x7c, 0x8b, 0x11, 0xa7, 0x1e, 0xc3, 0x13,
+		0x14, 0xb3, 0xdc, 0x24, 0xb6, 0x47, 0x5c, 0xe9, 0xfa, 0x94, 0x50, 0x7a, 0x10, 0x90, 0x61, 0x94,
+		0xd1, 0x20, 0x5a, 0xd1, 0x51, 0xe5, 0x18, 0x16, 0xe2, 0x00, 0x63, 0x76, 0xfa, 0xcd, 0x68, 0x00,
+		0x49, 0x74, 0xac, 0x7d, 0xe4, 0xc2, 0x7b, 0xfd, 0xb3, 0x0c, 0x2c, 0x69, 0xc4, 0x30, 0x77, 0xee,
+		0x3f, 0xec, 0x77, 0xa2, 0x37, 0x61, 0x82, 0x27, 0xb4, 0x12, 0x37, 0xa3, 0x4b, 0x89, 0x17, 0xb7,
+		0xfb, 0x0f, 0xb9, 0x01, 0x71, 0xe0, 0x48, 0x22, 0x9d, 0x89, 0x26, 0xd2, 0xcc, 0xd0, 0x9d, 0xb6,
+		0x5b, 0x25, 0x3a, 0xfa, 0x35, 0x74, 0x73, 0x73, 0x62, 0x14, 0x95, 0x25, 0x1f, 0x42, 0xd1, 0xb2,
+		0x19, 0x84, 0xd5, 0x21, 0x3a, 0x4b, 0xef, 0x42, 0x2e, 0x76, 0x62, 0xb4, 0x8b, 0x5d, 0xec, 0x21,
+		0xef, 0xda, 0x21, 0x0f, 0xfb, 0xb9, 0x64, 0x78, 0xbf, 0xc8, 0xc0, 0xf2, 0x80, 0xb2, 0xd0, 0xc0,
+		0x4f, 0xa5, 0xad, 0xd8, 0x28, 0x99, 0xf9, 0x27, 0xa3, 0xa4, 0x6c, 0xc0, 0xd2, 0x00, 0xd5, 0xb0,
+		0xd9, 0x8e, 0x15, 0xf8, 0x17, 0xfa, 0xc9, 0xf3, 0x33, 0x11, 0xa3, 0xb1, 0x89, 0x38, 0x8d, 0x7d,
+		0x2a, 0xc1, 0xf2, 0x83, 0xb6, 0x5b, 0x23, 0x5f, 0x71, 0xfb, 0x52, 0x15, 0x28, 0x0e, 0xca, 0x89,
+		0x1e, 0xf3, 0xe7, 0x19, 0x58, 0x3e, 0x20, 0x5f, 0x7d, 0x25, 0x7c, 0x3e, 0x87, 0x6c, 0x0b, 0x8a,
+		0x07, 0x24, 0x5e, 0x93, 0x69, 0x6f, 0x4d, 0xea, 0x77, 0x25, 0x58, 0xd5, 0xc8, 0x91, 0x4b, 0x68,
+		0xdd, 0xcf, 0x31, 0xb8, 0xed, 0x7e, 0x41, 0x15, 0xe5, 0x8b, 0x70, 0x3e, 0x7e, 0x35, 0x68, 0x20,
+		0xbf, 0xcf, 0xc0, 0x05, 0x8d, 0x50, 0x62, 0x9b, 0x7d, 0x27, 0x90, 0x86, 0x4a, 0x9a, 0x58, 0x4c,
+		0xc3, 0x04, 0x76, 0x46, 0xcb, 0x89, 0x81, 0x7d, 0xf3, 0x5f, 0x95, 0x78, 0x5d, 0x85, 0x82, 0x4b,
+		0x9a, 0x8e, 0x37, 0x60, 0x4a, 0x62, 0xd4, 0x37, 0xa5, 0xbe, 0x1b, 0xfd, 0xc4, 0xe7, 0x77, 0xa3,
+		0x9f, 0x3c, 0xfd, 0x8d, 0x5e, 0x5d, 0x83, 0x8b, 0x49, 0x1a, 0x45, 0xa5, 0x1b, 0xb0, 0xba, 0x47,
+		0xbc, 0x6d, 0xd7, 0xa1, 0x14, 0x45, 0xe9, 0xd7, 0x78, 0x50, 0xdb, 0x94, 0xfa, 0x6a, 0x9b, 0x57,
+		0xa1, 0xe0, 0x19, 0x6e, 0x8d, 0x78, 0x3d, 0xd5, 0x60, 0xce, 0x26, 0x46, 0x91, 0x9e, 0xfa, 0xd7,
+		0x2c, 0x9c, 0x8f, 0xe7, 0x81, 0xf6, 0x7c, 0x0c, 0x05, 0xe1, 0x9d, 0x2b, 0x5d, 0x51, 0x69, 0x1d,
+		0x91, 0x6b, 0x0e, 0x23, 0xc6, 0x2b, 0x4b, 0x74, 0xab, 0xcb, 0xaf, 0x9e, 0x22, 0xb5, 0x98, 0xf5,
+		0x42, 0x43, 0xf2, 0x37, 0x60, 0xf1, 0xc8, 0xb0, 0x1a, 0x2c, 0xff, 0x32, 0xda, 0x94, 0x04, 0x3c,
+		0x45, 0xc0, 0x79, 0xf7, 0x34, 0x3c, 0xef, 0x72, 0x82, 0xdb, 0x8c, 0x5e, 0x84, 0xb3, 0x7c, 0x34,
+		0x30, 0xa1, 0x3c, 0x81, 0xb3, 0x03, 0x4b, 0x8c, 0xb9, 0x15, 0xdf, 0x8d, 0x26, 0x35, 0x2f, 0x27,
+		0x6d, 0x7f, 0xff, 0xa2, 0x70, 0xe3, 0xc2, 0x57, 0x63, 0xe5, 0x09, 0x2c, 0x27, 0xac, 0x30, 0x86,
+		0xf1, 0xdb, 0xd1, 0xbc, 0x39, 0xd1, 0xee, 0xf6, 0x88, 0xc7, 0xf8, 0x85, 0x08, 0x87, 0x13, 0xaa,
+		0x5f, 0x49, 0xb0, 0xf0, 0x80, 0x0d, 0x32, 0x98, 0xfb, 0x16, 0xf5, 0x46, 0xf9, 0x9c, 0xd7, 0xb1,
+		0x78, 0xc8, 0x5e, 0xf5, 0x8a, 0x99, 0x21, 0x57, 0x9c, 0x1e, 0xc1, 0x9c, 0x87, 0xbf, 0xe4, 0x3d,
+		0x28, 0xf4, 0x70, 0xc3, 0xd5, 0xc7, 0xcb, 0x43, 0x09, 0xf0, 0x48, 0x32, 0xeb, 0x85, 0xbe, 0xd8,
+		0x6d, 0xa9, 0x6f, 0xd1, 0x78, 0x4a, 0x7e, 0x2d, 0xb1, 0x7b, 0x14, 0x6d, 0x37, 0xff, 0xbd, 0xe4,
+		0x29, 0xc2, 0x52, 0xff, 0xaa, 0x51, 0xa0, 0xcf, 0x32, 0xa0, 0x1c, 0x38, 0x9d, 0xde, 0xc4, 0x96,
+		0x51, 0x3d, 0x6e, 0x38, 0xb5, 0x51, 0x52, 0xed, 0xc1, 0x3c, 0xc6, 0xd5, 0x31, 0x85, 0xc3, 0x70,
+		0x7c, 0x18, 0x88, 0x38, 0x8f, 0xae, 0x23, 0x20, 0x94, 0x4d, 0x45, 0x48, 0xa0, 0x1d, 0x26, 0xeb,
+		0x6a, 0xe2, 0x54, 0xba, 0x92, 0x2f, 0x00, 0xf0, 0xd2, 0x62, 0x38, 0x6c, 0xcf, 0xf0, 0x11, 0x1e,
+		0xb7, 0x55, 0x98, 0x33, 0x8e, 0x58, 0x65, 0xc2, 0x7f, 0x79, 0x98, 0xe2, 0x2f, 0x0f, 0x79, 0x3e,
+		0x78, 0x28, 0x9e, 0x1f, 0x96, 0x61, 0xda, 0x74, 0xbb, 0xba, 0xdb, 0xb6, 0x8b, 0xd3, 0x6b, 0xd2,
+		0x7a, 0x4e, 0x9b, 0x32, 0xdd, 0xae, 0xd6, 0xb6, 0xd5, 0x16, 0xac, 0xc6, 0x2a, 0x1b, 0xfd, 0xdf,
+		0x02, 0x4c, 0x56, 0x9d, 0xb6, 0xed, 0x71, 0x65, 0x67, 0x35, 0xf1, 0x21, 0xaf, 0xc1, 0x2c, 0xaf,
+		0xb2, 0xf8, 0x0c, 0x33, 0x7c, 0x12, 0xd8, 0x18, 0xf2, 0x5b, 0x81, 0x5c, 0xdd, 0xa0, 0x7a, 0xd3,
+		0x71, 0x85, 0x85, 0xe4, 0xb4, 0xe9, 0xba, 0x41, 0x0f, 0x1c, 0x57, 0x5c, 0xef, 0x35, 0x62, 0x98,
+		0x18, 0x16, 0x18, 0x02, 0x2f, 0x55, 0x8d, 0xac, 0xf3, 0x6b, 0xb0, 0x4c, 0x9e, 0xfa, 0x29, 0x51,
+		0x85, 0xd4, 0x2c, 0x3b, 0xb2, 0x80, 0x11, 0x19, 0xd1, 0x42, 0x0f, 0x77, 0x8b, 0xa1, 0xe2, 0x3a,
+		0xdf, 0x83, 0xc5, 0x68, 0x9a, 0x15, 0x7e, 0xbd, 0x19, 0x41, 0x51, 0x0e, 0xe7, 0x58, 0x48, 0x2f,
+		0x92, 0x60, 0x4d, 0x8c, 0x4e, 0xb0, 0x26, 0xe3, 0x92, 0xa3, 0x8f, 0x25, 0x50, 0xe2, 0x34, 0x84,
+		0x7b, 0xb2, 0x0b, 0xd3, 0xc4, 0xf6, 0x5c, 0x8b, 0x8c, 0xac, 0x13, 0x46, 0x09, 0x08, 0xc7, 0xef,
+		0xe3, 0xc6, 0xad, 0x26, 0x13, 0xb7, 0x9a, 0x3f, 0x4a, 0xa0, 0xf0, 0xcc, 0xf9, 0xab, 0xb8, 0x61,
+		0xea, 0x05, 0x58, 0x8d, 0x15, 0x0e, 0x9d, 0xd1, 0x4f, 0x32, 0x6c, 0x2b, 0x3c, 0xb7, 0xfb, 0x1f,
+		0x6b, 0x4d, 0xb2, 0xd6, 0x5d, 0x58, 0x8d, 0xd5, 0xd0, 0x98, 0x37, 0x82, 0x0f, 0x83, 0xbe, 0x81,
+		0x50, 0x46, 0x28, 0x9e, 0x89, 0x7d, 0x75, 0xf7, 0x57, 0x61, 0xa5, 0xc1, 0x97, 0xbe, 0x20, 0x3c,
+		0x64, 0x22, 0xe1, 0x21, 0x92, 0x2d, 0x66, 0xa3, 0xd9, 0xa2, 0xfa, 0x9b, 0x50, 0x67, 0x41, 0x0c,
+		0x73, 0x94, 0xe4, 0x6e, 0xaf, 0x5f, 0x40, 0x1c, 0xbb, 0x52, 0x8a, 0x5b, 0x3a, 0xcf, 0x67, 0x90,
+		0x0e, 0x62, 0xcb, 0xfb, 0x30, 0x2d, 0x16, 0xe5, 0x57, 0x12, 0x36, 0x52, 0x10, 0x12, 0x75, 0x65,
+		0xa4, 0xe4, 0xe3, 0xab, 0x7f, 0x92, 0xe0, 0xd2, 0x81, 0x55, 0x73, 0x0d, 0xef, 0xcb, 0xd2, 0x9c,
+		0x93, 0xf6, 0x7e, 0x7b, 0x05, 0x70, 0x40, 0xc7, 0xc5, 0x89, 0x36, 0x81, 0x59, 0x31, 0x28, 0xa4,
+		0x55, 0xff, 0x1f, 0xd6, 0x92, 0xa5, 0xc3, 0x5d, 0x59, 0x84, 0x29, 0xb7, 0x1d, 0xba, 0x75, 0x4d,
+		0xba, 0x6d, 0x76, 0xe5, 0x52, 0x81, 0xdb, 0x97, 0xb8, 0xa8, 0x04, 0x31, 0x2a, 0xcf, 0x06, 0xf9,
+		0x05, 0x64, 0xdf, 0x54, 0x7f, 0x29, 0xc1, 0xf2, 0xa6, 0x69, 0x6a, 0xe1, 0xdb, 0xd2, 0x18, 0xa6,
+		0x76, 0x87, 0x5d, 0xd1, 0x2d, 0xcf, 0x32, 0x1a, 0x3a, 0x4b, 0xa6, 0x9d, 0x0e, 0x71, 0xfd, 0x8a,
+		0x29, 0x72, 0x5b, 0xc2, 0xf9, 0xbb, 0x38, 0x8d, 0x17, 0x22, 0xe6, 0x36, 0xdc, 0x56, 0x35, 0xfc,
+		0x92, 0x30, 0xed, 0xb6, 0xaa, 0x9c, 0xe8, 0x25, 0xc8, 0xb3, 0x29, 0xbf, 0x29, 0x42, 0x68, 0x05,
+		0xdc, 0x56, 0x15, 0x9b, 0x7c, 0x58, 0x1d, 0x63, 0x70, 0xcd, 0xe8, 0xad, 0xde, 0x02, 0x45, 0x3c,
+		0xfe, 0x9f, 0x52, 0x24, 0xe6, 0x0d, 0x63, 0x09, 0x20, 0xfd, 0x2a, 0x9c, 0x15, 0x3b, 0x73, 0xd7,
+		0x22, 0x0d, 0x73, 0xbb, 0x6e, 0xd8, 0x35, 0x9e, 0x22, 0x1c, 0xb1, 0x4f, 0x5f, 0xff, 0xfc, 0x83,
+		0x9d, 0x37, 0xa7, 0x61, 0xea, 0xe1, 0x0e, 0x97, 0x9c, 0xd3, 0x10, 0xe5, 0x6c, 0x36, 0x69, 0x93,
+		0x13, 0x9c, 0x14, 0x0a, 0xc8, 0xd9, 0xe4, 0x84, 0x4f, 0xaa, 0x9f, 0x64, 0x60, 0x16, 0x7b, 0x4d,
+		0x04, 0x83, 0x02, 0x64, 0x70, 0x77, 0xb3, 0x5a, 0xc6, 0x32, 0xa3, 0x57, 0xed, 0x4c, 0xdf, 0x55,
+		0xfb, 0x12, 0x60, 0x5f, 0x4a, 0x58, 0xbb, 0x20, 0x86, 0xb8, 0x82, 0xcf, 0xc3, 0x8c, 0xd3, 0x22,
+		0x2e, 0x3f, 0x54, 0xa8, 0xde, 0x60, 0x80, 0x09, 0x63, 0x54, 0x3d, 0xc7, 0xc5, 0xd6, 0x0a, 0xf1,
+		0xc1, 0x8e, 0x90, 0x4b, 0x0c, 0xea, 0x88, 0x82, 0xc8, 0x8c, 0x86, 0x5f, 0xf2, 0x1d, 0x98, 0xe9,
+		0x75, 0x15, 0x16, 0xa7, 0x47, 0xb6, 0x73, 0x04, 0xc0, 0xf2, 0x01, 0xcc, 0x71, 0x3d, 0xe9, 0x55,
+		0x2e, 0x23, 0x2d, 0xe6, 0xe2, 0x5e, 0x60, 0x83, 0x7a, 0x75, 0xbf, 0xd6, 0xb5, 0xd9, 0xa3, 0xe0,
+		0x83, 0xaa, 0x27, 0x50, 0x64, 0xd9, 0x5b, 0x58, 0x6d, 0x23, 0x4b, 0x29, 0x11, 0xe7, 0x9f, 0x19,
+		0xed, 0xfc, 0xb3, 0x71, 0x5e, 0xfb, 0x23, 0x09, 0x56, 0x62, 0x38, 0xe3, 0xd9, 0x7c, 0x13, 0xa6,
+		0x7d, 0xf9, 0x84, 0xcb, 0xbc, 0x32, 0x54, 0x3e, 0x14, 0xcd, 0xc7, 0x49, 0x9d, 0xa1, 0xec, 0xf3,
+		0xd6, 0x1f, 0x9b, 0xf8, 0x6f, 0x6f, 0x42, 0xee, 0x4b, 0x90, 0x47, 0x0f, 0x13, 0xb2, 0x76, 0x10,
+		0x43, 0xdc, 0x12, 0x64, 0x98, 0x08, 0x3d, 0x5a, 0xf3, 0xdf, 0xea, 0x22, 0x9c, 0x8b, 0x90, 0x42,
+		0xc3, 0xdf, 0x81, 0x73, 0x1a, 0x61, 0x00, 0x51, 0x16, 0x3e, 0x05, 0x29, 0xa0, 0xc0, 0xce, 0x36,
+		0xb3, 0xed, 0x10, 0xe5, 0x69, 0x9b, 0x9c, 0xf0, 0xd3, 0xb5, 0xc4, 0xde, 0xeb, 0xc2, 0x54, 0x90,
+		0x7a, 0x1d, 0xce, 0xed, 0x90, 0x06, 0xf1, 0x52, 0x50, 0x5f, 0x83, 0x7c, 0xd5, 0xb1, 0xab, 0x6d,
+		0xd7, 0x25, 0x76, 0xb5, 0x8b, 0xdb, 0x16, 0x1e, 0x8a, 0x6e, 0x6b, 0x36, 0xba, 0xad, 0x6c, 0x05,
+		0x51, 0x4e, 0x62, 0x05, 0xe5, 0x3f, 0xaf, 0x41, 0x6e, 0x93, 0x6d, 0xc6, 0xe6, 0x83, 0x7d, 0xf9,
+		0x7b, 0x12, 0xac, 0x24, 0xb6, 0x57, 0xca, 0xaf, 0x8e, 0x78, 0x52, 0x49, 0x8a, 0x43, 0xca, 0x9d,
+		0xf1, 0x11, 0xd1, 0x8c, 0xbe, 0x0e, 0xe7, 0x7c, 0xa0, 0x50, 0x3b, 0x9c, 0x7c, 0x63, 0x04, 0xc1,
+		0xc1, 0x36, 0x4a, 0xa5, 0x3c, 0x0e, 0x0a, 0x72, 0x0f, 0xab, 0x63, 0xa0, 0x05, 0x70, 0xa4, 0x3a,
+		0x92, 0x7a, 0x20, 0x95, 0x3b, 0xe3, 0x23, 0xe2, 0x82, 0x0c, 0x80, 0xa0, 0xd3, 0x4d, 0x4e, 0x72,
+		0x19, 0x03, 0xcd, 0x73, 0xca, 0x0b, 0x29, 0x20, 0x03, 0x16, 0x41, 0x17, 0x59, 0x22, 0x8b, 0x81,
+		0xc6, 0x3a, 0xe5, 0x85, 0x14, 0x90, 0x61, 0x16, 0x7e, 0xff, 0xd7, 0x10, 0x16, 0x7d, 0x4d, 0x6b,
+		0xca, 0x0b, 0x29, 0x20, 0x91, 0xc5, 0xd7, 0x60, 0x2e, 0xd2, 0xb6, 0x25, 0x5f, 0x1b, 0xa1, 0xf3,
+		0x08, 0xa3, 0xeb, 0xe9, 0x80, 0x91, 0xd7, 0x4f, 0x25, 0xde, 0xe4, 0x31, 0xb4, 0xb7, 0x48, 0xfe,
+		0xaf, 0xe4, 0x0a, 0x5e, 0x9a, 0x56, 0x30, 0xe5, 0xad, 0x53, 0xe3, 0xe3, 0x2a, 0xbf, 0x23, 0xc1,
+		0x52, 0x7c, 0xf7, 0x8c, 0xfc, 0xca, 0x98, 0xcd, 0x36, 0x62, 0x45, 0xb7, 0x4e, 0xd5, 0xa2, 0xc3,
+		0xcf, 0x54, 0x62, 0x8b, 0x4a, 0xe2, 0x99, 0x1a, 0xd5, 0x44, 0xa3, 0xdc, 0x19, 0x1f, 0x11, 0x17,
+		0xf4, 0x23, 0x89, 0x17, 0x82, 0x13, 0xbb, 0x37, 0xe4, 0xd7, 0x87, 0x90, 0x1e, 0xd1, 0xec, 0xa2,
+		0xbc, 0x71, 0x2a, 0xdc, 0xc0, 0x88, 0x23, 0x6d, 0x12, 0x89, 0x46, 0x1c, 0xd7, 0x0a, 0xa2, 0x5c,
+		0x4f, 0x07, 0x8c, 0xbc, 0xba, 0x20, 0x0f, 0xf6, 0x15, 0xc8, 0x2f, 0x8f, 0xdb, 0x57, 0xa1, 0xdc,
+		0x18, 0x03, 0x03, 0x59, 0xb7, 0xe0, 0x4c, 0xdf, 0xa3, 0xbc, 0xfc, 0x52, 0xda, 0xc7, 0x7b, 0xc1,
+		0xb4, 0x34, 0xde, 0x5b, 0x3f, 0xe3, 0xd8, 0xf7, 0x54, 0x9c, 0xc8, 0x31, 0xfe, 0xfd, 0x5d, 0x29,
+		0xa5, 0x05, 0x47, 0x8e, 0x14, 0xe6, 0xfb, 0x9f, 0x20, 0xe5, 0x24, 0x1a, 0x09, 0x6f, 0xb2, 0xca,
+		0x46, 0x6a, 0xf8, 0x80, 0xe9, 0x01, 0x49, 0xc9, 0xf4, 0x80, 0x8c, 0xc7, 0x34, 0xf1, 0x19, 0xf0,
+		0x9b, 0xb0, 0x10, 0xf7, 0x9e, 0x26, 0x97, 0x13, 0x35, 0x96, 0xf8, 0x14, 0xa8, 0xdc, 0x1c, 0x0b,
+		0x27, 0xe4, 0xe8, 0xe2, 0x9f, 0x97, 0x12, 0x1d, 0xdd, 0xd0, 0xf7, 0x3d, 0xe5, 0xd6, 0x98, 0x58,
+		0x81, 0x22, 0xe2, 0x9e, 0x67, 0x12, 0x15, 0x31, 0xe4, 0xc1, 0x4b, 0xb9, 0x39, 0x16, 0x4e, 0xe0,
+		0x3e, 0x22, 0xef, 0x06, 0x89, 0xee, 0x23, 0xee, 0x49, 0x44, 0xb9, 0x9e, 0x0e, 0x18, 0x79, 0x35,
+		0xa1, 0x10, 0xad, 0xe9, 0xcb, 0xc9, 0xee, 0x27, 0xe6, 0xc1, 0x42, 0x79, 0x29, 0x25, 0x74, 0x90,
+		0x16, 0xc6, 0x94, 0xae, 0x13, 0xd3, 0xc2, 0xe4, 0x37, 0x05, 0xa5, 0x3c, 0x0e, 0x4a, 0xe0, 0x2b,
+		0x07, 0x6b, 0xb4, 0x89, 0xbe, 0x32, 0xb1, 0xe0, 0xad, 0xdc, 0x18, 0x03, 0x23, 0x10, 0x3c, 0xa6,
+		0x66, 0x99, 0x28, 0x78, 0x72, 0xf1, 0x56, 0x29, 0x8f, 0x83, 0x12, 0x70, 0x8f, 0xa9, 0xf7, 0xc9,
+		0xc9, 0x72, 0x24, 0x55, 0x4f, 0x95, 0xf2, 0x38, 0x28, 0x31, 0xd9, 0xf8, 0x40, 0xa9, 0x6e, 0x64,
+		0x36, 0x9e, 0x54, 0x59, 0x54, 0xee, 0x8c, 0x8f, 0x88, 0x0b, 0xfa, 0x58, 0x82, 0x62, 0x52, 0x91,
+		0x4a, 0xbe, 0x9d, 0x64, 0x58, 0xc3, 0x6b, 0x76, 0xca, 0xab, 0x63, 0xe3, 0x05, 0xde, 0xbe, 0xbf,
+		0x3a, 0x94, 0xe8, 0xed, 0x13, 0x4a, 0x5f, 0xca, 0x46, 0x6a, 0xf8, 0xb0, 0x45, 0x0c, 0x54, 0x8d,
+		0x86, 0x58, 0x44, 0x52, 0x89, 0x4a, 0x29, 0x8f, 0x83, 0x82, 0xdc, 0x3b, 0x70, 0x76, 0xa0, 0x02,
+		0x21, 0x27, 0xc9, 0x90, 0x54, 0x25, 0x51, 0x5e, 0x4e, 0x8f, 0x80, 0x7c, 0x4d, 0xc8, 0x87, 0x4a,
+		0x05, 0xf2, 0x90, 0xdb, 0x55, 0x5f, 0x65, 0x42, 0x79, 0x31, 0x0d, 0x28, 0x72, 0xa9, 0xc1, 0x6c,
+		0xb8, 0x66, 0x20, 0xbf, 0x98, 0xa8, 0xa1, 0x81, 0xf2, 0x84, 0x72, 0x2d, 0x15, 0x6c, 0xc0, 0x28,
+		0x5c, 0x1a, 0x48, 0x64, 0x14, 0x53, 0xa9, 0x50, 0xae, 0xa5, 0x82, 0x15, 0x8c, 0xb6, 0x6e, 0xfd,
+		0xef, 0xcd, 0x9a, 0xe5, 0xd5, 0xdb, 0x95, 0x52, 0xd5, 0x69, 0x6e, 0x44, 0xfe, 0x88, 0x5a, 0xaa,
+		0x11, 0x5b, 0xfc, 0x1f, 0xb7, 0xf7, 0x67, 0xdf, 0x37, 0xf8, 0x8f, 0xce, 0x8d, 0xca, 0x14, 0x1f,
+		0xbf, 0xf9, 0x8f, 0x01, 0x00, 0xda, 0x98, 0xe6, 0x34, 0x14, 0x3c, 0x00, 0x00,
 	},
 	// google/protobuf/timestamp.proto
 	[]byte{
@@ -1360,7 +2130,7 @@ var yarpcFileDescriptorClosurec6fc96d64a8b67fd = [][]byte{
 		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
 		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
 		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
-		0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
 	},
 	// google/protobuf/wrappers.proto
 	[]byte{
@@ -1379,8 +2149,7 @@ var yarpcFileDescriptorClosurec6fc96d64a8b67fd = [][]byte{
 		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
 		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
 		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
-		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x92, 0x48, 0x30, 0x06,
-		0x02, 0x00, 0x00,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/common.proto
 	[]byte{
@@ -1433,8 +2202,8 @@ var yarpcFileDescriptorClosurec6fc96d64a8b67fd = [][]byte{
 		0x0e, 0x69, 0xc1, 0x27, 0x9b, 0xf5, 0xa1, 0x77, 0x35, 0xbe, 0x7a, 0xde, 0xac, 0x9c, 0x5d, 0xc3,
 		0xd3, 0x40, 0x24, 0xdb, 0x86, 0xfc, 0x6c, 0xaf, 0x97, 0xb2, 0xa1, 0x4e, 0x3f, 0xb4, 0x7e, 0x71,
 		0xa6, 0x4c, 0xbd, 0xcb, 0x27, 0x76, 0x20, 0x12, 0x67, 0xe3, 0xc7, 0x64, 0x4f, 0x91, 0x97, 0x3f,
-		0x1b, 0xf3, 0x8f, 0x7a, 0x49, 0x53, 0x36, 0x3f, 0x9d, 0xd4, 0x8a, 0xda, 0xf3, 0xbf, 0x03, 0x00,
-		0x00, 0xff, 0xff, 0xdc, 0x8c, 0x77, 0x9a, 0xc7, 0x06, 0x00, 0x00,
+		0x1b, 0xf3, 0x8f, 0x7a, 0x49, 0x53, 0x36, 0x3f, 0x9d, 0xd4, 0x8a, 0xda, 0xf3, 0xbf, 0x07, 0x00,
+		0xdc, 0x8c, 0x77, 0x9a, 0xc7, 0x06, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -1449,235 +2218,242 @@ var yarpcFileDescriptorClosurec6fc96d64a8b67fd = [][]byte{
 		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
 		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
 		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
-		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
 	},
-	// uber/cadence/api/v1/visibility.proto
+	// uber/cadence/api/v1/tasklist.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
-		0x14, 0x85, 0x71, 0x68, 0x23, 0xb8, 0x29, 0x60, 0x0d, 0x82, 0x80, 0x2b, 0x08, 0xb2, 0x58, 0x54,
-		0x2c, 0xc6, 0x4a, 0x59, 0x76, 0x81, 0x12, 0x3c, 0xa0, 0x11, 0x21, 0x09, 0x8e, 0x9b, 0x12, 0x36,
-		0xd6, 0xd8, 0x9e, 0x86, 0x11, 0xb6, 0xc7, 0xb2, 0xc7, 0x6e, 0xfb, 0x14, 0xbc, 0x27, 0x4f, 0x81,
-		0xfc, 0x87, 0x84, 0x70, 0xc5, 0xce, 0x3e, 0xf7, 0x9c, 0x4f, 0x73, 0x7f, 0xe0, 0x75, 0xe1, 0xf3,
-		0xcc, 0x0a, 0x58, 0xc8, 0x93, 0x80, 0x5b, 0x2c, 0x15, 0x56, 0x39, 0xb5, 0x4a, 0x91, 0x0b, 0x5f,
-		0x44, 0x42, 0xdd, 0xe0, 0x34, 0x93, 0x4a, 0xa2, 0xc7, 0x95, 0x0b, 0xb7, 0x2e, 0xcc, 0x52, 0x81,
-		0xcb, 0xa9, 0x31, 0xd9, 0x4b, 0xb9, 0x8f, 0xb8, 0x55, 0x5b, 0xfc, 0xe2, 0xd2, 0x52, 0x22, 0xe6,
-		0xb9, 0x62, 0x71, 0xda, 0xa4, 0x0c, 0xb3, 0x8f, 0x7d, 0x25, 0xb3, 0x1f, 0x97, 0x91, 0xbc, 0x6a,
-		0x3c, 0xe6, 0x17, 0x18, 0x5f, 0xb4, 0x0a, 0xb9, 0xe6, 0x41, 0xa1, 0x84, 0x4c, 0x3e, 0x88, 0x48,
-		0xf1, 0x0c, 0x4d, 0x60, 0xd4, 0x99, 0x3d, 0x11, 0x3e, 0xd3, 0x5e, 0x69, 0x27, 0xf7, 0x1d, 0xe8,
-		0x24, 0x1a, 0xa2, 0x27, 0x30, 0xcc, 0x8a, 0xa4, 0xaa, 0x0d, 0xea, 0xda, 0x61, 0x56, 0x24, 0x34,
-		0x34, 0x4f, 0x00, 0x75, 0x48, 0xf7, 0x26, 0xe5, 0x2d, 0x0d, 0xc1, 0x41, 0xc2, 0x62, 0xde, 0x62,
-		0xea, 0x6f, 0xf3, 0xa7, 0x06, 0x8f, 0x36, 0x8a, 0x65, 0xca, 0x15, 0x71, 0xe7, 0x7b, 0x07, 0x0f,
-		0x38, 0xcb, 0x22, 0xc1, 0x73, 0xe5, 0x55, 0x0d, 0xd5, 0x81, 0xd1, 0xa9, 0x81, 0x9b, 0x6e, 0x71,
-		0xd7, 0x2d, 0x76, 0xbb, 0x6e, 0x9d, 0xa3, 0x2e, 0x50, 0x49, 0xe8, 0x0c, 0x46, 0x11, 0x53, 0x7f,
-		0xe2, 0x83, 0xff, 0xc6, 0xa1, 0xb1, 0x57, 0x82, 0xb9, 0x83, 0xa3, 0x8d, 0x62, 0xaa, 0xc8, 0xdb,
-		0xd7, 0x50, 0x18, 0xe6, 0xf5, 0x7f, 0xfd, 0x8c, 0x87, 0xa7, 0x53, 0xdc, 0xb3, 0x09, 0xfc, 0xcf,
-		0x04, 0xdf, 0x47, 0x32, 0xe7, 0x0d, 0xc8, 0x69, 0x01, 0x6f, 0x7e, 0x69, 0xa0, 0xd3, 0x24, 0xe4,
-		0xd7, 0x3c, 0xdc, 0xb2, 0xa8, 0xe0, 0xd5, 0x6c, 0xd0, 0x4b, 0x30, 0xe8, 0xd2, 0x26, 0x5f, 0x89,
-		0xed, 0x6d, 0x67, 0x8b, 0x73, 0xe2, 0xb9, 0xbb, 0x35, 0xf1, 0xe8, 0x72, 0x3b, 0x5b, 0x50, 0x5b,
-		0xbf, 0x83, 0x5e, 0xc0, 0xf3, 0x9e, 0xfa, 0xc6, 0x75, 0xe8, 0xf2, 0xa3, 0xae, 0xdd, 0x12, 0xff,
-		0x44, 0x76, 0x17, 0x2b, 0xc7, 0xd6, 0x07, 0xc8, 0x80, 0xa7, 0xbd, 0x78, 0x57, 0xbf, 0x7b, 0x0b,
-		0xda, 0x5e, 0x9d, 0xcf, 0x17, 0x44, 0x3f, 0x40, 0xc7, 0x30, 0xee, 0x29, 0xcf, 0x57, 0xab, 0x85,
-		0x7e, 0x88, 0x26, 0x70, 0xdc, 0x97, 0x9d, 0xb9, 0xc4, 0xa5, 0x9f, 0x89, 0x3e, 0x9c, 0x6f, 0x61,
-		0x1c, 0xc8, 0xb8, 0x6f, 0x58, 0xf3, 0x7b, 0xb3, 0x54, 0xac, 0xab, 0x2d, 0xac, 0xb5, 0x6f, 0xd6,
-		0x5e, 0xa8, 0xef, 0x85, 0x8f, 0x03, 0x19, 0x5b, 0x7f, 0x1d, 0x2b, 0xde, 0xf3, 0xa4, 0x39, 0xec,
-		0xf6, 0x6e, 0xcf, 0x58, 0x2a, 0xca, 0xa9, 0x3f, 0xac, 0xb5, 0xb7, 0xbf, 0x03, 0x00, 0x00, 0xff,
-		0xff, 0xd7, 0x22, 0x00, 0x73, 0x37, 0x03, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x6e, 0x1b, 0x37,
+		0x10, 0xee, 0x4a, 0x8e, 0x2b, 0x8f, 0x6b, 0x67, 0xcb, 0x36, 0x8e, 0xa5, 0x34, 0xad, 0xaa, 0x43,
+		0x60, 0x04, 0xc5, 0x0a, 0x76, 0xd1, 0x53, 0x0f, 0x85, 0x6c, 0x19, 0xcd, 0xc2, 0xb2, 0x22, 0xac,
+		0x36, 0x06, 0xdc, 0x0b, 0x4b, 0x2d, 0x27, 0x32, 0xb1, 0x3f, 0x5c, 0x90, 0x5c, 0xff, 0xdc, 0xfa,
+		0x14, 0x7d, 0x98, 0x3e, 0x5d, 0x41, 0xee, 0xca, 0x75, 0x6c, 0x35, 0x37, 0x72, 0xbe, 0xf9, 0xe6,
+		0xe7, 0xe3, 0x0c, 0x61, 0x50, 0x2d, 0x50, 0x0d, 0x13, 0xc6, 0xb1, 0x48, 0x70, 0xc8, 0x4a, 0x31,
+		0xbc, 0x3e, 0x1c, 0x1a, 0xa6, 0xd3, 0x4c, 0x68, 0x13, 0x94, 0x4a, 0x1a, 0x49, 0xbe, 0xb1, 0x3e,
+		0x41, 0xe3, 0x13, 0xb0, 0x52, 0x04, 0xd7, 0x87, 0xbd, 0xef, 0x97, 0x52, 0x2e, 0x33, 0x1c, 0x3a,
+		0x97, 0x45, 0xf5, 0x71, 0xc8, 0x2b, 0xc5, 0x8c, 0x90, 0x45, 0x4d, 0xea, 0xfd, 0xf0, 0x18, 0x37,
+		0x22, 0x47, 0x6d, 0x58, 0x5e, 0x36, 0x0e, 0x4f, 0x02, 0xdc, 0x28, 0x56, 0x96, 0xa8, 0x74, 0x8d,
+		0x0f, 0x3e, 0x40, 0x27, 0x66, 0x3a, 0x9d, 0x08, 0x6d, 0x08, 0x81, 0x8d, 0x82, 0xe5, 0xb8, 0xef,
+		0xf5, 0xbd, 0x83, 0xad, 0xc8, 0x9d, 0xc9, 0x2f, 0xb0, 0x91, 0x8a, 0x82, 0xef, 0xb7, 0xfa, 0xde,
+		0xc1, 0xee, 0xd1, 0x8f, 0xc1, 0x9a, 0x22, 0x83, 0x55, 0x80, 0x33, 0x51, 0xf0, 0xc8, 0xb9, 0x0f,
+		0x18, 0xf8, 0x2b, 0xeb, 0x39, 0x1a, 0xc6, 0x99, 0x61, 0xe4, 0x1c, 0xbe, 0xcd, 0xd9, 0x2d, 0xb5,
+		0x6d, 0x6b, 0x5a, 0xa2, 0xa2, 0x1a, 0x13, 0x59, 0x70, 0x97, 0x6e, 0xfb, 0xe8, 0xbb, 0xa0, 0xae,
+		0x34, 0x58, 0x55, 0x1a, 0x8c, 0x65, 0xb5, 0xc8, 0xf0, 0x82, 0x65, 0x15, 0x46, 0x5f, 0xe7, 0xec,
+		0xd6, 0x06, 0xd4, 0x33, 0x54, 0x73, 0x47, 0x1b, 0x7c, 0x80, 0xee, 0x2a, 0xc5, 0x8c, 0x29, 0x23,
+		0xac, 0x2a, 0xf7, 0xb9, 0x7c, 0x68, 0xa7, 0x78, 0xd7, 0x74, 0x62, 0x8f, 0xe4, 0x0d, 0x3c, 0x97,
+		0x37, 0x05, 0x2a, 0x7a, 0x25, 0xb5, 0xa1, 0xae, 0xcf, 0x96, 0x43, 0x77, 0x9c, 0xf9, 0x9d, 0xd4,
+		0x66, 0xca, 0x72, 0x1c, 0xfc, 0xd5, 0x82, 0xdd, 0x55, 0xdc, 0xb9, 0x61, 0xa6, 0xd2, 0xe4, 0x27,
+		0x20, 0x0b, 0x96, 0xa4, 0x99, 0x5c, 0xd2, 0x44, 0x56, 0x85, 0xa1, 0x57, 0xa2, 0x30, 0x2e, 0x76,
+		0x3b, 0xf2, 0x1b, 0xe4, 0xc4, 0x02, 0xef, 0x44, 0x61, 0xc8, 0x6b, 0x00, 0x85, 0x8c, 0xd3, 0x0c,
+		0xaf, 0x31, 0x73, 0x39, 0xda, 0xd1, 0x96, 0xb5, 0x4c, 0xac, 0x81, 0xbc, 0x82, 0x2d, 0x96, 0xa4,
+		0x0d, 0xda, 0x76, 0x68, 0x87, 0x25, 0x69, 0x0d, 0xbe, 0x81, 0xe7, 0x8a, 0x19, 0x7c, 0xa8, 0xce,
+		0x46, 0xdf, 0x3b, 0xf0, 0xa2, 0x1d, 0x6b, 0xbe, 0xef, 0x9d, 0x8c, 0x61, 0xc7, 0xca, 0x48, 0x05,
+		0xa7, 0x8b, 0x4c, 0x26, 0xe9, 0xfe, 0x33, 0xa7, 0x61, 0xff, 0x7f, 0x9f, 0x27, 0x1c, 0x1f, 0x5b,
+		0xbf, 0x68, 0xdb, 0xd2, 0x42, 0xee, 0x2e, 0x64, 0x0f, 0x36, 0x4b, 0x56, 0x69, 0xe4, 0xfb, 0x9b,
+		0x7d, 0xef, 0xa0, 0x13, 0x35, 0xb7, 0xc1, 0x6f, 0xb0, 0xfd, 0x80, 0x43, 0xba, 0xd0, 0xd1, 0x86,
+		0x29, 0x43, 0x05, 0x6f, 0x9a, 0xfe, 0xd2, 0xdd, 0x43, 0x4e, 0x5e, 0xc0, 0x26, 0x16, 0xdc, 0x02,
+		0x75, 0x9f, 0xcf, 0xb0, 0xe0, 0x21, 0x1f, 0xfc, 0xed, 0x01, 0xcc, 0x64, 0x96, 0xa1, 0x0a, 0x8b,
+		0x8f, 0x92, 0x8c, 0xc1, 0xcf, 0x98, 0x36, 0x94, 0x25, 0x09, 0x6a, 0x4d, 0xed, 0x88, 0x36, 0x8f,
+		0xde, 0x7b, 0xf2, 0xe8, 0xf1, 0x6a, 0x7e, 0xa3, 0x5d, 0xcb, 0x19, 0x39, 0x8a, 0x35, 0x92, 0x1e,
+		0x74, 0x04, 0xc7, 0xc2, 0x08, 0x73, 0xd7, 0xbc, 0xdc, 0xfd, 0x7d, 0x9d, 0x6e, 0xed, 0x35, 0xba,
+		0x0d, 0xfe, 0xf1, 0xa0, 0x3b, 0x37, 0x22, 0x49, 0xef, 0x4e, 0x6f, 0x31, 0xa9, 0xec, 0xc8, 0x8c,
+		0x8c, 0x51, 0x62, 0x51, 0x19, 0xd4, 0xe4, 0x77, 0xf0, 0x6f, 0xa4, 0x4a, 0x51, 0xb9, 0x19, 0xa5,
+		0x76, 0x37, 0x9b, 0x3a, 0x5f, 0x7f, 0x76, 0xee, 0xa3, 0xdd, 0x9a, 0x76, 0xbf, 0x48, 0x31, 0x74,
+		0x75, 0x72, 0x85, 0xbc, 0xca, 0x90, 0x1a, 0x49, 0x6b, 0xf5, 0x6c, 0xdb, 0xb2, 0x32, 0xae, 0xf6,
+		0xed, 0xa3, 0xee, 0xd3, 0x71, 0x6f, 0x36, 0x3b, 0xda, 0x5b, 0x71, 0x63, 0x39, 0xb7, 0xcc, 0xb8,
+		0x26, 0xbe, 0xfd, 0x13, 0xbe, 0x7a, 0xb8, 0x69, 0xa4, 0x07, 0x7b, 0xf1, 0x68, 0x7e, 0x46, 0x27,
+		0xe1, 0x3c, 0xa6, 0x67, 0xe1, 0x74, 0x4c, 0xc3, 0xe9, 0xc5, 0x68, 0x12, 0x8e, 0xfd, 0x2f, 0x48,
+		0x17, 0x5e, 0x3c, 0xc2, 0xa6, 0xef, 0xa3, 0xf3, 0xd1, 0xc4, 0xf7, 0xd6, 0x40, 0xf3, 0x38, 0x3c,
+		0x39, 0xbb, 0xf4, 0x5b, 0x6f, 0xf9, 0x7f, 0x19, 0xe2, 0xbb, 0x12, 0x3f, 0xcd, 0x10, 0x5f, 0xce,
+		0x4e, 0x1f, 0x64, 0x78, 0x05, 0x2f, 0x1f, 0x61, 0xe3, 0xd3, 0x93, 0x70, 0x1e, 0xbe, 0x9f, 0xfa,
+		0xde, 0x1a, 0x70, 0x74, 0x12, 0x87, 0x17, 0x61, 0x7c, 0xe9, 0xb7, 0x8e, 0x2f, 0xe0, 0x65, 0x22,
+		0xf3, 0x75, 0x8a, 0x1e, 0x77, 0x46, 0xa5, 0x98, 0x59, 0x41, 0x66, 0xde, 0x1f, 0xc3, 0xa5, 0x30,
+		0x57, 0xd5, 0x22, 0x48, 0x64, 0x3e, 0xfc, 0xe4, 0xfb, 0x0c, 0x96, 0x58, 0xd4, 0xff, 0x59, 0xf3,
+		0x93, 0xfe, 0xca, 0x4a, 0x71, 0x7d, 0xb8, 0xd8, 0x74, 0xb6, 0x9f, 0xff, 0x1d, 0x00, 0xde, 0xd0,
+		0xdf, 0x62, 0x6d, 0x05, 0x00, 0x00,
 	},
-	// uber/cadence/api/v1/workflow.proto
+	// uber/cadence/api/v1/visibility.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6f, 0xdb, 0xca,
-		0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0x0f, 0x7a, 0x1c, 0xc7, 0xca, 0xb7, 0xa3, 0xfb, 0x26,
-		0x71, 0xd4, 0x1b, 0xf9, 0x3a, 0xb9, 0xb9, 0x69, 0x6e, 0x9a, 0xa6, 0x34, 0x49, 0xc7, 0x4c, 0x64,
-		0x4a, 0x1d, 0x51, 0x71, 0x7c, 0x51, 0x94, 0xa0, 0xa5, 0xb1, 0x4d, 0x44, 0x22, 0x05, 0x72, 0x94,
-		0xc4, 0xfb, 0x02, 0x5d, 0x77, 0x57, 0xb4, 0x9b, 0xfe, 0x80, 0x02, 0x45, 0x7f, 0x40, 0xd1, 0xa2,
-		0x8b, 0xee, 0xba, 0xed, 0xb2, 0xfb, 0xfe, 0x8b, 0x62, 0x86, 0x43, 0x89, 0xb2, 0x3e, 0xa8, 0xb4,
-		0xc0, 0xed, 0xce, 0x3c, 0x7c, 0x9e, 0x87, 0x67, 0xce, 0x9c, 0xf3, 0x70, 0x68, 0x41, 0xa1, 0x7b,
-		0x4c, 0x82, 0xed, 0x86, 0xd3, 0x24, 0x5e, 0x83, 0x6c, 0x3b, 0x1d, 0x77, 0xfb, 0xc3, 0xce, 0xf6,
-		0x47, 0x3f, 0x78, 0x7f, 0xd2, 0xf2, 0x3f, 0x96, 0x3a, 0x81, 0x4f, 0x7d, 0xb4, 0xc6, 0x30, 0x25,
-		0x81, 0x29, 0x39, 0x1d, 0xb7, 0xf4, 0x61, 0xe7, 0xda, 0xad, 0x53, 0xdf, 0x3f, 0x6d, 0x91, 0x6d,
-		0x0e, 0x39, 0xee, 0x9e, 0x6c, 0x37, 0xbb, 0x81, 0x43, 0x5d, 0xdf, 0x8b, 0x48, 0xd7, 0x6e, 0x5f,
-		0xbc, 0x4f, 0xdd, 0x36, 0x09, 0xa9, 0xd3, 0xee, 0x08, 0xc0, 0xe6, 0xa8, 0x27, 0x37, 0xfc, 0x76,
-		0xbb, 0x27, 0x31, 0x32, 0x37, 0xea, 0x84, 0xef, 0x5b, 0x6e, 0x48, 0x23, 0x4c, 0xe1, 0x2f, 0x73,
-		0xb0, 0x7e, 0x28, 0xd2, 0xd5, 0x3f, 0x91, 0x46, 0x97, 0xa5, 0x60, 0x78, 0x27, 0x3e, 0xaa, 0x03,
-		0x8a, 0xd7, 0x61, 0x93, 0xf8, 0x4e, 0x5e, 0xda, 0x94, 0xb6, 0x72, 0x8f, 0xee, 0x95, 0x46, 0x2c,
-		0xa9, 0x34, 0xa4, 0x83, 0x57, 0x3f, 0x5e, 0x0c, 0xa1, 0x27, 0x30, 0x43, 0xcf, 0x3b, 0x24, 0x9f,
-		0xe1, 0x42, 0x77, 0x26, 0x0a, 0x59, 0xe7, 0x1d, 0x82, 0x39, 0x1c, 0x3d, 0x03, 0x08, 0xa9, 0x13,
-		0x50, 0x9b, 0x95, 0x21, 0x9f, 0xe5, 0xe4, 0x6b, 0xa5, 0xa8, 0x46, 0xa5, 0xb8, 0x46, 0x25, 0x2b,
-		0xae, 0x11, 0x5e, 0xe0, 0x68, 0x76, 0xcd, 0xa8, 0x8d, 0x96, 0x1f, 0x92, 0x88, 0x3a, 0x93, 0x4e,
-		0xe5, 0x68, 0x4e, 0xb5, 0x60, 0x31, 0xa2, 0x86, 0xd4, 0xa1, 0xdd, 0x30, 0x3f, 0xbb, 0x29, 0x6d,
-		0x2d, 0x3f, 0xda, 0x99, 0x6e, 0xf5, 0x2a, 0x63, 0xd6, 0x38, 0x11, 0xe7, 0x1a, 0xfd, 0x0b, 0x74,
-		0x17, 0x96, 0xcf, 0xdc, 0x90, 0xfa, 0xc1, 0xb9, 0xdd, 0x22, 0xde, 0x29, 0x3d, 0xcb, 0xcf, 0x6d,
-		0x4a, 0x5b, 0x59, 0xbc, 0x24, 0xa2, 0x65, 0x1e, 0x44, 0x3f, 0x83, 0xf5, 0x8e, 0x13, 0x10, 0x8f,
-		0xf6, 0xcb, 0x6f, 0xbb, 0xde, 0x89, 0x9f, 0xbf, 0xc4, 0x97, 0xb0, 0x35, 0x32, 0x8b, 0x2a, 0x67,
-		0x0c, 0xec, 0x24, 0x5e, 0xeb, 0x0c, 0x07, 0x91, 0x02, 0xcb, 0x7d, 0x59, 0x5e, 0x99, 0xf9, 0xd4,
-		0xca, 0x2c, 0xf5, 0x18, 0xbc, 0x3a, 0x0f, 0x61, 0xa6, 0x4d, 0xda, 0x7e, 0x7e, 0x81, 0x13, 0xaf,
-		0x8e, 0xcc, 0xe7, 0x80, 0xb4, 0x7d, 0xcc, 0x61, 0x08, 0xc3, 0x6a, 0x48, 0x9c, 0xa0, 0x71, 0x66,
-		0x3b, 0x94, 0x06, 0xee, 0x71, 0x97, 0x92, 0x30, 0x0f, 0x9c, 0x7b, 0x77, 0x24, 0xb7, 0xc6, 0xd1,
-		0x4a, 0x0f, 0x8c, 0xe5, 0xf0, 0x42, 0x04, 0x95, 0x61, 0xd5, 0xe9, 0x52, 0xdf, 0x0e, 0x48, 0x48,
-		0xa8, 0xdd, 0xf1, 0x5d, 0x8f, 0x86, 0xf9, 0x1c, 0xd7, 0xdc, 0x1c, 0xa9, 0x89, 0x19, 0xb0, 0xca,
-		0x71, 0x78, 0x85, 0x51, 0x13, 0x01, 0x74, 0x1d, 0x16, 0xd8, 0x78, 0xd8, 0x6c, 0x3e, 0xf2, 0x8b,
-		0x9b, 0xd2, 0xd6, 0x02, 0x9e, 0x67, 0x81, 0xb2, 0x1b, 0x52, 0xb4, 0x01, 0x97, 0xdc, 0xd0, 0x6e,
-		0x04, 0xbe, 0x97, 0x5f, 0xda, 0x94, 0xb6, 0xe6, 0xf1, 0x9c, 0x1b, 0xaa, 0x81, 0xef, 0x15, 0x7e,
-		0x9d, 0x81, 0x5b, 0xc3, 0x9b, 0xef, 0x7b, 0x27, 0xee, 0xa9, 0x18, 0x69, 0xf4, 0x6d, 0x52, 0x38,
-		0x1a, 0xa1, 0x9b, 0x23, 0xd3, 0xb3, 0xc4, 0xd3, 0x12, 0xcf, 0x75, 0x60, 0xb3, 0xbf, 0x51, 0x62,
-		0x06, 0x7c, 0xbb, 0xdf, 0xd1, 0x7e, 0x97, 0x8a, 0x61, 0xba, 0x3a, 0xb4, 0x75, 0x9a, 0x48, 0x00,
-		0xdf, 0xe8, 0x49, 0xd4, 0xf8, 0x5c, 0xf8, 0x6a, 0xdc, 0xe3, 0x7e, 0x97, 0xa2, 0x43, 0xb8, 0xce,
-		0xd3, 0x1b, 0xa3, 0x9e, 0x4d, 0x53, 0xdf, 0x60, 0xec, 0x11, 0xc2, 0x85, 0xbf, 0x4b, 0xb0, 0x36,
-		0xa2, 0x23, 0x59, 0xa1, 0x9b, 0x7e, 0xdb, 0x71, 0x3d, 0xdb, 0x6d, 0xf2, 0x7a, 0x2c, 0xe0, 0xf9,
-		0x28, 0x60, 0x34, 0xd1, 0x6d, 0xc8, 0x89, 0x9b, 0x9e, 0xd3, 0x8e, 0x8c, 0x62, 0x01, 0x43, 0x14,
-		0x32, 0x9d, 0x36, 0x19, 0xe3, 0x4c, 0xd9, 0xff, 0xd6, 0x99, 0xee, 0xc0, 0xa2, 0xeb, 0xb9, 0xd4,
-		0x75, 0x28, 0x69, 0xb2, 0xbc, 0x66, 0xf8, 0x50, 0xe6, 0x7a, 0x31, 0xa3, 0x59, 0xf8, 0x95, 0x04,
-		0xeb, 0xfa, 0x27, 0x4a, 0x02, 0xcf, 0x69, 0x7d, 0x2f, 0x6e, 0x79, 0x31, 0xa7, 0xcc, 0x70, 0x4e,
-		0xff, 0x9c, 0x85, 0xb5, 0x2a, 0xf1, 0x9a, 0xae, 0x77, 0xaa, 0x34, 0xa8, 0xfb, 0xc1, 0xa5, 0xe7,
-		0x3c, 0xa3, 0xdb, 0x90, 0x73, 0xc4, 0x75, 0xbf, 0xca, 0x10, 0x87, 0x8c, 0x26, 0xda, 0x83, 0xa5,
-		0x1e, 0x20, 0xd5, 0x92, 0x63, 0x69, 0x6e, 0xc9, 0x8b, 0x4e, 0xe2, 0x0a, 0xbd, 0x84, 0x59, 0x66,
-		0x8f, 0x91, 0x2b, 0x2f, 0x3f, 0x7a, 0x30, 0xda, 0x97, 0x06, 0x33, 0x64, 0x4e, 0x48, 0x70, 0xc4,
-		0x43, 0x06, 0xac, 0x9e, 0x11, 0x27, 0xa0, 0xc7, 0xc4, 0xa1, 0x76, 0x93, 0x50, 0xc7, 0x6d, 0x85,
-		0xc2, 0xa7, 0x6f, 0x8c, 0x31, 0xb9, 0xf3, 0x96, 0xef, 0x34, 0xb1, 0xdc, 0xa3, 0x69, 0x11, 0x0b,
-		0xbd, 0x86, 0xb5, 0x96, 0x13, 0x52, 0xbb, 0xaf, 0xc7, 0xad, 0x6d, 0x36, 0xd5, 0xda, 0x56, 0x19,
-		0x6d, 0x3f, 0x66, 0x71, 0x7b, 0xdb, 0x03, 0x1e, 0x8c, 0xa6, 0x82, 0x34, 0x23, 0xa5, 0xb9, 0x54,
-		0xa5, 0x15, 0x46, 0xaa, 0x45, 0x1c, 0xae, 0x93, 0x87, 0x4b, 0x0e, 0xa5, 0xa4, 0xdd, 0xa1, 0xdc,
-		0xb9, 0x67, 0x71, 0x7c, 0x89, 0x1e, 0x80, 0xdc, 0x76, 0x3e, 0xb9, 0xed, 0x6e, 0xdb, 0x16, 0xa1,
-		0x90, 0xbb, 0xf0, 0x2c, 0x5e, 0x11, 0x71, 0x45, 0x84, 0x99, 0x5d, 0x87, 0x8d, 0x33, 0xd2, 0xec,
-		0xb6, 0xe2, 0x4c, 0x16, 0xd2, 0xed, 0xba, 0xc7, 0xe0, 0x79, 0xa8, 0xb0, 0x42, 0x3e, 0x75, 0xdc,
-		0x68, 0x66, 0x23, 0x0d, 0x48, 0xd5, 0x58, 0xee, 0x53, 0xb8, 0xc8, 0x4b, 0x58, 0xe4, 0x45, 0x39,
-		0x71, 0xdc, 0x56, 0x37, 0x20, 0xc2, 0x6b, 0x47, 0x6f, 0xd3, 0x5e, 0x84, 0xc1, 0x39, 0xc6, 0x10,
-		0x17, 0xe8, 0x2b, 0xb8, 0xcc, 0x05, 0x58, 0xaf, 0x93, 0xc0, 0x76, 0x9b, 0xc4, 0xa3, 0x2e, 0x3d,
-		0x17, 0x76, 0x8b, 0xd8, 0xbd, 0x43, 0x7e, 0xcb, 0x10, 0x77, 0x0a, 0xbf, 0xcd, 0xc0, 0x55, 0xd1,
-		0x3e, 0xea, 0x99, 0xdb, 0x6a, 0x7e, 0x2f, 0x83, 0xf7, 0x65, 0x42, 0x96, 0x0d, 0x47, 0xd2, 0x8b,
-		0xe4, 0x8f, 0x89, 0xf3, 0x09, 0x77, 0xa4, 0x8b, 0x63, 0x9a, 0x1d, 0x1a, 0x53, 0xf4, 0x16, 0xc4,
-		0x6b, 0x58, 0x98, 0x6b, 0xc7, 0x6f, 0xb9, 0x8d, 0x73, 0xde, 0xe6, 0xcb, 0x63, 0x12, 0x8d, 0x9c,
-		0x93, 0x1b, 0x6a, 0x95, 0xa3, 0xf1, 0x6a, 0xe7, 0x62, 0xa8, 0xf0, 0xb7, 0x4c, 0x6f, 0xfc, 0x35,
-		0xd2, 0x70, 0xc3, 0xb8, 0x2e, 0xbd, 0xa9, 0x94, 0xd2, 0xa7, 0x32, 0x26, 0x0e, 0x4c, 0xe5, 0x70,
-		0xc7, 0x65, 0x3e, 0xb7, 0xe3, 0x5e, 0xc0, 0xe2, 0xc0, 0xf0, 0xa4, 0x1f, 0xdb, 0x72, 0xe1, 0xe8,
-		0xc1, 0x99, 0x19, 0x1c, 0x1c, 0x0c, 0x1b, 0x7e, 0xe0, 0x9e, 0xba, 0x9e, 0xd3, 0xb2, 0x2f, 0x24,
-		0x99, 0x3e, 0xea, 0xeb, 0x31, 0xb5, 0x96, 0x4c, 0xb6, 0xf0, 0xa7, 0x0c, 0x5c, 0x8d, 0xed, 0xa9,
-		0xec, 0x37, 0x9c, 0x96, 0xe6, 0x86, 0x1d, 0x87, 0x36, 0xce, 0xa6, 0x73, 0xd3, 0xff, 0x7d, 0xb9,
-		0x7e, 0x0e, 0xb7, 0x06, 0x33, 0xb0, 0xfd, 0x13, 0x9b, 0x9e, 0xb9, 0xa1, 0x9d, 0xac, 0xe2, 0x64,
-		0xc1, 0x6b, 0x03, 0x19, 0x55, 0x4e, 0xac, 0x33, 0x37, 0x14, 0x1e, 0x84, 0x6e, 0x02, 0xf0, 0x53,
-		0x02, 0xf5, 0xdf, 0x13, 0x8f, 0xd7, 0x79, 0x11, 0xf3, 0x63, 0x8d, 0xc5, 0x02, 0x85, 0xd7, 0x90,
-		0x4b, 0x9e, 0xa5, 0x9e, 0xc3, 0x9c, 0x38, 0x8e, 0x49, 0x9b, 0xd9, 0xad, 0xdc, 0xa3, 0x2f, 0x52,
-		0x8e, 0x63, 0xfc, 0xa4, 0x2a, 0x28, 0x85, 0x3f, 0x64, 0x60, 0x79, 0xf0, 0x16, 0xba, 0x0f, 0x2b,
-		0xc7, 0xae, 0xe7, 0x04, 0xe7, 0x76, 0xe3, 0x8c, 0x34, 0xde, 0x87, 0xdd, 0xb6, 0xd8, 0x84, 0xe5,
-		0x28, 0xac, 0x8a, 0x28, 0x5a, 0x87, 0xb9, 0xa0, 0xeb, 0xc5, 0x2f, 0xcb, 0x05, 0x3c, 0x1b, 0x74,
-		0xd9, 0xa9, 0xe2, 0x05, 0x5c, 0x3f, 0x71, 0x83, 0x90, 0xbd, 0x60, 0xa2, 0x66, 0xb7, 0x1b, 0x7e,
-		0xbb, 0xd3, 0x22, 0x03, 0x13, 0x9b, 0xe7, 0x90, 0x78, 0x1c, 0xd4, 0x18, 0xc0, 0xe9, 0x8b, 0x8d,
-		0x80, 0x38, 0xbd, 0xbd, 0x49, 0x2f, 0x65, 0x4e, 0xe0, 0x85, 0x6d, 0x2e, 0x71, 0x23, 0x75, 0xbd,
-		0xd3, 0x69, 0xdb, 0x74, 0x31, 0x26, 0x70, 0x81, 0x5b, 0x00, 0xfc, 0x8c, 0x4b, 0x9d, 0xe3, 0x56,
-		0xf4, 0x16, 0x9a, 0xc7, 0x89, 0x48, 0xf1, 0x8f, 0x12, 0x5c, 0x1e, 0xf5, 0x8e, 0x45, 0x05, 0xb8,
-		0x55, 0xd5, 0x4d, 0xcd, 0x30, 0x5f, 0xd9, 0x8a, 0x6a, 0x19, 0x6f, 0x0d, 0xeb, 0xc8, 0xae, 0x59,
-		0x8a, 0xa5, 0xdb, 0x86, 0xf9, 0x56, 0x29, 0x1b, 0x9a, 0xfc, 0x7f, 0xe8, 0xff, 0x61, 0x73, 0x0c,
-		0xa6, 0xa6, 0xee, 0xeb, 0x5a, 0xbd, 0xac, 0x6b, 0xb2, 0x34, 0x41, 0xa9, 0x66, 0x29, 0xd8, 0xd2,
-		0x35, 0x39, 0x83, 0x7e, 0x00, 0xf7, 0xc7, 0x60, 0x54, 0xc5, 0x54, 0xf5, 0xb2, 0x8d, 0xf5, 0x9f,
-		0xd6, 0xf5, 0x1a, 0x03, 0x67, 0x8b, 0xbf, 0xe8, 0xe7, 0x3c, 0xe0, 0x40, 0xc9, 0x27, 0x69, 0xba,
-		0x6a, 0xd4, 0x8c, 0x8a, 0x39, 0x29, 0xe7, 0x0b, 0x98, 0x31, 0x39, 0x5f, 0x44, 0xc5, 0x39, 0x17,
-		0x7f, 0x99, 0xe9, 0x7f, 0x02, 0x1b, 0x4d, 0x4c, 0xba, 0xb1, 0xb7, 0xb2, 0x67, 0x1c, 0x56, 0xf0,
-		0x9b, 0xbd, 0x72, 0xe5, 0xd0, 0x36, 0x34, 0x1b, 0xeb, 0xf5, 0x9a, 0x6e, 0x57, 0x2b, 0x65, 0x43,
-		0x3d, 0x4a, 0x64, 0xf2, 0x43, 0xf8, 0x7a, 0x2c, 0x4a, 0x29, 0xb3, 0xa8, 0x56, 0xaf, 0x96, 0x0d,
-		0x95, 0x3d, 0x75, 0x4f, 0x31, 0xca, 0xba, 0x66, 0x57, 0xcc, 0xf2, 0x91, 0x2c, 0xa1, 0x2f, 0x61,
-		0x6b, 0x5a, 0xa6, 0x9c, 0x41, 0x0f, 0xe1, 0xc1, 0x58, 0x34, 0xd6, 0x5f, 0xeb, 0xaa, 0x95, 0x80,
-		0x67, 0xd1, 0x0e, 0x3c, 0x1c, 0x0b, 0xb7, 0x74, 0x7c, 0x60, 0x98, 0xbc, 0xa0, 0x7b, 0x36, 0xae,
-		0x9b, 0xa6, 0x61, 0xbe, 0x92, 0x67, 0x8a, 0xbf, 0x93, 0x60, 0x75, 0xe8, 0xa5, 0x83, 0x6e, 0xc3,
-		0xf5, 0xaa, 0x82, 0x75, 0xd3, 0xb2, 0xd5, 0x72, 0x65, 0x54, 0x01, 0xc6, 0x00, 0x94, 0x5d, 0xc5,
-		0xd4, 0x2a, 0xa6, 0x2c, 0xa1, 0x7b, 0x50, 0x18, 0x05, 0x10, 0xbd, 0x20, 0x5a, 0x43, 0xce, 0xa0,
-		0x3b, 0x70, 0x73, 0x14, 0xae, 0x97, 0xad, 0x9c, 0x2d, 0xfe, 0x2b, 0x03, 0x37, 0x26, 0x7d, 0x69,
-		0xb3, 0x0e, 0xec, 0x2d, 0x5b, 0x7f, 0xa7, 0xab, 0x75, 0x8b, 0xed, 0x79, 0xa4, 0xc7, 0x76, 0xbe,
-		0x5e, 0x4b, 0x64, 0x9e, 0x2c, 0xe9, 0x18, 0xb0, 0x5a, 0x39, 0xa8, 0x96, 0x75, 0x8b, 0x77, 0x53,
-		0x11, 0xee, 0xa5, 0xc1, 0xa3, 0x0d, 0x96, 0x33, 0x03, 0x7b, 0x3b, 0x4e, 0x9a, 0xaf, 0x9b, 0x8d,
-		0x02, 0x2a, 0x41, 0x31, 0x0d, 0xdd, 0xab, 0x82, 0x26, 0xcf, 0xa0, 0xaf, 0xe1, 0xab, 0xf4, 0xc4,
-		0x4d, 0xcb, 0x30, 0xeb, 0xba, 0x66, 0x2b, 0x35, 0xdb, 0xd4, 0x0f, 0xe5, 0xd9, 0x69, 0x96, 0x6b,
-		0x19, 0x07, 0xac, 0x3f, 0xeb, 0x96, 0x3c, 0x57, 0xfc, 0xb3, 0x04, 0x57, 0x54, 0xdf, 0xa3, 0xae,
-		0xd7, 0x25, 0x4a, 0x68, 0x92, 0x8f, 0x46, 0x74, 0x9e, 0xf1, 0x03, 0x74, 0x17, 0xee, 0xc4, 0xfa,
-		0x42, 0xde, 0x36, 0x4c, 0xc3, 0x32, 0x14, 0xab, 0x82, 0x13, 0xf5, 0x9d, 0x08, 0x63, 0x03, 0xa9,
-		0xe9, 0x38, 0xaa, 0xeb, 0x78, 0x18, 0xd6, 0x2d, 0x7c, 0x24, 0x5a, 0x21, 0x72, 0x98, 0xf1, 0x58,
-		0x15, 0xb3, 0xf9, 0x16, 0xf3, 0x2f, 0x67, 0x8b, 0xbf, 0x97, 0x20, 0x27, 0xbe, 0x45, 0xf9, 0xa7,
-		0x4a, 0x1e, 0x2e, 0xb3, 0x05, 0x56, 0xea, 0x96, 0x6d, 0x1d, 0x55, 0xf5, 0xc1, 0x1e, 0x1e, 0xb8,
-		0xc3, 0xed, 0xc1, 0xb6, 0x2a, 0x51, 0x75, 0x22, 0x27, 0x19, 0x04, 0x88, 0xa7, 0x30, 0x0c, 0x07,
-		0xcb, 0x99, 0x89, 0x98, 0x48, 0x27, 0x8b, 0xae, 0xc1, 0x95, 0x01, 0xcc, 0xbe, 0xae, 0x60, 0x6b,
-		0x57, 0x57, 0x2c, 0x79, 0xa6, 0xf8, 0x1b, 0x09, 0xae, 0xc6, 0x4e, 0x68, 0xb1, 0x17, 0xab, 0xdb,
-		0x26, 0xcd, 0x4a, 0x97, 0xaa, 0x4e, 0x37, 0x24, 0xe8, 0x01, 0xdc, 0xed, 0x79, 0x98, 0xa5, 0xd4,
-		0xde, 0xf4, 0xf7, 0xca, 0x56, 0x15, 0x36, 0xdc, 0xfd, 0xd5, 0xa4, 0x42, 0x45, 0x0a, 0xb2, 0x84,
-		0xee, 0xc3, 0x17, 0x93, 0xa1, 0x58, 0xaf, 0xe9, 0x96, 0x9c, 0x29, 0xfe, 0x23, 0x07, 0x1b, 0xc9,
-		0xe4, 0xd8, 0x81, 0x9e, 0x34, 0xa3, 0xd4, 0xee, 0x41, 0x61, 0x50, 0x44, 0xf8, 0xdc, 0xc5, 0xbc,
-		0x76, 0xe0, 0xe1, 0x04, 0x5c, 0xdd, 0xdc, 0x57, 0x4c, 0x8d, 0x5d, 0xc7, 0x20, 0x59, 0x42, 0x2f,
-		0xe1, 0xf9, 0x04, 0xca, 0xae, 0xa2, 0xf5, 0xab, 0xdc, 0x7b, 0xe3, 0x28, 0x96, 0x85, 0x8d, 0xdd,
-		0xba, 0xa5, 0xd7, 0xe4, 0x0c, 0xd2, 0x41, 0x49, 0x11, 0x18, 0xf4, 0xa1, 0x91, 0x32, 0x59, 0xf4,
-		0x0c, 0x9e, 0xa4, 0xe5, 0x11, 0xb5, 0x8c, 0x71, 0xa0, 0xe3, 0x24, 0x75, 0x06, 0x7d, 0x0b, 0xdf,
-		0xa4, 0x50, 0xc5, 0x93, 0x87, 0xb8, 0xb3, 0xe8, 0x39, 0x3c, 0x4d, 0xcd, 0x5e, 0xad, 0x60, 0xcd,
-		0x3e, 0x50, 0xf0, 0x9b, 0x41, 0xf2, 0x1c, 0x32, 0x40, 0x4f, 0x7b, 0xb0, 0x70, 0x37, 0x7b, 0x84,
-		0x2f, 0x24, 0xa4, 0x2e, 0x4d, 0x51, 0x45, 0x16, 0x48, 0x91, 0x99, 0x47, 0xaf, 0x40, 0x9d, 0xae,
-		0x14, 0x93, 0x85, 0x16, 0xd0, 0x3b, 0xb0, 0x3e, 0x6f, 0x57, 0xf5, 0x77, 0x96, 0x8e, 0x4d, 0x25,
-		0x4d, 0x19, 0xd0, 0x0b, 0x78, 0x96, 0x5a, 0xb4, 0x41, 0xff, 0x49, 0xd0, 0x73, 0xe8, 0x29, 0x3c,
-		0x9e, 0x40, 0x4f, 0xf6, 0x48, 0xff, 0x54, 0x60, 0x68, 0xf2, 0x22, 0x7a, 0x02, 0x3b, 0x13, 0x88,
-		0x7c, 0x0a, 0xed, 0x9a, 0x65, 0xa8, 0x6f, 0x8e, 0xa2, 0xdb, 0x65, 0xa3, 0x66, 0xc9, 0x4b, 0xe8,
-		0x27, 0xf0, 0xa3, 0x09, 0xb4, 0xde, 0x62, 0xd9, 0x1f, 0x3a, 0x4e, 0x8c, 0x18, 0x83, 0xd5, 0xb1,
-		0x2e, 0x2f, 0x4f, 0xb1, 0x27, 0x35, 0xe3, 0x55, 0x7a, 0xe5, 0x56, 0x90, 0x0a, 0x2f, 0xa7, 0x1a,
-		0x11, 0x75, 0xdf, 0x28, 0x6b, 0xa3, 0x45, 0x64, 0xf4, 0x18, 0xb6, 0x27, 0x88, 0xec, 0x55, 0xb0,
-		0xaa, 0x8b, 0x37, 0x56, 0xcf, 0x24, 0x56, 0xd1, 0x37, 0xf0, 0x68, 0x12, 0x49, 0x31, 0xca, 0x95,
-		0xb7, 0x3a, 0xbe, 0xc8, 0x43, 0xec, 0x35, 0x3a, 0xdd, 0xd2, 0x0d, 0xb3, 0x5a, 0xb7, 0xec, 0x9a,
-		0xf1, 0x9d, 0x2e, 0xaf, 0xb1, 0xd7, 0x68, 0xea, 0x4e, 0xc5, 0xb5, 0x92, 0x2f, 0x0f, 0x9b, 0xf1,
-		0xd0, 0x43, 0x76, 0x0d, 0x53, 0xc1, 0x47, 0xf2, 0x7a, 0x4a, 0xef, 0x0d, 0x1b, 0xdd, 0x40, 0x0b,
-		0x5d, 0x99, 0x66, 0x39, 0xba, 0x82, 0xd5, 0xfd, 0x64, 0xc5, 0x37, 0xd8, 0x5b, 0xe7, 0x0e, 0xff,
-		0xc7, 0xca, 0xd0, 0xb9, 0x2a, 0x69, 0xf1, 0x3b, 0xf0, 0x30, 0xda, 0xb7, 0x11, 0x5d, 0x30, 0xc6,
-		0xed, 0x77, 0xe1, 0xc7, 0xd3, 0x51, 0x7a, 0xf7, 0x95, 0x32, 0xd6, 0x15, 0xed, 0xa8, 0x77, 0x24,
-		0x95, 0x8a, 0x7f, 0x95, 0xa0, 0xa8, 0x3a, 0x5e, 0x83, 0xb4, 0xe2, 0xff, 0xbb, 0x4e, 0xcc, 0xf2,
-		0x39, 0x3c, 0x9d, 0x62, 0xde, 0xc7, 0xe4, 0x7b, 0x08, 0xb5, 0xcf, 0x25, 0xd7, 0xcd, 0x37, 0x66,
-		0xe5, 0xd0, 0x9c, 0x44, 0x10, 0x8b, 0xa8, 0xb9, 0xa7, 0xfc, 0x9f, 0xc6, 0xd3, 0x2d, 0x42, 0xb4,
-		0xdd, 0x7f, 0xb6, 0x88, 0xcf, 0x25, 0x4f, 0xb5, 0x88, 0xdd, 0xb7, 0xb0, 0xd1, 0xf0, 0xdb, 0xa3,
-		0xbe, 0xe2, 0x77, 0xe7, 0x95, 0x8e, 0x5b, 0x65, 0x5f, 0xb0, 0x55, 0xe9, 0xbb, 0xed, 0x53, 0x97,
-		0x9e, 0x75, 0x8f, 0x4b, 0x0d, 0xbf, 0xbd, 0x3d, 0xf0, 0xfb, 0x63, 0xe9, 0x94, 0x78, 0xd1, 0xaf,
-		0x99, 0xe2, 0xa7, 0xc8, 0xe7, 0x4e, 0xc7, 0xfd, 0xb0, 0x73, 0x3c, 0xc7, 0x63, 0x8f, 0xff, 0x1d,
-		0x00, 0x00, 0xff, 0xff, 0x1c, 0xfe, 0xdc, 0x7d, 0x4a, 0x1d, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x6f, 0xd3, 0x40,
+		0x10, 0xc5, 0x49, 0x68, 0x03, 0x4c, 0x0a, 0x58, 0x8b, 0x20, 0xe0, 0x0a, 0x82, 0x0c, 0x87, 0x8a,
+		0xc3, 0x5a, 0x29, 0xc7, 0x1e, 0x50, 0x82, 0x0d, 0x5a, 0x61, 0x92, 0xe0, 0x6c, 0x53, 0xc2, 0xc5,
+		0x5a, 0xdb, 0xdb, 0xb0, 0xc2, 0xf6, 0x5a, 0xf6, 0x3a, 0x6d, 0x3f, 0x45, 0xbf, 0x32, 0xf2, 0x3f,
+		0x24, 0x84, 0x23, 0x6e, 0xf6, 0x9b, 0xf7, 0x7e, 0x9a, 0x99, 0x1d, 0x78, 0x5b, 0xf8, 0x3c, 0x33,
+		0x03, 0x16, 0xf2, 0x24, 0xe0, 0x26, 0x4b, 0x85, 0xb9, 0x9b, 0x98, 0x3b, 0x91, 0x0b, 0x5f, 0x44,
+		0x42, 0xdd, 0xe0, 0x34, 0x93, 0x4a, 0xa2, 0x27, 0xa5, 0x0b, 0x37, 0x2e, 0xcc, 0x52, 0x81, 0x77,
+		0x13, 0x7d, 0xbc, 0x95, 0x72, 0x1b, 0x71, 0xb3, 0xb2, 0xf8, 0xc5, 0xa5, 0xa9, 0x44, 0xcc, 0x73,
+		0xc5, 0xe2, 0xb4, 0x4e, 0xe9, 0x46, 0x17, 0xfb, 0x4a, 0x66, 0xbf, 0x2e, 0x23, 0x79, 0x55, 0x7b,
+		0x8c, 0x6f, 0x30, 0xba, 0x68, 0x14, 0xfb, 0x9a, 0x07, 0x85, 0x12, 0x32, 0xf9, 0x24, 0x22, 0xc5,
+		0x33, 0x34, 0x86, 0x61, 0x6b, 0xf6, 0x44, 0xf8, 0xbc, 0xf7, 0xba, 0x77, 0xf2, 0xc0, 0x85, 0x56,
+		0x22, 0x21, 0x7a, 0x0a, 0x83, 0xac, 0x48, 0xca, 0x5a, 0xbf, 0xaa, 0x1d, 0x66, 0x45, 0x42, 0x42,
+		0xe3, 0x04, 0x50, 0x8b, 0xa4, 0x37, 0x29, 0x6f, 0x68, 0x08, 0x0e, 0x12, 0x16, 0xf3, 0x06, 0x53,
+		0x7d, 0x1b, 0xb7, 0x3d, 0x78, 0xbc, 0x52, 0x2c, 0x53, 0x54, 0xc4, 0xad, 0xef, 0x03, 0x3c, 0xe4,
+		0x2c, 0x8b, 0x04, 0xcf, 0x95, 0xa7, 0x44, 0x13, 0x18, 0x9e, 0xea, 0xb8, 0x9e, 0x16, 0xb7, 0xd3,
+		0x62, 0xda, 0x4e, 0xeb, 0x1e, 0xb5, 0x81, 0x52, 0x42, 0x67, 0x30, 0x8c, 0x98, 0xfa, 0x13, 0xef,
+		0xff, 0x37, 0x0e, 0xb5, 0xbd, 0x14, 0x8c, 0x0d, 0x1c, 0xad, 0x14, 0x53, 0x45, 0xde, 0x74, 0x43,
+		0x60, 0x90, 0x57, 0xff, 0x55, 0x1b, 0x8f, 0x4e, 0x27, 0xb8, 0xe3, 0x25, 0xf0, 0x3f, 0x1b, 0xfc,
+		0x18, 0xc9, 0x9c, 0xd7, 0x20, 0xb7, 0x01, 0xbc, 0xbb, 0xed, 0x83, 0x46, 0x92, 0x90, 0x5f, 0xf3,
+		0x70, 0xcd, 0xa2, 0x82, 0x97, 0xbb, 0x41, 0xaf, 0x40, 0x27, 0x73, 0xcb, 0xfe, 0x6e, 0x5b, 0xde,
+		0x7a, 0xea, 0x9c, 0xdb, 0x1e, 0xdd, 0x2c, 0x6d, 0x8f, 0xcc, 0xd7, 0x53, 0x87, 0x58, 0xda, 0x1d,
+		0xf4, 0x12, 0x5e, 0x74, 0xd4, 0x57, 0xd4, 0x25, 0xf3, 0xcf, 0x5a, 0x6f, 0x4f, 0xfc, 0x8b, 0xbd,
+		0xb9, 0x58, 0xb8, 0x96, 0xd6, 0x47, 0x3a, 0x3c, 0xeb, 0xc4, 0x53, 0xed, 0xee, 0x1e, 0xb4, 0xb5,
+		0x38, 0x9f, 0x39, 0xb6, 0x76, 0x80, 0x8e, 0x61, 0xd4, 0x51, 0x9e, 0x2d, 0x16, 0x8e, 0x76, 0x88,
+		0xc6, 0x70, 0xdc, 0x95, 0x9d, 0x52, 0x9b, 0x92, 0xaf, 0xb6, 0x36, 0x40, 0x6f, 0x60, 0xbc, 0xbf,
+		0x31, 0xcf, 0x21, 0x2b, 0xaa, 0xdd, 0x9b, 0xad, 0x61, 0x14, 0xc8, 0xb8, 0x6b, 0xa3, 0xb3, 0xfb,
+		0xd3, 0x54, 0x2c, 0xcb, 0xa7, 0x5a, 0xf6, 0x7e, 0x98, 0x5b, 0xa1, 0x7e, 0x16, 0x3e, 0x0e, 0x64,
+		0x6c, 0xfe, 0x75, 0xd1, 0x78, 0xcb, 0x93, 0xfa, 0xfa, 0x9b, 0xe3, 0x3e, 0x63, 0xa9, 0xd8, 0x4d,
+		0xfc, 0x41, 0xa5, 0xbd, 0xff, 0x3d, 0x00, 0x30, 0x93, 0x6e, 0x99, 0x5c, 0x03, 0x00, 0x00,
 	},
-	// uber/cadence/api/v1/tasklist.proto
+	// uber/cadence/api/v1/workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xdb, 0x36,
-		0x14, 0x9e, 0xe2, 0xb4, 0x73, 0x98, 0x25, 0xd5, 0xb8, 0xb5, 0x8d, 0xdd, 0x75, 0xcb, 0x74, 0x51,
-		0x04, 0xc5, 0x20, 0x21, 0x19, 0x76, 0xb5, 0x8b, 0xc1, 0x89, 0x83, 0x55, 0x88, 0xe3, 0x1a, 0x92,
-		0x1a, 0x20, 0xbb, 0xe1, 0x28, 0xf1, 0xd4, 0x21, 0xf4, 0x43, 0x81, 0xa4, 0x92, 0xf8, 0x45, 0xf6,
-		0x30, 0x7b, 0xa2, 0x3d, 0xc6, 0x40, 0x4a, 0xf6, 0xdc, 0xc4, 0xeb, 0x1d, 0x79, 0xbe, 0xf3, 0x9d,
-		0x9f, 0x8f, 0xe7, 0x10, 0x79, 0x4d, 0x0a, 0x32, 0xc8, 0x28, 0x83, 0x2a, 0x83, 0x80, 0xd6, 0x3c,
-		0xb8, 0x3d, 0x0e, 0x34, 0x55, 0x79, 0xc1, 0x95, 0xf6, 0x6b, 0x29, 0xb4, 0xc0, 0xdf, 0x18, 0x1f,
-		0xbf, 0xf3, 0xf1, 0x69, 0xcd, 0xfd, 0xdb, 0xe3, 0xe1, 0xf7, 0x73, 0x21, 0xe6, 0x05, 0x04, 0xd6,
-		0x25, 0x6d, 0x3e, 0x06, 0xac, 0x91, 0x54, 0x73, 0x51, 0xb5, 0xa4, 0xe1, 0x0f, 0x0f, 0x71, 0xcd,
-		0x4b, 0x50, 0x9a, 0x96, 0x75, 0xe7, 0xf0, 0x28, 0xc0, 0x9d, 0xa4, 0x75, 0x0d, 0x52, 0xb5, 0xb8,
-		0xf7, 0x01, 0xf5, 0x13, 0xaa, 0xf2, 0x09, 0x57, 0x1a, 0x63, 0xb4, 0x5d, 0xd1, 0x12, 0x0e, 0x9c,
-		0x43, 0xe7, 0x68, 0x27, 0xb2, 0x67, 0xfc, 0x0b, 0xda, 0xce, 0x79, 0xc5, 0x0e, 0xb6, 0x0e, 0x9d,
-		0xa3, 0xfd, 0x93, 0x1f, 0xfd, 0x0d, 0x45, 0xfa, 0xcb, 0x00, 0x17, 0xbc, 0x62, 0x91, 0x75, 0xf7,
-		0x28, 0x72, 0x97, 0xd6, 0x4b, 0xd0, 0x94, 0x51, 0x4d, 0xf1, 0x25, 0xfa, 0xb6, 0xa4, 0xf7, 0xc4,
-		0xb4, 0xad, 0x48, 0x0d, 0x92, 0x28, 0xc8, 0x44, 0xc5, 0x6c, 0xba, 0xdd, 0x93, 0xef, 0xfc, 0xb6,
-		0x52, 0x7f, 0x59, 0xa9, 0x3f, 0x16, 0x4d, 0x5a, 0xc0, 0x15, 0x2d, 0x1a, 0x88, 0xbe, 0x2e, 0xe9,
-		0xbd, 0x09, 0xa8, 0x66, 0x20, 0x63, 0x4b, 0xf3, 0x3e, 0xa0, 0xc1, 0x32, 0xc5, 0x8c, 0x4a, 0xcd,
-		0x8d, 0x2a, 0xab, 0x5c, 0x2e, 0xea, 0xe5, 0xb0, 0xe8, 0x3a, 0x31, 0x47, 0xfc, 0x06, 0x3d, 0x13,
-		0x77, 0x15, 0x48, 0x72, 0x23, 0x94, 0x26, 0xb6, 0xcf, 0x2d, 0x8b, 0xee, 0x59, 0xf3, 0x3b, 0xa1,
-		0xf4, 0x94, 0x96, 0xe0, 0xfd, 0xe3, 0xa0, 0xfd, 0x65, 0xdc, 0x58, 0x53, 0xdd, 0x28, 0xfc, 0x13,
-		0xc2, 0x29, 0xcd, 0xf2, 0x42, 0xcc, 0x49, 0x26, 0x9a, 0x4a, 0x93, 0x1b, 0x5e, 0x69, 0x1b, 0xbb,
-		0x17, 0xb9, 0x1d, 0x72, 0x66, 0x80, 0x77, 0xbc, 0xd2, 0xf8, 0x35, 0x42, 0x12, 0x28, 0x23, 0x05,
-		0xdc, 0x42, 0x61, 0x73, 0xf4, 0xa2, 0x1d, 0x63, 0x99, 0x18, 0x03, 0x7e, 0x85, 0x76, 0x68, 0x96,
-		0x77, 0x68, 0xcf, 0xa2, 0x7d, 0x9a, 0xe5, 0x2d, 0xf8, 0x06, 0x3d, 0x93, 0x54, 0xc3, 0xba, 0x3a,
-		0xdb, 0x87, 0xce, 0x91, 0x13, 0xed, 0x19, 0xf3, 0xaa, 0x77, 0x3c, 0x46, 0x7b, 0x46, 0x46, 0xc2,
-		0x19, 0x49, 0x0b, 0x91, 0xe5, 0x07, 0x4f, 0xac, 0x86, 0x87, 0xff, 0xfb, 0x3c, 0xe1, 0xf8, 0xd4,
-		0xf8, 0x45, 0xbb, 0x86, 0x16, 0x32, 0x7b, 0xf1, 0x7e, 0x43, 0xbb, 0x6b, 0x18, 0x1e, 0xa0, 0xbe,
-		0xd2, 0x54, 0x6a, 0xc2, 0x59, 0xd7, 0xdc, 0x97, 0xf6, 0x1e, 0x32, 0xfc, 0x1c, 0x3d, 0x85, 0x8a,
-		0x19, 0xa0, 0xed, 0xe7, 0x09, 0x54, 0x2c, 0x64, 0xde, 0x5f, 0x0e, 0x42, 0x33, 0x51, 0x14, 0x20,
-		0xc3, 0xea, 0xa3, 0xc0, 0x63, 0xe4, 0x16, 0x54, 0x69, 0x42, 0xb3, 0x0c, 0x94, 0x22, 0x66, 0x14,
-		0xbb, 0xc7, 0x1d, 0x3e, 0x7a, 0xdc, 0x64, 0x39, 0xa7, 0xd1, 0xbe, 0xe1, 0x8c, 0x2c, 0xc5, 0x18,
-		0xf1, 0x10, 0xf5, 0x39, 0x83, 0x4a, 0x73, 0xbd, 0xe8, 0x5e, 0x68, 0x75, 0xdf, 0xa4, 0x4f, 0x6f,
-		0x83, 0x3e, 0xde, 0xdf, 0x0e, 0x1a, 0xc4, 0x9a, 0x67, 0xf9, 0xe2, 0xfc, 0x1e, 0xb2, 0xc6, 0x8c,
-		0xc6, 0x48, 0x6b, 0xc9, 0xd3, 0x46, 0x83, 0xc2, 0xbf, 0x23, 0xf7, 0x4e, 0xc8, 0x1c, 0xa4, 0x9d,
-		0x45, 0x62, 0x76, 0xb0, 0xab, 0xf3, 0xf5, 0x67, 0xe7, 0x3b, 0xda, 0x6f, 0x69, 0xab, 0x85, 0x49,
-		0xd0, 0x40, 0x65, 0x37, 0xc0, 0x9a, 0x02, 0x88, 0x16, 0xa4, 0x55, 0xcf, 0xb4, 0x2d, 0x1a, 0x6d,
-		0x6b, 0xdf, 0x3d, 0x19, 0x3c, 0x1e, 0xeb, 0x6e, 0x83, 0xa3, 0x17, 0x4b, 0x6e, 0x22, 0x62, 0xc3,
-		0x4c, 0x5a, 0xe2, 0xdb, 0x3f, 0xd1, 0x57, 0xeb, 0x1b, 0x85, 0x87, 0xe8, 0x45, 0x32, 0x8a, 0x2f,
-		0xc8, 0x24, 0x8c, 0x13, 0x72, 0x11, 0x4e, 0xc7, 0x24, 0x9c, 0x5e, 0x8d, 0x26, 0xe1, 0xd8, 0xfd,
-		0x02, 0x0f, 0xd0, 0xf3, 0x07, 0xd8, 0xf4, 0x7d, 0x74, 0x39, 0x9a, 0xb8, 0xce, 0x06, 0x28, 0x4e,
-		0xc2, 0xb3, 0x8b, 0x6b, 0x77, 0xeb, 0x2d, 0xfb, 0x2f, 0x43, 0xb2, 0xa8, 0xe1, 0xd3, 0x0c, 0xc9,
-		0xf5, 0xec, 0x7c, 0x2d, 0xc3, 0x2b, 0xf4, 0xf2, 0x01, 0x36, 0x3e, 0x3f, 0x0b, 0xe3, 0xf0, 0xfd,
-		0xd4, 0x75, 0x36, 0x80, 0xa3, 0xb3, 0x24, 0xbc, 0x0a, 0x93, 0x6b, 0x77, 0xeb, 0xf4, 0x0a, 0xbd,
-		0xcc, 0x44, 0xb9, 0x49, 0xd1, 0xd3, 0xfe, 0xa8, 0xe6, 0x33, 0x23, 0xc8, 0xcc, 0xf9, 0x23, 0x98,
-		0x73, 0x7d, 0xd3, 0xa4, 0x7e, 0x26, 0xca, 0xe0, 0x93, 0x6f, 0xd2, 0x9f, 0x43, 0xd5, 0xfe, 0x5b,
-		0xdd, 0x8f, 0xf9, 0x2b, 0xad, 0xf9, 0xed, 0x71, 0xfa, 0xd4, 0xda, 0x7e, 0xfe, 0x37, 0x00, 0x00,
-		0xff, 0xff, 0x58, 0x62, 0x2b, 0xbf, 0x55, 0x05, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5d, 0x6f, 0xdb, 0xc8,
+		0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0x0f, 0x7a, 0x1c, 0xaf, 0xe5, 0x7c, 0x3a, 0xda, 0x37,
+		0x89, 0xa3, 0x6e, 0xe4, 0xb5, 0xb3, 0xd9, 0x34, 0x9b, 0x66, 0x53, 0x9a, 0x1c, 0xc7, 0x4c, 0x64,
+		0x4a, 0x1d, 0x52, 0x71, 0xbc, 0x28, 0x4a, 0xd0, 0x12, 0x6d, 0x13, 0x91, 0x48, 0x81, 0x1c, 0x25,
+		0xf1, 0x7d, 0x81, 0x5e, 0xf7, 0xae, 0x68, 0x6f, 0xfa, 0x03, 0x0a, 0x14, 0xfd, 0x01, 0x45, 0x8b,
+		0x5e, 0xf4, 0xa6, 0xe8, 0x5f, 0xe8, 0x45, 0xef, 0xfa, 0x2f, 0x8a, 0x19, 0x92, 0x12, 0xf5, 0x49,
+		0xa5, 0x05, 0xb6, 0x77, 0xd6, 0xe1, 0xf3, 0x3c, 0x3c, 0x73, 0xe6, 0x9c, 0x67, 0x46, 0x32, 0x14,
+		0x3a, 0xa7, 0xb6, 0xbf, 0x53, 0xb7, 0x1a, 0xb6, 0x5b, 0xb7, 0x77, 0xac, 0xb6, 0xb3, 0xf3, 0x7e,
+		0x77, 0xe7, 0x83, 0xe7, 0xbf, 0x3b, 0x6b, 0x7a, 0x1f, 0x4a, 0x6d, 0xdf, 0xa3, 0x1e, 0x5a, 0x63,
+		0x98, 0x52, 0x84, 0x29, 0x59, 0x6d, 0xa7, 0xf4, 0x7e, 0xf7, 0xda, 0xad, 0x73, 0xcf, 0x3b, 0x6f,
+		0xda, 0x3b, 0x1c, 0x72, 0xda, 0x39, 0xdb, 0x69, 0x74, 0x7c, 0x8b, 0x3a, 0x9e, 0x1b, 0x92, 0xae,
+		0xdd, 0x1e, 0x7c, 0x4e, 0x9d, 0x96, 0x1d, 0x50, 0xab, 0xd5, 0x8e, 0x00, 0x5b, 0xa3, 0xde, 0x5c,
+		0xf7, 0x5a, 0xad, 0xae, 0xc4, 0xc8, 0xdc, 0xa8, 0x15, 0xbc, 0x6b, 0x3a, 0x01, 0x0d, 0x31, 0x85,
+		0x3f, 0xcf, 0xc1, 0xfa, 0x71, 0x94, 0x2e, 0xfe, 0x68, 0xd7, 0x3b, 0x2c, 0x05, 0xd5, 0x3d, 0xf3,
+		0x50, 0x0d, 0x50, 0xbc, 0x0e, 0xd3, 0x8e, 0x9f, 0xe4, 0x85, 0x2d, 0x61, 0x3b, 0xb7, 0x77, 0xaf,
+		0x34, 0x62, 0x49, 0xa5, 0x21, 0x1d, 0xb2, 0xfa, 0x61, 0x30, 0x84, 0x1e, 0xc3, 0x0c, 0xbd, 0x6c,
+		0xdb, 0xf9, 0x0c, 0x17, 0xba, 0x33, 0x51, 0xc8, 0xb8, 0x6c, 0xdb, 0x84, 0xc3, 0xd1, 0x53, 0x80,
+		0x80, 0x5a, 0x3e, 0x35, 0x59, 0x19, 0xf2, 0x59, 0x4e, 0xbe, 0x56, 0x0a, 0x6b, 0x54, 0x8a, 0x6b,
+		0x54, 0x32, 0xe2, 0x1a, 0x91, 0x05, 0x8e, 0x66, 0x9f, 0x19, 0xb5, 0xde, 0xf4, 0x02, 0x3b, 0xa4,
+		0xce, 0xa4, 0x53, 0x39, 0x9a, 0x53, 0x0d, 0x58, 0x0c, 0xa9, 0x01, 0xb5, 0x68, 0x27, 0xc8, 0xcf,
+		0x6e, 0x09, 0xdb, 0xcb, 0x7b, 0xbb, 0xd3, 0xad, 0x5e, 0x66, 0x4c, 0x9d, 0x13, 0x49, 0xae, 0xde,
+		0xfb, 0x80, 0xee, 0xc2, 0xf2, 0x85, 0x13, 0x50, 0xcf, 0xbf, 0x34, 0x9b, 0xb6, 0x7b, 0x4e, 0x2f,
+		0xf2, 0x73, 0x5b, 0xc2, 0x76, 0x96, 0x2c, 0x45, 0xd1, 0x32, 0x0f, 0xa2, 0x9f, 0xc2, 0x7a, 0xdb,
+		0xf2, 0x6d, 0x97, 0xf6, 0xca, 0x6f, 0x3a, 0xee, 0x99, 0x97, 0xbf, 0xc2, 0x97, 0xb0, 0x3d, 0x32,
+		0x8b, 0x2a, 0x67, 0xf4, 0xed, 0x24, 0x59, 0x6b, 0x0f, 0x07, 0x91, 0x04, 0xcb, 0x3d, 0x59, 0x5e,
+		0x99, 0xf9, 0xd4, 0xca, 0x2c, 0x75, 0x19, 0xbc, 0x3a, 0x0f, 0x61, 0xa6, 0x65, 0xb7, 0xbc, 0xfc,
+		0x02, 0x27, 0x6e, 0x8e, 0xcc, 0xe7, 0xc8, 0x6e, 0x79, 0x84, 0xc3, 0x10, 0x81, 0xd5, 0xc0, 0xb6,
+		0xfc, 0xfa, 0x85, 0x69, 0x51, 0xea, 0x3b, 0xa7, 0x1d, 0x6a, 0x07, 0x79, 0xe0, 0xdc, 0xbb, 0x23,
+		0xb9, 0x3a, 0x47, 0x4b, 0x5d, 0x30, 0x11, 0x83, 0x81, 0x08, 0x2a, 0xc3, 0xaa, 0xd5, 0xa1, 0x9e,
+		0xe9, 0xdb, 0x81, 0x4d, 0xcd, 0xb6, 0xe7, 0xb8, 0x34, 0xc8, 0xe7, 0xb8, 0xe6, 0xd6, 0x48, 0x4d,
+		0xc2, 0x80, 0x55, 0x8e, 0x23, 0x2b, 0x8c, 0x9a, 0x08, 0xa0, 0xeb, 0xb0, 0xc0, 0xc6, 0xc3, 0x64,
+		0xf3, 0x91, 0x5f, 0xdc, 0x12, 0xb6, 0x17, 0xc8, 0x3c, 0x0b, 0x94, 0x9d, 0x80, 0xa2, 0x0d, 0xb8,
+		0xe2, 0x04, 0x66, 0xdd, 0xf7, 0xdc, 0xfc, 0xd2, 0x96, 0xb0, 0x3d, 0x4f, 0xe6, 0x9c, 0x40, 0xf6,
+		0x3d, 0xb7, 0xf0, 0xab, 0x0c, 0xdc, 0x1a, 0xde, 0x7c, 0xcf, 0x3d, 0x73, 0xce, 0xa3, 0x91, 0x46,
+		0xdf, 0x24, 0x85, 0xc3, 0x11, 0xba, 0x39, 0x32, 0x3d, 0x23, 0x7a, 0x5b, 0xe2, 0xbd, 0x16, 0x6c,
+		0xf5, 0x36, 0x2a, 0x9a, 0x01, 0xcf, 0xec, 0x75, 0xb4, 0xd7, 0xa1, 0xd1, 0x30, 0x6d, 0x0e, 0x6d,
+		0x9d, 0x12, 0x25, 0x40, 0x6e, 0x74, 0x25, 0x74, 0x3e, 0x17, 0x9e, 0x1c, 0xf7, 0xb8, 0xd7, 0xa1,
+		0xe8, 0x18, 0xae, 0xf3, 0xf4, 0xc6, 0xa8, 0x67, 0xd3, 0xd4, 0x37, 0x18, 0x7b, 0x84, 0x70, 0xe1,
+		0xef, 0x02, 0xac, 0x8d, 0xe8, 0x48, 0x56, 0xe8, 0x86, 0xd7, 0xb2, 0x1c, 0xd7, 0x74, 0x1a, 0xbc,
+		0x1e, 0x0b, 0x64, 0x3e, 0x0c, 0xa8, 0x0d, 0x74, 0x1b, 0x72, 0xd1, 0x43, 0xd7, 0x6a, 0x85, 0x46,
+		0xb1, 0x40, 0x20, 0x0c, 0x69, 0x56, 0xcb, 0x1e, 0xe3, 0x4c, 0xd9, 0xff, 0xd6, 0x99, 0xee, 0xc0,
+		0xa2, 0xe3, 0x3a, 0xd4, 0xb1, 0xa8, 0xdd, 0x60, 0x79, 0xcd, 0xf0, 0xa1, 0xcc, 0x75, 0x63, 0x6a,
+		0xa3, 0xf0, 0x4b, 0x01, 0xd6, 0xf1, 0x47, 0x6a, 0xfb, 0xae, 0xd5, 0xfc, 0x5e, 0xdc, 0x72, 0x30,
+		0xa7, 0xcc, 0x70, 0x4e, 0xff, 0x98, 0x85, 0xb5, 0xaa, 0xed, 0x36, 0x1c, 0xf7, 0x5c, 0xaa, 0x53,
+		0xe7, 0xbd, 0x43, 0x2f, 0x79, 0x46, 0xb7, 0x21, 0x67, 0x45, 0x9f, 0x7b, 0x55, 0x86, 0x38, 0xa4,
+		0x36, 0xd0, 0x01, 0x2c, 0x75, 0x01, 0xa9, 0x96, 0x1c, 0x4b, 0x73, 0x4b, 0x5e, 0xb4, 0x12, 0x9f,
+		0xd0, 0x0b, 0x98, 0x65, 0xf6, 0x18, 0xba, 0xf2, 0xf2, 0xde, 0x83, 0xd1, 0xbe, 0xd4, 0x9f, 0x21,
+		0x73, 0x42, 0x9b, 0x84, 0x3c, 0xa4, 0xc2, 0xea, 0x85, 0x6d, 0xf9, 0xf4, 0xd4, 0xb6, 0xa8, 0xd9,
+		0xb0, 0xa9, 0xe5, 0x34, 0x83, 0xc8, 0xa7, 0x6f, 0x8c, 0x31, 0xb9, 0xcb, 0xa6, 0x67, 0x35, 0x88,
+		0xd8, 0xa5, 0x29, 0x21, 0x0b, 0xbd, 0x82, 0xb5, 0xa6, 0x15, 0x50, 0xb3, 0xa7, 0xc7, 0xad, 0x6d,
+		0x36, 0xd5, 0xda, 0x56, 0x19, 0xed, 0x30, 0x66, 0xb1, 0x38, 0x3a, 0x00, 0x1e, 0x0c, 0xa7, 0xc2,
+		0x6e, 0x84, 0x4a, 0x73, 0xa9, 0x4a, 0x2b, 0x8c, 0xa4, 0x87, 0x1c, 0xae, 0x93, 0x87, 0x2b, 0x16,
+		0xa5, 0x76, 0xab, 0x4d, 0xb9, 0x73, 0xcf, 0x92, 0xf8, 0x23, 0x7a, 0x00, 0x62, 0xcb, 0xfa, 0xe8,
+		0xb4, 0x3a, 0x2d, 0x33, 0x0a, 0x05, 0xdc, 0x85, 0x67, 0xc9, 0x4a, 0x14, 0x97, 0xa2, 0x30, 0xb3,
+		0xeb, 0xa0, 0x7e, 0x61, 0x37, 0x3a, 0xcd, 0x38, 0x93, 0x85, 0x74, 0xbb, 0xee, 0x32, 0x78, 0x1e,
+		0x32, 0xac, 0xd8, 0x1f, 0xdb, 0x4e, 0x38, 0xb3, 0xa1, 0x06, 0xa4, 0x6a, 0x2c, 0xf7, 0x28, 0x5c,
+		0xe4, 0x05, 0x2c, 0xf2, 0xa2, 0x9c, 0x59, 0x4e, 0xb3, 0xe3, 0xdb, 0xf9, 0xdc, 0x84, 0x6d, 0x3a,
+		0x08, 0x31, 0x24, 0xc7, 0x18, 0xd1, 0x07, 0xf4, 0x25, 0x5c, 0xe5, 0x02, 0xac, 0xd7, 0x6d, 0xdf,
+		0x74, 0x1a, 0xb6, 0x4b, 0x1d, 0x7a, 0x19, 0xd9, 0x2d, 0x62, 0xcf, 0x8e, 0xf9, 0x23, 0x35, 0x7a,
+		0x52, 0xf8, 0x4d, 0x06, 0x36, 0xa3, 0xf6, 0x91, 0x2f, 0x9c, 0x66, 0xe3, 0x7b, 0x19, 0xbc, 0x2f,
+		0x12, 0xb2, 0x6c, 0x38, 0x92, 0x5e, 0x24, 0x7e, 0x48, 0xdc, 0x4f, 0xb8, 0x23, 0x0d, 0x8e, 0x69,
+		0x76, 0x68, 0x4c, 0xd1, 0x1b, 0x88, 0x8e, 0xe1, 0xc8, 0x5c, 0xdb, 0x5e, 0xd3, 0xa9, 0x5f, 0xf2,
+		0x36, 0x5f, 0x1e, 0x93, 0x68, 0xe8, 0x9c, 0xdc, 0x50, 0xab, 0x1c, 0x4d, 0x56, 0xdb, 0x83, 0xa1,
+		0xc2, 0x5f, 0x33, 0xdd, 0xf1, 0x57, 0xec, 0xba, 0x13, 0xc4, 0x75, 0xe9, 0x4e, 0xa5, 0x90, 0x3e,
+		0x95, 0x31, 0xb1, 0x6f, 0x2a, 0x87, 0x3b, 0x2e, 0xf3, 0xa9, 0x1d, 0xf7, 0x1c, 0x16, 0xfb, 0x86,
+		0x27, 0xfd, 0xda, 0x96, 0x0b, 0x46, 0x0f, 0xce, 0x4c, 0xff, 0xe0, 0x10, 0xd8, 0xf0, 0x7c, 0xe7,
+		0xdc, 0x71, 0xad, 0xa6, 0x39, 0x90, 0x64, 0xfa, 0xa8, 0xaf, 0xc7, 0x54, 0x3d, 0x99, 0x6c, 0xe1,
+		0x8f, 0x19, 0xd8, 0x8c, 0xed, 0xa9, 0xec, 0xd5, 0xad, 0xa6, 0xe2, 0x04, 0x6d, 0x8b, 0xd6, 0x2f,
+		0xa6, 0x73, 0xd3, 0xff, 0x7d, 0xb9, 0x7e, 0x06, 0xb7, 0xfa, 0x33, 0x30, 0xbd, 0x33, 0x93, 0x5e,
+		0x38, 0x81, 0x99, 0xac, 0xe2, 0x64, 0xc1, 0x6b, 0x7d, 0x19, 0x55, 0xce, 0x8c, 0x0b, 0x27, 0x88,
+		0x3c, 0x08, 0xdd, 0x04, 0xe0, 0xb7, 0x04, 0xea, 0xbd, 0xb3, 0x5d, 0x5e, 0xe7, 0x45, 0xc2, 0xaf,
+		0x35, 0x06, 0x0b, 0x14, 0x5e, 0x41, 0x2e, 0x79, 0x97, 0x7a, 0x06, 0x73, 0xd1, 0x75, 0x4c, 0xd8,
+		0xca, 0x6e, 0xe7, 0xf6, 0x3e, 0x4f, 0xb9, 0x8e, 0xf1, 0x9b, 0x6a, 0x44, 0x29, 0xfc, 0x3e, 0x03,
+		0xcb, 0xfd, 0x8f, 0xd0, 0x7d, 0x58, 0x39, 0x75, 0x5c, 0xcb, 0xbf, 0x34, 0xeb, 0x17, 0x76, 0xfd,
+		0x5d, 0xd0, 0x69, 0x45, 0x9b, 0xb0, 0x1c, 0x86, 0xe5, 0x28, 0x8a, 0xd6, 0x61, 0xce, 0xef, 0xb8,
+		0xf1, 0x61, 0xb9, 0x40, 0x66, 0xfd, 0x0e, 0xbb, 0x55, 0x3c, 0x87, 0xeb, 0x67, 0x8e, 0x1f, 0xb0,
+		0x03, 0x26, 0x6c, 0x76, 0xb3, 0xee, 0xb5, 0xda, 0x4d, 0xbb, 0x6f, 0x62, 0xf3, 0x1c, 0x12, 0x8f,
+		0x83, 0x1c, 0x03, 0x38, 0x7d, 0xb1, 0xee, 0xdb, 0x56, 0x77, 0x6f, 0xd2, 0x4b, 0x99, 0x8b, 0xf0,
+		0x91, 0x6d, 0x2e, 0x71, 0x23, 0x75, 0xdc, 0xf3, 0x69, 0xdb, 0x74, 0x31, 0x26, 0x70, 0x81, 0x5b,
+		0x00, 0xfc, 0x8e, 0x4b, 0xad, 0xd3, 0x66, 0x78, 0x0a, 0xcd, 0x93, 0x44, 0xa4, 0xf8, 0x07, 0x01,
+		0xae, 0x8e, 0x3a, 0x63, 0x51, 0x01, 0x6e, 0x55, 0xb1, 0xa6, 0xa8, 0xda, 0x4b, 0x53, 0x92, 0x0d,
+		0xf5, 0x8d, 0x6a, 0x9c, 0x98, 0xba, 0x21, 0x19, 0xd8, 0x54, 0xb5, 0x37, 0x52, 0x59, 0x55, 0xc4,
+		0xff, 0x43, 0xff, 0x0f, 0x5b, 0x63, 0x30, 0xba, 0x7c, 0x88, 0x95, 0x5a, 0x19, 0x2b, 0xa2, 0x30,
+		0x41, 0x49, 0x37, 0x24, 0x62, 0x60, 0x45, 0xcc, 0xa0, 0x1f, 0xc0, 0xfd, 0x31, 0x18, 0x59, 0xd2,
+		0x64, 0x5c, 0x36, 0x09, 0xfe, 0x49, 0x0d, 0xeb, 0x0c, 0x9c, 0x2d, 0xfe, 0xbc, 0x97, 0x73, 0x9f,
+		0x03, 0x25, 0xdf, 0xa4, 0x60, 0x59, 0xd5, 0xd5, 0x8a, 0x36, 0x29, 0xe7, 0x01, 0xcc, 0x98, 0x9c,
+		0x07, 0x51, 0x71, 0xce, 0xc5, 0x5f, 0x64, 0x7a, 0x5f, 0x81, 0xd5, 0x06, 0xb1, 0x3b, 0xb1, 0xb7,
+		0xb2, 0x77, 0x1c, 0x57, 0xc8, 0xeb, 0x83, 0x72, 0xe5, 0xd8, 0x54, 0x15, 0x93, 0xe0, 0x9a, 0x8e,
+		0xcd, 0x6a, 0xa5, 0xac, 0xca, 0x27, 0x89, 0x4c, 0x7e, 0x08, 0x5f, 0x8d, 0x45, 0x49, 0x65, 0x16,
+		0x55, 0x6a, 0xd5, 0xb2, 0x2a, 0xb3, 0xb7, 0x1e, 0x48, 0x6a, 0x19, 0x2b, 0x66, 0x45, 0x2b, 0x9f,
+		0x88, 0x02, 0xfa, 0x02, 0xb6, 0xa7, 0x65, 0x8a, 0x19, 0xf4, 0x10, 0x1e, 0x8c, 0x45, 0x13, 0xfc,
+		0x0a, 0xcb, 0x46, 0x02, 0x9e, 0x45, 0xbb, 0xf0, 0x70, 0x2c, 0xdc, 0xc0, 0xe4, 0x48, 0xd5, 0x78,
+		0x41, 0x0f, 0x4c, 0x52, 0xd3, 0x34, 0x55, 0x7b, 0x29, 0xce, 0x14, 0xff, 0x26, 0x40, 0xbe, 0x57,
+		0x09, 0xf6, 0x15, 0xa6, 0xe9, 0xd4, 0x69, 0x54, 0x8c, 0xfb, 0xf0, 0x79, 0x52, 0x4f, 0xae, 0x68,
+		0x07, 0x65, 0x55, 0x36, 0x86, 0xeb, 0x31, 0x50, 0xb5, 0x41, 0x20, 0x2b, 0xc1, 0xf0, 0xda, 0x07,
+		0x51, 0x2c, 0x59, 0xfc, 0x56, 0xd5, 0x0d, 0x96, 0x59, 0x06, 0xed, 0x41, 0x69, 0x12, 0xba, 0xb7,
+		0x9e, 0x2e, 0x27, 0x5b, 0xfc, 0xad, 0x00, 0xab, 0x43, 0x47, 0x28, 0xba, 0x0d, 0xd7, 0xab, 0x12,
+		0xc1, 0x9a, 0x61, 0xca, 0xe5, 0xca, 0xa8, 0xed, 0x1c, 0x03, 0x90, 0xf6, 0x25, 0x4d, 0xa9, 0x68,
+		0xa2, 0x80, 0xee, 0x41, 0x61, 0x14, 0x20, 0xea, 0xec, 0xa8, 0xd1, 0xc5, 0x0c, 0xba, 0x03, 0x37,
+		0x47, 0xe1, 0xba, 0xb9, 0x8a, 0xd9, 0xe2, 0xbf, 0x32, 0x70, 0x63, 0xd2, 0xef, 0x06, 0x6c, 0x9e,
+		0xba, 0xeb, 0xc6, 0x6f, 0xb1, 0x5c, 0x33, 0x58, 0x07, 0x87, 0x7a, 0xac, 0x8f, 0x6b, 0x7a, 0x22,
+		0xf3, 0x64, 0x83, 0x8c, 0x01, 0xcb, 0x95, 0xa3, 0x6a, 0x19, 0x1b, 0x7c, 0x36, 0x8a, 0x70, 0x2f,
+		0x0d, 0x1e, 0xb6, 0xab, 0x98, 0xe9, 0xdb, 0xad, 0x71, 0xd2, 0x7c, 0xdd, 0x6c, 0xb0, 0x51, 0x09,
+		0x8a, 0x69, 0xe8, 0x6e, 0x15, 0x14, 0x71, 0x06, 0x7d, 0x05, 0x5f, 0xa6, 0x27, 0xae, 0x19, 0xaa,
+		0x56, 0xc3, 0x8a, 0x29, 0xe9, 0xa6, 0x86, 0x8f, 0xc5, 0xd9, 0x69, 0x96, 0x6b, 0xa8, 0x47, 0x6c,
+		0xda, 0x6a, 0x86, 0x38, 0x57, 0xfc, 0x93, 0x00, 0x9f, 0xc9, 0x9e, 0x4b, 0x1d, 0xb7, 0x63, 0x4b,
+		0x81, 0x66, 0x7f, 0x50, 0xc3, 0xdb, 0x99, 0xe7, 0xa3, 0xbb, 0x70, 0x27, 0xd6, 0x8f, 0xe4, 0x4d,
+		0x55, 0x53, 0x0d, 0x55, 0x32, 0x2a, 0x24, 0x51, 0xdf, 0x89, 0x30, 0x66, 0x2f, 0x0a, 0x26, 0x61,
+		0x5d, 0xc7, 0xc3, 0x08, 0x36, 0xc8, 0x49, 0xd4, 0x0a, 0xa1, 0x5f, 0x8e, 0xc7, 0xca, 0xa4, 0xa2,
+		0x75, 0xdd, 0x4c, 0xcc, 0x16, 0x7f, 0x27, 0x40, 0x2e, 0xfa, 0x66, 0xcd, 0xbf, 0x78, 0xe5, 0xe1,
+		0x2a, 0x5b, 0x60, 0xa5, 0x66, 0x98, 0xc6, 0x49, 0x15, 0xf7, 0xf7, 0x70, 0xdf, 0x13, 0x6e, 0x76,
+		0xa6, 0x51, 0x09, 0xab, 0x13, 0xfa, 0x62, 0x3f, 0x20, 0x7a, 0x0b, 0xc3, 0x70, 0xb0, 0x98, 0x99,
+		0x88, 0x09, 0x75, 0xb2, 0xe8, 0x1a, 0x7c, 0xd6, 0x87, 0x39, 0xc4, 0x12, 0x31, 0xf6, 0xb1, 0x64,
+		0x88, 0x33, 0xc5, 0x5f, 0x0b, 0xb0, 0x19, 0xfb, 0x3a, 0xfb, 0x5d, 0x83, 0xa5, 0xde, 0xa8, 0x74,
+		0xa8, 0x6c, 0x75, 0x02, 0x1b, 0x3d, 0x80, 0xbb, 0x5d, 0x47, 0x36, 0x24, 0xfd, 0x75, 0x6f, 0xaf,
+		0x4c, 0x59, 0xaa, 0xe9, 0xc9, 0xd5, 0xa4, 0x42, 0xa3, 0x14, 0x44, 0x81, 0x99, 0xd4, 0x64, 0x28,
+		0xc1, 0x3a, 0x36, 0xc4, 0x4c, 0xf1, 0x9f, 0x8b, 0xb0, 0x91, 0x4c, 0x8e, 0x7d, 0x3d, 0xb1, 0x1b,
+		0x61, 0x6a, 0xf7, 0xa0, 0xd0, 0x2f, 0x12, 0xb9, 0xf6, 0x60, 0x5e, 0xbb, 0xf0, 0x70, 0x02, 0xae,
+		0xa6, 0x1d, 0x4a, 0x9a, 0xc2, 0x3e, 0xc7, 0x20, 0x51, 0x40, 0x2f, 0xe0, 0xd9, 0x04, 0xca, 0xbe,
+		0xa4, 0xf4, 0xaa, 0xdc, 0x3d, 0x3f, 0x25, 0xc3, 0x20, 0xea, 0x7e, 0xcd, 0xc0, 0xba, 0x98, 0x41,
+		0x18, 0xa4, 0x14, 0x81, 0x7e, 0x1f, 0x1a, 0x29, 0x93, 0x45, 0x4f, 0xe1, 0x71, 0x5a, 0x1e, 0x61,
+		0xcb, 0xa8, 0x47, 0x98, 0x24, 0xa9, 0x33, 0xe8, 0x1b, 0xf8, 0x3a, 0x85, 0x1a, 0xbd, 0x79, 0x88,
+		0x3b, 0x8b, 0x9e, 0xc1, 0x93, 0xd4, 0xec, 0xe5, 0x0a, 0x51, 0xcc, 0x23, 0x89, 0xbc, 0xee, 0x27,
+		0xcf, 0x21, 0x15, 0x70, 0xda, 0x8b, 0x23, 0x77, 0x33, 0x47, 0xf8, 0x42, 0x42, 0xea, 0xca, 0x14,
+		0x55, 0x64, 0x81, 0x14, 0x99, 0x79, 0xf4, 0x12, 0xe4, 0xe9, 0x4a, 0x31, 0x59, 0x68, 0x01, 0xbd,
+		0x05, 0xe3, 0xd3, 0x76, 0x15, 0xbf, 0x35, 0x30, 0xd1, 0xa4, 0x34, 0x65, 0x40, 0xcf, 0xe1, 0x69,
+		0x6a, 0xd1, 0xfa, 0xfd, 0x27, 0x41, 0xcf, 0xa1, 0x27, 0xf0, 0x68, 0x02, 0x3d, 0xd9, 0x23, 0xbd,
+		0x3b, 0x8e, 0xaa, 0x88, 0x8b, 0xe8, 0x31, 0xec, 0x4e, 0x20, 0xf2, 0x29, 0x34, 0x75, 0x43, 0x95,
+		0x5f, 0x9f, 0x84, 0x8f, 0xcb, 0xaa, 0x6e, 0x88, 0x4b, 0xe8, 0xc7, 0xf0, 0xa3, 0x09, 0xb4, 0xee,
+		0x62, 0xd9, 0x1f, 0x98, 0x24, 0x46, 0x8c, 0xc1, 0x6a, 0x04, 0x8b, 0xcb, 0x53, 0xec, 0x89, 0xae,
+		0xbe, 0x4c, 0xaf, 0xdc, 0x0a, 0x92, 0xe1, 0xc5, 0x54, 0x23, 0x22, 0x1f, 0xaa, 0x65, 0x65, 0xb4,
+		0x88, 0x88, 0x1e, 0xc1, 0xce, 0x04, 0x91, 0x83, 0x0a, 0x91, 0x71, 0x74, 0x62, 0x75, 0x4d, 0x62,
+		0x15, 0x7d, 0x0d, 0x7b, 0x93, 0x48, 0x92, 0x5a, 0xae, 0xbc, 0xc1, 0x64, 0x90, 0x87, 0xd8, 0x31,
+		0x3a, 0xdd, 0xd2, 0x55, 0xad, 0x5a, 0x33, 0x4c, 0x5d, 0xfd, 0x0e, 0x8b, 0x6b, 0xec, 0x18, 0x4d,
+		0xdd, 0xa9, 0xb8, 0x56, 0xe2, 0xd5, 0x61, 0x33, 0x1e, 0x7a, 0xc9, 0xbe, 0xaa, 0x49, 0xe4, 0x44,
+		0x5c, 0x4f, 0xe9, 0xbd, 0x61, 0xa3, 0xeb, 0x6b, 0xa1, 0xcf, 0xa6, 0x59, 0x0e, 0x96, 0x88, 0x7c,
+		0x98, 0xac, 0xf8, 0x06, 0x3a, 0x80, 0xfd, 0x4f, 0x76, 0x89, 0x5a, 0x55, 0x61, 0x6f, 0x4d, 0xe8,
+		0xe4, 0xd1, 0x3e, 0x7c, 0x9b, 0xa2, 0x53, 0xab, 0xea, 0x98, 0xf4, 0x6a, 0x63, 0x1e, 0xe1, 0xa3,
+		0x4a, 0x52, 0x63, 0x93, 0x9d, 0x80, 0x77, 0xf8, 0x4f, 0x56, 0x43, 0x77, 0xbc, 0xe4, 0x71, 0xb3,
+		0x0b, 0x0f, 0xc3, 0x1e, 0x1a, 0xd1, 0x91, 0x63, 0x4e, 0x9e, 0x7d, 0xf8, 0x76, 0x3a, 0x4a, 0xf7,
+		0xb9, 0x54, 0x26, 0x58, 0x52, 0x4e, 0xba, 0x97, 0x7d, 0xa1, 0xf8, 0x17, 0x01, 0x8a, 0xb2, 0xe5,
+		0xd6, 0xed, 0x66, 0xfc, 0x8b, 0xf6, 0xc4, 0x2c, 0x9f, 0xc1, 0x93, 0x29, 0xbc, 0x67, 0x4c, 0xbe,
+		0xc7, 0xa0, 0x7f, 0x2a, 0xb9, 0xa6, 0xbd, 0xd6, 0x2a, 0xc7, 0xda, 0x24, 0x42, 0xb4, 0x08, 0xdd,
+		0x39, 0x77, 0xad, 0xa9, 0x17, 0x11, 0x8d, 0xc0, 0x7f, 0xb6, 0x88, 0x4f, 0x25, 0x4f, 0xb5, 0x88,
+		0xfd, 0x37, 0xb0, 0x51, 0xf7, 0x5a, 0xa3, 0x7e, 0x1f, 0xd9, 0x9f, 0x97, 0xda, 0x4e, 0x95, 0xfd,
+		0x36, 0x50, 0x15, 0xbe, 0xdb, 0x39, 0x77, 0xe8, 0x45, 0xe7, 0xb4, 0x54, 0xf7, 0x5a, 0x3b, 0x7d,
+		0xff, 0xd9, 0x2d, 0x9d, 0xdb, 0x6e, 0xf8, 0x7f, 0xe2, 0xe8, 0x9f, 0xbc, 0xcf, 0xac, 0xb6, 0xf3,
+		0x7e, 0xf7, 0x74, 0x8e, 0xc7, 0x1e, 0xfd, 0x7b, 0x00, 0xa1, 0x49, 0xb6, 0x43, 0xa4, 0x1e, 0x00,
+		0x00,
 	},
 	// uber/cadence/shared/v1/cluster.proto
 	[]byte{
@@ -1710,7 +2486,7 @@ var yarpcFileDescriptorClosurec6fc96d64a8b67fd = [][]byte{
 		0xff, 0xef, 0x8a, 0x6e, 0xb9, 0xad, 0xce, 0xd2, 0x99, 0xed, 0x17, 0x78, 0x1d, 0x9d, 0xae, 0x3f,
 		0xba, 0xe5, 0x9e, 0xbc, 0xfe, 0xfe, 0xb2, 0x10, 0xa6, 0x6c, 0xb2, 0x98, 0xab, 0x2a, 0xb9, 0x70,
 		0x7c, 0x71, 0x81, 0x32, 0xb1, 0xf7, 0xf6, 0xf7, 0x0e, 0xdf, 0xb8, 0x68, 0x3d, 0xcb, 0x6e, 0xda,
-		0xca, 0x8b, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x90, 0xef, 0x39, 0x7d, 0xb1, 0x03, 0x00, 0x00,
+		0xca, 0x8b, 0x3f, 0x03, 0x00, 0x90, 0xef, 0x39, 0x7d, 0xb1, 0x03, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/history.proto
 	[]byte{
@@ -1735,470 +2511,525 @@ var yarpcFileDescriptorClosurec6fc96d64a8b67fd = [][]byte{
 		0xbd, 0xc8, 0xfe, 0xa5, 0x88, 0x71, 0x0a, 0xef, 0xa6, 0x4a, 0x2b, 0x1c, 0x58, 0xd3, 0x0f, 0x7a,
 		0xe9, 0xc0, 0x53, 0xf4, 0x2f, 0x5b, 0xf1, 0x6c, 0xbb, 0xd3, 0xdd, 0xda, 0x85, 0x9b, 0xe0, 0xed,
 		0xe5, 0xf3, 0xc5, 0x9c, 0xe9, 0x2c, 0x4f, 0x48, 0x2a, 0x16, 0x5e, 0x6d, 0x7b, 0xc8, 0x1c, 0xb8,
-		0x67, 0x76, 0x66, 0xb3, 0x86, 0x57, 0xd5, 0x53, 0xe1, 0x27, 0x7f, 0xcc, 0xcd, 0xf9, 0x77, 0x00,
-		0x00, 0x00, 0xff, 0xff, 0x84, 0xb4, 0x3e, 0x6c, 0xb0, 0x02, 0x00, 0x00,
+		0x67, 0x76, 0x66, 0xb3, 0x86, 0x57, 0xd5, 0x53, 0xe1, 0x27, 0x7f, 0xcc, 0xcd, 0xf9, 0xf7, 0x00,
+		0x84, 0xb4, 0x3e, 0x6c, 0xb0, 0x02, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/history.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1c, 0x57,
-		0xd5, 0xef, 0xec, 0xda, 0xbb, 0xde, 0xb3, 0x8e, 0x63, 0xdf, 0x24, 0x8e, 0x9d, 0xbf, 0xce, 0x24,
-		0x4d, 0x5c, 0xc7, 0x59, 0x27, 0x4e, 0x9a, 0x7c, 0x69, 0xbe, 0xb6, 0x38, 0x8e, 0xad, 0xae, 0x64,
-		0x92, 0x68, 0xe2, 0xa4, 0x80, 0x90, 0x96, 0xf1, 0xcc, 0x75, 0x3c, 0xf2, 0xee, 0xcc, 0x76, 0x66,
-		0xd6, 0x1b, 0x23, 0xf1, 0xc4, 0x03, 0x12, 0x6a, 0x05, 0x55, 0x85, 0x44, 0x05, 0x08, 0x84, 0x04,
-		0x6a, 0x11, 0x52, 0x11, 0x08, 0x01, 0xe2, 0x05, 0x90, 0x10, 0x48, 0xa0, 0xc2, 0x13, 0x2f, 0x3c,
-		0xc0, 0x03, 0x0f, 0xf4, 0x8d, 0x07, 0xca, 0x1b, 0x12, 0x9a, 0x3b, 0x77, 0x76, 0x77, 0x66, 0xee,
-		0x9d, 0xb9, 0xb3, 0xde, 0xb4, 0xa0, 0xe6, 0xcd, 0x73, 0xe7, 0xdc, 0x33, 0xbf, 0x7b, 0xee, 0x39,
-		0xe7, 0x9e, 0x7b, 0xce, 0x59, 0xc3, 0xa9, 0xd6, 0x06, 0xb6, 0x17, 0x34, 0x55, 0xc7, 0xa6, 0x86,
-		0x17, 0xd4, 0xa6, 0xb1, 0xb0, 0x73, 0x69, 0x61, 0xcb, 0x70, 0x5c, 0xcb, 0xde, 0xad, 0x34, 0x6d,
-		0xcb, 0xb5, 0xd0, 0x01, 0x8f, 0xa4, 0x42, 0x49, 0x2a, 0x6a, 0xd3, 0xa8, 0xec, 0x5c, 0x3a, 0x72,
-		0xe2, 0xa1, 0x65, 0x3d, 0xac, 0xe3, 0x05, 0x42, 0xb2, 0xd1, 0xda, 0x5c, 0xd0, 0x5b, 0xb6, 0xea,
-		0x1a, 0x96, 0xe9, 0x4f, 0x3a, 0x72, 0x32, 0xfa, 0xde, 0x35, 0x1a, 0xd8, 0x71, 0xd5, 0x46, 0x93,
-		0x12, 0xcc, 0xb0, 0x3e, 0xac, 0x59, 0x8d, 0x46, 0x87, 0x85, 0xcc, 0xa2, 0x70, 0x55, 0x67, 0xbb,
-		0x6e, 0x38, 0x6e, 0x12, 0x4d, 0xdb, 0xb2, 0xb7, 0x37, 0xeb, 0x56, 0xdb, 0xa7, 0x91, 0x6f, 0x41,
-		0xf1, 0x25, 0x7f, 0x41, 0xe8, 0x3a, 0x14, 0xf0, 0x0e, 0x36, 0x5d, 0x67, 0x4a, 0x9a, 0xc9, 0xcf,
-		0x96, 0x17, 0x4f, 0x55, 0x18, 0x6b, 0xab, 0x50, 0xea, 0x15, 0x8f, 0x52, 0xa1, 0x13, 0xe4, 0xf7,
-		0xae, 0xc1, 0x68, 0xef, 0x0b, 0x34, 0x0d, 0x23, 0xe4, 0x55, 0xcd, 0xd0, 0xa7, 0xa4, 0x19, 0x69,
-		0x36, 0xaf, 0x14, 0xc9, 0x73, 0x55, 0x47, 0xd7, 0x01, 0xfc, 0x57, 0xde, 0xa2, 0xa7, 0x72, 0x33,
-		0xd2, 0x6c, 0x79, 0xf1, 0x48, 0xc5, 0x97, 0x48, 0x25, 0x90, 0x48, 0x65, 0x3d, 0x90, 0x88, 0x52,
-		0x22, 0xd4, 0xde, 0x33, 0x9a, 0x82, 0xe2, 0x0e, 0xb6, 0x1d, 0xc3, 0x32, 0xa7, 0xf2, 0x3e, 0x53,
-		0xfa, 0x88, 0x0e, 0x43, 0xd1, 0x5b, 0xbc, 0xf7, 0xb9, 0x21, 0xf2, 0xa6, 0xe0, 0x3d, 0x56, 0x75,
-		0xf4, 0x75, 0x09, 0xce, 0x07, 0x4b, 0xae, 0xe1, 0x47, 0x58, 0x6b, 0x79, 0xfb, 0x50, 0x73, 0x5c,
-		0xd5, 0x76, 0xb1, 0x5e, 0xf3, 0x91, 0xa8, 0xae, 0x6b, 0x1b, 0x1b, 0x2d, 0x17, 0x3b, 0x53, 0xc3,
-		0x04, 0xcf, 0xff, 0x33, 0x97, 0xfe, 0x32, 0xe5, 0xb3, 0x12, 0xb0, 0xb9, 0xe7, 0x73, 0x21, 0x4b,
-		0x5e, 0xea, 0xf0, 0x78, 0xe9, 0x29, 0xe5, 0x5c, 0x5b, 0x8c, 0x14, 0x7d, 0x5b, 0x82, 0x0b, 0x0c,
-		0x78, 0x9a, 0xd5, 0x68, 0xd6, 0x31, 0x13, 0x60, 0x81, 0x00, 0x7c, 0x41, 0x0c, 0xe0, 0x72, 0xc0,
-		0x27, 0x0e, 0xf1, 0x99, 0xb6, 0x28, 0x31, 0x7a, 0x53, 0x82, 0x39, 0x06, 0xc8, 0x4d, 0xd5, 0xa8,
-		0xb3, 0x10, 0x16, 0x09, 0xc2, 0x1b, 0x62, 0x08, 0x57, 0x09, 0x93, 0x38, 0xbc, 0xb3, 0x6d, 0x21,
-		0x4a, 0xf4, 0x2d, 0xb6, 0x00, 0x3d, 0xdd, 0xd2, 0x6b, 0x56, 0xcb, 0x8d, 0xc3, 0x1b, 0x21, 0xf0,
-		0x9e, 0x17, 0x83, 0xe7, 0xa9, 0x9d, 0x7e, 0xa7, 0xe5, 0xc6, 0x01, 0xce, 0xb6, 0x05, 0x69, 0xd1,
-		0x1b, 0x12, 0xcc, 0xea, 0x58, 0x33, 0x1c, 0x02, 0xcc, 0xd3, 0x52, 0x47, 0xdb, 0xc2, 0x7a, 0x8b,
-		0x29, 0xbc, 0x12, 0x41, 0x77, 0x9d, 0x89, 0xee, 0x16, 0x65, 0xb2, 0xae, 0x3a, 0xdb, 0xf7, 0x02,
-		0x16, 0x71, 0x64, 0x67, 0x74, 0x01, 0x3a, 0xf4, 0x9a, 0x04, 0x67, 0x23, 0xa8, 0x78, 0x36, 0x01,
-		0x04, 0xd3, 0xb5, 0x74, 0x4c, 0x3c, 0x73, 0x90, 0xf5, 0x54, 0x2a, 0x86, 0x94, 0x12, 0x8c, 0xa0,
-		0x2c, 0x28, 0xa5, 0x04, 0xfd, 0x0f, 0x49, 0x89, 0xab, 0xfa, 0xaf, 0xc7, 0x50, 0x25, 0x68, 0xd6,
-		0x28, 0x41, 0xf5, 0x7f, 0xa9, 0xa8, 0xf8, 0x4a, 0x75, 0x5a, 0x4f, 0x27, 0x43, 0x5f, 0x94, 0xe0,
-		0xe9, 0x30, 0x26, 0x9e, 0x25, 0xee, 0x23, 0x80, 0xae, 0xa6, 0x02, 0xe2, 0x19, 0xe1, 0x29, 0x3d,
-		0x8d, 0x88, 0x6c, 0x9b, 0xaa, 0xb9, 0xc6, 0x8e, 0xe1, 0xee, 0xa6, 0x2a, 0xf7, 0x58, 0xc2, 0xb6,
-		0x2d, 0x51, 0x26, 0x69, 0xca, 0xad, 0x0a, 0xd0, 0x11, 0xe5, 0x8e, 0xa0, 0xe2, 0x29, 0xf7, 0xfe,
-		0x04, 0xe5, 0x0e, 0x61, 0xe2, 0x2a, 0xb7, 0x9a, 0x4a, 0xc5, 0x90, 0x52, 0x82, 0x72, 0x8f, 0x0b,
-		0x4a, 0x29, 0x49, 0xb9, 0x55, 0x01, 0x3a, 0xa2, 0x48, 0x61, 0x54, 0x3c, 0x45, 0x9a, 0x48, 0x50,
-		0xa4, 0x5e, 0x48, 0x5c, 0x45, 0x52, 0xd3, 0x88, 0x88, 0xa5, 0x85, 0xc1, 0x24, 0x58, 0x1a, 0x4a,
-		0xb0, 0xb4, 0x5e, 0x3c, 0x09, 0x96, 0xa6, 0xa6, 0x93, 0xa1, 0x36, 0x9c, 0xf0, 0x40, 0xd8, 0x7c,
-		0xed, 0x39, 0x40, 0x80, 0x5c, 0x64, 0x02, 0xf1, 0xb8, 0xda, 0x5c, 0xb5, 0x39, 0xea, 0xf2, 0x5f,
-		0xa3, 0x57, 0xe0, 0x98, 0xff, 0xe1, 0x4d, 0xc3, 0x66, 0x7d, 0xf6, 0x20, 0xf9, 0x6c, 0x85, 0xff,
-		0xd9, 0x55, 0x6f, 0x5e, 0xfc, 0xa3, 0xd3, 0x2e, 0xef, 0x25, 0xfa, 0xae, 0x04, 0x0b, 0x11, 0x15,
-		0x55, 0x4d, 0x0d, 0xd7, 0x6b, 0x36, 0x7e, 0xa5, 0x85, 0x1d, 0xe6, 0xea, 0x0f, 0x11, 0x18, 0x2f,
-		0xa6, 0x6b, 0x2a, 0xe1, 0xa4, 0x04, 0x8c, 0xe2, 0xb8, 0xe6, 0x54, 0x61, 0x6a, 0xf4, 0x23, 0x09,
-		0xae, 0x50, 0x4c, 0x01, 0x44, 0x31, 0x25, 0x9e, 0x24, 0x68, 0x97, 0x99, 0x68, 0xe9, 0xd7, 0xfc,
-		0x4f, 0x8b, 0x68, 0x74, 0xc5, 0xce, 0x34, 0x03, 0x7d, 0x59, 0x82, 0x73, 0x2c, 0xf1, 0xb2, 0x80,
-		0x1e, 0x16, 0xd4, 0xee, 0x65, 0xca, 0x21, 0x45, 0xbb, 0x39, 0x64, 0xe8, 0xb3, 0x70, 0xd2, 0x57,
-		0x32, 0x3e, 0x92, 0x29, 0x82, 0xe4, 0x12, 0x5f, 0xcf, 0xf8, 0x10, 0x7c, 0x05, 0xe6, 0x7d, 0xfb,
-		0x0b, 0x12, 0x9c, 0xa1, 0x9b, 0x47, 0x15, 0x9d, 0xb3, 0x69, 0xd3, 0x04, 0xc1, 0xb3, 0x4c, 0x04,
-		0x3e, 0x73, 0x5f, 0xdf, 0x39, 0xdb, 0x34, 0xa3, 0xa5, 0xd0, 0xa0, 0xcf, 0xc1, 0x4c, 0x43, 0xb5,
-		0xb7, 0xb1, 0x5d, 0xb3, 0xb1, 0x66, 0xd9, 0x3a, 0x0b, 0xc4, 0x11, 0x02, 0x62, 0x91, 0x09, 0xe2,
-		0xe3, 0x64, 0xb2, 0x42, 0xe7, 0xc6, 0x11, 0x1c, 0x6f, 0x24, 0x11, 0xa0, 0x6f, 0x4a, 0x30, 0xcf,
-		0xba, 0x9f, 0x18, 0x0f, 0x4d, 0x95, 0x29, 0x90, 0xa3, 0x59, 0xc2, 0xd7, 0x7b, 0x94, 0x8d, 0x48,
-		0xf8, 0xca, 0xa1, 0x45, 0xdf, 0x91, 0xa0, 0xc2, 0x8a, 0xb0, 0xb1, 0xdd, 0x30, 0x4c, 0x95, 0xe9,
-		0x17, 0x8e, 0x25, 0xf8, 0x85, 0x78, 0x88, 0xdd, 0x61, 0xc4, 0xf0, 0x0b, 0x6d, 0x61, 0x6a, 0xf4,
-		0x63, 0x09, 0xae, 0xb0, 0xae, 0x52, 0xa9, 0x5e, 0xec, 0x38, 0x41, 0x7b, 0x4b, 0xf0, 0x46, 0x95,
-		0xe6, 0xca, 0x16, 0xda, 0xd9, 0xa6, 0xf0, 0x34, 0x80, 0x6f, 0x94, 0x27, 0xb2, 0x68, 0x00, 0xdf,
-		0x40, 0x67, 0xdb, 0x82, 0xb4, 0xe8, 0x6f, 0x12, 0xac, 0x44, 0x3c, 0x2e, 0x7e, 0xe4, 0x62, 0xdb,
-		0x54, 0xeb, 0x35, 0x06, 0x72, 0xc3, 0x34, 0x5c, 0x83, 0xad, 0x18, 0x27, 0x09, 0xf4, 0x7b, 0xe9,
-		0x2e, 0x78, 0x85, 0xf2, 0x8f, 0xad, 0xa7, 0x1a, 0x30, 0x8f, 0x2f, 0xe8, 0x05, 0x7b, 0x4f, 0x1c,
-		0xd0, 0x9f, 0x25, 0xb8, 0x99, 0x61, 0x99, 0x3c, 0x8f, 0x35, 0x43, 0xd6, 0x78, 0x77, 0x0f, 0x6b,
-		0xe4, 0x39, 0xb3, 0x1b, 0x76, 0xff, 0xd3, 0xd1, 0xbb, 0x12, 0x3c, 0x9f, 0xb4, 0x9c, 0x74, 0x3b,
-		0x39, 0x45, 0x16, 0xb6, 0xc6, 0x5c, 0x18, 0x17, 0x4c, 0xaa, 0xbd, 0x5c, 0xc3, 0xfd, 0x4d, 0x25,
-		0x71, 0x00, 0x33, 0x75, 0x62, 0xba, 0x86, 0xd9, 0xc2, 0x7a, 0x4d, 0x75, 0x6a, 0x26, 0x6e, 0xc7,
-		0xd7, 0x21, 0x27, 0xc4, 0x01, 0x8c, 0x0c, 0x0a, 0x65, 0xb7, 0xe4, 0xdc, 0xc6, 0x6d, 0x46, 0x1c,
-		0xd0, 0xce, 0x34, 0x03, 0xfd, 0x4a, 0x82, 0xeb, 0x24, 0x9a, 0xac, 0x69, 0x5b, 0x46, 0x5d, 0xcf,
-		0x68, 0x3f, 0xa7, 0x09, 0xf4, 0x97, 0x98, 0xd0, 0x49, 0x28, 0xb9, 0xec, 0x31, 0xcd, 0x62, 0x34,
-		0x97, 0x9d, 0xec, 0xd3, 0xd0, 0xcf, 0x24, 0xb8, 0x9a, 0xb2, 0x08, 0x9e, 0x75, 0x9c, 0x21, 0x2b,
-		0x58, 0xc9, 0xba, 0x02, 0x9e, 0x49, 0x5c, 0x74, 0x32, 0xce, 0x41, 0xdf, 0x97, 0xe0, 0x12, 0x17,
-		0x35, 0x37, 0xce, 0x7f, 0x9a, 0xc0, 0x5e, 0x62, 0x87, 0x21, 0xcc, 0xaf, 0x73, 0x03, 0xff, 0x79,
-		0x2d, 0x03, 0x3d, 0xfa, 0xa1, 0x04, 0x97, 0xb9, 0x70, 0x13, 0x2e, 0x91, 0x67, 0x13, 0x94, 0x9c,
-		0x0d, 0x38, 0xe1, 0x3a, 0x59, 0xd1, 0x32, 0xcd, 0x40, 0x6f, 0x4b, 0x70, 0x31, 0xb3, 0x66, 0x9c,
-		0x23, 0x88, 0x3f, 0x96, 0x01, 0x31, 0x4f, 0x29, 0xce, 0x6b, 0x19, 0xf4, 0xe1, 0x1d, 0x09, 0x16,
-		0xf9, 0x02, 0xe6, 0x1e, 0xc2, 0xb3, 0x04, 0xed, 0xcd, 0x2c, 0xf2, 0xe5, 0x9e, 0xc4, 0x17, 0xb4,
-		0x2c, 0x13, 0xd0, 0x0f, 0x92, 0x54, 0x22, 0xe1, 0xd2, 0xfc, 0x4c, 0x66, 0xc8, 0xfc, 0xeb, 0x33,
-		0x07, 0x32, 0xef, 0x22, 0xed, 0xc5, 0x66, 0x7c, 0xc8, 0x09, 0x91, 0xe4, 0x5c, 0x42, 0x6c, 0xc6,
-		0xc1, 0x9c, 0x10, 0x4e, 0x2e, 0x68, 0xd9, 0xa6, 0x90, 0x43, 0xd3, 0x0f, 0xc5, 0xfb, 0x8d, 0x78,
-		0xce, 0x27, 0x1c, 0x9a, 0x7e, 0xc4, 0xdd, 0x4f, 0xa8, 0x73, 0xcd, 0xe9, 0x6f, 0x2a, 0xfa, 0xb5,
-		0x04, 0xcf, 0x09, 0x2c, 0x88, 0x67, 0xa3, 0xf3, 0x64, 0x35, 0xd5, 0x7e, 0x56, 0xc3, 0x33, 0xd6,
-		0x2b, 0x4e, 0x1f, 0xf3, 0xd0, 0x4f, 0x25, 0x78, 0x36, 0x69, 0x01, 0xfc, 0xfb, 0xd3, 0x85, 0x84,
-		0x03, 0x88, 0x0b, 0x82, 0x7f, 0x8f, 0xba, 0x88, 0x33, 0xce, 0x21, 0x0e, 0xa7, 0xd5, 0x74, 0xb0,
-		0xed, 0x76, 0x81, 0x3b, 0x58, 0xb5, 0xb5, 0xad, 0x1e, 0x98, 0x71, 0xdc, 0x95, 0x04, 0xeb, 0xbd,
-		0x4f, 0xd8, 0x05, 0x08, 0xee, 0x11, 0x66, 0xdd, 0x2f, 0x32, 0xac, 0xb7, 0x95, 0x65, 0xc2, 0xcd,
-		0x51, 0x80, 0x2e, 0x10, 0xf9, 0xaf, 0x65, 0x38, 0x27, 0x7a, 0x7a, 0xad, 0xc2, 0xbe, 0xce, 0x1a,
-		0xdd, 0xdd, 0x26, 0x26, 0xb5, 0x40, 0x5e, 0x65, 0x31, 0x60, 0xba, 0xbe, 0xdb, 0xc4, 0xca, 0x68,
-		0xbb, 0xe7, 0x09, 0x7d, 0x1a, 0x0e, 0x35, 0x55, 0xdb, 0x93, 0x48, 0xaf, 0xd1, 0x6d, 0x5a, 0xb4,
-		0x7c, 0x38, 0xcb, 0xe4, 0x77, 0x97, 0xcc, 0xe8, 0xb1, 0x89, 0x4d, 0x4b, 0x39, 0xd0, 0x8c, 0x0f,
-		0xa2, 0xe7, 0xa0, 0x44, 0x32, 0x32, 0x75, 0xc3, 0x71, 0x49, 0x61, 0xb1, 0xbc, 0x78, 0x9c, 0x9d,
-		0xf2, 0x50, 0x9d, 0xed, 0x35, 0xc3, 0x71, 0x95, 0x11, 0x97, 0xfe, 0x85, 0x16, 0x61, 0xd8, 0x30,
-		0x9b, 0x2d, 0x97, 0x94, 0x1d, 0xcb, 0x8b, 0xc7, 0x38, 0x48, 0x76, 0xeb, 0x96, 0xaa, 0x2b, 0x3e,
-		0x29, 0x52, 0x61, 0x26, 0x12, 0x72, 0xd4, 0x5c, 0xab, 0xa6, 0xd5, 0x2d, 0x07, 0x13, 0xff, 0x6d,
-		0xb5, 0x5c, 0x5a, 0x87, 0x9c, 0x8e, 0xd5, 0x45, 0x6f, 0xd1, 0x4a, 0xb2, 0x72, 0x0c, 0x87, 0x64,
-		0xbf, 0x6e, 0x2d, 0x7b, 0xf3, 0xd7, 0xfd, 0xe9, 0xe8, 0x65, 0x38, 0xda, 0x4d, 0x7b, 0xc7, 0xb9,
-		0x17, 0xd2, 0xb8, 0x1f, 0x76, 0x83, 0x64, 0x76, 0x84, 0xf1, 0x0d, 0x38, 0xd2, 0x8d, 0xb0, 0xbb,
-		0xab, 0xb0, 0x5b, 0x66, 0xcd, 0xd0, 0x49, 0xe9, 0xaf, 0xa4, 0x1c, 0xee, 0x50, 0x74, 0xe4, 0xac,
-		0xb4, 0xcc, 0xaa, 0x8e, 0xaa, 0x50, 0xa2, 0xae, 0xd2, 0xb2, 0x49, 0x1d, 0x6e, 0x6c, 0xf1, 0x3c,
-		0xdb, 0xb5, 0x53, 0x06, 0x24, 0x84, 0xae, 0x06, 0x53, 0x94, 0xee, 0x6c, 0x54, 0x85, 0x89, 0x2e,
-		0x0e, 0xcf, 0x5d, 0xb5, 0x6c, 0x4c, 0x8b, 0x67, 0xec, 0x3d, 0x58, 0xf5, 0x69, 0x94, 0xf1, 0xce,
-		0x34, 0x3a, 0x82, 0x14, 0x98, 0xac, 0xab, 0xde, 0x9d, 0xcf, 0x0f, 0x67, 0xc8, 0x72, 0xb0, 0xd3,
-		0xaa, 0xbb, 0xb4, 0xf0, 0x95, 0xbc, 0xa7, 0x07, 0xbd, 0xb9, 0xcb, 0x9d, 0xa9, 0x0a, 0x99, 0x89,
-		0xae, 0xc3, 0xb4, 0x65, 0x1b, 0x0f, 0x0d, 0xdf, 0xd1, 0x46, 0xa4, 0x54, 0x26, 0x52, 0x9a, 0x0c,
-		0x08, 0x22, 0x42, 0x3a, 0x02, 0x23, 0x86, 0x8e, 0x4d, 0xd7, 0x70, 0x77, 0x49, 0x45, 0xa9, 0xa4,
-		0x74, 0x9e, 0xd1, 0x65, 0x98, 0xdc, 0x34, 0x6c, 0xc7, 0x8d, 0xf3, 0xdc, 0x47, 0x28, 0x0f, 0x90,
-		0xb7, 0x11, 0x86, 0xcb, 0x30, 0x6a, 0x63, 0xd7, 0xde, 0xad, 0x35, 0xad, 0xba, 0xa1, 0xed, 0xd2,
-		0x2a, 0xcc, 0x0c, 0xe7, 0x82, 0xea, 0xda, 0xbb, 0x77, 0x09, 0x9d, 0x52, 0xb6, 0xbb, 0x0f, 0x68,
-		0x0a, 0x8a, 0xaa, 0xeb, 0xe2, 0x46, 0xd3, 0x25, 0x15, 0x93, 0x61, 0x25, 0x78, 0x44, 0xcb, 0xb0,
-		0x1f, 0x3f, 0x6a, 0x1a, 0xbe, 0xe2, 0xf8, 0x45, 0xfd, 0xf1, 0xd4, 0xa2, 0xfe, 0x58, 0x77, 0x0a,
-		0xa9, 0xec, 0x9f, 0x86, 0x7d, 0x9a, 0xed, 0x59, 0x03, 0xad, 0xe8, 0x90, 0x8a, 0x43, 0x49, 0x19,
-		0xf5, 0x06, 0x83, 0x2a, 0x0f, 0xfa, 0x04, 0x1c, 0xf5, 0x57, 0x1f, 0xae, 0x7e, 0x6d, 0xa8, 0xda,
-		0xb6, 0xb5, 0xb9, 0x49, 0x8b, 0x02, 0x09, 0x4a, 0x3d, 0x45, 0x66, 0xf7, 0x16, 0xbe, 0x6e, 0xfa,
-		0x53, 0xd1, 0x05, 0x18, 0x6a, 0xe0, 0x86, 0x45, 0xd3, 0xf9, 0xd3, 0xec, 0x44, 0x1f, 0x6e, 0x58,
-		0x0a, 0x21, 0x43, 0x0a, 0x4c, 0xc4, 0x3c, 0x36, 0xcd, 0xc9, 0x3f, 0xcd, 0x3e, 0x1b, 0x23, 0x1e,
-		0x56, 0x19, 0x77, 0x22, 0x23, 0xe8, 0x3e, 0x4c, 0x36, 0x6d, 0xbc, 0x53, 0x53, 0x5b, 0xae, 0xe5,
-		0xe9, 0x1f, 0x76, 0x6b, 0x4d, 0xcb, 0x30, 0xdd, 0x20, 0xcb, 0xce, 0xdb, 0x2f, 0x07, 0xbb, 0x77,
-		0x09, 0x9d, 0x72, 0xc0, 0x9b, 0xbf, 0xd4, 0x72, 0xad, 0x9e, 0x41, 0x74, 0x19, 0x0a, 0x5b, 0x58,
-		0xd5, 0xb1, 0x4d, 0xd3, 0xdf, 0x47, 0xd9, 0x4d, 0x1d, 0x84, 0x44, 0xa1, 0xa4, 0xf2, 0xdb, 0x12,
-		0x3c, 0x23, 0x1e, 0xed, 0x5f, 0x81, 0x02, 0xb5, 0x17, 0x49, 0xc0, 0x5e, 0x28, 0x2d, 0x5a, 0x85,
-		0x99, 0xe4, 0x72, 0xaf, 0xa1, 0x13, 0xef, 0x9e, 0x57, 0x8e, 0xf1, 0x2b, 0xb5, 0x55, 0x5d, 0x7e,
-		0x4b, 0x82, 0xb3, 0x82, 0x41, 0xc3, 0x55, 0x28, 0x06, 0x9e, 0x42, 0x12, 0xf0, 0x14, 0x01, 0xf1,
-		0xc0, 0xa0, 0x5a, 0x30, 0x2b, 0x1c, 0x31, 0x2f, 0xc3, 0x28, 0x75, 0xd6, 0xdd, 0x83, 0x73, 0x8c,
-		0xa3, 0x04, 0xd4, 0x37, 0x93, 0x73, 0xb3, 0xec, 0x76, 0x1f, 0xe4, 0xdf, 0x4b, 0x70, 0x46, 0xa4,
-		0x69, 0x20, 0x7c, 0x02, 0x4a, 0xd9, 0x4e, 0xc0, 0xdb, 0x30, 0xc9, 0x39, 0x65, 0x72, 0x69, 0x06,
-		0x79, 0xc0, 0x61, 0x9c, 0x30, 0x3d, 0x9e, 0x26, 0x1f, 0xf2, 0x34, 0xf2, 0x6b, 0x12, 0xc8, 0xe9,
-		0xfd, 0x06, 0x68, 0x1e, 0x50, 0xb4, 0x06, 0xdd, 0xe9, 0x42, 0x1a, 0x77, 0x42, 0x22, 0x88, 0xb8,
-		0xdb, 0x5c, 0xc4, 0xdd, 0x1e, 0x07, 0x08, 0x12, 0x82, 0x86, 0x4e, 0xd0, 0x94, 0x94, 0x12, 0x1d,
-		0xa9, 0xea, 0xf2, 0x3f, 0x22, 0xe2, 0xe5, 0x5a, 0x48, 0x36, 0x44, 0xb3, 0x30, 0x1e, 0xce, 0x43,
-		0x74, 0xd4, 0x6b, 0xcc, 0xe9, 0x59, 0x71, 0x04, 0x7b, 0x3e, 0x82, 0xfd, 0x1c, 0xec, 0xdf, 0x30,
-		0x4c, 0xd5, 0xde, 0xad, 0x69, 0x5b, 0x58, 0xdb, 0x76, 0x5a, 0x0d, 0x12, 0xa2, 0x94, 0x94, 0x31,
-		0x7f, 0x78, 0x99, 0x8e, 0xa2, 0xf3, 0x30, 0x11, 0xce, 0x9e, 0xe1, 0x47, 0x7e, 0xf8, 0x31, 0xaa,
-		0x8c, 0xe3, 0xde, 0xa4, 0x16, 0x7e, 0xe4, 0xca, 0xaf, 0xe6, 0xe1, 0xb4, 0x40, 0x2b, 0xc3, 0x63,
-		0x5b, 0x71, 0xd4, 0x2c, 0xf2, 0x7d, 0x98, 0x05, 0x3a, 0x01, 0xe5, 0x0d, 0xd5, 0xc1, 0xc1, 0xd1,
-		0xe9, 0x8b, 0xa5, 0xe4, 0x0d, 0xf9, 0x07, 0xe6, 0x31, 0x00, 0x13, 0xb7, 0x83, 0xd7, 0xc3, 0xbe,
-		0x60, 0x4d, 0xdc, 0xf6, 0xdf, 0xce, 0x03, 0xda, 0xb4, 0xec, 0x6d, 0x8a, 0x34, 0xe8, 0x47, 0x2b,
-		0xf8, 0x4b, 0xf3, 0xde, 0x10, 0xac, 0x0f, 0x68, 0x63, 0xda, 0xa4, 0xe7, 0x1c, 0x55, 0xc7, 0x32,
-		0x69, 0x6c, 0x44, 0x9f, 0xd0, 0x2d, 0x18, 0xd6, 0xd4, 0x96, 0x83, 0x69, 0x18, 0x54, 0x11, 0x6e,
-		0x1a, 0x59, 0xf6, 0x66, 0x29, 0xfe, 0x64, 0xf9, 0xad, 0x3c, 0x9c, 0x4a, 0x6d, 0xe4, 0x78, 0x6c,
-		0x9b, 0x71, 0x33, 0x58, 0x83, 0xbf, 0x0b, 0xf3, 0x82, 0x7d, 0x26, 0xbd, 0x2b, 0xe8, 0xf5, 0xc9,
-		0x43, 0x59, 0x7c, 0x72, 0xaf, 0xea, 0x0f, 0x47, 0x54, 0x3f, 0xb2, 0xbf, 0x85, 0xe4, 0xfd, 0x2d,
-		0x0a, 0xed, 0xef, 0x08, 0x67, 0x7f, 0x19, 0x66, 0x56, 0x62, 0x99, 0x99, 0xfc, 0x8d, 0x02, 0x9c,
-		0x11, 0xe9, 0x71, 0x41, 0x27, 0xa1, 0xdc, 0x29, 0x14, 0xd3, 0x6d, 0x2a, 0x29, 0x10, 0x0c, 0x55,
-		0x75, 0xef, 0x52, 0xd5, 0xad, 0x24, 0x7b, 0x46, 0x90, 0x4b, 0xb8, 0x54, 0x75, 0x3e, 0x49, 0x2e,
-		0x55, 0x6a, 0xcf, 0x93, 0xa7, 0x9a, 0xba, 0xd5, 0x50, 0x0d, 0x93, 0xfa, 0x0e, 0xfa, 0x14, 0x3e,
-		0x0c, 0x86, 0xfa, 0xbc, 0x0e, 0x15, 0xc4, 0xaf, 0x43, 0xeb, 0x30, 0x1d, 0x28, 0x61, 0xfc, 0x0c,
-		0x29, 0xa6, 0x9d, 0x21, 0x93, 0xc1, 0xdc, 0xc8, 0x31, 0x12, 0xe1, 0x4a, 0x8f, 0x28, 0xca, 0x75,
-		0x24, 0x03, 0x57, 0xff, 0x16, 0x44, 0xb9, 0xf2, 0x0f, 0xbb, 0x52, 0x5f, 0x87, 0xdd, 0x2a, 0x4c,
-		0x6c, 0x61, 0xd5, 0x76, 0x37, 0xb0, 0xda, 0x45, 0x07, 0x69, 0xac, 0xc6, 0x3b, 0x73, 0xba, 0x7c,
-		0xd2, 0x43, 0x94, 0x72, 0x7a, 0x88, 0x12, 0xbb, 0x2b, 0x8c, 0xf6, 0x73, 0x57, 0xe8, 0xc6, 0x9c,
-		0xfb, 0xc4, 0x63, 0xce, 0xbf, 0x4b, 0x20, 0xa7, 0xf7, 0x5b, 0x7d, 0x60, 0x87, 0x7b, 0x6f, 0x18,
-		0x32, 0x14, 0xbe, 0xf0, 0xbc, 0x08, 0xa3, 0xe4, 0xbe, 0x18, 0xf8, 0xad, 0x61, 0x01, 0xbf, 0x55,
-		0xf6, 0x66, 0xd0, 0x07, 0xf9, 0x8f, 0x52, 0xd8, 0x15, 0x0c, 0x38, 0xb2, 0x66, 0x8b, 0x28, 0x97,
-		0xc1, 0xdd, 0xe7, 0x53, 0xa3, 0x8d, 0xa1, 0xb0, 0x30, 0xe5, 0x3f, 0x48, 0x70, 0x2a, 0xbd, 0x09,
-		0xa6, 0xdf, 0x00, 0xfc, 0xc3, 0x58, 0xd1, 0xcf, 0x73, 0x70, 0x5a, 0xa0, 0x95, 0xcc, 0x5b, 0x93,
-		0x8e, 0x5d, 0xd5, 0xa8, 0x3b, 0x42, 0x9b, 0x14, 0x10, 0x3f, 0xb6, 0x35, 0x45, 0x23, 0xa4, 0xa1,
-		0x7e, 0x22, 0xa4, 0x3d, 0xab, 0xf8, 0x57, 0x24, 0x98, 0x13, 0xef, 0x00, 0x13, 0x39, 0xf3, 0x06,
-		0x73, 0x05, 0x7b, 0x47, 0x82, 0x8c, 0xbd, 0x5e, 0xe9, 0xd8, 0x0e, 0x06, 0x61, 0x90, 0xef, 0x61,
-		0x68, 0x60, 0x23, 0x82, 0x38, 0x2f, 0x80, 0xf8, 0xcd, 0x88, 0x1e, 0xf2, 0xaa, 0x42, 0xfd, 0xea,
-		0xe1, 0x2a, 0xcc, 0xd4, 0x55, 0xb7, 0xa7, 0xe7, 0x21, 0xda, 0x01, 0xd0, 0x95, 0xac, 0x4f, 0xc7,
-		0xda, 0x4a, 0x3f, 0x6c, 0x62, 0xe8, 0x73, 0x3e, 0x83, 0x3e, 0x0f, 0xa5, 0xda, 0x68, 0x24, 0xd0,
-		0x93, 0xdf, 0x95, 0xe0, 0x68, 0x42, 0x97, 0x25, 0x9a, 0x86, 0x11, 0xbf, 0xbb, 0xac, 0xb3, 0x6f,
-		0x45, 0xf2, 0x5c, 0xd5, 0xd1, 0x1a, 0x1c, 0xea, 0x1c, 0xe4, 0x9b, 0x86, 0x9d, 0xe1, 0xd2, 0x8a,
-		0xe8, 0x39, 0xbe, 0x6a, 0xd8, 0x38, 0xcb, 0xf1, 0x2b, 0xb2, 0xd9, 0x9f, 0x81, 0x69, 0x6e, 0xfb,
-		0x66, 0xd2, 0x6a, 0x84, 0x63, 0x76, 0xf9, 0x37, 0x12, 0x1c, 0x4b, 0xea, 0xdc, 0x1b, 0xc8, 0x57,
-		0x06, 0x25, 0x8f, 0x44, 0x07, 0xfd, 0x13, 0x09, 0x66, 0xd2, 0x3a, 0x00, 0x93, 0x56, 0xf3, 0x58,
-		0xcd, 0x36, 0x11, 0xf9, 0xbf, 0x8b, 0x90, 0xb1, 0xd1, 0x04, 0x2d, 0xc0, 0x41, 0xd2, 0xcb, 0x12,
-		0x4d, 0xfb, 0xfa, 0x6b, 0x9a, 0x30, 0x71, 0x3b, 0x92, 0xf4, 0x8d, 0x55, 0x5e, 0x72, 0xfd, 0x55,
-		0x5e, 0x9e, 0xd4, 0x46, 0xc4, 0x6b, 0x23, 0x22, 0xba, 0x53, 0x14, 0xd0, 0x9d, 0x3b, 0x30, 0x49,
-		0x73, 0xda, 0x14, 0xa3, 0x61, 0xba, 0xd8, 0xde, 0x51, 0xeb, 0xe9, 0xf7, 0x96, 0x83, 0x74, 0x22,
-		0x81, 0x57, 0xa5, 0xd3, 0xc2, 0x75, 0x97, 0xd2, 0x9e, 0xea, 0x2e, 0x3d, 0x21, 0x1c, 0x64, 0x09,
-		0xe1, 0xf8, 0x45, 0x96, 0x72, 0xdf, 0x45, 0x96, 0xee, 0x3d, 0x63, 0x54, 0xf8, 0x9e, 0xd1, 0x49,
-		0xf5, 0xef, 0xdb, 0x43, 0xaa, 0x7f, 0x6c, 0x4f, 0xa9, 0x7e, 0xcf, 0x07, 0x2f, 0x64, 0xed, 0x76,
-		0xeb, 0x78, 0x2b, 0xa9, 0xd7, 0x5b, 0x25, 0xdd, 0x6f, 0x36, 0xe0, 0x70, 0xa7, 0x42, 0x1e, 0xa9,
-		0x9a, 0xfa, 0x76, 0x3c, 0x97, 0x58, 0x03, 0x0f, 0xd7, 0x4d, 0x0f, 0x61, 0xd6, 0xb0, 0xfc, 0x3d,
-		0x89, 0x91, 0xd2, 0xe6, 0x9d, 0x2c, 0x22, 0xe6, 0x21, 0x09, 0x98, 0x47, 0x4f, 0xa4, 0x93, 0xcb,
-		0x10, 0xe9, 0xc8, 0xef, 0x4b, 0x70, 0x3c, 0xb1, 0x5b, 0xdb, 0x0b, 0xf5, 0x68, 0x2f, 0xb8, 0xa9,
-		0x36, 0x02, 0x51, 0x83, 0x3f, 0x74, 0x5b, 0x6d, 0xe0, 0x7e, 0x3f, 0x3d, 0xb0, 0x53, 0xa5, 0xab,
-		0xf1, 0x43, 0xe2, 0x37, 0xeb, 0xaf, 0xb1, 0x36, 0x89, 0xd7, 0x9d, 0x70, 0x12, 0xca, 0xb4, 0x3f,
-		0xa4, 0x57, 0x04, 0xfe, 0x10, 0x11, 0x41, 0xc7, 0xa9, 0xe7, 0xc4, 0x9d, 0x7a, 0x42, 0x9e, 0x5a,
-		0xfe, 0xaa, 0x04, 0x73, 0x19, 0x3a, 0x72, 0xba, 0xf9, 0x54, 0x29, 0x94, 0x4f, 0xed, 0x77, 0x67,
-		0x92, 0xa0, 0xfd, 0x32, 0x07, 0x2f, 0xec, 0xad, 0x2b, 0x79, 0x60, 0x3a, 0xdf, 0xcd, 0xd5, 0xe5,
-		0x42, 0xb9, 0xba, 0xfb, 0x80, 0xe2, 0xdd, 0x2f, 0xd4, 0xbe, 0xcf, 0x8a, 0x75, 0xb8, 0x2a, 0x13,
-		0xb1, 0x16, 0x56, 0x34, 0x05, 0x45, 0xcd, 0x32, 0x5d, 0xdb, 0xaa, 0x13, 0x45, 0x1b, 0x55, 0x82,
-		0x47, 0x54, 0x81, 0x03, 0x91, 0x46, 0x2e, 0xcb, 0xac, 0xfb, 0x91, 0xf9, 0x88, 0x32, 0x11, 0xea,
-		0xaf, 0xba, 0x63, 0xd6, 0x77, 0xe5, 0x37, 0xf2, 0x70, 0x63, 0x0f, 0x5d, 0xcf, 0xe8, 0x7e, 0xaf,
-		0xdf, 0x1b, 0xe3, 0xfc, 0xa6, 0x40, 0x88, 0x73, 0x28, 0xed, 0x3c, 0xa0, 0xfb, 0x24, 0x37, 0x87,
-		0xca, 0xde, 0x97, 0xa1, 0xbd, 0xee, 0xcb, 0x3c, 0xa0, 0x68, 0xaf, 0x19, 0xad, 0x50, 0xe4, 0x95,
-		0x71, 0x23, 0xa4, 0x84, 0x7e, 0x0a, 0x2b, 0xd8, 0xc5, 0x42, 0x68, 0x17, 0xe5, 0x3f, 0x49, 0x70,
-		0xad, 0xcf, 0x96, 0x6d, 0x0e, 0x06, 0x89, 0x83, 0xe1, 0x83, 0x55, 0x5c, 0xf9, 0x4b, 0x79, 0xb8,
-		0xd6, 0x67, 0x5b, 0xdd, 0xff, 0xaa, 0xad, 0x46, 0x3c, 0xf6, 0x10, 0xdf, 0x63, 0x0f, 0x8b, 0x7b,
-		0x6c, 0xae, 0xea, 0xf0, 0x1c, 0x40, 0x91, 0xe7, 0x00, 0x5e, 0xcd, 0xc3, 0x95, 0x7e, 0x5a, 0x03,
-		0xc5, 0x2c, 0x5f, 0x88, 0xf3, 0x13, 0xcb, 0xef, 0x5a, 0xfe, 0x7b, 0x12, 0x5c, 0xcc, 0xda, 0xe6,
-		0xf8, 0x5f, 0x6d, 0xf2, 0xfc, 0xb3, 0x4a, 0xfe, 0x9d, 0x04, 0x17, 0x32, 0xb5, 0x46, 0x0e, 0xcc,
-		0x05, 0x30, 0x6f, 0x0d, 0xb9, 0xbd, 0xdd, 0x1a, 0xfe, 0x32, 0x02, 0x97, 0xfb, 0xf8, 0x8d, 0x47,
-		0xcf, 0x76, 0x48, 0xa1, 0xed, 0x38, 0x09, 0xe5, 0xce, 0x76, 0x50, 0x9d, 0x2f, 0x29, 0x10, 0x0c,
-		0xb1, 0x52, 0x08, 0xf9, 0x01, 0xa4, 0x10, 0xfa, 0xad, 0x27, 0x0e, 0x0f, 0x36, 0x85, 0x50, 0x78,
-		0xac, 0x29, 0x84, 0x62, 0xdf, 0x29, 0x84, 0x07, 0x40, 0x3b, 0x54, 0x29, 0x47, 0x5a, 0x86, 0xf3,
-		0x9b, 0x04, 0xce, 0x26, 0xb4, 0xb9, 0x12, 0x2e, 0xb4, 0x18, 0x37, 0xd1, 0x8c, 0x0e, 0xf5, 0x1a,
-		0x49, 0x29, 0xec, 0xcf, 0x45, 0x54, 0x1e, 0x04, 0x54, 0x5e, 0x83, 0xa9, 0x1e, 0x75, 0xaa, 0xd9,
-		0xb8, 0xd5, 0x85, 0x5f, 0x26, 0xf0, 0xe7, 0x12, 0x15, 0xa7, 0xaa, 0x2b, 0xde, 0x14, 0xba, 0x84,
-		0x43, 0x6d, 0xd6, 0x70, 0xac, 0x3c, 0xb9, 0xaf, 0x9f, 0xf2, 0x64, 0xac, 0xd7, 0x70, 0x8c, 0xd1,
-		0x6b, 0xd8, 0xbd, 0x69, 0xed, 0xcf, 0x9e, 0x5b, 0x18, 0xdf, 0x43, 0x6e, 0x61, 0x62, 0x6f, 0x6d,
-		0x84, 0xcf, 0x41, 0x59, 0xc7, 0x75, 0x75, 0xd7, 0x57, 0xcd, 0xf4, 0x9e, 0x48, 0x20, 0xd4, 0x44,
-		0x15, 0xe5, 0xd7, 0xf3, 0x70, 0x31, 0xeb, 0x6f, 0xb0, 0x3e, 0x7c, 0xf7, 0xb2, 0x16, 0xc4, 0x09,
-		0x7e, 0xa5, 0xeb, 0x6a, 0xe6, 0x1f, 0x10, 0x85, 0xc2, 0x83, 0x1e, 0x43, 0x19, 0x0e, 0x1b, 0x0a,
-		0xfb, 0x10, 0x2c, 0x70, 0x0e, 0xc1, 0x01, 0xe5, 0x02, 0xe5, 0xdf, 0xe6, 0x60, 0x3e, 0xcb, 0x0f,
-		0xcc, 0xb8, 0xfb, 0xc1, 0x3e, 0x7d, 0x73, 0x7b, 0x3d, 0x7d, 0x07, 0xb5, 0x8b, 0x6c, 0xe9, 0x0e,
-		0x71, 0xa4, 0xdb, 0xb5, 0xce, 0x61, 0xf1, 0x3c, 0xc8, 0xfb, 0x39, 0xc8, 0xf8, 0xd3, 0xb7, 0x8f,
-		0x86, 0x30, 0x59, 0x65, 0x9d, 0x61, 0x66, 0x59, 0xa7, 0xdb, 0x8f, 0x50, 0x10, 0xef, 0x47, 0x90,
-		0xff, 0x99, 0x83, 0xf3, 0x83, 0xf0, 0x28, 0x1f, 0x51, 0xa1, 0xf7, 0x64, 0xdc, 0x0b, 0x19, 0x32,
-		0xee, 0xf2, 0xbf, 0x72, 0x70, 0x21, 0xd3, 0x2f, 0x11, 0x9f, 0x08, 0x3e, 0x26, 0xf8, 0x20, 0xa5,
-		0x58, 0xc8, 0x92, 0x67, 0xfe, 0x7c, 0x9e, 0x27, 0x78, 0x5e, 0x0f, 0xc9, 0x13, 0xc1, 0x27, 0xb6,
-		0xb0, 0x14, 0xfa, 0xe9, 0x7d, 0xff, 0x45, 0x0e, 0x16, 0x32, 0xfe, 0x42, 0xf4, 0xc9, 0x3e, 0x84,
-		0xf6, 0x61, 0xce, 0x85, 0xfd, 0xe4, 0xcf, 0x55, 0xa3, 0xee, 0x62, 0x9b, 0x7c, 0xea, 0x38, 0x4c,
-		0xaf, 0x3c, 0x58, 0xb9, 0xbd, 0x5e, 0x5b, 0xad, 0xae, 0xad, 0xaf, 0x28, 0xb5, 0xf5, 0x4f, 0xde,
-		0x5d, 0xa9, 0x55, 0x6f, 0x3f, 0x58, 0x5a, 0xab, 0xde, 0x1a, 0x7f, 0x0a, 0x9d, 0x84, 0xa3, 0xf1,
-		0xd7, 0x4b, 0x6b, 0x6b, 0x35, 0x32, 0x3a, 0x2e, 0xa1, 0x53, 0x70, 0x3c, 0x4e, 0xb0, 0xbc, 0x76,
-		0xe7, 0xde, 0x0a, 0x25, 0xc9, 0xdd, 0x7c, 0x00, 0x87, 0x35, 0xab, 0xc1, 0x92, 0xc1, 0xcd, 0x91,
-		0xa5, 0xa6, 0x71, 0xd7, 0x0b, 0x61, 0xef, 0x4a, 0x9f, 0x5a, 0x78, 0x68, 0xb8, 0x5b, 0xad, 0x8d,
-		0x8a, 0x66, 0x35, 0x16, 0x42, 0xff, 0xe7, 0xb4, 0xf2, 0x10, 0x9b, 0xfe, 0x7f, 0x56, 0xa5, 0xff,
-		0xf2, 0xf4, 0x86, 0xda, 0x34, 0x76, 0x2e, 0x6d, 0x14, 0xc8, 0xd8, 0xe5, 0xff, 0x04, 0x00, 0x00,
-		0xff, 0xff, 0xee, 0x07, 0x38, 0xbd, 0xd5, 0x55, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x1c, 0xc7,
+		0x91, 0xf6, 0xec, 0x92, 0x4b, 0x6e, 0x2d, 0x49, 0x91, 0x2d, 0x89, 0x22, 0x45, 0x4a, 0x22, 0x47,
+		0xb2, 0x44, 0x53, 0xd4, 0xae, 0x44, 0xc9, 0xd2, 0xc9, 0x3a, 0xcb, 0x47, 0x51, 0x24, 0xb4, 0x00,
+		0x2d, 0x11, 0x23, 0x4a, 0xbe, 0x3b, 0x1c, 0xb0, 0x37, 0x9c, 0x69, 0x8a, 0x03, 0xee, 0xee, 0xac,
+		0x67, 0x66, 0xb9, 0xe2, 0x1d, 0xfc, 0x74, 0x0f, 0x07, 0x1c, 0x6c, 0xdc, 0x19, 0xc6, 0x01, 0xe7,
+		0xfb, 0xc1, 0xfd, 0x00, 0x77, 0xb0, 0x0f, 0x01, 0x1c, 0x24, 0x08, 0x92, 0x20, 0x2f, 0x49, 0x80,
+		0x20, 0x01, 0x12, 0x38, 0x79, 0xca, 0x4b, 0x10, 0xe4, 0x25, 0x0f, 0xc9, 0x5b, 0x02, 0xc4, 0x79,
+		0x0b, 0x10, 0x4c, 0x4f, 0xcf, 0xee, 0xce, 0x4c, 0xf7, 0x4c, 0xcf, 0x72, 0x65, 0x27, 0xb0, 0xde,
+		0x38, 0x3d, 0xd5, 0x35, 0x5f, 0x75, 0x57, 0x55, 0x57, 0x75, 0xd5, 0x12, 0xe6, 0x9b, 0xdb, 0xd8,
+		0x2a, 0x69, 0xaa, 0x8e, 0xeb, 0x1a, 0x2e, 0xa9, 0x0d, 0xa3, 0xb4, 0x7f, 0xa5, 0xb4, 0x6b, 0xd8,
+		0x8e, 0x69, 0x1d, 0x14, 0x1b, 0x96, 0xe9, 0x98, 0xe8, 0xa8, 0x4b, 0x52, 0xa4, 0x24, 0x45, 0xb5,
+		0x61, 0x14, 0xf7, 0xaf, 0x9c, 0x3c, 0xfd, 0xc4, 0x34, 0x9f, 0x54, 0x71, 0x89, 0x90, 0x6c, 0x37,
+		0x77, 0x4a, 0x7a, 0xd3, 0x52, 0x1d, 0xc3, 0xac, 0x7b, 0x93, 0x4e, 0x9e, 0x09, 0xbf, 0x77, 0x8c,
+		0x1a, 0xb6, 0x1d, 0xb5, 0xd6, 0xa0, 0x04, 0x73, 0xac, 0x0f, 0x6b, 0x66, 0xad, 0xd6, 0x66, 0x21,
+		0xb3, 0x28, 0x1c, 0xd5, 0xde, 0xab, 0x1a, 0xb6, 0x13, 0x47, 0xd3, 0x32, 0xad, 0xbd, 0x9d, 0xaa,
+		0xd9, 0xf2, 0x68, 0xe4, 0xbb, 0x30, 0x74, 0xcf, 0x13, 0x08, 0xdd, 0x84, 0x1c, 0xde, 0xc7, 0x75,
+		0xc7, 0x9e, 0x92, 0xe6, 0xb2, 0x0b, 0x85, 0xe5, 0xf9, 0x22, 0x43, 0xb6, 0x22, 0xa5, 0x5e, 0x73,
+		0x29, 0x15, 0x3a, 0x41, 0xfe, 0xef, 0xdb, 0x30, 0xd2, 0xfd, 0x02, 0x4d, 0xc3, 0x30, 0x79, 0x55,
+		0x31, 0xf4, 0x29, 0x69, 0x4e, 0x5a, 0xc8, 0x2a, 0x43, 0xe4, 0xb9, 0xac, 0xa3, 0x9b, 0x00, 0xde,
+		0x2b, 0x57, 0xe8, 0xa9, 0xcc, 0x9c, 0xb4, 0x50, 0x58, 0x3e, 0x59, 0xf4, 0x56, 0xa4, 0xe8, 0xaf,
+		0x48, 0x71, 0xcb, 0x5f, 0x11, 0x25, 0x4f, 0xa8, 0xdd, 0x67, 0x34, 0x05, 0x43, 0xfb, 0xd8, 0xb2,
+		0x0d, 0xb3, 0x3e, 0x95, 0xf5, 0x98, 0xd2, 0x47, 0x74, 0x02, 0x86, 0x5c, 0xe1, 0xdd, 0xcf, 0x0d,
+		0x90, 0x37, 0x39, 0xf7, 0xb1, 0xac, 0xa3, 0x7f, 0x97, 0xe0, 0xa2, 0x2f, 0x72, 0x05, 0x3f, 0xc5,
+		0x5a, 0xd3, 0xdd, 0x87, 0x8a, 0xed, 0xa8, 0x96, 0x83, 0xf5, 0x8a, 0x87, 0x44, 0x75, 0x1c, 0xcb,
+		0xd8, 0x6e, 0x3a, 0xd8, 0x9e, 0x1a, 0x24, 0x78, 0xfe, 0x94, 0x29, 0xfa, 0x1b, 0x94, 0xcf, 0x9a,
+		0xcf, 0xe6, 0xa1, 0xc7, 0x85, 0x88, 0xbc, 0xd2, 0xe6, 0x71, 0xef, 0x05, 0xe5, 0x42, 0x4b, 0x8c,
+		0x14, 0xfd, 0x8f, 0x04, 0x97, 0x18, 0xf0, 0x34, 0xb3, 0xd6, 0xa8, 0x62, 0x26, 0xc0, 0x1c, 0x01,
+		0x78, 0x5b, 0x0c, 0xe0, 0xaa, 0xcf, 0x27, 0x0a, 0xf1, 0xa5, 0x96, 0x28, 0x31, 0x7a, 0x5f, 0x82,
+		0x45, 0x06, 0xc8, 0x1d, 0xd5, 0xa8, 0xb2, 0x10, 0x0e, 0x11, 0x84, 0xb7, 0xc4, 0x10, 0xae, 0x13,
+		0x26, 0x51, 0x78, 0xe7, 0x5b, 0x42, 0x94, 0xe8, 0xbf, 0xd8, 0x0b, 0xe8, 0xea, 0x96, 0x5e, 0x31,
+		0x9b, 0x4e, 0x14, 0xde, 0x30, 0x81, 0xf7, 0xaa, 0x18, 0x3c, 0x57, 0xed, 0xf4, 0x07, 0x4d, 0x27,
+		0x0a, 0x70, 0xa1, 0x25, 0x48, 0x8b, 0xde, 0x93, 0x60, 0x41, 0xc7, 0x9a, 0x61, 0x13, 0x60, 0xae,
+		0x96, 0xda, 0xda, 0x2e, 0xd6, 0x9b, 0xcc, 0xc5, 0xcb, 0x13, 0x74, 0x37, 0x99, 0xe8, 0xee, 0x52,
+		0x26, 0x5b, 0xaa, 0xbd, 0xf7, 0xd0, 0x67, 0x11, 0x45, 0x76, 0x4e, 0x17, 0xa0, 0x43, 0xef, 0x48,
+		0x70, 0x3e, 0x84, 0x8a, 0x67, 0x13, 0x40, 0x30, 0xdd, 0x48, 0xc6, 0xc4, 0x33, 0x07, 0x59, 0x4f,
+		0xa4, 0x62, 0xac, 0x52, 0x8c, 0x11, 0x14, 0x04, 0x57, 0x29, 0x46, 0xff, 0xcf, 0xe9, 0x02, 0x74,
+		0xe8, 0xdd, 0x08, 0xaa, 0x18, 0xcd, 0x1a, 0x21, 0xa8, 0xfe, 0x24, 0x11, 0x15, 0x5f, 0xa9, 0xce,
+		0xea, 0xc9, 0x64, 0xe8, 0x1f, 0x24, 0x78, 0x31, 0x88, 0x89, 0x67, 0x89, 0xa3, 0x04, 0xd0, 0xf5,
+		0x44, 0x40, 0x3c, 0x23, 0x9c, 0xd7, 0x93, 0x88, 0xc8, 0xb6, 0xa9, 0x9a, 0x63, 0xec, 0x1b, 0xce,
+		0x41, 0xa2, 0x72, 0x8f, 0xc5, 0x6c, 0xdb, 0x0a, 0x65, 0x92, 0xa4, 0xdc, 0xaa, 0x00, 0x1d, 0x51,
+		0xee, 0x10, 0x2a, 0x9e, 0x72, 0x1f, 0x89, 0x51, 0xee, 0x00, 0x26, 0xae, 0x72, 0xab, 0x89, 0x54,
+		0x8c, 0x55, 0x8a, 0x51, 0xee, 0x71, 0xc1, 0x55, 0x8a, 0x53, 0x6e, 0x55, 0x80, 0x8e, 0x28, 0x52,
+		0x10, 0x15, 0x4f, 0x91, 0x26, 0x62, 0x14, 0xa9, 0x1b, 0x12, 0x57, 0x91, 0xd4, 0x24, 0x22, 0x62,
+		0x69, 0x41, 0x30, 0x31, 0x96, 0x86, 0x62, 0x2c, 0xad, 0x1b, 0x4f, 0x8c, 0xa5, 0xa9, 0xc9, 0x64,
+		0xa8, 0x05, 0xa7, 0x5d, 0x10, 0x16, 0x5f, 0x7b, 0x8e, 0x12, 0x20, 0x97, 0x99, 0x40, 0x5c, 0xae,
+		0x16, 0x57, 0x6d, 0x66, 0x1c, 0xfe, 0x6b, 0xf4, 0x26, 0xcc, 0x7a, 0x1f, 0xde, 0x31, 0x2c, 0xd6,
+		0x67, 0x8f, 0x91, 0xcf, 0x16, 0xf9, 0x9f, 0x5d, 0x37, 0xac, 0x08, 0xd7, 0x7b, 0x2f, 0x28, 0xd3,
+		0x0e, 0xef, 0x25, 0xfa, 0x3f, 0x09, 0x4a, 0x21, 0x15, 0x55, 0xeb, 0x1a, 0xae, 0x56, 0x2c, 0xfc,
+		0x66, 0x13, 0xdb, 0x4c, 0xe9, 0x8f, 0x13, 0x18, 0xaf, 0x25, 0x6b, 0x2a, 0xe1, 0xa4, 0xf8, 0x8c,
+		0xa2, 0xb8, 0x16, 0x55, 0x61, 0x6a, 0xf4, 0x65, 0x09, 0xae, 0x51, 0x4c, 0x3e, 0x44, 0x31, 0x25,
+		0x9e, 0x24, 0x68, 0x57, 0x99, 0x68, 0xe9, 0xd7, 0xbc, 0x4f, 0x8b, 0x68, 0x74, 0xd1, 0x4a, 0x35,
+		0x03, 0xfd, 0x93, 0x04, 0x17, 0x58, 0xcb, 0xcb, 0x02, 0x7a, 0x42, 0x50, 0xbb, 0x57, 0x29, 0x87,
+		0x04, 0xed, 0xe6, 0x90, 0xa1, 0xbf, 0x81, 0x33, 0x9e, 0x92, 0xf1, 0x91, 0x4c, 0x11, 0x24, 0x57,
+		0xf8, 0x7a, 0xc6, 0x87, 0x30, 0xeb, 0xc4, 0xbc, 0x47, 0x7f, 0x2f, 0xc1, 0x39, 0xba, 0x79, 0x54,
+		0xd1, 0x39, 0x9b, 0x36, 0x4d, 0x10, 0xbc, 0xcc, 0x44, 0xe0, 0x31, 0xf7, 0xf4, 0x9d, 0xb3, 0x4d,
+		0x73, 0x5a, 0x02, 0x0d, 0x7a, 0x0b, 0xe6, 0x6a, 0xaa, 0xb5, 0x87, 0xad, 0x8a, 0x85, 0x35, 0xd3,
+		0xd2, 0x59, 0x20, 0x4e, 0x12, 0x10, 0xcb, 0x4c, 0x10, 0xaf, 0x93, 0xc9, 0x0a, 0x9d, 0x1b, 0x45,
+		0x70, 0xaa, 0x16, 0x47, 0x80, 0xfe, 0x53, 0x82, 0x25, 0x56, 0x7e, 0x62, 0x3c, 0xa9, 0xab, 0xcc,
+		0x05, 0x99, 0x49, 0x13, 0xbe, 0x3e, 0xa4, 0x6c, 0x44, 0xc2, 0x57, 0x0e, 0x2d, 0xfa, 0x5f, 0x09,
+		0x8a, 0x0c, 0x84, 0x0e, 0xb6, 0x6a, 0x46, 0x5d, 0x65, 0xfa, 0x85, 0xd9, 0x18, 0xbf, 0x10, 0x0d,
+		0xb1, 0xdb, 0x8c, 0x18, 0x7e, 0xa1, 0x25, 0x4c, 0x8d, 0xbe, 0x22, 0xc1, 0x35, 0x56, 0x2a, 0x95,
+		0xe8, 0xc5, 0x4e, 0x11, 0xb4, 0x77, 0x05, 0x33, 0xaa, 0x24, 0x57, 0x56, 0x6a, 0xa5, 0x9b, 0xc2,
+		0xd3, 0x00, 0xbe, 0x51, 0x9e, 0x4e, 0xa3, 0x01, 0x7c, 0x03, 0x5d, 0x68, 0x09, 0xd2, 0xa2, 0x9f,
+		0x4b, 0xb0, 0x16, 0xf2, 0xb8, 0xf8, 0xa9, 0x83, 0xad, 0xba, 0x5a, 0xad, 0x30, 0x90, 0x1b, 0x75,
+		0xc3, 0x31, 0xd8, 0x8a, 0x71, 0x86, 0x40, 0x7f, 0x98, 0xec, 0x82, 0xd7, 0x28, 0xff, 0x88, 0x3c,
+		0x65, 0x9f, 0x79, 0x54, 0xa0, 0xdb, 0xd6, 0xa1, 0x38, 0xa0, 0x9f, 0x48, 0x70, 0x27, 0x85, 0x98,
+		0x3c, 0x8f, 0x35, 0x47, 0x64, 0xdc, 0x3c, 0x84, 0x8c, 0x3c, 0x67, 0x76, 0xcb, 0xea, 0x7d, 0x3a,
+		0xfa, 0x58, 0x82, 0x57, 0xe3, 0xc4, 0x49, 0xb6, 0x93, 0x79, 0x22, 0xd8, 0x06, 0x53, 0x30, 0x2e,
+		0x98, 0x44, 0x7b, 0xb9, 0x81, 0x7b, 0x9b, 0x4a, 0xe2, 0x00, 0x96, 0x1c, 0x66, 0xdd, 0x31, 0xea,
+		0x4d, 0xac, 0x57, 0x54, 0xbb, 0x52, 0xc7, 0xad, 0xa8, 0x1c, 0x72, 0x4c, 0x1c, 0x10, 0x05, 0xe1,
+		0xb3, 0x5b, 0xb1, 0xef, 0xe3, 0x56, 0x14, 0x7e, 0xb1, 0x95, 0x6a, 0x06, 0xfa, 0xb6, 0x04, 0x37,
+		0x49, 0x34, 0x59, 0xd1, 0x76, 0x8d, 0xaa, 0x9e, 0xd2, 0x7e, 0xce, 0x12, 0xe8, 0xf7, 0x98, 0xd0,
+		0x49, 0x28, 0xb9, 0xea, 0x32, 0x4d, 0x63, 0x34, 0x57, 0xed, 0xf4, 0xd3, 0xd0, 0xd7, 0x25, 0xb8,
+		0x9e, 0x20, 0x04, 0xcf, 0x3a, 0xce, 0x11, 0x09, 0xd6, 0xd2, 0x4a, 0xc0, 0x33, 0x89, 0xcb, 0x76,
+		0xca, 0x39, 0xe8, 0x0b, 0x12, 0x5c, 0xe1, 0xa2, 0xe6, 0xc6, 0xf9, 0x2f, 0x12, 0xd8, 0x2b, 0xec,
+		0x30, 0x84, 0xf9, 0x75, 0x6e, 0xe0, 0xbf, 0xa4, 0xa5, 0xa0, 0x47, 0x5f, 0x92, 0xe0, 0x2a, 0x17,
+		0x6e, 0x4c, 0x12, 0x79, 0x3e, 0x46, 0xc9, 0xd9, 0x80, 0x63, 0xd2, 0xc9, 0xa2, 0x96, 0x6a, 0x06,
+		0xfa, 0x50, 0x82, 0xcb, 0xa9, 0x35, 0xe3, 0x02, 0x41, 0xfc, 0x67, 0x29, 0x10, 0xf3, 0x94, 0xe2,
+		0xa2, 0x96, 0x42, 0x1f, 0x3e, 0x92, 0x60, 0x99, 0xbf, 0xc0, 0xdc, 0x43, 0x78, 0x81, 0xa0, 0xbd,
+		0x93, 0x66, 0x7d, 0xb9, 0x27, 0xf1, 0x25, 0x2d, 0xcd, 0x04, 0xf4, 0xc5, 0x38, 0x95, 0x88, 0x49,
+		0x9a, 0x5f, 0x4a, 0x0d, 0x99, 0x9f, 0x3e, 0x5f, 0xd2, 0xd2, 0x4c, 0x20, 0xb1, 0x19, 0x1f, 0x72,
+		0x4c, 0x24, 0xb9, 0x18, 0x13, 0x9b, 0x71, 0x30, 0xc7, 0x84, 0x93, 0x25, 0x2d, 0xdd, 0x14, 0x72,
+		0x68, 0x7a, 0xa1, 0x78, 0xaf, 0x11, 0xcf, 0xc5, 0x98, 0x43, 0xd3, 0x8b, 0xb8, 0x7b, 0x09, 0x75,
+		0x6e, 0xd8, 0xbd, 0x4d, 0x45, 0xdf, 0x91, 0xe0, 0x15, 0x01, 0x81, 0x78, 0x36, 0xba, 0x44, 0xa4,
+		0x29, 0xf7, 0x22, 0x0d, 0xcf, 0x58, 0xaf, 0xd9, 0x3d, 0xcc, 0x43, 0x5f, 0x93, 0xe0, 0xe5, 0x38,
+		0x01, 0xf8, 0xf9, 0xd3, 0xa5, 0x98, 0x03, 0x88, 0x0b, 0x82, 0x9f, 0x47, 0x5d, 0xc6, 0x29, 0xe7,
+		0x10, 0x87, 0xd3, 0x6c, 0xd8, 0xd8, 0x72, 0x3a, 0xc0, 0x6d, 0xac, 0x5a, 0xda, 0x6e, 0x17, 0xcc,
+		0x28, 0xee, 0x62, 0x8c, 0xf5, 0x3e, 0x22, 0xec, 0x7c, 0x04, 0x0f, 0x09, 0xb3, 0xce, 0x17, 0x19,
+		0xd6, 0xdb, 0x4c, 0x33, 0x81, 0x57, 0xff, 0x69, 0xa8, 0x4d, 0x9b, 0xb5, 0xc2, 0xa5, 0x34, 0xf5,
+		0x9f, 0x4d, 0xc2, 0x44, 0xa4, 0xfe, 0xc3, 0xa4, 0xe4, 0x65, 0x4f, 0xcd, 0x3a, 0x0f, 0xdd, 0xe5,
+		0x34, 0xd9, 0xd3, 0xa3, 0x7a, 0x83, 0xf5, 0x55, 0x66, 0xf6, 0xc4, 0xa1, 0x25, 0x27, 0x38, 0x0b,
+		0x61, 0x43, 0x57, 0x1d, 0x5c, 0x51, 0x35, 0x0d, 0x37, 0x98, 0x9e, 0xe3, 0x4a, 0x9a, 0x30, 0xf5,
+		0x11, 0xe1, 0xb6, 0x42, 0x99, 0x89, 0x84, 0xa9, 0xb1, 0x33, 0x78, 0xc9, 0x34, 0x05, 0x1d, 0x13,
+		0x77, 0x2c, 0xa7, 0x49, 0xa6, 0x3d, 0x0c, 0x31, 0x81, 0x47, 0xa9, 0x95, 0x6e, 0x0a, 0xb9, 0xd2,
+		0x0e, 0x1b, 0x57, 0x0d, 0xd7, 0xcc, 0x28, 0xd0, 0xab, 0x31, 0x57, 0xda, 0x41, 0x7b, 0x7a, 0x1d,
+		0xd7, 0x4c, 0xc6, 0x95, 0x76, 0x33, 0x89, 0xe8, 0xce, 0x08, 0x40, 0xe7, 0x83, 0xf2, 0x7b, 0x23,
+		0x70, 0x41, 0x34, 0xea, 0x5b, 0x87, 0xd1, 0x36, 0x7c, 0xe7, 0xa0, 0x81, 0x49, 0x0d, 0x9d, 0x57,
+		0x91, 0xf7, 0x99, 0x6e, 0x1d, 0x34, 0xb0, 0x32, 0xd2, 0xea, 0x7a, 0x42, 0x7f, 0x05, 0xc7, 0x1b,
+		0xaa, 0xe5, 0x4a, 0xde, 0x7d, 0x58, 0xed, 0x98, 0xb4, 0xec, 0xbe, 0xc0, 0xe4, 0xb7, 0x49, 0x66,
+		0x74, 0x9d, 0x25, 0x3b, 0xa6, 0x72, 0xb4, 0x11, 0x1d, 0x44, 0xaf, 0x40, 0x9e, 0xdc, 0x64, 0x56,
+		0x0d, 0xdb, 0x21, 0x05, 0xf9, 0xc2, 0xf2, 0x29, 0xf6, 0x55, 0xa1, 0x6a, 0xef, 0x6d, 0x18, 0xb6,
+		0xa3, 0x0c, 0x3b, 0xf4, 0x2f, 0xb4, 0x0c, 0x83, 0x46, 0xbd, 0xd1, 0x74, 0x48, 0xb9, 0xbe, 0xb0,
+		0x3c, 0xcb, 0x41, 0x72, 0x50, 0x35, 0x55, 0x5d, 0xf1, 0x48, 0x91, 0x0a, 0x73, 0xa1, 0x50, 0xbd,
+		0xe2, 0x98, 0x15, 0xad, 0x6a, 0xda, 0x98, 0xc4, 0x3d, 0x66, 0xd3, 0xa1, 0xf5, 0xfb, 0xe9, 0x48,
+		0x3f, 0xc1, 0x5d, 0xda, 0x81, 0xa1, 0xcc, 0xe2, 0xc0, 0xda, 0x6f, 0x99, 0xab, 0xee, 0xfc, 0x2d,
+		0x6f, 0x3a, 0x7a, 0x03, 0x66, 0x3a, 0xe5, 0xa2, 0x28, 0xf7, 0x5c, 0x12, 0xf7, 0x13, 0x8e, 0x5f,
+		0x04, 0x0a, 0x31, 0xbe, 0x05, 0x27, 0x3b, 0x99, 0x69, 0x47, 0x0a, 0xab, 0x59, 0x77, 0x7b, 0x16,
+		0xdc, 0x92, 0x79, 0x5e, 0x39, 0xd1, 0xa6, 0x68, 0xaf, 0xb3, 0xd2, 0xac, 0x97, 0x75, 0x54, 0x86,
+		0x3c, 0x0d, 0x31, 0x4c, 0x8b, 0xd4, 0xaf, 0xc7, 0x96, 0x2f, 0xb2, 0x43, 0x22, 0xca, 0x80, 0xa4,
+		0x9e, 0x65, 0x7f, 0x8a, 0xd2, 0x99, 0x8d, 0xca, 0x30, 0xd1, 0xc1, 0xe1, 0x1e, 0xf3, 0x4d, 0x0b,
+		0x4f, 0xe5, 0x63, 0xf6, 0x60, 0xdd, 0xa3, 0x51, 0xc6, 0xdb, 0xd3, 0xe8, 0x08, 0x52, 0x60, 0xb2,
+		0xaa, 0xba, 0x77, 0x25, 0x9e, 0x31, 0x12, 0x71, 0xb0, 0xdd, 0xac, 0x3a, 0x53, 0x10, 0xc3, 0xcf,
+		0xdf, 0xd3, 0x63, 0xee, 0xdc, 0xd5, 0xf6, 0x54, 0x85, 0xcc, 0x44, 0x37, 0x61, 0xda, 0xb4, 0x8c,
+		0x27, 0x86, 0x17, 0xa0, 0x84, 0x56, 0xa9, 0x40, 0x56, 0x69, 0xd2, 0x27, 0x08, 0x2d, 0xd2, 0x49,
+		0x18, 0x36, 0x74, 0x5c, 0x77, 0x0c, 0xe7, 0x80, 0x54, 0x62, 0xf3, 0x4a, 0xfb, 0x19, 0x5d, 0x85,
+		0xc9, 0x1d, 0xc3, 0xb2, 0x9d, 0x28, 0xcf, 0x51, 0x42, 0x79, 0x94, 0xbc, 0x0d, 0x31, 0x5c, 0x85,
+		0x11, 0x0b, 0x3b, 0xd6, 0x41, 0xa5, 0x61, 0x56, 0x0d, 0xed, 0x80, 0x56, 0x2f, 0xe7, 0x38, 0x17,
+		0x3b, 0x8e, 0x75, 0xb0, 0x49, 0xe8, 0x94, 0x82, 0xd5, 0x79, 0x70, 0x5b, 0x56, 0x54, 0xc7, 0xc1,
+		0xb5, 0x86, 0x43, 0x2a, 0x8d, 0x83, 0x8a, 0xff, 0x88, 0x56, 0xe1, 0x08, 0x7e, 0xda, 0x30, 0x3c,
+		0xc5, 0xf1, 0x9a, 0x61, 0xc6, 0x13, 0x9b, 0x61, 0xc6, 0x3a, 0x53, 0xdc, 0x41, 0x74, 0x16, 0x46,
+		0x35, 0xcb, 0xb5, 0x06, 0x5a, 0x09, 0x25, 0x95, 0xba, 0xbc, 0x32, 0xe2, 0x0e, 0xfa, 0xd5, 0x51,
+		0xf4, 0xe7, 0x30, 0xe3, 0x49, 0x1f, 0xac, 0x1a, 0x6f, 0xab, 0xda, 0x9e, 0xb9, 0xb3, 0x33, 0x85,
+		0x92, 0x94, 0x7a, 0x8a, 0xcc, 0xee, 0x2e, 0x18, 0xdf, 0xf1, 0xa6, 0xa2, 0x4b, 0x30, 0xe0, 0x7a,
+		0x57, 0x5a, 0x06, 0x9b, 0x66, 0x5f, 0x90, 0xe3, 0x9a, 0xa9, 0x10, 0x32, 0xa4, 0xc0, 0x44, 0x24,
+		0xd2, 0xa1, 0xb5, 0xac, 0x17, 0xd9, 0x31, 0x65, 0x28, 0x32, 0x51, 0xc6, 0xed, 0xd0, 0x08, 0x7a,
+		0x04, 0x93, 0x0d, 0x0b, 0xef, 0x57, 0xd4, 0xa6, 0x63, 0xba, 0xfa, 0x87, 0x9d, 0x4a, 0xc3, 0x34,
+		0xea, 0x8e, 0x5f, 0x9d, 0xe2, 0xed, 0x97, 0x8d, 0x9d, 0x4d, 0x42, 0xa7, 0x1c, 0x75, 0xe7, 0xaf,
+		0x34, 0x1d, 0xb3, 0x6b, 0x10, 0x5d, 0x85, 0xdc, 0x2e, 0x56, 0x75, 0x6c, 0xd1, 0xb2, 0xd1, 0x0c,
+		0xbb, 0x19, 0x8a, 0x90, 0x28, 0x94, 0x14, 0x5d, 0x81, 0xe3, 0x16, 0xf6, 0xef, 0x10, 0x76, 0x2c,
+		0xb3, 0xe6, 0x6b, 0xd9, 0x09, 0xb2, 0x2b, 0xa8, 0xfd, 0x72, 0xdd, 0x32, 0x6b, 0x44, 0xc9, 0xe4,
+		0x0f, 0x25, 0x78, 0x49, 0x3c, 0xb1, 0xbe, 0x06, 0x39, 0x6a, 0x62, 0x92, 0x80, 0x89, 0x51, 0x5a,
+		0xb4, 0x0e, 0x73, 0xf1, 0x9d, 0x15, 0x86, 0x4e, 0x0e, 0x84, 0xac, 0x32, 0xcb, 0x6f, 0x8a, 0x28,
+		0xeb, 0xf2, 0x07, 0x12, 0x9c, 0x17, 0x8c, 0xcf, 0xaf, 0xc3, 0x90, 0xef, 0x5c, 0x24, 0x01, 0xe7,
+		0xe2, 0x13, 0xf7, 0x0d, 0xaa, 0x09, 0x0b, 0xc2, 0xc9, 0xe9, 0x2a, 0x8c, 0x50, 0xff, 0xde, 0x39,
+		0x6b, 0xc7, 0x38, 0x7a, 0x43, 0xdd, 0x39, 0x39, 0x6a, 0x0b, 0x4e, 0xe7, 0x41, 0xfe, 0x81, 0x04,
+		0xe7, 0x44, 0xfa, 0x73, 0x82, 0x87, 0xa6, 0x94, 0xee, 0xd0, 0xbc, 0x0f, 0x93, 0x9c, 0x83, 0x29,
+		0x93, 0x64, 0xc3, 0x47, 0x6d, 0xc6, 0xa1, 0xd4, 0xe5, 0x9c, 0xb2, 0x01, 0xe7, 0x24, 0xbf, 0x23,
+		0x81, 0x9c, 0xdc, 0xda, 0x83, 0x96, 0x00, 0x85, 0xdb, 0x3d, 0xda, 0x0d, 0x7f, 0xe3, 0x76, 0x60,
+		0x09, 0x42, 0x1e, 0x3a, 0x13, 0xf2, 0xd0, 0xa7, 0x00, 0xfc, 0xbb, 0x77, 0x43, 0x27, 0x68, 0xf2,
+		0x4a, 0x9e, 0x8e, 0x94, 0x75, 0xf9, 0x57, 0xa1, 0xe5, 0xe5, 0x5a, 0x48, 0x3a, 0x44, 0x0b, 0x30,
+		0x1e, 0xbc, 0xf2, 0x6b, 0xab, 0xd7, 0x98, 0xdd, 0x25, 0x71, 0x08, 0x7b, 0x36, 0x84, 0xfd, 0x02,
+		0x1c, 0xd9, 0x36, 0xea, 0xaa, 0x75, 0x50, 0xd1, 0x76, 0xb1, 0xb6, 0x67, 0x37, 0x6b, 0x24, 0xaa,
+		0xc9, 0x2b, 0x63, 0xde, 0xf0, 0x2a, 0x1d, 0x45, 0x17, 0x61, 0x22, 0x78, 0x51, 0x8d, 0x9f, 0x7a,
+		0x11, 0xcb, 0x88, 0x32, 0x8e, 0xbb, 0xef, 0x8f, 0xf1, 0x53, 0x47, 0x7e, 0x3b, 0x0b, 0x67, 0x05,
+		0xba, 0x86, 0x9e, 0x99, 0xc4, 0x61, 0xb3, 0xc8, 0xf6, 0x60, 0x16, 0xe8, 0x34, 0x14, 0xb6, 0x55,
+		0x1b, 0xfb, 0x7e, 0xd0, 0x5b, 0x96, 0xbc, 0x3b, 0xe4, 0x9d, 0xb1, 0xb3, 0x00, 0xee, 0x1d, 0x3d,
+		0x7d, 0x3d, 0xe8, 0x2d, 0x6c, 0x1d, 0xb7, 0xbc, 0xb7, 0x4b, 0x80, 0x76, 0x4c, 0x6b, 0x8f, 0x22,
+		0xf5, 0x5b, 0x3f, 0x73, 0x9e, 0x68, 0xee, 0x1b, 0x82, 0xf5, 0xb1, 0x37, 0x8e, 0x26, 0x5d, 0xe7,
+		0xa8, 0xda, 0x66, 0x9d, 0x86, 0x53, 0xf4, 0x09, 0xdd, 0x85, 0x41, 0xcd, 0xcd, 0xcc, 0x68, 0xe4,
+		0x54, 0x14, 0xee, 0xcf, 0x5a, 0x75, 0x67, 0x29, 0xde, 0x64, 0xf9, 0x83, 0x2c, 0xcc, 0x27, 0xf6,
+		0x4c, 0x3d, 0xb3, 0xcd, 0xb8, 0xe3, 0xcb, 0xe0, 0xed, 0xc2, 0x92, 0x60, 0x4b, 0x57, 0xb7, 0x04,
+		0xdd, 0x3e, 0x79, 0x20, 0x8d, 0x4f, 0xee, 0x56, 0xfd, 0xc1, 0x90, 0xea, 0x87, 0xf6, 0x37, 0x17,
+		0xbf, 0xbf, 0x43, 0x42, 0xfb, 0x3b, 0xcc, 0xd9, 0x5f, 0x86, 0x99, 0xe5, 0x59, 0x66, 0x26, 0xff,
+		0x47, 0x0e, 0xce, 0x89, 0xb4, 0x93, 0xa1, 0x33, 0x50, 0x68, 0xf7, 0x64, 0xd0, 0x6d, 0xca, 0x2b,
+		0xe0, 0x0f, 0x95, 0x75, 0x37, 0x0f, 0x6b, 0x13, 0x10, 0x23, 0xc8, 0xc4, 0xe4, 0x61, 0xed, 0x4f,
+		0x92, 0x3c, 0x4c, 0xed, 0x7a, 0x72, 0x55, 0x53, 0x37, 0x6b, 0xaa, 0x51, 0xa7, 0xbe, 0x83, 0x3e,
+		0x05, 0x0f, 0x83, 0x81, 0x1e, 0x33, 0xa8, 0x9c, 0x78, 0x06, 0xb5, 0x05, 0xd3, 0xbe, 0x12, 0x46,
+		0xcf, 0x90, 0xa1, 0xa4, 0x33, 0x64, 0xd2, 0x9f, 0x1b, 0x3a, 0x46, 0x42, 0x5c, 0xe9, 0x11, 0x45,
+		0xb9, 0x0e, 0xa7, 0xe0, 0xea, 0x25, 0x4e, 0x94, 0x2b, 0xff, 0xb0, 0xcb, 0xf7, 0x74, 0xd8, 0xad,
+		0xc3, 0xc4, 0x2e, 0x56, 0x2d, 0x67, 0x1b, 0xab, 0x1d, 0x74, 0x90, 0xc4, 0x6a, 0xbc, 0x3d, 0xa7,
+		0xc3, 0x27, 0x39, 0x44, 0x29, 0x24, 0x87, 0x28, 0x91, 0xf4, 0x62, 0xa4, 0x97, 0xf4, 0xa2, 0x13,
+		0xa6, 0x8e, 0x0a, 0x87, 0xa9, 0xf2, 0x2f, 0x24, 0x90, 0x93, 0x5b, 0x1b, 0x3f, 0xb5, 0xc3, 0xbd,
+		0x3b, 0x0c, 0x19, 0x08, 0xe6, 0x48, 0xaf, 0xc1, 0x08, 0x49, 0x31, 0x7d, 0xbf, 0x35, 0x28, 0xe0,
+		0xb7, 0x0a, 0xee, 0x0c, 0xfa, 0x20, 0xff, 0x48, 0x0a, 0xba, 0x82, 0x3e, 0x47, 0xd6, 0xec, 0x25,
+		0xca, 0xa4, 0x70, 0xf7, 0xd9, 0xc4, 0x68, 0x63, 0x20, 0xb8, 0x98, 0xf2, 0x0f, 0x25, 0x98, 0x4f,
+		0xee, 0x37, 0xeb, 0x35, 0x00, 0xff, 0x2c, 0x24, 0xfa, 0x46, 0x06, 0xce, 0x0a, 0x74, 0x6d, 0xba,
+		0x32, 0xe9, 0xd8, 0x51, 0x8d, 0xaa, 0x2d, 0xb4, 0x49, 0x3e, 0xf1, 0x33, 0x93, 0x29, 0x1c, 0x21,
+		0x0d, 0xf4, 0x12, 0x21, 0x1d, 0x5a, 0xc5, 0xff, 0x59, 0x82, 0x45, 0xf1, 0x66, 0x4b, 0x91, 0x33,
+		0xaf, 0x3f, 0x29, 0xd8, 0x47, 0x12, 0xa4, 0x6c, 0xab, 0x4c, 0xc6, 0x76, 0xcc, 0x0f, 0x83, 0x3c,
+		0x0f, 0xe3, 0x3d, 0x08, 0x21, 0xce, 0x0a, 0x20, 0x7e, 0x3f, 0xa4, 0x87, 0xbc, 0x02, 0x6c, 0xaf,
+		0x7a, 0xb8, 0x0e, 0x73, 0x55, 0xd5, 0xe9, 0x6a, 0x2f, 0x0a, 0x37, 0xdb, 0x74, 0x56, 0xd6, 0xa3,
+		0x63, 0x6d, 0xa5, 0x17, 0x36, 0x31, 0xf4, 0x39, 0x9b, 0x42, 0x9f, 0x07, 0x12, 0x6d, 0x34, 0x14,
+		0xe8, 0xc9, 0x1f, 0x4b, 0x30, 0x13, 0xd3, 0xd0, 0xec, 0xfe, 0xe0, 0xcb, 0x6b, 0xe4, 0x6c, 0xef,
+		0xdb, 0x10, 0x79, 0x2e, 0xeb, 0x68, 0x03, 0x8e, 0xb7, 0x0f, 0xf2, 0x1d, 0xc3, 0x4a, 0x91, 0xb4,
+		0x22, 0x7a, 0x8e, 0xbb, 0x0d, 0xcb, 0x69, 0x8e, 0x5f, 0x91, 0xcd, 0xfe, 0x6b, 0x98, 0xe6, 0x76,
+		0x4a, 0xc7, 0x49, 0x23, 0x1c, 0xb3, 0xcb, 0xdf, 0x95, 0x60, 0x36, 0xae, 0x49, 0xb6, 0x2f, 0x5f,
+		0xe9, 0xd7, 0x7a, 0xc4, 0x3a, 0xe8, 0xaf, 0x4a, 0x30, 0x97, 0xd4, 0x6c, 0x1b, 0x27, 0xcd, 0x33,
+		0x35, 0xdb, 0x58, 0xe4, 0xbf, 0x1b, 0x82, 0x94, 0x3d, 0x5d, 0xa8, 0x04, 0xc7, 0x48, 0xdb, 0x58,
+		0xf8, 0xa6, 0xd8, 0x93, 0x69, 0xa2, 0x8e, 0x5b, 0xa1, 0x7b, 0xe2, 0x48, 0xb1, 0x26, 0xd3, 0x5b,
+		0xb1, 0xe6, 0x79, 0x39, 0x45, 0xbc, 0x9c, 0x22, 0xa2, 0x3b, 0x43, 0x02, 0xba, 0xf3, 0x00, 0x26,
+		0xe9, 0x35, 0x38, 0xc5, 0x68, 0xd4, 0x1d, 0x6c, 0xed, 0xab, 0xd5, 0xe4, 0xbc, 0xe5, 0x18, 0x9d,
+		0x48, 0xe0, 0x95, 0xe9, 0xb4, 0x60, 0xa9, 0x26, 0x7f, 0xa8, 0x52, 0x4d, 0x57, 0x08, 0x07, 0x69,
+		0x42, 0x38, 0x7e, 0x5d, 0xa6, 0xd0, 0x73, 0x5d, 0xa6, 0x93, 0x67, 0x8c, 0x88, 0x5f, 0x87, 0xfb,
+		0xd5, 0x81, 0xd1, 0x43, 0x54, 0x07, 0xc6, 0x0e, 0x55, 0x1d, 0x70, 0x7d, 0x70, 0x29, 0x6d, 0x63,
+		0x69, 0xdb, 0x5b, 0x49, 0xdd, 0xde, 0x2a, 0x2e, 0xbf, 0xd9, 0x86, 0x13, 0xed, 0x66, 0x94, 0x50,
+		0xa1, 0xd5, 0xb3, 0xe3, 0xc5, 0xd8, 0x76, 0x93, 0x60, 0xa9, 0xf5, 0x38, 0x66, 0x0d, 0xcb, 0xff,
+		0x2f, 0xc1, 0x02, 0x47, 0x12, 0x56, 0xfd, 0x38, 0xd9, 0x3c, 0x24, 0x01, 0xf3, 0xe8, 0x8a, 0x74,
+		0x32, 0x29, 0x22, 0x1d, 0xf9, 0x6f, 0x19, 0x85, 0x02, 0x76, 0xff, 0x46, 0xe7, 0xd2, 0x4e, 0x0a,
+		0x5c, 0xda, 0x1d, 0xe2, 0xae, 0xf8, 0x2d, 0xc6, 0x42, 0xf1, 0x9a, 0x33, 0x9e, 0xc1, 0xe7, 0x7f,
+		0x2a, 0x41, 0xca, 0xfe, 0x0c, 0x34, 0x03, 0x79, 0xda, 0x59, 0xd1, 0x3e, 0x67, 0x86, 0xbd, 0x81,
+		0xb2, 0xee, 0x06, 0xc5, 0xf4, 0x65, 0x5d, 0xad, 0xf9, 0x47, 0x28, 0x78, 0x43, 0xf7, 0xd5, 0x1a,
+		0xee, 0xf8, 0xfe, 0xac, 0xb8, 0xef, 0x8f, 0x39, 0x33, 0x43, 0xf2, 0x0d, 0x86, 0xe5, 0xfb, 0x35,
+		0xcb, 0xa4, 0x12, 0xda, 0x32, 0xfa, 0xa5, 0x8f, 0x81, 0x85, 0xca, 0x84, 0x16, 0xaa, 0x93, 0xc2,
+		0x67, 0x53, 0xa4, 0xf0, 0x67, 0x61, 0x14, 0x5b, 0x96, 0x69, 0x55, 0x6a, 0xd8, 0xb6, 0xd5, 0x27,
+		0x98, 0x2e, 0xc7, 0x08, 0x19, 0x7c, 0xdd, 0x1b, 0x93, 0x3f, 0x91, 0xe0, 0x54, 0xec, 0x0f, 0x7d,
+		0xdc, 0x5d, 0xa2, 0x3f, 0x23, 0x22, 0xbb, 0x44, 0x53, 0x17, 0x6f, 0x88, 0xec, 0x52, 0x8f, 0xa6,
+		0xd4, 0xb7, 0x28, 0xa9, 0xe3, 0xc1, 0x07, 0xc4, 0x6f, 0x8a, 0xfe, 0x8d, 0xe5, 0x74, 0x78, 0x8d,
+		0x6d, 0x67, 0xa0, 0x40, 0x5b, 0x0b, 0xbb, 0x97, 0xc0, 0x1b, 0x0a, 0x2a, 0x6a, 0xa6, 0x37, 0x45,
+		0x0d, 0xd5, 0x5d, 0xe4, 0x7f, 0x91, 0x60, 0x31, 0x45, 0x33, 0x27, 0xcf, 0xd6, 0x7b, 0xdd, 0x99,
+		0x38, 0x68, 0xdf, 0xca, 0xc0, 0xed, 0xc3, 0xfd, 0xa0, 0xa5, 0x6f, 0x36, 0xd3, 0xb9, 0x7b, 0xce,
+		0x04, 0xee, 0x9e, 0x1f, 0x01, 0x8a, 0x76, 0x78, 0x51, 0xd3, 0x39, 0x2f, 0xd6, 0xbf, 0xa5, 0x4c,
+		0x44, 0xfa, 0xb3, 0xdc, 0xcb, 0x3c, 0xcd, 0xac, 0x3b, 0x96, 0x59, 0x25, 0x8a, 0x36, 0xa2, 0xf8,
+		0x8f, 0xa8, 0x08, 0x47, 0x43, 0x3d, 0xc0, 0x66, 0xbd, 0xea, 0x65, 0x9a, 0xc3, 0xca, 0x44, 0xa0,
+		0x35, 0xf7, 0x41, 0xbd, 0x7a, 0x20, 0xbf, 0x97, 0x85, 0x5b, 0x87, 0xf8, 0xc1, 0x0c, 0x7a, 0xd4,
+		0x7d, 0x8e, 0x8f, 0x71, 0x7e, 0x8e, 0x26, 0xc4, 0x39, 0x50, 0x46, 0xe9, 0xd3, 0xfd, 0x08, 0xb7,
+		0x26, 0xc0, 0xde, 0x97, 0x81, 0xc3, 0xee, 0xcb, 0x12, 0xa0, 0x70, 0x9b, 0x32, 0xf5, 0xee, 0x59,
+		0x65, 0xdc, 0x08, 0x28, 0xa1, 0x77, 0x25, 0xeb, 0xef, 0x62, 0x2e, 0xb0, 0x8b, 0xf2, 0x8f, 0x25,
+		0xb8, 0xd1, 0xe3, 0xaf, 0x7d, 0x38, 0x18, 0x24, 0x0e, 0x86, 0x4f, 0x57, 0x71, 0xe5, 0x7f, 0xcc,
+		0xc2, 0x8d, 0x1e, 0x3b, 0xb2, 0xff, 0x58, 0x6d, 0x35, 0xe4, 0xb1, 0x07, 0xf8, 0x1e, 0x7b, 0x50,
+		0xdc, 0x63, 0x73, 0x55, 0x87, 0xe7, 0x00, 0x86, 0x78, 0x0e, 0xe0, 0xed, 0x2c, 0x5c, 0xeb, 0xa5,
+		0xab, 0x5c, 0xcc, 0xf2, 0x85, 0x38, 0x3f, 0xb7, 0xfc, 0x8e, 0xe5, 0xff, 0x52, 0x82, 0xcb, 0x69,
+		0x3b, 0xe4, 0xff, 0xa0, 0x4d, 0x9e, 0x7f, 0x56, 0xc9, 0xdf, 0x97, 0xe0, 0x52, 0xaa, 0xae, 0xfa,
+		0xbe, 0xb9, 0x00, 0x66, 0x16, 0x9c, 0x39, 0x5c, 0x16, 0xfc, 0xaf, 0x12, 0xcc, 0x27, 0xf6, 0x34,
+		0xf7, 0x4d, 0x02, 0x3f, 0xed, 0xcf, 0x08, 0xa5, 0xfd, 0xf2, 0xcf, 0x86, 0xe1, 0x6a, 0x0f, 0xbf,
+		0x5d, 0xec, 0xd2, 0x15, 0x29, 0xa0, 0x2b, 0x67, 0xa0, 0xd0, 0xd6, 0x95, 0x76, 0x96, 0x00, 0xfe,
+		0x10, 0xeb, 0xbe, 0x2e, 0xdb, 0x87, 0xfb, 0xba, 0x5e, 0x8b, 0xf7, 0x83, 0xfd, 0xbd, 0xaf, 0xcb,
+		0x3d, 0xd3, 0xfb, 0xba, 0xa1, 0x9e, 0xef, 0xeb, 0x1e, 0x03, 0xed, 0x20, 0xa7, 0x1c, 0x69, 0xcd,
+		0xdb, 0xeb, 0xc8, 0x39, 0x1f, 0xd3, 0x86, 0x4e, 0xb8, 0xd0, 0xca, 0xf7, 0x44, 0x23, 0x3c, 0xd4,
+		0x6d, 0xc1, 0xf9, 0xe0, 0x61, 0x23, 0xa2, 0xcd, 0x20, 0xa0, 0xcd, 0x1a, 0x4c, 0x75, 0xa9, 0x53,
+		0xc5, 0xc2, 0xcd, 0x0e, 0xfc, 0x02, 0x81, 0xbf, 0x18, 0xab, 0x38, 0x65, 0x5d, 0xc1, 0x4d, 0x1f,
+		0xaf, 0x72, 0xbc, 0xc5, 0x1a, 0x8e, 0xf4, 0x02, 0x8c, 0xf6, 0xd2, 0x0b, 0x10, 0xe9, 0x05, 0x1e,
+		0x63, 0xf4, 0x02, 0x77, 0xd2, 0xc0, 0x23, 0xe9, 0x2f, 0xf2, 0xc6, 0x0f, 0x71, 0x91, 0x37, 0x71,
+		0xb8, 0x36, 0xdf, 0x57, 0xa0, 0xa0, 0xe3, 0xaa, 0x7a, 0xe0, 0xa9, 0x66, 0x72, 0xcf, 0x32, 0x10,
+		0x6a, 0xa2, 0x8a, 0xf2, 0xbb, 0x59, 0xb8, 0x9c, 0xf6, 0xb7, 0xc5, 0x9f, 0xbd, 0x7b, 0xd9, 0xf0,
+		0x83, 0x18, 0xaf, 0xac, 0x7c, 0x3d, 0xf5, 0x0f, 0x63, 0x03, 0xb1, 0x4b, 0x97, 0xa1, 0x0c, 0x06,
+		0x0d, 0x85, 0x7d, 0x42, 0xe7, 0x38, 0x27, 0x74, 0x9f, 0x2e, 0xde, 0xe5, 0xef, 0x65, 0x60, 0x29,
+		0xcd, 0x0f, 0xa7, 0xb9, 0xfb, 0xc1, 0x0e, 0x0d, 0x32, 0x87, 0x0d, 0x0d, 0xfa, 0xb5, 0x8b, 0xec,
+		0xd5, 0x1d, 0xe0, 0xac, 0x6e, 0xc7, 0x3a, 0x07, 0xc5, 0x2f, 0x69, 0x3e, 0xc9, 0x40, 0xca, 0x9f,
+		0x74, 0x7f, 0x3e, 0x16, 0x93, 0x55, 0x43, 0x1d, 0x64, 0xd6, 0x50, 0x3b, 0x37, 0x87, 0x39, 0xf1,
+		0x9b, 0x43, 0xf9, 0x37, 0x19, 0xb8, 0xd8, 0x0f, 0x8f, 0xf2, 0x39, 0x5d, 0xf4, 0xae, 0xf2, 0x56,
+		0x2e, 0x45, 0x79, 0x4b, 0xfe, 0x6d, 0x06, 0x2e, 0xa5, 0xfa, 0x85, 0xfd, 0xf3, 0x85, 0x8f, 0x2c,
+		0xbc, 0x7f, 0xdf, 0x99, 0x4b, 0x53, 0xd4, 0xf9, 0xbb, 0x2c, 0x6f, 0xe1, 0x79, 0x0d, 0x5b, 0xcf,
+		0x17, 0x3e, 0xb6, 0x5f, 0x2c, 0xd7, 0xcb, 0x0f, 0x4d, 0xbe, 0x99, 0x81, 0x52, 0xca, 0xff, 0x7c,
+		0xf0, 0x7c, 0x1f, 0x02, 0xfb, 0xb0, 0xe8, 0xc0, 0x11, 0xf2, 0xe7, 0xba, 0x51, 0x75, 0xb0, 0x45,
+		0x3e, 0x75, 0x0a, 0xa6, 0xd7, 0x1e, 0xaf, 0xdd, 0xdf, 0xaa, 0xac, 0x97, 0x37, 0xb6, 0xd6, 0x94,
+		0xca, 0xd6, 0x5f, 0x6c, 0xae, 0x55, 0xca, 0xf7, 0x1f, 0xaf, 0x6c, 0x94, 0xef, 0x8e, 0xbf, 0x80,
+		0xce, 0xc0, 0x4c, 0xf4, 0xf5, 0xca, 0xc6, 0x46, 0x85, 0x8c, 0x8e, 0x4b, 0x68, 0x1e, 0x4e, 0x45,
+		0x09, 0x56, 0x37, 0x1e, 0x3c, 0x5c, 0xa3, 0x24, 0x99, 0x3b, 0x8f, 0xe1, 0x84, 0x66, 0xd6, 0x58,
+		0x6b, 0x70, 0x67, 0x78, 0xa5, 0x61, 0x6c, 0x5a, 0xa6, 0x63, 0x6e, 0x4a, 0x7f, 0x59, 0x7a, 0x62,
+		0x38, 0xbb, 0xcd, 0xed, 0xa2, 0x66, 0xd6, 0x4a, 0x81, 0xff, 0xdf, 0x5d, 0x7c, 0x82, 0xeb, 0xde,
+		0x7f, 0x0c, 0xa7, 0xff, 0xca, 0xfb, 0x96, 0xda, 0x30, 0xf6, 0xaf, 0x6c, 0xe7, 0xc8, 0xd8, 0xd5,
+		0xdf, 0x0f, 0x00, 0x02, 0x07, 0xff, 0x35, 0xad, 0x5c, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/queue.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xdf, 0x72, 0xd3, 0xc6,
-		0x17, 0x46, 0x76, 0x12, 0x92, 0xe3, 0xfc, 0xf8, 0x89, 0xa5, 0x10, 0x13, 0x9a, 0xc1, 0x98, 0x19,
-		0xf0, 0xa4, 0x41, 0xae, 0xc3, 0x30, 0x74, 0xda, 0x4e, 0x3b, 0x42, 0x56, 0xe2, 0x2d, 0x46, 0xce,
-		0xac, 0xe4, 0x04, 0xb8, 0xd1, 0xc8, 0xd6, 0x26, 0xd1, 0xc4, 0x96, 0x8c, 0xb4, 0x0a, 0xf5, 0x7d,
-		0x61, 0x7a, 0xd9, 0x4e, 0xdf, 0xa0, 0xef, 0xd0, 0x8b, 0xbe, 0x42, 0x1f, 0xa0, 0x37, 0xbc, 0x4c,
-		0x47, 0x2b, 0x39, 0xfe, 0xff, 0x27, 0xd0, 0xcb, 0xde, 0xd9, 0x67, 0xbf, 0x73, 0xf6, 0x9c, 0xf3,
-		0x7d, 0x7b, 0x76, 0x05, 0xf9, 0xb0, 0x41, 0xfd, 0x62, 0xd3, 0xb2, 0xa9, 0xdb, 0xa4, 0xc5, 0xe0,
-		0xd4, 0xf2, 0xa9, 0x5d, 0x3c, 0x2f, 0x15, 0xdf, 0x84, 0x34, 0xa4, 0x52, 0xc7, 0xf7, 0x98, 0x87,
-		0x6e, 0x45, 0x18, 0x29, 0xc1, 0x48, 0x31, 0x46, 0x3a, 0x2f, 0x6d, 0xde, 0x3d, 0xf1, 0xbc, 0x93,
-		0x16, 0x2d, 0x72, 0x54, 0x23, 0x3c, 0x2e, 0x32, 0xa7, 0x4d, 0x03, 0x66, 0xb5, 0x3b, 0xb1, 0xe3,
-		0x66, 0x6e, 0x28, 0xb8, 0xd5, 0x71, 0xa2, 0xc8, 0x4d, 0xaf, 0xdd, 0xf6, 0xdc, 0x04, 0x71, 0x6f,
-		0x12, 0xe2, 0xd4, 0x09, 0x98, 0xe7, 0x77, 0x63, 0x48, 0xfe, 0x43, 0x0a, 0x3e, 0x53, 0x7c, 0x2f,
-		0x08, 0x94, 0x56, 0x18, 0x30, 0xea, 0x1b, 0x56, 0x70, 0x86, 0xdd, 0x63, 0x0f, 0xdd, 0x81, 0x35,
-		0xdb, 0x6b, 0x5b, 0x8e, 0x6b, 0x3a, 0x76, 0x56, 0xc8, 0x09, 0x85, 0x35, 0xb2, 0x1a, 0x1b, 0xb0,
-		0x8d, 0xea, 0x80, 0xde, 0x7a, 0xfe, 0xd9, 0x71, 0xcb, 0x7b, 0x6b, 0xd2, 0x1f, 0x69, 0x33, 0x64,
-		0x8e, 0xe7, 0x66, 0x53, 0x39, 0xa1, 0x90, 0xd9, 0x7d, 0x20, 0x0d, 0x15, 0x64, 0x75, 0x1c, 0xe9,
-		0xbc, 0x24, 0x1d, 0x25, 0x70, 0xb5, 0x87, 0x26, 0xd7, 0xdf, 0x8e, 0x9a, 0x10, 0x86, 0x35, 0x66,
-		0x05, 0x67, 0x26, 0xeb, 0x76, 0x68, 0x36, 0x9d, 0x13, 0x0a, 0xd7, 0x76, 0x77, 0xa4, 0xc9, 0xed,
-		0x91, 0x46, 0x93, 0x36, 0xba, 0x1d, 0x4a, 0x56, 0x59, 0xf2, 0x0b, 0x6d, 0x01, 0xf0, 0x50, 0x01,
-		0xb3, 0x18, 0xcd, 0x2e, 0xe5, 0x84, 0xc2, 0x32, 0xe1, 0xc1, 0xf5, 0xc8, 0x80, 0x36, 0xe0, 0x2a,
-		0x5f, 0x76, 0xec, 0xec, 0x72, 0x4e, 0x28, 0xa4, 0xc9, 0x4a, 0xf4, 0x17, 0xdb, 0xa8, 0x0a, 0x37,
-		0xce, 0x9d, 0xc0, 0x69, 0x38, 0x2d, 0x87, 0x75, 0x8d, 0x5e, 0xc7, 0xb3, 0x2b, 0xbc, 0xb4, 0x4d,
-		0x29, 0xe6, 0x44, 0xea, 0x71, 0x22, 0x5d, 0x20, 0xc8, 0x24, 0xb7, 0xfc, 0xdf, 0x29, 0xf8, 0x72,
-		0x30, 0x51, 0x9d, 0x59, 0x3e, 0x53, 0x4e, 0x9d, 0x96, 0xdd, 0xef, 0x03, 0x7d, 0x13, 0xd2, 0x80,
-		0xc9, 0x8c, 0xf9, 0x4e, 0x23, 0x64, 0x34, 0x40, 0x05, 0x10, 0x99, 0xe5, 0x9f, 0x50, 0x66, 0x8e,
-		0x12, 0x70, 0x2d, 0xb6, 0x97, 0x7b, 0x34, 0x6c, 0x01, 0xf8, 0xb1, 0x7b, 0x84, 0x49, 0x71, 0xcc,
-		0x5a, 0x62, 0xc1, 0x36, 0xda, 0x01, 0xe4, 0xb8, 0x0e, 0x73, 0x2c, 0x46, 0x6d, 0x93, 0x9e, 0x53,
-		0x97, 0xc3, 0xd2, 0xbc, 0x5e, 0xf1, 0x62, 0x45, 0x8d, 0x16, 0xb0, 0x8d, 0xde, 0x0b, 0xb0, 0x39,
-		0x0a, 0xb7, 0x2e, 0xb2, 0xe2, 0x2d, 0xcc, 0xec, 0x56, 0x26, 0x92, 0xdb, 0x2f, 0x6b, 0x8c, 0x66,
-		0x3c, 0xb4, 0x4d, 0xbf, 0x4a, 0x92, 0x75, 0xa6, 0xac, 0xa0, 0x3c, 0xfc, 0x2f, 0xa9, 0xdf, 0x0f,
-		0xdd, 0x1e, 0x43, 0x6b, 0x24, 0x13, 0x1b, 0x49, 0xe8, 0x62, 0x3b, 0xff, 0x03, 0x94, 0xe6, 0xf6,
-		0x35, 0xe8, 0x78, 0x6e, 0x40, 0x07, 0x02, 0xdf, 0x84, 0x95, 0x24, 0x62, 0xdc, 0xce, 0x65, 0x9f,
-		0xc7, 0xfa, 0x33, 0x05, 0x3b, 0x83, 0xc1, 0x14, 0xcb, 0x6d, 0xd2, 0xd6, 0xbf, 0x42, 0x50, 0x03,
-		0x6e, 0x27, 0xc8, 0x4f, 0x3e, 0x2e, 0x1b, 0x71, 0xa0, 0xb1, 0x85, 0x11, 0x11, 0xa4, 0x17, 0x13,
-		0xc1, 0xd2, 0x14, 0x11, 0x48, 0x70, 0xa3, 0x19, 0xb5, 0xb1, 0x9f, 0xaf, 0xe7, 0xb6, 0xba, 0x9c,
-		0x81, 0x55, 0x72, 0xbd, 0x39, 0x48, 0x71, 0xcd, 0x6d, 0x75, 0xf3, 0x45, 0x78, 0x34, 0xb3, 0x75,
-		0xa3, 0x1c, 0xe4, 0xff, 0x48, 0x0f, 0x37, 0x5b, 0x77, 0x4e, 0x5c, 0xeb, 0xbf, 0x66, 0x2f, 0xd2,
-		0x6c, 0x74, 0x17, 0x32, 0x01, 0x6f, 0x97, 0xe9, 0x5a, 0x6d, 0xca, 0x67, 0xd2, 0x1a, 0x81, 0xd8,
-		0xa4, 0x59, 0x6d, 0x8a, 0xbe, 0x87, 0xf5, 0x04, 0xe0, 0xb8, 0x9d, 0x90, 0x65, 0xaf, 0xf2, 0xa2,
-		0x3f, 0x9f, 0x58, 0xf4, 0x81, 0xd5, 0x6d, 0x79, 0x96, 0x4d, 0x92, 0x90, 0x38, 0x72, 0x40, 0x59,
-		0xb8, 0xda, 0xf4, 0x5c, 0xe6, 0x7b, 0xad, 0xec, 0x6a, 0x4e, 0x28, 0xac, 0x93, 0xde, 0xdf, 0x51,
-		0xa2, 0xc7, 0x68, 0x1b, 0x23, 0xfa, 0xb7, 0x25, 0xd8, 0x18, 0x9d, 0xd1, 0x09, 0xbb, 0x17, 0x73,
-		0xde, 0x71, 0x8f, 0x3d, 0x4e, 0x66, 0x66, 0xf1, 0x39, 0x1f, 0x5d, 0x4e, 0xf1, 0x9c, 0xe7, 0xd7,
-		0xd4, 0x2f, 0x02, 0x6c, 0x05, 0xe3, 0xa7, 0xbf, 0x9f, 0x48, 0xc2, 0x7c, 0x65, 0x91, 0xf8, 0x8b,
-		0x8c, 0xe7, 0xca, 0x15, 0x32, 0x7b, 0x43, 0xf4, 0x93, 0x00, 0xb7, 0x9b, 0xc3, 0x07, 0x61, 0x20,
-		0x9d, 0x34, 0x4f, 0xa7, 0xbc, 0x48, 0x3a, 0xf3, 0x06, 0x51, 0xe5, 0x0a, 0x99, 0xbe, 0x11, 0x4f,
-		0x23, 0x18, 0xa6, 0x49, 0x1e, 0x1d, 0xe7, 0x0b, 0xa5, 0x31, 0xef, 0x88, 0x46, 0x69, 0x4c, 0xdd,
-		0xe8, 0xd9, 0x3a, 0x40, 0xff, 0x16, 0xc9, 0xff, 0xbc, 0x0c, 0xd9, 0x71, 0x55, 0xc4, 0xe2, 0x19,
-		0xbc, 0x94, 0x85, 0xa1, 0x4b, 0x79, 0xe8, 0x5d, 0x90, 0xfa, 0xa4, 0x77, 0xc1, 0x21, 0xac, 0x1f,
-		0x5b, 0x4e, 0x8b, 0xda, 0x66, 0xd3, 0x0a, 0x83, 0xde, 0x2b, 0xe3, 0xf1, 0xa2, 0xd1, 0xf6, 0xb8,
-		0xaf, 0x12, 0xb9, 0x92, 0xcc, 0x71, 0xff, 0x0f, 0xfa, 0x75, 0xae, 0x0e, 0xe3, 0x8e, 0xe3, 0x8f,
-		0xd6, 0xe1, 0xe8, 0x09, 0x9b, 0x2f, 0xc4, 0x77, 0x33, 0x85, 0xb8, 0xcc, 0xf3, 0x51, 0x3f, 0x4a,
-		0x88, 0x13, 0x72, 0x99, 0xa1, 0xc4, 0x77, 0x33, 0x95, 0xb8, 0xb2, 0x78, 0x1e, 0x73, 0xa7, 0xce,
-		0x65, 0xa4, 0xd8, 0x99, 0xa4, 0x44, 0x2e, 0xed, 0x00, 0x19, 0xd1, 0x13, 0x24, 0x38, 0x33, 0x93,
-		0xc9, 0x1e, 0x64, 0x85, 0x5c, 0xba, 0x90, 0xd9, 0x2d, 0x2e, 0x2a, 0x93, 0x24, 0x10, 0x59, 0x67,
-		0x03, 0x51, 0xb7, 0xdf, 0x0b, 0xb0, 0xda, 0x93, 0x24, 0xba, 0x09, 0xd7, 0x0d, 0x59, 0x7f, 0x6e,
-		0x1a, 0xaf, 0x0e, 0x54, 0x13, 0x6b, 0x87, 0x72, 0x15, 0x97, 0xc5, 0x2b, 0xe8, 0x16, 0xa0, 0xbe,
-		0xd9, 0x20, 0xb2, 0xa6, 0xef, 0xa9, 0x44, 0x14, 0xd0, 0x0d, 0xf8, 0xff, 0x80, 0x1d, 0xbf, 0x50,
-		0x89, 0x98, 0x42, 0xb7, 0xe1, 0x66, 0xdf, 0x48, 0xd4, 0x83, 0x2a, 0x56, 0x64, 0x03, 0xd7, 0x34,
-		0x31, 0x8d, 0xee, 0xc0, 0x46, 0x7f, 0x49, 0x21, 0x35, 0x5d, 0x37, 0x95, 0x6a, 0x5d, 0x37, 0x54,
-		0x22, 0x2e, 0x6d, 0xff, 0x25, 0x8c, 0x3f, 0xfa, 0x79, 0x52, 0xf7, 0xe1, 0xee, 0x10, 0xd6, 0x9c,
-		0x94, 0x62, 0x09, 0x1e, 0x4d, 0x03, 0xe9, 0x86, 0x4c, 0x0c, 0x53, 0xa9, 0xe0, 0x6a, 0xd9, 0x54,
-		0x5f, 0xaa, 0x4a, 0x9d, 0x67, 0x23, 0xa0, 0x1d, 0x28, 0x4c, 0x73, 0x51, 0x64, 0x4d, 0x51, 0xab,
-		0x03, 0xe8, 0xd4, 0x2c, 0xb4, 0x8e, 0xf7, 0x35, 0x79, 0x10, 0x9d, 0xde, 0xfe, 0x3d, 0x0d, 0x77,
-		0x66, 0x1c, 0x53, 0xf4, 0x05, 0x3c, 0x9c, 0x10, 0x6d, 0x4f, 0xc6, 0x55, 0xb5, 0x6c, 0x2a, 0x72,
-		0x5d, 0x1f, 0xac, 0xed, 0x09, 0x94, 0xe6, 0x81, 0xcb, 0xb5, 0x17, 0x32, 0xd6, 0x4c, 0xad, 0x66,
-		0x98, 0xb2, 0x62, 0xe0, 0x43, 0x55, 0x14, 0x2e, 0xe9, 0xa6, 0xbe, 0xc4, 0xba, 0xa1, 0x8b, 0x29,
-		0xf4, 0x2d, 0x7c, 0x35, 0xcf, 0xed, 0xa8, 0x46, 0x9e, 0xef, 0x55, 0x6b, 0x47, 0xa6, 0x5c, 0x25,
-		0xaa, 0x5c, 0x7e, 0x65, 0x92, 0xba, 0xa6, 0x61, 0x6d, 0x5f, 0x4c, 0xa3, 0xa7, 0xf0, 0x78, 0x61,
-		0xef, 0x81, 0x6d, 0x97, 0xd0, 0x77, 0xf0, 0xf5, 0xa5, 0xb7, 0x55, 0x6a, 0x2f, 0x0e, 0xaa, 0xaa,
-		0xa1, 0x96, 0xc5, 0xe5, 0x29, 0x02, 0x18, 0xf2, 0xaf, 0x6b, 0x8a, 0x6c, 0xa8, 0xfb, 0x35, 0x82,
-		0x5f, 0xab, 0x65, 0x71, 0x65, 0xfb, 0x83, 0x00, 0x68, 0x9f, 0xb2, 0x51, 0x6e, 0xee, 0xc1, 0xd6,
-		0xbe, 0x6a, 0xcc, 0x64, 0xe4, 0x01, 0xe4, 0x27, 0x43, 0x74, 0x95, 0x1c, 0x62, 0x45, 0x35, 0x9f,
-		0xd5, 0xf5, 0x57, 0xa2, 0x30, 0x3d, 0x54, 0x74, 0x58, 0x6a, 0x75, 0x43, 0x4c, 0x21, 0x09, 0xb6,
-		0xa7, 0x84, 0xaa, 0xc8, 0xa4, 0x6c, 0xd6, 0x8e, 0x34, 0x95, 0xe8, 0x15, 0x7c, 0x60, 0x56, 0x6b,
-		0xba, 0x21, 0xa6, 0xd1, 0x43, 0xb8, 0x3f, 0x19, 0x3f, 0x5c, 0xdd, 0xd2, 0xb3, 0xa7, 0xaf, 0x9f,
-		0x9c, 0x38, 0xec, 0x34, 0x6c, 0x48, 0x4d, 0xaf, 0x5d, 0x1c, 0xfa, 0xe8, 0x96, 0x4e, 0xa8, 0x1b,
-		0x7f, 0xc2, 0xf7, 0x3f, 0xff, 0xbf, 0x89, 0x7f, 0x9d, 0x97, 0x1a, 0x2b, 0x7c, 0xe5, 0xf1, 0x3f,
-		0x01, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xb2, 0x3c, 0x5d, 0x28, 0x10, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xdd, 0x72, 0xd3, 0x46,
+		0x14, 0x46, 0x76, 0x12, 0x92, 0xe3, 0x34, 0x88, 0x4d, 0x21, 0x26, 0x34, 0x83, 0x31, 0x33, 0xe0,
+		0x49, 0x83, 0xdd, 0x84, 0x61, 0xe8, 0xb4, 0xb4, 0x1d, 0x21, 0x2b, 0xb1, 0x8a, 0x91, 0xd3, 0x95,
+		0x9c, 0x00, 0x37, 0x1a, 0x59, 0xda, 0x38, 0x9a, 0xd8, 0x92, 0x91, 0x56, 0xa1, 0xbe, 0x2f, 0x4c,
+		0x2f, 0xdb, 0xe9, 0x1b, 0xf4, 0x1d, 0x7a, 0xd1, 0x3e, 0x42, 0x1f, 0xa0, 0x37, 0xbc, 0x4c, 0x47,
+		0x3f, 0x8e, 0x2c, 0x5b, 0xfe, 0x01, 0x7a, 0xd9, 0x3b, 0xeb, 0xec, 0x77, 0xce, 0x9e, 0xfd, 0xbe,
+		0x6f, 0x7f, 0xc6, 0x50, 0xf4, 0x5a, 0xc4, 0xa9, 0xe8, 0x9a, 0x41, 0x2c, 0x9d, 0x54, 0xdc, 0x53,
+		0xcd, 0x21, 0x46, 0xe5, 0x7c, 0xb7, 0xf2, 0xca, 0x23, 0x1e, 0x29, 0xf7, 0x1c, 0x9b, 0xda, 0xe8,
+		0xba, 0x8f, 0x29, 0x47, 0x98, 0x72, 0x88, 0x29, 0x9f, 0xef, 0x6e, 0xde, 0x6a, 0xdb, 0x76, 0xbb,
+		0x43, 0x2a, 0x01, 0xaa, 0xe5, 0x9d, 0x54, 0xa8, 0xd9, 0x25, 0x2e, 0xd5, 0xba, 0xbd, 0x30, 0x71,
+		0xb3, 0x90, 0x28, 0xae, 0xf5, 0x4c, 0xbf, 0xb2, 0x6e, 0x77, 0xbb, 0xb6, 0x15, 0x21, 0x6e, 0xa7,
+		0x21, 0x4e, 0x4d, 0x97, 0xda, 0x4e, 0x3f, 0x84, 0x14, 0xff, 0xca, 0xc2, 0x7a, 0x2d, 0x8c, 0x28,
+		0x9a, 0x7b, 0x56, 0xad, 0xff, 0x20, 0x58, 0xd4, 0xe9, 0xa3, 0x0d, 0xb8, 0x4c, 0x35, 0xf7, 0x4c,
+		0x35, 0x8d, 0x3c, 0x53, 0x60, 0x4a, 0x59, 0xbc, 0xe4, 0x7f, 0x8a, 0x06, 0x7a, 0x0c, 0xcb, 0xba,
+		0x46, 0x49, 0xdb, 0x76, 0xfa, 0xf9, 0x4c, 0x81, 0x29, 0xad, 0xed, 0x15, 0xca, 0xe9, 0x2b, 0x28,
+		0xfb, 0x05, 0x95, 0x7e, 0x8f, 0xe0, 0x8b, 0x0c, 0x74, 0x13, 0x56, 0x82, 0xb2, 0xb4, 0xdf, 0x23,
+		0xf9, 0x6c, 0x81, 0x29, 0x2d, 0xe2, 0x65, 0x1a, 0xc1, 0xfc, 0x41, 0xc3, 0xee, 0x6a, 0xa6, 0xe5,
+		0xcf, 0xba, 0x50, 0x60, 0x4a, 0x2b, 0x78, 0x39, 0x0c, 0x88, 0x06, 0x6a, 0x02, 0x7a, 0x6d, 0x3b,
+		0x67, 0x27, 0x1d, 0xfb, 0xb5, 0x4a, 0x7e, 0x24, 0xba, 0x47, 0x4d, 0xdb, 0xca, 0x2f, 0x16, 0x98,
+		0x52, 0x6e, 0xef, 0x6e, 0xb2, 0x03, 0xad, 0x67, 0xfa, 0xd3, 0x1f, 0x47, 0x70, 0x61, 0x80, 0xc6,
+		0x57, 0x5f, 0x8f, 0x86, 0x10, 0x0f, 0x57, 0xce, 0x4d, 0xd7, 0x6c, 0x99, 0x1d, 0x93, 0xf6, 0x55,
+		0x9f, 0xe2, 0xfc, 0x52, 0x50, 0x73, 0xb3, 0x1c, 0xf2, 0x5f, 0x1e, 0xf0, 0x5f, 0x56, 0x06, 0xfc,
+		0xe3, 0xb5, 0x38, 0xc5, 0x0f, 0xa2, 0x3c, 0x5c, 0xd6, 0x28, 0x25, 0xdd, 0x1e, 0xcd, 0x5f, 0x0e,
+		0xd6, 0x34, 0xf8, 0x44, 0x5b, 0x00, 0x1d, 0xcd, 0xa5, 0x2a, 0x71, 0x1c, 0xdb, 0xc9, 0x2f, 0x07,
+		0x6b, 0x5a, 0xf1, 0x23, 0x82, 0x1f, 0x40, 0xdf, 0xc0, 0xaa, 0xee, 0x10, 0x8d, 0x12, 0x23, 0x9c,
+		0x7a, 0x65, 0xe6, 0xd4, 0xb9, 0x08, 0xef, 0x47, 0x8a, 0xef, 0x32, 0xf0, 0x29, 0xef, 0xd8, 0xae,
+		0xcb, 0x77, 0x3c, 0x97, 0x12, 0xc7, 0x27, 0x5c, 0xb4, 0x4e, 0xec, 0x24, 0x93, 0xcc, 0x5c, 0x4c,
+		0x66, 0x3e, 0x96, 0x49, 0x71, 0x54, 0xda, 0xb5, 0xbd, 0x9d, 0x49, 0xce, 0x18, 0x6d, 0x3a, 0x74,
+		0xc9, 0x85, 0x11, 0xb6, 0x00, 0x82, 0x52, 0x2e, 0xd5, 0x28, 0x09, 0x9c, 0xb0, 0x88, 0x83, 0xe2,
+		0xb2, 0x1f, 0x18, 0xf6, 0xe6, 0x62, 0xc2, 0x9b, 0x75, 0x58, 0x4f, 0x2a, 0x13, 0x70, 0x36, 0x87,
+		0xa0, 0x69, 0x69, 0xc5, 0x7f, 0x32, 0xf0, 0xc5, 0x70, 0xa3, 0x32, 0xd5, 0x1c, 0xca, 0x9f, 0x9a,
+		0x1d, 0x23, 0xe6, 0x81, 0xbc, 0xf2, 0x88, 0x4b, 0x39, 0x4a, 0x1d, 0xb3, 0xe5, 0x51, 0xe2, 0xa2,
+		0x12, 0xb0, 0x54, 0x73, 0xda, 0x84, 0xaa, 0xa3, 0x02, 0xac, 0x85, 0xf1, 0xea, 0x40, 0x86, 0x2d,
+		0x00, 0x27, 0x4c, 0xf7, 0x31, 0x99, 0xd0, 0x1a, 0x51, 0x44, 0x34, 0xd0, 0x0e, 0x20, 0xd3, 0x32,
+		0xa9, 0x19, 0x98, 0x83, 0x9c, 0x13, 0x2b, 0x80, 0x65, 0x83, 0xf5, 0xb2, 0x17, 0x23, 0x82, 0x3f,
+		0x20, 0x1a, 0xe8, 0x2d, 0x03, 0x9b, 0xa3, 0x70, 0xed, 0xa2, 0xab, 0x80, 0xc2, 0xdc, 0x5e, 0x2d,
+		0x55, 0xdc, 0x78, 0x59, 0x63, 0x32, 0x8b, 0x89, 0x69, 0xe2, 0x55, 0xe2, 0xbc, 0x39, 0x61, 0x04,
+		0x15, 0xe1, 0x93, 0x68, 0xfd, 0x8e, 0x67, 0x0d, 0x14, 0x5a, 0xc1, 0xb9, 0x30, 0x88, 0x3d, 0x4b,
+		0x34, 0x8a, 0xdf, 0xc3, 0xee, 0x4c, 0x5e, 0xdd, 0x9e, 0x6d, 0xb9, 0x64, 0xa8, 0xf0, 0x35, 0x58,
+		0x72, 0xbc, 0x21, 0x3a, 0x17, 0x9d, 0xa0, 0xd6, 0x9f, 0x19, 0xd8, 0x19, 0x2e, 0xc6, 0x6b, 0x96,
+		0x4e, 0x3a, 0xff, 0x89, 0x40, 0x2d, 0xb8, 0x11, 0x21, 0x3f, 0x7a, 0xbb, 0x6c, 0x84, 0x85, 0xc6,
+		0x06, 0x46, 0x4c, 0x90, 0x9d, 0xcf, 0x04, 0x0b, 0x13, 0x4c, 0x50, 0x86, 0x75, 0xdd, 0xa7, 0x31,
+		0xee, 0xd7, 0xb6, 0x3a, 0xfd, 0x40, 0x81, 0x65, 0x7c, 0x55, 0x1f, 0x96, 0xb8, 0x61, 0x75, 0xfa,
+		0xc5, 0x0a, 0xdc, 0x9f, 0x4a, 0xdd, 0xa8, 0x06, 0xc5, 0x3f, 0xb2, 0x49, 0xb2, 0x65, 0xb3, 0x6d,
+		0x69, 0xff, 0x93, 0x3d, 0x0f, 0xd9, 0xe8, 0x16, 0xe4, 0xdc, 0x80, 0x2e, 0xd5, 0xd2, 0xa2, 0x4b,
+		0x66, 0x05, 0x43, 0x18, 0x92, 0xb4, 0x2e, 0x41, 0xdf, 0xc1, 0x6a, 0x04, 0x30, 0xad, 0x9e, 0x17,
+		0xde, 0x24, 0xb9, 0xbd, 0xcf, 0x52, 0x17, 0x7d, 0xa8, 0xf5, 0x3b, 0xb6, 0x66, 0xe0, 0xa8, 0xa4,
+		0xe8, 0x27, 0xf8, 0xb7, 0x90, 0x6e, 0x5b, 0xd4, 0xb1, 0x3b, 0xc1, 0x45, 0xb3, 0x8a, 0x07, 0x9f,
+		0xa3, 0x42, 0x8f, 0xc9, 0x36, 0x26, 0xf4, 0x6f, 0x0b, 0xb0, 0x31, 0x7a, 0x46, 0x47, 0xea, 0x5e,
+		0x9c, 0xf3, 0xa6, 0x75, 0x62, 0x07, 0x62, 0xe6, 0xe6, 0x3f, 0xe7, 0xfd, 0xcb, 0x29, 0x3c, 0xe7,
+		0xfd, 0x5f, 0xe8, 0x17, 0x06, 0xb6, 0xdc, 0xf1, 0xdd, 0x1f, 0x37, 0x92, 0xcf, 0xa4, 0x1d, 0x5c,
+		0xe9, 0xf5, 0xe7, 0x39, 0x9e, 0x6b, 0x97, 0xf0, 0xf4, 0x09, 0xd1, 0x4f, 0x0c, 0xdc, 0xd0, 0x93,
+		0x1b, 0x61, 0xa8, 0x9d, 0x6c, 0xd0, 0x4e, 0x75, 0x9e, 0x76, 0x66, 0x1d, 0x44, 0xb5, 0x4b, 0x78,
+		0xf2, 0x44, 0x41, 0x1b, 0x6e, 0x52, 0x26, 0x6e, 0xf4, 0x38, 0x9f, 0xab, 0x8d, 0x59, 0x5b, 0xd4,
+		0x6f, 0x63, 0xe2, 0x44, 0x4f, 0x56, 0x01, 0xe2, 0x5b, 0xa4, 0xf8, 0xf3, 0x22, 0xe4, 0xc7, 0x5d,
+		0x11, 0x9a, 0x67, 0xf2, 0x83, 0x31, 0xf1, 0x2e, 0xc8, 0x7c, 0xd4, 0xbb, 0xe0, 0x08, 0x56, 0x4f,
+		0x34, 0xb3, 0x43, 0x0c, 0x55, 0xd7, 0x3c, 0x77, 0xf0, 0xca, 0x78, 0x30, 0x6f, 0xb5, 0xfd, 0x20,
+		0x97, 0xf7, 0x53, 0x71, 0xee, 0x24, 0xfe, 0x40, 0xbf, 0xce, 0xf4, 0x61, 0xc8, 0xb8, 0xf8, 0xc1,
+		0x3e, 0x1c, 0xdd, 0x61, 0xb3, 0x8d, 0xf8, 0x66, 0xaa, 0x11, 0xc3, 0x77, 0xaf, 0xf0, 0x41, 0x46,
+		0x4c, 0xe9, 0x65, 0x8a, 0x13, 0xdf, 0x4c, 0x75, 0xe2, 0xd2, 0xfc, 0x7d, 0xcc, 0x3c, 0x75, 0xde,
+		0xc7, 0x8a, 0xbd, 0x34, 0x27, 0x06, 0xd6, 0x76, 0x91, 0xe2, 0x3f, 0x41, 0xdc, 0x33, 0x35, 0x3a,
+		0xd9, 0xdd, 0x3c, 0x53, 0xc8, 0x96, 0x72, 0x7b, 0x95, 0x79, 0x6d, 0x12, 0x15, 0xc2, 0xab, 0x34,
+		0xfe, 0x70, 0xb7, 0xdf, 0x32, 0xb0, 0x3c, 0xb0, 0x24, 0xba, 0x06, 0x57, 0x15, 0x4e, 0x7e, 0xaa,
+		0x2a, 0x2f, 0x0e, 0x05, 0x55, 0x94, 0x8e, 0xb8, 0xba, 0x58, 0x65, 0x2f, 0xa1, 0xeb, 0x80, 0xe2,
+		0xb0, 0x82, 0x39, 0x49, 0xde, 0x17, 0x30, 0xcb, 0xa0, 0x75, 0xb8, 0x32, 0x14, 0x17, 0x9f, 0x09,
+		0x98, 0xcd, 0xa0, 0x1b, 0x70, 0x2d, 0x0e, 0x62, 0xe1, 0xb0, 0x2e, 0xf2, 0x9c, 0x22, 0x36, 0x24,
+		0x36, 0x8b, 0x6e, 0xc2, 0x46, 0x3c, 0xc4, 0xe3, 0x86, 0x2c, 0xab, 0x7c, 0xbd, 0x29, 0x2b, 0x02,
+		0x66, 0x17, 0xb6, 0xff, 0x66, 0xc6, 0x1f, 0xfd, 0x41, 0x53, 0x77, 0xe0, 0x56, 0x02, 0xab, 0xa6,
+		0xb5, 0xb8, 0x0b, 0xf7, 0x27, 0x81, 0x64, 0x85, 0xc3, 0x8a, 0xca, 0xd7, 0xc4, 0x7a, 0x55, 0x15,
+		0x9e, 0x0b, 0x7c, 0x33, 0xe8, 0x86, 0x41, 0x3b, 0x50, 0x9a, 0x94, 0xc2, 0x73, 0x12, 0x2f, 0xd4,
+		0x87, 0xd0, 0x99, 0x69, 0x68, 0x59, 0x3c, 0x90, 0xb8, 0x61, 0x74, 0x76, 0xfb, 0xf7, 0x2c, 0xdc,
+		0x9c, 0xb2, 0x4d, 0xd1, 0xe7, 0x70, 0x2f, 0xa5, 0xda, 0x3e, 0x27, 0xd6, 0x85, 0xaa, 0xca, 0x73,
+		0x4d, 0x79, 0x78, 0x6d, 0x0f, 0x61, 0x77, 0x16, 0xb8, 0xda, 0x78, 0xc6, 0x89, 0x92, 0x2a, 0x35,
+		0x14, 0x95, 0xe3, 0x15, 0xf1, 0x48, 0x60, 0x99, 0xf7, 0x4c, 0x13, 0x9e, 0x8b, 0xb2, 0x22, 0xb3,
+		0x19, 0xf4, 0x18, 0xbe, 0x9c, 0x95, 0x76, 0xdc, 0xc0, 0x4f, 0xf7, 0xeb, 0x8d, 0x63, 0x95, 0xab,
+		0x63, 0x81, 0xab, 0xbe, 0x50, 0x71, 0x53, 0x92, 0x44, 0xe9, 0x80, 0xcd, 0xa2, 0x47, 0xf0, 0x60,
+		0xee, 0xec, 0xa1, 0x69, 0x17, 0xd0, 0xb7, 0xf0, 0xd5, 0x7b, 0x4f, 0xcb, 0x37, 0x9e, 0x1d, 0xd6,
+		0x05, 0x45, 0xa8, 0xb2, 0x8b, 0x13, 0x0c, 0x90, 0xc8, 0x6f, 0x4a, 0x3c, 0xa7, 0x08, 0x07, 0x0d,
+		0x2c, 0xbe, 0x14, 0xaa, 0xec, 0xd2, 0xf6, 0x3b, 0x06, 0xd0, 0x01, 0xa1, 0xa3, 0xda, 0xdc, 0x86,
+		0xad, 0x03, 0x41, 0x99, 0xaa, 0xc8, 0x5d, 0x28, 0xa6, 0x43, 0x64, 0x01, 0x1f, 0x89, 0xbc, 0xa0,
+		0x3e, 0x69, 0xca, 0x2f, 0x58, 0x66, 0x72, 0x29, 0x7f, 0xb3, 0x34, 0x9a, 0x0a, 0x9b, 0x41, 0x65,
+		0xd8, 0x9e, 0x50, 0xaa, 0xc6, 0xe1, 0xaa, 0xda, 0x38, 0x96, 0x04, 0x2c, 0xd7, 0xc4, 0x43, 0xb5,
+		0xde, 0x90, 0x15, 0x36, 0x8b, 0xee, 0xc1, 0x9d, 0x74, 0x7c, 0x72, 0x75, 0x0b, 0x4f, 0x1e, 0xbd,
+		0x7c, 0xd8, 0x36, 0xe9, 0xa9, 0xd7, 0x2a, 0xeb, 0x76, 0xb7, 0x92, 0xf8, 0xc7, 0xa4, 0xdc, 0x26,
+		0x56, 0xf8, 0xff, 0x4b, 0xfc, 0xdf, 0xcd, 0xd7, 0xe1, 0xaf, 0xf3, 0xdd, 0xd6, 0x52, 0x30, 0xf2,
+		0xe0, 0xdf, 0x01, 0x00, 0x42, 0xc4, 0xc4, 0x67, 0xe5, 0x11, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/replication.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x73, 0x1a, 0x47,
-		0x13, 0xf6, 0x82, 0xf8, 0x50, 0x0b, 0x01, 0x1a, 0xe9, 0xb5, 0xb0, 0x64, 0xd5, 0x8b, 0x89, 0x6c,
-		0xc9, 0x72, 0x0a, 0x6c, 0xb9, 0x9c, 0xcf, 0x4a, 0xb9, 0xd6, 0x02, 0x95, 0x36, 0xd6, 0x97, 0x87,
-		0xb5, 0x5c, 0xca, 0x21, 0x5b, 0x2b, 0x76, 0x24, 0xb6, 0x04, 0xbb, 0xd4, 0xce, 0x80, 0xcc, 0x31,
-		0xb9, 0xe7, 0x98, 0x5c, 0x72, 0xcc, 0xef, 0xc8, 0x35, 0x67, 0xff, 0xa4, 0xd4, 0xce, 0xcc, 0x02,
-		0x0b, 0x0b, 0x96, 0xcb, 0x87, 0xdc, 0xb4, 0xdd, 0xcf, 0xd3, 0xdd, 0xd3, 0xdd, 0xd3, 0x3d, 0x02,
-		0xb6, 0xbb, 0x17, 0xc4, 0xab, 0x34, 0x4c, 0x8b, 0x38, 0x0d, 0x52, 0xa1, 0x4d, 0xd3, 0x23, 0x56,
-		0xa5, 0xf7, 0xac, 0xe2, 0x91, 0x4e, 0xcb, 0x6e, 0x98, 0xcc, 0x76, 0x9d, 0x72, 0xc7, 0x73, 0x99,
-		0x8b, 0xee, 0xfa, 0xc8, 0xb2, 0x44, 0x96, 0x05, 0xb2, 0xdc, 0x7b, 0xb6, 0xf6, 0xff, 0x2b, 0xd7,
-		0xbd, 0x6a, 0x91, 0x0a, 0x47, 0x5d, 0x74, 0x2f, 0x2b, 0xcc, 0x6e, 0x13, 0xca, 0xcc, 0x76, 0x47,
-		0x10, 0xd7, 0x8a, 0x21, 0x17, 0x66, 0xc7, 0xf6, 0xed, 0x37, 0xdc, 0x76, 0x3b, 0x30, 0x1d, 0x8d,
-		0xb0, 0xdc, 0xb6, 0x69, 0x07, 0x88, 0xcd, 0x29, 0x61, 0x36, 0x6d, 0xca, 0x5c, 0xaf, 0x2f, 0x50,
-		0xa5, 0x3f, 0x62, 0xb0, 0x8c, 0x87, 0x81, 0x1f, 0x11, 0x4a, 0xcd, 0x2b, 0x42, 0x91, 0x0e, 0x4b,
-		0x23, 0xe7, 0x31, 0x98, 0x49, 0xaf, 0x69, 0x41, 0x29, 0xc6, 0xb7, 0x17, 0x76, 0xb7, 0xca, 0xd1,
-		0xc7, 0x2a, 0x8f, 0xd8, 0xd1, 0x4d, 0x7a, 0x8d, 0xf3, 0x5e, 0x58, 0x40, 0xd1, 0xb7, 0x70, 0xaf,
-		0x65, 0x52, 0x66, 0x78, 0x84, 0x79, 0x36, 0xe9, 0x11, 0xcb, 0x68, 0x0b, 0x87, 0x86, 0x6d, 0x15,
-		0x62, 0x45, 0x65, 0x3b, 0x8e, 0xef, 0xfa, 0x00, 0x1c, 0xe8, 0x65, 0x3c, 0x9a, 0x85, 0xee, 0x41,
-		0xba, 0x69, 0x52, 0xa3, 0xed, 0x7a, 0xa4, 0x10, 0x2f, 0x2a, 0xdb, 0x69, 0x9c, 0x6a, 0x9a, 0xf4,
-		0xc8, 0xf5, 0x08, 0xaa, 0xc3, 0x12, 0xed, 0x3b, 0x0d, 0xc3, 0x8f, 0xc4, 0x32, 0x28, 0x33, 0x59,
-		0x97, 0x16, 0xe6, 0x8a, 0xca, 0xac, 0x58, 0xeb, 0x7d, 0xa7, 0x51, 0xf7, 0xf1, 0x75, 0x0e, 0xc7,
-		0x39, 0x1a, 0x16, 0x94, 0x7e, 0x4f, 0x42, 0x6e, 0xec, 0x40, 0xe8, 0x00, 0xe6, 0xfd, 0x44, 0x18,
-		0xac, 0xdf, 0x21, 0x05, 0xa5, 0xa8, 0x6c, 0x67, 0x77, 0x9f, 0xdc, 0x32, 0x19, 0x7a, 0xbf, 0x43,
-		0x70, 0x9a, 0xc9, 0xbf, 0xd0, 0x26, 0x64, 0xa9, 0xdb, 0xf5, 0x1a, 0x84, 0x67, 0x76, 0x78, 0xfa,
-		0x8c, 0x90, 0xfa, 0x0c, 0xcd, 0x42, 0x2f, 0x61, 0xb1, 0xe1, 0x11, 0x59, 0x01, 0xbb, 0x2d, 0x0e,
-		0xbe, 0xb0, 0xbb, 0x56, 0x16, 0xfd, 0x53, 0x0e, 0xfa, 0xa7, 0xac, 0x07, 0xfd, 0x83, 0x33, 0x01,
-		0xc1, 0x17, 0x21, 0x0b, 0xee, 0x8a, 0x9e, 0x10, 0x6e, 0x4c, 0xc6, 0x3c, 0xfb, 0xa2, 0xcb, 0x48,
-		0x90, 0x9e, 0x2f, 0xa7, 0x45, 0x5f, 0xe5, 0x2c, 0x3f, 0x0c, 0x75, 0xc0, 0x39, 0xb8, 0x83, 0x57,
-		0xac, 0x08, 0x39, 0xfa, 0x45, 0x81, 0x07, 0x13, 0x05, 0x98, 0xf0, 0x98, 0xe0, 0x1e, 0x5f, 0xdc,
-		0xb2, 0x20, 0x13, 0xae, 0x37, 0xe8, 0x2c, 0x00, 0xba, 0x01, 0x0e, 0x30, 0xcc, 0x06, 0xb3, 0x7b,
-		0x36, 0xeb, 0x4f, 0xb8, 0x4f, 0x72, 0xf7, 0xbb, 0xb3, 0xdc, 0xab, 0x92, 0x3b, 0xe1, 0x7b, 0x8d,
-		0x4e, 0xd5, 0x22, 0x07, 0xd6, 0xe4, 0x8d, 0x12, 0x2e, 0x7b, 0xbb, 0xa3, 0x5e, 0x53, 0xdc, 0x6b,
-		0x65, 0x9a, 0xd7, 0x03, 0xc1, 0xf4, 0x4d, 0x9e, 0xed, 0x86, 0x5c, 0xae, 0x36, 0xa3, 0x55, 0xa8,
-		0x03, 0x6b, 0x97, 0xa6, 0xdd, 0x72, 0x7b, 0xc4, 0x33, 0xda, 0xa6, 0x77, 0x4d, 0xbc, 0x51, 0x7f,
-		0x69, 0xee, 0xef, 0xe9, 0x34, 0x7f, 0xfb, 0x92, 0x79, 0xc4, 0x89, 0x21, 0x87, 0x85, 0xcb, 0x29,
-		0xba, 0x57, 0x19, 0x80, 0xa1, 0x87, 0xd2, 0xdf, 0x31, 0x58, 0x89, 0xea, 0x0e, 0x84, 0x21, 0x2f,
-		0x7b, 0xcd, 0xed, 0x10, 0x8f, 0xf7, 0xa0, 0xbc, 0x23, 0x5b, 0xb3, 0xbb, 0xec, 0x24, 0x80, 0xe3,
-		0x9c, 0x15, 0x16, 0xa0, 0x2c, 0xc4, 0xe4, 0xd5, 0x98, 0xc7, 0x31, 0xdb, 0x42, 0xcf, 0x21, 0x29,
-		0x20, 0xf2, 0x26, 0xac, 0x87, 0x2d, 0x9b, 0x1d, 0x7b, 0x68, 0x16, 0x4b, 0x28, 0x7a, 0x08, 0xd9,
-		0x86, 0xeb, 0x5c, 0xda, 0x57, 0x46, 0x8f, 0x78, 0xd4, 0x0f, 0x6b, 0x8e, 0xdf, 0xb5, 0x45, 0x21,
-		0x3d, 0x13, 0x42, 0xf4, 0x18, 0xf2, 0x83, 0xc4, 0x06, 0xc0, 0x04, 0x07, 0xe6, 0x02, 0x79, 0x00,
-		0xfd, 0x0e, 0xee, 0x75, 0x3c, 0xd2, 0xb3, 0xdd, 0x2e, 0x35, 0x26, 0x38, 0x49, 0xce, 0x59, 0x0d,
-		0x00, 0xfb, 0x61, 0x6e, 0xe9, 0x4f, 0x05, 0x36, 0x66, 0xf6, 0xba, 0x1f, 0xaf, 0x9c, 0x0d, 0x8d,
-		0x56, 0x97, 0x32, 0xe2, 0xf1, 0x34, 0xce, 0xe3, 0x45, 0x21, 0xdd, 0x13, 0x42, 0x7f, 0x20, 0x8a,
-		0xfb, 0x26, 0x33, 0x94, 0xc0, 0x29, 0xfe, 0xad, 0x59, 0xe8, 0x1b, 0x98, 0x1f, 0x6c, 0x94, 0x5b,
-		0xcc, 0x8c, 0x21, 0xb8, 0xf4, 0x21, 0x01, 0x6b, 0xd3, 0xaf, 0x02, 0x5a, 0x87, 0x79, 0x59, 0x63,
-		0xdb, 0x92, 0x51, 0xa5, 0x85, 0x40, 0xb3, 0xd0, 0x5b, 0x40, 0x37, 0xae, 0x77, 0x7d, 0xd9, 0x72,
-		0x6f, 0x0c, 0xf2, 0x9e, 0x34, 0xba, 0xbc, 0x05, 0x62, 0xdc, 0xfd, 0xa3, 0xc8, 0x42, 0xbd, 0x93,
-		0xf0, 0x5a, 0x80, 0xc6, 0x4b, 0x37, 0xe3, 0x22, 0x54, 0x80, 0x54, 0x90, 0xda, 0x38, 0x4f, 0x6d,
-		0xf0, 0x89, 0x1e, 0x40, 0x86, 0x36, 0x9a, 0xc4, 0xea, 0xb6, 0x08, 0xcf, 0x82, 0x28, 0xeb, 0xc2,
-		0x40, 0xa6, 0x59, 0x48, 0x85, 0xec, 0x10, 0xc2, 0x47, 0x68, 0xe2, 0xa3, 0xe9, 0x58, 0x1c, 0x30,
-		0xf8, 0x0c, 0xdd, 0x00, 0xa0, 0xcc, 0xf4, 0x98, 0xf0, 0x21, 0xaa, 0x3b, 0x2f, 0x25, 0x9a, 0x85,
-		0x7e, 0x80, 0x4c, 0xa0, 0xe6, 0xf6, 0x53, 0x1f, 0xb5, 0xbf, 0x20, 0xf1, 0xdc, 0xfa, 0x8f, 0xb0,
-		0xcc, 0x37, 0x62, 0x93, 0x98, 0x1e, 0xbb, 0x20, 0x26, 0x13, 0x56, 0xd2, 0x1f, 0xb5, 0xb2, 0xe4,
-		0xd3, 0x0e, 0x02, 0x16, 0xb7, 0xf5, 0x15, 0xa4, 0x2c, 0xc2, 0x4c, 0xbb, 0x45, 0x0b, 0xf3, 0x9c,
-		0x7f, 0x3f, 0x32, 0xeb, 0xa7, 0x66, 0xbf, 0xe5, 0x9a, 0x16, 0x0e, 0xc0, 0x7e, 0x86, 0x4d, 0xc6,
-		0x48, 0xbb, 0xc3, 0x0a, 0x20, 0x1a, 0x49, 0x7e, 0xa2, 0x97, 0x90, 0xe1, 0xd1, 0xf9, 0x4d, 0xde,
-		0xf5, 0x48, 0x61, 0x61, 0x86, 0xd9, 0x7d, 0x81, 0xc1, 0x0b, 0x3e, 0x43, 0x7e, 0xa0, 0xa7, 0xb0,
-		0xc2, 0x0d, 0xf8, 0x65, 0x25, 0x9e, 0x61, 0x5b, 0xc4, 0x61, 0x36, 0xeb, 0x17, 0x32, 0xbc, 0x77,
-		0x90, 0xaf, 0x7b, 0xc7, 0x55, 0x9a, 0xd4, 0xa0, 0x13, 0xc8, 0xc9, 0xfa, 0x1a, 0x72, 0x04, 0x16,
-		0x16, 0xa3, 0x5a, 0x68, 0x38, 0x45, 0xe4, 0xcd, 0x92, 0xb3, 0x14, 0x67, 0x7b, 0xa1, 0xef, 0xd2,
-		0xaf, 0x71, 0x58, 0x9d, 0x32, 0x67, 0xd1, 0x2a, 0xa4, 0x82, 0xfd, 0xab, 0xf0, 0xc2, 0x26, 0x99,
-		0xd8, 0xbc, 0xa1, 0x46, 0x8f, 0xdd, 0xaa, 0xd1, 0xe3, 0x9f, 0xdb, 0xe8, 0x3f, 0xc3, 0xff, 0xc6,
-		0x4e, 0x6e, 0xd8, 0x8c, 0xb4, 0xfd, 0x5d, 0xed, 0x3f, 0xbb, 0x76, 0x6e, 0x77, 0x7e, 0x8d, 0x91,
-		0x36, 0x5e, 0xee, 0x4d, 0xc8, 0x28, 0x7a, 0x01, 0x49, 0xd2, 0x23, 0x0e, 0x0b, 0x56, 0xf1, 0x46,
-		0xf4, 0xf0, 0x34, 0x99, 0xf9, 0xaa, 0xe5, 0x5e, 0x60, 0x09, 0x46, 0x7b, 0x90, 0x75, 0xc8, 0x8d,
-		0xe1, 0x75, 0x1d, 0x43, 0xd2, 0x93, 0xb7, 0xa1, 0x67, 0x1c, 0x72, 0x83, 0xbb, 0x4e, 0x8d, 0x53,
-		0x4a, 0x7f, 0x29, 0x50, 0x98, 0xb6, 0x7c, 0x66, 0x4f, 0x95, 0xa8, 0xb1, 0x1c, 0x8b, 0x1e, 0xcb,
-		0x9f, 0xfb, 0x5c, 0x2a, 0xfd, 0xa6, 0xc0, 0x72, 0x38, 0x4a, 0xdd, 0xbd, 0x26, 0x8e, 0x1f, 0x60,
-		0x30, 0x6a, 0xc5, 0x23, 0x38, 0x81, 0xd3, 0x72, 0xd6, 0x52, 0x74, 0x0e, 0xb9, 0xb1, 0x85, 0x2c,
-		0x67, 0xde, 0x27, 0x6f, 0x61, 0x9c, 0x0d, 0xef, 0xe0, 0xd2, 0x3f, 0xe1, 0xc7, 0x39, 0x7f, 0x15,
-		0x3a, 0x97, 0xee, 0x7f, 0x32, 0x86, 0xd7, 0x47, 0xdf, 0xbe, 0x71, 0x3e, 0x26, 0x86, 0xcf, 0xd9,
-		0x91, 0x7b, 0x34, 0x17, 0xba, 0x47, 0x23, 0xc3, 0x3b, 0x11, 0x1e, 0xde, 0x9b, 0x90, 0xbd, 0xb4,
-		0x3d, 0xca, 0x44, 0x53, 0x0d, 0x47, 0x6b, 0x86, 0x4b, 0x79, 0xdb, 0x68, 0x16, 0x2a, 0xc1, 0xa2,
-		0x43, 0xde, 0x8f, 0x80, 0x52, 0x62, 0xc6, 0xfb, 0xc2, 0x00, 0x33, 0xbe, 0x06, 0xd2, 0x13, 0x6b,
-		0xc0, 0x6f, 0xbf, 0xfc, 0x68, 0x22, 0x79, 0x55, 0x47, 0x17, 0xa8, 0x12, 0x5e, 0xa0, 0x9f, 0xf1,
-		0x7f, 0x4a, 0x40, 0xed, 0x78, 0x6e, 0x83, 0x50, 0x1a, 0xa6, 0xc6, 0x87, 0xd4, 0xd3, 0x40, 0x3f,
-		0xa0, 0x96, 0x5e, 0x43, 0x6e, 0xec, 0x65, 0x10, 0xde, 0xe4, 0xca, 0x27, 0x6c, 0xf2, 0x9d, 0x0f,
-		0x93, 0xbd, 0xc3, 0x4b, 0xf5, 0x00, 0x36, 0x70, 0xed, 0xf4, 0x50, 0xdb, 0x53, 0x75, 0xed, 0xe4,
-		0xd8, 0xd0, 0xd5, 0xfa, 0x6b, 0x43, 0x3f, 0x3f, 0xad, 0x19, 0xda, 0xf1, 0x99, 0x7a, 0xa8, 0x55,
-		0xf3, 0x77, 0x50, 0x11, 0xee, 0x47, 0x43, 0xaa, 0x27, 0x47, 0xaa, 0x76, 0x9c, 0x57, 0xa6, 0x1b,
-		0x39, 0xd0, 0xea, 0xfa, 0x09, 0x3e, 0xcf, 0xc7, 0xd0, 0x13, 0xd8, 0x8a, 0x86, 0xd4, 0xcf, 0x8f,
-		0xf7, 0x8c, 0xfa, 0x81, 0x8a, 0xab, 0x46, 0x5d, 0x57, 0xf5, 0xb7, 0xf5, 0x7c, 0x1c, 0x6d, 0xc1,
-		0x17, 0x33, 0xc0, 0xea, 0x9e, 0xae, 0x9d, 0x69, 0xfa, 0x79, 0x7e, 0x0e, 0xed, 0xc0, 0xa3, 0x99,
-		0x8e, 0x8d, 0xa3, 0x9a, 0xae, 0x56, 0x55, 0x5d, 0xcd, 0x27, 0xd0, 0x26, 0x14, 0x67, 0x63, 0xcf,
-		0x76, 0xf3, 0x49, 0xf4, 0x18, 0x1e, 0x46, 0xa3, 0xf6, 0x55, 0xed, 0xf0, 0xe4, 0xac, 0x86, 0x8d,
-		0x23, 0x15, 0xbf, 0xae, 0xe1, 0x7c, 0x6a, 0xc7, 0x86, 0xdc, 0xd8, 0x8b, 0x15, 0xdd, 0x87, 0x82,
-		0x48, 0x8a, 0x71, 0x72, 0x5a, 0xc3, 0xc2, 0xc4, 0x30, 0x91, 0xeb, 0xb0, 0x3a, 0xa1, 0xdd, 0xc3,
-		0x35, 0x55, 0xaf, 0xe5, 0x95, 0x48, 0xe5, 0xdb, 0xd3, 0xaa, 0xaf, 0x8c, 0xed, 0x1c, 0x43, 0xaa,
-		0x7a, 0xf8, 0x86, 0x17, 0x6c, 0x05, 0xf2, 0xd5, 0xc3, 0x37, 0xe3, 0x35, 0x2a, 0xc0, 0xca, 0x40,
-		0x3a, 0x12, 0x7f, 0x5e, 0x41, 0xcb, 0x90, 0x1b, 0x68, 0x64, 0xc1, 0x62, 0xaf, 0xbe, 0xfe, 0xe9,
-		0xc5, 0x95, 0xcd, 0x9a, 0xdd, 0x8b, 0x72, 0xc3, 0x6d, 0x57, 0x42, 0xbf, 0x0c, 0x94, 0xaf, 0x88,
-		0x23, 0x7e, 0x89, 0x18, 0xfe, 0x48, 0xf0, 0xbd, 0xf8, 0xab, 0xf7, 0xec, 0x22, 0xc9, 0x35, 0xcf,
-		0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x50, 0xe1, 0x45, 0xf5, 0x10, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4f, 0x53, 0xe3, 0xc8,
+		0x15, 0x5f, 0xdb, 0x60, 0xc3, 0xc3, 0x18, 0xd3, 0x30, 0xa0, 0xf1, 0x0c, 0x09, 0xe3, 0xcc, 0xee,
+		0xb0, 0x6c, 0xca, 0x2c, 0x4c, 0x4d, 0x36, 0xd9, 0x54, 0x6a, 0xcb, 0x83, 0x4d, 0xa1, 0x0c, 0x60,
+		0x46, 0xf6, 0x30, 0x45, 0x0e, 0x51, 0x35, 0x52, 0xdb, 0xa8, 0xb0, 0x24, 0xaf, 0xba, 0x6d, 0x60,
+		0x6f, 0xc9, 0x3d, 0xc7, 0xe4, 0x92, 0x63, 0xaa, 0xf2, 0x2d, 0x72, 0xcd, 0x39, 0x97, 0x7c, 0x87,
+		0x7c, 0x8c, 0x54, 0xff, 0x91, 0x2d, 0xd9, 0xb2, 0x21, 0x99, 0xc3, 0xde, 0xac, 0xf7, 0x7e, 0xef,
+		0x8f, 0xde, 0xfb, 0xf5, 0x7b, 0x2d, 0xc3, 0x4e, 0xff, 0x8a, 0x04, 0x7b, 0x16, 0xb6, 0x89, 0x67,
+		0x91, 0x3d, 0x7a, 0x8d, 0x03, 0x62, 0xef, 0x0d, 0xf6, 0xf7, 0x02, 0xd2, 0xeb, 0x3a, 0x16, 0x66,
+		0x8e, 0xef, 0x55, 0x7a, 0x81, 0xcf, 0x7c, 0xb4, 0xc1, 0x91, 0x15, 0x85, 0xac, 0x48, 0x64, 0x65,
+		0xb0, 0x5f, 0xfa, 0x69, 0xc7, 0xf7, 0x3b, 0x5d, 0xb2, 0x27, 0x50, 0x57, 0xfd, 0xf6, 0x1e, 0x73,
+		0x5c, 0x42, 0x19, 0x76, 0x7b, 0xd2, 0xb0, 0xb4, 0x1d, 0x0b, 0x81, 0x7b, 0x0e, 0xf7, 0x6f, 0xf9,
+		0xae, 0xeb, 0x7b, 0xb3, 0x10, 0xb6, 0xef, 0x62, 0x27, 0x44, 0xbc, 0x9c, 0x92, 0xe6, 0xb5, 0x43,
+		0x99, 0x1f, 0xdc, 0x4b, 0x54, 0xf9, 0x2f, 0x69, 0x58, 0x33, 0x46, 0x89, 0x9f, 0x12, 0x4a, 0x71,
+		0x87, 0x50, 0xd4, 0x82, 0xd5, 0xc8, 0xfb, 0x98, 0x0c, 0xd3, 0x1b, 0xaa, 0xa5, 0xb6, 0x33, 0x3b,
+		0x4b, 0x07, 0xaf, 0x2a, 0xc9, 0xaf, 0x55, 0x89, 0xf8, 0x69, 0x61, 0x7a, 0x63, 0x14, 0x83, 0xb8,
+		0x80, 0xa2, 0x5f, 0xc1, 0xd3, 0x2e, 0xa6, 0xcc, 0x0c, 0x08, 0x0b, 0x1c, 0x32, 0x20, 0xb6, 0xe9,
+		0xca, 0x80, 0xa6, 0x63, 0x6b, 0xe9, 0xed, 0xd4, 0x4e, 0xc6, 0xd8, 0xe0, 0x00, 0x23, 0xd4, 0xab,
+		0x7c, 0x74, 0x1b, 0x3d, 0x85, 0x85, 0x6b, 0x4c, 0x4d, 0xd7, 0x0f, 0x88, 0x96, 0xd9, 0x4e, 0xed,
+		0x2c, 0x18, 0xb9, 0x6b, 0x4c, 0x4f, 0xfd, 0x80, 0xa0, 0x26, 0xac, 0xd2, 0x7b, 0xcf, 0x32, 0x79,
+		0x26, 0xb6, 0x49, 0x19, 0x66, 0x7d, 0xaa, 0xcd, 0x6d, 0xa7, 0x66, 0xe5, 0xda, 0xbc, 0xf7, 0xac,
+		0x26, 0xc7, 0x37, 0x05, 0xdc, 0x58, 0xa1, 0x71, 0x41, 0xf9, 0xcf, 0x59, 0x58, 0x19, 0x7b, 0x21,
+		0x74, 0x0c, 0x8b, 0xbc, 0x10, 0x26, 0xbb, 0xef, 0x11, 0x2d, 0xb5, 0x9d, 0xda, 0x29, 0x1c, 0x7c,
+		0xf5, 0xc8, 0x62, 0xb4, 0xee, 0x7b, 0xc4, 0x58, 0x60, 0xea, 0x17, 0x7a, 0x09, 0x05, 0xea, 0xf7,
+		0x03, 0x8b, 0x88, 0xca, 0x8e, 0xde, 0x3e, 0x2f, 0xa5, 0xdc, 0x42, 0xb7, 0xd1, 0x77, 0xb0, 0x6c,
+		0x05, 0x44, 0x75, 0xc0, 0x71, 0xe5, 0x8b, 0x2f, 0x1d, 0x94, 0x2a, 0x92, 0x3f, 0x95, 0x90, 0x3f,
+		0x95, 0x56, 0xc8, 0x1f, 0x23, 0x1f, 0x1a, 0x70, 0x11, 0xb2, 0x61, 0x43, 0x72, 0x42, 0x86, 0xc1,
+		0x8c, 0x05, 0xce, 0x55, 0x9f, 0x91, 0xb0, 0x3c, 0x3f, 0x9f, 0x96, 0x7d, 0x4d, 0x58, 0xf1, 0x34,
+		0xaa, 0x43, 0x9b, 0xe3, 0xcf, 0x8c, 0x75, 0x3b, 0x41, 0x8e, 0xfe, 0x90, 0x82, 0x17, 0x13, 0x0d,
+		0x98, 0x88, 0x38, 0x2f, 0x22, 0xbe, 0x79, 0x64, 0x43, 0x26, 0x42, 0x6f, 0xd1, 0x59, 0x00, 0x74,
+		0x0b, 0x02, 0x60, 0x62, 0x8b, 0x39, 0x03, 0x87, 0xdd, 0x4f, 0x84, 0xcf, 0x8a, 0xf0, 0x07, 0xb3,
+		0xc2, 0x57, 0x95, 0xed, 0x44, 0xec, 0x12, 0x9d, 0xaa, 0x45, 0x1e, 0x94, 0xd4, 0x89, 0x92, 0x21,
+		0x07, 0x07, 0xd1, 0xa8, 0x39, 0x11, 0x75, 0x6f, 0x5a, 0xd4, 0x63, 0x69, 0xc9, 0x5d, 0x5e, 0x1c,
+		0xc4, 0x42, 0x6e, 0x5e, 0x27, 0xab, 0x50, 0x0f, 0x4a, 0x6d, 0xec, 0x74, 0xfd, 0x01, 0x09, 0x4c,
+		0x17, 0x07, 0x37, 0x24, 0x88, 0xc6, 0x5b, 0x10, 0xf1, 0xbe, 0x9e, 0x16, 0xef, 0x48, 0x59, 0x9e,
+		0x0a, 0xc3, 0x58, 0x40, 0xad, 0x3d, 0x45, 0xf7, 0x36, 0x0f, 0x30, 0x8a, 0x50, 0xfe, 0x47, 0x1a,
+		0xd6, 0x93, 0xd8, 0x81, 0x0c, 0x28, 0x2a, 0xae, 0xf9, 0x3d, 0x12, 0x08, 0x0e, 0xaa, 0x33, 0xf2,
+		0x6a, 0x36, 0xcb, 0x1a, 0x21, 0xdc, 0x58, 0xb1, 0xe3, 0x02, 0x54, 0x80, 0xb4, 0x3a, 0x1a, 0x8b,
+		0x46, 0xda, 0xb1, 0xd1, 0x6b, 0xc8, 0x4a, 0x88, 0x3a, 0x09, 0xcf, 0xe2, 0x9e, 0x71, 0xcf, 0x19,
+		0xb9, 0x35, 0x14, 0x14, 0x7d, 0x0e, 0x05, 0xcb, 0xf7, 0xda, 0x4e, 0xc7, 0x1c, 0x90, 0x80, 0xf2,
+		0xb4, 0xe6, 0xc4, 0x59, 0x5b, 0x96, 0xd2, 0x0b, 0x29, 0x44, 0x5f, 0x42, 0x71, 0x58, 0xd8, 0x10,
+		0x38, 0x2f, 0x80, 0x2b, 0xa1, 0x3c, 0x84, 0x7e, 0x0b, 0x4f, 0x7b, 0x01, 0x19, 0x38, 0x7e, 0x9f,
+		0x9a, 0x13, 0x36, 0x59, 0x61, 0xb3, 0x19, 0x02, 0x8e, 0xe2, 0xb6, 0xe5, 0xbf, 0xa6, 0x60, 0x6b,
+		0x26, 0xd7, 0x79, 0xbe, 0x6a, 0x36, 0x58, 0xdd, 0x3e, 0x65, 0x24, 0x10, 0x65, 0x5c, 0x34, 0x96,
+		0xa5, 0xf4, 0x50, 0x0a, 0xf9, 0x40, 0x94, 0xe7, 0x4d, 0x55, 0x68, 0xde, 0xc8, 0x89, 0x67, 0xdd,
+		0x46, 0xbf, 0x84, 0xc5, 0xe1, 0x46, 0x79, 0xc4, 0xcc, 0x18, 0x81, 0xcb, 0xff, 0x99, 0x87, 0xd2,
+		0xf4, 0xa3, 0x80, 0x9e, 0xc1, 0xa2, 0xea, 0xb1, 0x63, 0xab, 0xac, 0x16, 0xa4, 0x40, 0xb7, 0xd1,
+		0x07, 0x40, 0xb7, 0x7e, 0x70, 0xd3, 0xee, 0xfa, 0xb7, 0x26, 0xb9, 0x23, 0x56, 0x5f, 0x50, 0x20,
+		0x2d, 0xc2, 0x7f, 0x91, 0xd8, 0xa8, 0x8f, 0x0a, 0x5e, 0x0f, 0xd1, 0xc6, 0xea, 0xed, 0xb8, 0x08,
+		0x69, 0x90, 0x0b, 0x4b, 0x9b, 0x11, 0xa5, 0x0d, 0x1f, 0xd1, 0x0b, 0xc8, 0x53, 0xeb, 0x9a, 0xd8,
+		0xfd, 0x2e, 0x11, 0x55, 0x90, 0x6d, 0x5d, 0x1a, 0xca, 0x74, 0x1b, 0x55, 0xa1, 0x30, 0x82, 0x88,
+		0x11, 0x3a, 0xff, 0x60, 0x39, 0x96, 0x87, 0x16, 0x5c, 0x86, 0xb6, 0x00, 0x28, 0xc3, 0x01, 0x93,
+		0x31, 0x64, 0x77, 0x17, 0x95, 0x44, 0xb7, 0xd1, 0x6f, 0x20, 0x1f, 0xaa, 0x85, 0xff, 0xdc, 0x83,
+		0xfe, 0x97, 0x14, 0x5e, 0x78, 0xff, 0x2d, 0xac, 0x89, 0x8d, 0x78, 0x4d, 0x70, 0xc0, 0xae, 0x08,
+		0x66, 0xd2, 0xcb, 0xc2, 0x83, 0x5e, 0x56, 0xb9, 0xd9, 0x71, 0x68, 0x25, 0x7c, 0xfd, 0x02, 0x72,
+		0x36, 0x61, 0xd8, 0xe9, 0x52, 0x6d, 0x51, 0xd8, 0x3f, 0x4f, 0xac, 0xfa, 0x39, 0xbe, 0xef, 0xfa,
+		0xd8, 0x36, 0x42, 0x30, 0xaf, 0x30, 0x66, 0x8c, 0xb8, 0x3d, 0xa6, 0x81, 0x24, 0x92, 0x7a, 0x44,
+		0xdf, 0x41, 0x5e, 0x64, 0xc7, 0x49, 0xde, 0x0f, 0x88, 0xb6, 0x34, 0xc3, 0xed, 0x91, 0xc4, 0x18,
+		0x4b, 0xdc, 0x42, 0x3d, 0xa0, 0xaf, 0x61, 0x5d, 0x38, 0xe0, 0x6d, 0x25, 0x81, 0xe9, 0xd8, 0xc4,
+		0x63, 0x0e, 0xbb, 0xd7, 0xf2, 0x82, 0x3b, 0x88, 0xeb, 0x3e, 0x0a, 0x95, 0xae, 0x34, 0xa8, 0x01,
+		0x2b, 0xaa, 0xbf, 0xa6, 0x1a, 0x81, 0xda, 0x72, 0x12, 0x85, 0x46, 0x53, 0x44, 0x9d, 0x2c, 0x35,
+		0x4b, 0x8d, 0xc2, 0x20, 0xf6, 0x8c, 0x36, 0x20, 0xdb, 0xc3, 0x7d, 0x4a, 0x6c, 0xad, 0x20, 0xae,
+		0x0d, 0xea, 0xa9, 0xfc, 0xc7, 0x0c, 0x6c, 0x4e, 0x99, 0xbf, 0x68, 0x13, 0x72, 0xe1, 0x5e, 0x4e,
+		0x89, 0x86, 0x67, 0x99, 0xdc, 0xc8, 0xb1, 0x03, 0x90, 0x7e, 0xd4, 0x01, 0xc8, 0x7c, 0xea, 0x01,
+		0xf8, 0x3d, 0x3c, 0x19, 0xab, 0x88, 0xe9, 0x30, 0xe2, 0xf2, 0x1d, 0xce, 0xaf, 0x63, 0xbb, 0x8f,
+		0xab, 0x8b, 0xce, 0x88, 0x6b, 0xac, 0x0d, 0x26, 0x64, 0x14, 0xbd, 0x81, 0x2c, 0x19, 0x10, 0x8f,
+		0x85, 0x2b, 0x7a, 0x2b, 0x79, 0xa8, 0x62, 0x86, 0xdf, 0x76, 0xfd, 0x2b, 0x43, 0x81, 0xd1, 0x21,
+		0x14, 0x3c, 0x72, 0x6b, 0x06, 0x7d, 0xcf, 0x54, 0xe6, 0xd9, 0xc7, 0x98, 0xe7, 0x3d, 0x72, 0x6b,
+		0xf4, 0xbd, 0xba, 0x30, 0x29, 0xff, 0x2d, 0x05, 0xda, 0xb4, 0xa5, 0x34, 0x7b, 0xda, 0x24, 0x8d,
+		0xeb, 0x74, 0xf2, 0xb8, 0xfe, 0xd4, 0x6b, 0x54, 0xf9, 0x4f, 0x29, 0x58, 0x8b, 0x67, 0xd9, 0xf2,
+		0x6f, 0x88, 0xc7, 0x13, 0x0c, 0x47, 0xb0, 0xbc, 0x1c, 0xcf, 0x1b, 0x0b, 0x6a, 0x06, 0x53, 0x74,
+		0x09, 0x2b, 0x63, 0x8b, 0x5a, 0x4b, 0xff, 0x7f, 0xdb, 0xd9, 0x28, 0xc4, 0x77, 0x73, 0xf9, 0x9f,
+		0xf1, 0x4b, 0xbb, 0xb8, 0x2d, 0x7a, 0x6d, 0xff, 0x47, 0x19, 0xcf, 0xcf, 0xa2, 0x77, 0xe2, 0x8c,
+		0x18, 0x1f, 0xa3, 0x6b, 0x6e, 0xe4, 0x1c, 0xcd, 0xc5, 0xce, 0x51, 0x64, 0xa8, 0xcf, 0xc7, 0x87,
+		0xfa, 0x4b, 0x28, 0xb4, 0x9d, 0x80, 0x32, 0x49, 0xaa, 0xd1, 0xc8, 0xcd, 0x0b, 0xa9, 0xa0, 0x8d,
+		0x6e, 0xa3, 0x32, 0x2c, 0x7b, 0xe4, 0x2e, 0x02, 0xca, 0xc9, 0xd9, 0xcf, 0x85, 0x21, 0x66, 0x7c,
+		0x3d, 0x2c, 0x4c, 0xac, 0x07, 0x4e, 0xbf, 0x62, 0xb4, 0x90, 0xa2, 0xab, 0xd1, 0xc5, 0x9a, 0x8a,
+		0x2f, 0xd6, 0x4f, 0xf8, 0x7e, 0x09, 0x4d, 0x7b, 0x81, 0x6f, 0x11, 0x4a, 0xe3, 0xa6, 0x99, 0x91,
+		0xe9, 0x79, 0xa8, 0x1f, 0x9a, 0x96, 0xdf, 0xc1, 0xca, 0xd8, 0x8d, 0x21, 0xbe, 0xe1, 0x53, 0xff,
+		0xcb, 0x86, 0xff, 0xf7, 0x1c, 0x6c, 0x44, 0x5e, 0x39, 0xea, 0x74, 0xc6, 0x8b, 0xbf, 0x80, 0xbc,
+		0xba, 0x8c, 0x98, 0x1e, 0x76, 0x89, 0x1a, 0x7d, 0x4b, 0x4a, 0x76, 0x86, 0x5d, 0x82, 0x7e, 0x02,
+		0x4b, 0x2e, 0xbe, 0x1b, 0x7e, 0xcf, 0xc8, 0x57, 0x5a, 0x74, 0xf1, 0x9d, 0xfa, 0x98, 0xf9, 0x06,
+		0x34, 0xbf, 0xcf, 0xae, 0xfc, 0xbe, 0x67, 0x9b, 0x6d, 0xc2, 0x78, 0x17, 0xcc, 0x38, 0x39, 0x9e,
+		0x84, 0xfa, 0x23, 0xa9, 0x56, 0x86, 0xaf, 0x61, 0x63, 0x68, 0x88, 0xad, 0x9b, 0x88, 0x99, 0xa4,
+		0xce, 0x5a, 0xa8, 0xad, 0x5a, 0x37, 0x43, 0xa3, 0x66, 0x24, 0x9a, 0x5c, 0x61, 0xdc, 0xa7, 0x3c,
+		0xfe, 0xd9, 0x07, 0xeb, 0x35, 0xcc, 0xe4, 0x84, 0xef, 0x32, 0x6e, 0xc9, 0x75, 0xe8, 0x0d, 0x6c,
+		0x3a, 0x5e, 0xf2, 0x1b, 0x48, 0xfe, 0xad, 0x2b, 0x75, 0xfc, 0x05, 0xf6, 0xe1, 0x89, 0xe3, 0x25,
+		0xe5, 0x2f, 0x19, 0x89, 0x1c, 0x6f, 0x22, 0xfd, 0xf7, 0xa3, 0x48, 0xe3, 0xd9, 0x2f, 0x3e, 0x98,
+		0x7d, 0x98, 0x45, 0x3c, 0xf9, 0x1d, 0x28, 0x86, 0x2e, 0xed, 0xee, 0xf7, 0x26, 0x75, 0x7e, 0x20,
+		0x62, 0xdd, 0x67, 0x8c, 0x82, 0x92, 0xd7, 0xba, 0xdf, 0x37, 0x9d, 0x1f, 0x08, 0x2f, 0xb8, 0x15,
+		0xf8, 0x94, 0x86, 0xf7, 0x4f, 0x99, 0x6f, 0x17, 0x77, 0xc4, 0xfe, 0xcf, 0x18, 0x6b, 0x42, 0xab,
+		0xee, 0xa1, 0x3c, 0xe1, 0x13, 0xdc, 0x29, 0xff, 0x7d, 0x0e, 0x36, 0x23, 0xbc, 0x92, 0x77, 0x70,
+		0x45, 0xac, 0x8d, 0xe1, 0xb5, 0x5d, 0x0e, 0x25, 0xf5, 0x84, 0x2a, 0xb0, 0x26, 0xbe, 0xd7, 0x88,
+		0x99, 0x40, 0xae, 0x55, 0xa9, 0x3a, 0x8c, 0x50, 0x6c, 0x9c, 0x85, 0x99, 0x49, 0x16, 0xee, 0xc3,
+		0x13, 0xce, 0xc2, 0x61, 0xef, 0x87, 0xa9, 0x4b, 0x8a, 0x21, 0x17, 0xdf, 0x35, 0x94, 0x4e, 0x65,
+		0x8e, 0x3e, 0x42, 0xc9, 0xef, 0xda, 0x84, 0x32, 0x33, 0xce, 0xcf, 0xc7, 0xde, 0x17, 0x37, 0xa5,
+		0x75, 0x23, 0x4a, 0x5e, 0x51, 0xf1, 0x0f, 0xf0, 0x54, 0x39, 0x76, 0xbc, 0x09, 0xbf, 0x0f, 0x93,
+		0x70, 0x43, 0x1a, 0xeb, 0xde, 0x98, 0xdb, 0xa4, 0x46, 0xe6, 0x12, 0x1b, 0xf9, 0x2d, 0x94, 0x78,
+		0x31, 0xa6, 0x34, 0x53, 0xb2, 0x6f, 0xc3, 0xc5, 0x77, 0x87, 0x93, 0xfd, 0xe4, 0x55, 0xe9, 0x04,
+		0xd8, 0x22, 0xed, 0x7e, 0x77, 0xf4, 0x8d, 0x43, 0x3c, 0xfb, 0xb1, 0x24, 0xdc, 0x0c, 0xad, 0xc3,
+		0xad, 0x56, 0xf7, 0xc4, 0x8d, 0x77, 0xf7, 0x5f, 0x93, 0xcb, 0x4b, 0xec, 0x8a, 0x17, 0xb0, 0x65,
+		0xd4, 0xcf, 0x4f, 0xf4, 0xc3, 0x6a, 0x4b, 0x6f, 0x9c, 0x99, 0xad, 0x6a, 0xf3, 0x9d, 0xd9, 0xba,
+		0x3c, 0xaf, 0x9b, 0xfa, 0xd9, 0x45, 0xf5, 0x44, 0xaf, 0x15, 0x3f, 0x43, 0xdb, 0xf0, 0x3c, 0x19,
+		0x52, 0x6b, 0x9c, 0x56, 0xf5, 0xb3, 0x62, 0x6a, 0xba, 0x93, 0x63, 0xbd, 0xd9, 0x6a, 0x18, 0x97,
+		0xc5, 0x34, 0xfa, 0x0a, 0x5e, 0x25, 0x43, 0x9a, 0x97, 0x67, 0x87, 0x66, 0xf3, 0xb8, 0x6a, 0xd4,
+		0xcc, 0x66, 0xab, 0xda, 0xfa, 0xd0, 0x2c, 0x66, 0xd0, 0x2b, 0xf8, 0xd9, 0x0c, 0x70, 0xf5, 0xb0,
+		0xa5, 0x5f, 0xe8, 0xad, 0xcb, 0xe2, 0x1c, 0xda, 0x85, 0x2f, 0x66, 0x06, 0x36, 0x4f, 0xeb, 0xad,
+		0x6a, 0xad, 0xda, 0xaa, 0x16, 0xe7, 0xd1, 0x4b, 0xd8, 0x9e, 0x8d, 0xbd, 0x38, 0x28, 0x66, 0xd1,
+		0x97, 0xf0, 0x79, 0x32, 0xea, 0xa8, 0xaa, 0x9f, 0x34, 0x2e, 0xea, 0x86, 0x79, 0x5a, 0x35, 0xde,
+		0xd5, 0x8d, 0x62, 0x6e, 0xd7, 0x81, 0x95, 0xb1, 0x4f, 0x69, 0xf4, 0x1c, 0x34, 0x59, 0x14, 0xb3,
+		0x71, 0x5e, 0x37, 0xa4, 0x8b, 0x51, 0x21, 0x9f, 0xc1, 0xe6, 0x84, 0xf6, 0xd0, 0xa8, 0x57, 0x5b,
+		0xf5, 0x62, 0x2a, 0x51, 0xf9, 0xe1, 0xbc, 0xc6, 0x95, 0xe9, 0xdd, 0x33, 0xc8, 0xd5, 0x4e, 0xde,
+		0x8b, 0x86, 0xad, 0x43, 0xb1, 0x76, 0xf2, 0x7e, 0xbc, 0x47, 0x1a, 0xac, 0x0f, 0xa5, 0x91, 0xfc,
+		0x8b, 0x29, 0xb4, 0x06, 0x2b, 0x43, 0x8d, 0x6a, 0x58, 0xfa, 0xed, 0x37, 0xbf, 0x7b, 0xd3, 0x71,
+		0xd8, 0x75, 0xff, 0xaa, 0x62, 0xf9, 0xee, 0x5e, 0xec, 0x2f, 0xcb, 0x4a, 0x87, 0x78, 0xf2, 0x2f,
+		0xd2, 0xd1, 0xbf, 0x97, 0xbf, 0x96, 0xbf, 0x06, 0xfb, 0x57, 0x59, 0xa1, 0x79, 0xfd, 0xdf, 0x01,
+		0x00, 0x70, 0x3a, 0x30, 0x9a, 0x8e, 0x15, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/domain.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x72, 0xdb, 0x44,
-		0x18, 0x45, 0x76, 0xea, 0x3a, 0x9f, 0x1c, 0xd7, 0x6c, 0x1b, 0xa2, 0x1a, 0x86, 0xa8, 0xe9, 0x30,
-		0x63, 0x7a, 0x21, 0x13, 0xc3, 0x40, 0x0b, 0xc3, 0x85, 0x6d, 0x69, 0x8a, 0x99, 0x10, 0x3c, 0xb2,
-		0x9b, 0x0b, 0xb8, 0xd0, 0xac, 0xa4, 0xb5, 0xbd, 0x53, 0x59, 0xab, 0x59, 0xfd, 0x84, 0xdc, 0x31,
-		0x3c, 0x16, 0x0f, 0xc1, 0x33, 0x31, 0x5a, 0xad, 0x1c, 0xff, 0x68, 0x9a, 0xde, 0xed, 0x7e, 0x3f,
-		0xe7, 0x3b, 0x3a, 0x3a, 0xbb, 0x0b, 0x7a, 0xea, 0x12, 0xde, 0xf7, 0xb0, 0x4f, 0x42, 0x8f, 0xf4,
-		0x71, 0x44, 0xfb, 0xd9, 0x65, 0xdf, 0x67, 0x6b, 0x4c, 0x43, 0x23, 0xe2, 0x2c, 0x61, 0xe8, 0x69,
-		0x5e, 0x61, 0xc8, 0x0a, 0x03, 0x47, 0xd4, 0xc8, 0x2e, 0xbb, 0x5f, 0x2e, 0x19, 0x5b, 0x06, 0xa4,
-		0x2f, 0x4a, 0xdc, 0x74, 0xd1, 0xf7, 0x53, 0x8e, 0x13, 0xca, 0x64, 0x53, 0xf7, 0x7c, 0x3f, 0x9f,
-		0xd0, 0x35, 0x89, 0x13, 0xbc, 0x8e, 0x8a, 0x82, 0x8b, 0xff, 0x1e, 0x43, 0xc3, 0x14, 0x63, 0x50,
-		0x1b, 0x6a, 0xd4, 0xd7, 0x14, 0x5d, 0xe9, 0x1d, 0xdb, 0x35, 0xea, 0x23, 0x04, 0x47, 0x21, 0x5e,
-		0x13, 0xad, 0x26, 0x22, 0x62, 0x8d, 0xde, 0x40, 0x23, 0x4e, 0x70, 0x92, 0xc6, 0x5a, 0x5d, 0x57,
-		0x7a, 0xed, 0xc1, 0x0b, 0xa3, 0x82, 0x95, 0x51, 0x00, 0xce, 0x44, 0xa1, 0x2d, 0x1b, 0x90, 0x0e,
-		0xaa, 0x4f, 0x62, 0x8f, 0xd3, 0x28, 0xe7, 0xa7, 0x1d, 0x09, 0xd4, 0xed, 0x10, 0x3a, 0x07, 0x95,
-		0xdd, 0x86, 0x84, 0x3b, 0x64, 0x8d, 0x69, 0xa0, 0x3d, 0x12, 0x15, 0x20, 0x42, 0x56, 0x1e, 0x41,
-		0x6f, 0xe0, 0xc8, 0xc7, 0x09, 0xd6, 0x1a, 0x7a, 0xbd, 0xa7, 0x0e, 0xbe, 0xfa, 0xc0, 0x6c, 0xc3,
-		0xc4, 0x09, 0xb6, 0xc2, 0x84, 0xdf, 0xd9, 0xa2, 0x05, 0xad, 0xe0, 0xe5, 0x2d, 0xe3, 0xef, 0x17,
-		0x01, 0xbb, 0x75, 0xc8, 0x5f, 0xc4, 0x4b, 0xf3, 0x89, 0x0e, 0x27, 0x09, 0x09, 0xc5, 0x2a, 0x22,
-		0x9c, 0x32, 0x5f, 0x7b, 0xac, 0x2b, 0x3d, 0x75, 0xf0, 0xdc, 0x28, 0x64, 0x33, 0x4a, 0xd9, 0x0c,
-		0x53, 0xca, 0x6a, 0xeb, 0x25, 0x8a, 0x55, 0x82, 0xd8, 0x25, 0xc6, 0x54, 0x40, 0xa0, 0x31, 0xb4,
-		0x5c, 0xec, 0x3b, 0x2e, 0x0d, 0x31, 0xa7, 0x24, 0xd6, 0x9a, 0x02, 0x52, 0xaf, 0x24, 0x3b, 0xc2,
-		0xfe, 0x48, 0xd6, 0xd9, 0xaa, 0x7b, 0xbf, 0x41, 0x7f, 0xc2, 0xd9, 0x8a, 0xc6, 0x09, 0xe3, 0x77,
-		0x0e, 0xe6, 0xde, 0x8a, 0x66, 0x38, 0x70, 0xa4, 0xf0, 0xc7, 0x42, 0xf8, 0x97, 0x95, 0x78, 0x43,
-		0x59, 0x2b, 0xa5, 0x3f, 0x95, 0x18, 0xbb, 0x61, 0xf4, 0x0d, 0x3c, 0x3b, 0x00, 0x4f, 0x39, 0xd5,
-		0x40, 0x08, 0x8e, 0xf6, 0x9a, 0xde, 0x71, 0x8a, 0x30, 0x74, 0x33, 0x1a, 0x53, 0x97, 0x06, 0x34,
-		0x39, 0x64, 0xa4, 0x7e, 0x3c, 0x23, 0xed, 0x1e, 0x66, 0x8f, 0xd4, 0xf7, 0x70, 0x56, 0x35, 0x22,
-		0xe7, 0xd5, 0x12, 0xbc, 0x4e, 0x0f, 0x5b, 0x73, 0x6a, 0x06, 0x3c, 0xc5, 0x5e, 0x42, 0x33, 0xe2,
-		0x78, 0x41, 0x1a, 0x27, 0x84, 0x3b, 0xc2, 0xb4, 0x27, 0xa2, 0xe7, 0xd3, 0x22, 0x35, 0x2e, 0x32,
-		0xd7, 0xb9, 0x83, 0xa7, 0xd0, 0x94, 0x85, 0xb1, 0xd6, 0x16, 0x3e, 0xfa, 0xae, 0x92, 0xb8, 0xec,
-		0xb1, 0x49, 0x14, 0x50, 0x4f, 0xfc, 0xfb, 0x31, 0x0b, 0x17, 0x74, 0x59, 0x1a, 0x61, 0x83, 0x82,
-		0xbe, 0x86, 0xce, 0x02, 0xd3, 0x80, 0x65, 0x84, 0x3b, 0x19, 0xe1, 0x71, 0xee, 0xee, 0x27, 0xba,
-		0xd2, 0xab, 0xdb, 0x4f, 0xca, 0xf8, 0x4d, 0x11, 0x46, 0x3d, 0xe8, 0xd0, 0xd8, 0x59, 0x06, 0xcc,
-		0xc5, 0x81, 0x53, 0x9c, 0x6e, 0xad, 0xa3, 0x2b, 0xbd, 0xa6, 0xdd, 0xa6, 0xf1, 0x5b, 0x11, 0x2e,
-		0xfc, 0xdb, 0xfd, 0x01, 0x8e, 0x37, 0x16, 0x46, 0x1d, 0xa8, 0xbf, 0x27, 0x77, 0xf2, 0x68, 0xe6,
-		0x4b, 0xf4, 0x0c, 0x1e, 0x65, 0x38, 0x48, 0xcb, 0xc3, 0x59, 0x6c, 0x7e, 0xac, 0xbd, 0x56, 0x2e,
-		0x4c, 0x38, 0x7f, 0x80, 0x3a, 0x7a, 0x01, 0xad, 0x1d, 0xad, 0x0a, 0x5c, 0xd5, 0xbb, 0x57, 0xe9,
-		0xe2, 0x5f, 0x05, 0xd4, 0x2d, 0x73, 0xa2, 0x5f, 0xa1, 0xb9, 0x31, 0xb4, 0x22, 0x54, 0x33, 0x1e,
-		0x32, 0xb4, 0x51, 0x2e, 0x8a, 0x63, 0xb8, 0xe9, 0xef, 0x3a, 0x70, 0xb2, 0x93, 0xaa, 0xf8, 0xbc,
-		0xd7, 0xdb, 0x9f, 0xa7, 0x0e, 0x2e, 0x3e, 0x38, 0xeb, 0x6e, 0x12, 0x2e, 0xd8, 0xb6, 0x04, 0xff,
-		0x28, 0x70, 0xb2, 0x93, 0x44, 0x9f, 0x41, 0x83, 0x13, 0x1c, 0xb3, 0x50, 0x0e, 0x91, 0x3b, 0xd4,
-		0x85, 0x26, 0x8b, 0x08, 0xc7, 0x09, 0xe3, 0x52, 0xc9, 0xcd, 0x1e, 0xfd, 0x0c, 0x2d, 0x8f, 0x13,
-		0x9c, 0x10, 0xdf, 0xc9, 0x2f, 0x4d, 0x71, 0xe1, 0xa9, 0x83, 0xee, 0xc1, 0xd5, 0x30, 0x2f, 0x6f,
-		0x54, 0x5b, 0x95, 0xf5, 0x79, 0xe4, 0xd5, 0xdf, 0x0a, 0xb4, 0xb6, 0xef, 0x41, 0xf4, 0x1c, 0x4e,
-		0xcd, 0xdf, 0x7f, 0x1b, 0x4e, 0xae, 0x9d, 0xd9, 0x7c, 0x38, 0x7f, 0x37, 0x73, 0x26, 0xd7, 0x37,
-		0xc3, 0xab, 0x89, 0xd9, 0xf9, 0x04, 0x7d, 0x01, 0xda, 0x6e, 0xca, 0xb6, 0xde, 0x4e, 0x66, 0x73,
-		0xcb, 0xb6, 0xcc, 0x8e, 0x72, 0x98, 0x35, 0xad, 0xa9, 0x6d, 0x8d, 0x87, 0x73, 0xcb, 0xec, 0xd4,
-		0x0e, 0x61, 0x4d, 0xeb, 0xca, 0xca, 0x53, 0xf5, 0x57, 0x2b, 0x68, 0xef, 0x1d, 0xb2, 0xcf, 0xe1,
-		0x6c, 0x68, 0x8f, 0x7f, 0x99, 0xdc, 0x0c, 0xaf, 0x2a, 0x59, 0xec, 0x27, 0xcd, 0xc9, 0x6c, 0x38,
-		0xba, 0x12, 0x2c, 0x2a, 0x5a, 0xad, 0xeb, 0x22, 0x59, 0x1b, 0xdd, 0xc0, 0x99, 0xc7, 0xd6, 0x55,
-		0x7f, 0x69, 0xd4, 0x1c, 0x46, 0x74, 0x9a, 0x6b, 0x35, 0x55, 0xfe, 0xe8, 0x2f, 0x69, 0xb2, 0x4a,
-		0x5d, 0xc3, 0x63, 0xeb, 0xfe, 0xce, 0x7b, 0x67, 0x2c, 0x49, 0x58, 0xbc, 0x51, 0xf2, 0xe9, 0xfb,
-		0x09, 0x47, 0x34, 0xbb, 0x74, 0x1b, 0x22, 0xf6, 0xed, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd4,
-		0x52, 0xa7, 0xe0, 0x1e, 0x07, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x5f, 0x6f, 0xe3, 0x44,
+		0x10, 0xc7, 0x49, 0x1b, 0xd2, 0x71, 0x9b, 0x4b, 0xf7, 0xda, 0xab, 0x2f, 0x20, 0x9a, 0xeb, 0x09,
+		0x29, 0xdc, 0x83, 0x43, 0xcb, 0x09, 0x5a, 0x10, 0x0f, 0x69, 0x6c, 0xdd, 0x05, 0x95, 0x12, 0x39,
+		0xbd, 0x22, 0xc1, 0xc3, 0x6a, 0x63, 0x6f, 0xd3, 0xd5, 0xd9, 0x5e, 0xb3, 0x5e, 0xa7, 0xed, 0x3d,
+		0x21, 0xde, 0xf8, 0x4a, 0x7c, 0x12, 0x3e, 0x0e, 0xf2, 0x7a, 0x9d, 0x36, 0x7f, 0xd4, 0xe3, 0x6d,
+		0x77, 0x7e, 0x33, 0xbf, 0xf9, 0xed, 0x78, 0x66, 0x12, 0x68, 0x67, 0x63, 0x2a, 0xba, 0x3e, 0x09,
+		0x68, 0xec, 0xd3, 0x2e, 0x49, 0x58, 0x77, 0x7a, 0xd8, 0x0d, 0x78, 0x44, 0x58, 0x6c, 0x27, 0x82,
+		0x4b, 0x8e, 0x9e, 0xe6, 0x1e, 0xb6, 0xf6, 0xb0, 0x49, 0xc2, 0xec, 0xe9, 0x61, 0xeb, 0x8b, 0x09,
+		0xe7, 0x93, 0x90, 0x76, 0x95, 0xcb, 0x38, 0xbb, 0xea, 0x06, 0x99, 0x20, 0x92, 0x71, 0x1d, 0xd4,
+		0xda, 0x5f, 0xc4, 0x25, 0x8b, 0x68, 0x2a, 0x49, 0x94, 0x14, 0x0e, 0x07, 0x7f, 0xd7, 0xa1, 0xe6,
+		0xa8, 0x34, 0xa8, 0x01, 0x15, 0x16, 0x58, 0x46, 0xdb, 0xe8, 0x6c, 0x78, 0x15, 0x16, 0x20, 0x04,
+		0x6b, 0x31, 0x89, 0xa8, 0x55, 0x51, 0x16, 0x75, 0x46, 0x27, 0x50, 0x4b, 0x25, 0x91, 0x59, 0x6a,
+		0x55, 0xdb, 0x46, 0xa7, 0x71, 0xf4, 0xc2, 0x5e, 0xa1, 0xca, 0x2e, 0x08, 0x47, 0xca, 0xd1, 0xd3,
+		0x01, 0xa8, 0x0d, 0x66, 0x40, 0x53, 0x5f, 0xb0, 0x24, 0xd7, 0x67, 0xad, 0x29, 0xd6, 0x87, 0x26,
+		0xb4, 0x0f, 0x26, 0xbf, 0x89, 0xa9, 0xc0, 0x34, 0x22, 0x2c, 0xb4, 0xd6, 0x95, 0x07, 0x28, 0x93,
+		0x9b, 0x5b, 0xd0, 0x09, 0xac, 0x05, 0x44, 0x12, 0xab, 0xd6, 0xae, 0x76, 0xcc, 0xa3, 0x2f, 0x1f,
+		0xc9, 0x6d, 0x3b, 0x44, 0x12, 0x37, 0x96, 0xe2, 0xce, 0x53, 0x21, 0xe8, 0x1a, 0x5e, 0xde, 0x70,
+		0xf1, 0xfe, 0x2a, 0xe4, 0x37, 0x98, 0xde, 0x52, 0x3f, 0xcb, 0x33, 0x62, 0x41, 0x25, 0x8d, 0xd5,
+		0x29, 0xa1, 0x82, 0xf1, 0xc0, 0xfa, 0xb4, 0x6d, 0x74, 0xcc, 0xa3, 0xe7, 0x76, 0x51, 0x36, 0xbb,
+		0x2c, 0x9b, 0xed, 0xe8, 0xb2, 0x7a, 0xed, 0x92, 0xc5, 0x2d, 0x49, 0xbc, 0x92, 0x63, 0xa8, 0x28,
+		0x50, 0x1f, 0x36, 0xc7, 0x24, 0xc0, 0x63, 0x16, 0x13, 0xc1, 0x68, 0x6a, 0xd5, 0x15, 0x65, 0x7b,
+		0xa5, 0xd8, 0x53, 0x12, 0x9c, 0x6a, 0x3f, 0xcf, 0x1c, 0xdf, 0x5f, 0xd0, 0xef, 0xb0, 0x77, 0xcd,
+		0x52, 0xc9, 0xc5, 0x1d, 0x26, 0xc2, 0xbf, 0x66, 0x53, 0x12, 0x62, 0x5d, 0xf8, 0x0d, 0x55, 0xf8,
+		0x97, 0x2b, 0xf9, 0x7a, 0xda, 0x57, 0x97, 0x7e, 0x57, 0x73, 0xcc, 0x9b, 0xd1, 0xd7, 0xb0, 0xb3,
+		0x44, 0x9e, 0x09, 0x66, 0x81, 0x2a, 0x38, 0x5a, 0x08, 0x7a, 0x27, 0x18, 0x22, 0xd0, 0x9a, 0xb2,
+		0x94, 0x8d, 0x59, 0xc8, 0xe4, 0xb2, 0x22, 0xf3, 0xff, 0x2b, 0xb2, 0xee, 0x69, 0x16, 0x44, 0x7d,
+		0x0b, 0x7b, 0xab, 0x52, 0xe4, 0xba, 0x36, 0x95, 0xae, 0xdd, 0xe5, 0xd0, 0x5c, 0x9a, 0x0d, 0x4f,
+		0x89, 0x2f, 0xd9, 0x94, 0x62, 0x3f, 0xcc, 0x52, 0x49, 0x05, 0x56, 0x4d, 0xbb, 0xa5, 0x62, 0xb6,
+		0x0b, 0xa8, 0x5f, 0x20, 0xe7, 0x79, 0x07, 0x0f, 0xa1, 0xae, 0x1d, 0x53, 0xab, 0xa1, 0xfa, 0xe8,
+		0xf5, 0x4a, 0xe1, 0x3a, 0xc6, 0xa3, 0x49, 0xc8, 0x7c, 0xf5, 0xed, 0xfb, 0x3c, 0xbe, 0x62, 0x93,
+		0xb2, 0x11, 0x66, 0x2c, 0xe8, 0x2b, 0x68, 0x5e, 0x11, 0x16, 0xf2, 0x29, 0x15, 0x78, 0x4a, 0x45,
+		0x9a, 0x77, 0xf7, 0x93, 0xb6, 0xd1, 0xa9, 0x7a, 0x4f, 0x4a, 0xfb, 0x65, 0x61, 0x46, 0x1d, 0x68,
+		0xb2, 0x14, 0x4f, 0x42, 0x3e, 0x26, 0x21, 0x2e, 0xa6, 0xdb, 0x6a, 0xb6, 0x8d, 0x4e, 0xdd, 0x6b,
+		0xb0, 0xf4, 0x8d, 0x32, 0xeb, 0x61, 0x3c, 0x81, 0x5a, 0xc8, 0x22, 0x26, 0x53, 0x6b, 0x5b, 0xf5,
+		0xcf, 0x63, 0x83, 0x76, 0xa6, 0x1c, 0x3d, 0x1d, 0xd0, 0xfa, 0x0e, 0x36, 0x66, 0xdd, 0x8f, 0x9a,
+		0x50, 0x7d, 0x4f, 0xef, 0xf4, 0x54, 0xe7, 0x47, 0xb4, 0x03, 0xeb, 0x53, 0x12, 0x66, 0xe5, 0x5c,
+		0x17, 0x97, 0xef, 0x2b, 0xc7, 0xc6, 0x81, 0x03, 0xfb, 0x1f, 0x79, 0x35, 0x7a, 0x01, 0x9b, 0x73,
+		0x65, 0x2e, 0x78, 0x4d, 0xff, 0xbe, 0xc0, 0x07, 0xff, 0x18, 0x60, 0x3e, 0xe8, 0x6b, 0xf4, 0x13,
+		0xd4, 0x67, 0xb3, 0x60, 0xa8, 0x82, 0xdb, 0x1f, 0x9b, 0x05, 0xbb, 0x3c, 0x14, 0x13, 0x3c, 0x8b,
+		0x6f, 0x61, 0xd8, 0x9a, 0x83, 0x56, 0x3c, 0xef, 0xf8, 0xe1, 0xf3, 0xcc, 0xa3, 0x83, 0x47, 0x73,
+		0xdd, 0x0d, 0xe2, 0x2b, 0xfe, 0xb0, 0x04, 0x7f, 0x19, 0xb0, 0x35, 0x07, 0xa2, 0x67, 0x50, 0x13,
+		0x94, 0xa4, 0x3c, 0xd6, 0x49, 0xf4, 0x0d, 0xb5, 0xa0, 0xce, 0x13, 0x2a, 0x88, 0xe4, 0x42, 0x57,
+		0x72, 0x76, 0x47, 0x3f, 0xc2, 0xa6, 0x2f, 0x28, 0x91, 0x34, 0xc0, 0xf9, 0xbe, 0x55, 0xbb, 0xd2,
+		0x3c, 0x6a, 0x2d, 0x6d, 0x95, 0x8b, 0x72, 0x19, 0x7b, 0xa6, 0xf6, 0xcf, 0x2d, 0x07, 0xff, 0x1a,
+		0xb0, 0xf9, 0xf0, 0xcb, 0xe6, 0x3d, 0x2e, 0xe8, 0x1f, 0x19, 0x4d, 0x65, 0x9a, 0x2f, 0x2a, 0x9c,
+		0x52, 0x9f, 0xc7, 0xc5, 0xaa, 0x5e, 0xf7, 0xb6, 0x4b, 0x68, 0x48, 0xc5, 0x48, 0x01, 0xe8, 0x18,
+		0xac, 0x88, 0xdc, 0x62, 0x9f, 0xc7, 0x7e, 0x26, 0x04, 0x8d, 0x25, 0x2e, 0xb7, 0x56, 0xaa, 0xb4,
+		0xae, 0x7b, 0xcf, 0x22, 0x72, 0xdb, 0x9f, 0xc1, 0xbf, 0x96, 0x28, 0x7a, 0x0d, 0x39, 0x82, 0x13,
+		0x1a, 0x07, 0x2c, 0x9e, 0x60, 0x35, 0x3e, 0x4c, 0xe6, 0x9f, 0xae, 0xaa, 0xe2, 0x76, 0x22, 0x72,
+		0x3b, 0x2c, 0xc0, 0xde, 0x0c, 0xcb, 0xdb, 0x3a, 0x8f, 0x2a, 0x97, 0x4a, 0xca, 0x3e, 0x50, 0xb5,
+		0xdf, 0xab, 0x5e, 0x23, 0x22, 0xb7, 0x6f, 0x0b, 0xf3, 0x88, 0x7d, 0xa0, 0xaf, 0xfe, 0x9c, 0x3d,
+		0x4d, 0x8f, 0xfd, 0x73, 0xd8, 0x75, 0x7e, 0xf9, 0xb9, 0x37, 0x38, 0xc7, 0xa3, 0x8b, 0xde, 0xc5,
+		0xbb, 0x11, 0x1e, 0x9c, 0x5f, 0xf6, 0xce, 0x06, 0x4e, 0xf3, 0x13, 0xf4, 0x39, 0x58, 0xf3, 0x90,
+		0xe7, 0xbe, 0x19, 0x8c, 0x2e, 0x5c, 0xcf, 0x75, 0x9a, 0xc6, 0x32, 0xea, 0xb8, 0x43, 0xcf, 0xed,
+		0xf7, 0x2e, 0x5c, 0xa7, 0x59, 0x59, 0xa6, 0x75, 0xdc, 0x33, 0x37, 0x87, 0xaa, 0xaf, 0xae, 0xa1,
+		0xb1, 0xb0, 0x7a, 0x3e, 0x83, 0xbd, 0x9e, 0xd7, 0x7f, 0x3b, 0xb8, 0xec, 0x9d, 0xad, 0x54, 0xb1,
+		0x08, 0x3a, 0x83, 0x51, 0xef, 0xf4, 0x4c, 0xa9, 0x58, 0x11, 0xea, 0x9e, 0x17, 0x60, 0xe5, 0xf4,
+		0x12, 0xf6, 0x7c, 0x1e, 0xad, 0x6a, 0xc0, 0xd3, 0x7a, 0x2f, 0x61, 0xc3, 0xbc, 0x0d, 0x86, 0xc6,
+		0x6f, 0xdd, 0x09, 0x93, 0xd7, 0xd9, 0xd8, 0xf6, 0x79, 0xd4, 0x9d, 0xfb, 0x17, 0x60, 0x4f, 0x68,
+		0x5c, 0xfc, 0x72, 0xeb, 0x3f, 0x04, 0x3f, 0x90, 0x84, 0x4d, 0x0f, 0xc7, 0x35, 0x65, 0xfb, 0xe6,
+		0xbf, 0x01, 0x00, 0x20, 0x24, 0xae, 0x25, 0x34, 0x08, 0x00, 0x00,
 	},
 }
 
@@ -61,6 +61,8 @@ type Decision struct {
 	//	*Decision_StartChildWorkflowExecutionDecisionAttributes
 	//	*Decision_SignalExternalWorkflowExecutionDecisionAttributes
 	//	*Decision_UpsertWorkflowSearchAttributesDecisionAttributes
+	//	*Decision_CompleteWorkflowUpdateDecisionAttributes
+	//	*Decision_UpsertWorkflowMemoDecisionAttributes
 	Attributes           isDecision_Attributes `protobuf_oneof:"attributes"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
@@ -145,6 +147,12 @@ type Decision_SignalExternalWorkflowExecutionDecisionAttributes struct {
 type Decision_UpsertWorkflowSearchAttributesDecisionAttributes struct {
 	UpsertWorkflowSearchAttributesDecisionAttributes *UpsertWorkflowSearchAttributesDecisionAttributes `protobuf:"bytes,13,opt,name=upsert_workflow_search_attributes_decision_attributes,json=upsertWorkflowSearchAttributesDecisionAttributes,proto3,oneof" json:"upsert_workflow_search_attributes_decision_attributes,omitempty"`
 }
+type Decision_CompleteWorkflowUpdateDecisionAttributes struct {
+	CompleteWorkflowUpdateDecisionAttributes *CompleteWorkflowUpdateDecisionAttributes `protobuf:"bytes,14,opt,name=complete_workflow_update_decision_attributes,json=completeWorkflowUpdateDecisionAttributes,proto3,oneof" json:"complete_workflow_update_decision_attributes,omitempty"`
+}
+type Decision_UpsertWorkflowMemoDecisionAttributes struct {
+	UpsertWorkflowMemoDecisionAttributes *UpsertWorkflowMemoDecisionAttributes `protobuf:"bytes,15,opt,name=upsert_workflow_memo_decision_attributes,json=upsertWorkflowMemoDecisionAttributes,proto3,oneof" json:"upsert_workflow_memo_decision_attributes,omitempty"`
+}
 
 func (*Decision_ScheduleActivityTaskDecisionAttributes) isDecision_Attributes()                   {}
 func (*Decision_StartTimerDecisionAttributes) isDecision_Attributes()                             {}
@@ -159,6 +167,8 @@ func (*Decision_ContinueAsNewWorkflowExecutionDecisionAttributes) isDecision_Att
 func (*Decision_StartChildWorkflowExecutionDecisionAttributes) isDecision_Attributes()            {}
 func (*Decision_SignalExternalWorkflowExecutionDecisionAttributes) isDecision_Attributes()        {}
 func (*Decision_UpsertWorkflowSearchAttributesDecisionAttributes) isDecision_Attributes()         {}
+func (*Decision_CompleteWorkflowUpdateDecisionAttributes) isDecision_Attributes()                 {}
+func (*Decision_UpsertWorkflowMemoDecisionAttributes) isDecision_Attributes()                     {}
 
 func (m *Decision) GetAttributes() isDecision_Attributes {
 	if m != nil {
@@ -258,6 +268,20 @@ func (m *Decision) GetUpsertWorkflowSearchAttributesDecisionAttributes() *Upsert
 	return nil
 }
 
+func (m *Decision) GetCompleteWorkflowUpdateDecisionAttributes() *CompleteWorkflowUpdateDecisionAttributes {
+	if x, ok := m.GetAttributes().(*Decision_CompleteWorkflowUpdateDecisionAttributes); ok {
+		return x.CompleteWorkflowUpdateDecisionAttributes
+	}
+	return nil
+}
+
+func (m *Decision) GetUpsertWorkflowMemoDecisionAttributes() *UpsertWorkflowMemoDecisionAttributes {
+	if x, ok := m.GetAttributes().(*Decision_UpsertWorkflowMemoDecisionAttributes); ok {
+		return x.UpsertWorkflowMemoDecisionAttributes
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Decision) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -274,6 +298,8 @@ func (*Decision) XXX_OneofWrappers() []interface{} {
 		(*Decision_StartChildWorkflowExecutionDecisionAttributes)(nil),
 		(*Decision_SignalExternalWorkflowExecutionDecisionAttributes)(nil),
 		(*Decision_UpsertWorkflowSearchAttributesDecisionAttributes)(nil),
+		(*Decision_CompleteWorkflowUpdateDecisionAttributes)(nil),
+		(*Decision_UpsertWorkflowMemoDecisionAttributes)(nil),
 	}
 }
 
@@ -1316,6 +1342,118 @@ func (m *UpsertWorkflowSearchAttributesDecisionAttributes) GetSearchAttributes()
 	return nil
 }
 
+type CompleteWorkflowUpdateDecisionAttributes struct {
+	UpdateId             string   `protobuf:"bytes,1,opt,name=update_id,json=updateId,proto3" json:"update_id,omitempty"`
+	Result               *Payload `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	ErrorMessage         string   `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompleteWorkflowUpdateDecisionAttributes) Reset() {
+	*m = CompleteWorkflowUpdateDecisionAttributes{}
+}
+func (m *CompleteWorkflowUpdateDecisionAttributes) String() string { return proto.CompactTextString(m) }
+func (*CompleteWorkflowUpdateDecisionAttributes) ProtoMessage()    {}
+func (*CompleteWorkflowUpdateDecisionAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb529b236ea74dc2, []int{14}
+}
+func (m *CompleteWorkflowUpdateDecisionAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompleteWorkflowUpdateDecisionAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompleteWorkflowUpdateDecisionAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompleteWorkflowUpdateDecisionAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompleteWorkflowUpdateDecisionAttributes.Merge(m, src)
+}
+func (m *CompleteWorkflowUpdateDecisionAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompleteWorkflowUpdateDecisionAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompleteWorkflowUpdateDecisionAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompleteWorkflowUpdateDecisionAttributes proto.InternalMessageInfo
+
+func (m *CompleteWorkflowUpdateDecisionAttributes) GetUpdateId() string {
+	if m != nil {
+		return m.UpdateId
+	}
+	return ""
+}
+
+func (m *CompleteWorkflowUpdateDecisionAttributes) GetResult() *Payload {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *CompleteWorkflowUpdateDecisionAttributes) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+type UpsertWorkflowMemoDecisionAttributes struct {
+	Memo                 *Memo    `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpsertWorkflowMemoDecisionAttributes) Reset()         { *m = UpsertWorkflowMemoDecisionAttributes{} }
+func (m *UpsertWorkflowMemoDecisionAttributes) String() string { return proto.CompactTextString(m) }
+func (*UpsertWorkflowMemoDecisionAttributes) ProtoMessage()    {}
+func (*UpsertWorkflowMemoDecisionAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb529b236ea74dc2, []int{15}
+}
+func (m *UpsertWorkflowMemoDecisionAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpsertWorkflowMemoDecisionAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpsertWorkflowMemoDecisionAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpsertWorkflowMemoDecisionAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertWorkflowMemoDecisionAttributes.Merge(m, src)
+}
+func (m *UpsertWorkflowMemoDecisionAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpsertWorkflowMemoDecisionAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertWorkflowMemoDecisionAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertWorkflowMemoDecisionAttributes proto.InternalMessageInfo
+
+func (m *UpsertWorkflowMemoDecisionAttributes) GetMemo() *Memo {
+	if m != nil {
+		return m.Memo
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Decision)(nil), "uber.cadence.api.v1.Decision")
 	proto.RegisterType((*ScheduleActivityTaskDecisionAttributes)(nil), "uber.cadence.api.v1.ScheduleActivityTaskDecisionAttributes")
@@ -1331,6 +1469,8 @@ func init() {
 	proto.RegisterType((*StartChildWorkflowExecutionDecisionAttributes)(nil), "uber.cadence.api.v1.StartChildWorkflowExecutionDecisionAttributes")
 	proto.RegisterType((*SignalExternalWorkflowExecutionDecisionAttributes)(nil), "uber.cadence.api.v1.SignalExternalWorkflowExecutionDecisionAttributes")
 	proto.RegisterType((*UpsertWorkflowSearchAttributesDecisionAttributes)(nil), "uber.cadence.api.v1.UpsertWorkflowSearchAttributesDecisionAttributes")
+	proto.RegisterType((*CompleteWorkflowUpdateDecisionAttributes)(nil), "uber.cadence.api.v1.CompleteWorkflowUpdateDecisionAttributes")
+	proto.RegisterType((*UpsertWorkflowMemoDecisionAttributes)(nil), "uber.cadence.api.v1.UpsertWorkflowMemoDecisionAttributes")
 }
 
 func init() {
@@ -1338,105 +1478,112 @@ func init() {
 }
 
 var fileDescriptor_fb529b236ea74dc2 = []byte{
-	// 1564 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4d, 0x4f, 0x1c, 0xc7,
-	0x16, 0x7d, 0xcd, 0xc7, 0x7c, 0x5c, 0xc0, 0xcf, 0x14, 0x36, 0x06, 0x1b, 0x03, 0x9e, 0xa7, 0x87,
-	0x9f, 0x8d, 0x98, 0x01, 0xec, 0x67, 0x59, 0xf6, 0x93, 0xf5, 0x00, 0x1b, 0x19, 0xc9, 0xc6, 0xa8,
-	0x8d, 0x63, 0x29, 0x9b, 0x56, 0x4d, 0x75, 0x01, 0x25, 0x7a, 0xba, 0x27, 0xd5, 0xd5, 0xe0, 0x89,
-	0x14, 0x29, 0xab, 0x24, 0x9b, 0xfc, 0x80, 0x48, 0x59, 0x65, 0x95, 0x6c, 0x92, 0x6d, 0xa2, 0xac,
-	0xb2, 0xcb, 0x32, 0x59, 0x64, 0x1f, 0xf9, 0x17, 0x44, 0xca, 0x1f, 0x88, 0xaa, 0xba, 0xba, 0x67,
-	0x18, 0x7a, 0x7a, 0xba, 0xb1, 0xe3, 0x1d, 0x5d, 0x7d, 0xef, 0xa9, 0x53, 0x75, 0xab, 0xee, 0x39,
-	0xcd, 0x40, 0x25, 0xa8, 0x53, 0x5e, 0x23, 0xd8, 0xa6, 0x2e, 0xa1, 0x35, 0xdc, 0x64, 0xb5, 0xa3,
-	0x95, 0x9a, 0x4d, 0x09, 0xf3, 0x99, 0xe7, 0x56, 0x9b, 0xdc, 0x13, 0x1e, 0x9a, 0x90, 0x31, 0x55,
-	0x1d, 0x53, 0xc5, 0x4d, 0x56, 0x3d, 0x5a, 0xb9, 0x3c, 0xbb, 0xef, 0x79, 0xfb, 0x0e, 0xad, 0xa9,
-	0x90, 0x7a, 0xb0, 0x57, 0xb3, 0x03, 0x8e, 0x45, 0x9c, 0x74, 0x79, 0x3e, 0x09, 0x98, 0x78, 0x8d,
-	0x46, 0x1c, 0x91, 0x38, 0xb5, 0xc0, 0xfe, 0xa1, 0xc3, 0x7c, 0x91, 0x16, 0x73, 0xec, 0xf1, 0xc3,
-	0x3d, 0xc7, 0x3b, 0x0e, 0x63, 0x2a, 0x9f, 0x8d, 0x43, 0xe9, 0xa1, 0x66, 0x8c, 0xbe, 0x30, 0xe0,
-	0xa6, 0x4f, 0x0e, 0xa8, 0x1d, 0x38, 0xd4, 0xc2, 0x44, 0xb0, 0x23, 0x26, 0x5a, 0x96, 0x44, 0xb5,
-	0xa2, 0x55, 0x59, 0x58, 0x08, 0xce, 0xea, 0x81, 0xa0, 0xfe, 0x94, 0x31, 0x6f, 0xfc, 0x67, 0x64,
-	0xf5, 0x7e, 0x35, 0x61, 0x85, 0xd5, 0xe7, 0x1a, 0x66, 0x4d, 0xa3, 0xec, 0x62, 0xff, 0x30, 0x9a,
-	0x67, 0x2d, 0x86, 0x78, 0xfc, 0x0f, 0x73, 0xc1, 0xcf, 0x14, 0x89, 0x3e, 0x84, 0x39, 0x5f, 0x60,
-	0x2e, 0x2c, 0xc1, 0x1a, 0x94, 0x27, 0xf2, 0x19, 0x50, 0x7c, 0x56, 0x92, 0xf9, 0xc8, 0xdc, 0x5d,
-	0x99, 0x9a, 0xc8, 0x62, 0xc6, 0x4f, 0x79, 0x8f, 0xbe, 0x36, 0x40, 0xee, 0x7e, 0xd3, 0xa1, 0x82,
-	0x5a, 0xd1, 0x06, 0x5a, 0xf4, 0x15, 0x25, 0x81, 0x2c, 0x5a, 0x22, 0x99, 0x41, 0x45, 0xe6, 0xff,
-	0x89, 0x64, 0x36, 0x34, 0xd6, 0x4b, 0x0d, 0xf5, 0x28, 0x42, 0x4a, 0xe4, 0xb6, 0x48, 0xb2, 0x87,
-	0xa3, 0x2f, 0x0d, 0x58, 0xdc, 0xc3, 0xcc, 0xc9, 0x4a, 0x73, 0x48, 0xd1, 0xfc, 0x5f, 0x22, 0xcd,
-	0x4d, 0xcc, 0x9c, 0x6c, 0x14, 0xaf, 0xef, 0x65, 0x0b, 0x45, 0xdf, 0x18, 0xb0, 0xcc, 0xe9, 0x07,
-	0x01, 0xf5, 0x85, 0x45, 0xb0, 0x4b, 0xa8, 0x93, 0xe1, 0x9c, 0x0d, 0xa7, 0x6c, 0xa5, 0x19, 0x82,
-	0x6d, 0x28, 0xac, 0xbe, 0x87, 0x6d, 0x91, 0x67, 0x0f, 0x47, 0x1f, 0xc1, 0xbc, 0xa6, 0xd8, 0xfb,
-	0xc8, 0x15, 0x14, 0xb5, 0xd5, 0xe4, 0x2a, 0xab, 0xe4, 0xde, 0x67, 0xee, 0x2a, 0x49, 0x0b, 0x40,
-	0x5f, 0x19, 0xb0, 0xa4, 0xe7, 0xcf, 0x58, 0xcb, 0xa2, 0x22, 0xf3, 0x20, 0x85, 0x4c, 0xb6, 0x6a,
-	0xde, 0x20, 0x59, 0x83, 0xd1, 0xaf, 0x06, 0x3c, 0xe8, 0xaa, 0x27, 0x7d, 0x25, 0x28, 0x77, 0x71,
-	0x66, 0xd6, 0x25, 0xc5, 0xfa, 0x69, 0xff, 0xea, 0x3e, 0xd2, 0xc0, 0xd9, 0x16, 0x71, 0x97, 0x9f,
-	0x31, 0x17, 0x7d, 0x6c, 0xc0, 0x35, 0x4e, 0x89, 0xc7, 0x6d, 0xab, 0x81, 0xf9, 0x61, 0x8f, 0xca,
-	0x97, 0x15, 0xed, 0x5b, 0x3d, 0x68, 0xcb, 0xec, 0xa7, 0x2a, 0x39, 0x91, 0xdc, 0x2c, 0x4f, 0x8d,
-	0x40, 0x3f, 0x18, 0x70, 0x87, 0x78, 0xae, 0x60, 0x6e, 0x40, 0x2d, 0xec, 0x5b, 0x2e, 0x3d, 0xce,
-	0xba, 0x9d, 0xa0, 0x78, 0x3d, 0xea, 0xd1, 0x77, 0x42, 0xc8, 0x35, 0x7f, 0x9b, 0x1e, 0x67, 0xdb,
-	0xc6, 0x65, 0x92, 0x33, 0x07, 0x7d, 0x67, 0xc0, 0x6a, 0xd8, 0xa9, 0xc9, 0x01, 0x73, 0xec, 0xac,
-	0xbc, 0x47, 0x14, 0xef, 0xf5, 0xde, 0xcd, 0x7b, 0x43, 0xa2, 0x65, 0x23, 0xbd, 0xe4, 0xe7, 0x49,
-	0x40, 0x3f, 0x1a, 0x70, 0xc7, 0x67, 0xfb, 0xf2, 0xcc, 0xe6, 0x3d, 0xbc, 0xa3, 0x8a, 0xf5, 0x66,
-	0x32, 0x6b, 0x05, 0x99, 0xef, 0xd4, 0xae, 0xf8, 0x79, 0x93, 0xd0, 0xf7, 0x06, 0xfc, 0x37, 0x68,
-	0xfa, 0x94, 0x8b, 0x36, 0x69, 0x9f, 0x62, 0x4e, 0x0e, 0x3a, 0x88, 0x26, 0x92, 0x1f, 0x4b, 0x39,
-	0x2a, 0x2f, 0x14, 0x62, 0x34, 0xff, 0x73, 0x85, 0xd7, 0x9e, 0x34, 0xf9, 0xa8, 0x04, 0x39, 0x73,
-	0xd6, 0x47, 0x01, 0xda, 0x74, 0x2a, 0x9f, 0x17, 0x60, 0x21, 0x9b, 0x6d, 0x40, 0x73, 0x30, 0x12,
-	0xcb, 0x06, 0xb3, 0x95, 0x11, 0x29, 0x9b, 0x10, 0x0d, 0x6d, 0xd9, 0x68, 0x13, 0xc6, 0xda, 0xba,
-	0xd2, 0x6a, 0x52, 0xed, 0x0d, 0xae, 0x25, 0xae, 0x35, 0x9e, 0xac, 0xd5, 0xa4, 0xe6, 0x28, 0xee,
-	0x78, 0x42, 0x93, 0x50, 0xb0, 0xbd, 0x06, 0x66, 0xae, 0xd2, 0xf3, 0xb2, 0xa9, 0x9f, 0xd0, 0x3d,
-	0x28, 0x2b, 0xb9, 0x92, 0x6e, 0x4b, 0x6b, 0xe8, 0xd5, 0x44, 0x6c, 0xb9, 0x80, 0x27, 0xcc, 0x17,
-	0x66, 0x49, 0xe8, 0xbf, 0xd0, 0x2a, 0x0c, 0x33, 0xb7, 0x19, 0x08, 0xad, 0x6b, 0x33, 0x89, 0x79,
-	0x3b, 0xb8, 0xe5, 0x78, 0xd8, 0x36, 0xc3, 0x50, 0xb4, 0x0b, 0xd3, 0xb1, 0x31, 0x13, 0x9e, 0x45,
-	0x1c, 0xcf, 0xa7, 0x4a, 0x96, 0xbc, 0x40, 0x68, 0x11, 0x9a, 0xae, 0x86, 0xa6, 0xb2, 0x1a, 0x99,
-	0xca, 0xea, 0x43, 0x6d, 0x2a, 0xcd, 0xc9, 0x28, 0x77, 0xd7, 0xdb, 0x90, 0x99, 0xbb, 0x61, 0x62,
-	0x37, 0x6a, 0xdb, 0x5f, 0x49, 0xd4, 0x62, 0x0e, 0xd4, 0xd8, 0x5d, 0x49, 0xd4, 0x6d, 0x98, 0xd4,
-	0x48, 0xdd, 0x44, 0x4b, 0xfd, 0x20, 0x27, 0x42, 0x1b, 0x76, 0x92, 0xe5, 0x26, 0x8c, 0x1f, 0x50,
-	0xcc, 0x45, 0x9d, 0xe2, 0x36, 0xbb, 0x72, 0x3f, 0xa8, 0xf3, 0x71, 0x4e, 0x84, 0xb3, 0x01, 0xa3,
-	0x9c, 0x0a, 0xde, 0xb2, 0x9a, 0x9e, 0xc3, 0x48, 0x4b, 0x77, 0x9c, 0xf9, 0x1e, 0x1d, 0x5c, 0xf0,
-	0xd6, 0x8e, 0x8a, 0x33, 0x47, 0x78, 0xfb, 0x01, 0xdd, 0x82, 0xc2, 0x01, 0xc5, 0x36, 0xe5, 0xfa,
-	0xea, 0x5f, 0x49, 0x4c, 0x7f, 0xac, 0x42, 0x4c, 0x1d, 0x8a, 0x6e, 0xc3, 0x64, 0x24, 0x92, 0x8e,
-	0x47, 0xb0, 0x63, 0xd9, 0xcc, 0x6f, 0x62, 0x41, 0x0e, 0xd4, 0x15, 0x2c, 0x99, 0x17, 0xf4, 0xdb,
-	0x27, 0xf2, 0xe5, 0x43, 0xfd, 0xae, 0xf2, 0xa9, 0x01, 0x33, 0x69, 0xb6, 0x15, 0x4d, 0x43, 0x29,
-	0x74, 0x26, 0xf1, 0x15, 0x28, 0xaa, 0xe7, 0x2d, 0x1b, 0x3d, 0x81, 0x8b, 0x71, 0x0d, 0xf6, 0x18,
-	0x6f, 0x97, 0x60, 0xa0, 0xdf, 0xbe, 0x21, 0x5d, 0x82, 0x4d, 0xc6, 0xa3, 0x0a, 0x54, 0x08, 0x2c,
-	0xe6, 0xb0, 0xac, 0xe8, 0x36, 0x14, 0x38, 0xf5, 0x03, 0x47, 0xe8, 0x2f, 0x84, 0xf4, 0x13, 0xae,
-	0x63, 0x2b, 0x18, 0xae, 0x67, 0x34, 0x9c, 0xe8, 0x0e, 0x14, 0xa5, 0xe1, 0x0c, 0x38, 0x4d, 0x9d,
-	0x61, 0x33, 0x8c, 0x31, 0xa3, 0xe0, 0xca, 0x36, 0x2c, 0xe6, 0xf0, 0x8b, 0x7d, 0xbb, 0x4c, 0xe5,
-	0x1e, 0x5c, 0x4d, 0x35, 0x79, 0x29, 0x15, 0xaa, 0x10, 0xb8, 0x91, 0xd9, 0x93, 0xc9, 0x05, 0xdb,
-	0x54, 0x60, 0xe6, 0xf8, 0x99, 0xb6, 0x34, 0x0a, 0xae, 0xfc, 0x69, 0xc0, 0xdd, 0xb3, 0x7a, 0xa8,
-	0x8e, 0xde, 0x67, 0x9c, 0xe8, 0x7d, 0x2f, 0x00, 0x9d, 0x56, 0x47, 0x7d, 0xb0, 0x16, 0x12, 0x79,
-	0x9d, 0x9a, 0xcd, 0x1c, 0x3f, 0xee, 0x1e, 0x42, 0x53, 0x50, 0x94, 0x5e, 0x83, 0x7b, 0x8e, 0xea,
-	0xb5, 0xa3, 0x66, 0xf4, 0x88, 0xaa, 0x30, 0xd1, 0x65, 0x25, 0x3c, 0xd7, 0x69, 0xa9, 0xb6, 0x5b,
-	0x32, 0xc7, 0x49, 0xa7, 0xcc, 0x3f, 0x73, 0x9d, 0x56, 0xe5, 0x5b, 0x03, 0x66, 0xd3, 0x2d, 0x98,
-	0x2c, 0xad, 0xf6, 0x76, 0x2e, 0x6e, 0xd0, 0xa8, 0xb4, 0xe1, 0xd0, 0x36, 0x6e, 0xd0, 0xce, 0x1d,
-	0x1f, 0xc8, 0xb1, 0xe3, 0x1d, 0xfd, 0x61, 0x30, 0x73, 0x7f, 0xa8, 0xfc, 0x51, 0x84, 0xe5, 0xbc,
-	0xde, 0x4c, 0x4a, 0x5c, 0xbc, 0x1f, 0x4a, 0xe2, 0x8c, 0x14, 0x89, 0x8b, 0x00, 0x43, 0x89, 0x3b,
-	0xee, 0x78, 0x3a, 0x29, 0x65, 0x03, 0x67, 0x94, 0xb2, 0xc1, 0xec, 0x52, 0x86, 0x61, 0xbe, 0xed,
-	0xa9, 0x7a, 0x08, 0xc5, 0x50, 0xbf, 0x2e, 0x35, 0x13, 0x43, 0x3c, 0x4f, 0x50, 0x8c, 0x97, 0x70,
-	0x45, 0x2d, 0xa9, 0x07, 0xfa, 0x70, 0x3f, 0xf4, 0x4b, 0x32, 0x3b, 0x09, 0xf8, 0x19, 0x4c, 0xd6,
-	0x31, 0x39, 0xf4, 0xf6, 0xf6, 0x34, 0x36, 0x73, 0x05, 0xe5, 0x47, 0xd8, 0xe9, 0xaf, 0xc1, 0x17,
-	0x74, 0xa2, 0x82, 0xdd, 0xd2, 0x69, 0xa7, 0x34, 0xa9, 0x78, 0x16, 0x4d, 0xda, 0x82, 0x32, 0x73,
-	0x99, 0x60, 0x58, 0x78, 0x5c, 0x69, 0xec, 0xb9, 0xd5, 0xc5, 0xfe, 0xfe, 0x7f, 0x2b, 0x4a, 0x31,
-	0xdb, 0xd9, 0x9d, 0x9d, 0xb5, 0x9c, 0xa3, 0xb3, 0x22, 0x13, 0x26, 0x1d, 0x2c, 0xbf, 0x01, 0x43,
-	0x99, 0x90, 0xa5, 0xd5, 0x12, 0x00, 0x19, 0x4e, 0xc6, 0x05, 0x99, 0xbb, 0x11, 0xa7, 0x9a, 0x2a,
-	0x13, 0xfd, 0x0b, 0xc6, 0x08, 0x97, 0x67, 0x44, 0xdb, 0x0c, 0x25, 0xd8, 0x65, 0x73, 0x54, 0x0e,
-	0x46, 0x3e, 0xf1, 0x6c, 0x7a, 0xbc, 0x04, 0x43, 0x0d, 0xda, 0xf0, 0xb4, 0x01, 0x9e, 0x4e, 0x4c,
-	0x79, 0x4a, 0x1b, 0x9e, 0xa9, 0xc2, 0x90, 0x09, 0xe3, 0xa7, 0x0c, 0xf5, 0xd4, 0x39, 0x95, 0xfb,
-	0xef, 0x64, 0xe7, 0xdf, 0x65, 0x7d, 0xcd, 0xf3, 0x7e, 0xd7, 0x48, 0xe5, 0xb7, 0x22, 0x2c, 0xe5,
-	0xfa, 0xac, 0xe9, 0xd9, 0x8e, 0xe7, 0x60, 0x24, 0xee, 0x03, 0xcc, 0x56, 0x37, 0xb8, 0x6c, 0x42,
-	0x34, 0x14, 0x7a, 0xe1, 0x93, 0x8d, 0x62, 0xf0, 0x2d, 0x34, 0x8a, 0x77, 0xe0, 0x79, 0xb3, 0x34,
-	0x8a, 0xc2, 0xdf, 0xda, 0x28, 0x8a, 0x67, 0x6e, 0x14, 0xef, 0xc1, 0x44, 0x13, 0x73, 0xea, 0x0a,
-	0x8d, 0xa8, 0xaf, 0x77, 0x78, 0x39, 0x17, 0x7a, 0xac, 0x5e, 0xc6, 0x2b, 0x14, 0x7d, 0xc9, 0xc7,
-	0x9b, 0xdd, 0x43, 0x9d, 0x22, 0x59, 0x3e, 0x29, 0x92, 0x04, 0xa6, 0x3a, 0x8e, 0x81, 0xc5, 0x69,
-	0xd0, 0x9e, 0x16, 0xd4, 0xb4, 0x37, 0x53, 0x0b, 0xbe, 0x65, 0x9b, 0x32, 0x45, 0x4f, 0x7d, 0xf1,
-	0x38, 0x69, 0xf8, 0xed, 0x58, 0xe8, 0x53, 0xf7, 0x7a, 0x34, 0xf5, 0x5e, 0x8f, 0xe5, 0xbf, 0xd7,
-	0xe7, 0xde, 0xe0, 0x5e, 0xff, 0xf3, 0xcd, 0xee, 0xf5, 0x4f, 0x03, 0xb0, 0x92, 0xfb, 0xc3, 0xff,
-	0x5d, 0x5b, 0xad, 0x39, 0x18, 0xd1, 0xff, 0xef, 0x50, 0xee, 0x27, 0xfc, 0xb4, 0x85, 0x70, 0x48,
-	0xb9, 0x9f, 0xf8, 0xba, 0x0e, 0x65, 0xbf, 0xae, 0x1d, 0x47, 0x73, 0x38, 0x93, 0x7f, 0x2b, 0xf4,
-	0xf2, 0x6f, 0x9f, 0x18, 0xb0, 0x9c, 0xf7, 0xff, 0x0f, 0xc9, 0xc5, 0x34, 0xde, 0xa8, 0x98, 0xeb,
-	0xf5, 0x9f, 0x5f, 0xcf, 0x1a, 0xbf, 0xbc, 0x9e, 0x35, 0x7e, 0x7f, 0x3d, 0x6b, 0xc0, 0x25, 0xe2,
-	0x35, 0x92, 0x90, 0xd6, 0x4b, 0x6b, 0x4d, 0xb6, 0x23, 0x7b, 0xc3, 0x8e, 0xf1, 0x7e, 0x6d, 0x9f,
-	0x89, 0x83, 0xa0, 0x5e, 0x25, 0x5e, 0xa3, 0x76, 0xe2, 0xe7, 0x97, 0xea, 0x3e, 0x75, 0xc3, 0xdf,
-	0x7b, 0xf4, 0x2f, 0x31, 0xf7, 0x71, 0x93, 0x1d, 0xad, 0xd4, 0x0b, 0x6a, 0xec, 0xd6, 0x5f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xdf, 0xda, 0xbb, 0xfb, 0x4c, 0x1a, 0x00, 0x00,
+	// 1674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc6, 0xf9, 0xb1, 0x3f, 0x5e, 0x36, 0x6d, 0x33, 0x69, 0xd3, 0xa4, 0x4d, 0x93, 0x74, 0x29,
+	0x69, 0xda, 0x90, 0xdd, 0x24, 0x2d, 0x55, 0x69, 0xa1, 0x22, 0x49, 0x1b, 0x35, 0x52, 0x93, 0x46,
+	0x6e, 0x42, 0x25, 0x2e, 0xd6, 0xac, 0x3d, 0x49, 0xac, 0x78, 0xed, 0x65, 0x3c, 0x4e, 0xba, 0x48,
+	0x48, 0x9c, 0xe0, 0xc4, 0x89, 0x13, 0x12, 0x12, 0x52, 0x4f, 0x70, 0x81, 0x2b, 0x88, 0x0b, 0xdc,
+	0x38, 0xc2, 0x81, 0x3b, 0xea, 0x5f, 0x80, 0xc4, 0x3f, 0x80, 0x3c, 0x1e, 0x7b, 0x37, 0x9b, 0xd9,
+	0xdd, 0x71, 0x5a, 0x7a, 0x5b, 0x8f, 0xdf, 0xfb, 0xe6, 0x9b, 0x79, 0xf3, 0xde, 0xfb, 0xc6, 0x0b,
+	0xc5, 0xa0, 0x42, 0x68, 0xd9, 0xc4, 0x16, 0x71, 0x4d, 0x52, 0xc6, 0x35, 0xbb, 0x7c, 0xb0, 0x50,
+	0xb6, 0x88, 0x69, 0xfb, 0xb6, 0xe7, 0x96, 0x6a, 0xd4, 0x63, 0x1e, 0x1a, 0x0e, 0x6d, 0x4a, 0xc2,
+	0xa6, 0x84, 0x6b, 0x76, 0xe9, 0x60, 0xe1, 0xc2, 0xc4, 0xae, 0xe7, 0xed, 0x3a, 0xa4, 0xcc, 0x4d,
+	0x2a, 0xc1, 0x4e, 0xd9, 0x0a, 0x28, 0x66, 0x89, 0xd3, 0x85, 0x29, 0x19, 0xb0, 0xe9, 0x55, 0xab,
+	0x89, 0x85, 0x74, 0x6a, 0x86, 0xfd, 0x7d, 0xc7, 0xf6, 0x59, 0x27, 0x9b, 0x43, 0x8f, 0xee, 0xef,
+	0x38, 0xde, 0x61, 0x64, 0x53, 0xfc, 0x75, 0x18, 0x72, 0xf7, 0x05, 0x63, 0xf4, 0xb5, 0x06, 0xd7,
+	0x7d, 0x73, 0x8f, 0x58, 0x81, 0x43, 0x0c, 0x6c, 0x32, 0xfb, 0xc0, 0x66, 0x75, 0x23, 0x44, 0x35,
+	0xe2, 0x55, 0x19, 0x98, 0x31, 0x6a, 0x57, 0x02, 0x46, 0xfc, 0x51, 0x6d, 0x4a, 0x9b, 0x19, 0x58,
+	0xbc, 0x5b, 0x92, 0xac, 0xb0, 0xf4, 0x44, 0xc0, 0x2c, 0x09, 0x94, 0x2d, 0xec, 0xef, 0xc7, 0xf3,
+	0x2c, 0x25, 0x10, 0x0f, 0xdf, 0xd0, 0xa7, 0x7d, 0x25, 0x4b, 0xf4, 0x09, 0x4c, 0xfa, 0x0c, 0x53,
+	0x66, 0x30, 0xbb, 0x4a, 0xa8, 0x94, 0x4f, 0x0f, 0xe7, 0xb3, 0x20, 0xe7, 0x13, 0xfa, 0x6e, 0x85,
+	0xae, 0x52, 0x16, 0xe3, 0x7e, 0x87, 0xf7, 0xe8, 0x3b, 0x0d, 0xc2, 0xdd, 0xaf, 0x39, 0x84, 0x11,
+	0x23, 0xde, 0x40, 0x83, 0x3c, 0x23, 0x66, 0x10, 0x06, 0x4d, 0x4a, 0xa6, 0x97, 0x93, 0xf9, 0x40,
+	0x4a, 0x66, 0x45, 0x60, 0x3d, 0x15, 0x50, 0x0f, 0x62, 0x24, 0x29, 0xb7, 0x59, 0x53, 0xdd, 0x1c,
+	0x7d, 0xa3, 0xc1, 0xec, 0x0e, 0xb6, 0x1d, 0x55, 0x9a, 0x7d, 0x9c, 0xe6, 0x7b, 0x52, 0x9a, 0xab,
+	0xd8, 0x76, 0xd4, 0x28, 0x5e, 0xdd, 0x51, 0x33, 0x45, 0xdf, 0x6b, 0x30, 0x4f, 0xc9, 0xc7, 0x01,
+	0xf1, 0x99, 0x61, 0x62, 0xd7, 0x24, 0x8e, 0xc2, 0x39, 0xeb, 0xef, 0xb0, 0x95, 0x7a, 0x04, 0xb6,
+	0xc2, 0xb1, 0xba, 0x1e, 0xb6, 0x59, 0xaa, 0x6e, 0x8e, 0x3e, 0x85, 0x29, 0x41, 0xb1, 0xfd, 0x91,
+	0xcb, 0x70, 0x6a, 0x8b, 0xf2, 0x28, 0x73, 0xe7, 0xf6, 0x67, 0xee, 0x92, 0xd9, 0xc9, 0x00, 0x3d,
+	0xd7, 0x60, 0x4e, 0xcc, 0xaf, 0x18, 0xcb, 0x2c, 0x27, 0x73, 0xaf, 0x03, 0x19, 0xb5, 0x68, 0x5e,
+	0x33, 0x55, 0x8d, 0xd1, 0x9f, 0x1a, 0xdc, 0x6b, 0x89, 0x27, 0x79, 0xc6, 0x08, 0x75, 0xb1, 0x32,
+	0xeb, 0x1c, 0x67, 0xbd, 0xde, 0x3d, 0xba, 0x0f, 0x04, 0xb0, 0xda, 0x22, 0x6e, 0xd3, 0x13, 0xfa,
+	0xa2, 0xcf, 0x34, 0xb8, 0x4c, 0x89, 0xe9, 0x51, 0xcb, 0xa8, 0x62, 0xba, 0xdf, 0x26, 0xf2, 0x79,
+	0x4e, 0xfb, 0x46, 0x1b, 0xda, 0xa1, 0xf7, 0x3a, 0x77, 0x96, 0x92, 0x9b, 0xa0, 0x1d, 0x2d, 0xd0,
+	0xcf, 0x1a, 0xdc, 0x32, 0x3d, 0x97, 0xd9, 0x6e, 0x40, 0x0c, 0xec, 0x1b, 0x2e, 0x39, 0x54, 0xdd,
+	0x4e, 0xe0, 0xbc, 0x1e, 0xb4, 0xa9, 0x3b, 0x11, 0xe4, 0x92, 0xbf, 0x41, 0x0e, 0xd5, 0xb6, 0x71,
+	0xde, 0x4c, 0xe9, 0x83, 0x7e, 0xd4, 0x60, 0x31, 0xaa, 0xd4, 0xe6, 0x9e, 0xed, 0x58, 0xaa, 0xbc,
+	0x07, 0x38, 0xef, 0xe5, 0xf6, 0xc5, 0x7b, 0x25, 0x44, 0x53, 0x23, 0x3d, 0xe7, 0xa7, 0x71, 0x40,
+	0xbf, 0x68, 0x70, 0xcb, 0xb7, 0x77, 0x5d, 0x9c, 0xfe, 0xf0, 0x16, 0x38, 0xeb, 0x55, 0x39, 0x6b,
+	0x0e, 0x99, 0xee, 0xd4, 0x2e, 0xf8, 0x69, 0x9d, 0xd0, 0x4f, 0x1a, 0xbc, 0x13, 0xd4, 0x7c, 0x42,
+	0x59, 0x83, 0xb4, 0x4f, 0x30, 0x35, 0xf7, 0x9a, 0x88, 0x4a, 0xc9, 0x0f, 0x76, 0x38, 0x2a, 0xdb,
+	0x1c, 0x31, 0x9e, 0xff, 0x09, 0xc7, 0x6b, 0x4c, 0x2a, 0x3f, 0x2a, 0x41, 0x4a, 0x1f, 0xf4, 0xad,
+	0x06, 0x6f, 0x1f, 0xef, 0xab, 0x41, 0xcd, 0xc2, 0x8c, 0x48, 0x19, 0x9f, 0xe2, 0x8c, 0xdf, 0x57,
+	0x6a, 0xaa, 0xdb, 0x1c, 0x46, 0xca, 0x74, 0xc6, 0x54, 0xb4, 0x45, 0x5f, 0x69, 0x30, 0xd3, 0xba,
+	0xb9, 0x55, 0x52, 0xf5, 0xa4, 0xec, 0x4e, 0x73, 0x76, 0xef, 0x2a, 0xec, 0xe7, 0x3a, 0xa9, 0x7a,
+	0x52, 0x66, 0x57, 0x02, 0x05, 0xbb, 0xe5, 0x02, 0x40, 0x63, 0xda, 0xe2, 0x97, 0x19, 0x98, 0x56,
+	0x93, 0x5b, 0x68, 0x12, 0x06, 0x92, 0x76, 0x6b, 0x5b, 0x5c, 0xc0, 0xe5, 0x75, 0x88, 0x87, 0xd6,
+	0x2c, 0xb4, 0x0a, 0x83, 0x89, 0x01, 0xab, 0xd7, 0x88, 0xd0, 0x54, 0x97, 0xa5, 0x6b, 0x4a, 0x26,
+	0xab, 0xd7, 0x88, 0x5e, 0xc0, 0x4d, 0x4f, 0x68, 0x04, 0x32, 0x96, 0x57, 0xc5, 0xb6, 0xcb, 0x75,
+	0x50, 0x5e, 0x17, 0x4f, 0xe8, 0x0e, 0xe4, 0x79, 0x9b, 0x0f, 0x55, 0xaa, 0xd0, 0x1e, 0x97, 0xa4,
+	0xd8, 0xe1, 0x02, 0x1e, 0xd9, 0x3e, 0xd3, 0x73, 0x4c, 0xfc, 0x42, 0x8b, 0xd0, 0x6f, 0xbb, 0xb5,
+	0x80, 0x09, 0x3d, 0x30, 0x2e, 0xf5, 0xdb, 0xc4, 0x75, 0xc7, 0xc3, 0x96, 0x1e, 0x99, 0xa2, 0x2d,
+	0x18, 0x4b, 0x04, 0x2d, 0xf3, 0x0c, 0xd3, 0xf1, 0x7c, 0xc2, 0xdb, 0xb9, 0x17, 0x30, 0xd1, 0xbc,
+	0xc7, 0x4a, 0x91, 0x18, 0x2f, 0xc5, 0x62, 0xbc, 0x74, 0x5f, 0x88, 0x71, 0x7d, 0x24, 0xf6, 0xdd,
+	0xf2, 0x56, 0x42, 0xcf, 0xad, 0xc8, 0xb1, 0x15, 0xb5, 0xa1, 0x4b, 0x43, 0xd4, 0x6c, 0x0a, 0xd4,
+	0x44, 0x95, 0x86, 0xa8, 0x1b, 0x30, 0x22, 0x90, 0x5a, 0x89, 0xe6, 0xba, 0x41, 0x0e, 0x47, 0xf2,
+	0xf5, 0x28, 0xcb, 0x55, 0x18, 0xda, 0x23, 0x98, 0xb2, 0x0a, 0xc1, 0x0d, 0x76, 0xf9, 0x6e, 0x50,
+	0x67, 0x12, 0x9f, 0x18, 0x67, 0x05, 0x0a, 0x94, 0x30, 0x5a, 0x37, 0x6a, 0x9e, 0x63, 0x9b, 0x75,
+	0x51, 0xa9, 0xa7, 0xda, 0x74, 0x3e, 0x46, 0xeb, 0x9b, 0xdc, 0x4e, 0x1f, 0xa0, 0x8d, 0x07, 0x74,
+	0x03, 0x32, 0x7b, 0x04, 0x5b, 0x84, 0x8a, 0x92, 0x79, 0x51, 0xea, 0xfe, 0x90, 0x9b, 0xe8, 0xc2,
+	0x14, 0xdd, 0x84, 0x91, 0x58, 0x5c, 0x38, 0x9e, 0x89, 0x1d, 0xc3, 0xb2, 0xfd, 0x1a, 0x66, 0xe6,
+	0x1e, 0x2f, 0x5d, 0x39, 0xfd, 0xac, 0x78, 0xfb, 0x28, 0x7c, 0x79, 0x5f, 0xbc, 0x2b, 0x7e, 0xa1,
+	0xc1, 0x78, 0x27, 0xb9, 0x8f, 0xc6, 0x20, 0x17, 0x29, 0xba, 0x24, 0x05, 0xb2, 0xfc, 0x79, 0xcd,
+	0x42, 0x8f, 0xe0, 0x5c, 0x12, 0x83, 0x1d, 0x9b, 0x36, 0x42, 0xd0, 0xd3, 0x6d, 0xdf, 0x90, 0x08,
+	0xc1, 0xaa, 0x4d, 0xe3, 0x08, 0x14, 0x4d, 0x98, 0x4d, 0x21, 0xf5, 0xd1, 0x4d, 0xc8, 0x50, 0xe2,
+	0x07, 0x0e, 0x1b, 0xd5, 0x14, 0x4e, 0xb8, 0xb0, 0x2d, 0x62, 0xb8, 0xaa, 0x28, 0xd4, 0xd1, 0x2d,
+	0xc8, 0x86, 0x42, 0x3d, 0xa0, 0xa4, 0xe3, 0x0c, 0xab, 0x91, 0x8d, 0x1e, 0x1b, 0x17, 0x37, 0x60,
+	0x36, 0x85, 0xce, 0xee, 0x5a, 0x65, 0x8a, 0x77, 0xe0, 0x52, 0x47, 0x71, 0xdc, 0x21, 0x42, 0x45,
+	0x13, 0xae, 0x29, 0x6b, 0xd9, 0x70, 0xc1, 0x16, 0x61, 0xd8, 0x76, 0x7c, 0xa5, 0x2d, 0x8d, 0x8d,
+	0x8b, 0xff, 0x6a, 0x70, 0xfb, 0xa4, 0xda, 0xb3, 0xa9, 0xf6, 0x69, 0x47, 0x6a, 0xdf, 0x36, 0xa0,
+	0xe3, 0xaa, 0x42, 0x1c, 0xac, 0x69, 0x29, 0xaf, 0x63, 0xb3, 0xe9, 0x43, 0x87, 0xad, 0x43, 0x68,
+	0x14, 0xb2, 0xa6, 0xe7, 0x32, 0xea, 0x39, 0xbc, 0xd6, 0x16, 0xf4, 0xf8, 0x11, 0x95, 0x60, 0xb8,
+	0x45, 0x82, 0x79, 0xae, 0x53, 0xe7, 0x65, 0x37, 0xa7, 0x0f, 0x99, 0xcd, 0xf2, 0xe8, 0xb1, 0xeb,
+	0xd4, 0x8b, 0x3f, 0x68, 0x30, 0xd1, 0x59, 0xba, 0x86, 0xa1, 0x15, 0x9a, 0xd8, 0xc5, 0x55, 0x12,
+	0x87, 0x36, 0x1a, 0xda, 0xc0, 0x55, 0xd2, 0xbc, 0xe3, 0x3d, 0x29, 0x76, 0xbc, 0xa9, 0x3e, 0xf4,
+	0x2a, 0xd7, 0x87, 0xe2, 0x3f, 0x59, 0x98, 0x4f, 0xab, 0x69, 0xc3, 0x16, 0x97, 0xec, 0x07, 0x6f,
+	0x71, 0x5a, 0x87, 0x16, 0x17, 0x03, 0x46, 0x2d, 0xee, 0xb0, 0xe9, 0xe9, 0x68, 0x2b, 0xeb, 0x39,
+	0x61, 0x2b, 0xeb, 0x55, 0x6f, 0x65, 0x18, 0xa6, 0x1a, 0x5a, 0xb4, 0x4d, 0xa3, 0xe8, 0xeb, 0x56,
+	0xa5, 0xc6, 0x13, 0x88, 0x27, 0x92, 0x8e, 0xf1, 0x14, 0x2e, 0xf2, 0x25, 0xb5, 0x41, 0xef, 0xef,
+	0x86, 0x7e, 0x3e, 0xf4, 0x96, 0x01, 0x3f, 0x86, 0x91, 0x0a, 0x36, 0xf7, 0xbd, 0x9d, 0x1d, 0x81,
+	0x6d, 0xbb, 0x8c, 0xd0, 0x03, 0xec, 0x74, 0xef, 0xc1, 0x67, 0x85, 0x23, 0x87, 0x5d, 0x13, 0x6e,
+	0xc7, 0x7a, 0x52, 0xf6, 0x24, 0x3d, 0x69, 0x0d, 0xf2, 0xb6, 0x6b, 0x33, 0x1b, 0x33, 0x8f, 0xf2,
+	0x1e, 0x7b, 0x6a, 0x71, 0xb6, 0xfb, 0xbd, 0x69, 0x2d, 0x76, 0xd1, 0x1b, 0xde, 0xcd, 0x95, 0x35,
+	0x9f, 0xa2, 0xb2, 0x22, 0x1d, 0x46, 0x1c, 0x1c, 0xde, 0x9d, 0xa3, 0x36, 0x11, 0x86, 0x56, 0xb4,
+	0x00, 0x50, 0x38, 0x19, 0x67, 0x43, 0xdf, 0x95, 0xc4, 0x55, 0xe7, 0x9e, 0xe8, 0x4d, 0x18, 0x34,
+	0x69, 0x78, 0x46, 0x84, 0xcc, 0xe0, 0x0d, 0x3b, 0xaf, 0x17, 0xc2, 0xc1, 0x58, 0x27, 0x9e, 0xac,
+	0x1f, 0xcf, 0x41, 0x5f, 0x28, 0x7e, 0xc5, 0xc5, 0x61, 0x4c, 0xea, 0x12, 0x4a, 0x56, 0x9d, 0x9b,
+	0x21, 0x1d, 0x86, 0x8e, 0x5d, 0x44, 0x84, 0x84, 0x7f, 0x4b, 0x7e, 0x63, 0x6a, 0xb9, 0x32, 0xe8,
+	0x67, 0xfc, 0x96, 0x91, 0xe2, 0x5f, 0x59, 0x98, 0x4b, 0x75, 0x1d, 0x6c, 0x5b, 0x8e, 0x27, 0x61,
+	0x20, 0xa9, 0x03, 0xb6, 0xc5, 0x33, 0x38, 0xaf, 0x43, 0x3c, 0x14, 0x69, 0xe1, 0xa3, 0x85, 0xa2,
+	0xf7, 0x15, 0x14, 0x8a, 0xd7, 0xa0, 0x79, 0x55, 0x0a, 0x45, 0xe6, 0x7f, 0x2d, 0x14, 0xd9, 0x13,
+	0x17, 0x8a, 0x0f, 0x61, 0xb8, 0x86, 0x29, 0x71, 0x99, 0x40, 0x14, 0xe9, 0x1d, 0x25, 0xe7, 0x74,
+	0x9b, 0xd5, 0x87, 0xf6, 0x1c, 0x45, 0x24, 0xf9, 0x50, 0xad, 0x75, 0xa8, 0xb9, 0x49, 0xe6, 0x8f,
+	0x36, 0x49, 0x13, 0x46, 0x9b, 0x8e, 0x81, 0x41, 0x49, 0xd0, 0x98, 0x16, 0xf8, 0xb4, 0xd7, 0x3b,
+	0x06, 0x7c, 0xcd, 0xd2, 0x49, 0x10, 0xcf, 0xa3, 0x9f, 0x3b, 0x94, 0x0d, 0xbf, 0x1a, 0x09, 0x7d,
+	0x2c, 0xaf, 0x0b, 0x1d, 0xf3, 0x7a, 0x30, 0x7d, 0x5e, 0x9f, 0x7a, 0x89, 0xbc, 0x3e, 0xfd, 0x72,
+	0x79, 0xfd, 0x5b, 0x0f, 0x2c, 0xa4, 0xfe, 0x60, 0xf2, 0xba, 0xa5, 0xd6, 0x24, 0x0c, 0x88, 0xef,
+	0x44, 0x5c, 0xfd, 0x44, 0x57, 0x5b, 0x88, 0x86, 0xb8, 0xfa, 0x49, 0xd2, 0xb5, 0x4f, 0x3d, 0x5d,
+	0x9b, 0x8e, 0x66, 0xbf, 0x92, 0x7e, 0xcb, 0xb4, 0xd3, 0x6f, 0x9f, 0x6b, 0x30, 0x9f, 0xf6, 0xbb,
+	0x8d, 0x3c, 0x98, 0xda, 0xcb, 0x05, 0xf3, 0xb9, 0x06, 0x33, 0xaa, 0x9f, 0x63, 0xd0, 0x45, 0xc8,
+	0x8b, 0x2f, 0x3e, 0x89, 0xd8, 0xcf, 0x45, 0x03, 0x6b, 0x56, 0xd3, 0x95, 0xa8, 0x47, 0xfd, 0x4a,
+	0x14, 0x66, 0x0a, 0xa1, 0xd4, 0xa3, 0x46, 0x95, 0xf8, 0x3e, 0xde, 0x8d, 0x23, 0x55, 0xe0, 0x83,
+	0xeb, 0xd1, 0x58, 0x71, 0x1b, 0xae, 0xa8, 0x7c, 0x94, 0x49, 0x92, 0x43, 0x53, 0x4a, 0x8e, 0xe5,
+	0xca, 0xef, 0x2f, 0x26, 0xb4, 0x3f, 0x5e, 0x4c, 0x68, 0x7f, 0xbf, 0x98, 0xd0, 0xe0, 0xbc, 0xe9,
+	0x55, 0x65, 0x1e, 0xcb, 0xb9, 0xa5, 0x9a, 0xbd, 0x49, 0x3d, 0xe6, 0x6d, 0x6a, 0x1f, 0x95, 0x77,
+	0x6d, 0xb6, 0x17, 0x54, 0x4a, 0xa6, 0x57, 0x2d, 0x1f, 0xf9, 0xcb, 0xae, 0xb4, 0x4b, 0xdc, 0xe8,
+	0x3f, 0x42, 0xf1, 0xef, 0xdd, 0x5d, 0x5c, 0xb3, 0x0f, 0x16, 0x2a, 0x19, 0x3e, 0x76, 0xe3, 0xbf,
+	0x01, 0x00, 0x42, 0xef, 0xf8, 0xd5, 0x80, 0x1c, 0x00, 0x00,
 }
 
 func (m *Decision) Marshal() (dAtA []byte, err error) {
@@ -1748,6 +1895,48 @@ func (m *Decision_UpsertWorkflowSearchAttributesDecisionAttributes) MarshalToSiz
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Decision_CompleteWorkflowUpdateDecisionAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Decision_CompleteWorkflowUpdateDecisionAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CompleteWorkflowUpdateDecisionAttributes != nil {
+		{
+			size, err := m.CompleteWorkflowUpdateDecisionAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDecision(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Decision_UpsertWorkflowMemoDecisionAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Decision_UpsertWorkflowMemoDecisionAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpsertWorkflowMemoDecisionAttributes != nil {
+		{
+			size, err := m.UpsertWorkflowMemoDecisionAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDecision(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ScheduleActivityTaskDecisionAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2736,6 +2925,98 @@ func (m *UpsertWorkflowSearchAttributesDecisionAttributes) MarshalToSizedBuffer(
 	return len(dAtA) - i, nil
 }
 
+func (m *CompleteWorkflowUpdateDecisionAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompleteWorkflowUpdateDecisionAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompleteWorkflowUpdateDecisionAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintDecision(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDecision(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UpdateId) > 0 {
+		i -= len(m.UpdateId)
+		copy(dAtA[i:], m.UpdateId)
+		i = encodeVarintDecision(dAtA, i, uint64(len(m.UpdateId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpsertWorkflowMemoDecisionAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpsertWorkflowMemoDecisionAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpsertWorkflowMemoDecisionAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Memo != nil {
+		{
+			size, err := m.Memo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDecision(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDecision(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDecision(v)
 	base := offset
@@ -2918,6 +3199,30 @@ func (m *Decision_UpsertWorkflowSearchAttributesDecisionAttributes) Size() (n in
 	}
 	return n
 }
+func (m *Decision_CompleteWorkflowUpdateDecisionAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompleteWorkflowUpdateDecisionAttributes != nil {
+		l = m.CompleteWorkflowUpdateDecisionAttributes.Size()
+		n += 1 + l + sovDecision(uint64(l))
+	}
+	return n
+}
+func (m *Decision_UpsertWorkflowMemoDecisionAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpsertWorkflowMemoDecisionAttributes != nil {
+		l = m.UpsertWorkflowMemoDecisionAttributes.Size()
+		n += 1 + l + sovDecision(uint64(l))
+	}
+	return n
+}
 func (m *ScheduleActivityTaskDecisionAttributes) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3316,6 +3621,46 @@ func (m *UpsertWorkflowSearchAttributesDecisionAttributes) Size() (n int) {
 	return n
 }
 
+func (m *CompleteWorkflowUpdateDecisionAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpdateId)
+	if l > 0 {
+		n += 1 + l + sovDecision(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovDecision(uint64(l))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovDecision(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpsertWorkflowMemoDecisionAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Memo != nil {
+		l = m.Memo.Size()
+		n += 1 + l + sovDecision(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovDecision(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3806,14 +4151,84 @@ func (m *Decision) Unmarshal(dAtA []byte) error {
 			}
 			m.Attributes = &Decision_UpsertWorkflowSearchAttributesDecisionAttributes{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDecision(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteWorkflowUpdateDecisionAttributes", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDecision
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDecision
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDecision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CompleteWorkflowUpdateDecisionAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &Decision_CompleteWorkflowUpdateDecisionAttributes{v}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpsertWorkflowMemoDecisionAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDecision
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDecision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpsertWorkflowMemoDecisionAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &Decision_UpsertWorkflowMemoDecisionAttributes{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDecision(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDecision
 			}
 			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDecision
@@ -6608,6 +7023,250 @@ func (m *UpsertWorkflowSearchAttributesDecisionAttributes) Unmarshal(dAtA []byte
 	}
 	return nil
 }
+func (m *CompleteWorkflowUpdateDecisionAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDecision
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompleteWorkflowUpdateDecisionAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompleteWorkflowUpdateDecisionAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDecision
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDecision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDecision
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDecision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &Payload{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDecision
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDecision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDecision(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDecision
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDecision
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpsertWorkflowMemoDecisionAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDecision
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpsertWorkflowMemoDecisionAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpsertWorkflowMemoDecisionAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDecision
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDecision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Memo == nil {
+				m.Memo = &Memo{}
+			}
+			if err := m.Memo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDecision(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDecision
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDecision
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDecision(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -122,6 +122,7 @@ type Domain struct {
 	Clusters                         []*ClusterReplicationConfiguration `protobuf:"bytes,14,rep,name=clusters,proto3" json:"clusters,omitempty"`
 	FailoverVersion                  int64                              `protobuf:"varint,15,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
 	IsGlobalDomain                   bool                               `protobuf:"varint,16,opt,name=is_global_domain,json=isGlobalDomain,proto3" json:"is_global_domain,omitempty"`
+	Limits                           *DomainLimits                      `protobuf:"bytes,17,opt,name=limits,proto3" json:"limits,omitempty"`
 	XXX_NoUnkeyedLiteral             struct{}                           `json:"-"`
 	XXX_unrecognized                 []byte                             `json:"-"`
 	XXX_sizecache                    int32                              `json:"-"`
@@ -272,6 +273,13 @@ func (m *Domain) GetIsGlobalDomain() bool {
 	return false
 }
 
+func (m *Domain) GetLimits() *DomainLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
 type ClusterReplicationConfiguration struct {
 	ClusterName          string   `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -429,6 +437,77 @@ func (m *BadBinaryInfo) GetCreatedTime() *types.Timestamp {
 	return nil
 }
 
+type DomainLimits struct {
+	RequestsPerSecond      int32    `protobuf:"varint,1,opt,name=requests_per_second,json=requestsPerSecond,proto3" json:"requests_per_second,omitempty"`
+	MaxConcurrentWorkflows int32    `protobuf:"varint,2,opt,name=max_concurrent_workflows,json=maxConcurrentWorkflows,proto3" json:"max_concurrent_workflows,omitempty"`
+	MaxPendingActivities   int32    `protobuf:"varint,3,opt,name=max_pending_activities,json=maxPendingActivities,proto3" json:"max_pending_activities,omitempty"`
+	MaxHistorySize         int64    `protobuf:"varint,4,opt,name=max_history_size,json=maxHistorySize,proto3" json:"max_history_size,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
+}
+
+func (m *DomainLimits) Reset()         { *m = DomainLimits{} }
+func (m *DomainLimits) String() string { return proto.CompactTextString(m) }
+func (*DomainLimits) ProtoMessage()    {}
+func (*DomainLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_824795d6ae7d8e2f, []int{4}
+}
+func (m *DomainLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainLimits.Merge(m, src)
+}
+func (m *DomainLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainLimits proto.InternalMessageInfo
+
+func (m *DomainLimits) GetRequestsPerSecond() int32 {
+	if m != nil {
+		return m.RequestsPerSecond
+	}
+	return 0
+}
+
+func (m *DomainLimits) GetMaxConcurrentWorkflows() int32 {
+	if m != nil {
+		return m.MaxConcurrentWorkflows
+	}
+	return 0
+}
+
+func (m *DomainLimits) GetMaxPendingActivities() int32 {
+	if m != nil {
+		return m.MaxPendingActivities
+	}
+	return 0
+}
+
+func (m *DomainLimits) GetMaxHistorySize() int64 {
+	if m != nil {
+		return m.MaxHistorySize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("uber.cadence.api.v1.DomainStatus", DomainStatus_name, DomainStatus_value)
 	proto.RegisterEnum("uber.cadence.api.v1.ArchivalStatus", ArchivalStatus_name, ArchivalStatus_value)
@@ -438,66 +517,75 @@ func init() {
 	proto.RegisterType((*BadBinaries)(nil), "uber.cadence.api.v1.BadBinaries")
 	proto.RegisterMapType((map[string]*BadBinaryInfo)(nil), "uber.cadence.api.v1.BadBinaries.BinariesEntry")
 	proto.RegisterType((*BadBinaryInfo)(nil), "uber.cadence.api.v1.BadBinaryInfo")
+	proto.RegisterType((*DomainLimits)(nil), "uber.cadence.api.v1.DomainLimits")
 }
 
 func init() { proto.RegisterFile("uber/cadence/api/v1/domain.proto", fileDescriptor_824795d6ae7d8e2f) }
 
 var fileDescriptor_824795d6ae7d8e2f = []byte{
-	// 856 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x8e, 0xdb, 0x44,
-	0x18, 0xc5, 0xc9, 0x36, 0xcd, 0x7e, 0xce, 0xa6, 0x61, 0xda, 0x65, 0xdd, 0x80, 0x76, 0xdd, 0xad,
-	0x90, 0x42, 0x2f, 0x1c, 0x36, 0x20, 0x68, 0x41, 0x5c, 0x38, 0xb1, 0x55, 0x82, 0x96, 0x25, 0x72,
-	0xd2, 0xbd, 0x80, 0x0b, 0x6b, 0x6c, 0x4f, 0x92, 0x51, 0x1d, 0x8f, 0x35, 0xfe, 0x59, 0xf6, 0x0e,
-	0xf1, 0x58, 0x3c, 0x01, 0x57, 0x88, 0x47, 0x40, 0xfb, 0x24, 0xc8, 0x63, 0x3b, 0x9b, 0x1f, 0xab,
-	0xed, 0xdd, 0xcc, 0xf7, 0x73, 0xbe, 0xe3, 0xe3, 0x33, 0x33, 0xa0, 0x26, 0x0e, 0xe1, 0x7d, 0x17,
-	0x7b, 0x24, 0x70, 0x49, 0x1f, 0x87, 0xb4, 0x9f, 0x5e, 0xf4, 0x3d, 0xb6, 0xc2, 0x34, 0xd0, 0x42,
-	0xce, 0x62, 0x86, 0x1e, 0x67, 0x15, 0x5a, 0x51, 0xa1, 0xe1, 0x90, 0x6a, 0xe9, 0x45, 0xf7, 0x74,
-	0xc1, 0xd8, 0xc2, 0x27, 0x7d, 0x51, 0xe2, 0x24, 0xf3, 0xbe, 0x97, 0x70, 0x1c, 0x53, 0x56, 0x34,
-	0x75, 0xcf, 0x76, 0xf3, 0x31, 0x5d, 0x91, 0x28, 0xc6, 0xab, 0x30, 0x2f, 0x38, 0xff, 0xe7, 0x21,
-	0x34, 0x0c, 0x31, 0x06, 0xb5, 0xa1, 0x46, 0x3d, 0x45, 0x52, 0xa5, 0xde, 0xa1, 0x55, 0xa3, 0x1e,
-	0x42, 0x70, 0x10, 0xe0, 0x15, 0x51, 0x6a, 0x22, 0x22, 0xd6, 0xe8, 0x15, 0x34, 0xa2, 0x18, 0xc7,
-	0x49, 0xa4, 0xd4, 0x55, 0xa9, 0xd7, 0x1e, 0x3c, 0xd3, 0x2a, 0x58, 0x69, 0x39, 0xe0, 0x54, 0x14,
-	0x5a, 0x45, 0x03, 0x52, 0x41, 0xf6, 0x48, 0xe4, 0x72, 0x1a, 0x66, 0xfc, 0x94, 0x03, 0x81, 0xba,
-	0x19, 0x42, 0x67, 0x20, 0xb3, 0x9b, 0x80, 0x70, 0x9b, 0xac, 0x30, 0xf5, 0x95, 0x07, 0xa2, 0x02,
-	0x44, 0xc8, 0xcc, 0x22, 0xe8, 0x15, 0x1c, 0x78, 0x38, 0xc6, 0x4a, 0x43, 0xad, 0xf7, 0xe4, 0xc1,
-	0xe7, 0xef, 0x98, 0xad, 0x19, 0x38, 0xc6, 0x66, 0x10, 0xf3, 0x5b, 0x4b, 0xb4, 0xa0, 0x25, 0x3c,
-	0xbf, 0x61, 0xfc, 0xed, 0xdc, 0x67, 0x37, 0x36, 0xf9, 0x9d, 0xb8, 0x49, 0x36, 0xd1, 0xe6, 0x24,
-	0x26, 0x81, 0x58, 0x85, 0x84, 0x53, 0xe6, 0x29, 0x0f, 0x55, 0xa9, 0x27, 0x0f, 0x9e, 0x6a, 0xb9,
-	0x6c, 0x5a, 0x29, 0x9b, 0x66, 0x14, 0xb2, 0x5a, 0x6a, 0x89, 0x62, 0x96, 0x20, 0x56, 0x89, 0x31,
-	0x11, 0x10, 0x68, 0x04, 0x2d, 0x07, 0x7b, 0xb6, 0x43, 0x03, 0xcc, 0x29, 0x89, 0x94, 0xa6, 0x80,
-	0x54, 0x2b, 0xc9, 0x0e, 0xb1, 0x37, 0x2c, 0xea, 0x2c, 0xd9, 0xb9, 0xdf, 0xa0, 0xdf, 0xe0, 0x64,
-	0x49, 0xa3, 0x98, 0xf1, 0x5b, 0x1b, 0x73, 0x77, 0x49, 0x53, 0xec, 0xdb, 0x85, 0xf0, 0x87, 0x42,
-	0xf8, 0xe7, 0x95, 0x78, 0x7a, 0x51, 0x5b, 0x48, 0x7f, 0x5c, 0x60, 0x6c, 0x87, 0xd1, 0x97, 0xf0,
-	0x64, 0x0f, 0x3c, 0xe1, 0x54, 0x01, 0x21, 0x38, 0xda, 0x69, 0x7a, 0xc3, 0x29, 0xc2, 0xd0, 0x4d,
-	0x69, 0x44, 0x1d, 0xea, 0xd3, 0x78, 0x9f, 0x91, 0xfc, 0xe1, 0x8c, 0x94, 0x7b, 0x98, 0x1d, 0x52,
-	0xdf, 0xc0, 0x49, 0xd5, 0x88, 0x8c, 0x57, 0x4b, 0xf0, 0x3a, 0xde, 0x6f, 0xcd, 0xa8, 0x69, 0xf0,
-	0x18, 0xbb, 0x31, 0x4d, 0x89, 0xed, 0xfa, 0x49, 0x14, 0x13, 0x6e, 0x0b, 0xd3, 0x1e, 0x89, 0x9e,
-	0x8f, 0xf3, 0xd4, 0x28, 0xcf, 0x5c, 0x65, 0x0e, 0x9e, 0x40, 0xb3, 0x28, 0x8c, 0x94, 0xb6, 0xf0,
-	0xd1, 0xd7, 0x95, 0xc4, 0x8b, 0x1e, 0x8b, 0x84, 0x3e, 0x75, 0xc5, 0xbf, 0x1f, 0xb1, 0x60, 0x4e,
-	0x17, 0xa5, 0x11, 0xd6, 0x28, 0xe8, 0x0b, 0xe8, 0xcc, 0x31, 0xf5, 0x59, 0x4a, 0xb8, 0x9d, 0x12,
-	0x1e, 0x65, 0xee, 0x7e, 0xa4, 0x4a, 0xbd, 0xba, 0xf5, 0xa8, 0x8c, 0x5f, 0xe7, 0x61, 0xd4, 0x83,
-	0x0e, 0x8d, 0xec, 0x85, 0xcf, 0x1c, 0xec, 0xdb, 0xf9, 0xe9, 0x56, 0x3a, 0xaa, 0xd4, 0x6b, 0x5a,
-	0x6d, 0x1a, 0xbd, 0x16, 0xe1, 0xdc, 0xbf, 0xdd, 0x6f, 0xe1, 0x70, 0x6d, 0x61, 0xd4, 0x81, 0xfa,
-	0x5b, 0x72, 0x5b, 0x1c, 0xcd, 0x6c, 0x89, 0x9e, 0xc0, 0x83, 0x14, 0xfb, 0x49, 0x79, 0x38, 0xf3,
-	0xcd, 0x77, 0xb5, 0x97, 0xd2, 0xb9, 0x01, 0x67, 0xef, 0xa1, 0x8e, 0x9e, 0x41, 0x6b, 0x4b, 0xab,
-	0x1c, 0x57, 0x76, 0xef, 0x55, 0x3a, 0xff, 0x4b, 0x02, 0x79, 0xc3, 0x9c, 0xe8, 0x27, 0x68, 0xae,
-	0x0d, 0x2d, 0x09, 0xd5, 0xb4, 0xf7, 0x19, 0x5a, 0x2b, 0x17, 0xf9, 0x31, 0x5c, 0xf7, 0x77, 0x6d,
-	0x38, 0xda, 0x4a, 0x55, 0x7c, 0xde, 0xcb, 0xcd, 0xcf, 0x93, 0x07, 0xe7, 0xef, 0x9c, 0x75, 0x3b,
-	0x0e, 0xe6, 0x6c, 0x53, 0x82, 0x3f, 0x25, 0x38, 0xda, 0x4a, 0xa2, 0x4f, 0xa0, 0xc1, 0x09, 0x8e,
-	0x58, 0x50, 0x0c, 0x29, 0x76, 0xa8, 0x0b, 0x4d, 0x16, 0x12, 0x8e, 0x63, 0xc6, 0x0b, 0x25, 0xd7,
-	0x7b, 0xf4, 0x03, 0xb4, 0x5c, 0x4e, 0x70, 0x4c, 0x3c, 0x3b, 0xbb, 0x34, 0xc5, 0x85, 0x27, 0x0f,
-	0xba, 0x7b, 0x57, 0xc3, 0xac, 0xbc, 0x51, 0x2d, 0xb9, 0xa8, 0xcf, 0x22, 0x2f, 0xfe, 0x90, 0xa0,
-	0xb5, 0x79, 0x0f, 0xa2, 0xa7, 0x70, 0x6c, 0xfc, 0xf2, 0xb3, 0x3e, 0xbe, 0xb2, 0xa7, 0x33, 0x7d,
-	0xf6, 0x66, 0x6a, 0x8f, 0xaf, 0xae, 0xf5, 0xcb, 0xb1, 0xd1, 0xf9, 0x08, 0x7d, 0x06, 0xca, 0x76,
-	0xca, 0x32, 0x5f, 0x8f, 0xa7, 0x33, 0xd3, 0x32, 0x8d, 0x8e, 0xb4, 0x9f, 0x35, 0xcc, 0x89, 0x65,
-	0x8e, 0xf4, 0x99, 0x69, 0x74, 0x6a, 0xfb, 0xb0, 0x86, 0x79, 0x69, 0x66, 0xa9, 0xfa, 0x8b, 0x25,
-	0xb4, 0x77, 0x0e, 0xd9, 0xa7, 0x70, 0xa2, 0x5b, 0xa3, 0x1f, 0xc7, 0xd7, 0xfa, 0x65, 0x25, 0x8b,
-	0xdd, 0xa4, 0x31, 0x9e, 0xea, 0xc3, 0x4b, 0xc1, 0xa2, 0xa2, 0xd5, 0xbc, 0xca, 0x93, 0xb5, 0xa1,
-	0xf3, 0xf7, 0xdd, 0xa9, 0xf4, 0xef, 0xdd, 0xa9, 0xf4, 0xdf, 0xdd, 0xa9, 0x04, 0x27, 0x2e, 0x5b,
-	0x55, 0xfd, 0xb1, 0x61, 0x53, 0x0f, 0xe9, 0x24, 0xd3, 0x6d, 0x22, 0xfd, 0xda, 0x5f, 0xd0, 0x78,
-	0x99, 0x38, 0x9a, 0xcb, 0x56, 0xfd, 0xad, 0xb7, 0x4f, 0x5b, 0x90, 0x20, 0x7f, 0xaf, 0x8a, 0x67,
-	0xf0, 0x7b, 0x1c, 0xd2, 0xf4, 0xc2, 0x69, 0x88, 0xd8, 0x57, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff,
-	0xb8, 0xf9, 0x4d, 0xfa, 0x2a, 0x07, 0x00, 0x00,
+	// 988 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x2e, 0xa5, 0x58, 0x95, 0x87, 0xb6, 0x22, 0x6f, 0xec, 0x98, 0x51, 0x0b, 0x5b, 0x71, 0x50,
+	0x40, 0xcd, 0x81, 0xaa, 0xdd, 0xa0, 0xb5, 0x5b, 0xf4, 0x20, 0x8b, 0x44, 0xa2, 0xc2, 0x75, 0x05,
+	0xca, 0x71, 0x81, 0xf6, 0x40, 0x2c, 0xc9, 0xb5, 0xbc, 0x08, 0xc9, 0x65, 0x97, 0x4b, 0xd9, 0xce,
+	0xa9, 0xe8, 0xad, 0xaf, 0xd4, 0x27, 0xe8, 0xb1, 0xc7, 0x1e, 0x0b, 0x3f, 0x49, 0xc1, 0xe5, 0x52,
+	0xb6, 0x7e, 0xe0, 0xf4, 0xb6, 0x3b, 0xdf, 0xcc, 0x37, 0xdf, 0x0e, 0x67, 0x46, 0x82, 0x76, 0xe6,
+	0x11, 0xde, 0xf5, 0x71, 0x40, 0x62, 0x9f, 0x74, 0x71, 0x42, 0xbb, 0x93, 0xfd, 0x6e, 0xc0, 0x22,
+	0x4c, 0x63, 0x33, 0xe1, 0x4c, 0x30, 0xf4, 0x24, 0xf7, 0x30, 0x95, 0x87, 0x89, 0x13, 0x6a, 0x4e,
+	0xf6, 0x5b, 0x3b, 0x63, 0xc6, 0xc6, 0x21, 0xe9, 0x4a, 0x17, 0x2f, 0xbb, 0xe8, 0x06, 0x19, 0xc7,
+	0x82, 0x32, 0x15, 0xd4, 0xda, 0x9d, 0xc7, 0x05, 0x8d, 0x48, 0x2a, 0x70, 0x94, 0x14, 0x0e, 0x7b,
+	0x7f, 0xd4, 0xa1, 0x66, 0xc9, 0x34, 0xa8, 0x01, 0x15, 0x1a, 0x18, 0x5a, 0x5b, 0xeb, 0xac, 0x3a,
+	0x15, 0x1a, 0x20, 0x04, 0x8f, 0x62, 0x1c, 0x11, 0xa3, 0x22, 0x2d, 0xf2, 0x8c, 0x8e, 0xa0, 0x96,
+	0x0a, 0x2c, 0xb2, 0xd4, 0xa8, 0xb6, 0xb5, 0x4e, 0xe3, 0xe0, 0xb9, 0xb9, 0x44, 0x95, 0x59, 0x10,
+	0x8e, 0xa4, 0xa3, 0xa3, 0x02, 0x50, 0x1b, 0xf4, 0x80, 0xa4, 0x3e, 0xa7, 0x49, 0xae, 0xcf, 0x78,
+	0x24, 0x59, 0xef, 0x9b, 0xd0, 0x2e, 0xe8, 0xec, 0x2a, 0x26, 0xdc, 0x25, 0x11, 0xa6, 0xa1, 0xb1,
+	0x22, 0x3d, 0x40, 0x9a, 0xec, 0xdc, 0x82, 0x8e, 0xe0, 0x51, 0x80, 0x05, 0x36, 0x6a, 0xed, 0x6a,
+	0x47, 0x3f, 0xf8, 0xec, 0x81, 0xdc, 0xa6, 0x85, 0x05, 0xb6, 0x63, 0xc1, 0x6f, 0x1c, 0x19, 0x82,
+	0x2e, 0xe1, 0xc5, 0x15, 0xe3, 0xef, 0x2e, 0x42, 0x76, 0xe5, 0x92, 0x6b, 0xe2, 0x67, 0x79, 0x46,
+	0x97, 0x13, 0x41, 0x62, 0x79, 0x4a, 0x08, 0xa7, 0x2c, 0x30, 0x3e, 0x6e, 0x6b, 0x1d, 0xfd, 0xe0,
+	0x99, 0x59, 0x94, 0xcd, 0x2c, 0xcb, 0x66, 0x5a, 0xaa, 0xac, 0x4e, 0xbb, 0x64, 0xb1, 0x4b, 0x12,
+	0xa7, 0xe4, 0x18, 0x4a, 0x0a, 0xd4, 0x87, 0x35, 0x0f, 0x07, 0xae, 0x47, 0x63, 0xcc, 0x29, 0x49,
+	0x8d, 0xba, 0xa4, 0x6c, 0x2f, 0x15, 0x7b, 0x8c, 0x83, 0x63, 0xe5, 0xe7, 0xe8, 0xde, 0xdd, 0x05,
+	0xfd, 0x02, 0xdb, 0x97, 0x34, 0x15, 0x8c, 0xdf, 0xb8, 0x98, 0xfb, 0x97, 0x74, 0x82, 0x43, 0x57,
+	0x15, 0x7e, 0x55, 0x16, 0xfe, 0xc5, 0x52, 0xbe, 0x9e, 0xf2, 0x55, 0xa5, 0xdf, 0x52, 0x1c, 0xb3,
+	0x66, 0xf4, 0x05, 0x6c, 0x2e, 0x90, 0x67, 0x9c, 0x1a, 0x20, 0x0b, 0x8e, 0xe6, 0x82, 0xde, 0x72,
+	0x8a, 0x30, 0xb4, 0x26, 0x34, 0xa5, 0x1e, 0x0d, 0xa9, 0x58, 0x54, 0xa4, 0xff, 0x7f, 0x45, 0xc6,
+	0x1d, 0xcd, 0x9c, 0xa8, 0xaf, 0x60, 0x7b, 0x59, 0x8a, 0x5c, 0xd7, 0x9a, 0xd4, 0xb5, 0xb5, 0x18,
+	0x9a, 0x4b, 0x33, 0xe1, 0x09, 0xf6, 0x05, 0x9d, 0x10, 0xd7, 0x0f, 0xb3, 0x54, 0x10, 0xee, 0xca,
+	0xa6, 0x5d, 0x97, 0x31, 0x1b, 0x05, 0xd4, 0x2f, 0x90, 0xd3, 0xbc, 0x83, 0x87, 0x50, 0x57, 0x8e,
+	0xa9, 0xd1, 0x90, 0x7d, 0xf4, 0x6a, 0xa9, 0x70, 0x15, 0xe3, 0x90, 0x24, 0xa4, 0xbe, 0xfc, 0xf6,
+	0x7d, 0x16, 0x5f, 0xd0, 0x71, 0xd9, 0x08, 0x53, 0x16, 0xf4, 0x39, 0x34, 0x2f, 0x30, 0x0d, 0xd9,
+	0x84, 0x70, 0x77, 0x42, 0x78, 0x9a, 0x77, 0xf7, 0xe3, 0xb6, 0xd6, 0xa9, 0x3a, 0x8f, 0x4b, 0xfb,
+	0x79, 0x61, 0x46, 0x1d, 0x68, 0xd2, 0xd4, 0x1d, 0x87, 0xcc, 0xc3, 0xa1, 0x5b, 0x4c, 0xb7, 0xd1,
+	0x6c, 0x6b, 0x9d, 0xba, 0xd3, 0xa0, 0xe9, 0x6b, 0x69, 0x56, 0xc3, 0x78, 0x04, 0xb5, 0x90, 0x46,
+	0x54, 0xa4, 0xc6, 0x86, 0xec, 0x9f, 0x87, 0x06, 0xed, 0x44, 0x3a, 0x3a, 0x2a, 0xa0, 0xf5, 0x35,
+	0xac, 0x4e, 0xbb, 0x1f, 0x35, 0xa1, 0xfa, 0x8e, 0xdc, 0xa8, 0xa9, 0xce, 0x8f, 0x68, 0x13, 0x56,
+	0x26, 0x38, 0xcc, 0xca, 0xb9, 0x2e, 0x2e, 0xdf, 0x54, 0x0e, 0xb5, 0x3d, 0x0b, 0x76, 0x3f, 0xf0,
+	0x6a, 0xf4, 0x1c, 0xd6, 0x66, 0xca, 0x5c, 0xf0, 0xea, 0xfe, 0x5d, 0x81, 0xf7, 0xfe, 0xd4, 0x40,
+	0xbf, 0xd7, 0xd7, 0xe8, 0x7b, 0xa8, 0x4f, 0x67, 0x41, 0x93, 0x05, 0x37, 0x3f, 0x34, 0x0b, 0x66,
+	0x79, 0x28, 0x26, 0x78, 0x1a, 0xdf, 0x72, 0x61, 0x7d, 0x06, 0x5a, 0xf2, 0xbc, 0xc3, 0xfb, 0xcf,
+	0xd3, 0x0f, 0xf6, 0x1e, 0xcc, 0x75, 0x33, 0x88, 0x2f, 0xd8, 0xfd, 0x12, 0xfc, 0xae, 0xc1, 0xfa,
+	0x0c, 0x88, 0x9e, 0x42, 0x8d, 0x13, 0x9c, 0xb2, 0x58, 0x25, 0x51, 0x37, 0xd4, 0x82, 0x3a, 0x4b,
+	0x08, 0xc7, 0x82, 0x71, 0x55, 0xc9, 0xe9, 0x1d, 0x7d, 0x07, 0x6b, 0x3e, 0x27, 0x58, 0x90, 0xc0,
+	0xcd, 0xf7, 0xad, 0xdc, 0x95, 0xfa, 0x41, 0x6b, 0x61, 0xab, 0x9c, 0x95, 0xcb, 0xd8, 0xd1, 0x95,
+	0x7f, 0x6e, 0xd9, 0xfb, 0x47, 0x83, 0xb5, 0xfb, 0x5f, 0x36, 0xef, 0x71, 0x4e, 0x7e, 0xcd, 0x48,
+	0x2a, 0xd2, 0x7c, 0x51, 0xb9, 0x29, 0xf1, 0x59, 0x5c, 0xac, 0xea, 0x15, 0x67, 0xa3, 0x84, 0x86,
+	0x84, 0x8f, 0x24, 0x80, 0x0e, 0xc1, 0x88, 0xf0, 0xb5, 0xeb, 0xb3, 0xd8, 0xcf, 0x38, 0x27, 0xb1,
+	0x70, 0xcb, 0xad, 0x95, 0x4a, 0xad, 0x2b, 0xce, 0xd3, 0x08, 0x5f, 0xf7, 0xa7, 0xf0, 0x4f, 0x25,
+	0x8a, 0x5e, 0x41, 0x8e, 0xb8, 0x09, 0x89, 0x03, 0x1a, 0x8f, 0x5d, 0x39, 0x3e, 0x54, 0xe4, 0x9f,
+	0xae, 0x2a, 0xe3, 0x36, 0x23, 0x7c, 0x3d, 0x2c, 0xc0, 0xde, 0x14, 0xcb, 0xdb, 0x3a, 0x8f, 0x2a,
+	0x97, 0x4a, 0x4a, 0xdf, 0x13, 0xb9, 0xdf, 0xab, 0x4e, 0x23, 0xc2, 0xd7, 0x6f, 0x0a, 0xf3, 0x88,
+	0xbe, 0x27, 0x2f, 0x7f, 0x9b, 0x3e, 0x4d, 0x8d, 0xfd, 0x33, 0xd8, 0xb2, 0x7e, 0xfc, 0xa1, 0x37,
+	0x38, 0x75, 0x47, 0x67, 0xbd, 0xb3, 0xb7, 0x23, 0x77, 0x70, 0x7a, 0xde, 0x3b, 0x19, 0x58, 0xcd,
+	0x8f, 0xd0, 0xa7, 0x60, 0xcc, 0x42, 0x8e, 0xfd, 0x7a, 0x30, 0x3a, 0xb3, 0x1d, 0xdb, 0x6a, 0x6a,
+	0x8b, 0xa8, 0x65, 0x0f, 0x1d, 0xbb, 0xdf, 0x3b, 0xb3, 0xad, 0x66, 0x65, 0x91, 0xd6, 0xb2, 0x4f,
+	0xec, 0x1c, 0xaa, 0xbe, 0xbc, 0x84, 0xc6, 0xdc, 0xea, 0xf9, 0x04, 0xb6, 0x7b, 0x4e, 0xff, 0xcd,
+	0xe0, 0xbc, 0x77, 0xb2, 0x54, 0xc5, 0x3c, 0x68, 0x0d, 0x46, 0xbd, 0xe3, 0x13, 0xa9, 0x62, 0x49,
+	0xa8, 0x7d, 0x5a, 0x80, 0x95, 0x63, 0xef, 0xaf, 0xdb, 0x1d, 0xed, 0xef, 0xdb, 0x1d, 0xed, 0xdf,
+	0xdb, 0x1d, 0x0d, 0xb6, 0x7d, 0x16, 0x2d, 0x6b, 0xc6, 0xe3, 0x7a, 0x2f, 0xa1, 0xc3, 0xbc, 0x25,
+	0x86, 0xda, 0xcf, 0xdd, 0x31, 0x15, 0x97, 0x99, 0x67, 0xfa, 0x2c, 0xea, 0xce, 0xfc, 0x23, 0x30,
+	0xc7, 0x24, 0x2e, 0x7e, 0xc5, 0xd5, 0x9f, 0x83, 0x6f, 0x71, 0x42, 0x27, 0xfb, 0x5e, 0x4d, 0xda,
+	0xbe, 0xfc, 0x6f, 0x00, 0xae, 0xd9, 0x14, 0xa5, 0x40, 0x08, 0x00, 0x00,
 }
 
 func (m *Domain) Marshal() (dAtA []byte, err error) {
@@ -524,6 +612,20 @@ func (m *Domain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Limits != nil {
+		{
+			size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDomain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.IsGlobalDomain {
 		i--
 		if m.IsGlobalDomain {
@@ -805,6 +907,53 @@ func (m *BadBinaryInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DomainLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxHistorySize != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.MaxHistorySize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxPendingActivities != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.MaxPendingActivities))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxConcurrentWorkflows != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.MaxConcurrentWorkflows))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestsPerSecond != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.RequestsPerSecond))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDomain(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDomain(v)
 	base := offset
@@ -887,6 +1036,10 @@ func (m *Domain) Size() (n int) {
 	if m.IsGlobalDomain {
 		n += 3
 	}
+	if m.Limits != nil {
+		l = m.Limits.Size()
+		n += 2 + l + sovDomain(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -958,6 +1111,30 @@ func (m *BadBinaryInfo) Size() (n int) {
 	return n
 }
 
+func (m *DomainLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestsPerSecond != 0 {
+		n += 1 + sovDomain(uint64(m.RequestsPerSecond))
+	}
+	if m.MaxConcurrentWorkflows != 0 {
+		n += 1 + sovDomain(uint64(m.MaxConcurrentWorkflows))
+	}
+	if m.MaxPendingActivities != 0 {
+		n += 1 + sovDomain(uint64(m.MaxPendingActivities))
+	}
+	if m.MaxHistorySize != 0 {
+		n += 1 + sovDomain(uint64(m.MaxHistorySize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovDomain(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1546,6 +1723,42 @@ func (m *Domain) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsGlobalDomain = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limits == nil {
+				m.Limits = &DomainLimits{}
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDomain(dAtA[iNdEx:])
@@ -1994,6 +2207,136 @@ func (m *BadBinaryInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DomainLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsPerSecond", wireType)
+			}
+			m.RequestsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestsPerSecond |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConcurrentWorkflows", wireType)
+			}
+			m.MaxConcurrentWorkflows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConcurrentWorkflows |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingActivities", wireType)
+			}
+			m.MaxPendingActivities = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPendingActivities |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHistorySize", wireType)
+			}
+			m.MaxHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHistorySize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDomain(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
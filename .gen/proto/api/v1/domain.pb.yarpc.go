@@ -28,59 +28,67 @@ package apiv1
 var yarpcFileDescriptorClosure824795d6ae7d8e2f = [][]byte{
 	// uber/cadence/api/v1/domain.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x72, 0xdb, 0x44,
-		0x18, 0x45, 0x76, 0xea, 0x3a, 0x9f, 0x1c, 0xd7, 0x6c, 0x1b, 0xa2, 0x1a, 0x86, 0xa8, 0xe9, 0x30,
-		0x63, 0x7a, 0x21, 0x13, 0xc3, 0x40, 0x0b, 0xc3, 0x85, 0x6d, 0x69, 0x8a, 0x99, 0x10, 0x3c, 0xb2,
-		0x9b, 0x0b, 0xb8, 0xd0, 0xac, 0xa4, 0xb5, 0xbd, 0x53, 0x59, 0xab, 0x59, 0xfd, 0x84, 0xdc, 0x31,
-		0x3c, 0x16, 0x0f, 0xc1, 0x33, 0x31, 0x5a, 0xad, 0x1c, 0xff, 0x68, 0x9a, 0xde, 0xed, 0x7e, 0x3f,
-		0xe7, 0x3b, 0x3a, 0x3a, 0xbb, 0x0b, 0x7a, 0xea, 0x12, 0xde, 0xf7, 0xb0, 0x4f, 0x42, 0x8f, 0xf4,
-		0x71, 0x44, 0xfb, 0xd9, 0x65, 0xdf, 0x67, 0x6b, 0x4c, 0x43, 0x23, 0xe2, 0x2c, 0x61, 0xe8, 0x69,
-		0x5e, 0x61, 0xc8, 0x0a, 0x03, 0x47, 0xd4, 0xc8, 0x2e, 0xbb, 0x5f, 0x2e, 0x19, 0x5b, 0x06, 0xa4,
-		0x2f, 0x4a, 0xdc, 0x74, 0xd1, 0xf7, 0x53, 0x8e, 0x13, 0xca, 0x64, 0x53, 0xf7, 0x7c, 0x3f, 0x9f,
-		0xd0, 0x35, 0x89, 0x13, 0xbc, 0x8e, 0x8a, 0x82, 0x8b, 0xff, 0x1e, 0x43, 0xc3, 0x14, 0x63, 0x50,
-		0x1b, 0x6a, 0xd4, 0xd7, 0x14, 0x5d, 0xe9, 0x1d, 0xdb, 0x35, 0xea, 0x23, 0x04, 0x47, 0x21, 0x5e,
-		0x13, 0xad, 0x26, 0x22, 0x62, 0x8d, 0xde, 0x40, 0x23, 0x4e, 0x70, 0x92, 0xc6, 0x5a, 0x5d, 0x57,
-		0x7a, 0xed, 0xc1, 0x0b, 0xa3, 0x82, 0x95, 0x51, 0x00, 0xce, 0x44, 0xa1, 0x2d, 0x1b, 0x90, 0x0e,
-		0xaa, 0x4f, 0x62, 0x8f, 0xd3, 0x28, 0xe7, 0xa7, 0x1d, 0x09, 0xd4, 0xed, 0x10, 0x3a, 0x07, 0x95,
-		0xdd, 0x86, 0x84, 0x3b, 0x64, 0x8d, 0x69, 0xa0, 0x3d, 0x12, 0x15, 0x20, 0x42, 0x56, 0x1e, 0x41,
-		0x6f, 0xe0, 0xc8, 0xc7, 0x09, 0xd6, 0x1a, 0x7a, 0xbd, 0xa7, 0x0e, 0xbe, 0xfa, 0xc0, 0x6c, 0xc3,
-		0xc4, 0x09, 0xb6, 0xc2, 0x84, 0xdf, 0xd9, 0xa2, 0x05, 0xad, 0xe0, 0xe5, 0x2d, 0xe3, 0xef, 0x17,
-		0x01, 0xbb, 0x75, 0xc8, 0x5f, 0xc4, 0x4b, 0xf3, 0x89, 0x0e, 0x27, 0x09, 0x09, 0xc5, 0x2a, 0x22,
-		0x9c, 0x32, 0x5f, 0x7b, 0xac, 0x2b, 0x3d, 0x75, 0xf0, 0xdc, 0x28, 0x64, 0x33, 0x4a, 0xd9, 0x0c,
-		0x53, 0xca, 0x6a, 0xeb, 0x25, 0x8a, 0x55, 0x82, 0xd8, 0x25, 0xc6, 0x54, 0x40, 0xa0, 0x31, 0xb4,
-		0x5c, 0xec, 0x3b, 0x2e, 0x0d, 0x31, 0xa7, 0x24, 0xd6, 0x9a, 0x02, 0x52, 0xaf, 0x24, 0x3b, 0xc2,
-		0xfe, 0x48, 0xd6, 0xd9, 0xaa, 0x7b, 0xbf, 0x41, 0x7f, 0xc2, 0xd9, 0x8a, 0xc6, 0x09, 0xe3, 0x77,
-		0x0e, 0xe6, 0xde, 0x8a, 0x66, 0x38, 0x70, 0xa4, 0xf0, 0xc7, 0x42, 0xf8, 0x97, 0x95, 0x78, 0x43,
-		0x59, 0x2b, 0xa5, 0x3f, 0x95, 0x18, 0xbb, 0x61, 0xf4, 0x0d, 0x3c, 0x3b, 0x00, 0x4f, 0x39, 0xd5,
-		0x40, 0x08, 0x8e, 0xf6, 0x9a, 0xde, 0x71, 0x8a, 0x30, 0x74, 0x33, 0x1a, 0x53, 0x97, 0x06, 0x34,
-		0x39, 0x64, 0xa4, 0x7e, 0x3c, 0x23, 0xed, 0x1e, 0x66, 0x8f, 0xd4, 0xf7, 0x70, 0x56, 0x35, 0x22,
-		0xe7, 0xd5, 0x12, 0xbc, 0x4e, 0x0f, 0x5b, 0x73, 0x6a, 0x06, 0x3c, 0xc5, 0x5e, 0x42, 0x33, 0xe2,
-		0x78, 0x41, 0x1a, 0x27, 0x84, 0x3b, 0xc2, 0xb4, 0x27, 0xa2, 0xe7, 0xd3, 0x22, 0x35, 0x2e, 0x32,
-		0xd7, 0xb9, 0x83, 0xa7, 0xd0, 0x94, 0x85, 0xb1, 0xd6, 0x16, 0x3e, 0xfa, 0xae, 0x92, 0xb8, 0xec,
-		0xb1, 0x49, 0x14, 0x50, 0x4f, 0xfc, 0xfb, 0x31, 0x0b, 0x17, 0x74, 0x59, 0x1a, 0x61, 0x83, 0x82,
-		0xbe, 0x86, 0xce, 0x02, 0xd3, 0x80, 0x65, 0x84, 0x3b, 0x19, 0xe1, 0x71, 0xee, 0xee, 0x27, 0xba,
-		0xd2, 0xab, 0xdb, 0x4f, 0xca, 0xf8, 0x4d, 0x11, 0x46, 0x3d, 0xe8, 0xd0, 0xd8, 0x59, 0x06, 0xcc,
-		0xc5, 0x81, 0x53, 0x9c, 0x6e, 0xad, 0xa3, 0x2b, 0xbd, 0xa6, 0xdd, 0xa6, 0xf1, 0x5b, 0x11, 0x2e,
-		0xfc, 0xdb, 0xfd, 0x01, 0x8e, 0x37, 0x16, 0x46, 0x1d, 0xa8, 0xbf, 0x27, 0x77, 0xf2, 0x68, 0xe6,
-		0x4b, 0xf4, 0x0c, 0x1e, 0x65, 0x38, 0x48, 0xcb, 0xc3, 0x59, 0x6c, 0x7e, 0xac, 0xbd, 0x56, 0x2e,
-		0x4c, 0x38, 0x7f, 0x80, 0x3a, 0x7a, 0x01, 0xad, 0x1d, 0xad, 0x0a, 0x5c, 0xd5, 0xbb, 0x57, 0xe9,
-		0xe2, 0x5f, 0x05, 0xd4, 0x2d, 0x73, 0xa2, 0x5f, 0xa1, 0xb9, 0x31, 0xb4, 0x22, 0x54, 0x33, 0x1e,
-		0x32, 0xb4, 0x51, 0x2e, 0x8a, 0x63, 0xb8, 0xe9, 0xef, 0x3a, 0x70, 0xb2, 0x93, 0xaa, 0xf8, 0xbc,
-		0xd7, 0xdb, 0x9f, 0xa7, 0x0e, 0x2e, 0x3e, 0x38, 0xeb, 0x6e, 0x12, 0x2e, 0xd8, 0xb6, 0x04, 0xff,
-		0x28, 0x70, 0xb2, 0x93, 0x44, 0x9f, 0x41, 0x83, 0x13, 0x1c, 0xb3, 0x50, 0x0e, 0x91, 0x3b, 0xd4,
-		0x85, 0x26, 0x8b, 0x08, 0xc7, 0x09, 0xe3, 0x52, 0xc9, 0xcd, 0x1e, 0xfd, 0x0c, 0x2d, 0x8f, 0x13,
-		0x9c, 0x10, 0xdf, 0xc9, 0x2f, 0x4d, 0x71, 0xe1, 0xa9, 0x83, 0xee, 0xc1, 0xd5, 0x30, 0x2f, 0x6f,
-		0x54, 0x5b, 0x95, 0xf5, 0x79, 0xe4, 0xd5, 0xdf, 0x0a, 0xb4, 0xb6, 0xef, 0x41, 0xf4, 0x1c, 0x4e,
-		0xcd, 0xdf, 0x7f, 0x1b, 0x4e, 0xae, 0x9d, 0xd9, 0x7c, 0x38, 0x7f, 0x37, 0x73, 0x26, 0xd7, 0x37,
-		0xc3, 0xab, 0x89, 0xd9, 0xf9, 0x04, 0x7d, 0x01, 0xda, 0x6e, 0xca, 0xb6, 0xde, 0x4e, 0x66, 0x73,
-		0xcb, 0xb6, 0xcc, 0x8e, 0x72, 0x98, 0x35, 0xad, 0xa9, 0x6d, 0x8d, 0x87, 0x73, 0xcb, 0xec, 0xd4,
-		0x0e, 0x61, 0x4d, 0xeb, 0xca, 0xca, 0x53, 0xf5, 0x57, 0x2b, 0x68, 0xef, 0x1d, 0xb2, 0xcf, 0xe1,
-		0x6c, 0x68, 0x8f, 0x7f, 0x99, 0xdc, 0x0c, 0xaf, 0x2a, 0x59, 0xec, 0x27, 0xcd, 0xc9, 0x6c, 0x38,
-		0xba, 0x12, 0x2c, 0x2a, 0x5a, 0xad, 0xeb, 0x22, 0x59, 0x1b, 0xdd, 0xc0, 0x99, 0xc7, 0xd6, 0x55,
-		0x7f, 0x69, 0xd4, 0x1c, 0x46, 0x74, 0x9a, 0x6b, 0x35, 0x55, 0xfe, 0xe8, 0x2f, 0x69, 0xb2, 0x4a,
-		0x5d, 0xc3, 0x63, 0xeb, 0xfe, 0xce, 0x7b, 0x67, 0x2c, 0x49, 0x58, 0xbc, 0x51, 0xf2, 0xe9, 0xfb,
-		0x09, 0x47, 0x34, 0xbb, 0x74, 0x1b, 0x22, 0xf6, 0xed, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd4,
-		0x52, 0xa7, 0xe0, 0x1e, 0x07, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x5f, 0x6f, 0xe3, 0x44,
+		0x10, 0xc7, 0x49, 0x1b, 0xd2, 0x71, 0x9b, 0x4b, 0xf7, 0xda, 0xab, 0x2f, 0x20, 0x9a, 0xeb, 0x09,
+		0x29, 0xdc, 0x83, 0x43, 0xcb, 0x09, 0x5a, 0x10, 0x0f, 0x69, 0x6c, 0xdd, 0x05, 0x95, 0x12, 0x39,
+		0xbd, 0x22, 0xc1, 0xc3, 0x6a, 0x63, 0x6f, 0xd3, 0xd5, 0xd9, 0x5e, 0xb3, 0x5e, 0xa7, 0xed, 0x3d,
+		0x21, 0xde, 0xf8, 0x4a, 0x7c, 0x12, 0x3e, 0x0e, 0xf2, 0x7a, 0x9d, 0x36, 0x7f, 0xd4, 0xe3, 0x6d,
+		0x77, 0x7e, 0x33, 0xbf, 0xf9, 0xed, 0x78, 0x66, 0x12, 0x68, 0x67, 0x63, 0x2a, 0xba, 0x3e, 0x09,
+		0x68, 0xec, 0xd3, 0x2e, 0x49, 0x58, 0x77, 0x7a, 0xd8, 0x0d, 0x78, 0x44, 0x58, 0x6c, 0x27, 0x82,
+		0x4b, 0x8e, 0x9e, 0xe6, 0x1e, 0xb6, 0xf6, 0xb0, 0x49, 0xc2, 0xec, 0xe9, 0x61, 0xeb, 0x8b, 0x09,
+		0xe7, 0x93, 0x90, 0x76, 0x95, 0xcb, 0x38, 0xbb, 0xea, 0x06, 0x99, 0x20, 0x92, 0x71, 0x1d, 0xd4,
+		0xda, 0x5f, 0xc4, 0x25, 0x8b, 0x68, 0x2a, 0x49, 0x94, 0x14, 0x0e, 0x07, 0x7f, 0xd7, 0xa1, 0xe6,
+		0xa8, 0x34, 0xa8, 0x01, 0x15, 0x16, 0x58, 0x46, 0xdb, 0xe8, 0x6c, 0x78, 0x15, 0x16, 0x20, 0x04,
+		0x6b, 0x31, 0x89, 0xa8, 0x55, 0x51, 0x16, 0x75, 0x46, 0x27, 0x50, 0x4b, 0x25, 0x91, 0x59, 0x6a,
+		0x55, 0xdb, 0x46, 0xa7, 0x71, 0xf4, 0xc2, 0x5e, 0xa1, 0xca, 0x2e, 0x08, 0x47, 0xca, 0xd1, 0xd3,
+		0x01, 0xa8, 0x0d, 0x66, 0x40, 0x53, 0x5f, 0xb0, 0x24, 0xd7, 0x67, 0xad, 0x29, 0xd6, 0x87, 0x26,
+		0xb4, 0x0f, 0x26, 0xbf, 0x89, 0xa9, 0xc0, 0x34, 0x22, 0x2c, 0xb4, 0xd6, 0x95, 0x07, 0x28, 0x93,
+		0x9b, 0x5b, 0xd0, 0x09, 0xac, 0x05, 0x44, 0x12, 0xab, 0xd6, 0xae, 0x76, 0xcc, 0xa3, 0x2f, 0x1f,
+		0xc9, 0x6d, 0x3b, 0x44, 0x12, 0x37, 0x96, 0xe2, 0xce, 0x53, 0x21, 0xe8, 0x1a, 0x5e, 0xde, 0x70,
+		0xf1, 0xfe, 0x2a, 0xe4, 0x37, 0x98, 0xde, 0x52, 0x3f, 0xcb, 0x33, 0x62, 0x41, 0x25, 0x8d, 0xd5,
+		0x29, 0xa1, 0x82, 0xf1, 0xc0, 0xfa, 0xb4, 0x6d, 0x74, 0xcc, 0xa3, 0xe7, 0x76, 0x51, 0x36, 0xbb,
+		0x2c, 0x9b, 0xed, 0xe8, 0xb2, 0x7a, 0xed, 0x92, 0xc5, 0x2d, 0x49, 0xbc, 0x92, 0x63, 0xa8, 0x28,
+		0x50, 0x1f, 0x36, 0xc7, 0x24, 0xc0, 0x63, 0x16, 0x13, 0xc1, 0x68, 0x6a, 0xd5, 0x15, 0x65, 0x7b,
+		0xa5, 0xd8, 0x53, 0x12, 0x9c, 0x6a, 0x3f, 0xcf, 0x1c, 0xdf, 0x5f, 0xd0, 0xef, 0xb0, 0x77, 0xcd,
+		0x52, 0xc9, 0xc5, 0x1d, 0x26, 0xc2, 0xbf, 0x66, 0x53, 0x12, 0x62, 0x5d, 0xf8, 0x0d, 0x55, 0xf8,
+		0x97, 0x2b, 0xf9, 0x7a, 0xda, 0x57, 0x97, 0x7e, 0x57, 0x73, 0xcc, 0x9b, 0xd1, 0xd7, 0xb0, 0xb3,
+		0x44, 0x9e, 0x09, 0x66, 0x81, 0x2a, 0x38, 0x5a, 0x08, 0x7a, 0x27, 0x18, 0x22, 0xd0, 0x9a, 0xb2,
+		0x94, 0x8d, 0x59, 0xc8, 0xe4, 0xb2, 0x22, 0xf3, 0xff, 0x2b, 0xb2, 0xee, 0x69, 0x16, 0x44, 0x7d,
+		0x0b, 0x7b, 0xab, 0x52, 0xe4, 0xba, 0x36, 0x95, 0xae, 0xdd, 0xe5, 0xd0, 0x5c, 0x9a, 0x0d, 0x4f,
+		0x89, 0x2f, 0xd9, 0x94, 0x62, 0x3f, 0xcc, 0x52, 0x49, 0x05, 0x56, 0x4d, 0xbb, 0xa5, 0x62, 0xb6,
+		0x0b, 0xa8, 0x5f, 0x20, 0xe7, 0x79, 0x07, 0x0f, 0xa1, 0xae, 0x1d, 0x53, 0xab, 0xa1, 0xfa, 0xe8,
+		0xf5, 0x4a, 0xe1, 0x3a, 0xc6, 0xa3, 0x49, 0xc8, 0x7c, 0xf5, 0xed, 0xfb, 0x3c, 0xbe, 0x62, 0x93,
+		0xb2, 0x11, 0x66, 0x2c, 0xe8, 0x2b, 0x68, 0x5e, 0x11, 0x16, 0xf2, 0x29, 0x15, 0x78, 0x4a, 0x45,
+		0x9a, 0x77, 0xf7, 0x93, 0xb6, 0xd1, 0xa9, 0x7a, 0x4f, 0x4a, 0xfb, 0x65, 0x61, 0x46, 0x1d, 0x68,
+		0xb2, 0x14, 0x4f, 0x42, 0x3e, 0x26, 0x21, 0x2e, 0xa6, 0xdb, 0x6a, 0xb6, 0x8d, 0x4e, 0xdd, 0x6b,
+		0xb0, 0xf4, 0x8d, 0x32, 0xeb, 0x61, 0x3c, 0x81, 0x5a, 0xc8, 0x22, 0x26, 0x53, 0x6b, 0x5b, 0xf5,
+		0xcf, 0x63, 0x83, 0x76, 0xa6, 0x1c, 0x3d, 0x1d, 0xd0, 0xfa, 0x0e, 0x36, 0x66, 0xdd, 0x8f, 0x9a,
+		0x50, 0x7d, 0x4f, 0xef, 0xf4, 0x54, 0xe7, 0x47, 0xb4, 0x03, 0xeb, 0x53, 0x12, 0x66, 0xe5, 0x5c,
+		0x17, 0x97, 0xef, 0x2b, 0xc7, 0xc6, 0x81, 0x03, 0xfb, 0x1f, 0x79, 0x35, 0x7a, 0x01, 0x9b, 0x73,
+		0x65, 0x2e, 0x78, 0x4d, 0xff, 0xbe, 0xc0, 0x07, 0xff, 0x18, 0x60, 0x3e, 0xe8, 0x6b, 0xf4, 0x13,
+		0xd4, 0x67, 0xb3, 0x60, 0xa8, 0x82, 0xdb, 0x1f, 0x9b, 0x05, 0xbb, 0x3c, 0x14, 0x13, 0x3c, 0x8b,
+		0x6f, 0x61, 0xd8, 0x9a, 0x83, 0x56, 0x3c, 0xef, 0xf8, 0xe1, 0xf3, 0xcc, 0xa3, 0x83, 0x47, 0x73,
+		0xdd, 0x0d, 0xe2, 0x2b, 0xfe, 0xb0, 0x04, 0x7f, 0x19, 0xb0, 0x35, 0x07, 0xa2, 0x67, 0x50, 0x13,
+		0x94, 0xa4, 0x3c, 0xd6, 0x49, 0xf4, 0x0d, 0xb5, 0xa0, 0xce, 0x13, 0x2a, 0x88, 0xe4, 0x42, 0x57,
+		0x72, 0x76, 0x47, 0x3f, 0xc2, 0xa6, 0x2f, 0x28, 0x91, 0x34, 0xc0, 0xf9, 0xbe, 0x55, 0xbb, 0xd2,
+		0x3c, 0x6a, 0x2d, 0x6d, 0x95, 0x8b, 0x72, 0x19, 0x7b, 0xa6, 0xf6, 0xcf, 0x2d, 0x07, 0xff, 0x1a,
+		0xb0, 0xf9, 0xf0, 0xcb, 0xe6, 0x3d, 0x2e, 0xe8, 0x1f, 0x19, 0x4d, 0x65, 0x9a, 0x2f, 0x2a, 0x9c,
+		0x52, 0x9f, 0xc7, 0xc5, 0xaa, 0x5e, 0xf7, 0xb6, 0x4b, 0x68, 0x48, 0xc5, 0x48, 0x01, 0xe8, 0x18,
+		0xac, 0x88, 0xdc, 0x62, 0x9f, 0xc7, 0x7e, 0x26, 0x04, 0x8d, 0x25, 0x2e, 0xb7, 0x56, 0xaa, 0xb4,
+		0xae, 0x7b, 0xcf, 0x22, 0x72, 0xdb, 0x9f, 0xc1, 0xbf, 0x96, 0x28, 0x7a, 0x0d, 0x39, 0x82, 0x13,
+		0x1a, 0x07, 0x2c, 0x9e, 0x60, 0x35, 0x3e, 0x4c, 0xe6, 0x9f, 0xae, 0xaa, 0xe2, 0x76, 0x22, 0x72,
+		0x3b, 0x2c, 0xc0, 0xde, 0x0c, 0xcb, 0xdb, 0x3a, 0x8f, 0x2a, 0x97, 0x4a, 0xca, 0x3e, 0x50, 0xb5,
+		0xdf, 0xab, 0x5e, 0x23, 0x22, 0xb7, 0x6f, 0x0b, 0xf3, 0x88, 0x7d, 0xa0, 0xaf, 0xfe, 0x9c, 0x3d,
+		0x4d, 0x8f, 0xfd, 0x73, 0xd8, 0x75, 0x7e, 0xf9, 0xb9, 0x37, 0x38, 0xc7, 0xa3, 0x8b, 0xde, 0xc5,
+		0xbb, 0x11, 0x1e, 0x9c, 0x5f, 0xf6, 0xce, 0x06, 0x4e, 0xf3, 0x13, 0xf4, 0x39, 0x58, 0xf3, 0x90,
+		0xe7, 0xbe, 0x19, 0x8c, 0x2e, 0x5c, 0xcf, 0x75, 0x9a, 0xc6, 0x32, 0xea, 0xb8, 0x43, 0xcf, 0xed,
+		0xf7, 0x2e, 0x5c, 0xa7, 0x59, 0x59, 0xa6, 0x75, 0xdc, 0x33, 0x37, 0x87, 0xaa, 0xaf, 0xae, 0xa1,
+		0xb1, 0xb0, 0x7a, 0x3e, 0x83, 0xbd, 0x9e, 0xd7, 0x7f, 0x3b, 0xb8, 0xec, 0x9d, 0xad, 0x54, 0xb1,
+		0x08, 0x3a, 0x83, 0x51, 0xef, 0xf4, 0x4c, 0xa9, 0x58, 0x11, 0xea, 0x9e, 0x17, 0x60, 0xe5, 0xf4,
+		0x12, 0xf6, 0x7c, 0x1e, 0xad, 0x6a, 0xc0, 0xd3, 0x7a, 0x2f, 0x61, 0xc3, 0xbc, 0x0d, 0x86, 0xc6,
+		0x6f, 0xdd, 0x09, 0x93, 0xd7, 0xd9, 0xd8, 0xf6, 0x79, 0xd4, 0x9d, 0xfb, 0x17, 0x60, 0x4f, 0x68,
+		0x5c, 0xfc, 0x72, 0xeb, 0x3f, 0x04, 0x3f, 0x90, 0x84, 0x4d, 0x0f, 0xc7, 0x35, 0x65, 0xfb, 0xe6,
+		0xbf, 0x01, 0x00, 0x20, 0x24, 0xae, 0x25, 0x34, 0x08, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
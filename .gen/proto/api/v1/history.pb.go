@@ -169,6 +169,11 @@ type HistoryEvent struct {
 	//	*HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes
 	//	*HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes
 	//	*HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes
+	//	*HistoryEvent_WorkflowExecutionPausedEventAttributes
+	//	*HistoryEvent_WorkflowExecutionUnpausedEventAttributes
+	//	*HistoryEvent_WorkflowExecutionUpdateAcceptedEventAttributes
+	//	*HistoryEvent_WorkflowExecutionUpdateCompletedEventAttributes
+	//	*HistoryEvent_UpsertWorkflowMemoEventAttributes
 	Attributes           isHistoryEvent_Attributes `protobuf_oneof:"attributes"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
@@ -340,6 +345,21 @@ type HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes struct {
 type HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes struct {
 	UpsertWorkflowSearchAttributesEventAttributes *UpsertWorkflowSearchAttributesEventAttributes `protobuf:"bytes,46,opt,name=upsert_workflow_search_attributes_event_attributes,json=upsertWorkflowSearchAttributesEventAttributes,proto3,oneof" json:"upsert_workflow_search_attributes_event_attributes,omitempty"`
 }
+type HistoryEvent_WorkflowExecutionPausedEventAttributes struct {
+	WorkflowExecutionPausedEventAttributes *WorkflowExecutionPausedEventAttributes `protobuf:"bytes,47,opt,name=workflow_execution_paused_event_attributes,json=workflowExecutionPausedEventAttributes,proto3,oneof" json:"workflow_execution_paused_event_attributes,omitempty"`
+}
+type HistoryEvent_WorkflowExecutionUnpausedEventAttributes struct {
+	WorkflowExecutionUnpausedEventAttributes *WorkflowExecutionUnpausedEventAttributes `protobuf:"bytes,48,opt,name=workflow_execution_unpaused_event_attributes,json=workflowExecutionUnpausedEventAttributes,proto3,oneof" json:"workflow_execution_unpaused_event_attributes,omitempty"`
+}
+type HistoryEvent_WorkflowExecutionUpdateAcceptedEventAttributes struct {
+	WorkflowExecutionUpdateAcceptedEventAttributes *WorkflowExecutionUpdateAcceptedEventAttributes `protobuf:"bytes,49,opt,name=workflow_execution_update_accepted_event_attributes,json=workflowExecutionUpdateAcceptedEventAttributes,proto3,oneof" json:"workflow_execution_update_accepted_event_attributes,omitempty"`
+}
+type HistoryEvent_WorkflowExecutionUpdateCompletedEventAttributes struct {
+	WorkflowExecutionUpdateCompletedEventAttributes *WorkflowExecutionUpdateCompletedEventAttributes `protobuf:"bytes,50,opt,name=workflow_execution_update_completed_event_attributes,json=workflowExecutionUpdateCompletedEventAttributes,proto3,oneof" json:"workflow_execution_update_completed_event_attributes,omitempty"`
+}
+type HistoryEvent_UpsertWorkflowMemoEventAttributes struct {
+	UpsertWorkflowMemoEventAttributes *UpsertWorkflowMemoEventAttributes `protobuf:"bytes,51,opt,name=upsert_workflow_memo_event_attributes,json=upsertWorkflowMemoEventAttributes,proto3,oneof" json:"upsert_workflow_memo_event_attributes,omitempty"`
+}
 
 func (*HistoryEvent_WorkflowExecutionStartedEventAttributes) isHistoryEvent_Attributes()         {}
 func (*HistoryEvent_WorkflowExecutionCompletedEventAttributes) isHistoryEvent_Attributes()       {}
@@ -389,6 +409,11 @@ func (*HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes) isHist
 }
 func (*HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes) isHistoryEvent_Attributes() {}
 func (*HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes) isHistoryEvent_Attributes()    {}
+func (*HistoryEvent_WorkflowExecutionPausedEventAttributes) isHistoryEvent_Attributes()           {}
+func (*HistoryEvent_WorkflowExecutionUnpausedEventAttributes) isHistoryEvent_Attributes()         {}
+func (*HistoryEvent_WorkflowExecutionUpdateAcceptedEventAttributes) isHistoryEvent_Attributes()   {}
+func (*HistoryEvent_WorkflowExecutionUpdateCompletedEventAttributes) isHistoryEvent_Attributes()  {}
+func (*HistoryEvent_UpsertWorkflowMemoEventAttributes) isHistoryEvent_Attributes()                {}
 
 func (m *HistoryEvent) GetAttributes() isHistoryEvent_Attributes {
 	if m != nil {
@@ -719,6 +744,41 @@ func (m *HistoryEvent) GetUpsertWorkflowSearchAttributesEventAttributes() *Upser
 	return nil
 }
 
+func (m *HistoryEvent) GetWorkflowExecutionPausedEventAttributes() *WorkflowExecutionPausedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_WorkflowExecutionPausedEventAttributes); ok {
+		return x.WorkflowExecutionPausedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetWorkflowExecutionUnpausedEventAttributes() *WorkflowExecutionUnpausedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_WorkflowExecutionUnpausedEventAttributes); ok {
+		return x.WorkflowExecutionUnpausedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetWorkflowExecutionUpdateAcceptedEventAttributes() *WorkflowExecutionUpdateAcceptedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_WorkflowExecutionUpdateAcceptedEventAttributes); ok {
+		return x.WorkflowExecutionUpdateAcceptedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetWorkflowExecutionUpdateCompletedEventAttributes() *WorkflowExecutionUpdateCompletedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_WorkflowExecutionUpdateCompletedEventAttributes); ok {
+		return x.WorkflowExecutionUpdateCompletedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetUpsertWorkflowMemoEventAttributes() *UpsertWorkflowMemoEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_UpsertWorkflowMemoEventAttributes); ok {
+		return x.UpsertWorkflowMemoEventAttributes
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*HistoryEvent) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -764,6 +824,11 @@ func (*HistoryEvent) XXX_OneofWrappers() []interface{} {
 		(*HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes)(nil),
 		(*HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes)(nil),
 		(*HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes)(nil),
+		(*HistoryEvent_WorkflowExecutionPausedEventAttributes)(nil),
+		(*HistoryEvent_WorkflowExecutionUnpausedEventAttributes)(nil),
+		(*HistoryEvent_WorkflowExecutionUpdateAcceptedEventAttributes)(nil),
+		(*HistoryEvent_WorkflowExecutionUpdateCompletedEventAttributes)(nil),
+		(*HistoryEvent_UpsertWorkflowMemoEventAttributes)(nil),
 	}
 }
 
@@ -792,9 +857,11 @@ type WorkflowExecutionStartedEventAttributes struct {
 	SearchAttributes         *SearchAttributes `protobuf:"bytes,20,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
 	PrevAutoResetPoints      *ResetPoints      `protobuf:"bytes,21,opt,name=prev_auto_reset_points,json=prevAutoResetPoints,proto3" json:"prev_auto_reset_points,omitempty"`
 	Header                   *Header           `protobuf:"bytes,22,opt,name=header,proto3" json:"header,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{}          `json:"-"`
-	XXX_unrecognized         []byte            `json:"-"`
-	XXX_sizecache            int32             `json:"-"`
+	// This is the runID of the closed run this execution was restarted from.
+	RestartedFromRunId   string   `protobuf:"bytes,23,opt,name=restarted_from_run_id,json=restartedFromRunId,proto3" json:"restarted_from_run_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *WorkflowExecutionStartedEventAttributes) Reset() {
@@ -986,6 +1053,13 @@ func (m *WorkflowExecutionStartedEventAttributes) GetHeader() *Header {
 	return nil
 }
 
+func (m *WorkflowExecutionStartedEventAttributes) GetRestartedFromRunId() string {
+	if m != nil {
+		return m.RestartedFromRunId
+	}
+	return ""
+}
+
 type WorkflowExecutionCompletedEventAttributes struct {
 	Result                       *Payload `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 	DecisionTaskCompletedEventId int64    `protobuf:"varint,2,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
@@ -2753,6 +2827,294 @@ func (m *WorkflowExecutionCanceledEventAttributes) GetDetails() *Payload {
 	return nil
 }
 
+type WorkflowExecutionPausedEventAttributes struct {
+	Reason               string   `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity             string   `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string   `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowExecutionPausedEventAttributes) Reset() {
+	*m = WorkflowExecutionPausedEventAttributes{}
+}
+func (m *WorkflowExecutionPausedEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*WorkflowExecutionPausedEventAttributes) ProtoMessage()    {}
+func (*WorkflowExecutionPausedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{26}
+}
+func (m *WorkflowExecutionPausedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExecutionPausedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExecutionPausedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExecutionPausedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecutionPausedEventAttributes.Merge(m, src)
+}
+func (m *WorkflowExecutionPausedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExecutionPausedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecutionPausedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecutionPausedEventAttributes proto.InternalMessageInfo
+
+func (m *WorkflowExecutionPausedEventAttributes) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionPausedEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionPausedEventAttributes) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type WorkflowExecutionUnpausedEventAttributes struct {
+	Reason               string   `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity             string   `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string   `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowExecutionUnpausedEventAttributes) Reset() {
+	*m = WorkflowExecutionUnpausedEventAttributes{}
+}
+func (m *WorkflowExecutionUnpausedEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*WorkflowExecutionUnpausedEventAttributes) ProtoMessage()    {}
+func (*WorkflowExecutionUnpausedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{27}
+}
+func (m *WorkflowExecutionUnpausedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExecutionUnpausedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExecutionUnpausedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExecutionUnpausedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecutionUnpausedEventAttributes.Merge(m, src)
+}
+func (m *WorkflowExecutionUnpausedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExecutionUnpausedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecutionUnpausedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecutionUnpausedEventAttributes proto.InternalMessageInfo
+
+func (m *WorkflowExecutionUnpausedEventAttributes) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionUnpausedEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionUnpausedEventAttributes) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type WorkflowExecutionUpdateAcceptedEventAttributes struct {
+	UpdateId             string   `protobuf:"bytes,1,opt,name=update_id,json=updateId,proto3" json:"update_id,omitempty"`
+	UpdateName           string   `protobuf:"bytes,2,opt,name=update_name,json=updateName,proto3" json:"update_name,omitempty"`
+	Input                *Payload `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	Identity             string   `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string   `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowExecutionUpdateAcceptedEventAttributes) Reset() {
+	*m = WorkflowExecutionUpdateAcceptedEventAttributes{}
+}
+func (m *WorkflowExecutionUpdateAcceptedEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*WorkflowExecutionUpdateAcceptedEventAttributes) ProtoMessage() {}
+func (*WorkflowExecutionUpdateAcceptedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{28}
+}
+func (m *WorkflowExecutionUpdateAcceptedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExecutionUpdateAcceptedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExecutionUpdateAcceptedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExecutionUpdateAcceptedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecutionUpdateAcceptedEventAttributes.Merge(m, src)
+}
+func (m *WorkflowExecutionUpdateAcceptedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExecutionUpdateAcceptedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecutionUpdateAcceptedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecutionUpdateAcceptedEventAttributes proto.InternalMessageInfo
+
+func (m *WorkflowExecutionUpdateAcceptedEventAttributes) GetUpdateId() string {
+	if m != nil {
+		return m.UpdateId
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionUpdateAcceptedEventAttributes) GetUpdateName() string {
+	if m != nil {
+		return m.UpdateName
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionUpdateAcceptedEventAttributes) GetInput() *Payload {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionUpdateAcceptedEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionUpdateAcceptedEventAttributes) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type WorkflowExecutionUpdateCompletedEventAttributes struct {
+	DecisionTaskCompletedEventId int64    `protobuf:"varint,1,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	UpdateId                     string   `protobuf:"bytes,2,opt,name=update_id,json=updateId,proto3" json:"update_id,omitempty"`
+	Result                       *Payload `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	ErrorMessage                 string   `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *WorkflowExecutionUpdateCompletedEventAttributes) Reset() {
+	*m = WorkflowExecutionUpdateCompletedEventAttributes{}
+}
+func (m *WorkflowExecutionUpdateCompletedEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*WorkflowExecutionUpdateCompletedEventAttributes) ProtoMessage() {}
+func (*WorkflowExecutionUpdateCompletedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{29}
+}
+func (m *WorkflowExecutionUpdateCompletedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExecutionUpdateCompletedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExecutionUpdateCompletedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExecutionUpdateCompletedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecutionUpdateCompletedEventAttributes.Merge(m, src)
+}
+func (m *WorkflowExecutionUpdateCompletedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExecutionUpdateCompletedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecutionUpdateCompletedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecutionUpdateCompletedEventAttributes proto.InternalMessageInfo
+
+func (m *WorkflowExecutionUpdateCompletedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionUpdateCompletedEventAttributes) GetUpdateId() string {
+	if m != nil {
+		return m.UpdateId
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionUpdateCompletedEventAttributes) GetResult() *Payload {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionUpdateCompletedEventAttributes) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
 type MarkerRecordedEventAttributes struct {
 	MarkerName                   string   `protobuf:"bytes,1,opt,name=marker_name,json=markerName,proto3" json:"marker_name,omitempty"`
 	Details                      *Payload `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
@@ -2767,7 +3129,7 @@ func (m *MarkerRecordedEventAttributes) Reset()         { *m = MarkerRecordedEve
 func (m *MarkerRecordedEventAttributes) String() string { return proto.CompactTextString(m) }
 func (*MarkerRecordedEventAttributes) ProtoMessage()    {}
 func (*MarkerRecordedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{26}
+	return fileDescriptor_8237ca6511ad6c62, []int{30}
 }
 func (m *MarkerRecordedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2839,7 +3201,7 @@ func (m *WorkflowExecutionSignaledEventAttributes) Reset() {
 func (m *WorkflowExecutionSignaledEventAttributes) String() string { return proto.CompactTextString(m) }
 func (*WorkflowExecutionSignaledEventAttributes) ProtoMessage()    {}
 func (*WorkflowExecutionSignaledEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{27}
+	return fileDescriptor_8237ca6511ad6c62, []int{31}
 }
 func (m *WorkflowExecutionSignaledEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2906,7 +3268,7 @@ func (m *WorkflowExecutionTerminatedEventAttributes) String() string {
 }
 func (*WorkflowExecutionTerminatedEventAttributes) ProtoMessage() {}
 func (*WorkflowExecutionTerminatedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{28}
+	return fileDescriptor_8237ca6511ad6c62, []int{32}
 }
 func (m *WorkflowExecutionTerminatedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2975,7 +3337,7 @@ func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) String(
 }
 func (*RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) ProtoMessage() {}
 func (*RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{29}
+	return fileDescriptor_8237ca6511ad6c62, []int{33}
 }
 func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3059,7 +3421,7 @@ func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) String() s
 }
 func (*RequestCancelExternalWorkflowExecutionFailedEventAttributes) ProtoMessage() {}
 func (*RequestCancelExternalWorkflowExecutionFailedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{30}
+	return fileDescriptor_8237ca6511ad6c62, []int{34}
 }
 func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3147,7 +3509,7 @@ func (m *ExternalWorkflowExecutionCancelRequestedEventAttributes) String() strin
 }
 func (*ExternalWorkflowExecutionCancelRequestedEventAttributes) ProtoMessage() {}
 func (*ExternalWorkflowExecutionCancelRequestedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{31}
+	return fileDescriptor_8237ca6511ad6c62, []int{35}
 }
 func (m *ExternalWorkflowExecutionCancelRequestedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3218,7 +3580,7 @@ func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) String() strin
 }
 func (*SignalExternalWorkflowExecutionInitiatedEventAttributes) ProtoMessage() {}
 func (*SignalExternalWorkflowExecutionInitiatedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{32}
+	return fileDescriptor_8237ca6511ad6c62, []int{36}
 }
 func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3316,7 +3678,7 @@ func (m *SignalExternalWorkflowExecutionFailedEventAttributes) String() string {
 }
 func (*SignalExternalWorkflowExecutionFailedEventAttributes) ProtoMessage() {}
 func (*SignalExternalWorkflowExecutionFailedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{33}
+	return fileDescriptor_8237ca6511ad6c62, []int{37}
 }
 func (m *SignalExternalWorkflowExecutionFailedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3405,7 +3767,7 @@ func (m *ExternalWorkflowExecutionSignaledEventAttributes) String() string {
 }
 func (*ExternalWorkflowExecutionSignaledEventAttributes) ProtoMessage() {}
 func (*ExternalWorkflowExecutionSignaledEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{34}
+	return fileDescriptor_8237ca6511ad6c62, []int{38}
 }
 func (m *ExternalWorkflowExecutionSignaledEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3478,7 +3840,7 @@ func (m *UpsertWorkflowSearchAttributesEventAttributes) String() string {
 }
 func (*UpsertWorkflowSearchAttributesEventAttributes) ProtoMessage() {}
 func (*UpsertWorkflowSearchAttributesEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{35}
+	return fileDescriptor_8237ca6511ad6c62, []int{39}
 }
 func (m *UpsertWorkflowSearchAttributesEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3521,7 +3883,62 @@ func (m *UpsertWorkflowSearchAttributesEventAttributes) GetSearchAttributes() *S
 	return nil
 }
 
-type StartChildWorkflowExecutionInitiatedEventAttributes struct {
+type UpsertWorkflowMemoEventAttributes struct {
+	DecisionTaskCompletedEventId int64    `protobuf:"varint,1,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	Memo                         *Memo    `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *UpsertWorkflowMemoEventAttributes) Reset()         { *m = UpsertWorkflowMemoEventAttributes{} }
+func (m *UpsertWorkflowMemoEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*UpsertWorkflowMemoEventAttributes) ProtoMessage()    {}
+func (*UpsertWorkflowMemoEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{40}
+}
+func (m *UpsertWorkflowMemoEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpsertWorkflowMemoEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpsertWorkflowMemoEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpsertWorkflowMemoEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertWorkflowMemoEventAttributes.Merge(m, src)
+}
+func (m *UpsertWorkflowMemoEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpsertWorkflowMemoEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertWorkflowMemoEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertWorkflowMemoEventAttributes proto.InternalMessageInfo
+
+func (m *UpsertWorkflowMemoEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+func (m *UpsertWorkflowMemoEventAttributes) GetMemo() *Memo {
+	if m != nil {
+		return m.Memo
+	}
+	return nil
+}
+
+type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 	Domain                       string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	WorkflowId                   string                `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
 	WorkflowType                 *WorkflowType         `protobuf:"bytes,3,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
@@ -3552,7 +3969,7 @@ func (m *StartChildWorkflowExecutionInitiatedEventAttributes) String() string {
 }
 func (*StartChildWorkflowExecutionInitiatedEventAttributes) ProtoMessage() {}
 func (*StartChildWorkflowExecutionInitiatedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{36}
+	return fileDescriptor_8237ca6511ad6c62, []int{41}
 }
 func (m *StartChildWorkflowExecutionInitiatedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3721,7 +4138,7 @@ func (m *StartChildWorkflowExecutionFailedEventAttributes) String() string {
 }
 func (*StartChildWorkflowExecutionFailedEventAttributes) ProtoMessage() {}
 func (*StartChildWorkflowExecutionFailedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{37}
+	return fileDescriptor_8237ca6511ad6c62, []int{42}
 }
 func (m *StartChildWorkflowExecutionFailedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3818,7 +4235,7 @@ func (m *ChildWorkflowExecutionStartedEventAttributes) String() string {
 }
 func (*ChildWorkflowExecutionStartedEventAttributes) ProtoMessage() {}
 func (*ChildWorkflowExecutionStartedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{38}
+	return fileDescriptor_8237ca6511ad6c62, []int{43}
 }
 func (m *ChildWorkflowExecutionStartedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3902,7 +4319,7 @@ func (m *ChildWorkflowExecutionCompletedEventAttributes) String() string {
 }
 func (*ChildWorkflowExecutionCompletedEventAttributes) ProtoMessage() {}
 func (*ChildWorkflowExecutionCompletedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{39}
+	return fileDescriptor_8237ca6511ad6c62, []int{44}
 }
 func (m *ChildWorkflowExecutionCompletedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3993,7 +4410,7 @@ func (m *ChildWorkflowExecutionFailedEventAttributes) String() string {
 }
 func (*ChildWorkflowExecutionFailedEventAttributes) ProtoMessage() {}
 func (*ChildWorkflowExecutionFailedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{40}
+	return fileDescriptor_8237ca6511ad6c62, []int{45}
 }
 func (m *ChildWorkflowExecutionFailedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4084,7 +4501,7 @@ func (m *ChildWorkflowExecutionCanceledEventAttributes) String() string {
 }
 func (*ChildWorkflowExecutionCanceledEventAttributes) ProtoMessage() {}
 func (*ChildWorkflowExecutionCanceledEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{41}
+	return fileDescriptor_8237ca6511ad6c62, []int{46}
 }
 func (m *ChildWorkflowExecutionCanceledEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4175,7 +4592,7 @@ func (m *ChildWorkflowExecutionTimedOutEventAttributes) String() string {
 }
 func (*ChildWorkflowExecutionTimedOutEventAttributes) ProtoMessage() {}
 func (*ChildWorkflowExecutionTimedOutEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{42}
+	return fileDescriptor_8237ca6511ad6c62, []int{47}
 }
 func (m *ChildWorkflowExecutionTimedOutEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4265,7 +4682,7 @@ func (m *ChildWorkflowExecutionTerminatedEventAttributes) String() string {
 }
 func (*ChildWorkflowExecutionTerminatedEventAttributes) ProtoMessage() {}
 func (*ChildWorkflowExecutionTerminatedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{43}
+	return fileDescriptor_8237ca6511ad6c62, []int{48}
 }
 func (m *ChildWorkflowExecutionTerminatedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4357,6 +4774,10 @@ func init() {
 	proto.RegisterType((*WorkflowExecutionContinuedAsNewEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionContinuedAsNewEventAttributes")
 	proto.RegisterType((*WorkflowExecutionCancelRequestedEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionCancelRequestedEventAttributes")
 	proto.RegisterType((*WorkflowExecutionCanceledEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionCanceledEventAttributes")
+	proto.RegisterType((*WorkflowExecutionPausedEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionPausedEventAttributes")
+	proto.RegisterType((*WorkflowExecutionUnpausedEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionUnpausedEventAttributes")
+	proto.RegisterType((*WorkflowExecutionUpdateAcceptedEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionUpdateAcceptedEventAttributes")
+	proto.RegisterType((*WorkflowExecutionUpdateCompletedEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionUpdateCompletedEventAttributes")
 	proto.RegisterType((*MarkerRecordedEventAttributes)(nil), "uber.cadence.api.v1.MarkerRecordedEventAttributes")
 	proto.RegisterType((*WorkflowExecutionSignaledEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionSignaledEventAttributes")
 	proto.RegisterType((*WorkflowExecutionTerminatedEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionTerminatedEventAttributes")
@@ -4367,6 +4788,7 @@ func init() {
 	proto.RegisterType((*SignalExternalWorkflowExecutionFailedEventAttributes)(nil), "uber.cadence.api.v1.SignalExternalWorkflowExecutionFailedEventAttributes")
 	proto.RegisterType((*ExternalWorkflowExecutionSignaledEventAttributes)(nil), "uber.cadence.api.v1.ExternalWorkflowExecutionSignaledEventAttributes")
 	proto.RegisterType((*UpsertWorkflowSearchAttributesEventAttributes)(nil), "uber.cadence.api.v1.UpsertWorkflowSearchAttributesEventAttributes")
+	proto.RegisterType((*UpsertWorkflowMemoEventAttributes)(nil), "uber.cadence.api.v1.UpsertWorkflowMemoEventAttributes")
 	proto.RegisterType((*StartChildWorkflowExecutionInitiatedEventAttributes)(nil), "uber.cadence.api.v1.StartChildWorkflowExecutionInitiatedEventAttributes")
 	proto.RegisterType((*StartChildWorkflowExecutionFailedEventAttributes)(nil), "uber.cadence.api.v1.StartChildWorkflowExecutionFailedEventAttributes")
 	proto.RegisterType((*ChildWorkflowExecutionStartedEventAttributes)(nil), "uber.cadence.api.v1.ChildWorkflowExecutionStartedEventAttributes")
@@ -4380,233 +4802,250 @@ func init() {
 func init() { proto.RegisterFile("uber/cadence/api/v1/history.proto", fileDescriptor_8237ca6511ad6c62) }
 
 var fileDescriptor_8237ca6511ad6c62 = []byte{
-	// 3609 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1c, 0x57,
-	0xd5, 0xef, 0xdd, 0xb5, 0x77, 0xbd, 0x67, 0x1d, 0xc7, 0xbe, 0x49, 0x1c, 0x3b, 0x7f, 0x9d, 0x49,
-	0x9a, 0xb8, 0x8e, 0xb3, 0x4e, 0x9c, 0x34, 0xf9, 0xd2, 0x7c, 0x6d, 0x3f, 0xc7, 0xb1, 0xd5, 0x95,
-	0xfc, 0x25, 0xd6, 0xc4, 0x49, 0x01, 0x21, 0x2d, 0xe3, 0x9d, 0xeb, 0x78, 0xe4, 0xdd, 0x9d, 0xed,
-	0xcc, 0xac, 0x37, 0x46, 0xe2, 0x89, 0x07, 0x24, 0xd4, 0x0a, 0xaa, 0x0a, 0x89, 0x0a, 0x10, 0x08,
-	0x09, 0xd4, 0x22, 0xa4, 0x22, 0x10, 0x02, 0xc4, 0x0b, 0x20, 0x21, 0x2a, 0x81, 0x4a, 0x9f, 0x78,
-	0xe1, 0x81, 0xaa, 0xe2, 0x81, 0xbe, 0xf1, 0x40, 0x79, 0x43, 0x42, 0x73, 0xe7, 0xce, 0xee, 0xce,
-	0xcc, 0xbd, 0x33, 0x77, 0xd6, 0x4e, 0x0b, 0x6a, 0xde, 0x3c, 0x77, 0xce, 0x3d, 0xf3, 0xbb, 0xe7,
-	0x9e, 0x73, 0xee, 0xb9, 0xe7, 0x9c, 0x35, 0x9c, 0x6a, 0xad, 0x13, 0x6b, 0xae, 0xaa, 0xe9, 0xa4,
-	0x51, 0x25, 0x73, 0x5a, 0xd3, 0x98, 0xdb, 0xbe, 0x34, 0xb7, 0x69, 0xd8, 0x8e, 0x69, 0xed, 0x94,
-	0x9a, 0x96, 0xe9, 0x98, 0xf8, 0x80, 0x4b, 0x52, 0x62, 0x24, 0x25, 0xad, 0x69, 0x94, 0xb6, 0x2f,
-	0x1d, 0x39, 0xf1, 0xc0, 0x34, 0x1f, 0xd4, 0xc8, 0x1c, 0x25, 0x59, 0x6f, 0x6d, 0xcc, 0xe9, 0x2d,
-	0x4b, 0x73, 0x0c, 0xb3, 0xe1, 0x4d, 0x3a, 0x72, 0x32, 0xfc, 0xde, 0x31, 0xea, 0xc4, 0x76, 0xb4,
-	0x7a, 0x93, 0x11, 0x4c, 0xf1, 0x3e, 0x5c, 0x35, 0xeb, 0xf5, 0x0e, 0x0b, 0x85, 0x47, 0xe1, 0x68,
-	0xf6, 0x56, 0xcd, 0xb0, 0x9d, 0x38, 0x9a, 0xb6, 0x69, 0x6d, 0x6d, 0xd4, 0xcc, 0xb6, 0x47, 0xa3,
-	0xdc, 0x82, 0xfc, 0x0b, 0xde, 0x82, 0xf0, 0x75, 0xc8, 0x91, 0x6d, 0xd2, 0x70, 0xec, 0x09, 0x34,
-	0x95, 0x9d, 0x2e, 0xce, 0x9f, 0x2a, 0x71, 0xd6, 0x56, 0x62, 0xd4, 0x4b, 0x2e, 0xa5, 0xca, 0x26,
-	0x28, 0x1f, 0x5c, 0x83, 0xe1, 0xde, 0x17, 0x78, 0x12, 0x86, 0xe8, 0xab, 0x8a, 0xa1, 0x4f, 0xa0,
-	0x29, 0x34, 0x9d, 0x55, 0xf3, 0xf4, 0xb9, 0xac, 0xe3, 0xeb, 0x00, 0xde, 0x2b, 0x77, 0xd1, 0x13,
-	0x99, 0x29, 0x34, 0x5d, 0x9c, 0x3f, 0x52, 0xf2, 0x24, 0x52, 0xf2, 0x25, 0x52, 0x5a, 0xf3, 0x25,
-	0xa2, 0x16, 0x28, 0xb5, 0xfb, 0x8c, 0x27, 0x20, 0xbf, 0x4d, 0x2c, 0xdb, 0x30, 0x1b, 0x13, 0x59,
-	0x8f, 0x29, 0x7b, 0xc4, 0x87, 0x21, 0xef, 0x2e, 0xde, 0xfd, 0xdc, 0x00, 0x7d, 0x93, 0x73, 0x1f,
-	0xcb, 0x3a, 0xfe, 0x26, 0x82, 0xf3, 0xfe, 0x92, 0x2b, 0xe4, 0x21, 0xa9, 0xb6, 0xdc, 0x7d, 0xa8,
-	0xd8, 0x8e, 0x66, 0x39, 0x44, 0xaf, 0x78, 0x48, 0x34, 0xc7, 0xb1, 0x8c, 0xf5, 0x96, 0x43, 0xec,
-	0x89, 0x41, 0x8a, 0xe7, 0x7f, 0xb9, 0x4b, 0x7f, 0x91, 0xf1, 0x59, 0xf2, 0xd9, 0xdc, 0xf5, 0xb8,
-	0xd0, 0x25, 0x2f, 0x74, 0x78, 0xbc, 0xf0, 0x84, 0x7a, 0xae, 0x2d, 0x47, 0x8a, 0xbf, 0x8b, 0xe0,
-	0x02, 0x07, 0x5e, 0xd5, 0xac, 0x37, 0x6b, 0x84, 0x0b, 0x30, 0x47, 0x01, 0x3e, 0x27, 0x07, 0x70,
-	0xd1, 0xe7, 0x13, 0x85, 0xf8, 0x54, 0x5b, 0x96, 0x18, 0xbf, 0x8e, 0x60, 0x86, 0x03, 0x72, 0x43,
-	0x33, 0x6a, 0x3c, 0x84, 0x79, 0x8a, 0xf0, 0x86, 0x1c, 0xc2, 0x65, 0xca, 0x24, 0x0a, 0xef, 0x6c,
-	0x5b, 0x8a, 0x12, 0x7f, 0x87, 0x2f, 0x40, 0x57, 0xb7, 0xf4, 0x8a, 0xd9, 0x72, 0xa2, 0xf0, 0x86,
-	0x28, 0xbc, 0x67, 0xe5, 0xe0, 0xb9, 0x6a, 0xa7, 0xdf, 0x69, 0x39, 0x51, 0x80, 0xd3, 0x6d, 0x49,
-	0x5a, 0xfc, 0x1a, 0x82, 0x69, 0x9d, 0x54, 0x0d, 0x9b, 0x02, 0x73, 0xb5, 0xd4, 0xae, 0x6e, 0x12,
-	0xbd, 0xc5, 0x15, 0x5e, 0x81, 0xa2, 0xbb, 0xce, 0x45, 0x77, 0x8b, 0x31, 0x59, 0xd3, 0xec, 0xad,
-	0xbb, 0x3e, 0x8b, 0x28, 0xb2, 0x33, 0xba, 0x04, 0x1d, 0x7e, 0x05, 0xc1, 0xd9, 0x10, 0x2a, 0x91,
-	0x4d, 0x00, 0xc5, 0x74, 0x2d, 0x19, 0x93, 0xc8, 0x1c, 0x14, 0x3d, 0x91, 0x8a, 0x23, 0xa5, 0x18,
-	0x23, 0x28, 0x4a, 0x4a, 0x29, 0x46, 0xff, 0x03, 0x52, 0x12, 0xaa, 0xfe, 0xab, 0x11, 0x54, 0x31,
-	0x9a, 0x35, 0x4c, 0x51, 0xfd, 0x4f, 0x22, 0x2a, 0xb1, 0x52, 0x9d, 0xd6, 0x93, 0xc9, 0xf0, 0x97,
-	0x11, 0x3c, 0x19, 0xc4, 0x24, 0xb2, 0xc4, 0x7d, 0x14, 0xd0, 0xd5, 0x44, 0x40, 0x22, 0x23, 0x3c,
-	0xa5, 0x27, 0x11, 0xd1, 0x6d, 0xd3, 0xaa, 0x8e, 0xb1, 0x6d, 0x38, 0x3b, 0x89, 0xca, 0x3d, 0x12,
-	0xb3, 0x6d, 0x0b, 0x8c, 0x49, 0x92, 0x72, 0x6b, 0x12, 0x74, 0x54, 0xb9, 0x43, 0xa8, 0x44, 0xca,
-	0xbd, 0x3f, 0x46, 0xb9, 0x03, 0x98, 0x84, 0xca, 0xad, 0x25, 0x52, 0x71, 0xa4, 0x14, 0xa3, 0xdc,
-	0xa3, 0x92, 0x52, 0x8a, 0x53, 0x6e, 0x4d, 0x82, 0x8e, 0x2a, 0x52, 0x10, 0x95, 0x48, 0x91, 0xc6,
-	0x62, 0x14, 0xa9, 0x17, 0x92, 0x50, 0x91, 0xb4, 0x24, 0x22, 0x6a, 0x69, 0x41, 0x30, 0x31, 0x96,
-	0x86, 0x63, 0x2c, 0xad, 0x17, 0x4f, 0x8c, 0xa5, 0x69, 0xc9, 0x64, 0xb8, 0x0d, 0x27, 0x5c, 0x10,
-	0x96, 0x58, 0x7b, 0x0e, 0x50, 0x20, 0x17, 0xb9, 0x40, 0x5c, 0xae, 0x96, 0x50, 0x6d, 0x8e, 0x3a,
-	0xe2, 0xd7, 0xf8, 0x25, 0x38, 0xe6, 0x7d, 0x78, 0xc3, 0xb0, 0x78, 0x9f, 0x3d, 0x48, 0x3f, 0x5b,
-	0x12, 0x7f, 0x76, 0xd9, 0x9d, 0x17, 0xfd, 0xe8, 0xa4, 0x23, 0x7a, 0x89, 0xbf, 0x8f, 0x60, 0x2e,
-	0xa4, 0xa2, 0x5a, 0xa3, 0x4a, 0x6a, 0x15, 0x8b, 0xbc, 0xd4, 0x22, 0x36, 0x77, 0xf5, 0x87, 0x28,
-	0x8c, 0xe7, 0x93, 0x35, 0x95, 0x72, 0x52, 0x7d, 0x46, 0x51, 0x5c, 0x33, 0x9a, 0x34, 0x35, 0xfe,
-	0x09, 0x82, 0x2b, 0x0c, 0x93, 0x0f, 0x51, 0x4e, 0x89, 0xc7, 0x29, 0xda, 0x45, 0x2e, 0x5a, 0xf6,
-	0x35, 0xef, 0xd3, 0x32, 0x1a, 0x5d, 0xb2, 0x52, 0xcd, 0xc0, 0x5f, 0x45, 0x70, 0x8e, 0x27, 0x5e,
-	0x1e, 0xd0, 0xc3, 0x92, 0xda, 0xbd, 0xc8, 0x38, 0x24, 0x68, 0xb7, 0x80, 0x0c, 0x7f, 0x1e, 0x4e,
-	0x7a, 0x4a, 0x26, 0x46, 0x32, 0x41, 0x91, 0x5c, 0x12, 0xeb, 0x99, 0x18, 0x82, 0xa7, 0xc0, 0xa2,
-	0x6f, 0x7f, 0x09, 0xc1, 0x19, 0xb6, 0x79, 0x4c, 0xd1, 0x05, 0x9b, 0x36, 0x49, 0x11, 0x3c, 0xcd,
-	0x45, 0xe0, 0x31, 0xf7, 0xf4, 0x5d, 0xb0, 0x4d, 0x53, 0xd5, 0x04, 0x1a, 0xfc, 0x05, 0x98, 0xaa,
-	0x6b, 0xd6, 0x16, 0xb1, 0x2a, 0x16, 0xa9, 0x9a, 0x96, 0xce, 0x03, 0x71, 0x84, 0x82, 0x98, 0xe7,
-	0x82, 0xf8, 0x7f, 0x3a, 0x59, 0x65, 0x73, 0xa3, 0x08, 0x8e, 0xd7, 0xe3, 0x08, 0xf0, 0xb7, 0x11,
-	0xcc, 0xf2, 0xee, 0x27, 0xc6, 0x83, 0x86, 0xc6, 0x15, 0xc8, 0xd1, 0x34, 0xe1, 0xeb, 0x5d, 0xc6,
-	0x46, 0x26, 0x7c, 0x15, 0xd0, 0xe2, 0xef, 0x21, 0x28, 0xf1, 0x22, 0x6c, 0x62, 0xd5, 0x8d, 0x86,
-	0xc6, 0xf5, 0x0b, 0xc7, 0x62, 0xfc, 0x42, 0x34, 0xc4, 0xee, 0x30, 0xe2, 0xf8, 0x85, 0xb6, 0x34,
-	0x35, 0xfe, 0x29, 0x82, 0x2b, 0xbc, 0xab, 0x54, 0xa2, 0x17, 0x3b, 0x4e, 0xd1, 0xde, 0x92, 0xbc,
-	0x51, 0x25, 0xb9, 0xb2, 0xb9, 0x76, 0xba, 0x29, 0x22, 0x0d, 0x10, 0x1b, 0xe5, 0x89, 0x34, 0x1a,
-	0x20, 0x36, 0xd0, 0xe9, 0xb6, 0x24, 0x2d, 0xfe, 0x2b, 0x82, 0xa5, 0x90, 0xc7, 0x25, 0x0f, 0x1d,
-	0x62, 0x35, 0xb4, 0x5a, 0x85, 0x83, 0xdc, 0x68, 0x18, 0x8e, 0xc1, 0x57, 0x8c, 0x93, 0x14, 0xfa,
-	0xdd, 0x64, 0x17, 0xbc, 0xc4, 0xf8, 0x47, 0xd6, 0x53, 0xf6, 0x99, 0x47, 0x17, 0xf4, 0x9c, 0xb5,
-	0x2b, 0x0e, 0xf8, 0xcf, 0x08, 0x6e, 0xa6, 0x58, 0xa6, 0xc8, 0x63, 0x4d, 0xd1, 0x35, 0xae, 0xee,
-	0x62, 0x8d, 0x22, 0x67, 0x76, 0xc3, 0xea, 0x7f, 0x3a, 0x7e, 0x07, 0xc1, 0xb3, 0x71, 0xcb, 0x49,
-	0xb6, 0x93, 0x53, 0x74, 0x61, 0x2b, 0xdc, 0x85, 0x09, 0xc1, 0x24, 0xda, 0xcb, 0x35, 0xd2, 0xdf,
-	0x54, 0x1a, 0x07, 0x70, 0x53, 0x27, 0x0d, 0xc7, 0x68, 0xb4, 0x88, 0x5e, 0xd1, 0xec, 0x4a, 0x83,
-	0xb4, 0xa3, 0xeb, 0x50, 0x62, 0xe2, 0x00, 0x4e, 0x06, 0x85, 0xb1, 0x5b, 0xb0, 0x6f, 0x93, 0x36,
-	0x27, 0x0e, 0x68, 0xa7, 0x9a, 0x81, 0x7f, 0x83, 0xe0, 0x3a, 0x8d, 0x26, 0x2b, 0xd5, 0x4d, 0xa3,
-	0xa6, 0xa7, 0xb4, 0x9f, 0xd3, 0x14, 0xfa, 0x0b, 0x5c, 0xe8, 0x34, 0x94, 0x5c, 0x74, 0x99, 0xa6,
-	0x31, 0x9a, 0xcb, 0x76, 0xfa, 0x69, 0xf8, 0x17, 0x08, 0xae, 0x26, 0x2c, 0x42, 0x64, 0x1d, 0x67,
-	0xe8, 0x0a, 0x96, 0xd2, 0xae, 0x40, 0x64, 0x12, 0x17, 0xed, 0x94, 0x73, 0xf0, 0x0f, 0x11, 0x5c,
-	0x12, 0xa2, 0x16, 0xc6, 0xf9, 0x4f, 0x52, 0xd8, 0x0b, 0xfc, 0x30, 0x84, 0xfb, 0x75, 0x61, 0xe0,
-	0x3f, 0x5b, 0x4d, 0x41, 0x8f, 0x7f, 0x8c, 0xe0, 0xb2, 0x10, 0x6e, 0xcc, 0x25, 0xf2, 0x6c, 0x8c,
-	0x92, 0xf3, 0x01, 0xc7, 0x5c, 0x27, 0x4b, 0xd5, 0x54, 0x33, 0xf0, 0x9b, 0x08, 0x2e, 0xa6, 0xd6,
-	0x8c, 0x73, 0x14, 0xf1, 0xff, 0xa5, 0x40, 0x2c, 0x52, 0x8a, 0xf3, 0xd5, 0x14, 0xfa, 0xf0, 0x16,
-	0x82, 0x79, 0xb1, 0x80, 0x85, 0x87, 0xf0, 0x34, 0x45, 0x7b, 0x33, 0x8d, 0x7c, 0x85, 0x27, 0xf1,
-	0x85, 0x6a, 0x9a, 0x09, 0xf8, 0x47, 0x71, 0x2a, 0x11, 0x73, 0x69, 0x7e, 0x2a, 0x35, 0x64, 0xf1,
-	0xf5, 0x59, 0x00, 0x59, 0x74, 0x91, 0x76, 0x63, 0x33, 0x31, 0xe4, 0x98, 0x48, 0x72, 0x26, 0x26,
-	0x36, 0x13, 0x60, 0x8e, 0x09, 0x27, 0xe7, 0xaa, 0xe9, 0xa6, 0xd0, 0x43, 0xd3, 0x0b, 0xc5, 0xfb,
-	0x8d, 0x78, 0xce, 0xc7, 0x1c, 0x9a, 0x5e, 0xc4, 0xdd, 0x4f, 0xa8, 0x73, 0xcd, 0xee, 0x6f, 0x2a,
-	0xfe, 0x2d, 0x82, 0x67, 0x24, 0x16, 0x24, 0xb2, 0xd1, 0x59, 0xba, 0x9a, 0x72, 0x3f, 0xab, 0x11,
-	0x19, 0xeb, 0x15, 0xbb, 0x8f, 0x79, 0xf8, 0xe7, 0x08, 0x9e, 0x8e, 0x5b, 0x80, 0xf8, 0xfe, 0x74,
-	0x21, 0xe6, 0x00, 0x12, 0x82, 0x10, 0xdf, 0xa3, 0x2e, 0x92, 0x94, 0x73, 0xa8, 0xc3, 0x69, 0x35,
-	0x6d, 0x62, 0x39, 0x5d, 0xe0, 0x36, 0xd1, 0xac, 0xea, 0x66, 0x0f, 0xcc, 0x28, 0xee, 0x52, 0x8c,
-	0xf5, 0xde, 0xa3, 0xec, 0x7c, 0x04, 0x77, 0x29, 0xb3, 0xee, 0x17, 0x39, 0xd6, 0xdb, 0x4a, 0x33,
-	0xe1, 0xe6, 0x30, 0x40, 0x17, 0x88, 0xf2, 0x5e, 0x11, 0xce, 0xc9, 0x9e, 0x5e, 0xcb, 0xb0, 0xaf,
-	0xb3, 0x46, 0x67, 0xa7, 0x49, 0x68, 0x2d, 0x50, 0x54, 0x59, 0xf4, 0x99, 0xae, 0xed, 0x34, 0x89,
-	0x3a, 0xdc, 0xee, 0x79, 0xc2, 0x9f, 0x85, 0x43, 0x4d, 0xcd, 0x72, 0x25, 0xd2, 0x6b, 0x74, 0x1b,
-	0x26, 0x2b, 0x1f, 0x4e, 0x73, 0xf9, 0xad, 0xd2, 0x19, 0x3d, 0x36, 0xb1, 0x61, 0xaa, 0x07, 0x9a,
-	0xd1, 0x41, 0xfc, 0x0c, 0x14, 0x68, 0x46, 0xa6, 0x66, 0xd8, 0x0e, 0x2d, 0x2c, 0x16, 0xe7, 0x8f,
-	0xf3, 0x53, 0x1e, 0x9a, 0xbd, 0xb5, 0x62, 0xd8, 0x8e, 0x3a, 0xe4, 0xb0, 0xbf, 0xf0, 0x3c, 0x0c,
-	0x1a, 0x8d, 0x66, 0xcb, 0xa1, 0x65, 0xc7, 0xe2, 0xfc, 0x31, 0x01, 0x92, 0x9d, 0x9a, 0xa9, 0xe9,
-	0xaa, 0x47, 0x8a, 0x35, 0x98, 0x0a, 0x85, 0x1c, 0x15, 0xc7, 0xac, 0x54, 0x6b, 0xa6, 0x4d, 0xa8,
-	0xff, 0x36, 0x5b, 0x0e, 0xab, 0x43, 0x4e, 0x46, 0xea, 0xa2, 0xb7, 0x58, 0x25, 0x59, 0x3d, 0x46,
-	0x02, 0xb2, 0x5f, 0x33, 0x17, 0xdd, 0xf9, 0x6b, 0xde, 0x74, 0xfc, 0x22, 0x1c, 0xed, 0xa6, 0xbd,
-	0xa3, 0xdc, 0x73, 0x49, 0xdc, 0x0f, 0x3b, 0x7e, 0x32, 0x3b, 0xc4, 0xf8, 0x06, 0x1c, 0xe9, 0x46,
-	0xd8, 0xdd, 0x55, 0x58, 0xad, 0x46, 0xc5, 0xd0, 0x69, 0xe9, 0xaf, 0xa0, 0x1e, 0xee, 0x50, 0x74,
-	0xe4, 0xac, 0xb6, 0x1a, 0x65, 0x1d, 0x97, 0xa1, 0xc0, 0x5c, 0xa5, 0x69, 0xd1, 0x3a, 0xdc, 0xc8,
-	0xfc, 0x79, 0xbe, 0x6b, 0x67, 0x0c, 0x68, 0x08, 0x5d, 0xf6, 0xa7, 0xa8, 0xdd, 0xd9, 0xb8, 0x0c,
-	0x63, 0x5d, 0x1c, 0xae, 0xbb, 0x6a, 0x59, 0x84, 0x15, 0xcf, 0xf8, 0x7b, 0xb0, 0xec, 0xd1, 0xa8,
-	0xa3, 0x9d, 0x69, 0x6c, 0x04, 0xab, 0x30, 0x5e, 0xd3, 0xdc, 0x3b, 0x9f, 0x17, 0xce, 0xd0, 0xe5,
-	0x10, 0xbb, 0x55, 0x73, 0x58, 0xe1, 0x2b, 0x7e, 0x4f, 0x0f, 0xba, 0x73, 0x17, 0x3b, 0x53, 0x55,
-	0x3a, 0x13, 0x5f, 0x87, 0x49, 0xd3, 0x32, 0x1e, 0x18, 0x9e, 0xa3, 0x0d, 0x49, 0xa9, 0x48, 0xa5,
-	0x34, 0xee, 0x13, 0x84, 0x84, 0x74, 0x04, 0x86, 0x0c, 0x9d, 0x34, 0x1c, 0xc3, 0xd9, 0xa1, 0x15,
-	0xa5, 0x82, 0xda, 0x79, 0xc6, 0x97, 0x61, 0x7c, 0xc3, 0xb0, 0x6c, 0x27, 0xca, 0x73, 0x1f, 0xa5,
-	0x3c, 0x40, 0xdf, 0x86, 0x18, 0x2e, 0xc2, 0xb0, 0x45, 0x1c, 0x6b, 0xa7, 0xd2, 0x34, 0x6b, 0x46,
-	0x75, 0x87, 0x55, 0x61, 0xa6, 0x04, 0x17, 0x54, 0xc7, 0xda, 0x59, 0xa5, 0x74, 0x6a, 0xd1, 0xea,
-	0x3e, 0xe0, 0x09, 0xc8, 0x6b, 0x8e, 0x43, 0xea, 0x4d, 0x87, 0x56, 0x4c, 0x06, 0x55, 0xff, 0x11,
-	0x2f, 0xc2, 0x7e, 0xf2, 0xb0, 0x69, 0x78, 0x8a, 0xe3, 0x15, 0xf5, 0x47, 0x13, 0x8b, 0xfa, 0x23,
-	0xdd, 0x29, 0xb4, 0xb2, 0x7f, 0x1a, 0xf6, 0x55, 0x2d, 0xd7, 0x1a, 0x58, 0x45, 0x87, 0x56, 0x1c,
-	0x0a, 0xea, 0xb0, 0x3b, 0xe8, 0x57, 0x79, 0xf0, 0xa7, 0xe0, 0xa8, 0xb7, 0xfa, 0x60, 0xf5, 0x6b,
-	0x5d, 0xab, 0x6e, 0x99, 0x1b, 0x1b, 0xac, 0x28, 0x10, 0xa3, 0xd4, 0x13, 0x74, 0x76, 0x6f, 0xe1,
-	0xeb, 0xa6, 0x37, 0x15, 0x5f, 0x80, 0x81, 0x3a, 0xa9, 0x9b, 0x2c, 0x9d, 0x3f, 0xc9, 0x4f, 0xf4,
-	0x91, 0xba, 0xa9, 0x52, 0x32, 0xac, 0xc2, 0x58, 0xc4, 0x63, 0xb3, 0x9c, 0xfc, 0x93, 0xfc, 0xb3,
-	0x31, 0xe4, 0x61, 0xd5, 0x51, 0x3b, 0x34, 0x82, 0xef, 0xc1, 0x78, 0xd3, 0x22, 0xdb, 0x15, 0xad,
-	0xe5, 0x98, 0xae, 0xfe, 0x11, 0xa7, 0xd2, 0x34, 0x8d, 0x86, 0xe3, 0x67, 0xd9, 0x45, 0xfb, 0x65,
-	0x13, 0x67, 0x95, 0xd2, 0xa9, 0x07, 0xdc, 0xf9, 0x0b, 0x2d, 0xc7, 0xec, 0x19, 0xc4, 0x97, 0x21,
-	0xb7, 0x49, 0x34, 0x9d, 0x58, 0x2c, 0xfd, 0x7d, 0x94, 0xdf, 0xd4, 0x41, 0x49, 0x54, 0x46, 0xaa,
-	0xbc, 0x89, 0xe0, 0x29, 0xf9, 0x68, 0xff, 0x0a, 0xe4, 0x98, 0xbd, 0x20, 0x09, 0x7b, 0x61, 0xb4,
-	0x78, 0x19, 0xa6, 0xe2, 0xcb, 0xbd, 0x86, 0x4e, 0xbd, 0x7b, 0x56, 0x3d, 0x26, 0xae, 0xd4, 0x96,
-	0x75, 0xe5, 0x0d, 0x04, 0x67, 0x25, 0x83, 0x86, 0xab, 0x90, 0xf7, 0x3d, 0x05, 0x92, 0xf0, 0x14,
-	0x3e, 0xf1, 0x9e, 0x41, 0x35, 0x61, 0x5a, 0x3a, 0x62, 0x5e, 0x84, 0x61, 0xe6, 0xac, 0xbb, 0x07,
-	0xe7, 0x88, 0x40, 0x09, 0x98, 0x6f, 0xa6, 0xe7, 0x66, 0xd1, 0xe9, 0x3e, 0x28, 0x7f, 0x40, 0x70,
-	0x46, 0xa6, 0x69, 0x20, 0x78, 0x02, 0xa2, 0x74, 0x27, 0xe0, 0x6d, 0x18, 0x17, 0x9c, 0x32, 0x99,
-	0x24, 0x83, 0x3c, 0x60, 0x73, 0x4e, 0x98, 0x1e, 0x4f, 0x93, 0x0d, 0x78, 0x1a, 0xe5, 0x15, 0x04,
-	0x4a, 0x72, 0xbf, 0x01, 0x9e, 0x05, 0x1c, 0xae, 0x41, 0x77, 0xba, 0x90, 0x46, 0xed, 0x80, 0x08,
-	0x42, 0xee, 0x36, 0x13, 0x72, 0xb7, 0xc7, 0x01, 0xfc, 0x84, 0xa0, 0xa1, 0x53, 0x34, 0x05, 0xb5,
-	0xc0, 0x46, 0xca, 0xba, 0xf2, 0xf7, 0x90, 0x78, 0x85, 0x16, 0x92, 0x0e, 0xd1, 0x34, 0x8c, 0x06,
-	0xf3, 0x10, 0x1d, 0xf5, 0x1a, 0xb1, 0x7b, 0x56, 0x1c, 0xc2, 0x9e, 0x0d, 0x61, 0x3f, 0x07, 0xfb,
-	0xd7, 0x8d, 0x86, 0x66, 0xed, 0x54, 0xaa, 0x9b, 0xa4, 0xba, 0x65, 0xb7, 0xea, 0x34, 0x44, 0x29,
-	0xa8, 0x23, 0xde, 0xf0, 0x22, 0x1b, 0xc5, 0xe7, 0x61, 0x2c, 0x98, 0x3d, 0x23, 0x0f, 0xbd, 0xf0,
-	0x63, 0x58, 0x1d, 0x25, 0xbd, 0x49, 0x2d, 0xf2, 0xd0, 0x51, 0x5e, 0xce, 0xc2, 0x69, 0x89, 0x56,
-	0x86, 0x47, 0xb6, 0xe2, 0xb0, 0x59, 0x64, 0xfb, 0x30, 0x0b, 0x7c, 0x02, 0x8a, 0xeb, 0x9a, 0x4d,
-	0xfc, 0xa3, 0xd3, 0x13, 0x4b, 0xc1, 0x1d, 0xf2, 0x0e, 0xcc, 0x63, 0x00, 0x0d, 0xd2, 0xf6, 0x5f,
-	0x0f, 0x7a, 0x82, 0x6d, 0x90, 0xb6, 0xf7, 0x76, 0x16, 0xf0, 0x86, 0x69, 0x6d, 0x31, 0xa4, 0x7e,
-	0x3f, 0x5a, 0xce, 0x5b, 0x9a, 0xfb, 0x86, 0x62, 0xbd, 0xcf, 0x1a, 0xd3, 0xc6, 0x5d, 0xe7, 0xa8,
-	0xd9, 0x66, 0x83, 0xc5, 0x46, 0xec, 0x09, 0xdf, 0x82, 0xc1, 0xaa, 0xd6, 0xb2, 0x09, 0x0b, 0x83,
-	0x4a, 0xd2, 0x4d, 0x23, 0x8b, 0xee, 0x2c, 0xd5, 0x9b, 0xac, 0xbc, 0x91, 0x85, 0x53, 0x89, 0x8d,
-	0x1c, 0x8f, 0x6c, 0x33, 0x6e, 0xfa, 0x6b, 0xf0, 0x76, 0x61, 0x56, 0xb2, 0xcf, 0xa4, 0x77, 0x05,
-	0xbd, 0x3e, 0x79, 0x20, 0x8d, 0x4f, 0xee, 0x55, 0xfd, 0xc1, 0x90, 0xea, 0x87, 0xf6, 0x37, 0x17,
-	0xbf, 0xbf, 0x79, 0xa9, 0xfd, 0x1d, 0x12, 0xec, 0x2f, 0xc7, 0xcc, 0x0a, 0x3c, 0x33, 0x53, 0xbe,
-	0x95, 0x83, 0x33, 0x32, 0x3d, 0x2e, 0xf8, 0x24, 0x14, 0x3b, 0x85, 0x62, 0xb6, 0x4d, 0x05, 0x15,
-	0xfc, 0xa1, 0xb2, 0xee, 0x5e, 0xaa, 0xba, 0x95, 0x64, 0xd7, 0x08, 0x32, 0x31, 0x97, 0xaa, 0xce,
-	0x27, 0xe9, 0xa5, 0x4a, 0xeb, 0x79, 0x72, 0x55, 0x53, 0x37, 0xeb, 0x9a, 0xd1, 0x60, 0xbe, 0x83,
-	0x3d, 0x05, 0x0f, 0x83, 0x81, 0x3e, 0xaf, 0x43, 0x39, 0xf9, 0xeb, 0xd0, 0x1a, 0x4c, 0xfa, 0x4a,
-	0x18, 0x3d, 0x43, 0xf2, 0x49, 0x67, 0xc8, 0xb8, 0x3f, 0x37, 0x74, 0x8c, 0x84, 0xb8, 0xb2, 0x23,
-	0x8a, 0x71, 0x1d, 0x4a, 0xc1, 0xd5, 0xbb, 0x05, 0x31, 0xae, 0xe2, 0xc3, 0xae, 0xd0, 0xd7, 0x61,
-	0xb7, 0x0c, 0x63, 0x9b, 0x44, 0xb3, 0x9c, 0x75, 0xa2, 0x75, 0xd1, 0x41, 0x12, 0xab, 0xd1, 0xce,
-	0x9c, 0x2e, 0x9f, 0xe4, 0x10, 0xa5, 0x98, 0x1c, 0xa2, 0x44, 0xee, 0x0a, 0xc3, 0xfd, 0xdc, 0x15,
-	0xba, 0x31, 0xe7, 0x3e, 0xf9, 0x98, 0xf3, 0x6f, 0x08, 0x94, 0xe4, 0x7e, 0xab, 0x8f, 0xec, 0x70,
-	0xef, 0x0d, 0x43, 0x06, 0x82, 0x17, 0x9e, 0xe7, 0x61, 0x98, 0xde, 0x17, 0x7d, 0xbf, 0x35, 0x28,
-	0xe1, 0xb7, 0x8a, 0xee, 0x0c, 0xf6, 0xa0, 0xbc, 0x8b, 0x82, 0xae, 0x60, 0x8f, 0x23, 0x6b, 0xbe,
-	0x88, 0x32, 0x29, 0xdc, 0x7d, 0x36, 0x31, 0xda, 0x18, 0x08, 0x0a, 0x53, 0xf9, 0x23, 0x82, 0x53,
-	0xc9, 0x4d, 0x30, 0xfd, 0x06, 0xe0, 0x1f, 0xc7, 0x8a, 0x7e, 0x99, 0x81, 0xd3, 0x12, 0xad, 0x64,
-	0xee, 0x9a, 0x74, 0xe2, 0x68, 0x46, 0xcd, 0x96, 0xda, 0x24, 0x9f, 0xf8, 0x91, 0xad, 0x29, 0x1c,
-	0x21, 0x0d, 0xf4, 0x13, 0x21, 0xed, 0x5a, 0xc5, 0xbf, 0x86, 0x60, 0x46, 0xbe, 0x03, 0x4c, 0xe6,
-	0xcc, 0xdb, 0x9b, 0x2b, 0xd8, 0x5b, 0x08, 0x52, 0xf6, 0x7a, 0x25, 0x63, 0x3b, 0xe8, 0x87, 0x41,
-	0x9e, 0x87, 0x61, 0x81, 0x8d, 0x0c, 0xe2, 0xac, 0x04, 0xe2, 0xd7, 0x43, 0x7a, 0x28, 0xaa, 0x0a,
-	0xf5, 0xab, 0x87, 0xcb, 0x30, 0x55, 0xd3, 0x9c, 0x9e, 0x9e, 0x87, 0x70, 0x07, 0x40, 0x57, 0xb2,
-	0x1e, 0x1d, 0x6f, 0x2b, 0xbd, 0xb0, 0x89, 0xa3, 0xcf, 0xd9, 0x14, 0xfa, 0x3c, 0x90, 0x68, 0xa3,
-	0xa1, 0x40, 0x4f, 0x79, 0x07, 0xc1, 0xd1, 0x98, 0x2e, 0x4b, 0x3c, 0x09, 0x43, 0x5e, 0x77, 0x59,
-	0x67, 0xdf, 0xf2, 0xf4, 0xb9, 0xac, 0xe3, 0x15, 0x38, 0xd4, 0x39, 0xc8, 0x37, 0x0c, 0x2b, 0xc5,
-	0xa5, 0x15, 0xb3, 0x73, 0x7c, 0xd9, 0xb0, 0x48, 0x9a, 0xe3, 0x57, 0x66, 0xb3, 0x3f, 0x07, 0x93,
-	0xc2, 0xf6, 0xcd, 0xb8, 0xd5, 0x48, 0xc7, 0xec, 0xca, 0xef, 0x10, 0x1c, 0x8b, 0xeb, 0xdc, 0xdb,
-	0x93, 0xaf, 0xec, 0x95, 0x3c, 0x62, 0x1d, 0xf4, 0xcf, 0x10, 0x4c, 0x25, 0x75, 0x00, 0xc6, 0xad,
-	0xe6, 0x91, 0x9a, 0x6d, 0x2c, 0xf2, 0x7f, 0xe5, 0x21, 0x65, 0xa3, 0x09, 0x9e, 0x83, 0x83, 0xb4,
-	0x97, 0x25, 0x9c, 0xf6, 0xf5, 0xd6, 0x34, 0xd6, 0x20, 0xed, 0x50, 0xd2, 0x37, 0x52, 0x79, 0xc9,
-	0xf4, 0x57, 0x79, 0x79, 0x5c, 0x1b, 0x91, 0xaf, 0x8d, 0xc8, 0xe8, 0x4e, 0x5e, 0x42, 0x77, 0xee,
-	0xc0, 0x38, 0xcb, 0x69, 0x33, 0x8c, 0x46, 0xc3, 0x21, 0xd6, 0xb6, 0x56, 0x4b, 0xbe, 0xb7, 0x1c,
-	0x64, 0x13, 0x29, 0xbc, 0x32, 0x9b, 0x16, 0xac, 0xbb, 0x14, 0x76, 0x55, 0x77, 0xe9, 0x09, 0xe1,
-	0x20, 0x4d, 0x08, 0x27, 0x2e, 0xb2, 0x14, 0xfb, 0x2e, 0xb2, 0x74, 0xef, 0x19, 0xc3, 0xd2, 0xf7,
-	0x8c, 0x4e, 0xaa, 0x7f, 0xdf, 0x2e, 0x52, 0xfd, 0x23, 0xbb, 0x4a, 0xf5, 0xbb, 0x3e, 0x78, 0x2e,
-	0x6d, 0xb7, 0x5b, 0xc7, 0x5b, 0xa1, 0x5e, 0x6f, 0x15, 0x77, 0xbf, 0x59, 0x87, 0xc3, 0x9d, 0x0a,
-	0x79, 0xa8, 0x6a, 0xea, 0xd9, 0xf1, 0x4c, 0x6c, 0x0d, 0x3c, 0x58, 0x37, 0x3d, 0x44, 0x78, 0xc3,
-	0xca, 0x0f, 0x10, 0x27, 0xa5, 0x2d, 0x3a, 0x59, 0x64, 0xcc, 0x03, 0x49, 0x98, 0x47, 0x4f, 0xa4,
-	0x93, 0x49, 0x11, 0xe9, 0x28, 0x1f, 0x22, 0x38, 0x1e, 0xdb, 0xad, 0xed, 0x86, 0x7a, 0xac, 0x17,
-	0xbc, 0xa1, 0xd5, 0x7d, 0x51, 0x83, 0x37, 0x74, 0x5b, 0xab, 0x93, 0x7e, 0x3f, 0xbd, 0x67, 0xa7,
-	0x4a, 0x57, 0xe3, 0x07, 0xe4, 0x6f, 0xd6, 0xdf, 0xe0, 0x6d, 0x92, 0xa8, 0x3b, 0xe1, 0x24, 0x14,
-	0x59, 0x7f, 0x48, 0xaf, 0x08, 0xbc, 0x21, 0x2a, 0x82, 0x8e, 0x53, 0xcf, 0xc8, 0x3b, 0xf5, 0x98,
-	0x3c, 0xb5, 0xf2, 0x75, 0x04, 0x33, 0x29, 0x3a, 0x72, 0xba, 0xf9, 0x54, 0x14, 0xc8, 0xa7, 0xf6,
-	0xbb, 0x33, 0x71, 0xd0, 0x7e, 0x9d, 0x81, 0xe7, 0x76, 0xd7, 0x95, 0xbc, 0x67, 0x3a, 0xdf, 0xcd,
-	0xd5, 0x65, 0x02, 0xb9, 0xba, 0x7b, 0x80, 0xa3, 0xdd, 0x2f, 0xcc, 0xbe, 0xcf, 0xca, 0x75, 0xb8,
-	0xaa, 0x63, 0x91, 0x16, 0x56, 0x3c, 0x01, 0xf9, 0xaa, 0xd9, 0x70, 0x2c, 0xb3, 0x46, 0x15, 0x6d,
-	0x58, 0xf5, 0x1f, 0x71, 0x09, 0x0e, 0x84, 0x1a, 0xb9, 0xcc, 0x46, 0xcd, 0x8b, 0xcc, 0x87, 0xd4,
-	0xb1, 0x40, 0x7f, 0xd5, 0x9d, 0x46, 0x6d, 0x47, 0x79, 0x2d, 0x0b, 0x37, 0x76, 0xd1, 0xf5, 0x8c,
-	0xef, 0xf5, 0xfa, 0xbd, 0x11, 0xc1, 0x6f, 0x0a, 0xa4, 0x38, 0x07, 0xd2, 0xce, 0x7b, 0x74, 0x9f,
-	0x14, 0xe6, 0x50, 0xf9, 0xfb, 0x32, 0xb0, 0xdb, 0x7d, 0x99, 0x05, 0x1c, 0xee, 0x35, 0x63, 0x15,
-	0x8a, 0xac, 0x3a, 0x6a, 0x04, 0x94, 0xd0, 0x4b, 0x61, 0xf9, 0xbb, 0x98, 0x0b, 0xec, 0xa2, 0xf2,
-	0x27, 0x04, 0xd7, 0xfa, 0x6c, 0xd9, 0x16, 0x60, 0x40, 0x02, 0x0c, 0x1f, 0xad, 0xe2, 0x2a, 0x5f,
-	0xc9, 0xc2, 0xb5, 0x3e, 0xdb, 0xea, 0xfe, 0x5b, 0x6d, 0x35, 0xe4, 0xb1, 0x07, 0xc4, 0x1e, 0x7b,
-	0x50, 0xde, 0x63, 0x0b, 0x55, 0x47, 0xe4, 0x00, 0xf2, 0x22, 0x07, 0xf0, 0x72, 0x16, 0xae, 0xf4,
-	0xd3, 0x1a, 0x28, 0x67, 0xf9, 0x52, 0x9c, 0x1f, 0x5b, 0x7e, 0xd7, 0xf2, 0x3f, 0x40, 0x70, 0x31,
-	0x6d, 0x9b, 0xe3, 0x7f, 0xb4, 0xc9, 0x8b, 0xcf, 0x2a, 0xe5, 0xf7, 0x08, 0x2e, 0xa4, 0x6a, 0x8d,
-	0xdc, 0x33, 0x17, 0xc0, 0xbd, 0x35, 0x64, 0x76, 0x77, 0x6b, 0xf8, 0xcb, 0x10, 0x5c, 0xee, 0xe3,
-	0x37, 0x1e, 0x3d, 0xdb, 0x81, 0x02, 0xdb, 0x71, 0x12, 0x8a, 0x9d, 0xed, 0x60, 0x3a, 0x5f, 0x50,
-	0xc1, 0x1f, 0xe2, 0xa5, 0x10, 0xb2, 0x7b, 0x90, 0x42, 0xe8, 0xb7, 0x9e, 0x38, 0xb8, 0xb7, 0x29,
-	0x84, 0xdc, 0x23, 0x4d, 0x21, 0xe4, 0xfb, 0x4e, 0x21, 0xdc, 0x07, 0xd6, 0xa1, 0xca, 0x38, 0xb2,
-	0x32, 0x9c, 0xd7, 0x24, 0x70, 0x36, 0xa6, 0xcd, 0x95, 0x72, 0x61, 0xc5, 0xb8, 0xb1, 0x66, 0x78,
-	0xa8, 0xd7, 0x48, 0x0a, 0x41, 0x7f, 0x2e, 0xa3, 0xf2, 0x20, 0xa1, 0xf2, 0x55, 0x98, 0xe8, 0x51,
-	0xa7, 0x8a, 0x45, 0x5a, 0x5d, 0xf8, 0x45, 0x0a, 0x7f, 0x26, 0x56, 0x71, 0xca, 0xba, 0xea, 0x4e,
-	0x61, 0x4b, 0x38, 0xd4, 0xe6, 0x0d, 0x47, 0xca, 0x93, 0xfb, 0xfa, 0x29, 0x4f, 0x46, 0x7a, 0x0d,
-	0x47, 0x38, 0xbd, 0x86, 0xdd, 0x9b, 0xd6, 0xfe, 0xf4, 0xb9, 0x85, 0xd1, 0x5d, 0xe4, 0x16, 0xc6,
-	0x76, 0xd7, 0x46, 0xf8, 0x0c, 0x14, 0x75, 0x52, 0xd3, 0x76, 0x3c, 0xd5, 0x4c, 0xee, 0x89, 0x04,
-	0x4a, 0x4d, 0x55, 0x51, 0x79, 0x35, 0x0b, 0x17, 0xd3, 0xfe, 0x06, 0xeb, 0xe3, 0x77, 0x2f, 0x2b,
-	0x7e, 0x9c, 0xe0, 0x55, 0xba, 0xae, 0xa6, 0xfe, 0x01, 0x51, 0x20, 0x3c, 0xe8, 0x31, 0x94, 0xc1,
-	0xa0, 0xa1, 0xf0, 0x0f, 0xc1, 0x9c, 0xe0, 0x10, 0xdc, 0xa3, 0x5c, 0xa0, 0xf2, 0x76, 0x06, 0x66,
-	0xd3, 0xfc, 0xc0, 0x4c, 0xb8, 0x1f, 0xfc, 0xd3, 0x37, 0xb3, 0xdb, 0xd3, 0x77, 0xaf, 0x76, 0x91,
-	0x2f, 0xdd, 0x01, 0x81, 0x74, 0xbb, 0xd6, 0x39, 0x28, 0x9f, 0x07, 0xf9, 0x30, 0x03, 0x29, 0x7f,
-	0xfa, 0xf6, 0xc9, 0x10, 0x26, 0xaf, 0xac, 0x33, 0xc8, 0x2d, 0xeb, 0x74, 0xfb, 0x11, 0x72, 0xf2,
-	0xfd, 0x08, 0xca, 0x3f, 0x32, 0x70, 0x7e, 0x2f, 0x3c, 0xca, 0x27, 0x54, 0xe8, 0x3d, 0x19, 0xf7,
-	0x5c, 0x8a, 0x8c, 0xbb, 0xf2, 0xcf, 0x0c, 0x5c, 0x48, 0xf5, 0x4b, 0xc4, 0xc7, 0x82, 0x8f, 0x08,
-	0xde, 0x4f, 0x29, 0xe6, 0xd2, 0xe4, 0x99, 0xbf, 0x98, 0x15, 0x09, 0x5e, 0xd4, 0x43, 0xf2, 0x58,
-	0xf0, 0xb1, 0x2d, 0x2c, 0xb9, 0x7e, 0x7a, 0xdf, 0x7f, 0x95, 0x81, 0xb9, 0x94, 0xbf, 0x10, 0x7d,
-	0xbc, 0x0f, 0x81, 0x7d, 0x98, 0x71, 0x60, 0x3f, 0xfd, 0x73, 0xd9, 0xa8, 0x39, 0xc4, 0xa2, 0x9f,
-	0x3a, 0x0e, 0x93, 0x4b, 0xf7, 0x97, 0x6e, 0xaf, 0x55, 0x96, 0xcb, 0x2b, 0x6b, 0x4b, 0x6a, 0x65,
-	0xed, 0xd3, 0xab, 0x4b, 0x95, 0xf2, 0xed, 0xfb, 0x0b, 0x2b, 0xe5, 0x5b, 0xa3, 0x4f, 0xe0, 0x93,
-	0x70, 0x34, 0xfa, 0x7a, 0x61, 0x65, 0xa5, 0x42, 0x47, 0x47, 0x11, 0x3e, 0x05, 0xc7, 0xa3, 0x04,
-	0x8b, 0x2b, 0x77, 0xee, 0x2e, 0x31, 0x92, 0xcc, 0xcd, 0xf5, 0xb7, 0xdf, 0x3f, 0x81, 0xde, 0x7d,
-	0xff, 0x04, 0x7a, 0xef, 0xfd, 0x13, 0x08, 0x0e, 0x57, 0xcd, 0x3a, 0x4f, 0x1e, 0x37, 0x87, 0x16,
-	0x9a, 0xc6, 0xaa, 0x1b, 0xce, 0xae, 0xa2, 0xcf, 0xcc, 0x3d, 0x30, 0x9c, 0xcd, 0xd6, 0x7a, 0xa9,
-	0x6a, 0xd6, 0xe7, 0x02, 0xff, 0xf3, 0xb4, 0xf4, 0x80, 0x34, 0xbc, 0xff, 0xb2, 0xca, 0xfe, 0xfd,
-	0xe9, 0x0d, 0xad, 0x69, 0x6c, 0x5f, 0x5a, 0xcf, 0xd1, 0xb1, 0xcb, 0xff, 0x0e, 0x00, 0x00, 0xff,
-	0xff, 0xfe, 0x23, 0x07, 0xbe, 0xe1, 0x55, 0x00, 0x00,
+	// 3882 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x6f, 0x6c, 0x1c, 0xc7,
+	0x75, 0xf7, 0xde, 0x91, 0x47, 0xde, 0x3b, 0x92, 0x22, 0x47, 0x12, 0x45, 0x8a, 0x94, 0x44, 0xae,
+	0x64, 0x89, 0xa6, 0xa8, 0x3b, 0x89, 0x92, 0xa5, 0xca, 0xaa, 0xe5, 0x52, 0x14, 0x09, 0x1d, 0x40,
+	0x4b, 0xc4, 0x8a, 0x92, 0xdb, 0xa2, 0xc0, 0x75, 0xb9, 0x3b, 0x14, 0x17, 0xbc, 0xbb, 0x3d, 0xef,
+	0xee, 0xf1, 0xc4, 0x16, 0xfe, 0xd4, 0x0f, 0x05, 0x0a, 0x1b, 0xad, 0x61, 0x14, 0xa8, 0xfb, 0x07,
+	0xfd, 0x03, 0xb4, 0xb0, 0x8b, 0x02, 0x2e, 0x5a, 0x14, 0x6d, 0xd1, 0x2f, 0x4d, 0x80, 0x20, 0x06,
+	0x12, 0x38, 0xfe, 0x94, 0x2f, 0x41, 0x90, 0xc0, 0xc8, 0x87, 0xe4, 0x5b, 0x02, 0xc4, 0xf9, 0x16,
+	0x20, 0xd8, 0xd9, 0xd9, 0xbb, 0xdb, 0xdd, 0x99, 0xdd, 0xd9, 0xe3, 0xc9, 0x4e, 0x60, 0x7d, 0xe3,
+	0xce, 0xbe, 0x79, 0xfb, 0x7b, 0x33, 0xef, 0xbd, 0x79, 0x6f, 0xde, 0x3b, 0xc2, 0x7c, 0x73, 0x1b,
+	0x5b, 0x25, 0x4d, 0xd5, 0x71, 0x5d, 0xc3, 0x25, 0xb5, 0x61, 0x94, 0xf6, 0xaf, 0x94, 0x76, 0x0d,
+	0xdb, 0x31, 0xad, 0x83, 0x62, 0xc3, 0x32, 0x1d, 0x13, 0x1d, 0x75, 0x49, 0x8a, 0x94, 0xa4, 0xa8,
+	0x36, 0x8c, 0xe2, 0xfe, 0x95, 0x93, 0xa7, 0x9f, 0x98, 0xe6, 0x93, 0x2a, 0x2e, 0x11, 0x92, 0xed,
+	0xe6, 0x4e, 0x49, 0x6f, 0x5a, 0xaa, 0x63, 0x98, 0x75, 0x6f, 0xd2, 0xc9, 0x33, 0xe1, 0xf7, 0x8e,
+	0x51, 0xc3, 0xb6, 0xa3, 0xd6, 0x1a, 0x94, 0x60, 0x8e, 0xf5, 0x61, 0xcd, 0xac, 0xd5, 0xda, 0x2c,
+	0x64, 0x16, 0x85, 0xa3, 0xda, 0x7b, 0x55, 0xc3, 0x76, 0xe2, 0x68, 0x5a, 0xa6, 0xb5, 0xb7, 0x53,
+	0x35, 0x5b, 0x1e, 0x8d, 0x7c, 0x17, 0x86, 0xee, 0x79, 0x02, 0xa1, 0x9b, 0x90, 0xc3, 0xfb, 0xb8,
+	0xee, 0xd8, 0x53, 0xd2, 0x5c, 0x76, 0xa1, 0xb0, 0x3c, 0x5f, 0x64, 0xc8, 0x56, 0xa4, 0xd4, 0x6b,
+	0x2e, 0xa5, 0x42, 0x27, 0xc8, 0xff, 0x78, 0x1b, 0x46, 0xba, 0x5f, 0xa0, 0x69, 0x18, 0x26, 0xaf,
+	0x2a, 0x86, 0x3e, 0x25, 0xcd, 0x49, 0x0b, 0x59, 0x65, 0x88, 0x3c, 0x97, 0x75, 0x74, 0x13, 0xc0,
+	0x7b, 0xe5, 0x0a, 0x3d, 0x95, 0x99, 0x93, 0x16, 0x0a, 0xcb, 0x27, 0x8b, 0xde, 0x8a, 0x14, 0xfd,
+	0x15, 0x29, 0x6e, 0xf9, 0x2b, 0xa2, 0xe4, 0x09, 0xb5, 0xfb, 0x8c, 0xa6, 0x60, 0x68, 0x1f, 0x5b,
+	0xb6, 0x61, 0xd6, 0xa7, 0xb2, 0x1e, 0x53, 0xfa, 0x88, 0x4e, 0xc0, 0x90, 0x2b, 0xbc, 0xfb, 0xb9,
+	0x01, 0xf2, 0x26, 0xe7, 0x3e, 0x96, 0x75, 0xf4, 0xb7, 0x12, 0x5c, 0xf4, 0x45, 0xae, 0xe0, 0xa7,
+	0x58, 0x6b, 0xba, 0xfb, 0x50, 0xb1, 0x1d, 0xd5, 0x72, 0xb0, 0x5e, 0xf1, 0x90, 0xa8, 0x8e, 0x63,
+	0x19, 0xdb, 0x4d, 0x07, 0xdb, 0x53, 0x83, 0x04, 0xcf, 0x6f, 0x33, 0x45, 0x7f, 0x83, 0xf2, 0x59,
+	0xf3, 0xd9, 0x3c, 0xf4, 0xb8, 0x10, 0x91, 0x57, 0xda, 0x3c, 0xee, 0xbd, 0xa0, 0x5c, 0x68, 0x89,
+	0x91, 0xa2, 0x7f, 0x92, 0xe0, 0x12, 0x03, 0x9e, 0x66, 0xd6, 0x1a, 0x55, 0xcc, 0x04, 0x98, 0x23,
+	0x00, 0x6f, 0x8b, 0x01, 0x5c, 0xf5, 0xf9, 0x44, 0x21, 0xbe, 0xd4, 0x12, 0x25, 0x46, 0xef, 0x4b,
+	0xb0, 0xc8, 0x00, 0xb9, 0xa3, 0x1a, 0x55, 0x16, 0xc2, 0x21, 0x82, 0xf0, 0x96, 0x18, 0xc2, 0x75,
+	0xc2, 0x24, 0x0a, 0xef, 0x7c, 0x4b, 0x88, 0x12, 0xfd, 0x03, 0x7b, 0x01, 0x5d, 0xdd, 0xd2, 0x2b,
+	0x66, 0xd3, 0x89, 0xc2, 0x1b, 0x26, 0xf0, 0x5e, 0x15, 0x83, 0xe7, 0xaa, 0x9d, 0xfe, 0xa0, 0xe9,
+	0x44, 0x01, 0x2e, 0xb4, 0x04, 0x69, 0xd1, 0x7b, 0x12, 0x2c, 0xe8, 0x58, 0x33, 0x6c, 0x02, 0xcc,
+	0xd5, 0x52, 0x5b, 0xdb, 0xc5, 0x7a, 0x93, 0xb9, 0x78, 0x79, 0x82, 0xee, 0x26, 0x13, 0xdd, 0x5d,
+	0xca, 0x64, 0x4b, 0xb5, 0xf7, 0x1e, 0xfa, 0x2c, 0xa2, 0xc8, 0xce, 0xe9, 0x02, 0x74, 0xe8, 0x1d,
+	0x09, 0xce, 0x87, 0x50, 0xf1, 0x6c, 0x02, 0x08, 0xa6, 0x1b, 0xc9, 0x98, 0x78, 0xe6, 0x20, 0xeb,
+	0x89, 0x54, 0x8c, 0x55, 0x8a, 0x31, 0x82, 0x82, 0xe0, 0x2a, 0xc5, 0xe8, 0xff, 0x39, 0x5d, 0x80,
+	0x0e, 0xbd, 0x1b, 0x41, 0x15, 0xa3, 0x59, 0x23, 0x04, 0xd5, 0x6f, 0x25, 0xa2, 0xe2, 0x2b, 0xd5,
+	0x59, 0x3d, 0x99, 0x0c, 0xfd, 0x99, 0x04, 0x2f, 0x06, 0x31, 0xf1, 0x2c, 0x71, 0x94, 0x00, 0xba,
+	0x9e, 0x08, 0x88, 0x67, 0x84, 0xf3, 0x7a, 0x12, 0x11, 0xd9, 0x36, 0x55, 0x73, 0x8c, 0x7d, 0xc3,
+	0x39, 0x48, 0x54, 0xee, 0xb1, 0x98, 0x6d, 0x5b, 0xa1, 0x4c, 0x92, 0x94, 0x5b, 0x15, 0xa0, 0x23,
+	0xca, 0x1d, 0x42, 0xc5, 0x53, 0xee, 0x23, 0x31, 0xca, 0x1d, 0xc0, 0xc4, 0x55, 0x6e, 0x35, 0x91,
+	0x8a, 0xb1, 0x4a, 0x31, 0xca, 0x3d, 0x2e, 0xb8, 0x4a, 0x71, 0xca, 0xad, 0x0a, 0xd0, 0x11, 0x45,
+	0x0a, 0xa2, 0xe2, 0x29, 0xd2, 0x44, 0x8c, 0x22, 0x75, 0x43, 0xe2, 0x2a, 0x92, 0x9a, 0x44, 0x44,
+	0x2c, 0x2d, 0x08, 0x26, 0xc6, 0xd2, 0x50, 0x8c, 0xa5, 0x75, 0xe3, 0x89, 0xb1, 0x34, 0x35, 0x99,
+	0x0c, 0xb5, 0xe0, 0xb4, 0x0b, 0xc2, 0xe2, 0x6b, 0xcf, 0x51, 0x02, 0xe4, 0x32, 0x13, 0x88, 0xcb,
+	0xd5, 0xe2, 0xaa, 0xcd, 0x8c, 0xc3, 0x7f, 0x8d, 0xde, 0x84, 0x59, 0xef, 0xc3, 0x3b, 0x86, 0xc5,
+	0xfa, 0xec, 0x31, 0xf2, 0xd9, 0x22, 0xff, 0xb3, 0xeb, 0x86, 0x15, 0xe1, 0x7a, 0xef, 0x05, 0x65,
+	0xda, 0xe1, 0xbd, 0x44, 0xff, 0x22, 0x41, 0x29, 0xa4, 0xa2, 0x6a, 0x5d, 0xc3, 0xd5, 0x8a, 0x85,
+	0xdf, 0x6c, 0x62, 0x9b, 0x29, 0xfd, 0x71, 0x02, 0xe3, 0xb5, 0x64, 0x4d, 0x25, 0x9c, 0x14, 0x9f,
+	0x51, 0x14, 0xd7, 0xa2, 0x2a, 0x4c, 0x8d, 0xfe, 0x53, 0x82, 0x6b, 0x14, 0x93, 0x0f, 0x51, 0x4c,
+	0x89, 0x27, 0x09, 0xda, 0x55, 0x26, 0x5a, 0xfa, 0x35, 0xef, 0xd3, 0x22, 0x1a, 0x5d, 0xb4, 0x52,
+	0xcd, 0x40, 0x7f, 0x21, 0xc1, 0x05, 0xd6, 0xf2, 0xb2, 0x80, 0x9e, 0x10, 0xd4, 0xee, 0x55, 0xca,
+	0x21, 0x41, 0xbb, 0x39, 0x64, 0xe8, 0x8f, 0xe0, 0x8c, 0xa7, 0x64, 0x7c, 0x24, 0x53, 0x04, 0xc9,
+	0x15, 0xbe, 0x9e, 0xf1, 0x21, 0xcc, 0x3a, 0x31, 0xef, 0xd1, 0x9f, 0x4a, 0x70, 0x8e, 0x6e, 0x1e,
+	0x55, 0x74, 0xce, 0xa6, 0x4d, 0x13, 0x04, 0x2f, 0x33, 0x11, 0x78, 0xcc, 0x3d, 0x7d, 0xe7, 0x6c,
+	0xd3, 0x9c, 0x96, 0x40, 0x83, 0xde, 0x82, 0xb9, 0x9a, 0x6a, 0xed, 0x61, 0xab, 0x62, 0x61, 0xcd,
+	0xb4, 0x74, 0x16, 0x88, 0x93, 0x04, 0xc4, 0x32, 0x13, 0xc4, 0xeb, 0x64, 0xb2, 0x42, 0xe7, 0x46,
+	0x11, 0x9c, 0xaa, 0xc5, 0x11, 0xa0, 0xbf, 0x97, 0x60, 0x89, 0x95, 0x9f, 0x18, 0x4f, 0xea, 0x2a,
+	0x73, 0x41, 0x66, 0xd2, 0x84, 0xaf, 0x0f, 0x29, 0x1b, 0x91, 0xf0, 0x95, 0x43, 0x8b, 0xfe, 0x59,
+	0x82, 0x22, 0x03, 0xa1, 0x83, 0xad, 0x9a, 0x51, 0x57, 0x99, 0x7e, 0x61, 0x36, 0xc6, 0x2f, 0x44,
+	0x43, 0xec, 0x36, 0x23, 0x86, 0x5f, 0x68, 0x09, 0x53, 0xa3, 0xff, 0x92, 0xe0, 0x1a, 0x2b, 0x95,
+	0x4a, 0xf4, 0x62, 0xa7, 0x08, 0xda, 0xbb, 0x82, 0x19, 0x55, 0x92, 0x2b, 0x2b, 0xb5, 0xd2, 0x4d,
+	0xe1, 0x69, 0x00, 0xdf, 0x28, 0x4f, 0xa7, 0xd1, 0x00, 0xbe, 0x81, 0x2e, 0xb4, 0x04, 0x69, 0xd1,
+	0x8f, 0x24, 0x58, 0x0b, 0x79, 0x5c, 0xfc, 0xd4, 0xc1, 0x56, 0x5d, 0xad, 0x56, 0x18, 0xc8, 0x8d,
+	0xba, 0xe1, 0x18, 0x6c, 0xc5, 0x38, 0x43, 0xa0, 0x3f, 0x4c, 0x76, 0xc1, 0x6b, 0x94, 0x7f, 0x44,
+	0x9e, 0xb2, 0xcf, 0x3c, 0x2a, 0xd0, 0x6d, 0xeb, 0x50, 0x1c, 0xd0, 0xf7, 0x24, 0xb8, 0x93, 0x42,
+	0x4c, 0x9e, 0xc7, 0x9a, 0x23, 0x32, 0x6e, 0x1e, 0x42, 0x46, 0x9e, 0x33, 0xbb, 0x65, 0xf5, 0x3e,
+	0x1d, 0x7d, 0x22, 0xc1, 0xab, 0x71, 0xe2, 0x24, 0xdb, 0xc9, 0x3c, 0x11, 0x6c, 0x83, 0x29, 0x18,
+	0x17, 0x4c, 0xa2, 0xbd, 0xdc, 0xc0, 0xbd, 0x4d, 0x25, 0x71, 0x00, 0x4b, 0x0e, 0xb3, 0xee, 0x18,
+	0xf5, 0x26, 0xd6, 0x2b, 0xaa, 0x5d, 0xa9, 0xe3, 0x56, 0x54, 0x0e, 0x39, 0x26, 0x0e, 0x88, 0x82,
+	0xf0, 0xd9, 0xad, 0xd8, 0xf7, 0x71, 0x2b, 0x0a, 0xbf, 0xd8, 0x4a, 0x35, 0x03, 0x7d, 0x5d, 0x82,
+	0x9b, 0x24, 0x9a, 0xac, 0x68, 0xbb, 0x46, 0x55, 0x4f, 0x69, 0x3f, 0x67, 0x09, 0xf4, 0x7b, 0x4c,
+	0xe8, 0x24, 0x94, 0x5c, 0x75, 0x99, 0xa6, 0x31, 0x9a, 0xab, 0x76, 0xfa, 0x69, 0xe8, 0x7f, 0x25,
+	0xb8, 0x9e, 0x20, 0x04, 0xcf, 0x3a, 0xce, 0x11, 0x09, 0xd6, 0xd2, 0x4a, 0xc0, 0x33, 0x89, 0xcb,
+	0x76, 0xca, 0x39, 0xe8, 0xdf, 0x24, 0xb8, 0xc2, 0x45, 0xcd, 0x8d, 0xf3, 0x5f, 0x24, 0xb0, 0x57,
+	0xd8, 0x61, 0x08, 0xf3, 0xeb, 0xdc, 0xc0, 0x7f, 0x49, 0x4b, 0x41, 0x8f, 0xfe, 0x43, 0x82, 0xab,
+	0x5c, 0xb8, 0x31, 0x49, 0xe4, 0xf9, 0x18, 0x25, 0x67, 0x03, 0x8e, 0x49, 0x27, 0x8b, 0x5a, 0xaa,
+	0x19, 0xe8, 0x43, 0x09, 0x2e, 0xa7, 0xd6, 0x8c, 0x0b, 0x04, 0xf1, 0xef, 0xa4, 0x40, 0xcc, 0x53,
+	0x8a, 0x8b, 0x5a, 0x0a, 0x7d, 0xf8, 0x48, 0x82, 0x65, 0xfe, 0x02, 0x73, 0x0f, 0xe1, 0x05, 0x82,
+	0xf6, 0x4e, 0x9a, 0xf5, 0xe5, 0x9e, 0xc4, 0x97, 0xb4, 0x34, 0x13, 0xd0, 0xbf, 0xc7, 0xa9, 0x44,
+	0x4c, 0xd2, 0xfc, 0x52, 0x6a, 0xc8, 0xfc, 0xf4, 0xf9, 0x92, 0x96, 0x66, 0x02, 0x89, 0xcd, 0xf8,
+	0x90, 0x63, 0x22, 0xc9, 0xc5, 0x98, 0xd8, 0x8c, 0x83, 0x39, 0x26, 0x9c, 0x2c, 0x69, 0xe9, 0xa6,
+	0x90, 0x43, 0xd3, 0x0b, 0xc5, 0x7b, 0x8d, 0x78, 0x2e, 0xc6, 0x1c, 0x9a, 0x5e, 0xc4, 0xdd, 0x4b,
+	0xa8, 0x73, 0xc3, 0xee, 0x6d, 0x2a, 0xfa, 0x86, 0x04, 0xaf, 0x08, 0x08, 0xc4, 0xb3, 0xd1, 0x25,
+	0x22, 0x4d, 0xb9, 0x17, 0x69, 0x78, 0xc6, 0x7a, 0xcd, 0xee, 0x61, 0x1e, 0xfa, 0x1f, 0x09, 0x5e,
+	0x8e, 0x13, 0x80, 0x9f, 0x3f, 0x5d, 0x8a, 0x39, 0x80, 0xb8, 0x20, 0xf8, 0x79, 0xd4, 0x65, 0x9c,
+	0x72, 0x0e, 0x71, 0x38, 0xcd, 0x86, 0x8d, 0x2d, 0xa7, 0x03, 0xdc, 0xc6, 0xaa, 0xa5, 0xed, 0x76,
+	0xc1, 0x8c, 0xe2, 0x2e, 0xc6, 0x58, 0xef, 0x23, 0xc2, 0xce, 0x47, 0xf0, 0x90, 0x30, 0xeb, 0x7c,
+	0x91, 0x61, 0xbd, 0xcd, 0x34, 0x13, 0x78, 0xf5, 0x9f, 0x86, 0xda, 0xb4, 0x59, 0x2b, 0x5c, 0x4a,
+	0x53, 0xff, 0xd9, 0x24, 0x4c, 0x44, 0xea, 0x3f, 0x4c, 0x4a, 0x5e, 0xf6, 0xd4, 0xac, 0xf3, 0xd0,
+	0x5d, 0x4e, 0x93, 0x3d, 0x3d, 0xaa, 0x37, 0x58, 0x5f, 0x65, 0x66, 0x4f, 0x1c, 0x5a, 0x72, 0x82,
+	0xb3, 0x10, 0x36, 0x74, 0xd5, 0xc1, 0x15, 0x55, 0xd3, 0x70, 0x83, 0xe9, 0x39, 0xae, 0xa4, 0x09,
+	0x53, 0x1f, 0x11, 0x6e, 0x2b, 0x94, 0x99, 0x48, 0x98, 0x1a, 0x3b, 0x83, 0x97, 0x4c, 0x53, 0xd0,
+	0x31, 0x71, 0xc7, 0x72, 0x9a, 0x64, 0xda, 0xc3, 0x10, 0x13, 0x78, 0x94, 0x5a, 0xe9, 0xa6, 0x90,
+	0x2b, 0xed, 0xb0, 0x71, 0xd5, 0x70, 0xcd, 0x8c, 0x02, 0xbd, 0x1a, 0x73, 0xa5, 0x1d, 0xb4, 0xa7,
+	0xd7, 0x71, 0xcd, 0x64, 0x5c, 0x69, 0x37, 0x93, 0x88, 0xee, 0x8c, 0x00, 0x74, 0x3e, 0x28, 0xbf,
+	0x37, 0x02, 0x17, 0x44, 0xa3, 0xbe, 0x75, 0x18, 0x6d, 0xc3, 0x77, 0x0e, 0x1a, 0x98, 0xd4, 0xd0,
+	0x79, 0x15, 0x79, 0x9f, 0xe9, 0xd6, 0x41, 0x03, 0x2b, 0x23, 0xad, 0xae, 0x27, 0xf4, 0x07, 0x70,
+	0xbc, 0xa1, 0x5a, 0xae, 0xe4, 0xdd, 0x87, 0xd5, 0x8e, 0x49, 0xcb, 0xee, 0x0b, 0x4c, 0x7e, 0x9b,
+	0x64, 0x46, 0xd7, 0x59, 0xb2, 0x63, 0x2a, 0x47, 0x1b, 0xd1, 0x41, 0xf4, 0x0a, 0xe4, 0xc9, 0x4d,
+	0x66, 0xd5, 0xb0, 0x1d, 0x52, 0x90, 0x2f, 0x2c, 0x9f, 0x62, 0x5f, 0x15, 0xaa, 0xf6, 0xde, 0x86,
+	0x61, 0x3b, 0xca, 0xb0, 0x43, 0xff, 0x42, 0xcb, 0x30, 0x68, 0xd4, 0x1b, 0x4d, 0x87, 0x94, 0xeb,
+	0x0b, 0xcb, 0xb3, 0x1c, 0x24, 0x07, 0x55, 0x53, 0xd5, 0x15, 0x8f, 0x14, 0xa9, 0x30, 0x17, 0x0a,
+	0xd5, 0x2b, 0x8e, 0x59, 0xd1, 0xaa, 0xa6, 0x8d, 0x49, 0xdc, 0x63, 0x36, 0x1d, 0x5a, 0xbf, 0x9f,
+	0x8e, 0xf4, 0x13, 0xdc, 0xa5, 0x1d, 0x18, 0xca, 0x2c, 0x0e, 0xac, 0xfd, 0x96, 0xb9, 0xea, 0xce,
+	0xdf, 0xf2, 0xa6, 0xa3, 0x37, 0x60, 0xa6, 0x53, 0x2e, 0x8a, 0x72, 0xcf, 0x25, 0x71, 0x3f, 0xe1,
+	0xf8, 0x45, 0xa0, 0x10, 0xe3, 0x5b, 0x70, 0xb2, 0x93, 0x99, 0x76, 0xa4, 0xb0, 0x9a, 0x75, 0xb7,
+	0x67, 0xc1, 0x2d, 0x99, 0xe7, 0x95, 0x13, 0x6d, 0x8a, 0xf6, 0x3a, 0x2b, 0xcd, 0x7a, 0x59, 0x47,
+	0x65, 0xc8, 0xd3, 0x10, 0xc3, 0xb4, 0x48, 0xfd, 0x7a, 0x6c, 0xf9, 0x22, 0x3b, 0x24, 0xa2, 0x0c,
+	0x48, 0xea, 0x59, 0xf6, 0xa7, 0x28, 0x9d, 0xd9, 0xa8, 0x0c, 0x13, 0x1d, 0x1c, 0xee, 0x31, 0xdf,
+	0xb4, 0xf0, 0x54, 0x3e, 0x66, 0x0f, 0xd6, 0x3d, 0x1a, 0x65, 0xbc, 0x3d, 0x8d, 0x8e, 0x20, 0x05,
+	0x26, 0xab, 0xaa, 0x7b, 0x57, 0xe2, 0x19, 0x23, 0x11, 0x07, 0xdb, 0xcd, 0xaa, 0x33, 0x05, 0x31,
+	0xfc, 0xfc, 0x3d, 0x3d, 0xe6, 0xce, 0x5d, 0x6d, 0x4f, 0x55, 0xc8, 0x4c, 0x74, 0x13, 0xa6, 0x4d,
+	0xcb, 0x78, 0x62, 0x78, 0x01, 0x4a, 0x68, 0x95, 0x0a, 0x64, 0x95, 0x26, 0x7d, 0x82, 0xd0, 0x22,
+	0x9d, 0x84, 0x61, 0x43, 0xc7, 0x75, 0xc7, 0x70, 0x0e, 0x48, 0x25, 0x36, 0xaf, 0xb4, 0x9f, 0xd1,
+	0x55, 0x98, 0xdc, 0x31, 0x2c, 0xdb, 0x89, 0xf2, 0x1c, 0x25, 0x94, 0x47, 0xc9, 0xdb, 0x10, 0xc3,
+	0x55, 0x18, 0xb1, 0xb0, 0x63, 0x1d, 0x54, 0x1a, 0x66, 0xd5, 0xd0, 0x0e, 0x68, 0xf5, 0x72, 0x8e,
+	0x73, 0xb1, 0xe3, 0x58, 0x07, 0x9b, 0x84, 0x4e, 0x29, 0x58, 0x9d, 0x07, 0xb7, 0x65, 0x45, 0x75,
+	0x1c, 0x5c, 0x6b, 0x38, 0xa4, 0xd2, 0x38, 0xa8, 0xf8, 0x8f, 0x68, 0x15, 0x8e, 0xe0, 0xa7, 0x0d,
+	0xc3, 0x53, 0x1c, 0xaf, 0x19, 0x66, 0x3c, 0xb1, 0x19, 0x66, 0xac, 0x33, 0xc5, 0x1d, 0x44, 0x67,
+	0x61, 0x54, 0xb3, 0x5c, 0x6b, 0xa0, 0x95, 0x50, 0x52, 0xa9, 0xcb, 0x2b, 0x23, 0xee, 0xa0, 0x5f,
+	0x1d, 0x45, 0xbf, 0x0b, 0x33, 0x9e, 0xf4, 0xc1, 0xaa, 0xf1, 0xb6, 0xaa, 0xed, 0x99, 0x3b, 0x3b,
+	0x53, 0x28, 0x49, 0xa9, 0xa7, 0xc8, 0xec, 0xee, 0x82, 0xf1, 0x1d, 0x6f, 0x2a, 0xba, 0x04, 0x03,
+	0xae, 0x77, 0xa5, 0x65, 0xb0, 0x69, 0xf6, 0x05, 0x39, 0xae, 0x99, 0x0a, 0x21, 0x43, 0x0a, 0x4c,
+	0x44, 0x22, 0x1d, 0x5a, 0xcb, 0x7a, 0x91, 0x1d, 0x53, 0x86, 0x22, 0x13, 0x65, 0xdc, 0x0e, 0x8d,
+	0xa0, 0x47, 0x30, 0xd9, 0xb0, 0xf0, 0x7e, 0x45, 0x6d, 0x3a, 0xa6, 0xab, 0x7f, 0xd8, 0xa9, 0x34,
+	0x4c, 0xa3, 0xee, 0xf8, 0xd5, 0x29, 0xde, 0x7e, 0xd9, 0xd8, 0xd9, 0x24, 0x74, 0xca, 0x51, 0x77,
+	0xfe, 0x4a, 0xd3, 0x31, 0xbb, 0x06, 0xd1, 0x55, 0xc8, 0xed, 0x62, 0x55, 0xc7, 0x16, 0x2d, 0x1b,
+	0xcd, 0xb0, 0x9b, 0xa1, 0x08, 0x89, 0x42, 0x49, 0xd1, 0x15, 0x38, 0x6e, 0x61, 0xff, 0x0e, 0x61,
+	0xc7, 0x32, 0x6b, 0xbe, 0x96, 0x9d, 0x20, 0xbb, 0x82, 0xda, 0x2f, 0xd7, 0x2d, 0xb3, 0x46, 0x94,
+	0x4c, 0xfe, 0x50, 0x82, 0x97, 0xc4, 0x13, 0xeb, 0x6b, 0x90, 0xa3, 0x26, 0x26, 0x09, 0x98, 0x18,
+	0xa5, 0x45, 0xeb, 0x30, 0x17, 0xdf, 0x59, 0x61, 0xe8, 0xe4, 0x40, 0xc8, 0x2a, 0xb3, 0xfc, 0xa6,
+	0x88, 0xb2, 0x2e, 0x7f, 0x20, 0xc1, 0x79, 0xc1, 0xf8, 0xfc, 0x3a, 0x0c, 0xf9, 0xce, 0x45, 0x12,
+	0x70, 0x2e, 0x3e, 0x71, 0xdf, 0xa0, 0x9a, 0xb0, 0x20, 0x9c, 0x9c, 0xae, 0xc2, 0x08, 0xf5, 0xef,
+	0x9d, 0xb3, 0x76, 0x8c, 0xa3, 0x37, 0xd4, 0x9d, 0x93, 0xa3, 0xb6, 0xe0, 0x74, 0x1e, 0xe4, 0x6f,
+	0x4b, 0x70, 0x4e, 0xa4, 0x3f, 0x27, 0x78, 0x68, 0x4a, 0xe9, 0x0e, 0xcd, 0xfb, 0x30, 0xc9, 0x39,
+	0x98, 0x32, 0x49, 0x36, 0x7c, 0xd4, 0x66, 0x1c, 0x4a, 0x5d, 0xce, 0x29, 0x1b, 0x70, 0x4e, 0xf2,
+	0x3b, 0x12, 0xc8, 0xc9, 0xad, 0x3d, 0x68, 0x09, 0x50, 0xb8, 0xdd, 0xa3, 0xdd, 0xf0, 0x37, 0x6e,
+	0x07, 0x96, 0x20, 0xe4, 0xa1, 0x33, 0x21, 0x0f, 0x7d, 0x0a, 0xc0, 0xbf, 0x7b, 0x37, 0x74, 0x82,
+	0x26, 0xaf, 0xe4, 0xe9, 0x48, 0x59, 0x97, 0x7f, 0x1a, 0x5a, 0x5e, 0xae, 0x85, 0xa4, 0x43, 0xb4,
+	0x00, 0xe3, 0xc1, 0x2b, 0xbf, 0xb6, 0x7a, 0x8d, 0xd9, 0x5d, 0x12, 0x87, 0xb0, 0x67, 0x43, 0xd8,
+	0x2f, 0xc0, 0x91, 0x6d, 0xa3, 0xae, 0x5a, 0x07, 0x15, 0x6d, 0x17, 0x6b, 0x7b, 0x76, 0xb3, 0x46,
+	0xa2, 0x9a, 0xbc, 0x32, 0xe6, 0x0d, 0xaf, 0xd2, 0x51, 0x74, 0x11, 0x26, 0x82, 0x17, 0xd5, 0xf8,
+	0xa9, 0x17, 0xb1, 0x8c, 0x28, 0xe3, 0xb8, 0xfb, 0xfe, 0x18, 0x3f, 0x75, 0xe4, 0xb7, 0xb3, 0x70,
+	0x56, 0xa0, 0x6b, 0xe8, 0x99, 0x49, 0x1c, 0x36, 0x8b, 0x6c, 0x0f, 0x66, 0x81, 0x4e, 0x43, 0x61,
+	0x5b, 0xb5, 0xb1, 0xef, 0x07, 0xbd, 0x65, 0xc9, 0xbb, 0x43, 0xde, 0x19, 0x3b, 0x0b, 0xe0, 0xde,
+	0xd1, 0xd3, 0xd7, 0x83, 0xde, 0xc2, 0xd6, 0x71, 0xcb, 0x7b, 0xbb, 0x04, 0x68, 0xc7, 0xb4, 0xf6,
+	0x28, 0x52, 0xbf, 0xf5, 0x33, 0xe7, 0x89, 0xe6, 0xbe, 0x21, 0x58, 0x1f, 0x7b, 0xe3, 0x68, 0xd2,
+	0x75, 0x8e, 0xaa, 0x6d, 0xd6, 0x69, 0x38, 0x45, 0x9f, 0xd0, 0x5d, 0x18, 0xd4, 0xdc, 0xcc, 0x8c,
+	0x46, 0x4e, 0x45, 0xe1, 0xfe, 0xac, 0x55, 0x77, 0x96, 0xe2, 0x4d, 0x96, 0x3f, 0xc8, 0xc2, 0x7c,
+	0x62, 0xcf, 0xd4, 0x33, 0xdb, 0x8c, 0x3b, 0xbe, 0x0c, 0xde, 0x2e, 0x2c, 0x09, 0xb6, 0x74, 0x75,
+	0x4b, 0xd0, 0xed, 0x93, 0x07, 0xd2, 0xf8, 0xe4, 0x6e, 0xd5, 0x1f, 0x0c, 0xa9, 0x7e, 0x68, 0x7f,
+	0x73, 0xf1, 0xfb, 0x3b, 0x24, 0xb4, 0xbf, 0xc3, 0x9c, 0xfd, 0x65, 0x98, 0x59, 0x9e, 0x65, 0x66,
+	0xf2, 0xdf, 0xe5, 0xe0, 0x9c, 0x48, 0x3b, 0x19, 0x3a, 0x03, 0x85, 0x76, 0x4f, 0x06, 0xdd, 0xa6,
+	0xbc, 0x02, 0xfe, 0x50, 0x59, 0x77, 0xf3, 0xb0, 0x36, 0x01, 0x31, 0x82, 0x4c, 0x4c, 0x1e, 0xd6,
+	0xfe, 0x24, 0xc9, 0xc3, 0xd4, 0xae, 0x27, 0x57, 0x35, 0x75, 0xb3, 0xa6, 0x1a, 0x75, 0xea, 0x3b,
+	0xe8, 0x53, 0xf0, 0x30, 0x18, 0xe8, 0x31, 0x83, 0xca, 0x89, 0x67, 0x50, 0x5b, 0x30, 0xed, 0x2b,
+	0x61, 0xf4, 0x0c, 0x19, 0x4a, 0x3a, 0x43, 0x26, 0xfd, 0xb9, 0xa1, 0x63, 0x24, 0xc4, 0x95, 0x1e,
+	0x51, 0x94, 0xeb, 0x70, 0x0a, 0xae, 0x5e, 0xe2, 0x44, 0xb9, 0xf2, 0x0f, 0xbb, 0x7c, 0x4f, 0x87,
+	0xdd, 0x3a, 0x4c, 0xec, 0x62, 0xd5, 0x72, 0xb6, 0xb1, 0xda, 0x41, 0x07, 0x49, 0xac, 0xc6, 0xdb,
+	0x73, 0x3a, 0x7c, 0x92, 0x43, 0x94, 0x42, 0x72, 0x88, 0x12, 0x49, 0x2f, 0x46, 0x7a, 0x49, 0x2f,
+	0x3a, 0x61, 0xea, 0xa8, 0x70, 0x98, 0x2a, 0xff, 0x58, 0x02, 0x39, 0xb9, 0xb5, 0xf1, 0x0b, 0x3b,
+	0xdc, 0xbb, 0xc3, 0x90, 0x81, 0x60, 0x8e, 0xf4, 0x1a, 0x8c, 0x90, 0x14, 0xd3, 0xf7, 0x5b, 0x83,
+	0x02, 0x7e, 0xab, 0xe0, 0xce, 0xa0, 0x0f, 0xf2, 0xa7, 0x52, 0xd0, 0x15, 0xf4, 0x39, 0xb2, 0x66,
+	0x2f, 0x51, 0x26, 0x85, 0xbb, 0xcf, 0x26, 0x46, 0x1b, 0x03, 0xc1, 0xc5, 0x94, 0xbf, 0x23, 0xc1,
+	0x7c, 0x72, 0xbf, 0x59, 0xaf, 0x01, 0xf8, 0x97, 0x21, 0xd1, 0xff, 0x65, 0xe0, 0xac, 0x40, 0xd7,
+	0xa6, 0x2b, 0x93, 0x8e, 0x1d, 0xd5, 0xa8, 0xda, 0x42, 0x9b, 0xe4, 0x13, 0x3f, 0x33, 0x99, 0xc2,
+	0x11, 0xd2, 0x40, 0x2f, 0x11, 0xd2, 0xa1, 0x55, 0xfc, 0x2f, 0x25, 0x58, 0x14, 0x6f, 0xb6, 0x14,
+	0x39, 0xf3, 0xfa, 0x93, 0x82, 0x7d, 0x24, 0x41, 0xca, 0xb6, 0xca, 0x64, 0x6c, 0xc7, 0xfc, 0x30,
+	0xc8, 0xf3, 0x30, 0xde, 0x83, 0x10, 0xe2, 0xac, 0x00, 0xe2, 0xf7, 0x43, 0x7a, 0xc8, 0x2b, 0xc0,
+	0xf6, 0xaa, 0x87, 0xeb, 0x30, 0x57, 0x55, 0x9d, 0xae, 0xf6, 0xa2, 0x70, 0xb3, 0x4d, 0x67, 0x65,
+	0x3d, 0x3a, 0xd6, 0x56, 0x7a, 0x61, 0x13, 0x43, 0x9f, 0xb3, 0x29, 0xf4, 0x79, 0x20, 0xd1, 0x46,
+	0x43, 0x81, 0x9e, 0xfc, 0x89, 0x04, 0x33, 0x31, 0x0d, 0xcd, 0xee, 0x0f, 0xbe, 0xbc, 0x46, 0xce,
+	0xf6, 0xbe, 0x0d, 0x91, 0xe7, 0xb2, 0x8e, 0x36, 0xe0, 0x78, 0xfb, 0x20, 0xdf, 0x31, 0xac, 0x14,
+	0x49, 0x2b, 0xa2, 0xe7, 0xb8, 0xdb, 0xb0, 0x9c, 0xe6, 0xf8, 0x15, 0xd9, 0xec, 0x3f, 0x84, 0x69,
+	0x6e, 0xa7, 0x74, 0x9c, 0x34, 0xc2, 0x31, 0xbb, 0xfc, 0x4d, 0x09, 0x66, 0xe3, 0x9a, 0x64, 0xfb,
+	0xf2, 0x95, 0x7e, 0xad, 0x47, 0xac, 0x83, 0xfe, 0x6f, 0x09, 0xe6, 0x92, 0x9a, 0x6d, 0xe3, 0xa4,
+	0x79, 0xa6, 0x66, 0x1b, 0x8b, 0xfc, 0x97, 0x43, 0x90, 0xb2, 0xa7, 0x0b, 0x95, 0xe0, 0x18, 0x69,
+	0x1b, 0x0b, 0xdf, 0x14, 0x7b, 0x32, 0x4d, 0xd4, 0x71, 0x2b, 0x74, 0x4f, 0x1c, 0x29, 0xd6, 0x64,
+	0x7a, 0x2b, 0xd6, 0x3c, 0x2f, 0xa7, 0x88, 0x97, 0x53, 0x44, 0x74, 0x67, 0x48, 0x40, 0x77, 0x1e,
+	0xc0, 0x24, 0xbd, 0x06, 0xa7, 0x18, 0x8d, 0xba, 0x83, 0xad, 0x7d, 0xb5, 0x9a, 0x9c, 0xb7, 0x1c,
+	0xa3, 0x13, 0x09, 0xbc, 0x32, 0x9d, 0x16, 0x2c, 0xd5, 0xe4, 0x0f, 0x55, 0xaa, 0xe9, 0x0a, 0xe1,
+	0x20, 0x4d, 0x08, 0xc7, 0xaf, 0xcb, 0x14, 0x7a, 0xae, 0xcb, 0x74, 0xf2, 0x8c, 0x11, 0xf1, 0xeb,
+	0x70, 0xbf, 0x3a, 0x30, 0x7a, 0x88, 0xea, 0xc0, 0xd8, 0xa1, 0xaa, 0x03, 0xae, 0x0f, 0x2e, 0xa5,
+	0x6d, 0x2c, 0x6d, 0x7b, 0x2b, 0xa9, 0xdb, 0x5b, 0xc5, 0xe5, 0x37, 0xdb, 0x70, 0xa2, 0xdd, 0x8c,
+	0x12, 0x2a, 0xb4, 0x7a, 0x76, 0xbc, 0x18, 0xdb, 0x6e, 0x12, 0x2c, 0xb5, 0x1e, 0xc7, 0xac, 0x61,
+	0xf9, 0x5f, 0x25, 0x58, 0xe0, 0x48, 0xc2, 0xaa, 0x1f, 0x27, 0x9b, 0x87, 0x24, 0x60, 0x1e, 0x5d,
+	0x91, 0x4e, 0x26, 0x45, 0xa4, 0x23, 0xff, 0x31, 0xa3, 0x50, 0xc0, 0xee, 0xdf, 0xe8, 0x5c, 0xda,
+	0x49, 0x81, 0x4b, 0xbb, 0x43, 0xdc, 0x15, 0xbf, 0xc5, 0x58, 0x28, 0x5e, 0x73, 0xc6, 0x33, 0xf8,
+	0xfc, 0xf7, 0x25, 0x48, 0xd9, 0x9f, 0x81, 0x66, 0x20, 0x4f, 0x3b, 0x2b, 0xda, 0xe7, 0xcc, 0xb0,
+	0x37, 0x50, 0xd6, 0xdd, 0xa0, 0x98, 0xbe, 0xac, 0xab, 0x35, 0xff, 0x08, 0x05, 0x6f, 0xe8, 0xbe,
+	0x5a, 0xc3, 0x1d, 0xdf, 0x9f, 0x15, 0xf7, 0xfd, 0x31, 0x67, 0x66, 0x48, 0xbe, 0xc1, 0xb0, 0x7c,
+	0x3f, 0x63, 0x99, 0x54, 0x42, 0x5b, 0x46, 0xbf, 0xf4, 0x31, 0xb0, 0x50, 0x99, 0xd0, 0x42, 0x75,
+	0x52, 0xf8, 0x6c, 0x8a, 0x14, 0xfe, 0x2c, 0x8c, 0x62, 0xcb, 0x32, 0xad, 0x4a, 0x0d, 0xdb, 0xb6,
+	0xfa, 0x04, 0xd3, 0xe5, 0x18, 0x21, 0x83, 0xaf, 0x7b, 0x63, 0xf2, 0xe7, 0x12, 0x9c, 0x8a, 0xfd,
+	0xa1, 0x8f, 0xbb, 0x4b, 0xf4, 0x67, 0x44, 0x64, 0x97, 0x68, 0xea, 0xe2, 0x0d, 0x91, 0x5d, 0xea,
+	0xd1, 0x94, 0xfa, 0x16, 0x25, 0x75, 0x3c, 0xf8, 0x80, 0xf8, 0x4d, 0xd1, 0xdf, 0xb0, 0x9c, 0x0e,
+	0xaf, 0xb1, 0xed, 0x0c, 0x14, 0x68, 0x6b, 0x61, 0xf7, 0x12, 0x78, 0x43, 0x41, 0x45, 0xcd, 0xf4,
+	0xa6, 0xa8, 0xa1, 0xba, 0x8b, 0xfc, 0x57, 0x12, 0x2c, 0xa6, 0x68, 0xe6, 0xe4, 0xd9, 0x7a, 0xaf,
+	0x3b, 0x13, 0x07, 0xed, 0x6b, 0x19, 0xb8, 0x7d, 0xb8, 0x1f, 0xb4, 0xf4, 0xcd, 0x66, 0x3a, 0x77,
+	0xcf, 0x99, 0xc0, 0xdd, 0xf3, 0x23, 0x40, 0xd1, 0x0e, 0x2f, 0x6a, 0x3a, 0xe7, 0xc5, 0xfa, 0xb7,
+	0x94, 0x89, 0x48, 0x7f, 0x96, 0x7b, 0x99, 0xa7, 0x99, 0x75, 0xc7, 0x32, 0xab, 0x44, 0xd1, 0x46,
+	0x14, 0xff, 0x11, 0x15, 0xe1, 0x68, 0xa8, 0x07, 0xd8, 0xac, 0x57, 0xbd, 0x4c, 0x73, 0x58, 0x99,
+	0x08, 0xb4, 0xe6, 0x3e, 0xa8, 0x57, 0x0f, 0xe4, 0xf7, 0xb2, 0x70, 0xeb, 0x10, 0x3f, 0x98, 0x41,
+	0x8f, 0xba, 0xcf, 0xf1, 0x31, 0xce, 0xcf, 0xd1, 0x84, 0x38, 0x07, 0xca, 0x28, 0x7d, 0xba, 0x1f,
+	0xe1, 0xd6, 0x04, 0xd8, 0xfb, 0x32, 0x70, 0xd8, 0x7d, 0x59, 0x02, 0x14, 0x6e, 0x53, 0xa6, 0xde,
+	0x3d, 0xab, 0x8c, 0x1b, 0x01, 0x25, 0xf4, 0xae, 0x64, 0xfd, 0x5d, 0xcc, 0x05, 0x76, 0x51, 0xfe,
+	0xae, 0x04, 0x37, 0x7a, 0xfc, 0xb5, 0x0f, 0x07, 0x83, 0xc4, 0xc1, 0xf0, 0xc5, 0x2a, 0xae, 0xfc,
+	0xe7, 0x59, 0xb8, 0xd1, 0x63, 0x47, 0xf6, 0x6f, 0xaa, 0xad, 0x86, 0x3c, 0xf6, 0x00, 0xdf, 0x63,
+	0x0f, 0x8a, 0x7b, 0x6c, 0xae, 0xea, 0xf0, 0x1c, 0xc0, 0x10, 0xcf, 0x01, 0xbc, 0x9d, 0x85, 0x6b,
+	0xbd, 0x74, 0x95, 0x8b, 0x59, 0xbe, 0x10, 0xe7, 0xe7, 0x96, 0xdf, 0xb1, 0xfc, 0x9f, 0x48, 0x70,
+	0x39, 0x6d, 0x87, 0xfc, 0xaf, 0xb5, 0xc9, 0xf3, 0xcf, 0x2a, 0xf9, 0x5b, 0x12, 0x5c, 0x4a, 0xd5,
+	0x55, 0xdf, 0x37, 0x17, 0xc0, 0xcc, 0x82, 0x33, 0x87, 0xcb, 0x82, 0xff, 0x5a, 0x82, 0xf9, 0xc4,
+	0x9e, 0xe6, 0xbe, 0x49, 0xe0, 0xa7, 0xfd, 0x19, 0xa1, 0xb4, 0x5f, 0xfe, 0xc1, 0x30, 0x5c, 0xed,
+	0xe1, 0xb7, 0x8b, 0x5d, 0xba, 0x22, 0x05, 0x74, 0xe5, 0x0c, 0x14, 0xda, 0xba, 0xd2, 0xce, 0x12,
+	0xc0, 0x1f, 0x62, 0xdd, 0xd7, 0x65, 0xfb, 0x70, 0x5f, 0xd7, 0x6b, 0xf1, 0x7e, 0xb0, 0xbf, 0xf7,
+	0x75, 0xb9, 0x67, 0x7a, 0x5f, 0x37, 0xd4, 0xf3, 0x7d, 0xdd, 0x63, 0xa0, 0x1d, 0xe4, 0x94, 0x23,
+	0xad, 0x79, 0x7b, 0x1d, 0x39, 0xe7, 0x63, 0xda, 0xd0, 0x09, 0x17, 0x5a, 0xf9, 0x9e, 0x68, 0x84,
+	0x87, 0xba, 0x2d, 0x38, 0x1f, 0x3c, 0x6c, 0x44, 0xb4, 0x19, 0x04, 0xb4, 0x59, 0x83, 0xa9, 0x2e,
+	0x75, 0xaa, 0x58, 0xb8, 0xd9, 0x81, 0x5f, 0x20, 0xf0, 0x17, 0x63, 0x15, 0xa7, 0xac, 0x2b, 0xb8,
+	0xe9, 0xe3, 0x55, 0x8e, 0xb7, 0x58, 0xc3, 0x91, 0x5e, 0x80, 0xd1, 0x5e, 0x7a, 0x01, 0x22, 0xbd,
+	0xc0, 0x63, 0x8c, 0x5e, 0xe0, 0x4e, 0x1a, 0x78, 0x24, 0xfd, 0x45, 0xde, 0xf8, 0x21, 0x2e, 0xf2,
+	0x26, 0x0e, 0xd7, 0xe6, 0xfb, 0x0a, 0x14, 0x74, 0x5c, 0x55, 0x0f, 0x3c, 0xd5, 0x4c, 0xee, 0x59,
+	0x06, 0x42, 0x4d, 0x54, 0x51, 0x7e, 0x37, 0x0b, 0x97, 0xd3, 0xfe, 0xb6, 0xf8, 0xcb, 0x77, 0x2f,
+	0x1b, 0x7e, 0x10, 0xe3, 0x95, 0x95, 0xaf, 0xa7, 0xfe, 0x61, 0x6c, 0x20, 0x76, 0xe9, 0x32, 0x94,
+	0xc1, 0xa0, 0xa1, 0xb0, 0x4f, 0xe8, 0x1c, 0xe7, 0x84, 0xee, 0xd3, 0xc5, 0xbb, 0xfc, 0x71, 0x06,
+	0x96, 0xd2, 0xfc, 0x70, 0x9a, 0xbb, 0x1f, 0xec, 0xd0, 0x20, 0x73, 0xd8, 0xd0, 0xa0, 0x5f, 0xbb,
+	0xc8, 0x5e, 0xdd, 0x01, 0xce, 0xea, 0x76, 0xac, 0x73, 0x50, 0xfc, 0x92, 0xe6, 0xf3, 0x0c, 0xa4,
+	0xfc, 0x49, 0xf7, 0x57, 0x63, 0x31, 0x59, 0x35, 0xd4, 0x41, 0x66, 0x0d, 0xb5, 0x73, 0x73, 0x98,
+	0x13, 0xbf, 0x39, 0x94, 0x7f, 0x9e, 0x81, 0x8b, 0xfd, 0xf0, 0x28, 0x5f, 0xd1, 0x45, 0xef, 0x2a,
+	0x6f, 0xe5, 0x52, 0x94, 0xb7, 0xe4, 0x5f, 0x64, 0xe0, 0x52, 0xaa, 0x5f, 0xd8, 0x3f, 0x5f, 0xf8,
+	0xc8, 0xc2, 0xfb, 0xf7, 0x9d, 0xb9, 0x34, 0x45, 0x9d, 0x3f, 0xc9, 0xf2, 0x16, 0x9e, 0xd7, 0xb0,
+	0xf5, 0x7c, 0xe1, 0x63, 0xfb, 0xc5, 0x72, 0xbd, 0xfc, 0xd0, 0xe4, 0xff, 0x33, 0x50, 0x4a, 0xf9,
+	0x9f, 0x0f, 0x9e, 0xef, 0x43, 0x60, 0x1f, 0x16, 0x1d, 0x38, 0x42, 0xfe, 0x5c, 0x37, 0xaa, 0x0e,
+	0xb6, 0xc8, 0xa7, 0x4e, 0xc1, 0xf4, 0xda, 0xe3, 0xb5, 0xfb, 0x5b, 0x95, 0xf5, 0xf2, 0xc6, 0xd6,
+	0x9a, 0x52, 0xd9, 0xfa, 0xbd, 0xcd, 0xb5, 0x4a, 0xf9, 0xfe, 0xe3, 0x95, 0x8d, 0xf2, 0xdd, 0xf1,
+	0x17, 0xd0, 0x19, 0x98, 0x89, 0xbe, 0x5e, 0xd9, 0xd8, 0xa8, 0x90, 0xd1, 0x71, 0x09, 0xcd, 0xc3,
+	0xa9, 0x28, 0xc1, 0xea, 0xc6, 0x83, 0x87, 0x6b, 0x94, 0x24, 0x73, 0x67, 0xfb, 0xe3, 0xcf, 0x4e,
+	0x4b, 0x9f, 0x7e, 0x76, 0x5a, 0xfa, 0xe1, 0x67, 0xa7, 0x25, 0x38, 0xa1, 0x99, 0x35, 0xd6, 0x7a,
+	0xdc, 0x19, 0x5e, 0x69, 0x18, 0x9b, 0x96, 0xe9, 0x98, 0x9b, 0xd2, 0xef, 0x97, 0x9e, 0x18, 0xce,
+	0x6e, 0x73, 0xbb, 0xa8, 0x99, 0xb5, 0x52, 0xe0, 0x7f, 0x79, 0x17, 0x9f, 0xe0, 0xba, 0xf7, 0xdf,
+	0xc3, 0xe9, 0xbf, 0xf5, 0xbe, 0xa5, 0x36, 0x8c, 0xfd, 0x2b, 0xdb, 0x39, 0x32, 0x76, 0xf5, 0x57,
+	0x03, 0x00, 0x18, 0xda, 0x76, 0xb4, 0xb9, 0x5c, 0x00, 0x00,
 }
 
 func (m *History) Marshal() (dAtA []byte, err error) {
@@ -5657,6 +6096,121 @@ func (m *HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes) MarshalToSi
 	}
 	return len(dAtA) - i, nil
 }
+func (m *HistoryEvent_WorkflowExecutionPausedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_WorkflowExecutionPausedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WorkflowExecutionPausedEventAttributes != nil {
+		{
+			size, err := m.WorkflowExecutionPausedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_WorkflowExecutionUnpausedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_WorkflowExecutionUnpausedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WorkflowExecutionUnpausedEventAttributes != nil {
+		{
+			size, err := m.WorkflowExecutionUnpausedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x82
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_WorkflowExecutionUpdateAcceptedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_WorkflowExecutionUpdateAcceptedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WorkflowExecutionUpdateAcceptedEventAttributes != nil {
+		{
+			size, err := m.WorkflowExecutionUpdateAcceptedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x8a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_WorkflowExecutionUpdateCompletedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_WorkflowExecutionUpdateCompletedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WorkflowExecutionUpdateCompletedEventAttributes != nil {
+		{
+			size, err := m.WorkflowExecutionUpdateCompletedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x92
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_UpsertWorkflowMemoEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_UpsertWorkflowMemoEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpsertWorkflowMemoEventAttributes != nil {
+		{
+			size, err := m.UpsertWorkflowMemoEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *WorkflowExecutionStartedEventAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5681,6 +6235,15 @@ func (m *WorkflowExecutionStartedEventAttributes) MarshalToSizedBuffer(dAtA []by
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RestartedFromRunId) > 0 {
+		i -= len(m.RestartedFromRunId)
+		copy(dAtA[i:], m.RestartedFromRunId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.RestartedFromRunId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
 	if m.Header != nil {
 		{
 			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
@@ -7371,7 +7934,7 @@ func (m *WorkflowExecutionCanceledEventAttributes) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func (m *MarkerRecordedEventAttributes) Marshal() (dAtA []byte, err error) {
+func (m *WorkflowExecutionPausedEventAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7381,12 +7944,12 @@ func (m *MarkerRecordedEventAttributes) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MarkerRecordedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+func (m *WorkflowExecutionPausedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MarkerRecordedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WorkflowExecutionPausedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7395,46 +7958,31 @@ func (m *MarkerRecordedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintHistory(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.DecisionTaskCompletedEventId != 0 {
-		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.RequestId)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.Details != nil {
-		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintHistory(dAtA, i, uint64(size))
-		}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.MarkerName) > 0 {
-		i -= len(m.MarkerName)
-		copy(dAtA[i:], m.MarkerName)
-		i = encodeVarintHistory(dAtA, i, uint64(len(m.MarkerName)))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Reason)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowExecutionSignaledEventAttributes) Marshal() (dAtA []byte, err error) {
+func (m *WorkflowExecutionUnpausedEventAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7444,12 +7992,12 @@ func (m *WorkflowExecutionSignaledEventAttributes) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowExecutionSignaledEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+func (m *WorkflowExecutionUnpausedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowExecutionSignaledEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WorkflowExecutionUnpausedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7458,36 +8006,31 @@ func (m *WorkflowExecutionSignaledEventAttributes) MarshalToSizedBuffer(dAtA []b
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Identity) > 0 {
 		i -= len(m.Identity)
 		copy(dAtA[i:], m.Identity)
 		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Input != nil {
-		{
-			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintHistory(dAtA, i, uint64(size))
-		}
-		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.SignalName) > 0 {
-		i -= len(m.SignalName)
-		copy(dAtA[i:], m.SignalName)
-		i = encodeVarintHistory(dAtA, i, uint64(len(m.SignalName)))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Reason)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowExecutionTerminatedEventAttributes) Marshal() (dAtA []byte, err error) {
+func (m *WorkflowExecutionUpdateAcceptedEventAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7497,12 +8040,12 @@ func (m *WorkflowExecutionTerminatedEventAttributes) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowExecutionTerminatedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+func (m *WorkflowExecutionUpdateAcceptedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowExecutionTerminatedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WorkflowExecutionUpdateAcceptedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7511,16 +8054,23 @@ func (m *WorkflowExecutionTerminatedEventAttributes) MarshalToSizedBuffer(dAtA [
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Identity) > 0 {
 		i -= len(m.Identity)
 		copy(dAtA[i:], m.Identity)
 		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
-	if m.Details != nil {
+	if m.Input != nil {
 		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7528,19 +8078,26 @@ func (m *WorkflowExecutionTerminatedEventAttributes) MarshalToSizedBuffer(dAtA [
 			i = encodeVarintHistory(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.UpdateName) > 0 {
+		i -= len(m.UpdateName)
+		copy(dAtA[i:], m.UpdateName)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.UpdateName)))
+		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintHistory(dAtA, i, uint64(len(m.Reason)))
+	if len(m.UpdateId) > 0 {
+		i -= len(m.UpdateId)
+		copy(dAtA[i:], m.UpdateId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.UpdateId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Marshal() (dAtA []byte, err error) {
+func (m *WorkflowExecutionUpdateCompletedEventAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7550,12 +8107,12 @@ func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Marshal
 	return dAtA[:n], nil
 }
 
-func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+func (m *WorkflowExecutionUpdateCompletedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WorkflowExecutionUpdateCompletedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7564,17 +8121,244 @@ func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Marshal
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ChildWorkflowOnly {
-		i--
-		if m.ChildWorkflowOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.ErrorMessage)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x22
 	}
-	if len(m.Control) > 0 {
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.UpdateId) > 0 {
+		i -= len(m.UpdateId)
+		copy(dAtA[i:], m.UpdateId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.UpdateId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkerRecordedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkerRecordedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkerRecordedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarkerName) > 0 {
+		i -= len(m.MarkerName)
+		copy(dAtA[i:], m.MarkerName)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.MarkerName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowExecutionSignaledEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExecutionSignaledEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExecutionSignaledEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignalName) > 0 {
+		i -= len(m.SignalName)
+		copy(dAtA[i:], m.SignalName)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.SignalName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowExecutionTerminatedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExecutionTerminatedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExecutionTerminatedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChildWorkflowOnly {
+		i--
+		if m.ChildWorkflowOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Control) > 0 {
 		i -= len(m.Control)
 		copy(dAtA[i:], m.Control)
 		i = encodeVarintHistory(dAtA, i, uint64(len(m.Control)))
@@ -7984,7 +8768,7 @@ func (m *UpsertWorkflowSearchAttributesEventAttributes) MarshalToSizedBuffer(dAt
 	return len(dAtA) - i, nil
 }
 
-func (m *StartChildWorkflowExecutionInitiatedEventAttributes) Marshal() (dAtA []byte, err error) {
+func (m *UpsertWorkflowMemoEventAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7994,12 +8778,12 @@ func (m *StartChildWorkflowExecutionInitiatedEventAttributes) Marshal() (dAtA []
 	return dAtA[:n], nil
 }
 
-func (m *StartChildWorkflowExecutionInitiatedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpsertWorkflowMemoEventAttributes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StartChildWorkflowExecutionInitiatedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpsertWorkflowMemoEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8008,9 +8792,9 @@ func (m *StartChildWorkflowExecutionInitiatedEventAttributes) MarshalToSizedBuff
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.DelayStart != nil {
+	if m.Memo != nil {
 		{
-			size, err := m.DelayStart.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Memo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8018,27 +8802,71 @@ func (m *StartChildWorkflowExecutionInitiatedEventAttributes) MarshalToSizedBuff
 			i = encodeVarintHistory(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+		dAtA[i] = 0x12
 	}
-	if m.SearchAttributes != nil {
-		{
-			size, err := m.SearchAttributes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintHistory(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
 		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0x8
 	}
-	if m.Memo != nil {
-		{
-			size, err := m.Memo.MarshalToSizedBuffer(dAtA[:i])
+	return len(dAtA) - i, nil
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DelayStart != nil {
+		{
+			size, err := m.DelayStart.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.SearchAttributes != nil {
+		{
+			size, err := m.SearchAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Memo != nil {
+		{
+			size, err := m.Memo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9272,6 +10100,66 @@ func (m *HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes) Size() (n i
 	}
 	return n
 }
+func (m *HistoryEvent_WorkflowExecutionPausedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecutionPausedEventAttributes != nil {
+		l = m.WorkflowExecutionPausedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_WorkflowExecutionUnpausedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecutionUnpausedEventAttributes != nil {
+		l = m.WorkflowExecutionUnpausedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_WorkflowExecutionUpdateAcceptedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecutionUpdateAcceptedEventAttributes != nil {
+		l = m.WorkflowExecutionUpdateAcceptedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_WorkflowExecutionUpdateCompletedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecutionUpdateCompletedEventAttributes != nil {
+		l = m.WorkflowExecutionUpdateCompletedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_UpsertWorkflowMemoEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpsertWorkflowMemoEventAttributes != nil {
+		l = m.UpsertWorkflowMemoEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
 func (m *WorkflowExecutionStartedEventAttributes) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9364,6 +10252,10 @@ func (m *WorkflowExecutionStartedEventAttributes) Size() (n int) {
 		l = m.Header.Size()
 		n += 2 + l + sovHistory(uint64(l))
 	}
+	l = len(m.RestartedFromRunId)
+	if l > 0 {
+		n += 2 + l + sovHistory(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10027,6 +10919,113 @@ func (m *WorkflowExecutionCanceledEventAttributes) Size() (n int) {
 	return n
 }
 
+func (m *WorkflowExecutionPausedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowExecutionUnpausedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowExecutionUpdateAcceptedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpdateId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.UpdateName)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowExecutionUpdateCompletedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	l = len(m.UpdateId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *MarkerRecordedEventAttributes) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10305,6 +11304,25 @@ func (m *UpsertWorkflowSearchAttributesEventAttributes) Size() (n int) {
 	return n
 }
 
+func (m *UpsertWorkflowMemoEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	if m.Memo != nil {
+		l = m.Memo.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *StartChildWorkflowExecutionInitiatedEventAttributes) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12301,26 +13319,201 @@ func (m *HistoryEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.Attributes = &HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipHistory(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionPausedEventAttributes", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthHistory
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthHistory
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
+			v := &WorkflowExecutionPausedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_WorkflowExecutionPausedEventAttributes{v}
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionUnpausedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkflowExecutionUnpausedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_WorkflowExecutionUnpausedEventAttributes{v}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionUpdateAcceptedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkflowExecutionUpdateAcceptedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_WorkflowExecutionUpdateAcceptedEventAttributes{v}
+			iNdEx = postIndex
+		case 50:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionUpdateCompletedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkflowExecutionUpdateCompletedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_WorkflowExecutionUpdateCompletedEventAttributes{v}
+			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpsertWorkflowMemoEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpsertWorkflowMemoEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_UpsertWorkflowMemoEventAttributes{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -13093,6 +14286,38 @@ func (m *WorkflowExecutionStartedEventAttributes) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartedFromRunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestartedFromRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHistory(dAtA[iNdEx:])
@@ -17088,7 +18313,570 @@ func (m *WorkflowExecutionContinuedAsNewEventAttributes) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *WorkflowExecutionCancelRequestedEventAttributes) Unmarshal(dAtA []byte) error {
+func (m *WorkflowExecutionCancelRequestedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionCancelRequestedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionCancelRequestedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cause = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalExecutionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalExecutionInfo == nil {
+				m.ExternalExecutionInfo = &ExternalExecutionInfo{}
+			}
+			if err := m.ExternalExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExecutionCanceledEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionCanceledEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionCanceledEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &Payload{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExecutionPausedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionPausedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionPausedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExecutionUnpausedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionUnpausedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionUnpausedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExecutionUpdateAcceptedEventAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17111,15 +18899,15 @@ func (m *WorkflowExecutionCancelRequestedEventAttributes) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowExecutionCancelRequestedEventAttributes: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowExecutionUpdateAcceptedEventAttributes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowExecutionCancelRequestedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowExecutionUpdateAcceptedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17147,11 +18935,11 @@ func (m *WorkflowExecutionCancelRequestedEventAttributes) Unmarshal(dAtA []byte)
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cause = string(dAtA[iNdEx:postIndex])
+			m.UpdateId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17179,11 +18967,11 @@ func (m *WorkflowExecutionCancelRequestedEventAttributes) Unmarshal(dAtA []byte)
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			m.UpdateName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalExecutionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17210,13 +18998,77 @@ func (m *WorkflowExecutionCancelRequestedEventAttributes) Unmarshal(dAtA []byte)
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalExecutionInfo == nil {
-				m.ExternalExecutionInfo = &ExternalExecutionInfo{}
+			if m.Input == nil {
+				m.Input = &Payload{}
 			}
-			if err := m.ExternalExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHistory(dAtA[iNdEx:])
@@ -17242,7 +19094,7 @@ func (m *WorkflowExecutionCancelRequestedEventAttributes) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *WorkflowExecutionCanceledEventAttributes) Unmarshal(dAtA []byte) error {
+func (m *WorkflowExecutionUpdateCompletedEventAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17265,10 +19117,10 @@ func (m *WorkflowExecutionCanceledEventAttributes) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowExecutionCanceledEventAttributes: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowExecutionUpdateCompletedEventAttributes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowExecutionCanceledEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowExecutionUpdateCompletedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17292,7 +19144,39 @@ func (m *WorkflowExecutionCanceledEventAttributes) Unmarshal(dAtA []byte) error
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17319,13 +19203,45 @@ func (m *WorkflowExecutionCanceledEventAttributes) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Details == nil {
-				m.Details = &Payload{}
+			if m.Result == nil {
+				m.Result = &Payload{}
 			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHistory(dAtA[iNdEx:])
@@ -19145,6 +21061,115 @@ func (m *UpsertWorkflowSearchAttributesEventAttributes) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
+func (m *UpsertWorkflowMemoEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpsertWorkflowMemoEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpsertWorkflowMemoEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Memo == nil {
+				m.Memo = &Memo{}
+			}
+			if err := m.Memo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *StartChildWorkflowExecutionInitiatedEventAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -60,8 +60,8 @@ var yarpcFileDescriptorClosure91769cfce21084c6 = [][]byte{
 		0x47, 0x5c, 0xdb, 0xfa, 0x20, 0xd7, 0xae, 0x7a, 0xf0, 0x32, 0x64, 0x93, 0xaa, 0x17, 0xbd, 0x7a,
 		0xa2, 0x25, 0x91, 0x93, 0xdf, 0x8f, 0x23, 0x7d, 0x52, 0x47, 0x51, 0xfa, 0x25, 0x1b, 0x28, 0x21,
 		0x9b, 0xa8, 0xf7, 0x0e, 0x4e, 0x19, 0xd1, 0x58, 0x2d, 0xae, 0xac, 0xbc, 0xbd, 0xcb, 0x20, 0x89,
-		0xa6, 0xed, 0xc1, 0x56, 0x81, 0xbd, 0xfd, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x96, 0x7d, 0xf9, 0x10,
-		0xf7, 0x03, 0x00, 0x00,
+		0xa6, 0xed, 0xc1, 0x56, 0x81, 0xbd, 0xfd, 0x3d, 0x00, 0x96, 0x7d, 0xf9, 0x10, 0xf7, 0x03, 0x00,
+		0x00,
 	},
 	// uber/cadence/api/v1/common.proto
 	[]byte{
@@ -114,8 +114,8 @@ var yarpcFileDescriptorClosure91769cfce21084c6 = [][]byte{
 		0x0e, 0x69, 0xc1, 0x27, 0x9b, 0xf5, 0xa1, 0x77, 0x35, 0xbe, 0x7a, 0xde, 0xac, 0x9c, 0x5d, 0xc3,
 		0xd3, 0x40, 0x24, 0xdb, 0x86, 0xfc, 0x6c, 0xaf, 0x97, 0xb2, 0xa1, 0x4e, 0x3f, 0xb4, 0x7e, 0x71,
 		0xa6, 0x4c, 0xbd, 0xcb, 0x27, 0x76, 0x20, 0x12, 0x67, 0xe3, 0xc7, 0x64, 0x4f, 0x91, 0x97, 0x3f,
-		0x1b, 0xf3, 0x8f, 0x7a, 0x49, 0x53, 0x36, 0x3f, 0x9d, 0xd4, 0x8a, 0xda, 0xf3, 0xbf, 0x03, 0x00,
-		0x00, 0xff, 0xff, 0xdc, 0x8c, 0x77, 0x9a, 0xc7, 0x06, 0x00, 0x00,
+		0x1b, 0xf3, 0x8f, 0x7a, 0x49, 0x53, 0x36, 0x3f, 0x9d, 0xd4, 0x8a, 0xda, 0xf3, 0xbf, 0x07, 0x00,
+		0xdc, 0x8c, 0x77, 0x9a, 0xc7, 0x06, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -130,153 +130,159 @@ var yarpcFileDescriptorClosure91769cfce21084c6 = [][]byte{
 		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
 		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
 		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
-		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6f, 0xdb, 0xca,
-		0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0x0f, 0x7a, 0x1c, 0xc7, 0xca, 0xb7, 0xa3, 0xfb, 0x26,
-		0x71, 0xd4, 0x1b, 0xf9, 0x3a, 0xb9, 0xb9, 0x69, 0x6e, 0x9a, 0xa6, 0x34, 0x49, 0xc7, 0x4c, 0x64,
-		0x4a, 0x1d, 0x51, 0x71, 0x7c, 0x51, 0x94, 0xa0, 0xa5, 0xb1, 0x4d, 0x44, 0x22, 0x05, 0x72, 0x94,
-		0xc4, 0xfb, 0x02, 0x5d, 0x77, 0x57, 0xb4, 0x9b, 0xfe, 0x80, 0x02, 0x45, 0x7f, 0x40, 0xd1, 0xa2,
-		0x8b, 0xee, 0xba, 0xed, 0xb2, 0xfb, 0xfe, 0x8b, 0x62, 0x86, 0x43, 0x89, 0xb2, 0x3e, 0xa8, 0xb4,
-		0xc0, 0xed, 0xce, 0x3c, 0x7c, 0x9e, 0x87, 0x67, 0xce, 0x9c, 0xf3, 0x70, 0x68, 0x41, 0xa1, 0x7b,
-		0x4c, 0x82, 0xed, 0x86, 0xd3, 0x24, 0x5e, 0x83, 0x6c, 0x3b, 0x1d, 0x77, 0xfb, 0xc3, 0xce, 0xf6,
-		0x47, 0x3f, 0x78, 0x7f, 0xd2, 0xf2, 0x3f, 0x96, 0x3a, 0x81, 0x4f, 0x7d, 0xb4, 0xc6, 0x30, 0x25,
-		0x81, 0x29, 0x39, 0x1d, 0xb7, 0xf4, 0x61, 0xe7, 0xda, 0xad, 0x53, 0xdf, 0x3f, 0x6d, 0x91, 0x6d,
-		0x0e, 0x39, 0xee, 0x9e, 0x6c, 0x37, 0xbb, 0x81, 0x43, 0x5d, 0xdf, 0x8b, 0x48, 0xd7, 0x6e, 0x5f,
-		0xbc, 0x4f, 0xdd, 0x36, 0x09, 0xa9, 0xd3, 0xee, 0x08, 0xc0, 0xe6, 0xa8, 0x27, 0x37, 0xfc, 0x76,
-		0xbb, 0x27, 0x31, 0x32, 0x37, 0xea, 0x84, 0xef, 0x5b, 0x6e, 0x48, 0x23, 0x4c, 0xe1, 0x2f, 0x73,
-		0xb0, 0x7e, 0x28, 0xd2, 0xd5, 0x3f, 0x91, 0x46, 0x97, 0xa5, 0x60, 0x78, 0x27, 0x3e, 0xaa, 0x03,
-		0x8a, 0xd7, 0x61, 0x93, 0xf8, 0x4e, 0x5e, 0xda, 0x94, 0xb6, 0x72, 0x8f, 0xee, 0x95, 0x46, 0x2c,
-		0xa9, 0x34, 0xa4, 0x83, 0x57, 0x3f, 0x5e, 0x0c, 0xa1, 0x27, 0x30, 0x43, 0xcf, 0x3b, 0x24, 0x9f,
-		0xe1, 0x42, 0x77, 0x26, 0x0a, 0x59, 0xe7, 0x1d, 0x82, 0x39, 0x1c, 0x3d, 0x03, 0x08, 0xa9, 0x13,
-		0x50, 0x9b, 0x95, 0x21, 0x9f, 0xe5, 0xe4, 0x6b, 0xa5, 0xa8, 0x46, 0xa5, 0xb8, 0x46, 0x25, 0x2b,
-		0xae, 0x11, 0x5e, 0xe0, 0x68, 0x76, 0xcd, 0xa8, 0x8d, 0x96, 0x1f, 0x92, 0x88, 0x3a, 0x93, 0x4e,
-		0xe5, 0x68, 0x4e, 0xb5, 0x60, 0x31, 0xa2, 0x86, 0xd4, 0xa1, 0xdd, 0x30, 0x3f, 0xbb, 0x29, 0x6d,
-		0x2d, 0x3f, 0xda, 0x99, 0x6e, 0xf5, 0x2a, 0x63, 0xd6, 0x38, 0x11, 0xe7, 0x1a, 0xfd, 0x0b, 0x74,
-		0x17, 0x96, 0xcf, 0xdc, 0x90, 0xfa, 0xc1, 0xb9, 0xdd, 0x22, 0xde, 0x29, 0x3d, 0xcb, 0xcf, 0x6d,
-		0x4a, 0x5b, 0x59, 0xbc, 0x24, 0xa2, 0x65, 0x1e, 0x44, 0x3f, 0x83, 0xf5, 0x8e, 0x13, 0x10, 0x8f,
-		0xf6, 0xcb, 0x6f, 0xbb, 0xde, 0x89, 0x9f, 0xbf, 0xc4, 0x97, 0xb0, 0x35, 0x32, 0x8b, 0x2a, 0x67,
-		0x0c, 0xec, 0x24, 0x5e, 0xeb, 0x0c, 0x07, 0x91, 0x02, 0xcb, 0x7d, 0x59, 0x5e, 0x99, 0xf9, 0xd4,
-		0xca, 0x2c, 0xf5, 0x18, 0xbc, 0x3a, 0x0f, 0x61, 0xa6, 0x4d, 0xda, 0x7e, 0x7e, 0x81, 0x13, 0xaf,
-		0x8e, 0xcc, 0xe7, 0x80, 0xb4, 0x7d, 0xcc, 0x61, 0x08, 0xc3, 0x6a, 0x48, 0x9c, 0xa0, 0x71, 0x66,
-		0x3b, 0x94, 0x06, 0xee, 0x71, 0x97, 0x92, 0x30, 0x0f, 0x9c, 0x7b, 0x77, 0x24, 0xb7, 0xc6, 0xd1,
-		0x4a, 0x0f, 0x8c, 0xe5, 0xf0, 0x42, 0x04, 0x95, 0x61, 0xd5, 0xe9, 0x52, 0xdf, 0x0e, 0x48, 0x48,
-		0xa8, 0xdd, 0xf1, 0x5d, 0x8f, 0x86, 0xf9, 0x1c, 0xd7, 0xdc, 0x1c, 0xa9, 0x89, 0x19, 0xb0, 0xca,
-		0x71, 0x78, 0x85, 0x51, 0x13, 0x01, 0x74, 0x1d, 0x16, 0xd8, 0x78, 0xd8, 0x6c, 0x3e, 0xf2, 0x8b,
-		0x9b, 0xd2, 0xd6, 0x02, 0x9e, 0x67, 0x81, 0xb2, 0x1b, 0x52, 0xb4, 0x01, 0x97, 0xdc, 0xd0, 0x6e,
-		0x04, 0xbe, 0x97, 0x5f, 0xda, 0x94, 0xb6, 0xe6, 0xf1, 0x9c, 0x1b, 0xaa, 0x81, 0xef, 0x15, 0x7e,
-		0x9d, 0x81, 0x5b, 0xc3, 0x9b, 0xef, 0x7b, 0x27, 0xee, 0xa9, 0x18, 0x69, 0xf4, 0x6d, 0x52, 0x38,
-		0x1a, 0xa1, 0x9b, 0x23, 0xd3, 0xb3, 0xc4, 0xd3, 0x12, 0xcf, 0x75, 0x60, 0xb3, 0xbf, 0x51, 0x62,
-		0x06, 0x7c, 0xbb, 0xdf, 0xd1, 0x7e, 0x97, 0x8a, 0x61, 0xba, 0x3a, 0xb4, 0x75, 0x9a, 0x48, 0x00,
-		0xdf, 0xe8, 0x49, 0xd4, 0xf8, 0x5c, 0xf8, 0x6a, 0xdc, 0xe3, 0x7e, 0x97, 0xa2, 0x43, 0xb8, 0xce,
-		0xd3, 0x1b, 0xa3, 0x9e, 0x4d, 0x53, 0xdf, 0x60, 0xec, 0x11, 0xc2, 0x85, 0xbf, 0x4b, 0xb0, 0x36,
-		0xa2, 0x23, 0x59, 0xa1, 0x9b, 0x7e, 0xdb, 0x71, 0x3d, 0xdb, 0x6d, 0xf2, 0x7a, 0x2c, 0xe0, 0xf9,
-		0x28, 0x60, 0x34, 0xd1, 0x6d, 0xc8, 0x89, 0x9b, 0x9e, 0xd3, 0x8e, 0x8c, 0x62, 0x01, 0x43, 0x14,
-		0x32, 0x9d, 0x36, 0x19, 0xe3, 0x4c, 0xd9, 0xff, 0xd6, 0x99, 0xee, 0xc0, 0xa2, 0xeb, 0xb9, 0xd4,
-		0x75, 0x28, 0x69, 0xb2, 0xbc, 0x66, 0xf8, 0x50, 0xe6, 0x7a, 0x31, 0xa3, 0x59, 0xf8, 0x95, 0x04,
-		0xeb, 0xfa, 0x27, 0x4a, 0x02, 0xcf, 0x69, 0x7d, 0x2f, 0x6e, 0x79, 0x31, 0xa7, 0xcc, 0x70, 0x4e,
-		0xff, 0x9c, 0x85, 0xb5, 0x2a, 0xf1, 0x9a, 0xae, 0x77, 0xaa, 0x34, 0xa8, 0xfb, 0xc1, 0xa5, 0xe7,
-		0x3c, 0xa3, 0xdb, 0x90, 0x73, 0xc4, 0x75, 0xbf, 0xca, 0x10, 0x87, 0x8c, 0x26, 0xda, 0x83, 0xa5,
-		0x1e, 0x20, 0xd5, 0x92, 0x63, 0x69, 0x6e, 0xc9, 0x8b, 0x4e, 0xe2, 0x0a, 0xbd, 0x84, 0x59, 0x66,
-		0x8f, 0x91, 0x2b, 0x2f, 0x3f, 0x7a, 0x30, 0xda, 0x97, 0x06, 0x33, 0x64, 0x4e, 0x48, 0x70, 0xc4,
-		0x43, 0x06, 0xac, 0x9e, 0x11, 0x27, 0xa0, 0xc7, 0xc4, 0xa1, 0x76, 0x93, 0x50, 0xc7, 0x6d, 0x85,
-		0xc2, 0xa7, 0x6f, 0x8c, 0x31, 0xb9, 0xf3, 0x96, 0xef, 0x34, 0xb1, 0xdc, 0xa3, 0x69, 0x11, 0x0b,
-		0xbd, 0x86, 0xb5, 0x96, 0x13, 0x52, 0xbb, 0xaf, 0xc7, 0xad, 0x6d, 0x36, 0xd5, 0xda, 0x56, 0x19,
-		0x6d, 0x3f, 0x66, 0x71, 0x7b, 0xdb, 0x03, 0x1e, 0x8c, 0xa6, 0x82, 0x34, 0x23, 0xa5, 0xb9, 0x54,
-		0xa5, 0x15, 0x46, 0xaa, 0x45, 0x1c, 0xae, 0x93, 0x87, 0x4b, 0x0e, 0xa5, 0xa4, 0xdd, 0xa1, 0xdc,
-		0xb9, 0x67, 0x71, 0x7c, 0x89, 0x1e, 0x80, 0xdc, 0x76, 0x3e, 0xb9, 0xed, 0x6e, 0xdb, 0x16, 0xa1,
-		0x90, 0xbb, 0xf0, 0x2c, 0x5e, 0x11, 0x71, 0x45, 0x84, 0x99, 0x5d, 0x87, 0x8d, 0x33, 0xd2, 0xec,
-		0xb6, 0xe2, 0x4c, 0x16, 0xd2, 0xed, 0xba, 0xc7, 0xe0, 0x79, 0xa8, 0xb0, 0x42, 0x3e, 0x75, 0xdc,
-		0x68, 0x66, 0x23, 0x0d, 0x48, 0xd5, 0x58, 0xee, 0x53, 0xb8, 0xc8, 0x4b, 0x58, 0xe4, 0x45, 0x39,
-		0x71, 0xdc, 0x56, 0x37, 0x20, 0xc2, 0x6b, 0x47, 0x6f, 0xd3, 0x5e, 0x84, 0xc1, 0x39, 0xc6, 0x10,
-		0x17, 0xe8, 0x2b, 0xb8, 0xcc, 0x05, 0x58, 0xaf, 0x93, 0xc0, 0x76, 0x9b, 0xc4, 0xa3, 0x2e, 0x3d,
-		0x17, 0x76, 0x8b, 0xd8, 0xbd, 0x43, 0x7e, 0xcb, 0x10, 0x77, 0x0a, 0xbf, 0xcd, 0xc0, 0x55, 0xd1,
-		0x3e, 0xea, 0x99, 0xdb, 0x6a, 0x7e, 0x2f, 0x83, 0xf7, 0x65, 0x42, 0x96, 0x0d, 0x47, 0xd2, 0x8b,
-		0xe4, 0x8f, 0x89, 0xf3, 0x09, 0x77, 0xa4, 0x8b, 0x63, 0x9a, 0x1d, 0x1a, 0x53, 0xf4, 0x16, 0xc4,
-		0x6b, 0x58, 0x98, 0x6b, 0xc7, 0x6f, 0xb9, 0x8d, 0x73, 0xde, 0xe6, 0xcb, 0x63, 0x12, 0x8d, 0x9c,
-		0x93, 0x1b, 0x6a, 0x95, 0xa3, 0xf1, 0x6a, 0xe7, 0x62, 0xa8, 0xf0, 0xb7, 0x4c, 0x6f, 0xfc, 0x35,
-		0xd2, 0x70, 0xc3, 0xb8, 0x2e, 0xbd, 0xa9, 0x94, 0xd2, 0xa7, 0x32, 0x26, 0x0e, 0x4c, 0xe5, 0x70,
-		0xc7, 0x65, 0x3e, 0xb7, 0xe3, 0x5e, 0xc0, 0xe2, 0xc0, 0xf0, 0xa4, 0x1f, 0xdb, 0x72, 0xe1, 0xe8,
-		0xc1, 0x99, 0x19, 0x1c, 0x1c, 0x0c, 0x1b, 0x7e, 0xe0, 0x9e, 0xba, 0x9e, 0xd3, 0xb2, 0x2f, 0x24,
-		0x99, 0x3e, 0xea, 0xeb, 0x31, 0xb5, 0x96, 0x4c, 0xb6, 0xf0, 0xa7, 0x0c, 0x5c, 0x8d, 0xed, 0xa9,
-		0xec, 0x37, 0x9c, 0x96, 0xe6, 0x86, 0x1d, 0x87, 0x36, 0xce, 0xa6, 0x73, 0xd3, 0xff, 0x7d, 0xb9,
-		0x7e, 0x0e, 0xb7, 0x06, 0x33, 0xb0, 0xfd, 0x13, 0x9b, 0x9e, 0xb9, 0xa1, 0x9d, 0xac, 0xe2, 0x64,
-		0xc1, 0x6b, 0x03, 0x19, 0x55, 0x4e, 0xac, 0x33, 0x37, 0x14, 0x1e, 0x84, 0x6e, 0x02, 0xf0, 0x53,
-		0x02, 0xf5, 0xdf, 0x13, 0x8f, 0xd7, 0x79, 0x11, 0xf3, 0x63, 0x8d, 0xc5, 0x02, 0x85, 0xd7, 0x90,
-		0x4b, 0x9e, 0xa5, 0x9e, 0xc3, 0x9c, 0x38, 0x8e, 0x49, 0x9b, 0xd9, 0xad, 0xdc, 0xa3, 0x2f, 0x52,
-		0x8e, 0x63, 0xfc, 0xa4, 0x2a, 0x28, 0x85, 0x3f, 0x64, 0x60, 0x79, 0xf0, 0x16, 0xba, 0x0f, 0x2b,
-		0xc7, 0xae, 0xe7, 0x04, 0xe7, 0x76, 0xe3, 0x8c, 0x34, 0xde, 0x87, 0xdd, 0xb6, 0xd8, 0x84, 0xe5,
-		0x28, 0xac, 0x8a, 0x28, 0x5a, 0x87, 0xb9, 0xa0, 0xeb, 0xc5, 0x2f, 0xcb, 0x05, 0x3c, 0x1b, 0x74,
-		0xd9, 0xa9, 0xe2, 0x05, 0x5c, 0x3f, 0x71, 0x83, 0x90, 0xbd, 0x60, 0xa2, 0x66, 0xb7, 0x1b, 0x7e,
-		0xbb, 0xd3, 0x22, 0x03, 0x13, 0x9b, 0xe7, 0x90, 0x78, 0x1c, 0xd4, 0x18, 0xc0, 0xe9, 0x8b, 0x8d,
-		0x80, 0x38, 0xbd, 0xbd, 0x49, 0x2f, 0x65, 0x4e, 0xe0, 0x85, 0x6d, 0x2e, 0x71, 0x23, 0x75, 0xbd,
-		0xd3, 0x69, 0xdb, 0x74, 0x31, 0x26, 0x70, 0x81, 0x5b, 0x00, 0xfc, 0x8c, 0x4b, 0x9d, 0xe3, 0x56,
-		0xf4, 0x16, 0x9a, 0xc7, 0x89, 0x48, 0xf1, 0x8f, 0x12, 0x5c, 0x1e, 0xf5, 0x8e, 0x45, 0x05, 0xb8,
-		0x55, 0xd5, 0x4d, 0xcd, 0x30, 0x5f, 0xd9, 0x8a, 0x6a, 0x19, 0x6f, 0x0d, 0xeb, 0xc8, 0xae, 0x59,
-		0x8a, 0xa5, 0xdb, 0x86, 0xf9, 0x56, 0x29, 0x1b, 0x9a, 0xfc, 0x7f, 0xe8, 0xff, 0x61, 0x73, 0x0c,
-		0xa6, 0xa6, 0xee, 0xeb, 0x5a, 0xbd, 0xac, 0x6b, 0xb2, 0x34, 0x41, 0xa9, 0x66, 0x29, 0xd8, 0xd2,
-		0x35, 0x39, 0x83, 0x7e, 0x00, 0xf7, 0xc7, 0x60, 0x54, 0xc5, 0x54, 0xf5, 0xb2, 0x8d, 0xf5, 0x9f,
-		0xd6, 0xf5, 0x1a, 0x03, 0x67, 0x8b, 0xbf, 0xe8, 0xe7, 0x3c, 0xe0, 0x40, 0xc9, 0x27, 0x69, 0xba,
-		0x6a, 0xd4, 0x8c, 0x8a, 0x39, 0x29, 0xe7, 0x0b, 0x98, 0x31, 0x39, 0x5f, 0x44, 0xc5, 0x39, 0x17,
-		0x7f, 0x99, 0xe9, 0x7f, 0x02, 0x1b, 0x4d, 0x4c, 0xba, 0xb1, 0xb7, 0xb2, 0x67, 0x1c, 0x56, 0xf0,
-		0x9b, 0xbd, 0x72, 0xe5, 0xd0, 0x36, 0x34, 0x1b, 0xeb, 0xf5, 0x9a, 0x6e, 0x57, 0x2b, 0x65, 0x43,
-		0x3d, 0x4a, 0x64, 0xf2, 0x43, 0xf8, 0x7a, 0x2c, 0x4a, 0x29, 0xb3, 0xa8, 0x56, 0xaf, 0x96, 0x0d,
-		0x95, 0x3d, 0x75, 0x4f, 0x31, 0xca, 0xba, 0x66, 0x57, 0xcc, 0xf2, 0x91, 0x2c, 0xa1, 0x2f, 0x61,
-		0x6b, 0x5a, 0xa6, 0x9c, 0x41, 0x0f, 0xe1, 0xc1, 0x58, 0x34, 0xd6, 0x5f, 0xeb, 0xaa, 0x95, 0x80,
-		0x67, 0xd1, 0x0e, 0x3c, 0x1c, 0x0b, 0xb7, 0x74, 0x7c, 0x60, 0x98, 0xbc, 0xa0, 0x7b, 0x36, 0xae,
-		0x9b, 0xa6, 0x61, 0xbe, 0x92, 0x67, 0x8a, 0xbf, 0x93, 0x60, 0x75, 0xe8, 0xa5, 0x83, 0x6e, 0xc3,
-		0xf5, 0xaa, 0x82, 0x75, 0xd3, 0xb2, 0xd5, 0x72, 0x65, 0x54, 0x01, 0xc6, 0x00, 0x94, 0x5d, 0xc5,
-		0xd4, 0x2a, 0xa6, 0x2c, 0xa1, 0x7b, 0x50, 0x18, 0x05, 0x10, 0xbd, 0x20, 0x5a, 0x43, 0xce, 0xa0,
-		0x3b, 0x70, 0x73, 0x14, 0xae, 0x97, 0xad, 0x9c, 0x2d, 0xfe, 0x2b, 0x03, 0x37, 0x26, 0x7d, 0x69,
-		0xb3, 0x0e, 0xec, 0x2d, 0x5b, 0x7f, 0xa7, 0xab, 0x75, 0x8b, 0xed, 0x79, 0xa4, 0xc7, 0x76, 0xbe,
-		0x5e, 0x4b, 0x64, 0x9e, 0x2c, 0xe9, 0x18, 0xb0, 0x5a, 0x39, 0xa8, 0x96, 0x75, 0x8b, 0x77, 0x53,
-		0x11, 0xee, 0xa5, 0xc1, 0xa3, 0x0d, 0x96, 0x33, 0x03, 0x7b, 0x3b, 0x4e, 0x9a, 0xaf, 0x9b, 0x8d,
-		0x02, 0x2a, 0x41, 0x31, 0x0d, 0xdd, 0xab, 0x82, 0x26, 0xcf, 0xa0, 0xaf, 0xe1, 0xab, 0xf4, 0xc4,
-		0x4d, 0xcb, 0x30, 0xeb, 0xba, 0x66, 0x2b, 0x35, 0xdb, 0xd4, 0x0f, 0xe5, 0xd9, 0x69, 0x96, 0x6b,
-		0x19, 0x07, 0xac, 0x3f, 0xeb, 0x96, 0x3c, 0x57, 0xfc, 0xb3, 0x04, 0x57, 0x54, 0xdf, 0xa3, 0xae,
-		0xd7, 0x25, 0x4a, 0x68, 0x92, 0x8f, 0x46, 0x74, 0x9e, 0xf1, 0x03, 0x74, 0x17, 0xee, 0xc4, 0xfa,
-		0x42, 0xde, 0x36, 0x4c, 0xc3, 0x32, 0x14, 0xab, 0x82, 0x13, 0xf5, 0x9d, 0x08, 0x63, 0x03, 0xa9,
-		0xe9, 0x38, 0xaa, 0xeb, 0x78, 0x18, 0xd6, 0x2d, 0x7c, 0x24, 0x5a, 0x21, 0x72, 0x98, 0xf1, 0x58,
-		0x15, 0xb3, 0xf9, 0x16, 0xf3, 0x2f, 0x67, 0x8b, 0xbf, 0x97, 0x20, 0x27, 0xbe, 0x45, 0xf9, 0xa7,
-		0x4a, 0x1e, 0x2e, 0xb3, 0x05, 0x56, 0xea, 0x96, 0x6d, 0x1d, 0x55, 0xf5, 0xc1, 0x1e, 0x1e, 0xb8,
-		0xc3, 0xed, 0xc1, 0xb6, 0x2a, 0x51, 0x75, 0x22, 0x27, 0x19, 0x04, 0x88, 0xa7, 0x30, 0x0c, 0x07,
-		0xcb, 0x99, 0x89, 0x98, 0x48, 0x27, 0x8b, 0xae, 0xc1, 0x95, 0x01, 0xcc, 0xbe, 0xae, 0x60, 0x6b,
-		0x57, 0x57, 0x2c, 0x79, 0xa6, 0xf8, 0x1b, 0x09, 0xae, 0xc6, 0x4e, 0x68, 0xb1, 0x17, 0xab, 0xdb,
-		0x26, 0xcd, 0x4a, 0x97, 0xaa, 0x4e, 0x37, 0x24, 0xe8, 0x01, 0xdc, 0xed, 0x79, 0x98, 0xa5, 0xd4,
-		0xde, 0xf4, 0xf7, 0xca, 0x56, 0x15, 0x36, 0xdc, 0xfd, 0xd5, 0xa4, 0x42, 0x45, 0x0a, 0xb2, 0x84,
-		0xee, 0xc3, 0x17, 0x93, 0xa1, 0x58, 0xaf, 0xe9, 0x96, 0x9c, 0x29, 0xfe, 0x23, 0x07, 0x1b, 0xc9,
-		0xe4, 0xd8, 0x81, 0x9e, 0x34, 0xa3, 0xd4, 0xee, 0x41, 0x61, 0x50, 0x44, 0xf8, 0xdc, 0xc5, 0xbc,
-		0x76, 0xe0, 0xe1, 0x04, 0x5c, 0xdd, 0xdc, 0x57, 0x4c, 0x8d, 0x5d, 0xc7, 0x20, 0x59, 0x42, 0x2f,
-		0xe1, 0xf9, 0x04, 0xca, 0xae, 0xa2, 0xf5, 0xab, 0xdc, 0x7b, 0xe3, 0x28, 0x96, 0x85, 0x8d, 0xdd,
-		0xba, 0xa5, 0xd7, 0xe4, 0x0c, 0xd2, 0x41, 0x49, 0x11, 0x18, 0xf4, 0xa1, 0x91, 0x32, 0x59, 0xf4,
-		0x0c, 0x9e, 0xa4, 0xe5, 0x11, 0xb5, 0x8c, 0x71, 0xa0, 0xe3, 0x24, 0x75, 0x06, 0x7d, 0x0b, 0xdf,
-		0xa4, 0x50, 0xc5, 0x93, 0x87, 0xb8, 0xb3, 0xe8, 0x39, 0x3c, 0x4d, 0xcd, 0x5e, 0xad, 0x60, 0xcd,
-		0x3e, 0x50, 0xf0, 0x9b, 0x41, 0xf2, 0x1c, 0x32, 0x40, 0x4f, 0x7b, 0xb0, 0x70, 0x37, 0x7b, 0x84,
-		0x2f, 0x24, 0xa4, 0x2e, 0x4d, 0x51, 0x45, 0x16, 0x48, 0x91, 0x99, 0x47, 0xaf, 0x40, 0x9d, 0xae,
-		0x14, 0x93, 0x85, 0x16, 0xd0, 0x3b, 0xb0, 0x3e, 0x6f, 0x57, 0xf5, 0x77, 0x96, 0x8e, 0x4d, 0x25,
-		0x4d, 0x19, 0xd0, 0x0b, 0x78, 0x96, 0x5a, 0xb4, 0x41, 0xff, 0x49, 0xd0, 0x73, 0xe8, 0x29, 0x3c,
-		0x9e, 0x40, 0x4f, 0xf6, 0x48, 0xff, 0x54, 0x60, 0x68, 0xf2, 0x22, 0x7a, 0x02, 0x3b, 0x13, 0x88,
-		0x7c, 0x0a, 0xed, 0x9a, 0x65, 0xa8, 0x6f, 0x8e, 0xa2, 0xdb, 0x65, 0xa3, 0x66, 0xc9, 0x4b, 0xe8,
-		0x27, 0xf0, 0xa3, 0x09, 0xb4, 0xde, 0x62, 0xd9, 0x1f, 0x3a, 0x4e, 0x8c, 0x18, 0x83, 0xd5, 0xb1,
-		0x2e, 0x2f, 0x4f, 0xb1, 0x27, 0x35, 0xe3, 0x55, 0x7a, 0xe5, 0x56, 0x90, 0x0a, 0x2f, 0xa7, 0x1a,
-		0x11, 0x75, 0xdf, 0x28, 0x6b, 0xa3, 0x45, 0x64, 0xf4, 0x18, 0xb6, 0x27, 0x88, 0xec, 0x55, 0xb0,
-		0xaa, 0x8b, 0x37, 0x56, 0xcf, 0x24, 0x56, 0xd1, 0x37, 0xf0, 0x68, 0x12, 0x49, 0x31, 0xca, 0x95,
-		0xb7, 0x3a, 0xbe, 0xc8, 0x43, 0xec, 0x35, 0x3a, 0xdd, 0xd2, 0x0d, 0xb3, 0x5a, 0xb7, 0xec, 0x9a,
-		0xf1, 0x9d, 0x2e, 0xaf, 0xb1, 0xd7, 0x68, 0xea, 0x4e, 0xc5, 0xb5, 0x92, 0x2f, 0x0f, 0x9b, 0xf1,
-		0xd0, 0x43, 0x76, 0x0d, 0x53, 0xc1, 0x47, 0xf2, 0x7a, 0x4a, 0xef, 0x0d, 0x1b, 0xdd, 0x40, 0x0b,
-		0x5d, 0x99, 0x66, 0x39, 0xba, 0x82, 0xd5, 0xfd, 0x64, 0xc5, 0x37, 0xd8, 0x5b, 0xe7, 0x0e, 0xff,
-		0xc7, 0xca, 0xd0, 0xb9, 0x2a, 0x69, 0xf1, 0x3b, 0xf0, 0x30, 0xda, 0xb7, 0x11, 0x5d, 0x30, 0xc6,
-		0xed, 0x77, 0xe1, 0xc7, 0xd3, 0x51, 0x7a, 0xf7, 0x95, 0x32, 0xd6, 0x15, 0xed, 0xa8, 0x77, 0x24,
-		0x95, 0x8a, 0x7f, 0x95, 0xa0, 0xa8, 0x3a, 0x5e, 0x83, 0xb4, 0xe2, 0xff, 0xbb, 0x4e, 0xcc, 0xf2,
-		0x39, 0x3c, 0x9d, 0x62, 0xde, 0xc7, 0xe4, 0x7b, 0x08, 0xb5, 0xcf, 0x25, 0xd7, 0xcd, 0x37, 0x66,
-		0xe5, 0xd0, 0x9c, 0x44, 0x10, 0x8b, 0xa8, 0xb9, 0xa7, 0xfc, 0x9f, 0xc6, 0xd3, 0x2d, 0x42, 0xb4,
-		0xdd, 0x7f, 0xb6, 0x88, 0xcf, 0x25, 0x4f, 0xb5, 0x88, 0xdd, 0xb7, 0xb0, 0xd1, 0xf0, 0xdb, 0xa3,
-		0xbe, 0xe2, 0x77, 0xe7, 0x95, 0x8e, 0x5b, 0x65, 0x5f, 0xb0, 0x55, 0xe9, 0xbb, 0xed, 0x53, 0x97,
-		0x9e, 0x75, 0x8f, 0x4b, 0x0d, 0xbf, 0xbd, 0x3d, 0xf0, 0xfb, 0x63, 0xe9, 0x94, 0x78, 0xd1, 0xaf,
-		0x99, 0xe2, 0xa7, 0xc8, 0xe7, 0x4e, 0xc7, 0xfd, 0xb0, 0x73, 0x3c, 0xc7, 0x63, 0x8f, 0xff, 0x1d,
-		0x00, 0x00, 0xff, 0xff, 0x1c, 0xfe, 0xdc, 0x7d, 0x4a, 0x1d, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5d, 0x6f, 0xdb, 0xc8,
+		0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0x0f, 0x7a, 0x1c, 0xaf, 0xe5, 0x7c, 0x3a, 0xda, 0x37,
+		0x89, 0xa3, 0x6e, 0xe4, 0xb5, 0xb3, 0xd9, 0x34, 0x9b, 0x66, 0x53, 0x9a, 0x1c, 0xc7, 0x4c, 0x64,
+		0x4a, 0x1d, 0x52, 0x71, 0xbc, 0x28, 0x4a, 0xd0, 0x12, 0x6d, 0x13, 0x91, 0x48, 0x81, 0x1c, 0x25,
+		0xf1, 0x7d, 0x81, 0x5e, 0xf7, 0xae, 0x68, 0x6f, 0xfa, 0x03, 0x0a, 0x14, 0xfd, 0x01, 0x45, 0x8b,
+		0x5e, 0xf4, 0xa6, 0xe8, 0x5f, 0xe8, 0x45, 0xef, 0xfa, 0x2f, 0x8a, 0x19, 0x92, 0x12, 0xf5, 0x49,
+		0xa5, 0x05, 0xb6, 0x77, 0xd6, 0xe1, 0xf3, 0x3c, 0x3c, 0x73, 0xe6, 0x9c, 0x67, 0x46, 0x32, 0x14,
+		0x3a, 0xa7, 0xb6, 0xbf, 0x53, 0xb7, 0x1a, 0xb6, 0x5b, 0xb7, 0x77, 0xac, 0xb6, 0xb3, 0xf3, 0x7e,
+		0x77, 0xe7, 0x83, 0xe7, 0xbf, 0x3b, 0x6b, 0x7a, 0x1f, 0x4a, 0x6d, 0xdf, 0xa3, 0x1e, 0x5a, 0x63,
+		0x98, 0x52, 0x84, 0x29, 0x59, 0x6d, 0xa7, 0xf4, 0x7e, 0xf7, 0xda, 0xad, 0x73, 0xcf, 0x3b, 0x6f,
+		0xda, 0x3b, 0x1c, 0x72, 0xda, 0x39, 0xdb, 0x69, 0x74, 0x7c, 0x8b, 0x3a, 0x9e, 0x1b, 0x92, 0xae,
+		0xdd, 0x1e, 0x7c, 0x4e, 0x9d, 0x96, 0x1d, 0x50, 0xab, 0xd5, 0x8e, 0x00, 0x5b, 0xa3, 0xde, 0x5c,
+		0xf7, 0x5a, 0xad, 0xae, 0xc4, 0xc8, 0xdc, 0xa8, 0x15, 0xbc, 0x6b, 0x3a, 0x01, 0x0d, 0x31, 0x85,
+		0x3f, 0xcf, 0xc1, 0xfa, 0x71, 0x94, 0x2e, 0xfe, 0x68, 0xd7, 0x3b, 0x2c, 0x05, 0xd5, 0x3d, 0xf3,
+		0x50, 0x0d, 0x50, 0xbc, 0x0e, 0xd3, 0x8e, 0x9f, 0xe4, 0x85, 0x2d, 0x61, 0x3b, 0xb7, 0x77, 0xaf,
+		0x34, 0x62, 0x49, 0xa5, 0x21, 0x1d, 0xb2, 0xfa, 0x61, 0x30, 0x84, 0x1e, 0xc3, 0x0c, 0xbd, 0x6c,
+		0xdb, 0xf9, 0x0c, 0x17, 0xba, 0x33, 0x51, 0xc8, 0xb8, 0x6c, 0xdb, 0x84, 0xc3, 0xd1, 0x53, 0x80,
+		0x80, 0x5a, 0x3e, 0x35, 0x59, 0x19, 0xf2, 0x59, 0x4e, 0xbe, 0x56, 0x0a, 0x6b, 0x54, 0x8a, 0x6b,
+		0x54, 0x32, 0xe2, 0x1a, 0x91, 0x05, 0x8e, 0x66, 0x9f, 0x19, 0xb5, 0xde, 0xf4, 0x02, 0x3b, 0xa4,
+		0xce, 0xa4, 0x53, 0x39, 0x9a, 0x53, 0x0d, 0x58, 0x0c, 0xa9, 0x01, 0xb5, 0x68, 0x27, 0xc8, 0xcf,
+		0x6e, 0x09, 0xdb, 0xcb, 0x7b, 0xbb, 0xd3, 0xad, 0x5e, 0x66, 0x4c, 0x9d, 0x13, 0x49, 0xae, 0xde,
+		0xfb, 0x80, 0xee, 0xc2, 0xf2, 0x85, 0x13, 0x50, 0xcf, 0xbf, 0x34, 0x9b, 0xb6, 0x7b, 0x4e, 0x2f,
+		0xf2, 0x73, 0x5b, 0xc2, 0x76, 0x96, 0x2c, 0x45, 0xd1, 0x32, 0x0f, 0xa2, 0x9f, 0xc2, 0x7a, 0xdb,
+		0xf2, 0x6d, 0x97, 0xf6, 0xca, 0x6f, 0x3a, 0xee, 0x99, 0x97, 0xbf, 0xc2, 0x97, 0xb0, 0x3d, 0x32,
+		0x8b, 0x2a, 0x67, 0xf4, 0xed, 0x24, 0x59, 0x6b, 0x0f, 0x07, 0x91, 0x04, 0xcb, 0x3d, 0x59, 0x5e,
+		0x99, 0xf9, 0xd4, 0xca, 0x2c, 0x75, 0x19, 0xbc, 0x3a, 0x0f, 0x61, 0xa6, 0x65, 0xb7, 0xbc, 0xfc,
+		0x02, 0x27, 0x6e, 0x8e, 0xcc, 0xe7, 0xc8, 0x6e, 0x79, 0x84, 0xc3, 0x10, 0x81, 0xd5, 0xc0, 0xb6,
+		0xfc, 0xfa, 0x85, 0x69, 0x51, 0xea, 0x3b, 0xa7, 0x1d, 0x6a, 0x07, 0x79, 0xe0, 0xdc, 0xbb, 0x23,
+		0xb9, 0x3a, 0x47, 0x4b, 0x5d, 0x30, 0x11, 0x83, 0x81, 0x08, 0x2a, 0xc3, 0xaa, 0xd5, 0xa1, 0x9e,
+		0xe9, 0xdb, 0x81, 0x4d, 0xcd, 0xb6, 0xe7, 0xb8, 0x34, 0xc8, 0xe7, 0xb8, 0xe6, 0xd6, 0x48, 0x4d,
+		0xc2, 0x80, 0x55, 0x8e, 0x23, 0x2b, 0x8c, 0x9a, 0x08, 0xa0, 0xeb, 0xb0, 0xc0, 0xc6, 0xc3, 0x64,
+		0xf3, 0x91, 0x5f, 0xdc, 0x12, 0xb6, 0x17, 0xc8, 0x3c, 0x0b, 0x94, 0x9d, 0x80, 0xa2, 0x0d, 0xb8,
+		0xe2, 0x04, 0x66, 0xdd, 0xf7, 0xdc, 0xfc, 0xd2, 0x96, 0xb0, 0x3d, 0x4f, 0xe6, 0x9c, 0x40, 0xf6,
+		0x3d, 0xb7, 0xf0, 0xab, 0x0c, 0xdc, 0x1a, 0xde, 0x7c, 0xcf, 0x3d, 0x73, 0xce, 0xa3, 0x91, 0x46,
+		0xdf, 0x24, 0x85, 0xc3, 0x11, 0xba, 0x39, 0x32, 0x3d, 0x23, 0x7a, 0x5b, 0xe2, 0xbd, 0x16, 0x6c,
+		0xf5, 0x36, 0x2a, 0x9a, 0x01, 0xcf, 0xec, 0x75, 0xb4, 0xd7, 0xa1, 0xd1, 0x30, 0x6d, 0x0e, 0x6d,
+		0x9d, 0x12, 0x25, 0x40, 0x6e, 0x74, 0x25, 0x74, 0x3e, 0x17, 0x9e, 0x1c, 0xf7, 0xb8, 0xd7, 0xa1,
+		0xe8, 0x18, 0xae, 0xf3, 0xf4, 0xc6, 0xa8, 0x67, 0xd3, 0xd4, 0x37, 0x18, 0x7b, 0x84, 0x70, 0xe1,
+		0xef, 0x02, 0xac, 0x8d, 0xe8, 0x48, 0x56, 0xe8, 0x86, 0xd7, 0xb2, 0x1c, 0xd7, 0x74, 0x1a, 0xbc,
+		0x1e, 0x0b, 0x64, 0x3e, 0x0c, 0xa8, 0x0d, 0x74, 0x1b, 0x72, 0xd1, 0x43, 0xd7, 0x6a, 0x85, 0x46,
+		0xb1, 0x40, 0x20, 0x0c, 0x69, 0x56, 0xcb, 0x1e, 0xe3, 0x4c, 0xd9, 0xff, 0xd6, 0x99, 0xee, 0xc0,
+		0xa2, 0xe3, 0x3a, 0xd4, 0xb1, 0xa8, 0xdd, 0x60, 0x79, 0xcd, 0xf0, 0xa1, 0xcc, 0x75, 0x63, 0x6a,
+		0xa3, 0xf0, 0x4b, 0x01, 0xd6, 0xf1, 0x47, 0x6a, 0xfb, 0xae, 0xd5, 0xfc, 0x5e, 0xdc, 0x72, 0x30,
+		0xa7, 0xcc, 0x70, 0x4e, 0xff, 0x98, 0x85, 0xb5, 0xaa, 0xed, 0x36, 0x1c, 0xf7, 0x5c, 0xaa, 0x53,
+		0xe7, 0xbd, 0x43, 0x2f, 0x79, 0x46, 0xb7, 0x21, 0x67, 0x45, 0x9f, 0x7b, 0x55, 0x86, 0x38, 0xa4,
+		0x36, 0xd0, 0x01, 0x2c, 0x75, 0x01, 0xa9, 0x96, 0x1c, 0x4b, 0x73, 0x4b, 0x5e, 0xb4, 0x12, 0x9f,
+		0xd0, 0x0b, 0x98, 0x65, 0xf6, 0x18, 0xba, 0xf2, 0xf2, 0xde, 0x83, 0xd1, 0xbe, 0xd4, 0x9f, 0x21,
+		0x73, 0x42, 0x9b, 0x84, 0x3c, 0xa4, 0xc2, 0xea, 0x85, 0x6d, 0xf9, 0xf4, 0xd4, 0xb6, 0xa8, 0xd9,
+		0xb0, 0xa9, 0xe5, 0x34, 0x83, 0xc8, 0xa7, 0x6f, 0x8c, 0x31, 0xb9, 0xcb, 0xa6, 0x67, 0x35, 0x88,
+		0xd8, 0xa5, 0x29, 0x21, 0x0b, 0xbd, 0x82, 0xb5, 0xa6, 0x15, 0x50, 0xb3, 0xa7, 0xc7, 0xad, 0x6d,
+		0x36, 0xd5, 0xda, 0x56, 0x19, 0xed, 0x30, 0x66, 0xb1, 0x38, 0x3a, 0x00, 0x1e, 0x0c, 0xa7, 0xc2,
+		0x6e, 0x84, 0x4a, 0x73, 0xa9, 0x4a, 0x2b, 0x8c, 0xa4, 0x87, 0x1c, 0xae, 0x93, 0x87, 0x2b, 0x16,
+		0xa5, 0x76, 0xab, 0x4d, 0xb9, 0x73, 0xcf, 0x92, 0xf8, 0x23, 0x7a, 0x00, 0x62, 0xcb, 0xfa, 0xe8,
+		0xb4, 0x3a, 0x2d, 0x33, 0x0a, 0x05, 0xdc, 0x85, 0x67, 0xc9, 0x4a, 0x14, 0x97, 0xa2, 0x30, 0xb3,
+		0xeb, 0xa0, 0x7e, 0x61, 0x37, 0x3a, 0xcd, 0x38, 0x93, 0x85, 0x74, 0xbb, 0xee, 0x32, 0x78, 0x1e,
+		0x32, 0xac, 0xd8, 0x1f, 0xdb, 0x4e, 0x38, 0xb3, 0xa1, 0x06, 0xa4, 0x6a, 0x2c, 0xf7, 0x28, 0x5c,
+		0xe4, 0x05, 0x2c, 0xf2, 0xa2, 0x9c, 0x59, 0x4e, 0xb3, 0xe3, 0xdb, 0xf9, 0xdc, 0x84, 0x6d, 0x3a,
+		0x08, 0x31, 0x24, 0xc7, 0x18, 0xd1, 0x07, 0xf4, 0x25, 0x5c, 0xe5, 0x02, 0xac, 0xd7, 0x6d, 0xdf,
+		0x74, 0x1a, 0xb6, 0x4b, 0x1d, 0x7a, 0x19, 0xd9, 0x2d, 0x62, 0xcf, 0x8e, 0xf9, 0x23, 0x35, 0x7a,
+		0x52, 0xf8, 0x4d, 0x06, 0x36, 0xa3, 0xf6, 0x91, 0x2f, 0x9c, 0x66, 0xe3, 0x7b, 0x19, 0xbc, 0x2f,
+		0x12, 0xb2, 0x6c, 0x38, 0x92, 0x5e, 0x24, 0x7e, 0x48, 0xdc, 0x4f, 0xb8, 0x23, 0x0d, 0x8e, 0x69,
+		0x76, 0x68, 0x4c, 0xd1, 0x1b, 0x88, 0x8e, 0xe1, 0xc8, 0x5c, 0xdb, 0x5e, 0xd3, 0xa9, 0x5f, 0xf2,
+		0x36, 0x5f, 0x1e, 0x93, 0x68, 0xe8, 0x9c, 0xdc, 0x50, 0xab, 0x1c, 0x4d, 0x56, 0xdb, 0x83, 0xa1,
+		0xc2, 0x5f, 0x33, 0xdd, 0xf1, 0x57, 0xec, 0xba, 0x13, 0xc4, 0x75, 0xe9, 0x4e, 0xa5, 0x90, 0x3e,
+		0x95, 0x31, 0xb1, 0x6f, 0x2a, 0x87, 0x3b, 0x2e, 0xf3, 0xa9, 0x1d, 0xf7, 0x1c, 0x16, 0xfb, 0x86,
+		0x27, 0xfd, 0xda, 0x96, 0x0b, 0x46, 0x0f, 0xce, 0x4c, 0xff, 0xe0, 0x10, 0xd8, 0xf0, 0x7c, 0xe7,
+		0xdc, 0x71, 0xad, 0xa6, 0x39, 0x90, 0x64, 0xfa, 0xa8, 0xaf, 0xc7, 0x54, 0x3d, 0x99, 0x6c, 0xe1,
+		0x8f, 0x19, 0xd8, 0x8c, 0xed, 0xa9, 0xec, 0xd5, 0xad, 0xa6, 0xe2, 0x04, 0x6d, 0x8b, 0xd6, 0x2f,
+		0xa6, 0x73, 0xd3, 0xff, 0x7d, 0xb9, 0x7e, 0x06, 0xb7, 0xfa, 0x33, 0x30, 0xbd, 0x33, 0x93, 0x5e,
+		0x38, 0x81, 0x99, 0xac, 0xe2, 0x64, 0xc1, 0x6b, 0x7d, 0x19, 0x55, 0xce, 0x8c, 0x0b, 0x27, 0x88,
+		0x3c, 0x08, 0xdd, 0x04, 0xe0, 0xb7, 0x04, 0xea, 0xbd, 0xb3, 0x5d, 0x5e, 0xe7, 0x45, 0xc2, 0xaf,
+		0x35, 0x06, 0x0b, 0x14, 0x5e, 0x41, 0x2e, 0x79, 0x97, 0x7a, 0x06, 0x73, 0xd1, 0x75, 0x4c, 0xd8,
+		0xca, 0x6e, 0xe7, 0xf6, 0x3e, 0x4f, 0xb9, 0x8e, 0xf1, 0x9b, 0x6a, 0x44, 0x29, 0xfc, 0x3e, 0x03,
+		0xcb, 0xfd, 0x8f, 0xd0, 0x7d, 0x58, 0x39, 0x75, 0x5c, 0xcb, 0xbf, 0x34, 0xeb, 0x17, 0x76, 0xfd,
+		0x5d, 0xd0, 0x69, 0x45, 0x9b, 0xb0, 0x1c, 0x86, 0xe5, 0x28, 0x8a, 0xd6, 0x61, 0xce, 0xef, 0xb8,
+		0xf1, 0x61, 0xb9, 0x40, 0x66, 0xfd, 0x0e, 0xbb, 0x55, 0x3c, 0x87, 0xeb, 0x67, 0x8e, 0x1f, 0xb0,
+		0x03, 0x26, 0x6c, 0x76, 0xb3, 0xee, 0xb5, 0xda, 0x4d, 0xbb, 0x6f, 0x62, 0xf3, 0x1c, 0x12, 0x8f,
+		0x83, 0x1c, 0x03, 0x38, 0x7d, 0xb1, 0xee, 0xdb, 0x56, 0x77, 0x6f, 0xd2, 0x4b, 0x99, 0x8b, 0xf0,
+		0x91, 0x6d, 0x2e, 0x71, 0x23, 0x75, 0xdc, 0xf3, 0x69, 0xdb, 0x74, 0x31, 0x26, 0x70, 0x81, 0x5b,
+		0x00, 0xfc, 0x8e, 0x4b, 0xad, 0xd3, 0x66, 0x78, 0x0a, 0xcd, 0x93, 0x44, 0xa4, 0xf8, 0x07, 0x01,
+		0xae, 0x8e, 0x3a, 0x63, 0x51, 0x01, 0x6e, 0x55, 0xb1, 0xa6, 0xa8, 0xda, 0x4b, 0x53, 0x92, 0x0d,
+		0xf5, 0x8d, 0x6a, 0x9c, 0x98, 0xba, 0x21, 0x19, 0xd8, 0x54, 0xb5, 0x37, 0x52, 0x59, 0x55, 0xc4,
+		0xff, 0x43, 0xff, 0x0f, 0x5b, 0x63, 0x30, 0xba, 0x7c, 0x88, 0x95, 0x5a, 0x19, 0x2b, 0xa2, 0x30,
+		0x41, 0x49, 0x37, 0x24, 0x62, 0x60, 0x45, 0xcc, 0xa0, 0x1f, 0xc0, 0xfd, 0x31, 0x18, 0x59, 0xd2,
+		0x64, 0x5c, 0x36, 0x09, 0xfe, 0x49, 0x0d, 0xeb, 0x0c, 0x9c, 0x2d, 0xfe, 0xbc, 0x97, 0x73, 0x9f,
+		0x03, 0x25, 0xdf, 0xa4, 0x60, 0x59, 0xd5, 0xd5, 0x8a, 0x36, 0x29, 0xe7, 0x01, 0xcc, 0x98, 0x9c,
+		0x07, 0x51, 0x71, 0xce, 0xc5, 0x5f, 0x64, 0x7a, 0x5f, 0x81, 0xd5, 0x06, 0xb1, 0x3b, 0xb1, 0xb7,
+		0xb2, 0x77, 0x1c, 0x57, 0xc8, 0xeb, 0x83, 0x72, 0xe5, 0xd8, 0x54, 0x15, 0x93, 0xe0, 0x9a, 0x8e,
+		0xcd, 0x6a, 0xa5, 0xac, 0xca, 0x27, 0x89, 0x4c, 0x7e, 0x08, 0x5f, 0x8d, 0x45, 0x49, 0x65, 0x16,
+		0x55, 0x6a, 0xd5, 0xb2, 0x2a, 0xb3, 0xb7, 0x1e, 0x48, 0x6a, 0x19, 0x2b, 0x66, 0x45, 0x2b, 0x9f,
+		0x88, 0x02, 0xfa, 0x02, 0xb6, 0xa7, 0x65, 0x8a, 0x19, 0xf4, 0x10, 0x1e, 0x8c, 0x45, 0x13, 0xfc,
+		0x0a, 0xcb, 0x46, 0x02, 0x9e, 0x45, 0xbb, 0xf0, 0x70, 0x2c, 0xdc, 0xc0, 0xe4, 0x48, 0xd5, 0x78,
+		0x41, 0x0f, 0x4c, 0x52, 0xd3, 0x34, 0x55, 0x7b, 0x29, 0xce, 0x14, 0xff, 0x26, 0x40, 0xbe, 0x57,
+		0x09, 0xf6, 0x15, 0xa6, 0xe9, 0xd4, 0x69, 0x54, 0x8c, 0xfb, 0xf0, 0x79, 0x52, 0x4f, 0xae, 0x68,
+		0x07, 0x65, 0x55, 0x36, 0x86, 0xeb, 0x31, 0x50, 0xb5, 0x41, 0x20, 0x2b, 0xc1, 0xf0, 0xda, 0x07,
+		0x51, 0x2c, 0x59, 0xfc, 0x56, 0xd5, 0x0d, 0x96, 0x59, 0x06, 0xed, 0x41, 0x69, 0x12, 0xba, 0xb7,
+		0x9e, 0x2e, 0x27, 0x5b, 0xfc, 0xad, 0x00, 0xab, 0x43, 0x47, 0x28, 0xba, 0x0d, 0xd7, 0xab, 0x12,
+		0xc1, 0x9a, 0x61, 0xca, 0xe5, 0xca, 0xa8, 0xed, 0x1c, 0x03, 0x90, 0xf6, 0x25, 0x4d, 0xa9, 0x68,
+		0xa2, 0x80, 0xee, 0x41, 0x61, 0x14, 0x20, 0xea, 0xec, 0xa8, 0xd1, 0xc5, 0x0c, 0xba, 0x03, 0x37,
+		0x47, 0xe1, 0xba, 0xb9, 0x8a, 0xd9, 0xe2, 0xbf, 0x32, 0x70, 0x63, 0xd2, 0xef, 0x06, 0x6c, 0x9e,
+		0xba, 0xeb, 0xc6, 0x6f, 0xb1, 0x5c, 0x33, 0x58, 0x07, 0x87, 0x7a, 0xac, 0x8f, 0x6b, 0x7a, 0x22,
+		0xf3, 0x64, 0x83, 0x8c, 0x01, 0xcb, 0x95, 0xa3, 0x6a, 0x19, 0x1b, 0x7c, 0x36, 0x8a, 0x70, 0x2f,
+		0x0d, 0x1e, 0xb6, 0xab, 0x98, 0xe9, 0xdb, 0xad, 0x71, 0xd2, 0x7c, 0xdd, 0x6c, 0xb0, 0x51, 0x09,
+		0x8a, 0x69, 0xe8, 0x6e, 0x15, 0x14, 0x71, 0x06, 0x7d, 0x05, 0x5f, 0xa6, 0x27, 0xae, 0x19, 0xaa,
+		0x56, 0xc3, 0x8a, 0x29, 0xe9, 0xa6, 0x86, 0x8f, 0xc5, 0xd9, 0x69, 0x96, 0x6b, 0xa8, 0x47, 0x6c,
+		0xda, 0x6a, 0x86, 0x38, 0x57, 0xfc, 0x93, 0x00, 0x9f, 0xc9, 0x9e, 0x4b, 0x1d, 0xb7, 0x63, 0x4b,
+		0x81, 0x66, 0x7f, 0x50, 0xc3, 0xdb, 0x99, 0xe7, 0xa3, 0xbb, 0x70, 0x27, 0xd6, 0x8f, 0xe4, 0x4d,
+		0x55, 0x53, 0x0d, 0x55, 0x32, 0x2a, 0x24, 0x51, 0xdf, 0x89, 0x30, 0x66, 0x2f, 0x0a, 0x26, 0x61,
+		0x5d, 0xc7, 0xc3, 0x08, 0x36, 0xc8, 0x49, 0xd4, 0x0a, 0xa1, 0x5f, 0x8e, 0xc7, 0xca, 0xa4, 0xa2,
+		0x75, 0xdd, 0x4c, 0xcc, 0x16, 0x7f, 0x27, 0x40, 0x2e, 0xfa, 0x66, 0xcd, 0xbf, 0x78, 0xe5, 0xe1,
+		0x2a, 0x5b, 0x60, 0xa5, 0x66, 0x98, 0xc6, 0x49, 0x15, 0xf7, 0xf7, 0x70, 0xdf, 0x13, 0x6e, 0x76,
+		0xa6, 0x51, 0x09, 0xab, 0x13, 0xfa, 0x62, 0x3f, 0x20, 0x7a, 0x0b, 0xc3, 0x70, 0xb0, 0x98, 0x99,
+		0x88, 0x09, 0x75, 0xb2, 0xe8, 0x1a, 0x7c, 0xd6, 0x87, 0x39, 0xc4, 0x12, 0x31, 0xf6, 0xb1, 0x64,
+		0x88, 0x33, 0xc5, 0x5f, 0x0b, 0xb0, 0x19, 0xfb, 0x3a, 0xfb, 0x5d, 0x83, 0xa5, 0xde, 0xa8, 0x74,
+		0xa8, 0x6c, 0x75, 0x02, 0x1b, 0x3d, 0x80, 0xbb, 0x5d, 0x47, 0x36, 0x24, 0xfd, 0x75, 0x6f, 0xaf,
+		0x4c, 0x59, 0xaa, 0xe9, 0xc9, 0xd5, 0xa4, 0x42, 0xa3, 0x14, 0x44, 0x81, 0x99, 0xd4, 0x64, 0x28,
+		0xc1, 0x3a, 0x36, 0xc4, 0x4c, 0xf1, 0x9f, 0x8b, 0xb0, 0x91, 0x4c, 0x8e, 0x7d, 0x3d, 0xb1, 0x1b,
+		0x61, 0x6a, 0xf7, 0xa0, 0xd0, 0x2f, 0x12, 0xb9, 0xf6, 0x60, 0x5e, 0xbb, 0xf0, 0x70, 0x02, 0xae,
+		0xa6, 0x1d, 0x4a, 0x9a, 0xc2, 0x3e, 0xc7, 0x20, 0x51, 0x40, 0x2f, 0xe0, 0xd9, 0x04, 0xca, 0xbe,
+		0xa4, 0xf4, 0xaa, 0xdc, 0x3d, 0x3f, 0x25, 0xc3, 0x20, 0xea, 0x7e, 0xcd, 0xc0, 0xba, 0x98, 0x41,
+		0x18, 0xa4, 0x14, 0x81, 0x7e, 0x1f, 0x1a, 0x29, 0x93, 0x45, 0x4f, 0xe1, 0x71, 0x5a, 0x1e, 0x61,
+		0xcb, 0xa8, 0x47, 0x98, 0x24, 0xa9, 0x33, 0xe8, 0x1b, 0xf8, 0x3a, 0x85, 0x1a, 0xbd, 0x79, 0x88,
+		0x3b, 0x8b, 0x9e, 0xc1, 0x93, 0xd4, 0xec, 0xe5, 0x0a, 0x51, 0xcc, 0x23, 0x89, 0xbc, 0xee, 0x27,
+		0xcf, 0x21, 0x15, 0x70, 0xda, 0x8b, 0x23, 0x77, 0x33, 0x47, 0xf8, 0x42, 0x42, 0xea, 0xca, 0x14,
+		0x55, 0x64, 0x81, 0x14, 0x99, 0x79, 0xf4, 0x12, 0xe4, 0xe9, 0x4a, 0x31, 0x59, 0x68, 0x01, 0xbd,
+		0x05, 0xe3, 0xd3, 0x76, 0x15, 0xbf, 0x35, 0x30, 0xd1, 0xa4, 0x34, 0x65, 0x40, 0xcf, 0xe1, 0x69,
+		0x6a, 0xd1, 0xfa, 0xfd, 0x27, 0x41, 0xcf, 0xa1, 0x27, 0xf0, 0x68, 0x02, 0x3d, 0xd9, 0x23, 0xbd,
+		0x3b, 0x8e, 0xaa, 0x88, 0x8b, 0xe8, 0x31, 0xec, 0x4e, 0x20, 0xf2, 0x29, 0x34, 0x75, 0x43, 0x95,
+		0x5f, 0x9f, 0x84, 0x8f, 0xcb, 0xaa, 0x6e, 0x88, 0x4b, 0xe8, 0xc7, 0xf0, 0xa3, 0x09, 0xb4, 0xee,
+		0x62, 0xd9, 0x1f, 0x98, 0x24, 0x46, 0x8c, 0xc1, 0x6a, 0x04, 0x8b, 0xcb, 0x53, 0xec, 0x89, 0xae,
+		0xbe, 0x4c, 0xaf, 0xdc, 0x0a, 0x92, 0xe1, 0xc5, 0x54, 0x23, 0x22, 0x1f, 0xaa, 0x65, 0x65, 0xb4,
+		0x88, 0x88, 0x1e, 0xc1, 0xce, 0x04, 0x91, 0x83, 0x0a, 0x91, 0x71, 0x74, 0x62, 0x75, 0x4d, 0x62,
+		0x15, 0x7d, 0x0d, 0x7b, 0x93, 0x48, 0x92, 0x5a, 0xae, 0xbc, 0xc1, 0x64, 0x90, 0x87, 0xd8, 0x31,
+		0x3a, 0xdd, 0xd2, 0x55, 0xad, 0x5a, 0x33, 0x4c, 0x5d, 0xfd, 0x0e, 0x8b, 0x6b, 0xec, 0x18, 0x4d,
+		0xdd, 0xa9, 0xb8, 0x56, 0xe2, 0xd5, 0x61, 0x33, 0x1e, 0x7a, 0xc9, 0xbe, 0xaa, 0x49, 0xe4, 0x44,
+		0x5c, 0x4f, 0xe9, 0xbd, 0x61, 0xa3, 0xeb, 0x6b, 0xa1, 0xcf, 0xa6, 0x59, 0x0e, 0x96, 0x88, 0x7c,
+		0x98, 0xac, 0xf8, 0x06, 0x3a, 0x80, 0xfd, 0x4f, 0x76, 0x89, 0x5a, 0x55, 0x61, 0x6f, 0x4d, 0xe8,
+		0xe4, 0xd1, 0x3e, 0x7c, 0x9b, 0xa2, 0x53, 0xab, 0xea, 0x98, 0xf4, 0x6a, 0x63, 0x1e, 0xe1, 0xa3,
+		0x4a, 0x52, 0x63, 0x93, 0x9d, 0x80, 0x77, 0xf8, 0x4f, 0x56, 0x43, 0x77, 0xbc, 0xe4, 0x71, 0xb3,
+		0x0b, 0x0f, 0xc3, 0x1e, 0x1a, 0xd1, 0x91, 0x63, 0x4e, 0x9e, 0x7d, 0xf8, 0x76, 0x3a, 0x4a, 0xf7,
+		0xb9, 0x54, 0x26, 0x58, 0x52, 0x4e, 0xba, 0x97, 0x7d, 0xa1, 0xf8, 0x17, 0x01, 0x8a, 0xb2, 0xe5,
+		0xd6, 0xed, 0x66, 0xfc, 0x8b, 0xf6, 0xc4, 0x2c, 0x9f, 0xc1, 0x93, 0x29, 0xbc, 0x67, 0x4c, 0xbe,
+		0xc7, 0xa0, 0x7f, 0x2a, 0xb9, 0xa6, 0xbd, 0xd6, 0x2a, 0xc7, 0xda, 0x24, 0x42, 0xb4, 0x08, 0xdd,
+		0x39, 0x77, 0xad, 0xa9, 0x17, 0x11, 0x8d, 0xc0, 0x7f, 0xb6, 0x88, 0x4f, 0x25, 0x4f, 0xb5, 0x88,
+		0xfd, 0x37, 0xb0, 0x51, 0xf7, 0x5a, 0xa3, 0x7e, 0x1f, 0xd9, 0x9f, 0x97, 0xda, 0x4e, 0x95, 0xfd,
+		0x36, 0x50, 0x15, 0xbe, 0xdb, 0x39, 0x77, 0xe8, 0x45, 0xe7, 0xb4, 0x54, 0xf7, 0x5a, 0x3b, 0x7d,
+		0xff, 0xd9, 0x2d, 0x9d, 0xdb, 0x6e, 0xf8, 0x7f, 0xe2, 0xe8, 0x9f, 0xbc, 0xcf, 0xac, 0xb6, 0xf3,
+		0x7e, 0xf7, 0x74, 0x8e, 0xc7, 0x1e, 0xfd, 0x7b, 0x00, 0xa1, 0x49, 0xb6, 0x43, 0xa4, 0x1e, 0x00,
+		0x00,
 	},
 	// google/protobuf/timestamp.proto
 	[]byte{
@@ -291,57 +297,58 @@ var yarpcFileDescriptorClosure91769cfce21084c6 = [][]byte{
 		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
 		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
 		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
-		0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/tasklist.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xdb, 0x36,
-		0x14, 0x9e, 0xe2, 0xb4, 0x73, 0x98, 0x25, 0xd5, 0xb8, 0xb5, 0x8d, 0xdd, 0x75, 0xcb, 0x74, 0x51,
-		0x04, 0xc5, 0x20, 0x21, 0x19, 0x76, 0xb5, 0x8b, 0xc1, 0x89, 0x83, 0x55, 0x88, 0xe3, 0x1a, 0x92,
-		0x1a, 0x20, 0xbb, 0xe1, 0x28, 0xf1, 0xd4, 0x21, 0xf4, 0x43, 0x81, 0xa4, 0x92, 0xf8, 0x45, 0xf6,
-		0x30, 0x7b, 0xa2, 0x3d, 0xc6, 0x40, 0x4a, 0xf6, 0xdc, 0xc4, 0xeb, 0x1d, 0x79, 0xbe, 0xf3, 0x9d,
-		0x9f, 0x8f, 0xe7, 0x10, 0x79, 0x4d, 0x0a, 0x32, 0xc8, 0x28, 0x83, 0x2a, 0x83, 0x80, 0xd6, 0x3c,
-		0xb8, 0x3d, 0x0e, 0x34, 0x55, 0x79, 0xc1, 0x95, 0xf6, 0x6b, 0x29, 0xb4, 0xc0, 0xdf, 0x18, 0x1f,
-		0xbf, 0xf3, 0xf1, 0x69, 0xcd, 0xfd, 0xdb, 0xe3, 0xe1, 0xf7, 0x73, 0x21, 0xe6, 0x05, 0x04, 0xd6,
-		0x25, 0x6d, 0x3e, 0x06, 0xac, 0x91, 0x54, 0x73, 0x51, 0xb5, 0xa4, 0xe1, 0x0f, 0x0f, 0x71, 0xcd,
-		0x4b, 0x50, 0x9a, 0x96, 0x75, 0xe7, 0xf0, 0x28, 0xc0, 0x9d, 0xa4, 0x75, 0x0d, 0x52, 0xb5, 0xb8,
-		0xf7, 0x01, 0xf5, 0x13, 0xaa, 0xf2, 0x09, 0x57, 0x1a, 0x63, 0xb4, 0x5d, 0xd1, 0x12, 0x0e, 0x9c,
-		0x43, 0xe7, 0x68, 0x27, 0xb2, 0x67, 0xfc, 0x0b, 0xda, 0xce, 0x79, 0xc5, 0x0e, 0xb6, 0x0e, 0x9d,
-		0xa3, 0xfd, 0x93, 0x1f, 0xfd, 0x0d, 0x45, 0xfa, 0xcb, 0x00, 0x17, 0xbc, 0x62, 0x91, 0x75, 0xf7,
-		0x28, 0x72, 0x97, 0xd6, 0x4b, 0xd0, 0x94, 0x51, 0x4d, 0xf1, 0x25, 0xfa, 0xb6, 0xa4, 0xf7, 0xc4,
-		0xb4, 0xad, 0x48, 0x0d, 0x92, 0x28, 0xc8, 0x44, 0xc5, 0x6c, 0xba, 0xdd, 0x93, 0xef, 0xfc, 0xb6,
-		0x52, 0x7f, 0x59, 0xa9, 0x3f, 0x16, 0x4d, 0x5a, 0xc0, 0x15, 0x2d, 0x1a, 0x88, 0xbe, 0x2e, 0xe9,
-		0xbd, 0x09, 0xa8, 0x66, 0x20, 0x63, 0x4b, 0xf3, 0x3e, 0xa0, 0xc1, 0x32, 0xc5, 0x8c, 0x4a, 0xcd,
-		0x8d, 0x2a, 0xab, 0x5c, 0x2e, 0xea, 0xe5, 0xb0, 0xe8, 0x3a, 0x31, 0x47, 0xfc, 0x06, 0x3d, 0x13,
-		0x77, 0x15, 0x48, 0x72, 0x23, 0x94, 0x26, 0xb6, 0xcf, 0x2d, 0x8b, 0xee, 0x59, 0xf3, 0x3b, 0xa1,
-		0xf4, 0x94, 0x96, 0xe0, 0xfd, 0xe3, 0xa0, 0xfd, 0x65, 0xdc, 0x58, 0x53, 0xdd, 0x28, 0xfc, 0x13,
-		0xc2, 0x29, 0xcd, 0xf2, 0x42, 0xcc, 0x49, 0x26, 0x9a, 0x4a, 0x93, 0x1b, 0x5e, 0x69, 0x1b, 0xbb,
-		0x17, 0xb9, 0x1d, 0x72, 0x66, 0x80, 0x77, 0xbc, 0xd2, 0xf8, 0x35, 0x42, 0x12, 0x28, 0x23, 0x05,
-		0xdc, 0x42, 0x61, 0x73, 0xf4, 0xa2, 0x1d, 0x63, 0x99, 0x18, 0x03, 0x7e, 0x85, 0x76, 0x68, 0x96,
-		0x77, 0x68, 0xcf, 0xa2, 0x7d, 0x9a, 0xe5, 0x2d, 0xf8, 0x06, 0x3d, 0x93, 0x54, 0xc3, 0xba, 0x3a,
-		0xdb, 0x87, 0xce, 0x91, 0x13, 0xed, 0x19, 0xf3, 0xaa, 0x77, 0x3c, 0x46, 0x7b, 0x46, 0x46, 0xc2,
-		0x19, 0x49, 0x0b, 0x91, 0xe5, 0x07, 0x4f, 0xac, 0x86, 0x87, 0xff, 0xfb, 0x3c, 0xe1, 0xf8, 0xd4,
-		0xf8, 0x45, 0xbb, 0x86, 0x16, 0x32, 0x7b, 0xf1, 0x7e, 0x43, 0xbb, 0x6b, 0x18, 0x1e, 0xa0, 0xbe,
-		0xd2, 0x54, 0x6a, 0xc2, 0x59, 0xd7, 0xdc, 0x97, 0xf6, 0x1e, 0x32, 0xfc, 0x1c, 0x3d, 0x85, 0x8a,
-		0x19, 0xa0, 0xed, 0xe7, 0x09, 0x54, 0x2c, 0x64, 0xde, 0x5f, 0x0e, 0x42, 0x33, 0x51, 0x14, 0x20,
-		0xc3, 0xea, 0xa3, 0xc0, 0x63, 0xe4, 0x16, 0x54, 0x69, 0x42, 0xb3, 0x0c, 0x94, 0x22, 0x66, 0x14,
-		0xbb, 0xc7, 0x1d, 0x3e, 0x7a, 0xdc, 0x64, 0x39, 0xa7, 0xd1, 0xbe, 0xe1, 0x8c, 0x2c, 0xc5, 0x18,
-		0xf1, 0x10, 0xf5, 0x39, 0x83, 0x4a, 0x73, 0xbd, 0xe8, 0x5e, 0x68, 0x75, 0xdf, 0xa4, 0x4f, 0x6f,
-		0x83, 0x3e, 0xde, 0xdf, 0x0e, 0x1a, 0xc4, 0x9a, 0x67, 0xf9, 0xe2, 0xfc, 0x1e, 0xb2, 0xc6, 0x8c,
-		0xc6, 0x48, 0x6b, 0xc9, 0xd3, 0x46, 0x83, 0xc2, 0xbf, 0x23, 0xf7, 0x4e, 0xc8, 0x1c, 0xa4, 0x9d,
-		0x45, 0x62, 0x76, 0xb0, 0xab, 0xf3, 0xf5, 0x67, 0xe7, 0x3b, 0xda, 0x6f, 0x69, 0xab, 0x85, 0x49,
-		0xd0, 0x40, 0x65, 0x37, 0xc0, 0x9a, 0x02, 0x88, 0x16, 0xa4, 0x55, 0xcf, 0xb4, 0x2d, 0x1a, 0x6d,
-		0x6b, 0xdf, 0x3d, 0x19, 0x3c, 0x1e, 0xeb, 0x6e, 0x83, 0xa3, 0x17, 0x4b, 0x6e, 0x22, 0x62, 0xc3,
-		0x4c, 0x5a, 0xe2, 0xdb, 0x3f, 0xd1, 0x57, 0xeb, 0x1b, 0x85, 0x87, 0xe8, 0x45, 0x32, 0x8a, 0x2f,
-		0xc8, 0x24, 0x8c, 0x13, 0x72, 0x11, 0x4e, 0xc7, 0x24, 0x9c, 0x5e, 0x8d, 0x26, 0xe1, 0xd8, 0xfd,
-		0x02, 0x0f, 0xd0, 0xf3, 0x07, 0xd8, 0xf4, 0x7d, 0x74, 0x39, 0x9a, 0xb8, 0xce, 0x06, 0x28, 0x4e,
-		0xc2, 0xb3, 0x8b, 0x6b, 0x77, 0xeb, 0x2d, 0xfb, 0x2f, 0x43, 0xb2, 0xa8, 0xe1, 0xd3, 0x0c, 0xc9,
-		0xf5, 0xec, 0x7c, 0x2d, 0xc3, 0x2b, 0xf4, 0xf2, 0x01, 0x36, 0x3e, 0x3f, 0x0b, 0xe3, 0xf0, 0xfd,
-		0xd4, 0x75, 0x36, 0x80, 0xa3, 0xb3, 0x24, 0xbc, 0x0a, 0x93, 0x6b, 0x77, 0xeb, 0xf4, 0x0a, 0xbd,
-		0xcc, 0x44, 0xb9, 0x49, 0xd1, 0xd3, 0xfe, 0xa8, 0xe6, 0x33, 0x23, 0xc8, 0xcc, 0xf9, 0x23, 0x98,
-		0x73, 0x7d, 0xd3, 0xa4, 0x7e, 0x26, 0xca, 0xe0, 0x93, 0x6f, 0xd2, 0x9f, 0x43, 0xd5, 0xfe, 0x5b,
-		0xdd, 0x8f, 0xf9, 0x2b, 0xad, 0xf9, 0xed, 0x71, 0xfa, 0xd4, 0xda, 0x7e, 0xfe, 0x37, 0x00, 0x00,
-		0xff, 0xff, 0x58, 0x62, 0x2b, 0xbf, 0x55, 0x05, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x6e, 0x1b, 0x37,
+		0x10, 0xee, 0x4a, 0x8e, 0x2b, 0x8f, 0x6b, 0x67, 0xcb, 0x36, 0x8e, 0xa5, 0x34, 0xad, 0xaa, 0x43,
+		0x60, 0x04, 0xc5, 0x0a, 0x76, 0xd1, 0x53, 0x0f, 0x85, 0x6c, 0x19, 0xcd, 0xc2, 0xb2, 0x22, 0xac,
+		0x36, 0x06, 0xdc, 0x0b, 0x4b, 0x2d, 0x27, 0x32, 0xb1, 0x3f, 0x5c, 0x90, 0x5c, 0xff, 0xdc, 0xfa,
+		0x14, 0x7d, 0x98, 0x3e, 0x5d, 0x41, 0xee, 0xca, 0x75, 0x6c, 0x35, 0x37, 0x72, 0xbe, 0xf9, 0xe6,
+		0xe7, 0xe3, 0x0c, 0x61, 0x50, 0x2d, 0x50, 0x0d, 0x13, 0xc6, 0xb1, 0x48, 0x70, 0xc8, 0x4a, 0x31,
+		0xbc, 0x3e, 0x1c, 0x1a, 0xa6, 0xd3, 0x4c, 0x68, 0x13, 0x94, 0x4a, 0x1a, 0x49, 0xbe, 0xb1, 0x3e,
+		0x41, 0xe3, 0x13, 0xb0, 0x52, 0x04, 0xd7, 0x87, 0xbd, 0xef, 0x97, 0x52, 0x2e, 0x33, 0x1c, 0x3a,
+		0x97, 0x45, 0xf5, 0x71, 0xc8, 0x2b, 0xc5, 0x8c, 0x90, 0x45, 0x4d, 0xea, 0xfd, 0xf0, 0x18, 0x37,
+		0x22, 0x47, 0x6d, 0x58, 0x5e, 0x36, 0x0e, 0x4f, 0x02, 0xdc, 0x28, 0x56, 0x96, 0xa8, 0x74, 0x8d,
+		0x0f, 0x3e, 0x40, 0x27, 0x66, 0x3a, 0x9d, 0x08, 0x6d, 0x08, 0x81, 0x8d, 0x82, 0xe5, 0xb8, 0xef,
+		0xf5, 0xbd, 0x83, 0xad, 0xc8, 0x9d, 0xc9, 0x2f, 0xb0, 0x91, 0x8a, 0x82, 0xef, 0xb7, 0xfa, 0xde,
+		0xc1, 0xee, 0xd1, 0x8f, 0xc1, 0x9a, 0x22, 0x83, 0x55, 0x80, 0x33, 0x51, 0xf0, 0xc8, 0xb9, 0x0f,
+		0x18, 0xf8, 0x2b, 0xeb, 0x39, 0x1a, 0xc6, 0x99, 0x61, 0xe4, 0x1c, 0xbe, 0xcd, 0xd9, 0x2d, 0xb5,
+		0x6d, 0x6b, 0x5a, 0xa2, 0xa2, 0x1a, 0x13, 0x59, 0x70, 0x97, 0x6e, 0xfb, 0xe8, 0xbb, 0xa0, 0xae,
+		0x34, 0x58, 0x55, 0x1a, 0x8c, 0x65, 0xb5, 0xc8, 0xf0, 0x82, 0x65, 0x15, 0x46, 0x5f, 0xe7, 0xec,
+		0xd6, 0x06, 0xd4, 0x33, 0x54, 0x73, 0x47, 0x1b, 0x7c, 0x80, 0xee, 0x2a, 0xc5, 0x8c, 0x29, 0x23,
+		0xac, 0x2a, 0xf7, 0xb9, 0x7c, 0x68, 0xa7, 0x78, 0xd7, 0x74, 0x62, 0x8f, 0xe4, 0x0d, 0x3c, 0x97,
+		0x37, 0x05, 0x2a, 0x7a, 0x25, 0xb5, 0xa1, 0xae, 0xcf, 0x96, 0x43, 0x77, 0x9c, 0xf9, 0x9d, 0xd4,
+		0x66, 0xca, 0x72, 0x1c, 0xfc, 0xd5, 0x82, 0xdd, 0x55, 0xdc, 0xb9, 0x61, 0xa6, 0xd2, 0xe4, 0x27,
+		0x20, 0x0b, 0x96, 0xa4, 0x99, 0x5c, 0xd2, 0x44, 0x56, 0x85, 0xa1, 0x57, 0xa2, 0x30, 0x2e, 0x76,
+		0x3b, 0xf2, 0x1b, 0xe4, 0xc4, 0x02, 0xef, 0x44, 0x61, 0xc8, 0x6b, 0x00, 0x85, 0x8c, 0xd3, 0x0c,
+		0xaf, 0x31, 0x73, 0x39, 0xda, 0xd1, 0x96, 0xb5, 0x4c, 0xac, 0x81, 0xbc, 0x82, 0x2d, 0x96, 0xa4,
+		0x0d, 0xda, 0x76, 0x68, 0x87, 0x25, 0x69, 0x0d, 0xbe, 0x81, 0xe7, 0x8a, 0x19, 0x7c, 0xa8, 0xce,
+		0x46, 0xdf, 0x3b, 0xf0, 0xa2, 0x1d, 0x6b, 0xbe, 0xef, 0x9d, 0x8c, 0x61, 0xc7, 0xca, 0x48, 0x05,
+		0xa7, 0x8b, 0x4c, 0x26, 0xe9, 0xfe, 0x33, 0xa7, 0x61, 0xff, 0x7f, 0x9f, 0x27, 0x1c, 0x1f, 0x5b,
+		0xbf, 0x68, 0xdb, 0xd2, 0x42, 0xee, 0x2e, 0x64, 0x0f, 0x36, 0x4b, 0x56, 0x69, 0xe4, 0xfb, 0x9b,
+		0x7d, 0xef, 0xa0, 0x13, 0x35, 0xb7, 0xc1, 0x6f, 0xb0, 0xfd, 0x80, 0x43, 0xba, 0xd0, 0xd1, 0x86,
+		0x29, 0x43, 0x05, 0x6f, 0x9a, 0xfe, 0xd2, 0xdd, 0x43, 0x4e, 0x5e, 0xc0, 0x26, 0x16, 0xdc, 0x02,
+		0x75, 0x9f, 0xcf, 0xb0, 0xe0, 0x21, 0x1f, 0xfc, 0xed, 0x01, 0xcc, 0x64, 0x96, 0xa1, 0x0a, 0x8b,
+		0x8f, 0x92, 0x8c, 0xc1, 0xcf, 0x98, 0x36, 0x94, 0x25, 0x09, 0x6a, 0x4d, 0xed, 0x88, 0x36, 0x8f,
+		0xde, 0x7b, 0xf2, 0xe8, 0xf1, 0x6a, 0x7e, 0xa3, 0x5d, 0xcb, 0x19, 0x39, 0x8a, 0x35, 0x92, 0x1e,
+		0x74, 0x04, 0xc7, 0xc2, 0x08, 0x73, 0xd7, 0xbc, 0xdc, 0xfd, 0x7d, 0x9d, 0x6e, 0xed, 0x35, 0xba,
+		0x0d, 0xfe, 0xf1, 0xa0, 0x3b, 0x37, 0x22, 0x49, 0xef, 0x4e, 0x6f, 0x31, 0xa9, 0xec, 0xc8, 0x8c,
+		0x8c, 0x51, 0x62, 0x51, 0x19, 0xd4, 0xe4, 0x77, 0xf0, 0x6f, 0xa4, 0x4a, 0x51, 0xb9, 0x19, 0xa5,
+		0x76, 0x37, 0x9b, 0x3a, 0x5f, 0x7f, 0x76, 0xee, 0xa3, 0xdd, 0x9a, 0x76, 0xbf, 0x48, 0x31, 0x74,
+		0x75, 0x72, 0x85, 0xbc, 0xca, 0x90, 0x1a, 0x49, 0x6b, 0xf5, 0x6c, 0xdb, 0xb2, 0x32, 0xae, 0xf6,
+		0xed, 0xa3, 0xee, 0xd3, 0x71, 0x6f, 0x36, 0x3b, 0xda, 0x5b, 0x71, 0x63, 0x39, 0xb7, 0xcc, 0xb8,
+		0x26, 0xbe, 0xfd, 0x13, 0xbe, 0x7a, 0xb8, 0x69, 0xa4, 0x07, 0x7b, 0xf1, 0x68, 0x7e, 0x46, 0x27,
+		0xe1, 0x3c, 0xa6, 0x67, 0xe1, 0x74, 0x4c, 0xc3, 0xe9, 0xc5, 0x68, 0x12, 0x8e, 0xfd, 0x2f, 0x48,
+		0x17, 0x5e, 0x3c, 0xc2, 0xa6, 0xef, 0xa3, 0xf3, 0xd1, 0xc4, 0xf7, 0xd6, 0x40, 0xf3, 0x38, 0x3c,
+		0x39, 0xbb, 0xf4, 0x5b, 0x6f, 0xf9, 0x7f, 0x19, 0xe2, 0xbb, 0x12, 0x3f, 0xcd, 0x10, 0x5f, 0xce,
+		0x4e, 0x1f, 0x64, 0x78, 0x05, 0x2f, 0x1f, 0x61, 0xe3, 0xd3, 0x93, 0x70, 0x1e, 0xbe, 0x9f, 0xfa,
+		0xde, 0x1a, 0x70, 0x74, 0x12, 0x87, 0x17, 0x61, 0x7c, 0xe9, 0xb7, 0x8e, 0x2f, 0xe0, 0x65, 0x22,
+		0xf3, 0x75, 0x8a, 0x1e, 0x77, 0x46, 0xa5, 0x98, 0x59, 0x41, 0x66, 0xde, 0x1f, 0xc3, 0xa5, 0x30,
+		0x57, 0xd5, 0x22, 0x48, 0x64, 0x3e, 0xfc, 0xe4, 0xfb, 0x0c, 0x96, 0x58, 0xd4, 0xff, 0x59, 0xf3,
+		0x93, 0xfe, 0xca, 0x4a, 0x71, 0x7d, 0xb8, 0xd8, 0x74, 0xb6, 0x9f, 0xff, 0x1d, 0x00, 0xde, 0xd0,
+		0xdf, 0x62, 0x6d, 0x05, 0x00, 0x00,
 	},
 	// google/protobuf/wrappers.proto
 	[]byte{
@@ -360,7 +367,6 @@ var yarpcFileDescriptorClosure91769cfce21084c6 = [][]byte{
 		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
 		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
 		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
-		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x92, 0x48, 0x30, 0x06,
-		0x02, 0x00, 0x00,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
 	},
 }
@@ -0,0 +1,1980 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: uber/cadence/api/v1/schedule.proto
+
+package apiv1
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ScheduleOverlapPolicy int32
+
+const (
+	ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_INVALID ScheduleOverlapPolicy = 0
+	// Skip the new run if the previous run is still running.
+	ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_SKIP_NEW ScheduleOverlapPolicy = 1
+	// Buffer the new run and start it once the previous run has closed.
+	ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_BUFFER ScheduleOverlapPolicy = 2
+	// Request cancellation of the previous run and start the new run.
+	ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER ScheduleOverlapPolicy = 3
+	// Start the new run regardless of any previous run.
+	ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_ALLOW_ALL ScheduleOverlapPolicy = 4
+)
+
+var ScheduleOverlapPolicy_name = map[int32]string{
+	0: "SCHEDULE_OVERLAP_POLICY_INVALID",
+	1: "SCHEDULE_OVERLAP_POLICY_SKIP_NEW",
+	2: "SCHEDULE_OVERLAP_POLICY_BUFFER",
+	3: "SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER",
+	4: "SCHEDULE_OVERLAP_POLICY_ALLOW_ALL",
+}
+
+var ScheduleOverlapPolicy_value = map[string]int32{
+	"SCHEDULE_OVERLAP_POLICY_INVALID":      0,
+	"SCHEDULE_OVERLAP_POLICY_SKIP_NEW":     1,
+	"SCHEDULE_OVERLAP_POLICY_BUFFER":       2,
+	"SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER": 3,
+	"SCHEDULE_OVERLAP_POLICY_ALLOW_ALL":    4,
+}
+
+func (x ScheduleOverlapPolicy) String() string {
+	return proto.EnumName(ScheduleOverlapPolicy_name, int32(x))
+}
+
+func (ScheduleOverlapPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7fe71309dc9555dc, []int{0}
+}
+
+type ScheduleSpec struct {
+	CronExpression       string           `protobuf:"bytes,1,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	StartTime            *types.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              *types.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ScheduleSpec) Reset()         { *m = ScheduleSpec{} }
+func (m *ScheduleSpec) String() string { return proto.CompactTextString(m) }
+func (*ScheduleSpec) ProtoMessage()    {}
+func (*ScheduleSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fe71309dc9555dc, []int{0}
+}
+func (m *ScheduleSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleSpec.Merge(m, src)
+}
+func (m *ScheduleSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleSpec proto.InternalMessageInfo
+
+func (m *ScheduleSpec) GetCronExpression() string {
+	if m != nil {
+		return m.CronExpression
+	}
+	return ""
+}
+
+func (m *ScheduleSpec) GetStartTime() *types.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *ScheduleSpec) GetEndTime() *types.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+type ScheduleAction struct {
+	WorkflowType                 *WorkflowType   `protobuf:"bytes,1,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	TaskList                     *TaskList       `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	Input                        *Payload        `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	ExecutionStartToCloseTimeout *types.Duration `protobuf:"bytes,4,opt,name=execution_start_to_close_timeout,json=executionStartToCloseTimeout,proto3" json:"execution_start_to_close_timeout,omitempty"`
+	TaskStartToCloseTimeout      *types.Duration `protobuf:"bytes,5,opt,name=task_start_to_close_timeout,json=taskStartToCloseTimeout,proto3" json:"task_start_to_close_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}        `json:"-"`
+	XXX_unrecognized             []byte          `json:"-"`
+	XXX_sizecache                int32           `json:"-"`
+}
+
+func (m *ScheduleAction) Reset()         { *m = ScheduleAction{} }
+func (m *ScheduleAction) String() string { return proto.CompactTextString(m) }
+func (*ScheduleAction) ProtoMessage()    {}
+func (*ScheduleAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fe71309dc9555dc, []int{1}
+}
+func (m *ScheduleAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleAction.Merge(m, src)
+}
+func (m *ScheduleAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleAction proto.InternalMessageInfo
+
+func (m *ScheduleAction) GetWorkflowType() *WorkflowType {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return nil
+}
+
+func (m *ScheduleAction) GetTaskList() *TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *ScheduleAction) GetInput() *Payload {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *ScheduleAction) GetExecutionStartToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.ExecutionStartToCloseTimeout
+	}
+	return nil
+}
+
+func (m *ScheduleAction) GetTaskStartToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.TaskStartToCloseTimeout
+	}
+	return nil
+}
+
+type SchedulePolicies struct {
+	OverlapPolicy        ScheduleOverlapPolicy `protobuf:"varint,1,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=uber.cadence.api.v1.ScheduleOverlapPolicy" json:"overlap_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SchedulePolicies) Reset()         { *m = SchedulePolicies{} }
+func (m *SchedulePolicies) String() string { return proto.CompactTextString(m) }
+func (*SchedulePolicies) ProtoMessage()    {}
+func (*SchedulePolicies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fe71309dc9555dc, []int{2}
+}
+func (m *SchedulePolicies) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchedulePolicies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchedulePolicies.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchedulePolicies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulePolicies.Merge(m, src)
+}
+func (m *SchedulePolicies) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchedulePolicies) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulePolicies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchedulePolicies proto.InternalMessageInfo
+
+func (m *SchedulePolicies) GetOverlapPolicy() ScheduleOverlapPolicy {
+	if m != nil {
+		return m.OverlapPolicy
+	}
+	return ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_INVALID
+}
+
+type ScheduleState struct {
+	Paused               bool     `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	PauseReason          string   `protobuf:"bytes,2,opt,name=pause_reason,json=pauseReason,proto3" json:"pause_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScheduleState) Reset()         { *m = ScheduleState{} }
+func (m *ScheduleState) String() string { return proto.CompactTextString(m) }
+func (*ScheduleState) ProtoMessage()    {}
+func (*ScheduleState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fe71309dc9555dc, []int{3}
+}
+func (m *ScheduleState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleState.Merge(m, src)
+}
+func (m *ScheduleState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleState proto.InternalMessageInfo
+
+func (m *ScheduleState) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *ScheduleState) GetPauseReason() string {
+	if m != nil {
+		return m.PauseReason
+	}
+	return ""
+}
+
+type ScheduleInfo struct {
+	LastRunTime          *types.Timestamp   `protobuf:"bytes,1,opt,name=last_run_time,json=lastRunTime,proto3" json:"last_run_time,omitempty"`
+	NextRunTime          *types.Timestamp   `protobuf:"bytes,2,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
+	TotalRuns            int64              `protobuf:"varint,3,opt,name=total_runs,json=totalRuns,proto3" json:"total_runs,omitempty"`
+	SkippedRuns          int64              `protobuf:"varint,4,opt,name=skipped_runs,json=skippedRuns,proto3" json:"skipped_runs,omitempty"`
+	BufferedRuns         int32              `protobuf:"varint,5,opt,name=buffered_runs,json=bufferedRuns,proto3" json:"buffered_runs,omitempty"`
+	LastRunExecution     *WorkflowExecution `protobuf:"bytes,6,opt,name=last_run_execution,json=lastRunExecution,proto3" json:"last_run_execution,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ScheduleInfo) Reset()         { *m = ScheduleInfo{} }
+func (m *ScheduleInfo) String() string { return proto.CompactTextString(m) }
+func (*ScheduleInfo) ProtoMessage()    {}
+func (*ScheduleInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fe71309dc9555dc, []int{4}
+}
+func (m *ScheduleInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleInfo.Merge(m, src)
+}
+func (m *ScheduleInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleInfo proto.InternalMessageInfo
+
+func (m *ScheduleInfo) GetLastRunTime() *types.Timestamp {
+	if m != nil {
+		return m.LastRunTime
+	}
+	return nil
+}
+
+func (m *ScheduleInfo) GetNextRunTime() *types.Timestamp {
+	if m != nil {
+		return m.NextRunTime
+	}
+	return nil
+}
+
+func (m *ScheduleInfo) GetTotalRuns() int64 {
+	if m != nil {
+		return m.TotalRuns
+	}
+	return 0
+}
+
+func (m *ScheduleInfo) GetSkippedRuns() int64 {
+	if m != nil {
+		return m.SkippedRuns
+	}
+	return 0
+}
+
+func (m *ScheduleInfo) GetBufferedRuns() int32 {
+	if m != nil {
+		return m.BufferedRuns
+	}
+	return 0
+}
+
+func (m *ScheduleInfo) GetLastRunExecution() *WorkflowExecution {
+	if m != nil {
+		return m.LastRunExecution
+	}
+	return nil
+}
+
+type ScheduleListEntry struct {
+	ScheduleId           string   `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScheduleListEntry) Reset()         { *m = ScheduleListEntry{} }
+func (m *ScheduleListEntry) String() string { return proto.CompactTextString(m) }
+func (*ScheduleListEntry) ProtoMessage()    {}
+func (*ScheduleListEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fe71309dc9555dc, []int{5}
+}
+func (m *ScheduleListEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleListEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleListEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleListEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleListEntry.Merge(m, src)
+}
+func (m *ScheduleListEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleListEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleListEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleListEntry proto.InternalMessageInfo
+
+func (m *ScheduleListEntry) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("uber.cadence.api.v1.ScheduleOverlapPolicy", ScheduleOverlapPolicy_name, ScheduleOverlapPolicy_value)
+	proto.RegisterType((*ScheduleSpec)(nil), "uber.cadence.api.v1.ScheduleSpec")
+	proto.RegisterType((*ScheduleAction)(nil), "uber.cadence.api.v1.ScheduleAction")
+	proto.RegisterType((*SchedulePolicies)(nil), "uber.cadence.api.v1.SchedulePolicies")
+	proto.RegisterType((*ScheduleState)(nil), "uber.cadence.api.v1.ScheduleState")
+	proto.RegisterType((*ScheduleInfo)(nil), "uber.cadence.api.v1.ScheduleInfo")
+	proto.RegisterType((*ScheduleListEntry)(nil), "uber.cadence.api.v1.ScheduleListEntry")
+}
+
+func init() {
+	proto.RegisterFile("uber/cadence/api/v1/schedule.proto", fileDescriptor_7fe71309dc9555dc)
+}
+
+var fileDescriptor_7fe71309dc9555dc = []byte{
+	// 779 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x5d, 0x6f, 0xe3, 0x44,
+	0x14, 0xc5, 0xfd, 0xa2, 0xb9, 0x69, 0x4a, 0x18, 0x04, 0x1b, 0xca, 0x6e, 0x9a, 0x7a, 0x17, 0xa8,
+	0xf6, 0xc1, 0x56, 0x03, 0x3c, 0x00, 0xd2, 0x4a, 0x69, 0xea, 0x6a, 0x03, 0x56, 0x13, 0x26, 0xd9,
+	0x8d, 0xe0, 0xc5, 0x9a, 0xd8, 0x93, 0xd4, 0x8a, 0xe3, 0x19, 0x79, 0xc6, 0x69, 0xf3, 0xce, 0x9f,
+	0xe1, 0x9f, 0x20, 0xf1, 0xc2, 0x1b, 0xaf, 0xa8, 0xbf, 0x04, 0xcd, 0xd8, 0x0e, 0x05, 0x1c, 0xf2,
+	0x12, 0x79, 0x4e, 0xce, 0xb9, 0xbe, 0xf7, 0xcc, 0xf1, 0x05, 0x33, 0x9d, 0xd0, 0xc4, 0xf6, 0x49,
+	0x40, 0x63, 0x9f, 0xda, 0x84, 0x87, 0xf6, 0xf2, 0xc2, 0x16, 0xfe, 0x2d, 0x0d, 0xd2, 0x88, 0x5a,
+	0x3c, 0x61, 0x92, 0xa1, 0x0f, 0x14, 0xc7, 0xca, 0x39, 0x16, 0xe1, 0xa1, 0xb5, 0xbc, 0x38, 0x69,
+	0xce, 0x18, 0x9b, 0x45, 0xd4, 0xd6, 0x94, 0x49, 0x3a, 0xb5, 0x83, 0x34, 0x21, 0x32, 0x64, 0x71,
+	0x26, 0x3a, 0x39, 0xfd, 0xf7, 0xff, 0x32, 0x5c, 0x50, 0x21, 0xc9, 0x82, 0xe7, 0x84, 0x56, 0xd9,
+	0x9b, 0x7d, 0xb6, 0x58, 0xac, 0x4b, 0x94, 0xf6, 0x26, 0x89, 0x98, 0x47, 0xa1, 0x90, 0x19, 0xc7,
+	0xfc, 0xc5, 0x80, 0xa3, 0x61, 0xde, 0xee, 0x90, 0x53, 0x1f, 0x7d, 0x0e, 0xef, 0xf9, 0x09, 0x8b,
+	0x3d, 0x7a, 0xcf, 0x13, 0x2a, 0x44, 0xc8, 0xe2, 0x86, 0xd1, 0x32, 0xce, 0x2b, 0xf8, 0x58, 0xc1,
+	0xce, 0x1a, 0x45, 0x5f, 0x03, 0x08, 0x49, 0x12, 0xe9, 0xa9, 0xc6, 0x1a, 0x3b, 0x2d, 0xe3, 0xbc,
+	0xda, 0x3e, 0xb1, 0xb2, 0xae, 0xad, 0xa2, 0x6b, 0x6b, 0x54, 0x74, 0x8d, 0x2b, 0x9a, 0xad, 0xce,
+	0xe8, 0x2b, 0x38, 0xa4, 0x71, 0x90, 0x09, 0x77, 0xb7, 0x0a, 0xdf, 0xa5, 0x71, 0xa0, 0x4e, 0xe6,
+	0xcf, 0xbb, 0x70, 0x5c, 0xf4, 0xda, 0xf1, 0x95, 0x57, 0xe8, 0x1a, 0x6a, 0x77, 0x2c, 0x99, 0x4f,
+	0x23, 0x76, 0xe7, 0xc9, 0x15, 0xa7, 0xba, 0xd7, 0x6a, 0xfb, 0xcc, 0x2a, 0xb1, 0xdc, 0x1a, 0xe7,
+	0xcc, 0xd1, 0x8a, 0x53, 0x7c, 0x74, 0xf7, 0xe8, 0x84, 0xbe, 0x81, 0x8a, 0x32, 0xc6, 0x53, 0xce,
+	0xe4, 0xb3, 0x3c, 0x2b, 0xad, 0x31, 0x22, 0x62, 0xee, 0x86, 0x42, 0xe2, 0x43, 0x99, 0x3f, 0xa1,
+	0x36, 0xec, 0x87, 0x31, 0x4f, 0x65, 0x3e, 0xca, 0xd3, 0x52, 0xdd, 0x80, 0xac, 0x22, 0x46, 0x02,
+	0x9c, 0x51, 0x11, 0x81, 0x16, 0xbd, 0xa7, 0x7e, 0xaa, 0x86, 0xf0, 0x72, 0x1b, 0x99, 0xe7, 0x47,
+	0x4c, 0x50, 0x6d, 0x0b, 0x4b, 0x65, 0x63, 0x4f, 0x97, 0xfb, 0xf8, 0x3f, 0xce, 0x5c, 0xe5, 0x41,
+	0xc1, 0x4f, 0xd7, 0x25, 0x86, 0xda, 0x5a, 0xd6, 0x55, 0xfa, 0x51, 0x26, 0x47, 0x63, 0xf8, 0x44,
+	0x8f, 0xb4, 0xa1, 0xfa, 0xfe, 0xb6, 0xea, 0x4f, 0x94, 0xba, 0xa4, 0xb0, 0x49, 0xa1, 0x5e, 0xdc,
+	0xc2, 0x80, 0x45, 0xa1, 0x1f, 0x52, 0x81, 0x7e, 0x80, 0x63, 0xb6, 0xa4, 0x49, 0x44, 0xb8, 0xc7,
+	0x15, 0xb6, 0xd2, 0x17, 0x71, 0xdc, 0x7e, 0x59, 0x6a, 0x46, 0x21, 0xef, 0x67, 0x12, 0x5d, 0x65,
+	0x85, 0x6b, 0xec, 0xf1, 0xd1, 0xfc, 0x0e, 0x6a, 0xeb, 0x60, 0x4a, 0x22, 0x29, 0xfa, 0x08, 0x0e,
+	0x38, 0x49, 0x05, 0x0d, 0x74, 0xed, 0x43, 0x9c, 0x9f, 0xd0, 0x19, 0x1c, 0xe9, 0x27, 0x2f, 0xa1,
+	0x44, 0xb0, 0x58, 0x5f, 0x5f, 0x05, 0x57, 0x35, 0x86, 0x35, 0x64, 0xfe, 0xb6, 0xf3, 0x77, 0xca,
+	0x7b, 0xf1, 0x94, 0xa1, 0x57, 0x50, 0x8b, 0x88, 0x90, 0x5e, 0x92, 0xc6, 0x59, 0x0c, 0x8d, 0xad,
+	0x31, 0xac, 0x2a, 0x01, 0x4e, 0x63, 0x9d, 0xe0, 0x57, 0x50, 0x8b, 0xe9, 0xfd, 0x23, 0xfd, 0xf6,
+	0xfc, 0x57, 0x95, 0xa0, 0xd0, 0x3f, 0x03, 0x90, 0x4c, 0x92, 0x48, 0x15, 0x10, 0x3a, 0x38, 0xbb,
+	0xb8, 0xa2, 0x11, 0x9c, 0xc6, 0x42, 0x8d, 0x24, 0xe6, 0x21, 0xe7, 0x34, 0xc8, 0x08, 0x7b, 0x9a,
+	0x50, 0xcd, 0x31, 0x4d, 0x79, 0x0e, 0xb5, 0x49, 0x3a, 0x9d, 0xd2, 0xa4, 0xe0, 0xa8, 0x0b, 0xdd,
+	0xc7, 0x47, 0x05, 0xa8, 0x49, 0x23, 0x40, 0xeb, 0x31, 0xd7, 0x61, 0x69, 0x1c, 0xe8, 0x5e, 0x3f,
+	0xfb, 0xdf, 0x6f, 0xc4, 0x29, 0xd8, 0xb8, 0x9e, 0xcf, 0xbd, 0x46, 0xcc, 0x2f, 0xe1, 0xfd, 0xc2,
+	0x4c, 0xf5, 0x01, 0x38, 0xb1, 0x4c, 0x56, 0xe8, 0x14, 0xaa, 0xc5, 0xda, 0xf3, 0xc2, 0x20, 0xdf,
+	0x19, 0x50, 0x40, 0xbd, 0xe0, 0xe5, 0x1f, 0x06, 0x7c, 0x58, 0x7a, 0xf1, 0xe8, 0x39, 0x9c, 0x0e,
+	0xbb, 0xaf, 0x9d, 0xab, 0x37, 0xae, 0xe3, 0xf5, 0xdf, 0x3a, 0xd8, 0xed, 0x0c, 0xbc, 0x41, 0xdf,
+	0xed, 0x75, 0x7f, 0xf4, 0x7a, 0x37, 0x6f, 0x3b, 0x6e, 0xef, 0xaa, 0xfe, 0x0e, 0x7a, 0x01, 0xad,
+	0x4d, 0xa4, 0xe1, 0xf7, 0xbd, 0x81, 0x77, 0xe3, 0x8c, 0xeb, 0x06, 0x32, 0xa1, 0xb9, 0x89, 0x75,
+	0xf9, 0xe6, 0xfa, 0xda, 0xc1, 0xf5, 0x1d, 0x74, 0x0e, 0x2f, 0x36, 0x71, 0xba, 0x9d, 0x9b, 0xae,
+	0xe3, 0x7a, 0xfd, 0xd1, 0x6b, 0x07, 0xd7, 0x77, 0xd1, 0xa7, 0x70, 0xb6, 0x89, 0xd9, 0x71, 0xdd,
+	0xfe, 0x58, 0xfd, 0xd6, 0xf7, 0x2e, 0x27, 0xbf, 0x3e, 0x34, 0x8d, 0xdf, 0x1f, 0x9a, 0xc6, 0x9f,
+	0x0f, 0x4d, 0x03, 0x9e, 0xf8, 0x6c, 0x51, 0x66, 0xed, 0xe5, 0x61, 0x87, 0x87, 0x03, 0x95, 0x8b,
+	0x81, 0xf1, 0x93, 0x3d, 0x0b, 0xe5, 0x6d, 0x3a, 0xb1, 0x7c, 0xb6, 0xb0, 0xff, 0xb1, 0xa5, 0xad,
+	0x19, 0x8d, 0xb3, 0x9d, 0x9f, 0x2f, 0xec, 0x6f, 0x09, 0x0f, 0x97, 0x17, 0x93, 0x03, 0x8d, 0x7d,
+	0xf1, 0xd7, 0x00, 0x04, 0xbf, 0x22, 0x65, 0x70, 0x06, 0x00, 0x00,
+}
+
+func (m *ScheduleSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EndTime != nil {
+		{
+			size, err := m.EndTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartTime != nil {
+		{
+			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CronExpression) > 0 {
+		i -= len(m.CronExpression)
+		copy(dAtA[i:], m.CronExpression)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.CronExpression)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TaskStartToCloseTimeout != nil {
+		{
+			size, err := m.TaskStartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExecutionStartToCloseTimeout != nil {
+		{
+			size, err := m.ExecutionStartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulePolicies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulePolicies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulePolicies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.OverlapPolicy != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.OverlapPolicy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PauseReason) > 0 {
+		i -= len(m.PauseReason)
+		copy(dAtA[i:], m.PauseReason)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.PauseReason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastRunExecution != nil {
+		{
+			size, err := m.LastRunExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BufferedRuns != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.BufferedRuns))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SkippedRuns != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.SkippedRuns))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalRuns != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.TotalRuns))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NextRunTime != nil {
+		{
+			size, err := m.NextRunTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LastRunTime != nil {
+		{
+			size, err := m.LastRunTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleListEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleListEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleListEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSchedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSchedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduleSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CronExpression)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = m.StartTime.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = m.EndTime.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.ExecutionStartToCloseTimeout != nil {
+		l = m.ExecutionStartToCloseTimeout.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.TaskStartToCloseTimeout != nil {
+		l = m.TaskStartToCloseTimeout.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchedulePolicies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OverlapPolicy != 0 {
+		n += 1 + sovSchedule(uint64(m.OverlapPolicy))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.PauseReason)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastRunTime != nil {
+		l = m.LastRunTime.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.NextRunTime != nil {
+		l = m.NextRunTime.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.TotalRuns != 0 {
+		n += 1 + sovSchedule(uint64(m.TotalRuns))
+	}
+	if m.SkippedRuns != 0 {
+		n += 1 + sovSchedule(uint64(m.SkippedRuns))
+	}
+	if m.BufferedRuns != 0 {
+		n += 1 + sovSchedule(uint64(m.BufferedRuns))
+	}
+	if m.LastRunExecution != nil {
+		l = m.LastRunExecution.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleListEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSchedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSchedule(x uint64) (n int) {
+	return sovSchedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ScheduleSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = &types.Timestamp{}
+			}
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = &types.Timestamp{}
+			}
+			if err := m.EndTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Payload{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionStartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionStartToCloseTimeout == nil {
+				m.ExecutionStartToCloseTimeout = &types.Duration{}
+			}
+			if err := m.ExecutionStartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskStartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskStartToCloseTimeout == nil {
+				m.TaskStartToCloseTimeout = &types.Duration{}
+			}
+			if err := m.TaskStartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulePolicies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulePolicies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulePolicies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverlapPolicy", wireType)
+			}
+			m.OverlapPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OverlapPolicy |= ScheduleOverlapPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauseReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRunTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastRunTime == nil {
+				m.LastRunTime = &types.Timestamp{}
+			}
+			if err := m.LastRunTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRunTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextRunTime == nil {
+				m.NextRunTime = &types.Timestamp{}
+			}
+			if err := m.NextRunTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRuns", wireType)
+			}
+			m.TotalRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalRuns |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedRuns", wireType)
+			}
+			m.SkippedRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedRuns |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferedRuns", wireType)
+			}
+			m.BufferedRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BufferedRuns |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRunExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastRunExecution == nil {
+				m.LastRunExecution = &WorkflowExecution{}
+			}
+			if err := m.LastRunExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleListEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleListEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleListEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSchedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSchedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSchedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSchedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSchedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSchedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSchedule = fmt.Errorf("proto: unexpected end of group")
+)
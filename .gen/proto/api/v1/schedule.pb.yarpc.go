@@ -0,0 +1,234 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/api/v1/schedule.proto
+
+package apiv1
+
+var yarpcFileDescriptorClosure7fe71309dc9555dc = [][]byte{
+	// uber/cadence/api/v1/schedule.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x5d, 0x6f, 0xe3, 0x44,
+		0x14, 0xc5, 0xfd, 0x22, 0xb9, 0xf9, 0x20, 0x0c, 0x82, 0x0d, 0x65, 0x77, 0x9b, 0x7a, 0x17, 0xa8,
+		0xf6, 0xc1, 0x56, 0x03, 0x3c, 0x00, 0xd2, 0x4a, 0x69, 0xea, 0x6a, 0x03, 0x56, 0x13, 0x26, 0xd9,
+		0x46, 0xf0, 0x62, 0x4d, 0xec, 0x49, 0xd6, 0x8a, 0xe3, 0x19, 0x79, 0xc6, 0x69, 0xf3, 0xce, 0x9f,
+		0xe1, 0xb7, 0xf0, 0x03, 0xf8, 0x3b, 0x68, 0xc6, 0x76, 0x28, 0xe0, 0x6c, 0x5e, 0x22, 0xcf, 0xc9,
+		0x39, 0xd7, 0xf7, 0x9e, 0x39, 0xbe, 0x60, 0xa6, 0x33, 0x9a, 0xd8, 0x3e, 0x09, 0x68, 0xec, 0x53,
+		0x9b, 0xf0, 0xd0, 0x5e, 0x5f, 0xda, 0xc2, 0x7f, 0x47, 0x83, 0x34, 0xa2, 0x16, 0x4f, 0x98, 0x64,
+		0xe8, 0x13, 0xc5, 0xb1, 0x72, 0x8e, 0x45, 0x78, 0x68, 0xad, 0x2f, 0x4f, 0x9f, 0x2f, 0x18, 0x5b,
+		0x44, 0xd4, 0xd6, 0x94, 0x59, 0x3a, 0xb7, 0x83, 0x34, 0x21, 0x32, 0x64, 0x71, 0x26, 0x3a, 0x3d,
+		0xfb, 0xef, 0xff, 0x32, 0x5c, 0x51, 0x21, 0xc9, 0x8a, 0xe7, 0x84, 0x4e, 0xd9, 0x9b, 0x7d, 0xb6,
+		0x5a, 0x6d, 0x4b, 0x94, 0xf6, 0x26, 0x89, 0x58, 0x46, 0xa1, 0x90, 0x19, 0xc7, 0xfc, 0xc3, 0x80,
+		0xfa, 0x38, 0x6f, 0x77, 0xcc, 0xa9, 0x8f, 0xbe, 0x86, 0x8f, 0xfc, 0x84, 0xc5, 0x1e, 0x7d, 0xe0,
+		0x09, 0x15, 0x22, 0x64, 0x71, 0xdb, 0xe8, 0x18, 0x17, 0x55, 0xdc, 0x54, 0xb0, 0xb3, 0x45, 0xd1,
+		0xf7, 0x00, 0x42, 0x92, 0x44, 0x7a, 0xaa, 0xb1, 0xf6, 0x41, 0xc7, 0xb8, 0xa8, 0x75, 0x4f, 0xad,
+		0xac, 0x6b, 0xab, 0xe8, 0xda, 0x9a, 0x14, 0x5d, 0xe3, 0xaa, 0x66, 0xab, 0x33, 0xfa, 0x0e, 0x2a,
+		0x34, 0x0e, 0x32, 0xe1, 0xe1, 0x5e, 0xe1, 0x87, 0x34, 0x0e, 0xd4, 0xc9, 0xfc, 0xfd, 0x10, 0x9a,
+		0x45, 0xaf, 0x3d, 0x5f, 0x79, 0x85, 0x6e, 0xa0, 0x71, 0xcf, 0x92, 0xe5, 0x3c, 0x62, 0xf7, 0x9e,
+		0xdc, 0x70, 0xaa, 0x7b, 0xad, 0x75, 0xcf, 0xad, 0x12, 0xcb, 0xad, 0x69, 0xce, 0x9c, 0x6c, 0x38,
+		0xc5, 0xf5, 0xfb, 0x47, 0x27, 0xf4, 0x03, 0x54, 0x95, 0x31, 0x9e, 0x72, 0x26, 0x9f, 0xe5, 0x59,
+		0x69, 0x8d, 0x09, 0x11, 0x4b, 0x37, 0x14, 0x12, 0x57, 0x64, 0xfe, 0x84, 0xba, 0x70, 0x1c, 0xc6,
+		0x3c, 0x95, 0xf9, 0x28, 0x4f, 0x4b, 0x75, 0x23, 0xb2, 0x89, 0x18, 0x09, 0x70, 0x46, 0x45, 0x04,
+		0x3a, 0xf4, 0x81, 0xfa, 0xa9, 0x1a, 0xc2, 0xcb, 0x6d, 0x64, 0x9e, 0x1f, 0x31, 0x41, 0xb5, 0x2d,
+		0x2c, 0x95, 0xed, 0x23, 0x5d, 0xee, 0xf3, 0xff, 0x39, 0x73, 0x9d, 0x07, 0x05, 0x3f, 0xdd, 0x96,
+		0x18, 0x6b, 0x6b, 0x59, 0x5f, 0xe9, 0x27, 0x99, 0x1c, 0x4d, 0xe1, 0x0b, 0x3d, 0xd2, 0x8e, 0xea,
+		0xc7, 0xfb, 0xaa, 0x3f, 0x51, 0xea, 0x92, 0xc2, 0x26, 0x85, 0x56, 0x71, 0x0b, 0x23, 0x16, 0x85,
+		0x7e, 0x48, 0x05, 0xfa, 0x05, 0x9a, 0x6c, 0x4d, 0x93, 0x88, 0x70, 0x8f, 0x2b, 0x6c, 0xa3, 0x2f,
+		0xa2, 0xd9, 0x7d, 0x55, 0x6a, 0x46, 0x21, 0x1f, 0x66, 0x12, 0x5d, 0x65, 0x83, 0x1b, 0xec, 0xf1,
+		0xd1, 0xfc, 0x09, 0x1a, 0xdb, 0x60, 0x4a, 0x22, 0x29, 0xfa, 0x0c, 0x4e, 0x38, 0x49, 0x05, 0x0d,
+		0x74, 0xed, 0x0a, 0xce, 0x4f, 0xe8, 0x1c, 0xea, 0xfa, 0xc9, 0x4b, 0x28, 0x11, 0x2c, 0xd6, 0xd7,
+		0x57, 0xc5, 0x35, 0x8d, 0x61, 0x0d, 0x99, 0x7f, 0x1e, 0xfc, 0x93, 0xf2, 0x41, 0x3c, 0x67, 0xe8,
+		0x35, 0x34, 0x22, 0x22, 0xa4, 0x97, 0xa4, 0x71, 0x16, 0x43, 0x63, 0x6f, 0x0c, 0x6b, 0x4a, 0x80,
+		0xd3, 0x58, 0x27, 0xf8, 0x35, 0x34, 0x62, 0xfa, 0xf0, 0x48, 0xbf, 0x3f, 0xff, 0x35, 0x25, 0x28,
+		0xf4, 0xcf, 0x00, 0x24, 0x93, 0x24, 0x52, 0x05, 0x84, 0x0e, 0xce, 0x21, 0xae, 0x6a, 0x04, 0xa7,
+		0xb1, 0x50, 0x23, 0x89, 0x65, 0xc8, 0x39, 0x0d, 0x32, 0xc2, 0x91, 0x26, 0xd4, 0x72, 0x4c, 0x53,
+		0x5e, 0x40, 0x63, 0x96, 0xce, 0xe7, 0x34, 0x29, 0x38, 0xea, 0x42, 0x8f, 0x71, 0xbd, 0x00, 0x35,
+		0x69, 0x02, 0x68, 0x3b, 0xe6, 0x36, 0x2c, 0xed, 0x13, 0xdd, 0xeb, 0x57, 0xef, 0xfd, 0x46, 0x9c,
+		0x82, 0x8d, 0x5b, 0xf9, 0xdc, 0x5b, 0xc4, 0xfc, 0x16, 0x3e, 0x2e, 0xcc, 0x54, 0x1f, 0x80, 0x13,
+		0xcb, 0x64, 0x83, 0xce, 0xa0, 0x56, 0xac, 0x3d, 0x2f, 0x0c, 0xf2, 0x9d, 0x01, 0x05, 0x34, 0x08,
+		0x5e, 0xfd, 0x65, 0xc0, 0xa7, 0xa5, 0x17, 0x8f, 0x5e, 0xc0, 0xd9, 0xb8, 0xff, 0xc6, 0xb9, 0x7e,
+		0xeb, 0x3a, 0xde, 0xf0, 0xce, 0xc1, 0x6e, 0x6f, 0xe4, 0x8d, 0x86, 0xee, 0xa0, 0xff, 0xab, 0x37,
+		0xb8, 0xbd, 0xeb, 0xb9, 0x83, 0xeb, 0xd6, 0x07, 0xe8, 0x25, 0x74, 0x76, 0x91, 0xc6, 0x3f, 0x0f,
+		0x46, 0xde, 0xad, 0x33, 0x6d, 0x19, 0xc8, 0x84, 0xe7, 0xbb, 0x58, 0x57, 0x6f, 0x6f, 0x6e, 0x1c,
+		0xdc, 0x3a, 0x40, 0x17, 0xf0, 0x72, 0x17, 0xa7, 0xdf, 0xbb, 0xed, 0x3b, 0xae, 0x37, 0x9c, 0xbc,
+		0x71, 0x70, 0xeb, 0x10, 0x7d, 0x09, 0xe7, 0xbb, 0x98, 0x3d, 0xd7, 0x1d, 0x4e, 0xd5, 0x6f, 0xeb,
+		0xe8, 0xea, 0x0e, 0x9e, 0xf8, 0x6c, 0x55, 0x66, 0xe7, 0x55, 0xa5, 0xc7, 0xc3, 0x91, 0xca, 0xc2,
+		0xc8, 0xf8, 0xcd, 0x5e, 0x84, 0xf2, 0x5d, 0x3a, 0xb3, 0x7c, 0xb6, 0xb2, 0xff, 0xb5, 0x99, 0xad,
+		0x05, 0x8d, 0xb3, 0x3d, 0x9f, 0x2f, 0xe9, 0x1f, 0x09, 0x0f, 0xd7, 0x97, 0xb3, 0x13, 0x8d, 0x7d,
+		0xf3, 0xf7, 0x00, 0x45, 0xd4, 0x19, 0x2f, 0x64, 0x06, 0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/common.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6f, 0xdb, 0x36,
+		0x14, 0x9f, 0xe2, 0xda, 0x49, 0x9f, 0xdd, 0xd4, 0x63, 0xd6, 0xd4, 0xc9, 0xfe, 0x79, 0x06, 0x86,
+		0x66, 0x3b, 0x48, 0x88, 0x7b, 0x29, 0x56, 0x14, 0x83, 0x13, 0x3b, 0xab, 0xda, 0x2d, 0x31, 0x64,
+		0x23, 0xc1, 0x76, 0x98, 0x40, 0x4b, 0x4f, 0x2e, 0x67, 0x89, 0x14, 0x28, 0xca, 0x89, 0x6f, 0xfb,
+		0x24, 0x3b, 0xec, 0x2b, 0xed, 0x0b, 0x0d, 0x94, 0xe8, 0xd8, 0xee, 0x3c, 0xf4, 0x32, 0xec, 0x46,
+		0xbe, 0xdf, 0x9f, 0xf7, 0xa3, 0xf0, 0x48, 0x41, 0x3b, 0x9f, 0xa0, 0x74, 0x02, 0x1a, 0x22, 0x0f,
+		0xd0, 0xa1, 0x29, 0x73, 0xe6, 0xa7, 0x4e, 0x20, 0x92, 0x44, 0x70, 0x3b, 0x95, 0x42, 0x09, 0x72,
+		0xa0, 0x19, 0xb6, 0x61, 0xd8, 0x34, 0x65, 0xf6, 0xfc, 0xf4, 0xf8, 0x8b, 0xa9, 0x10, 0xd3, 0x18,
+		0x9d, 0x82, 0x32, 0xc9, 0x23, 0x27, 0xcc, 0x25, 0x55, 0x6c, 0x29, 0xea, 0xbc, 0x85, 0x8f, 0x6f,
+		0x84, 0x9c, 0x45, 0xb1, 0xb8, 0x1d, 0xdc, 0x61, 0x90, 0x6b, 0x88, 0x7c, 0x09, 0xf5, 0x5b, 0x53,
+		0xf4, 0x59, 0xd8, 0xb2, 0xda, 0xd6, 0xc9, 0x43, 0x0f, 0x96, 0x25, 0x37, 0x24, 0x4f, 0xa0, 0x26,
+		0x73, 0xae, 0xb1, 0x9d, 0x02, 0xab, 0xca, 0x9c, 0xbb, 0x61, 0xa7, 0x03, 0x8d, 0xa5, 0xd9, 0x78,
+		0x91, 0x22, 0x21, 0xf0, 0x80, 0xd3, 0x04, 0x8d, 0x41, 0xb1, 0xd6, 0x9c, 0x5e, 0xa0, 0xd8, 0x9c,
+		0xa9, 0xc5, 0xbf, 0x72, 0x3e, 0x87, 0xdd, 0x21, 0x5d, 0xc4, 0x82, 0x86, 0x1a, 0x0e, 0xa9, 0xa2,
+		0x05, 0xdc, 0xf0, 0x8a, 0x75, 0xe7, 0x25, 0xec, 0x5e, 0x50, 0x16, 0xe7, 0x12, 0xc9, 0x21, 0xd4,
+		0x24, 0xd2, 0x4c, 0x70, 0xa3, 0x37, 0x3b, 0xd2, 0x82, 0xdd, 0x10, 0x15, 0x65, 0x71, 0x56, 0x24,
+		0x6c, 0x78, 0xcb, 0x6d, 0xe7, 0x0f, 0x0b, 0x1e, 0xfc, 0x84, 0x89, 0x20, 0xaf, 0xa0, 0x16, 0x31,
+		0x8c, 0xc3, 0xac, 0x65, 0xb5, 0x2b, 0x27, 0xf5, 0xee, 0xd7, 0xf6, 0x96, 0xef, 0x67, 0x6b, 0xaa,
+		0x7d, 0x51, 0xf0, 0x06, 0x5c, 0xc9, 0x85, 0x67, 0x44, 0xc7, 0x37, 0x50, 0x5f, 0x2b, 0x93, 0x26,
+		0x54, 0x66, 0xb8, 0x30, 0x29, 0xf4, 0x92, 0x74, 0xa1, 0x3a, 0xa7, 0x71, 0x8e, 0x45, 0x80, 0x7a,
+		0xf7, 0xb3, 0xad, 0xf6, 0xe6, 0x98, 0x5e, 0x49, 0xfd, 0x6e, 0xe7, 0x85, 0xd5, 0xf9, 0xd3, 0x82,
+		0xda, 0x6b, 0xa4, 0x21, 0x4a, 0xf2, 0xfd, 0x7b, 0x11, 0x9f, 0x6d, 0xf5, 0x28, 0xc9, 0xff, 0x6f,
+		0xc8, 0xbf, 0x2c, 0x68, 0x8e, 0x90, 0xca, 0xe0, 0x5d, 0x4f, 0x29, 0xc9, 0x26, 0xb9, 0xc2, 0x8c,
+		0xf8, 0xb0, 0xcf, 0x78, 0x88, 0x77, 0x18, 0xfa, 0x1b, 0xb1, 0x5f, 0x6c, 0x75, 0x7d, 0x5f, 0x6e,
+		0xbb, 0xa5, 0x76, 0xfd, 0x1c, 0x8f, 0xd8, 0x7a, 0xed, 0xf8, 0x57, 0x20, 0xff, 0x24, 0xfd, 0x87,
+		0xa7, 0x8a, 0x60, 0xaf, 0x4f, 0x15, 0x3d, 0x8b, 0xc5, 0x84, 0x5c, 0xc0, 0x23, 0xe4, 0x81, 0x08,
+		0x19, 0x9f, 0xfa, 0x6a, 0x91, 0x96, 0x03, 0xba, 0xdf, 0xfd, 0x6a, 0xab, 0xd7, 0xc0, 0x30, 0xf5,
+		0x44, 0x7b, 0x0d, 0x5c, 0xdb, 0xdd, 0x0f, 0xf0, 0xce, 0xda, 0x00, 0x0f, 0xcb, 0x4b, 0x87, 0xf2,
+		0x1a, 0x65, 0xc6, 0x04, 0x77, 0x79, 0x24, 0x34, 0x91, 0x25, 0x69, 0xbc, 0xbc, 0x08, 0x7a, 0x4d,
+		0x9e, 0xc1, 0xe3, 0x08, 0xa9, 0xca, 0x25, 0xfa, 0xf3, 0x92, 0x6a, 0x2e, 0xdc, 0xbe, 0x29, 0x1b,
+		0x83, 0xce, 0x5b, 0x78, 0x3a, 0xca, 0xd3, 0x54, 0x48, 0x85, 0xe1, 0x79, 0xcc, 0x90, 0x2b, 0x83,
+		0x64, 0xfa, 0xae, 0x4e, 0x85, 0x9f, 0x85, 0x33, 0xe3, 0x5c, 0x9d, 0x8a, 0x51, 0x38, 0x23, 0x47,
+		0xb0, 0xf7, 0x1b, 0x9d, 0xd3, 0x02, 0x28, 0x3d, 0x77, 0xf5, 0x7e, 0x14, 0xce, 0x3a, 0xbf, 0x57,
+		0xa0, 0xee, 0xa1, 0x92, 0x8b, 0xa1, 0x88, 0x59, 0xb0, 0x20, 0x7d, 0x68, 0x32, 0xce, 0x14, 0xa3,
+		0xb1, 0xcf, 0xb8, 0x42, 0x39, 0xa7, 0x65, 0xca, 0x7a, 0xf7, 0xc8, 0x2e, 0x9f, 0x17, 0x7b, 0xf9,
+		0xbc, 0xd8, 0x7d, 0xf3, 0xbc, 0x78, 0x8f, 0x8d, 0xc4, 0x35, 0x0a, 0xe2, 0xc0, 0xc1, 0x84, 0x06,
+		0x33, 0x11, 0x45, 0x7e, 0x20, 0x30, 0x8a, 0x58, 0xa0, 0x63, 0x16, 0xbd, 0x2d, 0x8f, 0x18, 0xe8,
+		0x7c, 0x85, 0xe8, 0xb6, 0x09, 0xbd, 0x63, 0x49, 0x9e, 0xac, 0xda, 0x56, 0x3e, 0xd8, 0xd6, 0x48,
+		0xee, 0xdb, 0x7e, 0xb3, 0x72, 0xa1, 0x4a, 0x61, 0x92, 0xaa, 0xac, 0xf5, 0xa0, 0x6d, 0x9d, 0x54,
+		0xef, 0xa9, 0x3d, 0x53, 0x26, 0xaf, 0xe0, 0x53, 0x2e, 0xb8, 0x2f, 0xf5, 0xd1, 0xe9, 0x24, 0x46,
+		0x1f, 0xa5, 0x14, 0xd2, 0x2f, 0x9f, 0x94, 0xac, 0x55, 0x6d, 0x57, 0x4e, 0x1e, 0x7a, 0x2d, 0x2e,
+		0xb8, 0xb7, 0x64, 0x0c, 0x34, 0xc1, 0x2b, 0x71, 0xf2, 0x06, 0x0e, 0xf0, 0x2e, 0x65, 0x65, 0x90,
+		0x55, 0xe4, 0xda, 0x87, 0x22, 0x93, 0x95, 0x6a, 0x99, 0xfa, 0xdb, 0x5b, 0x68, 0xac, 0xcf, 0x14,
+		0x39, 0x82, 0x27, 0x83, 0xcb, 0xf3, 0xab, 0xbe, 0x7b, 0xf9, 0x83, 0x3f, 0xfe, 0x79, 0x38, 0xf0,
+		0xdd, 0xcb, 0xeb, 0xde, 0x8f, 0x6e, 0xbf, 0xf9, 0x11, 0x39, 0x86, 0xc3, 0x4d, 0x68, 0xfc, 0xda,
+		0x73, 0x2f, 0xc6, 0xde, 0x4d, 0xd3, 0x22, 0x87, 0x40, 0x36, 0xb1, 0x37, 0xa3, 0xab, 0xcb, 0xe6,
+		0x0e, 0x69, 0xc1, 0x27, 0x9b, 0xf5, 0xa1, 0x77, 0x35, 0xbe, 0x7a, 0xde, 0xac, 0x9c, 0x5d, 0xc3,
+		0xd3, 0x40, 0x24, 0xdb, 0x86, 0xfc, 0x6c, 0xaf, 0x97, 0xb2, 0xa1, 0x4e, 0x3f, 0xb4, 0x7e, 0x71,
+		0xa6, 0x4c, 0xbd, 0xcb, 0x27, 0x76, 0x20, 0x12, 0x67, 0xe3, 0xc7, 0x64, 0x4f, 0x91, 0x97, 0x3f,
+		0x1b, 0xf3, 0x8f, 0x7a, 0x49, 0x53, 0x36, 0x3f, 0x9d, 0xd4, 0x8a, 0xda, 0xf3, 0xbf, 0x07, 0x00,
+		0xdc, 0x8c, 0x77, 0x9a, 0xc7, 0x06, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/tasklist.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x6e, 0x1b, 0x37,
+		0x10, 0xee, 0x4a, 0x8e, 0x2b, 0x8f, 0x6b, 0x67, 0xcb, 0x36, 0x8e, 0xa5, 0x34, 0xad, 0xaa, 0x43,
+		0x60, 0x04, 0xc5, 0x0a, 0x76, 0xd1, 0x53, 0x0f, 0x85, 0x6c, 0x19, 0xcd, 0xc2, 0xb2, 0x22, 0xac,
+		0x36, 0x06, 0xdc, 0x0b, 0x4b, 0x2d, 0x27, 0x32, 0xb1, 0x3f, 0x5c, 0x90, 0x5c, 0xff, 0xdc, 0xfa,
+		0x14, 0x7d, 0x98, 0x3e, 0x5d, 0x41, 0xee, 0xca, 0x75, 0x6c, 0x35, 0x37, 0x72, 0xbe, 0xf9, 0xe6,
+		0xe7, 0xe3, 0x0c, 0x61, 0x50, 0x2d, 0x50, 0x0d, 0x13, 0xc6, 0xb1, 0x48, 0x70, 0xc8, 0x4a, 0x31,
+		0xbc, 0x3e, 0x1c, 0x1a, 0xa6, 0xd3, 0x4c, 0x68, 0x13, 0x94, 0x4a, 0x1a, 0x49, 0xbe, 0xb1, 0x3e,
+		0x41, 0xe3, 0x13, 0xb0, 0x52, 0x04, 0xd7, 0x87, 0xbd, 0xef, 0x97, 0x52, 0x2e, 0x33, 0x1c, 0x3a,
+		0x97, 0x45, 0xf5, 0x71, 0xc8, 0x2b, 0xc5, 0x8c, 0x90, 0x45, 0x4d, 0xea, 0xfd, 0xf0, 0x18, 0x37,
+		0x22, 0x47, 0x6d, 0x58, 0x5e, 0x36, 0x0e, 0x4f, 0x02, 0xdc, 0x28, 0x56, 0x96, 0xa8, 0x74, 0x8d,
+		0x0f, 0x3e, 0x40, 0x27, 0x66, 0x3a, 0x9d, 0x08, 0x6d, 0x08, 0x81, 0x8d, 0x82, 0xe5, 0xb8, 0xef,
+		0xf5, 0xbd, 0x83, 0xad, 0xc8, 0x9d, 0xc9, 0x2f, 0xb0, 0x91, 0x8a, 0x82, 0xef, 0xb7, 0xfa, 0xde,
+		0xc1, 0xee, 0xd1, 0x8f, 0xc1, 0x9a, 0x22, 0x83, 0x55, 0x80, 0x33, 0x51, 0xf0, 0xc8, 0xb9, 0x0f,
+		0x18, 0xf8, 0x2b, 0xeb, 0x39, 0x1a, 0xc6, 0x99, 0x61, 0xe4, 0x1c, 0xbe, 0xcd, 0xd9, 0x2d, 0xb5,
+		0x6d, 0x6b, 0x5a, 0xa2, 0xa2, 0x1a, 0x13, 0x59, 0x70, 0x97, 0x6e, 0xfb, 0xe8, 0xbb, 0xa0, 0xae,
+		0x34, 0x58, 0x55, 0x1a, 0x8c, 0x65, 0xb5, 0xc8, 0xf0, 0x82, 0x65, 0x15, 0x46, 0x5f, 0xe7, 0xec,
+		0xd6, 0x06, 0xd4, 0x33, 0x54, 0x73, 0x47, 0x1b, 0x7c, 0x80, 0xee, 0x2a, 0xc5, 0x8c, 0x29, 0x23,
+		0xac, 0x2a, 0xf7, 0xb9, 0x7c, 0x68, 0xa7, 0x78, 0xd7, 0x74, 0x62, 0x8f, 0xe4, 0x0d, 0x3c, 0x97,
+		0x37, 0x05, 0x2a, 0x7a, 0x25, 0xb5, 0xa1, 0xae, 0xcf, 0x96, 0x43, 0x77, 0x9c, 0xf9, 0x9d, 0xd4,
+		0x66, 0xca, 0x72, 0x1c, 0xfc, 0xd5, 0x82, 0xdd, 0x55, 0xdc, 0xb9, 0x61, 0xa6, 0xd2, 0xe4, 0x27,
+		0x20, 0x0b, 0x96, 0xa4, 0x99, 0x5c, 0xd2, 0x44, 0x56, 0x85, 0xa1, 0x57, 0xa2, 0x30, 0x2e, 0x76,
+		0x3b, 0xf2, 0x1b, 0xe4, 0xc4, 0x02, 0xef, 0x44, 0x61, 0xc8, 0x6b, 0x00, 0x85, 0x8c, 0xd3, 0x0c,
+		0xaf, 0x31, 0x73, 0x39, 0xda, 0xd1, 0x96, 0xb5, 0x4c, 0xac, 0x81, 0xbc, 0x82, 0x2d, 0x96, 0xa4,
+		0x0d, 0xda, 0x76, 0x68, 0x87, 0x25, 0x69, 0x0d, 0xbe, 0x81, 0xe7, 0x8a, 0x19, 0x7c, 0xa8, 0xce,
+		0x46, 0xdf, 0x3b, 0xf0, 0xa2, 0x1d, 0x6b, 0xbe, 0xef, 0x9d, 0x8c, 0x61, 0xc7, 0xca, 0x48, 0x05,
+		0xa7, 0x8b, 0x4c, 0x26, 0xe9, 0xfe, 0x33, 0xa7, 0x61, 0xff, 0x7f, 0x9f, 0x27, 0x1c, 0x1f, 0x5b,
+		0xbf, 0x68, 0xdb, 0xd2, 0x42, 0xee, 0x2e, 0x64, 0x0f, 0x36, 0x4b, 0x56, 0x69, 0xe4, 0xfb, 0x9b,
+		0x7d, 0xef, 0xa0, 0x13, 0x35, 0xb7, 0xc1, 0x6f, 0xb0, 0xfd, 0x80, 0x43, 0xba, 0xd0, 0xd1, 0x86,
+		0x29, 0x43, 0x05, 0x6f, 0x9a, 0xfe, 0xd2, 0xdd, 0x43, 0x4e, 0x5e, 0xc0, 0x26, 0x16, 0xdc, 0x02,
+		0x75, 0x9f, 0xcf, 0xb0, 0xe0, 0x21, 0x1f, 0xfc, 0xed, 0x01, 0xcc, 0x64, 0x96, 0xa1, 0x0a, 0x8b,
+		0x8f, 0x92, 0x8c, 0xc1, 0xcf, 0x98, 0x36, 0x94, 0x25, 0x09, 0x6a, 0x4d, 0xed, 0x88, 0x36, 0x8f,
+		0xde, 0x7b, 0xf2, 0xe8, 0xf1, 0x6a, 0x7e, 0xa3, 0x5d, 0xcb, 0x19, 0x39, 0x8a, 0x35, 0x92, 0x1e,
+		0x74, 0x04, 0xc7, 0xc2, 0x08, 0x73, 0xd7, 0xbc, 0xdc, 0xfd, 0x7d, 0x9d, 0x6e, 0xed, 0x35, 0xba,
+		0x0d, 0xfe, 0xf1, 0xa0, 0x3b, 0x37, 0x22, 0x49, 0xef, 0x4e, 0x6f, 0x31, 0xa9, 0xec, 0xc8, 0x8c,
+		0x8c, 0x51, 0x62, 0x51, 0x19, 0xd4, 0xe4, 0x77, 0xf0, 0x6f, 0xa4, 0x4a, 0x51, 0xb9, 0x19, 0xa5,
+		0x76, 0x37, 0x9b, 0x3a, 0x5f, 0x7f, 0x76, 0xee, 0xa3, 0xdd, 0x9a, 0x76, 0xbf, 0x48, 0x31, 0x74,
+		0x75, 0x72, 0x85, 0xbc, 0xca, 0x90, 0x1a, 0x49, 0x6b, 0xf5, 0x6c, 0xdb, 0xb2, 0x32, 0xae, 0xf6,
+		0xed, 0xa3, 0xee, 0xd3, 0x71, 0x6f, 0x36, 0x3b, 0xda, 0x5b, 0x71, 0x63, 0x39, 0xb7, 0xcc, 0xb8,
+		0x26, 0xbe, 0xfd, 0x13, 0xbe, 0x7a, 0xb8, 0x69, 0xa4, 0x07, 0x7b, 0xf1, 0x68, 0x7e, 0x46, 0x27,
+		0xe1, 0x3c, 0xa6, 0x67, 0xe1, 0x74, 0x4c, 0xc3, 0xe9, 0xc5, 0x68, 0x12, 0x8e, 0xfd, 0x2f, 0x48,
+		0x17, 0x5e, 0x3c, 0xc2, 0xa6, 0xef, 0xa3, 0xf3, 0xd1, 0xc4, 0xf7, 0xd6, 0x40, 0xf3, 0x38, 0x3c,
+		0x39, 0xbb, 0xf4, 0x5b, 0x6f, 0xf9, 0x7f, 0x19, 0xe2, 0xbb, 0x12, 0x3f, 0xcd, 0x10, 0x5f, 0xce,
+		0x4e, 0x1f, 0x64, 0x78, 0x05, 0x2f, 0x1f, 0x61, 0xe3, 0xd3, 0x93, 0x70, 0x1e, 0xbe, 0x9f, 0xfa,
+		0xde, 0x1a, 0x70, 0x74, 0x12, 0x87, 0x17, 0x61, 0x7c, 0xe9, 0xb7, 0x8e, 0x2f, 0xe0, 0x65, 0x22,
+		0xf3, 0x75, 0x8a, 0x1e, 0x77, 0x46, 0xa5, 0x98, 0x59, 0x41, 0x66, 0xde, 0x1f, 0xc3, 0xa5, 0x30,
+		0x57, 0xd5, 0x22, 0x48, 0x64, 0x3e, 0xfc, 0xe4, 0xfb, 0x0c, 0x96, 0x58, 0xd4, 0xff, 0x59, 0xf3,
+		0x93, 0xfe, 0xca, 0x4a, 0x71, 0x7d, 0xb8, 0xd8, 0x74, 0xb6, 0x9f, 0xff, 0x1d, 0x00, 0xde, 0xd0,
+		0xdf, 0x62, 0x6d, 0x05, 0x00, 0x00,
+	},
+	// google/protobuf/wrappers.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0x2f, 0x4a, 0x2c,
+		0x28, 0x48, 0x2d, 0x2a, 0xd6, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0xca,
+		0x5c, 0xdc, 0x2e, 0xf9, 0xa5, 0x49, 0x39, 0xa9, 0x61, 0x89, 0x39, 0xa5, 0xa9, 0x42, 0x22, 0x5c,
+		0xac, 0x65, 0x20, 0x86, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x63, 0x10, 0x84, 0xa3, 0xa4, 0xc4, 0xc5,
+		0xe5, 0x96, 0x93, 0x9f, 0x58, 0x82, 0x45, 0x0d, 0x13, 0x92, 0x1a, 0xcf, 0xbc, 0x12, 0x33, 0x13,
+		0x2c, 0x6a, 0x98, 0x61, 0x6a, 0x94, 0xb9, 0xb8, 0x43, 0x71, 0x29, 0x62, 0x41, 0x35, 0xc8, 0xd8,
+		0x08, 0x8b, 0x1a, 0x56, 0x34, 0x83, 0xb0, 0x2a, 0xe2, 0x85, 0x29, 0x52, 0xe4, 0xe2, 0x74, 0xca,
+		0xcf, 0xcf, 0xc1, 0xa2, 0x84, 0x03, 0xc9, 0x9c, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0x74, 0x2c, 0x8a,
+		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x33,
+		0x23, 0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xf0, 0x3a, 0xf1, 0x86, 0x43, 0xc3, 0x3f, 0x00,
+		0x24, 0x12, 0xc0, 0x18, 0x65, 0x08, 0x55, 0x91, 0x9e, 0x9f, 0x93, 0x98, 0x97, 0xae, 0x97, 0x5f,
+		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
+		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
+		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
+	},
+}
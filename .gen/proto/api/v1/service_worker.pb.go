@@ -133,6 +133,9 @@ type PollForDecisionTaskResponse struct {
 	StartedTime               *types.Timestamp          `protobuf:"bytes,13,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
 	Queries                   map[string]*WorkflowQuery `protobuf:"bytes,14,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	NextEventId               int64                     `protobuf:"varint,15,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
+	HistorySize               int64                     `protobuf:"varint,16,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
+	HistoryCount              int64                     `protobuf:"varint,17,opt,name=history_count,json=historyCount,proto3" json:"history_count,omitempty"`
+	SuggestContinueAsNew      bool                      `protobuf:"varint,18,opt,name=suggest_continue_as_new,json=suggestContinueAsNew,proto3" json:"suggest_continue_as_new,omitempty"`
 	XXX_NoUnkeyedLiteral      struct{}                  `json:"-"`
 	XXX_unrecognized          []byte                    `json:"-"`
 	XXX_sizecache             int32                     `json:"-"`
@@ -276,6 +279,27 @@ func (m *PollForDecisionTaskResponse) GetNextEventId() int64 {
 	return 0
 }
 
+func (m *PollForDecisionTaskResponse) GetHistorySize() int64 {
+	if m != nil {
+		return m.HistorySize
+	}
+	return 0
+}
+
+func (m *PollForDecisionTaskResponse) GetHistoryCount() int64 {
+	if m != nil {
+		return m.HistoryCount
+	}
+	return 0
+}
+
+func (m *PollForDecisionTaskResponse) GetSuggestContinueAsNew() bool {
+	if m != nil {
+		return m.SuggestContinueAsNew
+	}
+	return false
+}
+
 type RespondDecisionTaskCompletedRequest struct {
 	TaskToken                  []byte                          `protobuf:"bytes,1,opt,name=task_token,json=taskToken,proto3" json:"task_token,omitempty"`
 	Decisions                  []*Decision                     `protobuf:"bytes,2,rep,name=decisions,proto3" json:"decisions,omitempty"`
@@ -1941,132 +1965,135 @@ func init() {
 }
 
 var fileDescriptor_00684e887839a48c = []byte{
-	// 1986 bytes of a gzipped FileDescriptorProto
+	// 2042 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcd, 0x6f, 0xdb, 0xc8,
-	0x15, 0x07, 0xfd, 0xed, 0x27, 0x7f, 0xc8, 0x13, 0x34, 0x95, 0x95, 0xc4, 0x71, 0xb4, 0x4d, 0xe2,
-	0x6d, 0x17, 0x52, 0xe3, 0xdd, 0x66, 0xb3, 0xc9, 0xa6, 0xa8, 0x3f, 0x62, 0xc4, 0xc5, 0x76, 0xeb,
-	0x65, 0xb4, 0x59, 0x60, 0x0b, 0x84, 0x18, 0x91, 0x63, 0x6b, 0x60, 0x8a, 0xa3, 0x90, 0x43, 0xd9,
-	0xba, 0xf4, 0xd0, 0xe3, 0x6e, 0x0f, 0x05, 0x8a, 0x6e, 0x2f, 0x05, 0x72, 0xee, 0x1f, 0xd0, 0xff,
-	0xa1, 0xc7, 0xde, 0x7b, 0x69, 0x73, 0xed, 0xff, 0x50, 0x14, 0x9c, 0x19, 0x52, 0x94, 0x34, 0xa4,
-	0x3e, 0x7a, 0x70, 0x80, 0xbd, 0x99, 0xc3, 0xdf, 0xfb, 0xf1, 0xcd, 0x7b, 0x6f, 0xde, 0xfc, 0x66,
-	0x2c, 0xd8, 0x09, 0x1b, 0xc4, 0xaf, 0xd9, 0xd8, 0x21, 0x9e, 0x4d, 0x6a, 0xb8, 0x4d, 0x6b, 0x9d,
-	0x07, 0xb5, 0x80, 0xf8, 0x1d, 0x6a, 0x13, 0xeb, 0x82, 0xf9, 0xe7, 0xc4, 0xaf, 0xb6, 0x7d, 0xc6,
-	0x19, 0xba, 0x16, 0x21, 0xab, 0x0a, 0x59, 0xc5, 0x6d, 0x5a, 0xed, 0x3c, 0x28, 0x6f, 0x9d, 0x31,
-	0x76, 0xe6, 0x92, 0x9a, 0x80, 0x34, 0xc2, 0xd3, 0x9a, 0x13, 0xfa, 0x98, 0x53, 0xe6, 0x49, 0xa3,
-	0xf2, 0xed, 0xc1, 0xf7, 0x9c, 0xb6, 0x48, 0xc0, 0x71, 0xab, 0xad, 0x00, 0x43, 0x04, 0x17, 0x3e,
-	0x6e, 0xb7, 0x89, 0x1f, 0xa8, 0xf7, 0xdb, 0x3a, 0xff, 0x6c, 0xd6, 0x6a, 0x25, 0x9f, 0xa8, 0xe8,
-	0x10, 0x0e, 0xb1, 0x69, 0xd0, 0x73, 0xe3, 0x8e, 0x0e, 0xd3, 0xa4, 0x01, 0x67, 0x7e, 0x37, 0xf6,
-	0x54, 0x07, 0x79, 0x1d, 0x92, 0x04, 0xa0, 0xfd, 0x0e, 0xc7, 0xc1, 0xb9, 0x4b, 0x03, 0x9e, 0x87,
-	0x89, 0xa2, 0x78, 0xea, 0xb2, 0x0b, 0x89, 0xa9, 0xfc, 0xcd, 0x80, 0xf2, 0x09, 0x73, 0xdd, 0x23,
-	0xe6, 0x1f, 0x2a, 0x2f, 0xeb, 0x38, 0x38, 0x37, 0xc9, 0xeb, 0x90, 0x04, 0x1c, 0x5d, 0x87, 0x05,
-	0x87, 0xb5, 0x30, 0xf5, 0x4a, 0xc6, 0xb6, 0xb1, 0xb3, 0x6c, 0xaa, 0x27, 0xf4, 0x18, 0x96, 0xa3,
-	0x8f, 0x59, 0xd1, 0xd7, 0x4a, 0x33, 0xdb, 0xc6, 0x4e, 0x61, 0xf7, 0x56, 0x55, 0x93, 0x92, 0x6a,
-	0x44, 0xf6, 0x19, 0x0d, 0xb8, 0xb9, 0xc4, 0xd5, 0x5f, 0xa8, 0x0c, 0x4b, 0xd4, 0x21, 0x1e, 0xa7,
-	0xbc, 0x5b, 0x9a, 0x15, 0xac, 0xc9, 0x33, 0xba, 0x0f, 0xeb, 0x0d, 0xea, 0x61, 0xbf, 0x6b, 0xd9,
-	0x4d, 0x62, 0x9f, 0x07, 0x61, 0xab, 0x34, 0x27, 0x20, 0x6b, 0x72, 0xf8, 0x40, 0x8d, 0x56, 0xfe,
-	0xbb, 0x08, 0x37, 0xb4, 0x7e, 0x07, 0x6d, 0xe6, 0x05, 0x04, 0xdd, 0x02, 0x10, 0x0e, 0x72, 0x76,
-	0x4e, 0xa4, 0xf3, 0x2b, 0xa6, 0x70, 0xb9, 0x1e, 0x0d, 0xa0, 0x2f, 0x01, 0xc5, 0x81, 0xb0, 0xc8,
-	0x25, 0xb1, 0xc3, 0xa8, 0x4a, 0xd4, 0x44, 0xee, 0x69, 0x27, 0xf2, 0x95, 0x82, 0x3f, 0x8b, 0xd1,
-	0xe6, 0xc6, 0xc5, 0xe0, 0x10, 0x3a, 0x82, 0xd5, 0x84, 0x96, 0x77, 0xdb, 0x44, 0xcc, 0xaf, 0xb0,
-	0x7b, 0x27, 0x97, 0xb1, 0xde, 0x6d, 0x13, 0x73, 0xe5, 0x22, 0xf5, 0x84, 0x5e, 0xc2, 0x66, 0xdb,
-	0x27, 0x1d, 0xca, 0xc2, 0xc0, 0x0a, 0x38, 0xf6, 0x39, 0x71, 0x2c, 0xd2, 0x21, 0x1e, 0xb7, 0xa8,
-	0x23, 0x02, 0x52, 0xd8, 0xbd, 0x51, 0x95, 0xb5, 0x5a, 0x8d, 0x6b, 0xb5, 0x7a, 0xec, 0xf1, 0x87,
-	0x1f, 0xbd, 0xc4, 0x6e, 0x48, 0xcc, 0xeb, 0xb1, 0xf5, 0x0b, 0x69, 0xfc, 0x2c, 0xb2, 0x3d, 0x76,
-	0xd0, 0x0e, 0x14, 0x87, 0xe8, 0xe6, 0xb7, 0x8d, 0x9d, 0x59, 0x73, 0x2d, 0xe8, 0x47, 0x96, 0x60,
-	0x11, 0x73, 0x4e, 0x5a, 0x6d, 0x5e, 0x5a, 0x10, 0x80, 0xf8, 0x11, 0x7d, 0x00, 0xa8, 0x81, 0xed,
-	0x73, 0x97, 0x9d, 0x59, 0x36, 0x0b, 0x3d, 0x6e, 0x35, 0xa9, 0xc7, 0x4b, 0x8b, 0x02, 0x54, 0x54,
-	0x6f, 0x0e, 0xa2, 0x17, 0xcf, 0xa9, 0xc7, 0xd1, 0x43, 0x58, 0x54, 0x95, 0x5d, 0x5a, 0x12, 0x7e,
-	0xdf, 0xd4, 0xc6, 0xe2, 0xb9, 0xc4, 0x98, 0x31, 0x18, 0xdd, 0x83, 0x75, 0x8f, 0x5c, 0x72, 0xab,
-	0x8d, 0xcf, 0x88, 0x4a, 0xe2, 0xb2, 0x48, 0xe2, 0x6a, 0x34, 0x7c, 0x82, 0xcf, 0x88, 0x4c, 0xe4,
-	0x23, 0x98, 0x17, 0xcb, 0xa2, 0x04, 0x82, 0xbd, 0x92, 0x1b, 0xe9, 0x2f, 0x22, 0xa4, 0x29, 0x0d,
-	0xd0, 0x2b, 0xb8, 0x39, 0x5c, 0x02, 0x56, 0xaf, 0xaa, 0x0b, 0xe3, 0x54, 0xf5, 0xe6, 0x50, 0x0d,
-	0xc4, 0xaf, 0xd0, 0x1e, 0xac, 0x05, 0x76, 0x93, 0x38, 0xa1, 0x4b, 0x1c, 0x2b, 0x6a, 0x34, 0xa5,
-	0x15, 0xc1, 0x58, 0x1e, 0x4a, 0x5c, 0x3d, 0xee, 0x42, 0xe6, 0x6a, 0x62, 0x11, 0x8d, 0xa1, 0xa7,
-	0xb0, 0x12, 0xa7, 0x4b, 0x10, 0xac, 0x8e, 0x24, 0x28, 0x28, 0xbc, 0x30, 0xff, 0x0a, 0x16, 0xa3,
-	0xa9, 0x52, 0x12, 0x94, 0xd6, 0xb6, 0x67, 0x77, 0x0a, 0xbb, 0x4f, 0xb5, 0x93, 0xc9, 0x59, 0x46,
-	0xd5, 0x2f, 0xa4, 0xfd, 0x33, 0x8f, 0x47, 0xc9, 0x51, 0x6c, 0xa8, 0x02, 0x22, 0x0b, 0xbd, 0x1a,
-	0x5a, 0x17, 0xd9, 0x2f, 0x44, 0x83, 0xaa, 0x80, 0xca, 0xaf, 0x60, 0x25, 0x6d, 0x8c, 0x8a, 0x30,
-	0x7b, 0x4e, 0xba, 0xaa, 0x8d, 0x44, 0x7f, 0x46, 0xa9, 0xeb, 0x44, 0xd5, 0xaa, 0x96, 0xdd, 0x58,
-	0xa9, 0x13, 0x06, 0x8f, 0x67, 0x1e, 0x19, 0x95, 0xbf, 0xce, 0xc3, 0x7b, 0xd2, 0x4d, 0x27, 0xed,
-	0xf9, 0x01, 0x6b, 0xb5, 0x5d, 0xc2, 0x89, 0x13, 0x77, 0xb0, 0x11, 0x8d, 0xe0, 0x09, 0x2c, 0xc7,
-	0xdd, 0x39, 0x28, 0xcd, 0x88, 0x28, 0xe9, 0x53, 0x1e, 0x7f, 0xc4, 0xec, 0xe1, 0xd1, 0x4f, 0x60,
-	0xa3, 0x57, 0x39, 0x36, 0xf3, 0x38, 0xb9, 0xe4, 0x62, 0xc9, 0xaf, 0x98, 0xc5, 0xe4, 0xc5, 0x81,
-	0x1c, 0xef, 0x6b, 0x7b, 0x73, 0x03, 0x6d, 0xef, 0x37, 0xb0, 0x11, 0x70, 0x6a, 0x9f, 0x77, 0x2d,
-	0xcc, 0xb9, 0x4f, 0x1b, 0x21, 0x27, 0x81, 0x58, 0x98, 0x85, 0xdd, 0xaa, 0xd6, 0x9b, 0x17, 0x02,
-	0x9d, 0x14, 0xdd, 0x5e, 0x62, 0x65, 0x16, 0x25, 0x51, 0x6f, 0x04, 0x7d, 0x0c, 0x25, 0x9f, 0xf0,
-	0xd0, 0xf7, 0x2c, 0x8f, 0x5c, 0x58, 0xb1, 0xf7, 0xa2, 0xd2, 0xc5, 0xda, 0x5e, 0x32, 0x7f, 0x20,
-	0xdf, 0x7f, 0x4e, 0x2e, 0xd2, 0xa1, 0x44, 0xfb, 0xb0, 0x75, 0xca, 0x7c, 0x9b, 0x58, 0xb6, 0x4f,
-	0x30, 0x27, 0x1a, 0xf3, 0x45, 0x61, 0x5e, 0x16, 0xa8, 0x03, 0x01, 0x1a, 0xe4, 0xd0, 0x34, 0xf4,
-	0x25, 0x5d, 0x43, 0x47, 0x0c, 0x56, 0xc5, 0xba, 0xb4, 0x7c, 0x12, 0x84, 0x2e, 0x0f, 0x4a, 0xcb,
-	0x22, 0x19, 0xbf, 0xd4, 0x4e, 0x7f, 0x8c, 0xc4, 0x57, 0x65, 0xc5, 0x48, 0x32, 0x59, 0xbf, 0x2b,
-	0xaf, 0x53, 0x43, 0x65, 0x0a, 0x1b, 0x43, 0x10, 0x4d, 0x95, 0xfe, 0xbc, 0xbf, 0x4a, 0x77, 0xc6,
-	0xa8, 0x52, 0x41, 0x98, 0xae, 0xd5, 0x37, 0xb3, 0xf0, 0xa3, 0x7c, 0x97, 0xd5, 0xae, 0xf5, 0x25,
-	0xac, 0xf6, 0x07, 0xd8, 0x10, 0x1f, 0xfd, 0xe9, 0xa4, 0xeb, 0xd6, 0x5c, 0x71, 0xd2, 0x49, 0x78,
-	0x63, 0xc0, 0x16, 0xb6, 0x39, 0xed, 0x50, 0x4e, 0x49, 0x60, 0x71, 0x66, 0x39, 0x34, 0x68, 0x63,
-	0x6e, 0x37, 0x2d, 0x97, 0xd9, 0xd8, 0x75, 0xbb, 0xaa, 0xf4, 0xbf, 0x9e, 0x22, 0xda, 0xaa, 0x53,
-	0xec, 0x25, 0xfc, 0x75, 0x76, 0xa8, 0xd8, 0x3f, 0x93, 0xe4, 0x32, 0xfa, 0x37, 0x70, 0x36, 0xa2,
-	0xfc, 0x5b, 0xd8, 0x1e, 0x45, 0xa0, 0xc9, 0xcd, 0x61, 0x7f, 0x6e, 0xf4, 0x4b, 0x45, 0xf1, 0x76,
-	0x05, 0x57, 0x4c, 0x7c, 0xec, 0x9d, 0xb2, 0x74, 0x86, 0x7e, 0x37, 0x03, 0xdb, 0x9a, 0x69, 0x1e,
-	0x61, 0xea, 0x8e, 0xdd, 0x4a, 0xf6, 0x61, 0xde, 0xc6, 0x61, 0x20, 0xbd, 0x59, 0xdb, 0xfd, 0x20,
-	0xb7, 0x8d, 0xf4, 0xd8, 0x0f, 0x22, 0x1b, 0x53, 0x9a, 0x46, 0xdb, 0xa5, 0x43, 0x38, 0xa6, 0x6e,
-	0xa0, 0xa4, 0x83, 0x7e, 0xbb, 0x3c, 0xc1, 0x5d, 0x97, 0x61, 0xc7, 0x8c, 0xc1, 0xb9, 0xcd, 0x45,
-	0xb3, 0x04, 0xe7, 0xb5, 0x9a, 0xea, 0x3d, 0xb8, 0x93, 0x13, 0x03, 0x99, 0xe7, 0xca, 0xbf, 0x7b,
-	0x82, 0x31, 0x8e, 0xec, 0x55, 0x0a, 0xc6, 0x17, 0x80, 0x12, 0x5e, 0xab, 0x45, 0x38, 0x76, 0x30,
-	0xc7, 0x4a, 0x22, 0xdd, 0xcd, 0xfd, 0xc0, 0xaf, 0x14, 0xd8, 0x2c, 0xf2, 0x81, 0x91, 0xca, 0xb7,
-	0x4b, 0x89, 0xb8, 0xec, 0x9f, 0xe3, 0x95, 0x8a, 0xcb, 0xdb, 0x50, 0x50, 0x4b, 0xa8, 0x1b, 0xed,
-	0xb9, 0x32, 0x12, 0x10, 0x0f, 0x1d, 0x3b, 0x91, 0xfa, 0x4c, 0x00, 0x42, 0x7d, 0xce, 0xe5, 0xa8,
-	0xcf, 0x64, 0x62, 0x42, 0x7d, 0xe2, 0xd4, 0x13, 0xda, 0x85, 0x79, 0xea, 0xb5, 0x43, 0xae, 0x76,
-	0xa0, 0xfc, 0x12, 0x94, 0x50, 0x8d, 0xda, 0x59, 0xf8, 0x7f, 0xd5, 0xce, 0xe2, 0x64, 0x6a, 0xa7,
-	0x0e, 0x9b, 0x31, 0x5f, 0xd4, 0xe1, 0x6c, 0x97, 0x05, 0x44, 0x10, 0xb1, 0x90, 0x2b, 0xed, 0xb9,
-	0x39, 0xc4, 0x75, 0xa8, 0x0e, 0x88, 0xe6, 0xf5, 0xd8, 0xb6, 0xce, 0x0e, 0x22, 0xcb, 0xba, 0x34,
-	0x44, 0x9f, 0xc3, 0x75, 0xf1, 0x91, 0x61, 0xca, 0xe5, 0x51, 0x94, 0xd7, 0x84, 0xe1, 0x00, 0xdf,
-	0x11, 0x6c, 0x34, 0x09, 0xf6, 0x79, 0x83, 0x60, 0x9e, 0x50, 0xc1, 0x28, 0xaa, 0x62, 0x62, 0x13,
-	0xf3, 0xa4, 0xf4, 0x79, 0x24, 0x54, 0xe7, 0x7b, 0xfa, 0xfc, 0x15, 0x6c, 0xf5, 0x67, 0xc2, 0x62,
-	0xa7, 0x16, 0x6f, 0xd2, 0xc0, 0x8a, 0x0d, 0x46, 0xeb, 0xd0, 0x72, 0x5f, 0x66, 0x7e, 0x7d, 0x5a,
-	0x6f, 0xd2, 0x60, 0x4f, 0xf1, 0x1f, 0xa7, 0x67, 0x10, 0x37, 0xab, 0xd5, 0x31, 0x2a, 0xa5, 0x37,
-	0x89, 0x43, 0xd5, 0xb5, 0x86, 0x8e, 0x4b, 0x6b, 0xd3, 0x1d, 0x97, 0xee, 0xc3, 0x7a, 0xc2, 0xa3,
-	0xba, 0xcf, 0xba, 0xec, 0x70, 0xf1, 0xf0, 0xa1, 0xec, 0x42, 0x1f, 0xc2, 0x42, 0x93, 0x60, 0x87,
-	0xf8, 0xa5, 0xa2, 0x3a, 0x44, 0x69, 0x0f, 0x23, 0x02, 0x62, 0x2a, 0x68, 0xe5, 0x3b, 0x23, 0x51,
-	0x9a, 0xe9, 0x6e, 0x30, 0xa9, 0xd2, 0xfc, 0x08, 0x16, 0xa4, 0xb4, 0x51, 0x9d, 0x20, 0x3f, 0x58,
-	0x0a, 0x9b, 0xd7, 0xfb, 0x2a, 0xf7, 0x12, 0x55, 0x91, 0xe1, 0x97, 0x6a, 0xd9, 0xdf, 0xce, 0xc0,
-	0xfd, 0x3c, 0xe0, 0x7e, 0xf7, 0xf8, 0x70, 0x54, 0xff, 0xbe, 0xaa, 0x9e, 0xd6, 0x8b, 0xda, 0xdc,
-	0x94, 0x51, 0x9b, 0x1f, 0x88, 0xda, 0x8f, 0x61, 0x67, 0x74, 0x30, 0x54, 0xe4, 0xfe, 0x64, 0x24,
-	0xb2, 0x20, 0x0d, 0x9e, 0x48, 0x16, 0x3c, 0x84, 0xc5, 0x53, 0x4c, 0xdd, 0xd0, 0x27, 0xb9, 0x89,
-	0x3f, 0x92, 0x18, 0x33, 0x06, 0xe7, 0x66, 0xbe, 0xb7, 0x53, 0xeb, 0xdc, 0x52, 0xce, 0x7f, 0x33,
-	0xa3, 0xad, 0x0f, 0x89, 0x7a, 0x97, 0x73, 0x9e, 0x8a, 0xd8, 0xdc, 0xb4, 0x11, 0x1b, 0xcc, 0xfa,
-	0x7d, 0xb8, 0x3b, 0x22, 0x16, 0x2a, 0x6a, 0x7f, 0x36, 0xa0, 0xa2, 0xab, 0x0f, 0xec, 0xd9, 0x64,
-	0xa2, 0xa4, 0xc7, 0xad, 0x71, 0x66, 0x5a, 0x1d, 0x37, 0x98, 0xf4, 0xbb, 0xfa, 0x36, 0x94, 0x38,
-	0xa6, 0x26, 0xf0, 0xfb, 0x19, 0xb8, 0x97, 0x83, 0x7b, 0xc7, 0x13, 0x1f, 0x47, 0x6d, 0x6e, 0xda,
-	0xa8, 0x0d, 0x26, 0xfe, 0x7d, 0x7d, 0xef, 0xeb, 0x8b, 0x46, 0x5f, 0xea, 0x6d, 0xe6, 0xf7, 0x41,
-	0x9f, 0xc7, 0xbb, 0xd6, 0x15, 0xa6, 0xfe, 0x24, 0x4a, 0x7d, 0x8e, 0x63, 0x4a, 0x97, 0xbe, 0x0f,
-	0x45, 0x5b, 0x4c, 0xcc, 0xf2, 0xa5, 0xaf, 0xc4, 0x11, 0xfe, 0x2d, 0x99, 0xeb, 0x72, 0xdc, 0x8c,
-	0x87, 0x55, 0x95, 0x64, 0x52, 0x7e, 0xdf, 0xaa, 0xa4, 0x1e, 0x55, 0xc9, 0x88, 0x68, 0x4c, 0x1e,
-	0xe4, 0x7f, 0xf6, 0xb6, 0x0f, 0x71, 0x33, 0x30, 0x8d, 0x6c, 0xf8, 0xc5, 0x80, 0x6c, 0x18, 0xff,
-	0x02, 0x22, 0xde, 0x0c, 0x5f, 0xc2, 0x35, 0xf9, 0xaf, 0x13, 0xab, 0x43, 0x7c, 0x71, 0xb5, 0x40,
-	0xbd, 0x53, 0xa6, 0xce, 0x97, 0xd9, 0x89, 0x22, 0xfe, 0x4b, 0x09, 0x17, 0x67, 0xe5, 0x8d, 0x8b,
-	0xc1, 0xa1, 0xd4, 0x26, 0xa4, 0x9b, 0x5c, 0xac, 0x3d, 0x0c, 0x28, 0x9b, 0x24, 0x20, 0x5c, 0xde,
-	0x58, 0x25, 0xa7, 0xbb, 0x2b, 0xa9, 0xad, 0xca, 0x2d, 0xb8, 0xa1, 0x75, 0x46, 0x3a, 0xbb, 0xfb,
-	0x9f, 0x75, 0x58, 0x96, 0x53, 0xdf, 0x3b, 0x39, 0x46, 0x97, 0x70, 0x4d, 0x73, 0xc5, 0x82, 0x6a,
-	0xe3, 0x5f, 0xc6, 0x88, 0x39, 0x96, 0x27, 0xbe, 0xbd, 0x41, 0x7f, 0x34, 0xe0, 0x66, 0xde, 0xa5,
-	0x0b, 0x7a, 0x34, 0xed, 0xad, 0x58, 0xf9, 0x93, 0xa9, 0x6f, 0x78, 0xd0, 0x37, 0x06, 0x6c, 0x66,
-	0xde, 0x0f, 0xa0, 0x9f, 0x8d, 0x4b, 0xdc, 0x27, 0x9e, 0xca, 0x0f, 0x27, 0x35, 0x53, 0xce, 0xf4,
-	0x92, 0x93, 0x5e, 0xb1, 0xf9, 0xc9, 0xd1, 0xdc, 0x57, 0xe4, 0x27, 0x47, 0x7b, 0xf8, 0x4f, 0x25,
-	0x47, 0x2b, 0x20, 0xf3, 0x93, 0x93, 0x77, 0x82, 0xc8, 0x4f, 0x4e, 0xae, 0xc6, 0x47, 0x6f, 0xf4,
-	0x4a, 0xb5, 0x4f, 0xd6, 0xa2, 0x4f, 0x27, 0xe6, 0x4f, 0xed, 0x03, 0xe5, 0xa7, 0x53, 0x5a, 0x0f,
-	0x97, 0xcf, 0xb0, 0x04, 0xcb, 0x2f, 0x9f, 0x4c, 0xed, 0x9d, 0x5f, 0x3e, 0xd9, 0xda, 0x18, 0x7d,
-	0x67, 0xc0, 0xad, 0x5c, 0x3d, 0x88, 0x3e, 0x99, 0x8c, 0x39, 0x1d, 0xa8, 0xc7, 0xd3, 0x98, 0x2a,
-	0xc7, 0xfe, 0x60, 0x88, 0x16, 0x95, 0xa5, 0x57, 0xd0, 0xc7, 0x63, 0x27, 0xa1, 0x5f, 0xb0, 0x96,
-	0x1f, 0x4d, 0x6e, 0xa8, 0x5c, 0xfa, 0x8b, 0x01, 0xb7, 0x47, 0x48, 0x28, 0xf4, 0x64, 0x52, 0xf6,
-	0x74, 0xbc, 0x3e, 0x9d, 0xce, 0xb8, 0x2f, 0x62, 0x99, 0x7b, 0x77, 0x66, 0xc4, 0x46, 0xe9, 0xbc,
-	0xcc, 0x88, 0x8d, 0xd6, 0x61, 0x32, 0x62, 0xb9, 0x72, 0x22, 0x33, 0x62, 0xe3, 0x48, 0xb2, 0xcc,
-	0x88, 0x8d, 0xa7, 0x60, 0x52, 0x2b, 0x71, 0x78, 0xe7, 0xce, 0x5f, 0x89, 0x99, 0x32, 0x26, 0x7f,
-	0x25, 0x66, 0x0b, 0x84, 0xa8, 0x91, 0x6b, 0xb6, 0xe4, 0x8c, 0x46, 0x9e, 0xad, 0x24, 0x32, 0x1a,
-	0x79, 0xce, 0x6e, 0xbf, 0xdf, 0xf8, 0xfb, 0xdb, 0x2d, 0xe3, 0x1f, 0x6f, 0xb7, 0x8c, 0x7f, 0xbd,
-	0xdd, 0x32, 0xe0, 0x87, 0x36, 0x6b, 0xe9, 0x28, 0xf6, 0x97, 0xf6, 0xda, 0xf4, 0xc4, 0x67, 0x9c,
-	0x9d, 0x18, 0x5f, 0xd7, 0xce, 0x28, 0x6f, 0x86, 0x8d, 0xaa, 0xcd, 0x5a, 0xb5, 0xbe, 0x1f, 0x57,
-	0x54, 0xcf, 0x88, 0x27, 0x7f, 0x35, 0xa2, 0x7e, 0x67, 0xf1, 0x04, 0xb7, 0x69, 0xe7, 0x41, 0x63,
-	0x41, 0x8c, 0x7d, 0xf8, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x45, 0x4a, 0x43, 0x05, 0xd9, 0x22,
-	0x00, 0x00,
+	0x15, 0x07, 0xe5, 0xef, 0xe7, 0x2f, 0x79, 0xd2, 0x66, 0x69, 0x3a, 0x71, 0x14, 0x39, 0x89, 0xbd,
+	0xed, 0x42, 0x4e, 0xbc, 0xbb, 0x5e, 0xe7, 0xab, 0xa8, 0x3f, 0x62, 0xc4, 0xc5, 0x36, 0xf5, 0x32,
+	0xda, 0x2c, 0xb0, 0x05, 0x42, 0x8c, 0xc9, 0xb1, 0x35, 0xb0, 0xc4, 0x51, 0x38, 0x43, 0x3b, 0xda,
+	0x43, 0x0f, 0x3d, 0xee, 0xf6, 0x50, 0xa0, 0xe8, 0xf6, 0x52, 0x20, 0xe7, 0xfe, 0x01, 0xfd, 0x1f,
+	0x7a, 0xec, 0xbd, 0x97, 0x36, 0xd7, 0xfe, 0x0d, 0x05, 0x0a, 0xce, 0x0c, 0x25, 0x4a, 0xa2, 0xa8,
+	0x8f, 0x1e, 0xbc, 0x40, 0x6f, 0xe6, 0xf0, 0xf7, 0x7e, 0x7c, 0xf3, 0xde, 0x9b, 0x37, 0xbf, 0x19,
+	0x0b, 0x36, 0xc2, 0x13, 0x12, 0x6c, 0xba, 0xd8, 0x23, 0xbe, 0x4b, 0x36, 0x71, 0x9d, 0x6e, 0x5e,
+	0x3c, 0xd8, 0xe4, 0x24, 0xb8, 0xa0, 0x2e, 0x71, 0x2e, 0x59, 0x70, 0x4e, 0x82, 0x52, 0x3d, 0x60,
+	0x82, 0xa1, 0x6b, 0x11, 0xb2, 0xa4, 0x91, 0x25, 0x5c, 0xa7, 0xa5, 0x8b, 0x07, 0xd6, 0xea, 0x19,
+	0x63, 0x67, 0x55, 0xb2, 0x29, 0x21, 0x27, 0xe1, 0xe9, 0xa6, 0x17, 0x06, 0x58, 0x50, 0xe6, 0x2b,
+	0x23, 0xeb, 0x56, 0xe7, 0x7b, 0x41, 0x6b, 0x84, 0x0b, 0x5c, 0xab, 0x6b, 0x40, 0x17, 0xc1, 0x65,
+	0x80, 0xeb, 0x75, 0x12, 0x70, 0xfd, 0xbe, 0x90, 0xe6, 0x9f, 0xcb, 0x6a, 0xb5, 0xe6, 0x27, 0x8a,
+	0x69, 0x08, 0x8f, 0xb8, 0x94, 0xb7, 0xdc, 0xb8, 0x9d, 0x86, 0xa9, 0x50, 0x2e, 0x58, 0xd0, 0x88,
+	0x3d, 0x4d, 0x83, 0xbc, 0x09, 0x49, 0xd0, 0xc8, 0xfa, 0x8e, 0xc0, 0xfc, 0xbc, 0x4a, 0xb9, 0xc8,
+	0xc2, 0x44, 0x51, 0x3c, 0xad, 0xb2, 0x4b, 0x85, 0x29, 0xfe, 0xd5, 0x00, 0xeb, 0x98, 0x55, 0xab,
+	0x87, 0x2c, 0x38, 0xd0, 0x5e, 0x96, 0x31, 0x3f, 0xb7, 0xc9, 0x9b, 0x90, 0x70, 0x81, 0xae, 0xc3,
+	0xa4, 0xc7, 0x6a, 0x98, 0xfa, 0xa6, 0x51, 0x30, 0x36, 0x66, 0x6c, 0xfd, 0x84, 0x1e, 0xc1, 0x4c,
+	0xf4, 0x31, 0x27, 0xfa, 0x9a, 0x99, 0x2b, 0x18, 0x1b, 0xb3, 0x5b, 0x37, 0x4b, 0x29, 0x29, 0x29,
+	0x45, 0x64, 0x9f, 0x53, 0x2e, 0xec, 0x69, 0xa1, 0xff, 0x42, 0x16, 0x4c, 0x53, 0x8f, 0xf8, 0x82,
+	0x8a, 0x86, 0x39, 0x26, 0x59, 0x9b, 0xcf, 0x68, 0x1d, 0x16, 0x4f, 0xa8, 0x8f, 0x83, 0x86, 0xe3,
+	0x56, 0x88, 0x7b, 0xce, 0xc3, 0x9a, 0x39, 0x2e, 0x21, 0x0b, 0x6a, 0x78, 0x5f, 0x8f, 0x16, 0xff,
+	0x33, 0x0d, 0x2b, 0xa9, 0x7e, 0xf3, 0x3a, 0xf3, 0x39, 0x41, 0x37, 0x01, 0xa4, 0x83, 0x82, 0x9d,
+	0x13, 0xe5, 0xfc, 0x9c, 0x2d, 0x5d, 0x2e, 0x47, 0x03, 0xe8, 0x4b, 0x40, 0x71, 0x20, 0x1c, 0xf2,
+	0x96, 0xb8, 0x61, 0x54, 0x25, 0x7a, 0x22, 0xf7, 0x52, 0x27, 0xf2, 0x95, 0x86, 0x3f, 0x8b, 0xd1,
+	0xf6, 0xd2, 0x65, 0xe7, 0x10, 0x3a, 0x84, 0xf9, 0x26, 0xad, 0x68, 0xd4, 0x89, 0x9c, 0xdf, 0xec,
+	0xd6, 0xed, 0x4c, 0xc6, 0x72, 0xa3, 0x4e, 0xec, 0xb9, 0xcb, 0xc4, 0x13, 0x7a, 0x05, 0xcb, 0xf5,
+	0x80, 0x5c, 0x50, 0x16, 0x72, 0x87, 0x0b, 0x1c, 0x08, 0xe2, 0x39, 0xe4, 0x82, 0xf8, 0xc2, 0xa1,
+	0x9e, 0x0c, 0xc8, 0xec, 0xd6, 0x4a, 0x49, 0xd5, 0x6a, 0x29, 0xae, 0xd5, 0xd2, 0x91, 0x2f, 0xb6,
+	0x3f, 0x79, 0x85, 0xab, 0x21, 0xb1, 0xaf, 0xc7, 0xd6, 0x2f, 0x95, 0xf1, 0xb3, 0xc8, 0xf6, 0xc8,
+	0x43, 0x1b, 0x90, 0xef, 0xa2, 0x9b, 0x28, 0x18, 0x1b, 0x63, 0xf6, 0x02, 0x6f, 0x47, 0x9a, 0x30,
+	0x85, 0x85, 0x20, 0xb5, 0xba, 0x30, 0x27, 0x25, 0x20, 0x7e, 0x44, 0x1f, 0x01, 0x3a, 0xc1, 0xee,
+	0x79, 0x95, 0x9d, 0x39, 0x2e, 0x0b, 0x7d, 0xe1, 0x54, 0xa8, 0x2f, 0xcc, 0x29, 0x09, 0xca, 0xeb,
+	0x37, 0xfb, 0xd1, 0x8b, 0xe7, 0xd4, 0x17, 0x68, 0x1b, 0xa6, 0x74, 0x65, 0x9b, 0xd3, 0xd2, 0xef,
+	0x1b, 0xa9, 0xb1, 0x78, 0xae, 0x30, 0x76, 0x0c, 0x46, 0xf7, 0x60, 0xd1, 0x27, 0x6f, 0x85, 0x53,
+	0xc7, 0x67, 0x44, 0x27, 0x71, 0x46, 0x26, 0x71, 0x3e, 0x1a, 0x3e, 0xc6, 0x67, 0x44, 0x25, 0x72,
+	0x07, 0x26, 0xe4, 0xb2, 0x30, 0x41, 0xb2, 0x17, 0x33, 0x23, 0xfd, 0x45, 0x84, 0xb4, 0x95, 0x01,
+	0x7a, 0x0d, 0x37, 0xba, 0x4b, 0xc0, 0x69, 0x55, 0xf5, 0xec, 0x20, 0x55, 0xbd, 0xdc, 0x55, 0x03,
+	0xf1, 0x2b, 0xb4, 0x0b, 0x0b, 0xdc, 0xad, 0x10, 0x2f, 0xac, 0x12, 0xcf, 0x89, 0x1a, 0x8d, 0x39,
+	0x27, 0x19, 0xad, 0xae, 0xc4, 0x95, 0xe3, 0x2e, 0x64, 0xcf, 0x37, 0x2d, 0xa2, 0x31, 0xf4, 0x14,
+	0xe6, 0xe2, 0x74, 0x49, 0x82, 0xf9, 0xbe, 0x04, 0xb3, 0x1a, 0x2f, 0xcd, 0xbf, 0x82, 0xa9, 0x68,
+	0xaa, 0x94, 0x70, 0x73, 0xa1, 0x30, 0xb6, 0x31, 0xbb, 0xf5, 0x34, 0x75, 0x32, 0x19, 0xcb, 0xa8,
+	0xf4, 0x85, 0xb2, 0x7f, 0xe6, 0x8b, 0x28, 0x39, 0x9a, 0x0d, 0x15, 0x41, 0x66, 0xa1, 0x55, 0x43,
+	0x8b, 0x32, 0xfb, 0xb3, 0xd1, 0x60, 0x5c, 0x40, 0xb7, 0x61, 0x4e, 0xe7, 0xd2, 0xe1, 0xf4, 0x1b,
+	0x62, 0xe6, 0x15, 0x44, 0x8f, 0xbd, 0xa4, 0xdf, 0x10, 0xb4, 0x06, 0xf3, 0x31, 0x44, 0x56, 0x92,
+	0xb9, 0x24, 0x31, 0xb1, 0x9d, 0x2c, 0x22, 0xf4, 0x29, 0x7c, 0xc0, 0xc3, 0xb3, 0x33, 0xc2, 0x85,
+	0xe3, 0x32, 0x5f, 0x50, 0x3f, 0x24, 0x0e, 0xe6, 0x8e, 0x4f, 0x2e, 0x4d, 0x54, 0x30, 0x36, 0xa6,
+	0xed, 0x1f, 0xe9, 0xd7, 0xfb, 0xfa, 0xed, 0x2e, 0x7f, 0x41, 0x2e, 0xad, 0xd7, 0x30, 0x97, 0xf4,
+	0x1d, 0xe5, 0x61, 0xec, 0x9c, 0x34, 0x74, 0x17, 0x8b, 0xfe, 0x8c, 0x2a, 0xe7, 0x22, 0x5a, 0x2c,
+	0x66, 0x6e, 0xf0, 0xca, 0x91, 0x06, 0x8f, 0x72, 0x3b, 0x46, 0xf1, 0x2f, 0x13, 0xb0, 0xa6, 0xa2,
+	0xe4, 0x25, 0x03, 0xb7, 0xcf, 0x6a, 0xf5, 0x2a, 0x11, 0xc4, 0x8b, 0x1b, 0x68, 0x9f, 0x3e, 0xf4,
+	0x18, 0x66, 0xe2, 0xcd, 0x81, 0x9b, 0xb9, 0xc2, 0x58, 0xcf, 0x8a, 0x8b, 0x3f, 0x62, 0xb7, 0xf0,
+	0xe8, 0xa7, 0xb0, 0xd4, 0x2a, 0xdc, 0x28, 0x38, 0xe4, 0xad, 0x90, 0x1d, 0x67, 0xce, 0xce, 0x37,
+	0x5f, 0xec, 0xab, 0xf1, 0xb6, 0xae, 0x3b, 0xde, 0xd1, 0x75, 0x7f, 0x0d, 0x4b, 0x5c, 0x50, 0xf7,
+	0xbc, 0xe1, 0x60, 0x21, 0x02, 0x7a, 0x12, 0x0a, 0xc2, 0x65, 0x5f, 0x98, 0xdd, 0x2a, 0xa5, 0x7a,
+	0xf3, 0x52, 0xa2, 0x9b, 0x35, 0xbf, 0xdb, 0xb4, 0xb2, 0xf3, 0x8a, 0xa8, 0x35, 0x82, 0x3e, 0x03,
+	0x33, 0x20, 0x22, 0x0c, 0xfc, 0x28, 0x67, 0x4e, 0xec, 0xbd, 0x5c, 0x68, 0xb2, 0xb5, 0x4c, 0xdb,
+	0x3f, 0x56, 0xef, 0x5f, 0x90, 0xcb, 0x64, 0x28, 0xd1, 0x1e, 0xac, 0x9e, 0xb2, 0xc0, 0x25, 0x8e,
+	0x1b, 0x10, 0x2c, 0x48, 0x8a, 0xf9, 0x94, 0x34, 0xb7, 0x24, 0x6a, 0x5f, 0x82, 0x3a, 0x39, 0x52,
+	0xf6, 0x93, 0xe9, 0xb4, 0xfd, 0x04, 0x31, 0x98, 0x97, 0x6d, 0xc1, 0x09, 0x08, 0x0f, 0xab, 0x82,
+	0x9b, 0x33, 0x32, 0x19, 0xbf, 0x48, 0x9d, 0xfe, 0x00, 0x89, 0x2f, 0xa9, 0x8a, 0x51, 0x64, 0x6a,
+	0xf9, 0xcc, 0xbd, 0x49, 0x0c, 0x59, 0x14, 0x96, 0xba, 0x20, 0x29, 0x55, 0xfa, 0xb3, 0xf6, 0x2a,
+	0xdd, 0x18, 0xa0, 0x4a, 0x25, 0x61, 0xb2, 0x56, 0xdf, 0x8d, 0xc1, 0x9d, 0x6c, 0x97, 0xf5, 0xa6,
+	0xf9, 0x25, 0xcc, 0xb7, 0x07, 0xd8, 0x90, 0x1f, 0xbd, 0x3f, 0x6c, 0xdb, 0xb0, 0xe7, 0xbc, 0x64,
+	0x12, 0xde, 0x19, 0xb0, 0x8a, 0x5d, 0x41, 0x2f, 0xa8, 0xa0, 0x84, 0x3b, 0x82, 0x39, 0x1e, 0xe5,
+	0x75, 0x2c, 0xdc, 0x8a, 0x53, 0x65, 0x2e, 0xae, 0x56, 0x1b, 0xba, 0xf4, 0xbf, 0x1e, 0x21, 0xda,
+	0xba, 0x51, 0xed, 0x36, 0xf9, 0xcb, 0xec, 0x40, 0xb3, 0x7f, 0xae, 0xc8, 0x55, 0xf4, 0x57, 0x70,
+	0x6f, 0x84, 0xf5, 0x1b, 0x28, 0xf4, 0x23, 0x48, 0xc9, 0xcd, 0x41, 0x7b, 0x6e, 0xd2, 0x97, 0x8a,
+	0xe6, 0x6d, 0x48, 0xae, 0x98, 0xf8, 0xc8, 0x3f, 0x65, 0xc9, 0x0c, 0xfd, 0x36, 0x07, 0x85, 0x94,
+	0x69, 0x1e, 0x62, 0x5a, 0x1d, 0xb8, 0x95, 0xec, 0xc1, 0x84, 0x8b, 0x43, 0xae, 0xbc, 0x59, 0xd8,
+	0xfa, 0x28, 0xb3, 0x8d, 0xb4, 0xd8, 0xf7, 0x23, 0x1b, 0x5b, 0x99, 0x46, 0xbb, 0xb5, 0x47, 0x04,
+	0xa6, 0x55, 0x6e, 0x8e, 0x65, 0xec, 0xd6, 0xc7, 0xb8, 0x51, 0x65, 0xd8, 0xb3, 0x63, 0x70, 0x66,
+	0x73, 0x49, 0x59, 0x82, 0x13, 0xa9, 0x92, 0x6e, 0x0d, 0x6e, 0x67, 0xc4, 0x40, 0xe5, 0xb9, 0xf8,
+	0xaf, 0x96, 0x5e, 0x8d, 0x23, 0x7b, 0x95, 0x7a, 0xf5, 0x25, 0xa0, 0x26, 0xaf, 0x53, 0x23, 0x02,
+	0x7b, 0x58, 0x60, 0xad, 0xd0, 0xee, 0x66, 0x7e, 0xe0, 0x97, 0x1a, 0x6c, 0xe7, 0x45, 0xc7, 0x48,
+	0xf1, 0xbb, 0x96, 0xb6, 0x6d, 0x9f, 0xe3, 0x95, 0x6a, 0xdb, 0x5b, 0x30, 0xab, 0x97, 0x50, 0x23,
+	0xda, 0xf2, 0x55, 0x24, 0x20, 0x1e, 0x3a, 0xf2, 0x22, 0xf1, 0xdb, 0x04, 0x48, 0xf1, 0x3b, 0x9e,
+	0x21, 0x7e, 0x9b, 0x13, 0x93, 0xe2, 0x17, 0x27, 0x9e, 0xd0, 0x16, 0x4c, 0x50, 0xbf, 0x1e, 0x0a,
+	0x73, 0x62, 0x80, 0x12, 0x54, 0xd0, 0x14, 0xb1, 0x35, 0xf9, 0xbf, 0x8a, 0xad, 0xa9, 0xe1, 0xc4,
+	0x56, 0x19, 0x96, 0x63, 0xbe, 0xa8, 0xc3, 0xb9, 0x55, 0xc6, 0x89, 0x24, 0x62, 0xa1, 0xd0, 0xd2,
+	0x77, 0xb9, 0x8b, 0xeb, 0x40, 0x9f, 0x4f, 0xed, 0xeb, 0xb1, 0x6d, 0x99, 0xed, 0x47, 0x96, 0x65,
+	0x65, 0x88, 0x5e, 0xc0, 0x75, 0xf9, 0x91, 0x6e, 0xca, 0x99, 0x7e, 0x94, 0xd7, 0xa4, 0x61, 0x07,
+	0xdf, 0x21, 0x2c, 0x55, 0x08, 0x0e, 0xc4, 0x09, 0xc1, 0xa2, 0x49, 0x05, 0xfd, 0xa8, 0xf2, 0x4d,
+	0x9b, 0x98, 0x27, 0x71, 0x3c, 0x88, 0x74, 0xf2, 0x44, 0xeb, 0x78, 0xf0, 0x1a, 0x56, 0xdb, 0x33,
+	0xe1, 0xb0, 0x53, 0x47, 0x54, 0x28, 0x77, 0x62, 0x83, 0xfe, 0x32, 0xd8, 0x6a, 0xcb, 0xcc, 0xaf,
+	0x4e, 0xcb, 0x15, 0xca, 0x77, 0x35, 0xff, 0x51, 0x72, 0x06, 0x71, 0xb3, 0x9a, 0x1f, 0xa0, 0x52,
+	0x5a, 0x93, 0x38, 0xd0, 0x5d, 0xab, 0xeb, 0xb4, 0xb6, 0x30, 0xda, 0x69, 0x6d, 0x1d, 0x16, 0x9b,
+	0x3c, 0xba, 0xfb, 0x2c, 0xaa, 0x0e, 0x17, 0x0f, 0x1f, 0xc8, 0x51, 0xf4, 0x31, 0x4c, 0x56, 0x08,
+	0xf6, 0x48, 0x60, 0xe6, 0xf5, 0x19, 0x2e, 0xf5, 0x2c, 0x24, 0x21, 0xb6, 0x86, 0x16, 0xbf, 0x37,
+	0x9a, 0x4a, 0x33, 0xd9, 0x0d, 0x86, 0x55, 0x9a, 0x9f, 0xc0, 0xa4, 0x92, 0x36, 0x66, 0x6e, 0x80,
+	0x60, 0x69, 0x6c, 0x56, 0xef, 0x2b, 0xde, 0x83, 0x3b, 0xd9, 0x7e, 0xe9, 0x96, 0xfd, 0x5d, 0x0e,
+	0xd6, 0xb3, 0x80, 0x7b, 0x8d, 0xa3, 0x83, 0x7e, 0xfd, 0xfb, 0xaa, 0x7a, 0x5a, 0x2b, 0x6a, 0xe3,
+	0x23, 0x46, 0x6d, 0xa2, 0x23, 0x6a, 0x3f, 0x81, 0x8d, 0xfe, 0xc1, 0xd0, 0x91, 0xfb, 0xa3, 0x01,
+	0x85, 0x14, 0xf0, 0x50, 0xb2, 0x60, 0x1b, 0xa6, 0x4e, 0x31, 0xad, 0x86, 0x01, 0xc9, 0x4c, 0xfc,
+	0xa1, 0xc2, 0xd8, 0x31, 0x38, 0x33, 0xf3, 0xad, 0x9d, 0x3a, 0xcd, 0x2d, 0xed, 0xfc, 0xb7, 0x39,
+	0xb8, 0xd3, 0x13, 0xf5, 0x43, 0xce, 0x79, 0x22, 0x62, 0xe3, 0xa3, 0x46, 0xac, 0x33, 0xeb, 0xeb,
+	0x70, 0xb7, 0x4f, 0x2c, 0x74, 0xd4, 0xfe, 0x64, 0x40, 0x31, 0xad, 0x3e, 0xb0, 0xef, 0x92, 0xa1,
+	0x92, 0x1e, 0xb7, 0xc6, 0xdc, 0xa8, 0x3a, 0xae, 0x33, 0xe9, 0x77, 0x61, 0x2d, 0xd3, 0x31, 0x3d,
+	0x81, 0xdf, 0xe5, 0xe0, 0x5e, 0x06, 0xee, 0x07, 0x9e, 0xf8, 0x38, 0x6a, 0xe3, 0xa3, 0x46, 0xad,
+	0x33, 0xf1, 0x1f, 0xc2, 0x7a, 0xdf, 0x68, 0xb4, 0xa5, 0xde, 0x65, 0x41, 0x1b, 0xf4, 0x79, 0xbc,
+	0x6b, 0x5d, 0x61, 0xea, 0x8f, 0x61, 0x2d, 0xd3, 0x31, 0xad, 0x4b, 0x3f, 0x84, 0xbc, 0x2b, 0x27,
+	0xe6, 0x04, 0xca, 0x57, 0xe2, 0x49, 0xff, 0xa6, 0xed, 0x45, 0x35, 0x6e, 0xc7, 0xc3, 0xba, 0x4a,
+	0x7a, 0x52, 0xfe, 0xbf, 0x55, 0x49, 0x19, 0xd6, 0xfb, 0x46, 0x63, 0xf8, 0x20, 0xff, 0xa3, 0xb5,
+	0x7d, 0xc8, 0x9b, 0x81, 0x51, 0x64, 0xc3, 0xcf, 0x3b, 0x64, 0xc3, 0xe0, 0x17, 0x10, 0xf1, 0x66,
+	0xf8, 0x0a, 0xae, 0xa9, 0xff, 0xdc, 0x38, 0x17, 0x24, 0x90, 0x57, 0x0b, 0xd4, 0x3f, 0x65, 0xe6,
+	0x58, 0x9f, 0x44, 0x91, 0xe0, 0x95, 0x82, 0xcb, 0xb3, 0xf2, 0xd2, 0x65, 0xe7, 0x50, 0x62, 0x13,
+	0x4a, 0x9b, 0x5c, 0xac, 0x3d, 0x0c, 0xb0, 0x6c, 0xc2, 0x89, 0x50, 0x37, 0x56, 0xcd, 0xd3, 0xdd,
+	0x95, 0xd4, 0x56, 0xf1, 0x26, 0xac, 0xa4, 0x3a, 0xa3, 0x9c, 0xdd, 0xfa, 0xf7, 0x22, 0xcc, 0xa8,
+	0xa9, 0xef, 0x1e, 0x1f, 0xa1, 0xb7, 0x70, 0x2d, 0xe5, 0x8a, 0x05, 0x6d, 0x0e, 0x7e, 0x19, 0x23,
+	0xe7, 0x68, 0x0d, 0x7d, 0x7b, 0x83, 0xfe, 0x60, 0xc0, 0x8d, 0xac, 0x4b, 0x17, 0xb4, 0x33, 0xea,
+	0xad, 0x98, 0xf5, 0x70, 0xe4, 0x1b, 0x1e, 0xf4, 0xad, 0x01, 0xcb, 0x3d, 0xef, 0x07, 0xd0, 0xa7,
+	0x83, 0x12, 0xb7, 0x89, 0x27, 0x6b, 0x7b, 0x58, 0x33, 0xed, 0x4c, 0x2b, 0x39, 0xc9, 0x15, 0x9b,
+	0x9d, 0x9c, 0x94, 0xfb, 0x0a, 0xeb, 0xfe, 0xe0, 0x06, 0xdd, 0xc9, 0x49, 0x15, 0x90, 0xd9, 0xc9,
+	0xc9, 0x3a, 0x41, 0x58, 0x0f, 0x47, 0xb0, 0xd4, 0x5e, 0xbd, 0x4b, 0x57, 0xaa, 0x6d, 0xb2, 0x16,
+	0x3d, 0x19, 0x9a, 0x3f, 0xb1, 0x0f, 0x58, 0x4f, 0x47, 0xb4, 0xee, 0x2e, 0x9f, 0x6e, 0x09, 0x96,
+	0x5d, 0x3e, 0x3d, 0xb5, 0xb7, 0xb5, 0x3d, 0xac, 0x99, 0x76, 0xe6, 0x7b, 0x03, 0x6e, 0x66, 0xea,
+	0x41, 0xf4, 0x70, 0x38, 0xe6, 0x64, 0xa0, 0x1e, 0x8d, 0x62, 0xaa, 0x1d, 0xfb, 0xbd, 0x01, 0x2b,
+	0x29, 0xc8, 0x58, 0xaf, 0xa0, 0xcf, 0x06, 0x4e, 0x42, 0xbb, 0x60, 0xb5, 0x76, 0x86, 0x37, 0xd4,
+	0x2e, 0xfd, 0xd9, 0x80, 0x5b, 0x7d, 0x24, 0x14, 0x7a, 0x3c, 0x2c, 0x7b, 0x32, 0x5e, 0x4f, 0x46,
+	0x33, 0x6e, 0x8b, 0x58, 0xcf, 0xbd, 0xbb, 0x67, 0xc4, 0xfa, 0xe9, 0x3c, 0x6b, 0x67, 0x78, 0xc3,
+	0xb6, 0x88, 0x65, 0xca, 0x89, 0x9e, 0x11, 0x1b, 0x44, 0x92, 0x59, 0x4f, 0x46, 0x33, 0xee, 0x5e,
+	0x89, 0xdd, 0x3b, 0x77, 0xf6, 0x4a, 0xec, 0x29, 0x63, 0xac, 0xed, 0x61, 0xcd, 0x5a, 0x8d, 0x3c,
+	0x65, 0x4b, 0xee, 0xd1, 0xc8, 0x7b, 0x2b, 0x09, 0xeb, 0xfe, 0xe0, 0x06, 0xea, 0xcb, 0x7b, 0x27,
+	0x7f, 0x7b, 0xbf, 0x6a, 0xfc, 0xfd, 0xfd, 0xaa, 0xf1, 0xcf, 0xf7, 0xab, 0x06, 0x7c, 0xe0, 0xb2,
+	0x5a, 0x1a, 0xc5, 0xde, 0xf4, 0x6e, 0x9d, 0x1e, 0x07, 0x4c, 0xb0, 0x63, 0xe3, 0xeb, 0xcd, 0x33,
+	0x2a, 0x2a, 0xe1, 0x49, 0xc9, 0x65, 0xb5, 0xcd, 0xb6, 0xdf, 0x76, 0x94, 0xce, 0x88, 0xaf, 0x7e,
+	0xb4, 0xa2, 0x7f, 0xe6, 0xf1, 0x18, 0xd7, 0xe9, 0xc5, 0x83, 0x93, 0x49, 0x39, 0xf6, 0xf1, 0x7f,
+	0x07, 0x00, 0x65, 0xfe, 0x78, 0x94, 0x58, 0x23, 0x00, 0x00,
 }
 
 func (m *PollForDecisionTaskRequest) Marshal() (dAtA []byte, err error) {
@@ -2153,6 +2180,32 @@ func (m *PollForDecisionTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SuggestContinueAsNew {
+		i--
+		if m.SuggestContinueAsNew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.HistoryCount != 0 {
+		i = encodeVarintServiceWorker(dAtA, i, uint64(m.HistoryCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.HistorySize != 0 {
+		i = encodeVarintServiceWorker(dAtA, i, uint64(m.HistorySize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.NextEventId != 0 {
 		i = encodeVarintServiceWorker(dAtA, i, uint64(m.NextEventId))
 		i--
@@ -3864,6 +3917,15 @@ func (m *PollForDecisionTaskResponse) Size() (n int) {
 	if m.NextEventId != 0 {
 		n += 1 + sovServiceWorker(uint64(m.NextEventId))
 	}
+	if m.HistorySize != 0 {
+		n += 2 + sovServiceWorker(uint64(m.HistorySize))
+	}
+	if m.HistoryCount != 0 {
+		n += 2 + sovServiceWorker(uint64(m.HistoryCount))
+	}
+	if m.SuggestContinueAsNew {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5278,6 +5340,64 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySize", wireType)
+			}
+			m.HistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistorySize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryCount", wireType)
+			}
+			m.HistoryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuggestContinueAsNew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuggestContinueAsNew = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceWorker(dAtA[iNdEx:])
@@ -900,128 +900,132 @@ var yarpcFileDescriptorClosure00684e887839a48c = [][]byte{
 	// uber/cadence/api/v1/service_worker.proto
 	[]byte{
 		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5b, 0x6f, 0xdb, 0xc8,
-		0x15, 0x06, 0x7d, 0xf7, 0x91, 0x2f, 0xf2, 0x04, 0x4d, 0x65, 0xe5, 0xe6, 0x68, 0x9b, 0xc4, 0xdb,
-		0x2e, 0xa4, 0xc6, 0xbb, 0xcd, 0x66, 0x93, 0x4d, 0x51, 0x5f, 0x62, 0xc4, 0xc5, 0x76, 0xeb, 0x65,
-		0xb4, 0x5e, 0x60, 0x0b, 0x84, 0x18, 0x93, 0x63, 0x6b, 0x60, 0x8a, 0xc3, 0x90, 0x43, 0xd9, 0x7a,
-		0xe9, 0x43, 0x1f, 0x77, 0xfb, 0x50, 0xa0, 0xe8, 0xf6, 0xa5, 0x40, 0x9e, 0xfb, 0x03, 0xfa, 0x6b,
-		0xfa, 0xd4, 0xe7, 0xfe, 0x87, 0xa2, 0xe0, 0xcc, 0x90, 0xa2, 0xa4, 0x21, 0x75, 0xe9, 0x83, 0x03,
-		0xf4, 0xcd, 0x1c, 0x7e, 0xe7, 0xf0, 0xcc, 0xf9, 0xce, 0x9c, 0xf9, 0x66, 0x2c, 0xd8, 0x8e, 0x4e,
-		0x49, 0xd0, 0xb0, 0xb1, 0x43, 0x3c, 0x9b, 0x34, 0xb0, 0x4f, 0x1b, 0x9d, 0xc7, 0x8d, 0x90, 0x04,
-		0x1d, 0x6a, 0x13, 0xeb, 0x92, 0x05, 0x17, 0x24, 0xa8, 0xfb, 0x01, 0xe3, 0x0c, 0xdd, 0x88, 0x91,
-		0x75, 0x85, 0xac, 0x63, 0x9f, 0xd6, 0x3b, 0x8f, 0xab, 0x77, 0xcf, 0x19, 0x3b, 0x77, 0x49, 0x43,
-		0x40, 0x4e, 0xa3, 0xb3, 0x86, 0x13, 0x05, 0x98, 0x53, 0xe6, 0x49, 0xa3, 0xea, 0xbd, 0xc1, 0xf7,
-		0x9c, 0xb6, 0x49, 0xc8, 0x71, 0xdb, 0x57, 0x80, 0x21, 0x07, 0x97, 0x01, 0xf6, 0x7d, 0x12, 0x84,
-		0xea, 0xfd, 0x96, 0x2e, 0x3e, 0x9b, 0xb5, 0xdb, 0xe9, 0x27, 0x6a, 0x3a, 0x84, 0x43, 0x6c, 0x1a,
-		0xf6, 0xc2, 0xb8, 0xaf, 0xc3, 0xb4, 0x68, 0xc8, 0x59, 0xd0, 0x4d, 0x22, 0xd5, 0x41, 0xde, 0x46,
-		0x24, 0x05, 0x68, 0xbf, 0xc3, 0x71, 0x78, 0xe1, 0xd2, 0x90, 0x17, 0x61, 0xe2, 0x2c, 0x9e, 0xb9,
-		0xec, 0x52, 0x62, 0x6a, 0xff, 0x30, 0xa0, 0x7a, 0xcc, 0x5c, 0xf7, 0x90, 0x05, 0x07, 0x2a, 0xca,
-		0x26, 0x0e, 0x2f, 0x4c, 0xf2, 0x36, 0x22, 0x21, 0x47, 0x37, 0x61, 0xc1, 0x61, 0x6d, 0x4c, 0xbd,
-		0x8a, 0xb1, 0x65, 0x6c, 0x2f, 0x9b, 0xea, 0x09, 0x3d, 0x83, 0xe5, 0xf8, 0x63, 0x56, 0xfc, 0xb5,
-		0xca, 0xcc, 0x96, 0xb1, 0x5d, 0xda, 0xb9, 0x53, 0xd7, 0x50, 0x52, 0x8f, 0x9d, 0x7d, 0x41, 0x43,
-		0x6e, 0x2e, 0x71, 0xf5, 0x17, 0xaa, 0xc2, 0x12, 0x75, 0x88, 0xc7, 0x29, 0xef, 0x56, 0x66, 0x85,
-		0xd7, 0xf4, 0x19, 0x3d, 0x82, 0xf5, 0x53, 0xea, 0xe1, 0xa0, 0x6b, 0xd9, 0x2d, 0x62, 0x5f, 0x84,
-		0x51, 0xbb, 0x32, 0x27, 0x20, 0x6b, 0x72, 0x78, 0x5f, 0x8d, 0xd6, 0xfe, 0xb3, 0x08, 0xb7, 0xb4,
-		0x71, 0x87, 0x3e, 0xf3, 0x42, 0x82, 0xee, 0x00, 0x88, 0x00, 0x39, 0xbb, 0x20, 0x32, 0xf8, 0x15,
-		0x53, 0x84, 0xdc, 0x8c, 0x07, 0xd0, 0xd7, 0x80, 0x92, 0x44, 0x58, 0xe4, 0x8a, 0xd8, 0x51, 0x5c,
-		0x25, 0x6a, 0x22, 0x0f, 0xb5, 0x13, 0xf9, 0x46, 0xc1, 0x5f, 0x26, 0x68, 0x73, 0xe3, 0x72, 0x70,
-		0x08, 0x1d, 0xc2, 0x6a, 0xea, 0x96, 0x77, 0x7d, 0x22, 0xe6, 0x57, 0xda, 0xb9, 0x5f, 0xe8, 0xb1,
-		0xd9, 0xf5, 0x89, 0xb9, 0x72, 0x99, 0x79, 0x42, 0x27, 0xb0, 0xe9, 0x07, 0xa4, 0x43, 0x59, 0x14,
-		0x5a, 0x21, 0xc7, 0x01, 0x27, 0x8e, 0x45, 0x3a, 0xc4, 0xe3, 0x16, 0x75, 0x44, 0x42, 0x4a, 0x3b,
-		0xb7, 0xea, 0xb2, 0x56, 0xeb, 0x49, 0xad, 0xd6, 0x8f, 0x3c, 0xfe, 0xe4, 0x93, 0x13, 0xec, 0x46,
-		0xc4, 0xbc, 0x99, 0x58, 0xbf, 0x96, 0xc6, 0x2f, 0x63, 0xdb, 0x23, 0x07, 0x6d, 0x43, 0x79, 0xc8,
-		0xdd, 0xfc, 0x96, 0xb1, 0x3d, 0x6b, 0xae, 0x85, 0xfd, 0xc8, 0x0a, 0x2c, 0x62, 0xce, 0x49, 0xdb,
-		0xe7, 0x95, 0x05, 0x01, 0x48, 0x1e, 0xd1, 0x47, 0x80, 0x4e, 0xb1, 0x7d, 0xe1, 0xb2, 0x73, 0xcb,
-		0x66, 0x91, 0xc7, 0xad, 0x16, 0xf5, 0x78, 0x65, 0x51, 0x80, 0xca, 0xea, 0xcd, 0x7e, 0xfc, 0xe2,
-		0x15, 0xf5, 0x38, 0x7a, 0x02, 0x8b, 0xaa, 0xb2, 0x2b, 0x4b, 0x22, 0xee, 0xdb, 0xda, 0x5c, 0xbc,
-		0x92, 0x18, 0x33, 0x01, 0xa3, 0x87, 0xb0, 0xee, 0x91, 0x2b, 0x6e, 0xf9, 0xf8, 0x9c, 0x28, 0x12,
-		0x97, 0x05, 0x89, 0xab, 0xf1, 0xf0, 0x31, 0x3e, 0x27, 0x92, 0xc8, 0xa7, 0x30, 0x2f, 0x96, 0x45,
-		0x05, 0x84, 0xf7, 0x5a, 0x61, 0xa6, 0xbf, 0x8a, 0x91, 0xa6, 0x34, 0x40, 0x6f, 0xe0, 0xf6, 0x70,
-		0x09, 0x58, 0xbd, 0xaa, 0x2e, 0x8d, 0x53, 0xd5, 0x9b, 0x43, 0x35, 0x90, 0xbc, 0x42, 0xbb, 0xb0,
-		0x16, 0xda, 0x2d, 0xe2, 0x44, 0x2e, 0x71, 0xac, 0xb8, 0xd1, 0x54, 0x56, 0x84, 0xc7, 0xea, 0x10,
-		0x71, 0xcd, 0xa4, 0x0b, 0x99, 0xab, 0xa9, 0x45, 0x3c, 0x86, 0x5e, 0xc0, 0x4a, 0x42, 0x97, 0x70,
-		0xb0, 0x3a, 0xd2, 0x41, 0x49, 0xe1, 0x85, 0xf9, 0x37, 0xb0, 0x18, 0x4f, 0x95, 0x92, 0xb0, 0xb2,
-		0xb6, 0x35, 0xbb, 0x5d, 0xda, 0x79, 0xa1, 0x9d, 0x4c, 0xc1, 0x32, 0xaa, 0x7f, 0x25, 0xed, 0x5f,
-		0x7a, 0x3c, 0x26, 0x47, 0x79, 0x43, 0x35, 0x10, 0x2c, 0xf4, 0x6a, 0x68, 0x5d, 0xb0, 0x5f, 0x8a,
-		0x07, 0x55, 0x01, 0x55, 0xdf, 0xc0, 0x4a, 0xd6, 0x18, 0x95, 0x61, 0xf6, 0x82, 0x74, 0x55, 0x1b,
-		0x89, 0xff, 0x8c, 0xa9, 0xeb, 0xc4, 0xd5, 0xaa, 0x96, 0xdd, 0x58, 0xd4, 0x09, 0x83, 0x67, 0x33,
-		0x4f, 0x8d, 0xda, 0xdf, 0xe7, 0xe1, 0x03, 0x19, 0xa6, 0x93, 0x8d, 0x7c, 0x9f, 0xb5, 0x7d, 0x97,
-		0x70, 0xe2, 0x24, 0x1d, 0x6c, 0x44, 0x23, 0x78, 0x0e, 0xcb, 0x49, 0x77, 0x0e, 0x2b, 0x33, 0x22,
-		0x4b, 0x7a, 0xca, 0x93, 0x8f, 0x98, 0x3d, 0x3c, 0xfa, 0x19, 0x6c, 0xf4, 0x2a, 0xc7, 0x66, 0x1e,
-		0x27, 0x57, 0x5c, 0x2c, 0xf9, 0x15, 0xb3, 0x9c, 0xbe, 0xd8, 0x97, 0xe3, 0x7d, 0x6d, 0x6f, 0x6e,
-		0xa0, 0xed, 0xfd, 0x0e, 0x36, 0x42, 0x4e, 0xed, 0x8b, 0xae, 0x85, 0x39, 0x0f, 0xe8, 0x69, 0xc4,
-		0x49, 0x28, 0x16, 0x66, 0x69, 0xa7, 0xae, 0x8d, 0xe6, 0xb5, 0x40, 0xa7, 0x45, 0xb7, 0x9b, 0x5a,
-		0x99, 0x65, 0xe9, 0xa8, 0x37, 0x82, 0x3e, 0x85, 0x4a, 0x40, 0x78, 0x14, 0x78, 0x96, 0x47, 0x2e,
-		0xad, 0x24, 0x7a, 0x51, 0xe9, 0x62, 0x6d, 0x2f, 0x99, 0x3f, 0x92, 0xef, 0xbf, 0x24, 0x97, 0xd9,
-		0x54, 0xa2, 0x3d, 0xb8, 0x7b, 0xc6, 0x02, 0x9b, 0x58, 0x76, 0x40, 0x30, 0x27, 0x1a, 0xf3, 0x45,
-		0x61, 0x5e, 0x15, 0xa8, 0x7d, 0x01, 0x1a, 0xf4, 0xa1, 0x69, 0xe8, 0x4b, 0xba, 0x86, 0x8e, 0x18,
-		0xac, 0x8a, 0x75, 0x69, 0x05, 0x24, 0x8c, 0x5c, 0x1e, 0x56, 0x96, 0x05, 0x19, 0xbf, 0xd6, 0x4e,
-		0x7f, 0x0c, 0xe2, 0xeb, 0xb2, 0x62, 0xa4, 0x33, 0x59, 0xbf, 0x2b, 0x6f, 0x33, 0x43, 0x55, 0x0a,
-		0x1b, 0x43, 0x10, 0x4d, 0x95, 0xfe, 0xb2, 0xbf, 0x4a, 0xb7, 0xc7, 0xa8, 0x52, 0xe1, 0x30, 0x5b,
-		0xab, 0xef, 0x66, 0xe1, 0x27, 0xc5, 0x21, 0xab, 0x5d, 0xeb, 0x6b, 0x58, 0xed, 0x4f, 0xb0, 0x21,
-		0x3e, 0xfa, 0xf3, 0x49, 0xd7, 0xad, 0xb9, 0xe2, 0x64, 0x49, 0x78, 0x67, 0xc0, 0x5d, 0x6c, 0x73,
-		0xda, 0xa1, 0x9c, 0x92, 0xd0, 0xe2, 0xcc, 0x72, 0x68, 0xe8, 0x63, 0x6e, 0xb7, 0x2c, 0x97, 0xd9,
-		0xd8, 0x75, 0xbb, 0xaa, 0xf4, 0xbf, 0x9d, 0x22, 0xdb, 0xaa, 0x53, 0xec, 0xa6, 0xfe, 0x9b, 0xec,
-		0x40, 0x79, 0xff, 0x42, 0x3a, 0x97, 0xd9, 0xbf, 0x85, 0xf3, 0x11, 0xd5, 0xdf, 0xc3, 0xd6, 0x28,
-		0x07, 0x1a, 0x6e, 0x0e, 0xfa, 0xb9, 0xd1, 0x2f, 0x15, 0xe5, 0xb7, 0x2b, 0x7c, 0x25, 0x8e, 0x8f,
-		0xbc, 0x33, 0x96, 0x65, 0xe8, 0x0f, 0x33, 0xb0, 0xa5, 0x99, 0xe6, 0x21, 0xa6, 0xee, 0xd8, 0xad,
-		0x64, 0x0f, 0xe6, 0x6d, 0x1c, 0x85, 0x32, 0x9a, 0xb5, 0x9d, 0x8f, 0x0a, 0xdb, 0x48, 0xcf, 0xfb,
-		0x7e, 0x6c, 0x63, 0x4a, 0xd3, 0x78, 0xbb, 0x74, 0x08, 0xc7, 0xd4, 0x0d, 0x95, 0x74, 0xd0, 0x6f,
-		0x97, 0xc7, 0xb8, 0xeb, 0x32, 0xec, 0x98, 0x09, 0xb8, 0xb0, 0xb9, 0x68, 0x96, 0xe0, 0xbc, 0x56,
-		0x53, 0x7d, 0x00, 0xf7, 0x0b, 0x72, 0x20, 0x79, 0xae, 0xfd, 0xab, 0x27, 0x18, 0x93, 0xcc, 0x5e,
-		0xa7, 0x60, 0x7c, 0x0d, 0x28, 0xf5, 0x6b, 0xb5, 0x09, 0xc7, 0x0e, 0xe6, 0x58, 0x49, 0xa4, 0x07,
-		0x85, 0x1f, 0xf8, 0x8d, 0x02, 0x9b, 0x65, 0x3e, 0x30, 0x52, 0xfb, 0x7e, 0x29, 0x15, 0x97, 0xfd,
-		0x73, 0xbc, 0x56, 0x71, 0x79, 0x0f, 0x4a, 0x6a, 0x09, 0x75, 0xe3, 0x3d, 0x57, 0x66, 0x02, 0x92,
-		0xa1, 0x23, 0x27, 0x56, 0x9f, 0x29, 0x40, 0xa8, 0xcf, 0xb9, 0x02, 0xf5, 0x99, 0x4e, 0x4c, 0xa8,
-		0x4f, 0x9c, 0x79, 0x42, 0x3b, 0x30, 0x4f, 0x3d, 0x3f, 0xe2, 0x6a, 0x07, 0x2a, 0x2e, 0x41, 0x09,
-		0xd5, 0xa8, 0x9d, 0x85, 0xff, 0x55, 0xed, 0x2c, 0x4e, 0xa6, 0x76, 0x9a, 0xb0, 0x99, 0xf8, 0x8b,
-		0x3b, 0x9c, 0xed, 0xb2, 0x90, 0x08, 0x47, 0x2c, 0xe2, 0x4a, 0x7b, 0x6e, 0x0e, 0xf9, 0x3a, 0x50,
-		0x07, 0x44, 0xf3, 0x66, 0x62, 0xdb, 0x64, 0xfb, 0xb1, 0x65, 0x53, 0x1a, 0xa2, 0x2f, 0xe1, 0xa6,
-		0xf8, 0xc8, 0xb0, 0xcb, 0xe5, 0x51, 0x2e, 0x6f, 0x08, 0xc3, 0x01, 0x7f, 0x87, 0xb0, 0xd1, 0x22,
-		0x38, 0xe0, 0xa7, 0x04, 0xf3, 0xd4, 0x15, 0x8c, 0x72, 0x55, 0x4e, 0x6d, 0x12, 0x3f, 0x19, 0x7d,
-		0x1e, 0x0b, 0xd5, 0xf9, 0x9e, 0x3e, 0x7f, 0x03, 0x77, 0xfb, 0x99, 0xb0, 0xd8, 0x99, 0xc5, 0x5b,
-		0x34, 0xb4, 0x12, 0x83, 0xd1, 0x3a, 0xb4, 0xda, 0xc7, 0xcc, 0x6f, 0xcf, 0x9a, 0x2d, 0x1a, 0xee,
-		0x2a, 0xff, 0x47, 0xd9, 0x19, 0x24, 0xcd, 0x6a, 0x75, 0x8c, 0x4a, 0xe9, 0x4d, 0xe2, 0x40, 0x75,
-		0xad, 0xa1, 0xe3, 0xd2, 0xda, 0x74, 0xc7, 0xa5, 0x47, 0xb0, 0x9e, 0xfa, 0x51, 0xdd, 0x67, 0x5d,
-		0x76, 0xb8, 0x64, 0xf8, 0x40, 0x76, 0xa1, 0x8f, 0x61, 0xa1, 0x45, 0xb0, 0x43, 0x82, 0x4a, 0x59,
-		0x1d, 0xa2, 0xb4, 0x87, 0x11, 0x01, 0x31, 0x15, 0xb4, 0xf6, 0x83, 0x91, 0x2a, 0xcd, 0x6c, 0x37,
-		0x98, 0x54, 0x69, 0x7e, 0x02, 0x0b, 0x52, 0xda, 0xa8, 0x4e, 0x50, 0x9c, 0x2c, 0x85, 0x2d, 0xea,
-		0x7d, 0xb5, 0x87, 0xa9, 0xaa, 0xc8, 0x89, 0x4b, 0xb5, 0xec, 0xef, 0x67, 0xe0, 0x51, 0x11, 0x70,
-		0xaf, 0x7b, 0x74, 0x30, 0xaa, 0x7f, 0x5f, 0x57, 0x4f, 0xeb, 0x65, 0x6d, 0x6e, 0xca, 0xac, 0xcd,
-		0x0f, 0x64, 0xed, 0xa7, 0xb0, 0x3d, 0x3a, 0x19, 0x2a, 0x73, 0x7f, 0x31, 0x52, 0x59, 0x90, 0x05,
-		0x4f, 0x24, 0x0b, 0x9e, 0xc0, 0xe2, 0x19, 0xa6, 0x6e, 0x14, 0x90, 0x42, 0xe2, 0x0f, 0x25, 0xc6,
-		0x4c, 0xc0, 0x85, 0xcc, 0xf7, 0x76, 0x6a, 0x5d, 0x58, 0x2a, 0xf8, 0xef, 0x66, 0xb4, 0xf5, 0x21,
-		0x51, 0xef, 0x33, 0xe7, 0x99, 0x8c, 0xcd, 0x4d, 0x9b, 0xb1, 0x41, 0xd6, 0x1f, 0xc1, 0x83, 0x11,
-		0xb9, 0x50, 0x59, 0xfb, 0xab, 0x01, 0x35, 0x5d, 0x7d, 0x60, 0xcf, 0x26, 0x13, 0x91, 0x9e, 0xb4,
-		0xc6, 0x99, 0x69, 0x75, 0xdc, 0x20, 0xe9, 0x0f, 0xf4, 0x6d, 0x28, 0x0d, 0x4c, 0x4d, 0xe0, 0x8f,
-		0x33, 0xf0, 0xb0, 0x00, 0xf7, 0x9e, 0x13, 0x9f, 0x64, 0x6d, 0x6e, 0xda, 0xac, 0x0d, 0x12, 0xff,
-		0xa1, 0xbe, 0xf7, 0xf5, 0x65, 0xa3, 0x8f, 0x7a, 0x9b, 0x05, 0x7d, 0xd0, 0x57, 0xc9, 0xae, 0x75,
-		0x8d, 0xd4, 0x1f, 0xc7, 0xd4, 0x17, 0x04, 0xa6, 0x74, 0xe9, 0x87, 0x50, 0xb6, 0xc5, 0xc4, 0xac,
-		0x40, 0xc6, 0x4a, 0x1c, 0x11, 0xdf, 0x92, 0xb9, 0x2e, 0xc7, 0xcd, 0x64, 0x58, 0x55, 0x49, 0xae,
-		0xcb, 0xff, 0xb7, 0x2a, 0x69, 0xc6, 0x55, 0x32, 0x22, 0x1b, 0x93, 0x27, 0xf9, 0x9f, 0xbd, 0xed,
-		0x43, 0xdc, 0x0c, 0x4c, 0x23, 0x1b, 0x7e, 0x35, 0x20, 0x1b, 0xc6, 0xbf, 0x80, 0x48, 0x36, 0xc3,
-		0x13, 0xb8, 0x21, 0xff, 0x75, 0x62, 0x75, 0x48, 0x20, 0xae, 0x16, 0xa8, 0x77, 0xc6, 0xd4, 0xf9,
-		0x32, 0x9f, 0x28, 0x12, 0x9c, 0x48, 0xb8, 0x38, 0x2b, 0x6f, 0x5c, 0x0e, 0x0e, 0x65, 0x36, 0x21,
-		0xdd, 0xe4, 0x12, 0xed, 0x61, 0x40, 0xd5, 0x24, 0x21, 0xe1, 0xf2, 0xc6, 0x2a, 0x3d, 0xdd, 0x5d,
-		0x4b, 0x6d, 0xd5, 0xee, 0xc0, 0x2d, 0x6d, 0x30, 0x32, 0xd8, 0x9d, 0x7f, 0xaf, 0xc3, 0xb2, 0x9c,
-		0xfa, 0xee, 0xf1, 0x11, 0xba, 0x82, 0x1b, 0x9a, 0x2b, 0x16, 0xd4, 0x18, 0xff, 0x32, 0x46, 0xcc,
-		0xb1, 0x3a, 0xf1, 0xed, 0x0d, 0xfa, 0xb3, 0x01, 0xb7, 0x8b, 0x2e, 0x5d, 0xd0, 0xd3, 0x69, 0x6f,
-		0xc5, 0xaa, 0x9f, 0x4d, 0x7d, 0xc3, 0x83, 0xbe, 0x33, 0x60, 0x33, 0xf7, 0x7e, 0x00, 0xfd, 0x62,
-		0x5c, 0xc7, 0x7d, 0xe2, 0xa9, 0xfa, 0x64, 0x52, 0x33, 0x15, 0x4c, 0x8f, 0x9c, 0xec, 0x8a, 0x2d,
-		0x26, 0x47, 0x73, 0x5f, 0x51, 0x4c, 0x8e, 0xf6, 0xf0, 0x9f, 0x21, 0x47, 0x2b, 0x20, 0x8b, 0xc9,
-		0x29, 0x3a, 0x41, 0x14, 0x93, 0x53, 0xa8, 0xf1, 0xd1, 0x3b, 0xbd, 0x52, 0xed, 0x93, 0xb5, 0xe8,
-		0xf3, 0x89, 0xfd, 0x67, 0xf6, 0x81, 0xea, 0x8b, 0x29, 0xad, 0x87, 0xcb, 0x67, 0x58, 0x82, 0x15,
-		0x97, 0x4f, 0xae, 0xf6, 0x2e, 0x2e, 0x9f, 0x7c, 0x6d, 0x8c, 0x7e, 0x30, 0xe0, 0x4e, 0xa1, 0x1e,
-		0x44, 0x9f, 0x4d, 0xe6, 0x39, 0x9b, 0xa8, 0x67, 0xd3, 0x98, 0xaa, 0xc0, 0xfe, 0x64, 0x88, 0x16,
-		0x95, 0xa7, 0x57, 0xd0, 0xa7, 0x63, 0x93, 0xd0, 0x2f, 0x58, 0xab, 0x4f, 0x27, 0x37, 0x54, 0x21,
-		0xfd, 0xcd, 0x80, 0x7b, 0x23, 0x24, 0x14, 0x7a, 0x3e, 0xa9, 0xf7, 0x6c, 0xbe, 0x3e, 0x9f, 0xce,
-		0xb8, 0x2f, 0x63, 0xb9, 0x7b, 0x77, 0x6e, 0xc6, 0x46, 0xe9, 0xbc, 0xdc, 0x8c, 0x8d, 0xd6, 0x61,
-		0x32, 0x63, 0x85, 0x72, 0x22, 0x37, 0x63, 0xe3, 0x48, 0xb2, 0xdc, 0x8c, 0x8d, 0xa7, 0x60, 0x32,
-		0x2b, 0x71, 0x78, 0xe7, 0x2e, 0x5e, 0x89, 0xb9, 0x32, 0xa6, 0x78, 0x25, 0xe6, 0x0b, 0x84, 0xb8,
-		0x91, 0x6b, 0xb6, 0xe4, 0x9c, 0x46, 0x9e, 0xaf, 0x24, 0x72, 0x1a, 0x79, 0xc1, 0x6e, 0xbf, 0x77,
-		0x02, 0x3f, 0xb6, 0x59, 0x5b, 0x67, 0xb6, 0xb7, 0xb4, 0xeb, 0xd3, 0xe3, 0x80, 0x71, 0x76, 0x6c,
-		0x7c, 0xdb, 0x38, 0xa7, 0xbc, 0x15, 0x9d, 0xd6, 0x6d, 0xd6, 0x6e, 0xf4, 0xfd, 0xa0, 0xa2, 0x7e,
-		0x4e, 0x3c, 0xf9, 0x4b, 0x11, 0xf5, 0xdb, 0x8a, 0xe7, 0xd8, 0xa7, 0x9d, 0xc7, 0xa7, 0x0b, 0x62,
-		0xec, 0xe3, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x42, 0xbc, 0xac, 0xed, 0xcd, 0x22, 0x00, 0x00,
+		0xf5, 0x07, 0xe5, 0xfb, 0xf1, 0x4d, 0x9e, 0xfc, 0xff, 0x59, 0x9a, 0xce, 0x45, 0x91, 0x93, 0xd8,
+		0xdb, 0x2e, 0xe4, 0xc4, 0xbb, 0xeb, 0x75, 0x6e, 0x45, 0x7d, 0x89, 0x11, 0x17, 0xdb, 0xd4, 0xcb,
+		0x68, 0xbd, 0xc0, 0x16, 0x08, 0x31, 0x26, 0xc7, 0xd6, 0xc0, 0x12, 0x47, 0xe1, 0x0c, 0xad, 0x68,
+		0x1f, 0xfa, 0xd0, 0xc7, 0xdd, 0x3e, 0x14, 0x28, 0xba, 0x7d, 0x29, 0x90, 0xe7, 0x7e, 0x80, 0x7e,
+		0x9a, 0x3e, 0xf5, 0xb9, 0x9f, 0xa1, 0x40, 0xc1, 0x99, 0xa1, 0x44, 0x49, 0x14, 0x75, 0xe9, 0x83,
+		0x17, 0xe8, 0x9b, 0x39, 0xfc, 0x9d, 0x1f, 0xcf, 0x9c, 0x73, 0xe6, 0xcc, 0x6f, 0xc6, 0x82, 0xcd,
+		0xf0, 0x8c, 0x04, 0x5b, 0x2e, 0xf6, 0x88, 0xef, 0x92, 0x2d, 0x5c, 0xa7, 0x5b, 0x57, 0x8f, 0xb7,
+		0x38, 0x09, 0xae, 0xa8, 0x4b, 0x9c, 0x06, 0x0b, 0x2e, 0x49, 0x50, 0xaa, 0x07, 0x4c, 0x30, 0x74,
+		0x23, 0x42, 0x96, 0x34, 0xb2, 0x84, 0xeb, 0xb4, 0x74, 0xf5, 0xd8, 0xba, 0x73, 0xc1, 0xd8, 0x45,
+		0x95, 0x6c, 0x49, 0xc8, 0x59, 0x78, 0xbe, 0xe5, 0x85, 0x01, 0x16, 0x94, 0xf9, 0xca, 0xc8, 0xba,
+		0xdb, 0xfd, 0x5e, 0xd0, 0x1a, 0xe1, 0x02, 0xd7, 0xea, 0x1a, 0xd0, 0x43, 0xd0, 0x08, 0x70, 0xbd,
+		0x4e, 0x02, 0xae, 0xdf, 0x17, 0xd2, 0xfc, 0x73, 0x59, 0xad, 0xd6, 0xfa, 0x44, 0x31, 0x0d, 0xe1,
+		0x11, 0x97, 0xf2, 0xb6, 0x1b, 0xf7, 0xd2, 0x30, 0x15, 0xca, 0x05, 0x0b, 0x9a, 0xb1, 0xa7, 0x69,
+		0x90, 0x77, 0x21, 0x09, 0x9a, 0x59, 0xdf, 0x11, 0x98, 0x5f, 0x56, 0x29, 0x17, 0x59, 0x98, 0x28,
+		0x8a, 0xe7, 0x55, 0xd6, 0x50, 0x98, 0xe2, 0xdf, 0x0d, 0xb0, 0x4e, 0x58, 0xb5, 0x7a, 0xc4, 0x82,
+		0x43, 0xed, 0x65, 0x19, 0xf3, 0x4b, 0x9b, 0xbc, 0x0b, 0x09, 0x17, 0xe8, 0x26, 0x4c, 0x7b, 0xac,
+		0x86, 0xa9, 0x6f, 0x1a, 0x05, 0x63, 0x73, 0xce, 0xd6, 0x4f, 0xe8, 0x29, 0xcc, 0x45, 0x1f, 0x73,
+		0xa2, 0xaf, 0x99, 0xb9, 0x82, 0xb1, 0x39, 0xbf, 0x7d, 0xbb, 0x94, 0x92, 0x92, 0x52, 0x44, 0xf6,
+		0x25, 0xe5, 0xc2, 0x9e, 0x15, 0xfa, 0x2f, 0x64, 0xc1, 0x2c, 0xf5, 0x88, 0x2f, 0xa8, 0x68, 0x9a,
+		0x13, 0x92, 0xb5, 0xf5, 0x8c, 0x36, 0x60, 0xf9, 0x8c, 0xfa, 0x38, 0x68, 0x3a, 0x6e, 0x85, 0xb8,
+		0x97, 0x3c, 0xac, 0x99, 0x93, 0x12, 0xb2, 0xa4, 0x86, 0x0f, 0xf4, 0x68, 0xf1, 0xdf, 0xb3, 0xb0,
+		0x96, 0xea, 0x37, 0xaf, 0x33, 0x9f, 0x13, 0x74, 0x1b, 0x40, 0x3a, 0x28, 0xd8, 0x25, 0x51, 0xce,
+		0x2f, 0xd8, 0xd2, 0xe5, 0x72, 0x34, 0x80, 0xbe, 0x06, 0x14, 0x07, 0xc2, 0x21, 0xef, 0x89, 0x1b,
+		0x46, 0x55, 0xa2, 0x27, 0xf2, 0x30, 0x75, 0x22, 0xdf, 0x68, 0xf8, 0xcb, 0x18, 0x6d, 0xaf, 0x34,
+		0xba, 0x87, 0xd0, 0x11, 0x2c, 0xb6, 0x68, 0x45, 0xb3, 0x4e, 0xe4, 0xfc, 0xe6, 0xb7, 0xef, 0x65,
+		0x32, 0x96, 0x9b, 0x75, 0x62, 0x2f, 0x34, 0x12, 0x4f, 0xe8, 0x14, 0x56, 0xeb, 0x01, 0xb9, 0xa2,
+		0x2c, 0xe4, 0x0e, 0x17, 0x38, 0x10, 0xc4, 0x73, 0xc8, 0x15, 0xf1, 0x85, 0x43, 0x3d, 0x19, 0x90,
+		0xf9, 0xed, 0xb5, 0x92, 0xaa, 0xd5, 0x52, 0x5c, 0xab, 0xa5, 0x63, 0x5f, 0xec, 0x7c, 0x76, 0x8a,
+		0xab, 0x21, 0xb1, 0x6f, 0xc6, 0xd6, 0x6f, 0x94, 0xf1, 0xcb, 0xc8, 0xf6, 0xd8, 0x43, 0x9b, 0x90,
+		0xef, 0xa1, 0x9b, 0x2a, 0x18, 0x9b, 0x13, 0xf6, 0x12, 0xef, 0x44, 0x9a, 0x30, 0x83, 0x85, 0x20,
+		0xb5, 0xba, 0x30, 0xa7, 0x25, 0x20, 0x7e, 0x44, 0x9f, 0x00, 0x3a, 0xc3, 0xee, 0x65, 0x95, 0x5d,
+		0x38, 0x2e, 0x0b, 0x7d, 0xe1, 0x54, 0xa8, 0x2f, 0xcc, 0x19, 0x09, 0xca, 0xeb, 0x37, 0x07, 0xd1,
+		0x8b, 0x57, 0xd4, 0x17, 0x68, 0x07, 0x66, 0x74, 0x65, 0x9b, 0xb3, 0xd2, 0xef, 0x5b, 0xa9, 0xb1,
+		0x78, 0xa5, 0x30, 0x76, 0x0c, 0x46, 0x0f, 0x61, 0xd9, 0x27, 0xef, 0x85, 0x53, 0xc7, 0x17, 0x44,
+		0x27, 0x71, 0x4e, 0x26, 0x71, 0x31, 0x1a, 0x3e, 0xc1, 0x17, 0x44, 0x25, 0x72, 0x17, 0xa6, 0xe4,
+		0xb2, 0x30, 0x41, 0xb2, 0x17, 0x33, 0x23, 0xfd, 0x55, 0x84, 0xb4, 0x95, 0x01, 0x7a, 0x0b, 0xb7,
+		0x7a, 0x4b, 0xc0, 0x69, 0x57, 0xf5, 0xfc, 0x30, 0x55, 0xbd, 0xda, 0x53, 0x03, 0xf1, 0x2b, 0xb4,
+		0x07, 0x4b, 0xdc, 0xad, 0x10, 0x2f, 0xac, 0x12, 0xcf, 0x89, 0x1a, 0x8d, 0xb9, 0x20, 0x19, 0xad,
+		0x9e, 0xc4, 0x95, 0xe3, 0x2e, 0x64, 0x2f, 0xb6, 0x2c, 0xa2, 0x31, 0xf4, 0x02, 0x16, 0xe2, 0x74,
+		0x49, 0x82, 0xc5, 0x81, 0x04, 0xf3, 0x1a, 0x2f, 0xcd, 0xbf, 0x81, 0x99, 0x68, 0xaa, 0x94, 0x70,
+		0x73, 0xa9, 0x30, 0xb1, 0x39, 0xbf, 0xfd, 0x22, 0x75, 0x32, 0x19, 0xcb, 0xa8, 0xf4, 0x95, 0xb2,
+		0x7f, 0xe9, 0x8b, 0x28, 0x39, 0x9a, 0x0d, 0x15, 0x41, 0x66, 0xa1, 0x5d, 0x43, 0xcb, 0x32, 0xfb,
+		0xf3, 0xd1, 0x60, 0x5c, 0x40, 0xf7, 0x60, 0x41, 0xe7, 0xd2, 0xe1, 0xf4, 0x3b, 0x62, 0xe6, 0x15,
+		0x44, 0x8f, 0xbd, 0xa1, 0xdf, 0x11, 0xb4, 0x0e, 0x8b, 0x31, 0x44, 0x56, 0x92, 0xb9, 0x22, 0x31,
+		0xb1, 0x9d, 0x2c, 0x22, 0xf4, 0x39, 0x7c, 0xc4, 0xc3, 0x8b, 0x0b, 0xc2, 0x85, 0xe3, 0x32, 0x5f,
+		0x50, 0x3f, 0x24, 0x0e, 0xe6, 0x8e, 0x4f, 0x1a, 0x26, 0x2a, 0x18, 0x9b, 0xb3, 0xf6, 0xff, 0xe9,
+		0xd7, 0x07, 0xfa, 0xed, 0x1e, 0x7f, 0x4d, 0x1a, 0xd6, 0x5b, 0x58, 0x48, 0xfa, 0x8e, 0xf2, 0x30,
+		0x71, 0x49, 0x9a, 0xba, 0x8b, 0x45, 0x7f, 0x46, 0x95, 0x73, 0x15, 0x2d, 0x16, 0x33, 0x37, 0x7c,
+		0xe5, 0x48, 0x83, 0xa7, 0xb9, 0x5d, 0xa3, 0xf8, 0xb7, 0x29, 0x58, 0x57, 0x51, 0xf2, 0x92, 0x81,
+		0x3b, 0x60, 0xb5, 0x7a, 0x95, 0x08, 0xe2, 0xc5, 0x0d, 0x74, 0x40, 0x1f, 0x7a, 0x06, 0x73, 0xf1,
+		0xe6, 0xc0, 0xcd, 0x5c, 0x61, 0xa2, 0x6f, 0xc5, 0xc5, 0x1f, 0xb1, 0xdb, 0x78, 0xf4, 0x73, 0x58,
+		0x69, 0x17, 0x6e, 0x14, 0x1c, 0xf2, 0x5e, 0xc8, 0x8e, 0xb3, 0x60, 0xe7, 0x5b, 0x2f, 0x0e, 0xd4,
+		0x78, 0x47, 0xd7, 0x9d, 0xec, 0xea, 0xba, 0xbf, 0x85, 0x15, 0x2e, 0xa8, 0x7b, 0xd9, 0x74, 0xb0,
+		0x10, 0x01, 0x3d, 0x0b, 0x05, 0xe1, 0xb2, 0x2f, 0xcc, 0x6f, 0x97, 0x52, 0xbd, 0x79, 0x23, 0xd1,
+		0xad, 0x9a, 0xdf, 0x6b, 0x59, 0xd9, 0x79, 0x45, 0xd4, 0x1e, 0x41, 0x5f, 0x80, 0x19, 0x10, 0x11,
+		0x06, 0x7e, 0x94, 0x33, 0x27, 0xf6, 0x5e, 0x2e, 0x34, 0xd9, 0x5a, 0x66, 0xed, 0xff, 0x57, 0xef,
+		0x5f, 0x93, 0x46, 0x32, 0x94, 0x68, 0x1f, 0xee, 0x9c, 0xb3, 0xc0, 0x25, 0x8e, 0x1b, 0x10, 0x2c,
+		0x48, 0x8a, 0xf9, 0x8c, 0x34, 0xb7, 0x24, 0xea, 0x40, 0x82, 0xba, 0x39, 0x52, 0xf6, 0x93, 0xd9,
+		0xb4, 0xfd, 0x04, 0x31, 0x58, 0x94, 0x6d, 0xc1, 0x09, 0x08, 0x0f, 0xab, 0x82, 0x9b, 0x73, 0x32,
+		0x19, 0xbf, 0x4a, 0x9d, 0xfe, 0x10, 0x89, 0x2f, 0xa9, 0x8a, 0x51, 0x64, 0x6a, 0xf9, 0x2c, 0xbc,
+		0x4b, 0x0c, 0x59, 0x14, 0x56, 0x7a, 0x20, 0x29, 0x55, 0xfa, 0x8b, 0xce, 0x2a, 0xdd, 0x1c, 0xa2,
+		0x4a, 0x25, 0x61, 0xb2, 0x56, 0x3f, 0x4c, 0xc0, 0xfd, 0x6c, 0x97, 0xf5, 0xa6, 0xf9, 0x35, 0x2c,
+		0x76, 0x06, 0xd8, 0x90, 0x1f, 0x7d, 0x34, 0x6a, 0xdb, 0xb0, 0x17, 0xbc, 0x64, 0x12, 0x3e, 0x18,
+		0x70, 0x07, 0xbb, 0x82, 0x5e, 0x51, 0x41, 0x09, 0x77, 0x04, 0x73, 0x3c, 0xca, 0xeb, 0x58, 0xb8,
+		0x15, 0xa7, 0xca, 0x5c, 0x5c, 0xad, 0x36, 0x75, 0xe9, 0x7f, 0x3b, 0x46, 0xb4, 0x75, 0xa3, 0xda,
+		0x6b, 0xf1, 0x97, 0xd9, 0xa1, 0x66, 0xff, 0x52, 0x91, 0xab, 0xe8, 0xaf, 0xe1, 0xfe, 0x08, 0xeb,
+		0x77, 0x50, 0x18, 0x44, 0x90, 0x92, 0x9b, 0xc3, 0xce, 0xdc, 0xa4, 0x2f, 0x15, 0xcd, 0xdb, 0x94,
+		0x5c, 0x31, 0xf1, 0xb1, 0x7f, 0xce, 0x92, 0x19, 0xfa, 0x7d, 0x0e, 0x0a, 0x29, 0xd3, 0x3c, 0xc2,
+		0xb4, 0x3a, 0x74, 0x2b, 0xd9, 0x87, 0x29, 0x17, 0x87, 0x5c, 0x79, 0xb3, 0xb4, 0xfd, 0x49, 0x66,
+		0x1b, 0x69, 0xb3, 0x1f, 0x44, 0x36, 0xb6, 0x32, 0x8d, 0x76, 0x6b, 0x8f, 0x08, 0x4c, 0xab, 0xdc,
+		0x9c, 0xc8, 0xd8, 0xad, 0x4f, 0x70, 0xb3, 0xca, 0xb0, 0x67, 0xc7, 0xe0, 0xcc, 0xe6, 0x92, 0xb2,
+		0x04, 0xa7, 0x52, 0x25, 0xdd, 0x3a, 0xdc, 0xcb, 0x88, 0x81, 0xca, 0x73, 0xf1, 0x9f, 0x6d, 0xbd,
+		0x1a, 0x47, 0xf6, 0x3a, 0xf5, 0xea, 0x1b, 0x40, 0x2d, 0x5e, 0xa7, 0x46, 0x04, 0xf6, 0xb0, 0xc0,
+		0x5a, 0xa1, 0x3d, 0xc8, 0xfc, 0xc0, 0xaf, 0x35, 0xd8, 0xce, 0x8b, 0xae, 0x91, 0xe2, 0x0f, 0x6d,
+		0x6d, 0xdb, 0x39, 0xc7, 0x6b, 0xd5, 0xb6, 0x77, 0x61, 0x5e, 0x2f, 0xa1, 0x66, 0xb4, 0xe5, 0xab,
+		0x48, 0x40, 0x3c, 0x74, 0xec, 0x45, 0xe2, 0xb7, 0x05, 0x90, 0xe2, 0x77, 0x32, 0x43, 0xfc, 0xb6,
+		0x26, 0x26, 0xc5, 0x2f, 0x4e, 0x3c, 0xa1, 0x6d, 0x98, 0xa2, 0x7e, 0x3d, 0x14, 0xe6, 0xd4, 0x10,
+		0x25, 0xa8, 0xa0, 0x29, 0x62, 0x6b, 0xfa, 0xbf, 0x15, 0x5b, 0x33, 0xa3, 0x89, 0xad, 0x32, 0xac,
+		0xc6, 0x7c, 0x51, 0x87, 0x73, 0xab, 0x8c, 0x13, 0x49, 0xc4, 0x42, 0xa1, 0xa5, 0xef, 0x6a, 0x0f,
+		0xd7, 0xa1, 0x3e, 0x9f, 0xda, 0x37, 0x63, 0xdb, 0x32, 0x3b, 0x88, 0x2c, 0xcb, 0xca, 0x10, 0xbd,
+		0x86, 0x9b, 0xf2, 0x23, 0xbd, 0x94, 0x73, 0x83, 0x28, 0x6f, 0x48, 0xc3, 0x2e, 0xbe, 0x23, 0x58,
+		0xa9, 0x10, 0x1c, 0x88, 0x33, 0x82, 0x45, 0x8b, 0x0a, 0x06, 0x51, 0xe5, 0x5b, 0x36, 0x31, 0x4f,
+		0xe2, 0x78, 0x10, 0xe9, 0xe4, 0xa9, 0xf6, 0xf1, 0xe0, 0x2d, 0xdc, 0xe9, 0xcc, 0x84, 0xc3, 0xce,
+		0x1d, 0x51, 0xa1, 0xdc, 0x89, 0x0d, 0x06, 0xcb, 0x60, 0xab, 0x23, 0x33, 0xbf, 0x39, 0x2f, 0x57,
+		0x28, 0xdf, 0xd3, 0xfc, 0xc7, 0xc9, 0x19, 0xc4, 0xcd, 0x6a, 0x71, 0x88, 0x4a, 0x69, 0x4f, 0xe2,
+		0x50, 0x77, 0xad, 0x9e, 0xd3, 0xda, 0xd2, 0x78, 0xa7, 0xb5, 0x0d, 0x58, 0x6e, 0xf1, 0xe8, 0xee,
+		0xb3, 0xac, 0x3a, 0x5c, 0x3c, 0x7c, 0x28, 0x47, 0xd1, 0xa7, 0x30, 0x5d, 0x21, 0xd8, 0x23, 0x81,
+		0x99, 0xd7, 0x67, 0xb8, 0xd4, 0xb3, 0x90, 0x84, 0xd8, 0x1a, 0x5a, 0xfc, 0xd1, 0x68, 0x29, 0xcd,
+		0x64, 0x37, 0x18, 0x55, 0x69, 0x7e, 0x06, 0xd3, 0x4a, 0xda, 0x98, 0xb9, 0x21, 0x82, 0xa5, 0xb1,
+		0x59, 0xbd, 0xaf, 0xf8, 0x10, 0xee, 0x67, 0xfb, 0xa5, 0x5b, 0xf6, 0x0f, 0x39, 0xd8, 0xc8, 0x02,
+		0xee, 0x37, 0x8f, 0x0f, 0x07, 0xf5, 0xef, 0xeb, 0xea, 0x69, 0xed, 0xa8, 0x4d, 0x8e, 0x19, 0xb5,
+		0xa9, 0xae, 0xa8, 0xfd, 0x0c, 0x36, 0x07, 0x07, 0x43, 0x47, 0xee, 0xcf, 0x06, 0x14, 0x52, 0xc0,
+		0x23, 0xc9, 0x82, 0x1d, 0x98, 0x39, 0xc7, 0xb4, 0x1a, 0x06, 0x24, 0x33, 0xf1, 0x47, 0x0a, 0x63,
+		0xc7, 0xe0, 0xcc, 0xcc, 0xb7, 0x77, 0xea, 0x34, 0xb7, 0xb4, 0xf3, 0xdf, 0xe7, 0xe0, 0x7e, 0x5f,
+		0xd4, 0x4f, 0x39, 0xe7, 0x89, 0x88, 0x4d, 0x8e, 0x1b, 0xb1, 0xee, 0xac, 0x6f, 0xc0, 0x83, 0x01,
+		0xb1, 0xd0, 0x51, 0xfb, 0x8b, 0x01, 0xc5, 0xb4, 0xfa, 0xc0, 0xbe, 0x4b, 0x46, 0x4a, 0x7a, 0xdc,
+		0x1a, 0x73, 0xe3, 0xea, 0xb8, 0xee, 0xa4, 0x3f, 0x80, 0xf5, 0x4c, 0xc7, 0xf4, 0x04, 0xfe, 0x90,
+		0x83, 0x87, 0x19, 0xb8, 0x9f, 0x78, 0xe2, 0xe3, 0xa8, 0x4d, 0x8e, 0x1b, 0xb5, 0xee, 0xc4, 0x7f,
+		0x0c, 0x1b, 0x03, 0xa3, 0xd1, 0x91, 0x7a, 0x97, 0x05, 0x1d, 0xd0, 0x57, 0xf1, 0xae, 0x75, 0x8d,
+		0xa9, 0x3f, 0x81, 0xf5, 0x4c, 0xc7, 0xb4, 0x2e, 0xfd, 0x18, 0xf2, 0xae, 0x9c, 0x98, 0x13, 0x28,
+		0x5f, 0x89, 0x27, 0xfd, 0x9b, 0xb5, 0x97, 0xd5, 0xb8, 0x1d, 0x0f, 0xeb, 0x2a, 0xe9, 0x4b, 0xf9,
+		0xbf, 0x56, 0x25, 0x65, 0xd8, 0x18, 0x18, 0x8d, 0xd1, 0x83, 0xfc, 0x8f, 0xf6, 0xf6, 0x21, 0x6f,
+		0x06, 0xc6, 0x91, 0x0d, 0xbf, 0xec, 0x92, 0x0d, 0xc3, 0x5f, 0x40, 0xc4, 0x9b, 0xe1, 0x29, 0xdc,
+		0x50, 0xff, 0xb9, 0x71, 0xae, 0x48, 0x20, 0xaf, 0x16, 0xa8, 0x7f, 0xce, 0xcc, 0x89, 0x01, 0x89,
+		0x22, 0xc1, 0xa9, 0x82, 0xcb, 0xb3, 0xf2, 0x4a, 0xa3, 0x7b, 0x28, 0xb1, 0x09, 0xa5, 0x4d, 0x2e,
+		0xd6, 0x1e, 0x06, 0x58, 0x36, 0xe1, 0x44, 0xa8, 0x1b, 0xab, 0xd6, 0xe9, 0xee, 0x5a, 0x6a, 0xab,
+		0x78, 0x1b, 0xd6, 0x52, 0x9d, 0x51, 0xce, 0x6e, 0xff, 0x6b, 0x19, 0xe6, 0xd4, 0xd4, 0xf7, 0x4e,
+		0x8e, 0xd1, 0x7b, 0xb8, 0x91, 0x72, 0xc5, 0x82, 0xb6, 0x86, 0xbf, 0x8c, 0x91, 0x73, 0xb4, 0x46,
+		0xbe, 0xbd, 0x41, 0x7f, 0x32, 0xe0, 0x56, 0xd6, 0xa5, 0x0b, 0xda, 0x1d, 0xf7, 0x56, 0xcc, 0x7a,
+		0x32, 0xf6, 0x0d, 0x0f, 0xfa, 0xde, 0x80, 0xd5, 0xbe, 0xf7, 0x03, 0xe8, 0xf3, 0x61, 0x89, 0x3b,
+		0xc4, 0x93, 0xb5, 0x33, 0xaa, 0x99, 0x76, 0xa6, 0x9d, 0x9c, 0xe4, 0x8a, 0xcd, 0x4e, 0x4e, 0xca,
+		0x7d, 0x85, 0xf5, 0x68, 0x78, 0x83, 0xde, 0xe4, 0xa4, 0x0a, 0xc8, 0xec, 0xe4, 0x64, 0x9d, 0x20,
+		0xac, 0x27, 0x63, 0x58, 0x6a, 0xaf, 0x3e, 0xa4, 0x2b, 0xd5, 0x0e, 0x59, 0x8b, 0x9e, 0x8f, 0xcc,
+		0x9f, 0xd8, 0x07, 0xac, 0x17, 0x63, 0x5a, 0xf7, 0x96, 0x4f, 0xaf, 0x04, 0xcb, 0x2e, 0x9f, 0xbe,
+		0xda, 0xdb, 0xda, 0x19, 0xd5, 0x4c, 0x3b, 0xf3, 0xa3, 0x01, 0xb7, 0x33, 0xf5, 0x20, 0x7a, 0x32,
+		0x1a, 0x73, 0x32, 0x50, 0x4f, 0xc7, 0x31, 0xd5, 0x8e, 0xfd, 0xd1, 0x80, 0xb5, 0x14, 0x64, 0xac,
+		0x57, 0xd0, 0x17, 0x43, 0x27, 0xa1, 0x53, 0xb0, 0x5a, 0xbb, 0xa3, 0x1b, 0x6a, 0x97, 0xfe, 0x6a,
+		0xc0, 0xdd, 0x01, 0x12, 0x0a, 0x3d, 0x1b, 0x95, 0x3d, 0x19, 0xaf, 0xe7, 0xe3, 0x19, 0x77, 0x44,
+		0xac, 0xef, 0xde, 0xdd, 0x37, 0x62, 0x83, 0x74, 0x9e, 0xb5, 0x3b, 0xba, 0x61, 0x47, 0xc4, 0x32,
+		0xe5, 0x44, 0xdf, 0x88, 0x0d, 0x23, 0xc9, 0xac, 0xe7, 0xe3, 0x19, 0xf7, 0xae, 0xc4, 0xde, 0x9d,
+		0x3b, 0x7b, 0x25, 0xf6, 0x95, 0x31, 0xd6, 0xce, 0xa8, 0x66, 0xed, 0x46, 0x9e, 0xb2, 0x25, 0xf7,
+		0x69, 0xe4, 0xfd, 0x95, 0x84, 0xf5, 0x68, 0x78, 0x03, 0xf5, 0xe5, 0xfd, 0x53, 0xf8, 0xc8, 0x65,
+		0xb5, 0x34, 0xb3, 0xfd, 0xd9, 0xbd, 0x3a, 0x3d, 0x09, 0x98, 0x60, 0x27, 0xc6, 0xb7, 0x5b, 0x17,
+		0x54, 0x54, 0xc2, 0xb3, 0x92, 0xcb, 0x6a, 0x5b, 0x1d, 0xbf, 0xe7, 0x28, 0x5d, 0x10, 0x5f, 0xfd,
+		0x50, 0x45, 0xff, 0xb4, 0xe3, 0x19, 0xae, 0xd3, 0xab, 0xc7, 0x67, 0xd3, 0x72, 0xec, 0xd3, 0xff,
+		0x0c, 0x00, 0x3e, 0x2d, 0x4d, 0x11, 0x4c, 0x23, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -1036,7 +1040,7 @@ var yarpcFileDescriptorClosure00684e887839a48c = [][]byte{
 		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
 		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
 		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
-		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
 	},
 	// google/protobuf/timestamp.proto
 	[]byte{
@@ -1051,7 +1055,7 @@ var yarpcFileDescriptorClosure00684e887839a48c = [][]byte{
 		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
 		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
 		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
-		0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
 	},
 	// google/protobuf/wrappers.proto
 	[]byte{
@@ -1070,8 +1074,7 @@ var yarpcFileDescriptorClosure00684e887839a48c = [][]byte{
 		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
 		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
 		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
-		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x92, 0x48, 0x30, 0x06,
-		0x02, 0x00, 0x00,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/common.proto
 	[]byte{
@@ -1124,532 +1127,563 @@ var yarpcFileDescriptorClosure00684e887839a48c = [][]byte{
 		0x0e, 0x69, 0xc1, 0x27, 0x9b, 0xf5, 0xa1, 0x77, 0x35, 0xbe, 0x7a, 0xde, 0xac, 0x9c, 0x5d, 0xc3,
 		0xd3, 0x40, 0x24, 0xdb, 0x86, 0xfc, 0x6c, 0xaf, 0x97, 0xb2, 0xa1, 0x4e, 0x3f, 0xb4, 0x7e, 0x71,
 		0xa6, 0x4c, 0xbd, 0xcb, 0x27, 0x76, 0x20, 0x12, 0x67, 0xe3, 0xc7, 0x64, 0x4f, 0x91, 0x97, 0x3f,
-		0x1b, 0xf3, 0x8f, 0x7a, 0x49, 0x53, 0x36, 0x3f, 0x9d, 0xd4, 0x8a, 0xda, 0xf3, 0xbf, 0x03, 0x00,
-		0x00, 0xff, 0xff, 0xdc, 0x8c, 0x77, 0x9a, 0xc7, 0x06, 0x00, 0x00,
+		0x1b, 0xf3, 0x8f, 0x7a, 0x49, 0x53, 0x36, 0x3f, 0x9d, 0xd4, 0x8a, 0xda, 0xf3, 0xbf, 0x07, 0x00,
+		0xdc, 0x8c, 0x77, 0x9a, 0xc7, 0x06, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/decision.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x5d, 0x4f, 0xdc, 0x46,
-		0x17, 0x7e, 0xcd, 0xc7, 0x7e, 0x1c, 0x20, 0x6f, 0x18, 0x12, 0x02, 0x09, 0x09, 0x64, 0x5f, 0xbd,
-		0xa4, 0x09, 0x62, 0x17, 0x48, 0x1a, 0x45, 0x49, 0x15, 0x15, 0x48, 0x50, 0x90, 0x12, 0x82, 0x1c,
-		0x92, 0x48, 0xbd, 0xb1, 0x86, 0xf1, 0x00, 0x23, 0xbc, 0xb6, 0x3b, 0x1e, 0x43, 0xb6, 0x52, 0xa5,
-		0x5e, 0xb5, 0xbd, 0xe9, 0x0f, 0xa8, 0xd4, 0xab, 0x5e, 0xb5, 0x37, 0xed, 0x6d, 0xab, 0x5e, 0xf5,
-		0x27, 0xf4, 0xa2, 0xff, 0xa3, 0x52, 0xff, 0x40, 0x35, 0xe3, 0xb1, 0x77, 0x59, 0xbc, 0x5e, 0x9b,
-		0xa4, 0xb9, 0xc3, 0xe3, 0x73, 0x9e, 0x79, 0x66, 0xce, 0xcc, 0x79, 0x1e, 0xb3, 0x50, 0x0b, 0x77,
-		0x29, 0x6f, 0x10, 0x6c, 0x53, 0x97, 0xd0, 0x06, 0xf6, 0x59, 0xe3, 0x68, 0xb9, 0x61, 0x53, 0xc2,
-		0x02, 0xe6, 0xb9, 0x75, 0x9f, 0x7b, 0xc2, 0x43, 0x13, 0x32, 0xa6, 0xae, 0x63, 0xea, 0xd8, 0x67,
-		0xf5, 0xa3, 0xe5, 0xcb, 0xd7, 0xf6, 0x3d, 0x6f, 0xdf, 0xa1, 0x0d, 0x15, 0xb2, 0x1b, 0xee, 0x35,
-		0xec, 0x90, 0x63, 0x91, 0x24, 0x5d, 0x9e, 0x4b, 0x03, 0x26, 0x5e, 0xb3, 0x99, 0x44, 0xa4, 0x4e,
-		0x2d, 0x70, 0x70, 0xe8, 0xb0, 0x40, 0x64, 0xc5, 0x1c, 0x7b, 0xfc, 0x70, 0xcf, 0xf1, 0x8e, 0xa3,
-		0x98, 0xda, 0xd7, 0xe3, 0x50, 0x79, 0xa4, 0x19, 0xa3, 0x6f, 0x0d, 0xb8, 0x15, 0x90, 0x03, 0x6a,
-		0x87, 0x0e, 0xb5, 0x30, 0x11, 0xec, 0x88, 0x89, 0x96, 0x25, 0x51, 0xad, 0x78, 0x55, 0x16, 0x16,
-		0x82, 0xb3, 0xdd, 0x50, 0xd0, 0x60, 0xca, 0x98, 0x33, 0x3e, 0x18, 0x59, 0x79, 0x50, 0x4f, 0x59,
-		0x61, 0xfd, 0x85, 0x86, 0x59, 0xd5, 0x28, 0x3b, 0x38, 0x38, 0x8c, 0xe7, 0x59, 0x4d, 0x20, 0x9e,
-		0xfc, 0xc7, 0x9c, 0x0f, 0x72, 0x45, 0xa2, 0xcf, 0x60, 0x36, 0x10, 0x98, 0x0b, 0x4b, 0xb0, 0x26,
-		0xe5, 0xa9, 0x7c, 0x06, 0x14, 0x9f, 0xe5, 0x74, 0x3e, 0x32, 0x77, 0x47, 0xa6, 0xa6, 0xb2, 0x98,
-		0x09, 0x32, 0xde, 0xa3, 0x1f, 0x0c, 0x90, 0xbb, 0xef, 0x3b, 0x54, 0x50, 0x2b, 0xde, 0x40, 0x8b,
-		0xbe, 0xa1, 0x24, 0x94, 0x45, 0x4b, 0x25, 0x33, 0xa8, 0xc8, 0x7c, 0x9c, 0x4a, 0x66, 0x5d, 0x63,
-		0xbd, 0xd6, 0x50, 0x8f, 0x63, 0xa4, 0x54, 0x6e, 0x0b, 0x24, 0x7f, 0x38, 0xfa, 0xce, 0x80, 0x85,
-		0x3d, 0xcc, 0x9c, 0xbc, 0x34, 0x87, 0x14, 0xcd, 0x8f, 0x52, 0x69, 0x6e, 0x60, 0xe6, 0xe4, 0xa3,
-		0x78, 0x63, 0x2f, 0x5f, 0x28, 0xfa, 0xd1, 0x80, 0x25, 0x4e, 0x3f, 0x0d, 0x69, 0x20, 0x2c, 0x82,
-		0x5d, 0x42, 0x9d, 0x1c, 0xe7, 0x6c, 0x38, 0x63, 0x2b, 0xcd, 0x08, 0x6c, 0x5d, 0x61, 0xf5, 0x3d,
-		0x6c, 0x0b, 0x3c, 0x7f, 0x38, 0xfa, 0x1c, 0xe6, 0x34, 0xc5, 0xde, 0x47, 0xae, 0xa4, 0xa8, 0xad,
-		0xa4, 0x57, 0x59, 0x25, 0xf7, 0x3e, 0x73, 0x57, 0x49, 0x56, 0x00, 0xfa, 0xde, 0x80, 0x45, 0x3d,
-		0x7f, 0xce, 0x5a, 0x96, 0x15, 0x99, 0x87, 0x19, 0x64, 0xf2, 0x55, 0xf3, 0x26, 0xc9, 0x1b, 0x8c,
-		0xfe, 0x30, 0xe0, 0x61, 0x57, 0x3d, 0xe9, 0x1b, 0x41, 0xb9, 0x8b, 0x73, 0xb3, 0xae, 0x28, 0xd6,
-		0xcf, 0xfa, 0x57, 0xf7, 0xb1, 0x06, 0xce, 0xb7, 0x88, 0x7b, 0xfc, 0x8c, 0xb9, 0xe8, 0x0b, 0x03,
-		0xae, 0x73, 0x4a, 0x3c, 0x6e, 0x5b, 0x4d, 0xcc, 0x0f, 0x7b, 0x54, 0xbe, 0xaa, 0x68, 0xdf, 0xee,
-		0x41, 0x5b, 0x66, 0x3f, 0x53, 0xc9, 0xa9, 0xe4, 0xae, 0xf1, 0xcc, 0x08, 0xf4, 0xab, 0x01, 0x77,
-		0x89, 0xe7, 0x0a, 0xe6, 0x86, 0xd4, 0xc2, 0x81, 0xe5, 0xd2, 0xe3, 0xbc, 0xdb, 0x09, 0x8a, 0xd7,
-		0xe3, 0x1e, 0x7d, 0x27, 0x82, 0x5c, 0x0d, 0xb6, 0xe8, 0x71, 0xbe, 0x6d, 0x5c, 0x22, 0x05, 0x73,
-		0xd0, 0xcf, 0x06, 0xac, 0x44, 0x9d, 0x9a, 0x1c, 0x30, 0xc7, 0xce, 0xcb, 0x7b, 0x44, 0xf1, 0x5e,
-		0xeb, 0xdd, 0xbc, 0xd7, 0x25, 0x5a, 0x3e, 0xd2, 0x8b, 0x41, 0x91, 0x04, 0xf4, 0x9b, 0x01, 0x77,
-		0x03, 0xb6, 0x2f, 0xcf, 0x6c, 0xd1, 0xc3, 0x3b, 0xaa, 0x58, 0x6f, 0xa4, 0xb3, 0x56, 0x90, 0xc5,
-		0x4e, 0xed, 0x72, 0x50, 0x34, 0x09, 0xfd, 0x62, 0xc0, 0x87, 0xa1, 0x1f, 0x50, 0x2e, 0xda, 0xa4,
-		0x03, 0x8a, 0x39, 0x39, 0xe8, 0x20, 0x9a, 0x4a, 0x7e, 0x2c, 0xe3, 0xa8, 0xbc, 0x54, 0x88, 0xf1,
-		0xfc, 0x2f, 0x14, 0x5e, 0x7b, 0xd2, 0xf4, 0xa3, 0x12, 0x16, 0xcc, 0x59, 0x1b, 0x05, 0x68, 0xd3,
-		0xa9, 0x7d, 0x53, 0x82, 0xf9, 0x7c, 0xb6, 0x01, 0xcd, 0xc2, 0x48, 0x22, 0x1b, 0xcc, 0x56, 0x46,
-		0xa4, 0x6a, 0x42, 0x3c, 0xb4, 0x69, 0xa3, 0x0d, 0x18, 0x6b, 0xeb, 0x4a, 0xcb, 0xa7, 0xda, 0x1b,
-		0x5c, 0x4f, 0x5d, 0x6b, 0x32, 0x59, 0xcb, 0xa7, 0xe6, 0x28, 0xee, 0x78, 0x42, 0x93, 0x50, 0xb2,
-		0xbd, 0x26, 0x66, 0xae, 0xd2, 0xf3, 0xaa, 0xa9, 0x9f, 0xd0, 0x7d, 0xa8, 0x2a, 0xb9, 0x92, 0x6e,
-		0x4b, 0x6b, 0xe8, 0xd5, 0x54, 0x6c, 0xb9, 0x80, 0xa7, 0x2c, 0x10, 0x66, 0x45, 0xe8, 0xbf, 0xd0,
-		0x0a, 0x0c, 0x33, 0xd7, 0x0f, 0x85, 0xd6, 0xb5, 0x99, 0xd4, 0xbc, 0x6d, 0xdc, 0x72, 0x3c, 0x6c,
-		0x9b, 0x51, 0x28, 0xda, 0x81, 0xe9, 0xc4, 0x98, 0x09, 0xcf, 0x22, 0x8e, 0x17, 0x50, 0x25, 0x4b,
-		0x5e, 0x28, 0xb4, 0x08, 0x4d, 0xd7, 0x23, 0x53, 0x59, 0x8f, 0x4d, 0x65, 0xfd, 0x91, 0x36, 0x95,
-		0xe6, 0x64, 0x9c, 0xbb, 0xe3, 0xad, 0xcb, 0xcc, 0x9d, 0x28, 0xb1, 0x1b, 0xb5, 0xed, 0xaf, 0x24,
-		0x6a, 0xb9, 0x00, 0x6a, 0xe2, 0xae, 0x24, 0xea, 0x16, 0x4c, 0x6a, 0xa4, 0x6e, 0xa2, 0x95, 0x7e,
-		0x90, 0x13, 0x91, 0x0d, 0x3b, 0xc9, 0x72, 0x03, 0xc6, 0x0f, 0x28, 0xe6, 0x62, 0x97, 0xe2, 0x36,
-		0xbb, 0x6a, 0x3f, 0xa8, 0xf3, 0x49, 0x4e, 0x8c, 0xb3, 0x0e, 0xa3, 0x9c, 0x0a, 0xde, 0xb2, 0x7c,
-		0xcf, 0x61, 0xa4, 0xa5, 0x3b, 0xce, 0x5c, 0x8f, 0x0e, 0x2e, 0x78, 0x6b, 0x5b, 0xc5, 0x99, 0x23,
-		0xbc, 0xfd, 0x80, 0x6e, 0x43, 0xe9, 0x80, 0x62, 0x9b, 0x72, 0x7d, 0xf5, 0xaf, 0xa4, 0xa6, 0x3f,
-		0x51, 0x21, 0xa6, 0x0e, 0x45, 0x77, 0x60, 0x32, 0x16, 0x49, 0xc7, 0x23, 0xd8, 0xb1, 0x6c, 0x16,
-		0xf8, 0x58, 0x90, 0x03, 0x75, 0x05, 0x2b, 0xe6, 0x05, 0xfd, 0xf6, 0xa9, 0x7c, 0xf9, 0x48, 0xbf,
-		0xab, 0x7d, 0x65, 0xc0, 0x4c, 0x96, 0x6d, 0x45, 0xd3, 0x50, 0x89, 0x9c, 0x49, 0x72, 0x05, 0xca,
-		0xea, 0x79, 0xd3, 0x46, 0x4f, 0xe1, 0x62, 0x52, 0x83, 0x3d, 0xc6, 0xdb, 0x25, 0x18, 0xe8, 0xb7,
-		0x6f, 0x48, 0x97, 0x60, 0x83, 0xf1, 0xb8, 0x02, 0x35, 0x02, 0x0b, 0x05, 0x2c, 0x2b, 0xba, 0x03,
-		0x25, 0x4e, 0x83, 0xd0, 0x11, 0xfa, 0x0b, 0x21, 0xfb, 0x84, 0xeb, 0xd8, 0x1a, 0x86, 0x1b, 0x39,
-		0x0d, 0x27, 0xba, 0x0b, 0x65, 0x69, 0x38, 0x43, 0x4e, 0x33, 0x67, 0xd8, 0x88, 0x62, 0xcc, 0x38,
-		0xb8, 0xb6, 0x05, 0x0b, 0x05, 0xfc, 0x62, 0xdf, 0x2e, 0x53, 0xbb, 0x0f, 0x57, 0x33, 0x4d, 0x5e,
-		0x46, 0x85, 0x6a, 0x04, 0x6e, 0xe6, 0xf6, 0x64, 0x72, 0xc1, 0x36, 0x15, 0x98, 0x39, 0x41, 0xae,
-		0x2d, 0x8d, 0x83, 0x6b, 0x7f, 0x1b, 0x70, 0xef, 0xac, 0x1e, 0xaa, 0xa3, 0xf7, 0x19, 0x27, 0x7a,
-		0xdf, 0x4b, 0x40, 0xa7, 0xd5, 0x51, 0x1f, 0xac, 0xf9, 0x54, 0x5e, 0xa7, 0x66, 0x33, 0xc7, 0x8f,
-		0xbb, 0x87, 0xd0, 0x14, 0x94, 0xa5, 0xd7, 0xe0, 0x9e, 0xa3, 0x7a, 0xed, 0xa8, 0x19, 0x3f, 0xa2,
-		0x3a, 0x4c, 0x74, 0x59, 0x09, 0xcf, 0x75, 0x5a, 0xaa, 0xed, 0x56, 0xcc, 0x71, 0xd2, 0x29, 0xf3,
-		0xcf, 0x5d, 0xa7, 0x55, 0xfb, 0xc9, 0x80, 0x6b, 0xd9, 0x16, 0x4c, 0x96, 0x56, 0x7b, 0x3b, 0x17,
-		0x37, 0x69, 0x5c, 0xda, 0x68, 0x68, 0x0b, 0x37, 0x69, 0xe7, 0x8e, 0x0f, 0x14, 0xd8, 0xf1, 0x8e,
-		0xfe, 0x30, 0x98, 0xbb, 0x3f, 0xd4, 0xfe, 0x2a, 0xc3, 0x52, 0x51, 0x6f, 0x26, 0x25, 0x2e, 0xd9,
-		0x0f, 0x25, 0x71, 0x46, 0x86, 0xc4, 0xc5, 0x80, 0x91, 0xc4, 0x1d, 0x77, 0x3c, 0x9d, 0x94, 0xb2,
-		0x81, 0x33, 0x4a, 0xd9, 0x60, 0x7e, 0x29, 0xc3, 0x30, 0xd7, 0xf6, 0x54, 0x3d, 0x84, 0x62, 0xa8,
-		0x5f, 0x97, 0x9a, 0x49, 0x20, 0x5e, 0xa4, 0x28, 0xc6, 0x6b, 0xb8, 0xa2, 0x96, 0xd4, 0x03, 0x7d,
-		0xb8, 0x1f, 0xfa, 0x25, 0x99, 0x9d, 0x06, 0xfc, 0x1c, 0x26, 0x77, 0x31, 0x39, 0xf4, 0xf6, 0xf6,
-		0x34, 0x36, 0x73, 0x05, 0xe5, 0x47, 0xd8, 0xe9, 0xaf, 0xc1, 0x17, 0x74, 0xa2, 0x82, 0xdd, 0xd4,
-		0x69, 0xa7, 0x34, 0xa9, 0x7c, 0x16, 0x4d, 0xda, 0x84, 0x2a, 0x73, 0x99, 0x60, 0x58, 0x78, 0x5c,
-		0x69, 0xec, 0xb9, 0x95, 0x85, 0xfe, 0xfe, 0x7f, 0x33, 0x4e, 0x31, 0xdb, 0xd9, 0x9d, 0x9d, 0xb5,
-		0x5a, 0xa0, 0xb3, 0x22, 0x13, 0x26, 0x1d, 0x2c, 0xbf, 0x01, 0x23, 0x99, 0x90, 0xa5, 0xd5, 0x12,
-		0x00, 0x39, 0x4e, 0xc6, 0x05, 0x99, 0xbb, 0x9e, 0xa4, 0x9a, 0x2a, 0x13, 0xfd, 0x0f, 0xc6, 0x08,
-		0x97, 0x67, 0x44, 0xdb, 0x0c, 0x25, 0xd8, 0x55, 0x73, 0x54, 0x0e, 0xc6, 0x3e, 0xf1, 0x6c, 0x7a,
-		0xbc, 0x08, 0x43, 0x4d, 0xda, 0xf4, 0xb4, 0x01, 0x9e, 0x4e, 0x4d, 0x79, 0x46, 0x9b, 0x9e, 0xa9,
-		0xc2, 0x90, 0x09, 0xe3, 0xa7, 0x0c, 0xf5, 0xd4, 0x39, 0x95, 0xfb, 0xff, 0x74, 0xe7, 0xdf, 0x65,
-		0x7d, 0xcd, 0xf3, 0x41, 0xd7, 0x48, 0xed, 0xcf, 0x32, 0x2c, 0x16, 0xfa, 0xac, 0xe9, 0xd9, 0x8e,
-		0x67, 0x61, 0x24, 0xe9, 0x03, 0xcc, 0x56, 0x37, 0xb8, 0x6a, 0x42, 0x3c, 0x14, 0x79, 0xe1, 0x93,
-		0x8d, 0x62, 0xf0, 0x1d, 0x34, 0x8a, 0xf7, 0xe0, 0x79, 0xf3, 0x34, 0x8a, 0xd2, 0xbf, 0xda, 0x28,
-		0xca, 0x67, 0x6e, 0x14, 0xaf, 0x60, 0xc2, 0xc7, 0x9c, 0xba, 0x42, 0x23, 0xea, 0xeb, 0x1d, 0x5d,
-		0xce, 0xf9, 0x1e, 0xab, 0x97, 0xf1, 0x0a, 0x45, 0x5f, 0xf2, 0x71, 0xbf, 0x7b, 0xa8, 0x53, 0x24,
-		0xab, 0x27, 0x45, 0x92, 0xc0, 0x54, 0xc7, 0x31, 0xb0, 0x38, 0x0d, 0xdb, 0xd3, 0x82, 0x9a, 0xf6,
-		0x56, 0x66, 0xc1, 0x37, 0x6d, 0x53, 0xa6, 0xe8, 0xa9, 0x2f, 0x1e, 0xa7, 0x0d, 0xbf, 0x1b, 0x0b,
-		0x7d, 0xea, 0x5e, 0x8f, 0x66, 0xde, 0xeb, 0xb1, 0xe2, 0xf7, 0xfa, 0xdc, 0x5b, 0xdc, 0xeb, 0xff,
-		0xbe, 0xdd, 0xbd, 0xfe, 0x7d, 0x00, 0x96, 0x0b, 0x7f, 0xf8, 0xbf, 0x6f, 0xab, 0x35, 0x0b, 0x23,
-		0xfa, 0xff, 0x1d, 0xca, 0xfd, 0x44, 0x9f, 0xb6, 0x10, 0x0d, 0x29, 0xf7, 0x93, 0x5c, 0xd7, 0xa1,
-		0xfc, 0xd7, 0xb5, 0xe3, 0x68, 0x0e, 0xe7, 0xf2, 0x6f, 0xa5, 0x5e, 0xfe, 0xed, 0x4b, 0x03, 0x96,
-		0x8a, 0xfe, 0xff, 0x21, 0xbd, 0x98, 0xc6, 0x5b, 0x15, 0x73, 0xed, 0x15, 0x5c, 0x22, 0x5e, 0x33,
-		0x2d, 0x7b, 0xad, 0xb2, 0xea, 0xb3, 0x6d, 0xd9, 0x0f, 0xb6, 0x8d, 0x4f, 0x1a, 0xfb, 0x4c, 0x1c,
-		0x84, 0xbb, 0x75, 0xe2, 0x35, 0x1b, 0x27, 0x7e, 0x72, 0xa9, 0xef, 0x53, 0x37, 0xfa, 0x8d, 0x47,
-		0xff, 0xfa, 0xf2, 0x00, 0xfb, 0xec, 0x68, 0x79, 0xb7, 0xa4, 0xc6, 0x6e, 0xff, 0x13, 0x00, 0x00,
-		0xff, 0xff, 0xbc, 0x58, 0xcc, 0x6b, 0x40, 0x1a, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4f, 0x4f, 0x1c, 0x47,
+		0x16, 0xdf, 0xe6, 0xcf, 0xfc, 0x79, 0x0c, 0xb6, 0x29, 0x6c, 0x0c, 0x36, 0x36, 0x78, 0xd6, 0x8b,
+		0xb1, 0x59, 0x66, 0x00, 0x7b, 0x2d, 0xaf, 0xbd, 0x6b, 0x2d, 0x60, 0x23, 0x23, 0x19, 0x8c, 0xda,
+		0x60, 0x4b, 0x7b, 0x69, 0x15, 0xdd, 0x05, 0x94, 0xe8, 0xe9, 0x9e, 0xad, 0xae, 0x06, 0xcf, 0x4a,
+		0x91, 0x72, 0x4a, 0x4e, 0x39, 0xe5, 0x14, 0x29, 0x52, 0x24, 0x9f, 0x92, 0x4b, 0x72, 0x4d, 0x94,
+		0x4b, 0xf2, 0x11, 0x72, 0xc8, 0xf7, 0x88, 0x94, 0x2f, 0x10, 0x75, 0x75, 0x75, 0xcf, 0x30, 0xd4,
+		0xcc, 0x54, 0x63, 0xc7, 0xb7, 0xe9, 0xea, 0xf7, 0x7e, 0xf5, 0xab, 0x7a, 0xf5, 0xde, 0xfb, 0x55,
+		0x0f, 0x94, 0xc3, 0x5d, 0xc2, 0xaa, 0x36, 0x76, 0x88, 0x67, 0x93, 0x2a, 0xae, 0xd3, 0xea, 0xd1,
+		0x62, 0xd5, 0x21, 0x36, 0x0d, 0xa8, 0xef, 0x55, 0xea, 0xcc, 0xe7, 0x3e, 0x1a, 0x8d, 0x6c, 0x2a,
+		0xd2, 0xa6, 0x82, 0xeb, 0xb4, 0x72, 0xb4, 0x78, 0xe5, 0xfa, 0xbe, 0xef, 0xef, 0xbb, 0xa4, 0x2a,
+		0x4c, 0x76, 0xc3, 0xbd, 0xaa, 0x13, 0x32, 0xcc, 0x53, 0xa7, 0x2b, 0xd3, 0x2a, 0x60, 0xdb, 0xaf,
+		0xd5, 0x52, 0x0b, 0xe5, 0xd4, 0x1c, 0x07, 0x87, 0x2e, 0x0d, 0x78, 0x37, 0x9b, 0x63, 0x9f, 0x1d,
+		0xee, 0xb9, 0xfe, 0x71, 0x6c, 0x53, 0xfe, 0x69, 0x14, 0x0a, 0x4f, 0x24, 0x63, 0xf4, 0x85, 0x01,
+		0x77, 0x02, 0xfb, 0x80, 0x38, 0xa1, 0x4b, 0x2c, 0x6c, 0x73, 0x7a, 0x44, 0x79, 0xc3, 0x8a, 0x50,
+		0xad, 0x64, 0x55, 0x16, 0xe6, 0x9c, 0xd1, 0xdd, 0x90, 0x93, 0x60, 0xdc, 0x98, 0x36, 0x66, 0x87,
+		0x96, 0x1e, 0x55, 0x14, 0x2b, 0xac, 0xbc, 0x94, 0x30, 0xcb, 0x12, 0x65, 0x1b, 0x07, 0x87, 0xc9,
+		0x3c, 0xcb, 0x29, 0xc4, 0xb3, 0xbf, 0x98, 0x33, 0x81, 0x96, 0x25, 0xfa, 0x3f, 0x4c, 0x05, 0x1c,
+		0x33, 0x6e, 0x71, 0x5a, 0x23, 0x4c, 0xc9, 0xa7, 0x4f, 0xf0, 0x59, 0x54, 0xf3, 0x89, 0x7c, 0xb7,
+		0x23, 0x57, 0x25, 0x8b, 0xc9, 0xa0, 0xcb, 0x7b, 0xf4, 0xb5, 0x01, 0xd1, 0xee, 0xd7, 0x5d, 0xc2,
+		0x89, 0x95, 0x6c, 0xa0, 0x45, 0xde, 0x10, 0x3b, 0x8c, 0x82, 0xa6, 0x24, 0xd3, 0x2f, 0xc8, 0xfc,
+		0x47, 0x49, 0x66, 0x55, 0x62, 0xbd, 0x96, 0x50, 0x4f, 0x13, 0x24, 0x25, 0xb7, 0x39, 0x5b, 0xdf,
+		0x1c, 0x7d, 0x69, 0xc0, 0xdc, 0x1e, 0xa6, 0xae, 0x2e, 0xcd, 0x01, 0x41, 0xf3, 0x5f, 0x4a, 0x9a,
+		0x6b, 0x98, 0xba, 0x7a, 0x14, 0x6f, 0xed, 0xe9, 0x99, 0xa2, 0x6f, 0x0c, 0x58, 0x60, 0xe4, 0x7f,
+		0x21, 0x09, 0xb8, 0x65, 0x63, 0xcf, 0x26, 0xae, 0xc6, 0x39, 0x1b, 0xec, 0xb2, 0x95, 0x66, 0x0c,
+		0xb6, 0x2a, 0xb0, 0x7a, 0x1e, 0xb6, 0x39, 0xa6, 0x6f, 0x8e, 0x3e, 0x82, 0x69, 0x49, 0xb1, 0xf3,
+		0x91, 0xcb, 0x09, 0x6a, 0x4b, 0xea, 0x28, 0x0b, 0xe7, 0xce, 0x67, 0xee, 0x9a, 0xdd, 0xcd, 0x00,
+		0xbd, 0x35, 0x60, 0x5e, 0xce, 0xaf, 0x19, 0xcb, 0xbc, 0x20, 0xf3, 0xb8, 0x0b, 0x19, 0xbd, 0x68,
+		0xde, 0xb6, 0x75, 0x8d, 0xd1, 0x2f, 0x06, 0x3c, 0x6e, 0x8b, 0x27, 0x79, 0xc3, 0x09, 0xf3, 0xb0,
+		0x36, 0xeb, 0x82, 0x60, 0xbd, 0xd1, 0x3b, 0xba, 0x4f, 0x25, 0xb0, 0xde, 0x22, 0x1e, 0xb0, 0x33,
+		0xfa, 0xa2, 0x8f, 0x0d, 0xb8, 0xc1, 0x88, 0xed, 0x33, 0xc7, 0xaa, 0x61, 0x76, 0xd8, 0x21, 0xf2,
+		0x45, 0x41, 0xfb, 0x6e, 0x07, 0xda, 0x91, 0xf7, 0x86, 0x70, 0x56, 0x92, 0xbb, 0xce, 0xba, 0x5a,
+		0xa0, 0x1f, 0x0c, 0xb8, 0x6f, 0xfb, 0x1e, 0xa7, 0x5e, 0x48, 0x2c, 0x1c, 0x58, 0x1e, 0x39, 0xd6,
+		0xdd, 0x4e, 0x10, 0xbc, 0x9e, 0x76, 0xa8, 0x3b, 0x31, 0xe4, 0x72, 0xb0, 0x49, 0x8e, 0xf5, 0xb6,
+		0x71, 0xc1, 0xce, 0xe8, 0x83, 0xbe, 0x33, 0x60, 0x29, 0xae, 0xd4, 0xf6, 0x01, 0x75, 0x1d, 0x5d,
+		0xde, 0x43, 0x82, 0xf7, 0x4a, 0xe7, 0xe2, 0xbd, 0x1a, 0xa1, 0xe9, 0x91, 0x9e, 0x0f, 0xb2, 0x38,
+		0xa0, 0x1f, 0x0d, 0xb8, 0x1f, 0xd0, 0x7d, 0x0f, 0x67, 0x3f, 0xbc, 0x25, 0xc1, 0x7a, 0x4d, 0xcd,
+		0x5a, 0x40, 0x66, 0x3b, 0xb5, 0x8b, 0x41, 0x56, 0x27, 0xf4, 0xbd, 0x01, 0xff, 0x08, 0xeb, 0x01,
+		0x61, 0xbc, 0x49, 0x3a, 0x20, 0x98, 0xd9, 0x07, 0x2d, 0x44, 0x95, 0xe4, 0x87, 0xbb, 0x1c, 0x95,
+		0x1d, 0x81, 0x98, 0xcc, 0xff, 0x52, 0xe0, 0x35, 0x27, 0x55, 0x1f, 0x95, 0x30, 0xa3, 0x0f, 0xfa,
+		0xca, 0x80, 0xbf, 0x9f, 0xee, 0xab, 0x61, 0xdd, 0xc1, 0x9c, 0x28, 0x19, 0x9f, 0x13, 0x8c, 0xff,
+		0xad, 0xd5, 0x54, 0x77, 0x04, 0x8c, 0x92, 0xe9, 0xac, 0xad, 0x69, 0x8b, 0x3e, 0x37, 0x60, 0xb6,
+		0x7d, 0x73, 0x6b, 0xa4, 0xe6, 0x2b, 0xd9, 0x9d, 0x17, 0xec, 0xfe, 0xa9, 0xb1, 0x9f, 0x1b, 0xa4,
+		0xe6, 0x2b, 0x99, 0xdd, 0x0c, 0x35, 0xec, 0x56, 0x4a, 0x00, 0xcd, 0x69, 0xcb, 0x9f, 0xe5, 0x60,
+		0x46, 0x4f, 0x6e, 0xa1, 0x29, 0x18, 0x4a, 0xdb, 0x2d, 0x75, 0x84, 0x80, 0x2b, 0x9a, 0x90, 0x0c,
+		0xad, 0x3b, 0x68, 0x0d, 0x86, 0x53, 0x03, 0xde, 0xa8, 0x13, 0xa9, 0xa9, 0x6e, 0x28, 0xd7, 0x94,
+		0x4e, 0xd6, 0xa8, 0x13, 0xb3, 0x84, 0x5b, 0x9e, 0xd0, 0x18, 0xe4, 0x1c, 0xbf, 0x86, 0xa9, 0x27,
+		0x74, 0x50, 0xd1, 0x94, 0x4f, 0xe8, 0x21, 0x14, 0x45, 0x9b, 0x8f, 0x54, 0xaa, 0xd4, 0x1e, 0xd7,
+		0x94, 0xd8, 0xd1, 0x02, 0x9e, 0xd3, 0x80, 0x9b, 0x05, 0x2e, 0x7f, 0xa1, 0x25, 0x18, 0xa4, 0x5e,
+		0x3d, 0xe4, 0x52, 0x0f, 0x4c, 0x2a, 0xfd, 0xb6, 0x70, 0xc3, 0xf5, 0xb1, 0x63, 0xc6, 0xa6, 0x68,
+		0x1b, 0x26, 0x52, 0x41, 0xcb, 0x7d, 0xcb, 0x76, 0xfd, 0x80, 0x88, 0x76, 0xee, 0x87, 0x5c, 0x36,
+		0xef, 0x89, 0x4a, 0x2c, 0xc6, 0x2b, 0x89, 0x18, 0xaf, 0x3c, 0x91, 0x62, 0xdc, 0x1c, 0x4b, 0x7c,
+		0xb7, 0xfd, 0xd5, 0xc8, 0x73, 0x3b, 0x76, 0x6c, 0x47, 0x6d, 0xea, 0xd2, 0x08, 0x35, 0x9f, 0x01,
+		0x35, 0x55, 0xa5, 0x11, 0xea, 0x26, 0x8c, 0x49, 0xa4, 0x76, 0xa2, 0x85, 0x5e, 0x90, 0xa3, 0xb1,
+		0x7c, 0x3d, 0xc9, 0x72, 0x0d, 0x46, 0x0e, 0x08, 0x66, 0x7c, 0x97, 0xe0, 0x26, 0xbb, 0x62, 0x2f,
+		0xa8, 0x0b, 0xa9, 0x4f, 0x82, 0xb3, 0x0a, 0x25, 0x46, 0x38, 0x6b, 0x58, 0x75, 0xdf, 0xa5, 0x76,
+		0x43, 0x56, 0xea, 0xe9, 0x0e, 0x9d, 0x8f, 0xb3, 0xc6, 0x96, 0xb0, 0x33, 0x87, 0x58, 0xf3, 0x01,
+		0xdd, 0x85, 0xdc, 0x01, 0xc1, 0x0e, 0x61, 0xb2, 0x64, 0x5e, 0x55, 0xba, 0x3f, 0x13, 0x26, 0xa6,
+		0x34, 0x45, 0xf7, 0x60, 0x2c, 0x11, 0x17, 0xae, 0x6f, 0x63, 0xd7, 0x72, 0x68, 0x50, 0xc7, 0xdc,
+		0x3e, 0x10, 0xa5, 0xab, 0x60, 0x5e, 0x94, 0x6f, 0x9f, 0x47, 0x2f, 0x9f, 0xc8, 0x77, 0xe5, 0x4f,
+		0x0d, 0x98, 0xec, 0x26, 0xf7, 0xd1, 0x04, 0x14, 0x62, 0x45, 0x97, 0xa6, 0x40, 0x5e, 0x3c, 0xaf,
+		0x3b, 0xe8, 0x39, 0x5c, 0x4a, 0x63, 0xb0, 0x47, 0x59, 0x33, 0x04, 0x7d, 0xbd, 0xf6, 0x0d, 0xc9,
+		0x10, 0xac, 0x51, 0x96, 0x44, 0xa0, 0x6c, 0xc3, 0x5c, 0x06, 0xa9, 0x8f, 0xee, 0x41, 0x8e, 0x91,
+		0x20, 0x74, 0xf9, 0xb8, 0xa1, 0x71, 0xc2, 0xa5, 0x6d, 0x19, 0xc3, 0x2d, 0x4d, 0xa1, 0x8e, 0xee,
+		0x43, 0x3e, 0x12, 0xea, 0x21, 0x23, 0x5d, 0x67, 0x58, 0x8b, 0x6d, 0xcc, 0xc4, 0xb8, 0xbc, 0x09,
+		0x73, 0x19, 0x74, 0x76, 0xcf, 0x2a, 0x53, 0x7e, 0x08, 0xd7, 0xba, 0x8a, 0xe3, 0x2e, 0x11, 0x2a,
+		0xdb, 0x70, 0x5b, 0x5b, 0xcb, 0x46, 0x0b, 0x76, 0x08, 0xc7, 0xd4, 0x0d, 0xb4, 0xb6, 0x34, 0x31,
+		0x2e, 0xff, 0x6e, 0xc0, 0x83, 0xb3, 0x6a, 0xcf, 0x96, 0xda, 0x67, 0x9c, 0xa8, 0x7d, 0x3b, 0x80,
+		0x4e, 0xab, 0x0a, 0x79, 0xb0, 0x66, 0x94, 0xbc, 0x4e, 0xcd, 0x66, 0x8e, 0x1c, 0xb7, 0x0f, 0xa1,
+		0x71, 0xc8, 0xdb, 0xbe, 0xc7, 0x99, 0xef, 0x8a, 0x5a, 0x5b, 0x32, 0x93, 0x47, 0x54, 0x81, 0xd1,
+		0x36, 0x09, 0xe6, 0x7b, 0x6e, 0x43, 0x94, 0xdd, 0x82, 0x39, 0x62, 0xb7, 0xca, 0xa3, 0x17, 0x9e,
+		0xdb, 0x28, 0x7f, 0x6b, 0xc0, 0xf5, 0xee, 0xd2, 0x35, 0x0a, 0xad, 0xd4, 0xc4, 0x1e, 0xae, 0x91,
+		0x24, 0xb4, 0xf1, 0xd0, 0x26, 0xae, 0x91, 0xd6, 0x1d, 0xef, 0xcb, 0xb0, 0xe3, 0x2d, 0xf5, 0xa1,
+		0x5f, 0xbb, 0x3e, 0x94, 0x7f, 0xcb, 0xc3, 0x42, 0x56, 0x4d, 0x1b, 0xb5, 0xb8, 0x74, 0x3f, 0x44,
+		0x8b, 0x33, 0xba, 0xb4, 0xb8, 0x04, 0x30, 0x6e, 0x71, 0xc7, 0x2d, 0x4f, 0x27, 0x5b, 0x59, 0xdf,
+		0x19, 0x5b, 0x59, 0xbf, 0x7e, 0x2b, 0xc3, 0x30, 0xdd, 0xd4, 0xa2, 0x1d, 0x1a, 0xc5, 0x40, 0xaf,
+		0x2a, 0x35, 0x99, 0x42, 0xbc, 0x54, 0x74, 0x8c, 0xd7, 0x70, 0x55, 0x2c, 0xa9, 0x03, 0xfa, 0x60,
+		0x2f, 0xf4, 0xcb, 0x91, 0xb7, 0x0a, 0xf8, 0x05, 0x8c, 0xed, 0x62, 0xfb, 0xd0, 0xdf, 0xdb, 0x93,
+		0xd8, 0xd4, 0xe3, 0x84, 0x1d, 0x61, 0xb7, 0x77, 0x0f, 0xbe, 0x28, 0x1d, 0x05, 0xec, 0xba, 0x74,
+		0x3b, 0xd5, 0x93, 0xf2, 0x67, 0xe9, 0x49, 0xeb, 0x50, 0xa4, 0x1e, 0xe5, 0x14, 0x73, 0x9f, 0x89,
+		0x1e, 0x7b, 0x6e, 0x69, 0xae, 0xf7, 0xbd, 0x69, 0x3d, 0x71, 0x31, 0x9b, 0xde, 0xad, 0x95, 0xb5,
+		0x98, 0xa1, 0xb2, 0x22, 0x13, 0xc6, 0x5c, 0x1c, 0xdd, 0x9d, 0xe3, 0x36, 0x11, 0x85, 0x56, 0xb6,
+		0x00, 0xd0, 0x38, 0x19, 0x17, 0x23, 0xdf, 0xd5, 0xd4, 0xd5, 0x14, 0x9e, 0xe8, 0xaf, 0x30, 0x6c,
+		0xb3, 0xe8, 0x8c, 0x48, 0x99, 0x21, 0x1a, 0x76, 0xd1, 0x2c, 0x45, 0x83, 0x89, 0x4e, 0x3c, 0x5b,
+		0x3f, 0x9e, 0x87, 0x81, 0x48, 0xfc, 0xca, 0x8b, 0xc3, 0x84, 0xd2, 0x25, 0x92, 0xac, 0xa6, 0x30,
+		0x43, 0x26, 0x8c, 0x9c, 0xba, 0x88, 0x48, 0x09, 0xff, 0x37, 0xf5, 0x8d, 0xa9, 0xed, 0xca, 0x60,
+		0x5e, 0x08, 0xda, 0x46, 0xca, 0xbf, 0xe6, 0x61, 0x3e, 0xd3, 0x75, 0xb0, 0x63, 0x39, 0x9e, 0x82,
+		0xa1, 0xb4, 0x0e, 0x50, 0x47, 0x64, 0x70, 0xd1, 0x84, 0x64, 0x28, 0xd6, 0xc2, 0x27, 0x0b, 0x45,
+		0xff, 0x7b, 0x28, 0x14, 0x1f, 0x40, 0xf3, 0xea, 0x14, 0x8a, 0xdc, 0x9f, 0x5a, 0x28, 0xf2, 0x67,
+		0x2e, 0x14, 0xaf, 0x60, 0xb4, 0x8e, 0x19, 0xf1, 0xb8, 0x44, 0x94, 0xe9, 0x1d, 0x27, 0xe7, 0x4c,
+		0x87, 0xd5, 0x47, 0xf6, 0x02, 0x45, 0x26, 0xf9, 0x48, 0xbd, 0x7d, 0xa8, 0xb5, 0x49, 0x16, 0x4f,
+		0x36, 0x49, 0x1b, 0xc6, 0x5b, 0x8e, 0x81, 0xc5, 0x48, 0xd8, 0x9c, 0x16, 0xc4, 0xb4, 0x77, 0xba,
+		0x06, 0x7c, 0xdd, 0x31, 0x49, 0x98, 0xcc, 0x63, 0x5e, 0x3a, 0x56, 0x0d, 0xbf, 0x1f, 0x09, 0x7d,
+		0x2a, 0xaf, 0x4b, 0x5d, 0xf3, 0x7a, 0x38, 0x7b, 0x5e, 0x9f, 0x7b, 0x87, 0xbc, 0x3e, 0xff, 0x6e,
+		0x79, 0xfd, 0x73, 0x1f, 0x2c, 0x66, 0xfe, 0x60, 0xf2, 0xa1, 0xa5, 0xd6, 0x14, 0x0c, 0xc9, 0xef,
+		0x44, 0x42, 0xfd, 0xc4, 0x57, 0x5b, 0x88, 0x87, 0x84, 0xfa, 0x49, 0xd3, 0x75, 0x40, 0x3f, 0x5d,
+		0x5b, 0x8e, 0xe6, 0xa0, 0x96, 0x7e, 0xcb, 0x75, 0xd2, 0x6f, 0x9f, 0x18, 0xb0, 0x90, 0xf5, 0xbb,
+		0x8d, 0x3a, 0x98, 0xc6, 0xbb, 0x05, 0xf3, 0xad, 0x01, 0xb3, 0xba, 0x9f, 0x63, 0xd0, 0x55, 0x28,
+		0xca, 0x2f, 0x3e, 0xa9, 0xd8, 0x2f, 0xc4, 0x03, 0xeb, 0x4e, 0xcb, 0x95, 0xa8, 0x4f, 0xff, 0x4a,
+		0x14, 0x65, 0x0a, 0x61, 0xcc, 0x67, 0x56, 0x8d, 0x04, 0x01, 0xde, 0x4f, 0x22, 0x55, 0x12, 0x83,
+		0x1b, 0xf1, 0x58, 0x79, 0x07, 0x6e, 0xea, 0x7c, 0x94, 0x49, 0x93, 0xc3, 0xd0, 0x4a, 0x8e, 0x95,
+		0x57, 0x70, 0xd9, 0xf6, 0x6b, 0x2a, 0xab, 0x95, 0xc2, 0x72, 0x9d, 0x6e, 0x31, 0x9f, 0xfb, 0x5b,
+		0xc6, 0x7f, 0xab, 0xfb, 0x94, 0x1f, 0x84, 0xbb, 0x15, 0xdb, 0xaf, 0x55, 0x4f, 0xfc, 0x4d, 0x57,
+		0xd9, 0x27, 0x5e, 0xfc, 0xbf, 0xa0, 0xfc, 0xc7, 0xee, 0x11, 0xae, 0xd3, 0xa3, 0xc5, 0xdd, 0x9c,
+		0x18, 0xbb, 0xfb, 0xc7, 0x00, 0xf5, 0x2f, 0xe5, 0x1a, 0x74, 0x1c, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/tasklist.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xdb, 0x36,
-		0x14, 0x9e, 0xe2, 0xb4, 0x73, 0x98, 0x25, 0xd5, 0xb8, 0xb5, 0x8d, 0xdd, 0x75, 0xcb, 0x74, 0x51,
-		0x04, 0xc5, 0x20, 0x21, 0x19, 0x76, 0xb5, 0x8b, 0xc1, 0x89, 0x83, 0x55, 0x88, 0xe3, 0x1a, 0x92,
-		0x1a, 0x20, 0xbb, 0xe1, 0x28, 0xf1, 0xd4, 0x21, 0xf4, 0x43, 0x81, 0xa4, 0x92, 0xf8, 0x45, 0xf6,
-		0x30, 0x7b, 0xa2, 0x3d, 0xc6, 0x40, 0x4a, 0xf6, 0xdc, 0xc4, 0xeb, 0x1d, 0x79, 0xbe, 0xf3, 0x9d,
-		0x9f, 0x8f, 0xe7, 0x10, 0x79, 0x4d, 0x0a, 0x32, 0xc8, 0x28, 0x83, 0x2a, 0x83, 0x80, 0xd6, 0x3c,
-		0xb8, 0x3d, 0x0e, 0x34, 0x55, 0x79, 0xc1, 0x95, 0xf6, 0x6b, 0x29, 0xb4, 0xc0, 0xdf, 0x18, 0x1f,
-		0xbf, 0xf3, 0xf1, 0x69, 0xcd, 0xfd, 0xdb, 0xe3, 0xe1, 0xf7, 0x73, 0x21, 0xe6, 0x05, 0x04, 0xd6,
-		0x25, 0x6d, 0x3e, 0x06, 0xac, 0x91, 0x54, 0x73, 0x51, 0xb5, 0xa4, 0xe1, 0x0f, 0x0f, 0x71, 0xcd,
-		0x4b, 0x50, 0x9a, 0x96, 0x75, 0xe7, 0xf0, 0x28, 0xc0, 0x9d, 0xa4, 0x75, 0x0d, 0x52, 0xb5, 0xb8,
-		0xf7, 0x01, 0xf5, 0x13, 0xaa, 0xf2, 0x09, 0x57, 0x1a, 0x63, 0xb4, 0x5d, 0xd1, 0x12, 0x0e, 0x9c,
-		0x43, 0xe7, 0x68, 0x27, 0xb2, 0x67, 0xfc, 0x0b, 0xda, 0xce, 0x79, 0xc5, 0x0e, 0xb6, 0x0e, 0x9d,
-		0xa3, 0xfd, 0x93, 0x1f, 0xfd, 0x0d, 0x45, 0xfa, 0xcb, 0x00, 0x17, 0xbc, 0x62, 0x91, 0x75, 0xf7,
-		0x28, 0x72, 0x97, 0xd6, 0x4b, 0xd0, 0x94, 0x51, 0x4d, 0xf1, 0x25, 0xfa, 0xb6, 0xa4, 0xf7, 0xc4,
-		0xb4, 0xad, 0x48, 0x0d, 0x92, 0x28, 0xc8, 0x44, 0xc5, 0x6c, 0xba, 0xdd, 0x93, 0xef, 0xfc, 0xb6,
-		0x52, 0x7f, 0x59, 0xa9, 0x3f, 0x16, 0x4d, 0x5a, 0xc0, 0x15, 0x2d, 0x1a, 0x88, 0xbe, 0x2e, 0xe9,
-		0xbd, 0x09, 0xa8, 0x66, 0x20, 0x63, 0x4b, 0xf3, 0x3e, 0xa0, 0xc1, 0x32, 0xc5, 0x8c, 0x4a, 0xcd,
-		0x8d, 0x2a, 0xab, 0x5c, 0x2e, 0xea, 0xe5, 0xb0, 0xe8, 0x3a, 0x31, 0x47, 0xfc, 0x06, 0x3d, 0x13,
-		0x77, 0x15, 0x48, 0x72, 0x23, 0x94, 0x26, 0xb6, 0xcf, 0x2d, 0x8b, 0xee, 0x59, 0xf3, 0x3b, 0xa1,
-		0xf4, 0x94, 0x96, 0xe0, 0xfd, 0xe3, 0xa0, 0xfd, 0x65, 0xdc, 0x58, 0x53, 0xdd, 0x28, 0xfc, 0x13,
-		0xc2, 0x29, 0xcd, 0xf2, 0x42, 0xcc, 0x49, 0x26, 0x9a, 0x4a, 0x93, 0x1b, 0x5e, 0x69, 0x1b, 0xbb,
-		0x17, 0xb9, 0x1d, 0x72, 0x66, 0x80, 0x77, 0xbc, 0xd2, 0xf8, 0x35, 0x42, 0x12, 0x28, 0x23, 0x05,
-		0xdc, 0x42, 0x61, 0x73, 0xf4, 0xa2, 0x1d, 0x63, 0x99, 0x18, 0x03, 0x7e, 0x85, 0x76, 0x68, 0x96,
-		0x77, 0x68, 0xcf, 0xa2, 0x7d, 0x9a, 0xe5, 0x2d, 0xf8, 0x06, 0x3d, 0x93, 0x54, 0xc3, 0xba, 0x3a,
-		0xdb, 0x87, 0xce, 0x91, 0x13, 0xed, 0x19, 0xf3, 0xaa, 0x77, 0x3c, 0x46, 0x7b, 0x46, 0x46, 0xc2,
-		0x19, 0x49, 0x0b, 0x91, 0xe5, 0x07, 0x4f, 0xac, 0x86, 0x87, 0xff, 0xfb, 0x3c, 0xe1, 0xf8, 0xd4,
-		0xf8, 0x45, 0xbb, 0x86, 0x16, 0x32, 0x7b, 0xf1, 0x7e, 0x43, 0xbb, 0x6b, 0x18, 0x1e, 0xa0, 0xbe,
-		0xd2, 0x54, 0x6a, 0xc2, 0x59, 0xd7, 0xdc, 0x97, 0xf6, 0x1e, 0x32, 0xfc, 0x1c, 0x3d, 0x85, 0x8a,
-		0x19, 0xa0, 0xed, 0xe7, 0x09, 0x54, 0x2c, 0x64, 0xde, 0x5f, 0x0e, 0x42, 0x33, 0x51, 0x14, 0x20,
-		0xc3, 0xea, 0xa3, 0xc0, 0x63, 0xe4, 0x16, 0x54, 0x69, 0x42, 0xb3, 0x0c, 0x94, 0x22, 0x66, 0x14,
-		0xbb, 0xc7, 0x1d, 0x3e, 0x7a, 0xdc, 0x64, 0x39, 0xa7, 0xd1, 0xbe, 0xe1, 0x8c, 0x2c, 0xc5, 0x18,
-		0xf1, 0x10, 0xf5, 0x39, 0x83, 0x4a, 0x73, 0xbd, 0xe8, 0x5e, 0x68, 0x75, 0xdf, 0xa4, 0x4f, 0x6f,
-		0x83, 0x3e, 0xde, 0xdf, 0x0e, 0x1a, 0xc4, 0x9a, 0x67, 0xf9, 0xe2, 0xfc, 0x1e, 0xb2, 0xc6, 0x8c,
-		0xc6, 0x48, 0x6b, 0xc9, 0xd3, 0x46, 0x83, 0xc2, 0xbf, 0x23, 0xf7, 0x4e, 0xc8, 0x1c, 0xa4, 0x9d,
-		0x45, 0x62, 0x76, 0xb0, 0xab, 0xf3, 0xf5, 0x67, 0xe7, 0x3b, 0xda, 0x6f, 0x69, 0xab, 0x85, 0x49,
-		0xd0, 0x40, 0x65, 0x37, 0xc0, 0x9a, 0x02, 0x88, 0x16, 0xa4, 0x55, 0xcf, 0xb4, 0x2d, 0x1a, 0x6d,
-		0x6b, 0xdf, 0x3d, 0x19, 0x3c, 0x1e, 0xeb, 0x6e, 0x83, 0xa3, 0x17, 0x4b, 0x6e, 0x22, 0x62, 0xc3,
-		0x4c, 0x5a, 0xe2, 0xdb, 0x3f, 0xd1, 0x57, 0xeb, 0x1b, 0x85, 0x87, 0xe8, 0x45, 0x32, 0x8a, 0x2f,
-		0xc8, 0x24, 0x8c, 0x13, 0x72, 0x11, 0x4e, 0xc7, 0x24, 0x9c, 0x5e, 0x8d, 0x26, 0xe1, 0xd8, 0xfd,
-		0x02, 0x0f, 0xd0, 0xf3, 0x07, 0xd8, 0xf4, 0x7d, 0x74, 0x39, 0x9a, 0xb8, 0xce, 0x06, 0x28, 0x4e,
-		0xc2, 0xb3, 0x8b, 0x6b, 0x77, 0xeb, 0x2d, 0xfb, 0x2f, 0x43, 0xb2, 0xa8, 0xe1, 0xd3, 0x0c, 0xc9,
-		0xf5, 0xec, 0x7c, 0x2d, 0xc3, 0x2b, 0xf4, 0xf2, 0x01, 0x36, 0x3e, 0x3f, 0x0b, 0xe3, 0xf0, 0xfd,
-		0xd4, 0x75, 0x36, 0x80, 0xa3, 0xb3, 0x24, 0xbc, 0x0a, 0x93, 0x6b, 0x77, 0xeb, 0xf4, 0x0a, 0xbd,
-		0xcc, 0x44, 0xb9, 0x49, 0xd1, 0xd3, 0xfe, 0xa8, 0xe6, 0x33, 0x23, 0xc8, 0xcc, 0xf9, 0x23, 0x98,
-		0x73, 0x7d, 0xd3, 0xa4, 0x7e, 0x26, 0xca, 0xe0, 0x93, 0x6f, 0xd2, 0x9f, 0x43, 0xd5, 0xfe, 0x5b,
-		0xdd, 0x8f, 0xf9, 0x2b, 0xad, 0xf9, 0xed, 0x71, 0xfa, 0xd4, 0xda, 0x7e, 0xfe, 0x37, 0x00, 0x00,
-		0xff, 0xff, 0x58, 0x62, 0x2b, 0xbf, 0x55, 0x05, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x6e, 0x1b, 0x37,
+		0x10, 0xee, 0x4a, 0x8e, 0x2b, 0x8f, 0x6b, 0x67, 0xcb, 0x36, 0x8e, 0xa5, 0x34, 0xad, 0xaa, 0x43,
+		0x60, 0x04, 0xc5, 0x0a, 0x76, 0xd1, 0x53, 0x0f, 0x85, 0x6c, 0x19, 0xcd, 0xc2, 0xb2, 0x22, 0xac,
+		0x36, 0x06, 0xdc, 0x0b, 0x4b, 0x2d, 0x27, 0x32, 0xb1, 0x3f, 0x5c, 0x90, 0x5c, 0xff, 0xdc, 0xfa,
+		0x14, 0x7d, 0x98, 0x3e, 0x5d, 0x41, 0xee, 0xca, 0x75, 0x6c, 0x35, 0x37, 0x72, 0xbe, 0xf9, 0xe6,
+		0xe7, 0xe3, 0x0c, 0x61, 0x50, 0x2d, 0x50, 0x0d, 0x13, 0xc6, 0xb1, 0x48, 0x70, 0xc8, 0x4a, 0x31,
+		0xbc, 0x3e, 0x1c, 0x1a, 0xa6, 0xd3, 0x4c, 0x68, 0x13, 0x94, 0x4a, 0x1a, 0x49, 0xbe, 0xb1, 0x3e,
+		0x41, 0xe3, 0x13, 0xb0, 0x52, 0x04, 0xd7, 0x87, 0xbd, 0xef, 0x97, 0x52, 0x2e, 0x33, 0x1c, 0x3a,
+		0x97, 0x45, 0xf5, 0x71, 0xc8, 0x2b, 0xc5, 0x8c, 0x90, 0x45, 0x4d, 0xea, 0xfd, 0xf0, 0x18, 0x37,
+		0x22, 0x47, 0x6d, 0x58, 0x5e, 0x36, 0x0e, 0x4f, 0x02, 0xdc, 0x28, 0x56, 0x96, 0xa8, 0x74, 0x8d,
+		0x0f, 0x3e, 0x40, 0x27, 0x66, 0x3a, 0x9d, 0x08, 0x6d, 0x08, 0x81, 0x8d, 0x82, 0xe5, 0xb8, 0xef,
+		0xf5, 0xbd, 0x83, 0xad, 0xc8, 0x9d, 0xc9, 0x2f, 0xb0, 0x91, 0x8a, 0x82, 0xef, 0xb7, 0xfa, 0xde,
+		0xc1, 0xee, 0xd1, 0x8f, 0xc1, 0x9a, 0x22, 0x83, 0x55, 0x80, 0x33, 0x51, 0xf0, 0xc8, 0xb9, 0x0f,
+		0x18, 0xf8, 0x2b, 0xeb, 0x39, 0x1a, 0xc6, 0x99, 0x61, 0xe4, 0x1c, 0xbe, 0xcd, 0xd9, 0x2d, 0xb5,
+		0x6d, 0x6b, 0x5a, 0xa2, 0xa2, 0x1a, 0x13, 0x59, 0x70, 0x97, 0x6e, 0xfb, 0xe8, 0xbb, 0xa0, 0xae,
+		0x34, 0x58, 0x55, 0x1a, 0x8c, 0x65, 0xb5, 0xc8, 0xf0, 0x82, 0x65, 0x15, 0x46, 0x5f, 0xe7, 0xec,
+		0xd6, 0x06, 0xd4, 0x33, 0x54, 0x73, 0x47, 0x1b, 0x7c, 0x80, 0xee, 0x2a, 0xc5, 0x8c, 0x29, 0x23,
+		0xac, 0x2a, 0xf7, 0xb9, 0x7c, 0x68, 0xa7, 0x78, 0xd7, 0x74, 0x62, 0x8f, 0xe4, 0x0d, 0x3c, 0x97,
+		0x37, 0x05, 0x2a, 0x7a, 0x25, 0xb5, 0xa1, 0xae, 0xcf, 0x96, 0x43, 0x77, 0x9c, 0xf9, 0x9d, 0xd4,
+		0x66, 0xca, 0x72, 0x1c, 0xfc, 0xd5, 0x82, 0xdd, 0x55, 0xdc, 0xb9, 0x61, 0xa6, 0xd2, 0xe4, 0x27,
+		0x20, 0x0b, 0x96, 0xa4, 0x99, 0x5c, 0xd2, 0x44, 0x56, 0x85, 0xa1, 0x57, 0xa2, 0x30, 0x2e, 0x76,
+		0x3b, 0xf2, 0x1b, 0xe4, 0xc4, 0x02, 0xef, 0x44, 0x61, 0xc8, 0x6b, 0x00, 0x85, 0x8c, 0xd3, 0x0c,
+		0xaf, 0x31, 0x73, 0x39, 0xda, 0xd1, 0x96, 0xb5, 0x4c, 0xac, 0x81, 0xbc, 0x82, 0x2d, 0x96, 0xa4,
+		0x0d, 0xda, 0x76, 0x68, 0x87, 0x25, 0x69, 0x0d, 0xbe, 0x81, 0xe7, 0x8a, 0x19, 0x7c, 0xa8, 0xce,
+		0x46, 0xdf, 0x3b, 0xf0, 0xa2, 0x1d, 0x6b, 0xbe, 0xef, 0x9d, 0x8c, 0x61, 0xc7, 0xca, 0x48, 0x05,
+		0xa7, 0x8b, 0x4c, 0x26, 0xe9, 0xfe, 0x33, 0xa7, 0x61, 0xff, 0x7f, 0x9f, 0x27, 0x1c, 0x1f, 0x5b,
+		0xbf, 0x68, 0xdb, 0xd2, 0x42, 0xee, 0x2e, 0x64, 0x0f, 0x36, 0x4b, 0x56, 0x69, 0xe4, 0xfb, 0x9b,
+		0x7d, 0xef, 0xa0, 0x13, 0x35, 0xb7, 0xc1, 0x6f, 0xb0, 0xfd, 0x80, 0x43, 0xba, 0xd0, 0xd1, 0x86,
+		0x29, 0x43, 0x05, 0x6f, 0x9a, 0xfe, 0xd2, 0xdd, 0x43, 0x4e, 0x5e, 0xc0, 0x26, 0x16, 0xdc, 0x02,
+		0x75, 0x9f, 0xcf, 0xb0, 0xe0, 0x21, 0x1f, 0xfc, 0xed, 0x01, 0xcc, 0x64, 0x96, 0xa1, 0x0a, 0x8b,
+		0x8f, 0x92, 0x8c, 0xc1, 0xcf, 0x98, 0x36, 0x94, 0x25, 0x09, 0x6a, 0x4d, 0xed, 0x88, 0x36, 0x8f,
+		0xde, 0x7b, 0xf2, 0xe8, 0xf1, 0x6a, 0x7e, 0xa3, 0x5d, 0xcb, 0x19, 0x39, 0x8a, 0x35, 0x92, 0x1e,
+		0x74, 0x04, 0xc7, 0xc2, 0x08, 0x73, 0xd7, 0xbc, 0xdc, 0xfd, 0x7d, 0x9d, 0x6e, 0xed, 0x35, 0xba,
+		0x0d, 0xfe, 0xf1, 0xa0, 0x3b, 0x37, 0x22, 0x49, 0xef, 0x4e, 0x6f, 0x31, 0xa9, 0xec, 0xc8, 0x8c,
+		0x8c, 0x51, 0x62, 0x51, 0x19, 0xd4, 0xe4, 0x77, 0xf0, 0x6f, 0xa4, 0x4a, 0x51, 0xb9, 0x19, 0xa5,
+		0x76, 0x37, 0x9b, 0x3a, 0x5f, 0x7f, 0x76, 0xee, 0xa3, 0xdd, 0x9a, 0x76, 0xbf, 0x48, 0x31, 0x74,
+		0x75, 0x72, 0x85, 0xbc, 0xca, 0x90, 0x1a, 0x49, 0x6b, 0xf5, 0x6c, 0xdb, 0xb2, 0x32, 0xae, 0xf6,
+		0xed, 0xa3, 0xee, 0xd3, 0x71, 0x6f, 0x36, 0x3b, 0xda, 0x5b, 0x71, 0x63, 0x39, 0xb7, 0xcc, 0xb8,
+		0x26, 0xbe, 0xfd, 0x13, 0xbe, 0x7a, 0xb8, 0x69, 0xa4, 0x07, 0x7b, 0xf1, 0x68, 0x7e, 0x46, 0x27,
+		0xe1, 0x3c, 0xa6, 0x67, 0xe1, 0x74, 0x4c, 0xc3, 0xe9, 0xc5, 0x68, 0x12, 0x8e, 0xfd, 0x2f, 0x48,
+		0x17, 0x5e, 0x3c, 0xc2, 0xa6, 0xef, 0xa3, 0xf3, 0xd1, 0xc4, 0xf7, 0xd6, 0x40, 0xf3, 0x38, 0x3c,
+		0x39, 0xbb, 0xf4, 0x5b, 0x6f, 0xf9, 0x7f, 0x19, 0xe2, 0xbb, 0x12, 0x3f, 0xcd, 0x10, 0x5f, 0xce,
+		0x4e, 0x1f, 0x64, 0x78, 0x05, 0x2f, 0x1f, 0x61, 0xe3, 0xd3, 0x93, 0x70, 0x1e, 0xbe, 0x9f, 0xfa,
+		0xde, 0x1a, 0x70, 0x74, 0x12, 0x87, 0x17, 0x61, 0x7c, 0xe9, 0xb7, 0x8e, 0x2f, 0xe0, 0x65, 0x22,
+		0xf3, 0x75, 0x8a, 0x1e, 0x77, 0x46, 0xa5, 0x98, 0x59, 0x41, 0x66, 0xde, 0x1f, 0xc3, 0xa5, 0x30,
+		0x57, 0xd5, 0x22, 0x48, 0x64, 0x3e, 0xfc, 0xe4, 0xfb, 0x0c, 0x96, 0x58, 0xd4, 0xff, 0x59, 0xf3,
+		0x93, 0xfe, 0xca, 0x4a, 0x71, 0x7d, 0xb8, 0xd8, 0x74, 0xb6, 0x9f, 0xff, 0x1d, 0x00, 0xde, 0xd0,
+		0xdf, 0x62, 0x6d, 0x05, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6f, 0xdb, 0xca,
-		0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0x0f, 0x7a, 0x1c, 0xc7, 0xca, 0xb7, 0xa3, 0xfb, 0x26,
-		0x71, 0xd4, 0x1b, 0xf9, 0x3a, 0xb9, 0xb9, 0x69, 0x6e, 0x9a, 0xa6, 0x34, 0x49, 0xc7, 0x4c, 0x64,
-		0x4a, 0x1d, 0x51, 0x71, 0x7c, 0x51, 0x94, 0xa0, 0xa5, 0xb1, 0x4d, 0x44, 0x22, 0x05, 0x72, 0x94,
-		0xc4, 0xfb, 0x02, 0x5d, 0x77, 0x57, 0xb4, 0x9b, 0xfe, 0x80, 0x02, 0x45, 0x7f, 0x40, 0xd1, 0xa2,
-		0x8b, 0xee, 0xba, 0xed, 0xb2, 0xfb, 0xfe, 0x8b, 0x62, 0x86, 0x43, 0x89, 0xb2, 0x3e, 0xa8, 0xb4,
-		0xc0, 0xed, 0xce, 0x3c, 0x7c, 0x9e, 0x87, 0x67, 0xce, 0x9c, 0xf3, 0x70, 0x68, 0x41, 0xa1, 0x7b,
-		0x4c, 0x82, 0xed, 0x86, 0xd3, 0x24, 0x5e, 0x83, 0x6c, 0x3b, 0x1d, 0x77, 0xfb, 0xc3, 0xce, 0xf6,
-		0x47, 0x3f, 0x78, 0x7f, 0xd2, 0xf2, 0x3f, 0x96, 0x3a, 0x81, 0x4f, 0x7d, 0xb4, 0xc6, 0x30, 0x25,
-		0x81, 0x29, 0x39, 0x1d, 0xb7, 0xf4, 0x61, 0xe7, 0xda, 0xad, 0x53, 0xdf, 0x3f, 0x6d, 0x91, 0x6d,
-		0x0e, 0x39, 0xee, 0x9e, 0x6c, 0x37, 0xbb, 0x81, 0x43, 0x5d, 0xdf, 0x8b, 0x48, 0xd7, 0x6e, 0x5f,
-		0xbc, 0x4f, 0xdd, 0x36, 0x09, 0xa9, 0xd3, 0xee, 0x08, 0xc0, 0xe6, 0xa8, 0x27, 0x37, 0xfc, 0x76,
-		0xbb, 0x27, 0x31, 0x32, 0x37, 0xea, 0x84, 0xef, 0x5b, 0x6e, 0x48, 0x23, 0x4c, 0xe1, 0x2f, 0x73,
-		0xb0, 0x7e, 0x28, 0xd2, 0xd5, 0x3f, 0x91, 0x46, 0x97, 0xa5, 0x60, 0x78, 0x27, 0x3e, 0xaa, 0x03,
-		0x8a, 0xd7, 0x61, 0x93, 0xf8, 0x4e, 0x5e, 0xda, 0x94, 0xb6, 0x72, 0x8f, 0xee, 0x95, 0x46, 0x2c,
-		0xa9, 0x34, 0xa4, 0x83, 0x57, 0x3f, 0x5e, 0x0c, 0xa1, 0x27, 0x30, 0x43, 0xcf, 0x3b, 0x24, 0x9f,
-		0xe1, 0x42, 0x77, 0x26, 0x0a, 0x59, 0xe7, 0x1d, 0x82, 0x39, 0x1c, 0x3d, 0x03, 0x08, 0xa9, 0x13,
-		0x50, 0x9b, 0x95, 0x21, 0x9f, 0xe5, 0xe4, 0x6b, 0xa5, 0xa8, 0x46, 0xa5, 0xb8, 0x46, 0x25, 0x2b,
-		0xae, 0x11, 0x5e, 0xe0, 0x68, 0x76, 0xcd, 0xa8, 0x8d, 0x96, 0x1f, 0x92, 0x88, 0x3a, 0x93, 0x4e,
-		0xe5, 0x68, 0x4e, 0xb5, 0x60, 0x31, 0xa2, 0x86, 0xd4, 0xa1, 0xdd, 0x30, 0x3f, 0xbb, 0x29, 0x6d,
-		0x2d, 0x3f, 0xda, 0x99, 0x6e, 0xf5, 0x2a, 0x63, 0xd6, 0x38, 0x11, 0xe7, 0x1a, 0xfd, 0x0b, 0x74,
-		0x17, 0x96, 0xcf, 0xdc, 0x90, 0xfa, 0xc1, 0xb9, 0xdd, 0x22, 0xde, 0x29, 0x3d, 0xcb, 0xcf, 0x6d,
-		0x4a, 0x5b, 0x59, 0xbc, 0x24, 0xa2, 0x65, 0x1e, 0x44, 0x3f, 0x83, 0xf5, 0x8e, 0x13, 0x10, 0x8f,
-		0xf6, 0xcb, 0x6f, 0xbb, 0xde, 0x89, 0x9f, 0xbf, 0xc4, 0x97, 0xb0, 0x35, 0x32, 0x8b, 0x2a, 0x67,
-		0x0c, 0xec, 0x24, 0x5e, 0xeb, 0x0c, 0x07, 0x91, 0x02, 0xcb, 0x7d, 0x59, 0x5e, 0x99, 0xf9, 0xd4,
-		0xca, 0x2c, 0xf5, 0x18, 0xbc, 0x3a, 0x0f, 0x61, 0xa6, 0x4d, 0xda, 0x7e, 0x7e, 0x81, 0x13, 0xaf,
-		0x8e, 0xcc, 0xe7, 0x80, 0xb4, 0x7d, 0xcc, 0x61, 0x08, 0xc3, 0x6a, 0x48, 0x9c, 0xa0, 0x71, 0x66,
-		0x3b, 0x94, 0x06, 0xee, 0x71, 0x97, 0x92, 0x30, 0x0f, 0x9c, 0x7b, 0x77, 0x24, 0xb7, 0xc6, 0xd1,
-		0x4a, 0x0f, 0x8c, 0xe5, 0xf0, 0x42, 0x04, 0x95, 0x61, 0xd5, 0xe9, 0x52, 0xdf, 0x0e, 0x48, 0x48,
-		0xa8, 0xdd, 0xf1, 0x5d, 0x8f, 0x86, 0xf9, 0x1c, 0xd7, 0xdc, 0x1c, 0xa9, 0x89, 0x19, 0xb0, 0xca,
-		0x71, 0x78, 0x85, 0x51, 0x13, 0x01, 0x74, 0x1d, 0x16, 0xd8, 0x78, 0xd8, 0x6c, 0x3e, 0xf2, 0x8b,
-		0x9b, 0xd2, 0xd6, 0x02, 0x9e, 0x67, 0x81, 0xb2, 0x1b, 0x52, 0xb4, 0x01, 0x97, 0xdc, 0xd0, 0x6e,
-		0x04, 0xbe, 0x97, 0x5f, 0xda, 0x94, 0xb6, 0xe6, 0xf1, 0x9c, 0x1b, 0xaa, 0x81, 0xef, 0x15, 0x7e,
-		0x9d, 0x81, 0x5b, 0xc3, 0x9b, 0xef, 0x7b, 0x27, 0xee, 0xa9, 0x18, 0x69, 0xf4, 0x6d, 0x52, 0x38,
-		0x1a, 0xa1, 0x9b, 0x23, 0xd3, 0xb3, 0xc4, 0xd3, 0x12, 0xcf, 0x75, 0x60, 0xb3, 0xbf, 0x51, 0x62,
-		0x06, 0x7c, 0xbb, 0xdf, 0xd1, 0x7e, 0x97, 0x8a, 0x61, 0xba, 0x3a, 0xb4, 0x75, 0x9a, 0x48, 0x00,
-		0xdf, 0xe8, 0x49, 0xd4, 0xf8, 0x5c, 0xf8, 0x6a, 0xdc, 0xe3, 0x7e, 0x97, 0xa2, 0x43, 0xb8, 0xce,
-		0xd3, 0x1b, 0xa3, 0x9e, 0x4d, 0x53, 0xdf, 0x60, 0xec, 0x11, 0xc2, 0x85, 0xbf, 0x4b, 0xb0, 0x36,
-		0xa2, 0x23, 0x59, 0xa1, 0x9b, 0x7e, 0xdb, 0x71, 0x3d, 0xdb, 0x6d, 0xf2, 0x7a, 0x2c, 0xe0, 0xf9,
-		0x28, 0x60, 0x34, 0xd1, 0x6d, 0xc8, 0x89, 0x9b, 0x9e, 0xd3, 0x8e, 0x8c, 0x62, 0x01, 0x43, 0x14,
-		0x32, 0x9d, 0x36, 0x19, 0xe3, 0x4c, 0xd9, 0xff, 0xd6, 0x99, 0xee, 0xc0, 0xa2, 0xeb, 0xb9, 0xd4,
-		0x75, 0x28, 0x69, 0xb2, 0xbc, 0x66, 0xf8, 0x50, 0xe6, 0x7a, 0x31, 0xa3, 0x59, 0xf8, 0x95, 0x04,
-		0xeb, 0xfa, 0x27, 0x4a, 0x02, 0xcf, 0x69, 0x7d, 0x2f, 0x6e, 0x79, 0x31, 0xa7, 0xcc, 0x70, 0x4e,
-		0xff, 0x9c, 0x85, 0xb5, 0x2a, 0xf1, 0x9a, 0xae, 0x77, 0xaa, 0x34, 0xa8, 0xfb, 0xc1, 0xa5, 0xe7,
-		0x3c, 0xa3, 0xdb, 0x90, 0x73, 0xc4, 0x75, 0xbf, 0xca, 0x10, 0x87, 0x8c, 0x26, 0xda, 0x83, 0xa5,
-		0x1e, 0x20, 0xd5, 0x92, 0x63, 0x69, 0x6e, 0xc9, 0x8b, 0x4e, 0xe2, 0x0a, 0xbd, 0x84, 0x59, 0x66,
-		0x8f, 0x91, 0x2b, 0x2f, 0x3f, 0x7a, 0x30, 0xda, 0x97, 0x06, 0x33, 0x64, 0x4e, 0x48, 0x70, 0xc4,
-		0x43, 0x06, 0xac, 0x9e, 0x11, 0x27, 0xa0, 0xc7, 0xc4, 0xa1, 0x76, 0x93, 0x50, 0xc7, 0x6d, 0x85,
-		0xc2, 0xa7, 0x6f, 0x8c, 0x31, 0xb9, 0xf3, 0x96, 0xef, 0x34, 0xb1, 0xdc, 0xa3, 0x69, 0x11, 0x0b,
-		0xbd, 0x86, 0xb5, 0x96, 0x13, 0x52, 0xbb, 0xaf, 0xc7, 0xad, 0x6d, 0x36, 0xd5, 0xda, 0x56, 0x19,
-		0x6d, 0x3f, 0x66, 0x71, 0x7b, 0xdb, 0x03, 0x1e, 0x8c, 0xa6, 0x82, 0x34, 0x23, 0xa5, 0xb9, 0x54,
-		0xa5, 0x15, 0x46, 0xaa, 0x45, 0x1c, 0xae, 0x93, 0x87, 0x4b, 0x0e, 0xa5, 0xa4, 0xdd, 0xa1, 0xdc,
-		0xb9, 0x67, 0x71, 0x7c, 0x89, 0x1e, 0x80, 0xdc, 0x76, 0x3e, 0xb9, 0xed, 0x6e, 0xdb, 0x16, 0xa1,
-		0x90, 0xbb, 0xf0, 0x2c, 0x5e, 0x11, 0x71, 0x45, 0x84, 0x99, 0x5d, 0x87, 0x8d, 0x33, 0xd2, 0xec,
-		0xb6, 0xe2, 0x4c, 0x16, 0xd2, 0xed, 0xba, 0xc7, 0xe0, 0x79, 0xa8, 0xb0, 0x42, 0x3e, 0x75, 0xdc,
-		0x68, 0x66, 0x23, 0x0d, 0x48, 0xd5, 0x58, 0xee, 0x53, 0xb8, 0xc8, 0x4b, 0x58, 0xe4, 0x45, 0x39,
-		0x71, 0xdc, 0x56, 0x37, 0x20, 0xc2, 0x6b, 0x47, 0x6f, 0xd3, 0x5e, 0x84, 0xc1, 0x39, 0xc6, 0x10,
-		0x17, 0xe8, 0x2b, 0xb8, 0xcc, 0x05, 0x58, 0xaf, 0x93, 0xc0, 0x76, 0x9b, 0xc4, 0xa3, 0x2e, 0x3d,
-		0x17, 0x76, 0x8b, 0xd8, 0xbd, 0x43, 0x7e, 0xcb, 0x10, 0x77, 0x0a, 0xbf, 0xcd, 0xc0, 0x55, 0xd1,
-		0x3e, 0xea, 0x99, 0xdb, 0x6a, 0x7e, 0x2f, 0x83, 0xf7, 0x65, 0x42, 0x96, 0x0d, 0x47, 0xd2, 0x8b,
-		0xe4, 0x8f, 0x89, 0xf3, 0x09, 0x77, 0xa4, 0x8b, 0x63, 0x9a, 0x1d, 0x1a, 0x53, 0xf4, 0x16, 0xc4,
-		0x6b, 0x58, 0x98, 0x6b, 0xc7, 0x6f, 0xb9, 0x8d, 0x73, 0xde, 0xe6, 0xcb, 0x63, 0x12, 0x8d, 0x9c,
-		0x93, 0x1b, 0x6a, 0x95, 0xa3, 0xf1, 0x6a, 0xe7, 0x62, 0xa8, 0xf0, 0xb7, 0x4c, 0x6f, 0xfc, 0x35,
-		0xd2, 0x70, 0xc3, 0xb8, 0x2e, 0xbd, 0xa9, 0x94, 0xd2, 0xa7, 0x32, 0x26, 0x0e, 0x4c, 0xe5, 0x70,
-		0xc7, 0x65, 0x3e, 0xb7, 0xe3, 0x5e, 0xc0, 0xe2, 0xc0, 0xf0, 0xa4, 0x1f, 0xdb, 0x72, 0xe1, 0xe8,
-		0xc1, 0x99, 0x19, 0x1c, 0x1c, 0x0c, 0x1b, 0x7e, 0xe0, 0x9e, 0xba, 0x9e, 0xd3, 0xb2, 0x2f, 0x24,
-		0x99, 0x3e, 0xea, 0xeb, 0x31, 0xb5, 0x96, 0x4c, 0xb6, 0xf0, 0xa7, 0x0c, 0x5c, 0x8d, 0xed, 0xa9,
-		0xec, 0x37, 0x9c, 0x96, 0xe6, 0x86, 0x1d, 0x87, 0x36, 0xce, 0xa6, 0x73, 0xd3, 0xff, 0x7d, 0xb9,
-		0x7e, 0x0e, 0xb7, 0x06, 0x33, 0xb0, 0xfd, 0x13, 0x9b, 0x9e, 0xb9, 0xa1, 0x9d, 0xac, 0xe2, 0x64,
-		0xc1, 0x6b, 0x03, 0x19, 0x55, 0x4e, 0xac, 0x33, 0x37, 0x14, 0x1e, 0x84, 0x6e, 0x02, 0xf0, 0x53,
-		0x02, 0xf5, 0xdf, 0x13, 0x8f, 0xd7, 0x79, 0x11, 0xf3, 0x63, 0x8d, 0xc5, 0x02, 0x85, 0xd7, 0x90,
-		0x4b, 0x9e, 0xa5, 0x9e, 0xc3, 0x9c, 0x38, 0x8e, 0x49, 0x9b, 0xd9, 0xad, 0xdc, 0xa3, 0x2f, 0x52,
-		0x8e, 0x63, 0xfc, 0xa4, 0x2a, 0x28, 0x85, 0x3f, 0x64, 0x60, 0x79, 0xf0, 0x16, 0xba, 0x0f, 0x2b,
-		0xc7, 0xae, 0xe7, 0x04, 0xe7, 0x76, 0xe3, 0x8c, 0x34, 0xde, 0x87, 0xdd, 0xb6, 0xd8, 0x84, 0xe5,
-		0x28, 0xac, 0x8a, 0x28, 0x5a, 0x87, 0xb9, 0xa0, 0xeb, 0xc5, 0x2f, 0xcb, 0x05, 0x3c, 0x1b, 0x74,
-		0xd9, 0xa9, 0xe2, 0x05, 0x5c, 0x3f, 0x71, 0x83, 0x90, 0xbd, 0x60, 0xa2, 0x66, 0xb7, 0x1b, 0x7e,
-		0xbb, 0xd3, 0x22, 0x03, 0x13, 0x9b, 0xe7, 0x90, 0x78, 0x1c, 0xd4, 0x18, 0xc0, 0xe9, 0x8b, 0x8d,
-		0x80, 0x38, 0xbd, 0xbd, 0x49, 0x2f, 0x65, 0x4e, 0xe0, 0x85, 0x6d, 0x2e, 0x71, 0x23, 0x75, 0xbd,
-		0xd3, 0x69, 0xdb, 0x74, 0x31, 0x26, 0x70, 0x81, 0x5b, 0x00, 0xfc, 0x8c, 0x4b, 0x9d, 0xe3, 0x56,
-		0xf4, 0x16, 0x9a, 0xc7, 0x89, 0x48, 0xf1, 0x8f, 0x12, 0x5c, 0x1e, 0xf5, 0x8e, 0x45, 0x05, 0xb8,
-		0x55, 0xd5, 0x4d, 0xcd, 0x30, 0x5f, 0xd9, 0x8a, 0x6a, 0x19, 0x6f, 0x0d, 0xeb, 0xc8, 0xae, 0x59,
-		0x8a, 0xa5, 0xdb, 0x86, 0xf9, 0x56, 0x29, 0x1b, 0x9a, 0xfc, 0x7f, 0xe8, 0xff, 0x61, 0x73, 0x0c,
-		0xa6, 0xa6, 0xee, 0xeb, 0x5a, 0xbd, 0xac, 0x6b, 0xb2, 0x34, 0x41, 0xa9, 0x66, 0x29, 0xd8, 0xd2,
-		0x35, 0x39, 0x83, 0x7e, 0x00, 0xf7, 0xc7, 0x60, 0x54, 0xc5, 0x54, 0xf5, 0xb2, 0x8d, 0xf5, 0x9f,
-		0xd6, 0xf5, 0x1a, 0x03, 0x67, 0x8b, 0xbf, 0xe8, 0xe7, 0x3c, 0xe0, 0x40, 0xc9, 0x27, 0x69, 0xba,
-		0x6a, 0xd4, 0x8c, 0x8a, 0x39, 0x29, 0xe7, 0x0b, 0x98, 0x31, 0x39, 0x5f, 0x44, 0xc5, 0x39, 0x17,
-		0x7f, 0x99, 0xe9, 0x7f, 0x02, 0x1b, 0x4d, 0x4c, 0xba, 0xb1, 0xb7, 0xb2, 0x67, 0x1c, 0x56, 0xf0,
-		0x9b, 0xbd, 0x72, 0xe5, 0xd0, 0x36, 0x34, 0x1b, 0xeb, 0xf5, 0x9a, 0x6e, 0x57, 0x2b, 0x65, 0x43,
-		0x3d, 0x4a, 0x64, 0xf2, 0x43, 0xf8, 0x7a, 0x2c, 0x4a, 0x29, 0xb3, 0xa8, 0x56, 0xaf, 0x96, 0x0d,
-		0x95, 0x3d, 0x75, 0x4f, 0x31, 0xca, 0xba, 0x66, 0x57, 0xcc, 0xf2, 0x91, 0x2c, 0xa1, 0x2f, 0x61,
-		0x6b, 0x5a, 0xa6, 0x9c, 0x41, 0x0f, 0xe1, 0xc1, 0x58, 0x34, 0xd6, 0x5f, 0xeb, 0xaa, 0x95, 0x80,
-		0x67, 0xd1, 0x0e, 0x3c, 0x1c, 0x0b, 0xb7, 0x74, 0x7c, 0x60, 0x98, 0xbc, 0xa0, 0x7b, 0x36, 0xae,
-		0x9b, 0xa6, 0x61, 0xbe, 0x92, 0x67, 0x8a, 0xbf, 0x93, 0x60, 0x75, 0xe8, 0xa5, 0x83, 0x6e, 0xc3,
-		0xf5, 0xaa, 0x82, 0x75, 0xd3, 0xb2, 0xd5, 0x72, 0x65, 0x54, 0x01, 0xc6, 0x00, 0x94, 0x5d, 0xc5,
-		0xd4, 0x2a, 0xa6, 0x2c, 0xa1, 0x7b, 0x50, 0x18, 0x05, 0x10, 0xbd, 0x20, 0x5a, 0x43, 0xce, 0xa0,
-		0x3b, 0x70, 0x73, 0x14, 0xae, 0x97, 0xad, 0x9c, 0x2d, 0xfe, 0x2b, 0x03, 0x37, 0x26, 0x7d, 0x69,
-		0xb3, 0x0e, 0xec, 0x2d, 0x5b, 0x7f, 0xa7, 0xab, 0x75, 0x8b, 0xed, 0x79, 0xa4, 0xc7, 0x76, 0xbe,
-		0x5e, 0x4b, 0x64, 0x9e, 0x2c, 0xe9, 0x18, 0xb0, 0x5a, 0x39, 0xa8, 0x96, 0x75, 0x8b, 0x77, 0x53,
-		0x11, 0xee, 0xa5, 0xc1, 0xa3, 0x0d, 0x96, 0x33, 0x03, 0x7b, 0x3b, 0x4e, 0x9a, 0xaf, 0x9b, 0x8d,
-		0x02, 0x2a, 0x41, 0x31, 0x0d, 0xdd, 0xab, 0x82, 0x26, 0xcf, 0xa0, 0xaf, 0xe1, 0xab, 0xf4, 0xc4,
-		0x4d, 0xcb, 0x30, 0xeb, 0xba, 0x66, 0x2b, 0x35, 0xdb, 0xd4, 0x0f, 0xe5, 0xd9, 0x69, 0x96, 0x6b,
-		0x19, 0x07, 0xac, 0x3f, 0xeb, 0x96, 0x3c, 0x57, 0xfc, 0xb3, 0x04, 0x57, 0x54, 0xdf, 0xa3, 0xae,
-		0xd7, 0x25, 0x4a, 0x68, 0x92, 0x8f, 0x46, 0x74, 0x9e, 0xf1, 0x03, 0x74, 0x17, 0xee, 0xc4, 0xfa,
-		0x42, 0xde, 0x36, 0x4c, 0xc3, 0x32, 0x14, 0xab, 0x82, 0x13, 0xf5, 0x9d, 0x08, 0x63, 0x03, 0xa9,
-		0xe9, 0x38, 0xaa, 0xeb, 0x78, 0x18, 0xd6, 0x2d, 0x7c, 0x24, 0x5a, 0x21, 0x72, 0x98, 0xf1, 0x58,
-		0x15, 0xb3, 0xf9, 0x16, 0xf3, 0x2f, 0x67, 0x8b, 0xbf, 0x97, 0x20, 0x27, 0xbe, 0x45, 0xf9, 0xa7,
-		0x4a, 0x1e, 0x2e, 0xb3, 0x05, 0x56, 0xea, 0x96, 0x6d, 0x1d, 0x55, 0xf5, 0xc1, 0x1e, 0x1e, 0xb8,
-		0xc3, 0xed, 0xc1, 0xb6, 0x2a, 0x51, 0x75, 0x22, 0x27, 0x19, 0x04, 0x88, 0xa7, 0x30, 0x0c, 0x07,
-		0xcb, 0x99, 0x89, 0x98, 0x48, 0x27, 0x8b, 0xae, 0xc1, 0x95, 0x01, 0xcc, 0xbe, 0xae, 0x60, 0x6b,
-		0x57, 0x57, 0x2c, 0x79, 0xa6, 0xf8, 0x1b, 0x09, 0xae, 0xc6, 0x4e, 0x68, 0xb1, 0x17, 0xab, 0xdb,
-		0x26, 0xcd, 0x4a, 0x97, 0xaa, 0x4e, 0x37, 0x24, 0xe8, 0x01, 0xdc, 0xed, 0x79, 0x98, 0xa5, 0xd4,
-		0xde, 0xf4, 0xf7, 0xca, 0x56, 0x15, 0x36, 0xdc, 0xfd, 0xd5, 0xa4, 0x42, 0x45, 0x0a, 0xb2, 0x84,
-		0xee, 0xc3, 0x17, 0x93, 0xa1, 0x58, 0xaf, 0xe9, 0x96, 0x9c, 0x29, 0xfe, 0x23, 0x07, 0x1b, 0xc9,
-		0xe4, 0xd8, 0x81, 0x9e, 0x34, 0xa3, 0xd4, 0xee, 0x41, 0x61, 0x50, 0x44, 0xf8, 0xdc, 0xc5, 0xbc,
-		0x76, 0xe0, 0xe1, 0x04, 0x5c, 0xdd, 0xdc, 0x57, 0x4c, 0x8d, 0x5d, 0xc7, 0x20, 0x59, 0x42, 0x2f,
-		0xe1, 0xf9, 0x04, 0xca, 0xae, 0xa2, 0xf5, 0xab, 0xdc, 0x7b, 0xe3, 0x28, 0x96, 0x85, 0x8d, 0xdd,
-		0xba, 0xa5, 0xd7, 0xe4, 0x0c, 0xd2, 0x41, 0x49, 0x11, 0x18, 0xf4, 0xa1, 0x91, 0x32, 0x59, 0xf4,
-		0x0c, 0x9e, 0xa4, 0xe5, 0x11, 0xb5, 0x8c, 0x71, 0xa0, 0xe3, 0x24, 0x75, 0x06, 0x7d, 0x0b, 0xdf,
-		0xa4, 0x50, 0xc5, 0x93, 0x87, 0xb8, 0xb3, 0xe8, 0x39, 0x3c, 0x4d, 0xcd, 0x5e, 0xad, 0x60, 0xcd,
-		0x3e, 0x50, 0xf0, 0x9b, 0x41, 0xf2, 0x1c, 0x32, 0x40, 0x4f, 0x7b, 0xb0, 0x70, 0x37, 0x7b, 0x84,
-		0x2f, 0x24, 0xa4, 0x2e, 0x4d, 0x51, 0x45, 0x16, 0x48, 0x91, 0x99, 0x47, 0xaf, 0x40, 0x9d, 0xae,
-		0x14, 0x93, 0x85, 0x16, 0xd0, 0x3b, 0xb0, 0x3e, 0x6f, 0x57, 0xf5, 0x77, 0x96, 0x8e, 0x4d, 0x25,
-		0x4d, 0x19, 0xd0, 0x0b, 0x78, 0x96, 0x5a, 0xb4, 0x41, 0xff, 0x49, 0xd0, 0x73, 0xe8, 0x29, 0x3c,
-		0x9e, 0x40, 0x4f, 0xf6, 0x48, 0xff, 0x54, 0x60, 0x68, 0xf2, 0x22, 0x7a, 0x02, 0x3b, 0x13, 0x88,
-		0x7c, 0x0a, 0xed, 0x9a, 0x65, 0xa8, 0x6f, 0x8e, 0xa2, 0xdb, 0x65, 0xa3, 0x66, 0xc9, 0x4b, 0xe8,
-		0x27, 0xf0, 0xa3, 0x09, 0xb4, 0xde, 0x62, 0xd9, 0x1f, 0x3a, 0x4e, 0x8c, 0x18, 0x83, 0xd5, 0xb1,
-		0x2e, 0x2f, 0x4f, 0xb1, 0x27, 0x35, 0xe3, 0x55, 0x7a, 0xe5, 0x56, 0x90, 0x0a, 0x2f, 0xa7, 0x1a,
-		0x11, 0x75, 0xdf, 0x28, 0x6b, 0xa3, 0x45, 0x64, 0xf4, 0x18, 0xb6, 0x27, 0x88, 0xec, 0x55, 0xb0,
-		0xaa, 0x8b, 0x37, 0x56, 0xcf, 0x24, 0x56, 0xd1, 0x37, 0xf0, 0x68, 0x12, 0x49, 0x31, 0xca, 0x95,
-		0xb7, 0x3a, 0xbe, 0xc8, 0x43, 0xec, 0x35, 0x3a, 0xdd, 0xd2, 0x0d, 0xb3, 0x5a, 0xb7, 0xec, 0x9a,
-		0xf1, 0x9d, 0x2e, 0xaf, 0xb1, 0xd7, 0x68, 0xea, 0x4e, 0xc5, 0xb5, 0x92, 0x2f, 0x0f, 0x9b, 0xf1,
-		0xd0, 0x43, 0x76, 0x0d, 0x53, 0xc1, 0x47, 0xf2, 0x7a, 0x4a, 0xef, 0x0d, 0x1b, 0xdd, 0x40, 0x0b,
-		0x5d, 0x99, 0x66, 0x39, 0xba, 0x82, 0xd5, 0xfd, 0x64, 0xc5, 0x37, 0xd8, 0x5b, 0xe7, 0x0e, 0xff,
-		0xc7, 0xca, 0xd0, 0xb9, 0x2a, 0x69, 0xf1, 0x3b, 0xf0, 0x30, 0xda, 0xb7, 0x11, 0x5d, 0x30, 0xc6,
-		0xed, 0x77, 0xe1, 0xc7, 0xd3, 0x51, 0x7a, 0xf7, 0x95, 0x32, 0xd6, 0x15, 0xed, 0xa8, 0x77, 0x24,
-		0x95, 0x8a, 0x7f, 0x95, 0xa0, 0xa8, 0x3a, 0x5e, 0x83, 0xb4, 0xe2, 0xff, 0xbb, 0x4e, 0xcc, 0xf2,
-		0x39, 0x3c, 0x9d, 0x62, 0xde, 0xc7, 0xe4, 0x7b, 0x08, 0xb5, 0xcf, 0x25, 0xd7, 0xcd, 0x37, 0x66,
-		0xe5, 0xd0, 0x9c, 0x44, 0x10, 0x8b, 0xa8, 0xb9, 0xa7, 0xfc, 0x9f, 0xc6, 0xd3, 0x2d, 0x42, 0xb4,
-		0xdd, 0x7f, 0xb6, 0x88, 0xcf, 0x25, 0x4f, 0xb5, 0x88, 0xdd, 0xb7, 0xb0, 0xd1, 0xf0, 0xdb, 0xa3,
-		0xbe, 0xe2, 0x77, 0xe7, 0x95, 0x8e, 0x5b, 0x65, 0x5f, 0xb0, 0x55, 0xe9, 0xbb, 0xed, 0x53, 0x97,
-		0x9e, 0x75, 0x8f, 0x4b, 0x0d, 0xbf, 0xbd, 0x3d, 0xf0, 0xfb, 0x63, 0xe9, 0x94, 0x78, 0xd1, 0xaf,
-		0x99, 0xe2, 0xa7, 0xc8, 0xe7, 0x4e, 0xc7, 0xfd, 0xb0, 0x73, 0x3c, 0xc7, 0x63, 0x8f, 0xff, 0x1d,
-		0x00, 0x00, 0xff, 0xff, 0x1c, 0xfe, 0xdc, 0x7d, 0x4a, 0x1d, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5d, 0x6f, 0xdb, 0xc8,
+		0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0x0f, 0x7a, 0x1c, 0xaf, 0xe5, 0x7c, 0x3a, 0xda, 0x37,
+		0x89, 0xa3, 0x6e, 0xe4, 0xb5, 0xb3, 0xd9, 0x34, 0x9b, 0x66, 0x53, 0x9a, 0x1c, 0xc7, 0x4c, 0x64,
+		0x4a, 0x1d, 0x52, 0x71, 0xbc, 0x28, 0x4a, 0xd0, 0x12, 0x6d, 0x13, 0x91, 0x48, 0x81, 0x1c, 0x25,
+		0xf1, 0x7d, 0x81, 0x5e, 0xf7, 0xae, 0x68, 0x6f, 0xfa, 0x03, 0x0a, 0x14, 0xfd, 0x01, 0x45, 0x8b,
+		0x5e, 0xf4, 0xa6, 0xe8, 0x5f, 0xe8, 0x45, 0xef, 0xfa, 0x2f, 0x8a, 0x19, 0x92, 0x12, 0xf5, 0x49,
+		0xa5, 0x05, 0xb6, 0x77, 0xd6, 0xe1, 0xf3, 0x3c, 0x3c, 0x73, 0xe6, 0x9c, 0x67, 0x46, 0x32, 0x14,
+		0x3a, 0xa7, 0xb6, 0xbf, 0x53, 0xb7, 0x1a, 0xb6, 0x5b, 0xb7, 0x77, 0xac, 0xb6, 0xb3, 0xf3, 0x7e,
+		0x77, 0xe7, 0x83, 0xe7, 0xbf, 0x3b, 0x6b, 0x7a, 0x1f, 0x4a, 0x6d, 0xdf, 0xa3, 0x1e, 0x5a, 0x63,
+		0x98, 0x52, 0x84, 0x29, 0x59, 0x6d, 0xa7, 0xf4, 0x7e, 0xf7, 0xda, 0xad, 0x73, 0xcf, 0x3b, 0x6f,
+		0xda, 0x3b, 0x1c, 0x72, 0xda, 0x39, 0xdb, 0x69, 0x74, 0x7c, 0x8b, 0x3a, 0x9e, 0x1b, 0x92, 0xae,
+		0xdd, 0x1e, 0x7c, 0x4e, 0x9d, 0x96, 0x1d, 0x50, 0xab, 0xd5, 0x8e, 0x00, 0x5b, 0xa3, 0xde, 0x5c,
+		0xf7, 0x5a, 0xad, 0xae, 0xc4, 0xc8, 0xdc, 0xa8, 0x15, 0xbc, 0x6b, 0x3a, 0x01, 0x0d, 0x31, 0x85,
+		0x3f, 0xcf, 0xc1, 0xfa, 0x71, 0x94, 0x2e, 0xfe, 0x68, 0xd7, 0x3b, 0x2c, 0x05, 0xd5, 0x3d, 0xf3,
+		0x50, 0x0d, 0x50, 0xbc, 0x0e, 0xd3, 0x8e, 0x9f, 0xe4, 0x85, 0x2d, 0x61, 0x3b, 0xb7, 0x77, 0xaf,
+		0x34, 0x62, 0x49, 0xa5, 0x21, 0x1d, 0xb2, 0xfa, 0x61, 0x30, 0x84, 0x1e, 0xc3, 0x0c, 0xbd, 0x6c,
+		0xdb, 0xf9, 0x0c, 0x17, 0xba, 0x33, 0x51, 0xc8, 0xb8, 0x6c, 0xdb, 0x84, 0xc3, 0xd1, 0x53, 0x80,
+		0x80, 0x5a, 0x3e, 0x35, 0x59, 0x19, 0xf2, 0x59, 0x4e, 0xbe, 0x56, 0x0a, 0x6b, 0x54, 0x8a, 0x6b,
+		0x54, 0x32, 0xe2, 0x1a, 0x91, 0x05, 0x8e, 0x66, 0x9f, 0x19, 0xb5, 0xde, 0xf4, 0x02, 0x3b, 0xa4,
+		0xce, 0xa4, 0x53, 0x39, 0x9a, 0x53, 0x0d, 0x58, 0x0c, 0xa9, 0x01, 0xb5, 0x68, 0x27, 0xc8, 0xcf,
+		0x6e, 0x09, 0xdb, 0xcb, 0x7b, 0xbb, 0xd3, 0xad, 0x5e, 0x66, 0x4c, 0x9d, 0x13, 0x49, 0xae, 0xde,
+		0xfb, 0x80, 0xee, 0xc2, 0xf2, 0x85, 0x13, 0x50, 0xcf, 0xbf, 0x34, 0x9b, 0xb6, 0x7b, 0x4e, 0x2f,
+		0xf2, 0x73, 0x5b, 0xc2, 0x76, 0x96, 0x2c, 0x45, 0xd1, 0x32, 0x0f, 0xa2, 0x9f, 0xc2, 0x7a, 0xdb,
+		0xf2, 0x6d, 0x97, 0xf6, 0xca, 0x6f, 0x3a, 0xee, 0x99, 0x97, 0xbf, 0xc2, 0x97, 0xb0, 0x3d, 0x32,
+		0x8b, 0x2a, 0x67, 0xf4, 0xed, 0x24, 0x59, 0x6b, 0x0f, 0x07, 0x91, 0x04, 0xcb, 0x3d, 0x59, 0x5e,
+		0x99, 0xf9, 0xd4, 0xca, 0x2c, 0x75, 0x19, 0xbc, 0x3a, 0x0f, 0x61, 0xa6, 0x65, 0xb7, 0xbc, 0xfc,
+		0x02, 0x27, 0x6e, 0x8e, 0xcc, 0xe7, 0xc8, 0x6e, 0x79, 0x84, 0xc3, 0x10, 0x81, 0xd5, 0xc0, 0xb6,
+		0xfc, 0xfa, 0x85, 0x69, 0x51, 0xea, 0x3b, 0xa7, 0x1d, 0x6a, 0x07, 0x79, 0xe0, 0xdc, 0xbb, 0x23,
+		0xb9, 0x3a, 0x47, 0x4b, 0x5d, 0x30, 0x11, 0x83, 0x81, 0x08, 0x2a, 0xc3, 0xaa, 0xd5, 0xa1, 0x9e,
+		0xe9, 0xdb, 0x81, 0x4d, 0xcd, 0xb6, 0xe7, 0xb8, 0x34, 0xc8, 0xe7, 0xb8, 0xe6, 0xd6, 0x48, 0x4d,
+		0xc2, 0x80, 0x55, 0x8e, 0x23, 0x2b, 0x8c, 0x9a, 0x08, 0xa0, 0xeb, 0xb0, 0xc0, 0xc6, 0xc3, 0x64,
+		0xf3, 0x91, 0x5f, 0xdc, 0x12, 0xb6, 0x17, 0xc8, 0x3c, 0x0b, 0x94, 0x9d, 0x80, 0xa2, 0x0d, 0xb8,
+		0xe2, 0x04, 0x66, 0xdd, 0xf7, 0xdc, 0xfc, 0xd2, 0x96, 0xb0, 0x3d, 0x4f, 0xe6, 0x9c, 0x40, 0xf6,
+		0x3d, 0xb7, 0xf0, 0xab, 0x0c, 0xdc, 0x1a, 0xde, 0x7c, 0xcf, 0x3d, 0x73, 0xce, 0xa3, 0x91, 0x46,
+		0xdf, 0x24, 0x85, 0xc3, 0x11, 0xba, 0x39, 0x32, 0x3d, 0x23, 0x7a, 0x5b, 0xe2, 0xbd, 0x16, 0x6c,
+		0xf5, 0x36, 0x2a, 0x9a, 0x01, 0xcf, 0xec, 0x75, 0xb4, 0xd7, 0xa1, 0xd1, 0x30, 0x6d, 0x0e, 0x6d,
+		0x9d, 0x12, 0x25, 0x40, 0x6e, 0x74, 0x25, 0x74, 0x3e, 0x17, 0x9e, 0x1c, 0xf7, 0xb8, 0xd7, 0xa1,
+		0xe8, 0x18, 0xae, 0xf3, 0xf4, 0xc6, 0xa8, 0x67, 0xd3, 0xd4, 0x37, 0x18, 0x7b, 0x84, 0x70, 0xe1,
+		0xef, 0x02, 0xac, 0x8d, 0xe8, 0x48, 0x56, 0xe8, 0x86, 0xd7, 0xb2, 0x1c, 0xd7, 0x74, 0x1a, 0xbc,
+		0x1e, 0x0b, 0x64, 0x3e, 0x0c, 0xa8, 0x0d, 0x74, 0x1b, 0x72, 0xd1, 0x43, 0xd7, 0x6a, 0x85, 0x46,
+		0xb1, 0x40, 0x20, 0x0c, 0x69, 0x56, 0xcb, 0x1e, 0xe3, 0x4c, 0xd9, 0xff, 0xd6, 0x99, 0xee, 0xc0,
+		0xa2, 0xe3, 0x3a, 0xd4, 0xb1, 0xa8, 0xdd, 0x60, 0x79, 0xcd, 0xf0, 0xa1, 0xcc, 0x75, 0x63, 0x6a,
+		0xa3, 0xf0, 0x4b, 0x01, 0xd6, 0xf1, 0x47, 0x6a, 0xfb, 0xae, 0xd5, 0xfc, 0x5e, 0xdc, 0x72, 0x30,
+		0xa7, 0xcc, 0x70, 0x4e, 0xff, 0x98, 0x85, 0xb5, 0xaa, 0xed, 0x36, 0x1c, 0xf7, 0x5c, 0xaa, 0x53,
+		0xe7, 0xbd, 0x43, 0x2f, 0x79, 0x46, 0xb7, 0x21, 0x67, 0x45, 0x9f, 0x7b, 0x55, 0x86, 0x38, 0xa4,
+		0x36, 0xd0, 0x01, 0x2c, 0x75, 0x01, 0xa9, 0x96, 0x1c, 0x4b, 0x73, 0x4b, 0x5e, 0xb4, 0x12, 0x9f,
+		0xd0, 0x0b, 0x98, 0x65, 0xf6, 0x18, 0xba, 0xf2, 0xf2, 0xde, 0x83, 0xd1, 0xbe, 0xd4, 0x9f, 0x21,
+		0x73, 0x42, 0x9b, 0x84, 0x3c, 0xa4, 0xc2, 0xea, 0x85, 0x6d, 0xf9, 0xf4, 0xd4, 0xb6, 0xa8, 0xd9,
+		0xb0, 0xa9, 0xe5, 0x34, 0x83, 0xc8, 0xa7, 0x6f, 0x8c, 0x31, 0xb9, 0xcb, 0xa6, 0x67, 0x35, 0x88,
+		0xd8, 0xa5, 0x29, 0x21, 0x0b, 0xbd, 0x82, 0xb5, 0xa6, 0x15, 0x50, 0xb3, 0xa7, 0xc7, 0xad, 0x6d,
+		0x36, 0xd5, 0xda, 0x56, 0x19, 0xed, 0x30, 0x66, 0xb1, 0x38, 0x3a, 0x00, 0x1e, 0x0c, 0xa7, 0xc2,
+		0x6e, 0x84, 0x4a, 0x73, 0xa9, 0x4a, 0x2b, 0x8c, 0xa4, 0x87, 0x1c, 0xae, 0x93, 0x87, 0x2b, 0x16,
+		0xa5, 0x76, 0xab, 0x4d, 0xb9, 0x73, 0xcf, 0x92, 0xf8, 0x23, 0x7a, 0x00, 0x62, 0xcb, 0xfa, 0xe8,
+		0xb4, 0x3a, 0x2d, 0x33, 0x0a, 0x05, 0xdc, 0x85, 0x67, 0xc9, 0x4a, 0x14, 0x97, 0xa2, 0x30, 0xb3,
+		0xeb, 0xa0, 0x7e, 0x61, 0x37, 0x3a, 0xcd, 0x38, 0x93, 0x85, 0x74, 0xbb, 0xee, 0x32, 0x78, 0x1e,
+		0x32, 0xac, 0xd8, 0x1f, 0xdb, 0x4e, 0x38, 0xb3, 0xa1, 0x06, 0xa4, 0x6a, 0x2c, 0xf7, 0x28, 0x5c,
+		0xe4, 0x05, 0x2c, 0xf2, 0xa2, 0x9c, 0x59, 0x4e, 0xb3, 0xe3, 0xdb, 0xf9, 0xdc, 0x84, 0x6d, 0x3a,
+		0x08, 0x31, 0x24, 0xc7, 0x18, 0xd1, 0x07, 0xf4, 0x25, 0x5c, 0xe5, 0x02, 0xac, 0xd7, 0x6d, 0xdf,
+		0x74, 0x1a, 0xb6, 0x4b, 0x1d, 0x7a, 0x19, 0xd9, 0x2d, 0x62, 0xcf, 0x8e, 0xf9, 0x23, 0x35, 0x7a,
+		0x52, 0xf8, 0x4d, 0x06, 0x36, 0xa3, 0xf6, 0x91, 0x2f, 0x9c, 0x66, 0xe3, 0x7b, 0x19, 0xbc, 0x2f,
+		0x12, 0xb2, 0x6c, 0x38, 0x92, 0x5e, 0x24, 0x7e, 0x48, 0xdc, 0x4f, 0xb8, 0x23, 0x0d, 0x8e, 0x69,
+		0x76, 0x68, 0x4c, 0xd1, 0x1b, 0x88, 0x8e, 0xe1, 0xc8, 0x5c, 0xdb, 0x5e, 0xd3, 0xa9, 0x5f, 0xf2,
+		0x36, 0x5f, 0x1e, 0x93, 0x68, 0xe8, 0x9c, 0xdc, 0x50, 0xab, 0x1c, 0x4d, 0x56, 0xdb, 0x83, 0xa1,
+		0xc2, 0x5f, 0x33, 0xdd, 0xf1, 0x57, 0xec, 0xba, 0x13, 0xc4, 0x75, 0xe9, 0x4e, 0xa5, 0x90, 0x3e,
+		0x95, 0x31, 0xb1, 0x6f, 0x2a, 0x87, 0x3b, 0x2e, 0xf3, 0xa9, 0x1d, 0xf7, 0x1c, 0x16, 0xfb, 0x86,
+		0x27, 0xfd, 0xda, 0x96, 0x0b, 0x46, 0x0f, 0xce, 0x4c, 0xff, 0xe0, 0x10, 0xd8, 0xf0, 0x7c, 0xe7,
+		0xdc, 0x71, 0xad, 0xa6, 0x39, 0x90, 0x64, 0xfa, 0xa8, 0xaf, 0xc7, 0x54, 0x3d, 0x99, 0x6c, 0xe1,
+		0x8f, 0x19, 0xd8, 0x8c, 0xed, 0xa9, 0xec, 0xd5, 0xad, 0xa6, 0xe2, 0x04, 0x6d, 0x8b, 0xd6, 0x2f,
+		0xa6, 0x73, 0xd3, 0xff, 0x7d, 0xb9, 0x7e, 0x06, 0xb7, 0xfa, 0x33, 0x30, 0xbd, 0x33, 0x93, 0x5e,
+		0x38, 0x81, 0x99, 0xac, 0xe2, 0x64, 0xc1, 0x6b, 0x7d, 0x19, 0x55, 0xce, 0x8c, 0x0b, 0x27, 0x88,
+		0x3c, 0x08, 0xdd, 0x04, 0xe0, 0xb7, 0x04, 0xea, 0xbd, 0xb3, 0x5d, 0x5e, 0xe7, 0x45, 0xc2, 0xaf,
+		0x35, 0x06, 0x0b, 0x14, 0x5e, 0x41, 0x2e, 0x79, 0x97, 0x7a, 0x06, 0x73, 0xd1, 0x75, 0x4c, 0xd8,
+		0xca, 0x6e, 0xe7, 0xf6, 0x3e, 0x4f, 0xb9, 0x8e, 0xf1, 0x9b, 0x6a, 0x44, 0x29, 0xfc, 0x3e, 0x03,
+		0xcb, 0xfd, 0x8f, 0xd0, 0x7d, 0x58, 0x39, 0x75, 0x5c, 0xcb, 0xbf, 0x34, 0xeb, 0x17, 0x76, 0xfd,
+		0x5d, 0xd0, 0x69, 0x45, 0x9b, 0xb0, 0x1c, 0x86, 0xe5, 0x28, 0x8a, 0xd6, 0x61, 0xce, 0xef, 0xb8,
+		0xf1, 0x61, 0xb9, 0x40, 0x66, 0xfd, 0x0e, 0xbb, 0x55, 0x3c, 0x87, 0xeb, 0x67, 0x8e, 0x1f, 0xb0,
+		0x03, 0x26, 0x6c, 0x76, 0xb3, 0xee, 0xb5, 0xda, 0x4d, 0xbb, 0x6f, 0x62, 0xf3, 0x1c, 0x12, 0x8f,
+		0x83, 0x1c, 0x03, 0x38, 0x7d, 0xb1, 0xee, 0xdb, 0x56, 0x77, 0x6f, 0xd2, 0x4b, 0x99, 0x8b, 0xf0,
+		0x91, 0x6d, 0x2e, 0x71, 0x23, 0x75, 0xdc, 0xf3, 0x69, 0xdb, 0x74, 0x31, 0x26, 0x70, 0x81, 0x5b,
+		0x00, 0xfc, 0x8e, 0x4b, 0xad, 0xd3, 0x66, 0x78, 0x0a, 0xcd, 0x93, 0x44, 0xa4, 0xf8, 0x07, 0x01,
+		0xae, 0x8e, 0x3a, 0x63, 0x51, 0x01, 0x6e, 0x55, 0xb1, 0xa6, 0xa8, 0xda, 0x4b, 0x53, 0x92, 0x0d,
+		0xf5, 0x8d, 0x6a, 0x9c, 0x98, 0xba, 0x21, 0x19, 0xd8, 0x54, 0xb5, 0x37, 0x52, 0x59, 0x55, 0xc4,
+		0xff, 0x43, 0xff, 0x0f, 0x5b, 0x63, 0x30, 0xba, 0x7c, 0x88, 0x95, 0x5a, 0x19, 0x2b, 0xa2, 0x30,
+		0x41, 0x49, 0x37, 0x24, 0x62, 0x60, 0x45, 0xcc, 0xa0, 0x1f, 0xc0, 0xfd, 0x31, 0x18, 0x59, 0xd2,
+		0x64, 0x5c, 0x36, 0x09, 0xfe, 0x49, 0x0d, 0xeb, 0x0c, 0x9c, 0x2d, 0xfe, 0xbc, 0x97, 0x73, 0x9f,
+		0x03, 0x25, 0xdf, 0xa4, 0x60, 0x59, 0xd5, 0xd5, 0x8a, 0x36, 0x29, 0xe7, 0x01, 0xcc, 0x98, 0x9c,
+		0x07, 0x51, 0x71, 0xce, 0xc5, 0x5f, 0x64, 0x7a, 0x5f, 0x81, 0xd5, 0x06, 0xb1, 0x3b, 0xb1, 0xb7,
+		0xb2, 0x77, 0x1c, 0x57, 0xc8, 0xeb, 0x83, 0x72, 0xe5, 0xd8, 0x54, 0x15, 0x93, 0xe0, 0x9a, 0x8e,
+		0xcd, 0x6a, 0xa5, 0xac, 0xca, 0x27, 0x89, 0x4c, 0x7e, 0x08, 0x5f, 0x8d, 0x45, 0x49, 0x65, 0x16,
+		0x55, 0x6a, 0xd5, 0xb2, 0x2a, 0xb3, 0xb7, 0x1e, 0x48, 0x6a, 0x19, 0x2b, 0x66, 0x45, 0x2b, 0x9f,
+		0x88, 0x02, 0xfa, 0x02, 0xb6, 0xa7, 0x65, 0x8a, 0x19, 0xf4, 0x10, 0x1e, 0x8c, 0x45, 0x13, 0xfc,
+		0x0a, 0xcb, 0x46, 0x02, 0x9e, 0x45, 0xbb, 0xf0, 0x70, 0x2c, 0xdc, 0xc0, 0xe4, 0x48, 0xd5, 0x78,
+		0x41, 0x0f, 0x4c, 0x52, 0xd3, 0x34, 0x55, 0x7b, 0x29, 0xce, 0x14, 0xff, 0x26, 0x40, 0xbe, 0x57,
+		0x09, 0xf6, 0x15, 0xa6, 0xe9, 0xd4, 0x69, 0x54, 0x8c, 0xfb, 0xf0, 0x79, 0x52, 0x4f, 0xae, 0x68,
+		0x07, 0x65, 0x55, 0x36, 0x86, 0xeb, 0x31, 0x50, 0xb5, 0x41, 0x20, 0x2b, 0xc1, 0xf0, 0xda, 0x07,
+		0x51, 0x2c, 0x59, 0xfc, 0x56, 0xd5, 0x0d, 0x96, 0x59, 0x06, 0xed, 0x41, 0x69, 0x12, 0xba, 0xb7,
+		0x9e, 0x2e, 0x27, 0x5b, 0xfc, 0xad, 0x00, 0xab, 0x43, 0x47, 0x28, 0xba, 0x0d, 0xd7, 0xab, 0x12,
+		0xc1, 0x9a, 0x61, 0xca, 0xe5, 0xca, 0xa8, 0xed, 0x1c, 0x03, 0x90, 0xf6, 0x25, 0x4d, 0xa9, 0x68,
+		0xa2, 0x80, 0xee, 0x41, 0x61, 0x14, 0x20, 0xea, 0xec, 0xa8, 0xd1, 0xc5, 0x0c, 0xba, 0x03, 0x37,
+		0x47, 0xe1, 0xba, 0xb9, 0x8a, 0xd9, 0xe2, 0xbf, 0x32, 0x70, 0x63, 0xd2, 0xef, 0x06, 0x6c, 0x9e,
+		0xba, 0xeb, 0xc6, 0x6f, 0xb1, 0x5c, 0x33, 0x58, 0x07, 0x87, 0x7a, 0xac, 0x8f, 0x6b, 0x7a, 0x22,
+		0xf3, 0x64, 0x83, 0x8c, 0x01, 0xcb, 0x95, 0xa3, 0x6a, 0x19, 0x1b, 0x7c, 0x36, 0x8a, 0x70, 0x2f,
+		0x0d, 0x1e, 0xb6, 0xab, 0x98, 0xe9, 0xdb, 0xad, 0x71, 0xd2, 0x7c, 0xdd, 0x6c, 0xb0, 0x51, 0x09,
+		0x8a, 0x69, 0xe8, 0x6e, 0x15, 0x14, 0x71, 0x06, 0x7d, 0x05, 0x5f, 0xa6, 0x27, 0xae, 0x19, 0xaa,
+		0x56, 0xc3, 0x8a, 0x29, 0xe9, 0xa6, 0x86, 0x8f, 0xc5, 0xd9, 0x69, 0x96, 0x6b, 0xa8, 0x47, 0x6c,
+		0xda, 0x6a, 0x86, 0x38, 0x57, 0xfc, 0x93, 0x00, 0x9f, 0xc9, 0x9e, 0x4b, 0x1d, 0xb7, 0x63, 0x4b,
+		0x81, 0x66, 0x7f, 0x50, 0xc3, 0xdb, 0x99, 0xe7, 0xa3, 0xbb, 0x70, 0x27, 0xd6, 0x8f, 0xe4, 0x4d,
+		0x55, 0x53, 0x0d, 0x55, 0x32, 0x2a, 0x24, 0x51, 0xdf, 0x89, 0x30, 0x66, 0x2f, 0x0a, 0x26, 0x61,
+		0x5d, 0xc7, 0xc3, 0x08, 0x36, 0xc8, 0x49, 0xd4, 0x0a, 0xa1, 0x5f, 0x8e, 0xc7, 0xca, 0xa4, 0xa2,
+		0x75, 0xdd, 0x4c, 0xcc, 0x16, 0x7f, 0x27, 0x40, 0x2e, 0xfa, 0x66, 0xcd, 0xbf, 0x78, 0xe5, 0xe1,
+		0x2a, 0x5b, 0x60, 0xa5, 0x66, 0x98, 0xc6, 0x49, 0x15, 0xf7, 0xf7, 0x70, 0xdf, 0x13, 0x6e, 0x76,
+		0xa6, 0x51, 0x09, 0xab, 0x13, 0xfa, 0x62, 0x3f, 0x20, 0x7a, 0x0b, 0xc3, 0x70, 0xb0, 0x98, 0x99,
+		0x88, 0x09, 0x75, 0xb2, 0xe8, 0x1a, 0x7c, 0xd6, 0x87, 0x39, 0xc4, 0x12, 0x31, 0xf6, 0xb1, 0x64,
+		0x88, 0x33, 0xc5, 0x5f, 0x0b, 0xb0, 0x19, 0xfb, 0x3a, 0xfb, 0x5d, 0x83, 0xa5, 0xde, 0xa8, 0x74,
+		0xa8, 0x6c, 0x75, 0x02, 0x1b, 0x3d, 0x80, 0xbb, 0x5d, 0x47, 0x36, 0x24, 0xfd, 0x75, 0x6f, 0xaf,
+		0x4c, 0x59, 0xaa, 0xe9, 0xc9, 0xd5, 0xa4, 0x42, 0xa3, 0x14, 0x44, 0x81, 0x99, 0xd4, 0x64, 0x28,
+		0xc1, 0x3a, 0x36, 0xc4, 0x4c, 0xf1, 0x9f, 0x8b, 0xb0, 0x91, 0x4c, 0x8e, 0x7d, 0x3d, 0xb1, 0x1b,
+		0x61, 0x6a, 0xf7, 0xa0, 0xd0, 0x2f, 0x12, 0xb9, 0xf6, 0x60, 0x5e, 0xbb, 0xf0, 0x70, 0x02, 0xae,
+		0xa6, 0x1d, 0x4a, 0x9a, 0xc2, 0x3e, 0xc7, 0x20, 0x51, 0x40, 0x2f, 0xe0, 0xd9, 0x04, 0xca, 0xbe,
+		0xa4, 0xf4, 0xaa, 0xdc, 0x3d, 0x3f, 0x25, 0xc3, 0x20, 0xea, 0x7e, 0xcd, 0xc0, 0xba, 0x98, 0x41,
+		0x18, 0xa4, 0x14, 0x81, 0x7e, 0x1f, 0x1a, 0x29, 0x93, 0x45, 0x4f, 0xe1, 0x71, 0x5a, 0x1e, 0x61,
+		0xcb, 0xa8, 0x47, 0x98, 0x24, 0xa9, 0x33, 0xe8, 0x1b, 0xf8, 0x3a, 0x85, 0x1a, 0xbd, 0x79, 0x88,
+		0x3b, 0x8b, 0x9e, 0xc1, 0x93, 0xd4, 0xec, 0xe5, 0x0a, 0x51, 0xcc, 0x23, 0x89, 0xbc, 0xee, 0x27,
+		0xcf, 0x21, 0x15, 0x70, 0xda, 0x8b, 0x23, 0x77, 0x33, 0x47, 0xf8, 0x42, 0x42, 0xea, 0xca, 0x14,
+		0x55, 0x64, 0x81, 0x14, 0x99, 0x79, 0xf4, 0x12, 0xe4, 0xe9, 0x4a, 0x31, 0x59, 0x68, 0x01, 0xbd,
+		0x05, 0xe3, 0xd3, 0x76, 0x15, 0xbf, 0x35, 0x30, 0xd1, 0xa4, 0x34, 0x65, 0x40, 0xcf, 0xe1, 0x69,
+		0x6a, 0xd1, 0xfa, 0xfd, 0x27, 0x41, 0xcf, 0xa1, 0x27, 0xf0, 0x68, 0x02, 0x3d, 0xd9, 0x23, 0xbd,
+		0x3b, 0x8e, 0xaa, 0x88, 0x8b, 0xe8, 0x31, 0xec, 0x4e, 0x20, 0xf2, 0x29, 0x34, 0x75, 0x43, 0x95,
+		0x5f, 0x9f, 0x84, 0x8f, 0xcb, 0xaa, 0x6e, 0x88, 0x4b, 0xe8, 0xc7, 0xf0, 0xa3, 0x09, 0xb4, 0xee,
+		0x62, 0xd9, 0x1f, 0x98, 0x24, 0x46, 0x8c, 0xc1, 0x6a, 0x04, 0x8b, 0xcb, 0x53, 0xec, 0x89, 0xae,
+		0xbe, 0x4c, 0xaf, 0xdc, 0x0a, 0x92, 0xe1, 0xc5, 0x54, 0x23, 0x22, 0x1f, 0xaa, 0x65, 0x65, 0xb4,
+		0x88, 0x88, 0x1e, 0xc1, 0xce, 0x04, 0x91, 0x83, 0x0a, 0x91, 0x71, 0x74, 0x62, 0x75, 0x4d, 0x62,
+		0x15, 0x7d, 0x0d, 0x7b, 0x93, 0x48, 0x92, 0x5a, 0xae, 0xbc, 0xc1, 0x64, 0x90, 0x87, 0xd8, 0x31,
+		0x3a, 0xdd, 0xd2, 0x55, 0xad, 0x5a, 0x33, 0x4c, 0x5d, 0xfd, 0x0e, 0x8b, 0x6b, 0xec, 0x18, 0x4d,
+		0xdd, 0xa9, 0xb8, 0x56, 0xe2, 0xd5, 0x61, 0x33, 0x1e, 0x7a, 0xc9, 0xbe, 0xaa, 0x49, 0xe4, 0x44,
+		0x5c, 0x4f, 0xe9, 0xbd, 0x61, 0xa3, 0xeb, 0x6b, 0xa1, 0xcf, 0xa6, 0x59, 0x0e, 0x96, 0x88, 0x7c,
+		0x98, 0xac, 0xf8, 0x06, 0x3a, 0x80, 0xfd, 0x4f, 0x76, 0x89, 0x5a, 0x55, 0x61, 0x6f, 0x4d, 0xe8,
+		0xe4, 0xd1, 0x3e, 0x7c, 0x9b, 0xa2, 0x53, 0xab, 0xea, 0x98, 0xf4, 0x6a, 0x63, 0x1e, 0xe1, 0xa3,
+		0x4a, 0x52, 0x63, 0x93, 0x9d, 0x80, 0x77, 0xf8, 0x4f, 0x56, 0x43, 0x77, 0xbc, 0xe4, 0x71, 0xb3,
+		0x0b, 0x0f, 0xc3, 0x1e, 0x1a, 0xd1, 0x91, 0x63, 0x4e, 0x9e, 0x7d, 0xf8, 0x76, 0x3a, 0x4a, 0xf7,
+		0xb9, 0x54, 0x26, 0x58, 0x52, 0x4e, 0xba, 0x97, 0x7d, 0xa1, 0xf8, 0x17, 0x01, 0x8a, 0xb2, 0xe5,
+		0xd6, 0xed, 0x66, 0xfc, 0x8b, 0xf6, 0xc4, 0x2c, 0x9f, 0xc1, 0x93, 0x29, 0xbc, 0x67, 0x4c, 0xbe,
+		0xc7, 0xa0, 0x7f, 0x2a, 0xb9, 0xa6, 0xbd, 0xd6, 0x2a, 0xc7, 0xda, 0x24, 0x42, 0xb4, 0x08, 0xdd,
+		0x39, 0x77, 0xad, 0xa9, 0x17, 0x11, 0x8d, 0xc0, 0x7f, 0xb6, 0x88, 0x4f, 0x25, 0x4f, 0xb5, 0x88,
+		0xfd, 0x37, 0xb0, 0x51, 0xf7, 0x5a, 0xa3, 0x7e, 0x1f, 0xd9, 0x9f, 0x97, 0xda, 0x4e, 0x95, 0xfd,
+		0x36, 0x50, 0x15, 0xbe, 0xdb, 0x39, 0x77, 0xe8, 0x45, 0xe7, 0xb4, 0x54, 0xf7, 0x5a, 0x3b, 0x7d,
+		0xff, 0xd9, 0x2d, 0x9d, 0xdb, 0x6e, 0xf8, 0x7f, 0xe2, 0xe8, 0x9f, 0xbc, 0xcf, 0xac, 0xb6, 0xf3,
+		0x7e, 0xf7, 0x74, 0x8e, 0xc7, 0x1e, 0xfd, 0x7b, 0x00, 0xa1, 0x49, 0xb6, 0x43, 0xa4, 0x1e, 0x00,
+		0x00,
 	},
 	// uber/cadence/api/v1/history.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1c, 0x57,
-		0xd5, 0xef, 0xec, 0xda, 0xbb, 0xde, 0xb3, 0x8e, 0x63, 0xdf, 0x24, 0x8e, 0x9d, 0xbf, 0xce, 0x24,
-		0x4d, 0x5c, 0xc7, 0x59, 0x27, 0x4e, 0x9a, 0x7c, 0x69, 0xbe, 0xb6, 0x38, 0x8e, 0xad, 0xae, 0x64,
-		0x92, 0x68, 0xe2, 0xa4, 0x80, 0x90, 0x96, 0xf1, 0xcc, 0x75, 0x3c, 0xf2, 0xee, 0xcc, 0x76, 0x66,
-		0xd6, 0x1b, 0x23, 0xf1, 0xc4, 0x03, 0x12, 0x6a, 0x05, 0x55, 0x85, 0x44, 0x05, 0x08, 0x84, 0x04,
-		0x6a, 0x11, 0x52, 0x11, 0x08, 0x01, 0xe2, 0x05, 0x90, 0x10, 0x48, 0xa0, 0xc2, 0x13, 0x2f, 0x3c,
-		0xc0, 0x03, 0x0f, 0xf4, 0x8d, 0x07, 0xca, 0x1b, 0x12, 0x9a, 0x3b, 0x77, 0x76, 0x77, 0x66, 0xee,
-		0x9d, 0xb9, 0xb3, 0xde, 0xb4, 0xa0, 0xe6, 0xcd, 0x73, 0xe7, 0xdc, 0x33, 0xbf, 0x7b, 0xee, 0x39,
-		0xe7, 0x9e, 0x7b, 0xce, 0x59, 0xc3, 0xa9, 0xd6, 0x06, 0xb6, 0x17, 0x34, 0x55, 0xc7, 0xa6, 0x86,
-		0x17, 0xd4, 0xa6, 0xb1, 0xb0, 0x73, 0x69, 0x61, 0xcb, 0x70, 0x5c, 0xcb, 0xde, 0xad, 0x34, 0x6d,
-		0xcb, 0xb5, 0xd0, 0x01, 0x8f, 0xa4, 0x42, 0x49, 0x2a, 0x6a, 0xd3, 0xa8, 0xec, 0x5c, 0x3a, 0x72,
-		0xe2, 0xa1, 0x65, 0x3d, 0xac, 0xe3, 0x05, 0x42, 0xb2, 0xd1, 0xda, 0x5c, 0xd0, 0x5b, 0xb6, 0xea,
-		0x1a, 0x96, 0xe9, 0x4f, 0x3a, 0x72, 0x32, 0xfa, 0xde, 0x35, 0x1a, 0xd8, 0x71, 0xd5, 0x46, 0x93,
-		0x12, 0xcc, 0xb0, 0x3e, 0xac, 0x59, 0x8d, 0x46, 0x87, 0x85, 0xcc, 0xa2, 0x70, 0x55, 0x67, 0xbb,
-		0x6e, 0x38, 0x6e, 0x12, 0x4d, 0xdb, 0xb2, 0xb7, 0x37, 0xeb, 0x56, 0xdb, 0xa7, 0x91, 0x6f, 0x41,
-		0xf1, 0x25, 0x7f, 0x41, 0xe8, 0x3a, 0x14, 0xf0, 0x0e, 0x36, 0x5d, 0x67, 0x4a, 0x9a, 0xc9, 0xcf,
-		0x96, 0x17, 0x4f, 0x55, 0x18, 0x6b, 0xab, 0x50, 0xea, 0x15, 0x8f, 0x52, 0xa1, 0x13, 0xe4, 0xf7,
-		0xae, 0xc1, 0x68, 0xef, 0x0b, 0x34, 0x0d, 0x23, 0xe4, 0x55, 0xcd, 0xd0, 0xa7, 0xa4, 0x19, 0x69,
-		0x36, 0xaf, 0x14, 0xc9, 0x73, 0x55, 0x47, 0xd7, 0x01, 0xfc, 0x57, 0xde, 0xa2, 0xa7, 0x72, 0x33,
-		0xd2, 0x6c, 0x79, 0xf1, 0x48, 0xc5, 0x97, 0x48, 0x25, 0x90, 0x48, 0x65, 0x3d, 0x90, 0x88, 0x52,
-		0x22, 0xd4, 0xde, 0x33, 0x9a, 0x82, 0xe2, 0x0e, 0xb6, 0x1d, 0xc3, 0x32, 0xa7, 0xf2, 0x3e, 0x53,
-		0xfa, 0x88, 0x0e, 0x43, 0xd1, 0x5b, 0xbc, 0xf7, 0xb9, 0x21, 0xf2, 0xa6, 0xe0, 0x3d, 0x56, 0x75,
-		0xf4, 0x75, 0x09, 0xce, 0x07, 0x4b, 0xae, 0xe1, 0x47, 0x58, 0x6b, 0x79, 0xfb, 0x50, 0x73, 0x5c,
-		0xd5, 0x76, 0xb1, 0x5e, 0xf3, 0x91, 0xa8, 0xae, 0x6b, 0x1b, 0x1b, 0x2d, 0x17, 0x3b, 0x53, 0xc3,
-		0x04, 0xcf, 0xff, 0x33, 0x97, 0xfe, 0x32, 0xe5, 0xb3, 0x12, 0xb0, 0xb9, 0xe7, 0x73, 0x21, 0x4b,
-		0x5e, 0xea, 0xf0, 0x78, 0xe9, 0x29, 0xe5, 0x5c, 0x5b, 0x8c, 0x14, 0x7d, 0x5b, 0x82, 0x0b, 0x0c,
-		0x78, 0x9a, 0xd5, 0x68, 0xd6, 0x31, 0x13, 0x60, 0x81, 0x00, 0x7c, 0x41, 0x0c, 0xe0, 0x72, 0xc0,
-		0x27, 0x0e, 0xf1, 0x99, 0xb6, 0x28, 0x31, 0x7a, 0x53, 0x82, 0x39, 0x06, 0xc8, 0x4d, 0xd5, 0xa8,
-		0xb3, 0x10, 0x16, 0x09, 0xc2, 0x1b, 0x62, 0x08, 0x57, 0x09, 0x93, 0x38, 0xbc, 0xb3, 0x6d, 0x21,
-		0x4a, 0xf4, 0x2d, 0xb6, 0x00, 0x3d, 0xdd, 0xd2, 0x6b, 0x56, 0xcb, 0x8d, 0xc3, 0x1b, 0x21, 0xf0,
-		0x9e, 0x17, 0x83, 0xe7, 0xa9, 0x9d, 0x7e, 0xa7, 0xe5, 0xc6, 0x01, 0xce, 0xb6, 0x05, 0x69, 0xd1,
-		0x1b, 0x12, 0xcc, 0xea, 0x58, 0x33, 0x1c, 0x02, 0xcc, 0xd3, 0x52, 0x47, 0xdb, 0xc2, 0x7a, 0x8b,
-		0x29, 0xbc, 0x12, 0x41, 0x77, 0x9d, 0x89, 0xee, 0x16, 0x65, 0xb2, 0xae, 0x3a, 0xdb, 0xf7, 0x02,
-		0x16, 0x71, 0x64, 0x67, 0x74, 0x01, 0x3a, 0xf4, 0x9a, 0x04, 0x67, 0x23, 0xa8, 0x78, 0x36, 0x01,
-		0x04, 0xd3, 0xb5, 0x74, 0x4c, 0x3c, 0x73, 0x90, 0xf5, 0x54, 0x2a, 0x86, 0x94, 0x12, 0x8c, 0xa0,
-		0x2c, 0x28, 0xa5, 0x04, 0xfd, 0x0f, 0x49, 0x89, 0xab, 0xfa, 0xaf, 0xc7, 0x50, 0x25, 0x68, 0xd6,
-		0x28, 0x41, 0xf5, 0x7f, 0xa9, 0xa8, 0xf8, 0x4a, 0x75, 0x5a, 0x4f, 0x27, 0x43, 0x5f, 0x94, 0xe0,
-		0xe9, 0x30, 0x26, 0x9e, 0x25, 0xee, 0x23, 0x80, 0xae, 0xa6, 0x02, 0xe2, 0x19, 0xe1, 0x29, 0x3d,
-		0x8d, 0x88, 0x6c, 0x9b, 0xaa, 0xb9, 0xc6, 0x8e, 0xe1, 0xee, 0xa6, 0x2a, 0xf7, 0x58, 0xc2, 0xb6,
-		0x2d, 0x51, 0x26, 0x69, 0xca, 0xad, 0x0a, 0xd0, 0x11, 0xe5, 0x8e, 0xa0, 0xe2, 0x29, 0xf7, 0xfe,
-		0x04, 0xe5, 0x0e, 0x61, 0xe2, 0x2a, 0xb7, 0x9a, 0x4a, 0xc5, 0x90, 0x52, 0x82, 0x72, 0x8f, 0x0b,
-		0x4a, 0x29, 0x49, 0xb9, 0x55, 0x01, 0x3a, 0xa2, 0x48, 0x61, 0x54, 0x3c, 0x45, 0x9a, 0x48, 0x50,
-		0xa4, 0x5e, 0x48, 0x5c, 0x45, 0x52, 0xd3, 0x88, 0x88, 0xa5, 0x85, 0xc1, 0x24, 0x58, 0x1a, 0x4a,
-		0xb0, 0xb4, 0x5e, 0x3c, 0x09, 0x96, 0xa6, 0xa6, 0x93, 0xa1, 0x36, 0x9c, 0xf0, 0x40, 0xd8, 0x7c,
-		0xed, 0x39, 0x40, 0x80, 0x5c, 0x64, 0x02, 0xf1, 0xb8, 0xda, 0x5c, 0xb5, 0x39, 0xea, 0xf2, 0x5f,
-		0xa3, 0x57, 0xe0, 0x98, 0xff, 0xe1, 0x4d, 0xc3, 0x66, 0x7d, 0xf6, 0x20, 0xf9, 0x6c, 0x85, 0xff,
-		0xd9, 0x55, 0x6f, 0x5e, 0xfc, 0xa3, 0xd3, 0x2e, 0xef, 0x25, 0xfa, 0xae, 0x04, 0x0b, 0x11, 0x15,
-		0x55, 0x4d, 0x0d, 0xd7, 0x6b, 0x36, 0x7e, 0xa5, 0x85, 0x1d, 0xe6, 0xea, 0x0f, 0x11, 0x18, 0x2f,
-		0xa6, 0x6b, 0x2a, 0xe1, 0xa4, 0x04, 0x8c, 0xe2, 0xb8, 0xe6, 0x54, 0x61, 0x6a, 0xf4, 0x23, 0x09,
-		0xae, 0x50, 0x4c, 0x01, 0x44, 0x31, 0x25, 0x9e, 0x24, 0x68, 0x97, 0x99, 0x68, 0xe9, 0xd7, 0xfc,
-		0x4f, 0x8b, 0x68, 0x74, 0xc5, 0xce, 0x34, 0x03, 0x7d, 0x59, 0x82, 0x73, 0x2c, 0xf1, 0xb2, 0x80,
-		0x1e, 0x16, 0xd4, 0xee, 0x65, 0xca, 0x21, 0x45, 0xbb, 0x39, 0x64, 0xe8, 0xb3, 0x70, 0xd2, 0x57,
-		0x32, 0x3e, 0x92, 0x29, 0x82, 0xe4, 0x12, 0x5f, 0xcf, 0xf8, 0x10, 0x7c, 0x05, 0xe6, 0x7d, 0xfb,
-		0x0b, 0x12, 0x9c, 0xa1, 0x9b, 0x47, 0x15, 0x9d, 0xb3, 0x69, 0xd3, 0x04, 0xc1, 0xb3, 0x4c, 0x04,
-		0x3e, 0x73, 0x5f, 0xdf, 0x39, 0xdb, 0x34, 0xa3, 0xa5, 0xd0, 0xa0, 0xcf, 0xc1, 0x4c, 0x43, 0xb5,
-		0xb7, 0xb1, 0x5d, 0xb3, 0xb1, 0x66, 0xd9, 0x3a, 0x0b, 0xc4, 0x11, 0x02, 0x62, 0x91, 0x09, 0xe2,
-		0xe3, 0x64, 0xb2, 0x42, 0xe7, 0xc6, 0x11, 0x1c, 0x6f, 0x24, 0x11, 0xa0, 0x6f, 0x4a, 0x30, 0xcf,
-		0xba, 0x9f, 0x18, 0x0f, 0x4d, 0x95, 0x29, 0x90, 0xa3, 0x59, 0xc2, 0xd7, 0x7b, 0x94, 0x8d, 0x48,
-		0xf8, 0xca, 0xa1, 0x45, 0xdf, 0x91, 0xa0, 0xc2, 0x8a, 0xb0, 0xb1, 0xdd, 0x30, 0x4c, 0x95, 0xe9,
-		0x17, 0x8e, 0x25, 0xf8, 0x85, 0x78, 0x88, 0xdd, 0x61, 0xc4, 0xf0, 0x0b, 0x6d, 0x61, 0x6a, 0xf4,
-		0x63, 0x09, 0xae, 0xb0, 0xae, 0x52, 0xa9, 0x5e, 0xec, 0x38, 0x41, 0x7b, 0x4b, 0xf0, 0x46, 0x95,
-		0xe6, 0xca, 0x16, 0xda, 0xd9, 0xa6, 0xf0, 0x34, 0x80, 0x6f, 0x94, 0x27, 0xb2, 0x68, 0x00, 0xdf,
-		0x40, 0x67, 0xdb, 0x82, 0xb4, 0xe8, 0x6f, 0x12, 0xac, 0x44, 0x3c, 0x2e, 0x7e, 0xe4, 0x62, 0xdb,
-		0x54, 0xeb, 0x35, 0x06, 0x72, 0xc3, 0x34, 0x5c, 0x83, 0xad, 0x18, 0x27, 0x09, 0xf4, 0x7b, 0xe9,
-		0x2e, 0x78, 0x85, 0xf2, 0x8f, 0xad, 0xa7, 0x1a, 0x30, 0x8f, 0x2f, 0xe8, 0x05, 0x7b, 0x4f, 0x1c,
-		0xd0, 0x9f, 0x25, 0xb8, 0x99, 0x61, 0x99, 0x3c, 0x8f, 0x35, 0x43, 0xd6, 0x78, 0x77, 0x0f, 0x6b,
-		0xe4, 0x39, 0xb3, 0x1b, 0x76, 0xff, 0xd3, 0xd1, 0xbb, 0x12, 0x3c, 0x9f, 0xb4, 0x9c, 0x74, 0x3b,
-		0x39, 0x45, 0x16, 0xb6, 0xc6, 0x5c, 0x18, 0x17, 0x4c, 0xaa, 0xbd, 0x5c, 0xc3, 0xfd, 0x4d, 0x25,
-		0x71, 0x00, 0x33, 0x75, 0x62, 0xba, 0x86, 0xd9, 0xc2, 0x7a, 0x4d, 0x75, 0x6a, 0x26, 0x6e, 0xc7,
-		0xd7, 0x21, 0x27, 0xc4, 0x01, 0x8c, 0x0c, 0x0a, 0x65, 0xb7, 0xe4, 0xdc, 0xc6, 0x6d, 0x46, 0x1c,
-		0xd0, 0xce, 0x34, 0x03, 0xfd, 0x4a, 0x82, 0xeb, 0x24, 0x9a, 0xac, 0x69, 0x5b, 0x46, 0x5d, 0xcf,
-		0x68, 0x3f, 0xa7, 0x09, 0xf4, 0x97, 0x98, 0xd0, 0x49, 0x28, 0xb9, 0xec, 0x31, 0xcd, 0x62, 0x34,
-		0x97, 0x9d, 0xec, 0xd3, 0xd0, 0xcf, 0x24, 0xb8, 0x9a, 0xb2, 0x08, 0x9e, 0x75, 0x9c, 0x21, 0x2b,
-		0x58, 0xc9, 0xba, 0x02, 0x9e, 0x49, 0x5c, 0x74, 0x32, 0xce, 0x41, 0xdf, 0x97, 0xe0, 0x12, 0x17,
-		0x35, 0x37, 0xce, 0x7f, 0x9a, 0xc0, 0x5e, 0x62, 0x87, 0x21, 0xcc, 0xaf, 0x73, 0x03, 0xff, 0x79,
-		0x2d, 0x03, 0x3d, 0xfa, 0xa1, 0x04, 0x97, 0xb9, 0x70, 0x13, 0x2e, 0x91, 0x67, 0x13, 0x94, 0x9c,
-		0x0d, 0x38, 0xe1, 0x3a, 0x59, 0xd1, 0x32, 0xcd, 0x40, 0x6f, 0x4b, 0x70, 0x31, 0xb3, 0x66, 0x9c,
-		0x23, 0x88, 0x3f, 0x96, 0x01, 0x31, 0x4f, 0x29, 0xce, 0x6b, 0x19, 0xf4, 0xe1, 0x1d, 0x09, 0x16,
-		0xf9, 0x02, 0xe6, 0x1e, 0xc2, 0xb3, 0x04, 0xed, 0xcd, 0x2c, 0xf2, 0xe5, 0x9e, 0xc4, 0x17, 0xb4,
-		0x2c, 0x13, 0xd0, 0x0f, 0x92, 0x54, 0x22, 0xe1, 0xd2, 0xfc, 0x4c, 0x66, 0xc8, 0xfc, 0xeb, 0x33,
-		0x07, 0x32, 0xef, 0x22, 0xed, 0xc5, 0x66, 0x7c, 0xc8, 0x09, 0x91, 0xe4, 0x5c, 0x42, 0x6c, 0xc6,
-		0xc1, 0x9c, 0x10, 0x4e, 0x2e, 0x68, 0xd9, 0xa6, 0x90, 0x43, 0xd3, 0x0f, 0xc5, 0xfb, 0x8d, 0x78,
-		0xce, 0x27, 0x1c, 0x9a, 0x7e, 0xc4, 0xdd, 0x4f, 0xa8, 0x73, 0xcd, 0xe9, 0x6f, 0x2a, 0xfa, 0xb5,
-		0x04, 0xcf, 0x09, 0x2c, 0x88, 0x67, 0xa3, 0xf3, 0x64, 0x35, 0xd5, 0x7e, 0x56, 0xc3, 0x33, 0xd6,
-		0x2b, 0x4e, 0x1f, 0xf3, 0xd0, 0x4f, 0x25, 0x78, 0x36, 0x69, 0x01, 0xfc, 0xfb, 0xd3, 0x85, 0x84,
-		0x03, 0x88, 0x0b, 0x82, 0x7f, 0x8f, 0xba, 0x88, 0x33, 0xce, 0x21, 0x0e, 0xa7, 0xd5, 0x74, 0xb0,
-		0xed, 0x76, 0x81, 0x3b, 0x58, 0xb5, 0xb5, 0xad, 0x1e, 0x98, 0x71, 0xdc, 0x95, 0x04, 0xeb, 0xbd,
-		0x4f, 0xd8, 0x05, 0x08, 0xee, 0x11, 0x66, 0xdd, 0x2f, 0x32, 0xac, 0xb7, 0x95, 0x65, 0xc2, 0xcd,
-		0x51, 0x80, 0x2e, 0x10, 0xf9, 0xaf, 0x65, 0x38, 0x27, 0x7a, 0x7a, 0xad, 0xc2, 0xbe, 0xce, 0x1a,
-		0xdd, 0xdd, 0x26, 0x26, 0xb5, 0x40, 0x5e, 0x65, 0x31, 0x60, 0xba, 0xbe, 0xdb, 0xc4, 0xca, 0x68,
-		0xbb, 0xe7, 0x09, 0x7d, 0x1a, 0x0e, 0x35, 0x55, 0xdb, 0x93, 0x48, 0xaf, 0xd1, 0x6d, 0x5a, 0xb4,
-		0x7c, 0x38, 0xcb, 0xe4, 0x77, 0x97, 0xcc, 0xe8, 0xb1, 0x89, 0x4d, 0x4b, 0x39, 0xd0, 0x8c, 0x0f,
-		0xa2, 0xe7, 0xa0, 0x44, 0x32, 0x32, 0x75, 0xc3, 0x71, 0x49, 0x61, 0xb1, 0xbc, 0x78, 0x9c, 0x9d,
-		0xf2, 0x50, 0x9d, 0xed, 0x35, 0xc3, 0x71, 0x95, 0x11, 0x97, 0xfe, 0x85, 0x16, 0x61, 0xd8, 0x30,
-		0x9b, 0x2d, 0x97, 0x94, 0x1d, 0xcb, 0x8b, 0xc7, 0x38, 0x48, 0x76, 0xeb, 0x96, 0xaa, 0x2b, 0x3e,
-		0x29, 0x52, 0x61, 0x26, 0x12, 0x72, 0xd4, 0x5c, 0xab, 0xa6, 0xd5, 0x2d, 0x07, 0x13, 0xff, 0x6d,
-		0xb5, 0x5c, 0x5a, 0x87, 0x9c, 0x8e, 0xd5, 0x45, 0x6f, 0xd1, 0x4a, 0xb2, 0x72, 0x0c, 0x87, 0x64,
-		0xbf, 0x6e, 0x2d, 0x7b, 0xf3, 0xd7, 0xfd, 0xe9, 0xe8, 0x65, 0x38, 0xda, 0x4d, 0x7b, 0xc7, 0xb9,
-		0x17, 0xd2, 0xb8, 0x1f, 0x76, 0x83, 0x64, 0x76, 0x84, 0xf1, 0x0d, 0x38, 0xd2, 0x8d, 0xb0, 0xbb,
-		0xab, 0xb0, 0x5b, 0x66, 0xcd, 0xd0, 0x49, 0xe9, 0xaf, 0xa4, 0x1c, 0xee, 0x50, 0x74, 0xe4, 0xac,
-		0xb4, 0xcc, 0xaa, 0x8e, 0xaa, 0x50, 0xa2, 0xae, 0xd2, 0xb2, 0x49, 0x1d, 0x6e, 0x6c, 0xf1, 0x3c,
-		0xdb, 0xb5, 0x53, 0x06, 0x24, 0x84, 0xae, 0x06, 0x53, 0x94, 0xee, 0x6c, 0x54, 0x85, 0x89, 0x2e,
-		0x0e, 0xcf, 0x5d, 0xb5, 0x6c, 0x4c, 0x8b, 0x67, 0xec, 0x3d, 0x58, 0xf5, 0x69, 0x94, 0xf1, 0xce,
-		0x34, 0x3a, 0x82, 0x14, 0x98, 0xac, 0xab, 0xde, 0x9d, 0xcf, 0x0f, 0x67, 0xc8, 0x72, 0xb0, 0xd3,
-		0xaa, 0xbb, 0xb4, 0xf0, 0x95, 0xbc, 0xa7, 0x07, 0xbd, 0xb9, 0xcb, 0x9d, 0xa9, 0x0a, 0x99, 0x89,
-		0xae, 0xc3, 0xb4, 0x65, 0x1b, 0x0f, 0x0d, 0xdf, 0xd1, 0x46, 0xa4, 0x54, 0x26, 0x52, 0x9a, 0x0c,
-		0x08, 0x22, 0x42, 0x3a, 0x02, 0x23, 0x86, 0x8e, 0x4d, 0xd7, 0x70, 0x77, 0x49, 0x45, 0xa9, 0xa4,
-		0x74, 0x9e, 0xd1, 0x65, 0x98, 0xdc, 0x34, 0x6c, 0xc7, 0x8d, 0xf3, 0xdc, 0x47, 0x28, 0x0f, 0x90,
-		0xb7, 0x11, 0x86, 0xcb, 0x30, 0x6a, 0x63, 0xd7, 0xde, 0xad, 0x35, 0xad, 0xba, 0xa1, 0xed, 0xd2,
-		0x2a, 0xcc, 0x0c, 0xe7, 0x82, 0xea, 0xda, 0xbb, 0x77, 0x09, 0x9d, 0x52, 0xb6, 0xbb, 0x0f, 0x68,
-		0x0a, 0x8a, 0xaa, 0xeb, 0xe2, 0x46, 0xd3, 0x25, 0x15, 0x93, 0x61, 0x25, 0x78, 0x44, 0xcb, 0xb0,
-		0x1f, 0x3f, 0x6a, 0x1a, 0xbe, 0xe2, 0xf8, 0x45, 0xfd, 0xf1, 0xd4, 0xa2, 0xfe, 0x58, 0x77, 0x0a,
-		0xa9, 0xec, 0x9f, 0x86, 0x7d, 0x9a, 0xed, 0x59, 0x03, 0xad, 0xe8, 0x90, 0x8a, 0x43, 0x49, 0x19,
-		0xf5, 0x06, 0x83, 0x2a, 0x0f, 0xfa, 0x04, 0x1c, 0xf5, 0x57, 0x1f, 0xae, 0x7e, 0x6d, 0xa8, 0xda,
-		0xb6, 0xb5, 0xb9, 0x49, 0x8b, 0x02, 0x09, 0x4a, 0x3d, 0x45, 0x66, 0xf7, 0x16, 0xbe, 0x6e, 0xfa,
-		0x53, 0xd1, 0x05, 0x18, 0x6a, 0xe0, 0x86, 0x45, 0xd3, 0xf9, 0xd3, 0xec, 0x44, 0x1f, 0x6e, 0x58,
-		0x0a, 0x21, 0x43, 0x0a, 0x4c, 0xc4, 0x3c, 0x36, 0xcd, 0xc9, 0x3f, 0xcd, 0x3e, 0x1b, 0x23, 0x1e,
-		0x56, 0x19, 0x77, 0x22, 0x23, 0xe8, 0x3e, 0x4c, 0x36, 0x6d, 0xbc, 0x53, 0x53, 0x5b, 0xae, 0xe5,
-		0xe9, 0x1f, 0x76, 0x6b, 0x4d, 0xcb, 0x30, 0xdd, 0x20, 0xcb, 0xce, 0xdb, 0x2f, 0x07, 0xbb, 0x77,
-		0x09, 0x9d, 0x72, 0xc0, 0x9b, 0xbf, 0xd4, 0x72, 0xad, 0x9e, 0x41, 0x74, 0x19, 0x0a, 0x5b, 0x58,
-		0xd5, 0xb1, 0x4d, 0xd3, 0xdf, 0x47, 0xd9, 0x4d, 0x1d, 0x84, 0x44, 0xa1, 0xa4, 0xf2, 0xdb, 0x12,
-		0x3c, 0x23, 0x1e, 0xed, 0x5f, 0x81, 0x02, 0xb5, 0x17, 0x49, 0xc0, 0x5e, 0x28, 0x2d, 0x5a, 0x85,
-		0x99, 0xe4, 0x72, 0xaf, 0xa1, 0x13, 0xef, 0x9e, 0x57, 0x8e, 0xf1, 0x2b, 0xb5, 0x55, 0x5d, 0x7e,
-		0x4b, 0x82, 0xb3, 0x82, 0x41, 0xc3, 0x55, 0x28, 0x06, 0x9e, 0x42, 0x12, 0xf0, 0x14, 0x01, 0xf1,
-		0xc0, 0xa0, 0x5a, 0x30, 0x2b, 0x1c, 0x31, 0x2f, 0xc3, 0x28, 0x75, 0xd6, 0xdd, 0x83, 0x73, 0x8c,
-		0xa3, 0x04, 0xd4, 0x37, 0x93, 0x73, 0xb3, 0xec, 0x76, 0x1f, 0xe4, 0xdf, 0x4b, 0x70, 0x46, 0xa4,
-		0x69, 0x20, 0x7c, 0x02, 0x4a, 0xd9, 0x4e, 0xc0, 0xdb, 0x30, 0xc9, 0x39, 0x65, 0x72, 0x69, 0x06,
-		0x79, 0xc0, 0x61, 0x9c, 0x30, 0x3d, 0x9e, 0x26, 0x1f, 0xf2, 0x34, 0xf2, 0x6b, 0x12, 0xc8, 0xe9,
-		0xfd, 0x06, 0x68, 0x1e, 0x50, 0xb4, 0x06, 0xdd, 0xe9, 0x42, 0x1a, 0x77, 0x42, 0x22, 0x88, 0xb8,
-		0xdb, 0x5c, 0xc4, 0xdd, 0x1e, 0x07, 0x08, 0x12, 0x82, 0x86, 0x4e, 0xd0, 0x94, 0x94, 0x12, 0x1d,
-		0xa9, 0xea, 0xf2, 0x3f, 0x22, 0xe2, 0xe5, 0x5a, 0x48, 0x36, 0x44, 0xb3, 0x30, 0x1e, 0xce, 0x43,
-		0x74, 0xd4, 0x6b, 0xcc, 0xe9, 0x59, 0x71, 0x04, 0x7b, 0x3e, 0x82, 0xfd, 0x1c, 0xec, 0xdf, 0x30,
-		0x4c, 0xd5, 0xde, 0xad, 0x69, 0x5b, 0x58, 0xdb, 0x76, 0x5a, 0x0d, 0x12, 0xa2, 0x94, 0x94, 0x31,
-		0x7f, 0x78, 0x99, 0x8e, 0xa2, 0xf3, 0x30, 0x11, 0xce, 0x9e, 0xe1, 0x47, 0x7e, 0xf8, 0x31, 0xaa,
-		0x8c, 0xe3, 0xde, 0xa4, 0x16, 0x7e, 0xe4, 0xca, 0xaf, 0xe6, 0xe1, 0xb4, 0x40, 0x2b, 0xc3, 0x63,
-		0x5b, 0x71, 0xd4, 0x2c, 0xf2, 0x7d, 0x98, 0x05, 0x3a, 0x01, 0xe5, 0x0d, 0xd5, 0xc1, 0xc1, 0xd1,
-		0xe9, 0x8b, 0xa5, 0xe4, 0x0d, 0xf9, 0x07, 0xe6, 0x31, 0x00, 0x13, 0xb7, 0x83, 0xd7, 0xc3, 0xbe,
-		0x60, 0x4d, 0xdc, 0xf6, 0xdf, 0xce, 0x03, 0xda, 0xb4, 0xec, 0x6d, 0x8a, 0x34, 0xe8, 0x47, 0x2b,
-		0xf8, 0x4b, 0xf3, 0xde, 0x10, 0xac, 0x0f, 0x68, 0x63, 0xda, 0xa4, 0xe7, 0x1c, 0x55, 0xc7, 0x32,
-		0x69, 0x6c, 0x44, 0x9f, 0xd0, 0x2d, 0x18, 0xd6, 0xd4, 0x96, 0x83, 0x69, 0x18, 0x54, 0x11, 0x6e,
-		0x1a, 0x59, 0xf6, 0x66, 0x29, 0xfe, 0x64, 0xf9, 0xad, 0x3c, 0x9c, 0x4a, 0x6d, 0xe4, 0x78, 0x6c,
-		0x9b, 0x71, 0x33, 0x58, 0x83, 0xbf, 0x0b, 0xf3, 0x82, 0x7d, 0x26, 0xbd, 0x2b, 0xe8, 0xf5, 0xc9,
-		0x43, 0x59, 0x7c, 0x72, 0xaf, 0xea, 0x0f, 0x47, 0x54, 0x3f, 0xb2, 0xbf, 0x85, 0xe4, 0xfd, 0x2d,
-		0x0a, 0xed, 0xef, 0x08, 0x67, 0x7f, 0x19, 0x66, 0x56, 0x62, 0x99, 0x99, 0xfc, 0x8d, 0x02, 0x9c,
-		0x11, 0xe9, 0x71, 0x41, 0x27, 0xa1, 0xdc, 0x29, 0x14, 0xd3, 0x6d, 0x2a, 0x29, 0x10, 0x0c, 0x55,
-		0x75, 0xef, 0x52, 0xd5, 0xad, 0x24, 0x7b, 0x46, 0x90, 0x4b, 0xb8, 0x54, 0x75, 0x3e, 0x49, 0x2e,
-		0x55, 0x6a, 0xcf, 0x93, 0xa7, 0x9a, 0xba, 0xd5, 0x50, 0x0d, 0x93, 0xfa, 0x0e, 0xfa, 0x14, 0x3e,
-		0x0c, 0x86, 0xfa, 0xbc, 0x0e, 0x15, 0xc4, 0xaf, 0x43, 0xeb, 0x30, 0x1d, 0x28, 0x61, 0xfc, 0x0c,
-		0x29, 0xa6, 0x9d, 0x21, 0x93, 0xc1, 0xdc, 0xc8, 0x31, 0x12, 0xe1, 0x4a, 0x8f, 0x28, 0xca, 0x75,
-		0x24, 0x03, 0x57, 0xff, 0x16, 0x44, 0xb9, 0xf2, 0x0f, 0xbb, 0x52, 0x5f, 0x87, 0xdd, 0x2a, 0x4c,
-		0x6c, 0x61, 0xd5, 0x76, 0x37, 0xb0, 0xda, 0x45, 0x07, 0x69, 0xac, 0xc6, 0x3b, 0x73, 0xba, 0x7c,
-		0xd2, 0x43, 0x94, 0x72, 0x7a, 0x88, 0x12, 0xbb, 0x2b, 0x8c, 0xf6, 0x73, 0x57, 0xe8, 0xc6, 0x9c,
-		0xfb, 0xc4, 0x63, 0xce, 0xbf, 0x4b, 0x20, 0xa7, 0xf7, 0x5b, 0x7d, 0x60, 0x87, 0x7b, 0x6f, 0x18,
-		0x32, 0x14, 0xbe, 0xf0, 0xbc, 0x08, 0xa3, 0xe4, 0xbe, 0x18, 0xf8, 0xad, 0x61, 0x01, 0xbf, 0x55,
-		0xf6, 0x66, 0xd0, 0x07, 0xf9, 0x8f, 0x52, 0xd8, 0x15, 0x0c, 0x38, 0xb2, 0x66, 0x8b, 0x28, 0x97,
-		0xc1, 0xdd, 0xe7, 0x53, 0xa3, 0x8d, 0xa1, 0xb0, 0x30, 0xe5, 0x3f, 0x48, 0x70, 0x2a, 0xbd, 0x09,
-		0xa6, 0xdf, 0x00, 0xfc, 0xc3, 0x58, 0xd1, 0xcf, 0x73, 0x70, 0x5a, 0xa0, 0x95, 0xcc, 0x5b, 0x93,
-		0x8e, 0x5d, 0xd5, 0xa8, 0x3b, 0x42, 0x9b, 0x14, 0x10, 0x3f, 0xb6, 0x35, 0x45, 0x23, 0xa4, 0xa1,
-		0x7e, 0x22, 0xa4, 0x3d, 0xab, 0xf8, 0x57, 0x24, 0x98, 0x13, 0xef, 0x00, 0x13, 0x39, 0xf3, 0x06,
-		0x73, 0x05, 0x7b, 0x47, 0x82, 0x8c, 0xbd, 0x5e, 0xe9, 0xd8, 0x0e, 0x06, 0x61, 0x90, 0xef, 0x61,
-		0x68, 0x60, 0x23, 0x82, 0x38, 0x2f, 0x80, 0xf8, 0xcd, 0x88, 0x1e, 0xf2, 0xaa, 0x42, 0xfd, 0xea,
-		0xe1, 0x2a, 0xcc, 0xd4, 0x55, 0xb7, 0xa7, 0xe7, 0x21, 0xda, 0x01, 0xd0, 0x95, 0xac, 0x4f, 0xc7,
-		0xda, 0x4a, 0x3f, 0x6c, 0x62, 0xe8, 0x73, 0x3e, 0x83, 0x3e, 0x0f, 0xa5, 0xda, 0x68, 0x24, 0xd0,
-		0x93, 0xdf, 0x95, 0xe0, 0x68, 0x42, 0x97, 0x25, 0x9a, 0x86, 0x11, 0xbf, 0xbb, 0xac, 0xb3, 0x6f,
-		0x45, 0xf2, 0x5c, 0xd5, 0xd1, 0x1a, 0x1c, 0xea, 0x1c, 0xe4, 0x9b, 0x86, 0x9d, 0xe1, 0xd2, 0x8a,
-		0xe8, 0x39, 0xbe, 0x6a, 0xd8, 0x38, 0xcb, 0xf1, 0x2b, 0xb2, 0xd9, 0x9f, 0x81, 0x69, 0x6e, 0xfb,
-		0x66, 0xd2, 0x6a, 0x84, 0x63, 0x76, 0xf9, 0x37, 0x12, 0x1c, 0x4b, 0xea, 0xdc, 0x1b, 0xc8, 0x57,
-		0x06, 0x25, 0x8f, 0x44, 0x07, 0xfd, 0x13, 0x09, 0x66, 0xd2, 0x3a, 0x00, 0x93, 0x56, 0xf3, 0x58,
-		0xcd, 0x36, 0x11, 0xf9, 0xbf, 0x8b, 0x90, 0xb1, 0xd1, 0x04, 0x2d, 0xc0, 0x41, 0xd2, 0xcb, 0x12,
-		0x4d, 0xfb, 0xfa, 0x6b, 0x9a, 0x30, 0x71, 0x3b, 0x92, 0xf4, 0x8d, 0x55, 0x5e, 0x72, 0xfd, 0x55,
-		0x5e, 0x9e, 0xd4, 0x46, 0xc4, 0x6b, 0x23, 0x22, 0xba, 0x53, 0x14, 0xd0, 0x9d, 0x3b, 0x30, 0x49,
-		0x73, 0xda, 0x14, 0xa3, 0x61, 0xba, 0xd8, 0xde, 0x51, 0xeb, 0xe9, 0xf7, 0x96, 0x83, 0x74, 0x22,
-		0x81, 0x57, 0xa5, 0xd3, 0xc2, 0x75, 0x97, 0xd2, 0x9e, 0xea, 0x2e, 0x3d, 0x21, 0x1c, 0x64, 0x09,
-		0xe1, 0xf8, 0x45, 0x96, 0x72, 0xdf, 0x45, 0x96, 0xee, 0x3d, 0x63, 0x54, 0xf8, 0x9e, 0xd1, 0x49,
-		0xf5, 0xef, 0xdb, 0x43, 0xaa, 0x7f, 0x6c, 0x4f, 0xa9, 0x7e, 0xcf, 0x07, 0x2f, 0x64, 0xed, 0x76,
-		0xeb, 0x78, 0x2b, 0xa9, 0xd7, 0x5b, 0x25, 0xdd, 0x6f, 0x36, 0xe0, 0x70, 0xa7, 0x42, 0x1e, 0xa9,
-		0x9a, 0xfa, 0x76, 0x3c, 0x97, 0x58, 0x03, 0x0f, 0xd7, 0x4d, 0x0f, 0x61, 0xd6, 0xb0, 0xfc, 0x3d,
-		0x89, 0x91, 0xd2, 0xe6, 0x9d, 0x2c, 0x22, 0xe6, 0x21, 0x09, 0x98, 0x47, 0x4f, 0xa4, 0x93, 0xcb,
-		0x10, 0xe9, 0xc8, 0xef, 0x4b, 0x70, 0x3c, 0xb1, 0x5b, 0xdb, 0x0b, 0xf5, 0x68, 0x2f, 0xb8, 0xa9,
-		0x36, 0x02, 0x51, 0x83, 0x3f, 0x74, 0x5b, 0x6d, 0xe0, 0x7e, 0x3f, 0x3d, 0xb0, 0x53, 0xa5, 0xab,
-		0xf1, 0x43, 0xe2, 0x37, 0xeb, 0xaf, 0xb1, 0x36, 0x89, 0xd7, 0x9d, 0x70, 0x12, 0xca, 0xb4, 0x3f,
-		0xa4, 0x57, 0x04, 0xfe, 0x10, 0x11, 0x41, 0xc7, 0xa9, 0xe7, 0xc4, 0x9d, 0x7a, 0x42, 0x9e, 0x5a,
-		0xfe, 0xaa, 0x04, 0x73, 0x19, 0x3a, 0x72, 0xba, 0xf9, 0x54, 0x29, 0x94, 0x4f, 0xed, 0x77, 0x67,
-		0x92, 0xa0, 0xfd, 0x32, 0x07, 0x2f, 0xec, 0xad, 0x2b, 0x79, 0x60, 0x3a, 0xdf, 0xcd, 0xd5, 0xe5,
-		0x42, 0xb9, 0xba, 0xfb, 0x80, 0xe2, 0xdd, 0x2f, 0xd4, 0xbe, 0xcf, 0x8a, 0x75, 0xb8, 0x2a, 0x13,
-		0xb1, 0x16, 0x56, 0x34, 0x05, 0x45, 0xcd, 0x32, 0x5d, 0xdb, 0xaa, 0x13, 0x45, 0x1b, 0x55, 0x82,
-		0x47, 0x54, 0x81, 0x03, 0x91, 0x46, 0x2e, 0xcb, 0xac, 0xfb, 0x91, 0xf9, 0x88, 0x32, 0x11, 0xea,
-		0xaf, 0xba, 0x63, 0xd6, 0x77, 0xe5, 0x37, 0xf2, 0x70, 0x63, 0x0f, 0x5d, 0xcf, 0xe8, 0x7e, 0xaf,
-		0xdf, 0x1b, 0xe3, 0xfc, 0xa6, 0x40, 0x88, 0x73, 0x28, 0xed, 0x3c, 0xa0, 0xfb, 0x24, 0x37, 0x87,
-		0xca, 0xde, 0x97, 0xa1, 0xbd, 0xee, 0xcb, 0x3c, 0xa0, 0x68, 0xaf, 0x19, 0xad, 0x50, 0xe4, 0x95,
-		0x71, 0x23, 0xa4, 0x84, 0x7e, 0x0a, 0x2b, 0xd8, 0xc5, 0x42, 0x68, 0x17, 0xe5, 0x3f, 0x49, 0x70,
-		0xad, 0xcf, 0x96, 0x6d, 0x0e, 0x06, 0x89, 0x83, 0xe1, 0x83, 0x55, 0x5c, 0xf9, 0x4b, 0x79, 0xb8,
-		0xd6, 0x67, 0x5b, 0xdd, 0xff, 0xaa, 0xad, 0x46, 0x3c, 0xf6, 0x10, 0xdf, 0x63, 0x0f, 0x8b, 0x7b,
-		0x6c, 0xae, 0xea, 0xf0, 0x1c, 0x40, 0x91, 0xe7, 0x00, 0x5e, 0xcd, 0xc3, 0x95, 0x7e, 0x5a, 0x03,
-		0xc5, 0x2c, 0x5f, 0x88, 0xf3, 0x13, 0xcb, 0xef, 0x5a, 0xfe, 0x7b, 0x12, 0x5c, 0xcc, 0xda, 0xe6,
-		0xf8, 0x5f, 0x6d, 0xf2, 0xfc, 0xb3, 0x4a, 0xfe, 0x9d, 0x04, 0x17, 0x32, 0xb5, 0x46, 0x0e, 0xcc,
-		0x05, 0x30, 0x6f, 0x0d, 0xb9, 0xbd, 0xdd, 0x1a, 0xfe, 0x32, 0x02, 0x97, 0xfb, 0xf8, 0x8d, 0x47,
-		0xcf, 0x76, 0x48, 0xa1, 0xed, 0x38, 0x09, 0xe5, 0xce, 0x76, 0x50, 0x9d, 0x2f, 0x29, 0x10, 0x0c,
-		0xb1, 0x52, 0x08, 0xf9, 0x01, 0xa4, 0x10, 0xfa, 0xad, 0x27, 0x0e, 0x0f, 0x36, 0x85, 0x50, 0x78,
-		0xac, 0x29, 0x84, 0x62, 0xdf, 0x29, 0x84, 0x07, 0x40, 0x3b, 0x54, 0x29, 0x47, 0x5a, 0x86, 0xf3,
-		0x9b, 0x04, 0xce, 0x26, 0xb4, 0xb9, 0x12, 0x2e, 0xb4, 0x18, 0x37, 0xd1, 0x8c, 0x0e, 0xf5, 0x1a,
-		0x49, 0x29, 0xec, 0xcf, 0x45, 0x54, 0x1e, 0x04, 0x54, 0x5e, 0x83, 0xa9, 0x1e, 0x75, 0xaa, 0xd9,
-		0xb8, 0xd5, 0x85, 0x5f, 0x26, 0xf0, 0xe7, 0x12, 0x15, 0xa7, 0xaa, 0x2b, 0xde, 0x14, 0xba, 0x84,
-		0x43, 0x6d, 0xd6, 0x70, 0xac, 0x3c, 0xb9, 0xaf, 0x9f, 0xf2, 0x64, 0xac, 0xd7, 0x70, 0x8c, 0xd1,
-		0x6b, 0xd8, 0xbd, 0x69, 0xed, 0xcf, 0x9e, 0x5b, 0x18, 0xdf, 0x43, 0x6e, 0x61, 0x62, 0x6f, 0x6d,
-		0x84, 0xcf, 0x41, 0x59, 0xc7, 0x75, 0x75, 0xd7, 0x57, 0xcd, 0xf4, 0x9e, 0x48, 0x20, 0xd4, 0x44,
-		0x15, 0xe5, 0xd7, 0xf3, 0x70, 0x31, 0xeb, 0x6f, 0xb0, 0x3e, 0x7c, 0xf7, 0xb2, 0x16, 0xc4, 0x09,
-		0x7e, 0xa5, 0xeb, 0x6a, 0xe6, 0x1f, 0x10, 0x85, 0xc2, 0x83, 0x1e, 0x43, 0x19, 0x0e, 0x1b, 0x0a,
-		0xfb, 0x10, 0x2c, 0x70, 0x0e, 0xc1, 0x01, 0xe5, 0x02, 0xe5, 0xdf, 0xe6, 0x60, 0x3e, 0xcb, 0x0f,
-		0xcc, 0xb8, 0xfb, 0xc1, 0x3e, 0x7d, 0x73, 0x7b, 0x3d, 0x7d, 0x07, 0xb5, 0x8b, 0x6c, 0xe9, 0x0e,
-		0x71, 0xa4, 0xdb, 0xb5, 0xce, 0x61, 0xf1, 0x3c, 0xc8, 0xfb, 0x39, 0xc8, 0xf8, 0xd3, 0xb7, 0x8f,
-		0x86, 0x30, 0x59, 0x65, 0x9d, 0x61, 0x66, 0x59, 0xa7, 0xdb, 0x8f, 0x50, 0x10, 0xef, 0x47, 0x90,
-		0xff, 0x99, 0x83, 0xf3, 0x83, 0xf0, 0x28, 0x1f, 0x51, 0xa1, 0xf7, 0x64, 0xdc, 0x0b, 0x19, 0x32,
-		0xee, 0xf2, 0xbf, 0x72, 0x70, 0x21, 0xd3, 0x2f, 0x11, 0x9f, 0x08, 0x3e, 0x26, 0xf8, 0x20, 0xa5,
-		0x58, 0xc8, 0x92, 0x67, 0xfe, 0x7c, 0x9e, 0x27, 0x78, 0x5e, 0x0f, 0xc9, 0x13, 0xc1, 0x27, 0xb6,
-		0xb0, 0x14, 0xfa, 0xe9, 0x7d, 0xff, 0x45, 0x0e, 0x16, 0x32, 0xfe, 0x42, 0xf4, 0xc9, 0x3e, 0x84,
-		0xf6, 0x61, 0xce, 0x85, 0xfd, 0xe4, 0xcf, 0x55, 0xa3, 0xee, 0x62, 0x9b, 0x7c, 0xea, 0x38, 0x4c,
-		0xaf, 0x3c, 0x58, 0xb9, 0xbd, 0x5e, 0x5b, 0xad, 0xae, 0xad, 0xaf, 0x28, 0xb5, 0xf5, 0x4f, 0xde,
-		0x5d, 0xa9, 0x55, 0x6f, 0x3f, 0x58, 0x5a, 0xab, 0xde, 0x1a, 0x7f, 0x0a, 0x9d, 0x84, 0xa3, 0xf1,
-		0xd7, 0x4b, 0x6b, 0x6b, 0x35, 0x32, 0x3a, 0x2e, 0xa1, 0x53, 0x70, 0x3c, 0x4e, 0xb0, 0xbc, 0x76,
-		0xe7, 0xde, 0x0a, 0x25, 0xc9, 0xdd, 0x7c, 0x00, 0x87, 0x35, 0xab, 0xc1, 0x92, 0xc1, 0xcd, 0x91,
-		0xa5, 0xa6, 0x71, 0xd7, 0x0b, 0x61, 0xef, 0x4a, 0x9f, 0x5a, 0x78, 0x68, 0xb8, 0x5b, 0xad, 0x8d,
-		0x8a, 0x66, 0x35, 0x16, 0x42, 0xff, 0xe7, 0xb4, 0xf2, 0x10, 0x9b, 0xfe, 0x7f, 0x56, 0xa5, 0xff,
-		0xf2, 0xf4, 0x86, 0xda, 0x34, 0x76, 0x2e, 0x6d, 0x14, 0xc8, 0xd8, 0xe5, 0xff, 0x04, 0x00, 0x00,
-		0xff, 0xff, 0xee, 0x07, 0x38, 0xbd, 0xd5, 0x55, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x1c, 0xc7,
+		0x91, 0xf6, 0xec, 0x92, 0x4b, 0x6e, 0x2d, 0x49, 0x91, 0x2d, 0x89, 0x22, 0x45, 0x4a, 0x22, 0x47,
+		0xb2, 0x44, 0x53, 0xd4, 0xae, 0x44, 0xc9, 0xd2, 0xc9, 0x3a, 0xcb, 0x47, 0x51, 0x24, 0xb4, 0x00,
+		0x2d, 0x11, 0x23, 0x4a, 0xbe, 0x3b, 0x1c, 0xb0, 0x37, 0x9c, 0x69, 0x8a, 0x03, 0xee, 0xee, 0xac,
+		0x67, 0x66, 0xb9, 0xe2, 0x1d, 0xfc, 0x74, 0x0f, 0x07, 0x1c, 0x6c, 0xdc, 0x19, 0xc6, 0x01, 0xe7,
+		0xfb, 0xc1, 0xfd, 0x00, 0x77, 0xb0, 0x0f, 0x01, 0x1c, 0x24, 0x08, 0x92, 0x20, 0x2f, 0x49, 0x80,
+		0x20, 0x01, 0x12, 0x38, 0x79, 0xca, 0x4b, 0x10, 0xe4, 0x25, 0x0f, 0xc9, 0x5b, 0x02, 0xc4, 0x79,
+		0x0b, 0x10, 0x4c, 0x4f, 0xcf, 0xee, 0xce, 0x4c, 0xf7, 0x4c, 0xcf, 0x72, 0x65, 0x27, 0xb0, 0xde,
+		0x38, 0x3d, 0xd5, 0x35, 0x5f, 0x75, 0x57, 0x55, 0x57, 0x75, 0xd5, 0x12, 0xe6, 0x9b, 0xdb, 0xd8,
+		0x2a, 0x69, 0xaa, 0x8e, 0xeb, 0x1a, 0x2e, 0xa9, 0x0d, 0xa3, 0xb4, 0x7f, 0xa5, 0xb4, 0x6b, 0xd8,
+		0x8e, 0x69, 0x1d, 0x14, 0x1b, 0x96, 0xe9, 0x98, 0xe8, 0xa8, 0x4b, 0x52, 0xa4, 0x24, 0x45, 0xb5,
+		0x61, 0x14, 0xf7, 0xaf, 0x9c, 0x3c, 0xfd, 0xc4, 0x34, 0x9f, 0x54, 0x71, 0x89, 0x90, 0x6c, 0x37,
+		0x77, 0x4a, 0x7a, 0xd3, 0x52, 0x1d, 0xc3, 0xac, 0x7b, 0x93, 0x4e, 0x9e, 0x09, 0xbf, 0x77, 0x8c,
+		0x1a, 0xb6, 0x1d, 0xb5, 0xd6, 0xa0, 0x04, 0x73, 0xac, 0x0f, 0x6b, 0x66, 0xad, 0xd6, 0x66, 0x21,
+		0xb3, 0x28, 0x1c, 0xd5, 0xde, 0xab, 0x1a, 0xb6, 0x13, 0x47, 0xd3, 0x32, 0xad, 0xbd, 0x9d, 0xaa,
+		0xd9, 0xf2, 0x68, 0xe4, 0xbb, 0x30, 0x74, 0xcf, 0x13, 0x08, 0xdd, 0x84, 0x1c, 0xde, 0xc7, 0x75,
+		0xc7, 0x9e, 0x92, 0xe6, 0xb2, 0x0b, 0x85, 0xe5, 0xf9, 0x22, 0x43, 0xb6, 0x22, 0xa5, 0x5e, 0x73,
+		0x29, 0x15, 0x3a, 0x41, 0xfe, 0xef, 0xdb, 0x30, 0xd2, 0xfd, 0x02, 0x4d, 0xc3, 0x30, 0x79, 0x55,
+		0x31, 0xf4, 0x29, 0x69, 0x4e, 0x5a, 0xc8, 0x2a, 0x43, 0xe4, 0xb9, 0xac, 0xa3, 0x9b, 0x00, 0xde,
+		0x2b, 0x57, 0xe8, 0xa9, 0xcc, 0x9c, 0xb4, 0x50, 0x58, 0x3e, 0x59, 0xf4, 0x56, 0xa4, 0xe8, 0xaf,
+		0x48, 0x71, 0xcb, 0x5f, 0x11, 0x25, 0x4f, 0xa8, 0xdd, 0x67, 0x34, 0x05, 0x43, 0xfb, 0xd8, 0xb2,
+		0x0d, 0xb3, 0x3e, 0x95, 0xf5, 0x98, 0xd2, 0x47, 0x74, 0x02, 0x86, 0x5c, 0xe1, 0xdd, 0xcf, 0x0d,
+		0x90, 0x37, 0x39, 0xf7, 0xb1, 0xac, 0xa3, 0x7f, 0x97, 0xe0, 0xa2, 0x2f, 0x72, 0x05, 0x3f, 0xc5,
+		0x5a, 0xd3, 0xdd, 0x87, 0x8a, 0xed, 0xa8, 0x96, 0x83, 0xf5, 0x8a, 0x87, 0x44, 0x75, 0x1c, 0xcb,
+		0xd8, 0x6e, 0x3a, 0xd8, 0x9e, 0x1a, 0x24, 0x78, 0xfe, 0x94, 0x29, 0xfa, 0x1b, 0x94, 0xcf, 0x9a,
+		0xcf, 0xe6, 0xa1, 0xc7, 0x85, 0x88, 0xbc, 0xd2, 0xe6, 0x71, 0xef, 0x05, 0xe5, 0x42, 0x4b, 0x8c,
+		0x14, 0xfd, 0x8f, 0x04, 0x97, 0x18, 0xf0, 0x34, 0xb3, 0xd6, 0xa8, 0x62, 0x26, 0xc0, 0x1c, 0x01,
+		0x78, 0x5b, 0x0c, 0xe0, 0xaa, 0xcf, 0x27, 0x0a, 0xf1, 0xa5, 0x96, 0x28, 0x31, 0x7a, 0x5f, 0x82,
+		0x45, 0x06, 0xc8, 0x1d, 0xd5, 0xa8, 0xb2, 0x10, 0x0e, 0x11, 0x84, 0xb7, 0xc4, 0x10, 0xae, 0x13,
+		0x26, 0x51, 0x78, 0xe7, 0x5b, 0x42, 0x94, 0xe8, 0xbf, 0xd8, 0x0b, 0xe8, 0xea, 0x96, 0x5e, 0x31,
+		0x9b, 0x4e, 0x14, 0xde, 0x30, 0x81, 0xf7, 0xaa, 0x18, 0x3c, 0x57, 0xed, 0xf4, 0x07, 0x4d, 0x27,
+		0x0a, 0x70, 0xa1, 0x25, 0x48, 0x8b, 0xde, 0x93, 0x60, 0x41, 0xc7, 0x9a, 0x61, 0x13, 0x60, 0xae,
+		0x96, 0xda, 0xda, 0x2e, 0xd6, 0x9b, 0xcc, 0xc5, 0xcb, 0x13, 0x74, 0x37, 0x99, 0xe8, 0xee, 0x52,
+		0x26, 0x5b, 0xaa, 0xbd, 0xf7, 0xd0, 0x67, 0x11, 0x45, 0x76, 0x4e, 0x17, 0xa0, 0x43, 0xef, 0x48,
+		0x70, 0x3e, 0x84, 0x8a, 0x67, 0x13, 0x40, 0x30, 0xdd, 0x48, 0xc6, 0xc4, 0x33, 0x07, 0x59, 0x4f,
+		0xa4, 0x62, 0xac, 0x52, 0x8c, 0x11, 0x14, 0x04, 0x57, 0x29, 0x46, 0xff, 0xcf, 0xe9, 0x02, 0x74,
+		0xe8, 0xdd, 0x08, 0xaa, 0x18, 0xcd, 0x1a, 0x21, 0xa8, 0xfe, 0x24, 0x11, 0x15, 0x5f, 0xa9, 0xce,
+		0xea, 0xc9, 0x64, 0xe8, 0x1f, 0x24, 0x78, 0x31, 0x88, 0x89, 0x67, 0x89, 0xa3, 0x04, 0xd0, 0xf5,
+		0x44, 0x40, 0x3c, 0x23, 0x9c, 0xd7, 0x93, 0x88, 0xc8, 0xb6, 0xa9, 0x9a, 0x63, 0xec, 0x1b, 0xce,
+		0x41, 0xa2, 0x72, 0x8f, 0xc5, 0x6c, 0xdb, 0x0a, 0x65, 0x92, 0xa4, 0xdc, 0xaa, 0x00, 0x1d, 0x51,
+		0xee, 0x10, 0x2a, 0x9e, 0x72, 0x1f, 0x89, 0x51, 0xee, 0x00, 0x26, 0xae, 0x72, 0xab, 0x89, 0x54,
+		0x8c, 0x55, 0x8a, 0x51, 0xee, 0x71, 0xc1, 0x55, 0x8a, 0x53, 0x6e, 0x55, 0x80, 0x8e, 0x28, 0x52,
+		0x10, 0x15, 0x4f, 0x91, 0x26, 0x62, 0x14, 0xa9, 0x1b, 0x12, 0x57, 0x91, 0xd4, 0x24, 0x22, 0x62,
+		0x69, 0x41, 0x30, 0x31, 0x96, 0x86, 0x62, 0x2c, 0xad, 0x1b, 0x4f, 0x8c, 0xa5, 0xa9, 0xc9, 0x64,
+		0xa8, 0x05, 0xa7, 0x5d, 0x10, 0x16, 0x5f, 0x7b, 0x8e, 0x12, 0x20, 0x97, 0x99, 0x40, 0x5c, 0xae,
+		0x16, 0x57, 0x6d, 0x66, 0x1c, 0xfe, 0x6b, 0xf4, 0x26, 0xcc, 0x7a, 0x1f, 0xde, 0x31, 0x2c, 0xd6,
+		0x67, 0x8f, 0x91, 0xcf, 0x16, 0xf9, 0x9f, 0x5d, 0x37, 0xac, 0x08, 0xd7, 0x7b, 0x2f, 0x28, 0xd3,
+		0x0e, 0xef, 0x25, 0xfa, 0x3f, 0x09, 0x4a, 0x21, 0x15, 0x55, 0xeb, 0x1a, 0xae, 0x56, 0x2c, 0xfc,
+		0x66, 0x13, 0xdb, 0x4c, 0xe9, 0x8f, 0x13, 0x18, 0xaf, 0x25, 0x6b, 0x2a, 0xe1, 0xa4, 0xf8, 0x8c,
+		0xa2, 0xb8, 0x16, 0x55, 0x61, 0x6a, 0xf4, 0x65, 0x09, 0xae, 0x51, 0x4c, 0x3e, 0x44, 0x31, 0x25,
+		0x9e, 0x24, 0x68, 0x57, 0x99, 0x68, 0xe9, 0xd7, 0xbc, 0x4f, 0x8b, 0x68, 0x74, 0xd1, 0x4a, 0x35,
+		0x03, 0xfd, 0x93, 0x04, 0x17, 0x58, 0xcb, 0xcb, 0x02, 0x7a, 0x42, 0x50, 0xbb, 0x57, 0x29, 0x87,
+		0x04, 0xed, 0xe6, 0x90, 0xa1, 0xbf, 0x81, 0x33, 0x9e, 0x92, 0xf1, 0x91, 0x4c, 0x11, 0x24, 0x57,
+		0xf8, 0x7a, 0xc6, 0x87, 0x30, 0xeb, 0xc4, 0xbc, 0x47, 0x7f, 0x2f, 0xc1, 0x39, 0xba, 0x79, 0x54,
+		0xd1, 0x39, 0x9b, 0x36, 0x4d, 0x10, 0xbc, 0xcc, 0x44, 0xe0, 0x31, 0xf7, 0xf4, 0x9d, 0xb3, 0x4d,
+		0x73, 0x5a, 0x02, 0x0d, 0x7a, 0x0b, 0xe6, 0x6a, 0xaa, 0xb5, 0x87, 0xad, 0x8a, 0x85, 0x35, 0xd3,
+		0xd2, 0x59, 0x20, 0x4e, 0x12, 0x10, 0xcb, 0x4c, 0x10, 0xaf, 0x93, 0xc9, 0x0a, 0x9d, 0x1b, 0x45,
+		0x70, 0xaa, 0x16, 0x47, 0x80, 0xfe, 0x53, 0x82, 0x25, 0x56, 0x7e, 0x62, 0x3c, 0xa9, 0xab, 0xcc,
+		0x05, 0x99, 0x49, 0x13, 0xbe, 0x3e, 0xa4, 0x6c, 0x44, 0xc2, 0x57, 0x0e, 0x2d, 0xfa, 0x5f, 0x09,
+		0x8a, 0x0c, 0x84, 0x0e, 0xb6, 0x6a, 0x46, 0x5d, 0x65, 0xfa, 0x85, 0xd9, 0x18, 0xbf, 0x10, 0x0d,
+		0xb1, 0xdb, 0x8c, 0x18, 0x7e, 0xa1, 0x25, 0x4c, 0x8d, 0xbe, 0x22, 0xc1, 0x35, 0x56, 0x2a, 0x95,
+		0xe8, 0xc5, 0x4e, 0x11, 0xb4, 0x77, 0x05, 0x33, 0xaa, 0x24, 0x57, 0x56, 0x6a, 0xa5, 0x9b, 0xc2,
+		0xd3, 0x00, 0xbe, 0x51, 0x9e, 0x4e, 0xa3, 0x01, 0x7c, 0x03, 0x5d, 0x68, 0x09, 0xd2, 0xa2, 0x9f,
+		0x4b, 0xb0, 0x16, 0xf2, 0xb8, 0xf8, 0xa9, 0x83, 0xad, 0xba, 0x5a, 0xad, 0x30, 0x90, 0x1b, 0x75,
+		0xc3, 0x31, 0xd8, 0x8a, 0x71, 0x86, 0x40, 0x7f, 0x98, 0xec, 0x82, 0xd7, 0x28, 0xff, 0x88, 0x3c,
+		0x65, 0x9f, 0x79, 0x54, 0xa0, 0xdb, 0xd6, 0xa1, 0x38, 0xa0, 0x9f, 0x48, 0x70, 0x27, 0x85, 0x98,
+		0x3c, 0x8f, 0x35, 0x47, 0x64, 0xdc, 0x3c, 0x84, 0x8c, 0x3c, 0x67, 0x76, 0xcb, 0xea, 0x7d, 0x3a,
+		0xfa, 0x58, 0x82, 0x57, 0xe3, 0xc4, 0x49, 0xb6, 0x93, 0x79, 0x22, 0xd8, 0x06, 0x53, 0x30, 0x2e,
+		0x98, 0x44, 0x7b, 0xb9, 0x81, 0x7b, 0x9b, 0x4a, 0xe2, 0x00, 0x96, 0x1c, 0x66, 0xdd, 0x31, 0xea,
+		0x4d, 0xac, 0x57, 0x54, 0xbb, 0x52, 0xc7, 0xad, 0xa8, 0x1c, 0x72, 0x4c, 0x1c, 0x10, 0x05, 0xe1,
+		0xb3, 0x5b, 0xb1, 0xef, 0xe3, 0x56, 0x14, 0x7e, 0xb1, 0x95, 0x6a, 0x06, 0xfa, 0xb6, 0x04, 0x37,
+		0x49, 0x34, 0x59, 0xd1, 0x76, 0x8d, 0xaa, 0x9e, 0xd2, 0x7e, 0xce, 0x12, 0xe8, 0xf7, 0x98, 0xd0,
+		0x49, 0x28, 0xb9, 0xea, 0x32, 0x4d, 0x63, 0x34, 0x57, 0xed, 0xf4, 0xd3, 0xd0, 0xd7, 0x25, 0xb8,
+		0x9e, 0x20, 0x04, 0xcf, 0x3a, 0xce, 0x11, 0x09, 0xd6, 0xd2, 0x4a, 0xc0, 0x33, 0x89, 0xcb, 0x76,
+		0xca, 0x39, 0xe8, 0x0b, 0x12, 0x5c, 0xe1, 0xa2, 0xe6, 0xc6, 0xf9, 0x2f, 0x12, 0xd8, 0x2b, 0xec,
+		0x30, 0x84, 0xf9, 0x75, 0x6e, 0xe0, 0xbf, 0xa4, 0xa5, 0xa0, 0x47, 0x5f, 0x92, 0xe0, 0x2a, 0x17,
+		0x6e, 0x4c, 0x12, 0x79, 0x3e, 0x46, 0xc9, 0xd9, 0x80, 0x63, 0xd2, 0xc9, 0xa2, 0x96, 0x6a, 0x06,
+		0xfa, 0x50, 0x82, 0xcb, 0xa9, 0x35, 0xe3, 0x02, 0x41, 0xfc, 0x67, 0x29, 0x10, 0xf3, 0x94, 0xe2,
+		0xa2, 0x96, 0x42, 0x1f, 0x3e, 0x92, 0x60, 0x99, 0xbf, 0xc0, 0xdc, 0x43, 0x78, 0x81, 0xa0, 0xbd,
+		0x93, 0x66, 0x7d, 0xb9, 0x27, 0xf1, 0x25, 0x2d, 0xcd, 0x04, 0xf4, 0xc5, 0x38, 0x95, 0x88, 0x49,
+		0x9a, 0x5f, 0x4a, 0x0d, 0x99, 0x9f, 0x3e, 0x5f, 0xd2, 0xd2, 0x4c, 0x20, 0xb1, 0x19, 0x1f, 0x72,
+		0x4c, 0x24, 0xb9, 0x18, 0x13, 0x9b, 0x71, 0x30, 0xc7, 0x84, 0x93, 0x25, 0x2d, 0xdd, 0x14, 0x72,
+		0x68, 0x7a, 0xa1, 0x78, 0xaf, 0x11, 0xcf, 0xc5, 0x98, 0x43, 0xd3, 0x8b, 0xb8, 0x7b, 0x09, 0x75,
+		0x6e, 0xd8, 0xbd, 0x4d, 0x45, 0xdf, 0x91, 0xe0, 0x15, 0x01, 0x81, 0x78, 0x36, 0xba, 0x44, 0xa4,
+		0x29, 0xf7, 0x22, 0x0d, 0xcf, 0x58, 0xaf, 0xd9, 0x3d, 0xcc, 0x43, 0x5f, 0x93, 0xe0, 0xe5, 0x38,
+		0x01, 0xf8, 0xf9, 0xd3, 0xa5, 0x98, 0x03, 0x88, 0x0b, 0x82, 0x9f, 0x47, 0x5d, 0xc6, 0x29, 0xe7,
+		0x10, 0x87, 0xd3, 0x6c, 0xd8, 0xd8, 0x72, 0x3a, 0xc0, 0x6d, 0xac, 0x5a, 0xda, 0x6e, 0x17, 0xcc,
+		0x28, 0xee, 0x62, 0x8c, 0xf5, 0x3e, 0x22, 0xec, 0x7c, 0x04, 0x0f, 0x09, 0xb3, 0xce, 0x17, 0x19,
+		0xd6, 0xdb, 0x4c, 0x33, 0x81, 0x57, 0xff, 0x69, 0xa8, 0x4d, 0x9b, 0xb5, 0xc2, 0xa5, 0x34, 0xf5,
+		0x9f, 0x4d, 0xc2, 0x44, 0xa4, 0xfe, 0xc3, 0xa4, 0xe4, 0x65, 0x4f, 0xcd, 0x3a, 0x0f, 0xdd, 0xe5,
+		0x34, 0xd9, 0xd3, 0xa3, 0x7a, 0x83, 0xf5, 0x55, 0x66, 0xf6, 0xc4, 0xa1, 0x25, 0x27, 0x38, 0x0b,
+		0x61, 0x43, 0x57, 0x1d, 0x5c, 0x51, 0x35, 0x0d, 0x37, 0x98, 0x9e, 0xe3, 0x4a, 0x9a, 0x30, 0xf5,
+		0x11, 0xe1, 0xb6, 0x42, 0x99, 0x89, 0x84, 0xa9, 0xb1, 0x33, 0x78, 0xc9, 0x34, 0x05, 0x1d, 0x13,
+		0x77, 0x2c, 0xa7, 0x49, 0xa6, 0x3d, 0x0c, 0x31, 0x81, 0x47, 0xa9, 0x95, 0x6e, 0x0a, 0xb9, 0xd2,
+		0x0e, 0x1b, 0x57, 0x0d, 0xd7, 0xcc, 0x28, 0xd0, 0xab, 0x31, 0x57, 0xda, 0x41, 0x7b, 0x7a, 0x1d,
+		0xd7, 0x4c, 0xc6, 0x95, 0x76, 0x33, 0x89, 0xe8, 0xce, 0x08, 0x40, 0xe7, 0x83, 0xf2, 0x7b, 0x23,
+		0x70, 0x41, 0x34, 0xea, 0x5b, 0x87, 0xd1, 0x36, 0x7c, 0xe7, 0xa0, 0x81, 0x49, 0x0d, 0x9d, 0x57,
+		0x91, 0xf7, 0x99, 0x6e, 0x1d, 0x34, 0xb0, 0x32, 0xd2, 0xea, 0x7a, 0x42, 0x7f, 0x05, 0xc7, 0x1b,
+		0xaa, 0xe5, 0x4a, 0xde, 0x7d, 0x58, 0xed, 0x98, 0xb4, 0xec, 0xbe, 0xc0, 0xe4, 0xb7, 0x49, 0x66,
+		0x74, 0x9d, 0x25, 0x3b, 0xa6, 0x72, 0xb4, 0x11, 0x1d, 0x44, 0xaf, 0x40, 0x9e, 0xdc, 0x64, 0x56,
+		0x0d, 0xdb, 0x21, 0x05, 0xf9, 0xc2, 0xf2, 0x29, 0xf6, 0x55, 0xa1, 0x6a, 0xef, 0x6d, 0x18, 0xb6,
+		0xa3, 0x0c, 0x3b, 0xf4, 0x2f, 0xb4, 0x0c, 0x83, 0x46, 0xbd, 0xd1, 0x74, 0x48, 0xb9, 0xbe, 0xb0,
+		0x3c, 0xcb, 0x41, 0x72, 0x50, 0x35, 0x55, 0x5d, 0xf1, 0x48, 0x91, 0x0a, 0x73, 0xa1, 0x50, 0xbd,
+		0xe2, 0x98, 0x15, 0xad, 0x6a, 0xda, 0x98, 0xc4, 0x3d, 0x66, 0xd3, 0xa1, 0xf5, 0xfb, 0xe9, 0x48,
+		0x3f, 0xc1, 0x5d, 0xda, 0x81, 0xa1, 0xcc, 0xe2, 0xc0, 0xda, 0x6f, 0x99, 0xab, 0xee, 0xfc, 0x2d,
+		0x6f, 0x3a, 0x7a, 0x03, 0x66, 0x3a, 0xe5, 0xa2, 0x28, 0xf7, 0x5c, 0x12, 0xf7, 0x13, 0x8e, 0x5f,
+		0x04, 0x0a, 0x31, 0xbe, 0x05, 0x27, 0x3b, 0x99, 0x69, 0x47, 0x0a, 0xab, 0x59, 0x77, 0x7b, 0x16,
+		0xdc, 0x92, 0x79, 0x5e, 0x39, 0xd1, 0xa6, 0x68, 0xaf, 0xb3, 0xd2, 0xac, 0x97, 0x75, 0x54, 0x86,
+		0x3c, 0x0d, 0x31, 0x4c, 0x8b, 0xd4, 0xaf, 0xc7, 0x96, 0x2f, 0xb2, 0x43, 0x22, 0xca, 0x80, 0xa4,
+		0x9e, 0x65, 0x7f, 0x8a, 0xd2, 0x99, 0x8d, 0xca, 0x30, 0xd1, 0xc1, 0xe1, 0x1e, 0xf3, 0x4d, 0x0b,
+		0x4f, 0xe5, 0x63, 0xf6, 0x60, 0xdd, 0xa3, 0x51, 0xc6, 0xdb, 0xd3, 0xe8, 0x08, 0x52, 0x60, 0xb2,
+		0xaa, 0xba, 0x77, 0x25, 0x9e, 0x31, 0x12, 0x71, 0xb0, 0xdd, 0xac, 0x3a, 0x53, 0x10, 0xc3, 0xcf,
+		0xdf, 0xd3, 0x63, 0xee, 0xdc, 0xd5, 0xf6, 0x54, 0x85, 0xcc, 0x44, 0x37, 0x61, 0xda, 0xb4, 0x8c,
+		0x27, 0x86, 0x17, 0xa0, 0x84, 0x56, 0xa9, 0x40, 0x56, 0x69, 0xd2, 0x27, 0x08, 0x2d, 0xd2, 0x49,
+		0x18, 0x36, 0x74, 0x5c, 0x77, 0x0c, 0xe7, 0x80, 0x54, 0x62, 0xf3, 0x4a, 0xfb, 0x19, 0x5d, 0x85,
+		0xc9, 0x1d, 0xc3, 0xb2, 0x9d, 0x28, 0xcf, 0x51, 0x42, 0x79, 0x94, 0xbc, 0x0d, 0x31, 0x5c, 0x85,
+		0x11, 0x0b, 0x3b, 0xd6, 0x41, 0xa5, 0x61, 0x56, 0x0d, 0xed, 0x80, 0x56, 0x2f, 0xe7, 0x38, 0x17,
+		0x3b, 0x8e, 0x75, 0xb0, 0x49, 0xe8, 0x94, 0x82, 0xd5, 0x79, 0x70, 0x5b, 0x56, 0x54, 0xc7, 0xc1,
+		0xb5, 0x86, 0x43, 0x2a, 0x8d, 0x83, 0x8a, 0xff, 0x88, 0x56, 0xe1, 0x08, 0x7e, 0xda, 0x30, 0x3c,
+		0xc5, 0xf1, 0x9a, 0x61, 0xc6, 0x13, 0x9b, 0x61, 0xc6, 0x3a, 0x53, 0xdc, 0x41, 0x74, 0x16, 0x46,
+		0x35, 0xcb, 0xb5, 0x06, 0x5a, 0x09, 0x25, 0x95, 0xba, 0xbc, 0x32, 0xe2, 0x0e, 0xfa, 0xd5, 0x51,
+		0xf4, 0xe7, 0x30, 0xe3, 0x49, 0x1f, 0xac, 0x1a, 0x6f, 0xab, 0xda, 0x9e, 0xb9, 0xb3, 0x33, 0x85,
+		0x92, 0x94, 0x7a, 0x8a, 0xcc, 0xee, 0x2e, 0x18, 0xdf, 0xf1, 0xa6, 0xa2, 0x4b, 0x30, 0xe0, 0x7a,
+		0x57, 0x5a, 0x06, 0x9b, 0x66, 0x5f, 0x90, 0xe3, 0x9a, 0xa9, 0x10, 0x32, 0xa4, 0xc0, 0x44, 0x24,
+		0xd2, 0xa1, 0xb5, 0xac, 0x17, 0xd9, 0x31, 0x65, 0x28, 0x32, 0x51, 0xc6, 0xed, 0xd0, 0x08, 0x7a,
+		0x04, 0x93, 0x0d, 0x0b, 0xef, 0x57, 0xd4, 0xa6, 0x63, 0xba, 0xfa, 0x87, 0x9d, 0x4a, 0xc3, 0x34,
+		0xea, 0x8e, 0x5f, 0x9d, 0xe2, 0xed, 0x97, 0x8d, 0x9d, 0x4d, 0x42, 0xa7, 0x1c, 0x75, 0xe7, 0xaf,
+		0x34, 0x1d, 0xb3, 0x6b, 0x10, 0x5d, 0x85, 0xdc, 0x2e, 0x56, 0x75, 0x6c, 0xd1, 0xb2, 0xd1, 0x0c,
+		0xbb, 0x19, 0x8a, 0x90, 0x28, 0x94, 0x14, 0x5d, 0x81, 0xe3, 0x16, 0xf6, 0xef, 0x10, 0x76, 0x2c,
+		0xb3, 0xe6, 0x6b, 0xd9, 0x09, 0xb2, 0x2b, 0xa8, 0xfd, 0x72, 0xdd, 0x32, 0x6b, 0x44, 0xc9, 0xe4,
+		0x0f, 0x25, 0x78, 0x49, 0x3c, 0xb1, 0xbe, 0x06, 0x39, 0x6a, 0x62, 0x92, 0x80, 0x89, 0x51, 0x5a,
+		0xb4, 0x0e, 0x73, 0xf1, 0x9d, 0x15, 0x86, 0x4e, 0x0e, 0x84, 0xac, 0x32, 0xcb, 0x6f, 0x8a, 0x28,
+		0xeb, 0xf2, 0x07, 0x12, 0x9c, 0x17, 0x8c, 0xcf, 0xaf, 0xc3, 0x90, 0xef, 0x5c, 0x24, 0x01, 0xe7,
+		0xe2, 0x13, 0xf7, 0x0d, 0xaa, 0x09, 0x0b, 0xc2, 0xc9, 0xe9, 0x2a, 0x8c, 0x50, 0xff, 0xde, 0x39,
+		0x6b, 0xc7, 0x38, 0x7a, 0x43, 0xdd, 0x39, 0x39, 0x6a, 0x0b, 0x4e, 0xe7, 0x41, 0xfe, 0x81, 0x04,
+		0xe7, 0x44, 0xfa, 0x73, 0x82, 0x87, 0xa6, 0x94, 0xee, 0xd0, 0xbc, 0x0f, 0x93, 0x9c, 0x83, 0x29,
+		0x93, 0x64, 0xc3, 0x47, 0x6d, 0xc6, 0xa1, 0xd4, 0xe5, 0x9c, 0xb2, 0x01, 0xe7, 0x24, 0xbf, 0x23,
+		0x81, 0x9c, 0xdc, 0xda, 0x83, 0x96, 0x00, 0x85, 0xdb, 0x3d, 0xda, 0x0d, 0x7f, 0xe3, 0x76, 0x60,
+		0x09, 0x42, 0x1e, 0x3a, 0x13, 0xf2, 0xd0, 0xa7, 0x00, 0xfc, 0xbb, 0x77, 0x43, 0x27, 0x68, 0xf2,
+		0x4a, 0x9e, 0x8e, 0x94, 0x75, 0xf9, 0x57, 0xa1, 0xe5, 0xe5, 0x5a, 0x48, 0x3a, 0x44, 0x0b, 0x30,
+		0x1e, 0xbc, 0xf2, 0x6b, 0xab, 0xd7, 0x98, 0xdd, 0x25, 0x71, 0x08, 0x7b, 0x36, 0x84, 0xfd, 0x02,
+		0x1c, 0xd9, 0x36, 0xea, 0xaa, 0x75, 0x50, 0xd1, 0x76, 0xb1, 0xb6, 0x67, 0x37, 0x6b, 0x24, 0xaa,
+		0xc9, 0x2b, 0x63, 0xde, 0xf0, 0x2a, 0x1d, 0x45, 0x17, 0x61, 0x22, 0x78, 0x51, 0x8d, 0x9f, 0x7a,
+		0x11, 0xcb, 0x88, 0x32, 0x8e, 0xbb, 0xef, 0x8f, 0xf1, 0x53, 0x47, 0x7e, 0x3b, 0x0b, 0x67, 0x05,
+		0xba, 0x86, 0x9e, 0x99, 0xc4, 0x61, 0xb3, 0xc8, 0xf6, 0x60, 0x16, 0xe8, 0x34, 0x14, 0xb6, 0x55,
+		0x1b, 0xfb, 0x7e, 0xd0, 0x5b, 0x96, 0xbc, 0x3b, 0xe4, 0x9d, 0xb1, 0xb3, 0x00, 0xee, 0x1d, 0x3d,
+		0x7d, 0x3d, 0xe8, 0x2d, 0x6c, 0x1d, 0xb7, 0xbc, 0xb7, 0x4b, 0x80, 0x76, 0x4c, 0x6b, 0x8f, 0x22,
+		0xf5, 0x5b, 0x3f, 0x73, 0x9e, 0x68, 0xee, 0x1b, 0x82, 0xf5, 0xb1, 0x37, 0x8e, 0x26, 0x5d, 0xe7,
+		0xa8, 0xda, 0x66, 0x9d, 0x86, 0x53, 0xf4, 0x09, 0xdd, 0x85, 0x41, 0xcd, 0xcd, 0xcc, 0x68, 0xe4,
+		0x54, 0x14, 0xee, 0xcf, 0x5a, 0x75, 0x67, 0x29, 0xde, 0x64, 0xf9, 0x83, 0x2c, 0xcc, 0x27, 0xf6,
+		0x4c, 0x3d, 0xb3, 0xcd, 0xb8, 0xe3, 0xcb, 0xe0, 0xed, 0xc2, 0x92, 0x60, 0x4b, 0x57, 0xb7, 0x04,
+		0xdd, 0x3e, 0x79, 0x20, 0x8d, 0x4f, 0xee, 0x56, 0xfd, 0xc1, 0x90, 0xea, 0x87, 0xf6, 0x37, 0x17,
+		0xbf, 0xbf, 0x43, 0x42, 0xfb, 0x3b, 0xcc, 0xd9, 0x5f, 0x86, 0x99, 0xe5, 0x59, 0x66, 0x26, 0xff,
+		0x47, 0x0e, 0xce, 0x89, 0xb4, 0x93, 0xa1, 0x33, 0x50, 0x68, 0xf7, 0x64, 0xd0, 0x6d, 0xca, 0x2b,
+		0xe0, 0x0f, 0x95, 0x75, 0x37, 0x0f, 0x6b, 0x13, 0x10, 0x23, 0xc8, 0xc4, 0xe4, 0x61, 0xed, 0x4f,
+		0x92, 0x3c, 0x4c, 0xed, 0x7a, 0x72, 0x55, 0x53, 0x37, 0x6b, 0xaa, 0x51, 0xa7, 0xbe, 0x83, 0x3e,
+		0x05, 0x0f, 0x83, 0x81, 0x1e, 0x33, 0xa8, 0x9c, 0x78, 0x06, 0xb5, 0x05, 0xd3, 0xbe, 0x12, 0x46,
+		0xcf, 0x90, 0xa1, 0xa4, 0x33, 0x64, 0xd2, 0x9f, 0x1b, 0x3a, 0x46, 0x42, 0x5c, 0xe9, 0x11, 0x45,
+		0xb9, 0x0e, 0xa7, 0xe0, 0xea, 0x25, 0x4e, 0x94, 0x2b, 0xff, 0xb0, 0xcb, 0xf7, 0x74, 0xd8, 0xad,
+		0xc3, 0xc4, 0x2e, 0x56, 0x2d, 0x67, 0x1b, 0xab, 0x1d, 0x74, 0x90, 0xc4, 0x6a, 0xbc, 0x3d, 0xa7,
+		0xc3, 0x27, 0x39, 0x44, 0x29, 0x24, 0x87, 0x28, 0x91, 0xf4, 0x62, 0xa4, 0x97, 0xf4, 0xa2, 0x13,
+		0xa6, 0x8e, 0x0a, 0x87, 0xa9, 0xf2, 0x2f, 0x24, 0x90, 0x93, 0x5b, 0x1b, 0x3f, 0xb5, 0xc3, 0xbd,
+		0x3b, 0x0c, 0x19, 0x08, 0xe6, 0x48, 0xaf, 0xc1, 0x08, 0x49, 0x31, 0x7d, 0xbf, 0x35, 0x28, 0xe0,
+		0xb7, 0x0a, 0xee, 0x0c, 0xfa, 0x20, 0xff, 0x48, 0x0a, 0xba, 0x82, 0x3e, 0x47, 0xd6, 0xec, 0x25,
+		0xca, 0xa4, 0x70, 0xf7, 0xd9, 0xc4, 0x68, 0x63, 0x20, 0xb8, 0x98, 0xf2, 0x0f, 0x25, 0x98, 0x4f,
+		0xee, 0x37, 0xeb, 0x35, 0x00, 0xff, 0x2c, 0x24, 0xfa, 0x46, 0x06, 0xce, 0x0a, 0x74, 0x6d, 0xba,
+		0x32, 0xe9, 0xd8, 0x51, 0x8d, 0xaa, 0x2d, 0xb4, 0x49, 0x3e, 0xf1, 0x33, 0x93, 0x29, 0x1c, 0x21,
+		0x0d, 0xf4, 0x12, 0x21, 0x1d, 0x5a, 0xc5, 0xff, 0x59, 0x82, 0x45, 0xf1, 0x66, 0x4b, 0x91, 0x33,
+		0xaf, 0x3f, 0x29, 0xd8, 0x47, 0x12, 0xa4, 0x6c, 0xab, 0x4c, 0xc6, 0x76, 0xcc, 0x0f, 0x83, 0x3c,
+		0x0f, 0xe3, 0x3d, 0x08, 0x21, 0xce, 0x0a, 0x20, 0x7e, 0x3f, 0xa4, 0x87, 0xbc, 0x02, 0x6c, 0xaf,
+		0x7a, 0xb8, 0x0e, 0x73, 0x55, 0xd5, 0xe9, 0x6a, 0x2f, 0x0a, 0x37, 0xdb, 0x74, 0x56, 0xd6, 0xa3,
+		0x63, 0x6d, 0xa5, 0x17, 0x36, 0x31, 0xf4, 0x39, 0x9b, 0x42, 0x9f, 0x07, 0x12, 0x6d, 0x34, 0x14,
+		0xe8, 0xc9, 0x1f, 0x4b, 0x30, 0x13, 0xd3, 0xd0, 0xec, 0xfe, 0xe0, 0xcb, 0x6b, 0xe4, 0x6c, 0xef,
+		0xdb, 0x10, 0x79, 0x2e, 0xeb, 0x68, 0x03, 0x8e, 0xb7, 0x0f, 0xf2, 0x1d, 0xc3, 0x4a, 0x91, 0xb4,
+		0x22, 0x7a, 0x8e, 0xbb, 0x0d, 0xcb, 0x69, 0x8e, 0x5f, 0x91, 0xcd, 0xfe, 0x6b, 0x98, 0xe6, 0x76,
+		0x4a, 0xc7, 0x49, 0x23, 0x1c, 0xb3, 0xcb, 0xdf, 0x95, 0x60, 0x36, 0xae, 0x49, 0xb6, 0x2f, 0x5f,
+		0xe9, 0xd7, 0x7a, 0xc4, 0x3a, 0xe8, 0xaf, 0x4a, 0x30, 0x97, 0xd4, 0x6c, 0x1b, 0x27, 0xcd, 0x33,
+		0x35, 0xdb, 0x58, 0xe4, 0xbf, 0x1b, 0x82, 0x94, 0x3d, 0x5d, 0xa8, 0x04, 0xc7, 0x48, 0xdb, 0x58,
+		0xf8, 0xa6, 0xd8, 0x93, 0x69, 0xa2, 0x8e, 0x5b, 0xa1, 0x7b, 0xe2, 0x48, 0xb1, 0x26, 0xd3, 0x5b,
+		0xb1, 0xe6, 0x79, 0x39, 0x45, 0xbc, 0x9c, 0x22, 0xa2, 0x3b, 0x43, 0x02, 0xba, 0xf3, 0x00, 0x26,
+		0xe9, 0x35, 0x38, 0xc5, 0x68, 0xd4, 0x1d, 0x6c, 0xed, 0xab, 0xd5, 0xe4, 0xbc, 0xe5, 0x18, 0x9d,
+		0x48, 0xe0, 0x95, 0xe9, 0xb4, 0x60, 0xa9, 0x26, 0x7f, 0xa8, 0x52, 0x4d, 0x57, 0x08, 0x07, 0x69,
+		0x42, 0x38, 0x7e, 0x5d, 0xa6, 0xd0, 0x73, 0x5d, 0xa6, 0x93, 0x67, 0x8c, 0x88, 0x5f, 0x87, 0xfb,
+		0xd5, 0x81, 0xd1, 0x43, 0x54, 0x07, 0xc6, 0x0e, 0x55, 0x1d, 0x70, 0x7d, 0x70, 0x29, 0x6d, 0x63,
+		0x69, 0xdb, 0x5b, 0x49, 0xdd, 0xde, 0x2a, 0x2e, 0xbf, 0xd9, 0x86, 0x13, 0xed, 0x66, 0x94, 0x50,
+		0xa1, 0xd5, 0xb3, 0xe3, 0xc5, 0xd8, 0x76, 0x93, 0x60, 0xa9, 0xf5, 0x38, 0x66, 0x0d, 0xcb, 0xff,
+		0x2f, 0xc1, 0x02, 0x47, 0x12, 0x56, 0xfd, 0x38, 0xd9, 0x3c, 0x24, 0x01, 0xf3, 0xe8, 0x8a, 0x74,
+		0x32, 0x29, 0x22, 0x1d, 0xf9, 0x6f, 0x19, 0x85, 0x02, 0x76, 0xff, 0x46, 0xe7, 0xd2, 0x4e, 0x0a,
+		0x5c, 0xda, 0x1d, 0xe2, 0xae, 0xf8, 0x2d, 0xc6, 0x42, 0xf1, 0x9a, 0x33, 0x9e, 0xc1, 0xe7, 0x7f,
+		0x2a, 0x41, 0xca, 0xfe, 0x0c, 0x34, 0x03, 0x79, 0xda, 0x59, 0xd1, 0x3e, 0x67, 0x86, 0xbd, 0x81,
+		0xb2, 0xee, 0x06, 0xc5, 0xf4, 0x65, 0x5d, 0xad, 0xf9, 0x47, 0x28, 0x78, 0x43, 0xf7, 0xd5, 0x1a,
+		0xee, 0xf8, 0xfe, 0xac, 0xb8, 0xef, 0x8f, 0x39, 0x33, 0x43, 0xf2, 0x0d, 0x86, 0xe5, 0xfb, 0x35,
+		0xcb, 0xa4, 0x12, 0xda, 0x32, 0xfa, 0xa5, 0x8f, 0x81, 0x85, 0xca, 0x84, 0x16, 0xaa, 0x93, 0xc2,
+		0x67, 0x53, 0xa4, 0xf0, 0x67, 0x61, 0x14, 0x5b, 0x96, 0x69, 0x55, 0x6a, 0xd8, 0xb6, 0xd5, 0x27,
+		0x98, 0x2e, 0xc7, 0x08, 0x19, 0x7c, 0xdd, 0x1b, 0x93, 0x3f, 0x91, 0xe0, 0x54, 0xec, 0x0f, 0x7d,
+		0xdc, 0x5d, 0xa2, 0x3f, 0x23, 0x22, 0xbb, 0x44, 0x53, 0x17, 0x6f, 0x88, 0xec, 0x52, 0x8f, 0xa6,
+		0xd4, 0xb7, 0x28, 0xa9, 0xe3, 0xc1, 0x07, 0xc4, 0x6f, 0x8a, 0xfe, 0x8d, 0xe5, 0x74, 0x78, 0x8d,
+		0x6d, 0x67, 0xa0, 0x40, 0x5b, 0x0b, 0xbb, 0x97, 0xc0, 0x1b, 0x0a, 0x2a, 0x6a, 0xa6, 0x37, 0x45,
+		0x0d, 0xd5, 0x5d, 0xe4, 0x7f, 0x91, 0x60, 0x31, 0x45, 0x33, 0x27, 0xcf, 0xd6, 0x7b, 0xdd, 0x99,
+		0x38, 0x68, 0xdf, 0xca, 0xc0, 0xed, 0xc3, 0xfd, 0xa0, 0xa5, 0x6f, 0x36, 0xd3, 0xb9, 0x7b, 0xce,
+		0x04, 0xee, 0x9e, 0x1f, 0x01, 0x8a, 0x76, 0x78, 0x51, 0xd3, 0x39, 0x2f, 0xd6, 0xbf, 0xa5, 0x4c,
+		0x44, 0xfa, 0xb3, 0xdc, 0xcb, 0x3c, 0xcd, 0xac, 0x3b, 0x96, 0x59, 0x25, 0x8a, 0x36, 0xa2, 0xf8,
+		0x8f, 0xa8, 0x08, 0x47, 0x43, 0x3d, 0xc0, 0x66, 0xbd, 0xea, 0x65, 0x9a, 0xc3, 0xca, 0x44, 0xa0,
+		0x35, 0xf7, 0x41, 0xbd, 0x7a, 0x20, 0xbf, 0x97, 0x85, 0x5b, 0x87, 0xf8, 0xc1, 0x0c, 0x7a, 0xd4,
+		0x7d, 0x8e, 0x8f, 0x71, 0x7e, 0x8e, 0x26, 0xc4, 0x39, 0x50, 0x46, 0xe9, 0xd3, 0xfd, 0x08, 0xb7,
+		0x26, 0xc0, 0xde, 0x97, 0x81, 0xc3, 0xee, 0xcb, 0x12, 0xa0, 0x70, 0x9b, 0x32, 0xf5, 0xee, 0x59,
+		0x65, 0xdc, 0x08, 0x28, 0xa1, 0x77, 0x25, 0xeb, 0xef, 0x62, 0x2e, 0xb0, 0x8b, 0xf2, 0x8f, 0x25,
+		0xb8, 0xd1, 0xe3, 0xaf, 0x7d, 0x38, 0x18, 0x24, 0x0e, 0x86, 0x4f, 0x57, 0x71, 0xe5, 0x7f, 0xcc,
+		0xc2, 0x8d, 0x1e, 0x3b, 0xb2, 0xff, 0x58, 0x6d, 0x35, 0xe4, 0xb1, 0x07, 0xf8, 0x1e, 0x7b, 0x50,
+		0xdc, 0x63, 0x73, 0x55, 0x87, 0xe7, 0x00, 0x86, 0x78, 0x0e, 0xe0, 0xed, 0x2c, 0x5c, 0xeb, 0xa5,
+		0xab, 0x5c, 0xcc, 0xf2, 0x85, 0x38, 0x3f, 0xb7, 0xfc, 0x8e, 0xe5, 0xff, 0x52, 0x82, 0xcb, 0x69,
+		0x3b, 0xe4, 0xff, 0xa0, 0x4d, 0x9e, 0x7f, 0x56, 0xc9, 0xdf, 0x97, 0xe0, 0x52, 0xaa, 0xae, 0xfa,
+		0xbe, 0xb9, 0x00, 0x66, 0x16, 0x9c, 0x39, 0x5c, 0x16, 0xfc, 0xaf, 0x12, 0xcc, 0x27, 0xf6, 0x34,
+		0xf7, 0x4d, 0x02, 0x3f, 0xed, 0xcf, 0x08, 0xa5, 0xfd, 0xf2, 0xcf, 0x86, 0xe1, 0x6a, 0x0f, 0xbf,
+		0x5d, 0xec, 0xd2, 0x15, 0x29, 0xa0, 0x2b, 0x67, 0xa0, 0xd0, 0xd6, 0x95, 0x76, 0x96, 0x00, 0xfe,
+		0x10, 0xeb, 0xbe, 0x2e, 0xdb, 0x87, 0xfb, 0xba, 0x5e, 0x8b, 0xf7, 0x83, 0xfd, 0xbd, 0xaf, 0xcb,
+		0x3d, 0xd3, 0xfb, 0xba, 0xa1, 0x9e, 0xef, 0xeb, 0x1e, 0x03, 0xed, 0x20, 0xa7, 0x1c, 0x69, 0xcd,
+		0xdb, 0xeb, 0xc8, 0x39, 0x1f, 0xd3, 0x86, 0x4e, 0xb8, 0xd0, 0xca, 0xf7, 0x44, 0x23, 0x3c, 0xd4,
+		0x6d, 0xc1, 0xf9, 0xe0, 0x61, 0x23, 0xa2, 0xcd, 0x20, 0xa0, 0xcd, 0x1a, 0x4c, 0x75, 0xa9, 0x53,
+		0xc5, 0xc2, 0xcd, 0x0e, 0xfc, 0x02, 0x81, 0xbf, 0x18, 0xab, 0x38, 0x65, 0x5d, 0xc1, 0x4d, 0x1f,
+		0xaf, 0x72, 0xbc, 0xc5, 0x1a, 0x8e, 0xf4, 0x02, 0x8c, 0xf6, 0xd2, 0x0b, 0x10, 0xe9, 0x05, 0x1e,
+		0x63, 0xf4, 0x02, 0x77, 0xd2, 0xc0, 0x23, 0xe9, 0x2f, 0xf2, 0xc6, 0x0f, 0x71, 0x91, 0x37, 0x71,
+		0xb8, 0x36, 0xdf, 0x57, 0xa0, 0xa0, 0xe3, 0xaa, 0x7a, 0xe0, 0xa9, 0x66, 0x72, 0xcf, 0x32, 0x10,
+		0x6a, 0xa2, 0x8a, 0xf2, 0xbb, 0x59, 0xb8, 0x9c, 0xf6, 0xb7, 0xc5, 0x9f, 0xbd, 0x7b, 0xd9, 0xf0,
+		0x83, 0x18, 0xaf, 0xac, 0x7c, 0x3d, 0xf5, 0x0f, 0x63, 0x03, 0xb1, 0x4b, 0x97, 0xa1, 0x0c, 0x06,
+		0x0d, 0x85, 0x7d, 0x42, 0xe7, 0x38, 0x27, 0x74, 0x9f, 0x2e, 0xde, 0xe5, 0xef, 0x65, 0x60, 0x29,
+		0xcd, 0x0f, 0xa7, 0xb9, 0xfb, 0xc1, 0x0e, 0x0d, 0x32, 0x87, 0x0d, 0x0d, 0xfa, 0xb5, 0x8b, 0xec,
+		0xd5, 0x1d, 0xe0, 0xac, 0x6e, 0xc7, 0x3a, 0x07, 0xc5, 0x2f, 0x69, 0x3e, 0xc9, 0x40, 0xca, 0x9f,
+		0x74, 0x7f, 0x3e, 0x16, 0x93, 0x55, 0x43, 0x1d, 0x64, 0xd6, 0x50, 0x3b, 0x37, 0x87, 0x39, 0xf1,
+		0x9b, 0x43, 0xf9, 0x37, 0x19, 0xb8, 0xd8, 0x0f, 0x8f, 0xf2, 0x39, 0x5d, 0xf4, 0xae, 0xf2, 0x56,
+		0x2e, 0x45, 0x79, 0x4b, 0xfe, 0x6d, 0x06, 0x2e, 0xa5, 0xfa, 0x85, 0xfd, 0xf3, 0x85, 0x8f, 0x2c,
+		0xbc, 0x7f, 0xdf, 0x99, 0x4b, 0x53, 0xd4, 0xf9, 0xbb, 0x2c, 0x6f, 0xe1, 0x79, 0x0d, 0x5b, 0xcf,
+		0x17, 0x3e, 0xb6, 0x5f, 0x2c, 0xd7, 0xcb, 0x0f, 0x4d, 0xbe, 0x99, 0x81, 0x52, 0xca, 0xff, 0x7c,
+		0xf0, 0x7c, 0x1f, 0x02, 0xfb, 0xb0, 0xe8, 0xc0, 0x11, 0xf2, 0xe7, 0xba, 0x51, 0x75, 0xb0, 0x45,
+		0x3e, 0x75, 0x0a, 0xa6, 0xd7, 0x1e, 0xaf, 0xdd, 0xdf, 0xaa, 0xac, 0x97, 0x37, 0xb6, 0xd6, 0x94,
+		0xca, 0xd6, 0x5f, 0x6c, 0xae, 0x55, 0xca, 0xf7, 0x1f, 0xaf, 0x6c, 0x94, 0xef, 0x8e, 0xbf, 0x80,
+		0xce, 0xc0, 0x4c, 0xf4, 0xf5, 0xca, 0xc6, 0x46, 0x85, 0x8c, 0x8e, 0x4b, 0x68, 0x1e, 0x4e, 0x45,
+		0x09, 0x56, 0x37, 0x1e, 0x3c, 0x5c, 0xa3, 0x24, 0x99, 0x3b, 0x8f, 0xe1, 0x84, 0x66, 0xd6, 0x58,
+		0x6b, 0x70, 0x67, 0x78, 0xa5, 0x61, 0x6c, 0x5a, 0xa6, 0x63, 0x6e, 0x4a, 0x7f, 0x59, 0x7a, 0x62,
+		0x38, 0xbb, 0xcd, 0xed, 0xa2, 0x66, 0xd6, 0x4a, 0x81, 0xff, 0xdf, 0x5d, 0x7c, 0x82, 0xeb, 0xde,
+		0x7f, 0x0c, 0xa7, 0xff, 0xca, 0xfb, 0x96, 0xda, 0x30, 0xf6, 0xaf, 0x6c, 0xe7, 0xc8, 0xd8, 0xd5,
+		0xdf, 0x0f, 0x00, 0x02, 0x07, 0xff, 0x35, 0xad, 0x5c, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/query.proto
 	[]byte{
@@ -1685,8 +1719,8 @@ var yarpcFileDescriptorClosure00684e887839a48c = [][]byte{
 		0x47, 0x5c, 0xdb, 0xfa, 0x20, 0xd7, 0xae, 0x7a, 0xf0, 0x32, 0x64, 0x93, 0xaa, 0x17, 0xbd, 0x7a,
 		0xa2, 0x25, 0x91, 0x93, 0xdf, 0x8f, 0x23, 0x7d, 0x52, 0x47, 0x51, 0xfa, 0x25, 0x1b, 0x28, 0x21,
 		0x9b, 0xa8, 0xf7, 0x0e, 0x4e, 0x19, 0xd1, 0x58, 0x2d, 0xae, 0xac, 0xbc, 0xbd, 0xcb, 0x20, 0x89,
-		0xa6, 0xed, 0xc1, 0x56, 0x81, 0xbd, 0xfd, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x96, 0x7d, 0xf9, 0x10,
-		0xf7, 0x03, 0x00, 0x00,
+		0xa6, 0xed, 0xc1, 0x56, 0x81, 0xbd, 0xfd, 0x3d, 0x00, 0x96, 0x7d, 0xf9, 0x10, 0xf7, 0x03, 0x00,
+		0x00,
 	},
 }
 
@@ -47,25 +47,26 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type StartWorkflowExecutionRequest struct {
-	Domain                       string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	WorkflowId                   string                `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	WorkflowType                 *WorkflowType         `protobuf:"bytes,3,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
-	TaskList                     *TaskList             `protobuf:"bytes,4,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
-	Input                        *Payload              `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
-	ExecutionStartToCloseTimeout *types.Duration       `protobuf:"bytes,6,opt,name=execution_start_to_close_timeout,json=executionStartToCloseTimeout,proto3" json:"execution_start_to_close_timeout,omitempty"`
-	TaskStartToCloseTimeout      *types.Duration       `protobuf:"bytes,7,opt,name=task_start_to_close_timeout,json=taskStartToCloseTimeout,proto3" json:"task_start_to_close_timeout,omitempty"`
-	Identity                     string                `protobuf:"bytes,8,opt,name=identity,proto3" json:"identity,omitempty"`
-	RequestId                    string                `protobuf:"bytes,9,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	WorkflowIdReusePolicy        WorkflowIdReusePolicy `protobuf:"varint,10,opt,name=workflow_id_reuse_policy,json=workflowIdReusePolicy,proto3,enum=uber.cadence.api.v1.WorkflowIdReusePolicy" json:"workflow_id_reuse_policy,omitempty"`
-	RetryPolicy                  *RetryPolicy          `protobuf:"bytes,11,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
-	CronSchedule                 string                `protobuf:"bytes,12,opt,name=cron_schedule,json=cronSchedule,proto3" json:"cron_schedule,omitempty"`
-	Memo                         *Memo                 `protobuf:"bytes,13,opt,name=memo,proto3" json:"memo,omitempty"`
-	SearchAttributes             *SearchAttributes     `protobuf:"bytes,14,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
-	Header                       *Header               `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
-	DelayStart                   *types.Duration       `protobuf:"bytes,16,opt,name=delay_start,json=delayStart,proto3" json:"delay_start,omitempty"`
-	XXX_NoUnkeyedLiteral         struct{}              `json:"-"`
-	XXX_unrecognized             []byte                `json:"-"`
-	XXX_sizecache                int32                 `json:"-"`
+	Domain                       string                   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowId                   string                   `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	WorkflowType                 *WorkflowType            `protobuf:"bytes,3,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	TaskList                     *TaskList                `protobuf:"bytes,4,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	Input                        *Payload                 `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
+	ExecutionStartToCloseTimeout *types.Duration          `protobuf:"bytes,6,opt,name=execution_start_to_close_timeout,json=executionStartToCloseTimeout,proto3" json:"execution_start_to_close_timeout,omitempty"`
+	TaskStartToCloseTimeout      *types.Duration          `protobuf:"bytes,7,opt,name=task_start_to_close_timeout,json=taskStartToCloseTimeout,proto3" json:"task_start_to_close_timeout,omitempty"`
+	Identity                     string                   `protobuf:"bytes,8,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId                    string                   `protobuf:"bytes,9,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	WorkflowIdReusePolicy        WorkflowIdReusePolicy    `protobuf:"varint,10,opt,name=workflow_id_reuse_policy,json=workflowIdReusePolicy,proto3,enum=uber.cadence.api.v1.WorkflowIdReusePolicy" json:"workflow_id_reuse_policy,omitempty"`
+	RetryPolicy                  *RetryPolicy             `protobuf:"bytes,11,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	CronSchedule                 string                   `protobuf:"bytes,12,opt,name=cron_schedule,json=cronSchedule,proto3" json:"cron_schedule,omitempty"`
+	Memo                         *Memo                    `protobuf:"bytes,13,opt,name=memo,proto3" json:"memo,omitempty"`
+	SearchAttributes             *SearchAttributes        `protobuf:"bytes,14,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	Header                       *Header                  `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	DelayStart                   *types.Duration          `protobuf:"bytes,16,opt,name=delay_start,json=delayStart,proto3" json:"delay_start,omitempty"`
+	WorkflowIdConflictPolicy     WorkflowIdConflictPolicy `protobuf:"varint,17,opt,name=workflow_id_conflict_policy,json=workflowIdConflictPolicy,proto3,enum=uber.cadence.api.v1.WorkflowIdConflictPolicy" json:"workflow_id_conflict_policy,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}                 `json:"-"`
+	XXX_unrecognized             []byte                   `json:"-"`
+	XXX_sizecache                int32                    `json:"-"`
 }
 
 func (m *StartWorkflowExecutionRequest) Reset()         { *m = StartWorkflowExecutionRequest{} }
@@ -213,6 +214,13 @@ func (m *StartWorkflowExecutionRequest) GetDelayStart() *types.Duration {
 	return nil
 }
 
+func (m *StartWorkflowExecutionRequest) GetWorkflowIdConflictPolicy() WorkflowIdConflictPolicy {
+	if m != nil {
+		return m.WorkflowIdConflictPolicy
+	}
+	return WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_INVALID
+}
+
 type StartWorkflowExecutionResponse struct {
 	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -650,6 +658,116 @@ func (m *ResetWorkflowExecutionResponse) GetRunId() string {
 	return ""
 }
 
+type RestartWorkflowExecutionRequest struct {
+	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Identity             string             `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *RestartWorkflowExecutionRequest) Reset()         { *m = RestartWorkflowExecutionRequest{} }
+func (m *RestartWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*RestartWorkflowExecutionRequest) ProtoMessage()    {}
+func (*RestartWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{8}
+}
+func (m *RestartWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestartWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestartWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestartWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestartWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *RestartWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestartWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestartWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestartWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *RestartWorkflowExecutionRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *RestartWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *RestartWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+type RestartWorkflowExecutionResponse struct {
+	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestartWorkflowExecutionResponse) Reset()         { *m = RestartWorkflowExecutionResponse{} }
+func (m *RestartWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*RestartWorkflowExecutionResponse) ProtoMessage()    {}
+func (*RestartWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{9}
+}
+func (m *RestartWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestartWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestartWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestartWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestartWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *RestartWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestartWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestartWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestartWorkflowExecutionResponse proto.InternalMessageInfo
+
+func (m *RestartWorkflowExecutionResponse) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
 type RequestCancelWorkflowExecutionRequest struct {
 	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -664,7 +782,7 @@ func (m *RequestCancelWorkflowExecutionRequest) Reset()         { *m = RequestCa
 func (m *RequestCancelWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*RequestCancelWorkflowExecutionRequest) ProtoMessage()    {}
 func (*RequestCancelWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{8}
+	return fileDescriptor_674d14d2fee4e473, []int{10}
 }
 func (m *RequestCancelWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +851,7 @@ func (m *RequestCancelWorkflowExecutionResponse) Reset() {
 func (m *RequestCancelWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*RequestCancelWorkflowExecutionResponse) ProtoMessage()    {}
 func (*RequestCancelWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{9}
+	return fileDescriptor_674d14d2fee4e473, []int{11}
 }
 func (m *RequestCancelWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -762,29 +880,29 @@ func (m *RequestCancelWorkflowExecutionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RequestCancelWorkflowExecutionResponse proto.InternalMessageInfo
 
-type TerminateWorkflowExecutionRequest struct {
+type PauseWorkflowExecutionRequest struct {
 	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
 	Reason               string             `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	Details              *Payload           `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
-	Identity             string             `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	Identity             string             `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string             `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *TerminateWorkflowExecutionRequest) Reset()         { *m = TerminateWorkflowExecutionRequest{} }
-func (m *TerminateWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
-func (*TerminateWorkflowExecutionRequest) ProtoMessage()    {}
-func (*TerminateWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{10}
+func (m *PauseWorkflowExecutionRequest) Reset()         { *m = PauseWorkflowExecutionRequest{} }
+func (m *PauseWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseWorkflowExecutionRequest) ProtoMessage()    {}
+func (*PauseWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{12}
 }
-func (m *TerminateWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+func (m *PauseWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TerminateWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PauseWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TerminateWorkflowExecutionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PauseWorkflowExecutionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -794,71 +912,71 @@ func (m *TerminateWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *TerminateWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TerminateWorkflowExecutionRequest.Merge(m, src)
+func (m *PauseWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWorkflowExecutionRequest.Merge(m, src)
 }
-func (m *TerminateWorkflowExecutionRequest) XXX_Size() int {
+func (m *PauseWorkflowExecutionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *TerminateWorkflowExecutionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TerminateWorkflowExecutionRequest.DiscardUnknown(m)
+func (m *PauseWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWorkflowExecutionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TerminateWorkflowExecutionRequest proto.InternalMessageInfo
+var xxx_messageInfo_PauseWorkflowExecutionRequest proto.InternalMessageInfo
 
-func (m *TerminateWorkflowExecutionRequest) GetDomain() string {
+func (m *PauseWorkflowExecutionRequest) GetDomain() string {
 	if m != nil {
 		return m.Domain
 	}
 	return ""
 }
 
-func (m *TerminateWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
+func (m *PauseWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
 	if m != nil {
 		return m.WorkflowExecution
 	}
 	return nil
 }
 
-func (m *TerminateWorkflowExecutionRequest) GetReason() string {
+func (m *PauseWorkflowExecutionRequest) GetReason() string {
 	if m != nil {
 		return m.Reason
 	}
 	return ""
 }
 
-func (m *TerminateWorkflowExecutionRequest) GetDetails() *Payload {
+func (m *PauseWorkflowExecutionRequest) GetIdentity() string {
 	if m != nil {
-		return m.Details
+		return m.Identity
 	}
-	return nil
+	return ""
 }
 
-func (m *TerminateWorkflowExecutionRequest) GetIdentity() string {
+func (m *PauseWorkflowExecutionRequest) GetRequestId() string {
 	if m != nil {
-		return m.Identity
+		return m.RequestId
 	}
 	return ""
 }
 
-type TerminateWorkflowExecutionResponse struct {
+type PauseWorkflowExecutionResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TerminateWorkflowExecutionResponse) Reset()         { *m = TerminateWorkflowExecutionResponse{} }
-func (m *TerminateWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
-func (*TerminateWorkflowExecutionResponse) ProtoMessage()    {}
-func (*TerminateWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{11}
+func (m *PauseWorkflowExecutionResponse) Reset()         { *m = PauseWorkflowExecutionResponse{} }
+func (m *PauseWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseWorkflowExecutionResponse) ProtoMessage()    {}
+func (*PauseWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{13}
 }
-func (m *TerminateWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+func (m *PauseWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TerminateWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PauseWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TerminateWorkflowExecutionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PauseWorkflowExecutionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -868,38 +986,41 @@ func (m *TerminateWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *TerminateWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TerminateWorkflowExecutionResponse.Merge(m, src)
+func (m *PauseWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWorkflowExecutionResponse.Merge(m, src)
 }
-func (m *TerminateWorkflowExecutionResponse) XXX_Size() int {
+func (m *PauseWorkflowExecutionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *TerminateWorkflowExecutionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_TerminateWorkflowExecutionResponse.DiscardUnknown(m)
+func (m *PauseWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWorkflowExecutionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TerminateWorkflowExecutionResponse proto.InternalMessageInfo
+var xxx_messageInfo_PauseWorkflowExecutionResponse proto.InternalMessageInfo
 
-type DescribeWorkflowExecutionRequest struct {
+type UnpauseWorkflowExecutionRequest struct {
 	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Reason               string             `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity             string             `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string             `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *DescribeWorkflowExecutionRequest) Reset()         { *m = DescribeWorkflowExecutionRequest{} }
-func (m *DescribeWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
-func (*DescribeWorkflowExecutionRequest) ProtoMessage()    {}
-func (*DescribeWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{12}
+func (m *UnpauseWorkflowExecutionRequest) Reset()         { *m = UnpauseWorkflowExecutionRequest{} }
+func (m *UnpauseWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*UnpauseWorkflowExecutionRequest) ProtoMessage()    {}
+func (*UnpauseWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{14}
 }
-func (m *DescribeWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+func (m *UnpauseWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DescribeWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnpauseWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DescribeWorkflowExecutionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnpauseWorkflowExecutionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -909,55 +1030,71 @@ func (m *DescribeWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *DescribeWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeWorkflowExecutionRequest.Merge(m, src)
+func (m *UnpauseWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseWorkflowExecutionRequest.Merge(m, src)
 }
-func (m *DescribeWorkflowExecutionRequest) XXX_Size() int {
+func (m *UnpauseWorkflowExecutionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DescribeWorkflowExecutionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeWorkflowExecutionRequest.DiscardUnknown(m)
+func (m *UnpauseWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseWorkflowExecutionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeWorkflowExecutionRequest proto.InternalMessageInfo
+var xxx_messageInfo_UnpauseWorkflowExecutionRequest proto.InternalMessageInfo
 
-func (m *DescribeWorkflowExecutionRequest) GetDomain() string {
+func (m *UnpauseWorkflowExecutionRequest) GetDomain() string {
 	if m != nil {
 		return m.Domain
 	}
 	return ""
 }
 
-func (m *DescribeWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
+func (m *UnpauseWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
 	if m != nil {
 		return m.WorkflowExecution
 	}
 	return nil
 }
 
-type DescribeWorkflowExecutionResponse struct {
-	ExecutionConfiguration *WorkflowExecutionConfiguration `protobuf:"bytes,1,opt,name=execution_configuration,json=executionConfiguration,proto3" json:"execution_configuration,omitempty"`
-	WorkflowExecutionInfo  *WorkflowExecutionInfo          `protobuf:"bytes,2,opt,name=workflow_execution_info,json=workflowExecutionInfo,proto3" json:"workflow_execution_info,omitempty"`
-	PendingActivities      []*PendingActivityInfo          `protobuf:"bytes,3,rep,name=pending_activities,json=pendingActivities,proto3" json:"pending_activities,omitempty"`
-	PendingChildren        []*PendingChildExecutionInfo    `protobuf:"bytes,4,rep,name=pending_children,json=pendingChildren,proto3" json:"pending_children,omitempty"`
-	PendingDecision        *PendingDecisionInfo            `protobuf:"bytes,5,opt,name=pending_decision,json=pendingDecision,proto3" json:"pending_decision,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}                        `json:"-"`
-	XXX_unrecognized       []byte                          `json:"-"`
-	XXX_sizecache          int32                           `json:"-"`
+func (m *UnpauseWorkflowExecutionRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *DescribeWorkflowExecutionResponse) Reset()         { *m = DescribeWorkflowExecutionResponse{} }
-func (m *DescribeWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
-func (*DescribeWorkflowExecutionResponse) ProtoMessage()    {}
-func (*DescribeWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{13}
+func (m *UnpauseWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
 }
-func (m *DescribeWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *UnpauseWorkflowExecutionRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type UnpauseWorkflowExecutionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Reset()         { *m = UnpauseWorkflowExecutionResponse{} }
+func (m *UnpauseWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*UnpauseWorkflowExecutionResponse) ProtoMessage()    {}
+func (*UnpauseWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{15}
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DescribeWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnpauseWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DescribeWorkflowExecutionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnpauseWorkflowExecutionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -967,76 +1104,107 @@ func (m *DescribeWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *DescribeWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeWorkflowExecutionResponse.Merge(m, src)
+func (m *UnpauseWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseWorkflowExecutionResponse.Merge(m, src)
 }
-func (m *DescribeWorkflowExecutionResponse) XXX_Size() int {
+func (m *UnpauseWorkflowExecutionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DescribeWorkflowExecutionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeWorkflowExecutionResponse.DiscardUnknown(m)
+func (m *UnpauseWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseWorkflowExecutionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeWorkflowExecutionResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnpauseWorkflowExecutionResponse proto.InternalMessageInfo
 
-func (m *DescribeWorkflowExecutionResponse) GetExecutionConfiguration() *WorkflowExecutionConfiguration {
-	if m != nil {
-		return m.ExecutionConfiguration
+type DeleteWorkflowExecutionRequest struct {
+	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Archive              bool               `protobuf:"varint,3,opt,name=archive,proto3" json:"archive,omitempty"`
+	Identity             string             `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DeleteWorkflowExecutionRequest) Reset()         { *m = DeleteWorkflowExecutionRequest{} }
+func (m *DeleteWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteWorkflowExecutionRequest) ProtoMessage()    {}
+func (*DeleteWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{16}
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWorkflowExecutionRequest.DiscardUnknown(m)
 }
 
-func (m *DescribeWorkflowExecutionResponse) GetWorkflowExecutionInfo() *WorkflowExecutionInfo {
+var xxx_messageInfo_DeleteWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *DeleteWorkflowExecutionRequest) GetDomain() string {
 	if m != nil {
-		return m.WorkflowExecutionInfo
+		return m.Domain
 	}
-	return nil
+	return ""
 }
 
-func (m *DescribeWorkflowExecutionResponse) GetPendingActivities() []*PendingActivityInfo {
+func (m *DeleteWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
 	if m != nil {
-		return m.PendingActivities
+		return m.WorkflowExecution
 	}
 	return nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) GetPendingChildren() []*PendingChildExecutionInfo {
+func (m *DeleteWorkflowExecutionRequest) GetArchive() bool {
 	if m != nil {
-		return m.PendingChildren
+		return m.Archive
 	}
-	return nil
+	return false
 }
 
-func (m *DescribeWorkflowExecutionResponse) GetPendingDecision() *PendingDecisionInfo {
+func (m *DeleteWorkflowExecutionRequest) GetIdentity() string {
 	if m != nil {
-		return m.PendingDecision
+		return m.Identity
 	}
-	return nil
+	return ""
 }
 
-type QueryWorkflowRequest struct {
-	Domain                string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	WorkflowExecution     *WorkflowExecution    `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	Query                 *WorkflowQuery        `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
-	QueryRejectCondition  QueryRejectCondition  `protobuf:"varint,4,opt,name=query_reject_condition,json=queryRejectCondition,proto3,enum=uber.cadence.api.v1.QueryRejectCondition" json:"query_reject_condition,omitempty"`
-	QueryConsistencyLevel QueryConsistencyLevel `protobuf:"varint,5,opt,name=query_consistency_level,json=queryConsistencyLevel,proto3,enum=uber.cadence.api.v1.QueryConsistencyLevel" json:"query_consistency_level,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}              `json:"-"`
-	XXX_unrecognized      []byte                `json:"-"`
-	XXX_sizecache         int32                 `json:"-"`
+type DeleteWorkflowExecutionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *QueryWorkflowRequest) Reset()         { *m = QueryWorkflowRequest{} }
-func (m *QueryWorkflowRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryWorkflowRequest) ProtoMessage()    {}
-func (*QueryWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{14}
+func (m *DeleteWorkflowExecutionResponse) Reset()         { *m = DeleteWorkflowExecutionResponse{} }
+func (m *DeleteWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteWorkflowExecutionResponse) ProtoMessage()    {}
+func (*DeleteWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{17}
 }
-func (m *QueryWorkflowRequest) XXX_Unmarshal(b []byte) error {
+func (m *DeleteWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWorkflowRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteWorkflowExecutionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1046,73 +1214,107 @@ func (m *QueryWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryWorkflowRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWorkflowRequest.Merge(m, src)
+func (m *DeleteWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWorkflowExecutionResponse.Merge(m, src)
 }
-func (m *QueryWorkflowRequest) XXX_Size() int {
+func (m *DeleteWorkflowExecutionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWorkflowRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWorkflowRequest.DiscardUnknown(m)
+func (m *DeleteWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWorkflowExecutionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryWorkflowRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteWorkflowExecutionResponse proto.InternalMessageInfo
 
-func (m *QueryWorkflowRequest) GetDomain() string {
-	if m != nil {
-		return m.Domain
+type PauseActivityRequest struct {
+	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	ActivityId           string             `protobuf:"bytes,3,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	Identity             string             `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *PauseActivityRequest) Reset()         { *m = PauseActivityRequest{} }
+func (m *PauseActivityRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseActivityRequest) ProtoMessage()    {}
+func (*PauseActivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{18}
+}
+func (m *PauseActivityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseActivityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *PauseActivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseActivityRequest.Merge(m, src)
+}
+func (m *PauseActivityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseActivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseActivityRequest.DiscardUnknown(m)
 }
 
-func (m *QueryWorkflowRequest) GetWorkflowExecution() *WorkflowExecution {
+var xxx_messageInfo_PauseActivityRequest proto.InternalMessageInfo
+
+func (m *PauseActivityRequest) GetDomain() string {
 	if m != nil {
-		return m.WorkflowExecution
+		return m.Domain
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryWorkflowRequest) GetQuery() *WorkflowQuery {
+func (m *PauseActivityRequest) GetWorkflowExecution() *WorkflowExecution {
 	if m != nil {
-		return m.Query
+		return m.WorkflowExecution
 	}
 	return nil
 }
 
-func (m *QueryWorkflowRequest) GetQueryRejectCondition() QueryRejectCondition {
+func (m *PauseActivityRequest) GetActivityId() string {
 	if m != nil {
-		return m.QueryRejectCondition
+		return m.ActivityId
 	}
-	return QueryRejectCondition_QUERY_REJECT_CONDITION_INVALID
+	return ""
 }
 
-func (m *QueryWorkflowRequest) GetQueryConsistencyLevel() QueryConsistencyLevel {
+func (m *PauseActivityRequest) GetIdentity() string {
 	if m != nil {
-		return m.QueryConsistencyLevel
+		return m.Identity
 	}
-	return QueryConsistencyLevel_QUERY_CONSISTENCY_LEVEL_INVALID
+	return ""
 }
 
-type QueryWorkflowResponse struct {
-	QueryResult          *Payload       `protobuf:"bytes,1,opt,name=query_result,json=queryResult,proto3" json:"query_result,omitempty"`
-	QueryRejected        *QueryRejected `protobuf:"bytes,2,opt,name=query_rejected,json=queryRejected,proto3" json:"query_rejected,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type PauseActivityResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *QueryWorkflowResponse) Reset()         { *m = QueryWorkflowResponse{} }
-func (m *QueryWorkflowResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryWorkflowResponse) ProtoMessage()    {}
-func (*QueryWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{15}
+func (m *PauseActivityResponse) Reset()         { *m = PauseActivityResponse{} }
+func (m *PauseActivityResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseActivityResponse) ProtoMessage()    {}
+func (*PauseActivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{19}
 }
-func (m *QueryWorkflowResponse) XXX_Unmarshal(b []byte) error {
+func (m *PauseActivityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWorkflowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PauseActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWorkflowResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PauseActivityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1122,54 +1324,40 @@ func (m *QueryWorkflowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryWorkflowResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWorkflowResponse.Merge(m, src)
+func (m *PauseActivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseActivityResponse.Merge(m, src)
 }
-func (m *QueryWorkflowResponse) XXX_Size() int {
+func (m *PauseActivityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWorkflowResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWorkflowResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryWorkflowResponse proto.InternalMessageInfo
-
-func (m *QueryWorkflowResponse) GetQueryResult() *Payload {
-	if m != nil {
-		return m.QueryResult
-	}
-	return nil
+func (m *PauseActivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseActivityResponse.DiscardUnknown(m)
 }
 
-func (m *QueryWorkflowResponse) GetQueryRejected() *QueryRejected {
-	if m != nil {
-		return m.QueryRejected
-	}
-	return nil
-}
+var xxx_messageInfo_PauseActivityResponse proto.InternalMessageInfo
 
-type DescribeTaskListRequest struct {
-	Domain                string       `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	TaskList              *TaskList    `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
-	TaskListType          TaskListType `protobuf:"varint,3,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
-	IncludeTaskListStatus bool         `protobuf:"varint,4,opt,name=include_task_list_status,json=includeTaskListStatus,proto3" json:"include_task_list_status,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}     `json:"-"`
-	XXX_unrecognized      []byte       `json:"-"`
-	XXX_sizecache         int32        `json:"-"`
+type UnpauseActivityRequest struct {
+	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	ActivityId           string             `protobuf:"bytes,3,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	Identity             string             `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *DescribeTaskListRequest) Reset()         { *m = DescribeTaskListRequest{} }
-func (m *DescribeTaskListRequest) String() string { return proto.CompactTextString(m) }
-func (*DescribeTaskListRequest) ProtoMessage()    {}
-func (*DescribeTaskListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{16}
+func (m *UnpauseActivityRequest) Reset()         { *m = UnpauseActivityRequest{} }
+func (m *UnpauseActivityRequest) String() string { return proto.CompactTextString(m) }
+func (*UnpauseActivityRequest) ProtoMessage()    {}
+func (*UnpauseActivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{20}
 }
-func (m *DescribeTaskListRequest) XXX_Unmarshal(b []byte) error {
+func (m *UnpauseActivityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DescribeTaskListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnpauseActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DescribeTaskListRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnpauseActivityRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1179,66 +1367,64 @@ func (m *DescribeTaskListRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *DescribeTaskListRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeTaskListRequest.Merge(m, src)
+func (m *UnpauseActivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseActivityRequest.Merge(m, src)
 }
-func (m *DescribeTaskListRequest) XXX_Size() int {
+func (m *UnpauseActivityRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DescribeTaskListRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeTaskListRequest.DiscardUnknown(m)
+func (m *UnpauseActivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseActivityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeTaskListRequest proto.InternalMessageInfo
+var xxx_messageInfo_UnpauseActivityRequest proto.InternalMessageInfo
 
-func (m *DescribeTaskListRequest) GetDomain() string {
+func (m *UnpauseActivityRequest) GetDomain() string {
 	if m != nil {
 		return m.Domain
 	}
 	return ""
 }
 
-func (m *DescribeTaskListRequest) GetTaskList() *TaskList {
+func (m *UnpauseActivityRequest) GetWorkflowExecution() *WorkflowExecution {
 	if m != nil {
-		return m.TaskList
+		return m.WorkflowExecution
 	}
 	return nil
 }
 
-func (m *DescribeTaskListRequest) GetTaskListType() TaskListType {
+func (m *UnpauseActivityRequest) GetActivityId() string {
 	if m != nil {
-		return m.TaskListType
+		return m.ActivityId
 	}
-	return TaskListType_TASK_LIST_TYPE_INVALID
+	return ""
 }
 
-func (m *DescribeTaskListRequest) GetIncludeTaskListStatus() bool {
+func (m *UnpauseActivityRequest) GetIdentity() string {
 	if m != nil {
-		return m.IncludeTaskListStatus
+		return m.Identity
 	}
-	return false
+	return ""
 }
 
-type DescribeTaskListResponse struct {
-	Pollers              []*PollerInfo   `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
-	TaskListStatus       *TaskListStatus `protobuf:"bytes,2,opt,name=task_list_status,json=taskListStatus,proto3" json:"task_list_status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type UnpauseActivityResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DescribeTaskListResponse) Reset()         { *m = DescribeTaskListResponse{} }
-func (m *DescribeTaskListResponse) String() string { return proto.CompactTextString(m) }
-func (*DescribeTaskListResponse) ProtoMessage()    {}
-func (*DescribeTaskListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{17}
+func (m *UnpauseActivityResponse) Reset()         { *m = UnpauseActivityResponse{} }
+func (m *UnpauseActivityResponse) String() string { return proto.CompactTextString(m) }
+func (*UnpauseActivityResponse) ProtoMessage()    {}
+func (*UnpauseActivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{21}
 }
-func (m *DescribeTaskListResponse) XXX_Unmarshal(b []byte) error {
+func (m *UnpauseActivityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DescribeTaskListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnpauseActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DescribeTaskListResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnpauseActivityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1248,51 +1434,41 @@ func (m *DescribeTaskListResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *DescribeTaskListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeTaskListResponse.Merge(m, src)
+func (m *UnpauseActivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseActivityResponse.Merge(m, src)
 }
-func (m *DescribeTaskListResponse) XXX_Size() int {
+func (m *UnpauseActivityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DescribeTaskListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeTaskListResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DescribeTaskListResponse proto.InternalMessageInfo
-
-func (m *DescribeTaskListResponse) GetPollers() []*PollerInfo {
-	if m != nil {
-		return m.Pollers
-	}
-	return nil
+func (m *UnpauseActivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseActivityResponse.DiscardUnknown(m)
 }
 
-func (m *DescribeTaskListResponse) GetTaskListStatus() *TaskListStatus {
-	if m != nil {
-		return m.TaskListStatus
-	}
-	return nil
-}
+var xxx_messageInfo_UnpauseActivityResponse proto.InternalMessageInfo
 
-type GetTaskListsByDomainRequest struct {
-	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ResetActivityRequest struct {
+	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	ActivityId           string             `protobuf:"bytes,3,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	RetryNow             bool               `protobuf:"varint,4,opt,name=retry_now,json=retryNow,proto3" json:"retry_now,omitempty"`
+	Identity             string             `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *GetTaskListsByDomainRequest) Reset()         { *m = GetTaskListsByDomainRequest{} }
-func (m *GetTaskListsByDomainRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTaskListsByDomainRequest) ProtoMessage()    {}
-func (*GetTaskListsByDomainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{18}
+func (m *ResetActivityRequest) Reset()         { *m = ResetActivityRequest{} }
+func (m *ResetActivityRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetActivityRequest) ProtoMessage()    {}
+func (*ResetActivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{22}
 }
-func (m *GetTaskListsByDomainRequest) XXX_Unmarshal(b []byte) error {
+func (m *ResetActivityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetTaskListsByDomainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResetActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetTaskListsByDomainRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ResetActivityRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1302,44 +1478,71 @@ func (m *GetTaskListsByDomainRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *GetTaskListsByDomainRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTaskListsByDomainRequest.Merge(m, src)
+func (m *ResetActivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetActivityRequest.Merge(m, src)
 }
-func (m *GetTaskListsByDomainRequest) XXX_Size() int {
+func (m *ResetActivityRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetTaskListsByDomainRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTaskListsByDomainRequest.DiscardUnknown(m)
+func (m *ResetActivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetActivityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTaskListsByDomainRequest proto.InternalMessageInfo
+var xxx_messageInfo_ResetActivityRequest proto.InternalMessageInfo
 
-func (m *GetTaskListsByDomainRequest) GetDomain() string {
+func (m *ResetActivityRequest) GetDomain() string {
 	if m != nil {
 		return m.Domain
 	}
 	return ""
 }
 
-type GetTaskListsByDomainResponse struct {
-	TaskListNames        []string `protobuf:"bytes,1,rep,name=task_list_names,json=taskListNames,proto3" json:"task_list_names,omitempty"`
+func (m *ResetActivityRequest) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *ResetActivityRequest) GetActivityId() string {
+	if m != nil {
+		return m.ActivityId
+	}
+	return ""
+}
+
+func (m *ResetActivityRequest) GetRetryNow() bool {
+	if m != nil {
+		return m.RetryNow
+	}
+	return false
+}
+
+func (m *ResetActivityRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+type ResetActivityResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTaskListsByDomainResponse) Reset()         { *m = GetTaskListsByDomainResponse{} }
-func (m *GetTaskListsByDomainResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTaskListsByDomainResponse) ProtoMessage()    {}
-func (*GetTaskListsByDomainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{19}
+func (m *ResetActivityResponse) Reset()         { *m = ResetActivityResponse{} }
+func (m *ResetActivityResponse) String() string { return proto.CompactTextString(m) }
+func (*ResetActivityResponse) ProtoMessage()    {}
+func (*ResetActivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{23}
 }
-func (m *GetTaskListsByDomainResponse) XXX_Unmarshal(b []byte) error {
+func (m *ResetActivityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetTaskListsByDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResetActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetTaskListsByDomainResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ResetActivityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1349,45 +1552,42 @@ func (m *GetTaskListsByDomainResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *GetTaskListsByDomainResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTaskListsByDomainResponse.Merge(m, src)
+func (m *ResetActivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetActivityResponse.Merge(m, src)
 }
-func (m *GetTaskListsByDomainResponse) XXX_Size() int {
+func (m *ResetActivityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetTaskListsByDomainResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTaskListsByDomainResponse.DiscardUnknown(m)
+func (m *ResetActivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetActivityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTaskListsByDomainResponse proto.InternalMessageInfo
+var xxx_messageInfo_ResetActivityResponse proto.InternalMessageInfo
 
-func (m *GetTaskListsByDomainResponse) GetTaskListNames() []string {
-	if m != nil {
-		return m.TaskListNames
-	}
-	return nil
+type UpdateWorkflowExecutionRequest struct {
+	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	UpdateName           string             `protobuf:"bytes,3,opt,name=update_name,json=updateName,proto3" json:"update_name,omitempty"`
+	Input                *Payload           `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	Identity             string             `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string             `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-type ListTaskListPartitionsRequest struct {
-	Domain               string    `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	TaskList             *TaskList `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
-}
-
-func (m *ListTaskListPartitionsRequest) Reset()         { *m = ListTaskListPartitionsRequest{} }
-func (m *ListTaskListPartitionsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListTaskListPartitionsRequest) ProtoMessage()    {}
-func (*ListTaskListPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{20}
+func (m *UpdateWorkflowExecutionRequest) Reset()         { *m = UpdateWorkflowExecutionRequest{} }
+func (m *UpdateWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateWorkflowExecutionRequest) ProtoMessage()    {}
+func (*UpdateWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{24}
 }
-func (m *ListTaskListPartitionsRequest) XXX_Unmarshal(b []byte) error {
+func (m *UpdateWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListTaskListPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListTaskListPartitionsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateWorkflowExecutionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1397,52 +1597,80 @@ func (m *ListTaskListPartitionsRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *ListTaskListPartitionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListTaskListPartitionsRequest.Merge(m, src)
+func (m *UpdateWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkflowExecutionRequest.Merge(m, src)
 }
-func (m *ListTaskListPartitionsRequest) XXX_Size() int {
+func (m *UpdateWorkflowExecutionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListTaskListPartitionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListTaskListPartitionsRequest.DiscardUnknown(m)
+func (m *UpdateWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkflowExecutionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListTaskListPartitionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateWorkflowExecutionRequest proto.InternalMessageInfo
 
-func (m *ListTaskListPartitionsRequest) GetDomain() string {
+func (m *UpdateWorkflowExecutionRequest) GetDomain() string {
 	if m != nil {
 		return m.Domain
 	}
 	return ""
 }
 
-func (m *ListTaskListPartitionsRequest) GetTaskList() *TaskList {
+func (m *UpdateWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
 	if m != nil {
-		return m.TaskList
+		return m.WorkflowExecution
 	}
 	return nil
 }
 
-type ListTaskListPartitionsResponse struct {
-	ActivityTaskListPartitions []*TaskListPartitionMetadata `protobuf:"bytes,1,rep,name=activity_task_list_partitions,json=activityTaskListPartitions,proto3" json:"activity_task_list_partitions,omitempty"`
-	DecisionTaskListPartitions []*TaskListPartitionMetadata `protobuf:"bytes,2,rep,name=decision_task_list_partitions,json=decisionTaskListPartitions,proto3" json:"decision_task_list_partitions,omitempty"`
-	XXX_NoUnkeyedLiteral       struct{}                     `json:"-"`
-	XXX_unrecognized           []byte                       `json:"-"`
-	XXX_sizecache              int32                        `json:"-"`
+func (m *UpdateWorkflowExecutionRequest) GetUpdateName() string {
+	if m != nil {
+		return m.UpdateName
+	}
+	return ""
 }
 
-func (m *ListTaskListPartitionsResponse) Reset()         { *m = ListTaskListPartitionsResponse{} }
-func (m *ListTaskListPartitionsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListTaskListPartitionsResponse) ProtoMessage()    {}
-func (*ListTaskListPartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{21}
+func (m *UpdateWorkflowExecutionRequest) GetInput() *Payload {
+	if m != nil {
+		return m.Input
+	}
+	return nil
 }
-func (m *ListTaskListPartitionsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *UpdateWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *UpdateWorkflowExecutionRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type UpdateWorkflowExecutionResponse struct {
+	Result               *Payload `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	ErrorMessage         string   `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateWorkflowExecutionResponse) Reset()         { *m = UpdateWorkflowExecutionResponse{} }
+func (m *UpdateWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateWorkflowExecutionResponse) ProtoMessage()    {}
+func (*UpdateWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{25}
+}
+func (m *UpdateWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListTaskListPartitionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListTaskListPartitionsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateWorkflowExecutionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1452,50 +1680,57 @@ func (m *ListTaskListPartitionsResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *ListTaskListPartitionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListTaskListPartitionsResponse.Merge(m, src)
+func (m *UpdateWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkflowExecutionResponse.Merge(m, src)
 }
-func (m *ListTaskListPartitionsResponse) XXX_Size() int {
+func (m *UpdateWorkflowExecutionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListTaskListPartitionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListTaskListPartitionsResponse.DiscardUnknown(m)
+func (m *UpdateWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkflowExecutionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListTaskListPartitionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateWorkflowExecutionResponse proto.InternalMessageInfo
 
-func (m *ListTaskListPartitionsResponse) GetActivityTaskListPartitions() []*TaskListPartitionMetadata {
+func (m *UpdateWorkflowExecutionResponse) GetResult() *Payload {
 	if m != nil {
-		return m.ActivityTaskListPartitions
+		return m.Result
 	}
 	return nil
 }
 
-func (m *ListTaskListPartitionsResponse) GetDecisionTaskListPartitions() []*TaskListPartitionMetadata {
+func (m *UpdateWorkflowExecutionResponse) GetErrorMessage() string {
 	if m != nil {
-		return m.DecisionTaskListPartitions
+		return m.ErrorMessage
 	}
-	return nil
+	return ""
 }
 
-type GetClusterInfoRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type CreateScheduleRequest struct {
+	Domain               string            `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	ScheduleId           string            `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Spec                 *ScheduleSpec     `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	Action               *ScheduleAction   `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Policies             *SchedulePolicies `protobuf:"bytes,5,opt,name=policies,proto3" json:"policies,omitempty"`
+	Identity             string            `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string            `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetClusterInfoRequest) Reset()         { *m = GetClusterInfoRequest{} }
-func (m *GetClusterInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetClusterInfoRequest) ProtoMessage()    {}
-func (*GetClusterInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{22}
+func (m *CreateScheduleRequest) Reset()         { *m = CreateScheduleRequest{} }
+func (m *CreateScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateScheduleRequest) ProtoMessage()    {}
+func (*CreateScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{26}
 }
-func (m *GetClusterInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *CreateScheduleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetClusterInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CreateScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetClusterInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CreateScheduleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1505,37 +1740,85 @@ func (m *GetClusterInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *GetClusterInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetClusterInfoRequest.Merge(m, src)
+func (m *CreateScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateScheduleRequest.Merge(m, src)
 }
-func (m *GetClusterInfoRequest) XXX_Size() int {
+func (m *CreateScheduleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetClusterInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetClusterInfoRequest.DiscardUnknown(m)
+func (m *CreateScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateScheduleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetClusterInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateScheduleRequest proto.InternalMessageInfo
 
-type GetClusterInfoResponse struct {
-	SupportedClientVersions *SupportedClientVersions `protobuf:"bytes,1,opt,name=supported_client_versions,json=supportedClientVersions,proto3" json:"supported_client_versions,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}                 `json:"-"`
-	XXX_unrecognized        []byte                   `json:"-"`
-	XXX_sizecache           int32                    `json:"-"`
+func (m *CreateScheduleRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
 }
 
-func (m *GetClusterInfoResponse) Reset()         { *m = GetClusterInfoResponse{} }
-func (m *GetClusterInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*GetClusterInfoResponse) ProtoMessage()    {}
-func (*GetClusterInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{23}
+func (m *CreateScheduleRequest) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
 }
-func (m *GetClusterInfoResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *CreateScheduleRequest) GetSpec() *ScheduleSpec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *CreateScheduleRequest) GetAction() *ScheduleAction {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (m *CreateScheduleRequest) GetPolicies() *SchedulePolicies {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+func (m *CreateScheduleRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *CreateScheduleRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type CreateScheduleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateScheduleResponse) Reset()         { *m = CreateScheduleResponse{} }
+func (m *CreateScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateScheduleResponse) ProtoMessage()    {}
+func (*CreateScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{27}
+}
+func (m *CreateScheduleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetClusterInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CreateScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetClusterInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CreateScheduleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1545,50 +1828,38 @@ func (m *GetClusterInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *GetClusterInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetClusterInfoResponse.Merge(m, src)
+func (m *CreateScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateScheduleResponse.Merge(m, src)
 }
-func (m *GetClusterInfoResponse) XXX_Size() int {
+func (m *CreateScheduleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetClusterInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetClusterInfoResponse.DiscardUnknown(m)
+func (m *CreateScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateScheduleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetClusterInfoResponse proto.InternalMessageInfo
-
-func (m *GetClusterInfoResponse) GetSupportedClientVersions() *SupportedClientVersions {
-	if m != nil {
-		return m.SupportedClientVersions
-	}
-	return nil
-}
+var xxx_messageInfo_CreateScheduleResponse proto.InternalMessageInfo
 
-type GetWorkflowExecutionHistoryRequest struct {
-	Domain                 string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	WorkflowExecution      *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	PageSize               int32              `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken          []byte             `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	WaitForNewEvent        bool               `protobuf:"varint,5,opt,name=wait_for_new_event,json=waitForNewEvent,proto3" json:"wait_for_new_event,omitempty"`
-	HistoryEventFilterType EventFilterType    `protobuf:"varint,6,opt,name=history_event_filter_type,json=historyEventFilterType,proto3,enum=uber.cadence.api.v1.EventFilterType" json:"history_event_filter_type,omitempty"`
-	SkipArchival           bool               `protobuf:"varint,7,opt,name=skip_archival,json=skipArchival,proto3" json:"skip_archival,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}           `json:"-"`
-	XXX_unrecognized       []byte             `json:"-"`
-	XXX_sizecache          int32              `json:"-"`
+type DescribeScheduleRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	ScheduleId           string   `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetWorkflowExecutionHistoryRequest) Reset()         { *m = GetWorkflowExecutionHistoryRequest{} }
-func (m *GetWorkflowExecutionHistoryRequest) String() string { return proto.CompactTextString(m) }
-func (*GetWorkflowExecutionHistoryRequest) ProtoMessage()    {}
-func (*GetWorkflowExecutionHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{24}
+func (m *DescribeScheduleRequest) Reset()         { *m = DescribeScheduleRequest{} }
+func (m *DescribeScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeScheduleRequest) ProtoMessage()    {}
+func (*DescribeScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{28}
 }
-func (m *GetWorkflowExecutionHistoryRequest) XXX_Unmarshal(b []byte) error {
+func (m *DescribeScheduleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetWorkflowExecutionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DescribeScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetWorkflowExecutionHistoryRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DescribeScheduleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1598,89 +1869,136 @@ func (m *GetWorkflowExecutionHistoryRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *GetWorkflowExecutionHistoryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetWorkflowExecutionHistoryRequest.Merge(m, src)
+func (m *DescribeScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeScheduleRequest.Merge(m, src)
 }
-func (m *GetWorkflowExecutionHistoryRequest) XXX_Size() int {
+func (m *DescribeScheduleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetWorkflowExecutionHistoryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetWorkflowExecutionHistoryRequest.DiscardUnknown(m)
+func (m *DescribeScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeScheduleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetWorkflowExecutionHistoryRequest proto.InternalMessageInfo
+var xxx_messageInfo_DescribeScheduleRequest proto.InternalMessageInfo
 
-func (m *GetWorkflowExecutionHistoryRequest) GetDomain() string {
+func (m *DescribeScheduleRequest) GetDomain() string {
 	if m != nil {
 		return m.Domain
 	}
 	return ""
 }
 
-func (m *GetWorkflowExecutionHistoryRequest) GetWorkflowExecution() *WorkflowExecution {
+func (m *DescribeScheduleRequest) GetScheduleId() string {
 	if m != nil {
-		return m.WorkflowExecution
+		return m.ScheduleId
 	}
-	return nil
+	return ""
 }
 
-func (m *GetWorkflowExecutionHistoryRequest) GetPageSize() int32 {
+type DescribeScheduleResponse struct {
+	Spec                 *ScheduleSpec     `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	Action               *ScheduleAction   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Policies             *SchedulePolicies `protobuf:"bytes,3,opt,name=policies,proto3" json:"policies,omitempty"`
+	State                *ScheduleState    `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Info                 *ScheduleInfo     `protobuf:"bytes,5,opt,name=info,proto3" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DescribeScheduleResponse) Reset()         { *m = DescribeScheduleResponse{} }
+func (m *DescribeScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeScheduleResponse) ProtoMessage()    {}
+func (*DescribeScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{29}
+}
+func (m *DescribeScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeScheduleResponse.Merge(m, src)
+}
+func (m *DescribeScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeScheduleResponse proto.InternalMessageInfo
+
+func (m *DescribeScheduleResponse) GetSpec() *ScheduleSpec {
 	if m != nil {
-		return m.PageSize
+		return m.Spec
 	}
-	return 0
+	return nil
 }
 
-func (m *GetWorkflowExecutionHistoryRequest) GetNextPageToken() []byte {
+func (m *DescribeScheduleResponse) GetAction() *ScheduleAction {
 	if m != nil {
-		return m.NextPageToken
+		return m.Action
 	}
 	return nil
 }
 
-func (m *GetWorkflowExecutionHistoryRequest) GetWaitForNewEvent() bool {
+func (m *DescribeScheduleResponse) GetPolicies() *SchedulePolicies {
 	if m != nil {
-		return m.WaitForNewEvent
+		return m.Policies
 	}
-	return false
+	return nil
 }
 
-func (m *GetWorkflowExecutionHistoryRequest) GetHistoryEventFilterType() EventFilterType {
+func (m *DescribeScheduleResponse) GetState() *ScheduleState {
 	if m != nil {
-		return m.HistoryEventFilterType
+		return m.State
 	}
-	return EventFilterType_EVENT_FILTER_TYPE_INVALID
+	return nil
 }
 
-func (m *GetWorkflowExecutionHistoryRequest) GetSkipArchival() bool {
+func (m *DescribeScheduleResponse) GetInfo() *ScheduleInfo {
 	if m != nil {
-		return m.SkipArchival
+		return m.Info
 	}
-	return false
+	return nil
 }
 
-type GetWorkflowExecutionHistoryResponse struct {
-	History              *History    `protobuf:"bytes,1,opt,name=history,proto3" json:"history,omitempty"`
-	RawHistory           []*DataBlob `protobuf:"bytes,2,rep,name=raw_history,json=rawHistory,proto3" json:"raw_history,omitempty"`
-	NextPageToken        []byte      `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	Archived             bool        `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type UpdateScheduleRequest struct {
+	Domain               string            `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	ScheduleId           string            `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Spec                 *ScheduleSpec     `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	Action               *ScheduleAction   `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Policies             *SchedulePolicies `protobuf:"bytes,5,opt,name=policies,proto3" json:"policies,omitempty"`
+	Identity             string            `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string            `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetWorkflowExecutionHistoryResponse) Reset()         { *m = GetWorkflowExecutionHistoryResponse{} }
-func (m *GetWorkflowExecutionHistoryResponse) String() string { return proto.CompactTextString(m) }
-func (*GetWorkflowExecutionHistoryResponse) ProtoMessage()    {}
-func (*GetWorkflowExecutionHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{25}
+func (m *UpdateScheduleRequest) Reset()         { *m = UpdateScheduleRequest{} }
+func (m *UpdateScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateScheduleRequest) ProtoMessage()    {}
+func (*UpdateScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{30}
 }
-func (m *GetWorkflowExecutionHistoryResponse) XXX_Unmarshal(b []byte) error {
+func (m *UpdateScheduleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetWorkflowExecutionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetWorkflowExecutionHistoryResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateScheduleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1690,65 +2008,85 @@ func (m *GetWorkflowExecutionHistoryResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *GetWorkflowExecutionHistoryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetWorkflowExecutionHistoryResponse.Merge(m, src)
+func (m *UpdateScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateScheduleRequest.Merge(m, src)
 }
-func (m *GetWorkflowExecutionHistoryResponse) XXX_Size() int {
+func (m *UpdateScheduleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetWorkflowExecutionHistoryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetWorkflowExecutionHistoryResponse.DiscardUnknown(m)
+func (m *UpdateScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateScheduleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetWorkflowExecutionHistoryResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateScheduleRequest proto.InternalMessageInfo
 
-func (m *GetWorkflowExecutionHistoryResponse) GetHistory() *History {
+func (m *UpdateScheduleRequest) GetDomain() string {
 	if m != nil {
-		return m.History
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *UpdateScheduleRequest) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
+func (m *UpdateScheduleRequest) GetSpec() *ScheduleSpec {
+	if m != nil {
+		return m.Spec
 	}
 	return nil
 }
 
-func (m *GetWorkflowExecutionHistoryResponse) GetRawHistory() []*DataBlob {
+func (m *UpdateScheduleRequest) GetAction() *ScheduleAction {
 	if m != nil {
-		return m.RawHistory
+		return m.Action
 	}
 	return nil
 }
 
-func (m *GetWorkflowExecutionHistoryResponse) GetNextPageToken() []byte {
+func (m *UpdateScheduleRequest) GetPolicies() *SchedulePolicies {
 	if m != nil {
-		return m.NextPageToken
+		return m.Policies
 	}
 	return nil
 }
 
-func (m *GetWorkflowExecutionHistoryResponse) GetArchived() bool {
+func (m *UpdateScheduleRequest) GetIdentity() string {
 	if m != nil {
-		return m.Archived
+		return m.Identity
 	}
-	return false
+	return ""
 }
 
-type FeatureFlags struct {
-	WorkflowExecutionAlreadyCompletedErrorEnabled bool     `protobuf:"varint,1,opt,name=workflow_execution_already_completed_error_enabled,json=workflowExecutionAlreadyCompletedErrorEnabled,proto3" json:"workflow_execution_already_completed_error_enabled,omitempty"`
-	XXX_NoUnkeyedLiteral                          struct{} `json:"-"`
-	XXX_unrecognized                              []byte   `json:"-"`
-	XXX_sizecache                                 int32    `json:"-"`
+func (m *UpdateScheduleRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
 }
 
-func (m *FeatureFlags) Reset()         { *m = FeatureFlags{} }
-func (m *FeatureFlags) String() string { return proto.CompactTextString(m) }
-func (*FeatureFlags) ProtoMessage()    {}
-func (*FeatureFlags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{26}
+type UpdateScheduleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *FeatureFlags) XXX_Unmarshal(b []byte) error {
+
+func (m *UpdateScheduleResponse) Reset()         { *m = UpdateScheduleResponse{} }
+func (m *UpdateScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateScheduleResponse) ProtoMessage()    {}
+func (*UpdateScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{31}
+}
+func (m *UpdateScheduleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FeatureFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FeatureFlags.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateScheduleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1758,2398 +2096,10574 @@ func (m *FeatureFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *FeatureFlags) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FeatureFlags.Merge(m, src)
+func (m *UpdateScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateScheduleResponse.Merge(m, src)
 }
-func (m *FeatureFlags) XXX_Size() int {
+func (m *UpdateScheduleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *FeatureFlags) XXX_DiscardUnknown() {
-	xxx_messageInfo_FeatureFlags.DiscardUnknown(m)
+func (m *UpdateScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateScheduleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FeatureFlags proto.InternalMessageInfo
+var xxx_messageInfo_UpdateScheduleResponse proto.InternalMessageInfo
 
-func (m *FeatureFlags) GetWorkflowExecutionAlreadyCompletedErrorEnabled() bool {
+type PauseScheduleRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	ScheduleId           string   `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity             string   `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string   `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseScheduleRequest) Reset()         { *m = PauseScheduleRequest{} }
+func (m *PauseScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseScheduleRequest) ProtoMessage()    {}
+func (*PauseScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{32}
+}
+func (m *PauseScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseScheduleRequest.Merge(m, src)
+}
+func (m *PauseScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseScheduleRequest proto.InternalMessageInfo
+
+func (m *PauseScheduleRequest) GetDomain() string {
 	if m != nil {
-		return m.WorkflowExecutionAlreadyCompletedErrorEnabled
+		return m.Domain
 	}
-	return false
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.StartWorkflowExecutionRequest")
-	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.StartWorkflowExecutionResponse")
-	proto.RegisterType((*SignalWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.SignalWorkflowExecutionRequest")
-	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.SignalWorkflowExecutionResponse")
-	proto.RegisterType((*SignalWithStartWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.SignalWithStartWorkflowExecutionRequest")
-	proto.RegisterType((*SignalWithStartWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.SignalWithStartWorkflowExecutionResponse")
-	proto.RegisterType((*ResetWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.ResetWorkflowExecutionRequest")
-	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.ResetWorkflowExecutionResponse")
-	proto.RegisterType((*RequestCancelWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.RequestCancelWorkflowExecutionRequest")
-	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.RequestCancelWorkflowExecutionResponse")
-	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.TerminateWorkflowExecutionRequest")
-	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.TerminateWorkflowExecutionResponse")
-	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.DescribeWorkflowExecutionRequest")
-	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.DescribeWorkflowExecutionResponse")
-	proto.RegisterType((*QueryWorkflowRequest)(nil), "uber.cadence.api.v1.QueryWorkflowRequest")
-	proto.RegisterType((*QueryWorkflowResponse)(nil), "uber.cadence.api.v1.QueryWorkflowResponse")
-	proto.RegisterType((*DescribeTaskListRequest)(nil), "uber.cadence.api.v1.DescribeTaskListRequest")
-	proto.RegisterType((*DescribeTaskListResponse)(nil), "uber.cadence.api.v1.DescribeTaskListResponse")
-	proto.RegisterType((*GetTaskListsByDomainRequest)(nil), "uber.cadence.api.v1.GetTaskListsByDomainRequest")
-	proto.RegisterType((*GetTaskListsByDomainResponse)(nil), "uber.cadence.api.v1.GetTaskListsByDomainResponse")
-	proto.RegisterType((*ListTaskListPartitionsRequest)(nil), "uber.cadence.api.v1.ListTaskListPartitionsRequest")
-	proto.RegisterType((*ListTaskListPartitionsResponse)(nil), "uber.cadence.api.v1.ListTaskListPartitionsResponse")
-	proto.RegisterType((*GetClusterInfoRequest)(nil), "uber.cadence.api.v1.GetClusterInfoRequest")
-	proto.RegisterType((*GetClusterInfoResponse)(nil), "uber.cadence.api.v1.GetClusterInfoResponse")
-	proto.RegisterType((*GetWorkflowExecutionHistoryRequest)(nil), "uber.cadence.api.v1.GetWorkflowExecutionHistoryRequest")
-	proto.RegisterType((*GetWorkflowExecutionHistoryResponse)(nil), "uber.cadence.api.v1.GetWorkflowExecutionHistoryResponse")
-	proto.RegisterType((*FeatureFlags)(nil), "uber.cadence.api.v1.FeatureFlags")
+func (m *PauseScheduleRequest) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("uber/cadence/api/v1/service_workflow.proto", fileDescriptor_674d14d2fee4e473)
+func (m *PauseScheduleRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-var fileDescriptor_674d14d2fee4e473 = []byte{
-	// 2063 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x5f, 0x73, 0x1b, 0x49,
-	0x11, 0xaf, 0xf5, 0x5f, 0xb9, 0x25, 0x39, 0xc9, 0x5c, 0x6c, 0x6f, 0x94, 0xd8, 0x71, 0x36, 0x77,
-	0xc1, 0xe4, 0x2e, 0x32, 0x71, 0xb8, 0xe4, 0xc8, 0x01, 0x57, 0x8e, 0x12, 0xe7, 0x5c, 0x75, 0xb9,
-	0x32, 0x6b, 0x43, 0x0a, 0x5e, 0xb6, 0x46, 0xbb, 0x6d, 0x69, 0xf0, 0x6a, 0x77, 0x3d, 0x33, 0x6b,
-	0x9d, 0x8e, 0x27, 0xa8, 0x2b, 0x1e, 0xa0, 0xa8, 0x82, 0x47, 0x9e, 0x78, 0x80, 0x67, 0x3e, 0x06,
-	0x45, 0xf1, 0x02, 0x7c, 0x03, 0xc8, 0x17, 0xe0, 0x1b, 0x5c, 0x51, 0x3b, 0x3b, 0x2b, 0x59, 0xf2,
-	0x6a, 0x65, 0x87, 0xa2, 0x2e, 0x75, 0x6f, 0xda, 0x9e, 0xee, 0x5f, 0x4f, 0xff, 0x99, 0x9e, 0xee,
-	0x11, 0xdc, 0x8d, 0x9b, 0xc8, 0x37, 0x5d, 0xea, 0x61, 0xe0, 0xe2, 0x26, 0x8d, 0xd8, 0xe6, 0xc9,
-	0xfd, 0x4d, 0x81, 0xfc, 0x84, 0xb9, 0xe8, 0x74, 0x43, 0x7e, 0x74, 0xe8, 0x87, 0xdd, 0x7a, 0xc4,
-	0x43, 0x19, 0x92, 0xb7, 0x12, 0xde, 0xba, 0xe6, 0xad, 0xd3, 0x88, 0xd5, 0x4f, 0xee, 0xd7, 0xd6,
-	0x5a, 0x61, 0xd8, 0xf2, 0x71, 0x53, 0xb1, 0x34, 0xe3, 0xc3, 0x4d, 0x2f, 0xe6, 0x54, 0xb2, 0x30,
-	0x48, 0x85, 0x6a, 0xeb, 0x79, 0x0a, 0xdc, 0xb0, 0xd3, 0xe9, 0x73, 0xdc, 0xca, 0xe3, 0x68, 0x33,
-	0x21, 0x43, 0xde, 0xd3, 0x2c, 0x37, 0xf3, 0x58, 0x8e, 0x63, 0xec, 0x33, 0x58, 0x79, 0x0c, 0x92,
-	0x8a, 0x23, 0x9f, 0x09, 0x59, 0xc4, 0x33, 0x6c, 0xa2, 0xf5, 0xb7, 0x79, 0x58, 0xdd, 0x97, 0x94,
-	0xcb, 0x97, 0x9a, 0xfe, 0xec, 0x33, 0x74, 0xe3, 0xc4, 0x1c, 0x1b, 0x8f, 0x63, 0x14, 0x92, 0x2c,
-	0xc3, 0x9c, 0x17, 0x76, 0x28, 0x0b, 0x4c, 0x63, 0xdd, 0xd8, 0x58, 0xb0, 0xf5, 0x17, 0xb9, 0x09,
-	0xe5, 0x0c, 0xcb, 0x61, 0x9e, 0x39, 0xa5, 0x16, 0x21, 0x23, 0xed, 0x7a, 0x64, 0x07, 0xaa, 0x7d,
-	0x06, 0xd9, 0x8b, 0xd0, 0x9c, 0x5e, 0x37, 0x36, 0xca, 0x5b, 0xb7, 0xea, 0x39, 0x5e, 0xad, 0x67,
-	0xea, 0x0f, 0x7a, 0x11, 0xda, 0x95, 0xee, 0xa9, 0x2f, 0xf2, 0x18, 0x16, 0x12, 0xc3, 0x9c, 0xc4,
-	0x32, 0x73, 0x46, 0x61, 0xac, 0xe6, 0x62, 0x1c, 0x50, 0x71, 0xf4, 0x09, 0x13, 0xd2, 0x2e, 0x49,
-	0xfd, 0x8b, 0x6c, 0xc1, 0x2c, 0x0b, 0xa2, 0x58, 0x9a, 0xb3, 0x4a, 0xee, 0x46, 0xae, 0xdc, 0x1e,
-	0xed, 0xf9, 0x21, 0xf5, 0xec, 0x94, 0x95, 0x50, 0x58, 0xc7, 0xcc, 0x09, 0x8e, 0x48, 0x7c, 0xe3,
-	0xc8, 0xd0, 0x71, 0xfd, 0x50, 0xa0, 0x23, 0x59, 0x07, 0xc3, 0x58, 0x9a, 0x73, 0x0a, 0xee, 0x5a,
-	0x3d, 0xcd, 0x85, 0x7a, 0x96, 0x0b, 0xf5, 0xa7, 0x3a, 0x17, 0xec, 0x1b, 0x7d, 0x08, 0xe5, 0xdd,
-	0x83, 0xb0, 0x91, 0xc8, 0x1f, 0xa4, 0xe2, 0xe4, 0x25, 0x5c, 0x57, 0x26, 0x8d, 0x41, 0x9f, 0x9f,
-	0x84, 0xbe, 0x92, 0x48, 0xe7, 0x01, 0xd7, 0xa0, 0xc4, 0x3c, 0x0c, 0x24, 0x93, 0x3d, 0xb3, 0xa4,
-	0x22, 0xd2, 0xff, 0x26, 0xab, 0x00, 0x3c, 0x8d, 0x69, 0x12, 0xaf, 0x05, 0xb5, 0xba, 0xa0, 0x29,
-	0xbb, 0x1e, 0x71, 0xc1, 0x3c, 0x15, 0x4f, 0x87, 0x63, 0x2c, 0xd0, 0x89, 0x42, 0x9f, 0xb9, 0x3d,
-	0x13, 0xd6, 0x8d, 0x8d, 0xc5, 0xad, 0xbb, 0x85, 0x91, 0xdb, 0xf5, 0xec, 0x44, 0x64, 0x4f, 0x49,
-	0xd8, 0x4b, 0xdd, 0x3c, 0x32, 0x69, 0x40, 0x85, 0xa3, 0xe4, 0xbd, 0x0c, 0xb8, 0xac, 0x2c, 0x5d,
-	0xcf, 0x05, 0xb6, 0x13, 0x46, 0x0d, 0x57, 0xe6, 0x83, 0x0f, 0x72, 0x1b, 0xaa, 0x2e, 0x4f, 0x62,
-	0xe3, 0xb6, 0xd1, 0x8b, 0x7d, 0x34, 0x2b, 0xca, 0x96, 0x4a, 0x42, 0xdc, 0xd7, 0x34, 0x72, 0x0f,
-	0x66, 0x3a, 0xd8, 0x09, 0xcd, 0xaa, 0xf6, 0x65, 0x9e, 0x86, 0x17, 0xd8, 0x09, 0x6d, 0xc5, 0x46,
-	0x6c, 0xb8, 0x22, 0x90, 0x72, 0xb7, 0xed, 0x50, 0x29, 0x39, 0x6b, 0xc6, 0x12, 0x85, 0xb9, 0xa8,
-	0x64, 0xdf, 0xc9, 0x95, 0xdd, 0x57, 0xdc, 0xdb, 0x7d, 0x66, 0xfb, 0xb2, 0x18, 0xa1, 0x90, 0x07,
-	0x30, 0xd7, 0x46, 0xea, 0x21, 0x37, 0x2f, 0x29, 0xa0, 0xeb, 0xb9, 0x40, 0x1f, 0x2b, 0x16, 0x5b,
-	0xb3, 0x92, 0xc7, 0x50, 0xf6, 0xd0, 0xa7, 0xbd, 0x34, 0x37, 0xcc, 0xcb, 0x93, 0x52, 0x01, 0x14,
-	0xb7, 0xca, 0x05, 0xeb, 0x11, 0xac, 0x8d, 0x3b, 0xcb, 0x22, 0x0a, 0x03, 0x81, 0x64, 0x09, 0xe6,
-	0x78, 0x1c, 0x24, 0xf1, 0x4f, 0x0f, 0xf3, 0x2c, 0x8f, 0x83, 0x5d, 0xcf, 0xfa, 0xcb, 0x14, 0xac,
-	0xed, 0xb3, 0x56, 0x40, 0xfd, 0x0b, 0x97, 0x81, 0x1f, 0x02, 0xe9, 0xa7, 0x4d, 0x3f, 0xe7, 0x55,
-	0x35, 0x28, 0x6f, 0xdd, 0x29, 0x4c, 0x98, 0x81, 0x8a, 0x2b, 0xdd, 0x51, 0xd2, 0x50, 0x22, 0x4f,
-	0x17, 0x26, 0xf2, 0xcc, 0x68, 0x22, 0xdf, 0x84, 0xb2, 0x50, 0xb6, 0x38, 0x01, 0xed, 0xa0, 0x3a,
-	0xf9, 0x0b, 0x36, 0xa4, 0xa4, 0x4f, 0x69, 0x07, 0xc9, 0x47, 0x50, 0xd1, 0x0c, 0x69, 0x6d, 0x98,
-	0x3b, 0x47, 0x6d, 0xd0, 0x90, 0xbb, 0xaa, 0x42, 0x98, 0x30, 0xef, 0x86, 0x81, 0xe4, 0xa1, 0xaf,
-	0x8e, 0x6a, 0xc5, 0xce, 0x3e, 0xad, 0x5b, 0x70, 0x73, 0xac, 0x1f, 0xd3, 0x10, 0x58, 0x5f, 0x1a,
-	0xf0, 0x0d, 0xcd, 0xc3, 0x64, 0xbb, 0xb8, 0xf6, 0xbe, 0x84, 0x6a, 0x5a, 0x22, 0xb4, 0x75, 0xca,
-	0xf7, 0xe5, 0xad, 0xad, 0xfc, 0x8c, 0x2c, 0x82, 0xb2, 0x2b, 0x0a, 0x28, 0x03, 0x1e, 0xf1, 0xd1,
-	0xd4, 0x44, 0x1f, 0x4d, 0xff, 0x0f, 0x3e, 0x9a, 0x19, 0xf6, 0xd1, 0x36, 0x6c, 0x4c, 0xb6, 0xbf,
-	0x38, 0x5f, 0xff, 0x3c, 0x05, 0xab, 0x36, 0x0a, 0x94, 0x6f, 0x4a, 0xba, 0x2e, 0xc3, 0x1c, 0x47,
-	0x2a, 0xc2, 0x40, 0x27, 0xab, 0xfe, 0x22, 0x8f, 0xc0, 0xf4, 0xd0, 0x65, 0x22, 0xb9, 0x4a, 0x0e,
-	0x59, 0xc0, 0x44, 0xdb, 0xc1, 0x13, 0x0c, 0xfa, 0x89, 0x3b, 0x6d, 0x2f, 0x65, 0xeb, 0x3b, 0x6a,
-	0xf9, 0x59, 0xb2, 0xba, 0xeb, 0x8d, 0xe4, 0xf8, 0xec, 0x68, 0x8e, 0xd7, 0xe1, 0x2d, 0x71, 0xc4,
-	0x22, 0x47, 0xc7, 0x88, 0x23, 0x8d, 0x22, 0xbf, 0xa7, 0x32, 0xb9, 0x64, 0x5f, 0x49, 0x96, 0x52,
-	0x17, 0xdb, 0xe9, 0x42, 0x52, 0x19, 0xc6, 0xf9, 0xab, 0xd8, 0xd3, 0xff, 0x34, 0xe0, 0x1d, 0xed,
-	0xd3, 0x06, 0x0d, 0x5c, 0xfc, 0x1a, 0x14, 0x08, 0x6b, 0x03, 0xee, 0x4c, 0x32, 0x69, 0x70, 0x56,
-	0x6f, 0x1d, 0x20, 0xef, 0xb0, 0x80, 0x4a, 0x7c, 0xd3, 0x73, 0xed, 0x21, 0xcc, 0x7b, 0x28, 0x29,
-	0xf3, 0x85, 0xee, 0x92, 0x8a, 0x4f, 0x6b, 0xc6, 0x3c, 0xe4, 0xc9, 0xd9, 0x61, 0x4f, 0x5a, 0x6f,
-	0x83, 0x55, 0x64, 0xbf, 0x76, 0xd3, 0xef, 0x0c, 0x58, 0x7f, 0x8a, 0xc2, 0xe5, 0xac, 0xf9, 0xa6,
-	0x78, 0xc9, 0xfa, 0x72, 0x1a, 0x6e, 0x15, 0xec, 0x49, 0x67, 0xbd, 0x0f, 0x2b, 0x83, 0x5e, 0xcf,
-	0x0d, 0x83, 0x43, 0xd6, 0xd2, 0x17, 0xab, 0x2e, 0xb5, 0x0f, 0xce, 0xb7, 0x83, 0xc6, 0x69, 0x51,
-	0x7b, 0x19, 0x73, 0xe9, 0xa4, 0x09, 0x2b, 0x67, 0x4d, 0x75, 0x58, 0x70, 0x18, 0x6a, 0x7b, 0xef,
-	0x9e, 0x4f, 0xdb, 0x6e, 0x70, 0x18, 0x0e, 0x3a, 0xac, 0x21, 0x32, 0x79, 0x09, 0x24, 0xc2, 0xc0,
-	0x63, 0x41, 0xcb, 0xa1, 0xae, 0x64, 0x27, 0x4c, 0x32, 0x14, 0xe6, 0xf4, 0xfa, 0xf4, 0x46, 0x79,
-	0x6b, 0x23, 0x3f, 0x21, 0x52, 0xf6, 0xed, 0x94, 0xbb, 0xa7, 0xc0, 0xaf, 0x44, 0x43, 0x44, 0x86,
-	0x82, 0xfc, 0x18, 0x2e, 0x67, 0xc0, 0x6e, 0x9b, 0xf9, 0x1e, 0xc7, 0xc0, 0x9c, 0x51, 0xb0, 0xf5,
-	0x22, 0xd8, 0x46, 0xc2, 0x3b, 0xbc, 0xf3, 0x4b, 0xd1, 0xa9, 0x25, 0x8e, 0x01, 0xd9, 0x1f, 0x40,
-	0x67, 0xd5, 0x50, 0x37, 0xec, 0x85, 0x3b, 0x7e, 0xaa, 0x79, 0x87, 0x40, 0x33, 0xa2, 0xf5, 0xc5,
-	0x34, 0x5c, 0xfd, 0x41, 0x32, 0x31, 0x65, 0xee, 0xfb, 0x8a, 0x8e, 0xeb, 0x07, 0x30, 0xab, 0x06,
-	0x37, 0x7d, 0x85, 0x5a, 0x85, 0x48, 0x6a, 0xc3, 0x76, 0x2a, 0x40, 0x1c, 0x58, 0x56, 0x3f, 0x1c,
-	0x8e, 0x3f, 0x45, 0x57, 0x26, 0xf9, 0xe9, 0x31, 0xb5, 0xa9, 0x19, 0xd5, 0x8f, 0x7f, 0x33, 0x17,
-	0x2a, 0x85, 0x50, 0x12, 0x8d, 0x4c, 0xc0, 0xbe, 0x7a, 0x9c, 0x43, 0x4d, 0xf2, 0x31, 0x55, 0xe0,
-	0x86, 0x81, 0x60, 0x42, 0x62, 0xe0, 0xf6, 0x1c, 0x1f, 0x4f, 0xd0, 0x57, 0xee, 0x1f, 0xd7, 0xf1,
-	0x2b, 0x0d, 0x8d, 0x81, 0xc8, 0x27, 0x89, 0x84, 0xbd, 0x74, 0x9c, 0x47, 0xb6, 0xfe, 0x68, 0xc0,
-	0xd2, 0x48, 0x18, 0xf4, 0xd9, 0xfb, 0x08, 0x2a, 0x99, 0x79, 0x22, 0xf6, 0xb3, 0xde, 0x66, 0x42,
-	0x8b, 0xa1, 0xed, 0x48, 0x04, 0xc8, 0x2e, 0x2c, 0x9e, 0xf6, 0x0f, 0x7a, 0x3a, 0x58, 0xd6, 0x24,
-	0xbf, 0xa0, 0x67, 0x57, 0x8f, 0x4f, 0x7f, 0x5a, 0xff, 0x31, 0x60, 0x25, 0xab, 0x16, 0xfd, 0x31,
-	0x72, 0x42, 0xbe, 0x0c, 0xcd, 0xa5, 0x53, 0x17, 0x9b, 0x4b, 0x9f, 0xc3, 0x62, 0x5f, 0x76, 0x30,
-	0x1c, 0x2f, 0x8e, 0x19, 0x8e, 0x33, 0x80, 0x74, 0x38, 0x96, 0xa7, 0xbe, 0x92, 0x06, 0x83, 0x05,
-	0xae, 0x1f, 0x7b, 0xe8, 0x0c, 0x00, 0x85, 0xa4, 0x32, 0x4e, 0x6f, 0x81, 0x92, 0xbd, 0xa4, 0xd7,
-	0x33, 0x90, 0x7d, 0xb5, 0x68, 0xfd, 0xc9, 0x00, 0xf3, 0xac, 0xc5, 0x3a, 0x34, 0xdf, 0x81, 0xf9,
-	0x28, 0xf4, 0x7d, 0xe4, 0xc2, 0x34, 0xd4, 0x11, 0xbf, 0x99, 0x1f, 0x15, 0xc5, 0xa3, 0x8e, 0x5f,
-	0xc6, 0x4f, 0x5e, 0xc0, 0xe5, 0x33, 0x1b, 0x49, 0x9d, 0x73, 0xbb, 0xd0, 0xb6, 0x74, 0x5b, 0xf6,
-	0xa2, 0x1c, 0xde, 0xe6, 0xfb, 0x70, 0xfd, 0x39, 0xca, 0x8c, 0x49, 0x3c, 0xe9, 0x3d, 0x55, 0xce,
-	0x9f, 0x10, 0x1b, 0x6b, 0x07, 0x6e, 0xe4, 0x8b, 0x69, 0x03, 0xef, 0xc0, 0xa5, 0xc1, 0x2e, 0x93,
-	0x16, 0x38, 0x35, 0x74, 0xc1, 0xae, 0x66, 0xfa, 0x93, 0x2e, 0x58, 0x58, 0x02, 0x56, 0x95, 0xab,
-	0x35, 0x71, 0x8f, 0x72, 0xa9, 0x8e, 0x8e, 0xf8, 0x3f, 0x26, 0x87, 0xf5, 0xcb, 0x29, 0x58, 0x1b,
-	0xa7, 0x55, 0xef, 0xff, 0x18, 0x56, 0x75, 0x75, 0xef, 0x9d, 0x8a, 0x7b, 0xd4, 0x67, 0xd4, 0x61,
-	0xab, 0x17, 0xaa, 0xec, 0xe3, 0xbe, 0x40, 0x49, 0x3d, 0x2a, 0xa9, 0x5d, 0xcb, 0x40, 0xcf, 0xaa,
-	0x4e, 0x54, 0xf6, 0x5b, 0xd9, 0x5c, 0x95, 0x53, 0xaf, 0xa7, 0x32, 0x03, 0x3d, 0xab, 0xd2, 0x5a,
-	0x81, 0xa5, 0xe7, 0x28, 0x1b, 0x7e, 0x2c, 0xa4, 0x4e, 0xb3, 0xd4, 0xeb, 0xd6, 0x2f, 0x0c, 0x58,
-	0x1e, 0x5d, 0xd1, 0x9e, 0x69, 0xc3, 0x35, 0x11, 0x47, 0x51, 0xc8, 0x25, 0x7a, 0x8e, 0xeb, 0xb3,
-	0xa4, 0xd9, 0x3e, 0x41, 0x2e, 0xb4, 0x57, 0x92, 0x40, 0xbc, 0x97, 0x3f, 0x3e, 0x65, 0x52, 0x0d,
-	0x25, 0xf4, 0x23, 0x2d, 0x63, 0xaf, 0x88, 0xfc, 0x05, 0xeb, 0xd7, 0xd3, 0x60, 0x3d, 0xcf, 0x69,
-	0xa9, 0x3f, 0x4e, 0x5f, 0xf2, 0xbe, 0xa2, 0xeb, 0xe6, 0x3a, 0x2c, 0x44, 0xb4, 0x85, 0x8e, 0x60,
-	0x9f, 0xa7, 0x45, 0x65, 0xd6, 0x2e, 0x25, 0x84, 0x7d, 0xf6, 0xb9, 0x4a, 0xfb, 0x00, 0x3f, 0x4b,
-	0xa2, 0xd6, 0x42, 0x47, 0x86, 0x47, 0x18, 0xe8, 0xe1, 0xac, 0x9a, 0x90, 0xf7, 0x68, 0x0b, 0x0f,
-	0x12, 0x22, 0x79, 0x17, 0x48, 0x97, 0x32, 0xe9, 0x1c, 0x86, 0xdc, 0x09, 0xb0, 0x9b, 0xce, 0x2c,
-	0xea, 0x4e, 0x28, 0xd9, 0x97, 0x92, 0x95, 0x9d, 0x90, 0x7f, 0x8a, 0x5d, 0x35, 0xac, 0x10, 0x07,
-	0xae, 0xe9, 0xc7, 0x4b, 0x3d, 0xdb, 0x1c, 0x32, 0x5f, 0x22, 0x4f, 0xcb, 0xda, 0x9c, 0x2a, 0x6b,
-	0x6f, 0xe7, 0xda, 0xa3, 0xc4, 0x77, 0x14, 0xb3, 0xaa, 0x6c, 0xcb, 0x1a, 0x66, 0x84, 0x4e, 0x6e,
-	0x43, 0x55, 0x0d, 0x3b, 0x94, 0xbb, 0x6d, 0x76, 0x42, 0xd3, 0xa1, 0xbb, 0x64, 0x57, 0x12, 0xe2,
-	0xb6, 0xa6, 0x59, 0xff, 0x36, 0xe0, 0x76, 0x61, 0x34, 0x74, 0x7e, 0x3c, 0x84, 0x79, 0xad, 0xa6,
-	0xf0, 0xc2, 0xc9, 0xc4, 0x32, 0x66, 0xf2, 0x7d, 0x28, 0x73, 0xda, 0x75, 0x32, 0xd9, 0x34, 0xd9,
-	0xf3, 0x8f, 0xf4, 0x53, 0x2a, 0xe9, 0x13, 0x3f, 0x6c, 0xda, 0xc0, 0x69, 0x57, 0x03, 0xe5, 0xb9,
-	0x7e, 0x3a, 0xcf, 0xf5, 0x35, 0x28, 0xa5, 0x76, 0xa2, 0xa7, 0x0b, 0x78, 0xff, 0xdb, 0xea, 0x41,
-	0x65, 0x07, 0xa9, 0x8c, 0x39, 0xee, 0xf8, 0xb4, 0x25, 0x08, 0x83, 0xad, 0x9c, 0x7e, 0x92, 0xfa,
-	0x1c, 0xa9, 0x97, 0x5c, 0xea, 0x9d, 0xc8, 0xc7, 0xe4, 0x18, 0x20, 0xe7, 0x21, 0x77, 0x30, 0xa0,
-	0x4d, 0x1f, 0xd3, 0xf9, 0xae, 0x64, 0xdf, 0x3b, 0x93, 0x3a, 0xdb, 0xa9, 0x5c, 0x23, 0x13, 0x7b,
-	0x96, 0x48, 0x3d, 0x4b, 0x85, 0xb6, 0xfe, 0x5e, 0x85, 0x72, 0xe6, 0xdb, 0xed, 0xbd, 0x5d, 0xf2,
-	0x73, 0x03, 0x96, 0xf3, 0x67, 0x77, 0xf2, 0x1a, 0xaf, 0x13, 0xb5, 0x07, 0x17, 0x92, 0xd1, 0xa1,
-	0xfc, 0xc2, 0x80, 0x95, 0x31, 0xaf, 0x2d, 0x64, 0x0c, 0x60, 0xe1, 0x1b, 0x57, 0xed, 0xdb, 0x17,
-	0x13, 0xd2, 0xdb, 0xf8, 0x83, 0x01, 0xeb, 0x93, 0x1e, 0x34, 0xc8, 0x77, 0x8b, 0xa0, 0x27, 0xbd,
-	0x03, 0xd5, 0xbe, 0xf7, 0x9a, 0xd2, 0x7a, 0x87, 0x49, 0xb0, 0xf2, 0xc7, 0xff, 0x31, 0xc1, 0x2a,
-	0x7c, 0x5b, 0x19, 0x13, 0xac, 0x09, 0xef, 0x0b, 0xbf, 0x37, 0x60, 0xad, 0x78, 0xea, 0x26, 0x8f,
-	0xc7, 0xe0, 0x9e, 0xe3, 0xf5, 0xa1, 0xf6, 0xe1, 0x6b, 0xc9, 0xea, 0xbd, 0xfd, 0xc6, 0x80, 0xda,
-	0xf8, 0x31, 0x97, 0x3c, 0xcc, 0xbf, 0xd2, 0x26, 0xbd, 0x0b, 0xd4, 0x1e, 0x5d, 0x58, 0x4e, 0xef,
-	0xe7, 0x57, 0x06, 0x5c, 0x1b, 0x3b, 0xbb, 0x92, 0xf7, 0xf3, 0x8b, 0xce, 0x84, 0xf9, 0xbb, 0xf6,
-	0xf0, 0xa2, 0x62, 0x7a, 0x33, 0x87, 0x50, 0x1d, 0xea, 0xdf, 0x49, 0xc1, 0xd8, 0x31, 0x32, 0x6a,
-	0xd5, 0xee, 0x9e, 0x87, 0x55, 0xeb, 0x09, 0xe1, 0xf2, 0x68, 0x3f, 0x4a, 0xde, 0x2b, 0xdc, 0xf3,
-	0x48, 0xa3, 0x5e, 0xbb, 0x77, 0x4e, 0x6e, 0xad, 0xf0, 0x67, 0x70, 0x35, 0xaf, 0x47, 0x24, 0xdf,
-	0xca, 0x85, 0x29, 0xe8, 0x42, 0x6b, 0xf7, 0x2f, 0x20, 0x71, 0xea, 0x48, 0xe6, 0xf7, 0x78, 0x63,
-	0x8e, 0x64, 0x61, 0x1b, 0x3a, 0xe6, 0x48, 0x4e, 0x68, 0x22, 0x19, 0x2c, 0x0e, 0x37, 0x51, 0xe4,
-	0xee, 0x38, 0x43, 0xce, 0xf6, 0x60, 0xb5, 0x77, 0xcf, 0xc5, 0xab, 0x55, 0xfd, 0xd6, 0x50, 0x7d,
-	0xfc, 0xb8, 0xdb, 0x99, 0x3c, 0x1a, 0x07, 0x36, 0xa1, 0xbb, 0xaa, 0x7d, 0x70, 0x71, 0xc1, 0x74,
-	0x4b, 0x4f, 0x9a, 0x7f, 0x7d, 0xb5, 0x66, 0xfc, 0xe3, 0xd5, 0x9a, 0xf1, 0xaf, 0x57, 0x6b, 0x06,
-	0xac, 0xb8, 0x61, 0x27, 0x0f, 0xea, 0x49, 0x69, 0x3b, 0x62, 0x7b, 0x3c, 0x94, 0xe1, 0x9e, 0xf1,
-	0x93, 0xcd, 0x16, 0x93, 0xed, 0xb8, 0x59, 0x77, 0xc3, 0xce, 0xe6, 0xd0, 0x7f, 0xab, 0xf5, 0x16,
-	0x06, 0xe9, 0x1f, 0xc2, 0xfa, 0x6f, 0xd6, 0x0f, 0x69, 0xc4, 0x4e, 0xee, 0x37, 0xe7, 0x14, 0xed,
-	0xc1, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x3c, 0x6b, 0x73, 0x75, 0x1e, 0x00, 0x00,
+func (m *PauseScheduleRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
 }
 
-func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PauseScheduleRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *StartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PauseScheduleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.DelayStart != nil {
-		{
-			size, err := m.DelayStart.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+func (m *PauseScheduleResponse) Reset()         { *m = PauseScheduleResponse{} }
+func (m *PauseScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseScheduleResponse) ProtoMessage()    {}
+func (*PauseScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{33}
+}
+func (m *PauseScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+		return b[:n], nil
 	}
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+}
+func (m *PauseScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseScheduleResponse.Merge(m, src)
+}
+func (m *PauseScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseScheduleResponse proto.InternalMessageInfo
+
+type UnpauseScheduleRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	ScheduleId           string   `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity             string   `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string   `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnpauseScheduleRequest) Reset()         { *m = UnpauseScheduleRequest{} }
+func (m *UnpauseScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*UnpauseScheduleRequest) ProtoMessage()    {}
+func (*UnpauseScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{34}
+}
+func (m *UnpauseScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x7a
+		return b[:n], nil
 	}
-	if m.SearchAttributes != nil {
-		{
-			size, err := m.SearchAttributes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
+}
+func (m *UnpauseScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseScheduleRequest.Merge(m, src)
+}
+func (m *UnpauseScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpauseScheduleRequest proto.InternalMessageInfo
+
+func (m *UnpauseScheduleRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	if m.Memo != nil {
-		{
-			size, err := m.Memo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
+	return ""
+}
+
+func (m *UnpauseScheduleRequest) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
 	}
-	if len(m.CronSchedule) > 0 {
-		i -= len(m.CronSchedule)
-		copy(dAtA[i:], m.CronSchedule)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.CronSchedule)))
-		i--
-		dAtA[i] = 0x62
+	return ""
+}
+
+func (m *UnpauseScheduleRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if m.RetryPolicy != nil {
-		{
-			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.WorkflowIdReusePolicy != 0 {
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.WorkflowIdReusePolicy))
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RequestId)))
-		i--
-		dAtA[i] = 0x4a
+	return ""
+}
+
+func (m *UnpauseScheduleRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *UnpauseScheduleRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	if m.TaskStartToCloseTimeout != nil {
-		{
-			size, err := m.TaskStartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+	return ""
+}
+
+type UnpauseScheduleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnpauseScheduleResponse) Reset()         { *m = UnpauseScheduleResponse{} }
+func (m *UnpauseScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*UnpauseScheduleResponse) ProtoMessage()    {}
+func (*UnpauseScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{35}
+}
+func (m *UnpauseScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x3a
+		return b[:n], nil
 	}
-	if m.ExecutionStartToCloseTimeout != nil {
-		{
-			size, err := m.ExecutionStartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+}
+func (m *UnpauseScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseScheduleResponse.Merge(m, src)
+}
+func (m *UnpauseScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpauseScheduleResponse proto.InternalMessageInfo
+
+type BackfillScheduleRequest struct {
+	Domain               string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	ScheduleId           string                `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	StartTime            *types.Timestamp      `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              *types.Timestamp      `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	OverlapPolicy        ScheduleOverlapPolicy `protobuf:"varint,5,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=uber.cadence.api.v1.ScheduleOverlapPolicy" json:"overlap_policy,omitempty"`
+	Identity             string                `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string                `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *BackfillScheduleRequest) Reset()         { *m = BackfillScheduleRequest{} }
+func (m *BackfillScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*BackfillScheduleRequest) ProtoMessage()    {}
+func (*BackfillScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{36}
+}
+func (m *BackfillScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackfillScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackfillScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
+		return b[:n], nil
 	}
-	if m.Input != nil {
-		{
-			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *BackfillScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackfillScheduleRequest.Merge(m, src)
+}
+func (m *BackfillScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackfillScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackfillScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackfillScheduleRequest proto.InternalMessageInfo
+
+func (m *BackfillScheduleRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	if m.TaskList != nil {
-		{
-			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *BackfillScheduleRequest) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
 	}
-	if m.WorkflowType != nil {
-		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *BackfillScheduleRequest) GetStartTime() *types.Timestamp {
+	if m != nil {
+		return m.StartTime
 	}
-	if len(m.WorkflowId) > 0 {
-		i -= len(m.WorkflowId)
-		copy(dAtA[i:], m.WorkflowId)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.WorkflowId)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *BackfillScheduleRequest) GetEndTime() *types.Timestamp {
+	if m != nil {
+		return m.EndTime
 	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *BackfillScheduleRequest) GetOverlapPolicy() ScheduleOverlapPolicy {
+	if m != nil {
+		return m.OverlapPolicy
 	}
-	return len(dAtA) - i, nil
+	return ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_INVALID
 }
 
-func (m *StartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BackfillScheduleRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *StartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BackfillScheduleRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
 }
 
-func (m *StartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type BackfillScheduleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackfillScheduleResponse) Reset()         { *m = BackfillScheduleResponse{} }
+func (m *BackfillScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*BackfillScheduleResponse) ProtoMessage()    {}
+func (*BackfillScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{37}
+}
+func (m *BackfillScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackfillScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackfillScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *BackfillScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackfillScheduleResponse.Merge(m, src)
+}
+func (m *BackfillScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackfillScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackfillScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackfillScheduleResponse proto.InternalMessageInfo
+
+type ListSchedulesRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken        []byte   `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSchedulesRequest) Reset()         { *m = ListSchedulesRequest{} }
+func (m *ListSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSchedulesRequest) ProtoMessage()    {}
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{38}
+}
+func (m *ListSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ListSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSchedulesRequest.Merge(m, src)
+}
+func (m *ListSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSchedulesRequest.DiscardUnknown(m)
 }
 
-func (m *SignalWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListSchedulesRequest proto.InternalMessageInfo
+
+func (m *ListSchedulesRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SignalWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListSchedulesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
 }
 
-func (m *SignalWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Control) > 0 {
-		i -= len(m.Control)
-		copy(dAtA[i:], m.Control)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Control)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.SignalInput != nil {
-		{
-			size, err := m.SignalInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.SignalName) > 0 {
-		i -= len(m.SignalName)
-		copy(dAtA[i:], m.SignalName)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.SignalName)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RequestId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
+func (m *ListSchedulesRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SignalWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ListSchedulesResponse struct {
+	Schedules            []*ScheduleListEntry `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	NextPageToken        []byte               `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *SignalWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListSchedulesResponse) Reset()         { *m = ListSchedulesResponse{} }
+func (m *ListSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSchedulesResponse) ProtoMessage()    {}
+func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{39}
+}
+func (m *ListSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSchedulesResponse.Merge(m, src)
+}
+func (m *ListSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSchedulesResponse.DiscardUnknown(m)
 }
 
-func (m *SignalWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_ListSchedulesResponse proto.InternalMessageInfo
+
+func (m *ListSchedulesResponse) GetSchedules() []*ScheduleListEntry {
+	if m != nil {
+		return m.Schedules
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ListSchedulesResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TerminateWorkflowExecutionRequest struct {
+	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Reason               string             `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Details              *Payload           `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
+	Identity             string             `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Control) > 0 {
-		i -= len(m.Control)
-		copy(dAtA[i:], m.Control)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Control)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.SignalInput != nil {
-		{
-			size, err := m.SignalInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SignalName) > 0 {
-		i -= len(m.SignalName)
-		copy(dAtA[i:], m.SignalName)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.SignalName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.StartRequest != nil {
-		{
-			size, err := m.StartRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+func (m *TerminateWorkflowExecutionRequest) Reset()         { *m = TerminateWorkflowExecutionRequest{} }
+func (m *TerminateWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*TerminateWorkflowExecutionRequest) ProtoMessage()    {}
+func (*TerminateWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{40}
+}
+func (m *TerminateWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TerminateWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TerminateWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TerminateWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminateWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *TerminateWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TerminateWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminateWorkflowExecutionRequest.DiscardUnknown(m)
 }
 
-func (m *SignalWithStartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TerminateWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *TerminateWorkflowExecutionRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SignalWithStartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TerminateWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
 }
 
-func (m *SignalWithStartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0xa
+func (m *TerminateWorkflowExecutionRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ResetWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TerminateWorkflowExecutionRequest) GetDetails() *Payload {
+	if m != nil {
+		return m.Details
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ResetWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ResetWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.SkipSignalReapply {
-		i--
-		if m.SkipSignalReapply {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RequestId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.DecisionFinishEventId != 0 {
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.DecisionFinishEventId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
+func (m *TerminateWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ResetWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type TerminateWorkflowExecutionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResetWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TerminateWorkflowExecutionResponse) Reset()         { *m = TerminateWorkflowExecutionResponse{} }
+func (m *TerminateWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*TerminateWorkflowExecutionResponse) ProtoMessage()    {}
+func (*TerminateWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{41}
 }
-
-func (m *ResetWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *TerminateWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *RequestCancelWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TerminateWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TerminateWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *TerminateWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminateWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *TerminateWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TerminateWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminateWorkflowExecutionResponse.DiscardUnknown(m)
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_TerminateWorkflowExecutionResponse proto.InternalMessageInfo
+
+type DescribeWorkflowExecutionRequest struct {
+	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RequestId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+func (m *DescribeWorkflowExecutionRequest) Reset()         { *m = DescribeWorkflowExecutionRequest{} }
+func (m *DescribeWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeWorkflowExecutionRequest) ProtoMessage()    {}
+func (*DescribeWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{42}
+}
+func (m *DescribeWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *RequestCancelWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DescribeWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeWorkflowExecutionRequest.Merge(m, src)
 }
-
-func (m *RequestCancelWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DescribeWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeWorkflowExecutionRequest.DiscardUnknown(m)
 }
 
-func (m *RequestCancelWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_DescribeWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *DescribeWorkflowExecutionRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *TerminateWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DescribeWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TerminateWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DescribeWorkflowExecutionResponse struct {
+	ExecutionConfiguration *WorkflowExecutionConfiguration `protobuf:"bytes,1,opt,name=execution_configuration,json=executionConfiguration,proto3" json:"execution_configuration,omitempty"`
+	WorkflowExecutionInfo  *WorkflowExecutionInfo          `protobuf:"bytes,2,opt,name=workflow_execution_info,json=workflowExecutionInfo,proto3" json:"workflow_execution_info,omitempty"`
+	PendingActivities      []*PendingActivityInfo          `protobuf:"bytes,3,rep,name=pending_activities,json=pendingActivities,proto3" json:"pending_activities,omitempty"`
+	PendingChildren        []*PendingChildExecutionInfo    `protobuf:"bytes,4,rep,name=pending_children,json=pendingChildren,proto3" json:"pending_children,omitempty"`
+	PendingDecision        *PendingDecisionInfo            `protobuf:"bytes,5,opt,name=pending_decision,json=pendingDecision,proto3" json:"pending_decision,omitempty"`
+	SignalRequestIds       map[string]*types.Timestamp     `protobuf:"bytes,6,rep,name=signal_request_ids,json=signalRequestIds,proto3" json:"signal_request_ids,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral   struct{}                        `json:"-"`
+	XXX_unrecognized       []byte                          `json:"-"`
+	XXX_sizecache          int32                           `json:"-"`
 }
 
-func (m *TerminateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Details != nil {
-		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+func (m *DescribeWorkflowExecutionResponse) Reset()         { *m = DescribeWorkflowExecutionResponse{} }
+func (m *DescribeWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeWorkflowExecutionResponse) ProtoMessage()    {}
+func (*DescribeWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{43}
+}
+func (m *DescribeWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+}
+func (m *DescribeWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *DescribeWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeWorkflowExecutionResponse proto.InternalMessageInfo
+
+func (m *DescribeWorkflowExecutionResponse) GetExecutionConfiguration() *WorkflowExecutionConfiguration {
+	if m != nil {
+		return m.ExecutionConfiguration
 	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *DescribeWorkflowExecutionResponse) GetWorkflowExecutionInfo() *WorkflowExecutionInfo {
+	if m != nil {
+		return m.WorkflowExecutionInfo
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *TerminateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DescribeWorkflowExecutionResponse) GetPendingActivities() []*PendingActivityInfo {
+	if m != nil {
+		return m.PendingActivities
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TerminateWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DescribeWorkflowExecutionResponse) GetPendingChildren() []*PendingChildExecutionInfo {
+	if m != nil {
+		return m.PendingChildren
+	}
+	return nil
 }
 
-func (m *TerminateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DescribeWorkflowExecutionResponse) GetPendingDecision() *PendingDecisionInfo {
+	if m != nil {
+		return m.PendingDecision
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DescribeWorkflowExecutionResponse) GetSignalRequestIds() map[string]*types.Timestamp {
+	if m != nil {
+		return m.SignalRequestIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DescribeWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryWorkflowRequest struct {
+	Domain                string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution     *WorkflowExecution    `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Query                 *WorkflowQuery        `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	QueryRejectCondition  QueryRejectCondition  `protobuf:"varint,4,opt,name=query_reject_condition,json=queryRejectCondition,proto3,enum=uber.cadence.api.v1.QueryRejectCondition" json:"query_reject_condition,omitempty"`
+	QueryConsistencyLevel QueryConsistencyLevel `protobuf:"varint,5,opt,name=query_consistency_level,json=queryConsistencyLevel,proto3,enum=uber.cadence.api.v1.QueryConsistencyLevel" json:"query_consistency_level,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}              `json:"-"`
+	XXX_unrecognized      []byte                `json:"-"`
+	XXX_sizecache         int32                 `json:"-"`
 }
 
-func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+func (m *QueryWorkflowRequest) Reset()         { *m = QueryWorkflowRequest{} }
+func (m *QueryWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWorkflowRequest) ProtoMessage()    {}
+func (*QueryWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{44}
+}
+func (m *QueryWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWorkflowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWorkflowRequest.Merge(m, src)
+}
+func (m *QueryWorkflowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWorkflowRequest proto.InternalMessageInfo
+
+func (m *QueryWorkflowRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWorkflowRequest) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryWorkflowRequest) GetQuery() *WorkflowQuery {
+	if m != nil {
+		return m.Query
+	}
+	return nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *QueryWorkflowRequest) GetQueryRejectCondition() QueryRejectCondition {
+	if m != nil {
+		return m.QueryRejectCondition
 	}
-	if m.PendingDecision != nil {
-		{
-			size, err := m.PendingDecision.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	return QueryRejectCondition_QUERY_REJECT_CONDITION_INVALID
+}
+
+func (m *QueryWorkflowRequest) GetQueryConsistencyLevel() QueryConsistencyLevel {
+	if m != nil {
+		return m.QueryConsistencyLevel
 	}
-	if len(m.PendingChildren) > 0 {
-		for iNdEx := len(m.PendingChildren) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingChildren[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.PendingActivities) > 0 {
-		for iNdEx := len(m.PendingActivities) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingActivities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.WorkflowExecutionInfo != nil {
-		{
-			size, err := m.WorkflowExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ExecutionConfiguration != nil {
-		{
-			size, err := m.ExecutionConfiguration.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return QueryConsistencyLevel_QUERY_CONSISTENCY_LEVEL_INVALID
 }
 
-func (m *QueryWorkflowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type QueryWorkflowResponse struct {
+	QueryResult          *Payload       `protobuf:"bytes,1,opt,name=query_result,json=queryResult,proto3" json:"query_result,omitempty"`
+	QueryRejected        *QueryRejected `protobuf:"bytes,2,opt,name=query_rejected,json=queryRejected,proto3" json:"query_rejected,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *QueryWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryWorkflowResponse) Reset()         { *m = QueryWorkflowResponse{} }
+func (m *QueryWorkflowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWorkflowResponse) ProtoMessage()    {}
+func (*QueryWorkflowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{45}
 }
-
-func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.QueryConsistencyLevel != 0 {
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.QueryConsistencyLevel))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.QueryRejectCondition != 0 {
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.QueryRejectCondition))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Query != nil {
-		{
-			size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+func (m *QueryWorkflowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWorkflowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWorkflowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryWorkflowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWorkflowResponse.Merge(m, src)
+}
+func (m *QueryWorkflowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWorkflowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWorkflowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWorkflowResponse proto.InternalMessageInfo
+
+func (m *QueryWorkflowResponse) GetQueryResult() *Payload {
+	if m != nil {
+		return m.QueryResult
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryWorkflowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWorkflowResponse) GetQueryRejected() *QueryRejected {
+	if m != nil {
+		return m.QueryRejected
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DescribeTaskListRequest struct {
+	Domain                string       `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	TaskList              *TaskList    `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	TaskListType          TaskListType `protobuf:"varint,3,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	IncludeTaskListStatus bool         `protobuf:"varint,4,opt,name=include_task_list_status,json=includeTaskListStatus,proto3" json:"include_task_list_status,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}     `json:"-"`
+	XXX_unrecognized      []byte       `json:"-"`
+	XXX_sizecache         int32        `json:"-"`
 }
 
-func (m *QueryWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.QueryRejected != nil {
-		{
-			size, err := m.QueryRejected.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+func (m *DescribeTaskListRequest) Reset()         { *m = DescribeTaskListRequest{} }
+func (m *DescribeTaskListRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeTaskListRequest) ProtoMessage()    {}
+func (*DescribeTaskListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{46}
+}
+func (m *DescribeTaskListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeTaskListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeTaskListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.QueryResult != nil {
-		{
-			size, err := m.QueryResult.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *DescribeTaskListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeTaskListRequest.Merge(m, src)
+}
+func (m *DescribeTaskListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeTaskListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeTaskListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeTaskListRequest proto.InternalMessageInfo
+
+func (m *DescribeTaskListRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DescribeTaskListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DescribeTaskListRequest) GetTaskList() *TaskList {
+	if m != nil {
+		return m.TaskList
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DescribeTaskListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DescribeTaskListRequest) GetTaskListType() TaskListType {
+	if m != nil {
+		return m.TaskListType
+	}
+	return TaskListType_TASK_LIST_TYPE_INVALID
 }
 
-func (m *DescribeTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.IncludeTaskListStatus {
-		i--
-		if m.IncludeTaskListStatus {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TaskListType != 0 {
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.TaskListType))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.TaskList != nil {
-		{
-			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
+func (m *DescribeTaskListRequest) GetIncludeTaskListStatus() bool {
+	if m != nil {
+		return m.IncludeTaskListStatus
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *DescribeTaskListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type DescribeTaskListResponse struct {
+	Pollers              []*PollerInfo   `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
+	TaskListStatus       *TaskListStatus `protobuf:"bytes,2,opt,name=task_list_status,json=taskListStatus,proto3" json:"task_list_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *DescribeTaskListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DescribeTaskListResponse) Reset()         { *m = DescribeTaskListResponse{} }
+func (m *DescribeTaskListResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeTaskListResponse) ProtoMessage()    {}
+func (*DescribeTaskListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{47}
 }
-
-func (m *DescribeTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.TaskListStatus != nil {
-		{
-			size, err := m.TaskListStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pollers) > 0 {
-		for iNdEx := len(m.Pollers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pollers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *DescribeTaskListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeTaskListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeTaskListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GetTaskListsByDomainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DescribeTaskListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeTaskListResponse.Merge(m, src)
 }
-
-func (m *GetTaskListsByDomainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DescribeTaskListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeTaskListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeTaskListResponse.DiscardUnknown(m)
 }
 
-func (m *GetTaskListsByDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_DescribeTaskListResponse proto.InternalMessageInfo
+
+func (m *DescribeTaskListResponse) GetPollers() []*PollerInfo {
+	if m != nil {
+		return m.Pollers
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GetTaskListsByDomainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DescribeTaskListResponse) GetTaskListStatus() *TaskListStatus {
+	if m != nil {
+		return m.TaskListStatus
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetTaskListsByDomainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetTaskListsByDomainRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTaskListsByDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.TaskListNames) > 0 {
-		for iNdEx := len(m.TaskListNames) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TaskListNames[iNdEx])
-			copy(dAtA[i:], m.TaskListNames[iNdEx])
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.TaskListNames[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *GetTaskListsByDomainRequest) Reset()         { *m = GetTaskListsByDomainRequest{} }
+func (m *GetTaskListsByDomainRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTaskListsByDomainRequest) ProtoMessage()    {}
+func (*GetTaskListsByDomainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{48}
+}
+func (m *GetTaskListsByDomainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTaskListsByDomainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTaskListsByDomainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetTaskListsByDomainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskListsByDomainRequest.Merge(m, src)
+}
+func (m *GetTaskListsByDomainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTaskListsByDomainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskListsByDomainRequest.DiscardUnknown(m)
 }
 
-func (m *ListTaskListPartitionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetTaskListsByDomainRequest proto.InternalMessageInfo
+
+func (m *GetTaskListsByDomainRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ListTaskListPartitionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetTaskListsByDomainResponse struct {
+	TaskListNames        []string `protobuf:"bytes,1,rep,name=task_list_names,json=taskListNames,proto3" json:"task_list_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListTaskListPartitionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.TaskList != nil {
-		{
-			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
+func (m *GetTaskListsByDomainResponse) Reset()         { *m = GetTaskListsByDomainResponse{} }
+func (m *GetTaskListsByDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTaskListsByDomainResponse) ProtoMessage()    {}
+func (*GetTaskListsByDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{49}
+}
+func (m *GetTaskListsByDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTaskListsByDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTaskListsByDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetTaskListsByDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskListsByDomainResponse.Merge(m, src)
+}
+func (m *GetTaskListsByDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTaskListsByDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskListsByDomainResponse.DiscardUnknown(m)
 }
 
-func (m *ListTaskListPartitionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetTaskListsByDomainResponse proto.InternalMessageInfo
+
+func (m *GetTaskListsByDomainResponse) GetTaskListNames() []string {
+	if m != nil {
+		return m.TaskListNames
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ListTaskListPartitionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListTaskListPartitionsRequest struct {
+	Domain               string    `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	TaskList             *TaskList `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *ListTaskListPartitionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DecisionTaskListPartitions) > 0 {
-		for iNdEx := len(m.DecisionTaskListPartitions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DecisionTaskListPartitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.ActivityTaskListPartitions) > 0 {
-		for iNdEx := len(m.ActivityTaskListPartitions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ActivityTaskListPartitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ListTaskListPartitionsRequest) Reset()         { *m = ListTaskListPartitionsRequest{} }
+func (m *ListTaskListPartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTaskListPartitionsRequest) ProtoMessage()    {}
+func (*ListTaskListPartitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{50}
+}
+func (m *ListTaskListPartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListTaskListPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListTaskListPartitionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GetClusterInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ListTaskListPartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTaskListPartitionsRequest.Merge(m, src)
 }
-
-func (m *GetClusterInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListTaskListPartitionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListTaskListPartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTaskListPartitionsRequest.DiscardUnknown(m)
 }
 
-func (m *GetClusterInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_ListTaskListPartitionsRequest proto.InternalMessageInfo
+
+func (m *ListTaskListPartitionsRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetClusterInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ListTaskListPartitionsRequest) GetTaskList() *TaskList {
+	if m != nil {
+		return m.TaskList
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetClusterInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListTaskListPartitionsResponse struct {
+	ActivityTaskListPartitions []*TaskListPartitionMetadata `protobuf:"bytes,1,rep,name=activity_task_list_partitions,json=activityTaskListPartitions,proto3" json:"activity_task_list_partitions,omitempty"`
+	DecisionTaskListPartitions []*TaskListPartitionMetadata `protobuf:"bytes,2,rep,name=decision_task_list_partitions,json=decisionTaskListPartitions,proto3" json:"decision_task_list_partitions,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{}                     `json:"-"`
+	XXX_unrecognized           []byte                       `json:"-"`
+	XXX_sizecache              int32                        `json:"-"`
 }
 
-func (m *GetClusterInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.SupportedClientVersions != nil {
-		{
-			size, err := m.SupportedClientVersions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+func (m *ListTaskListPartitionsResponse) Reset()         { *m = ListTaskListPartitionsResponse{} }
+func (m *ListTaskListPartitionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTaskListPartitionsResponse) ProtoMessage()    {}
+func (*ListTaskListPartitionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{51}
+}
+func (m *ListTaskListPartitionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListTaskListPartitionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListTaskListPartitionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ListTaskListPartitionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTaskListPartitionsResponse.Merge(m, src)
+}
+func (m *ListTaskListPartitionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListTaskListPartitionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTaskListPartitionsResponse.DiscardUnknown(m)
 }
 
-func (m *GetWorkflowExecutionHistoryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListTaskListPartitionsResponse proto.InternalMessageInfo
+
+func (m *ListTaskListPartitionsResponse) GetActivityTaskListPartitions() []*TaskListPartitionMetadata {
+	if m != nil {
+		return m.ActivityTaskListPartitions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetWorkflowExecutionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListTaskListPartitionsResponse) GetDecisionTaskListPartitions() []*TaskListPartitionMetadata {
+	if m != nil {
+		return m.DecisionTaskListPartitions
+	}
+	return nil
 }
 
-func (m *GetWorkflowExecutionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.SkipArchival {
-		i--
-		if m.SkipArchival {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.HistoryEventFilterType != 0 {
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.HistoryEventFilterType))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.WaitForNewEvent {
-		i--
-		if m.WaitForNewEvent {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+type GetClusterInfoRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetClusterInfoRequest) Reset()         { *m = GetClusterInfoRequest{} }
+func (m *GetClusterInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetClusterInfoRequest) ProtoMessage()    {}
+func (*GetClusterInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{52}
+}
+func (m *GetClusterInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetClusterInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetClusterInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.PageSize != 0 {
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.PageSize))
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+}
+func (m *GetClusterInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClusterInfoRequest.Merge(m, src)
+}
+func (m *GetClusterInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetClusterInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClusterInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetClusterInfoRequest proto.InternalMessageInfo
+
+type GetClusterInfoResponse struct {
+	SupportedClientVersions *SupportedClientVersions `protobuf:"bytes,1,opt,name=supported_client_versions,json=supportedClientVersions,proto3" json:"supported_client_versions,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}                 `json:"-"`
+	XXX_unrecognized        []byte                   `json:"-"`
+	XXX_sizecache           int32                    `json:"-"`
+}
+
+func (m *GetClusterInfoResponse) Reset()         { *m = GetClusterInfoResponse{} }
+func (m *GetClusterInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetClusterInfoResponse) ProtoMessage()    {}
+func (*GetClusterInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{53}
+}
+func (m *GetClusterInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetClusterInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetClusterInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetClusterInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClusterInfoResponse.Merge(m, src)
+}
+func (m *GetClusterInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetClusterInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClusterInfoResponse.DiscardUnknown(m)
 }
 
-func (m *GetWorkflowExecutionHistoryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetClusterInfoResponse proto.InternalMessageInfo
+
+func (m *GetClusterInfoResponse) GetSupportedClientVersions() *SupportedClientVersions {
+	if m != nil {
+		return m.SupportedClientVersions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetWorkflowExecutionHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetWorkflowExecutionHistoryRequest struct {
+	Domain                 string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution      *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	PageSize               int32              `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken          []byte             `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	WaitForNewEvent        bool               `protobuf:"varint,5,opt,name=wait_for_new_event,json=waitForNewEvent,proto3" json:"wait_for_new_event,omitempty"`
+	HistoryEventFilterType EventFilterType    `protobuf:"varint,6,opt,name=history_event_filter_type,json=historyEventFilterType,proto3,enum=uber.cadence.api.v1.EventFilterType" json:"history_event_filter_type,omitempty"`
+	SkipArchival           bool               `protobuf:"varint,7,opt,name=skip_archival,json=skipArchival,proto3" json:"skip_archival,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}           `json:"-"`
+	XXX_unrecognized       []byte             `json:"-"`
+	XXX_sizecache          int32              `json:"-"`
 }
 
-func (m *GetWorkflowExecutionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Archived {
-		i--
-		if m.Archived {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RawHistory) > 0 {
-		for iNdEx := len(m.RawHistory) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RawHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.History != nil {
-		{
-			size, err := m.History.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+func (m *GetWorkflowExecutionHistoryRequest) Reset()         { *m = GetWorkflowExecutionHistoryRequest{} }
+func (m *GetWorkflowExecutionHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetWorkflowExecutionHistoryRequest) ProtoMessage()    {}
+func (*GetWorkflowExecutionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{54}
+}
+func (m *GetWorkflowExecutionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetWorkflowExecutionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetWorkflowExecutionHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetWorkflowExecutionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkflowExecutionHistoryRequest.Merge(m, src)
+}
+func (m *GetWorkflowExecutionHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetWorkflowExecutionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkflowExecutionHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *FeatureFlags) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetWorkflowExecutionHistoryRequest proto.InternalMessageInfo
+
+func (m *GetWorkflowExecutionHistoryRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *FeatureFlags) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetWorkflowExecutionHistoryRequest) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
 }
 
-func (m *FeatureFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.WorkflowExecutionAlreadyCompletedErrorEnabled {
-		i--
-		if m.WorkflowExecutionAlreadyCompletedErrorEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (m *GetWorkflowExecutionHistoryRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintServiceWorkflow(dAtA []byte, offset int, v uint64) int {
-	offset -= sovServiceWorkflow(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetWorkflowExecutionHistoryRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *StartWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	l = len(m.WorkflowId)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.TaskList != nil {
-		l = m.TaskList.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.ExecutionStartToCloseTimeout != nil {
-		l = m.ExecutionStartToCloseTimeout.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.TaskStartToCloseTimeout != nil {
-		l = m.TaskStartToCloseTimeout.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.WorkflowIdReusePolicy != 0 {
-		n += 1 + sovServiceWorkflow(uint64(m.WorkflowIdReusePolicy))
-	}
-	if m.RetryPolicy != nil {
-		l = m.RetryPolicy.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	l = len(m.CronSchedule)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.Memo != nil {
-		l = m.Memo.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.SearchAttributes != nil {
-		l = m.SearchAttributes.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.DelayStart != nil {
-		l = m.DelayStart.Size()
-		n += 2 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (m *GetWorkflowExecutionHistoryRequest) GetWaitForNewEvent() bool {
+	if m != nil {
+		return m.WaitForNewEvent
 	}
-	return n
+	return false
 }
 
-func (m *StartWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetWorkflowExecutionHistoryRequest) GetHistoryEventFilterType() EventFilterType {
+	if m != nil {
+		return m.HistoryEventFilterType
 	}
-	return n
+	return EventFilterType_EVENT_FILTER_TYPE_INVALID
 }
 
-func (m *SignalWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	l = len(m.SignalName)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.SignalInput != nil {
-		l = m.SignalInput.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	l = len(m.Control)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetWorkflowExecutionHistoryRequest) GetSkipArchival() bool {
+	if m != nil {
+		return m.SkipArchival
 	}
-	return n
+	return false
 }
 
-func (m *SignalWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type GetWorkflowExecutionHistoryResponse struct {
+	History              *History    `protobuf:"bytes,1,opt,name=history,proto3" json:"history,omitempty"`
+	RawHistory           []*DataBlob `protobuf:"bytes,2,rep,name=raw_history,json=rawHistory,proto3" json:"raw_history,omitempty"`
+	NextPageToken        []byte      `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Archived             bool        `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StartRequest != nil {
-		l = m.StartRequest.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	l = len(m.SignalName)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.SignalInput != nil {
-		l = m.SignalInput.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	l = len(m.Control)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetWorkflowExecutionHistoryResponse) Reset()         { *m = GetWorkflowExecutionHistoryResponse{} }
+func (m *GetWorkflowExecutionHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetWorkflowExecutionHistoryResponse) ProtoMessage()    {}
+func (*GetWorkflowExecutionHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{55}
+}
+func (m *GetWorkflowExecutionHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetWorkflowExecutionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetWorkflowExecutionHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *GetWorkflowExecutionHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkflowExecutionHistoryResponse.Merge(m, src)
+}
+func (m *GetWorkflowExecutionHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetWorkflowExecutionHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkflowExecutionHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *SignalWithStartWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_GetWorkflowExecutionHistoryResponse proto.InternalMessageInfo
+
+func (m *GetWorkflowExecutionHistoryResponse) GetHistory() *History {
+	if m != nil {
+		return m.History
 	}
-	var l int
-	_ = l
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
+	return nil
+}
+
+func (m *GetWorkflowExecutionHistoryResponse) GetRawHistory() []*DataBlob {
+	if m != nil {
+		return m.RawHistory
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+func (m *GetWorkflowExecutionHistoryResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
-	return n
+	return nil
 }
 
-func (m *ResetWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetWorkflowExecutionHistoryResponse) GetArchived() bool {
+	if m != nil {
+		return m.Archived
 	}
-	var l int
-	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.DecisionFinishEventId != 0 {
-		n += 1 + sovServiceWorkflow(uint64(m.DecisionFinishEventId))
-	}
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.SkipSignalReapply {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return false
 }
 
-func (m *ResetWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type FeatureFlags struct {
+	WorkflowExecutionAlreadyCompletedErrorEnabled bool     `protobuf:"varint,1,opt,name=workflow_execution_already_completed_error_enabled,json=workflowExecutionAlreadyCompletedErrorEnabled,proto3" json:"workflow_execution_already_completed_error_enabled,omitempty"`
+	XXX_NoUnkeyedLiteral                          struct{} `json:"-"`
+	XXX_unrecognized                              []byte   `json:"-"`
+	XXX_sizecache                                 int32    `json:"-"`
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *FeatureFlags) Reset()         { *m = FeatureFlags{} }
+func (m *FeatureFlags) String() string { return proto.CompactTextString(m) }
+func (*FeatureFlags) ProtoMessage()    {}
+func (*FeatureFlags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{56}
+}
+func (m *FeatureFlags) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeatureFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeatureFlags.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *FeatureFlags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureFlags.Merge(m, src)
+}
+func (m *FeatureFlags) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeatureFlags) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureFlags.DiscardUnknown(m)
 }
 
-func (m *RequestCancelWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_FeatureFlags proto.InternalMessageInfo
+
+func (m *FeatureFlags) GetWorkflowExecutionAlreadyCompletedErrorEnabled() bool {
+	if m != nil {
+		return m.WorkflowExecutionAlreadyCompletedErrorEnabled
 	}
-	return n
+	return false
 }
 
-func (m *TerminateWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.Details != nil {
-		l = m.Details.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func init() {
+	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.StartWorkflowExecutionRequest")
+	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.StartWorkflowExecutionResponse")
+	proto.RegisterType((*SignalWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.SignalWorkflowExecutionRequest")
+	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.SignalWorkflowExecutionResponse")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.SignalWithStartWorkflowExecutionRequest")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.SignalWithStartWorkflowExecutionResponse")
+	proto.RegisterType((*ResetWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.ResetWorkflowExecutionRequest")
+	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.ResetWorkflowExecutionResponse")
+	proto.RegisterType((*RestartWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.RestartWorkflowExecutionRequest")
+	proto.RegisterType((*RestartWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.RestartWorkflowExecutionResponse")
+	proto.RegisterType((*RequestCancelWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.RequestCancelWorkflowExecutionRequest")
+	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.RequestCancelWorkflowExecutionResponse")
+	proto.RegisterType((*PauseWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.PauseWorkflowExecutionRequest")
+	proto.RegisterType((*PauseWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.PauseWorkflowExecutionResponse")
+	proto.RegisterType((*UnpauseWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.UnpauseWorkflowExecutionRequest")
+	proto.RegisterType((*UnpauseWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.UnpauseWorkflowExecutionResponse")
+	proto.RegisterType((*DeleteWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.DeleteWorkflowExecutionRequest")
+	proto.RegisterType((*DeleteWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.DeleteWorkflowExecutionResponse")
+	proto.RegisterType((*PauseActivityRequest)(nil), "uber.cadence.api.v1.PauseActivityRequest")
+	proto.RegisterType((*PauseActivityResponse)(nil), "uber.cadence.api.v1.PauseActivityResponse")
+	proto.RegisterType((*UnpauseActivityRequest)(nil), "uber.cadence.api.v1.UnpauseActivityRequest")
+	proto.RegisterType((*UnpauseActivityResponse)(nil), "uber.cadence.api.v1.UnpauseActivityResponse")
+	proto.RegisterType((*ResetActivityRequest)(nil), "uber.cadence.api.v1.ResetActivityRequest")
+	proto.RegisterType((*ResetActivityResponse)(nil), "uber.cadence.api.v1.ResetActivityResponse")
+	proto.RegisterType((*UpdateWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.UpdateWorkflowExecutionRequest")
+	proto.RegisterType((*UpdateWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.UpdateWorkflowExecutionResponse")
+	proto.RegisterType((*CreateScheduleRequest)(nil), "uber.cadence.api.v1.CreateScheduleRequest")
+	proto.RegisterType((*CreateScheduleResponse)(nil), "uber.cadence.api.v1.CreateScheduleResponse")
+	proto.RegisterType((*DescribeScheduleRequest)(nil), "uber.cadence.api.v1.DescribeScheduleRequest")
+	proto.RegisterType((*DescribeScheduleResponse)(nil), "uber.cadence.api.v1.DescribeScheduleResponse")
+	proto.RegisterType((*UpdateScheduleRequest)(nil), "uber.cadence.api.v1.UpdateScheduleRequest")
+	proto.RegisterType((*UpdateScheduleResponse)(nil), "uber.cadence.api.v1.UpdateScheduleResponse")
+	proto.RegisterType((*PauseScheduleRequest)(nil), "uber.cadence.api.v1.PauseScheduleRequest")
+	proto.RegisterType((*PauseScheduleResponse)(nil), "uber.cadence.api.v1.PauseScheduleResponse")
+	proto.RegisterType((*UnpauseScheduleRequest)(nil), "uber.cadence.api.v1.UnpauseScheduleRequest")
+	proto.RegisterType((*UnpauseScheduleResponse)(nil), "uber.cadence.api.v1.UnpauseScheduleResponse")
+	proto.RegisterType((*BackfillScheduleRequest)(nil), "uber.cadence.api.v1.BackfillScheduleRequest")
+	proto.RegisterType((*BackfillScheduleResponse)(nil), "uber.cadence.api.v1.BackfillScheduleResponse")
+	proto.RegisterType((*ListSchedulesRequest)(nil), "uber.cadence.api.v1.ListSchedulesRequest")
+	proto.RegisterType((*ListSchedulesResponse)(nil), "uber.cadence.api.v1.ListSchedulesResponse")
+	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.TerminateWorkflowExecutionRequest")
+	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.TerminateWorkflowExecutionResponse")
+	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.DescribeWorkflowExecutionRequest")
+	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.DescribeWorkflowExecutionResponse")
+	proto.RegisterMapType((map[string]*types.Timestamp)(nil), "uber.cadence.api.v1.DescribeWorkflowExecutionResponse.SignalRequestIdsEntry")
+	proto.RegisterType((*QueryWorkflowRequest)(nil), "uber.cadence.api.v1.QueryWorkflowRequest")
+	proto.RegisterType((*QueryWorkflowResponse)(nil), "uber.cadence.api.v1.QueryWorkflowResponse")
+	proto.RegisterType((*DescribeTaskListRequest)(nil), "uber.cadence.api.v1.DescribeTaskListRequest")
+	proto.RegisterType((*DescribeTaskListResponse)(nil), "uber.cadence.api.v1.DescribeTaskListResponse")
+	proto.RegisterType((*GetTaskListsByDomainRequest)(nil), "uber.cadence.api.v1.GetTaskListsByDomainRequest")
+	proto.RegisterType((*GetTaskListsByDomainResponse)(nil), "uber.cadence.api.v1.GetTaskListsByDomainResponse")
+	proto.RegisterType((*ListTaskListPartitionsRequest)(nil), "uber.cadence.api.v1.ListTaskListPartitionsRequest")
+	proto.RegisterType((*ListTaskListPartitionsResponse)(nil), "uber.cadence.api.v1.ListTaskListPartitionsResponse")
+	proto.RegisterType((*GetClusterInfoRequest)(nil), "uber.cadence.api.v1.GetClusterInfoRequest")
+	proto.RegisterType((*GetClusterInfoResponse)(nil), "uber.cadence.api.v1.GetClusterInfoResponse")
+	proto.RegisterType((*GetWorkflowExecutionHistoryRequest)(nil), "uber.cadence.api.v1.GetWorkflowExecutionHistoryRequest")
+	proto.RegisterType((*GetWorkflowExecutionHistoryResponse)(nil), "uber.cadence.api.v1.GetWorkflowExecutionHistoryResponse")
+	proto.RegisterType((*FeatureFlags)(nil), "uber.cadence.api.v1.FeatureFlags")
 }
 
-func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func init() {
+	proto.RegisterFile("uber/cadence/api/v1/service_workflow.proto", fileDescriptor_674d14d2fee4e473)
 }
 
-func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+var fileDescriptor_674d14d2fee4e473 = []byte{
+	// 2973 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1b, 0x4d, 0x6f, 0x24, 0x47,
+	0x55, 0x3d, 0xfe, 0x1a, 0xbf, 0xb1, 0xbd, 0xde, 0xca, 0xda, 0xee, 0x6d, 0xc7, 0x1f, 0x3b, 0x49,
+	0x96, 0x8d, 0x77, 0x77, 0x9c, 0xf5, 0x7e, 0x66, 0x03, 0x44, 0x5e, 0x7b, 0xbd, 0xb1, 0x94, 0x0d,
+	0x4e, 0xdb, 0x61, 0x05, 0x97, 0x56, 0xbb, 0xbb, 0x6c, 0x37, 0xee, 0xe9, 0xee, 0xed, 0xaa, 0xf1,
+	0x64, 0x02, 0x17, 0x50, 0x00, 0x09, 0x84, 0x04, 0x47, 0x4e, 0x91, 0x80, 0x0b, 0x12, 0xe2, 0xcc,
+	0x39, 0x02, 0xc4, 0x11, 0xb8, 0x72, 0x00, 0xc2, 0x0f, 0xe0, 0x1f, 0x44, 0xa8, 0xbe, 0xe6, 0xa3,
+	0xa7, 0xbb, 0x67, 0xc6, 0x9b, 0xb0, 0x26, 0xe2, 0x36, 0xfd, 0xea, 0xbd, 0x57, 0xef, 0xab, 0x5e,
+	0xd5, 0xab, 0x57, 0x03, 0x2b, 0xb5, 0x7d, 0x1c, 0xaf, 0x3a, 0xb6, 0x8b, 0x03, 0x07, 0xaf, 0xda,
+	0x91, 0xb7, 0x7a, 0x72, 0x63, 0x95, 0xe0, 0xf8, 0xc4, 0x73, 0xb0, 0x55, 0x0f, 0xe3, 0xe3, 0x03,
+	0x3f, 0xac, 0x57, 0xa2, 0x38, 0xa4, 0x21, 0x7a, 0x81, 0xe1, 0x56, 0x24, 0x6e, 0xc5, 0x8e, 0xbc,
+	0xca, 0xc9, 0x0d, 0x63, 0xf1, 0x30, 0x0c, 0x0f, 0x7d, 0xbc, 0xca, 0x51, 0xf6, 0x6b, 0x07, 0xab,
+	0x6e, 0x2d, 0xb6, 0xa9, 0x17, 0x06, 0x82, 0xc8, 0x58, 0x4a, 0x8e, 0x53, 0xaf, 0x8a, 0x09, 0xb5,
+	0xab, 0x91, 0x44, 0x58, 0x4e, 0x93, 0xc0, 0x09, 0xab, 0xd5, 0x26, 0x8b, 0x4b, 0x69, 0x18, 0x47,
+	0x1e, 0xa1, 0x61, 0xdc, 0x50, 0xb3, 0xa4, 0xa1, 0x3c, 0xad, 0xe1, 0x26, 0x42, 0x39, 0x55, 0x4f,
+	0xe7, 0x08, 0xbb, 0x35, 0x1f, 0xe7, 0xe1, 0x50, 0x9b, 0x1c, 0xfb, 0x1e, 0xa1, 0x79, 0x38, 0x9d,
+	0x76, 0x2a, 0xff, 0xae, 0x08, 0x0b, 0xbb, 0xd4, 0x8e, 0xe9, 0x13, 0x09, 0x7f, 0xf8, 0x3e, 0x76,
+	0x6a, 0xcc, 0x26, 0x26, 0x7e, 0x5a, 0xc3, 0x84, 0xa2, 0x59, 0x18, 0x75, 0xc3, 0xaa, 0xed, 0x05,
+	0xba, 0xb6, 0xac, 0x5d, 0x19, 0x37, 0xe5, 0x17, 0x5a, 0x82, 0x92, 0xe2, 0x65, 0x79, 0xae, 0x5e,
+	0xe0, 0x83, 0xa0, 0x40, 0xdb, 0x2e, 0xda, 0x82, 0xc9, 0x26, 0x02, 0x6d, 0x44, 0x58, 0x1f, 0x5a,
+	0xd6, 0xae, 0x94, 0xd6, 0x2e, 0x55, 0x52, 0x5c, 0x53, 0x51, 0xd3, 0xef, 0x35, 0x22, 0x6c, 0x4e,
+	0xd4, 0xdb, 0xbe, 0xd0, 0x7d, 0x18, 0x67, 0x8a, 0x59, 0x4c, 0x33, 0x7d, 0x98, 0xf3, 0x58, 0x48,
+	0xe5, 0xb1, 0x67, 0x93, 0xe3, 0xb7, 0x3d, 0x42, 0xcd, 0x22, 0x95, 0xbf, 0xd0, 0x1a, 0x8c, 0x78,
+	0x41, 0x54, 0xa3, 0xfa, 0x08, 0xa7, 0x7b, 0x31, 0x95, 0x6e, 0xc7, 0x6e, 0xf8, 0xa1, 0xed, 0x9a,
+	0x02, 0x15, 0xd9, 0xb0, 0x8c, 0x95, 0x11, 0x2c, 0xc2, 0x6c, 0x63, 0xd1, 0xd0, 0x72, 0xfc, 0x90,
+	0x60, 0x8b, 0x05, 0x44, 0x58, 0xa3, 0xfa, 0x28, 0x67, 0x77, 0xb1, 0x22, 0x02, 0xa6, 0xa2, 0x02,
+	0xa6, 0xb2, 0x29, 0x03, 0xca, 0x7c, 0xb1, 0xc9, 0x82, 0x5b, 0x77, 0x2f, 0xdc, 0x60, 0xf4, 0x7b,
+	0x82, 0x1c, 0x3d, 0x81, 0x79, 0xae, 0x52, 0x06, 0xf7, 0xb1, 0x5e, 0xdc, 0xe7, 0x18, 0x75, 0x1a,
+	0x63, 0x03, 0x8a, 0x9e, 0x8b, 0x03, 0xea, 0xd1, 0x86, 0x5e, 0xe4, 0x1e, 0x69, 0x7e, 0xa3, 0x05,
+	0x80, 0x58, 0xf8, 0x94, 0xf9, 0x6b, 0x9c, 0x8f, 0x8e, 0x4b, 0xc8, 0xb6, 0x8b, 0x1c, 0xd0, 0xdb,
+	0xfc, 0x69, 0xc5, 0xb8, 0x46, 0xb0, 0x15, 0x85, 0xbe, 0xe7, 0x34, 0x74, 0x58, 0xd6, 0xae, 0x4c,
+	0xad, 0xad, 0xe4, 0x7a, 0x6e, 0xdb, 0x35, 0x19, 0xc9, 0x0e, 0xa7, 0x30, 0x67, 0xea, 0x69, 0x60,
+	0xb4, 0x01, 0x13, 0x31, 0xa6, 0x71, 0x43, 0x31, 0x2e, 0x71, 0x4d, 0x97, 0x53, 0x19, 0x9b, 0x0c,
+	0x51, 0xb2, 0x2b, 0xc5, 0xad, 0x0f, 0xf4, 0x12, 0x4c, 0x3a, 0x31, 0xf3, 0x8d, 0x5c, 0x12, 0xfa,
+	0x04, 0xd7, 0x65, 0x82, 0x01, 0x77, 0x25, 0x0c, 0x5d, 0x87, 0xe1, 0x2a, 0xae, 0x86, 0xfa, 0xa4,
+	0xb4, 0x65, 0xda, 0x0c, 0x8f, 0x71, 0x35, 0x34, 0x39, 0x1a, 0x32, 0xe1, 0x3c, 0xc1, 0x76, 0xec,
+	0x1c, 0x59, 0x36, 0xa5, 0xb1, 0xb7, 0x5f, 0xa3, 0x98, 0xe8, 0x53, 0x9c, 0xf6, 0x95, 0x54, 0xda,
+	0x5d, 0x8e, 0xbd, 0xde, 0x44, 0x36, 0xa7, 0x49, 0x02, 0x82, 0x6e, 0xc2, 0xe8, 0x11, 0xb6, 0x5d,
+	0x1c, 0xeb, 0xe7, 0x38, 0xa3, 0xf9, 0x54, 0x46, 0x6f, 0x71, 0x14, 0x53, 0xa2, 0xa2, 0xfb, 0x50,
+	0x72, 0xb1, 0x6f, 0x37, 0x44, 0x6c, 0xe8, 0xd3, 0xbd, 0x42, 0x01, 0x38, 0x36, 0x8f, 0x05, 0xe4,
+	0xc3, 0x7c, 0xbb, 0x0b, 0x9d, 0x30, 0x38, 0xf0, 0x3d, 0x87, 0x2a, 0x63, 0x9f, 0xe7, 0x5e, 0xbc,
+	0xde, 0xc3, 0x8b, 0x1b, 0x92, 0x4a, 0x5a, 0x5e, 0xaf, 0x67, 0x8c, 0x94, 0xef, 0xc2, 0x62, 0x56,
+	0xe6, 0x20, 0x51, 0x18, 0x10, 0x8c, 0x66, 0x60, 0x34, 0xae, 0x05, 0x2c, 0xda, 0x44, 0xea, 0x18,
+	0x89, 0x6b, 0xc1, 0xb6, 0x5b, 0xfe, 0x63, 0x01, 0x16, 0x77, 0xbd, 0xc3, 0xc0, 0xf6, 0x07, 0x4e,
+	0x3a, 0xef, 0x01, 0x6a, 0x6a, 0xd8, 0x5c, 0x61, 0x3c, 0xf7, 0x94, 0xd6, 0x2e, 0xe7, 0x2a, 0xd6,
+	0x9a, 0xe2, 0x7c, 0x3d, 0x09, 0xea, 0x58, 0x36, 0x43, 0xb9, 0xcb, 0x66, 0x38, 0xb9, 0x6c, 0x96,
+	0xa0, 0x44, 0xb8, 0x2e, 0x56, 0x60, 0x57, 0x31, 0xcf, 0x33, 0xe3, 0x26, 0x08, 0xd0, 0x3b, 0x76,
+	0x15, 0xa3, 0x37, 0x61, 0x42, 0x22, 0x88, 0x4c, 0x34, 0xda, 0x47, 0x26, 0x92, 0x2c, 0xb7, 0x79,
+	0x3e, 0xd2, 0x61, 0xcc, 0x09, 0x03, 0x1a, 0x87, 0x3e, 0x4f, 0x0c, 0x13, 0xa6, 0xfa, 0x2c, 0x5f,
+	0x82, 0xa5, 0x4c, 0x3b, 0x0a, 0x17, 0x94, 0x3f, 0xd5, 0xe0, 0x4b, 0x12, 0xc7, 0xa3, 0x47, 0xf9,
+	0x99, 0xfe, 0x09, 0x4c, 0x8a, 0x84, 0x24, 0xb5, 0xe3, 0xb6, 0x2f, 0xad, 0xad, 0xa5, 0xc7, 0x7f,
+	0x1e, 0x2b, 0x73, 0x82, 0x33, 0x52, 0x8c, 0x13, 0x36, 0x2a, 0xf4, 0xb4, 0xd1, 0xd0, 0x33, 0xd8,
+	0x68, 0xb8, 0xd3, 0x46, 0xeb, 0x70, 0xa5, 0xb7, 0xfe, 0xf9, 0xf1, 0xfa, 0xdb, 0x02, 0x2c, 0x98,
+	0x98, 0x60, 0x7a, 0x56, 0xc2, 0x75, 0x16, 0x46, 0x63, 0x6c, 0x93, 0x30, 0x90, 0xc1, 0x2a, 0xbf,
+	0xd0, 0x5d, 0xd0, 0x5d, 0xec, 0x78, 0x84, 0x6d, 0x5c, 0x07, 0x5e, 0xe0, 0x91, 0x23, 0x0b, 0x9f,
+	0xe0, 0xa0, 0x19, 0xb8, 0x43, 0xe6, 0x8c, 0x1a, 0xdf, 0xe2, 0xc3, 0x0f, 0xd9, 0xe8, 0xb6, 0x9b,
+	0x88, 0xf1, 0x91, 0x64, 0x8c, 0x57, 0xe0, 0x05, 0x72, 0xec, 0x45, 0x96, 0xf4, 0x51, 0x8c, 0xed,
+	0x28, 0xf2, 0x1b, 0x3c, 0x92, 0x8b, 0xe6, 0x79, 0x36, 0x24, 0x4c, 0x6c, 0x8a, 0x01, 0x96, 0x19,
+	0xb2, 0xec, 0x95, 0x6f, 0xe9, 0xdf, 0x68, 0xb0, 0x64, 0x62, 0x72, 0xaa, 0xf3, 0xc8, 0x7f, 0x3f,
+	0x35, 0x94, 0x5f, 0x87, 0xe5, 0x6c, 0x69, 0xf3, 0x35, 0xfd, 0x8b, 0x06, 0xaf, 0x48, 0x8d, 0x36,
+	0xec, 0xc0, 0xc1, 0x5f, 0x80, 0x54, 0x58, 0xbe, 0x02, 0x97, 0x7b, 0xa9, 0x24, 0xb3, 0xd2, 0x3f,
+	0x35, 0x58, 0xd8, 0xb1, 0x6b, 0x04, 0x9f, 0xf5, 0x15, 0xd5, 0x6e, 0x8d, 0xe1, 0x5c, 0x6b, 0x24,
+	0x17, 0x4d, 0x79, 0x19, 0x16, 0xb3, 0x54, 0x94, 0x56, 0xf8, 0x97, 0x06, 0x4b, 0xef, 0x05, 0xd1,
+	0x17, 0xdc, 0x0e, 0x65, 0x58, 0xce, 0x56, 0x52, 0x5a, 0xe2, 0x0f, 0x1a, 0x2c, 0x6e, 0x62, 0x1f,
+	0xd3, 0x33, 0x63, 0x08, 0x1d, 0xc6, 0xd8, 0x69, 0xce, 0x3b, 0x11, 0x65, 0x4b, 0xd1, 0x54, 0x9f,
+	0x79, 0xa6, 0x60, 0x1b, 0x72, 0xa6, 0x1a, 0x52, 0xd5, 0x8f, 0x35, 0xb8, 0xc0, 0xe3, 0x62, 0xdd,
+	0xa1, 0xde, 0x89, 0x47, 0x1b, 0xcf, 0x49, 0xc1, 0x25, 0x28, 0xd9, 0x52, 0x02, 0xe6, 0x36, 0xe1,
+	0x6e, 0x50, 0xa0, 0x6d, 0x37, 0x57, 0xcf, 0x39, 0x98, 0x49, 0xe8, 0x20, 0xb5, 0xfb, 0xbd, 0x06,
+	0xb3, 0xd2, 0xdb, 0xff, 0xcb, 0xfa, 0x5d, 0x84, 0xb9, 0x2e, 0x2d, 0xa4, 0x86, 0x7f, 0xd7, 0xe0,
+	0x02, 0xdf, 0xdc, 0xce, 0xba, 0x7e, 0xf3, 0x30, 0x2e, 0x4a, 0xad, 0x20, 0xac, 0x73, 0x05, 0x8b,
+	0x66, 0x91, 0x03, 0xde, 0x09, 0xeb, 0x1d, 0xca, 0x8f, 0x74, 0x3b, 0x37, 0xa1, 0xa0, 0x54, 0xfd,
+	0xa3, 0x02, 0x2c, 0xbe, 0x17, 0xb9, 0xf6, 0xd9, 0x59, 0xa5, 0x4b, 0x50, 0xaa, 0x71, 0x81, 0xc4,
+	0xc1, 0x52, 0x1a, 0x41, 0x80, 0xf8, 0xc1, 0xb2, 0x59, 0xff, 0x0f, 0xf7, 0x5f, 0xff, 0xe7, 0xd8,
+	0x26, 0x91, 0xeb, 0x46, 0x93, 0xb9, 0xee, 0x3b, 0xb0, 0x94, 0x69, 0x20, 0x79, 0x1e, 0xb8, 0xc5,
+	0x32, 0x2c, 0xa9, 0xf9, 0xea, 0x74, 0x9d, 0x2f, 0x92, 0xc4, 0x65, 0x25, 0x2f, 0x8e, 0xe3, 0x30,
+	0xb6, 0xaa, 0x98, 0x10, 0xfb, 0x50, 0x9d, 0xa1, 0x27, 0x38, 0xf0, 0xb1, 0x80, 0x95, 0x3f, 0x2e,
+	0xc0, 0xcc, 0x46, 0x8c, 0x6d, 0x8a, 0x55, 0x15, 0xdc, 0xc7, 0x1d, 0x8e, 0x2a, 0xa2, 0xdb, 0xee,
+	0x70, 0x14, 0x68, 0xdb, 0x45, 0xb7, 0x61, 0x98, 0x44, 0xd8, 0xc9, 0xbd, 0xba, 0x51, 0x93, 0xed,
+	0x46, 0xd8, 0x31, 0x39, 0x3a, 0x7a, 0x03, 0x46, 0x59, 0x24, 0x86, 0x81, 0xb4, 0xfb, 0x4b, 0xb9,
+	0x84, 0xeb, 0x1c, 0xd5, 0x94, 0x24, 0x68, 0x1d, 0x8a, 0xbc, 0x60, 0xf5, 0x30, 0xd1, 0x47, 0xf2,
+	0x2a, 0x70, 0x49, 0xbe, 0x23, 0x91, 0xcd, 0x26, 0x59, 0x87, 0x0b, 0x47, 0x73, 0x5d, 0x38, 0x96,
+	0x74, 0xa1, 0x0e, 0xb3, 0x49, 0x1b, 0xca, 0xf0, 0x37, 0x61, 0x6e, 0x13, 0x13, 0x27, 0xf6, 0xf6,
+	0x3f, 0x33, 0xfb, 0x32, 0x97, 0xe9, 0xdd, 0x4c, 0x65, 0xa8, 0x28, 0xe3, 0x6b, 0xa7, 0x35, 0x7e,
+	0xe1, 0xd9, 0x8c, 0x3f, 0x74, 0x3a, 0xe3, 0xdf, 0x83, 0x11, 0x42, 0x6d, 0x8a, 0xa5, 0xef, 0xcb,
+	0xf9, 0x72, 0x33, 0x4c, 0x53, 0x10, 0x30, 0x85, 0xbd, 0xe0, 0x20, 0xd4, 0x47, 0xfa, 0x50, 0x78,
+	0x3b, 0x38, 0x08, 0x4d, 0x8e, 0xce, 0xe3, 0x5e, 0x2c, 0xbb, 0xff, 0xc7, 0xfd, 0xb3, 0xc4, 0x7d,
+	0xd2, 0x86, 0x32, 0xee, 0x7f, 0xa1, 0x4e, 0x2c, 0x9f, 0x99, 0x75, 0x3f, 0x87, 0x53, 0xa6, 0x3a,
+	0x91, 0x74, 0x49, 0xff, 0xab, 0xd6, 0x89, 0xe4, 0x2c, 0xcb, 0xdf, 0x3a, 0x71, 0x74, 0x69, 0xf0,
+	0xd7, 0x02, 0xcc, 0x3d, 0xb0, 0x9d, 0xe3, 0x03, 0xcf, 0xf7, 0x3f, 0x33, 0x15, 0x5e, 0x07, 0x90,
+	0x97, 0xcf, 0x5e, 0x55, 0xdd, 0xcc, 0x1b, 0x5d, 0xb7, 0x8c, 0x7b, 0xaa, 0xff, 0x61, 0x8e, 0x73,
+	0x6c, 0xf6, 0x8d, 0x6e, 0x43, 0x11, 0x07, 0xae, 0x20, 0x1c, 0xee, 0x49, 0x38, 0x86, 0x03, 0x97,
+	0x93, 0xbd, 0x0b, 0x53, 0xe1, 0x09, 0x8e, 0x7d, 0x3b, 0x52, 0xf7, 0x91, 0x23, 0x39, 0xb7, 0xca,
+	0x4a, 0xd1, 0xaf, 0x09, 0x12, 0x79, 0x19, 0x39, 0x19, 0xb6, 0x7f, 0x3e, 0x4b, 0xb8, 0x1b, 0xa0,
+	0x77, 0xdb, 0x54, 0x1a, 0x9c, 0xc0, 0x05, 0xd6, 0x3c, 0x50, 0x70, 0xd2, 0xcb, 0xd8, 0xf3, 0x30,
+	0x1e, 0xd9, 0x87, 0xd8, 0x22, 0xde, 0x07, 0x62, 0x63, 0x1e, 0x31, 0x8b, 0x0c, 0xb0, 0xeb, 0x7d,
+	0x80, 0xd1, 0x65, 0x38, 0x17, 0xe0, 0xf7, 0xa9, 0xc5, 0x31, 0x68, 0x78, 0x8c, 0x45, 0xd0, 0x4c,
+	0x98, 0x93, 0x0c, 0xbc, 0x63, 0x1f, 0xe2, 0x3d, 0x06, 0x2c, 0x7f, 0x5f, 0x83, 0x99, 0xc4, 0xac,
+	0x72, 0x1b, 0xd8, 0x84, 0x71, 0xe5, 0x38, 0xa2, 0x6b, 0xcb, 0x43, 0x99, 0x47, 0x26, 0x45, 0xca,
+	0xd8, 0x3c, 0x0c, 0x68, 0xdc, 0x30, 0x5b, 0x84, 0x69, 0x72, 0x14, 0xd2, 0xe4, 0xf8, 0x54, 0x83,
+	0x4b, 0x7b, 0x38, 0xae, 0x7a, 0xc1, 0x19, 0x3a, 0xe7, 0x65, 0x2d, 0xb8, 0x3b, 0x30, 0xe6, 0x62,
+	0x6a, 0x7b, 0x3e, 0xe9, 0xeb, 0x80, 0xa7, 0x90, 0x73, 0x8f, 0xbf, 0x2f, 0x43, 0x39, 0x4f, 0x7f,
+	0x19, 0x23, 0x3f, 0xd3, 0x60, 0x59, 0x6d, 0xdc, 0x67, 0xc4, 0x4a, 0xe5, 0xbf, 0x8d, 0xc0, 0xa5,
+	0x1c, 0x99, 0x64, 0x38, 0xf9, 0x30, 0xd7, 0x6a, 0x6f, 0xb1, 0x16, 0x81, 0x77, 0x28, 0x7b, 0x09,
+	0xf2, 0xa0, 0x71, 0xb3, 0x3f, 0x09, 0x36, 0xda, 0x49, 0xcd, 0x59, 0x9c, 0x0a, 0x47, 0xfb, 0x30,
+	0xd7, 0xad, 0xaa, 0xc5, 0x77, 0x79, 0xa1, 0xef, 0x4a, 0x7f, 0xb3, 0xf1, 0xed, 0x7e, 0xa6, 0x9e,
+	0x06, 0x46, 0x4f, 0x00, 0x45, 0x38, 0x70, 0xbd, 0xe0, 0xd0, 0x92, 0xf5, 0x8f, 0x38, 0xbd, 0xb0,
+	0x95, 0x72, 0x25, 0x3d, 0x20, 0x04, 0xba, 0xaa, 0x70, 0x38, 0xf3, 0xf3, 0x51, 0x07, 0x90, 0x6d,
+	0xa7, 0xdf, 0x80, 0x69, 0xc5, 0xd8, 0x39, 0xf2, 0x7c, 0x37, 0xc6, 0x6c, 0x63, 0x67, 0x6c, 0x2b,
+	0x79, 0x6c, 0x37, 0x18, 0x6e, 0xa7, 0xe4, 0xe7, 0xa2, 0xb6, 0xa1, 0x18, 0x07, 0x68, 0xb7, 0xc5,
+	0x5a, 0x5d, 0xc9, 0xca, 0x4d, 0x3f, 0x57, 0xe2, 0x4d, 0x89, 0xdb, 0xc1, 0x54, 0x01, 0xd1, 0x07,
+	0x80, 0x9a, 0x57, 0xb4, 0x2a, 0xf5, 0x11, 0x7d, 0x94, 0x4b, 0xfc, 0x76, 0x2a, 0xdb, 0x9e, 0xe1,
+	0x52, 0x51, 0x37, 0xbb, 0x32, 0x71, 0x12, 0x91, 0x58, 0xa6, 0x49, 0x02, 0x6c, 0x58, 0x30, 0x93,
+	0x8a, 0x8a, 0xa6, 0x61, 0xe8, 0x18, 0x37, 0xe4, 0x0a, 0x60, 0x3f, 0xd1, 0x6b, 0x30, 0x72, 0x62,
+	0xfb, 0x35, 0xac, 0x17, 0x7a, 0xee, 0x1e, 0x02, 0xf1, 0x7e, 0xe1, 0x9e, 0x56, 0xfe, 0x70, 0x08,
+	0x2e, 0xbc, 0xcb, 0xba, 0xe4, 0x4a, 0xd6, 0xe7, 0x94, 0x8b, 0xee, 0xc1, 0x08, 0x6f, 0xd6, 0xeb,
+	0x43, 0x39, 0xc7, 0x5b, 0xc5, 0x89, 0x0b, 0x6c, 0x0a, 0x02, 0x64, 0xc1, 0x2c, 0xff, 0x61, 0xc5,
+	0xf8, 0x5b, 0xd8, 0xa1, 0x6c, 0xf1, 0xb9, 0x5e, 0xf3, 0xb4, 0x38, 0xb5, 0xf6, 0x6a, 0x2a, 0x2b,
+	0xc1, 0x82, 0x53, 0x6c, 0x28, 0x02, 0xf3, 0xc2, 0xd3, 0x14, 0x28, 0x5b, 0x6c, 0x62, 0x02, 0x27,
+	0x0c, 0x88, 0x47, 0x28, 0x0e, 0x9c, 0x86, 0xe5, 0xe3, 0x13, 0xec, 0xe7, 0xee, 0xb5, 0x7c, 0x86,
+	0x8d, 0x16, 0xc9, 0xdb, 0x8c, 0xc2, 0x9c, 0x79, 0x9a, 0x06, 0x2e, 0xff, 0x52, 0x83, 0x99, 0x84,
+	0x1b, 0x64, 0x62, 0x79, 0x13, 0x26, 0x94, 0x7a, 0x7d, 0xd7, 0xb7, 0x25, 0xa9, 0x07, 0x23, 0x40,
+	0xdb, 0x30, 0xd5, 0x6e, 0x1f, 0xec, 0xea, 0x85, 0x1c, 0x13, 0xb7, 0xd9, 0x05, 0xbb, 0xe6, 0xe4,
+	0xd3, 0xf6, 0xcf, 0xf2, 0xbf, 0xb5, 0x56, 0xb1, 0xd6, 0x7c, 0x16, 0xd0, 0x23, 0x5e, 0x3a, 0xde,
+	0x19, 0x14, 0x06, 0x7b, 0x67, 0xf0, 0x08, 0xa6, 0x9a, 0xb4, 0xad, 0xc7, 0x0e, 0x53, 0x6b, 0x97,
+	0x72, 0x19, 0x88, 0xc7, 0x0e, 0xb4, 0xed, 0x8b, 0xb5, 0x70, 0xbc, 0xc0, 0xf1, 0x6b, 0x2e, 0xb6,
+	0x5a, 0x0c, 0x59, 0x75, 0x54, 0x23, 0xf2, 0x12, 0x67, 0x46, 0x8e, 0x2b, 0x26, 0xbb, 0x7c, 0x90,
+	0x9d, 0x73, 0xf5, 0x6e, 0x8d, 0xa5, 0x6b, 0x5e, 0x87, 0xb1, 0x28, 0xf4, 0x7d, 0x1c, 0xab, 0x03,
+	0xc4, 0x52, 0xba, 0x57, 0x38, 0x0e, 0xcf, 0x2d, 0x0a, 0x1f, 0x3d, 0x86, 0xe9, 0x2e, 0x41, 0xf2,
+	0xea, 0xca, 0x4e, 0xb1, 0xcc, 0x29, 0xda, 0x29, 0xe6, 0x6d, 0x98, 0x7f, 0x84, 0xa9, 0x42, 0x22,
+	0x0f, 0x1a, 0x9b, 0xdc, 0xf8, 0x3d, 0x7c, 0x53, 0xde, 0x82, 0x17, 0xd3, 0xc9, 0xa4, 0x82, 0x97,
+	0xe1, 0x5c, 0x4b, 0x4a, 0x76, 0x17, 0x24, 0x14, 0x1d, 0x37, 0x27, 0xd5, 0xfc, 0xec, 0x3a, 0x88,
+	0x94, 0x09, 0x2c, 0x70, 0x53, 0x4b, 0xe0, 0x8e, 0x1d, 0x53, 0xbe, 0x74, 0xc8, 0xe7, 0x18, 0x1c,
+	0xe5, 0x1f, 0x14, 0x60, 0x31, 0x6b, 0x56, 0x29, 0xff, 0x53, 0x58, 0x68, 0xde, 0xe6, 0xb5, 0x14,
+	0x89, 0x9a, 0x88, 0xba, 0x96, 0xb3, 0xed, 0x74, 0xf1, 0x7d, 0x8c, 0xa9, 0xed, 0xda, 0xd4, 0x36,
+	0x0d, 0xc5, 0xb4, 0x7b, 0x6a, 0x36, 0x65, 0xb3, 0x59, 0x98, 0x3a, 0x65, 0xe1, 0x74, 0x53, 0x2a,
+	0xa6, 0xdd, 0x53, 0xb2, 0x22, 0xed, 0x11, 0xa6, 0x1b, 0x7e, 0x8d, 0x50, 0x19, 0x66, 0xc2, 0xea,
+	0xe5, 0xef, 0x69, 0x30, 0x9b, 0x1c, 0x91, 0x96, 0x39, 0x82, 0x8b, 0xa4, 0x16, 0x45, 0x61, 0x4c,
+	0xb1, 0x6b, 0x39, 0xbe, 0xc7, 0xda, 0x99, 0x27, 0x38, 0x26, 0xd2, 0x2a, 0xcc, 0x11, 0xd7, 0xd2,
+	0x4f, 0xc3, 0x8a, 0x6a, 0x83, 0x13, 0x7d, 0x5d, 0xd2, 0x98, 0x73, 0x24, 0x7d, 0xa0, 0xfc, 0xe3,
+	0x21, 0x28, 0x3f, 0x4a, 0x69, 0x5a, 0xbe, 0x25, 0x5e, 0x6f, 0x3d, 0xa7, 0xed, 0xa6, 0xa3, 0xb8,
+	0x18, 0xea, 0x5d, 0x5c, 0x0c, 0xa7, 0x1c, 0xea, 0xd1, 0x55, 0x40, 0x75, 0xdb, 0xa3, 0xd6, 0x41,
+	0x18, 0x5b, 0x01, 0xae, 0x8b, 0xae, 0x30, 0xdf, 0x13, 0x8a, 0xe6, 0x39, 0x36, 0xb2, 0x15, 0xc6,
+	0xef, 0xe0, 0x3a, 0x6f, 0x07, 0x23, 0x0b, 0x2e, 0xca, 0x07, 0x6b, 0x02, 0xcf, 0x3a, 0xf0, 0x7c,
+	0x8a, 0x63, 0x91, 0xd6, 0x46, 0x79, 0x5a, 0x7b, 0x39, 0x55, 0x1f, 0x4e, 0xbe, 0xc5, 0x91, 0x79,
+	0x66, 0x9b, 0x95, 0x6c, 0x12, 0x70, 0x76, 0x99, 0xc9, 0xdb, 0xc9, 0xa2, 0xa3, 0x62, 0x8b, 0x67,
+	0x0d, 0x45, 0x73, 0x82, 0x01, 0xd7, 0x25, 0x8c, 0xb5, 0x08, 0x5f, 0xca, 0xf5, 0x86, 0x8c, 0x8f,
+	0x3b, 0x30, 0x26, 0xa7, 0xc9, 0xdd, 0x70, 0x14, 0x99, 0x42, 0x46, 0x5f, 0x85, 0x52, 0x6c, 0xd7,
+	0x2d, 0x45, 0x2b, 0x82, 0x3d, 0x7d, 0x49, 0x6f, 0xda, 0xd4, 0x7e, 0xe0, 0x87, 0xfb, 0x26, 0xc4,
+	0x76, 0x5d, 0x32, 0xea, 0xb7, 0xae, 0x63, 0xa5, 0x86, 0xd0, 0x13, 0xbb, 0xea, 0x16, 0x5e, 0x7d,
+	0x97, 0x1b, 0x30, 0xb1, 0x85, 0x6d, 0x5a, 0x8b, 0xf1, 0x96, 0x6f, 0x1f, 0x12, 0xe4, 0xc1, 0x5a,
+	0xca, 0x61, 0xd9, 0xf6, 0x63, 0x6c, 0xbb, 0x6c, 0x53, 0xaf, 0x46, 0x3e, 0x66, 0xcb, 0x40, 0x5c,
+	0x05, 0xe3, 0xc0, 0xde, 0xf7, 0xb1, 0xe8, 0x2b, 0x17, 0xcd, 0xeb, 0x5d, 0xa1, 0xb3, 0x2e, 0xe8,
+	0x36, 0x14, 0xd9, 0x43, 0x46, 0xf5, 0x50, 0x10, 0xad, 0xfd, 0x7a, 0x1e, 0x4a, 0xca, 0xb6, 0xeb,
+	0x3b, 0xdb, 0xe8, 0xbb, 0x1a, 0xcc, 0xa6, 0xbf, 0x8e, 0x40, 0xa7, 0x78, 0xff, 0x61, 0xdc, 0x1c,
+	0x88, 0x46, 0xba, 0xf2, 0x43, 0x0d, 0xe6, 0x32, 0xde, 0xb3, 0xa0, 0x0c, 0x86, 0xb9, 0xaf, 0x88,
+	0x8c, 0x5b, 0x83, 0x11, 0x49, 0x31, 0x3e, 0xd2, 0x60, 0xb9, 0xd7, 0x93, 0x11, 0xf4, 0xe5, 0x3c,
+	0xd6, 0xbd, 0x5e, 0xda, 0x18, 0x5f, 0x39, 0x25, 0xb5, 0x94, 0x90, 0x39, 0x2b, 0xfd, 0x81, 0x45,
+	0x86, 0xb3, 0x72, 0x5f, 0xaf, 0x18, 0x37, 0x07, 0xa2, 0x91, 0x32, 0xfc, 0x50, 0x03, 0x3d, 0xeb,
+	0xf1, 0x03, 0xba, 0x95, 0xc5, 0x31, 0xd7, 0x2a, 0xb7, 0x07, 0xa4, 0x92, 0x92, 0xfc, 0x5c, 0x83,
+	0x45, 0xc9, 0x22, 0xe3, 0xdd, 0x01, 0xba, 0x9f, 0xc1, 0xb9, 0x8f, 0xf7, 0x17, 0xc6, 0x1b, 0xa7,
+	0xa2, 0x6d, 0xf3, 0x54, 0xfa, 0x2b, 0x80, 0x0c, 0x4f, 0xe5, 0xbe, 0x8a, 0x30, 0x6e, 0x0e, 0x44,
+	0xd3, 0xe6, 0xa9, 0xac, 0x0e, 0x7c, 0x86, 0xa7, 0x7a, 0xbc, 0x4a, 0x30, 0x6e, 0x0f, 0x48, 0xd5,
+	0xb6, 0xc0, 0x33, 0xfa, 0xe3, 0x19, 0x0b, 0x3c, 0xff, 0x51, 0x80, 0x71, 0x6b, 0x30, 0x22, 0x29,
+	0xc6, 0x01, 0x4c, 0x76, 0x74, 0xaf, 0xd1, 0xab, 0xd9, 0x66, 0x4d, 0x74, 0x79, 0x8d, 0x95, 0x7e,
+	0x50, 0x9b, 0x37, 0x2d, 0xe7, 0x12, 0x5d, 0x64, 0x74, 0x35, 0xcf, 0x70, 0xc9, 0xb9, 0xae, 0xf5,
+	0x87, 0xdc, 0xd2, 0xaa, 0xa3, 0x6d, 0x9b, 0xa1, 0x55, 0x5a, 0xef, 0xda, 0x58, 0xe9, 0x07, 0xb5,
+	0xcd, 0x89, 0x19, 0x4d, 0xce, 0x0c, 0x27, 0xe6, 0xf7, 0x8c, 0x8d, 0x5b, 0x83, 0x11, 0x49, 0x31,
+	0x7e, 0xa2, 0x81, 0x91, 0x7d, 0x4f, 0x87, 0xee, 0xa4, 0x1f, 0x5b, 0x7b, 0x5d, 0x6c, 0x1a, 0x77,
+	0x07, 0xa6, 0x93, 0xf2, 0xfc, 0x48, 0x83, 0x8b, 0x99, 0xb7, 0x29, 0xe8, 0xf6, 0xa0, 0xb7, 0x2f,
+	0x42, 0x9a, 0x3b, 0xa7, 0xbb, 0xb4, 0x61, 0xb1, 0xd0, 0x51, 0xa3, 0xa3, 0x9c, 0xab, 0x85, 0xc4,
+	0x75, 0x8a, 0xb1, 0xd2, 0x0f, 0xaa, 0x9c, 0x27, 0x84, 0xe9, 0x64, 0xcd, 0x89, 0xae, 0xe5, 0xca,
+	0x9c, 0x28, 0xc6, 0x8d, 0xeb, 0x7d, 0x62, 0xcb, 0x09, 0xbf, 0x0d, 0x17, 0xd2, 0xea, 0x40, 0xf4,
+	0x5a, 0x2a, 0x9b, 0x9c, 0x4a, 0xd3, 0xb8, 0x31, 0x00, 0x45, 0x5b, 0x32, 0x4f, 0xaf, 0xe3, 0x32,
+	0x92, 0x79, 0x6e, 0xa9, 0x99, 0x91, 0xcc, 0x7b, 0x14, 0x8a, 0x1e, 0x4c, 0x75, 0x16, 0x4a, 0x68,
+	0x25, 0x4b, 0x91, 0xee, 0x3a, 0xcb, 0xb8, 0xda, 0x17, 0xae, 0x9c, 0xea, 0xa7, 0x1a, 0xaf, 0xd5,
+	0xb3, 0x4e, 0xe0, 0xe8, 0x6e, 0x16, 0xb3, 0x1e, 0x15, 0x94, 0x71, 0x6f, 0x70, 0xc2, 0x96, 0xf6,
+	0x9d, 0xcd, 0xf9, 0x0c, 0xed, 0x53, 0x5f, 0x41, 0x18, 0x57, 0xfb, 0xc2, 0xed, 0x0e, 0xed, 0xe6,
+	0x64, 0xf9, 0xa1, 0x9d, 0x9c, 0xee, 0x7a, 0x9f, 0xd8, 0x2d, 0xdd, 0x3a, 0x1b, 0xb0, 0x19, 0xba,
+	0xa5, 0x76, 0xba, 0x8d, 0xab, 0x7d, 0xe1, 0x26, 0x36, 0xc0, 0xe6, 0x4c, 0x39, 0x1b, 0x60, 0x72,
+	0xa2, 0x95, 0x7e, 0x50, 0xbb, 0x36, 0xc0, 0xe6, 0x4c, 0xb9, 0x1b, 0x60, 0x72, 0xae, 0x6b, 0xfd,
+	0x21, 0xb7, 0x3c, 0x96, 0x6c, 0xe9, 0x65, 0x78, 0x2c, 0xa3, 0x9b, 0x6a, 0x5c, 0xef, 0x13, 0xbb,
+	0x65, 0xc6, 0x8e, 0x8e, 0x5d, 0x86, 0x19, 0xd3, 0x7a, 0x89, 0xc6, 0x4a, 0x3f, 0xa8, 0x62, 0x9e,
+	0x07, 0xfb, 0x7f, 0xfa, 0x64, 0x51, 0xfb, 0xf3, 0x27, 0x8b, 0xda, 0x3f, 0x3e, 0x59, 0xd4, 0x60,
+	0xce, 0x09, 0xab, 0x69, 0xc4, 0x0f, 0x8a, 0xeb, 0x91, 0xb7, 0x13, 0x87, 0x34, 0xdc, 0xd1, 0xbe,
+	0xb9, 0x7a, 0xe8, 0xd1, 0xa3, 0xda, 0x7e, 0xc5, 0x09, 0xab, 0xab, 0x1d, 0xff, 0x02, 0xab, 0x1c,
+	0xe2, 0x40, 0xfc, 0xbf, 0x4d, 0xfe, 0x21, 0xec, 0x0d, 0x3b, 0xf2, 0x4e, 0x6e, 0xec, 0x8f, 0x72,
+	0xd8, 0xcd, 0xff, 0x0c, 0x00, 0xe5, 0x91, 0x0a, 0xa7, 0x64, 0x37, 0x00, 0x00,
 }
 
-func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ExecutionConfiguration != nil {
-		l = m.ExecutionConfiguration.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.WorkflowExecutionInfo != nil {
-		l = m.WorkflowExecutionInfo.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PendingActivities) > 0 {
-		for _, e := range m.PendingActivities {
-			l = e.Size()
-			n += 1 + l + sovServiceWorkflow(uint64(l))
-		}
+	if m.WorkflowIdConflictPolicy != 0 {
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.WorkflowIdConflictPolicy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	if len(m.PendingChildren) > 0 {
-		for _, e := range m.PendingChildren {
-			l = e.Size()
-			n += 1 + l + sovServiceWorkflow(uint64(l))
+	if m.DelayStart != nil {
+		{
+			size, err := m.DelayStart.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	if m.PendingDecision != nil {
-		l = m.PendingDecision.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *QueryWorkflowRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	if m.Query != nil {
-		l = m.Query.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
+	if m.SearchAttributes != nil {
+		{
+			size, err := m.SearchAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
 	}
-	if m.QueryRejectCondition != 0 {
-		n += 1 + sovServiceWorkflow(uint64(m.QueryRejectCondition))
+	if m.Memo != nil {
+		{
+			size, err := m.Memo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	if m.QueryConsistencyLevel != 0 {
-		n += 1 + sovServiceWorkflow(uint64(m.QueryConsistencyLevel))
+	if len(m.CronSchedule) > 0 {
+		i -= len(m.CronSchedule)
+		copy(dAtA[i:], m.CronSchedule)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.CronSchedule)))
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	return n
-}
-
-func (m *QueryWorkflowResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.WorkflowIdReusePolicy != 0 {
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.WorkflowIdReusePolicy))
+		i--
+		dAtA[i] = 0x50
 	}
-	var l int
-	_ = l
-	if m.QueryResult != nil {
-		l = m.QueryResult.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.QueryRejected != nil {
-		l = m.QueryRejected.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.TaskStartToCloseTimeout != nil {
+		{
+			size, err := m.TaskStartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
-}
-
-func (m *DescribeTaskListRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ExecutionStartToCloseTimeout != nil {
+		{
+			size, err := m.ExecutionStartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.TaskList != nil {
-		l = m.TaskList.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.TaskListType != 0 {
-		n += 1 + sovServiceWorkflow(uint64(m.TaskListType))
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.IncludeTaskListStatus {
-		n += 2
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DescribeTaskListResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pollers) > 0 {
-		for _, e := range m.Pollers {
-			l = e.Size()
-			n += 1 + l + sovServiceWorkflow(uint64(l))
-		}
-	}
-	if m.TaskListStatus != nil {
-		l = m.TaskListStatus.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *StartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetTaskListsByDomainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetTaskListsByDomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TaskListNames) > 0 {
-		for _, s := range m.TaskListNames {
-			l = len(s)
-			n += 1 + l + sovServiceWorkflow(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SignalWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListTaskListPartitionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SignalWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.TaskList != nil {
-		l = m.TaskList.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *ListTaskListPartitionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Control) > 0 {
+		i -= len(m.Control)
+		copy(dAtA[i:], m.Control)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Control)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	var l int
-	_ = l
-	if len(m.ActivityTaskListPartitions) > 0 {
-		for _, e := range m.ActivityTaskListPartitions {
-			l = e.Size()
-			n += 1 + l + sovServiceWorkflow(uint64(l))
+	if m.SignalInput != nil {
+		{
+			size, err := m.SignalInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.DecisionTaskListPartitions) > 0 {
-		for _, e := range m.DecisionTaskListPartitions {
-			l = e.Size()
-			n += 1 + l + sovServiceWorkflow(uint64(l))
+	if len(m.SignalName) > 0 {
+		i -= len(m.SignalName)
+		copy(dAtA[i:], m.SignalName)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.SignalName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetClusterInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SignalWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetClusterInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SignalWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SupportedClientVersions != nil {
-		l = m.SupportedClientVersions.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetWorkflowExecutionHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SignalWithStartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalWithStartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalWithStartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	if m.PageSize != 0 {
-		n += 1 + sovServiceWorkflow(uint64(m.PageSize))
-	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WaitForNewEvent {
-		n += 2
+	if len(m.Control) > 0 {
+		i -= len(m.Control)
+		copy(dAtA[i:], m.Control)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Control)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.HistoryEventFilterType != 0 {
-		n += 1 + sovServiceWorkflow(uint64(m.HistoryEventFilterType))
+	if m.SignalInput != nil {
+		{
+			size, err := m.SignalInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.SkipArchival {
-		n += 2
+	if len(m.SignalName) > 0 {
+		i -= len(m.SignalName)
+		copy(dAtA[i:], m.SignalName)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.SignalName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.StartRequest != nil {
+		{
+			size, err := m.StartRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetWorkflowExecutionHistoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SignalWithStartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalWithStartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalWithStartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.History != nil {
-		l = m.History.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.RawHistory) > 0 {
-		for _, e := range m.RawHistory {
-			l = e.Size()
-			n += 1 + l + sovServiceWorkflow(uint64(l))
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SkipSignalReapply {
+		i--
+		if m.SkipSignalReapply {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Archived {
-		n += 2
+	if m.DecisionFinishEventId != 0 {
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.DecisionFinishEventId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *FeatureFlags) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RestartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RestartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.WorkflowExecutionAlreadyCompletedErrorEnabled {
-		n += 2
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestCancelWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestCancelWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestCancelWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Archive {
+		i--
+		if m.Archive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseActivityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseActivityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ActivityId) > 0 {
+		i -= len(m.ActivityId)
+		copy(dAtA[i:], m.ActivityId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.ActivityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseActivityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseActivityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseActivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseActivityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseActivityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ActivityId) > 0 {
+		i -= len(m.ActivityId)
+		copy(dAtA[i:], m.ActivityId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.ActivityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseActivityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseActivityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseActivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetActivityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetActivityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RetryNow {
+		i--
+		if m.RetryNow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ActivityId) > 0 {
+		i -= len(m.ActivityId)
+		copy(dAtA[i:], m.ActivityId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.ActivityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetActivityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetActivityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetActivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UpdateName) > 0 {
+		i -= len(m.UpdateName)
+		copy(dAtA[i:], m.UpdateName)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.UpdateName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Policies != nil {
+		{
+			size, err := m.Policies.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Action != nil {
+		{
+			size, err := m.Action.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Policies != nil {
+		{
+			size, err := m.Policies.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Action != nil {
+		{
+			size, err := m.Action.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Policies != nil {
+		{
+			size, err := m.Policies.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Action != nil {
+		{
+			size, err := m.Action.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackfillScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackfillScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackfillScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.OverlapPolicy != 0 {
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.OverlapPolicy))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndTime != nil {
+		{
+			size, err := m.EndTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartTime != nil {
+		{
+			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackfillScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackfillScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackfillScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TerminateWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerminateWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerminateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TerminateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerminateWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerminateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SignalRequestIds) > 0 {
+		for k := range m.SignalRequestIds {
+			v := m.SignalRequestIds[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.PendingDecision != nil {
+		{
+			size, err := m.PendingDecision.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PendingChildren) > 0 {
+		for iNdEx := len(m.PendingChildren) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingChildren[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PendingActivities) > 0 {
+		for iNdEx := len(m.PendingActivities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingActivities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.WorkflowExecutionInfo != nil {
+		{
+			size, err := m.WorkflowExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ExecutionConfiguration != nil {
+		{
+			size, err := m.ExecutionConfiguration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.QueryConsistencyLevel != 0 {
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.QueryConsistencyLevel))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.QueryRejectCondition != 0 {
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.QueryRejectCondition))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Query != nil {
+		{
+			size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorkflowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.QueryRejected != nil {
+		{
+			size, err := m.QueryRejected.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.QueryResult != nil {
+		{
+			size, err := m.QueryResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeTaskListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeTaskListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IncludeTaskListStatus {
+		i--
+		if m.IncludeTaskListStatus {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TaskListType != 0 {
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeTaskListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeTaskListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TaskListStatus != nil {
+		{
+			size, err := m.TaskListStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pollers) > 0 {
+		for iNdEx := len(m.Pollers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pollers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskListsByDomainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskListsByDomainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskListsByDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskListsByDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskListsByDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskListsByDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TaskListNames) > 0 {
+		for iNdEx := len(m.TaskListNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TaskListNames[iNdEx])
+			copy(dAtA[i:], m.TaskListNames[iNdEx])
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.TaskListNames[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListTaskListPartitionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTaskListPartitionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListTaskListPartitionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListTaskListPartitionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTaskListPartitionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListTaskListPartitionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DecisionTaskListPartitions) > 0 {
+		for iNdEx := len(m.DecisionTaskListPartitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecisionTaskListPartitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ActivityTaskListPartitions) > 0 {
+		for iNdEx := len(m.ActivityTaskListPartitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActivityTaskListPartitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetClusterInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetClusterInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetClusterInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetClusterInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetClusterInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetClusterInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SupportedClientVersions != nil {
+		{
+			size, err := m.SupportedClientVersions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkflowExecutionHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkflowExecutionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkflowExecutionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SkipArchival {
+		i--
+		if m.SkipArchival {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.HistoryEventFilterType != 0 {
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.HistoryEventFilterType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WaitForNewEvent {
+		i--
+		if m.WaitForNewEvent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkflowExecutionHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkflowExecutionHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkflowExecutionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Archived {
+		i--
+		if m.Archived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RawHistory) > 0 {
+		for iNdEx := len(m.RawHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RawHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.History != nil {
+		{
+			size, err := m.History.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeatureFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeatureFlags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeatureFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.WorkflowExecutionAlreadyCompletedErrorEnabled {
+		i--
+		if m.WorkflowExecutionAlreadyCompletedErrorEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintServiceWorkflow(dAtA []byte, offset int, v uint64) int {
+	offset -= sovServiceWorkflow(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StartWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.ExecutionStartToCloseTimeout != nil {
+		l = m.ExecutionStartToCloseTimeout.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.TaskStartToCloseTimeout != nil {
+		l = m.TaskStartToCloseTimeout.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowIdReusePolicy != 0 {
+		n += 1 + sovServiceWorkflow(uint64(m.WorkflowIdReusePolicy))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.CronSchedule)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.Memo != nil {
+		l = m.Memo.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.SearchAttributes != nil {
+		l = m.SearchAttributes.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.DelayStart != nil {
+		l = m.DelayStart.Size()
+		n += 2 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowIdConflictPolicy != 0 {
+		n += 2 + sovServiceWorkflow(uint64(m.WorkflowIdConflictPolicy))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StartWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignalWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.SignalName)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.SignalInput != nil {
+		l = m.SignalInput.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Control)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignalWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignalWithStartWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartRequest != nil {
+		l = m.StartRequest.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.SignalName)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.SignalInput != nil {
+		l = m.SignalInput.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Control)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignalWithStartWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResetWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.DecisionFinishEventId != 0 {
+		n += 1 + sovServiceWorkflow(uint64(m.DecisionFinishEventId))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.SkipSignalReapply {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResetWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestartWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestartWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestCancelWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PauseWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PauseWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnpauseWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.Archive {
+		n += 2
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PauseActivityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.ActivityId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PauseActivityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnpauseActivityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.ActivityId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnpauseActivityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResetActivityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.ActivityId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.RetryNow {
+		n += 2
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResetActivityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.UpdateName)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.Spec != nil {
+		l = m.Spec.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.Action != nil {
+		l = m.Action.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.Policies != nil {
+		l = m.Policies.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Spec != nil {
+		l = m.Spec.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.Action != nil {
+		l = m.Action.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.Policies != nil {
+		l = m.Policies.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.Spec != nil {
+		l = m.Spec.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.Action != nil {
+		l = m.Action.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.Policies != nil {
+		l = m.Policies.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PauseScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PauseScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnpauseScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnpauseScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackfillScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = m.StartTime.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = m.EndTime.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.OverlapPolicy != 0 {
+		n += 1 + sovServiceWorkflow(uint64(m.OverlapPolicy))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackfillScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovServiceWorkflow(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovServiceWorkflow(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TerminateWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecutionConfiguration != nil {
+		l = m.ExecutionConfiguration.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowExecutionInfo != nil {
+		l = m.WorkflowExecutionInfo.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if len(m.PendingActivities) > 0 {
+		for _, e := range m.PendingActivities {
+			l = e.Size()
+			n += 1 + l + sovServiceWorkflow(uint64(l))
+		}
+	}
+	if len(m.PendingChildren) > 0 {
+		for _, e := range m.PendingChildren {
+			l = e.Size()
+			n += 1 + l + sovServiceWorkflow(uint64(l))
+		}
+	}
+	if m.PendingDecision != nil {
+		l = m.PendingDecision.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if len(m.SignalRequestIds) > 0 {
+		for k, v := range m.SignalRequestIds {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovServiceWorkflow(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovServiceWorkflow(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovServiceWorkflow(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *QueryWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.Query != nil {
+		l = m.Query.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.QueryRejectCondition != 0 {
+		n += 1 + sovServiceWorkflow(uint64(m.QueryRejectCondition))
+	}
+	if m.QueryConsistencyLevel != 0 {
+		n += 1 + sovServiceWorkflow(uint64(m.QueryConsistencyLevel))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *QueryWorkflowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryResult != nil {
+		l = m.QueryResult.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.QueryRejected != nil {
+		l = m.QueryRejected.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeTaskListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovServiceWorkflow(uint64(m.TaskListType))
+	}
+	if m.IncludeTaskListStatus {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeTaskListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pollers) > 0 {
+		for _, e := range m.Pollers {
+			l = e.Size()
+			n += 1 + l + sovServiceWorkflow(uint64(l))
+		}
+	}
+	if m.TaskListStatus != nil {
+		l = m.TaskListStatus.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskListsByDomainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskListsByDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskListNames) > 0 {
+		for _, s := range m.TaskListNames {
+			l = len(s)
+			n += 1 + l + sovServiceWorkflow(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListTaskListPartitionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListTaskListPartitionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActivityTaskListPartitions) > 0 {
+		for _, e := range m.ActivityTaskListPartitions {
+			l = e.Size()
+			n += 1 + l + sovServiceWorkflow(uint64(l))
+		}
+	}
+	if len(m.DecisionTaskListPartitions) > 0 {
+		for _, e := range m.DecisionTaskListPartitions {
+			l = e.Size()
+			n += 1 + l + sovServiceWorkflow(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetClusterInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetClusterInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SupportedClientVersions != nil {
+		l = m.SupportedClientVersions.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetWorkflowExecutionHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovServiceWorkflow(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WaitForNewEvent {
+		n += 2
+	}
+	if m.HistoryEventFilterType != 0 {
+		n += 1 + sovServiceWorkflow(uint64(m.HistoryEventFilterType))
+	}
+	if m.SkipArchival {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetWorkflowExecutionHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.History != nil {
+		l = m.History.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if len(m.RawHistory) > 0 {
+		for _, e := range m.RawHistory {
+			l = e.Size()
+			n += 1 + l + sovServiceWorkflow(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.Archived {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FeatureFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecutionAlreadyCompletedErrorEnabled {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovServiceWorkflow(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozServiceWorkflow(x uint64) (n int) {
+	return sovServiceWorkflow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Payload{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionStartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionStartToCloseTimeout == nil {
+				m.ExecutionStartToCloseTimeout = &types.Duration{}
+			}
+			if err := m.ExecutionStartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskStartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskStartToCloseTimeout == nil {
+				m.TaskStartToCloseTimeout = &types.Duration{}
+			}
+			if err := m.TaskStartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowIdReusePolicy", wireType)
+			}
+			m.WorkflowIdReusePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowIdReusePolicy |= WorkflowIdReusePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronSchedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronSchedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Memo == nil {
+				m.Memo = &Memo{}
+			}
+			if err := m.Memo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SearchAttributes == nil {
+				m.SearchAttributes = &SearchAttributes{}
+			}
+			if err := m.SearchAttributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelayStart == nil {
+				m.DelayStart = &types.Duration{}
+			}
+			if err := m.DelayStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowIdConflictPolicy", wireType)
+			}
+			m.WorkflowIdConflictPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowIdConflictPolicy |= WorkflowIdConflictPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignalInput == nil {
+				m.SignalInput = &Payload{}
+			}
+			if err := m.SignalInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Control", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Control = append(m.Control[:0], dAtA[iNdEx:postIndex]...)
+			if m.Control == nil {
+				m.Control = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartRequest == nil {
+				m.StartRequest = &StartWorkflowExecutionRequest{}
+			}
+			if err := m.StartRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignalInput == nil {
+				m.SignalInput = &Payload{}
+			}
+			if err := m.SignalInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Control", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Control = append(m.Control[:0], dAtA[iNdEx:postIndex]...)
+			if m.Control == nil {
+				m.Control = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionFinishEventId", wireType)
+			}
+			m.DecisionFinishEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionFinishEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipSignalReapply", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipSignalReapply = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnpauseWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnpauseWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Archive = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseActivityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseActivityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseActivityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseActivityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnpauseActivityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnpauseActivityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnpauseActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnpauseActivityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnpauseActivityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnpauseActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetActivityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetActivityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryNow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RetryNow = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetActivityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetActivityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Payload{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &Payload{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Spec == nil {
+				m.Spec = &ScheduleSpec{}
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Action == nil {
+				m.Action = &ScheduleAction{}
+			}
+			if err := m.Action.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policies == nil {
+				m.Policies = &SchedulePolicies{}
+			}
+			if err := m.Policies.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *CreateScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovServiceWorkflow(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozServiceWorkflow(x uint64) (n int) {
-	return sovServiceWorkflow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4172,10 +12686,10 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4212,7 +12726,7 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4240,83 +12754,65 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServiceWorkflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &WorkflowType{}
-			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServiceWorkflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthServiceWorkflow
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthServiceWorkflow
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskList == nil {
-				m.TaskList = &TaskList{}
-			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4343,16 +12839,16 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Payload{}
+			if m.Spec == nil {
+				m.Spec = &ScheduleSpec{}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionStartToCloseTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4379,16 +12875,16 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutionStartToCloseTimeout == nil {
-				m.ExecutionStartToCloseTimeout = &types.Duration{}
+			if m.Action == nil {
+				m.Action = &ScheduleAction{}
 			}
-			if err := m.ExecutionStartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Action.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskStartToCloseTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4415,82 +12911,18 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskStartToCloseTimeout == nil {
-				m.TaskStartToCloseTimeout = &types.Duration{}
+			if m.Policies == nil {
+				m.Policies = &SchedulePolicies{}
 			}
-			if err := m.TaskStartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policies.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServiceWorkflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthServiceWorkflow
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServiceWorkflow
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServiceWorkflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthServiceWorkflow
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServiceWorkflow
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowIdReusePolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.WorkflowIdReusePolicy = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServiceWorkflow
@@ -4500,14 +12932,31 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkflowIdReusePolicy |= WorkflowIdReusePolicy(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &ScheduleState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4534,16 +12983,70 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetryPolicy == nil {
-				m.RetryPolicy = &RetryPolicy{}
+			if m.Info == nil {
+				m.Info = &ScheduleInfo{}
 			}
-			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CronSchedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4571,13 +13074,13 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CronSchedule = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServiceWorkflow
@@ -4587,31 +13090,27 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Memo == nil {
-				m.Memo = &Memo{}
-			}
-			if err := m.Memo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4638,16 +13137,16 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SearchAttributes == nil {
-				m.SearchAttributes = &SearchAttributes{}
+			if m.Spec == nil {
+				m.Spec = &ScheduleSpec{}
 			}
-			if err := m.SearchAttributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4674,16 +13173,16 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &Header{}
+			if m.Action == nil {
+				m.Action = &ScheduleAction{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Action.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelayStart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4710,70 +13209,48 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DelayStart == nil {
-				m.DelayStart = &types.Duration{}
+			if m.Policies == nil {
+				m.Policies = &SchedulePolicies{}
 			}
-			if err := m.DelayStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policies.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthServiceWorkflow
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServiceWorkflow
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StartWorkflowExecutionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4801,7 +13278,7 @@ func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4828,7 +13305,7 @@ func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4851,83 +13328,69 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServiceWorkflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthServiceWorkflow
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServiceWorkflow
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServiceWorkflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &WorkflowExecution{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4955,11 +13418,11 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4987,11 +13450,11 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5019,13 +13482,13 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalName = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServiceWorkflow
@@ -5035,33 +13498,29 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SignalInput == nil {
-				m.SignalInput = &Payload{}
-			}
-			if err := m.SignalInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Control", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServiceWorkflow
@@ -5071,25 +13530,23 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Control = append(m.Control[:0], dAtA[iNdEx:postIndex]...)
-			if m.Control == nil {
-				m.Control = []byte{}
-			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5116,7 +13573,7 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *PauseScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5139,10 +13596,10 @@ func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5170,7 +13627,7 @@ func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *UnpauseScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5193,17 +13650,17 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServiceWorkflow
@@ -5213,31 +13670,27 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartRequest == nil {
-				m.StartRequest = &StartWorkflowExecutionRequest{}
-			}
-			if err := m.StartRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5265,13 +13718,13 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalName = string(dAtA[iNdEx:postIndex])
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServiceWorkflow
@@ -5281,33 +13734,29 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SignalInput == nil {
-				m.SignalInput = &Payload{}
-			}
-			if err := m.SignalInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Control", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServiceWorkflow
@@ -5317,25 +13766,55 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Control = append(m.Control[:0], dAtA[iNdEx:postIndex]...)
-			if m.Control == nil {
-				m.Control = []byte{}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5362,7 +13841,7 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *UnpauseScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5385,44 +13864,12 @@ func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServiceWorkflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthServiceWorkflow
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServiceWorkflow
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
@@ -5448,7 +13895,7 @@ func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *BackfillScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5471,10 +13918,10 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackfillScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackfillScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5511,7 +13958,39 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5538,18 +14017,18 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &WorkflowExecution{}
+			if m.StartTime == nil {
+				m.StartTime = &types.Timestamp{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServiceWorkflow
@@ -5559,29 +14038,33 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if m.EndTime == nil {
+				m.EndTime = &types.Timestamp{}
+			}
+			if err := m.EndTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionFinishEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OverlapPolicy", wireType)
 			}
-			m.DecisionFinishEventId = 0
+			m.OverlapPolicy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServiceWorkflow
@@ -5591,14 +14074,14 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DecisionFinishEventId |= int64(b&0x7F) << shift
+				m.OverlapPolicy |= ScheduleOverlapPolicy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5626,13 +14109,13 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkipSignalReapply", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServiceWorkflow
@@ -5642,12 +14125,24 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SkipSignalReapply = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
@@ -5673,7 +14168,7 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *BackfillScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5696,44 +14191,12 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackfillScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackfillScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServiceWorkflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthServiceWorkflow
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServiceWorkflow
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
@@ -5759,7 +14222,7 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *ListSchedulesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5782,10 +14245,10 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListSchedulesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5821,10 +14284,10 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			var msglen int
+			m.PageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServiceWorkflow
@@ -5834,33 +14297,16 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PageSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthServiceWorkflow
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthServiceWorkflow
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServiceWorkflow
@@ -5870,55 +14316,25 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServiceWorkflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthServiceWorkflow
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServiceWorkflow
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5945,7 +14361,7 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *ListSchedulesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5968,12 +14384,80 @@ func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListSchedulesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, &ScheduleListEntry{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
@@ -6602,6 +15086,135 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalRequestIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignalRequestIds == nil {
+				m.SignalRequestIds = make(map[string]*types.Timestamp)
+			}
+			var mapkey string
+			var mapvalue *types.Timestamp
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServiceWorkflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServiceWorkflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthServiceWorkflow
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthServiceWorkflow
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServiceWorkflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthServiceWorkflow
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthServiceWorkflow
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &types.Timestamp{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthServiceWorkflow
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SignalRequestIds[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
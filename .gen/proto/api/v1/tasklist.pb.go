@@ -266,6 +266,7 @@ type TaskListStatus struct {
 	AckLevel             int64        `protobuf:"varint,3,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
 	RatePerSecond        float64      `protobuf:"fixed64,4,opt,name=rate_per_second,json=ratePerSecond,proto3" json:"rate_per_second,omitempty"`
 	TaskIdBlock          *TaskIDBlock `protobuf:"bytes,5,opt,name=task_id_block,json=taskIdBlock,proto3" json:"task_id_block,omitempty"`
+	Paused               bool         `protobuf:"varint,6,opt,name=paused,proto3" json:"paused,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -339,6 +340,13 @@ func (m *TaskListStatus) GetTaskIdBlock() *TaskIDBlock {
 	return nil
 }
 
+func (m *TaskListStatus) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 type TaskIDBlock struct {
 	StartId              int64    `protobuf:"varint,1,opt,name=start_id,json=startId,proto3" json:"start_id,omitempty"`
 	EndId                int64    `protobuf:"varint,2,opt,name=end_id,json=endId,proto3" json:"end_id,omitempty"`
@@ -529,55 +537,56 @@ func init() {
 }
 
 var fileDescriptor_216fa006947e00a0 = []byte{
-	// 764 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcb, 0x6e, 0xdc, 0x36,
-	0x14, 0xad, 0x3c, 0x4e, 0x3a, 0xa6, 0x6b, 0x47, 0x65, 0x9b, 0xc4, 0x33, 0x69, 0x5c, 0x57, 0x8b,
-	0xc0, 0x08, 0x0a, 0x09, 0x76, 0xd1, 0x55, 0x17, 0xc5, 0xd8, 0x63, 0x34, 0x82, 0xc7, 0x93, 0x81,
-	0xa4, 0x18, 0x70, 0x37, 0x2c, 0x25, 0xde, 0x8c, 0x09, 0x3d, 0x28, 0x90, 0x94, 0x1f, 0x3f, 0xd2,
-	0x8f, 0xe9, 0x17, 0x74, 0xd9, 0x4f, 0x28, 0xbc, 0xea, 0x67, 0x14, 0xa4, 0x34, 0xae, 0x1f, 0xd3,
-	0xec, 0xc8, 0x7b, 0xee, 0xb9, 0x8f, 0xc3, 0x7b, 0x89, 0xbc, 0x26, 0x05, 0x19, 0x64, 0x94, 0x41,
-	0x95, 0x41, 0x40, 0x6b, 0x1e, 0x5c, 0xec, 0x05, 0x9a, 0xaa, 0xbc, 0xe0, 0x4a, 0xfb, 0xb5, 0x14,
-	0x5a, 0xe0, 0xaf, 0x8c, 0x8f, 0xdf, 0xf9, 0xf8, 0xb4, 0xe6, 0xfe, 0xc5, 0xde, 0x70, 0x7b, 0x2e,
-	0xc4, 0xbc, 0x80, 0xc0, 0xba, 0xa4, 0xcd, 0xc7, 0x80, 0x35, 0x92, 0x6a, 0x2e, 0xaa, 0x96, 0x34,
-	0xfc, 0xf6, 0x21, 0xae, 0x79, 0x09, 0x4a, 0xd3, 0xb2, 0xee, 0x1c, 0x1e, 0x05, 0xb8, 0x94, 0xb4,
-	0xae, 0x41, 0xaa, 0x16, 0xf7, 0x3e, 0xa0, 0x7e, 0x42, 0x55, 0x3e, 0xe1, 0x4a, 0x63, 0x8c, 0x56,
-	0x2b, 0x5a, 0xc2, 0x96, 0xb3, 0xe3, 0xec, 0xae, 0x45, 0xf6, 0x8c, 0x7f, 0x44, 0xab, 0x39, 0xaf,
-	0xd8, 0xd6, 0xca, 0x8e, 0xb3, 0xbb, 0xb9, 0xff, 0x9d, 0xbf, 0xa4, 0x48, 0x7f, 0x11, 0xe0, 0x98,
-	0x57, 0x2c, 0xb2, 0xee, 0x1e, 0x45, 0xee, 0xc2, 0x7a, 0x02, 0x9a, 0x32, 0xaa, 0x29, 0x3e, 0x41,
-	0x5f, 0x97, 0xf4, 0x8a, 0x98, 0xb6, 0x15, 0xa9, 0x41, 0x12, 0x05, 0x99, 0xa8, 0x98, 0x4d, 0xb7,
-	0xbe, 0xff, 0x8d, 0xdf, 0x56, 0xea, 0x2f, 0x2a, 0xf5, 0xc7, 0xa2, 0x49, 0x0b, 0x38, 0xa5, 0x45,
-	0x03, 0xd1, 0x97, 0x25, 0xbd, 0x32, 0x01, 0xd5, 0x0c, 0x64, 0x6c, 0x69, 0xde, 0x07, 0x34, 0x58,
-	0xa4, 0x98, 0x51, 0xa9, 0xb9, 0x51, 0xe5, 0x36, 0x97, 0x8b, 0x7a, 0x39, 0x5c, 0x77, 0x9d, 0x98,
-	0x23, 0x7e, 0x83, 0x9e, 0x89, 0xcb, 0x0a, 0x24, 0x39, 0x17, 0x4a, 0x13, 0xdb, 0xe7, 0x8a, 0x45,
-	0x37, 0xac, 0xf9, 0x9d, 0x50, 0x7a, 0x4a, 0x4b, 0xf0, 0xfe, 0x71, 0xd0, 0xe6, 0x22, 0x6e, 0xac,
-	0xa9, 0x6e, 0x14, 0xfe, 0x1e, 0xe1, 0x94, 0x66, 0x79, 0x21, 0xe6, 0x24, 0x13, 0x4d, 0xa5, 0xc9,
-	0x39, 0xaf, 0xb4, 0x8d, 0xdd, 0x8b, 0xdc, 0x0e, 0x39, 0x34, 0xc0, 0x3b, 0x5e, 0x69, 0xfc, 0x1a,
-	0x21, 0x09, 0x94, 0x91, 0x02, 0x2e, 0xa0, 0xb0, 0x39, 0x7a, 0xd1, 0x9a, 0xb1, 0x4c, 0x8c, 0x01,
-	0xbf, 0x42, 0x6b, 0x34, 0xcb, 0x3b, 0xb4, 0x67, 0xd1, 0x3e, 0xcd, 0xf2, 0x16, 0x7c, 0x83, 0x9e,
-	0x49, 0xaa, 0xe1, 0xae, 0x3a, 0xab, 0x3b, 0xce, 0xae, 0x13, 0x6d, 0x18, 0xf3, 0x6d, 0xef, 0x78,
-	0x8c, 0x36, 0x8c, 0x8c, 0x84, 0x33, 0x92, 0x16, 0x22, 0xcb, 0xb7, 0x9e, 0x58, 0x0d, 0x77, 0xfe,
-	0xf7, 0x79, 0xc2, 0xf1, 0x81, 0xf1, 0x8b, 0xd6, 0x0d, 0x2d, 0x64, 0xf6, 0xe2, 0xfd, 0x8c, 0xd6,
-	0xef, 0x60, 0x78, 0x80, 0xfa, 0x4a, 0x53, 0xa9, 0x09, 0x67, 0x5d, 0x73, 0x9f, 0xdb, 0x7b, 0xc8,
-	0xf0, 0x73, 0xf4, 0x14, 0x2a, 0x66, 0x80, 0xb6, 0x9f, 0x27, 0x50, 0xb1, 0x90, 0x79, 0xbf, 0x3b,
-	0x08, 0xcd, 0x44, 0x51, 0x80, 0x0c, 0xab, 0x8f, 0x02, 0x8f, 0x91, 0x5b, 0x50, 0xa5, 0x09, 0xcd,
-	0x32, 0x50, 0x8a, 0x98, 0x51, 0xec, 0x1e, 0x77, 0xf8, 0xe8, 0x71, 0x93, 0xc5, 0x9c, 0x46, 0x9b,
-	0x86, 0x33, 0xb2, 0x14, 0x63, 0xc4, 0x43, 0xd4, 0xe7, 0x0c, 0x2a, 0xcd, 0xf5, 0x75, 0xf7, 0x42,
-	0xb7, 0xf7, 0x65, 0xfa, 0xf4, 0x96, 0xe8, 0xe3, 0xfd, 0xe1, 0xa0, 0x41, 0xac, 0x79, 0x96, 0x5f,
-	0x1f, 0x5d, 0x41, 0xd6, 0x98, 0xd1, 0x18, 0x69, 0x2d, 0x79, 0xda, 0x68, 0x50, 0xf8, 0x17, 0xe4,
-	0x5e, 0x0a, 0x99, 0x83, 0xb4, 0xb3, 0x48, 0xcc, 0x0e, 0x76, 0x75, 0xbe, 0xfe, 0xe4, 0x7c, 0x47,
-	0x9b, 0x2d, 0xed, 0x76, 0x61, 0x12, 0x34, 0x50, 0xd9, 0x39, 0xb0, 0xa6, 0x00, 0xa2, 0x05, 0x69,
-	0xd5, 0x33, 0x6d, 0x8b, 0x46, 0xdb, 0xda, 0xd7, 0xf7, 0x07, 0x8f, 0xc7, 0xba, 0xdb, 0xe0, 0xe8,
-	0xc5, 0x82, 0x9b, 0x88, 0xd8, 0x30, 0x93, 0x96, 0xf8, 0xf6, 0x37, 0xf4, 0xc5, 0xdd, 0x8d, 0xc2,
-	0x43, 0xf4, 0x22, 0x19, 0xc5, 0xc7, 0x64, 0x12, 0xc6, 0x09, 0x39, 0x0e, 0xa7, 0x63, 0x12, 0x4e,
-	0x4f, 0x47, 0x93, 0x70, 0xec, 0x7e, 0x86, 0x07, 0xe8, 0xf9, 0x03, 0x6c, 0xfa, 0x3e, 0x3a, 0x19,
-	0x4d, 0x5c, 0x67, 0x09, 0x14, 0x27, 0xe1, 0xe1, 0xf1, 0x99, 0xbb, 0xf2, 0x96, 0xfd, 0x97, 0x21,
-	0xb9, 0xae, 0xe1, 0x7e, 0x86, 0xe4, 0x6c, 0x76, 0x74, 0x27, 0xc3, 0x2b, 0xf4, 0xf2, 0x01, 0x36,
-	0x3e, 0x3a, 0x0c, 0xe3, 0xf0, 0xfd, 0xd4, 0x75, 0x96, 0x80, 0xa3, 0xc3, 0x24, 0x3c, 0x0d, 0x93,
-	0x33, 0x77, 0xe5, 0x20, 0xfd, 0xf3, 0x66, 0xdb, 0xf9, 0xeb, 0x66, 0xdb, 0xf9, 0xfb, 0x66, 0xdb,
-	0x41, 0x2f, 0x33, 0x51, 0x2e, 0x53, 0xf7, 0xa0, 0x3f, 0xaa, 0xf9, 0xcc, 0x88, 0x33, 0x73, 0x7e,
-	0x0d, 0xe6, 0x5c, 0x9f, 0x37, 0xa9, 0x9f, 0x89, 0x32, 0xb8, 0xf7, 0x65, 0xfa, 0x73, 0xa8, 0xda,
-	0x3f, 0xac, 0xfb, 0x3d, 0x7f, 0xa2, 0x35, 0xbf, 0xd8, 0x4b, 0x9f, 0x5a, 0xdb, 0x0f, 0xff, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0xed, 0xf7, 0x38, 0xe6, 0x61, 0x05, 0x00, 0x00,
+	// 777 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x6e, 0xe3, 0x36,
+	0x10, 0xae, 0xec, 0x6c, 0xea, 0x4c, 0x9a, 0xac, 0xca, 0x76, 0xb3, 0xb1, 0xb7, 0x9b, 0xba, 0x3e,
+	0x2c, 0x82, 0x45, 0x21, 0x23, 0x29, 0x7a, 0xea, 0xa1, 0x70, 0xe2, 0xa0, 0x2b, 0xc4, 0xf1, 0x1a,
+	0xb2, 0x36, 0x40, 0x7a, 0x61, 0x29, 0x71, 0xd6, 0x21, 0x24, 0x8b, 0x82, 0x48, 0xe5, 0xe7, 0xd6,
+	0xa7, 0xe8, 0xc3, 0xf4, 0x09, 0x7a, 0xec, 0x23, 0x14, 0x79, 0x92, 0x82, 0x94, 0x9c, 0x3a, 0x89,
+	0xbb, 0x37, 0x72, 0xbe, 0xf9, 0xe6, 0xe7, 0xe3, 0x0c, 0xa1, 0x57, 0x46, 0x58, 0xf4, 0x63, 0xc6,
+	0x31, 0x8b, 0xb1, 0xcf, 0x72, 0xd1, 0xbf, 0x3a, 0xe8, 0x6b, 0xa6, 0x92, 0x54, 0x28, 0xed, 0xe5,
+	0x85, 0xd4, 0x92, 0x7c, 0x65, 0x7c, 0xbc, 0xda, 0xc7, 0x63, 0xb9, 0xf0, 0xae, 0x0e, 0x3a, 0x7b,
+	0x33, 0x29, 0x67, 0x29, 0xf6, 0xad, 0x4b, 0x54, 0x7e, 0xec, 0xf3, 0xb2, 0x60, 0x5a, 0xc8, 0xac,
+	0x22, 0x75, 0xbe, 0x7d, 0x8c, 0x6b, 0x31, 0x47, 0xa5, 0xd9, 0x3c, 0xaf, 0x1d, 0x9e, 0x04, 0xb8,
+	0x2e, 0x58, 0x9e, 0x63, 0xa1, 0x2a, 0xbc, 0xf7, 0x01, 0x5a, 0x21, 0x53, 0xc9, 0x48, 0x28, 0x4d,
+	0x08, 0xac, 0x65, 0x6c, 0x8e, 0xbb, 0x4e, 0xd7, 0xd9, 0xdf, 0x08, 0xec, 0x99, 0xfc, 0x08, 0x6b,
+	0x89, 0xc8, 0xf8, 0x6e, 0xa3, 0xeb, 0xec, 0x6f, 0x1f, 0x7e, 0xe7, 0xad, 0x28, 0xd2, 0x5b, 0x04,
+	0x38, 0x15, 0x19, 0x0f, 0xac, 0x7b, 0x8f, 0x81, 0xbb, 0xb0, 0x9e, 0xa1, 0x66, 0x9c, 0x69, 0x46,
+	0xce, 0xe0, 0xeb, 0x39, 0xbb, 0xa1, 0xa6, 0x6d, 0x45, 0x73, 0x2c, 0xa8, 0xc2, 0x58, 0x66, 0xdc,
+	0xa6, 0xdb, 0x3c, 0xfc, 0xc6, 0xab, 0x2a, 0xf5, 0x16, 0x95, 0x7a, 0x43, 0x59, 0x46, 0x29, 0x9e,
+	0xb3, 0xb4, 0xc4, 0xe0, 0xcb, 0x39, 0xbb, 0x31, 0x01, 0xd5, 0x04, 0x8b, 0xa9, 0xa5, 0xf5, 0x3e,
+	0x40, 0x7b, 0x91, 0x62, 0xc2, 0x0a, 0x2d, 0x8c, 0x2a, 0xf7, 0xb9, 0x5c, 0x68, 0x26, 0x78, 0x5b,
+	0x77, 0x62, 0x8e, 0xe4, 0x0d, 0x3c, 0x97, 0xd7, 0x19, 0x16, 0xf4, 0x52, 0x2a, 0x4d, 0x6d, 0x9f,
+	0x0d, 0x8b, 0x6e, 0x59, 0xf3, 0x3b, 0xa9, 0xf4, 0x98, 0xcd, 0xb1, 0xf7, 0x7b, 0x03, 0xb6, 0x17,
+	0x71, 0xa7, 0x9a, 0xe9, 0x52, 0x91, 0xef, 0x81, 0x44, 0x2c, 0x4e, 0x52, 0x39, 0xa3, 0xb1, 0x2c,
+	0x33, 0x4d, 0x2f, 0x45, 0xa6, 0x6d, 0xec, 0x66, 0xe0, 0xd6, 0xc8, 0xb1, 0x01, 0xde, 0x89, 0x4c,
+	0x93, 0xd7, 0x00, 0x05, 0x32, 0x4e, 0x53, 0xbc, 0xc2, 0xd4, 0xe6, 0x68, 0x06, 0x1b, 0xc6, 0x32,
+	0x32, 0x06, 0xf2, 0x0a, 0x36, 0x58, 0x9c, 0xd4, 0x68, 0xd3, 0xa2, 0x2d, 0x16, 0x27, 0x15, 0xf8,
+	0x06, 0x9e, 0x17, 0x4c, 0xe3, 0xb2, 0x3a, 0x6b, 0x5d, 0x67, 0xdf, 0x09, 0xb6, 0x8c, 0xf9, 0xbe,
+	0x77, 0x32, 0x84, 0x2d, 0x23, 0x23, 0x15, 0x9c, 0x46, 0xa9, 0x8c, 0x93, 0xdd, 0x67, 0x56, 0xc3,
+	0xee, 0xff, 0x3e, 0x8f, 0x3f, 0x3c, 0x32, 0x7e, 0xc1, 0xa6, 0xa1, 0xf9, 0xdc, 0x5e, 0xc8, 0x0e,
+	0xac, 0xe7, 0xac, 0x54, 0xc8, 0x77, 0xd7, 0xbb, 0xce, 0x7e, 0x2b, 0xa8, 0x6f, 0xbd, 0x9f, 0x61,
+	0x73, 0x89, 0x43, 0xda, 0xd0, 0x52, 0x9a, 0x15, 0x9a, 0x0a, 0x5e, 0x37, 0xfd, 0xb9, 0xbd, 0xfb,
+	0x9c, 0xbc, 0x80, 0x75, 0xcc, 0xb8, 0x01, 0xaa, 0x3e, 0x9f, 0x61, 0xc6, 0x7d, 0xde, 0xfb, 0xc3,
+	0x01, 0x98, 0xc8, 0x34, 0xc5, 0xc2, 0xcf, 0x3e, 0x4a, 0x32, 0x04, 0x37, 0x65, 0x4a, 0x53, 0x16,
+	0xc7, 0xa8, 0x14, 0x35, 0x23, 0x5a, 0x3f, 0x7a, 0xe7, 0xc9, 0xa3, 0x87, 0x8b, 0xf9, 0x0d, 0xb6,
+	0x0d, 0x67, 0x60, 0x29, 0xc6, 0x48, 0x3a, 0xd0, 0x12, 0x1c, 0x33, 0x2d, 0xf4, 0x6d, 0xfd, 0x72,
+	0xf7, 0xf7, 0x55, 0xba, 0x35, 0x57, 0xe8, 0xd6, 0xfb, 0xd3, 0x81, 0xf6, 0x54, 0x8b, 0x38, 0xb9,
+	0x3d, 0xb9, 0xc1, 0xb8, 0x34, 0x23, 0x33, 0xd0, 0xba, 0x10, 0x51, 0xa9, 0x51, 0x91, 0x5f, 0xc0,
+	0xbd, 0x96, 0x45, 0x82, 0x85, 0x9d, 0x51, 0x6a, 0x76, 0xb3, 0xae, 0xf3, 0xf5, 0x27, 0xe7, 0x3e,
+	0xd8, 0xae, 0x68, 0xf7, 0x8b, 0x14, 0x42, 0x5b, 0xc5, 0x97, 0xc8, 0xcb, 0x14, 0xa9, 0x96, 0xb4,
+	0x52, 0xcf, 0xb4, 0x2d, 0x4b, 0x6d, 0x6b, 0xdf, 0x3c, 0x6c, 0x3f, 0x1d, 0xf7, 0x7a, 0xb3, 0x83,
+	0x9d, 0x05, 0x37, 0x94, 0x53, 0xc3, 0x0c, 0x2b, 0xe2, 0xdb, 0xdf, 0xe0, 0x8b, 0xe5, 0x4d, 0x23,
+	0x1d, 0xd8, 0x09, 0x07, 0xd3, 0x53, 0x3a, 0xf2, 0xa7, 0x21, 0x3d, 0xf5, 0xc7, 0x43, 0xea, 0x8f,
+	0xcf, 0x07, 0x23, 0x7f, 0xe8, 0x7e, 0x46, 0xda, 0xf0, 0xe2, 0x11, 0x36, 0x7e, 0x1f, 0x9c, 0x0d,
+	0x46, 0xae, 0xb3, 0x02, 0x9a, 0x86, 0xfe, 0xf1, 0xe9, 0x85, 0xdb, 0x78, 0xcb, 0xff, 0xcb, 0x10,
+	0xde, 0xe6, 0xf8, 0x30, 0x43, 0x78, 0x31, 0x39, 0x59, 0xca, 0xf0, 0x0a, 0x5e, 0x3e, 0xc2, 0x86,
+	0x27, 0xc7, 0xfe, 0xd4, 0x7f, 0x3f, 0x76, 0x9d, 0x15, 0xe0, 0xe0, 0x38, 0xf4, 0xcf, 0xfd, 0xf0,
+	0xc2, 0x6d, 0x1c, 0x45, 0x7f, 0xdd, 0xed, 0x39, 0x7f, 0xdf, 0xed, 0x39, 0xff, 0xdc, 0xed, 0x39,
+	0xf0, 0x32, 0x96, 0xf3, 0x55, 0xea, 0x1e, 0xb5, 0x06, 0xb9, 0x98, 0x18, 0x71, 0x26, 0xce, 0xaf,
+	0xfd, 0x99, 0xd0, 0x97, 0x65, 0xe4, 0xc5, 0x72, 0xde, 0x7f, 0xf0, 0x95, 0x7a, 0x33, 0xcc, 0xaa,
+	0xbf, 0xad, 0xfe, 0x55, 0x7f, 0x62, 0xb9, 0xb8, 0x3a, 0x88, 0xd6, 0xad, 0xed, 0x87, 0x7f, 0x07,
+	0x00, 0x22, 0x70, 0xca, 0x92, 0x79, 0x05, 0x00, 0x00,
 }
 
 func (m *TaskList) Marshal() (dAtA []byte, err error) {
@@ -723,6 +732,16 @@ func (m *TaskListStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.TaskIdBlock != nil {
 		{
 			size, err := m.TaskIdBlock.MarshalToSizedBuffer(dAtA[:i])
@@ -987,6 +1006,9 @@ func (m *TaskListStatus) Size() (n int) {
 		l = m.TaskIdBlock.Size()
 		n += 1 + l + sovTasklist(uint64(l))
 	}
+	if m.Paused {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1506,6 +1528,26 @@ func (m *TaskListStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTasklist(dAtA[iNdEx:])
@@ -49,13 +49,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type IndexedValueType int32
 
 const (
-	IndexedValueType_INDEXED_VALUE_TYPE_INVALID  IndexedValueType = 0
-	IndexedValueType_INDEXED_VALUE_TYPE_STRING   IndexedValueType = 1
-	IndexedValueType_INDEXED_VALUE_TYPE_KEYWORD  IndexedValueType = 2
-	IndexedValueType_INDEXED_VALUE_TYPE_INT      IndexedValueType = 3
-	IndexedValueType_INDEXED_VALUE_TYPE_DOUBLE   IndexedValueType = 4
-	IndexedValueType_INDEXED_VALUE_TYPE_BOOL     IndexedValueType = 5
-	IndexedValueType_INDEXED_VALUE_TYPE_DATETIME IndexedValueType = 6
+	IndexedValueType_INDEXED_VALUE_TYPE_INVALID      IndexedValueType = 0
+	IndexedValueType_INDEXED_VALUE_TYPE_STRING       IndexedValueType = 1
+	IndexedValueType_INDEXED_VALUE_TYPE_KEYWORD      IndexedValueType = 2
+	IndexedValueType_INDEXED_VALUE_TYPE_INT          IndexedValueType = 3
+	IndexedValueType_INDEXED_VALUE_TYPE_DOUBLE       IndexedValueType = 4
+	IndexedValueType_INDEXED_VALUE_TYPE_BOOL         IndexedValueType = 5
+	IndexedValueType_INDEXED_VALUE_TYPE_DATETIME     IndexedValueType = 6
+	IndexedValueType_INDEXED_VALUE_TYPE_KEYWORD_LIST IndexedValueType = 7
 )
 
 var IndexedValueType_name = map[int32]string{
@@ -66,16 +67,18 @@ var IndexedValueType_name = map[int32]string{
 	4: "INDEXED_VALUE_TYPE_DOUBLE",
 	5: "INDEXED_VALUE_TYPE_BOOL",
 	6: "INDEXED_VALUE_TYPE_DATETIME",
+	7: "INDEXED_VALUE_TYPE_KEYWORD_LIST",
 }
 
 var IndexedValueType_value = map[string]int32{
-	"INDEXED_VALUE_TYPE_INVALID":  0,
-	"INDEXED_VALUE_TYPE_STRING":   1,
-	"INDEXED_VALUE_TYPE_KEYWORD":  2,
-	"INDEXED_VALUE_TYPE_INT":      3,
-	"INDEXED_VALUE_TYPE_DOUBLE":   4,
-	"INDEXED_VALUE_TYPE_BOOL":     5,
-	"INDEXED_VALUE_TYPE_DATETIME": 6,
+	"INDEXED_VALUE_TYPE_INVALID":      0,
+	"INDEXED_VALUE_TYPE_STRING":       1,
+	"INDEXED_VALUE_TYPE_KEYWORD":      2,
+	"INDEXED_VALUE_TYPE_INT":          3,
+	"INDEXED_VALUE_TYPE_DOUBLE":       4,
+	"INDEXED_VALUE_TYPE_BOOL":         5,
+	"INDEXED_VALUE_TYPE_DATETIME":     6,
+	"INDEXED_VALUE_TYPE_KEYWORD_LIST": 7,
 }
 
 func (x IndexedValueType) String() string {
@@ -303,37 +306,38 @@ func init() {
 }
 
 var fileDescriptor_c1b2132ef24217c8 = []byte{
-	// 474 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
-	0x14, 0x85, 0x71, 0x68, 0x23, 0xb8, 0x29, 0x60, 0x0d, 0x82, 0x80, 0x2b, 0x12, 0x64, 0xb1, 0xa8,
-	0x58, 0x8c, 0x95, 0xb2, 0xec, 0x02, 0x25, 0xd8, 0xa0, 0x11, 0x21, 0x09, 0x8e, 0x9b, 0x12, 0x36,
-	0xd6, 0xd8, 0x9e, 0x86, 0x11, 0xb6, 0xc7, 0xb2, 0xc7, 0x69, 0xfb, 0x14, 0xbc, 0x16, 0x4b, 0x1e,
-	0x01, 0x65, 0xc9, 0x53, 0x20, 0xff, 0x21, 0x21, 0x1c, 0xb1, 0xb3, 0xcf, 0x3d, 0xe7, 0xd3, 0xdc,
-	0x1f, 0x78, 0x91, 0x7b, 0x2c, 0x35, 0x7c, 0x1a, 0xb0, 0xd8, 0x67, 0x06, 0x4d, 0xb8, 0xb1, 0x1d,
-	0x19, 0x5b, 0x9e, 0x71, 0x8f, 0x87, 0x5c, 0xde, 0xe0, 0x24, 0x15, 0x52, 0xa0, 0x87, 0x85, 0x0b,
-	0xd7, 0x2e, 0x4c, 0x13, 0x8e, 0xb7, 0x23, 0x6d, 0xb8, 0x11, 0x62, 0x13, 0x32, 0xa3, 0xb4, 0x78,
-	0xf9, 0xa5, 0x21, 0x79, 0xc4, 0x32, 0x49, 0xa3, 0xa4, 0x4a, 0x69, 0x7a, 0x1b, 0xfb, 0x4a, 0xa4,
-	0x5f, 0x2f, 0x43, 0x71, 0x55, 0x79, 0xf4, 0x8f, 0xd0, 0xbf, 0xa8, 0x15, 0xeb, 0x9a, 0xf9, 0xb9,
-	0xe4, 0x22, 0x7e, 0xcb, 0x43, 0xc9, 0x52, 0x34, 0x84, 0x5e, 0x63, 0x76, 0x79, 0xf0, 0x44, 0x79,
-	0xae, 0x9c, 0xdc, 0xb5, 0xa1, 0x91, 0x48, 0x80, 0x1e, 0x41, 0x37, 0xcd, 0xe3, 0xa2, 0xd6, 0x29,
-	0x6b, 0x87, 0x69, 0x1e, 0x93, 0x40, 0x3f, 0x01, 0xd4, 0x20, 0x9d, 0x9b, 0x84, 0xd5, 0x34, 0x04,
-	0x07, 0x31, 0x8d, 0x58, 0x8d, 0x29, 0xbf, 0xf5, 0x6f, 0x0a, 0x3c, 0x58, 0x4a, 0x9a, 0x4a, 0x87,
-	0x47, 0x8d, 0xef, 0x35, 0xdc, 0x63, 0x34, 0x0d, 0x39, 0xcb, 0xa4, 0x5b, 0x34, 0x54, 0x06, 0x7a,
-	0xa7, 0x1a, 0xae, 0xba, 0xc5, 0x4d, 0xb7, 0xd8, 0x69, 0xba, 0xb5, 0x8f, 0x9a, 0x40, 0x21, 0xa1,
-	0x33, 0xe8, 0x85, 0x54, 0xfe, 0x89, 0x77, 0xfe, 0x1b, 0x87, 0xca, 0x5e, 0x08, 0xfa, 0x1a, 0x8e,
-	0x96, 0x92, 0xca, 0x3c, 0xab, 0x5f, 0x43, 0xa0, 0x9b, 0x95, 0xff, 0xe5, 0x33, 0xee, 0x9f, 0x8e,
-	0x70, 0xcb, 0x26, 0xf0, 0x3f, 0x13, 0x7c, 0x13, 0x8a, 0x8c, 0x55, 0x20, 0xbb, 0x06, 0xbc, 0xfc,
-	0xa5, 0x80, 0x4a, 0xe2, 0x80, 0x5d, 0xb3, 0x60, 0x45, 0xc3, 0x9c, 0x15, 0xb3, 0x41, 0x03, 0xd0,
-	0xc8, 0xcc, 0xb4, 0x3e, 0x59, 0xa6, 0xbb, 0x1a, 0x4f, 0xcf, 0x2d, 0xd7, 0x59, 0x2f, 0x2c, 0x97,
-	0xcc, 0x56, 0xe3, 0x29, 0x31, 0xd5, 0x5b, 0xe8, 0x19, 0x3c, 0x6d, 0xa9, 0x2f, 0x1d, 0x9b, 0xcc,
-	0xde, 0xa9, 0xca, 0x9e, 0xf8, 0x7b, 0x6b, 0x7d, 0x31, 0xb7, 0x4d, 0xb5, 0x83, 0x34, 0x78, 0xdc,
-	0x8a, 0x77, 0xd4, 0xdb, 0x7b, 0xd0, 0xe6, 0xfc, 0x7c, 0x32, 0xb5, 0xd4, 0x03, 0x74, 0x0c, 0xfd,
-	0x96, 0xf2, 0x64, 0x3e, 0x9f, 0xaa, 0x87, 0x68, 0x08, 0xc7, 0x6d, 0xd9, 0xb1, 0x63, 0x39, 0xe4,
-	0x83, 0xa5, 0x76, 0x27, 0xde, 0xf7, 0xdd, 0x40, 0xf9, 0xb1, 0x1b, 0x28, 0x3f, 0x77, 0x03, 0x05,
-	0xfa, 0xbe, 0x88, 0xda, 0x06, 0x37, 0xb9, 0x33, 0x4e, 0xf8, 0xa2, 0xd8, 0xc8, 0x42, 0xf9, 0x6c,
-	0x6c, 0xb8, 0xfc, 0x92, 0x7b, 0xd8, 0x17, 0x91, 0xf1, 0xd7, 0xe1, 0xe2, 0x0d, 0x8b, 0xab, 0x23,
-	0xaf, 0x6f, 0xf8, 0x8c, 0x26, 0x7c, 0x3b, 0xf2, 0xba, 0xa5, 0xf6, 0xea, 0x77, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xe8, 0x16, 0x6d, 0xcb, 0x43, 0x03, 0x00, 0x00,
+	// 482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x71, 0x68, 0x03, 0x4c, 0x0b, 0x58, 0x8b, 0x20, 0x90, 0x8a, 0x04, 0x19, 0x0e, 0x15,
+	0x87, 0xb5, 0x52, 0x8e, 0x3d, 0xa0, 0x04, 0x1b, 0xb4, 0xc2, 0x24, 0xc1, 0x71, 0x53, 0xc2, 0xc5,
+	0x5a, 0xdb, 0xdb, 0xb0, 0xc2, 0xf6, 0x5a, 0xf6, 0x3a, 0x6d, 0x9f, 0xa2, 0xaf, 0xc5, 0x91, 0x47,
+	0x40, 0x79, 0x12, 0xe4, 0x7f, 0x48, 0x08, 0x47, 0xdc, 0xec, 0x6f, 0xbe, 0xef, 0xa7, 0x99, 0xd9,
+	0x5d, 0x78, 0x95, 0x7b, 0x2c, 0xd5, 0x7d, 0x1a, 0xb0, 0xd8, 0x67, 0x3a, 0x4d, 0xb8, 0xbe, 0x19,
+	0xe9, 0x1b, 0x9e, 0x71, 0x8f, 0x87, 0x5c, 0x5e, 0xe3, 0x24, 0x15, 0x52, 0xa0, 0x47, 0x85, 0x0b,
+	0xd7, 0x2e, 0x4c, 0x13, 0x8e, 0x37, 0xa3, 0xfe, 0x70, 0x2d, 0xc4, 0x3a, 0x64, 0x7a, 0x69, 0xf1,
+	0xf2, 0x0b, 0x5d, 0xf2, 0x88, 0x65, 0x92, 0x46, 0x49, 0x95, 0xea, 0x6b, 0x6d, 0xec, 0x4b, 0x91,
+	0x7e, 0xbf, 0x08, 0xc5, 0x65, 0xe5, 0xd1, 0x3e, 0x43, 0xef, 0xbc, 0x56, 0xcc, 0x2b, 0xe6, 0xe7,
+	0x92, 0x8b, 0xf8, 0x3d, 0x0f, 0x25, 0x4b, 0xd1, 0x10, 0x0e, 0x1a, 0xb3, 0xcb, 0x83, 0xa7, 0xca,
+	0x0b, 0xe5, 0xf8, 0x9e, 0x0d, 0x8d, 0x44, 0x02, 0xf4, 0x18, 0xba, 0x69, 0x1e, 0x17, 0xb5, 0x4e,
+	0x59, 0xdb, 0x4f, 0xf3, 0x98, 0x04, 0xda, 0x31, 0xa0, 0x06, 0xe9, 0x5c, 0x27, 0xac, 0xa6, 0x21,
+	0xd8, 0x8b, 0x69, 0xc4, 0x6a, 0x4c, 0xf9, 0xad, 0xdd, 0x28, 0xf0, 0x70, 0x21, 0x69, 0x2a, 0x1d,
+	0x1e, 0x35, 0xbe, 0xb7, 0x70, 0x9f, 0xd1, 0x34, 0xe4, 0x2c, 0x93, 0xae, 0xe4, 0x75, 0xe0, 0xe0,
+	0xa4, 0x8f, 0xab, 0x69, 0x71, 0x33, 0x2d, 0x76, 0x9a, 0x69, 0xed, 0xc3, 0x26, 0x50, 0x48, 0xe8,
+	0x14, 0x0e, 0x42, 0x2a, 0xff, 0xc4, 0x3b, 0xff, 0x8d, 0x43, 0x65, 0x2f, 0x04, 0x6d, 0x05, 0x87,
+	0x0b, 0x49, 0x65, 0x9e, 0xd5, 0xdd, 0x10, 0xe8, 0x66, 0xe5, 0x7f, 0xd9, 0xc6, 0x83, 0x93, 0x11,
+	0x6e, 0x39, 0x09, 0xfc, 0xcf, 0x06, 0xdf, 0x85, 0x22, 0x63, 0x15, 0xc8, 0xae, 0x01, 0xaf, 0x6f,
+	0x3a, 0xa0, 0x92, 0x38, 0x60, 0x57, 0x2c, 0x58, 0xd2, 0x30, 0x67, 0xc5, 0x6e, 0xd0, 0x00, 0xfa,
+	0x64, 0x6a, 0x98, 0x5f, 0x4c, 0xc3, 0x5d, 0x8e, 0xad, 0x33, 0xd3, 0x75, 0x56, 0x73, 0xd3, 0x25,
+	0xd3, 0xe5, 0xd8, 0x22, 0x86, 0x7a, 0x0b, 0x3d, 0x87, 0x67, 0x2d, 0xf5, 0x85, 0x63, 0x93, 0xe9,
+	0x07, 0x55, 0xd9, 0x11, 0xff, 0x68, 0xae, 0xce, 0x67, 0xb6, 0xa1, 0x76, 0x50, 0x1f, 0x9e, 0xb4,
+	0xe2, 0x1d, 0xf5, 0xf6, 0x0e, 0xb4, 0x31, 0x3b, 0x9b, 0x58, 0xa6, 0xba, 0x87, 0x8e, 0xa0, 0xd7,
+	0x52, 0x9e, 0xcc, 0x66, 0x96, 0xba, 0x8f, 0x86, 0x70, 0xd4, 0x96, 0x1d, 0x3b, 0xa6, 0x43, 0x3e,
+	0x99, 0x6a, 0x17, 0xbd, 0x84, 0xe1, 0xee, 0xc6, 0x5c, 0x8b, 0x2c, 0x1c, 0xf5, 0xce, 0xc4, 0xfb,
+	0xb1, 0x1d, 0x28, 0x3f, 0xb7, 0x03, 0xe5, 0xd7, 0x76, 0xa0, 0x40, 0xcf, 0x17, 0x51, 0xdb, 0x76,
+	0x27, 0x77, 0xc7, 0x09, 0x9f, 0x17, 0xc7, 0x36, 0x57, 0xbe, 0xea, 0x6b, 0x2e, 0xbf, 0xe5, 0x1e,
+	0xf6, 0x45, 0xa4, 0xff, 0x75, 0xbb, 0xf1, 0x9a, 0xc5, 0xd5, 0x4b, 0xa8, 0x2f, 0xfa, 0x29, 0x4d,
+	0xf8, 0x66, 0xe4, 0x75, 0x4b, 0xed, 0xcd, 0xef, 0x01, 0x00, 0x7a, 0x62, 0x53, 0x09, 0x68, 0x03,
+	0x00, 0x00,
 }
 
 func (m *WorkflowExecutionFilter) Marshal() (dAtA []byte, err error) {
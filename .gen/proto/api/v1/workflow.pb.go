@@ -144,6 +144,40 @@ func (WorkflowIdReusePolicy) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2775eefb5053680f, []int{2}
 }
 
+type WorkflowIdConflictPolicy int32
+
+const (
+	WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_INVALID WorkflowIdConflictPolicy = 0
+	// Fail the start request when a workflow with the same workflow ID is running.
+	WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_FAIL WorkflowIdConflictPolicy = 1
+	// Return the run ID of the running workflow with the same workflow ID instead of starting a new one.
+	WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING WorkflowIdConflictPolicy = 2
+	// Terminate the running workflow with the same workflow ID and start a new one.
+	WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING WorkflowIdConflictPolicy = 3
+)
+
+var WorkflowIdConflictPolicy_name = map[int32]string{
+	0: "WORKFLOW_ID_CONFLICT_POLICY_INVALID",
+	1: "WORKFLOW_ID_CONFLICT_POLICY_FAIL",
+	2: "WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING",
+	3: "WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING",
+}
+
+var WorkflowIdConflictPolicy_value = map[string]int32{
+	"WORKFLOW_ID_CONFLICT_POLICY_INVALID":            0,
+	"WORKFLOW_ID_CONFLICT_POLICY_FAIL":               1,
+	"WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING":       2,
+	"WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING": 3,
+}
+
+func (x WorkflowIdConflictPolicy) String() string {
+	return proto.EnumName(WorkflowIdConflictPolicy_name, int32(x))
+}
+
+func (WorkflowIdConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2775eefb5053680f, []int{3}
+}
+
 type ParentClosePolicy int32
 
 const (
@@ -175,7 +209,7 @@ func (x ParentClosePolicy) String() string {
 }
 
 func (ParentClosePolicy) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{3}
+	return fileDescriptor_2775eefb5053680f, []int{4}
 }
 
 type WorkflowExecutionCloseStatus int32
@@ -215,7 +249,7 @@ func (x WorkflowExecutionCloseStatus) String() string {
 }
 
 func (WorkflowExecutionCloseStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{4}
+	return fileDescriptor_2775eefb5053680f, []int{5}
 }
 
 type ContinueAsNewInitiator int32
@@ -246,7 +280,7 @@ func (x ContinueAsNewInitiator) String() string {
 }
 
 func (ContinueAsNewInitiator) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{5}
+	return fileDescriptor_2775eefb5053680f, []int{6}
 }
 
 type TimeoutType int32
@@ -280,7 +314,7 @@ func (x TimeoutType) String() string {
 }
 
 func (TimeoutType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{6}
+	return fileDescriptor_2775eefb5053680f, []int{7}
 }
 
 type DecisionTaskTimedOutCause int32
@@ -308,7 +342,7 @@ func (x DecisionTaskTimedOutCause) String() string {
 }
 
 func (DecisionTaskTimedOutCause) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{7}
+	return fileDescriptor_2775eefb5053680f, []int{8}
 }
 
 type DecisionTaskFailedCause int32
@@ -338,6 +372,8 @@ const (
 	DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_BINARY                                                DecisionTaskFailedCause = 21
 	DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_SCHEDULE_ACTIVITY_DUPLICATE_ID                            DecisionTaskFailedCause = 22
 	DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES                                     DecisionTaskFailedCause = 23
+	DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_COMPLETE_WORKFLOW_UPDATE_ATTRIBUTES                   DecisionTaskFailedCause = 24
+	DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES                       DecisionTaskFailedCause = 25
 )
 
 var DecisionTaskFailedCause_name = map[int32]string{
@@ -365,6 +401,8 @@ var DecisionTaskFailedCause_name = map[int32]string{
 	21: "DECISION_TASK_FAILED_CAUSE_BAD_BINARY",
 	22: "DECISION_TASK_FAILED_CAUSE_SCHEDULE_ACTIVITY_DUPLICATE_ID",
 	23: "DECISION_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES",
+	24: "DECISION_TASK_FAILED_CAUSE_BAD_COMPLETE_WORKFLOW_UPDATE_ATTRIBUTES",
+	25: "DECISION_TASK_FAILED_CAUSE_BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES",
 }
 
 var DecisionTaskFailedCause_value = map[string]int32{
@@ -392,6 +430,8 @@ var DecisionTaskFailedCause_value = map[string]int32{
 	"DECISION_TASK_FAILED_CAUSE_BAD_BINARY":                                                21,
 	"DECISION_TASK_FAILED_CAUSE_SCHEDULE_ACTIVITY_DUPLICATE_ID":                            22,
 	"DECISION_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES":                                     23,
+	"DECISION_TASK_FAILED_CAUSE_BAD_COMPLETE_WORKFLOW_UPDATE_ATTRIBUTES":                   24,
+	"DECISION_TASK_FAILED_CAUSE_BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES":                       25,
 }
 
 func (x DecisionTaskFailedCause) String() string {
@@ -399,7 +439,7 @@ func (x DecisionTaskFailedCause) String() string {
 }
 
 func (DecisionTaskFailedCause) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{8}
+	return fileDescriptor_2775eefb5053680f, []int{9}
 }
 
 type ChildWorkflowExecutionFailedCause int32
@@ -424,7 +464,7 @@ func (x ChildWorkflowExecutionFailedCause) String() string {
 }
 
 func (ChildWorkflowExecutionFailedCause) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{9}
+	return fileDescriptor_2775eefb5053680f, []int{10}
 }
 
 type CancelExternalWorkflowExecutionFailedCause int32
@@ -449,7 +489,7 @@ func (x CancelExternalWorkflowExecutionFailedCause) String() string {
 }
 
 func (CancelExternalWorkflowExecutionFailedCause) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{10}
+	return fileDescriptor_2775eefb5053680f, []int{11}
 }
 
 type SignalExternalWorkflowExecutionFailedCause int32
@@ -474,7 +514,7 @@ func (x SignalExternalWorkflowExecutionFailedCause) String() string {
 }
 
 func (SignalExternalWorkflowExecutionFailedCause) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{11}
+	return fileDescriptor_2775eefb5053680f, []int{12}
 }
 
 type WorkflowExecutionInfo struct {
@@ -1311,6 +1351,7 @@ func init() {
 	proto.RegisterEnum("uber.cadence.api.v1.PendingActivityState", PendingActivityState_name, PendingActivityState_value)
 	proto.RegisterEnum("uber.cadence.api.v1.PendingDecisionState", PendingDecisionState_name, PendingDecisionState_value)
 	proto.RegisterEnum("uber.cadence.api.v1.WorkflowIdReusePolicy", WorkflowIdReusePolicy_name, WorkflowIdReusePolicy_value)
+	proto.RegisterEnum("uber.cadence.api.v1.WorkflowIdConflictPolicy", WorkflowIdConflictPolicy_name, WorkflowIdConflictPolicy_value)
 	proto.RegisterEnum("uber.cadence.api.v1.ParentClosePolicy", ParentClosePolicy_name, ParentClosePolicy_value)
 	proto.RegisterEnum("uber.cadence.api.v1.WorkflowExecutionCloseStatus", WorkflowExecutionCloseStatus_name, WorkflowExecutionCloseStatus_value)
 	proto.RegisterEnum("uber.cadence.api.v1.ContinueAsNewInitiator", ContinueAsNewInitiator_name, ContinueAsNewInitiator_value)
@@ -1337,151 +1378,157 @@ func init() {
 }
 
 var fileDescriptor_2775eefb5053680f = []byte{
-	// 2302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
-	0x15, 0x2e, 0x25, 0xdb, 0xb1, 0x9f, 0xfc, 0x83, 0x1e, 0xc7, 0x6b, 0x25, 0xd9, 0x38, 0x8e, 0xb6,
-	0x49, 0x1c, 0x75, 0x23, 0xaf, 0x93, 0xcd, 0xa6, 0xd9, 0x34, 0x4d, 0x69, 0x92, 0x8e, 0x99, 0xc8,
-	0x94, 0x3a, 0xa2, 0xe2, 0x78, 0x51, 0x94, 0xa0, 0xa5, 0xb1, 0x4d, 0x44, 0x22, 0x05, 0x92, 0x4a,
-	0xe2, 0x7b, 0x81, 0x9e, 0x7b, 0x2b, 0xda, 0x4b, 0xff, 0x80, 0x02, 0x45, 0xff, 0x80, 0xa2, 0x45,
-	0x0f, 0x45, 0x2f, 0x05, 0x7a, 0xea, 0xb1, 0x28, 0x72, 0xeb, 0x7f, 0x51, 0xcc, 0x70, 0x28, 0x51,
-	0x12, 0x25, 0x2a, 0x2d, 0xb0, 0xbd, 0x99, 0x8f, 0xdf, 0xf7, 0xf1, 0xcd, 0x9b, 0xf7, 0x3e, 0x0e,
-	0x2d, 0x28, 0x74, 0x4f, 0x88, 0xb7, 0xd3, 0xb0, 0x9a, 0xc4, 0x69, 0x90, 0x1d, 0xab, 0x63, 0xef,
-	0xbc, 0xdd, 0xdd, 0x79, 0xe7, 0x7a, 0x6f, 0x4e, 0x5b, 0xee, 0xbb, 0x52, 0xc7, 0x73, 0x03, 0x17,
-	0xad, 0x51, 0x4c, 0x89, 0x63, 0x4a, 0x56, 0xc7, 0x2e, 0xbd, 0xdd, 0xbd, 0xba, 0x79, 0xe6, 0xba,
-	0x67, 0x2d, 0xb2, 0xc3, 0x20, 0x27, 0xdd, 0xd3, 0x9d, 0x66, 0xd7, 0xb3, 0x02, 0xdb, 0x75, 0x42,
-	0xd2, 0xd5, 0x1b, 0xc3, 0xf7, 0x03, 0xbb, 0x4d, 0xfc, 0xc0, 0x6a, 0x77, 0x38, 0x60, 0x2b, 0xe9,
-	0xc9, 0x0d, 0xb7, 0xdd, 0xee, 0x49, 0x24, 0xe6, 0x16, 0x58, 0xfe, 0x9b, 0x96, 0xed, 0x07, 0x21,
-	0xa6, 0xf0, 0xa7, 0x39, 0x58, 0x3f, 0xe2, 0xe9, 0xaa, 0xef, 0x49, 0xa3, 0x4b, 0x53, 0xd0, 0x9c,
-	0x53, 0x17, 0xd5, 0x01, 0x45, 0xeb, 0x30, 0x49, 0x74, 0x27, 0x2f, 0x6c, 0x09, 0xdb, 0xb9, 0xfb,
-	0xb7, 0x4b, 0x09, 0x4b, 0x2a, 0x8d, 0xe8, 0xe0, 0xd5, 0x77, 0xc3, 0x21, 0xf4, 0x10, 0x66, 0x82,
-	0x8b, 0x0e, 0xc9, 0x67, 0x98, 0xd0, 0xcd, 0x89, 0x42, 0xc6, 0x45, 0x87, 0x60, 0x06, 0x47, 0x8f,
-	0x01, 0xfc, 0xc0, 0xf2, 0x02, 0x93, 0x96, 0x21, 0x9f, 0x65, 0xe4, 0xab, 0xa5, 0xb0, 0x46, 0xa5,
-	0xa8, 0x46, 0x25, 0x23, 0xaa, 0x11, 0x5e, 0x60, 0x68, 0x7a, 0x4d, 0xa9, 0x8d, 0x96, 0xeb, 0x93,
-	0x90, 0x3a, 0x93, 0x4e, 0x65, 0x68, 0x46, 0x35, 0x60, 0x31, 0xa4, 0xfa, 0x81, 0x15, 0x74, 0xfd,
-	0xfc, 0xec, 0x96, 0xb0, 0xbd, 0x7c, 0x7f, 0x77, 0xba, 0xd5, 0xcb, 0x94, 0x59, 0x63, 0x44, 0x9c,
-	0x6b, 0xf4, 0x2f, 0xd0, 0x2d, 0x58, 0x3e, 0xb7, 0xfd, 0xc0, 0xf5, 0x2e, 0xcc, 0x16, 0x71, 0xce,
-	0x82, 0xf3, 0xfc, 0xdc, 0x96, 0xb0, 0x9d, 0xc5, 0x4b, 0x3c, 0x5a, 0x66, 0x41, 0xf4, 0x13, 0x58,
-	0xef, 0x58, 0x1e, 0x71, 0x82, 0x7e, 0xf9, 0x4d, 0xdb, 0x39, 0x75, 0xf3, 0x97, 0xd8, 0x12, 0xb6,
-	0x13, 0xb3, 0xa8, 0x32, 0xc6, 0xc0, 0x4e, 0xe2, 0xb5, 0xce, 0x68, 0x10, 0x49, 0xb0, 0xdc, 0x97,
-	0x65, 0x95, 0x99, 0x4f, 0xad, 0xcc, 0x52, 0x8f, 0xc1, 0xaa, 0x73, 0x0f, 0x66, 0xda, 0xa4, 0xed,
-	0xe6, 0x17, 0x18, 0xf1, 0x4a, 0x62, 0x3e, 0x87, 0xa4, 0xed, 0x62, 0x06, 0x43, 0x18, 0x56, 0x7d,
-	0x62, 0x79, 0x8d, 0x73, 0xd3, 0x0a, 0x02, 0xcf, 0x3e, 0xe9, 0x06, 0xc4, 0xcf, 0x03, 0xe3, 0xde,
-	0x4a, 0xe4, 0xd6, 0x18, 0x5a, 0xea, 0x81, 0xb1, 0xe8, 0x0f, 0x45, 0x50, 0x19, 0x56, 0xad, 0x6e,
-	0xe0, 0x9a, 0x1e, 0xf1, 0x49, 0x60, 0x76, 0x5c, 0xdb, 0x09, 0xfc, 0x7c, 0x8e, 0x69, 0x6e, 0x25,
-	0x6a, 0x62, 0x0a, 0xac, 0x32, 0x1c, 0x5e, 0xa1, 0xd4, 0x58, 0x00, 0x5d, 0x83, 0x05, 0x3a, 0x1e,
-	0x26, 0x9d, 0x8f, 0xfc, 0xe2, 0x96, 0xb0, 0xbd, 0x80, 0xe7, 0x69, 0xa0, 0x6c, 0xfb, 0x01, 0xda,
-	0x80, 0x4b, 0xb6, 0x6f, 0x36, 0x3c, 0xd7, 0xc9, 0x2f, 0x6d, 0x09, 0xdb, 0xf3, 0x78, 0xce, 0xf6,
-	0x65, 0xcf, 0x75, 0x0a, 0xbf, 0xcc, 0xc0, 0xe6, 0xe8, 0xe6, 0xbb, 0xce, 0xa9, 0x7d, 0xc6, 0x47,
-	0x1a, 0x7d, 0x1d, 0x17, 0x0e, 0x47, 0xe8, 0x7a, 0x62, 0x7a, 0x06, 0x7f, 0x5a, 0xec, 0xb9, 0x16,
-	0x6c, 0xf5, 0x37, 0x8a, 0xcf, 0x80, 0x6b, 0xf6, 0x3b, 0xda, 0xed, 0x06, 0x7c, 0x98, 0xae, 0x8c,
-	0x6c, 0x9d, 0xc2, 0x13, 0xc0, 0x9f, 0xf6, 0x24, 0x6a, 0x6c, 0x2e, 0x5c, 0x39, 0xea, 0x71, 0xb7,
-	0x1b, 0xa0, 0x23, 0xb8, 0xc6, 0xd2, 0x1b, 0xa3, 0x9e, 0x4d, 0x53, 0xdf, 0xa0, 0xec, 0x04, 0xe1,
-	0xc2, 0xdf, 0x04, 0x58, 0x4b, 0xe8, 0x48, 0x5a, 0xe8, 0xa6, 0xdb, 0xb6, 0x6c, 0xc7, 0xb4, 0x9b,
-	0xac, 0x1e, 0x0b, 0x78, 0x3e, 0x0c, 0x68, 0x4d, 0x74, 0x03, 0x72, 0xfc, 0xa6, 0x63, 0xb5, 0x43,
-	0xa3, 0x58, 0xc0, 0x10, 0x86, 0x74, 0xab, 0x4d, 0xc6, 0x38, 0x53, 0xf6, 0x7f, 0x75, 0xa6, 0x9b,
-	0xb0, 0x68, 0x3b, 0x76, 0x60, 0x5b, 0x01, 0x69, 0xd2, 0xbc, 0x66, 0xd8, 0x50, 0xe6, 0x7a, 0x31,
-	0xad, 0x59, 0xf8, 0x85, 0x00, 0xeb, 0xea, 0xfb, 0x80, 0x78, 0x8e, 0xd5, 0xfa, 0x56, 0xdc, 0x72,
-	0x38, 0xa7, 0xcc, 0x68, 0x4e, 0xff, 0x9c, 0x85, 0xb5, 0x2a, 0x71, 0x9a, 0xb6, 0x73, 0x26, 0x35,
-	0x02, 0xfb, 0xad, 0x1d, 0x5c, 0xb0, 0x8c, 0x6e, 0x40, 0xce, 0xe2, 0xd7, 0xfd, 0x2a, 0x43, 0x14,
-	0xd2, 0x9a, 0x68, 0x1f, 0x96, 0x7a, 0x80, 0x54, 0x4b, 0x8e, 0xa4, 0x99, 0x25, 0x2f, 0x5a, 0xb1,
-	0x2b, 0xf4, 0x0c, 0x66, 0xa9, 0x3d, 0x86, 0xae, 0xbc, 0x7c, 0xff, 0x6e, 0xb2, 0x2f, 0x0d, 0x66,
-	0x48, 0x9d, 0x90, 0xe0, 0x90, 0x87, 0x34, 0x58, 0x3d, 0x27, 0x96, 0x17, 0x9c, 0x10, 0x2b, 0x30,
-	0x9b, 0x24, 0xb0, 0xec, 0x96, 0xcf, 0x7d, 0xfa, 0xd3, 0x31, 0x26, 0x77, 0xd1, 0x72, 0xad, 0x26,
-	0x16, 0x7b, 0x34, 0x25, 0x64, 0xa1, 0x17, 0xb0, 0xd6, 0xb2, 0xfc, 0xc0, 0xec, 0xeb, 0x31, 0x6b,
-	0x9b, 0x4d, 0xb5, 0xb6, 0x55, 0x4a, 0x3b, 0x88, 0x58, 0xcc, 0xde, 0xf6, 0x81, 0x05, 0xc3, 0xa9,
-	0x20, 0xcd, 0x50, 0x69, 0x2e, 0x55, 0x69, 0x85, 0x92, 0x6a, 0x21, 0x87, 0xe9, 0xe4, 0xe1, 0x92,
-	0x15, 0x04, 0xa4, 0xdd, 0x09, 0x98, 0x73, 0xcf, 0xe2, 0xe8, 0x12, 0xdd, 0x05, 0xb1, 0x6d, 0xbd,
-	0xb7, 0xdb, 0xdd, 0xb6, 0xc9, 0x43, 0x3e, 0x73, 0xe1, 0x59, 0xbc, 0xc2, 0xe3, 0x12, 0x0f, 0x53,
-	0xbb, 0xf6, 0x1b, 0xe7, 0xa4, 0xd9, 0x6d, 0x45, 0x99, 0x2c, 0xa4, 0xdb, 0x75, 0x8f, 0xc1, 0xf2,
-	0x90, 0x61, 0x85, 0xbc, 0xef, 0xd8, 0xe1, 0xcc, 0x86, 0x1a, 0x90, 0xaa, 0xb1, 0xdc, 0xa7, 0x30,
-	0x91, 0x67, 0xb0, 0xc8, 0x8a, 0x72, 0x6a, 0xd9, 0xad, 0xae, 0x47, 0xb8, 0xd7, 0x26, 0x6f, 0xd3,
-	0x7e, 0x88, 0xc1, 0x39, 0xca, 0xe0, 0x17, 0xe8, 0x0b, 0xb8, 0xcc, 0x04, 0x68, 0xaf, 0x13, 0xcf,
-	0xb4, 0x9b, 0xc4, 0x09, 0xec, 0xe0, 0x82, 0xdb, 0x2d, 0xa2, 0xf7, 0x8e, 0xd8, 0x2d, 0x8d, 0xdf,
-	0x29, 0xfc, 0x3a, 0x03, 0x57, 0x78, 0xfb, 0xc8, 0xe7, 0x76, 0xab, 0xf9, 0xad, 0x0c, 0xde, 0xe7,
-	0x31, 0x59, 0x3a, 0x1c, 0x71, 0x2f, 0x12, 0xdf, 0xc5, 0xce, 0x27, 0xcc, 0x91, 0x86, 0xc7, 0x34,
-	0x3b, 0x32, 0xa6, 0xe8, 0x15, 0xf0, 0xd7, 0x30, 0x37, 0xd7, 0x8e, 0xdb, 0xb2, 0x1b, 0x17, 0xac,
-	0xcd, 0x97, 0xc7, 0x24, 0x1a, 0x3a, 0x27, 0x33, 0xd4, 0x2a, 0x43, 0xe3, 0xd5, 0xce, 0x70, 0xa8,
-	0xf0, 0x97, 0x4c, 0x6f, 0xfc, 0x15, 0xd2, 0xb0, 0xfd, 0xa8, 0x2e, 0xbd, 0xa9, 0x14, 0xd2, 0xa7,
-	0x32, 0x22, 0x0e, 0x4c, 0xe5, 0x68, 0xc7, 0x65, 0x3e, 0xb6, 0xe3, 0x9e, 0xc2, 0xe2, 0xc0, 0xf0,
-	0xa4, 0x1f, 0xdb, 0x72, 0x7e, 0xf2, 0xe0, 0xcc, 0x0c, 0x0e, 0x0e, 0x86, 0x0d, 0xd7, 0xb3, 0xcf,
-	0x6c, 0xc7, 0x6a, 0x99, 0x43, 0x49, 0xa6, 0x8f, 0xfa, 0x7a, 0x44, 0xad, 0xc5, 0x93, 0x2d, 0xfc,
-	0x21, 0x03, 0x57, 0x22, 0x7b, 0x2a, 0xbb, 0x0d, 0xab, 0xa5, 0xd8, 0x7e, 0xc7, 0x0a, 0x1a, 0xe7,
-	0xd3, 0xb9, 0xe9, 0xff, 0xbf, 0x5c, 0x3f, 0x85, 0xcd, 0xc1, 0x0c, 0x4c, 0xf7, 0xd4, 0x0c, 0xce,
-	0x6d, 0xdf, 0x8c, 0x57, 0x71, 0xb2, 0xe0, 0xd5, 0x81, 0x8c, 0x2a, 0xa7, 0xc6, 0xb9, 0xed, 0x73,
-	0x0f, 0x42, 0xd7, 0x01, 0xd8, 0x29, 0x21, 0x70, 0xdf, 0x10, 0x87, 0xd5, 0x79, 0x11, 0xb3, 0x63,
-	0x8d, 0x41, 0x03, 0x85, 0x17, 0x90, 0x8b, 0x9f, 0xa5, 0x9e, 0xc0, 0x1c, 0x3f, 0x8e, 0x09, 0x5b,
-	0xd9, 0xed, 0xdc, 0xfd, 0xcf, 0x52, 0x8e, 0x63, 0xec, 0xa4, 0xca, 0x29, 0x85, 0xdf, 0x65, 0x60,
-	0x79, 0xf0, 0x16, 0xba, 0x03, 0x2b, 0x27, 0xb6, 0x63, 0x79, 0x17, 0x66, 0xe3, 0x9c, 0x34, 0xde,
-	0xf8, 0xdd, 0x36, 0xdf, 0x84, 0xe5, 0x30, 0x2c, 0xf3, 0x28, 0x5a, 0x87, 0x39, 0xaf, 0xeb, 0x44,
-	0x2f, 0xcb, 0x05, 0x3c, 0xeb, 0x75, 0xe9, 0xa9, 0xe2, 0x29, 0x5c, 0x3b, 0xb5, 0x3d, 0x9f, 0xbe,
-	0x60, 0xc2, 0x66, 0x37, 0x1b, 0x6e, 0xbb, 0xd3, 0x22, 0x03, 0x13, 0x9b, 0x67, 0x90, 0x68, 0x1c,
-	0xe4, 0x08, 0xc0, 0xe8, 0x8b, 0x0d, 0x8f, 0x58, 0xbd, 0xbd, 0x49, 0x2f, 0x65, 0x8e, 0xe3, 0xb9,
-	0x6d, 0x2e, 0x31, 0x23, 0xb5, 0x9d, 0xb3, 0x69, 0xdb, 0x74, 0x31, 0x22, 0x30, 0x81, 0x4d, 0x00,
-	0x76, 0xc6, 0x0d, 0xac, 0x93, 0x56, 0xf8, 0x16, 0x9a, 0xc7, 0xb1, 0x48, 0xf1, 0xf7, 0x02, 0x5c,
-	0x4e, 0x7a, 0xc7, 0xa2, 0x02, 0x6c, 0x56, 0x55, 0x5d, 0xd1, 0xf4, 0xe7, 0xa6, 0x24, 0x1b, 0xda,
-	0x2b, 0xcd, 0x38, 0x36, 0x6b, 0x86, 0x64, 0xa8, 0xa6, 0xa6, 0xbf, 0x92, 0xca, 0x9a, 0x22, 0x7e,
-	0x07, 0x7d, 0x17, 0xb6, 0xc6, 0x60, 0x6a, 0xf2, 0x81, 0xaa, 0xd4, 0xcb, 0xaa, 0x22, 0x0a, 0x13,
-	0x94, 0x6a, 0x86, 0x84, 0x0d, 0x55, 0x11, 0x33, 0xe8, 0x7b, 0x70, 0x67, 0x0c, 0x46, 0x96, 0x74,
-	0x59, 0x2d, 0x9b, 0x58, 0xfd, 0x71, 0x5d, 0xad, 0x51, 0x70, 0xb6, 0xf8, 0xb3, 0x7e, 0xce, 0x03,
-	0x0e, 0x14, 0x7f, 0x92, 0xa2, 0xca, 0x5a, 0x4d, 0xab, 0xe8, 0x93, 0x72, 0x1e, 0xc2, 0x8c, 0xc9,
-	0x79, 0x18, 0x15, 0xe5, 0x5c, 0xfc, 0x79, 0xa6, 0xff, 0x09, 0xac, 0x35, 0x31, 0xe9, 0x46, 0xde,
-	0x4a, 0x9f, 0x71, 0x54, 0xc1, 0x2f, 0xf7, 0xcb, 0x95, 0x23, 0x53, 0x53, 0x4c, 0xac, 0xd6, 0x6b,
-	0xaa, 0x59, 0xad, 0x94, 0x35, 0xf9, 0x38, 0x96, 0xc9, 0xf7, 0xe1, 0xcb, 0xb1, 0x28, 0xa9, 0x4c,
-	0xa3, 0x4a, 0xbd, 0x5a, 0xd6, 0x64, 0xfa, 0xd4, 0x7d, 0x49, 0x2b, 0xab, 0x8a, 0x59, 0xd1, 0xcb,
-	0xc7, 0xa2, 0x80, 0x3e, 0x87, 0xed, 0x69, 0x99, 0x62, 0x06, 0xdd, 0x83, 0xbb, 0x63, 0xd1, 0x58,
-	0x7d, 0xa1, 0xca, 0x46, 0x0c, 0x9e, 0x45, 0xbb, 0x70, 0x6f, 0x2c, 0xdc, 0x50, 0xf1, 0xa1, 0xa6,
-	0xb3, 0x82, 0xee, 0x9b, 0xb8, 0xae, 0xeb, 0x9a, 0xfe, 0x5c, 0x9c, 0x29, 0xfe, 0x46, 0x80, 0xd5,
-	0x91, 0x97, 0x0e, 0xba, 0x01, 0xd7, 0xaa, 0x12, 0x56, 0x75, 0xc3, 0x94, 0xcb, 0x95, 0xa4, 0x02,
-	0x8c, 0x01, 0x48, 0x7b, 0x92, 0xae, 0x54, 0x74, 0x51, 0x40, 0xb7, 0xa1, 0x90, 0x04, 0xe0, 0xbd,
-	0xc0, 0x5b, 0x43, 0xcc, 0xa0, 0x9b, 0x70, 0x3d, 0x09, 0xd7, 0xcb, 0x56, 0xcc, 0x16, 0xff, 0x9d,
-	0x81, 0x4f, 0x27, 0x7d, 0x69, 0xd3, 0x0e, 0xec, 0x2d, 0x5b, 0x7d, 0xad, 0xca, 0x75, 0x83, 0xee,
-	0x79, 0xa8, 0x47, 0x77, 0xbe, 0x5e, 0x8b, 0x65, 0x1e, 0x2f, 0xe9, 0x18, 0xb0, 0x5c, 0x39, 0xac,
-	0x96, 0x55, 0x83, 0x75, 0x53, 0x11, 0x6e, 0xa7, 0xc1, 0xc3, 0x0d, 0x16, 0x33, 0x03, 0x7b, 0x3b,
-	0x4e, 0x9a, 0xad, 0x9b, 0x8e, 0x02, 0x2a, 0x41, 0x31, 0x0d, 0xdd, 0xab, 0x82, 0x22, 0xce, 0xa0,
-	0x2f, 0xe1, 0x8b, 0xf4, 0xc4, 0x75, 0x43, 0xd3, 0xeb, 0xaa, 0x62, 0x4a, 0x35, 0x53, 0x57, 0x8f,
-	0xc4, 0xd9, 0x69, 0x96, 0x6b, 0x68, 0x87, 0xb4, 0x3f, 0xeb, 0x86, 0x38, 0x57, 0xfc, 0xa3, 0x00,
-	0x9f, 0xc8, 0xae, 0x13, 0xd8, 0x4e, 0x97, 0x48, 0xbe, 0x4e, 0xde, 0x69, 0xe1, 0x79, 0xc6, 0xf5,
-	0xd0, 0x2d, 0xb8, 0x19, 0xe9, 0x73, 0x79, 0x53, 0xd3, 0x35, 0x43, 0x93, 0x8c, 0x0a, 0x8e, 0xd5,
-	0x77, 0x22, 0x8c, 0x0e, 0xa4, 0xa2, 0xe2, 0xb0, 0xae, 0xe3, 0x61, 0x58, 0x35, 0xf0, 0x31, 0x6f,
-	0x85, 0xd0, 0x61, 0xc6, 0x63, 0x65, 0x4c, 0xe7, 0x9b, 0xcf, 0xbf, 0x98, 0x2d, 0xfe, 0x56, 0x80,
-	0x1c, 0xff, 0x16, 0x65, 0x9f, 0x2a, 0x79, 0xb8, 0x4c, 0x17, 0x58, 0xa9, 0x1b, 0xa6, 0x71, 0x5c,
-	0x55, 0x07, 0x7b, 0x78, 0xe0, 0x0e, 0xb3, 0x07, 0xd3, 0xa8, 0x84, 0xd5, 0x09, 0x9d, 0x64, 0x10,
-	0xc0, 0x9f, 0x42, 0x31, 0x0c, 0x2c, 0x66, 0x26, 0x62, 0x42, 0x9d, 0x2c, 0xba, 0x0a, 0x9f, 0x0c,
-	0x60, 0x0e, 0x54, 0x09, 0x1b, 0x7b, 0xaa, 0x64, 0x88, 0x33, 0xc5, 0x5f, 0x09, 0x70, 0x25, 0x72,
-	0x42, 0x83, 0xbe, 0x58, 0xed, 0x36, 0x69, 0x56, 0xba, 0x81, 0x6c, 0x75, 0x7d, 0x82, 0xee, 0xc2,
-	0xad, 0x9e, 0x87, 0x19, 0x52, 0xed, 0x65, 0x7f, 0xaf, 0x4c, 0x59, 0xa2, 0xc3, 0xdd, 0x5f, 0x4d,
-	0x2a, 0x94, 0xa7, 0x20, 0x0a, 0xe8, 0x0e, 0x7c, 0x36, 0x19, 0x8a, 0xd5, 0x9a, 0x6a, 0x88, 0x99,
-	0xe2, 0x3f, 0x72, 0xb0, 0x11, 0x4f, 0x8e, 0x1e, 0xe8, 0x49, 0x33, 0x4c, 0xed, 0x36, 0x14, 0x06,
-	0x45, 0xb8, 0xcf, 0x0d, 0xe7, 0xb5, 0x0b, 0xf7, 0x26, 0xe0, 0xea, 0xfa, 0x81, 0xa4, 0x2b, 0xf4,
-	0x3a, 0x02, 0x89, 0x02, 0x7a, 0x06, 0x4f, 0x26, 0x50, 0xf6, 0x24, 0xa5, 0x5f, 0xe5, 0xde, 0x1b,
-	0x47, 0x32, 0x0c, 0xac, 0xed, 0xd5, 0x0d, 0xb5, 0x26, 0x66, 0x90, 0x0a, 0x52, 0x8a, 0xc0, 0xa0,
-	0x0f, 0x25, 0xca, 0x64, 0xd1, 0x63, 0x78, 0x98, 0x96, 0x47, 0xd8, 0x32, 0xda, 0xa1, 0x8a, 0xe3,
-	0xd4, 0x19, 0xf4, 0x35, 0x7c, 0x95, 0x42, 0xe5, 0x4f, 0x1e, 0xe1, 0xce, 0xa2, 0x27, 0xf0, 0x28,
-	0x35, 0x7b, 0xb9, 0x82, 0x15, 0xf3, 0x50, 0xc2, 0x2f, 0x07, 0xc9, 0x73, 0x48, 0x03, 0x35, 0xed,
-	0xc1, 0xdc, 0xdd, 0xcc, 0x04, 0x5f, 0x88, 0x49, 0x5d, 0x9a, 0xa2, 0x8a, 0x34, 0x90, 0x22, 0x33,
-	0x8f, 0x9e, 0x83, 0x3c, 0x5d, 0x29, 0x26, 0x0b, 0x2d, 0xa0, 0xd7, 0x60, 0x7c, 0xdc, 0xae, 0xaa,
-	0xaf, 0x0d, 0x15, 0xeb, 0x52, 0x9a, 0x32, 0xa0, 0xa7, 0xf0, 0x38, 0xb5, 0x68, 0x83, 0xfe, 0x13,
-	0xa3, 0xe7, 0xd0, 0x23, 0x78, 0x30, 0x81, 0x1e, 0xef, 0x91, 0xfe, 0xa9, 0x40, 0x53, 0xc4, 0x45,
-	0xf4, 0x10, 0x76, 0x27, 0x10, 0xd9, 0x14, 0x9a, 0x35, 0x43, 0x93, 0x5f, 0x1e, 0x87, 0xb7, 0xcb,
-	0x5a, 0xcd, 0x10, 0x97, 0xd0, 0x8f, 0xe0, 0x07, 0x13, 0x68, 0xbd, 0xc5, 0xd2, 0x3f, 0x54, 0x1c,
-	0x1b, 0x31, 0x0a, 0xab, 0x63, 0x55, 0x5c, 0x9e, 0x62, 0x4f, 0x6a, 0xda, 0xf3, 0xf4, 0xca, 0xad,
-	0x20, 0x19, 0x9e, 0x4d, 0x35, 0x22, 0xf2, 0x81, 0x56, 0x56, 0x92, 0x45, 0x44, 0xf4, 0x00, 0x76,
-	0x26, 0x88, 0xec, 0x57, 0xb0, 0xac, 0xf2, 0x37, 0x56, 0xcf, 0x24, 0x56, 0xd1, 0x57, 0x70, 0x7f,
-	0x12, 0x49, 0xd2, 0xca, 0x95, 0x57, 0x2a, 0x1e, 0xe6, 0x21, 0xfa, 0x1a, 0x9d, 0x6e, 0xe9, 0x9a,
-	0x5e, 0xad, 0x1b, 0x66, 0x4d, 0xfb, 0x46, 0x15, 0xd7, 0xe8, 0x6b, 0x34, 0x75, 0xa7, 0xa2, 0x5a,
-	0x89, 0x97, 0x47, 0xcd, 0x78, 0xe4, 0x21, 0x7b, 0x9a, 0x2e, 0xe1, 0x63, 0x71, 0x3d, 0xa5, 0xf7,
-	0x46, 0x8d, 0x6e, 0xa0, 0x85, 0x3e, 0x99, 0x66, 0x39, 0xaa, 0x84, 0xe5, 0x83, 0x78, 0xc5, 0x37,
-	0xe8, 0x5b, 0xe7, 0x26, 0xfb, 0xc7, 0xca, 0xc8, 0xb9, 0x2a, 0x6e, 0xf1, 0xbb, 0x70, 0x2f, 0xdc,
-	0xb7, 0x84, 0x2e, 0x18, 0xe3, 0xf6, 0x7b, 0xf0, 0xc3, 0xe9, 0x28, 0xbd, 0xfb, 0x52, 0x19, 0xab,
-	0x92, 0x72, 0xdc, 0x3b, 0x92, 0x0a, 0xc5, 0x3f, 0x0b, 0x50, 0x94, 0x2d, 0xa7, 0x41, 0x5a, 0xd1,
-	0xff, 0x5d, 0x27, 0x66, 0xf9, 0x04, 0x1e, 0x4d, 0x31, 0xef, 0x63, 0xf2, 0x3d, 0x82, 0xda, 0xc7,
-	0x92, 0xeb, 0xfa, 0x4b, 0xbd, 0x72, 0xa4, 0x4f, 0x22, 0xf0, 0x45, 0xd4, 0xec, 0x33, 0xf6, 0x4f,
-	0xe3, 0xe9, 0x16, 0xc1, 0xdb, 0xee, 0xbf, 0x5b, 0xc4, 0xc7, 0x92, 0xa7, 0x5a, 0xc4, 0xde, 0xc9,
-	0x5f, 0x3f, 0x6c, 0x0a, 0x7f, 0xff, 0xb0, 0x29, 0xfc, 0xeb, 0xc3, 0xa6, 0x00, 0x1b, 0x0d, 0xb7,
-	0x9d, 0xf4, 0x45, 0xbf, 0x37, 0x2f, 0x75, 0xec, 0x2a, 0xfd, 0x9a, 0xad, 0x0a, 0xdf, 0xec, 0x9c,
-	0xd9, 0xc1, 0x79, 0xf7, 0xa4, 0xd4, 0x70, 0xdb, 0x3b, 0x03, 0xbf, 0x45, 0x96, 0xce, 0x88, 0x13,
-	0xfe, 0xb2, 0xc9, 0x7f, 0x96, 0x7c, 0x62, 0x75, 0xec, 0xb7, 0xbb, 0x27, 0x73, 0x2c, 0xf6, 0xe0,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xa4, 0xf1, 0x62, 0x56, 0x1d, 0x00, 0x00,
+	// 2386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5d, 0x6f, 0xdb, 0xc8,
+	0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0x0f, 0x7a, 0x1c, 0xaf, 0xe5, 0x64, 0xd7, 0x71, 0xb4,
+	0x6f, 0xb2, 0x8e, 0xba, 0x91, 0xd7, 0xde, 0x8f, 0x34, 0x9b, 0x66, 0x53, 0x9a, 0x1c, 0xc7, 0x4c,
+	0x64, 0x4a, 0x1d, 0x52, 0x71, 0xbc, 0x28, 0x4a, 0xd0, 0x12, 0x6d, 0x13, 0x91, 0x48, 0x81, 0x1c,
+	0x25, 0xf1, 0x7d, 0x81, 0x5e, 0xf7, 0xae, 0x68, 0x6f, 0xfa, 0x03, 0x0a, 0x14, 0xfd, 0x01, 0x45,
+	0x8b, 0x5e, 0x14, 0x05, 0x8a, 0x02, 0xfd, 0x05, 0x45, 0x91, 0xbb, 0xfe, 0x8b, 0x62, 0x86, 0xa4,
+	0x44, 0x7d, 0x52, 0x69, 0x81, 0xed, 0x9d, 0x75, 0xf8, 0x3c, 0x0f, 0xcf, 0x9c, 0x39, 0xe7, 0x99,
+	0x91, 0x0c, 0x85, 0xce, 0x99, 0xed, 0xef, 0xd6, 0xad, 0x86, 0xed, 0xd6, 0xed, 0x5d, 0xab, 0xed,
+	0xec, 0xbe, 0xde, 0xdb, 0x7d, 0xe3, 0xf9, 0xaf, 0xce, 0x9b, 0xde, 0x9b, 0x52, 0xdb, 0xf7, 0xa8,
+	0x87, 0xd6, 0x18, 0xa6, 0x14, 0x61, 0x4a, 0x56, 0xdb, 0x29, 0xbd, 0xde, 0xbb, 0xb1, 0x75, 0xe1,
+	0x79, 0x17, 0x4d, 0x7b, 0x97, 0x43, 0xce, 0x3a, 0xe7, 0xbb, 0x8d, 0x8e, 0x6f, 0x51, 0xc7, 0x73,
+	0x43, 0xd2, 0x8d, 0x5b, 0x83, 0xcf, 0xa9, 0xd3, 0xb2, 0x03, 0x6a, 0xb5, 0xda, 0x11, 0x60, 0x7b,
+	0xd4, 0x9b, 0xeb, 0x5e, 0xab, 0xd5, 0x95, 0x18, 0x99, 0x1b, 0xb5, 0x82, 0x57, 0x4d, 0x27, 0xa0,
+	0x21, 0xa6, 0xf0, 0xc7, 0x39, 0x58, 0x3f, 0x89, 0xd2, 0xc5, 0x6f, 0xed, 0x7a, 0x87, 0xa5, 0xa0,
+	0xba, 0xe7, 0x1e, 0xaa, 0x01, 0x8a, 0xd7, 0x61, 0xda, 0xf1, 0x93, 0xbc, 0xb0, 0x2d, 0xec, 0xe4,
+	0xf6, 0xef, 0x96, 0x46, 0x2c, 0xa9, 0x34, 0xa4, 0x43, 0x56, 0xdf, 0x0c, 0x86, 0xd0, 0x97, 0x30,
+	0x43, 0xaf, 0xda, 0x76, 0x3e, 0xc3, 0x85, 0x6e, 0x4f, 0x14, 0x32, 0xae, 0xda, 0x36, 0xe1, 0x70,
+	0xf4, 0x10, 0x20, 0xa0, 0x96, 0x4f, 0x4d, 0x56, 0x86, 0x7c, 0x96, 0x93, 0x6f, 0x94, 0xc2, 0x1a,
+	0x95, 0xe2, 0x1a, 0x95, 0x8c, 0xb8, 0x46, 0x64, 0x81, 0xa3, 0xd9, 0x67, 0x46, 0xad, 0x37, 0xbd,
+	0xc0, 0x0e, 0xa9, 0x33, 0xe9, 0x54, 0x8e, 0xe6, 0x54, 0x03, 0x16, 0x43, 0x6a, 0x40, 0x2d, 0xda,
+	0x09, 0xf2, 0xb3, 0xdb, 0xc2, 0xce, 0xf2, 0xfe, 0xde, 0x74, 0xab, 0x97, 0x19, 0x53, 0xe7, 0x44,
+	0x92, 0xab, 0xf7, 0x3e, 0xa0, 0x3b, 0xb0, 0x7c, 0xe9, 0x04, 0xd4, 0xf3, 0xaf, 0xcc, 0xa6, 0xed,
+	0x5e, 0xd0, 0xcb, 0xfc, 0xdc, 0xb6, 0xb0, 0x93, 0x25, 0x4b, 0x51, 0xb4, 0xcc, 0x83, 0xe8, 0xc7,
+	0xb0, 0xde, 0xb6, 0x7c, 0xdb, 0xa5, 0xbd, 0xf2, 0x9b, 0x8e, 0x7b, 0xee, 0xe5, 0xaf, 0xf1, 0x25,
+	0xec, 0x8c, 0xcc, 0xa2, 0xca, 0x19, 0x7d, 0x3b, 0x49, 0xd6, 0xda, 0xc3, 0x41, 0x24, 0xc1, 0x72,
+	0x4f, 0x96, 0x57, 0x66, 0x3e, 0xb5, 0x32, 0x4b, 0x5d, 0x06, 0xaf, 0xce, 0x7d, 0x98, 0x69, 0xd9,
+	0x2d, 0x2f, 0xbf, 0xc0, 0x89, 0x9b, 0x23, 0xf3, 0x39, 0xb6, 0x5b, 0x1e, 0xe1, 0x30, 0x44, 0x60,
+	0x35, 0xb0, 0x2d, 0xbf, 0x7e, 0x69, 0x5a, 0x94, 0xfa, 0xce, 0x59, 0x87, 0xda, 0x41, 0x1e, 0x38,
+	0xf7, 0xce, 0x48, 0xae, 0xce, 0xd1, 0x52, 0x17, 0x4c, 0xc4, 0x60, 0x20, 0x82, 0xca, 0xb0, 0x6a,
+	0x75, 0xa8, 0x67, 0xfa, 0x76, 0x60, 0x53, 0xb3, 0xed, 0x39, 0x2e, 0x0d, 0xf2, 0x39, 0xae, 0xb9,
+	0x3d, 0x52, 0x93, 0x30, 0x60, 0x95, 0xe3, 0xc8, 0x0a, 0xa3, 0x26, 0x02, 0xe8, 0x26, 0x2c, 0xb0,
+	0xf1, 0x30, 0xd9, 0x7c, 0xe4, 0x17, 0xb7, 0x85, 0x9d, 0x05, 0x32, 0xcf, 0x02, 0x65, 0x27, 0xa0,
+	0x68, 0x03, 0xae, 0x39, 0x81, 0x59, 0xf7, 0x3d, 0x37, 0xbf, 0xb4, 0x2d, 0xec, 0xcc, 0x93, 0x39,
+	0x27, 0x90, 0x7d, 0xcf, 0x2d, 0xfc, 0x22, 0x03, 0x5b, 0xc3, 0x9b, 0xef, 0xb9, 0xe7, 0xce, 0x45,
+	0x34, 0xd2, 0xe8, 0xeb, 0xa4, 0x70, 0x38, 0x42, 0x1f, 0x8d, 0x4c, 0xcf, 0x88, 0xde, 0x96, 0x78,
+	0xaf, 0x05, 0xdb, 0xbd, 0x8d, 0x8a, 0x66, 0xc0, 0x33, 0x7b, 0x1d, 0xed, 0x75, 0x68, 0x34, 0x4c,
+	0x9b, 0x43, 0x5b, 0xa7, 0x44, 0x09, 0x90, 0x0f, 0xbb, 0x12, 0x3a, 0x9f, 0x0b, 0x4f, 0x8e, 0x7b,
+	0xdc, 0xeb, 0x50, 0x74, 0x02, 0x37, 0x79, 0x7a, 0x63, 0xd4, 0xb3, 0x69, 0xea, 0x1b, 0x8c, 0x3d,
+	0x42, 0xb8, 0xf0, 0x37, 0x01, 0xd6, 0x46, 0x74, 0x24, 0x2b, 0x74, 0xc3, 0x6b, 0x59, 0x8e, 0x6b,
+	0x3a, 0x0d, 0x5e, 0x8f, 0x05, 0x32, 0x1f, 0x06, 0xd4, 0x06, 0xba, 0x05, 0xb9, 0xe8, 0xa1, 0x6b,
+	0xb5, 0x42, 0xa3, 0x58, 0x20, 0x10, 0x86, 0x34, 0xab, 0x65, 0x8f, 0x71, 0xa6, 0xec, 0x7f, 0xeb,
+	0x4c, 0xb7, 0x61, 0xd1, 0x71, 0x1d, 0xea, 0x58, 0xd4, 0x6e, 0xb0, 0xbc, 0x66, 0xf8, 0x50, 0xe6,
+	0xba, 0x31, 0xb5, 0x51, 0xf8, 0xb9, 0x00, 0xeb, 0xf8, 0x2d, 0xb5, 0x7d, 0xd7, 0x6a, 0x7e, 0x27,
+	0x6e, 0x39, 0x98, 0x53, 0x66, 0x38, 0xa7, 0x7f, 0xcc, 0xc2, 0x5a, 0xd5, 0x76, 0x1b, 0x8e, 0x7b,
+	0x21, 0xd5, 0xa9, 0xf3, 0xda, 0xa1, 0x57, 0x3c, 0xa3, 0x5b, 0x90, 0xb3, 0xa2, 0xcf, 0xbd, 0x2a,
+	0x43, 0x1c, 0x52, 0x1b, 0xe8, 0x10, 0x96, 0xba, 0x80, 0x54, 0x4b, 0x8e, 0xa5, 0xb9, 0x25, 0x2f,
+	0x5a, 0x89, 0x4f, 0xe8, 0x09, 0xcc, 0x32, 0x7b, 0x0c, 0x5d, 0x79, 0x79, 0xff, 0xde, 0x68, 0x5f,
+	0xea, 0xcf, 0x90, 0x39, 0xa1, 0x4d, 0x42, 0x1e, 0x52, 0x61, 0xf5, 0xd2, 0xb6, 0x7c, 0x7a, 0x66,
+	0x5b, 0xd4, 0x6c, 0xd8, 0xd4, 0x72, 0x9a, 0x41, 0xe4, 0xd3, 0x1f, 0x8e, 0x31, 0xb9, 0xab, 0xa6,
+	0x67, 0x35, 0x88, 0xd8, 0xa5, 0x29, 0x21, 0x0b, 0x3d, 0x83, 0xb5, 0xa6, 0x15, 0x50, 0xb3, 0xa7,
+	0xc7, 0xad, 0x6d, 0x36, 0xd5, 0xda, 0x56, 0x19, 0xed, 0x28, 0x66, 0xb1, 0x38, 0x3a, 0x04, 0x1e,
+	0x0c, 0xa7, 0xc2, 0x6e, 0x84, 0x4a, 0x73, 0xa9, 0x4a, 0x2b, 0x8c, 0xa4, 0x87, 0x1c, 0xae, 0x93,
+	0x87, 0x6b, 0x16, 0xa5, 0x76, 0xab, 0x4d, 0xb9, 0x73, 0xcf, 0x92, 0xf8, 0x23, 0xba, 0x07, 0x62,
+	0xcb, 0x7a, 0xeb, 0xb4, 0x3a, 0x2d, 0x33, 0x0a, 0x05, 0xdc, 0x85, 0x67, 0xc9, 0x4a, 0x14, 0x97,
+	0xa2, 0x30, 0xb3, 0xeb, 0xa0, 0x7e, 0x69, 0x37, 0x3a, 0xcd, 0x38, 0x93, 0x85, 0x74, 0xbb, 0xee,
+	0x32, 0x78, 0x1e, 0x32, 0xac, 0xd8, 0x6f, 0xdb, 0x4e, 0x38, 0xb3, 0xa1, 0x06, 0xa4, 0x6a, 0x2c,
+	0xf7, 0x28, 0x5c, 0xe4, 0x09, 0x2c, 0xf2, 0xa2, 0x9c, 0x5b, 0x4e, 0xb3, 0xe3, 0xdb, 0xf9, 0xdc,
+	0x84, 0x6d, 0x3a, 0x0c, 0x31, 0x24, 0xc7, 0x18, 0xd1, 0x07, 0xf4, 0x19, 0x5c, 0xe7, 0x02, 0xac,
+	0xd7, 0x6d, 0xdf, 0x74, 0x1a, 0xb6, 0x4b, 0x1d, 0x7a, 0x15, 0xd9, 0x2d, 0x62, 0xcf, 0x4e, 0xf8,
+	0x23, 0x35, 0x7a, 0x52, 0xf8, 0x55, 0x06, 0x36, 0xa3, 0xf6, 0x91, 0x2f, 0x9d, 0x66, 0xe3, 0x3b,
+	0x19, 0xbc, 0x4f, 0x13, 0xb2, 0x6c, 0x38, 0x92, 0x5e, 0x24, 0xbe, 0x49, 0xdc, 0x4f, 0xb8, 0x23,
+	0x0d, 0x8e, 0x69, 0x76, 0x68, 0x4c, 0xd1, 0x0b, 0x88, 0x8e, 0xe1, 0xc8, 0x5c, 0xdb, 0x5e, 0xd3,
+	0xa9, 0x5f, 0xf1, 0x36, 0x5f, 0x1e, 0x93, 0x68, 0xe8, 0x9c, 0xdc, 0x50, 0xab, 0x1c, 0x4d, 0x56,
+	0xdb, 0x83, 0xa1, 0xc2, 0x9f, 0x33, 0xdd, 0xf1, 0x57, 0xec, 0xba, 0x13, 0xc4, 0x75, 0xe9, 0x4e,
+	0xa5, 0x90, 0x3e, 0x95, 0x31, 0xb1, 0x6f, 0x2a, 0x87, 0x3b, 0x2e, 0xf3, 0xbe, 0x1d, 0xf7, 0x18,
+	0x16, 0xfb, 0x86, 0x27, 0xfd, 0xda, 0x96, 0x0b, 0x46, 0x0f, 0xce, 0x4c, 0xff, 0xe0, 0x10, 0xd8,
+	0xf0, 0x7c, 0xe7, 0xc2, 0x71, 0xad, 0xa6, 0x39, 0x90, 0x64, 0xfa, 0xa8, 0xaf, 0xc7, 0x54, 0x3d,
+	0x99, 0x6c, 0xe1, 0xf7, 0x19, 0xd8, 0x8c, 0xed, 0xa9, 0xec, 0xd5, 0xad, 0xa6, 0xe2, 0x04, 0x6d,
+	0x8b, 0xd6, 0x2f, 0xa7, 0x73, 0xd3, 0xff, 0x7d, 0xb9, 0x7e, 0x02, 0x5b, 0xfd, 0x19, 0x98, 0xde,
+	0xb9, 0x49, 0x2f, 0x9d, 0xc0, 0x4c, 0x56, 0x71, 0xb2, 0xe0, 0x8d, 0xbe, 0x8c, 0x2a, 0xe7, 0xc6,
+	0xa5, 0x13, 0x44, 0x1e, 0x84, 0x3e, 0x02, 0xe0, 0xb7, 0x04, 0xea, 0xbd, 0xb2, 0x5d, 0x5e, 0xe7,
+	0x45, 0xc2, 0xaf, 0x35, 0x06, 0x0b, 0x14, 0x9e, 0x41, 0x2e, 0x79, 0x97, 0x7a, 0x04, 0x73, 0xd1,
+	0x75, 0x4c, 0xd8, 0xce, 0xee, 0xe4, 0xf6, 0x3f, 0x4e, 0xb9, 0x8e, 0xf1, 0x9b, 0x6a, 0x44, 0x29,
+	0xfc, 0x36, 0x03, 0xcb, 0xfd, 0x8f, 0xd0, 0x27, 0xb0, 0x72, 0xe6, 0xb8, 0x96, 0x7f, 0x65, 0xd6,
+	0x2f, 0xed, 0xfa, 0xab, 0xa0, 0xd3, 0x8a, 0x36, 0x61, 0x39, 0x0c, 0xcb, 0x51, 0x14, 0xad, 0xc3,
+	0x9c, 0xdf, 0x71, 0xe3, 0xc3, 0x72, 0x81, 0xcc, 0xfa, 0x1d, 0x76, 0xab, 0x78, 0x0c, 0x37, 0xcf,
+	0x1d, 0x3f, 0x60, 0x07, 0x4c, 0xd8, 0xec, 0x66, 0xdd, 0x6b, 0xb5, 0x9b, 0x76, 0xdf, 0xc4, 0xe6,
+	0x39, 0x24, 0x1e, 0x07, 0x39, 0x06, 0x70, 0xfa, 0x62, 0xdd, 0xb7, 0xad, 0xee, 0xde, 0xa4, 0x97,
+	0x32, 0x17, 0xe1, 0x23, 0xdb, 0x5c, 0xe2, 0x46, 0xea, 0xb8, 0x17, 0xd3, 0xb6, 0xe9, 0x62, 0x4c,
+	0xe0, 0x02, 0x5b, 0x00, 0xfc, 0x8e, 0x4b, 0xad, 0xb3, 0x66, 0x78, 0x0a, 0xcd, 0x93, 0x44, 0xa4,
+	0xf8, 0x3b, 0x01, 0xae, 0x8f, 0x3a, 0x63, 0x51, 0x01, 0xb6, 0xaa, 0x58, 0x53, 0x54, 0xed, 0xa9,
+	0x29, 0xc9, 0x86, 0xfa, 0x42, 0x35, 0x4e, 0x4d, 0xdd, 0x90, 0x0c, 0x6c, 0xaa, 0xda, 0x0b, 0xa9,
+	0xac, 0x2a, 0xe2, 0xff, 0xa1, 0xff, 0x87, 0xed, 0x31, 0x18, 0x5d, 0x3e, 0xc2, 0x4a, 0xad, 0x8c,
+	0x15, 0x51, 0x98, 0xa0, 0xa4, 0x1b, 0x12, 0x31, 0xb0, 0x22, 0x66, 0xd0, 0xf7, 0xe0, 0x93, 0x31,
+	0x18, 0x59, 0xd2, 0x64, 0x5c, 0x36, 0x09, 0xfe, 0x51, 0x0d, 0xeb, 0x0c, 0x9c, 0x2d, 0xfe, 0xb4,
+	0x97, 0x73, 0x9f, 0x03, 0x25, 0xdf, 0xa4, 0x60, 0x59, 0xd5, 0xd5, 0x8a, 0x36, 0x29, 0xe7, 0x01,
+	0xcc, 0x98, 0x9c, 0x07, 0x51, 0x71, 0xce, 0xc5, 0x9f, 0x65, 0x7a, 0x5f, 0x81, 0xd5, 0x06, 0xb1,
+	0x3b, 0xb1, 0xb7, 0xb2, 0x77, 0x9c, 0x54, 0xc8, 0xf3, 0xc3, 0x72, 0xe5, 0xc4, 0x54, 0x15, 0x93,
+	0xe0, 0x9a, 0x8e, 0xcd, 0x6a, 0xa5, 0xac, 0xca, 0xa7, 0x89, 0x4c, 0xbe, 0x0f, 0x5f, 0x8c, 0x45,
+	0x49, 0x65, 0x16, 0x55, 0x6a, 0xd5, 0xb2, 0x2a, 0xb3, 0xb7, 0x1e, 0x4a, 0x6a, 0x19, 0x2b, 0x66,
+	0x45, 0x2b, 0x9f, 0x8a, 0x02, 0xfa, 0x14, 0x76, 0xa6, 0x65, 0x8a, 0x19, 0x74, 0x1f, 0xee, 0x8d,
+	0x45, 0x13, 0xfc, 0x0c, 0xcb, 0x46, 0x02, 0x9e, 0x45, 0x7b, 0x70, 0x7f, 0x2c, 0xdc, 0xc0, 0xe4,
+	0x58, 0xd5, 0x78, 0x41, 0x0f, 0x4d, 0x52, 0xd3, 0x34, 0x55, 0x7b, 0x2a, 0xce, 0x14, 0xff, 0x2a,
+	0x40, 0xbe, 0x57, 0x09, 0xf6, 0x15, 0xa6, 0xe9, 0xd4, 0x69, 0x54, 0x8c, 0x4f, 0xe0, 0xe3, 0xa4,
+	0x9e, 0x5c, 0xd1, 0x0e, 0xcb, 0xaa, 0x6c, 0x0c, 0xd7, 0x63, 0xa0, 0x6a, 0x83, 0x40, 0x56, 0x82,
+	0xe1, 0xb5, 0x0f, 0xa2, 0x58, 0xb2, 0xf8, 0xa5, 0xaa, 0x1b, 0x2c, 0xb3, 0x0c, 0xda, 0x87, 0xd2,
+	0x24, 0x74, 0x6f, 0x3d, 0x5d, 0x4e, 0xb6, 0xf8, 0x6b, 0x01, 0x56, 0x87, 0x8e, 0x50, 0x74, 0x0b,
+	0x6e, 0x56, 0x25, 0x82, 0x35, 0xc3, 0x94, 0xcb, 0x95, 0x51, 0xdb, 0x39, 0x06, 0x20, 0x1d, 0x48,
+	0x9a, 0x52, 0xd1, 0x44, 0x01, 0xdd, 0x85, 0xc2, 0x28, 0x40, 0xd4, 0xd9, 0x51, 0xa3, 0x8b, 0x19,
+	0x74, 0x1b, 0x3e, 0x1a, 0x85, 0xeb, 0xe6, 0x2a, 0x66, 0x8b, 0xff, 0xca, 0xc0, 0x87, 0x93, 0x7e,
+	0x37, 0x60, 0xf3, 0xd4, 0x5d, 0x37, 0x7e, 0x89, 0xe5, 0x9a, 0xc1, 0x3a, 0x38, 0xd4, 0x63, 0x7d,
+	0x5c, 0xd3, 0x13, 0x99, 0x27, 0x1b, 0x64, 0x0c, 0x58, 0xae, 0x1c, 0x57, 0xcb, 0xd8, 0xe0, 0xb3,
+	0x51, 0x84, 0xbb, 0x69, 0xf0, 0xb0, 0x5d, 0xc5, 0x4c, 0xdf, 0x6e, 0x8d, 0x93, 0xe6, 0xeb, 0x66,
+	0x83, 0x8d, 0x4a, 0x50, 0x4c, 0x43, 0x77, 0xab, 0xa0, 0x88, 0x33, 0xe8, 0x0b, 0xf8, 0x2c, 0x3d,
+	0x71, 0xcd, 0x50, 0xb5, 0x1a, 0x56, 0x4c, 0x49, 0x37, 0x35, 0x7c, 0x22, 0xce, 0x4e, 0xb3, 0x5c,
+	0x43, 0x3d, 0x66, 0xd3, 0x56, 0x33, 0xc4, 0xb9, 0xe2, 0x1f, 0x04, 0xf8, 0x40, 0xf6, 0x5c, 0xea,
+	0xb8, 0x1d, 0x5b, 0x0a, 0x34, 0xfb, 0x8d, 0x1a, 0xde, 0xce, 0x3c, 0x1f, 0xdd, 0x81, 0xdb, 0xb1,
+	0x7e, 0x24, 0x6f, 0xaa, 0x9a, 0x6a, 0xa8, 0x92, 0x51, 0x21, 0x89, 0xfa, 0x4e, 0x84, 0x31, 0x7b,
+	0x51, 0x30, 0x09, 0xeb, 0x3a, 0x1e, 0x46, 0xb0, 0x41, 0x4e, 0xa3, 0x56, 0x08, 0xfd, 0x72, 0x3c,
+	0x56, 0x26, 0x15, 0xad, 0xeb, 0x66, 0x62, 0xb6, 0xf8, 0x1b, 0x01, 0x72, 0xd1, 0x37, 0x6b, 0xfe,
+	0xc5, 0x2b, 0x0f, 0xd7, 0xd9, 0x02, 0x2b, 0x35, 0xc3, 0x34, 0x4e, 0xab, 0xb8, 0xbf, 0x87, 0xfb,
+	0x9e, 0x70, 0xb3, 0x33, 0x8d, 0x4a, 0x58, 0x9d, 0xd0, 0x17, 0xfb, 0x01, 0xd1, 0x5b, 0x18, 0x86,
+	0x83, 0xc5, 0xcc, 0x44, 0x4c, 0xa8, 0x93, 0x45, 0x37, 0xe0, 0x83, 0x3e, 0xcc, 0x11, 0x96, 0x88,
+	0x71, 0x80, 0x25, 0x43, 0x9c, 0x29, 0xfe, 0x52, 0x80, 0xcd, 0xd8, 0xd7, 0xd9, 0xef, 0x1a, 0x2c,
+	0xf5, 0x46, 0xa5, 0x43, 0x65, 0xab, 0x13, 0xd8, 0xe8, 0x1e, 0xdc, 0xe9, 0x3a, 0xb2, 0x21, 0xe9,
+	0xcf, 0x7b, 0x7b, 0x65, 0xca, 0x52, 0x4d, 0x4f, 0xae, 0x26, 0x15, 0x1a, 0xa5, 0x20, 0x0a, 0xcc,
+	0xa4, 0x26, 0x43, 0x09, 0xd6, 0xb1, 0x21, 0x66, 0x8a, 0xef, 0x16, 0x61, 0x23, 0x99, 0x1c, 0xfb,
+	0x7a, 0x62, 0x37, 0xc2, 0xd4, 0xee, 0x42, 0xa1, 0x5f, 0x24, 0x72, 0xed, 0xc1, 0xbc, 0xf6, 0xe0,
+	0xfe, 0x04, 0x5c, 0x4d, 0x3b, 0x92, 0x34, 0x85, 0x7d, 0x8e, 0x41, 0xa2, 0x80, 0x9e, 0xc0, 0xa3,
+	0x09, 0x94, 0x03, 0x49, 0xe9, 0x55, 0xb9, 0x7b, 0x7e, 0x4a, 0x86, 0x41, 0xd4, 0x83, 0x9a, 0x81,
+	0x75, 0x31, 0x83, 0x30, 0x48, 0x29, 0x02, 0xfd, 0x3e, 0x34, 0x52, 0x26, 0x8b, 0x1e, 0xc2, 0x97,
+	0x69, 0x79, 0x84, 0x2d, 0xa3, 0x1e, 0x63, 0x92, 0xa4, 0xce, 0xa0, 0xaf, 0xe1, 0xab, 0x14, 0x6a,
+	0xf4, 0xe6, 0x21, 0xee, 0x2c, 0x7a, 0x04, 0x0f, 0x52, 0xb3, 0x97, 0x2b, 0x44, 0x31, 0x8f, 0x25,
+	0xf2, 0xbc, 0x9f, 0x3c, 0x87, 0x54, 0xc0, 0x69, 0x2f, 0x8e, 0xdc, 0xcd, 0x1c, 0xe1, 0x0b, 0x09,
+	0xa9, 0x6b, 0x53, 0x54, 0x91, 0x05, 0x52, 0x64, 0xe6, 0xd1, 0x53, 0x90, 0xa7, 0x2b, 0xc5, 0x64,
+	0xa1, 0x05, 0xf4, 0x12, 0x8c, 0xf7, 0xdb, 0x55, 0xfc, 0xd2, 0xc0, 0x44, 0x93, 0xd2, 0x94, 0x01,
+	0x3d, 0x86, 0x87, 0xa9, 0x45, 0xeb, 0xf7, 0x9f, 0x04, 0x3d, 0x87, 0x1e, 0xc0, 0xe7, 0x13, 0xe8,
+	0xc9, 0x1e, 0xe9, 0xdd, 0x71, 0x54, 0x45, 0x5c, 0x44, 0x5f, 0xc2, 0xde, 0x04, 0x22, 0x9f, 0x42,
+	0x53, 0x37, 0x54, 0xf9, 0xf9, 0x69, 0xf8, 0xb8, 0xac, 0xea, 0x86, 0xb8, 0x84, 0x7e, 0x08, 0x3f,
+	0x98, 0x40, 0xeb, 0x2e, 0x96, 0xfd, 0x81, 0x49, 0x62, 0xc4, 0x18, 0xac, 0x46, 0xb0, 0xb8, 0x3c,
+	0xc5, 0x9e, 0xe8, 0xea, 0xd3, 0xf4, 0xca, 0xad, 0x20, 0x19, 0x9e, 0x4c, 0x35, 0x22, 0xf2, 0x91,
+	0x5a, 0x56, 0x46, 0x8b, 0x88, 0xe8, 0x73, 0xd8, 0x9d, 0x20, 0x72, 0x58, 0x21, 0x32, 0x8e, 0x4e,
+	0xac, 0xae, 0x49, 0xac, 0xa2, 0xaf, 0x60, 0x7f, 0x12, 0x49, 0x52, 0xcb, 0x95, 0x17, 0x98, 0x0c,
+	0xf2, 0x10, 0x3b, 0x46, 0xa7, 0x5b, 0xba, 0xaa, 0x55, 0x6b, 0x86, 0xa9, 0xab, 0xdf, 0x62, 0x71,
+	0x8d, 0x1d, 0xa3, 0xa9, 0x3b, 0x15, 0xd7, 0x4a, 0xbc, 0x3e, 0x6c, 0xc6, 0x43, 0x2f, 0x39, 0x50,
+	0x35, 0x89, 0x9c, 0x8a, 0xeb, 0x29, 0xbd, 0x37, 0x6c, 0x74, 0x7d, 0x2d, 0xf4, 0xc1, 0x34, 0xcb,
+	0xc1, 0x12, 0x91, 0x8f, 0x92, 0x15, 0xdf, 0x40, 0x87, 0x70, 0xf0, 0xde, 0x2e, 0x51, 0xab, 0x2a,
+	0xec, 0xad, 0x09, 0x9d, 0x3c, 0x3a, 0x80, 0x6f, 0x52, 0x74, 0x6a, 0x55, 0x1d, 0x93, 0x5e, 0x6d,
+	0xcc, 0x63, 0x7c, 0x5c, 0x49, 0x6a, 0x6c, 0xb2, 0x13, 0xf0, 0x36, 0xff, 0xc9, 0x6a, 0xe8, 0x8e,
+	0x97, 0x3c, 0x6e, 0xf6, 0xe0, 0x7e, 0xd8, 0x43, 0x23, 0x3a, 0x72, 0xcc, 0xc9, 0x73, 0x00, 0xdf,
+	0x4c, 0x47, 0xe9, 0x3e, 0x97, 0xca, 0x04, 0x4b, 0xca, 0x69, 0xf7, 0xb2, 0x2f, 0x14, 0xff, 0x24,
+	0x40, 0x51, 0xb6, 0xdc, 0xba, 0xdd, 0x8c, 0x7f, 0xd1, 0x9e, 0x98, 0xe5, 0x23, 0x78, 0x30, 0x85,
+	0xf7, 0x8c, 0xc9, 0xf7, 0x04, 0xf4, 0xf7, 0x25, 0xd7, 0xb4, 0xe7, 0x5a, 0xe5, 0x44, 0x9b, 0x44,
+	0x88, 0x16, 0xa1, 0x3b, 0x17, 0xae, 0x35, 0xf5, 0x22, 0xa2, 0x11, 0xf8, 0xcf, 0x16, 0xf1, 0xbe,
+	0xe4, 0xa9, 0x16, 0x71, 0x70, 0xf6, 0x97, 0x77, 0x5b, 0xc2, 0xdf, 0xdf, 0x6d, 0x09, 0xff, 0x7c,
+	0xb7, 0x25, 0xc0, 0x46, 0xdd, 0x6b, 0x8d, 0xfa, 0xad, 0xe4, 0x60, 0x5e, 0x6a, 0x3b, 0x55, 0xf6,
+	0x3b, 0x41, 0x55, 0xf8, 0x76, 0xf7, 0xc2, 0xa1, 0x97, 0x9d, 0xb3, 0x52, 0xdd, 0x6b, 0xed, 0xf6,
+	0xfd, 0x97, 0xb7, 0x74, 0x61, 0xbb, 0xe1, 0xff, 0x8c, 0xa3, 0x7f, 0xf8, 0x3e, 0xb2, 0xda, 0xce,
+	0xeb, 0xbd, 0xb3, 0x39, 0x1e, 0xfb, 0xfc, 0xdf, 0x03, 0x00, 0x2c, 0x18, 0x36, 0xde, 0xb0, 0x1e,
+	0x00, 0x00,
 }
 
 func (m *WorkflowExecutionInfo) Marshal() (dAtA []byte, err error) {
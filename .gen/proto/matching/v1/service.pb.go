@@ -138,6 +138,9 @@ type PollForDecisionTaskResponse struct {
 	ScheduledTime             *types.Timestamp             `protobuf:"bytes,15,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
 	StartedTime               *types.Timestamp             `protobuf:"bytes,16,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
 	Queries                   map[string]*v1.WorkflowQuery `protobuf:"bytes,17,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	HistorySize               int64                        `protobuf:"varint,18,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
+	HistoryCount              int64                        `protobuf:"varint,19,opt,name=history_count,json=historyCount,proto3" json:"history_count,omitempty"`
+	SuggestContinueAsNew      bool                         `protobuf:"varint,20,opt,name=suggest_continue_as_new,json=suggestContinueAsNew,proto3" json:"suggest_continue_as_new,omitempty"`
 	XXX_NoUnkeyedLiteral      struct{}                     `json:"-"`
 	XXX_unrecognized          []byte                       `json:"-"`
 	XXX_sizecache             int32                        `json:"-"`
@@ -295,6 +298,27 @@ func (m *PollForDecisionTaskResponse) GetQueries() map[string]*v1.WorkflowQuery
 	return nil
 }
 
+func (m *PollForDecisionTaskResponse) GetHistorySize() int64 {
+	if m != nil {
+		return m.HistorySize
+	}
+	return 0
+}
+
+func (m *PollForDecisionTaskResponse) GetHistoryCount() int64 {
+	if m != nil {
+		return m.HistoryCount
+	}
+	return 0
+}
+
+func (m *PollForDecisionTaskResponse) GetSuggestContinueAsNew() bool {
+	if m != nil {
+		return m.SuggestContinueAsNew
+	}
+	return false
+}
+
 type PollForActivityTaskRequest struct {
 	Request              *v1.PollForActivityTaskRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId             string                         `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -1469,6 +1493,370 @@ func (m *GetTaskListsByDomainResponse) GetTaskListNames() []string {
 	return nil
 }
 
+type PauseTaskListRequest struct {
+	DomainId             string          `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	TaskList             *v1.TaskList    `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	TaskListType         v1.TaskListType `protobuf:"varint,3,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PauseTaskListRequest) Reset()         { *m = PauseTaskListRequest{} }
+func (m *PauseTaskListRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseTaskListRequest) ProtoMessage()    {}
+func (*PauseTaskListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{20}
+}
+func (m *PauseTaskListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseTaskListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseTaskListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseTaskListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseTaskListRequest.Merge(m, src)
+}
+func (m *PauseTaskListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseTaskListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseTaskListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseTaskListRequest proto.InternalMessageInfo
+
+func (m *PauseTaskListRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *PauseTaskListRequest) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *PauseTaskListRequest) GetTaskListType() v1.TaskListType {
+	if m != nil {
+		return m.TaskListType
+	}
+	return v1.TaskListType_TASK_LIST_TYPE_INVALID
+}
+
+type PauseTaskListResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseTaskListResponse) Reset()         { *m = PauseTaskListResponse{} }
+func (m *PauseTaskListResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseTaskListResponse) ProtoMessage()    {}
+func (*PauseTaskListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{21}
+}
+func (m *PauseTaskListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseTaskListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseTaskListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseTaskListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseTaskListResponse.Merge(m, src)
+}
+func (m *PauseTaskListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseTaskListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseTaskListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseTaskListResponse proto.InternalMessageInfo
+
+type ResumeTaskListRequest struct {
+	DomainId             string          `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	TaskList             *v1.TaskList    `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	TaskListType         v1.TaskListType `protobuf:"varint,3,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ResumeTaskListRequest) Reset()         { *m = ResumeTaskListRequest{} }
+func (m *ResumeTaskListRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeTaskListRequest) ProtoMessage()    {}
+func (*ResumeTaskListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{22}
+}
+func (m *ResumeTaskListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResumeTaskListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResumeTaskListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResumeTaskListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeTaskListRequest.Merge(m, src)
+}
+func (m *ResumeTaskListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResumeTaskListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeTaskListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeTaskListRequest proto.InternalMessageInfo
+
+func (m *ResumeTaskListRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *ResumeTaskListRequest) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *ResumeTaskListRequest) GetTaskListType() v1.TaskListType {
+	if m != nil {
+		return m.TaskListType
+	}
+	return v1.TaskListType_TASK_LIST_TYPE_INVALID
+}
+
+type ResumeTaskListResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeTaskListResponse) Reset()         { *m = ResumeTaskListResponse{} }
+func (m *ResumeTaskListResponse) String() string { return proto.CompactTextString(m) }
+func (*ResumeTaskListResponse) ProtoMessage()    {}
+func (*ResumeTaskListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{23}
+}
+func (m *ResumeTaskListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResumeTaskListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResumeTaskListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResumeTaskListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeTaskListResponse.Merge(m, src)
+}
+func (m *ResumeTaskListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResumeTaskListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeTaskListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeTaskListResponse proto.InternalMessageInfo
+
+type MoveTaskListBacklogRequest struct {
+	DomainId             string          `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	SourceTaskList       *v1.TaskList    `protobuf:"bytes,2,opt,name=source_task_list,json=sourceTaskList,proto3" json:"source_task_list,omitempty"`
+	TargetTaskList       *v1.TaskList    `protobuf:"bytes,3,opt,name=target_task_list,json=targetTaskList,proto3" json:"target_task_list,omitempty"`
+	TaskListType         v1.TaskListType `protobuf:"varint,4,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	BatchSize            int32           `protobuf:"varint,5,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	AfterTaskId          int64           `protobuf:"varint,6,opt,name=after_task_id,json=afterTaskId,proto3" json:"after_task_id,omitempty"`
+	DryRun               bool            `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *MoveTaskListBacklogRequest) Reset()         { *m = MoveTaskListBacklogRequest{} }
+func (m *MoveTaskListBacklogRequest) String() string { return proto.CompactTextString(m) }
+func (*MoveTaskListBacklogRequest) ProtoMessage()    {}
+func (*MoveTaskListBacklogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{24}
+}
+func (m *MoveTaskListBacklogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MoveTaskListBacklogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MoveTaskListBacklogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MoveTaskListBacklogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveTaskListBacklogRequest.Merge(m, src)
+}
+func (m *MoveTaskListBacklogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MoveTaskListBacklogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveTaskListBacklogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveTaskListBacklogRequest proto.InternalMessageInfo
+
+func (m *MoveTaskListBacklogRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *MoveTaskListBacklogRequest) GetSourceTaskList() *v1.TaskList {
+	if m != nil {
+		return m.SourceTaskList
+	}
+	return nil
+}
+
+func (m *MoveTaskListBacklogRequest) GetTargetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TargetTaskList
+	}
+	return nil
+}
+
+func (m *MoveTaskListBacklogRequest) GetTaskListType() v1.TaskListType {
+	if m != nil {
+		return m.TaskListType
+	}
+	return v1.TaskListType_TASK_LIST_TYPE_INVALID
+}
+
+func (m *MoveTaskListBacklogRequest) GetBatchSize() int32 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *MoveTaskListBacklogRequest) GetAfterTaskId() int64 {
+	if m != nil {
+		return m.AfterTaskId
+	}
+	return 0
+}
+
+func (m *MoveTaskListBacklogRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type MoveTaskListBacklogResponse struct {
+	// Number of tasks moved, or the number of tasks that would be moved in a dry run.
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Highest task ID visited, pass it as after_task_id to continue.
+	LastTaskId           int64    `protobuf:"varint,2,opt,name=last_task_id,json=lastTaskId,proto3" json:"last_task_id,omitempty"`
+	HasMore              bool     `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveTaskListBacklogResponse) Reset()         { *m = MoveTaskListBacklogResponse{} }
+func (m *MoveTaskListBacklogResponse) String() string { return proto.CompactTextString(m) }
+func (*MoveTaskListBacklogResponse) ProtoMessage()    {}
+func (*MoveTaskListBacklogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{25}
+}
+func (m *MoveTaskListBacklogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MoveTaskListBacklogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MoveTaskListBacklogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MoveTaskListBacklogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveTaskListBacklogResponse.Merge(m, src)
+}
+func (m *MoveTaskListBacklogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MoveTaskListBacklogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveTaskListBacklogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveTaskListBacklogResponse proto.InternalMessageInfo
+
+func (m *MoveTaskListBacklogResponse) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *MoveTaskListBacklogResponse) GetLastTaskId() int64 {
+	if m != nil {
+		return m.LastTaskId
+	}
+	return 0
+}
+
+func (m *MoveTaskListBacklogResponse) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*PollForDecisionTaskRequest)(nil), "uber.cadence.matching.v1.PollForDecisionTaskRequest")
 	proto.RegisterType((*PollForDecisionTaskResponse)(nil), "uber.cadence.matching.v1.PollForDecisionTaskResponse")
@@ -1491,6 +1879,12 @@ func init() {
 	proto.RegisterType((*ListTaskListPartitionsResponse)(nil), "uber.cadence.matching.v1.ListTaskListPartitionsResponse")
 	proto.RegisterType((*GetTaskListsByDomainRequest)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainRequest")
 	proto.RegisterType((*GetTaskListsByDomainResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse")
+	proto.RegisterType((*PauseTaskListRequest)(nil), "uber.cadence.matching.v1.PauseTaskListRequest")
+	proto.RegisterType((*PauseTaskListResponse)(nil), "uber.cadence.matching.v1.PauseTaskListResponse")
+	proto.RegisterType((*ResumeTaskListRequest)(nil), "uber.cadence.matching.v1.ResumeTaskListRequest")
+	proto.RegisterType((*ResumeTaskListResponse)(nil), "uber.cadence.matching.v1.ResumeTaskListResponse")
+	proto.RegisterType((*MoveTaskListBacklogRequest)(nil), "uber.cadence.matching.v1.MoveTaskListBacklogRequest")
+	proto.RegisterType((*MoveTaskListBacklogResponse)(nil), "uber.cadence.matching.v1.MoveTaskListBacklogResponse")
 }
 
 func init() {
@@ -1498,123 +1892,142 @@ func init() {
 }
 
 var fileDescriptor_826e827d3aabf7fc = []byte{
-	// 1851 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xdb, 0x6f, 0xdb, 0xd6,
-	0x19, 0x07, 0xed, 0xd8, 0xb2, 0x3e, 0x5d, 0xe2, 0x9c, 0xb4, 0x0e, 0x2d, 0xc7, 0x97, 0xa8, 0x6b,
-	0xe6, 0x0d, 0x1d, 0x35, 0xab, 0x4d, 0x96, 0xa6, 0x18, 0x06, 0xc7, 0x8e, 0x53, 0x01, 0x4b, 0x93,
-	0x32, 0x5a, 0x06, 0x0c, 0x43, 0x88, 0x23, 0xf2, 0xd8, 0xe2, 0x4c, 0x91, 0x32, 0xcf, 0xa1, 0x5c,
-	0xbd, 0xec, 0x61, 0xd8, 0x86, 0x01, 0x7d, 0xdd, 0x5f, 0xb0, 0xed, 0x71, 0x7f, 0x48, 0x1f, 0xf6,
-	0xb0, 0xf7, 0x61, 0xc0, 0x10, 0x60, 0xff, 0xc7, 0x70, 0x2e, 0xa4, 0x44, 0x89, 0xba, 0xd9, 0x6b,
-	0xf3, 0xe6, 0x73, 0xce, 0x77, 0xbf, 0xfc, 0xbe, 0x8f, 0x16, 0xdc, 0x8f, 0x5a, 0x24, 0xac, 0xd9,
-	0xd8, 0x21, 0xbe, 0x4d, 0x6a, 0x1d, 0xcc, 0xec, 0xb6, 0xeb, 0x9f, 0xd5, 0x7a, 0x07, 0x35, 0x4a,
-	0xc2, 0x9e, 0x6b, 0x13, 0xa3, 0x1b, 0x06, 0x2c, 0x40, 0x3a, 0xa7, 0x33, 0x14, 0x9d, 0x11, 0xd3,
-	0x19, 0xbd, 0x83, 0xca, 0xce, 0x59, 0x10, 0x9c, 0x79, 0xa4, 0x26, 0xe8, 0x5a, 0xd1, 0x69, 0xcd,
-	0x89, 0x42, 0xcc, 0xdc, 0xc0, 0x97, 0x9c, 0x95, 0xdd, 0xd1, 0x77, 0xe6, 0x76, 0x08, 0x65, 0xb8,
-	0xd3, 0x55, 0x04, 0x63, 0x02, 0x2e, 0x43, 0xdc, 0xed, 0x92, 0x90, 0xaa, 0xf7, 0xbd, 0x94, 0x89,
-	0xb8, 0xeb, 0x72, 0xeb, 0xec, 0xa0, 0xd3, 0x19, 0xa8, 0xc8, 0xa2, 0xb8, 0x88, 0x48, 0xd8, 0x57,
-	0x04, 0xd5, 0x2c, 0x02, 0x86, 0xe9, 0xb9, 0xe7, 0x52, 0xa6, 0x68, 0xf6, 0xb3, 0x68, 0x54, 0x10,
-	0xac, 0xcb, 0x20, 0x3c, 0x27, 0xa1, 0xa2, 0xfc, 0xe1, 0x2c, 0xca, 0x53, 0x2f, 0xb8, 0x54, 0xb4,
-	0xdf, 0x4b, 0xd1, 0xd2, 0x36, 0x0e, 0x89, 0xc3, 0xc9, 0xdb, 0x2e, 0x65, 0x41, 0x62, 0xdf, 0x87,
-	0x13, 0xa8, 0xd2, 0x26, 0x56, 0xbf, 0xd1, 0xa0, 0xf2, 0x32, 0xf0, 0xbc, 0x93, 0x20, 0x3c, 0x26,
-	0xb6, 0x4b, 0xdd, 0xc0, 0x6f, 0x62, 0x7a, 0x6e, 0x92, 0x8b, 0x88, 0x50, 0x86, 0x1a, 0x90, 0x0b,
-	0xe5, 0x9f, 0xba, 0xb6, 0xa7, 0xed, 0x17, 0xea, 0x35, 0x23, 0x95, 0x35, 0xdc, 0x75, 0x8d, 0xde,
-	0x81, 0x31, 0x59, 0x82, 0x19, 0xf3, 0xa3, 0x2d, 0xc8, 0x3b, 0x41, 0x07, 0xbb, 0xbe, 0xe5, 0x3a,
-	0xfa, 0xd2, 0x9e, 0xb6, 0x9f, 0x37, 0xd7, 0xe4, 0x45, 0xc3, 0xe1, 0x8f, 0xdd, 0xc0, 0xf3, 0x48,
-	0xc8, 0x1f, 0x97, 0xe5, 0xa3, 0xbc, 0x68, 0x38, 0xe8, 0x43, 0x28, 0x9f, 0x06, 0xe1, 0x25, 0x0e,
-	0x1d, 0xe2, 0x58, 0xa7, 0x61, 0xd0, 0xd1, 0x6f, 0x08, 0x8a, 0x52, 0x72, 0x7b, 0x12, 0x06, 0x9d,
-	0xea, 0xef, 0xf3, 0xb0, 0x95, 0x69, 0x08, 0xed, 0x06, 0x3e, 0x25, 0x68, 0x1b, 0x80, 0x3b, 0x6f,
-	0xb1, 0xe0, 0x9c, 0xf8, 0xc2, 0x9d, 0xa2, 0x99, 0xe7, 0x37, 0x4d, 0x7e, 0x81, 0x7e, 0x01, 0x28,
-	0x0e, 0xb4, 0x45, 0xbe, 0x22, 0x76, 0xc4, 0x0b, 0x4e, 0x18, 0x5a, 0xa8, 0xdf, 0xcf, 0xf4, 0xfa,
-	0x97, 0x8a, 0xfc, 0x69, 0x4c, 0x6d, 0xde, 0xba, 0x1c, 0xbd, 0x42, 0x27, 0x50, 0x4a, 0xc4, 0xb2,
-	0x7e, 0x97, 0x08, 0xef, 0x0a, 0xf5, 0x7b, 0x53, 0x25, 0x36, 0xfb, 0x5d, 0x62, 0x16, 0x2f, 0x87,
-	0x4e, 0xe8, 0x35, 0x6c, 0x76, 0x43, 0xd2, 0x73, 0x83, 0x88, 0x5a, 0x94, 0xe1, 0x90, 0x11, 0xc7,
-	0x22, 0x3d, 0xe2, 0x33, 0x1e, 0xb1, 0x1b, 0x42, 0xe6, 0x96, 0x21, 0xcb, 0xde, 0x88, 0xcb, 0xde,
-	0x68, 0xf8, 0xec, 0xe1, 0x27, 0xaf, 0xb1, 0x17, 0x11, 0x73, 0x23, 0xe6, 0x7e, 0x25, 0x99, 0x9f,
-	0x72, 0xde, 0x86, 0x83, 0xf6, 0x61, 0x7d, 0x4c, 0xdc, 0xca, 0x9e, 0xb6, 0xbf, 0x6c, 0x96, 0x69,
-	0x9a, 0x52, 0x87, 0x1c, 0x66, 0x8c, 0x74, 0xba, 0x4c, 0x5f, 0xdd, 0xd3, 0xf6, 0x57, 0xcc, 0xf8,
-	0x88, 0xaa, 0x50, 0xf2, 0xc9, 0x57, 0x6c, 0x20, 0x20, 0x27, 0x04, 0x14, 0xf8, 0x65, 0xcc, 0xfd,
-	0x11, 0xa0, 0x16, 0xb6, 0xcf, 0xbd, 0xe0, 0xcc, 0xb2, 0x83, 0xc8, 0x67, 0x56, 0xdb, 0xf5, 0x99,
-	0xbe, 0x26, 0x08, 0xd7, 0xd5, 0xcb, 0x11, 0x7f, 0xf8, 0xdc, 0xf5, 0x19, 0x7a, 0x04, 0x3a, 0x65,
-	0xae, 0x7d, 0xde, 0x1f, 0xa4, 0xc2, 0x22, 0x3e, 0x6e, 0x79, 0xc4, 0xd1, 0xf3, 0x7b, 0xda, 0xfe,
-	0x9a, 0xb9, 0x21, 0xdf, 0x93, 0x40, 0x3f, 0x95, 0xaf, 0xe8, 0x11, 0xac, 0x88, 0x36, 0xd5, 0x41,
-	0xc4, 0xa4, 0x3a, 0x35, 0xce, 0x5f, 0x72, 0x4a, 0x53, 0x32, 0x20, 0x13, 0x4a, 0x8e, 0xaa, 0x1b,
-	0xcb, 0xf5, 0x4f, 0x03, 0xbd, 0x20, 0x24, 0xfc, 0x28, 0x2d, 0x41, 0x76, 0x12, 0x17, 0xd2, 0x0c,
-	0xb1, 0x4f, 0x5d, 0xe2, 0xb3, 0xb8, 0xda, 0x1a, 0xfe, 0x69, 0x60, 0x16, 0x9d, 0xa1, 0x13, 0x7a,
-	0x03, 0x77, 0xc7, 0x8b, 0xca, 0x12, 0x65, 0xc8, 0x9b, 0x50, 0x2f, 0x0a, 0x15, 0xdb, 0x99, 0x46,
-	0xf2, 0xe2, 0xfd, 0xb9, 0x4b, 0x99, 0xb9, 0x39, 0x56, 0x55, 0xf1, 0x13, 0x32, 0xe0, 0xb6, 0x0c,
-	0x3a, 0x6f, 0x7d, 0x62, 0xf5, 0x48, 0xc8, 0x55, 0xeb, 0x25, 0x91, 0x9f, 0x5b, 0xe2, 0xe9, 0x15,
-	0x7f, 0x79, 0x2d, 0x1f, 0xd0, 0x3d, 0x28, 0xb6, 0x42, 0xec, 0xdb, 0x6d, 0xd5, 0x05, 0x65, 0xd1,
-	0x05, 0x05, 0x79, 0x27, 0xfb, 0xe0, 0x10, 0xca, 0xd4, 0x6e, 0x13, 0x27, 0xf2, 0x88, 0x63, 0x71,
-	0x60, 0xd5, 0x6f, 0x0a, 0x23, 0x2b, 0x63, 0xd5, 0xd5, 0x8c, 0x51, 0xd7, 0x2c, 0x25, 0x1c, 0xfc,
-	0x0e, 0xfd, 0x14, 0x8a, 0x71, 0x4d, 0x09, 0x01, 0xeb, 0x33, 0x05, 0x14, 0x14, 0xbd, 0x60, 0xff,
-	0x35, 0xe4, 0x78, 0x46, 0x5c, 0x42, 0xf5, 0x5b, 0x7b, 0xcb, 0xfb, 0x85, 0xfa, 0x13, 0x63, 0xd2,
-	0xa8, 0x30, 0xa6, 0x34, 0xbc, 0xf1, 0xa5, 0x14, 0xf2, 0xd4, 0x67, 0x61, 0xdf, 0x8c, 0x45, 0x56,
-	0xde, 0x40, 0x71, 0xf8, 0x01, 0xad, 0xc3, 0xf2, 0x39, 0xe9, 0x0b, 0x3c, 0xc8, 0x9b, 0xfc, 0x4f,
-	0x5e, 0x42, 0x3d, 0xde, 0x33, 0xaa, 0xf9, 0xe7, 0x2a, 0x21, 0xc1, 0xf0, 0x78, 0xe9, 0x91, 0x36,
-	0x8c, 0xa8, 0x87, 0x36, 0x73, 0x7b, 0x2e, 0xeb, 0x5f, 0x1d, 0x51, 0x33, 0x24, 0x7c, 0x87, 0x88,
-	0xfa, 0xf5, 0x5a, 0x82, 0xa8, 0x69, 0x43, 0xde, 0x29, 0xa2, 0xee, 0x42, 0x01, 0x2b, 0x6b, 0x06,
-	0xbe, 0x41, 0x7c, 0xd5, 0x70, 0x38, 0xe4, 0x26, 0x04, 0x02, 0x72, 0x6f, 0x4c, 0x81, 0xdc, 0xc4,
-	0x31, 0x01, 0xb9, 0x78, 0xe8, 0x84, 0xea, 0xb0, 0xe2, 0xfa, 0xdd, 0x88, 0x09, 0x3c, 0x2c, 0xd4,
-	0xef, 0x66, 0x27, 0x0a, 0xf7, 0xbd, 0x00, 0x3b, 0xa6, 0x24, 0xcd, 0xe8, 0x9e, 0xd5, 0xeb, 0x76,
-	0x4f, 0x6e, 0xb1, 0xee, 0x69, 0xc2, 0x66, 0x2c, 0xcf, 0x62, 0x81, 0x65, 0x7b, 0x01, 0x25, 0x42,
-	0x50, 0x10, 0x49, 0xbc, 0x2d, 0xd4, 0x37, 0xc7, 0x64, 0x1d, 0xab, 0x05, 0xcb, 0xdc, 0x88, 0x79,
-	0x9b, 0xc1, 0x11, 0xe7, 0x6c, 0x4a, 0x46, 0xf4, 0x05, 0x6c, 0x08, 0x25, 0xe3, 0x22, 0xf3, 0xb3,
-	0x44, 0xde, 0x16, 0x8c, 0x23, 0xf2, 0x4e, 0xe0, 0x56, 0x9b, 0xe0, 0x90, 0xb5, 0x08, 0x66, 0x89,
-	0x28, 0x98, 0x25, 0x6a, 0x3d, 0xe1, 0x89, 0xe5, 0x0c, 0x0d, 0xa5, 0x42, 0x7a, 0x28, 0xbd, 0x81,
-	0x9d, 0x74, 0x26, 0xac, 0xe0, 0xd4, 0x62, 0x6d, 0x97, 0x5a, 0x31, 0x43, 0x71, 0x66, 0x60, 0x2b,
-	0xa9, 0xcc, 0xbc, 0x38, 0x6d, 0xb6, 0x5d, 0x7a, 0xa8, 0xe4, 0x37, 0x86, 0x3d, 0x70, 0x08, 0xc3,
-	0xae, 0x47, 0x05, 0xf0, 0xce, 0xaa, 0x94, 0x81, 0x13, 0xc7, 0x92, 0x6b, 0x7c, 0x47, 0x28, 0x5f,
-	0x6d, 0x47, 0xf8, 0x3e, 0xdc, 0x4c, 0xe4, 0x48, 0x20, 0x10, 0xd8, 0x9d, 0x37, 0xcb, 0xf1, 0xf5,
-	0xb1, 0xb8, 0x45, 0x1f, 0xc3, 0x6a, 0x9b, 0x60, 0x87, 0x84, 0x0a, 0x9a, 0xb7, 0x32, 0x35, 0x7d,
-	0x2e, 0x48, 0x4c, 0x45, 0x5a, 0xfd, 0xcb, 0x32, 0x6c, 0x1c, 0x3a, 0x4e, 0xd6, 0x9a, 0x98, 0x42,
-	0x22, 0x6d, 0x04, 0x89, 0xbe, 0x25, 0x18, 0x78, 0x0c, 0xf9, 0xc1, 0x1c, 0x5d, 0x9e, 0x67, 0x8e,
-	0xae, 0xb1, 0x78, 0x6c, 0xee, 0x42, 0x21, 0xe9, 0x11, 0xb5, 0x3e, 0x2d, 0x9b, 0x10, 0x5f, 0x35,
-	0x9c, 0xd1, 0x26, 0x52, 0xa5, 0xaf, 0xca, 0x74, 0x65, 0x81, 0x26, 0x12, 0xdb, 0x56, 0x5c, 0xac,
-	0x8f, 0x61, 0x95, 0x06, 0x51, 0x68, 0x4b, 0x50, 0x28, 0x8f, 0x4e, 0x96, 0xa1, 0xd5, 0x02, 0xd3,
-	0xf3, 0x57, 0x82, 0xd2, 0x54, 0x1c, 0x19, 0x90, 0x9d, 0xcb, 0x82, 0xec, 0x4d, 0xb8, 0x33, 0x96,
-	0x23, 0x89, 0xd6, 0xd5, 0x7f, 0xc8, 0xfc, 0x65, 0x0d, 0xa5, 0x77, 0x91, 0x3f, 0xbe, 0x78, 0x0a,
-	0xd7, 0xac, 0x81, 0x6a, 0x89, 0xe5, 0x65, 0x79, 0x7f, 0x1c, 0x1b, 0x90, 0xca, 0xf4, 0x8d, 0x6b,
-	0x65, 0x7a, 0x65, 0xb1, 0x4c, 0xaf, 0x5e, 0x3f, 0xd3, 0xb9, 0xff, 0x43, 0xa6, 0xd7, 0x26, 0x67,
-	0x3a, 0x6b, 0x2e, 0x57, 0xff, 0xa5, 0xc1, 0x7b, 0x62, 0x2f, 0x89, 0x13, 0x11, 0xe7, 0xf9, 0x68,
-	0x74, 0xf9, 0xf8, 0x41, 0x66, 0x1c, 0xb3, 0x78, 0xe7, 0x5c, 0x3b, 0xae, 0xd3, 0x95, 0x73, 0x6e,
-	0x25, 0x7f, 0xd5, 0xe0, 0xfd, 0x11, 0x0b, 0xd5, 0x3e, 0xf2, 0x33, 0x28, 0x8a, 0x55, 0xde, 0x0a,
-	0x09, 0x8d, 0xbc, 0xd8, 0xc7, 0xe9, 0x68, 0x5c, 0x10, 0x1c, 0xa6, 0x60, 0x40, 0x0d, 0x28, 0xc7,
-	0x02, 0x7e, 0x43, 0x6c, 0x46, 0x9c, 0xa9, 0x2b, 0xa0, 0x5c, 0xfd, 0x14, 0xa5, 0x59, 0xba, 0x18,
-	0x3e, 0x56, 0xff, 0xab, 0xc1, 0x9e, 0x34, 0xcc, 0x11, 0x74, 0xdc, 0xdf, 0xa3, 0xa0, 0xd3, 0xf5,
-	0x08, 0x27, 0x56, 0xa1, 0x7c, 0x31, 0x9a, 0x8f, 0x07, 0x99, 0x8a, 0x66, 0xc9, 0xf9, 0x0e, 0x72,
-	0x73, 0x07, 0x72, 0x82, 0x57, 0xa1, 0x65, 0xde, 0x5c, 0xe5, 0xc7, 0x86, 0x53, 0xfd, 0x00, 0xee,
-	0x4d, 0x31, 0x4f, 0x15, 0xe4, 0xbf, 0x35, 0xb8, 0x7b, 0x84, 0x7d, 0x9b, 0x78, 0x2f, 0x22, 0x46,
-	0x19, 0xf6, 0x1d, 0xd7, 0x3f, 0xe3, 0x9b, 0xe5, 0x5c, 0x00, 0x94, 0x5a, 0x65, 0x97, 0x46, 0x56,
-	0xd9, 0x67, 0x50, 0x4e, 0x9c, 0x1a, 0x7c, 0x60, 0x97, 0x27, 0x0c, 0xcf, 0xd8, 0x33, 0x39, 0x3c,
-	0xd9, 0xd0, 0xe9, 0x3a, 0x28, 0x53, 0xdd, 0x85, 0xed, 0x09, 0xee, 0xa9, 0x00, 0xfc, 0x16, 0xee,
-	0x1c, 0x13, 0x6a, 0x87, 0x6e, 0x8b, 0x24, 0xec, 0xca, 0xf5, 0x93, 0xd1, 0x1a, 0xf8, 0x28, 0x53,
-	0xeb, 0x04, 0xf6, 0xf9, 0x52, 0x5f, 0xfd, 0x9b, 0x06, 0xfa, 0xb8, 0x04, 0xd5, 0x36, 0x9f, 0x42,
-	0x4e, 0x86, 0x93, 0xea, 0x9a, 0xf8, 0xde, 0xda, 0x9d, 0xf8, 0x49, 0x42, 0x42, 0xf1, 0x91, 0x1b,
-	0xd3, 0xa3, 0xe7, 0xb0, 0x3e, 0x88, 0x3e, 0x65, 0x98, 0x45, 0x54, 0xb5, 0xcc, 0x07, 0x53, 0x63,
-	0xf7, 0x4a, 0x90, 0x9a, 0x65, 0x96, 0x3a, 0x57, 0x29, 0x6c, 0x8b, 0x7c, 0xa8, 0xdb, 0x97, 0x38,
-	0x64, 0x2e, 0xc7, 0x59, 0x1a, 0x07, 0x6b, 0x03, 0x56, 0xd5, 0x62, 0x23, 0x8b, 0x44, 0x9d, 0xd2,
-	0xc9, 0x5b, 0x5a, 0x2c, 0x79, 0x7f, 0x5c, 0x82, 0x9d, 0x49, 0x5a, 0x55, 0x84, 0x2e, 0x60, 0x7b,
-	0xf0, 0x45, 0x91, 0xf8, 0xdb, 0x4d, 0x08, 0x55, 0xdc, 0x8c, 0xa9, 0x2a, 0x13, 0xb9, 0xcf, 0x09,
-	0xc3, 0x0e, 0x66, 0xd8, 0xac, 0xe0, 0x21, 0xf4, 0x4e, 0xab, 0xe6, 0x2a, 0x93, 0xff, 0x46, 0x64,
-	0xaa, 0x5c, 0xba, 0x9a, 0x4a, 0x67, 0x68, 0x35, 0x48, 0xab, 0xac, 0x3e, 0x80, 0xad, 0x67, 0x24,
-	0x09, 0x03, 0x7d, 0xd2, 0x97, 0x13, 0x78, 0x46, 0xec, 0xab, 0x27, 0x70, 0x37, 0x9b, 0x4d, 0x05,
-	0xef, 0x3e, 0xdc, 0x1c, 0x38, 0xe0, 0xe3, 0x0e, 0x91, 0xe1, 0xca, 0x9b, 0xa5, 0x38, 0x05, 0x5f,
-	0xf0, 0xcb, 0xfa, 0xdf, 0x01, 0x0a, 0xcf, 0xd5, 0xa7, 0xfd, 0xe1, 0xcb, 0x06, 0xfa, 0x9d, 0x06,
-	0xb7, 0x33, 0x3e, 0xef, 0xd1, 0x27, 0x0b, 0xfe, 0x37, 0x40, 0x58, 0x5f, 0x79, 0x70, 0xa5, 0xff,
-	0x21, 0x0c, 0x1b, 0x31, 0x3c, 0x6a, 0xe7, 0x30, 0x22, 0x63, 0xcf, 0x9a, 0xc3, 0x88, 0xcc, 0xef,
-	0xec, 0x1e, 0xdc, 0x1c, 0x59, 0xea, 0xd0, 0x8f, 0x27, 0x4b, 0xca, 0xde, 0xd1, 0x2b, 0x07, 0x0b,
-	0x70, 0xa4, 0xf4, 0xa6, 0xfc, 0x9e, 0xae, 0x37, 0xcb, 0xe7, 0x83, 0x05, 0x38, 0x94, 0xde, 0x2e,
-	0x94, 0x52, 0x03, 0x1e, 0x19, 0x93, 0x65, 0x64, 0xed, 0x2a, 0x95, 0xda, 0xdc, 0xf4, 0x4a, 0xe3,
-	0x9f, 0x35, 0xd8, 0x9c, 0x38, 0xc6, 0xd0, 0xe3, 0xc9, 0xe2, 0x66, 0x8d, 0xe6, 0xca, 0x67, 0x57,
-	0xe2, 0x55, 0x66, 0xfd, 0x49, 0x83, 0xf7, 0x33, 0x07, 0x0b, 0x7a, 0x38, 0x59, 0xec, 0xb4, 0x41,
-	0x5b, 0xf9, 0xc9, 0xc2, 0x7c, 0xca, 0x94, 0x3e, 0xac, 0x8f, 0x0e, 0x10, 0x34, 0x25, 0xb5, 0x13,
-	0xc6, 0x55, 0xa5, 0xbe, 0x08, 0x8b, 0x52, 0xfd, 0xb5, 0x06, 0x1b, 0xd9, 0x00, 0x8d, 0xa6, 0xb8,
-	0x33, 0x75, 0x90, 0x54, 0x1e, 0x2d, 0xce, 0xa8, 0xac, 0xf9, 0x83, 0x06, 0xef, 0x65, 0xe1, 0x1d,
-	0x9a, 0xd2, 0xdc, 0x53, 0x60, 0xb5, 0xf2, 0x70, 0x51, 0x36, 0x69, 0xc7, 0x93, 0x67, 0xdf, 0xbc,
-	0xdd, 0xd1, 0xfe, 0xf9, 0x76, 0x47, 0xfb, 0xcf, 0xdb, 0x1d, 0xed, 0x57, 0x9f, 0x9e, 0xb9, 0xac,
-	0x1d, 0xb5, 0x0c, 0x3b, 0xe8, 0xd4, 0x52, 0xbf, 0xfc, 0x18, 0x67, 0xc4, 0x97, 0xbf, 0x83, 0x0d,
-	0xff, 0x14, 0xf7, 0x59, 0xfc, 0x77, 0xef, 0xa0, 0xb5, 0x2a, 0x5e, 0x3f, 0xfe, 0x5f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xda, 0xde, 0x02, 0x5a, 0xb8, 0x1b, 0x00, 0x00,
+	// 2155 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcb, 0x6f, 0xdb, 0xc8,
+	0xfd, 0x07, 0xed, 0xd8, 0xb2, 0xbe, 0x7a, 0xc4, 0x61, 0x1c, 0x87, 0xa6, 0x63, 0xc7, 0x61, 0x7e,
+	0x9b, 0x9f, 0x5b, 0x6c, 0xa5, 0xb5, 0x76, 0x9d, 0x66, 0xb3, 0x28, 0x0a, 0xc7, 0x8e, 0xb3, 0x02,
+	0x9a, 0xc7, 0xd2, 0x6a, 0x0a, 0x14, 0x45, 0x88, 0x11, 0x39, 0x96, 0x58, 0x4b, 0xa4, 0xc2, 0x19,
+	0xca, 0xab, 0x3d, 0xf4, 0xd0, 0xa2, 0x45, 0x81, 0xbd, 0xf6, 0xd0, 0x73, 0xdb, 0x3f, 0xa2, 0xfd,
+	0x0f, 0xf6, 0xd0, 0x43, 0xef, 0x45, 0x81, 0x22, 0x40, 0xef, 0xfd, 0x13, 0x8a, 0x79, 0x90, 0x12,
+	0x25, 0x8a, 0x96, 0xec, 0xed, 0x2e, 0x7a, 0x13, 0x67, 0xbe, 0xef, 0xd7, 0x7c, 0x66, 0x6c, 0x78,
+	0x10, 0x36, 0x71, 0x50, 0xb5, 0x91, 0x83, 0x3d, 0x1b, 0x57, 0xbb, 0x88, 0xda, 0x6d, 0xd7, 0x6b,
+	0x55, 0xfb, 0x7b, 0x55, 0x82, 0x83, 0xbe, 0x6b, 0xe3, 0x4a, 0x2f, 0xf0, 0xa9, 0xaf, 0x6a, 0x8c,
+	0xae, 0x22, 0xe9, 0x2a, 0x11, 0x5d, 0xa5, 0xbf, 0xa7, 0x6f, 0xb7, 0x7c, 0xbf, 0xd5, 0xc1, 0x55,
+	0x4e, 0xd7, 0x0c, 0x4f, 0xab, 0x4e, 0x18, 0x20, 0xea, 0xfa, 0x9e, 0xe0, 0xd4, 0xef, 0x8e, 0xef,
+	0x53, 0xb7, 0x8b, 0x09, 0x45, 0xdd, 0x9e, 0x24, 0x98, 0x10, 0x70, 0x1e, 0xa0, 0x5e, 0x0f, 0x07,
+	0x44, 0xee, 0xef, 0x24, 0x4c, 0x44, 0x3d, 0x97, 0x59, 0x67, 0xfb, 0xdd, 0xee, 0x50, 0x45, 0x1a,
+	0xc5, 0xdb, 0x10, 0x07, 0x03, 0x49, 0x60, 0xa4, 0x11, 0x50, 0x44, 0xce, 0x3a, 0x2e, 0xa1, 0x92,
+	0x66, 0x37, 0x8d, 0x46, 0x06, 0xc1, 0x3a, 0xf7, 0x83, 0x33, 0x1c, 0x48, 0xca, 0xef, 0x5e, 0x44,
+	0x79, 0xda, 0xf1, 0xcf, 0x25, 0xed, 0xff, 0x25, 0x68, 0x49, 0x1b, 0x05, 0xd8, 0x61, 0xe4, 0x6d,
+	0x97, 0x50, 0x3f, 0xb6, 0xef, 0xbd, 0x29, 0x54, 0x49, 0x13, 0x8d, 0xaf, 0x14, 0xd0, 0x5f, 0xf9,
+	0x9d, 0xce, 0xb1, 0x1f, 0x1c, 0x61, 0xdb, 0x25, 0xae, 0xef, 0x35, 0x10, 0x39, 0x33, 0xf1, 0xdb,
+	0x10, 0x13, 0xaa, 0xd6, 0x21, 0x17, 0x88, 0x9f, 0x9a, 0xb2, 0xa3, 0xec, 0x16, 0x6a, 0xd5, 0x4a,
+	0x22, 0x6b, 0xa8, 0xe7, 0x56, 0xfa, 0x7b, 0x95, 0xe9, 0x12, 0xcc, 0x88, 0x5f, 0xdd, 0x84, 0xbc,
+	0xe3, 0x77, 0x91, 0xeb, 0x59, 0xae, 0xa3, 0x2d, 0xec, 0x28, 0xbb, 0x79, 0x73, 0x45, 0x2c, 0xd4,
+	0x1d, 0xb6, 0xd9, 0xf3, 0x3b, 0x1d, 0x1c, 0xb0, 0xcd, 0x45, 0xb1, 0x29, 0x16, 0xea, 0x8e, 0xfa,
+	0x1e, 0x94, 0x4f, 0xfd, 0xe0, 0x1c, 0x05, 0x0e, 0x76, 0xac, 0xd3, 0xc0, 0xef, 0x6a, 0xd7, 0x38,
+	0x45, 0x29, 0x5e, 0x3d, 0x0e, 0xfc, 0xae, 0xf1, 0x2b, 0x80, 0xcd, 0x54, 0x43, 0x48, 0xcf, 0xf7,
+	0x08, 0x56, 0xb7, 0x00, 0x98, 0xf3, 0x16, 0xf5, 0xcf, 0xb0, 0xc7, 0xdd, 0x29, 0x9a, 0x79, 0xb6,
+	0xd2, 0x60, 0x0b, 0xea, 0x8f, 0x41, 0x8d, 0x02, 0x6d, 0xe1, 0xcf, 0xb1, 0x1d, 0xb2, 0x82, 0xe3,
+	0x86, 0x16, 0x6a, 0x0f, 0x52, 0xbd, 0xfe, 0x89, 0x24, 0x7f, 0x1a, 0x51, 0x9b, 0x37, 0xce, 0xc7,
+	0x97, 0xd4, 0x63, 0x28, 0xc5, 0x62, 0xe9, 0xa0, 0x87, 0xb9, 0x77, 0x85, 0xda, 0xbd, 0x4c, 0x89,
+	0x8d, 0x41, 0x0f, 0x9b, 0xc5, 0xf3, 0x91, 0x2f, 0xf5, 0x35, 0x6c, 0xf4, 0x02, 0xdc, 0x77, 0xfd,
+	0x90, 0x58, 0x84, 0xa2, 0x80, 0x62, 0xc7, 0xc2, 0x7d, 0xec, 0x51, 0x16, 0xb1, 0x6b, 0x5c, 0xe6,
+	0x66, 0x45, 0x94, 0x7d, 0x25, 0x2a, 0xfb, 0x4a, 0xdd, 0xa3, 0x0f, 0x3f, 0x7a, 0x8d, 0x3a, 0x21,
+	0x36, 0xd7, 0x23, 0xee, 0x13, 0xc1, 0xfc, 0x94, 0xf1, 0xd6, 0x1d, 0x75, 0x17, 0x56, 0x27, 0xc4,
+	0x2d, 0xed, 0x28, 0xbb, 0x8b, 0x66, 0x99, 0x24, 0x29, 0x35, 0xc8, 0x21, 0x4a, 0x71, 0xb7, 0x47,
+	0xb5, 0xe5, 0x1d, 0x65, 0x77, 0xc9, 0x8c, 0x3e, 0x55, 0x03, 0x4a, 0x1e, 0xfe, 0x9c, 0x0e, 0x05,
+	0xe4, 0xb8, 0x80, 0x02, 0x5b, 0x8c, 0xb8, 0xdf, 0x07, 0xb5, 0x89, 0xec, 0xb3, 0x8e, 0xdf, 0xb2,
+	0x6c, 0x3f, 0xf4, 0xa8, 0xd5, 0x76, 0x3d, 0xaa, 0xad, 0x70, 0xc2, 0x55, 0xb9, 0x73, 0xc8, 0x36,
+	0x3e, 0x75, 0x3d, 0xaa, 0x3e, 0x02, 0x8d, 0x50, 0xd7, 0x3e, 0x1b, 0x0c, 0x53, 0x61, 0x61, 0x0f,
+	0x35, 0x3b, 0xd8, 0xd1, 0xf2, 0x3b, 0xca, 0xee, 0x8a, 0xb9, 0x2e, 0xf6, 0xe3, 0x40, 0x3f, 0x15,
+	0xbb, 0xea, 0x23, 0x58, 0xe2, 0x6d, 0xaa, 0x01, 0x8f, 0x89, 0x91, 0x19, 0xe7, 0xcf, 0x18, 0xa5,
+	0x29, 0x18, 0x54, 0x13, 0x4a, 0x8e, 0xac, 0x1b, 0xcb, 0xf5, 0x4e, 0x7d, 0xad, 0xc0, 0x25, 0x7c,
+	0x2f, 0x29, 0x41, 0x74, 0x12, 0x13, 0xd2, 0x08, 0x90, 0x47, 0x5c, 0xec, 0xd1, 0xa8, 0xda, 0xea,
+	0xde, 0xa9, 0x6f, 0x16, 0x9d, 0x91, 0x2f, 0xf5, 0x0d, 0xdc, 0x99, 0x2c, 0x2a, 0x8b, 0x97, 0x21,
+	0x6b, 0x42, 0xad, 0xc8, 0x55, 0x6c, 0xa5, 0x1a, 0xc9, 0x8a, 0xf7, 0x47, 0x2e, 0xa1, 0xe6, 0xc6,
+	0x44, 0x55, 0x45, 0x5b, 0x6a, 0x05, 0x6e, 0x8a, 0xa0, 0xb3, 0xd6, 0xc7, 0x56, 0x1f, 0x07, 0x4c,
+	0xb5, 0x56, 0xe2, 0xf9, 0xb9, 0xc1, 0xb7, 0x4e, 0xd8, 0xce, 0x6b, 0xb1, 0xa1, 0xde, 0x83, 0x62,
+	0x33, 0x40, 0x9e, 0xdd, 0x96, 0x5d, 0x50, 0xe6, 0x5d, 0x50, 0x10, 0x6b, 0xa2, 0x0f, 0x0e, 0xa0,
+	0x4c, 0xec, 0x36, 0x76, 0xc2, 0x0e, 0x76, 0x2c, 0x36, 0x58, 0xb5, 0xeb, 0xdc, 0x48, 0x7d, 0xa2,
+	0xba, 0x1a, 0xd1, 0xd4, 0x35, 0x4b, 0x31, 0x07, 0x5b, 0x53, 0x7f, 0x00, 0xc5, 0xa8, 0xa6, 0xb8,
+	0x80, 0xd5, 0x0b, 0x05, 0x14, 0x24, 0x3d, 0x67, 0xff, 0x19, 0xe4, 0x58, 0x46, 0x5c, 0x4c, 0xb4,
+	0x1b, 0x3b, 0x8b, 0xbb, 0x85, 0xda, 0x93, 0xca, 0xb4, 0xa3, 0xa2, 0x92, 0xd1, 0xf0, 0x95, 0xcf,
+	0x84, 0x90, 0xa7, 0x1e, 0x0d, 0x06, 0x66, 0x24, 0x92, 0x85, 0x40, 0x4e, 0x4a, 0x8b, 0xb8, 0x5f,
+	0x60, 0x4d, 0x15, 0xb5, 0x2a, 0xd7, 0x4e, 0xdc, 0x2f, 0xb0, 0x7a, 0x1f, 0x4a, 0x11, 0x09, 0xaf,
+	0x55, 0xed, 0x26, 0xa7, 0x89, 0xf8, 0x78, 0x99, 0xaa, 0xfb, 0x70, 0x9b, 0x84, 0xad, 0x16, 0x26,
+	0xd4, 0xb2, 0x7d, 0x8f, 0xba, 0x5e, 0x88, 0x2d, 0x44, 0x2c, 0x0f, 0x9f, 0x6b, 0x6b, 0xbc, 0x42,
+	0xd7, 0xe4, 0xf6, 0xa1, 0xdc, 0x3d, 0x20, 0x2f, 0xf0, 0xb9, 0xfe, 0x06, 0x8a, 0xa3, 0x76, 0xa9,
+	0xab, 0xb0, 0x78, 0x86, 0x07, 0x7c, 0x1c, 0xe5, 0x4d, 0xf6, 0x93, 0x55, 0x70, 0x9f, 0xb5, 0xac,
+	0xb6, 0x30, 0x7b, 0x05, 0x73, 0x86, 0xc7, 0x0b, 0x8f, 0x94, 0xd1, 0x81, 0x7e, 0x60, 0x53, 0xb7,
+	0xef, 0xd2, 0xc1, 0xe5, 0x07, 0x7a, 0x8a, 0x84, 0x6f, 0x70, 0xa0, 0x7f, 0xb9, 0x02, 0x9b, 0xa9,
+	0x86, 0x7c, 0xab, 0x03, 0xfd, 0x2e, 0x14, 0x90, 0xb4, 0x66, 0xe8, 0x1b, 0x44, 0x4b, 0x75, 0x87,
+	0x4d, 0xfc, 0x98, 0x80, 0x4f, 0xfc, 0x6b, 0x19, 0x13, 0x3f, 0x76, 0x8c, 0x4f, 0x7c, 0x34, 0xf2,
+	0xa5, 0xd6, 0x60, 0xc9, 0xf5, 0x7a, 0x21, 0xe5, 0xe3, 0xb8, 0x50, 0xbb, 0x93, 0x9e, 0x28, 0x34,
+	0xe8, 0xf8, 0xc8, 0x31, 0x05, 0x69, 0x4a, 0xf3, 0x2e, 0x5f, 0xb5, 0x79, 0x73, 0xf3, 0x35, 0x6f,
+	0x03, 0x36, 0x22, 0x79, 0x16, 0xf5, 0x2d, 0xbb, 0xe3, 0x13, 0xcc, 0x05, 0xf9, 0xa1, 0x18, 0xf7,
+	0x85, 0xda, 0xc6, 0x84, 0xac, 0x23, 0x89, 0xef, 0xcc, 0xf5, 0x88, 0xb7, 0xe1, 0x1f, 0x32, 0xce,
+	0x86, 0x60, 0x54, 0x5f, 0xc0, 0x3a, 0x57, 0x32, 0x29, 0x32, 0x7f, 0x91, 0xc8, 0x9b, 0x9c, 0x71,
+	0x4c, 0xde, 0x31, 0xdc, 0x68, 0x63, 0x14, 0xd0, 0x26, 0x46, 0x34, 0x16, 0x05, 0x17, 0x89, 0x5a,
+	0x8d, 0x79, 0x22, 0x39, 0x23, 0x67, 0x62, 0x21, 0x79, 0x26, 0xbe, 0x81, 0xed, 0x64, 0x26, 0x2c,
+	0xff, 0xd4, 0xa2, 0x6d, 0x97, 0x58, 0x11, 0x43, 0xf1, 0xc2, 0xc0, 0xea, 0x89, 0xcc, 0xbc, 0x3c,
+	0x6d, 0xb4, 0x5d, 0x72, 0x20, 0xe5, 0xd7, 0x47, 0x3d, 0x70, 0x30, 0x45, 0x6e, 0x87, 0x68, 0xa5,
+	0x19, 0x2a, 0x65, 0xe8, 0xc4, 0x91, 0xe0, 0x9a, 0x84, 0x28, 0xe5, 0xcb, 0x41, 0x94, 0xff, 0x87,
+	0xeb, 0xb1, 0x1c, 0x31, 0x08, 0xf8, 0xd1, 0x91, 0x37, 0xcb, 0xd1, 0xf2, 0x11, 0x5f, 0x55, 0x3f,
+	0x84, 0xe5, 0x36, 0x46, 0x0e, 0x0e, 0xe4, 0xc9, 0xb0, 0x99, 0xaa, 0xe9, 0x53, 0x4e, 0x62, 0x4a,
+	0x52, 0xe3, 0x0f, 0x8b, 0xb0, 0x7e, 0xe0, 0x38, 0x69, 0x28, 0x35, 0x31, 0x89, 0x94, 0xb1, 0x49,
+	0xf4, 0x5f, 0x1a, 0x03, 0x8f, 0x21, 0x3f, 0x3c, 0xc6, 0x17, 0x67, 0x39, 0xc6, 0x57, 0xa8, 0xfc,
+	0xc5, 0x46, 0x48, 0xdc, 0x23, 0x12, 0xbd, 0x2d, 0x9a, 0x10, 0x2d, 0xd5, 0x9d, 0xf1, 0x26, 0x92,
+	0xa5, 0x2f, 0xcb, 0x74, 0x69, 0x8e, 0x26, 0xe2, 0x60, 0x2f, 0x2a, 0xd6, 0xc7, 0xb0, 0x4c, 0xfc,
+	0x30, 0xb0, 0xc5, 0x50, 0x28, 0xd7, 0x8c, 0xa9, 0xc8, 0x06, 0x91, 0xb3, 0x13, 0x4e, 0x69, 0x4a,
+	0x8e, 0x94, 0x91, 0x9d, 0x4b, 0x1b, 0xd9, 0x1b, 0x70, 0x7b, 0x22, 0x47, 0x62, 0x5a, 0x1b, 0x7f,
+	0x15, 0xf9, 0x4b, 0x3b, 0x94, 0xbe, 0x8d, 0xfc, 0x31, 0xdc, 0xcb, 0x5d, 0xb3, 0x86, 0xaa, 0xc5,
+	0x2c, 0x2f, 0x8b, 0xf5, 0xa3, 0xc8, 0x80, 0x44, 0xa6, 0xaf, 0x5d, 0x29, 0xd3, 0x4b, 0xf3, 0x65,
+	0x7a, 0xf9, 0xea, 0x99, 0xce, 0x7d, 0x0d, 0x99, 0x5e, 0x99, 0x9e, 0xe9, 0xb4, 0x73, 0xd9, 0xf8,
+	0xbb, 0x02, 0x6b, 0x1c, 0x97, 0x44, 0x89, 0x88, 0xf2, 0x7c, 0x38, 0x0e, 0x3e, 0xbe, 0x93, 0x1a,
+	0xc7, 0x34, 0xde, 0x19, 0x61, 0xc7, 0x55, 0xba, 0x72, 0x46, 0x54, 0xf2, 0x47, 0x05, 0x6e, 0x8d,
+	0x59, 0x28, 0xf1, 0xc8, 0x0f, 0xa1, 0xc8, 0x6f, 0x12, 0x56, 0x80, 0x49, 0xd8, 0x89, 0x7c, 0xcc,
+	0x9e, 0xc6, 0x05, 0xce, 0x61, 0x72, 0x06, 0xb5, 0x0e, 0xe5, 0x48, 0xc0, 0xcf, 0xb1, 0x4d, 0xb1,
+	0x93, 0x09, 0x01, 0x05, 0xf4, 0x93, 0x94, 0x66, 0xe9, 0xed, 0xe8, 0xa7, 0xf1, 0x2f, 0x05, 0x76,
+	0x84, 0x61, 0x0e, 0xa7, 0x63, 0xfe, 0x1e, 0xfa, 0xdd, 0x5e, 0x07, 0x33, 0x62, 0x19, 0xca, 0x97,
+	0xe3, 0xf9, 0xd8, 0x4f, 0x55, 0x74, 0x91, 0x9c, 0x6f, 0x20, 0x37, 0xb7, 0x21, 0xc7, 0x79, 0xe5,
+	0xb4, 0xcc, 0x9b, 0xcb, 0xec, 0xb3, 0xee, 0x18, 0xf7, 0xe1, 0x5e, 0x86, 0x79, 0xb2, 0x20, 0xff,
+	0xa1, 0xc0, 0x9d, 0x43, 0xe4, 0xd9, 0xb8, 0xf3, 0x32, 0xa4, 0x84, 0x22, 0xcf, 0x71, 0xbd, 0x16,
+	0x43, 0x96, 0x33, 0x0d, 0xa0, 0x04, 0x94, 0x5d, 0x18, 0x83, 0xb2, 0xcf, 0xa0, 0x1c, 0x3b, 0x35,
+	0xbc, 0xdf, 0x97, 0xa7, 0x1c, 0x9e, 0x91, 0x67, 0xe2, 0xf0, 0xa4, 0x23, 0x5f, 0x57, 0x99, 0x32,
+	0xc6, 0x5d, 0xd8, 0x9a, 0xe2, 0x9e, 0x0c, 0xc0, 0x2f, 0xe0, 0xf6, 0x11, 0x26, 0x76, 0xe0, 0x36,
+	0x71, 0xcc, 0x2e, 0x5d, 0x3f, 0x1e, 0xaf, 0x81, 0xf7, 0x53, 0xb5, 0x4e, 0x61, 0x9f, 0x2d, 0xf5,
+	0xc6, 0x9f, 0x14, 0xd0, 0x26, 0x25, 0xc8, 0xb6, 0xf9, 0x18, 0x72, 0x22, 0x9c, 0x44, 0x53, 0xf8,
+	0x75, 0xef, 0xee, 0xd4, 0x2b, 0x09, 0x0e, 0xf8, 0x1d, 0x3b, 0xa2, 0x57, 0x9f, 0xc3, 0xea, 0x30,
+	0xfa, 0x84, 0x22, 0x1a, 0x12, 0xd9, 0x32, 0xf7, 0x33, 0x63, 0x77, 0xc2, 0x49, 0xcd, 0x32, 0x4d,
+	0x7c, 0x1b, 0x04, 0xb6, 0x78, 0x3e, 0xe4, 0xea, 0x2b, 0x14, 0x50, 0x97, 0xcd, 0x59, 0x12, 0x05,
+	0x6b, 0x1d, 0x96, 0x25, 0xb0, 0x11, 0x45, 0x22, 0xbf, 0x92, 0xc9, 0x5b, 0x98, 0x2f, 0x79, 0xbf,
+	0x59, 0x80, 0xed, 0x69, 0x5a, 0x65, 0x84, 0xde, 0xc2, 0xd6, 0xf0, 0x46, 0x11, 0xfb, 0xdb, 0x8b,
+	0x09, 0x65, 0xdc, 0x2a, 0x99, 0x2a, 0x63, 0xb9, 0xcf, 0x31, 0x45, 0x0e, 0xa2, 0xc8, 0xd4, 0xd1,
+	0xc8, 0xf4, 0x4e, 0xaa, 0x66, 0x2a, 0xe3, 0xc7, 0x90, 0x54, 0x95, 0x0b, 0x97, 0x53, 0xe9, 0x8c,
+	0x40, 0x83, 0xa4, 0x4a, 0x63, 0x1f, 0x36, 0x9f, 0xe1, 0x38, 0x0c, 0xe4, 0xc9, 0x40, 0x9c, 0xc0,
+	0x17, 0xc4, 0xde, 0x38, 0x86, 0x3b, 0xe9, 0x6c, 0x32, 0x78, 0x0f, 0xe0, 0xfa, 0xd0, 0x01, 0x0f,
+	0x75, 0xb1, 0x08, 0x57, 0xde, 0x2c, 0x45, 0x29, 0x78, 0xc1, 0x16, 0x8d, 0x3f, 0x2b, 0xb0, 0xf6,
+	0x0a, 0x85, 0x64, 0xa2, 0x43, 0x32, 0x87, 0xc3, 0x15, 0x32, 0xff, 0xb5, 0xcd, 0x0e, 0xe3, 0x36,
+	0xdc, 0x1a, 0xb3, 0x5c, 0xf6, 0xfd, 0x5f, 0x14, 0xb8, 0xc5, 0xce, 0x96, 0xee, 0xff, 0xa0, 0x53,
+	0x1a, 0xac, 0x8f, 0x9b, 0x2e, 0xbd, 0xfa, 0xf7, 0x02, 0xe8, 0xcf, 0xfd, 0x7e, 0xbc, 0xf1, 0x44,
+	0xbc, 0x1e, 0xce, 0xe4, 0xda, 0xb3, 0x18, 0xf6, 0xcd, 0xe9, 0xa1, 0x44, 0x85, 0x8d, 0xa1, 0x9f,
+	0xab, 0x14, 0x05, 0x2d, 0x4c, 0xad, 0x39, 0x0f, 0xb5, 0xb2, 0x60, 0x6b, 0x4c, 0x0f, 0xd8, 0xb5,
+	0xcb, 0x9d, 0x20, 0x5b, 0x00, 0x4d, 0xf6, 0x32, 0x26, 0x9e, 0xb5, 0x96, 0xf8, 0x75, 0x34, 0xcf,
+	0x57, 0xf8, 0xa3, 0x96, 0x01, 0x25, 0x74, 0x4a, 0x71, 0x60, 0x45, 0x07, 0xe9, 0xb2, 0x78, 0xf8,
+	0xe2, 0x8b, 0x0d, 0x7e, 0x9a, 0xb2, 0x63, 0xd6, 0x61, 0xf0, 0x23, 0xf4, 0x38, 0x70, 0x5c, 0x31,
+	0x97, 0x9d, 0x60, 0x60, 0x86, 0x9e, 0xd1, 0x83, 0xcd, 0xd4, 0x88, 0xcb, 0x1e, 0x5b, 0x83, 0x25,
+	0xf1, 0x50, 0xa6, 0x70, 0x99, 0xe2, 0x43, 0xdd, 0x81, 0x62, 0x07, 0x11, 0x1a, 0x2b, 0x5c, 0xe0,
+	0x9b, 0xc0, 0xd6, 0xa4, 0xbe, 0x0d, 0x58, 0x69, 0x23, 0x62, 0x75, 0xfd, 0x40, 0x94, 0xc9, 0x8a,
+	0x99, 0x6b, 0x23, 0xf2, 0xdc, 0x0f, 0x70, 0xed, 0xf7, 0x25, 0x28, 0x3c, 0x97, 0x0f, 0x7d, 0x07,
+	0xaf, 0xea, 0xea, 0x2f, 0x15, 0xb8, 0x99, 0xf2, 0xd8, 0xa7, 0x7e, 0x34, 0xe7, 0xdb, 0x20, 0xaf,
+	0x11, 0x7d, 0xff, 0x52, 0x2f, 0x8a, 0xa3, 0x46, 0x8c, 0x22, 0xdf, 0x19, 0x8c, 0x48, 0xb9, 0xf6,
+	0xe8, 0xfb, 0x73, 0x72, 0x49, 0x23, 0xfa, 0x70, 0x7d, 0xec, 0x8e, 0xa5, 0x7e, 0x30, 0x5d, 0x52,
+	0xfa, 0x95, 0x59, 0xdf, 0x9b, 0x83, 0x23, 0xa1, 0x37, 0xe1, 0x77, 0xb6, 0xde, 0x34, 0x9f, 0xf7,
+	0xe6, 0xe0, 0x90, 0x7a, 0x7b, 0x50, 0x4a, 0xe0, 0x6d, 0xb5, 0x32, 0x5d, 0x46, 0xda, 0xd5, 0x41,
+	0xaf, 0xce, 0x4c, 0x2f, 0x35, 0xfe, 0x4e, 0x81, 0x8d, 0xa9, 0xa8, 0x52, 0x7d, 0x3c, 0x5d, 0xdc,
+	0x45, 0x48, 0x59, 0xff, 0xe4, 0x52, 0xbc, 0xd2, 0xac, 0xdf, 0x2a, 0x70, 0x2b, 0x15, 0xe7, 0xa9,
+	0x0f, 0xa7, 0x8b, 0xcd, 0xc2, 0xbd, 0xfa, 0xf7, 0xe7, 0xe6, 0x93, 0xa6, 0x0c, 0x60, 0x75, 0x1c,
+	0xcf, 0xa9, 0x19, 0xa9, 0x9d, 0x82, 0x1e, 0xf5, 0xda, 0x3c, 0x2c, 0x52, 0xf5, 0x97, 0x0a, 0xac,
+	0xa7, 0xe3, 0x25, 0x35, 0xc3, 0x9d, 0x4c, 0x5c, 0xa7, 0x3f, 0x9a, 0x9f, 0x51, 0x5a, 0xf3, 0x6b,
+	0x05, 0xd6, 0xd2, 0xe0, 0x87, 0x9a, 0xd1, 0xdc, 0x19, 0x28, 0x47, 0x7f, 0x38, 0x2f, 0xdb, 0xb0,
+	0x49, 0x12, 0x10, 0x20, 0xab, 0x49, 0xd2, 0x50, 0x8e, 0x5e, 0x9d, 0x99, 0x5e, 0x6a, 0x24, 0x50,
+	0x4e, 0x9e, 0xcf, 0x6a, 0x35, 0xb3, 0xb8, 0x27, 0x41, 0x88, 0xfe, 0xc1, 0xec, 0x0c, 0x23, 0x03,
+	0x38, 0xe5, 0x20, 0xca, 0x1a, 0xc0, 0xd3, 0x91, 0x82, 0xbe, 0x3f, 0x27, 0x97, 0x30, 0xe2, 0xc9,
+	0xb3, 0xaf, 0xde, 0x6d, 0x2b, 0x7f, 0x7b, 0xb7, 0xad, 0xfc, 0xf3, 0xdd, 0xb6, 0xf2, 0xd3, 0x8f,
+	0x5b, 0x2e, 0x6d, 0x87, 0xcd, 0x8a, 0xed, 0x77, 0xab, 0x89, 0xbf, 0xb9, 0x57, 0x5a, 0xd8, 0x13,
+	0xff, 0x81, 0x30, 0xfa, 0x4f, 0x10, 0x9f, 0x44, 0xbf, 0xfb, 0x7b, 0xcd, 0x65, 0xbe, 0xfb, 0xe1,
+	0x7f, 0x06, 0x00, 0x60, 0x3d, 0x5c, 0xae, 0x32, 0x21, 0x00, 0x00,
 }
 
 func (m *PollForDecisionTaskRequest) Marshal() (dAtA []byte, err error) {
@@ -1701,10 +2114,36 @@ func (m *PollForDecisionTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Queries) > 0 {
-		for k := range m.Queries {
-			v := m.Queries[k]
-			baseI := i
+	if m.SuggestContinueAsNew {
+		i--
+		if m.SuggestContinueAsNew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.HistoryCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.HistoryCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.HistorySize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.HistorySize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.Queries) > 0 {
+		for k := range m.Queries {
+			v := m.Queries[k]
+			baseI := i
 			if v != nil {
 				{
 					size, err := v.MarshalToSizedBuffer(dAtA[:i])
@@ -2936,224 +3375,324 @@ func (m *GetTaskListsByDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PauseTaskListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PollForDecisionTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PauseTaskListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.PollerId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.TaskListType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ForwardedFrom)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PollForDecisionTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PauseTaskListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseTaskListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TaskToken)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.PreviousStartedEventId != nil {
-		l = m.PreviousStartedEventId.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.StartedEventId != 0 {
-		n += 1 + sovService(uint64(m.StartedEventId))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovService(uint64(m.Attempt))
-	}
-	if m.NextEventId != 0 {
-		n += 1 + sovService(uint64(m.NextEventId))
-	}
-	if m.BacklogCountHint != 0 {
-		n += 1 + sovService(uint64(m.BacklogCountHint))
-	}
-	if m.StickyExecutionEnabled {
-		n += 2
-	}
-	if m.Query != nil {
-		l = m.Query.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.DecisionInfo != nil {
-		l = m.DecisionInfo.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowExecutionTaskList != nil {
-		l = m.WorkflowExecutionTaskList.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.EventStoreVersion != 0 {
-		n += 1 + sovService(uint64(m.EventStoreVersion))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.BranchToken)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ResumeTaskListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ScheduledTime != nil {
-		l = m.ScheduledTime.Size()
-		n += 1 + l + sovService(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ResumeTaskListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResumeTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.StartedTime != nil {
-		l = m.StartedTime.Size()
-		n += 2 + l + sovService(uint64(l))
+	if m.TaskListType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Queries) > 0 {
-		for k, v := range m.Queries {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovService(uint64(l))
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + l
-			n += mapEntrySize + 2 + sovService(uint64(mapEntrySize))
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PollForActivityTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ResumeTaskListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeTaskListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResumeTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.PollerId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.ForwardedFrom)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PollForActivityTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MoveTaskListBacklogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MoveTaskListBacklogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveTaskListBacklogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TaskToken)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.ActivityId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.AfterTaskId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.AfterTaskId))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.ActivityType != nil {
-		l = m.ActivityType.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.BatchSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.TaskListType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.ScheduledTime != nil {
-		l = m.ScheduledTime.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.TargetTaskList != nil {
+		{
+			size, err := m.TargetTaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.StartedTime != nil {
-		l = m.StartedTime.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.SourceTaskList != nil {
+		{
+			size, err := m.SourceTaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ScheduleToCloseTimeout != nil {
-		l = m.ScheduleToCloseTimeout.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.StartToCloseTimeout != nil {
-		l = m.StartToCloseTimeout.Size()
-		n += 1 + l + sovService(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MoveTaskListBacklogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.HeartbeatTimeout != nil {
-		l = m.HeartbeatTimeout.Size()
-		n += 1 + l + sovService(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MoveTaskListBacklogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveTaskListBacklogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Attempt != 0 {
-		n += 1 + sovService(uint64(m.Attempt))
+	if m.HasMore {
+		i--
+		if m.HasMore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.ScheduledTimeOfThisAttempt != nil {
-		l = m.ScheduledTimeOfThisAttempt.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.LastTaskId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.LastTaskId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.HeartbeatDetails != nil {
-		l = m.HeartbeatDetails.Size()
+	if m.Count != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PollForDecisionTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
+	l = len(m.DomainId)
+	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	l = len(m.WorkflowDomain)
+	l = len(m.PollerId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 2 + l + sovService(uint64(l))
+	l = len(m.ForwardedFrom)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -3161,13 +3700,13 @@ func (m *PollForActivityTaskResponse) Size() (n int) {
 	return n
 }
 
-func (m *AddDecisionTaskRequest) Size() (n int) {
+func (m *PollForDecisionTaskResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DomainId)
+	l = len(m.TaskToken)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
@@ -3175,49 +3714,119 @@ func (m *AddDecisionTaskRequest) Size() (n int) {
 		l = m.WorkflowExecution.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.TaskList != nil {
-		l = m.TaskList.Size()
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.ScheduleId != 0 {
-		n += 1 + sovService(uint64(m.ScheduleId))
+	if m.PreviousStartedEventId != nil {
+		l = m.PreviousStartedEventId.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	if m.ScheduleToStartTimeout != nil {
-		l = m.ScheduleToStartTimeout.Size()
+	if m.StartedEventId != 0 {
+		n += 1 + sovService(uint64(m.StartedEventId))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovService(uint64(m.Attempt))
+	}
+	if m.NextEventId != 0 {
+		n += 1 + sovService(uint64(m.NextEventId))
+	}
+	if m.BacklogCountHint != 0 {
+		n += 1 + sovService(uint64(m.BacklogCountHint))
+	}
+	if m.StickyExecutionEnabled {
+		n += 2
+	}
+	if m.Query != nil {
+		l = m.Query.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.Source != 0 {
-		n += 1 + sovService(uint64(m.Source))
+	if m.DecisionInfo != nil {
+		l = m.DecisionInfo.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	l = len(m.ForwardedFrom)
+	if m.WorkflowExecutionTaskList != nil {
+		l = m.WorkflowExecutionTaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.EventStoreVersion != 0 {
+		n += 1 + sovService(uint64(m.EventStoreVersion))
+	}
+	l = len(m.BranchToken)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.ScheduledTime != nil {
+		l = m.ScheduledTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartedTime != nil {
+		l = m.StartedTime.Size()
+		n += 2 + l + sovService(uint64(l))
+	}
+	if len(m.Queries) > 0 {
+		for k, v := range m.Queries {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovService(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.HistorySize != 0 {
+		n += 2 + sovService(uint64(m.HistorySize))
+	}
+	if m.HistoryCount != 0 {
+		n += 2 + sovService(uint64(m.HistoryCount))
+	}
+	if m.SuggestContinueAsNew {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *AddDecisionTaskResponse) Size() (n int) {
+func (m *PollForActivityTaskRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.PollerId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.ForwardedFrom)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *AddActivityTaskRequest) Size() (n int) {
+func (m *PollForActivityTaskResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DomainId)
+	l = len(m.TaskToken)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
@@ -3225,11 +3834,136 @@ func (m *AddActivityTaskRequest) Size() (n int) {
 		l = m.WorkflowExecution.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	l = len(m.SourceDomainId)
+	l = len(m.ActivityId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.TaskList != nil {
+	if m.ActivityType != nil {
+		l = m.ActivityType.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ScheduledTime != nil {
+		l = m.ScheduledTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartedTime != nil {
+		l = m.StartedTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ScheduleToCloseTimeout != nil {
+		l = m.ScheduleToCloseTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartToCloseTimeout != nil {
+		l = m.StartToCloseTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.HeartbeatTimeout != nil {
+		l = m.HeartbeatTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovService(uint64(m.Attempt))
+	}
+	if m.ScheduledTimeOfThisAttempt != nil {
+		l = m.ScheduledTimeOfThisAttempt.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.HeartbeatDetails != nil {
+		l = m.HeartbeatDetails.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.WorkflowDomain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 2 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddDecisionTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ScheduleId != 0 {
+		n += 1 + sovService(uint64(m.ScheduleId))
+	}
+	if m.ScheduleToStartTimeout != nil {
+		l = m.ScheduleToStartTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovService(uint64(m.Source))
+	}
+	l = len(m.ForwardedFrom)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddDecisionTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddActivityTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.SourceDomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
 		l = m.TaskList.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
@@ -3506,19 +4240,1044 @@ func (m *GetTaskListsByDomainResponse) Size() (n int) {
 			n += 1 + l + sovService(uint64(l))
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PauseTaskListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PauseTaskListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResumeTaskListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResumeTaskListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MoveTaskListBacklogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.SourceTaskList != nil {
+		l = m.SourceTaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TargetTaskList != nil {
+		l = m.TargetTaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovService(uint64(m.BatchSize))
+	}
+	if m.AfterTaskId != 0 {
+		n += 1 + sovService(uint64(m.AfterTaskId))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MoveTaskListBacklogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovService(uint64(m.Count))
+	}
+	if m.LastTaskId != 0 {
+		n += 1 + sovService(uint64(m.LastTaskId))
+	}
+	if m.HasMore {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PollForDecisionTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollForDecisionTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollForDecisionTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.PollForDecisionTaskRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PollerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollForDecisionTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollForDecisionTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskToken = append(m.TaskToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskToken == nil {
+				m.TaskToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousStartedEventId == nil {
+				m.PreviousStartedEventId = &types.Int64Value{}
+			}
+			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			}
+			m.NextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCountHint", wireType)
+			}
+			m.BacklogCountHint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BacklogCountHint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StickyExecutionEnabled = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Query == nil {
+				m.Query = &v1.WorkflowQuery{}
+			}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionInfo == nil {
+				m.DecisionInfo = &v11.TransientDecisionInfo{}
+			}
+			if err := m.DecisionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecutionTaskList == nil {
+				m.WorkflowExecutionTaskList = &v1.TaskList{}
+			}
+			if err := m.WorkflowExecutionTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
+			}
+			m.EventStoreVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventStoreVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.BranchToken == nil {
+				m.BranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = &types.Timestamp{}
+			}
+			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = &types.Timestamp{}
+			}
+			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Queries == nil {
+				m.Queries = make(map[string]*v1.WorkflowQuery)
+			}
+			var mapkey string
+			var mapvalue *v1.WorkflowQuery
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v1.WorkflowQuery{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Queries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySize", wireType)
+			}
+			m.HistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistorySize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryCount", wireType)
+			}
+			m.HistoryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuggestContinueAsNew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuggestContinueAsNew = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovService(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozService(x uint64) (n int) {
-	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *PollForDecisionTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *PollForActivityTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3541,10 +5300,10 @@ func (m *PollForDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollForDecisionTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PollForActivityTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollForDecisionTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PollForActivityTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3577,7 +5336,7 @@ func (m *PollForDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.PollForDecisionTaskRequest{}
+				m.Request = &v1.PollForActivityTaskRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3704,7 +5463,7 @@ func (m *PollForDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3727,10 +5486,10 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollForDecisionTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PollForActivityTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollForDecisionTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PollForActivityTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3805,9 +5564,9 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -3817,31 +5576,27 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v1.WorkflowType{}
-			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3868,18 +5623,18 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PreviousStartedEventId == nil {
-				m.PreviousStartedEventId = &types.Int64Value{}
+			if m.ActivityType == nil {
+				m.ActivityType = &v1.ActivityType{}
 			}
-			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ActivityType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-			m.StartedEventId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -3889,16 +5644,33 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartedEventId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &v1.Payload{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
 			}
-			m.Attempt = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -3908,16 +5680,33 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = &types.Timestamp{}
+			}
+			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
 			}
-			m.NextEventId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -3927,53 +5716,31 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCountHint", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthService
 			}
-			m.BacklogCountHint = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BacklogCountHint |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.StartedTime == nil {
+				m.StartedTime = &types.Timestamp{}
 			}
-			m.StickyExecutionEnabled = bool(v != 0)
-		case 10:
+			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToCloseTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4000,16 +5767,16 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Query == nil {
-				m.Query = &v1.WorkflowQuery{}
+			if m.ScheduleToCloseTimeout == nil {
+				m.ScheduleToCloseTimeout = &types.Duration{}
 			}
-			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScheduleToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4036,16 +5803,16 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DecisionInfo == nil {
-				m.DecisionInfo = &v11.TransientDecisionInfo{}
+			if m.StartToCloseTimeout == nil {
+				m.StartToCloseTimeout = &types.Duration{}
 			}
-			if err := m.DecisionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4072,18 +5839,18 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecutionTaskList == nil {
-				m.WorkflowExecutionTaskList = &v1.TaskList{}
+			if m.HeartbeatTimeout == nil {
+				m.HeartbeatTimeout = &types.Duration{}
 			}
-			if err := m.WorkflowExecutionTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HeartbeatTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			m.EventStoreVersion = 0
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -4093,16 +5860,52 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventStoreVersion |= int32(b&0x7F) << shift
+				m.Attempt |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimeOfThisAttempt", wireType)
 			}
-			var byteLen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTimeOfThisAttempt == nil {
+				m.ScheduledTimeOfThisAttempt = &types.Timestamp{}
+			}
+			if err := m.ScheduledTimeOfThisAttempt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -4112,29 +5915,31 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.BranchToken == nil {
-				m.BranchToken = []byte{}
+			if m.HeartbeatDetails == nil {
+				m.HeartbeatDetails = &v1.Payload{}
+			}
+			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4161,18 +5966,18 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = &types.Timestamp{}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
 			}
-			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowDomain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -4182,31 +5987,27 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = &types.Timestamp{}
-			}
-			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkflowDomain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4233,105 +6034,12 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Queries == nil {
-				m.Queries = make(map[string]*v1.WorkflowQuery)
+			if m.Header == nil {
+				m.Header = &v1.Header{}
 			}
-			var mapkey string
-			var mapvalue *v1.WorkflowQuery
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthService
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthService
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v1.WorkflowQuery{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Queries[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4358,7 +6066,7 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollForActivityTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4381,15 +6089,47 @@ func (m *PollForActivityTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollForActivityTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddDecisionTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollForActivityTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddDecisionTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4416,18 +6156,73 @@ func (m *PollForActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.PollForActivityTaskRequest{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -4437,29 +6232,33 @@ func (m *PollForActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = &types.Duration{}
+			}
+			if err := m.ScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			m.Source = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -4469,25 +6268,12 @@ func (m *PollForActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Source |= v11.TaskSource(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PollerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedFrom", wireType)
 			}
@@ -4544,7 +6330,7 @@ func (m *PollForActivityTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *AddDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4567,17 +6353,71 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollForActivityTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddDecisionTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollForActivityTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddDecisionTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddActivityTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddActivityTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -4587,25 +6427,23 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskToken = append(m.TaskToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.TaskToken == nil {
-				m.TaskToken = []byte{}
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4645,7 +6483,7 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4673,11 +6511,11 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			m.SourceDomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4704,18 +6542,18 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActivityType == nil {
-				m.ActivityType = &v1.ActivityType{}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
 			}
-			if err := m.ActivityType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
-			var msglen int
+			m.ScheduleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -4725,31 +6563,14 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ScheduleId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Input == nil {
-				m.Input = &v1.Payload{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4776,18 +6597,37 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = &types.Timestamp{}
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = &types.Duration{}
 			}
-			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= v11.TaskSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedFrom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -4797,31 +6637,135 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddActivityTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddActivityTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = &types.Timestamp{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToCloseTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4848,18 +6792,18 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduleToCloseTimeout == nil {
-				m.ScheduleToCloseTimeout = &types.Duration{}
+			if m.Request == nil {
+				m.Request = &v1.QueryWorkflowRequest{}
 			}
-			if err := m.ScheduleToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -4869,31 +6813,27 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartToCloseTimeout == nil {
-				m.StartToCloseTimeout = &types.Duration{}
-			}
-			if err := m.StartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4920,37 +6860,18 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeartbeatTimeout == nil {
-				m.HeartbeatTimeout = &types.Duration{}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
 			}
-			if err := m.HeartbeatTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
-			}
-			m.Attempt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimeOfThisAttempt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedFrom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -4960,67 +6881,81 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTimeOfThisAttempt == nil {
-				m.ScheduledTimeOfThisAttempt = &types.Timestamp{}
-			}
-			if err := m.ScheduledTimeOfThisAttempt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeartbeatDetails == nil {
-				m.HeartbeatDetails = &v1.Payload{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 14:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryResult", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5047,48 +6982,16 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v1.WorkflowType{}
+			if m.QueryResult == nil {
+				m.QueryResult = &v1.Payload{}
 			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueryResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowDomain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WorkflowDomain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 16:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryRejected", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5115,10 +7018,10 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &v1.Header{}
+			if m.QueryRejected == nil {
+				m.QueryRejected = &v1.QueryRejected{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueryRejected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5147,7 +7050,7 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5170,47 +7073,15 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddDecisionTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddDecisionTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5237,18 +7108,18 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.Request == nil {
+				m.Request = &v1.RespondQueryTaskCompletedRequest{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -5258,50 +7129,27 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
-			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
-			}
-			m.ScheduleId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduleId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5328,35 +7176,16 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduleToStartTimeout == nil {
-				m.ScheduleToStartTimeout = &types.Duration{}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
 			}
-			if err := m.ScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
-			}
-			m.Source = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Source |= v11.TaskSource(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5384,7 +7213,7 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5411,7 +7240,7 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddDecisionTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5434,10 +7263,10 @@ func (m *AddDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddDecisionTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddDecisionTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5465,7 +7294,7 @@ func (m *AddDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5488,10 +7317,10 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddActivityTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelOutstandingPollRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddActivityTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelOutstandingPollRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5528,43 +7357,7 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5585,111 +7378,20 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceDomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
-			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
-			}
-			m.ScheduleId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduleId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduleToStartTimeout == nil {
-				m.ScheduleToStartTimeout = &types.Duration{}
-			}
-			if err := m.ScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PollerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
 			}
-			m.Source = 0
+			m.TaskListType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -5699,16 +7401,16 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Source |= v11.TaskSource(b&0x7F) << shift
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -5718,23 +7420,27 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5761,7 +7467,7 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5784,10 +7490,10 @@ func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddActivityTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelOutstandingPollResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddActivityTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelOutstandingPollResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5815,7 +7521,7 @@ func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5838,10 +7544,10 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeTaskListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5874,7 +7580,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.QueryWorkflowRequest{}
+				m.Request = &v1.DescribeTaskListRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5912,9 +7618,63 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTaskListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pollers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5941,18 +7701,16 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
-			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pollers = append(m.Pollers, &v1.PollerInfo{})
+			if err := m.Pollers[len(m.Pollers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListStatus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -5962,23 +7720,27 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
+			if m.TaskListStatus == nil {
+				m.TaskListStatus = &v1.TaskListStatus{}
+			}
+			if err := m.TaskListStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6005,7 +7767,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
+func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6028,17 +7790,17 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTaskListPartitionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTaskListPartitionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -6048,31 +7810,27 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueryResult == nil {
-				m.QueryResult = &v1.Payload{}
-			}
-			if err := m.QueryResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryRejected", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6099,10 +7857,10 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueryRejected == nil {
-				m.QueryRejected = &v1.QueryRejected{}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
 			}
-			if err := m.QueryRejected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6131,7 +7889,7 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6154,15 +7912,15 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTaskListPartitionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTaskListPartitionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskListPartitions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6189,18 +7947,16 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.RespondQueryTaskCompletedRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActivityTaskListPartitions = append(m.ActivityTaskListPartitions, &v1.TaskListPartitionMetadata{})
+			if err := m.ActivityTaskListPartitions[len(m.ActivityTaskListPartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskListPartitions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -6210,63 +7966,83 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			m.DecisionTaskListPartitions = append(m.DecisionTaskListPartitions, &v1.TaskListPartitionMetadata{})
+			if err := m.DecisionTaskListPartitions[len(m.DecisionTaskListPartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTaskListsByDomainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTaskListsByDomainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTaskListsByDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6294,7 +8070,7 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6321,7 +8097,7 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTaskListsByDomainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6344,12 +8120,44 @@ func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTaskListsByDomainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTaskListsByDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskListNames = append(m.TaskListNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -6375,7 +8183,7 @@ func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
+func (m *PauseTaskListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6398,10 +8206,10 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelOutstandingPollRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseTaskListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelOutstandingPollRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6438,9 +8246,9 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -6450,23 +8258,27 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PollerId = string(dAtA[iNdEx:postIndex])
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
@@ -6487,42 +8299,6 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
-			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -6548,7 +8324,7 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
+func (m *PauseTaskListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6571,10 +8347,10 @@ func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelOutstandingPollResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseTaskListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelOutstandingPollResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6602,7 +8378,7 @@ func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
+func (m *ResumeTaskListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6625,17 +8401,17 @@ func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTaskListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResumeTaskListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResumeTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -6645,33 +8421,29 @@ func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.DescribeTaskListRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -6681,24 +8453,47 @@ func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
+			}
+			m.TaskListType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -6724,7 +8519,7 @@ func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
+func (m *ResumeTaskListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6740,89 +8535,19 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTaskListResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pollers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Pollers = append(m.Pollers, &v1.PollerInfo{})
-			if err := m.Pollers[len(m.Pollers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskListStatus", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TaskListStatus == nil {
-				m.TaskListStatus = &v1.TaskListStatus{}
-			}
-			if err := m.TaskListStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeTaskListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -6848,7 +8573,7 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
+func (m *MoveTaskListBacklogRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6871,15 +8596,15 @@ func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTaskListPartitionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MoveTaskListBacklogRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTaskListPartitionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MoveTaskListBacklogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6907,11 +8632,11 @@ func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceTaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6938,70 +8663,16 @@ func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
+			if m.SourceTaskList == nil {
+				m.SourceTaskList = &v1.TaskList{}
 			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SourceTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListTaskListPartitionsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTaskListPartitionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskListPartitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetTaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7028,16 +8699,18 @@ func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActivityTaskListPartitions = append(m.ActivityTaskListPartitions, &v1.TaskListPartitionMetadata{})
-			if err := m.ActivityTaskListPartitions[len(m.ActivityTaskListPartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TargetTaskList == nil {
+				m.TargetTaskList = &v1.TaskList{}
+			}
+			if err := m.TargetTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskListPartitions", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
 			}
-			var msglen int
+			m.TaskListType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7047,85 +8720,35 @@ func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DecisionTaskListPartitions = append(m.DecisionTaskListPartitions, &v1.TaskListPartitionMetadata{})
-			if err := m.DecisionTaskListPartitions[len(m.DecisionTaskListPartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetTaskListsByDomainRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskListsByDomainRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskListsByDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterTaskId", wireType)
 			}
-			var stringLen uint64
+			m.AfterTaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7135,24 +8758,31 @@ func (m *GetTaskListsByDomainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AfterTaskId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -7178,7 +8808,7 @@ func (m *GetTaskListsByDomainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTaskListsByDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *MoveTaskListBacklogResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7201,17 +8831,17 @@ func (m *GetTaskListsByDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskListsByDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MoveTaskListBacklogResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskListsByDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MoveTaskListBacklogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskListNames", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7221,24 +8851,50 @@ func (m *GetTaskListsByDomainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTaskId", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
+			m.LastTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
 			}
-			m.TaskListNames = append(m.TaskListNames, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMore = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
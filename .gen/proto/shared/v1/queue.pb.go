@@ -187,6 +187,118 @@ func (GetTaskFailedCause) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_8958fa454fc8f819, []int{3}
 }
 
+type HistoryTaskDLQEntry struct {
+	TaskId int64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Category of the task, transfer or timer.
+	Category             TaskType              `protobuf:"varint,2,opt,name=category,proto3,enum=uber.cadence.shared.v1.TaskType" json:"category,omitempty"`
+	TaskType             int32                 `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
+	DomainId             string                `protobuf:"bytes,4,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,5,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	VisibilityTime       *types.Timestamp      `protobuf:"bytes,6,opt,name=visibility_time,json=visibilityTime,proto3" json:"visibility_time,omitempty"`
+	Attempt              int32                 `protobuf:"varint,7,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	LastError            string                `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedTime          *types.Timestamp      `protobuf:"bytes,9,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *HistoryTaskDLQEntry) Reset()         { *m = HistoryTaskDLQEntry{} }
+func (m *HistoryTaskDLQEntry) String() string { return proto.CompactTextString(m) }
+func (*HistoryTaskDLQEntry) ProtoMessage()    {}
+func (*HistoryTaskDLQEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8958fa454fc8f819, []int{0}
+}
+func (m *HistoryTaskDLQEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryTaskDLQEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryTaskDLQEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryTaskDLQEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryTaskDLQEntry.Merge(m, src)
+}
+func (m *HistoryTaskDLQEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryTaskDLQEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryTaskDLQEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryTaskDLQEntry proto.InternalMessageInfo
+
+func (m *HistoryTaskDLQEntry) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *HistoryTaskDLQEntry) GetCategory() TaskType {
+	if m != nil {
+		return m.Category
+	}
+	return TaskType_TASK_TYPE_INVALID
+}
+
+func (m *HistoryTaskDLQEntry) GetTaskType() int32 {
+	if m != nil {
+		return m.TaskType
+	}
+	return 0
+}
+
+func (m *HistoryTaskDLQEntry) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *HistoryTaskDLQEntry) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *HistoryTaskDLQEntry) GetVisibilityTime() *types.Timestamp {
+	if m != nil {
+		return m.VisibilityTime
+	}
+	return nil
+}
+
+func (m *HistoryTaskDLQEntry) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *HistoryTaskDLQEntry) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *HistoryTaskDLQEntry) GetCreatedTime() *types.Timestamp {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return nil
+}
+
 type CrossClusterTaskInfo struct {
 	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -203,7 +315,7 @@ func (m *CrossClusterTaskInfo) Reset()         { *m = CrossClusterTaskInfo{} }
 func (m *CrossClusterTaskInfo) String() string { return proto.CompactTextString(m) }
 func (*CrossClusterTaskInfo) ProtoMessage()    {}
 func (*CrossClusterTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8958fa454fc8f819, []int{0}
+	return fileDescriptor_8958fa454fc8f819, []int{1}
 }
 func (m *CrossClusterTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -295,7 +407,7 @@ func (m *CrossClusterStartChildExecutionRequestAttributes) String() string {
 }
 func (*CrossClusterStartChildExecutionRequestAttributes) ProtoMessage() {}
 func (*CrossClusterStartChildExecutionRequestAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8958fa454fc8f819, []int{1}
+	return fileDescriptor_8958fa454fc8f819, []int{2}
 }
 func (m *CrossClusterStartChildExecutionRequestAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -374,7 +486,7 @@ func (m *CrossClusterStartChildExecutionResponseAttributes) String() string {
 }
 func (*CrossClusterStartChildExecutionResponseAttributes) ProtoMessage() {}
 func (*CrossClusterStartChildExecutionResponseAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8958fa454fc8f819, []int{2}
+	return fileDescriptor_8958fa454fc8f819, []int{3}
 }
 func (m *CrossClusterStartChildExecutionResponseAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -429,7 +541,7 @@ func (m *CrossClusterCancelExecutionRequestAttributes) String() string {
 }
 func (*CrossClusterCancelExecutionRequestAttributes) ProtoMessage() {}
 func (*CrossClusterCancelExecutionRequestAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8958fa454fc8f819, []int{3}
+	return fileDescriptor_8958fa454fc8f819, []int{4}
 }
 func (m *CrossClusterCancelExecutionRequestAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -507,7 +619,7 @@ func (m *CrossClusterCancelExecutionResponseAttributes) String() string {
 }
 func (*CrossClusterCancelExecutionResponseAttributes) ProtoMessage() {}
 func (*CrossClusterCancelExecutionResponseAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8958fa454fc8f819, []int{4}
+	return fileDescriptor_8958fa454fc8f819, []int{5}
 }
 func (m *CrossClusterCancelExecutionResponseAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -558,7 +670,7 @@ func (m *CrossClusterSignalExecutionRequestAttributes) String() string {
 }
 func (*CrossClusterSignalExecutionRequestAttributes) ProtoMessage() {}
 func (*CrossClusterSignalExecutionRequestAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8958fa454fc8f819, []int{5}
+	return fileDescriptor_8958fa454fc8f819, []int{6}
 }
 func (m *CrossClusterSignalExecutionRequestAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -657,7 +769,7 @@ func (m *CrossClusterSignalExecutionResponseAttributes) String() string {
 }
 func (*CrossClusterSignalExecutionResponseAttributes) ProtoMessage() {}
 func (*CrossClusterSignalExecutionResponseAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8958fa454fc8f819, []int{6}
+	return fileDescriptor_8958fa454fc8f819, []int{7}
 }
 func (m *CrossClusterSignalExecutionResponseAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -702,7 +814,7 @@ func (m *CrossClusterTaskRequest) Reset()         { *m = CrossClusterTaskRequest
 func (m *CrossClusterTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*CrossClusterTaskRequest) ProtoMessage()    {}
 func (*CrossClusterTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8958fa454fc8f819, []int{7}
+	return fileDescriptor_8958fa454fc8f819, []int{8}
 }
 func (m *CrossClusterTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -814,7 +926,7 @@ func (m *CrossClusterTaskResponse) Reset()         { *m = CrossClusterTaskRespon
 func (m *CrossClusterTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*CrossClusterTaskResponse) ProtoMessage()    {}
 func (*CrossClusterTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8958fa454fc8f819, []int{8}
+	return fileDescriptor_8958fa454fc8f819, []int{9}
 }
 func (m *CrossClusterTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,7 +1045,7 @@ func (m *CrossClusterTaskRequests) Reset()         { *m = CrossClusterTaskReques
 func (m *CrossClusterTaskRequests) String() string { return proto.CompactTextString(m) }
 func (*CrossClusterTaskRequests) ProtoMessage()    {}
 func (*CrossClusterTaskRequests) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8958fa454fc8f819, []int{9}
+	return fileDescriptor_8958fa454fc8f819, []int{10}
 }
 func (m *CrossClusterTaskRequests) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -974,6 +1086,7 @@ func init() {
 	proto.RegisterEnum("uber.cadence.shared.v1.CrossClusterTaskType", CrossClusterTaskType_name, CrossClusterTaskType_value)
 	proto.RegisterEnum("uber.cadence.shared.v1.CrossClusterTaskFailedCause", CrossClusterTaskFailedCause_name, CrossClusterTaskFailedCause_value)
 	proto.RegisterEnum("uber.cadence.shared.v1.GetTaskFailedCause", GetTaskFailedCause_name, GetTaskFailedCause_value)
+	proto.RegisterType((*HistoryTaskDLQEntry)(nil), "uber.cadence.shared.v1.HistoryTaskDLQEntry")
 	proto.RegisterType((*CrossClusterTaskInfo)(nil), "uber.cadence.shared.v1.CrossClusterTaskInfo")
 	proto.RegisterType((*CrossClusterStartChildExecutionRequestAttributes)(nil), "uber.cadence.shared.v1.CrossClusterStartChildExecutionRequestAttributes")
 	proto.RegisterType((*CrossClusterStartChildExecutionResponseAttributes)(nil), "uber.cadence.shared.v1.CrossClusterStartChildExecutionResponseAttributes")
@@ -991,87 +1104,191 @@ func init() {
 }
 
 var fileDescriptor_8958fa454fc8f819 = []byte{
-	// 1279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6e, 0xdb, 0xc6,
-	0x13, 0x0e, 0x25, 0xdb, 0xb1, 0x47, 0xfe, 0xe5, 0xc7, 0x6c, 0x9a, 0x58, 0x71, 0xea, 0x44, 0x51,
-	0x80, 0x44, 0x70, 0x1d, 0xaa, 0x72, 0x10, 0xb4, 0x68, 0x8b, 0x16, 0x0c, 0x45, 0x5b, 0xdb, 0x28,
-	0x94, 0xb1, 0xa4, 0xec, 0x24, 0x17, 0x82, 0x12, 0xd7, 0x36, 0x61, 0x89, 0x54, 0xc8, 0xa5, 0x52,
-	0xdd, 0x9b, 0xa0, 0xc7, 0x16, 0x7d, 0x83, 0xbe, 0x43, 0x0f, 0x7d, 0x83, 0xa2, 0xa7, 0x9e, 0x7a,
-	0xc9, 0xa5, 0xc8, 0x93, 0x14, 0xfc, 0x23, 0x4b, 0xd4, 0xff, 0x24, 0x3d, 0xf6, 0x66, 0xce, 0x7e,
-	0x33, 0x3b, 0x33, 0xdf, 0xb7, 0xb3, 0x2b, 0x43, 0xde, 0x6f, 0x50, 0xb7, 0xd8, 0x34, 0x4c, 0x6a,
-	0x37, 0x69, 0xd1, 0x3b, 0x35, 0x5c, 0x6a, 0x16, 0xbb, 0xa5, 0xe2, 0x0b, 0x9f, 0xfa, 0x54, 0xe8,
-	0xb8, 0x0e, 0x73, 0xd0, 0xb5, 0x00, 0x23, 0xc4, 0x18, 0x21, 0xc2, 0x08, 0xdd, 0xd2, 0xe6, 0xad,
-	0x13, 0xc7, 0x39, 0x69, 0xd1, 0x62, 0x88, 0x6a, 0xf8, 0xc7, 0x45, 0x66, 0xb5, 0xa9, 0xc7, 0x8c,
-	0x76, 0x27, 0x72, 0xdc, 0xcc, 0x25, 0x82, 0x1b, 0x1d, 0x2b, 0x88, 0xdc, 0x74, 0xda, 0x6d, 0xc7,
-	0x8e, 0x11, 0xb7, 0x27, 0x21, 0x4e, 0x2d, 0x8f, 0x39, 0x6e, 0x2f, 0x82, 0xe4, 0xdf, 0xa4, 0xe0,
-	0x23, 0xc9, 0x75, 0x3c, 0x4f, 0x6a, 0xf9, 0x1e, 0xa3, 0xae, 0x66, 0x78, 0x67, 0xd8, 0x3e, 0x76,
-	0xd0, 0x0d, 0x58, 0x33, 0x9d, 0xb6, 0x61, 0xd9, 0xba, 0x65, 0x66, 0xb9, 0x1c, 0x57, 0x58, 0x23,
-	0xab, 0x91, 0x01, 0x9b, 0xa8, 0x0e, 0xe8, 0xa5, 0xe3, 0x9e, 0x1d, 0xb7, 0x9c, 0x97, 0x3a, 0xfd,
-	0x8e, 0x36, 0x7d, 0x66, 0x39, 0x76, 0x36, 0x95, 0xe3, 0x0a, 0x99, 0xdd, 0xbb, 0x42, 0xa2, 0x20,
-	0xa3, 0x63, 0x09, 0xdd, 0x92, 0x70, 0x14, 0xc3, 0xe5, 0x3e, 0x9a, 0x5c, 0x7e, 0x39, 0x6a, 0x42,
-	0x18, 0xd6, 0x98, 0xe1, 0x9d, 0xe9, 0xac, 0xd7, 0xa1, 0xd9, 0x74, 0x8e, 0x2b, 0x5c, 0xda, 0xdd,
-	0x11, 0x26, 0xb7, 0x47, 0x18, 0x4d, 0x5a, 0xeb, 0x75, 0x28, 0x59, 0x65, 0xf1, 0x5f, 0x68, 0x0b,
-	0x20, 0x0c, 0xe5, 0x31, 0x83, 0xd1, 0xec, 0x52, 0x8e, 0x2b, 0x2c, 0x93, 0x30, 0xb8, 0x1a, 0x18,
-	0xd0, 0x06, 0x5c, 0x0c, 0x97, 0x2d, 0x33, 0xbb, 0x9c, 0xe3, 0x0a, 0x69, 0xb2, 0x12, 0x7c, 0x62,
-	0x13, 0x55, 0xe1, 0x4a, 0xd7, 0xf2, 0xac, 0x86, 0xd5, 0xb2, 0x58, 0x4f, 0xeb, 0x77, 0x3c, 0xbb,
-	0x12, 0x96, 0xb6, 0x29, 0x44, 0x9c, 0x08, 0x7d, 0x4e, 0x84, 0x73, 0x04, 0x99, 0xe4, 0x96, 0xff,
-	0x2b, 0x05, 0x9f, 0x0e, 0x27, 0xaa, 0x32, 0xc3, 0x65, 0xd2, 0xa9, 0xd5, 0x32, 0x07, 0x7d, 0xa0,
-	0x2f, 0x7c, 0xea, 0x31, 0x91, 0x31, 0xd7, 0x6a, 0xf8, 0x8c, 0x7a, 0xa8, 0x00, 0x3c, 0x33, 0xdc,
-	0x13, 0xca, 0xf4, 0x51, 0x02, 0x2e, 0x45, 0xf6, 0x72, 0x9f, 0x86, 0x2d, 0x00, 0x37, 0x72, 0x0f,
-	0x30, 0xa9, 0x10, 0xb3, 0x16, 0x5b, 0xb0, 0x89, 0x76, 0x00, 0x59, 0xb6, 0xc5, 0x2c, 0x83, 0x51,
-	0x53, 0xa7, 0x5d, 0x6a, 0x87, 0xb0, 0x74, 0x58, 0x2f, 0x7f, 0xbe, 0x22, 0x07, 0x0b, 0xd8, 0x44,
-	0xaf, 0x39, 0xd8, 0x1c, 0x85, 0x1b, 0xe7, 0x59, 0x85, 0x2d, 0xcc, 0xec, 0x56, 0x26, 0x92, 0x3b,
-	0x28, 0x6b, 0x8c, 0x66, 0x9c, 0xd8, 0x66, 0x50, 0x25, 0xc9, 0x5a, 0x53, 0x56, 0x50, 0x1e, 0xfe,
-	0x17, 0xd7, 0xef, 0xfa, 0x76, 0x9f, 0xa1, 0x35, 0x92, 0x89, 0x8c, 0xc4, 0xb7, 0xb1, 0x99, 0xff,
-	0x16, 0x4a, 0x73, 0xfb, 0xea, 0x75, 0x1c, 0xdb, 0xa3, 0x43, 0x81, 0xaf, 0xc2, 0x4a, 0x1c, 0x31,
-	0x6a, 0xe7, 0xb2, 0x1b, 0xc6, 0xfa, 0x2d, 0x05, 0x3b, 0xc3, 0xc1, 0x24, 0xc3, 0x6e, 0xd2, 0xd6,
-	0xbf, 0x42, 0x50, 0x03, 0xae, 0xc7, 0xc8, 0x0f, 0x3e, 0x2e, 0x1b, 0x51, 0xa0, 0xb1, 0x85, 0x11,
-	0x11, 0xa4, 0x17, 0x13, 0xc1, 0xd2, 0x14, 0x11, 0x08, 0x70, 0xa5, 0x19, 0xb4, 0x71, 0x90, 0xaf,
-	0x63, 0xb7, 0x7a, 0x21, 0x03, 0xab, 0xe4, 0x72, 0x73, 0x98, 0xe2, 0x9a, 0xdd, 0xea, 0xe5, 0x8b,
-	0x70, 0x7f, 0x66, 0xeb, 0x46, 0x39, 0xc8, 0xff, 0x9a, 0x4e, 0x36, 0x5b, 0xb5, 0x4e, 0x6c, 0xe3,
-	0xbf, 0x66, 0x2f, 0xd2, 0x6c, 0x74, 0x0b, 0x32, 0x5e, 0xd8, 0x2e, 0xdd, 0x36, 0xda, 0x34, 0x9c,
-	0x49, 0x6b, 0x04, 0x22, 0x93, 0x62, 0xb4, 0x29, 0xfa, 0x06, 0xd6, 0x63, 0x80, 0x65, 0x77, 0x7c,
-	0x96, 0xbd, 0x18, 0x16, 0xfd, 0xf1, 0xc4, 0xa2, 0x0f, 0x8c, 0x5e, 0xcb, 0x31, 0x4c, 0x12, 0x87,
-	0xc4, 0x81, 0x03, 0xca, 0xc2, 0xc5, 0xa6, 0x63, 0x33, 0xd7, 0x69, 0x65, 0x57, 0x73, 0x5c, 0x61,
-	0x9d, 0xf4, 0x3f, 0x47, 0x89, 0x1e, 0xa3, 0x6d, 0x8c, 0xe8, 0x9f, 0x97, 0x60, 0x63, 0x74, 0x46,
-	0xc7, 0xec, 0x9e, 0xcf, 0x79, 0xcb, 0x3e, 0x76, 0x42, 0x32, 0x33, 0x8b, 0xcf, 0xf9, 0xe0, 0x72,
-	0x8a, 0xe6, 0x7c, 0x78, 0x4d, 0xfd, 0xc8, 0xc1, 0x96, 0x37, 0x7e, 0xfa, 0x07, 0x89, 0xc4, 0xcc,
-	0x57, 0x16, 0x89, 0xbf, 0xc8, 0x78, 0xae, 0x5c, 0x20, 0xb3, 0x37, 0x44, 0xdf, 0x73, 0x70, 0xbd,
-	0x99, 0x3c, 0x08, 0x43, 0xe9, 0xa4, 0xc3, 0x74, 0xca, 0x8b, 0xa4, 0x33, 0x6f, 0x10, 0x55, 0x2e,
-	0x90, 0xe9, 0x1b, 0x85, 0x69, 0x78, 0x49, 0x9a, 0xc4, 0xd1, 0x71, 0xbe, 0x50, 0x1a, 0xf3, 0x8e,
-	0x68, 0x90, 0xc6, 0xd4, 0x8d, 0x1e, 0xad, 0x03, 0x0c, 0x6e, 0x91, 0xfc, 0x0f, 0xcb, 0x90, 0x1d,
-	0x57, 0x45, 0x24, 0x9e, 0xe1, 0x4b, 0x99, 0x4b, 0x5c, 0xca, 0x89, 0x77, 0x41, 0xea, 0x83, 0xde,
-	0x05, 0x87, 0xb0, 0x7e, 0x6c, 0x58, 0x2d, 0x6a, 0xea, 0x4d, 0xc3, 0xf7, 0xfa, 0xaf, 0x8c, 0x07,
-	0x8b, 0x46, 0xdb, 0x0b, 0x7d, 0xa5, 0xc0, 0x95, 0x64, 0x8e, 0x07, 0x1f, 0xe8, 0xa7, 0xb9, 0x3a,
-	0x8c, 0x3a, 0x8e, 0xdf, 0x5b, 0x87, 0xa3, 0x27, 0x6c, 0xbe, 0x10, 0x5f, 0xcd, 0x14, 0xe2, 0x72,
-	0x98, 0x8f, 0xfc, 0x5e, 0x42, 0x9c, 0x90, 0xcb, 0x0c, 0x25, 0xbe, 0x9a, 0xa9, 0xc4, 0x95, 0xc5,
-	0xf3, 0x98, 0x3b, 0x75, 0xde, 0x45, 0x8a, 0x9d, 0x49, 0x4a, 0x0c, 0xa5, 0xed, 0x21, 0x2d, 0x78,
-	0x82, 0x78, 0x67, 0x7a, 0x3c, 0xd9, 0xbd, 0x2c, 0x97, 0x4b, 0x17, 0x32, 0xbb, 0xc5, 0x45, 0x65,
-	0x12, 0x07, 0x22, 0xeb, 0x6c, 0x28, 0xea, 0xf6, 0x6b, 0x0e, 0x56, 0xfb, 0x92, 0x44, 0x57, 0xe1,
-	0xb2, 0x26, 0xaa, 0x8f, 0x75, 0xed, 0xd9, 0x81, 0xac, 0x63, 0xe5, 0x50, 0xac, 0xe2, 0x32, 0x7f,
-	0x01, 0x5d, 0x03, 0x34, 0x30, 0x6b, 0x44, 0x54, 0xd4, 0x3d, 0x99, 0xf0, 0x1c, 0xba, 0x02, 0xff,
-	0x1f, 0xb2, 0xe3, 0x27, 0x32, 0xe1, 0x53, 0xe8, 0x3a, 0x5c, 0x1d, 0x18, 0x89, 0x7c, 0x50, 0xc5,
-	0x92, 0xa8, 0xe1, 0x9a, 0xc2, 0xa7, 0xd1, 0x0d, 0xd8, 0x18, 0x2c, 0x49, 0xa4, 0xa6, 0xaa, 0xba,
-	0x54, 0xad, 0xab, 0x9a, 0x4c, 0xf8, 0xa5, 0xed, 0x3f, 0xb8, 0xf1, 0x47, 0x7f, 0x98, 0xd4, 0x1d,
-	0xb8, 0x95, 0xc0, 0xea, 0x93, 0x52, 0x2c, 0xc1, 0xfd, 0x69, 0x20, 0x55, 0x13, 0x89, 0xa6, 0x4b,
-	0x15, 0x5c, 0x2d, 0xeb, 0xf2, 0x53, 0x59, 0xaa, 0x87, 0xd9, 0x70, 0x68, 0x07, 0x0a, 0xd3, 0x5c,
-	0x24, 0x51, 0x91, 0xe4, 0xea, 0x10, 0x3a, 0x35, 0x0b, 0xad, 0xe2, 0x7d, 0x45, 0x1c, 0x46, 0xa7,
-	0xb7, 0x7f, 0x49, 0xc3, 0x8d, 0x19, 0xc7, 0x14, 0x7d, 0x02, 0xf7, 0x26, 0x44, 0xdb, 0x13, 0x71,
-	0x55, 0x2e, 0xeb, 0x92, 0x58, 0x57, 0x87, 0x6b, 0x7b, 0x08, 0xa5, 0x79, 0xe0, 0x72, 0xed, 0x89,
-	0x88, 0x15, 0x5d, 0xa9, 0x69, 0xba, 0x28, 0x69, 0xf8, 0x50, 0xe6, 0xb9, 0x77, 0x74, 0x93, 0x9f,
-	0x62, 0x55, 0x53, 0xf9, 0x14, 0xfa, 0x0a, 0x3e, 0x9f, 0xe7, 0x76, 0x54, 0x23, 0x8f, 0xf7, 0xaa,
-	0xb5, 0x23, 0x5d, 0xac, 0x12, 0x59, 0x2c, 0x3f, 0xd3, 0x49, 0x5d, 0x51, 0xb0, 0xb2, 0xcf, 0xa7,
-	0xd1, 0x67, 0xf0, 0x60, 0x61, 0xef, 0xa1, 0x6d, 0x97, 0xd0, 0xd7, 0xf0, 0xc5, 0x3b, 0x6f, 0x2b,
-	0xd5, 0x9e, 0x1c, 0x54, 0x65, 0x4d, 0x2e, 0xf3, 0xcb, 0x53, 0x04, 0x90, 0xf0, 0xaf, 0x2b, 0x92,
-	0xa8, 0xc9, 0xfb, 0x35, 0x82, 0x9f, 0xcb, 0x65, 0x7e, 0x65, 0xfb, 0x0d, 0x07, 0x68, 0x9f, 0xb2,
-	0x51, 0x6e, 0x6e, 0xc3, 0xd6, 0xbe, 0xac, 0xcd, 0x64, 0xe4, 0x2e, 0xe4, 0x27, 0x43, 0x54, 0x99,
-	0x1c, 0x62, 0x49, 0xd6, 0x1f, 0xd5, 0xd5, 0x67, 0x3c, 0x37, 0x3d, 0x54, 0x70, 0x58, 0x6a, 0x75,
-	0x8d, 0x4f, 0x21, 0x01, 0xb6, 0xa7, 0x84, 0xaa, 0x88, 0xa4, 0xac, 0xd7, 0x8e, 0x14, 0x99, 0xa8,
-	0x15, 0x7c, 0xa0, 0x57, 0x6b, 0xaa, 0xc6, 0xa7, 0xd1, 0x3d, 0xb8, 0x33, 0x19, 0x9f, 0xac, 0x6e,
-	0xe9, 0x91, 0xf4, 0xfb, 0xdb, 0x9b, 0xdc, 0x9f, 0x6f, 0x6f, 0x72, 0x7f, 0xbf, 0xbd, 0xc9, 0x3d,
-	0x7f, 0x78, 0x62, 0xb1, 0x53, 0xbf, 0x21, 0x34, 0x9d, 0x76, 0x31, 0xf1, 0x03, 0x5c, 0x38, 0xa1,
-	0x76, 0xf4, 0x73, 0x7e, 0xf0, 0xaf, 0x80, 0x2f, 0xa3, 0xbf, 0xba, 0xa5, 0xc6, 0x4a, 0xb8, 0xf2,
-	0xe0, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x51, 0x6e, 0x20, 0x3e, 0x34, 0x10, 0x00, 0x00,
+	// 1391 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4d, 0x73, 0xd3, 0x56,
+	0x17, 0x46, 0x76, 0x1c, 0x92, 0xe3, 0xbc, 0x41, 0xdc, 0xbc, 0x10, 0x13, 0xde, 0x80, 0x31, 0x33,
+	0xe0, 0xc9, 0x1b, 0xec, 0x26, 0x0c, 0xd3, 0x4e, 0x4b, 0xdb, 0x11, 0xb2, 0x12, 0xab, 0x18, 0x3b,
+	0xbd, 0x92, 0x13, 0x60, 0xa3, 0x91, 0xa5, 0x1b, 0x47, 0x13, 0x5b, 0x32, 0xd2, 0x55, 0xa8, 0xf7,
+	0x85, 0xe9, 0xb2, 0x9d, 0xfe, 0x83, 0xfe, 0x87, 0x2e, 0xda, 0x5f, 0xd0, 0xe9, 0xaa, 0xab, 0x6e,
+	0xd8, 0x74, 0xf8, 0x25, 0x1d, 0x7d, 0x38, 0xb2, 0x6c, 0xf9, 0x03, 0xe8, 0xb2, 0x3b, 0xeb, 0xdc,
+	0xe7, 0x9c, 0x7b, 0xee, 0xf3, 0x3c, 0xf7, 0x63, 0x0c, 0x05, 0xb7, 0x45, 0xec, 0xb2, 0xa6, 0xea,
+	0xc4, 0xd4, 0x48, 0xd9, 0x39, 0x51, 0x6d, 0xa2, 0x97, 0xcf, 0x76, 0xca, 0x2f, 0x5c, 0xe2, 0x92,
+	0x52, 0xcf, 0xb6, 0xa8, 0x85, 0xae, 0x7a, 0x98, 0x52, 0x88, 0x29, 0x05, 0x98, 0xd2, 0xd9, 0xce,
+	0xc6, 0xcd, 0xb6, 0x65, 0xb5, 0x3b, 0xa4, 0xec, 0xa3, 0x5a, 0xee, 0x71, 0x99, 0x1a, 0x5d, 0xe2,
+	0x50, 0xb5, 0xdb, 0x0b, 0x12, 0x37, 0xf2, 0xb1, 0xe2, 0x6a, 0xcf, 0xf0, 0x2a, 0x6b, 0x56, 0xb7,
+	0x6b, 0x99, 0x21, 0xe2, 0x56, 0x12, 0xe2, 0xc4, 0x70, 0xa8, 0x65, 0xf7, 0x03, 0x48, 0xe1, 0xd7,
+	0x34, 0xac, 0x55, 0x83, 0x88, 0xac, 0x3a, 0xa7, 0x95, 0xda, 0xd7, 0x82, 0x49, 0xed, 0x3e, 0x5a,
+	0x87, 0x8b, 0x54, 0x75, 0x4e, 0x15, 0x43, 0xcf, 0x31, 0x79, 0xa6, 0x98, 0xc6, 0x8b, 0xde, 0xa7,
+	0xa8, 0xa3, 0x87, 0xb0, 0xa4, 0xa9, 0x94, 0xb4, 0x2d, 0xbb, 0x9f, 0x4b, 0xe5, 0x99, 0xe2, 0xea,
+	0x6e, 0xbe, 0x94, 0xbc, 0x82, 0x92, 0x57, 0x50, 0xee, 0xf7, 0x08, 0x3e, 0xcf, 0x40, 0xd7, 0x61,
+	0xd9, 0x2f, 0x4b, 0xfb, 0x3d, 0x92, 0x4b, 0xe7, 0x99, 0x62, 0x06, 0x2f, 0xd1, 0x10, 0xe6, 0x0d,
+	0xea, 0x56, 0x57, 0x35, 0x4c, 0x6f, 0xd6, 0x85, 0x3c, 0x53, 0x5c, 0xc6, 0x4b, 0x41, 0x40, 0xd4,
+	0x51, 0x13, 0xd0, 0x4b, 0xcb, 0x3e, 0x3d, 0xee, 0x58, 0x2f, 0x15, 0xf2, 0x0d, 0xd1, 0x5c, 0x6a,
+	0x58, 0x66, 0x2e, 0x93, 0x67, 0x8a, 0xd9, 0xdd, 0x3b, 0xf1, 0x0e, 0xd4, 0x9e, 0xe1, 0x4d, 0x7f,
+	0x14, 0xc2, 0x85, 0x01, 0x1a, 0x5f, 0x7e, 0x39, 0x1a, 0x42, 0x3c, 0x5c, 0x3a, 0x33, 0x1c, 0xa3,
+	0x65, 0x74, 0x0c, 0xda, 0x57, 0x3c, 0x8a, 0x73, 0x8b, 0x7e, 0xcd, 0x8d, 0x52, 0xc0, 0x7f, 0x69,
+	0xc0, 0x7f, 0x49, 0x1e, 0xf0, 0x8f, 0x57, 0xa3, 0x14, 0x2f, 0x88, 0x72, 0x70, 0x51, 0xa5, 0x94,
+	0x74, 0x7b, 0x34, 0x77, 0xd1, 0x5f, 0xd3, 0xe0, 0x13, 0x6d, 0x02, 0x74, 0x54, 0x87, 0x2a, 0xc4,
+	0xb6, 0x2d, 0x3b, 0xb7, 0xe4, 0xaf, 0x69, 0xd9, 0x8b, 0x08, 0x5e, 0x00, 0x7d, 0x0e, 0x2b, 0x9a,
+	0x4d, 0x54, 0x4a, 0xf4, 0x60, 0xea, 0xe5, 0x99, 0x53, 0x67, 0x43, 0xbc, 0x17, 0x29, 0xbc, 0x49,
+	0xc1, 0x7f, 0x79, 0xdb, 0x72, 0x1c, 0xbe, 0xe3, 0x3a, 0x94, 0xd8, 0x1e, 0xe1, 0xa2, 0x79, 0x6c,
+	0xc5, 0x99, 0x64, 0xe6, 0x62, 0x32, 0xf5, 0xa1, 0x4c, 0x8a, 0xa3, 0xd2, 0xae, 0xee, 0x6e, 0x4f,
+	0x72, 0xc6, 0x68, 0xd3, 0x81, 0x4b, 0xce, 0x8d, 0xb0, 0x09, 0xe0, 0x97, 0x72, 0xa8, 0x4a, 0x89,
+	0xef, 0x84, 0x0c, 0xf6, 0x8b, 0x4b, 0x5e, 0x60, 0xd8, 0x9b, 0x99, 0x98, 0x37, 0x6b, 0xb0, 0x16,
+	0x57, 0xc6, 0xe7, 0x6c, 0x0e, 0x41, 0x93, 0xd2, 0x0a, 0x7f, 0xa6, 0xe0, 0xa3, 0xe1, 0x46, 0x25,
+	0xaa, 0xda, 0x94, 0x3f, 0x31, 0x3a, 0x7a, 0xc4, 0x03, 0x79, 0xe1, 0x12, 0x87, 0x72, 0x94, 0xda,
+	0x46, 0xcb, 0xa5, 0xc4, 0x41, 0x45, 0x60, 0xa9, 0x6a, 0xb7, 0x09, 0x55, 0x46, 0x05, 0x58, 0x0d,
+	0xe2, 0x95, 0x81, 0x0c, 0x9b, 0x00, 0x76, 0x90, 0xee, 0x61, 0x52, 0x81, 0x35, 0xc2, 0x88, 0xa8,
+	0xa3, 0x6d, 0x40, 0x86, 0x69, 0x50, 0xc3, 0x37, 0x07, 0x39, 0x23, 0xa6, 0x0f, 0x4b, 0xfb, 0xeb,
+	0x65, 0xcf, 0x47, 0x04, 0x6f, 0x40, 0xd4, 0xd1, 0x6b, 0x06, 0x36, 0x46, 0xe1, 0xea, 0x79, 0x57,
+	0x3e, 0x85, 0xd9, 0xdd, 0x6a, 0xa2, 0xb8, 0xd1, 0xb2, 0xc6, 0x64, 0x16, 0x63, 0xd3, 0x44, 0xab,
+	0xc4, 0x39, 0x63, 0xc2, 0x08, 0x2a, 0xc0, 0x7f, 0xc2, 0xf5, 0xdb, 0xae, 0x39, 0x50, 0x68, 0x19,
+	0x67, 0x83, 0x20, 0x76, 0x4d, 0x51, 0x2f, 0x7c, 0x05, 0x3b, 0x33, 0x79, 0x75, 0x7a, 0x96, 0xe9,
+	0x90, 0xa1, 0xc2, 0x57, 0x60, 0xd1, 0x76, 0x87, 0xe8, 0xcc, 0xd8, 0x7e, 0xad, 0x5f, 0x52, 0xb0,
+	0x3d, 0x5c, 0x8c, 0x57, 0x4d, 0x8d, 0x74, 0xfe, 0x11, 0x81, 0x5a, 0x70, 0x2d, 0x44, 0x7e, 0xf0,
+	0x76, 0x59, 0x0f, 0x0a, 0x8d, 0x0d, 0x8c, 0x98, 0x20, 0x3d, 0x9f, 0x09, 0x16, 0x26, 0x98, 0xa0,
+	0x04, 0x6b, 0x9a, 0x47, 0x63, 0xd4, 0xaf, 0x65, 0x76, 0xfa, 0xbe, 0x02, 0x4b, 0xf8, 0xb2, 0x36,
+	0x2c, 0x71, 0xc3, 0xec, 0xf4, 0x0b, 0x65, 0xb8, 0x37, 0x95, 0xba, 0x51, 0x0d, 0x0a, 0x3f, 0xa7,
+	0xe3, 0x64, 0x4b, 0x46, 0xdb, 0x54, 0xff, 0x25, 0x7b, 0x1e, 0xb2, 0xd1, 0x4d, 0xc8, 0x3a, 0x3e,
+	0x5d, 0x8a, 0xa9, 0x86, 0x97, 0xcc, 0x32, 0x86, 0x20, 0x54, 0x57, 0xbb, 0x04, 0x7d, 0x09, 0x2b,
+	0x21, 0xc0, 0x30, 0x7b, 0x6e, 0x70, 0x93, 0x64, 0x77, 0xff, 0x97, 0xb8, 0xe8, 0x03, 0xb5, 0xdf,
+	0xb1, 0x54, 0x1d, 0x87, 0x25, 0x45, 0x2f, 0xc1, 0xbb, 0x85, 0x34, 0xcb, 0xa4, 0xb6, 0xd5, 0xf1,
+	0x2f, 0x9a, 0x15, 0x3c, 0xf8, 0x1c, 0x15, 0x7a, 0x4c, 0xb6, 0x31, 0xa1, 0x7f, 0x5c, 0x80, 0xf5,
+	0xd1, 0x33, 0x3a, 0x54, 0xf7, 0xfc, 0x9c, 0x37, 0xcc, 0x63, 0xcb, 0x17, 0x33, 0x3b, 0xff, 0x39,
+	0xef, 0x5d, 0x4e, 0xc1, 0x39, 0xef, 0xfd, 0x42, 0xdf, 0x33, 0xb0, 0xe9, 0x8c, 0xef, 0xfe, 0xa8,
+	0x91, 0x5c, 0x2a, 0xe9, 0xe0, 0x4a, 0xae, 0x3f, 0xcf, 0xf1, 0x5c, 0xbd, 0x80, 0xa7, 0x4f, 0x88,
+	0xbe, 0x65, 0xe0, 0x9a, 0x16, 0xdf, 0x08, 0x43, 0xed, 0xa4, 0xfd, 0x76, 0x2a, 0xf3, 0xb4, 0x33,
+	0xeb, 0x20, 0xaa, 0x5e, 0xc0, 0x93, 0x27, 0xf2, 0xdb, 0x70, 0xe2, 0x32, 0x71, 0xa3, 0xc7, 0xf9,
+	0x5c, 0x6d, 0xcc, 0xda, 0xa2, 0x5e, 0x1b, 0x13, 0x27, 0x7a, 0xb4, 0x02, 0x10, 0xdd, 0x22, 0x85,
+	0xef, 0x32, 0x90, 0x1b, 0x77, 0x45, 0x60, 0x9e, 0xc9, 0x0f, 0xc6, 0xd8, 0xbb, 0x20, 0xf5, 0x41,
+	0xef, 0x82, 0x43, 0x58, 0x39, 0x56, 0x8d, 0x0e, 0xd1, 0x15, 0x4d, 0x75, 0x9d, 0xc1, 0x2b, 0xe3,
+	0xfe, 0xbc, 0xd5, 0xf6, 0xfc, 0x5c, 0xde, 0x4b, 0xc5, 0xd9, 0xe3, 0xe8, 0x03, 0xfd, 0x30, 0xd3,
+	0x87, 0x01, 0xe3, 0xe2, 0x7b, 0xfb, 0x70, 0x74, 0x87, 0xcd, 0x36, 0xe2, 0xab, 0xa9, 0x46, 0x0c,
+	0xde, 0xbd, 0xc2, 0x7b, 0x19, 0x31, 0xa1, 0x97, 0x29, 0x4e, 0x7c, 0x35, 0xd5, 0x89, 0x8b, 0xf3,
+	0xf7, 0x31, 0xf3, 0xd4, 0x79, 0x17, 0x2b, 0xf6, 0x92, 0x9c, 0xe8, 0x5b, 0xdb, 0x41, 0xb2, 0xf7,
+	0x04, 0x71, 0x4e, 0x95, 0xf0, 0x64, 0x77, 0x72, 0x4c, 0x3e, 0x5d, 0xcc, 0xee, 0x96, 0xe7, 0xb5,
+	0x49, 0x58, 0x08, 0xaf, 0xd0, 0xe8, 0xc3, 0xd9, 0x7a, 0xcd, 0xc0, 0xd2, 0xc0, 0x92, 0xe8, 0x0a,
+	0x5c, 0x96, 0x39, 0xe9, 0xb1, 0x22, 0x3f, 0x3b, 0x10, 0x14, 0xb1, 0x7e, 0xc8, 0xd5, 0xc4, 0x0a,
+	0x7b, 0x01, 0x5d, 0x05, 0x14, 0x85, 0x65, 0xcc, 0xd5, 0xa5, 0x3d, 0x01, 0xb3, 0x0c, 0x5a, 0x83,
+	0x4b, 0x43, 0x71, 0xf1, 0x89, 0x80, 0xd9, 0x14, 0xba, 0x06, 0x57, 0xa2, 0x20, 0x16, 0x0e, 0x6a,
+	0x22, 0xcf, 0xc9, 0x62, 0xa3, 0xce, 0xa6, 0xd1, 0x75, 0x58, 0x8f, 0x86, 0x78, 0xdc, 0x90, 0x24,
+	0x85, 0xaf, 0x35, 0x25, 0x59, 0xc0, 0xec, 0xc2, 0xd6, 0xef, 0xcc, 0xf8, 0xa3, 0xdf, 0x6f, 0xea,
+	0x36, 0xdc, 0x8c, 0x61, 0x95, 0xa4, 0x16, 0x77, 0xe0, 0xde, 0x24, 0x90, 0x24, 0x73, 0x58, 0x56,
+	0xf8, 0xaa, 0x58, 0xab, 0x28, 0xc2, 0x53, 0x81, 0x6f, 0xfa, 0xdd, 0x30, 0x68, 0x1b, 0x8a, 0x93,
+	0x52, 0x78, 0xae, 0xce, 0x0b, 0xb5, 0x21, 0x74, 0x6a, 0x1a, 0x5a, 0x12, 0xf7, 0xeb, 0xdc, 0x30,
+	0x3a, 0xbd, 0xf5, 0x53, 0x1a, 0xae, 0x4f, 0xd9, 0xa6, 0xe8, 0xff, 0x70, 0x37, 0xa1, 0xda, 0x1e,
+	0x27, 0xd6, 0x84, 0x8a, 0xc2, 0x73, 0x4d, 0x69, 0x78, 0x6d, 0x0f, 0x60, 0x67, 0x16, 0xb8, 0xd2,
+	0x78, 0xc2, 0x89, 0x75, 0xa5, 0xde, 0x90, 0x15, 0x8e, 0x97, 0xc5, 0x43, 0x81, 0x65, 0xde, 0x31,
+	0x4d, 0x78, 0x2a, 0x4a, 0xb2, 0xc4, 0xa6, 0xd0, 0x43, 0xf8, 0x64, 0x56, 0xda, 0x51, 0x03, 0x3f,
+	0xde, 0xab, 0x35, 0x8e, 0x14, 0xae, 0x86, 0x05, 0xae, 0xf2, 0x4c, 0xc1, 0xcd, 0x7a, 0x5d, 0xac,
+	0xef, 0xb3, 0x69, 0xf4, 0x31, 0xdc, 0x9f, 0x3b, 0x7b, 0x68, 0xda, 0x05, 0xf4, 0x05, 0x7c, 0xfa,
+	0xce, 0xd3, 0xf2, 0x8d, 0x27, 0x07, 0x35, 0x41, 0x16, 0x2a, 0x6c, 0x66, 0x82, 0x01, 0x62, 0xf9,
+	0xcd, 0x3a, 0xcf, 0xc9, 0xc2, 0x7e, 0x03, 0x8b, 0xcf, 0x85, 0x0a, 0xbb, 0xb8, 0xf5, 0x86, 0x01,
+	0xb4, 0x4f, 0xe8, 0xa8, 0x36, 0xb7, 0x60, 0x73, 0x5f, 0x90, 0xa7, 0x2a, 0x72, 0x07, 0x0a, 0xc9,
+	0x10, 0x49, 0xc0, 0x87, 0x22, 0x2f, 0x28, 0x8f, 0x9a, 0xd2, 0x33, 0x96, 0x99, 0x5c, 0xca, 0xdb,
+	0x2c, 0x8d, 0xa6, 0xcc, 0xa6, 0x50, 0x09, 0xb6, 0x26, 0x94, 0xaa, 0x72, 0xb8, 0xa2, 0x34, 0x8e,
+	0xea, 0x02, 0x96, 0xaa, 0xe2, 0x81, 0x52, 0x6b, 0x48, 0x32, 0x9b, 0x46, 0x77, 0xe1, 0x76, 0x32,
+	0x3e, 0xbe, 0xba, 0x85, 0x47, 0xfc, 0x6f, 0x6f, 0x6f, 0x30, 0x7f, 0xbc, 0xbd, 0xc1, 0xfc, 0xf5,
+	0xf6, 0x06, 0xf3, 0xfc, 0x41, 0xdb, 0xa0, 0x27, 0x6e, 0xab, 0xa4, 0x59, 0xdd, 0x72, 0xec, 0xdf,
+	0x93, 0x52, 0x9b, 0x98, 0xc1, 0x7f, 0x31, 0xd1, 0xff, 0x38, 0x9f, 0x05, 0xbf, 0xce, 0x76, 0x5a,
+	0x8b, 0xfe, 0xc8, 0xfd, 0xbf, 0x07, 0x00, 0xb5, 0x13, 0x74, 0x79, 0xf1, 0x11, 0x00, 0x00,
+}
+
+func (m *HistoryTaskDLQEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryTaskDLQEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryTaskDLQEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CreatedTime != nil {
+		{
+			size, err := m.CreatedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueue(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.VisibilityTime != nil {
+		{
+			size, err := m.VisibilityTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueue(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueue(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TaskType != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Category != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.Category))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *CrossClusterTaskInfo) Marshal() (dAtA []byte, err error) {
@@ -1739,6 +1956,50 @@ func encodeVarintQueue(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *HistoryTaskDLQEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovQueue(uint64(m.TaskId))
+	}
+	if m.Category != 0 {
+		n += 1 + sovQueue(uint64(m.Category))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovQueue(uint64(m.TaskType))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	if m.VisibilityTime != nil {
+		l = m.VisibilityTime.Size()
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovQueue(uint64(m.Attempt))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	if m.CreatedTime != nil {
+		l = m.CreatedTime.Size()
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *CrossClusterTaskInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2054,6 +2315,308 @@ func sovQueue(x uint64) (n int) {
 func sozQueue(x uint64) (n int) {
 	return sovQueue(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *HistoryTaskDLQEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryTaskDLQEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryTaskDLQEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			m.Category = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Category |= TaskType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VisibilityTime == nil {
+				m.VisibilityTime = &types.Timestamp{}
+			}
+			if err := m.VisibilityTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedTime == nil {
+				m.CreatedTime = &types.Timestamp{}
+			}
+			if err := m.CreatedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CrossClusterTaskInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -536,6 +536,7 @@ type SyncActivityTaskAttributes struct {
 	LastFailure          *v1.Failure           `protobuf:"bytes,11,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
 	LastWorkerIdentity   string                `protobuf:"bytes,12,opt,name=last_worker_identity,json=lastWorkerIdentity,proto3" json:"last_worker_identity,omitempty"`
 	VersionHistory       *VersionHistory       `protobuf:"bytes,13,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	Paused               bool                  `protobuf:"varint,14,opt,name=paused,proto3" json:"paused,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -665,6 +666,13 @@ func (m *SyncActivityTaskAttributes) GetVersionHistory() *VersionHistory {
 	return nil
 }
 
+func (m *SyncActivityTaskAttributes) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 type HistoryTaskV2Attributes struct {
 	TaskId              int64                 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	DomainId            string                `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -1087,6 +1095,253 @@ func (m *SyncShardStatus) GetTimestamp() *types.Timestamp {
 	return nil
 }
 
+type ReplicationShardStatus struct {
+	ShardId int32 `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// Remote cluster this status is reported against.
+	ClusterName string `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// Largest task ID allocated by the shard in the current cluster.
+	MaxTaskId int64 `protobuf:"varint,3,opt,name=max_task_id,json=maxTaskId,proto3" json:"max_task_id,omitempty"`
+	// Replication tasks read by the remote cluster from the current cluster.
+	OutboundFetchedTaskId int64            `protobuf:"varint,4,opt,name=outbound_fetched_task_id,json=outboundFetchedTaskId,proto3" json:"outbound_fetched_task_id,omitempty"`
+	OutboundAckedTaskId   int64            `protobuf:"varint,5,opt,name=outbound_acked_task_id,json=outboundAckedTaskId,proto3" json:"outbound_acked_task_id,omitempty"`
+	OutboundLastFetchTime *types.Timestamp `protobuf:"bytes,6,opt,name=outbound_last_fetch_time,json=outboundLastFetchTime,proto3" json:"outbound_last_fetch_time,omitempty"`
+	// Replication tasks read by the current cluster from the remote cluster.
+	InboundFetchedTaskId int64            `protobuf:"varint,7,opt,name=inbound_fetched_task_id,json=inboundFetchedTaskId,proto3" json:"inbound_fetched_task_id,omitempty"`
+	InboundAckedTaskId   int64            `protobuf:"varint,8,opt,name=inbound_acked_task_id,json=inboundAckedTaskId,proto3" json:"inbound_acked_task_id,omitempty"`
+	InboundLastFetchTime *types.Timestamp `protobuf:"bytes,9,opt,name=inbound_last_fetch_time,json=inboundLastFetchTime,proto3" json:"inbound_last_fetch_time,omitempty"`
+	// Replication tasks from the remote cluster parked in the replication DLQ of the shard.
+	InboundDlqSize int64 `protobuf:"varint,10,opt,name=inbound_dlq_size,json=inboundDlqSize,proto3" json:"inbound_dlq_size,omitempty"`
+	// Cross-cluster task IDs targeting the remote cluster which are not yet acked.
+	CrossClusterTaskLag  int64    `protobuf:"varint,11,opt,name=cross_cluster_task_lag,json=crossClusterTaskLag,proto3" json:"cross_cluster_task_lag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplicationShardStatus) Reset()         { *m = ReplicationShardStatus{} }
+func (m *ReplicationShardStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplicationShardStatus) ProtoMessage()    {}
+func (*ReplicationShardStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00df2ec6c2eaefe5, []int{11}
+}
+func (m *ReplicationShardStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationShardStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationShardStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicationShardStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationShardStatus.Merge(m, src)
+}
+func (m *ReplicationShardStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationShardStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationShardStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationShardStatus proto.InternalMessageInfo
+
+func (m *ReplicationShardStatus) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *ReplicationShardStatus) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
+func (m *ReplicationShardStatus) GetMaxTaskId() int64 {
+	if m != nil {
+		return m.MaxTaskId
+	}
+	return 0
+}
+
+func (m *ReplicationShardStatus) GetOutboundFetchedTaskId() int64 {
+	if m != nil {
+		return m.OutboundFetchedTaskId
+	}
+	return 0
+}
+
+func (m *ReplicationShardStatus) GetOutboundAckedTaskId() int64 {
+	if m != nil {
+		return m.OutboundAckedTaskId
+	}
+	return 0
+}
+
+func (m *ReplicationShardStatus) GetOutboundLastFetchTime() *types.Timestamp {
+	if m != nil {
+		return m.OutboundLastFetchTime
+	}
+	return nil
+}
+
+func (m *ReplicationShardStatus) GetInboundFetchedTaskId() int64 {
+	if m != nil {
+		return m.InboundFetchedTaskId
+	}
+	return 0
+}
+
+func (m *ReplicationShardStatus) GetInboundAckedTaskId() int64 {
+	if m != nil {
+		return m.InboundAckedTaskId
+	}
+	return 0
+}
+
+func (m *ReplicationShardStatus) GetInboundLastFetchTime() *types.Timestamp {
+	if m != nil {
+		return m.InboundLastFetchTime
+	}
+	return nil
+}
+
+func (m *ReplicationShardStatus) GetInboundDlqSize() int64 {
+	if m != nil {
+		return m.InboundDlqSize
+	}
+	return 0
+}
+
+func (m *ReplicationShardStatus) GetCrossClusterTaskLag() int64 {
+	if m != nil {
+		return m.CrossClusterTaskLag
+	}
+	return 0
+}
+
+type ReplicationDomainStatus struct {
+	Domain            string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	ActiveClusterName string `protobuf:"bytes,2,opt,name=active_cluster_name,json=activeClusterName,proto3" json:"active_cluster_name,omitempty"`
+	// Remote cluster the domain is replicated to or from.
+	ClusterName string `protobuf:"bytes,3,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// Largest number of task IDs not yet acked by the remote cluster across all shards.
+	MaxOutboundTaskLag      int64            `protobuf:"varint,4,opt,name=max_outbound_task_lag,json=maxOutboundTaskLag,proto3" json:"max_outbound_task_lag,omitempty"`
+	OldestOutboundFetchTime *types.Timestamp `protobuf:"bytes,5,opt,name=oldest_outbound_fetch_time,json=oldestOutboundFetchTime,proto3" json:"oldest_outbound_fetch_time,omitempty"`
+	OldestInboundFetchTime  *types.Timestamp `protobuf:"bytes,6,opt,name=oldest_inbound_fetch_time,json=oldestInboundFetchTime,proto3" json:"oldest_inbound_fetch_time,omitempty"`
+	InboundDlqSize          int64            `protobuf:"varint,7,opt,name=inbound_dlq_size,json=inboundDlqSize,proto3" json:"inbound_dlq_size,omitempty"`
+	MaxCrossClusterTaskLag  int64            `protobuf:"varint,8,opt,name=max_cross_cluster_task_lag,json=maxCrossClusterTaskLag,proto3" json:"max_cross_cluster_task_lag,omitempty"`
+	// Set while a graceful failover of the domain is waiting for failover markers.
+	GracefulFailoverEndTime *types.Timestamp `protobuf:"bytes,9,opt,name=graceful_failover_end_time,json=gracefulFailoverEndTime,proto3" json:"graceful_failover_end_time,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}         `json:"-"`
+	XXX_unrecognized        []byte           `json:"-"`
+	XXX_sizecache           int32            `json:"-"`
+}
+
+func (m *ReplicationDomainStatus) Reset()         { *m = ReplicationDomainStatus{} }
+func (m *ReplicationDomainStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplicationDomainStatus) ProtoMessage()    {}
+func (*ReplicationDomainStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00df2ec6c2eaefe5, []int{12}
+}
+func (m *ReplicationDomainStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationDomainStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationDomainStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicationDomainStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationDomainStatus.Merge(m, src)
+}
+func (m *ReplicationDomainStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationDomainStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationDomainStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationDomainStatus proto.InternalMessageInfo
+
+func (m *ReplicationDomainStatus) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ReplicationDomainStatus) GetActiveClusterName() string {
+	if m != nil {
+		return m.ActiveClusterName
+	}
+	return ""
+}
+
+func (m *ReplicationDomainStatus) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
+func (m *ReplicationDomainStatus) GetMaxOutboundTaskLag() int64 {
+	if m != nil {
+		return m.MaxOutboundTaskLag
+	}
+	return 0
+}
+
+func (m *ReplicationDomainStatus) GetOldestOutboundFetchTime() *types.Timestamp {
+	if m != nil {
+		return m.OldestOutboundFetchTime
+	}
+	return nil
+}
+
+func (m *ReplicationDomainStatus) GetOldestInboundFetchTime() *types.Timestamp {
+	if m != nil {
+		return m.OldestInboundFetchTime
+	}
+	return nil
+}
+
+func (m *ReplicationDomainStatus) GetInboundDlqSize() int64 {
+	if m != nil {
+		return m.InboundDlqSize
+	}
+	return 0
+}
+
+func (m *ReplicationDomainStatus) GetMaxCrossClusterTaskLag() int64 {
+	if m != nil {
+		return m.MaxCrossClusterTaskLag
+	}
+	return 0
+}
+
+func (m *ReplicationDomainStatus) GetGracefulFailoverEndTime() *types.Timestamp {
+	if m != nil {
+		return m.GracefulFailoverEndTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("uber.cadence.shared.v1.ReplicationTaskType", ReplicationTaskType_name, ReplicationTaskType_value)
 	proto.RegisterEnum("uber.cadence.shared.v1.DomainOperation", DomainOperation_name, DomainOperation_value)
@@ -1102,6 +1357,8 @@ func init() {
 	proto.RegisterType((*ReplicationTaskInfo)(nil), "uber.cadence.shared.v1.ReplicationTaskInfo")
 	proto.RegisterType((*ReplicationToken)(nil), "uber.cadence.shared.v1.ReplicationToken")
 	proto.RegisterType((*SyncShardStatus)(nil), "uber.cadence.shared.v1.SyncShardStatus")
+	proto.RegisterType((*ReplicationShardStatus)(nil), "uber.cadence.shared.v1.ReplicationShardStatus")
+	proto.RegisterType((*ReplicationDomainStatus)(nil), "uber.cadence.shared.v1.ReplicationDomainStatus")
 }
 
 func init() {
@@ -1109,102 +1366,124 @@ func init() {
 }
 
 var fileDescriptor_00df2ec6c2eaefe5 = []byte{
-	// 1506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x72, 0x1a, 0x49,
-	0x12, 0x76, 0x83, 0xf8, 0x51, 0x0a, 0x01, 0x2a, 0x69, 0x2d, 0x2c, 0x59, 0x5e, 0xcc, 0xca, 0x96,
-	0x2c, 0x6f, 0x80, 0x2d, 0x87, 0x37, 0xf6, 0x27, 0x36, 0x1c, 0x6d, 0x81, 0x42, 0xbd, 0xd6, 0x9f,
-	0x8b, 0xb6, 0x1c, 0xda, 0xc3, 0x74, 0xb4, 0xe8, 0x92, 0xe8, 0x10, 0x74, 0x13, 0x5d, 0x05, 0x32,
-	0xc7, 0x99, 0xfb, 0x1c, 0x67, 0x2e, 0x73, 0x9c, 0xe7, 0x98, 0xeb, 0xc4, 0x1c, 0xfd, 0x08, 0x13,
-	0x7e, 0x92, 0x89, 0xae, 0xaa, 0x06, 0x1a, 0x1a, 0x2c, 0x87, 0x0f, 0x73, 0x53, 0x67, 0x7e, 0x5f,
-	0x66, 0x56, 0x66, 0x56, 0x66, 0x09, 0xd8, 0xee, 0x5e, 0x10, 0xaf, 0xd2, 0x30, 0x2d, 0xe2, 0x34,
-	0x48, 0x85, 0x36, 0x4d, 0x8f, 0x58, 0x95, 0xde, 0xf3, 0x8a, 0x47, 0x3a, 0x2d, 0xbb, 0x61, 0x32,
-	0xdb, 0x75, 0xca, 0x1d, 0xcf, 0x65, 0x2e, 0xba, 0xeb, 0x23, 0xcb, 0x12, 0x59, 0x16, 0xc8, 0x72,
-	0xef, 0xf9, 0xda, 0x5f, 0xaf, 0x5c, 0xf7, 0xaa, 0x45, 0x2a, 0x1c, 0x75, 0xd1, 0xbd, 0xac, 0x30,
-	0xbb, 0x4d, 0x28, 0x33, 0xdb, 0x1d, 0x41, 0x5c, 0x2b, 0x86, 0x5c, 0x98, 0x1d, 0xdb, 0xb7, 0xdf,
-	0x70, 0xdb, 0xed, 0xc0, 0x74, 0x34, 0xc2, 0x72, 0xdb, 0xa6, 0x1d, 0x20, 0x36, 0xa7, 0x84, 0xd9,
-	0xb4, 0x29, 0x73, 0xbd, 0xbe, 0x40, 0x95, 0x7e, 0x8c, 0xc1, 0x32, 0x1e, 0x06, 0x7e, 0x44, 0x28,
-	0x35, 0xaf, 0x08, 0x45, 0x3a, 0x2c, 0x8d, 0x9c, 0xc7, 0x60, 0x26, 0xbd, 0xa6, 0x05, 0xa5, 0x18,
-	0xdf, 0x5e, 0xd8, 0xdd, 0x2a, 0x47, 0x1f, 0xab, 0x3c, 0x62, 0x47, 0x37, 0xe9, 0x35, 0xce, 0x7b,
-	0x61, 0x01, 0x45, 0xff, 0x82, 0x7b, 0x2d, 0x93, 0x32, 0xc3, 0x23, 0xcc, 0xb3, 0x49, 0x8f, 0x58,
-	0x46, 0x5b, 0x38, 0x34, 0x6c, 0xab, 0x10, 0x2b, 0x2a, 0xdb, 0x71, 0x7c, 0xd7, 0x07, 0xe0, 0x40,
-	0x2f, 0xe3, 0xd1, 0x2c, 0x74, 0x0f, 0xd2, 0x4d, 0x93, 0x1a, 0x6d, 0xd7, 0x23, 0x85, 0x78, 0x51,
-	0xd9, 0x4e, 0xe3, 0x54, 0xd3, 0xa4, 0x47, 0xae, 0x47, 0x50, 0x1d, 0x96, 0x68, 0xdf, 0x69, 0x18,
-	0x7e, 0x24, 0x96, 0x41, 0x99, 0xc9, 0xba, 0xb4, 0x30, 0x57, 0x54, 0x66, 0xc5, 0x5a, 0xef, 0x3b,
-	0x8d, 0xba, 0x8f, 0xaf, 0x73, 0x38, 0xce, 0xd1, 0xb0, 0xa0, 0xf4, 0x43, 0x12, 0x72, 0x63, 0x07,
-	0x42, 0x07, 0x30, 0xef, 0x27, 0xc2, 0x60, 0xfd, 0x0e, 0x29, 0x28, 0x45, 0x65, 0x3b, 0xbb, 0xfb,
-	0xf4, 0x96, 0xc9, 0xd0, 0xfb, 0x1d, 0x82, 0xd3, 0x4c, 0xfe, 0x85, 0x36, 0x21, 0x4b, 0xdd, 0xae,
-	0xd7, 0x20, 0x3c, 0xb3, 0xc3, 0xd3, 0x67, 0x84, 0xd4, 0x67, 0x68, 0x16, 0x7a, 0x05, 0x8b, 0x0d,
-	0x8f, 0xc8, 0x0a, 0xd8, 0x6d, 0x71, 0xf0, 0x85, 0xdd, 0xb5, 0xb2, 0xe8, 0x9f, 0x72, 0xd0, 0x3f,
-	0x65, 0x3d, 0xe8, 0x1f, 0x9c, 0x09, 0x08, 0xbe, 0x08, 0x59, 0x70, 0x57, 0xf4, 0x84, 0x70, 0x63,
-	0x32, 0xe6, 0xd9, 0x17, 0x5d, 0x46, 0x82, 0xf4, 0xfc, 0x7d, 0x5a, 0xf4, 0x55, 0xce, 0xf2, 0xc3,
-	0x50, 0x07, 0x9c, 0x83, 0x3b, 0x78, 0xc5, 0x8a, 0x90, 0xa3, 0x6f, 0x15, 0x78, 0x38, 0x51, 0x80,
-	0x09, 0x8f, 0x09, 0xee, 0xf1, 0xe5, 0x2d, 0x0b, 0x32, 0xe1, 0x7a, 0x83, 0xce, 0x02, 0xa0, 0x1b,
-	0xe0, 0x00, 0xc3, 0x6c, 0x30, 0xbb, 0x67, 0xb3, 0xfe, 0x84, 0xfb, 0x24, 0x77, 0xbf, 0x3b, 0xcb,
-	0xbd, 0x2a, 0xb9, 0x13, 0xbe, 0xd7, 0xe8, 0x54, 0x2d, 0x72, 0x60, 0x4d, 0xde, 0x28, 0xe1, 0xb2,
-	0xb7, 0x3b, 0xea, 0x35, 0xc5, 0xbd, 0x56, 0xa6, 0x79, 0x3d, 0x10, 0x4c, 0xdf, 0xe4, 0xd9, 0x6e,
-	0xc8, 0xe5, 0x6a, 0x33, 0x5a, 0x85, 0x3a, 0xb0, 0x76, 0x69, 0xda, 0x2d, 0xb7, 0x47, 0x3c, 0xa3,
-	0x6d, 0x7a, 0xd7, 0xc4, 0x1b, 0xf5, 0x97, 0xe6, 0xfe, 0x9e, 0x4d, 0xf3, 0xb7, 0x2f, 0x99, 0x47,
-	0x9c, 0x18, 0x72, 0x58, 0xb8, 0x9c, 0xa2, 0x7b, 0x9d, 0x01, 0x18, 0x7a, 0x28, 0xfd, 0x12, 0x83,
-	0x95, 0xa8, 0xee, 0x40, 0x18, 0xf2, 0xb2, 0xd7, 0xdc, 0x0e, 0xf1, 0x78, 0x0f, 0xca, 0x3b, 0xb2,
-	0x35, 0xbb, 0xcb, 0x4e, 0x02, 0x38, 0xce, 0x59, 0x61, 0x01, 0xca, 0x42, 0x4c, 0x5e, 0x8d, 0x79,
-	0x1c, 0xb3, 0x2d, 0xf4, 0x02, 0x92, 0x02, 0x22, 0x6f, 0xc2, 0x7a, 0xd8, 0xb2, 0xd9, 0xb1, 0x87,
-	0x66, 0xb1, 0x84, 0xa2, 0x47, 0x90, 0x6d, 0xb8, 0xce, 0xa5, 0x7d, 0x65, 0xf4, 0x88, 0x47, 0xfd,
-	0xb0, 0xe6, 0xf8, 0x5d, 0x5b, 0x14, 0xd2, 0x33, 0x21, 0x44, 0x4f, 0x20, 0x3f, 0x48, 0x6c, 0x00,
-	0x4c, 0x70, 0x60, 0x2e, 0x90, 0x07, 0xd0, 0x7f, 0xc3, 0xbd, 0x8e, 0x47, 0x7a, 0xb6, 0xdb, 0xa5,
-	0xc6, 0x04, 0x27, 0xc9, 0x39, 0xab, 0x01, 0x60, 0x3f, 0xcc, 0x2d, 0xfd, 0xa4, 0xc0, 0xc6, 0xcc,
-	0x5e, 0xf7, 0xe3, 0x95, 0xb3, 0xa1, 0xd1, 0xea, 0x52, 0x46, 0x3c, 0x9e, 0xc6, 0x79, 0xbc, 0x28,
-	0xa4, 0x7b, 0x42, 0xe8, 0x0f, 0x44, 0x71, 0xdf, 0x64, 0x86, 0x12, 0x38, 0xc5, 0xbf, 0x35, 0x0b,
-	0xfd, 0x13, 0xe6, 0x07, 0x1b, 0xe5, 0x16, 0x33, 0x63, 0x08, 0x2e, 0x7d, 0x4c, 0xc0, 0xda, 0xf4,
-	0xab, 0x80, 0xd6, 0x61, 0x5e, 0xd6, 0xd8, 0xb6, 0x64, 0x54, 0x69, 0x21, 0xd0, 0x2c, 0xf4, 0x0e,
-	0xd0, 0x8d, 0xeb, 0x5d, 0x5f, 0xb6, 0xdc, 0x1b, 0x83, 0x7c, 0x20, 0x8d, 0x2e, 0x6f, 0x81, 0x18,
-	0x77, 0xff, 0x38, 0xb2, 0x50, 0xef, 0x25, 0xbc, 0x16, 0xa0, 0xf1, 0xd2, 0xcd, 0xb8, 0x08, 0x15,
-	0x20, 0x15, 0xa4, 0x36, 0xce, 0x53, 0x1b, 0x7c, 0xa2, 0x87, 0x90, 0xa1, 0x8d, 0x26, 0xb1, 0xba,
-	0x2d, 0xc2, 0xb3, 0x20, 0xca, 0xba, 0x30, 0x90, 0x69, 0x16, 0x52, 0x21, 0x3b, 0x84, 0xf0, 0x11,
-	0x9a, 0xf8, 0x6c, 0x3a, 0x16, 0x07, 0x0c, 0x3e, 0x43, 0x37, 0x00, 0x28, 0x33, 0x3d, 0x26, 0x7c,
-	0x88, 0xea, 0xce, 0x4b, 0x89, 0x66, 0xa1, 0xff, 0x42, 0x26, 0x50, 0x73, 0xfb, 0xa9, 0xcf, 0xda,
-	0x5f, 0x90, 0x78, 0x6e, 0xfd, 0x7f, 0xb0, 0xcc, 0x37, 0x62, 0x93, 0x98, 0x1e, 0xbb, 0x20, 0x26,
-	0x13, 0x56, 0xd2, 0x9f, 0xb5, 0xb2, 0xe4, 0xd3, 0x0e, 0x02, 0x16, 0xb7, 0xf5, 0x0f, 0x48, 0x59,
-	0x84, 0x99, 0x76, 0x8b, 0x16, 0xe6, 0x39, 0xff, 0x7e, 0x64, 0xd6, 0x4f, 0xcd, 0x7e, 0xcb, 0x35,
-	0x2d, 0x1c, 0x80, 0xfd, 0x0c, 0x9b, 0x8c, 0x91, 0x76, 0x87, 0x15, 0x40, 0x34, 0x92, 0xfc, 0x44,
-	0xaf, 0x20, 0xc3, 0xa3, 0xf3, 0x9b, 0xbc, 0xeb, 0x91, 0xc2, 0xc2, 0x0c, 0xb3, 0xfb, 0x02, 0x83,
-	0x17, 0x7c, 0x86, 0xfc, 0x40, 0xcf, 0x60, 0x85, 0x1b, 0xf0, 0xcb, 0x4a, 0x3c, 0xc3, 0xb6, 0x88,
-	0xc3, 0x6c, 0xd6, 0x2f, 0x64, 0x78, 0xef, 0x20, 0x5f, 0xf7, 0x9e, 0xab, 0x34, 0xa9, 0x41, 0x27,
-	0x90, 0x93, 0xf5, 0x35, 0xe4, 0x08, 0x2c, 0x2c, 0x46, 0xb5, 0xd0, 0x70, 0x8a, 0xc8, 0x9b, 0x25,
-	0x67, 0x29, 0xce, 0xf6, 0x42, 0xdf, 0xa5, 0xef, 0xe2, 0xb0, 0x3a, 0x65, 0xce, 0xa2, 0x55, 0x48,
-	0x05, 0xfb, 0x57, 0xe1, 0x85, 0x4d, 0x32, 0xb1, 0x79, 0x43, 0x8d, 0x1e, 0xbb, 0x55, 0xa3, 0xc7,
-	0xbf, 0xb6, 0xd1, 0xbf, 0x81, 0xbf, 0x8c, 0x9d, 0xdc, 0xb0, 0x19, 0x69, 0xfb, 0xbb, 0xda, 0x7f,
-	0x76, 0xed, 0xdc, 0xee, 0xfc, 0x1a, 0x23, 0x6d, 0xbc, 0xdc, 0x9b, 0x90, 0x51, 0xf4, 0x12, 0x92,
-	0xa4, 0x47, 0x1c, 0x16, 0xac, 0xe2, 0x8d, 0xe8, 0xe1, 0x69, 0x32, 0xf3, 0x75, 0xcb, 0xbd, 0xc0,
-	0x12, 0x8c, 0xf6, 0x20, 0xeb, 0x90, 0x1b, 0xc3, 0xeb, 0x3a, 0x86, 0xa4, 0x27, 0x6f, 0x43, 0xcf,
-	0x38, 0xe4, 0x06, 0x77, 0x9d, 0x1a, 0xa7, 0x94, 0x7e, 0x56, 0xa0, 0x30, 0x6d, 0xf9, 0xcc, 0x9e,
-	0x2a, 0x51, 0x63, 0x39, 0x16, 0x3d, 0x96, 0xbf, 0xf6, 0xb9, 0x54, 0xfa, 0x5e, 0x81, 0xe5, 0x70,
-	0x94, 0xba, 0x7b, 0x4d, 0x1c, 0x3f, 0xc0, 0x60, 0xd4, 0x8a, 0x47, 0x70, 0x02, 0xa7, 0xe5, 0xac,
-	0xa5, 0xe8, 0x1c, 0x72, 0x63, 0x0b, 0x59, 0xce, 0xbc, 0x2f, 0xde, 0xc2, 0x38, 0x1b, 0xde, 0xc1,
-	0xa5, 0x5f, 0xc3, 0x8f, 0x73, 0xfe, 0x2a, 0x74, 0x2e, 0xdd, 0x3f, 0x65, 0x0c, 0xaf, 0x8f, 0xbe,
-	0x7d, 0xe3, 0x7c, 0x4c, 0x0c, 0x9f, 0xb3, 0x23, 0xf7, 0x68, 0x2e, 0x74, 0x8f, 0x46, 0x86, 0x77,
-	0x22, 0x3c, 0xbc, 0x37, 0x21, 0x7b, 0x69, 0x7b, 0x94, 0x89, 0xa6, 0x1a, 0x8e, 0xd6, 0x0c, 0x97,
-	0xf2, 0xb6, 0xd1, 0x2c, 0x54, 0x82, 0x45, 0x87, 0x7c, 0x18, 0x01, 0xa5, 0xc4, 0x8c, 0xf7, 0x85,
-	0x01, 0x66, 0x7c, 0x0d, 0xa4, 0x27, 0xd6, 0x80, 0xdf, 0x7e, 0xf9, 0xd1, 0x44, 0xf2, 0xaa, 0x8e,
-	0x2e, 0x50, 0x25, 0xbc, 0x40, 0xbf, 0xe2, 0xff, 0x94, 0x80, 0xda, 0xf1, 0xdc, 0x06, 0xa1, 0x34,
-	0x4c, 0x8d, 0x0f, 0xa9, 0xa7, 0x81, 0x7e, 0x40, 0x2d, 0xbd, 0x81, 0xdc, 0xd8, 0xcb, 0x20, 0xbc,
-	0xc9, 0x95, 0x2f, 0xd8, 0xe4, 0x3b, 0x1f, 0x27, 0x7b, 0x87, 0x97, 0xea, 0x21, 0x6c, 0xe0, 0xda,
-	0xe9, 0xa1, 0xb6, 0xa7, 0xea, 0xda, 0xc9, 0xb1, 0xa1, 0xab, 0xf5, 0x37, 0x86, 0x7e, 0x7e, 0x5a,
-	0x33, 0xb4, 0xe3, 0x33, 0xf5, 0x50, 0xab, 0xe6, 0xef, 0xa0, 0x22, 0xdc, 0x8f, 0x86, 0x54, 0x4f,
-	0x8e, 0x54, 0xed, 0x38, 0xaf, 0x4c, 0x37, 0x72, 0xa0, 0xd5, 0xf5, 0x13, 0x7c, 0x9e, 0x8f, 0xa1,
-	0xa7, 0xb0, 0x15, 0x0d, 0xa9, 0x9f, 0x1f, 0xef, 0x19, 0xf5, 0x03, 0x15, 0x57, 0x8d, 0xba, 0xae,
-	0xea, 0xef, 0xea, 0xf9, 0x38, 0xda, 0x82, 0xbf, 0xcd, 0x00, 0xab, 0x7b, 0xba, 0x76, 0xa6, 0xe9,
-	0xe7, 0xf9, 0x39, 0xb4, 0x03, 0x8f, 0x67, 0x3a, 0x36, 0x8e, 0x6a, 0xba, 0x5a, 0x55, 0x75, 0x35,
-	0x9f, 0x40, 0x9b, 0x50, 0x9c, 0x8d, 0x3d, 0xdb, 0xcd, 0x27, 0xd1, 0x13, 0x78, 0x14, 0x8d, 0xda,
-	0x57, 0xb5, 0xc3, 0x93, 0xb3, 0x1a, 0x36, 0x8e, 0x54, 0xfc, 0xa6, 0x86, 0xf3, 0xa9, 0x1d, 0x1b,
-	0x72, 0x63, 0x2f, 0x56, 0x74, 0x1f, 0x0a, 0x22, 0x29, 0xc6, 0xc9, 0x69, 0x0d, 0x0b, 0x13, 0xc3,
-	0x44, 0xae, 0xc3, 0xea, 0x84, 0x76, 0x0f, 0xd7, 0x54, 0xbd, 0x96, 0x57, 0x22, 0x95, 0xef, 0x4e,
-	0xab, 0xbe, 0x32, 0xb6, 0x73, 0x0c, 0xa9, 0xea, 0xe1, 0x5b, 0x5e, 0xb0, 0x15, 0xc8, 0x57, 0x0f,
-	0xdf, 0x8e, 0xd7, 0xa8, 0x00, 0x2b, 0x03, 0xe9, 0x48, 0xfc, 0x79, 0x05, 0x2d, 0x43, 0x6e, 0xa0,
-	0x91, 0x05, 0x8b, 0xbd, 0xde, 0xfb, 0xed, 0xd3, 0x03, 0xe5, 0xe3, 0xa7, 0x07, 0xca, 0xef, 0x9f,
-	0x1e, 0x28, 0xff, 0x7f, 0x79, 0x65, 0xb3, 0x66, 0xf7, 0xa2, 0xdc, 0x70, 0xdb, 0x95, 0xd0, 0xaf,
-	0x04, 0xe5, 0x2b, 0xe2, 0x88, 0x5f, 0x25, 0x86, 0x3f, 0x18, 0xfc, 0x47, 0xfc, 0xd5, 0x7b, 0x7e,
-	0x91, 0xe4, 0x9a, 0x17, 0x7f, 0x04, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x16, 0xd1, 0xe8, 0x01, 0x11,
-	0x00, 0x00,
+	// 1864 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x53, 0xe3, 0xc8,
+	0x15, 0x5f, 0xd9, 0x60, 0xc3, 0xc3, 0x18, 0xd3, 0x30, 0xa0, 0x61, 0x86, 0x09, 0xe3, 0xcc, 0xee,
+	0xb0, 0x6c, 0xca, 0x2c, 0x4c, 0x4d, 0x3e, 0x36, 0x95, 0xda, 0xf2, 0x80, 0x29, 0x94, 0xe1, 0x6b,
+	0x64, 0x0f, 0x53, 0xe4, 0x10, 0x55, 0x23, 0xb5, 0x8d, 0x0a, 0x4b, 0xf2, 0xaa, 0xdb, 0x06, 0xf6,
+	0x96, 0xdc, 0x73, 0x4c, 0x2e, 0x39, 0xa6, 0x2a, 0xff, 0x45, 0xae, 0xa9, 0x1c, 0xf7, 0x92, 0x7b,
+	0x6a, 0x4e, 0xf9, 0x33, 0x52, 0xfd, 0x21, 0x5b, 0xb2, 0x65, 0x43, 0x32, 0x87, 0xdc, 0xac, 0xf7,
+	0x7e, 0xef, 0x43, 0xef, 0xfd, 0xfa, 0xbd, 0x96, 0x61, 0xb3, 0x7b, 0x49, 0xc2, 0x6d, 0x1b, 0x3b,
+	0xc4, 0xb7, 0xc9, 0x36, 0xbd, 0xc2, 0x21, 0x71, 0xb6, 0x7b, 0x3b, 0xdb, 0x21, 0xe9, 0xb4, 0x5d,
+	0x1b, 0x33, 0x37, 0xf0, 0x2b, 0x9d, 0x30, 0x60, 0x01, 0x5a, 0xe1, 0xc8, 0x8a, 0x42, 0x56, 0x24,
+	0xb2, 0xd2, 0xdb, 0x59, 0xfb, 0x51, 0x2b, 0x08, 0x5a, 0x6d, 0xb2, 0x2d, 0x50, 0x97, 0xdd, 0xe6,
+	0x36, 0x73, 0x3d, 0x42, 0x19, 0xf6, 0x3a, 0xd2, 0x70, 0x6d, 0x23, 0x11, 0x02, 0x77, 0x5c, 0xee,
+	0xdf, 0x0e, 0x3c, 0x2f, 0xf0, 0x27, 0x21, 0x9c, 0xc0, 0xc3, 0x6e, 0x84, 0x78, 0x31, 0x26, 0xcd,
+	0x2b, 0x97, 0xb2, 0x20, 0xbc, 0x93, 0xa8, 0xf2, 0x9f, 0x32, 0xb0, 0x64, 0x0e, 0x12, 0x3f, 0x26,
+	0x94, 0xe2, 0x16, 0xa1, 0xa8, 0x01, 0x8b, 0xb1, 0xf7, 0xb1, 0x18, 0xa6, 0xd7, 0x54, 0xd7, 0x36,
+	0xb2, 0x9b, 0x73, 0xbb, 0x2f, 0x2b, 0xe9, 0xaf, 0x55, 0x89, 0xf9, 0x69, 0x60, 0x7a, 0x6d, 0x96,
+	0xc2, 0xa4, 0x80, 0xa2, 0x5f, 0xc0, 0xe3, 0x36, 0xa6, 0xcc, 0x0a, 0x09, 0x0b, 0x5d, 0xd2, 0x23,
+	0x8e, 0xe5, 0xc9, 0x80, 0x96, 0xeb, 0xe8, 0x99, 0x0d, 0x6d, 0x33, 0x6b, 0xae, 0x70, 0x80, 0x19,
+	0xe9, 0x55, 0x3e, 0x86, 0x83, 0x1e, 0xc3, 0xcc, 0x15, 0xa6, 0x96, 0x17, 0x84, 0x44, 0xcf, 0x6e,
+	0x68, 0x9b, 0x33, 0x66, 0xfe, 0x0a, 0xd3, 0xe3, 0x20, 0x24, 0xa8, 0x0e, 0x8b, 0xf4, 0xce, 0xb7,
+	0x2d, 0x9e, 0x89, 0x63, 0x51, 0x86, 0x59, 0x97, 0xea, 0x53, 0x1b, 0xda, 0xa4, 0x5c, 0xeb, 0x77,
+	0xbe, 0x5d, 0xe7, 0xf8, 0xba, 0x80, 0x9b, 0x0b, 0x34, 0x29, 0x28, 0xff, 0x31, 0x07, 0x0b, 0x43,
+	0x2f, 0x84, 0x0e, 0x61, 0x96, 0x17, 0xc2, 0x62, 0x77, 0x1d, 0xa2, 0x6b, 0x1b, 0xda, 0x66, 0x71,
+	0xf7, 0xab, 0x07, 0x16, 0xa3, 0x71, 0xd7, 0x21, 0xe6, 0x0c, 0x53, 0xbf, 0xd0, 0x0b, 0x28, 0xd2,
+	0xa0, 0x1b, 0xda, 0x44, 0x54, 0x76, 0xf0, 0xf6, 0x05, 0x29, 0xe5, 0x16, 0x86, 0x83, 0xbe, 0x85,
+	0x79, 0x3b, 0x24, 0xaa, 0x03, 0xae, 0x27, 0x5f, 0x7c, 0x6e, 0x77, 0xad, 0x22, 0xf9, 0x53, 0x89,
+	0xf8, 0x53, 0x69, 0x44, 0xfc, 0x31, 0x0b, 0x91, 0x01, 0x17, 0x21, 0x07, 0x56, 0x24, 0x27, 0x64,
+	0x18, 0xcc, 0x58, 0xe8, 0x5e, 0x76, 0x19, 0x89, 0xca, 0xf3, 0x93, 0x71, 0xd9, 0xef, 0x0b, 0x2b,
+	0x9e, 0x46, 0xb5, 0x6f, 0x73, 0xf8, 0x99, 0xb9, 0xec, 0xa4, 0xc8, 0xd1, 0xef, 0x34, 0x78, 0x3e,
+	0xd2, 0x80, 0x91, 0x88, 0xd3, 0x22, 0xe2, 0xeb, 0x07, 0x36, 0x64, 0x24, 0xf4, 0x3a, 0x9d, 0x04,
+	0x40, 0x37, 0x20, 0x00, 0x16, 0xb6, 0x99, 0xdb, 0x73, 0xd9, 0xdd, 0x48, 0xf8, 0x9c, 0x08, 0xbf,
+	0x3b, 0x29, 0x7c, 0x55, 0xd9, 0x8e, 0xc4, 0x5e, 0xa3, 0x63, 0xb5, 0xc8, 0x87, 0x35, 0x75, 0xa2,
+	0x64, 0xc8, 0xde, 0x6e, 0x3c, 0x6a, 0x5e, 0x44, 0xdd, 0x1e, 0x17, 0xf5, 0x50, 0x5a, 0x72, 0x97,
+	0xe7, 0xbb, 0x89, 0x90, 0xab, 0x57, 0xe9, 0x2a, 0xd4, 0x81, 0xb5, 0x26, 0x76, 0xdb, 0x41, 0x8f,
+	0x84, 0x96, 0x87, 0xc3, 0x6b, 0x12, 0xc6, 0xe3, 0xcd, 0x88, 0x78, 0x5f, 0x8f, 0x8b, 0x77, 0xa0,
+	0x2c, 0x8f, 0x85, 0x61, 0x22, 0xa0, 0xde, 0x1c, 0xa3, 0x7b, 0x53, 0x00, 0x18, 0x44, 0x28, 0xff,
+	0x2d, 0x03, 0xcb, 0x69, 0xec, 0x40, 0x26, 0x94, 0x14, 0xd7, 0x82, 0x0e, 0x09, 0x05, 0x07, 0xd5,
+	0x19, 0x79, 0x39, 0x99, 0x65, 0xa7, 0x11, 0xdc, 0x5c, 0x70, 0x92, 0x02, 0x54, 0x84, 0x8c, 0x3a,
+	0x1a, 0xb3, 0x66, 0xc6, 0x75, 0xd0, 0x2b, 0xc8, 0x49, 0x88, 0x3a, 0x09, 0x4f, 0x92, 0x9e, 0x71,
+	0xc7, 0x1d, 0xb8, 0x35, 0x15, 0x14, 0x7d, 0x0e, 0x45, 0x3b, 0xf0, 0x9b, 0x6e, 0xcb, 0xea, 0x91,
+	0x90, 0xf2, 0xb4, 0xa6, 0xc4, 0x59, 0x9b, 0x97, 0xd2, 0x73, 0x29, 0x44, 0x5f, 0x42, 0xa9, 0x5f,
+	0xd8, 0x08, 0x38, 0x2d, 0x80, 0x0b, 0x91, 0x3c, 0x82, 0x7e, 0x03, 0x8f, 0x3b, 0x21, 0xe9, 0xb9,
+	0x41, 0x97, 0x5a, 0x23, 0x36, 0x39, 0x61, 0xb3, 0x1a, 0x01, 0x0e, 0x92, 0xb6, 0xe5, 0x3f, 0x6b,
+	0xb0, 0x3e, 0x91, 0xeb, 0x3c, 0x5f, 0x35, 0x1b, 0xec, 0x76, 0x97, 0x32, 0x12, 0x8a, 0x32, 0xce,
+	0x9a, 0xf3, 0x52, 0xba, 0x27, 0x85, 0x7c, 0x20, 0xca, 0xf3, 0xa6, 0x2a, 0x34, 0x6d, 0xe6, 0xc5,
+	0xb3, 0xe1, 0xa0, 0x9f, 0xc3, 0x6c, 0x7f, 0xa3, 0x3c, 0x60, 0x66, 0x0c, 0xc0, 0xe5, 0x7f, 0x4f,
+	0xc3, 0xda, 0xf8, 0xa3, 0x80, 0x9e, 0xc0, 0xac, 0xea, 0xb1, 0xeb, 0xa8, 0xac, 0x66, 0xa4, 0xc0,
+	0x70, 0xd0, 0x7b, 0x40, 0x37, 0x41, 0x78, 0xdd, 0x6c, 0x07, 0x37, 0x16, 0xb9, 0x25, 0x76, 0x57,
+	0x50, 0x20, 0x23, 0xc2, 0x7f, 0x91, 0xda, 0xa8, 0x0f, 0x0a, 0x5e, 0x8b, 0xd0, 0xe6, 0xe2, 0xcd,
+	0xb0, 0x08, 0xe9, 0x90, 0x8f, 0x4a, 0x9b, 0x15, 0xa5, 0x8d, 0x1e, 0xd1, 0x73, 0x28, 0x50, 0xfb,
+	0x8a, 0x38, 0xdd, 0x36, 0x11, 0x55, 0x90, 0x6d, 0x9d, 0xeb, 0xcb, 0x0c, 0x07, 0x55, 0xa1, 0x38,
+	0x80, 0x88, 0x11, 0x3a, 0x7d, 0x6f, 0x39, 0xe6, 0xfb, 0x16, 0x5c, 0x86, 0xd6, 0x01, 0x28, 0xc3,
+	0x21, 0x93, 0x31, 0x64, 0x77, 0x67, 0x95, 0xc4, 0x70, 0xd0, 0xaf, 0xa0, 0x10, 0xa9, 0x85, 0xff,
+	0xfc, 0xbd, 0xfe, 0xe7, 0x14, 0x5e, 0x78, 0xff, 0x35, 0x2c, 0x89, 0x8d, 0x78, 0x45, 0x70, 0xc8,
+	0x2e, 0x09, 0x66, 0xd2, 0xcb, 0xcc, 0xbd, 0x5e, 0x16, 0xb9, 0xd9, 0x61, 0x64, 0x25, 0x7c, 0xfd,
+	0x14, 0xf2, 0x0e, 0x61, 0xd8, 0x6d, 0x53, 0x7d, 0x56, 0xd8, 0x3f, 0x4d, 0xad, 0xfa, 0x19, 0xbe,
+	0x6b, 0x07, 0xd8, 0x31, 0x23, 0x30, 0xaf, 0x30, 0x66, 0x8c, 0x78, 0x1d, 0xa6, 0x83, 0x24, 0x92,
+	0x7a, 0x44, 0xdf, 0x42, 0x41, 0x64, 0xc7, 0x49, 0xde, 0x0d, 0x89, 0x3e, 0x37, 0xc1, 0xed, 0x81,
+	0xc4, 0x98, 0x73, 0xdc, 0x42, 0x3d, 0xa0, 0xaf, 0x61, 0x59, 0x38, 0xe0, 0x6d, 0x25, 0xa1, 0xe5,
+	0x3a, 0xc4, 0x67, 0x2e, 0xbb, 0xd3, 0x0b, 0x82, 0x3b, 0x88, 0xeb, 0x3e, 0x08, 0x95, 0xa1, 0x34,
+	0xe8, 0x14, 0x16, 0x54, 0x7f, 0x2d, 0x35, 0x02, 0xf5, 0xf9, 0x34, 0x0a, 0x0d, 0xa6, 0x88, 0x3a,
+	0x59, 0x6a, 0x96, 0x9a, 0xc5, 0x5e, 0xe2, 0x19, 0xad, 0x40, 0xae, 0x83, 0xbb, 0x94, 0x38, 0x7a,
+	0x51, 0x5c, 0x1b, 0xd4, 0x53, 0xf9, 0xf7, 0x59, 0x58, 0x1d, 0x33, 0x7f, 0xd1, 0x2a, 0xe4, 0xa3,
+	0xbd, 0xac, 0x89, 0x86, 0xe7, 0x98, 0xdc, 0xc8, 0x89, 0x03, 0x90, 0x79, 0xd0, 0x01, 0xc8, 0x7e,
+	0xea, 0x01, 0xf8, 0x2d, 0x3c, 0x1a, 0xaa, 0x88, 0xe5, 0x32, 0xe2, 0xf1, 0x1d, 0xce, 0xaf, 0x63,
+	0x5b, 0x0f, 0xab, 0x8b, 0xc1, 0x88, 0x67, 0x2e, 0xf5, 0x46, 0x64, 0x14, 0xbd, 0x86, 0x1c, 0xe9,
+	0x11, 0x9f, 0x45, 0x2b, 0x7a, 0x3d, 0x7d, 0xa8, 0x62, 0x86, 0xdf, 0xb4, 0x83, 0x4b, 0x53, 0x81,
+	0xd1, 0x1e, 0x14, 0x7d, 0x72, 0x63, 0x85, 0x5d, 0xdf, 0x52, 0xe6, 0xb9, 0x87, 0x98, 0x17, 0x7c,
+	0x72, 0x63, 0x76, 0xfd, 0x9a, 0x30, 0x29, 0xff, 0x45, 0x03, 0x7d, 0xdc, 0x52, 0x9a, 0x3c, 0x6d,
+	0xd2, 0xc6, 0x75, 0x26, 0x7d, 0x5c, 0x7f, 0xea, 0x35, 0xaa, 0xfc, 0x07, 0x0d, 0x96, 0x92, 0x59,
+	0x36, 0x82, 0x6b, 0xe2, 0xf3, 0x04, 0xa3, 0x11, 0x2c, 0x2f, 0xc7, 0xd3, 0xe6, 0x8c, 0x9a, 0xc1,
+	0x14, 0x5d, 0xc0, 0xc2, 0xd0, 0xa2, 0xd6, 0x33, 0xff, 0xdb, 0x76, 0x36, 0x8b, 0xc9, 0xdd, 0x5c,
+	0xfe, 0x7b, 0xf2, 0xd2, 0x2e, 0x6e, 0x8b, 0x7e, 0x33, 0xf8, 0xbf, 0x8c, 0xe7, 0x27, 0xf1, 0x3b,
+	0x71, 0x56, 0x8c, 0x8f, 0xc1, 0x35, 0x37, 0x76, 0x8e, 0xa6, 0x12, 0xe7, 0x28, 0x36, 0xd4, 0xa7,
+	0x93, 0x43, 0xfd, 0x05, 0x14, 0x9b, 0x6e, 0x48, 0x99, 0x24, 0xd5, 0x60, 0xe4, 0x16, 0x84, 0x54,
+	0xd0, 0xc6, 0x70, 0x50, 0x19, 0xe6, 0x7d, 0x72, 0x1b, 0x03, 0xe5, 0xe5, 0xec, 0xe7, 0xc2, 0x08,
+	0x33, 0xbc, 0x1e, 0x66, 0x46, 0xd6, 0x03, 0xa7, 0x5f, 0x29, 0x5e, 0x48, 0xd1, 0xd5, 0xf8, 0x62,
+	0xd5, 0x92, 0x8b, 0xf5, 0x13, 0xbe, 0x5f, 0x22, 0xd3, 0x4e, 0x18, 0xd8, 0x84, 0xd2, 0xa4, 0x69,
+	0x76, 0x60, 0x7a, 0x16, 0xe9, 0xfb, 0xa6, 0xe5, 0xb7, 0xb0, 0x30, 0x74, 0x63, 0x48, 0x6e, 0x78,
+	0xed, 0xbf, 0xd9, 0xf0, 0xff, 0x9c, 0x82, 0x95, 0xd8, 0x2b, 0xc7, 0x9d, 0x4e, 0x78, 0xf1, 0xe7,
+	0x50, 0x50, 0x97, 0x11, 0xcb, 0xc7, 0x1e, 0x51, 0xa3, 0x6f, 0x4e, 0xc9, 0x4e, 0xb0, 0x47, 0xd0,
+	0x33, 0x98, 0xf3, 0xf0, 0x6d, 0xff, 0x7b, 0x46, 0xbe, 0xd2, 0xac, 0x87, 0x6f, 0xd5, 0xc7, 0xcc,
+	0xcf, 0x40, 0x0f, 0xba, 0xec, 0x32, 0xe8, 0xfa, 0x8e, 0xd5, 0x24, 0x8c, 0x77, 0xc1, 0x4a, 0x92,
+	0xe3, 0x51, 0xa4, 0x3f, 0x90, 0x6a, 0x65, 0xf8, 0x0a, 0x56, 0xfa, 0x86, 0xd8, 0xbe, 0x8e, 0x99,
+	0x49, 0xea, 0x2c, 0x45, 0xda, 0xaa, 0x7d, 0xdd, 0x37, 0xaa, 0xc7, 0xa2, 0xc9, 0x15, 0xc6, 0x7d,
+	0xca, 0xe3, 0x9f, 0xbb, 0xb7, 0x5e, 0xfd, 0x4c, 0x8e, 0xf8, 0x2e, 0xe3, 0x96, 0x5c, 0x87, 0x5e,
+	0xc3, 0xaa, 0xeb, 0xa7, 0xbf, 0x81, 0xe4, 0xdf, 0xb2, 0x52, 0x27, 0x5f, 0x60, 0x07, 0x1e, 0xb9,
+	0x7e, 0x5a, 0xfe, 0x92, 0x91, 0xc8, 0xf5, 0x47, 0xd2, 0x7f, 0x37, 0x88, 0x34, 0x9c, 0xfd, 0xec,
+	0xbd, 0xd9, 0x47, 0x59, 0x24, 0x93, 0xdf, 0x84, 0x52, 0xe4, 0xd2, 0x69, 0x7f, 0x67, 0x51, 0xf7,
+	0x7b, 0x22, 0xd6, 0x7d, 0xd6, 0x2c, 0x2a, 0xf9, 0x7e, 0xfb, 0xbb, 0xba, 0xfb, 0x3d, 0xe1, 0x05,
+	0xb7, 0xc3, 0x80, 0xd2, 0xe8, 0xfe, 0x29, 0xf3, 0x6d, 0xe3, 0x96, 0xd8, 0xff, 0x59, 0x73, 0x49,
+	0x68, 0xd5, 0x3d, 0x94, 0x27, 0x7c, 0x84, 0x5b, 0xe5, 0xbf, 0x4e, 0xc1, 0x6a, 0x8c, 0x57, 0xf2,
+	0x0e, 0xae, 0x88, 0xb5, 0xd2, 0xbf, 0xb6, 0xcb, 0xa1, 0xa4, 0x9e, 0x50, 0x05, 0x96, 0xc4, 0xf7,
+	0x1a, 0xb1, 0x52, 0xc8, 0xb5, 0x28, 0x55, 0x7b, 0x31, 0x8a, 0x0d, 0xb3, 0x30, 0x3b, 0xca, 0xc2,
+	0x1d, 0x78, 0xc4, 0x59, 0xd8, 0xef, 0x7d, 0x3f, 0x75, 0x49, 0x31, 0xe4, 0xe1, 0xdb, 0x53, 0xa5,
+	0x53, 0x99, 0xa3, 0x0f, 0xb0, 0x16, 0xb4, 0x1d, 0x42, 0x99, 0x95, 0xe4, 0xe7, 0x43, 0xef, 0x8b,
+	0xab, 0xd2, 0xfa, 0x34, 0x4e, 0x5e, 0x51, 0xf1, 0xf7, 0xf0, 0x58, 0x39, 0x76, 0xfd, 0x11, 0xbf,
+	0xf7, 0x93, 0x70, 0x45, 0x1a, 0x1b, 0xfe, 0x90, 0xdb, 0xb4, 0x46, 0xe6, 0x53, 0x1b, 0xf9, 0x0d,
+	0xac, 0xf1, 0x62, 0x8c, 0x69, 0xa6, 0x64, 0xdf, 0x8a, 0x87, 0x6f, 0xf7, 0x46, 0xfb, 0xc9, 0xab,
+	0xd2, 0x0a, 0xb1, 0x4d, 0x9a, 0xdd, 0xf6, 0xe0, 0x1b, 0x87, 0xf8, 0xce, 0x43, 0x49, 0xb8, 0x1a,
+	0x59, 0x47, 0x5b, 0xad, 0xe6, 0x8b, 0x1b, 0xef, 0xd6, 0x0f, 0xa3, 0xcb, 0x4b, 0xec, 0x8a, 0xe7,
+	0xb0, 0x6e, 0xd6, 0xce, 0x8e, 0x8c, 0xbd, 0x6a, 0xc3, 0x38, 0x3d, 0xb1, 0x1a, 0xd5, 0xfa, 0x5b,
+	0xab, 0x71, 0x71, 0x56, 0xb3, 0x8c, 0x93, 0xf3, 0xea, 0x91, 0xb1, 0x5f, 0xfa, 0x0c, 0x6d, 0xc0,
+	0xd3, 0x74, 0xc8, 0xfe, 0xe9, 0x71, 0xd5, 0x38, 0x29, 0x69, 0xe3, 0x9d, 0x1c, 0x1a, 0xf5, 0xc6,
+	0xa9, 0x79, 0x51, 0xca, 0xa0, 0xaf, 0xe0, 0x65, 0x3a, 0xa4, 0x7e, 0x71, 0xb2, 0x67, 0xd5, 0x0f,
+	0xab, 0xe6, 0xbe, 0x55, 0x6f, 0x54, 0x1b, 0xef, 0xeb, 0xa5, 0x2c, 0x7a, 0x09, 0x3f, 0x9e, 0x00,
+	0xae, 0xee, 0x35, 0x8c, 0x73, 0xa3, 0x71, 0x51, 0x9a, 0x42, 0x5b, 0xf0, 0xc5, 0xc4, 0xc0, 0xd6,
+	0x71, 0xad, 0x51, 0xdd, 0xaf, 0x36, 0xaa, 0xa5, 0x69, 0xf4, 0x02, 0x36, 0x26, 0x63, 0xcf, 0x77,
+	0x4b, 0x39, 0xf4, 0x25, 0x7c, 0x9e, 0x8e, 0x3a, 0xa8, 0x1a, 0x47, 0xa7, 0xe7, 0x35, 0xd3, 0x3a,
+	0xae, 0x9a, 0x6f, 0x6b, 0x66, 0x29, 0xbf, 0xe5, 0xc2, 0xc2, 0xd0, 0xa7, 0x34, 0x7a, 0x0a, 0xba,
+	0x2c, 0x8a, 0x75, 0x7a, 0x56, 0x33, 0xa5, 0x8b, 0x41, 0x21, 0x9f, 0xc0, 0xea, 0x88, 0x76, 0xcf,
+	0xac, 0x55, 0x1b, 0xb5, 0x92, 0x96, 0xaa, 0x7c, 0x7f, 0xb6, 0xcf, 0x95, 0x99, 0xad, 0x13, 0xc8,
+	0xef, 0x1f, 0xbd, 0x13, 0x0d, 0x5b, 0x86, 0xd2, 0xfe, 0xd1, 0xbb, 0xe1, 0x1e, 0xe9, 0xb0, 0xdc,
+	0x97, 0xc6, 0xf2, 0x2f, 0x69, 0x68, 0x09, 0x16, 0xfa, 0x1a, 0xd5, 0xb0, 0xcc, 0x9b, 0xbd, 0x7f,
+	0x7c, 0x7c, 0xa6, 0xfd, 0xf0, 0xf1, 0x99, 0xf6, 0xaf, 0x8f, 0xcf, 0xb4, 0xdf, 0xbc, 0x6e, 0xb9,
+	0xec, 0xaa, 0x7b, 0x59, 0xb1, 0x03, 0x6f, 0x3b, 0xf1, 0xf7, 0x65, 0xa5, 0x45, 0x7c, 0xf9, 0x77,
+	0xe9, 0xe0, 0x9f, 0xcc, 0x5f, 0xca, 0x5f, 0xbd, 0x9d, 0xcb, 0x9c, 0xd0, 0xbc, 0xfa, 0xcf, 0x00,
+	0x6a, 0xff, 0xcd, 0x24, 0x9a, 0x15, 0x00, 0x00,
 }
 
 func (m *ReplicationMessages) Marshal() (dAtA []byte, err error) {
@@ -1579,6 +1858,16 @@ func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.VersionHistory != nil {
 		{
 			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
@@ -2055,73 +2344,270 @@ func (m *SyncShardStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintReplication(dAtA []byte, offset int, v uint64) int {
-	offset -= sovReplication(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ReplicationShardStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ReplicationMessages) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ReplicationShardStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationShardStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ReplicationTasks) > 0 {
-		for _, e := range m.ReplicationTasks {
-			l = e.Size()
-			n += 1 + l + sovReplication(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.LastRetrievedMessageId != 0 {
-		n += 1 + sovReplication(uint64(m.LastRetrievedMessageId))
+	if m.CrossClusterTaskLag != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.CrossClusterTaskLag))
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.HasMore {
-		n += 2
+	if m.InboundDlqSize != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.InboundDlqSize))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.SyncShardStatus != nil {
-		l = m.SyncShardStatus.Size()
-		n += 1 + l + sovReplication(uint64(l))
+	if m.InboundLastFetchTime != nil {
+		{
+			size, err := m.InboundLastFetchTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.InboundAckedTaskId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.InboundAckedTaskId))
+		i--
+		dAtA[i] = 0x40
 	}
-	return n
-}
-
-func (m *ReplicationTask) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.InboundFetchedTaskId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.InboundFetchedTaskId))
+		i--
+		dAtA[i] = 0x38
 	}
-	var l int
-	_ = l
-	if m.TaskType != 0 {
-		n += 1 + sovReplication(uint64(m.TaskType))
+	if m.OutboundLastFetchTime != nil {
+		{
+			size, err := m.OutboundLastFetchTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.SourceTaskId != 0 {
-		n += 1 + sovReplication(uint64(m.SourceTaskId))
+	if m.OutboundAckedTaskId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.OutboundAckedTaskId))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.CreationTime != nil {
-		l = m.CreationTime.Size()
-		n += 1 + l + sovReplication(uint64(l))
+	if m.OutboundFetchedTaskId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.OutboundFetchedTaskId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Attributes != nil {
-		n += m.Attributes.Size()
+	if m.MaxTaskId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.MaxTaskId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.ShardId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ReplicationTask_DomainTaskAttributes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReplicationDomainStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationDomainStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationDomainStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.GracefulFailoverEndTime != nil {
+		{
+			size, err := m.GracefulFailoverEndTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaxCrossClusterTaskLag != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.MaxCrossClusterTaskLag))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.InboundDlqSize != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.InboundDlqSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OldestInboundFetchTime != nil {
+		{
+			size, err := m.OldestInboundFetchTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.OldestOutboundFetchTime != nil {
+		{
+			size, err := m.OldestOutboundFetchTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxOutboundTaskLag != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.MaxOutboundTaskLag))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ActiveClusterName) > 0 {
+		i -= len(m.ActiveClusterName)
+		copy(dAtA[i:], m.ActiveClusterName)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.ActiveClusterName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReplication(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReplication(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReplicationMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReplicationTasks) > 0 {
+		for _, e := range m.ReplicationTasks {
+			l = e.Size()
+			n += 1 + l + sovReplication(uint64(l))
+		}
+	}
+	if m.LastRetrievedMessageId != 0 {
+		n += 1 + sovReplication(uint64(m.LastRetrievedMessageId))
+	}
+	if m.HasMore {
+		n += 2
+	}
+	if m.SyncShardStatus != nil {
+		l = m.SyncShardStatus.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskType != 0 {
+		n += 1 + sovReplication(uint64(m.TaskType))
+	}
+	if m.SourceTaskId != 0 {
+		n += 1 + sovReplication(uint64(m.SourceTaskId))
+	}
+	if m.CreationTime != nil {
+		l = m.CreationTime.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.Attributes != nil {
+		n += m.Attributes.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationTask_DomainTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -2288,6 +2774,9 @@ func (m *SyncActivityTaskAttributes) Size() (n int) {
 		l = m.VersionHistory.Size()
 		n += 1 + l + sovReplication(uint64(l))
 	}
+	if m.Paused {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2452,6 +2941,99 @@ func (m *SyncShardStatus) Size() (n int) {
 	return n
 }
 
+func (m *ReplicationShardStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovReplication(uint64(m.ShardId))
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.MaxTaskId != 0 {
+		n += 1 + sovReplication(uint64(m.MaxTaskId))
+	}
+	if m.OutboundFetchedTaskId != 0 {
+		n += 1 + sovReplication(uint64(m.OutboundFetchedTaskId))
+	}
+	if m.OutboundAckedTaskId != 0 {
+		n += 1 + sovReplication(uint64(m.OutboundAckedTaskId))
+	}
+	if m.OutboundLastFetchTime != nil {
+		l = m.OutboundLastFetchTime.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.InboundFetchedTaskId != 0 {
+		n += 1 + sovReplication(uint64(m.InboundFetchedTaskId))
+	}
+	if m.InboundAckedTaskId != 0 {
+		n += 1 + sovReplication(uint64(m.InboundAckedTaskId))
+	}
+	if m.InboundLastFetchTime != nil {
+		l = m.InboundLastFetchTime.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.InboundDlqSize != 0 {
+		n += 1 + sovReplication(uint64(m.InboundDlqSize))
+	}
+	if m.CrossClusterTaskLag != 0 {
+		n += 1 + sovReplication(uint64(m.CrossClusterTaskLag))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationDomainStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	l = len(m.ActiveClusterName)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.MaxOutboundTaskLag != 0 {
+		n += 1 + sovReplication(uint64(m.MaxOutboundTaskLag))
+	}
+	if m.OldestOutboundFetchTime != nil {
+		l = m.OldestOutboundFetchTime.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.OldestInboundFetchTime != nil {
+		l = m.OldestInboundFetchTime.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.InboundDlqSize != 0 {
+		n += 1 + sovReplication(uint64(m.InboundDlqSize))
+	}
+	if m.MaxCrossClusterTaskLag != 0 {
+		n += 1 + sovReplication(uint64(m.MaxCrossClusterTaskLag))
+	}
+	if m.GracefulFailoverEndTime != nil {
+		l = m.GracefulFailoverEndTime.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovReplication(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3684,6 +4266,26 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplication(dAtA[iNdEx:])
@@ -4700,6 +5302,631 @@ func (m *SyncShardStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReplicationShardStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationShardStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationShardStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskId", wireType)
+			}
+			m.MaxTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundFetchedTaskId", wireType)
+			}
+			m.OutboundFetchedTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundFetchedTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundAckedTaskId", wireType)
+			}
+			m.OutboundAckedTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundAckedTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundLastFetchTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutboundLastFetchTime == nil {
+				m.OutboundLastFetchTime = &types.Timestamp{}
+			}
+			if err := m.OutboundLastFetchTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundFetchedTaskId", wireType)
+			}
+			m.InboundFetchedTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundFetchedTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundAckedTaskId", wireType)
+			}
+			m.InboundAckedTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundAckedTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundLastFetchTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InboundLastFetchTime == nil {
+				m.InboundLastFetchTime = &types.Timestamp{}
+			}
+			if err := m.InboundLastFetchTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundDlqSize", wireType)
+			}
+			m.InboundDlqSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundDlqSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossClusterTaskLag", wireType)
+			}
+			m.CrossClusterTaskLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CrossClusterTaskLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationDomainStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationDomainStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationDomainStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutboundTaskLag", wireType)
+			}
+			m.MaxOutboundTaskLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOutboundTaskLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestOutboundFetchTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldestOutboundFetchTime == nil {
+				m.OldestOutboundFetchTime = &types.Timestamp{}
+			}
+			if err := m.OldestOutboundFetchTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestInboundFetchTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldestInboundFetchTime == nil {
+				m.OldestInboundFetchTime = &types.Timestamp{}
+			}
+			if err := m.OldestInboundFetchTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundDlqSize", wireType)
+			}
+			m.InboundDlqSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundDlqSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCrossClusterTaskLag", wireType)
+			}
+			m.MaxCrossClusterTaskLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCrossClusterTaskLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracefulFailoverEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GracefulFailoverEndTime == nil {
+				m.GracefulFailoverEndTime = &types.Timestamp{}
+			}
+			if err := m.GracefulFailoverEndTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipReplication(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
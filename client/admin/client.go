@@ -155,6 +155,134 @@ func (c *clientImpl) ResetQueue(
 	return client.ResetQueue(ctx, request, opts...)
 }
 
+func (c *clientImpl) PurgeHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.PurgeHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PurgeHistoryTaskDLQ(ctx, request, opts...)
+}
+
+func (c *clientImpl) PauseTaskList(
+	ctx context.Context,
+	request *types.PauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PauseTaskList(ctx, request, opts...)
+}
+
+func (c *clientImpl) ResumeTaskList(
+	ctx context.Context,
+	request *types.ResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ResumeTaskList(ctx, request, opts...)
+}
+
+func (c *clientImpl) AddRemoteCluster(
+	ctx context.Context,
+	request *types.AddRemoteClusterRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.AddRemoteCluster(ctx, request, opts...)
+}
+
+func (c *clientImpl) RemoveRemoteCluster(
+	ctx context.Context,
+	request *types.RemoveRemoteClusterRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RemoveRemoteCluster(ctx, request, opts...)
+}
+
+func (c *clientImpl) CloneDomain(
+	ctx context.Context,
+	request *types.CloneDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.CloneDomain(ctx, request, opts...)
+}
+
+func (c *clientImpl) RenameDomain(
+	ctx context.Context,
+	request *types.RenameDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RenameDomain(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteDomain(
+	ctx context.Context,
+	request *types.DeleteDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DeleteDomain(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeQueue(
 	ctx context.Context,
 	request *types.DescribeQueueRequest,
@@ -171,6 +299,86 @@ func (c *clientImpl) DescribeQueue(
 	return client.DescribeQueue(ctx, request, opts...)
 }
 
+func (c *clientImpl) ReadHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.ReadHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (*types.ReadHistoryTaskDLQResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ReadHistoryTaskDLQ(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeReplicationStatus(
+	ctx context.Context,
+	request *types.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeReplicationStatusResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeReplicationStatus(ctx, request, opts...)
+}
+
+func (c *clientImpl) MigrateWorkflowExecution(
+	ctx context.Context,
+	request *types.MigrateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.MigrateWorkflowExecutionResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContextWithLargeTimeout(ctx)
+	defer cancel()
+	return client.MigrateWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListDomainChanges(
+	ctx context.Context,
+	request *types.ListDomainChangesRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListDomainChangesResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContextWithLargeTimeout(ctx)
+	defer cancel()
+	return client.ListDomainChanges(ctx, request, opts...)
+}
+
+func (c *clientImpl) RetryHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.RetryHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (*types.RetryHistoryTaskDLQResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RetryHistoryTaskDLQ(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *types.AdminDescribeWorkflowExecutionRequest,
@@ -374,6 +582,21 @@ func (c *clientImpl) GetCrossClusterTasks(
 	return client.GetCrossClusterTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) MoveTaskListBacklog(
+	ctx context.Context,
+	request *types.MoveTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (*types.MoveTaskListBacklogResponse, error) {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContextWithLargeTimeout(ctx)
+	defer cancel()
+	return client.MoveTaskListBacklog(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
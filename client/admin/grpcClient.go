@@ -68,6 +68,31 @@ func (g grpcClient) DescribeQueue(ctx context.Context, request *types.DescribeQu
 	return proto.ToAdminDescribeQueueResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) ReadHistoryTaskDLQ(ctx context.Context, request *types.ReadHistoryTaskDLQRequest, opts ...yarpc.CallOption) (*types.ReadHistoryTaskDLQResponse, error) {
+	response, err := g.c.ReadHistoryTaskDLQ(ctx, proto.FromAdminReadHistoryTaskDLQRequest(request), opts...)
+	return proto.ToAdminReadHistoryTaskDLQResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) DescribeReplicationStatus(ctx context.Context, request *types.DescribeReplicationStatusRequest, opts ...yarpc.CallOption) (*types.DescribeReplicationStatusResponse, error) {
+	response, err := g.c.DescribeReplicationStatus(ctx, proto.FromAdminDescribeReplicationStatusRequest(request), opts...)
+	return proto.ToAdminDescribeReplicationStatusResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) MigrateWorkflowExecution(ctx context.Context, request *types.MigrateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.MigrateWorkflowExecutionResponse, error) {
+	response, err := g.c.MigrateWorkflowExecution(ctx, proto.FromAdminMigrateWorkflowExecutionRequest(request), opts...)
+	return proto.ToAdminMigrateWorkflowExecutionResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) ListDomainChanges(ctx context.Context, request *types.ListDomainChangesRequest, opts ...yarpc.CallOption) (*types.ListDomainChangesResponse, error) {
+	response, err := g.c.ListDomainChanges(ctx, proto.FromAdminListDomainChangesRequest(request), opts...)
+	return proto.ToAdminListDomainChangesResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) RetryHistoryTaskDLQ(ctx context.Context, request *types.RetryHistoryTaskDLQRequest, opts ...yarpc.CallOption) (*types.RetryHistoryTaskDLQResponse, error) {
+	response, err := g.c.RetryHistoryTaskDLQ(ctx, proto.FromAdminRetryHistoryTaskDLQRequest(request), opts...)
+	return proto.ToAdminRetryHistoryTaskDLQResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) DescribeWorkflowExecution(ctx context.Context, request *types.AdminDescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.AdminDescribeWorkflowExecutionResponse, error) {
 	response, err := g.c.DescribeWorkflowExecution(ctx, proto.FromAdminDescribeWorkflowExecutionRequest(request), opts...)
 	return proto.ToAdminDescribeWorkflowExecutionResponse(response), proto.ToError(err)
@@ -133,7 +158,52 @@ func (g grpcClient) ResetQueue(ctx context.Context, request *types.ResetQueueReq
 	return proto.ToError(err)
 }
 
+func (g grpcClient) PurgeHistoryTaskDLQ(ctx context.Context, request *types.PurgeHistoryTaskDLQRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.PurgeHistoryTaskDLQ(ctx, proto.FromAdminPurgeHistoryTaskDLQRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) PauseTaskList(ctx context.Context, request *types.PauseTaskListRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.PauseTaskList(ctx, proto.FromAdminPauseTaskListRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) ResumeTaskList(ctx context.Context, request *types.ResumeTaskListRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.ResumeTaskList(ctx, proto.FromAdminResumeTaskListRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) AddRemoteCluster(ctx context.Context, request *types.AddRemoteClusterRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.AddRemoteCluster(ctx, proto.FromAdminAddRemoteClusterRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) RemoveRemoteCluster(ctx context.Context, request *types.RemoveRemoteClusterRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.RemoveRemoteCluster(ctx, proto.FromAdminRemoveRemoteClusterRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) CloneDomain(ctx context.Context, request *types.CloneDomainRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.CloneDomain(ctx, proto.FromAdminCloneDomainRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) RenameDomain(ctx context.Context, request *types.RenameDomainRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.RenameDomain(ctx, proto.FromAdminRenameDomainRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) DeleteDomain(ctx context.Context, request *types.DeleteDomainRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.DeleteDomain(ctx, proto.FromAdminDeleteDomainRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) GetCrossClusterTasks(ctx context.Context, request *types.GetCrossClusterTasksRequest, opts ...yarpc.CallOption) (*types.GetCrossClusterTasksResponse, error) {
 	response, err := g.c.GetCrossClusterTasks(ctx, proto.FromAdminGetCrossClusterTasksRequest(request), opts...)
 	return proto.ToAdminGetCrossClusterTasksResponse(response), proto.ToError(err)
 }
+
+func (g grpcClient) MoveTaskListBacklog(ctx context.Context, request *types.MoveTaskListBacklogRequest, opts ...yarpc.CallOption) (*types.MoveTaskListBacklogResponse, error) {
+	response, err := g.c.MoveTaskListBacklog(ctx, proto.FromAdminMoveTaskListBacklogRequest(request), opts...)
+	return proto.ToAdminMoveTaskListBacklogResponse(response), proto.ToError(err)
+}
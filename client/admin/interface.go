@@ -38,6 +38,10 @@ type Client interface {
 	DescribeShardDistribution(context.Context, *types.DescribeShardDistributionRequest, ...yarpc.CallOption) (*types.DescribeShardDistributionResponse, error)
 	DescribeHistoryHost(context.Context, *types.DescribeHistoryHostRequest, ...yarpc.CallOption) (*types.DescribeHistoryHostResponse, error)
 	DescribeQueue(context.Context, *types.DescribeQueueRequest, ...yarpc.CallOption) (*types.DescribeQueueResponse, error)
+	ReadHistoryTaskDLQ(context.Context, *types.ReadHistoryTaskDLQRequest, ...yarpc.CallOption) (*types.ReadHistoryTaskDLQResponse, error)
+	DescribeReplicationStatus(context.Context, *types.DescribeReplicationStatusRequest, ...yarpc.CallOption) (*types.DescribeReplicationStatusResponse, error)
+	MigrateWorkflowExecution(context.Context, *types.MigrateWorkflowExecutionRequest, ...yarpc.CallOption) (*types.MigrateWorkflowExecutionResponse, error)
+	RetryHistoryTaskDLQ(context.Context, *types.RetryHistoryTaskDLQRequest, ...yarpc.CallOption) (*types.RetryHistoryTaskDLQResponse, error)
 	DescribeWorkflowExecution(context.Context, *types.AdminDescribeWorkflowExecutionRequest, ...yarpc.CallOption) (*types.AdminDescribeWorkflowExecutionResponse, error)
 	GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest, ...yarpc.CallOption) (*types.GetDLQReplicationMessagesResponse, error)
 	GetDomainReplicationMessages(context.Context, *types.GetDomainReplicationMessagesRequest, ...yarpc.CallOption) (*types.GetDomainReplicationMessagesResponse, error)
@@ -51,5 +55,15 @@ type Client interface {
 	RemoveTask(context.Context, *types.RemoveTaskRequest, ...yarpc.CallOption) error
 	ResendReplicationTasks(context.Context, *types.ResendReplicationTasksRequest, ...yarpc.CallOption) error
 	ResetQueue(context.Context, *types.ResetQueueRequest, ...yarpc.CallOption) error
+	PurgeHistoryTaskDLQ(context.Context, *types.PurgeHistoryTaskDLQRequest, ...yarpc.CallOption) error
+	PauseTaskList(context.Context, *types.PauseTaskListRequest, ...yarpc.CallOption) error
+	ResumeTaskList(context.Context, *types.ResumeTaskListRequest, ...yarpc.CallOption) error
+	AddRemoteCluster(context.Context, *types.AddRemoteClusterRequest, ...yarpc.CallOption) error
+	RemoveRemoteCluster(context.Context, *types.RemoveRemoteClusterRequest, ...yarpc.CallOption) error
+	CloneDomain(context.Context, *types.CloneDomainRequest, ...yarpc.CallOption) error
+	RenameDomain(context.Context, *types.RenameDomainRequest, ...yarpc.CallOption) error
+	DeleteDomain(context.Context, *types.DeleteDomainRequest, ...yarpc.CallOption) error
+	ListDomainChanges(context.Context, *types.ListDomainChangesRequest, ...yarpc.CallOption) (*types.ListDomainChangesResponse, error)
 	GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest, ...yarpc.CallOption) (*types.GetCrossClusterTasksResponse, error)
+	MoveTaskListBacklog(context.Context, *types.MoveTaskListBacklogRequest, ...yarpc.CallOption) (*types.MoveTaskListBacklogResponse, error)
 }
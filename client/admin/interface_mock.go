@@ -21,7 +21,7 @@
 // SOFTWARE.
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: interface.go
+// Source: client/admin/interface.go
 
 // Package admin is a generated GoMock package.
 package admin
@@ -177,6 +177,86 @@ func (mr *MockClientMockRecorder) DescribeQueue(arg0, arg1 interface{}, arg2 ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueue", reflect.TypeOf((*MockClient)(nil).DescribeQueue), varargs...)
 }
 
+// ReadHistoryTaskDLQ mocks base method
+func (m *MockClient) ReadHistoryTaskDLQ(arg0 context.Context, arg1 *types.ReadHistoryTaskDLQRequest, arg2 ...yarpc.CallOption) (*types.ReadHistoryTaskDLQResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReadHistoryTaskDLQ", varargs...)
+	ret0, _ := ret[0].(*types.ReadHistoryTaskDLQResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadHistoryTaskDLQ indicates an expected call of ReadHistoryTaskDLQ
+func (mr *MockClientMockRecorder) ReadHistoryTaskDLQ(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadHistoryTaskDLQ", reflect.TypeOf((*MockClient)(nil).ReadHistoryTaskDLQ), varargs...)
+}
+
+// DescribeReplicationStatus mocks base method
+func (m *MockClient) DescribeReplicationStatus(arg0 context.Context, arg1 *types.DescribeReplicationStatusRequest, arg2 ...yarpc.CallOption) (*types.DescribeReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeReplicationStatus", varargs...)
+	ret0, _ := ret[0].(*types.DescribeReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplicationStatus indicates an expected call of DescribeReplicationStatus
+func (mr *MockClientMockRecorder) DescribeReplicationStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplicationStatus", reflect.TypeOf((*MockClient)(nil).DescribeReplicationStatus), varargs...)
+}
+
+// MigrateWorkflowExecution mocks base method
+func (m *MockClient) MigrateWorkflowExecution(arg0 context.Context, arg1 *types.MigrateWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.MigrateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigrateWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*types.MigrateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateWorkflowExecution indicates an expected call of MigrateWorkflowExecution
+func (mr *MockClientMockRecorder) MigrateWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateWorkflowExecution", reflect.TypeOf((*MockClient)(nil).MigrateWorkflowExecution), varargs...)
+}
+
+// RetryHistoryTaskDLQ mocks base method
+func (m *MockClient) RetryHistoryTaskDLQ(arg0 context.Context, arg1 *types.RetryHistoryTaskDLQRequest, arg2 ...yarpc.CallOption) (*types.RetryHistoryTaskDLQResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RetryHistoryTaskDLQ", varargs...)
+	ret0, _ := ret[0].(*types.RetryHistoryTaskDLQResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryHistoryTaskDLQ indicates an expected call of RetryHistoryTaskDLQ
+func (mr *MockClientMockRecorder) RetryHistoryTaskDLQ(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryHistoryTaskDLQ", reflect.TypeOf((*MockClient)(nil).RetryHistoryTaskDLQ), varargs...)
+}
+
 // DescribeWorkflowExecution mocks base method
 func (m *MockClient) DescribeWorkflowExecution(arg0 context.Context, arg1 *types.AdminDescribeWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.AdminDescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -431,6 +511,178 @@ func (mr *MockClientMockRecorder) ResetQueue(arg0, arg1 interface{}, arg2 ...int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetQueue", reflect.TypeOf((*MockClient)(nil).ResetQueue), varargs...)
 }
 
+// PurgeHistoryTaskDLQ mocks base method
+func (m *MockClient) PurgeHistoryTaskDLQ(arg0 context.Context, arg1 *types.PurgeHistoryTaskDLQRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeHistoryTaskDLQ", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeHistoryTaskDLQ indicates an expected call of PurgeHistoryTaskDLQ
+func (mr *MockClientMockRecorder) PurgeHistoryTaskDLQ(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeHistoryTaskDLQ", reflect.TypeOf((*MockClient)(nil).PurgeHistoryTaskDLQ), varargs...)
+}
+
+// PauseTaskList mocks base method
+func (m *MockClient) PauseTaskList(arg0 context.Context, arg1 *types.PauseTaskListRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseTaskList", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseTaskList indicates an expected call of PauseTaskList
+func (mr *MockClientMockRecorder) PauseTaskList(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseTaskList", reflect.TypeOf((*MockClient)(nil).PauseTaskList), varargs...)
+}
+
+// ResumeTaskList mocks base method
+func (m *MockClient) ResumeTaskList(arg0 context.Context, arg1 *types.ResumeTaskListRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResumeTaskList", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeTaskList indicates an expected call of ResumeTaskList
+func (mr *MockClientMockRecorder) ResumeTaskList(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTaskList", reflect.TypeOf((*MockClient)(nil).ResumeTaskList), varargs...)
+}
+
+// AddRemoteCluster mocks base method
+func (m *MockClient) AddRemoteCluster(arg0 context.Context, arg1 *types.AddRemoteClusterRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddRemoteCluster", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRemoteCluster indicates an expected call of AddRemoteCluster
+func (mr *MockClientMockRecorder) AddRemoteCluster(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRemoteCluster", reflect.TypeOf((*MockClient)(nil).AddRemoteCluster), varargs...)
+}
+
+// RemoveRemoteCluster mocks base method
+func (m *MockClient) RemoveRemoteCluster(arg0 context.Context, arg1 *types.RemoveRemoteClusterRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveRemoteCluster", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRemoteCluster indicates an expected call of RemoveRemoteCluster
+func (mr *MockClientMockRecorder) RemoveRemoteCluster(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRemoteCluster", reflect.TypeOf((*MockClient)(nil).RemoveRemoteCluster), varargs...)
+}
+
+// CloneDomain mocks base method
+func (m *MockClient) CloneDomain(arg0 context.Context, arg1 *types.CloneDomainRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CloneDomain", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloneDomain indicates an expected call of CloneDomain
+func (mr *MockClientMockRecorder) CloneDomain(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneDomain", reflect.TypeOf((*MockClient)(nil).CloneDomain), varargs...)
+}
+
+// RenameDomain mocks base method
+func (m *MockClient) RenameDomain(arg0 context.Context, arg1 *types.RenameDomainRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RenameDomain", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameDomain indicates an expected call of RenameDomain
+func (mr *MockClientMockRecorder) RenameDomain(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameDomain", reflect.TypeOf((*MockClient)(nil).RenameDomain), varargs...)
+}
+
+// DeleteDomain mocks base method
+func (m *MockClient) DeleteDomain(arg0 context.Context, arg1 *types.DeleteDomainRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDomain", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDomain indicates an expected call of DeleteDomain
+func (mr *MockClientMockRecorder) DeleteDomain(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomain", reflect.TypeOf((*MockClient)(nil).DeleteDomain), varargs...)
+}
+
+// ListDomainChanges mocks base method
+func (m *MockClient) ListDomainChanges(arg0 context.Context, arg1 *types.ListDomainChangesRequest, arg2 ...yarpc.CallOption) (*types.ListDomainChangesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDomainChanges", varargs...)
+	ret0, _ := ret[0].(*types.ListDomainChangesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDomainChanges indicates an expected call of ListDomainChanges
+func (mr *MockClientMockRecorder) ListDomainChanges(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomainChanges", reflect.TypeOf((*MockClient)(nil).ListDomainChanges), varargs...)
+}
+
 // GetCrossClusterTasks mocks base method
 func (m *MockClient) GetCrossClusterTasks(arg0 context.Context, arg1 *types.GetCrossClusterTasksRequest, arg2 ...yarpc.CallOption) (*types.GetCrossClusterTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -450,3 +702,23 @@ func (mr *MockClientMockRecorder) GetCrossClusterTasks(arg0, arg1 interface{}, a
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCrossClusterTasks", reflect.TypeOf((*MockClient)(nil).GetCrossClusterTasks), varargs...)
 }
+
+// MoveTaskListBacklog mocks base method
+func (m *MockClient) MoveTaskListBacklog(arg0 context.Context, arg1 *types.MoveTaskListBacklogRequest, arg2 ...yarpc.CallOption) (*types.MoveTaskListBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveTaskListBacklog", varargs...)
+	ret0, _ := ret[0].(*types.MoveTaskListBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskListBacklog indicates an expected call of MoveTaskListBacklog
+func (mr *MockClientMockRecorder) MoveTaskListBacklog(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskListBacklog", reflect.TypeOf((*MockClient)(nil).MoveTaskListBacklog), varargs...)
+}
@@ -152,6 +152,150 @@ func (c *metricClient) ResetQueue(
 	return err
 }
 
+func (c *metricClient) PurgeHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.PurgeHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPurgeHistoryTaskDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPurgeHistoryTaskDLQScope, metrics.CadenceClientLatency)
+	err := c.client.PurgeHistoryTaskDLQ(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPurgeHistoryTaskDLQScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) PauseTaskList(
+	ctx context.Context,
+	request *types.PauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPauseTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPauseTaskListScope, metrics.CadenceClientLatency)
+	err := c.client.PauseTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPauseTaskListScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ResumeTaskList(
+	ctx context.Context,
+	request *types.ResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientResumeTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientResumeTaskListScope, metrics.CadenceClientLatency)
+	err := c.client.ResumeTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientResumeTaskListScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) AddRemoteCluster(
+	ctx context.Context,
+	request *types.AddRemoteClusterRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientAddRemoteClusterScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientAddRemoteClusterScope, metrics.CadenceClientLatency)
+	err := c.client.AddRemoteCluster(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientAddRemoteClusterScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) RemoveRemoteCluster(
+	ctx context.Context,
+	request *types.RemoveRemoteClusterRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRemoveRemoteClusterScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRemoveRemoteClusterScope, metrics.CadenceClientLatency)
+	err := c.client.RemoveRemoteCluster(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRemoveRemoteClusterScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) CloneDomain(
+	ctx context.Context,
+	request *types.CloneDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientCloneDomainScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientCloneDomainScope, metrics.CadenceClientLatency)
+	err := c.client.CloneDomain(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientCloneDomainScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) RenameDomain(
+	ctx context.Context,
+	request *types.RenameDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRenameDomainScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRenameDomainScope, metrics.CadenceClientLatency)
+	err := c.client.RenameDomain(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRenameDomainScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) DeleteDomain(
+	ctx context.Context,
+	request *types.DeleteDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteDomainScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteDomainScope, metrics.CadenceClientLatency)
+	err := c.client.DeleteDomain(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteDomainScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *metricClient) DescribeQueue(
 	ctx context.Context,
 	request *types.DescribeQueueRequest,
@@ -170,6 +314,96 @@ func (c *metricClient) DescribeQueue(
 	return resp, err
 }
 
+func (c *metricClient) ReadHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.ReadHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (*types.ReadHistoryTaskDLQResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientReadHistoryTaskDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientReadHistoryTaskDLQScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ReadHistoryTaskDLQ(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientReadHistoryTaskDLQScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeReplicationStatus(
+	ctx context.Context,
+	request *types.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeReplicationStatusResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeReplicationStatusScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeReplicationStatusScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeReplicationStatus(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeReplicationStatusScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) MigrateWorkflowExecution(
+	ctx context.Context,
+	request *types.MigrateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.MigrateWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientMigrateWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientMigrateWorkflowExecutionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.MigrateWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientMigrateWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListDomainChanges(
+	ctx context.Context,
+	request *types.ListDomainChangesRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListDomainChangesResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListDomainChangesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListDomainChangesScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListDomainChanges(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListDomainChangesScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RetryHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.RetryHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (*types.RetryHistoryTaskDLQResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRetryHistoryTaskDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRetryHistoryTaskDLQScope, metrics.CadenceClientLatency)
+	resp, err := c.client.RetryHistoryTaskDLQ(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRetryHistoryTaskDLQScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *types.AdminDescribeWorkflowExecutionRequest,
@@ -392,3 +626,20 @@ func (c *metricClient) GetCrossClusterTasks(
 	}
 	return resp, err
 }
+
+func (c *metricClient) MoveTaskListBacklog(
+	ctx context.Context,
+	request *types.MoveTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (*types.MoveTaskListBacklogResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientMoveTaskListBacklogScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientMoveTaskListBacklogScope, metrics.CadenceClientLatency)
+	resp, err := c.client.MoveTaskListBacklog(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientMoveTaskListBacklogScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
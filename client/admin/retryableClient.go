@@ -126,6 +126,102 @@ func (c *retryableClient) ResetQueue(
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
 
+func (c *retryableClient) PurgeHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.PurgeHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PurgeHistoryTaskDLQ(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) PauseTaskList(
+	ctx context.Context,
+	request *types.PauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PauseTaskList(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ResumeTaskList(
+	ctx context.Context,
+	request *types.ResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ResumeTaskList(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) AddRemoteCluster(
+	ctx context.Context,
+	request *types.AddRemoteClusterRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.AddRemoteCluster(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) RemoveRemoteCluster(
+	ctx context.Context,
+	request *types.RemoveRemoteClusterRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.RemoveRemoteCluster(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) CloneDomain(
+	ctx context.Context,
+	request *types.CloneDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.CloneDomain(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) RenameDomain(
+	ctx context.Context,
+	request *types.RenameDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.RenameDomain(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) DeleteDomain(
+	ctx context.Context,
+	request *types.DeleteDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.DeleteDomain(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) DescribeQueue(
 	ctx context.Context,
 	request *types.DescribeQueueRequest,
@@ -142,6 +238,86 @@ func (c *retryableClient) DescribeQueue(
 	return resp, err
 }
 
+func (c *retryableClient) ReadHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.ReadHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (*types.ReadHistoryTaskDLQResponse, error) {
+
+	var resp *types.ReadHistoryTaskDLQResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ReadHistoryTaskDLQ(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeReplicationStatus(
+	ctx context.Context,
+	request *types.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeReplicationStatusResponse, error) {
+
+	var resp *types.DescribeReplicationStatusResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeReplicationStatus(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) MigrateWorkflowExecution(
+	ctx context.Context,
+	request *types.MigrateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.MigrateWorkflowExecutionResponse, error) {
+
+	var resp *types.MigrateWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.MigrateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListDomainChanges(
+	ctx context.Context,
+	request *types.ListDomainChangesRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListDomainChangesResponse, error) {
+
+	var resp *types.ListDomainChangesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListDomainChanges(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RetryHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.RetryHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (*types.RetryHistoryTaskDLQResponse, error) {
+
+	var resp *types.RetryHistoryTaskDLQResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RetryHistoryTaskDLQ(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *types.AdminDescribeWorkflowExecutionRequest,
@@ -328,3 +504,18 @@ func (c *retryableClient) GetCrossClusterTasks(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) MoveTaskListBacklog(
+	ctx context.Context,
+	request *types.MoveTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (*types.MoveTaskListBacklogResponse, error) {
+	var resp *types.MoveTaskListBacklogResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.MoveTaskListBacklog(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
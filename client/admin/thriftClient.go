@@ -69,6 +69,31 @@ func (t thriftClient) DescribeQueue(ctx context.Context, request *types.Describe
 	return thrift.ToDescribeQueueResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) ReadHistoryTaskDLQ(ctx context.Context, request *types.ReadHistoryTaskDLQRequest, opts ...yarpc.CallOption) (*types.ReadHistoryTaskDLQResponse, error) {
+	response, err := t.c.ReadHistoryTaskDLQ(ctx, thrift.FromReadHistoryTaskDLQRequest(request), opts...)
+	return thrift.ToReadHistoryTaskDLQResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) DescribeReplicationStatus(ctx context.Context, request *types.DescribeReplicationStatusRequest, opts ...yarpc.CallOption) (*types.DescribeReplicationStatusResponse, error) {
+	response, err := t.c.DescribeReplicationStatus(ctx, thrift.FromDescribeReplicationStatusRequest(request), opts...)
+	return thrift.ToDescribeReplicationStatusResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) MigrateWorkflowExecution(ctx context.Context, request *types.MigrateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.MigrateWorkflowExecutionResponse, error) {
+	response, err := t.c.MigrateWorkflowExecution(ctx, thrift.FromMigrateWorkflowExecutionRequest(request), opts...)
+	return thrift.ToMigrateWorkflowExecutionResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) ListDomainChanges(ctx context.Context, request *types.ListDomainChangesRequest, opts ...yarpc.CallOption) (*types.ListDomainChangesResponse, error) {
+	response, err := t.c.ListDomainChanges(ctx, thrift.FromListDomainChangesRequest(request), opts...)
+	return thrift.ToListDomainChangesResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) RetryHistoryTaskDLQ(ctx context.Context, request *types.RetryHistoryTaskDLQRequest, opts ...yarpc.CallOption) (*types.RetryHistoryTaskDLQResponse, error) {
+	response, err := t.c.RetryHistoryTaskDLQ(ctx, thrift.FromRetryHistoryTaskDLQRequest(request), opts...)
+	return thrift.ToRetryHistoryTaskDLQResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) DescribeWorkflowExecution(ctx context.Context, request *types.AdminDescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.AdminDescribeWorkflowExecutionResponse, error) {
 	response, err := t.c.DescribeWorkflowExecution(ctx, thrift.FromAdminDescribeWorkflowExecutionRequest(request), opts...)
 	return thrift.ToAdminDescribeWorkflowExecutionResponse(response), thrift.ToError(err)
@@ -134,7 +159,52 @@ func (t thriftClient) ResetQueue(ctx context.Context, request *types.ResetQueueR
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) PurgeHistoryTaskDLQ(ctx context.Context, request *types.PurgeHistoryTaskDLQRequest, opts ...yarpc.CallOption) error {
+	err := t.c.PurgeHistoryTaskDLQ(ctx, thrift.FromPurgeHistoryTaskDLQRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) PauseTaskList(ctx context.Context, request *types.PauseTaskListRequest, opts ...yarpc.CallOption) error {
+	err := t.c.PauseTaskList(ctx, thrift.FromPauseTaskListRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) ResumeTaskList(ctx context.Context, request *types.ResumeTaskListRequest, opts ...yarpc.CallOption) error {
+	err := t.c.ResumeTaskList(ctx, thrift.FromResumeTaskListRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) AddRemoteCluster(ctx context.Context, request *types.AddRemoteClusterRequest, opts ...yarpc.CallOption) error {
+	err := t.c.AddRemoteCluster(ctx, thrift.FromAddRemoteClusterRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) RemoveRemoteCluster(ctx context.Context, request *types.RemoveRemoteClusterRequest, opts ...yarpc.CallOption) error {
+	err := t.c.RemoveRemoteCluster(ctx, thrift.FromRemoveRemoteClusterRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) CloneDomain(ctx context.Context, request *types.CloneDomainRequest, opts ...yarpc.CallOption) error {
+	err := t.c.CloneDomain(ctx, thrift.FromCloneDomainRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) RenameDomain(ctx context.Context, request *types.RenameDomainRequest, opts ...yarpc.CallOption) error {
+	err := t.c.RenameDomain(ctx, thrift.FromRenameDomainRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) DeleteDomain(ctx context.Context, request *types.DeleteDomainRequest, opts ...yarpc.CallOption) error {
+	err := t.c.DeleteDomain(ctx, thrift.FromDeleteDomainRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) GetCrossClusterTasks(ctx context.Context, request *types.GetCrossClusterTasksRequest, opts ...yarpc.CallOption) (*types.GetCrossClusterTasksResponse, error) {
 	response, err := t.c.GetCrossClusterTasks(ctx, thrift.FromGetCrossClusterTasksRequest(request), opts...)
 	return thrift.ToGetCrossClusterTasksResponse(response), thrift.ToError(err)
 }
+
+func (t thriftClient) MoveTaskListBacklog(ctx context.Context, request *types.MoveTaskListBacklogRequest, opts ...yarpc.CallOption) (*types.MoveTaskListBacklogResponse, error) {
+	response, err := t.c.MoveTaskListBacklog(ctx, thrift.FromMoveTaskListBacklogRequest(request), opts...)
+	return thrift.ToMoveTaskListBacklogResponse(response), thrift.ToError(err)
+}
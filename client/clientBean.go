@@ -42,6 +42,7 @@ import (
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 )
@@ -75,9 +76,12 @@ type (
 		historyClient         history.Client
 		matchingClient        atomic.Value
 		frontendClient        frontend.Client
+		remoteClientsLock     sync.RWMutex
 		remoteAdminClients    map[string]admin.Client
 		remoteFrontendClients map[string]frontend.Client
 		factory               Factory
+		dispatcherProvider    DispatcherProvider
+		logger                log.Logger
 	}
 
 	dnsDispatcherProvider struct {
@@ -103,56 +107,45 @@ type (
 )
 
 // NewClientBean provides a collection of clients
-func NewClientBean(factory Factory, dispatcherProvider DispatcherProvider, clusterMetadata cluster.Metadata) (Bean, error) {
+func NewClientBean(
+	factory Factory,
+	dispatcherProvider DispatcherProvider,
+	clusterMetadata cluster.Metadata,
+	logger log.Logger,
+) (Bean, error) {
 
 	historyClient, err := factory.NewHistoryClient()
 	if err != nil {
 		return nil, err
 	}
 
-	remoteAdminClients := map[string]admin.Client{}
-	remoteFrontendClients := map[string]frontend.Client{}
+	bean := &clientBeanImpl{
+		factory:               factory,
+		dispatcherProvider:    dispatcherProvider,
+		logger:                logger,
+		historyClient:         historyClient,
+		remoteAdminClients:    map[string]admin.Client{},
+		remoteFrontendClients: map[string]frontend.Client{},
+	}
 	for clusterName, info := range clusterMetadata.GetAllClusterInfo() {
 		if !info.Enabled {
 			continue
 		}
 
-		dispatcher, err := dispatcherProvider.Get(info.RPCName, info.RPCAddress)
-		if err != nil {
-			return nil, err
-		}
-
-		adminClient, err := factory.NewAdminClientWithTimeoutAndDispatcher(
-			info.RPCName,
-			admin.DefaultTimeout,
-			admin.DefaultLargeTimeout,
-			dispatcher,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		frontendClient, err := factory.NewFrontendClientWithTimeoutAndDispatcher(
-			info.RPCName,
-			frontend.DefaultTimeout,
-			frontend.DefaultLongPollTimeout,
-			dispatcher,
-		)
+		adminClient, frontendClient, err := bean.newRemoteClients(info)
 		if err != nil {
 			return nil, err
 		}
-
-		remoteAdminClients[clusterName] = adminClient
-		remoteFrontendClients[clusterName] = frontendClient
+		bean.remoteAdminClients[clusterName] = adminClient
+		bean.remoteFrontendClients[clusterName] = frontendClient
 	}
+	bean.frontendClient = bean.remoteFrontendClients[clusterMetadata.GetCurrentClusterName()]
 
-	return &clientBeanImpl{
-		factory:               factory,
-		historyClient:         historyClient,
-		frontendClient:        remoteFrontendClients[clusterMetadata.GetCurrentClusterName()],
-		remoteAdminClients:    remoteAdminClients,
-		remoteFrontendClients: remoteFrontendClients,
-	}, nil
+	clusterMetadata.RegisterChangeCallback(
+		fmt.Sprintf("clientBean-%p", bean),
+		bean.onClusterMetadataChange,
+	)
+	return bean, nil
 }
 
 func (h *clientBeanImpl) GetHistoryClient() history.Client {
@@ -192,6 +185,9 @@ func (h *clientBeanImpl) SetFrontendClient(
 }
 
 func (h *clientBeanImpl) GetRemoteAdminClient(cluster string) admin.Client {
+	h.remoteClientsLock.RLock()
+	defer h.remoteClientsLock.RUnlock()
+
 	client, ok := h.remoteAdminClients[cluster]
 	if !ok {
 		panic(fmt.Sprintf(
@@ -208,10 +204,16 @@ func (h *clientBeanImpl) SetRemoteAdminClient(
 	client admin.Client,
 ) {
 
+	h.remoteClientsLock.Lock()
+	defer h.remoteClientsLock.Unlock()
+
 	h.remoteAdminClients[cluster] = client
 }
 
 func (h *clientBeanImpl) GetRemoteFrontendClient(cluster string) frontend.Client {
+	h.remoteClientsLock.RLock()
+	defer h.remoteClientsLock.RUnlock()
+
 	client, ok := h.remoteFrontendClients[cluster]
 	if !ok {
 		panic(fmt.Sprintf(
@@ -228,9 +230,72 @@ func (h *clientBeanImpl) SetRemoteFrontendClient(
 	client frontend.Client,
 ) {
 
+	h.remoteClientsLock.Lock()
+	defer h.remoteClientsLock.Unlock()
+
 	h.remoteFrontendClients[cluster] = client
 }
 
+func (h *clientBeanImpl) newRemoteClients(
+	info config.ClusterInformation,
+) (admin.Client, frontend.Client, error) {
+
+	dispatcher, err := h.dispatcherProvider.Get(info.RPCName, info.RPCAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	adminClient, err := h.factory.NewAdminClientWithTimeoutAndDispatcher(
+		info.RPCName,
+		admin.DefaultTimeout,
+		admin.DefaultLargeTimeout,
+		dispatcher,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	frontendClient, err := h.factory.NewFrontendClientWithTimeoutAndDispatcher(
+		info.RPCName,
+		frontend.DefaultTimeout,
+		frontend.DefaultLongPollTimeout,
+		dispatcher,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return adminClient, frontendClient, nil
+}
+
+// onClusterMetadataChange drops the clients of removed clusters and creates the clients of added ones,
+// the client of the current cluster is never touched as the current cluster cannot change
+func (h *clientBeanImpl) onClusterMetadataChange(
+	added map[string]config.ClusterInformation,
+	removed map[string]config.ClusterInformation,
+) {
+
+	h.remoteClientsLock.Lock()
+	defer h.remoteClientsLock.Unlock()
+
+	for clusterName := range removed {
+		delete(h.remoteAdminClients, clusterName)
+		delete(h.remoteFrontendClients, clusterName)
+	}
+	for clusterName, info := range added {
+		if !info.Enabled {
+			continue
+		}
+
+		adminClient, frontendClient, err := h.newRemoteClients(info)
+		if err != nil {
+			h.logger.Error("Failed to create clients for remote cluster", tag.ClusterName(clusterName), tag.Error(err))
+			continue
+		}
+		h.remoteAdminClients[clusterName] = adminClient
+		h.remoteFrontendClients[clusterName] = frontendClient
+	}
+}
+
 func (h *clientBeanImpl) lazyInitMatchingClient(domainIDToName DomainIDToNameFunc) (matching.Client, error) {
 	h.Lock()
 	defer h.Unlock()
@@ -107,6 +107,38 @@ func (c *clientImpl) DescribeTaskList(
 	return client.DescribeTaskList(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeSchedule(
+	ctx context.Context,
+	request *types.DescribeScheduleRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeScheduleResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListSchedules(
+	ctx context.Context,
+	request *types.ListSchedulesRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListSchedulesResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListSchedules(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *types.DescribeWorkflowExecutionRequest,
@@ -314,6 +346,22 @@ func (c *clientImpl) QueryWorkflow(
 	return client.QueryWorkflow(ctx, request, opts...)
 }
 
+func (c *clientImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateWorkflowExecutionResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	request *types.RecordActivityTaskHeartbeatRequest,
@@ -378,6 +426,182 @@ func (c *clientImpl) RequestCancelWorkflowExecution(
 	return client.RequestCancelWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *types.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) CreateSchedule(
+	ctx context.Context,
+	request *types.CreateScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.CreateSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateSchedule(
+	ctx context.Context,
+	request *types.UpdateScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) PauseSchedule(
+	ctx context.Context,
+	request *types.PauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PauseSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) UnpauseSchedule(
+	ctx context.Context,
+	request *types.UnpauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UnpauseSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) BackfillSchedule(
+	ctx context.Context,
+	request *types.BackfillScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.BackfillSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *types.UnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UnpauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *types.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DeleteWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) PauseActivity(
+	ctx context.Context,
+	request *types.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PauseActivity(ctx, request, opts...)
+}
+
+func (c *clientImpl) UnpauseActivity(
+	ctx context.Context,
+	request *types.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UnpauseActivity(ctx, request, opts...)
+}
+
+func (c *clientImpl) ResetActivity(
+	ctx context.Context,
+	request *types.ResetActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ResetActivity(ctx, request, opts...)
+}
+
 func (c *clientImpl) ResetStickyTaskList(
 	ctx context.Context,
 	request *types.ResetStickyTaskListRequest,
@@ -410,6 +634,22 @@ func (c *clientImpl) ResetWorkflowExecution(
 	return client.ResetWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *clientImpl) RestartWorkflowExecution(
+	ctx context.Context,
+	request *types.RestartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.RestartWorkflowExecutionResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RestartWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) RespondActivityTaskCanceled(
 	ctx context.Context,
 	request *types.RespondActivityTaskCanceledRequest,
@@ -66,6 +66,16 @@ func (g grpcClient) DescribeTaskList(ctx context.Context, request *types.Describ
 	return proto.ToDescribeTaskListResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) DescribeSchedule(ctx context.Context, request *types.DescribeScheduleRequest, opts ...yarpc.CallOption) (*types.DescribeScheduleResponse, error) {
+	response, err := g.workflow.DescribeSchedule(ctx, proto.FromDescribeScheduleRequest(request), opts...)
+	return proto.ToDescribeScheduleResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) ListSchedules(ctx context.Context, request *types.ListSchedulesRequest, opts ...yarpc.CallOption) (*types.ListSchedulesResponse, error) {
+	response, err := g.workflow.ListSchedules(ctx, proto.FromListSchedulesRequest(request), opts...)
+	return proto.ToListSchedulesResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) DescribeWorkflowExecution(ctx context.Context, request *types.DescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
 	response, err := g.workflow.DescribeWorkflowExecution(ctx, proto.FromDescribeWorkflowExecutionRequest(request), opts...)
 	return proto.ToDescribeWorkflowExecutionResponse(response), proto.ToError(err)
@@ -136,6 +146,11 @@ func (g grpcClient) QueryWorkflow(ctx context.Context, request *types.QueryWorkf
 	return proto.ToQueryWorkflowResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) UpdateWorkflowExecution(ctx context.Context, request *types.UpdateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.UpdateWorkflowExecutionResponse, error) {
+	response, err := g.workflow.UpdateWorkflowExecution(ctx, proto.FromUpdateWorkflowExecutionRequest(request), opts...)
+	return proto.ToUpdateWorkflowExecutionResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) RecordActivityTaskHeartbeat(ctx context.Context, request *types.RecordActivityTaskHeartbeatRequest, opts ...yarpc.CallOption) (*types.RecordActivityTaskHeartbeatResponse, error) {
 	response, err := g.worker.RecordActivityTaskHeartbeat(ctx, proto.FromRecordActivityTaskHeartbeatRequest(request), opts...)
 	return proto.ToRecordActivityTaskHeartbeatResponse(response), proto.ToError(err)
@@ -156,6 +171,61 @@ func (g grpcClient) RequestCancelWorkflowExecution(ctx context.Context, request
 	return proto.ToError(err)
 }
 
+func (g grpcClient) PauseWorkflowExecution(ctx context.Context, request *types.PauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.PauseWorkflowExecution(ctx, proto.FromPauseWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) CreateSchedule(ctx context.Context, request *types.CreateScheduleRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.CreateSchedule(ctx, proto.FromCreateScheduleRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) UpdateSchedule(ctx context.Context, request *types.UpdateScheduleRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.UpdateSchedule(ctx, proto.FromUpdateScheduleRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) PauseSchedule(ctx context.Context, request *types.PauseScheduleRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.PauseSchedule(ctx, proto.FromPauseScheduleRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) UnpauseSchedule(ctx context.Context, request *types.UnpauseScheduleRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.UnpauseSchedule(ctx, proto.FromUnpauseScheduleRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) BackfillSchedule(ctx context.Context, request *types.BackfillScheduleRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.BackfillSchedule(ctx, proto.FromBackfillScheduleRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) UnpauseWorkflowExecution(ctx context.Context, request *types.UnpauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.UnpauseWorkflowExecution(ctx, proto.FromUnpauseWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) DeleteWorkflowExecution(ctx context.Context, request *types.DeleteWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.DeleteWorkflowExecution(ctx, proto.FromDeleteWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) PauseActivity(ctx context.Context, request *types.PauseActivityRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.PauseActivity(ctx, proto.FromPauseActivityRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) UnpauseActivity(ctx context.Context, request *types.UnpauseActivityRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.UnpauseActivity(ctx, proto.FromUnpauseActivityRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) ResetActivity(ctx context.Context, request *types.ResetActivityRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.ResetActivity(ctx, proto.FromResetActivityRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) ResetStickyTaskList(ctx context.Context, request *types.ResetStickyTaskListRequest, opts ...yarpc.CallOption) (*types.ResetStickyTaskListResponse, error) {
 	_, err := g.worker.ResetStickyTaskList(ctx, proto.FromResetStickyTaskListRequest(request), opts...)
 	return &types.ResetStickyTaskListResponse{}, proto.ToError(err)
@@ -166,6 +236,11 @@ func (g grpcClient) ResetWorkflowExecution(ctx context.Context, request *types.R
 	return proto.ToResetWorkflowExecutionResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) RestartWorkflowExecution(ctx context.Context, request *types.RestartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.RestartWorkflowExecutionResponse, error) {
+	response, err := g.workflow.RestartWorkflowExecution(ctx, proto.FromRestartWorkflowExecutionRequest(request), opts...)
+	return proto.ToRestartWorkflowExecutionResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) RespondActivityTaskCanceled(ctx context.Context, request *types.RespondActivityTaskCanceledRequest, opts ...yarpc.CallOption) error {
 	_, err := g.worker.RespondActivityTaskCanceled(ctx, proto.FromRespondActivityTaskCanceledRequest(request), opts...)
 	return proto.ToError(err)
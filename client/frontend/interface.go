@@ -36,6 +36,8 @@ type Client interface {
 	DeprecateDomain(context.Context, *types.DeprecateDomainRequest, ...yarpc.CallOption) error
 	DescribeDomain(context.Context, *types.DescribeDomainRequest, ...yarpc.CallOption) (*types.DescribeDomainResponse, error)
 	DescribeTaskList(context.Context, *types.DescribeTaskListRequest, ...yarpc.CallOption) (*types.DescribeTaskListResponse, error)
+	DescribeSchedule(context.Context, *types.DescribeScheduleRequest, ...yarpc.CallOption) (*types.DescribeScheduleResponse, error)
+	ListSchedules(context.Context, *types.ListSchedulesRequest, ...yarpc.CallOption) (*types.ListSchedulesResponse, error)
 	DescribeWorkflowExecution(context.Context, *types.DescribeWorkflowExecutionRequest, ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error)
 	GetClusterInfo(context.Context, ...yarpc.CallOption) (*types.ClusterInfo, error)
 	GetSearchAttributes(context.Context, ...yarpc.CallOption) (*types.GetSearchAttributesResponse, error)
@@ -50,12 +52,25 @@ type Client interface {
 	PollForActivityTask(context.Context, *types.PollForActivityTaskRequest, ...yarpc.CallOption) (*types.PollForActivityTaskResponse, error)
 	PollForDecisionTask(context.Context, *types.PollForDecisionTaskRequest, ...yarpc.CallOption) (*types.PollForDecisionTaskResponse, error)
 	QueryWorkflow(context.Context, *types.QueryWorkflowRequest, ...yarpc.CallOption) (*types.QueryWorkflowResponse, error)
+	UpdateWorkflowExecution(context.Context, *types.UpdateWorkflowExecutionRequest, ...yarpc.CallOption) (*types.UpdateWorkflowExecutionResponse, error)
 	RecordActivityTaskHeartbeat(context.Context, *types.RecordActivityTaskHeartbeatRequest, ...yarpc.CallOption) (*types.RecordActivityTaskHeartbeatResponse, error)
 	RecordActivityTaskHeartbeatByID(context.Context, *types.RecordActivityTaskHeartbeatByIDRequest, ...yarpc.CallOption) (*types.RecordActivityTaskHeartbeatResponse, error)
 	RegisterDomain(context.Context, *types.RegisterDomainRequest, ...yarpc.CallOption) error
 	RequestCancelWorkflowExecution(context.Context, *types.RequestCancelWorkflowExecutionRequest, ...yarpc.CallOption) error
+	PauseWorkflowExecution(context.Context, *types.PauseWorkflowExecutionRequest, ...yarpc.CallOption) error
+	CreateSchedule(context.Context, *types.CreateScheduleRequest, ...yarpc.CallOption) error
+	UpdateSchedule(context.Context, *types.UpdateScheduleRequest, ...yarpc.CallOption) error
+	PauseSchedule(context.Context, *types.PauseScheduleRequest, ...yarpc.CallOption) error
+	UnpauseSchedule(context.Context, *types.UnpauseScheduleRequest, ...yarpc.CallOption) error
+	BackfillSchedule(context.Context, *types.BackfillScheduleRequest, ...yarpc.CallOption) error
+	UnpauseWorkflowExecution(context.Context, *types.UnpauseWorkflowExecutionRequest, ...yarpc.CallOption) error
+	DeleteWorkflowExecution(context.Context, *types.DeleteWorkflowExecutionRequest, ...yarpc.CallOption) error
+	PauseActivity(context.Context, *types.PauseActivityRequest, ...yarpc.CallOption) error
+	UnpauseActivity(context.Context, *types.UnpauseActivityRequest, ...yarpc.CallOption) error
+	ResetActivity(context.Context, *types.ResetActivityRequest, ...yarpc.CallOption) error
 	ResetStickyTaskList(context.Context, *types.ResetStickyTaskListRequest, ...yarpc.CallOption) (*types.ResetStickyTaskListResponse, error)
 	ResetWorkflowExecution(context.Context, *types.ResetWorkflowExecutionRequest, ...yarpc.CallOption) (*types.ResetWorkflowExecutionResponse, error)
+	RestartWorkflowExecution(context.Context, *types.RestartWorkflowExecutionRequest, ...yarpc.CallOption) (*types.RestartWorkflowExecutionResponse, error)
 	RespondActivityTaskCanceled(context.Context, *types.RespondActivityTaskCanceledRequest, ...yarpc.CallOption) error
 	RespondActivityTaskCanceledByID(context.Context, *types.RespondActivityTaskCanceledByIDRequest, ...yarpc.CallOption) error
 	RespondActivityTaskCompleted(context.Context, *types.RespondActivityTaskCompletedRequest, ...yarpc.CallOption) error
@@ -136,6 +136,46 @@ func (mr *MockClientMockRecorder) DescribeTaskList(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskList", reflect.TypeOf((*MockClient)(nil).DescribeTaskList), varargs...)
 }
 
+// DescribeSchedule mocks base method
+func (m *MockClient) DescribeSchedule(arg0 context.Context, arg1 *types.DescribeScheduleRequest, arg2 ...yarpc.CallOption) (*types.DescribeScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeSchedule", varargs...)
+	ret0, _ := ret[0].(*types.DescribeScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSchedule indicates an expected call of DescribeSchedule
+func (mr *MockClientMockRecorder) DescribeSchedule(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSchedule", reflect.TypeOf((*MockClient)(nil).DescribeSchedule), varargs...)
+}
+
+// ListSchedules mocks base method
+func (m *MockClient) ListSchedules(arg0 context.Context, arg1 *types.ListSchedulesRequest, arg2 ...yarpc.CallOption) (*types.ListSchedulesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSchedules", varargs...)
+	ret0, _ := ret[0].(*types.ListSchedulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchedules indicates an expected call of ListSchedules
+func (mr *MockClientMockRecorder) ListSchedules(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedules", reflect.TypeOf((*MockClient)(nil).ListSchedules), varargs...)
+}
+
 // DescribeWorkflowExecution mocks base method
 func (m *MockClient) DescribeWorkflowExecution(arg0 context.Context, arg1 *types.DescribeWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -416,6 +456,26 @@ func (mr *MockClientMockRecorder) QueryWorkflow(arg0, arg1 interface{}, arg2 ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockClient)(nil).QueryWorkflow), varargs...)
 }
 
+// UpdateWorkflowExecution mocks base method
+func (m *MockClient) UpdateWorkflowExecution(arg0 context.Context, arg1 *types.UpdateWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.UpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*types.UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution
+func (mr *MockClientMockRecorder) UpdateWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockClient)(nil).UpdateWorkflowExecution), varargs...)
+}
+
 // RecordActivityTaskHeartbeat mocks base method
 func (m *MockClient) RecordActivityTaskHeartbeat(arg0 context.Context, arg1 *types.RecordActivityTaskHeartbeatRequest, arg2 ...yarpc.CallOption) (*types.RecordActivityTaskHeartbeatResponse, error) {
 	m.ctrl.T.Helper()
@@ -494,6 +554,215 @@ func (mr *MockClientMockRecorder) RequestCancelWorkflowExecution(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockClient)(nil).RequestCancelWorkflowExecution), varargs...)
 }
 
+// PauseWorkflowExecution mocks base method
+func (m *MockClient) PauseWorkflowExecution(arg0 context.Context, arg1 *types.PauseWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution
+func (mr *MockClientMockRecorder) PauseWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockClient)(nil).PauseWorkflowExecution), varargs...)
+}
+
+// CreateSchedule mocks base method
+func (m *MockClient) CreateSchedule(arg0 context.Context, arg1 *types.CreateScheduleRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSchedule", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSchedule indicates an expected call of CreateSchedule
+func (mr *MockClientMockRecorder) CreateSchedule(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockClient)(nil).CreateSchedule), varargs...)
+}
+
+// UpdateSchedule mocks base method
+func (m *MockClient) UpdateSchedule(arg0 context.Context, arg1 *types.UpdateScheduleRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSchedule", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSchedule indicates an expected call of UpdateSchedule
+func (mr *MockClientMockRecorder) UpdateSchedule(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*MockClient)(nil).UpdateSchedule), varargs...)
+}
+
+// PauseSchedule mocks base method
+func (m *MockClient) PauseSchedule(arg0 context.Context, arg1 *types.PauseScheduleRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseSchedule", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseSchedule indicates an expected call of PauseSchedule
+func (mr *MockClientMockRecorder) PauseSchedule(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseSchedule", reflect.TypeOf((*MockClient)(nil).PauseSchedule), varargs...)
+}
+
+// UnpauseSchedule mocks base method
+func (m *MockClient) UnpauseSchedule(arg0 context.Context, arg1 *types.UnpauseScheduleRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseSchedule", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseSchedule indicates an expected call of UnpauseSchedule
+func (mr *MockClientMockRecorder) UnpauseSchedule(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseSchedule", reflect.TypeOf((*MockClient)(nil).UnpauseSchedule), varargs...)
+}
+
+// BackfillSchedule mocks base method
+func (m *MockClient) BackfillSchedule(arg0 context.Context, arg1 *types.BackfillScheduleRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BackfillSchedule", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BackfillSchedule indicates an expected call of BackfillSchedule
+func (mr *MockClientMockRecorder) BackfillSchedule(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillSchedule", reflect.TypeOf((*MockClient)(nil).BackfillSchedule), varargs...)
+}
+
+// UnpauseWorkflowExecution mocks base method
+func (m *MockClient) UnpauseWorkflowExecution(arg0 context.Context, arg1 *types.UnpauseWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution
+func (mr *MockClientMockRecorder) UnpauseWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockClient)(nil).UnpauseWorkflowExecution), varargs...)
+}
+
+// DeleteWorkflowExecution mocks base method
+func (m *MockClient) DeleteWorkflowExecution(arg0 context.Context, arg1 *types.DeleteWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution
+func (mr *MockClientMockRecorder) DeleteWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockClient)(nil).DeleteWorkflowExecution), varargs...)
+}
+
+// PauseActivity mocks base method
+func (m *MockClient) PauseActivity(arg0 context.Context, arg1 *types.PauseActivityRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseActivity", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseActivity indicates an expected call of PauseActivity
+func (mr *MockClientMockRecorder) PauseActivity(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockClient)(nil).PauseActivity), varargs...)
+}
+
+// UnpauseActivity mocks base method
+func (m *MockClient) UnpauseActivity(arg0 context.Context, arg1 *types.UnpauseActivityRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseActivity", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity
+func (mr *MockClientMockRecorder) UnpauseActivity(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockClient)(nil).UnpauseActivity), varargs...)
+}
+
+// ResetActivity mocks base method
+func (m *MockClient) ResetActivity(arg0 context.Context, arg1 *types.ResetActivityRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetActivity", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivity indicates an expected call of ResetActivity
+func (mr *MockClientMockRecorder) ResetActivity(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivity", reflect.TypeOf((*MockClient)(nil).ResetActivity), varargs...)
+}
+
 // ResetStickyTaskList mocks base method
 func (m *MockClient) ResetStickyTaskList(arg0 context.Context, arg1 *types.ResetStickyTaskListRequest, arg2 ...yarpc.CallOption) (*types.ResetStickyTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -534,6 +803,26 @@ func (mr *MockClientMockRecorder) ResetWorkflowExecution(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWorkflowExecution", reflect.TypeOf((*MockClient)(nil).ResetWorkflowExecution), varargs...)
 }
 
+// RestartWorkflowExecution mocks base method
+func (m *MockClient) RestartWorkflowExecution(arg0 context.Context, arg1 *types.RestartWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.RestartWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestartWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*types.RestartWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestartWorkflowExecution indicates an expected call of RestartWorkflowExecution
+func (mr *MockClientMockRecorder) RestartWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartWorkflowExecution", reflect.TypeOf((*MockClient)(nil).RestartWorkflowExecution), varargs...)
+}
+
 // RespondActivityTaskCanceled mocks base method
 func (m *MockClient) RespondActivityTaskCanceled(arg0 context.Context, arg1 *types.RespondActivityTaskCanceledRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
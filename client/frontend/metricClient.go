@@ -101,6 +101,42 @@ func (c *metricClient) DescribeTaskList(
 	return resp, err
 }
 
+func (c *metricClient) DescribeSchedule(
+	ctx context.Context,
+	request *types.DescribeScheduleRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientDescribeScheduleScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientDescribeScheduleScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientDescribeScheduleScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListSchedules(
+	ctx context.Context,
+	request *types.ListSchedulesRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListSchedulesResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientListSchedulesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientListSchedulesScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListSchedules(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientListSchedulesScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *types.DescribeWorkflowExecutionRequest,
@@ -334,6 +370,24 @@ func (c *metricClient) QueryWorkflow(
 	return resp, err
 }
 
+func (c *metricClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientUpdateWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientUpdateWorkflowExecutionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.UpdateWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientUpdateWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	request *types.RecordActivityTaskHeartbeatRequest,
@@ -406,6 +460,204 @@ func (c *metricClient) RequestCancelWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *types.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientPauseWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientPauseWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.PauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientPauseWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) CreateSchedule(
+	ctx context.Context,
+	request *types.CreateScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientCreateScheduleScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientCreateScheduleScope, metrics.CadenceClientLatency)
+	err := c.client.CreateSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientCreateScheduleScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) UpdateSchedule(
+	ctx context.Context,
+	request *types.UpdateScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientUpdateScheduleScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientUpdateScheduleScope, metrics.CadenceClientLatency)
+	err := c.client.UpdateSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientUpdateScheduleScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) PauseSchedule(
+	ctx context.Context,
+	request *types.PauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientPauseScheduleScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientPauseScheduleScope, metrics.CadenceClientLatency)
+	err := c.client.PauseSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientPauseScheduleScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) UnpauseSchedule(
+	ctx context.Context,
+	request *types.UnpauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientUnpauseScheduleScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientUnpauseScheduleScope, metrics.CadenceClientLatency)
+	err := c.client.UnpauseSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientUnpauseScheduleScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) BackfillSchedule(
+	ctx context.Context,
+	request *types.BackfillScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientBackfillScheduleScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientBackfillScheduleScope, metrics.CadenceClientLatency)
+	err := c.client.BackfillSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientBackfillScheduleScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *types.UnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientUnpauseWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientUnpauseWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientUnpauseWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *types.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientDeleteWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientDeleteWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.DeleteWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientDeleteWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) PauseActivity(
+	ctx context.Context,
+	request *types.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientPauseActivityScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientPauseActivityScope, metrics.CadenceClientLatency)
+	err := c.client.PauseActivity(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientPauseActivityScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) UnpauseActivity(
+	ctx context.Context,
+	request *types.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientUnpauseActivityScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientUnpauseActivityScope, metrics.CadenceClientLatency)
+	err := c.client.UnpauseActivity(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientUnpauseActivityScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ResetActivity(
+	ctx context.Context,
+	request *types.ResetActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientResetActivityScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientResetActivityScope, metrics.CadenceClientLatency)
+	err := c.client.ResetActivity(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientResetActivityScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *metricClient) ResetStickyTaskList(
 	ctx context.Context,
 	request *types.ResetStickyTaskListRequest,
@@ -442,6 +694,24 @@ func (c *metricClient) ResetWorkflowExecution(
 	return resp, err
 }
 
+func (c *metricClient) RestartWorkflowExecution(
+	ctx context.Context,
+	request *types.RestartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.RestartWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientRestartWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientRestartWorkflowExecutionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.RestartWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientRestartWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) RespondActivityTaskCanceled(
 	ctx context.Context,
 	request *types.RespondActivityTaskCanceledRequest,
@@ -94,6 +94,38 @@ func (c *retryableClient) DescribeTaskList(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeSchedule(
+	ctx context.Context,
+	request *types.DescribeScheduleRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeScheduleResponse, error) {
+
+	var resp *types.DescribeScheduleResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeSchedule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListSchedules(
+	ctx context.Context,
+	request *types.ListSchedulesRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListSchedulesResponse, error) {
+
+	var resp *types.ListSchedulesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListSchedules(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *types.DescribeWorkflowExecutionRequest,
@@ -301,6 +333,22 @@ func (c *retryableClient) QueryWorkflow(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateWorkflowExecutionResponse, error) {
+
+	var resp *types.UpdateWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	request *types.RecordActivityTaskHeartbeatRequest,
@@ -357,6 +405,138 @@ func (c *retryableClient) RequestCancelWorkflowExecution(
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
 
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *types.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PauseWorkflowExecution(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) CreateSchedule(
+	ctx context.Context,
+	request *types.CreateScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.CreateSchedule(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) UpdateSchedule(
+	ctx context.Context,
+	request *types.UpdateScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UpdateSchedule(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) PauseSchedule(
+	ctx context.Context,
+	request *types.PauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PauseSchedule(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) UnpauseSchedule(
+	ctx context.Context,
+	request *types.UnpauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UnpauseSchedule(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) BackfillSchedule(
+	ctx context.Context,
+	request *types.BackfillScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.BackfillSchedule(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *types.UnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *types.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.DeleteWorkflowExecution(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) PauseActivity(
+	ctx context.Context,
+	request *types.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PauseActivity(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) UnpauseActivity(
+	ctx context.Context,
+	request *types.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UnpauseActivity(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ResetActivity(
+	ctx context.Context,
+	request *types.ResetActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ResetActivity(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) ResetStickyTaskList(
 	ctx context.Context,
 	request *types.ResetStickyTaskListRequest,
@@ -389,6 +569,22 @@ func (c *retryableClient) ResetWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) RestartWorkflowExecution(
+	ctx context.Context,
+	request *types.RestartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.RestartWorkflowExecutionResponse, error) {
+
+	var resp *types.RestartWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RestartWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RespondActivityTaskCanceled(
 	ctx context.Context,
 	request *types.RespondActivityTaskCanceledRequest,
@@ -59,6 +59,16 @@ func (t thriftClient) DescribeTaskList(ctx context.Context, request *types.Descr
 	return thrift.ToDescribeTaskListResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) DescribeSchedule(ctx context.Context, request *types.DescribeScheduleRequest, opts ...yarpc.CallOption) (*types.DescribeScheduleResponse, error) {
+	response, err := t.c.DescribeSchedule(ctx, thrift.FromDescribeScheduleRequest(request), opts...)
+	return thrift.ToDescribeScheduleResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) ListSchedules(ctx context.Context, request *types.ListSchedulesRequest, opts ...yarpc.CallOption) (*types.ListSchedulesResponse, error) {
+	response, err := t.c.ListSchedules(ctx, thrift.FromListSchedulesRequest(request), opts...)
+	return thrift.ToListSchedulesResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) DescribeWorkflowExecution(ctx context.Context, request *types.DescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
 	response, err := t.c.DescribeWorkflowExecution(ctx, thrift.FromDescribeWorkflowExecutionRequest(request), opts...)
 	return thrift.ToDescribeWorkflowExecutionResponse(response), thrift.ToError(err)
@@ -129,6 +139,11 @@ func (t thriftClient) QueryWorkflow(ctx context.Context, request *types.QueryWor
 	return thrift.ToQueryWorkflowResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) UpdateWorkflowExecution(ctx context.Context, request *types.UpdateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.UpdateWorkflowExecutionResponse, error) {
+	response, err := t.c.UpdateWorkflowExecution(ctx, thrift.FromUpdateWorkflowExecutionRequest(request), opts...)
+	return thrift.ToUpdateWorkflowExecutionResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) RecordActivityTaskHeartbeat(ctx context.Context, request *types.RecordActivityTaskHeartbeatRequest, opts ...yarpc.CallOption) (*types.RecordActivityTaskHeartbeatResponse, error) {
 	response, err := t.c.RecordActivityTaskHeartbeat(ctx, thrift.FromRecordActivityTaskHeartbeatRequest(request), opts...)
 	return thrift.ToRecordActivityTaskHeartbeatResponse(response), thrift.ToError(err)
@@ -149,6 +164,61 @@ func (t thriftClient) RequestCancelWorkflowExecution(ctx context.Context, reques
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) PauseWorkflowExecution(ctx context.Context, request *types.PauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.PauseWorkflowExecution(ctx, thrift.FromPauseWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) CreateSchedule(ctx context.Context, request *types.CreateScheduleRequest, opts ...yarpc.CallOption) error {
+	err := t.c.CreateSchedule(ctx, thrift.FromCreateScheduleRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) UpdateSchedule(ctx context.Context, request *types.UpdateScheduleRequest, opts ...yarpc.CallOption) error {
+	err := t.c.UpdateSchedule(ctx, thrift.FromUpdateScheduleRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) PauseSchedule(ctx context.Context, request *types.PauseScheduleRequest, opts ...yarpc.CallOption) error {
+	err := t.c.PauseSchedule(ctx, thrift.FromPauseScheduleRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) UnpauseSchedule(ctx context.Context, request *types.UnpauseScheduleRequest, opts ...yarpc.CallOption) error {
+	err := t.c.UnpauseSchedule(ctx, thrift.FromUnpauseScheduleRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) BackfillSchedule(ctx context.Context, request *types.BackfillScheduleRequest, opts ...yarpc.CallOption) error {
+	err := t.c.BackfillSchedule(ctx, thrift.FromBackfillScheduleRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) UnpauseWorkflowExecution(ctx context.Context, request *types.UnpauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.UnpauseWorkflowExecution(ctx, thrift.FromUnpauseWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) DeleteWorkflowExecution(ctx context.Context, request *types.DeleteWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.DeleteWorkflowExecution(ctx, thrift.FromDeleteWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) PauseActivity(ctx context.Context, request *types.PauseActivityRequest, opts ...yarpc.CallOption) error {
+	err := t.c.PauseActivity(ctx, thrift.FromPauseActivityRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) UnpauseActivity(ctx context.Context, request *types.UnpauseActivityRequest, opts ...yarpc.CallOption) error {
+	err := t.c.UnpauseActivity(ctx, thrift.FromUnpauseActivityRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) ResetActivity(ctx context.Context, request *types.ResetActivityRequest, opts ...yarpc.CallOption) error {
+	err := t.c.ResetActivity(ctx, thrift.FromResetActivityRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) ResetStickyTaskList(ctx context.Context, request *types.ResetStickyTaskListRequest, opts ...yarpc.CallOption) (*types.ResetStickyTaskListResponse, error) {
 	response, err := t.c.ResetStickyTaskList(ctx, thrift.FromResetStickyTaskListRequest(request), opts...)
 	return thrift.ToResetStickyTaskListResponse(response), thrift.ToError(err)
@@ -159,6 +229,11 @@ func (t thriftClient) ResetWorkflowExecution(ctx context.Context, request *types
 	return thrift.ToResetWorkflowExecutionResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) RestartWorkflowExecution(ctx context.Context, request *types.RestartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.RestartWorkflowExecutionResponse, error) {
+	response, err := t.c.RestartWorkflowExecution(ctx, thrift.FromRestartWorkflowExecutionRequest(request), opts...)
+	return thrift.ToRestartWorkflowExecutionResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) RespondActivityTaskCanceled(ctx context.Context, request *types.RespondActivityTaskCanceledRequest, opts ...yarpc.CallOption) error {
 	err := t.c.RespondActivityTaskCanceled(ctx, thrift.FromRespondActivityTaskCanceledRequest(request), opts...)
 	return thrift.ToError(err)
@@ -22,6 +22,7 @@ package history
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
 
@@ -252,6 +253,31 @@ func (c *clientImpl) ResetQueue(
 	return nil
 }
 
+func (c *clientImpl) PurgeHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.PurgeHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) error {
+	client, err := c.getClientForShardID(int(request.GetShardID()))
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client Client) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		err = client.PurgeHistoryTaskDLQ(ctx, request, opts...)
+		return err
+	}
+
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (c *clientImpl) DescribeQueue(
 	ctx context.Context,
 	request *types.DescribeQueueRequest,
@@ -278,6 +304,123 @@ func (c *clientImpl) DescribeQueue(
 	return response, nil
 }
 
+func (c *clientImpl) ReadHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.ReadHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (*types.ReadHistoryTaskDLQResponse, error) {
+	client, err := c.getClientForShardID(int(request.GetShardID()))
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *types.ReadHistoryTaskDLQResponse
+	op := func(ctx context.Context, client Client) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ReadHistoryTaskDLQ(ctx, request, opts...)
+		return err
+	}
+
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) DescribeReplicationStatus(
+	ctx context.Context,
+	request *types.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeReplicationStatusResponse, error) {
+	requestsByClient := make(map[Client]*types.DescribeReplicationStatusRequest)
+
+	for _, shardID := range request.GetShardIDs() {
+		client, err := c.getClientForShardID(int(shardID))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := requestsByClient[client]; !ok {
+			requestsByClient[client] = &types.DescribeReplicationStatusRequest{
+				ClusterName: request.ClusterName,
+				Domain:      request.Domain,
+			}
+		}
+
+		req := requestsByClient[client]
+		req.ShardIDs = append(req.ShardIDs, shardID)
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(len(requestsByClient))
+	respChan := make(chan *types.DescribeReplicationStatusResponse, len(requestsByClient))
+	errChan := make(chan error, 1)
+
+	for client, req := range requestsByClient {
+		go func(client Client, request *types.DescribeReplicationStatusRequest) {
+			defer wg.Done()
+			resp, err := client.DescribeReplicationStatus(ctx, request, opts...)
+			if err != nil {
+				select {
+				case errChan <- err:
+				default:
+				}
+				return
+			}
+			respChan <- resp
+		}(client, req)
+	}
+
+	wg.Wait()
+	close(respChan)
+	close(errChan)
+
+	if len(errChan) > 0 {
+		return nil, <-errChan
+	}
+	response := &types.DescribeReplicationStatusResponse{}
+	for resp := range respChan {
+		response.Shards = append(response.Shards, resp.Shards...)
+	}
+	sort.Slice(response.Shards, func(i, j int) bool {
+		return response.Shards[i].ShardID < response.Shards[j].ShardID
+	})
+	return response, nil
+}
+
+func (c *clientImpl) RetryHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.RetryHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (*types.RetryHistoryTaskDLQResponse, error) {
+	client, err := c.getClientForShardID(int(request.GetShardID()))
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *types.RetryHistoryTaskDLQResponse
+	op := func(ctx context.Context, client Client) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.RetryHistoryTaskDLQ(ctx, request, opts...)
+		return err
+	}
+
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) DescribeMutableState(
 	ctx context.Context,
 	request *types.DescribeMutableStateRequest,
@@ -567,6 +710,114 @@ func (c *clientImpl) RequestCancelWorkflowExecution(
 	return c.executeWithRedirect(ctx, client, op)
 }
 
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryPauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	client, err := c.getClientForWorkflowID(request.PauseRequest.WorkflowExecution.WorkflowID)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client Client) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.PauseWorkflowExecution(ctx, request, opts...)
+	}
+	return c.executeWithRedirect(ctx, client, op)
+}
+
+func (c *clientImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryUnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	client, err := c.getClientForWorkflowID(request.UnpauseRequest.WorkflowExecution.WorkflowID)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client Client) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.UnpauseWorkflowExecution(ctx, request, opts...)
+	}
+	return c.executeWithRedirect(ctx, client, op)
+}
+
+func (c *clientImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryDeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	client, err := c.getClientForWorkflowID(request.DeleteRequest.WorkflowExecution.WorkflowID)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client Client) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.DeleteWorkflowExecution(ctx, request, opts...)
+	}
+	return c.executeWithRedirect(ctx, client, op)
+}
+
+func (c *clientImpl) PauseActivity(
+	ctx context.Context,
+	request *types.HistoryPauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	client, err := c.getClientForWorkflowID(request.PauseRequest.WorkflowExecution.WorkflowID)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client Client) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.PauseActivity(ctx, request, opts...)
+	}
+	return c.executeWithRedirect(ctx, client, op)
+}
+
+func (c *clientImpl) UnpauseActivity(
+	ctx context.Context,
+	request *types.HistoryUnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	client, err := c.getClientForWorkflowID(request.UnpauseRequest.WorkflowExecution.WorkflowID)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client Client) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.UnpauseActivity(ctx, request, opts...)
+	}
+	return c.executeWithRedirect(ctx, client, op)
+}
+
+func (c *clientImpl) ResetActivity(
+	ctx context.Context,
+	request *types.HistoryResetActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	client, err := c.getClientForWorkflowID(request.ResetRequest.WorkflowExecution.WorkflowID)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client Client) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.ResetActivity(ctx, request, opts...)
+	}
+	return c.executeWithRedirect(ctx, client, op)
+}
+
 func (c *clientImpl) SignalWorkflowExecution(
 	ctx context.Context,
 	request *types.HistorySignalWorkflowExecutionRequest,
@@ -799,6 +1050,56 @@ func (c *clientImpl) QueryWorkflow(
 	return response, nil
 }
 
+func (c *clientImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryUpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.HistoryUpdateWorkflowExecutionResponse, error) {
+	client, err := c.getClientForWorkflowID(request.GetRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *types.HistoryUpdateWorkflowExecutionResponse
+	op := func(ctx context.Context, client Client) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.UpdateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) MigrateWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryMigrateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.MigrateWorkflowExecutionResponse, error) {
+	client, err := c.getClientForWorkflowID(request.GetWorkflowID())
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *types.MigrateWorkflowExecutionResponse
+	op := func(ctx context.Context, client Client) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.MigrateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) GetReplicationMessages(
 	ctx context.Context,
 	request *types.GetReplicationMessagesRequest,
@@ -58,6 +58,21 @@ func (g grpcClient) DescribeQueue(ctx context.Context, request *types.DescribeQu
 	return proto.ToHistoryDescribeQueueResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) ReadHistoryTaskDLQ(ctx context.Context, request *types.ReadHistoryTaskDLQRequest, opts ...yarpc.CallOption) (*types.ReadHistoryTaskDLQResponse, error) {
+	response, err := g.c.ReadHistoryTaskDLQ(ctx, proto.FromHistoryReadHistoryTaskDLQRequest(request), opts...)
+	return proto.ToHistoryReadHistoryTaskDLQResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) DescribeReplicationStatus(ctx context.Context, request *types.DescribeReplicationStatusRequest, opts ...yarpc.CallOption) (*types.DescribeReplicationStatusResponse, error) {
+	response, err := g.c.DescribeReplicationStatus(ctx, proto.FromHistoryDescribeReplicationStatusRequest(request), opts...)
+	return proto.ToHistoryDescribeReplicationStatusResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) RetryHistoryTaskDLQ(ctx context.Context, request *types.RetryHistoryTaskDLQRequest, opts ...yarpc.CallOption) (*types.RetryHistoryTaskDLQResponse, error) {
+	response, err := g.c.RetryHistoryTaskDLQ(ctx, proto.FromHistoryRetryHistoryTaskDLQRequest(request), opts...)
+	return proto.ToHistoryRetryHistoryTaskDLQResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) DescribeWorkflowExecution(ctx context.Context, request *types.HistoryDescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
 	response, err := g.c.DescribeWorkflowExecution(ctx, proto.FromHistoryDescribeWorkflowExecutionRequest(request), opts...)
 	return proto.ToHistoryDescribeWorkflowExecutionResponse(response), proto.ToError(err)
@@ -108,6 +123,16 @@ func (g grpcClient) QueryWorkflow(ctx context.Context, request *types.HistoryQue
 	return proto.ToHistoryQueryWorkflowResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) UpdateWorkflowExecution(ctx context.Context, request *types.HistoryUpdateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.HistoryUpdateWorkflowExecutionResponse, error) {
+	response, err := g.c.UpdateWorkflowExecution(ctx, proto.FromHistoryUpdateWorkflowExecutionRequest(request), opts...)
+	return proto.ToHistoryUpdateWorkflowExecutionResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) MigrateWorkflowExecution(ctx context.Context, request *types.HistoryMigrateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.MigrateWorkflowExecutionResponse, error) {
+	response, err := g.c.MigrateWorkflowExecution(ctx, proto.FromHistoryMigrateWorkflowExecutionRequest(request), opts...)
+	return proto.ToHistoryMigrateWorkflowExecutionResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) ReadDLQMessages(ctx context.Context, request *types.ReadDLQMessagesRequest, opts ...yarpc.CallOption) (*types.ReadDLQMessagesResponse, error) {
 	response, err := g.c.ReadDLQMessages(ctx, proto.FromHistoryReadDLQMessagesRequest(request), opts...)
 	return proto.ToHistoryReadDLQMessagesResponse(response), proto.ToError(err)
@@ -163,11 +188,46 @@ func (g grpcClient) RequestCancelWorkflowExecution(ctx context.Context, request
 	return proto.ToError(err)
 }
 
+func (g grpcClient) PauseWorkflowExecution(ctx context.Context, request *types.HistoryPauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.PauseWorkflowExecution(ctx, proto.FromHistoryPauseWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) UnpauseWorkflowExecution(ctx context.Context, request *types.HistoryUnpauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.UnpauseWorkflowExecution(ctx, proto.FromHistoryUnpauseWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) DeleteWorkflowExecution(ctx context.Context, request *types.HistoryDeleteWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.DeleteWorkflowExecution(ctx, proto.FromHistoryDeleteWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) PauseActivity(ctx context.Context, request *types.HistoryPauseActivityRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.PauseActivity(ctx, proto.FromHistoryPauseActivityRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) UnpauseActivity(ctx context.Context, request *types.HistoryUnpauseActivityRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.UnpauseActivity(ctx, proto.FromHistoryUnpauseActivityRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) ResetActivity(ctx context.Context, request *types.HistoryResetActivityRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.ResetActivity(ctx, proto.FromHistoryResetActivityRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) ResetQueue(ctx context.Context, request *types.ResetQueueRequest, opts ...yarpc.CallOption) error {
 	_, err := g.c.ResetQueue(ctx, proto.FromHistoryResetQueueRequest(request), opts...)
 	return proto.ToError(err)
 }
 
+func (g grpcClient) PurgeHistoryTaskDLQ(ctx context.Context, request *types.PurgeHistoryTaskDLQRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.PurgeHistoryTaskDLQ(ctx, proto.FromHistoryPurgeHistoryTaskDLQRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) ResetStickyTaskList(ctx context.Context, request *types.HistoryResetStickyTaskListRequest, opts ...yarpc.CallOption) (*types.HistoryResetStickyTaskListResponse, error) {
 	_, err := g.c.ResetStickyTaskList(ctx, proto.FromHistoryResetStickyTaskListRequest(request), opts...)
 	return &types.HistoryResetStickyTaskListResponse{}, proto.ToError(err)
@@ -36,6 +36,9 @@ type Client interface {
 	DescribeHistoryHost(context.Context, *types.DescribeHistoryHostRequest, ...yarpc.CallOption) (*types.DescribeHistoryHostResponse, error)
 	DescribeMutableState(context.Context, *types.DescribeMutableStateRequest, ...yarpc.CallOption) (*types.DescribeMutableStateResponse, error)
 	DescribeQueue(context.Context, *types.DescribeQueueRequest, ...yarpc.CallOption) (*types.DescribeQueueResponse, error)
+	ReadHistoryTaskDLQ(context.Context, *types.ReadHistoryTaskDLQRequest, ...yarpc.CallOption) (*types.ReadHistoryTaskDLQResponse, error)
+	DescribeReplicationStatus(context.Context, *types.DescribeReplicationStatusRequest, ...yarpc.CallOption) (*types.DescribeReplicationStatusResponse, error)
+	RetryHistoryTaskDLQ(context.Context, *types.RetryHistoryTaskDLQRequest, ...yarpc.CallOption) (*types.RetryHistoryTaskDLQResponse, error)
 	DescribeWorkflowExecution(context.Context, *types.HistoryDescribeWorkflowExecutionRequest, ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error)
 	GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest, ...yarpc.CallOption) (*types.GetCrossClusterTasksResponse, error)
 	GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest, ...yarpc.CallOption) (*types.GetDLQReplicationMessagesResponse, error)
@@ -46,6 +49,8 @@ type Client interface {
 	PollMutableState(context.Context, *types.PollMutableStateRequest, ...yarpc.CallOption) (*types.PollMutableStateResponse, error)
 	PurgeDLQMessages(context.Context, *types.PurgeDLQMessagesRequest, ...yarpc.CallOption) error
 	QueryWorkflow(context.Context, *types.HistoryQueryWorkflowRequest, ...yarpc.CallOption) (*types.HistoryQueryWorkflowResponse, error)
+	UpdateWorkflowExecution(context.Context, *types.HistoryUpdateWorkflowExecutionRequest, ...yarpc.CallOption) (*types.HistoryUpdateWorkflowExecutionResponse, error)
+	MigrateWorkflowExecution(context.Context, *types.HistoryMigrateWorkflowExecutionRequest, ...yarpc.CallOption) (*types.MigrateWorkflowExecutionResponse, error)
 	ReadDLQMessages(context.Context, *types.ReadDLQMessagesRequest, ...yarpc.CallOption) (*types.ReadDLQMessagesResponse, error)
 	ReapplyEvents(context.Context, *types.HistoryReapplyEventsRequest, ...yarpc.CallOption) error
 	RecordActivityTaskHeartbeat(context.Context, *types.HistoryRecordActivityTaskHeartbeatRequest, ...yarpc.CallOption) (*types.RecordActivityTaskHeartbeatResponse, error)
@@ -57,7 +62,14 @@ type Client interface {
 	RemoveTask(context.Context, *types.RemoveTaskRequest, ...yarpc.CallOption) error
 	ReplicateEventsV2(context.Context, *types.ReplicateEventsV2Request, ...yarpc.CallOption) error
 	RequestCancelWorkflowExecution(context.Context, *types.HistoryRequestCancelWorkflowExecutionRequest, ...yarpc.CallOption) error
+	PauseWorkflowExecution(context.Context, *types.HistoryPauseWorkflowExecutionRequest, ...yarpc.CallOption) error
+	UnpauseWorkflowExecution(context.Context, *types.HistoryUnpauseWorkflowExecutionRequest, ...yarpc.CallOption) error
+	DeleteWorkflowExecution(context.Context, *types.HistoryDeleteWorkflowExecutionRequest, ...yarpc.CallOption) error
+	PauseActivity(context.Context, *types.HistoryPauseActivityRequest, ...yarpc.CallOption) error
+	UnpauseActivity(context.Context, *types.HistoryUnpauseActivityRequest, ...yarpc.CallOption) error
+	ResetActivity(context.Context, *types.HistoryResetActivityRequest, ...yarpc.CallOption) error
 	ResetQueue(context.Context, *types.ResetQueueRequest, ...yarpc.CallOption) error
+	PurgeHistoryTaskDLQ(context.Context, *types.PurgeHistoryTaskDLQRequest, ...yarpc.CallOption) error
 	ResetStickyTaskList(context.Context, *types.HistoryResetStickyTaskListRequest, ...yarpc.CallOption) (*types.HistoryResetStickyTaskListResponse, error)
 	ResetWorkflowExecution(context.Context, *types.HistoryResetWorkflowExecutionRequest, ...yarpc.CallOption) (*types.ResetWorkflowExecutionResponse, error)
 	RespondActivityTaskCanceled(context.Context, *types.HistoryRespondActivityTaskCanceledRequest, ...yarpc.CallOption) error
@@ -21,7 +21,7 @@
 // SOFTWARE.
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: interface.go
+// Source: client/history/interface.go
 
 // Package history is a generated GoMock package.
 package history
@@ -138,6 +138,66 @@ func (mr *MockClientMockRecorder) DescribeQueue(arg0, arg1 interface{}, arg2 ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueue", reflect.TypeOf((*MockClient)(nil).DescribeQueue), varargs...)
 }
 
+// ReadHistoryTaskDLQ mocks base method
+func (m *MockClient) ReadHistoryTaskDLQ(arg0 context.Context, arg1 *types.ReadHistoryTaskDLQRequest, arg2 ...yarpc.CallOption) (*types.ReadHistoryTaskDLQResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReadHistoryTaskDLQ", varargs...)
+	ret0, _ := ret[0].(*types.ReadHistoryTaskDLQResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplicationStatus mocks base method
+func (m *MockClient) DescribeReplicationStatus(arg0 context.Context, arg1 *types.DescribeReplicationStatusRequest, arg2 ...yarpc.CallOption) (*types.DescribeReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeReplicationStatus", varargs...)
+	ret0, _ := ret[0].(*types.DescribeReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadHistoryTaskDLQ indicates an expected call of ReadHistoryTaskDLQ
+func (mr *MockClientMockRecorder) ReadHistoryTaskDLQ(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadHistoryTaskDLQ", reflect.TypeOf((*MockClient)(nil).ReadHistoryTaskDLQ), varargs...)
+}
+
+// DescribeReplicationStatus indicates an expected call of DescribeReplicationStatus
+func (mr *MockClientMockRecorder) DescribeReplicationStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplicationStatus", reflect.TypeOf((*MockClient)(nil).DescribeReplicationStatus), varargs...)
+}
+
+// RetryHistoryTaskDLQ mocks base method
+func (m *MockClient) RetryHistoryTaskDLQ(arg0 context.Context, arg1 *types.RetryHistoryTaskDLQRequest, arg2 ...yarpc.CallOption) (*types.RetryHistoryTaskDLQResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RetryHistoryTaskDLQ", varargs...)
+	ret0, _ := ret[0].(*types.RetryHistoryTaskDLQResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryHistoryTaskDLQ indicates an expected call of RetryHistoryTaskDLQ
+func (mr *MockClientMockRecorder) RetryHistoryTaskDLQ(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryHistoryTaskDLQ", reflect.TypeOf((*MockClient)(nil).RetryHistoryTaskDLQ), varargs...)
+}
+
 // DescribeWorkflowExecution mocks base method
 func (m *MockClient) DescribeWorkflowExecution(arg0 context.Context, arg1 *types.HistoryDescribeWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -336,6 +396,46 @@ func (mr *MockClientMockRecorder) QueryWorkflow(arg0, arg1 interface{}, arg2 ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockClient)(nil).QueryWorkflow), varargs...)
 }
 
+// UpdateWorkflowExecution mocks base method
+func (m *MockClient) UpdateWorkflowExecution(arg0 context.Context, arg1 *types.HistoryUpdateWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.HistoryUpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*types.HistoryUpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateWorkflowExecution mocks base method
+func (m *MockClient) MigrateWorkflowExecution(arg0 context.Context, arg1 *types.HistoryMigrateWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.MigrateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigrateWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*types.MigrateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution
+func (mr *MockClientMockRecorder) UpdateWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockClient)(nil).UpdateWorkflowExecution), varargs...)
+}
+
+// MigrateWorkflowExecution indicates an expected call of MigrateWorkflowExecution
+func (mr *MockClientMockRecorder) MigrateWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateWorkflowExecution", reflect.TypeOf((*MockClient)(nil).MigrateWorkflowExecution), varargs...)
+}
+
 // ReadDLQMessages mocks base method
 func (m *MockClient) ReadDLQMessages(arg0 context.Context, arg1 *types.ReadDLQMessagesRequest, arg2 ...yarpc.CallOption) (*types.ReadDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -549,6 +649,120 @@ func (mr *MockClientMockRecorder) RequestCancelWorkflowExecution(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockClient)(nil).RequestCancelWorkflowExecution), varargs...)
 }
 
+// PauseWorkflowExecution mocks base method
+func (m *MockClient) PauseWorkflowExecution(arg0 context.Context, arg1 *types.HistoryPauseWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution
+func (mr *MockClientMockRecorder) PauseWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockClient)(nil).PauseWorkflowExecution), varargs...)
+}
+
+// UnpauseWorkflowExecution mocks base method
+func (m *MockClient) UnpauseWorkflowExecution(arg0 context.Context, arg1 *types.HistoryUnpauseWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution
+func (mr *MockClientMockRecorder) UnpauseWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockClient)(nil).UnpauseWorkflowExecution), varargs...)
+}
+
+// DeleteWorkflowExecution mocks base method
+func (m *MockClient) DeleteWorkflowExecution(arg0 context.Context, arg1 *types.HistoryDeleteWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution
+func (mr *MockClientMockRecorder) DeleteWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockClient)(nil).DeleteWorkflowExecution), varargs...)
+}
+
+// PauseActivity mocks base method
+func (m *MockClient) PauseActivity(arg0 context.Context, arg1 *types.HistoryPauseActivityRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseActivity", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseActivity indicates an expected call of PauseActivity
+func (mr *MockClientMockRecorder) PauseActivity(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockClient)(nil).PauseActivity), varargs...)
+}
+
+// UnpauseActivity mocks base method
+func (m *MockClient) UnpauseActivity(arg0 context.Context, arg1 *types.HistoryUnpauseActivityRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseActivity", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity
+func (mr *MockClientMockRecorder) UnpauseActivity(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockClient)(nil).UnpauseActivity), varargs...)
+}
+
+// ResetActivity mocks base method
+func (m *MockClient) ResetActivity(arg0 context.Context, arg1 *types.HistoryResetActivityRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetActivity", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivity indicates an expected call of ResetActivity
+func (mr *MockClientMockRecorder) ResetActivity(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivity", reflect.TypeOf((*MockClient)(nil).ResetActivity), varargs...)
+}
+
 // ResetQueue mocks base method
 func (m *MockClient) ResetQueue(arg0 context.Context, arg1 *types.ResetQueueRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -568,6 +782,25 @@ func (mr *MockClientMockRecorder) ResetQueue(arg0, arg1 interface{}, arg2 ...int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetQueue", reflect.TypeOf((*MockClient)(nil).ResetQueue), varargs...)
 }
 
+// PurgeHistoryTaskDLQ mocks base method
+func (m *MockClient) PurgeHistoryTaskDLQ(arg0 context.Context, arg1 *types.PurgeHistoryTaskDLQRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeHistoryTaskDLQ", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeHistoryTaskDLQ indicates an expected call of PurgeHistoryTaskDLQ
+func (mr *MockClientMockRecorder) PurgeHistoryTaskDLQ(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeHistoryTaskDLQ", reflect.TypeOf((*MockClient)(nil).PurgeHistoryTaskDLQ), varargs...)
+}
+
 // ResetStickyTaskList mocks base method
 func (m *MockClient) ResetStickyTaskList(arg0 context.Context, arg1 *types.HistoryResetStickyTaskListRequest, arg2 ...yarpc.CallOption) (*types.HistoryResetStickyTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -665,19 +898,6 @@ func (mr *MockClientMockRecorder) RespondActivityTaskFailed(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondActivityTaskFailed", reflect.TypeOf((*MockClient)(nil).RespondActivityTaskFailed), varargs...)
 }
 
-// RespondDecisionTaskCompleted mocks base method
-func (m *MockClient) RespondDecisionTaskCompleted(arg0 context.Context, arg1 *types.HistoryRespondDecisionTaskCompletedRequest, arg2 ...yarpc.CallOption) (*types.HistoryRespondDecisionTaskCompletedResponse, error) {
-	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "RespondDecisionTaskCompleted", varargs...)
-	ret0, _ := ret[0].(*types.HistoryRespondDecisionTaskCompletedResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
 // RespondCrossClusterTasksCompleted mocks base method
 func (m *MockClient) RespondCrossClusterTasksCompleted(arg0 context.Context, arg1 *types.RespondCrossClusterTasksCompletedRequest, arg2 ...yarpc.CallOption) (*types.RespondCrossClusterTasksCompletedResponse, error) {
 	m.ctrl.T.Helper()
@@ -698,6 +918,19 @@ func (mr *MockClientMockRecorder) RespondCrossClusterTasksCompleted(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondCrossClusterTasksCompleted", reflect.TypeOf((*MockClient)(nil).RespondCrossClusterTasksCompleted), varargs...)
 }
 
+// RespondDecisionTaskCompleted mocks base method
+func (m *MockClient) RespondDecisionTaskCompleted(arg0 context.Context, arg1 *types.HistoryRespondDecisionTaskCompletedRequest, arg2 ...yarpc.CallOption) (*types.HistoryRespondDecisionTaskCompletedResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RespondDecisionTaskCompleted", varargs...)
+	ret0, _ := ret[0].(*types.HistoryRespondDecisionTaskCompletedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // RespondDecisionTaskCompleted indicates an expected call of RespondDecisionTaskCompleted
 func (mr *MockClientMockRecorder) RespondDecisionTaskCompleted(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
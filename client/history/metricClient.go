@@ -134,6 +134,24 @@ func (c *metricClient) ResetQueue(
 	return err
 }
 
+func (c *metricClient) PurgeHistoryTaskDLQ(
+	context context.Context,
+	request *types.PurgeHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientPurgeHistoryTaskDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientPurgeHistoryTaskDLQScope, metrics.CadenceClientLatency)
+	err := c.client.PurgeHistoryTaskDLQ(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientPurgeHistoryTaskDLQScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
 func (c *metricClient) DescribeQueue(
 	context context.Context,
 	request *types.DescribeQueueRequest,
@@ -152,6 +170,60 @@ func (c *metricClient) DescribeQueue(
 	return resp, err
 }
 
+func (c *metricClient) ReadHistoryTaskDLQ(
+	context context.Context,
+	request *types.ReadHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (*types.ReadHistoryTaskDLQResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientReadHistoryTaskDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientReadHistoryTaskDLQScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ReadHistoryTaskDLQ(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientReadHistoryTaskDLQScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) DescribeReplicationStatus(
+	context context.Context,
+	request *types.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeReplicationStatusResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientDescribeReplicationStatusScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeReplicationStatusScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeReplicationStatus(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDescribeReplicationStatusScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) RetryHistoryTaskDLQ(
+	context context.Context,
+	request *types.RetryHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (*types.RetryHistoryTaskDLQResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientRetryHistoryTaskDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRetryHistoryTaskDLQScope, metrics.CadenceClientLatency)
+	resp, err := c.client.RetryHistoryTaskDLQ(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRetryHistoryTaskDLQScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) DescribeMutableState(
 	context context.Context,
 	request *types.DescribeMutableStateRequest,
@@ -404,6 +476,114 @@ func (c *metricClient) RequestCancelWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) PauseWorkflowExecution(
+	context context.Context,
+	request *types.HistoryPauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.PauseWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) UnpauseWorkflowExecution(
+	context context.Context,
+	request *types.HistoryUnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientUnpauseWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUnpauseWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.UnpauseWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUnpauseWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) DeleteWorkflowExecution(
+	context context.Context,
+	request *types.HistoryDeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.DeleteWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) PauseActivity(
+	context context.Context,
+	request *types.HistoryPauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientPauseActivityScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientPauseActivityScope, metrics.CadenceClientLatency)
+	err := c.client.PauseActivity(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientPauseActivityScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) UnpauseActivity(
+	context context.Context,
+	request *types.HistoryUnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientUnpauseActivityScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUnpauseActivityScope, metrics.CadenceClientLatency)
+	err := c.client.UnpauseActivity(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUnpauseActivityScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) ResetActivity(
+	context context.Context,
+	request *types.HistoryResetActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientResetActivityScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientResetActivityScope, metrics.CadenceClientLatency)
+	err := c.client.ResetActivity(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientResetActivityScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
 func (c *metricClient) SignalWorkflowExecution(
 	context context.Context,
 	request *types.HistorySignalWorkflowExecutionRequest,
@@ -638,6 +818,42 @@ func (c *metricClient) QueryWorkflow(
 	return resp, err
 }
 
+func (c *metricClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryUpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.HistoryUpdateWorkflowExecutionResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientUpdateWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUpdateWorkflowExecutionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.UpdateWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUpdateWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) MigrateWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryMigrateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.MigrateWorkflowExecutionResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientMigrateWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientMigrateWorkflowExecutionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.MigrateWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientMigrateWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) ReapplyEvents(
 	ctx context.Context,
 	request *types.HistoryReapplyEventsRequest,
@@ -114,6 +114,21 @@ func (c *retryableClient) ResetQueue(
 	return err
 }
 
+func (c *retryableClient) PurgeHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.PurgeHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		err := c.client.PurgeHistoryTaskDLQ(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return err
+}
+
 func (c *retryableClient) DescribeQueue(
 	ctx context.Context,
 	request *types.DescribeQueueRequest,
@@ -131,6 +146,57 @@ func (c *retryableClient) DescribeQueue(
 	return resp, err
 }
 
+func (c *retryableClient) ReadHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.ReadHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (*types.ReadHistoryTaskDLQResponse, error) {
+
+	var resp *types.ReadHistoryTaskDLQResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ReadHistoryTaskDLQ(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeReplicationStatus(
+	ctx context.Context,
+	request *types.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeReplicationStatusResponse, error) {
+
+	var resp *types.DescribeReplicationStatusResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeReplicationStatus(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RetryHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.RetryHistoryTaskDLQRequest,
+	opts ...yarpc.CallOption,
+) (*types.RetryHistoryTaskDLQResponse, error) {
+
+	var resp *types.RetryHistoryTaskDLQResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RetryHistoryTaskDLQ(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RemoveTask(
 	ctx context.Context,
 	request *types.RemoveTaskRequest,
@@ -364,6 +430,84 @@ func (c *retryableClient) RequestCancelWorkflowExecution(
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
 
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryPauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PauseWorkflowExecution(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryUnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryDeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.DeleteWorkflowExecution(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) PauseActivity(
+	ctx context.Context,
+	request *types.HistoryPauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PauseActivity(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) UnpauseActivity(
+	ctx context.Context,
+	request *types.HistoryUnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UnpauseActivity(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ResetActivity(
+	ctx context.Context,
+	request *types.HistoryResetActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ResetActivity(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) SignalWorkflowExecution(
 	ctx context.Context,
 	request *types.HistorySignalWorkflowExecutionRequest,
@@ -550,6 +694,38 @@ func (c *retryableClient) QueryWorkflow(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryUpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.HistoryUpdateWorkflowExecutionResponse, error) {
+	var resp *types.HistoryUpdateWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) MigrateWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryMigrateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.MigrateWorkflowExecutionResponse, error) {
+	var resp *types.MigrateWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.MigrateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ReapplyEvents(
 	ctx context.Context,
 	request *types.HistoryReapplyEventsRequest,
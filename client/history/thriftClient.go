@@ -59,6 +59,21 @@ func (t thriftClient) DescribeQueue(ctx context.Context, request *types.Describe
 	return thrift.ToDescribeQueueResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) ReadHistoryTaskDLQ(ctx context.Context, request *types.ReadHistoryTaskDLQRequest, opts ...yarpc.CallOption) (*types.ReadHistoryTaskDLQResponse, error) {
+	response, err := t.c.ReadHistoryTaskDLQ(ctx, thrift.FromReadHistoryTaskDLQRequest(request), opts...)
+	return thrift.ToReadHistoryTaskDLQResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) DescribeReplicationStatus(ctx context.Context, request *types.DescribeReplicationStatusRequest, opts ...yarpc.CallOption) (*types.DescribeReplicationStatusResponse, error) {
+	response, err := t.c.DescribeReplicationStatus(ctx, thrift.FromDescribeReplicationStatusRequest(request), opts...)
+	return thrift.ToDescribeReplicationStatusResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) RetryHistoryTaskDLQ(ctx context.Context, request *types.RetryHistoryTaskDLQRequest, opts ...yarpc.CallOption) (*types.RetryHistoryTaskDLQResponse, error) {
+	response, err := t.c.RetryHistoryTaskDLQ(ctx, thrift.FromRetryHistoryTaskDLQRequest(request), opts...)
+	return thrift.ToRetryHistoryTaskDLQResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) DescribeWorkflowExecution(ctx context.Context, request *types.HistoryDescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
 	response, err := t.c.DescribeWorkflowExecution(ctx, thrift.FromHistoryDescribeWorkflowExecutionRequest(request), opts...)
 	return thrift.ToDescribeWorkflowExecutionResponse(response), thrift.ToError(err)
@@ -109,6 +124,16 @@ func (t thriftClient) QueryWorkflow(ctx context.Context, request *types.HistoryQ
 	return thrift.ToHistoryQueryWorkflowResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) UpdateWorkflowExecution(ctx context.Context, request *types.HistoryUpdateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.HistoryUpdateWorkflowExecutionResponse, error) {
+	response, err := t.c.UpdateWorkflowExecution(ctx, thrift.FromHistoryUpdateWorkflowExecutionRequest(request), opts...)
+	return thrift.ToHistoryUpdateWorkflowExecutionResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) MigrateWorkflowExecution(ctx context.Context, request *types.HistoryMigrateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.MigrateWorkflowExecutionResponse, error) {
+	response, err := t.c.MigrateWorkflowExecution(ctx, thrift.FromHistoryMigrateWorkflowExecutionRequest(request), opts...)
+	return thrift.ToHistoryMigrateWorkflowExecutionResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) ReadDLQMessages(ctx context.Context, request *types.ReadDLQMessagesRequest, opts ...yarpc.CallOption) (*types.ReadDLQMessagesResponse, error) {
 	response, err := t.c.ReadDLQMessages(ctx, thrift.FromReadDLQMessagesRequest(request), opts...)
 	return thrift.ToReadDLQMessagesResponse(response), thrift.ToError(err)
@@ -164,11 +189,46 @@ func (t thriftClient) RequestCancelWorkflowExecution(ctx context.Context, reques
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) PauseWorkflowExecution(ctx context.Context, request *types.HistoryPauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.PauseWorkflowExecution(ctx, thrift.FromHistoryPauseWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) UnpauseWorkflowExecution(ctx context.Context, request *types.HistoryUnpauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.UnpauseWorkflowExecution(ctx, thrift.FromHistoryUnpauseWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) DeleteWorkflowExecution(ctx context.Context, request *types.HistoryDeleteWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.DeleteWorkflowExecution(ctx, thrift.FromHistoryDeleteWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) PauseActivity(ctx context.Context, request *types.HistoryPauseActivityRequest, opts ...yarpc.CallOption) error {
+	err := t.c.PauseActivity(ctx, thrift.FromHistoryPauseActivityRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) UnpauseActivity(ctx context.Context, request *types.HistoryUnpauseActivityRequest, opts ...yarpc.CallOption) error {
+	err := t.c.UnpauseActivity(ctx, thrift.FromHistoryUnpauseActivityRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) ResetActivity(ctx context.Context, request *types.HistoryResetActivityRequest, opts ...yarpc.CallOption) error {
+	err := t.c.ResetActivity(ctx, thrift.FromHistoryResetActivityRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) ResetQueue(ctx context.Context, request *types.ResetQueueRequest, opts ...yarpc.CallOption) error {
 	err := t.c.ResetQueue(ctx, thrift.FromResetQueueRequest(request), opts...)
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) PurgeHistoryTaskDLQ(ctx context.Context, request *types.PurgeHistoryTaskDLQRequest, opts ...yarpc.CallOption) error {
+	err := t.c.PurgeHistoryTaskDLQ(ctx, thrift.FromPurgeHistoryTaskDLQRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) ResetStickyTaskList(ctx context.Context, request *types.HistoryResetStickyTaskListRequest, opts ...yarpc.CallOption) (*types.HistoryResetStickyTaskListResponse, error) {
 	response, err := t.c.ResetStickyTaskList(ctx, thrift.FromHistoryResetStickyTaskListRequest(request), opts...)
 	return thrift.ToHistoryResetStickyTaskListResponse(response), thrift.ToError(err)
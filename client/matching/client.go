@@ -210,6 +210,36 @@ func (c *clientImpl) CancelOutstandingPoll(
 	return client.CancelOutstandingPoll(ctx, request, opts...)
 }
 
+func (c *clientImpl) PauseTaskList(
+	ctx context.Context,
+	request *types.MatchingPauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getClientForTaskList(request.TaskList.GetName())
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PauseTaskList(ctx, request, opts...)
+}
+
+func (c *clientImpl) ResumeTaskList(
+	ctx context.Context,
+	request *types.MatchingResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getClientForTaskList(request.TaskList.GetName())
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ResumeTaskList(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeTaskList(
 	ctx context.Context,
 	request *types.MatchingDescribeTaskListRequest,
@@ -240,6 +270,21 @@ func (c *clientImpl) ListTaskListPartitions(
 	return client.ListTaskListPartitions(ctx, request, opts...)
 }
 
+func (c *clientImpl) MoveTaskListBacklog(
+	ctx context.Context,
+	request *types.MatchingMoveTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (*types.MoveTaskListBacklogResponse, error) {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getClientForTaskList(request.SourceTaskList.GetName())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.MoveTaskListBacklog(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetTaskListsByDomain(
 	ctx context.Context,
 	request *types.GetTaskListsByDomainRequest,
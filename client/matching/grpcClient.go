@@ -53,6 +53,16 @@ func (g grpcClient) CancelOutstandingPoll(ctx context.Context, request *types.Ca
 	return proto.ToError(err)
 }
 
+func (g grpcClient) PauseTaskList(ctx context.Context, request *types.MatchingPauseTaskListRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.PauseTaskList(ctx, proto.FromMatchingPauseTaskListRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) ResumeTaskList(ctx context.Context, request *types.MatchingResumeTaskListRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.ResumeTaskList(ctx, proto.FromMatchingResumeTaskListRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) DescribeTaskList(ctx context.Context, request *types.MatchingDescribeTaskListRequest, opts ...yarpc.CallOption) (*types.DescribeTaskListResponse, error) {
 	response, err := g.c.DescribeTaskList(ctx, proto.FromMatchingDescribeTaskListRequest(request), opts...)
 	return proto.ToMatchingDescribeTaskListResponse(response), proto.ToError(err)
@@ -63,6 +73,11 @@ func (g grpcClient) ListTaskListPartitions(ctx context.Context, request *types.M
 	return proto.ToMatchingListTaskListPartitionsResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) MoveTaskListBacklog(ctx context.Context, request *types.MatchingMoveTaskListBacklogRequest, opts ...yarpc.CallOption) (*types.MoveTaskListBacklogResponse, error) {
+	response, err := g.c.MoveTaskListBacklog(ctx, proto.FromMatchingMoveTaskListBacklogRequest(request), opts...)
+	return proto.ToMatchingMoveTaskListBacklogResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) GetTaskListsByDomain(ctx context.Context, request *types.GetTaskListsByDomainRequest, opts ...yarpc.CallOption) (*types.GetTaskListsByDomainResponse, error) {
 	response, err := g.c.GetTaskListsByDomain(ctx, proto.FromMatchingGetTaskListsByDomainRequest(request), opts...)
 	return proto.ToMatchingGetTaskListsByDomainResponse(response), proto.ToError(err)
@@ -35,8 +35,11 @@ type Client interface {
 	AddActivityTask(context.Context, *types.AddActivityTaskRequest, ...yarpc.CallOption) error
 	AddDecisionTask(context.Context, *types.AddDecisionTaskRequest, ...yarpc.CallOption) error
 	CancelOutstandingPoll(context.Context, *types.CancelOutstandingPollRequest, ...yarpc.CallOption) error
+	PauseTaskList(context.Context, *types.MatchingPauseTaskListRequest, ...yarpc.CallOption) error
+	ResumeTaskList(context.Context, *types.MatchingResumeTaskListRequest, ...yarpc.CallOption) error
 	DescribeTaskList(context.Context, *types.MatchingDescribeTaskListRequest, ...yarpc.CallOption) (*types.DescribeTaskListResponse, error)
 	ListTaskListPartitions(context.Context, *types.MatchingListTaskListPartitionsRequest, ...yarpc.CallOption) (*types.ListTaskListPartitionsResponse, error)
+	MoveTaskListBacklog(context.Context, *types.MatchingMoveTaskListBacklogRequest, ...yarpc.CallOption) (*types.MoveTaskListBacklogResponse, error)
 	GetTaskListsByDomain(context.Context, *types.GetTaskListsByDomainRequest, ...yarpc.CallOption) (*types.GetTaskListsByDomainResponse, error)
 	PollForActivityTask(context.Context, *types.MatchingPollForActivityTaskRequest, ...yarpc.CallOption) (*types.PollForActivityTaskResponse, error)
 	PollForDecisionTask(context.Context, *types.MatchingPollForDecisionTaskRequest, ...yarpc.CallOption) (*types.MatchingPollForDecisionTaskResponse, error)
@@ -206,6 +206,42 @@ func (c *metricClient) CancelOutstandingPoll(
 	return err
 }
 
+func (c *metricClient) PauseTaskList(
+	ctx context.Context,
+	request *types.MatchingPauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.MatchingClientPauseTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientPauseTaskListScope, metrics.CadenceClientLatency)
+	err := c.client.PauseTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientPauseTaskListScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) ResumeTaskList(
+	ctx context.Context,
+	request *types.MatchingResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.MatchingClientResumeTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientResumeTaskListScope, metrics.CadenceClientLatency)
+	err := c.client.ResumeTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientResumeTaskListScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
 func (c *metricClient) DescribeTaskList(
 	ctx context.Context,
 	request *types.MatchingDescribeTaskListRequest,
@@ -242,6 +278,24 @@ func (c *metricClient) ListTaskListPartitions(
 	return resp, err
 }
 
+func (c *metricClient) MoveTaskListBacklog(
+	ctx context.Context,
+	request *types.MatchingMoveTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (*types.MoveTaskListBacklogResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientMoveTaskListBacklogScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientMoveTaskListBacklogScope, metrics.CadenceClientLatency)
+	resp, err := c.client.MoveTaskListBacklog(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientMoveTaskListBacklogScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) GetTaskListsByDomain(
 	ctx context.Context,
 	request *types.GetTaskListsByDomainRequest,
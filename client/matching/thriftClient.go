@@ -66,6 +66,24 @@ func (t thriftClient) CancelOutstandingPoll(
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) PauseTaskList(
+	ctx context.Context,
+	request *types.MatchingPauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+	err := t.c.PauseTaskList(ctx, thrift.FromMatchingPauseTaskListRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) ResumeTaskList(
+	ctx context.Context,
+	request *types.MatchingResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+	err := t.c.ResumeTaskList(ctx, thrift.FromMatchingResumeTaskListRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) DescribeTaskList(
 	ctx context.Context,
 	request *types.MatchingDescribeTaskListRequest,
@@ -84,6 +102,15 @@ func (t thriftClient) ListTaskListPartitions(
 	return thrift.ToListTaskListPartitionsResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) MoveTaskListBacklog(
+	ctx context.Context,
+	request *types.MatchingMoveTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (*types.MoveTaskListBacklogResponse, error) {
+	response, err := t.c.MoveTaskListBacklog(ctx, thrift.FromMatchingMoveTaskListBacklogRequest(request), opts...)
+	return thrift.ToMoveTaskListBacklogResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) GetTaskListsByDomain(
 	ctx context.Context,
 	request *types.GetTaskListsByDomainRequest,
@@ -0,0 +1,61 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cristalhq/jwt/v3"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common"
+)
+
+// UnknownActor is returned by GetActor when the caller cannot be identified
+const UnknownActor = "unknown"
+
+// GetActor returns a human readable identity of the caller for auditing purposes.
+// The token is not verified here, authorization is expected to have already happened.
+// It prefers the name or subject from the JWT, then falls back to the yarpc caller name.
+func GetActor(ctx context.Context) string {
+	call := yarpc.CallFromContext(ctx)
+	if call == nil {
+		return UnknownActor
+	}
+	if tokenStr := call.Header(common.AuthorizationTokenHeaderName); tokenStr != "" {
+		if token, err := jwt.ParseString(tokenStr); err == nil {
+			var claims JWTClaims
+			if err := json.Unmarshal(token.RawClaims(), &claims); err == nil {
+				if claims.Name != "" {
+					return claims.Name
+				}
+				if claims.Sub != "" {
+					return claims.Sub
+				}
+			}
+		}
+	}
+	if caller := call.Caller(); caller != "" {
+		return caller
+	}
+	return UnknownActor
+}
@@ -501,6 +501,10 @@ UpdateLoop:
 			// will be loaded into cache in the next refresh
 			break UpdateLoop
 		}
+		if prevName := c.cachedDomainName(newCacheByID, domain.info.ID); prevName != "" && prevName != domain.info.Name {
+			// the domain was renamed, its previous name must no longer resolve
+			newCacheNameToID.Delete(prevName)
+		}
 		prevEntry, nextEntry, err := c.updateIDToDomainCache(newCacheByID, domain.info.ID, domain)
 		if err != nil {
 			return err
@@ -554,6 +558,24 @@ func (c *domainCache) updateNameToIDCache(
 	cacheNameToID.Put(name, id)
 }
 
+func (c *domainCache) cachedDomainName(
+	cacheByID Cache,
+	id string,
+) string {
+
+	elem := cacheByID.Get(id)
+	if elem == nil {
+		return ""
+	}
+	entry := elem.(*DomainCacheEntry)
+	entry.RLock()
+	defer entry.RUnlock()
+	if entry.info == nil {
+		return ""
+	}
+	return entry.info.Name
+}
+
 func (c *domainCache) updateIDToDomainCache(
 	cacheByID Cache,
 	id string,
@@ -613,6 +613,66 @@ func (s *domainCacheSuite) TestUpdateCache_TriggerCallBack() {
 	s.Equal([]*DomainCacheEntry{entry2New, entry1New}, entriesNew)
 }
 
+func (s *domainCacheSuite) TestUpdateCache_RenameDomain() {
+	domainNotificationVersion := int64(0)
+	domainRecordOld := &persistence.GetDomainResponse{
+		Info: &persistence.DomainInfo{ID: uuid.New(), Name: "some random domain name", Data: make(map[string]string)},
+		Config: &persistence.DomainConfig{
+			Retention: 1,
+			BadBinaries: types.BadBinaries{
+				Binaries: map[string]*types.BadBinaryInfo{},
+			}},
+		ReplicationConfig: &persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+			},
+		},
+		NotificationVersion: domainNotificationVersion,
+	}
+	domainNotificationVersion++
+
+	s.metadataMgr.On("GetMetadata", mock.Anything).Return(&persistence.GetMetadataResponse{NotificationVersion: domainNotificationVersion}, nil).Once()
+	s.clusterMetadata.On("IsGlobalDomainEnabled").Return(true)
+	s.metadataMgr.On("ListDomains", mock.Anything, &persistence.ListDomainsRequest{
+		PageSize:      domainCacheRefreshPageSize,
+		NextPageToken: nil,
+	}).Return(&persistence.ListDomainsResponse{
+		Domains:       []*persistence.GetDomainResponse{domainRecordOld},
+		NextPageToken: nil,
+	}, nil).Once()
+	s.Nil(s.domainCache.refreshDomains())
+
+	domainRecordNew := &persistence.GetDomainResponse{
+		Info:                &persistence.DomainInfo{ID: domainRecordOld.Info.ID, Name: "renamed domain name", Data: make(map[string]string)},
+		Config:              domainRecordOld.Config,
+		ReplicationConfig:   domainRecordOld.ReplicationConfig,
+		ConfigVersion:       domainRecordOld.ConfigVersion + 1,
+		NotificationVersion: domainNotificationVersion,
+	}
+	entryNew := s.buildEntryFromRecord(domainRecordNew)
+	domainNotificationVersion++
+
+	s.metadataMgr.On("GetMetadata", mock.Anything).Return(&persistence.GetMetadataResponse{NotificationVersion: domainNotificationVersion}, nil).Once()
+	s.metadataMgr.On("ListDomains", mock.Anything, &persistence.ListDomainsRequest{
+		PageSize:      domainCacheRefreshPageSize,
+		NextPageToken: nil,
+	}).Return(&persistence.ListDomainsResponse{
+		Domains:       []*persistence.GetDomainResponse{domainRecordNew},
+		NextPageToken: nil,
+	}, nil).Once()
+	s.domainCache.timeSource.(*clock.EventTimeSource).Update(s.now.Add(domainCacheMinRefreshInterval))
+	s.Nil(s.domainCache.refreshDomains())
+
+	s.Nil(s.domainCache.cacheNameToID.Load().(Cache).Get(domainRecordOld.Info.Name))
+	entryByName, err := s.domainCache.GetDomain(domainRecordNew.Info.Name)
+	s.Nil(err)
+	s.Equal(entryNew, entryByName)
+	entryByID, err := s.domainCache.GetDomainByID(domainRecordOld.Info.ID)
+	s.Nil(err)
+	s.Equal(entryNew, entryByID)
+}
+
 func (s *domainCacheSuite) TestGetTriggerListAndUpdateCache_ConcurrentAccess() {
 	s.clusterMetadata.On("IsGlobalDomainEnabled").Return(true)
 	domainNotificationVersion := int64(999999) // make this notification version really large for test
@@ -23,7 +23,9 @@
 package cluster
 
 import (
+	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
@@ -51,9 +53,28 @@ type (
 		GetAllClusterInfo() map[string]config.ClusterInformation
 		// ClusterNameForFailoverVersion return the corresponding cluster name for a given failover version
 		ClusterNameForFailoverVersion(failoverVersion int64) string
+		// ValidateRemoteClusters returns the error UpdateRemoteClusters would fail with for the given information
+		ValidateRemoteClusters(clusterInfo map[string]config.ClusterInformation) error
+		// UpdateRemoteClusters replaces the information of all clusters with the given one,
+		// the current and primary cluster cannot be removed and the current cluster cannot be changed
+		UpdateRemoteClusters(clusterInfo map[string]config.ClusterInformation) error
+		// RegisterChangeCallback registers a callback invoked after the set of clusters changed
+		RegisterChangeCallback(key string, callback ChangeCallback)
+		// UnregisterChangeCallback removes a callback registered with RegisterChangeCallback
+		UnregisterChangeCallback(key string)
+	}
+
+	// ChangeCallback is invoked with the clusters added and removed by a cluster metadata update,
+	// a cluster whose information changed is reported as removed with the old and added with the new information
+	ChangeCallback func(added map[string]config.ClusterInformation, removed map[string]config.ClusterInformation)
+
+	changeCallbackEntry struct {
+		key      string
+		callback ChangeCallback
 	}
 
 	metadataImpl struct {
+		sync.RWMutex
 		logger log.Logger
 		// EnableGlobalDomain whether the global domain is enabled,
 		// this attr should be discarded when cross DC is made public
@@ -69,6 +90,9 @@ type (
 		clusterInfo map[string]config.ClusterInformation
 		// versionToClusterName contains all initial version -> corresponding cluster name
 		versionToClusterName map[int64]string
+
+		callbackLock sync.Mutex
+		callbacks    []changeCallbackEntry
 	}
 )
 
@@ -82,9 +106,7 @@ func NewMetadata(
 	clusterInfo map[string]config.ClusterInformation,
 ) Metadata {
 
-	if len(clusterInfo) == 0 {
-		panic("Empty cluster information")
-	} else if len(primaryClusterName) == 0 {
+	if len(primaryClusterName) == 0 {
 		panic("Primary cluster name is empty")
 	} else if len(currentClusterName) == 0 {
 		panic("Current cluster name is empty")
@@ -92,44 +114,75 @@ func NewMetadata(
 		panic("Version increment is 0")
 	}
 
+	versionToClusterName, err := validateClusterInfo(
+		failoverVersionIncrement,
+		primaryClusterName,
+		currentClusterName,
+		clusterInfo,
+	)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return &metadataImpl{
+		logger:                   logger,
+		enableGlobalDomain:       enableGlobalDomain,
+		failoverVersionIncrement: failoverVersionIncrement,
+		primaryClusterName:       primaryClusterName,
+		currentClusterName:       currentClusterName,
+		clusterInfo:              copyClusterInfo(clusterInfo),
+		versionToClusterName:     versionToClusterName,
+	}
+}
+
+func validateClusterInfo(
+	failoverVersionIncrement int64,
+	primaryClusterName string,
+	currentClusterName string,
+	clusterInfo map[string]config.ClusterInformation,
+) (map[int64]string, error) {
+
+	if len(clusterInfo) == 0 {
+		return nil, errors.New("Empty cluster information")
+	}
+
 	versionToClusterName := make(map[int64]string)
 	for clusterName, info := range clusterInfo {
 		if failoverVersionIncrement <= info.InitialFailoverVersion || info.InitialFailoverVersion < 0 {
-			panic(fmt.Sprintf(
+			return nil, fmt.Errorf(
 				"Version increment %v is smaller than initial version: %v.",
 				failoverVersionIncrement,
 				info.InitialFailoverVersion,
-			))
+			)
 		}
 		if len(clusterName) == 0 {
-			panic("Cluster name in all cluster names is empty")
+			return nil, errors.New("Cluster name in all cluster names is empty")
 		}
 		versionToClusterName[info.InitialFailoverVersion] = clusterName
 
 		if info.Enabled && (len(info.RPCName) == 0 || len(info.RPCAddress) == 0) {
-			panic(fmt.Sprintf("Cluster %v: rpc name / address is empty", clusterName))
+			return nil, fmt.Errorf("Cluster %v: rpc name / address is empty", clusterName)
 		}
 	}
 
 	if _, ok := clusterInfo[currentClusterName]; !ok {
-		panic("Current cluster is not specified in cluster info")
+		return nil, errors.New("Current cluster is not specified in cluster info")
 	}
 	if _, ok := clusterInfo[primaryClusterName]; !ok {
-		panic("Primary cluster is not specified in cluster info")
+		return nil, errors.New("Primary cluster is not specified in cluster info")
 	}
 	if len(versionToClusterName) != len(clusterInfo) {
-		panic("Cluster info initial versions have duplicates")
+		return nil, errors.New("Cluster info initial versions have duplicates")
 	}
+	return versionToClusterName, nil
+}
 
-	return &metadataImpl{
-		logger:                   logger,
-		enableGlobalDomain:       enableGlobalDomain,
-		failoverVersionIncrement: failoverVersionIncrement,
-		primaryClusterName:       primaryClusterName,
-		currentClusterName:       currentClusterName,
-		clusterInfo:              clusterInfo,
-		versionToClusterName:     versionToClusterName,
+func copyClusterInfo(clusterInfo map[string]config.ClusterInformation) map[string]config.ClusterInformation {
+	result := make(map[string]config.ClusterInformation, len(clusterInfo))
+	for name, info := range clusterInfo {
+		result[name] = info
 	}
+	return result
 }
 
 // IsGlobalDomainEnabled whether the global domain is enabled,
@@ -140,7 +193,9 @@ func (metadata *metadataImpl) IsGlobalDomainEnabled() bool {
 
 // GetNextFailoverVersion return the next failover version based on input
 func (metadata *metadataImpl) GetNextFailoverVersion(cluster string, currentFailoverVersion int64) int64 {
+	metadata.RLock()
 	info, ok := metadata.clusterInfo[cluster]
+	metadata.RUnlock()
 	if !ok {
 		panic(fmt.Sprintf(
 			"Unknown cluster name: %v with given cluster initial failover version map: %v.",
@@ -176,7 +231,10 @@ func (metadata *metadataImpl) GetCurrentClusterName() string {
 
 // GetAllClusterInfo return the all cluster name -> corresponding information
 func (metadata *metadataImpl) GetAllClusterInfo() map[string]config.ClusterInformation {
-	return metadata.clusterInfo
+	metadata.RLock()
+	defer metadata.RUnlock()
+
+	return copyClusterInfo(metadata.clusterInfo)
 }
 
 // ClusterNameForFailoverVersion return the corresponding cluster name for a given failover version
@@ -185,6 +243,9 @@ func (metadata *metadataImpl) ClusterNameForFailoverVersion(failoverVersion int6
 		return metadata.currentClusterName
 	}
 
+	metadata.RLock()
+	defer metadata.RUnlock()
+
 	initialFailoverVersion := failoverVersion % metadata.failoverVersionIncrement
 	clusterName, ok := metadata.versionToClusterName[initialFailoverVersion]
 	if !ok {
@@ -197,3 +258,109 @@ func (metadata *metadataImpl) ClusterNameForFailoverVersion(failoverVersion int6
 	}
 	return clusterName
 }
+
+// ValidateRemoteClusters returns the error UpdateRemoteClusters would fail with for the given information
+func (metadata *metadataImpl) ValidateRemoteClusters(clusterInfo map[string]config.ClusterInformation) error {
+	if _, err := validateClusterInfo(
+		metadata.failoverVersionIncrement,
+		metadata.primaryClusterName,
+		metadata.currentClusterName,
+		clusterInfo,
+	); err != nil {
+		return err
+	}
+
+	metadata.RLock()
+	defer metadata.RUnlock()
+	return metadata.validateCurrentClusterUnchanged(clusterInfo)
+}
+
+// UpdateRemoteClusters replaces the information of all clusters with the given one and
+// notifies the registered callbacks about the clusters added and removed
+func (metadata *metadataImpl) UpdateRemoteClusters(clusterInfo map[string]config.ClusterInformation) error {
+	versionToClusterName, err := validateClusterInfo(
+		metadata.failoverVersionIncrement,
+		metadata.primaryClusterName,
+		metadata.currentClusterName,
+		clusterInfo,
+	)
+	if err != nil {
+		return err
+	}
+
+	metadata.Lock()
+	if err := metadata.validateCurrentClusterUnchanged(clusterInfo); err != nil {
+		metadata.Unlock()
+		return err
+	}
+
+	added := make(map[string]config.ClusterInformation)
+	removed := make(map[string]config.ClusterInformation)
+	for name, info := range clusterInfo {
+		oldInfo, ok := metadata.clusterInfo[name]
+		if !ok || oldInfo != info {
+			added[name] = info
+		}
+		if ok && oldInfo != info {
+			removed[name] = oldInfo
+		}
+	}
+	for name, oldInfo := range metadata.clusterInfo {
+		if _, ok := clusterInfo[name]; !ok {
+			removed[name] = oldInfo
+		}
+	}
+	metadata.clusterInfo = copyClusterInfo(clusterInfo)
+	metadata.versionToClusterName = versionToClusterName
+	metadata.Unlock()
+
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+	metadata.logger.Info(fmt.Sprintf("Cluster metadata updated, added: %v, removed: %v.", added, removed))
+
+	metadata.callbackLock.Lock()
+	callbacks := make([]changeCallbackEntry, len(metadata.callbacks))
+	copy(callbacks, metadata.callbacks)
+	metadata.callbackLock.Unlock()
+
+	for _, entry := range callbacks {
+		entry.callback(added, removed)
+	}
+	return nil
+}
+
+func (metadata *metadataImpl) validateCurrentClusterUnchanged(clusterInfo map[string]config.ClusterInformation) error {
+	if clusterInfo[metadata.currentClusterName] != metadata.clusterInfo[metadata.currentClusterName] {
+		return fmt.Errorf("Cluster %v: information of the current cluster cannot be changed", metadata.currentClusterName)
+	}
+	return nil
+}
+
+// RegisterChangeCallback registers a callback invoked after the set of clusters changed,
+// callbacks are invoked in the order of registration
+func (metadata *metadataImpl) RegisterChangeCallback(key string, callback ChangeCallback) {
+	metadata.callbackLock.Lock()
+	defer metadata.callbackLock.Unlock()
+
+	for i, entry := range metadata.callbacks {
+		if entry.key == key {
+			metadata.callbacks[i].callback = callback
+			return
+		}
+	}
+	metadata.callbacks = append(metadata.callbacks, changeCallbackEntry{key: key, callback: callback})
+}
+
+// UnregisterChangeCallback removes a callback registered with RegisterChangeCallback
+func (metadata *metadataImpl) UnregisterChangeCallback(key string) {
+	metadata.callbackLock.Lock()
+	defer metadata.callbackLock.Unlock()
+
+	for i, entry := range metadata.callbacks {
+		if entry.key == key {
+			metadata.callbacks = append(metadata.callbacks[:i], metadata.callbacks[i+1:]...)
+			return
+		}
+	}
+}
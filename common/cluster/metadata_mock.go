@@ -168,3 +168,55 @@ func (mr *MockMetadataMockRecorder) ClusterNameForFailoverVersion(failoverVersio
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterNameForFailoverVersion", reflect.TypeOf((*MockMetadata)(nil).ClusterNameForFailoverVersion), failoverVersion)
 }
+
+// ValidateRemoteClusters mocks base method
+func (m *MockMetadata) ValidateRemoteClusters(clusterInfo map[string]config.ClusterInformation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateRemoteClusters", clusterInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateRemoteClusters indicates an expected call of ValidateRemoteClusters
+func (mr *MockMetadataMockRecorder) ValidateRemoteClusters(clusterInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateRemoteClusters", reflect.TypeOf((*MockMetadata)(nil).ValidateRemoteClusters), clusterInfo)
+}
+
+// UpdateRemoteClusters mocks base method
+func (m *MockMetadata) UpdateRemoteClusters(clusterInfo map[string]config.ClusterInformation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRemoteClusters", clusterInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRemoteClusters indicates an expected call of UpdateRemoteClusters
+func (mr *MockMetadataMockRecorder) UpdateRemoteClusters(clusterInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRemoteClusters", reflect.TypeOf((*MockMetadata)(nil).UpdateRemoteClusters), clusterInfo)
+}
+
+// RegisterChangeCallback mocks base method
+func (m *MockMetadata) RegisterChangeCallback(key string, callback ChangeCallback) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterChangeCallback", key, callback)
+}
+
+// RegisterChangeCallback indicates an expected call of RegisterChangeCallback
+func (mr *MockMetadataMockRecorder) RegisterChangeCallback(key, callback interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterChangeCallback", reflect.TypeOf((*MockMetadata)(nil).RegisterChangeCallback), key, callback)
+}
+
+// UnregisterChangeCallback mocks base method
+func (m *MockMetadata) UnregisterChangeCallback(key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UnregisterChangeCallback", key)
+}
+
+// UnregisterChangeCallback indicates an expected call of UnregisterChangeCallback
+func (mr *MockMetadataMockRecorder) UnregisterChangeCallback(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterChangeCallback", reflect.TypeOf((*MockMetadata)(nil).UnregisterChangeCallback), key)
+}
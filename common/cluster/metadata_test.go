@@ -0,0 +1,134 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+)
+
+type (
+	metadataSuite struct {
+		suite.Suite
+
+		metadata Metadata
+	}
+)
+
+func TestMetadataSuite(t *testing.T) {
+	s := new(metadataSuite)
+	suite.Run(t, s)
+}
+
+func (s *metadataSuite) SetupTest() {
+	s.metadata = NewMetadata(
+		loggerimpl.NewNopLogger(),
+		dynamicconfig.GetBoolPropertyFn(true),
+		TestFailoverVersionIncrement,
+		TestCurrentClusterName,
+		TestCurrentClusterName,
+		TestAllClusterInfo,
+	)
+}
+
+func (s *metadataSuite) TestUpdateRemoteClusters_AddAndRemove() {
+	var added, removed map[string]config.ClusterInformation
+	s.metadata.RegisterChangeCallback("test", func(a, r map[string]config.ClusterInformation) {
+		added, removed = a, r
+	})
+
+	newCluster := config.ClusterInformation{
+		Enabled:                true,
+		InitialFailoverVersion: 3,
+		RPCName:                common.FrontendServiceName,
+		RPCAddress:             "127.0.0.1:9104",
+	}
+	clusterInfo := s.metadata.GetAllClusterInfo()
+	clusterInfo["new"] = newCluster
+	delete(clusterInfo, TestDisabledClusterName)
+
+	s.NoError(s.metadata.UpdateRemoteClusters(clusterInfo))
+	s.Equal(map[string]config.ClusterInformation{"new": newCluster}, added)
+	s.Equal(map[string]config.ClusterInformation{TestDisabledClusterName: TestAllClusterInfo[TestDisabledClusterName]}, removed)
+	s.Equal(clusterInfo, s.metadata.GetAllClusterInfo())
+	s.Equal("new", s.metadata.ClusterNameForFailoverVersion(13))
+	s.Equal(int64(13), s.metadata.GetNextFailoverVersion("new", 11))
+
+	s.metadata.UnregisterChangeCallback("test")
+	added, removed = nil, nil
+	delete(clusterInfo, "new")
+	s.NoError(s.metadata.UpdateRemoteClusters(clusterInfo))
+	s.Nil(added)
+	s.Nil(removed)
+	s.NotContains(s.metadata.GetAllClusterInfo(), "new")
+}
+
+func (s *metadataSuite) TestUpdateRemoteClusters_ChangedCluster() {
+	var added, removed map[string]config.ClusterInformation
+	s.metadata.RegisterChangeCallback("test", func(a, r map[string]config.ClusterInformation) {
+		added, removed = a, r
+	})
+
+	clusterInfo := s.metadata.GetAllClusterInfo()
+	info := clusterInfo[TestAlternativeClusterName]
+	info.RPCAddress = "127.0.0.1:9104"
+	clusterInfo[TestAlternativeClusterName] = info
+
+	s.NoError(s.metadata.UpdateRemoteClusters(clusterInfo))
+	s.Equal(map[string]config.ClusterInformation{TestAlternativeClusterName: info}, added)
+	s.Equal(map[string]config.ClusterInformation{TestAlternativeClusterName: TestAllClusterInfo[TestAlternativeClusterName]}, removed)
+}
+
+func (s *metadataSuite) TestUpdateRemoteClusters_Invalid() {
+	testCases := map[string]func(map[string]config.ClusterInformation){
+		"duplicate initial failover version": func(clusterInfo map[string]config.ClusterInformation) {
+			clusterInfo["new"] = config.ClusterInformation{InitialFailoverVersion: TestAlternativeClusterInitialFailoverVersion}
+		},
+		"initial failover version too large": func(clusterInfo map[string]config.ClusterInformation) {
+			clusterInfo["new"] = config.ClusterInformation{InitialFailoverVersion: TestFailoverVersionIncrement}
+		},
+		"missing rpc address": func(clusterInfo map[string]config.ClusterInformation) {
+			clusterInfo["new"] = config.ClusterInformation{Enabled: true, InitialFailoverVersion: 3, RPCName: common.FrontendServiceName}
+		},
+		"remove current cluster": func(clusterInfo map[string]config.ClusterInformation) {
+			delete(clusterInfo, TestCurrentClusterName)
+		},
+		"change current cluster": func(clusterInfo map[string]config.ClusterInformation) {
+			info := clusterInfo[TestCurrentClusterName]
+			info.RPCAddress = "127.0.0.1:9104"
+			clusterInfo[TestCurrentClusterName] = info
+		},
+	}
+
+	for name, update := range testCases {
+		clusterInfo := s.metadata.GetAllClusterInfo()
+		update(clusterInfo)
+		s.Error(s.metadata.ValidateRemoteClusters(clusterInfo), name)
+		s.Error(s.metadata.UpdateRemoteClusters(clusterInfo), name)
+		s.Equal(TestAllClusterInfo, s.metadata.GetAllClusterInfo(), name)
+	}
+}
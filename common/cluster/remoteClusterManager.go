@@ -0,0 +1,308 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination remoteClusterManager_mock.go
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	remoteClusterRefreshTimeout = 10 * time.Second
+
+	remoteClustersInitialVersion = 1
+
+	listDomainsPageSize = 100
+)
+
+type (
+	// RemoteClusterManager persists the remote cluster definitions of the current cluster and
+	// keeps the cluster metadata of this host in sync with them
+	RemoteClusterManager interface {
+		common.Daemon
+
+		// AddRemoteCluster adds or updates a remote cluster, the change is persisted and applied to this host
+		// right away, other hosts pick it up on their next refresh
+		AddRemoteCluster(ctx context.Context, clusterName string, info config.ClusterInformation) error
+		// RemoveRemoteCluster removes a remote cluster which no domain replicates to, the change is persisted
+		// and applied to this host right away, other hosts pick it up on their next refresh
+		RemoveRemoteCluster(ctx context.Context, clusterName string) error
+	}
+
+	remoteClusterManagerImpl struct {
+		status       int32
+		shutdownChan chan struct{}
+
+		// updateLock serializes the updates done through this host
+		updateLock         sync.Mutex
+		metadata           Metadata
+		configStoreManager persistence.ConfigStoreManager
+		domainManager      persistence.DomainManager
+		timeSource         clock.TimeSource
+		refreshInterval    dynamicconfig.DurationPropertyFn
+		logger             log.Logger
+	}
+)
+
+var _ RemoteClusterManager = (*remoteClusterManagerImpl)(nil)
+
+// NewRemoteClusterManager creates a new instance of RemoteClusterManager
+func NewRemoteClusterManager(
+	metadata Metadata,
+	configStoreManager persistence.ConfigStoreManager,
+	domainManager persistence.DomainManager,
+	timeSource clock.TimeSource,
+	refreshInterval dynamicconfig.DurationPropertyFn,
+	logger log.Logger,
+) RemoteClusterManager {
+	return &remoteClusterManagerImpl{
+		status:             common.DaemonStatusInitialized,
+		shutdownChan:       make(chan struct{}),
+		metadata:           metadata,
+		configStoreManager: configStoreManager,
+		domainManager:      domainManager,
+		timeSource:         timeSource,
+		refreshInterval:    refreshInterval,
+		logger:             logger,
+	}
+}
+
+// Start loads the persisted remote clusters and starts the background refresh
+func (m *remoteClusterManagerImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	if err := m.refresh(); err != nil {
+		m.logger.Error("Unable to load remote clusters", tag.Error(err))
+	}
+	go m.refreshLoop()
+}
+
+// Stop stops the background refresh
+func (m *remoteClusterManagerImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(m.shutdownChan)
+}
+
+func (m *remoteClusterManagerImpl) AddRemoteCluster(
+	ctx context.Context,
+	clusterName string,
+	info config.ClusterInformation,
+) error {
+
+	if clusterName == m.metadata.GetCurrentClusterName() {
+		return &types.BadRequestError{Message: "Current cluster cannot be added as a remote cluster."}
+	}
+	return m.update(ctx, func(remoteClusters map[string]config.ClusterInformation) {
+		remoteClusters[clusterName] = info
+	})
+}
+
+func (m *remoteClusterManagerImpl) RemoveRemoteCluster(
+	ctx context.Context,
+	clusterName string,
+) error {
+
+	if clusterName == m.metadata.GetCurrentClusterName() {
+		return &types.BadRequestError{Message: "Current cluster cannot be removed."}
+	}
+	if _, ok := m.metadata.GetAllClusterInfo()[clusterName]; !ok {
+		return &types.EntityNotExistsError{Message: fmt.Sprintf("Remote cluster %v does not exist.", clusterName)}
+	}
+	if err := m.validateClusterNotInUse(ctx, clusterName); err != nil {
+		return err
+	}
+	return m.update(ctx, func(remoteClusters map[string]config.ClusterInformation) {
+		delete(remoteClusters, clusterName)
+	})
+}
+
+// validateClusterNotInUse rejects the removal of a cluster which is the active cluster
+// or one of the replication clusters of any domain
+func (m *remoteClusterManagerImpl) validateClusterNotInUse(
+	ctx context.Context,
+	clusterName string,
+) error {
+
+	var nextPageToken []byte
+	for {
+		resp, err := m.domainManager.ListDomains(ctx, &persistence.ListDomainsRequest{
+			PageSize:      listDomainsPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for _, domain := range resp.Domains {
+			if domain.ReplicationConfig == nil {
+				continue
+			}
+			inUse := domain.ReplicationConfig.ActiveClusterName == clusterName
+			for _, clusterConfig := range domain.ReplicationConfig.Clusters {
+				inUse = inUse || clusterConfig.ClusterName == clusterName
+			}
+			if inUse {
+				return &types.BadRequestError{Message: fmt.Sprintf(
+					"Remote cluster %v is used by domain %v.",
+					clusterName,
+					domain.Info.Name,
+				)}
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		nextPageToken = resp.NextPageToken
+	}
+}
+
+func (m *remoteClusterManagerImpl) update(
+	ctx context.Context,
+	update func(remoteClusters map[string]config.ClusterInformation),
+) error {
+
+	m.updateLock.Lock()
+	defer m.updateLock.Unlock()
+
+	var remoteClusters map[string]config.ClusterInformation
+	version := int64(remoteClustersInitialVersion)
+	entry, err := m.configStoreManager.FetchConfig(ctx, persistence.RemoteClustersConfigType)
+	switch err.(type) {
+	case nil:
+		if remoteClusters, err = decodeRemoteClusters(entry.Values); err != nil {
+			return err
+		}
+		version = entry.Version + 1
+	case *types.EntityNotExistsError:
+		// the first update seeds the persisted remote clusters with the static config
+		remoteClusters = m.metadata.GetAllClusterInfo()
+		delete(remoteClusters, m.metadata.GetCurrentClusterName())
+	default:
+		return err
+	}
+	update(remoteClusters)
+
+	clusterInfo := m.withCurrentCluster(remoteClusters)
+	if err := m.metadata.ValidateRemoteClusters(clusterInfo); err != nil {
+		return &types.BadRequestError{Message: err.Error()}
+	}
+
+	encoded, err := json.Marshal(remoteClusters)
+	if err != nil {
+		return err
+	}
+
+	// the write is conditioned on the version, concurrent updates through other hosts fail instead of being lost
+	err = m.configStoreManager.UpdateConfig(ctx, &persistence.ConfigStoreEntry{
+		ConfigType: persistence.RemoteClustersConfigType,
+		Version:    version,
+		Timestamp:  m.timeSource.Now(),
+		Values:     persistence.NewDataBlob(encoded, common.EncodingTypeJSON),
+	})
+	if err != nil {
+		return err
+	}
+
+	// the change is applied only once persisted, if applying fails the next refresh retries it
+	return m.metadata.UpdateRemoteClusters(clusterInfo)
+}
+
+func (m *remoteClusterManagerImpl) refreshLoop() {
+	timer := time.NewTimer(m.refreshInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-m.shutdownChan:
+			return
+		case <-timer.C:
+			if err := m.refresh(); err != nil {
+				m.logger.Error("Error refreshing remote clusters", tag.Error(err))
+			}
+			timer.Reset(m.refreshInterval())
+		}
+	}
+}
+
+func (m *remoteClusterManagerImpl) refresh() error {
+	m.updateLock.Lock()
+	defer m.updateLock.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteClusterRefreshTimeout)
+	defer cancel()
+
+	entry, err := m.configStoreManager.FetchConfig(ctx, persistence.RemoteClustersConfigType)
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			// no remote cluster has been added or removed at runtime, the static config applies
+			return nil
+		}
+		return err
+	}
+
+	remoteClusters, err := decodeRemoteClusters(entry.Values)
+	if err != nil {
+		return err
+	}
+	return m.metadata.UpdateRemoteClusters(m.withCurrentCluster(remoteClusters))
+}
+
+func (m *remoteClusterManagerImpl) withCurrentCluster(
+	remoteClusters map[string]config.ClusterInformation,
+) map[string]config.ClusterInformation {
+
+	currentClusterName := m.metadata.GetCurrentClusterName()
+	clusterInfo := copyClusterInfo(remoteClusters)
+	clusterInfo[currentClusterName] = m.metadata.GetAllClusterInfo()[currentClusterName]
+	return clusterInfo
+}
+
+func decodeRemoteClusters(blob *persistence.DataBlob) (map[string]config.ClusterInformation, error) {
+	remoteClusters := make(map[string]config.ClusterInformation)
+	if blob == nil {
+		return remoteClusters, nil
+	}
+	if blob.Encoding != common.EncodingTypeJSON {
+		return nil, fmt.Errorf("unsupported encoding of remote clusters: %v", blob.Encoding)
+	}
+	if err := json.Unmarshal(blob.Data, &remoteClusters); err != nil {
+		return nil, err
+	}
+	return remoteClusters, nil
+}
@@ -0,0 +1,263 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	remoteClusterManagerSuite struct {
+		suite.Suite
+
+		metadata      Metadata
+		configStore   *testConfigStoreManager
+		domainManager *testDomainManager
+		manager       *remoteClusterManagerImpl
+	}
+
+	// testConfigStoreManager keeps the versions of the config store records in memory
+	testConfigStoreManager struct {
+		entries   []*persistence.ConfigStoreEntry
+		updateErr error
+	}
+
+	// testDomainManager lists the given domains in pages of one domain
+	testDomainManager struct {
+		persistence.DomainManager
+
+		domains []*persistence.GetDomainResponse
+	}
+)
+
+func TestRemoteClusterManagerSuite(t *testing.T) {
+	s := new(remoteClusterManagerSuite)
+	suite.Run(t, s)
+}
+
+func (s *remoteClusterManagerSuite) SetupTest() {
+	s.metadata = NewMetadata(
+		loggerimpl.NewNopLogger(),
+		dynamicconfig.GetBoolPropertyFn(true),
+		TestFailoverVersionIncrement,
+		TestCurrentClusterName,
+		TestCurrentClusterName,
+		TestAllClusterInfo,
+	)
+	s.configStore = &testConfigStoreManager{}
+	s.domainManager = &testDomainManager{}
+	s.manager = NewRemoteClusterManager(
+		s.metadata,
+		s.configStore,
+		s.domainManager,
+		clock.NewEventTimeSource().Update(time.Now()),
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		loggerimpl.NewNopLogger(),
+	).(*remoteClusterManagerImpl)
+}
+
+func (s *remoteClusterManagerSuite) TestAddRemoteCluster_SeedsFromStaticConfig() {
+	newCluster := config.ClusterInformation{
+		Enabled:                true,
+		InitialFailoverVersion: 3,
+		RPCName:                common.FrontendServiceName,
+		RPCAddress:             "127.0.0.1:9104",
+	}
+	s.NoError(s.manager.AddRemoteCluster(context.Background(), "new", newCluster))
+
+	s.Len(s.configStore.entries, 1)
+	s.Equal(persistence.RemoteClustersConfigType, s.configStore.entries[0].ConfigType)
+	s.Equal(int64(remoteClustersInitialVersion), s.configStore.entries[0].Version)
+	persisted := s.persistedRemoteClusters()
+	s.Equal(newCluster, persisted["new"])
+	s.Contains(persisted, TestAlternativeClusterName)
+	s.Contains(persisted, TestDisabledClusterName)
+	s.NotContains(persisted, TestCurrentClusterName)
+	s.Equal(newCluster, s.metadata.GetAllClusterInfo()["new"])
+
+	s.NoError(s.manager.RemoveRemoteCluster(context.Background(), "new"))
+	s.Len(s.configStore.entries, 2)
+	s.Equal(int64(remoteClustersInitialVersion+1), s.configStore.entries[1].Version)
+	s.NotContains(s.persistedRemoteClusters(), "new")
+	s.NotContains(s.metadata.GetAllClusterInfo(), "new")
+}
+
+func (s *remoteClusterManagerSuite) TestAddRemoteCluster_InvalidCluster() {
+	err := s.manager.AddRemoteCluster(context.Background(), "new", config.ClusterInformation{
+		Enabled:                true,
+		InitialFailoverVersion: TestAllClusterInfo[TestAlternativeClusterName].InitialFailoverVersion,
+		RPCName:                common.FrontendServiceName,
+		RPCAddress:             "127.0.0.1:9104",
+	})
+	s.IsType(&types.BadRequestError{}, err)
+	s.Empty(s.configStore.entries)
+	s.Equal(TestAllClusterInfo, s.metadata.GetAllClusterInfo())
+}
+
+func (s *remoteClusterManagerSuite) TestAddRemoteCluster_NotAppliedOnPersistenceFailure() {
+	s.configStore.updateErr = &persistence.ConditionFailedError{Msg: "version exists"}
+
+	err := s.manager.AddRemoteCluster(context.Background(), "new", config.ClusterInformation{
+		Enabled:                true,
+		InitialFailoverVersion: 3,
+		RPCName:                common.FrontendServiceName,
+		RPCAddress:             "127.0.0.1:9104",
+	})
+	s.IsType(&persistence.ConditionFailedError{}, err)
+	s.NotContains(s.metadata.GetAllClusterInfo(), "new")
+	s.Equal(TestAllClusterInfo, s.metadata.GetAllClusterInfo())
+}
+
+func (s *remoteClusterManagerSuite) TestRemoveRemoteCluster_CurrentCluster() {
+	err := s.manager.RemoveRemoteCluster(context.Background(), TestCurrentClusterName)
+	s.IsType(&types.BadRequestError{}, err)
+	s.Empty(s.configStore.entries)
+}
+
+func (s *remoteClusterManagerSuite) TestRemoveRemoteCluster_UsedByDomain() {
+	s.domainManager.domains = []*persistence.GetDomainResponse{
+		{
+			Info: &persistence.DomainInfo{Name: "local"},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: TestCurrentClusterName,
+				Clusters:          []*persistence.ClusterReplicationConfig{{ClusterName: TestCurrentClusterName}},
+			},
+		},
+		{
+			Info: &persistence.DomainInfo{Name: "replicated"},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: TestCurrentClusterName},
+					{ClusterName: TestAlternativeClusterName},
+				},
+			},
+		},
+		{
+			Info: &persistence.DomainInfo{Name: "active"},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: TestDisabledClusterName,
+			},
+		},
+	}
+
+	err := s.manager.RemoveRemoteCluster(context.Background(), TestAlternativeClusterName)
+	s.IsType(&types.BadRequestError{}, err)
+	s.Contains(err.Error(), "replicated")
+
+	err = s.manager.RemoveRemoteCluster(context.Background(), TestDisabledClusterName)
+	s.IsType(&types.BadRequestError{}, err)
+	s.Contains(err.Error(), "active")
+
+	s.Empty(s.configStore.entries)
+	s.Equal(TestAllClusterInfo, s.metadata.GetAllClusterInfo())
+}
+
+func (s *remoteClusterManagerSuite) TestRefresh() {
+	s.NoError(s.manager.refresh())
+	s.Equal(TestAllClusterInfo, s.metadata.GetAllClusterInfo())
+
+	remoteClusters := map[string]config.ClusterInformation{
+		TestAlternativeClusterName: TestAllClusterInfo[TestAlternativeClusterName],
+	}
+	encoded, err := json.Marshal(remoteClusters)
+	s.NoError(err)
+	s.configStore.entries = append(s.configStore.entries, &persistence.ConfigStoreEntry{
+		ConfigType: persistence.RemoteClustersConfigType,
+		Version:    remoteClustersInitialVersion,
+		Values:     persistence.NewDataBlob(encoded, common.EncodingTypeJSON),
+	})
+
+	s.NoError(s.manager.refresh())
+	s.Equal(map[string]config.ClusterInformation{
+		TestCurrentClusterName:     TestAllClusterInfo[TestCurrentClusterName],
+		TestAlternativeClusterName: TestAllClusterInfo[TestAlternativeClusterName],
+	}, s.metadata.GetAllClusterInfo())
+}
+
+func (s *remoteClusterManagerSuite) persistedRemoteClusters() map[string]config.ClusterInformation {
+	latest := s.configStore.entries[len(s.configStore.entries)-1]
+	remoteClusters, err := decodeRemoteClusters(latest.Values)
+	s.NoError(err)
+	return remoteClusters
+}
+
+func (m *testConfigStoreManager) Close() {}
+
+func (m *testConfigStoreManager) FetchConfig(
+	_ context.Context,
+	configType persistence.ConfigType,
+) (*persistence.ConfigStoreEntry, error) {
+	for i := len(m.entries) - 1; i >= 0; i-- {
+		if m.entries[i].ConfigType == configType {
+			return m.entries[i], nil
+		}
+	}
+	return nil, &types.EntityNotExistsError{Message: "config not found"}
+}
+
+func (m *testConfigStoreManager) UpdateConfig(
+	_ context.Context,
+	entry *persistence.ConfigStoreEntry,
+) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	for _, existing := range m.entries {
+		if existing.ConfigType == entry.ConfigType && existing.Version == entry.Version {
+			return errors.New("version exists")
+		}
+	}
+	m.entries = append(m.entries, entry)
+	return nil
+}
+
+func (m *testDomainManager) ListDomains(
+	_ context.Context,
+	request *persistence.ListDomainsRequest,
+) (*persistence.ListDomainsResponse, error) {
+	index := 0
+	if len(request.NextPageToken) != 0 {
+		index = int(request.NextPageToken[0])
+	}
+	if index >= len(m.domains) {
+		return &persistence.ListDomainsResponse{}, nil
+	}
+	resp := &persistence.ListDomainsResponse{Domains: m.domains[index : index+1]}
+	if index+1 < len(m.domains) {
+		resp.NextPageToken = []byte{byte(index + 1)}
+	}
+	return resp, nil
+}
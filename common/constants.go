@@ -173,6 +173,8 @@ const (
 	DomainDataKeyForReadGroups = "READ_GROUPS"
 	// DomainDataKeyForWriteGroups stores which groups have write permission of the domain API
 	DomainDataKeyForWriteGroups = "WRITE_GROUPS"
+	// DomainDataKeyForDeletionProgress is the key of DomainData for the progress of domain deletion
+	DomainDataKeyForDeletionProgress = "DeletionProgress"
 )
 
 type (
@@ -39,13 +39,14 @@ const (
 	TaskList        = "TaskList"
 	IsCron          = "IsCron"
 
-	CustomStringField    = "CustomStringField"
-	CustomKeywordField   = "CustomKeywordField"
-	CustomIntField       = "CustomIntField"
-	CustomBoolField      = "CustomBoolField"
-	CustomDoubleField    = "CustomDoubleField"
-	CustomDatetimeField  = "CustomDatetimeField"
-	CadenceChangeVersion = "CadenceChangeVersion"
+	CustomStringField      = "CustomStringField"
+	CustomKeywordField     = "CustomKeywordField"
+	CustomIntField         = "CustomIntField"
+	CustomBoolField        = "CustomBoolField"
+	CustomDoubleField      = "CustomDoubleField"
+	CustomDatetimeField    = "CustomDatetimeField"
+	CustomKeywordListField = "CustomKeywordListField"
+	CadenceChangeVersion   = "CadenceChangeVersion"
 )
 
 // valid non-indexed fields on ES
@@ -61,14 +62,15 @@ var defaultIndexedKeys = createDefaultIndexedKeys()
 
 func createDefaultIndexedKeys() map[string]interface{} {
 	defaultIndexedKeys := map[string]interface{}{
-		CustomStringField:    shared.IndexedValueTypeString,
-		CustomKeywordField:   shared.IndexedValueTypeKeyword,
-		CustomIntField:       shared.IndexedValueTypeInt,
-		CustomBoolField:      shared.IndexedValueTypeBool,
-		CustomDoubleField:    shared.IndexedValueTypeDouble,
-		CustomDatetimeField:  shared.IndexedValueTypeDatetime,
-		CadenceChangeVersion: shared.IndexedValueTypeKeyword,
-		BinaryChecksums:      shared.IndexedValueTypeKeyword,
+		CustomStringField:      shared.IndexedValueTypeString,
+		CustomKeywordField:     shared.IndexedValueTypeKeyword,
+		CustomIntField:         shared.IndexedValueTypeInt,
+		CustomBoolField:        shared.IndexedValueTypeBool,
+		CustomDoubleField:      shared.IndexedValueTypeDouble,
+		CustomDatetimeField:    shared.IndexedValueTypeDatetime,
+		CustomKeywordListField: shared.IndexedValueTypeKeywordList,
+		CadenceChangeVersion:   shared.IndexedValueTypeKeyword,
+		BinaryChecksums:        shared.IndexedValueTypeKeyword,
 	}
 	for k, v := range systemIndexedKeys {
 		defaultIndexedKeys[k] = v
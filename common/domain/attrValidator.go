@@ -58,6 +58,12 @@ func (d *AttrValidatorImpl) validateDomainConfig(config *persistence.DomainConfi
 	if config.VisibilityArchivalStatus == types.ArchivalStatusEnabled && len(config.VisibilityArchivalURI) == 0 {
 		return errInvalidArchivalConfig
 	}
+	if config.Limits.RequestsPerSecond < 0 ||
+		config.Limits.MaxConcurrentWorkflows < 0 ||
+		config.Limits.MaxPendingActivities < 0 ||
+		config.Limits.MaxHistorySize < 0 {
+		return errInvalidDomainLimits
+	}
 	return nil
 }
 
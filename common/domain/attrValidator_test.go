@@ -87,6 +87,32 @@ func (s *attrValidatorSuite) TestValidateConfigRetentionPeriod() {
 	}
 }
 
+func (s *attrValidatorSuite) TestValidateConfigLimits() {
+	testCases := []struct {
+		limits      types.DomainLimits
+		expectedErr error
+	}{
+		{
+			limits:      types.DomainLimits{},
+			expectedErr: nil,
+		},
+		{
+			limits:      types.DomainLimits{RequestsPerSecond: 10, MaxHistorySize: 1024},
+			expectedErr: nil,
+		},
+		{
+			limits:      types.DomainLimits{MaxPendingActivities: -1},
+			expectedErr: errInvalidDomainLimits,
+		},
+	}
+	for _, tc := range testCases {
+		actualErr := s.validator.validateDomainConfig(
+			&persistence.DomainConfig{Retention: 10, Limits: tc.limits},
+		)
+		s.Equal(tc.expectedErr, actualErr)
+	}
+}
+
 func (s *attrValidatorSuite) TestClusterName() {
 	s.mockClusterMetadata.On("GetAllClusterInfo").Return(
 		cluster.TestAllClusterInfo,
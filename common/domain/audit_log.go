@@ -0,0 +1,230 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination audit_log_mock.go -self_package github.com/uber/cadence/common/domain
+
+package domain
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+)
+
+const (
+	domainChangeOperationRegister  = "RegisterDomain"
+	domainChangeOperationUpdate    = "UpdateDomain"
+	domainChangeOperationDeprecate = "DeprecateDomain"
+	domainChangeOperationClone     = "CloneDomain"
+	domainChangeOperationRename    = "RenameDomain"
+)
+
+var _ AuditLog = (*auditLogImpl)(nil)
+
+type (
+	// AuditLog records every mutation of a domain and lists them back, oldest first
+	AuditLog interface {
+		Append(ctx context.Context, change *types.DomainChange) error
+		List(ctx context.Context, domainID string, pageSize int, pageToken []byte) ([]*types.DomainChange, []byte, error)
+	}
+
+	auditLogImpl struct {
+		auditManager persistence.DomainAuditManager
+		encoder      codec.BinaryEncoder
+	}
+)
+
+// NewAuditLog creates a new AuditLog backed by the per domain audit log store
+func NewAuditLog(
+	auditManager persistence.DomainAuditManager,
+) AuditLog {
+	return &auditLogImpl{
+		auditManager: auditManager,
+		encoder:      codec.NewThriftRWEncoder(),
+	}
+}
+
+func (a *auditLogImpl) Append(
+	ctx context.Context,
+	change *types.DomainChange,
+) error {
+
+	bytes, err := a.encoder.Encode(thrift.FromDomainChange(change))
+	if err != nil {
+		return fmt.Errorf("failed to encode domain change: %v", err)
+	}
+	return a.auditManager.AppendDomainAuditLog(ctx, change.DomainID, persistence.NewDataBlob(bytes, common.EncodingTypeThriftRW))
+}
+
+// List returns up to pageSize changes of the given domain,
+// the returned token is nil once the last change was returned
+func (a *auditLogImpl) List(
+	ctx context.Context,
+	domainID string,
+	pageSize int,
+	pageToken []byte,
+) ([]*types.DomainChange, []byte, error) {
+
+	lastEventID := int64(common.EmptyMessageID)
+	if len(pageToken) > 0 {
+		if len(pageToken) != 8 {
+			return nil, nil, &types.BadRequestError{Message: "Invalid next page token."}
+		}
+		lastEventID = int64(binary.LittleEndian.Uint64(pageToken))
+	}
+
+	entries, err := a.auditManager.ReadDomainAuditLogs(ctx, domainID, lastEventID, pageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var changes []*types.DomainChange
+	for _, entry := range entries {
+		var change admin.DomainChange
+		if err := a.encoder.Decode(entry.Payload.Data, &change); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode domain change: %v", err)
+		}
+		change.ID = common.Int64Ptr(entry.EventID)
+		changes = append(changes, thrift.ToDomainChange(&change))
+		lastEventID = entry.EventID
+	}
+
+	if len(entries) < pageSize {
+		return changes, nil, nil
+	}
+	token := make([]byte, 8)
+	binary.LittleEndian.PutUint64(token, uint64(lastEventID))
+	return changes, token, nil
+}
+
+// appendDomainChange appends the change to the audit log if there is one,
+// failures are logged and counted but never fail the domain mutation that was already persisted
+func appendDomainChange(
+	ctx context.Context,
+	auditLog AuditLog,
+	metricsClient metrics.Client,
+	logger log.Logger,
+	change *types.DomainChange,
+) {
+
+	if auditLog == nil {
+		return
+	}
+	if err := auditLog.Append(ctx, change); err != nil {
+		metricsClient.IncCounter(metrics.DomainAuditLogScope, metrics.DomainAuditLogAppendFailures)
+		logger.Warn("Failed to append domain change to audit log",
+			tag.WorkflowDomainName(change.DomainName),
+			tag.WorkflowDomainID(change.DomainID),
+			tag.Error(err),
+		)
+	}
+}
+
+// flattenDomain renders the mutable parts of a domain as field name to value,
+// so that two snapshots can be diffed into a list of field changes
+func flattenDomain(
+	info *persistence.DomainInfo,
+	config *persistence.DomainConfig,
+	replicationConfig *persistence.DomainReplicationConfig,
+) map[string]string {
+
+	fields := make(map[string]string)
+	if info != nil {
+		fields["name"] = info.Name
+		if status := getDomainStatus(info); status != nil {
+			fields["status"] = status.String()
+		}
+		fields["description"] = info.Description
+		fields["ownerEmail"] = info.OwnerEmail
+		for k, v := range info.Data {
+			fields["data."+k] = v
+		}
+	}
+	if config != nil {
+		fields["retentionDays"] = strconv.Itoa(int(config.Retention))
+		fields["emitMetric"] = strconv.FormatBool(config.EmitMetric)
+		fields["historyArchivalStatus"] = config.HistoryArchivalStatus.String()
+		fields["historyArchivalURI"] = config.HistoryArchivalURI
+		fields["visibilityArchivalStatus"] = config.VisibilityArchivalStatus.String()
+		fields["visibilityArchivalURI"] = config.VisibilityArchivalURI
+		for checksum, binaryInfo := range config.BadBinaries.Binaries {
+			fields["badBinaries."+checksum] = binaryInfo.GetReason()
+		}
+		fields["limits.requestsPerSecond"] = strconv.Itoa(int(config.Limits.RequestsPerSecond))
+		fields["limits.maxConcurrentWorkflows"] = strconv.Itoa(int(config.Limits.MaxConcurrentWorkflows))
+		fields["limits.maxPendingActivities"] = strconv.Itoa(int(config.Limits.MaxPendingActivities))
+		fields["limits.maxHistorySize"] = strconv.FormatInt(config.Limits.MaxHistorySize, 10)
+	}
+	if replicationConfig != nil {
+		fields["activeClusterName"] = replicationConfig.ActiveClusterName
+		var clusters []string
+		for _, clusterConfig := range replicationConfig.Clusters {
+			clusters = append(clusters, clusterConfig.ClusterName)
+		}
+		sort.Strings(clusters)
+		fields["clusters"] = strings.Join(clusters, ",")
+	}
+	return fields
+}
+
+// diffDomain returns the fields that differ between two flattened domains, sorted by field name
+func diffDomain(before, after map[string]string) []*types.DomainFieldChange {
+	var changes []*types.DomainFieldChange
+	for field, newValue := range after {
+		if oldValue, ok := before[field]; !ok || oldValue != newValue {
+			changes = append(changes, &types.DomainFieldChange{Field: field, OldValue: before[field], NewValue: newValue})
+		}
+	}
+	for field, oldValue := range before {
+		if _, ok := after[field]; !ok {
+			changes = append(changes, &types.DomainFieldChange{Field: field, OldValue: oldValue})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes
+}
+
+// getChangeReason returns the reason supplied by the caller through the request header,
+// or the given default when none was supplied
+func getChangeReason(ctx context.Context, defaultReason string) string {
+	if call := yarpc.CallFromContext(ctx); call != nil {
+		if reason := call.Header(common.DomainChangeReasonHeaderName); reason != "" {
+			return reason
+		}
+	}
+	return defaultReason
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+// inMemoryDomainAuditManager implements persistence.DomainAuditManager with one slice per domain
+type inMemoryDomainAuditManager struct {
+	persistence.DomainAuditManager
+	entries map[string][]*persistence.DomainAuditLogEntry
+}
+
+func newInMemoryDomainAuditManager() *inMemoryDomainAuditManager {
+	return &inMemoryDomainAuditManager{entries: make(map[string][]*persistence.DomainAuditLogEntry)}
+}
+
+func (m *inMemoryDomainAuditManager) AppendDomainAuditLog(_ context.Context, domainID string, payload *persistence.DataBlob) error {
+	m.entries[domainID] = append(m.entries[domainID], &persistence.DomainAuditLogEntry{
+		DomainID: domainID,
+		EventID:  int64(len(m.entries[domainID])),
+		Payload:  payload,
+	})
+	return nil
+}
+
+func (m *inMemoryDomainAuditManager) ReadDomainAuditLogs(_ context.Context, domainID string, lastEventID int64, maxCount int) ([]*persistence.DomainAuditLogEntry, error) {
+	var result []*persistence.DomainAuditLogEntry
+	for _, entry := range m.entries[domainID] {
+		if entry.EventID > lastEventID && len(result) < maxCount {
+			result = append(result, entry)
+		}
+	}
+	return result, nil
+}
+
+func TestAuditLog_AppendAndList(t *testing.T) {
+	ctx := context.Background()
+	auditLog := NewAuditLog(newInMemoryDomainAuditManager())
+
+	for _, change := range []*types.DomainChange{
+		{DomainID: "id-1", DomainName: "domain-1", Operation: domainChangeOperationRegister},
+		{DomainID: "id-2", DomainName: "domain-2", Operation: domainChangeOperationRegister},
+		{DomainID: "id-1", DomainName: "domain-1", Operation: domainChangeOperationUpdate, Actor: "John Doe", Reason: "failover to standby",
+			FieldChanges: []*types.DomainFieldChange{{Field: "activeClusterName", OldValue: "active", NewValue: "standby"}}},
+	} {
+		require.NoError(t, auditLog.Append(ctx, change))
+	}
+
+	changes, token, err := auditLog.List(ctx, "id-1", 1, nil)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, int64(0), changes[0].ID)
+	require.Equal(t, domainChangeOperationRegister, changes[0].Operation)
+	require.NotNil(t, token)
+
+	changes, token, err = auditLog.List(ctx, "id-1", 1, token)
+	require.NoError(t, err)
+	require.Equal(t, []*types.DomainChange{{
+		ID:           1,
+		DomainID:     "id-1",
+		DomainName:   "domain-1",
+		Operation:    domainChangeOperationUpdate,
+		Actor:        "John Doe",
+		Reason:       "failover to standby",
+		FieldChanges: []*types.DomainFieldChange{{Field: "activeClusterName", OldValue: "active", NewValue: "standby"}},
+	}}, changes)
+
+	changes, token, err = auditLog.List(ctx, "id-1", 1, token)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+	require.Nil(t, token)
+
+	_, _, err = auditLog.List(ctx, "id-1", 1, []byte("bad"))
+	require.IsType(t, &types.BadRequestError{}, err)
+}
+
+func TestAppendDomainChange_CountsFailures(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	auditLog := NewMockAuditLog(controller)
+	auditLog.EXPECT().Append(gomock.Any(), gomock.Any()).Return(errors.New("store unavailable"))
+	scope := tally.NewTestScope("test", nil)
+
+	appendDomainChange(context.Background(), auditLog, metrics.NewClient(scope, metrics.Common), loggerimpl.NewNopLogger(),
+		&types.DomainChange{DomainID: "id-1", DomainName: "domain-1", Operation: domainChangeOperationUpdate})
+
+	var failures int64
+	for _, counter := range scope.Snapshot().Counters() {
+		if counter.Name() == "test.domain_audit_log_append_failures" {
+			failures += counter.Value()
+		}
+	}
+	require.Equal(t, int64(1), failures)
+}
+
+func TestDiffDomain(t *testing.T) {
+	before := flattenDomain(
+		&persistence.DomainInfo{Name: "domain", Status: persistence.DomainStatusRegistered, Data: map[string]string{"k1": "v1", "k2": "v2"}},
+		&persistence.DomainConfig{Retention: 1},
+		&persistence.DomainReplicationConfig{ActiveClusterName: "active"},
+	)
+	after := flattenDomain(
+		&persistence.DomainInfo{Name: "domain", Status: persistence.DomainStatusRegistered, Data: map[string]string{"k1": "v1", "k3": "v3"}},
+		&persistence.DomainConfig{Retention: 3, BadBinaries: types.BadBinaries{Binaries: map[string]*types.BadBinaryInfo{"checksum": {Reason: "broken"}}}},
+		&persistence.DomainReplicationConfig{ActiveClusterName: "active"},
+	)
+
+	require.Equal(t, []*types.DomainFieldChange{
+		{Field: "badBinaries.checksum", NewValue: "broken"},
+		{Field: "data.k2", OldValue: "v2"},
+		{Field: "data.k3", NewValue: "v3"},
+		{Field: "retentionDays", OldValue: "1", NewValue: "3"},
+	}, diffDomain(before, after))
+	require.Empty(t, diffDomain(before, before))
+	require.Len(t, diffDomain(nil, before), len(before))
+}
@@ -34,7 +34,10 @@ var (
 	errGracefulFailoverInActiveCluster     = &types.BadRequestError{Message: "Cannot start the graceful failover from an active cluster to an active cluster."}
 	errOngoingGracefulFailover             = &types.BadRequestError{Message: "Cannot start concurrent graceful failover."}
 	errInvalidGracefulFailover             = &types.BadRequestError{Message: "Cannot start graceful failover without updating active cluster or in local domain."}
+	errDomainNameNotChanged                = &types.BadRequestError{Message: "Cannot rename a domain to its current name."}
+	errConcurrentWorkflowsLimitUnsupported = &types.BadRequestError{Message: "MaxConcurrentWorkflows requires advanced visibility to be enabled."}
 
 	errInvalidRetentionPeriod = &types.BadRequestError{Message: "A valid retention period is not set on request."}
 	errInvalidArchivalConfig  = &types.BadRequestError{Message: "Invalid to enable archival without specifying a uri."}
+	errInvalidDomainLimits    = &types.BadRequestError{Message: "Domain limits cannot be negative."}
 )
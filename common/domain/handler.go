@@ -25,6 +25,8 @@ package domain
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -32,11 +34,13 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
@@ -48,6 +52,10 @@ var (
 type (
 	// Handler is the domain operation handler
 	Handler interface {
+		CloneDomain(
+			ctx context.Context,
+			cloneRequest *types.CloneDomainRequest,
+		) error
 		DeprecateDomain(
 			ctx context.Context,
 			deprecateRequest *types.DeprecateDomainRequest,
@@ -64,6 +72,10 @@ type (
 			ctx context.Context,
 			registerRequest *types.RegisterDomainRequest,
 		) error
+		RenameDomain(
+			ctx context.Context,
+			renameRequest *types.RenameDomainRequest,
+		) error
 		UpdateDomain(
 			ctx context.Context,
 			updateRequest *types.UpdateDomainRequest,
@@ -75,12 +87,14 @@ type (
 		domainManager       persistence.DomainManager
 		clusterMetadata     cluster.Metadata
 		domainReplicator    Replicator
+		auditLog            AuditLog
 		domainAttrValidator *AttrValidatorImpl
 		archivalMetadata    archiver.ArchivalMetadata
 		archiverProvider    provider.ArchiverProvider
 		timeSource          clock.TimeSource
 		config              Config
 		logger              log.Logger
+		metricsClient       metrics.Client
 	}
 
 	// Config is the domain config for domain handler
@@ -90,6 +104,9 @@ type (
 		RequiredDomainDataKeys dynamicconfig.MapPropertyFn
 		MaxBadBinaryCount      dynamicconfig.IntPropertyFnWithDomainFilter
 		FailoverCoolDown       dynamicconfig.DurationPropertyFnWithDomainFilter
+		// EnableAdvancedVisibility reports whether visibility is served by the advanced
+		// (ES) store, which is required to enforce MaxConcurrentWorkflows
+		EnableAdvancedVisibility dynamicconfig.BoolPropertyFnWithDomainFilter
 	}
 )
 
@@ -99,18 +116,22 @@ var _ Handler = (*handlerImpl)(nil)
 func NewHandler(
 	config Config,
 	logger log.Logger,
+	metricsClient metrics.Client,
 	domainManager persistence.DomainManager,
 	clusterMetadata cluster.Metadata,
 	domainReplicator Replicator,
+	auditLog AuditLog,
 	archivalMetadata archiver.ArchivalMetadata,
 	archiverProvider provider.ArchiverProvider,
 	timeSource clock.TimeSource,
 ) Handler {
 	return &handlerImpl{
 		logger:              logger,
+		metricsClient:       metricsClient,
 		domainManager:       domainManager,
 		clusterMetadata:     clusterMetadata,
 		domainReplicator:    domainReplicator,
+		auditLog:            auditLog,
 		domainAttrValidator: newAttrValidator(clusterMetadata, int32(config.MinRetentionDays())),
 		archivalMetadata:    archivalMetadata,
 		archiverProvider:    archiverProvider,
@@ -279,6 +300,15 @@ func (d *handlerImpl) RegisterDomain(
 		}
 	}
 
+	d.recordDomainChange(
+		ctx,
+		domainChangeOperationRegister,
+		domainRequest.Info,
+		nil,
+		flattenDomain(domainRequest.Info, domainRequest.Config, domainRequest.ReplicationConfig),
+		"",
+	)
+
 	d.logger.Info("Register domain succeeded",
 		tag.WorkflowDomainName(registerRequest.GetName()),
 		tag.WorkflowDomainID(domainResponse.ID),
@@ -372,6 +402,8 @@ func (d *handlerImpl) UpdateDomain(
 	info := getResponse.Info
 	config := getResponse.Config
 	replicationConfig := getResponse.ReplicationConfig
+	// the update helpers below modify the domain in place, snapshot it for the audit log first
+	domainBeforeUpdate := flattenDomain(info, config, replicationConfig)
 	configVersion := getResponse.ConfigVersion
 	failoverVersion := getResponse.FailoverVersion
 	failoverNotificationVersion := getResponse.FailoverNotificationVersion
@@ -476,6 +508,11 @@ func (d *handlerImpl) UpdateDomain(
 	if err := d.domainAttrValidator.validateDomainConfig(config); err != nil {
 		return nil, err
 	}
+	if updateRequest.Limits != nil {
+		if err := d.validateDomainLimits(info.Name, config.Limits); err != nil {
+			return nil, err
+		}
+	}
 	if isGlobalDomain {
 		if err := d.domainAttrValidator.validateDomainReplicationConfigForGlobalDomain(
 			replicationConfig,
@@ -557,6 +594,17 @@ func (d *handlerImpl) UpdateDomain(
 		}
 	}
 
+	if configurationChanged || activeClusterChanged {
+		d.recordDomainChange(
+			ctx,
+			domainChangeOperationUpdate,
+			info,
+			domainBeforeUpdate,
+			flattenDomain(info, config, replicationConfig),
+			getUpdateDomainReason(updateRequest, activeClusterChanged),
+		)
+	}
+
 	response := &types.UpdateDomainResponse{
 		IsGlobalDomain:  isGlobalDomain,
 		FailoverVersion: failoverVersion,
@@ -594,6 +642,7 @@ func (d *handlerImpl) DeprecateDomain(
 	if isGlobalDomain && !d.clusterMetadata.IsPrimaryCluster() {
 		return errNotPrimaryCluster
 	}
+	domainBeforeUpdate := flattenDomain(getResponse.Info, getResponse.Config, getResponse.ReplicationConfig)
 	getResponse.ConfigVersion = getResponse.ConfigVersion + 1
 	getResponse.Info.Status = persistence.DomainStatusDeprecated
 
@@ -630,6 +679,15 @@ func (d *handlerImpl) DeprecateDomain(
 		}
 	}
 
+	d.recordDomainChange(
+		ctx,
+		domainChangeOperationDeprecate,
+		getResponse.Info,
+		domainBeforeUpdate,
+		flattenDomain(getResponse.Info, getResponse.Config, getResponse.ReplicationConfig),
+		"",
+	)
+
 	d.logger.Info("DeprecateDomain domain succeeded",
 		tag.WorkflowDomainName(getResponse.Info.Name),
 		tag.WorkflowDomainID(getResponse.Info.ID),
@@ -637,6 +695,241 @@ func (d *handlerImpl) DeprecateDomain(
 	return nil
 }
 
+// CloneDomain registers a new domain carrying over the configuration of an existing one
+func (d *handlerImpl) CloneDomain(
+	ctx context.Context,
+	cloneRequest *types.CloneDomainRequest,
+) error {
+
+	getResponse, err := d.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{Name: cloneRequest.GetSourceName()})
+	if err != nil {
+		return err
+	}
+
+	isGlobalDomain := getResponse.IsGlobalDomain
+	if isGlobalDomain && !d.clusterMetadata.IsPrimaryCluster() {
+		return errNotPrimaryCluster
+	}
+
+	_, err = d.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{Name: cloneRequest.GetName()})
+	switch err.(type) {
+	case nil:
+		// domain already exists, cannot proceed
+		return &types.DomainAlreadyExistsError{Message: "Domain already exists."}
+	case *types.EntityNotExistsError:
+		// domain does not exists, proceeds
+	default:
+		// other err
+		return err
+	}
+
+	data := make(map[string]string, len(getResponse.Info.Data))
+	for k, v := range getResponse.Info.Data {
+		data[k] = v
+	}
+	badBinaries := make(map[string]*types.BadBinaryInfo, len(getResponse.Config.BadBinaries.Binaries))
+	for k, v := range getResponse.Config.BadBinaries.Binaries {
+		badBinaries[k] = v
+	}
+	info := &persistence.DomainInfo{
+		ID:          uuid.New(),
+		Name:        cloneRequest.GetName(),
+		Status:      persistence.DomainStatusRegistered,
+		OwnerEmail:  getResponse.Info.OwnerEmail,
+		Description: getResponse.Info.Description,
+		Data:        data,
+	}
+	config := &persistence.DomainConfig{
+		Retention:                getResponse.Config.Retention,
+		EmitMetric:               getResponse.Config.EmitMetric,
+		HistoryArchivalStatus:    getResponse.Config.HistoryArchivalStatus,
+		HistoryArchivalURI:       getResponse.Config.HistoryArchivalURI,
+		VisibilityArchivalStatus: getResponse.Config.VisibilityArchivalStatus,
+		VisibilityArchivalURI:    getResponse.Config.VisibilityArchivalURI,
+		BadBinaries:              types.BadBinaries{Binaries: badBinaries},
+		Limits:                   getResponse.Config.Limits,
+	}
+	clusters := []*persistence.ClusterReplicationConfig{}
+	for _, clusterConfig := range getResponse.ReplicationConfig.Clusters {
+		clusters = append(clusters, &persistence.ClusterReplicationConfig{ClusterName: clusterConfig.ClusterName})
+	}
+	replicationConfig := &persistence.DomainReplicationConfig{
+		ActiveClusterName: getResponse.ReplicationConfig.ActiveClusterName,
+		Clusters:          clusters,
+	}
+
+	if err := d.domainAttrValidator.validateDomainConfig(config); err != nil {
+		return err
+	}
+	if err := d.validateDomainLimits(info.Name, config.Limits); err != nil {
+		return err
+	}
+	if isGlobalDomain {
+		if err := d.domainAttrValidator.validateDomainReplicationConfigForGlobalDomain(
+			replicationConfig,
+		); err != nil {
+			return err
+		}
+	} else {
+		if err := d.domainAttrValidator.validateDomainReplicationConfigForLocalDomain(
+			replicationConfig,
+		); err != nil {
+			return err
+		}
+	}
+
+	failoverVersion := common.EmptyVersion
+	if isGlobalDomain {
+		failoverVersion = d.clusterMetadata.GetNextFailoverVersion(replicationConfig.ActiveClusterName, 0)
+	}
+
+	domainRequest := &persistence.CreateDomainRequest{
+		Info:              info,
+		Config:            config,
+		ReplicationConfig: replicationConfig,
+		IsGlobalDomain:    isGlobalDomain,
+		ConfigVersion:     0,
+		FailoverVersion:   failoverVersion,
+		LastUpdatedTime:   d.timeSource.Now().UnixNano(),
+	}
+
+	domainResponse, err := d.domainManager.CreateDomain(ctx, domainRequest)
+	if err != nil {
+		return err
+	}
+
+	if domainRequest.IsGlobalDomain {
+		err = d.domainReplicator.HandleTransmissionTask(
+			ctx,
+			types.DomainOperationCreate,
+			domainRequest.Info,
+			domainRequest.Config,
+			domainRequest.ReplicationConfig,
+			domainRequest.ConfigVersion,
+			domainRequest.FailoverVersion,
+			common.InitialPreviousFailoverVersion,
+			domainRequest.IsGlobalDomain,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.recordDomainChange(
+		ctx,
+		domainChangeOperationClone,
+		domainRequest.Info,
+		nil,
+		flattenDomain(domainRequest.Info, domainRequest.Config, domainRequest.ReplicationConfig),
+		fmt.Sprintf("clone of %v", cloneRequest.GetSourceName()),
+	)
+
+	d.logger.Info("Clone domain succeeded",
+		tag.WorkflowDomainName(cloneRequest.GetName()),
+		tag.WorkflowDomainID(domainResponse.ID),
+	)
+
+	return nil
+}
+
+// RenameDomain changes the name of a domain, keeping its ID
+func (d *handlerImpl) RenameDomain(
+	ctx context.Context,
+	renameRequest *types.RenameDomainRequest,
+) error {
+
+	if renameRequest.GetNewName() == renameRequest.GetName() {
+		return errDomainNameNotChanged
+	}
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 domain table
+	// and since we do not know which table will return the domain afterwards
+	// this call has to be made
+	metadata, err := d.domainManager.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	notificationVersion := metadata.NotificationVersion
+	getResponse, err := d.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{Name: renameRequest.GetName()})
+	if err != nil {
+		return err
+	}
+
+	isGlobalDomain := getResponse.IsGlobalDomain
+	if isGlobalDomain && !d.clusterMetadata.IsPrimaryCluster() {
+		return errNotPrimaryCluster
+	}
+
+	_, err = d.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{Name: renameRequest.GetNewName()})
+	switch err.(type) {
+	case nil:
+		// new name is taken, cannot proceed
+		return &types.DomainAlreadyExistsError{Message: "Domain already exists."}
+	case *types.EntityNotExistsError:
+		// new name is free, proceeds
+	default:
+		// other err
+		return err
+	}
+
+	domainBeforeUpdate := flattenDomain(getResponse.Info, getResponse.Config, getResponse.ReplicationConfig)
+	getResponse.ConfigVersion = getResponse.ConfigVersion + 1
+	getResponse.Info.Name = renameRequest.GetNewName()
+
+	renameReq := &persistence.RenameDomainRequest{
+		PreviousName:   renameRequest.GetName(),
+		IsGlobalDomain: isGlobalDomain,
+		UpdateDomainRequest: persistence.UpdateDomainRequest{
+			Info:                        getResponse.Info,
+			Config:                      getResponse.Config,
+			ReplicationConfig:           getResponse.ReplicationConfig,
+			ConfigVersion:               getResponse.ConfigVersion,
+			FailoverVersion:             getResponse.FailoverVersion,
+			FailoverNotificationVersion: getResponse.FailoverNotificationVersion,
+			FailoverEndTime:             getResponse.FailoverEndTime,
+			PreviousFailoverVersion:     getResponse.PreviousFailoverVersion,
+			LastUpdatedTime:             d.timeSource.Now().UnixNano(),
+			NotificationVersion:         notificationVersion,
+		},
+	}
+	err = d.domainManager.RenameDomain(ctx, renameReq)
+	if err != nil {
+		return err
+	}
+
+	if isGlobalDomain {
+		if err := d.domainReplicator.HandleTransmissionTask(
+			ctx,
+			types.DomainOperationUpdate,
+			getResponse.Info,
+			getResponse.Config,
+			getResponse.ReplicationConfig,
+			getResponse.ConfigVersion,
+			getResponse.FailoverVersion,
+			getResponse.PreviousFailoverVersion,
+			isGlobalDomain,
+		); err != nil {
+			return err
+		}
+	}
+
+	d.recordDomainChange(
+		ctx,
+		domainChangeOperationRename,
+		getResponse.Info,
+		domainBeforeUpdate,
+		flattenDomain(getResponse.Info, getResponse.Config, getResponse.ReplicationConfig),
+		"",
+	)
+
+	d.logger.Info("Rename domain succeeded",
+		tag.WorkflowDomainName(getResponse.Info.Name),
+		tag.WorkflowDomainID(getResponse.Info.ID),
+	)
+	return nil
+}
+
 func (d *handlerImpl) createResponse(
 	info *persistence.DomainInfo,
 	config *persistence.DomainConfig,
@@ -660,6 +953,7 @@ func (d *handlerImpl) createResponse(
 		VisibilityArchivalStatus:               config.VisibilityArchivalStatus.Ptr(),
 		VisibilityArchivalURI:                  config.VisibilityArchivalURI,
 		BadBinaries:                            &config.BadBinaries,
+		Limits:                                 domainLimits(config.Limits),
 	}
 
 	clusters := []*types.ClusterReplicationConfiguration{}
@@ -870,6 +1164,10 @@ func (d *handlerImpl) updateDomainConfiguration(
 			}
 		}
 	}
+	if updateRequest.Limits != nil {
+		isConfigChanged = true
+		config.Limits = *updateRequest.Limits
+	}
 	return config, isConfigChanged, nil
 }
 
@@ -923,6 +1221,81 @@ func (d *handlerImpl) updateReplicationConfig(
 	return config, clusterUpdated, activeClusterUpdated, nil
 }
 
+// recordDomainChange appends the change to the domain audit log. The mutation is already
+// persisted at this point, so a failure is only logged and never returned to the caller.
+func (d *handlerImpl) recordDomainChange(
+	ctx context.Context,
+	operation string,
+	info *persistence.DomainInfo,
+	before map[string]string,
+	after map[string]string,
+	defaultReason string,
+) {
+
+	appendDomainChange(ctx, d.auditLog, d.metricsClient, d.logger, &types.DomainChange{
+		DomainID:     info.ID,
+		DomainName:   info.Name,
+		Operation:    operation,
+		Actor:        authorization.GetActor(ctx),
+		Reason:       getChangeReason(ctx, defaultReason),
+		Timestamp:    d.timeSource.Now().UnixNano(),
+		FieldChanges: diffDomain(before, after),
+	})
+}
+
+// getUpdateDomainReason describes failovers and bad binary changes,
+// which callers often do not bother to explain
+func getUpdateDomainReason(
+	updateRequest *types.UpdateDomainRequest,
+	activeClusterChanged bool,
+) string {
+
+	var reasons []string
+	if activeClusterChanged {
+		if updateRequest.FailoverTimeoutInSeconds != nil {
+			reasons = append(reasons, fmt.Sprintf("graceful failover to %v", common.StringDefault(updateRequest.ActiveClusterName)))
+		} else {
+			reasons = append(reasons, fmt.Sprintf("failover to %v", common.StringDefault(updateRequest.ActiveClusterName)))
+		}
+	}
+	if updateRequest.BadBinaries != nil {
+		var checksums []string
+		for checksum := range updateRequest.BadBinaries.Binaries {
+			checksums = append(checksums, checksum)
+		}
+		sort.Strings(checksums)
+		for _, checksum := range checksums {
+			reasons = append(reasons, fmt.Sprintf("add bad binary %v: %v", checksum, updateRequest.BadBinaries.Binaries[checksum].GetReason()))
+		}
+	}
+	if updateRequest.DeleteBadBinary != nil {
+		reasons = append(reasons, fmt.Sprintf("delete bad binary %v", updateRequest.GetDeleteBadBinary()))
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// validateDomainLimits rejects limits which cannot be enforced with the current
+// visibility store: counting open workflows requires advanced visibility.
+func (d *handlerImpl) validateDomainLimits(
+	domainName string,
+	limits types.DomainLimits,
+) error {
+
+	if limits.MaxConcurrentWorkflows > 0 && !d.config.EnableAdvancedVisibility(domainName) {
+		return errConcurrentWorkflowsLimitUnsupported
+	}
+	return nil
+}
+
+// domainLimits returns nil when no limit is set, so that unlimited domains
+// keep rendering without a limits section
+func domainLimits(limits types.DomainLimits) *types.DomainLimits {
+	if limits == (types.DomainLimits{}) {
+		return nil
+	}
+	return &limits
+}
+
 func getDomainStatus(info *persistence.DomainInfo) *types.DomainStatus {
 	switch info.Status {
 	case persistence.DomainStatusRegistered:
@@ -38,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/config"
 	dc "github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql/public"
@@ -99,17 +100,20 @@ func (s *domainHandlerGlobalDomainDisabledSuite) SetupTest() {
 		&config.ArchivalDomainDefaults{},
 	)
 	domainConfig := Config{
-		MinRetentionDays:  dc.GetIntPropertyFn(s.minRetentionDays),
-		MaxBadBinaryCount: dc.GetIntPropertyFilteredByDomain(s.maxBadBinaryCount),
-		FailoverCoolDown:  dc.GetDurationPropertyFnFilteredByDomain(0 * time.Second),
+		MinRetentionDays:         dc.GetIntPropertyFn(s.minRetentionDays),
+		MaxBadBinaryCount:        dc.GetIntPropertyFilteredByDomain(s.maxBadBinaryCount),
+		FailoverCoolDown:         dc.GetDurationPropertyFnFilteredByDomain(0 * time.Second),
+		EnableAdvancedVisibility: dc.GetBoolPropertyFnFilteredByDomain(true),
 	}
 	s.mockArchiverProvider = &provider.MockArchiverProvider{}
 	s.handler = NewHandler(
 		domainConfig,
 		logger,
+		metrics.NewNoopMetricsClient(),
 		s.domainManager,
 		s.ClusterMetadata,
 		s.mockDomainReplicator,
+		NewAuditLog(s.DomainAuditManager),
 		s.archivalMetadata,
 		s.mockArchiverProvider,
 		clock.NewRealTimeSource(),
@@ -394,6 +398,12 @@ func (s *domainHandlerGlobalDomainDisabledSuite) TestUpdateGetDomain_AllAttrSet(
 		},
 	}
 	data := map[string]string{"some random key": "some random value"}
+	limits := &types.DomainLimits{
+		RequestsPerSecond:      100,
+		MaxConcurrentWorkflows: 1000,
+		MaxPendingActivities:   50,
+		MaxHistorySize:         1024 * 1024,
+	}
 
 	var expectedClusters []*types.ClusterReplicationConfiguration
 	for _, replicationConfig := range cluster.GetOrUseDefaultClusters(s.ClusterMetadata.GetCurrentClusterName(), nil) {
@@ -422,6 +432,7 @@ func (s *domainHandlerGlobalDomainDisabledSuite) TestUpdateGetDomain_AllAttrSet(
 			VisibilityArchivalStatus:               types.ArchivalStatusDisabled.Ptr(),
 			VisibilityArchivalURI:                  "",
 			BadBinaries:                            &types.BadBinaries{Binaries: map[string]*types.BadBinaryInfo{}},
+			Limits:                                 limits,
 		}, config)
 		s.Equal(&types.DomainReplicationConfiguration{
 			ActiveClusterName: s.ClusterMetadata.GetCurrentClusterName(),
@@ -445,6 +456,7 @@ func (s *domainHandlerGlobalDomainDisabledSuite) TestUpdateGetDomain_AllAttrSet(
 		BadBinaries:                            &types.BadBinaries{Binaries: map[string]*types.BadBinaryInfo{}},
 		ActiveClusterName:                      common.StringPtr(activeClusterName),
 		Clusters:                               clusters,
+		Limits:                                 limits,
 	})
 	s.Nil(err)
 	fnTest(updateResp.DomainInfo, updateResp.Configuration, updateResp.ReplicationConfiguration, updateResp.GetIsGlobalDomain(), updateResp.GetFailoverVersion())
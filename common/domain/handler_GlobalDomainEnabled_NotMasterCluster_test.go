@@ -39,6 +39,7 @@ import (
 	"github.com/uber/cadence/common/config"
 	dc "github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql/public"
@@ -101,16 +102,19 @@ func (s *domainHandlerGlobalDomainEnabledNotPrimaryClusterSuite) SetupTest() {
 	)
 	s.mockArchiverProvider = &provider.MockArchiverProvider{}
 	domainConfig := Config{
-		MinRetentionDays:  dc.GetIntPropertyFn(s.minRetentionDays),
-		MaxBadBinaryCount: dc.GetIntPropertyFilteredByDomain(s.maxBadBinaryCount),
-		FailoverCoolDown:  dc.GetDurationPropertyFnFilteredByDomain(0 * time.Second),
+		MinRetentionDays:         dc.GetIntPropertyFn(s.minRetentionDays),
+		MaxBadBinaryCount:        dc.GetIntPropertyFilteredByDomain(s.maxBadBinaryCount),
+		FailoverCoolDown:         dc.GetDurationPropertyFnFilteredByDomain(0 * time.Second),
+		EnableAdvancedVisibility: dc.GetBoolPropertyFnFilteredByDomain(true),
 	}
 	s.handler = NewHandler(
 		domainConfig,
 		logger,
+		metrics.NewNoopMetricsClient(),
 		s.domainManager,
 		s.ClusterMetadata,
 		s.mockDomainReplicator,
+		NewAuditLog(s.DomainAuditManager),
 		s.archivalMetadata,
 		s.mockArchiverProvider,
 		clock.NewRealTimeSource(),
@@ -58,6 +58,20 @@ func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
 	return m.recorder
 }
 
+// CloneDomain mocks base method
+func (m *MockHandler) CloneDomain(ctx context.Context, cloneRequest *types.CloneDomainRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneDomain", ctx, cloneRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloneDomain indicates an expected call of CloneDomain
+func (mr *MockHandlerMockRecorder) CloneDomain(ctx, cloneRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneDomain", reflect.TypeOf((*MockHandler)(nil).CloneDomain), ctx, cloneRequest)
+}
+
 // DeprecateDomain mocks base method
 func (m *MockHandler) DeprecateDomain(ctx context.Context, deprecateRequest *types.DeprecateDomainRequest) error {
 	m.ctrl.T.Helper()
@@ -116,6 +130,20 @@ func (mr *MockHandlerMockRecorder) RegisterDomain(ctx, registerRequest interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDomain", reflect.TypeOf((*MockHandler)(nil).RegisterDomain), ctx, registerRequest)
 }
 
+// RenameDomain mocks base method
+func (m *MockHandler) RenameDomain(ctx context.Context, renameRequest *types.RenameDomainRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameDomain", ctx, renameRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameDomain indicates an expected call of RenameDomain
+func (mr *MockHandlerMockRecorder) RenameDomain(ctx, renameRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameDomain", reflect.TypeOf((*MockHandler)(nil).RenameDomain), ctx, renameRequest)
+}
+
 // UpdateDomain mocks base method
 func (m *MockHandler) UpdateDomain(ctx context.Context, updateRequest *types.UpdateDomainRequest) (*types.UpdateDomainResponse, error) {
 	m.ctrl.T.Helper()
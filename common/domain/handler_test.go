@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/common/config"
 	dc "github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql/public"
@@ -104,16 +105,19 @@ func (s *domainHandlerCommonSuite) SetupTest() {
 	)
 	s.mockArchiverProvider = &provider.MockArchiverProvider{}
 	domainConfig := Config{
-		MinRetentionDays:  dc.GetIntPropertyFn(s.minRetentionDays),
-		MaxBadBinaryCount: dc.GetIntPropertyFilteredByDomain(s.maxBadBinaryCount),
-		FailoverCoolDown:  dc.GetDurationPropertyFnFilteredByDomain(0 * time.Second),
+		MinRetentionDays:         dc.GetIntPropertyFn(s.minRetentionDays),
+		MaxBadBinaryCount:        dc.GetIntPropertyFilteredByDomain(s.maxBadBinaryCount),
+		FailoverCoolDown:         dc.GetDurationPropertyFnFilteredByDomain(0 * time.Second),
+		EnableAdvancedVisibility: dc.GetBoolPropertyFnFilteredByDomain(true),
 	}
 	s.handler = NewHandler(
 		domainConfig,
 		logger,
+		metrics.NewNoopMetricsClient(),
 		s.domainManager,
 		s.ClusterMetadata,
 		s.mockDomainReplicator,
+		NewAuditLog(s.DomainAuditManager),
 		s.archivalMetadata,
 		s.mockArchiverProvider,
 		clock.NewRealTimeSource(),
@@ -423,6 +427,30 @@ func (s *domainHandlerCommonSuite) TestUpdateDomain_InvalidRetentionPeriod() {
 	s.Equal(errInvalidRetentionPeriod, err)
 }
 
+func (s *domainHandlerCommonSuite) TestUpdateDomain_ConcurrentWorkflowsLimitWithoutAdvancedVisibility() {
+	domain := "random domain name"
+	registerRequest := &types.RegisterDomainRequest{
+		Name:                                   domain,
+		Description:                            domain,
+		WorkflowExecutionRetentionPeriodInDays: int32(10),
+		IsGlobalDomain:                         false,
+	}
+	err := s.handler.RegisterDomain(context.Background(), registerRequest)
+	s.NoError(err)
+
+	s.handler.config.EnableAdvancedVisibility = dc.GetBoolPropertyFnFilteredByDomain(false)
+	updateRequest := &types.UpdateDomainRequest{
+		Name:   domain,
+		Limits: &types.DomainLimits{MaxConcurrentWorkflows: 10},
+	}
+	_, err = s.handler.UpdateDomain(context.Background(), updateRequest)
+	s.Equal(errConcurrentWorkflowsLimitUnsupported, err)
+
+	updateRequest.Limits = &types.DomainLimits{RequestsPerSecond: 10}
+	_, err = s.handler.UpdateDomain(context.Background(), updateRequest)
+	s.NoError(err)
+}
+
 func (s *domainHandlerCommonSuite) TestUpdateDomain_GracefulFailover_Success() {
 	s.mockProducer.On("Publish", mock.Anything, mock.Anything).Return(nil).Twice()
 	domain := uuid.New()
@@ -607,6 +635,86 @@ func (s *domainHandlerCommonSuite) TestUpdateDomain_ForceFailover_SameActiveClus
 	s.NoError(err)
 }
 
+func (s *domainHandlerCommonSuite) TestCloneDomain() {
+	s.mockProducer.On("Publish", mock.Anything, mock.Anything).Return(nil).Twice()
+	source := s.getRandomDomainName()
+	registerRequest := &types.RegisterDomainRequest{
+		Name:                                   source,
+		Description:                            source,
+		OwnerEmail:                             "owner@uber.com",
+		Data:                                   map[string]string{"k0": "v0"},
+		WorkflowExecutionRetentionPeriodInDays: int32(7),
+		IsGlobalDomain:                         true,
+		ActiveClusterName:                      "standby",
+		Clusters: []*types.ClusterReplicationConfiguration{
+			{ClusterName: s.ClusterMetadata.GetCurrentClusterName()},
+			{ClusterName: "standby"},
+		},
+	}
+	err := s.handler.RegisterDomain(context.Background(), registerRequest)
+	s.NoError(err)
+
+	err = s.handler.CloneDomain(context.Background(), &types.CloneDomainRequest{
+		SourceName: source,
+		Name:       source,
+	})
+	s.IsType(&types.DomainAlreadyExistsError{}, err)
+
+	clone := s.getRandomDomainName()
+	err = s.handler.CloneDomain(context.Background(), &types.CloneDomainRequest{
+		SourceName: source,
+		Name:       clone,
+	})
+	s.NoError(err)
+
+	sourceResp, err := s.domainManager.GetDomain(context.Background(), &persistence.GetDomainRequest{Name: source})
+	s.NoError(err)
+	cloneResp, err := s.domainManager.GetDomain(context.Background(), &persistence.GetDomainRequest{Name: clone})
+	s.NoError(err)
+	s.NotEqual(sourceResp.Info.ID, cloneResp.Info.ID)
+	s.Equal(sourceResp.Info.Description, cloneResp.Info.Description)
+	s.Equal(sourceResp.Info.OwnerEmail, cloneResp.Info.OwnerEmail)
+	s.Equal(sourceResp.Info.Data, cloneResp.Info.Data)
+	s.Equal(sourceResp.Config, cloneResp.Config)
+	s.Equal(sourceResp.ReplicationConfig, cloneResp.ReplicationConfig)
+	s.True(cloneResp.IsGlobalDomain)
+	s.Equal(cluster.TestAlternativeClusterInitialFailoverVersion, cloneResp.FailoverVersion)
+}
+
+func (s *domainHandlerCommonSuite) TestRenameDomain() {
+	domain := s.getRandomDomainName()
+	registerRequest := &types.RegisterDomainRequest{
+		Name:                                   domain,
+		Description:                            domain,
+		WorkflowExecutionRetentionPeriodInDays: int32(10),
+		IsGlobalDomain:                         false,
+	}
+	err := s.handler.RegisterDomain(context.Background(), registerRequest)
+	s.NoError(err)
+	resp1, err := s.domainManager.GetDomain(context.Background(), &persistence.GetDomainRequest{Name: domain})
+	s.NoError(err)
+
+	err = s.handler.RenameDomain(context.Background(), &types.RenameDomainRequest{
+		Name:    domain,
+		NewName: domain,
+	})
+	s.Equal(errDomainNameNotChanged, err)
+
+	newName := s.getRandomDomainName()
+	err = s.handler.RenameDomain(context.Background(), &types.RenameDomainRequest{
+		Name:    domain,
+		NewName: newName,
+	})
+	s.NoError(err)
+
+	_, err = s.domainManager.GetDomain(context.Background(), &persistence.GetDomainRequest{Name: domain})
+	s.IsType(&types.EntityNotExistsError{}, err)
+	resp2, err := s.domainManager.GetDomain(context.Background(), &persistence.GetDomainRequest{Name: newName})
+	s.NoError(err)
+	s.Equal(resp1.Info.ID, resp2.Info.ID)
+	s.Equal(resp1.ConfigVersion+1, resp2.ConfigVersion)
+}
+
 func (s *domainHandlerCommonSuite) getRandomDomainName() string {
 	return "domain" + uuid.New()
 }
@@ -28,6 +28,7 @@ import (
 
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
@@ -53,6 +54,8 @@ var (
 
 const (
 	defaultDomainRepliationTaskContextTimeout = 5 * time.Second
+
+	domainReplicationChangeReason = "domain replication task"
 )
 
 // NOTE: the counterpart of domain replication transmission logic is in service/fropntend package
@@ -65,7 +68,9 @@ type (
 
 	domainReplicationTaskExecutorImpl struct {
 		domainManager persistence.DomainManager
+		auditLog      AuditLog
 		timeSource    clock.TimeSource
+		metricsClient metrics.Client
 		logger        log.Logger
 	}
 )
@@ -73,13 +78,17 @@ type (
 // NewReplicationTaskExecutor create a new instance of domain replicator
 func NewReplicationTaskExecutor(
 	domainManager persistence.DomainManager,
+	auditLog AuditLog,
 	timeSource clock.TimeSource,
+	metricsClient metrics.Client,
 	logger log.Logger,
 ) ReplicationTaskExecutor {
 
 	return &domainReplicationTaskExecutorImpl{
 		domainManager: domainManager,
+		auditLog:      auditLog,
 		timeSource:    timeSource,
+		metricsClient: metricsClient,
 		logger:        logger,
 	}
 }
@@ -137,6 +146,9 @@ func (h *domainReplicationTaskExecutorImpl) handleDomainCreationReplicationTask(
 		FailoverVersion: task.GetFailoverVersion(),
 		LastUpdatedTime: h.timeSource.Now().UnixNano(),
 	}
+	if task.Config.GetLimits() != nil {
+		request.Config.Limits = *task.Config.GetLimits()
+	}
 
 	_, err = h.domainManager.CreateDomain(ctx, request)
 	if err != nil {
@@ -184,7 +196,8 @@ func (h *domainReplicationTaskExecutorImpl) handleDomainCreationReplicationTask(
 		return err
 	}
 
-	return err
+	h.recordDomainChange(ctx, domainChangeOperationRegister, request.Info, nil, flattenDomain(request.Info, request.Config, request.ReplicationConfig))
+	return nil
 }
 
 // handleDomainUpdateReplicationTask handles the domain update replication task
@@ -208,13 +221,25 @@ func (h *domainReplicationTaskExecutorImpl) handleDomainUpdateReplicationTask(ct
 		Name: task.Info.GetName(),
 	})
 	if err != nil {
-		if _, ok := err.(*types.EntityNotExistsError); ok {
-			// this can happen if the create domain replication task is to processed.
-			// e.g. new cluster which does not have anything
-			return h.handleDomainCreationReplicationTask(ctx, task)
+		if _, ok := err.(*types.EntityNotExistsError); !ok {
+			return err
+		}
+		// the domain may still be stored under its previous name if it was renamed
+		resp, err = h.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{
+			ID: task.GetID(),
+		})
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); ok {
+				// this can happen if the create domain replication task is to processed.
+				// e.g. new cluster which does not have anything
+				return h.handleDomainCreationReplicationTask(ctx, task)
+			}
+			return err
 		}
-		return err
 	}
+	previousName := resp.Info.Name
+	// the replication config is updated in place, so flatten the current domain before applying the task
+	domainBeforeUpdate := flattenDomain(resp.Info, resp.Config, resp.ReplicationConfig)
 
 	recordUpdated := false
 	request := &persistence.UpdateDomainRequest{
@@ -250,6 +275,9 @@ func (h *domainReplicationTaskExecutorImpl) handleDomainUpdateReplicationTask(ct
 		if task.Config.GetBadBinaries() != nil {
 			request.Config.BadBinaries = *task.Config.GetBadBinaries()
 		}
+		if task.Config.GetLimits() != nil {
+			request.Config.Limits = *task.Config.GetLimits()
+		}
 		request.ReplicationConfig.Clusters = h.convertClusterReplicationConfigFromThrift(task.ReplicationConfig.Clusters)
 		request.ConfigVersion = task.GetConfigVersion()
 	}
@@ -265,7 +293,45 @@ func (h *domainReplicationTaskExecutorImpl) handleDomainUpdateReplicationTask(ct
 		return nil
 	}
 
-	return h.domainManager.UpdateDomain(ctx, request)
+	operation := domainChangeOperationUpdate
+	if request.Info.Name != previousName {
+		operation = domainChangeOperationRename
+		err = h.domainManager.RenameDomain(ctx, &persistence.RenameDomainRequest{
+			PreviousName:        previousName,
+			IsGlobalDomain:      resp.IsGlobalDomain,
+			UpdateDomainRequest: *request,
+		})
+	} else {
+		if request.Info.Status == persistence.DomainStatusDeprecated && resp.Info.Status != persistence.DomainStatusDeprecated {
+			operation = domainChangeOperationDeprecate
+		}
+		err = h.domainManager.UpdateDomain(ctx, request)
+	}
+	if err != nil {
+		return err
+	}
+
+	h.recordDomainChange(ctx, operation, request.Info, domainBeforeUpdate, flattenDomain(request.Info, request.Config, request.ReplicationConfig))
+	return nil
+}
+
+// recordDomainChange records a domain change applied from the active cluster in the audit log of this cluster
+func (h *domainReplicationTaskExecutorImpl) recordDomainChange(
+	ctx context.Context,
+	operation string,
+	info *persistence.DomainInfo,
+	before map[string]string,
+	after map[string]string,
+) {
+
+	appendDomainChange(ctx, h.auditLog, h.metricsClient, h.logger, &types.DomainChange{
+		DomainID:     info.ID,
+		DomainName:   info.Name,
+		Operation:    operation,
+		Reason:       domainReplicationChangeReason,
+		Timestamp:    h.timeSource.Now().UnixNano(),
+		FieldChanges: diffDomain(before, after),
+	})
 }
 
 func (h *domainReplicationTaskExecutorImpl) validateDomainReplicationTask(task *types.DomainTaskAttributes) error {
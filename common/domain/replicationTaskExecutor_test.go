@@ -31,6 +31,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql/public"
 	persistencetests "github.com/uber/cadence/common/persistence/persistence-tests"
@@ -65,7 +66,9 @@ func (s *domainReplicationTaskExecutorSuite) SetupTest() {
 	logger := loggerimpl.NewLogger(zapLogger)
 	s.domainReplicator = NewReplicationTaskExecutor(
 		s.DomainManager,
+		NewAuditLog(s.DomainAuditManager),
 		clock.NewRealTimeSource(),
+		metrics.NewNoopMetricsClient(),
 		logger,
 	).(*domainReplicationTaskExecutorImpl)
 }
@@ -227,6 +230,12 @@ func (s *domainReplicationTaskExecutorSuite) TestExecute_RegisterDomainTask() {
 	// handle duplicated task
 	err = s.domainReplicator.Execute(task)
 	s.Nil(err)
+
+	changes, _, err := s.domainReplicator.auditLog.List(context.Background(), id, 10, nil)
+	s.Nil(err)
+	s.Len(changes, 1)
+	s.Equal(domainChangeOperationRegister, changes[0].Operation)
+	s.Equal(domainReplicationChangeReason, changes[0].Reason)
 }
 
 func (s *domainReplicationTaskExecutorSuite) TestExecute_UpdateDomainTask_DomainNotExist() {
@@ -731,6 +740,15 @@ func (s *domainReplicationTaskExecutorSuite) TestExecute_UpdateDomainTask_NoUpda
 	s.Equal(notificationVersion, resp.FailoverNotificationVersion)
 	s.Equal(notificationVersion, resp.NotificationVersion)
 	s.Equal(failoverVersion, resp.PreviousFailoverVersion)
+
+	changes, _, err := s.domainReplicator.auditLog.List(context.Background(), id, 10, nil)
+	s.Nil(err)
+	s.Len(changes, 2)
+	s.Equal(domainChangeOperationUpdate, changes[1].Operation)
+	s.Equal(domainReplicationChangeReason, changes[1].Reason)
+	s.Equal([]*types.DomainFieldChange{
+		{Field: "activeClusterName", OldValue: clusterActive, NewValue: updateClusterActive},
+	}, changes[1].FieldChanges)
 }
 
 func (s *domainReplicationTaskExecutorSuite) TestExecute_UpdateDomainTask_NoUpdateConfig_NoUpdateActiveCluster() {
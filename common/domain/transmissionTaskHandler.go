@@ -104,6 +104,7 @@ func (domainReplicator *domainReplicatorImpl) HandleTransmissionTask(
 			VisibilityArchivalStatus:               config.VisibilityArchivalStatus.Ptr(),
 			VisibilityArchivalURI:                  config.VisibilityArchivalURI,
 			BadBinaries:                            &config.BadBinaries,
+			Limits:                                 domainLimits(config.Limits),
 		},
 		ReplicationConfig: &types.DomainReplicationConfiguration{
 			ActiveClusterName: replicationConfig.ActiveClusterName,
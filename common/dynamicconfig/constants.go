@@ -188,6 +188,12 @@ const (
 	// Default value: FALSE
 	// Allowed filters: N/A
 	EnableGRPCOutbound
+	// ClusterMetadataRefreshInterval is the interval at which remote cluster definitions are reloaded from the database
+	// KeyName: system.clusterMetadataRefreshInterval
+	// Value type: Duration
+	// Default value: 1 minute (time.Minute)
+	// Allowed filters: N/A
+	ClusterMetadataRefreshInterval
 	// BlobSizeLimitError is the per event blob size limit
 	// KeyName: limit.blobSize.error
 	// Value type: Int
@@ -206,7 +212,8 @@ const (
 	// Default value: 200*1024*1024
 	// Allowed filters: DomainName
 	HistorySizeLimitError
-	// HistorySizeLimitWarn is the per workflow execution history size limit for warning
+	// HistorySizeLimitWarn is the per workflow execution history size limit for warning,
+	// crossing it also sets SuggestContinueAsNew on decision tasks
 	// KeyName: limit.historySize.warn
 	// Value type: Int
 	// Default value: 50*1024*1024
@@ -218,7 +225,8 @@ const (
 	// Default value: 200*1024
 	// Allowed filters: DomainName
 	HistoryCountLimitError
-	// HistoryCountLimitWarn is the per workflow execution history event count limit for warning
+	// HistoryCountLimitWarn is the per workflow execution history event count limit for warning,
+	// crossing it also sets SuggestContinueAsNew on decision tasks
 	// KeyName: limit.historyCount.warn
 	// Value type: Int
 	// Default value: 50*1024
@@ -366,6 +374,12 @@ const (
 	// Default value: 0
 	// Allowed filters: DomainName
 	FrontendGlobalDomainRPS
+	// FrontendEnableDomainLimits is whether limits configured on the domain itself take precedence over the dynamic config defaults
+	// KeyName: frontend.enableDomainLimits
+	// Value type: Bool
+	// Default value: true
+	// Allowed filters: DomainName
+	FrontendEnableDomainLimits
 	// FrontendHistoryMgrNumConns is for persistence cluster.NumConns
 	// KeyName: frontend.historyMgrNumConns
 	// Value type: Int
@@ -738,6 +752,14 @@ const (
 	// Default value: 1000
 	// Allowed filters: DomainName
 	TaskProcessRPS
+	// TaskDomainTier is the tier of a domain used when prioritizing its transfer and timer tasks.
+	// Valid values are critical, standard and batch. The priorities for non-standard tiers must also
+	// be present in TaskSchedulerRoundRobinWeights if that config is overridden
+	// KeyName: history.taskDomainTier
+	// Value type: String
+	// Default value: standard
+	// Allowed filters: DomainName
+	TaskDomainTier
 	// TaskSchedulerType is the task scheduler type for priority task processor
 	// KeyName: history.taskSchedulerType
 	// Value type: Int
@@ -858,6 +880,12 @@ const (
 	// Default value: 20*time.Minute
 	// Allowed filters: DomainID
 	QueueProcessorSplitLookAheadDurationByDomainID
+	// QueueProcessorDomainTierSplitLevel is the processing queue level that domains of a tier are split into
+	// KeyName: history.queueProcessorDomainTierSplitLevel
+	// Value type: Map
+	// Default value: empty map, which disables the domain tier split policy
+	// Allowed filters: N/A
+	QueueProcessorDomainTierSplitLevel
 	// QueueProcessorPollBackoffInterval is the backoff duration when queue processor is throttled
 	// KeyName: history.queueProcessorPollBackoffInterval
 	// Value type: Duration
@@ -1283,6 +1311,18 @@ const (
 	// Default value: 10000
 	// Allowed filters: DomainName
 	MaximumSignalsPerExecution
+	// SignalRequestIDDedupWindow is how long a signal request ID is remembered for deduplicating signals
+	// KeyName: history.signalRequestIDDedupWindow
+	// Value type: Duration
+	// Default value: 24h (24*time.Hour)
+	// Allowed filters: DomainName
+	SignalRequestIDDedupWindow
+	// MaximumSignalRequestIDsPerExecution is max number of signal request IDs remembered by single execution for deduplication
+	// KeyName: history.maximumSignalRequestIDsPerExecution
+	// Value type: Int
+	// Default value: 1000
+	// Allowed filters: DomainName
+	MaximumSignalRequestIDsPerExecution
 	// ShardUpdateMinInterval is the minimal time interval which the shard info can be updated
 	// KeyName: history.shardUpdateMinInterval
 	// Value type: Duration
@@ -1379,6 +1419,18 @@ const (
 	// Default value: FALSE
 	// Allowed filters: DomainID
 	EnableDropStuckTaskByDomainID
+	// EnableHistoryTaskDLQByDomainID is whether timer/transfer task that keeps failing should be moved to the history task DLQ for a domain
+	// KeyName: history.enableHistoryTaskDLQByDomainID
+	// Value type: Bool
+	// Default value: FALSE
+	// Allowed filters: DomainID
+	EnableHistoryTaskDLQByDomainID
+	// HistoryTaskDLQMaxAttempts is the number of attempts after which a failing timer/transfer task is moved to the history task DLQ
+	// KeyName: history.historyTaskDLQMaxAttempts
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	HistoryTaskDLQMaxAttempts
 	// EnableConsistentQuery is indicates if consistent query is enabled for the cluster
 	// KeyName: history.EnableConsistentQuery
 	// Value type: Bool
@@ -1433,6 +1485,48 @@ const (
 	// Default value: 0.15
 	// Allowed filters: N/A
 	NotifyFailoverMarkerTimerJitterCoefficient
+	// EnableHealthDrivenFailover is the kill switch for automatically failing over domains away from unhealthy clusters
+	// KeyName: history.enableHealthDrivenFailover
+	// Value type: Bool
+	// Default value: FALSE
+	// Allowed filters: N/A
+	EnableHealthDrivenFailover
+	// HealthDrivenFailoverCheckInterval is how often the health of clusters is evaluated
+	// KeyName: history.healthDrivenFailoverCheckInterval
+	// Value type: Duration
+	// Default value: 30*time.Second
+	// Allowed filters: N/A
+	HealthDrivenFailoverCheckInterval
+	// HealthDrivenFailoverUnhealthyThreshold is the number of consecutive unhealthy checks before domains are failed over away from a cluster
+	// KeyName: history.healthDrivenFailoverUnhealthyThreshold
+	// Value type: Int
+	// Default value: 5
+	// Allowed filters: N/A
+	HealthDrivenFailoverUnhealthyThreshold
+	// HealthDrivenFailoverCooldown is the minimum time between two health driven failovers of the same domain
+	// KeyName: history.healthDrivenFailoverCooldown
+	// Value type: Duration
+	// Default value: 30*time.Minute
+	// Allowed filters: N/A
+	HealthDrivenFailoverCooldown
+	// HealthDrivenFailoverFetchStalenessThreshold is the time since replication tasks were last fetched from a remote cluster above which the cluster is considered unhealthy
+	// KeyName: history.healthDrivenFailoverFetchStalenessThreshold
+	// Value type: Duration
+	// Default value: 5*time.Minute
+	// Allowed filters: N/A
+	HealthDrivenFailoverFetchStalenessThreshold
+	// HealthDrivenFailoverRemoteUnavailabilityThreshold is the failure rate of recent DescribeCluster probes to a cluster above which the cluster is considered unhealthy
+	// KeyName: history.healthDrivenFailoverRemoteUnavailabilityThreshold
+	// Value type: Float64
+	// Default value: 0.5
+	// Allowed filters: N/A
+	HealthDrivenFailoverRemoteUnavailabilityThreshold
+	// HealthDrivenFailoverFrontendErrorRateThreshold is the error rate of recent GetClusterInfo calls to the frontend of a cluster above which the cluster is considered unhealthy
+	// KeyName: history.healthDrivenFailoverFrontendErrorRateThreshold
+	// Value type: Float64
+	// Default value: 0.2
+	// Allowed filters: N/A
+	HealthDrivenFailoverFrontendErrorRateThreshold
 	// EnableActivityLocalDispatchByDomain is allows worker to dispatch activity tasks through local tunnel after decisions are made. This is an performance optimization to skip activity scheduling efforts
 	// KeyName: history.enableActivityLocalDispatchByDomain
 	// Value type: Bool
@@ -1574,6 +1668,24 @@ const (
 	// Default value: 100
 	// Allowed filters: N/A
 	ReplicationTaskGenerationQPS
+	// ReplicationDLQAutoMergeEnabled is whether replication DLQ messages are merged periodically in background
+	// KeyName: history.ReplicationDLQAutoMergeEnabled
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	ReplicationDLQAutoMergeEnabled
+	// ReplicationDLQAutoMergeInterval is the interval between each replication DLQ merge attempt
+	// KeyName: history.ReplicationDLQAutoMergeInterval
+	// Value type: Duration
+	// Default value: 5 * time.Minute
+	// Allowed filters: N/A
+	ReplicationDLQAutoMergeInterval
+	// ReplicationDLQAutoMergeBatchSize is the max number of replication DLQ messages merged in one attempt
+	// KeyName: history.ReplicationDLQAutoMergeBatchSize
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	ReplicationDLQAutoMergeBatchSize
 
 	// key for worker
 
@@ -1691,6 +1803,12 @@ const (
 	// Default value: TRUE
 	// Allowed filters: N/A
 	HistoryScannerEnabled
+	// DomainDeletionEnabled is indicates if domain deletion workflows should be processed as part of worker.Scanner
+	// KeyName: worker.domainDeletionEnabled
+	// Value type: Bool
+	// Default value: TRUE
+	// Allowed filters: N/A
+	DomainDeletionEnabled
 	// ConcreteExecutionsScannerEnabled is indicates if executions scanner should be started as part of worker.Scanner
 	// KeyName: worker.executionsScannerEnabled
 	// Value type: Bool
@@ -1805,6 +1923,12 @@ const (
 	// Default value: FALSE
 	// Allowed filters: N/A
 	EnableWorkflowShadower
+	// EnableScheduler indicates if the scheduler worker that runs workflow schedules is enabled
+	// KeyName: worker.enableScheduler
+	// Value type: Bool
+	// Default value: TRUE
+	// Allowed filters: N/A
+	EnableScheduler
 	// ConcreteExecutionFixerDomainAllow is which domains are allowed to be fixed by concrete fixer workflow
 	// KeyName: worker.concreteExecutionFixerDomainAllow
 	// Value type: Bool
@@ -1965,10 +2089,12 @@ var keys = map[Key]string{
 	EnableParentClosePolicyWorker:       "system.enableParentClosePolicyWorker",
 	EnableFailoverManager:               "system.enableFailoverManager",
 	EnableWorkflowShadower:              "system.enableWorkflowShadower",
+	EnableScheduler:                     "worker.enableScheduler",
 	EnableStickyQuery:                   "system.enableStickyQuery",
 	EnableDebugMode:                     "system.enableDebugMode",
 	RequiredDomainDataKeys:              "system.requiredDomainDataKeys",
 	EnableGRPCOutbound:                  "system.enableGRPCOutbound",
+	ClusterMetadataRefreshInterval:      "system.clusterMetadataRefreshInterval",
 
 	// size limit
 	BlobSizeLimitError:     "limit.blobSize.error",
@@ -2008,6 +2134,7 @@ var keys = map[Key]string{
 	FrontendRPS:                                 "frontend.rps",
 	FrontendMaxDomainRPSPerInstance:             "frontend.domainrps",
 	FrontendGlobalDomainRPS:                     "frontend.globalDomainrps",
+	FrontendEnableDomainLimits:                  "frontend.enableDomainLimits",
 	FrontendHistoryMgrNumConns:                  "frontend.historyMgrNumConns",
 	FrontendShutdownDrainDuration:               "frontend.shutdownDrainDuration",
 	DisableListVisibilityByFilter:               "frontend.disableListVisibilityByFilter",
@@ -2073,6 +2200,7 @@ var keys = map[Key]string{
 	StandbyTaskMissingEventsResendDelay:                "history.standbyTaskMissingEventsResendDelay",
 	StandbyTaskMissingEventsDiscardDelay:               "history.standbyTaskMissingEventsDiscardDelay",
 	TaskProcessRPS:                                     "history.taskProcessRPS",
+	TaskDomainTier:                                     "history.taskDomainTier",
 	TaskSchedulerType:                                  "history.taskSchedulerType",
 	TaskSchedulerWorkerCount:                           "history.taskSchedulerWorkerCount",
 	TaskSchedulerShardWorkerCount:                      "history.taskSchedulerShardWorkerCount",
@@ -2093,6 +2221,7 @@ var keys = map[Key]string{
 	QueueProcessorEnableStuckTaskSplitByDomainID:       "history.queueProcessorEnableStuckTaskSplitByDomainID",
 	QueueProcessorStuckTaskSplitThreshold:              "history.queueProcessorStuckTaskSplitThreshold",
 	QueueProcessorSplitLookAheadDurationByDomainID:     "history.queueProcessorSplitLookAheadDurationByDomainID",
+	QueueProcessorDomainTierSplitLevel:                 "history.queueProcessorDomainTierSplitLevel",
 	QueueProcessorPollBackoffInterval:                  "history.queueProcessorPollBackoffInterval",
 	QueueProcessorPollBackoffIntervalJitterCoefficient: "history.queueProcessorPollBackoffIntervalJitterCoefficient",
 	QueueProcessorEnablePersistQueueStates:             "history.queueProcessorEnablePersistQueueStates",
@@ -2168,6 +2297,8 @@ var keys = map[Key]string{
 	HistoryMgrNumConns:                                 "history.historyMgrNumConns",
 	MaximumBufferedEventsBatch:                         "history.maximumBufferedEventsBatch",
 	MaximumSignalsPerExecution:                         "history.maximumSignalsPerExecution",
+	SignalRequestIDDedupWindow:                         "history.signalRequestIDDedupWindow",
+	MaximumSignalRequestIDsPerExecution:                "history.maximumSignalRequestIDsPerExecution",
 	ShardUpdateMinInterval:                             "history.shardUpdateMinInterval",
 	ShardSyncMinInterval:                               "history.shardSyncMinInterval",
 	DefaultEventEncoding:                               "history.defaultEventEncoding",
@@ -2202,6 +2333,9 @@ var keys = map[Key]string{
 	ReplicationTaskProcessorHostQPS:                    "history.ReplicationTaskProcessorHostQPS",
 	ReplicationTaskProcessorShardQPS:                   "history.ReplicationTaskProcessorShardQPS",
 	ReplicationTaskGenerationQPS:                       "history.ReplicationTaskGenerationQPS",
+	ReplicationDLQAutoMergeEnabled:                     "history.ReplicationDLQAutoMergeEnabled",
+	ReplicationDLQAutoMergeInterval:                    "history.ReplicationDLQAutoMergeInterval",
+	ReplicationDLQAutoMergeBatchSize:                   "history.ReplicationDLQAutoMergeBatchSize",
 	EnableConsistentQuery:                              "history.EnableConsistentQuery",
 	EnableConsistentQueryByDomain:                      "history.EnableConsistentQueryByDomain",
 	MaxBufferedQueryCount:                              "history.MaxBufferedQueryCount",
@@ -2211,7 +2345,16 @@ var keys = map[Key]string{
 	ReplicationEventsFromCurrentCluster:                "history.ReplicationEventsFromCurrentCluster",
 	NotifyFailoverMarkerInterval:                       "history.NotifyFailoverMarkerInterval",
 	NotifyFailoverMarkerTimerJitterCoefficient:         "history.NotifyFailoverMarkerTimerJitterCoefficient",
+	EnableHealthDrivenFailover:                         "history.enableHealthDrivenFailover",
+	HealthDrivenFailoverCheckInterval:                  "history.healthDrivenFailoverCheckInterval",
+	HealthDrivenFailoverUnhealthyThreshold:             "history.healthDrivenFailoverUnhealthyThreshold",
+	HealthDrivenFailoverCooldown:                       "history.healthDrivenFailoverCooldown",
+	HealthDrivenFailoverFetchStalenessThreshold:        "history.healthDrivenFailoverFetchStalenessThreshold",
+	HealthDrivenFailoverRemoteUnavailabilityThreshold:  "history.healthDrivenFailoverRemoteUnavailabilityThreshold",
+	HealthDrivenFailoverFrontendErrorRateThreshold:     "history.healthDrivenFailoverFrontendErrorRateThreshold",
 	EnableDropStuckTaskByDomainID:                      "history.DropStuckTaskByDomain",
+	EnableHistoryTaskDLQByDomainID:                     "history.enableHistoryTaskDLQByDomainID",
+	HistoryTaskDLQMaxAttempts:                          "history.historyTaskDLQMaxAttempts",
 	EnableActivityLocalDispatchByDomain:                "history.enableActivityLocalDispatchByDomain",
 	HistoryErrorInjectionRate:                          "history.errorInjectionRate",
 	HistoryEnableTaskInfoLogByDomainID:                 "history.enableTaskInfoLogByDomainID",
@@ -2236,6 +2379,7 @@ var keys = map[Key]string{
 	ScannerMaxTasksProcessedPerTasklistJob:                   "worker.scannerMaxTasksProcessedPerTasklistJob",
 	TaskListScannerEnabled:                                   "worker.taskListScannerEnabled",
 	HistoryScannerEnabled:                                    "worker.historyScannerEnabled",
+	DomainDeletionEnabled:                                    "worker.domainDeletionEnabled",
 	ConcreteExecutionsScannerEnabled:                         "worker.executionsScannerEnabled",
 	ConcreteExecutionsScannerBlobstoreFlushThreshold:         "worker.executionsScannerBlobstoreFlushThreshold",
 	ConcreteExecutionsScannerActivityBatchSize:               "worker.executionsScannerActivityBatchSize",
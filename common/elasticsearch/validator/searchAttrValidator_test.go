@@ -119,3 +119,32 @@ func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes() {
 	err = validator.ValidateSearchAttributes(attr, domain)
 	s.Equal(`BadRequestError{Message: total size 44 exceed limit}`, err.Error())
 }
+
+func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes_KeywordList() {
+	validator := NewSearchAttributesValidator(log.NewNoop(),
+		dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
+		dynamicconfig.GetIntPropertyFilteredByDomain(2),
+		dynamicconfig.GetIntPropertyFilteredByDomain(100),
+		dynamicconfig.GetIntPropertyFilteredByDomain(200))
+
+	domain := "domain"
+	attr := &types.SearchAttributes{
+		IndexedFields: map[string][]byte{
+			"CustomKeywordListField": []byte(`["customer1","customer2"]`),
+		},
+	}
+	err := validator.ValidateSearchAttributes(attr, domain)
+	s.NoError(err)
+
+	attr.IndexedFields = map[string][]byte{
+		"CustomKeywordListField": []byte(`"customer1"`),
+	}
+	err = validator.ValidateSearchAttributes(attr, domain)
+	s.NoError(err)
+
+	attr.IndexedFields = map[string][]byte{
+		"CustomKeywordListField": []byte(`[1]`),
+	}
+	err = validator.ValidateSearchAttributes(attr, domain)
+	s.Equal(`BadRequestError{Message: [1] is not a valid search attribute value for key CustomKeywordListField}`, err.Error())
+}
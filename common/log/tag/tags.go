@@ -148,6 +148,11 @@ func QueryID(queryID string) Tag {
 	return newStringTag("query-id", queryID)
 }
 
+// UpdateID returns tag for UpdateID
+func UpdateID(updateID string) Tag {
+	return newStringTag("update-id", updateID)
+}
+
 // BlobSizeViolationOperation returns tag for BlobSizeViolationOperation
 func BlobSizeViolationOperation(operation string) Tag {
 	return newStringTag("blob-size-violation-operation", operation)
@@ -649,6 +654,11 @@ func SourceCluster(sourceCluster string) Tag {
 	return newStringTag("xdc-source-cluster", sourceCluster)
 }
 
+// ReplicationDLQFailureClass returns tag for the failure class of a replication DLQ message
+func ReplicationDLQFailureClass(failureClass string) Tag {
+	return newStringTag("xdc-dlq-failure-class", failureClass)
+}
+
 // PrevActiveCluster returns tag for PrevActiveCluster
 func PrevActiveCluster(prevActiveCluster string) Tag {
 	return newStringTag("xdc-prev-active-cluster", prevActiveCluster)
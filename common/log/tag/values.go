@@ -36,6 +36,11 @@ var (
 	WorkflowActionWorkflowSignaled               = workflowAction("add-workflow-signaled-event")
 	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
 	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
+	WorkflowActionUpsertWorkflowMemo             = workflowAction("add-workflow-upsert-memo-event")
+	WorkflowActionWorkflowPaused                 = workflowAction("add-workflow-paused-event")
+	WorkflowActionWorkflowUnpaused               = workflowAction("add-workflow-unpaused-event")
+	WorkflowActionWorkflowUpdateAccepted         = workflowAction("add-workflow-update-accepted-event")
+	WorkflowActionWorkflowUpdateCompleted        = workflowAction("add-workflow-update-completed-event")
 
 	// decision
 	WorkflowActionDecisionTaskScheduled = workflowAction("add-decisiontask-scheduled-event")
@@ -58,6 +63,9 @@ var (
 	WorkflowActionActivityTaskCancelRequested = workflowAction("add-activitytask-cancel-requested-event")
 	WorkflowActionActivityTaskCancelFailed    = workflowAction("add-activitytask-cancel-failed-event")
 	WorkflowActionActivityTaskRetry           = workflowAction("add-activitytask-retry-event")
+	WorkflowActionActivityTaskPaused          = workflowAction("activitytask-paused")
+	WorkflowActionActivityTaskUnpaused        = workflowAction("activitytask-unpaused")
+	WorkflowActionActivityTaskReset           = workflowAction("activitytask-reset")
 
 	// timer
 	WorkflowActionTimerStarted      = workflowAction("add-timer-started-event")
@@ -126,8 +134,10 @@ var (
 	ComponentServiceResolver            = component("service-resolver")
 	ComponentFailoverCoordinator        = component("failover-coordinator")
 	ComponentFailoverMarkerNotifier     = component("failover-marker-notifier")
+	ComponentHealthDrivenFailover       = component("health-driven-failover")
 	ComponentCrossClusterQueueProcessor = component("cross-cluster-queue-processor")
 	ComponentCrossClusterTaskProcessor  = component("cross-cluster-task-processor")
+	ComponentScheduler                  = component("scheduler")
 )
 
 // Pre-defined values for TagSysLifecycle
@@ -205,6 +215,10 @@ var (
 	StoreOperationDeleteReplicationTaskFromDLQ      = storeOperation("delete-replication-task-from-dlq")
 	StoreOperationRangeDeleteReplicationTaskFromDLQ = storeOperation("range-delete-replication-task-from-dlq")
 	StoreOperationCreateFailoverMarkerTasks         = storeOperation("createFailoverMarkerTasks")
+	StoreOperationPutHistoryTaskToDLQ               = storeOperation("put-history-task-to-dlq")
+	StoreOperationGetHistoryTasksFromDLQ            = storeOperation("get-history-tasks-from-dlq")
+	StoreOperationDeleteHistoryTaskFromDLQ          = storeOperation("delete-history-task-from-dlq")
+	StoreOperationRangeDeleteHistoryTaskFromDLQ     = storeOperation("range-delete-history-task-from-dlq")
 	StoreOperationGetTimerIndexTasks                = storeOperation("get-timer-index-tasks")
 	StoreOperationCompleteTimerTask                 = storeOperation("complete-timer-task")
 	StoreOperationRangeCompleteTimerTask            = storeOperation("range-complete-timer-task")
@@ -224,6 +238,7 @@ var (
 	StoreOperationUpdateDomain       = storeOperation("update-domain")
 	StoreOperationDeleteDomain       = storeOperation("delete-domain")
 	StoreOperationDeleteDomainByName = storeOperation("delete-domain-by-name")
+	StoreOperationRenameDomain       = storeOperation("rename-domain")
 	StoreOperationListDomains        = storeOperation("list-domains")
 	StoreOperationGetMetadata        = storeOperation("get-metadata")
 
@@ -264,6 +279,12 @@ var (
 	StoreOperationGetDLQAckLevels            = storeOperation("get-dlq-ack-levels")
 	StoreOperationGetDLQSize                 = storeOperation("get-dlq-size")
 	StoreOperationDeleteMessageFromDLQ       = storeOperation("delete-message-from-dlq")
+
+	StoreOperationFetchConfig  = storeOperation("fetch-config")
+	StoreOperationUpdateConfig = storeOperation("update-config")
+
+	StoreOperationAppendDomainAuditLog = storeOperation("append-domain-audit-log")
+	StoreOperationReadDomainAuditLogs  = storeOperation("read-domain-audit-logs")
 )
 
 // Pre-defined values for TagSysClientOperation
@@ -275,6 +296,11 @@ var (
 	AdminClientOperationCloseShard                       = clientOperation("admin-close-shard")
 	AdminClientOperationResetQueue                       = clientOperation("admin-reset-queue")
 	AdminClientOperationDescribeQueue                    = clientOperation("admin-describe-queue")
+	AdminClientOperationReadHistoryTaskDLQ               = clientOperation("admin-read-history-task-dlq")
+	AdminClientOperationDescribeReplicationStatus        = clientOperation("admin-describe-replication-status")
+	AdminClientOperationMigrateWorkflowExecution         = clientOperation("admin-migrate-workflow-execution")
+	AdminClientOperationPurgeHistoryTaskDLQ              = clientOperation("admin-purge-history-task-dlq")
+	AdminClientOperationRetryHistoryTaskDLQ              = clientOperation("admin-retry-history-task-dlq")
 	AdminClientOperationDescribeWorkflowExecution        = clientOperation("admin-describe-wf-execution")
 	AdminClientOperationGetWorkflowExecutionRawHistoryV2 = clientOperation("admin-get-wf-execution-raw-history-v2")
 	AdminClientOperationDescribeCluster                  = clientOperation("admin-describe-cluster")
@@ -288,6 +314,15 @@ var (
 	AdminClientOperationRefreshWorkflowTasks             = clientOperation("admin-refresh-wf-tasks")
 	AdminClientOperationResendReplicationTasks           = clientOperation("admin-resend-replication-tasks")
 	AdminClientOperationGetCrossClusterTasks             = clientOperation("admin-get-cross-cluster-tasks")
+	AdminClientOperationPauseTaskList                    = clientOperation("admin-pause-task-list")
+	AdminClientOperationResumeTaskList                   = clientOperation("admin-resume-task-list")
+	AdminClientOperationAddRemoteCluster                 = clientOperation("admin-add-remote-cluster")
+	AdminClientOperationRemoveRemoteCluster              = clientOperation("admin-remove-remote-cluster")
+	AdminClientOperationCloneDomain                      = clientOperation("admin-clone-domain")
+	AdminClientOperationRenameDomain                     = clientOperation("admin-rename-domain")
+	AdminClientOperationDeleteDomain                     = clientOperation("admin-delete-domain")
+	AdminClientOperationListDomainChanges                = clientOperation("admin-list-domain-changes")
+	AdminClientOperationMoveTaskListBacklog              = clientOperation("admin-move-task-list-backlog")
 
 	FrontendClientOperationDeprecateDomain                  = clientOperation("frontend-deprecate-domain")
 	FrontendClientOperationDescribeDomain                   = clientOperation("frontend-describe-domain")
@@ -309,8 +344,23 @@ var (
 	FrontendClientOperationRecordActivityTaskHeartbeatByID  = clientOperation("frontend-record-activity-heartbeat-by-id")
 	FrontendClientOperationRegisterDomain                   = clientOperation("frontend-register-domain")
 	FrontendClientOperationRequestCancelWorkflowExecution   = clientOperation("frontend-request-cancel-wf-execution")
+	FrontendClientOperationPauseWorkflowExecution           = clientOperation("frontend-pause-wf-execution")
+	FrontendClientOperationUnpauseWorkflowExecution         = clientOperation("frontend-unpause-wf-execution")
+	FrontendClientOperationDeleteWorkflowExecution          = clientOperation("frontend-delete-wf-execution")
+	FrontendClientOperationPauseActivity                    = clientOperation("frontend-pause-activity")
+	FrontendClientOperationUnpauseActivity                  = clientOperation("frontend-unpause-activity")
+	FrontendClientOperationResetActivity                    = clientOperation("frontend-reset-activity")
+	FrontendClientOperationUpdateWorkflowExecution          = clientOperation("frontend-update-wf-execution")
+	FrontendClientOperationCreateSchedule                   = clientOperation("frontend-create-schedule")
+	FrontendClientOperationDescribeSchedule                 = clientOperation("frontend-describe-schedule")
+	FrontendClientOperationUpdateSchedule                   = clientOperation("frontend-update-schedule")
+	FrontendClientOperationPauseSchedule                    = clientOperation("frontend-pause-schedule")
+	FrontendClientOperationUnpauseSchedule                  = clientOperation("frontend-unpause-schedule")
+	FrontendClientOperationBackfillSchedule                 = clientOperation("frontend-backfill-schedule")
+	FrontendClientOperationListSchedules                    = clientOperation("frontend-list-schedules")
 	FrontendClientOperationResetStickyTaskList              = clientOperation("frontend-reset-sticky-task-list")
 	FrontendClientOperationResetWorkflowExecution           = clientOperation("frontend-reset-wf-execution")
+	FrontendClientOperationRestartWorkflowExecution         = clientOperation("frontend-restart-wf-execution")
 	FrontendClientOperationRespondActivityTaskCanceled      = clientOperation("frontend-respond-activity-task-canceled")
 	FrontendClientOperationRespondActivityTaskCanceledByID  = clientOperation("frontend-respond-activity-task-canceled-by-id")
 	FrontendClientOperationRespondActivityTaskCompleted     = clientOperation("frontend-respond-activity-task-completed")
@@ -334,6 +384,10 @@ var (
 	HistoryClientOperationCloseShard                        = clientOperation("history-close-shard")
 	HistoryClientOperationResetQueue                        = clientOperation("history-reset-queue")
 	HistoryClientOperationDescribeQueue                     = clientOperation("history-describe-queue")
+	HistoryClientOperationReadHistoryTaskDLQ                = clientOperation("history-read-history-task-dlq")
+	HistoryClientOperationDescribeReplicationStatus         = clientOperation("history-describe-replication-status")
+	HistoryClientOperationPurgeHistoryTaskDLQ               = clientOperation("history-purge-history-task-dlq")
+	HistoryClientOperationRetryHistoryTaskDLQ               = clientOperation("history-retry-history-task-dlq")
 	HistoryClientOperationRemoveTask                        = clientOperation("history-remove-task")
 	HistoryClientOperationDescribeMutableState              = clientOperation("history-describe-mutable-state")
 	HistoryClientOperationGetMutableState                   = clientOperation("history-get-mutable-state")
@@ -349,6 +403,14 @@ var (
 	HistoryClientOperationRecordActivityTaskCanceled        = clientOperation("history-record-activity-task-canceled")
 	HistoryClientOperationRecordActivityTaskHeartbeat       = clientOperation("history-record-activity-task-heartbeat")
 	HistoryClientOperationRequestCancelWorkflowExecution    = clientOperation("history-request-cancel-wf-execution")
+	HistoryClientOperationPauseWorkflowExecution            = clientOperation("history-pause-wf-execution")
+	HistoryClientOperationUnpauseWorkflowExecution          = clientOperation("history-unpause-wf-execution")
+	HistoryClientOperationDeleteWorkflowExecution           = clientOperation("history-delete-wf-execution")
+	HistoryClientOperationPauseActivity                     = clientOperation("history-pause-activity")
+	HistoryClientOperationUnpauseActivity                   = clientOperation("history-unpause-activity")
+	HistoryClientOperationResetActivity                     = clientOperation("history-reset-activity")
+	HistoryClientOperationUpdateWorkflowExecution           = clientOperation("history-update-wf-execution")
+	HistoryClientOperationMigrateWorkflowExecution          = clientOperation("history-migrate-wf-execution")
 	HistoryClientOperationSignalWorkflowExecution           = clientOperation("history-signal-wf-execution")
 	HistoryClientOperationSignalWithStartWorkflowExecution  = clientOperation("history-signal-with-start-wf-execution")
 	HistoryClientOperationRemoveSignalMutableState          = clientOperation("history-remove-signal-mutable-state")
@@ -381,4 +443,7 @@ var (
 	MatchingClientOperationDescribeTaskList       = clientOperation("matching-describe-task-list")
 	MatchingClientOperationListTaskListPartitions = clientOperation("matching-list-task-list-partitions")
 	MatchingClientOperationGetTaskListsByDomain   = clientOperation("get-task-list-for-domain")
+	MatchingClientOperationPauseTaskList          = clientOperation("matching-pause-task-list")
+	MatchingClientOperationResumeTaskList         = clientOperation("matching-resume-task-list")
+	MatchingClientOperationMoveTaskListBacklog    = clientOperation("matching-move-task-list-backlog")
 )
@@ -190,6 +190,14 @@ const (
 	PersistenceRangeDeleteReplicationTaskFromDLQScope
 	// PersistenceCreateFailoverMarkerTasksScope tracks CreateFailoverMarkerTasks calls made by service to persistence layer
 	PersistenceCreateFailoverMarkerTasksScope
+	// PersistencePutHistoryTaskToDLQScope tracks PutHistoryTaskToDLQ calls made by service to persistence layer
+	PersistencePutHistoryTaskToDLQScope
+	// PersistenceGetHistoryTasksFromDLQScope tracks GetHistoryTasksFromDLQ calls made by service to persistence layer
+	PersistenceGetHistoryTasksFromDLQScope
+	// PersistenceDeleteHistoryTaskFromDLQScope tracks DeleteHistoryTaskFromDLQ calls made by service to persistence layer
+	PersistenceDeleteHistoryTaskFromDLQScope
+	// PersistenceRangeDeleteHistoryTaskFromDLQScope tracks RangeDeleteHistoryTaskFromDLQ calls made by service to persistence layer
+	PersistenceRangeDeleteHistoryTaskFromDLQScope
 	// PersistenceGetTimerIndexTasksScope tracks GetTimerIndexTasks calls made by service to persistence layer
 	PersistenceGetTimerIndexTasksScope
 	// PersistenceCompleteTimerTaskScope tracks CompleteTimerTasks calls made by service to persistence layer
@@ -230,6 +238,8 @@ const (
 	PersistenceDeleteDomainScope
 	// PersistenceDeleteDomainByNameScope tracks DeleteDomainByName calls made by service to persistence layer
 	PersistenceDeleteDomainByNameScope
+	// PersistenceRenameDomainScope tracks RenameDomain calls made by service to persistence layer
+	PersistenceRenameDomainScope
 	// PersistenceListDomainScope tracks DeleteDomainByName calls made by service to persistence layer
 	PersistenceListDomainScope
 	// PersistenceGetMetadataScope tracks DeleteDomainByName calls made by service to persistence layer
@@ -288,6 +298,14 @@ const (
 	PersistenceGetDLQAckLevelScope
 	// PersistenceGetDLQSizeScope tracks GetDLQSize calls made by service to persistence layer
 	PersistenceGetDLQSizeScope
+	// PersistenceFetchConfigScope tracks FetchConfig calls made by service to persistence layer
+	PersistenceFetchConfigScope
+	// PersistenceUpdateConfigScope tracks UpdateConfig calls made by service to persistence layer
+	PersistenceUpdateConfigScope
+	// PersistenceAppendDomainAuditLogScope tracks AppendDomainAuditLog calls made by service to persistence layer
+	PersistenceAppendDomainAuditLogScope
+	// PersistenceReadDomainAuditLogsScope tracks ReadDomainAuditLogs calls made by service to persistence layer
+	PersistenceReadDomainAuditLogsScope
 	// HistoryClientStartWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientStartWorkflowExecutionScope
 	// HistoryClientDescribeHistoryHostScope tracks RPC calls to history service
@@ -300,6 +318,14 @@ const (
 	HistoryClientResetQueueScope
 	// HistoryClientDescribeQueueScope tracks RPC calls to history service
 	HistoryClientDescribeQueueScope
+	// HistoryClientReadHistoryTaskDLQScope tracks RPC calls to history service
+	HistoryClientReadHistoryTaskDLQScope
+	// HistoryClientDescribeReplicationStatusScope tracks RPC calls to history service
+	HistoryClientDescribeReplicationStatusScope
+	// HistoryClientPurgeHistoryTaskDLQScope tracks RPC calls to history service
+	HistoryClientPurgeHistoryTaskDLQScope
+	// HistoryClientRetryHistoryTaskDLQScope tracks RPC calls to history service
+	HistoryClientRetryHistoryTaskDLQScope
 	// HistoryClientRecordActivityTaskHeartbeatScope tracks RPC calls to history service
 	HistoryClientRecordActivityTaskHeartbeatScope
 	// HistoryClientRespondDecisionTaskCompletedScope tracks RPC calls to history service
@@ -328,6 +354,22 @@ const (
 	HistoryClientRecordActivityTaskStartedScope
 	// HistoryClientRequestCancelWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientRequestCancelWorkflowExecutionScope
+	// HistoryClientPauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientPauseWorkflowExecutionScope
+	// HistoryClientUnpauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUnpauseWorkflowExecutionScope
+	// HistoryClientDeleteWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientDeleteWorkflowExecutionScope
+	// HistoryClientPauseActivityScope tracks RPC calls to history service
+	HistoryClientPauseActivityScope
+	// HistoryClientUnpauseActivityScope tracks RPC calls to history service
+	HistoryClientUnpauseActivityScope
+	// HistoryClientResetActivityScope tracks RPC calls to history service
+	HistoryClientResetActivityScope
+	// HistoryClientUpdateWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUpdateWorkflowExecutionScope
+	// HistoryClientMigrateWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientMigrateWorkflowExecutionScope
 	// HistoryClientSignalWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientSignalWorkflowExecutionScope
 	// HistoryClientSignalWithStartWorkflowExecutionScope tracks RPC calls to history service
@@ -390,6 +432,12 @@ const (
 	MatchingClientListTaskListPartitionsScope
 	// MatchingClientGetTaskListsByDomainScope tracks RPC calls to matching service
 	MatchingClientGetTaskListsByDomainScope
+	// MatchingClientPauseTaskListScope tracks RPC calls to matching service
+	MatchingClientPauseTaskListScope
+	// MatchingClientResumeTaskListScope tracks RPC calls to matching service
+	MatchingClientResumeTaskListScope
+	// MatchingClientMoveTaskListBacklogScope tracks RPC calls to matching service
+	MatchingClientMoveTaskListBacklogScope
 	// FrontendClientDeprecateDomainScope tracks RPC calls to frontend service
 	FrontendClientDeprecateDomainScope
 	// FrontendClientDescribeDomainScope tracks RPC calls to frontend service
@@ -426,10 +474,40 @@ const (
 	FrontendClientRegisterDomainScope
 	// FrontendClientRequestCancelWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientRequestCancelWorkflowExecutionScope
+	// FrontendClientPauseWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientPauseWorkflowExecutionScope
+	// FrontendClientUnpauseWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientUnpauseWorkflowExecutionScope
+	// FrontendClientDeleteWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientDeleteWorkflowExecutionScope
+	// FrontendClientPauseActivityScope tracks RPC calls to frontend service
+	FrontendClientPauseActivityScope
+	// FrontendClientUnpauseActivityScope tracks RPC calls to frontend service
+	FrontendClientUnpauseActivityScope
+	// FrontendClientResetActivityScope tracks RPC calls to frontend service
+	FrontendClientResetActivityScope
+	// FrontendClientUpdateWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientUpdateWorkflowExecutionScope
+	// FrontendClientCreateScheduleScope tracks RPC calls to frontend service
+	FrontendClientCreateScheduleScope
+	// FrontendClientDescribeScheduleScope tracks RPC calls to frontend service
+	FrontendClientDescribeScheduleScope
+	// FrontendClientUpdateScheduleScope tracks RPC calls to frontend service
+	FrontendClientUpdateScheduleScope
+	// FrontendClientPauseScheduleScope tracks RPC calls to frontend service
+	FrontendClientPauseScheduleScope
+	// FrontendClientUnpauseScheduleScope tracks RPC calls to frontend service
+	FrontendClientUnpauseScheduleScope
+	// FrontendClientBackfillScheduleScope tracks RPC calls to frontend service
+	FrontendClientBackfillScheduleScope
+	// FrontendClientListSchedulesScope tracks RPC calls to frontend service
+	FrontendClientListSchedulesScope
 	// FrontendClientResetStickyTaskListScope tracks RPC calls to frontend service
 	FrontendClientResetStickyTaskListScope
 	// FrontendClientResetWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientResetWorkflowExecutionScope
+	// FrontendClientRestartWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientRestartWorkflowExecutionScope
 	// FrontendClientRespondActivityTaskCanceledScope tracks RPC calls to frontend service
 	FrontendClientRespondActivityTaskCanceledScope
 	// FrontendClientRespondActivityTaskCanceledByIDScope tracks RPC calls to frontend service
@@ -490,6 +568,16 @@ const (
 	AdminClientResetQueueScope
 	// AdminClientDescribeQueueScope tracks RPC calls to admin service
 	AdminClientDescribeQueueScope
+	// AdminClientReadHistoryTaskDLQScope tracks RPC calls to admin service
+	AdminClientReadHistoryTaskDLQScope
+	// AdminClientDescribeReplicationStatusScope tracks RPC calls to admin service
+	AdminClientDescribeReplicationStatusScope
+	// AdminClientMigrateWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientMigrateWorkflowExecutionScope
+	// AdminClientPurgeHistoryTaskDLQScope tracks RPC calls to admin service
+	AdminClientPurgeHistoryTaskDLQScope
+	// AdminClientRetryHistoryTaskDLQScope tracks RPC calls to admin service
+	AdminClientRetryHistoryTaskDLQScope
 	// AdminClientDescribeHistoryHostScope tracks RPC calls to admin service
 	AdminClientDescribeHistoryHostScope
 	// AdminClientDescribeShardDistributionScope tracks RPC calls to admin service
@@ -512,6 +600,24 @@ const (
 	AdminClientResendReplicationTasksScope
 	// AdminClientGetCrossClusterTasksScope tracks RPC calls to Admin service
 	AdminClientGetCrossClusterTasksScope
+	// AdminClientPauseTaskListScope tracks RPC calls to admin service
+	AdminClientPauseTaskListScope
+	// AdminClientResumeTaskListScope tracks RPC calls to admin service
+	AdminClientResumeTaskListScope
+	// AdminClientAddRemoteClusterScope tracks RPC calls to admin service
+	AdminClientAddRemoteClusterScope
+	// AdminClientRemoveRemoteClusterScope tracks RPC calls to admin service
+	AdminClientRemoveRemoteClusterScope
+	// AdminClientCloneDomainScope tracks RPC calls to admin service
+	AdminClientCloneDomainScope
+	// AdminClientRenameDomainScope tracks RPC calls to admin service
+	AdminClientRenameDomainScope
+	// AdminClientDeleteDomainScope tracks RPC calls to admin service
+	AdminClientDeleteDomainScope
+	// AdminClientListDomainChangesScope tracks RPC calls to admin service
+	AdminClientListDomainChangesScope
+	// AdminClientMoveTaskListBacklogScope tracks RPC calls to admin service
+	AdminClientMoveTaskListBacklogScope
 	// DCRedirectionDeprecateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateDomainScope
 	// DCRedirectionDescribeDomainScope tracks RPC calls for dc redirection
@@ -556,10 +662,40 @@ const (
 	DCRedirectionRegisterDomainScope
 	// DCRedirectionRequestCancelWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionRequestCancelWorkflowExecutionScope
+	// DCRedirectionPauseWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionPauseWorkflowExecutionScope
+	// DCRedirectionUnpauseWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionUnpauseWorkflowExecutionScope
+	// DCRedirectionDeleteWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionDeleteWorkflowExecutionScope
+	// DCRedirectionPauseActivityScope tracks RPC calls for dc redirection
+	DCRedirectionPauseActivityScope
+	// DCRedirectionUnpauseActivityScope tracks RPC calls for dc redirection
+	DCRedirectionUnpauseActivityScope
+	// DCRedirectionResetActivityScope tracks RPC calls for dc redirection
+	DCRedirectionResetActivityScope
+	// DCRedirectionUpdateWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateWorkflowExecutionScope
+	// DCRedirectionCreateScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionCreateScheduleScope
+	// DCRedirectionDescribeScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionDescribeScheduleScope
+	// DCRedirectionUpdateScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateScheduleScope
+	// DCRedirectionPauseScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionPauseScheduleScope
+	// DCRedirectionUnpauseScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionUnpauseScheduleScope
+	// DCRedirectionBackfillScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionBackfillScheduleScope
+	// DCRedirectionListSchedulesScope tracks RPC calls for dc redirection
+	DCRedirectionListSchedulesScope
 	// DCRedirectionResetStickyTaskListScope tracks RPC calls for dc redirection
 	DCRedirectionResetStickyTaskListScope
 	// DCRedirectionResetWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionResetWorkflowExecutionScope
+	// DCRedirectionRestartWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionRestartWorkflowExecutionScope
 	// DCRedirectionRespondActivityTaskCanceledScope tracks RPC calls for dc redirection
 	DCRedirectionRespondActivityTaskCanceledScope
 	// DCRedirectionRespondActivityTaskCanceledByIDScope tracks RPC calls for dc redirection
@@ -602,6 +738,8 @@ const (
 
 	// DomainCacheScope tracks domain cache callbacks
 	DomainCacheScope
+	// DomainAuditLogScope tracks the recording of domain changes into the domain audit log
+	DomainAuditLogScope
 	// HistoryRereplicationByTransferTaskScope tracks history replication calls made by transfer task
 	HistoryRereplicationByTransferTaskScope
 	// HistoryRereplicationByTimerTaskScope tracks history replication calls made by timer task
@@ -730,6 +868,16 @@ const (
 	AdminResetQueueScope
 	// AdminDescribeQueueScope is the metrics scope for admin.AdminDescribeQueueScope
 	AdminDescribeQueueScope
+	// AdminReadHistoryTaskDLQScope is the metric scope for admin.ReadHistoryTaskDLQ
+	AdminReadHistoryTaskDLQScope
+	// AdminDescribeReplicationStatusScope is the metric scope for admin.DescribeReplicationStatus
+	AdminDescribeReplicationStatusScope
+	// AdminMigrateWorkflowExecutionScope is the metric scope for admin.MigrateWorkflowExecution
+	AdminMigrateWorkflowExecutionScope
+	// AdminPurgeHistoryTaskDLQScope is the metric scope for admin.PurgeHistoryTaskDLQ
+	AdminPurgeHistoryTaskDLQScope
+	// AdminRetryHistoryTaskDLQScope is the metric scope for admin.RetryHistoryTaskDLQ
+	AdminRetryHistoryTaskDLQScope
 	// AdminReadDLQMessagesScope is the metric scope for admin.AdminReadDLQMessagesScope
 	AdminReadDLQMessagesScope
 	// AdminPurgeDLQMessagesScope is the metric scope for admin.AdminPurgeDLQMessagesScope
@@ -740,6 +888,24 @@ const (
 	AdminDescribeShardDistributionScope
 	// AdminGetCrossClusterTasksScope is the metric scope for admin.GetCrossClusterTasks
 	AdminGetCrossClusterTasksScope
+	// AdminPauseTaskListScope is the metric scope for admin.PauseTaskList
+	AdminPauseTaskListScope
+	// AdminResumeTaskListScope is the metric scope for admin.ResumeTaskList
+	AdminResumeTaskListScope
+	// AdminAddRemoteClusterScope is the metric scope for admin.AddRemoteCluster
+	AdminAddRemoteClusterScope
+	// AdminRemoveRemoteClusterScope is the metric scope for admin.RemoveRemoteCluster
+	AdminRemoveRemoteClusterScope
+	// AdminCloneDomainScope is the metric scope for admin.CloneDomain
+	AdminCloneDomainScope
+	// AdminRenameDomainScope is the metric scope for admin.RenameDomain
+	AdminRenameDomainScope
+	// AdminDeleteDomainScope is the metric scope for admin.DeleteDomain
+	AdminDeleteDomainScope
+	// AdminListDomainChangesScope is the metric scope for admin.ListDomainChanges
+	AdminListDomainChangesScope
+	// AdminMoveTaskListBacklogScope is the metric scope for admin.MoveTaskListBacklog
+	AdminMoveTaskListBacklogScope
 
 	NumAdminScopes
 )
@@ -788,6 +954,34 @@ const (
 	FrontendTerminateWorkflowExecutionScope
 	// FrontendRequestCancelWorkflowExecutionScope is the metric scope for frontend.RequestCancelWorkflowExecution
 	FrontendRequestCancelWorkflowExecutionScope
+	// FrontendPauseWorkflowExecutionScope is the metric scope for frontend.PauseWorkflowExecution
+	FrontendPauseWorkflowExecutionScope
+	// FrontendUnpauseWorkflowExecutionScope is the metric scope for frontend.UnpauseWorkflowExecution
+	FrontendUnpauseWorkflowExecutionScope
+	// FrontendDeleteWorkflowExecutionScope is the metric scope for frontend.DeleteWorkflowExecution
+	FrontendDeleteWorkflowExecutionScope
+	// FrontendPauseActivityScope is the metric scope for frontend.PauseActivity
+	FrontendPauseActivityScope
+	// FrontendUnpauseActivityScope is the metric scope for frontend.UnpauseActivity
+	FrontendUnpauseActivityScope
+	// FrontendResetActivityScope is the metric scope for frontend.ResetActivity
+	FrontendResetActivityScope
+	// FrontendUpdateWorkflowExecutionScope is the metric scope for frontend.UpdateWorkflowExecution
+	FrontendUpdateWorkflowExecutionScope
+	// FrontendCreateScheduleScope is the metric scope for frontend.CreateSchedule
+	FrontendCreateScheduleScope
+	// FrontendDescribeScheduleScope is the metric scope for frontend.DescribeSchedule
+	FrontendDescribeScheduleScope
+	// FrontendUpdateScheduleScope is the metric scope for frontend.UpdateSchedule
+	FrontendUpdateScheduleScope
+	// FrontendPauseScheduleScope is the metric scope for frontend.PauseSchedule
+	FrontendPauseScheduleScope
+	// FrontendUnpauseScheduleScope is the metric scope for frontend.UnpauseSchedule
+	FrontendUnpauseScheduleScope
+	// FrontendBackfillScheduleScope is the metric scope for frontend.BackfillSchedule
+	FrontendBackfillScheduleScope
+	// FrontendListSchedulesScope is the metric scope for frontend.ListSchedules
+	FrontendListSchedulesScope
 	// FrontendListArchivedWorkflowExecutionsScope is the metric scope for frontend.ListArchivedWorkflowExecutions
 	FrontendListArchivedWorkflowExecutionsScope
 	// FrontendListOpenWorkflowExecutionsScope is the metric scope for frontend.ListOpenWorkflowExecutions
@@ -824,6 +1018,8 @@ const (
 	FrontendListDomainsScope
 	// FrontendResetWorkflowExecutionScope is the metric scope for frontend.ResetWorkflowExecution
 	FrontendResetWorkflowExecutionScope
+	// FrontendRestartWorkflowExecutionScope is the metric scope for frontend.RestartWorkflowExecution
+	FrontendRestartWorkflowExecutionScope
 	// FrontendGetSearchAttributesScope is the metric scope for frontend.GetSearchAttributes
 	FrontendGetSearchAttributesScope
 
@@ -850,6 +1046,14 @@ const (
 	HistoryResetQueueScope
 	// HistoryDescribeQueueScope tracks DescribeQueue API calls received by service
 	HistoryDescribeQueueScope
+	// HistoryReadHistoryTaskDLQScope tracks ReadHistoryTaskDLQ API calls received by service
+	HistoryReadHistoryTaskDLQScope
+	// HistoryDescribeReplicationStatusScope tracks DescribeReplicationStatus API calls received by service
+	HistoryDescribeReplicationStatusScope
+	// HistoryPurgeHistoryTaskDLQScope tracks PurgeHistoryTaskDLQ API calls received by service
+	HistoryPurgeHistoryTaskDLQScope
+	// HistoryRetryHistoryTaskDLQScope tracks RetryHistoryTaskDLQ API calls received by service
+	HistoryRetryHistoryTaskDLQScope
 	// HistoryDescribeMutabelStateScope tracks DescribeMutableState API calls received by service
 	HistoryDescribeMutabelStateScope
 	// HistoryGetMutableStateScope tracks GetMutableState API calls received by service
@@ -878,6 +1082,22 @@ const (
 	HistoryRecordChildExecutionCompletedScope
 	// HistoryRequestCancelWorkflowExecutionScope tracks RequestCancelWorkflowExecution API calls received by service
 	HistoryRequestCancelWorkflowExecutionScope
+	// HistoryPauseWorkflowExecutionScope tracks PauseWorkflowExecution API calls received by service
+	HistoryPauseWorkflowExecutionScope
+	// HistoryUnpauseWorkflowExecutionScope tracks UnpauseWorkflowExecution API calls received by service
+	HistoryUnpauseWorkflowExecutionScope
+	// HistoryDeleteWorkflowExecutionScope tracks DeleteWorkflowExecution API calls received by service
+	HistoryDeleteWorkflowExecutionScope
+	// HistoryPauseActivityScope tracks PauseActivity API calls received by service
+	HistoryPauseActivityScope
+	// HistoryUnpauseActivityScope tracks UnpauseActivity API calls received by service
+	HistoryUnpauseActivityScope
+	// HistoryResetActivityScope tracks ResetActivity API calls received by service
+	HistoryResetActivityScope
+	// HistoryUpdateWorkflowExecutionScope tracks UpdateWorkflowExecution API calls received by service
+	HistoryUpdateWorkflowExecutionScope
+	// HistoryMigrateWorkflowExecutionScope tracks MigrateWorkflowExecution API calls received by service
+	HistoryMigrateWorkflowExecutionScope
 	// HistoryReplicateEventsScope tracks ReplicateEvents API calls received by service
 	HistoryReplicateEventsScope
 	// HistoryReplicateRawEventsScope tracks ReplicateEvents API calls received by service
@@ -1054,6 +1274,8 @@ const (
 	ReplicationDLQStatsScope
 	// FailoverMarkerScope is scope used by all metrics emitted related to failover marker
 	FailoverMarkerScope
+	// HealthDrivenFailoverScope is scope used by all metrics emitted by the health driven failover controller
+	HealthDrivenFailoverScope
 	// HistoryReplicationV2TaskScope is the scope used by history task replication processing
 	HistoryReplicationV2TaskScope
 	// SyncActivityTaskScope is the scope used by sync activity information processing
@@ -1086,6 +1308,12 @@ const (
 	MatchingListTaskListPartitionsScope
 	// MatchingGetTaskListsByDomainScope tracks GetTaskListsByDomain API calls received by service
 	MatchingGetTaskListsByDomainScope
+	// MatchingPauseTaskListScope tracks PauseTaskList API calls received by service
+	MatchingPauseTaskListScope
+	// MatchingResumeTaskListScope tracks ResumeTaskList API calls received by service
+	MatchingResumeTaskListScope
+	// MatchingMoveTaskListBacklogScope tracks MoveTaskListBacklog API calls received by service
+	MatchingMoveTaskListBacklogScope
 
 	NumMatchingScopes
 )
@@ -1163,6 +1391,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceDeleteReplicationTaskFromDLQScope:             {operation: "DeleteReplicationTaskFromDLQ"},
 		PersistenceRangeDeleteReplicationTaskFromDLQScope:        {operation: "RangeDeleteReplicationTaskFromDLQ"},
 		PersistenceCreateFailoverMarkerTasksScope:                {operation: "CreateFailoverMarkerTasks"},
+		PersistencePutHistoryTaskToDLQScope:                      {operation: "PutHistoryTaskToDLQ"},
+		PersistenceGetHistoryTasksFromDLQScope:                   {operation: "GetHistoryTasksFromDLQ"},
+		PersistenceDeleteHistoryTaskFromDLQScope:                 {operation: "DeleteHistoryTaskFromDLQ"},
+		PersistenceRangeDeleteHistoryTaskFromDLQScope:            {operation: "RangeDeleteHistoryTaskFromDLQ"},
 		PersistenceGetTimerIndexTasksScope:                       {operation: "GetTimerIndexTasks"},
 		PersistenceCompleteTimerTaskScope:                        {operation: "CompleteTimerTask"},
 		PersistenceRangeCompleteTimerTaskScope:                   {operation: "RangeCompleteTimerTask"},
@@ -1183,6 +1415,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceUpdateDomainScope:                             {operation: "UpdateDomain"},
 		PersistenceDeleteDomainScope:                             {operation: "DeleteDomain"},
 		PersistenceDeleteDomainByNameScope:                       {operation: "DeleteDomainByName"},
+		PersistenceRenameDomainScope:                             {operation: "RenameDomain"},
 		PersistenceListDomainScope:                               {operation: "ListDomain"},
 		PersistenceGetMetadataScope:                              {operation: "GetMetadata"},
 		PersistenceRecordWorkflowExecutionStartedScope:           {operation: "RecordWorkflowExecutionStarted"},
@@ -1219,6 +1452,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceUpdateDLQAckLevelScope:                        {operation: "UpdateDLQAckLevel"},
 		PersistenceGetDLQAckLevelScope:                           {operation: "GetDLQAckLevel"},
 		PersistenceGetDLQSizeScope:                               {operation: "GetDLQSize"},
+		PersistenceFetchConfigScope:                              {operation: "FetchConfig"},
+		PersistenceUpdateConfigScope:                             {operation: "UpdateConfig"},
+		PersistenceAppendDomainAuditLogScope:                     {operation: "AppendDomainAuditLog"},
+		PersistenceReadDomainAuditLogsScope:                      {operation: "ReadDomainAuditLogs"},
 
 		ClusterMetadataArchivalConfigScope: {operation: "ArchivalConfig"},
 
@@ -1228,6 +1465,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientCloseShardScope:                          {operation: "HistoryClientCloseShard", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientResetQueueScope:                          {operation: "HistoryClientResetQueue", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientDescribeQueueScope:                       {operation: "HistoryClientDescribeQueue", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientReadHistoryTaskDLQScope:                  {operation: "HistoryClientReadHistoryTaskDLQ", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientDescribeReplicationStatusScope:           {operation: "HistoryClientDescribeReplicationStatus", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientPurgeHistoryTaskDLQScope:                 {operation: "HistoryClientPurgeHistoryTaskDLQ", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRetryHistoryTaskDLQScope:                 {operation: "HistoryClientRetryHistoryTaskDLQ", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRecordActivityTaskHeartbeatScope:         {operation: "HistoryClientRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRespondDecisionTaskCompletedScope:        {operation: "HistoryClientRespondDecisionTaskCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRespondDecisionTaskFailedScope:           {operation: "HistoryClientRespondDecisionTaskFailed", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
@@ -1242,6 +1483,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientRecordDecisionTaskStartedScope:           {operation: "HistoryClientRecordDecisionTaskStarted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRecordActivityTaskStartedScope:           {operation: "HistoryClientRecordActivityTaskStarted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRequestCancelWorkflowExecutionScope:      {operation: "HistoryClientRequestCancelWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientPauseWorkflowExecutionScope:              {operation: "HistoryClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUnpauseWorkflowExecutionScope:            {operation: "HistoryClientUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientDeleteWorkflowExecutionScope:             {operation: "HistoryClientDeleteWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientPauseActivityScope:                       {operation: "HistoryClientPauseActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUnpauseActivityScope:                     {operation: "HistoryClientUnpauseActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientResetActivityScope:                       {operation: "HistoryClientResetActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUpdateWorkflowExecutionScope:             {operation: "HistoryClientUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientMigrateWorkflowExecutionScope:            {operation: "HistoryClientMigrateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientSignalWorkflowExecutionScope:             {operation: "HistoryClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientSignalWithStartWorkflowExecutionScope:    {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRemoveSignalMutableStateScope:            {operation: "HistoryClientRemoveSignalMutableStateScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
@@ -1273,6 +1522,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingClientDescribeTaskListScope:                   {operation: "MatchingClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientListTaskListPartitionsScope:             {operation: "MatchingClientListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientGetTaskListsByDomainScope:               {operation: "MatchingClientGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientPauseTaskListScope:                      {operation: "MatchingClientPauseTaskList", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientResumeTaskListScope:                     {operation: "MatchingClientResumeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientMoveTaskListBacklogScope:                {operation: "MatchingClientMoveTaskListBacklog", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		FrontendClientDeprecateDomainScope:                    {operation: "FrontendClientDeprecateDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientDescribeDomainScope:                     {operation: "FrontendClientDescribeDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientDescribeTaskListScope:                   {operation: "FrontendClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1291,8 +1543,23 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientRecordActivityTaskHeartbeatByIDScope:    {operation: "FrontendClientRecordActivityTaskHeartbeatByID", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientRegisterDomainScope:                     {operation: "FrontendClientRegisterDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientRequestCancelWorkflowExecutionScope:     {operation: "FrontendClientRequestCancelWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientPauseWorkflowExecutionScope:             {operation: "FrontendClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUnpauseWorkflowExecutionScope:           {operation: "FrontendClientUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientDeleteWorkflowExecutionScope:            {operation: "FrontendClientDeleteWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientPauseActivityScope:                      {operation: "FrontendClientPauseActivity", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUnpauseActivityScope:                    {operation: "FrontendClientUnpauseActivity", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientResetActivityScope:                      {operation: "FrontendClientResetActivity", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUpdateWorkflowExecutionScope:            {operation: "FrontendClientUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientCreateScheduleScope:                     {operation: "FrontendClientCreateSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientDescribeScheduleScope:                   {operation: "FrontendClientDescribeSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUpdateScheduleScope:                     {operation: "FrontendClientUpdateSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientPauseScheduleScope:                      {operation: "FrontendClientPauseSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUnpauseScheduleScope:                    {operation: "FrontendClientUnpauseSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientBackfillScheduleScope:                   {operation: "FrontendClientBackfillSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientListSchedulesScope:                      {operation: "FrontendClientListSchedules", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientResetStickyTaskListScope:                {operation: "FrontendClientResetStickyTaskList", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientResetWorkflowExecutionScope:             {operation: "FrontendClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientRestartWorkflowExecutionScope:           {operation: "FrontendClientRestartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientRespondActivityTaskCanceledScope:        {operation: "FrontendClientRespondActivityTaskCanceled", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientRespondActivityTaskCanceledByIDScope:    {operation: "FrontendClientRespondActivityTaskCanceledByID", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientRespondActivityTaskCompletedScope:       {operation: "FrontendClientRespondActivityTaskCompleted", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1330,10 +1597,24 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientRemoveTaskScope:                            {operation: "AdminClientRemoveTask", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientResetQueueScope:                            {operation: "AdminClientResetQueue", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientDescribeQueueScope:                         {operation: "AdminClientDescribeQueue", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientReadHistoryTaskDLQScope:                    {operation: "AdminClientReadHistoryTaskDLQ", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientDescribeReplicationStatusScope:             {operation: "AdminClientDescribeReplicationStatus", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientMigrateWorkflowExecutionScope:              {operation: "AdminClientMigrateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientPurgeHistoryTaskDLQScope:                   {operation: "AdminClientPurgeHistoryTaskDLQ", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientRetryHistoryTaskDLQScope:                   {operation: "AdminClientRetryHistoryTaskDLQ", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientReadDLQMessagesScope:                       {operation: "AdminClientReadDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientPurgeDLQMessagesScope:                      {operation: "AdminClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientMergeDLQMessagesScope:                      {operation: "AdminClientMergeDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientGetCrossClusterTasksScope:                  {operation: "AdminClientGetCrossClusterTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientPauseTaskListScope:                         {operation: "AdminClientPauseTaskList", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientResumeTaskListScope:                        {operation: "AdminClientResumeTaskList", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientAddRemoteClusterScope:                      {operation: "AdminClientAddRemoteCluster", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientRemoveRemoteClusterScope:                   {operation: "AdminClientRemoveRemoteCluster", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientCloneDomainScope:                           {operation: "AdminClientCloneDomain", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientRenameDomainScope:                          {operation: "AdminClientRenameDomain", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientDeleteDomainScope:                          {operation: "AdminClientDeleteDomain", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientListDomainChangesScope:                     {operation: "AdminClientListDomainChanges", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientMoveTaskListBacklogScope:                   {operation: "AdminClientMoveTaskListBacklog", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		DCRedirectionDeprecateDomainScope:                     {operation: "DCRedirectionDeprecateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeDomainScope:                      {operation: "DCRedirectionDescribeDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeTaskListScope:                    {operation: "DCRedirectionDescribeTaskList", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1356,8 +1637,23 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		DCRedirectionRecordActivityTaskHeartbeatByIDScope:     {operation: "DCRedirectionRecordActivityTaskHeartbeatByID", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionRegisterDomainScope:                      {operation: "DCRedirectionRegisterDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionRequestCancelWorkflowExecutionScope:      {operation: "DCRedirectionRequestCancelWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseWorkflowExecutionScope:              {operation: "DCRedirectionPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUnpauseWorkflowExecutionScope:            {operation: "DCRedirectionUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDeleteWorkflowExecutionScope:             {operation: "DCRedirectionDeleteWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseActivityScope:                       {operation: "DCRedirectionPauseActivity", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUnpauseActivityScope:                     {operation: "DCRedirectionUnpauseActivity", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionResetActivityScope:                       {operation: "DCRedirectionResetActivity", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateWorkflowExecutionScope:             {operation: "DCRedirectionUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionCreateScheduleScope:                      {operation: "DCRedirectionCreateSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDescribeScheduleScope:                    {operation: "DCRedirectionDescribeSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateScheduleScope:                      {operation: "DCRedirectionUpdateSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseScheduleScope:                       {operation: "DCRedirectionPauseSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUnpauseScheduleScope:                     {operation: "DCRedirectionUnpauseSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionBackfillScheduleScope:                    {operation: "DCRedirectionBackfillSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionListSchedulesScope:                       {operation: "DCRedirectionListSchedules", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionResetStickyTaskListScope:                 {operation: "DCRedirectionResetStickyTaskList", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionResetWorkflowExecutionScope:              {operation: "DCRedirectionResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionRestartWorkflowExecutionScope:            {operation: "DCRedirectionRestartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionRespondActivityTaskCanceledScope:         {operation: "DCRedirectionRespondActivityTaskCanceled", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionRespondActivityTaskCanceledByIDScope:     {operation: "DCRedirectionRespondActivityTaskCanceledByID", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionRespondActivityTaskCompletedScope:        {operation: "DCRedirectionRespondActivityTaskCompleted", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1380,6 +1676,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MessagingClientConsumerScope:     {operation: "MessagingClientConsumerScope"},
 
 		DomainCacheScope:                                      {operation: "DomainCache"},
+		DomainAuditLogScope:                                   {operation: "DomainAuditLog"},
 		HistoryRereplicationByTransferTaskScope:               {operation: "HistoryRereplicationByTransferTask"},
 		HistoryRereplicationByTimerTaskScope:                  {operation: "HistoryRereplicationByTimerTask"},
 		HistoryRereplicationByHistoryReplicationScope:         {operation: "HistoryRereplicationByHistoryReplication"},
@@ -1425,6 +1722,11 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminCloseShardScope:                       {operation: "AdminCloseShard"},
 		AdminResetQueueScope:                       {operation: "AdminResetQueueScope"},
 		AdminDescribeQueueScope:                    {operation: "AdminDescribeQueueScope"},
+		AdminReadHistoryTaskDLQScope:               {operation: "ReadHistoryTaskDLQ"},
+		AdminDescribeReplicationStatusScope:        {operation: "DescribeReplicationStatus"},
+		AdminMigrateWorkflowExecutionScope:         {operation: "MigrateWorkflowExecution"},
+		AdminPurgeHistoryTaskDLQScope:              {operation: "PurgeHistoryTaskDLQ"},
+		AdminRetryHistoryTaskDLQScope:              {operation: "RetryHistoryTaskDLQ"},
 		AdminReadDLQMessagesScope:                  {operation: "AdminReadDLQMessages"},
 		AdminPurgeDLQMessagesScope:                 {operation: "AdminPurgeDLQMessages"},
 		AdminMergeDLQMessagesScope:                 {operation: "AdminMergeDLQMessages"},
@@ -1441,6 +1743,15 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminRefreshWorkflowTasksScope:             {operation: "RefreshWorkflowTasks"},
 		AdminResendReplicationTasksScope:           {operation: "ResendReplicationTasks"},
 		AdminGetCrossClusterTasksScope:             {operation: "AdminGetCrossClusterTasksScope"},
+		AdminPauseTaskListScope:                    {operation: "PauseTaskList"},
+		AdminResumeTaskListScope:                   {operation: "ResumeTaskList"},
+		AdminAddRemoteClusterScope:                 {operation: "AddRemoteCluster"},
+		AdminRemoveRemoteClusterScope:              {operation: "RemoveRemoteCluster"},
+		AdminCloneDomainScope:                      {operation: "CloneDomain"},
+		AdminRenameDomainScope:                     {operation: "RenameDomain"},
+		AdminDeleteDomainScope:                     {operation: "DeleteDomain"},
+		AdminListDomainChangesScope:                {operation: "ListDomainChanges"},
+		AdminMoveTaskListBacklogScope:              {operation: "MoveTaskListBacklog"},
 
 		FrontendStartWorkflowExecutionScope:             {operation: "StartWorkflowExecution"},
 		FrontendPollForDecisionTaskScope:                {operation: "PollForDecisionTask"},
@@ -1463,7 +1774,22 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendSignalWithStartWorkflowExecutionScope:   {operation: "SignalWithStartWorkflowExecution"},
 		FrontendTerminateWorkflowExecutionScope:         {operation: "TerminateWorkflowExecution"},
 		FrontendResetWorkflowExecutionScope:             {operation: "ResetWorkflowExecution"},
+		FrontendRestartWorkflowExecutionScope:           {operation: "RestartWorkflowExecution"},
 		FrontendRequestCancelWorkflowExecutionScope:     {operation: "RequestCancelWorkflowExecution"},
+		FrontendPauseWorkflowExecutionScope:             {operation: "PauseWorkflowExecution"},
+		FrontendUnpauseWorkflowExecutionScope:           {operation: "UnpauseWorkflowExecution"},
+		FrontendDeleteWorkflowExecutionScope:            {operation: "DeleteWorkflowExecution"},
+		FrontendPauseActivityScope:                      {operation: "PauseActivity"},
+		FrontendUnpauseActivityScope:                    {operation: "UnpauseActivity"},
+		FrontendResetActivityScope:                      {operation: "ResetActivity"},
+		FrontendUpdateWorkflowExecutionScope:            {operation: "UpdateWorkflowExecution"},
+		FrontendCreateScheduleScope:                     {operation: "CreateSchedule"},
+		FrontendDescribeScheduleScope:                   {operation: "DescribeSchedule"},
+		FrontendUpdateScheduleScope:                     {operation: "UpdateSchedule"},
+		FrontendPauseScheduleScope:                      {operation: "PauseSchedule"},
+		FrontendUnpauseScheduleScope:                    {operation: "UnpauseSchedule"},
+		FrontendBackfillScheduleScope:                   {operation: "BackfillSchedule"},
+		FrontendListSchedulesScope:                      {operation: "ListSchedules"},
 		FrontendListArchivedWorkflowExecutionsScope:     {operation: "ListArchivedWorkflowExecutions"},
 		FrontendListOpenWorkflowExecutionsScope:         {operation: "ListOpenWorkflowExecutions"},
 		FrontendListClosedWorkflowExecutionsScope:       {operation: "ListClosedWorkflowExecutions"},
@@ -1494,6 +1820,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryRespondActivityTaskCanceledScope:                {operation: "RespondActivityTaskCanceled"},
 		HistoryResetQueueScope:                                 {operation: "ResetQueue"},
 		HistoryDescribeQueueScope:                              {operation: "DescribeQueue"},
+		HistoryReadHistoryTaskDLQScope:                         {operation: "ReadHistoryTaskDLQ"},
+		HistoryDescribeReplicationStatusScope:                  {operation: "DescribeReplicationStatus"},
+		HistoryPurgeHistoryTaskDLQScope:                        {operation: "PurgeHistoryTaskDLQ"},
+		HistoryRetryHistoryTaskDLQScope:                        {operation: "RetryHistoryTaskDLQ"},
 		HistoryDescribeMutabelStateScope:                       {operation: "DescribeMutableState"},
 		HistoryGetMutableStateScope:                            {operation: "GetMutableState"},
 		HistoryPollMutableStateScope:                           {operation: "PollMutableState"},
@@ -1511,6 +1841,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryScheduleDecisionTaskScope:                       {operation: "ScheduleDecisionTask"},
 		HistoryRecordChildExecutionCompletedScope:              {operation: "RecordChildExecutionCompleted"},
 		HistoryRequestCancelWorkflowExecutionScope:             {operation: "RequestCancelWorkflowExecution"},
+		HistoryPauseWorkflowExecutionScope:                     {operation: "PauseWorkflowExecution"},
+		HistoryUnpauseWorkflowExecutionScope:                   {operation: "UnpauseWorkflowExecution"},
+		HistoryDeleteWorkflowExecutionScope:                    {operation: "DeleteWorkflowExecution"},
+		HistoryPauseActivityScope:                              {operation: "PauseActivity"},
+		HistoryUnpauseActivityScope:                            {operation: "UnpauseActivity"},
+		HistoryResetActivityScope:                              {operation: "ResetActivity"},
+		HistoryUpdateWorkflowExecutionScope:                    {operation: "UpdateWorkflowExecution"},
+		HistoryMigrateWorkflowExecutionScope:                   {operation: "MigrateWorkflowExecution"},
 		HistoryReplicateEventsScope:                            {operation: "ReplicateEvents"},
 		HistoryReplicateRawEventsScope:                         {operation: "ReplicateRawEvents"},
 		HistoryReplicateEventsV2Scope:                          {operation: "ReplicateEventsV2"},
@@ -1596,6 +1934,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ReplicationTaskCleanupScope:                            {operation: "ReplicationTaskCleanup"},
 		ReplicationDLQStatsScope:                               {operation: "ReplicationDLQStats"},
 		FailoverMarkerScope:                                    {operation: "FailoverMarker"},
+		HealthDrivenFailoverScope:                              {operation: "HealthDrivenFailover"},
 		HistoryReplicationV2TaskScope:                          {operation: "HistoryReplicationV2Task"},
 		SyncActivityTaskScope:                                  {operation: "SyncActivityTask"},
 	},
@@ -1612,6 +1951,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingDescribeTaskListScope:          {operation: "DescribeTaskList"},
 		MatchingListTaskListPartitionsScope:    {operation: "ListTaskListPartitions"},
 		MatchingGetTaskListsByDomainScope:      {operation: "GetTaskListsByDomain"},
+		MatchingPauseTaskListScope:             {operation: "PauseTaskList"},
+		MatchingResumeTaskListScope:            {operation: "ResumeTaskList"},
+		MatchingMoveTaskListBacklogScope:       {operation: "MoveTaskListBacklog"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -1698,6 +2040,7 @@ const (
 
 	DomainCachePrepareCallbacksLatency
 	DomainCacheCallbacksLatency
+	DomainAuditLogAppendFailures
 
 	HistorySize
 	HistoryCount
@@ -1821,11 +2164,14 @@ const (
 	TaskProcessingLatencyPerDomain
 	TaskQueueLatencyPerDomain
 	TransferTaskMissingEventCounterPerDomain
+	TaskMovedToDLQPerDomain
+	TaskMoveToDLQFailurePerDomain
 
 	TaskRedispatchQueuePendingTasksTimer
 
 	TransferTaskThrottledCounter
 	TimerTaskThrottledCounter
+	UnknownDomainTierCounter
 
 	TransferTaskMissingEventCounter
 
@@ -1835,6 +2181,7 @@ const (
 	ProcessingQueueStuckTaskSplitCounter
 	ProcessingQueueSelectedDomainSplitCounter
 	ProcessingQueueRandomSplitCounter
+	ProcessingQueueDomainTierSplitCounter
 	ProcessingQueueThrottledCounter
 
 	QueueValidatorLostTaskCounter
@@ -1860,6 +2207,8 @@ const (
 	DecisionTypeContinueAsNewCounter
 	DecisionTypeSignalExternalWorkflowCounter
 	DecisionTypeUpsertWorkflowSearchAttributesCounter
+	DecisionTypeCompleteWorkflowUpdateCounter
+	DecisionTypeUpsertWorkflowMemoCounter
 	EmptyCompletionDecisionsCounter
 	MultipleCompletionDecisionsCounter
 	FailedDecisionsCounter
@@ -1979,6 +2328,10 @@ const (
 	ReplicationDLQAckLevelGauge
 	ReplicationDLQProbeFailed
 	ReplicationDLQSize
+	ReplicationDLQMergeRetryable
+	ReplicationDLQUnresolvedMissingHistory
+	ReplicationDLQUnresolvedVersionConflict
+	ReplicationDLQUnresolvedDomainNotActive
 	GetReplicationMessagesForShardLatency
 	GetDLQReplicationMessagesLatency
 	EventReapplySkippedCount
@@ -2009,6 +2362,11 @@ const (
 	FailoverMarkerInsertFailure
 	FailoverMarkerNotificationFailure
 	FailoverMarkerUpdateShardFailure
+	UnhealthyClusterCount
+	HealthDrivenFailoverCount
+	HealthDrivenFailoverFailure
+	ConcurrentWorkflowsLimitExceededCounter
+	ConcurrentWorkflowsLimitCheckFailureCounter
 
 	NumHistoryMetrics
 )
@@ -2180,6 +2538,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CadenceAuthorizationLatency:                         {metricName: "cadence_authorization_latency", metricType: Timer},
 		DomainCachePrepareCallbacksLatency:                  {metricName: "domain_cache_prepare_callbacks_latency", metricType: Timer},
 		DomainCacheCallbacksLatency:                         {metricName: "domain_cache_callbacks_latency", metricType: Timer},
+		DomainAuditLogAppendFailures:                        {metricName: "domain_audit_log_append_failures", metricType: Counter},
 		HistorySize:                                         {metricName: "history_size", metricType: Timer},
 		HistoryCount:                                        {metricName: "history_count", metricType: Timer},
 		EventBlobSize:                                       {metricName: "event_blob_size", metricType: Timer},
@@ -2327,12 +2686,15 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskProcessingLatencyPerDomain:           {metricName: "task_latency_processing_per_domain", metricRollupName: "task_latency_processing", metricType: Timer},
 		TaskQueueLatencyPerDomain:                {metricName: "task_latency_queue_per_domain", metricRollupName: "task_latency_queue", metricType: Timer},
 		TransferTaskMissingEventCounterPerDomain: {metricName: "transfer_task_missing_event_counter_per_domain", metricRollupName: "transfer_task_missing_event_counter", metricType: Counter},
+		TaskMovedToDLQPerDomain:                  {metricName: "task_moved_to_dlq_per_domain", metricRollupName: "task_moved_to_dlq", metricType: Counter},
+		TaskMoveToDLQFailurePerDomain:            {metricName: "task_move_to_dlq_failure_per_domain", metricRollupName: "task_move_to_dlq_failure", metricType: Counter},
 
 		TaskBatchCompleteCounter:                          {metricName: "task_batch_complete_counter", metricType: Counter},
 		TaskBatchCompleteFailure:                          {metricName: "task_batch_complete_error", metricType: Counter},
 		TaskRedispatchQueuePendingTasksTimer:              {metricName: "task_redispatch_queue_pending_tasks", metricType: Timer},
 		TransferTaskThrottledCounter:                      {metricName: "transfer_task_throttled_counter", metricType: Counter},
 		TimerTaskThrottledCounter:                         {metricName: "timer_task_throttled_counter", metricType: Counter},
+		UnknownDomainTierCounter:                          {metricName: "unknown_domain_tier_counter", metricType: Counter},
 		TransferTaskMissingEventCounter:                   {metricName: "transfer_task_missing_event_counter", metricType: Counter},
 		ProcessingQueueNumTimer:                           {metricName: "processing_queue_num", metricType: Timer},
 		ProcessingQueueMaxLevelTimer:                      {metricName: "processing_queue_max_level", metricType: Timer},
@@ -2340,6 +2702,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ProcessingQueueStuckTaskSplitCounter:              {metricName: "processing_queue_stuck_task_split_counter", metricType: Counter},
 		ProcessingQueueSelectedDomainSplitCounter:         {metricName: "processing_queue_selected_domain_split_counter", metricType: Counter},
 		ProcessingQueueRandomSplitCounter:                 {metricName: "processing_queue_random_split_counter", metricType: Counter},
+		ProcessingQueueDomainTierSplitCounter:             {metricName: "processing_queue_domain_tier_split_counter", metricType: Counter},
 		ProcessingQueueThrottledCounter:                   {metricName: "processing_queue_throttled_counter", metricType: Counter},
 		QueueValidatorLostTaskCounter:                     {metricName: "queue_validator_lost_task_counter", metricType: Counter},
 		QueueValidatorDropTaskCounter:                     {metricName: "queue_validator_drop_task_counter", metricType: Counter},
@@ -2362,6 +2725,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DecisionTypeContinueAsNewCounter:                  {metricName: "continue_as_new_decision", metricType: Counter},
 		DecisionTypeSignalExternalWorkflowCounter:         {metricName: "signal_external_workflow_decision", metricType: Counter},
 		DecisionTypeUpsertWorkflowSearchAttributesCounter: {metricName: "upsert_workflow_search_attributes_decision", metricType: Counter},
+		DecisionTypeCompleteWorkflowUpdateCounter:         {metricName: "complete_workflow_update_decision", metricType: Counter},
+		DecisionTypeUpsertWorkflowMemoCounter:             {metricName: "upsert_workflow_memo_decision", metricType: Counter},
 		DecisionTypeChildWorkflowCounter:                  {metricName: "child_workflow_decision", metricType: Counter},
 		EmptyCompletionDecisionsCounter:                   {metricName: "empty_completion_decisions", metricType: Counter},
 		MultipleCompletionDecisionsCounter:                {metricName: "multiple_completion_decisions", metricType: Counter},
@@ -2479,6 +2844,10 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ReplicationDLQAckLevelGauge:                       {metricName: "replication_dlq_ack_level", metricType: Gauge},
 		ReplicationDLQProbeFailed:                         {metricName: "replication_dlq_probe_failed", metricType: Counter},
 		ReplicationDLQSize:                                {metricName: "replication_dlq_size", metricType: Gauge},
+		ReplicationDLQMergeRetryable:                      {metricName: "replication_dlq_merge_retryable", metricType: Counter},
+		ReplicationDLQUnresolvedMissingHistory:            {metricName: "replication_dlq_unresolved_missing_history", metricType: Gauge},
+		ReplicationDLQUnresolvedVersionConflict:           {metricName: "replication_dlq_unresolved_version_conflict", metricType: Gauge},
+		ReplicationDLQUnresolvedDomainNotActive:           {metricName: "replication_dlq_unresolved_domain_not_active", metricType: Gauge},
 		GetReplicationMessagesForShardLatency:             {metricName: "get_replication_messages_for_shard", metricType: Timer},
 		GetDLQReplicationMessagesLatency:                  {metricName: "get_dlq_replication_messages", metricType: Timer},
 		EventReapplySkippedCount:                          {metricName: "event_reapply_skipped_count", metricType: Counter},
@@ -2509,6 +2878,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		FailoverMarkerInsertFailure:                       {metricName: "failover_marker_insert_failures", metricType: Counter},
 		FailoverMarkerNotificationFailure:                 {metricName: "failover_marker_notification_failures", metricType: Counter},
 		FailoverMarkerUpdateShardFailure:                  {metricName: "failover_marker_update_shard_failures", metricType: Counter},
+		UnhealthyClusterCount:                             {metricName: "unhealthy_cluster_count", metricType: Counter},
+		HealthDrivenFailoverCount:                         {metricName: "health_driven_failover_count", metricType: Counter},
+		HealthDrivenFailoverFailure:                       {metricName: "health_driven_failover_failures", metricType: Counter},
+		ConcurrentWorkflowsLimitExceededCounter:           {metricName: "concurrent_workflows_limit_exceeded", metricType: Counter},
+		ConcurrentWorkflowsLimitCheckFailureCounter:       {metricName: "concurrent_workflows_limit_check_failures", metricType: Counter},
 		TransferTasksCount:                                {metricName: "transfer_tasks_count", metricType: Timer},
 		TimerTasksCount:                                   {metricName: "timer_tasks_count", metricType: Timer},
 		ReplicationTasksCount:                             {metricName: "replication_tasks_count", metricType: Timer},
@@ -23,6 +23,7 @@ package mocks
 import (
 	"github.com/stretchr/testify/mock"
 
+	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
 )
 
@@ -158,3 +159,41 @@ func (_m *ClusterMetadata) IsPrimaryCluster() bool {
 
 	return r0
 }
+
+// ValidateRemoteClusters provides a mock function with given fields: clusterInfo
+func (_m *ClusterMetadata) ValidateRemoteClusters(clusterInfo map[string]config.ClusterInformation) error {
+	ret := _m.Called(clusterInfo)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(map[string]config.ClusterInformation) error); ok {
+		r0 = rf(clusterInfo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateRemoteClusters provides a mock function with given fields: clusterInfo
+func (_m *ClusterMetadata) UpdateRemoteClusters(clusterInfo map[string]config.ClusterInformation) error {
+	ret := _m.Called(clusterInfo)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(map[string]config.ClusterInformation) error); ok {
+		r0 = rf(clusterInfo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RegisterChangeCallback provides a mock function with given fields: key, callback
+func (_m *ClusterMetadata) RegisterChangeCallback(key string, callback cluster.ChangeCallback) {
+	_m.Called(key, callback)
+}
+
+// UnregisterChangeCallback provides a mock function with given fields: key
+func (_m *ClusterMetadata) UnregisterChangeCallback(key string) {
+	_m.Called(key)
+}
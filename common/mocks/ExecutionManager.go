@@ -172,6 +172,20 @@ func (_m *ExecutionManager) DeleteCurrentWorkflowExecution(ctx context.Context,
 	return r0
 }
 
+// DeleteHistoryTaskFromDLQ provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) DeleteHistoryTaskFromDLQ(ctx context.Context, request *persistence.DeleteHistoryTaskFromDLQRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.DeleteHistoryTaskFromDLQRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteReplicationTaskFromDLQ provides a mock function with given fields: ctx, request
 func (_m *ExecutionManager) DeleteReplicationTaskFromDLQ(ctx context.Context, request *persistence.DeleteReplicationTaskFromDLQRequest) error {
 	ret := _m.Called(ctx, request)
@@ -246,6 +260,29 @@ func (_m *ExecutionManager) GetCurrentExecution(ctx context.Context, request *pe
 	return r0, r1
 }
 
+// GetHistoryTasksFromDLQ provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) GetHistoryTasksFromDLQ(ctx context.Context, request *persistence.GetHistoryTasksFromDLQRequest) (*persistence.GetHistoryTasksFromDLQResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *persistence.GetHistoryTasksFromDLQResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetHistoryTasksFromDLQRequest) *persistence.GetHistoryTasksFromDLQResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetHistoryTasksFromDLQResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetHistoryTasksFromDLQRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetName provides a mock function with given fields:
 func (_m *ExecutionManager) GetName() string {
 	ret := _m.Called()
@@ -481,6 +518,20 @@ func (_m *ExecutionManager) ListCurrentExecutions(ctx context.Context, request *
 	return r0, r1
 }
 
+// PutHistoryTaskToDLQ provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) PutHistoryTaskToDLQ(ctx context.Context, request *persistence.PutHistoryTaskToDLQRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.PutHistoryTaskToDLQRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutReplicationTaskToDLQ provides a mock function with given fields: ctx, request
 func (_m *ExecutionManager) PutReplicationTaskToDLQ(ctx context.Context, request *persistence.PutReplicationTaskToDLQRequest) error {
 	ret := _m.Called(ctx, request)
@@ -551,6 +602,20 @@ func (_m *ExecutionManager) RangeCompleteTransferTask(ctx context.Context, reque
 	return r0
 }
 
+// RangeDeleteHistoryTaskFromDLQ provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) RangeDeleteHistoryTaskFromDLQ(ctx context.Context, request *persistence.RangeDeleteHistoryTaskFromDLQRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.RangeDeleteHistoryTaskFromDLQRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RangeDeleteReplicationTaskFromDLQ provides a mock function with given fields: ctx, request
 func (_m *ExecutionManager) RangeDeleteReplicationTaskFromDLQ(ctx context.Context, request *persistence.RangeDeleteReplicationTaskFromDLQRequest) error {
 	ret := _m.Called(ctx, request)
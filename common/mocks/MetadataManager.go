@@ -91,6 +91,20 @@ func (_m *MetadataManager) DeleteDomainByName(ctx context.Context, request *pers
 	return r0
 }
 
+// RenameDomain provides a mock function with given fields: ctx, request
+func (_m *MetadataManager) RenameDomain(ctx context.Context, request *persistence.RenameDomainRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.RenameDomainRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetDomain provides a mock function with given fields: ctx, request
 func (_m *MetadataManager) GetDomain(ctx context.Context, request *persistence.GetDomainRequest) (*persistence.GetDomainResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -47,6 +47,12 @@ type (
 		GetDomainReplicationQueueManager() persistence.QueueManager
 		SetDomainReplicationQueueManager(persistence.QueueManager)
 
+		GetDomainAuditManager() persistence.DomainAuditManager
+		SetDomainAuditManager(persistence.DomainAuditManager)
+
+		GetConfigStoreManager() persistence.ConfigStoreManager
+		SetConfigStoreManager(persistence.ConfigStoreManager)
+
 		GetShardManager() persistence.ShardManager
 		SetShardManager(persistence.ShardManager)
 
@@ -63,6 +69,8 @@ type (
 		taskManager                   persistence.TaskManager
 		visibilityManager             persistence.VisibilityManager
 		domainReplicationQueueManager persistence.QueueManager
+		domainAuditManager            persistence.DomainAuditManager
+		configStoreManager            persistence.ConfigStoreManager
 		shardManager                  persistence.ShardManager
 		historyManager                persistence.HistoryManager
 		executionManagerFactory       persistence.ExecutionManagerFactory
@@ -99,6 +107,16 @@ func NewBeanFromFactory(
 		return nil, err
 	}
 
+	domainAuditMgr, err := factory.NewDomainAuditManager()
+	if err != nil {
+		return nil, err
+	}
+
+	configStoreMgr, err := factory.NewConfigStoreManager()
+	if err != nil {
+		return nil, err
+	}
+
 	shardMgr, err := factory.NewShardManager()
 	if err != nil {
 		return nil, err
@@ -114,6 +132,8 @@ func NewBeanFromFactory(
 		taskMgr,
 		visibilityMgr,
 		domainReplicationQueue,
+		domainAuditMgr,
+		configStoreMgr,
 		shardMgr,
 		historyMgr,
 		factory,
@@ -126,6 +146,8 @@ func NewBean(
 	taskManager persistence.TaskManager,
 	visibilityManager persistence.VisibilityManager,
 	domainReplicationQueueManager persistence.QueueManager,
+	domainAuditManager persistence.DomainAuditManager,
+	configStoreManager persistence.ConfigStoreManager,
 	shardManager persistence.ShardManager,
 	historyManager persistence.HistoryManager,
 	executionManagerFactory persistence.ExecutionManagerFactory,
@@ -135,6 +157,8 @@ func NewBean(
 		taskManager:                   taskManager,
 		visibilityManager:             visibilityManager,
 		domainReplicationQueueManager: domainReplicationQueueManager,
+		domainAuditManager:            domainAuditManager,
+		configStoreManager:            configStoreManager,
 		shardManager:                  shardManager,
 		historyManager:                historyManager,
 		executionManagerFactory:       executionManagerFactory,
@@ -223,6 +247,46 @@ func (s *BeanImpl) SetDomainReplicationQueueManager(
 	s.domainReplicationQueueManager = domainReplicationQueueManager
 }
 
+// GetDomainAuditManager gets DomainAuditManager
+func (s *BeanImpl) GetDomainAuditManager() persistence.DomainAuditManager {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.domainAuditManager
+}
+
+// SetDomainAuditManager sets DomainAuditManager
+func (s *BeanImpl) SetDomainAuditManager(
+	domainAuditManager persistence.DomainAuditManager,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.domainAuditManager = domainAuditManager
+}
+
+// GetConfigStoreManager gets ConfigStoreManager
+func (s *BeanImpl) GetConfigStoreManager() persistence.ConfigStoreManager {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.configStoreManager
+}
+
+// SetConfigStoreManager sets ConfigStoreManager
+func (s *BeanImpl) SetConfigStoreManager(
+	configStoreManager persistence.ConfigStoreManager,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.configStoreManager = configStoreManager
+}
+
 // GetShardManager get ShardManager
 func (s *BeanImpl) GetShardManager() persistence.ShardManager {
 
@@ -318,6 +382,8 @@ func (s *BeanImpl) Close() {
 		s.visibilityManager.Close()
 	}
 	s.domainReplicationQueueManager.Close()
+	s.domainAuditManager.Close()
+	s.configStoreManager.Close()
 	s.shardManager.Close()
 	s.historyManager.Close()
 	s.executionManagerFactory.Close()
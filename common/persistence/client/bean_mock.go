@@ -173,6 +173,58 @@ func (mr *MockBeanMockRecorder) SetDomainReplicationQueueManager(arg0 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDomainReplicationQueueManager", reflect.TypeOf((*MockBean)(nil).SetDomainReplicationQueueManager), arg0)
 }
 
+// GetDomainAuditManager mocks base method
+func (m *MockBean) GetDomainAuditManager() persistence.DomainAuditManager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainAuditManager")
+	ret0, _ := ret[0].(persistence.DomainAuditManager)
+	return ret0
+}
+
+// GetDomainAuditManager indicates an expected call of GetDomainAuditManager
+func (mr *MockBeanMockRecorder) GetDomainAuditManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainAuditManager", reflect.TypeOf((*MockBean)(nil).GetDomainAuditManager))
+}
+
+// SetDomainAuditManager mocks base method
+func (m *MockBean) SetDomainAuditManager(arg0 persistence.DomainAuditManager) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDomainAuditManager", arg0)
+}
+
+// SetDomainAuditManager indicates an expected call of SetDomainAuditManager
+func (mr *MockBeanMockRecorder) SetDomainAuditManager(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDomainAuditManager", reflect.TypeOf((*MockBean)(nil).SetDomainAuditManager), arg0)
+}
+
+// GetConfigStoreManager mocks base method
+func (m *MockBean) GetConfigStoreManager() persistence.ConfigStoreManager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfigStoreManager")
+	ret0, _ := ret[0].(persistence.ConfigStoreManager)
+	return ret0
+}
+
+// GetConfigStoreManager indicates an expected call of GetConfigStoreManager
+func (mr *MockBeanMockRecorder) GetConfigStoreManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigStoreManager", reflect.TypeOf((*MockBean)(nil).GetConfigStoreManager))
+}
+
+// SetConfigStoreManager mocks base method
+func (m *MockBean) SetConfigStoreManager(arg0 persistence.ConfigStoreManager) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetConfigStoreManager", arg0)
+}
+
+// SetConfigStoreManager indicates an expected call of SetConfigStoreManager
+func (mr *MockBeanMockRecorder) SetConfigStoreManager(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConfigStoreManager", reflect.TypeOf((*MockBean)(nil).SetConfigStoreManager), arg0)
+}
+
 // GetShardManager mocks base method
 func (m *MockBean) GetShardManager() persistence.ShardManager {
 	m.ctrl.T.Helper()
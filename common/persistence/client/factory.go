@@ -62,6 +62,10 @@ type (
 		NewVisibilityManager(params *service.BootstrapParams, resourceConfig *rc.ResourceConfig) (p.VisibilityManager, error)
 		// NewDomainReplicationQueueManager returns a new queue for domain replication
 		NewDomainReplicationQueueManager() (p.QueueManager, error)
+		// NewConfigStoreManager returns a new config store manager
+		NewConfigStoreManager() (p.ConfigStoreManager, error)
+		// NewDomainAuditManager returns a new domain audit manager
+		NewDomainAuditManager() (p.DomainAuditManager, error)
 	}
 	// DataStoreFactory is a low level interface to be implemented by a datastore
 	// Examples of datastores are cassandra, mysql etc
@@ -83,6 +87,10 @@ type (
 		// be ordering by CloseTime. This will be removed when implementing https://github.com/uber/cadence/issues/3621
 		NewVisibilityStore(sortByCloseTime bool) (p.VisibilityStore, error)
 		NewQueue(queueType p.QueueType) (p.Queue, error)
+		// NewConfigStore returns a new config store
+		NewConfigStore() (p.ConfigStore, error)
+		// NewDomainAuditStore returns a new domain audit store
+		NewDomainAuditStore() (p.DomainAuditStore, error)
 	}
 
 	// Datastore represents a datastore
@@ -110,6 +118,8 @@ const (
 	storeTypeExecution
 	storeTypeVisibility
 	storeTypeQueue
+	storeTypeConfigStore
+	storeTypeDomainAudit
 )
 
 var storeTypes = []storeType{
@@ -120,6 +130,8 @@ var storeTypes = []storeType{
 	storeTypeExecution,
 	storeTypeVisibility,
 	storeTypeQueue,
+	storeTypeConfigStore,
+	storeTypeDomainAudit,
 }
 
 // NewFactory returns an implementation of factory that vends persistence objects based on
@@ -373,6 +385,46 @@ func (f *factoryImpl) NewDomainReplicationQueueManager() (p.QueueManager, error)
 	return result, nil
 }
 
+// NewConfigStoreManager returns a new config store manager
+func (f *factoryImpl) NewConfigStoreManager() (p.ConfigStoreManager, error) {
+	ds := f.datastores[storeTypeConfigStore]
+	store, err := ds.factory.NewConfigStore()
+	if err != nil {
+		return nil, err
+	}
+	result := p.NewConfigStoreManager(store)
+	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
+		result = p.NewConfigStorePersistenceErrorInjectionClient(result, errorRate, f.logger)
+	}
+	if ds.ratelimit != nil {
+		result = p.NewConfigStorePersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+	}
+	if f.metricsClient != nil {
+		result = p.NewConfigStorePersistenceMetricsClient(result, f.metricsClient, f.logger)
+	}
+	return result, nil
+}
+
+// NewDomainAuditManager returns a new domain audit manager
+func (f *factoryImpl) NewDomainAuditManager() (p.DomainAuditManager, error) {
+	ds := f.datastores[storeTypeDomainAudit]
+	store, err := ds.factory.NewDomainAuditStore()
+	if err != nil {
+		return nil, err
+	}
+	result := p.NewDomainAuditManager(store)
+	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
+		result = p.NewDomainAuditPersistenceErrorInjectionClient(result, errorRate, f.logger)
+	}
+	if ds.ratelimit != nil {
+		result = p.NewDomainAuditPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+	}
+	if f.metricsClient != nil {
+		result = p.NewDomainAuditPersistenceMetricsClient(result, f.metricsClient, f.logger)
+	}
+	return result, nil
+}
+
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	ds := f.datastores[storeTypeExecution]
@@ -0,0 +1,70 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+)
+
+type (
+	configStoreManager struct {
+		persistence ConfigStore
+	}
+)
+
+var _ ConfigStoreManager = (*configStoreManager)(nil)
+
+// NewConfigStoreManager returns a new ConfigStoreManager
+func NewConfigStoreManager(
+	persistence ConfigStore,
+) ConfigStoreManager {
+	return &configStoreManager{
+		persistence: persistence,
+	}
+}
+
+func (m *configStoreManager) Close() {
+	m.persistence.Close()
+}
+
+func (m *configStoreManager) FetchConfig(ctx context.Context, configType ConfigType) (*ConfigStoreEntry, error) {
+	entry, err := m.persistence.FetchConfig(ctx, configType)
+	if err != nil {
+		return nil, err
+	}
+	return &ConfigStoreEntry{
+		ConfigType: entry.ConfigType,
+		Version:    entry.Version,
+		Timestamp:  entry.Timestamp,
+		Values:     entry.Values,
+	}, nil
+}
+
+func (m *configStoreManager) UpdateConfig(ctx context.Context, entry *ConfigStoreEntry) error {
+	return m.persistence.UpdateConfig(ctx, &InternalConfigStoreEntry{
+		ConfigType: entry.ConfigType,
+		Version:    entry.Version,
+		Timestamp:  entry.Timestamp,
+		Values:     entry.Values,
+	})
+}
@@ -64,6 +64,15 @@ const (
 	DomainReplicationQueueType QueueType = iota + 1
 )
 
+// ConfigType is an enum that represents the types of the records in the config store
+type ConfigType int
+
+// Config types used in cluster_config table
+const (
+	// RemoteClustersConfigType is the record of the remote clusters added at runtime
+	RemoteClustersConfigType ConfigType = iota
+)
+
 // Create Workflow Execution Mode
 const (
 	// Fail if current record exists
@@ -186,6 +195,12 @@ const (
 	WorkflowBackoffTimeoutTypeCron
 )
 
+// Types of history deletion
+const (
+	DeleteHistoryEventTypeDefault = iota
+	DeleteHistoryEventTypeSkipArchival
+)
+
 const (
 	// InitialFailoverNotificationVersion is the initial failover version for a domain
 	InitialFailoverNotificationVersion int64 = 0
@@ -300,6 +315,8 @@ type (
 		LastUpdatedTimestamp               time.Time
 		CreateRequestID                    string
 		SignalCount                        int32
+		SignalRequestedTimestamps          map[string]int64
+		AcceptedUpdates                    map[string]int64
 		DecisionVersion                    int64
 		DecisionScheduleID                 int64
 		DecisionStartedID                  int64
@@ -311,6 +328,7 @@ type (
 		DecisionOriginalScheduledTimestamp int64
 		CancelRequested                    bool
 		CancelRequestID                    string
+		Paused                             bool
 		StickyTaskList                     string
 		StickyScheduleToStartTimeout       int32
 		ClientLibraryVersion               string
@@ -424,6 +442,7 @@ type (
 		Kind        int
 		Expiry      time.Time
 		LastUpdated time.Time
+		Paused      bool
 	}
 
 	// TaskInfo describes either activity or decision task
@@ -504,6 +523,7 @@ type (
 		VisibilityTimestamp time.Time
 		TaskID              int64
 		Version             int64
+		TimeoutType         int // 0 for default, 1 for deletion without archival.
 	}
 
 	// DecisionTimeoutTask identifies a timeout task.
@@ -710,6 +730,7 @@ type (
 		HeartbeatTimeout         int32
 		CancelRequested          bool
 		CancelRequestID          int64
+		Paused                   bool
 		LastHeartBeatUpdatedTime time.Time
 		TimerTaskStatus          int32
 		// For retry
@@ -1102,6 +1123,47 @@ type (
 		Size int64
 	}
 
+	// HistoryTaskDLQInfo describes a transfer or timer task which is parked in the
+	// history task DLQ after exceeding its retry budget, exactly one of TransferTaskInfo
+	// and TimerTaskInfo will be set
+	HistoryTaskDLQInfo struct {
+		TransferTaskInfo *TransferTaskInfo
+		TimerTaskInfo    *TimerTaskInfo
+		Attempt          int
+		LastError        string
+		CreatedTime      time.Time
+	}
+
+	// PutHistoryTaskToDLQRequest is used to put a transfer or timer task to history task dlq
+	PutHistoryTaskToDLQRequest struct {
+		TaskInfo *HistoryTaskDLQInfo
+	}
+
+	// GetHistoryTasksFromDLQRequest is used to get tasks from history task dlq
+	GetHistoryTasksFromDLQRequest struct {
+		ReadLevel     int64 // exclusive
+		MaxReadLevel  int64 // inclusive
+		BatchSize     int
+		NextPageToken []byte
+	}
+
+	// GetHistoryTasksFromDLQResponse is the response for GetHistoryTasksFromDLQ
+	GetHistoryTasksFromDLQResponse struct {
+		Tasks         []*HistoryTaskDLQInfo
+		NextPageToken []byte
+	}
+
+	// DeleteHistoryTaskFromDLQRequest is used to delete a task from history task dlq
+	DeleteHistoryTaskFromDLQRequest struct {
+		TaskID int64
+	}
+
+	// RangeDeleteHistoryTaskFromDLQRequest is used to delete tasks from history task dlq
+	RangeDeleteHistoryTaskFromDLQRequest struct {
+		ExclusiveBeginTaskID int64
+		InclusiveEndTaskID   int64
+	}
+
 	// RangeCompleteTimerTaskRequest is used to complete a range of tasks in the timer task queue
 	RangeCompleteTimerTaskRequest struct {
 		InclusiveBeginTimestamp time.Time
@@ -1249,6 +1311,7 @@ type (
 		VisibilityArchivalStatus types.ArchivalStatus
 		VisibilityArchivalURI    string
 		BadBinaries              types.BadBinaries
+		Limits                   types.DomainLimits
 	}
 
 	// DomainReplicationConfig describes the cross DC domain replication configuration
@@ -1324,6 +1387,14 @@ type (
 		Name string
 	}
 
+	// RenameDomainRequest is used to move a domain to a new name while keeping its ID.
+	// Info.Name carries the new name, PreviousName the name being replaced.
+	RenameDomainRequest struct {
+		PreviousName   string
+		IsGlobalDomain bool
+		UpdateDomainRequest
+	}
+
 	// ListDomainsRequest is used to list domains
 	ListDomainsRequest struct {
 		PageSize      int
@@ -1620,6 +1691,12 @@ type (
 		RangeDeleteReplicationTaskFromDLQ(ctx context.Context, request *RangeDeleteReplicationTaskFromDLQRequest) error
 		CreateFailoverMarkerTasks(ctx context.Context, request *CreateFailoverMarkersRequest) error
 
+		// History task dlq related methods
+		PutHistoryTaskToDLQ(ctx context.Context, request *PutHistoryTaskToDLQRequest) error
+		GetHistoryTasksFromDLQ(ctx context.Context, request *GetHistoryTasksFromDLQRequest) (*GetHistoryTasksFromDLQResponse, error)
+		DeleteHistoryTaskFromDLQ(ctx context.Context, request *DeleteHistoryTaskFromDLQRequest) error
+		RangeDeleteHistoryTaskFromDLQ(ctx context.Context, request *RangeDeleteHistoryTaskFromDLQRequest) error
+
 		// Timer related methods.
 		GetTimerIndexTasks(ctx context.Context, request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error)
 		CompleteTimerTask(ctx context.Context, request *CompleteTimerTaskRequest) error
@@ -1689,6 +1766,7 @@ type (
 		UpdateDomain(ctx context.Context, request *UpdateDomainRequest) error
 		DeleteDomain(ctx context.Context, request *DeleteDomainRequest) error
 		DeleteDomainByName(ctx context.Context, request *DeleteDomainByNameRequest) error
+		RenameDomain(ctx context.Context, request *RenameDomainRequest) error
 		ListDomains(ctx context.Context, request *ListDomainsRequest) (*ListDomainsResponse, error)
 		GetMetadata(ctx context.Context) (*GetMetadataResponse, error)
 	}
@@ -1710,6 +1788,37 @@ type (
 		GetDLQSize(ctx context.Context) (int64, error)
 	}
 
+	// ConfigStoreManager is used to manage the versioned records of the config store
+	ConfigStoreManager interface {
+		Closeable
+		FetchConfig(ctx context.Context, configType ConfigType) (*ConfigStoreEntry, error)
+		UpdateConfig(ctx context.Context, entry *ConfigStoreEntry) error
+	}
+
+	// ConfigStoreEntry is one version of a config store record,
+	// updating a record writes its next version and fails if that version already exists
+	ConfigStoreEntry struct {
+		ConfigType ConfigType
+		Version    int64
+		Timestamp  time.Time
+		Values     *DataBlob
+	}
+
+	// DomainAuditManager is used to manage the audit log of each domain
+	DomainAuditManager interface {
+		Closeable
+		AppendDomainAuditLog(ctx context.Context, domainID string, payload *DataBlob) error
+		ReadDomainAuditLogs(ctx context.Context, domainID string, lastEventID int64, maxCount int) ([]*DomainAuditLogEntry, error)
+	}
+
+	// DomainAuditLogEntry is one entry of the audit log of a domain,
+	// event IDs are assigned in append order and start from zero for every domain
+	DomainAuditLogEntry struct {
+		DomainID string
+		EventID  int64
+		Payload  *DataBlob
+	}
+
 	// QueueMessage is the message that stores in the queue
 	QueueMessage struct {
 		ID        int64     `json:"message_id"`
@@ -2557,6 +2666,17 @@ func (t *TimerTaskInfo) String() string {
 	)
 }
 
+// GetTaskID returns the task ID of the task in history task dlq
+func (t *HistoryTaskDLQInfo) GetTaskID() int64 {
+	if t.TransferTaskInfo != nil {
+		return t.TransferTaskInfo.GetTaskID()
+	}
+	if t.TimerTaskInfo != nil {
+		return t.TimerTaskInfo.GetTaskID()
+	}
+	return 0
+}
+
 // Copy returns a shallow copy of shardInfo
 func (s *ShardInfo) Copy() *ShardInfo {
 	// TODO: do we really need to deep copy those fields?
@@ -85,6 +85,7 @@ type (
 		UpdateDomain(ctx context.Context, request *InternalUpdateDomainRequest) error
 		DeleteDomain(ctx context.Context, request *DeleteDomainRequest) error
 		DeleteDomainByName(ctx context.Context, request *DeleteDomainByNameRequest) error
+		RenameDomain(ctx context.Context, request *InternalRenameDomainRequest) error
 		ListDomains(ctx context.Context, request *ListDomainsRequest) (*InternalListDomainsResponse, error)
 		GetMetadata(ctx context.Context) (*GetMetadataResponse, error)
 	}
@@ -126,6 +127,12 @@ type (
 		RangeDeleteReplicationTaskFromDLQ(ctx context.Context, request *RangeDeleteReplicationTaskFromDLQRequest) error
 		CreateFailoverMarkerTasks(ctx context.Context, request *CreateFailoverMarkersRequest) error
 
+		// History task dlq related methods
+		PutHistoryTaskToDLQ(ctx context.Context, request *InternalPutHistoryTaskToDLQRequest) error
+		GetHistoryTasksFromDLQ(ctx context.Context, request *GetHistoryTasksFromDLQRequest) (*InternalGetHistoryTasksFromDLQResponse, error)
+		DeleteHistoryTaskFromDLQ(ctx context.Context, request *DeleteHistoryTaskFromDLQRequest) error
+		RangeDeleteHistoryTaskFromDLQ(ctx context.Context, request *RangeDeleteHistoryTaskFromDLQRequest) error
+
 		// Timer related methods.
 		GetTimerIndexTasks(ctx context.Context, request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error)
 		CompleteTimerTask(ctx context.Context, request *CompleteTimerTaskRequest) error
@@ -196,6 +203,39 @@ type (
 		GetDLQSize(ctx context.Context) (int64, error)
 	}
 
+	// ConfigStore is a store of versioned config records
+	ConfigStore interface {
+		Closeable
+		// FetchConfig returns the latest version of a record, EntityNotExistsError if it was never written
+		FetchConfig(ctx context.Context, configType ConfigType) (*InternalConfigStoreEntry, error)
+		// UpdateConfig writes a new version of a record, ConditionFailedError if the version exists
+		UpdateConfig(ctx context.Context, entry *InternalConfigStoreEntry) error
+	}
+
+	// InternalConfigStoreEntry is one version of a config store record
+	InternalConfigStoreEntry struct {
+		ConfigType ConfigType
+		Version    int64
+		Timestamp  time.Time
+		Values     *DataBlob
+	}
+
+	// DomainAuditStore is a store of the audit log of each domain, partitioned by domain ID
+	DomainAuditStore interface {
+		Closeable
+		// AppendDomainAuditLog assigns the next event ID of the domain to the payload,
+		// ConditionFailedError if a concurrent append took the same event ID
+		AppendDomainAuditLog(ctx context.Context, domainID string, payload *DataBlob) error
+		ReadDomainAuditLogs(ctx context.Context, domainID string, lastEventID int64, maxCount int) ([]*InternalDomainAuditLogEntry, error)
+	}
+
+	// InternalDomainAuditLogEntry is one entry of the audit log of a domain
+	InternalDomainAuditLogEntry struct {
+		DomainID string
+		EventID  int64
+		Payload  *DataBlob
+	}
+
 	// InternalQueueMessage is the message that stores in the queue
 	InternalQueueMessage struct {
 		ID        int64     `json:"message_id"`
@@ -238,6 +278,23 @@ type (
 	// InternalGetReplicationTasksFromDLQResponse is the response for GetReplicationTasksFromDLQ
 	InternalGetReplicationTasksFromDLQResponse = InternalGetReplicationTasksResponse
 
+	// InternalHistoryTaskDLQInfo describes a task in history task dlq
+	InternalHistoryTaskDLQInfo struct {
+		TaskID int64
+		Task   *DataBlob
+	}
+
+	// InternalPutHistoryTaskToDLQRequest is used to put a task to history task dlq
+	InternalPutHistoryTaskToDLQRequest struct {
+		TaskInfo *InternalHistoryTaskDLQInfo
+	}
+
+	// InternalGetHistoryTasksFromDLQResponse is the response for GetHistoryTasksFromDLQ
+	InternalGetHistoryTasksFromDLQResponse struct {
+		Tasks         []*InternalHistoryTaskDLQInfo
+		NextPageToken []byte
+	}
+
 	// InternalReplicationTaskInfo describes the replication task created for replication of history events
 	InternalReplicationTaskInfo struct {
 		DomainID          string
@@ -280,6 +337,8 @@ type (
 		LastUpdatedTimestamp               time.Time
 		CreateRequestID                    string
 		SignalCount                        int32
+		SignalRequestedTimestamps          map[string]int64
+		AcceptedUpdates                    map[string]int64
 		DecisionVersion                    int64
 		DecisionScheduleID                 int64
 		DecisionStartedID                  int64
@@ -291,6 +350,7 @@ type (
 		DecisionOriginalScheduledTimestamp time.Time
 		CancelRequested                    bool
 		CancelRequestID                    string
+		Paused                             bool
 		StickyTaskList                     string
 		StickyScheduleToStartTimeout       time.Duration
 		ClientLibraryVersion               string
@@ -352,6 +412,7 @@ type (
 		HeartbeatTimeout         time.Duration
 		CancelRequested          bool
 		CancelRequestID          int64
+		Paused                   bool
 		LastHeartBeatUpdatedTime time.Time
 		TimerTaskStatus          int32
 		// For retry
@@ -744,6 +805,7 @@ type (
 		VisibilityArchivalStatus types.ArchivalStatus
 		VisibilityArchivalURI    string
 		BadBinaries              *DataBlob
+		Limits                   types.DomainLimits
 	}
 
 	// InternalCreateDomainRequest is used to create the domain
@@ -786,6 +848,13 @@ type (
 		NotificationVersion         int64
 	}
 
+	// InternalRenameDomainRequest is used to rename a domain while keeping its ID
+	InternalRenameDomainRequest struct {
+		PreviousName   string
+		IsGlobalDomain bool
+		InternalUpdateDomainRequest
+	}
+
 	// InternalListDomainsResponse is the response for GetDomain
 	InternalListDomainsResponse struct {
 		Domains       []*InternalGetDomainResponse
@@ -0,0 +1,73 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+)
+
+type (
+	domainAuditManager struct {
+		persistence DomainAuditStore
+	}
+)
+
+var _ DomainAuditManager = (*domainAuditManager)(nil)
+
+// NewDomainAuditManager returns a new DomainAuditManager
+func NewDomainAuditManager(
+	persistence DomainAuditStore,
+) DomainAuditManager {
+	return &domainAuditManager{
+		persistence: persistence,
+	}
+}
+
+func (m *domainAuditManager) Close() {
+	m.persistence.Close()
+}
+
+func (m *domainAuditManager) AppendDomainAuditLog(ctx context.Context, domainID string, payload *DataBlob) error {
+	return m.persistence.AppendDomainAuditLog(ctx, domainID, payload)
+}
+
+func (m *domainAuditManager) ReadDomainAuditLogs(
+	ctx context.Context,
+	domainID string,
+	lastEventID int64,
+	maxCount int,
+) ([]*DomainAuditLogEntry, error) {
+	entries, err := m.persistence.ReadDomainAuditLogs(ctx, domainID, lastEventID, maxCount)
+	if err != nil {
+		return nil, err
+	}
+	var result []*DomainAuditLogEntry
+	for _, entry := range entries {
+		result = append(result, &DomainAuditLogEntry{
+			DomainID: entry.DomainID,
+			EventID:  entry.EventID,
+			Payload:  entry.Payload,
+		})
+	}
+	return result, nil
+}
@@ -109,27 +109,28 @@ func (m *domainManagerImpl) UpdateDomain(
 	ctx context.Context,
 	request *UpdateDomainRequest,
 ) error {
-	dc, err := m.toInternalDomainConfig(request.Config)
+	internalReq, err := m.toInternalUpdateDomainRequest(request)
 	if err != nil {
 		return err
 	}
-	internalReq := &InternalUpdateDomainRequest{
-		Info:                        request.Info,
-		Config:                      &dc,
-		ReplicationConfig:           request.ReplicationConfig,
-		ConfigVersion:               request.ConfigVersion,
-		FailoverVersion:             request.FailoverVersion,
-		FailoverNotificationVersion: request.FailoverNotificationVersion,
-		PreviousFailoverVersion:     request.PreviousFailoverVersion,
-		LastUpdatedTime:             time.Unix(0, request.LastUpdatedTime),
-		NotificationVersion:         request.NotificationVersion,
-	}
-	if request.FailoverEndTime != nil {
-		internalReq.FailoverEndTime = common.TimePtr(time.Unix(0, *request.FailoverEndTime))
-	}
 	return m.persistence.UpdateDomain(ctx, internalReq)
 }
 
+func (m *domainManagerImpl) RenameDomain(
+	ctx context.Context,
+	request *RenameDomainRequest,
+) error {
+	internalReq, err := m.toInternalUpdateDomainRequest(&request.UpdateDomainRequest)
+	if err != nil {
+		return err
+	}
+	return m.persistence.RenameDomain(ctx, &InternalRenameDomainRequest{
+		PreviousName:                request.PreviousName,
+		IsGlobalDomain:              request.IsGlobalDomain,
+		InternalUpdateDomainRequest: *internalReq,
+	})
+}
+
 func (m *domainManagerImpl) DeleteDomain(
 	ctx context.Context,
 	request *DeleteDomainRequest,
@@ -180,6 +181,30 @@ func (m *domainManagerImpl) ListDomains(
 	}, nil
 }
 
+func (m *domainManagerImpl) toInternalUpdateDomainRequest(
+	request *UpdateDomainRequest,
+) (*InternalUpdateDomainRequest, error) {
+	dc, err := m.toInternalDomainConfig(request.Config)
+	if err != nil {
+		return nil, err
+	}
+	internalReq := &InternalUpdateDomainRequest{
+		Info:                        request.Info,
+		Config:                      &dc,
+		ReplicationConfig:           request.ReplicationConfig,
+		ConfigVersion:               request.ConfigVersion,
+		FailoverVersion:             request.FailoverVersion,
+		FailoverNotificationVersion: request.FailoverNotificationVersion,
+		PreviousFailoverVersion:     request.PreviousFailoverVersion,
+		LastUpdatedTime:             time.Unix(0, request.LastUpdatedTime),
+		NotificationVersion:         request.NotificationVersion,
+	}
+	if request.FailoverEndTime != nil {
+		internalReq.FailoverEndTime = common.TimePtr(time.Unix(0, *request.FailoverEndTime))
+	}
+	return internalReq, nil
+}
+
 func (m *domainManagerImpl) toInternalDomainConfig(c *DomainConfig) (InternalDomainConfig, error) {
 	if c == nil {
 		return InternalDomainConfig{}, nil
@@ -199,6 +224,7 @@ func (m *domainManagerImpl) toInternalDomainConfig(c *DomainConfig) (InternalDom
 		VisibilityArchivalStatus: c.VisibilityArchivalStatus,
 		VisibilityArchivalURI:    c.VisibilityArchivalURI,
 		BadBinaries:              badBinaries,
+		Limits:                   c.Limits,
 	}, nil
 }
 
@@ -221,6 +247,7 @@ func (m *domainManagerImpl) fromInternalDomainConfig(ic *InternalDomainConfig) (
 		VisibilityArchivalStatus: ic.VisibilityArchivalStatus,
 		VisibilityArchivalURI:    ic.VisibilityArchivalURI,
 		BadBinaries:              *badBinaries,
+		Limits:                   ic.Limits,
 	}, nil
 }
 
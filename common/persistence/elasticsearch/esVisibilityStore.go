@@ -637,6 +637,10 @@ func (v *esVisibilityStore) processSortField(dsl *fastjson.Value) (string, error
 		if v.getFieldType(sortField) == workflow.IndexedValueTypeString {
 			return "", errors.New("not able to sort by IndexedValueTypeString field, use IndexedValueTypeKeyword field")
 		}
+		// sort validation to exclude IndexedValueTypeKeywordList, sorting on multi-valued field is ambiguous
+		if v.getFieldType(sortField) == workflow.IndexedValueTypeKeywordList {
+			return "", errors.New("not able to sort by IndexedValueTypeKeywordList field, use IndexedValueTypeKeyword field")
+		}
 		// add RunID as tie-breaker
 		dsl.Get(dslFieldSort).Set("1", fastjson.MustParse(jsonSortWithTieBreaker))
 	}
@@ -634,6 +634,10 @@ func (s *ESVisibilitySuite) TestGetESQueryDSL() {
 	dsl, err = v.getESQueryDSL(request, token)
 	s.Equal(errors.New("not able to sort by IndexedValueTypeString field, use IndexedValueTypeKeyword field"), err)
 
+	request.Query = `order by CustomKeywordListField desc`
+	dsl, err = v.getESQueryDSL(request, token)
+	s.Equal(errors.New("not able to sort by IndexedValueTypeKeywordList field, use IndexedValueTypeKeyword field"), err)
+
 	request.Query = `order by CustomIntField asc`
 	dsl, err = v.getESQueryDSL(request, token)
 	s.Nil(err)
@@ -156,6 +156,8 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		LastUpdatedTimestamp:               info.LastUpdatedTimestamp,
 		CreateRequestID:                    info.CreateRequestID,
 		SignalCount:                        info.SignalCount,
+		SignalRequestedTimestamps:          info.SignalRequestedTimestamps,
+		AcceptedUpdates:                    info.AcceptedUpdates,
 		DecisionVersion:                    info.DecisionVersion,
 		DecisionScheduleID:                 info.DecisionScheduleID,
 		DecisionStartedID:                  info.DecisionStartedID,
@@ -167,6 +169,7 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		DecisionOriginalScheduledTimestamp: info.DecisionOriginalScheduledTimestamp.UnixNano(),
 		CancelRequested:                    info.CancelRequested,
 		CancelRequestID:                    info.CancelRequestID,
+		Paused:                             info.Paused,
 		StickyTaskList:                     info.StickyTaskList,
 		StickyScheduleToStartTimeout:       int32(info.StickyScheduleToStartTimeout.Seconds()),
 		ClientLibraryVersion:               info.ClientLibraryVersion,
@@ -287,6 +290,7 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			HeartbeatTimeout:                        int32(v.HeartbeatTimeout.Seconds()),
 			CancelRequested:                         v.CancelRequested,
 			CancelRequestID:                         v.CancelRequestID,
+			Paused:                                  v.Paused,
 			LastHeartBeatUpdatedTime:                v.LastHeartBeatUpdatedTime,
 			TimerTaskStatus:                         v.TimerTaskStatus,
 			Attempt:                                 v.Attempt,
@@ -411,6 +415,7 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			HeartbeatTimeout:                        common.SecondsToDuration(int64(v.HeartbeatTimeout)),
 			CancelRequested:                         v.CancelRequested,
 			CancelRequestID:                         v.CancelRequestID,
+			Paused:                                  v.Paused,
 			LastHeartBeatUpdatedTime:                v.LastHeartBeatUpdatedTime,
 			TimerTaskStatus:                         v.TimerTaskStatus,
 			Attempt:                                 v.Attempt,
@@ -478,6 +483,8 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		LastUpdatedTimestamp:               info.LastUpdatedTimestamp,
 		CreateRequestID:                    info.CreateRequestID,
 		SignalCount:                        info.SignalCount,
+		SignalRequestedTimestamps:          info.SignalRequestedTimestamps,
+		AcceptedUpdates:                    info.AcceptedUpdates,
 		DecisionVersion:                    info.DecisionVersion,
 		DecisionScheduleID:                 info.DecisionScheduleID,
 		DecisionStartedID:                  info.DecisionStartedID,
@@ -489,6 +496,7 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		DecisionOriginalScheduledTimestamp: time.Unix(0, info.DecisionOriginalScheduledTimestamp),
 		CancelRequested:                    info.CancelRequested,
 		CancelRequestID:                    info.CancelRequestID,
+		Paused:                             info.Paused,
 		StickyTaskList:                     info.StickyTaskList,
 		StickyScheduleToStartTimeout:       common.SecondsToDuration(int64(info.StickyScheduleToStartTimeout)),
 		ClientLibraryVersion:               info.ClientLibraryVersion,
@@ -928,6 +936,59 @@ func (m *executionManagerImpl) RangeDeleteReplicationTaskFromDLQ(
 	return m.persistence.RangeDeleteReplicationTaskFromDLQ(ctx, request)
 }
 
+func (m *executionManagerImpl) PutHistoryTaskToDLQ(
+	ctx context.Context,
+	request *PutHistoryTaskToDLQRequest,
+) error {
+	task, err := m.serializer.SerializeHistoryTaskDLQInfo(request.TaskInfo, common.EncodingTypeJSON)
+	if err != nil {
+		return err
+	}
+	internalRequest := &InternalPutHistoryTaskToDLQRequest{
+		TaskInfo: &InternalHistoryTaskDLQInfo{
+			TaskID: request.TaskInfo.GetTaskID(),
+			Task:   task,
+		},
+	}
+	return m.persistence.PutHistoryTaskToDLQ(ctx, internalRequest)
+}
+
+func (m *executionManagerImpl) GetHistoryTasksFromDLQ(
+	ctx context.Context,
+	request *GetHistoryTasksFromDLQRequest,
+) (*GetHistoryTasksFromDLQResponse, error) {
+	resp, err := m.persistence.GetHistoryTasksFromDLQ(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	tasks := make([]*HistoryTaskDLQInfo, 0, len(resp.Tasks))
+	for _, internalTask := range resp.Tasks {
+		task, err := m.serializer.DeserializeHistoryTaskDLQInfo(internalTask.Task)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return &GetHistoryTasksFromDLQResponse{
+		Tasks:         tasks,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+func (m *executionManagerImpl) DeleteHistoryTaskFromDLQ(
+	ctx context.Context,
+	request *DeleteHistoryTaskFromDLQRequest,
+) error {
+	return m.persistence.DeleteHistoryTaskFromDLQ(ctx, request)
+}
+
+func (m *executionManagerImpl) RangeDeleteHistoryTaskFromDLQ(
+	ctx context.Context,
+	request *RangeDeleteHistoryTaskFromDLQRequest,
+) error {
+	return m.persistence.RangeDeleteHistoryTaskFromDLQ(ctx, request)
+}
+
 func (m *executionManagerImpl) CreateFailoverMarkerTasks(
 	ctx context.Context,
 	request *CreateFailoverMarkersRequest,
@@ -94,6 +94,16 @@ func (f *Factory) NewQueue(queueType p.QueueType) (p.Queue, error) {
 	return newNoSQLQueueStore(f.cfg, f.logger, queueType)
 }
 
+// NewConfigStore returns a new config store backed by cassandra
+func (f *Factory) NewConfigStore() (p.ConfigStore, error) {
+	return newNoSQLConfigStore(f.cfg, f.logger)
+}
+
+// NewDomainAuditStore returns a new domain audit store backed by cassandra
+func (f *Factory) NewDomainAuditStore() (p.DomainAuditStore, error) {
+	return newNoSQLDomainAuditStore(f.cfg, f.logger)
+}
+
 // Close closes the factory
 func (f *Factory) Close() {
 	f.Lock()
@@ -0,0 +1,118 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nosql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+const (
+	emptyDomainAuditLogEventID = -1
+
+	domainAuditLogAppendMaxAttempts = 5
+)
+
+type (
+	nosqlDomainAuditStore struct {
+		nosqlStore
+	}
+)
+
+func newNoSQLDomainAuditStore(
+	cfg config.NoSQL,
+	logger log.Logger,
+) (persistence.DomainAuditStore, error) {
+	db, err := NewNoSQLDB(&cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nosqlDomainAuditStore{
+		nosqlStore: nosqlStore{
+			db:     db,
+			logger: logger,
+		},
+	}, nil
+}
+
+func (m *nosqlDomainAuditStore) AppendDomainAuditLog(
+	ctx context.Context,
+	domainID string,
+	payload *persistence.DataBlob,
+) error {
+	data, encoding := persistence.FromDataBlob(payload)
+	// concurrent appends to the same domain race for the next event ID,
+	// the ones losing the race re-read the last event ID and try again
+	for attempt := 1; ; attempt++ {
+		lastEventID, err := m.db.SelectLastDomainAuditLogEventID(ctx, domainID)
+		if err != nil {
+			if !m.db.IsNotFoundError(err) {
+				return convertCommonErrors(m.db, fmt.Sprintf("AppendDomainAuditLog, DomainID: %v", domainID), err)
+			}
+			lastEventID = emptyDomainAuditLogEventID
+		}
+
+		err = m.db.InsertIntoDomainAuditLog(ctx, &nosqlplugin.DomainAuditLogRow{
+			DomainID:     domainID,
+			EventID:      lastEventID + 1,
+			Data:         data,
+			DataEncoding: encoding,
+		})
+		if err == nil {
+			return nil
+		}
+		if _, ok := err.(*nosqlplugin.ConditionFailure); !ok {
+			return convertCommonErrors(m.db, fmt.Sprintf("AppendDomainAuditLog, DomainID: %v", domainID), err)
+		}
+		if attempt >= domainAuditLogAppendMaxAttempts {
+			return &persistence.ConditionFailedError{Msg: fmt.Sprintf("event ID %v exists in audit log of domain %v", lastEventID+1, domainID)}
+		}
+	}
+}
+
+func (m *nosqlDomainAuditStore) ReadDomainAuditLogs(
+	ctx context.Context,
+	domainID string,
+	lastEventID int64,
+	maxCount int,
+) ([]*persistence.InternalDomainAuditLogEntry, error) {
+	rows, err := m.db.SelectDomainAuditLogs(ctx, domainID, lastEventID, maxCount)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, fmt.Sprintf("ReadDomainAuditLogs, DomainID: %v", domainID), err)
+	}
+
+	var result []*persistence.InternalDomainAuditLogEntry
+	for _, row := range rows {
+		result = append(result, &persistence.InternalDomainAuditLogEntry{
+			DomainID: row.DomainID,
+			EventID:  row.EventID,
+			Payload:  persistence.NewDataBlob(row.Data, common.EncodingType(row.DataEncoding)),
+		})
+	}
+	return result, nil
+}
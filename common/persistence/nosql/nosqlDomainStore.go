@@ -102,24 +102,11 @@ func (m *nosqlDomainStore) UpdateDomain(
 	ctx context.Context,
 	request *p.InternalUpdateDomainRequest,
 ) error {
-	config, err := m.toNoSQLInternalDomainConfig(request.Config)
+	row, err := m.toNoSQLDomainRow(request)
 	if err != nil {
 		return err
 	}
 
-	row := &nosqlplugin.DomainRow{
-		Info:                        request.Info,
-		Config:                      config,
-		ReplicationConfig:           request.ReplicationConfig,
-		ConfigVersion:               request.ConfigVersion,
-		FailoverVersion:             request.FailoverVersion,
-		FailoverNotificationVersion: request.FailoverNotificationVersion,
-		PreviousFailoverVersion:     request.PreviousFailoverVersion,
-		FailoverEndTime:             request.FailoverEndTime,
-		NotificationVersion:         request.NotificationVersion,
-		LastUpdatedTime:             request.LastUpdatedTime,
-	}
-
 	err = m.db.UpdateDomain(ctx, row)
 	if err != nil {
 		return convertCommonErrors(m.db, "UpdateDomain", err)
@@ -128,6 +115,28 @@ func (m *nosqlDomainStore) UpdateDomain(
 	return nil
 }
 
+// RenameDomain moves the domain record to the new name, keeping the domain ID
+func (m *nosqlDomainStore) RenameDomain(
+	ctx context.Context,
+	request *p.InternalRenameDomainRequest,
+) error {
+	row, err := m.toNoSQLDomainRow(&request.InternalUpdateDomainRequest)
+	if err != nil {
+		return err
+	}
+	row.IsGlobalDomain = request.IsGlobalDomain
+
+	err = m.db.RenameDomain(ctx, request.PreviousName, row)
+	if err != nil {
+		if _, ok := err.(*types.DomainAlreadyExistsError); ok {
+			return err
+		}
+		return convertCommonErrors(m.db, "RenameDomain", err)
+	}
+
+	return nil
+}
+
 func (m *nosqlDomainStore) GetDomain(
 	ctx context.Context,
 	request *p.GetDomainRequest,
@@ -154,7 +163,7 @@ func (m *nosqlDomainStore) GetDomain(
 		if len(ID) > 0 {
 			identity = ID
 		}
-		if m.db.IsNotFoundError(err) {
+		if _, ok := err.(*types.EntityNotExistsError); ok || m.db.IsNotFoundError(err) {
 			return &types.EntityNotExistsError{
 				Message: fmt.Sprintf("Domain %s does not exist.", identity),
 			}
@@ -272,6 +281,28 @@ func (m *nosqlDomainStore) GetMetadata(
 	return &p.GetMetadataResponse{NotificationVersion: notificationVersion}, nil
 }
 
+func (m *nosqlDomainStore) toNoSQLDomainRow(
+	request *p.InternalUpdateDomainRequest,
+) (*nosqlplugin.DomainRow, error) {
+	config, err := m.toNoSQLInternalDomainConfig(request.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nosqlplugin.DomainRow{
+		Info:                        request.Info,
+		Config:                      config,
+		ReplicationConfig:           request.ReplicationConfig,
+		ConfigVersion:               request.ConfigVersion,
+		FailoverVersion:             request.FailoverVersion,
+		FailoverNotificationVersion: request.FailoverNotificationVersion,
+		PreviousFailoverVersion:     request.PreviousFailoverVersion,
+		FailoverEndTime:             request.FailoverEndTime,
+		NotificationVersion:         request.NotificationVersion,
+		LastUpdatedTime:             request.LastUpdatedTime,
+	}, nil
+}
+
 func (m *nosqlDomainStore) toNoSQLInternalDomainConfig(
 	domainConfig *p.InternalDomainConfig,
 ) (*nosqlplugin.NoSQLInternalDomainConfig, error) {
@@ -285,6 +316,7 @@ func (m *nosqlDomainStore) toNoSQLInternalDomainConfig(
 		VisibilityArchivalStatus: domainConfig.VisibilityArchivalStatus,
 		VisibilityArchivalURI:    domainConfig.VisibilityArchivalURI,
 		BadBinaries:              domainConfig.BadBinaries,
+		Limits:                   domainConfig.Limits,
 	}, nil
 }
 
@@ -301,5 +333,6 @@ func (m *nosqlDomainStore) fromNoSQLInternalDomainConfig(
 		VisibilityArchivalStatus: domainConfig.VisibilityArchivalStatus,
 		VisibilityArchivalURI:    domainConfig.VisibilityArchivalURI,
 		BadBinaries:              domainConfig.BadBinaries,
+		Limits:                   domainConfig.Limits,
 	}, nil
 }
@@ -791,6 +791,59 @@ func (d *nosqlExecutionStore) RangeDeleteReplicationTaskFromDLQ(
 	return nil
 }
 
+func (d *nosqlExecutionStore) PutHistoryTaskToDLQ(
+	ctx context.Context,
+	request *p.InternalPutHistoryTaskToDLQRequest,
+) error {
+
+	err := d.db.InsertHistoryTaskDLQTask(ctx, d.shardID, *request.TaskInfo)
+	if err != nil {
+		return convertCommonErrors(d.db, "PutHistoryTaskToDLQ", err)
+	}
+
+	return nil
+}
+
+func (d *nosqlExecutionStore) GetHistoryTasksFromDLQ(
+	ctx context.Context,
+	request *p.GetHistoryTasksFromDLQRequest,
+) (*p.InternalGetHistoryTasksFromDLQResponse, error) {
+	tasks, nextPageToken, err := d.db.SelectHistoryTaskDLQTasksOrderByTaskID(ctx, d.shardID, request.BatchSize, request.NextPageToken, request.ReadLevel, request.MaxReadLevel)
+	if err != nil {
+		return nil, convertCommonErrors(d.db, "GetHistoryTasksFromDLQ", err)
+	}
+	return &p.InternalGetHistoryTasksFromDLQResponse{
+		Tasks:         tasks,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (d *nosqlExecutionStore) DeleteHistoryTaskFromDLQ(
+	ctx context.Context,
+	request *p.DeleteHistoryTaskFromDLQRequest,
+) error {
+
+	err := d.db.DeleteHistoryTaskDLQTask(ctx, d.shardID, request.TaskID)
+	if err != nil {
+		return convertCommonErrors(d.db, "DeleteHistoryTaskFromDLQ", err)
+	}
+
+	return nil
+}
+
+func (d *nosqlExecutionStore) RangeDeleteHistoryTaskFromDLQ(
+	ctx context.Context,
+	request *p.RangeDeleteHistoryTaskFromDLQRequest,
+) error {
+
+	err := d.db.RangeDeleteHistoryTaskDLQTasks(ctx, d.shardID, request.ExclusiveBeginTaskID, request.InclusiveEndTaskID)
+	if err != nil {
+		return convertCommonErrors(d.db, "RangeDeleteHistoryTaskFromDLQ", err)
+	}
+
+	return nil
+}
+
 func (d *nosqlExecutionStore) CreateFailoverMarkerTasks(
 	ctx context.Context,
 	request *p.CreateFailoverMarkersRequest,
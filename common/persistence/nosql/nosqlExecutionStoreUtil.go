@@ -225,7 +225,7 @@ func (d *nosqlExecutionStore) prepareTimerTasksForWorkflowTxn(
 			// noop
 
 		case *p.DeleteHistoryEventTask:
-			// noop
+			timeoutType = t.TimeoutType
 
 		default:
 			return nil, &types.InternalServiceError{
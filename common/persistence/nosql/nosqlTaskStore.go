@@ -129,6 +129,7 @@ func (t *nosqlTaskStore) LeaseTaskList(
 			TaskListKind:    currTL.TaskListKind,
 			AckLevel:        currTL.AckLevel,
 			LastUpdatedTime: now,
+			Paused:          currTL.Paused,
 		}, currTL.RangeID-1)
 	}
 	if err != nil {
@@ -149,6 +150,7 @@ func (t *nosqlTaskStore) LeaseTaskList(
 		AckLevel:    currTL.AckLevel,
 		Kind:        request.TaskListKind,
 		LastUpdated: now,
+		Paused:      currTL.Paused,
 	}
 	return &p.LeaseTaskListResponse{TaskListInfo: tli}, nil
 }
@@ -167,6 +169,7 @@ func (t *nosqlTaskStore) UpdateTaskList(
 		TaskListKind:    tli.Kind,
 		AckLevel:        tli.AckLevel,
 		LastUpdatedTime: time.Now(),
+		Paused:          tli.Paused,
 	}
 
 	if tli.Kind == p.TaskListKindSticky { // if task_list is sticky, then update with TTL
@@ -271,6 +274,7 @@ func toTaskListRow(info *p.TaskListInfo) *nosqlplugin.TaskListRow {
 		RangeID:         info.RangeID,
 		AckLevel:        info.AckLevel,
 		LastUpdatedTime: info.LastUpdated,
+		Paused:          info.Paused,
 	}
 }
 
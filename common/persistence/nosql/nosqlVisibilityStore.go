@@ -133,7 +133,28 @@ func (v *nosqlVisibilityStore) UpsertWorkflowExecution(
 	if p.IsNopUpsertWorkflowRequest(request) {
 		return nil
 	}
-	return p.NewOperationNotSupportErrorForVis()
+
+	// only memo can be updated in db visibility, search attributes require advanced visibility.
+	// The open record is rewritten with the latest memo.
+	ttl := int64(request.WorkflowTimeout.Seconds()) + openExecutionTTLBuffer
+
+	err := v.db.InsertVisibility(ctx, ttl, &nosqlplugin.VisibilityRowForInsert{
+		DomainID: request.DomainUUID,
+		VisibilityRow: nosqlplugin.VisibilityRow{
+			WorkflowID:    request.WorkflowID,
+			RunID:         request.RunID,
+			TypeName:      request.WorkflowTypeName,
+			StartTime:     request.StartTimestamp,
+			ExecutionTime: request.ExecutionTimestamp,
+			Memo:          request.Memo,
+			TaskList:      request.TaskList,
+			IsCron:        request.IsCron,
+		},
+	})
+	if err != nil {
+		return convertCommonErrors(v.db, "UpsertWorkflowExecution", err)
+	}
+	return nil
 }
 
 func (v *nosqlVisibilityStore) ListOpenWorkflowExecutions(
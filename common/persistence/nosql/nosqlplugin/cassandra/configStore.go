@@ -0,0 +1,77 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+const (
+	templateInsertConfigQuery       = `INSERT INTO cluster_config (row_type, version, timestamp, data, data_encoding) VALUES(?, ?, ?, ?, ?) IF NOT EXISTS`
+	templateSelectLatestConfigQuery = `SELECT row_type, version, timestamp, data, data_encoding FROM cluster_config WHERE row_type = ? LIMIT 1`
+)
+
+// InsertConfig inserts a new version of a config store record
+// Must return ConditionFailure error if the version already exists
+func (db *cdb) InsertConfig(
+	ctx context.Context,
+	row *persistence.InternalConfigStoreEntry,
+) error {
+	data, encoding := persistence.FromDataBlob(row.Values)
+	query := db.session.Query(templateInsertConfigQuery, row.ConfigType, row.Version, row.Timestamp, data, encoding).WithContext(ctx)
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return err
+	}
+
+	if !applied {
+		return nosqlplugin.NewConditionFailure("cluster_config")
+	}
+	return nil
+}
+
+// SelectLatestConfig returns the latest version of a config store record
+func (db *cdb) SelectLatestConfig(
+	ctx context.Context,
+	rowType int,
+) (*persistence.InternalConfigStoreEntry, error) {
+	var version int64
+	var timestamp time.Time
+	var data []byte
+	var encoding string
+
+	query := db.session.Query(templateSelectLatestConfigQuery, rowType).WithContext(ctx)
+	if err := query.Scan(&rowType, &version, &timestamp, &data, &encoding); err != nil {
+		return nil, err
+	}
+	return &persistence.InternalConfigStoreEntry{
+		ConfigType: persistence.ConfigType(rowType),
+		Version:    version,
+		Timestamp:  timestamp,
+		Values:     persistence.NewDataBlob(data, common.EncodingType(encoding)),
+	}, nil
+}
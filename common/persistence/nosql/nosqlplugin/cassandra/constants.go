@@ -54,6 +54,7 @@ const (
 	rowTypeReplicationTask
 	rowTypeDLQ
 	rowTypeCrossClusterTask
+	rowTypeHistoryTaskDLQ
 )
 
 // Guidelines for creating new special UUID constants
@@ -61,7 +62,7 @@ const (
 // Where x is any hexadecimal value, E represents the entity type valid values are:
 // E = {DomainID = 1, WorkflowID = 2, RunID = 3}
 // R represents row type in executions table, valid values are:
-// R = {Shard = 0, Execution = 1, Transfer = 2, Timer = 3, Replication = 4, DLQ = 5, CrossCluster = 6}
+// R = {Shard = 0, Execution = 1, Transfer = 2, Timer = 3, Replication = 4, DLQ = 5, CrossCluster = 6, HistoryTaskDLQ = 7}
 const (
 	// Special Domains related constants
 	emptyDomainID = "10000000-0000-f000-f000-000000000000"
@@ -90,6 +91,10 @@ const (
 	// Row Constants for Replication Task DLQ Row. Source cluster name will be used as WorkflowID.
 	rowTypeDLQDomainID = "10000000-6000-f000-f000-000000000000"
 	rowTypeDLQRunID    = "30000000-6000-f000-f000-000000000000"
+	// Row Constants for History Task DLQ Row
+	rowTypeHistoryTaskDLQDomainID   = "10000000-8000-f000-f000-000000000000"
+	rowTypeHistoryTaskDLQWorkflowID = "20000000-8000-f000-f000-000000000000"
+	rowTypeHistoryTaskDLQRunID      = "30000000-8000-f000-f000-000000000000"
 	// Special TaskId constants
 	rowTypeExecutionTaskID = int64(-10)
 	rowTypeShardTaskID     = int64(-11)
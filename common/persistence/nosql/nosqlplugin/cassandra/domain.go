@@ -37,6 +37,7 @@ const (
 	constDomainPartition     = 0
 	domainMetadataRecordName = "cadence-domain-metadata"
 	emptyFailoverEndTime     = int64(0)
+	selectDomainByIDPageSize = 100
 )
 
 const (
@@ -59,7 +60,11 @@ const (
 		`visibility_archival_status: ?, ` +
 		`visibility_archival_uri: ?, ` +
 		`bad_binaries: ?,` +
-		`bad_binaries_encoding: ?` +
+		`bad_binaries_encoding: ?, ` +
+		`requests_per_second: ?, ` +
+		`max_concurrent_workflows: ?, ` +
+		`max_pending_activities: ?, ` +
+		`max_history_size: ?` +
 		`}`
 
 	templateDomainReplicationConfigType = `{` +
@@ -75,6 +80,10 @@ const (
 		`FROM domains ` +
 		`WHERE id = ?`
 
+	templateUpdateDomainNameQuery = `UPDATE domains ` +
+		`SET domain = {name: ?} ` +
+		`WHERE id = ?`
+
 	templateDeleteDomainQuery = `DELETE FROM domains ` +
 		`WHERE id = ?`
 
@@ -88,6 +97,8 @@ const (
 		`config.history_archival_status, config.history_archival_uri, ` +
 		`config.visibility_archival_status, config.visibility_archival_uri, ` +
 		`config.bad_binaries, config.bad_binaries_encoding, ` +
+		`config.requests_per_second, config.max_concurrent_workflows, ` +
+		`config.max_pending_activities, config.max_history_size, ` +
 		`replication_config.active_cluster_name, replication_config.clusters, ` +
 		`is_global_domain, ` +
 		`config_version, ` +
@@ -130,12 +141,19 @@ const (
 		`WHERE domains_partition = ? ` +
 		`and name = ?`
 
+	templateDeleteDomainByNameQueryWithinBatchV2 = `DELETE FROM domains_by_name_v2 ` +
+		`WHERE domains_partition = ? ` +
+		`and name = ? ` +
+		`IF EXISTS`
+
 	templateListDomainQueryV2 = `SELECT name, domain.id, domain.name, domain.status, domain.description, ` +
 		`domain.owner_email, domain.data, config.retention, config.emit_metric, ` +
 		`config.archival_bucket, config.archival_status, ` +
 		`config.history_archival_status, config.history_archival_uri, ` +
 		`config.visibility_archival_status, config.visibility_archival_uri, ` +
 		`config.bad_binaries, config.bad_binaries_encoding, ` +
+		`config.requests_per_second, config.max_concurrent_workflows, ` +
+		`config.max_pending_activities, config.max_history_size, ` +
 		`replication_config.active_cluster_name, replication_config.clusters, ` +
 		`is_global_domain, ` +
 		`config_version, ` +
@@ -194,6 +212,10 @@ func (db *cdb) InsertDomain(
 		row.Config.VisibilityArchivalURI,
 		row.Config.BadBinaries.Data,
 		string(row.Config.BadBinaries.Encoding),
+		row.Config.Limits.RequestsPerSecond,
+		row.Config.Limits.MaxConcurrentWorkflows,
+		row.Config.Limits.MaxPendingActivities,
+		row.Config.Limits.MaxHistorySize,
 		row.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(row.ReplicationConfig.Clusters),
 		row.IsGlobalDomain,
@@ -292,6 +314,10 @@ func (db *cdb) UpdateDomain(
 		row.Config.VisibilityArchivalURI,
 		row.Config.BadBinaries.Data,
 		string(row.Config.BadBinaries.Encoding),
+		row.Config.Limits.RequestsPerSecond,
+		row.Config.Limits.MaxConcurrentWorkflows,
+		row.Config.Limits.MaxPendingActivities,
+		row.Config.Limits.MaxHistorySize,
 		row.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(row.ReplicationConfig.Clusters),
 		row.ConfigVersion,
@@ -323,6 +349,109 @@ func (db *cdb) UpdateDomain(
 	return nil
 }
 
+// Rename domain
+// The rename is a single conditional batch on the domains_by_name_v2 partition: the new
+// name is inserted only if absent, the old one removed only if still present, and the
+// metadata notification version is bumped. Cassandra does not allow conditional batches
+// to span tables, so the id to name entry in domains is only a lookup hint: it is
+// refreshed after the batch, and SelectDomain falls back to the domains_by_name_v2
+// partition and repairs the hint whenever it points to a stale name.
+func (db *cdb) RenameDomain(
+	ctx context.Context,
+	previousName string,
+	row *nosqlplugin.DomainRow,
+) error {
+	batch := db.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	failoverEndTime := emptyFailoverEndTime
+	if row.FailoverEndTime != nil {
+		failoverEndTime = row.FailoverEndTime.UnixNano()
+	}
+	batch.Query(templateCreateDomainByNameQueryWithinBatchV2,
+		constDomainPartition,
+		row.Info.Name,
+		row.Info.ID,
+		row.Info.Name,
+		row.Info.Status,
+		row.Info.Description,
+		row.Info.OwnerEmail,
+		row.Info.Data,
+		common.DurationToDays(row.Config.Retention),
+		row.Config.EmitMetric,
+		row.Config.ArchivalBucket,
+		row.Config.ArchivalStatus,
+		row.Config.HistoryArchivalStatus,
+		row.Config.HistoryArchivalURI,
+		row.Config.VisibilityArchivalStatus,
+		row.Config.VisibilityArchivalURI,
+		row.Config.BadBinaries.Data,
+		string(row.Config.BadBinaries.Encoding),
+		row.Config.Limits.RequestsPerSecond,
+		row.Config.Limits.MaxConcurrentWorkflows,
+		row.Config.Limits.MaxPendingActivities,
+		row.Config.Limits.MaxHistorySize,
+		row.ReplicationConfig.ActiveClusterName,
+		p.SerializeClusterConfigs(row.ReplicationConfig.Clusters),
+		row.IsGlobalDomain,
+		row.ConfigVersion,
+		row.FailoverVersion,
+		row.FailoverNotificationVersion,
+		row.PreviousFailoverVersion,
+		failoverEndTime,
+		row.LastUpdatedTime.UnixNano(),
+		row.NotificationVersion,
+	)
+	batch.Query(templateDeleteDomainByNameQueryWithinBatchV2,
+		constDomainPartition,
+		previousName,
+	)
+	db.updateMetadataBatch(batch, row.NotificationVersion)
+
+	previous := make(map[string]interface{})
+	applied, iter, err := db.session.MapExecuteBatchCAS(batch, previous)
+	defer func() {
+		if iter != nil {
+			_ = iter.Close()
+		}
+	}()
+
+	if err != nil {
+		return err
+	}
+	if !applied {
+		for {
+			if name, ok := previous["name"].(string); ok && name == row.Info.Name {
+				return &types.DomainAlreadyExistsError{
+					Message: fmt.Sprintf("Domain %v already exists", row.Info.Name),
+				}
+			}
+
+			previous = make(map[string]interface{})
+			if !iter.MapScan(previous) {
+				break
+			}
+		}
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+
+	db.updateDomainNameHint(ctx, row.Info.ID, row.Info.Name)
+	return nil
+}
+
+func (db *cdb) updateDomainNameHint(
+	ctx context.Context,
+	domainID string,
+	domainName string,
+) {
+	err := db.session.Query(templateUpdateDomainNameQuery, domainName, domainID).WithContext(ctx).Exec()
+	if err != nil {
+		db.logger.Warn("Failed to update domain name hint.",
+			tag.WorkflowDomainID(domainID),
+			tag.WorkflowDomainName(domainName),
+			tag.Error(err),
+		)
+	}
+}
+
 // Get one domain data, either by domainID or domainName
 func (db *cdb) SelectDomain(
 	ctx context.Context,
@@ -335,16 +464,60 @@ func (db *cdb) SelectDomain(
 		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
 	}
 
-	var query gocql.Query
-	var err error
-	if domainID != nil {
-		query = db.session.Query(templateGetDomainQuery, domainID).WithContext(ctx)
-		err = query.Scan(&domainName)
+	if domainID == nil {
+		return db.selectDomainByName(ctx, *domainName)
+	}
+
+	query := db.session.Query(templateGetDomainQuery, domainID).WithContext(ctx)
+	err := query.Scan(&domainName)
+	if err != nil {
+		return nil, err
+	}
+	row, err := db.selectDomainByName(ctx, *domainName)
+	switch {
+	case err == nil && row.Info.ID == *domainID:
+		return row, nil
+	case err != nil && !db.client.IsNotFoundError(err):
+		return nil, err
+	}
+
+	// the name hint is stale after a rename whose hint update did not go through
+	row, err = db.selectDomainByIDFromNameRows(ctx, *domainID)
+	if err != nil {
+		return nil, err
+	}
+	db.updateDomainNameHint(ctx, row.Info.ID, row.Info.Name)
+	return row, nil
+}
+
+func (db *cdb) selectDomainByIDFromNameRows(
+	ctx context.Context,
+	domainID string,
+) (*nosqlplugin.DomainRow, error) {
+	var pageToken []byte
+	for {
+		rows, nextPageToken, err := db.SelectAllDomains(ctx, selectDomainByIDPageSize, pageToken)
 		if err != nil {
 			return nil, err
 		}
+		for _, row := range rows {
+			if row.Info.ID == domainID {
+				return row, nil
+			}
+		}
+		if len(nextPageToken) == 0 {
+			return nil, &types.EntityNotExistsError{
+				Message: fmt.Sprintf("Domain %v does not exist.", domainID),
+			}
+		}
+		pageToken = nextPageToken
 	}
+}
 
+func (db *cdb) selectDomainByName(
+	ctx context.Context,
+	domainName string,
+) (*nosqlplugin.DomainRow, error) {
 	info := &p.DomainInfo{}
 	config := &nosqlplugin.NoSQLInternalDomainConfig{}
 	replicationConfig := &p.DomainReplicationConfig{}
@@ -364,8 +537,8 @@ func (db *cdb) SelectDomain(
 	var isGlobalDomain bool
 	var retentionDays int32
 
-	query = db.session.Query(templateGetDomainByNameQueryV2, constDomainPartition, domainName).WithContext(ctx)
-	err = query.Scan(
+	query := db.session.Query(templateGetDomainByNameQueryV2, constDomainPartition, domainName).WithContext(ctx)
+	err := query.Scan(
 		&info.ID,
 		&info.Name,
 		&info.Status,
@@ -382,6 +555,10 @@ func (db *cdb) SelectDomain(
 		&config.VisibilityArchivalURI,
 		&badBinariesData,
 		&badBinariesDataEncoding,
+		&config.Limits.RequestsPerSecond,
+		&config.Limits.MaxConcurrentWorkflows,
+		&config.Limits.MaxPendingActivities,
+		&config.Limits.MaxHistorySize,
 		&replicationConfig.ActiveClusterName,
 		&replicationClusters,
 		&isGlobalDomain,
@@ -467,6 +644,10 @@ func (db *cdb) SelectAllDomains(
 		&domain.Config.VisibilityArchivalURI,
 		&badBinariesData,
 		&badBinariesDataEncoding,
+		&domain.Config.Limits.RequestsPerSecond,
+		&domain.Config.Limits.MaxConcurrentWorkflows,
+		&domain.Config.Limits.MaxPendingActivities,
+		&domain.Config.Limits.MaxHistorySize,
 		&domain.ReplicationConfig.ActiveClusterName,
 		&replicationClusters,
 		&domain.IsGlobalDomain,
@@ -533,7 +714,7 @@ func (db *cdb) DeleteDomain(
 	} else {
 		var ID string
 		query := db.session.Query(templateGetDomainByNameQueryV2, constDomainPartition, *domainName).WithContext(ctx)
-		err := query.Scan(&ID, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		err := query.Scan(&ID, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		if err != nil {
 			if db.client.IsNotFoundError(err) {
 				return nil
@@ -0,0 +1,97 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+const (
+	templateInsertDomainAuditLogQuery       = `INSERT INTO domain_audit_log (domain_id, event_id, data, data_encoding) VALUES(?, ?, ?, ?) IF NOT EXISTS`
+	templateGetLastDomainAuditLogEventQuery = `SELECT event_id FROM domain_audit_log WHERE domain_id = ? ORDER BY event_id DESC LIMIT 1`
+	templateGetDomainAuditLogsQuery         = `SELECT domain_id, event_id, data, data_encoding FROM domain_audit_log WHERE domain_id = ? and event_id > ? LIMIT ?`
+)
+
+// InsertIntoDomainAuditLog inserts an entry into the audit log of a domain
+// Must return ConditionFailure error if the event ID already exists
+func (db *cdb) InsertIntoDomainAuditLog(
+	ctx context.Context,
+	row *nosqlplugin.DomainAuditLogRow,
+) error {
+	query := db.session.Query(templateInsertDomainAuditLogQuery, row.DomainID, row.EventID, row.Data, row.DataEncoding).WithContext(ctx)
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return err
+	}
+
+	if !applied {
+		return nosqlplugin.NewConditionFailure("domain_audit_log")
+	}
+	return nil
+}
+
+// SelectLastDomainAuditLogEventID returns the ID of the last entry of the audit log of a domain
+func (db *cdb) SelectLastDomainAuditLogEventID(
+	ctx context.Context,
+	domainID string,
+) (int64, error) {
+	var eventID int64
+	query := db.session.Query(templateGetLastDomainAuditLogEventQuery, domainID).WithContext(ctx)
+	if err := query.Scan(&eventID); err != nil {
+		return 0, err
+	}
+	return eventID, nil
+}
+
+// SelectDomainAuditLogs reads the entries of the audit log of a domain starting from the exclusiveBeginEventID
+func (db *cdb) SelectDomainAuditLogs(
+	ctx context.Context,
+	domainID string,
+	exclusiveBeginEventID int64,
+	maxRows int,
+) ([]*nosqlplugin.DomainAuditLogRow, error) {
+	query := db.session.Query(templateGetDomainAuditLogsQuery,
+		domainID,
+		exclusiveBeginEventID,
+		maxRows,
+	).WithContext(ctx)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, fmt.Errorf("SelectDomainAuditLogs operation failed. Not able to create query iterator")
+	}
+
+	var result []*nosqlplugin.DomainAuditLogRow
+	row := &nosqlplugin.DomainAuditLogRow{}
+	for iter.Scan(&row.DomainID, &row.EventID, &row.Data, &row.DataEncoding) {
+		result = append(result, row)
+		row = &nosqlplugin.DomainAuditLogRow{}
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
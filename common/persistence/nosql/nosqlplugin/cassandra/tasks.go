@@ -51,7 +51,8 @@ const (
 		`type: ?, ` +
 		`ack_level: ?, ` +
 		`kind: ?, ` +
-		`last_updated: ? ` +
+		`last_updated: ?, ` +
+		`paused: ? ` +
 		`}`
 
 	templateTaskType = `{` +
@@ -170,6 +171,7 @@ func (db *cdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListF
 	ackLevel := tlDB["ack_level"].(int64)
 	taskListKind := tlDB["kind"].(int)
 	lastUpdatedTime := tlDB["last_updated"].(time.Time)
+	paused, _ := tlDB["paused"].(bool)
 
 	return &nosqlplugin.TaskListRow{
 		DomainID:     filter.DomainID,
@@ -180,6 +182,7 @@ func (db *cdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListF
 		LastUpdatedTime: lastUpdatedTime,
 		AckLevel:        ackLevel,
 		RangeID:         rangeID,
+		Paused:          paused,
 	}, nil
 }
 
@@ -199,6 +202,7 @@ func (db *cdb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow)
 		0,
 		row.TaskListKind,
 		row.LastUpdatedTime,
+		row.Paused,
 	).WithContext(ctx)
 
 	previous := make(map[string]interface{})
@@ -225,6 +229,7 @@ func (db *cdb) UpdateTaskList(
 		row.AckLevel,
 		row.TaskListKind,
 		row.LastUpdatedTime,
+		row.Paused,
 		row.DomainID,
 		row.TaskListName,
 		row.TaskListType,
@@ -288,6 +293,7 @@ func (db *cdb) UpdateTaskListWithTTL(
 		row.AckLevel,
 		row.TaskListKind,
 		time.Now(),
+		row.Paused,
 		row.DomainID,
 		row.TaskListName,
 		row.TaskListType,
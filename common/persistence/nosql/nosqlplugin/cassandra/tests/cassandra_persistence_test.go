@@ -84,3 +84,17 @@ func TestQueuePersistence(t *testing.T) {
 	s.TestBase.Setup()
 	suite.Run(t, s)
 }
+
+func TestConfigStorePersistence(t *testing.T) {
+	s := new(persistencetests.ConfigStorePersistenceSuite)
+	s.TestBase = public.NewTestBaseWithPublicCassandra(&persistencetests.TestBaseOptions{})
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestDomainAuditPersistence(t *testing.T) {
+	s := new(persistencetests.DomainAuditPersistenceSuite)
+	s.TestBase = public.NewTestBaseWithPublicCassandra(&persistencetests.TestBaseOptions{})
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
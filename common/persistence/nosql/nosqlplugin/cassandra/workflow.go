@@ -743,6 +743,89 @@ func (db *cdb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int,
 	return query.Exec()
 }
 
+func (db *cdb) InsertHistoryTaskDLQTask(ctx context.Context, shardID int, task nosqlplugin.HistoryTaskDLQTask) error {
+	data, encoding := p.FromDataBlob(task.Task)
+	query := db.session.Query(templateCreateHistoryTaskDLQQuery,
+		shardID,
+		rowTypeHistoryTaskDLQ,
+		rowTypeHistoryTaskDLQDomainID,
+		rowTypeHistoryTaskDLQWorkflowID,
+		rowTypeHistoryTaskDLQRunID,
+		data,
+		encoding,
+		defaultVisibilityTimestamp,
+		task.TaskID,
+	).WithContext(ctx)
+
+	return query.Exec()
+}
+
+func (db *cdb) SelectHistoryTaskDLQTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryTaskDLQTask, []byte, error) {
+	query := db.session.Query(templateGetHistoryTaskDLQQuery,
+		shardID,
+		rowTypeHistoryTaskDLQ,
+		rowTypeHistoryTaskDLQDomainID,
+		rowTypeHistoryTaskDLQWorkflowID,
+		rowTypeHistoryTaskDLQRunID,
+		defaultVisibilityTimestamp,
+		exclusiveMinTaskID,
+		inclusiveMaxTaskID,
+	).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, nil, &types.InternalServiceError{
+			Message: "SelectHistoryTaskDLQTasksOrderByTaskID operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	var tasks []*nosqlplugin.HistoryTaskDLQTask
+	var taskID int64
+	var data []byte
+	var encoding string
+	for iter.Scan(&taskID, &data, &encoding) {
+		tasks = append(tasks, &nosqlplugin.HistoryTaskDLQTask{
+			TaskID: taskID,
+			Task:   p.NewDataBlob(data, common.EncodingType(encoding)),
+		})
+		data = nil
+		encoding = ""
+	}
+	nextPageToken := getNextPageToken(iter)
+	err := iter.Close()
+
+	return tasks, nextPageToken, err
+}
+
+func (db *cdb) DeleteHistoryTaskDLQTask(ctx context.Context, shardID int, taskID int64) error {
+	query := db.session.Query(templateCompleteHistoryTaskDLQQuery,
+		shardID,
+		rowTypeHistoryTaskDLQ,
+		rowTypeHistoryTaskDLQDomainID,
+		rowTypeHistoryTaskDLQWorkflowID,
+		rowTypeHistoryTaskDLQRunID,
+		defaultVisibilityTimestamp,
+		taskID,
+	).WithContext(ctx)
+
+	return query.Exec()
+}
+
+func (db *cdb) RangeDeleteHistoryTaskDLQTasks(ctx context.Context, shardID int, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
+	query := db.session.Query(templateRangeCompleteHistoryTaskDLQQuery,
+		shardID,
+		rowTypeHistoryTaskDLQ,
+		rowTypeHistoryTaskDLQDomainID,
+		rowTypeHistoryTaskDLQWorkflowID,
+		rowTypeHistoryTaskDLQRunID,
+		defaultVisibilityTimestamp,
+		exclusiveBeginTaskID,
+		inclusiveEndTaskID,
+	).WithContext(ctx)
+
+	return query.Exec()
+}
+
 func (db *cdb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.ReplicationTask, shardCondition nosqlplugin.ShardCondition) error {
 	if len(tasks) == 0 {
 		return nil
@@ -48,6 +48,8 @@ const (
 		`last_updated_time: ?, ` +
 		`create_request_id: ?, ` +
 		`signal_count: ?, ` +
+		`signal_requested_timestamps: ?, ` +
+		`accepted_updates: ?, ` +
 		`history_size: ?, ` +
 		`decision_version: ?, ` +
 		`decision_schedule_id: ?, ` +
@@ -60,6 +62,7 @@ const (
 		`decision_original_scheduled_timestamp: ?, ` +
 		`cancel_requested: ?, ` +
 		`cancel_request_id: ?, ` +
+		`paused: ?, ` +
 		`sticky_task_list: ?, ` +
 		`sticky_schedule_to_start_timeout: ?,` +
 		`client_library_version: ?, ` +
@@ -150,6 +153,7 @@ const (
 		`heart_beat_timeout: ?, ` +
 		`cancel_requested: ?, ` +
 		`cancel_request_id: ?, ` +
+		`paused: ?, ` +
 		`last_hb_updated_time: ?, ` +
 		`timer_task_status: ?, ` +
 		`attempt: ?, ` +
@@ -253,6 +257,10 @@ workflow_state = ? ` +
 		`shard_id, type, domain_id, workflow_id, run_id, replication, visibility_ts, task_id) ` +
 		`VALUES(?, ?, ?, ?, ?, ` + templateReplicationTaskType + `, ?, ?)`
 
+	templateCreateHistoryTaskDLQQuery = `INSERT INTO executions (` +
+		`shard_id, type, domain_id, workflow_id, run_id, dlq_task, dlq_task_encoding, visibility_ts, task_id) ` +
+		`VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
 	templateCreateTimerTaskQuery = `INSERT INTO executions (` +
 		`shard_id, type, domain_id, workflow_id, run_id, timer, visibility_ts, task_id) ` +
 		`VALUES(?, ?, ?, ?, ?, ` + templateTimerTaskType + `, ?, ?)`
@@ -572,6 +580,17 @@ workflow_state = ? ` +
 		`and task_id > ? ` +
 		`and task_id <= ?`
 
+	templateGetHistoryTaskDLQQuery = `SELECT task_id, dlq_task, dlq_task_encoding ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id > ? ` +
+		`and task_id <= ?`
+
 	templateGetDLQSizeQuery = `SELECT count(1) as count ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
@@ -616,6 +635,10 @@ workflow_state = ? ` +
 
 	templateRangeCompleteReplicationTaskQuery = templateRangeCompleteTransferTaskQuery
 
+	templateCompleteHistoryTaskDLQQuery = templateCompleteTransferTaskQuery
+
+	templateRangeCompleteHistoryTaskDLQQuery = templateRangeCompleteTransferTaskQuery
+
 	templateGetTimerTasksQuery = `SELECT timer ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
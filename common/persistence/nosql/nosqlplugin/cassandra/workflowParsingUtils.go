@@ -104,6 +104,10 @@ func parseWorkflowExecutionInfo(
 			info.CreateRequestID = v.(gocql.UUID).String()
 		case "signal_count":
 			info.SignalCount = int32(v.(int))
+		case "signal_requested_timestamps":
+			info.SignalRequestedTimestamps = v.(map[string]int64)
+		case "accepted_updates":
+			info.AcceptedUpdates = v.(map[string]int64)
 		case "history_size":
 			info.HistorySize = v.(int64)
 		case "decision_version":
@@ -128,6 +132,8 @@ func parseWorkflowExecutionInfo(
 			info.CancelRequested = v.(bool)
 		case "cancel_request_id":
 			info.CancelRequestID = v.(string)
+		case "paused":
+			info.Paused = v.(bool)
 		case "sticky_task_list":
 			info.StickyTaskList = v.(string)
 		case "sticky_schedule_to_start_timeout":
@@ -264,6 +270,8 @@ func parseActivityInfo(
 			info.CancelRequested = v.(bool)
 		case "cancel_request_id":
 			info.CancelRequestID = v.(int64)
+		case "paused":
+			info.Paused = v.(bool)
 		case "last_hb_updated_time":
 			info.LastHeartBeatUpdatedTime = v.(time.Time)
 		case "timer_task_status":
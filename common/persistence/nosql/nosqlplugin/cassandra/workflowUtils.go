@@ -896,6 +896,7 @@ func resetActivityInfoMap(
 		aInfo["heart_beat_timeout"] = int32(a.HeartbeatTimeout.Seconds())
 		aInfo["cancel_requested"] = a.CancelRequested
 		aInfo["cancel_request_id"] = a.CancelRequestID
+		aInfo["paused"] = a.Paused
 		aInfo["last_hb_updated_time"] = a.LastHeartBeatUpdatedTime
 		aInfo["timer_task_status"] = a.TimerTaskStatus
 		aInfo["attempt"] = a.Attempt
@@ -947,6 +948,7 @@ func (db *cdb) updateActivityInfos(
 			int32(a.HeartbeatTimeout.Seconds()),
 			a.CancelRequested,
 			a.CancelRequestID,
+			a.Paused,
 			a.LastHeartBeatUpdatedTime,
 			a.TimerTaskStatus,
 			a.Attempt,
@@ -1221,6 +1223,8 @@ func (db *cdb) updateWorkflowExecution(
 		execution.LastUpdatedTimestamp,
 		execution.CreateRequestID,
 		execution.SignalCount,
+		execution.SignalRequestedTimestamps,
+		execution.AcceptedUpdates,
 		execution.HistorySize,
 		execution.DecisionVersion,
 		execution.DecisionScheduleID,
@@ -1233,6 +1237,7 @@ func (db *cdb) updateWorkflowExecution(
 		execution.DecisionOriginalScheduledTimestamp.UnixNano(),
 		execution.CancelRequested,
 		execution.CancelRequestID,
+		execution.Paused,
 		execution.StickyTaskList,
 		int32(execution.StickyScheduleToStartTimeout.Seconds()),
 		execution.ClientLibraryVersion,
@@ -1315,6 +1320,8 @@ func (db *cdb) createWorkflowExecution(
 		execution.LastUpdatedTimestamp,
 		execution.CreateRequestID,
 		execution.SignalCount,
+		execution.SignalRequestedTimestamps,
+		execution.AcceptedUpdates,
 		execution.HistorySize,
 		execution.DecisionVersion,
 		execution.DecisionScheduleID,
@@ -1327,6 +1334,7 @@ func (db *cdb) createWorkflowExecution(
 		execution.DecisionOriginalScheduledTimestamp.UnixNano(),
 		execution.CancelRequested,
 		execution.CancelRequestID,
+		execution.Paused,
 		execution.StickyTaskList,
 		int32(execution.StickyScheduleToStartTimeout.Seconds()),
 		execution.ClientLibraryVersion,
@@ -52,6 +52,15 @@ func (db *ddb) UpdateDomain(
 	panic("TODO")
 }
 
+// Rename domain
+func (db *ddb) RenameDomain(
+	ctx context.Context,
+	previousName string,
+	row *nosqlplugin.DomainRow,
+) error {
+	panic("TODO")
+}
+
 // Get one domain data, either by domainID or domainName
 func (db *ddb) SelectDomain(
 	ctx context.Context,
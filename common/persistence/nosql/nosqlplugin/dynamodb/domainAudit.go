@@ -0,0 +1,54 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+// InsertIntoDomainAuditLog inserts an entry into the audit log of a domain
+// Return ConditionFailure if the event ID already exists
+func (db *ddb) InsertIntoDomainAuditLog(
+	ctx context.Context,
+	row *nosqlplugin.DomainAuditLogRow,
+) error {
+	panic("TODO")
+}
+
+// SelectLastDomainAuditLogEventID returns the ID of the last entry of the audit log of a domain
+func (db *ddb) SelectLastDomainAuditLogEventID(
+	ctx context.Context,
+	domainID string,
+) (int64, error) {
+	panic("TODO")
+}
+
+// SelectDomainAuditLogs reads the entries of the audit log of a domain starting from the exclusiveBeginEventID
+func (db *ddb) SelectDomainAuditLogs(
+	ctx context.Context,
+	domainID string,
+	exclusiveBeginEventID int64,
+	maxRows int,
+) ([]*nosqlplugin.DomainAuditLogRow, error) {
+	panic("TODO")
+}
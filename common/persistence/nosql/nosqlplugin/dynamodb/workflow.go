@@ -158,3 +158,19 @@ func (db *ddb) DeleteReplicationDLQTask(ctx context.Context, shardID int, source
 func (db *ddb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
 	panic("TODO")
 }
+
+func (db *ddb) InsertHistoryTaskDLQTask(ctx context.Context, shardID int, task nosqlplugin.HistoryTaskDLQTask) error {
+	panic("TODO")
+}
+
+func (db *ddb) SelectHistoryTaskDLQTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryTaskDLQTask, []byte, error) {
+	panic("TODO")
+}
+
+func (db *ddb) DeleteHistoryTaskDLQTask(ctx context.Context, shardID int, taskID int64) error {
+	panic("TODO")
+}
+
+func (db *ddb) RangeDeleteHistoryTaskDLQTasks(ctx context.Context, shardID int, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
+	panic("TODO")
+}
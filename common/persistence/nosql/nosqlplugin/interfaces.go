@@ -68,6 +68,8 @@ type (
 		VisibilityCRUD
 		TaskCRUD
 		WorkflowCRUD
+		ConfigStoreCRUD
+		DomainAuditLogCRUD
 	}
 
 	// ClientErrorChecker checks for common nosql errors on client
@@ -174,6 +176,10 @@ type (
 		// Update domain data
 		// Must return ConditionFailure error if update condition doesn't match
 		UpdateDomain(ctx context.Context, row *DomainRow) error
+		// Move a domain record from previousName to row.Info.Name, keeping the domain ID
+		// return types.DomainAlreadyExistsError error if the new name is taken
+		// Must return ConditionFailure error if update condition doesn't match
+		RenameDomain(ctx context.Context, previousName string, row *DomainRow) error
 		// Get one domain data, either by domainID or domainName
 		SelectDomain(ctx context.Context, domainID *string, domainName *string) (*DomainRow, error)
 		// Get all domain data
@@ -184,6 +190,42 @@ type (
 		SelectDomainMetadata(ctx context.Context) (int64, error)
 	}
 
+	/**
+	* ConfigStoreCRUD is for the versioned records of the config store
+	*
+	* Recommendation: use one table, every update inserts the next version of a record as a new row
+	*
+	* Significant columns:
+	* cluster_config: partition key(rowType), range key(version)
+	 */
+	ConfigStoreCRUD interface {
+		// InsertConfig inserts a new version of a record
+		// Must return conditionFailed error if the version already exists
+		InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error
+		// SelectLatestConfig returns the latest version of a record
+		// Must return NotFound error if the record was never written
+		SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error)
+	}
+
+	/**
+	* DomainAuditLogCRUD is for the audit log of each domain
+	*
+	* Recommendation: use one table partitioned by domain, so that reading the log of a domain never scans other domains
+	*
+	* Significant columns:
+	* domain_audit_log: partition key(domainID), range key(eventID)
+	 */
+	DomainAuditLogCRUD interface {
+		// InsertIntoDomainAuditLog inserts an entry into the audit log of a domain
+		// Must return conditionFailed error if the event ID already exists
+		InsertIntoDomainAuditLog(ctx context.Context, row *DomainAuditLogRow) error
+		// SelectLastDomainAuditLogEventID returns the ID of the last entry of the audit log of a domain
+		// Must return NotFound error if the domain has no entry
+		SelectLastDomainAuditLogEventID(ctx context.Context, domainID string) (int64, error)
+		// SelectDomainAuditLogs reads the entries of the audit log of a domain starting from the exclusiveBeginEventID
+		SelectDomainAuditLogs(ctx context.Context, domainID string, exclusiveBeginEventID int64, maxRows int) ([]*DomainAuditLogRow, error)
+	}
+
 	/**
 	* ShardCRUD is for shard storage of workflow execution.
 
@@ -507,5 +549,15 @@ type (
 		DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error
 		// delete a range of replication DLQ tasks
 		RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error
+
+		// history_task_dlq
+		// insert a transfer or timer task to history task DLQ
+		InsertHistoryTaskDLQTask(ctx context.Context, shardID int, task HistoryTaskDLQTask) error
+		// within a shard, paging through history task DLQ tasks order by taskID(ASC), filtered by minTaskID(exclusive) and maxTaskID(inclusive)
+		SelectHistoryTaskDLQTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*HistoryTaskDLQTask, []byte, error)
+		// delete a single history task DLQ task
+		DeleteHistoryTaskDLQTask(ctx context.Context, shardID int, taskID int64) error
+		// delete a range of history task DLQ tasks
+		RangeDeleteHistoryTaskDLQTasks(ctx context.Context, shardID int, exclusiveBeginTaskID, inclusiveEndTaskID int64) error
 	}
 )
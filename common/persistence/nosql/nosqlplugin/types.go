@@ -88,6 +88,9 @@ type (
 	// TransferTask is for regular transfer task
 	TransferTask = persistence.TransferTaskInfo
 
+	// HistoryTaskDLQTask is transfer or timer task parked in history task DLQ
+	HistoryTaskDLQTask = persistence.InternalHistoryTaskDLQInfo
+
 	// ShardCondition is the condition for making changes within a shard
 	ShardCondition struct {
 		ShardID int
@@ -170,6 +173,7 @@ type (
 		TaskListKind    int
 		AckLevel        int64
 		LastUpdatedTime time.Time
+		Paused          bool
 	}
 
 	// ListTaskListResult is the result of list tasklists
@@ -217,6 +221,7 @@ type (
 		VisibilityArchivalStatus types.ArchivalStatus
 		VisibilityArchivalURI    string
 		BadBinaries              *persistence.DataBlob
+		Limits                   types.DomainLimits
 	}
 
 	// SelectMessagesBetweenRequest is a request struct for SelectMessagesBetween
@@ -241,6 +246,14 @@ type (
 		Payload   []byte
 	}
 
+	// DomainAuditLogRow defines the row struct for domain audit log
+	DomainAuditLogRow struct {
+		DomainID     string
+		EventID      int64
+		Data         []byte
+		DataEncoding string
+	}
+
 	// QueueMetadataRow defines the row struct for metadata
 	QueueMetadataRow struct {
 		QueueType        persistence.QueueType
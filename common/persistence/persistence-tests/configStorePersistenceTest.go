@@ -0,0 +1,102 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencetests
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// ConfigStorePersistenceSuite contains config store persistence tests
+	ConfigStorePersistenceSuite struct {
+		TestBase
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+// SetupSuite implementation
+func (s *ConfigStorePersistenceSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+}
+
+// SetupTest implementation
+func (s *ConfigStorePersistenceSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+// TearDownSuite implementation
+func (s *ConfigStorePersistenceSuite) TearDownSuite() {
+	s.TearDownWorkflowStore()
+}
+
+// TestRemoteClustersConfig tests writing and reading versions of the remote clusters record
+func (s *ConfigStorePersistenceSuite) TestRemoteClustersConfig() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	_, err := s.ConfigStoreManager.FetchConfig(ctx, p.RemoteClustersConfigType)
+	s.IsType(&types.EntityNotExistsError{}, err)
+
+	timestamp := time.Now().Truncate(time.Millisecond)
+	for version := int64(1); version <= 3; version++ {
+		err = s.ConfigStoreManager.UpdateConfig(ctx, &p.ConfigStoreEntry{
+			ConfigType: p.RemoteClustersConfigType,
+			Version:    version,
+			Timestamp:  timestamp,
+			Values:     p.NewDataBlob([]byte{byte(version)}, common.EncodingTypeJSON),
+		})
+		s.NoError(err)
+	}
+
+	entry, err := s.ConfigStoreManager.FetchConfig(ctx, p.RemoteClustersConfigType)
+	s.NoError(err)
+	s.Equal(p.RemoteClustersConfigType, entry.ConfigType)
+	s.Equal(int64(3), entry.Version)
+	s.True(timestamp.Equal(entry.Timestamp))
+	s.Equal(p.NewDataBlob([]byte{3}, common.EncodingTypeJSON), entry.Values)
+
+	err = s.ConfigStoreManager.UpdateConfig(ctx, &p.ConfigStoreEntry{
+		ConfigType: p.RemoteClustersConfigType,
+		Version:    3,
+		Timestamp:  timestamp,
+		Values:     p.NewDataBlob([]byte{4}, common.EncodingTypeJSON),
+	})
+	s.IsType(&p.ConditionFailedError{}, err)
+
+	entry, err = s.ConfigStoreManager.FetchConfig(ctx, p.RemoteClustersConfigType)
+	s.NoError(err)
+	s.Equal(p.NewDataBlob([]byte{3}, common.EncodingTypeJSON), entry.Values)
+}
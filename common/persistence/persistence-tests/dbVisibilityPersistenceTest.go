@@ -822,21 +822,6 @@ func (s *DBVisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 			},
 			expected: nil,
 		},
-		{
-			request: &p.UpsertWorkflowExecutionRequest{
-				DomainUUID:         "",
-				Domain:             "",
-				Execution:          types.WorkflowExecution{},
-				WorkflowTypeName:   "",
-				StartTimestamp:     0,
-				ExecutionTimestamp: 0,
-				WorkflowTimeout:    0,
-				TaskID:             0,
-				Memo:               nil,
-				SearchAttributes:   nil,
-			},
-			expected: p.NewOperationNotSupportErrorForVis(),
-		},
 	}
 
 	for _, test := range tests {
@@ -844,6 +829,50 @@ func (s *DBVisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 	}
 }
 
+// TestUpsertWorkflowExecutionMemo test
+func (s *DBVisibilityPersistenceSuite) TestUpsertWorkflowExecutionMemo() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	testDomainUUID := uuid.New()
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "visibility-upsert-memo-test",
+		RunID:      "1b2d2f2a-0a47-4a35-9b9e-8e4d4a9b8f1c",
+	}
+
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+	startReq := &p.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		WorkflowTimeout:  60,
+		Memo:             &types.Memo{Fields: map[string][]byte{"key": []byte("value")}},
+	}
+	s.Nil(s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, startReq))
+
+	upsertedMemo := &types.Memo{Fields: map[string][]byte{"key": []byte("new-value"), "other": []byte("other-value")}}
+	s.Nil(s.VisibilityMgr.UpsertWorkflowExecution(ctx, &p.UpsertWorkflowExecutionRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		WorkflowTimeout:  60,
+		Memo:             upsertedMemo,
+	}))
+
+	resp, err := s.VisibilityMgr.ListOpenWorkflowExecutions(ctx, &p.ListWorkflowExecutionsRequest{
+		DomainUUID:   testDomainUUID,
+		PageSize:     1,
+		EarliestTime: startTime,
+		LatestTime:   startTime,
+	})
+	s.Nil(err)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(upsertedMemo, resp.Executions[0].Memo)
+}
+
 func (s *DBVisibilityPersistenceSuite) assertClosedExecutionEquals(
 	req *p.RecordWorkflowExecutionClosedRequest, resp *types.WorkflowExecutionInfo) {
 	s.Equal(req.Execution.RunID, resp.Execution.RunID)
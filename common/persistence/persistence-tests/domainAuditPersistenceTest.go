@@ -0,0 +1,99 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencetests
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/pborman/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// DomainAuditPersistenceSuite contains domain audit log persistence tests
+	DomainAuditPersistenceSuite struct {
+		TestBase
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+// SetupSuite implementation
+func (s *DomainAuditPersistenceSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+}
+
+// SetupTest implementation
+func (s *DomainAuditPersistenceSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+// TearDownSuite implementation
+func (s *DomainAuditPersistenceSuite) TearDownSuite() {
+	s.TearDownWorkflowStore()
+}
+
+// TestAppendAndReadDomainAuditLogs tests that the audit log of each domain is numbered and read independently
+func (s *DomainAuditPersistenceSuite) TestAppendAndReadDomainAuditLogs() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	domainID := uuid.New()
+	otherDomainID := uuid.New()
+
+	entries, err := s.DomainAuditManager.ReadDomainAuditLogs(ctx, domainID, common.EmptyMessageID, 10)
+	s.NoError(err)
+	s.Empty(entries)
+
+	for i := 0; i < 3; i++ {
+		s.NoError(s.DomainAuditManager.AppendDomainAuditLog(ctx, domainID, p.NewDataBlob([]byte{byte(i)}, common.EncodingTypeThriftRW)))
+	}
+	s.NoError(s.DomainAuditManager.AppendDomainAuditLog(ctx, otherDomainID, p.NewDataBlob([]byte{byte(10)}, common.EncodingTypeThriftRW)))
+
+	entries, err = s.DomainAuditManager.ReadDomainAuditLogs(ctx, domainID, common.EmptyMessageID, 2)
+	s.NoError(err)
+	s.Equal([]*p.DomainAuditLogEntry{
+		{DomainID: domainID, EventID: 0, Payload: p.NewDataBlob([]byte{0}, common.EncodingTypeThriftRW)},
+		{DomainID: domainID, EventID: 1, Payload: p.NewDataBlob([]byte{1}, common.EncodingTypeThriftRW)},
+	}, entries)
+
+	entries, err = s.DomainAuditManager.ReadDomainAuditLogs(ctx, domainID, 1, 2)
+	s.NoError(err)
+	s.Equal([]*p.DomainAuditLogEntry{
+		{DomainID: domainID, EventID: 2, Payload: p.NewDataBlob([]byte{2}, common.EncodingTypeThriftRW)},
+	}, entries)
+
+	entries, err = s.DomainAuditManager.ReadDomainAuditLogs(ctx, otherDomainID, common.EmptyMessageID, 10)
+	s.NoError(err)
+	s.Equal([]*p.DomainAuditLogEntry{
+		{DomainID: otherDomainID, EventID: 0, Payload: p.NewDataBlob([]byte{10}, common.EncodingTypeThriftRW)},
+	}, entries)
+}
@@ -2635,7 +2635,7 @@ func (s *ExecutionManagerSuite) TestTimerTasksComplete() {
 	updatedInfo.LastProcessedEvent = int64(2)
 	tasks := []p.Task{
 		&p.WorkflowTimeoutTask{now.Add(2 * time.Second), 2, 12},
-		&p.DeleteHistoryEventTask{now.Add(2 * time.Second), 3, 13},
+		&p.DeleteHistoryEventTask{now.Add(2 * time.Second), 3, 13, p.DeleteHistoryEventTypeDefault},
 		&p.ActivityTimeoutTask{now.Add(3 * time.Second), 4, int(types.TimeoutTypeStartToClose), 7, 0, 14},
 		&p.UserTimerTask{now.Add(3 * time.Second), 5, 7, 15},
 	}
@@ -2706,7 +2706,7 @@ func (s *ExecutionManagerSuite) TestTimerTasksRangeComplete() {
 	tasks := []p.Task{
 		&p.DecisionTimeoutTask{time.Now(), 1, 2, 3, int(types.TimeoutTypeStartToClose), 11},
 		&p.WorkflowTimeoutTask{time.Now(), 2, 12},
-		&p.DeleteHistoryEventTask{time.Now(), 3, 13},
+		&p.DeleteHistoryEventTask{time.Now(), 3, 13, p.DeleteHistoryEventTypeDefault},
 		&p.ActivityTimeoutTask{time.Now(), 4, int(types.TimeoutTypeStartToClose), 7, 0, 14},
 		&p.UserTimerTask{time.Now(), 5, 7, 15},
 	}
@@ -5181,6 +5181,89 @@ func (s *ExecutionManagerSuite) TestReplicationDLQ() {
 	s.Len(resp.Tasks, 0)
 }
 
+// TestHistoryTaskDLQ test
+func (s *ExecutionManagerSuite) TestHistoryTaskDLQ() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	domainID := uuid.New()
+	transferTask := &p.HistoryTaskDLQInfo{
+		TransferTaskInfo: &p.TransferTaskInfo{
+			DomainID:   domainID,
+			WorkflowID: uuid.New(),
+			RunID:      uuid.New(),
+			TaskID:     1,
+			TaskType:   p.TransferTaskTypeActivityTask,
+		},
+		Attempt:     100,
+		LastError:   "some error",
+		CreatedTime: time.Now(),
+	}
+	timerTask := &p.HistoryTaskDLQInfo{
+		TimerTaskInfo: &p.TimerTaskInfo{
+			DomainID:            domainID,
+			WorkflowID:          uuid.New(),
+			RunID:               uuid.New(),
+			TaskID:              2,
+			TaskType:            p.TaskTypeUserTimer,
+			VisibilityTimestamp: time.Now(),
+		},
+		Attempt:     100,
+		LastError:   "some other error",
+		CreatedTime: time.Now(),
+	}
+	for _, dlqTask := range []*p.HistoryTaskDLQInfo{transferTask, timerTask} {
+		err := s.ExecutionManager.PutHistoryTaskToDLQ(ctx, &p.PutHistoryTaskToDLQRequest{TaskInfo: dlqTask})
+		s.NoError(err)
+	}
+
+	resp, err := s.ExecutionManager.GetHistoryTasksFromDLQ(ctx, &p.GetHistoryTasksFromDLQRequest{
+		ReadLevel:    0,
+		MaxReadLevel: 2,
+		BatchSize:    1,
+	})
+	s.NoError(err)
+	s.Len(resp.Tasks, 1)
+	s.Equal(int64(1), resp.Tasks[0].GetTaskID())
+	s.Equal(domainID, resp.Tasks[0].TransferTaskInfo.DomainID)
+	s.Equal("some error", resp.Tasks[0].LastError)
+	s.NotEmpty(resp.NextPageToken)
+
+	resp, err = s.ExecutionManager.GetHistoryTasksFromDLQ(ctx, &p.GetHistoryTasksFromDLQRequest{
+		ReadLevel:     0,
+		MaxReadLevel:  2,
+		BatchSize:     1,
+		NextPageToken: resp.NextPageToken,
+	})
+	s.NoError(err)
+	s.Len(resp.Tasks, 1)
+	s.Equal(int64(2), resp.Tasks[0].GetTaskID())
+	s.NotNil(resp.Tasks[0].TimerTaskInfo)
+
+	err = s.ExecutionManager.DeleteHistoryTaskFromDLQ(ctx, &p.DeleteHistoryTaskFromDLQRequest{TaskID: 1})
+	s.NoError(err)
+	resp, err = s.ExecutionManager.GetHistoryTasksFromDLQ(ctx, &p.GetHistoryTasksFromDLQRequest{
+		ReadLevel:    0,
+		MaxReadLevel: 2,
+		BatchSize:    2,
+	})
+	s.NoError(err)
+	s.Len(resp.Tasks, 1)
+
+	err = s.ExecutionManager.RangeDeleteHistoryTaskFromDLQ(ctx, &p.RangeDeleteHistoryTaskFromDLQRequest{
+		ExclusiveBeginTaskID: 0,
+		InclusiveEndTaskID:   2,
+	})
+	s.NoError(err)
+	resp, err = s.ExecutionManager.GetHistoryTasksFromDLQ(ctx, &p.GetHistoryTasksFromDLQRequest{
+		ReadLevel:    0,
+		MaxReadLevel: 2,
+		BatchSize:    2,
+	})
+	s.NoError(err)
+	s.Len(resp.Tasks, 0)
+}
+
 // TestCreateFailoverMarkerTasks test
 func (s *ExecutionManagerSuite) TestCreateFailoverMarkerTasks() {
 	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
@@ -395,6 +395,47 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskListSticky() {
 	s.NoError(err)
 }
 
+// TestLeaseAndUpdateTaskListPaused test
+func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskListPaused() {
+	domainID := uuid.New()
+	taskList := "paused-tasklist"
+
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	response, err := s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	tli := response.TaskListInfo
+	s.False(tli.Paused)
+
+	_, err = s.TaskMgr.UpdateTaskList(ctx, &p.UpdateTaskListRequest{
+		TaskListInfo: &p.TaskListInfo{
+			DomainID: domainID,
+			Name:     taskList,
+			TaskType: p.TaskListTypeActivity,
+			RangeID:  tli.RangeID,
+			AckLevel: 0,
+			Kind:     p.TaskListKindNormal,
+			Paused:   true,
+		},
+	})
+	s.NoError(err)
+
+	// paused state must survive an ownership change
+	response, err = s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	s.True(response.TaskListInfo.Paused)
+	s.EqualValues(tli.RangeID+1, response.TaskListInfo.RangeID)
+}
+
 func (s *MatchingPersistenceSuite) deleteAllTaskList() {
 	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
 	defer cancel()
@@ -783,6 +783,12 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 			},
 		},
 	}
+	updatedLimits := types.DomainLimits{
+		RequestsPerSecond:      100,
+		MaxConcurrentWorkflows: 1000,
+		MaxPendingActivities:   50,
+		MaxHistorySize:         1024 * 1024,
+	}
 
 	err3 := m.UpdateDomain(
 		ctx,
@@ -802,6 +808,7 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 			VisibilityArchivalStatus: updatedVisibilityArchivalStatus,
 			VisibilityArchivalURI:    updatedVisibilityArchivalURI,
 			BadBinaries:              testBinaries,
+			Limits:                   updatedLimits,
 		},
 		&p.DomainReplicationConfig{
 			ActiveClusterName: updateClusterActive,
@@ -834,6 +841,7 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 	m.Equal(updatedVisibilityArchivalStatus, resp4.Config.VisibilityArchivalStatus)
 	m.Equal(updatedVisibilityArchivalURI, resp4.Config.VisibilityArchivalURI)
 	m.Equal(testBinaries, resp4.Config.BadBinaries)
+	m.Equal(updatedLimits, resp4.Config.Limits)
 	m.Equal(updateClusterActive, resp4.ReplicationConfig.ActiveClusterName)
 	m.Equal(len(updateClusters), len(resp4.ReplicationConfig.Clusters))
 	for index := range clusters {
@@ -863,6 +871,7 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 	m.Equal(updatedHistoryArchivalURI, resp5.Config.HistoryArchivalURI)
 	m.Equal(updatedVisibilityArchivalStatus, resp5.Config.VisibilityArchivalStatus)
 	m.Equal(updatedVisibilityArchivalURI, resp5.Config.VisibilityArchivalURI)
+	m.Equal(updatedLimits, resp5.Config.Limits)
 	m.Equal(updateClusterActive, resp5.ReplicationConfig.ActiveClusterName)
 	m.Equal(len(updateClusters), len(resp5.ReplicationConfig.Clusters))
 	for index := range clusters {
@@ -896,6 +905,7 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 			VisibilityArchivalStatus: updatedVisibilityArchivalStatus,
 			VisibilityArchivalURI:    updatedVisibilityArchivalURI,
 			BadBinaries:              testBinaries,
+			Limits:                   updatedLimits,
 		},
 		&p.DomainReplicationConfig{
 			ActiveClusterName: updateClusterActive,
@@ -928,6 +938,7 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 	m.Equal(updatedVisibilityArchivalStatus, resp6.Config.VisibilityArchivalStatus)
 	m.Equal(updatedVisibilityArchivalURI, resp6.Config.VisibilityArchivalURI)
 	m.Equal(testBinaries, resp6.Config.BadBinaries)
+	m.Equal(updatedLimits, resp6.Config.Limits)
 	m.Equal(updateClusterActive, resp6.ReplicationConfig.ActiveClusterName)
 	m.Equal(len(updateClusters), len(resp6.ReplicationConfig.Clusters))
 	for index := range clusters {
@@ -959,6 +970,12 @@ func (m *MetadataPersistenceSuiteV2) TestDeleteDomain() {
 	historyArchivalURI := "test://history/uri"
 	visibilityArchivalStatus := types.ArchivalStatusEnabled
 	visibilityArchivalURI := "test://visibility/uri"
+	limits := types.DomainLimits{
+		RequestsPerSecond:      100,
+		MaxConcurrentWorkflows: 1000,
+		MaxPendingActivities:   50,
+		MaxHistorySize:         1024 * 1024,
+	}
 
 	clusterActive := "some random active cluster name"
 	clusterStandby := "some random standby cluster name"
@@ -991,6 +1008,7 @@ func (m *MetadataPersistenceSuiteV2) TestDeleteDomain() {
 			HistoryArchivalURI:       historyArchivalURI,
 			VisibilityArchivalStatus: visibilityArchivalStatus,
 			VisibilityArchivalURI:    visibilityArchivalURI,
+			Limits:                   limits,
 		},
 		&p.DomainReplicationConfig{
 			ActiveClusterName: clusterActive,
@@ -1007,6 +1025,7 @@ func (m *MetadataPersistenceSuiteV2) TestDeleteDomain() {
 	resp2, err2 := m.GetDomain(ctx, "", name)
 	m.NoError(err2)
 	m.NotNil(resp2)
+	m.Equal(limits, resp2.Config.Limits)
 
 	err3 := m.DeleteDomain(ctx, "", name)
 	m.NoError(err3)
@@ -1039,6 +1058,7 @@ func (m *MetadataPersistenceSuiteV2) TestDeleteDomain() {
 			HistoryArchivalURI:       historyArchivalURI,
 			VisibilityArchivalStatus: visibilityArchivalStatus,
 			VisibilityArchivalURI:    visibilityArchivalURI,
+			Limits:                   limits,
 		},
 		&p.DomainReplicationConfig{
 			ActiveClusterName: clusterActive,
@@ -1066,6 +1086,98 @@ func (m *MetadataPersistenceSuiteV2) TestDeleteDomain() {
 	m.Nil(resp9)
 }
 
+// TestRenameDomain test
+func (m *MetadataPersistenceSuiteV2) TestRenameDomain() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	id := uuid.New()
+	name := "rename-domain-test-name"
+	newName := "rename-domain-test-new-name"
+	takenName := "rename-domain-test-taken-name"
+	clusterActive := "some random active cluster name"
+	clusters := []*p.ClusterReplicationConfig{
+		{
+			ClusterName: clusterActive,
+		},
+	}
+	config := &p.DomainConfig{
+		Retention:  10,
+		EmitMetric: true,
+	}
+	replicationConfig := &p.DomainReplicationConfig{
+		ActiveClusterName: clusterActive,
+		Clusters:          clusters,
+	}
+
+	_, err := m.CreateDomain(
+		ctx,
+		&p.DomainInfo{ID: id, Name: name, Status: p.DomainStatusRegistered, Data: map[string]string{}},
+		config,
+		replicationConfig,
+		true,
+		1,
+		0,
+		0,
+	)
+	m.NoError(err)
+	_, err = m.CreateDomain(
+		ctx,
+		&p.DomainInfo{ID: uuid.New(), Name: takenName, Status: p.DomainStatusRegistered, Data: map[string]string{}},
+		config,
+		replicationConfig,
+		true,
+		1,
+		0,
+		0,
+	)
+	m.NoError(err)
+
+	resp, err := m.GetDomain(ctx, id, "")
+	m.NoError(err)
+	metadata, err := m.DomainManager.GetMetadata(ctx)
+	m.NoError(err)
+
+	rename := func(to string) error {
+		info := *resp.Info
+		info.Name = to
+		return m.DomainManager.RenameDomain(ctx, &p.RenameDomainRequest{
+			PreviousName:   name,
+			IsGlobalDomain: resp.IsGlobalDomain,
+			UpdateDomainRequest: p.UpdateDomainRequest{
+				Info:                        &info,
+				Config:                      resp.Config,
+				ReplicationConfig:           resp.ReplicationConfig,
+				ConfigVersion:               resp.ConfigVersion + 1,
+				FailoverVersion:             resp.FailoverVersion,
+				FailoverNotificationVersion: resp.FailoverNotificationVersion,
+				PreviousFailoverVersion:     resp.PreviousFailoverVersion,
+				NotificationVersion:         metadata.NotificationVersion,
+				LastUpdatedTime:             time.Now().UnixNano(),
+			},
+		})
+	}
+
+	err = rename(takenName)
+	m.IsType(&types.DomainAlreadyExistsError{}, err)
+
+	err = rename(newName)
+	m.NoError(err)
+
+	_, err = m.GetDomain(ctx, "", name)
+	m.IsType(&types.EntityNotExistsError{}, err)
+
+	byName, err := m.GetDomain(ctx, "", newName)
+	m.NoError(err)
+	m.Equal(id, byName.Info.ID)
+	m.Equal(resp.ConfigVersion+1, byName.ConfigVersion)
+	m.True(byName.IsGlobalDomain)
+
+	byID, err := m.GetDomain(ctx, id, "")
+	m.NoError(err)
+	m.Equal(newName, byID.Info.Name)
+}
+
 // TestListDomains test
 func (m *MetadataPersistenceSuiteV2) TestListDomains() {
 	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
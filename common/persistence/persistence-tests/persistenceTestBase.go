@@ -80,6 +80,8 @@ type (
 		HistoryV2Mgr              p.HistoryManager
 		DomainManager             p.DomainManager
 		DomainReplicationQueueMgr p.QueueManager
+		DomainAuditManager        p.DomainAuditManager
+		ConfigStoreManager        p.ConfigStoreManager
 		ShardInfo                 *p.ShardInfo
 		TaskIDGenerator           TransferTaskIDGenerator
 		ClusterMetadata           cluster.Metadata
@@ -244,6 +246,12 @@ func (s *TestBase) Setup() {
 	queue, err := factory.NewDomainReplicationQueueManager()
 	s.fatalOnError("Create DomainReplicationQueue", err)
 	s.DomainReplicationQueueMgr = queue
+
+	s.ConfigStoreManager, err = factory.NewConfigStoreManager()
+	s.fatalOnError("NewConfigStoreManager", err)
+
+	s.DomainAuditManager, err = factory.NewDomainAuditManager()
+	s.fatalOnError("NewDomainAuditManager", err)
 }
 
 func (s *TestBase) fatalOnError(msg string, err error) {
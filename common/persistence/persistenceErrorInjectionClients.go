@@ -89,6 +89,18 @@ type (
 		errorRate   float64
 		logger      log.Logger
 	}
+
+	configStoreErrorInjectionPersistenceClient struct {
+		persistence ConfigStoreManager
+		errorRate   float64
+		logger      log.Logger
+	}
+
+	domainAuditErrorInjectionPersistenceClient struct {
+		persistence DomainAuditManager
+		errorRate   float64
+		logger      log.Logger
+	}
 )
 
 var _ ShardManager = (*shardErrorInjectionPersistenceClient)(nil)
@@ -98,6 +110,8 @@ var _ HistoryManager = (*historyErrorInjectionPersistenceClient)(nil)
 var _ DomainManager = (*metadataErrorInjectionPersistenceClient)(nil)
 var _ VisibilityManager = (*visibilityErrorInjectionPersistenceClient)(nil)
 var _ QueueManager = (*queueErrorInjectionPersistenceClient)(nil)
+var _ ConfigStoreManager = (*configStoreErrorInjectionPersistenceClient)(nil)
+var _ DomainAuditManager = (*domainAuditErrorInjectionPersistenceClient)(nil)
 
 // NewShardPersistenceErrorInjectionClient creates an error injection client to manage shards
 func NewShardPersistenceErrorInjectionClient(
@@ -190,6 +204,32 @@ func NewQueuePersistenceErrorInjectionClient(
 	}
 }
 
+// NewConfigStorePersistenceErrorInjectionClient creates an error injection client to manage config store
+func NewConfigStorePersistenceErrorInjectionClient(
+	persistence ConfigStoreManager,
+	errorRate float64,
+	logger log.Logger,
+) ConfigStoreManager {
+	return &configStoreErrorInjectionPersistenceClient{
+		persistence: persistence,
+		errorRate:   errorRate,
+		logger:      logger,
+	}
+}
+
+// NewDomainAuditPersistenceErrorInjectionClient creates an error injection client to manage domain audit logs
+func NewDomainAuditPersistenceErrorInjectionClient(
+	persistence DomainAuditManager,
+	errorRate float64,
+	logger log.Logger,
+) DomainAuditManager {
+	return &domainAuditErrorInjectionPersistenceClient{
+		persistence: persistence,
+		errorRate:   errorRate,
+		logger:      logger,
+	}
+}
+
 func (p *shardErrorInjectionPersistenceClient) GetName() string {
 	return p.persistence.GetName()
 }
@@ -877,6 +917,103 @@ func (p *workflowExecutionErrorInjectionPersistenceClient) CreateFailoverMarkerT
 	return persistenceErr
 }
 
+func (p *workflowExecutionErrorInjectionPersistenceClient) PutHistoryTaskToDLQ(
+	ctx context.Context,
+	request *PutHistoryTaskToDLQRequest,
+) error {
+	fakeErr := generateFakeError(p.errorRate)
+
+	var persistenceErr error
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		persistenceErr = p.persistence.PutHistoryTaskToDLQ(ctx, request)
+	}
+
+	if fakeErr != nil {
+		p.logger.Error(msgInjectedFakeErr,
+			tag.StoreOperationPutHistoryTaskToDLQ,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.StoreError(persistenceErr),
+		)
+		return fakeErr
+	}
+	return persistenceErr
+}
+
+func (p *workflowExecutionErrorInjectionPersistenceClient) GetHistoryTasksFromDLQ(
+	ctx context.Context,
+	request *GetHistoryTasksFromDLQRequest,
+) (*GetHistoryTasksFromDLQResponse, error) {
+	fakeErr := generateFakeError(p.errorRate)
+
+	var response *GetHistoryTasksFromDLQResponse
+	var persistenceErr error
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		response, persistenceErr = p.persistence.GetHistoryTasksFromDLQ(ctx, request)
+	}
+
+	if fakeErr != nil {
+		p.logger.Error(msgInjectedFakeErr,
+			tag.StoreOperationGetHistoryTasksFromDLQ,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.StoreError(persistenceErr),
+		)
+		return nil, fakeErr
+	}
+	return response, persistenceErr
+}
+
+func (p *workflowExecutionErrorInjectionPersistenceClient) DeleteHistoryTaskFromDLQ(
+	ctx context.Context,
+	request *DeleteHistoryTaskFromDLQRequest,
+) error {
+	fakeErr := generateFakeError(p.errorRate)
+
+	var persistenceErr error
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		persistenceErr = p.persistence.DeleteHistoryTaskFromDLQ(ctx, request)
+	}
+
+	if fakeErr != nil {
+		p.logger.Error(msgInjectedFakeErr,
+			tag.StoreOperationDeleteHistoryTaskFromDLQ,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.StoreError(persistenceErr),
+		)
+		return fakeErr
+	}
+	return persistenceErr
+}
+
+func (p *workflowExecutionErrorInjectionPersistenceClient) RangeDeleteHistoryTaskFromDLQ(
+	ctx context.Context,
+	request *RangeDeleteHistoryTaskFromDLQRequest,
+) error {
+	fakeErr := generateFakeError(p.errorRate)
+
+	var persistenceErr error
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		persistenceErr = p.persistence.RangeDeleteHistoryTaskFromDLQ(ctx, request)
+	}
+
+	if fakeErr != nil {
+		p.logger.Error(msgInjectedFakeErr,
+			tag.StoreOperationRangeDeleteHistoryTaskFromDLQ,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.StoreError(persistenceErr),
+		)
+		return fakeErr
+	}
+	return persistenceErr
+}
+
 func (p *workflowExecutionErrorInjectionPersistenceClient) GetTimerIndexTasks(
 	ctx context.Context,
 	request *GetTimerIndexTasksRequest,
@@ -1311,6 +1448,30 @@ func (p *metadataErrorInjectionPersistenceClient) DeleteDomainByName(
 	return persistenceErr
 }
 
+func (p *metadataErrorInjectionPersistenceClient) RenameDomain(
+	ctx context.Context,
+	request *RenameDomainRequest,
+) error {
+	fakeErr := generateFakeError(p.errorRate)
+
+	var persistenceErr error
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		persistenceErr = p.persistence.RenameDomain(ctx, request)
+	}
+
+	if fakeErr != nil {
+		p.logger.Error(msgInjectedFakeErr,
+			tag.StoreOperationRenameDomain,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.StoreError(persistenceErr),
+		)
+		return fakeErr
+	}
+	return persistenceErr
+}
+
 func (p *metadataErrorInjectionPersistenceClient) ListDomains(
 	ctx context.Context,
 	request *ListDomainsRequest,
@@ -2259,6 +2420,115 @@ func (p *queueErrorInjectionPersistenceClient) Close() {
 	p.persistence.Close()
 }
 
+func (p *configStoreErrorInjectionPersistenceClient) FetchConfig(
+	ctx context.Context,
+	configType ConfigType,
+) (*ConfigStoreEntry, error) {
+	fakeErr := generateFakeError(p.errorRate)
+
+	var response *ConfigStoreEntry
+	var persistenceErr error
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		response, persistenceErr = p.persistence.FetchConfig(ctx, configType)
+	}
+
+	if fakeErr != nil {
+		p.logger.Error(msgInjectedFakeErr,
+			tag.StoreOperationFetchConfig,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.StoreError(persistenceErr),
+		)
+		return nil, fakeErr
+	}
+	return response, persistenceErr
+}
+
+func (p *configStoreErrorInjectionPersistenceClient) UpdateConfig(
+	ctx context.Context,
+	entry *ConfigStoreEntry,
+) error {
+	fakeErr := generateFakeError(p.errorRate)
+
+	var persistenceErr error
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		persistenceErr = p.persistence.UpdateConfig(ctx, entry)
+	}
+
+	if fakeErr != nil {
+		p.logger.Error(msgInjectedFakeErr,
+			tag.StoreOperationUpdateConfig,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.StoreError(persistenceErr),
+		)
+		return fakeErr
+	}
+	return persistenceErr
+}
+
+func (p *configStoreErrorInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *domainAuditErrorInjectionPersistenceClient) AppendDomainAuditLog(
+	ctx context.Context,
+	domainID string,
+	payload *DataBlob,
+) error {
+	fakeErr := generateFakeError(p.errorRate)
+
+	var persistenceErr error
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		persistenceErr = p.persistence.AppendDomainAuditLog(ctx, domainID, payload)
+	}
+
+	if fakeErr != nil {
+		p.logger.Error(msgInjectedFakeErr,
+			tag.StoreOperationAppendDomainAuditLog,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.StoreError(persistenceErr),
+		)
+		return fakeErr
+	}
+	return persistenceErr
+}
+
+func (p *domainAuditErrorInjectionPersistenceClient) ReadDomainAuditLogs(
+	ctx context.Context,
+	domainID string,
+	lastEventID int64,
+	maxCount int,
+) ([]*DomainAuditLogEntry, error) {
+	fakeErr := generateFakeError(p.errorRate)
+
+	var response []*DomainAuditLogEntry
+	var persistenceErr error
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		response, persistenceErr = p.persistence.ReadDomainAuditLogs(ctx, domainID, lastEventID, maxCount)
+	}
+
+	if fakeErr != nil {
+		p.logger.Error(msgInjectedFakeErr,
+			tag.StoreOperationReadDomainAuditLogs,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.StoreError(persistenceErr),
+		)
+		return nil, fakeErr
+	}
+	return response, persistenceErr
+}
+
+func (p *domainAuditErrorInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
 func shouldForwardCallToPersistence(
 	err error,
 ) bool {
@@ -71,6 +71,18 @@ type (
 		persistence  QueueManager
 		logger       log.Logger
 	}
+
+	configStorePersistenceClient struct {
+		metricClient metrics.Client
+		persistence  ConfigStoreManager
+		logger       log.Logger
+	}
+
+	domainAuditPersistenceClient struct {
+		metricClient metrics.Client
+		persistence  DomainAuditManager
+		logger       log.Logger
+	}
 )
 
 var _ ShardManager = (*shardPersistenceClient)(nil)
@@ -80,6 +92,8 @@ var _ HistoryManager = (*historyPersistenceClient)(nil)
 var _ DomainManager = (*metadataPersistenceClient)(nil)
 var _ VisibilityManager = (*visibilityPersistenceClient)(nil)
 var _ QueueManager = (*queuePersistenceClient)(nil)
+var _ ConfigStoreManager = (*configStorePersistenceClient)(nil)
+var _ DomainAuditManager = (*domainAuditPersistenceClient)(nil)
 
 // NewShardPersistenceMetricsClient creates a client to manage shards
 func NewShardPersistenceMetricsClient(
@@ -172,6 +186,32 @@ func NewQueuePersistenceMetricsClient(
 	}
 }
 
+// NewConfigStorePersistenceMetricsClient creates a client to manage config store
+func NewConfigStorePersistenceMetricsClient(
+	persistence ConfigStoreManager,
+	metricClient metrics.Client,
+	logger log.Logger,
+) ConfigStoreManager {
+	return &configStorePersistenceClient{
+		persistence:  persistence,
+		metricClient: metricClient,
+		logger:       logger,
+	}
+}
+
+// NewDomainAuditPersistenceMetricsClient creates a client to manage domain audit logs
+func NewDomainAuditPersistenceMetricsClient(
+	persistence DomainAuditManager,
+	metricClient metrics.Client,
+	logger log.Logger,
+) DomainAuditManager {
+	return &domainAuditPersistenceClient{
+		persistence:  persistence,
+		metricClient: metricClient,
+		logger:       logger,
+	}
+}
+
 func (p *shardPersistenceClient) GetName() string {
 	return p.persistence.GetName()
 }
@@ -681,6 +721,74 @@ func (p *workflowExecutionPersistenceClient) CreateFailoverMarkerTasks(
 	return err
 }
 
+func (p *workflowExecutionPersistenceClient) PutHistoryTaskToDLQ(
+	ctx context.Context,
+	request *PutHistoryTaskToDLQRequest,
+) error {
+	p.metricClient.IncCounter(metrics.PersistencePutHistoryTaskToDLQScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistencePutHistoryTaskToDLQScope, metrics.PersistenceLatency)
+	err := p.persistence.PutHistoryTaskToDLQ(ctx, request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistencePutHistoryTaskToDLQScope, err)
+	}
+
+	return err
+}
+
+func (p *workflowExecutionPersistenceClient) GetHistoryTasksFromDLQ(
+	ctx context.Context,
+	request *GetHistoryTasksFromDLQRequest,
+) (*GetHistoryTasksFromDLQResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetHistoryTasksFromDLQScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetHistoryTasksFromDLQScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetHistoryTasksFromDLQ(ctx, request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetHistoryTasksFromDLQScope, err)
+	}
+
+	return response, err
+}
+
+func (p *workflowExecutionPersistenceClient) DeleteHistoryTaskFromDLQ(
+	ctx context.Context,
+	request *DeleteHistoryTaskFromDLQRequest,
+) error {
+	p.metricClient.IncCounter(metrics.PersistenceDeleteHistoryTaskFromDLQScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteHistoryTaskFromDLQScope, metrics.PersistenceLatency)
+	err := p.persistence.DeleteHistoryTaskFromDLQ(ctx, request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceDeleteHistoryTaskFromDLQScope, err)
+	}
+
+	return err
+}
+
+func (p *workflowExecutionPersistenceClient) RangeDeleteHistoryTaskFromDLQ(
+	ctx context.Context,
+	request *RangeDeleteHistoryTaskFromDLQRequest,
+) error {
+	p.metricClient.IncCounter(metrics.PersistenceRangeDeleteHistoryTaskFromDLQScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceRangeDeleteHistoryTaskFromDLQScope, metrics.PersistenceLatency)
+	err := p.persistence.RangeDeleteHistoryTaskFromDLQ(ctx, request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceRangeDeleteHistoryTaskFromDLQScope, err)
+	}
+
+	return err
+}
+
 func (p *workflowExecutionPersistenceClient) GetTimerIndexTasks(
 	ctx context.Context,
 	request *GetTimerIndexTasksRequest,
@@ -1013,6 +1121,23 @@ func (p *metadataPersistenceClient) DeleteDomainByName(
 	return err
 }
 
+func (p *metadataPersistenceClient) RenameDomain(
+	ctx context.Context,
+	request *RenameDomainRequest,
+) error {
+	p.metricClient.IncCounter(metrics.PersistenceRenameDomainScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceRenameDomainScope, metrics.PersistenceLatency)
+	err := p.persistence.RenameDomain(ctx, request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceRenameDomainScope, err)
+	}
+
+	return err
+}
+
 func (p *metadataPersistenceClient) ListDomains(
 	ctx context.Context,
 	request *ListDomainsRequest,
@@ -1722,3 +1847,118 @@ func (p *queuePersistenceClient) updateErrorMetric(scope int, err error) {
 func (p *queuePersistenceClient) Close() {
 	p.persistence.Close()
 }
+
+func (p *configStorePersistenceClient) FetchConfig(
+	ctx context.Context,
+	configType ConfigType,
+) (*ConfigStoreEntry, error) {
+	p.metricClient.IncCounter(metrics.PersistenceFetchConfigScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceFetchConfigScope, metrics.PersistenceLatency)
+	result, err := p.persistence.FetchConfig(ctx, configType)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceFetchConfigScope, err)
+	}
+
+	return result, err
+}
+
+func (p *configStorePersistenceClient) UpdateConfig(
+	ctx context.Context,
+	entry *ConfigStoreEntry,
+) error {
+	p.metricClient.IncCounter(metrics.PersistenceUpdateConfigScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateConfigScope, metrics.PersistenceLatency)
+	err := p.persistence.UpdateConfig(ctx, entry)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceUpdateConfigScope, err)
+	}
+
+	return err
+}
+
+func (p *configStorePersistenceClient) updateErrorMetric(scope int, err error) {
+	switch err.(type) {
+	case *ConditionFailedError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrConditionFailedCounter)
+	case *types.EntityNotExistsError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrEntityNotExistsCounter)
+	case *TimeoutError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrTimeoutCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	case *types.ServiceBusyError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	default:
+		p.logger.Error("Operation failed with internal error.",
+			tag.Error(err), tag.MetricScope(scope))
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	}
+}
+
+func (p *configStorePersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *domainAuditPersistenceClient) AppendDomainAuditLog(
+	ctx context.Context,
+	domainID string,
+	payload *DataBlob,
+) error {
+	p.metricClient.IncCounter(metrics.PersistenceAppendDomainAuditLogScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceAppendDomainAuditLogScope, metrics.PersistenceLatency)
+	err := p.persistence.AppendDomainAuditLog(ctx, domainID, payload)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceAppendDomainAuditLogScope, err)
+	}
+
+	return err
+}
+
+func (p *domainAuditPersistenceClient) ReadDomainAuditLogs(
+	ctx context.Context,
+	domainID string,
+	lastEventID int64,
+	maxCount int,
+) ([]*DomainAuditLogEntry, error) {
+	p.metricClient.IncCounter(metrics.PersistenceReadDomainAuditLogsScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceReadDomainAuditLogsScope, metrics.PersistenceLatency)
+	result, err := p.persistence.ReadDomainAuditLogs(ctx, domainID, lastEventID, maxCount)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceReadDomainAuditLogsScope, err)
+	}
+
+	return result, err
+}
+
+func (p *domainAuditPersistenceClient) updateErrorMetric(scope int, err error) {
+	switch err.(type) {
+	case *ConditionFailedError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrConditionFailedCounter)
+	case *TimeoutError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrTimeoutCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	case *types.ServiceBusyError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	default:
+		p.logger.Error("Operation failed with internal error.",
+			tag.Error(err), tag.MetricScope(scope))
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	}
+}
+
+func (p *domainAuditPersistenceClient) Close() {
+	p.persistence.Close()
+}
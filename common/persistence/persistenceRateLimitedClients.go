@@ -75,6 +75,18 @@ type (
 		persistence QueueManager
 		logger      log.Logger
 	}
+
+	configStoreRateLimitedPersistenceClient struct {
+		rateLimiter quotas.Limiter
+		persistence ConfigStoreManager
+		logger      log.Logger
+	}
+
+	domainAuditRateLimitedPersistenceClient struct {
+		rateLimiter quotas.Limiter
+		persistence DomainAuditManager
+		logger      log.Logger
+	}
 )
 
 var _ ShardManager = (*shardRateLimitedPersistenceClient)(nil)
@@ -84,6 +96,8 @@ var _ HistoryManager = (*historyRateLimitedPersistenceClient)(nil)
 var _ DomainManager = (*metadataRateLimitedPersistenceClient)(nil)
 var _ VisibilityManager = (*visibilityRateLimitedPersistenceClient)(nil)
 var _ QueueManager = (*queueRateLimitedPersistenceClient)(nil)
+var _ ConfigStoreManager = (*configStoreRateLimitedPersistenceClient)(nil)
+var _ DomainAuditManager = (*domainAuditRateLimitedPersistenceClient)(nil)
 
 // NewShardPersistenceRateLimitedClient creates a client to manage shards
 func NewShardPersistenceRateLimitedClient(
@@ -176,6 +190,32 @@ func NewQueuePersistenceRateLimitedClient(
 	}
 }
 
+// NewConfigStorePersistenceRateLimitedClient creates a client to manage config store
+func NewConfigStorePersistenceRateLimitedClient(
+	persistence ConfigStoreManager,
+	rateLimiter quotas.Limiter,
+	logger log.Logger,
+) ConfigStoreManager {
+	return &configStoreRateLimitedPersistenceClient{
+		persistence: persistence,
+		rateLimiter: rateLimiter,
+		logger:      logger,
+	}
+}
+
+// NewDomainAuditPersistenceRateLimitedClient creates a client to manage domain audit logs
+func NewDomainAuditPersistenceRateLimitedClient(
+	persistence DomainAuditManager,
+	rateLimiter quotas.Limiter,
+	logger log.Logger,
+) DomainAuditManager {
+	return &domainAuditRateLimitedPersistenceClient{
+		persistence: persistence,
+		rateLimiter: rateLimiter,
+		logger:      logger,
+	}
+}
+
 func (p *shardRateLimitedPersistenceClient) GetName() string {
 	return p.persistence.GetName()
 }
@@ -523,6 +563,50 @@ func (p *workflowExecutionRateLimitedPersistenceClient) CreateFailoverMarkerTask
 	return err
 }
 
+func (p *workflowExecutionRateLimitedPersistenceClient) PutHistoryTaskToDLQ(
+	ctx context.Context,
+	request *PutHistoryTaskToDLQRequest,
+) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	return p.persistence.PutHistoryTaskToDLQ(ctx, request)
+}
+
+func (p *workflowExecutionRateLimitedPersistenceClient) GetHistoryTasksFromDLQ(
+	ctx context.Context,
+	request *GetHistoryTasksFromDLQRequest,
+) (*GetHistoryTasksFromDLQResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	return p.persistence.GetHistoryTasksFromDLQ(ctx, request)
+}
+
+func (p *workflowExecutionRateLimitedPersistenceClient) DeleteHistoryTaskFromDLQ(
+	ctx context.Context,
+	request *DeleteHistoryTaskFromDLQRequest,
+) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	return p.persistence.DeleteHistoryTaskFromDLQ(ctx, request)
+}
+
+func (p *workflowExecutionRateLimitedPersistenceClient) RangeDeleteHistoryTaskFromDLQ(
+	ctx context.Context,
+	request *RangeDeleteHistoryTaskFromDLQRequest,
+) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	return p.persistence.RangeDeleteHistoryTaskFromDLQ(ctx, request)
+}
+
 func (p *workflowExecutionRateLimitedPersistenceClient) GetTimerIndexTasks(
 	ctx context.Context,
 	request *GetTimerIndexTasksRequest,
@@ -732,6 +816,18 @@ func (p *metadataRateLimitedPersistenceClient) DeleteDomainByName(
 	return err
 }
 
+func (p *metadataRateLimitedPersistenceClient) RenameDomain(
+	ctx context.Context,
+	request *RenameDomainRequest,
+) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.RenameDomain(ctx, request)
+	return err
+}
+
 func (p *metadataRateLimitedPersistenceClient) ListDomains(
 	ctx context.Context,
 	request *ListDomainsRequest,
@@ -1180,3 +1276,58 @@ func (p *queueRateLimitedPersistenceClient) DeleteMessageFromDLQ(
 func (p *queueRateLimitedPersistenceClient) Close() {
 	p.persistence.Close()
 }
+
+func (p *configStoreRateLimitedPersistenceClient) FetchConfig(
+	ctx context.Context,
+	configType ConfigType,
+) (*ConfigStoreEntry, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	return p.persistence.FetchConfig(ctx, configType)
+}
+
+func (p *configStoreRateLimitedPersistenceClient) UpdateConfig(
+	ctx context.Context,
+	entry *ConfigStoreEntry,
+) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	return p.persistence.UpdateConfig(ctx, entry)
+}
+
+func (p *configStoreRateLimitedPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *domainAuditRateLimitedPersistenceClient) AppendDomainAuditLog(
+	ctx context.Context,
+	domainID string,
+	payload *DataBlob,
+) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	return p.persistence.AppendDomainAuditLog(ctx, domainID, payload)
+}
+
+func (p *domainAuditRateLimitedPersistenceClient) ReadDomainAuditLogs(
+	ctx context.Context,
+	domainID string,
+	lastEventID int64,
+	maxCount int,
+) ([]*DomainAuditLogEntry, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	return p.persistence.ReadDomainAuditLogs(ctx, domainID, lastEventID, maxCount)
+}
+
+func (p *domainAuditRateLimitedPersistenceClient) Close() {
+	p.persistence.Close()
+}
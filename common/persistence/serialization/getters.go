@@ -372,6 +372,38 @@ func (d *DomainInfo) GetLastUpdatedTimestamp() time.Time {
 	return time.Unix(0, 0)
 }
 
+// GetRequestsPerSecond internal sql blob getter
+func (d *DomainInfo) GetRequestsPerSecond() (o int32) {
+	if d != nil {
+		return d.RequestsPerSecond
+	}
+	return
+}
+
+// GetMaxConcurrentWorkflows internal sql blob getter
+func (d *DomainInfo) GetMaxConcurrentWorkflows() (o int32) {
+	if d != nil {
+		return d.MaxConcurrentWorkflows
+	}
+	return
+}
+
+// GetMaxPendingActivities internal sql blob getter
+func (d *DomainInfo) GetMaxPendingActivities() (o int32) {
+	if d != nil {
+		return d.MaxPendingActivities
+	}
+	return
+}
+
+// GetMaxHistorySize internal sql blob getter
+func (d *DomainInfo) GetMaxHistorySize() (o int64) {
+	if d != nil {
+		return d.MaxHistorySize
+	}
+	return
+}
+
 // GetCreatedTimestamp internal sql blob getter
 func (h *HistoryTreeInfo) GetCreatedTimestamp() time.Time {
 	if h != nil {
@@ -644,6 +676,22 @@ func (w *WorkflowExecutionInfo) GetSignalCount() (o int64) {
 	return
 }
 
+// GetSignalRequestedTimestamps internal sql blob getter
+func (w *WorkflowExecutionInfo) GetSignalRequestedTimestamps() (o map[string]int64) {
+	if w != nil {
+		return w.SignalRequestedTimestamps
+	}
+	return
+}
+
+// GetAcceptedUpdates internal sql blob getter
+func (w *WorkflowExecutionInfo) GetAcceptedUpdates() (o map[string]int64) {
+	if w != nil {
+		return w.AcceptedUpdates
+	}
+	return
+}
+
 // GetHistorySize internal sql blob getter
 func (w *WorkflowExecutionInfo) GetHistorySize() (o int64) {
 	if w != nil {
@@ -860,6 +908,14 @@ func (w *WorkflowExecutionInfo) GetCancelRequested() (o bool) {
 	return
 }
 
+// GetPaused internal sql blob getter
+func (w *WorkflowExecutionInfo) GetPaused() (o bool) {
+	if w != nil {
+		return w.Paused
+	}
+	return
+}
+
 // GetHasRetryPolicy internal sql blob getter
 func (w *WorkflowExecutionInfo) GetHasRetryPolicy() (o bool) {
 	if w != nil {
@@ -900,6 +956,14 @@ func (a *ActivityInfo) GetCancelRequestID() (o int64) {
 	return
 }
 
+// GetPaused internal sql blob getter
+func (a *ActivityInfo) GetPaused() (o bool) {
+	if a != nil {
+		return a.Paused
+	}
+	return
+}
+
 // GetTimerTaskStatus internal sql blob getter
 func (a *ActivityInfo) GetTimerTaskStatus() (o int32) {
 	if a != nil {
@@ -1396,6 +1460,14 @@ func (t *TaskListInfo) GetLastUpdated() time.Time {
 	return time.Unix(0, 0)
 }
 
+// GetPaused internal sql blob getter
+func (t *TaskListInfo) GetPaused() (o bool) {
+	if t != nil {
+		return t.Paused
+	}
+	return
+}
+
 // GetDomainID internal sql blob getter
 func (t *TransferTaskInfo) GetDomainID() (o []byte) {
 	if t != nil {
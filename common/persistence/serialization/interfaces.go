@@ -83,6 +83,10 @@ type (
 		FailoverEndTimestamp        *time.Time // TODO: There is logic checking if it's nil, should revisit this
 		PreviousFailoverVersion     int64
 		LastUpdatedTimestamp        time.Time
+		RequestsPerSecond           int32
+		MaxConcurrentWorkflows      int32
+		MaxPendingActivities        int32
+		MaxHistorySize              int64
 	}
 
 	// HistoryBranchRange blob in a serialization agnostic format
@@ -135,6 +139,7 @@ type (
 		CreateRequestID                    string
 		DecisionRequestID                  string
 		CancelRequestID                    string
+		Paused                             bool
 		StickyTaskList                     string
 		StickyScheduleToStartTimeout       time.Duration
 		RetryAttempt                       int64
@@ -150,6 +155,8 @@ type (
 		EventStoreVersion                  int32
 		EventBranchToken                   []byte
 		SignalCount                        int64
+		SignalRequestedTimestamps          map[string]int64
+		AcceptedUpdates                    map[string]int64
 		HistorySize                        int64
 		ClientLibraryVersion               string
 		ClientFeatureVersion               string
@@ -181,6 +188,7 @@ type (
 		HeartbeatTimeout         time.Duration
 		CancelRequested          bool
 		CancelRequestID          int64
+		Paused                   bool
 		TimerTaskStatus          int32
 		Attempt                  int32
 		TaskList                 string
@@ -254,6 +262,7 @@ type (
 		AckLevel        int64
 		ExpiryTimestamp time.Time
 		LastUpdated     time.Time
+		Paused          bool
 	}
 
 	// TransferTaskInfo blob in a serialization agnostic format
@@ -127,6 +127,10 @@ func domainInfoToThrift(info *DomainInfo) *sqlblobs.DomainInfo {
 		RetentionDays:               durationToDaysInt16Ptr(info.Retention),
 		FailoverEndTime:             unixNanoPtr(info.FailoverEndTimestamp),
 		LastUpdatedTime:             timeToUnixNanoPtr(info.LastUpdatedTimestamp),
+		RequestsPerSecond:           &info.RequestsPerSecond,
+		MaxConcurrentWorkflows:      &info.MaxConcurrentWorkflows,
+		MaxPendingActivities:        &info.MaxPendingActivities,
+		MaxHistorySize:              &info.MaxHistorySize,
 	}
 }
 
@@ -159,6 +163,10 @@ func domainInfoFromThrift(info *sqlblobs.DomainInfo) *DomainInfo {
 		Retention:                   common.DaysToDuration(int32(info.GetRetentionDays())),
 		FailoverEndTimestamp:        timePtr(info.FailoverEndTime),
 		LastUpdatedTimestamp:        timeFromUnixNano(info.GetLastUpdatedTime()),
+		RequestsPerSecond:           info.GetRequestsPerSecond(),
+		MaxConcurrentWorkflows:      info.GetMaxConcurrentWorkflows(),
+		MaxPendingActivities:        info.GetMaxPendingActivities(),
+		MaxHistorySize:              info.GetMaxHistorySize(),
 	}
 }
 
@@ -242,6 +250,7 @@ func workflowExecutionInfoToThrift(info *WorkflowExecutionInfo) *sqlblobs.Workfl
 		CreateRequestID:                         &info.CreateRequestID,
 		DecisionRequestID:                       &info.DecisionRequestID,
 		CancelRequestID:                         &info.CancelRequestID,
+		Paused:                                  &info.Paused,
 		StickyTaskList:                          &info.StickyTaskList,
 		StickyScheduleToStartTimeout:            durationToSecondsInt64Ptr(info.StickyScheduleToStartTimeout),
 		RetryAttempt:                            &info.RetryAttempt,
@@ -257,6 +266,8 @@ func workflowExecutionInfoToThrift(info *WorkflowExecutionInfo) *sqlblobs.Workfl
 		EventStoreVersion:                       &info.EventStoreVersion,
 		EventBranchToken:                        info.EventBranchToken,
 		SignalCount:                             &info.SignalCount,
+		SignalRequestedTimestamps:               info.SignalRequestedTimestamps,
+		AcceptedUpdates:                         info.AcceptedUpdates,
 		HistorySize:                             &info.HistorySize,
 		ClientLibraryVersion:                    &info.ClientLibraryVersion,
 		ClientFeatureVersion:                    &info.ClientFeatureVersion,
@@ -308,6 +319,7 @@ func workflowExecutionInfoFromThrift(info *sqlblobs.WorkflowExecutionInfo) *Work
 		CreateRequestID:                    info.GetCreateRequestID(),
 		DecisionRequestID:                  info.GetDecisionRequestID(),
 		CancelRequestID:                    info.GetCancelRequestID(),
+		Paused:                             info.GetPaused(),
 		StickyTaskList:                     info.GetStickyTaskList(),
 		StickyScheduleToStartTimeout:       common.SecondsToDuration(info.GetStickyScheduleToStartTimeout()),
 		RetryAttempt:                       info.GetRetryAttempt(),
@@ -323,6 +335,8 @@ func workflowExecutionInfoFromThrift(info *sqlblobs.WorkflowExecutionInfo) *Work
 		EventStoreVersion:                  info.GetEventStoreVersion(),
 		EventBranchToken:                   info.EventBranchToken,
 		SignalCount:                        info.GetSignalCount(),
+		SignalRequestedTimestamps:          info.GetSignalRequestedTimestamps(),
+		AcceptedUpdates:                    info.GetAcceptedUpdates(),
 		HistorySize:                        info.GetHistorySize(),
 		ClientLibraryVersion:               info.GetClientLibraryVersion(),
 		ClientFeatureVersion:               info.GetClientFeatureVersion(),
@@ -358,6 +372,7 @@ func activityInfoToThrift(info *ActivityInfo) *sqlblobs.ActivityInfo {
 		HeartbeatTimeoutSeconds:       durationToSecondsInt32Ptr(info.HeartbeatTimeout),
 		CancelRequested:               &info.CancelRequested,
 		CancelRequestID:               &info.CancelRequestID,
+		Paused:                        &info.Paused,
 		TimerTaskStatus:               &info.TimerTaskStatus,
 		Attempt:                       &info.Attempt,
 		TaskList:                      &info.TaskList,
@@ -397,6 +412,7 @@ func activityInfoFromThrift(info *sqlblobs.ActivityInfo) *ActivityInfo {
 		HeartbeatTimeout:         common.SecondsToDuration(int64(info.GetHeartbeatTimeoutSeconds())),
 		CancelRequested:          info.GetCancelRequested(),
 		CancelRequestID:          info.GetCancelRequestID(),
+		Paused:                   info.GetPaused(),
 		TimerTaskStatus:          info.GetTimerTaskStatus(),
 		Attempt:                  info.GetAttempt(),
 		TaskList:                 info.GetTaskList(),
@@ -565,6 +581,7 @@ func taskListInfoToThrift(info *TaskListInfo) *sqlblobs.TaskListInfo {
 		AckLevel:         &info.AckLevel,
 		ExpiryTimeNanos:  timeToUnixNanoPtr(info.ExpiryTimestamp),
 		LastUpdatedNanos: timeToUnixNanoPtr(info.LastUpdated),
+		Paused:           &info.Paused,
 	}
 }
 
@@ -577,6 +594,7 @@ func taskListInfoFromThrift(info *sqlblobs.TaskListInfo) *TaskListInfo {
 		AckLevel:        info.GetAckLevel(),
 		ExpiryTimestamp: timeFromUnixNano(info.GetExpiryTimeNanos()),
 		LastUpdated:     timeFromUnixNano(info.GetLastUpdatedNanos()),
+		Paused:          info.GetPaused(),
 	}
 }
 
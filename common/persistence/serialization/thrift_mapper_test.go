@@ -205,6 +205,7 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 		EventStoreVersion:                  int32(rand.Intn(1000)),
 		EventBranchToken:                   []byte("EventBranchToken"),
 		SignalCount:                        int64(rand.Intn(1000)),
+		AcceptedUpdates:                    map[string]int64{"update_1": int64(rand.Intn(1000))},
 		HistorySize:                        int64(rand.Intn(1000)),
 		ClientLibraryVersion:               "ClientLibraryVersion",
 		ClientFeatureVersion:               "ClientFeatureVersion",
@@ -259,6 +260,7 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 	assert.Equal(t, expected.EventStoreVersion, actual.EventStoreVersion)
 	assert.Equal(t, expected.EventBranchToken, actual.EventBranchToken)
 	assert.Equal(t, expected.SignalCount, actual.SignalCount)
+	assert.Equal(t, expected.AcceptedUpdates, actual.AcceptedUpdates)
 	assert.Equal(t, expected.HistorySize, actual.HistorySize)
 	assert.Equal(t, expected.ClientLibraryVersion, actual.ClientLibraryVersion)
 	assert.Equal(t, expected.ClientFeatureVersion, actual.ClientFeatureVersion)
@@ -295,6 +297,7 @@ func TestActivityInfo(t *testing.T) {
 		HeartbeatTimeout:         time.Minute * time.Duration(rand.Intn(10)),
 		CancelRequested:          true,
 		CancelRequestID:          int64(rand.Intn(1000)),
+		Paused:                   true,
 		TimerTaskStatus:          int32(rand.Intn(1000)),
 		Attempt:                  int32(rand.Intn(1000)),
 		TaskList:                 "TaskList",
@@ -322,6 +325,7 @@ func TestActivityInfo(t *testing.T) {
 	assert.Equal(t, expected.RequestID, actual.RequestID)
 	assert.Equal(t, expected.CancelRequested, actual.CancelRequested)
 	assert.Equal(t, expected.CancelRequestID, actual.CancelRequestID)
+	assert.Equal(t, expected.Paused, actual.Paused)
 	assert.Equal(t, expected.TimerTaskStatus, actual.TimerTaskStatus)
 	assert.Equal(t, expected.Attempt, actual.Attempt)
 	assert.Equal(t, expected.TaskList, actual.TaskList)
@@ -423,10 +427,12 @@ func TestTaskListInfo(t *testing.T) {
 		AckLevel:        int64(rand.Intn(1000)),
 		ExpiryTimestamp: time.Now(),
 		LastUpdated:     time.Now(),
+		Paused:          true,
 	}
 	actual := taskListInfoFromThrift(taskListInfoToThrift(expected))
 	assert.Equal(t, expected.Kind, actual.Kind)
 	assert.Equal(t, expected.AckLevel, actual.AckLevel)
+	assert.Equal(t, expected.Paused, actual.Paused)
 	assert.Equal(t, expected.LastUpdated.Sub(actual.LastUpdated), time.Duration(0))
 	assert.Equal(t, expected.ExpiryTimestamp.Sub(actual.ExpiryTimestamp), time.Duration(0))
 }
@@ -68,6 +68,10 @@ type (
 		// serialize/deserialize processing queue states
 		SerializeProcessingQueueStates(states *types.ProcessingQueueStates, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeProcessingQueueStates(data *DataBlob) (*types.ProcessingQueueStates, error)
+
+		// serialize/deserialize history task dlq info, only json encoding is supported
+		SerializeHistoryTaskDLQInfo(info *HistoryTaskDLQInfo, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeHistoryTaskDLQInfo(data *DataBlob) (*HistoryTaskDLQInfo, error)
 	}
 
 	// CadenceSerializationError is an error type for cadence serialization
@@ -234,6 +238,34 @@ func (t *serializerImpl) DeserializeProcessingQueueStates(
 	return &states, err
 }
 
+func (t *serializerImpl) SerializeHistoryTaskDLQInfo(
+	info *HistoryTaskDLQInfo,
+	encodingType common.EncodingType,
+) (*DataBlob, error) {
+	if info == nil {
+		return nil, nil
+	}
+	if encodingType == common.EncodingTypeThriftRW {
+		return nil, NewUnknownEncodingTypeError(encodingType)
+	}
+	return t.serialize(info, encodingType)
+}
+
+func (t *serializerImpl) DeserializeHistoryTaskDLQInfo(
+	data *DataBlob,
+) (*HistoryTaskDLQInfo, error) {
+	if data == nil {
+		return nil, nil
+	}
+	if data.GetEncoding() == common.EncodingTypeThriftRW {
+		return nil, NewUnknownEncodingTypeError(data.GetEncoding())
+	}
+
+	var info HistoryTaskDLQInfo
+	err := t.deserialize(data, &info)
+	return &info, err
+}
+
 func (t *serializerImpl) serialize(input interface{}, encodingType common.EncodingType) (*DataBlob, error) {
 	if input == nil {
 		return nil, nil
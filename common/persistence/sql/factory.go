@@ -131,6 +131,26 @@ func (f *Factory) NewQueue(queueType p.QueueType) (p.Queue, error) {
 	return newQueueStore(conn, f.logger, queueType)
 }
 
+// NewConfigStore returns a new config store backed by sql
+func (f *Factory) NewConfigStore() (p.ConfigStore, error) {
+	conn, err := f.dbConn.get()
+	if err != nil {
+		return nil, err
+	}
+
+	return newConfigStore(conn, f.logger)
+}
+
+// NewDomainAuditStore returns a new domain audit store backed by sql
+func (f *Factory) NewDomainAuditStore() (p.DomainAuditStore, error) {
+	conn, err := f.dbConn.get()
+	if err != nil {
+		return nil, err
+	}
+
+	return newDomainAuditStore(conn, f.logger)
+}
+
 // Close closes the factory
 func (f *Factory) Close() {
 	f.dbConn.forceClose()
@@ -0,0 +1,88 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+type (
+	sqlConfigStore struct {
+		sqlStore
+	}
+)
+
+func newConfigStore(
+	db sqlplugin.DB,
+	logger log.Logger,
+) (persistence.ConfigStore, error) {
+	return &sqlConfigStore{
+		sqlStore: sqlStore{
+			db:     db,
+			logger: logger,
+		},
+	}, nil
+}
+
+func (m *sqlConfigStore) FetchConfig(
+	ctx context.Context,
+	configType persistence.ConfigType,
+) (*persistence.InternalConfigStoreEntry, error) {
+
+	row, err := m.db.SelectLatestConfig(ctx, int(configType))
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "FetchConfig", fmt.Sprintf("Type: %v.", configType), err)
+	}
+	return &persistence.InternalConfigStoreEntry{
+		ConfigType: persistence.ConfigType(row.RowType),
+		Version:    row.Version,
+		Timestamp:  row.Timestamp,
+		Values:     persistence.NewDataBlob(row.Data, common.EncodingType(row.DataEncoding)),
+	}, nil
+}
+
+func (m *sqlConfigStore) UpdateConfig(
+	ctx context.Context,
+	entry *persistence.InternalConfigStoreEntry,
+) error {
+
+	data, encoding := persistence.FromDataBlob(entry.Values)
+	_, err := m.db.InsertIntoClusterConfig(ctx, &sqlplugin.ClusterConfigRow{
+		RowType:      int(entry.ConfigType),
+		Version:      entry.Version,
+		Timestamp:    entry.Timestamp,
+		Data:         data,
+		DataEncoding: encoding,
+	})
+	if err != nil {
+		if m.db.IsDupEntryError(err) {
+			return &persistence.ConditionFailedError{Msg: fmt.Sprintf("version %v of config type %v exists", entry.Version, entry.ConfigType)}
+		}
+		return convertCommonErrors(m.db, "UpdateConfig", fmt.Sprintf("Type: %v.", entry.ConfigType), err)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	emptyDomainAuditLogEventID = -1
+
+	domainAuditLogAppendMaxAttempts = 5
+)
+
+type (
+	sqlDomainAuditStore struct {
+		sqlStore
+	}
+)
+
+func newDomainAuditStore(
+	db sqlplugin.DB,
+	logger log.Logger,
+) (persistence.DomainAuditStore, error) {
+	return &sqlDomainAuditStore{
+		sqlStore: sqlStore{
+			db:     db,
+			logger: logger,
+		},
+	}, nil
+}
+
+func (m *sqlDomainAuditStore) AppendDomainAuditLog(
+	ctx context.Context,
+	domainID string,
+	payload *persistence.DataBlob,
+) error {
+
+	domainUUID := serialization.MustParseUUID(domainID)
+	data, encoding := persistence.FromDataBlob(payload)
+	// the first append of a domain has no row to lock, so concurrent first appends race
+	// for the same event ID, the ones losing the race try again in a new transaction
+	for attempt := 1; ; attempt++ {
+		err := m.txExecute(ctx, "AppendDomainAuditLog", func(tx sqlplugin.Tx) error {
+			lastEventID, err := tx.GetLastDomainAuditLogEventIDForUpdate(ctx, domainUUID)
+			if err != nil {
+				if err != sql.ErrNoRows {
+					return err
+				}
+				lastEventID = emptyDomainAuditLogEventID
+			}
+
+			_, err = tx.InsertIntoDomainAuditLog(ctx, &sqlplugin.DomainAuditLogRow{
+				DomainID:     domainUUID,
+				EventID:      lastEventID + 1,
+				Data:         data,
+				DataEncoding: encoding,
+			})
+			if err != nil && m.db.IsDupEntryError(err) {
+				return &persistence.ConditionFailedError{Msg: fmt.Sprintf("event ID %v exists in audit log of domain %v", lastEventID+1, domainID)}
+			}
+			return err
+		})
+		if _, ok := err.(*persistence.ConditionFailedError); !ok || attempt >= domainAuditLogAppendMaxAttempts {
+			return err
+		}
+	}
+}
+
+func (m *sqlDomainAuditStore) ReadDomainAuditLogs(
+	ctx context.Context,
+	domainID string,
+	lastEventID int64,
+	maxCount int,
+) ([]*persistence.InternalDomainAuditLogEntry, error) {
+
+	rows, err := m.db.SelectFromDomainAuditLog(ctx, serialization.MustParseUUID(domainID), lastEventID, maxCount)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "ReadDomainAuditLogs", fmt.Sprintf("DomainID: %v.", domainID), err)
+	}
+
+	var entries []*persistence.InternalDomainAuditLogEntry
+	for _, row := range rows {
+		entries = append(entries, &persistence.InternalDomainAuditLogEntry{
+			DomainID: row.DomainID.String(),
+			EventID:  row.EventID,
+			Payload:  persistence.NewDataBlob(row.Data, common.EncodingType(row.DataEncoding)),
+		})
+	}
+	return entries, nil
+}
@@ -129,6 +129,10 @@ func (m *sqlDomainStore) CreateDomain(
 		LastUpdatedTimestamp:        request.LastUpdatedTime,
 		BadBinaries:                 badBinaries,
 		BadBinariesEncoding:         badBinariesEncoding,
+		RequestsPerSecond:           request.Config.Limits.RequestsPerSecond,
+		MaxConcurrentWorkflows:      request.Config.Limits.MaxConcurrentWorkflows,
+		MaxPendingActivities:        request.Config.Limits.MaxPendingActivities,
+		MaxHistorySize:              request.Config.Limits.MaxHistorySize,
 	}
 
 	blob, err := m.parser.DomainInfoToBlob(domainInfo)
@@ -245,6 +249,12 @@ func (m *sqlDomainStore) domainRowToGetDomainResponse(row *sqlplugin.DomainRow)
 			VisibilityArchivalStatus: types.ArchivalStatus(domainInfo.GetVisibilityArchivalStatus()),
 			VisibilityArchivalURI:    domainInfo.GetVisibilityArchivalURI(),
 			BadBinaries:              badBinaries,
+			Limits: types.DomainLimits{
+				RequestsPerSecond:      domainInfo.GetRequestsPerSecond(),
+				MaxConcurrentWorkflows: domainInfo.GetMaxConcurrentWorkflows(),
+				MaxPendingActivities:   domainInfo.GetMaxPendingActivities(),
+				MaxHistorySize:         domainInfo.GetMaxHistorySize(),
+			},
 		},
 		ReplicationConfig: &persistence.DomainReplicationConfig{
 			ActiveClusterName: cluster.GetOrUseDefaultActiveCluster(m.activeClusterName, domainInfo.GetActiveClusterName()),
@@ -265,6 +275,23 @@ func (m *sqlDomainStore) UpdateDomain(
 	ctx context.Context,
 	request *persistence.InternalUpdateDomainRequest,
 ) error {
+	return m.updateDomain(ctx, "UpdateDomain", request)
+}
+
+// RenameDomain updates the domain row in place. Rows are keyed by ID, so writing
+// the new name is enough; the unique name index rejects names already taken.
+func (m *sqlDomainStore) RenameDomain(
+	ctx context.Context,
+	request *persistence.InternalRenameDomainRequest,
+) error {
+	return m.updateDomain(ctx, "RenameDomain", &request.InternalUpdateDomainRequest)
+}
+
+func (m *sqlDomainStore) updateDomain(
+	ctx context.Context,
+	operation string,
+	request *persistence.InternalUpdateDomainRequest,
+) error {
 
 	clusters := make([]string, len(request.ReplicationConfig.Clusters))
 	for i := range clusters {
@@ -302,6 +329,10 @@ func (m *sqlDomainStore) UpdateDomain(
 		LastUpdatedTimestamp:        request.LastUpdatedTime,
 		BadBinaries:                 badBinaries,
 		BadBinariesEncoding:         badBinariesEncoding,
+		RequestsPerSecond:           request.Config.Limits.RequestsPerSecond,
+		MaxConcurrentWorkflows:      request.Config.Limits.MaxConcurrentWorkflows,
+		MaxPendingActivities:        request.Config.Limits.MaxPendingActivities,
+		MaxHistorySize:              request.Config.Limits.MaxHistorySize,
 	}
 
 	blob, err := m.parser.DomainInfoToBlob(domainInfo)
@@ -309,7 +340,7 @@ func (m *sqlDomainStore) UpdateDomain(
 		return err
 	}
 
-	return m.txExecute(ctx, "UpdateDomain", func(tx sqlplugin.Tx) error {
+	return m.txExecute(ctx, operation, func(tx sqlplugin.Tx) error {
 		result, err := tx.UpdateDomain(ctx, &sqlplugin.DomainRow{
 			Name:         request.Info.Name,
 			ID:           serialization.MustParseUUID(request.Info.ID),
@@ -317,6 +348,11 @@ func (m *sqlDomainStore) UpdateDomain(
 			DataEncoding: string(blob.Encoding),
 		})
 		if err != nil {
+			if m.db.IsDupEntryError(err) {
+				return &types.DomainAlreadyExistsError{
+					Message: fmt.Sprintf("name: %v", request.Info.Name),
+				}
+			}
 			return err
 		}
 		noRowsAffected, err := result.RowsAffected()
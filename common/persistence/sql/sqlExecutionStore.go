@@ -1104,6 +1104,97 @@ func (m *sqlExecutionStore) CreateFailoverMarkerTasks(
 	})
 }
 
+func (m *sqlExecutionStore) PutHistoryTaskToDLQ(
+	ctx context.Context,
+	request *p.InternalPutHistoryTaskToDLQRequest,
+) error {
+
+	row := &sqlplugin.HistoryTaskDLQRow{
+		ShardID:      m.shardID,
+		TaskID:       request.TaskInfo.TaskID,
+		Data:         request.TaskInfo.Task.Data,
+		DataEncoding: string(request.TaskInfo.Task.Encoding),
+	}
+	if _, err := m.db.InsertIntoHistoryTaskDLQ(ctx, row); err != nil {
+		return convertCommonErrors(m.db, "PutHistoryTaskToDLQ", "", err)
+	}
+	return nil
+}
+
+func (m *sqlExecutionStore) GetHistoryTasksFromDLQ(
+	ctx context.Context,
+	request *p.GetHistoryTasksFromDLQRequest,
+) (*p.InternalGetHistoryTasksFromDLQResponse, error) {
+
+	readLevel := request.ReadLevel
+	if len(request.NextPageToken) > 0 {
+		var err error
+		readLevel, err = deserializePageToken(request.NextPageToken)
+		if err != nil {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("GetHistoryTasksFromDLQ: invalid next page token: %v", err)}
+		}
+	}
+
+	rows, err := m.db.SelectFromHistoryTaskDLQ(ctx, &sqlplugin.HistoryTaskDLQFilter{
+		ShardID:   m.shardID,
+		MinTaskID: readLevel,
+		MaxTaskID: request.MaxReadLevel,
+		PageSize:  request.BatchSize,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &p.InternalGetHistoryTasksFromDLQResponse{}, nil
+		}
+		return nil, convertCommonErrors(m.db, "GetHistoryTasksFromDLQ", "", err)
+	}
+
+	tasks := make([]*p.InternalHistoryTaskDLQInfo, len(rows))
+	for i, row := range rows {
+		tasks[i] = &p.InternalHistoryTaskDLQInfo{
+			TaskID: row.TaskID,
+			Task:   p.NewDataBlob(row.Data, common.EncodingType(row.DataEncoding)),
+		}
+	}
+
+	var nextPageToken []byte
+	if len(rows) > 0 && len(rows) == request.BatchSize {
+		nextPageToken = serializePageToken(rows[len(rows)-1].TaskID)
+	}
+	return &p.InternalGetHistoryTasksFromDLQResponse{
+		Tasks:         tasks,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (m *sqlExecutionStore) DeleteHistoryTaskFromDLQ(
+	ctx context.Context,
+	request *p.DeleteHistoryTaskFromDLQRequest,
+) error {
+
+	if _, err := m.db.DeleteFromHistoryTaskDLQ(ctx, &sqlplugin.HistoryTaskDLQFilter{
+		ShardID: m.shardID,
+		TaskID:  request.TaskID,
+	}); err != nil {
+		return convertCommonErrors(m.db, "DeleteHistoryTaskFromDLQ", "", err)
+	}
+	return nil
+}
+
+func (m *sqlExecutionStore) RangeDeleteHistoryTaskFromDLQ(
+	ctx context.Context,
+	request *p.RangeDeleteHistoryTaskFromDLQRequest,
+) error {
+
+	if _, err := m.db.RangeDeleteFromHistoryTaskDLQ(ctx, &sqlplugin.HistoryTaskDLQFilter{
+		ShardID:   m.shardID,
+		MinTaskID: request.ExclusiveBeginTaskID,
+		MaxTaskID: request.InclusiveEndTaskID,
+	}); err != nil {
+		return convertCommonErrors(m.db, "RangeDeleteHistoryTaskFromDLQ", "", err)
+	}
+	return nil
+}
+
 type timerTaskPageToken struct {
 	TaskID    int64
 	Timestamp time.Time
@@ -1297,6 +1388,8 @@ func (m *sqlExecutionStore) populateWorkflowMutableState(
 		ClientFeatureVersion:               info.GetClientFeatureVersion(),
 		ClientImpl:                         info.GetClientImpl(),
 		SignalCount:                        int32(info.GetSignalCount()),
+		SignalRequestedTimestamps:          info.GetSignalRequestedTimestamps(),
+		AcceptedUpdates:                    info.GetAcceptedUpdates(),
 		HistorySize:                        info.GetHistorySize(),
 		CronSchedule:                       info.GetCronSchedule(),
 		CompletionEventBatchID:             common.EmptyEventID,
@@ -1343,6 +1436,8 @@ func (m *sqlExecutionStore) populateWorkflowMutableState(
 		state.ExecutionInfo.CancelRequestID = info.GetCancelRequestID()
 	}
 
+	state.ExecutionInfo.Paused = info.GetPaused()
+
 	if info.CompletionEventBatchID != nil {
 		state.ExecutionInfo.CompletionEventBatchID = info.GetCompletionEventBatchID()
 	}
@@ -1097,7 +1097,7 @@ func createTimerTasks(
 			// noop
 
 		case *p.DeleteHistoryEventTask:
-			// noop
+			info.TimeoutType = common.Int16Ptr(int16(t.TimeoutType))
 
 		default:
 			return &types.InternalServiceError{
@@ -1352,6 +1352,8 @@ func buildExecutionRow(
 		ClientFeatureVersion:               executionInfo.ClientFeatureVersion,
 		ClientImpl:                         executionInfo.ClientImpl,
 		SignalCount:                        int64(executionInfo.SignalCount),
+		SignalRequestedTimestamps:          executionInfo.SignalRequestedTimestamps,
+		AcceptedUpdates:                    executionInfo.AcceptedUpdates,
 		HistorySize:                        executionInfo.HistorySize,
 		CronSchedule:                       executionInfo.CronSchedule,
 		CompletionEventBatchID:             &executionInfo.CompletionEventBatchID,
@@ -1402,6 +1404,8 @@ func buildExecutionRow(
 		info.CancelRequestID = executionInfo.CancelRequestID
 	}
 
+	info.Paused = executionInfo.Paused
+
 	blob, err := parser.WorkflowExecutionInfoToBlob(info)
 	if err != nil {
 		return nil, err
@@ -182,6 +182,7 @@ func (m *sqlTaskStore) LeaseTaskList(
 			AckLevel:    ackLevel,
 			Kind:        request.TaskListKind,
 			LastUpdated: now,
+			Paused:      tlInfo.GetPaused(),
 		}}
 		return nil
 	})
@@ -199,6 +200,7 @@ func (m *sqlTaskStore) UpdateTaskList(
 		Kind:            int16(request.TaskListInfo.Kind),
 		ExpiryTimestamp: time.Unix(0, 0),
 		LastUpdated:     time.Now(),
+		Paused:          request.TaskListInfo.Paused,
 	}
 	if request.TaskListInfo.Kind == persistence.TaskListKindSticky {
 		tlInfo.ExpiryTimestamp = stickyTaskListExpiry()
@@ -326,6 +328,7 @@ func (m *sqlTaskStore) ListTaskList(
 		resp.Items[i].AckLevel = info.GetAckLevel()
 		resp.Items[i].Expiry = info.GetExpiryTimestamp()
 		resp.Items[i].LastUpdated = info.GetLastUpdated()
+		resp.Items[i].Paused = info.GetPaused()
 	}
 
 	return resp, nil
@@ -127,7 +127,18 @@ func (s *sqlVisibilityStore) UpsertWorkflowExecution(
 	if p.IsNopUpsertWorkflowRequest(request) {
 		return nil
 	}
-	return p.NewOperationNotSupportErrorForVis()
+
+	// only memo can be updated in db visibility, search attributes require advanced visibility
+	_, err := s.db.UpdateVisibilityMemo(ctx, &sqlplugin.VisibilityRow{
+		DomainID: request.DomainUUID,
+		RunID:    request.RunID,
+		Memo:     request.Memo.Data,
+		Encoding: string(request.Memo.GetEncoding()),
+	})
+	if err != nil {
+		return convertCommonErrors(s.db, "UpsertWorkflowExecution", "", err)
+	}
+	return nil
 }
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(
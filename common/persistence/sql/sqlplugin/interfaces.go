@@ -308,6 +308,24 @@ type (
 		ShardID           int
 	}
 
+	// HistoryTaskDLQRow represents a row in history_task_dlq table
+	HistoryTaskDLQRow struct {
+		ShardID      int
+		TaskID       int64
+		Data         []byte
+		DataEncoding string
+	}
+
+	// HistoryTaskDLQFilter contains the column names within history_task_dlq table that
+	// can be used to filter results through a WHERE clause
+	HistoryTaskDLQFilter struct {
+		ShardID   int
+		TaskID    int64
+		MinTaskID int64
+		MaxTaskID int64
+		PageSize  int
+	}
+
 	// TimerTasksRow represents a row in timer_tasks table
 	TimerTasksRow struct {
 		ShardID             int
@@ -558,6 +576,23 @@ type (
 		MessagePayload []byte
 	}
 
+	// ClusterConfigRow represents a row in cluster_config table
+	ClusterConfigRow struct {
+		RowType      int
+		Version      int64
+		Timestamp    time.Time
+		Data         []byte
+		DataEncoding string
+	}
+
+	// DomainAuditLogRow represents a row in domain_audit_log table
+	DomainAuditLogRow struct {
+		DomainID     serialization.UUID
+		EventID      int64
+		Data         []byte
+		DataEncoding string
+	}
+
 	// QueueMetadataRow represents a row in queue_metadata table
 	QueueMetadataRow struct {
 		QueueType persistence.QueueType
@@ -702,6 +737,18 @@ type (
 		// Required filter params - {sourceClusterName, shardID, taskID, inclusiveTaskID}
 		RangeDeleteMessageFromReplicationTasksDLQ(ctx context.Context, filter *ReplicationTasksDLQFilter) (sql.Result, error)
 
+		// InsertIntoHistoryTaskDLQ puts a transfer or timer task into history_task_dlq table
+		InsertIntoHistoryTaskDLQ(ctx context.Context, row *HistoryTaskDLQRow) (sql.Result, error)
+		// SelectFromHistoryTaskDLQ returns one or more rows from history_task_dlq table
+		// Required filter params - {shardID, minTaskID, maxTaskID, pageSize}
+		SelectFromHistoryTaskDLQ(ctx context.Context, filter *HistoryTaskDLQFilter) ([]HistoryTaskDLQRow, error)
+		// DeleteFromHistoryTaskDLQ deletes one row from history_task_dlq table
+		// Required filter params - {shardID, taskID}
+		DeleteFromHistoryTaskDLQ(ctx context.Context, filter *HistoryTaskDLQFilter) (sql.Result, error)
+		// RangeDeleteFromHistoryTaskDLQ deletes one or more rows from history_task_dlq table
+		// Required filter params - {shardID, minTaskID, maxTaskID}
+		RangeDeleteFromHistoryTaskDLQ(ctx context.Context, filter *HistoryTaskDLQFilter) (sql.Result, error)
+
 		ReplaceIntoActivityInfoMaps(ctx context.Context, rows []ActivityInfoMapsRow) (sql.Result, error)
 		// SelectFromActivityInfoMaps returns one or more rows from activity_info_maps
 		// Required filter params - {shardID, domainID, workflowID, runID}
@@ -767,6 +814,9 @@ type (
 		InsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
 		// ReplaceIntoVisibility deletes old row (if it exist) and inserts new row into visibility table
 		ReplaceIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
+		// UpdateVisibilityMemo updates the memo of an open workflow row in visibility table.
+		// No changes will be made if the row does not exist or is already closed
+		UpdateVisibilityMemo(ctx context.Context, row *VisibilityRow) (sql.Result, error)
 		// SelectFromVisibility returns one or more rows from visibility table
 		// Required filter params:
 		// - getClosedWorkflowExecution - retrieves single row - {domainID, runID, closed=true}
@@ -790,6 +840,13 @@ type (
 		GetAckLevels(ctx context.Context, queueType persistence.QueueType, forUpdate bool) (map[string]int64, error)
 		GetQueueSize(ctx context.Context, queueType persistence.QueueType) (int64, error)
 
+		InsertIntoClusterConfig(ctx context.Context, row *ClusterConfigRow) (sql.Result, error)
+		SelectLatestConfig(ctx context.Context, rowType int) (*ClusterConfigRow, error)
+
+		InsertIntoDomainAuditLog(ctx context.Context, row *DomainAuditLogRow) (sql.Result, error)
+		GetLastDomainAuditLogEventIDForUpdate(ctx context.Context, domainID serialization.UUID) (int64, error)
+		SelectFromDomainAuditLog(ctx context.Context, domainID serialization.UUID, lastEventID int64, maxRows int) ([]DomainAuditLogRow, error)
+
 		// The follow provide information about the underlying sql crud implementation
 		SupportsTTL() bool
 		MaxAllowedTTL() (*time.Duration, error)
@@ -0,0 +1,59 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	templateInsertConfigQuery       = `INSERT INTO cluster_config (row_type, version, timestamp, data, data_encoding) VALUES(:row_type, :version, :timestamp, :data, :data_encoding)`
+	templateSelectLatestConfigQuery = `SELECT row_type, version, timestamp, data, data_encoding FROM cluster_config WHERE row_type = ? ORDER BY version DESC LIMIT 1`
+)
+
+// InsertIntoClusterConfig inserts a new version of a record into cluster_config table
+func (mdb *db) InsertIntoClusterConfig(
+	ctx context.Context,
+	row *sqlplugin.ClusterConfigRow,
+) (sql.Result, error) {
+
+	mysqlRow := *row
+	mysqlRow.Timestamp = mdb.converter.ToMySQLDateTime(row.Timestamp)
+	return mdb.conn.NamedExecContext(ctx, templateInsertConfigQuery, &mysqlRow)
+}
+
+// SelectLatestConfig returns the latest version of a record from cluster_config table
+func (mdb *db) SelectLatestConfig(
+	ctx context.Context,
+	rowType int,
+) (*sqlplugin.ClusterConfigRow, error) {
+
+	var row sqlplugin.ClusterConfigRow
+	err := mdb.conn.GetContext(ctx, &row, templateSelectLatestConfigQuery, rowType)
+	if err != nil {
+		return nil, err
+	}
+	row.Timestamp = mdb.converter.FromMySQLDateTime(row.Timestamp)
+	return &row, nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	templateInsertDomainAuditLogQuery       = `INSERT INTO domain_audit_log (domain_id, event_id, data, data_encoding) VALUES(:domain_id, :event_id, :data, :data_encoding)`
+	templateGetLastDomainAuditLogEventQuery = `SELECT event_id FROM domain_audit_log WHERE domain_id = ? ORDER BY event_id DESC LIMIT 1 FOR UPDATE`
+	templateGetDomainAuditLogsQuery         = `SELECT domain_id, event_id, data, data_encoding FROM domain_audit_log WHERE domain_id = ? and event_id > ? ORDER BY event_id ASC LIMIT ?`
+)
+
+// InsertIntoDomainAuditLog inserts a new row into domain_audit_log table
+func (mdb *db) InsertIntoDomainAuditLog(
+	ctx context.Context,
+	row *sqlplugin.DomainAuditLogRow,
+) (sql.Result, error) {
+
+	return mdb.conn.NamedExecContext(ctx, templateInsertDomainAuditLogQuery, row)
+}
+
+// GetLastDomainAuditLogEventIDForUpdate returns the ID of the last audit log entry of a domain
+func (mdb *db) GetLastDomainAuditLogEventIDForUpdate(
+	ctx context.Context,
+	domainID serialization.UUID,
+) (int64, error) {
+
+	var lastEventID int64
+	err := mdb.conn.GetContext(ctx, &lastEventID, templateGetLastDomainAuditLogEventQuery, domainID)
+	return lastEventID, err
+}
+
+// SelectFromDomainAuditLog reads the audit log entries of a domain after lastEventID
+func (mdb *db) SelectFromDomainAuditLog(
+	ctx context.Context,
+	domainID serialization.UUID,
+	lastEventID int64,
+	maxRows int,
+) ([]sqlplugin.DomainAuditLogRow, error) {
+
+	var rows []sqlplugin.DomainAuditLogRow
+	err := mdb.conn.SelectContext(ctx, &rows, templateGetDomainAuditLogsQuery, domainID, lastEventID, maxRows)
+	return rows, err
+}
@@ -169,6 +169,26 @@ VALUES     (:source_cluster_name,
 		AND shard_id = ?
 		AND task_id > ?
 		AND task_id <= ?`
+
+	insertHistoryTaskDLQQuery = `
+REPLACE INTO history_task_dlq
+            (shard_id,
+             task_id,
+             data,
+             data_encoding)
+VALUES     (:shard_id,
+            :task_id,
+            :data,
+            :data_encoding)
+`
+	getHistoryTaskDLQQuery = `SELECT task_id, data, data_encoding FROM history_task_dlq WHERE
+shard_id = ? AND
+task_id > ? AND
+task_id <= ?
+ORDER BY task_id LIMIT ?`
+
+	deleteHistoryTaskDLQQuery      = `DELETE FROM history_task_dlq WHERE shard_id = ? AND task_id = ?`
+	rangeDeleteHistoryTaskDLQQuery = `DELETE FROM history_task_dlq WHERE shard_id = ? AND task_id > ? AND task_id <= ?`
 )
 
 // InsertIntoExecutions inserts a row into executions table
@@ -450,3 +470,32 @@ func (mdb *db) RangeDeleteMessageFromReplicationTasksDLQ(
 		filter.InclusiveEndTaskID,
 	)
 }
+
+// InsertIntoHistoryTaskDLQ inserts one row into history_task_dlq table
+func (mdb *db) InsertIntoHistoryTaskDLQ(ctx context.Context, row *sqlplugin.HistoryTaskDLQRow) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx, insertHistoryTaskDLQQuery, row)
+}
+
+// SelectFromHistoryTaskDLQ reads one or more rows from history_task_dlq table
+func (mdb *db) SelectFromHistoryTaskDLQ(ctx context.Context, filter *sqlplugin.HistoryTaskDLQFilter) ([]sqlplugin.HistoryTaskDLQRow, error) {
+	var rows []sqlplugin.HistoryTaskDLQRow
+	err := mdb.conn.SelectContext(
+		ctx,
+		&rows,
+		getHistoryTaskDLQQuery,
+		filter.ShardID,
+		filter.MinTaskID,
+		filter.MaxTaskID,
+		filter.PageSize)
+	return rows, err
+}
+
+// DeleteFromHistoryTaskDLQ deletes one row from history_task_dlq table
+func (mdb *db) DeleteFromHistoryTaskDLQ(ctx context.Context, filter *sqlplugin.HistoryTaskDLQFilter) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx, deleteHistoryTaskDLQQuery, filter.ShardID, filter.TaskID)
+}
+
+// RangeDeleteFromHistoryTaskDLQ deletes one or more rows from history_task_dlq table
+func (mdb *db) RangeDeleteFromHistoryTaskDLQ(ctx context.Context, filter *sqlplugin.HistoryTaskDLQFilter) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx, rangeDeleteHistoryTaskDLQQuery, filter.ShardID, filter.MinTaskID, filter.MaxTaskID)
+}
@@ -38,6 +38,9 @@ const (
 		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron) ` +
 		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
+	templateUpdateWorkflowExecutionMemo = `UPDATE executions_visibility SET memo = ?, encoding = ? ` +
+		`WHERE domain_id = ? AND run_id = ? AND close_status IS NULL`
+
 	// RunID condition is needed for correct pagination
 	templateConditions = ` AND domain_id = ?
 		 AND start_time >= ?
@@ -93,6 +96,16 @@ func (mdb *db) InsertIntoVisibility(ctx context.Context, row *sqlplugin.Visibili
 		row.IsCron)
 }
 
+// UpdateVisibilityMemo updates the memo of an open workflow row in visibility table
+func (mdb *db) UpdateVisibilityMemo(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx,
+		templateUpdateWorkflowExecutionMemo,
+		row.Memo,
+		row.Encoding,
+		row.DomainID,
+		row.RunID)
+}
+
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
 func (mdb *db) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
 	switch {
@@ -0,0 +1,51 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	templateInsertConfigQuery       = `INSERT INTO cluster_config (row_type, version, timestamp, data, data_encoding) VALUES(:row_type, :version, :timestamp, :data, :data_encoding)`
+	templateSelectLatestConfigQuery = `SELECT row_type, version, timestamp, data, data_encoding FROM cluster_config WHERE row_type = $1 ORDER BY version DESC LIMIT 1`
+)
+
+// InsertIntoClusterConfig inserts a new version of a record into cluster_config table
+func (pdb *db) InsertIntoClusterConfig(ctx context.Context, row *sqlplugin.ClusterConfigRow) (sql.Result, error) {
+	postgresRow := *row
+	postgresRow.Timestamp = pdb.converter.ToPostgresDateTime(row.Timestamp)
+	return pdb.conn.NamedExecContext(ctx, templateInsertConfigQuery, &postgresRow)
+}
+
+// SelectLatestConfig returns the latest version of a record from cluster_config table
+func (pdb *db) SelectLatestConfig(ctx context.Context, rowType int) (*sqlplugin.ClusterConfigRow, error) {
+	var row sqlplugin.ClusterConfigRow
+	err := pdb.conn.GetContext(ctx, &row, templateSelectLatestConfigQuery, rowType)
+	if err != nil {
+		return nil, err
+	}
+	row.Timestamp = pdb.converter.FromPostgresDateTime(row.Timestamp)
+	return &row, nil
+}
@@ -167,6 +167,28 @@ VALUES     (:source_cluster_name,
 		AND shard_id = $2
 		AND task_id > $3
 		AND task_id <= $4`
+
+	insertHistoryTaskDLQQuery = `
+INSERT INTO history_task_dlq
+            (shard_id,
+             task_id,
+             data,
+             data_encoding)
+VALUES     (:shard_id,
+            :task_id,
+            :data,
+            :data_encoding)
+ON CONFLICT (shard_id, task_id) DO UPDATE
+SET data = excluded.data, data_encoding = excluded.data_encoding
+`
+	getHistoryTaskDLQQuery = `SELECT task_id, data, data_encoding FROM history_task_dlq WHERE
+shard_id = $1 AND
+task_id > $2 AND
+task_id <= $3
+ORDER BY task_id LIMIT $4`
+
+	deleteHistoryTaskDLQQuery      = `DELETE FROM history_task_dlq WHERE shard_id = $1 AND task_id = $2`
+	rangeDeleteHistoryTaskDLQQuery = `DELETE FROM history_task_dlq WHERE shard_id = $1 AND task_id > $2 AND task_id <= $3`
 )
 
 // InsertIntoExecutions inserts a row into executions table
@@ -446,3 +468,32 @@ func (pdb *db) RangeDeleteMessageFromReplicationTasksDLQ(
 		filter.InclusiveEndTaskID,
 	)
 }
+
+// InsertIntoHistoryTaskDLQ inserts one row into history_task_dlq table
+func (pdb *db) InsertIntoHistoryTaskDLQ(ctx context.Context, row *sqlplugin.HistoryTaskDLQRow) (sql.Result, error) {
+	return pdb.conn.NamedExecContext(ctx, insertHistoryTaskDLQQuery, row)
+}
+
+// SelectFromHistoryTaskDLQ reads one or more rows from history_task_dlq table
+func (pdb *db) SelectFromHistoryTaskDLQ(ctx context.Context, filter *sqlplugin.HistoryTaskDLQFilter) ([]sqlplugin.HistoryTaskDLQRow, error) {
+	var rows []sqlplugin.HistoryTaskDLQRow
+	err := pdb.conn.SelectContext(
+		ctx,
+		&rows,
+		getHistoryTaskDLQQuery,
+		filter.ShardID,
+		filter.MinTaskID,
+		filter.MaxTaskID,
+		filter.PageSize)
+	return rows, err
+}
+
+// DeleteFromHistoryTaskDLQ deletes one row from history_task_dlq table
+func (pdb *db) DeleteFromHistoryTaskDLQ(ctx context.Context, filter *sqlplugin.HistoryTaskDLQFilter) (sql.Result, error) {
+	return pdb.conn.ExecContext(ctx, deleteHistoryTaskDLQQuery, filter.ShardID, filter.TaskID)
+}
+
+// RangeDeleteFromHistoryTaskDLQ deletes one or more rows from history_task_dlq table
+func (pdb *db) RangeDeleteFromHistoryTaskDLQ(ctx context.Context, filter *sqlplugin.HistoryTaskDLQFilter) (sql.Result, error) {
+	return pdb.conn.ExecContext(ctx, rangeDeleteHistoryTaskDLQQuery, filter.ShardID, filter.MinTaskID, filter.MaxTaskID)
+}
@@ -51,6 +51,9 @@ const (
 			  encoding = excluded.encoding,
 				is_cron = excluded.is_cron`
 
+	templateUpdateWorkflowExecutionMemo = `UPDATE executions_visibility SET memo = $1, encoding = $2 ` +
+		`WHERE domain_id = $3 AND run_id = $4 AND close_status IS NULL`
+
 	// RunID condition is needed for correct pagination
 	templateConditions1 = ` AND domain_id = $1
 		 AND start_time >= $2
@@ -112,6 +115,15 @@ func (pdb *db) InsertIntoVisibility(ctx context.Context, row *sqlplugin.Visibili
 		row.IsCron)
 }
 
+// UpdateVisibilityMemo updates the memo of an open workflow row in visibility table
+func (pdb *db) UpdateVisibilityMemo(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	return pdb.conn.ExecContext(ctx, templateUpdateWorkflowExecutionMemo,
+		row.Memo,
+		row.Encoding,
+		row.DomainID,
+		row.RunID)
+}
+
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
 func (pdb *db) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
 	switch {
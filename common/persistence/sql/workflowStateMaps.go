@@ -68,6 +68,7 @@ func updateActivityInfos(
 				HeartbeatTimeout:         activityInfo.HeartbeatTimeout,
 				CancelRequested:          activityInfo.CancelRequested,
 				CancelRequestID:          activityInfo.CancelRequestID,
+				Paused:                   activityInfo.Paused,
 				TimerTaskStatus:          activityInfo.TimerTaskStatus,
 				Attempt:                  activityInfo.Attempt,
 				TaskList:                 activityInfo.TaskList,
@@ -165,6 +166,7 @@ func getActivityInfoMap(
 			HeartbeatTimeout:         decoded.GetHeartbeatTimeout(),
 			CancelRequested:          decoded.GetCancelRequested(),
 			CancelRequestID:          decoded.GetCancelRequestID(),
+			Paused:                   decoded.GetPaused(),
 			TimerTaskStatus:          decoded.GetTimerTaskStatus(),
 			Attempt:                  decoded.GetAttempt(),
 			StartedIdentity:          decoded.GetStartedIdentity(),
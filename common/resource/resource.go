@@ -70,6 +70,8 @@ type (
 		GetMessagingClient() messaging.Client
 		GetBlobstoreClient() blobstore.Client
 		GetDomainReplicationQueue() domain.ReplicationQueue
+		GetDomainAuditLog() domain.AuditLog
+		GetRemoteClusterManager() cluster.RemoteClusterManager
 
 		// membership infos
 
@@ -90,6 +90,8 @@ type (
 		archivalMetadata        archiver.ArchivalMetadata
 		archiverProvider        provider.ArchiverProvider
 		domainReplicationQueue  domain.ReplicationQueue
+		domainAuditLog          domain.AuditLog
+		remoteClusterManager    cluster.RemoteClusterManager
 
 		// membership infos
 
@@ -170,6 +172,7 @@ func New(
 		),
 		params.DispatcherProvider,
 		params.ClusterMetadata,
+		logger,
 	)
 	if err != nil {
 		return nil, err
@@ -223,12 +226,22 @@ func New(
 	)
 
 	domainMetricsScopeCache := cache.NewDomainMetricsScopeCache()
+	timeSource := clock.NewRealTimeSource()
+	remoteClusterManager := cluster.NewRemoteClusterManager(
+		params.ClusterMetadata,
+		persistenceBean.GetConfigStoreManager(),
+		persistenceBean.GetDomainManager(),
+		timeSource,
+		dynamicCollection.GetDurationProperty(dynamicconfig.ClusterMetadataRefreshInterval, time.Minute),
+		logger,
+	)
 	domainReplicationQueue := domain.NewReplicationQueue(
 		persistenceBean.GetDomainReplicationQueueManager(),
 		params.ClusterMetadata.GetCurrentClusterName(),
 		params.MetricsClient,
 		logger,
 	)
+	domainAuditLog := domain.NewAuditLog(persistenceBean.GetDomainAuditManager())
 
 	frontendRawClient := clientBean.GetFrontendClient()
 	frontendClient := frontend.NewRetryableClient(
@@ -290,7 +303,7 @@ func New(
 
 		domainCache:             domainCache,
 		domainMetricsScopeCache: domainMetricsScopeCache,
-		timeSource:              clock.NewRealTimeSource(),
+		timeSource:              timeSource,
 		payloadSerializer:       persistence.NewPayloadSerializer(),
 		metricsClient:           params.MetricsClient,
 		messagingClient:         params.MessagingClient,
@@ -298,6 +311,8 @@ func New(
 		archivalMetadata:        params.ArchivalMetadata,
 		archiverProvider:        params.ArchiverProvider,
 		domainReplicationQueue:  domainReplicationQueue,
+		domainAuditLog:          domainAuditLog,
+		remoteClusterManager:    remoteClusterManager,
 
 		// membership infos
 
@@ -366,6 +381,7 @@ func (h *Impl) Start() {
 	h.membershipMonitor.Start()
 	h.domainCache.Start()
 	h.domainMetricsScopeCache.Start()
+	h.remoteClusterManager.Start()
 
 	hostInfo, err := h.membershipMonitor.WhoAmI()
 	if err != nil {
@@ -390,6 +406,7 @@ func (h *Impl) Stop() {
 		return
 	}
 
+	h.remoteClusterManager.Stop()
 	h.domainCache.Stop()
 	h.domainMetricsScopeCache.Stop()
 	h.membershipMonitor.Stop()
@@ -472,6 +489,16 @@ func (h *Impl) GetDomainReplicationQueue() domain.ReplicationQueue {
 	return h.domainReplicationQueue
 }
 
+// GetDomainAuditLog return domain audit log
+func (h *Impl) GetDomainAuditLog() domain.AuditLog {
+	return h.domainAuditLog
+}
+
+// GetRemoteClusterManager return remote cluster manager
+func (h *Impl) GetRemoteClusterManager() cluster.RemoteClusterManager {
+	return h.remoteClusterManager
+}
+
 // membership infos
 
 // GetMembershipMonitor return the membership monitor
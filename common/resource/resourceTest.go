@@ -64,6 +64,8 @@ type (
 		DomainCache             *cache.MockDomainCache
 		DomainMetricsScopeCache cache.DomainMetricsScopeCache
 		DomainReplicationQueue  *domain.MockReplicationQueue
+		DomainAuditLog          *domain.MockAuditLog
+		RemoteClusterManager    *cluster.MockRemoteClusterManager
 		TimeSource              clock.TimeSource
 		PayloadSerializer       persistence.PayloadSerializer
 		MetricsClient           metrics.Client
@@ -146,6 +148,11 @@ func NewTest(
 	domainReplicationQueue := domain.NewMockReplicationQueue(controller)
 	domainReplicationQueue.EXPECT().Start().AnyTimes()
 	domainReplicationQueue.EXPECT().Stop().AnyTimes()
+	domainAuditLog := domain.NewMockAuditLog(controller)
+	domainAuditLog.EXPECT().Append(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	clusterMetadata := cluster.NewMockMetadata(controller)
+	clusterMetadata.EXPECT().RegisterChangeCallback(gomock.Any(), gomock.Any()).AnyTimes()
+	clusterMetadata.EXPECT().UnregisterChangeCallback(gomock.Any()).AnyTimes()
 	persistenceBean := persistenceClient.NewMockBean(controller)
 	persistenceBean.EXPECT().GetMetadataManager().Return(metadataMgr).AnyTimes()
 	persistenceBean.EXPECT().GetTaskManager().Return(taskMgr).AnyTimes()
@@ -168,13 +175,15 @@ func NewTest(
 
 	return &Test{
 		MetricsScope:    scope,
-		ClusterMetadata: cluster.NewMockMetadata(controller),
+		ClusterMetadata: clusterMetadata,
 
 		// other common resources
 
 		DomainCache:             cache.NewMockDomainCache(controller),
 		DomainMetricsScopeCache: cache.NewDomainMetricsScopeCache(),
 		DomainReplicationQueue:  domainReplicationQueue,
+		DomainAuditLog:          domainAuditLog,
+		RemoteClusterManager:    cluster.NewMockRemoteClusterManager(controller),
 		TimeSource:              clock.NewRealTimeSource(),
 		PayloadSerializer:       persistence.NewPayloadSerializer(),
 		MetricsClient:           metrics.NewClient(scope, serviceMetricsIndex),
@@ -266,6 +275,16 @@ func (s *Test) GetDomainReplicationQueue() domain.ReplicationQueue {
 	return s.DomainReplicationQueue
 }
 
+// GetDomainAuditLog for testing
+func (s *Test) GetDomainAuditLog() domain.AuditLog {
+	return s.DomainAuditLog
+}
+
+// GetRemoteClusterManager for testing
+func (s *Test) GetRemoteClusterManager() cluster.RemoteClusterManager {
+	return s.RemoteClusterManager
+}
+
 // GetTimeSource for testing
 func (s *Test) GetTimeSource() clock.TimeSource {
 	return s.TimeSource
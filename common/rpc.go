@@ -53,6 +53,8 @@ const (
 	EnforceDCRedirection = "cadence-enforce-dc-redirection"
 	// AuthorizationTokenHeaderName refers to the jwt token in the request
 	AuthorizationTokenHeaderName = "cadence-authorization"
+	// DomainChangeReasonHeaderName refers to the operator provided reason for a domain change
+	DomainChangeReasonHeaderName = "cadence-domain-change-reason"
 )
 
 type (
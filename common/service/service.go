@@ -215,6 +215,7 @@ func (h *serviceImpl) Start() {
 		client.NewRPCClientFactory(h.rpcFactory, h.membershipMonitor, h.metricsClient, h.dynamicCollection, h.numberOfHistoryShards, h.logger),
 		h.dispatcherProvider,
 		h.clusterMetadata,
+		h.logger,
 	)
 	if err != nil {
 		h.logger.WithTags(tag.Error(err)).Fatal("fail to initialize client bean")
@@ -404,3 +404,447 @@ func (v *RingInfo) GetMembers() (o []*HostInfo) {
 	}
 	return
 }
+
+// PauseTaskListRequest is an internal type (TBD...)
+type PauseTaskListRequest struct {
+	Domain       string        `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PauseTaskListRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *PauseTaskListRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *PauseTaskListRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// ResumeTaskListRequest is an internal type (TBD...)
+type ResumeTaskListRequest struct {
+	Domain       string        `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ResumeTaskListRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *ResumeTaskListRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *ResumeTaskListRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// MoveTaskListBacklogRequest is an internal type (TBD...)
+type MoveTaskListBacklogRequest struct {
+	Domain         string        `json:"domain,omitempty"`
+	SourceTaskList *TaskList     `json:"sourceTaskList,omitempty"`
+	TargetTaskList *TaskList     `json:"targetTaskList,omitempty"`
+	TaskListType   *TaskListType `json:"taskListType,omitempty"`
+	BatchSize      int32         `json:"batchSize,omitempty"`
+	AfterTaskID    int64         `json:"afterTaskID,omitempty"`
+	DryRun         bool          `json:"dryRun,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *MoveTaskListBacklogRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetSourceTaskList is an internal getter (TBD...)
+func (v *MoveTaskListBacklogRequest) GetSourceTaskList() (o *TaskList) {
+	if v != nil && v.SourceTaskList != nil {
+		return v.SourceTaskList
+	}
+	return
+}
+
+// GetTargetTaskList is an internal getter (TBD...)
+func (v *MoveTaskListBacklogRequest) GetTargetTaskList() (o *TaskList) {
+	if v != nil && v.TargetTaskList != nil {
+		return v.TargetTaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *MoveTaskListBacklogRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetBatchSize is an internal getter (TBD...)
+func (v *MoveTaskListBacklogRequest) GetBatchSize() (o int32) {
+	if v != nil {
+		return v.BatchSize
+	}
+	return
+}
+
+// GetAfterTaskID is an internal getter (TBD...)
+func (v *MoveTaskListBacklogRequest) GetAfterTaskID() (o int64) {
+	if v != nil {
+		return v.AfterTaskID
+	}
+	return
+}
+
+// GetDryRun is an internal getter (TBD...)
+func (v *MoveTaskListBacklogRequest) GetDryRun() (o bool) {
+	if v != nil {
+		return v.DryRun
+	}
+	return
+}
+
+// MigrateWorkflowExecutionRequest is an internal type (TBD...)
+type MigrateWorkflowExecutionRequest struct {
+	Domain        string `json:"domain,omitempty"`
+	WorkflowID    string `json:"workflowID,omitempty"`
+	RunID         string `json:"runID,omitempty"`
+	SourceCluster string `json:"sourceCluster,omitempty"`
+	SourceDomain  string `json:"sourceDomain,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *MigrateWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *MigrateWorkflowExecutionRequest) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *MigrateWorkflowExecutionRequest) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+// GetSourceCluster is an internal getter (TBD...)
+func (v *MigrateWorkflowExecutionRequest) GetSourceCluster() (o string) {
+	if v != nil {
+		return v.SourceCluster
+	}
+	return
+}
+
+// GetSourceDomain is an internal getter (TBD...)
+func (v *MigrateWorkflowExecutionRequest) GetSourceDomain() (o string) {
+	if v != nil {
+		return v.SourceDomain
+	}
+	return
+}
+
+// MigrateWorkflowExecutionResponse is an internal type (TBD...)
+type MigrateWorkflowExecutionResponse struct {
+	RunID       string `json:"runID,omitempty"`
+	NextEventID int64  `json:"nextEventID,omitempty"`
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *MigrateWorkflowExecutionResponse) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+// GetNextEventID is an internal getter (TBD...)
+func (v *MigrateWorkflowExecutionResponse) GetNextEventID() (o int64) {
+	if v != nil {
+		return v.NextEventID
+	}
+	return
+}
+
+// AddRemoteClusterRequest is an internal type (TBD...)
+type AddRemoteClusterRequest struct {
+	ClusterName            string `json:"clusterName,omitempty"`
+	InitialFailoverVersion int64  `json:"initialFailoverVersion,omitempty"`
+	RPCName                string `json:"rpcName,omitempty"`
+	RPCAddress             string `json:"rpcAddress,omitempty"`
+}
+
+// GetClusterName is an internal getter (TBD...)
+func (v *AddRemoteClusterRequest) GetClusterName() (o string) {
+	if v != nil {
+		return v.ClusterName
+	}
+	return
+}
+
+// GetInitialFailoverVersion is an internal getter (TBD...)
+func (v *AddRemoteClusterRequest) GetInitialFailoverVersion() (o int64) {
+	if v != nil {
+		return v.InitialFailoverVersion
+	}
+	return
+}
+
+// GetRPCName is an internal getter (TBD...)
+func (v *AddRemoteClusterRequest) GetRPCName() (o string) {
+	if v != nil {
+		return v.RPCName
+	}
+	return
+}
+
+// GetRPCAddress is an internal getter (TBD...)
+func (v *AddRemoteClusterRequest) GetRPCAddress() (o string) {
+	if v != nil {
+		return v.RPCAddress
+	}
+	return
+}
+
+// RemoveRemoteClusterRequest is an internal type (TBD...)
+type RemoveRemoteClusterRequest struct {
+	ClusterName string `json:"clusterName,omitempty"`
+}
+
+// GetClusterName is an internal getter (TBD...)
+func (v *RemoveRemoteClusterRequest) GetClusterName() (o string) {
+	if v != nil {
+		return v.ClusterName
+	}
+	return
+}
+
+// DeleteDomainRequest is an internal type (TBD...)
+type DeleteDomainRequest struct {
+	Name        string `json:"name,omitempty"`
+	Concurrency int32  `json:"concurrency,omitempty"`
+	PageSize    int32  `json:"pageSize,omitempty"`
+}
+
+// GetName is an internal getter (TBD...)
+func (v *DeleteDomainRequest) GetName() (o string) {
+	if v != nil {
+		return v.Name
+	}
+	return
+}
+
+// GetConcurrency is an internal getter (TBD...)
+func (v *DeleteDomainRequest) GetConcurrency() (o int32) {
+	if v != nil {
+		return v.Concurrency
+	}
+	return
+}
+
+// GetPageSize is an internal getter (TBD...)
+func (v *DeleteDomainRequest) GetPageSize() (o int32) {
+	if v != nil {
+		return v.PageSize
+	}
+	return
+}
+
+// DomainFieldChange is an internal type (TBD...)
+type DomainFieldChange struct {
+	Field    string `json:"field,omitempty"`
+	OldValue string `json:"oldValue,omitempty"`
+	NewValue string `json:"newValue,omitempty"`
+}
+
+// GetField is an internal getter (TBD...)
+func (v *DomainFieldChange) GetField() (o string) {
+	if v != nil {
+		return v.Field
+	}
+	return
+}
+
+// GetOldValue is an internal getter (TBD...)
+func (v *DomainFieldChange) GetOldValue() (o string) {
+	if v != nil {
+		return v.OldValue
+	}
+	return
+}
+
+// GetNewValue is an internal getter (TBD...)
+func (v *DomainFieldChange) GetNewValue() (o string) {
+	if v != nil {
+		return v.NewValue
+	}
+	return
+}
+
+// DomainChange is an internal type (TBD...)
+type DomainChange struct {
+	ID           int64                `json:"id,omitempty"`
+	DomainID     string               `json:"domainID,omitempty"`
+	DomainName   string               `json:"domainName,omitempty"`
+	Operation    string               `json:"operation,omitempty"`
+	Actor        string               `json:"actor,omitempty"`
+	Reason       string               `json:"reason,omitempty"`
+	Timestamp    int64                `json:"timestamp,omitempty"`
+	FieldChanges []*DomainFieldChange `json:"fieldChanges,omitempty"`
+}
+
+// GetID is an internal getter (TBD...)
+func (v *DomainChange) GetID() (o int64) {
+	if v != nil {
+		return v.ID
+	}
+	return
+}
+
+// GetDomainID is an internal getter (TBD...)
+func (v *DomainChange) GetDomainID() (o string) {
+	if v != nil {
+		return v.DomainID
+	}
+	return
+}
+
+// GetDomainName is an internal getter (TBD...)
+func (v *DomainChange) GetDomainName() (o string) {
+	if v != nil {
+		return v.DomainName
+	}
+	return
+}
+
+// GetOperation is an internal getter (TBD...)
+func (v *DomainChange) GetOperation() (o string) {
+	if v != nil {
+		return v.Operation
+	}
+	return
+}
+
+// GetActor is an internal getter (TBD...)
+func (v *DomainChange) GetActor() (o string) {
+	if v != nil {
+		return v.Actor
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *DomainChange) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetTimestamp is an internal getter (TBD...)
+func (v *DomainChange) GetTimestamp() (o int64) {
+	if v != nil {
+		return v.Timestamp
+	}
+	return
+}
+
+// GetFieldChanges is an internal getter (TBD...)
+func (v *DomainChange) GetFieldChanges() (o []*DomainFieldChange) {
+	if v != nil && v.FieldChanges != nil {
+		return v.FieldChanges
+	}
+	return
+}
+
+// ListDomainChangesRequest is an internal type (TBD...)
+type ListDomainChangesRequest struct {
+	Domain        string `json:"domain,omitempty"`
+	PageSize      int32  `json:"pageSize,omitempty"`
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ListDomainChangesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetPageSize is an internal getter (TBD...)
+func (v *ListDomainChangesRequest) GetPageSize() (o int32) {
+	if v != nil {
+		return v.PageSize
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListDomainChangesRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// ListDomainChangesResponse is an internal type (TBD...)
+type ListDomainChangesResponse struct {
+	Changes       []*DomainChange `json:"changes,omitempty"`
+	NextPageToken []byte          `json:"nextPageToken,omitempty"`
+}
+
+// GetChanges is an internal getter (TBD...)
+func (v *ListDomainChangesResponse) GetChanges() (o []*DomainChange) {
+	if v != nil && v.Changes != nil {
+		return v.Changes
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListDomainChangesResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
@@ -65,6 +65,11 @@ func EventTypeValues() []EventType {
 		EventTypeSignalExternalWorkflowExecutionFailed,
 		EventTypeExternalWorkflowExecutionSignaled,
 		EventTypeUpsertWorkflowSearchAttributes,
+		EventTypeWorkflowExecutionPaused,
+		EventTypeWorkflowExecutionUnpaused,
+		EventTypeWorkflowExecutionUpdateAccepted,
+		EventTypeWorkflowExecutionUpdateCompleted,
+		EventTypeUpsertWorkflowMemo,
 	}
 }
 
@@ -84,5 +89,7 @@ func DecisionTypeValues() []DecisionType {
 		DecisionTypeStartChildWorkflowExecution,
 		DecisionTypeSignalExternalWorkflowExecution,
 		DecisionTypeUpsertWorkflowSearchAttributes,
+		DecisionTypeCompleteWorkflowUpdate,
+		DecisionTypeUpsertWorkflowMemo,
 	}
 }
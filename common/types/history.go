@@ -961,6 +961,9 @@ type RecordDecisionTaskStartedResponse struct {
 	ScheduledTimestamp        *int64                    `json:"scheduledTimestamp,omitempty"`
 	StartedTimestamp          *int64                    `json:"startedTimestamp,omitempty"`
 	Queries                   map[string]*WorkflowQuery `json:"queries,omitempty"`
+	HistorySize               int64                     `json:"historySize,omitempty"`
+	HistoryCount              int64                     `json:"historyCount,omitempty"`
+	SuggestContinueAsNew      bool                      `json:"suggestContinueAsNew,omitempty"`
 }
 
 // GetWorkflowType is an internal getter (TBD...)
@@ -1075,6 +1078,30 @@ func (v *RecordDecisionTaskStartedResponse) GetQueries() (o map[string]*Workflow
 	return
 }
 
+// GetHistorySize is an internal getter (TBD...)
+func (v *RecordDecisionTaskStartedResponse) GetHistorySize() (o int64) {
+	if v != nil {
+		return v.HistorySize
+	}
+	return
+}
+
+// GetHistoryCount is an internal getter (TBD...)
+func (v *RecordDecisionTaskStartedResponse) GetHistoryCount() (o int64) {
+	if v != nil {
+		return v.HistoryCount
+	}
+	return
+}
+
+// GetSuggestContinueAsNew is an internal getter (TBD...)
+func (v *RecordDecisionTaskStartedResponse) GetSuggestContinueAsNew() (o bool) {
+	if v != nil {
+		return v.SuggestContinueAsNew
+	}
+	return
+}
+
 // HistoryRefreshWorkflowTasksRequest is an internal type (TBD...)
 type HistoryRefreshWorkflowTasksRequest struct {
 	DomainUIID string                       `json:"domainUIID,omitempty"`
@@ -1533,6 +1560,7 @@ type HistoryStartWorkflowExecutionRequest struct {
 	ContinuedFailureDetails         []byte                         `json:"continuedFailureDetails,omitempty"`
 	LastCompletionResult            []byte                         `json:"lastCompletionResult,omitempty"`
 	FirstDecisionTaskBackoffSeconds *int32                         `json:"firstDecisionTaskBackoffSeconds,omitempty"`
+	RestartedFromRunID              string                         `json:"restartedFromRunId,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -1615,6 +1643,14 @@ func (v *HistoryStartWorkflowExecutionRequest) GetFirstDecisionTaskBackoffSecond
 	return
 }
 
+// GetRestartedFromRunID is an internal getter (TBD...)
+func (v *HistoryStartWorkflowExecutionRequest) GetRestartedFromRunID() (o string) {
+	if v != nil {
+		return v.RestartedFromRunID
+	}
+	return
+}
+
 // SyncActivityRequest is an internal type (TBD...)
 type SyncActivityRequest struct {
 	DomainID           string          `json:"domainId,omitempty"`
@@ -1632,6 +1668,7 @@ type SyncActivityRequest struct {
 	LastWorkerIdentity string          `json:"lastWorkerIdentity,omitempty"`
 	LastFailureDetails []byte          `json:"lastFailureDetails,omitempty"`
 	VersionHistory     *VersionHistory `json:"versionHistory,omitempty"`
+	Paused             bool            `json:"paused,omitempty"`
 }
 
 // GetDomainID is an internal getter (TBD...)
@@ -1754,6 +1791,14 @@ func (v *SyncActivityRequest) GetVersionHistory() (o *VersionHistory) {
 	return
 }
 
+// GetPaused is an internal getter (TBD...)
+func (v *SyncActivityRequest) GetPaused() (o bool) {
+	if v != nil {
+		return v.Paused
+	}
+	return
+}
+
 // SyncShardStatusRequest is an internal type (TBD...)
 type SyncShardStatusRequest struct {
 	SourceCluster string `json:"sourceCluster,omitempty"`
@@ -1806,3 +1851,219 @@ func (v *HistoryTerminateWorkflowExecutionRequest) GetTerminateRequest() (o *Ter
 	}
 	return
 }
+
+// HistoryPauseWorkflowExecutionRequest is an internal type (TBD...)
+type HistoryPauseWorkflowExecutionRequest struct {
+	DomainUUID   string                         `json:"domainUUID,omitempty"`
+	PauseRequest *PauseWorkflowExecutionRequest `json:"pauseRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryPauseWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetPauseRequest is an internal getter (TBD...)
+func (v *HistoryPauseWorkflowExecutionRequest) GetPauseRequest() (o *PauseWorkflowExecutionRequest) {
+	if v != nil && v.PauseRequest != nil {
+		return v.PauseRequest
+	}
+	return
+}
+
+// HistoryUnpauseWorkflowExecutionRequest is an internal type (TBD...)
+type HistoryUnpauseWorkflowExecutionRequest struct {
+	DomainUUID     string                           `json:"domainUUID,omitempty"`
+	UnpauseRequest *UnpauseWorkflowExecutionRequest `json:"unpauseRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryUnpauseWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetUnpauseRequest is an internal getter (TBD...)
+func (v *HistoryUnpauseWorkflowExecutionRequest) GetUnpauseRequest() (o *UnpauseWorkflowExecutionRequest) {
+	if v != nil && v.UnpauseRequest != nil {
+		return v.UnpauseRequest
+	}
+	return
+}
+
+// HistoryDeleteWorkflowExecutionRequest is an internal type (TBD...)
+type HistoryDeleteWorkflowExecutionRequest struct {
+	DomainUUID    string                          `json:"domainUUID,omitempty"`
+	DeleteRequest *DeleteWorkflowExecutionRequest `json:"deleteRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryDeleteWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetDeleteRequest is an internal getter (TBD...)
+func (v *HistoryDeleteWorkflowExecutionRequest) GetDeleteRequest() (o *DeleteWorkflowExecutionRequest) {
+	if v != nil && v.DeleteRequest != nil {
+		return v.DeleteRequest
+	}
+	return
+}
+
+// HistoryPauseActivityRequest is an internal type (TBD...)
+type HistoryPauseActivityRequest struct {
+	DomainUUID   string                `json:"domainUUID,omitempty"`
+	PauseRequest *PauseActivityRequest `json:"pauseRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryPauseActivityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetPauseRequest is an internal getter (TBD...)
+func (v *HistoryPauseActivityRequest) GetPauseRequest() (o *PauseActivityRequest) {
+	if v != nil && v.PauseRequest != nil {
+		return v.PauseRequest
+	}
+	return
+}
+
+// HistoryUnpauseActivityRequest is an internal type (TBD...)
+type HistoryUnpauseActivityRequest struct {
+	DomainUUID     string                  `json:"domainUUID,omitempty"`
+	UnpauseRequest *UnpauseActivityRequest `json:"unpauseRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryUnpauseActivityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetUnpauseRequest is an internal getter (TBD...)
+func (v *HistoryUnpauseActivityRequest) GetUnpauseRequest() (o *UnpauseActivityRequest) {
+	if v != nil && v.UnpauseRequest != nil {
+		return v.UnpauseRequest
+	}
+	return
+}
+
+// HistoryResetActivityRequest is an internal type (TBD...)
+type HistoryResetActivityRequest struct {
+	DomainUUID   string                `json:"domainUUID,omitempty"`
+	ResetRequest *ResetActivityRequest `json:"resetRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryResetActivityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetResetRequest is an internal getter (TBD...)
+func (v *HistoryResetActivityRequest) GetResetRequest() (o *ResetActivityRequest) {
+	if v != nil && v.ResetRequest != nil {
+		return v.ResetRequest
+	}
+	return
+}
+
+// HistoryUpdateWorkflowExecutionRequest is an internal type (TBD...)
+type HistoryUpdateWorkflowExecutionRequest struct {
+	DomainUUID string                          `json:"domainUUID,omitempty"`
+	Request    *UpdateWorkflowExecutionRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryUpdateWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *HistoryUpdateWorkflowExecutionRequest) GetRequest() (o *UpdateWorkflowExecutionRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
+// HistoryUpdateWorkflowExecutionResponse is an internal type (TBD...)
+type HistoryUpdateWorkflowExecutionResponse struct {
+	Response *UpdateWorkflowExecutionResponse `json:"response,omitempty"`
+}
+
+// GetResponse is an internal getter (TBD...)
+func (v *HistoryUpdateWorkflowExecutionResponse) GetResponse() (o *UpdateWorkflowExecutionResponse) {
+	if v != nil && v.Response != nil {
+		return v.Response
+	}
+	return
+}
+
+// HistoryMigrateWorkflowExecutionRequest is an internal type (TBD...)
+type HistoryMigrateWorkflowExecutionRequest struct {
+	DomainUUID    string `json:"domainUUID,omitempty"`
+	WorkflowID    string `json:"workflowID,omitempty"`
+	RunID         string `json:"runID,omitempty"`
+	SourceCluster string `json:"sourceCluster,omitempty"`
+	SourceDomain  string `json:"sourceDomain,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryMigrateWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *HistoryMigrateWorkflowExecutionRequest) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *HistoryMigrateWorkflowExecutionRequest) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+// GetSourceCluster is an internal getter (TBD...)
+func (v *HistoryMigrateWorkflowExecutionRequest) GetSourceCluster() (o string) {
+	if v != nil {
+		return v.SourceCluster
+	}
+	return
+}
+
+// GetSourceDomain is an internal getter (TBD...)
+func (v *HistoryMigrateWorkflowExecutionRequest) GetSourceDomain() (o string) {
+	if v != nil {
+		return v.SourceDomain
+	}
+	return
+}
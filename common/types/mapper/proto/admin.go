@@ -22,6 +22,7 @@ package proto
 
 import (
 	adminv1 "github.com/uber/cadence/.gen/proto/admin/v1"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -722,3 +723,542 @@ func ToAdminGetCrossClusterTasksResponse(t *adminv1.GetCrossClusterTasksResponse
 		FailedCauseByShard: ToGetTaskFailedCauseMap(t.FailedCauseByShard),
 	}
 }
+
+func FromAdminPauseTaskListRequest(t *types.PauseTaskListRequest) *adminv1.PauseTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.PauseTaskListRequest{
+		Domain:       t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+func ToAdminPauseTaskListRequest(t *adminv1.PauseTaskListRequest) *types.PauseTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseTaskListRequest{
+		Domain:       t.Domain,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+func FromAdminResumeTaskListRequest(t *types.ResumeTaskListRequest) *adminv1.ResumeTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ResumeTaskListRequest{
+		Domain:       t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+func ToAdminResumeTaskListRequest(t *adminv1.ResumeTaskListRequest) *types.ResumeTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResumeTaskListRequest{
+		Domain:       t.Domain,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+func FromAdminMoveTaskListBacklogRequest(t *types.MoveTaskListBacklogRequest) *adminv1.MoveTaskListBacklogRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.MoveTaskListBacklogRequest{
+		Domain:         t.Domain,
+		SourceTaskList: FromTaskList(t.SourceTaskList),
+		TargetTaskList: FromTaskList(t.TargetTaskList),
+		TaskListType:   FromTaskListType(t.TaskListType),
+		BatchSize:      t.BatchSize,
+		AfterTaskId:    t.AfterTaskID,
+		DryRun:         t.DryRun,
+	}
+}
+
+func ToAdminMoveTaskListBacklogRequest(t *adminv1.MoveTaskListBacklogRequest) *types.MoveTaskListBacklogRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MoveTaskListBacklogRequest{
+		Domain:         t.Domain,
+		SourceTaskList: ToTaskList(t.SourceTaskList),
+		TargetTaskList: ToTaskList(t.TargetTaskList),
+		TaskListType:   ToTaskListType(t.TaskListType),
+		BatchSize:      t.BatchSize,
+		AfterTaskID:    t.AfterTaskId,
+		DryRun:         t.DryRun,
+	}
+}
+
+func FromAdminMoveTaskListBacklogResponse(t *types.MoveTaskListBacklogResponse) *adminv1.MoveTaskListBacklogResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.MoveTaskListBacklogResponse{
+		Count:      t.Count,
+		LastTaskId: t.LastTaskID,
+		HasMore:    t.HasMore,
+	}
+}
+
+func ToAdminMoveTaskListBacklogResponse(t *adminv1.MoveTaskListBacklogResponse) *types.MoveTaskListBacklogResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MoveTaskListBacklogResponse{
+		Count:      t.Count,
+		LastTaskID: t.LastTaskId,
+		HasMore:    t.HasMore,
+	}
+}
+
+func FromAdminReadHistoryTaskDLQRequest(t *types.ReadHistoryTaskDLQRequest) *adminv1.ReadHistoryTaskDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ReadHistoryTaskDLQRequest{
+		ShardId:              t.ShardID,
+		ExclusiveBeginTaskId: fromInt64Value(t.ExclusiveBeginTaskID),
+		InclusiveEndTaskId:   fromInt64Value(t.InclusiveEndTaskID),
+		PageSize:             t.MaximumPageSize,
+		NextPageToken:        t.NextPageToken,
+	}
+}
+
+func ToAdminReadHistoryTaskDLQRequest(t *adminv1.ReadHistoryTaskDLQRequest) *types.ReadHistoryTaskDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ReadHistoryTaskDLQRequest{
+		ShardID:              t.ShardId,
+		ExclusiveBeginTaskID: toInt64Value(t.ExclusiveBeginTaskId),
+		InclusiveEndTaskID:   toInt64Value(t.InclusiveEndTaskId),
+		MaximumPageSize:      t.PageSize,
+		NextPageToken:        t.NextPageToken,
+	}
+}
+
+func FromAdminReadHistoryTaskDLQResponse(t *types.ReadHistoryTaskDLQResponse) *adminv1.ReadHistoryTaskDLQResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ReadHistoryTaskDLQResponse{
+		Entries:       FromHistoryTaskDLQEntryArray(t.Entries),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToAdminReadHistoryTaskDLQResponse(t *adminv1.ReadHistoryTaskDLQResponse) *types.ReadHistoryTaskDLQResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ReadHistoryTaskDLQResponse{
+		Entries:       ToHistoryTaskDLQEntryArray(t.Entries),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromAdminPurgeHistoryTaskDLQRequest(t *types.PurgeHistoryTaskDLQRequest) *adminv1.PurgeHistoryTaskDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.PurgeHistoryTaskDLQRequest{
+		ShardId:              t.ShardID,
+		ExclusiveBeginTaskId: fromInt64Value(t.ExclusiveBeginTaskID),
+		InclusiveEndTaskId:   fromInt64Value(t.InclusiveEndTaskID),
+	}
+}
+
+func ToAdminPurgeHistoryTaskDLQRequest(t *adminv1.PurgeHistoryTaskDLQRequest) *types.PurgeHistoryTaskDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PurgeHistoryTaskDLQRequest{
+		ShardID:              t.ShardId,
+		ExclusiveBeginTaskID: toInt64Value(t.ExclusiveBeginTaskId),
+		InclusiveEndTaskID:   toInt64Value(t.InclusiveEndTaskId),
+	}
+}
+
+func FromAdminRetryHistoryTaskDLQRequest(t *types.RetryHistoryTaskDLQRequest) *adminv1.RetryHistoryTaskDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.RetryHistoryTaskDLQRequest{
+		ShardId:              t.ShardID,
+		ExclusiveBeginTaskId: fromInt64Value(t.ExclusiveBeginTaskID),
+		InclusiveEndTaskId:   fromInt64Value(t.InclusiveEndTaskID),
+		PageSize:             t.MaximumPageSize,
+		NextPageToken:        t.NextPageToken,
+	}
+}
+
+func ToAdminRetryHistoryTaskDLQRequest(t *adminv1.RetryHistoryTaskDLQRequest) *types.RetryHistoryTaskDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RetryHistoryTaskDLQRequest{
+		ShardID:              t.ShardId,
+		ExclusiveBeginTaskID: toInt64Value(t.ExclusiveBeginTaskId),
+		InclusiveEndTaskID:   toInt64Value(t.InclusiveEndTaskId),
+		MaximumPageSize:      t.PageSize,
+		NextPageToken:        t.NextPageToken,
+	}
+}
+
+func FromAdminRetryHistoryTaskDLQResponse(t *types.RetryHistoryTaskDLQResponse) *adminv1.RetryHistoryTaskDLQResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.RetryHistoryTaskDLQResponse{
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToAdminRetryHistoryTaskDLQResponse(t *adminv1.RetryHistoryTaskDLQResponse) *types.RetryHistoryTaskDLQResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.RetryHistoryTaskDLQResponse{
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromAdminDescribeReplicationStatusRequest(t *types.DescribeReplicationStatusRequest) *adminv1.DescribeReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.DescribeReplicationStatusRequest{
+		ClusterName: t.ClusterName,
+		Domain:      t.Domain,
+		ShardIds:    t.ShardIDs,
+	}
+}
+
+func ToAdminDescribeReplicationStatusRequest(t *adminv1.DescribeReplicationStatusRequest) *types.DescribeReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeReplicationStatusRequest{
+		ClusterName: t.ClusterName,
+		Domain:      t.Domain,
+		ShardIDs:    t.ShardIds,
+	}
+}
+
+func FromAdminDescribeReplicationStatusResponse(t *types.DescribeReplicationStatusResponse) *adminv1.DescribeReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.DescribeReplicationStatusResponse{
+		Shards:  FromReplicationShardStatusArray(t.Shards),
+		Domains: FromReplicationDomainStatusArray(t.Domains),
+	}
+}
+
+func ToAdminDescribeReplicationStatusResponse(t *adminv1.DescribeReplicationStatusResponse) *types.DescribeReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeReplicationStatusResponse{
+		Shards:  ToReplicationShardStatusArray(t.Shards),
+		Domains: ToReplicationDomainStatusArray(t.Domains),
+	}
+}
+
+func FromAdminMigrateWorkflowExecutionRequest(t *types.MigrateWorkflowExecutionRequest) *adminv1.MigrateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.MigrateWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowRunPair(t.WorkflowID, t.RunID),
+		SourceCluster:     t.SourceCluster,
+		SourceDomain:      t.SourceDomain,
+	}
+}
+
+func ToAdminMigrateWorkflowExecutionRequest(t *adminv1.MigrateWorkflowExecutionRequest) *types.MigrateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MigrateWorkflowExecutionRequest{
+		Domain:        t.Domain,
+		WorkflowID:    ToWorkflowID(t.WorkflowExecution),
+		RunID:         ToRunID(t.WorkflowExecution),
+		SourceCluster: t.SourceCluster,
+		SourceDomain:  t.SourceDomain,
+	}
+}
+
+func FromAdminMigrateWorkflowExecutionResponse(t *types.MigrateWorkflowExecutionResponse) *adminv1.MigrateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.MigrateWorkflowExecutionResponse{
+		RunId:       t.RunID,
+		NextEventId: t.NextEventID,
+	}
+}
+
+func ToAdminMigrateWorkflowExecutionResponse(t *adminv1.MigrateWorkflowExecutionResponse) *types.MigrateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MigrateWorkflowExecutionResponse{
+		RunID:       t.RunId,
+		NextEventID: t.NextEventId,
+	}
+}
+
+func FromAdminAddRemoteClusterRequest(t *types.AddRemoteClusterRequest) *adminv1.AddRemoteClusterRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.AddRemoteClusterRequest{
+		ClusterName:            t.ClusterName,
+		InitialFailoverVersion: t.InitialFailoverVersion,
+		RpcName:                t.RPCName,
+		RpcAddress:             t.RPCAddress,
+	}
+}
+
+func ToAdminAddRemoteClusterRequest(t *adminv1.AddRemoteClusterRequest) *types.AddRemoteClusterRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.AddRemoteClusterRequest{
+		ClusterName:            t.ClusterName,
+		InitialFailoverVersion: t.InitialFailoverVersion,
+		RPCName:                t.RpcName,
+		RPCAddress:             t.RpcAddress,
+	}
+}
+
+func FromAdminRemoveRemoteClusterRequest(t *types.RemoveRemoteClusterRequest) *adminv1.RemoveRemoteClusterRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.RemoveRemoteClusterRequest{
+		ClusterName: t.ClusterName,
+	}
+}
+
+func ToAdminRemoveRemoteClusterRequest(t *adminv1.RemoveRemoteClusterRequest) *types.RemoveRemoteClusterRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RemoveRemoteClusterRequest{
+		ClusterName: t.ClusterName,
+	}
+}
+
+func FromAdminListDomainChangesRequest(t *types.ListDomainChangesRequest) *adminv1.ListDomainChangesRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ListDomainChangesRequest{
+		Domain:        t.Domain,
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToAdminListDomainChangesRequest(t *adminv1.ListDomainChangesRequest) *types.ListDomainChangesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListDomainChangesRequest{
+		Domain:        t.Domain,
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromAdminListDomainChangesResponse(t *types.ListDomainChangesResponse) *adminv1.ListDomainChangesResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ListDomainChangesResponse{
+		Changes:       FromDomainChangeArray(t.Changes),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToAdminListDomainChangesResponse(t *adminv1.ListDomainChangesResponse) *types.ListDomainChangesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListDomainChangesResponse{
+		Changes:       ToDomainChangeArray(t.Changes),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromDomainChange(t *types.DomainChange) *adminv1.DomainChange {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.DomainChange{
+		Id:           t.ID,
+		DomainId:     t.DomainID,
+		DomainName:   t.DomainName,
+		Operation:    t.Operation,
+		Actor:        t.Actor,
+		Reason:       t.Reason,
+		Timestamp:    unixNanoToTime(&t.Timestamp),
+		FieldChanges: FromDomainFieldChangeArray(t.FieldChanges),
+	}
+}
+
+func ToDomainChange(t *adminv1.DomainChange) *types.DomainChange {
+	if t == nil {
+		return nil
+	}
+	return &types.DomainChange{
+		ID:           t.Id,
+		DomainID:     t.DomainId,
+		DomainName:   t.DomainName,
+		Operation:    t.Operation,
+		Actor:        t.Actor,
+		Reason:       t.Reason,
+		Timestamp:    common.Int64Default(timeToUnixNano(t.Timestamp)),
+		FieldChanges: ToDomainFieldChangeArray(t.FieldChanges),
+	}
+}
+
+func FromDomainChangeArray(t []*types.DomainChange) []*adminv1.DomainChange {
+	if t == nil {
+		return nil
+	}
+	v := make([]*adminv1.DomainChange, len(t))
+	for i := range t {
+		v[i] = FromDomainChange(t[i])
+	}
+	return v
+}
+
+func ToDomainChangeArray(t []*adminv1.DomainChange) []*types.DomainChange {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DomainChange, len(t))
+	for i := range t {
+		v[i] = ToDomainChange(t[i])
+	}
+	return v
+}
+
+func FromDomainFieldChange(t *types.DomainFieldChange) *adminv1.DomainFieldChange {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.DomainFieldChange{
+		Field:    t.Field,
+		OldValue: t.OldValue,
+		NewValue: t.NewValue,
+	}
+}
+
+func ToDomainFieldChange(t *adminv1.DomainFieldChange) *types.DomainFieldChange {
+	if t == nil {
+		return nil
+	}
+	return &types.DomainFieldChange{
+		Field:    t.Field,
+		OldValue: t.OldValue,
+		NewValue: t.NewValue,
+	}
+}
+
+func FromDomainFieldChangeArray(t []*types.DomainFieldChange) []*adminv1.DomainFieldChange {
+	if t == nil {
+		return nil
+	}
+	v := make([]*adminv1.DomainFieldChange, len(t))
+	for i := range t {
+		v[i] = FromDomainFieldChange(t[i])
+	}
+	return v
+}
+
+func ToDomainFieldChangeArray(t []*adminv1.DomainFieldChange) []*types.DomainFieldChange {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DomainFieldChange, len(t))
+	for i := range t {
+		v[i] = ToDomainFieldChange(t[i])
+	}
+	return v
+}
+
+func FromAdminCloneDomainRequest(t *types.CloneDomainRequest) *adminv1.CloneDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.CloneDomainRequest{
+		SourceName: t.SourceName,
+		Name:       t.Name,
+	}
+}
+
+func ToAdminCloneDomainRequest(t *adminv1.CloneDomainRequest) *types.CloneDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.CloneDomainRequest{
+		SourceName: t.SourceName,
+		Name:       t.Name,
+	}
+}
+
+func FromAdminRenameDomainRequest(t *types.RenameDomainRequest) *adminv1.RenameDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.RenameDomainRequest{
+		Name:    t.Name,
+		NewName: t.NewName,
+	}
+}
+
+func ToAdminRenameDomainRequest(t *adminv1.RenameDomainRequest) *types.RenameDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RenameDomainRequest{
+		Name:    t.Name,
+		NewName: t.NewName,
+	}
+}
+
+func FromAdminDeleteDomainRequest(t *types.DeleteDomainRequest) *adminv1.DeleteDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.DeleteDomainRequest{
+		Name:        t.Name,
+		Concurrency: t.Concurrency,
+		PageSize:    t.PageSize,
+	}
+}
+
+func ToAdminDeleteDomainRequest(t *adminv1.DeleteDomainRequest) *types.DeleteDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteDomainRequest{
+		Name:        t.Name,
+		Concurrency: t.Concurrency,
+		PageSize:    t.PageSize,
+	}
+}
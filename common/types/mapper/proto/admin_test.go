@@ -167,6 +167,26 @@ func TestAdminResendReplicationTasksRequest(t *testing.T) {
 		assert.Equal(t, item, ToAdminResendReplicationTasksRequest(FromAdminResendReplicationTasksRequest(item)))
 	}
 }
+func TestAdminPauseTaskListRequest(t *testing.T) {
+	for _, item := range []*types.PauseTaskListRequest{nil, {}, &testdata.AdminPauseTaskListRequest} {
+		assert.Equal(t, item, ToAdminPauseTaskListRequest(FromAdminPauseTaskListRequest(item)))
+	}
+}
+func TestAdminResumeTaskListRequest(t *testing.T) {
+	for _, item := range []*types.ResumeTaskListRequest{nil, {}, &testdata.AdminResumeTaskListRequest} {
+		assert.Equal(t, item, ToAdminResumeTaskListRequest(FromAdminResumeTaskListRequest(item)))
+	}
+}
+func TestAdminMoveTaskListBacklogRequest(t *testing.T) {
+	for _, item := range []*types.MoveTaskListBacklogRequest{nil, {}, &testdata.AdminMoveTaskListBacklogRequest} {
+		assert.Equal(t, item, ToAdminMoveTaskListBacklogRequest(FromAdminMoveTaskListBacklogRequest(item)))
+	}
+}
+func TestAdminMoveTaskListBacklogResponse(t *testing.T) {
+	for _, item := range []*types.MoveTaskListBacklogResponse{nil, {}, &testdata.AdminMoveTaskListBacklogResponse} {
+		assert.Equal(t, item, ToAdminMoveTaskListBacklogResponse(FromAdminMoveTaskListBacklogResponse(item)))
+	}
+}
 func TestAdminResetQueueRequest(t *testing.T) {
 	for _, item := range []*types.ResetQueueRequest{nil, {}, &testdata.AdminResetQueueRequest} {
 		assert.Equal(t, item, ToAdminResetQueueRequest(FromAdminResetQueueRequest(item)))
@@ -184,3 +204,83 @@ func TestAdminGetCrossClusterTasksResponse(t *testing.T) {
 		assert.Equal(t, item, ToAdminGetCrossClusterTasksResponse(FromAdminGetCrossClusterTasksResponse(item)))
 	}
 }
+func TestAdminReadHistoryTaskDLQRequest(t *testing.T) {
+	for _, item := range []*types.ReadHistoryTaskDLQRequest{nil, {}, &testdata.AdminReadHistoryTaskDLQRequest} {
+		assert.Equal(t, item, ToAdminReadHistoryTaskDLQRequest(FromAdminReadHistoryTaskDLQRequest(item)))
+	}
+}
+func TestAdminReadHistoryTaskDLQResponse(t *testing.T) {
+	for _, item := range []*types.ReadHistoryTaskDLQResponse{nil, {}, &testdata.AdminReadHistoryTaskDLQResponse} {
+		assert.Equal(t, item, ToAdminReadHistoryTaskDLQResponse(FromAdminReadHistoryTaskDLQResponse(item)))
+	}
+}
+func TestAdminPurgeHistoryTaskDLQRequest(t *testing.T) {
+	for _, item := range []*types.PurgeHistoryTaskDLQRequest{nil, {}, &testdata.AdminPurgeHistoryTaskDLQRequest} {
+		assert.Equal(t, item, ToAdminPurgeHistoryTaskDLQRequest(FromAdminPurgeHistoryTaskDLQRequest(item)))
+	}
+}
+func TestAdminRetryHistoryTaskDLQRequest(t *testing.T) {
+	for _, item := range []*types.RetryHistoryTaskDLQRequest{nil, {}, &testdata.AdminRetryHistoryTaskDLQRequest} {
+		assert.Equal(t, item, ToAdminRetryHistoryTaskDLQRequest(FromAdminRetryHistoryTaskDLQRequest(item)))
+	}
+}
+func TestAdminRetryHistoryTaskDLQResponse(t *testing.T) {
+	for _, item := range []*types.RetryHistoryTaskDLQResponse{nil, {}, &testdata.AdminRetryHistoryTaskDLQResponse} {
+		assert.Equal(t, item, ToAdminRetryHistoryTaskDLQResponse(FromAdminRetryHistoryTaskDLQResponse(item)))
+	}
+}
+func TestAdminDescribeReplicationStatusRequest(t *testing.T) {
+	for _, item := range []*types.DescribeReplicationStatusRequest{nil, {}, &testdata.AdminDescribeReplicationStatusRequest} {
+		assert.Equal(t, item, ToAdminDescribeReplicationStatusRequest(FromAdminDescribeReplicationStatusRequest(item)))
+	}
+}
+func TestAdminDescribeReplicationStatusResponse(t *testing.T) {
+	for _, item := range []*types.DescribeReplicationStatusResponse{nil, {}, &testdata.AdminDescribeReplicationStatusResponse} {
+		assert.Equal(t, item, ToAdminDescribeReplicationStatusResponse(FromAdminDescribeReplicationStatusResponse(item)))
+	}
+}
+func TestAdminMigrateWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.MigrateWorkflowExecutionRequest{nil, {}, &testdata.AdminMigrateWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToAdminMigrateWorkflowExecutionRequest(FromAdminMigrateWorkflowExecutionRequest(item)))
+	}
+}
+func TestAdminMigrateWorkflowExecutionResponse(t *testing.T) {
+	for _, item := range []*types.MigrateWorkflowExecutionResponse{nil, {}, &testdata.AdminMigrateWorkflowExecutionResponse} {
+		assert.Equal(t, item, ToAdminMigrateWorkflowExecutionResponse(FromAdminMigrateWorkflowExecutionResponse(item)))
+	}
+}
+func TestAdminAddRemoteClusterRequest(t *testing.T) {
+	for _, item := range []*types.AddRemoteClusterRequest{nil, {}, &testdata.AdminAddRemoteClusterRequest} {
+		assert.Equal(t, item, ToAdminAddRemoteClusterRequest(FromAdminAddRemoteClusterRequest(item)))
+	}
+}
+func TestAdminRemoveRemoteClusterRequest(t *testing.T) {
+	for _, item := range []*types.RemoveRemoteClusterRequest{nil, {}, &testdata.AdminRemoveRemoteClusterRequest} {
+		assert.Equal(t, item, ToAdminRemoveRemoteClusterRequest(FromAdminRemoveRemoteClusterRequest(item)))
+	}
+}
+func TestAdminCloneDomainRequest(t *testing.T) {
+	for _, item := range []*types.CloneDomainRequest{nil, {}, &testdata.AdminCloneDomainRequest} {
+		assert.Equal(t, item, ToAdminCloneDomainRequest(FromAdminCloneDomainRequest(item)))
+	}
+}
+func TestAdminRenameDomainRequest(t *testing.T) {
+	for _, item := range []*types.RenameDomainRequest{nil, {}, &testdata.AdminRenameDomainRequest} {
+		assert.Equal(t, item, ToAdminRenameDomainRequest(FromAdminRenameDomainRequest(item)))
+	}
+}
+func TestAdminDeleteDomainRequest(t *testing.T) {
+	for _, item := range []*types.DeleteDomainRequest{nil, {}, &testdata.AdminDeleteDomainRequest} {
+		assert.Equal(t, item, ToAdminDeleteDomainRequest(FromAdminDeleteDomainRequest(item)))
+	}
+}
+func TestAdminListDomainChangesRequest(t *testing.T) {
+	for _, item := range []*types.ListDomainChangesRequest{nil, {}, &testdata.AdminListDomainChangesRequest} {
+		assert.Equal(t, item, ToAdminListDomainChangesRequest(FromAdminListDomainChangesRequest(item)))
+	}
+}
+func TestAdminListDomainChangesResponse(t *testing.T) {
+	for _, item := range []*types.ListDomainChangesResponse{nil, {}, &testdata.AdminListDomainChangesResponse} {
+		assert.Equal(t, item, ToAdminListDomainChangesResponse(FromAdminListDomainChangesResponse(item)))
+	}
+}
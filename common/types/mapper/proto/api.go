@@ -302,6 +302,30 @@ func ToBadBinaries(t *apiv1.BadBinaries) *types.BadBinaries {
 	}
 }
 
+func FromDomainLimits(t *types.DomainLimits) *apiv1.DomainLimits {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DomainLimits{
+		RequestsPerSecond:      t.RequestsPerSecond,
+		MaxConcurrentWorkflows: t.MaxConcurrentWorkflows,
+		MaxPendingActivities:   t.MaxPendingActivities,
+		MaxHistorySize:         t.MaxHistorySize,
+	}
+}
+
+func ToDomainLimits(t *apiv1.DomainLimits) *types.DomainLimits {
+	if t == nil {
+		return nil
+	}
+	return &types.DomainLimits{
+		RequestsPerSecond:      t.RequestsPerSecond,
+		MaxConcurrentWorkflows: t.MaxConcurrentWorkflows,
+		MaxPendingActivities:   t.MaxPendingActivities,
+		MaxHistorySize:         t.MaxHistorySize,
+	}
+}
+
 func FromBadBinaryInfo(t *types.BadBinaryInfo) *apiv1.BadBinaryInfo {
 	if t == nil {
 		return nil
@@ -836,6 +860,10 @@ func FromDecisionTaskFailedCause(t *types.DecisionTaskFailedCause) apiv1.Decisio
 		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_SCHEDULE_ACTIVITY_DUPLICATE_ID
 	case types.DecisionTaskFailedCauseBadSearchAttributes:
 		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES
+	case types.DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_COMPLETE_WORKFLOW_UPDATE_ATTRIBUTES
+	case types.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES
 	}
 	panic("unexpected enum value")
 }
@@ -890,6 +918,10 @@ func ToDecisionTaskFailedCause(t apiv1.DecisionTaskFailedCause) *types.DecisionT
 		return types.DecisionTaskFailedCauseScheduleActivityDuplicateID.Ptr()
 	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES:
 		return types.DecisionTaskFailedCauseBadSearchAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_COMPLETE_WORKFLOW_UPDATE_ATTRIBUTES:
+		return types.DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES:
+		return types.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes.Ptr()
 	}
 	panic("unexpected enum value")
 }
@@ -1074,6 +1106,7 @@ func FromDescribeDomainResponseDomain(t *types.DescribeDomainResponse) *apiv1.Do
 		domain.HistoryArchivalUri = config.HistoryArchivalURI
 		domain.VisibilityArchivalStatus = FromArchivalStatus(config.VisibilityArchivalStatus)
 		domain.VisibilityArchivalUri = config.VisibilityArchivalURI
+		domain.Limits = FromDomainLimits(config.Limits)
 	}
 	if repl := t.ReplicationConfiguration; repl != nil {
 		domain.ActiveClusterName = repl.ActiveClusterName
@@ -1112,6 +1145,7 @@ func ToDescribeDomainResponseDomain(t *apiv1.Domain) *types.DescribeDomainRespon
 			HistoryArchivalURI:                     t.HistoryArchivalUri,
 			VisibilityArchivalStatus:               ToArchivalStatus(t.VisibilityArchivalStatus),
 			VisibilityArchivalURI:                  t.VisibilityArchivalUri,
+			Limits:                                 ToDomainLimits(t.Limits),
 		},
 		ReplicationConfiguration: &types.DomainReplicationConfiguration{
 			ActiveClusterName: t.ActiveClusterName,
@@ -1203,6 +1237,7 @@ func FromDescribeWorkflowExecutionResponse(t *types.DescribeWorkflowExecutionRes
 		PendingActivities:      FromPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        FromPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        FromPendingDecisionInfo(t.PendingDecision),
+		SignalRequestIds:       unixNanoMapToTimeMap(t.SignalRequestIDs),
 	}
 }
 
@@ -1216,6 +1251,7 @@ func ToDescribeWorkflowExecutionResponse(t *apiv1.DescribeWorkflowExecutionRespo
 		PendingActivities:      ToPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        ToPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        ToPendingDecisionInfo(t.PendingDecision),
+		SignalRequestIDs:       timeMapToUnixNanoMap(t.SignalRequestIds),
 	}
 }
 
@@ -1525,6 +1561,8 @@ func FromIndexedValueType(t types.IndexedValueType) apiv1.IndexedValueType {
 		return apiv1.IndexedValueType_INDEXED_VALUE_TYPE_BOOL
 	case types.IndexedValueTypeDatetime:
 		return apiv1.IndexedValueType_INDEXED_VALUE_TYPE_DATETIME
+	case types.IndexedValueTypeKeywordList:
+		return apiv1.IndexedValueType_INDEXED_VALUE_TYPE_KEYWORD_LIST
 	}
 	panic("unexpected enum value")
 }
@@ -1545,6 +1583,8 @@ func ToIndexedValueType(t apiv1.IndexedValueType) types.IndexedValueType {
 		return types.IndexedValueTypeBool
 	case apiv1.IndexedValueType_INDEXED_VALUE_TYPE_DATETIME:
 		return types.IndexedValueTypeDatetime
+	case apiv1.IndexedValueType_INDEXED_VALUE_TYPE_KEYWORD_LIST:
+		return types.IndexedValueTypeKeywordList
 	}
 	panic("unexpected enum value")
 }
@@ -2126,6 +2166,9 @@ func FromPollForDecisionTaskResponse(t *types.PollForDecisionTaskResponse) *apiv
 		StartedTime:               unixNanoToTime(t.StartedTimestamp),
 		Queries:                   FromWorkflowQueryMap(t.Queries),
 		NextEventId:               t.NextEventID,
+		HistorySize:               t.HistorySize,
+		HistoryCount:              t.HistoryCount,
+		SuggestContinueAsNew:      t.SuggestContinueAsNew,
 	}
 }
 
@@ -2149,6 +2192,9 @@ func ToPollForDecisionTaskResponse(t *apiv1.PollForDecisionTaskResponse) *types.
 		StartedTimestamp:          timeToUnixNano(t.StartedTime),
 		Queries:                   ToWorkflowQueryMap(t.Queries),
 		NextEventID:               t.NextEventId,
+		HistorySize:               t.HistorySize,
+		HistoryCount:              t.HistoryCount,
+		SuggestContinueAsNew:      t.SuggestContinueAsNew,
 	}
 }
 
@@ -2463,147 +2509,762 @@ func ToRegisterDomainRequest(t *apiv1.RegisterDomainRequest) *types.RegisterDoma
 	}
 }
 
-func FromRequestCancelActivityTaskDecisionAttributes(t *types.RequestCancelActivityTaskDecisionAttributes) *apiv1.RequestCancelActivityTaskDecisionAttributes {
+func FromRequestCancelActivityTaskDecisionAttributes(t *types.RequestCancelActivityTaskDecisionAttributes) *apiv1.RequestCancelActivityTaskDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelActivityTaskDecisionAttributes{
+		ActivityId: t.ActivityID,
+	}
+}
+
+func ToRequestCancelActivityTaskDecisionAttributes(t *apiv1.RequestCancelActivityTaskDecisionAttributes) *types.RequestCancelActivityTaskDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.RequestCancelActivityTaskDecisionAttributes{
+		ActivityID: t.ActivityId,
+	}
+}
+
+func FromRequestCancelActivityTaskFailedEventAttributes(t *types.RequestCancelActivityTaskFailedEventAttributes) *apiv1.RequestCancelActivityTaskFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelActivityTaskFailedEventAttributes{
+		ActivityId:                   t.ActivityID,
+		Cause:                        t.Cause,
+		DecisionTaskCompletedEventId: t.DecisionTaskCompletedEventID,
+	}
+}
+
+func ToRequestCancelActivityTaskFailedEventAttributes(t *apiv1.RequestCancelActivityTaskFailedEventAttributes) *types.RequestCancelActivityTaskFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.RequestCancelActivityTaskFailedEventAttributes{
+		ActivityID:                   t.ActivityId,
+		Cause:                        t.Cause,
+		DecisionTaskCompletedEventID: t.DecisionTaskCompletedEventId,
+	}
+}
+
+func FromRequestCancelExternalWorkflowExecutionDecisionAttributes(t *types.RequestCancelExternalWorkflowExecutionDecisionAttributes) *apiv1.RequestCancelExternalWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelExternalWorkflowExecutionDecisionAttributes{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowRunPair(t.WorkflowID, t.RunID),
+		Control:           t.Control,
+		ChildWorkflowOnly: t.ChildWorkflowOnly,
+	}
+}
+
+func ToRequestCancelExternalWorkflowExecutionDecisionAttributes(t *apiv1.RequestCancelExternalWorkflowExecutionDecisionAttributes) *types.RequestCancelExternalWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.RequestCancelExternalWorkflowExecutionDecisionAttributes{
+		Domain:            t.Domain,
+		WorkflowID:        ToWorkflowID(t.WorkflowExecution),
+		RunID:             ToRunID(t.WorkflowExecution),
+		Control:           t.Control,
+		ChildWorkflowOnly: t.ChildWorkflowOnly,
+	}
+}
+
+func FromRequestCancelExternalWorkflowExecutionFailedEventAttributes(t *types.RequestCancelExternalWorkflowExecutionFailedEventAttributes) *apiv1.RequestCancelExternalWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        FromCancelExternalWorkflowExecutionFailedCause(t.Cause),
+		DecisionTaskCompletedEventId: t.DecisionTaskCompletedEventID,
+		Domain:                       t.Domain,
+		WorkflowExecution:            FromWorkflowExecution(t.WorkflowExecution),
+		InitiatedEventId:             t.InitiatedEventID,
+		Control:                      t.Control,
+	}
+}
+
+func ToRequestCancelExternalWorkflowExecutionFailedEventAttributes(t *apiv1.RequestCancelExternalWorkflowExecutionFailedEventAttributes) *types.RequestCancelExternalWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.RequestCancelExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        ToCancelExternalWorkflowExecutionFailedCause(t.Cause),
+		DecisionTaskCompletedEventID: t.DecisionTaskCompletedEventId,
+		Domain:                       t.Domain,
+		WorkflowExecution:            ToWorkflowExecution(t.WorkflowExecution),
+		InitiatedEventID:             t.InitiatedEventId,
+		Control:                      t.Control,
+	}
+}
+
+func FromRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(t *types.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) *apiv1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: t.DecisionTaskCompletedEventID,
+		Domain:                       t.Domain,
+		WorkflowExecution:            FromWorkflowExecution(t.WorkflowExecution),
+		Control:                      t.Control,
+		ChildWorkflowOnly:            t.ChildWorkflowOnly,
+	}
+}
+
+func ToRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(t *apiv1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) *types.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventID: t.DecisionTaskCompletedEventId,
+		Domain:                       t.Domain,
+		WorkflowExecution:            ToWorkflowExecution(t.WorkflowExecution),
+		Control:                      t.Control,
+		ChildWorkflowOnly:            t.ChildWorkflowOnly,
+	}
+}
+
+func FromRequestCancelWorkflowExecutionRequest(t *types.RequestCancelWorkflowExecutionRequest) *apiv1.RequestCancelWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Identity:          t.Identity,
+		RequestId:         t.RequestID,
+	}
+}
+
+func ToRequestCancelWorkflowExecutionRequest(t *apiv1.RequestCancelWorkflowExecutionRequest) *types.RequestCancelWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RequestCancelWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Identity:          t.Identity,
+		RequestID:         t.RequestId,
+	}
+}
+
+func FromPauseWorkflowExecutionRequest(t *types.PauseWorkflowExecutionRequest) *apiv1.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PauseWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+		RequestId:         t.RequestID,
+	}
+}
+
+func ToPauseWorkflowExecutionRequest(t *apiv1.PauseWorkflowExecutionRequest) *types.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+		RequestID:         t.RequestId,
+	}
+}
+
+func FromUnpauseWorkflowExecutionRequest(t *types.UnpauseWorkflowExecutionRequest) *apiv1.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UnpauseWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+		RequestId:         t.RequestID,
+	}
+}
+
+func ToUnpauseWorkflowExecutionRequest(t *apiv1.UnpauseWorkflowExecutionRequest) *types.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+		RequestID:         t.RequestId,
+	}
+}
+
+func FromDeleteWorkflowExecutionRequest(t *types.DeleteWorkflowExecutionRequest) *apiv1.DeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DeleteWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Archive:           t.Archive,
+		Identity:          t.Identity,
+	}
+}
+
+func ToDeleteWorkflowExecutionRequest(t *apiv1.DeleteWorkflowExecutionRequest) *types.DeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Archive:           t.Archive,
+		Identity:          t.Identity,
+	}
+}
+
+func FromPauseActivityRequest(t *types.PauseActivityRequest) *apiv1.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PauseActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        t.ActivityID,
+		Identity:          t.Identity,
+	}
+}
+
+func ToPauseActivityRequest(t *apiv1.PauseActivityRequest) *types.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.ActivityId,
+		Identity:          t.Identity,
+	}
+}
+
+func FromUnpauseActivityRequest(t *types.UnpauseActivityRequest) *apiv1.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UnpauseActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        t.ActivityID,
+		Identity:          t.Identity,
+	}
+}
+
+func ToUnpauseActivityRequest(t *apiv1.UnpauseActivityRequest) *types.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.ActivityId,
+		Identity:          t.Identity,
+	}
+}
+
+func FromResetActivityRequest(t *types.ResetActivityRequest) *apiv1.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        t.ActivityID,
+		RetryNow:          t.RetryNow,
+		Identity:          t.Identity,
+	}
+}
+
+func ToResetActivityRequest(t *apiv1.ResetActivityRequest) *types.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResetActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.ActivityId,
+		RetryNow:          t.RetryNow,
+		Identity:          t.Identity,
+	}
+}
+
+func FromUpdateWorkflowExecutionRequest(t *types.UpdateWorkflowExecutionRequest) *apiv1.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		UpdateName:        t.UpdateName,
+		Input:             FromPayload(t.Input),
+		Identity:          t.Identity,
+		RequestId:         t.RequestID,
+	}
+}
+
+func ToUpdateWorkflowExecutionRequest(t *apiv1.UpdateWorkflowExecutionRequest) *types.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		UpdateName:        t.UpdateName,
+		Input:             ToPayload(t.Input),
+		Identity:          t.Identity,
+		RequestID:         t.RequestId,
+	}
+}
+
+func FromUpdateWorkflowExecutionResponse(t *types.UpdateWorkflowExecutionResponse) *apiv1.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateWorkflowExecutionResponse{
+		Result:       FromPayload(t.Result),
+		ErrorMessage: t.ErrorMessage,
+	}
+}
+
+func ToUpdateWorkflowExecutionResponse(t *apiv1.UpdateWorkflowExecutionResponse) *types.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionResponse{
+		Result:       ToPayload(t.Result),
+		ErrorMessage: t.ErrorMessage,
+	}
+}
+
+func FromScheduleSpec(t *types.ScheduleSpec) *apiv1.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleSpec{
+		CronExpression: t.CronExpression,
+		StartTime:      unixNanoToTime(t.StartTimestamp),
+		EndTime:        unixNanoToTime(t.EndTimestamp),
+	}
+}
+
+func ToScheduleSpec(t *apiv1.ScheduleSpec) *types.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleSpec{
+		CronExpression: t.CronExpression,
+		StartTimestamp: timeToUnixNano(t.StartTime),
+		EndTimestamp:   timeToUnixNano(t.EndTime),
+	}
+}
+
+func FromScheduleAction(t *types.ScheduleAction) *apiv1.ScheduleAction {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleAction{
+		WorkflowType:                 FromWorkflowType(t.WorkflowType),
+		TaskList:                     FromTaskList(t.TaskList),
+		Input:                        FromPayload(t.Input),
+		ExecutionStartToCloseTimeout: secondsToDuration(t.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeout:      secondsToDuration(t.TaskStartToCloseTimeoutSeconds),
+	}
+}
+
+func ToScheduleAction(t *apiv1.ScheduleAction) *types.ScheduleAction {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleAction{
+		WorkflowType:                        ToWorkflowType(t.WorkflowType),
+		TaskList:                            ToTaskList(t.TaskList),
+		Input:                               ToPayload(t.Input),
+		ExecutionStartToCloseTimeoutSeconds: durationToSeconds(t.ExecutionStartToCloseTimeout),
+		TaskStartToCloseTimeoutSeconds:      durationToSeconds(t.TaskStartToCloseTimeout),
+	}
+}
+
+func FromSchedulePolicies(t *types.SchedulePolicies) *apiv1.SchedulePolicies {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SchedulePolicies{
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+	}
+}
+
+func ToSchedulePolicies(t *apiv1.SchedulePolicies) *types.SchedulePolicies {
+	if t == nil {
+		return nil
+	}
+	return &types.SchedulePolicies{
+		OverlapPolicy: ToScheduleOverlapPolicy(t.OverlapPolicy),
+	}
+}
+
+func FromScheduleState(t *types.ScheduleState) *apiv1.ScheduleState {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleState{
+		Paused:      t.Paused,
+		PauseReason: t.PauseReason,
+	}
+}
+
+func ToScheduleState(t *apiv1.ScheduleState) *types.ScheduleState {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleState{
+		Paused:      t.Paused,
+		PauseReason: t.PauseReason,
+	}
+}
+
+func FromScheduleInfo(t *types.ScheduleInfo) *apiv1.ScheduleInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleInfo{
+		LastRunTime:      unixNanoToTime(t.LastRunTimestamp),
+		NextRunTime:      unixNanoToTime(t.NextRunTimestamp),
+		TotalRuns:        t.TotalRuns,
+		SkippedRuns:      t.SkippedRuns,
+		BufferedRuns:     t.BufferedRuns,
+		LastRunExecution: FromWorkflowExecution(t.LastRunExecution),
+	}
+}
+
+func ToScheduleInfo(t *apiv1.ScheduleInfo) *types.ScheduleInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleInfo{
+		LastRunTimestamp: timeToUnixNano(t.LastRunTime),
+		NextRunTimestamp: timeToUnixNano(t.NextRunTime),
+		TotalRuns:        t.TotalRuns,
+		SkippedRuns:      t.SkippedRuns,
+		BufferedRuns:     t.BufferedRuns,
+		LastRunExecution: ToWorkflowExecution(t.LastRunExecution),
+	}
+}
+
+func FromScheduleListEntry(t *types.ScheduleListEntry) *apiv1.ScheduleListEntry {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleListEntry{
+		ScheduleId: t.ScheduleID,
+	}
+}
+
+func ToScheduleListEntry(t *apiv1.ScheduleListEntry) *types.ScheduleListEntry {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleListEntry{
+		ScheduleID: t.ScheduleId,
+	}
+}
+
+func FromCreateScheduleRequest(t *types.CreateScheduleRequest) *apiv1.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CreateScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+		Spec:       FromScheduleSpec(t.Spec),
+		Action:     FromScheduleAction(t.Action),
+		Policies:   FromSchedulePolicies(t.Policies),
+		Identity:   t.Identity,
+		RequestId:  t.RequestID,
+	}
+}
+
+func ToCreateScheduleRequest(t *apiv1.CreateScheduleRequest) *types.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.CreateScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+		Spec:       ToScheduleSpec(t.Spec),
+		Action:     ToScheduleAction(t.Action),
+		Policies:   ToSchedulePolicies(t.Policies),
+		Identity:   t.Identity,
+		RequestID:  t.RequestId,
+	}
+}
+
+func FromDescribeScheduleRequest(t *types.DescribeScheduleRequest) *apiv1.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+	}
+}
+
+func ToDescribeScheduleRequest(t *apiv1.DescribeScheduleRequest) *types.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+	}
+}
+
+func FromDescribeScheduleResponse(t *types.DescribeScheduleResponse) *apiv1.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeScheduleResponse{
+		Spec:     FromScheduleSpec(t.Spec),
+		Action:   FromScheduleAction(t.Action),
+		Policies: FromSchedulePolicies(t.Policies),
+		State:    FromScheduleState(t.State),
+		Info:     FromScheduleInfo(t.Info),
+	}
+}
+
+func ToDescribeScheduleResponse(t *apiv1.DescribeScheduleResponse) *types.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleResponse{
+		Spec:     ToScheduleSpec(t.Spec),
+		Action:   ToScheduleAction(t.Action),
+		Policies: ToSchedulePolicies(t.Policies),
+		State:    ToScheduleState(t.State),
+		Info:     ToScheduleInfo(t.Info),
+	}
+}
+
+func FromUpdateScheduleRequest(t *types.UpdateScheduleRequest) *apiv1.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+		Spec:       FromScheduleSpec(t.Spec),
+		Action:     FromScheduleAction(t.Action),
+		Policies:   FromSchedulePolicies(t.Policies),
+		Identity:   t.Identity,
+		RequestId:  t.RequestID,
+	}
+}
+
+func ToUpdateScheduleRequest(t *apiv1.UpdateScheduleRequest) *types.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+		Spec:       ToScheduleSpec(t.Spec),
+		Action:     ToScheduleAction(t.Action),
+		Policies:   ToSchedulePolicies(t.Policies),
+		Identity:   t.Identity,
+		RequestID:  t.RequestId,
+	}
+}
+
+func FromPauseScheduleRequest(t *types.PauseScheduleRequest) *apiv1.PauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PauseScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+		Reason:     t.Reason,
+		Identity:   t.Identity,
+		RequestId:  t.RequestID,
+	}
+}
+
+func ToPauseScheduleRequest(t *apiv1.PauseScheduleRequest) *types.PauseScheduleRequest {
 	if t == nil {
 		return nil
 	}
-	return &apiv1.RequestCancelActivityTaskDecisionAttributes{
-		ActivityId: t.ActivityID,
+	return &types.PauseScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+		Reason:     t.Reason,
+		Identity:   t.Identity,
+		RequestID:  t.RequestId,
 	}
 }
 
-func ToRequestCancelActivityTaskDecisionAttributes(t *apiv1.RequestCancelActivityTaskDecisionAttributes) *types.RequestCancelActivityTaskDecisionAttributes {
+func FromUnpauseScheduleRequest(t *types.UnpauseScheduleRequest) *apiv1.UnpauseScheduleRequest {
 	if t == nil {
 		return nil
 	}
-	return &types.RequestCancelActivityTaskDecisionAttributes{
-		ActivityID: t.ActivityId,
+	return &apiv1.UnpauseScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+		Reason:     t.Reason,
+		Identity:   t.Identity,
+		RequestId:  t.RequestID,
 	}
 }
 
-func FromRequestCancelActivityTaskFailedEventAttributes(t *types.RequestCancelActivityTaskFailedEventAttributes) *apiv1.RequestCancelActivityTaskFailedEventAttributes {
+func ToUnpauseScheduleRequest(t *apiv1.UnpauseScheduleRequest) *types.UnpauseScheduleRequest {
 	if t == nil {
 		return nil
 	}
-	return &apiv1.RequestCancelActivityTaskFailedEventAttributes{
-		ActivityId:                   t.ActivityID,
-		Cause:                        t.Cause,
-		DecisionTaskCompletedEventId: t.DecisionTaskCompletedEventID,
+	return &types.UnpauseScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+		Reason:     t.Reason,
+		Identity:   t.Identity,
+		RequestID:  t.RequestId,
 	}
 }
 
-func ToRequestCancelActivityTaskFailedEventAttributes(t *apiv1.RequestCancelActivityTaskFailedEventAttributes) *types.RequestCancelActivityTaskFailedEventAttributes {
+func FromBackfillScheduleRequest(t *types.BackfillScheduleRequest) *apiv1.BackfillScheduleRequest {
 	if t == nil {
 		return nil
 	}
-	return &types.RequestCancelActivityTaskFailedEventAttributes{
-		ActivityID:                   t.ActivityId,
-		Cause:                        t.Cause,
-		DecisionTaskCompletedEventID: t.DecisionTaskCompletedEventId,
+	return &apiv1.BackfillScheduleRequest{
+		Domain:        t.Domain,
+		ScheduleId:    t.ScheduleID,
+		StartTime:     unixNanoToTime(t.StartTimestamp),
+		EndTime:       unixNanoToTime(t.EndTimestamp),
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:      t.Identity,
+		RequestId:     t.RequestID,
 	}
 }
 
-func FromRequestCancelExternalWorkflowExecutionDecisionAttributes(t *types.RequestCancelExternalWorkflowExecutionDecisionAttributes) *apiv1.RequestCancelExternalWorkflowExecutionDecisionAttributes {
+func ToBackfillScheduleRequest(t *apiv1.BackfillScheduleRequest) *types.BackfillScheduleRequest {
 	if t == nil {
 		return nil
 	}
-	return &apiv1.RequestCancelExternalWorkflowExecutionDecisionAttributes{
-		Domain:            t.Domain,
-		WorkflowExecution: FromWorkflowRunPair(t.WorkflowID, t.RunID),
-		Control:           t.Control,
-		ChildWorkflowOnly: t.ChildWorkflowOnly,
+	return &types.BackfillScheduleRequest{
+		Domain:         t.Domain,
+		ScheduleID:     t.ScheduleId,
+		StartTimestamp: timeToUnixNano(t.StartTime),
+		EndTimestamp:   timeToUnixNano(t.EndTime),
+		OverlapPolicy:  ToScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:       t.Identity,
+		RequestID:      t.RequestId,
 	}
 }
 
-func ToRequestCancelExternalWorkflowExecutionDecisionAttributes(t *apiv1.RequestCancelExternalWorkflowExecutionDecisionAttributes) *types.RequestCancelExternalWorkflowExecutionDecisionAttributes {
+func FromListSchedulesRequest(t *types.ListSchedulesRequest) *apiv1.ListSchedulesRequest {
 	if t == nil {
 		return nil
 	}
-	return &types.RequestCancelExternalWorkflowExecutionDecisionAttributes{
-		Domain:            t.Domain,
-		WorkflowID:        ToWorkflowID(t.WorkflowExecution),
-		RunID:             ToRunID(t.WorkflowExecution),
-		Control:           t.Control,
-		ChildWorkflowOnly: t.ChildWorkflowOnly,
+	return &apiv1.ListSchedulesRequest{
+		Domain:        t.Domain,
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
 	}
 }
 
-func FromRequestCancelExternalWorkflowExecutionFailedEventAttributes(t *types.RequestCancelExternalWorkflowExecutionFailedEventAttributes) *apiv1.RequestCancelExternalWorkflowExecutionFailedEventAttributes {
+func ToListSchedulesRequest(t *apiv1.ListSchedulesRequest) *types.ListSchedulesRequest {
 	if t == nil {
 		return nil
 	}
-	return &apiv1.RequestCancelExternalWorkflowExecutionFailedEventAttributes{
-		Cause:                        FromCancelExternalWorkflowExecutionFailedCause(t.Cause),
-		DecisionTaskCompletedEventId: t.DecisionTaskCompletedEventID,
-		Domain:                       t.Domain,
-		WorkflowExecution:            FromWorkflowExecution(t.WorkflowExecution),
-		InitiatedEventId:             t.InitiatedEventID,
-		Control:                      t.Control,
+	return &types.ListSchedulesRequest{
+		Domain:        t.Domain,
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
 	}
 }
 
-func ToRequestCancelExternalWorkflowExecutionFailedEventAttributes(t *apiv1.RequestCancelExternalWorkflowExecutionFailedEventAttributes) *types.RequestCancelExternalWorkflowExecutionFailedEventAttributes {
+func FromListSchedulesResponse(t *types.ListSchedulesResponse) *apiv1.ListSchedulesResponse {
 	if t == nil {
 		return nil
 	}
-	return &types.RequestCancelExternalWorkflowExecutionFailedEventAttributes{
-		Cause:                        ToCancelExternalWorkflowExecutionFailedCause(t.Cause),
-		DecisionTaskCompletedEventID: t.DecisionTaskCompletedEventId,
-		Domain:                       t.Domain,
-		WorkflowExecution:            ToWorkflowExecution(t.WorkflowExecution),
-		InitiatedEventID:             t.InitiatedEventId,
-		Control:                      t.Control,
+	return &apiv1.ListSchedulesResponse{
+		Schedules:     FromScheduleListEntryArray(t.Schedules),
+		NextPageToken: t.NextPageToken,
 	}
 }
 
-func FromRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(t *types.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) *apiv1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
+func ToListSchedulesResponse(t *apiv1.ListSchedulesResponse) *types.ListSchedulesResponse {
 	if t == nil {
 		return nil
 	}
-	return &apiv1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{
-		DecisionTaskCompletedEventId: t.DecisionTaskCompletedEventID,
-		Domain:                       t.Domain,
-		WorkflowExecution:            FromWorkflowExecution(t.WorkflowExecution),
-		Control:                      t.Control,
-		ChildWorkflowOnly:            t.ChildWorkflowOnly,
+	return &types.ListSchedulesResponse{
+		Schedules:     ToScheduleListEntryArray(t.Schedules),
+		NextPageToken: t.NextPageToken,
 	}
 }
 
-func ToRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(t *apiv1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) *types.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
+func FromScheduleListEntryArray(t []*types.ScheduleListEntry) []*apiv1.ScheduleListEntry {
 	if t == nil {
 		return nil
 	}
-	return &types.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{
-		DecisionTaskCompletedEventID: t.DecisionTaskCompletedEventId,
-		Domain:                       t.Domain,
-		WorkflowExecution:            ToWorkflowExecution(t.WorkflowExecution),
-		Control:                      t.Control,
-		ChildWorkflowOnly:            t.ChildWorkflowOnly,
+	v := make([]*apiv1.ScheduleListEntry, len(t))
+	for i := range t {
+		v[i] = FromScheduleListEntry(t[i])
 	}
+	return v
 }
 
-func FromRequestCancelWorkflowExecutionRequest(t *types.RequestCancelWorkflowExecutionRequest) *apiv1.RequestCancelWorkflowExecutionRequest {
+func ToScheduleListEntryArray(t []*apiv1.ScheduleListEntry) []*types.ScheduleListEntry {
 	if t == nil {
 		return nil
 	}
-	return &apiv1.RequestCancelWorkflowExecutionRequest{
-		Domain:            t.Domain,
-		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
-		Identity:          t.Identity,
-		RequestId:         t.RequestID,
+	v := make([]*types.ScheduleListEntry, len(t))
+	for i := range t {
+		v[i] = ToScheduleListEntry(t[i])
 	}
+	return v
 }
 
-func ToRequestCancelWorkflowExecutionRequest(t *apiv1.RequestCancelWorkflowExecutionRequest) *types.RequestCancelWorkflowExecutionRequest {
+func FromScheduleOverlapPolicy(t *types.ScheduleOverlapPolicy) apiv1.ScheduleOverlapPolicy {
 	if t == nil {
-		return nil
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_INVALID
 	}
-	return &types.RequestCancelWorkflowExecutionRequest{
-		Domain:            t.Domain,
-		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
-		Identity:          t.Identity,
-		RequestID:         t.RequestId,
+	switch *t {
+	case types.ScheduleOverlapPolicySkipNew:
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_SKIP_NEW
+	case types.ScheduleOverlapPolicyBuffer:
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_BUFFER
+	case types.ScheduleOverlapPolicyCancelOther:
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER
+	case types.ScheduleOverlapPolicyAllowAll:
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_ALLOW_ALL
+	}
+	panic("unexpected enum value")
+}
+
+func ToScheduleOverlapPolicy(t apiv1.ScheduleOverlapPolicy) *types.ScheduleOverlapPolicy {
+	switch t {
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_INVALID:
+		return nil
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_SKIP_NEW:
+		return types.ScheduleOverlapPolicySkipNew.Ptr()
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_BUFFER:
+		return types.ScheduleOverlapPolicyBuffer.Ptr()
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER:
+		return types.ScheduleOverlapPolicyCancelOther.Ptr()
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_ALLOW_ALL:
+		return types.ScheduleOverlapPolicyAllowAll.Ptr()
 	}
+	panic("unexpected enum value")
 }
 
 func FromResetPointInfo(t *types.ResetPointInfo) *apiv1.ResetPointInfo {
@@ -2718,6 +3379,46 @@ func ToResetWorkflowExecutionResponse(t *apiv1.ResetWorkflowExecutionResponse) *
 	}
 }
 
+func FromRestartWorkflowExecutionRequest(t *types.RestartWorkflowExecutionRequest) *apiv1.RestartWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RestartWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Identity:          t.Identity,
+	}
+}
+
+func ToRestartWorkflowExecutionRequest(t *apiv1.RestartWorkflowExecutionRequest) *types.RestartWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RestartWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Identity:          t.Identity,
+	}
+}
+
+func FromRestartWorkflowExecutionResponse(t *types.RestartWorkflowExecutionResponse) *apiv1.RestartWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RestartWorkflowExecutionResponse{
+		RunId: t.RunID,
+	}
+}
+
+func ToRestartWorkflowExecutionResponse(t *apiv1.RestartWorkflowExecutionResponse) *types.RestartWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.RestartWorkflowExecutionResponse{
+		RunID: t.RunId,
+	}
+}
+
 func FromRespondActivityTaskCanceledByIDRequest(t *types.RespondActivityTaskCanceledByIDRequest) *apiv1.RespondActivityTaskCanceledByIDRequest {
 	if t == nil {
 		return nil
@@ -3259,6 +3960,7 @@ func FromSignalWithStartWorkflowExecutionRequest(t *types.SignalWithStartWorkflo
 			SearchAttributes:             FromSearchAttributes(t.SearchAttributes),
 			Header:                       FromHeader(t.Header),
 			DelayStart:                   secondsToDuration(t.DelayStartSeconds),
+			WorkflowIdConflictPolicy:     FromWorkflowIDConflictPolicy(t.WorkflowIDConflictPolicy),
 		},
 		SignalName:  t.SignalName,
 		SignalInput: FromPayload(t.SignalInput),
@@ -3290,6 +3992,7 @@ func ToSignalWithStartWorkflowExecutionRequest(t *apiv1.SignalWithStartWorkflowE
 		SearchAttributes:                    ToSearchAttributes(t.StartRequest.SearchAttributes),
 		Header:                              ToHeader(t.StartRequest.Header),
 		DelayStartSeconds:                   durationToSeconds(t.StartRequest.DelayStart),
+		WorkflowIDConflictPolicy:            ToWorkflowIDConflictPolicy(t.StartRequest.WorkflowIdConflictPolicy),
 	}
 }
 
@@ -3528,6 +4231,7 @@ func FromStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *
 		SearchAttributes:             FromSearchAttributes(t.SearchAttributes),
 		Header:                       FromHeader(t.Header),
 		DelayStart:                   secondsToDuration(t.DelayStartSeconds),
+		WorkflowIdConflictPolicy:     FromWorkflowIDConflictPolicy(t.WorkflowIDConflictPolicy),
 	}
 }
 
@@ -3552,6 +4256,7 @@ func ToStartWorkflowExecutionRequest(t *apiv1.StartWorkflowExecutionRequest) *ty
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		Header:                              ToHeader(t.Header),
 		DelayStartSeconds:                   durationToSeconds(t.DelayStart),
+		WorkflowIDConflictPolicy:            ToWorkflowIDConflictPolicy(t.WorkflowIdConflictPolicy),
 	}
 }
 
@@ -3742,6 +4447,7 @@ func FromTaskListStatus(t *types.TaskListStatus) *apiv1.TaskListStatus {
 		AckLevel:         t.AckLevel,
 		RatePerSecond:    t.RatePerSecond,
 		TaskIdBlock:      FromTaskIDBlock(t.TaskIDBlock),
+		Paused:           t.Paused,
 	}
 }
 
@@ -3755,6 +4461,7 @@ func ToTaskListStatus(t *apiv1.TaskListStatus) *types.TaskListStatus {
 		AckLevel:         t.AckLevel,
 		RatePerSecond:    t.RatePerSecond,
 		TaskIDBlock:      ToTaskIDBlock(t.TaskIdBlock),
+		Paused:           t.Paused,
 	}
 }
 
@@ -3947,6 +4654,7 @@ const (
 	DomainUpdateClustersField                 = "clusters"
 	DomainUpdateDeleteBadBinaryField          = "delete_bad_binary"
 	DomainUpdateFailoverTimeoutField          = "failover_timeout"
+	DomainUpdateLimitsField                   = "limits"
 )
 
 func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *apiv1.UpdateDomainRequest {
@@ -4012,6 +4720,10 @@ func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *apiv1.UpdateDomainRe
 		request.FailoverTimeout = secondsToDuration(t.FailoverTimeoutInSeconds)
 		fields = append(fields, DomainUpdateFailoverTimeoutField)
 	}
+	if t.Limits != nil {
+		request.Limits = FromDomainLimits(t.Limits)
+		fields = append(fields, DomainUpdateLimitsField)
+	}
 
 	request.UpdateMask = newFieldMask(fields)
 
@@ -4068,6 +4780,9 @@ func ToUpdateDomainRequest(t *apiv1.UpdateDomainRequest) *types.UpdateDomainRequ
 	if fs.isSet(DomainUpdateFailoverTimeoutField) {
 		request.FailoverTimeoutInSeconds = durationToSeconds(t.FailoverTimeout)
 	}
+	if fs.isSet(DomainUpdateLimitsField) {
+		request.Limits = ToDomainLimits(t.Limits)
+	}
 
 	return &request
 }
@@ -4095,6 +4810,7 @@ func FromUpdateDomainResponse(t *types.UpdateDomainResponse) *apiv1.UpdateDomain
 		domain.HistoryArchivalUri = config.HistoryArchivalURI
 		domain.VisibilityArchivalStatus = FromArchivalStatus(config.VisibilityArchivalStatus)
 		domain.VisibilityArchivalUri = config.VisibilityArchivalURI
+		domain.Limits = FromDomainLimits(config.Limits)
 	}
 	if repl := t.ReplicationConfiguration; repl != nil {
 		domain.ActiveClusterName = repl.ActiveClusterName
@@ -4126,6 +4842,7 @@ func ToUpdateDomainResponse(t *apiv1.UpdateDomainResponse) *types.UpdateDomainRe
 			HistoryArchivalURI:                     t.Domain.HistoryArchivalUri,
 			VisibilityArchivalStatus:               ToArchivalStatus(t.Domain.VisibilityArchivalStatus),
 			VisibilityArchivalURI:                  t.Domain.VisibilityArchivalUri,
+			Limits:                                 ToDomainLimits(t.Domain.Limits),
 		},
 		ReplicationConfiguration: &types.DomainReplicationConfiguration{
 			ActiveClusterName: t.Domain.ActiveClusterName,
@@ -4154,6 +4871,46 @@ func ToUpsertWorkflowSearchAttributesDecisionAttributes(t *apiv1.UpsertWorkflowS
 	}
 }
 
+func FromCompleteWorkflowUpdateDecisionAttributes(t *types.CompleteWorkflowUpdateDecisionAttributes) *apiv1.CompleteWorkflowUpdateDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CompleteWorkflowUpdateDecisionAttributes{
+		UpdateId:     t.UpdateID,
+		Result:       FromPayload(t.Result),
+		ErrorMessage: t.ErrorMessage,
+	}
+}
+
+func ToCompleteWorkflowUpdateDecisionAttributes(t *apiv1.CompleteWorkflowUpdateDecisionAttributes) *types.CompleteWorkflowUpdateDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.CompleteWorkflowUpdateDecisionAttributes{
+		UpdateID:     t.UpdateId,
+		Result:       ToPayload(t.Result),
+		ErrorMessage: t.ErrorMessage,
+	}
+}
+
+func FromUpsertWorkflowMemoDecisionAttributes(t *types.UpsertWorkflowMemoDecisionAttributes) *apiv1.UpsertWorkflowMemoDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpsertWorkflowMemoDecisionAttributes{
+		Memo: FromMemo(t.Memo),
+	}
+}
+
+func ToUpsertWorkflowMemoDecisionAttributes(t *apiv1.UpsertWorkflowMemoDecisionAttributes) *types.UpsertWorkflowMemoDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.UpsertWorkflowMemoDecisionAttributes{
+		Memo: ToMemo(t.Memo),
+	}
+}
+
 func FromUpsertWorkflowSearchAttributesEventAttributes(t *types.UpsertWorkflowSearchAttributesEventAttributes) *apiv1.UpsertWorkflowSearchAttributesEventAttributes {
 	if t == nil {
 		return nil
@@ -4174,6 +4931,26 @@ func ToUpsertWorkflowSearchAttributesEventAttributes(t *apiv1.UpsertWorkflowSear
 	}
 }
 
+func FromUpsertWorkflowMemoEventAttributes(t *types.UpsertWorkflowMemoEventAttributes) *apiv1.UpsertWorkflowMemoEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpsertWorkflowMemoEventAttributes{
+		DecisionTaskCompletedEventId: t.DecisionTaskCompletedEventID,
+		Memo:                         FromMemo(t.Memo),
+	}
+}
+
+func ToUpsertWorkflowMemoEventAttributes(t *apiv1.UpsertWorkflowMemoEventAttributes) *types.UpsertWorkflowMemoEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.UpsertWorkflowMemoEventAttributes{
+		DecisionTaskCompletedEventID: t.DecisionTaskCompletedEventId,
+		Memo:                         ToMemo(t.Memo),
+	}
+}
+
 func FromWorkerVersionInfo(t *types.WorkerVersionInfo) *apiv1.WorkerVersionInfo {
 	if t == nil {
 		return nil
@@ -4285,6 +5062,100 @@ func ToWorkflowExecutionCancelRequestedEventAttributes(t *apiv1.WorkflowExecutio
 	}
 }
 
+func FromWorkflowExecutionPausedEventAttributes(t *types.WorkflowExecutionPausedEventAttributes) *apiv1.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionPausedEventAttributes{
+		Reason:    t.Reason,
+		Identity:  t.Identity,
+		RequestId: t.RequestID,
+	}
+}
+
+func ToWorkflowExecutionPausedEventAttributes(t *apiv1.WorkflowExecutionPausedEventAttributes) *types.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionPausedEventAttributes{
+		Reason:    t.Reason,
+		Identity:  t.Identity,
+		RequestID: t.RequestId,
+	}
+}
+
+func FromWorkflowExecutionUnpausedEventAttributes(t *types.WorkflowExecutionUnpausedEventAttributes) *apiv1.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionUnpausedEventAttributes{
+		Reason:    t.Reason,
+		Identity:  t.Identity,
+		RequestId: t.RequestID,
+	}
+}
+
+func ToWorkflowExecutionUnpausedEventAttributes(t *apiv1.WorkflowExecutionUnpausedEventAttributes) *types.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:    t.Reason,
+		Identity:  t.Identity,
+		RequestID: t.RequestId,
+	}
+}
+
+func FromWorkflowExecutionUpdateAcceptedEventAttributes(t *types.WorkflowExecutionUpdateAcceptedEventAttributes) *apiv1.WorkflowExecutionUpdateAcceptedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionUpdateAcceptedEventAttributes{
+		UpdateId:   t.UpdateID,
+		UpdateName: t.UpdateName,
+		Input:      FromPayload(t.Input),
+		Identity:   t.Identity,
+		RequestId:  t.RequestID,
+	}
+}
+
+func ToWorkflowExecutionUpdateAcceptedEventAttributes(t *apiv1.WorkflowExecutionUpdateAcceptedEventAttributes) *types.WorkflowExecutionUpdateAcceptedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUpdateAcceptedEventAttributes{
+		UpdateID:   t.UpdateId,
+		UpdateName: t.UpdateName,
+		Input:      ToPayload(t.Input),
+		Identity:   t.Identity,
+		RequestID:  t.RequestId,
+	}
+}
+
+func FromWorkflowExecutionUpdateCompletedEventAttributes(t *types.WorkflowExecutionUpdateCompletedEventAttributes) *apiv1.WorkflowExecutionUpdateCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionUpdateCompletedEventAttributes{
+		DecisionTaskCompletedEventId: t.DecisionTaskCompletedEventID,
+		UpdateId:                     t.UpdateID,
+		Result:                       FromPayload(t.Result),
+		ErrorMessage:                 t.ErrorMessage,
+	}
+}
+
+func ToWorkflowExecutionUpdateCompletedEventAttributes(t *apiv1.WorkflowExecutionUpdateCompletedEventAttributes) *types.WorkflowExecutionUpdateCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUpdateCompletedEventAttributes{
+		DecisionTaskCompletedEventID: t.DecisionTaskCompletedEventId,
+		UpdateID:                     t.UpdateId,
+		Result:                       ToPayload(t.Result),
+		ErrorMessage:                 t.ErrorMessage,
+	}
+}
+
 func FromWorkflowExecutionCanceledEventAttributes(t *types.WorkflowExecutionCanceledEventAttributes) *apiv1.WorkflowExecutionCanceledEventAttributes {
 	if t == nil {
 		return nil
@@ -4644,6 +5515,7 @@ func FromWorkflowExecutionStartedEventAttributes(t *types.WorkflowExecutionStart
 		SearchAttributes:             FromSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:          FromResetPoints(t.PrevAutoResetPoints),
 		Header:                       FromHeader(t.Header),
+		RestartedFromRunId:           t.RestartedFromRunID,
 	}
 }
 
@@ -4678,6 +5550,7 @@ func ToWorkflowExecutionStartedEventAttributes(t *apiv1.WorkflowExecutionStarted
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:                 ToResetPoints(t.PrevAutoResetPoints),
 		Header:                              ToHeader(t.Header),
+		RestartedFromRunID:                  t.RestartedFromRunId,
 	}
 }
 
@@ -4721,6 +5594,35 @@ func ToWorkflowExecutionTimedOutEventAttributes(t *apiv1.WorkflowExecutionTimedO
 	}
 }
 
+func FromWorkflowIDConflictPolicy(t *types.WorkflowIDConflictPolicy) apiv1.WorkflowIdConflictPolicy {
+	if t == nil {
+		return apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_INVALID
+	}
+	switch *t {
+	case types.WorkflowIDConflictPolicyFail:
+		return apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_FAIL
+	case types.WorkflowIDConflictPolicyUseExisting:
+		return apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING
+	case types.WorkflowIDConflictPolicyTerminateExisting:
+		return apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING
+	}
+	panic("unexpected enum value")
+}
+
+func ToWorkflowIDConflictPolicy(t apiv1.WorkflowIdConflictPolicy) *types.WorkflowIDConflictPolicy {
+	switch t {
+	case apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_INVALID:
+		return nil
+	case apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_FAIL:
+		return types.WorkflowIDConflictPolicyFail.Ptr()
+	case apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING:
+		return types.WorkflowIDConflictPolicyUseExisting.Ptr()
+	case apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING:
+		return types.WorkflowIDConflictPolicyTerminateExisting.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
 func FromWorkflowIDReusePolicy(t *types.WorkflowIDReusePolicy) apiv1.WorkflowIdReusePolicy {
 	if t == nil {
 		return apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_INVALID
@@ -5432,6 +6334,26 @@ func FromHistoryEvent(e *types.HistoryEvent) *apiv1.HistoryEvent {
 		event.Attributes = &apiv1.HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes{
 			UpsertWorkflowSearchAttributesEventAttributes: FromUpsertWorkflowSearchAttributesEventAttributes(e.UpsertWorkflowSearchAttributesEventAttributes),
 		}
+	case types.EventTypeWorkflowExecutionPaused:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionPausedEventAttributes{
+			WorkflowExecutionPausedEventAttributes: FromWorkflowExecutionPausedEventAttributes(e.WorkflowExecutionPausedEventAttributes),
+		}
+	case types.EventTypeWorkflowExecutionUnpaused:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionUnpausedEventAttributes{
+			WorkflowExecutionUnpausedEventAttributes: FromWorkflowExecutionUnpausedEventAttributes(e.WorkflowExecutionUnpausedEventAttributes),
+		}
+	case types.EventTypeWorkflowExecutionUpdateAccepted:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionUpdateAcceptedEventAttributes{
+			WorkflowExecutionUpdateAcceptedEventAttributes: FromWorkflowExecutionUpdateAcceptedEventAttributes(e.WorkflowExecutionUpdateAcceptedEventAttributes),
+		}
+	case types.EventTypeWorkflowExecutionUpdateCompleted:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionUpdateCompletedEventAttributes{
+			WorkflowExecutionUpdateCompletedEventAttributes: FromWorkflowExecutionUpdateCompletedEventAttributes(e.WorkflowExecutionUpdateCompletedEventAttributes),
+		}
+	case types.EventTypeUpsertWorkflowMemo:
+		event.Attributes = &apiv1.HistoryEvent_UpsertWorkflowMemoEventAttributes{
+			UpsertWorkflowMemoEventAttributes: FromUpsertWorkflowMemoEventAttributes(e.UpsertWorkflowMemoEventAttributes),
+		}
 	}
 	return &event
 }
@@ -5573,6 +6495,21 @@ func ToHistoryEvent(e *apiv1.HistoryEvent) *types.HistoryEvent {
 	case *apiv1.HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes:
 		event.EventType = types.EventTypeUpsertWorkflowSearchAttributes.Ptr()
 		event.UpsertWorkflowSearchAttributesEventAttributes = ToUpsertWorkflowSearchAttributesEventAttributes(attr.UpsertWorkflowSearchAttributesEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionPausedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionPaused.Ptr()
+		event.WorkflowExecutionPausedEventAttributes = ToWorkflowExecutionPausedEventAttributes(attr.WorkflowExecutionPausedEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionUnpausedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionUnpaused.Ptr()
+		event.WorkflowExecutionUnpausedEventAttributes = ToWorkflowExecutionUnpausedEventAttributes(attr.WorkflowExecutionUnpausedEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionUpdateAcceptedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionUpdateAccepted.Ptr()
+		event.WorkflowExecutionUpdateAcceptedEventAttributes = ToWorkflowExecutionUpdateAcceptedEventAttributes(attr.WorkflowExecutionUpdateAcceptedEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionUpdateCompletedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionUpdateCompleted.Ptr()
+		event.WorkflowExecutionUpdateCompletedEventAttributes = ToWorkflowExecutionUpdateCompletedEventAttributes(attr.WorkflowExecutionUpdateCompletedEventAttributes)
+	case *apiv1.HistoryEvent_UpsertWorkflowMemoEventAttributes:
+		event.EventType = types.EventTypeUpsertWorkflowMemo.Ptr()
+		event.UpsertWorkflowMemoEventAttributes = ToUpsertWorkflowMemoEventAttributes(attr.UpsertWorkflowMemoEventAttributes)
 	}
 	return &event
 }
@@ -5635,6 +6572,14 @@ func FromDecision(d *types.Decision) *apiv1.Decision {
 		decision.Attributes = &apiv1.Decision_UpsertWorkflowSearchAttributesDecisionAttributes{
 			UpsertWorkflowSearchAttributesDecisionAttributes: FromUpsertWorkflowSearchAttributesDecisionAttributes(d.UpsertWorkflowSearchAttributesDecisionAttributes),
 		}
+	case types.DecisionTypeCompleteWorkflowUpdate:
+		decision.Attributes = &apiv1.Decision_CompleteWorkflowUpdateDecisionAttributes{
+			CompleteWorkflowUpdateDecisionAttributes: FromCompleteWorkflowUpdateDecisionAttributes(d.CompleteWorkflowUpdateDecisionAttributes),
+		}
+	case types.DecisionTypeUpsertWorkflowMemo:
+		decision.Attributes = &apiv1.Decision_UpsertWorkflowMemoDecisionAttributes{
+			UpsertWorkflowMemoDecisionAttributes: FromUpsertWorkflowMemoDecisionAttributes(d.UpsertWorkflowMemoDecisionAttributes),
+		}
 	}
 	return &decision
 }
@@ -5684,6 +6629,12 @@ func ToDecision(d *apiv1.Decision) *types.Decision {
 	case *apiv1.Decision_UpsertWorkflowSearchAttributesDecisionAttributes:
 		decision.DecisionType = types.DecisionTypeUpsertWorkflowSearchAttributes.Ptr()
 		decision.UpsertWorkflowSearchAttributesDecisionAttributes = ToUpsertWorkflowSearchAttributesDecisionAttributes(attr.UpsertWorkflowSearchAttributesDecisionAttributes)
+	case *apiv1.Decision_CompleteWorkflowUpdateDecisionAttributes:
+		decision.DecisionType = types.DecisionTypeCompleteWorkflowUpdate.Ptr()
+		decision.CompleteWorkflowUpdateDecisionAttributes = ToCompleteWorkflowUpdateDecisionAttributes(attr.CompleteWorkflowUpdateDecisionAttributes)
+	case *apiv1.Decision_UpsertWorkflowMemoDecisionAttributes:
+		decision.DecisionType = types.DecisionTypeUpsertWorkflowMemo.Ptr()
+		decision.UpsertWorkflowMemoDecisionAttributes = ToUpsertWorkflowMemoDecisionAttributes(attr.UpsertWorkflowMemoDecisionAttributes)
 	}
 	return &decision
 }
@@ -83,6 +83,11 @@ func TestBadBinaries(t *testing.T) {
 		assert.Equal(t, item, ToBadBinaries(FromBadBinaries(item)))
 	}
 }
+func TestDomainLimits(t *testing.T) {
+	for _, item := range []*types.DomainLimits{nil, {}, &testdata.DomainLimits} {
+		assert.Equal(t, item, ToDomainLimits(FromDomainLimits(item)))
+	}
+}
 func TestBadBinaryInfo(t *testing.T) {
 	for _, item := range []*types.BadBinaryInfo{nil, {}, &testdata.BadBinaryInfo} {
 		assert.Equal(t, item, ToBadBinaryInfo(FromBadBinaryInfo(item)))
@@ -455,6 +460,91 @@ func TestRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(t *testi
 		assert.Equal(t, item, ToRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(FromRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(item)))
 	}
 }
+func TestPauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.PauseWorkflowExecutionRequest{nil, {}, &testdata.PauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToPauseWorkflowExecutionRequest(FromPauseWorkflowExecutionRequest(item)))
+	}
+}
+func TestUnpauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.UnpauseWorkflowExecutionRequest{nil, {}, &testdata.UnpauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToUnpauseWorkflowExecutionRequest(FromUnpauseWorkflowExecutionRequest(item)))
+	}
+}
+func TestDeleteWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.DeleteWorkflowExecutionRequest{nil, {}, &testdata.DeleteWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToDeleteWorkflowExecutionRequest(FromDeleteWorkflowExecutionRequest(item)))
+	}
+}
+func TestPauseActivityRequest(t *testing.T) {
+	for _, item := range []*types.PauseActivityRequest{nil, {}, &testdata.PauseActivityRequest} {
+		assert.Equal(t, item, ToPauseActivityRequest(FromPauseActivityRequest(item)))
+	}
+}
+func TestUnpauseActivityRequest(t *testing.T) {
+	for _, item := range []*types.UnpauseActivityRequest{nil, {}, &testdata.UnpauseActivityRequest} {
+		assert.Equal(t, item, ToUnpauseActivityRequest(FromUnpauseActivityRequest(item)))
+	}
+}
+func TestResetActivityRequest(t *testing.T) {
+	for _, item := range []*types.ResetActivityRequest{nil, {}, &testdata.ResetActivityRequest} {
+		assert.Equal(t, item, ToResetActivityRequest(FromResetActivityRequest(item)))
+	}
+}
+func TestUpdateWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.UpdateWorkflowExecutionRequest{nil, {}, &testdata.UpdateWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToUpdateWorkflowExecutionRequest(FromUpdateWorkflowExecutionRequest(item)))
+	}
+}
+func TestUpdateWorkflowExecutionResponse(t *testing.T) {
+	for _, item := range []*types.UpdateWorkflowExecutionResponse{nil, {}, &testdata.UpdateWorkflowExecutionResponse} {
+		assert.Equal(t, item, ToUpdateWorkflowExecutionResponse(FromUpdateWorkflowExecutionResponse(item)))
+	}
+}
+func TestCreateScheduleRequest(t *testing.T) {
+	for _, item := range []*types.CreateScheduleRequest{nil, {}, &testdata.CreateScheduleRequest} {
+		assert.Equal(t, item, ToCreateScheduleRequest(FromCreateScheduleRequest(item)))
+	}
+}
+func TestDescribeScheduleRequest(t *testing.T) {
+	for _, item := range []*types.DescribeScheduleRequest{nil, {}, &testdata.DescribeScheduleRequest} {
+		assert.Equal(t, item, ToDescribeScheduleRequest(FromDescribeScheduleRequest(item)))
+	}
+}
+func TestDescribeScheduleResponse(t *testing.T) {
+	for _, item := range []*types.DescribeScheduleResponse{nil, {}, &testdata.DescribeScheduleResponse} {
+		assert.Equal(t, item, ToDescribeScheduleResponse(FromDescribeScheduleResponse(item)))
+	}
+}
+func TestUpdateScheduleRequest(t *testing.T) {
+	for _, item := range []*types.UpdateScheduleRequest{nil, {}, &testdata.UpdateScheduleRequest} {
+		assert.Equal(t, item, ToUpdateScheduleRequest(FromUpdateScheduleRequest(item)))
+	}
+}
+func TestPauseScheduleRequest(t *testing.T) {
+	for _, item := range []*types.PauseScheduleRequest{nil, {}, &testdata.PauseScheduleRequest} {
+		assert.Equal(t, item, ToPauseScheduleRequest(FromPauseScheduleRequest(item)))
+	}
+}
+func TestUnpauseScheduleRequest(t *testing.T) {
+	for _, item := range []*types.UnpauseScheduleRequest{nil, {}, &testdata.UnpauseScheduleRequest} {
+		assert.Equal(t, item, ToUnpauseScheduleRequest(FromUnpauseScheduleRequest(item)))
+	}
+}
+func TestBackfillScheduleRequest(t *testing.T) {
+	for _, item := range []*types.BackfillScheduleRequest{nil, {}, &testdata.BackfillScheduleRequest} {
+		assert.Equal(t, item, ToBackfillScheduleRequest(FromBackfillScheduleRequest(item)))
+	}
+}
+func TestListSchedulesRequest(t *testing.T) {
+	for _, item := range []*types.ListSchedulesRequest{nil, {}, &testdata.ListSchedulesRequest} {
+		assert.Equal(t, item, ToListSchedulesRequest(FromListSchedulesRequest(item)))
+	}
+}
+func TestListSchedulesResponse(t *testing.T) {
+	for _, item := range []*types.ListSchedulesResponse{nil, {}, &testdata.ListSchedulesResponse} {
+		assert.Equal(t, item, ToListSchedulesResponse(FromListSchedulesResponse(item)))
+	}
+}
 func TestRequestCancelWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.RequestCancelWorkflowExecutionRequest{nil, {}, &testdata.RequestCancelWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToRequestCancelWorkflowExecutionRequest(FromRequestCancelWorkflowExecutionRequest(item)))
@@ -485,6 +575,16 @@ func TestResetWorkflowExecutionResponse(t *testing.T) {
 		assert.Equal(t, item, ToResetWorkflowExecutionResponse(FromResetWorkflowExecutionResponse(item)))
 	}
 }
+func TestRestartWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.RestartWorkflowExecutionRequest{nil, {}, &testdata.RestartWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToRestartWorkflowExecutionRequest(FromRestartWorkflowExecutionRequest(item)))
+	}
+}
+func TestRestartWorkflowExecutionResponse(t *testing.T) {
+	for _, item := range []*types.RestartWorkflowExecutionResponse{nil, {}, &testdata.RestartWorkflowExecutionResponse} {
+		assert.Equal(t, item, ToRestartWorkflowExecutionResponse(FromRestartWorkflowExecutionResponse(item)))
+	}
+}
 func TestRespondActivityTaskCanceledByIDRequest(t *testing.T) {
 	for _, item := range []*types.RespondActivityTaskCanceledByIDRequest{nil, {}, &testdata.RespondActivityTaskCanceledByIDRequest} {
 		assert.Equal(t, item, ToRespondActivityTaskCanceledByIDRequest(FromRespondActivityTaskCanceledByIDRequest(item)))
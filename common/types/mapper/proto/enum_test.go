@@ -206,6 +206,7 @@ func TestIndexedValueType(t *testing.T) {
 		types.IndexedValueTypeDouble,
 		types.IndexedValueTypeBool,
 		types.IndexedValueTypeDatetime,
+		types.IndexedValueTypeKeywordList,
 	} {
 		assert.Equal(t, item, ToIndexedValueType(FromIndexedValueType(item)))
 	}
@@ -367,6 +368,18 @@ func TestWorkflowExecutionCloseStatus(t *testing.T) {
 	assert.Panics(t, func() { ToWorkflowExecutionCloseStatus(apiv1.WorkflowExecutionCloseStatus(UnknownValue)) })
 	assert.Panics(t, func() { FromWorkflowExecutionCloseStatus(types.WorkflowExecutionCloseStatus(UnknownValue).Ptr()) })
 }
+func TestWorkflowIDConflictPolicy(t *testing.T) {
+	for _, item := range []*types.WorkflowIDConflictPolicy{
+		nil,
+		types.WorkflowIDConflictPolicyFail.Ptr(),
+		types.WorkflowIDConflictPolicyUseExisting.Ptr(),
+		types.WorkflowIDConflictPolicyTerminateExisting.Ptr(),
+	} {
+		assert.Equal(t, item, ToWorkflowIDConflictPolicy(FromWorkflowIDConflictPolicy(item)))
+	}
+	assert.Panics(t, func() { ToWorkflowIDConflictPolicy(apiv1.WorkflowIdConflictPolicy(UnknownValue)) })
+	assert.Panics(t, func() { FromWorkflowIDConflictPolicy(types.WorkflowIDConflictPolicy(UnknownValue).Ptr()) })
+}
 func TestWorkflowIDReusePolicy(t *testing.T) {
 	for _, item := range []*types.WorkflowIDReusePolicy{
 		nil,
@@ -79,6 +79,28 @@ func timeToUnixNano(t *gogo.Timestamp) *int64 {
 	return common.Int64Ptr(timestamp.UnixNano())
 }
 
+func unixNanoMapToTimeMap(t map[string]int64) map[string]*gogo.Timestamp {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*gogo.Timestamp, len(t))
+	for key := range t {
+		v[key] = unixNanoToTime(common.Int64Ptr(t[key]))
+	}
+	return v
+}
+
+func timeMapToUnixNanoMap(t map[string]*gogo.Timestamp) map[string]int64 {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]int64, len(t))
+	for key := range t {
+		v[key] = common.Int64Default(timeToUnixNano(t[key]))
+	}
+	return v
+}
+
 func daysToDuration(d *int32) *gogo.Duration {
 	if d == nil {
 		return nil
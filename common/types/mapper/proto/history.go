@@ -197,6 +197,7 @@ func FromHistoryDescribeWorkflowExecutionResponse(t *types.DescribeWorkflowExecu
 		PendingActivities:      FromPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        FromPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        FromPendingDecisionInfo(t.PendingDecision),
+		SignalRequestIds:       unixNanoMapToTimeMap(t.SignalRequestIDs),
 	}
 }
 
@@ -210,6 +211,7 @@ func ToHistoryDescribeWorkflowExecutionResponse(t *historyv1.DescribeWorkflowExe
 		PendingActivities:      ToPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        ToPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        ToPendingDecisionInfo(t.PendingDecision),
+		SignalRequestIDs:       timeMapToUnixNanoMap(t.SignalRequestIds),
 	}
 }
 
@@ -572,6 +574,93 @@ func ToHistoryQueryWorkflowResponse(t *historyv1.QueryWorkflowResponse) *types.H
 	}
 }
 
+func FromHistoryUpdateWorkflowExecutionRequest(t *types.HistoryUpdateWorkflowExecutionRequest) *historyv1.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UpdateWorkflowExecutionRequest{
+		Request:  FromUpdateWorkflowExecutionRequest(t.Request),
+		DomainId: t.DomainUUID,
+	}
+}
+
+func ToHistoryUpdateWorkflowExecutionRequest(t *historyv1.UpdateWorkflowExecutionRequest) *types.HistoryUpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateWorkflowExecutionRequest{
+		Request:    ToUpdateWorkflowExecutionRequest(t.Request),
+		DomainUUID: t.DomainId,
+	}
+}
+
+func FromHistoryUpdateWorkflowExecutionResponse(t *types.HistoryUpdateWorkflowExecutionResponse) *historyv1.UpdateWorkflowExecutionResponse {
+	if t == nil || t.Response == nil {
+		return nil
+	}
+	return &historyv1.UpdateWorkflowExecutionResponse{
+		Result:       FromPayload(t.Response.Result),
+		ErrorMessage: t.Response.ErrorMessage,
+	}
+}
+
+func ToHistoryUpdateWorkflowExecutionResponse(t *historyv1.UpdateWorkflowExecutionResponse) *types.HistoryUpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateWorkflowExecutionResponse{
+		Response: &types.UpdateWorkflowExecutionResponse{
+			Result:       ToPayload(t.Result),
+			ErrorMessage: t.ErrorMessage,
+		},
+	}
+}
+
+func FromHistoryMigrateWorkflowExecutionRequest(t *types.HistoryMigrateWorkflowExecutionRequest) *historyv1.MigrateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.MigrateWorkflowExecutionRequest{
+		DomainId:          t.DomainUUID,
+		WorkflowExecution: FromWorkflowRunPair(t.WorkflowID, t.RunID),
+		SourceCluster:     t.SourceCluster,
+		SourceDomain:      t.SourceDomain,
+	}
+}
+
+func ToHistoryMigrateWorkflowExecutionRequest(t *historyv1.MigrateWorkflowExecutionRequest) *types.HistoryMigrateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryMigrateWorkflowExecutionRequest{
+		DomainUUID:    t.DomainId,
+		WorkflowID:    ToWorkflowID(t.WorkflowExecution),
+		RunID:         ToRunID(t.WorkflowExecution),
+		SourceCluster: t.SourceCluster,
+		SourceDomain:  t.SourceDomain,
+	}
+}
+
+func FromHistoryMigrateWorkflowExecutionResponse(t *types.MigrateWorkflowExecutionResponse) *historyv1.MigrateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.MigrateWorkflowExecutionResponse{
+		RunId:       t.RunID,
+		NextEventId: t.NextEventID,
+	}
+}
+
+func ToHistoryMigrateWorkflowExecutionResponse(t *historyv1.MigrateWorkflowExecutionResponse) *types.MigrateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MigrateWorkflowExecutionResponse{
+		RunID:       t.RunId,
+		NextEventID: t.NextEventId,
+	}
+}
+
 func FromHistoryReadDLQMessagesRequest(t *types.ReadDLQMessagesRequest) *historyv1.ReadDLQMessagesRequest {
 	if t == nil {
 		return nil
@@ -819,6 +908,9 @@ func FromHistoryRecordDecisionTaskStartedResponse(t *types.RecordDecisionTaskSta
 		ScheduledTime:             unixNanoToTime(t.ScheduledTimestamp),
 		StartedTime:               unixNanoToTime(t.StartedTimestamp),
 		Queries:                   FromWorkflowQueryMap(t.Queries),
+		HistorySize:               t.HistorySize,
+		HistoryCount:              t.HistoryCount,
+		SuggestContinueAsNew:      t.SuggestContinueAsNew,
 	}
 }
 
@@ -841,6 +933,9 @@ func ToHistoryRecordDecisionTaskStartedResponse(t *historyv1.RecordDecisionTaskS
 		ScheduledTimestamp:        timeToUnixNano(t.ScheduledTime),
 		StartedTimestamp:          timeToUnixNano(t.StartedTime),
 		Queries:                   ToWorkflowQueryMap(t.Queries),
+		HistorySize:               t.HistorySize,
+		HistoryCount:              t.HistoryCount,
+		SuggestContinueAsNew:      t.SuggestContinueAsNew,
 	}
 }
 
@@ -967,6 +1062,126 @@ func ToHistoryRequestCancelWorkflowExecutionRequest(t *historyv1.RequestCancelWo
 	}
 }
 
+func FromHistoryPauseWorkflowExecutionRequest(t *types.HistoryPauseWorkflowExecutionRequest) *historyv1.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.PauseWorkflowExecutionRequest{
+		DomainId:     t.DomainUUID,
+		PauseRequest: FromPauseWorkflowExecutionRequest(t.PauseRequest),
+	}
+}
+
+func ToHistoryPauseWorkflowExecutionRequest(t *historyv1.PauseWorkflowExecutionRequest) *types.HistoryPauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID:   t.DomainId,
+		PauseRequest: ToPauseWorkflowExecutionRequest(t.PauseRequest),
+	}
+}
+
+func FromHistoryUnpauseWorkflowExecutionRequest(t *types.HistoryUnpauseWorkflowExecutionRequest) *historyv1.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UnpauseWorkflowExecutionRequest{
+		DomainId:       t.DomainUUID,
+		UnpauseRequest: FromUnpauseWorkflowExecutionRequest(t.UnpauseRequest),
+	}
+}
+
+func ToHistoryUnpauseWorkflowExecutionRequest(t *historyv1.UnpauseWorkflowExecutionRequest) *types.HistoryUnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     t.DomainId,
+		UnpauseRequest: ToUnpauseWorkflowExecutionRequest(t.UnpauseRequest),
+	}
+}
+
+func FromHistoryDeleteWorkflowExecutionRequest(t *types.HistoryDeleteWorkflowExecutionRequest) *historyv1.DeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.DeleteWorkflowExecutionRequest{
+		DomainId:      t.DomainUUID,
+		DeleteRequest: FromDeleteWorkflowExecutionRequest(t.DeleteRequest),
+	}
+}
+
+func ToHistoryDeleteWorkflowExecutionRequest(t *historyv1.DeleteWorkflowExecutionRequest) *types.HistoryDeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryDeleteWorkflowExecutionRequest{
+		DomainUUID:    t.DomainId,
+		DeleteRequest: ToDeleteWorkflowExecutionRequest(t.DeleteRequest),
+	}
+}
+
+func FromHistoryPauseActivityRequest(t *types.HistoryPauseActivityRequest) *historyv1.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.PauseActivityRequest{
+		DomainId:     t.DomainUUID,
+		PauseRequest: FromPauseActivityRequest(t.PauseRequest),
+	}
+}
+
+func ToHistoryPauseActivityRequest(t *historyv1.PauseActivityRequest) *types.HistoryPauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseActivityRequest{
+		DomainUUID:   t.DomainId,
+		PauseRequest: ToPauseActivityRequest(t.PauseRequest),
+	}
+}
+
+func FromHistoryUnpauseActivityRequest(t *types.HistoryUnpauseActivityRequest) *historyv1.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UnpauseActivityRequest{
+		DomainId:       t.DomainUUID,
+		UnpauseRequest: FromUnpauseActivityRequest(t.UnpauseRequest),
+	}
+}
+
+func ToHistoryUnpauseActivityRequest(t *historyv1.UnpauseActivityRequest) *types.HistoryUnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseActivityRequest{
+		DomainUUID:     t.DomainId,
+		UnpauseRequest: ToUnpauseActivityRequest(t.UnpauseRequest),
+	}
+}
+
+func FromHistoryResetActivityRequest(t *types.HistoryResetActivityRequest) *historyv1.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ResetActivityRequest{
+		DomainId:     t.DomainUUID,
+		ResetRequest: FromResetActivityRequest(t.ResetRequest),
+	}
+}
+
+func ToHistoryResetActivityRequest(t *historyv1.ResetActivityRequest) *types.HistoryResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryResetActivityRequest{
+		DomainUUID:   t.DomainId,
+		ResetRequest: ToResetActivityRequest(t.ResetRequest),
+	}
+}
+
 func FromHistoryResetQueueRequest(t *types.ResetQueueRequest) *historyv1.ResetQueueRequest {
 	if t == nil {
 		return nil
@@ -1268,6 +1483,7 @@ func FromHistoryStartWorkflowExecutionRequest(t *types.HistoryStartWorkflowExecu
 		ContinuedFailure:         FromFailure(t.ContinuedFailureReason, t.ContinuedFailureDetails),
 		LastCompletionResult:     FromPayload(t.LastCompletionResult),
 		FirstDecisionTaskBackoff: secondsToDuration(t.FirstDecisionTaskBackoffSeconds),
+		RestartedFromRunId:       t.RestartedFromRunID,
 	}
 }
 
@@ -1286,6 +1502,7 @@ func ToHistoryStartWorkflowExecutionRequest(t *historyv1.StartWorkflowExecutionR
 		ContinuedFailureDetails:         ToFailureDetails(t.ContinuedFailure),
 		LastCompletionResult:            ToPayload(t.LastCompletionResult),
 		FirstDecisionTaskBackoffSeconds: durationToSeconds(t.FirstDecisionTaskBackoff),
+		RestartedFromRunID:              t.RestartedFromRunId,
 	}
 }
 
@@ -1325,6 +1542,7 @@ func FromHistorySyncActivityRequest(t *types.SyncActivityRequest) *historyv1.Syn
 		LastFailure:        FromFailure(t.LastFailureReason, t.LastFailureDetails),
 		LastWorkerIdentity: t.LastWorkerIdentity,
 		VersionHistory:     FromVersionHistory(t.VersionHistory),
+		Paused:             t.Paused,
 	}
 }
 
@@ -1348,6 +1566,7 @@ func ToHistorySyncActivityRequest(t *historyv1.SyncActivityRequest) *types.SyncA
 		LastFailureDetails: ToFailureDetails(t.LastFailure),
 		LastWorkerIdentity: t.LastWorkerIdentity,
 		VersionHistory:     ToVersionHistory(t.VersionHistory),
+		Paused:             t.Paused,
 	}
 }
 
@@ -1482,3 +1701,157 @@ func ToHistoryRespondCrossClusterTasksCompletedResponse(t *historyv1.RespondCros
 		Tasks: ToCrossClusterTaskRequestArray(t.Tasks),
 	}
 }
+
+func FromHistoryReadHistoryTaskDLQRequest(t *types.ReadHistoryTaskDLQRequest) *historyv1.ReadHistoryTaskDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ReadHistoryTaskDLQRequest{
+		ShardId:              t.ShardID,
+		ExclusiveBeginTaskId: fromInt64Value(t.ExclusiveBeginTaskID),
+		InclusiveEndTaskId:   fromInt64Value(t.InclusiveEndTaskID),
+		PageSize:             t.MaximumPageSize,
+		NextPageToken:        t.NextPageToken,
+	}
+}
+
+func ToHistoryReadHistoryTaskDLQRequest(t *historyv1.ReadHistoryTaskDLQRequest) *types.ReadHistoryTaskDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ReadHistoryTaskDLQRequest{
+		ShardID:              t.ShardId,
+		ExclusiveBeginTaskID: toInt64Value(t.ExclusiveBeginTaskId),
+		InclusiveEndTaskID:   toInt64Value(t.InclusiveEndTaskId),
+		MaximumPageSize:      t.PageSize,
+		NextPageToken:        t.NextPageToken,
+	}
+}
+
+func FromHistoryReadHistoryTaskDLQResponse(t *types.ReadHistoryTaskDLQResponse) *historyv1.ReadHistoryTaskDLQResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ReadHistoryTaskDLQResponse{
+		Entries:       FromHistoryTaskDLQEntryArray(t.Entries),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToHistoryReadHistoryTaskDLQResponse(t *historyv1.ReadHistoryTaskDLQResponse) *types.ReadHistoryTaskDLQResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ReadHistoryTaskDLQResponse{
+		Entries:       ToHistoryTaskDLQEntryArray(t.Entries),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromHistoryPurgeHistoryTaskDLQRequest(t *types.PurgeHistoryTaskDLQRequest) *historyv1.PurgeHistoryTaskDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.PurgeHistoryTaskDLQRequest{
+		ShardId:              t.ShardID,
+		ExclusiveBeginTaskId: fromInt64Value(t.ExclusiveBeginTaskID),
+		InclusiveEndTaskId:   fromInt64Value(t.InclusiveEndTaskID),
+	}
+}
+
+func ToHistoryPurgeHistoryTaskDLQRequest(t *historyv1.PurgeHistoryTaskDLQRequest) *types.PurgeHistoryTaskDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PurgeHistoryTaskDLQRequest{
+		ShardID:              t.ShardId,
+		ExclusiveBeginTaskID: toInt64Value(t.ExclusiveBeginTaskId),
+		InclusiveEndTaskID:   toInt64Value(t.InclusiveEndTaskId),
+	}
+}
+
+func FromHistoryRetryHistoryTaskDLQRequest(t *types.RetryHistoryTaskDLQRequest) *historyv1.RetryHistoryTaskDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.RetryHistoryTaskDLQRequest{
+		ShardId:              t.ShardID,
+		ExclusiveBeginTaskId: fromInt64Value(t.ExclusiveBeginTaskID),
+		InclusiveEndTaskId:   fromInt64Value(t.InclusiveEndTaskID),
+		PageSize:             t.MaximumPageSize,
+		NextPageToken:        t.NextPageToken,
+	}
+}
+
+func ToHistoryRetryHistoryTaskDLQRequest(t *historyv1.RetryHistoryTaskDLQRequest) *types.RetryHistoryTaskDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RetryHistoryTaskDLQRequest{
+		ShardID:              t.ShardId,
+		ExclusiveBeginTaskID: toInt64Value(t.ExclusiveBeginTaskId),
+		InclusiveEndTaskID:   toInt64Value(t.InclusiveEndTaskId),
+		MaximumPageSize:      t.PageSize,
+		NextPageToken:        t.NextPageToken,
+	}
+}
+
+func FromHistoryRetryHistoryTaskDLQResponse(t *types.RetryHistoryTaskDLQResponse) *historyv1.RetryHistoryTaskDLQResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.RetryHistoryTaskDLQResponse{
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToHistoryRetryHistoryTaskDLQResponse(t *historyv1.RetryHistoryTaskDLQResponse) *types.RetryHistoryTaskDLQResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.RetryHistoryTaskDLQResponse{
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromHistoryDescribeReplicationStatusRequest(t *types.DescribeReplicationStatusRequest) *historyv1.DescribeReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.DescribeReplicationStatusRequest{
+		ClusterName: t.ClusterName,
+		Domain:      t.Domain,
+		ShardIds:    t.ShardIDs,
+	}
+}
+
+func ToHistoryDescribeReplicationStatusRequest(t *historyv1.DescribeReplicationStatusRequest) *types.DescribeReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeReplicationStatusRequest{
+		ClusterName: t.ClusterName,
+		Domain:      t.Domain,
+		ShardIDs:    t.ShardIds,
+	}
+}
+
+func FromHistoryDescribeReplicationStatusResponse(t *types.DescribeReplicationStatusResponse) *historyv1.DescribeReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.DescribeReplicationStatusResponse{
+		Shards:  FromReplicationShardStatusArray(t.Shards),
+		Domains: FromReplicationDomainStatusArray(t.Domains),
+	}
+}
+
+func ToHistoryDescribeReplicationStatusResponse(t *historyv1.DescribeReplicationStatusResponse) *types.DescribeReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeReplicationStatusResponse{
+		Shards:  ToReplicationShardStatusArray(t.Shards),
+		Domains: ToReplicationDomainStatusArray(t.Domains),
+	}
+}
@@ -217,6 +217,56 @@ func TestHistoryReplicateEventsV2Request(t *testing.T) {
 		assert.Equal(t, item, ToHistoryReplicateEventsV2Request(FromHistoryReplicateEventsV2Request(item)))
 	}
 }
+func TestHistoryPauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryPauseWorkflowExecutionRequest{nil, {}, &testdata.HistoryPauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryPauseWorkflowExecutionRequest(FromHistoryPauseWorkflowExecutionRequest(item)))
+	}
+}
+func TestHistoryUnpauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUnpauseWorkflowExecutionRequest{nil, {}, &testdata.HistoryUnpauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryUnpauseWorkflowExecutionRequest(FromHistoryUnpauseWorkflowExecutionRequest(item)))
+	}
+}
+func TestHistoryDeleteWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryDeleteWorkflowExecutionRequest{nil, {}, &testdata.HistoryDeleteWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryDeleteWorkflowExecutionRequest(FromHistoryDeleteWorkflowExecutionRequest(item)))
+	}
+}
+func TestHistoryPauseActivityRequest(t *testing.T) {
+	for _, item := range []*types.HistoryPauseActivityRequest{nil, {}, &testdata.HistoryPauseActivityRequest} {
+		assert.Equal(t, item, ToHistoryPauseActivityRequest(FromHistoryPauseActivityRequest(item)))
+	}
+}
+func TestHistoryUnpauseActivityRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUnpauseActivityRequest{nil, {}, &testdata.HistoryUnpauseActivityRequest} {
+		assert.Equal(t, item, ToHistoryUnpauseActivityRequest(FromHistoryUnpauseActivityRequest(item)))
+	}
+}
+func TestHistoryResetActivityRequest(t *testing.T) {
+	for _, item := range []*types.HistoryResetActivityRequest{nil, {}, &testdata.HistoryResetActivityRequest} {
+		assert.Equal(t, item, ToHistoryResetActivityRequest(FromHistoryResetActivityRequest(item)))
+	}
+}
+func TestHistoryUpdateWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUpdateWorkflowExecutionRequest{nil, {}, &testdata.HistoryUpdateWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryUpdateWorkflowExecutionRequest(FromHistoryUpdateWorkflowExecutionRequest(item)))
+	}
+}
+func TestHistoryUpdateWorkflowExecutionResponse(t *testing.T) {
+	for _, item := range []*types.HistoryUpdateWorkflowExecutionResponse{nil, &testdata.HistoryUpdateWorkflowExecutionResponse} {
+		assert.Equal(t, item, ToHistoryUpdateWorkflowExecutionResponse(FromHistoryUpdateWorkflowExecutionResponse(item)))
+	}
+}
+func TestHistoryMigrateWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryMigrateWorkflowExecutionRequest{nil, {}, &testdata.HistoryMigrateWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryMigrateWorkflowExecutionRequest(FromHistoryMigrateWorkflowExecutionRequest(item)))
+	}
+}
+func TestHistoryMigrateWorkflowExecutionResponse(t *testing.T) {
+	for _, item := range []*types.MigrateWorkflowExecutionResponse{nil, {}, &testdata.HistoryMigrateWorkflowExecutionResponse} {
+		assert.Equal(t, item, ToHistoryMigrateWorkflowExecutionResponse(FromHistoryMigrateWorkflowExecutionResponse(item)))
+	}
+}
 func TestHistoryRequestCancelWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.HistoryRequestCancelWorkflowExecutionRequest{nil, {}, &testdata.HistoryRequestCancelWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToHistoryRequestCancelWorkflowExecutionRequest(FromHistoryRequestCancelWorkflowExecutionRequest(item)))
@@ -341,3 +391,38 @@ func TestHistoryRespondCrossClusterTasksCompletedResponse(t *testing.T) {
 		assert.Equal(t, item, ToHistoryRespondCrossClusterTasksCompletedResponse(FromHistoryRespondCrossClusterTasksCompletedResponse(item)))
 	}
 }
+func TestHistoryReadHistoryTaskDLQRequest(t *testing.T) {
+	for _, item := range []*types.ReadHistoryTaskDLQRequest{nil, {}, &testdata.HistoryReadHistoryTaskDLQRequest} {
+		assert.Equal(t, item, ToHistoryReadHistoryTaskDLQRequest(FromHistoryReadHistoryTaskDLQRequest(item)))
+	}
+}
+func TestHistoryReadHistoryTaskDLQResponse(t *testing.T) {
+	for _, item := range []*types.ReadHistoryTaskDLQResponse{nil, {}, &testdata.HistoryReadHistoryTaskDLQResponse} {
+		assert.Equal(t, item, ToHistoryReadHistoryTaskDLQResponse(FromHistoryReadHistoryTaskDLQResponse(item)))
+	}
+}
+func TestHistoryPurgeHistoryTaskDLQRequest(t *testing.T) {
+	for _, item := range []*types.PurgeHistoryTaskDLQRequest{nil, {}, &testdata.HistoryPurgeHistoryTaskDLQRequest} {
+		assert.Equal(t, item, ToHistoryPurgeHistoryTaskDLQRequest(FromHistoryPurgeHistoryTaskDLQRequest(item)))
+	}
+}
+func TestHistoryRetryHistoryTaskDLQRequest(t *testing.T) {
+	for _, item := range []*types.RetryHistoryTaskDLQRequest{nil, {}, &testdata.HistoryRetryHistoryTaskDLQRequest} {
+		assert.Equal(t, item, ToHistoryRetryHistoryTaskDLQRequest(FromHistoryRetryHistoryTaskDLQRequest(item)))
+	}
+}
+func TestHistoryRetryHistoryTaskDLQResponse(t *testing.T) {
+	for _, item := range []*types.RetryHistoryTaskDLQResponse{nil, {}, &testdata.HistoryRetryHistoryTaskDLQResponse} {
+		assert.Equal(t, item, ToHistoryRetryHistoryTaskDLQResponse(FromHistoryRetryHistoryTaskDLQResponse(item)))
+	}
+}
+func TestHistoryDescribeReplicationStatusRequest(t *testing.T) {
+	for _, item := range []*types.DescribeReplicationStatusRequest{nil, {}, &testdata.HistoryDescribeReplicationStatusRequest} {
+		assert.Equal(t, item, ToHistoryDescribeReplicationStatusRequest(FromHistoryDescribeReplicationStatusRequest(item)))
+	}
+}
+func TestHistoryDescribeReplicationStatusResponse(t *testing.T) {
+	for _, item := range []*types.DescribeReplicationStatusResponse{nil, {}, &testdata.HistoryDescribeReplicationStatusResponse} {
+		assert.Equal(t, item, ToHistoryDescribeReplicationStatusResponse(FromHistoryDescribeReplicationStatusResponse(item)))
+	}
+}
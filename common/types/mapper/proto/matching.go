@@ -354,6 +354,9 @@ func FromMatchingPollForDecisionTaskResponse(t *types.MatchingPollForDecisionTas
 		ScheduledTime:             unixNanoToTime(t.ScheduledTimestamp),
 		StartedTime:               unixNanoToTime(t.StartedTimestamp),
 		Queries:                   FromWorkflowQueryMap(t.Queries),
+		HistorySize:               t.HistorySize,
+		HistoryCount:              t.HistoryCount,
+		SuggestContinueAsNew:      t.SuggestContinueAsNew,
 	}
 }
 
@@ -379,6 +382,9 @@ func ToMatchingPollForDecisionTaskResponse(t *matchingv1.PollForDecisionTaskResp
 		ScheduledTimestamp:        timeToUnixNano(t.ScheduledTime),
 		StartedTimestamp:          timeToUnixNano(t.StartedTime),
 		Queries:                   ToWorkflowQueryMap(t.Queries),
+		HistorySize:               t.HistorySize,
+		HistoryCount:              t.HistoryCount,
+		SuggestContinueAsNew:      t.SuggestContinueAsNew,
 	}
 }
 
@@ -449,3 +455,99 @@ func ToMatchingRespondQueryTaskCompletedRequest(t *matchingv1.RespondQueryTaskCo
 		TaskID:           t.TaskId,
 	}
 }
+
+func FromMatchingPauseTaskListRequest(t *types.MatchingPauseTaskListRequest) *matchingv1.PauseTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.PauseTaskListRequest{
+		DomainId:     t.DomainUUID,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+func ToMatchingPauseTaskListRequest(t *matchingv1.PauseTaskListRequest) *types.MatchingPauseTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingPauseTaskListRequest{
+		DomainUUID:   t.DomainId,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+func FromMatchingResumeTaskListRequest(t *types.MatchingResumeTaskListRequest) *matchingv1.ResumeTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.ResumeTaskListRequest{
+		DomainId:     t.DomainUUID,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+func ToMatchingResumeTaskListRequest(t *matchingv1.ResumeTaskListRequest) *types.MatchingResumeTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingResumeTaskListRequest{
+		DomainUUID:   t.DomainId,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+func FromMatchingMoveTaskListBacklogRequest(t *types.MatchingMoveTaskListBacklogRequest) *matchingv1.MoveTaskListBacklogRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.MoveTaskListBacklogRequest{
+		DomainId:       t.DomainUUID,
+		SourceTaskList: FromTaskList(t.SourceTaskList),
+		TargetTaskList: FromTaskList(t.TargetTaskList),
+		TaskListType:   FromTaskListType(t.TaskListType),
+		BatchSize:      t.BatchSize,
+		AfterTaskId:    t.AfterTaskID,
+		DryRun:         t.DryRun,
+	}
+}
+
+func ToMatchingMoveTaskListBacklogRequest(t *matchingv1.MoveTaskListBacklogRequest) *types.MatchingMoveTaskListBacklogRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingMoveTaskListBacklogRequest{
+		DomainUUID:     t.DomainId,
+		SourceTaskList: ToTaskList(t.SourceTaskList),
+		TargetTaskList: ToTaskList(t.TargetTaskList),
+		TaskListType:   ToTaskListType(t.TaskListType),
+		BatchSize:      t.BatchSize,
+		AfterTaskID:    t.AfterTaskId,
+		DryRun:         t.DryRun,
+	}
+}
+
+func FromMatchingMoveTaskListBacklogResponse(t *types.MoveTaskListBacklogResponse) *matchingv1.MoveTaskListBacklogResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.MoveTaskListBacklogResponse{
+		Count:      t.Count,
+		LastTaskId: t.LastTaskID,
+		HasMore:    t.HasMore,
+	}
+}
+
+func ToMatchingMoveTaskListBacklogResponse(t *matchingv1.MoveTaskListBacklogResponse) *types.MoveTaskListBacklogResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MoveTaskListBacklogResponse{
+		Count:      t.Count,
+		LastTaskID: t.LastTaskId,
+		HasMore:    t.HasMore,
+	}
+}
@@ -71,6 +71,30 @@ func TestMatchingListTaskListPartitionsResponse(t *testing.T) {
 	}
 }
 
+func TestMatchingPauseTaskListRequest(t *testing.T) {
+	for _, item := range []*types.MatchingPauseTaskListRequest{nil, {}, &testdata.MatchingPauseTaskListRequest} {
+		assert.Equal(t, item, ToMatchingPauseTaskListRequest(FromMatchingPauseTaskListRequest(item)))
+	}
+}
+
+func TestMatchingResumeTaskListRequest(t *testing.T) {
+	for _, item := range []*types.MatchingResumeTaskListRequest{nil, {}, &testdata.MatchingResumeTaskListRequest} {
+		assert.Equal(t, item, ToMatchingResumeTaskListRequest(FromMatchingResumeTaskListRequest(item)))
+	}
+}
+
+func TestMatchingMoveTaskListBacklogRequest(t *testing.T) {
+	for _, item := range []*types.MatchingMoveTaskListBacklogRequest{nil, {}, &testdata.MatchingMoveTaskListBacklogRequest} {
+		assert.Equal(t, item, ToMatchingMoveTaskListBacklogRequest(FromMatchingMoveTaskListBacklogRequest(item)))
+	}
+}
+
+func TestMatchingMoveTaskListBacklogResponse(t *testing.T) {
+	for _, item := range []*types.MoveTaskListBacklogResponse{nil, {}, &testdata.MatchingMoveTaskListBacklogResponse} {
+		assert.Equal(t, item, ToMatchingMoveTaskListBacklogResponse(FromMatchingMoveTaskListBacklogResponse(item)))
+	}
+}
+
 func TestMatchingPollForActivityTaskRequest(t *testing.T) {
 	for _, item := range []*types.MatchingPollForActivityTaskRequest{nil, {}, &testdata.MatchingPollForActivityTaskRequest} {
 		assert.Equal(t, item, ToMatchingPollForActivityTaskRequest(FromMatchingPollForActivityTaskRequest(item)))
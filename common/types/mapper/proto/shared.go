@@ -757,6 +757,7 @@ func FromSyncActivityTaskAttributes(t *types.SyncActivityTaskAttributes) *shared
 		LastFailure:        FromFailure(t.LastFailureReason, t.LastFailureDetails),
 		LastWorkerIdentity: t.LastWorkerIdentity,
 		VersionHistory:     FromVersionHistory(t.VersionHistory),
+		Paused:             t.Paused,
 	}
 }
 
@@ -780,6 +781,7 @@ func ToSyncActivityTaskAttributes(t *sharedv1.SyncActivityTaskAttributes) *types
 		LastFailureDetails: ToFailureDetails(t.LastFailure),
 		LastWorkerIdentity: t.LastWorkerIdentity,
 		VersionHistory:     ToVersionHistory(t.VersionHistory),
+		Paused:             t.Paused,
 	}
 }
 
@@ -1553,3 +1555,188 @@ func ToCrossClusterTaskResponseArray(t []*sharedv1.CrossClusterTaskResponse) []*
 	}
 	return v
 }
+
+// FromHistoryTaskDLQEntry converts internal HistoryTaskDLQEntry type to proto
+func FromHistoryTaskDLQEntry(t *types.HistoryTaskDLQEntry) *sharedv1.HistoryTaskDLQEntry {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.HistoryTaskDLQEntry{
+		TaskId:            t.TaskID,
+		Category:          FromTaskType(t.Category),
+		TaskType:          t.TaskType,
+		DomainId:          t.DomainID,
+		WorkflowExecution: FromWorkflowRunPair(t.WorkflowID, t.RunID),
+		VisibilityTime:    unixNanoToTime(&t.VisibilityTimestamp),
+		Attempt:           t.Attempt,
+		LastError:         t.LastError,
+		CreatedTime:       unixNanoToTime(&t.CreatedTimestamp),
+	}
+}
+
+// ToHistoryTaskDLQEntry converts proto HistoryTaskDLQEntry type to internal
+func ToHistoryTaskDLQEntry(t *sharedv1.HistoryTaskDLQEntry) *types.HistoryTaskDLQEntry {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryTaskDLQEntry{
+		TaskID:              t.TaskId,
+		Category:            ToTaskType(t.Category),
+		TaskType:            t.TaskType,
+		DomainID:            t.DomainId,
+		WorkflowID:          ToWorkflowID(t.WorkflowExecution),
+		RunID:               ToRunID(t.WorkflowExecution),
+		VisibilityTimestamp: common.Int64Default(timeToUnixNano(t.VisibilityTime)),
+		Attempt:             t.Attempt,
+		LastError:           t.LastError,
+		CreatedTimestamp:    common.Int64Default(timeToUnixNano(t.CreatedTime)),
+	}
+}
+
+// FromHistoryTaskDLQEntryArray converts internal HistoryTaskDLQEntry type array to proto
+func FromHistoryTaskDLQEntryArray(t []*types.HistoryTaskDLQEntry) []*sharedv1.HistoryTaskDLQEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*sharedv1.HistoryTaskDLQEntry, len(t))
+	for i := range t {
+		v[i] = FromHistoryTaskDLQEntry(t[i])
+	}
+	return v
+}
+
+// ToHistoryTaskDLQEntryArray converts proto HistoryTaskDLQEntry type array to internal
+func ToHistoryTaskDLQEntryArray(t []*sharedv1.HistoryTaskDLQEntry) []*types.HistoryTaskDLQEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.HistoryTaskDLQEntry, len(t))
+	for i := range t {
+		v[i] = ToHistoryTaskDLQEntry(t[i])
+	}
+	return v
+}
+
+// FromReplicationShardStatus converts internal ReplicationShardStatus type to proto
+func FromReplicationShardStatus(t *types.ReplicationShardStatus) *sharedv1.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.ReplicationShardStatus{
+		ShardId:               t.ShardID,
+		ClusterName:           t.ClusterName,
+		MaxTaskId:             t.MaxTaskID,
+		OutboundFetchedTaskId: t.OutboundFetchedTaskID,
+		OutboundAckedTaskId:   t.OutboundAckedTaskID,
+		OutboundLastFetchTime: unixNanoToTime(t.OutboundLastFetchTime),
+		InboundFetchedTaskId:  t.InboundFetchedTaskID,
+		InboundAckedTaskId:    t.InboundAckedTaskID,
+		InboundLastFetchTime:  unixNanoToTime(t.InboundLastFetchTime),
+		InboundDlqSize:        t.InboundDLQSize,
+		CrossClusterTaskLag:   t.CrossClusterTaskLag,
+	}
+}
+
+// ToReplicationShardStatus converts proto ReplicationShardStatus type to internal
+func ToReplicationShardStatus(t *sharedv1.ReplicationShardStatus) *types.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationShardStatus{
+		ShardID:               t.ShardId,
+		ClusterName:           t.ClusterName,
+		MaxTaskID:             t.MaxTaskId,
+		OutboundFetchedTaskID: t.OutboundFetchedTaskId,
+		OutboundAckedTaskID:   t.OutboundAckedTaskId,
+		OutboundLastFetchTime: timeToUnixNano(t.OutboundLastFetchTime),
+		InboundFetchedTaskID:  t.InboundFetchedTaskId,
+		InboundAckedTaskID:    t.InboundAckedTaskId,
+		InboundLastFetchTime:  timeToUnixNano(t.InboundLastFetchTime),
+		InboundDLQSize:        t.InboundDlqSize,
+		CrossClusterTaskLag:   t.CrossClusterTaskLag,
+	}
+}
+
+// FromReplicationShardStatusArray converts internal ReplicationShardStatus type array to proto
+func FromReplicationShardStatusArray(t []*types.ReplicationShardStatus) []*sharedv1.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*sharedv1.ReplicationShardStatus, len(t))
+	for i := range t {
+		v[i] = FromReplicationShardStatus(t[i])
+	}
+	return v
+}
+
+// ToReplicationShardStatusArray converts proto ReplicationShardStatus type array to internal
+func ToReplicationShardStatusArray(t []*sharedv1.ReplicationShardStatus) []*types.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ReplicationShardStatus, len(t))
+	for i := range t {
+		v[i] = ToReplicationShardStatus(t[i])
+	}
+	return v
+}
+
+// FromReplicationDomainStatus converts internal ReplicationDomainStatus type to proto
+func FromReplicationDomainStatus(t *types.ReplicationDomainStatus) *sharedv1.ReplicationDomainStatus {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.ReplicationDomainStatus{
+		Domain:                  t.Domain,
+		ActiveClusterName:       t.ActiveClusterName,
+		ClusterName:             t.ClusterName,
+		MaxOutboundTaskLag:      t.MaxOutboundTaskLag,
+		OldestOutboundFetchTime: unixNanoToTime(t.OldestOutboundFetchTime),
+		OldestInboundFetchTime:  unixNanoToTime(t.OldestInboundFetchTime),
+		InboundDlqSize:          t.InboundDLQSize,
+		MaxCrossClusterTaskLag:  t.MaxCrossClusterTaskLag,
+		GracefulFailoverEndTime: unixNanoToTime(t.GracefulFailoverEndTime),
+	}
+}
+
+// ToReplicationDomainStatus converts proto ReplicationDomainStatus type to internal
+func ToReplicationDomainStatus(t *sharedv1.ReplicationDomainStatus) *types.ReplicationDomainStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationDomainStatus{
+		Domain:                  t.Domain,
+		ActiveClusterName:       t.ActiveClusterName,
+		ClusterName:             t.ClusterName,
+		MaxOutboundTaskLag:      t.MaxOutboundTaskLag,
+		OldestOutboundFetchTime: timeToUnixNano(t.OldestOutboundFetchTime),
+		OldestInboundFetchTime:  timeToUnixNano(t.OldestInboundFetchTime),
+		InboundDLQSize:          t.InboundDlqSize,
+		MaxCrossClusterTaskLag:  t.MaxCrossClusterTaskLag,
+		GracefulFailoverEndTime: timeToUnixNano(t.GracefulFailoverEndTime),
+	}
+}
+
+// FromReplicationDomainStatusArray converts internal ReplicationDomainStatus type array to proto
+func FromReplicationDomainStatusArray(t []*types.ReplicationDomainStatus) []*sharedv1.ReplicationDomainStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*sharedv1.ReplicationDomainStatus, len(t))
+	for i := range t {
+		v[i] = FromReplicationDomainStatus(t[i])
+	}
+	return v
+}
+
+// ToReplicationDomainStatusArray converts proto ReplicationDomainStatus type array to internal
+func ToReplicationDomainStatusArray(t []*sharedv1.ReplicationDomainStatus) []*types.ReplicationDomainStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ReplicationDomainStatus, len(t))
+	for i := range t {
+		v[i] = ToReplicationDomainStatus(t[i])
+	}
+	return v
+}
@@ -467,3 +467,399 @@ func ToHostInfoArray(t []*admin.HostInfo) []*types.HostInfo {
 	}
 	return v
 }
+
+// FromPauseTaskListRequest converts internal PauseTaskListRequest type to thrift
+func FromPauseTaskListRequest(t *types.PauseTaskListRequest) *admin.PauseTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.PauseTaskListRequest{
+		Domain:       &t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+// ToPauseTaskListRequest converts thrift PauseTaskListRequest type to internal
+func ToPauseTaskListRequest(t *admin.PauseTaskListRequest) *types.PauseTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseTaskListRequest{
+		Domain:       t.GetDomain(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+// FromResumeTaskListRequest converts internal ResumeTaskListRequest type to thrift
+func FromResumeTaskListRequest(t *types.ResumeTaskListRequest) *admin.ResumeTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ResumeTaskListRequest{
+		Domain:       &t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+// ToResumeTaskListRequest converts thrift ResumeTaskListRequest type to internal
+func ToResumeTaskListRequest(t *admin.ResumeTaskListRequest) *types.ResumeTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResumeTaskListRequest{
+		Domain:       t.GetDomain(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+// FromMoveTaskListBacklogRequest converts internal MoveTaskListBacklogRequest type to thrift
+func FromMoveTaskListBacklogRequest(t *types.MoveTaskListBacklogRequest) *admin.MoveTaskListBacklogRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.MoveTaskListBacklogRequest{
+		Domain:         &t.Domain,
+		SourceTaskList: FromTaskList(t.SourceTaskList),
+		TargetTaskList: FromTaskList(t.TargetTaskList),
+		TaskListType:   FromTaskListType(t.TaskListType),
+		BatchSize:      &t.BatchSize,
+		AfterTaskID:    &t.AfterTaskID,
+		DryRun:         &t.DryRun,
+	}
+}
+
+// ToMoveTaskListBacklogRequest converts thrift MoveTaskListBacklogRequest type to internal
+func ToMoveTaskListBacklogRequest(t *admin.MoveTaskListBacklogRequest) *types.MoveTaskListBacklogRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MoveTaskListBacklogRequest{
+		Domain:         t.GetDomain(),
+		SourceTaskList: ToTaskList(t.SourceTaskList),
+		TargetTaskList: ToTaskList(t.TargetTaskList),
+		TaskListType:   ToTaskListType(t.TaskListType),
+		BatchSize:      t.GetBatchSize(),
+		AfterTaskID:    t.GetAfterTaskID(),
+		DryRun:         t.GetDryRun(),
+	}
+}
+
+// FromMigrateWorkflowExecutionRequest converts internal MigrateWorkflowExecutionRequest type to thrift
+func FromMigrateWorkflowExecutionRequest(t *types.MigrateWorkflowExecutionRequest) *admin.MigrateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.MigrateWorkflowExecutionRequest{
+		Domain:        &t.Domain,
+		WorkflowID:    &t.WorkflowID,
+		RunID:         &t.RunID,
+		SourceCluster: &t.SourceCluster,
+		SourceDomain:  &t.SourceDomain,
+	}
+}
+
+// ToMigrateWorkflowExecutionRequest converts thrift MigrateWorkflowExecutionRequest type to internal
+func ToMigrateWorkflowExecutionRequest(t *admin.MigrateWorkflowExecutionRequest) *types.MigrateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MigrateWorkflowExecutionRequest{
+		Domain:        t.GetDomain(),
+		WorkflowID:    t.GetWorkflowID(),
+		RunID:         t.GetRunID(),
+		SourceCluster: t.GetSourceCluster(),
+		SourceDomain:  t.GetSourceDomain(),
+	}
+}
+
+// FromMigrateWorkflowExecutionResponse converts internal MigrateWorkflowExecutionResponse type to thrift
+func FromMigrateWorkflowExecutionResponse(t *types.MigrateWorkflowExecutionResponse) *admin.MigrateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.MigrateWorkflowExecutionResponse{
+		RunID:       &t.RunID,
+		NextEventID: &t.NextEventID,
+	}
+}
+
+// ToMigrateWorkflowExecutionResponse converts thrift MigrateWorkflowExecutionResponse type to internal
+func ToMigrateWorkflowExecutionResponse(t *admin.MigrateWorkflowExecutionResponse) *types.MigrateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MigrateWorkflowExecutionResponse{
+		RunID:       t.GetRunID(),
+		NextEventID: t.GetNextEventID(),
+	}
+}
+
+// FromAddRemoteClusterRequest converts internal AddRemoteClusterRequest type to thrift
+func FromAddRemoteClusterRequest(t *types.AddRemoteClusterRequest) *admin.AddRemoteClusterRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.AddRemoteClusterRequest{
+		ClusterName:            &t.ClusterName,
+		InitialFailoverVersion: &t.InitialFailoverVersion,
+		RpcName:                &t.RPCName,
+		RpcAddress:             &t.RPCAddress,
+	}
+}
+
+// ToAddRemoteClusterRequest converts thrift AddRemoteClusterRequest type to internal
+func ToAddRemoteClusterRequest(t *admin.AddRemoteClusterRequest) *types.AddRemoteClusterRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.AddRemoteClusterRequest{
+		ClusterName:            t.GetClusterName(),
+		InitialFailoverVersion: t.GetInitialFailoverVersion(),
+		RPCName:                t.GetRpcName(),
+		RPCAddress:             t.GetRpcAddress(),
+	}
+}
+
+// FromRemoveRemoteClusterRequest converts internal RemoveRemoteClusterRequest type to thrift
+func FromRemoveRemoteClusterRequest(t *types.RemoveRemoteClusterRequest) *admin.RemoveRemoteClusterRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.RemoveRemoteClusterRequest{
+		ClusterName: &t.ClusterName,
+	}
+}
+
+// ToRemoveRemoteClusterRequest converts thrift RemoveRemoteClusterRequest type to internal
+func ToRemoveRemoteClusterRequest(t *admin.RemoveRemoteClusterRequest) *types.RemoveRemoteClusterRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RemoveRemoteClusterRequest{
+		ClusterName: t.GetClusterName(),
+	}
+}
+
+// FromListDomainChangesRequest converts internal ListDomainChangesRequest type to thrift
+func FromListDomainChangesRequest(t *types.ListDomainChangesRequest) *admin.ListDomainChangesRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ListDomainChangesRequest{
+		Domain:        &t.Domain,
+		PageSize:      &t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListDomainChangesRequest converts thrift ListDomainChangesRequest type to internal
+func ToListDomainChangesRequest(t *admin.ListDomainChangesRequest) *types.ListDomainChangesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListDomainChangesRequest{
+		Domain:        t.GetDomain(),
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromListDomainChangesResponse converts internal ListDomainChangesResponse type to thrift
+func FromListDomainChangesResponse(t *types.ListDomainChangesResponse) *admin.ListDomainChangesResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.ListDomainChangesResponse{
+		Changes:       FromDomainChangeArray(t.Changes),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListDomainChangesResponse converts thrift ListDomainChangesResponse type to internal
+func ToListDomainChangesResponse(t *admin.ListDomainChangesResponse) *types.ListDomainChangesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListDomainChangesResponse{
+		Changes:       ToDomainChangeArray(t.Changes),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromDomainChange converts internal DomainChange type to thrift
+func FromDomainChange(t *types.DomainChange) *admin.DomainChange {
+	if t == nil {
+		return nil
+	}
+	return &admin.DomainChange{
+		ID:           &t.ID,
+		DomainID:     &t.DomainID,
+		DomainName:   &t.DomainName,
+		Operation:    &t.Operation,
+		Actor:        &t.Actor,
+		Reason:       &t.Reason,
+		Timestamp:    &t.Timestamp,
+		FieldChanges: FromDomainFieldChangeArray(t.FieldChanges),
+	}
+}
+
+// ToDomainChange converts thrift DomainChange type to internal
+func ToDomainChange(t *admin.DomainChange) *types.DomainChange {
+	if t == nil {
+		return nil
+	}
+	return &types.DomainChange{
+		ID:           t.GetID(),
+		DomainID:     t.GetDomainID(),
+		DomainName:   t.GetDomainName(),
+		Operation:    t.GetOperation(),
+		Actor:        t.GetActor(),
+		Reason:       t.GetReason(),
+		Timestamp:    t.GetTimestamp(),
+		FieldChanges: ToDomainFieldChangeArray(t.FieldChanges),
+	}
+}
+
+// FromDomainChangeArray converts internal DomainChange type array to thrift
+func FromDomainChangeArray(t []*types.DomainChange) []*admin.DomainChange {
+	if t == nil {
+		return nil
+	}
+	v := make([]*admin.DomainChange, len(t))
+	for i := range t {
+		v[i] = FromDomainChange(t[i])
+	}
+	return v
+}
+
+// ToDomainChangeArray converts thrift DomainChange type array to internal
+func ToDomainChangeArray(t []*admin.DomainChange) []*types.DomainChange {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DomainChange, len(t))
+	for i := range t {
+		v[i] = ToDomainChange(t[i])
+	}
+	return v
+}
+
+// FromDomainFieldChange converts internal DomainFieldChange type to thrift
+func FromDomainFieldChange(t *types.DomainFieldChange) *admin.DomainFieldChange {
+	if t == nil {
+		return nil
+	}
+	return &admin.DomainFieldChange{
+		Field:    &t.Field,
+		OldValue: &t.OldValue,
+		NewValue: &t.NewValue,
+	}
+}
+
+// ToDomainFieldChange converts thrift DomainFieldChange type to internal
+func ToDomainFieldChange(t *admin.DomainFieldChange) *types.DomainFieldChange {
+	if t == nil {
+		return nil
+	}
+	return &types.DomainFieldChange{
+		Field:    t.GetField(),
+		OldValue: t.GetOldValue(),
+		NewValue: t.GetNewValue(),
+	}
+}
+
+// FromDomainFieldChangeArray converts internal DomainFieldChange type array to thrift
+func FromDomainFieldChangeArray(t []*types.DomainFieldChange) []*admin.DomainFieldChange {
+	if t == nil {
+		return nil
+	}
+	v := make([]*admin.DomainFieldChange, len(t))
+	for i := range t {
+		v[i] = FromDomainFieldChange(t[i])
+	}
+	return v
+}
+
+// ToDomainFieldChangeArray converts thrift DomainFieldChange type array to internal
+func ToDomainFieldChangeArray(t []*admin.DomainFieldChange) []*types.DomainFieldChange {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DomainFieldChange, len(t))
+	for i := range t {
+		v[i] = ToDomainFieldChange(t[i])
+	}
+	return v
+}
+
+// FromCloneDomainRequest converts internal CloneDomainRequest type to thrift
+func FromCloneDomainRequest(t *types.CloneDomainRequest) *admin.CloneDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.CloneDomainRequest{
+		SourceName: &t.SourceName,
+		Name:       &t.Name,
+	}
+}
+
+// ToCloneDomainRequest converts thrift CloneDomainRequest type to internal
+func ToCloneDomainRequest(t *admin.CloneDomainRequest) *types.CloneDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.CloneDomainRequest{
+		SourceName: t.GetSourceName(),
+		Name:       t.GetName(),
+	}
+}
+
+// FromRenameDomainRequest converts internal RenameDomainRequest type to thrift
+func FromRenameDomainRequest(t *types.RenameDomainRequest) *admin.RenameDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.RenameDomainRequest{
+		Name:    &t.Name,
+		NewName: &t.NewName,
+	}
+}
+
+// ToRenameDomainRequest converts thrift RenameDomainRequest type to internal
+func ToRenameDomainRequest(t *admin.RenameDomainRequest) *types.RenameDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RenameDomainRequest{
+		Name:    t.GetName(),
+		NewName: t.GetNewName(),
+	}
+}
+
+// FromDeleteDomainRequest converts internal DeleteDomainRequest type to thrift
+func FromDeleteDomainRequest(t *types.DeleteDomainRequest) *admin.DeleteDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.DeleteDomainRequest{
+		Name:        &t.Name,
+		Concurrency: &t.Concurrency,
+		PageSize:    &t.PageSize,
+	}
+}
+
+// ToDeleteDomainRequest converts thrift DeleteDomainRequest type to internal
+func ToDeleteDomainRequest(t *admin.DeleteDomainRequest) *types.DeleteDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteDomainRequest{
+		Name:        t.GetName(),
+		Concurrency: t.GetConcurrency(),
+		PageSize:    t.GetPageSize(),
+	}
+}
@@ -444,6 +444,98 @@ func ToHistoryQueryWorkflowResponse(t *history.QueryWorkflowResponse) *types.His
 	}
 }
 
+// FromHistoryUpdateWorkflowExecutionRequest converts internal UpdateWorkflowExecutionRequest type to thrift
+func FromHistoryUpdateWorkflowExecutionRequest(t *types.HistoryUpdateWorkflowExecutionRequest) *history.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.UpdateWorkflowExecutionRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromUpdateWorkflowExecutionRequest(t.Request),
+	}
+}
+
+// ToHistoryUpdateWorkflowExecutionRequest converts thrift UpdateWorkflowExecutionRequest type to internal
+func ToHistoryUpdateWorkflowExecutionRequest(t *history.UpdateWorkflowExecutionRequest) *types.HistoryUpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToUpdateWorkflowExecutionRequest(t.Request),
+	}
+}
+
+// FromHistoryUpdateWorkflowExecutionResponse converts internal UpdateWorkflowExecutionResponse type to thrift
+func FromHistoryUpdateWorkflowExecutionResponse(t *types.HistoryUpdateWorkflowExecutionResponse) *history.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &history.UpdateWorkflowExecutionResponse{
+		Response: FromUpdateWorkflowExecutionResponse(t.Response),
+	}
+}
+
+// ToHistoryUpdateWorkflowExecutionResponse converts thrift UpdateWorkflowExecutionResponse type to internal
+func ToHistoryUpdateWorkflowExecutionResponse(t *history.UpdateWorkflowExecutionResponse) *types.HistoryUpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateWorkflowExecutionResponse{
+		Response: ToUpdateWorkflowExecutionResponse(t.Response),
+	}
+}
+
+// FromHistoryMigrateWorkflowExecutionRequest converts internal MigrateWorkflowExecutionRequest type to thrift
+func FromHistoryMigrateWorkflowExecutionRequest(t *types.HistoryMigrateWorkflowExecutionRequest) *history.MigrateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.MigrateWorkflowExecutionRequest{
+		DomainUUID:    &t.DomainUUID,
+		WorkflowID:    &t.WorkflowID,
+		RunID:         &t.RunID,
+		SourceCluster: &t.SourceCluster,
+		SourceDomain:  &t.SourceDomain,
+	}
+}
+
+// ToHistoryMigrateWorkflowExecutionRequest converts thrift MigrateWorkflowExecutionRequest type to internal
+func ToHistoryMigrateWorkflowExecutionRequest(t *history.MigrateWorkflowExecutionRequest) *types.HistoryMigrateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryMigrateWorkflowExecutionRequest{
+		DomainUUID:    t.GetDomainUUID(),
+		WorkflowID:    t.GetWorkflowID(),
+		RunID:         t.GetRunID(),
+		SourceCluster: t.GetSourceCluster(),
+		SourceDomain:  t.GetSourceDomain(),
+	}
+}
+
+// FromHistoryMigrateWorkflowExecutionResponse converts internal MigrateWorkflowExecutionResponse type to thrift
+func FromHistoryMigrateWorkflowExecutionResponse(t *types.MigrateWorkflowExecutionResponse) *history.MigrateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &history.MigrateWorkflowExecutionResponse{
+		RunID:       &t.RunID,
+		NextEventID: &t.NextEventID,
+	}
+}
+
+// ToHistoryMigrateWorkflowExecutionResponse converts thrift MigrateWorkflowExecutionResponse type to internal
+func ToHistoryMigrateWorkflowExecutionResponse(t *history.MigrateWorkflowExecutionResponse) *types.MigrateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MigrateWorkflowExecutionResponse{
+		RunID:       t.GetRunID(),
+		NextEventID: t.GetNextEventID(),
+	}
+}
+
 // FromHistoryReapplyEventsRequest converts internal ReapplyEventsRequest type to thrift
 func FromHistoryReapplyEventsRequest(t *types.HistoryReapplyEventsRequest) *history.ReapplyEventsRequest {
 	if t == nil {
@@ -628,6 +720,9 @@ func FromRecordDecisionTaskStartedResponse(t *types.RecordDecisionTaskStartedRes
 		ScheduledTimestamp:        t.ScheduledTimestamp,
 		StartedTimestamp:          t.StartedTimestamp,
 		Queries:                   FromWorkflowQueryMap(t.Queries),
+		HistorySize:               &t.HistorySize,
+		HistoryCount:              &t.HistoryCount,
+		SuggestContinueAsNew:      &t.SuggestContinueAsNew,
 	}
 }
 
@@ -651,6 +746,9 @@ func ToRecordDecisionTaskStartedResponse(t *history.RecordDecisionTaskStartedRes
 		ScheduledTimestamp:        t.ScheduledTimestamp,
 		StartedTimestamp:          t.StartedTimestamp,
 		Queries:                   ToWorkflowQueryMap(t.Queries),
+		HistorySize:               t.GetHistorySize(),
+		HistoryCount:              t.GetHistoryCount(),
+		SuggestContinueAsNew:      t.GetSuggestContinueAsNew(),
 	}
 }
 
@@ -756,6 +854,138 @@ func ToHistoryRequestCancelWorkflowExecutionRequest(t *history.RequestCancelWork
 	}
 }
 
+// FromHistoryPauseWorkflowExecutionRequest converts internal PauseWorkflowExecutionRequest type to thrift
+func FromHistoryPauseWorkflowExecutionRequest(t *types.HistoryPauseWorkflowExecutionRequest) *history.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.PauseWorkflowExecutionRequest{
+		DomainUUID:   &t.DomainUUID,
+		PauseRequest: FromPauseWorkflowExecutionRequest(t.PauseRequest),
+	}
+}
+
+// ToHistoryPauseWorkflowExecutionRequest converts thrift PauseWorkflowExecutionRequest type to internal
+func ToHistoryPauseWorkflowExecutionRequest(t *history.PauseWorkflowExecutionRequest) *types.HistoryPauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID:   t.GetDomainUUID(),
+		PauseRequest: ToPauseWorkflowExecutionRequest(t.PauseRequest),
+	}
+}
+
+// FromHistoryUnpauseWorkflowExecutionRequest converts internal UnpauseWorkflowExecutionRequest type to thrift
+func FromHistoryUnpauseWorkflowExecutionRequest(t *types.HistoryUnpauseWorkflowExecutionRequest) *history.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.UnpauseWorkflowExecutionRequest{
+		DomainUUID:     &t.DomainUUID,
+		UnpauseRequest: FromUnpauseWorkflowExecutionRequest(t.UnpauseRequest),
+	}
+}
+
+// ToHistoryUnpauseWorkflowExecutionRequest converts thrift UnpauseWorkflowExecutionRequest type to internal
+func ToHistoryUnpauseWorkflowExecutionRequest(t *history.UnpauseWorkflowExecutionRequest) *types.HistoryUnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     t.GetDomainUUID(),
+		UnpauseRequest: ToUnpauseWorkflowExecutionRequest(t.UnpauseRequest),
+	}
+}
+
+// FromHistoryDeleteWorkflowExecutionRequest converts internal DeleteWorkflowExecutionRequest type to thrift
+func FromHistoryDeleteWorkflowExecutionRequest(t *types.HistoryDeleteWorkflowExecutionRequest) *history.DeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.DeleteWorkflowExecutionRequest{
+		DomainUUID:    &t.DomainUUID,
+		DeleteRequest: FromDeleteWorkflowExecutionRequest(t.DeleteRequest),
+	}
+}
+
+// ToHistoryDeleteWorkflowExecutionRequest converts thrift DeleteWorkflowExecutionRequest type to internal
+func ToHistoryDeleteWorkflowExecutionRequest(t *history.DeleteWorkflowExecutionRequest) *types.HistoryDeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryDeleteWorkflowExecutionRequest{
+		DomainUUID:    t.GetDomainUUID(),
+		DeleteRequest: ToDeleteWorkflowExecutionRequest(t.DeleteRequest),
+	}
+}
+
+// FromHistoryPauseActivityRequest converts internal PauseActivityRequest type to thrift
+func FromHistoryPauseActivityRequest(t *types.HistoryPauseActivityRequest) *history.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.PauseActivityRequest{
+		DomainUUID:   &t.DomainUUID,
+		PauseRequest: FromPauseActivityRequest(t.PauseRequest),
+	}
+}
+
+// ToHistoryPauseActivityRequest converts thrift PauseActivityRequest type to internal
+func ToHistoryPauseActivityRequest(t *history.PauseActivityRequest) *types.HistoryPauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseActivityRequest{
+		DomainUUID:   t.GetDomainUUID(),
+		PauseRequest: ToPauseActivityRequest(t.PauseRequest),
+	}
+}
+
+// FromHistoryUnpauseActivityRequest converts internal UnpauseActivityRequest type to thrift
+func FromHistoryUnpauseActivityRequest(t *types.HistoryUnpauseActivityRequest) *history.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.UnpauseActivityRequest{
+		DomainUUID:     &t.DomainUUID,
+		UnpauseRequest: FromUnpauseActivityRequest(t.UnpauseRequest),
+	}
+}
+
+// ToHistoryUnpauseActivityRequest converts thrift UnpauseActivityRequest type to internal
+func ToHistoryUnpauseActivityRequest(t *history.UnpauseActivityRequest) *types.HistoryUnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseActivityRequest{
+		DomainUUID:     t.GetDomainUUID(),
+		UnpauseRequest: ToUnpauseActivityRequest(t.UnpauseRequest),
+	}
+}
+
+// FromHistoryResetActivityRequest converts internal ResetActivityRequest type to thrift
+func FromHistoryResetActivityRequest(t *types.HistoryResetActivityRequest) *history.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.ResetActivityRequest{
+		DomainUUID:   &t.DomainUUID,
+		ResetRequest: FromResetActivityRequest(t.ResetRequest),
+	}
+}
+
+// ToHistoryResetActivityRequest converts thrift ResetActivityRequest type to internal
+func ToHistoryResetActivityRequest(t *history.ResetActivityRequest) *types.HistoryResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryResetActivityRequest{
+		DomainUUID:   t.GetDomainUUID(),
+		ResetRequest: ToResetActivityRequest(t.ResetRequest),
+	}
+}
+
 // FromHistoryResetStickyTaskListRequest converts internal ResetStickyTaskListRequest type to thrift
 func FromHistoryResetStickyTaskListRequest(t *types.HistoryResetStickyTaskListRequest) *history.ResetStickyTaskListRequest {
 	if t == nil {
@@ -1058,6 +1288,7 @@ func FromHistoryStartWorkflowExecutionRequest(t *types.HistoryStartWorkflowExecu
 		ContinuedFailureDetails:         t.ContinuedFailureDetails,
 		LastCompletionResult:            t.LastCompletionResult,
 		FirstDecisionTaskBackoffSeconds: t.FirstDecisionTaskBackoffSeconds,
+		RestartedFromRunId:              &t.RestartedFromRunID,
 	}
 }
 
@@ -1077,6 +1308,7 @@ func ToHistoryStartWorkflowExecutionRequest(t *history.StartWorkflowExecutionReq
 		ContinuedFailureDetails:         t.ContinuedFailureDetails,
 		LastCompletionResult:            t.LastCompletionResult,
 		FirstDecisionTaskBackoffSeconds: t.FirstDecisionTaskBackoffSeconds,
+		RestartedFromRunID:              t.GetRestartedFromRunId(),
 	}
 }
 
@@ -1101,6 +1333,7 @@ func FromSyncActivityRequest(t *types.SyncActivityRequest) *history.SyncActivity
 		LastWorkerIdentity: &t.LastWorkerIdentity,
 		LastFailureDetails: t.LastFailureDetails,
 		VersionHistory:     FromVersionHistory(t.VersionHistory),
+		Paused:             &t.Paused,
 	}
 }
 
@@ -1125,6 +1358,7 @@ func ToSyncActivityRequest(t *history.SyncActivityRequest) *types.SyncActivityRe
 		LastWorkerIdentity: t.GetLastWorkerIdentity(),
 		LastFailureDetails: t.LastFailureDetails,
 		VersionHistory:     ToVersionHistory(t.VersionHistory),
+		Paused:             t.GetPaused(),
 	}
 }
 
@@ -237,6 +237,9 @@ func FromMatchingPollForDecisionTaskResponse(t *types.MatchingPollForDecisionTas
 		ScheduledTimestamp:        t.ScheduledTimestamp,
 		StartedTimestamp:          t.StartedTimestamp,
 		Queries:                   FromWorkflowQueryMap(t.Queries),
+		HistorySize:               &t.HistorySize,
+		HistoryCount:              &t.HistoryCount,
+		SuggestContinueAsNew:      &t.SuggestContinueAsNew,
 	}
 }
 
@@ -263,6 +266,9 @@ func ToMatchingPollForDecisionTaskResponse(t *matching.PollForDecisionTaskRespon
 		ScheduledTimestamp:        t.ScheduledTimestamp,
 		StartedTimestamp:          t.StartedTimestamp,
 		Queries:                   ToWorkflowQueryMap(t.Queries),
+		HistorySize:               t.GetHistorySize(),
+		HistoryCount:              t.GetHistoryCount(),
+		SuggestContinueAsNew:      t.GetSuggestContinueAsNew(),
 	}
 }
 
@@ -349,3 +355,83 @@ func ToTaskSource(t *matching.TaskSource) *types.TaskSource {
 	}
 	panic("unexpected enum value")
 }
+
+// FromMatchingPauseTaskListRequest converts internal PauseTaskListRequest type to thrift
+func FromMatchingPauseTaskListRequest(t *types.MatchingPauseTaskListRequest) *matching.PauseTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.PauseTaskListRequest{
+		DomainUUID:   &t.DomainUUID,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+// ToMatchingPauseTaskListRequest converts thrift PauseTaskListRequest type to internal
+func ToMatchingPauseTaskListRequest(t *matching.PauseTaskListRequest) *types.MatchingPauseTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingPauseTaskListRequest{
+		DomainUUID:   t.GetDomainUUID(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+// FromMatchingResumeTaskListRequest converts internal ResumeTaskListRequest type to thrift
+func FromMatchingResumeTaskListRequest(t *types.MatchingResumeTaskListRequest) *matching.ResumeTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.ResumeTaskListRequest{
+		DomainUUID:   &t.DomainUUID,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+// ToMatchingResumeTaskListRequest converts thrift ResumeTaskListRequest type to internal
+func ToMatchingResumeTaskListRequest(t *matching.ResumeTaskListRequest) *types.MatchingResumeTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingResumeTaskListRequest{
+		DomainUUID:   t.GetDomainUUID(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+// FromMatchingMoveTaskListBacklogRequest converts internal MoveTaskListBacklogRequest type to thrift
+func FromMatchingMoveTaskListBacklogRequest(t *types.MatchingMoveTaskListBacklogRequest) *matching.MoveTaskListBacklogRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.MoveTaskListBacklogRequest{
+		DomainUUID:     &t.DomainUUID,
+		SourceTaskList: FromTaskList(t.SourceTaskList),
+		TargetTaskList: FromTaskList(t.TargetTaskList),
+		TaskListType:   FromTaskListType(t.TaskListType),
+		BatchSize:      &t.BatchSize,
+		AfterTaskID:    &t.AfterTaskID,
+		DryRun:         &t.DryRun,
+	}
+}
+
+// ToMatchingMoveTaskListBacklogRequest converts thrift MoveTaskListBacklogRequest type to internal
+func ToMatchingMoveTaskListBacklogRequest(t *matching.MoveTaskListBacklogRequest) *types.MatchingMoveTaskListBacklogRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingMoveTaskListBacklogRequest{
+		DomainUUID:     t.GetDomainUUID(),
+		SourceTaskList: ToTaskList(t.SourceTaskList),
+		TargetTaskList: ToTaskList(t.TargetTaskList),
+		TaskListType:   ToTaskListType(t.TaskListType),
+		BatchSize:      t.GetBatchSize(),
+		AfterTaskID:    t.GetAfterTaskID(),
+		DryRun:         t.GetDryRun(),
+	}
+}
@@ -1028,6 +1028,8 @@ func FromDecision(t *types.Decision) *shared.Decision {
 		StartChildWorkflowExecutionDecisionAttributes:            FromStartChildWorkflowExecutionDecisionAttributes(t.StartChildWorkflowExecutionDecisionAttributes),
 		SignalExternalWorkflowExecutionDecisionAttributes:        FromSignalExternalWorkflowExecutionDecisionAttributes(t.SignalExternalWorkflowExecutionDecisionAttributes),
 		UpsertWorkflowSearchAttributesDecisionAttributes:         FromUpsertWorkflowSearchAttributesDecisionAttributes(t.UpsertWorkflowSearchAttributesDecisionAttributes),
+		CompleteWorkflowUpdateDecisionAttributes:                 FromCompleteWorkflowUpdateDecisionAttributes(t.CompleteWorkflowUpdateDecisionAttributes),
+		UpsertWorkflowMemoDecisionAttributes:                     FromUpsertWorkflowMemoDecisionAttributes(t.UpsertWorkflowMemoDecisionAttributes),
 	}
 }
 
@@ -1051,6 +1053,8 @@ func ToDecision(t *shared.Decision) *types.Decision {
 		StartChildWorkflowExecutionDecisionAttributes:            ToStartChildWorkflowExecutionDecisionAttributes(t.StartChildWorkflowExecutionDecisionAttributes),
 		SignalExternalWorkflowExecutionDecisionAttributes:        ToSignalExternalWorkflowExecutionDecisionAttributes(t.SignalExternalWorkflowExecutionDecisionAttributes),
 		UpsertWorkflowSearchAttributesDecisionAttributes:         ToUpsertWorkflowSearchAttributesDecisionAttributes(t.UpsertWorkflowSearchAttributesDecisionAttributes),
+		CompleteWorkflowUpdateDecisionAttributes:                 ToCompleteWorkflowUpdateDecisionAttributes(t.CompleteWorkflowUpdateDecisionAttributes),
+		UpsertWorkflowMemoDecisionAttributes:                     ToUpsertWorkflowMemoDecisionAttributes(t.UpsertWorkflowMemoDecisionAttributes),
 	}
 }
 
@@ -1157,6 +1161,12 @@ func FromDecisionTaskFailedCause(t *types.DecisionTaskFailedCause) *shared.Decis
 	case types.DecisionTaskFailedCauseBadSearchAttributes:
 		v := shared.DecisionTaskFailedCauseBadSearchAttributes
 		return &v
+	case types.DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes:
+		v := shared.DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes
+		return &v
+	case types.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes:
+		v := shared.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1236,6 +1246,12 @@ func ToDecisionTaskFailedCause(t *shared.DecisionTaskFailedCause) *types.Decisio
 	case shared.DecisionTaskFailedCauseBadSearchAttributes:
 		v := types.DecisionTaskFailedCauseBadSearchAttributes
 		return &v
+	case shared.DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes:
+		v := types.DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes
+		return &v
+	case shared.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes:
+		v := types.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1427,6 +1443,12 @@ func FromDecisionType(t *types.DecisionType) *shared.DecisionType {
 	case types.DecisionTypeUpsertWorkflowSearchAttributes:
 		v := shared.DecisionTypeUpsertWorkflowSearchAttributes
 		return &v
+	case types.DecisionTypeCompleteWorkflowUpdate:
+		v := shared.DecisionTypeCompleteWorkflowUpdate
+		return &v
+	case types.DecisionTypeUpsertWorkflowMemo:
+		v := shared.DecisionTypeUpsertWorkflowMemo
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1476,6 +1498,12 @@ func ToDecisionType(t *shared.DecisionType) *types.DecisionType {
 	case shared.DecisionTypeUpsertWorkflowSearchAttributes:
 		v := types.DecisionTypeUpsertWorkflowSearchAttributes
 		return &v
+	case shared.DecisionTypeCompleteWorkflowUpdate:
+		v := types.DecisionTypeCompleteWorkflowUpdate
+		return &v
+	case shared.DecisionTypeUpsertWorkflowMemo:
+		v := types.DecisionTypeUpsertWorkflowMemo
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1773,6 +1801,7 @@ func FromDescribeWorkflowExecutionResponse(t *types.DescribeWorkflowExecutionRes
 		PendingActivities:      FromPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        FromPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        FromPendingDecisionInfo(t.PendingDecision),
+		SignalRequestIDs:       t.SignalRequestIDs,
 	}
 }
 
@@ -1787,6 +1816,7 @@ func ToDescribeWorkflowExecutionResponse(t *shared.DescribeWorkflowExecutionResp
 		PendingActivities:      ToPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        ToPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        ToPendingDecisionInfo(t.PendingDecision),
+		SignalRequestIDs:       t.SignalRequestIDs,
 	}
 }
 
@@ -1845,6 +1875,7 @@ func FromDomainConfiguration(t *types.DomainConfiguration) *shared.DomainConfigu
 		HistoryArchivalURI:                     &t.HistoryArchivalURI,
 		VisibilityArchivalStatus:               FromArchivalStatus(t.VisibilityArchivalStatus),
 		VisibilityArchivalURI:                  &t.VisibilityArchivalURI,
+		Limits:                                 FromDomainLimits(t.Limits),
 	}
 }
 
@@ -1861,6 +1892,33 @@ func ToDomainConfiguration(t *shared.DomainConfiguration) *types.DomainConfigura
 		HistoryArchivalURI:                     t.GetHistoryArchivalURI(),
 		VisibilityArchivalStatus:               ToArchivalStatus(t.VisibilityArchivalStatus),
 		VisibilityArchivalURI:                  t.GetVisibilityArchivalURI(),
+		Limits:                                 ToDomainLimits(t.Limits),
+	}
+}
+
+// FromDomainLimits converts internal DomainLimits type to thrift
+func FromDomainLimits(t *types.DomainLimits) *shared.DomainLimits {
+	if t == nil {
+		return nil
+	}
+	return &shared.DomainLimits{
+		RequestsPerSecond:      &t.RequestsPerSecond,
+		MaxConcurrentWorkflows: &t.MaxConcurrentWorkflows,
+		MaxPendingActivities:   &t.MaxPendingActivities,
+		MaxHistorySize:         &t.MaxHistorySize,
+	}
+}
+
+// ToDomainLimits converts thrift DomainLimits type to internal
+func ToDomainLimits(t *shared.DomainLimits) *types.DomainLimits {
+	if t == nil {
+		return nil
+	}
+	return &types.DomainLimits{
+		RequestsPerSecond:      t.GetRequestsPerSecond(),
+		MaxConcurrentWorkflows: t.GetMaxConcurrentWorkflows(),
+		MaxPendingActivities:   t.GetMaxPendingActivities(),
+		MaxHistorySize:         t.GetMaxHistorySize(),
 	}
 }
 
@@ -2188,6 +2246,21 @@ func FromEventType(t *types.EventType) *shared.EventType {
 	case types.EventTypeUpsertWorkflowSearchAttributes:
 		v := shared.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case types.EventTypeWorkflowExecutionPaused:
+		v := shared.EventTypeWorkflowExecutionPaused
+		return &v
+	case types.EventTypeWorkflowExecutionUnpaused:
+		v := shared.EventTypeWorkflowExecutionUnpaused
+		return &v
+	case types.EventTypeWorkflowExecutionUpdateAccepted:
+		v := shared.EventTypeWorkflowExecutionUpdateAccepted
+		return &v
+	case types.EventTypeWorkflowExecutionUpdateCompleted:
+		v := shared.EventTypeWorkflowExecutionUpdateCompleted
+		return &v
+	case types.EventTypeUpsertWorkflowMemo:
+		v := shared.EventTypeUpsertWorkflowMemo
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2324,6 +2397,21 @@ func ToEventType(t *shared.EventType) *types.EventType {
 	case shared.EventTypeUpsertWorkflowSearchAttributes:
 		v := types.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case shared.EventTypeWorkflowExecutionPaused:
+		v := types.EventTypeWorkflowExecutionPaused
+		return &v
+	case shared.EventTypeWorkflowExecutionUnpaused:
+		v := types.EventTypeWorkflowExecutionUnpaused
+		return &v
+	case shared.EventTypeWorkflowExecutionUpdateAccepted:
+		v := types.EventTypeWorkflowExecutionUpdateAccepted
+		return &v
+	case shared.EventTypeWorkflowExecutionUpdateCompleted:
+		v := types.EventTypeWorkflowExecutionUpdateCompleted
+		return &v
+	case shared.EventTypeUpsertWorkflowMemo:
+		v := types.EventTypeUpsertWorkflowMemo
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2619,6 +2707,11 @@ func FromHistoryEvent(t *types.HistoryEvent) *shared.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           FromSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               FromExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  FromUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		WorkflowExecutionPausedEventAttributes:                         FromWorkflowExecutionPausedEventAttributes(t.WorkflowExecutionPausedEventAttributes),
+		WorkflowExecutionUnpausedEventAttributes:                       FromWorkflowExecutionUnpausedEventAttributes(t.WorkflowExecutionUnpausedEventAttributes),
+		WorkflowExecutionUpdateAcceptedEventAttributes:                 FromWorkflowExecutionUpdateAcceptedEventAttributes(t.WorkflowExecutionUpdateAcceptedEventAttributes),
+		WorkflowExecutionUpdateCompletedEventAttributes:                FromWorkflowExecutionUpdateCompletedEventAttributes(t.WorkflowExecutionUpdateCompletedEventAttributes),
+		UpsertWorkflowMemoEventAttributes:                              FromUpsertWorkflowMemoEventAttributes(t.UpsertWorkflowMemoEventAttributes),
 	}
 }
 
@@ -2675,6 +2768,11 @@ func ToHistoryEvent(t *shared.HistoryEvent) *types.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           ToSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               ToExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  ToUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		WorkflowExecutionPausedEventAttributes:                         ToWorkflowExecutionPausedEventAttributes(t.WorkflowExecutionPausedEventAttributes),
+		WorkflowExecutionUnpausedEventAttributes:                       ToWorkflowExecutionUnpausedEventAttributes(t.WorkflowExecutionUnpausedEventAttributes),
+		WorkflowExecutionUpdateAcceptedEventAttributes:                 ToWorkflowExecutionUpdateAcceptedEventAttributes(t.WorkflowExecutionUpdateAcceptedEventAttributes),
+		WorkflowExecutionUpdateCompletedEventAttributes:                ToWorkflowExecutionUpdateCompletedEventAttributes(t.WorkflowExecutionUpdateCompletedEventAttributes),
+		UpsertWorkflowMemoEventAttributes:                              ToUpsertWorkflowMemoEventAttributes(t.UpsertWorkflowMemoEventAttributes),
 	}
 }
 
@@ -2726,6 +2824,8 @@ func FromIndexedValueType(t types.IndexedValueType) shared.IndexedValueType {
 		return shared.IndexedValueTypeBool
 	case types.IndexedValueTypeDatetime:
 		return shared.IndexedValueTypeDatetime
+	case types.IndexedValueTypeKeywordList:
+		return shared.IndexedValueTypeKeywordList
 	}
 	panic("unexpected enum value")
 }
@@ -2746,6 +2846,8 @@ func ToIndexedValueType(t shared.IndexedValueType) types.IndexedValueType {
 		return types.IndexedValueTypeBool
 	case shared.IndexedValueTypeDatetime:
 		return types.IndexedValueTypeDatetime
+	case shared.IndexedValueTypeKeywordList:
+		return types.IndexedValueTypeKeywordList
 	}
 	panic("unexpected enum value")
 }
@@ -3186,6 +3288,30 @@ func ToMemo(t *shared.Memo) *types.Memo {
 	}
 }
 
+// FromMoveTaskListBacklogResponse converts internal MoveTaskListBacklogResponse type to thrift
+func FromMoveTaskListBacklogResponse(t *types.MoveTaskListBacklogResponse) *shared.MoveTaskListBacklogResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.MoveTaskListBacklogResponse{
+		Count:      &t.Count,
+		LastTaskID: &t.LastTaskID,
+		HasMore:    &t.HasMore,
+	}
+}
+
+// ToMoveTaskListBacklogResponse converts thrift MoveTaskListBacklogResponse type to internal
+func ToMoveTaskListBacklogResponse(t *shared.MoveTaskListBacklogResponse) *types.MoveTaskListBacklogResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MoveTaskListBacklogResponse{
+		Count:      t.GetCount(),
+		LastTaskID: t.GetLastTaskID(),
+		HasMore:    t.GetHasMore(),
+	}
+}
+
 // FromParentClosePolicy converts internal ParentClosePolicy type to thrift
 func FromParentClosePolicy(t *types.ParentClosePolicy) *shared.ParentClosePolicy {
 	if t == nil {
@@ -3517,6 +3643,9 @@ func FromPollForDecisionTaskResponse(t *types.PollForDecisionTaskResponse) *shar
 		StartedTimestamp:          t.StartedTimestamp,
 		Queries:                   FromWorkflowQueryMap(t.Queries),
 		NextEventId:               &t.NextEventID,
+		HistorySize:               &t.HistorySize,
+		HistoryCount:              &t.HistoryCount,
+		SuggestContinueAsNew:      &t.SuggestContinueAsNew,
 	}
 }
 
@@ -3541,6 +3670,9 @@ func ToPollForDecisionTaskResponse(t *shared.PollForDecisionTaskResponse) *types
 		StartedTimestamp:          t.StartedTimestamp,
 		Queries:                   ToWorkflowQueryMap(t.Queries),
 		NextEventID:               t.GetNextEventId(),
+		HistorySize:               t.GetHistorySize(),
+		HistoryCount:              t.GetHistoryCount(),
+		SuggestContinueAsNew:      t.GetSuggestContinueAsNew(),
 	}
 }
 
@@ -4342,6 +4474,50 @@ func ToResetWorkflowExecutionResponse(t *shared.ResetWorkflowExecutionResponse)
 	}
 }
 
+// FromRestartWorkflowExecutionRequest converts internal RestartWorkflowExecutionRequest type to thrift
+func FromRestartWorkflowExecutionRequest(t *types.RestartWorkflowExecutionRequest) *shared.RestartWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RestartWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Identity:          &t.Identity,
+	}
+}
+
+// ToRestartWorkflowExecutionRequest converts thrift RestartWorkflowExecutionRequest type to internal
+func ToRestartWorkflowExecutionRequest(t *shared.RestartWorkflowExecutionRequest) *types.RestartWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RestartWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// FromRestartWorkflowExecutionResponse converts internal RestartWorkflowExecutionResponse type to thrift
+func FromRestartWorkflowExecutionResponse(t *types.RestartWorkflowExecutionResponse) *shared.RestartWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.RestartWorkflowExecutionResponse{
+		RunId: &t.RunID,
+	}
+}
+
+// ToRestartWorkflowExecutionResponse converts thrift RestartWorkflowExecutionResponse type to internal
+func ToRestartWorkflowExecutionResponse(t *shared.RestartWorkflowExecutionResponse) *types.RestartWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.RestartWorkflowExecutionResponse{
+		RunID: t.GetRunId(),
+	}
+}
+
 // FromRespondActivityTaskCanceledByIDRequest converts internal RespondActivityTaskCanceledByIDRequest type to thrift
 func FromRespondActivityTaskCanceledByIDRequest(t *types.RespondActivityTaskCanceledByIDRequest) *shared.RespondActivityTaskCanceledByIDRequest {
 	if t == nil {
@@ -4910,6 +5086,7 @@ func FromSignalWithStartWorkflowExecutionRequest(t *types.SignalWithStartWorkflo
 		Memo:                                FromMemo(t.Memo),
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
 		Header:                              FromHeader(t.Header),
+		WorkflowIdConflictPolicy:            FromWorkflowIDConflictPolicy(t.WorkflowIDConflictPolicy),
 	}
 }
 
@@ -4937,6 +5114,7 @@ func ToSignalWithStartWorkflowExecutionRequest(t *shared.SignalWithStartWorkflow
 		Memo:                                ToMemo(t.Memo),
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		Header:                              ToHeader(t.Header),
+		WorkflowIDConflictPolicy:            ToWorkflowIDConflictPolicy(t.WorkflowIdConflictPolicy),
 	}
 }
 
@@ -5168,6 +5346,7 @@ func FromStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
 		Header:                              FromHeader(t.Header),
 		DelayStartSeconds:                   t.DelayStartSeconds,
+		WorkflowIdConflictPolicy:            FromWorkflowIDConflictPolicy(t.WorkflowIDConflictPolicy),
 	}
 }
 
@@ -5193,6 +5372,7 @@ func ToStartWorkflowExecutionRequest(t *shared.StartWorkflowExecutionRequest) *t
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		Header:                              ToHeader(t.Header),
 		DelayStartSeconds:                   t.DelayStartSeconds,
+		WorkflowIDConflictPolicy:            ToWorkflowIDConflictPolicy(t.WorkflowIdConflictPolicy),
 	}
 }
 
@@ -5389,6 +5569,7 @@ func FromTaskListStatus(t *types.TaskListStatus) *shared.TaskListStatus {
 		AckLevel:         &t.AckLevel,
 		RatePerSecond:    &t.RatePerSecond,
 		TaskIDBlock:      FromTaskIDBlock(t.TaskIDBlock),
+		Paused:           &t.Paused,
 	}
 }
 
@@ -5403,6 +5584,7 @@ func ToTaskListStatus(t *shared.TaskListStatus) *types.TaskListStatus {
 		AckLevel:         t.GetAckLevel(),
 		RatePerSecond:    t.GetRatePerSecond(),
 		TaskIDBlock:      ToTaskIDBlock(t.TaskIDBlock),
+		Paused:           t.GetPaused(),
 	}
 }
 
@@ -5628,7 +5810,8 @@ func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *shared.UpdateDomainR
 		t.HistoryArchivalStatus != nil ||
 		t.HistoryArchivalURI != nil ||
 		t.VisibilityArchivalStatus != nil ||
-		t.VisibilityArchivalURI != nil {
+		t.VisibilityArchivalURI != nil ||
+		t.Limits != nil {
 		request.Configuration = &shared.DomainConfiguration{
 			WorkflowExecutionRetentionPeriodInDays: t.WorkflowExecutionRetentionPeriodInDays,
 			EmitMetric:                             t.EmitMetric,
@@ -5637,6 +5820,7 @@ func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *shared.UpdateDomainR
 			HistoryArchivalURI:                     t.HistoryArchivalURI,
 			VisibilityArchivalStatus:               FromArchivalStatus(t.VisibilityArchivalStatus),
 			VisibilityArchivalURI:                  t.VisibilityArchivalURI,
+			Limits:                                 FromDomainLimits(t.Limits),
 		}
 	}
 	if t.ActiveClusterName != nil || t.Clusters != nil {
@@ -5672,6 +5856,7 @@ func ToUpdateDomainRequest(t *shared.UpdateDomainRequest) *types.UpdateDomainReq
 		request.HistoryArchivalURI = t.Configuration.HistoryArchivalURI
 		request.VisibilityArchivalStatus = ToArchivalStatus(t.Configuration.VisibilityArchivalStatus)
 		request.VisibilityArchivalURI = t.Configuration.VisibilityArchivalURI
+		request.Limits = ToDomainLimits(t.Configuration.Limits)
 	}
 	if t.ReplicationConfiguration != nil {
 		request.ActiveClusterName = t.ReplicationConfiguration.ActiveClusterName
@@ -5728,6 +5913,50 @@ func ToUpsertWorkflowSearchAttributesDecisionAttributes(t *shared.UpsertWorkflow
 	}
 }
 
+// FromCompleteWorkflowUpdateDecisionAttributes converts internal CompleteWorkflowUpdateDecisionAttributes type to thrift
+func FromCompleteWorkflowUpdateDecisionAttributes(t *types.CompleteWorkflowUpdateDecisionAttributes) *shared.CompleteWorkflowUpdateDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.CompleteWorkflowUpdateDecisionAttributes{
+		UpdateId:     &t.UpdateID,
+		Result:       t.Result,
+		ErrorMessage: &t.ErrorMessage,
+	}
+}
+
+// ToCompleteWorkflowUpdateDecisionAttributes converts thrift CompleteWorkflowUpdateDecisionAttributes type to internal
+func ToCompleteWorkflowUpdateDecisionAttributes(t *shared.CompleteWorkflowUpdateDecisionAttributes) *types.CompleteWorkflowUpdateDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.CompleteWorkflowUpdateDecisionAttributes{
+		UpdateID:     t.GetUpdateId(),
+		Result:       t.Result,
+		ErrorMessage: t.GetErrorMessage(),
+	}
+}
+
+// FromUpsertWorkflowMemoDecisionAttributes converts internal UpsertWorkflowMemoDecisionAttributes type to thrift
+func FromUpsertWorkflowMemoDecisionAttributes(t *types.UpsertWorkflowMemoDecisionAttributes) *shared.UpsertWorkflowMemoDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpsertWorkflowMemoDecisionAttributes{
+		Memo: FromMemo(t.Memo),
+	}
+}
+
+// ToUpsertWorkflowMemoDecisionAttributes converts thrift UpsertWorkflowMemoDecisionAttributes type to internal
+func ToUpsertWorkflowMemoDecisionAttributes(t *shared.UpsertWorkflowMemoDecisionAttributes) *types.UpsertWorkflowMemoDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.UpsertWorkflowMemoDecisionAttributes{
+		Memo: ToMemo(t.Memo),
+	}
+}
+
 // FromUpsertWorkflowSearchAttributesEventAttributes converts internal UpsertWorkflowSearchAttributesEventAttributes type to thrift
 func FromUpsertWorkflowSearchAttributesEventAttributes(t *types.UpsertWorkflowSearchAttributesEventAttributes) *shared.UpsertWorkflowSearchAttributesEventAttributes {
 	if t == nil {
@@ -5750,6 +5979,28 @@ func ToUpsertWorkflowSearchAttributesEventAttributes(t *shared.UpsertWorkflowSea
 	}
 }
 
+// FromUpsertWorkflowMemoEventAttributes converts internal UpsertWorkflowMemoEventAttributes type to thrift
+func FromUpsertWorkflowMemoEventAttributes(t *types.UpsertWorkflowMemoEventAttributes) *shared.UpsertWorkflowMemoEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpsertWorkflowMemoEventAttributes{
+		DecisionTaskCompletedEventId: &t.DecisionTaskCompletedEventID,
+		Memo:                         FromMemo(t.Memo),
+	}
+}
+
+// ToUpsertWorkflowMemoEventAttributes converts thrift UpsertWorkflowMemoEventAttributes type to internal
+func ToUpsertWorkflowMemoEventAttributes(t *shared.UpsertWorkflowMemoEventAttributes) *types.UpsertWorkflowMemoEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.UpsertWorkflowMemoEventAttributes{
+		DecisionTaskCompletedEventID: t.GetDecisionTaskCompletedEventId(),
+		Memo:                         ToMemo(t.Memo),
+	}
+}
+
 // FromVersionHistories converts internal VersionHistories type to thrift
 func FromVersionHistories(t *types.VersionHistories) *shared.VersionHistories {
 	if t == nil {
@@ -6229,6 +6480,7 @@ func FromWorkflowExecutionStartedEventAttributes(t *types.WorkflowExecutionStart
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:                 FromResetPoints(t.PrevAutoResetPoints),
 		Header:                              FromHeader(t.Header),
+		RestartedFromRunId:                  &t.RestartedFromRunID,
 	}
 }
 
@@ -6263,6 +6515,7 @@ func ToWorkflowExecutionStartedEventAttributes(t *shared.WorkflowExecutionStarte
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:                 ToResetPoints(t.PrevAutoResetPoints),
 		Header:                              ToHeader(t.Header),
+		RestartedFromRunID:                  t.GetRestartedFromRunId(),
 	}
 }
 
@@ -6310,6 +6563,44 @@ func ToWorkflowExecutionTimedOutEventAttributes(t *shared.WorkflowExecutionTimed
 	}
 }
 
+// FromWorkflowIDConflictPolicy converts internal WorkflowIDConflictPolicy type to thrift
+func FromWorkflowIDConflictPolicy(t *types.WorkflowIDConflictPolicy) *shared.WorkflowIdConflictPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.WorkflowIDConflictPolicyFail:
+		v := shared.WorkflowIdConflictPolicyFail
+		return &v
+	case types.WorkflowIDConflictPolicyUseExisting:
+		v := shared.WorkflowIdConflictPolicyUseExisting
+		return &v
+	case types.WorkflowIDConflictPolicyTerminateExisting:
+		v := shared.WorkflowIdConflictPolicyTerminateExisting
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToWorkflowIDConflictPolicy converts thrift WorkflowIdConflictPolicy type to internal
+func ToWorkflowIDConflictPolicy(t *shared.WorkflowIdConflictPolicy) *types.WorkflowIDConflictPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.WorkflowIdConflictPolicyFail:
+		v := types.WorkflowIDConflictPolicyFail
+		return &v
+	case shared.WorkflowIdConflictPolicyUseExisting:
+		v := types.WorkflowIDConflictPolicyUseExisting
+		return &v
+	case shared.WorkflowIdConflictPolicyTerminateExisting:
+		v := types.WorkflowIDConflictPolicyTerminateExisting
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
 // FromWorkflowIDReusePolicy converts internal WorkflowIDReusePolicy type to thrift
 func FromWorkflowIDReusePolicy(t *types.WorkflowIDReusePolicy) *shared.WorkflowIdReusePolicy {
 	if t == nil {
@@ -7477,3 +7768,1133 @@ func ToRespondCrossClusterTasksCompletedResponse(t *shared.RespondCrossClusterTa
 		Tasks: ToCrossClusterTaskRequestArray(t.Tasks),
 	}
 }
+
+// FromHistoryTaskDLQEntry converts internal HistoryTaskDLQEntry type to thrift
+func FromHistoryTaskDLQEntry(t *types.HistoryTaskDLQEntry) *shared.HistoryTaskDLQEntry {
+	if t == nil {
+		return nil
+	}
+	return &shared.HistoryTaskDLQEntry{
+		TaskID:              &t.TaskID,
+		Category:            t.Category,
+		TaskType:            &t.TaskType,
+		DomainID:            &t.DomainID,
+		WorkflowID:          &t.WorkflowID,
+		RunID:               &t.RunID,
+		VisibilityTimestamp: &t.VisibilityTimestamp,
+		Attempt:             &t.Attempt,
+		LastError:           &t.LastError,
+		CreatedTimestamp:    &t.CreatedTimestamp,
+	}
+}
+
+// ToHistoryTaskDLQEntry converts thrift HistoryTaskDLQEntry type to internal
+func ToHistoryTaskDLQEntry(t *shared.HistoryTaskDLQEntry) *types.HistoryTaskDLQEntry {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryTaskDLQEntry{
+		TaskID:              t.GetTaskID(),
+		Category:            t.Category,
+		TaskType:            t.GetTaskType(),
+		DomainID:            t.GetDomainID(),
+		WorkflowID:          t.GetWorkflowID(),
+		RunID:               t.GetRunID(),
+		VisibilityTimestamp: t.GetVisibilityTimestamp(),
+		Attempt:             t.GetAttempt(),
+		LastError:           t.GetLastError(),
+		CreatedTimestamp:    t.GetCreatedTimestamp(),
+	}
+}
+
+// FromPurgeHistoryTaskDLQRequest converts internal PurgeHistoryTaskDLQRequest type to thrift
+func FromPurgeHistoryTaskDLQRequest(t *types.PurgeHistoryTaskDLQRequest) *shared.PurgeHistoryTaskDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.PurgeHistoryTaskDLQRequest{
+		ShardID:              &t.ShardID,
+		ExclusiveBeginTaskID: t.ExclusiveBeginTaskID,
+		InclusiveEndTaskID:   t.InclusiveEndTaskID,
+	}
+}
+
+// ToPurgeHistoryTaskDLQRequest converts thrift PurgeHistoryTaskDLQRequest type to internal
+func ToPurgeHistoryTaskDLQRequest(t *shared.PurgeHistoryTaskDLQRequest) *types.PurgeHistoryTaskDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PurgeHistoryTaskDLQRequest{
+		ShardID:              t.GetShardID(),
+		ExclusiveBeginTaskID: t.ExclusiveBeginTaskID,
+		InclusiveEndTaskID:   t.InclusiveEndTaskID,
+	}
+}
+
+// FromReadHistoryTaskDLQRequest converts internal ReadHistoryTaskDLQRequest type to thrift
+func FromReadHistoryTaskDLQRequest(t *types.ReadHistoryTaskDLQRequest) *shared.ReadHistoryTaskDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ReadHistoryTaskDLQRequest{
+		ShardID:              &t.ShardID,
+		ExclusiveBeginTaskID: t.ExclusiveBeginTaskID,
+		InclusiveEndTaskID:   t.InclusiveEndTaskID,
+		MaximumPageSize:      &t.MaximumPageSize,
+		NextPageToken:        t.NextPageToken,
+	}
+}
+
+// ToReadHistoryTaskDLQRequest converts thrift ReadHistoryTaskDLQRequest type to internal
+func ToReadHistoryTaskDLQRequest(t *shared.ReadHistoryTaskDLQRequest) *types.ReadHistoryTaskDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ReadHistoryTaskDLQRequest{
+		ShardID:              t.GetShardID(),
+		ExclusiveBeginTaskID: t.ExclusiveBeginTaskID,
+		InclusiveEndTaskID:   t.InclusiveEndTaskID,
+		MaximumPageSize:      t.GetMaximumPageSize(),
+		NextPageToken:        t.NextPageToken,
+	}
+}
+
+// FromReadHistoryTaskDLQResponse converts internal ReadHistoryTaskDLQResponse type to thrift
+func FromReadHistoryTaskDLQResponse(t *types.ReadHistoryTaskDLQResponse) *shared.ReadHistoryTaskDLQResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ReadHistoryTaskDLQResponse{
+		Entries:       FromHistoryTaskDLQEntryArray(t.Entries),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToReadHistoryTaskDLQResponse converts thrift ReadHistoryTaskDLQResponse type to internal
+func ToReadHistoryTaskDLQResponse(t *shared.ReadHistoryTaskDLQResponse) *types.ReadHistoryTaskDLQResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ReadHistoryTaskDLQResponse{
+		Entries:       ToHistoryTaskDLQEntryArray(t.Entries),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromRetryHistoryTaskDLQRequest converts internal RetryHistoryTaskDLQRequest type to thrift
+func FromRetryHistoryTaskDLQRequest(t *types.RetryHistoryTaskDLQRequest) *shared.RetryHistoryTaskDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RetryHistoryTaskDLQRequest{
+		ShardID:              &t.ShardID,
+		ExclusiveBeginTaskID: t.ExclusiveBeginTaskID,
+		InclusiveEndTaskID:   t.InclusiveEndTaskID,
+		MaximumPageSize:      &t.MaximumPageSize,
+		NextPageToken:        t.NextPageToken,
+	}
+}
+
+// ToRetryHistoryTaskDLQRequest converts thrift RetryHistoryTaskDLQRequest type to internal
+func ToRetryHistoryTaskDLQRequest(t *shared.RetryHistoryTaskDLQRequest) *types.RetryHistoryTaskDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RetryHistoryTaskDLQRequest{
+		ShardID:              t.GetShardID(),
+		ExclusiveBeginTaskID: t.ExclusiveBeginTaskID,
+		InclusiveEndTaskID:   t.InclusiveEndTaskID,
+		MaximumPageSize:      t.GetMaximumPageSize(),
+		NextPageToken:        t.NextPageToken,
+	}
+}
+
+// FromRetryHistoryTaskDLQResponse converts internal RetryHistoryTaskDLQResponse type to thrift
+func FromRetryHistoryTaskDLQResponse(t *types.RetryHistoryTaskDLQResponse) *shared.RetryHistoryTaskDLQResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.RetryHistoryTaskDLQResponse{
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToRetryHistoryTaskDLQResponse converts thrift RetryHistoryTaskDLQResponse type to internal
+func ToRetryHistoryTaskDLQResponse(t *shared.RetryHistoryTaskDLQResponse) *types.RetryHistoryTaskDLQResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.RetryHistoryTaskDLQResponse{
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromHistoryTaskDLQEntryArray converts internal HistoryTaskDLQEntry type array to thrift
+func FromHistoryTaskDLQEntryArray(t []*types.HistoryTaskDLQEntry) []*shared.HistoryTaskDLQEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.HistoryTaskDLQEntry, len(t))
+	for i := range t {
+		v[i] = FromHistoryTaskDLQEntry(t[i])
+	}
+	return v
+}
+
+// ToHistoryTaskDLQEntryArray converts thrift HistoryTaskDLQEntry type array to internal
+func ToHistoryTaskDLQEntryArray(t []*shared.HistoryTaskDLQEntry) []*types.HistoryTaskDLQEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.HistoryTaskDLQEntry, len(t))
+	for i := range t {
+		v[i] = ToHistoryTaskDLQEntry(t[i])
+	}
+	return v
+}
+
+// FromDescribeReplicationStatusRequest converts internal DescribeReplicationStatusRequest type to thrift
+func FromDescribeReplicationStatusRequest(t *types.DescribeReplicationStatusRequest) *shared.DescribeReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeReplicationStatusRequest{
+		ClusterName: &t.ClusterName,
+		Domain:      &t.Domain,
+		ShardIDs:    t.ShardIDs,
+	}
+}
+
+// ToDescribeReplicationStatusRequest converts thrift DescribeReplicationStatusRequest type to internal
+func ToDescribeReplicationStatusRequest(t *shared.DescribeReplicationStatusRequest) *types.DescribeReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeReplicationStatusRequest{
+		ClusterName: t.GetClusterName(),
+		Domain:      t.GetDomain(),
+		ShardIDs:    t.ShardIDs,
+	}
+}
+
+// FromDescribeReplicationStatusResponse converts internal DescribeReplicationStatusResponse type to thrift
+func FromDescribeReplicationStatusResponse(t *types.DescribeReplicationStatusResponse) *shared.DescribeReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeReplicationStatusResponse{
+		Shards:  FromReplicationShardStatusArray(t.Shards),
+		Domains: FromReplicationDomainStatusArray(t.Domains),
+	}
+}
+
+// ToDescribeReplicationStatusResponse converts thrift DescribeReplicationStatusResponse type to internal
+func ToDescribeReplicationStatusResponse(t *shared.DescribeReplicationStatusResponse) *types.DescribeReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeReplicationStatusResponse{
+		Shards:  ToReplicationShardStatusArray(t.Shards),
+		Domains: ToReplicationDomainStatusArray(t.Domains),
+	}
+}
+
+// FromReplicationShardStatus converts internal ReplicationShardStatus type to thrift
+func FromReplicationShardStatus(t *types.ReplicationShardStatus) *shared.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	return &shared.ReplicationShardStatus{
+		ShardID:               &t.ShardID,
+		ClusterName:           &t.ClusterName,
+		MaxTaskID:             &t.MaxTaskID,
+		OutboundFetchedTaskID: &t.OutboundFetchedTaskID,
+		OutboundAckedTaskID:   &t.OutboundAckedTaskID,
+		OutboundLastFetchTime: t.OutboundLastFetchTime,
+		InboundFetchedTaskID:  &t.InboundFetchedTaskID,
+		InboundAckedTaskID:    &t.InboundAckedTaskID,
+		InboundLastFetchTime:  t.InboundLastFetchTime,
+		InboundDLQSize:        &t.InboundDLQSize,
+		CrossClusterTaskLag:   &t.CrossClusterTaskLag,
+	}
+}
+
+// ToReplicationShardStatus converts thrift ReplicationShardStatus type to internal
+func ToReplicationShardStatus(t *shared.ReplicationShardStatus) *types.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationShardStatus{
+		ShardID:               t.GetShardID(),
+		ClusterName:           t.GetClusterName(),
+		MaxTaskID:             t.GetMaxTaskID(),
+		OutboundFetchedTaskID: t.GetOutboundFetchedTaskID(),
+		OutboundAckedTaskID:   t.GetOutboundAckedTaskID(),
+		OutboundLastFetchTime: t.OutboundLastFetchTime,
+		InboundFetchedTaskID:  t.GetInboundFetchedTaskID(),
+		InboundAckedTaskID:    t.GetInboundAckedTaskID(),
+		InboundLastFetchTime:  t.InboundLastFetchTime,
+		InboundDLQSize:        t.GetInboundDLQSize(),
+		CrossClusterTaskLag:   t.GetCrossClusterTaskLag(),
+	}
+}
+
+// FromReplicationDomainStatus converts internal ReplicationDomainStatus type to thrift
+func FromReplicationDomainStatus(t *types.ReplicationDomainStatus) *shared.ReplicationDomainStatus {
+	if t == nil {
+		return nil
+	}
+	return &shared.ReplicationDomainStatus{
+		Domain:                  &t.Domain,
+		ActiveClusterName:       &t.ActiveClusterName,
+		ClusterName:             &t.ClusterName,
+		MaxOutboundTaskLag:      &t.MaxOutboundTaskLag,
+		OldestOutboundFetchTime: t.OldestOutboundFetchTime,
+		OldestInboundFetchTime:  t.OldestInboundFetchTime,
+		InboundDLQSize:          &t.InboundDLQSize,
+		MaxCrossClusterTaskLag:  &t.MaxCrossClusterTaskLag,
+		GracefulFailoverEndTime: t.GracefulFailoverEndTime,
+	}
+}
+
+// ToReplicationDomainStatus converts thrift ReplicationDomainStatus type to internal
+func ToReplicationDomainStatus(t *shared.ReplicationDomainStatus) *types.ReplicationDomainStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationDomainStatus{
+		Domain:                  t.GetDomain(),
+		ActiveClusterName:       t.GetActiveClusterName(),
+		ClusterName:             t.GetClusterName(),
+		MaxOutboundTaskLag:      t.GetMaxOutboundTaskLag(),
+		OldestOutboundFetchTime: t.OldestOutboundFetchTime,
+		OldestInboundFetchTime:  t.OldestInboundFetchTime,
+		InboundDLQSize:          t.GetInboundDLQSize(),
+		MaxCrossClusterTaskLag:  t.GetMaxCrossClusterTaskLag(),
+		GracefulFailoverEndTime: t.GracefulFailoverEndTime,
+	}
+}
+
+// FromReplicationShardStatusArray converts internal ReplicationShardStatus type array to thrift
+func FromReplicationShardStatusArray(t []*types.ReplicationShardStatus) []*shared.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ReplicationShardStatus, len(t))
+	for i := range t {
+		v[i] = FromReplicationShardStatus(t[i])
+	}
+	return v
+}
+
+// ToReplicationShardStatusArray converts thrift ReplicationShardStatus type array to internal
+func ToReplicationShardStatusArray(t []*shared.ReplicationShardStatus) []*types.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ReplicationShardStatus, len(t))
+	for i := range t {
+		v[i] = ToReplicationShardStatus(t[i])
+	}
+	return v
+}
+
+// FromReplicationDomainStatusArray converts internal ReplicationDomainStatus type array to thrift
+func FromReplicationDomainStatusArray(t []*types.ReplicationDomainStatus) []*shared.ReplicationDomainStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ReplicationDomainStatus, len(t))
+	for i := range t {
+		v[i] = FromReplicationDomainStatus(t[i])
+	}
+	return v
+}
+
+// ToReplicationDomainStatusArray converts thrift ReplicationDomainStatus type array to internal
+func ToReplicationDomainStatusArray(t []*shared.ReplicationDomainStatus) []*types.ReplicationDomainStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ReplicationDomainStatus, len(t))
+	for i := range t {
+		v[i] = ToReplicationDomainStatus(t[i])
+	}
+	return v
+}
+
+// FromWorkflowExecutionPausedEventAttributes converts internal WorkflowExecutionPausedEventAttributes type to thrift
+func FromWorkflowExecutionPausedEventAttributes(t *types.WorkflowExecutionPausedEventAttributes) *shared.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionPausedEventAttributes{
+		Reason:    &t.Reason,
+		Identity:  &t.Identity,
+		RequestId: &t.RequestID,
+	}
+}
+
+// ToWorkflowExecutionPausedEventAttributes converts thrift WorkflowExecutionPausedEventAttributes type to internal
+func ToWorkflowExecutionPausedEventAttributes(t *shared.WorkflowExecutionPausedEventAttributes) *types.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionPausedEventAttributes{
+		Reason:    t.GetReason(),
+		Identity:  t.GetIdentity(),
+		RequestID: t.GetRequestId(),
+	}
+}
+
+// FromWorkflowExecutionUnpausedEventAttributes converts internal WorkflowExecutionUnpausedEventAttributes type to thrift
+func FromWorkflowExecutionUnpausedEventAttributes(t *types.WorkflowExecutionUnpausedEventAttributes) *shared.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionUnpausedEventAttributes{
+		Reason:    &t.Reason,
+		Identity:  &t.Identity,
+		RequestId: &t.RequestID,
+	}
+}
+
+// ToWorkflowExecutionUnpausedEventAttributes converts thrift WorkflowExecutionUnpausedEventAttributes type to internal
+func ToWorkflowExecutionUnpausedEventAttributes(t *shared.WorkflowExecutionUnpausedEventAttributes) *types.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:    t.GetReason(),
+		Identity:  t.GetIdentity(),
+		RequestID: t.GetRequestId(),
+	}
+}
+
+// FromWorkflowExecutionUpdateAcceptedEventAttributes converts internal WorkflowExecutionUpdateAcceptedEventAttributes type to thrift
+func FromWorkflowExecutionUpdateAcceptedEventAttributes(t *types.WorkflowExecutionUpdateAcceptedEventAttributes) *shared.WorkflowExecutionUpdateAcceptedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionUpdateAcceptedEventAttributes{
+		UpdateId:   &t.UpdateID,
+		UpdateName: &t.UpdateName,
+		Input:      t.Input,
+		Identity:   &t.Identity,
+		RequestId:  &t.RequestID,
+	}
+}
+
+// ToWorkflowExecutionUpdateAcceptedEventAttributes converts thrift WorkflowExecutionUpdateAcceptedEventAttributes type to internal
+func ToWorkflowExecutionUpdateAcceptedEventAttributes(t *shared.WorkflowExecutionUpdateAcceptedEventAttributes) *types.WorkflowExecutionUpdateAcceptedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUpdateAcceptedEventAttributes{
+		UpdateID:   t.GetUpdateId(),
+		UpdateName: t.GetUpdateName(),
+		Input:      t.Input,
+		Identity:   t.GetIdentity(),
+		RequestID:  t.GetRequestId(),
+	}
+}
+
+// FromWorkflowExecutionUpdateCompletedEventAttributes converts internal WorkflowExecutionUpdateCompletedEventAttributes type to thrift
+func FromWorkflowExecutionUpdateCompletedEventAttributes(t *types.WorkflowExecutionUpdateCompletedEventAttributes) *shared.WorkflowExecutionUpdateCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionUpdateCompletedEventAttributes{
+		DecisionTaskCompletedEventId: &t.DecisionTaskCompletedEventID,
+		UpdateId:                     &t.UpdateID,
+		Result:                       t.Result,
+		ErrorMessage:                 &t.ErrorMessage,
+	}
+}
+
+// ToWorkflowExecutionUpdateCompletedEventAttributes converts thrift WorkflowExecutionUpdateCompletedEventAttributes type to internal
+func ToWorkflowExecutionUpdateCompletedEventAttributes(t *shared.WorkflowExecutionUpdateCompletedEventAttributes) *types.WorkflowExecutionUpdateCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUpdateCompletedEventAttributes{
+		DecisionTaskCompletedEventID: t.GetDecisionTaskCompletedEventId(),
+		UpdateID:                     t.GetUpdateId(),
+		Result:                       t.Result,
+		ErrorMessage:                 t.GetErrorMessage(),
+	}
+}
+
+// FromPauseWorkflowExecutionRequest converts internal PauseWorkflowExecutionRequest type to thrift
+func FromPauseWorkflowExecutionRequest(t *types.PauseWorkflowExecutionRequest) *shared.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.PauseWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            &t.Reason,
+		Identity:          &t.Identity,
+		RequestId:         &t.RequestID,
+	}
+}
+
+// ToPauseWorkflowExecutionRequest converts thrift PauseWorkflowExecutionRequest type to internal
+func ToPauseWorkflowExecutionRequest(t *shared.PauseWorkflowExecutionRequest) *types.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+		RequestID:         t.GetRequestId(),
+	}
+}
+
+// FromUnpauseWorkflowExecutionRequest converts internal UnpauseWorkflowExecutionRequest type to thrift
+func FromUnpauseWorkflowExecutionRequest(t *types.UnpauseWorkflowExecutionRequest) *shared.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UnpauseWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            &t.Reason,
+		Identity:          &t.Identity,
+		RequestId:         &t.RequestID,
+	}
+}
+
+// ToUnpauseWorkflowExecutionRequest converts thrift UnpauseWorkflowExecutionRequest type to internal
+func ToUnpauseWorkflowExecutionRequest(t *shared.UnpauseWorkflowExecutionRequest) *types.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+		RequestID:         t.GetRequestId(),
+	}
+}
+
+// FromDeleteWorkflowExecutionRequest converts internal DeleteWorkflowExecutionRequest type to thrift
+func FromDeleteWorkflowExecutionRequest(t *types.DeleteWorkflowExecutionRequest) *shared.DeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DeleteWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Archive:           &t.Archive,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToDeleteWorkflowExecutionRequest converts thrift DeleteWorkflowExecutionRequest type to internal
+func ToDeleteWorkflowExecutionRequest(t *shared.DeleteWorkflowExecutionRequest) *types.DeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Archive:           t.GetArchive(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// FromPauseActivityRequest converts internal PauseActivityRequest type to thrift
+func FromPauseActivityRequest(t *types.PauseActivityRequest) *shared.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.PauseActivityRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        &t.ActivityID,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToPauseActivityRequest converts thrift PauseActivityRequest type to internal
+func ToPauseActivityRequest(t *shared.PauseActivityRequest) *types.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseActivityRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityID(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// FromUnpauseActivityRequest converts internal UnpauseActivityRequest type to thrift
+func FromUnpauseActivityRequest(t *types.UnpauseActivityRequest) *shared.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UnpauseActivityRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        &t.ActivityID,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToUnpauseActivityRequest converts thrift UnpauseActivityRequest type to internal
+func ToUnpauseActivityRequest(t *shared.UnpauseActivityRequest) *types.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseActivityRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityID(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// FromResetActivityRequest converts internal ResetActivityRequest type to thrift
+func FromResetActivityRequest(t *types.ResetActivityRequest) *shared.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ResetActivityRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        &t.ActivityID,
+		RetryNow:          &t.RetryNow,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToResetActivityRequest converts thrift ResetActivityRequest type to internal
+func ToResetActivityRequest(t *shared.ResetActivityRequest) *types.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResetActivityRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityID(),
+		RetryNow:          t.GetRetryNow(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// FromUpdateWorkflowExecutionRequest converts internal UpdateWorkflowExecutionRequest type to thrift
+func FromUpdateWorkflowExecutionRequest(t *types.UpdateWorkflowExecutionRequest) *shared.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		UpdateName:        &t.UpdateName,
+		Input:             t.Input,
+		Identity:          &t.Identity,
+		RequestId:         &t.RequestID,
+	}
+}
+
+// ToUpdateWorkflowExecutionRequest converts thrift UpdateWorkflowExecutionRequest type to internal
+func ToUpdateWorkflowExecutionRequest(t *shared.UpdateWorkflowExecutionRequest) *types.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		UpdateName:        t.GetUpdateName(),
+		Input:             t.Input,
+		Identity:          t.GetIdentity(),
+		RequestID:         t.GetRequestId(),
+	}
+}
+
+// FromUpdateWorkflowExecutionResponse converts internal UpdateWorkflowExecutionResponse type to thrift
+func FromUpdateWorkflowExecutionResponse(t *types.UpdateWorkflowExecutionResponse) *shared.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateWorkflowExecutionResponse{
+		Result:       t.Result,
+		ErrorMessage: &t.ErrorMessage,
+	}
+}
+
+// ToUpdateWorkflowExecutionResponse converts thrift UpdateWorkflowExecutionResponse type to internal
+func ToUpdateWorkflowExecutionResponse(t *shared.UpdateWorkflowExecutionResponse) *types.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionResponse{
+		Result:       t.Result,
+		ErrorMessage: t.GetErrorMessage(),
+	}
+}
+
+// FromScheduleSpec converts internal ScheduleSpec type to thrift
+func FromScheduleSpec(t *types.ScheduleSpec) *shared.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleSpec{
+		CronExpression: &t.CronExpression,
+		StartTimestamp: t.StartTimestamp,
+		EndTimestamp:   t.EndTimestamp,
+	}
+}
+
+// ToScheduleSpec converts thrift ScheduleSpec type to internal
+func ToScheduleSpec(t *shared.ScheduleSpec) *types.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleSpec{
+		CronExpression: t.GetCronExpression(),
+		StartTimestamp: t.StartTimestamp,
+		EndTimestamp:   t.EndTimestamp,
+	}
+}
+
+// FromScheduleAction converts internal ScheduleAction type to thrift
+func FromScheduleAction(t *types.ScheduleAction) *shared.ScheduleAction {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleAction{
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      t.TaskStartToCloseTimeoutSeconds,
+	}
+}
+
+// ToScheduleAction converts thrift ScheduleAction type to internal
+func ToScheduleAction(t *shared.ScheduleAction) *types.ScheduleAction {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleAction{
+		WorkflowType:                        ToWorkflowType(t.WorkflowType),
+		TaskList:                            ToTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      t.TaskStartToCloseTimeoutSeconds,
+	}
+}
+
+// FromSchedulePolicies converts internal SchedulePolicies type to thrift
+func FromSchedulePolicies(t *types.SchedulePolicies) *shared.SchedulePolicies {
+	if t == nil {
+		return nil
+	}
+	return &shared.SchedulePolicies{
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+	}
+}
+
+// ToSchedulePolicies converts thrift SchedulePolicies type to internal
+func ToSchedulePolicies(t *shared.SchedulePolicies) *types.SchedulePolicies {
+	if t == nil {
+		return nil
+	}
+	return &types.SchedulePolicies{
+		OverlapPolicy: ToScheduleOverlapPolicy(t.OverlapPolicy),
+	}
+}
+
+// FromScheduleState converts internal ScheduleState type to thrift
+func FromScheduleState(t *types.ScheduleState) *shared.ScheduleState {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleState{
+		Paused:      &t.Paused,
+		PauseReason: &t.PauseReason,
+	}
+}
+
+// ToScheduleState converts thrift ScheduleState type to internal
+func ToScheduleState(t *shared.ScheduleState) *types.ScheduleState {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleState{
+		Paused:      t.GetPaused(),
+		PauseReason: t.GetPauseReason(),
+	}
+}
+
+// FromScheduleInfo converts internal ScheduleInfo type to thrift
+func FromScheduleInfo(t *types.ScheduleInfo) *shared.ScheduleInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleInfo{
+		LastRunTimestamp: t.LastRunTimestamp,
+		NextRunTimestamp: t.NextRunTimestamp,
+		TotalRuns:        &t.TotalRuns,
+		SkippedRuns:      &t.SkippedRuns,
+		BufferedRuns:     &t.BufferedRuns,
+		LastRunExecution: FromWorkflowExecution(t.LastRunExecution),
+	}
+}
+
+// ToScheduleInfo converts thrift ScheduleInfo type to internal
+func ToScheduleInfo(t *shared.ScheduleInfo) *types.ScheduleInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleInfo{
+		LastRunTimestamp: t.LastRunTimestamp,
+		NextRunTimestamp: t.NextRunTimestamp,
+		TotalRuns:        t.GetTotalRuns(),
+		SkippedRuns:      t.GetSkippedRuns(),
+		BufferedRuns:     t.GetBufferedRuns(),
+		LastRunExecution: ToWorkflowExecution(t.LastRunExecution),
+	}
+}
+
+// FromScheduleListEntry converts internal ScheduleListEntry type to thrift
+func FromScheduleListEntry(t *types.ScheduleListEntry) *shared.ScheduleListEntry {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleListEntry{
+		ScheduleId: &t.ScheduleID,
+	}
+}
+
+// ToScheduleListEntry converts thrift ScheduleListEntry type to internal
+func ToScheduleListEntry(t *shared.ScheduleListEntry) *types.ScheduleListEntry {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleListEntry{
+		ScheduleID: t.GetScheduleId(),
+	}
+}
+
+// FromCreateScheduleRequest converts internal CreateScheduleRequest type to thrift
+func FromCreateScheduleRequest(t *types.CreateScheduleRequest) *shared.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.CreateScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+		Spec:       FromScheduleSpec(t.Spec),
+		Action:     FromScheduleAction(t.Action),
+		Policies:   FromSchedulePolicies(t.Policies),
+		Identity:   &t.Identity,
+		RequestId:  &t.RequestID,
+	}
+}
+
+// ToCreateScheduleRequest converts thrift CreateScheduleRequest type to internal
+func ToCreateScheduleRequest(t *shared.CreateScheduleRequest) *types.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.CreateScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+		Spec:       ToScheduleSpec(t.Spec),
+		Action:     ToScheduleAction(t.Action),
+		Policies:   ToSchedulePolicies(t.Policies),
+		Identity:   t.GetIdentity(),
+		RequestID:  t.GetRequestId(),
+	}
+}
+
+// FromDescribeScheduleRequest converts internal DescribeScheduleRequest type to thrift
+func FromDescribeScheduleRequest(t *types.DescribeScheduleRequest) *shared.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+	}
+}
+
+// ToDescribeScheduleRequest converts thrift DescribeScheduleRequest type to internal
+func ToDescribeScheduleRequest(t *shared.DescribeScheduleRequest) *types.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+	}
+}
+
+// FromDescribeScheduleResponse converts internal DescribeScheduleResponse type to thrift
+func FromDescribeScheduleResponse(t *types.DescribeScheduleResponse) *shared.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeScheduleResponse{
+		Spec:     FromScheduleSpec(t.Spec),
+		Action:   FromScheduleAction(t.Action),
+		Policies: FromSchedulePolicies(t.Policies),
+		State:    FromScheduleState(t.State),
+		Info:     FromScheduleInfo(t.Info),
+	}
+}
+
+// ToDescribeScheduleResponse converts thrift DescribeScheduleResponse type to internal
+func ToDescribeScheduleResponse(t *shared.DescribeScheduleResponse) *types.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleResponse{
+		Spec:     ToScheduleSpec(t.Spec),
+		Action:   ToScheduleAction(t.Action),
+		Policies: ToSchedulePolicies(t.Policies),
+		State:    ToScheduleState(t.State),
+		Info:     ToScheduleInfo(t.Info),
+	}
+}
+
+// FromUpdateScheduleRequest converts internal UpdateScheduleRequest type to thrift
+func FromUpdateScheduleRequest(t *types.UpdateScheduleRequest) *shared.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+		Spec:       FromScheduleSpec(t.Spec),
+		Action:     FromScheduleAction(t.Action),
+		Policies:   FromSchedulePolicies(t.Policies),
+		Identity:   &t.Identity,
+		RequestId:  &t.RequestID,
+	}
+}
+
+// ToUpdateScheduleRequest converts thrift UpdateScheduleRequest type to internal
+func ToUpdateScheduleRequest(t *shared.UpdateScheduleRequest) *types.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+		Spec:       ToScheduleSpec(t.Spec),
+		Action:     ToScheduleAction(t.Action),
+		Policies:   ToSchedulePolicies(t.Policies),
+		Identity:   t.GetIdentity(),
+		RequestID:  t.GetRequestId(),
+	}
+}
+
+// FromPauseScheduleRequest converts internal PauseScheduleRequest type to thrift
+func FromPauseScheduleRequest(t *types.PauseScheduleRequest) *shared.PauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.PauseScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+		Reason:     &t.Reason,
+		Identity:   &t.Identity,
+		RequestId:  &t.RequestID,
+	}
+}
+
+// ToPauseScheduleRequest converts thrift PauseScheduleRequest type to internal
+func ToPauseScheduleRequest(t *shared.PauseScheduleRequest) *types.PauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+		Reason:     t.GetReason(),
+		Identity:   t.GetIdentity(),
+		RequestID:  t.GetRequestId(),
+	}
+}
+
+// FromUnpauseScheduleRequest converts internal UnpauseScheduleRequest type to thrift
+func FromUnpauseScheduleRequest(t *types.UnpauseScheduleRequest) *shared.UnpauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UnpauseScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+		Reason:     &t.Reason,
+		Identity:   &t.Identity,
+		RequestId:  &t.RequestID,
+	}
+}
+
+// ToUnpauseScheduleRequest converts thrift UnpauseScheduleRequest type to internal
+func ToUnpauseScheduleRequest(t *shared.UnpauseScheduleRequest) *types.UnpauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+		Reason:     t.GetReason(),
+		Identity:   t.GetIdentity(),
+		RequestID:  t.GetRequestId(),
+	}
+}
+
+// FromBackfillScheduleRequest converts internal BackfillScheduleRequest type to thrift
+func FromBackfillScheduleRequest(t *types.BackfillScheduleRequest) *shared.BackfillScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.BackfillScheduleRequest{
+		Domain:         &t.Domain,
+		ScheduleId:     &t.ScheduleID,
+		StartTimestamp: t.StartTimestamp,
+		EndTimestamp:   t.EndTimestamp,
+		OverlapPolicy:  FromScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:       &t.Identity,
+		RequestId:      &t.RequestID,
+	}
+}
+
+// ToBackfillScheduleRequest converts thrift BackfillScheduleRequest type to internal
+func ToBackfillScheduleRequest(t *shared.BackfillScheduleRequest) *types.BackfillScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.BackfillScheduleRequest{
+		Domain:         t.GetDomain(),
+		ScheduleID:     t.GetScheduleId(),
+		StartTimestamp: t.StartTimestamp,
+		EndTimestamp:   t.EndTimestamp,
+		OverlapPolicy:  ToScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:       t.GetIdentity(),
+		RequestID:      t.GetRequestId(),
+	}
+}
+
+// FromListSchedulesRequest converts internal ListSchedulesRequest type to thrift
+func FromListSchedulesRequest(t *types.ListSchedulesRequest) *shared.ListSchedulesRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListSchedulesRequest{
+		Domain:        &t.Domain,
+		PageSize:      &t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListSchedulesRequest converts thrift ListSchedulesRequest type to internal
+func ToListSchedulesRequest(t *shared.ListSchedulesRequest) *types.ListSchedulesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSchedulesRequest{
+		Domain:        t.GetDomain(),
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromListSchedulesResponse converts internal ListSchedulesResponse type to thrift
+func FromListSchedulesResponse(t *types.ListSchedulesResponse) *shared.ListSchedulesResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListSchedulesResponse{
+		Schedules:     FromScheduleListEntryArray(t.Schedules),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListSchedulesResponse converts thrift ListSchedulesResponse type to internal
+func ToListSchedulesResponse(t *shared.ListSchedulesResponse) *types.ListSchedulesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSchedulesResponse{
+		Schedules:     ToScheduleListEntryArray(t.Schedules),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromScheduleListEntryArray converts internal ScheduleListEntry type array to thrift
+func FromScheduleListEntryArray(t []*types.ScheduleListEntry) []*shared.ScheduleListEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ScheduleListEntry, len(t))
+	for i := range t {
+		v[i] = FromScheduleListEntry(t[i])
+	}
+	return v
+}
+
+// ToScheduleListEntryArray converts thrift ScheduleListEntry type array to internal
+func ToScheduleListEntryArray(t []*shared.ScheduleListEntry) []*types.ScheduleListEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ScheduleListEntry, len(t))
+	for i := range t {
+		v[i] = ToScheduleListEntry(t[i])
+	}
+	return v
+}
+
+// FromScheduleOverlapPolicy converts internal ScheduleOverlapPolicy type to thrift
+func FromScheduleOverlapPolicy(t *types.ScheduleOverlapPolicy) *shared.ScheduleOverlapPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.ScheduleOverlapPolicySkipNew:
+		v := shared.ScheduleOverlapPolicySkipNew
+		return &v
+	case types.ScheduleOverlapPolicyBuffer:
+		v := shared.ScheduleOverlapPolicyBuffer
+		return &v
+	case types.ScheduleOverlapPolicyCancelOther:
+		v := shared.ScheduleOverlapPolicyCancelOther
+		return &v
+	case types.ScheduleOverlapPolicyAllowAll:
+		v := shared.ScheduleOverlapPolicyAllowAll
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToScheduleOverlapPolicy converts thrift ScheduleOverlapPolicy type to internal
+func ToScheduleOverlapPolicy(t *shared.ScheduleOverlapPolicy) *types.ScheduleOverlapPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.ScheduleOverlapPolicySkipNew:
+		v := types.ScheduleOverlapPolicySkipNew
+		return &v
+	case shared.ScheduleOverlapPolicyBuffer:
+		v := types.ScheduleOverlapPolicyBuffer
+		return &v
+	case shared.ScheduleOverlapPolicyCancelOther:
+		v := types.ScheduleOverlapPolicyCancelOther
+		return &v
+	case shared.ScheduleOverlapPolicyAllowAll:
+		v := types.ScheduleOverlapPolicyAllowAll
+		return &v
+	}
+	panic("unexpected enum value")
+}
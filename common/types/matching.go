@@ -365,6 +365,9 @@ type MatchingPollForDecisionTaskResponse struct {
 	ScheduledTimestamp        *int64                    `json:"scheduledTimestamp,omitempty"`
 	StartedTimestamp          *int64                    `json:"startedTimestamp,omitempty"`
 	Queries                   map[string]*WorkflowQuery `json:"queries,omitempty"`
+	HistorySize               int64                     `json:"historySize,omitempty"`
+	HistoryCount              int64                     `json:"historyCount,omitempty"`
+	SuggestContinueAsNew      bool                      `json:"suggestContinueAsNew,omitempty"`
 }
 
 // GetTaskToken is an internal getter (TBD...)
@@ -503,6 +506,30 @@ func (v *MatchingPollForDecisionTaskResponse) GetQueries() (o map[string]*Workfl
 	return
 }
 
+// GetHistorySize is an internal getter (TBD...)
+func (v *MatchingPollForDecisionTaskResponse) GetHistorySize() (o int64) {
+	if v != nil {
+		return v.HistorySize
+	}
+	return
+}
+
+// GetHistoryCount is an internal getter (TBD...)
+func (v *MatchingPollForDecisionTaskResponse) GetHistoryCount() (o int64) {
+	if v != nil {
+		return v.HistoryCount
+	}
+	return
+}
+
+// GetSuggestContinueAsNew is an internal getter (TBD...)
+func (v *MatchingPollForDecisionTaskResponse) GetSuggestContinueAsNew() (o bool) {
+	if v != nil {
+		return v.SuggestContinueAsNew
+	}
+	return
+}
+
 // MatchingQueryWorkflowRequest is an internal type (TBD...)
 type MatchingQueryWorkflowRequest struct {
 	DomainUUID    string                `json:"domainUUID,omitempty"`
@@ -633,3 +660,132 @@ const (
 	// TaskSourceDbBacklog is an option for TaskSource
 	TaskSourceDbBacklog
 )
+
+// MatchingPauseTaskListRequest is an internal type (TBD...)
+type MatchingPauseTaskListRequest struct {
+	DomainUUID   string        `json:"domainUUID,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingPauseTaskListRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *MatchingPauseTaskListRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *MatchingPauseTaskListRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// MatchingResumeTaskListRequest is an internal type (TBD...)
+type MatchingResumeTaskListRequest struct {
+	DomainUUID   string        `json:"domainUUID,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingResumeTaskListRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *MatchingResumeTaskListRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *MatchingResumeTaskListRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// MatchingMoveTaskListBacklogRequest is an internal type (TBD...)
+type MatchingMoveTaskListBacklogRequest struct {
+	DomainUUID     string        `json:"domainUUID,omitempty"`
+	SourceTaskList *TaskList     `json:"sourceTaskList,omitempty"`
+	TargetTaskList *TaskList     `json:"targetTaskList,omitempty"`
+	TaskListType   *TaskListType `json:"taskListType,omitempty"`
+	BatchSize      int32         `json:"batchSize,omitempty"`
+	AfterTaskID    int64         `json:"afterTaskID,omitempty"`
+	DryRun         bool          `json:"dryRun,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingMoveTaskListBacklogRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetSourceTaskList is an internal getter (TBD...)
+func (v *MatchingMoveTaskListBacklogRequest) GetSourceTaskList() (o *TaskList) {
+	if v != nil && v.SourceTaskList != nil {
+		return v.SourceTaskList
+	}
+	return
+}
+
+// GetTargetTaskList is an internal getter (TBD...)
+func (v *MatchingMoveTaskListBacklogRequest) GetTargetTaskList() (o *TaskList) {
+	if v != nil && v.TargetTaskList != nil {
+		return v.TargetTaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *MatchingMoveTaskListBacklogRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetBatchSize is an internal getter (TBD...)
+func (v *MatchingMoveTaskListBacklogRequest) GetBatchSize() (o int32) {
+	if v != nil {
+		return v.BatchSize
+	}
+	return
+}
+
+// GetAfterTaskID is an internal getter (TBD...)
+func (v *MatchingMoveTaskListBacklogRequest) GetAfterTaskID() (o int64) {
+	if v != nil {
+		return v.AfterTaskID
+	}
+	return
+}
+
+// GetDryRun is an internal getter (TBD...)
+func (v *MatchingMoveTaskListBacklogRequest) GetDryRun() (o bool) {
+	if v != nil {
+		return v.DryRun
+	}
+	return
+}
@@ -964,6 +964,7 @@ type SyncActivityTaskAttributes struct {
 	LastWorkerIdentity string          `json:"lastWorkerIdentity,omitempty"`
 	LastFailureDetails []byte          `json:"lastFailureDetails,omitempty"`
 	VersionHistory     *VersionHistory `json:"versionHistory,omitempty"`
+	Paused             bool            `json:"paused,omitempty"`
 }
 
 // GetDomainID is an internal getter (TBD...)
@@ -1086,6 +1087,14 @@ func (v *SyncActivityTaskAttributes) GetVersionHistory() (o *VersionHistory) {
 	return
 }
 
+// GetPaused is an internal getter (TBD...)
+func (v *SyncActivityTaskAttributes) GetPaused() (o bool) {
+	if v != nil {
+		return v.Paused
+	}
+	return
+}
+
 // SyncShardStatus is an internal type (TBD...)
 type SyncShardStatus struct {
 	Timestamp *int64 `json:"timestamp,omitempty"`
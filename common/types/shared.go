@@ -1491,6 +1491,8 @@ type Decision struct {
 	StartChildWorkflowExecutionDecisionAttributes            *StartChildWorkflowExecutionDecisionAttributes            `json:"startChildWorkflowExecutionDecisionAttributes,omitempty"`
 	SignalExternalWorkflowExecutionDecisionAttributes        *SignalExternalWorkflowExecutionDecisionAttributes        `json:"signalExternalWorkflowExecutionDecisionAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesDecisionAttributes         *UpsertWorkflowSearchAttributesDecisionAttributes         `json:"upsertWorkflowSearchAttributesDecisionAttributes,omitempty"`
+	CompleteWorkflowUpdateDecisionAttributes                 *CompleteWorkflowUpdateDecisionAttributes                 `json:"completeWorkflowUpdateDecisionAttributes,omitempty"`
+	UpsertWorkflowMemoDecisionAttributes                     *UpsertWorkflowMemoDecisionAttributes                     `json:"upsertWorkflowMemoDecisionAttributes,omitempty"`
 }
 
 // GetDecisionType is an internal getter (TBD...)
@@ -1605,6 +1607,22 @@ func (v *Decision) GetUpsertWorkflowSearchAttributesDecisionAttributes() (o *Ups
 	return
 }
 
+// GetCompleteWorkflowUpdateDecisionAttributes is an internal getter (TBD...)
+func (v *Decision) GetCompleteWorkflowUpdateDecisionAttributes() (o *CompleteWorkflowUpdateDecisionAttributes) {
+	if v != nil && v.CompleteWorkflowUpdateDecisionAttributes != nil {
+		return v.CompleteWorkflowUpdateDecisionAttributes
+	}
+	return
+}
+
+// GetUpsertWorkflowMemoDecisionAttributes is an internal getter (TBD...)
+func (v *Decision) GetUpsertWorkflowMemoDecisionAttributes() (o *UpsertWorkflowMemoDecisionAttributes) {
+	if v != nil && v.UpsertWorkflowMemoDecisionAttributes != nil {
+		return v.UpsertWorkflowMemoDecisionAttributes
+	}
+	return
+}
+
 // DecisionTaskCompletedEventAttributes is an internal type (TBD...)
 type DecisionTaskCompletedEventAttributes struct {
 	ExecutionContext []byte `json:"executionContext,omitempty"`
@@ -1712,6 +1730,10 @@ func (e DecisionTaskFailedCause) String() string {
 		return "SCHEDULE_ACTIVITY_DUPLICATE_I_D"
 	case 22:
 		return "BAD_SEARCH_ATTRIBUTES"
+	case 23:
+		return "BAD_COMPLETE_WORKFLOW_UPDATE_ATTRIBUTES"
+	case 24:
+		return "BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES"
 	}
 	return fmt.Sprintf("DecisionTaskFailedCause(%d)", w)
 }
@@ -1788,6 +1810,12 @@ func (e *DecisionTaskFailedCause) UnmarshalText(value []byte) error {
 	case "BAD_SEARCH_ATTRIBUTES":
 		*e = DecisionTaskFailedCauseBadSearchAttributes
 		return nil
+	case "BAD_COMPLETE_WORKFLOW_UPDATE_ATTRIBUTES":
+		*e = DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes
+		return nil
+	case "BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES":
+		*e = DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -1850,6 +1878,10 @@ const (
 	DecisionTaskFailedCauseScheduleActivityDuplicateID
 	// DecisionTaskFailedCauseBadSearchAttributes is an option for DecisionTaskFailedCause
 	DecisionTaskFailedCauseBadSearchAttributes
+	// DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes is an option for DecisionTaskFailedCause
+	DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes
+	// DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes is an option for DecisionTaskFailedCause
+	DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes
 )
 
 // DecisionTaskFailedEventAttributes is an internal type (TBD...)
@@ -2173,6 +2205,10 @@ func (e DecisionType) String() string {
 		return "SignalExternalWorkflowExecution"
 	case 12:
 		return "UpsertWorkflowSearchAttributes"
+	case 13:
+		return "CompleteWorkflowUpdate"
+	case 14:
+		return "UpsertWorkflowMemo"
 	}
 	return fmt.Sprintf("DecisionType(%d)", w)
 }
@@ -2219,6 +2255,12 @@ func (e *DecisionType) UnmarshalText(value []byte) error {
 	case "UPSERTWORKFLOWSEARCHATTRIBUTES":
 		*e = DecisionTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "COMPLETEWORKFLOWUPDATE":
+		*e = DecisionTypeCompleteWorkflowUpdate
+		return nil
+	case "UPSERTWORKFLOWMEMO":
+		*e = DecisionTypeUpsertWorkflowMemo
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -2261,6 +2303,10 @@ const (
 	DecisionTypeSignalExternalWorkflowExecution
 	// DecisionTypeUpsertWorkflowSearchAttributes is an option for DecisionType
 	DecisionTypeUpsertWorkflowSearchAttributes
+	// DecisionTypeCompleteWorkflowUpdate is an option for DecisionType
+	DecisionTypeCompleteWorkflowUpdate
+	// DecisionTypeUpsertWorkflowMemo is an option for DecisionType
+	DecisionTypeUpsertWorkflowMemo
 )
 
 // DeprecateDomainRequest is an internal type (TBD...)
@@ -2599,6 +2645,7 @@ type DescribeWorkflowExecutionResponse struct {
 	PendingActivities      []*PendingActivityInfo          `json:"pendingActivities,omitempty"`
 	PendingChildren        []*PendingChildExecutionInfo    `json:"pendingChildren,omitempty"`
 	PendingDecision        *PendingDecisionInfo            `json:"pendingDecision,omitempty"`
+	SignalRequestIDs       map[string]int64                `json:"signalRequestIDs,omitempty"`
 }
 
 // GetExecutionConfiguration is an internal getter (TBD...)
@@ -2641,6 +2688,14 @@ func (v *DescribeWorkflowExecutionResponse) GetPendingDecision() (o *PendingDeci
 	return
 }
 
+// GetSignalRequestIDs is an internal getter (TBD...)
+func (v *DescribeWorkflowExecutionResponse) GetSignalRequestIDs() (o map[string]int64) {
+	if v != nil && v.SignalRequestIDs != nil {
+		return v.SignalRequestIDs
+	}
+	return
+}
+
 // DomainAlreadyExistsError is an internal type (TBD...)
 type DomainAlreadyExistsError struct {
 	Message string `json:"message,required"`
@@ -2685,6 +2740,7 @@ type DomainConfiguration struct {
 	HistoryArchivalURI                     string          `json:"historyArchivalURI,omitempty"`
 	VisibilityArchivalStatus               *ArchivalStatus `json:"visibilityArchivalStatus,omitempty"`
 	VisibilityArchivalURI                  string          `json:"visibilityArchivalURI,omitempty"`
+	Limits                                 *DomainLimits   `json:"limits,omitempty"`
 }
 
 // GetWorkflowExecutionRetentionPeriodInDays is an internal getter (TBD...)
@@ -2743,6 +2799,14 @@ func (v *DomainConfiguration) GetVisibilityArchivalURI() (o string) {
 	return
 }
 
+// GetLimits is an internal getter (TBD...)
+func (v *DomainConfiguration) GetLimits() (o *DomainLimits) {
+	if v != nil && v.Limits != nil {
+		return v.Limits
+	}
+	return
+}
+
 // DomainInfo is an internal type (TBD...)
 type DomainInfo struct {
 	Name        string            `json:"name,omitempty"`
@@ -3112,6 +3176,16 @@ func (e EventType) String() string {
 		return "ExternalWorkflowExecutionSignaled"
 	case 41:
 		return "UpsertWorkflowSearchAttributes"
+	case 42:
+		return "WorkflowExecutionPaused"
+	case 43:
+		return "WorkflowExecutionUnpaused"
+	case 44:
+		return "WorkflowExecutionUpdateAccepted"
+	case 45:
+		return "WorkflowExecutionUpdateCompleted"
+	case 46:
+		return "UpsertWorkflowMemo"
 	}
 	return fmt.Sprintf("EventType(%d)", w)
 }
@@ -3245,6 +3319,21 @@ func (e *EventType) UnmarshalText(value []byte) error {
 	case "UPSERTWORKFLOWSEARCHATTRIBUTES":
 		*e = EventTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "WORKFLOWEXECUTIONPAUSED":
+		*e = EventTypeWorkflowExecutionPaused
+		return nil
+	case "WORKFLOWEXECUTIONUNPAUSED":
+		*e = EventTypeWorkflowExecutionUnpaused
+		return nil
+	case "WORKFLOWEXECUTIONUPDATEACCEPTED":
+		*e = EventTypeWorkflowExecutionUpdateAccepted
+		return nil
+	case "WORKFLOWEXECUTIONUPDATECOMPLETED":
+		*e = EventTypeWorkflowExecutionUpdateCompleted
+		return nil
+	case "UPSERTWORKFLOWMEMO":
+		*e = EventTypeUpsertWorkflowMemo
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -3345,6 +3434,16 @@ const (
 	EventTypeExternalWorkflowExecutionSignaled
 	// EventTypeUpsertWorkflowSearchAttributes is an option for EventType
 	EventTypeUpsertWorkflowSearchAttributes
+	// EventTypeWorkflowExecutionPaused is an option for EventType
+	EventTypeWorkflowExecutionPaused
+	// EventTypeWorkflowExecutionUnpaused is an option for EventType
+	EventTypeWorkflowExecutionUnpaused
+	// EventTypeWorkflowExecutionUpdateAccepted is an option for EventType
+	EventTypeWorkflowExecutionUpdateAccepted
+	// EventTypeWorkflowExecutionUpdateCompleted is an option for EventType
+	EventTypeWorkflowExecutionUpdateCompleted
+	// EventTypeUpsertWorkflowMemo is an option for EventType
+	EventTypeUpsertWorkflowMemo
 )
 
 // ExternalWorkflowExecutionCancelRequestedEventAttributes is an internal type (TBD...)
@@ -3697,6 +3796,11 @@ type HistoryEvent struct {
 	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
 	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesEventAttributes                  *UpsertWorkflowSearchAttributesEventAttributes                  `json:"upsertWorkflowSearchAttributesEventAttributes,omitempty"`
+	WorkflowExecutionPausedEventAttributes                         *WorkflowExecutionPausedEventAttributes                         `json:"workflowExecutionPausedEventAttributes,omitempty"`
+	WorkflowExecutionUnpausedEventAttributes                       *WorkflowExecutionUnpausedEventAttributes                       `json:"workflowExecutionUnpausedEventAttributes,omitempty"`
+	WorkflowExecutionUpdateAcceptedEventAttributes                 *WorkflowExecutionUpdateAcceptedEventAttributes                 `json:"workflowExecutionUpdateAcceptedEventAttributes,omitempty"`
+	WorkflowExecutionUpdateCompletedEventAttributes                *WorkflowExecutionUpdateCompletedEventAttributes                `json:"workflowExecutionUpdateCompletedEventAttributes,omitempty"`
+	UpsertWorkflowMemoEventAttributes                              *UpsertWorkflowMemoEventAttributes                              `json:"upsertWorkflowMemoEventAttributes,omitempty"`
 }
 
 // GetEventID is an internal getter (TBD...)
@@ -4075,6 +4179,46 @@ func (v *HistoryEvent) GetUpsertWorkflowSearchAttributesEventAttributes() (o *Up
 	return
 }
 
+// GetWorkflowExecutionPausedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionPausedEventAttributes() (o *WorkflowExecutionPausedEventAttributes) {
+	if v != nil && v.WorkflowExecutionPausedEventAttributes != nil {
+		return v.WorkflowExecutionPausedEventAttributes
+	}
+	return
+}
+
+// GetWorkflowExecutionUnpausedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionUnpausedEventAttributes() (o *WorkflowExecutionUnpausedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUnpausedEventAttributes != nil {
+		return v.WorkflowExecutionUnpausedEventAttributes
+	}
+	return
+}
+
+// GetWorkflowExecutionUpdateAcceptedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionUpdateAcceptedEventAttributes() (o *WorkflowExecutionUpdateAcceptedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUpdateAcceptedEventAttributes != nil {
+		return v.WorkflowExecutionUpdateAcceptedEventAttributes
+	}
+	return
+}
+
+// GetWorkflowExecutionUpdateCompletedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionUpdateCompletedEventAttributes() (o *WorkflowExecutionUpdateCompletedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUpdateCompletedEventAttributes != nil {
+		return v.WorkflowExecutionUpdateCompletedEventAttributes
+	}
+	return
+}
+
+// GetUpsertWorkflowMemoEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetUpsertWorkflowMemoEventAttributes() (o *UpsertWorkflowMemoEventAttributes) {
+	if v != nil && v.UpsertWorkflowMemoEventAttributes != nil {
+		return v.UpsertWorkflowMemoEventAttributes
+	}
+	return
+}
+
 // HistoryEventFilterType is an internal type (TBD...)
 type HistoryEventFilterType int32
 
@@ -4150,6 +4294,8 @@ func (e IndexedValueType) String() string {
 		return "BOOL"
 	case 5:
 		return "DATETIME"
+	case 6:
+		return "KEYWORD_LIST"
 	}
 	return fmt.Sprintf("IndexedValueType(%d)", w)
 }
@@ -4175,6 +4321,9 @@ func (e *IndexedValueType) UnmarshalText(value []byte) error {
 	case "DATETIME":
 		*e = IndexedValueTypeDatetime
 		return nil
+	case "KEYWORD_LIST":
+		*e = IndexedValueTypeKeywordList
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -4203,6 +4352,8 @@ const (
 	IndexedValueTypeBool
 	// IndexedValueTypeDatetime is an option for IndexedValueType
 	IndexedValueTypeDatetime
+	// IndexedValueTypeKeywordList is an option for IndexedValueType
+	IndexedValueTypeKeywordList
 )
 
 // InternalDataInconsistencyError is an internal type (TBD...)
@@ -5335,6 +5486,9 @@ type PollForDecisionTaskResponse struct {
 	StartedTimestamp          *int64                    `json:"startedTimestamp,omitempty"`
 	Queries                   map[string]*WorkflowQuery `json:"queries,omitempty"`
 	NextEventID               int64                     `json:"nextEventId,omitempty"`
+	HistorySize               int64                     `json:"historySize,omitempty"`
+	HistoryCount              int64                     `json:"historyCount,omitempty"`
+	SuggestContinueAsNew      bool                      `json:"suggestContinueAsNew,omitempty"`
 }
 
 // GetTaskToken is an internal getter (TBD...)
@@ -5457,6 +5611,30 @@ func (v *PollForDecisionTaskResponse) GetNextEventID() (o int64) {
 	return
 }
 
+// GetHistorySize is an internal getter (TBD...)
+func (v *PollForDecisionTaskResponse) GetHistorySize() (o int64) {
+	if v != nil {
+		return v.HistorySize
+	}
+	return
+}
+
+// GetHistoryCount is an internal getter (TBD...)
+func (v *PollForDecisionTaskResponse) GetHistoryCount() (o int64) {
+	if v != nil {
+		return v.HistoryCount
+	}
+	return
+}
+
+// GetSuggestContinueAsNew is an internal getter (TBD...)
+func (v *PollForDecisionTaskResponse) GetSuggestContinueAsNew() (o bool) {
+	if v != nil {
+		return v.SuggestContinueAsNew
+	}
+	return
+}
+
 // PollerInfo is an internal type (TBD...)
 type PollerInfo struct {
 	LastAccessTime *int64  `json:"lastAccessTime,omitempty"`
@@ -6607,6 +6785,50 @@ func (v *ResetWorkflowExecutionResponse) GetRunID() (o string) {
 	return
 }
 
+// RestartWorkflowExecutionRequest is an internal type (TBD...)
+type RestartWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *RestartWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *RestartWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *RestartWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// RestartWorkflowExecutionResponse is an internal type (TBD...)
+type RestartWorkflowExecutionResponse struct {
+	RunID string `json:"runId,omitempty"`
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *RestartWorkflowExecutionResponse) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
 // RespondActivityTaskCanceledByIDRequest is an internal type (TBD...)
 type RespondActivityTaskCanceledByIDRequest struct {
 	Domain     string `json:"domain,omitempty"`
@@ -7598,25 +7820,26 @@ func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetChildWorkfl
 
 // SignalWithStartWorkflowExecutionRequest is an internal type (TBD...)
 type SignalWithStartWorkflowExecutionRequest struct {
-	Domain                              string                 `json:"domain,omitempty"`
-	WorkflowID                          string                 `json:"workflowId,omitempty"`
-	WorkflowType                        *WorkflowType          `json:"workflowType,omitempty"`
-	TaskList                            *TaskList              `json:"taskList,omitempty"`
-	Input                               []byte                 `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                 `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                 `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	Identity                            string                 `json:"identity,omitempty"`
-	RequestID                           string                 `json:"requestId,omitempty"`
-	WorkflowIDReusePolicy               *WorkflowIDReusePolicy `json:"workflowIdReusePolicy,omitempty"`
-	SignalName                          string                 `json:"signalName,omitempty"`
-	SignalInput                         []byte                 `json:"signalInput,omitempty"`
-	Control                             []byte                 `json:"control,omitempty"`
-	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
-	CronSchedule                        string                 `json:"cronSchedule,omitempty"`
-	Memo                                *Memo                  `json:"memo,omitempty"`
-	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
-	Header                              *Header                `json:"header,omitempty"`
-	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
+	Domain                              string                    `json:"domain,omitempty"`
+	WorkflowID                          string                    `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType             `json:"workflowType,omitempty"`
+	TaskList                            *TaskList                 `json:"taskList,omitempty"`
+	Input                               []byte                    `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                    `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                    `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	Identity                            string                    `json:"identity,omitempty"`
+	RequestID                           string                    `json:"requestId,omitempty"`
+	WorkflowIDReusePolicy               *WorkflowIDReusePolicy    `json:"workflowIdReusePolicy,omitempty"`
+	SignalName                          string                    `json:"signalName,omitempty"`
+	SignalInput                         []byte                    `json:"signalInput,omitempty"`
+	Control                             []byte                    `json:"control,omitempty"`
+	RetryPolicy                         *RetryPolicy              `json:"retryPolicy,omitempty"`
+	CronSchedule                        string                    `json:"cronSchedule,omitempty"`
+	Memo                                *Memo                     `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes         `json:"searchAttributes,omitempty"`
+	Header                              *Header                   `json:"header,omitempty"`
+	DelayStartSeconds                   *int32                    `json:"delayStartSeconds,omitempty"`
+	WorkflowIDConflictPolicy            *WorkflowIDConflictPolicy `json:"workflowIdConflictPolicy,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -7707,6 +7930,14 @@ func (v *SignalWithStartWorkflowExecutionRequest) GetWorkflowIDReusePolicy() (o
 	return
 }
 
+// GetWorkflowIDConflictPolicy is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetWorkflowIDConflictPolicy() (o WorkflowIDConflictPolicy) {
+	if v != nil && v.WorkflowIDConflictPolicy != nil {
+		return *v.WorkflowIDConflictPolicy
+	}
+	return
+}
+
 // GetSignalName is an internal getter (TBD...)
 func (v *SignalWithStartWorkflowExecutionRequest) GetSignalName() (o string) {
 	if v != nil {
@@ -8247,22 +8478,23 @@ func (v *StartTimerDecisionAttributes) GetStartToFireTimeoutSeconds() (o int64)
 
 // StartWorkflowExecutionRequest is an internal type (TBD...)
 type StartWorkflowExecutionRequest struct {
-	Domain                              string                 `json:"domain,omitempty"`
-	WorkflowID                          string                 `json:"workflowId,omitempty"`
-	WorkflowType                        *WorkflowType          `json:"workflowType,omitempty"`
-	TaskList                            *TaskList              `json:"taskList,omitempty"`
-	Input                               []byte                 `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                 `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                 `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	Identity                            string                 `json:"identity,omitempty"`
-	RequestID                           string                 `json:"requestId,omitempty"`
-	WorkflowIDReusePolicy               *WorkflowIDReusePolicy `json:"workflowIdReusePolicy,omitempty"`
-	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
-	CronSchedule                        string                 `json:"cronSchedule,omitempty"`
-	Memo                                *Memo                  `json:"memo,omitempty"`
-	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
-	Header                              *Header                `json:"header,omitempty"`
-	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
+	Domain                              string                    `json:"domain,omitempty"`
+	WorkflowID                          string                    `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType             `json:"workflowType,omitempty"`
+	TaskList                            *TaskList                 `json:"taskList,omitempty"`
+	Input                               []byte                    `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                    `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                    `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	Identity                            string                    `json:"identity,omitempty"`
+	RequestID                           string                    `json:"requestId,omitempty"`
+	WorkflowIDReusePolicy               *WorkflowIDReusePolicy    `json:"workflowIdReusePolicy,omitempty"`
+	RetryPolicy                         *RetryPolicy              `json:"retryPolicy,omitempty"`
+	CronSchedule                        string                    `json:"cronSchedule,omitempty"`
+	Memo                                *Memo                     `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes         `json:"searchAttributes,omitempty"`
+	Header                              *Header                   `json:"header,omitempty"`
+	DelayStartSeconds                   *int32                    `json:"delayStartSeconds,omitempty"`
+	WorkflowIDConflictPolicy            *WorkflowIDConflictPolicy `json:"workflowIdConflictPolicy,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -8353,6 +8585,14 @@ func (v *StartWorkflowExecutionRequest) GetWorkflowIDReusePolicy() (o WorkflowID
 	return
 }
 
+// GetWorkflowIDConflictPolicy is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetWorkflowIDConflictPolicy() (o WorkflowIDConflictPolicy) {
+	if v != nil && v.WorkflowIDConflictPolicy != nil {
+		return *v.WorkflowIDConflictPolicy
+	}
+	return
+}
+
 // GetRetryPolicy is an internal getter (TBD...)
 func (v *StartWorkflowExecutionRequest) GetRetryPolicy() (o *RetryPolicy) {
 	if v != nil && v.RetryPolicy != nil {
@@ -8587,6 +8827,7 @@ type TaskListStatus struct {
 	AckLevel         int64        `json:"ackLevel,omitempty"`
 	RatePerSecond    float64      `json:"ratePerSecond,omitempty"`
 	TaskIDBlock      *TaskIDBlock `json:"taskIDBlock,omitempty"`
+	Paused           bool         `json:"paused,omitempty"`
 }
 
 // GetBacklogCountHint is an internal getter (TBD...)
@@ -8629,6 +8870,14 @@ func (v *TaskListStatus) GetTaskIDBlock() (o *TaskIDBlock) {
 	return
 }
 
+// GetPaused is an internal getter (TBD...)
+func (v *TaskListStatus) GetPaused() (o bool) {
+	if v != nil {
+		return v.Paused
+	}
+	return
+}
+
 // TaskListType is an internal type (TBD...)
 type TaskListType int32
 
@@ -8927,6 +9176,7 @@ type UpdateDomainRequest struct {
 	SecurityToken                          string                             `json:"securityToken,omitempty"`
 	DeleteBadBinary                        *string                            `json:"deleteBadBinary,omitempty"`
 	FailoverTimeoutInSeconds               *int32                             `json:"failoverTimeoutInSeconds,omitempty"`
+	Limits                                 *DomainLimits                      `json:"limits,omitempty"`
 }
 
 // GetName is an internal getter (TBD...)
@@ -8961,6 +9211,14 @@ func (v *UpdateDomainRequest) GetFailoverTimeoutInSeconds() (o int32) {
 	return
 }
 
+// GetLimits is an internal getter (TBD...)
+func (v *UpdateDomainRequest) GetLimits() (o *DomainLimits) {
+	if v != nil && v.Limits != nil {
+		return v.Limits
+	}
+	return
+}
+
 // GetHistoryArchivalURI is an internal getter (TBD...)
 func (v *UpdateDomainRequest) GetHistoryArchivalURI() (o string) {
 	if v != nil && v.HistoryArchivalURI != nil {
@@ -9026,6 +9284,41 @@ func (v *UpdateDomainResponse) GetIsGlobalDomain() (o bool) {
 	return
 }
 
+// UpsertWorkflowMemoDecisionAttributes is an internal type (TBD...)
+type UpsertWorkflowMemoDecisionAttributes struct {
+	Memo *Memo `json:"memo,omitempty"`
+}
+
+// GetMemo is an internal getter (TBD...)
+func (v *UpsertWorkflowMemoDecisionAttributes) GetMemo() (o *Memo) {
+	if v != nil && v.Memo != nil {
+		return v.Memo
+	}
+	return
+}
+
+// UpsertWorkflowMemoEventAttributes is an internal type (TBD...)
+type UpsertWorkflowMemoEventAttributes struct {
+	DecisionTaskCompletedEventID int64 `json:"decisionTaskCompletedEventId,omitempty"`
+	Memo                         *Memo `json:"memo,omitempty"`
+}
+
+// GetDecisionTaskCompletedEventID is an internal getter (TBD...)
+func (v *UpsertWorkflowMemoEventAttributes) GetDecisionTaskCompletedEventID() (o int64) {
+	if v != nil {
+		return v.DecisionTaskCompletedEventID
+	}
+	return
+}
+
+// GetMemo is an internal getter (TBD...)
+func (v *UpsertWorkflowMemoEventAttributes) GetMemo() (o *Memo) {
+	if v != nil && v.Memo != nil {
+		return v.Memo
+	}
+	return
+}
+
 // UpsertWorkflowSearchAttributesDecisionAttributes is an internal type (TBD...)
 type UpsertWorkflowSearchAttributesDecisionAttributes struct {
 	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
@@ -9779,6 +10072,7 @@ type WorkflowExecutionStartedEventAttributes struct {
 	SearchAttributes                    *SearchAttributes       `json:"searchAttributes,omitempty"`
 	PrevAutoResetPoints                 *ResetPoints            `json:"prevAutoResetPoints,omitempty"`
 	Header                              *Header                 `json:"header,omitempty"`
+	RestartedFromRunID                  string                  `json:"restartedFromRunId,omitempty"`
 }
 
 // GetWorkflowType is an internal getter (TBD...)
@@ -9982,6 +10276,14 @@ func (v *WorkflowExecutionStartedEventAttributes) GetHeader() (o *Header) {
 	return
 }
 
+// GetRestartedFromRunID is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetRestartedFromRunID() (o string) {
+	if v != nil {
+		return v.RestartedFromRunID
+	}
+	return
+}
+
 // WorkflowExecutionTerminatedEventAttributes is an internal type (TBD...)
 type WorkflowExecutionTerminatedEventAttributes struct {
 	Reason   string `json:"reason,omitempty"`
@@ -10026,6 +10328,64 @@ func (v *WorkflowExecutionTimedOutEventAttributes) GetTimeoutType() (o TimeoutTy
 	return
 }
 
+// WorkflowIDConflictPolicy is an internal type (TBD...)
+type WorkflowIDConflictPolicy int32
+
+// Ptr is a helper function for getting pointer value
+func (e WorkflowIDConflictPolicy) Ptr() *WorkflowIDConflictPolicy {
+	return &e
+}
+
+// String returns a readable string representation of WorkflowIDConflictPolicy.
+func (e WorkflowIDConflictPolicy) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "Fail"
+	case 1:
+		return "UseExisting"
+	case 2:
+		return "TerminateExisting"
+	}
+	return fmt.Sprintf("WorkflowIDConflictPolicy(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *WorkflowIDConflictPolicy) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "FAIL":
+		*e = WorkflowIDConflictPolicyFail
+		return nil
+	case "USEEXISTING":
+		*e = WorkflowIDConflictPolicyUseExisting
+		return nil
+	case "TERMINATEEXISTING":
+		*e = WorkflowIDConflictPolicyTerminateExisting
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "WorkflowIDConflictPolicy", err)
+		}
+		*e = WorkflowIDConflictPolicy(val)
+		return nil
+	}
+}
+
+// MarshalText encodes WorkflowIDConflictPolicy to text.
+func (e WorkflowIDConflictPolicy) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// WorkflowIDConflictPolicyFail is an option for WorkflowIDConflictPolicy
+	WorkflowIDConflictPolicyFail WorkflowIDConflictPolicy = iota
+	// WorkflowIDConflictPolicyUseExisting is an option for WorkflowIDConflictPolicy
+	WorkflowIDConflictPolicyUseExisting
+	// WorkflowIDConflictPolicyTerminateExisting is an option for WorkflowIDConflictPolicy
+	WorkflowIDConflictPolicyTerminateExisting
+)
+
 // WorkflowIDReusePolicy is an internal type (TBD...)
 type WorkflowIDReusePolicy int32
 
@@ -10846,3 +11206,1821 @@ func (v *RespondCrossClusterTasksCompletedResponse) GetTasks() (o []*CrossCluste
 	}
 	return
 }
+
+// MoveTaskListBacklogResponse is an internal type (TBD...)
+type MoveTaskListBacklogResponse struct {
+	Count      int64 `json:"count,omitempty"`
+	LastTaskID int64 `json:"lastTaskID,omitempty"`
+	HasMore    bool  `json:"hasMore,omitempty"`
+}
+
+// GetCount is an internal getter (TBD...)
+func (v *MoveTaskListBacklogResponse) GetCount() (o int64) {
+	if v != nil {
+		return v.Count
+	}
+	return
+}
+
+// GetLastTaskID is an internal getter (TBD...)
+func (v *MoveTaskListBacklogResponse) GetLastTaskID() (o int64) {
+	if v != nil {
+		return v.LastTaskID
+	}
+	return
+}
+
+// GetHasMore is an internal getter (TBD...)
+func (v *MoveTaskListBacklogResponse) GetHasMore() (o bool) {
+	if v != nil {
+		return v.HasMore
+	}
+	return
+}
+
+// HistoryTaskDLQEntry is an internal type (TBD...)
+type HistoryTaskDLQEntry struct {
+	TaskID              int64  `json:"taskID,omitempty"`
+	Category            *int32 `json:"category,omitempty"`
+	TaskType            int32  `json:"taskType,omitempty"`
+	DomainID            string `json:"domainID,omitempty"`
+	WorkflowID          string `json:"workflowID,omitempty"`
+	RunID               string `json:"runID,omitempty"`
+	VisibilityTimestamp int64  `json:"visibilityTimestamp,omitempty"`
+	Attempt             int32  `json:"attempt,omitempty"`
+	LastError           string `json:"lastError,omitempty"`
+	CreatedTimestamp    int64  `json:"createdTimestamp,omitempty"`
+}
+
+// GetTaskID is an internal getter (TBD...)
+func (v *HistoryTaskDLQEntry) GetTaskID() (o int64) {
+	if v != nil {
+		return v.TaskID
+	}
+	return
+}
+
+// GetCategory is an internal getter (TBD...)
+func (v *HistoryTaskDLQEntry) GetCategory() (o int32) {
+	if v != nil && v.Category != nil {
+		return *v.Category
+	}
+	return
+}
+
+// GetTaskType is an internal getter (TBD...)
+func (v *HistoryTaskDLQEntry) GetTaskType() (o int32) {
+	if v != nil {
+		return v.TaskType
+	}
+	return
+}
+
+// GetDomainID is an internal getter (TBD...)
+func (v *HistoryTaskDLQEntry) GetDomainID() (o string) {
+	if v != nil {
+		return v.DomainID
+	}
+	return
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *HistoryTaskDLQEntry) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *HistoryTaskDLQEntry) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+// GetVisibilityTimestamp is an internal getter (TBD...)
+func (v *HistoryTaskDLQEntry) GetVisibilityTimestamp() (o int64) {
+	if v != nil {
+		return v.VisibilityTimestamp
+	}
+	return
+}
+
+// GetAttempt is an internal getter (TBD...)
+func (v *HistoryTaskDLQEntry) GetAttempt() (o int32) {
+	if v != nil {
+		return v.Attempt
+	}
+	return
+}
+
+// GetLastError is an internal getter (TBD...)
+func (v *HistoryTaskDLQEntry) GetLastError() (o string) {
+	if v != nil {
+		return v.LastError
+	}
+	return
+}
+
+// GetCreatedTimestamp is an internal getter (TBD...)
+func (v *HistoryTaskDLQEntry) GetCreatedTimestamp() (o int64) {
+	if v != nil {
+		return v.CreatedTimestamp
+	}
+	return
+}
+
+// ReadHistoryTaskDLQRequest is an internal type (TBD...)
+type ReadHistoryTaskDLQRequest struct {
+	ShardID              int32  `json:"shardID,omitempty"`
+	ExclusiveBeginTaskID *int64 `json:"exclusiveBeginTaskID,omitempty"`
+	InclusiveEndTaskID   *int64 `json:"inclusiveEndTaskID,omitempty"`
+	MaximumPageSize      int32  `json:"maximumPageSize,omitempty"`
+	NextPageToken        []byte `json:"nextPageToken,omitempty"`
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *ReadHistoryTaskDLQRequest) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetExclusiveBeginTaskID is an internal getter (TBD...)
+func (v *ReadHistoryTaskDLQRequest) GetExclusiveBeginTaskID() (o int64) {
+	if v != nil && v.ExclusiveBeginTaskID != nil {
+		return *v.ExclusiveBeginTaskID
+	}
+	return
+}
+
+// GetInclusiveEndTaskID is an internal getter (TBD...)
+func (v *ReadHistoryTaskDLQRequest) GetInclusiveEndTaskID() (o int64) {
+	if v != nil && v.InclusiveEndTaskID != nil {
+		return *v.InclusiveEndTaskID
+	}
+	return
+}
+
+// GetMaximumPageSize is an internal getter (TBD...)
+func (v *ReadHistoryTaskDLQRequest) GetMaximumPageSize() (o int32) {
+	if v != nil {
+		return v.MaximumPageSize
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ReadHistoryTaskDLQRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// ReadHistoryTaskDLQResponse is an internal type (TBD...)
+type ReadHistoryTaskDLQResponse struct {
+	Entries       []*HistoryTaskDLQEntry `json:"entries,omitempty"`
+	NextPageToken []byte                 `json:"nextPageToken,omitempty"`
+}
+
+// GetEntries is an internal getter (TBD...)
+func (v *ReadHistoryTaskDLQResponse) GetEntries() (o []*HistoryTaskDLQEntry) {
+	if v != nil && v.Entries != nil {
+		return v.Entries
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ReadHistoryTaskDLQResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// PurgeHistoryTaskDLQRequest is an internal type (TBD...)
+type PurgeHistoryTaskDLQRequest struct {
+	ShardID              int32  `json:"shardID,omitempty"`
+	ExclusiveBeginTaskID *int64 `json:"exclusiveBeginTaskID,omitempty"`
+	InclusiveEndTaskID   *int64 `json:"inclusiveEndTaskID,omitempty"`
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *PurgeHistoryTaskDLQRequest) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetExclusiveBeginTaskID is an internal getter (TBD...)
+func (v *PurgeHistoryTaskDLQRequest) GetExclusiveBeginTaskID() (o int64) {
+	if v != nil && v.ExclusiveBeginTaskID != nil {
+		return *v.ExclusiveBeginTaskID
+	}
+	return
+}
+
+// GetInclusiveEndTaskID is an internal getter (TBD...)
+func (v *PurgeHistoryTaskDLQRequest) GetInclusiveEndTaskID() (o int64) {
+	if v != nil && v.InclusiveEndTaskID != nil {
+		return *v.InclusiveEndTaskID
+	}
+	return
+}
+
+// RetryHistoryTaskDLQRequest is an internal type (TBD...)
+type RetryHistoryTaskDLQRequest struct {
+	ShardID              int32  `json:"shardID,omitempty"`
+	ExclusiveBeginTaskID *int64 `json:"exclusiveBeginTaskID,omitempty"`
+	InclusiveEndTaskID   *int64 `json:"inclusiveEndTaskID,omitempty"`
+	MaximumPageSize      int32  `json:"maximumPageSize,omitempty"`
+	NextPageToken        []byte `json:"nextPageToken,omitempty"`
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *RetryHistoryTaskDLQRequest) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetExclusiveBeginTaskID is an internal getter (TBD...)
+func (v *RetryHistoryTaskDLQRequest) GetExclusiveBeginTaskID() (o int64) {
+	if v != nil && v.ExclusiveBeginTaskID != nil {
+		return *v.ExclusiveBeginTaskID
+	}
+	return
+}
+
+// GetInclusiveEndTaskID is an internal getter (TBD...)
+func (v *RetryHistoryTaskDLQRequest) GetInclusiveEndTaskID() (o int64) {
+	if v != nil && v.InclusiveEndTaskID != nil {
+		return *v.InclusiveEndTaskID
+	}
+	return
+}
+
+// GetMaximumPageSize is an internal getter (TBD...)
+func (v *RetryHistoryTaskDLQRequest) GetMaximumPageSize() (o int32) {
+	if v != nil {
+		return v.MaximumPageSize
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *RetryHistoryTaskDLQRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// RetryHistoryTaskDLQResponse is an internal type (TBD...)
+type RetryHistoryTaskDLQResponse struct {
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *RetryHistoryTaskDLQResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// DescribeReplicationStatusRequest is an internal type (TBD...)
+type DescribeReplicationStatusRequest struct {
+	ClusterName string  `json:"clusterName,omitempty"`
+	Domain      string  `json:"domain,omitempty"`
+	ShardIDs    []int32 `json:"shardIDs,omitempty"`
+}
+
+// GetClusterName is an internal getter (TBD...)
+func (v *DescribeReplicationStatusRequest) GetClusterName() (o string) {
+	if v != nil {
+		return v.ClusterName
+	}
+	return
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DescribeReplicationStatusRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetShardIDs is an internal getter (TBD...)
+func (v *DescribeReplicationStatusRequest) GetShardIDs() (o []int32) {
+	if v != nil && v.ShardIDs != nil {
+		return v.ShardIDs
+	}
+	return
+}
+
+// DescribeReplicationStatusResponse is an internal type (TBD...)
+type DescribeReplicationStatusResponse struct {
+	Shards  []*ReplicationShardStatus  `json:"shards,omitempty"`
+	Domains []*ReplicationDomainStatus `json:"domains,omitempty"`
+}
+
+// GetShards is an internal getter (TBD...)
+func (v *DescribeReplicationStatusResponse) GetShards() (o []*ReplicationShardStatus) {
+	if v != nil && v.Shards != nil {
+		return v.Shards
+	}
+	return
+}
+
+// GetDomains is an internal getter (TBD...)
+func (v *DescribeReplicationStatusResponse) GetDomains() (o []*ReplicationDomainStatus) {
+	if v != nil && v.Domains != nil {
+		return v.Domains
+	}
+	return
+}
+
+// ReplicationShardStatus is an internal type (TBD...)
+type ReplicationShardStatus struct {
+	ShardID               int32  `json:"shardID,omitempty"`
+	ClusterName           string `json:"clusterName,omitempty"`
+	MaxTaskID             int64  `json:"maxTaskID,omitempty"`
+	OutboundFetchedTaskID int64  `json:"outboundFetchedTaskID,omitempty"`
+	OutboundAckedTaskID   int64  `json:"outboundAckedTaskID,omitempty"`
+	OutboundLastFetchTime *int64 `json:"outboundLastFetchTime,omitempty"`
+	InboundFetchedTaskID  int64  `json:"inboundFetchedTaskID,omitempty"`
+	InboundAckedTaskID    int64  `json:"inboundAckedTaskID,omitempty"`
+	InboundLastFetchTime  *int64 `json:"inboundLastFetchTime,omitempty"`
+	InboundDLQSize        int64  `json:"inboundDLQSize,omitempty"`
+	CrossClusterTaskLag   int64  `json:"crossClusterTaskLag,omitempty"`
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *ReplicationShardStatus) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetClusterName is an internal getter (TBD...)
+func (v *ReplicationShardStatus) GetClusterName() (o string) {
+	if v != nil {
+		return v.ClusterName
+	}
+	return
+}
+
+// GetMaxTaskID is an internal getter (TBD...)
+func (v *ReplicationShardStatus) GetMaxTaskID() (o int64) {
+	if v != nil {
+		return v.MaxTaskID
+	}
+	return
+}
+
+// GetOutboundFetchedTaskID is an internal getter (TBD...)
+func (v *ReplicationShardStatus) GetOutboundFetchedTaskID() (o int64) {
+	if v != nil {
+		return v.OutboundFetchedTaskID
+	}
+	return
+}
+
+// GetOutboundAckedTaskID is an internal getter (TBD...)
+func (v *ReplicationShardStatus) GetOutboundAckedTaskID() (o int64) {
+	if v != nil {
+		return v.OutboundAckedTaskID
+	}
+	return
+}
+
+// GetOutboundLastFetchTime is an internal getter (TBD...)
+func (v *ReplicationShardStatus) GetOutboundLastFetchTime() (o int64) {
+	if v != nil && v.OutboundLastFetchTime != nil {
+		return *v.OutboundLastFetchTime
+	}
+	return
+}
+
+// GetInboundFetchedTaskID is an internal getter (TBD...)
+func (v *ReplicationShardStatus) GetInboundFetchedTaskID() (o int64) {
+	if v != nil {
+		return v.InboundFetchedTaskID
+	}
+	return
+}
+
+// GetInboundAckedTaskID is an internal getter (TBD...)
+func (v *ReplicationShardStatus) GetInboundAckedTaskID() (o int64) {
+	if v != nil {
+		return v.InboundAckedTaskID
+	}
+	return
+}
+
+// GetInboundLastFetchTime is an internal getter (TBD...)
+func (v *ReplicationShardStatus) GetInboundLastFetchTime() (o int64) {
+	if v != nil && v.InboundLastFetchTime != nil {
+		return *v.InboundLastFetchTime
+	}
+	return
+}
+
+// GetInboundDLQSize is an internal getter (TBD...)
+func (v *ReplicationShardStatus) GetInboundDLQSize() (o int64) {
+	if v != nil {
+		return v.InboundDLQSize
+	}
+	return
+}
+
+// GetCrossClusterTaskLag is an internal getter (TBD...)
+func (v *ReplicationShardStatus) GetCrossClusterTaskLag() (o int64) {
+	if v != nil {
+		return v.CrossClusterTaskLag
+	}
+	return
+}
+
+// ReplicationDomainStatus is an internal type (TBD...)
+type ReplicationDomainStatus struct {
+	Domain                  string `json:"domain,omitempty"`
+	ActiveClusterName       string `json:"activeClusterName,omitempty"`
+	ClusterName             string `json:"clusterName,omitempty"`
+	MaxOutboundTaskLag      int64  `json:"maxOutboundTaskLag,omitempty"`
+	OldestOutboundFetchTime *int64 `json:"oldestOutboundFetchTime,omitempty"`
+	OldestInboundFetchTime  *int64 `json:"oldestInboundFetchTime,omitempty"`
+	InboundDLQSize          int64  `json:"inboundDLQSize,omitempty"`
+	MaxCrossClusterTaskLag  int64  `json:"maxCrossClusterTaskLag,omitempty"`
+	GracefulFailoverEndTime *int64 `json:"gracefulFailoverEndTime,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ReplicationDomainStatus) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetActiveClusterName is an internal getter (TBD...)
+func (v *ReplicationDomainStatus) GetActiveClusterName() (o string) {
+	if v != nil {
+		return v.ActiveClusterName
+	}
+	return
+}
+
+// GetClusterName is an internal getter (TBD...)
+func (v *ReplicationDomainStatus) GetClusterName() (o string) {
+	if v != nil {
+		return v.ClusterName
+	}
+	return
+}
+
+// GetMaxOutboundTaskLag is an internal getter (TBD...)
+func (v *ReplicationDomainStatus) GetMaxOutboundTaskLag() (o int64) {
+	if v != nil {
+		return v.MaxOutboundTaskLag
+	}
+	return
+}
+
+// GetOldestOutboundFetchTime is an internal getter (TBD...)
+func (v *ReplicationDomainStatus) GetOldestOutboundFetchTime() (o int64) {
+	if v != nil && v.OldestOutboundFetchTime != nil {
+		return *v.OldestOutboundFetchTime
+	}
+	return
+}
+
+// GetOldestInboundFetchTime is an internal getter (TBD...)
+func (v *ReplicationDomainStatus) GetOldestInboundFetchTime() (o int64) {
+	if v != nil && v.OldestInboundFetchTime != nil {
+		return *v.OldestInboundFetchTime
+	}
+	return
+}
+
+// GetInboundDLQSize is an internal getter (TBD...)
+func (v *ReplicationDomainStatus) GetInboundDLQSize() (o int64) {
+	if v != nil {
+		return v.InboundDLQSize
+	}
+	return
+}
+
+// GetMaxCrossClusterTaskLag is an internal getter (TBD...)
+func (v *ReplicationDomainStatus) GetMaxCrossClusterTaskLag() (o int64) {
+	if v != nil {
+		return v.MaxCrossClusterTaskLag
+	}
+	return
+}
+
+// GetGracefulFailoverEndTime is an internal getter (TBD...)
+func (v *ReplicationDomainStatus) GetGracefulFailoverEndTime() (o int64) {
+	if v != nil && v.GracefulFailoverEndTime != nil {
+		return *v.GracefulFailoverEndTime
+	}
+	return
+}
+
+// WorkflowExecutionPausedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionPausedEventAttributes struct {
+	Reason    string `json:"reason,omitempty"`
+	Identity  string `json:"identity,omitempty"`
+	RequestID string `json:"requestId,omitempty"`
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionPausedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionPausedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *WorkflowExecutionPausedEventAttributes) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// WorkflowExecutionUnpausedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionUnpausedEventAttributes struct {
+	Reason    string `json:"reason,omitempty"`
+	Identity  string `json:"identity,omitempty"`
+	RequestID string `json:"requestId,omitempty"`
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionUnpausedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionUnpausedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *WorkflowExecutionUnpausedEventAttributes) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// PauseWorkflowExecutionRequest is an internal type (TBD...)
+type PauseWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	RequestID         string             `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// UnpauseWorkflowExecutionRequest is an internal type (TBD...)
+type UnpauseWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	RequestID         string             `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// DeleteWorkflowExecutionRequest is an internal type (TBD...)
+type DeleteWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Archive           bool               `json:"archive,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DeleteWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *DeleteWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetArchive is an internal getter (TBD...)
+func (v *DeleteWorkflowExecutionRequest) GetArchive() (o bool) {
+	if v != nil {
+		return v.Archive
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *DeleteWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// PauseActivityRequest is an internal type (TBD...)
+type PauseActivityRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PauseActivityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *PauseActivityRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *PauseActivityRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *PauseActivityRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// UnpauseActivityRequest is an internal type (TBD...)
+type UnpauseActivityRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// ResetActivityRequest is an internal type (TBD...)
+type ResetActivityRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
+	RetryNow          bool               `json:"retryNow,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ResetActivityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ResetActivityRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *ResetActivityRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetRetryNow is an internal getter (TBD...)
+func (v *ResetActivityRequest) GetRetryNow() (o bool) {
+	if v != nil {
+		return v.RetryNow
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *ResetActivityRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// CompleteWorkflowUpdateDecisionAttributes is an internal type (TBD...)
+type CompleteWorkflowUpdateDecisionAttributes struct {
+	UpdateID     string `json:"updateId,omitempty"`
+	Result       []byte `json:"result,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// GetUpdateID is an internal getter (TBD...)
+func (v *CompleteWorkflowUpdateDecisionAttributes) GetUpdateID() (o string) {
+	if v != nil {
+		return v.UpdateID
+	}
+	return
+}
+
+// GetResult is an internal getter (TBD...)
+func (v *CompleteWorkflowUpdateDecisionAttributes) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
+	}
+	return
+}
+
+// GetErrorMessage is an internal getter (TBD...)
+func (v *CompleteWorkflowUpdateDecisionAttributes) GetErrorMessage() (o string) {
+	if v != nil {
+		return v.ErrorMessage
+	}
+	return
+}
+
+// WorkflowExecutionUpdateAcceptedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionUpdateAcceptedEventAttributes struct {
+	UpdateID   string `json:"updateId,omitempty"`
+	UpdateName string `json:"updateName,omitempty"`
+	Input      []byte `json:"input,omitempty"`
+	Identity   string `json:"identity,omitempty"`
+	RequestID  string `json:"requestId,omitempty"`
+}
+
+// GetUpdateID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetUpdateID() (o string) {
+	if v != nil {
+		return v.UpdateID
+	}
+	return
+}
+
+// GetUpdateName is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetUpdateName() (o string) {
+	if v != nil {
+		return v.UpdateName
+	}
+	return
+}
+
+// GetInput is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// WorkflowExecutionUpdateCompletedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionUpdateCompletedEventAttributes struct {
+	DecisionTaskCompletedEventID int64  `json:"decisionTaskCompletedEventId,omitempty"`
+	UpdateID                     string `json:"updateId,omitempty"`
+	Result                       []byte `json:"result,omitempty"`
+	ErrorMessage                 string `json:"errorMessage,omitempty"`
+}
+
+// GetDecisionTaskCompletedEventID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetDecisionTaskCompletedEventID() (o int64) {
+	if v != nil {
+		return v.DecisionTaskCompletedEventID
+	}
+	return
+}
+
+// GetUpdateID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetUpdateID() (o string) {
+	if v != nil {
+		return v.UpdateID
+	}
+	return
+}
+
+// GetResult is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
+	}
+	return
+}
+
+// GetErrorMessage is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetErrorMessage() (o string) {
+	if v != nil {
+		return v.ErrorMessage
+	}
+	return
+}
+
+// UpdateWorkflowExecutionRequest is an internal type (TBD...)
+type UpdateWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	UpdateName        string             `json:"updateName,omitempty"`
+	Input             []byte             `json:"input,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	RequestID         string             `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetUpdateName is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetUpdateName() (o string) {
+	if v != nil {
+		return v.UpdateName
+	}
+	return
+}
+
+// GetInput is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// UpdateWorkflowExecutionResponse is an internal type (TBD...)
+type UpdateWorkflowExecutionResponse struct {
+	Result       []byte `json:"result,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// GetResult is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionResponse) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
+	}
+	return
+}
+
+// GetErrorMessage is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionResponse) GetErrorMessage() (o string) {
+	if v != nil {
+		return v.ErrorMessage
+	}
+	return
+}
+
+// ScheduleSpec is an internal type (TBD...)
+type ScheduleSpec struct {
+	CronExpression string `json:"cronExpression,omitempty"`
+	StartTimestamp *int64 `json:"startTimestamp,omitempty"`
+	EndTimestamp   *int64 `json:"endTimestamp,omitempty"`
+}
+
+// GetCronExpression is an internal getter (TBD...)
+func (v *ScheduleSpec) GetCronExpression() (o string) {
+	if v != nil {
+		return v.CronExpression
+	}
+	return
+}
+
+// GetStartTimestamp is an internal getter (TBD...)
+func (v *ScheduleSpec) GetStartTimestamp() (o int64) {
+	if v != nil && v.StartTimestamp != nil {
+		return *v.StartTimestamp
+	}
+	return
+}
+
+// GetEndTimestamp is an internal getter (TBD...)
+func (v *ScheduleSpec) GetEndTimestamp() (o int64) {
+	if v != nil && v.EndTimestamp != nil {
+		return *v.EndTimestamp
+	}
+	return
+}
+
+// ScheduleAction is an internal type (TBD...)
+type ScheduleAction struct {
+	WorkflowType                        *WorkflowType `json:"workflowType,omitempty"`
+	TaskList                            *TaskList     `json:"taskList,omitempty"`
+	Input                               []byte        `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+}
+
+// GetWorkflowType is an internal getter (TBD...)
+func (v *ScheduleAction) GetWorkflowType() (o *WorkflowType) {
+	if v != nil && v.WorkflowType != nil {
+		return v.WorkflowType
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *ScheduleAction) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetInput is an internal getter (TBD...)
+func (v *ScheduleAction) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+	return
+}
+
+// GetExecutionStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *ScheduleAction) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
+		return *v.ExecutionStartToCloseTimeoutSeconds
+	}
+	return
+}
+
+// GetTaskStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *ScheduleAction) GetTaskStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.TaskStartToCloseTimeoutSeconds != nil {
+		return *v.TaskStartToCloseTimeoutSeconds
+	}
+	return
+}
+
+// SchedulePolicies is an internal type (TBD...)
+type SchedulePolicies struct {
+	OverlapPolicy *ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+}
+
+// GetOverlapPolicy is an internal getter (TBD...)
+func (v *SchedulePolicies) GetOverlapPolicy() (o ScheduleOverlapPolicy) {
+	if v != nil && v.OverlapPolicy != nil {
+		return *v.OverlapPolicy
+	}
+	return
+}
+
+// ScheduleState is an internal type (TBD...)
+type ScheduleState struct {
+	Paused      bool   `json:"paused,omitempty"`
+	PauseReason string `json:"pauseReason,omitempty"`
+}
+
+// GetPaused is an internal getter (TBD...)
+func (v *ScheduleState) GetPaused() (o bool) {
+	if v != nil {
+		return v.Paused
+	}
+	return
+}
+
+// GetPauseReason is an internal getter (TBD...)
+func (v *ScheduleState) GetPauseReason() (o string) {
+	if v != nil {
+		return v.PauseReason
+	}
+	return
+}
+
+// ScheduleInfo is an internal type (TBD...)
+type ScheduleInfo struct {
+	LastRunTimestamp *int64             `json:"lastRunTimestamp,omitempty"`
+	NextRunTimestamp *int64             `json:"nextRunTimestamp,omitempty"`
+	TotalRuns        int64              `json:"totalRuns,omitempty"`
+	SkippedRuns      int64              `json:"skippedRuns,omitempty"`
+	BufferedRuns     int32              `json:"bufferedRuns,omitempty"`
+	LastRunExecution *WorkflowExecution `json:"lastRunExecution,omitempty"`
+}
+
+// GetLastRunTimestamp is an internal getter (TBD...)
+func (v *ScheduleInfo) GetLastRunTimestamp() (o int64) {
+	if v != nil && v.LastRunTimestamp != nil {
+		return *v.LastRunTimestamp
+	}
+	return
+}
+
+// GetNextRunTimestamp is an internal getter (TBD...)
+func (v *ScheduleInfo) GetNextRunTimestamp() (o int64) {
+	if v != nil && v.NextRunTimestamp != nil {
+		return *v.NextRunTimestamp
+	}
+	return
+}
+
+// GetTotalRuns is an internal getter (TBD...)
+func (v *ScheduleInfo) GetTotalRuns() (o int64) {
+	if v != nil {
+		return v.TotalRuns
+	}
+	return
+}
+
+// GetSkippedRuns is an internal getter (TBD...)
+func (v *ScheduleInfo) GetSkippedRuns() (o int64) {
+	if v != nil {
+		return v.SkippedRuns
+	}
+	return
+}
+
+// GetBufferedRuns is an internal getter (TBD...)
+func (v *ScheduleInfo) GetBufferedRuns() (o int32) {
+	if v != nil {
+		return v.BufferedRuns
+	}
+	return
+}
+
+// GetLastRunExecution is an internal getter (TBD...)
+func (v *ScheduleInfo) GetLastRunExecution() (o *WorkflowExecution) {
+	if v != nil && v.LastRunExecution != nil {
+		return v.LastRunExecution
+	}
+	return
+}
+
+// ScheduleListEntry is an internal type (TBD...)
+type ScheduleListEntry struct {
+	ScheduleID string `json:"scheduleId,omitempty"`
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *ScheduleListEntry) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// CreateScheduleRequest is an internal type (TBD...)
+type CreateScheduleRequest struct {
+	Domain     string            `json:"domain,omitempty"`
+	ScheduleID string            `json:"scheduleId,omitempty"`
+	Spec       *ScheduleSpec     `json:"spec,omitempty"`
+	Action     *ScheduleAction   `json:"action,omitempty"`
+	Policies   *SchedulePolicies `json:"policies,omitempty"`
+	Identity   string            `json:"identity,omitempty"`
+	RequestID  string            `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetSpec is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetSpec() (o *ScheduleSpec) {
+	if v != nil && v.Spec != nil {
+		return v.Spec
+	}
+	return
+}
+
+// GetAction is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetAction() (o *ScheduleAction) {
+	if v != nil && v.Action != nil {
+		return v.Action
+	}
+	return
+}
+
+// GetPolicies is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetPolicies() (o *SchedulePolicies) {
+	if v != nil && v.Policies != nil {
+		return v.Policies
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// DescribeScheduleRequest is an internal type (TBD...)
+type DescribeScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DescribeScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *DescribeScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// DescribeScheduleResponse is an internal type (TBD...)
+type DescribeScheduleResponse struct {
+	Spec     *ScheduleSpec     `json:"spec,omitempty"`
+	Action   *ScheduleAction   `json:"action,omitempty"`
+	Policies *SchedulePolicies `json:"policies,omitempty"`
+	State    *ScheduleState    `json:"state,omitempty"`
+	Info     *ScheduleInfo     `json:"info,omitempty"`
+}
+
+// GetSpec is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetSpec() (o *ScheduleSpec) {
+	if v != nil && v.Spec != nil {
+		return v.Spec
+	}
+	return
+}
+
+// GetAction is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetAction() (o *ScheduleAction) {
+	if v != nil && v.Action != nil {
+		return v.Action
+	}
+	return
+}
+
+// GetPolicies is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetPolicies() (o *SchedulePolicies) {
+	if v != nil && v.Policies != nil {
+		return v.Policies
+	}
+	return
+}
+
+// GetState is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetState() (o *ScheduleState) {
+	if v != nil && v.State != nil {
+		return v.State
+	}
+	return
+}
+
+// GetInfo is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetInfo() (o *ScheduleInfo) {
+	if v != nil && v.Info != nil {
+		return v.Info
+	}
+	return
+}
+
+// UpdateScheduleRequest is an internal type (TBD...)
+type UpdateScheduleRequest struct {
+	Domain     string            `json:"domain,omitempty"`
+	ScheduleID string            `json:"scheduleId,omitempty"`
+	Spec       *ScheduleSpec     `json:"spec,omitempty"`
+	Action     *ScheduleAction   `json:"action,omitempty"`
+	Policies   *SchedulePolicies `json:"policies,omitempty"`
+	Identity   string            `json:"identity,omitempty"`
+	RequestID  string            `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetSpec is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetSpec() (o *ScheduleSpec) {
+	if v != nil && v.Spec != nil {
+		return v.Spec
+	}
+	return
+}
+
+// GetAction is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetAction() (o *ScheduleAction) {
+	if v != nil && v.Action != nil {
+		return v.Action
+	}
+	return
+}
+
+// GetPolicies is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetPolicies() (o *SchedulePolicies) {
+	if v != nil && v.Policies != nil {
+		return v.Policies
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// PauseScheduleRequest is an internal type (TBD...)
+type PauseScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleId,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+	Identity   string `json:"identity,omitempty"`
+	RequestID  string `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// UnpauseScheduleRequest is an internal type (TBD...)
+type UnpauseScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleId,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+	Identity   string `json:"identity,omitempty"`
+	RequestID  string `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UnpauseScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *UnpauseScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *UnpauseScheduleRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UnpauseScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *UnpauseScheduleRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// BackfillScheduleRequest is an internal type (TBD...)
+type BackfillScheduleRequest struct {
+	Domain         string                 `json:"domain,omitempty"`
+	ScheduleID     string                 `json:"scheduleId,omitempty"`
+	StartTimestamp *int64                 `json:"startTimestamp,omitempty"`
+	EndTimestamp   *int64                 `json:"endTimestamp,omitempty"`
+	OverlapPolicy  *ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+	Identity       string                 `json:"identity,omitempty"`
+	RequestID      string                 `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetStartTimestamp is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetStartTimestamp() (o int64) {
+	if v != nil && v.StartTimestamp != nil {
+		return *v.StartTimestamp
+	}
+	return
+}
+
+// GetEndTimestamp is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetEndTimestamp() (o int64) {
+	if v != nil && v.EndTimestamp != nil {
+		return *v.EndTimestamp
+	}
+	return
+}
+
+// GetOverlapPolicy is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetOverlapPolicy() (o ScheduleOverlapPolicy) {
+	if v != nil && v.OverlapPolicy != nil {
+		return *v.OverlapPolicy
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// ListSchedulesRequest is an internal type (TBD...)
+type ListSchedulesRequest struct {
+	Domain        string `json:"domain,omitempty"`
+	PageSize      int32  `json:"pageSize,omitempty"`
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ListSchedulesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetPageSize is an internal getter (TBD...)
+func (v *ListSchedulesRequest) GetPageSize() (o int32) {
+	if v != nil {
+		return v.PageSize
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListSchedulesRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// ListSchedulesResponse is an internal type (TBD...)
+type ListSchedulesResponse struct {
+	Schedules     []*ScheduleListEntry `json:"schedules,omitempty"`
+	NextPageToken []byte               `json:"nextPageToken,omitempty"`
+}
+
+// GetSchedules is an internal getter (TBD...)
+func (v *ListSchedulesResponse) GetSchedules() (o []*ScheduleListEntry) {
+	if v != nil && v.Schedules != nil {
+		return v.Schedules
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListSchedulesResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// ScheduleOverlapPolicy is an internal type (TBD...)
+type ScheduleOverlapPolicy int32
+
+// Ptr is a helper function for getting pointer value
+func (e ScheduleOverlapPolicy) Ptr() *ScheduleOverlapPolicy {
+	return &e
+}
+
+// String returns a readable string representation of ScheduleOverlapPolicy.
+func (e ScheduleOverlapPolicy) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "SkipNew"
+	case 1:
+		return "Buffer"
+	case 2:
+		return "CancelOther"
+	case 3:
+		return "AllowAll"
+	}
+	return fmt.Sprintf("ScheduleOverlapPolicy(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *ScheduleOverlapPolicy) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "SKIPNEW":
+		*e = ScheduleOverlapPolicySkipNew
+		return nil
+	case "BUFFER":
+		*e = ScheduleOverlapPolicyBuffer
+		return nil
+	case "CANCELOTHER":
+		*e = ScheduleOverlapPolicyCancelOther
+		return nil
+	case "ALLOWALL":
+		*e = ScheduleOverlapPolicyAllowAll
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ScheduleOverlapPolicy", err)
+		}
+		*e = ScheduleOverlapPolicy(val)
+		return nil
+	}
+}
+
+// MarshalText encodes ScheduleOverlapPolicy to text.
+func (e ScheduleOverlapPolicy) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// ScheduleOverlapPolicySkipNew is an option for ScheduleOverlapPolicy
+	ScheduleOverlapPolicySkipNew ScheduleOverlapPolicy = iota
+	// ScheduleOverlapPolicyBuffer is an option for ScheduleOverlapPolicy
+	ScheduleOverlapPolicyBuffer
+	// ScheduleOverlapPolicyCancelOther is an option for ScheduleOverlapPolicy
+	ScheduleOverlapPolicyCancelOther
+	// ScheduleOverlapPolicyAllowAll is an option for ScheduleOverlapPolicy
+	ScheduleOverlapPolicyAllowAll
+)
+
+// CloneDomainRequest is an internal type (TBD...)
+type CloneDomainRequest struct {
+	SourceName string `json:"sourceName,omitempty"`
+	Name       string `json:"name,omitempty"`
+}
+
+// GetSourceName is an internal getter (TBD...)
+func (v *CloneDomainRequest) GetSourceName() (o string) {
+	if v != nil {
+		return v.SourceName
+	}
+	return
+}
+
+// GetName is an internal getter (TBD...)
+func (v *CloneDomainRequest) GetName() (o string) {
+	if v != nil {
+		return v.Name
+	}
+	return
+}
+
+// RenameDomainRequest is an internal type (TBD...)
+type RenameDomainRequest struct {
+	Name    string `json:"name,omitempty"`
+	NewName string `json:"newName,omitempty"`
+}
+
+// GetName is an internal getter (TBD...)
+func (v *RenameDomainRequest) GetName() (o string) {
+	if v != nil {
+		return v.Name
+	}
+	return
+}
+
+// GetNewName is an internal getter (TBD...)
+func (v *RenameDomainRequest) GetNewName() (o string) {
+	if v != nil {
+		return v.NewName
+	}
+	return
+}
+
+// DomainLimits is an internal type (TBD...)
+// A zero value for any limit means the dynamic config default applies.
+type DomainLimits struct {
+	RequestsPerSecond      int32 `json:"requestsPerSecond,omitempty"`
+	MaxConcurrentWorkflows int32 `json:"maxConcurrentWorkflows,omitempty"`
+	MaxPendingActivities   int32 `json:"maxPendingActivities,omitempty"`
+	MaxHistorySize         int64 `json:"maxHistorySize,omitempty"`
+}
+
+// GetRequestsPerSecond is an internal getter (TBD...)
+func (v *DomainLimits) GetRequestsPerSecond() (o int32) {
+	if v != nil {
+		return v.RequestsPerSecond
+	}
+	return
+}
+
+// GetMaxConcurrentWorkflows is an internal getter (TBD...)
+func (v *DomainLimits) GetMaxConcurrentWorkflows() (o int32) {
+	if v != nil {
+		return v.MaxConcurrentWorkflows
+	}
+	return
+}
+
+// GetMaxPendingActivities is an internal getter (TBD...)
+func (v *DomainLimits) GetMaxPendingActivities() (o int32) {
+	if v != nil {
+		return v.MaxPendingActivities
+	}
+	return
+}
+
+// GetMaxHistorySize is an internal getter (TBD...)
+func (v *DomainLimits) GetMaxHistorySize() (o int64) {
+	if v != nil {
+		return v.MaxHistorySize
+	}
+	return
+}
@@ -41,8 +41,12 @@ const (
 	WorkflowTypeName     = "WorkflowTypeName"
 	ActivityTypeName     = "ActivityTypeName"
 	TaskListName         = "TaskListName"
+	TargetTaskListName   = "TargetTaskListName"
 	MarkerName           = "MarkerName"
 	SignalName           = "SignalName"
+	UpdateName           = "UpdateName"
+	UpdateID             = "UpdateID"
+	ScheduleID           = "ScheduleID"
 	QueryType            = "QueryType"
 	HostName             = "HostName"
 	Identity             = "Identity"
@@ -56,6 +60,8 @@ const (
 	ClientImpl           = "ClientImpl"
 	ClientLibraryVersion = "ClientLibraryVersion"
 	SupportedVersions    = "SupportedVersions"
+	RPCName              = "RPCName"
+	RPCAddress           = "RPCAddress"
 
 	Attempt           = 2
 	PageSize          = 10
@@ -69,6 +75,8 @@ const (
 	MessageID1        = 50001
 	MessageID2        = 50002
 	EventStoreVersion = 333
+	HistorySize       = 4096
+	HistoryCount      = 40
 
 	EventID1 = int64(1)
 	EventID2 = int64(2)
@@ -141,6 +149,25 @@ var (
 		Name: TaskListName,
 		Kind: types.TaskListKindNormal.Ptr(),
 	}
+	TargetTaskList = types.TaskList{
+		Name: TargetTaskListName,
+		Kind: types.TaskListKindNormal.Ptr(),
+	}
+	ScheduleSpec = types.ScheduleSpec{
+		CronExpression: CronSchedule,
+		StartTimestamp: &Timestamp1,
+		EndTimestamp:   &Timestamp2,
+	}
+	ScheduleAction = types.ScheduleAction{
+		WorkflowType:                        &WorkflowType,
+		TaskList:                            &TaskList,
+		Input:                               Payload1,
+		ExecutionStartToCloseTimeoutSeconds: &Duration1,
+		TaskStartToCloseTimeoutSeconds:      &Duration2,
+	}
+	SchedulePolicies = types.SchedulePolicies{
+		OverlapPolicy: types.ScheduleOverlapPolicyBuffer.Ptr(),
+	}
 	RetryPolicy = types.RetryPolicy{
 		InitialIntervalInSeconds:    1,
 		BackoffCoefficient:          1.1,
@@ -227,6 +254,7 @@ var (
 		AckLevel:         AckLevel,
 		RatePerSecond:    RatePerSecond,
 		TaskIDBlock:      &TaskIDBlock,
+		Paused:           true,
 	}
 	TaskIDBlock = types.TaskIDBlock{
 		StartID: 551,
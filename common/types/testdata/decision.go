@@ -40,6 +40,8 @@ var (
 		&Decision_StartChildWorkflowExecution,
 		&Decision_StartTimer,
 		&Decision_UpsertWorkflowSearchAttributes,
+		&Decision_CompleteWorkflowUpdate,
+		&Decision_UpsertWorkflowMemo,
 	}
 
 	Decision_CancelTimer = types.Decision{
@@ -94,6 +96,14 @@ var (
 		DecisionType: types.DecisionTypeUpsertWorkflowSearchAttributes.Ptr(),
 		UpsertWorkflowSearchAttributesDecisionAttributes: &UpsertWorkflowSearchAttributesDecisionAttributes,
 	}
+	Decision_CompleteWorkflowUpdate = types.Decision{
+		DecisionType:                             types.DecisionTypeCompleteWorkflowUpdate.Ptr(),
+		CompleteWorkflowUpdateDecisionAttributes: &CompleteWorkflowUpdateDecisionAttributes,
+	}
+	Decision_UpsertWorkflowMemo = types.Decision{
+		DecisionType:                         types.DecisionTypeUpsertWorkflowMemo.Ptr(),
+		UpsertWorkflowMemoDecisionAttributes: &UpsertWorkflowMemoDecisionAttributes,
+	}
 
 	CancelTimerDecisionAttributes = types.CancelTimerDecisionAttributes{
 		TimerID: TimerID,
@@ -186,4 +196,12 @@ var (
 	UpsertWorkflowSearchAttributesDecisionAttributes = types.UpsertWorkflowSearchAttributesDecisionAttributes{
 		SearchAttributes: &SearchAttributes,
 	}
+	CompleteWorkflowUpdateDecisionAttributes = types.CompleteWorkflowUpdateDecisionAttributes{
+		UpdateID:     UpdateID,
+		Result:       Payload1,
+		ErrorMessage: ErrorMessage,
+	}
+	UpsertWorkflowMemoDecisionAttributes = types.UpsertWorkflowMemoDecisionAttributes{
+		Memo: &Memo,
+	}
 )
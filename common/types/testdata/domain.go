@@ -27,6 +27,7 @@ import (
 const (
 	DomainID          = "DomainID"
 	DomainName        = "DomainName"
+	NewDomainName     = "NewDomainName"
 	DomainDescription = "DomainDescription"
 	DomainOwnerEmail  = "DomainOwnerEmail"
 	DomainDataKey     = "DomainDataKey"
@@ -34,6 +35,11 @@ const (
 	DomainRetention   = 3
 	DomainEmitMetric  = true
 
+	DomainRequestsPerSecond      = 100
+	DomainMaxConcurrentWorkflows = 1000
+	DomainMaxPendingActivities   = 500
+	DomainMaxHistorySize         = 50 * 1024 * 1024
+
 	ClusterName1 = "ClusterName1"
 	ClusterName2 = "ClusterName2"
 
@@ -78,6 +84,13 @@ var (
 		HistoryArchivalURI:                     HistoryArchivalURI,
 		VisibilityArchivalStatus:               &ArchivalStatus,
 		VisibilityArchivalURI:                  VisibilityArchivalURI,
+		Limits:                                 &DomainLimits,
+	}
+	DomainLimits = types.DomainLimits{
+		RequestsPerSecond:      DomainRequestsPerSecond,
+		MaxConcurrentWorkflows: DomainMaxConcurrentWorkflows,
+		MaxPendingActivities:   DomainMaxPendingActivities,
+		MaxHistorySize:         DomainMaxHistorySize,
 	}
 	DomainReplicationConfiguration = types.DomainReplicationConfiguration{
 		ActiveClusterName: ClusterName1,
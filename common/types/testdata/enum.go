@@ -47,5 +47,6 @@ var (
 	TaskListType                               = types.TaskListTypeActivity
 	TimeoutType                                = types.TimeoutTypeScheduleToStart
 	WorkflowExecutionCloseStatus               = types.WorkflowExecutionCloseStatusContinuedAsNew
+	WorkflowIDConflictPolicy                   = types.WorkflowIDConflictPolicyTerminateExisting
 	WorkflowIDReusePolicy                      = types.WorkflowIDReusePolicyTerminateIfRunning
 )
@@ -73,6 +73,11 @@ var (
 		&HistoryEvent_SignalExternalWorkflowExecutionFailed,
 		&HistoryEvent_ExternalWorkflowExecutionSignaled,
 		&HistoryEvent_UpsertWorkflowSearchAttributes,
+		&HistoryEvent_WorkflowExecutionPaused,
+		&HistoryEvent_WorkflowExecutionUnpaused,
+		&HistoryEvent_WorkflowExecutionUpdateAccepted,
+		&HistoryEvent_WorkflowExecutionUpdateCompleted,
+		&HistoryEvent_UpsertWorkflowMemo,
 	}
 
 	HistoryEvent_WorkflowExecutionStarted = generateEvent(func(e *types.HistoryEvent) {
@@ -243,6 +248,26 @@ var (
 		e.EventType = types.EventTypeUpsertWorkflowSearchAttributes.Ptr()
 		e.UpsertWorkflowSearchAttributesEventAttributes = &UpsertWorkflowSearchAttributesEventAttributes
 	})
+	HistoryEvent_WorkflowExecutionPaused = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionPaused.Ptr()
+		e.WorkflowExecutionPausedEventAttributes = &WorkflowExecutionPausedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionUnpaused = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionUnpaused.Ptr()
+		e.WorkflowExecutionUnpausedEventAttributes = &WorkflowExecutionUnpausedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionUpdateAccepted = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionUpdateAccepted.Ptr()
+		e.WorkflowExecutionUpdateAcceptedEventAttributes = &WorkflowExecutionUpdateAcceptedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionUpdateCompleted = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionUpdateCompleted.Ptr()
+		e.WorkflowExecutionUpdateCompletedEventAttributes = &WorkflowExecutionUpdateCompletedEventAttributes
+	})
+	HistoryEvent_UpsertWorkflowMemo = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeUpsertWorkflowMemo.Ptr()
+		e.UpsertWorkflowMemoEventAttributes = &UpsertWorkflowMemoEventAttributes
+	})
 
 	WorkflowExecutionStartedEventAttributes = types.WorkflowExecutionStartedEventAttributes{
 		WorkflowType:                        &WorkflowType,
@@ -271,6 +296,7 @@ var (
 		SearchAttributes:                    &SearchAttributes,
 		PrevAutoResetPoints:                 &ResetPoints,
 		Header:                              &Header,
+		RestartedFromRunID:                  RunID1,
 	}
 	WorkflowExecutionCompletedEventAttributes = types.WorkflowExecutionCompletedEventAttributes{
 		Result:                       Payload1,
@@ -567,6 +593,33 @@ var (
 		DecisionTaskCompletedEventID: EventID1,
 		SearchAttributes:             &SearchAttributes,
 	}
+	WorkflowExecutionPausedEventAttributes = types.WorkflowExecutionPausedEventAttributes{
+		Reason:    Reason,
+		Identity:  Identity,
+		RequestID: RequestID,
+	}
+	WorkflowExecutionUnpausedEventAttributes = types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:    Reason,
+		Identity:  Identity,
+		RequestID: RequestID,
+	}
+	WorkflowExecutionUpdateAcceptedEventAttributes = types.WorkflowExecutionUpdateAcceptedEventAttributes{
+		UpdateID:   UpdateID,
+		UpdateName: UpdateName,
+		Input:      Payload1,
+		Identity:   Identity,
+		RequestID:  RequestID,
+	}
+	WorkflowExecutionUpdateCompletedEventAttributes = types.WorkflowExecutionUpdateCompletedEventAttributes{
+		DecisionTaskCompletedEventID: EventID1,
+		UpdateID:                     UpdateID,
+		Result:                       Payload2,
+		ErrorMessage:                 ErrorMessage,
+	}
+	UpsertWorkflowMemoEventAttributes = types.UpsertWorkflowMemoEventAttributes{
+		DecisionTaskCompletedEventID: EventID1,
+		Memo:                         &Memo,
+	}
 )
 
 func generateEvent(modifier func(e *types.HistoryEvent)) types.HistoryEvent {
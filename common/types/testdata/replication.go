@@ -127,6 +127,7 @@ var (
 		LastWorkerIdentity: Identity,
 		LastFailureDetails: FailureDetails,
 		VersionHistory:     &VersionHistory,
+		Paused:             true,
 	}
 	HistoryTaskV2Attributes = types.HistoryTaskV2Attributes{
 		TaskID:              TaskID,
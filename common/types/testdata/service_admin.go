@@ -175,4 +175,155 @@ var (
 	}
 	AdminGetCrossClusterTasksRequest  = GetCrossClusterTasksRequest
 	AdminGetCrossClusterTasksResponse = GetCrossClusterTasksResponse
+	AdminPauseTaskListRequest         = types.PauseTaskListRequest{
+		Domain:       DomainName,
+		TaskList:     &TaskList,
+		TaskListType: &TaskListType,
+	}
+	AdminResumeTaskListRequest = types.ResumeTaskListRequest{
+		Domain:       DomainName,
+		TaskList:     &TaskList,
+		TaskListType: &TaskListType,
+	}
+	AdminMoveTaskListBacklogRequest = types.MoveTaskListBacklogRequest{
+		Domain:         DomainName,
+		SourceTaskList: &TaskList,
+		TargetTaskList: &TargetTaskList,
+		TaskListType:   &TaskListType,
+		BatchSize:      PageSize,
+		AfterTaskID:    TaskID,
+		DryRun:         true,
+	}
+	AdminMoveTaskListBacklogResponse = types.MoveTaskListBacklogResponse{
+		Count:      BacklogCountHint,
+		LastTaskID: TaskID,
+		HasMore:    true,
+	}
+	AdminHistoryTaskDLQEntry = types.HistoryTaskDLQEntry{
+		TaskID:              TaskID,
+		Category:            common.Int32Ptr(int32(common.TaskTypeTransfer)),
+		TaskType:            1,
+		DomainID:            DomainID,
+		WorkflowID:          WorkflowID,
+		RunID:               RunID,
+		VisibilityTimestamp: Timestamp1,
+		Attempt:             Attempt,
+		LastError:           Reason,
+		CreatedTimestamp:    Timestamp2,
+	}
+	AdminReadHistoryTaskDLQRequest = types.ReadHistoryTaskDLQRequest{
+		ShardID:              ShardID,
+		ExclusiveBeginTaskID: common.Int64Ptr(MessageID1),
+		InclusiveEndTaskID:   common.Int64Ptr(MessageID2),
+		MaximumPageSize:      PageSize,
+		NextPageToken:        NextPageToken,
+	}
+	AdminReadHistoryTaskDLQResponse = types.ReadHistoryTaskDLQResponse{
+		Entries:       []*types.HistoryTaskDLQEntry{&AdminHistoryTaskDLQEntry},
+		NextPageToken: NextPageToken,
+	}
+	AdminPurgeHistoryTaskDLQRequest = types.PurgeHistoryTaskDLQRequest{
+		ShardID:              ShardID,
+		ExclusiveBeginTaskID: common.Int64Ptr(MessageID1),
+		InclusiveEndTaskID:   common.Int64Ptr(MessageID2),
+	}
+	AdminRetryHistoryTaskDLQRequest = types.RetryHistoryTaskDLQRequest{
+		ShardID:              ShardID,
+		ExclusiveBeginTaskID: common.Int64Ptr(MessageID1),
+		InclusiveEndTaskID:   common.Int64Ptr(MessageID2),
+		MaximumPageSize:      PageSize,
+		NextPageToken:        NextPageToken,
+	}
+	AdminRetryHistoryTaskDLQResponse = types.RetryHistoryTaskDLQResponse{
+		NextPageToken: NextPageToken,
+	}
+	AdminDescribeReplicationStatusRequest = types.DescribeReplicationStatusRequest{
+		ClusterName: ClusterName1,
+		Domain:      DomainName,
+		ShardIDs:    []int32{ShardID},
+	}
+	AdminReplicationShardStatus = types.ReplicationShardStatus{
+		ShardID:               ShardID,
+		ClusterName:           ClusterName1,
+		MaxTaskID:             MessageID2,
+		OutboundFetchedTaskID: MessageID2,
+		OutboundAckedTaskID:   MessageID1,
+		OutboundLastFetchTime: common.Int64Ptr(Timestamp1),
+		InboundFetchedTaskID:  MessageID2,
+		InboundAckedTaskID:    MessageID1,
+		InboundLastFetchTime:  common.Int64Ptr(Timestamp2),
+		InboundDLQSize:        3,
+		CrossClusterTaskLag:   MessageID2 - MessageID1,
+	}
+	AdminReplicationDomainStatus = types.ReplicationDomainStatus{
+		Domain:                  DomainName,
+		ActiveClusterName:       ClusterName2,
+		ClusterName:             ClusterName1,
+		MaxOutboundTaskLag:      MessageID2 - MessageID1,
+		OldestOutboundFetchTime: common.Int64Ptr(Timestamp1),
+		OldestInboundFetchTime:  common.Int64Ptr(Timestamp2),
+		InboundDLQSize:          3,
+		MaxCrossClusterTaskLag:  MessageID2 - MessageID1,
+		GracefulFailoverEndTime: common.Int64Ptr(Timestamp3),
+	}
+	AdminDescribeReplicationStatusResponse = types.DescribeReplicationStatusResponse{
+		Shards:  []*types.ReplicationShardStatus{&AdminReplicationShardStatus},
+		Domains: []*types.ReplicationDomainStatus{&AdminReplicationDomainStatus},
+	}
+	AdminMigrateWorkflowExecutionRequest = types.MigrateWorkflowExecutionRequest{
+		Domain:        DomainName,
+		WorkflowID:    WorkflowID,
+		RunID:         RunID,
+		SourceCluster: ClusterName2,
+		SourceDomain:  DomainName,
+	}
+	AdminMigrateWorkflowExecutionResponse = types.MigrateWorkflowExecutionResponse{
+		RunID:       RunID,
+		NextEventID: EventID3,
+	}
+	AdminAddRemoteClusterRequest = types.AddRemoteClusterRequest{
+		ClusterName:            ClusterName2,
+		InitialFailoverVersion: Version1,
+		RPCName:                RPCName,
+		RPCAddress:             RPCAddress,
+	}
+	AdminRemoveRemoteClusterRequest = types.RemoveRemoteClusterRequest{
+		ClusterName: ClusterName2,
+	}
+	AdminCloneDomainRequest = types.CloneDomainRequest{
+		SourceName: DomainName,
+		Name:       NewDomainName,
+	}
+	AdminRenameDomainRequest = types.RenameDomainRequest{
+		Name:    DomainName,
+		NewName: NewDomainName,
+	}
+	AdminDeleteDomainRequest = types.DeleteDomainRequest{
+		Name:        DomainName,
+		Concurrency: 5,
+		PageSize:    PageSize,
+	}
+	AdminListDomainChangesRequest = types.ListDomainChangesRequest{
+		Domain:        DomainName,
+		PageSize:      PageSize,
+		NextPageToken: NextPageToken,
+	}
+	AdminListDomainChangesResponse = types.ListDomainChangesResponse{
+		Changes: []*types.DomainChange{
+			{
+				ID:         MessageID1,
+				DomainID:   DomainID,
+				DomainName: DomainName,
+				Operation:  "UpdateDomain",
+				Actor:      Identity,
+				Reason:     Reason,
+				Timestamp:  Timestamp1,
+				FieldChanges: []*types.DomainFieldChange{
+					{Field: "ownerEmail", OldValue: "", NewValue: DomainOwnerEmail},
+					{Field: "data." + DomainDataKey, OldValue: "", NewValue: DomainDataValue},
+				},
+			},
+		},
+		NextPageToken: NextPageToken,
+	}
 )
@@ -80,6 +80,7 @@ var (
 		SecurityToken:                          SecurityToken,
 		DeleteBadBinary:                        common.StringPtr(DeleteBadBinary),
 		FailoverTimeoutInSeconds:               &Duration1,
+		Limits:                                 &DomainLimits,
 	}
 	UpdateDomainResponse = types.UpdateDomainResponse{
 		DomainInfo:               &DomainInfo,
@@ -187,6 +188,9 @@ var (
 		StartedTimestamp:          &Timestamp2,
 		Queries:                   WorkflowQueryMap,
 		NextEventID:               EventID3,
+		HistorySize:               HistorySize,
+		HistoryCount:              HistoryCount,
+		SuggestContinueAsNew:      true,
 	}
 	RespondDecisionTaskCompletedRequest = types.RespondDecisionTaskCompletedRequest{
 		TaskToken:                  TaskToken,
@@ -304,6 +308,128 @@ var (
 		Identity:          Identity,
 		RequestID:         RequestID,
 	}
+	PauseWorkflowExecutionRequest = types.PauseWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Reason:            Reason,
+		Identity:          Identity,
+		RequestID:         RequestID,
+	}
+	UnpauseWorkflowExecutionRequest = types.UnpauseWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Reason:            Reason,
+		Identity:          Identity,
+		RequestID:         RequestID,
+	}
+	DeleteWorkflowExecutionRequest = types.DeleteWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Archive:           true,
+		Identity:          Identity,
+	}
+	PauseActivityRequest = types.PauseActivityRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+		Identity:          Identity,
+	}
+	UnpauseActivityRequest = types.UnpauseActivityRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+		Identity:          Identity,
+	}
+	ResetActivityRequest = types.ResetActivityRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+		RetryNow:          true,
+		Identity:          Identity,
+	}
+	UpdateWorkflowExecutionRequest = types.UpdateWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		UpdateName:        UpdateName,
+		Input:             Payload1,
+		Identity:          Identity,
+		RequestID:         RequestID,
+	}
+	UpdateWorkflowExecutionResponse = types.UpdateWorkflowExecutionResponse{
+		Result:       Payload2,
+		ErrorMessage: ErrorMessage,
+	}
+	CreateScheduleRequest = types.CreateScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+		Spec:       &ScheduleSpec,
+		Action:     &ScheduleAction,
+		Policies:   &SchedulePolicies,
+		Identity:   Identity,
+		RequestID:  RequestID,
+	}
+	DescribeScheduleRequest = types.DescribeScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+	}
+	DescribeScheduleResponse = types.DescribeScheduleResponse{
+		Spec:     &ScheduleSpec,
+		Action:   &ScheduleAction,
+		Policies: &SchedulePolicies,
+		State: &types.ScheduleState{
+			Paused:      true,
+			PauseReason: Reason,
+		},
+		Info: &types.ScheduleInfo{
+			LastRunTimestamp: &Timestamp1,
+			NextRunTimestamp: &Timestamp2,
+			TotalRuns:        HistoryLength,
+			SkippedRuns:      Attempt,
+			BufferedRuns:     Attempt,
+			LastRunExecution: &WorkflowExecution,
+		},
+	}
+	UpdateScheduleRequest = types.UpdateScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+		Spec:       &ScheduleSpec,
+		Action:     &ScheduleAction,
+		Policies:   &SchedulePolicies,
+		Identity:   Identity,
+		RequestID:  RequestID,
+	}
+	PauseScheduleRequest = types.PauseScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+		Reason:     Reason,
+		Identity:   Identity,
+		RequestID:  RequestID,
+	}
+	UnpauseScheduleRequest = types.UnpauseScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+		Reason:     Reason,
+		Identity:   Identity,
+		RequestID:  RequestID,
+	}
+	BackfillScheduleRequest = types.BackfillScheduleRequest{
+		Domain:         DomainName,
+		ScheduleID:     ScheduleID,
+		StartTimestamp: &Timestamp1,
+		EndTimestamp:   &Timestamp2,
+		OverlapPolicy:  types.ScheduleOverlapPolicyAllowAll.Ptr(),
+		Identity:       Identity,
+		RequestID:      RequestID,
+	}
+	ListSchedulesRequest = types.ListSchedulesRequest{
+		Domain:        DomainName,
+		PageSize:      PageSize,
+		NextPageToken: NextPageToken,
+	}
+	ListSchedulesResponse = types.ListSchedulesResponse{
+		Schedules:     []*types.ScheduleListEntry{{ScheduleID: ScheduleID}},
+		NextPageToken: NextPageToken,
+	}
 	StartWorkflowExecutionRequest = types.StartWorkflowExecutionRequest{
 		Domain:                              DomainName,
 		WorkflowID:                          WorkflowID,
@@ -320,6 +446,7 @@ var (
 		Memo:                                &Memo,
 		SearchAttributes:                    &SearchAttributes,
 		Header:                              &Header,
+		WorkflowIDConflictPolicy:            &WorkflowIDConflictPolicy,
 	}
 	StartWorkflowExecutionResponse = types.StartWorkflowExecutionResponse{
 		RunID: RunID,
@@ -352,6 +479,7 @@ var (
 		Memo:                                &Memo,
 		SearchAttributes:                    &SearchAttributes,
 		Header:                              &Header,
+		WorkflowIDConflictPolicy:            &WorkflowIDConflictPolicy,
 	}
 	ResetWorkflowExecutionRequest = types.ResetWorkflowExecutionRequest{
 		Domain:                DomainName,
@@ -364,6 +492,14 @@ var (
 	ResetWorkflowExecutionResponse = types.ResetWorkflowExecutionResponse{
 		RunID: RunID,
 	}
+	RestartWorkflowExecutionRequest = types.RestartWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Identity:          Identity,
+	}
+	RestartWorkflowExecutionResponse = types.RestartWorkflowExecutionResponse{
+		RunID: RunID,
+	}
 	TerminateWorkflowExecutionRequest = types.TerminateWorkflowExecutionRequest{
 		Domain:            DomainName,
 		WorkflowExecution: &WorkflowExecution,
@@ -381,6 +517,7 @@ var (
 		PendingActivities:      PendingActivityInfoArray,
 		PendingChildren:        PendingChildExecutionInfoArray,
 		PendingDecision:        &PendingDecisionInfo,
+		SignalRequestIDs:       map[string]int64{RequestID: Timestamp1},
 	}
 	QueryWorkflowRequest = types.QueryWorkflowRequest{
 		Domain:                DomainName,
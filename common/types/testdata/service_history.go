@@ -169,6 +169,9 @@ var (
 		ScheduledTimestamp:        &Timestamp1,
 		StartedTimestamp:          &Timestamp2,
 		Queries:                   WorkflowQueryMap,
+		HistorySize:               HistorySize,
+		HistoryCount:              HistoryCount,
+		SuggestContinueAsNew:      true,
 	}
 	HistoryRefreshWorkflowTasksRequest = types.HistoryRefreshWorkflowTasksRequest{
 		DomainUIID: DomainID,
@@ -194,6 +197,48 @@ var (
 		ExternalWorkflowExecution: &WorkflowExecution,
 		ChildWorkflowOnly:         true,
 	}
+	HistoryPauseWorkflowExecutionRequest = types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID:   DomainID,
+		PauseRequest: &PauseWorkflowExecutionRequest,
+	}
+	HistoryUnpauseWorkflowExecutionRequest = types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     DomainID,
+		UnpauseRequest: &UnpauseWorkflowExecutionRequest,
+	}
+	HistoryDeleteWorkflowExecutionRequest = types.HistoryDeleteWorkflowExecutionRequest{
+		DomainUUID:    DomainID,
+		DeleteRequest: &DeleteWorkflowExecutionRequest,
+	}
+	HistoryPauseActivityRequest = types.HistoryPauseActivityRequest{
+		DomainUUID:   DomainID,
+		PauseRequest: &PauseActivityRequest,
+	}
+	HistoryUnpauseActivityRequest = types.HistoryUnpauseActivityRequest{
+		DomainUUID:     DomainID,
+		UnpauseRequest: &UnpauseActivityRequest,
+	}
+	HistoryResetActivityRequest = types.HistoryResetActivityRequest{
+		DomainUUID:   DomainID,
+		ResetRequest: &ResetActivityRequest,
+	}
+	HistoryUpdateWorkflowExecutionRequest = types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: DomainID,
+		Request:    &UpdateWorkflowExecutionRequest,
+	}
+	HistoryUpdateWorkflowExecutionResponse = types.HistoryUpdateWorkflowExecutionResponse{
+		Response: &UpdateWorkflowExecutionResponse,
+	}
+	HistoryMigrateWorkflowExecutionRequest = types.HistoryMigrateWorkflowExecutionRequest{
+		DomainUUID:    DomainID,
+		WorkflowID:    WorkflowID,
+		RunID:         RunID,
+		SourceCluster: ClusterName2,
+		SourceDomain:  DomainName,
+	}
+	HistoryMigrateWorkflowExecutionResponse = types.MigrateWorkflowExecutionResponse{
+		RunID:       RunID,
+		NextEventID: EventID3,
+	}
 	HistoryResetQueueRequest          = AdminResetQueueRequest
 	HistoryResetStickyTaskListRequest = types.HistoryResetStickyTaskListRequest{
 		DomainUUID: DomainID,
@@ -259,6 +304,7 @@ var (
 		ContinuedFailureDetails:         FailureDetails,
 		LastCompletionResult:            Payload1,
 		FirstDecisionTaskBackoffSeconds: &Duration1,
+		RestartedFromRunID:              RunID1,
 	}
 	HistoryStartWorkflowExecutionResponse = types.StartWorkflowExecutionResponse{
 		RunID: RunID,
@@ -279,6 +325,7 @@ var (
 		LastWorkerIdentity: Identity,
 		LastFailureDetails: FailureDetails,
 		VersionHistory:     &VersionHistory,
+		Paused:             true,
 	}
 	HistorySyncShardStatusRequest = types.SyncShardStatusRequest{
 		SourceCluster: ClusterName1,
@@ -293,4 +340,11 @@ var (
 	HistoryGetCrossClusterTasksResponse              = GetCrossClusterTasksResponse
 	HistoryRespondCrossClusterTasksCompletedRequest  = RespondCrossClusterTasksCompletedRequest
 	HistoryRespondCrossClusterTasksCompletedResponse = RespondCrossClusterTasksCompletedResponse
+	HistoryReadHistoryTaskDLQRequest                 = AdminReadHistoryTaskDLQRequest
+	HistoryReadHistoryTaskDLQResponse                = AdminReadHistoryTaskDLQResponse
+	HistoryPurgeHistoryTaskDLQRequest                = AdminPurgeHistoryTaskDLQRequest
+	HistoryRetryHistoryTaskDLQRequest                = AdminRetryHistoryTaskDLQRequest
+	HistoryRetryHistoryTaskDLQResponse               = AdminRetryHistoryTaskDLQResponse
+	HistoryDescribeReplicationStatusRequest          = AdminDescribeReplicationStatusRequest
+	HistoryDescribeReplicationStatusResponse         = AdminDescribeReplicationStatusResponse
 )
@@ -120,6 +120,9 @@ var (
 		ScheduledTimestamp:        &Timestamp1,
 		StartedTimestamp:          &Timestamp2,
 		Queries:                   WorkflowQueryMap,
+		HistorySize:               HistorySize,
+		HistoryCount:              HistoryCount,
+		SuggestContinueAsNew:      true,
 	}
 	MatchingQueryWorkflowRequest = types.MatchingQueryWorkflowRequest{
 		DomainUUID:    DomainID,
@@ -144,4 +147,32 @@ var (
 	MatchingGetTaskListsByDomainResponse = types.GetTaskListsByDomainResponse{
 		TaskListNames: []string{},
 	}
+
+	MatchingPauseTaskListRequest = types.MatchingPauseTaskListRequest{
+		DomainUUID:   DomainID,
+		TaskList:     &TaskList,
+		TaskListType: &TaskListType,
+	}
+
+	MatchingResumeTaskListRequest = types.MatchingResumeTaskListRequest{
+		DomainUUID:   DomainID,
+		TaskList:     &TaskList,
+		TaskListType: &TaskListType,
+	}
+
+	MatchingMoveTaskListBacklogRequest = types.MatchingMoveTaskListBacklogRequest{
+		DomainUUID:     DomainID,
+		SourceTaskList: &TaskList,
+		TargetTaskList: &TargetTaskList,
+		TaskListType:   &TaskListType,
+		BatchSize:      PageSize,
+		AfterTaskID:    TaskID,
+		DryRun:         true,
+	}
+
+	MatchingMoveTaskListBacklogResponse = types.MoveTaskListBacklogResponse{
+		Count:      BacklogCountHint,
+		LastTaskID: TaskID,
+		HasMore:    true,
+	}
 )
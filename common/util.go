@@ -379,6 +379,9 @@ func CreateMatchingPollForDecisionTaskResponse(historyResponse *types.RecordDeci
 		ScheduledTimestamp:        historyResponse.ScheduledTimestamp,
 		StartedTimestamp:          historyResponse.StartedTimestamp,
 		Queries:                   historyResponse.Queries,
+		HistorySize:               historyResponse.HistorySize,
+		HistoryCount:              historyResponse.HistoryCount,
+		SuggestContinueAsNew:      historyResponse.SuggestContinueAsNew,
 	}
 	if historyResponse.GetPreviousStartedEventID() != EmptyEventID {
 		matchingResp.PreviousStartedEventID = historyResponse.PreviousStartedEventID
@@ -738,6 +741,10 @@ func GetSizeOfHistoryEvent(event *types.HistoryEvent) uint64 {
 		if event.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes != nil {
 			res += GetSizeOfMapStringToByteArray(event.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes.IndexedFields)
 		}
+	case types.EventTypeUpsertWorkflowMemo:
+		if event.UpsertWorkflowMemoEventAttributes.Memo != nil {
+			res += GetSizeOfMapStringToByteArray(event.UpsertWorkflowMemoEventAttributes.Memo.Fields)
+		}
 	}
 	return uint64(res)
 }
@@ -810,6 +817,16 @@ func DeserializeSearchAttributeValue(value []byte, valueType workflow.IndexedVal
 			return listVal, err
 		}
 		return val, nil
+	case workflow.IndexedValueTypeKeywordList:
+		var listVal []string
+		if err := json.Unmarshal(value, &listVal); err != nil {
+			var val string
+			if err := json.Unmarshal(value, &val); err != nil {
+				return nil, err
+			}
+			return []string{val}, nil
+		}
+		return listVal, nil
 	default:
 		return nil, fmt.Errorf("error: unknown index value type [%v]", valueType)
 	}
@@ -848,24 +865,48 @@ func ConvertDynamicConfigMapPropertyToIntMap(
 			return nil, fmt.Errorf("failed to convert key %v, error: %v", key, err)
 		}
 
-		var intValue int
-		switch value.(type) {
-		case float64:
-			intValue = int(value.(float64))
-		case int:
-			intValue = value.(int)
-		case int32:
-			intValue = int(value.(int32))
-		case int64:
-			intValue = int(value.(int64))
-		default:
-			return nil, fmt.Errorf("unknown value %v with type %T", value, value)
+		intValue, err := convertDynamicConfigIntValue(value)
+		if err != nil {
+			return nil, err
 		}
 		intMap[intKey] = intValue
 	}
 	return intMap, nil
 }
 
+// ConvertDynamicConfigMapPropertyToStringIntMap convert a map property from dynamic config to a map
+// whose type for both key and value are string and int respectively
+func ConvertDynamicConfigMapPropertyToStringIntMap(
+	dcValue map[string]interface{},
+) (map[string]int, error) {
+	intMap := make(map[string]int)
+	for key, value := range dcValue {
+		intValue, err := convertDynamicConfigIntValue(value)
+		if err != nil {
+			return nil, err
+		}
+		intMap[strings.TrimSpace(key)] = intValue
+	}
+	return intMap, nil
+}
+
+func convertDynamicConfigIntValue(
+	value interface{},
+) (int, error) {
+	switch value := value.(type) {
+	case float64:
+		return int(value), nil
+	case int:
+		return value, nil
+	case int32:
+		return int(value), nil
+	case int64:
+		return int(value), nil
+	default:
+		return 0, fmt.Errorf("unknown value %v with type %T", value, value)
+	}
+}
+
 // IsStickyTaskConditionError is error from matching engine
 func IsStickyTaskConditionError(err error) bool {
 	if e, ok := err.(*types.InternalServiceError); ok {
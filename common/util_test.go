@@ -74,6 +74,19 @@ func TestIsContextTimeoutError(t *testing.T) {
 	require.False(t, IsContextTimeoutError(ctx.Err()))
 }
 
+func TestDeserializeSearchAttributeValue_KeywordList(t *testing.T) {
+	val, err := DeserializeSearchAttributeValue([]byte(`["a","b"]`), workflow.IndexedValueTypeKeywordList)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, val)
+
+	val, err = DeserializeSearchAttributeValue([]byte(`"a"`), workflow.IndexedValueTypeKeywordList)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a"}, val)
+
+	_, err = DeserializeSearchAttributeValue([]byte(`[1,2]`), workflow.IndexedValueTypeKeywordList)
+	require.Error(t, err)
+}
+
 func TestConvertDynamicConfigMapPropertyToIntMap(t *testing.T) {
 	dcValue := make(map[string]interface{})
 	for idx, value := range []interface{}{int(0), int32(1), int64(2), float64(3.0)} {
@@ -88,6 +101,21 @@ func TestConvertDynamicConfigMapPropertyToIntMap(t *testing.T) {
 	}
 }
 
+func TestConvertDynamicConfigMapPropertyToStringIntMap(t *testing.T) {
+	dcValue := map[string]interface{}{
+		"critical": int(1),
+		" batch ":  float64(2.0),
+	}
+
+	intMap, err := ConvertDynamicConfigMapPropertyToStringIntMap(dcValue)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"critical": 1, "batch": 2}, intMap)
+
+	dcValue["standard"] = "0"
+	_, err = ConvertDynamicConfigMapPropertyToStringIntMap(dcValue)
+	require.Error(t, err)
+}
+
 func TestCreateHistoryStartWorkflowRequest_ExpirationTimeWithCron(t *testing.T) {
 	domainID := uuid.New()
 	request := &types.StartWorkflowExecutionRequest{
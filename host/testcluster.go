@@ -154,7 +154,7 @@ func NewCluster(options *TestClusterConfig, logger log.Logger, params persistenc
 		HistoryConfig:                 options.HistoryConfig,
 		WorkerConfig:                  options.WorkerConfig,
 		MockAdminClient:               options.MockAdminClient,
-		DomainReplicationTaskExecutor: domain.NewReplicationTaskExecutor(testBase.DomainManager, clock.NewRealTimeSource(), logger),
+		DomainReplicationTaskExecutor: domain.NewReplicationTaskExecutor(testBase.DomainManager, domain.NewAuditLog(testBase.DomainAuditManager), clock.NewRealTimeSource(), metricsClient, logger),
 		AuthorizationConfig:           aConfig,
 	}
 	cluster := NewCadence(cadenceParams)
@@ -128,6 +128,15 @@ CREATE TABLE replication_tasks_dlq (
   PRIMARY KEY (source_cluster_name, shard_id, task_id)
 );
 
+CREATE TABLE history_task_dlq (
+  shard_id INT NOT NULL,
+  task_id BIGINT NOT NULL,
+  --
+  data MEDIUMBLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, task_id)
+);
+
 CREATE TABLE timer_tasks (
   shard_id INT NOT NULL,
   visibility_timestamp DATETIME(6) NOT NULL,
@@ -265,3 +274,22 @@ CREATE TABLE queue_metadata (
   data MEDIUMBLOB NOT NULL,
   PRIMARY KEY(queue_type)
 );
+
+CREATE TABLE cluster_config (
+  row_type INT NOT NULL,
+  version BIGINT NOT NULL,
+  --
+  timestamp DATETIME(6) NOT NULL,
+  data MEDIUMBLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (row_type, version)
+);
+
+CREATE TABLE domain_audit_log (
+  domain_id BINARY(16) NOT NULL,
+  event_id BIGINT NOT NULL,
+  --
+  data MEDIUMBLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (domain_id, event_id)
+);
@@ -0,0 +1,8 @@
+CREATE TABLE domain_audit_log (
+  domain_id BYTEA NOT NULL,
+  event_id BIGINT NOT NULL,
+  --
+  data BYTEA NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (domain_id, event_id)
+);
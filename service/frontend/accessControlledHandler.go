@@ -155,6 +155,54 @@ func (a *AccessControlledWorkflowHandler) DescribeTaskList(
 	return a.frontendHandler.DescribeTaskList(ctx, request)
 }
 
+// DescribeSchedule API call
+func (a *AccessControlledWorkflowHandler) DescribeSchedule(
+	ctx context.Context,
+	request *types.DescribeScheduleRequest,
+) (*types.DescribeScheduleResponse, error) {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendDescribeScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "DescribeSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionRead,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.DescribeSchedule(ctx, request)
+}
+
+// ListSchedules API call
+func (a *AccessControlledWorkflowHandler) ListSchedules(
+	ctx context.Context,
+	request *types.ListSchedulesRequest,
+) (*types.ListSchedulesResponse, error) {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendListSchedulesScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "ListSchedules",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionRead,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.ListSchedules(ctx, request)
+}
+
 // DescribeWorkflowExecution API call
 func (a *AccessControlledWorkflowHandler) DescribeWorkflowExecution(
 	ctx context.Context,
@@ -402,6 +450,30 @@ func (a *AccessControlledWorkflowHandler) QueryWorkflow(
 	return a.frontendHandler.QueryWorkflow(ctx, request)
 }
 
+// UpdateWorkflowExecution API call
+func (a *AccessControlledWorkflowHandler) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.UpdateWorkflowExecutionRequest,
+) (*types.UpdateWorkflowExecutionResponse, error) {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUpdateWorkflowExecutionScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "UpdateWorkflowExecution",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.UpdateWorkflowExecution(ctx, request)
+}
+
 // GetClusterInfo API call
 func (a *AccessControlledWorkflowHandler) GetClusterInfo(
 	ctx context.Context,
@@ -474,6 +546,270 @@ func (a *AccessControlledWorkflowHandler) RequestCancelWorkflowExecution(
 	return a.frontendHandler.RequestCancelWorkflowExecution(ctx, request)
 }
 
+// PauseWorkflowExecution API call
+func (a *AccessControlledWorkflowHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	request *types.PauseWorkflowExecutionRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendPauseWorkflowExecutionScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "PauseWorkflowExecution",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.PauseWorkflowExecution(ctx, request)
+}
+
+// CreateSchedule API call
+func (a *AccessControlledWorkflowHandler) CreateSchedule(
+	ctx context.Context,
+	request *types.CreateScheduleRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendCreateScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "CreateSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.CreateSchedule(ctx, request)
+}
+
+// UpdateSchedule API call
+func (a *AccessControlledWorkflowHandler) UpdateSchedule(
+	ctx context.Context,
+	request *types.UpdateScheduleRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUpdateScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "UpdateSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.UpdateSchedule(ctx, request)
+}
+
+// PauseSchedule API call
+func (a *AccessControlledWorkflowHandler) PauseSchedule(
+	ctx context.Context,
+	request *types.PauseScheduleRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendPauseScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "PauseSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.PauseSchedule(ctx, request)
+}
+
+// UnpauseSchedule API call
+func (a *AccessControlledWorkflowHandler) UnpauseSchedule(
+	ctx context.Context,
+	request *types.UnpauseScheduleRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUnpauseScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "UnpauseSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.UnpauseSchedule(ctx, request)
+}
+
+// BackfillSchedule API call
+func (a *AccessControlledWorkflowHandler) BackfillSchedule(
+	ctx context.Context,
+	request *types.BackfillScheduleRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendBackfillScheduleScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "BackfillSchedule",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.BackfillSchedule(ctx, request)
+}
+
+// UnpauseWorkflowExecution API call
+func (a *AccessControlledWorkflowHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *types.UnpauseWorkflowExecutionRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUnpauseWorkflowExecutionScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "UnpauseWorkflowExecution",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.UnpauseWorkflowExecution(ctx, request)
+}
+
+// DeleteWorkflowExecution API call
+func (a *AccessControlledWorkflowHandler) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *types.DeleteWorkflowExecutionRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendDeleteWorkflowExecutionScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "DeleteWorkflowExecution",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.DeleteWorkflowExecution(ctx, request)
+}
+
+// PauseActivity API call
+func (a *AccessControlledWorkflowHandler) PauseActivity(
+	ctx context.Context,
+	request *types.PauseActivityRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendPauseActivityScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "PauseActivity",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.PauseActivity(ctx, request)
+}
+
+// UnpauseActivity API call
+func (a *AccessControlledWorkflowHandler) UnpauseActivity(
+	ctx context.Context,
+	request *types.UnpauseActivityRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUnpauseActivityScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "UnpauseActivity",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.UnpauseActivity(ctx, request)
+}
+
+// ResetActivity API call
+func (a *AccessControlledWorkflowHandler) ResetActivity(
+	ctx context.Context,
+	request *types.ResetActivityRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendResetActivityScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "ResetActivity",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.ResetActivity(ctx, request)
+}
+
 // ResetStickyTaskList API call
 func (a *AccessControlledWorkflowHandler) ResetStickyTaskList(
 	ctx context.Context,
@@ -522,6 +858,30 @@ func (a *AccessControlledWorkflowHandler) ResetWorkflowExecution(
 	return a.frontendHandler.ResetWorkflowExecution(ctx, request)
 }
 
+// RestartWorkflowExecution API call
+func (a *AccessControlledWorkflowHandler) RestartWorkflowExecution(
+	ctx context.Context,
+	request *types.RestartWorkflowExecutionRequest,
+) (*types.RestartWorkflowExecutionResponse, error) {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendRestartWorkflowExecutionScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "RestartWorkflowExecution",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.RestartWorkflowExecution(ctx, request)
+}
+
 // RespondActivityTaskCanceled API call
 func (a *AccessControlledWorkflowHandler) RespondActivityTaskCanceled(
 	ctx context.Context,
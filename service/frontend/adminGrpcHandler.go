@@ -136,6 +136,76 @@ func (g adminGRPCHandler) ResetQueue(ctx context.Context, request *adminv1.Reset
 	return &adminv1.ResetQueueResponse{}, proto.FromError(err)
 }
 
+func (g adminGRPCHandler) PauseTaskList(ctx context.Context, request *adminv1.PauseTaskListRequest) (*adminv1.PauseTaskListResponse, error) {
+	err := g.h.PauseTaskList(withGRPCTag(ctx), proto.ToAdminPauseTaskListRequest(request))
+	return &adminv1.PauseTaskListResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) ResumeTaskList(ctx context.Context, request *adminv1.ResumeTaskListRequest) (*adminv1.ResumeTaskListResponse, error) {
+	err := g.h.ResumeTaskList(withGRPCTag(ctx), proto.ToAdminResumeTaskListRequest(request))
+	return &adminv1.ResumeTaskListResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) AddRemoteCluster(ctx context.Context, request *adminv1.AddRemoteClusterRequest) (*adminv1.AddRemoteClusterResponse, error) {
+	err := g.h.AddRemoteCluster(withGRPCTag(ctx), proto.ToAdminAddRemoteClusterRequest(request))
+	return &adminv1.AddRemoteClusterResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) RemoveRemoteCluster(ctx context.Context, request *adminv1.RemoveRemoteClusterRequest) (*adminv1.RemoveRemoteClusterResponse, error) {
+	err := g.h.RemoveRemoteCluster(withGRPCTag(ctx), proto.ToAdminRemoveRemoteClusterRequest(request))
+	return &adminv1.RemoveRemoteClusterResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) CloneDomain(ctx context.Context, request *adminv1.CloneDomainRequest) (*adminv1.CloneDomainResponse, error) {
+	err := g.h.CloneDomain(withGRPCTag(ctx), proto.ToAdminCloneDomainRequest(request))
+	return &adminv1.CloneDomainResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) RenameDomain(ctx context.Context, request *adminv1.RenameDomainRequest) (*adminv1.RenameDomainResponse, error) {
+	err := g.h.RenameDomain(withGRPCTag(ctx), proto.ToAdminRenameDomainRequest(request))
+	return &adminv1.RenameDomainResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) DeleteDomain(ctx context.Context, request *adminv1.DeleteDomainRequest) (*adminv1.DeleteDomainResponse, error) {
+	err := g.h.DeleteDomain(withGRPCTag(ctx), proto.ToAdminDeleteDomainRequest(request))
+	return &adminv1.DeleteDomainResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) MoveTaskListBacklog(ctx context.Context, request *adminv1.MoveTaskListBacklogRequest) (*adminv1.MoveTaskListBacklogResponse, error) {
+	response, err := g.h.MoveTaskListBacklog(withGRPCTag(ctx), proto.ToAdminMoveTaskListBacklogRequest(request))
+	return proto.FromAdminMoveTaskListBacklogResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) ReadHistoryTaskDLQ(ctx context.Context, request *adminv1.ReadHistoryTaskDLQRequest) (*adminv1.ReadHistoryTaskDLQResponse, error) {
+	response, err := g.h.ReadHistoryTaskDLQ(withGRPCTag(ctx), proto.ToAdminReadHistoryTaskDLQRequest(request))
+	return proto.FromAdminReadHistoryTaskDLQResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) DescribeReplicationStatus(ctx context.Context, request *adminv1.DescribeReplicationStatusRequest) (*adminv1.DescribeReplicationStatusResponse, error) {
+	response, err := g.h.DescribeReplicationStatus(withGRPCTag(ctx), proto.ToAdminDescribeReplicationStatusRequest(request))
+	return proto.FromAdminDescribeReplicationStatusResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) MigrateWorkflowExecution(ctx context.Context, request *adminv1.MigrateWorkflowExecutionRequest) (*adminv1.MigrateWorkflowExecutionResponse, error) {
+	response, err := g.h.MigrateWorkflowExecution(withGRPCTag(ctx), proto.ToAdminMigrateWorkflowExecutionRequest(request))
+	return proto.FromAdminMigrateWorkflowExecutionResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) ListDomainChanges(ctx context.Context, request *adminv1.ListDomainChangesRequest) (*adminv1.ListDomainChangesResponse, error) {
+	response, err := g.h.ListDomainChanges(withGRPCTag(ctx), proto.ToAdminListDomainChangesRequest(request))
+	return proto.FromAdminListDomainChangesResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) PurgeHistoryTaskDLQ(ctx context.Context, request *adminv1.PurgeHistoryTaskDLQRequest) (*adminv1.PurgeHistoryTaskDLQResponse, error) {
+	err := g.h.PurgeHistoryTaskDLQ(withGRPCTag(ctx), proto.ToAdminPurgeHistoryTaskDLQRequest(request))
+	return &adminv1.PurgeHistoryTaskDLQResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) RetryHistoryTaskDLQ(ctx context.Context, request *adminv1.RetryHistoryTaskDLQRequest) (*adminv1.RetryHistoryTaskDLQResponse, error) {
+	response, err := g.h.RetryHistoryTaskDLQ(withGRPCTag(ctx), proto.ToAdminRetryHistoryTaskDLQRequest(request))
+	return proto.FromAdminRetryHistoryTaskDLQResponse(response), proto.FromError(err)
+}
+
 func (g adminGRPCHandler) GetCrossClusterTasks(ctx context.Context, request *adminv1.GetCrossClusterTasksRequest) (*adminv1.GetCrossClusterTasksResponse, error) {
 	response, err := g.h.GetCrossClusterTasks(withGRPCTag(ctx), proto.ToAdminGetCrossClusterTasksRequest(request))
 	return proto.FromAdminGetCrossClusterTasksResponse(response), proto.FromError(err)
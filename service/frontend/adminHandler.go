@@ -27,6 +27,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -34,7 +35,9 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
+	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
@@ -47,16 +50,24 @@ import (
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/scanner/domaindeletion"
 )
 
 var _ AdminHandler = (*adminHandlerImpl)(nil)
 
 const (
 	endMessageID int64 = 1<<63 - 1
+
+	defaultListDomainChangesPageSize = 100
 )
 
 var (
 	errMaxMessageIDNotSet = &types.BadRequestError{Message: "Max messageID is not set."}
+	errRPCNameNotSet      = &types.BadRequestError{Message: "RPC name is not set."}
+	errRPCAddressNotSet   = &types.BadRequestError{Message: "RPC address is not set."}
+
+	errDeleteGlobalDomain        = &types.BadRequestError{Message: "Global domains cannot be deleted."}
+	errDeleteNotDeprecatedDomain = &types.BadRequestError{Message: "Domain must be deprecated before it can be deleted."}
 )
 
 type (
@@ -83,6 +94,20 @@ type (
 		RemoveTask(context.Context, *types.RemoveTaskRequest) error
 		ResendReplicationTasks(context.Context, *types.ResendReplicationTasksRequest) error
 		ResetQueue(context.Context, *types.ResetQueueRequest) error
+		PauseTaskList(context.Context, *types.PauseTaskListRequest) error
+		ResumeTaskList(context.Context, *types.ResumeTaskListRequest) error
+		AddRemoteCluster(context.Context, *types.AddRemoteClusterRequest) error
+		RemoveRemoteCluster(context.Context, *types.RemoveRemoteClusterRequest) error
+		MoveTaskListBacklog(context.Context, *types.MoveTaskListBacklogRequest) (*types.MoveTaskListBacklogResponse, error)
+		ReadHistoryTaskDLQ(context.Context, *types.ReadHistoryTaskDLQRequest) (*types.ReadHistoryTaskDLQResponse, error)
+		DescribeReplicationStatus(context.Context, *types.DescribeReplicationStatusRequest) (*types.DescribeReplicationStatusResponse, error)
+		MigrateWorkflowExecution(context.Context, *types.MigrateWorkflowExecutionRequest) (*types.MigrateWorkflowExecutionResponse, error)
+		ListDomainChanges(context.Context, *types.ListDomainChangesRequest) (*types.ListDomainChangesResponse, error)
+		CloneDomain(context.Context, *types.CloneDomainRequest) error
+		RenameDomain(context.Context, *types.RenameDomainRequest) error
+		DeleteDomain(context.Context, *types.DeleteDomainRequest) error
+		PurgeHistoryTaskDLQ(context.Context, *types.PurgeHistoryTaskDLQRequest) error
+		RetryHistoryTaskDLQ(context.Context, *types.RetryHistoryTaskDLQRequest) (*types.RetryHistoryTaskDLQResponse, error)
 		GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error)
 	}
 
@@ -93,6 +118,8 @@ type (
 		numberOfHistoryShards int
 		params                *service.BootstrapParams
 		config                *Config
+		domainHandler         domain.Handler
+		domainDeletionClient  domaindeletion.Client
 		domainDLQHandler      domain.DLQMessageHandler
 		domainFailoverWatcher domain.FailoverWatcher
 		eventSerializder      persistence.PayloadSerializer
@@ -122,11 +149,14 @@ func NewAdminHandler(
 	resource resource.Resource,
 	params *service.BootstrapParams,
 	config *Config,
+	domainHandler domain.Handler,
 ) AdminHandler {
 
 	domainReplicationTaskExecutor := domain.NewReplicationTaskExecutor(
 		resource.GetDomainManager(),
+		resource.GetDomainAuditLog(),
 		resource.GetTimeSource(),
+		resource.GetMetricsClient(),
 		resource.GetLogger(),
 	)
 	return &adminHandlerImpl{
@@ -134,6 +164,8 @@ func NewAdminHandler(
 		numberOfHistoryShards: params.PersistenceConfig.NumHistoryShards,
 		params:                params,
 		config:                config,
+		domainHandler:         domainHandler,
+		domainDeletionClient:  domaindeletion.NewClient(resource.GetFrontendClient()),
 		domainDLQHandler: domain.NewDLQMessageHandler(
 			domainReplicationTaskExecutor,
 			resource.GetDomainReplicationQueue(),
@@ -365,6 +397,411 @@ func (adh *adminHandlerImpl) DescribeQueue(
 	return adh.GetHistoryClient().DescribeQueue(ctx, request)
 }
 
+// ReadHistoryTaskDLQ reads transfer and timer tasks parked in the history task DLQ of a shard
+func (adh *adminHandlerImpl) ReadHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.ReadHistoryTaskDLQRequest,
+) (resp *types.ReadHistoryTaskDLQResponse, retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminReadHistoryTaskDLQScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetMaximumPageSize() <= 0 {
+		request.MaximumPageSize = common.ReadDLQMessagesPageSize
+	}
+
+	resp, err := adh.GetHistoryClient().ReadHistoryTaskDLQ(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// DescribeReplicationStatus reports the replication progress of each shard against the remote clusters
+// and aggregates it for every global domain replicated to those clusters
+func (adh *adminHandlerImpl) DescribeReplicationStatus(
+	ctx context.Context,
+	request *types.DescribeReplicationStatusRequest,
+) (resp *types.DescribeReplicationStatusResponse, retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeReplicationStatusScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	clusterMetadata := adh.GetClusterMetadata()
+	if request.GetClusterName() != "" {
+		if _, ok := clusterMetadata.GetAllClusterInfo()[request.GetClusterName()]; !ok {
+			return nil, adh.error(&types.BadRequestError{
+				Message: fmt.Sprintf("Unknown cluster: %v.", request.GetClusterName()),
+			}, scope)
+		}
+	}
+	for _, shardID := range request.GetShardIDs() {
+		if shardID < 0 || int(shardID) >= adh.numberOfHistoryShards {
+			return nil, adh.error(&types.BadRequestError{
+				Message: fmt.Sprintf("Invalid shardID: %v.", shardID),
+			}, scope)
+		}
+	}
+
+	var domains []*cache.DomainCacheEntry
+	if request.GetDomain() != "" {
+		domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		domains = append(domains, domainEntry)
+	} else {
+		for _, domainEntry := range adh.GetDomainCache().GetAllDomain() {
+			domains = append(domains, domainEntry)
+		}
+	}
+
+	historyRequest := &types.DescribeReplicationStatusRequest{
+		ClusterName: request.GetClusterName(),
+		ShardIDs:    request.GetShardIDs(),
+	}
+	if len(historyRequest.ShardIDs) == 0 {
+		for shardID := 0; shardID < adh.numberOfHistoryShards; shardID++ {
+			historyRequest.ShardIDs = append(historyRequest.ShardIDs, int32(shardID))
+		}
+	}
+	resp, err := adh.GetHistoryClient().DescribeReplicationStatus(ctx, historyRequest)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp.Domains = aggregateDomainReplicationStatus(
+		clusterMetadata.GetCurrentClusterName(),
+		request.GetClusterName(),
+		domains,
+		resp.Shards,
+	)
+	return resp, nil
+}
+
+// aggregateDomainReplicationStatus folds the shard level replication progress into one status per
+// global domain and remote cluster. Replication tasks of all domains share the same per shard queue,
+// so the lag reported for a domain is an upper bound of its actual lag.
+func aggregateDomainReplicationStatus(
+	currentCluster string,
+	clusterName string,
+	domains []*cache.DomainCacheEntry,
+	shards []*types.ReplicationShardStatus,
+) []*types.ReplicationDomainStatus {
+
+	shardsByCluster := make(map[string][]*types.ReplicationShardStatus)
+	for _, shard := range shards {
+		shardsByCluster[shard.ClusterName] = append(shardsByCluster[shard.ClusterName], shard)
+	}
+
+	var result []*types.ReplicationDomainStatus
+	for _, domain := range domains {
+		if !domain.IsGlobalDomain() {
+			continue
+		}
+		replicationConfig := domain.GetReplicationConfig()
+		for _, cluster := range replicationConfig.Clusters {
+			if cluster.ClusterName == currentCluster {
+				continue
+			}
+			if clusterName != "" && cluster.ClusterName != clusterName {
+				continue
+			}
+
+			status := &types.ReplicationDomainStatus{
+				Domain:                  domain.GetInfo().Name,
+				ActiveClusterName:       replicationConfig.ActiveClusterName,
+				ClusterName:             cluster.ClusterName,
+				GracefulFailoverEndTime: domain.GetFailoverEndTime(),
+			}
+			for _, shard := range shardsByCluster[cluster.ClusterName] {
+				if lag := shard.MaxTaskID - shard.OutboundAckedTaskID; lag > status.MaxOutboundTaskLag {
+					status.MaxOutboundTaskLag = lag
+				}
+				if shard.CrossClusterTaskLag > status.MaxCrossClusterTaskLag {
+					status.MaxCrossClusterTaskLag = shard.CrossClusterTaskLag
+				}
+				// replication DLQ is not partitioned by domain
+				status.InboundDLQSize += shard.InboundDLQSize
+				status.OldestOutboundFetchTime = minUnixNano(status.OldestOutboundFetchTime, shard.OutboundLastFetchTime)
+				status.OldestInboundFetchTime = minUnixNano(status.OldestInboundFetchTime, shard.InboundLastFetchTime)
+			}
+			result = append(result, status)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Domain != result[j].Domain {
+			return result[i].Domain < result[j].Domain
+		}
+		return result[i].ClusterName < result[j].ClusterName
+	})
+	return result
+}
+
+func minUnixNano(current *int64, candidate *int64) *int64 {
+	if candidate == nil {
+		return current
+	}
+	if current == nil || *candidate < *current {
+		return common.Int64Ptr(*candidate)
+	}
+	return current
+}
+
+// PurgeHistoryTaskDLQ deletes tasks parked in the history task DLQ of a shard
+func (adh *adminHandlerImpl) PurgeHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.PurgeHistoryTaskDLQRequest,
+) (retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminPurgeHistoryTaskDLQScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.GetHistoryClient().PurgeHistoryTaskDLQ(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// RetryHistoryTaskDLQ resubmits tasks parked in the history task DLQ of a shard to the queue processors
+func (adh *adminHandlerImpl) RetryHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.RetryHistoryTaskDLQRequest,
+) (resp *types.RetryHistoryTaskDLQResponse, retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminRetryHistoryTaskDLQScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetMaximumPageSize() <= 0 {
+		request.MaximumPageSize = common.ReadDLQMessagesPageSize
+	}
+
+	resp, err := adh.GetHistoryClient().RetryHistoryTaskDLQ(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// PauseTaskList pauses dispatching of tasks from all partitions of a task list
+func (adh *adminHandlerImpl) PauseTaskList(
+	ctx context.Context,
+	request *types.PauseTaskListRequest,
+) (retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminPauseTaskListScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.setTaskListPaused(ctx, request.GetDomain(), request.GetTaskList(), request.TaskListType, true); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// ResumeTaskList resumes dispatching of tasks from all partitions of a task list
+func (adh *adminHandlerImpl) ResumeTaskList(
+	ctx context.Context,
+	request *types.ResumeTaskListRequest,
+) (retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminResumeTaskListScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.setTaskListPaused(ctx, request.GetDomain(), request.GetTaskList(), request.TaskListType, false); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+func (adh *adminHandlerImpl) setTaskListPaused(
+	ctx context.Context,
+	domain string,
+	taskList *types.TaskList,
+	taskListType *types.TaskListType,
+	paused bool,
+) error {
+
+	if domain == "" {
+		return errDomainNotSet
+	}
+	if taskList == nil || taskList.GetName() == "" {
+		return errTaskListNotSet
+	}
+	if taskListType == nil {
+		return errTaskListTypeNotSet
+	}
+
+	domainID, err := adh.GetDomainCache().GetDomainID(domain)
+	if err != nil {
+		return err
+	}
+
+	resp, err := adh.GetMatchingClient().ListTaskListPartitions(ctx, &types.MatchingListTaskListPartitionsRequest{
+		Domain:   domain,
+		TaskList: taskList,
+	})
+	if err != nil {
+		return err
+	}
+	partitions := resp.GetDecisionTaskListPartitions()
+	if *taskListType == types.TaskListTypeActivity {
+		partitions = resp.GetActivityTaskListPartitions()
+	}
+
+	// every partition is owned and dispatched independently, so each one has to be updated
+	for _, partition := range partitions {
+		partitionTaskList := &types.TaskList{
+			Name: partition.GetKey(),
+			Kind: taskList.Kind,
+		}
+		if paused {
+			err = adh.GetMatchingClient().PauseTaskList(ctx, &types.MatchingPauseTaskListRequest{
+				DomainUUID:   domainID,
+				TaskList:     partitionTaskList,
+				TaskListType: taskListType,
+			})
+		} else {
+			err = adh.GetMatchingClient().ResumeTaskList(ctx, &types.MatchingResumeTaskListRequest{
+				DomainUUID:   domainID,
+				TaskList:     partitionTaskList,
+				TaskListType: taskListType,
+			})
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddRemoteCluster adds a remote cluster to, or updates it in, the cluster metadata without a restart
+func (adh *adminHandlerImpl) AddRemoteCluster(
+	ctx context.Context,
+	request *types.AddRemoteClusterRequest,
+) (retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminAddRemoteClusterScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetClusterName() == "" {
+		return adh.error(errClusterNameNotSet, scope)
+	}
+	if request.GetRPCName() == "" {
+		return adh.error(errRPCNameNotSet, scope)
+	}
+	if request.GetRPCAddress() == "" {
+		return adh.error(errRPCAddressNotSet, scope)
+	}
+
+	if err := adh.GetRemoteClusterManager().AddRemoteCluster(ctx, request.GetClusterName(), config.ClusterInformation{
+		Enabled:                true,
+		InitialFailoverVersion: request.GetInitialFailoverVersion(),
+		RPCName:                request.GetRPCName(),
+		RPCAddress:             request.GetRPCAddress(),
+	}); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// RemoveRemoteCluster removes a remote cluster from the cluster metadata without a restart
+func (adh *adminHandlerImpl) RemoveRemoteCluster(
+	ctx context.Context,
+	request *types.RemoveRemoteClusterRequest,
+) (retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminRemoveRemoteClusterScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetClusterName() == "" {
+		return adh.error(errClusterNameNotSet, scope)
+	}
+
+	if err := adh.GetRemoteClusterManager().RemoveRemoteCluster(ctx, request.GetClusterName()); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// MoveTaskListBacklog moves a batch of persisted tasks from one task list partition to another task list
+func (adh *adminHandlerImpl) MoveTaskListBacklog(
+	ctx context.Context,
+	request *types.MoveTaskListBacklogRequest,
+) (resp *types.MoveTaskListBacklogResponse, retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminMoveTaskListBacklogScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetSourceTaskList().GetName() == "" || request.GetTargetTaskList().GetName() == "" {
+		return nil, adh.error(errTaskListNotSet, scope)
+	}
+	if request.TaskListType == nil {
+		return nil, adh.error(errTaskListTypeNotSet, scope)
+	}
+
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err = adh.GetMatchingClient().MoveTaskListBacklog(ctx, &types.MatchingMoveTaskListBacklogRequest{
+		DomainUUID:     domainID,
+		SourceTaskList: request.SourceTaskList,
+		TargetTaskList: request.TargetTaskList,
+		TaskListType:   request.TaskListType,
+		BatchSize:      request.BatchSize,
+		AfterTaskID:    request.AfterTaskID,
+		DryRun:         request.DryRun,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
 // DescribeShardDistribution returns information about history shard distribution
 func (adh *adminHandlerImpl) DescribeShardDistribution(
 	ctx context.Context,
@@ -1005,6 +1442,184 @@ func (adh *adminHandlerImpl) ResendReplicationTasks(
 	)
 }
 
+// MigrateWorkflowExecution moves a running workflow from a source cluster the domain is not replicated to into a
+// local domain of the current cluster, the history service closes the source and copies its history
+func (adh *adminHandlerImpl) MigrateWorkflowExecution(
+	ctx context.Context,
+	request *types.MigrateWorkflowExecutionRequest,
+) (resp *types.MigrateWorkflowExecutionResponse, retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminMigrateWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetWorkflowID() == "" {
+		return nil, adh.error(errWorkflowIDNotSet, scope)
+	}
+	if request.GetRunID() != "" && uuid.Parse(request.GetRunID()) == nil {
+		return nil, adh.error(errInvalidRunID, scope)
+	}
+
+	currentClusterName := adh.GetClusterMetadata().GetCurrentClusterName()
+	sourceCluster := request.GetSourceCluster()
+	if sourceCluster == "" || sourceCluster == currentClusterName {
+		return nil, adh.error(&types.BadRequestError{Message: "Invalid source cluster."}, scope)
+	}
+	if info, ok := adh.GetClusterMetadata().GetAllClusterInfo()[sourceCluster]; !ok || !info.Enabled {
+		return nil, adh.error(&types.BadRequestError{
+			Message: fmt.Sprintf("Unknown cluster: %v.", sourceCluster),
+		}, scope)
+	}
+
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if !domainEntry.IsDomainActive() {
+		return nil, adh.error(domainEntry.GetDomainNotActiveErr(), scope)
+	}
+	for _, replicationCluster := range domainEntry.GetReplicationConfig().Clusters {
+		if replicationCluster.ClusterName == sourceCluster {
+			return nil, adh.error(&types.BadRequestError{
+				Message: fmt.Sprintf("Domain %v is replicated to cluster %v, use domain failover instead.", request.GetDomain(), sourceCluster),
+			}, scope)
+		}
+	}
+	resp, err = adh.GetHistoryClient().MigrateWorkflowExecution(ctx, &types.HistoryMigrateWorkflowExecutionRequest{
+		DomainUUID:    domainEntry.GetInfo().ID,
+		WorkflowID:    request.GetWorkflowID(),
+		RunID:         request.GetRunID(),
+		SourceCluster: sourceCluster,
+		SourceDomain:  request.GetSourceDomain(),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// ListDomainChanges returns the audit log of changes made to a domain, oldest first
+func (adh *adminHandlerImpl) ListDomainChanges(
+	ctx context.Context,
+	request *types.ListDomainChangesRequest,
+) (resp *types.ListDomainChangesResponse, retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminListDomainChangesScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultListDomainChangesPageSize
+	}
+
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	changes, nextPageToken, err := adh.GetDomainAuditLog().List(ctx, domainID, pageSize, request.NextPageToken)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &types.ListDomainChangesResponse{
+		Changes:       changes,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// CloneDomain registers a new domain with the configuration of an existing one
+func (adh *adminHandlerImpl) CloneDomain(
+	ctx context.Context,
+	request *types.CloneDomainRequest,
+) (retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminCloneDomainScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetSourceName() == "" || request.GetName() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+
+	if err := adh.domainHandler.CloneDomain(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// RenameDomain changes the name of a domain, keeping its domain ID
+func (adh *adminHandlerImpl) RenameDomain(
+	ctx context.Context,
+	request *types.RenameDomainRequest,
+) (retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminRenameDomainScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetName() == "" || request.GetNewName() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+
+	if err := adh.domainHandler.RenameDomain(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// DeleteDomain starts the system workflow which deletes a deprecated domain with all its data
+func (adh *adminHandlerImpl) DeleteDomain(
+	ctx context.Context,
+	request *types.DeleteDomainRequest,
+) (retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminDeleteDomainScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetName() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+
+	// the deletion workflow verifies the domain again, this only fails obviously wrong requests early
+	resp, err := adh.domainHandler.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(request.GetName())})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	if resp.GetIsGlobalDomain() {
+		return adh.error(errDeleteGlobalDomain, scope)
+	}
+	if resp.GetDomainInfo().GetStatus() != types.DomainStatusDeprecated {
+		return adh.error(errDeleteNotDeprecatedDomain, scope)
+	}
+
+	if err := adh.domainDeletionClient.DeleteDomain(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
 func (adh *adminHandlerImpl) GetCrossClusterTasks(
 	ctx context.Context,
 	request *types.GetCrossClusterTasksRequest,
@@ -1213,6 +1828,9 @@ func (adh *adminHandlerImpl) error(err error, scope metrics.Scope) error {
 		return err
 	case *types.EntityNotExistsError:
 		return err
+	case *types.DomainAlreadyExistsError:
+		scope.IncCounter(metrics.CadenceErrDomainAlreadyExistsCounter)
+		return err
 	default:
 		adh.GetLogger().Error("Uncategorized error", tag.Error(err))
 		scope.IncCounter(metrics.CadenceFailures)
@@ -1234,6 +1852,9 @@ func convertIndexedValueTypeToESDataType(valueType types.IndexedValueType) strin
 		return "boolean"
 	case types.IndexedValueTypeDatetime:
 		return "date"
+	case types.IndexedValueTypeKeywordList:
+		// elasticsearch fields are multi-valued by nature, a keyword list is a keyword field holding an array
+		return "keyword"
 	default:
 		return ""
 	}
@@ -359,6 +359,208 @@ func (mr *MockAdminHandlerMockRecorder) ResetQueue(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetQueue", reflect.TypeOf((*MockAdminHandler)(nil).ResetQueue), arg0, arg1)
 }
 
+// PauseTaskList mocks base method
+func (m *MockAdminHandler) PauseTaskList(arg0 context.Context, arg1 *types.PauseTaskListRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseTaskList", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseTaskList indicates an expected call of PauseTaskList
+func (mr *MockAdminHandlerMockRecorder) PauseTaskList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseTaskList", reflect.TypeOf((*MockAdminHandler)(nil).PauseTaskList), arg0, arg1)
+}
+
+// ResumeTaskList mocks base method
+func (m *MockAdminHandler) ResumeTaskList(arg0 context.Context, arg1 *types.ResumeTaskListRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeTaskList", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeTaskList indicates an expected call of ResumeTaskList
+func (mr *MockAdminHandlerMockRecorder) ResumeTaskList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTaskList", reflect.TypeOf((*MockAdminHandler)(nil).ResumeTaskList), arg0, arg1)
+}
+
+// AddRemoteCluster mocks base method
+func (m *MockAdminHandler) AddRemoteCluster(arg0 context.Context, arg1 *types.AddRemoteClusterRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRemoteCluster", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRemoteCluster indicates an expected call of AddRemoteCluster
+func (mr *MockAdminHandlerMockRecorder) AddRemoteCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRemoteCluster", reflect.TypeOf((*MockAdminHandler)(nil).AddRemoteCluster), arg0, arg1)
+}
+
+// RemoveRemoteCluster mocks base method
+func (m *MockAdminHandler) RemoveRemoteCluster(arg0 context.Context, arg1 *types.RemoveRemoteClusterRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRemoteCluster", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRemoteCluster indicates an expected call of RemoveRemoteCluster
+func (mr *MockAdminHandlerMockRecorder) RemoveRemoteCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRemoteCluster", reflect.TypeOf((*MockAdminHandler)(nil).RemoveRemoteCluster), arg0, arg1)
+}
+
+// MoveTaskListBacklog mocks base method
+func (m *MockAdminHandler) MoveTaskListBacklog(arg0 context.Context, arg1 *types.MoveTaskListBacklogRequest) (*types.MoveTaskListBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTaskListBacklog", arg0, arg1)
+	ret0, _ := ret[0].(*types.MoveTaskListBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskListBacklog indicates an expected call of MoveTaskListBacklog
+func (mr *MockAdminHandlerMockRecorder) MoveTaskListBacklog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskListBacklog", reflect.TypeOf((*MockAdminHandler)(nil).MoveTaskListBacklog), arg0, arg1)
+}
+
+// ReadHistoryTaskDLQ mocks base method
+func (m *MockAdminHandler) ReadHistoryTaskDLQ(arg0 context.Context, arg1 *types.ReadHistoryTaskDLQRequest) (*types.ReadHistoryTaskDLQResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadHistoryTaskDLQ", arg0, arg1)
+	ret0, _ := ret[0].(*types.ReadHistoryTaskDLQResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadHistoryTaskDLQ indicates an expected call of ReadHistoryTaskDLQ
+func (mr *MockAdminHandlerMockRecorder) ReadHistoryTaskDLQ(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadHistoryTaskDLQ", reflect.TypeOf((*MockAdminHandler)(nil).ReadHistoryTaskDLQ), arg0, arg1)
+}
+
+// DescribeReplicationStatus mocks base method
+func (m *MockAdminHandler) DescribeReplicationStatus(arg0 context.Context, arg1 *types.DescribeReplicationStatusRequest) (*types.DescribeReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeReplicationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplicationStatus indicates an expected call of DescribeReplicationStatus
+func (mr *MockAdminHandlerMockRecorder) DescribeReplicationStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplicationStatus", reflect.TypeOf((*MockAdminHandler)(nil).DescribeReplicationStatus), arg0, arg1)
+}
+
+// MigrateWorkflowExecution mocks base method
+func (m *MockAdminHandler) MigrateWorkflowExecution(arg0 context.Context, arg1 *types.MigrateWorkflowExecutionRequest) (*types.MigrateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*types.MigrateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateWorkflowExecution indicates an expected call of MigrateWorkflowExecution
+func (mr *MockAdminHandlerMockRecorder) MigrateWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateWorkflowExecution", reflect.TypeOf((*MockAdminHandler)(nil).MigrateWorkflowExecution), arg0, arg1)
+}
+
+// ListDomainChanges mocks base method
+func (m *MockAdminHandler) ListDomainChanges(arg0 context.Context, arg1 *types.ListDomainChangesRequest) (*types.ListDomainChangesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDomainChanges", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListDomainChangesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDomainChanges indicates an expected call of ListDomainChanges
+func (mr *MockAdminHandlerMockRecorder) ListDomainChanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomainChanges", reflect.TypeOf((*MockAdminHandler)(nil).ListDomainChanges), arg0, arg1)
+}
+
+// CloneDomain mocks base method
+func (m *MockAdminHandler) CloneDomain(arg0 context.Context, arg1 *types.CloneDomainRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneDomain", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloneDomain indicates an expected call of CloneDomain
+func (mr *MockAdminHandlerMockRecorder) CloneDomain(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneDomain", reflect.TypeOf((*MockAdminHandler)(nil).CloneDomain), arg0, arg1)
+}
+
+// RenameDomain mocks base method
+func (m *MockAdminHandler) RenameDomain(arg0 context.Context, arg1 *types.RenameDomainRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameDomain", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameDomain indicates an expected call of RenameDomain
+func (mr *MockAdminHandlerMockRecorder) RenameDomain(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameDomain", reflect.TypeOf((*MockAdminHandler)(nil).RenameDomain), arg0, arg1)
+}
+
+// DeleteDomain mocks base method
+func (m *MockAdminHandler) DeleteDomain(arg0 context.Context, arg1 *types.DeleteDomainRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDomain", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDomain indicates an expected call of DeleteDomain
+func (mr *MockAdminHandlerMockRecorder) DeleteDomain(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomain", reflect.TypeOf((*MockAdminHandler)(nil).DeleteDomain), arg0, arg1)
+}
+
+// PurgeHistoryTaskDLQ mocks base method
+func (m *MockAdminHandler) PurgeHistoryTaskDLQ(arg0 context.Context, arg1 *types.PurgeHistoryTaskDLQRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeHistoryTaskDLQ", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeHistoryTaskDLQ indicates an expected call of PurgeHistoryTaskDLQ
+func (mr *MockAdminHandlerMockRecorder) PurgeHistoryTaskDLQ(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeHistoryTaskDLQ", reflect.TypeOf((*MockAdminHandler)(nil).PurgeHistoryTaskDLQ), arg0, arg1)
+}
+
+// RetryHistoryTaskDLQ mocks base method
+func (m *MockAdminHandler) RetryHistoryTaskDLQ(arg0 context.Context, arg1 *types.RetryHistoryTaskDLQRequest) (*types.RetryHistoryTaskDLQResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryHistoryTaskDLQ", arg0, arg1)
+	ret0, _ := ret[0].(*types.RetryHistoryTaskDLQResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryHistoryTaskDLQ indicates an expected call of RetryHistoryTaskDLQ
+func (mr *MockAdminHandlerMockRecorder) RetryHistoryTaskDLQ(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryHistoryTaskDLQ", reflect.TypeOf((*MockAdminHandler)(nil).RetryHistoryTaskDLQ), arg0, arg1)
+}
+
 // GetCrossClusterTasks mocks base method
 func (m *MockAdminHandler) GetCrossClusterTasks(arg0 context.Context, arg1 *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -35,8 +35,10 @@ import (
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
 	esmock "github.com/uber/cadence/common/elasticsearch/mocks"
 	"github.com/uber/cadence/common/metrics"
@@ -45,6 +47,7 @@ import (
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/scanner/domaindeletion"
 )
 
 type (
@@ -56,6 +59,7 @@ type (
 		mockResource      *resource.Test
 		mockHistoryClient *history.MockClient
 		mockDomainCache   *cache.MockDomainCache
+		mockDomainHandler *domain.MockHandler
 
 		mockHistoryV2Mgr *mocks.HistoryV2Manager
 
@@ -82,6 +86,7 @@ func (s *adminHandlerSuite) SetupTest() {
 	s.mockDomainCache = s.mockResource.DomainCache
 	s.mockHistoryClient = s.mockResource.HistoryClient
 	s.mockHistoryV2Mgr = s.mockResource.HistoryMgr
+	s.mockDomainHandler = domain.NewMockHandler(s.controller)
 
 	params := &service.BootstrapParams{
 		PersistenceConfig: config.Persistence{
@@ -92,7 +97,7 @@ func (s *adminHandlerSuite) SetupTest() {
 		EnableAdminProtection:  dynamicconfig.GetBoolPropertyFn(false),
 		EnableGracefulFailover: dynamicconfig.GetBoolPropertyFn(false),
 	}
-	s.handler = NewAdminHandler(s.mockResource, params, config).(*adminHandlerImpl)
+	s.handler = NewAdminHandler(s.mockResource, params, config, s.mockDomainHandler).(*adminHandlerImpl)
 	s.handler.Start()
 }
 
@@ -131,6 +136,10 @@ func (s *adminHandlerSuite) Test_ConvertIndexedValueTypeToESDataType() {
 			input:    types.IndexedValueTypeDatetime,
 			expected: "date",
 		},
+		{
+			input:    types.IndexedValueTypeKeywordList,
+			expected: "keyword",
+		},
 		{
 			input:    types.IndexedValueType(-1),
 			expected: "",
@@ -580,3 +589,424 @@ func (s *adminHandlerSuite) Test_AddSearchAttribute_Permission() {
 		s.Equal(testCase.Expected, handler.AddSearchAttribute(ctx, testCase.Request))
 	}
 }
+
+func (s *adminHandlerSuite) Test_PauseTaskList_Validate() {
+	ctx := context.Background()
+	activityTaskList := types.TaskListTypeActivity
+
+	err := s.handler.PauseTaskList(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	err = s.handler.PauseTaskList(ctx, &types.PauseTaskListRequest{
+		TaskList:     &types.TaskList{Name: "some random task list"},
+		TaskListType: &activityTaskList,
+	})
+	s.Equal(errDomainNotSet, err)
+
+	err = s.handler.PauseTaskList(ctx, &types.PauseTaskListRequest{
+		Domain:       s.domainName,
+		TaskListType: &activityTaskList,
+	})
+	s.Equal(errTaskListNotSet, err)
+
+	err = s.handler.PauseTaskList(ctx, &types.PauseTaskListRequest{
+		Domain:   s.domainName,
+		TaskList: &types.TaskList{Name: "some random task list"},
+	})
+	s.Equal(errTaskListTypeNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_PauseTaskList() {
+	ctx := context.Background()
+	taskList := &types.TaskList{Name: "some random task list"}
+	activityTaskList := types.TaskListTypeActivity
+	partitions := []*types.TaskListPartitionMetadata{
+		{Key: taskList.Name},
+		{Key: "/__cadence_sys/some random task list/1"},
+	}
+
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.MatchingClient.EXPECT().ListTaskListPartitions(ctx, &types.MatchingListTaskListPartitionsRequest{
+		Domain:   s.domainName,
+		TaskList: taskList,
+	}).Return(&types.ListTaskListPartitionsResponse{
+		ActivityTaskListPartitions: partitions,
+		DecisionTaskListPartitions: []*types.TaskListPartitionMetadata{{Key: taskList.Name}},
+	}, nil).Times(1)
+	for _, partition := range partitions {
+		s.mockResource.MatchingClient.EXPECT().PauseTaskList(ctx, &types.MatchingPauseTaskListRequest{
+			DomainUUID:   s.domainID,
+			TaskList:     &types.TaskList{Name: partition.Key},
+			TaskListType: &activityTaskList,
+		}).Return(nil).Times(1)
+	}
+
+	err := s.handler.PauseTaskList(ctx, &types.PauseTaskListRequest{
+		Domain:       s.domainName,
+		TaskList:     taskList,
+		TaskListType: &activityTaskList,
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_ResumeTaskList() {
+	ctx := context.Background()
+	taskList := &types.TaskList{Name: "some random task list"}
+	decisionTaskList := types.TaskListTypeDecision
+
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.MatchingClient.EXPECT().ListTaskListPartitions(ctx, &types.MatchingListTaskListPartitionsRequest{
+		Domain:   s.domainName,
+		TaskList: taskList,
+	}).Return(&types.ListTaskListPartitionsResponse{
+		DecisionTaskListPartitions: []*types.TaskListPartitionMetadata{{Key: taskList.Name}},
+	}, nil).Times(1)
+	s.mockResource.MatchingClient.EXPECT().ResumeTaskList(ctx, &types.MatchingResumeTaskListRequest{
+		DomainUUID:   s.domainID,
+		TaskList:     &types.TaskList{Name: taskList.Name},
+		TaskListType: &decisionTaskList,
+	}).Return(&types.InternalServiceError{Message: "some random error"}).Times(1)
+
+	err := s.handler.ResumeTaskList(ctx, &types.ResumeTaskListRequest{
+		Domain:       s.domainName,
+		TaskList:     taskList,
+		TaskListType: &decisionTaskList,
+	})
+	s.Error(err)
+}
+
+func (s *adminHandlerSuite) Test_AddRemoteCluster() {
+	ctx := context.Background()
+
+	err := s.handler.AddRemoteCluster(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+	err = s.handler.AddRemoteCluster(ctx, &types.AddRemoteClusterRequest{ClusterName: "remote"})
+	s.Equal(errRPCNameNotSet, err)
+	err = s.handler.AddRemoteCluster(ctx, &types.AddRemoteClusterRequest{ClusterName: "remote", RPCName: "cadence-frontend"})
+	s.Equal(errRPCAddressNotSet, err)
+
+	s.mockResource.RemoteClusterManager.EXPECT().AddRemoteCluster(ctx, "remote", config.ClusterInformation{
+		Enabled:                true,
+		InitialFailoverVersion: 3,
+		RPCName:                "cadence-frontend",
+		RPCAddress:             "127.0.0.1:9104",
+	}).Return(nil).Times(1)
+	err = s.handler.AddRemoteCluster(ctx, &types.AddRemoteClusterRequest{
+		ClusterName:            "remote",
+		InitialFailoverVersion: 3,
+		RPCName:                "cadence-frontend",
+		RPCAddress:             "127.0.0.1:9104",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_RemoveRemoteCluster() {
+	ctx := context.Background()
+
+	err := s.handler.RemoveRemoteCluster(ctx, &types.RemoveRemoteClusterRequest{})
+	s.Equal(errClusterNameNotSet, err)
+
+	s.mockResource.RemoteClusterManager.EXPECT().RemoveRemoteCluster(ctx, "remote").
+		Return(&types.EntityNotExistsError{Message: "some random error"}).Times(1)
+	err = s.handler.RemoveRemoteCluster(ctx, &types.RemoveRemoteClusterRequest{ClusterName: "remote"})
+	s.Error(err)
+}
+
+func (s *adminHandlerSuite) Test_CloneDomain() {
+	ctx := context.Background()
+
+	err := s.handler.CloneDomain(ctx, &types.CloneDomainRequest{SourceName: s.domainName})
+	s.Equal(errDomainNotSet, err)
+
+	request := &types.CloneDomainRequest{SourceName: s.domainName, Name: "new domain name"}
+	s.mockDomainHandler.EXPECT().CloneDomain(ctx, request).Return(nil).Times(1)
+	err = s.handler.CloneDomain(ctx, request)
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_RenameDomain() {
+	ctx := context.Background()
+
+	err := s.handler.RenameDomain(ctx, &types.RenameDomainRequest{Name: s.domainName})
+	s.Equal(errDomainNotSet, err)
+
+	request := &types.RenameDomainRequest{Name: s.domainName, NewName: "new domain name"}
+	s.mockDomainHandler.EXPECT().RenameDomain(ctx, request).Return(&types.DomainAlreadyExistsError{}).Times(1)
+	err = s.handler.RenameDomain(ctx, request)
+	s.IsType(&types.DomainAlreadyExistsError{}, err)
+}
+
+func (s *adminHandlerSuite) Test_DeleteDomain() {
+	ctx := context.Background()
+	mockDeletionClient := domaindeletion.NewMockClient(s.controller)
+	s.handler.domainDeletionClient = mockDeletionClient
+
+	err := s.handler.DeleteDomain(ctx, &types.DeleteDomainRequest{})
+	s.Equal(errDomainNotSet, err)
+
+	request := &types.DeleteDomainRequest{Name: s.domainName}
+	describeRequest := &types.DescribeDomainRequest{Name: common.StringPtr(s.domainName)}
+	s.mockDomainHandler.EXPECT().DescribeDomain(ctx, describeRequest).Return(&types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{Name: s.domainName, Status: types.DomainStatusRegistered.Ptr()},
+	}, nil).Times(1)
+	err = s.handler.DeleteDomain(ctx, request)
+	s.Equal(errDeleteNotDeprecatedDomain, err)
+
+	s.mockDomainHandler.EXPECT().DescribeDomain(ctx, describeRequest).Return(&types.DescribeDomainResponse{
+		DomainInfo:     &types.DomainInfo{Name: s.domainName, Status: types.DomainStatusDeprecated.Ptr()},
+		IsGlobalDomain: true,
+	}, nil).Times(1)
+	err = s.handler.DeleteDomain(ctx, request)
+	s.Equal(errDeleteGlobalDomain, err)
+
+	s.mockDomainHandler.EXPECT().DescribeDomain(ctx, describeRequest).Return(&types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{Name: s.domainName, Status: types.DomainStatusDeprecated.Ptr()},
+	}, nil).Times(1)
+	mockDeletionClient.EXPECT().DeleteDomain(ctx, request).Return(nil).Times(1)
+	err = s.handler.DeleteDomain(ctx, request)
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_MoveTaskListBacklog_Validate() {
+	ctx := context.Background()
+	activityTaskList := types.TaskListTypeActivity
+	taskList := &types.TaskList{Name: "some random task list"}
+
+	_, err := s.handler.MoveTaskListBacklog(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	_, err = s.handler.MoveTaskListBacklog(ctx, &types.MoveTaskListBacklogRequest{
+		SourceTaskList: taskList,
+		TargetTaskList: taskList,
+		TaskListType:   &activityTaskList,
+	})
+	s.Equal(errDomainNotSet, err)
+
+	_, err = s.handler.MoveTaskListBacklog(ctx, &types.MoveTaskListBacklogRequest{
+		Domain:         s.domainName,
+		SourceTaskList: taskList,
+		TaskListType:   &activityTaskList,
+	})
+	s.Equal(errTaskListNotSet, err)
+
+	_, err = s.handler.MoveTaskListBacklog(ctx, &types.MoveTaskListBacklogRequest{
+		Domain:         s.domainName,
+		SourceTaskList: taskList,
+		TargetTaskList: taskList,
+	})
+	s.Equal(errTaskListTypeNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_MoveTaskListBacklog() {
+	ctx := context.Background()
+	sourceTaskList := &types.TaskList{Name: "/__cadence_sys/some random task list/1"}
+	targetTaskList := &types.TaskList{Name: "some other task list"}
+	activityTaskList := types.TaskListTypeActivity
+	expectedResp := &types.MoveTaskListBacklogResponse{Count: 10, LastTaskID: 123, HasMore: true}
+
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.MatchingClient.EXPECT().MoveTaskListBacklog(ctx, &types.MatchingMoveTaskListBacklogRequest{
+		DomainUUID:     s.domainID,
+		SourceTaskList: sourceTaskList,
+		TargetTaskList: targetTaskList,
+		TaskListType:   &activityTaskList,
+		BatchSize:      10,
+		AfterTaskID:    100,
+	}).Return(expectedResp, nil).Times(1)
+
+	resp, err := s.handler.MoveTaskListBacklog(ctx, &types.MoveTaskListBacklogRequest{
+		Domain:         s.domainName,
+		SourceTaskList: sourceTaskList,
+		TargetTaskList: targetTaskList,
+		TaskListType:   &activityTaskList,
+		BatchSize:      10,
+		AfterTaskID:    100,
+	})
+	s.NoError(err)
+	s.Equal(expectedResp, resp)
+}
+
+func (s *adminHandlerSuite) Test_ReadHistoryTaskDLQ() {
+	ctx := context.Background()
+	expectedResp := &types.ReadHistoryTaskDLQResponse{
+		Entries: []*types.HistoryTaskDLQEntry{{TaskID: 101, DomainID: s.domainID}},
+	}
+
+	_, err := s.handler.ReadHistoryTaskDLQ(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	s.mockHistoryClient.EXPECT().ReadHistoryTaskDLQ(ctx, &types.ReadHistoryTaskDLQRequest{
+		ShardID:         1,
+		MaximumPageSize: common.ReadDLQMessagesPageSize,
+	}).Return(expectedResp, nil).Times(1)
+	resp, err := s.handler.ReadHistoryTaskDLQ(ctx, &types.ReadHistoryTaskDLQRequest{ShardID: 1})
+	s.NoError(err)
+	s.Equal(expectedResp, resp)
+}
+
+func (s *adminHandlerSuite) Test_DescribeReplicationStatus() {
+	ctx := context.Background()
+	s.mockResource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+
+	_, err := s.handler.DescribeReplicationStatus(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	_, err = s.handler.DescribeReplicationStatus(ctx, &types.DescribeReplicationStatusRequest{ClusterName: "unknown"})
+	s.IsType(&types.BadRequestError{}, err)
+
+	_, err = s.handler.DescribeReplicationStatus(ctx, &types.DescribeReplicationStatusRequest{ShardIDs: []int32{1}})
+	s.IsType(&types.BadRequestError{}, err)
+
+	s.mockDomainCache.EXPECT().GetDomain(s.domainName).Return(cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName},
+		&persistence.DomainConfig{Retention: 1},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		1,
+		nil,
+	), nil).Times(1)
+	s.mockHistoryClient.EXPECT().DescribeReplicationStatus(ctx, &types.DescribeReplicationStatusRequest{
+		ClusterName: cluster.TestAlternativeClusterName,
+		ShardIDs:    []int32{0},
+	}).Return(&types.DescribeReplicationStatusResponse{
+		Shards: []*types.ReplicationShardStatus{
+			{
+				ShardID:               0,
+				ClusterName:           cluster.TestAlternativeClusterName,
+				MaxTaskID:             150,
+				OutboundAckedTaskID:   100,
+				OutboundLastFetchTime: common.Int64Ptr(10),
+				InboundDLQSize:        2,
+				CrossClusterTaskLag:   5,
+			},
+		},
+	}, nil).Times(1)
+	resp, err := s.handler.DescribeReplicationStatus(ctx, &types.DescribeReplicationStatusRequest{
+		ClusterName: cluster.TestAlternativeClusterName,
+		Domain:      s.domainName,
+	})
+	s.NoError(err)
+	s.Len(resp.Shards, 1)
+	s.Equal([]*types.ReplicationDomainStatus{
+		{
+			Domain:                  s.domainName,
+			ActiveClusterName:       cluster.TestCurrentClusterName,
+			ClusterName:             cluster.TestAlternativeClusterName,
+			MaxOutboundTaskLag:      50,
+			OldestOutboundFetchTime: common.Int64Ptr(10),
+			InboundDLQSize:          2,
+			MaxCrossClusterTaskLag:  5,
+		},
+	}, resp.Domains)
+}
+
+func (s *adminHandlerSuite) Test_PurgeHistoryTaskDLQ() {
+	ctx := context.Background()
+	request := &types.PurgeHistoryTaskDLQRequest{
+		ShardID:              1,
+		ExclusiveBeginTaskID: common.Int64Ptr(100),
+		InclusiveEndTaskID:   common.Int64Ptr(200),
+	}
+
+	err := s.handler.PurgeHistoryTaskDLQ(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	s.mockHistoryClient.EXPECT().PurgeHistoryTaskDLQ(ctx, request).Return(nil).Times(1)
+	err = s.handler.PurgeHistoryTaskDLQ(ctx, request)
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_RetryHistoryTaskDLQ() {
+	ctx := context.Background()
+	expectedResp := &types.RetryHistoryTaskDLQResponse{NextPageToken: []byte("token")}
+
+	_, err := s.handler.RetryHistoryTaskDLQ(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	s.mockHistoryClient.EXPECT().RetryHistoryTaskDLQ(ctx, &types.RetryHistoryTaskDLQRequest{
+		ShardID:         1,
+		MaximumPageSize: 10,
+	}).Return(expectedResp, nil).Times(1)
+	resp, err := s.handler.RetryHistoryTaskDLQ(ctx, &types.RetryHistoryTaskDLQRequest{
+		ShardID:         1,
+		MaximumPageSize: 10,
+	})
+	s.NoError(err)
+	s.Equal(expectedResp, resp)
+}
+
+func (s *adminHandlerSuite) Test_MigrateWorkflowExecution() {
+	ctx := context.Background()
+	sourceDomain := "some random source domain name"
+	execution := &types.WorkflowExecution{WorkflowID: "some random workflow ID", RunID: uuid.New()}
+	s.mockResource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+
+	_, err := s.handler.MigrateWorkflowExecution(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	_, err = s.handler.MigrateWorkflowExecution(ctx, &types.MigrateWorkflowExecutionRequest{WorkflowID: execution.WorkflowID})
+	s.Equal(errDomainNotSet, err)
+
+	_, err = s.handler.MigrateWorkflowExecution(ctx, &types.MigrateWorkflowExecutionRequest{
+		Domain:        s.domainName,
+		WorkflowID:    execution.WorkflowID,
+		SourceCluster: cluster.TestCurrentClusterName,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+
+	s.mockDomainCache.EXPECT().GetDomain(s.domainName).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName},
+		&persistence.DomainConfig{Retention: 1},
+		cluster.TestCurrentClusterName,
+		nil,
+	), nil).Times(1)
+	s.mockHistoryClient.EXPECT().MigrateWorkflowExecution(ctx, &types.HistoryMigrateWorkflowExecutionRequest{
+		DomainUUID:    s.domainID,
+		WorkflowID:    execution.WorkflowID,
+		RunID:         execution.RunID,
+		SourceCluster: cluster.TestAlternativeClusterName,
+		SourceDomain:  sourceDomain,
+	}).Return(&types.MigrateWorkflowExecutionResponse{RunID: execution.RunID, NextEventID: 7}, nil).Times(1)
+
+	resp, err := s.handler.MigrateWorkflowExecution(ctx, &types.MigrateWorkflowExecutionRequest{
+		Domain:        s.domainName,
+		WorkflowID:    execution.WorkflowID,
+		RunID:         execution.RunID,
+		SourceCluster: cluster.TestAlternativeClusterName,
+		SourceDomain:  sourceDomain,
+	})
+	s.NoError(err)
+	s.Equal(&types.MigrateWorkflowExecutionResponse{RunID: execution.RunID, NextEventID: 7}, resp)
+}
+
+func (s *adminHandlerSuite) Test_ListDomainChanges() {
+	ctx := context.Background()
+
+	_, err := s.handler.ListDomainChanges(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+	_, err = s.handler.ListDomainChanges(ctx, &types.ListDomainChangesRequest{})
+	s.Equal(errDomainNotSet, err)
+
+	changes := []*types.DomainChange{
+		{ID: 1, DomainID: s.domainID, DomainName: s.domainName, Operation: "UpdateDomain", Actor: "John Doe"},
+	}
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.DomainAuditLog.EXPECT().List(ctx, s.domainID, defaultListDomainChangesPageSize, []byte("token")).
+		Return(changes, []byte("next token"), nil).Times(1)
+	resp, err := s.handler.ListDomainChanges(ctx, &types.ListDomainChangesRequest{
+		Domain:        s.domainName,
+		NextPageToken: []byte("token"),
+	})
+	s.NoError(err)
+	s.Equal(&types.ListDomainChangesResponse{
+		Changes:       changes,
+		NextPageToken: []byte("next token"),
+	}, resp)
+}
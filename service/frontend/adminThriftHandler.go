@@ -160,6 +160,90 @@ func (t AdminThriftHandler) ResetQueue(ctx context.Context, request *shared.Rese
 	return thrift.FromError(err)
 }
 
+// PauseTaskList forwards request to the underlying handler
+func (t AdminThriftHandler) PauseTaskList(ctx context.Context, request *admin.PauseTaskListRequest) error {
+	err := t.h.PauseTaskList(withThriftTag(ctx), thrift.ToPauseTaskListRequest(request))
+	return thrift.FromError(err)
+}
+
+// ResumeTaskList forwards request to the underlying handler
+func (t AdminThriftHandler) ResumeTaskList(ctx context.Context, request *admin.ResumeTaskListRequest) error {
+	err := t.h.ResumeTaskList(withThriftTag(ctx), thrift.ToResumeTaskListRequest(request))
+	return thrift.FromError(err)
+}
+
+// AddRemoteCluster forwards request to the underlying handler
+func (t AdminThriftHandler) AddRemoteCluster(ctx context.Context, request *admin.AddRemoteClusterRequest) error {
+	err := t.h.AddRemoteCluster(withThriftTag(ctx), thrift.ToAddRemoteClusterRequest(request))
+	return thrift.FromError(err)
+}
+
+// RemoveRemoteCluster forwards request to the underlying handler
+func (t AdminThriftHandler) RemoveRemoteCluster(ctx context.Context, request *admin.RemoveRemoteClusterRequest) error {
+	err := t.h.RemoveRemoteCluster(withThriftTag(ctx), thrift.ToRemoveRemoteClusterRequest(request))
+	return thrift.FromError(err)
+}
+
+// CloneDomain forwards request to the underlying handler
+func (t AdminThriftHandler) CloneDomain(ctx context.Context, request *admin.CloneDomainRequest) error {
+	err := t.h.CloneDomain(withThriftTag(ctx), thrift.ToCloneDomainRequest(request))
+	return thrift.FromError(err)
+}
+
+// RenameDomain forwards request to the underlying handler
+func (t AdminThriftHandler) RenameDomain(ctx context.Context, request *admin.RenameDomainRequest) error {
+	err := t.h.RenameDomain(withThriftTag(ctx), thrift.ToRenameDomainRequest(request))
+	return thrift.FromError(err)
+}
+
+// DeleteDomain forwards request to the underlying handler
+func (t AdminThriftHandler) DeleteDomain(ctx context.Context, request *admin.DeleteDomainRequest) error {
+	err := t.h.DeleteDomain(withThriftTag(ctx), thrift.ToDeleteDomainRequest(request))
+	return thrift.FromError(err)
+}
+
+// MoveTaskListBacklog forwards request to the underlying handler
+func (t AdminThriftHandler) MoveTaskListBacklog(ctx context.Context, request *admin.MoveTaskListBacklogRequest) (*shared.MoveTaskListBacklogResponse, error) {
+	response, err := t.h.MoveTaskListBacklog(withThriftTag(ctx), thrift.ToMoveTaskListBacklogRequest(request))
+	return thrift.FromMoveTaskListBacklogResponse(response), thrift.FromError(err)
+}
+
+// ReadHistoryTaskDLQ forwards request to the underlying handler
+func (t AdminThriftHandler) ReadHistoryTaskDLQ(ctx context.Context, request *shared.ReadHistoryTaskDLQRequest) (*shared.ReadHistoryTaskDLQResponse, error) {
+	response, err := t.h.ReadHistoryTaskDLQ(withThriftTag(ctx), thrift.ToReadHistoryTaskDLQRequest(request))
+	return thrift.FromReadHistoryTaskDLQResponse(response), thrift.FromError(err)
+}
+
+// DescribeReplicationStatus forwards request to the underlying handler
+func (t AdminThriftHandler) DescribeReplicationStatus(ctx context.Context, request *shared.DescribeReplicationStatusRequest) (*shared.DescribeReplicationStatusResponse, error) {
+	response, err := t.h.DescribeReplicationStatus(withThriftTag(ctx), thrift.ToDescribeReplicationStatusRequest(request))
+	return thrift.FromDescribeReplicationStatusResponse(response), thrift.FromError(err)
+}
+
+// MigrateWorkflowExecution forwards request to the underlying handler
+func (t AdminThriftHandler) MigrateWorkflowExecution(ctx context.Context, request *admin.MigrateWorkflowExecutionRequest) (*admin.MigrateWorkflowExecutionResponse, error) {
+	response, err := t.h.MigrateWorkflowExecution(withThriftTag(ctx), thrift.ToMigrateWorkflowExecutionRequest(request))
+	return thrift.FromMigrateWorkflowExecutionResponse(response), thrift.FromError(err)
+}
+
+// ListDomainChanges forwards request to the underlying handler
+func (t AdminThriftHandler) ListDomainChanges(ctx context.Context, request *admin.ListDomainChangesRequest) (*admin.ListDomainChangesResponse, error) {
+	response, err := t.h.ListDomainChanges(withThriftTag(ctx), thrift.ToListDomainChangesRequest(request))
+	return thrift.FromListDomainChangesResponse(response), thrift.FromError(err)
+}
+
+// PurgeHistoryTaskDLQ forwards request to the underlying handler
+func (t AdminThriftHandler) PurgeHistoryTaskDLQ(ctx context.Context, request *shared.PurgeHistoryTaskDLQRequest) error {
+	err := t.h.PurgeHistoryTaskDLQ(withThriftTag(ctx), thrift.ToPurgeHistoryTaskDLQRequest(request))
+	return thrift.FromError(err)
+}
+
+// RetryHistoryTaskDLQ forwards request to the underlying handler
+func (t AdminThriftHandler) RetryHistoryTaskDLQ(ctx context.Context, request *shared.RetryHistoryTaskDLQRequest) (*shared.RetryHistoryTaskDLQResponse, error) {
+	response, err := t.h.RetryHistoryTaskDLQ(withThriftTag(ctx), thrift.ToRetryHistoryTaskDLQRequest(request))
+	return thrift.FromRetryHistoryTaskDLQResponse(response), thrift.FromError(err)
+}
+
 // GetCrossClusterTasks fetches cross cluster tasks
 func (t AdminThriftHandler) GetCrossClusterTasks(ctx context.Context, request *shared.GetCrossClusterTasksRequest) (*shared.GetCrossClusterTasksResponse, error) {
 	response, err := t.h.GetCrossClusterTasks(withThriftTag(ctx), thrift.ToGetCrossClusterTasksRequest(request))
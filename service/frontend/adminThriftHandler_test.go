@@ -146,6 +146,82 @@ func TestAdminThriftHandler(t *testing.T) {
 		err := th.ResetQueue(ctx, &shared.ResetQueueRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("PauseTaskList", func(t *testing.T) {
+		h.EXPECT().PauseTaskList(taggedCtx, &types.PauseTaskListRequest{}).Return(internalErr).Times(1)
+		err := th.PauseTaskList(ctx, &admin.PauseTaskListRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ResumeTaskList", func(t *testing.T) {
+		h.EXPECT().ResumeTaskList(taggedCtx, &types.ResumeTaskListRequest{}).Return(internalErr).Times(1)
+		err := th.ResumeTaskList(ctx, &admin.ResumeTaskListRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("AddRemoteCluster", func(t *testing.T) {
+		h.EXPECT().AddRemoteCluster(taggedCtx, &types.AddRemoteClusterRequest{}).Return(internalErr).Times(1)
+		err := th.AddRemoteCluster(ctx, &admin.AddRemoteClusterRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("RemoveRemoteCluster", func(t *testing.T) {
+		h.EXPECT().RemoveRemoteCluster(taggedCtx, &types.RemoveRemoteClusterRequest{}).Return(internalErr).Times(1)
+		err := th.RemoveRemoteCluster(ctx, &admin.RemoveRemoteClusterRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("CloneDomain", func(t *testing.T) {
+		h.EXPECT().CloneDomain(taggedCtx, &types.CloneDomainRequest{}).Return(internalErr).Times(1)
+		err := th.CloneDomain(ctx, &admin.CloneDomainRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("RenameDomain", func(t *testing.T) {
+		h.EXPECT().RenameDomain(taggedCtx, &types.RenameDomainRequest{}).Return(internalErr).Times(1)
+		err := th.RenameDomain(ctx, &admin.RenameDomainRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("DeleteDomain", func(t *testing.T) {
+		h.EXPECT().DeleteDomain(taggedCtx, &types.DeleteDomainRequest{}).Return(internalErr).Times(1)
+		err := th.DeleteDomain(ctx, &admin.DeleteDomainRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("MoveTaskListBacklog", func(t *testing.T) {
+		h.EXPECT().MoveTaskListBacklog(taggedCtx, &types.MoveTaskListBacklogRequest{}).Return(&types.MoveTaskListBacklogResponse{}, internalErr).Times(1)
+		resp, err := th.MoveTaskListBacklog(ctx, &admin.MoveTaskListBacklogRequest{})
+		assert.Equal(t, shared.MoveTaskListBacklogResponse{Count: common.Int64Ptr(0), LastTaskID: common.Int64Ptr(0), HasMore: common.BoolPtr(false)}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("DescribeReplicationStatus", func(t *testing.T) {
+		h.EXPECT().DescribeReplicationStatus(taggedCtx, &types.DescribeReplicationStatusRequest{}).Return(&types.DescribeReplicationStatusResponse{}, internalErr).Times(1)
+		resp, err := th.DescribeReplicationStatus(ctx, &shared.DescribeReplicationStatusRequest{})
+		assert.Equal(t, shared.DescribeReplicationStatusResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("MigrateWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().MigrateWorkflowExecution(taggedCtx, &types.MigrateWorkflowExecutionRequest{}).Return(&types.MigrateWorkflowExecutionResponse{}, internalErr).Times(1)
+		resp, err := th.MigrateWorkflowExecution(ctx, &admin.MigrateWorkflowExecutionRequest{})
+		assert.Equal(t, admin.MigrateWorkflowExecutionResponse{RunID: common.StringPtr(""), NextEventID: common.Int64Ptr(0)}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ListDomainChanges", func(t *testing.T) {
+		h.EXPECT().ListDomainChanges(taggedCtx, &types.ListDomainChangesRequest{}).Return(&types.ListDomainChangesResponse{}, internalErr).Times(1)
+		resp, err := th.ListDomainChanges(ctx, &admin.ListDomainChangesRequest{})
+		assert.Equal(t, admin.ListDomainChangesResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ReadHistoryTaskDLQ", func(t *testing.T) {
+		h.EXPECT().ReadHistoryTaskDLQ(taggedCtx, &types.ReadHistoryTaskDLQRequest{}).Return(&types.ReadHistoryTaskDLQResponse{}, internalErr).Times(1)
+		resp, err := th.ReadHistoryTaskDLQ(ctx, &shared.ReadHistoryTaskDLQRequest{})
+		assert.Equal(t, shared.ReadHistoryTaskDLQResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("PurgeHistoryTaskDLQ", func(t *testing.T) {
+		h.EXPECT().PurgeHistoryTaskDLQ(taggedCtx, &types.PurgeHistoryTaskDLQRequest{}).Return(internalErr).Times(1)
+		err := th.PurgeHistoryTaskDLQ(ctx, &shared.PurgeHistoryTaskDLQRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("RetryHistoryTaskDLQ", func(t *testing.T) {
+		h.EXPECT().RetryHistoryTaskDLQ(taggedCtx, &types.RetryHistoryTaskDLQRequest{}).Return(&types.RetryHistoryTaskDLQResponse{}, internalErr).Times(1)
+		resp, err := th.RetryHistoryTaskDLQ(ctx, &shared.RetryHistoryTaskDLQRequest{})
+		assert.Equal(t, shared.RetryHistoryTaskDLQResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("GetCrossClusterTasks", func(t *testing.T) {
 		h.EXPECT().GetCrossClusterTasks(taggedCtx, &types.GetCrossClusterTasksRequest{}).Return(&types.GetCrossClusterTasksResponse{}, internalErr).Times(1)
 		resp, err := th.GetCrossClusterTasks(ctx, &shared.GetCrossClusterTasksRequest{})
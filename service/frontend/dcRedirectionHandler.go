@@ -188,6 +188,66 @@ func (handler *DCRedirectionHandlerImpl) DescribeTaskList(
 	return resp, err
 }
 
+// DescribeSchedule API call
+func (handler *DCRedirectionHandlerImpl) DescribeSchedule(
+	ctx context.Context,
+	request *types.DescribeScheduleRequest,
+) (resp *types.DescribeScheduleResponse, retError error) {
+
+	var apiName = "DescribeSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDescribeScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.DescribeSchedule(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.DescribeSchedule(ctx, request)
+		}
+		return err
+	})
+
+	return resp, err
+}
+
+// ListSchedules API call
+func (handler *DCRedirectionHandlerImpl) ListSchedules(
+	ctx context.Context,
+	request *types.ListSchedulesRequest,
+) (resp *types.ListSchedulesResponse, retError error) {
+
+	var apiName = "ListSchedules"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionListSchedulesScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.ListSchedules(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.ListSchedules(ctx, request)
+		}
+		return err
+	})
+
+	return resp, err
+}
+
 // DescribeWorkflowExecution API call
 func (handler *DCRedirectionHandlerImpl) DescribeWorkflowExecution(
 	ctx context.Context,
@@ -634,6 +694,366 @@ func (handler *DCRedirectionHandlerImpl) RequestCancelWorkflowExecution(
 	return err
 }
 
+// PauseWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *types.PauseWorkflowExecutionRequest,
+) (retError error) {
+
+	var apiName = "PauseWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPauseWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.PauseWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.PauseWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// CreateSchedule API call
+func (handler *DCRedirectionHandlerImpl) CreateSchedule(
+	ctx context.Context,
+	request *types.CreateScheduleRequest,
+) (retError error) {
+
+	var apiName = "CreateSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionCreateScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.CreateSchedule(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.CreateSchedule(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// UpdateSchedule API call
+func (handler *DCRedirectionHandlerImpl) UpdateSchedule(
+	ctx context.Context,
+	request *types.UpdateScheduleRequest,
+) (retError error) {
+
+	var apiName = "UpdateSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUpdateScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.UpdateSchedule(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.UpdateSchedule(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// PauseSchedule API call
+func (handler *DCRedirectionHandlerImpl) PauseSchedule(
+	ctx context.Context,
+	request *types.PauseScheduleRequest,
+) (retError error) {
+
+	var apiName = "PauseSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPauseScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.PauseSchedule(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.PauseSchedule(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// UnpauseSchedule API call
+func (handler *DCRedirectionHandlerImpl) UnpauseSchedule(
+	ctx context.Context,
+	request *types.UnpauseScheduleRequest,
+) (retError error) {
+
+	var apiName = "UnpauseSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUnpauseScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.UnpauseSchedule(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.UnpauseSchedule(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// BackfillSchedule API call
+func (handler *DCRedirectionHandlerImpl) BackfillSchedule(
+	ctx context.Context,
+	request *types.BackfillScheduleRequest,
+) (retError error) {
+
+	var apiName = "BackfillSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionBackfillScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.BackfillSchedule(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.BackfillSchedule(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// UnpauseWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *types.UnpauseWorkflowExecutionRequest,
+) (retError error) {
+
+	var apiName = "UnpauseWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUnpauseWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.UnpauseWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.UnpauseWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// DeleteWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *types.DeleteWorkflowExecutionRequest,
+) (retError error) {
+
+	var apiName = "DeleteWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDeleteWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.DeleteWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.DeleteWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// PauseActivity API call
+func (handler *DCRedirectionHandlerImpl) PauseActivity(
+	ctx context.Context,
+	request *types.PauseActivityRequest,
+) (retError error) {
+
+	var apiName = "PauseActivity"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPauseActivityScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.PauseActivity(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.PauseActivity(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// UnpauseActivity API call
+func (handler *DCRedirectionHandlerImpl) UnpauseActivity(
+	ctx context.Context,
+	request *types.UnpauseActivityRequest,
+) (retError error) {
+
+	var apiName = "UnpauseActivity"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUnpauseActivityScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.UnpauseActivity(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.UnpauseActivity(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// ResetActivity API call
+func (handler *DCRedirectionHandlerImpl) ResetActivity(
+	ctx context.Context,
+	request *types.ResetActivityRequest,
+) (retError error) {
+
+	var apiName = "ResetActivity"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionResetActivityScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.ResetActivity(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.ResetActivity(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// UpdateWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.UpdateWorkflowExecutionRequest,
+) (resp *types.UpdateWorkflowExecutionResponse, retError error) {
+
+	var apiName = "UpdateWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUpdateWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.UpdateWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.UpdateWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+
+	return resp, err
+}
+
 // ResetStickyTaskList API call
 func (handler *DCRedirectionHandlerImpl) ResetStickyTaskList(
 	ctx context.Context,
@@ -694,6 +1114,36 @@ func (handler *DCRedirectionHandlerImpl) ResetWorkflowExecution(
 	return resp, err
 }
 
+// RestartWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) RestartWorkflowExecution(
+	ctx context.Context,
+	request *types.RestartWorkflowExecutionRequest,
+) (resp *types.RestartWorkflowExecutionResponse, retError error) {
+
+	var apiName = "RestartWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionRestartWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.RestartWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.RestartWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+
+	return resp, err
+}
+
 // RespondActivityTaskCanceled API call
 func (handler *DCRedirectionHandlerImpl) RespondActivityTaskCanceled(
 	ctx context.Context,
@@ -463,6 +463,306 @@ func (s *dcRedirectionHandlerSuite) TestRequestCancelWorkflowExecution() {
 	s.Nil(err)
 }
 
+func (s *dcRedirectionHandlerSuite) TestPauseWorkflowExecution() {
+	apiName := "PauseWorkflowExecution"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &types.PauseWorkflowExecutionRequest{
+		Domain: s.domainName,
+	}
+	err := s.handler.PauseWorkflowExecution(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().PauseWorkflowExecution(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().PauseWorkflowExecution(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestDeleteWorkflowExecution() {
+	apiName := "DeleteWorkflowExecution"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &types.DeleteWorkflowExecutionRequest{
+		Domain: s.domainName,
+	}
+	err := s.handler.DeleteWorkflowExecution(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().DeleteWorkflowExecution(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestPauseActivity() {
+	apiName := "PauseActivity"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &types.PauseActivityRequest{
+		Domain: s.domainName,
+	}
+	err := s.handler.PauseActivity(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().PauseActivity(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().PauseActivity(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestUnpauseActivity() {
+	apiName := "UnpauseActivity"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &types.UnpauseActivityRequest{
+		Domain: s.domainName,
+	}
+	err := s.handler.UnpauseActivity(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().UnpauseActivity(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().UnpauseActivity(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestResetActivity() {
+	apiName := "ResetActivity"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &types.ResetActivityRequest{
+		Domain: s.domainName,
+	}
+	err := s.handler.ResetActivity(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().ResetActivity(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().ResetActivity(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestUnpauseWorkflowExecution() {
+	apiName := "UnpauseWorkflowExecution"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &types.UnpauseWorkflowExecutionRequest{
+		Domain: s.domainName,
+	}
+	err := s.handler.UnpauseWorkflowExecution(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().UnpauseWorkflowExecution(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().UnpauseWorkflowExecution(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestUpdateWorkflowExecution() {
+	apiName := "UpdateWorkflowExecution"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &types.UpdateWorkflowExecutionRequest{
+		Domain: s.domainName,
+	}
+	resp, err := s.handler.UpdateWorkflowExecution(context.Background(), req)
+	s.Nil(err)
+	// the resp is initialized to nil, since inner function is not called
+	s.Nil(resp)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().UpdateWorkflowExecution(gomock.Any(), req).Return(&types.UpdateWorkflowExecutionResponse{}, nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), req).Return(&types.UpdateWorkflowExecutionResponse{}, nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestCreateSchedule() {
+	apiName := "CreateSchedule"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &types.CreateScheduleRequest{
+		Domain: s.domainName,
+	}
+	err := s.handler.CreateSchedule(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().CreateSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().CreateSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestDescribeSchedule() {
+	apiName := "DescribeSchedule"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &types.DescribeScheduleRequest{
+		Domain: s.domainName,
+	}
+	resp, err := s.handler.DescribeSchedule(context.Background(), req)
+	s.Nil(err)
+	// the resp is initialized to nil, since inner function is not called
+	s.Nil(resp)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().DescribeSchedule(gomock.Any(), req).Return(&types.DescribeScheduleResponse{}, nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().DescribeSchedule(gomock.Any(), req).Return(&types.DescribeScheduleResponse{}, nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestUpdateSchedule() {
+	apiName := "UpdateSchedule"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &types.UpdateScheduleRequest{
+		Domain: s.domainName,
+	}
+	err := s.handler.UpdateSchedule(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().UpdateSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().UpdateSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestPauseSchedule() {
+	apiName := "PauseSchedule"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &types.PauseScheduleRequest{
+		Domain: s.domainName,
+	}
+	err := s.handler.PauseSchedule(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().PauseSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().PauseSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestUnpauseSchedule() {
+	apiName := "UnpauseSchedule"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &types.UnpauseScheduleRequest{
+		Domain: s.domainName,
+	}
+	err := s.handler.UnpauseSchedule(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().UnpauseSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().UnpauseSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestBackfillSchedule() {
+	apiName := "BackfillSchedule"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &types.BackfillScheduleRequest{
+		Domain: s.domainName,
+	}
+	err := s.handler.BackfillSchedule(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().BackfillSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().BackfillSchedule(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestListSchedules() {
+	apiName := "ListSchedules"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &types.ListSchedulesRequest{
+		Domain: s.domainName,
+	}
+	resp, err := s.handler.ListSchedules(context.Background(), req)
+	s.Nil(err)
+	// the resp is initialized to nil, since inner function is not called
+	s.Nil(resp)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().ListSchedules(gomock.Any(), req).Return(&types.ListSchedulesResponse{}, nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().ListSchedules(gomock.Any(), req).Return(&types.ListSchedulesResponse{}, nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
 func (s *dcRedirectionHandlerSuite) TestResetStickyTaskList() {
 	apiName := "ResetStickyTaskList"
 
@@ -509,6 +809,29 @@ func (s *dcRedirectionHandlerSuite) TestResetWorkflowExecution() {
 	s.Nil(err)
 }
 
+func (s *dcRedirectionHandlerSuite) TestRestartWorkflowExecution() {
+	apiName := "RestartWorkflowExecution"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &types.RestartWorkflowExecutionRequest{
+		Domain: s.domainName,
+	}
+	resp, err := s.handler.RestartWorkflowExecution(context.Background(), req)
+	s.Nil(err)
+	// the resp is initialized to nil, since inner function is not called
+	s.Nil(resp)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().RestartWorkflowExecution(gomock.Any(), req).Return(&types.RestartWorkflowExecutionResponse{}, nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().RestartWorkflowExecution(gomock.Any(), req).Return(&types.RestartWorkflowExecutionResponse{}, nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
 func (s *dcRedirectionHandlerSuite) TestRespondActivityTaskCanceled() {
 	apiName := "RespondActivityTaskCanceled"
 
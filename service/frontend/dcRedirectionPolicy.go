@@ -79,6 +79,21 @@ var selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs = map[string]struct{}
 	"TerminateWorkflowExecution":       {},
 	"QueryWorkflow":                    {},
 	"ResetWorkflowExecution":           {},
+	"RestartWorkflowExecution":         {},
+	"PauseWorkflowExecution":           {},
+	"UnpauseWorkflowExecution":         {},
+	"DeleteWorkflowExecution":          {},
+	"PauseActivity":                    {},
+	"UnpauseActivity":                  {},
+	"ResetActivity":                    {},
+	"UpdateWorkflowExecution":          {},
+	"CreateSchedule":                   {},
+	"DescribeSchedule":                 {},
+	"UpdateSchedule":                   {},
+	"PauseSchedule":                    {},
+	"UnpauseSchedule":                  {},
+	"BackfillSchedule":                 {},
+	"ListSchedules":                    {},
 }
 
 // RedirectionPolicyGenerator generate corresponding redirection policy
@@ -71,6 +71,16 @@ func (g grpcHandler) DescribeTaskList(ctx context.Context, request *apiv1.Descri
 	return proto.FromDescribeTaskListResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) DescribeSchedule(ctx context.Context, request *apiv1.DescribeScheduleRequest) (*apiv1.DescribeScheduleResponse, error) {
+	response, err := g.h.DescribeSchedule(withGRPCTag(ctx), proto.ToDescribeScheduleRequest(request))
+	return proto.FromDescribeScheduleResponse(response), proto.FromError(err)
+}
+
+func (g grpcHandler) ListSchedules(ctx context.Context, request *apiv1.ListSchedulesRequest) (*apiv1.ListSchedulesResponse, error) {
+	response, err := g.h.ListSchedules(withGRPCTag(ctx), proto.ToListSchedulesRequest(request))
+	return proto.FromListSchedulesResponse(response), proto.FromError(err)
+}
+
 func (g grpcHandler) DescribeWorkflowExecution(ctx context.Context, request *apiv1.DescribeWorkflowExecutionRequest) (*apiv1.DescribeWorkflowExecutionResponse, error) {
 	response, err := g.h.DescribeWorkflowExecution(withGRPCTag(ctx), proto.ToDescribeWorkflowExecutionRequest(request))
 	return proto.FromDescribeWorkflowExecutionResponse(response), proto.FromError(err)
@@ -141,6 +151,11 @@ func (g grpcHandler) QueryWorkflow(ctx context.Context, request *apiv1.QueryWork
 	return proto.FromQueryWorkflowResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) UpdateWorkflowExecution(ctx context.Context, request *apiv1.UpdateWorkflowExecutionRequest) (*apiv1.UpdateWorkflowExecutionResponse, error) {
+	response, err := g.h.UpdateWorkflowExecution(withGRPCTag(ctx), proto.ToUpdateWorkflowExecutionRequest(request))
+	return proto.FromUpdateWorkflowExecutionResponse(response), proto.FromError(err)
+}
+
 func (g grpcHandler) RecordActivityTaskHeartbeat(ctx context.Context, request *apiv1.RecordActivityTaskHeartbeatRequest) (*apiv1.RecordActivityTaskHeartbeatResponse, error) {
 	response, err := g.h.RecordActivityTaskHeartbeat(withGRPCTag(ctx), proto.ToRecordActivityTaskHeartbeatRequest(request))
 	return proto.FromRecordActivityTaskHeartbeatResponse(response), proto.FromError(err)
@@ -161,6 +176,61 @@ func (g grpcHandler) RequestCancelWorkflowExecution(ctx context.Context, request
 	return &apiv1.RequestCancelWorkflowExecutionResponse{}, proto.FromError(err)
 }
 
+func (g grpcHandler) PauseWorkflowExecution(ctx context.Context, request *apiv1.PauseWorkflowExecutionRequest) (*apiv1.PauseWorkflowExecutionResponse, error) {
+	err := g.h.PauseWorkflowExecution(withGRPCTag(ctx), proto.ToPauseWorkflowExecutionRequest(request))
+	return &apiv1.PauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) CreateSchedule(ctx context.Context, request *apiv1.CreateScheduleRequest) (*apiv1.CreateScheduleResponse, error) {
+	err := g.h.CreateSchedule(withGRPCTag(ctx), proto.ToCreateScheduleRequest(request))
+	return &apiv1.CreateScheduleResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) UpdateSchedule(ctx context.Context, request *apiv1.UpdateScheduleRequest) (*apiv1.UpdateScheduleResponse, error) {
+	err := g.h.UpdateSchedule(withGRPCTag(ctx), proto.ToUpdateScheduleRequest(request))
+	return &apiv1.UpdateScheduleResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) PauseSchedule(ctx context.Context, request *apiv1.PauseScheduleRequest) (*apiv1.PauseScheduleResponse, error) {
+	err := g.h.PauseSchedule(withGRPCTag(ctx), proto.ToPauseScheduleRequest(request))
+	return &apiv1.PauseScheduleResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) UnpauseSchedule(ctx context.Context, request *apiv1.UnpauseScheduleRequest) (*apiv1.UnpauseScheduleResponse, error) {
+	err := g.h.UnpauseSchedule(withGRPCTag(ctx), proto.ToUnpauseScheduleRequest(request))
+	return &apiv1.UnpauseScheduleResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) BackfillSchedule(ctx context.Context, request *apiv1.BackfillScheduleRequest) (*apiv1.BackfillScheduleResponse, error) {
+	err := g.h.BackfillSchedule(withGRPCTag(ctx), proto.ToBackfillScheduleRequest(request))
+	return &apiv1.BackfillScheduleResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) UnpauseWorkflowExecution(ctx context.Context, request *apiv1.UnpauseWorkflowExecutionRequest) (*apiv1.UnpauseWorkflowExecutionResponse, error) {
+	err := g.h.UnpauseWorkflowExecution(withGRPCTag(ctx), proto.ToUnpauseWorkflowExecutionRequest(request))
+	return &apiv1.UnpauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) DeleteWorkflowExecution(ctx context.Context, request *apiv1.DeleteWorkflowExecutionRequest) (*apiv1.DeleteWorkflowExecutionResponse, error) {
+	err := g.h.DeleteWorkflowExecution(withGRPCTag(ctx), proto.ToDeleteWorkflowExecutionRequest(request))
+	return &apiv1.DeleteWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) PauseActivity(ctx context.Context, request *apiv1.PauseActivityRequest) (*apiv1.PauseActivityResponse, error) {
+	err := g.h.PauseActivity(withGRPCTag(ctx), proto.ToPauseActivityRequest(request))
+	return &apiv1.PauseActivityResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) UnpauseActivity(ctx context.Context, request *apiv1.UnpauseActivityRequest) (*apiv1.UnpauseActivityResponse, error) {
+	err := g.h.UnpauseActivity(withGRPCTag(ctx), proto.ToUnpauseActivityRequest(request))
+	return &apiv1.UnpauseActivityResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) ResetActivity(ctx context.Context, request *apiv1.ResetActivityRequest) (*apiv1.ResetActivityResponse, error) {
+	err := g.h.ResetActivity(withGRPCTag(ctx), proto.ToResetActivityRequest(request))
+	return &apiv1.ResetActivityResponse{}, proto.FromError(err)
+}
+
 func (g grpcHandler) ResetStickyTaskList(ctx context.Context, request *apiv1.ResetStickyTaskListRequest) (*apiv1.ResetStickyTaskListResponse, error) {
 	_, err := g.h.ResetStickyTaskList(withGRPCTag(ctx), proto.ToResetStickyTaskListRequest(request))
 	return &apiv1.ResetStickyTaskListResponse{}, proto.FromError(err)
@@ -171,6 +241,11 @@ func (g grpcHandler) ResetWorkflowExecution(ctx context.Context, request *apiv1.
 	return proto.FromResetWorkflowExecutionResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) RestartWorkflowExecution(ctx context.Context, request *apiv1.RestartWorkflowExecutionRequest) (*apiv1.RestartWorkflowExecutionResponse, error) {
+	response, err := g.h.RestartWorkflowExecution(withGRPCTag(ctx), proto.ToRestartWorkflowExecutionRequest(request))
+	return proto.FromRestartWorkflowExecutionResponse(response), proto.FromError(err)
+}
+
 func (g grpcHandler) RespondActivityTaskCanceled(ctx context.Context, request *apiv1.RespondActivityTaskCanceledRequest) (*apiv1.RespondActivityTaskCanceledResponse, error) {
 	err := g.h.RespondActivityTaskCanceled(withGRPCTag(ctx), proto.ToRespondActivityTaskCanceledRequest(request))
 	return &apiv1.RespondActivityTaskCanceledResponse{}, proto.FromError(err)
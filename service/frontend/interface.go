@@ -36,6 +36,8 @@ type (
 		DeprecateDomain(context.Context, *types.DeprecateDomainRequest) error
 		DescribeDomain(context.Context, *types.DescribeDomainRequest) (*types.DescribeDomainResponse, error)
 		DescribeTaskList(context.Context, *types.DescribeTaskListRequest) (*types.DescribeTaskListResponse, error)
+		DescribeSchedule(context.Context, *types.DescribeScheduleRequest) (*types.DescribeScheduleResponse, error)
+		ListSchedules(context.Context, *types.ListSchedulesRequest) (*types.ListSchedulesResponse, error)
 		DescribeWorkflowExecution(context.Context, *types.DescribeWorkflowExecutionRequest) (*types.DescribeWorkflowExecutionResponse, error)
 		GetClusterInfo(context.Context) (*types.ClusterInfo, error)
 		GetSearchAttributes(context.Context) (*types.GetSearchAttributesResponse, error)
@@ -50,12 +52,25 @@ type (
 		PollForActivityTask(context.Context, *types.PollForActivityTaskRequest) (*types.PollForActivityTaskResponse, error)
 		PollForDecisionTask(context.Context, *types.PollForDecisionTaskRequest) (*types.PollForDecisionTaskResponse, error)
 		QueryWorkflow(context.Context, *types.QueryWorkflowRequest) (*types.QueryWorkflowResponse, error)
+		UpdateWorkflowExecution(context.Context, *types.UpdateWorkflowExecutionRequest) (*types.UpdateWorkflowExecutionResponse, error)
 		RecordActivityTaskHeartbeat(context.Context, *types.RecordActivityTaskHeartbeatRequest) (*types.RecordActivityTaskHeartbeatResponse, error)
 		RecordActivityTaskHeartbeatByID(context.Context, *types.RecordActivityTaskHeartbeatByIDRequest) (*types.RecordActivityTaskHeartbeatResponse, error)
 		RegisterDomain(context.Context, *types.RegisterDomainRequest) error
 		RequestCancelWorkflowExecution(context.Context, *types.RequestCancelWorkflowExecutionRequest) error
+		PauseWorkflowExecution(context.Context, *types.PauseWorkflowExecutionRequest) error
+		CreateSchedule(context.Context, *types.CreateScheduleRequest) error
+		UpdateSchedule(context.Context, *types.UpdateScheduleRequest) error
+		PauseSchedule(context.Context, *types.PauseScheduleRequest) error
+		UnpauseSchedule(context.Context, *types.UnpauseScheduleRequest) error
+		BackfillSchedule(context.Context, *types.BackfillScheduleRequest) error
+		UnpauseWorkflowExecution(context.Context, *types.UnpauseWorkflowExecutionRequest) error
+		DeleteWorkflowExecution(context.Context, *types.DeleteWorkflowExecutionRequest) error
+		PauseActivity(context.Context, *types.PauseActivityRequest) error
+		UnpauseActivity(context.Context, *types.UnpauseActivityRequest) error
+		ResetActivity(context.Context, *types.ResetActivityRequest) error
 		ResetStickyTaskList(context.Context, *types.ResetStickyTaskListRequest) (*types.ResetStickyTaskListResponse, error)
 		ResetWorkflowExecution(context.Context, *types.ResetWorkflowExecutionRequest) (*types.ResetWorkflowExecutionResponse, error)
+		RestartWorkflowExecution(context.Context, *types.RestartWorkflowExecutionRequest) (*types.RestartWorkflowExecutionResponse, error)
 		RespondActivityTaskCanceled(context.Context, *types.RespondActivityTaskCanceledRequest) error
 		RespondActivityTaskCanceledByID(context.Context, *types.RespondActivityTaskCanceledByIDRequest) error
 		RespondActivityTaskCompleted(context.Context, *types.RespondActivityTaskCompletedRequest) error
@@ -80,6 +80,18 @@ func (t ThriftHandler) DescribeTaskList(ctx context.Context, request *shared.Des
 	return thrift.FromDescribeTaskListResponse(response), thrift.FromError(err)
 }
 
+// DescribeSchedule forwards request to the underlying handler
+func (t ThriftHandler) DescribeSchedule(ctx context.Context, request *shared.DescribeScheduleRequest) (*shared.DescribeScheduleResponse, error) {
+	response, err := t.h.DescribeSchedule(withThriftTag(ctx), thrift.ToDescribeScheduleRequest(request))
+	return thrift.FromDescribeScheduleResponse(response), thrift.FromError(err)
+}
+
+// ListSchedules forwards request to the underlying handler
+func (t ThriftHandler) ListSchedules(ctx context.Context, request *shared.ListSchedulesRequest) (*shared.ListSchedulesResponse, error) {
+	response, err := t.h.ListSchedules(withThriftTag(ctx), thrift.ToListSchedulesRequest(request))
+	return thrift.FromListSchedulesResponse(response), thrift.FromError(err)
+}
+
 // DescribeWorkflowExecution forwards request to the underlying handler
 func (t ThriftHandler) DescribeWorkflowExecution(ctx context.Context, request *shared.DescribeWorkflowExecutionRequest) (*shared.DescribeWorkflowExecutionResponse, error) {
 	response, err := t.h.DescribeWorkflowExecution(withThriftTag(ctx), thrift.ToDescribeWorkflowExecutionRequest(request))
@@ -164,6 +176,12 @@ func (t ThriftHandler) QueryWorkflow(ctx context.Context, request *shared.QueryW
 	return thrift.FromQueryWorkflowResponse(response), thrift.FromError(err)
 }
 
+// UpdateWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) UpdateWorkflowExecution(ctx context.Context, request *shared.UpdateWorkflowExecutionRequest) (*shared.UpdateWorkflowExecutionResponse, error) {
+	response, err := t.h.UpdateWorkflowExecution(withThriftTag(ctx), thrift.ToUpdateWorkflowExecutionRequest(request))
+	return thrift.FromUpdateWorkflowExecutionResponse(response), thrift.FromError(err)
+}
+
 // RecordActivityTaskHeartbeat forwards request to the underlying handler
 func (t ThriftHandler) RecordActivityTaskHeartbeat(ctx context.Context, request *shared.RecordActivityTaskHeartbeatRequest) (*shared.RecordActivityTaskHeartbeatResponse, error) {
 	response, err := t.h.RecordActivityTaskHeartbeat(withThriftTag(ctx), thrift.ToRecordActivityTaskHeartbeatRequest(request))
@@ -188,6 +206,72 @@ func (t ThriftHandler) RequestCancelWorkflowExecution(ctx context.Context, reque
 	return thrift.FromError(err)
 }
 
+// PauseWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) PauseWorkflowExecution(ctx context.Context, request *shared.PauseWorkflowExecutionRequest) error {
+	err := t.h.PauseWorkflowExecution(withThriftTag(ctx), thrift.ToPauseWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
+// CreateSchedule forwards request to the underlying handler
+func (t ThriftHandler) CreateSchedule(ctx context.Context, request *shared.CreateScheduleRequest) error {
+	err := t.h.CreateSchedule(withThriftTag(ctx), thrift.ToCreateScheduleRequest(request))
+	return thrift.FromError(err)
+}
+
+// UpdateSchedule forwards request to the underlying handler
+func (t ThriftHandler) UpdateSchedule(ctx context.Context, request *shared.UpdateScheduleRequest) error {
+	err := t.h.UpdateSchedule(withThriftTag(ctx), thrift.ToUpdateScheduleRequest(request))
+	return thrift.FromError(err)
+}
+
+// PauseSchedule forwards request to the underlying handler
+func (t ThriftHandler) PauseSchedule(ctx context.Context, request *shared.PauseScheduleRequest) error {
+	err := t.h.PauseSchedule(withThriftTag(ctx), thrift.ToPauseScheduleRequest(request))
+	return thrift.FromError(err)
+}
+
+// UnpauseSchedule forwards request to the underlying handler
+func (t ThriftHandler) UnpauseSchedule(ctx context.Context, request *shared.UnpauseScheduleRequest) error {
+	err := t.h.UnpauseSchedule(withThriftTag(ctx), thrift.ToUnpauseScheduleRequest(request))
+	return thrift.FromError(err)
+}
+
+// BackfillSchedule forwards request to the underlying handler
+func (t ThriftHandler) BackfillSchedule(ctx context.Context, request *shared.BackfillScheduleRequest) error {
+	err := t.h.BackfillSchedule(withThriftTag(ctx), thrift.ToBackfillScheduleRequest(request))
+	return thrift.FromError(err)
+}
+
+// UnpauseWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) UnpauseWorkflowExecution(ctx context.Context, request *shared.UnpauseWorkflowExecutionRequest) error {
+	err := t.h.UnpauseWorkflowExecution(withThriftTag(ctx), thrift.ToUnpauseWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
+// DeleteWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) DeleteWorkflowExecution(ctx context.Context, request *shared.DeleteWorkflowExecutionRequest) error {
+	err := t.h.DeleteWorkflowExecution(withThriftTag(ctx), thrift.ToDeleteWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
+// PauseActivity forwards request to the underlying handler
+func (t ThriftHandler) PauseActivity(ctx context.Context, request *shared.PauseActivityRequest) error {
+	err := t.h.PauseActivity(withThriftTag(ctx), thrift.ToPauseActivityRequest(request))
+	return thrift.FromError(err)
+}
+
+// UnpauseActivity forwards request to the underlying handler
+func (t ThriftHandler) UnpauseActivity(ctx context.Context, request *shared.UnpauseActivityRequest) error {
+	err := t.h.UnpauseActivity(withThriftTag(ctx), thrift.ToUnpauseActivityRequest(request))
+	return thrift.FromError(err)
+}
+
+// ResetActivity forwards request to the underlying handler
+func (t ThriftHandler) ResetActivity(ctx context.Context, request *shared.ResetActivityRequest) error {
+	err := t.h.ResetActivity(withThriftTag(ctx), thrift.ToResetActivityRequest(request))
+	return thrift.FromError(err)
+}
+
 // ResetStickyTaskList forwards request to the underlying handler
 func (t ThriftHandler) ResetStickyTaskList(ctx context.Context, request *shared.ResetStickyTaskListRequest) (*shared.ResetStickyTaskListResponse, error) {
 	response, err := t.h.ResetStickyTaskList(withThriftTag(ctx), thrift.ToResetStickyTaskListRequest(request))
@@ -200,6 +284,12 @@ func (t ThriftHandler) ResetWorkflowExecution(ctx context.Context, request *shar
 	return thrift.FromResetWorkflowExecutionResponse(response), thrift.FromError(err)
 }
 
+// RestartWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) RestartWorkflowExecution(ctx context.Context, request *shared.RestartWorkflowExecutionRequest) (*shared.RestartWorkflowExecutionResponse, error) {
+	response, err := t.h.RestartWorkflowExecution(withThriftTag(ctx), thrift.ToRestartWorkflowExecutionRequest(request))
+	return thrift.FromRestartWorkflowExecutionResponse(response), thrift.FromError(err)
+}
+
 // RespondActivityTaskCanceled forwards request to the underlying handler
 func (t ThriftHandler) RespondActivityTaskCanceled(ctx context.Context, request *shared.RespondActivityTaskCanceledRequest) error {
 	err := t.h.RespondActivityTaskCanceled(withThriftTag(ctx), thrift.ToRespondActivityTaskCanceledRequest(request))
@@ -53,12 +53,27 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, health.HealthStatus{Msg: common.StringPtr("")}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("BackfillSchedule", func(t *testing.T) {
+		h.EXPECT().BackfillSchedule(taggedCtx, &types.BackfillScheduleRequest{}).Return(internalErr).Times(1)
+		err := th.BackfillSchedule(ctx, &shared.BackfillScheduleRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("CountWorkflowExecutions", func(t *testing.T) {
 		h.EXPECT().CountWorkflowExecutions(taggedCtx, &types.CountWorkflowExecutionsRequest{}).Return(&types.CountWorkflowExecutionsResponse{}, internalErr).Times(1)
 		resp, err := th.CountWorkflowExecutions(ctx, &shared.CountWorkflowExecutionsRequest{})
 		assert.Equal(t, shared.CountWorkflowExecutionsResponse{Count: common.Int64Ptr(0)}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("CreateSchedule", func(t *testing.T) {
+		h.EXPECT().CreateSchedule(taggedCtx, &types.CreateScheduleRequest{}).Return(internalErr).Times(1)
+		err := th.CreateSchedule(ctx, &shared.CreateScheduleRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("DeleteWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().DeleteWorkflowExecution(taggedCtx, &types.DeleteWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.DeleteWorkflowExecution(ctx, &shared.DeleteWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("DeprecateDomain", func(t *testing.T) {
 		h.EXPECT().DeprecateDomain(taggedCtx, &types.DeprecateDomainRequest{}).Return(internalErr).Times(1)
 		err := th.DeprecateDomain(ctx, &shared.DeprecateDomainRequest{})
@@ -70,6 +85,12 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.DescribeDomainResponse{IsGlobalDomain: common.BoolPtr(false), FailoverVersion: common.Int64Ptr(0)}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("DescribeSchedule", func(t *testing.T) {
+		h.EXPECT().DescribeSchedule(taggedCtx, &types.DescribeScheduleRequest{}).Return(&types.DescribeScheduleResponse{}, internalErr).Times(1)
+		resp, err := th.DescribeSchedule(ctx, &shared.DescribeScheduleRequest{})
+		assert.Equal(t, shared.DescribeScheduleResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("DescribeTaskList", func(t *testing.T) {
 		h.EXPECT().DescribeTaskList(taggedCtx, &types.DescribeTaskListRequest{}).Return(&types.DescribeTaskListResponse{}, internalErr).Times(1)
 		resp, err := th.DescribeTaskList(ctx, &shared.DescribeTaskListRequest{})
@@ -124,6 +145,12 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.ListOpenWorkflowExecutionsResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("ListSchedules", func(t *testing.T) {
+		h.EXPECT().ListSchedules(taggedCtx, &types.ListSchedulesRequest{}).Return(&types.ListSchedulesResponse{}, internalErr).Times(1)
+		resp, err := th.ListSchedules(ctx, &shared.ListSchedulesRequest{})
+		assert.Equal(t, shared.ListSchedulesResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("ListTaskListPartitions", func(t *testing.T) {
 		h.EXPECT().ListTaskListPartitions(taggedCtx, &types.ListTaskListPartitionsRequest{}).Return(&types.ListTaskListPartitionsResponse{}, internalErr).Times(1)
 		resp, err := th.ListTaskListPartitions(ctx, &shared.ListTaskListPartitionsRequest{})
@@ -136,6 +163,21 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.ListWorkflowExecutionsResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("PauseActivity", func(t *testing.T) {
+		h.EXPECT().PauseActivity(taggedCtx, &types.PauseActivityRequest{}).Return(internalErr).Times(1)
+		err := th.PauseActivity(ctx, &shared.PauseActivityRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("PauseSchedule", func(t *testing.T) {
+		h.EXPECT().PauseSchedule(taggedCtx, &types.PauseScheduleRequest{}).Return(internalErr).Times(1)
+		err := th.PauseSchedule(ctx, &shared.PauseScheduleRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("PauseWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().PauseWorkflowExecution(taggedCtx, &types.PauseWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.PauseWorkflowExecution(ctx, &shared.PauseWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("PollForActivityTask", func(t *testing.T) {
 		h.EXPECT().PollForActivityTask(taggedCtx, &types.PollForActivityTaskRequest{}).Return(&types.PollForActivityTaskResponse{}, internalErr).Times(1)
 		resp, err := th.PollForActivityTask(ctx, &shared.PollForActivityTaskRequest{})
@@ -145,7 +187,7 @@ func TestThriftHandler(t *testing.T) {
 	t.Run("PollForDecisionTask", func(t *testing.T) {
 		h.EXPECT().PollForDecisionTask(taggedCtx, &types.PollForDecisionTaskRequest{}).Return(&types.PollForDecisionTaskResponse{}, internalErr).Times(1)
 		resp, err := th.PollForDecisionTask(ctx, &shared.PollForDecisionTaskRequest{})
-		assert.Equal(t, shared.PollForDecisionTaskResponse{StartedEventId: common.Int64Ptr(0), Attempt: common.Int64Ptr(0), BacklogCountHint: common.Int64Ptr(0), NextEventId: common.Int64Ptr(0)}, *resp)
+		assert.Equal(t, shared.PollForDecisionTaskResponse{StartedEventId: common.Int64Ptr(0), Attempt: common.Int64Ptr(0), BacklogCountHint: common.Int64Ptr(0), NextEventId: common.Int64Ptr(0), HistorySize: common.Int64Ptr(0), HistoryCount: common.Int64Ptr(0), SuggestContinueAsNew: common.BoolPtr(false)}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
 	t.Run("QueryWorkflow", func(t *testing.T) {
@@ -176,6 +218,11 @@ func TestThriftHandler(t *testing.T) {
 		err := th.RequestCancelWorkflowExecution(ctx, &shared.RequestCancelWorkflowExecutionRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("ResetActivity", func(t *testing.T) {
+		h.EXPECT().ResetActivity(taggedCtx, &types.ResetActivityRequest{}).Return(internalErr).Times(1)
+		err := th.ResetActivity(ctx, &shared.ResetActivityRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("ResetStickyTaskList", func(t *testing.T) {
 		h.EXPECT().ResetStickyTaskList(taggedCtx, &types.ResetStickyTaskListRequest{}).Return(&types.ResetStickyTaskListResponse{}, internalErr).Times(1)
 		resp, err := th.ResetStickyTaskList(ctx, &shared.ResetStickyTaskListRequest{})
@@ -188,6 +235,12 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.ResetWorkflowExecutionResponse{RunId: common.StringPtr("")}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("RestartWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().RestartWorkflowExecution(taggedCtx, &types.RestartWorkflowExecutionRequest{}).Return(&types.RestartWorkflowExecutionResponse{}, internalErr).Times(1)
+		resp, err := th.RestartWorkflowExecution(ctx, &shared.RestartWorkflowExecutionRequest{})
+		assert.Equal(t, shared.RestartWorkflowExecutionResponse{RunId: common.StringPtr("")}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("RespondActivityTaskCanceled", func(t *testing.T) {
 		h.EXPECT().RespondActivityTaskCanceled(taggedCtx, &types.RespondActivityTaskCanceledRequest{}).Return(internalErr).Times(1)
 		err := th.RespondActivityTaskCanceled(ctx, &shared.RespondActivityTaskCanceledRequest{})
@@ -262,10 +315,36 @@ func TestThriftHandler(t *testing.T) {
 		err := th.TerminateWorkflowExecution(ctx, &shared.TerminateWorkflowExecutionRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("UnpauseActivity", func(t *testing.T) {
+		h.EXPECT().UnpauseActivity(taggedCtx, &types.UnpauseActivityRequest{}).Return(internalErr).Times(1)
+		err := th.UnpauseActivity(ctx, &shared.UnpauseActivityRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("UnpauseSchedule", func(t *testing.T) {
+		h.EXPECT().UnpauseSchedule(taggedCtx, &types.UnpauseScheduleRequest{}).Return(internalErr).Times(1)
+		err := th.UnpauseSchedule(ctx, &shared.UnpauseScheduleRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("UnpauseWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().UnpauseWorkflowExecution(taggedCtx, &types.UnpauseWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.UnpauseWorkflowExecution(ctx, &shared.UnpauseWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("UpdateWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().UpdateWorkflowExecution(taggedCtx, &types.UpdateWorkflowExecutionRequest{}).Return(&types.UpdateWorkflowExecutionResponse{}, internalErr).Times(1)
+		resp, err := th.UpdateWorkflowExecution(ctx, &shared.UpdateWorkflowExecutionRequest{})
+		assert.Equal(t, shared.UpdateWorkflowExecutionResponse{ErrorMessage: common.StringPtr("")}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("UpdateDomain", func(t *testing.T) {
 		h.EXPECT().UpdateDomain(taggedCtx, &types.UpdateDomainRequest{}).Return(&types.UpdateDomainResponse{}, internalErr).Times(1)
 		resp, err := th.UpdateDomain(ctx, &shared.UpdateDomainRequest{})
 		assert.Equal(t, shared.UpdateDomainResponse{IsGlobalDomain: common.BoolPtr(false), FailoverVersion: common.Int64Ptr(0)}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("UpdateSchedule", func(t *testing.T) {
+		h.EXPECT().UpdateSchedule(taggedCtx, &types.UpdateScheduleRequest{}).Return(internalErr).Times(1)
+		err := th.UpdateSchedule(ctx, &shared.UpdateScheduleRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 }
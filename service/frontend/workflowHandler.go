@@ -51,6 +51,7 @@ import (
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
+	"github.com/uber/cadence/service/worker/scheduler"
 )
 
 const (
@@ -83,6 +84,7 @@ type (
 		domainHandler             domain.Handler
 		visibilityQueryValidator  *validator.VisibilityQueryValidator
 		searchAttributesValidator *validator.SearchAttributesValidator
+		schedulerClient           scheduler.Client
 	}
 
 	getHistoryContinuationToken struct {
@@ -117,6 +119,11 @@ var (
 	errNextPageTokenRunIDMismatch                 = &types.BadRequestError{Message: "RunID in the request does not match the NextPageToken."}
 	errQueryNotSet                                = &types.BadRequestError{Message: "WorkflowQuery is not set on request."}
 	errQueryTypeNotSet                            = &types.BadRequestError{Message: "QueryType is not set on request."}
+	errUpdateNameNotSet                           = &types.BadRequestError{Message: "UpdateName is not set on request."}
+	errScheduleIDNotSet                           = &types.BadRequestError{Message: "ScheduleID is not set on request."}
+	errScheduleSpecNotSet                         = &types.BadRequestError{Message: "Schedule spec with a cron expression is not set on request."}
+	errScheduleActionNotSet                       = &types.BadRequestError{Message: "Schedule action is not set on request."}
+	errInvalidScheduleTimeRange                   = &types.BadRequestError{Message: "A valid time range is not set on request."}
 	errRequestNotSet                              = &types.BadRequestError{Message: "Request is nil."}
 	errNoPermission                               = &types.BadRequestError{Message: "No permission to do this operation."}
 	errRequestIDNotSet                            = &types.BadRequestError{Message: "RequestId is not set on request."}
@@ -125,10 +132,12 @@ var (
 	errInvalidExecutionStartToCloseTimeoutSeconds = &types.BadRequestError{Message: "A valid ExecutionStartToCloseTimeoutSeconds is not set on request."}
 	errInvalidTaskStartToCloseTimeoutSeconds      = &types.BadRequestError{Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}
 	errInvalidDelayStartSeconds                   = &types.BadRequestError{Message: "A valid DelayStartSeconds is not set on request."}
+	errInvalidConflictPolicyForSignalWithStart    = &types.BadRequestError{Message: "WorkflowIDConflictPolicy Fail is not supported by SignalWithStartWorkflowExecution."}
 	errQueryDisallowedForDomain                   = &types.BadRequestError{Message: "Domain is not allowed to query, please contact cadence team to re-enable queries."}
 	errClusterNameNotSet                          = &types.BadRequestError{Message: "Cluster name is not set."}
 	errEmptyReplicationInfo                       = &types.BadRequestError{Message: "Replication task info is not set."}
 	errEmptyQueueType                             = &types.BadRequestError{Message: "Queue type is not set."}
+	errWorkflowStillRunning                       = &types.BadRequestError{Message: "Only closed workflow executions can be restarted."}
 	errShuttingDown                               = &types.InternalServiceError{Message: "Shutting down"}
 
 	// err for archival
@@ -142,6 +151,7 @@ var (
 	errTaskListTooLong     = &types.BadRequestError{Message: "TaskList length exceeds limit."}
 	errRequestIDTooLong    = &types.BadRequestError{Message: "RequestID length exceeds limit."}
 	errIdentityTooLong     = &types.BadRequestError{Message: "Identity length exceeds limit."}
+	errScheduleIDTooLong   = &types.BadRequestError{Message: "ScheduleID length exceeds limit."}
 
 	frontendServiceRetryPolicy = common.CreateFrontendServiceRetryPolicy()
 )
@@ -163,6 +173,16 @@ func NewWorkflowHandler(
 				return float64(config.RPS())
 			},
 			func(domain string) float64 {
+				if limits := getDomainLimits(resource, config, domain); limits.GetRequestsPerSecond() > 0 {
+					// limits configured on the domain are cluster wide, spread them across frontend hosts
+					ringSize := 1
+					if monitor := resource.GetMembershipMonitor(); monitor != nil {
+						if count, err := monitor.GetMemberCount(common.FrontendServiceName); err == nil && count > 0 {
+							ringSize = count
+						}
+					}
+					return float64(common.MaxInt(int(limits.GetRequestsPerSecond())/ringSize, 1))
+				}
 				if monitor := resource.GetMembershipMonitor(); monitor != nil && config.GlobalDomainRPS(domain) > 0 {
 					ringSize, err := monitor.GetMemberCount(common.FrontendServiceName)
 					if err == nil && ringSize > 0 {
@@ -177,9 +197,11 @@ func NewWorkflowHandler(
 		domainHandler: domain.NewHandler(
 			config.domainConfig,
 			resource.GetLogger(),
+			resource.GetMetricsClient(),
 			resource.GetDomainManager(),
 			resource.GetClusterMetadata(),
 			domain.NewDomainReplicator(replicationMessageSink, resource.GetLogger()),
+			resource.GetDomainAuditLog(),
 			resource.GetArchivalMetadata(),
 			resource.GetArchiverProvider(),
 			resource.GetTimeSource(),
@@ -192,6 +214,7 @@ func NewWorkflowHandler(
 			config.SearchAttributesSizeOfValueLimit,
 			config.SearchAttributesTotalSizeLimit,
 		),
+		schedulerClient: scheduler.NewClient(resource.GetFrontendClient()),
 	}
 }
 
@@ -2413,6 +2436,11 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 			scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
+	if signalWithStartRequest.WorkflowIDConflictPolicy != nil &&
+		*signalWithStartRequest.WorkflowIDConflictPolicy == types.WorkflowIDConflictPolicyFail {
+		return nil, wh.error(errInvalidConflictPolicyForSignalWithStart, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
 	if err := common.ValidateRetryPolicy(signalWithStartRequest.RetryPolicy); err != nil {
 		return nil, wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
 	}
@@ -2577,6 +2605,110 @@ func (wh *WorkflowHandler) ResetWorkflowExecution(
 	return resp, nil
 }
 
+// RestartWorkflowExecution - starts a new run of a closed workflow execution using the
+// same workflow type, task list, input and timeouts as the original run.
+func (wh *WorkflowHandler) RestartWorkflowExecution(
+	ctx context.Context,
+	restartRequest *types.RestartWorkflowExecutionRequest,
+) (resp *types.RestartWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendRestartWorkflowExecutionScope, restartRequest)
+	defer sw.Stop()
+
+	wfExecution := restartRequest.GetWorkflowExecution()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if restartRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if ok := wh.allow(restartRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	domainName := restartRequest.GetDomain()
+	if domainName == "" {
+		return nil, wh.error(errDomainNotSet, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(wfExecution, scope); err != nil {
+		return nil, err
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	mutableState, err := wh.GetHistoryClient().GetMutableState(ctx, &types.GetMutableStateRequest{
+		DomainUUID: domainID,
+		Execution:  wfExecution,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+	if mutableState.GetIsWorkflowRunning() {
+		return nil, wh.error(errWorkflowStillRunning, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	execution := mutableState.GetExecution()
+	history, _, err := wh.getHistory(
+		ctx,
+		scope,
+		domainID,
+		*execution,
+		common.FirstEventID,
+		common.FirstEventID+1,
+		1,
+		nil,
+		nil,
+		mutableState.CurrentBranchToken,
+	)
+	if err != nil {
+		return nil, wh.error(err, scope, getWfIDRunIDTags(execution)...)
+	}
+	if len(history.GetEvents()) == 0 || history.Events[0].WorkflowExecutionStartedEventAttributes == nil {
+		return nil, wh.error(&types.InternalServiceError{Message: "Unable to find workflow execution started event."}, scope, getWfIDRunIDTags(execution)...)
+	}
+
+	attributes := history.Events[0].WorkflowExecutionStartedEventAttributes
+	startRequest := &types.StartWorkflowExecutionRequest{
+		Domain:                              domainName,
+		WorkflowID:                          execution.GetWorkflowID(),
+		WorkflowType:                        attributes.WorkflowType,
+		TaskList:                            attributes.TaskList,
+		Input:                               attributes.Input,
+		ExecutionStartToCloseTimeoutSeconds: attributes.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      attributes.TaskStartToCloseTimeoutSeconds,
+		Identity:                            restartRequest.GetIdentity(),
+		RequestID:                           uuid.New(),
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+		RetryPolicy:                         attributes.RetryPolicy,
+		CronSchedule:                        attributes.CronSchedule,
+		Memo:                                attributes.Memo,
+		SearchAttributes:                    attributes.SearchAttributes,
+		Header:                              attributes.Header,
+	}
+
+	historyRequest := common.CreateHistoryStartWorkflowRequest(domainID, startRequest, time.Now())
+	historyRequest.RestartedFromRunID = execution.GetRunID()
+
+	startResp, err := wh.GetHistoryClient().StartWorkflowExecution(ctx, historyRequest)
+	if err != nil {
+		return nil, wh.error(err, scope, getWfIDRunIDTags(execution)...)
+	}
+
+	return &types.RestartWorkflowExecutionResponse{RunID: startResp.GetRunID()}, nil
+}
+
 // RequestCancelWorkflowExecution - requests to cancel a workflow execution
 func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 	ctx context.Context,
@@ -2629,216 +2761,966 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 	return nil
 }
 
-// ListOpenWorkflowExecutions - retrieves info for open workflow executions in a domain
-func (wh *WorkflowHandler) ListOpenWorkflowExecutions(
+// PauseWorkflowExecution - pauses a workflow execution, suspending its decisions, activities and timers
+func (wh *WorkflowHandler) PauseWorkflowExecution(
 	ctx context.Context,
-	listRequest *types.ListOpenWorkflowExecutionsRequest,
-) (resp *types.ListOpenWorkflowExecutionsResponse, retError error) {
+	pauseRequest *types.PauseWorkflowExecutionRequest,
+) (retError error) {
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
-	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendListOpenWorkflowExecutionsScope, listRequest)
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendPauseWorkflowExecutionScope, pauseRequest)
 	defer sw.Stop()
 
+	wfExecution := pauseRequest.GetWorkflowExecution()
+
 	if wh.isShuttingDown() {
-		return nil, errShuttingDown
+		return errShuttingDown
 	}
 
 	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
-		return nil, wh.error(err, scope)
+		return wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	if listRequest == nil {
-		return nil, wh.error(errRequestNotSet, scope)
+	if pauseRequest == nil {
+		return wh.error(errRequestNotSet, scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	if ok := wh.allow(listRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if ok := wh.allow(pauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	if listRequest.GetDomain() == "" {
-		return nil, wh.error(errDomainNotSet, scope)
+	if pauseRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	if listRequest.StartTimeFilter == nil {
-		return nil, wh.error(&types.BadRequestError{Message: "StartTimeFilter is required"}, scope)
+	if err := wh.validateExecutionAndEmitMetrics(pauseRequest.WorkflowExecution, scope); err != nil {
+		return err
 	}
 
-	if listRequest.StartTimeFilter.EarliestTime == nil {
-		return nil, wh.error(&types.BadRequestError{Message: "EarliestTime in StartTimeFilter is required"}, scope)
+	domainID, err := wh.GetDomainCache().GetDomainID(pauseRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	if listRequest.StartTimeFilter.LatestTime == nil {
-		return nil, wh.error(&types.BadRequestError{Message: "LatestTime in StartTimeFilter is required"}, scope)
+	err = wh.GetHistoryClient().PauseWorkflowExecution(ctx, &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID:   domainID,
+		PauseRequest: pauseRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, wh.error(err, scope, getWfIDRunIDTags(wfExecution)...))
 	}
 
-	if listRequest.StartTimeFilter.GetEarliestTime() > listRequest.StartTimeFilter.GetLatestTime() {
-		return nil, wh.error(&types.BadRequestError{Message: "EarliestTime in StartTimeFilter should not be larger than LatestTime"}, scope)
+	return nil
+}
+
+// UnpauseWorkflowExecution - unpauses a paused workflow execution
+func (wh *WorkflowHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	unpauseRequest *types.UnpauseWorkflowExecutionRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendUnpauseWorkflowExecutionScope, unpauseRequest)
+	defer sw.Stop()
+
+	wfExecution := unpauseRequest.GetWorkflowExecution()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
 	}
 
-	if listRequest.ExecutionFilter != nil && listRequest.TypeFilter != nil {
-		return nil, wh.error(&types.BadRequestError{
-			Message: "Only one of ExecutionFilter or TypeFilter is allowed"}, scope)
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	if listRequest.GetMaximumPageSize() <= 0 {
-		listRequest.MaximumPageSize = int32(wh.config.VisibilityMaxPageSize(listRequest.GetDomain()))
+	if unpauseRequest == nil {
+		return wh.error(errRequestNotSet, scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	if wh.isListRequestPageSizeTooLarge(listRequest.GetMaximumPageSize(), listRequest.GetDomain()) {
-		return nil, wh.error(&types.BadRequestError{
-			Message: fmt.Sprintf("Pagesize is larger than allow %d", wh.config.ESIndexMaxResultWindow())}, scope)
+	if ok := wh.allow(unpauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	domain := listRequest.GetDomain()
-	domainID, err := wh.GetDomainCache().GetDomainID(domain)
-	if err != nil {
-		return nil, wh.error(err, scope)
+	if unpauseRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	baseReq := persistence.ListWorkflowExecutionsRequest{
-		DomainUUID:    domainID,
-		Domain:        domain,
-		PageSize:      int(listRequest.GetMaximumPageSize()),
-		NextPageToken: listRequest.NextPageToken,
-		EarliestTime:  listRequest.StartTimeFilter.GetEarliestTime(),
-		LatestTime:    listRequest.StartTimeFilter.GetLatestTime(),
+	if err := wh.validateExecutionAndEmitMetrics(unpauseRequest.WorkflowExecution, scope); err != nil {
+		return err
 	}
 
-	var persistenceResp *persistence.ListWorkflowExecutionsResponse
-	if listRequest.ExecutionFilter != nil {
-		if wh.config.DisableListVisibilityByFilter(domain) {
-			err = errNoPermission
-		} else {
-			persistenceResp, err = wh.GetVisibilityManager().ListOpenWorkflowExecutionsByWorkflowID(
-				ctx,
-				&persistence.ListWorkflowExecutionsByWorkflowIDRequest{
-					ListWorkflowExecutionsRequest: baseReq,
-					WorkflowID:                    listRequest.ExecutionFilter.GetWorkflowID(),
-				})
-		}
-		wh.GetLogger().Debug("List open workflow with filter",
-			tag.WorkflowDomainName(listRequest.GetDomain()), tag.WorkflowListWorkflowFilterByID)
-	} else if listRequest.TypeFilter != nil {
-		if wh.config.DisableListVisibilityByFilter(domain) {
-			err = errNoPermission
-		} else {
-			persistenceResp, err = wh.GetVisibilityManager().ListOpenWorkflowExecutionsByType(
-				ctx,
-				&persistence.ListWorkflowExecutionsByTypeRequest{
-					ListWorkflowExecutionsRequest: baseReq,
-					WorkflowTypeName:              listRequest.TypeFilter.GetName(),
-				},
-			)
-		}
-		wh.GetLogger().Debug("List open workflow with filter",
-			tag.WorkflowDomainName(listRequest.GetDomain()), tag.WorkflowListWorkflowFilterByType)
-	} else {
-		persistenceResp, err = wh.GetVisibilityManager().ListOpenWorkflowExecutions(ctx, &baseReq)
+	domainID, err := wh.GetDomainCache().GetDomainID(unpauseRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
+	err = wh.GetHistoryClient().UnpauseWorkflowExecution(ctx, &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     domainID,
+		UnpauseRequest: unpauseRequest,
+	})
 	if err != nil {
-		return nil, wh.error(err, scope)
+		return wh.normalizeVersionedErrors(ctx, wh.error(err, scope, getWfIDRunIDTags(wfExecution)...))
 	}
 
-	resp = &types.ListOpenWorkflowExecutionsResponse{}
-	resp.Executions = persistenceResp.Executions
-	resp.NextPageToken = persistenceResp.NextPageToken
-	return resp, nil
+	return nil
 }
 
-// ListArchivedWorkflowExecutions - retrieves archived info for closed workflow executions in a domain
-func (wh *WorkflowHandler) ListArchivedWorkflowExecutions(
+// DeleteWorkflowExecution - deletes a closed workflow execution, optionally archiving it first
+func (wh *WorkflowHandler) DeleteWorkflowExecution(
 	ctx context.Context,
-	listRequest *types.ListArchivedWorkflowExecutionsRequest,
-) (resp *types.ListArchivedWorkflowExecutionsResponse, retError error) {
+	deleteRequest *types.DeleteWorkflowExecutionRequest,
+) (retError error) {
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
-	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendListArchivedWorkflowExecutionsScope, listRequest)
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendDeleteWorkflowExecutionScope, deleteRequest)
 	defer sw.Stop()
 
+	wfExecution := deleteRequest.GetWorkflowExecution()
+
 	if wh.isShuttingDown() {
-		return nil, errShuttingDown
+		return errShuttingDown
 	}
 
 	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
-		return nil, wh.error(err, scope)
+		return wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	if listRequest == nil {
-		return nil, wh.error(errRequestNotSet, scope)
+	if deleteRequest == nil {
+		return wh.error(errRequestNotSet, scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	if ok := wh.allow(listRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if ok := wh.allow(deleteRequest); !ok {
+		return wh.error(createServiceBusyError(), scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	if listRequest.GetDomain() == "" {
-		return nil, wh.error(errDomainNotSet, scope)
+	if deleteRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	if listRequest.GetPageSize() <= 0 {
-		listRequest.PageSize = int32(wh.config.VisibilityMaxPageSize(listRequest.GetDomain()))
+	if err := wh.validateExecutionAndEmitMetrics(deleteRequest.WorkflowExecution, scope); err != nil {
+		return err
 	}
 
-	maxPageSize := wh.config.VisibilityArchivalQueryMaxPageSize()
-	if int(listRequest.GetPageSize()) > maxPageSize {
-		return nil, wh.error(&types.BadRequestError{
-			Message: fmt.Sprintf("Pagesize is larger than allowed %d", maxPageSize)}, scope)
+	domainID, err := wh.GetDomainCache().GetDomainID(deleteRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	if !wh.GetArchivalMetadata().GetVisibilityConfig().ClusterConfiguredForArchival() {
-		return nil, wh.error(&types.BadRequestError{Message: "Cluster is not configured for visibility archival"}, scope)
+	err = wh.GetHistoryClient().DeleteWorkflowExecution(ctx, &types.HistoryDeleteWorkflowExecutionRequest{
+		DomainUUID:    domainID,
+		DeleteRequest: deleteRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	if !wh.GetArchivalMetadata().GetVisibilityConfig().ReadEnabled() {
-		return nil, wh.error(&types.BadRequestError{Message: "Cluster is not configured for reading archived visibility records"}, scope)
+	return nil
+}
+
+// PauseActivity - suspends dispatch and retries of a pending activity
+func (wh *WorkflowHandler) PauseActivity(
+	ctx context.Context,
+	pauseRequest *types.PauseActivityRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendPauseActivityScope, pauseRequest)
+	defer sw.Stop()
+
+	wfExecution := pauseRequest.GetWorkflowExecution()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
 	}
 
-	entry, err := wh.GetDomainCache().GetDomain(listRequest.GetDomain())
-	if err != nil {
-		return nil, wh.error(err, scope)
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	if entry.GetConfig().VisibilityArchivalStatus != types.ArchivalStatusEnabled {
-		return nil, wh.error(&types.BadRequestError{Message: "Domain is not configured for visibility archival"}, scope)
+	if pauseRequest == nil {
+		return wh.error(errRequestNotSet, scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	URI, err := archiver.NewURI(entry.GetConfig().VisibilityArchivalURI)
-	if err != nil {
-		return nil, wh.error(err, scope)
+	if ok := wh.allow(pauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	visibilityArchiver, err := wh.GetArchiverProvider().GetVisibilityArchiver(URI.Scheme(), common.FrontendServiceName)
-	if err != nil {
-		return nil, wh.error(err, scope)
+	if pauseRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	archiverRequest := &archiver.QueryVisibilityRequest{
-		DomainID:      entry.GetInfo().ID,
-		PageSize:      int(listRequest.GetPageSize()),
-		NextPageToken: listRequest.NextPageToken,
-		Query:         listRequest.GetQuery(),
+	if err := wh.validateExecutionAndEmitMetrics(pauseRequest.WorkflowExecution, scope); err != nil {
+		return err
 	}
 
-	archiverResponse, err := visibilityArchiver.Query(ctx, URI, archiverRequest)
+	if pauseRequest.GetActivityID() == "" {
+		return wh.error(errActivityIDNotSet, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(pauseRequest.GetDomain())
 	if err != nil {
-		return nil, wh.error(err, scope)
+		return wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	// special handling of ExecutionTime for cron or retry
-	for _, execution := range archiverResponse.Executions {
-		if execution.GetExecutionTime() == 0 {
-			execution.ExecutionTime = common.Int64Ptr(execution.GetStartTime())
-		}
+	err = wh.GetHistoryClient().PauseActivity(ctx, &types.HistoryPauseActivityRequest{
+		DomainUUID:   domainID,
+		PauseRequest: pauseRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
-	return &types.ListArchivedWorkflowExecutionsResponse{
-		Executions:    archiverResponse.Executions,
-		NextPageToken: archiverResponse.NextPageToken,
-	}, nil
+	return nil
 }
 
-// ListClosedWorkflowExecutions - retrieves info for closed workflow executions in a domain
+// UnpauseActivity - resumes a paused activity
+func (wh *WorkflowHandler) UnpauseActivity(
+	ctx context.Context,
+	unpauseRequest *types.UnpauseActivityRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendUnpauseActivityScope, unpauseRequest)
+	defer sw.Stop()
+
+	wfExecution := unpauseRequest.GetWorkflowExecution()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if unpauseRequest == nil {
+		return wh.error(errRequestNotSet, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if ok := wh.allow(unpauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if unpauseRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(unpauseRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	if unpauseRequest.GetActivityID() == "" {
+		return wh.error(errActivityIDNotSet, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(unpauseRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	err = wh.GetHistoryClient().UnpauseActivity(ctx, &types.HistoryUnpauseActivityRequest{
+		DomainUUID:     domainID,
+		UnpauseRequest: unpauseRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	return nil
+}
+
+// ResetActivity - resets the attempt count and heartbeat details of a pending activity
+func (wh *WorkflowHandler) ResetActivity(
+	ctx context.Context,
+	resetRequest *types.ResetActivityRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendResetActivityScope, resetRequest)
+	defer sw.Stop()
+
+	wfExecution := resetRequest.GetWorkflowExecution()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if resetRequest == nil {
+		return wh.error(errRequestNotSet, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if ok := wh.allow(resetRequest); !ok {
+		return wh.error(createServiceBusyError(), scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if resetRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(resetRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	if resetRequest.GetActivityID() == "" {
+		return wh.error(errActivityIDNotSet, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(resetRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	err = wh.GetHistoryClient().ResetActivity(ctx, &types.HistoryResetActivityRequest{
+		DomainUUID:   domainID,
+		ResetRequest: resetRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	return nil
+}
+
+// UpdateWorkflowExecution delivers an update request to a running workflow and blocks until the
+// workflow has completed the update or the request deadline expires.
+func (wh *WorkflowHandler) UpdateWorkflowExecution(
+	ctx context.Context,
+	updateRequest *types.UpdateWorkflowExecutionRequest,
+) (resp *types.UpdateWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendUpdateWorkflowExecutionScope, updateRequest)
+	defer sw.Stop()
+
+	wfExecution := updateRequest.GetWorkflowExecution()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if updateRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if ok := wh.allow(updateRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	domainName := updateRequest.GetDomain()
+	if domainName == "" {
+		return nil, wh.error(errDomainNotSet, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(updateRequest.WorkflowExecution, scope); err != nil {
+		return nil, err
+	}
+
+	if updateRequest.GetUpdateName() == "" {
+		return nil, wh.error(errUpdateNameNotSet, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if !common.ValidIDLength(
+		updateRequest.GetRequestID(),
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.RequestIDMaxLength(domainName),
+		metrics.CadenceErrRequestIDExceededWarnLimit) {
+		return nil, wh.error(errRequestIDTooLong, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
+	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
+	if err := common.CheckEventBlobSizeLimit(
+		len(updateRequest.Input),
+		sizeLimitWarn,
+		sizeLimitError,
+		domainID,
+		wfExecution.GetWorkflowID(),
+		wfExecution.GetRunID(),
+		scope,
+		wh.GetThrottledLogger(),
+		tag.BlobSizeViolationOperation("UpdateWorkflowExecution"),
+	); err != nil {
+		return nil, wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	hResponse, err := wh.GetHistoryClient().UpdateWorkflowExecution(ctx, &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		Request:    updateRequest,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, wh.error(err, scope, getWfIDRunIDTags(wfExecution)...))
+	}
+
+	return hResponse.GetResponse(), nil
+}
+
+// CreateSchedule - creates a schedule that starts workflow executions according to its spec
+func (wh *WorkflowHandler) CreateSchedule(
+	ctx context.Context,
+	createRequest *types.CreateScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendCreateScheduleScope, createRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if createRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(createRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleID(createRequest.GetDomain(), createRequest.GetScheduleID(), scope); err != nil {
+		return err
+	}
+
+	if err := wh.validateScheduleSpec(createRequest.Spec, scope); err != nil {
+		return err
+	}
+
+	if err := wh.validateScheduleAction(createRequest.Action, scope, createRequest.GetDomain()); err != nil {
+		return err
+	}
+
+	if createRequest.GetRequestID() == "" {
+		return wh.error(errRequestIDNotSet, scope)
+	}
+
+	if !common.ValidIDLength(
+		createRequest.GetRequestID(),
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.RequestIDMaxLength(createRequest.GetDomain()),
+		metrics.CadenceErrRequestIDExceededWarnLimit) {
+		return wh.error(errRequestIDTooLong, scope)
+	}
+
+	if err := wh.schedulerClient.CreateSchedule(ctx, createRequest); err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// DescribeSchedule - returns the spec, action, policies and state of a schedule
+func (wh *WorkflowHandler) DescribeSchedule(
+	ctx context.Context,
+	describeRequest *types.DescribeScheduleRequest,
+) (resp *types.DescribeScheduleResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendDescribeScheduleScope, describeRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if describeRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(describeRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleID(describeRequest.GetDomain(), describeRequest.GetScheduleID(), scope); err != nil {
+		return nil, err
+	}
+
+	resp, err := wh.schedulerClient.DescribeSchedule(ctx, describeRequest)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return resp, nil
+}
+
+// UpdateSchedule - replaces the spec, action and policies of a schedule
+func (wh *WorkflowHandler) UpdateSchedule(
+	ctx context.Context,
+	updateRequest *types.UpdateScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendUpdateScheduleScope, updateRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if updateRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(updateRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleID(updateRequest.GetDomain(), updateRequest.GetScheduleID(), scope); err != nil {
+		return err
+	}
+
+	if updateRequest.Spec != nil {
+		if err := wh.validateScheduleSpec(updateRequest.Spec, scope); err != nil {
+			return err
+		}
+	}
+
+	if updateRequest.Action != nil {
+		if err := wh.validateScheduleAction(updateRequest.Action, scope, updateRequest.GetDomain()); err != nil {
+			return err
+		}
+	}
+
+	if !common.ValidIDLength(
+		updateRequest.GetRequestID(),
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.RequestIDMaxLength(updateRequest.GetDomain()),
+		metrics.CadenceErrRequestIDExceededWarnLimit) {
+		return wh.error(errRequestIDTooLong, scope)
+	}
+
+	if err := wh.schedulerClient.UpdateSchedule(ctx, updateRequest); err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// PauseSchedule - stops a schedule from starting workflow executions
+func (wh *WorkflowHandler) PauseSchedule(
+	ctx context.Context,
+	pauseRequest *types.PauseScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendPauseScheduleScope, pauseRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if pauseRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(pauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleID(pauseRequest.GetDomain(), pauseRequest.GetScheduleID(), scope); err != nil {
+		return err
+	}
+
+	if !common.ValidIDLength(
+		pauseRequest.GetRequestID(),
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.RequestIDMaxLength(pauseRequest.GetDomain()),
+		metrics.CadenceErrRequestIDExceededWarnLimit) {
+		return wh.error(errRequestIDTooLong, scope)
+	}
+
+	if err := wh.schedulerClient.PauseSchedule(ctx, pauseRequest); err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// UnpauseSchedule - resumes a paused schedule
+func (wh *WorkflowHandler) UnpauseSchedule(
+	ctx context.Context,
+	unpauseRequest *types.UnpauseScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendUnpauseScheduleScope, unpauseRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if unpauseRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(unpauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleID(unpauseRequest.GetDomain(), unpauseRequest.GetScheduleID(), scope); err != nil {
+		return err
+	}
+
+	if !common.ValidIDLength(
+		unpauseRequest.GetRequestID(),
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.RequestIDMaxLength(unpauseRequest.GetDomain()),
+		metrics.CadenceErrRequestIDExceededWarnLimit) {
+		return wh.error(errRequestIDTooLong, scope)
+	}
+
+	if err := wh.schedulerClient.UnpauseSchedule(ctx, unpauseRequest); err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// BackfillSchedule - starts the workflow executions a schedule would have started in a time range
+func (wh *WorkflowHandler) BackfillSchedule(
+	ctx context.Context,
+	backfillRequest *types.BackfillScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendBackfillScheduleScope, backfillRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if backfillRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(backfillRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleID(backfillRequest.GetDomain(), backfillRequest.GetScheduleID(), scope); err != nil {
+		return err
+	}
+
+	if backfillRequest.StartTimestamp == nil || backfillRequest.EndTimestamp == nil || backfillRequest.GetStartTimestamp() > backfillRequest.GetEndTimestamp() {
+		return wh.error(errInvalidScheduleTimeRange, scope)
+	}
+
+	if !common.ValidIDLength(
+		backfillRequest.GetRequestID(),
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.RequestIDMaxLength(backfillRequest.GetDomain()),
+		metrics.CadenceErrRequestIDExceededWarnLimit) {
+		return wh.error(errRequestIDTooLong, scope)
+	}
+
+	if err := wh.schedulerClient.BackfillSchedule(ctx, backfillRequest); err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// ListSchedules - retrieves the schedules of a domain
+func (wh *WorkflowHandler) ListSchedules(
+	ctx context.Context,
+	listRequest *types.ListSchedulesRequest,
+) (resp *types.ListSchedulesResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendListSchedulesScope, listRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if listRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(listRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	domainName := listRequest.GetDomain()
+	if domainName == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if _, err := wh.GetDomainCache().GetDomainID(domainName); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if listRequest.GetPageSize() <= 0 {
+		listRequest.PageSize = int32(wh.config.VisibilityMaxPageSize(domainName))
+	}
+
+	resp, err := wh.schedulerClient.ListSchedules(ctx, listRequest)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return resp, nil
+}
+
+// ListOpenWorkflowExecutions - retrieves info for open workflow executions in a domain
+func (wh *WorkflowHandler) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	listRequest *types.ListOpenWorkflowExecutionsRequest,
+) (resp *types.ListOpenWorkflowExecutionsResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendListOpenWorkflowExecutionsScope, listRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if listRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(listRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if listRequest.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if listRequest.StartTimeFilter == nil {
+		return nil, wh.error(&types.BadRequestError{Message: "StartTimeFilter is required"}, scope)
+	}
+
+	if listRequest.StartTimeFilter.EarliestTime == nil {
+		return nil, wh.error(&types.BadRequestError{Message: "EarliestTime in StartTimeFilter is required"}, scope)
+	}
+
+	if listRequest.StartTimeFilter.LatestTime == nil {
+		return nil, wh.error(&types.BadRequestError{Message: "LatestTime in StartTimeFilter is required"}, scope)
+	}
+
+	if listRequest.StartTimeFilter.GetEarliestTime() > listRequest.StartTimeFilter.GetLatestTime() {
+		return nil, wh.error(&types.BadRequestError{Message: "EarliestTime in StartTimeFilter should not be larger than LatestTime"}, scope)
+	}
+
+	if listRequest.ExecutionFilter != nil && listRequest.TypeFilter != nil {
+		return nil, wh.error(&types.BadRequestError{
+			Message: "Only one of ExecutionFilter or TypeFilter is allowed"}, scope)
+	}
+
+	if listRequest.GetMaximumPageSize() <= 0 {
+		listRequest.MaximumPageSize = int32(wh.config.VisibilityMaxPageSize(listRequest.GetDomain()))
+	}
+
+	if wh.isListRequestPageSizeTooLarge(listRequest.GetMaximumPageSize(), listRequest.GetDomain()) {
+		return nil, wh.error(&types.BadRequestError{
+			Message: fmt.Sprintf("Pagesize is larger than allow %d", wh.config.ESIndexMaxResultWindow())}, scope)
+	}
+
+	domain := listRequest.GetDomain()
+	domainID, err := wh.GetDomainCache().GetDomainID(domain)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	baseReq := persistence.ListWorkflowExecutionsRequest{
+		DomainUUID:    domainID,
+		Domain:        domain,
+		PageSize:      int(listRequest.GetMaximumPageSize()),
+		NextPageToken: listRequest.NextPageToken,
+		EarliestTime:  listRequest.StartTimeFilter.GetEarliestTime(),
+		LatestTime:    listRequest.StartTimeFilter.GetLatestTime(),
+	}
+
+	var persistenceResp *persistence.ListWorkflowExecutionsResponse
+	if listRequest.ExecutionFilter != nil {
+		if wh.config.DisableListVisibilityByFilter(domain) {
+			err = errNoPermission
+		} else {
+			persistenceResp, err = wh.GetVisibilityManager().ListOpenWorkflowExecutionsByWorkflowID(
+				ctx,
+				&persistence.ListWorkflowExecutionsByWorkflowIDRequest{
+					ListWorkflowExecutionsRequest: baseReq,
+					WorkflowID:                    listRequest.ExecutionFilter.GetWorkflowID(),
+				})
+		}
+		wh.GetLogger().Debug("List open workflow with filter",
+			tag.WorkflowDomainName(listRequest.GetDomain()), tag.WorkflowListWorkflowFilterByID)
+	} else if listRequest.TypeFilter != nil {
+		if wh.config.DisableListVisibilityByFilter(domain) {
+			err = errNoPermission
+		} else {
+			persistenceResp, err = wh.GetVisibilityManager().ListOpenWorkflowExecutionsByType(
+				ctx,
+				&persistence.ListWorkflowExecutionsByTypeRequest{
+					ListWorkflowExecutionsRequest: baseReq,
+					WorkflowTypeName:              listRequest.TypeFilter.GetName(),
+				},
+			)
+		}
+		wh.GetLogger().Debug("List open workflow with filter",
+			tag.WorkflowDomainName(listRequest.GetDomain()), tag.WorkflowListWorkflowFilterByType)
+	} else {
+		persistenceResp, err = wh.GetVisibilityManager().ListOpenWorkflowExecutions(ctx, &baseReq)
+	}
+
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp = &types.ListOpenWorkflowExecutionsResponse{}
+	resp.Executions = persistenceResp.Executions
+	resp.NextPageToken = persistenceResp.NextPageToken
+	return resp, nil
+}
+
+// ListArchivedWorkflowExecutions - retrieves archived info for closed workflow executions in a domain
+func (wh *WorkflowHandler) ListArchivedWorkflowExecutions(
+	ctx context.Context,
+	listRequest *types.ListArchivedWorkflowExecutionsRequest,
+) (resp *types.ListArchivedWorkflowExecutionsResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendListArchivedWorkflowExecutionsScope, listRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if listRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(listRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if listRequest.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if listRequest.GetPageSize() <= 0 {
+		listRequest.PageSize = int32(wh.config.VisibilityMaxPageSize(listRequest.GetDomain()))
+	}
+
+	maxPageSize := wh.config.VisibilityArchivalQueryMaxPageSize()
+	if int(listRequest.GetPageSize()) > maxPageSize {
+		return nil, wh.error(&types.BadRequestError{
+			Message: fmt.Sprintf("Pagesize is larger than allowed %d", maxPageSize)}, scope)
+	}
+
+	if !wh.GetArchivalMetadata().GetVisibilityConfig().ClusterConfiguredForArchival() {
+		return nil, wh.error(&types.BadRequestError{Message: "Cluster is not configured for visibility archival"}, scope)
+	}
+
+	if !wh.GetArchivalMetadata().GetVisibilityConfig().ReadEnabled() {
+		return nil, wh.error(&types.BadRequestError{Message: "Cluster is not configured for reading archived visibility records"}, scope)
+	}
+
+	entry, err := wh.GetDomainCache().GetDomain(listRequest.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if entry.GetConfig().VisibilityArchivalStatus != types.ArchivalStatusEnabled {
+		return nil, wh.error(&types.BadRequestError{Message: "Domain is not configured for visibility archival"}, scope)
+	}
+
+	URI, err := archiver.NewURI(entry.GetConfig().VisibilityArchivalURI)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	visibilityArchiver, err := wh.GetArchiverProvider().GetVisibilityArchiver(URI.Scheme(), common.FrontendServiceName)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	archiverRequest := &archiver.QueryVisibilityRequest{
+		DomainID:      entry.GetInfo().ID,
+		PageSize:      int(listRequest.GetPageSize()),
+		NextPageToken: listRequest.NextPageToken,
+		Query:         listRequest.GetQuery(),
+	}
+
+	archiverResponse, err := visibilityArchiver.Query(ctx, URI, archiverRequest)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	// special handling of ExecutionTime for cron or retry
+	for _, execution := range archiverResponse.Executions {
+		if execution.GetExecutionTime() == 0 {
+			execution.ExecutionTime = common.Int64Ptr(execution.GetStartTime())
+		}
+	}
+
+	return &types.ListArchivedWorkflowExecutionsResponse{
+		Executions:    archiverResponse.Executions,
+		NextPageToken: archiverResponse.NextPageToken,
+	}, nil
+}
+
+// ListClosedWorkflowExecutions - retrieves info for closed workflow executions in a domain
 func (wh *WorkflowHandler) ListClosedWorkflowExecutions(
 	ctx context.Context,
 	listRequest *types.ListClosedWorkflowExecutionsRequest,
@@ -3751,6 +4633,77 @@ func (wh *WorkflowHandler) validateTaskList(t *types.TaskList, scope metrics.Sco
 	return nil
 }
 
+func (wh *WorkflowHandler) validateScheduleID(domainName string, scheduleID string, scope metrics.Scope) error {
+	if domainName == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if scheduleID == "" {
+		return wh.error(errScheduleIDNotSet, scope)
+	}
+
+	if !common.ValidIDLength(
+		scheduleID,
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.WorkflowIDMaxLength(domainName),
+		metrics.CadenceErrWorkflowIDExceededWarnLimit) {
+		return wh.error(errScheduleIDTooLong, scope)
+	}
+
+	if _, err := wh.GetDomainCache().GetDomainID(domainName); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+func (wh *WorkflowHandler) validateScheduleSpec(spec *types.ScheduleSpec, scope metrics.Scope) error {
+	if spec.GetCronExpression() == "" {
+		return wh.error(errScheduleSpecNotSet, scope)
+	}
+
+	if err := backoff.ValidateSchedule(spec.GetCronExpression()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if spec.StartTimestamp != nil && spec.EndTimestamp != nil && spec.GetStartTimestamp() > spec.GetEndTimestamp() {
+		return wh.error(errInvalidScheduleTimeRange, scope)
+	}
+	return nil
+}
+
+func (wh *WorkflowHandler) validateScheduleAction(action *types.ScheduleAction, scope metrics.Scope, domainName string) error {
+	if action == nil {
+		return wh.error(errScheduleActionNotSet, scope)
+	}
+
+	if action.WorkflowType == nil || action.WorkflowType.GetName() == "" {
+		return wh.error(errWorkflowTypeNotSet, scope)
+	}
+
+	if !common.ValidIDLength(
+		action.WorkflowType.GetName(),
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.WorkflowTypeMaxLength(domainName),
+		metrics.CadenceErrWorkflowTypeExceededWarnLimit) {
+		return wh.error(errWorkflowTypeTooLong, scope)
+	}
+
+	if err := wh.validateTaskList(action.TaskList, scope, domainName); err != nil {
+		return err
+	}
+
+	if action.GetExecutionStartToCloseTimeoutSeconds() <= 0 {
+		return wh.error(errInvalidExecutionStartToCloseTimeoutSeconds, scope)
+	}
+
+	if action.GetTaskStartToCloseTimeoutSeconds() <= 0 {
+		return wh.error(errInvalidTaskStartToCloseTimeoutSeconds, scope)
+	}
+	return nil
+}
+
 func (wh *WorkflowHandler) validateExecutionAndEmitMetrics(w *types.WorkflowExecution, scope metrics.Scope) error {
 	err := validateExecution(w)
 	if err != nil {
@@ -3862,6 +4815,9 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 		StartedTimestamp:          matchingResp.StartedTimestamp,
 		Queries:                   matchingResp.Queries,
 		NextEventID:               matchingResp.NextEventID,
+		HistorySize:               matchingResp.HistorySize,
+		HistoryCount:              matchingResp.HistoryCount,
+		SuggestContinueAsNew:      matchingResp.SuggestContinueAsNew,
 	}
 
 	return resp, nil
@@ -4111,6 +5067,23 @@ func (wh *WorkflowHandler) allow(d domainGetter) bool {
 	return wh.rateLimiter.Allow(quotas.Info{Domain: domain})
 }
 
+// getDomainLimits returns the limits configured on the domain, or nil if domain limits are
+// disabled or the domain cannot be resolved, in which case dynamic config defaults apply
+func getDomainLimits(
+	resource resource.Resource,
+	config *Config,
+	domain string,
+) *types.DomainLimits {
+	if domain == "" || !config.EnableDomainLimits(domain) {
+		return nil
+	}
+	entry, err := resource.GetDomainCache().GetDomain(domain)
+	if err != nil {
+		return nil
+	}
+	return &entry.GetConfig().Limits
+}
+
 // GetClusterInfo return information about cadence deployment
 func (wh *WorkflowHandler) GetClusterInfo(
 	ctx context.Context,
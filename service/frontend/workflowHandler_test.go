@@ -479,6 +479,95 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidTaskStar
 	s.Equal(errInvalidTaskStartToCloseTimeoutSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestSignalWithStartWorkflowExecution_Failed_ConflictPolicyFail() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	signalWithStartRequest := &types.SignalWithStartWorkflowExecutionRequest{
+		Domain:     "test-domain",
+		WorkflowID: "workflow-id",
+		SignalName: "signal-name",
+		WorkflowType: &types.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskList: &types.TaskList{
+			Name: "task-list",
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestID:                           uuid.New(),
+		WorkflowIDConflictPolicy:            types.WorkflowIDConflictPolicyFail.Ptr(),
+	}
+	_, err := wh.SignalWithStartWorkflowExecution(context.Background(), signalWithStartRequest)
+	s.Error(err)
+	s.Equal(errInvalidConflictPolicyForSignalWithStart, err)
+}
+
+func (s *workflowHandlerSuite) TestCreateSchedule_Failed_ScheduleIDNotSet() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	err := wh.CreateSchedule(context.Background(), &types.CreateScheduleRequest{
+		Domain: "test-domain",
+	})
+	s.Error(err)
+	s.Equal(errScheduleIDNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestCreateSchedule_Failed_InvalidCron() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	s.mockDomainCache.EXPECT().GetDomainID(gomock.Any()).Return(uuid.New(), nil).Times(1)
+
+	err := wh.CreateSchedule(context.Background(), &types.CreateScheduleRequest{
+		Domain:     "test-domain",
+		ScheduleID: "schedule-id",
+		Spec: &types.ScheduleSpec{
+			CronExpression: "not a cron",
+		},
+		RequestID: uuid.New(),
+	})
+	s.Error(err)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *workflowHandlerSuite) TestCreateSchedule_Failed_ActionNotSet() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	s.mockDomainCache.EXPECT().GetDomainID(gomock.Any()).Return(uuid.New(), nil).Times(1)
+
+	err := wh.CreateSchedule(context.Background(), &types.CreateScheduleRequest{
+		Domain:     "test-domain",
+		ScheduleID: "schedule-id",
+		Spec: &types.ScheduleSpec{
+			CronExpression: "*/5 * * * *",
+		},
+		RequestID: uuid.New(),
+	})
+	s.Error(err)
+	s.Equal(errScheduleActionNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestBackfillSchedule_Failed_InvalidTimeRange() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	s.mockDomainCache.EXPECT().GetDomainID(gomock.Any()).Return(uuid.New(), nil).Times(1)
+
+	err := wh.BackfillSchedule(context.Background(), &types.BackfillScheduleRequest{
+		Domain:         "test-domain",
+		ScheduleID:     "schedule-id",
+		StartTimestamp: common.Int64Ptr(2),
+		EndTimestamp:   common.Int64Ptr(1),
+	})
+	s.Error(err)
+	s.Equal(errInvalidScheduleTimeRange, err)
+}
+
 func (s *workflowHandlerSuite) TestRegisterDomain_Failure_MissingDomainDataKey() {
 	dynamicClient := dc.NewInMemoryClient()
 	dynamicClient.UpdateValue(dc.RequiredDomainDataKeys, map[string]interface{}{"Tier": true})
@@ -1167,16 +1256,16 @@ func (s *workflowHandlerSuite) TestGetWorkflowExecutionHistory__Success__RawHist
 }
 
 func (s *workflowHandlerSuite) getWorkflowExecutionHistory(nextEventID int64, transientDecision *types.TransientDecisionInfo, historyEvents []*types.HistoryEvent) {
-	wh := s.getWorkflowHandler(
-		NewConfig(
-			dc.NewCollection(
-				dc.NewNopClient(),
-				s.mockResource.GetLogger()),
-			numHistoryShards,
-			false,
-			true,
-		),
+	config := NewConfig(
+		dc.NewCollection(
+			dc.NewNopClient(),
+			s.mockResource.GetLogger()),
+		numHistoryShards,
+		false,
+		true,
 	)
+	config.EnableDomainLimits = dc.GetBoolPropertyFnFilteredByDomain(false)
+	wh := s.getWorkflowHandler(config)
 	ctx := context.Background()
 	s.mockDomainCache.EXPECT().GetDomainID(gomock.Any()).Return(s.testDomainID, nil).AnyTimes()
 	s.mockVersionChecker.EXPECT().SupportsRawHistoryQuery(gomock.Any(), gomock.Any()).Return(nil).Times(1)
@@ -1452,8 +1541,239 @@ func (s *workflowHandlerSuite) TestSignalMetricHasSignalName() {
 	s.True(expectedMetrics["test.cadence_errors_bad_request"])
 }
 
+func (s *workflowHandlerSuite) TestPauseWorkflowExecution_Failed_DomainNotSet() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	err := wh.PauseWorkflowExecution(context.Background(), &types.PauseWorkflowExecutionRequest{
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "workflow-id"},
+	})
+	s.Error(err)
+	s.Equal(errDomainNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestPauseWorkflowExecution_Success() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	request := &types.PauseWorkflowExecutionRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "workflow-id"},
+		Reason:            "stuck",
+		Identity:          "operator",
+	}
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).Times(1)
+	s.mockHistoryClient.EXPECT().PauseWorkflowExecution(gomock.Any(), &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID:   s.testDomainID,
+		PauseRequest: request,
+	}).Return(nil).Times(1)
+
+	err := wh.PauseWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+}
+
+func (s *workflowHandlerSuite) TestRestartWorkflowExecution_Failed_StillRunning() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	execution := &types.WorkflowExecution{WorkflowID: "workflow-id"}
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).Times(1)
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), &types.GetMutableStateRequest{
+		DomainUUID: s.testDomainID,
+		Execution:  execution,
+	}).Return(&types.GetMutableStateResponse{IsWorkflowRunning: true}, nil).Times(1)
+
+	resp, err := wh.RestartWorkflowExecution(context.Background(), &types.RestartWorkflowExecutionRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: execution,
+	})
+	s.Nil(resp)
+	s.Equal(errWorkflowStillRunning, err)
+}
+
+func (s *workflowHandlerSuite) TestRestartWorkflowExecution_Success() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	execution := &types.WorkflowExecution{WorkflowID: "workflow-id", RunID: "run-id"}
+	startedAttributes := &types.WorkflowExecutionStartedEventAttributes{
+		WorkflowType:                        &types.WorkflowType{Name: "workflow-type"},
+		TaskList:                            &types.TaskList{Name: "task-list"},
+		Input:                               []byte("input"),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+	}
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).Times(1)
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(&types.GetMutableStateResponse{
+		Execution:          execution,
+		IsWorkflowRunning:  false,
+		CurrentBranchToken: []byte{1},
+	}, nil).Times(1)
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{
+			{
+				EventID:                                 common.FirstEventID,
+				EventType:                               types.EventTypeWorkflowExecutionStarted.Ptr(),
+				WorkflowExecutionStartedEventAttributes: startedAttributes,
+			},
+		},
+		NextPageToken: []byte{},
+		Size:          1,
+	}, nil).Once()
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.HistoryStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error) {
+			s.Equal("run-id", request.RestartedFromRunID)
+			s.Equal(execution.WorkflowID, request.StartRequest.WorkflowID)
+			s.Equal(startedAttributes.WorkflowType, request.StartRequest.WorkflowType)
+			s.Equal(startedAttributes.Input, request.StartRequest.Input)
+			s.Equal(types.WorkflowIDReusePolicyAllowDuplicate, request.StartRequest.GetWorkflowIDReusePolicy())
+			return &types.StartWorkflowExecutionResponse{RunID: "new-run-id"}, nil
+		}).Times(1)
+
+	resp, err := wh.RestartWorkflowExecution(context.Background(), &types.RestartWorkflowExecutionRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "workflow-id"},
+		Identity:          "operator",
+	})
+	s.NoError(err)
+	s.Equal("new-run-id", resp.GetRunID())
+}
+
+func (s *workflowHandlerSuite) TestDeleteWorkflowExecution_Failed_WorkflowIDNotSet() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	err := wh.DeleteWorkflowExecution(context.Background(), &types.DeleteWorkflowExecutionRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{},
+	})
+	s.Equal(errWorkflowIDNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestDeleteWorkflowExecution_Success() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	request := &types.DeleteWorkflowExecutionRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "workflow-id"},
+		Archive:           true,
+		Identity:          "operator",
+	}
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).Times(1)
+	s.mockHistoryClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), &types.HistoryDeleteWorkflowExecutionRequest{
+		DomainUUID:    s.testDomainID,
+		DeleteRequest: request,
+	}).Return(nil).Times(1)
+
+	err := wh.DeleteWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+}
+
+func (s *workflowHandlerSuite) TestPauseActivity_Failed_ActivityIDNotSet() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	err := wh.PauseActivity(context.Background(), &types.PauseActivityRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "workflow-id"},
+	})
+	s.Equal(errActivityIDNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestPauseActivity_Success() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	request := &types.PauseActivityRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "workflow-id"},
+		ActivityID:        "activity-id",
+		Identity:          "operator",
+	}
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).Times(1)
+	s.mockHistoryClient.EXPECT().PauseActivity(gomock.Any(), &types.HistoryPauseActivityRequest{
+		DomainUUID:   s.testDomainID,
+		PauseRequest: request,
+	}).Return(nil).Times(1)
+
+	err := wh.PauseActivity(context.Background(), request)
+	s.NoError(err)
+}
+
+func (s *workflowHandlerSuite) TestUnpauseActivity_Success() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	request := &types.UnpauseActivityRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "workflow-id"},
+		ActivityID:        "activity-id",
+		Identity:          "operator",
+	}
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).Times(1)
+	s.mockHistoryClient.EXPECT().UnpauseActivity(gomock.Any(), &types.HistoryUnpauseActivityRequest{
+		DomainUUID:     s.testDomainID,
+		UnpauseRequest: request,
+	}).Return(nil).Times(1)
+
+	err := wh.UnpauseActivity(context.Background(), request)
+	s.NoError(err)
+}
+
+func (s *workflowHandlerSuite) TestResetActivity_Success() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	request := &types.ResetActivityRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "workflow-id"},
+		ActivityID:        "activity-id",
+		RetryNow:          true,
+		Identity:          "operator",
+	}
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).Times(1)
+	s.mockHistoryClient.EXPECT().ResetActivity(gomock.Any(), &types.HistoryResetActivityRequest{
+		DomainUUID:   s.testDomainID,
+		ResetRequest: request,
+	}).Return(nil).Times(1)
+
+	err := wh.ResetActivity(context.Background(), request)
+	s.NoError(err)
+}
+
+func (s *workflowHandlerSuite) TestUnpauseWorkflowExecution_Success() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	request := &types.UnpauseWorkflowExecutionRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "workflow-id"},
+		Identity:          "operator",
+	}
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).Times(1)
+	s.mockHistoryClient.EXPECT().UnpauseWorkflowExecution(gomock.Any(), &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     s.testDomainID,
+		UnpauseRequest: request,
+	}).Return(nil).Times(1)
+
+	err := wh.UnpauseWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+}
+
 func (s *workflowHandlerSuite) newConfig(dynamicClient dc.Client) *Config {
-	return NewConfig(
+	config := NewConfig(
 		dc.NewCollection(
 			dynamicClient,
 			s.mockResource.GetLogger(),
@@ -1462,6 +1782,9 @@ func (s *workflowHandlerSuite) newConfig(dynamicClient dc.Client) *Config {
 		false,
 		false,
 	)
+	// domain limits are resolved through the domain cache, keep them off unless a test opts in
+	config.EnableDomainLimits = dc.GetBoolPropertyFnFilteredByDomain(false)
+	return config
 }
 
 func updateRequest(
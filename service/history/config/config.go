@@ -87,6 +87,7 @@ type Config struct {
 
 	// Task process settings
 	TaskProcessRPS                          dynamicconfig.IntPropertyFnWithDomainFilter
+	TaskDomainTier                          dynamicconfig.StringPropertyFnWithDomainFilter
 	TaskSchedulerType                       dynamicconfig.IntPropertyFn
 	TaskSchedulerWorkerCount                dynamicconfig.IntPropertyFn
 	TaskSchedulerShardWorkerCount           dynamicconfig.IntPropertyFn
@@ -99,6 +100,8 @@ type Config struct {
 	TaskRedispatchIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
 	StandbyTaskReReplicationContextTimeout  dynamicconfig.DurationPropertyFnWithDomainIDFilter
 	EnableDropStuckTaskByDomainID           dynamicconfig.BoolPropertyFnWithDomainIDFilter
+	EnableHistoryTaskDLQByDomainID          dynamicconfig.BoolPropertyFnWithDomainIDFilter
+	HistoryTaskDLQMaxAttempts               dynamicconfig.IntPropertyFn
 
 	// QueueProcessor settings
 	QueueProcessorEnableSplit                          dynamicconfig.BoolPropertyFn
@@ -110,6 +113,7 @@ type Config struct {
 	QueueProcessorEnableStuckTaskSplitByDomainID       dynamicconfig.BoolPropertyFnWithDomainIDFilter
 	QueueProcessorStuckTaskSplitThreshold              dynamicconfig.MapPropertyFn
 	QueueProcessorSplitLookAheadDurationByDomainID     dynamicconfig.DurationPropertyFnWithDomainIDFilter
+	QueueProcessorDomainTierSplitLevel                 dynamicconfig.MapPropertyFn
 	QueueProcessorPollBackoffInterval                  dynamicconfig.DurationPropertyFn
 	QueueProcessorPollBackoffIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
 	QueueProcessorEnablePersistQueueStates             dynamicconfig.BoolPropertyFn
@@ -193,6 +197,9 @@ type Config struct {
 	// System Limits
 	MaximumBufferedEventsBatch dynamicconfig.IntPropertyFn
 	MaximumSignalsPerExecution dynamicconfig.IntPropertyFnWithDomainFilter
+	// SignalRequestIDDedupWindow is how long signal request IDs are remembered for deduplication
+	SignalRequestIDDedupWindow          dynamicconfig.DurationPropertyFnWithDomainFilter
+	MaximumSignalRequestIDsPerExecution dynamicconfig.IntPropertyFnWithDomainFilter
 
 	// ShardUpdateMinInterval the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval dynamicconfig.DurationPropertyFn
@@ -264,6 +271,9 @@ type Config struct {
 	ReplicationTaskProcessorStartWaitJitterCoefficient dynamicconfig.FloatPropertyFnWithShardIDFilter
 	ReplicationTaskProcessorHostQPS                    dynamicconfig.FloatPropertyFn
 	ReplicationTaskProcessorShardQPS                   dynamicconfig.FloatPropertyFn
+	ReplicationDLQAutoMergeEnabled                     dynamicconfig.BoolPropertyFn
+	ReplicationDLQAutoMergeInterval                    dynamicconfig.DurationPropertyFn
+	ReplicationDLQAutoMergeBatchSize                   dynamicconfig.IntPropertyFn
 	ReplicationTaskGenerationQPS                       dynamicconfig.FloatPropertyFn
 
 	// The following are used by consistent query
@@ -284,6 +294,15 @@ type Config struct {
 	NotifyFailoverMarkerTimerJitterCoefficient dynamicconfig.FloatPropertyFn
 	EnableGracefulFailover                     dynamicconfig.BoolPropertyFn
 
+	// health driven failover
+	EnableHealthDrivenFailover                        dynamicconfig.BoolPropertyFn
+	HealthDrivenFailoverCheckInterval                 dynamicconfig.DurationPropertyFn
+	HealthDrivenFailoverUnhealthyThreshold            dynamicconfig.IntPropertyFn
+	HealthDrivenFailoverCooldown                      dynamicconfig.DurationPropertyFn
+	HealthDrivenFailoverFetchStalenessThreshold       dynamicconfig.DurationPropertyFn
+	HealthDrivenFailoverRemoteUnavailabilityThreshold dynamicconfig.FloatPropertyFn
+	HealthDrivenFailoverFrontendErrorRateThreshold    dynamicconfig.FloatPropertyFn
+
 	// Allows worker to dispatch activity tasks through local tunnel after decisions are made. This is an performance optimization to skip activity scheduling efforts.
 	EnableActivityLocalDispatchByDomain dynamicconfig.BoolPropertyFnWithDomainFilter
 
@@ -299,11 +318,25 @@ const (
 	DefaultHistoryMaxAutoResetPoints = 20
 )
 
+const (
+	// DomainTierCritical is the tier for domains whose tasks are processed ahead of all other domains
+	DomainTierCritical = "critical"
+	// DomainTierStandard is the default domain tier
+	DomainTierStandard = "standard"
+	// DomainTierBatch is the tier for throughput oriented domains that can tolerate higher task latency
+	DomainTierBatch = "batch"
+)
+
 var (
 	// DefaultTaskPriorityWeight is the default round robin weight used by task scheduler
+	// Domain tiers are mapped to priority subclasses: critical -> high, standard -> default, batch -> low
 	DefaultTaskPriorityWeight = map[int]int{
+		task.GetTaskPriority(task.HighPriorityClass, task.HighPrioritySubclass):       1000,
 		task.GetTaskPriority(task.HighPriorityClass, task.DefaultPrioritySubclass):    500,
+		task.GetTaskPriority(task.HighPriorityClass, task.LowPrioritySubclass):        100,
+		task.GetTaskPriority(task.DefaultPriorityClass, task.HighPrioritySubclass):    40,
 		task.GetTaskPriority(task.DefaultPriorityClass, task.DefaultPrioritySubclass): 20,
+		task.GetTaskPriority(task.DefaultPriorityClass, task.LowPrioritySubclass):     5,
 		task.GetTaskPriority(task.LowPriorityClass, task.DefaultPrioritySubclass):     5,
 	}
 
@@ -366,6 +399,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		StandbyTaskMissingEventsDiscardDelay: dc.GetDurationProperty(dynamicconfig.StandbyTaskMissingEventsDiscardDelay, 25*time.Minute),
 
 		TaskProcessRPS:                          dc.GetIntPropertyFilteredByDomain(dynamicconfig.TaskProcessRPS, 1000),
+		TaskDomainTier:                          dc.GetStringPropertyFilteredByDomain(dynamicconfig.TaskDomainTier, DomainTierStandard),
 		TaskSchedulerType:                       dc.GetIntProperty(dynamicconfig.TaskSchedulerType, int(task.SchedulerTypeWRR)),
 		TaskSchedulerWorkerCount:                dc.GetIntProperty(dynamicconfig.TaskSchedulerWorkerCount, 200),
 		TaskSchedulerShardWorkerCount:           dc.GetIntProperty(dynamicconfig.TaskSchedulerShardWorkerCount, 0),
@@ -378,6 +412,8 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		TaskRedispatchIntervalJitterCoefficient: dc.GetFloat64Property(dynamicconfig.TaskRedispatchIntervalJitterCoefficient, 0.15),
 		StandbyTaskReReplicationContextTimeout:  dc.GetDurationPropertyFilteredByDomainID(dynamicconfig.StandbyTaskReReplicationContextTimeout, 3*time.Minute),
 		EnableDropStuckTaskByDomainID:           dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.EnableDropStuckTaskByDomainID, false),
+		EnableHistoryTaskDLQByDomainID:          dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.EnableHistoryTaskDLQByDomainID, false),
+		HistoryTaskDLQMaxAttempts:               dc.GetIntProperty(dynamicconfig.HistoryTaskDLQMaxAttempts, 100),
 
 		QueueProcessorEnableSplit:                          dc.GetBoolProperty(dynamicconfig.QueueProcessorEnableSplit, false),
 		QueueProcessorSplitMaxLevel:                        dc.GetIntProperty(dynamicconfig.QueueProcessorSplitMaxLevel, 2), // 3 levels, start from 0
@@ -388,6 +424,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		QueueProcessorEnableStuckTaskSplitByDomainID:       dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.QueueProcessorEnableStuckTaskSplitByDomainID, false),
 		QueueProcessorStuckTaskSplitThreshold:              dc.GetMapProperty(dynamicconfig.QueueProcessorStuckTaskSplitThreshold, common.ConvertIntMapToDynamicConfigMapProperty(DefaultStuckTaskSplitThreshold)),
 		QueueProcessorSplitLookAheadDurationByDomainID:     dc.GetDurationPropertyFilteredByDomainID(dynamicconfig.QueueProcessorSplitLookAheadDurationByDomainID, 20*time.Minute),
+		QueueProcessorDomainTierSplitLevel:                 dc.GetMapProperty(dynamicconfig.QueueProcessorDomainTierSplitLevel, map[string]interface{}{}),
 		QueueProcessorPollBackoffInterval:                  dc.GetDurationProperty(dynamicconfig.QueueProcessorPollBackoffInterval, 5*time.Second),
 		QueueProcessorPollBackoffIntervalJitterCoefficient: dc.GetFloat64Property(dynamicconfig.QueueProcessorPollBackoffIntervalJitterCoefficient, 0.15),
 		QueueProcessorEnablePersistQueueStates:             dc.GetBoolProperty(dynamicconfig.QueueProcessorEnablePersistQueueStates, true),
@@ -464,10 +501,12 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		HistoryMgrNumConns:         dc.GetIntProperty(dynamicconfig.HistoryMgrNumConns, 50),
 		MaximumBufferedEventsBatch: dc.GetIntProperty(dynamicconfig.MaximumBufferedEventsBatch, 100),
 		// 10K signals should big enough given workflow execution has 200K history lengh limit. It needs to be non-zero to protect continueAsNew from infinit loop
-		MaximumSignalsPerExecution:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaximumSignalsPerExecution, 10000),
-		ShardUpdateMinInterval:          dc.GetDurationProperty(dynamicconfig.ShardUpdateMinInterval, 5*time.Minute),
-		ShardSyncMinInterval:            dc.GetDurationProperty(dynamicconfig.ShardSyncMinInterval, 5*time.Minute),
-		ShardSyncTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.TransferProcessorMaxPollIntervalJitterCoefficient, 0.15),
+		MaximumSignalsPerExecution:          dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaximumSignalsPerExecution, 10000),
+		SignalRequestIDDedupWindow:          dc.GetDurationPropertyFilteredByDomain(dynamicconfig.SignalRequestIDDedupWindow, 24*time.Hour),
+		MaximumSignalRequestIDsPerExecution: dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaximumSignalRequestIDsPerExecution, 1000),
+		ShardUpdateMinInterval:              dc.GetDurationProperty(dynamicconfig.ShardUpdateMinInterval, 5*time.Minute),
+		ShardSyncMinInterval:                dc.GetDurationProperty(dynamicconfig.ShardSyncMinInterval, 5*time.Minute),
+		ShardSyncTimerJitterCoefficient:     dc.GetFloat64Property(dynamicconfig.TransferProcessorMaxPollIntervalJitterCoefficient, 0.15),
 
 		// history client: client/history/client.go set the client timeout 30s
 		LongPollExpirationInterval:          dc.GetDurationPropertyFilteredByDomain(dynamicconfig.HistoryLongPollExpirationInterval, time.Second*20),
@@ -516,6 +555,9 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		ReplicationTaskProcessorStartWaitJitterCoefficient: dc.GetFloat64PropertyFilteredByShardID(dynamicconfig.ReplicationTaskProcessorStartWaitJitterCoefficient, 0.9),
 		ReplicationTaskProcessorHostQPS:                    dc.GetFloat64Property(dynamicconfig.ReplicationTaskProcessorHostQPS, 1500),
 		ReplicationTaskProcessorShardQPS:                   dc.GetFloat64Property(dynamicconfig.ReplicationTaskProcessorShardQPS, 5),
+		ReplicationDLQAutoMergeEnabled:                     dc.GetBoolProperty(dynamicconfig.ReplicationDLQAutoMergeEnabled, false),
+		ReplicationDLQAutoMergeInterval:                    dc.GetDurationProperty(dynamicconfig.ReplicationDLQAutoMergeInterval, 5*time.Minute),
+		ReplicationDLQAutoMergeBatchSize:                   dc.GetIntProperty(dynamicconfig.ReplicationDLQAutoMergeBatchSize, 100),
 		ReplicationTaskGenerationQPS:                       dc.GetFloat64Property(dynamicconfig.ReplicationTaskGenerationQPS, 100),
 
 		EnableConsistentQuery:                 dc.GetBoolProperty(dynamicconfig.EnableConsistentQuery, true),
@@ -531,6 +573,14 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		NotifyFailoverMarkerTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.NotifyFailoverMarkerTimerJitterCoefficient, 0.15),
 		EnableGracefulFailover:                     dc.GetBoolProperty(dynamicconfig.EnableGracefulFailover, false),
 
+		EnableHealthDrivenFailover:                        dc.GetBoolProperty(dynamicconfig.EnableHealthDrivenFailover, false),
+		HealthDrivenFailoverCheckInterval:                 dc.GetDurationProperty(dynamicconfig.HealthDrivenFailoverCheckInterval, 30*time.Second),
+		HealthDrivenFailoverUnhealthyThreshold:            dc.GetIntProperty(dynamicconfig.HealthDrivenFailoverUnhealthyThreshold, 5),
+		HealthDrivenFailoverCooldown:                      dc.GetDurationProperty(dynamicconfig.HealthDrivenFailoverCooldown, 30*time.Minute),
+		HealthDrivenFailoverFetchStalenessThreshold:       dc.GetDurationProperty(dynamicconfig.HealthDrivenFailoverFetchStalenessThreshold, 5*time.Minute),
+		HealthDrivenFailoverRemoteUnavailabilityThreshold: dc.GetFloat64Property(dynamicconfig.HealthDrivenFailoverRemoteUnavailabilityThreshold, 0.5),
+		HealthDrivenFailoverFrontendErrorRateThreshold:    dc.GetFloat64Property(dynamicconfig.HealthDrivenFailoverFrontendErrorRateThreshold, 0.2),
+
 		EnableActivityLocalDispatchByDomain: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableActivityLocalDispatchByDomain, false),
 
 		ActivityMaxScheduleToStartTimeoutForRetry: dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityMaxScheduleToStartTimeoutForRetry, 30*time.Minute),
@@ -593,6 +593,37 @@ func (v *attrValidator) validateUpsertWorkflowSearchAttributes(
 	return v.searchAttributesValidator.ValidateSearchAttributes(attributes.GetSearchAttributes(), domainName)
 }
 
+func (v *attrValidator) validateUpsertWorkflowMemoAttributes(
+	attributes *types.UpsertWorkflowMemoDecisionAttributes,
+) error {
+
+	if attributes == nil {
+		return &types.BadRequestError{Message: "UpsertWorkflowMemoDecisionAttributes is not set on decision."}
+	}
+
+	if len(attributes.GetMemo().GetFields()) == 0 {
+		return &types.BadRequestError{Message: "Memo fields are empty on decision."}
+	}
+
+	return nil
+}
+
+func (v *attrValidator) validateCompleteWorkflowUpdateAttributes(
+	attributes *types.CompleteWorkflowUpdateDecisionAttributes,
+) error {
+
+	if attributes == nil {
+		return &types.BadRequestError{Message: "CompleteWorkflowUpdateDecisionAttributes is not set on decision."}
+	}
+	if attributes.GetUpdateID() == "" {
+		return &types.BadRequestError{Message: "UpdateID is not set on decision."}
+	}
+	if attributes.Result != nil && attributes.GetErrorMessage() != "" {
+		return &types.BadRequestError{Message: "Only one of Result and ErrorMessage can be set on decision."}
+	}
+	return nil
+}
+
 func (v *attrValidator) validateContinueAsNewWorkflowExecutionAttributes(
 	attributes *types.ContinueAsNewWorkflowExecutionDecisionAttributes,
 	executionInfo *persistence.WorkflowExecutionInfo,
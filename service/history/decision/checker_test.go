@@ -175,6 +175,21 @@ func (s *attrValidatorSuite) TestValidateUpsertWorkflowSearchAttributes() {
 	s.Nil(err)
 }
 
+func (s *attrValidatorSuite) TestValidateUpsertWorkflowMemoAttributes() {
+	var attributes *types.UpsertWorkflowMemoDecisionAttributes
+
+	err := s.validator.validateUpsertWorkflowMemoAttributes(attributes)
+	s.EqualError(err, "BadRequestError{Message: UpsertWorkflowMemoDecisionAttributes is not set on decision.}")
+
+	attributes = &types.UpsertWorkflowMemoDecisionAttributes{}
+	err = s.validator.validateUpsertWorkflowMemoAttributes(attributes)
+	s.EqualError(err, "BadRequestError{Message: Memo fields are empty on decision.}")
+
+	attributes.Memo = &types.Memo{Fields: map[string][]byte{"key": []byte(`"value"`)}}
+	err = s.validator.validateUpsertWorkflowMemoAttributes(attributes)
+	s.Nil(err)
+}
+
 func (s *attrValidatorSuite) TestValidateCrossDomainCall_LocalToLocal() {
 	domainEntry := cache.NewLocalDomainCacheEntryForTest(
 		&persistence.DomainInfo{Name: s.testDomainID},
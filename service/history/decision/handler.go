@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/query"
 	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/update"
 	"github.com/uber/cadence/service/history/workflow"
 )
 
@@ -178,6 +179,11 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 				return nil, workflow.ErrNotExists
 			}
 
+			// decision will be redispatched when workflow is unpaused
+			if mutableState.IsWorkflowPaused() {
+				return nil, workflow.ErrWorkflowPaused
+			}
+
 			decision, isRunning := mutableState.GetDecisionInfo(scheduleID)
 
 			// First check to see if cache needs to be refreshed as we could potentially have stale workflow execution in
@@ -209,7 +215,7 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 			if decision.StartedID != common.EmptyEventID {
 				// If decision is started as part of the current request scope then return a positive response
 				if decision.RequestID == requestID {
-					resp, err = handler.createRecordDecisionTaskStartedResponse(domainID, mutableState, decision, req.PollRequest.GetIdentity())
+					resp, err = handler.createRecordDecisionTaskStartedResponse(domainEntry, mutableState, decision, req.PollRequest.GetIdentity(), context.GetHistorySize())
 					if err != nil {
 						return nil, err
 					}
@@ -228,7 +234,7 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 				return nil, &types.InternalServiceError{Message: "Unable to add DecisionTaskStarted event to history."}
 			}
 
-			resp, err = handler.createRecordDecisionTaskStartedResponse(domainID, mutableState, decision, req.PollRequest.GetIdentity())
+			resp, err = handler.createRecordDecisionTaskStartedResponse(domainEntry, mutableState, decision, req.PollRequest.GetIdentity(), context.GetHistorySize())
 			if err != nil {
 				return nil, err
 			}
@@ -397,6 +403,7 @@ Update_History_Loop:
 			continueAsNewBuilder        execution.MutableState
 			hasUnhandledEvents          bool
 			decisionResults             []*decisionResult
+			completedUpdates            []*types.CompleteWorkflowUpdateDecisionAttributes
 		)
 		hasUnhandledEvents = msBuilder.HasBufferedEvents()
 
@@ -421,11 +428,12 @@ Update_History_Loop:
 		} else {
 
 			domainName := domainEntry.GetInfo().Name
+			historySizeLimitWarn, historySizeLimitError := handler.historySizeLimits(domainEntry)
 			workflowSizeChecker := newWorkflowSizeChecker(
 				handler.config.BlobSizeLimitWarn(domainName),
 				handler.config.BlobSizeLimitError(domainName),
-				handler.config.HistorySizeLimitWarn(domainName),
-				handler.config.HistorySizeLimitError(domainName),
+				historySizeLimitWarn,
+				historySizeLimitError,
 				handler.config.HistoryCountLimitWarn(domainName),
 				handler.config.HistoryCountLimitError(domainName),
 				completedEvent.GetEventID(),
@@ -472,6 +480,7 @@ Update_History_Loop:
 			continueAsNewBuilder = decisionTaskHandler.continueAsNewBuilder
 
 			hasUnhandledEvents = decisionTaskHandler.hasUnhandledEventsBeforeDecisions
+			completedUpdates = decisionTaskHandler.completedUpdates
 		}
 
 		if failDecision {
@@ -486,6 +495,7 @@ Update_History_Loop:
 			}
 			hasUnhandledEvents = true
 			continueAsNewBuilder = nil
+			completedUpdates = nil
 		}
 
 		createNewDecisionTask := msBuilder.IsWorkflowExecutionRunning() && (hasUnhandledEvents || request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled)
@@ -592,6 +602,11 @@ Update_History_Loop:
 			domainEntry,
 			decisionHeartbeating)
 
+		handler.handlePendingUpdates(
+			msBuilder,
+			completedUpdates,
+			domainEntry)
+
 		if decisionHeartbeatTimeout {
 			// at this point, update is successful, but we still return an error to client so that the worker will give up this workflow
 			return nil, &types.EntityNotExistsError{
@@ -610,7 +625,7 @@ Update_History_Loop:
 
 		if request.GetReturnNewDecisionTask() && createNewDecisionTask {
 			decision, _ := msBuilder.GetDecisionInfo(newDecisionTaskScheduledID)
-			resp.StartedResponse, err = handler.createRecordDecisionTaskStartedResponse(domainID, msBuilder, decision, request.GetIdentity())
+			resp.StartedResponse, err = handler.createRecordDecisionTaskStartedResponse(domainEntry, msBuilder, decision, request.GetIdentity(), wfContext.GetHistorySize())
 			if err != nil {
 				return nil, err
 			}
@@ -625,10 +640,11 @@ Update_History_Loop:
 }
 
 func (handler *handlerImpl) createRecordDecisionTaskStartedResponse(
-	domainID string,
+	domainEntry *cache.DomainCacheEntry,
 	msBuilder execution.MutableState,
 	decision *execution.DecisionInfo,
 	identity string,
+	historySize int64,
 ) (*types.RecordDecisionTaskStartedResponse, error) {
 
 	response := &types.RecordDecisionTaskStartedResponse{}
@@ -652,6 +668,15 @@ func (handler *handlerImpl) createRecordDecisionTaskStartedResponse(
 	response.ScheduledTimestamp = common.Int64Ptr(decision.ScheduledTimestamp)
 	response.StartedTimestamp = common.Int64Ptr(decision.StartedTimestamp)
 
+	// Surface the history size and event count so that workers can continue as new
+	// before the workflow is terminated for exceeding the error limits.
+	domainName := domainEntry.GetInfo().Name
+	historySizeLimitWarn, _ := handler.historySizeLimits(domainEntry)
+	response.HistorySize = historySize
+	response.HistoryCount = msBuilder.GetNextEventID() - 1
+	response.SuggestContinueAsNew = response.HistorySize > int64(historySizeLimitWarn) ||
+		response.HistoryCount > int64(handler.config.HistoryCountLimitWarn(domainName))
+
 	if decision.Attempt > 0 {
 		// This decision is retried from mutable state
 		// Also return schedule and started which are not written to history yet
@@ -680,6 +705,30 @@ func (handler *handlerImpl) createRecordDecisionTaskStartedResponse(
 	return response, nil
 }
 
+// historySizeLimits returns the warn and error history size limits of the domain.
+// A domain level MaxHistorySize replaces the error limit and scales the warn limit
+// by the same ratio, so that workers are told to continue as new before the
+// workflow is terminated.
+func (handler *handlerImpl) historySizeLimits(
+	domainEntry *cache.DomainCacheEntry,
+) (int, int) {
+
+	domainName := domainEntry.GetInfo().Name
+	warnLimit := handler.config.HistorySizeLimitWarn(domainName)
+	errorLimit := handler.config.HistorySizeLimitError(domainName)
+	limit := domainEntry.GetConfig().Limits.MaxHistorySize
+	if limit <= 0 {
+		return warnLimit, errorLimit
+	}
+
+	if errorLimit > 0 && warnLimit < errorLimit {
+		warnLimit = int(limit * int64(warnLimit) / int64(errorLimit))
+	} else {
+		warnLimit = int(limit)
+	}
+	return warnLimit, int(limit)
+}
+
 func (handler *handlerImpl) handleBufferedQueries(
 	msBuilder execution.MutableState,
 	clientImpl string,
@@ -816,6 +865,59 @@ func (handler *handlerImpl) handleBufferedQueries(
 	}
 }
 
+func (handler *handlerImpl) handlePendingUpdates(
+	msBuilder execution.MutableState,
+	completedUpdates []*types.CompleteWorkflowUpdateDecisionAttributes,
+	domainEntry *cache.DomainCacheEntry,
+) {
+	updateRegistry := msBuilder.GetUpdateRegistry()
+	if !updateRegistry.HasPendingUpdate() {
+		return
+	}
+
+	domain := domainEntry.GetInfo().Name
+	workflowID := msBuilder.GetExecutionInfo().WorkflowID
+	runID := msBuilder.GetExecutionInfo().RunID
+
+	for _, attr := range completedUpdates {
+		completedTerminationState := &update.TerminationState{
+			TerminationType: update.TerminationTypeCompleted,
+			Result:          attr.Result,
+			ErrorMessage:    attr.GetErrorMessage(),
+		}
+		if err := updateRegistry.SetTerminationState(attr.GetUpdateID(), completedTerminationState); err != nil {
+			// the caller may have already given up on this update, or it was accepted by another host
+			handler.logger.Debug(
+				"failed to set update termination state to completed",
+				tag.WorkflowDomainName(domain),
+				tag.WorkflowID(workflowID),
+				tag.WorkflowRunID(runID),
+				tag.UpdateID(attr.GetUpdateID()),
+				tag.Error(err))
+		}
+	}
+
+	// updates which are still pending once the workflow is closed will never be completed
+	if msBuilder.IsWorkflowExecutionRunning() {
+		return
+	}
+	failedTerminationState := &update.TerminationState{
+		TerminationType: update.TerminationTypeFailed,
+		Failure:         workflow.ErrAlreadyCompleted,
+	}
+	for _, id := range updateRegistry.GetPendingIDs() {
+		if err := updateRegistry.SetTerminationState(id, failedTerminationState); err != nil {
+			handler.logger.Error(
+				"failed to set update termination state to failed",
+				tag.WorkflowDomainName(domain),
+				tag.WorkflowID(workflowID),
+				tag.WorkflowRunID(runID),
+				tag.UpdateID(id),
+				tag.Error(err))
+		}
+	}
+}
+
 func failDecisionHelper(
 	ctx context.Context,
 	wfContext execution.Context,
@@ -28,7 +28,10 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
 
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
@@ -144,3 +147,72 @@ func (s *DecisionHandlerSuite) assertQueryCounts(queryRegistry query.Registry, b
 	s.Len(queryRegistry.GetUnblockedIDs(), unblocked)
 	s.Len(queryRegistry.GetFailedIDs(), failed)
 }
+
+func (s *DecisionHandlerSuite) TestCreateRecordDecisionTaskStartedResponse_SuggestContinueAsNew() {
+	s.decisionHandler.config.HistorySizeLimitWarn = dynamicconfig.GetIntPropertyFilteredByDomain(1024)
+	s.decisionHandler.config.HistoryCountLimitWarn = dynamicconfig.GetIntPropertyFilteredByDomain(100)
+
+	testCases := []struct {
+		name          string
+		historySize   int64
+		nextEventID   int64
+		expectSuggest bool
+	}{
+		{name: "below warn limits", historySize: 512, nextEventID: 51, expectSuggest: false},
+		{name: "size exceeds warn limit", historySize: 2048, nextEventID: 51, expectSuggest: true},
+		{name: "count exceeds warn limit", historySize: 512, nextEventID: 102, expectSuggest: true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.mockMutableState.EXPECT().GetQueryRegistry().Return(s.queryRegistry).AnyTimes()
+			s.mockMutableState.EXPECT().GetWorkflowType().Return(&types.WorkflowType{Name: "wfType"})
+			s.mockMutableState.EXPECT().IsStickyTaskListEnabled().Return(false)
+			s.mockMutableState.EXPECT().GetNextEventID().Return(tc.nextEventID).Times(2)
+			s.mockMutableState.EXPECT().GetCurrentBranchToken().Return([]byte{}, nil)
+
+			resp, err := s.decisionHandler.createRecordDecisionTaskStartedResponse(
+				constants.TestGlobalDomainEntry,
+				s.mockMutableState,
+				&execution.DecisionInfo{ScheduleID: 2, StartedID: 3},
+				"identity",
+				tc.historySize,
+			)
+			s.NoError(err)
+			s.Equal(tc.historySize, resp.HistorySize)
+			s.Equal(tc.nextEventID-1, resp.HistoryCount)
+			s.Equal(tc.expectSuggest, resp.SuggestContinueAsNew)
+		})
+	}
+}
+
+func TestHistorySizeLimits(t *testing.T) {
+	handler := &handlerImpl{config: config.NewForTest()}
+	handler.config.HistorySizeLimitWarn = dynamicconfig.GetIntPropertyFilteredByDomain(800)
+	handler.config.HistorySizeLimitError = dynamicconfig.GetIntPropertyFilteredByDomain(1000)
+
+	testCases := []struct {
+		name           string
+		maxHistorySize int64
+		expectWarn     int
+		expectError    int
+	}{
+		{name: "no domain limit", maxHistorySize: 0, expectWarn: 800, expectError: 1000},
+		{name: "domain limit lower than config", maxHistorySize: 500, expectWarn: 400, expectError: 500},
+		{name: "domain limit higher than config", maxHistorySize: 2000, expectWarn: 1600, expectError: 2000},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			domainEntry := cache.NewLocalDomainCacheEntryForTest(
+				&persistence.DomainInfo{ID: constants.TestDomainID, Name: constants.TestDomainName},
+				&persistence.DomainConfig{Retention: 1, Limits: types.DomainLimits{MaxHistorySize: tc.maxHistorySize}},
+				cluster.TestCurrentClusterName,
+				nil,
+			)
+			warnLimit, errorLimit := handler.historySizeLimits(domainEntry)
+			require.Equal(t, tc.expectWarn, warnLimit)
+			require.Equal(t, tc.expectError, errorLimit)
+		})
+	}
+}
@@ -59,6 +59,7 @@ type (
 		activityNotStartedCancelled       bool
 		continueAsNewBuilder              execution.MutableState
 		stopProcessing                    bool // should stop processing any more decisions
+		completedUpdates                  []*types.CompleteWorkflowUpdateDecisionAttributes
 		mutableState                      execution.MutableState
 
 		// validation
@@ -201,6 +202,12 @@ func (handler *taskHandlerImpl) handleDecision(
 	case types.DecisionTypeUpsertWorkflowSearchAttributes:
 		return handler.handleDecisionUpsertWorkflowSearchAttributes(ctx, decision.UpsertWorkflowSearchAttributesDecisionAttributes)
 
+	case types.DecisionTypeCompleteWorkflowUpdate:
+		return handler.handleDecisionCompleteWorkflowUpdate(ctx, decision.CompleteWorkflowUpdateDecisionAttributes)
+
+	case types.DecisionTypeUpsertWorkflowMemo:
+		return handler.handleDecisionUpsertWorkflowMemo(ctx, decision.UpsertWorkflowMemoDecisionAttributes)
+
 	default:
 		return &types.BadRequestError{Message: fmt.Sprintf("Unknown decision type: %v", decision.GetDecisionType())}
 	}
@@ -244,6 +251,14 @@ func (handler *taskHandlerImpl) handleDecisionScheduleActivity(
 		return nil, err
 	}
 
+	if limit := handler.domainEntry.GetConfig().Limits.MaxPendingActivities; limit > 0 &&
+		len(handler.mutableState.GetPendingActivityInfos()) >= int(limit) {
+		return nil, handler.handlerFailDecision(
+			types.DecisionTaskFailedCauseBadScheduleActivityAttributes,
+			fmt.Sprintf("Domain limit of %v pending activities reached.", limit),
+		)
+	}
+
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
 		metrics.DecisionTypeTag(types.DecisionTypeScheduleActivityTask.String()),
 		attr.Input,
@@ -965,6 +980,82 @@ func (handler *taskHandlerImpl) handleDecisionUpsertWorkflowSearchAttributes(
 	return err
 }
 
+func (handler *taskHandlerImpl) handleDecisionUpsertWorkflowMemo(
+	ctx context.Context,
+	attr *types.UpsertWorkflowMemoDecisionAttributes,
+) error {
+
+	handler.metricsClient.IncCounter(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.DecisionTypeUpsertWorkflowMemoCounter,
+	)
+
+	if err := handler.validateDecisionAttr(
+		func() error {
+			return handler.attrValidator.validateUpsertWorkflowMemoAttributes(attr)
+		},
+		types.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
+	// blob size limit check
+	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
+		metrics.DecisionTypeTag(types.DecisionTypeUpsertWorkflowMemo.String()),
+		convertSearchAttributesToByteArray(attr.GetMemo().GetFields()),
+		"UpsertWorkflowMemoDecisionAttributes exceeds size limit.",
+	)
+	if err != nil || failWorkflow {
+		handler.stopProcessing = true
+		return err
+	}
+
+	_, err = handler.mutableState.AddUpsertWorkflowMemoEvent(
+		handler.decisionTaskCompletedID, attr,
+	)
+	return err
+}
+
+func (handler *taskHandlerImpl) handleDecisionCompleteWorkflowUpdate(
+	ctx context.Context,
+	attr *types.CompleteWorkflowUpdateDecisionAttributes,
+) error {
+
+	handler.metricsClient.IncCounter(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.DecisionTypeCompleteWorkflowUpdateCounter,
+	)
+
+	if err := handler.validateDecisionAttr(
+		func() error {
+			return handler.attrValidator.validateCompleteWorkflowUpdateAttributes(attr)
+		},
+		types.DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
+	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
+		metrics.DecisionTypeTag(types.DecisionTypeCompleteWorkflowUpdate.String()),
+		attr.Result,
+		"CompleteWorkflowUpdateDecisionAttributes.Result exceeds size limit.",
+	)
+	if err != nil || failWorkflow {
+		handler.stopProcessing = true
+		return err
+	}
+
+	if _, err := handler.mutableState.AddWorkflowExecutionUpdateCompletedEvent(handler.decisionTaskCompletedID, attr); err != nil {
+		// the update was never accepted or has already been completed
+		if _, ok := err.(*types.BadRequestError); ok {
+			return handler.handlerFailDecision(types.DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes, err.Error())
+		}
+		return err
+	}
+	handler.completedUpdates = append(handler.completedUpdates, attr)
+	return nil
+}
+
 func convertSearchAttributesToByteArray(fields map[string][]byte) []byte {
 	result := make([]byte, 0)
 
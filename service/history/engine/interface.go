@@ -51,6 +51,12 @@ type (
 		RespondActivityTaskCanceled(ctx context.Context, request *types.HistoryRespondActivityTaskCanceledRequest) error
 		RecordActivityTaskHeartbeat(ctx context.Context, request *types.HistoryRecordActivityTaskHeartbeatRequest) (*types.RecordActivityTaskHeartbeatResponse, error)
 		RequestCancelWorkflowExecution(ctx context.Context, request *types.HistoryRequestCancelWorkflowExecutionRequest) error
+		PauseWorkflowExecution(ctx context.Context, request *types.HistoryPauseWorkflowExecutionRequest) error
+		UnpauseWorkflowExecution(ctx context.Context, request *types.HistoryUnpauseWorkflowExecutionRequest) error
+		DeleteWorkflowExecution(ctx context.Context, request *types.HistoryDeleteWorkflowExecutionRequest) error
+		PauseActivity(ctx context.Context, request *types.HistoryPauseActivityRequest) error
+		UnpauseActivity(ctx context.Context, request *types.HistoryUnpauseActivityRequest) error
+		ResetActivity(ctx context.Context, request *types.HistoryResetActivityRequest) error
 		SignalWorkflowExecution(ctx context.Context, request *types.HistorySignalWorkflowExecutionRequest) error
 		SignalWithStartWorkflowExecution(ctx context.Context, request *types.HistorySignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
 		RemoveSignalMutableState(ctx context.Context, request *types.RemoveSignalMutableStateRequest) error
@@ -66,10 +72,15 @@ type (
 		GetCrossClusterTasks(ctx context.Context, targetCluster string) ([]*types.CrossClusterTaskRequest, error)
 		RespondCrossClusterTasksCompleted(ctx context.Context, targetCluster string, responses []*types.CrossClusterTaskResponse) error
 		QueryWorkflow(ctx context.Context, request *types.HistoryQueryWorkflowRequest) (*types.HistoryQueryWorkflowResponse, error)
+		UpdateWorkflowExecution(ctx context.Context, request *types.HistoryUpdateWorkflowExecutionRequest) (*types.HistoryUpdateWorkflowExecutionResponse, error)
+		MigrateWorkflowExecution(ctx context.Context, request *types.HistoryMigrateWorkflowExecutionRequest) (*types.MigrateWorkflowExecutionResponse, error)
 		ReapplyEvents(ctx context.Context, domainUUID string, workflowID string, runID string, events []*types.HistoryEvent) error
 		ReadDLQMessages(ctx context.Context, messagesRequest *types.ReadDLQMessagesRequest) (*types.ReadDLQMessagesResponse, error)
 		PurgeDLQMessages(ctx context.Context, messagesRequest *types.PurgeDLQMessagesRequest) error
 		MergeDLQMessages(ctx context.Context, messagesRequest *types.MergeDLQMessagesRequest) (*types.MergeDLQMessagesResponse, error)
+		ReadHistoryTaskDLQ(ctx context.Context, request *types.ReadHistoryTaskDLQRequest) (*types.ReadHistoryTaskDLQResponse, error)
+		PurgeHistoryTaskDLQ(ctx context.Context, request *types.PurgeHistoryTaskDLQRequest) error
+		RetryHistoryTaskDLQ(ctx context.Context, request *types.RetryHistoryTaskDLQRequest) (*types.RetryHistoryTaskDLQResponse, error)
 		RefreshWorkflowTasks(ctx context.Context, domainUUID string, execution types.WorkflowExecution) error
 		ResetTransferQueue(ctx context.Context, clusterName string) error
 		ResetTimerQueue(ctx context.Context, clusterName string) error
@@ -77,6 +88,7 @@ type (
 		DescribeTransferQueue(ctx context.Context, clusterName string) (*types.DescribeQueueResponse, error)
 		DescribeTimerQueue(ctx context.Context, clusterName string) (*types.DescribeQueueResponse, error)
 		DescribeCrossClusterQueue(ctx context.Context, clusterName string) (*types.DescribeQueueResponse, error)
+		DescribeReplicationStatus(ctx context.Context, clusterName string) ([]*types.ReplicationShardStatus, error)
 
 		NotifyNewHistoryEvent(event *events.Notification)
 		NotifyNewTransferTasks(executionInfo *persistence.WorkflowExecutionInfo, tasks []persistence.Task)
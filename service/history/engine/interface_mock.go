@@ -21,7 +21,7 @@
 // SOFTWARE.
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: interface.go
+// Source: service/history/engine/interface.go
 
 // Package engine is a generated GoMock package.
 package engine
@@ -304,6 +304,90 @@ func (mr *MockEngineMockRecorder) RequestCancelWorkflowExecution(ctx, request in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).RequestCancelWorkflowExecution), ctx, request)
 }
 
+// PauseWorkflowExecution mocks base method
+func (m *MockEngine) PauseWorkflowExecution(ctx context.Context, request *types.HistoryPauseWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution
+func (mr *MockEngineMockRecorder) PauseWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).PauseWorkflowExecution), ctx, request)
+}
+
+// UnpauseWorkflowExecution mocks base method
+func (m *MockEngine) UnpauseWorkflowExecution(ctx context.Context, request *types.HistoryUnpauseWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution
+func (mr *MockEngineMockRecorder) UnpauseWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).UnpauseWorkflowExecution), ctx, request)
+}
+
+// DeleteWorkflowExecution mocks base method
+func (m *MockEngine) DeleteWorkflowExecution(ctx context.Context, request *types.HistoryDeleteWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution
+func (mr *MockEngineMockRecorder) DeleteWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).DeleteWorkflowExecution), ctx, request)
+}
+
+// PauseActivity mocks base method
+func (m *MockEngine) PauseActivity(ctx context.Context, request *types.HistoryPauseActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivity", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseActivity indicates an expected call of PauseActivity
+func (mr *MockEngineMockRecorder) PauseActivity(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockEngine)(nil).PauseActivity), ctx, request)
+}
+
+// UnpauseActivity mocks base method
+func (m *MockEngine) UnpauseActivity(ctx context.Context, request *types.HistoryUnpauseActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivity", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity
+func (mr *MockEngineMockRecorder) UnpauseActivity(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockEngine)(nil).UnpauseActivity), ctx, request)
+}
+
+// ResetActivity mocks base method
+func (m *MockEngine) ResetActivity(ctx context.Context, request *types.HistoryResetActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivity", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivity indicates an expected call of ResetActivity
+func (mr *MockEngineMockRecorder) ResetActivity(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivity", reflect.TypeOf((*MockEngine)(nil).ResetActivity), ctx, request)
+}
+
 // SignalWorkflowExecution mocks base method
 func (m *MockEngine) SignalWorkflowExecution(ctx context.Context, request *types.HistorySignalWorkflowExecutionRequest) error {
 	m.ctrl.T.Helper()
@@ -520,6 +604,36 @@ func (mr *MockEngineMockRecorder) QueryWorkflow(ctx, request interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockEngine)(nil).QueryWorkflow), ctx, request)
 }
 
+// UpdateWorkflowExecution mocks base method
+func (m *MockEngine) UpdateWorkflowExecution(ctx context.Context, request *types.HistoryUpdateWorkflowExecutionRequest) (*types.HistoryUpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*types.HistoryUpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateWorkflowExecution mocks base method
+func (m *MockEngine) MigrateWorkflowExecution(ctx context.Context, request *types.HistoryMigrateWorkflowExecutionRequest) (*types.MigrateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*types.MigrateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution
+func (mr *MockEngineMockRecorder) UpdateWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).UpdateWorkflowExecution), ctx, request)
+}
+
+// MigrateWorkflowExecution indicates an expected call of MigrateWorkflowExecution
+func (mr *MockEngineMockRecorder) MigrateWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).MigrateWorkflowExecution), ctx, request)
+}
+
 // ReapplyEvents mocks base method
 func (m *MockEngine) ReapplyEvents(ctx context.Context, domainUUID, workflowID, runID string, events []*types.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -578,6 +692,50 @@ func (mr *MockEngineMockRecorder) MergeDLQMessages(ctx, messagesRequest interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockEngine)(nil).MergeDLQMessages), ctx, messagesRequest)
 }
 
+// ReadHistoryTaskDLQ mocks base method
+func (m *MockEngine) ReadHistoryTaskDLQ(ctx context.Context, request *types.ReadHistoryTaskDLQRequest) (*types.ReadHistoryTaskDLQResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadHistoryTaskDLQ", ctx, request)
+	ret0, _ := ret[0].(*types.ReadHistoryTaskDLQResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadHistoryTaskDLQ indicates an expected call of ReadHistoryTaskDLQ
+func (mr *MockEngineMockRecorder) ReadHistoryTaskDLQ(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadHistoryTaskDLQ", reflect.TypeOf((*MockEngine)(nil).ReadHistoryTaskDLQ), ctx, request)
+}
+
+// PurgeHistoryTaskDLQ mocks base method
+func (m *MockEngine) PurgeHistoryTaskDLQ(ctx context.Context, request *types.PurgeHistoryTaskDLQRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeHistoryTaskDLQ", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeHistoryTaskDLQ indicates an expected call of PurgeHistoryTaskDLQ
+func (mr *MockEngineMockRecorder) PurgeHistoryTaskDLQ(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeHistoryTaskDLQ", reflect.TypeOf((*MockEngine)(nil).PurgeHistoryTaskDLQ), ctx, request)
+}
+
+// RetryHistoryTaskDLQ mocks base method
+func (m *MockEngine) RetryHistoryTaskDLQ(ctx context.Context, request *types.RetryHistoryTaskDLQRequest) (*types.RetryHistoryTaskDLQResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryHistoryTaskDLQ", ctx, request)
+	ret0, _ := ret[0].(*types.RetryHistoryTaskDLQResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryHistoryTaskDLQ indicates an expected call of RetryHistoryTaskDLQ
+func (mr *MockEngineMockRecorder) RetryHistoryTaskDLQ(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryHistoryTaskDLQ", reflect.TypeOf((*MockEngine)(nil).RetryHistoryTaskDLQ), ctx, request)
+}
+
 // RefreshWorkflowTasks mocks base method
 func (m *MockEngine) RefreshWorkflowTasks(ctx context.Context, domainUUID string, execution types.WorkflowExecution) error {
 	m.ctrl.T.Helper()
@@ -679,6 +837,21 @@ func (mr *MockEngineMockRecorder) DescribeCrossClusterQueue(ctx, clusterName int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCrossClusterQueue", reflect.TypeOf((*MockEngine)(nil).DescribeCrossClusterQueue), ctx, clusterName)
 }
 
+// DescribeReplicationStatus mocks base method
+func (m *MockEngine) DescribeReplicationStatus(ctx context.Context, clusterName string) ([]*types.ReplicationShardStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeReplicationStatus", ctx, clusterName)
+	ret0, _ := ret[0].([]*types.ReplicationShardStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplicationStatus indicates an expected call of DescribeReplicationStatus
+func (mr *MockEngineMockRecorder) DescribeReplicationStatus(ctx, clusterName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplicationStatus", reflect.TypeOf((*MockEngine)(nil).DescribeReplicationStatus), ctx, clusterName)
+}
+
 // NotifyNewHistoryEvent mocks base method
 func (m *MockEngine) NotifyNewHistoryEvent(event *events.Notification) {
 	m.ctrl.T.Helper()
@@ -365,6 +365,38 @@ func (b *HistoryBuilder) AddWorkflowExecutionCancelRequestedEvent(cause string,
 	return b.addEventToHistory(event)
 }
 
+// AddWorkflowExecutionPausedEvent adds WorkflowExecutionPaused event to history
+func (b *HistoryBuilder) AddWorkflowExecutionPausedEvent(
+	request *types.PauseWorkflowExecutionRequest) *types.HistoryEvent {
+	event := b.newWorkflowExecutionPausedEvent(request)
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionUnpausedEvent adds WorkflowExecutionUnpaused event to history
+func (b *HistoryBuilder) AddWorkflowExecutionUnpausedEvent(
+	request *types.UnpauseWorkflowExecutionRequest) *types.HistoryEvent {
+	event := b.newWorkflowExecutionUnpausedEvent(request)
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionUpdateAcceptedEvent adds WorkflowExecutionUpdateAccepted event to history
+func (b *HistoryBuilder) AddWorkflowExecutionUpdateAcceptedEvent(updateID string,
+	request *types.UpdateWorkflowExecutionRequest) *types.HistoryEvent {
+	event := b.newWorkflowExecutionUpdateAcceptedEvent(updateID, request)
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionUpdateCompletedEvent adds WorkflowExecutionUpdateCompleted event to history
+func (b *HistoryBuilder) AddWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID int64,
+	attributes *types.CompleteWorkflowUpdateDecisionAttributes) *types.HistoryEvent {
+	event := b.newWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID, attributes)
+
+	return b.addEventToHistory(event)
+}
+
 // AddWorkflowExecutionCanceledEvent adds WorkflowExecutionCanceled event to history
 func (b *HistoryBuilder) AddWorkflowExecutionCanceledEvent(DecisionTaskCompletedEventID int64,
 	attributes *types.CancelWorkflowExecutionDecisionAttributes) *types.HistoryEvent {
@@ -416,6 +448,15 @@ func (b *HistoryBuilder) AddUpsertWorkflowSearchAttributesEvent(
 	return b.addEventToHistory(event)
 }
 
+// AddUpsertWorkflowMemoEvent adds UpsertWorkflowMemo event to history
+func (b *HistoryBuilder) AddUpsertWorkflowMemoEvent(
+	DecisionTaskCompletedEventID int64,
+	attributes *types.UpsertWorkflowMemoDecisionAttributes) *types.HistoryEvent {
+	event := b.newUpsertWorkflowMemoEvent(DecisionTaskCompletedEventID, attributes)
+
+	return b.addEventToHistory(event)
+}
+
 // AddSignalExternalWorkflowExecutionFailedEvent adds SignalExternalWorkflowExecutionFailed event to history
 func (b *HistoryBuilder) AddSignalExternalWorkflowExecutionFailedEvent(DecisionTaskCompletedEventID, initiatedEventID int64,
 	domain, workflowID, runID string, control []byte, cause types.SignalExternalWorkflowExecutionFailedCause) *types.HistoryEvent {
@@ -573,6 +614,7 @@ func (b *HistoryBuilder) newWorkflowExecutionStartedEvent(
 		OriginalExecutionRunID:              originalRunID,
 		Memo:                                request.Memo,
 		SearchAttributes:                    request.SearchAttributes,
+		RestartedFromRunID:                  startRequest.RestartedFromRunID,
 	}
 	if parentInfo := startRequest.ParentExecutionInfo; parentInfo != nil {
 		attributes.ParentWorkflowDomainID = &parentInfo.DomainUUID
@@ -838,6 +880,57 @@ func (b *HistoryBuilder) newWorkflowExecutionCancelRequestedEvent(cause string,
 	return event
 }
 
+func (b *HistoryBuilder) newWorkflowExecutionPausedEvent(
+	request *types.PauseWorkflowExecutionRequest) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionPaused)
+	attributes := &types.WorkflowExecutionPausedEventAttributes{}
+	attributes.Reason = request.Reason
+	attributes.Identity = request.Identity
+	attributes.RequestID = request.RequestID
+	event.WorkflowExecutionPausedEventAttributes = attributes
+
+	return event
+}
+
+func (b *HistoryBuilder) newWorkflowExecutionUnpausedEvent(
+	request *types.UnpauseWorkflowExecutionRequest) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionUnpaused)
+	attributes := &types.WorkflowExecutionUnpausedEventAttributes{}
+	attributes.Reason = request.Reason
+	attributes.Identity = request.Identity
+	attributes.RequestID = request.RequestID
+	event.WorkflowExecutionUnpausedEventAttributes = attributes
+
+	return event
+}
+
+func (b *HistoryBuilder) newWorkflowExecutionUpdateAcceptedEvent(updateID string,
+	request *types.UpdateWorkflowExecutionRequest) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionUpdateAccepted)
+	attributes := &types.WorkflowExecutionUpdateAcceptedEventAttributes{}
+	attributes.UpdateID = updateID
+	attributes.UpdateName = request.UpdateName
+	attributes.Input = request.Input
+	attributes.Identity = request.Identity
+	attributes.RequestID = request.RequestID
+	event.WorkflowExecutionUpdateAcceptedEventAttributes = attributes
+
+	return event
+}
+
+func (b *HistoryBuilder) newWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID int64,
+	request *types.CompleteWorkflowUpdateDecisionAttributes) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionUpdateCompleted)
+	attributes := &types.WorkflowExecutionUpdateCompletedEventAttributes{}
+	attributes.DecisionTaskCompletedEventID = decisionCompletedEventID
+	attributes.UpdateID = request.UpdateID
+	attributes.Result = request.Result
+	attributes.ErrorMessage = request.ErrorMessage
+	event.WorkflowExecutionUpdateCompletedEventAttributes = attributes
+
+	return event
+}
+
 func (b *HistoryBuilder) newWorkflowExecutionCanceledEvent(DecisionTaskCompletedEventID int64,
 	request *types.CancelWorkflowExecutionDecisionAttributes) *types.HistoryEvent {
 	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionCanceled)
@@ -928,6 +1021,17 @@ func (b *HistoryBuilder) newUpsertWorkflowSearchAttributesEvent(decisionTaskComp
 	return event
 }
 
+func (b *HistoryBuilder) newUpsertWorkflowMemoEvent(decisionTaskCompletedEventID int64,
+	request *types.UpsertWorkflowMemoDecisionAttributes) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeUpsertWorkflowMemo)
+	attributes := &types.UpsertWorkflowMemoEventAttributes{}
+	attributes.DecisionTaskCompletedEventID = decisionTaskCompletedEventID
+	attributes.Memo = request.GetMemo()
+	event.UpsertWorkflowMemoEventAttributes = attributes
+
+	return event
+}
+
 func (b *HistoryBuilder) newSignalExternalWorkflowExecutionFailedEvent(decisionTaskCompletedEventID, initiatedEventID int64,
 	domain, workflowID, runID string, control []byte, cause types.SignalExternalWorkflowExecutionFailedCause) *types.HistoryEvent {
 	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeSignalExternalWorkflowExecutionFailed)
@@ -31,6 +31,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/query"
+	"github.com/uber/cadence/service/history/update"
 )
 
 type (
@@ -99,7 +100,12 @@ type (
 		AddTimerFiredEvent(string) (*types.HistoryEvent, error)
 		AddTimerStartedEvent(int64, *types.StartTimerDecisionAttributes) (*types.HistoryEvent, *persistence.TimerInfo, error)
 		AddUpsertWorkflowSearchAttributesEvent(int64, *types.UpsertWorkflowSearchAttributesDecisionAttributes) (*types.HistoryEvent, error)
+		AddUpsertWorkflowMemoEvent(int64, *types.UpsertWorkflowMemoDecisionAttributes) (*types.HistoryEvent, error)
 		AddWorkflowExecutionCancelRequestedEvent(string, *types.HistoryRequestCancelWorkflowExecutionRequest) (*types.HistoryEvent, error)
+		AddWorkflowExecutionPausedEvent(*types.PauseWorkflowExecutionRequest) (*types.HistoryEvent, error)
+		AddWorkflowExecutionUnpausedEvent(*types.UnpauseWorkflowExecutionRequest) (*types.HistoryEvent, error)
+		AddWorkflowExecutionUpdateAcceptedEvent(string, *types.UpdateWorkflowExecutionRequest) (*types.HistoryEvent, error)
+		AddWorkflowExecutionUpdateCompletedEvent(int64, *types.CompleteWorkflowUpdateDecisionAttributes) (*types.HistoryEvent, error)
 		AddWorkflowExecutionCanceledEvent(int64, *types.CancelWorkflowExecutionDecisionAttributes) (*types.HistoryEvent, error)
 		AddWorkflowExecutionSignaled(signalName string, input []byte, identity string) (*types.HistoryEvent, error)
 		AddWorkflowExecutionStartedEvent(types.WorkflowExecution, *types.HistoryStartWorkflowExecutionRequest) (*types.HistoryEvent, error)
@@ -108,6 +114,9 @@ type (
 		CheckResettable() error
 		CopyToPersistence() *persistence.WorkflowMutableState
 		RetryActivity(ai *persistence.ActivityInfo, failureReason string, failureDetails []byte) (bool, error)
+		PauseActivity(ai *persistence.ActivityInfo) error
+		UnpauseActivity(ai *persistence.ActivityInfo) error
+		ResetActivity(ai *persistence.ActivityInfo, retryNow bool) error
 		CreateNewHistoryEvent(eventType types.EventType) *types.HistoryEvent
 		CreateNewHistoryEventWithTimestamp(eventType types.EventType, timestamp int64) *types.HistoryEvent
 		CreateTransientDecisionEvents(di *DecisionInfo, identity string) (*types.HistoryEvent, *types.HistoryEvent)
@@ -115,6 +124,7 @@ type (
 		DeleteSignalRequested(requestID string)
 		FailDecision(bool)
 		FlushBufferedEvents() error
+		GetAcceptedUpdate(updateID string) (int64, bool)
 		GetActivityByActivityID(string) (*persistence.ActivityInfo, bool)
 		GetActivityInfo(int64) (*persistence.ActivityInfo, bool)
 		GetActivityScheduledEvent(context.Context, int64) (*types.HistoryEvent, error)
@@ -152,12 +162,15 @@ type (
 		GetWorkflowStateCloseStatus() (int, int)
 		GetQueryRegistry() query.Registry
 		SetQueryRegistry(query.Registry)
+		GetUpdateRegistry() update.Registry
+		SetUpdateRegistry(update.Registry)
 		HasBufferedEvents() bool
 		HasInFlightDecision() bool
 		HasParentExecution() bool
 		HasPendingDecision() bool
 		HasProcessedOrPendingDecision() bool
 		IsCancelRequested() (bool, string)
+		IsWorkflowPaused() bool
 		IsCurrentWorkflowGuaranteed() bool
 		IsSignalRequested(requestID string) bool
 		IsStickyTaskListEnabled() bool
@@ -197,7 +210,12 @@ type (
 		ReplicateTimerStartedEvent(*types.HistoryEvent) (*persistence.TimerInfo, error)
 		ReplicateTransientDecisionTaskScheduled() (*DecisionInfo, error)
 		ReplicateUpsertWorkflowSearchAttributesEvent(*types.HistoryEvent)
+		ReplicateUpsertWorkflowMemoEvent(*types.HistoryEvent)
 		ReplicateWorkflowExecutionCancelRequestedEvent(*types.HistoryEvent) error
+		ReplicateWorkflowExecutionPausedEvent(*types.HistoryEvent) error
+		ReplicateWorkflowExecutionUnpausedEvent(*types.HistoryEvent) error
+		ReplicateWorkflowExecutionUpdateAcceptedEvent(*types.HistoryEvent) error
+		ReplicateWorkflowExecutionUpdateCompletedEvent(int64, *types.HistoryEvent) error
 		ReplicateWorkflowExecutionCanceledEvent(int64, *types.HistoryEvent) error
 		ReplicateWorkflowExecutionCompletedEvent(int64, *types.HistoryEvent) error
 		ReplicateWorkflowExecutionContinuedAsNewEvent(int64, string, *types.HistoryEvent) error
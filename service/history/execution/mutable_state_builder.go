@@ -26,6 +26,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"sort"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -47,6 +48,7 @@ import (
 	"github.com/uber/cadence/service/history/events"
 	"github.com/uber/cadence/service/history/query"
 	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/update"
 )
 
 const (
@@ -151,6 +153,7 @@ type (
 		taskGenerator       MutableStateTaskGenerator
 		decisionTaskManager mutableStateDecisionTaskManager
 		queryRegistry       query.Registry
+		updateRegistry      update.Registry
 
 		shard           shard.Context
 		clusterMetadata cluster.Metadata
@@ -213,7 +216,8 @@ func newMutableStateBuilder(
 		domainEntry:           domainEntry,
 		appliedEvents:         make(map[string]struct{}),
 
-		queryRegistry: query.NewRegistry(),
+		queryRegistry:  query.NewRegistry(),
+		updateRegistry: update.NewRegistry(),
 
 		shard:           shard,
 		clusterMetadata: shard.GetClusterMetadata(),
@@ -882,7 +886,9 @@ func (e *mutableStateBuilder) shouldBufferEvent(
 		types.EventTypeMarkerRecorded,
 		types.EventTypeStartChildWorkflowExecutionInitiated,
 		types.EventTypeSignalExternalWorkflowExecutionInitiated,
-		types.EventTypeUpsertWorkflowSearchAttributes:
+		types.EventTypeUpsertWorkflowSearchAttributes,
+		types.EventTypeUpsertWorkflowMemo,
+		types.EventTypeWorkflowExecutionUpdateCompleted:
 		// do not buffer event if event is directly generated from a corresponding decision
 
 		// sanity check there is no decision on the fly
@@ -912,6 +918,14 @@ func (e *mutableStateBuilder) SetQueryRegistry(queryRegistry query.Registry) {
 	e.queryRegistry = queryRegistry
 }
 
+func (e *mutableStateBuilder) GetUpdateRegistry() update.Registry {
+	return e.updateRegistry
+}
+
+func (e *mutableStateBuilder) SetUpdateRegistry(updateRegistry update.Registry) {
+	e.updateRegistry = updateRegistry
+}
+
 func (e *mutableStateBuilder) GetActivityScheduledEvent(
 	ctx context.Context,
 	scheduleEventID int64,
@@ -1309,6 +1323,7 @@ func (e *mutableStateBuilder) ReplicateActivityInfo(
 	ai.LastFailureReason = request.GetLastFailureReason()
 	ai.LastWorkerIdentity = request.GetLastWorkerIdentity()
 	ai.LastFailureDetails = request.GetLastFailureDetails()
+	ai.Paused = request.GetPaused()
 
 	if resetActivityTimerTaskStatus {
 		ai.TimerTaskStatus = TimerTaskStatusNone
@@ -1597,14 +1612,23 @@ func (e *mutableStateBuilder) IsCancelRequested() (bool, string) {
 	return false, ""
 }
 
+func (e *mutableStateBuilder) IsWorkflowPaused() bool {
+	return e.executionInfo.Paused
+}
+
 func (e *mutableStateBuilder) IsSignalRequested(
 	requestID string,
 ) bool {
 
-	if _, ok := e.pendingSignalRequestedIDs[requestID]; ok {
+	if _, ok := e.pendingSignalRequestedIDs[requestID]; !ok {
+		return false
+	}
+	requestedTimestamp, ok := e.executionInfo.SignalRequestedTimestamps[requestID]
+	if !ok {
+		// request IDs recorded before timestamps were tracked never expire
 		return true
 	}
-	return false
+	return !e.isSignalRequestExpired(requestedTimestamp)
 }
 
 func (e *mutableStateBuilder) AddSignalRequested(
@@ -1617,8 +1641,13 @@ func (e *mutableStateBuilder) AddSignalRequested(
 	if e.updateSignalRequestedIDs == nil {
 		e.updateSignalRequestedIDs = make(map[string]struct{})
 	}
+	if e.executionInfo.SignalRequestedTimestamps == nil {
+		e.executionInfo.SignalRequestedTimestamps = make(map[string]int64)
+	}
 	e.pendingSignalRequestedIDs[requestID] = struct{}{} // add requestID to set
 	e.updateSignalRequestedIDs[requestID] = struct{}{}
+	e.executionInfo.SignalRequestedTimestamps[requestID] = e.timeSource.Now().UnixNano()
+	e.trimSignalRequested()
 }
 
 func (e *mutableStateBuilder) DeleteSignalRequested(
@@ -1627,9 +1656,43 @@ func (e *mutableStateBuilder) DeleteSignalRequested(
 
 	delete(e.pendingSignalRequestedIDs, requestID)
 	delete(e.updateSignalRequestedIDs, requestID)
+	delete(e.executionInfo.SignalRequestedTimestamps, requestID)
 	e.deleteSignalRequestedIDs[requestID] = struct{}{}
 }
 
+func (e *mutableStateBuilder) isSignalRequestExpired(
+	requestedTimestamp int64,
+) bool {
+
+	window := e.config.SignalRequestIDDedupWindow(e.GetDomainEntry().GetInfo().Name)
+	return e.timeSource.Now().After(time.Unix(0, requestedTimestamp).Add(window))
+}
+
+// trimSignalRequested drops signal request IDs which are out of the dedup window,
+// then the oldest ones until the per execution limit is met
+func (e *mutableStateBuilder) trimSignalRequested() {
+
+	var requestIDs []string
+	for requestID, requestedTimestamp := range e.executionInfo.SignalRequestedTimestamps {
+		if e.isSignalRequestExpired(requestedTimestamp) {
+			e.DeleteSignalRequested(requestID)
+			continue
+		}
+		requestIDs = append(requestIDs, requestID)
+	}
+
+	maxRequestIDs := e.config.MaximumSignalRequestIDsPerExecution(e.GetDomainEntry().GetInfo().Name)
+	if len(requestIDs) <= maxRequestIDs {
+		return
+	}
+	sort.Slice(requestIDs, func(i, j int) bool {
+		return e.executionInfo.SignalRequestedTimestamps[requestIDs[i]] < e.executionInfo.SignalRequestedTimestamps[requestIDs[j]]
+	})
+	for _, requestID := range requestIDs[:len(requestIDs)-maxRequestIDs] {
+		e.DeleteSignalRequested(requestID)
+	}
+}
+
 func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 	parentExecutionInfo *types.ParentExecutionInfo,
 	execution types.WorkflowExecution,
@@ -2737,6 +2800,149 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionCancelRequestedEvent(
 	return nil
 }
 
+func (e *mutableStateBuilder) AddWorkflowExecutionPausedEvent(
+	request *types.PauseWorkflowExecutionRequest,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowPaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if e.executionInfo.Paused {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.WorkflowState(e.executionInfo.State),
+			tag.Bool(e.executionInfo.Paused),
+		)
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionPausedEvent(request)
+	if err := e.ReplicateWorkflowExecutionPausedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionPausedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	e.executionInfo.Paused = true
+	return nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUnpausedEvent(
+	request *types.UnpauseWorkflowExecutionRequest,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUnpaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if !e.executionInfo.Paused {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.WorkflowState(e.executionInfo.State),
+			tag.Bool(e.executionInfo.Paused),
+		)
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUnpausedEvent(request)
+	if err := e.ReplicateWorkflowExecutionUnpausedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUnpausedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	e.executionInfo.Paused = false
+	return nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateAcceptedEvent(
+	updateID string,
+	request *types.UpdateWorkflowExecutionRequest,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUpdateAccepted
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUpdateAcceptedEvent(updateID, request)
+	if err := e.ReplicateWorkflowExecutionUpdateAcceptedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUpdateAcceptedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	if e.executionInfo.AcceptedUpdates == nil {
+		e.executionInfo.AcceptedUpdates = make(map[string]int64)
+	}
+	e.executionInfo.AcceptedUpdates[event.WorkflowExecutionUpdateAcceptedEventAttributes.GetUpdateID()] = common.EmptyEventID
+	return nil
+}
+
+func (e *mutableStateBuilder) GetAcceptedUpdate(
+	updateID string,
+) (int64, bool) {
+
+	completedEventBatchID, ok := e.executionInfo.AcceptedUpdates[updateID]
+	return completedEventBatchID, ok
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateCompletedEvent(
+	decisionCompletedEventID int64,
+	attributes *types.CompleteWorkflowUpdateDecisionAttributes,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUpdateCompleted
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	updateID := attributes.GetUpdateID()
+	completedEventBatchID, ok := e.GetAcceptedUpdate(updateID)
+	if !ok {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Update %v has not been accepted.", updateID)}
+	}
+	if completedEventBatchID != common.EmptyEventID {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Update %v has already been completed.", updateID)}
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID, attributes)
+	// the completed event is written in the batch started by the decision task completed event
+	if err := e.ReplicateWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUpdateCompletedEvent(
+	firstEventID int64,
+	event *types.HistoryEvent,
+) error {
+
+	if e.executionInfo.AcceptedUpdates == nil {
+		e.executionInfo.AcceptedUpdates = make(map[string]int64)
+	}
+	e.executionInfo.AcceptedUpdates[event.WorkflowExecutionUpdateCompletedEventAttributes.GetUpdateID()] = firstEventID
+	return nil
+}
+
 func (e *mutableStateBuilder) AddWorkflowExecutionCanceledEvent(
 	decisionTaskCompletedEventID int64,
 	attributes *types.CancelWorkflowExecutionDecisionAttributes,
@@ -2992,6 +3198,55 @@ func mergeMapOfByteArray(
 	return current
 }
 
+func (e *mutableStateBuilder) AddUpsertWorkflowMemoEvent(
+	decisionCompletedEventID int64,
+	request *types.UpsertWorkflowMemoDecisionAttributes,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionUpsertWorkflowMemo
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddUpsertWorkflowMemoEvent(decisionCompletedEventID, request)
+	e.ReplicateUpsertWorkflowMemoEvent(event)
+	// memo is sent to visibility together with search attributes
+	if err := e.taskGenerator.GenerateWorkflowSearchAttrTasks(); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateUpsertWorkflowMemoEvent(
+	event *types.HistoryEvent,
+) {
+
+	upsertMemo := event.UpsertWorkflowMemoEventAttributes.GetMemo().GetFields()
+	currentMemo := e.GetExecutionInfo().Memo
+
+	e.executionInfo.Memo = mergeMemo(currentMemo, upsertMemo)
+}
+
+// mergeMemo merges upserted fields into the current memo,
+// a field with empty value removes the key from memo
+func mergeMemo(
+	current map[string][]byte,
+	upsert map[string][]byte,
+) map[string][]byte {
+
+	if current == nil {
+		current = make(map[string][]byte)
+	}
+	for k, v := range upsert {
+		if len(v) == 0 {
+			delete(current, k)
+			continue
+		}
+		current[k] = v
+	}
+	return current
+}
+
 func (e *mutableStateBuilder) AddExternalWorkflowExecutionSignaled(
 	initiatedID int64,
 	domain string,
@@ -3836,6 +4091,89 @@ func (e *mutableStateBuilder) RetryActivity(
 	return true, nil
 }
 
+// PauseActivity suspends dispatch and retries of a pending activity, an attempt which is
+// already running is not interrupted
+func (e *mutableStateBuilder) PauseActivity(
+	ai *persistence.ActivityInfo,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskPaused
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	ai.Paused = true
+
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return nil
+}
+
+// UnpauseActivity resumes a paused activity, dispatching it immediately if no attempt is running
+func (e *mutableStateBuilder) UnpauseActivity(
+	ai *persistence.ActivityInfo,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskUnpaused
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	ai.Paused = false
+
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+
+	if ai.StartedID != common.EmptyEventID {
+		return nil
+	}
+	return e.scheduleActivityAttemptNow(ai)
+}
+
+// ResetActivity resets the attempt count and heartbeat details of a pending activity,
+// if retryNow is set an activity waiting for its next attempt is dispatched immediately
+func (e *mutableStateBuilder) ResetActivity(
+	ai *persistence.ActivityInfo,
+	retryNow bool,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskReset
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	ai.Attempt = 0
+	ai.Details = nil
+	// the heartbeat is restarted, which also lets the standby tell the reset apart from an
+	// out of order sync activity task of an earlier attempt
+	ai.LastHeartBeatUpdatedTime = e.timeSource.Now()
+
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+
+	if !retryNow || ai.Paused || ai.StartedID != common.EmptyEventID {
+		return nil
+	}
+	return e.scheduleActivityAttemptNow(ai)
+}
+
+func (e *mutableStateBuilder) scheduleActivityAttemptNow(
+	ai *persistence.ActivityInfo,
+) error {
+
+	// timeouts of the pending attempt are counted from now on, the activity retry
+	// timer task created below is fired immediately and dispatches the activity
+	ai.ScheduledTime = e.timeSource.Now()
+	ai.TimerTaskStatus = TimerTaskStatusNone
+
+	return e.taskGenerator.GenerateActivityRetryTasks(
+		ai.ScheduleID,
+	)
+}
+
 // TODO mutable state should generate corresponding transfer / timer tasks according to
 //  updates accumulated, while currently all transfer / timer tasks are managed manually
 
@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
@@ -216,6 +217,8 @@ func (s *mutableStateSuite) TestShouldBufferEvent() {
 		types.EventTypeStartChildWorkflowExecutionInitiated:            true,
 		types.EventTypeSignalExternalWorkflowExecutionInitiated:        true,
 		types.EventTypeUpsertWorkflowSearchAttributes:                  true,
+		types.EventTypeUpsertWorkflowMemo:                              true,
+		types.EventTypeWorkflowExecutionUpdateCompleted:                true,
 	}
 
 	// other events will not be assign event ID immediately
@@ -523,6 +526,20 @@ func (s *mutableStateSuite) TestMergeMapOfByteArray() {
 	s.Equal(2, len(resultMap))
 }
 
+func (s *mutableStateSuite) TestMergeMemo() {
+	var currentMemo map[string][]byte
+	resultMemo := mergeMemo(currentMemo, nil)
+	s.Equal(make(map[string][]byte), resultMemo)
+
+	currentMemo = map[string][]byte{"key": []byte("val"), "number": []byte("1")}
+	resultMemo = mergeMemo(currentMemo, map[string][]byte{"key": []byte("new-val"), "other": []byte("2")})
+	s.Equal(map[string][]byte{"key": []byte("new-val"), "number": []byte("1"), "other": []byte("2")}, resultMemo)
+
+	// empty value removes the key
+	resultMemo = mergeMemo(resultMemo, map[string][]byte{"number": nil, "missing": {}})
+	s.Equal(map[string][]byte{"key": []byte("new-val"), "other": []byte("2")}, resultMemo)
+}
+
 func (s *mutableStateSuite) TestEventReapplied() {
 	runID := uuid.New()
 	eventID := int64(1)
@@ -535,6 +552,88 @@ func (s *mutableStateSuite) TestEventReapplied() {
 	s.True(isReapplied)
 }
 
+func (s *mutableStateSuite) TestSignalRequested_DedupWindow() {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	s.mockShard.Resource.TimeSource = timeSource
+	s.mockShard.GetConfig().SignalRequestIDDedupWindow = dynamicconfig.GetDurationPropertyFnFilteredByDomain(time.Hour)
+	s.msBuilder = newMutableStateBuilder(s.mockShard, s.logger, constants.TestLocalDomainEntry)
+
+	requestID := uuid.New()
+	s.False(s.msBuilder.IsSignalRequested(requestID))
+	s.msBuilder.AddSignalRequested(requestID)
+	s.True(s.msBuilder.IsSignalRequested(requestID))
+
+	timeSource.Update(timeSource.Now().Add(time.Hour + time.Second))
+	s.False(s.msBuilder.IsSignalRequested(requestID))
+
+	// expired request IDs are dropped when a new one is recorded
+	newRequestID := uuid.New()
+	s.msBuilder.AddSignalRequested(newRequestID)
+	s.True(s.msBuilder.IsSignalRequested(newRequestID))
+	s.NotContains(s.msBuilder.GetExecutionInfo().SignalRequestedTimestamps, requestID)
+	s.Contains(s.msBuilder.deleteSignalRequestedIDs, requestID)
+}
+
+func (s *mutableStateSuite) TestSignalRequested_MaximumRequestIDs() {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	s.mockShard.Resource.TimeSource = timeSource
+	s.mockShard.GetConfig().MaximumSignalRequestIDsPerExecution = dynamicconfig.GetIntPropertyFilteredByDomain(2)
+	s.msBuilder = newMutableStateBuilder(s.mockShard, s.logger, constants.TestLocalDomainEntry)
+
+	requestIDs := []string{uuid.New(), uuid.New(), uuid.New()}
+	for _, requestID := range requestIDs {
+		s.msBuilder.AddSignalRequested(requestID)
+		timeSource.Update(timeSource.Now().Add(time.Second))
+	}
+
+	s.False(s.msBuilder.IsSignalRequested(requestIDs[0]))
+	s.True(s.msBuilder.IsSignalRequested(requestIDs[1]))
+	s.True(s.msBuilder.IsSignalRequested(requestIDs[2]))
+	s.Len(s.msBuilder.GetExecutionInfo().SignalRequestedTimestamps, 2)
+}
+
+func (s *mutableStateSuite) TestSignalRequested_LegacyRequestIDNeverExpires() {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	s.mockShard.Resource.TimeSource = timeSource
+	s.msBuilder = newMutableStateBuilder(s.mockShard, s.logger, constants.TestLocalDomainEntry)
+
+	requestID := uuid.New()
+	s.msBuilder.pendingSignalRequestedIDs[requestID] = struct{}{}
+	timeSource.Update(timeSource.Now().Add(365 * 24 * time.Hour))
+	s.True(s.msBuilder.IsSignalRequested(requestID))
+}
+
+func (s *mutableStateSuite) TestWorkflowUpdate_AcceptedAndCompleted() {
+	s.msBuilder = newMutableStateBuilder(s.mockShard, s.logger, constants.TestLocalDomainEntry)
+	s.msBuilder.hBuilder = NewHistoryBuilder(s.msBuilder, s.logger)
+	s.msBuilder.executionInfo.NextEventID = 5
+	updateID := uuid.New()
+	completeAttributes := &types.CompleteWorkflowUpdateDecisionAttributes{
+		UpdateID: updateID,
+		Result:   []byte("result"),
+	}
+
+	_, err := s.msBuilder.AddWorkflowExecutionUpdateCompletedEvent(4, completeAttributes)
+	s.IsType(&types.BadRequestError{}, err)
+
+	_, err = s.msBuilder.AddWorkflowExecutionUpdateAcceptedEvent(updateID, &types.UpdateWorkflowExecutionRequest{UpdateName: "update"})
+	s.NoError(err)
+	completedEventBatchID, ok := s.msBuilder.GetAcceptedUpdate(updateID)
+	s.True(ok)
+	s.Equal(common.EmptyEventID, completedEventBatchID)
+	// accepted updates do not share the request ID set of signals
+	s.False(s.msBuilder.IsSignalRequested(updateID))
+
+	_, err = s.msBuilder.AddWorkflowExecutionUpdateCompletedEvent(4, completeAttributes)
+	s.NoError(err)
+	completedEventBatchID, ok = s.msBuilder.GetAcceptedUpdate(updateID)
+	s.True(ok)
+	s.Equal(int64(4), completedEventBatchID)
+
+	_, err = s.msBuilder.AddWorkflowExecutionUpdateCompletedEvent(4, completeAttributes)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
 func (s *mutableStateSuite) TestTransientDecisionTaskSchedule_CurrentVersionChanged() {
 	version := int64(2000)
 	runID := uuid.New()
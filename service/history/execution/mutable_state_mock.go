@@ -21,7 +21,7 @@
 // SOFTWARE.
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: mutable_state.go
+// Source: service/history/execution/mutable_state.go
 
 // Package execution is a generated GoMock package.
 package execution
@@ -38,6 +38,7 @@ import (
 	persistence "github.com/uber/cadence/common/persistence"
 	types "github.com/uber/cadence/common/types"
 	query "github.com/uber/cadence/service/history/query"
+	update "github.com/uber/cadence/service/history/update"
 )
 
 // MockMutableState is a mock of MutableState interface
@@ -698,6 +699,21 @@ func (mr *MockMutableStateMockRecorder) AddUpsertWorkflowSearchAttributesEvent(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUpsertWorkflowSearchAttributesEvent", reflect.TypeOf((*MockMutableState)(nil).AddUpsertWorkflowSearchAttributesEvent), arg0, arg1)
 }
 
+// AddUpsertWorkflowMemoEvent mocks base method
+func (m *MockMutableState) AddUpsertWorkflowMemoEvent(arg0 int64, arg1 *types.UpsertWorkflowMemoDecisionAttributes) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUpsertWorkflowMemoEvent", arg0, arg1)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddUpsertWorkflowMemoEvent indicates an expected call of AddUpsertWorkflowMemoEvent
+func (mr *MockMutableStateMockRecorder) AddUpsertWorkflowMemoEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUpsertWorkflowMemoEvent", reflect.TypeOf((*MockMutableState)(nil).AddUpsertWorkflowMemoEvent), arg0, arg1)
+}
+
 // AddWorkflowExecutionCancelRequestedEvent mocks base method
 func (m *MockMutableState) AddWorkflowExecutionCancelRequestedEvent(arg0 string, arg1 *types.HistoryRequestCancelWorkflowExecutionRequest) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -713,6 +729,66 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionCancelRequestedEvent
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionCancelRequestedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionCancelRequestedEvent), arg0, arg1)
 }
 
+// AddWorkflowExecutionPausedEvent mocks base method
+func (m *MockMutableState) AddWorkflowExecutionPausedEvent(arg0 *types.PauseWorkflowExecutionRequest) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionPausedEvent", arg0)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionPausedEvent indicates an expected call of AddWorkflowExecutionPausedEvent
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionPausedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionPausedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionPausedEvent), arg0)
+}
+
+// AddWorkflowExecutionUnpausedEvent mocks base method
+func (m *MockMutableState) AddWorkflowExecutionUnpausedEvent(arg0 *types.UnpauseWorkflowExecutionRequest) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionUnpausedEvent", arg0)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionUnpausedEvent indicates an expected call of AddWorkflowExecutionUnpausedEvent
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionUnpausedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionUnpausedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionUnpausedEvent), arg0)
+}
+
+// AddWorkflowExecutionUpdateAcceptedEvent mocks base method
+func (m *MockMutableState) AddWorkflowExecutionUpdateAcceptedEvent(arg0 string, arg1 *types.UpdateWorkflowExecutionRequest) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionUpdateAcceptedEvent", arg0, arg1)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionUpdateAcceptedEvent indicates an expected call of AddWorkflowExecutionUpdateAcceptedEvent
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionUpdateAcceptedEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionUpdateAcceptedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionUpdateAcceptedEvent), arg0, arg1)
+}
+
+// AddWorkflowExecutionUpdateCompletedEvent mocks base method
+func (m *MockMutableState) AddWorkflowExecutionUpdateCompletedEvent(arg0 int64, arg1 *types.CompleteWorkflowUpdateDecisionAttributes) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionUpdateCompletedEvent", arg0, arg1)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionUpdateCompletedEvent indicates an expected call of AddWorkflowExecutionUpdateCompletedEvent
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionUpdateCompletedEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionUpdateCompletedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionUpdateCompletedEvent), arg0, arg1)
+}
+
 // AddWorkflowExecutionCanceledEvent mocks base method
 func (m *MockMutableState) AddWorkflowExecutionCanceledEvent(arg0 int64, arg1 *types.CancelWorkflowExecutionDecisionAttributes) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -828,6 +904,48 @@ func (mr *MockMutableStateMockRecorder) RetryActivity(ai, failureReason, failure
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryActivity", reflect.TypeOf((*MockMutableState)(nil).RetryActivity), ai, failureReason, failureDetails)
 }
 
+// PauseActivity mocks base method
+func (m *MockMutableState) PauseActivity(ai *persistence.ActivityInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivity", ai)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseActivity indicates an expected call of PauseActivity
+func (mr *MockMutableStateMockRecorder) PauseActivity(ai interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockMutableState)(nil).PauseActivity), ai)
+}
+
+// UnpauseActivity mocks base method
+func (m *MockMutableState) UnpauseActivity(ai *persistence.ActivityInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivity", ai)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity
+func (mr *MockMutableStateMockRecorder) UnpauseActivity(ai interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockMutableState)(nil).UnpauseActivity), ai)
+}
+
+// ResetActivity mocks base method
+func (m *MockMutableState) ResetActivity(ai *persistence.ActivityInfo, retryNow bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivity", ai, retryNow)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivity indicates an expected call of ResetActivity
+func (mr *MockMutableStateMockRecorder) ResetActivity(ai, retryNow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivity", reflect.TypeOf((*MockMutableState)(nil).ResetActivity), ai, retryNow)
+}
+
 // CreateNewHistoryEvent mocks base method
 func (m *MockMutableState) CreateNewHistoryEvent(eventType types.EventType) *types.HistoryEvent {
 	m.ctrl.T.Helper()
@@ -921,6 +1039,21 @@ func (mr *MockMutableStateMockRecorder) FlushBufferedEvents() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushBufferedEvents", reflect.TypeOf((*MockMutableState)(nil).FlushBufferedEvents))
 }
 
+// GetAcceptedUpdate mocks base method
+func (m *MockMutableState) GetAcceptedUpdate(updateID string) (int64, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAcceptedUpdate", updateID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetAcceptedUpdate indicates an expected call of GetAcceptedUpdate
+func (mr *MockMutableStateMockRecorder) GetAcceptedUpdate(updateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAcceptedUpdate", reflect.TypeOf((*MockMutableState)(nil).GetAcceptedUpdate), updateID)
+}
+
 // GetActivityByActivityID mocks base method
 func (m *MockMutableState) GetActivityByActivityID(arg0 string) (*persistence.ActivityInfo, bool) {
 	m.ctrl.T.Helper()
@@ -1457,6 +1590,32 @@ func (mr *MockMutableStateMockRecorder) SetQueryRegistry(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQueryRegistry", reflect.TypeOf((*MockMutableState)(nil).SetQueryRegistry), arg0)
 }
 
+// GetUpdateRegistry mocks base method
+func (m *MockMutableState) GetUpdateRegistry() update.Registry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpdateRegistry")
+	ret0, _ := ret[0].(update.Registry)
+	return ret0
+}
+
+// GetUpdateRegistry indicates an expected call of GetUpdateRegistry
+func (mr *MockMutableStateMockRecorder) GetUpdateRegistry() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdateRegistry", reflect.TypeOf((*MockMutableState)(nil).GetUpdateRegistry))
+}
+
+// SetUpdateRegistry mocks base method
+func (m *MockMutableState) SetUpdateRegistry(arg0 update.Registry) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetUpdateRegistry", arg0)
+}
+
+// SetUpdateRegistry indicates an expected call of SetUpdateRegistry
+func (mr *MockMutableStateMockRecorder) SetUpdateRegistry(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUpdateRegistry", reflect.TypeOf((*MockMutableState)(nil).SetUpdateRegistry), arg0)
+}
+
 // HasBufferedEvents mocks base method
 func (m *MockMutableState) HasBufferedEvents() bool {
 	m.ctrl.T.Helper()
@@ -1542,6 +1701,20 @@ func (mr *MockMutableStateMockRecorder) IsCancelRequested() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCancelRequested", reflect.TypeOf((*MockMutableState)(nil).IsCancelRequested))
 }
 
+// IsWorkflowPaused mocks base method
+func (m *MockMutableState) IsWorkflowPaused() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsWorkflowPaused")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsWorkflowPaused indicates an expected call of IsWorkflowPaused
+func (mr *MockMutableStateMockRecorder) IsWorkflowPaused() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowPaused", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowPaused))
+}
+
 // IsCurrentWorkflowGuaranteed mocks base method
 func (m *MockMutableState) IsCurrentWorkflowGuaranteed() bool {
 	m.ctrl.T.Helper()
@@ -2090,6 +2263,18 @@ func (mr *MockMutableStateMockRecorder) ReplicateUpsertWorkflowSearchAttributesE
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateUpsertWorkflowSearchAttributesEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateUpsertWorkflowSearchAttributesEvent), arg0)
 }
 
+// ReplicateUpsertWorkflowMemoEvent mocks base method
+func (m *MockMutableState) ReplicateUpsertWorkflowMemoEvent(arg0 *types.HistoryEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReplicateUpsertWorkflowMemoEvent", arg0)
+}
+
+// ReplicateUpsertWorkflowMemoEvent indicates an expected call of ReplicateUpsertWorkflowMemoEvent
+func (mr *MockMutableStateMockRecorder) ReplicateUpsertWorkflowMemoEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateUpsertWorkflowMemoEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateUpsertWorkflowMemoEvent), arg0)
+}
+
 // ReplicateWorkflowExecutionCancelRequestedEvent mocks base method
 func (m *MockMutableState) ReplicateWorkflowExecutionCancelRequestedEvent(arg0 *types.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -2104,6 +2289,62 @@ func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionCancelRequeste
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionCancelRequestedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionCancelRequestedEvent), arg0)
 }
 
+// ReplicateWorkflowExecutionPausedEvent mocks base method
+func (m *MockMutableState) ReplicateWorkflowExecutionPausedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionPausedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionPausedEvent indicates an expected call of ReplicateWorkflowExecutionPausedEvent
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionPausedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionPausedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionPausedEvent), arg0)
+}
+
+// ReplicateWorkflowExecutionUnpausedEvent mocks base method
+func (m *MockMutableState) ReplicateWorkflowExecutionUnpausedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionUnpausedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionUnpausedEvent indicates an expected call of ReplicateWorkflowExecutionUnpausedEvent
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionUnpausedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionUnpausedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionUnpausedEvent), arg0)
+}
+
+// ReplicateWorkflowExecutionUpdateAcceptedEvent mocks base method
+func (m *MockMutableState) ReplicateWorkflowExecutionUpdateAcceptedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionUpdateAcceptedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionUpdateAcceptedEvent indicates an expected call of ReplicateWorkflowExecutionUpdateAcceptedEvent
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionUpdateAcceptedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionUpdateAcceptedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionUpdateAcceptedEvent), arg0)
+}
+
+// ReplicateWorkflowExecutionUpdateCompletedEvent mocks base method
+func (m *MockMutableState) ReplicateWorkflowExecutionUpdateCompletedEvent(arg0 int64, arg1 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionUpdateCompletedEvent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionUpdateCompletedEvent indicates an expected call of ReplicateWorkflowExecutionUpdateCompletedEvent
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionUpdateCompletedEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionUpdateCompletedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionUpdateCompletedEvent), arg0, arg1)
+}
+
 // ReplicateWorkflowExecutionCanceledEvent mocks base method
 func (m *MockMutableState) ReplicateWorkflowExecutionCanceledEvent(arg0 int64, arg1 *types.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -319,6 +319,21 @@ func CreatePersistenceMutableState(ms MutableState) *persistence.WorkflowMutable
 
 // CopyWorkflowExecutionInfo copies WorkflowExecutionInfo
 func CopyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *persistence.WorkflowExecutionInfo {
+	var signalRequestedTimestamps map[string]int64
+	if sourceInfo.SignalRequestedTimestamps != nil {
+		signalRequestedTimestamps = make(map[string]int64, len(sourceInfo.SignalRequestedTimestamps))
+		for requestID, requestedTimestamp := range sourceInfo.SignalRequestedTimestamps {
+			signalRequestedTimestamps[requestID] = requestedTimestamp
+		}
+	}
+	var acceptedUpdates map[string]int64
+	if sourceInfo.AcceptedUpdates != nil {
+		acceptedUpdates = make(map[string]int64, len(sourceInfo.AcceptedUpdates))
+		for updateID, completedEventBatchID := range sourceInfo.AcceptedUpdates {
+			acceptedUpdates[updateID] = completedEventBatchID
+		}
+	}
+
 	return &persistence.WorkflowExecutionInfo{
 		DomainID:                           sourceInfo.DomainID,
 		WorkflowID:                         sourceInfo.WorkflowID,
@@ -346,6 +361,8 @@ func CopyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		LastUpdatedTimestamp:               sourceInfo.LastUpdatedTimestamp,
 		CreateRequestID:                    sourceInfo.CreateRequestID,
 		SignalCount:                        sourceInfo.SignalCount,
+		SignalRequestedTimestamps:          signalRequestedTimestamps,
+		AcceptedUpdates:                    acceptedUpdates,
 		DecisionVersion:                    sourceInfo.DecisionVersion,
 		DecisionScheduleID:                 sourceInfo.DecisionScheduleID,
 		DecisionStartedID:                  sourceInfo.DecisionStartedID,
@@ -356,6 +373,7 @@ func CopyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		DecisionOriginalScheduledTimestamp: sourceInfo.DecisionOriginalScheduledTimestamp,
 		CancelRequested:                    sourceInfo.CancelRequested,
 		CancelRequestID:                    sourceInfo.CancelRequestID,
+		Paused:                             sourceInfo.Paused,
 		CronSchedule:                       sourceInfo.CronSchedule,
 		ClientLibraryVersion:               sourceInfo.ClientLibraryVersion,
 		ClientFeatureVersion:               sourceInfo.ClientFeatureVersion,
@@ -400,6 +418,7 @@ func CopyActivityInfo(sourceInfo *persistence.ActivityInfo) *persistence.Activit
 		LastHeartBeatUpdatedTime: sourceInfo.LastHeartBeatUpdatedTime,
 		CancelRequested:          sourceInfo.CancelRequested,
 		CancelRequestID:          sourceInfo.CancelRequestID,
+		Paused:                   sourceInfo.Paused,
 		TimerTaskStatus:          sourceInfo.TimerTaskStatus,
 		Attempt:                  sourceInfo.Attempt,
 		DomainID:                 sourceInfo.DomainID,
@@ -495,6 +495,21 @@ func (b *stateBuilderImpl) ApplyEvents(
 		case types.EventTypeMarkerRecorded:
 			// No mutable state action is needed
 
+		case types.EventTypeWorkflowExecutionUpdateAccepted:
+			if err := b.mutableState.ReplicateWorkflowExecutionUpdateAcceptedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeWorkflowExecutionUpdateCompleted:
+			if err := b.mutableState.ReplicateWorkflowExecutionUpdateCompletedEvent(
+				firstEvent.GetEventID(),
+				event,
+			); err != nil {
+				return nil, err
+			}
+
 		case types.EventTypeWorkflowExecutionSignaled:
 			if err := b.mutableState.ReplicateWorkflowExecutionSignaled(
 				event,
@@ -509,12 +524,32 @@ func (b *stateBuilderImpl) ApplyEvents(
 				return nil, err
 			}
 
+		case types.EventTypeWorkflowExecutionPaused:
+			if err := b.mutableState.ReplicateWorkflowExecutionPausedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeWorkflowExecutionUnpaused:
+			if err := b.mutableState.ReplicateWorkflowExecutionUnpausedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
 		case types.EventTypeUpsertWorkflowSearchAttributes:
 			b.mutableState.ReplicateUpsertWorkflowSearchAttributesEvent(event)
 			if err := taskGenerator.GenerateWorkflowSearchAttrTasks(); err != nil {
 				return nil, err
 			}
 
+		case types.EventTypeUpsertWorkflowMemo:
+			b.mutableState.ReplicateUpsertWorkflowMemoEvent(event)
+			if err := taskGenerator.GenerateWorkflowSearchAttrTasks(); err != nil {
+				return nil, err
+			}
+
 		case types.EventTypeWorkflowExecutionCompleted:
 			if err := b.mutableState.ReplicateWorkflowExecutionCompletedEvent(
 				firstEvent.GetEventID(),
@@ -611,6 +611,60 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionCancelRequ
 	s.Nil(err)
 }
 
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionPaused() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "some random workflow ID",
+		RunID:      constants.TestRunID,
+	}
+	now := time.Now()
+	evenType := types.EventTypeWorkflowExecutionPaused
+	event := &types.HistoryEvent{
+		Version:                                version,
+		EventID:                                130,
+		Timestamp:                              common.Int64Ptr(now.UnixNano()),
+		EventType:                              &evenType,
+		WorkflowExecutionPausedEventAttributes: &types.WorkflowExecutionPausedEventAttributes{},
+	}
+
+	s.mockMutableState.EXPECT().ReplicateWorkflowExecutionPausedEvent(event).Return(nil).Times(1)
+	s.mockUpdateVersion(event)
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).AnyTimes()
+	s.mockMutableState.EXPECT().ClearStickyness().Times(1)
+
+	_, err := s.stateBuilder.ApplyEvents(constants.TestDomainID, requestID, workflowExecution, s.toHistory(event), nil)
+	s.Nil(err)
+}
+
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionUnpaused() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "some random workflow ID",
+		RunID:      constants.TestRunID,
+	}
+	now := time.Now()
+	evenType := types.EventTypeWorkflowExecutionUnpaused
+	event := &types.HistoryEvent{
+		Version:                                  version,
+		EventID:                                  130,
+		Timestamp:                                common.Int64Ptr(now.UnixNano()),
+		EventType:                                &evenType,
+		WorkflowExecutionUnpausedEventAttributes: &types.WorkflowExecutionUnpausedEventAttributes{},
+	}
+
+	s.mockMutableState.EXPECT().ReplicateWorkflowExecutionUnpausedEvent(event).Return(nil).Times(1)
+	s.mockUpdateVersion(event)
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).AnyTimes()
+	s.mockMutableState.EXPECT().ClearStickyness().Times(1)
+
+	_, err := s.stateBuilder.ApplyEvents(constants.TestDomainID, requestID, workflowExecution, s.toHistory(event), nil)
+	s.Nil(err)
+}
+
 func (s *stateBuilderSuite) TestApplyEvents_EventTypeUpsertWorkflowSearchAttributes() {
 	version := int64(1)
 	requestID := uuid.New()
@@ -639,6 +693,34 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeUpsertWorkflowSearchAttribu
 	s.Nil(err)
 }
 
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeUpsertWorkflowMemo() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "some random workflow ID",
+		RunID:      constants.TestRunID,
+	}
+
+	now := time.Now()
+	evenType := types.EventTypeUpsertWorkflowMemo
+	event := &types.HistoryEvent{
+		Version:                           version,
+		EventID:                           130,
+		Timestamp:                         common.Int64Ptr(now.UnixNano()),
+		EventType:                         &evenType,
+		UpsertWorkflowMemoEventAttributes: &types.UpsertWorkflowMemoEventAttributes{},
+	}
+	s.mockMutableState.EXPECT().ReplicateUpsertWorkflowMemoEvent(event).Return().Times(1)
+	s.mockUpdateVersion(event)
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).AnyTimes()
+	s.mockTaskGenerator.EXPECT().GenerateWorkflowSearchAttrTasks().Return(nil).Times(1)
+	s.mockMutableState.EXPECT().ClearStickyness().Times(1)
+
+	_, err := s.stateBuilder.ApplyEvents(constants.TestDomainID, requestID, workflowExecution, s.toHistory(event), nil)
+	s.Nil(err)
+}
+
 func (s *stateBuilderSuite) TestApplyEvents_EventTypeMarkerRecorded() {
 	version := int64(1)
 	requestID := uuid.New()
@@ -665,6 +747,64 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeMarkerRecorded() {
 	s.Nil(err)
 }
 
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionUpdateAccepted() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "some random workflow ID",
+		RunID:      constants.TestRunID,
+	}
+
+	now := time.Now()
+	evenType := types.EventTypeWorkflowExecutionUpdateAccepted
+	event := &types.HistoryEvent{
+		Version:   version,
+		EventID:   130,
+		Timestamp: common.Int64Ptr(now.UnixNano()),
+		EventType: &evenType,
+		WorkflowExecutionUpdateAcceptedEventAttributes: &types.WorkflowExecutionUpdateAcceptedEventAttributes{
+			UpdateID: "some random update ID",
+		},
+	}
+	s.mockUpdateVersion(event)
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).AnyTimes()
+	s.mockMutableState.EXPECT().ClearStickyness().Times(1)
+	s.mockMutableState.EXPECT().ReplicateWorkflowExecutionUpdateAcceptedEvent(event).Return(nil).Times(1)
+
+	_, err := s.stateBuilder.ApplyEvents(constants.TestDomainID, requestID, workflowExecution, s.toHistory(event), nil)
+	s.Nil(err)
+}
+
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionUpdateCompleted() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "some random workflow ID",
+		RunID:      constants.TestRunID,
+	}
+
+	now := time.Now()
+	evenType := types.EventTypeWorkflowExecutionUpdateCompleted
+	event := &types.HistoryEvent{
+		Version:   version,
+		EventID:   130,
+		Timestamp: common.Int64Ptr(now.UnixNano()),
+		EventType: &evenType,
+		WorkflowExecutionUpdateCompletedEventAttributes: &types.WorkflowExecutionUpdateCompletedEventAttributes{
+			UpdateID: "some random update ID",
+		},
+	}
+	s.mockUpdateVersion(event)
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).AnyTimes()
+	s.mockMutableState.EXPECT().ClearStickyness().Times(1)
+	s.mockMutableState.EXPECT().ReplicateWorkflowExecutionUpdateCompletedEvent(event.EventID, event).Return(nil).Times(1)
+
+	_, err := s.stateBuilder.ApplyEvents(constants.TestDomainID, requestID, workflowExecution, s.toHistory(event), nil)
+	s.Nil(err)
+}
+
 // decision operations
 
 func (s *stateBuilderSuite) TestApplyEvents_EventTypeDecisionTaskScheduled() {
@@ -1758,7 +1898,7 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeExternalWorkflowExecutionSi
 
 func (s *stateBuilderSuite) TestApplyEventsNewEventsNotHandled() {
 	eventTypes := types.EventTypeValues()
-	s.Equal(42, len(eventTypes), "If you see this error, you are adding new event type. "+
+	s.Equal(47, len(eventTypes), "If you see this error, you are adding new event type. "+
 		"Before updating the number to make this test pass, please make sure you update stateBuilderImpl.ApplyEvents method "+
 		"to handle the new decision type. Otherwise cross dc will not work on the new event.")
 }
@@ -220,6 +220,12 @@ func (t *timerSequenceImpl) LoadAndSortActivityTimers() []TimerSequenceID {
 
 	for _, activityInfo := range pendingActivities {
 
+		// timeouts of a paused activity waiting for its next attempt are not tracked,
+		// they are restarted when the activity is unpaused
+		if activityInfo.Paused && activityInfo.StartedID == common.EmptyEventID {
+			continue
+		}
+
 		if sequenceID := t.getActivityScheduleToCloseTimeout(
 			activityInfo,
 		); sequenceID != nil {
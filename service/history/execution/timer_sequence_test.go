@@ -377,6 +377,31 @@ func (s *timerSequenceSuite) TestLoadAndSortActivityTimers_One_Scheduled_NotStar
 	}, TimerSequenceIDs)
 }
 
+func (s *timerSequenceSuite) TestLoadAndSortActivityTimers_One_Scheduled_NotStarted_Paused() {
+	now := time.Now()
+	activityInfo := &persistence.ActivityInfo{
+		Version:                  123,
+		ScheduleID:               234,
+		ScheduledTime:            now,
+		StartedID:                common.EmptyEventID,
+		StartedTime:              time.Time{},
+		ActivityID:               "some random activity ID",
+		ScheduleToStartTimeout:   10,
+		ScheduleToCloseTimeout:   1000,
+		StartToCloseTimeout:      100,
+		HeartbeatTimeout:         1,
+		LastHeartBeatUpdatedTime: time.Time{},
+		TimerTaskStatus:          TimerTaskStatusNone,
+		Paused:                   true,
+		Attempt:                  12,
+	}
+	activityInfos := map[int64]*persistence.ActivityInfo{activityInfo.ScheduleID: activityInfo}
+	s.mockMutableState.EXPECT().GetPendingActivityInfos().Return(activityInfos).Times(1)
+
+	TimerSequenceIDs := s.timerSequence.LoadAndSortActivityTimers()
+	s.Empty(TimerSequenceIDs)
+}
+
 func (s *timerSequenceSuite) TestLoadAndSortActivityTimers_One_Scheduled_Started_WithHeartbeatTimeout() {
 	now := time.Now()
 	activityInfo := &persistence.ActivityInfo{
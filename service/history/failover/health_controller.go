@@ -0,0 +1,536 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package failover
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+)
+
+const (
+	healthSignalTimeout                 = 5 * time.Second
+	healthDrivenFailoverTimeout         = 10 * time.Second
+	probeWindowSize                     = 10
+	replicationFetchStalenessSignalName = "replication-fetch-staleness"
+	remoteAvailabilitySignalName        = "remote-cluster-availability"
+	frontendErrorRateSignalName         = "frontend-error-rate"
+	healthDrivenFailoverLeaderShard     = 0
+)
+
+type (
+	// HealthController fails over domains away from a cluster once the cluster has
+	// been unhealthy for long enough. It runs on the leader host of every cluster, but
+	// only the first healthy cluster in name order makes failover decisions
+	HealthController interface {
+		common.Daemon
+	}
+
+	// HealthSignal reports whether a cluster is healthy, additional health
+	// probes can be plugged into the HealthController by implementing it
+	HealthSignal interface {
+		Name() string
+		IsHealthy(ctx context.Context, clusterName string) (bool, error)
+	}
+
+	// ReplicationFetchProvider reports the time since replication tasks were last
+	// fetched from a source cluster
+	ReplicationFetchProvider interface {
+		GetTimeSinceLastFetch(sourceCluster string) (time.Duration, bool)
+	}
+
+	healthControllerImpl struct {
+		status       int32
+		shutdownChan chan struct{}
+		signals      []HealthSignal
+		// consecutive unhealthy checks per cluster, only accessed by the check loop
+		unhealthyCounts map[string]int
+		// last health driven failover per domain ID, only accessed by the check loop
+		lastFailoverTimes map[string]time.Time
+
+		clusterMetadata cluster.Metadata
+		domainCache     cache.DomainCache
+		frontendClient  frontend.Client
+		resolver        membership.ServiceResolver
+		hostInfo        *membership.HostInfo
+		config          *config.Config
+		timeSource      clock.TimeSource
+		metrics         metrics.Client
+		logger          log.Logger
+	}
+
+	replicationFetchStalenessSignal struct {
+		provider  ReplicationFetchProvider
+		threshold dynamicconfig.DurationPropertyFn
+	}
+
+	remoteAvailabilitySignal struct {
+		clientBean client.Bean
+		threshold  dynamicconfig.FloatPropertyFn
+		results    probeResults
+	}
+
+	frontendErrorRateSignal struct {
+		clientBean client.Bean
+		threshold  dynamicconfig.FloatPropertyFn
+		results    probeResults
+	}
+
+	// probeResults keeps the recent probe results per cluster, true means the probe failed
+	probeResults map[string][]bool
+)
+
+var _ HealthController = (*healthControllerImpl)(nil)
+
+// NewHealthController initializes a health driven failover controller
+func NewHealthController(
+	clusterMetadata cluster.Metadata,
+	domainCache cache.DomainCache,
+	frontendClient frontend.Client,
+	resolver membership.ServiceResolver,
+	hostInfo *membership.HostInfo,
+	timeSource clock.TimeSource,
+	config *config.Config,
+	metrics metrics.Client,
+	logger log.Logger,
+	signals ...HealthSignal,
+) HealthController {
+
+	return &healthControllerImpl{
+		status:            common.DaemonStatusInitialized,
+		shutdownChan:      make(chan struct{}),
+		signals:           signals,
+		unhealthyCounts:   make(map[string]int),
+		lastFailoverTimes: make(map[string]time.Time),
+		clusterMetadata:   clusterMetadata,
+		domainCache:       domainCache,
+		frontendClient:    frontendClient,
+		resolver:          resolver,
+		hostInfo:          hostInfo,
+		config:            config,
+		timeSource:        timeSource,
+		metrics:           metrics,
+		logger:            logger.WithTags(tag.ComponentHealthDrivenFailover),
+	}
+}
+
+// NewReplicationFetchStalenessSignal reports a cluster as unhealthy when replication
+// tasks could not be fetched from it for longer than the threshold
+func NewReplicationFetchStalenessSignal(
+	provider ReplicationFetchProvider,
+	threshold dynamicconfig.DurationPropertyFn,
+) HealthSignal {
+
+	return &replicationFetchStalenessSignal{
+		provider:  provider,
+		threshold: threshold,
+	}
+}
+
+// NewRemoteAvailabilitySignal probes a cluster with DescribeCluster calls and reports
+// the cluster as unhealthy when the failure rate of recent probes is above the threshold
+func NewRemoteAvailabilitySignal(
+	clientBean client.Bean,
+	threshold dynamicconfig.FloatPropertyFn,
+) HealthSignal {
+
+	return &remoteAvailabilitySignal{
+		clientBean: clientBean,
+		threshold:  threshold,
+		results:    make(probeResults),
+	}
+}
+
+// NewFrontendErrorRateSignal probes the frontend of a cluster with GetClusterInfo calls and reports
+// the cluster as unhealthy when the error rate of recent calls is above the threshold
+func NewFrontendErrorRateSignal(
+	clientBean client.Bean,
+	threshold dynamicconfig.FloatPropertyFn,
+) HealthSignal {
+
+	return &frontendErrorRateSignal{
+		clientBean: clientBean,
+		threshold:  threshold,
+		results:    make(probeResults),
+	}
+}
+
+func (c *healthControllerImpl) Start() {
+
+	if !atomic.CompareAndSwapInt32(
+		&c.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	go c.checkHealthLoop()
+
+	c.logger.Info("Health driven failover controller state changed", tag.LifeCycleStarted)
+}
+
+func (c *healthControllerImpl) Stop() {
+
+	if !atomic.CompareAndSwapInt32(
+		&c.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	close(c.shutdownChan)
+	c.logger.Info("Health driven failover controller state changed", tag.LifeCycleStopped)
+}
+
+func (c *healthControllerImpl) checkHealthLoop() {
+
+	timer := time.NewTimer(c.config.HealthDrivenFailoverCheckInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-c.shutdownChan:
+			return
+		case <-timer.C:
+			c.checkHealth()
+			timer.Reset(c.config.HealthDrivenFailoverCheckInterval())
+		}
+	}
+}
+
+func (c *healthControllerImpl) checkHealth() {
+
+	if !c.config.EnableHealthDrivenFailover() ||
+		!c.clusterMetadata.IsGlobalDomainEnabled() ||
+		!c.isLeader() {
+		// forget the observations, so that turning the controller back on
+		// requires the full unhealthy threshold again before failing over
+		c.unhealthyCounts = make(map[string]int)
+		return
+	}
+
+	currentCluster := c.clusterMetadata.GetCurrentClusterName()
+	enabledClusters := make(map[string]struct{})
+	var clusters []string
+	for clusterName, info := range c.clusterMetadata.GetAllClusterInfo() {
+		if !info.Enabled {
+			continue
+		}
+		enabledClusters[clusterName] = struct{}{}
+		clusters = append(clusters, clusterName)
+	}
+	sort.Strings(clusters)
+
+	// evaluate every cluster first, the current one included, so that domains are
+	// never failed over to a cluster which is unhealthy in the same round
+	unhealthySignals := make(map[string][]string)
+	for _, clusterName := range clusters {
+		signals := c.getUnhealthySignals(clusterName)
+		if len(signals) == 0 {
+			delete(c.unhealthyCounts, clusterName)
+			continue
+		}
+
+		unhealthySignals[clusterName] = signals
+		c.unhealthyCounts[clusterName]++
+		c.metrics.Scope(
+			metrics.HealthDrivenFailoverScope,
+			metrics.TargetClusterTag(clusterName),
+		).IncCounter(metrics.UnhealthyClusterCount)
+		c.logger.Warn("Cluster is unhealthy.",
+			tag.ClusterName(clusterName),
+			tag.FailoverMsg(strings.Join(signals, ",")),
+			tag.Counter(c.unhealthyCounts[clusterName]),
+		)
+	}
+
+	// every cluster observes the health of all clusters, but only the first cluster in name
+	// order which is not over the unhealthy threshold fails over domains, so that failover
+	// decisions are still made when any single cluster, the primary included, is down
+	if coordinator, ok := c.getCoordinatorCluster(clusters); !ok || coordinator != currentCluster {
+		return
+	}
+
+	for _, clusterName := range clusters {
+		if c.unhealthyCounts[clusterName] < c.config.HealthDrivenFailoverUnhealthyThreshold() {
+			continue
+		}
+		reason := fmt.Sprintf(
+			"health driven failover: cluster %v unhealthy for %v consecutive checks, signals: %v",
+			clusterName,
+			c.unhealthyCounts[clusterName],
+			strings.Join(unhealthySignals[clusterName], ","),
+		)
+		c.failoverDomains(clusterName, enabledClusters, unhealthySignals, reason)
+	}
+}
+
+// isLeader returns true if this host owns the leader shard, so that only one
+// history host in the cluster is failing over domains
+func (c *healthControllerImpl) isLeader() bool {
+
+	info, err := c.resolver.Lookup(string(rune(healthDrivenFailoverLeaderShard)))
+	if err != nil {
+		c.logger.Warn("Failed to lookup health driven failover leader.", tag.Error(err))
+		return false
+	}
+	return info.Identity() == c.hostInfo.Identity()
+}
+
+func (c *healthControllerImpl) getCoordinatorCluster(
+	clusters []string,
+) (string, bool) {
+
+	for _, clusterName := range clusters {
+		if c.unhealthyCounts[clusterName] < c.config.HealthDrivenFailoverUnhealthyThreshold() {
+			return clusterName, true
+		}
+	}
+	return "", false
+}
+
+func (c *healthControllerImpl) getUnhealthySignals(
+	clusterName string,
+) []string {
+
+	var unhealthySignals []string
+	for _, signal := range c.signals {
+		ctx, cancel := context.WithTimeout(context.Background(), healthSignalTimeout)
+		healthy, err := signal.IsHealthy(ctx, clusterName)
+		cancel()
+		if err != nil {
+			// a signal which cannot be evaluated should not trigger a failover
+			c.logger.Warn("Failed to evaluate cluster health signal.",
+				tag.ClusterName(clusterName),
+				tag.Value(signal.Name()),
+				tag.Error(err),
+			)
+			continue
+		}
+		if !healthy {
+			unhealthySignals = append(unhealthySignals, signal.Name())
+		}
+	}
+	return unhealthySignals
+}
+
+func (c *healthControllerImpl) failoverDomains(
+	sourceCluster string,
+	enabledClusters map[string]struct{},
+	unhealthySignals map[string][]string,
+	reason string,
+) {
+
+	now := c.timeSource.Now()
+	for _, domain := range c.domainCache.GetAllDomain() {
+		if !shouldFailoverForHealth(domain, sourceCluster) {
+			continue
+		}
+
+		domainID := domain.GetInfo().ID
+		domainName := domain.GetInfo().Name
+		if lastFailoverTime, ok := c.lastFailoverTimes[domainID]; ok &&
+			now.Before(lastFailoverTime.Add(c.config.HealthDrivenFailoverCooldown())) {
+			continue
+		}
+
+		targetCluster, ok := selectFailoverTarget(domain, sourceCluster, enabledClusters, unhealthySignals)
+		if !ok {
+			c.logger.Warn("No healthy cluster to fail over domain to.",
+				tag.WorkflowDomainName(domainName),
+				tag.SourceCluster(sourceCluster),
+				tag.FailoverMsg(reason),
+			)
+			continue
+		}
+
+		// the reason is recorded with the domain change in the domain audit log
+		scope := c.metrics.Scope(
+			metrics.HealthDrivenFailoverScope,
+			metrics.TargetClusterTag(targetCluster),
+			metrics.DomainTag(domainName),
+		)
+		ctx, cancel := context.WithTimeout(context.Background(), healthDrivenFailoverTimeout)
+		_, err := c.frontendClient.UpdateDomain(
+			ctx,
+			&types.UpdateDomainRequest{
+				Name:              domainName,
+				ActiveClusterName: common.StringPtr(targetCluster),
+			},
+			yarpc.WithHeader(common.DomainChangeReasonHeaderName, reason),
+		)
+		cancel()
+		if err != nil {
+			scope.IncCounter(metrics.HealthDrivenFailoverFailure)
+			c.logger.Error("Failed to fail over domain away from unhealthy cluster.",
+				tag.WorkflowDomainName(domainName),
+				tag.SourceCluster(sourceCluster),
+				tag.ClusterName(targetCluster),
+				tag.FailoverMsg(reason),
+				tag.Error(err),
+			)
+			continue
+		}
+
+		c.lastFailoverTimes[domainID] = now
+		scope.IncCounter(metrics.HealthDrivenFailoverCount)
+		c.logger.Info("Failed over domain away from unhealthy cluster.",
+			tag.WorkflowDomainName(domainName),
+			tag.SourceCluster(sourceCluster),
+			tag.ClusterName(targetCluster),
+			tag.FailoverVersion(domain.GetFailoverVersion()),
+			tag.FailoverMsg(reason),
+		)
+	}
+}
+
+// shouldFailoverForHealth returns true for global domains which opted into
+// managed failover and are active in the source cluster
+func shouldFailoverForHealth(
+	domain *cache.DomainCacheEntry,
+	sourceCluster string,
+) bool {
+
+	if !domain.IsGlobalDomain() || domain.IsDomainPendingActive() {
+		return false
+	}
+	if strings.ToLower(strings.TrimSpace(domain.GetInfo().Data[common.DomainDataKeyForManagedFailover])) != "true" {
+		return false
+	}
+	return domain.GetReplicationConfig().ActiveClusterName == sourceCluster
+}
+
+// selectFailoverTarget picks the first enabled cluster of the domain, in cluster name order,
+// which was not reported unhealthy, so that the same decision is made on every evaluation
+func selectFailoverTarget(
+	domain *cache.DomainCacheEntry,
+	sourceCluster string,
+	enabledClusters map[string]struct{},
+	unhealthySignals map[string][]string,
+) (string, bool) {
+
+	var candidates []string
+	for _, clusterConfig := range domain.GetReplicationConfig().Clusters {
+		clusterName := clusterConfig.ClusterName
+		if clusterName == sourceCluster {
+			continue
+		}
+		if _, ok := enabledClusters[clusterName]; !ok {
+			continue
+		}
+		if _, ok := unhealthySignals[clusterName]; ok {
+			continue
+		}
+		candidates = append(candidates, clusterName)
+	}
+	if len(candidates) == 0 {
+		return "", false
+	}
+	sort.Strings(candidates)
+	return candidates[0], true
+}
+
+func (s *replicationFetchStalenessSignal) Name() string {
+	return replicationFetchStalenessSignalName
+}
+
+func (s *replicationFetchStalenessSignal) IsHealthy(
+	_ context.Context,
+	clusterName string,
+) (bool, error) {
+
+	staleness, ok := s.provider.GetTimeSinceLastFetch(clusterName)
+	if !ok {
+		// no replication from this cluster, so nothing to tell
+		return true, nil
+	}
+	return staleness <= s.threshold(), nil
+}
+
+func (s *remoteAvailabilitySignal) Name() string {
+	return remoteAvailabilitySignalName
+}
+
+func (s *remoteAvailabilitySignal) IsHealthy(
+	ctx context.Context,
+	clusterName string,
+) (bool, error) {
+
+	_, err := s.clientBean.GetRemoteAdminClient(clusterName).DescribeCluster(ctx)
+	return s.results.add(clusterName, err != nil) <= s.threshold(), nil
+}
+
+func (s *frontendErrorRateSignal) Name() string {
+	return frontendErrorRateSignalName
+}
+
+func (s *frontendErrorRateSignal) IsHealthy(
+	ctx context.Context,
+	clusterName string,
+) (bool, error) {
+
+	_, err := s.clientBean.GetRemoteFrontendClient(clusterName).GetClusterInfo(ctx)
+	return s.results.add(clusterName, err != nil) <= s.threshold(), nil
+}
+
+// add records a probe result of the cluster and returns the failure rate of the recent probes
+func (r probeResults) add(
+	clusterName string,
+	failed bool,
+) float64 {
+
+	results := append(r[clusterName], failed)
+	if len(results) > probeWindowSize {
+		results = results[len(results)-probeWindowSize:]
+	}
+	r[clusterName] = results
+
+	failures := 0
+	for _, failed := range results {
+		if failed {
+			failures++
+		}
+	}
+	return float64(failures) / float64(len(results))
+}
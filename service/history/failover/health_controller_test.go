@@ -0,0 +1,342 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package failover
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+	historyConfig "github.com/uber/cadence/service/history/config"
+)
+
+type (
+	healthControllerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller       *gomock.Controller
+		mockResource     *resource.Test
+		config           *historyConfig.Config
+		clusterInfo      map[string]config.ClusterInformation
+		signal           *fakeHealthSignal
+		healthController *healthControllerImpl
+	}
+
+	fakeHealthSignal struct {
+		unhealthyClusters map[string]bool
+		err               error
+	}
+
+	fakeReplicationFetchProvider map[string]time.Duration
+)
+
+const (
+	testBackupCluster  = "backup"
+	testCurrentCluster = "current"
+	testRemoteCluster  = "remote"
+	testStandbyCluster = "standby"
+)
+
+func TestHealthControllerSuite(t *testing.T) {
+	s := new(healthControllerSuite)
+	suite.Run(t, s)
+}
+
+func (s *healthControllerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockResource = resource.NewTest(s.controller, metrics.History)
+	s.config = historyConfig.NewForTest()
+	s.config.EnableHealthDrivenFailover = dynamicconfig.GetBoolPropertyFn(true)
+	s.config.HealthDrivenFailoverUnhealthyThreshold = dynamicconfig.GetIntPropertyFn(2)
+	s.config.HealthDrivenFailoverCooldown = dynamicconfig.GetDurationPropertyFn(time.Hour)
+	s.signal = &fakeHealthSignal{unhealthyClusters: make(map[string]bool)}
+
+	s.mockResource.ClusterMetadata.EXPECT().IsGlobalDomainEnabled().Return(true).AnyTimes()
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(testCurrentCluster).AnyTimes()
+	s.clusterInfo = map[string]config.ClusterInformation{
+		testCurrentCluster: {Enabled: true},
+		testRemoteCluster:  {Enabled: true},
+		testStandbyCluster: {Enabled: true},
+	}
+	s.mockResource.ClusterMetadata.EXPECT().GetAllClusterInfo().DoAndReturn(func() map[string]config.ClusterInformation {
+		return s.clusterInfo
+	}).AnyTimes()
+	s.mockResource.HistoryServiceResolver.EXPECT().Lookup(gomock.Any()).Return(s.mockResource.GetHostInfo(), nil).AnyTimes()
+
+	s.healthController = NewHealthController(
+		s.mockResource.GetClusterMetadata(),
+		s.mockResource.GetDomainCache(),
+		s.mockResource.GetFrontendClient(),
+		s.mockResource.GetHistoryServiceResolver(),
+		s.mockResource.GetHostInfo(),
+		s.mockResource.GetTimeSource(),
+		s.config,
+		s.mockResource.GetMetricsClient(),
+		s.mockResource.GetLogger(),
+		s.signal,
+	).(*healthControllerImpl)
+}
+
+func (s *healthControllerSuite) TearDownTest() {
+	s.controller.Finish()
+	s.mockResource.Finish(s.T())
+}
+
+func (s *healthControllerSuite) TestCheckHealth_Disabled() {
+	s.config.EnableHealthDrivenFailover = dynamicconfig.GetBoolPropertyFn(false)
+	s.healthController.unhealthyCounts[testRemoteCluster] = 10
+	s.signal.unhealthyClusters[testRemoteCluster] = true
+
+	s.healthController.checkHealth()
+	s.Empty(s.healthController.unhealthyCounts)
+}
+
+func (s *healthControllerSuite) TestCheckHealth_NotCoordinator() {
+	s.config.HealthDrivenFailoverUnhealthyThreshold = dynamicconfig.GetIntPropertyFn(1)
+	s.clusterInfo[testBackupCluster] = config.ClusterInformation{Enabled: true}
+	s.signal.unhealthyClusters[testRemoteCluster] = true
+
+	// the healthy backup cluster fails over the domains
+	s.healthController.checkHealth()
+	s.Equal(1, s.healthController.unhealthyCounts[testRemoteCluster])
+
+	// the current cluster takes over once the backup cluster is unhealthy as well
+	s.signal.unhealthyClusters[testBackupCluster] = true
+	s.mockResource.DomainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+		"backup": s.newDomainCacheEntry("backup", testBackupCluster, true, testBackupCluster, testCurrentCluster),
+		"remote": s.newDomainCacheEntry("remote", testRemoteCluster, true, testRemoteCluster, testCurrentCluster),
+	}).Times(2)
+	s.mockResource.FrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+		Name:              "backup",
+		ActiveClusterName: common.StringPtr(testCurrentCluster),
+	}, gomock.Any()).Return(&types.UpdateDomainResponse{}, nil).Times(1)
+	s.mockResource.FrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+		Name:              "remote",
+		ActiveClusterName: common.StringPtr(testCurrentCluster),
+	}, gomock.Any()).Return(&types.UpdateDomainResponse{}, nil).Times(1)
+	s.healthController.checkHealth()
+}
+
+func (s *healthControllerSuite) TestCheckHealth_CurrentClusterUnhealthy() {
+	s.config.HealthDrivenFailoverUnhealthyThreshold = dynamicconfig.GetIntPropertyFn(1)
+	s.signal.unhealthyClusters[testCurrentCluster] = true
+
+	// the domains of the current cluster are failed over by the next healthy cluster
+	s.healthController.checkHealth()
+	s.Equal(1, s.healthController.unhealthyCounts[testCurrentCluster])
+}
+
+func (s *healthControllerSuite) TestCheckHealth_Healthy() {
+	s.healthController.unhealthyCounts[testRemoteCluster] = 1
+
+	s.healthController.checkHealth()
+	s.Empty(s.healthController.unhealthyCounts)
+}
+
+func (s *healthControllerSuite) TestCheckHealth_SignalError() {
+	s.signal.unhealthyClusters[testRemoteCluster] = true
+	s.signal.err = errors.New("some random error")
+
+	s.healthController.checkHealth()
+	s.Empty(s.healthController.unhealthyCounts)
+}
+
+func (s *healthControllerSuite) TestCheckHealth_FailoverAfterThreshold() {
+	s.signal.unhealthyClusters[testRemoteCluster] = true
+	managedDomain := s.newDomainCacheEntry("managed", testRemoteCluster, true, testCurrentCluster, testRemoteCluster)
+	s.mockResource.DomainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+		"managed":   managedDomain,
+		"unmanaged": s.newDomainCacheEntry("unmanaged", testRemoteCluster, false, testCurrentCluster, testRemoteCluster),
+		"active":    s.newDomainCacheEntry("active", testCurrentCluster, true, testCurrentCluster, testRemoteCluster),
+	}).Times(2)
+	s.mockResource.FrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+		Name:              "managed",
+		ActiveClusterName: common.StringPtr(testCurrentCluster),
+	}, gomock.Any()).Return(&types.UpdateDomainResponse{}, nil).Times(1)
+
+	// below the threshold
+	s.healthController.checkHealth()
+	s.Equal(1, s.healthController.unhealthyCounts[testRemoteCluster])
+
+	s.healthController.checkHealth()
+	s.Equal(2, s.healthController.unhealthyCounts[testRemoteCluster])
+	s.Contains(s.healthController.lastFailoverTimes, managedDomain.GetInfo().ID)
+
+	// in cooldown
+	s.healthController.checkHealth()
+}
+
+func (s *healthControllerSuite) TestCheckHealth_FailoverTargetSelection() {
+	s.config.HealthDrivenFailoverUnhealthyThreshold = dynamicconfig.GetIntPropertyFn(1)
+	s.signal.unhealthyClusters[testRemoteCluster] = true
+	s.mockResource.DomainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+		"standby-only": s.newDomainCacheEntry("standby-only", testRemoteCluster, true, testStandbyCluster, testRemoteCluster),
+		"all":          s.newDomainCacheEntry("all", testRemoteCluster, true, testStandbyCluster, testRemoteCluster, testCurrentCluster),
+	}).Times(3)
+	// the first healthy cluster in name order is picked
+	s.mockResource.FrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+		Name:              "standby-only",
+		ActiveClusterName: common.StringPtr(testStandbyCluster),
+	}, gomock.Any()).Return(&types.UpdateDomainResponse{}, nil).Times(1)
+	s.mockResource.FrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+		Name:              "all",
+		ActiveClusterName: common.StringPtr(testCurrentCluster),
+	}, gomock.Any()).Return(&types.UpdateDomainResponse{}, nil).Times(1)
+	s.healthController.checkHealth()
+
+	// no domain is failed over to a cluster which is unhealthy as well
+	s.healthController.lastFailoverTimes = make(map[string]time.Time)
+	s.signal.unhealthyClusters[testStandbyCluster] = true
+	s.mockResource.FrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+		Name:              "all",
+		ActiveClusterName: common.StringPtr(testCurrentCluster),
+	}, gomock.Any()).Return(&types.UpdateDomainResponse{}, nil).Times(1)
+	s.healthController.checkHealth()
+}
+
+func (s *healthControllerSuite) TestCheckHealth_FailoverFailed_Retried() {
+	s.config.HealthDrivenFailoverUnhealthyThreshold = dynamicconfig.GetIntPropertyFn(1)
+	s.signal.unhealthyClusters[testRemoteCluster] = true
+	s.mockResource.DomainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+		"managed": s.newDomainCacheEntry("managed", testRemoteCluster, true, testCurrentCluster, testRemoteCluster),
+	}).Times(2)
+	s.mockResource.FrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, &types.InternalServiceError{Message: "some random error"}).Times(1)
+	s.mockResource.FrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&types.UpdateDomainResponse{}, nil).Times(1)
+
+	s.healthController.checkHealth()
+	s.Empty(s.healthController.lastFailoverTimes)
+	s.healthController.checkHealth()
+	s.Len(s.healthController.lastFailoverTimes, 1)
+}
+
+func (s *healthControllerSuite) TestReplicationFetchStalenessSignal() {
+	signal := NewReplicationFetchStalenessSignal(
+		fakeReplicationFetchProvider{testRemoteCluster: time.Minute},
+		dynamicconfig.GetDurationPropertyFn(10*time.Second),
+	)
+
+	healthy, err := signal.IsHealthy(context.Background(), testRemoteCluster)
+	s.NoError(err)
+	s.False(healthy)
+
+	healthy, err = signal.IsHealthy(context.Background(), "unknown")
+	s.NoError(err)
+	s.True(healthy)
+}
+
+func (s *healthControllerSuite) TestRemoteAvailabilitySignal() {
+	signal := NewRemoteAvailabilitySignal(
+		s.mockResource.GetClientBean(),
+		dynamicconfig.GetFloatPropertyFn(0.5),
+	)
+	s.mockResource.RemoteAdminClient.EXPECT().DescribeCluster(gomock.Any()).Return(&types.DescribeClusterResponse{}, nil).Times(1)
+	s.mockResource.RemoteAdminClient.EXPECT().DescribeCluster(gomock.Any()).Return(nil, errors.New("some random error")).Times(2)
+
+	healthy, err := signal.IsHealthy(context.Background(), testRemoteCluster)
+	s.NoError(err)
+	s.True(healthy)
+	healthy, err = signal.IsHealthy(context.Background(), testRemoteCluster)
+	s.NoError(err)
+	s.True(healthy)
+	healthy, err = signal.IsHealthy(context.Background(), testRemoteCluster)
+	s.NoError(err)
+	s.False(healthy)
+}
+
+func (s *healthControllerSuite) TestFrontendErrorRateSignal() {
+	signal := NewFrontendErrorRateSignal(
+		s.mockResource.GetClientBean(),
+		dynamicconfig.GetFloatPropertyFn(0.2),
+	)
+	s.mockResource.RemoteFrontendClient.EXPECT().GetClusterInfo(gomock.Any()).Return(&types.ClusterInfo{}, nil).Times(4)
+	s.mockResource.RemoteFrontendClient.EXPECT().GetClusterInfo(gomock.Any()).Return(nil, errors.New("some random error")).Times(2)
+
+	for i := 0; i != 4; i++ {
+		healthy, err := signal.IsHealthy(context.Background(), testCurrentCluster)
+		s.NoError(err)
+		s.True(healthy)
+	}
+	healthy, err := signal.IsHealthy(context.Background(), testCurrentCluster)
+	s.NoError(err)
+	s.True(healthy)
+	healthy, err = signal.IsHealthy(context.Background(), testCurrentCluster)
+	s.NoError(err)
+	s.False(healthy)
+}
+
+func (s *healthControllerSuite) newDomainCacheEntry(
+	name string,
+	activeCluster string,
+	managed bool,
+	clusters ...string,
+) *cache.DomainCacheEntry {
+
+	data := map[string]string{}
+	if managed {
+		data[common.DomainDataKeyForManagedFailover] = "true"
+	}
+	var clusterConfigs []*persistence.ClusterReplicationConfig
+	for _, clusterName := range clusters {
+		clusterConfigs = append(clusterConfigs, &persistence.ClusterReplicationConfig{ClusterName: clusterName})
+	}
+	return cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: name + "-id", Name: name, Data: data},
+		&persistence.DomainConfig{},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: activeCluster,
+			Clusters:          clusterConfigs,
+		},
+		1,
+		nil,
+	)
+}
+
+func (s *fakeHealthSignal) Name() string {
+	return "fake"
+}
+
+func (s *fakeHealthSignal) IsHealthy(_ context.Context, clusterName string) (bool, error) {
+	return !s.unhealthyClusters[clusterName], s.err
+}
+
+func (p fakeReplicationFetchProvider) GetTimeSinceLastFetch(sourceCluster string) (time.Duration, bool) {
+	staleness, ok := p[sourceCluster]
+	return staleness, ok
+}
@@ -69,6 +69,21 @@ func (g grpcHandler) DescribeQueue(ctx context.Context, request *historyv1.Descr
 	return proto.FromHistoryDescribeQueueResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) RetryHistoryTaskDLQ(ctx context.Context, request *historyv1.RetryHistoryTaskDLQRequest) (*historyv1.RetryHistoryTaskDLQResponse, error) {
+	response, err := g.h.RetryHistoryTaskDLQ(withGRPCTag(ctx), proto.ToHistoryRetryHistoryTaskDLQRequest(request))
+	return proto.FromHistoryRetryHistoryTaskDLQResponse(response), proto.FromError(err)
+}
+
+func (g grpcHandler) ReadHistoryTaskDLQ(ctx context.Context, request *historyv1.ReadHistoryTaskDLQRequest) (*historyv1.ReadHistoryTaskDLQResponse, error) {
+	response, err := g.h.ReadHistoryTaskDLQ(withGRPCTag(ctx), proto.ToHistoryReadHistoryTaskDLQRequest(request))
+	return proto.FromHistoryReadHistoryTaskDLQResponse(response), proto.FromError(err)
+}
+
+func (g grpcHandler) DescribeReplicationStatus(ctx context.Context, request *historyv1.DescribeReplicationStatusRequest) (*historyv1.DescribeReplicationStatusResponse, error) {
+	response, err := g.h.DescribeReplicationStatus(withGRPCTag(ctx), proto.ToHistoryDescribeReplicationStatusRequest(request))
+	return proto.FromHistoryDescribeReplicationStatusResponse(response), proto.FromError(err)
+}
+
 func (g grpcHandler) DescribeWorkflowExecution(ctx context.Context, request *historyv1.DescribeWorkflowExecutionRequest) (*historyv1.DescribeWorkflowExecutionResponse, error) {
 	response, err := g.h.DescribeWorkflowExecution(withGRPCTag(ctx), proto.ToHistoryDescribeWorkflowExecutionRequest(request))
 	return proto.FromHistoryDescribeWorkflowExecutionResponse(response), proto.FromError(err)
@@ -119,6 +134,16 @@ func (g grpcHandler) QueryWorkflow(ctx context.Context, request *historyv1.Query
 	return proto.FromHistoryQueryWorkflowResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) UpdateWorkflowExecution(ctx context.Context, request *historyv1.UpdateWorkflowExecutionRequest) (*historyv1.UpdateWorkflowExecutionResponse, error) {
+	response, err := g.h.UpdateWorkflowExecution(withGRPCTag(ctx), proto.ToHistoryUpdateWorkflowExecutionRequest(request))
+	return proto.FromHistoryUpdateWorkflowExecutionResponse(response), proto.FromError(err)
+}
+
+func (g grpcHandler) MigrateWorkflowExecution(ctx context.Context, request *historyv1.MigrateWorkflowExecutionRequest) (*historyv1.MigrateWorkflowExecutionResponse, error) {
+	response, err := g.h.MigrateWorkflowExecution(withGRPCTag(ctx), proto.ToHistoryMigrateWorkflowExecutionRequest(request))
+	return proto.FromHistoryMigrateWorkflowExecutionResponse(response), proto.FromError(err)
+}
+
 func (g grpcHandler) ReadDLQMessages(ctx context.Context, request *historyv1.ReadDLQMessagesRequest) (*historyv1.ReadDLQMessagesResponse, error) {
 	response, err := g.h.ReadDLQMessages(withGRPCTag(ctx), proto.ToHistoryReadDLQMessagesRequest(request))
 	return proto.FromHistoryReadDLQMessagesResponse(response), proto.FromError(err)
@@ -174,11 +199,46 @@ func (g grpcHandler) RequestCancelWorkflowExecution(ctx context.Context, request
 	return &historyv1.RequestCancelWorkflowExecutionResponse{}, proto.FromError(err)
 }
 
+func (g grpcHandler) PauseWorkflowExecution(ctx context.Context, request *historyv1.PauseWorkflowExecutionRequest) (*historyv1.PauseWorkflowExecutionResponse, error) {
+	err := g.h.PauseWorkflowExecution(withGRPCTag(ctx), proto.ToHistoryPauseWorkflowExecutionRequest(request))
+	return &historyv1.PauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) UnpauseWorkflowExecution(ctx context.Context, request *historyv1.UnpauseWorkflowExecutionRequest) (*historyv1.UnpauseWorkflowExecutionResponse, error) {
+	err := g.h.UnpauseWorkflowExecution(withGRPCTag(ctx), proto.ToHistoryUnpauseWorkflowExecutionRequest(request))
+	return &historyv1.UnpauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) DeleteWorkflowExecution(ctx context.Context, request *historyv1.DeleteWorkflowExecutionRequest) (*historyv1.DeleteWorkflowExecutionResponse, error) {
+	err := g.h.DeleteWorkflowExecution(withGRPCTag(ctx), proto.ToHistoryDeleteWorkflowExecutionRequest(request))
+	return &historyv1.DeleteWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) PauseActivity(ctx context.Context, request *historyv1.PauseActivityRequest) (*historyv1.PauseActivityResponse, error) {
+	err := g.h.PauseActivity(withGRPCTag(ctx), proto.ToHistoryPauseActivityRequest(request))
+	return &historyv1.PauseActivityResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) UnpauseActivity(ctx context.Context, request *historyv1.UnpauseActivityRequest) (*historyv1.UnpauseActivityResponse, error) {
+	err := g.h.UnpauseActivity(withGRPCTag(ctx), proto.ToHistoryUnpauseActivityRequest(request))
+	return &historyv1.UnpauseActivityResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) ResetActivity(ctx context.Context, request *historyv1.ResetActivityRequest) (*historyv1.ResetActivityResponse, error) {
+	err := g.h.ResetActivity(withGRPCTag(ctx), proto.ToHistoryResetActivityRequest(request))
+	return &historyv1.ResetActivityResponse{}, proto.FromError(err)
+}
+
 func (g grpcHandler) ResetQueue(ctx context.Context, request *historyv1.ResetQueueRequest) (*historyv1.ResetQueueResponse, error) {
 	err := g.h.ResetQueue(withGRPCTag(ctx), proto.ToHistoryResetQueueRequest(request))
 	return &historyv1.ResetQueueResponse{}, proto.FromError(err)
 }
 
+func (g grpcHandler) PurgeHistoryTaskDLQ(ctx context.Context, request *historyv1.PurgeHistoryTaskDLQRequest) (*historyv1.PurgeHistoryTaskDLQResponse, error) {
+	err := g.h.PurgeHistoryTaskDLQ(withGRPCTag(ctx), proto.ToHistoryPurgeHistoryTaskDLQRequest(request))
+	return &historyv1.PurgeHistoryTaskDLQResponse{}, proto.FromError(err)
+}
+
 func (g grpcHandler) ResetStickyTaskList(ctx context.Context, request *historyv1.ResetStickyTaskListRequest) (*historyv1.ResetStickyTaskListResponse, error) {
 	_, err := g.h.ResetStickyTaskList(withGRPCTag(ctx), proto.ToHistoryResetStickyTaskListRequest(request))
 	return &historyv1.ResetStickyTaskListResponse{}, proto.FromError(err)
@@ -65,6 +65,7 @@ type (
 		DescribeHistoryHost(context.Context, *types.DescribeHistoryHostRequest) (*types.DescribeHistoryHostResponse, error)
 		DescribeMutableState(context.Context, *types.DescribeMutableStateRequest) (*types.DescribeMutableStateResponse, error)
 		DescribeQueue(context.Context, *types.DescribeQueueRequest) (*types.DescribeQueueResponse, error)
+		DescribeReplicationStatus(context.Context, *types.DescribeReplicationStatusRequest) (*types.DescribeReplicationStatusResponse, error)
 		DescribeWorkflowExecution(context.Context, *types.HistoryDescribeWorkflowExecutionRequest) (*types.DescribeWorkflowExecutionResponse, error)
 		GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error)
 		GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest) (*types.GetDLQReplicationMessagesResponse, error)
@@ -74,8 +75,12 @@ type (
 		NotifyFailoverMarkers(context.Context, *types.NotifyFailoverMarkersRequest) error
 		PollMutableState(context.Context, *types.PollMutableStateRequest) (*types.PollMutableStateResponse, error)
 		PurgeDLQMessages(context.Context, *types.PurgeDLQMessagesRequest) error
+		PurgeHistoryTaskDLQ(context.Context, *types.PurgeHistoryTaskDLQRequest) error
 		QueryWorkflow(context.Context, *types.HistoryQueryWorkflowRequest) (*types.HistoryQueryWorkflowResponse, error)
+		UpdateWorkflowExecution(context.Context, *types.HistoryUpdateWorkflowExecutionRequest) (*types.HistoryUpdateWorkflowExecutionResponse, error)
+		MigrateWorkflowExecution(context.Context, *types.HistoryMigrateWorkflowExecutionRequest) (*types.MigrateWorkflowExecutionResponse, error)
 		ReadDLQMessages(context.Context, *types.ReadDLQMessagesRequest) (*types.ReadDLQMessagesResponse, error)
+		ReadHistoryTaskDLQ(context.Context, *types.ReadHistoryTaskDLQRequest) (*types.ReadHistoryTaskDLQResponse, error)
 		ReapplyEvents(context.Context, *types.HistoryReapplyEventsRequest) error
 		RecordActivityTaskHeartbeat(context.Context, *types.HistoryRecordActivityTaskHeartbeatRequest) (*types.RecordActivityTaskHeartbeatResponse, error)
 		RecordActivityTaskStarted(context.Context, *types.RecordActivityTaskStartedRequest) (*types.RecordActivityTaskStartedResponse, error)
@@ -86,6 +91,12 @@ type (
 		RemoveTask(context.Context, *types.RemoveTaskRequest) error
 		ReplicateEventsV2(context.Context, *types.ReplicateEventsV2Request) error
 		RequestCancelWorkflowExecution(context.Context, *types.HistoryRequestCancelWorkflowExecutionRequest) error
+		PauseWorkflowExecution(context.Context, *types.HistoryPauseWorkflowExecutionRequest) error
+		UnpauseWorkflowExecution(context.Context, *types.HistoryUnpauseWorkflowExecutionRequest) error
+		DeleteWorkflowExecution(context.Context, *types.HistoryDeleteWorkflowExecutionRequest) error
+		PauseActivity(context.Context, *types.HistoryPauseActivityRequest) error
+		UnpauseActivity(context.Context, *types.HistoryUnpauseActivityRequest) error
+		ResetActivity(context.Context, *types.HistoryResetActivityRequest) error
 		ResetQueue(context.Context, *types.ResetQueueRequest) error
 		ResetStickyTaskList(context.Context, *types.HistoryResetStickyTaskListRequest) (*types.HistoryResetStickyTaskListResponse, error)
 		ResetWorkflowExecution(context.Context, *types.HistoryResetWorkflowExecutionRequest) (*types.ResetWorkflowExecutionResponse, error)
@@ -95,6 +106,7 @@ type (
 		RespondCrossClusterTasksCompleted(context.Context, *types.RespondCrossClusterTasksCompletedRequest) (*types.RespondCrossClusterTasksCompletedResponse, error)
 		RespondDecisionTaskCompleted(context.Context, *types.HistoryRespondDecisionTaskCompletedRequest) (*types.HistoryRespondDecisionTaskCompletedResponse, error)
 		RespondDecisionTaskFailed(context.Context, *types.HistoryRespondDecisionTaskFailedRequest) error
+		RetryHistoryTaskDLQ(context.Context, *types.RetryHistoryTaskDLQRequest) (*types.RetryHistoryTaskDLQResponse, error)
 		ScheduleDecisionTask(context.Context, *types.ScheduleDecisionTaskRequest) error
 		SignalWithStartWorkflowExecution(context.Context, *types.HistorySignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
 		SignalWorkflowExecution(context.Context, *types.HistorySignalWorkflowExecutionRequest) error
@@ -118,6 +130,8 @@ type (
 		replicationTaskFetchers replication.TaskFetchers
 		queueTaskProcessor      task.Processor
 		failoverCoordinator     failover.Coordinator
+		replicationDLQMerger    replication.DLQMerger
+		healthController        failover.HealthController
 	}
 )
 
@@ -190,6 +204,14 @@ func (h *handlerImpl) Start() {
 	}
 	h.queueTaskProcessor.Start()
 
+	// the merger is started before the engines of the shards register with it
+	h.replicationDLQMerger = replication.NewDLQMerger(
+		h.config,
+		h.GetMetricsClient(),
+		h.GetLogger(),
+	)
+	h.replicationDLQMerger.Start()
+
 	h.controller = shard.NewShardController(
 		h.Resource,
 		h,
@@ -212,6 +234,23 @@ func (h *handlerImpl) Start() {
 		h.failoverCoordinator.Start()
 	}
 
+	// the controller is always started so that it can be turned on and off by dynamic config
+	h.healthController = failover.NewHealthController(
+		h.GetClusterMetadata(),
+		h.GetDomainCache(),
+		h.GetFrontendClient(),
+		h.GetHistoryServiceResolver(),
+		h.GetHostInfo(),
+		h.GetTimeSource(),
+		h.config,
+		h.GetMetricsClient(),
+		h.GetLogger(),
+		failover.NewReplicationFetchStalenessSignal(h.replicationTaskFetchers, h.config.HealthDrivenFailoverFetchStalenessThreshold),
+		failover.NewRemoteAvailabilitySignal(h.GetClientBean(), h.config.HealthDrivenFailoverRemoteUnavailabilityThreshold),
+		failover.NewFrontendErrorRateSignal(h.GetClientBean(), h.config.HealthDrivenFailoverFrontendErrorRateThreshold),
+	)
+	h.healthController.Start()
+
 	h.controller.Start()
 
 	h.startWG.Done()
@@ -225,6 +264,8 @@ func (h *handlerImpl) Stop() {
 	h.controller.Stop()
 	h.historyEventNotifier.Stop()
 	h.failoverCoordinator.Stop()
+	h.replicationDLQMerger.Stop()
+	h.healthController.Stop()
 }
 
 // PrepareToStop starts graceful traffic drain in preparation for shutdown
@@ -262,6 +303,7 @@ func (h *handlerImpl) CreateEngine(
 		h.GetMatchingRawClient(),
 		h.queueTaskProcessor,
 		h.failoverCoordinator,
+		h.replicationDLQMerger,
 	)
 }
 
@@ -1047,6 +1089,282 @@ func (h *handlerImpl) RequestCancelWorkflowExecution(
 	return nil
 }
 
+// PauseWorkflowExecution - pauses a workflow execution
+func (h *handlerImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryPauseWorkflowExecutionRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryPauseWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" || request.PauseRequest.GetDomain() == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	pauseRequest := request.PauseRequest
+	h.GetLogger().Debug(fmt.Sprintf("PauseWorkflowExecution. DomainID: %v/%v, WorkflowID: %v, RunID: %v.",
+		pauseRequest.GetDomain(),
+		request.GetDomainUUID(),
+		pauseRequest.WorkflowExecution.GetWorkflowID(),
+		pauseRequest.WorkflowExecution.GetRunID()))
+
+	workflowID := pauseRequest.WorkflowExecution.GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.PauseWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// UnpauseWorkflowExecution - unpauses a paused workflow execution
+func (h *handlerImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryUnpauseWorkflowExecutionRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUnpauseWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" || request.UnpauseRequest.GetDomain() == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	unpauseRequest := request.UnpauseRequest
+	h.GetLogger().Debug(fmt.Sprintf("UnpauseWorkflowExecution. DomainID: %v/%v, WorkflowID: %v, RunID: %v.",
+		unpauseRequest.GetDomain(),
+		request.GetDomainUUID(),
+		unpauseRequest.WorkflowExecution.GetWorkflowID(),
+		unpauseRequest.WorkflowExecution.GetRunID()))
+
+	workflowID := unpauseRequest.WorkflowExecution.GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.UnpauseWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// DeleteWorkflowExecution - schedules the deletion of a closed workflow execution
+func (h *handlerImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryDeleteWorkflowExecutionRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryDeleteWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" || request.DeleteRequest.GetDomain() == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	deleteRequest := request.DeleteRequest
+	h.GetLogger().Debug(fmt.Sprintf("DeleteWorkflowExecution. DomainID: %v/%v, WorkflowID: %v, RunID: %v.",
+		deleteRequest.GetDomain(),
+		request.GetDomainUUID(),
+		deleteRequest.WorkflowExecution.GetWorkflowID(),
+		deleteRequest.WorkflowExecution.GetRunID()))
+
+	workflowID := deleteRequest.WorkflowExecution.GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.DeleteWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// PauseActivity - suspends dispatch and retries of a pending activity
+func (h *handlerImpl) PauseActivity(
+	ctx context.Context,
+	request *types.HistoryPauseActivityRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryPauseActivityScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" || request.PauseRequest.GetDomain() == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	pauseRequest := request.PauseRequest
+	h.GetLogger().Debug(fmt.Sprintf("PauseActivity. DomainID: %v/%v, WorkflowID: %v, RunID: %v.",
+		pauseRequest.GetDomain(),
+		request.GetDomainUUID(),
+		pauseRequest.WorkflowExecution.GetWorkflowID(),
+		pauseRequest.WorkflowExecution.GetRunID()))
+
+	workflowID := pauseRequest.WorkflowExecution.GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.PauseActivity(ctx, request)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// UnpauseActivity - resumes a paused activity
+func (h *handlerImpl) UnpauseActivity(
+	ctx context.Context,
+	request *types.HistoryUnpauseActivityRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUnpauseActivityScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" || request.UnpauseRequest.GetDomain() == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	unpauseRequest := request.UnpauseRequest
+	h.GetLogger().Debug(fmt.Sprintf("UnpauseActivity. DomainID: %v/%v, WorkflowID: %v, RunID: %v.",
+		unpauseRequest.GetDomain(),
+		request.GetDomainUUID(),
+		unpauseRequest.WorkflowExecution.GetWorkflowID(),
+		unpauseRequest.WorkflowExecution.GetRunID()))
+
+	workflowID := unpauseRequest.WorkflowExecution.GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.UnpauseActivity(ctx, request)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// ResetActivity - resets the attempt count and heartbeat details of a pending activity
+func (h *handlerImpl) ResetActivity(
+	ctx context.Context,
+	request *types.HistoryResetActivityRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryResetActivityScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" || request.ResetRequest.GetDomain() == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	resetRequest := request.ResetRequest
+	h.GetLogger().Debug(fmt.Sprintf("ResetActivity. DomainID: %v/%v, WorkflowID: %v, RunID: %v.",
+		resetRequest.GetDomain(),
+		request.GetDomainUUID(),
+		resetRequest.WorkflowExecution.GetWorkflowID(),
+		resetRequest.WorkflowExecution.GetRunID()))
+
+	workflowID := resetRequest.WorkflowExecution.GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.ResetActivity(ctx, request)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
 // SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in
 // WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.
 func (h *handlerImpl) SignalWorkflowExecution(
@@ -1293,6 +1611,82 @@ func (h *handlerImpl) QueryWorkflow(
 	return resp, nil
 }
 
+// UpdateWorkflowExecution delivers an update to a workflow and waits for its result.
+func (h *handlerImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryUpdateWorkflowExecutionRequest,
+) (resp *types.HistoryUpdateWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUpdateWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowID := request.GetRequest().GetWorkflowExecution().GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID)
+	}
+
+	resp, err2 := engine.UpdateWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID)
+	}
+
+	return resp, nil
+}
+
+// MigrateWorkflowExecution moves a workflow execution from another cluster into this cluster.
+func (h *handlerImpl) MigrateWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryMigrateWorkflowExecutionRequest,
+) (resp *types.MigrateWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryMigrateWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowID := request.GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID)
+	}
+
+	resp, err2 := engine.MigrateWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID)
+	}
+
+	return resp, nil
+}
+
 // ScheduleDecisionTask is used for creating a decision task for already started workflow execution.  This is mainly
 // used by transfer queue processor during the processing of StartChildWorkflowExecution task, where it first starts
 // child execution without creating the decision task and then calls this API after updating the mutable state of
@@ -1761,6 +2155,62 @@ func (h *handlerImpl) ReadDLQMessages(
 	return engine.ReadDLQMessages(ctx, request)
 }
 
+// ReadHistoryTaskDLQ reads tasks from the history task DLQ
+func (h *handlerImpl) ReadHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.ReadHistoryTaskDLQRequest,
+) (resp *types.ReadHistoryTaskDLQResponse, retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryReadHistoryTaskDLQScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	engine, err := h.controller.GetEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	return engine.ReadHistoryTaskDLQ(ctx, request)
+}
+
+// DescribeReplicationStatus returns the replication progress of the requested shards
+func (h *handlerImpl) DescribeReplicationStatus(
+	ctx context.Context,
+	request *types.DescribeReplicationStatusRequest,
+) (resp *types.DescribeReplicationStatusResponse, retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryDescribeReplicationStatusScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	resp = &types.DescribeReplicationStatusResponse{}
+	for _, shardID := range request.GetShardIDs() {
+		engine, err := h.controller.GetEngineForShard(int(shardID))
+		if err != nil {
+			return nil, h.error(err, scope, "", "")
+		}
+
+		shards, err := engine.DescribeReplicationStatus(ctx, request.GetClusterName())
+		if err != nil {
+			return nil, h.error(err, scope, "", "")
+		}
+		resp.Shards = append(resp.Shards, shards...)
+	}
+	return resp, nil
+}
+
 // PurgeDLQMessages deletes replication DLQ messages
 func (h *handlerImpl) PurgeDLQMessages(
 	ctx context.Context,
@@ -1785,6 +2235,30 @@ func (h *handlerImpl) PurgeDLQMessages(
 	return engine.PurgeDLQMessages(ctx, request)
 }
 
+// PurgeHistoryTaskDLQ deletes tasks from the history task DLQ
+func (h *handlerImpl) PurgeHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.PurgeHistoryTaskDLQRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryPurgeHistoryTaskDLQScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	engine, err := h.controller.GetEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	return engine.PurgeHistoryTaskDLQ(ctx, request)
+}
+
 // MergeDLQMessages reads and applies replication DLQ messages
 func (h *handlerImpl) MergeDLQMessages(
 	ctx context.Context,
@@ -1809,6 +2283,30 @@ func (h *handlerImpl) MergeDLQMessages(
 	return engine.MergeDLQMessages(ctx, request)
 }
 
+// RetryHistoryTaskDLQ resubmits tasks from the history task DLQ
+func (h *handlerImpl) RetryHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.RetryHistoryTaskDLQRequest,
+) (resp *types.RetryHistoryTaskDLQResponse, retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRetryHistoryTaskDLQScope)
+	defer sw.Stop()
+
+	engine, err := h.controller.GetEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	return engine.RetryHistoryTaskDLQ(ctx, request)
+}
+
 // RefreshWorkflowTasks refreshes all the tasks of a workflow
 func (h *handlerImpl) RefreshWorkflowTasks(
 	ctx context.Context,
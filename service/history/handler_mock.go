@@ -21,7 +21,7 @@
 // SOFTWARE.
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: handler.go
+// Source: service/history/handler.go
 
 // Package history is a generated GoMock package.
 package history
@@ -29,7 +29,7 @@ package history
 import (
 	context "context"
 	reflect "reflect"
-	"time"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 
@@ -304,6 +304,20 @@ func (mr *MockHandlerMockRecorder) PurgeDLQMessages(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockHandler)(nil).PurgeDLQMessages), arg0, arg1)
 }
 
+// PurgeHistoryTaskDLQ mocks base method
+func (m *MockHandler) PurgeHistoryTaskDLQ(arg0 context.Context, arg1 *types.PurgeHistoryTaskDLQRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeHistoryTaskDLQ", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeHistoryTaskDLQ indicates an expected call of PurgeHistoryTaskDLQ
+func (mr *MockHandlerMockRecorder) PurgeHistoryTaskDLQ(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeHistoryTaskDLQ", reflect.TypeOf((*MockHandler)(nil).PurgeHistoryTaskDLQ), arg0, arg1)
+}
+
 // QueryWorkflow mocks base method
 func (m *MockHandler) QueryWorkflow(arg0 context.Context, arg1 *types.HistoryQueryWorkflowRequest) (*types.HistoryQueryWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -319,6 +333,36 @@ func (mr *MockHandlerMockRecorder) QueryWorkflow(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockHandler)(nil).QueryWorkflow), arg0, arg1)
 }
 
+// UpdateWorkflowExecution mocks base method
+func (m *MockHandler) UpdateWorkflowExecution(arg0 context.Context, arg1 *types.HistoryUpdateWorkflowExecutionRequest) (*types.HistoryUpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*types.HistoryUpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateWorkflowExecution mocks base method
+func (m *MockHandler) MigrateWorkflowExecution(arg0 context.Context, arg1 *types.HistoryMigrateWorkflowExecutionRequest) (*types.MigrateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*types.MigrateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution
+func (mr *MockHandlerMockRecorder) UpdateWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).UpdateWorkflowExecution), arg0, arg1)
+}
+
+// MigrateWorkflowExecution indicates an expected call of MigrateWorkflowExecution
+func (mr *MockHandlerMockRecorder) MigrateWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).MigrateWorkflowExecution), arg0, arg1)
+}
+
 // ReadDLQMessages mocks base method
 func (m *MockHandler) ReadDLQMessages(arg0 context.Context, arg1 *types.ReadDLQMessagesRequest) (*types.ReadDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -334,6 +378,36 @@ func (mr *MockHandlerMockRecorder) ReadDLQMessages(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDLQMessages", reflect.TypeOf((*MockHandler)(nil).ReadDLQMessages), arg0, arg1)
 }
 
+// ReadHistoryTaskDLQ mocks base method
+func (m *MockHandler) ReadHistoryTaskDLQ(arg0 context.Context, arg1 *types.ReadHistoryTaskDLQRequest) (*types.ReadHistoryTaskDLQResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadHistoryTaskDLQ", arg0, arg1)
+	ret0, _ := ret[0].(*types.ReadHistoryTaskDLQResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplicationStatus mocks base method
+func (m *MockHandler) DescribeReplicationStatus(arg0 context.Context, arg1 *types.DescribeReplicationStatusRequest) (*types.DescribeReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeReplicationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadHistoryTaskDLQ indicates an expected call of ReadHistoryTaskDLQ
+func (mr *MockHandlerMockRecorder) ReadHistoryTaskDLQ(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadHistoryTaskDLQ", reflect.TypeOf((*MockHandler)(nil).ReadHistoryTaskDLQ), arg0, arg1)
+}
+
+// DescribeReplicationStatus indicates an expected call of DescribeReplicationStatus
+func (mr *MockHandlerMockRecorder) DescribeReplicationStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplicationStatus", reflect.TypeOf((*MockHandler)(nil).DescribeReplicationStatus), arg0, arg1)
+}
+
 // ReapplyEvents mocks base method
 func (m *MockHandler) ReapplyEvents(arg0 context.Context, arg1 *types.HistoryReapplyEventsRequest) error {
 	m.ctrl.T.Helper()
@@ -477,6 +551,90 @@ func (mr *MockHandlerMockRecorder) RequestCancelWorkflowExecution(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).RequestCancelWorkflowExecution), arg0, arg1)
 }
 
+// PauseWorkflowExecution mocks base method
+func (m *MockHandler) PauseWorkflowExecution(arg0 context.Context, arg1 *types.HistoryPauseWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution
+func (mr *MockHandlerMockRecorder) PauseWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).PauseWorkflowExecution), arg0, arg1)
+}
+
+// UnpauseWorkflowExecution mocks base method
+func (m *MockHandler) UnpauseWorkflowExecution(arg0 context.Context, arg1 *types.HistoryUnpauseWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution
+func (mr *MockHandlerMockRecorder) UnpauseWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).UnpauseWorkflowExecution), arg0, arg1)
+}
+
+// DeleteWorkflowExecution mocks base method
+func (m *MockHandler) DeleteWorkflowExecution(arg0 context.Context, arg1 *types.HistoryDeleteWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution
+func (mr *MockHandlerMockRecorder) DeleteWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).DeleteWorkflowExecution), arg0, arg1)
+}
+
+// PauseActivity mocks base method
+func (m *MockHandler) PauseActivity(arg0 context.Context, arg1 *types.HistoryPauseActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseActivity indicates an expected call of PauseActivity
+func (mr *MockHandlerMockRecorder) PauseActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockHandler)(nil).PauseActivity), arg0, arg1)
+}
+
+// UnpauseActivity mocks base method
+func (m *MockHandler) UnpauseActivity(arg0 context.Context, arg1 *types.HistoryUnpauseActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity
+func (mr *MockHandlerMockRecorder) UnpauseActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockHandler)(nil).UnpauseActivity), arg0, arg1)
+}
+
+// ResetActivity mocks base method
+func (m *MockHandler) ResetActivity(arg0 context.Context, arg1 *types.HistoryResetActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivity indicates an expected call of ResetActivity
+func (mr *MockHandlerMockRecorder) ResetActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivity", reflect.TypeOf((*MockHandler)(nil).ResetActivity), arg0, arg1)
+}
+
 // ResetQueue mocks base method
 func (m *MockHandler) ResetQueue(arg0 context.Context, arg1 *types.ResetQueueRequest) error {
 	m.ctrl.T.Helper()
@@ -607,6 +765,21 @@ func (mr *MockHandlerMockRecorder) RespondDecisionTaskFailed(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondDecisionTaskFailed", reflect.TypeOf((*MockHandler)(nil).RespondDecisionTaskFailed), arg0, arg1)
 }
 
+// RetryHistoryTaskDLQ mocks base method
+func (m *MockHandler) RetryHistoryTaskDLQ(arg0 context.Context, arg1 *types.RetryHistoryTaskDLQRequest) (*types.RetryHistoryTaskDLQResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryHistoryTaskDLQ", arg0, arg1)
+	ret0, _ := ret[0].(*types.RetryHistoryTaskDLQResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryHistoryTaskDLQ indicates an expected call of RetryHistoryTaskDLQ
+func (mr *MockHandlerMockRecorder) RetryHistoryTaskDLQ(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryHistoryTaskDLQ", reflect.TypeOf((*MockHandler)(nil).RetryHistoryTaskDLQ), arg0, arg1)
+}
+
 // ScheduleDecisionTask mocks base method
 func (m *MockHandler) ScheduleDecisionTask(arg0 context.Context, arg1 *types.ScheduleDecisionTaskRequest) error {
 	m.ctrl.T.Helper()
@@ -114,6 +114,28 @@ func (s *handlerSuite) TestGetCrossClusterTasks() {
 	}
 }
 
+func (s *handlerSuite) TestDescribeReplicationStatus() {
+	targetCluster := cluster.TestAlternativeClusterName
+	shardIDs := []int32{1, 3, 5}
+	for _, shardID := range shardIDs {
+		s.mockEngine.EXPECT().DescribeReplicationStatus(gomock.Any(), targetCluster).Return(
+			[]*types.ReplicationShardStatus{{ShardID: shardID, ClusterName: targetCluster}},
+			nil,
+		).Times(1)
+	}
+
+	response, err := s.handler.DescribeReplicationStatus(context.Background(), &types.DescribeReplicationStatusRequest{
+		ClusterName: targetCluster,
+		ShardIDs:    shardIDs,
+	})
+	s.NoError(err)
+	s.Len(response.GetShards(), len(shardIDs))
+	for i, status := range response.GetShards() {
+		s.Equal(shardIDs[i], status.ShardID)
+		s.Equal(targetCluster, status.ClusterName)
+	}
+}
+
 func (s *handlerSuite) TestRespondCrossClusterTaskCompleted_FetchNewTask() {
 	s.testRespondCrossClusterTaskCompleted(true)
 }
@@ -26,13 +26,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
 	"time"
 
 	"github.com/pborman/uuid"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/yarpcerrors"
 
 	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
 	hc "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
@@ -62,6 +66,7 @@ import (
 	"github.com/uber/cadence/service/history/reset"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
+	"github.com/uber/cadence/service/history/update"
 	"github.com/uber/cadence/service/history/workflow"
 	warchiver "github.com/uber/cadence/service/worker/archiver"
 )
@@ -76,13 +81,21 @@ const (
 	TerminateIfRunningReason = "TerminateIfRunning Policy"
 	// TerminateIfRunningDetailsTemplate details template for terminateIfRunning
 	TerminateIfRunningDetailsTemplate = "New runID: %s"
+
+	migrationTerminateReason = "continued elsewhere"
+	migrationIdentity        = "cadence-admin-migration"
 )
 
 var (
 	errDomainDeprecated = &types.BadRequestError{Message: "Domain is deprecated."}
 
+	errConcurrentWorkflowsLimitExceeded = &types.LimitExceededError{Message: "Domain has reached its limit of concurrent workflows."}
+
 	// TODO: remove this error when cross-cluster queue is wired up and initialized
 	errQueueNotInitialized = types.BadRequestError{Message: "Requested queue processor not initialized"}
+
+	errMigrationNotSupported = &types.BadRequestError{Message: "Workflow migration requires global domains to be enabled."}
+	errMigrationSourceClosed = &types.BadRequestError{Message: "Workflow execution is not running in the source cluster."}
 )
 
 type (
@@ -118,8 +131,27 @@ type (
 		rawMatchingClient         matching.Client
 		clientChecker             client.VersionChecker
 		replicationDLQHandler     replication.DLQHandler
+		replicationDLQMerger      replication.DLQMerger
+		replicationSourceClusters []string
+		historyTaskDLQHandler     queue.DLQHandler
 		failoverMarkerNotifier    failover.MarkerNotifier
 	}
+
+	// migrationLink is recorded as termination details of a migrated source execution
+	migrationLink struct {
+		Cluster    string `json:"cluster"`
+		Domain     string `json:"domain"`
+		WorkflowID string `json:"workflowID"`
+		RunID      string `json:"runID"`
+	}
+
+	// migrationSourceClient is the admin client of the source cluster handed to the history resender,
+	// which only knows the domain the history is copied into
+	migrationSourceClient struct {
+		admin.Client
+
+		sourceDomain string
+	}
 )
 
 var _ engine.Engine = (*historyEngineImpl)(nil)
@@ -148,6 +180,7 @@ func NewEngineWithShardContext(
 	rawMatchingClient matching.Client,
 	queueTaskProcessor task.Processor,
 	failoverCoordinator failover.Coordinator,
+	replicationDLQMerger replication.DLQMerger,
 ) engine.Engine {
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 
@@ -226,6 +259,12 @@ func NewEngineWithShardContext(
 		openExecutionCheck,
 	)
 
+	historyEngImpl.historyTaskDLQHandler = queue.NewDLQHandler(
+		shard,
+		historyEngImpl.txProcessor,
+		historyEngImpl.timerProcessor,
+	)
+
 	// TODO: uncomment the following when cross cluster queue processor impl is ready
 	// and also add necessary Start(), Stop(), NotifyFailover() call in this file.
 	// historyEngImpl.crossClusterProcessor = queue.NewCrossClusterQueueProcessor(
@@ -290,6 +329,7 @@ func NewEngineWithShardContext(
 			shard.GetLogger(),
 		)
 		replicationTaskExecutors[sourceCluster] = replicationTaskExecutor
+		historyEngImpl.replicationSourceClusters = append(historyEngImpl.replicationSourceClusters, sourceCluster)
 
 		replicationTaskProcessor := replication.NewTaskProcessor(
 			shard,
@@ -304,6 +344,7 @@ func NewEngineWithShardContext(
 	historyEngImpl.replicationTaskProcessors = replicationTaskProcessors
 	replicationMessageHandler := replication.NewDLQHandler(shard, replicationTaskExecutors)
 	historyEngImpl.replicationDLQHandler = replicationMessageHandler
+	historyEngImpl.replicationDLQMerger = replicationDLQMerger
 
 	shard.SetEngine(historyEngImpl)
 	return historyEngImpl
@@ -329,6 +370,7 @@ func (e *historyEngineImpl) Start() {
 	for _, replicationTaskProcessor := range e.replicationTaskProcessors {
 		replicationTaskProcessor.Start()
 	}
+	e.replicationDLQMerger.AddShard(e.shard.GetShardID(), e.replicationDLQHandler, e.replicationSourceClusters)
 	if e.config.EnableGracefulFailover() {
 		e.failoverMarkerNotifier.Start()
 	}
@@ -345,6 +387,7 @@ func (e *historyEngineImpl) Stop() {
 	for _, replicationTaskProcessor := range e.replicationTaskProcessors {
 		replicationTaskProcessor.Stop()
 	}
+	e.replicationDLQMerger.RemoveShard(e.shard.GetShardID())
 
 	if e.queueTaskProcessor != nil {
 		e.queueTaskProcessor.StopShardProcessor(e.shard)
@@ -541,6 +584,39 @@ func (e *historyEngineImpl) newDomainNotActiveError(
 	)
 }
 
+// checkConcurrentWorkflowsLimit rejects new workflows, child workflows and signal with start
+// included, once the domain has reached its configured number of open workflows. Open workflows
+// are counted with advanced visibility through the frontend. A failure to count does not block
+// the start, but is emitted as a metric.
+func (e *historyEngineImpl) checkConcurrentWorkflowsLimit(
+	ctx context.Context,
+	domainEntry *cache.DomainCacheEntry,
+	metricsScope int,
+) error {
+
+	limit := domainEntry.GetConfig().Limits.MaxConcurrentWorkflows
+	if limit <= 0 {
+		return nil
+	}
+
+	domainName := domainEntry.GetInfo().Name
+	scope := e.metricsClient.Scope(metricsScope, metrics.DomainTag(domainName))
+	resp, err := e.shard.GetService().GetFrontendClient().CountWorkflowExecutions(ctx, &types.CountWorkflowExecutionsRequest{
+		Domain: domainName,
+		Query:  "CloseTime = missing",
+	})
+	if err != nil {
+		scope.IncCounter(metrics.ConcurrentWorkflowsLimitCheckFailureCounter)
+		e.logger.Warn("Failed to count open workflows for domain limit.", tag.WorkflowDomainName(domainName), tag.Error(err))
+		return nil
+	}
+	if resp.GetCount() >= int64(limit) {
+		scope.IncCounter(metrics.ConcurrentWorkflowsLimitExceededCounter)
+		return errConcurrentWorkflowsLimitExceeded
+	}
+	return nil
+}
+
 func (e *historyEngineImpl) startWorkflowHelper(
 	ctx context.Context,
 	startRequest *types.HistoryStartWorkflowExecutionRequest,
@@ -560,6 +636,10 @@ func (e *historyEngineImpl) startWorkflowHelper(
 	}
 	e.overrideStartWorkflowExecutionRequest(domainEntry, request, metricsScope)
 
+	if err := e.checkConcurrentWorkflowsLimit(ctx, domainEntry, metricsScope); err != nil {
+		return nil, err
+	}
+
 	workflowID := request.GetWorkflowID()
 	domainID := domainEntry.GetInfo().ID
 
@@ -687,6 +767,11 @@ func (e *historyEngineImpl) startWorkflowHelper(
 		}
 
 		prevRunID = t.RunID
+		if shouldUseExistingWorkflow(startRequest, t.State) {
+			return &types.StartWorkflowExecutionResponse{
+				RunID: t.RunID,
+			}, nil
+		}
 		if shouldTerminateAndStart(startRequest, t.State) {
 			runningWFCtx, err := workflow.LoadOnce(ctx, e.executionCache, domainID, workflowID, prevRunID)
 			if err != nil {
@@ -747,8 +832,21 @@ func shouldTerminateAndStart(
 	startRequest *types.HistoryStartWorkflowExecutionRequest,
 	state int,
 ) bool {
-	return startRequest.StartRequest.GetWorkflowIDReusePolicy() == types.WorkflowIDReusePolicyTerminateIfRunning &&
-		(state == persistence.WorkflowStateRunning || state == persistence.WorkflowStateCreated)
+	terminateIfRunning := startRequest.StartRequest.GetWorkflowIDReusePolicy() == types.WorkflowIDReusePolicyTerminateIfRunning ||
+		startRequest.StartRequest.GetWorkflowIDConflictPolicy() == types.WorkflowIDConflictPolicyTerminateExisting
+	return terminateIfRunning && isWorkflowRunningState(state)
+}
+
+func shouldUseExistingWorkflow(
+	startRequest *types.HistoryStartWorkflowExecutionRequest,
+	state int,
+) bool {
+	return startRequest.StartRequest.GetWorkflowIDConflictPolicy() == types.WorkflowIDConflictPolicyUseExisting &&
+		isWorkflowRunningState(state)
+}
+
+func isWorkflowRunningState(state int) bool {
+	return state == persistence.WorkflowStateRunning || state == persistence.WorkflowStateCreated
 }
 
 // terminate running workflow then start a new run in one transaction
@@ -866,6 +964,9 @@ func (e *historyEngineImpl) addStartEventsAndTasks(
 		if err != nil {
 			return &types.InternalServiceError{Message: "Failed to add workflow execution signaled event."}
 		}
+		if requestID := sRequest.GetRequestID(); requestID != "" {
+			mutableState.AddSignalRequested(requestID)
+		}
 	}
 
 	// Generate first decision task event if not child WF and no first decision task backoff
@@ -1593,6 +1694,16 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 		result.PendingDecision = pendingDecision
 	}
 
+	for requestID, requestedTimestamp := range executionInfo.SignalRequestedTimestamps {
+		if !mutableState.IsSignalRequested(requestID) {
+			continue
+		}
+		if result.SignalRequestIDs == nil {
+			result.SignalRequestIDs = make(map[string]int64)
+		}
+		result.SignalRequestIDs[requestID] = requestedTimestamp
+	}
+
 	return result, nil
 }
 
@@ -1623,6 +1734,11 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return workflow.ErrNotExists
 			}
 
+			// activity will be redispatched when workflow is unpaused
+			if mutableState.IsWorkflowPaused() {
+				return workflow.ErrWorkflowPaused
+			}
+
 			scheduleID := request.GetScheduleID()
 			requestID := request.GetRequestID()
 			ai, isRunning := mutableState.GetActivityInfo(scheduleID)
@@ -1676,6 +1792,11 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return &types.EventAlreadyStartedError{Message: "Activity task already started."}
 			}
 
+			// activity will be redispatched when it is unpaused
+			if ai.Paused {
+				return workflow.ErrActivityPaused
+			}
+
 			if _, err := mutableState.AddActivityTaskStartedEvent(
 				ai, scheduleID, requestID, request.PollRequest.GetIdentity(),
 			); err != nil {
@@ -2126,246 +2247,945 @@ func (e *historyEngineImpl) RequestCancelWorkflowExecution(
 		})
 }
 
-func (e *historyEngineImpl) SignalWorkflowExecution(
+func (e *historyEngineImpl) PauseWorkflowExecution(
 	ctx context.Context,
-	signalRequest *types.HistorySignalWorkflowExecutionRequest,
+	req *types.HistoryPauseWorkflowExecutionRequest,
 ) error {
 
-	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(signalRequest.DomainUUID)
+	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(req.DomainUUID)
 	if err != nil {
 		return err
 	}
-	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
-		return errDomainDeprecated
-	}
 	domainID := domainEntry.GetInfo().ID
 
-	request := signalRequest.SignalRequest
-	parentExecution := signalRequest.ExternalWorkflowExecution
-	childWorkflowOnly := signalRequest.GetChildWorkflowOnly()
+	request := req.PauseRequest
 	workflowExecution := types.WorkflowExecution{
 		WorkflowID: request.WorkflowExecution.WorkflowID,
 		RunID:      request.WorkflowExecution.RunID,
 	}
 
-	return workflow.UpdateCurrentWithActionFunc(
-		ctx,
-		e.executionCache,
-		e.executionManager,
-		domainID,
-		workflowExecution,
-		e.timeSource.Now(),
+	return workflow.UpdateCurrentWithActionFunc(ctx, e.executionCache, e.executionManager, domainID, workflowExecution, e.timeSource.Now(),
 		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
-			// first deduplicate by request id for signal decision
-			// this is done before workflow running check so that already completed error
-			// won't be returned for duplicated signals even if the workflow is closed.
-			if requestID := request.GetRequestID(); requestID != "" {
-				if mutableState.IsSignalRequested(requestID) {
-					return &workflow.UpdateAction{
-						Noop:           true,
-						CreateDecision: false,
-					}, nil
-				}
-			}
-
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return nil, workflow.ErrAlreadyCompleted
 			}
 
-			executionInfo := mutableState.GetExecutionInfo()
-			createDecisionTask := true
-			// Do not create decision task when the workflow is cron and the cron has not been started yet
-			if mutableState.GetExecutionInfo().CronSchedule != "" && !mutableState.HasProcessedOrPendingDecision() {
-				createDecisionTask = false
-			}
-
-			maxAllowedSignals := e.config.MaximumSignalsPerExecution(domainEntry.GetInfo().Name)
-			if maxAllowedSignals > 0 && int(executionInfo.SignalCount) >= maxAllowedSignals {
-				e.logger.Info("Execution limit reached for maximum signals", tag.WorkflowSignalCount(executionInfo.SignalCount),
-					tag.WorkflowID(workflowExecution.GetWorkflowID()),
-					tag.WorkflowRunID(workflowExecution.GetRunID()),
-					tag.WorkflowDomainID(domainID))
-				return nil, workflow.ErrSignalsLimitExceeded
-			}
-
-			if childWorkflowOnly {
-				parentWorkflowID := executionInfo.ParentWorkflowID
-				parentRunID := executionInfo.ParentRunID
-				if parentExecution.GetWorkflowID() != parentWorkflowID ||
-					parentExecution.GetRunID() != parentRunID {
-					return nil, workflow.ErrParentMismatch
-				}
-			}
-
-			if requestID := request.GetRequestID(); requestID != "" {
-				mutableState.AddSignalRequested(requestID)
+			if mutableState.IsWorkflowPaused() {
+				return nil, workflow.ErrWorkflowAlreadyPaused
 			}
 
-			if _, err := mutableState.AddWorkflowExecutionSignaled(
-				request.GetSignalName(),
-				request.GetInput(),
-				request.GetIdentity()); err != nil {
-				return nil, &types.InternalServiceError{Message: "Unable to signal workflow execution."}
+			// decision, activity and timer tasks generated while paused are dropped by the task executors,
+			// they will be regenerated from mutable state when the workflow is unpaused
+			if _, err := mutableState.AddWorkflowExecutionPausedEvent(request); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to pause workflow execution."}
 			}
 
-			return &workflow.UpdateAction{
-				Noop:           false,
-				CreateDecision: createDecisionTask,
-			}, nil
+			return workflow.UpdateWithoutDecision, nil
 		})
 }
 
-func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
+func (e *historyEngineImpl) UnpauseWorkflowExecution(
 	ctx context.Context,
-	signalWithStartRequest *types.HistorySignalWithStartWorkflowExecutionRequest,
-) (retResp *types.StartWorkflowExecutionResponse, retError error) {
+	req *types.HistoryUnpauseWorkflowExecutionRequest,
+) error {
 
-	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(signalWithStartRequest.DomainUUID)
+	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(req.DomainUUID)
 	if err != nil {
-		return nil, err
-	}
-	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
-		return nil, errDomainDeprecated
+		return err
 	}
 	domainID := domainEntry.GetInfo().ID
 
-	sRequest := signalWithStartRequest.SignalWithStartRequest
+	request := req.UnpauseRequest
 	workflowExecution := types.WorkflowExecution{
-		WorkflowID: sRequest.WorkflowID,
+		WorkflowID: request.WorkflowExecution.WorkflowID,
+		RunID:      request.WorkflowExecution.RunID,
 	}
 
-	var prevMutableState execution.MutableState
-	attempt := 0
-
-	wfContext, release, err0 := e.executionCache.GetOrCreateWorkflowExecution(ctx, domainID, workflowExecution)
-
-	if err0 == nil {
-		defer func() { release(retError) }()
-	Just_Signal_Loop:
-		for ; attempt < workflow.ConditionalRetryCount; attempt++ {
-			// workflow not exist, will create workflow then signal
-			mutableState, err1 := wfContext.LoadWorkflowExecution(ctx)
-			if err1 != nil {
-				if _, ok := err1.(*types.EntityNotExistsError); ok {
-					break
-				}
-				return nil, err1
-			}
-			// workflow exist but not running, will restart workflow then signal
+	return workflow.UpdateCurrentWithActionFunc(ctx, e.executionCache, e.executionManager, domainID, workflowExecution, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
 			if !mutableState.IsWorkflowExecutionRunning() {
-				prevMutableState = mutableState
-				break
-			}
-			// workflow is running, if policy is TerminateIfRunning, terminate current run then signalWithStart
-			if sRequest.GetWorkflowIDReusePolicy() == types.WorkflowIDReusePolicyTerminateIfRunning {
-				workflowExecution.RunID = uuid.New()
-				runningWFCtx := workflow.NewContext(wfContext, release, mutableState)
-				resp, errTerm := e.terminateAndStartWorkflow(
-					ctx,
-					runningWFCtx,
-					workflowExecution,
-					domainEntry,
-					domainID,
-					nil,
-					signalWithStartRequest,
-				)
-				// By the time we try to terminate the workflow, it was already terminated
-				// So continue as if we didn't need to terminate it in the first place
-				if _, ok := errTerm.(*types.WorkflowExecutionAlreadyCompletedError); !ok {
-					return resp, errTerm
-				}
-			}
-
-			executionInfo := mutableState.GetExecutionInfo()
-			maxAllowedSignals := e.config.MaximumSignalsPerExecution(domainEntry.GetInfo().Name)
-			if maxAllowedSignals > 0 && int(executionInfo.SignalCount) >= maxAllowedSignals {
-				e.logger.Info("Execution limit reached for maximum signals", tag.WorkflowSignalCount(executionInfo.SignalCount),
-					tag.WorkflowID(workflowExecution.GetWorkflowID()),
-					tag.WorkflowRunID(workflowExecution.GetRunID()),
-					tag.WorkflowDomainID(domainID))
-				return nil, workflow.ErrSignalsLimitExceeded
+				return nil, workflow.ErrAlreadyCompleted
 			}
 
-			if _, err := mutableState.AddWorkflowExecutionSignaled(
-				sRequest.GetSignalName(),
-				sRequest.GetSignalInput(),
-				sRequest.GetIdentity()); err != nil {
-				return nil, &types.InternalServiceError{Message: "Unable to signal workflow execution."}
+			if !mutableState.IsWorkflowPaused() {
+				return nil, workflow.ErrWorkflowNotPaused
 			}
 
-			// Create a transfer task to schedule a decision task
-			if !mutableState.HasPendingDecision() {
-				_, err := mutableState.AddDecisionTaskScheduledEvent(false)
-				if err != nil {
-					return nil, &types.InternalServiceError{Message: "Failed to add decision scheduled event."}
-				}
+			if _, err := mutableState.AddWorkflowExecutionUnpausedEvent(request); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to unpause workflow execution."}
 			}
 
-			// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict then reload
-			// the history and try the operation again.
-			if err := wfContext.UpdateWorkflowExecutionAsActive(ctx, e.shard.GetTimeSource().Now()); err != nil {
-				if err == execution.ErrConflict {
-					continue Just_Signal_Loop
-				}
+			// regenerate tasks dropped while the workflow was paused
+			mutableStateTaskRefresher := execution.NewMutableStateTaskRefresher(
+				e.shard.GetConfig(),
+				e.shard.GetClusterMetadata(),
+				e.shard.GetDomainCache(),
+				e.shard.GetEventsCache(),
+				e.shard.GetLogger(),
+				e.shard.GetShardID(),
+			)
+			if err := mutableStateTaskRefresher.RefreshTasks(ctx, e.shard.GetTimeSource().Now(), mutableState); err != nil {
 				return nil, err
 			}
-			return &types.StartWorkflowExecutionResponse{RunID: wfContext.GetExecution().RunID}, nil
-		} // end for Just_Signal_Loop
-		if attempt == workflow.ConditionalRetryCount {
-			return nil, workflow.ErrMaxAttemptsExceeded
-		}
-	} else {
-		if _, ok := err0.(*types.EntityNotExistsError); !ok {
-			return nil, err0
-		}
-		// workflow not exist, will create workflow then signal
-	}
-
-	// Start workflow and signal
-	startRequest, err := getStartRequest(domainID, sRequest)
-	if err != nil {
-		return nil, err
-	}
 
-	sigWithStartArg := &signalWithStartArg{
-		signalWithStartRequest: signalWithStartRequest,
-		prevMutableState:       prevMutableState,
-	}
-	return e.startWorkflowHelper(
-		ctx,
-		startRequest,
-		domainEntry,
-		metrics.HistorySignalWithStartWorkflowExecutionScope,
-		sigWithStartArg,
-	)
+			return workflow.UpdateWithoutDecision, nil
+		})
 }
 
-// RemoveSignalMutableState remove the signal request id in signal_requested for deduplicate
-func (e *historyEngineImpl) RemoveSignalMutableState(
+func (e *historyEngineImpl) DeleteWorkflowExecution(
 	ctx context.Context,
-	request *types.RemoveSignalMutableStateRequest,
-) error {
+	req *types.HistoryDeleteWorkflowExecutionRequest,
+) (retError error) {
 
-	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(request.DomainUUID)
+	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(req.DomainUUID)
 	if err != nil {
 		return err
 	}
 	domainID := domainEntry.GetInfo().ID
 
-	workflowExecution := types.WorkflowExecution{
-		WorkflowID: request.WorkflowExecution.WorkflowID,
-		RunID:      request.WorkflowExecution.RunID,
+	request := req.DeleteRequest
+	deleteType := persistence.DeleteHistoryEventTypeSkipArchival
+	if request.GetArchive() {
+		clusterConfiguredForHistoryArchival := e.shard.GetService().GetArchivalMetadata().GetHistoryConfig().ClusterConfiguredForArchival()
+		domainConfiguredForHistoryArchival := domainEntry.GetConfig().HistoryArchivalStatus == types.ArchivalStatusEnabled
+		if !clusterConfiguredForHistoryArchival || !domainConfiguredForHistoryArchival {
+			return workflow.ErrHistoryArchivalNotEnabled
+		}
+		deleteType = persistence.DeleteHistoryEventTypeDefault
 	}
 
-	return workflow.UpdateWithAction(ctx, e.executionCache, domainID, workflowExecution, false, e.timeSource.Now(),
-		func(wfContext execution.Context, mutableState execution.MutableState) error {
-			if !mutableState.IsWorkflowExecutionRunning() {
-				return workflow.ErrNotExists
-			}
+	workflowContext, err := workflow.Load(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		request.WorkflowExecution.GetWorkflowID(),
+		request.WorkflowExecution.GetRunID(),
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { workflowContext.GetReleaseFn()(retError) }()
 
-			mutableState.DeleteSignalRequested(request.GetRequestID())
+	mutableState := workflowContext.GetMutableState()
+	if mutableState.IsWorkflowExecutionRunning() {
+		return workflow.ErrWorkflowStillRunning
+	}
 
-			return nil
+	lastWriteVersion, err := mutableState.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+	// the deletion itself is carried out by the timer queue, in the same way as retention based deletion
+	mutableState.AddTimerTasks(&persistence.DeleteHistoryEventTask{
+		// TaskID is set by shard
+		VisibilityTimestamp: e.timeSource.Now(),
+		Version:             lastWriteVersion,
+		TimeoutType:         deleteType,
+	})
+
+	// closed runs other than the current run must not touch the current execution record
+	updateMode := persistence.UpdateWorkflowModeBypassCurrent
+	resp, err := e.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowContext.GetWorkflowID(),
+	})
+	switch err.(type) {
+	case nil:
+		if resp.RunID == workflowContext.GetRunID() {
+			updateMode = persistence.UpdateWorkflowModeUpdateCurrent
+		}
+	case *types.EntityNotExistsError:
+		// no current execution record
+	default:
+		return err
+	}
+
+	return workflowContext.GetContext().UpdateWorkflowExecutionWithNew(
+		ctx,
+		e.timeSource.Now(),
+		updateMode,
+		nil, // no new workflow
+		nil, // no new workflow
+		execution.TransactionPolicyActive,
+		nil,
+	)
+}
+
+func (e *historyEngineImpl) PauseActivity(
+	ctx context.Context,
+	req *types.HistoryPauseActivityRequest,
+) error {
+
+	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(req.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := req.PauseRequest
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.WorkflowExecution.WorkflowID,
+		RunID:      request.WorkflowExecution.RunID,
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(ctx, e.executionCache, e.executionManager, domainID, workflowExecution, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			activityInfo, ok := mutableState.GetActivityByActivityID(request.GetActivityID())
+			if !ok {
+				return nil, workflow.ErrActivityTaskNotFound
+			}
+
+			if activityInfo.Paused {
+				return nil, workflow.ErrActivityAlreadyPaused
+			}
+
+			if err := mutableState.PauseActivity(activityInfo); err != nil {
+				return nil, err
+			}
+
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
+func (e *historyEngineImpl) UnpauseActivity(
+	ctx context.Context,
+	req *types.HistoryUnpauseActivityRequest,
+) error {
+
+	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(req.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := req.UnpauseRequest
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.WorkflowExecution.WorkflowID,
+		RunID:      request.WorkflowExecution.RunID,
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(ctx, e.executionCache, e.executionManager, domainID, workflowExecution, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			activityInfo, ok := mutableState.GetActivityByActivityID(request.GetActivityID())
+			if !ok {
+				return nil, workflow.ErrActivityTaskNotFound
+			}
+
+			if !activityInfo.Paused {
+				return nil, workflow.ErrActivityNotPaused
+			}
+
+			if err := mutableState.UnpauseActivity(activityInfo); err != nil {
+				return nil, err
+			}
+
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
+func (e *historyEngineImpl) ResetActivity(
+	ctx context.Context,
+	req *types.HistoryResetActivityRequest,
+) error {
+
+	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(req.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := req.ResetRequest
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.WorkflowExecution.WorkflowID,
+		RunID:      request.WorkflowExecution.RunID,
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(ctx, e.executionCache, e.executionManager, domainID, workflowExecution, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			activityInfo, ok := mutableState.GetActivityByActivityID(request.GetActivityID())
+			if !ok {
+				return nil, workflow.ErrActivityTaskNotFound
+			}
+
+			if err := mutableState.ResetActivity(activityInfo, request.GetRetryNow()); err != nil {
+				return nil, err
+			}
+
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
+func (e *historyEngineImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	req *types.HistoryUpdateWorkflowExecutionRequest,
+) (*types.HistoryUpdateWorkflowExecutionResponse, error) {
+
+	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(req.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := req.Request
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.WorkflowExecution.WorkflowID,
+		RunID:      request.WorkflowExecution.RunID,
+	}
+
+	// the update is accepted into history and buffered in the registry of the cached mutable state,
+	// the decision task which completes it will set its termination state once the result is persisted.
+	// the update ID is derived from the request ID so that a retried request is deduplicated
+	// against the updates accepted in mutable state instead of being accepted twice
+	updateID := request.GetRequestID()
+	if updateID == "" {
+		updateID = uuid.New()
+	}
+	var updateReg update.Registry
+	var termCh <-chan struct{}
+	completedEventBatchID := common.EmptyEventID
+	var branchToken []byte
+	err = workflow.UpdateCurrentWithActionFunc(ctx, e.executionCache, e.executionManager, domainID, workflowExecution, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if batchID, ok := mutableState.GetAcceptedUpdate(updateID); ok && batchID != common.EmptyEventID {
+				// the update has been completed, its result is read back from history
+				currentBranchToken, err := mutableState.GetCurrentBranchToken()
+				if err != nil {
+					return nil, err
+				}
+				completedEventBatchID = batchID
+				branchToken = currentBranchToken
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			if mutableState.IsWorkflowPaused() {
+				return nil, workflow.ErrWorkflowPaused
+			}
+
+			action := &workflow.UpdateAction{Noop: true}
+			if _, ok := mutableState.GetAcceptedUpdate(updateID); !ok {
+				if _, err := mutableState.AddWorkflowExecutionUpdateAcceptedEvent(updateID, request); err != nil {
+					return nil, &types.InternalServiceError{Message: "Unable to update workflow execution."}
+				}
+				action = workflow.UpdateWithNewDecision
+			}
+
+			// a retried request waits on the update it accepted before, the registry is rebuilt
+			// empty when mutable state is reloaded so the update may have to be buffered again
+			updateReg = mutableState.GetUpdateRegistry()
+			termCh = updateReg.BufferUpdate(updateID)
+			return action, nil
+		})
+	if updateReg != nil {
+		defer updateReg.RemoveUpdate(updateID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if completedEventBatchID != common.EmptyEventID {
+		return e.getCompletedUpdateResponse(ctx, updateID, completedEventBatchID, branchToken)
+	}
+
+	select {
+	case <-termCh:
+		state, err := updateReg.GetTerminationState(updateID)
+		if err != nil {
+			return nil, err
+		}
+		switch state.TerminationType {
+		case update.TerminationTypeCompleted:
+			return &types.HistoryUpdateWorkflowExecutionResponse{
+				Response: &types.UpdateWorkflowExecutionResponse{
+					Result:       state.Result,
+					ErrorMessage: state.ErrorMessage,
+				},
+			}, nil
+		case update.TerminationTypeFailed:
+			return nil, state.Failure
+		default:
+			return nil, workflow.ErrUpdateEnteredInvalidState
+		}
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (e *historyEngineImpl) getCompletedUpdateResponse(
+	ctx context.Context,
+	updateID string,
+	completedEventBatchID int64,
+	branchToken []byte,
+) (*types.HistoryUpdateWorkflowExecutionResponse, error) {
+
+	response, err := e.historyV2Mgr.ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  completedEventBatchID,
+		MaxEventID:  completedEventBatchID + 1,
+		PageSize:    1,
+		ShardID:     common.IntPtr(e.shard.GetShardID()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range response.HistoryEvents {
+		attributes := event.WorkflowExecutionUpdateCompletedEventAttributes
+		if event.GetEventType() == types.EventTypeWorkflowExecutionUpdateCompleted && attributes.GetUpdateID() == updateID {
+			return &types.HistoryUpdateWorkflowExecutionResponse{
+				Response: &types.UpdateWorkflowExecutionResponse{
+					Result:       attributes.Result,
+					ErrorMessage: attributes.GetErrorMessage(),
+				},
+			}, nil
+		}
+	}
+	return nil, &types.InternalServiceError{Message: fmt.Sprintf("Completed event of update %v not found.", updateID)}
+}
+
+// MigrateWorkflowExecution moves a workflow execution from a cluster the domain is not replicated to into this cluster.
+// The source execution is terminated first with a link to its new home, then its history up to the terminate event is
+// copied with every event rebased onto the failover version of the target domain, so the copy is owned by this cluster.
+// A source terminated by an earlier attempt of the same migration is resumed instead of rejected and copying skips the
+// events already applied, so the request can be retried until it succeeds.
+func (e *historyEngineImpl) MigrateWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryMigrateWorkflowExecutionRequest,
+) (*types.MigrateWorkflowExecutionResponse, error) {
+
+	if e.nDCReplicator == nil {
+		return nil, errMigrationNotSupported
+	}
+	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+	domainID := domainEntry.GetInfo().ID
+	sourceCluster := request.GetSourceCluster()
+	sourceDomain := request.GetSourceDomain()
+	if sourceDomain == "" {
+		sourceDomain = domainEntry.GetInfo().Name
+	}
+	sourceClient := e.shard.GetService().GetClientBean().GetRemoteFrontendClient(sourceCluster)
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.GetWorkflowID(),
+		RunID:      request.GetRunID(),
+	}
+	describeResp, err := sourceClient.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    sourceDomain,
+		Execution: &workflowExecution,
+	})
+	if err != nil {
+		return nil, err
+	}
+	workflowExecution.RunID = describeResp.GetWorkflowExecutionInfo().GetExecution().GetRunID()
+	link := migrationLink{
+		Cluster:    e.currentClusterName,
+		Domain:     domainEntry.GetInfo().Name,
+		WorkflowID: workflowExecution.GetWorkflowID(),
+		RunID:      workflowExecution.GetRunID(),
+	}
+
+	if describeResp.GetWorkflowExecutionInfo().CloseStatus == nil {
+		if err := e.checkMigrationTarget(ctx, domainID, workflowExecution); err != nil {
+			return nil, err
+		}
+		details, err := json.Marshal(link)
+		if err != nil {
+			return nil, err
+		}
+		err = sourceClient.TerminateWorkflowExecution(ctx, &types.TerminateWorkflowExecutionRequest{
+			Domain:            sourceDomain,
+			WorkflowExecution: &workflowExecution,
+			Reason:            migrationTerminateReason,
+			Details:           details,
+			Identity:          migrationIdentity,
+		})
+		// the source may have closed in the meantime, the close event tells whether this migration closed it
+		if _, ok := err.(*types.WorkflowExecutionAlreadyCompletedError); err != nil && !ok {
+			return nil, err
+		}
+	}
+
+	closeEvent, err := getMigrationSourceCloseEvent(ctx, sourceClient, sourceDomain, workflowExecution)
+	if err != nil {
+		return nil, err
+	}
+	if !isMigrationCloseEvent(closeEvent, link) {
+		return nil, errMigrationSourceClosed
+	}
+
+	version, err := e.getMigrationVersion(ctx, domainEntry, workflowExecution)
+	if err != nil {
+		return nil, err
+	}
+	serializer := e.shard.GetService().GetPayloadSerializer()
+	resender := cndc.NewHistoryResender(
+		e.shard.GetDomainCache(),
+		&migrationSourceClient{
+			Client:       e.shard.GetService().GetClientBean().GetRemoteAdminClient(sourceCluster),
+			sourceDomain: sourceDomain,
+		},
+		func(ctx context.Context, request *types.ReplicateEventsV2Request) error {
+			rebasedRequest, err := rebaseMigratedEvents(serializer, request, closeEvent.EventID, version)
+			if err != nil || rebasedRequest == nil {
+				return err
+			}
+			return e.nDCReplicator.ApplyEvents(ctx, rebasedRequest)
+		},
+		serializer,
+		nil,
+		nil,
+		e.logger,
+	)
+	if err := resender.SendSingleWorkflowHistory(
+		domainID,
+		workflowExecution.GetWorkflowID(),
+		workflowExecution.GetRunID(),
+		nil,
+		nil,
+		common.Int64Ptr(closeEvent.EventID),
+		common.Int64Ptr(closeEvent.Version),
+	); err != nil {
+		return nil, err
+	}
+
+	// a decision the source had in flight is failed by its termination, so the copy may be left without one
+	var migratedState execution.MutableState
+	err = workflow.UpdateWithActionFunc(ctx, e.executionCache, domainID, workflowExecution, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			migratedState = mutableState
+			if mutableState.GetNextEventID() < closeEvent.EventID {
+				return nil, &types.InternalServiceError{
+					Message: fmt.Sprintf("Migrated history has %v events while the source closed at event %v.", mutableState.GetNextEventID()-1, closeEvent.EventID),
+				}
+			}
+			if !mutableState.IsWorkflowExecutionRunning() || mutableState.IsWorkflowPaused() || mutableState.HasPendingDecision() {
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+			return workflow.UpdateWithNewDecision, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	e.logger.Info("Migrated workflow execution.",
+		tag.WorkflowDomainID(domainID),
+		tag.WorkflowID(workflowExecution.GetWorkflowID()),
+		tag.WorkflowRunID(workflowExecution.GetRunID()),
+		tag.SourceCluster(sourceCluster),
+		tag.WorkflowNextEventID(migratedState.GetNextEventID()),
+	)
+	return &types.MigrateWorkflowExecutionResponse{
+		RunID:       workflowExecution.GetRunID(),
+		NextEventID: migratedState.GetNextEventID(),
+	}, nil
+}
+
+// checkMigrationTarget rejects a migration that would replace another run still open in the target domain
+func (e *historyEngineImpl) checkMigrationTarget(
+	ctx context.Context,
+	domainID string,
+	workflowExecution types.WorkflowExecution,
+) error {
+
+	resp, err := e.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowExecution.GetWorkflowID(),
+	})
+	switch err.(type) {
+	case nil:
+		if resp.RunID != workflowExecution.GetRunID() && resp.State != persistence.WorkflowStateCompleted {
+			return &types.WorkflowExecutionAlreadyStartedError{
+				Message:        "Workflow execution is already running in the target domain.",
+				StartRequestID: resp.StartRequestID,
+				RunID:          resp.RunID,
+			}
+		}
+		return nil
+	case *types.EntityNotExistsError:
+		return nil
+	default:
+		return err
+	}
+}
+
+// getMigrationVersion returns the version the copied events are rebased onto, which is the failover version
+// of the target domain unless an earlier attempt already started the copy with another one
+func (e *historyEngineImpl) getMigrationVersion(
+	ctx context.Context,
+	domainEntry *cache.DomainCacheEntry,
+	workflowExecution types.WorkflowExecution,
+) (int64, error) {
+
+	wfContext, err := workflow.LoadOnce(
+		ctx,
+		e.executionCache,
+		domainEntry.GetInfo().ID,
+		workflowExecution.GetWorkflowID(),
+		workflowExecution.GetRunID(),
+	)
+	switch err.(type) {
+	case nil:
+		defer wfContext.GetReleaseFn()(nil)
+		return wfContext.GetMutableState().GetStartVersion()
+	case *types.EntityNotExistsError:
+		return domainEntry.GetFailoverVersion(), nil
+	default:
+		return 0, err
+	}
+}
+
+func getMigrationSourceCloseEvent(
+	ctx context.Context,
+	sourceClient frontend.Client,
+	sourceDomain string,
+	workflowExecution types.WorkflowExecution,
+) (*types.HistoryEvent, error) {
+
+	resp, err := sourceClient.GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{
+		Domain:                 sourceDomain,
+		Execution:              &workflowExecution,
+		HistoryEventFilterType: types.HistoryEventFilterTypeCloseEvent.Ptr(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := resp.GetHistory().GetEvents()
+	if len(events) == 0 {
+		return nil, &types.InternalServiceError{Message: "Source workflow execution has no close event."}
+	}
+	return events[len(events)-1], nil
+}
+
+// isMigrationCloseEvent tells whether the source execution was closed by a migration to the given link
+func isMigrationCloseEvent(
+	closeEvent *types.HistoryEvent,
+	link migrationLink,
+) bool {
+
+	attributes := closeEvent.WorkflowExecutionTerminatedEventAttributes
+	if closeEvent.GetEventType() != types.EventTypeWorkflowExecutionTerminated || attributes == nil {
+		return false
+	}
+	if attributes.GetReason() != migrationTerminateReason || attributes.GetIdentity() != migrationIdentity {
+		return false
+	}
+	var closedBy migrationLink
+	if err := json.Unmarshal(attributes.Details, &closedBy); err != nil {
+		return false
+	}
+	return closedBy == link
+}
+
+// rebaseMigratedEvents rewrites a batch of events copied from the source cluster as if the target domain had written
+// it: every event takes the given version and the source close event is left out. It returns nil when nothing is left.
+func rebaseMigratedEvents(
+	serializer persistence.PayloadSerializer,
+	request *types.ReplicateEventsV2Request,
+	closeEventID int64,
+	version int64,
+) (*types.ReplicateEventsV2Request, error) {
+
+	blob := persistence.NewDataBlobFromInternal(request.Events)
+	events, err := serializer.DeserializeBatchEvents(blob)
+	if err != nil {
+		return nil, err
+	}
+	rebasedEvents := make([]*types.HistoryEvent, 0, len(events))
+	for _, event := range events {
+		if event.EventID >= closeEventID {
+			break
+		}
+		event.Version = version
+		rebasedEvents = append(rebasedEvents, event)
+	}
+	if len(rebasedEvents) == 0 {
+		return nil, nil
+	}
+	rebasedBlob, err := serializer.SerializeBatchEvents(rebasedEvents, blob.GetEncoding())
+	if err != nil {
+		return nil, err
+	}
+	return &types.ReplicateEventsV2Request{
+		DomainUUID:        request.DomainUUID,
+		WorkflowExecution: request.WorkflowExecution,
+		VersionHistoryItems: []*types.VersionHistoryItem{{
+			EventID: rebasedEvents[len(rebasedEvents)-1].EventID,
+			Version: version,
+		}},
+		Events: rebasedBlob.ToInternal(),
+	}, nil
+}
+
+func (e *historyEngineImpl) SignalWorkflowExecution(
+	ctx context.Context,
+	signalRequest *types.HistorySignalWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(signalRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
+		return errDomainDeprecated
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := signalRequest.SignalRequest
+	parentExecution := signalRequest.ExternalWorkflowExecution
+	childWorkflowOnly := signalRequest.GetChildWorkflowOnly()
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.WorkflowExecution.WorkflowID,
+		RunID:      request.WorkflowExecution.RunID,
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			// first deduplicate by request id for signal decision
+			// this is done before workflow running check so that already completed error
+			// won't be returned for duplicated signals even if the workflow is closed.
+			if requestID := request.GetRequestID(); requestID != "" {
+				if mutableState.IsSignalRequested(requestID) {
+					return &workflow.UpdateAction{
+						Noop:           true,
+						CreateDecision: false,
+					}, nil
+				}
+			}
+
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			executionInfo := mutableState.GetExecutionInfo()
+			createDecisionTask := true
+			// Do not create decision task when the workflow is cron and the cron has not been started yet
+			if mutableState.GetExecutionInfo().CronSchedule != "" && !mutableState.HasProcessedOrPendingDecision() {
+				createDecisionTask = false
+			}
+
+			maxAllowedSignals := e.config.MaximumSignalsPerExecution(domainEntry.GetInfo().Name)
+			if maxAllowedSignals > 0 && int(executionInfo.SignalCount) >= maxAllowedSignals {
+				e.logger.Info("Execution limit reached for maximum signals", tag.WorkflowSignalCount(executionInfo.SignalCount),
+					tag.WorkflowID(workflowExecution.GetWorkflowID()),
+					tag.WorkflowRunID(workflowExecution.GetRunID()),
+					tag.WorkflowDomainID(domainID))
+				return nil, workflow.ErrSignalsLimitExceeded
+			}
+
+			if childWorkflowOnly {
+				parentWorkflowID := executionInfo.ParentWorkflowID
+				parentRunID := executionInfo.ParentRunID
+				if parentExecution.GetWorkflowID() != parentWorkflowID ||
+					parentExecution.GetRunID() != parentRunID {
+					return nil, workflow.ErrParentMismatch
+				}
+			}
+
+			if requestID := request.GetRequestID(); requestID != "" {
+				mutableState.AddSignalRequested(requestID)
+			}
+
+			if _, err := mutableState.AddWorkflowExecutionSignaled(
+				request.GetSignalName(),
+				request.GetInput(),
+				request.GetIdentity()); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to signal workflow execution."}
+			}
+
+			return &workflow.UpdateAction{
+				Noop:           false,
+				CreateDecision: createDecisionTask,
+			}, nil
+		})
+}
+
+func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	signalWithStartRequest *types.HistorySignalWithStartWorkflowExecutionRequest,
+) (retResp *types.StartWorkflowExecutionResponse, retError error) {
+
+	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(signalWithStartRequest.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
+		return nil, errDomainDeprecated
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	sRequest := signalWithStartRequest.SignalWithStartRequest
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: sRequest.WorkflowID,
+	}
+
+	var prevMutableState execution.MutableState
+	attempt := 0
+
+	wfContext, release, err0 := e.executionCache.GetOrCreateWorkflowExecution(ctx, domainID, workflowExecution)
+
+	if err0 == nil {
+		defer func() { release(retError) }()
+	Just_Signal_Loop:
+		for ; attempt < workflow.ConditionalRetryCount; attempt++ {
+			// workflow not exist, will create workflow then signal
+			mutableState, err1 := wfContext.LoadWorkflowExecution(ctx)
+			if err1 != nil {
+				if _, ok := err1.(*types.EntityNotExistsError); ok {
+					break
+				}
+				return nil, err1
+			}
+			// workflow exist but not running, will restart workflow then signal
+			if !mutableState.IsWorkflowExecutionRunning() {
+				prevMutableState = mutableState
+				break
+			}
+			// workflow is running, if policy is TerminateIfRunning or TerminateExisting, terminate current run then signalWithStart
+			if sRequest.GetWorkflowIDReusePolicy() == types.WorkflowIDReusePolicyTerminateIfRunning ||
+				sRequest.GetWorkflowIDConflictPolicy() == types.WorkflowIDConflictPolicyTerminateExisting {
+				workflowExecution.RunID = uuid.New()
+				runningWFCtx := workflow.NewContext(wfContext, release, mutableState)
+				resp, errTerm := e.terminateAndStartWorkflow(
+					ctx,
+					runningWFCtx,
+					workflowExecution,
+					domainEntry,
+					domainID,
+					nil,
+					signalWithStartRequest,
+				)
+				// By the time we try to terminate the workflow, it was already terminated
+				// So continue as if we didn't need to terminate it in the first place
+				if _, ok := errTerm.(*types.WorkflowExecutionAlreadyCompletedError); !ok {
+					return resp, errTerm
+				}
+			}
+
+			// deduplicate by request id so that a retried signalWithStart won't signal the running workflow twice
+			requestID := sRequest.GetRequestID()
+			if requestID != "" && mutableState.IsSignalRequested(requestID) {
+				return &types.StartWorkflowExecutionResponse{RunID: wfContext.GetExecution().RunID}, nil
+			}
+
+			executionInfo := mutableState.GetExecutionInfo()
+			maxAllowedSignals := e.config.MaximumSignalsPerExecution(domainEntry.GetInfo().Name)
+			if maxAllowedSignals > 0 && int(executionInfo.SignalCount) >= maxAllowedSignals {
+				e.logger.Info("Execution limit reached for maximum signals", tag.WorkflowSignalCount(executionInfo.SignalCount),
+					tag.WorkflowID(workflowExecution.GetWorkflowID()),
+					tag.WorkflowRunID(workflowExecution.GetRunID()),
+					tag.WorkflowDomainID(domainID))
+				return nil, workflow.ErrSignalsLimitExceeded
+			}
+
+			if requestID != "" {
+				mutableState.AddSignalRequested(requestID)
+			}
+
+			if _, err := mutableState.AddWorkflowExecutionSignaled(
+				sRequest.GetSignalName(),
+				sRequest.GetSignalInput(),
+				sRequest.GetIdentity()); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to signal workflow execution."}
+			}
+
+			// Create a transfer task to schedule a decision task
+			if !mutableState.HasPendingDecision() {
+				_, err := mutableState.AddDecisionTaskScheduledEvent(false)
+				if err != nil {
+					return nil, &types.InternalServiceError{Message: "Failed to add decision scheduled event."}
+				}
+			}
+
+			// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict then reload
+			// the history and try the operation again.
+			if err := wfContext.UpdateWorkflowExecutionAsActive(ctx, e.shard.GetTimeSource().Now()); err != nil {
+				if err == execution.ErrConflict {
+					continue Just_Signal_Loop
+				}
+				return nil, err
+			}
+			return &types.StartWorkflowExecutionResponse{RunID: wfContext.GetExecution().RunID}, nil
+		} // end for Just_Signal_Loop
+		if attempt == workflow.ConditionalRetryCount {
+			return nil, workflow.ErrMaxAttemptsExceeded
+		}
+	} else {
+		if _, ok := err0.(*types.EntityNotExistsError); !ok {
+			return nil, err0
+		}
+		// workflow not exist, will create workflow then signal
+	}
+
+	// Start workflow and signal
+	startRequest, err := getStartRequest(domainID, sRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	sigWithStartArg := &signalWithStartArg{
+		signalWithStartRequest: signalWithStartRequest,
+		prevMutableState:       prevMutableState,
+	}
+	return e.startWorkflowHelper(
+		ctx,
+		startRequest,
+		domainEntry,
+		metrics.HistorySignalWithStartWorkflowExecutionScope,
+		sigWithStartArg,
+	)
+}
+
+// RemoveSignalMutableState remove the signal request id in signal_requested for deduplicate
+func (e *historyEngineImpl) RemoveSignalMutableState(
+	ctx context.Context,
+	request *types.RemoveSignalMutableStateRequest,
+) error {
+
+	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.WorkflowExecution.WorkflowID,
+		RunID:      request.WorkflowExecution.RunID,
+	}
+
+	return workflow.UpdateWithAction(ctx, e.executionCache, domainID, workflowExecution, false, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) error {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return workflow.ErrNotExists
+			}
+
+			mutableState.DeleteSignalRequested(request.GetRequestID())
+
+			return nil
 		})
 }
 
@@ -2896,6 +3716,7 @@ func getStartRequest(
 		SearchAttributes:                    request.SearchAttributes,
 		Header:                              request.Header,
 		DelayStartSeconds:                   request.DelayStartSeconds,
+		WorkflowIDConflictPolicy:            request.WorkflowIDConflictPolicy,
 	}
 
 	startRequest := common.CreateHistoryStartWorkflowRequest(domainID, req, time.Now())
@@ -3002,6 +3823,90 @@ func (e *historyEngineImpl) GetReplicationMessages(
 	return replicationMessages, nil
 }
 
+// DescribeReplicationStatus reports the replication progress of this shard against every enabled remote
+// cluster, or only against clusterName if it is not empty
+func (e *historyEngineImpl) DescribeReplicationStatus(
+	ctx context.Context,
+	clusterName string,
+) ([]*types.ReplicationShardStatus, error) {
+
+	clusterMetadata := e.shard.GetClusterMetadata()
+	inboundStatus := make(map[string]replication.Status)
+	for _, processor := range e.replicationTaskProcessors {
+		inboundStatus[processor.GetSourceCluster()] = processor.GetStatus()
+	}
+
+	var remoteClusters []string
+	for name, info := range clusterMetadata.GetAllClusterInfo() {
+		if !info.Enabled || name == e.currentClusterName {
+			continue
+		}
+		if clusterName != "" && name != clusterName {
+			continue
+		}
+		remoteClusters = append(remoteClusters, name)
+	}
+	sort.Strings(remoteClusters)
+
+	shardID := int32(e.shard.GetShardID())
+	maxTaskID := e.shard.GetTransferMaxReadLevel()
+	result := make([]*types.ReplicationShardStatus, 0, len(remoteClusters))
+	for _, name := range remoteClusters {
+		outbound := e.replicationAckManager.GetStatus(name)
+		inbound, ok := inboundStatus[name]
+		if !ok {
+			inbound = replication.Status{
+				FetchedTaskID: common.EmptyMessageID,
+				AckedTaskID:   common.EmptyMessageID,
+			}
+		}
+		dlqSize, err := e.replicationDLQHandler.GetMessageCount(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &types.ReplicationShardStatus{
+			ShardID:               shardID,
+			ClusterName:           name,
+			MaxTaskID:             maxTaskID,
+			OutboundFetchedTaskID: outbound.FetchedTaskID,
+			OutboundAckedTaskID:   outbound.AckedTaskID,
+			OutboundLastFetchTime: timeToUnixNanoPtr(outbound.LastFetchTime),
+			InboundFetchedTaskID:  inbound.FetchedTaskID,
+			InboundAckedTaskID:    inbound.AckedTaskID,
+			InboundLastFetchTime:  timeToUnixNanoPtr(inbound.LastFetchTime),
+			InboundDLQSize:        dlqSize,
+			CrossClusterTaskLag:   e.getCrossClusterTaskLag(name, maxTaskID),
+		})
+	}
+	return result, nil
+}
+
+func (e *historyEngineImpl) getCrossClusterTaskLag(
+	clusterName string,
+	maxTaskID int64,
+) int64 {
+
+	if e.crossClusterProcessor == nil {
+		// TODO: remove this check when crossClusterProcessor is wired up and initialized
+		return 0
+	}
+
+	lag := int64(0)
+	for _, state := range e.shard.GetCrossClusterProcessingQueueStates(clusterName) {
+		if maxTaskID-state.GetAckLevel() > lag {
+			lag = maxTaskID - state.GetAckLevel()
+		}
+	}
+	return lag
+}
+
+func timeToUnixNanoPtr(t time.Time) *int64 {
+	if t.IsZero() {
+		return nil
+	}
+	return common.Int64Ptr(t.UnixNano())
+}
+
 func (e *historyEngineImpl) GetDLQReplicationMessages(
 	ctx context.Context,
 	taskInfos []*types.ReplicationTaskInfo,
@@ -3225,6 +4130,87 @@ func (e *historyEngineImpl) MergeDLQMessages(
 	}, nil
 }
 
+func (e *historyEngineImpl) ReadHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.ReadHistoryTaskDLQRequest,
+) (*types.ReadHistoryTaskDLQResponse, error) {
+
+	exclusiveBeginTaskID, inclusiveEndTaskID := getHistoryTaskDLQRange(
+		request.ExclusiveBeginTaskID,
+		request.InclusiveEndTaskID,
+	)
+	entries, token, err := e.historyTaskDLQHandler.ReadTasks(
+		ctx,
+		exclusiveBeginTaskID,
+		inclusiveEndTaskID,
+		int(request.GetMaximumPageSize()),
+		request.GetNextPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ReadHistoryTaskDLQResponse{
+		Entries:       entries,
+		NextPageToken: token,
+	}, nil
+}
+
+func (e *historyEngineImpl) PurgeHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.PurgeHistoryTaskDLQRequest,
+) error {
+
+	exclusiveBeginTaskID, inclusiveEndTaskID := getHistoryTaskDLQRange(
+		request.ExclusiveBeginTaskID,
+		request.InclusiveEndTaskID,
+	)
+	return e.historyTaskDLQHandler.PurgeTasks(
+		ctx,
+		exclusiveBeginTaskID,
+		inclusiveEndTaskID,
+	)
+}
+
+func (e *historyEngineImpl) RetryHistoryTaskDLQ(
+	ctx context.Context,
+	request *types.RetryHistoryTaskDLQRequest,
+) (*types.RetryHistoryTaskDLQResponse, error) {
+
+	exclusiveBeginTaskID, inclusiveEndTaskID := getHistoryTaskDLQRange(
+		request.ExclusiveBeginTaskID,
+		request.InclusiveEndTaskID,
+	)
+	token, err := e.historyTaskDLQHandler.RetryTasks(
+		ctx,
+		exclusiveBeginTaskID,
+		inclusiveEndTaskID,
+		int(request.GetMaximumPageSize()),
+		request.GetNextPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RetryHistoryTaskDLQResponse{
+		NextPageToken: token,
+	}, nil
+}
+
+func getHistoryTaskDLQRange(
+	exclusiveBeginTaskID *int64,
+	inclusiveEndTaskID *int64,
+) (int64, int64) {
+
+	begin := int64(-1)
+	if exclusiveBeginTaskID != nil {
+		begin = *exclusiveBeginTaskID
+	}
+	end := int64(math.MaxInt64)
+	if inclusiveEndTaskID != nil {
+		end = *inclusiveEndTaskID
+	}
+	return begin, end
+}
+
 func (e *historyEngineImpl) RefreshWorkflowTasks(
 	ctx context.Context,
 	domainUUID string,
@@ -3318,3 +4304,15 @@ func (e *historyEngineImpl) newChildContext(
 	}
 	return context.WithTimeout(context.Background(), ctxTimeout)
 }
+
+// GetWorkflowExecutionRawHistoryV2 reads the source history under the name the domain has in the source cluster
+func (c *migrationSourceClient) GetWorkflowExecutionRawHistoryV2(
+	ctx context.Context,
+	request *types.GetWorkflowExecutionRawHistoryV2Request,
+	opts ...yarpc.CallOption,
+) (*types.GetWorkflowExecutionRawHistoryV2Response, error) {
+
+	sourceRequest := *request
+	sourceRequest.Domain = c.sourceDomain
+	return c.Client.GetWorkflowExecutionRawHistoryV2(ctx, &sourceRequest, opts...)
+}
@@ -219,6 +219,7 @@ func (s *engine2Suite) TestRecordDecisionTaskStartedSuccessStickyExpired() {
 	expectedResponse.StartedEventID = di.ScheduleID + 1
 	expectedResponse.StickyExecutionEnabled = false
 	expectedResponse.NextEventID = msBuilder.GetNextEventID() + 1
+	expectedResponse.HistoryCount = msBuilder.GetNextEventID()
 	expectedResponse.Attempt = di.Attempt
 	expectedResponse.WorkflowExecutionTaskList = &types.TaskList{
 		Name: executionInfo.TaskList,
@@ -292,6 +293,7 @@ func (s *engine2Suite) TestRecordDecisionTaskStartedSuccessStickyEnabled() {
 	expectedResponse.StartedEventID = di.ScheduleID + 1
 	expectedResponse.StickyExecutionEnabled = true
 	expectedResponse.NextEventID = msBuilder.GetNextEventID() + 1
+	expectedResponse.HistoryCount = msBuilder.GetNextEventID()
 	expectedResponse.Attempt = di.Attempt
 	expectedResponse.WorkflowExecutionTaskList = &types.TaskList{
 		Name: executionInfo.TaskList,
@@ -940,6 +942,60 @@ func (s *engine2Suite) TestStartWorkflowExecution_BrandNew() {
 	s.NotNil(resp.RunID)
 }
 
+func (s *engine2Suite) TestStartWorkflowExecution_ConcurrentWorkflowsLimitExceeded() {
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&p.DomainInfo{ID: constants.TestDomainID, Name: constants.TestDomainName},
+		&p.DomainConfig{Limits: types.DomainLimits{MaxConcurrentWorkflows: 10}},
+		"",
+		nil,
+	)
+	s.mockShard.Resource.FrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), &types.CountWorkflowExecutionsRequest{
+		Domain: constants.TestDomainName,
+		Query:  "CloseTime = missing",
+	}).Return(&types.CountWorkflowExecutionsResponse{Count: 10}, nil).Times(1)
+
+	_, err := s.historyEngine.startWorkflowHelper(
+		context.Background(),
+		&types.HistoryStartWorkflowExecutionRequest{
+			DomainUUID: constants.TestDomainID,
+			StartRequest: &types.StartWorkflowExecutionRequest{
+				Domain:                              constants.TestDomainName,
+				WorkflowID:                          "workflowID",
+				WorkflowType:                        &types.WorkflowType{Name: "workflowType"},
+				TaskList:                            &types.TaskList{Name: "testTaskList"},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+				Identity:                            "testIdentity",
+				RequestID:                           uuid.New(),
+			},
+		},
+		domainEntry,
+		metrics.HistoryStartWorkflowExecutionScope,
+		nil,
+	)
+	s.Equal(errConcurrentWorkflowsLimitExceeded, err)
+}
+
+func (s *engine2Suite) TestCheckConcurrentWorkflowsLimit() {
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&p.DomainInfo{ID: constants.TestDomainID, Name: constants.TestDomainName},
+		&p.DomainConfig{Limits: types.DomainLimits{MaxConcurrentWorkflows: 10}},
+		"",
+		nil,
+	)
+	s.mockShard.Resource.FrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(&types.CountWorkflowExecutionsResponse{Count: 9}, nil).Times(1)
+	s.NoError(s.historyEngine.checkConcurrentWorkflowsLimit(context.Background(), domainEntry, metrics.HistoryStartWorkflowExecutionScope))
+
+	// a failure to count open workflows does not block the start
+	s.mockShard.Resource.FrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(nil, &types.InternalServiceError{Message: "some random error"}).Times(1)
+	s.NoError(s.historyEngine.checkConcurrentWorkflowsLimit(context.Background(), domainEntry, metrics.HistoryStartWorkflowExecutionScope))
+
+	// no limit configured
+	s.NoError(s.historyEngine.checkConcurrentWorkflowsLimit(context.Background(), constants.TestLocalDomainEntry, metrics.HistoryStartWorkflowExecutionScope))
+}
+
 func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_Dedup() {
 	domainID := constants.TestDomainID
 	workflowID := "workflowID"
@@ -1015,6 +1071,43 @@ func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_NonDeDup() {
 	s.Nil(resp)
 }
 
+func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_UseExisting() {
+	domainID := constants.TestDomainID
+	workflowID := "workflowID"
+	runID := "runID"
+	workflowType := "workflowType"
+	taskList := "testTaskList"
+	identity := "testIdentity"
+	lastWriteVersion := common.EmptyVersion
+
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything, mock.Anything).Return(nil, &p.WorkflowExecutionAlreadyStartedError{
+		Msg:              "random message",
+		StartRequestID:   "oldRequestID",
+		RunID:            runID,
+		State:            p.WorkflowStateRunning,
+		CloseStatus:      p.WorkflowCloseStatusNone,
+		LastWriteVersion: lastWriteVersion,
+	}).Once()
+
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &types.HistoryStartWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		StartRequest: &types.StartWorkflowExecutionRequest{
+			Domain:                              domainID,
+			WorkflowID:                          workflowID,
+			WorkflowType:                        &types.WorkflowType{Name: workflowType},
+			TaskList:                            &types.TaskList{Name: taskList},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            identity,
+			RequestID:                           "newRequestID",
+			WorkflowIDConflictPolicy:            types.WorkflowIDConflictPolicyUseExisting.Ptr(),
+		},
+	})
+	s.Nil(err)
+	s.Equal(runID, resp.GetRunID())
+}
+
 func (s *engine2Suite) TestStartWorkflowExecution_NotRunning_PrevSuccess() {
 	domainID := constants.TestDomainID
 	workflowID := "workflowID"
@@ -1216,6 +1309,97 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal() {
 	s.Equal(runID, resp.GetRunID())
 }
 
+func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal_DuplicateRequest() {
+	domainID := constants.TestDomainID
+	workflowID := "wId"
+	runID := constants.TestRunID
+	requestID := uuid.New()
+	sRequest := &types.HistorySignalWithStartWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		SignalWithStartRequest: &types.SignalWithStartWorkflowExecutionRequest{
+			Domain:     domainID,
+			WorkflowID: workflowID,
+			Identity:   "testIdentity",
+			SignalName: "my signal name",
+			Input:      []byte("test input"),
+			RequestID:  requestID,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.historyEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		runID,
+		constants.TestLocalDomainEntry,
+	)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	// assume duplicate request id
+	ms.SignalRequestedIDs = map[string]struct{}{requestID: {}}
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &p.GetCurrentExecutionResponse{RunID: runID}
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
+	s.Nil(err)
+	s.Equal(runID, resp.GetRunID())
+}
+
+func (s *engine2Suite) TestSignalWithStartWorkflowExecution_TerminateExisting() {
+	domainID := constants.TestDomainID
+	workflowID := "wId"
+	runID := constants.TestRunID
+	identity := "testIdentity"
+	signalName := "my signal name"
+	input := []byte("test input")
+	sRequest := &types.HistorySignalWithStartWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		SignalWithStartRequest: &types.SignalWithStartWorkflowExecutionRequest{
+			Domain:                              domainID,
+			WorkflowID:                          workflowID,
+			WorkflowType:                        &types.WorkflowType{Name: "workflowType"},
+			TaskList:                            &types.TaskList{Name: "testTaskList"},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            identity,
+			SignalName:                          signalName,
+			Input:                               input,
+			RequestID:                           "newRequestID",
+			WorkflowIDConflictPolicy:            types.WorkflowIDConflictPolicyTerminateExisting.Ptr(),
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.historyEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		runID,
+		constants.TestLocalDomainEntry,
+	)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &p.GetCurrentExecutionResponse{RunID: runID}
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Twice()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		return request.UpdateWorkflowMutation.ExecutionInfo.State == p.WorkflowStateCompleted &&
+			request.UpdateWorkflowMutation.ExecutionInfo.CloseStatus == p.WorkflowCloseStatusTerminated &&
+			request.NewWorkflowSnapshot != nil
+	})).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+
+	s.mockEventsCache.EXPECT().GetEvent(
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+	).Return(&types.HistoryEvent{}, nil).Times(1)
+
+	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
+	s.Nil(err)
+	s.NotEqual(runID, resp.GetRunID())
+}
+
 func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotExist() {
 	sRequest := &types.HistorySignalWithStartWorkflowExecutionRequest{}
 	_, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
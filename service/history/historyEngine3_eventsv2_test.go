@@ -211,6 +211,7 @@ func (s *engine3Suite) TestRecordDecisionTaskStartedSuccessStickyEnabled() {
 	expectedResponse.StartedEventID = di.ScheduleID + 1
 	expectedResponse.StickyExecutionEnabled = true
 	expectedResponse.NextEventID = msBuilder.GetNextEventID() + 1
+	expectedResponse.HistoryCount = msBuilder.GetNextEventID()
 	expectedResponse.Attempt = di.Attempt
 	expectedResponse.WorkflowExecutionTaskList = &types.TaskList{
 		Name: executionInfo.TaskList,
@@ -40,6 +40,7 @@ import (
 	hclient "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/cache"
 	cc "github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
@@ -62,6 +63,7 @@ import (
 	"github.com/uber/cadence/service/history/reset"
 	"github.com/uber/cadence/service/history/shard"
 	test "github.com/uber/cadence/service/history/testing"
+	"github.com/uber/cadence/service/history/update"
 	"github.com/uber/cadence/service/history/workflow"
 )
 
@@ -1650,7 +1652,7 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedBadBinary() {
 		s.mockHistoryEngine.shard,
 		loggerimpl.NewLoggerForTest(s.Suite),
 		we.GetRunID(),
-		domainEntry,
+		constants.TestLocalDomainEntry,
 	)
 	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
 	di := test.AddDecisionTaskScheduledEvent(msBuilder)
@@ -4910,6 +4912,197 @@ func (s *engineSuite) TestCancelTimer_RespondDecisionTaskCompleted_TimerFired()
 	s.False(executionBuilder.HasBufferedEvents())
 }
 
+func (s *engineSuite) TestMigrateWorkflowExecution_SourceClosedElsewhere() {
+	s.mockHistoryEngine.nDCReplicator = &fakeHistoryReplicator{}
+	we := types.WorkflowExecution{WorkflowID: constants.TestWorkflowID, RunID: constants.TestRunID}
+	sourceFrontend := s.mockShard.Resource.RemoteFrontendClient
+	sourceFrontend.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{Execution: &we, CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr()},
+	}, nil).Times(1)
+	sourceFrontend.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: []*types.HistoryEvent{{
+			EventID:   5,
+			EventType: types.EventTypeWorkflowExecutionCompleted.Ptr(),
+		}}},
+	}, nil).Times(1)
+
+	_, err := s.mockHistoryEngine.MigrateWorkflowExecution(context.Background(), &types.HistoryMigrateWorkflowExecutionRequest{
+		DomainUUID:    constants.TestDomainID,
+		WorkflowID:    we.WorkflowID,
+		SourceCluster: cluster.TestAlternativeClusterName,
+	})
+	s.Equal(errMigrationSourceClosed, err)
+}
+
+func (s *engineSuite) TestMigrateWorkflowExecution() {
+	replicator := &fakeHistoryReplicator{}
+	s.mockHistoryEngine.nDCReplicator = replicator
+	we := types.WorkflowExecution{WorkflowID: constants.TestWorkflowID, RunID: constants.TestRunID}
+	sourceDomain := "source domain"
+	link := migrationLink{
+		Cluster:    cluster.TestCurrentClusterName,
+		Domain:     constants.TestDomainName,
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+	}
+	details, err := json.Marshal(link)
+	s.NoError(err)
+
+	sourceFrontend := s.mockShard.Resource.RemoteFrontendClient
+	sourceFrontend.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{Execution: &we},
+	}, nil).Times(1)
+	sourceFrontend.EXPECT().TerminateWorkflowExecution(gomock.Any(), &types.TerminateWorkflowExecutionRequest{
+		Domain:            sourceDomain,
+		WorkflowExecution: &we,
+		Reason:            migrationTerminateReason,
+		Details:           details,
+		Identity:          migrationIdentity,
+	}).Return(nil).Times(1)
+	sourceFrontend.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: []*types.HistoryEvent{s.newMigrationCloseEvent(5, details)}},
+	}, nil).Times(1)
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(nil, &types.EntityNotExistsError{}).Once()
+
+	// the terminate event shares its batch with the decision failed by the termination
+	serializer := s.mockShard.GetPayloadSerializer()
+	sourceBatches := [][]*types.HistoryEvent{
+		{{EventID: 1, Version: 7}, {EventID: 2, Version: 7}},
+		{{EventID: 3, Version: 7}, {EventID: 4, Version: 7}, s.newMigrationCloseEvent(5, details)},
+	}
+	var blobs []*types.DataBlob
+	for _, batch := range sourceBatches {
+		blob, err := serializer.SerializeBatchEvents(batch, common.EncodingTypeThriftRW)
+		s.NoError(err)
+		blobs = append(blobs, blob.ToInternal())
+	}
+	s.mockShard.Resource.RemoteAdminClient.EXPECT().GetWorkflowExecutionRawHistoryV2(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.GetWorkflowExecutionRawHistoryV2Request, _ ...interface{}) (*types.GetWorkflowExecutionRawHistoryV2Response, error) {
+			s.Equal(sourceDomain, request.Domain)
+			s.Equal(int64(5), request.GetEndEventID())
+			return &types.GetWorkflowExecutionRawHistoryV2Response{HistoryBatches: blobs}, nil
+		}).Times(1)
+
+	// the copy does not exist yet when its version is chosen, then has a pending decision
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 200, "identity")
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, "testTaskList", "identity")
+	di.StartedID = startedEvent.EventID
+	test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, di.StartedID, nil, "identity")
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(nil, &types.EntityNotExistsError{}).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&p.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+
+	resp, err := s.mockHistoryEngine.MigrateWorkflowExecution(context.Background(), &types.HistoryMigrateWorkflowExecutionRequest{
+		DomainUUID:    constants.TestDomainID,
+		WorkflowID:    we.WorkflowID,
+		SourceCluster: cluster.TestAlternativeClusterName,
+		SourceDomain:  sourceDomain,
+	})
+	s.NoError(err)
+	s.Equal(&types.MigrateWorkflowExecutionResponse{RunID: we.RunID, NextEventID: 6}, resp)
+
+	version := constants.TestLocalDomainEntry.GetFailoverVersion()
+	s.Len(replicator.requests, 2)
+	for i, lastEventID := range []int64{2, 4} {
+		request := replicator.requests[i]
+		s.Equal([]*types.VersionHistoryItem{{EventID: lastEventID, Version: version}}, request.VersionHistoryItems)
+		events, err := serializer.DeserializeBatchEvents(p.NewDataBlobFromInternal(request.Events))
+		s.NoError(err)
+		s.Equal(int64(2), int64(len(events)))
+		for _, event := range events {
+			s.Equal(version, event.Version)
+		}
+		s.Equal(lastEventID, events[len(events)-1].EventID)
+	}
+}
+
+func (s *engineSuite) TestMigrateWorkflowExecution_ResumeClosedSource() {
+	replicator := &fakeHistoryReplicator{}
+	s.mockHistoryEngine.nDCReplicator = replicator
+	we := types.WorkflowExecution{WorkflowID: constants.TestWorkflowID, RunID: constants.TestRunID}
+	details, err := json.Marshal(migrationLink{
+		Cluster:    cluster.TestCurrentClusterName,
+		Domain:     constants.TestDomainName,
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+	})
+	s.NoError(err)
+
+	// an earlier attempt terminated the source and copied part of its history
+	sourceFrontend := s.mockShard.Resource.RemoteFrontendClient
+	sourceFrontend.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{Execution: &we, CloseStatus: types.WorkflowExecutionCloseStatusTerminated.Ptr()},
+	}, nil).Times(1)
+	sourceFrontend.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: []*types.HistoryEvent{s.newMigrationCloseEvent(5, details)}},
+	}, nil).Times(1)
+	blob, err := s.mockShard.GetPayloadSerializer().SerializeBatchEvents(
+		[]*types.HistoryEvent{{EventID: 1, Version: 7}, {EventID: 2, Version: 7}, {EventID: 3, Version: 7}, {EventID: 4, Version: 7}},
+		common.EncodingTypeThriftRW,
+	)
+	s.NoError(err)
+	s.mockShard.Resource.RemoteAdminClient.EXPECT().GetWorkflowExecutionRawHistoryV2(gomock.Any(), gomock.Any()).Return(
+		&types.GetWorkflowExecutionRawHistoryV2Response{HistoryBatches: []*types.DataBlob{blob.ToInternal()}}, nil,
+	).Times(1)
+
+	// the decision the source had in flight completed before the termination, the copy needs a new one
+	startVersion := constants.TestLocalDomainEntry.GetFailoverVersion()
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 200, "identity")
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, "testTaskList", "identity")
+	di.StartedID = startedEvent.EventID
+	test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, di.StartedID, nil, "identity")
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	token, err := msBuilder.GetCurrentBranchToken()
+	s.NoError(err)
+	ms.VersionHistories = persistence.NewVersionHistories(persistence.NewVersionHistory(
+		token,
+		[]*persistence.VersionHistoryItem{persistence.NewVersionHistoryItem(4, startVersion)},
+	))
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&p.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	resp, err := s.mockHistoryEngine.MigrateWorkflowExecution(context.Background(), &types.HistoryMigrateWorkflowExecutionRequest{
+		DomainUUID:    constants.TestDomainID,
+		WorkflowID:    we.WorkflowID,
+		RunID:         we.RunID,
+		SourceCluster: cluster.TestAlternativeClusterName,
+	})
+	s.NoError(err)
+	s.Equal(&types.MigrateWorkflowExecutionResponse{RunID: we.RunID, NextEventID: 6}, resp)
+	s.Len(replicator.requests, 1)
+	s.Equal([]*types.VersionHistoryItem{{EventID: 4, Version: startVersion}}, replicator.requests[0].VersionHistoryItems)
+	s.True(s.getBuilder(constants.TestDomainID, we).HasPendingDecision())
+}
+
+func (s *engineSuite) newMigrationCloseEvent(eventID int64, details []byte) *types.HistoryEvent {
+	return &types.HistoryEvent{
+		EventID:   eventID,
+		Version:   7,
+		EventType: types.EventTypeWorkflowExecutionTerminated.Ptr(),
+		WorkflowExecutionTerminatedEventAttributes: &types.WorkflowExecutionTerminatedEventAttributes{
+			Reason:   migrationTerminateReason,
+			Details:  details,
+			Identity: migrationIdentity,
+		},
+	}
+}
+
 func (s *engineSuite) TestSignalWorkflowExecution_InvalidRequest() {
 	signalRequest := &types.HistorySignalWorkflowExecutionRequest{}
 	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
@@ -4956,26 +5149,20 @@ func (s *engineSuite) TestSignalWorkflowExecution() {
 	s.Nil(err)
 }
 
-// Test signal decision by adding request ID
-func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest_WorkflowOpen() {
+func (s *engineSuite) TestPauseWorkflowExecution() {
 	we := types.WorkflowExecution{
 		WorkflowID: constants.TestWorkflowID,
 		RunID:      constants.TestRunID,
 	}
 	tasklist := "testTaskList"
 	identity := "testIdentity"
-	signalName := "my signal name 2"
-	input := []byte("test input 2")
-	requestID := uuid.New()
-	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+	pauseRequest := &types.HistoryPauseWorkflowExecutionRequest{
 		DomainUUID: constants.TestDomainID,
-		SignalRequest: &types.SignalWorkflowExecutionRequest{
+		PauseRequest: &types.PauseWorkflowExecutionRequest{
 			Domain:            constants.TestDomainID,
 			WorkflowExecution: &we,
+			Reason:            "test reason",
 			Identity:          identity,
-			SignalName:        signalName,
-			Input:             input,
-			RequestID:         requestID,
 		},
 	}
 
@@ -4988,37 +5175,35 @@ func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest_WorkflowOpen(
 	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
 	test.AddDecisionTaskScheduledEvent(msBuilder)
 	ms := execution.CreatePersistenceMutableState(msBuilder)
-	// assume duplicate request id
-	ms.SignalRequestedIDs = make(map[string]struct{})
-	ms.SignalRequestedIDs[requestID] = struct{}{}
 	ms.ExecutionInfo.DomainID = constants.TestDomainID
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
+	var updatedInfo *p.WorkflowExecutionInfo
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		updatedInfo = request.UpdateWorkflowMutation.ExecutionInfo
+		return true
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
 
-	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	err := s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), pauseRequest)
 	s.Nil(err)
+	s.True(updatedInfo.Paused)
 }
 
-func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest_WorkflowCompleted() {
+func (s *engineSuite) TestPauseWorkflowExecution_AlreadyPaused() {
 	we := types.WorkflowExecution{
 		WorkflowID: constants.TestWorkflowID,
 		RunID:      constants.TestRunID,
 	}
 	tasklist := "testTaskList"
 	identity := "testIdentity"
-	signalName := "my signal name 2"
-	input := []byte("test input 2")
-	requestID := uuid.New()
-	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+	pauseRequest := &types.HistoryPauseWorkflowExecutionRequest{
 		DomainUUID: constants.TestDomainID,
-		SignalRequest: &types.SignalWorkflowExecutionRequest{
+		PauseRequest: &types.PauseWorkflowExecutionRequest{
 			Domain:            constants.TestDomainID,
 			WorkflowExecution: &we,
 			Identity:          identity,
-			SignalName:        signalName,
-			Input:             input,
-			RequestID:         requestID,
 		},
 	}
 
@@ -5031,36 +5216,29 @@ func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest_WorkflowCompl
 	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
 	test.AddDecisionTaskScheduledEvent(msBuilder)
 	ms := execution.CreatePersistenceMutableState(msBuilder)
-	// assume duplicate request id
-	ms.SignalRequestedIDs = make(map[string]struct{})
-	ms.SignalRequestedIDs[requestID] = struct{}{}
 	ms.ExecutionInfo.DomainID = constants.TestDomainID
-	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	ms.ExecutionInfo.Paused = true
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
 
-	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
-	s.Nil(err)
+	err := s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), pauseRequest)
+	s.Equal(workflow.ErrWorkflowAlreadyPaused, err)
 }
 
-func (s *engineSuite) TestSignalWorkflowExecution_WorkflowCompleted() {
-	we := &types.WorkflowExecution{
+func (s *engineSuite) TestUnpauseWorkflowExecution_NotPaused() {
+	we := types.WorkflowExecution{
 		WorkflowID: constants.TestWorkflowID,
 		RunID:      constants.TestRunID,
 	}
 	tasklist := "testTaskList"
 	identity := "testIdentity"
-	signalName := "my signal name"
-	input := []byte("test input")
-	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+	unpauseRequest := &types.HistoryUnpauseWorkflowExecutionRequest{
 		DomainUUID: constants.TestDomainID,
-		SignalRequest: &types.SignalWorkflowExecutionRequest{
+		UnpauseRequest: &types.UnpauseWorkflowExecutionRequest{
 			Domain:            constants.TestDomainID,
-			WorkflowExecution: we,
+			WorkflowExecution: &we,
 			Identity:          identity,
-			SignalName:        signalName,
-			Input:             input,
 		},
 	}
 
@@ -5070,159 +5248,925 @@ func (s *engineSuite) TestSignalWorkflowExecution_WorkflowCompleted() {
 		we.GetRunID(),
 		constants.TestLocalDomainEntry,
 	)
-	test.AddWorkflowExecutionStartedEvent(msBuilder, *we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
 	test.AddDecisionTaskScheduledEvent(msBuilder)
 	ms := execution.CreatePersistenceMutableState(msBuilder)
-	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
 
-	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
-	s.EqualError(err, "WorkflowExecutionAlreadyCompletedError{Message: workflow execution already completed}")
+	err := s.mockHistoryEngine.UnpauseWorkflowExecution(context.Background(), unpauseRequest)
+	s.Equal(workflow.ErrWorkflowNotPaused, err)
 }
 
-func (s *engineSuite) TestRemoveSignalMutableState() {
-	removeRequest := &types.RemoveSignalMutableStateRequest{}
-	err := s.mockHistoryEngine.RemoveSignalMutableState(context.Background(), removeRequest)
-	s.Error(err)
-
-	workflowExecution := types.WorkflowExecution{
-		WorkflowID: "wId",
+func (s *engineSuite) TestDeleteWorkflowExecution_StillRunning() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
 		RunID:      constants.TestRunID,
 	}
 	tasklist := "testTaskList"
 	identity := "testIdentity"
-	requestID := uuid.New()
-	removeRequest = &types.RemoveSignalMutableStateRequest{
-		DomainUUID:        constants.TestDomainID,
-		WorkflowExecution: &workflowExecution,
-		RequestID:         requestID,
+	deleteRequest := &types.HistoryDeleteWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		DeleteRequest: &types.DeleteWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+		},
 	}
 
 	msBuilder := execution.NewMutableStateBuilderWithEventV2(
 		s.mockHistoryEngine.shard,
 		loggerimpl.NewLoggerForTest(s.Suite),
-		constants.TestRunID,
+		we.GetRunID(),
 		constants.TestLocalDomainEntry,
 	)
-	test.AddWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
 	test.AddDecisionTaskScheduledEvent(msBuilder)
 	ms := execution.CreatePersistenceMutableState(msBuilder)
 	ms.ExecutionInfo.DomainID = constants.TestDomainID
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
 
-	err = s.mockHistoryEngine.RemoveSignalMutableState(context.Background(), removeRequest)
-	s.Nil(err)
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), deleteRequest)
+	s.Equal(workflow.ErrWorkflowStillRunning, err)
 }
 
-func (s *engineSuite) TestReapplyEvents_ReturnSuccess() {
-	workflowExecution := types.WorkflowExecution{
-		WorkflowID: "test-reapply",
+func (s *engineSuite) TestDeleteWorkflowExecution_ArchivalNotEnabled() {
+	deleteRequest := &types.HistoryDeleteWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		DeleteRequest: &types.DeleteWorkflowExecutionRequest{
+			Domain: constants.TestDomainID,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: constants.TestWorkflowID,
+				RunID:      constants.TestRunID,
+			},
+			Archive: true,
+		},
+	}
+	s.mockShard.Resource.ArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewDisabledArchvialConfig()).Once()
+
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), deleteRequest)
+	s.Equal(workflow.ErrHistoryArchivalNotEnabled, err)
+}
+
+func (s *engineSuite) TestDeleteWorkflowExecution_Closed() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
 		RunID:      constants.TestRunID,
 	}
-	history := []*types.HistoryEvent{
-		{
-			EventID:   1,
-			EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
-			Version:   1,
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	deleteRequest := &types.HistoryDeleteWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		DeleteRequest: &types.DeleteWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
 		},
 	}
+
 	msBuilder := execution.NewMutableStateBuilderWithEventV2(
 		s.mockHistoryEngine.shard,
 		loggerimpl.NewLoggerForTest(s.Suite),
-		workflowExecution.GetRunID(),
+		we.GetRunID(),
 		constants.TestLocalDomainEntry,
 	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
 	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	ms.ExecutionInfo.CloseStatus = persistence.WorkflowCloseStatusTerminated
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: constants.TestRunID}
-	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
+
+	var mutation *p.WorkflowMutation
+	var updateMode p.UpdateWorkflowMode
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockEventsReapplier.EXPECT().ReapplyEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		mutation = &request.UpdateWorkflowMutation
+		updateMode = request.Mode
+		return true
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
 
-	err := s.mockHistoryEngine.ReapplyEvents(
-		context.Background(),
-		constants.TestDomainID,
-		workflowExecution.WorkflowID,
-		workflowExecution.RunID,
-		history,
-	)
-	s.NoError(err)
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), deleteRequest)
+	s.Nil(err)
+	s.Equal(p.UpdateWorkflowModeUpdateCurrent, updateMode)
+	s.Len(mutation.TimerTasks, 1)
+	deleteTask, ok := mutation.TimerTasks[0].(*p.DeleteHistoryEventTask)
+	s.True(ok)
+	s.Equal(p.DeleteHistoryEventTypeSkipArchival, deleteTask.TimeoutType)
 }
 
-func (s *engineSuite) TestReapplyEvents_IgnoreSameVersionEvents() {
-	workflowExecution := types.WorkflowExecution{
-		WorkflowID: "test-reapply-same-version",
+func (s *engineSuite) TestPauseActivity() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
 		RunID:      constants.TestRunID,
 	}
-	history := []*types.HistoryEvent{
-		{
-			EventID:   1,
-			EventType: types.EventTypeTimerStarted.Ptr(),
-			Version:   common.EmptyVersion,
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	request := &types.HistoryPauseActivityRequest{
+		DomainUUID: constants.TestDomainID,
+		PauseRequest: &types.PauseActivityRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			ActivityID:        activityID,
+			Identity:          identity,
 		},
 	}
+
 	msBuilder := execution.NewMutableStateBuilderWithEventV2(
 		s.mockHistoryEngine.shard,
 		loggerimpl.NewLoggerForTest(s.Suite),
-		workflowExecution.GetRunID(),
+		we.GetRunID(),
 		constants.TestLocalDomainEntry,
 	)
-
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, decisionStartedEvent.EventID, nil, identity)
+	test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.EventID,
+		activityID, "activity_type", tasklist, []byte("input"), 100, 10, 1, 5)
 	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
-	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: constants.TestRunID}
-	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
+
+	var mutation *p.WorkflowMutation
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockEventsReapplier.EXPECT().ReapplyEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		mutation = &request.UpdateWorkflowMutation
+		return true
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
 
-	err := s.mockHistoryEngine.ReapplyEvents(
-		context.Background(),
-		constants.TestDomainID,
-		workflowExecution.WorkflowID,
-		workflowExecution.RunID,
-		history,
-	)
-	s.NoError(err)
+	err := s.mockHistoryEngine.PauseActivity(context.Background(), request)
+	s.Nil(err)
+	s.Len(mutation.UpsertActivityInfos, 1)
+	s.True(mutation.UpsertActivityInfos[0].Paused)
+	for _, task := range mutation.TimerTasks {
+		_, ok := task.(*p.ActivityTimeoutTask)
+		s.False(ok)
+	}
 }
 
-func (s *engineSuite) TestReapplyEvents_ResetWorkflow() {
-	workflowExecution := types.WorkflowExecution{
-		WorkflowID: "test-reapply-reset-workflow",
+func (s *engineSuite) TestPauseActivity_AlreadyPaused() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
 		RunID:      constants.TestRunID,
 	}
-	history := []*types.HistoryEvent{
-		{
-			EventID:   1,
-			EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
-			Version:   100,
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	request := &types.HistoryPauseActivityRequest{
+		DomainUUID: constants.TestDomainID,
+		PauseRequest: &types.PauseActivityRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			ActivityID:        activityID,
+			Identity:          identity,
 		},
 	}
+
 	msBuilder := execution.NewMutableStateBuilderWithEventV2(
 		s.mockHistoryEngine.shard,
 		loggerimpl.NewLoggerForTest(s.Suite),
-		workflowExecution.GetRunID(),
+		we.GetRunID(),
 		constants.TestLocalDomainEntry,
 	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, decisionStartedEvent.EventID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.EventID,
+		activityID, "activity_type", tasklist, []byte("input"), 100, 10, 1, 5)
 	ms := execution.CreatePersistenceMutableState(msBuilder)
-	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
-	ms.ExecutionInfo.LastProcessedEvent = 1
-	token, err := msBuilder.GetCurrentBranchToken()
-	s.NoError(err)
-	item := persistence.NewVersionHistoryItem(1, 1)
-	versionHistory := persistence.NewVersionHistory(token, []*persistence.VersionHistoryItem{item})
-	ms.VersionHistories = persistence.NewVersionHistories(versionHistory)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	ms.ActivityInfos[activityScheduledEvent.EventID].Paused = true
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
-	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: constants.TestRunID}
-	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
+
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockEventsReapplier.EXPECT().ReapplyEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
-	s.mockWorkflowResetter.EXPECT().ResetWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
-		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+
+	err := s.mockHistoryEngine.PauseActivity(context.Background(), request)
+	s.Equal(workflow.ErrActivityAlreadyPaused, err)
+}
+
+func (s *engineSuite) TestPauseActivity_ActivityNotFound() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	request := &types.HistoryPauseActivityRequest{
+		DomainUUID: constants.TestDomainID,
+		PauseRequest: &types.PauseActivityRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			ActivityID:        activityID,
+			Identity:          identity,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, decisionStartedEvent.EventID, nil, identity)
+	test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.EventID,
+		activityID, "activity_type", tasklist, []byte("input"), 100, 10, 1, 5)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	request.PauseRequest.ActivityID = "unknown_activity_id"
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.PauseActivity(context.Background(), request)
+	s.Equal(workflow.ErrActivityTaskNotFound, err)
+}
+
+func (s *engineSuite) TestUnpauseActivity() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	request := &types.HistoryUnpauseActivityRequest{
+		DomainUUID: constants.TestDomainID,
+		UnpauseRequest: &types.UnpauseActivityRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			ActivityID:        activityID,
+			Identity:          identity,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, decisionStartedEvent.EventID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.EventID,
+		activityID, "activity_type", tasklist, []byte("input"), 100, 10, 1, 5)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	ms.ActivityInfos[activityScheduledEvent.EventID].Paused = true
+	ms.ActivityInfos[activityScheduledEvent.EventID].Attempt = 2
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var mutation *p.WorkflowMutation
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		mutation = &request.UpdateWorkflowMutation
+		return true
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.UnpauseActivity(context.Background(), request)
+	s.Nil(err)
+	s.Len(mutation.UpsertActivityInfos, 1)
+	s.False(mutation.UpsertActivityInfos[0].Paused)
+	var retryTask *p.ActivityRetryTimerTask
+	for _, task := range mutation.TimerTasks {
+		if t, ok := task.(*p.ActivityRetryTimerTask); ok {
+			retryTask = t
+		}
+	}
+	s.NotNil(retryTask)
+	s.Equal(activityScheduledEvent.EventID, retryTask.EventID)
+	s.Equal(int32(2), retryTask.Attempt)
+}
+
+func (s *engineSuite) TestUnpauseActivity_NotPaused() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	request := &types.HistoryUnpauseActivityRequest{
+		DomainUUID: constants.TestDomainID,
+		UnpauseRequest: &types.UnpauseActivityRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			ActivityID:        activityID,
+			Identity:          identity,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, decisionStartedEvent.EventID, nil, identity)
+	test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.EventID,
+		activityID, "activity_type", tasklist, []byte("input"), 100, 10, 1, 5)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.UnpauseActivity(context.Background(), request)
+	s.Equal(workflow.ErrActivityNotPaused, err)
+}
+
+func (s *engineSuite) TestResetActivity() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	request := &types.HistoryResetActivityRequest{
+		DomainUUID: constants.TestDomainID,
+		ResetRequest: &types.ResetActivityRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			ActivityID:        activityID,
+			Identity:          identity,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, decisionStartedEvent.EventID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.EventID,
+		activityID, "activity_type", tasklist, []byte("input"), 100, 10, 1, 5)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	ms.ActivityInfos[activityScheduledEvent.EventID].Attempt = 3
+	ms.ActivityInfos[activityScheduledEvent.EventID].Details = []byte("heartbeat details")
+	lastHeartbeat := time.Now().Add(-time.Minute)
+	ms.ActivityInfos[activityScheduledEvent.EventID].LastHeartBeatUpdatedTime = lastHeartbeat
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var mutation *p.WorkflowMutation
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		mutation = &request.UpdateWorkflowMutation
+		return true
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.ResetActivity(context.Background(), request)
+	s.Nil(err)
+	s.Len(mutation.UpsertActivityInfos, 1)
+	s.Equal(int32(0), mutation.UpsertActivityInfos[0].Attempt)
+	s.Nil(mutation.UpsertActivityInfos[0].Details)
+	// the standby orders the reset after earlier attempts by the restarted heartbeat
+	s.True(mutation.UpsertActivityInfos[0].LastHeartBeatUpdatedTime.After(lastHeartbeat))
+	var retryTask *p.ActivityRetryTimerTask
+	for _, task := range mutation.TimerTasks {
+		if t, ok := task.(*p.ActivityRetryTimerTask); ok {
+			retryTask = t
+		}
+	}
+	s.Nil(retryTask)
+}
+
+func (s *engineSuite) TestResetActivity_RetryNow() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	request := &types.HistoryResetActivityRequest{
+		DomainUUID: constants.TestDomainID,
+		ResetRequest: &types.ResetActivityRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			ActivityID:        activityID,
+			RetryNow:          true,
+			Identity:          identity,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, decisionStartedEvent.EventID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.EventID,
+		activityID, "activity_type", tasklist, []byte("input"), 100, 10, 1, 5)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	ms.ActivityInfos[activityScheduledEvent.EventID].Attempt = 3
+	ms.ActivityInfos[activityScheduledEvent.EventID].Details = []byte("heartbeat details")
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var mutation *p.WorkflowMutation
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		mutation = &request.UpdateWorkflowMutation
+		return true
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.ResetActivity(context.Background(), request)
+	s.Nil(err)
+	s.Len(mutation.UpsertActivityInfos, 1)
+	s.Equal(int32(0), mutation.UpsertActivityInfos[0].Attempt)
+	s.Nil(mutation.UpsertActivityInfos[0].Details)
+	var retryTask *p.ActivityRetryTimerTask
+	for _, task := range mutation.TimerTasks {
+		if t, ok := task.(*p.ActivityRetryTimerTask); ok {
+			retryTask = t
+		}
+	}
+	s.NotNil(retryTask)
+	s.Equal(int32(0), retryTask.Attempt)
+}
+
+// Test signal decision by adding request ID
+func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest_WorkflowOpen() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	signalName := "my signal name 2"
+	input := []byte("test input 2")
+	requestID := uuid.New()
+	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+			SignalName:        signalName,
+			Input:             input,
+			RequestID:         requestID,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	// assume duplicate request id
+	ms.SignalRequestedIDs = make(map[string]struct{})
+	ms.SignalRequestedIDs[requestID] = struct{}{}
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest_WorkflowCompleted() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	signalName := "my signal name 2"
+	input := []byte("test input 2")
+	requestID := uuid.New()
+	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+			SignalName:        signalName,
+			Input:             input,
+			RequestID:         requestID,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	// assume duplicate request id
+	ms.SignalRequestedIDs = make(map[string]struct{})
+	ms.SignalRequestedIDs[requestID] = struct{}{}
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_DuplicateRequest() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	requestID := uuid.New()
+	updateRequest := &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		Request: &types.UpdateWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			UpdateName:        "my update",
+			Input:             []byte("test input"),
+			Identity:          identity,
+			RequestID:         requestID,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	respCh := make(chan *types.HistoryUpdateWorkflowExecutionResponse, 1)
+	go func() {
+		resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), updateRequest)
+		s.NoError(err)
+		respCh <- resp
+	}()
+
+	var builder execution.MutableState
+	s.Eventually(func() bool {
+		builder = s.getBuilder(constants.TestDomainID, we)
+		return builder != nil && builder.GetUpdateRegistry().HasPendingUpdate()
+	}, time.Second*2, time.Millisecond*10)
+	s.Equal([]string{requestID}, builder.GetUpdateRegistry().GetPendingIDs())
+	_, accepted := builder.GetAcceptedUpdate(requestID)
+	s.True(accepted)
+	s.False(builder.IsSignalRequested(requestID))
+
+	// the retried request waits on the accepted update instead of writing another accepted event
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	_, err := s.mockHistoryEngine.UpdateWorkflowExecution(ctx, updateRequest)
+	s.Equal(context.DeadlineExceeded, err)
+	s.mockHistoryV2Mgr.AssertNumberOfCalls(s.T(), "AppendHistoryNodes", 1)
+	s.mockExecutionMgr.AssertNumberOfCalls(s.T(), "UpdateWorkflowExecution", 1)
+
+	s.NoError(builder.GetUpdateRegistry().SetTerminationState(requestID, &update.TerminationState{
+		TerminationType: update.TerminationTypeCompleted,
+		Result:          []byte("test result"),
+	}))
+	resp := <-respCh
+	s.Equal([]byte("test result"), resp.Response.Result)
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_DuplicateRequest_MutableStateReloaded() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	requestID := uuid.New()
+	updateRequest := &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		Request: &types.UpdateWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			UpdateName:        "my update",
+			Input:             []byte("test input"),
+			Identity:          identity,
+			RequestID:         requestID,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	// assume the update was accepted before mutable state was reloaded
+	ms.ExecutionInfo.AcceptedUpdates = map[string]int64{requestID: common.EmptyEventID}
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	respCh := make(chan *types.HistoryUpdateWorkflowExecutionResponse, 1)
+	go func() {
+		resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), updateRequest)
+		s.NoError(err)
+		respCh <- resp
+	}()
+
+	// the retried request waits on the accepted update instead of writing another accepted event
+	var builder execution.MutableState
+	s.Eventually(func() bool {
+		builder = s.getBuilder(constants.TestDomainID, we)
+		return builder != nil && builder.GetUpdateRegistry().HasPendingUpdate()
+	}, time.Second*2, time.Millisecond*10)
+	s.Equal([]string{requestID}, builder.GetUpdateRegistry().GetPendingIDs())
+	s.mockHistoryV2Mgr.AssertNotCalled(s.T(), "AppendHistoryNodes", mock.Anything, mock.Anything)
+
+	s.NoError(builder.GetUpdateRegistry().SetTerminationState(requestID, &update.TerminationState{
+		TerminationType: update.TerminationTypeCompleted,
+		Result:          []byte("test result"),
+	}))
+	resp := <-respCh
+	s.Equal([]byte("test result"), resp.Response.Result)
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_DuplicateRequest_UpdateCompleted() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	requestID := uuid.New()
+	updateRequest := &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		Request: &types.UpdateWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			UpdateName:        "my update",
+			Input:             []byte("test input"),
+			Identity:          identity,
+			RequestID:         requestID,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	// assume the update was completed in the batch starting at event 4 and the workflow has closed since
+	ms.ExecutionInfo.AcceptedUpdates = map[string]int64{requestID: 4}
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.MatchedBy(func(request *p.ReadHistoryBranchRequest) bool {
+		return request.MinEventID == 4 && request.MaxEventID == 5
+	})).Return(&p.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{
+			{EventID: 4, EventType: types.EventTypeDecisionTaskCompleted.Ptr()},
+			{
+				EventID:   5,
+				EventType: types.EventTypeWorkflowExecutionUpdateCompleted.Ptr(),
+				WorkflowExecutionUpdateCompletedEventAttributes: &types.WorkflowExecutionUpdateCompletedEventAttributes{
+					DecisionTaskCompletedEventID: 4,
+					UpdateID:                     requestID,
+					Result:                       []byte("test result"),
+				},
+			},
+		},
+	}, nil).Once()
+
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), updateRequest)
+	s.NoError(err)
+	s.Equal([]byte("test result"), resp.Response.Result)
+	s.mockHistoryV2Mgr.AssertNotCalled(s.T(), "AppendHistoryNodes", mock.Anything, mock.Anything)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_WorkflowCompleted() {
+	we := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	signalName := "my signal name"
+	input := []byte("test input")
+	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: we,
+			Identity:          identity,
+			SignalName:        signalName,
+			Input:             input,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, *we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.EqualError(err, "WorkflowExecutionAlreadyCompletedError{Message: workflow execution already completed}")
+}
+
+func (s *engineSuite) TestRemoveSignalMutableState() {
+	removeRequest := &types.RemoveSignalMutableStateRequest{}
+	err := s.mockHistoryEngine.RemoveSignalMutableState(context.Background(), removeRequest)
+	s.Error(err)
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	requestID := uuid.New()
+	removeRequest = &types.RemoveSignalMutableStateRequest{
+		DomainUUID:        constants.TestDomainID,
+		WorkflowExecution: &workflowExecution,
+		RequestID:         requestID,
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		constants.TestRunID,
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err = s.mockHistoryEngine.RemoveSignalMutableState(context.Background(), removeRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestReapplyEvents_ReturnSuccess() {
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "test-reapply",
+		RunID:      constants.TestRunID,
+	}
+	history := []*types.HistoryEvent{
+		{
+			EventID:   1,
+			EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+			Version:   1,
+		},
+	}
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		workflowExecution.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: constants.TestRunID}
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockEventsReapplier.EXPECT().ReapplyEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+
+	err := s.mockHistoryEngine.ReapplyEvents(
+		context.Background(),
+		constants.TestDomainID,
+		workflowExecution.WorkflowID,
+		workflowExecution.RunID,
+		history,
+	)
+	s.NoError(err)
+}
+
+func (s *engineSuite) TestReapplyEvents_IgnoreSameVersionEvents() {
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "test-reapply-same-version",
+		RunID:      constants.TestRunID,
+	}
+	history := []*types.HistoryEvent{
+		{
+			EventID:   1,
+			EventType: types.EventTypeTimerStarted.Ptr(),
+			Version:   common.EmptyVersion,
+		},
+	}
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		workflowExecution.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: constants.TestRunID}
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockEventsReapplier.EXPECT().ReapplyEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.mockHistoryEngine.ReapplyEvents(
+		context.Background(),
+		constants.TestDomainID,
+		workflowExecution.WorkflowID,
+		workflowExecution.RunID,
+		history,
+	)
+	s.NoError(err)
+}
+
+func (s *engineSuite) TestReapplyEvents_ResetWorkflow() {
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "test-reapply-reset-workflow",
+		RunID:      constants.TestRunID,
+	}
+	history := []*types.HistoryEvent{
+		{
+			EventID:   1,
+			EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+			Version:   100,
+		},
+	}
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		workflowExecution.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	ms.ExecutionInfo.LastProcessedEvent = 1
+	token, err := msBuilder.GetCurrentBranchToken()
+	s.NoError(err)
+	item := persistence.NewVersionHistoryItem(1, 1)
+	versionHistory := persistence.NewVersionHistory(token, []*persistence.VersionHistoryItem{item})
+	ms.VersionHistories = persistence.NewVersionHistories(versionHistory)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: constants.TestRunID}
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockEventsReapplier.EXPECT().ReapplyEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.mockWorkflowResetter.EXPECT().ResetWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 		gomock.Any(),
 	).Return(nil).Times(1)
 	err = s.mockHistoryEngine.ReapplyEvents(
@@ -5256,3 +6200,15 @@ func (s *engineSuite) getActivityScheduledEvent(
 func (s *engineSuite) printHistory(builder execution.MutableState) string {
 	return thrift.FromHistory(builder.GetHistoryBuilder().GetHistory()).String()
 }
+
+type fakeHistoryReplicator struct {
+	requests []*types.ReplicateEventsV2Request
+}
+
+func (r *fakeHistoryReplicator) ApplyEvents(
+	_ context.Context,
+	request *types.ReplicateEventsV2Request,
+) error {
+	r.requests = append(r.requests, request)
+	return nil
+}
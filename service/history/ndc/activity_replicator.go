@@ -143,20 +143,22 @@ func (r *activityReplicatorImpl) SyncActivity(
 	}
 
 	if ai.Version == request.GetVersion() {
-		if ai.Attempt > request.GetAttempt() {
-			// this should not retry, can be caused by failover or reset
+		lastHeartbeatTime := time.Unix(0, request.GetLastHeartbeatTime())
+		if ai.Attempt > request.GetAttempt() && !lastHeartbeatTime.After(ai.LastHeartBeatUpdatedTime) {
+			// this should not retry, can be caused by failover or out of order delivery
 			return nil
 		}
 		if ai.Attempt == request.GetAttempt() {
-			lastHeartbeatTime := time.Unix(0, request.GetLastHeartbeatTime())
 			if ai.LastHeartBeatUpdatedTime.After(lastHeartbeatTime) {
 				// this should not retry, can be caused by out of order delivery
 				return nil
 			}
 			// version equal & attempt equal & last heartbeat after existing heartbeat
-			// should update activity
+			// should update activity, this also carries pause and unpause of the activity
 		}
 		// version equal & attempt larger then existing, should update activity
+		// version equal & attempt smaller but heartbeat restarted after existing heartbeat,
+		// the activity was reset on the active side, should update activity
 	}
 	// version larger then existing, should update activity
 
@@ -167,7 +169,7 @@ func (r *activityReplicatorImpl) SyncActivity(
 	resetActivityTimerTaskStatus := false
 	if !r.clusterMetadata.IsVersionFromSameCluster(request.GetVersion(), ai.Version) {
 		resetActivityTimerTaskStatus = true
-	} else if ai.Attempt < request.GetAttempt() {
+	} else if ai.Attempt != request.GetAttempt() {
 		resetActivityTimerTaskStatus = true
 	}
 	err = mutableState.ReplicateActivityInfo(request, resetActivityTimerTaskStatus)
@@ -1001,6 +1001,159 @@ func (s *activityReplicatorSuite) TestSyncActivity_ActivityRunning_Update_SameVe
 	s.Equal(expectedErr, err)
 }
 
+func (s *activityReplicatorSuite) TestSyncActivity_ActivityRunning_Update_SameVersionSmallerAttempt_HeartbeatRestarted() {
+	domainName := "some random domain name"
+	domainID := constants.TestDomainID
+	workflowID := "some random workflow ID"
+	runID := uuid.New()
+	version := int64(100)
+	scheduleID := int64(144)
+	scheduledTime := time.Now()
+	startedID := scheduleID + 1
+	startedTime := scheduledTime.Add(time.Minute)
+	heartBeatUpdatedTime := startedTime.Add(time.Minute)
+	attempt := int32(100)
+	details := []byte("some random activity heartbeat progress")
+	nextEventID := scheduleID + 10
+	versionHistoryItem := persistence.NewVersionHistoryItem(scheduleID, version)
+	versionHistory := persistence.NewVersionHistory([]byte{}, []*persistence.VersionHistoryItem{
+		versionHistoryItem,
+	})
+	versionHistories := persistence.NewVersionHistories(versionHistory)
+
+	key := definition.NewWorkflowIdentifier(domainID, workflowID, runID)
+	context := execution.NewMockContext(s.controller)
+	context.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil).Times(1)
+	context.EXPECT().Lock(gomock.Any()).Return(nil)
+	context.EXPECT().Unlock().Times(1)
+	context.EXPECT().Clear().Times(1)
+	_, err := s.executionCache.PutIfNotExist(key, context)
+	s.NoError(err)
+
+	request := &types.SyncActivityRequest{
+		DomainID:          domainID,
+		WorkflowID:        workflowID,
+		RunID:             runID,
+		Version:           version,
+		ScheduledID:       scheduleID,
+		ScheduledTime:     common.Int64Ptr(scheduledTime.UnixNano()),
+		StartedID:         startedID,
+		StartedTime:       common.Int64Ptr(startedTime.UnixNano()),
+		Attempt:           attempt,
+		LastHeartbeatTime: common.Int64Ptr(heartBeatUpdatedTime.UnixNano()),
+		Details:           details,
+		VersionHistory:    versionHistory.ToInternalType(),
+	}
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+	s.mockMutableState.EXPECT().GetNextEventID().Return(nextEventID).AnyTimes()
+	s.mockMutableState.EXPECT().GetVersionHistories().Return(versionHistories).AnyTimes()
+	s.mockMutableState.EXPECT().GetWorkflowStateCloseStatus().Return(1, 0).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainByID(domainID).Return(
+		cache.NewGlobalDomainCacheEntryForTest(
+			&persistence.DomainInfo{ID: domainID, Name: domainName},
+			&persistence.DomainConfig{Retention: 1},
+			&persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+					{ClusterName: cluster.TestAlternativeClusterName},
+				},
+			},
+			version,
+			nil,
+		), nil,
+	).AnyTimes()
+	// the activity was reset on the active side after the last heartbeat of the standby
+	activityInfo := &persistence.ActivityInfo{
+		Version:                  version,
+		ScheduleID:               scheduleID,
+		Attempt:                  attempt + 1,
+		LastHeartBeatUpdatedTime: heartBeatUpdatedTime.Add(-time.Second),
+	}
+	s.mockMutableState.EXPECT().GetActivityInfo(scheduleID).Return(activityInfo, true).AnyTimes()
+	s.mockClusterMetadata.EXPECT().IsVersionFromSameCluster(version, activityInfo.Version).Return(true).AnyTimes()
+
+	expectedErr := errors.New("this is error is used to by pass lots of mocking")
+	s.mockMutableState.EXPECT().ReplicateActivityInfo(request, true).Return(expectedErr).Times(1)
+
+	err = s.activityReplicator.SyncActivity(ctx.Background(), request)
+	s.Equal(expectedErr, err)
+}
+
+func (s *activityReplicatorSuite) TestSyncActivity_ActivityRunning_SameVersionSmallerAttempt() {
+	domainName := "some random domain name"
+	domainID := constants.TestDomainID
+	workflowID := "some random workflow ID"
+	runID := uuid.New()
+	version := int64(100)
+	scheduleID := int64(144)
+	scheduledTime := time.Now()
+	startedID := scheduleID + 1
+	startedTime := scheduledTime.Add(time.Minute)
+	heartBeatUpdatedTime := startedTime.Add(time.Minute)
+	attempt := int32(100)
+	details := []byte("some random activity heartbeat progress")
+	nextEventID := scheduleID + 10
+	versionHistoryItem := persistence.NewVersionHistoryItem(scheduleID, version)
+	versionHistory := persistence.NewVersionHistory([]byte{}, []*persistence.VersionHistoryItem{
+		versionHistoryItem,
+	})
+	versionHistories := persistence.NewVersionHistories(versionHistory)
+
+	key := definition.NewWorkflowIdentifier(domainID, workflowID, runID)
+	context := execution.NewMockContext(s.controller)
+	context.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil).Times(1)
+	context.EXPECT().Lock(gomock.Any()).Return(nil)
+	context.EXPECT().Unlock().Times(1)
+	_, err := s.executionCache.PutIfNotExist(key, context)
+	s.NoError(err)
+
+	request := &types.SyncActivityRequest{
+		DomainID:          domainID,
+		WorkflowID:        workflowID,
+		RunID:             runID,
+		Version:           version,
+		ScheduledID:       scheduleID,
+		ScheduledTime:     common.Int64Ptr(scheduledTime.UnixNano()),
+		StartedID:         startedID,
+		StartedTime:       common.Int64Ptr(startedTime.UnixNano()),
+		Attempt:           attempt,
+		LastHeartbeatTime: common.Int64Ptr(heartBeatUpdatedTime.UnixNano()),
+		Details:           details,
+		VersionHistory:    versionHistory.ToInternalType(),
+	}
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+	s.mockMutableState.EXPECT().GetNextEventID().Return(nextEventID).AnyTimes()
+	s.mockMutableState.EXPECT().GetVersionHistories().Return(versionHistories).AnyTimes()
+	s.mockMutableState.EXPECT().GetWorkflowStateCloseStatus().Return(1, 0).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainByID(domainID).Return(
+		cache.NewGlobalDomainCacheEntryForTest(
+			&persistence.DomainInfo{ID: domainID, Name: domainName},
+			&persistence.DomainConfig{Retention: 1},
+			&persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+					{ClusterName: cluster.TestAlternativeClusterName},
+				},
+			},
+			version,
+			nil,
+		), nil,
+	).AnyTimes()
+	activityInfo := &persistence.ActivityInfo{
+		Version:                  version,
+		ScheduleID:               scheduleID,
+		Attempt:                  attempt + 1,
+		LastHeartBeatUpdatedTime: heartBeatUpdatedTime.Add(time.Second),
+	}
+	s.mockMutableState.EXPECT().GetActivityInfo(scheduleID).Return(activityInfo, true).AnyTimes()
+	s.mockClusterMetadata.EXPECT().IsVersionFromSameCluster(version, activityInfo.Version).Return(true).AnyTimes()
+
+	err = s.activityReplicator.SyncActivity(ctx.Background(), request)
+	s.Nil(err)
+}
+
 func (s *activityReplicatorSuite) TestSyncActivity_ActivityRunning_Update_LargerVersion() {
 	domainName := "some random domain name"
 	domainID := constants.TestDomainID
@@ -22,6 +22,7 @@ package queue
 
 import (
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/task"
 )
 
 type (
@@ -35,6 +36,7 @@ type (
 		GetStateActionAttributes *GetStateActionAttributes
 		GetTasksAttributes       *GetTasksAttributes
 		UpdateTaskAttributes     *UpdateTasksAttributes
+		SubmitTasksAttributes    *SubmitTasksAttributes
 		// add attributes for other action types here
 	}
 
@@ -45,6 +47,7 @@ type (
 		GetStateActionResult *GetStateActionResult
 		GetTasksResult       *GetTasksResult
 		UpdateTaskResult     *UpdateTasksResult
+		SubmitTasksResult    *SubmitTasksResult
 	}
 
 	// ResetActionAttributes contains the parameter for performing Reset Action
@@ -72,6 +75,13 @@ type (
 	// UpdateTasksResult is the result for performing UpdateTask Action
 	UpdateTasksResult struct {
 	}
+
+	// SubmitTasksAttributes contains the parameter for submitting tasks to the processor
+	SubmitTasksAttributes struct {
+		TaskInfos []task.Info
+	}
+	// SubmitTasksResult is the result for performing SubmitTasks Action
+	SubmitTasksResult struct{}
 )
 
 const (
@@ -83,6 +93,9 @@ const (
 	ActionTypeGetTasks
 	// ActionTypeUpdateTask is the ActionType to update outstanding task
 	ActionTypeUpdateTask
+	// ActionTypeSubmitTasks is the ActionType for submitting tasks loaded outside the processor,
+	// e.g. tasks retried from the history task DLQ
+	ActionTypeSubmitTasks
 	// add more ActionType here
 )
 
@@ -122,3 +135,16 @@ func NewUpdateTasksAction(
 		},
 	}
 }
+
+// NewSubmitTasksAction creates a queue action for submitting the given
+// tasks to the processor
+func NewSubmitTasksAction(
+	taskInfos []task.Info,
+) *Action {
+	return &Action{
+		ActionType: ActionTypeSubmitTasks,
+		SubmitTasksAttributes: &SubmitTasksAttributes{
+			TaskInfos: taskInfos,
+		},
+	}
+}
@@ -680,6 +680,7 @@ func newCrossClusterQueueProcessorOptions(
 	options.EnableStuckTaskSplitByDomainID = config.QueueProcessorEnableStuckTaskSplitByDomainID
 	options.StuckTaskSplitThreshold = config.QueueProcessorStuckTaskSplitThreshold
 	options.SplitLookAheadDurationByDomainID = config.QueueProcessorSplitLookAheadDurationByDomainID
+	options.DomainTierSplitLevel = config.QueueProcessorDomainTierSplitLevel
 
 	options.EnablePersistQueueStates = dynamicconfig.GetBoolPropertyFn(true)
 	options.EnableLoadQueueStates = dynamicconfig.GetBoolPropertyFn(true)
@@ -0,0 +1,232 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination dlq_handler_mock.go
+
+package queue
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/task"
+)
+
+type (
+	// DLQHandler is the interface handles transfer and timer tasks parked in the history task DLQ
+	DLQHandler interface {
+		ReadTasks(
+			ctx context.Context,
+			exclusiveBeginTaskID int64,
+			inclusiveEndTaskID int64,
+			pageSize int,
+			pageToken []byte,
+		) ([]*types.HistoryTaskDLQEntry, []byte, error)
+		PurgeTasks(
+			ctx context.Context,
+			exclusiveBeginTaskID int64,
+			inclusiveEndTaskID int64,
+		) error
+		RetryTasks(
+			ctx context.Context,
+			exclusiveBeginTaskID int64,
+			inclusiveEndTaskID int64,
+			pageSize int,
+			pageToken []byte,
+		) ([]byte, error)
+	}
+
+	dlqHandlerImpl struct {
+		shard             shard.Context
+		transferProcessor Processor
+		timerProcessor    Processor
+		logger            log.Logger
+	}
+
+	processorAndCluster struct {
+		processor   Processor
+		clusterName string
+	}
+)
+
+var _ DLQHandler = (*dlqHandlerImpl)(nil)
+
+// NewDLQHandler initialize the history task DLQ handler
+func NewDLQHandler(
+	shard shard.Context,
+	transferProcessor Processor,
+	timerProcessor Processor,
+) DLQHandler {
+	return &dlqHandlerImpl{
+		shard:             shard,
+		transferProcessor: transferProcessor,
+		timerProcessor:    timerProcessor,
+		logger:            shard.GetLogger(),
+	}
+}
+
+func (h *dlqHandlerImpl) ReadTasks(
+	ctx context.Context,
+	exclusiveBeginTaskID int64,
+	inclusiveEndTaskID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*types.HistoryTaskDLQEntry, []byte, error) {
+
+	resp, err := h.shard.GetExecutionManager().GetHistoryTasksFromDLQ(ctx, &persistence.GetHistoryTasksFromDLQRequest{
+		ReadLevel:     exclusiveBeginTaskID,
+		MaxReadLevel:  inclusiveEndTaskID,
+		BatchSize:     pageSize,
+		NextPageToken: pageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entries := make([]*types.HistoryTaskDLQEntry, 0, len(resp.Tasks))
+	for _, dlqTask := range resp.Tasks {
+		entries = append(entries, toHistoryTaskDLQEntry(dlqTask))
+	}
+	return entries, resp.NextPageToken, nil
+}
+
+func (h *dlqHandlerImpl) PurgeTasks(
+	ctx context.Context,
+	exclusiveBeginTaskID int64,
+	inclusiveEndTaskID int64,
+) error {
+
+	return h.shard.GetExecutionManager().RangeDeleteHistoryTaskFromDLQ(ctx, &persistence.RangeDeleteHistoryTaskFromDLQRequest{
+		ExclusiveBeginTaskID: exclusiveBeginTaskID,
+		InclusiveEndTaskID:   inclusiveEndTaskID,
+	})
+}
+
+func (h *dlqHandlerImpl) RetryTasks(
+	ctx context.Context,
+	exclusiveBeginTaskID int64,
+	inclusiveEndTaskID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]byte, error) {
+
+	resp, err := h.shard.GetExecutionManager().GetHistoryTasksFromDLQ(ctx, &persistence.GetHistoryTasksFromDLQRequest{
+		ReadLevel:     exclusiveBeginTaskID,
+		MaxReadLevel:  inclusiveEndTaskID,
+		BatchSize:     pageSize,
+		NextPageToken: pageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	taskInfos := make(map[processorAndCluster][]task.Info)
+	taskIDs := make(map[processorAndCluster][]int64)
+	for _, dlqTask := range resp.Tasks {
+		var processor Processor
+		var taskInfo task.Info
+		switch {
+		case dlqTask.TransferTaskInfo != nil:
+			processor, taskInfo = h.transferProcessor, dlqTask.TransferTaskInfo
+		case dlqTask.TimerTaskInfo != nil:
+			processor, taskInfo = h.timerProcessor, dlqTask.TimerTaskInfo
+		default:
+			h.logger.Warn("Skip history task DLQ entry without task info")
+			continue
+		}
+
+		clusterName, err := h.getActiveClusterName(taskInfo.GetDomainID())
+		if err != nil {
+			return nil, err
+		}
+		key := processorAndCluster{processor: processor, clusterName: clusterName}
+		taskInfos[key] = append(taskInfos[key], taskInfo)
+		taskIDs[key] = append(taskIDs[key], dlqTask.GetTaskID())
+	}
+
+	for key, infos := range taskInfos {
+		if _, err := key.processor.HandleAction(key.clusterName, NewSubmitTasksAction(infos)); err != nil {
+			return nil, err
+		}
+
+		// tasks are deleted only after they are submitted, if a task fails again,
+		// it will be moved back to the DLQ after exhausting its retry budget
+		for _, taskID := range taskIDs[key] {
+			if err := h.shard.GetExecutionManager().DeleteHistoryTaskFromDLQ(ctx, &persistence.DeleteHistoryTaskFromDLQRequest{
+				TaskID: taskID,
+			}); err != nil {
+				return nil, err
+			}
+		}
+		h.logger.Info("Resubmitted tasks from history task DLQ", tag.ClusterName(key.clusterName), tag.Counter(len(infos)))
+	}
+	return resp.NextPageToken, nil
+}
+
+func (h *dlqHandlerImpl) getActiveClusterName(
+	domainID string,
+) (string, error) {
+	domainEntry, err := h.shard.GetDomainCache().GetDomainByID(domainID)
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			// the task will be dropped by the active processor
+			return h.shard.GetClusterMetadata().GetCurrentClusterName(), nil
+		}
+		return "", err
+	}
+	if !domainEntry.IsGlobalDomain() {
+		return h.shard.GetClusterMetadata().GetCurrentClusterName(), nil
+	}
+	return domainEntry.GetReplicationConfig().ActiveClusterName, nil
+}
+
+func toHistoryTaskDLQEntry(
+	dlqTask *persistence.HistoryTaskDLQInfo,
+) *types.HistoryTaskDLQEntry {
+	entry := &types.HistoryTaskDLQEntry{
+		TaskID:           dlqTask.GetTaskID(),
+		Attempt:          int32(dlqTask.Attempt),
+		LastError:        dlqTask.LastError,
+		CreatedTimestamp: dlqTask.CreatedTime.UnixNano(),
+	}
+
+	var taskInfo task.Info
+	switch {
+	case dlqTask.TransferTaskInfo != nil:
+		entry.Category = common.Int32Ptr(int32(common.TaskTypeTransfer))
+		taskInfo = dlqTask.TransferTaskInfo
+	case dlqTask.TimerTaskInfo != nil:
+		entry.Category = common.Int32Ptr(int32(common.TaskTypeTimer))
+		taskInfo = dlqTask.TimerTaskInfo
+	default:
+		return entry
+	}
+	entry.TaskType = int32(taskInfo.GetTaskType())
+	entry.DomainID = taskInfo.GetDomainID()
+	entry.WorkflowID = taskInfo.GetWorkflowID()
+	entry.RunID = taskInfo.GetRunID()
+	entry.VisibilityTimestamp = taskInfo.GetVisibilityTimestamp().UnixNano()
+	return entry
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/task"
+)
+
+type (
+	dlqHandlerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller            *gomock.Controller
+		mockShard             *shard.TestContext
+		mockTransferProcessor *MockProcessor
+		mockTimerProcessor    *MockProcessor
+
+		dlqHandler DLQHandler
+	}
+)
+
+func TestDLQHandlerSuite(t *testing.T) {
+	s := new(dlqHandlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *dlqHandlerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockShard = shard.NewTestContext(
+		s.controller,
+		&persistence.ShardInfo{
+			ShardID: 10,
+			RangeID: 1,
+		},
+		config.NewForTest(),
+	)
+	s.mockTransferProcessor = NewMockProcessor(s.controller)
+	s.mockTimerProcessor = NewMockProcessor(s.controller)
+
+	s.dlqHandler = NewDLQHandler(s.mockShard, s.mockTransferProcessor, s.mockTimerProcessor)
+}
+
+func (s *dlqHandlerSuite) TearDownTest() {
+	s.controller.Finish()
+	s.mockShard.Finish(s.T())
+}
+
+func (s *dlqHandlerSuite) TestReadTasks() {
+	createdTime := time.Now()
+	visibilityTime := createdTime.Add(-time.Minute)
+	s.mockShard.Resource.ExecutionMgr.On("GetHistoryTasksFromDLQ", mock.Anything, &persistence.GetHistoryTasksFromDLQRequest{
+		ReadLevel:     10,
+		MaxReadLevel:  20,
+		BatchSize:     5,
+		NextPageToken: []byte("token"),
+	}).Return(&persistence.GetHistoryTasksFromDLQResponse{
+		Tasks: []*persistence.HistoryTaskDLQInfo{
+			{
+				TransferTaskInfo: &persistence.TransferTaskInfo{
+					DomainID:   constants.TestDomainID,
+					WorkflowID: constants.TestWorkflowID,
+					RunID:      constants.TestRunID,
+					TaskID:     11,
+					TaskType:   persistence.TransferTaskTypeActivityTask,
+				},
+				Attempt:     100,
+				LastError:   "some error",
+				CreatedTime: createdTime,
+			},
+			{
+				TimerTaskInfo: &persistence.TimerTaskInfo{
+					DomainID:            constants.TestDomainID,
+					WorkflowID:          constants.TestWorkflowID,
+					RunID:               constants.TestRunID,
+					TaskID:              12,
+					TaskType:            persistence.TaskTypeUserTimer,
+					VisibilityTimestamp: visibilityTime,
+				},
+				Attempt:     100,
+				LastError:   "some other error",
+				CreatedTime: createdTime,
+			},
+		},
+		NextPageToken: []byte("next token"),
+	}, nil).Once()
+
+	entries, token, err := s.dlqHandler.ReadTasks(context.Background(), 10, 20, 5, []byte("token"))
+	s.NoError(err)
+	s.Equal([]byte("next token"), token)
+	s.Len(entries, 2)
+	s.Equal(int64(11), entries[0].TaskID)
+	s.Equal(int32(common.TaskTypeTransfer), entries[0].GetCategory())
+	s.Equal(int32(persistence.TransferTaskTypeActivityTask), entries[0].TaskType)
+	s.Equal(constants.TestWorkflowID, entries[0].WorkflowID)
+	s.Equal("some error", entries[0].LastError)
+	s.Equal(createdTime.UnixNano(), entries[0].CreatedTimestamp)
+	s.Equal(int64(12), entries[1].TaskID)
+	s.Equal(int32(common.TaskTypeTimer), entries[1].GetCategory())
+	s.Equal(visibilityTime.UnixNano(), entries[1].VisibilityTimestamp)
+}
+
+func (s *dlqHandlerSuite) TestPurgeTasks() {
+	s.mockShard.Resource.ExecutionMgr.On("RangeDeleteHistoryTaskFromDLQ", mock.Anything, &persistence.RangeDeleteHistoryTaskFromDLQRequest{
+		ExclusiveBeginTaskID: 10,
+		InclusiveEndTaskID:   20,
+	}).Return(nil).Once()
+
+	s.NoError(s.dlqHandler.PurgeTasks(context.Background(), 10, 20))
+}
+
+func (s *dlqHandlerSuite) TestRetryTasks() {
+	transferTask := &persistence.TransferTaskInfo{
+		DomainID: constants.TestDomainID,
+		TaskID:   11,
+		TaskType: persistence.TransferTaskTypeActivityTask,
+	}
+	timerTask := &persistence.TimerTaskInfo{
+		DomainID: constants.TestDomainID,
+		TaskID:   12,
+		TaskType: persistence.TaskTypeUserTimer,
+	}
+	s.mockShard.Resource.ExecutionMgr.On("GetHistoryTasksFromDLQ", mock.Anything, &persistence.GetHistoryTasksFromDLQRequest{
+		ReadLevel:    10,
+		MaxReadLevel: 20,
+		BatchSize:    5,
+	}).Return(&persistence.GetHistoryTasksFromDLQResponse{
+		Tasks: []*persistence.HistoryTaskDLQInfo{
+			{TransferTaskInfo: transferTask},
+			{TimerTaskInfo: timerTask},
+		},
+	}, nil).Once()
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainByID(constants.TestDomainID).Return(constants.TestGlobalDomainEntry, nil).Times(2)
+	s.mockTransferProcessor.EXPECT().HandleAction(cluster.TestCurrentClusterName, NewSubmitTasksAction([]task.Info{transferTask})).Return(&ActionResult{}, nil).Times(1)
+	s.mockTimerProcessor.EXPECT().HandleAction(cluster.TestCurrentClusterName, NewSubmitTasksAction([]task.Info{timerTask})).Return(&ActionResult{}, nil).Times(1)
+	s.mockShard.Resource.ExecutionMgr.On("DeleteHistoryTaskFromDLQ", mock.Anything, &persistence.DeleteHistoryTaskFromDLQRequest{TaskID: 11}).Return(nil).Once()
+	s.mockShard.Resource.ExecutionMgr.On("DeleteHistoryTaskFromDLQ", mock.Anything, &persistence.DeleteHistoryTaskFromDLQRequest{TaskID: 12}).Return(nil).Once()
+
+	token, err := s.dlqHandler.RetryTasks(context.Background(), 10, 20, 5, nil)
+	s.NoError(err)
+	s.Nil(token)
+}
+
+func (s *dlqHandlerSuite) TestRetryTasks_SubmitFailed() {
+	transferTask := &persistence.TransferTaskInfo{
+		DomainID: constants.TestDomainID,
+		TaskID:   11,
+		TaskType: persistence.TransferTaskTypeActivityTask,
+	}
+	s.mockShard.Resource.ExecutionMgr.On("GetHistoryTasksFromDLQ", mock.Anything, mock.Anything).Return(&persistence.GetHistoryTasksFromDLQResponse{
+		Tasks: []*persistence.HistoryTaskDLQInfo{
+			{TransferTaskInfo: transferTask},
+		},
+	}, nil).Once()
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainByID(constants.TestDomainID).Return(nil, &types.EntityNotExistsError{}).Times(1)
+	s.mockShard.Resource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockTransferProcessor.EXPECT().HandleAction(cluster.TestCurrentClusterName, gomock.Any()).Return(nil, errProcessorShutdown).Times(1)
+
+	_, err := s.dlqHandler.RetryTasks(context.Background(), 10, 20, 5, nil)
+	s.Equal(errProcessorShutdown, err)
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
 )
@@ -66,6 +67,7 @@ type (
 		EnableStuckTaskSplitByDomainID       dynamicconfig.BoolPropertyFnWithDomainIDFilter
 		StuckTaskSplitThreshold              dynamicconfig.MapPropertyFn
 		SplitLookAheadDurationByDomainID     dynamicconfig.DurationPropertyFnWithDomainIDFilter
+		DomainTierSplitLevel                 dynamicconfig.MapPropertyFn
 		PollBackoffInterval                  dynamicconfig.DurationPropertyFn
 		PollBackoffIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
 		EnablePersistQueueStates             dynamicconfig.BoolPropertyFn
@@ -233,6 +235,22 @@ func (p *processorBase) initializeSplitPolicy(
 	var policies []ProcessingQueueSplitPolicy
 	maxNewQueueLevel := p.options.SplitMaxLevel()
 
+	if p.options.DomainTierSplitLevel != nil {
+		tierSplitLevel, err := common.ConvertDynamicConfigMapPropertyToStringIntMap(p.options.DomainTierSplitLevel())
+		if err != nil {
+			p.logger.Error("Failed to convert domain tier split level", tag.Error(err))
+		} else if len(tierSplitLevel) != 0 {
+			policies = append(policies, NewDomainTierSplitPolicy(
+				tierSplitLevel,
+				p.getDomainTier,
+				maxNewQueueLevel,
+				lookAheadFunc,
+				p.logger,
+				p.metricsScope,
+			))
+		}
+	}
+
 	pendingTaskThresholds, err := common.ConvertDynamicConfigMapPropertyToIntMap(p.options.PendingTaskSplitThreshold())
 	if err != nil {
 		p.logger.Error("Failed to convert pending task threshold", tag.Error(err))
@@ -279,6 +297,16 @@ func (p *processorBase) initializeSplitPolicy(
 	return NewAggregatedSplitPolicy(policies...)
 }
 
+func (p *processorBase) getDomainTier(
+	domainID string,
+) string {
+	domainName, err := p.shard.GetDomainCache().GetDomainName(domainID)
+	if err != nil {
+		return config.DomainTierStandard
+	}
+	return p.shard.GetConfig().TaskDomainTier(domainName)
+}
+
 func (p *processorBase) splitProcessingQueueCollection(
 	splitPolicy ProcessingQueueSplitPolicy,
 	upsertPollTimeFn func(int, time.Time),
@@ -475,6 +503,21 @@ func (p *processorBase) submitTask(
 	return true, nil
 }
 
+func (p *processorBase) submitTasks(
+	action *Action,
+	taskInitializer task.Initializer,
+) (*ActionResult, error) {
+	for _, taskInfo := range action.SubmitTasksAttributes.TaskInfos {
+		if _, err := p.submitTask(taskInitializer(taskInfo)); err != nil {
+			return nil, err
+		}
+	}
+	return &ActionResult{
+		ActionType:        ActionTypeSubmitTasks,
+		SubmitTasksResult: &SubmitTasksResult{},
+	}, nil
+}
+
 func newProcessingQueueCollections(
 	processingQueueStates []ProcessingQueueState,
 	logger log.Logger,
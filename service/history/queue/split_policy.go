@@ -37,6 +37,7 @@ const (
 	policyTypeStuckTask
 	policyTypeSelectedDomain
 	policyTypeRandom
+	policyTypeDomainTier
 )
 
 type (
@@ -79,6 +80,16 @@ type (
 		metricsScope metrics.Scope
 	}
 
+	domainTierSplitPolicy struct {
+		tierSplitLevel   map[string]int // domain tier -> queue level
+		domainTierFn     func(domainID string) string
+		maxNewQueueLevel int
+		lookAheadFunc    lookAheadFunc
+
+		logger       log.Logger
+		metricsScope metrics.Scope
+	}
+
 	aggregatedSplitPolicy struct {
 		policies []ProcessingQueueSplitPolicy
 	}
@@ -158,6 +169,26 @@ func NewRandomSplitPolicy(
 	}
 }
 
+// NewDomainTierSplitPolicy creates a split policy that moves domains
+// into the queue level configured for their tier
+func NewDomainTierSplitPolicy(
+	tierSplitLevel map[string]int,
+	domainTierFn func(domainID string) string,
+	maxNewQueueLevel int,
+	lookAheadFunc lookAheadFunc,
+	logger log.Logger,
+	metricsScope metrics.Scope,
+) ProcessingQueueSplitPolicy {
+	return &domainTierSplitPolicy{
+		tierSplitLevel:   tierSplitLevel,
+		domainTierFn:     domainTierFn,
+		maxNewQueueLevel: maxNewQueueLevel,
+		lookAheadFunc:    lookAheadFunc,
+		logger:           logger,
+		metricsScope:     metricsScope,
+	}
+}
+
 // NewAggregatedSplitPolicy creates a new processing queue split policy
 // that which combines other policies. Policies are evaluated in the order
 // they passed in, and if one policy returns an non-empty result, that result
@@ -364,6 +395,60 @@ func (p *randomSplitPolicy) Evaluate(
 	)
 }
 
+func (p *domainTierSplitPolicy) Evaluate(
+	queue ProcessingQueue,
+) []ProcessingQueueState {
+	queueImpl := queue.(*processingQueueImpl)
+
+	if queueImpl.state.level >= p.maxNewQueueLevel {
+		// already reaches max level, skip splitting
+		return nil
+	}
+
+	domainIDs := make(map[string]struct{})
+	for _, task := range queueImpl.outstandingTasks {
+		domainIDs[task.GetDomainID()] = struct{}{}
+	}
+
+	// domains are only moved to a higher level, so that this policy
+	// won't move domains back and forth with other split policies
+	newQueueLevel := p.maxNewQueueLevel + 1
+	domainToSplit := make(map[string]struct{})
+	for domainID := range domainIDs {
+		level, ok := p.tierSplitLevel[p.domainTierFn(domainID)]
+		if !ok || level <= queueImpl.state.level || level > p.maxNewQueueLevel {
+			continue
+		}
+
+		if level < newQueueLevel {
+			newQueueLevel = level
+			domainToSplit = make(map[string]struct{})
+		}
+		if level == newQueueLevel {
+			domainToSplit[domainID] = struct{}{}
+		}
+	}
+
+	if len(domainToSplit) == 0 {
+		return nil
+	}
+
+	p.logger.Info("Split processing queue",
+		tag.QueueLevel(newQueueLevel),
+		tag.PreviousQueueLevel(queueImpl.state.level),
+		tag.WorkflowDomainIDs(domainToSplit),
+		tag.QueueSplitPolicyType(policyTypeDomainTier),
+	)
+	p.metricsScope.IncCounter(metrics.ProcessingQueueDomainTierSplitCounter)
+
+	return splitQueueHelper(
+		queueImpl,
+		domainToSplit,
+		newQueueLevel,
+		p.lookAheadFunc,
+	)
+}
+
 func (p *aggregatedSplitPolicy) Evaluate(
 	queue ProcessingQueue,
 ) []ProcessingQueueState {
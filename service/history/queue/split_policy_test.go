@@ -660,6 +660,156 @@ func (s *splitPolicySuite) TestRandomSplitPolicy() {
 	}
 }
 
+func (s *splitPolicySuite) TestDomainTierSplitPolicy() {
+	maxNewQueueLevel := 3
+	lookAheadFunc := func(key task.Key, _ string) task.Key {
+		currentID := key.(testKey).ID
+		return testKey{ID: currentID + 10}
+	}
+	tierSplitLevel := map[string]int{
+		"critical": 1,
+		"batch":    2,
+	}
+	domainTier := map[string]string{
+		"testDomain1": "critical",
+		"testDomain2": "standard",
+		"testDomain3": "batch",
+	}
+
+	testCases := []struct {
+		currentState      ProcessingQueueState
+		domainIDs         []string
+		expectedNewStates []ProcessingQueueState
+	}{
+		{
+			currentState: newProcessingQueueState(
+				0,
+				testKey{ID: 0},
+				testKey{ID: 5},
+				testKey{ID: 10},
+				NewDomainFilter(
+					map[string]struct{}{"testDomain1": {}, "testDomain2": {}, "testDomain3": {}},
+					false,
+				),
+			),
+			domainIDs: []string{"testDomain1", "testDomain2", "testDomain3"},
+			expectedNewStates: []ProcessingQueueState{
+				newProcessingQueueState(
+					1,
+					testKey{ID: 0},
+					testKey{ID: 5},
+					testKey{ID: 10},
+					NewDomainFilter(
+						map[string]struct{}{"testDomain1": {}},
+						false,
+					),
+				),
+				newProcessingQueueState(
+					0,
+					testKey{ID: 0},
+					testKey{ID: 5},
+					testKey{ID: 10},
+					NewDomainFilter(
+						map[string]struct{}{"testDomain2": {}, "testDomain3": {}},
+						false,
+					),
+				),
+			},
+		},
+		{
+			currentState: newProcessingQueueState(
+				1,
+				testKey{ID: 0},
+				testKey{ID: 5},
+				testKey{ID: 10},
+				NewDomainFilter(
+					map[string]struct{}{"testDomain1": {}, "testDomain3": {}},
+					false,
+				),
+			),
+			domainIDs: []string{"testDomain1", "testDomain3"},
+			expectedNewStates: []ProcessingQueueState{
+				newProcessingQueueState(
+					2,
+					testKey{ID: 0},
+					testKey{ID: 5},
+					testKey{ID: 10},
+					NewDomainFilter(
+						map[string]struct{}{"testDomain3": {}},
+						false,
+					),
+				),
+				newProcessingQueueState(
+					1,
+					testKey{ID: 0},
+					testKey{ID: 5},
+					testKey{ID: 10},
+					NewDomainFilter(
+						map[string]struct{}{"testDomain1": {}},
+						false,
+					),
+				),
+			},
+		},
+		{
+			currentState: newProcessingQueueState(
+				2,
+				testKey{ID: 0},
+				testKey{ID: 5},
+				testKey{ID: 10},
+				NewDomainFilter(
+					map[string]struct{}{"testDomain1": {}, "testDomain2": {}},
+					false,
+				),
+			),
+			domainIDs:         []string{"testDomain1", "testDomain2"},
+			expectedNewStates: nil,
+		},
+		{
+			currentState: newProcessingQueueState(
+				3,
+				testKey{ID: 0},
+				testKey{ID: 5},
+				testKey{ID: 10},
+				NewDomainFilter(
+					map[string]struct{}{"testDomain3": {}},
+					false,
+				),
+			),
+			domainIDs:         []string{"testDomain3"},
+			expectedNewStates: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		outstandingTasks := make(map[task.Key]task.Task)
+		for _, domainID := range tc.domainIDs {
+			mockTask := task.NewMockTask(s.controller)
+			mockTask.EXPECT().GetDomainID().Return(domainID).MaxTimes(1)
+			outstandingTasks[task.NewMockKey(s.controller)] = mockTask
+		}
+
+		queue := newProcessingQueue(
+			tc.currentState,
+			outstandingTasks,
+			nil,
+			nil,
+		)
+		splitPolicy := NewDomainTierSplitPolicy(
+			tierSplitLevel,
+			func(domainID string) string {
+				return domainTier[domainID]
+			},
+			maxNewQueueLevel,
+			lookAheadFunc,
+			s.logger,
+			s.metricsScope,
+		)
+
+		s.assertQueueStatesEqual(tc.expectedNewStates, splitPolicy.Evaluate(queue))
+	}
+}
+
 func (s *splitPolicySuite) TestAggregatedSplitPolicy() {
 	expectedNewStates := []ProcessingQueueState{
 		NewMockProcessingQueueState(s.controller),
@@ -401,12 +401,21 @@ func (t *timerQueueProcessorBase) splitQueue() {
 }
 
 func (t *timerQueueProcessorBase) handleActionNotification(notification actionNotification) {
-	t.processorBase.handleActionNotification(notification, func() {
-		switch notification.action.ActionType {
-		case ActionTypeReset:
-			t.upsertPollTime(defaultProcessingQueueLevel, time.Time{})
+	switch notification.action.ActionType {
+	case ActionTypeSubmitTasks:
+		result, err := t.submitTasks(notification.action, t.taskInitializer)
+		notification.resultNotificationCh <- actionResultNotification{
+			result: result,
+			err:    err,
 		}
-	})
+	default:
+		t.processorBase.handleActionNotification(notification, func() {
+			switch notification.action.ActionType {
+			case ActionTypeReset:
+				t.upsertPollTime(defaultProcessingQueueLevel, time.Time{})
+			}
+		})
+	}
 }
 
 func (t *timerQueueProcessorBase) readAndFilterTasks(
@@ -660,6 +669,7 @@ func newTimerQueueProcessorOptions(
 		options.EnableStuckTaskSplitByDomainID = config.QueueProcessorEnableStuckTaskSplitByDomainID
 		options.StuckTaskSplitThreshold = config.QueueProcessorStuckTaskSplitThreshold
 		options.SplitLookAheadDurationByDomainID = config.QueueProcessorSplitLookAheadDurationByDomainID
+		options.DomainTierSplitLevel = config.QueueProcessorDomainTierSplitLevel
 
 		options.EnablePersistQueueStates = config.QueueProcessorEnablePersistQueueStates
 		options.EnableLoadQueueStates = config.QueueProcessorEnableLoadQueueStates
@@ -484,12 +484,21 @@ func (t *transferQueueProcessorBase) splitQueue() {
 }
 
 func (t *transferQueueProcessorBase) handleActionNotification(notification actionNotification) {
-	t.processorBase.handleActionNotification(notification, func() {
-		switch notification.action.ActionType {
-		case ActionTypeReset:
-			t.readyForProcess(defaultProcessingQueueLevel)
+	switch notification.action.ActionType {
+	case ActionTypeSubmitTasks:
+		result, err := t.submitTasks(notification.action, t.taskInitializer)
+		notification.resultNotificationCh <- actionResultNotification{
+			result: result,
+			err:    err,
 		}
-	})
+	default:
+		t.processorBase.handleActionNotification(notification, func() {
+			switch notification.action.ActionType {
+			case ActionTypeReset:
+				t.readyForProcess(defaultProcessingQueueLevel)
+			}
+		})
+	}
 }
 
 func (t *transferQueueProcessorBase) readTasks(
@@ -569,6 +578,7 @@ func newTransferQueueProcessorOptions(
 		options.EnableStuckTaskSplitByDomainID = config.QueueProcessorEnableStuckTaskSplitByDomainID
 		options.StuckTaskSplitThreshold = config.QueueProcessorStuckTaskSplitThreshold
 		options.SplitLookAheadDurationByDomainID = config.QueueProcessorSplitLookAheadDurationByDomainID
+		options.DomainTierSplitLevel = config.QueueProcessorDomainTierSplitLevel
 
 		options.EnablePersistQueueStates = config.QueueProcessorEnablePersistQueueStates
 		options.EnableLoadQueueStates = config.QueueProcessorEnableLoadQueueStates
@@ -36,7 +36,8 @@ const (
 )
 
 var (
-	errInvalidCluster = &types.BadRequestError{Message: "Invalid target cluster name."}
+	errInvalidCluster     = &types.BadRequestError{Message: "Invalid target cluster name."}
+	errMessageNotHydrated = &types.EntityNotExistsError{Message: "Replication message could not be hydrated by source cluster."}
 )
 
 type (
@@ -61,6 +62,17 @@ type (
 			pageSize int,
 			pageToken []byte,
 		) ([]byte, error)
+		TryMergeMessages(
+			ctx context.Context,
+			sourceCluster string,
+			lastMessageID int64,
+			pageSize int,
+			pageToken []byte,
+		) ([]byte, map[int64]error, error)
+		GetMessageCount(
+			ctx context.Context,
+			sourceCluster string,
+		) (int64, error)
 	}
 
 	dlqHandlerImpl struct {
@@ -185,6 +197,23 @@ func (r *dlqHandlerImpl) PurgeMessages(
 	return nil
 }
 
+func (r *dlqHandlerImpl) GetMessageCount(
+	ctx context.Context,
+	sourceCluster string,
+) (int64, error) {
+
+	resp, err := r.shard.GetExecutionManager().GetReplicationDLQSize(
+		ctx,
+		&persistence.GetReplicationDLQSizeRequest{
+			SourceClusterName: sourceCluster,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return resp.Size, nil
+}
+
 func (r *dlqHandlerImpl) MergeMessages(
 	ctx context.Context,
 	sourceCluster string,
@@ -193,42 +222,120 @@ func (r *dlqHandlerImpl) MergeMessages(
 	pageToken []byte,
 ) ([]byte, error) {
 
-	if _, ok := r.taskExecutors[sourceCluster]; !ok {
-		return nil, errInvalidCluster
+	token, _, err := r.mergeMessages(
+		ctx,
+		sourceCluster,
+		lastMessageID,
+		pageSize,
+		pageToken,
+		true,
+	)
+	return token, err
+}
+
+// TryMergeMessages applies every message of one page, messages which fail to apply
+// are kept in DLQ and their errors are returned keyed by task ID
+func (r *dlqHandlerImpl) TryMergeMessages(
+	ctx context.Context,
+	sourceCluster string,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]byte, map[int64]error, error) {
+
+	return r.mergeMessages(
+		ctx,
+		sourceCluster,
+		lastMessageID,
+		pageSize,
+		pageToken,
+		false,
+	)
+}
+
+func (r *dlqHandlerImpl) mergeMessages(
+	ctx context.Context,
+	sourceCluster string,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+	stopOnFailure bool,
+) ([]byte, map[int64]error, error) {
+
+	taskExecutor, ok := r.taskExecutors[sourceCluster]
+	if !ok {
+		return nil, nil, errInvalidCluster
 	}
 
-	tasks, _, token, err := r.readMessagesWithAckLevel(
+	tasks, taskInfos, token, err := r.readMessagesWithAckLevel(
 		ctx,
 		sourceCluster,
 		lastMessageID,
 		pageSize,
 		pageToken,
 	)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(taskInfos) == 0 {
+		return token, nil, nil
+	}
 
-	lastMessageID = defaultBeginningMessageID
+	tasksByID := make(map[int64]*types.ReplicationTask, len(tasks))
 	for _, task := range tasks {
-		if _, err := r.taskExecutors[sourceCluster].execute(
-			task,
-			true,
-		); err != nil {
-			return nil, err
+		tasksByID[task.GetSourceTaskID()] = task
+	}
+
+	// merged messages are range deleted once the page is processed,
+	// the ranges are split around the messages which are kept in DLQ
+	var deleteRequests []*persistence.RangeDeleteReplicationTaskFromDLQRequest
+	failures := make(map[int64]error)
+	beginTaskID := taskInfos[0].GetTaskID() - 1
+	endTaskID := beginTaskID
+	addDeleteRequest := func() {
+		if endTaskID > beginTaskID {
+			deleteRequests = append(deleteRequests, &persistence.RangeDeleteReplicationTaskFromDLQRequest{
+				SourceClusterName:    sourceCluster,
+				ExclusiveBeginTaskID: beginTaskID,
+				InclusiveEndTaskID:   endTaskID,
+			})
+		}
+	}
+	for _, taskInfo := range taskInfos {
+		taskID := taskInfo.GetTaskID()
+		task, ok := tasksByID[taskID]
+		switch {
+		case ok:
+			_, err = taskExecutor.execute(task, true)
+		case stopOnFailure:
+			// the message can never be applied, it is dropped as part of the merge
+			err = nil
+		default:
+			err = errMessageNotHydrated
+		}
+		if err == nil {
+			endTaskID = taskID
+			continue
 		}
 
-		if lastMessageID < task.GetSourceTaskID() {
-			lastMessageID = task.GetSourceTaskID()
+		failures[taskID] = err
+		addDeleteRequest()
+		beginTaskID, endTaskID = taskID, taskID
+		if stopOnFailure {
+			break
 		}
 	}
+	addDeleteRequest()
 
-	err = r.shard.GetExecutionManager().RangeDeleteReplicationTaskFromDLQ(
-		ctx,
-		&persistence.RangeDeleteReplicationTaskFromDLQRequest{
-			SourceClusterName:    sourceCluster,
-			ExclusiveBeginTaskID: defaultBeginningMessageID,
-			InclusiveEndTaskID:   lastMessageID,
-		},
-	)
-	if err != nil {
-		return nil, err
+	for _, request := range deleteRequests {
+		if err := r.shard.GetExecutionManager().RangeDeleteReplicationTaskFromDLQ(ctx, request); err != nil {
+			return nil, nil, err
+		}
+	}
+	if stopOnFailure {
+		for _, err := range failures {
+			return nil, nil, err
+		}
 	}
-	return token, nil
+	return token, failures, nil
 }
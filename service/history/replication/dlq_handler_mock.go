@@ -103,3 +103,34 @@ func (mr *MockDLQHandlerMockRecorder) MergeMessages(ctx, sourceCluster, lastMess
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeMessages", reflect.TypeOf((*MockDLQHandler)(nil).MergeMessages), ctx, sourceCluster, lastMessageID, pageSize, pageToken)
 }
+
+// TryMergeMessages mocks base method
+func (m *MockDLQHandler) TryMergeMessages(ctx context.Context, sourceCluster string, lastMessageID int64, pageSize int, pageToken []byte) ([]byte, map[int64]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TryMergeMessages", ctx, sourceCluster, lastMessageID, pageSize, pageToken)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(map[int64]error)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TryMergeMessages indicates an expected call of TryMergeMessages
+func (mr *MockDLQHandlerMockRecorder) TryMergeMessages(ctx, sourceCluster, lastMessageID, pageSize, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TryMergeMessages", reflect.TypeOf((*MockDLQHandler)(nil).TryMergeMessages), ctx, sourceCluster, lastMessageID, pageSize, pageToken)
+}
+
+// GetMessageCount mocks base method
+func (m *MockDLQHandler) GetMessageCount(ctx context.Context, sourceCluster string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageCount", ctx, sourceCluster)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageCount indicates an expected call of GetMessageCount
+func (mr *MockDLQHandlerMockRecorder) GetMessageCount(ctx, sourceCluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageCount", reflect.TypeOf((*MockDLQHandler)(nil).GetMessageCount), ctx, sourceCluster)
+}
@@ -168,6 +168,17 @@ func (s *dlqHandlerSuite) TestPurgeMessages_OK() {
 	s.NoError(err)
 }
 
+func (s *dlqHandlerSuite) TestGetMessageCount_OK() {
+	s.executionManager.On("GetReplicationDLQSize", mock.Anything,
+		&persistence.GetReplicationDLQSizeRequest{
+			SourceClusterName: s.sourceCluster,
+		}).Return(&persistence.GetReplicationDLQSizeResponse{Size: 3}, nil).Times(1)
+
+	count, err := s.messageHandler.GetMessageCount(context.Background(), s.sourceCluster)
+	s.NoError(err)
+	s.Equal(int64(3), count)
+}
+
 func (s *dlqHandlerSuite) TestMergeMessages_OK() {
 	ctx := context.Background()
 	lastMessageID := int64(1)
@@ -209,7 +220,7 @@ func (s *dlqHandlerSuite) TestMergeMessages_OK() {
 	s.executionManager.On("RangeDeleteReplicationTaskFromDLQ", mock.Anything,
 		&persistence.RangeDeleteReplicationTaskFromDLQRequest{
 			SourceClusterName:    s.sourceCluster,
-			ExclusiveBeginTaskID: -1,
+			ExclusiveBeginTaskID: 0,
 			InclusiveEndTaskID:   lastMessageID,
 		}).Return(nil).Times(1)
 
@@ -217,3 +228,60 @@ func (s *dlqHandlerSuite) TestMergeMessages_OK() {
 	s.NoError(err)
 	s.Nil(token)
 }
+
+func (s *dlqHandlerSuite) TestTryMergeMessages_OK() {
+	ctx := context.Background()
+	lastMessageID := int64(4)
+	pageSize := 4
+	pageToken := []byte{}
+
+	resp := &persistence.GetReplicationTasksFromDLQResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{TaskID: 1}, {TaskID: 2}, {TaskID: 3}, {TaskID: 4},
+		},
+		NextPageToken: []byte{1},
+	}
+	s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, &persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: s.sourceCluster,
+		GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+			ReadLevel:     -1,
+			MaxReadLevel:  lastMessageID,
+			BatchSize:     pageSize,
+			NextPageToken: pageToken,
+		},
+	}).Return(resp, nil).Times(1)
+
+	s.mockClientBean.EXPECT().GetRemoteAdminClient(s.sourceCluster).Return(s.adminClient).AnyTimes()
+	mergedTask := &types.ReplicationTask{SourceTaskID: 1}
+	conflictTask := &types.ReplicationTask{SourceTaskID: 2}
+	lastTask := &types.ReplicationTask{SourceTaskID: 4}
+	// the message with task ID 3 could not be hydrated by the source cluster
+	s.adminClient.EXPECT().
+		GetDLQReplicationMessages(ctx, gomock.Any()).
+		Return(&types.GetDLQReplicationMessagesResponse{
+			ReplicationTasks: []*types.ReplicationTask{mergedTask, conflictTask, lastTask},
+		}, nil)
+	s.taskExecutor.EXPECT().execute(mergedTask, true).Return(0, nil).Times(1)
+	s.taskExecutor.EXPECT().execute(conflictTask, true).Return(0, &types.BadRequestError{}).Times(1)
+	s.taskExecutor.EXPECT().execute(lastTask, true).Return(0, nil).Times(1)
+	s.executionManager.On("RangeDeleteReplicationTaskFromDLQ", mock.Anything,
+		&persistence.RangeDeleteReplicationTaskFromDLQRequest{
+			SourceClusterName:    s.sourceCluster,
+			ExclusiveBeginTaskID: 0,
+			InclusiveEndTaskID:   1,
+		}).Return(nil).Times(1)
+	s.executionManager.On("RangeDeleteReplicationTaskFromDLQ", mock.Anything,
+		&persistence.RangeDeleteReplicationTaskFromDLQRequest{
+			SourceClusterName:    s.sourceCluster,
+			ExclusiveBeginTaskID: 3,
+			InclusiveEndTaskID:   4,
+		}).Return(nil).Times(1)
+
+	token, failures, err := s.messageHandler.TryMergeMessages(ctx, s.sourceCluster, lastMessageID, pageSize, pageToken)
+	s.NoError(err)
+	s.Equal([]byte{1}, token)
+	s.Equal(map[int64]error{
+		2: &types.BadRequestError{},
+		3: errMessageNotHydrated,
+	}, failures)
+}
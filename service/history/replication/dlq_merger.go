@@ -0,0 +1,324 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+)
+
+const (
+	dlqMergeJitterCoefficient = 0.15
+	dlqMergeTimeout           = 5 * time.Minute
+)
+
+const (
+	dlqMergeFailureNone dlqMergeFailure = iota
+	// the message will be merged again in the next round
+	dlqMergeFailureRetryable
+	// the workflow history is missing in source or current cluster
+	dlqMergeFailureMissingHistory
+	// the replicated events conflict with the version history in current cluster
+	dlqMergeFailureVersionConflict
+	// the domain is not active in the cluster the message is redirected to
+	dlqMergeFailureDomainNotActive
+)
+
+type (
+	dlqMergeFailure int
+
+	// DLQMerger periodically merges the replication DLQ messages of the shards owned
+	// by the host in background, one shard at a time
+	DLQMerger interface {
+		common.Daemon
+
+		AddShard(shardID int, dlqHandler DLQHandler, sourceClusters []string)
+		RemoveShard(shardID int)
+	}
+
+	dlqMergerImpl struct {
+		sync.Mutex
+
+		status       int32
+		config       *config.Config
+		shards       map[int]*dlqMergerShard
+		metricsScope metrics.Scope
+		logger       log.Logger
+		done         chan struct{}
+	}
+
+	dlqMergerShard struct {
+		dlqHandler     DLQHandler
+		sourceClusters []string
+		// unresolved messages already surfaced, keyed by source cluster and task ID,
+		// only accessed by the merge loop
+		unresolved map[string]map[int64]dlqMergeFailure
+	}
+)
+
+var _ DLQMerger = (*dlqMergerImpl)(nil)
+
+// NewDLQMerger creates a new replication DLQ merger
+func NewDLQMerger(
+	config *config.Config,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) DLQMerger {
+
+	return &dlqMergerImpl{
+		status:       common.DaemonStatusInitialized,
+		config:       config,
+		shards:       make(map[int]*dlqMergerShard),
+		metricsScope: metricsClient.Scope(metrics.ReplicationDLQStatsScope),
+		logger:       logger,
+		done:         make(chan struct{}),
+	}
+}
+
+// Start starts the merger
+func (m *dlqMergerImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	go m.mergeLoop()
+	m.logger.Info("Replication DLQ merger started.")
+}
+
+// Stop stops the merger
+func (m *dlqMergerImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(m.done)
+	m.logger.Info("Replication DLQ merger stopped.")
+}
+
+// AddShard starts merging the replication DLQ of the shard
+func (m *dlqMergerImpl) AddShard(
+	shardID int,
+	dlqHandler DLQHandler,
+	sourceClusters []string,
+) {
+
+	unresolved := make(map[string]map[int64]dlqMergeFailure, len(sourceClusters))
+	for _, sourceCluster := range sourceClusters {
+		unresolved[sourceCluster] = make(map[int64]dlqMergeFailure)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	m.shards[shardID] = &dlqMergerShard{
+		dlqHandler:     dlqHandler,
+		sourceClusters: sourceClusters,
+		unresolved:     unresolved,
+	}
+}
+
+// RemoveShard stops merging the replication DLQ of the shard
+func (m *dlqMergerImpl) RemoveShard(
+	shardID int,
+) {
+
+	m.Lock()
+	defer m.Unlock()
+	delete(m.shards, shardID)
+}
+
+func (m *dlqMergerImpl) mergeLoop() {
+	timer := time.NewTimer(backoff.JitDuration(
+		m.config.ReplicationDLQAutoMergeInterval(),
+		dlqMergeJitterCoefficient,
+	))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			if m.config.ReplicationDLQAutoMergeEnabled() {
+				m.mergeAll()
+			}
+			timer.Reset(backoff.JitDuration(
+				m.config.ReplicationDLQAutoMergeInterval(),
+				dlqMergeJitterCoefficient,
+			))
+		case <-m.done:
+			return
+		}
+	}
+}
+
+func (m *dlqMergerImpl) mergeAll() {
+	unresolvedCount := make(map[dlqMergeFailure]int)
+	for _, shardID := range m.getShardIDs() {
+		select {
+		case <-m.done:
+			return
+		default:
+		}
+
+		shard, ok := m.getShard(shardID)
+		if !ok {
+			continue
+		}
+		m.mergeShard(shardID, shard)
+
+		for _, messages := range shard.unresolved {
+			for _, failure := range messages {
+				unresolvedCount[failure]++
+			}
+		}
+	}
+
+	m.metricsScope.UpdateGauge(metrics.ReplicationDLQUnresolvedMissingHistory, float64(unresolvedCount[dlqMergeFailureMissingHistory]))
+	m.metricsScope.UpdateGauge(metrics.ReplicationDLQUnresolvedVersionConflict, float64(unresolvedCount[dlqMergeFailureVersionConflict]))
+	m.metricsScope.UpdateGauge(metrics.ReplicationDLQUnresolvedDomainNotActive, float64(unresolvedCount[dlqMergeFailureDomainNotActive]))
+}
+
+func (m *dlqMergerImpl) mergeShard(
+	shardID int,
+	shard *dlqMergerShard,
+) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), dlqMergeTimeout)
+	defer cancel()
+
+	for _, sourceCluster := range shard.sourceClusters {
+		if err := m.merge(ctx, shardID, shard, sourceCluster); err != nil {
+			m.logger.Warn("Failed to merge replication DLQ messages.",
+				tag.ShardID(shardID),
+				tag.SourceCluster(sourceCluster),
+				tag.Error(err),
+			)
+		}
+	}
+}
+
+func (m *dlqMergerImpl) merge(
+	ctx context.Context,
+	shardID int,
+	shard *dlqMergerShard,
+	sourceCluster string,
+) error {
+
+	// messages not seen in this round are either merged or purged
+	unresolved := make(map[int64]dlqMergeFailure)
+
+	var pageToken []byte
+	for more := true; more; more = len(pageToken) > 0 {
+		token, failures, err := shard.dlqHandler.TryMergeMessages(
+			ctx,
+			sourceCluster,
+			common.EndMessageID,
+			m.config.ReplicationDLQAutoMergeBatchSize(),
+			pageToken,
+		)
+		if err != nil {
+			return err
+		}
+		pageToken = token
+
+		for taskID, err := range failures {
+			failure := classifyDLQMergeError(err)
+			if failure == dlqMergeFailureRetryable {
+				m.metricsScope.IncCounter(metrics.ReplicationDLQMergeRetryable)
+				continue
+			}
+			if _, ok := shard.unresolved[sourceCluster][taskID]; !ok {
+				m.logger.Error("Unable to merge replication DLQ message.",
+					tag.ShardID(shardID),
+					tag.SourceCluster(sourceCluster),
+					tag.TaskID(taskID),
+					tag.ReplicationDLQFailureClass(failure.String()),
+					tag.Error(err),
+				)
+			}
+			unresolved[taskID] = failure
+		}
+	}
+	shard.unresolved[sourceCluster] = unresolved
+	return nil
+}
+
+func (m *dlqMergerImpl) getShardIDs() []int {
+	m.Lock()
+	defer m.Unlock()
+
+	shardIDs := make([]int, 0, len(m.shards))
+	for shardID := range m.shards {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Ints(shardIDs)
+	return shardIDs
+}
+
+func (m *dlqMergerImpl) getShard(
+	shardID int,
+) (*dlqMergerShard, bool) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	shard, ok := m.shards[shardID]
+	return shard, ok
+}
+
+func classifyDLQMergeError(err error) dlqMergeFailure {
+	switch err.(type) {
+	case *types.RetryTaskV2Error, *types.EntityNotExistsError:
+		return dlqMergeFailureMissingHistory
+	case *types.BadRequestError:
+		return dlqMergeFailureVersionConflict
+	case *types.DomainNotActiveError:
+		return dlqMergeFailureDomainNotActive
+	default:
+		return dlqMergeFailureRetryable
+	}
+}
+
+func (f dlqMergeFailure) String() string {
+	switch f {
+	case dlqMergeFailureNone:
+		return "none"
+	case dlqMergeFailureRetryable:
+		return "retryable"
+	case dlqMergeFailureMissingHistory:
+		return "missing-history"
+	case dlqMergeFailureVersionConflict:
+		return "version-conflict"
+	case dlqMergeFailureDomainNotActive:
+		return "domain-not-active"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+)
+
+type (
+	dlqMergerSuite struct {
+		suite.Suite
+		*require.Assertions
+		controller *gomock.Controller
+
+		config        *config.Config
+		dlqHandler    *MockDLQHandler
+		sourceCluster string
+
+		merger *dlqMergerImpl
+	}
+)
+
+func TestDLQMergerSuite(t *testing.T) {
+	s := new(dlqMergerSuite)
+	suite.Run(t, s)
+}
+
+func (s *dlqMergerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.config = config.NewForTest()
+	s.controller = gomock.NewController(s.T())
+	s.dlqHandler = NewMockDLQHandler(s.controller)
+	s.sourceCluster = "test"
+
+	s.merger = NewDLQMerger(
+		s.config,
+		metrics.NewNoopMetricsClient(),
+		loggerimpl.NewLoggerForTest(s.Suite),
+	).(*dlqMergerImpl)
+	s.merger.AddShard(0, s.dlqHandler, []string{s.sourceCluster})
+}
+
+func (s *dlqMergerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *dlqMergerSuite) TestMerge() {
+	ctx := context.Background()
+	shard, ok := s.merger.getShard(0)
+	s.True(ok)
+
+	s.dlqHandler.EXPECT().TryMergeMessages(ctx, s.sourceCluster, common.EndMessageID, gomock.Any(), nil).
+		Return([]byte{1}, map[int64]error{
+			2: &types.BadRequestError{},
+			3: errMessageNotHydrated,
+		}, nil).Times(1)
+	s.dlqHandler.EXPECT().TryMergeMessages(ctx, s.sourceCluster, common.EndMessageID, gomock.Any(), []byte{1}).
+		Return(nil, map[int64]error{
+			4: &types.ServiceBusyError{},
+		}, nil).Times(1)
+
+	s.NoError(s.merger.merge(ctx, 0, shard, s.sourceCluster))
+	s.Equal(map[int64]dlqMergeFailure{
+		2: dlqMergeFailureVersionConflict,
+		3: dlqMergeFailureMissingHistory,
+	}, shard.unresolved[s.sourceCluster])
+
+	// conflict is resolved and the other messages are purged
+	s.dlqHandler.EXPECT().TryMergeMessages(ctx, s.sourceCluster, common.EndMessageID, gomock.Any(), nil).
+		Return(nil, nil, nil).Times(1)
+
+	s.NoError(s.merger.merge(ctx, 0, shard, s.sourceCluster))
+	s.Empty(shard.unresolved[s.sourceCluster])
+}
+
+func (s *dlqMergerSuite) TestMerge_ReadError() {
+	ctx := context.Background()
+	shard, ok := s.merger.getShard(0)
+	s.True(ok)
+	shard.unresolved[s.sourceCluster][1] = dlqMergeFailureDomainNotActive
+	s.dlqHandler.EXPECT().TryMergeMessages(ctx, s.sourceCluster, common.EndMessageID, gomock.Any(), nil).
+		Return(nil, nil, errors.New("read error")).Times(1)
+
+	s.Error(s.merger.merge(ctx, 0, shard, s.sourceCluster))
+	s.Equal(map[int64]dlqMergeFailure{1: dlqMergeFailureDomainNotActive}, shard.unresolved[s.sourceCluster])
+}
+
+func (s *dlqMergerSuite) TestAddRemoveShard() {
+	s.merger.AddShard(2, s.dlqHandler, []string{s.sourceCluster})
+	s.merger.AddShard(1, s.dlqHandler, []string{s.sourceCluster})
+	s.Equal([]int{0, 1, 2}, s.merger.getShardIDs())
+
+	s.merger.RemoveShard(0)
+	s.Equal([]int{1, 2}, s.merger.getShardIDs())
+	_, ok := s.merger.getShard(0)
+	s.False(ok)
+}
+
+func TestClassifyDLQMergeError(t *testing.T) {
+	assert.Equal(t, dlqMergeFailureMissingHistory, classifyDLQMergeError(&types.RetryTaskV2Error{}))
+	assert.Equal(t, dlqMergeFailureMissingHistory, classifyDLQMergeError(&types.EntityNotExistsError{}))
+	assert.Equal(t, dlqMergeFailureVersionConflict, classifyDLQMergeError(&types.BadRequestError{}))
+	assert.Equal(t, dlqMergeFailureDomainNotActive, classifyDLQMergeError(&types.DomainNotActiveError{}))
+	assert.Equal(t, dlqMergeFailureRetryable, classifyDLQMergeError(&types.InternalServiceError{}))
+	assert.Equal(t, dlqMergeFailureRetryable, classifyDLQMergeError(errors.New("timeout")))
+}
@@ -29,6 +29,7 @@ import (
 	ctx "context"
 	"errors"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/uber/cadence/common"
@@ -67,6 +68,15 @@ type (
 			pollingCluster string,
 			lastReadTaskID int64,
 		) (*types.ReplicationMessages, error)
+
+		GetStatus(pollingCluster string) Status
+	}
+
+	// Status is the replication progress of a shard against a remote cluster
+	Status struct {
+		FetchedTaskID int64
+		AckedTaskID   int64
+		LastFetchTime time.Time
 	}
 
 	taskAckManagerImpl struct {
@@ -82,6 +92,10 @@ type (
 
 		// This is the batch size used by pull based RPC replicator.
 		fetchTasksBatchSize dynamicconfig.IntPropertyFnWithShardIDFilter
+
+		sync.RWMutex
+		// polling cluster -> replication progress reported by GetTasks
+		statusByCluster map[string]Status
 	}
 )
 
@@ -111,6 +125,7 @@ func NewTaskAckManager(
 		metricsClient:       shard.GetMetricsClient(),
 		logger:              shard.GetLogger().WithTags(tag.ComponentReplicationAckManager),
 		fetchTasksBatchSize: config.ReplicatorProcessorFetchTasksBatchSize,
+		statusByCluster:     make(map[string]Status),
 	}
 }
 
@@ -217,6 +232,14 @@ TaskInfoLoop:
 		t.logger.Error("error updating replication level for shard", tag.Error(err), tag.OperationFailed)
 	}
 
+	t.Lock()
+	t.statusByCluster[pollingCluster] = Status{
+		FetchedTaskID: readLevel,
+		AckedTaskID:   lastReadTaskID,
+		LastFetchTime: t.shard.GetTimeSource().Now(),
+	}
+	t.Unlock()
+
 	return &types.ReplicationMessages{
 		ReplicationTasks:       replicationTasks,
 		HasMore:                hasMore,
@@ -224,6 +247,25 @@ TaskInfoLoop:
 	}, nil
 }
 
+// GetStatus returns the replication progress of the polling cluster. If the cluster has not polled
+// since the shard was loaded, only the persisted ack level is known.
+func (t *taskAckManagerImpl) GetStatus(
+	pollingCluster string,
+) Status {
+
+	t.RLock()
+	status, ok := t.statusByCluster[pollingCluster]
+	t.RUnlock()
+	if !ok {
+		ackLevel := t.shard.GetClusterReplicationLevel(pollingCluster)
+		return Status{
+			FetchedTaskID: ackLevel,
+			AckedTaskID:   ackLevel,
+		}
+	}
+	return status
+}
+
 func (t *taskAckManagerImpl) toReplicationTask(
 	ctx ctx.Context,
 	taskInfo task.Info,
@@ -542,6 +584,7 @@ func (t *taskAckManagerImpl) generateSyncActivityTask(
 					LastWorkerIdentity: activityInfo.LastWorkerIdentity,
 					LastFailureDetails: activityInfo.LastFailureDetails,
 					VersionHistory:     versionHistory,
+					Paused:             activityInfo.Paused,
 				},
 				CreationTime: common.Int64Ptr(taskInfo.CreationTime),
 			}, nil
@@ -87,3 +87,17 @@ func (mr *MockTaskAckManagerMockRecorder) GetTasks(ctx, pollingCluster, lastRead
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasks", reflect.TypeOf((*MockTaskAckManager)(nil).GetTasks), ctx, pollingCluster, lastReadTaskID)
 }
+
+// GetStatus mocks base method
+func (m *MockTaskAckManager) GetStatus(pollingCluster string) Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatus", pollingCluster)
+	ret0, _ := ret[0].(Status)
+	return ret0
+}
+
+// GetStatus indicates an expected call of GetStatus
+func (mr *MockTaskAckManagerMockRecorder) GetStatus(pollingCluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockTaskAckManager)(nil).GetStatus), pollingCluster)
+}
@@ -571,6 +571,8 @@ func (s *taskAckManagerSuite) TestGenerateSyncActivityTask_OK() {
 		DomainID:                 domainID,
 		RequestID:                uuid.New(),
 		LastHeartBeatUpdatedTime: time.Now(),
+		Attempt:                  3,
+		Paused:                   true,
 	}
 	workflowContext, release, _ := s.ackManager.executionCache.GetOrCreateWorkflowExecutionForBackground(
 		domainID,
@@ -612,6 +614,8 @@ func (s *taskAckManagerSuite) TestGenerateSyncActivityTask_OK() {
 	s.Equal(activityInfo.StartedTime.UnixNano(), task.GetSyncActivityTaskAttributes().GetStartedTime())
 	s.Equal(activityInfo.LastHeartBeatUpdatedTime.UnixNano(), task.GetSyncActivityTaskAttributes().GetLastHeartbeatTime())
 	s.Equal(activityInfo.Version, task.GetSyncActivityTaskAttributes().GetVersion())
+	s.Equal(activityInfo.Attempt, task.GetSyncActivityTaskAttributes().GetAttempt())
+	s.True(task.GetSyncActivityTaskAttributes().GetPaused())
 	s.Equal(versionHistories.Histories[0].ToInternalType(), task.GetSyncActivityTaskAttributes().GetVersionHistory())
 }
 
@@ -948,6 +952,21 @@ func (s *taskAckManagerSuite) TestGetTasks() {
 	s.NoError(err)
 	ackLevel := s.mockShard.GetClusterReplicationLevel(clusterName)
 	s.Equal(int64(10), ackLevel)
+
+	status := s.ackManager.GetStatus(clusterName)
+	s.Equal(int64(10), status.AckedTaskID)
+	s.Equal(taskInfo.GetTaskID(), status.FetchedTaskID)
+	s.False(status.LastFetchTime.IsZero())
+}
+
+func (s *taskAckManagerSuite) TestGetStatus_NotPolled() {
+	clusterName := cluster.TestAlternativeClusterName
+	ackLevel := s.mockShard.GetClusterReplicationLevel(clusterName)
+
+	status := s.ackManager.GetStatus(clusterName)
+	s.Equal(ackLevel, status.AckedTaskID)
+	s.Equal(ackLevel, status.FetchedTaskID)
+	s.True(status.LastFetchTime.IsZero())
 }
 
 func (s *taskAckManagerSuite) TestGetTasks_ReturnDataErrors() {
@@ -132,6 +132,7 @@ func (e *taskExecutorImpl) handleActivityTask(
 		LastFailureDetails: attr.LastFailureDetails,
 		LastWorkerIdentity: attr.LastWorkerIdentity,
 		VersionHistory:     attr.GetVersionHistory(),
+		Paused:             attr.GetPaused(),
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
 	defer cancel()
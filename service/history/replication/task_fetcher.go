@@ -24,6 +24,7 @@ package replication
 
 import (
 	"context"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -32,6 +33,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cluster"
+	commonconfig "github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/quotas"
@@ -44,6 +46,8 @@ import (
 const (
 	fetchTaskRequestTimeout = 60 * time.Second
 	requestChanBufferSize   = 1000
+
+	taskFetchersClusterChangeCallbackKey = "replicationTaskFetchers"
 )
 
 type (
@@ -54,6 +58,7 @@ type (
 		GetSourceCluster() string
 		GetRequestChan() chan<- *request
 		GetRateLimiter() *quotas.DynamicRateLimiter
+		GetTimeSinceLastFetch() time.Duration
 	}
 
 	// TaskFetchers is a group of fetchers, one per source DC.
@@ -61,6 +66,7 @@ type (
 		common.Daemon
 
 		GetFetchers() []TaskFetcher
+		GetTimeSinceLastFetch(sourceCluster string) (time.Duration, bool)
 	}
 
 	// taskFetcherImpl is the implementation of fetching replication messages.
@@ -74,13 +80,18 @@ type (
 		rateLimiter    *quotas.DynamicRateLimiter
 		requestChan    chan *request
 		done           chan struct{}
+		lastFetchTime  int64
 	}
 
 	// taskFetchersImpl is a group of fetchers, one per source DC.
 	taskFetchersImpl struct {
-		status   int32
-		logger   log.Logger
-		fetchers []TaskFetcher
+		sync.Mutex
+		status          int32
+		logger          log.Logger
+		config          *config.Config
+		clusterMetadata cluster.Metadata
+		clientBean      client.Bean
+		fetchers        []TaskFetcher
 	}
 )
 
@@ -116,9 +127,12 @@ func NewTaskFetchers(
 	}
 
 	return &taskFetchersImpl{
-		fetchers: fetchers,
-		status:   common.DaemonStatusInitialized,
-		logger:   logger,
+		fetchers:        fetchers,
+		status:          common.DaemonStatusInitialized,
+		logger:          logger,
+		config:          config,
+		clusterMetadata: clusterMetadata,
+		clientBean:      clientBean,
 	}
 }
 
@@ -128,9 +142,12 @@ func (f *taskFetchersImpl) Start() {
 		return
 	}
 
+	f.Lock()
 	for _, fetcher := range f.fetchers {
 		fetcher.Start()
 	}
+	f.Unlock()
+	f.clusterMetadata.RegisterChangeCallback(taskFetchersClusterChangeCallbackKey, f.onClusterMetadataChange)
 	f.logger.Info("Replication task fetchers started.")
 }
 
@@ -140,15 +157,79 @@ func (f *taskFetchersImpl) Stop() {
 		return
 	}
 
+	f.clusterMetadata.UnregisterChangeCallback(taskFetchersClusterChangeCallbackKey)
+	f.Lock()
 	for _, fetcher := range f.fetchers {
 		fetcher.Stop()
 	}
+	f.Unlock()
 	f.logger.Info("Replication task fetchers stopped.")
 }
 
 // GetFetchers returns all the fetchers
 func (f *taskFetchersImpl) GetFetchers() []TaskFetcher {
-	return f.fetchers
+	f.Lock()
+	defer f.Unlock()
+
+	fetchers := make([]TaskFetcher, len(f.fetchers))
+	copy(fetchers, f.fetchers)
+	return fetchers
+}
+
+// GetTimeSinceLastFetch returns the time since the fetcher for the given source cluster last fetched replication tasks
+func (f *taskFetchersImpl) GetTimeSinceLastFetch(sourceCluster string) (time.Duration, bool) {
+	f.Lock()
+	defer f.Unlock()
+
+	for _, fetcher := range f.fetchers {
+		if fetcher.GetSourceCluster() == sourceCluster {
+			return fetcher.GetTimeSinceLastFetch(), true
+		}
+	}
+	return 0, false
+}
+
+// onClusterMetadataChange stops the fetchers of removed clusters and starts fetchers for added ones
+func (f *taskFetchersImpl) onClusterMetadataChange(
+	added map[string]commonconfig.ClusterInformation,
+	removed map[string]commonconfig.ClusterInformation,
+) {
+
+	f.Lock()
+	defer f.Unlock()
+
+	if atomic.LoadInt32(&f.status) != common.DaemonStatusStarted {
+		return
+	}
+
+	fetchers := f.fetchers[:0]
+	for _, fetcher := range f.fetchers {
+		if _, ok := removed[fetcher.GetSourceCluster()]; ok {
+			fetcher.Stop()
+			f.logger.Info("Replication task fetcher stopped.", tag.ClusterName(fetcher.GetSourceCluster()))
+			continue
+		}
+		fetchers = append(fetchers, fetcher)
+	}
+
+	currentCluster := f.clusterMetadata.GetCurrentClusterName()
+	for clusterName, info := range added {
+		if !info.Enabled || clusterName == currentCluster {
+			continue
+		}
+
+		fetcher := newReplicationTaskFetcher(
+			f.logger,
+			clusterName,
+			currentCluster,
+			f.config,
+			f.clientBean.GetRemoteAdminClient(clusterName),
+		)
+		fetcher.Start()
+		fetchers = append(fetchers, fetcher)
+		f.logger.Info("Replication task fetcher started.", tag.ClusterName(clusterName))
+	}
+	f.fetchers = fetchers
 }
 
 // newReplicationTaskFetcher creates a new fetcher.
@@ -181,6 +262,7 @@ func (f *taskFetcherImpl) Start() {
 		return
 	}
 
+	atomic.StoreInt64(&f.lastFetchTime, time.Now().UnixNano())
 	for i := 0; i < f.config.ReplicationTaskFetcherParallelism(); i++ {
 		go f.fetchTasks()
 	}
@@ -234,6 +316,7 @@ func (f *taskFetcherImpl) fetchTasks() {
 					))
 				}
 			} else {
+				atomic.StoreInt64(&f.lastFetchTime, time.Now().UnixNano())
 				timer.Reset(backoff.JitDuration(
 					f.config.ReplicationTaskFetcherAggregationInterval(),
 					f.config.ReplicationTaskFetcherTimerJitterCoefficient(),
@@ -310,3 +393,8 @@ func (f *taskFetcherImpl) GetRequestChan() chan<- *request {
 func (f *taskFetcherImpl) GetRateLimiter() *quotas.DynamicRateLimiter {
 	return f.rateLimiter
 }
+
+// GetTimeSinceLastFetch returns the time since replication tasks were last fetched successfully from the source cluster
+func (f *taskFetcherImpl) GetTimeSinceLastFetch() time.Duration {
+	return time.Since(time.Unix(0, atomic.LoadInt64(&f.lastFetchTime)))
+}
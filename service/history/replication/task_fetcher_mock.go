@@ -28,6 +28,7 @@ package replication
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 
@@ -123,6 +124,20 @@ func (mr *MockTaskFetcherMockRecorder) GetRateLimiter() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRateLimiter", reflect.TypeOf((*MockTaskFetcher)(nil).GetRateLimiter))
 }
 
+// GetTimeSinceLastFetch mocks base method
+func (m *MockTaskFetcher) GetTimeSinceLastFetch() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeSinceLastFetch")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetTimeSinceLastFetch indicates an expected call of GetTimeSinceLastFetch
+func (mr *MockTaskFetcherMockRecorder) GetTimeSinceLastFetch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeSinceLastFetch", reflect.TypeOf((*MockTaskFetcher)(nil).GetTimeSinceLastFetch))
+}
+
 // MockTaskFetchers is a mock of TaskFetchers interface
 type MockTaskFetchers struct {
 	ctrl     *gomock.Controller
@@ -183,3 +198,18 @@ func (mr *MockTaskFetchersMockRecorder) GetFetchers() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFetchers", reflect.TypeOf((*MockTaskFetchers)(nil).GetFetchers))
 }
+
+// GetTimeSinceLastFetch mocks base method
+func (m *MockTaskFetchers) GetTimeSinceLastFetch(sourceCluster string) (time.Duration, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeSinceLastFetch", sourceCluster)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetTimeSinceLastFetch indicates an expected call of GetTimeSinceLastFetch
+func (mr *MockTaskFetchersMockRecorder) GetTimeSinceLastFetch(sourceCluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeSinceLastFetch", reflect.TypeOf((*MockTaskFetchers)(nil).GetTimeSinceLastFetch), sourceCluster)
+}
@@ -27,6 +27,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -63,6 +64,9 @@ type (
 	// TaskProcessor is responsible for processing replication tasks for a shard.
 	TaskProcessor interface {
 		common.Daemon
+
+		GetSourceCluster() string
+		GetStatus() Status
 	}
 
 	// taskProcessorImpl is responsible for processing replication tasks for a shard.
@@ -87,6 +91,9 @@ type (
 		lastProcessedMessageID int64
 		lastRetrievedMessageID int64
 
+		replicationStatusLock sync.RWMutex
+		replicationStatus     Status
+
 		requestChan   chan<- *request
 		syncShardChan chan *types.SyncShardStatus
 		done          chan struct{}
@@ -147,6 +154,10 @@ func NewTaskProcessor(
 		done:                   make(chan struct{}),
 		lastProcessedMessageID: common.EmptyMessageID,
 		lastRetrievedMessageID: common.EmptyMessageID,
+		replicationStatus: Status{
+			FetchedTaskID: common.EmptyMessageID,
+			AckedTaskID:   common.EmptyMessageID,
+		},
 	}
 }
 
@@ -173,6 +184,19 @@ func (p *taskProcessorImpl) Stop() {
 	close(p.done)
 }
 
+// GetSourceCluster returns the cluster this processor pulls replication tasks from
+func (p *taskProcessorImpl) GetSourceCluster() string {
+	return p.sourceCluster
+}
+
+// GetStatus returns the progress of applying replication tasks from the source cluster
+func (p *taskProcessorImpl) GetStatus() Status {
+	p.replicationStatusLock.RLock()
+	defer p.replicationStatusLock.RUnlock()
+
+	return p.replicationStatus
+}
+
 func (p *taskProcessorImpl) processorLoop() {
 	defer func() {
 		p.logger.Debug("Closing replication task processor.", tag.ReadLevel(p.lastRetrievedMessageID))
@@ -203,6 +227,11 @@ Loop:
 				tag.Counter(len(response.GetReplicationTasks())),
 			)
 
+			p.replicationStatusLock.Lock()
+			p.replicationStatus.FetchedTaskID = response.GetLastRetrievedMessageID()
+			p.replicationStatus.LastFetchTime = p.shard.GetTimeSource().Now()
+			p.replicationStatusLock.Unlock()
+
 			p.taskProcessingStartWait()
 			p.processResponse(response)
 		case <-p.done:
@@ -318,6 +347,10 @@ func (p *taskProcessorImpl) processResponse(response *types.ReplicationMessages)
 	p.lastRetrievedMessageID = response.GetLastRetrievedMessageID()
 	scope.UpdateGauge(metrics.LastRetrievedMessageID, float64(p.lastRetrievedMessageID))
 	p.noTaskRetrier.Reset()
+
+	p.replicationStatusLock.Lock()
+	p.replicationStatus.AckedTaskID = p.lastProcessedMessageID
+	p.replicationStatusLock.Unlock()
 }
 
 func (p *taskProcessorImpl) syncShardStatusLoop() {
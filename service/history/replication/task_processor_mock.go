@@ -78,3 +78,31 @@ func (mr *MockTaskProcessorMockRecorder) Stop() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockTaskProcessor)(nil).Stop))
 }
+
+// GetSourceCluster mocks base method
+func (m *MockTaskProcessor) GetSourceCluster() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSourceCluster")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetSourceCluster indicates an expected call of GetSourceCluster
+func (mr *MockTaskProcessorMockRecorder) GetSourceCluster() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSourceCluster", reflect.TypeOf((*MockTaskProcessor)(nil).GetSourceCluster))
+}
+
+// GetStatus mocks base method
+func (m *MockTaskProcessor) GetStatus() Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatus")
+	ret0, _ := ret[0].(Status)
+	return ret0
+}
+
+// GetStatus indicates an expected call of GetStatus
+func (mr *MockTaskProcessorMockRecorder) GetStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockTaskProcessor)(nil).GetStatus))
+}
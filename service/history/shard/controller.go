@@ -29,6 +29,7 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	commonconfig "github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/membership"
@@ -42,6 +43,7 @@ import (
 
 const (
 	shardControllerMembershipUpdateListenerName = "ShardController"
+	shardControllerClusterChangeCallbackKey     = "ShardController"
 )
 
 type (
@@ -71,16 +73,17 @@ type (
 	controller struct {
 		resource.Resource
 
-		membershipUpdateCh chan *membership.ChangedEvent
-		engineFactory      EngineFactory
-		status             int32
-		shuttingDown       int32
-		shutdownWG         sync.WaitGroup
-		shutdownCh         chan struct{}
-		logger             log.Logger
-		throttledLogger    log.Logger
-		config             *config.Config
-		metricsScope       metrics.Scope
+		membershipUpdateCh      chan *membership.ChangedEvent
+		clusterMetadataChangeCh chan struct{}
+		engineFactory           EngineFactory
+		status                  int32
+		shuttingDown            int32
+		shutdownWG              sync.WaitGroup
+		shutdownCh              chan struct{}
+		logger                  log.Logger
+		throttledLogger         log.Logger
+		config                  *config.Config
+		metricsScope            metrics.Scope
 
 		sync.RWMutex
 		historyShards map[int]*historyShardsItem
@@ -117,16 +120,17 @@ func NewShardController(
 ) Controller {
 	hostIdentity := resource.GetHostInfo().Identity()
 	return &controller{
-		Resource:           resource,
-		status:             common.DaemonStatusInitialized,
-		membershipUpdateCh: make(chan *membership.ChangedEvent, 10),
-		engineFactory:      factory,
-		historyShards:      make(map[int]*historyShardsItem),
-		shutdownCh:         make(chan struct{}),
-		logger:             resource.GetLogger().WithTags(tag.ComponentShardController, tag.Address(hostIdentity)),
-		throttledLogger:    resource.GetThrottledLogger().WithTags(tag.ComponentShardController, tag.Address(hostIdentity)),
-		config:             config,
-		metricsScope:       resource.GetMetricsClient().Scope(metrics.HistoryShardControllerScope),
+		Resource:                resource,
+		status:                  common.DaemonStatusInitialized,
+		membershipUpdateCh:      make(chan *membership.ChangedEvent, 10),
+		clusterMetadataChangeCh: make(chan struct{}, 1),
+		engineFactory:           factory,
+		historyShards:           make(map[int]*historyShardsItem),
+		shutdownCh:              make(chan struct{}),
+		logger:                  resource.GetLogger().WithTags(tag.ComponentShardController, tag.Address(hostIdentity)),
+		throttledLogger:         resource.GetThrottledLogger().WithTags(tag.ComponentShardController, tag.Address(hostIdentity)),
+		config:                  config,
+		metricsScope:            resource.GetMetricsClient().Scope(metrics.HistoryShardControllerScope),
 	}
 }
 
@@ -162,6 +166,7 @@ func (c *controller) Start() {
 	if err != nil {
 		c.logger.Error("Error adding listener", tag.Error(err))
 	}
+	c.GetClusterMetadata().RegisterChangeCallback(shardControllerClusterChangeCallbackKey, c.onClusterMetadataChange)
 
 	c.logger.Info("Shard controller state changed", tag.LifeCycleStarted)
 }
@@ -176,6 +181,7 @@ func (c *controller) Stop() {
 	if err := c.GetHistoryServiceResolver().RemoveListener(shardControllerMembershipUpdateListenerName); err != nil {
 		c.logger.Error("Error removing membership update listener", tag.Error(err), tag.OperationFailed)
 	}
+	c.GetClusterMetadata().UnregisterChangeCallback(shardControllerClusterChangeCallbackKey)
 	close(c.shutdownCh)
 
 	if success := common.AwaitWaitGroup(&c.shutdownWG, time.Minute); !success {
@@ -331,9 +337,11 @@ func (c *controller) removeHistoryShardItem(shardID int, shardItem *historyShard
 // controller. It is responsible for acquiring /
 // releasing shards in response to any event that can
 // change the shard ownership. These events are
-//   a. Ring membership change
-//   b. Periodic ticker
-//   c. ShardOwnershipLostError and subsequent ShardClosedEvents from engine
+//
+//	a. Ring membership change
+//	b. Periodic ticker
+//	c. ShardOwnershipLostError and subsequent ShardClosedEvents from engine
+//	d. Remote clusters added to or removed from the cluster metadata
 func (c *controller) shardManagementPump() {
 
 	defer c.shutdownWG.Done()
@@ -357,10 +365,37 @@ func (c *controller) shardManagementPump() {
 				tag.NumberDeleted(len(changedEvent.HostsRemoved)),
 				tag.Number(int64(len(changedEvent.HostsUpdated))))
 			c.acquireShards()
+		case <-c.clusterMetadataChangeCh:
+			c.reloadShards()
 		}
 	}
 }
 
+// onClusterMetadataChange is invoked when remote clusters are added or removed,
+// the reload is done by the pump so the callback never blocks the metadata update
+func (c *controller) onClusterMetadataChange(
+	added map[string]commonconfig.ClusterInformation,
+	removed map[string]commonconfig.ClusterInformation,
+) {
+
+	select {
+	case c.clusterMetadataChangeCh <- struct{}{}:
+	default:
+		// a reload is already pending
+	}
+}
+
+// reloadShards closes the engines of all owned shards and acquires them again, so that the per remote cluster
+// components of a shard (replication task processors, standby and cross cluster queue processors) are rebuilt
+// against the updated cluster metadata
+func (c *controller) reloadShards() {
+	c.logger.Info("Cluster metadata changed, reloading shards", tag.Number(int64(c.NumShards())))
+	for _, shardID := range c.ShardIDs() {
+		c.RemoveEngineForShard(int(shardID))
+	}
+	c.acquireShards()
+}
+
 func (c *controller) acquireShards() {
 	c.metricsScope.IncCounter(metrics.AcquireShardsCounter)
 	sw := c.metricsScope.StartTimer(metrics.AcquireShardsLatency)
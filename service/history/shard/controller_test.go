@@ -588,6 +588,12 @@ func (s *controllerSuite) TestShardControllerClosed() {
 	workerWG.Wait()
 }
 
+func (s *controllerSuite) TestClusterMetadataChange_CoalescesReloads() {
+	s.shardController.onClusterMetadataChange(nil, nil)
+	s.shardController.onClusterMetadataChange(nil, nil)
+	s.Len(s.shardController.clusterMetadataChangeCh, 1)
+}
+
 func (s *controllerSuite) setupMocksForAcquireShard(shardID int, mockEngine *engine.MockEngine, currentRangeID,
 	newRangeID int64) {
 
@@ -25,6 +25,7 @@ import (
 
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/quotas"
@@ -41,6 +42,7 @@ type (
 		domainCache        cache.DomainCache
 		config             *config.Config
 		logger             log.Logger
+		throttledLogger    log.Logger
 		scope              metrics.Scope
 		rateLimiters       map[string]quotas.Limiter
 	}
@@ -61,6 +63,7 @@ func NewPriorityAssigner(
 		domainCache:        domainCache,
 		config:             config,
 		logger:             logger,
+		throttledLogger:    loggerimpl.NewThrottledLogger(logger, config.ThrottledLogRPS),
 		scope:              metricClient.Scope(metrics.TaskPriorityAssignerScope),
 		rateLimiters:       make(map[string]quotas.Limiter),
 	}
@@ -103,8 +106,11 @@ func (a *priorityAssignerImpl) Assign(
 	// for case 2 and 3 the task will be a no-op in most cases, also give it a high priority so that
 	// it can be quickly verified/acked and won't prevent the ack level in the processor from advancing
 	// (especially for active processor)
+	// within a priority class, the domain tier decides the subclass so that tasks from domains of
+	// different tiers are scheduled with different weights
+	subclass := a.getPrioritySubclass(domainName)
 	if !a.getRateLimiter(domainName).Allow() {
-		queueTask.SetPriority(task.GetTaskPriority(task.DefaultPriorityClass, task.DefaultPrioritySubclass))
+		queueTask.SetPriority(task.GetTaskPriority(task.DefaultPriorityClass, subclass))
 		taggedScope := a.scope.Tagged(metrics.DomainTag(domainName))
 		if queueType == QueueTypeActiveTransfer || queueType == QueueTypeStandbyTransfer {
 			taggedScope.IncCounter(metrics.TransferTaskThrottledCounter)
@@ -114,7 +120,7 @@ func (a *priorityAssignerImpl) Assign(
 		return nil
 	}
 
-	queueTask.SetPriority(task.GetTaskPriority(task.HighPriorityClass, task.DefaultPrioritySubclass))
+	queueTask.SetPriority(task.GetTaskPriority(task.HighPriorityClass, subclass))
 	return nil
 }
 
@@ -143,6 +149,28 @@ func (a *priorityAssignerImpl) getDomainInfo(
 	return domainEntry.GetInfo().Name, true, nil
 }
 
+func (a *priorityAssignerImpl) getPrioritySubclass(
+	domainName string,
+) int {
+	if domainName == "" {
+		return task.DefaultPrioritySubclass
+	}
+
+	switch tier := a.config.TaskDomainTier(domainName); tier {
+	case config.DomainTierCritical:
+		return task.HighPrioritySubclass
+	case config.DomainTierBatch:
+		return task.LowPrioritySubclass
+	case config.DomainTierStandard:
+		return task.DefaultPrioritySubclass
+	default:
+		// the tier is resolved for every task, so the warning is throttled and the metric tells which domains are misconfigured
+		a.scope.Tagged(metrics.DomainTag(domainName)).IncCounter(metrics.UnknownDomainTierCounter)
+		a.throttledLogger.Warn("Unknown domain tier, treat as standard", tag.WorkflowDomainName(domainName), tag.Value(tier))
+		return task.DefaultPrioritySubclass
+	}
+}
+
 func (a *priorityAssignerImpl) getRateLimiter(
 	domainName string,
 ) quotas.Limiter {
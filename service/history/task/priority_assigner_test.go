@@ -239,6 +239,52 @@ func (s *taskPriorityAssignerSuite) TestAssign_ThrottledTask() {
 	}
 }
 
+func (s *taskPriorityAssignerSuite) TestAssign_DomainTier() {
+	s.mockDomainCache.EXPECT().GetDomainByID(constants.TestDomainID).Return(constants.TestGlobalDomainEntry, nil).AnyTimes()
+
+	testCases := map[string]int{
+		config.DomainTierCritical: task.HighPrioritySubclass,
+		config.DomainTierStandard: task.DefaultPrioritySubclass,
+		config.DomainTierBatch:    task.LowPrioritySubclass,
+		"unknown tier":            task.DefaultPrioritySubclass,
+	}
+	for tier, subclass := range testCases {
+		s.priorityAssigner.config.TaskDomainTier = func(domain string) string {
+			s.Equal(constants.TestDomainName, domain)
+			return tier
+		}
+
+		mockTask := NewMockTask(s.controller)
+		mockTask.EXPECT().GetQueueType().Return(QueueTypeActiveTransfer).AnyTimes()
+		mockTask.EXPECT().GetDomainID().Return(constants.TestDomainID).Times(1)
+		mockTask.EXPECT().Priority().Return(task.NoPriority).Times(1)
+		mockTask.EXPECT().SetPriority(task.GetTaskPriority(task.HighPriorityClass, subclass)).Times(1)
+
+		err := s.priorityAssigner.Assign(mockTask)
+		s.NoError(err)
+	}
+}
+
+func (s *taskPriorityAssignerSuite) TestAssign_DomainTier_StandbyTask_StandbyDomain() {
+	constants.TestGlobalDomainEntry.GetReplicationConfig().ActiveClusterName = cluster.TestAlternativeClusterName
+	defer func() {
+		constants.TestGlobalDomainEntry.GetReplicationConfig().ActiveClusterName = cluster.TestCurrentClusterName
+	}()
+	s.mockDomainCache.EXPECT().GetDomainByID(constants.TestDomainID).Return(constants.TestGlobalDomainEntry, nil)
+	s.priorityAssigner.config.TaskDomainTier = func(string) string {
+		return config.DomainTierCritical
+	}
+
+	mockTask := NewMockTask(s.controller)
+	mockTask.EXPECT().GetQueueType().Return(QueueTypeStandbyTransfer).AnyTimes()
+	mockTask.EXPECT().GetDomainID().Return(constants.TestDomainID).Times(1)
+	mockTask.EXPECT().Priority().Return(task.NoPriority).Times(1)
+	mockTask.EXPECT().SetPriority(task.GetTaskPriority(task.LowPriorityClass, task.DefaultPrioritySubclass)).Times(1)
+
+	err := s.priorityAssigner.Assign(mockTask)
+	s.NoError(err)
+}
+
 func (s *taskPriorityAssignerSuite) TestAssign_AlreadyAssigned() {
 	priority := 5
 
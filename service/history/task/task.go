@@ -21,6 +21,7 @@
 package task
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"time"
@@ -54,6 +55,8 @@ var (
 	ErrTaskRedispatch = errors.New("passive task should be redispatched due to condition in mutable state is not met")
 	// ErrTaskPendingActive is the error indicating that the task should be re-dispatched
 	ErrTaskPendingActive = errors.New("redispatch the task while the domain is pending-active")
+
+	errUnknownTaskInfoType = errors.New("unknown task info type")
 )
 
 type (
@@ -301,10 +304,46 @@ func (t *taskImpl) HandleErr(
 		return nil
 	}
 
+	if t.GetAttempt() >= t.shard.GetConfig().HistoryTaskDLQMaxAttempts() &&
+		t.shard.GetConfig().EnableHistoryTaskDLQByDomainID(t.Info.GetDomainID()) { // use domainID here to avoid accessing domainCache
+		if dlqErr := t.moveToDLQ(err); dlqErr != nil {
+			t.scope.IncCounter(metrics.TaskMoveToDLQFailurePerDomain)
+			t.logger.Error("Fail to move task to history task DLQ", tag.Error(dlqErr))
+		} else {
+			t.scope.IncCounter(metrics.TaskMovedToDLQPerDomain)
+			t.logger.Warn("Task moved to history task DLQ after exceeding max attempts", tag.Error(err), tag.Attempt(int32(t.GetAttempt())))
+			return nil
+		}
+	}
+
 	t.logger.Error("Fail to process task", tag.Error(err), tag.LifeCycleProcessingFailed)
 	return err
 }
 
+func (t *taskImpl) moveToDLQ(
+	taskErr error,
+) error {
+	dlqInfo := &persistence.HistoryTaskDLQInfo{
+		Attempt:     t.GetAttempt(),
+		LastError:   taskErr.Error(),
+		CreatedTime: t.timeSource.Now(),
+	}
+	switch info := t.Info.(type) {
+	case *persistence.TransferTaskInfo:
+		dlqInfo.TransferTaskInfo = info
+	case *persistence.TimerTaskInfo:
+		dlqInfo.TimerTaskInfo = info
+	default:
+		return errUnknownTaskInfoType
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), taskDefaultTimeout)
+	defer cancel()
+	return t.shard.GetExecutionManager().PutHistoryTaskToDLQ(ctx, &persistence.PutHistoryTaskToDLQRequest{
+		TaskInfo: dlqInfo,
+	})
+}
+
 func (t *taskImpl) RetryErr(
 	err error,
 ) bool {
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
@@ -182,6 +183,47 @@ func (s *taskSuite) TestHandleErr_UnknownErr() {
 	s.Equal(err, taskBase.HandleErr(err))
 }
 
+func (s *taskSuite) TestHandleErr_MoveToDLQ() {
+	taskBase := s.newTestTask(func(task Info) (bool, error) {
+		return true, nil
+	}, nil)
+	transferTask := &persistence.TransferTaskInfo{
+		DomainID: constants.TestDomainID,
+		TaskID:   11,
+		TaskType: persistence.TransferTaskTypeActivityTask,
+	}
+	taskBase.Info = transferTask
+	taskBase.attempt = 5
+	s.mockShard.GetConfig().HistoryTaskDLQMaxAttempts = dynamicconfig.GetIntPropertyFn(5)
+	s.mockShard.GetConfig().EnableHistoryTaskDLQByDomainID = dynamicconfig.GetBoolPropertyFnFilteredByDomainID(true)
+
+	err := errors.New("some random error")
+	s.mockShard.Resource.ExecutionMgr.On("PutHistoryTaskToDLQ", mock.Anything, mock.MatchedBy(func(request *persistence.PutHistoryTaskToDLQRequest) bool {
+		return request.TaskInfo.TransferTaskInfo == transferTask &&
+			request.TaskInfo.Attempt == 5 &&
+			request.TaskInfo.LastError == err.Error()
+	})).Return(nil).Once()
+	s.NoError(taskBase.HandleErr(err))
+}
+
+func (s *taskSuite) TestHandleErr_MoveToDLQFailed() {
+	taskBase := s.newTestTask(func(task Info) (bool, error) {
+		return true, nil
+	}, nil)
+	taskBase.Info = &persistence.TimerTaskInfo{
+		DomainID: constants.TestDomainID,
+		TaskID:   12,
+		TaskType: persistence.TaskTypeUserTimer,
+	}
+	taskBase.attempt = 5
+	s.mockShard.GetConfig().HistoryTaskDLQMaxAttempts = dynamicconfig.GetIntPropertyFn(5)
+	s.mockShard.GetConfig().EnableHistoryTaskDLQByDomainID = dynamicconfig.GetBoolPropertyFnFilteredByDomainID(true)
+
+	err := errors.New("some random error")
+	s.mockShard.Resource.ExecutionMgr.On("PutHistoryTaskToDLQ", mock.Anything, mock.Anything).Return(errors.New("some persistence error")).Once()
+	s.Equal(err, taskBase.HandleErr(err))
+}
+
 func (s *taskSuite) TestTaskState() {
 	taskBase := s.newTestTask(func(task Info) (bool, error) {
 		return true, nil
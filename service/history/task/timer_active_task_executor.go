@@ -126,6 +126,11 @@ func (t *timerActiveTaskExecutor) executeUserTimerTimeoutTask(
 		return nil
 	}
 
+	if mutableState.IsWorkflowPaused() {
+		// timer will be regenerated when the workflow is unpaused
+		return nil
+	}
+
 	timerSequence := t.getTimerSequence(mutableState)
 	referenceTime := t.shard.GetTimeSource().Now()
 	timerFired := false
@@ -184,6 +189,11 @@ func (t *timerActiveTaskExecutor) executeActivityTimeoutTask(
 		return nil
 	}
 
+	if mutableState.IsWorkflowPaused() {
+		// timer will be regenerated when the workflow is unpaused
+		return nil
+	}
+
 	timerSequence := t.getTimerSequence(mutableState)
 	referenceTime := t.shard.GetTimeSource().Now()
 	updateMutableState := false
@@ -301,6 +311,11 @@ func (t *timerActiveTaskExecutor) executeDecisionTimeoutTask(
 		return nil
 	}
 
+	if mutableState.IsWorkflowPaused() {
+		// timer will be regenerated when the workflow is unpaused
+		return nil
+	}
+
 	scheduleID := task.EventID
 	decision, ok := mutableState.GetDecisionInfo(scheduleID)
 	if !ok {
@@ -379,6 +394,11 @@ func (t *timerActiveTaskExecutor) executeWorkflowBackoffTimerTask(
 		return nil
 	}
 
+	if mutableState.IsWorkflowPaused() {
+		// timer will be regenerated when the workflow is unpaused
+		return nil
+	}
+
 	if task.TimeoutType == persistence.WorkflowBackoffTimeoutTypeRetry {
 		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowRetryBackoffTimerCount)
 	} else {
@@ -420,6 +440,11 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		return nil
 	}
 
+	if mutableState.IsWorkflowPaused() {
+		// timer will be regenerated when the workflow is unpaused
+		return nil
+	}
+
 	// generate activity task
 	scheduledID := task.EventID
 	activityInfo, ok := mutableState.GetActivityInfo(scheduledID)
@@ -442,6 +467,11 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		return err
 	}
 
+	if activityInfo.Paused {
+		// timer will be regenerated when the activity is unpaused
+		return nil
+	}
+
 	domainID := task.DomainID
 	targetDomainID := domainID
 	if activityInfo.DomainID != "" {
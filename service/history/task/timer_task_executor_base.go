@@ -107,7 +107,8 @@ func (t *timerTaskExecutorBase) executeDeleteHistoryEventTask(
 	}
 	clusterConfiguredForHistoryArchival := t.shard.GetService().GetArchivalMetadata().GetHistoryConfig().ClusterConfiguredForArchival()
 	domainConfiguredForHistoryArchival := domainCacheEntry.GetConfig().HistoryArchivalStatus == types.ArchivalStatusEnabled
-	archiveHistory := clusterConfiguredForHistoryArchival && domainConfiguredForHistoryArchival
+	archiveHistory := clusterConfiguredForHistoryArchival && domainConfiguredForHistoryArchival &&
+		task.TimeoutType != persistence.DeleteHistoryEventTypeSkipArchival
 
 	// TODO: @ycyang once archival backfill is in place cluster:paused && domain:enabled should be a nop rather than a delete
 	if archiveHistory {
@@ -178,6 +178,16 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 		return err
 	}
 
+	if mutableState.IsWorkflowPaused() {
+		// task will be regenerated when the workflow is unpaused
+		return nil
+	}
+
+	if ai.Paused {
+		// task will be regenerated when the activity is unpaused
+		return nil
+	}
+
 	timeout := common.MinInt32(ai.ScheduleToStartTimeout, common.MaxTaskTimeout)
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
@@ -221,6 +231,11 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 		return err
 	}
 
+	if mutableState.IsWorkflowPaused() {
+		// task will be regenerated when the workflow is unpaused
+		return nil
+	}
+
 	executionInfo := mutableState.GetExecutionInfo()
 	workflowTimeout := executionInfo.WorkflowTimeout
 	decisionTimeout := common.MinInt32(workflowTimeout, common.MaxTaskTimeout)
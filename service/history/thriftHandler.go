@@ -80,6 +80,24 @@ func (t ThriftHandler) DescribeQueue(ctx context.Context, request *shared.Descri
 	return thrift.FromDescribeQueueResponse(response), thrift.FromError(err)
 }
 
+// RetryHistoryTaskDLQ forwards request to the underlying handler
+func (t ThriftHandler) RetryHistoryTaskDLQ(ctx context.Context, request *shared.RetryHistoryTaskDLQRequest) (*shared.RetryHistoryTaskDLQResponse, error) {
+	response, err := t.h.RetryHistoryTaskDLQ(withThriftTag(ctx), thrift.ToRetryHistoryTaskDLQRequest(request))
+	return thrift.FromRetryHistoryTaskDLQResponse(response), thrift.FromError(err)
+}
+
+// ReadHistoryTaskDLQ forwards request to the underlying handler
+func (t ThriftHandler) ReadHistoryTaskDLQ(ctx context.Context, request *shared.ReadHistoryTaskDLQRequest) (*shared.ReadHistoryTaskDLQResponse, error) {
+	response, err := t.h.ReadHistoryTaskDLQ(withThriftTag(ctx), thrift.ToReadHistoryTaskDLQRequest(request))
+	return thrift.FromReadHistoryTaskDLQResponse(response), thrift.FromError(err)
+}
+
+// DescribeReplicationStatus forwards request to the underlying handler
+func (t ThriftHandler) DescribeReplicationStatus(ctx context.Context, request *shared.DescribeReplicationStatusRequest) (*shared.DescribeReplicationStatusResponse, error) {
+	response, err := t.h.DescribeReplicationStatus(withThriftTag(ctx), thrift.ToDescribeReplicationStatusRequest(request))
+	return thrift.FromDescribeReplicationStatusResponse(response), thrift.FromError(err)
+}
+
 // DescribeWorkflowExecution forwards request to the underlying handler
 func (t ThriftHandler) DescribeWorkflowExecution(ctx context.Context, request *h.DescribeWorkflowExecutionRequest) (*shared.DescribeWorkflowExecutionResponse, error) {
 	response, err := t.h.DescribeWorkflowExecution(withThriftTag(ctx), thrift.ToHistoryDescribeWorkflowExecutionRequest(request))
@@ -140,6 +158,18 @@ func (t ThriftHandler) QueryWorkflow(ctx context.Context, request *h.QueryWorkfl
 	return thrift.FromHistoryQueryWorkflowResponse(response), thrift.FromError(err)
 }
 
+// UpdateWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) UpdateWorkflowExecution(ctx context.Context, request *h.UpdateWorkflowExecutionRequest) (*h.UpdateWorkflowExecutionResponse, error) {
+	response, err := t.h.UpdateWorkflowExecution(withThriftTag(ctx), thrift.ToHistoryUpdateWorkflowExecutionRequest(request))
+	return thrift.FromHistoryUpdateWorkflowExecutionResponse(response), thrift.FromError(err)
+}
+
+// MigrateWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) MigrateWorkflowExecution(ctx context.Context, request *h.MigrateWorkflowExecutionRequest) (*h.MigrateWorkflowExecutionResponse, error) {
+	response, err := t.h.MigrateWorkflowExecution(withThriftTag(ctx), thrift.ToHistoryMigrateWorkflowExecutionRequest(request))
+	return thrift.FromHistoryMigrateWorkflowExecutionResponse(response), thrift.FromError(err)
+}
+
 // ReadDLQMessages forwards request to the underlying handler
 func (t ThriftHandler) ReadDLQMessages(ctx context.Context, request *replicator.ReadDLQMessagesRequest) (*replicator.ReadDLQMessagesResponse, error) {
 	response, err := t.h.ReadDLQMessages(withThriftTag(ctx), thrift.ToReadDLQMessagesRequest(request))
@@ -206,12 +236,54 @@ func (t ThriftHandler) RequestCancelWorkflowExecution(ctx context.Context, reque
 	return thrift.FromError(err)
 }
 
+// PauseWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) PauseWorkflowExecution(ctx context.Context, request *h.PauseWorkflowExecutionRequest) error {
+	err := t.h.PauseWorkflowExecution(withThriftTag(ctx), thrift.ToHistoryPauseWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
+// UnpauseWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) UnpauseWorkflowExecution(ctx context.Context, request *h.UnpauseWorkflowExecutionRequest) error {
+	err := t.h.UnpauseWorkflowExecution(withThriftTag(ctx), thrift.ToHistoryUnpauseWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
+// DeleteWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) DeleteWorkflowExecution(ctx context.Context, request *h.DeleteWorkflowExecutionRequest) error {
+	err := t.h.DeleteWorkflowExecution(withThriftTag(ctx), thrift.ToHistoryDeleteWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
+// PauseActivity forwards request to the underlying handler
+func (t ThriftHandler) PauseActivity(ctx context.Context, request *h.PauseActivityRequest) error {
+	err := t.h.PauseActivity(withThriftTag(ctx), thrift.ToHistoryPauseActivityRequest(request))
+	return thrift.FromError(err)
+}
+
+// UnpauseActivity forwards request to the underlying handler
+func (t ThriftHandler) UnpauseActivity(ctx context.Context, request *h.UnpauseActivityRequest) error {
+	err := t.h.UnpauseActivity(withThriftTag(ctx), thrift.ToHistoryUnpauseActivityRequest(request))
+	return thrift.FromError(err)
+}
+
+// ResetActivity forwards request to the underlying handler
+func (t ThriftHandler) ResetActivity(ctx context.Context, request *h.ResetActivityRequest) error {
+	err := t.h.ResetActivity(withThriftTag(ctx), thrift.ToHistoryResetActivityRequest(request))
+	return thrift.FromError(err)
+}
+
 // ResetQueue forwards request to the underlying handler
 func (t ThriftHandler) ResetQueue(ctx context.Context, request *shared.ResetQueueRequest) error {
 	err := t.h.ResetQueue(withThriftTag(ctx), thrift.ToResetQueueRequest(request))
 	return thrift.FromError(err)
 }
 
+// PurgeHistoryTaskDLQ forwards request to the underlying handler
+func (t ThriftHandler) PurgeHistoryTaskDLQ(ctx context.Context, request *shared.PurgeHistoryTaskDLQRequest) error {
+	err := t.h.PurgeHistoryTaskDLQ(withThriftTag(ctx), thrift.ToPurgeHistoryTaskDLQRequest(request))
+	return thrift.FromError(err)
+}
+
 // ResetStickyTaskList forwards request to the underlying handler
 func (t ThriftHandler) ResetStickyTaskList(ctx context.Context, request *h.ResetStickyTaskListRequest) (*h.ResetStickyTaskListResponse, error) {
 	response, err := t.h.ResetStickyTaskList(withThriftTag(ctx), thrift.ToHistoryResetStickyTaskListRequest(request))
@@ -58,6 +58,11 @@ func TestThriftHandler(t *testing.T) {
 		err := th.CloseShard(ctx, &shared.CloseShardRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("DeleteWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().DeleteWorkflowExecution(taggedCtx, &types.HistoryDeleteWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.DeleteWorkflowExecution(ctx, &hist.DeleteWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("DescribeHistoryHost", func(t *testing.T) {
 		h.EXPECT().DescribeHistoryHost(taggedCtx, &types.DescribeHistoryHostRequest{}).Return(&types.DescribeHistoryHostResponse{}, internalErr).Times(1)
 		resp, err := th.DescribeHistoryHost(ctx, &shared.DescribeHistoryHostRequest{})
@@ -126,6 +131,16 @@ func TestThriftHandler(t *testing.T) {
 		err := th.NotifyFailoverMarkers(ctx, &hist.NotifyFailoverMarkersRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("PauseActivity", func(t *testing.T) {
+		h.EXPECT().PauseActivity(taggedCtx, &types.HistoryPauseActivityRequest{}).Return(internalErr).Times(1)
+		err := th.PauseActivity(ctx, &hist.PauseActivityRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("PauseWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().PauseWorkflowExecution(taggedCtx, &types.HistoryPauseWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.PauseWorkflowExecution(ctx, &hist.PauseWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("PollMutableState", func(t *testing.T) {
 		h.EXPECT().PollMutableState(taggedCtx, &types.PollMutableStateRequest{}).Return(&types.PollMutableStateResponse{}, internalErr).Times(1)
 		resp, err := th.PollMutableState(ctx, &hist.PollMutableStateRequest{})
@@ -143,6 +158,11 @@ func TestThriftHandler(t *testing.T) {
 		err := th.PurgeDLQMessages(ctx, &replicator.PurgeDLQMessagesRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("PurgeHistoryTaskDLQ", func(t *testing.T) {
+		h.EXPECT().PurgeHistoryTaskDLQ(taggedCtx, &types.PurgeHistoryTaskDLQRequest{}).Return(internalErr).Times(1)
+		err := th.PurgeHistoryTaskDLQ(ctx, &shared.PurgeHistoryTaskDLQRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("QueryWorkflow", func(t *testing.T) {
 		h.EXPECT().QueryWorkflow(taggedCtx, &types.HistoryQueryWorkflowRequest{}).Return(&types.HistoryQueryWorkflowResponse{}, internalErr).Times(1)
 		resp, err := th.QueryWorkflow(ctx, &hist.QueryWorkflowRequest{})
@@ -155,6 +175,18 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, replicator.ReadDLQMessagesResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("ReadHistoryTaskDLQ", func(t *testing.T) {
+		h.EXPECT().ReadHistoryTaskDLQ(taggedCtx, &types.ReadHistoryTaskDLQRequest{}).Return(&types.ReadHistoryTaskDLQResponse{}, internalErr).Times(1)
+		resp, err := th.ReadHistoryTaskDLQ(ctx, &shared.ReadHistoryTaskDLQRequest{})
+		assert.Equal(t, shared.ReadHistoryTaskDLQResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("DescribeReplicationStatus", func(t *testing.T) {
+		h.EXPECT().DescribeReplicationStatus(taggedCtx, &types.DescribeReplicationStatusRequest{}).Return(&types.DescribeReplicationStatusResponse{}, internalErr).Times(1)
+		resp, err := th.DescribeReplicationStatus(ctx, &shared.DescribeReplicationStatusRequest{})
+		assert.Equal(t, shared.DescribeReplicationStatusResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("ReapplyEvents", func(t *testing.T) {
 		h.EXPECT().ReapplyEvents(taggedCtx, &types.HistoryReapplyEventsRequest{}).Return(internalErr).Times(1)
 		err := th.ReapplyEvents(ctx, &hist.ReapplyEventsRequest{})
@@ -187,6 +219,9 @@ func TestThriftHandler(t *testing.T) {
 			Attempt:                common.Int64Ptr(0),
 			StickyExecutionEnabled: common.BoolPtr(false),
 			EventStoreVersion:      common.Int32Ptr(0),
+			HistorySize:            common.Int64Ptr(0),
+			HistoryCount:           common.Int64Ptr(0),
+			SuggestContinueAsNew:   common.BoolPtr(false),
 		}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
@@ -215,6 +250,11 @@ func TestThriftHandler(t *testing.T) {
 		err := th.RequestCancelWorkflowExecution(ctx, &hist.RequestCancelWorkflowExecutionRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("ResetActivity", func(t *testing.T) {
+		h.EXPECT().ResetActivity(taggedCtx, &types.HistoryResetActivityRequest{}).Return(internalErr).Times(1)
+		err := th.ResetActivity(ctx, &hist.ResetActivityRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("ResetQueue", func(t *testing.T) {
 		h.EXPECT().ResetQueue(taggedCtx, &types.ResetQueueRequest{}).Return(internalErr).Times(1)
 		err := th.ResetQueue(ctx, &shared.ResetQueueRequest{})
@@ -264,6 +304,12 @@ func TestThriftHandler(t *testing.T) {
 		err := th.RespondDecisionTaskFailed(ctx, &hist.RespondDecisionTaskFailedRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("RetryHistoryTaskDLQ", func(t *testing.T) {
+		h.EXPECT().RetryHistoryTaskDLQ(taggedCtx, &types.RetryHistoryTaskDLQRequest{}).Return(&types.RetryHistoryTaskDLQResponse{}, internalErr).Times(1)
+		resp, err := th.RetryHistoryTaskDLQ(ctx, &shared.RetryHistoryTaskDLQRequest{})
+		assert.Equal(t, shared.RetryHistoryTaskDLQResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("ScheduleDecisionTask", func(t *testing.T) {
 		h.EXPECT().ScheduleDecisionTask(taggedCtx, &types.ScheduleDecisionTaskRequest{}).Return(internalErr).Times(1)
 		err := th.ScheduleDecisionTask(ctx, &hist.ScheduleDecisionTaskRequest{})
@@ -301,4 +347,26 @@ func TestThriftHandler(t *testing.T) {
 		err := th.TerminateWorkflowExecution(ctx, &hist.TerminateWorkflowExecutionRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("UnpauseActivity", func(t *testing.T) {
+		h.EXPECT().UnpauseActivity(taggedCtx, &types.HistoryUnpauseActivityRequest{}).Return(internalErr).Times(1)
+		err := th.UnpauseActivity(ctx, &hist.UnpauseActivityRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("UnpauseWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().UnpauseWorkflowExecution(taggedCtx, &types.HistoryUnpauseWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.UnpauseWorkflowExecution(ctx, &hist.UnpauseWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("UpdateWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().UpdateWorkflowExecution(taggedCtx, &types.HistoryUpdateWorkflowExecutionRequest{}).Return(&types.HistoryUpdateWorkflowExecutionResponse{}, internalErr).Times(1)
+		resp, err := th.UpdateWorkflowExecution(ctx, &hist.UpdateWorkflowExecutionRequest{})
+		assert.Equal(t, hist.UpdateWorkflowExecutionResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("MigrateWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().MigrateWorkflowExecution(taggedCtx, &types.HistoryMigrateWorkflowExecutionRequest{}).Return(&types.MigrateWorkflowExecutionResponse{}, internalErr).Times(1)
+		resp, err := th.MigrateWorkflowExecution(ctx, &hist.MigrateWorkflowExecutionRequest{})
+		assert.Equal(t, hist.MigrateWorkflowExecutionResponse{RunID: common.StringPtr(""), NextEventID: common.Int64Ptr(0)}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 }
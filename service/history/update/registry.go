@@ -0,0 +1,150 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package update
+
+import (
+	"sync"
+
+	"github.com/uber/cadence/common/types"
+)
+
+var (
+	errUpdateNotExists = &types.InternalServiceError{Message: "update does not exist"}
+)
+
+type (
+	// Registry tracks the callers waiting on workflow updates which have been
+	// accepted into history but not yet completed by the workflow, retried requests
+	// for the same update share its entry until every caller has removed it
+	Registry interface {
+		HasPendingUpdate() bool
+		GetPendingIDs() []string
+
+		GetUpdateTermCh(string) (<-chan struct{}, error)
+		GetTerminationState(string) (*TerminationState, error)
+
+		BufferUpdate(id string) <-chan struct{}
+		SetTerminationState(string, *TerminationState) error
+		RemoveUpdate(id string)
+	}
+
+	registryImpl struct {
+		sync.RWMutex
+
+		pending    map[string]update
+		terminated map[string]update
+		waiters    map[string]int
+	}
+)
+
+// NewRegistry creates a new update registry
+func NewRegistry() Registry {
+	return &registryImpl{
+		pending:    make(map[string]update),
+		terminated: make(map[string]update),
+		waiters:    make(map[string]int),
+	}
+}
+
+func (r *registryImpl) HasPendingUpdate() bool {
+	r.RLock()
+	defer r.RUnlock()
+	return len(r.pending) > 0
+}
+
+func (r *registryImpl) GetPendingIDs() []string {
+	r.RLock()
+	defer r.RUnlock()
+	result := make([]string, 0, len(r.pending))
+	for id := range r.pending {
+		result = append(result, id)
+	}
+	return result
+}
+
+func (r *registryImpl) GetUpdateTermCh(id string) (<-chan struct{}, error) {
+	r.RLock()
+	defer r.RUnlock()
+	u, err := r.getUpdateNoLock(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.getUpdateTermCh(), nil
+}
+
+func (r *registryImpl) GetTerminationState(id string) (*TerminationState, error) {
+	r.RLock()
+	defer r.RUnlock()
+	u, err := r.getUpdateNoLock(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.getTerminationState()
+}
+
+func (r *registryImpl) BufferUpdate(id string) <-chan struct{} {
+	r.Lock()
+	defer r.Unlock()
+	r.waiters[id]++
+	if u, err := r.getUpdateNoLock(id); err == nil {
+		return u.getUpdateTermCh()
+	}
+	u := newUpdate(id)
+	r.pending[id] = u
+	return u.getUpdateTermCh()
+}
+
+func (r *registryImpl) SetTerminationState(id string, terminationState *TerminationState) error {
+	r.Lock()
+	defer r.Unlock()
+	u, ok := r.pending[id]
+	if !ok {
+		return errUpdateNotExists
+	}
+	if err := u.setTerminationState(terminationState); err != nil {
+		return err
+	}
+	delete(r.pending, id)
+	r.terminated[id] = u
+	return nil
+}
+
+func (r *registryImpl) RemoveUpdate(id string) {
+	r.Lock()
+	defer r.Unlock()
+	if r.waiters[id] > 1 {
+		r.waiters[id]--
+		return
+	}
+	delete(r.waiters, id)
+	delete(r.pending, id)
+	delete(r.terminated, id)
+}
+
+func (r *registryImpl) getUpdateNoLock(id string) (update, error) {
+	if u, ok := r.pending[id]; ok {
+		return u, nil
+	}
+	if u, ok := r.terminated[id]; ok {
+		return u, nil
+	}
+	return nil, errUpdateNotExists
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package update
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type UpdateRegistrySuite struct {
+	suite.Suite
+	*require.Assertions
+}
+
+func TestUpdateRegistrySuite(t *testing.T) {
+	suite.Run(t, new(UpdateRegistrySuite))
+}
+
+func (s *UpdateRegistrySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *UpdateRegistrySuite) TestUpdateRegistry() {
+	ur := NewRegistry()
+	s.False(ur.HasPendingUpdate())
+
+	_, err := ur.GetUpdateTermCh("completed")
+	s.Equal(errUpdateNotExists, err)
+
+	completedCh := ur.BufferUpdate("completed")
+	failedCh := ur.BufferUpdate("failed")
+	s.Equal(completedCh, ur.BufferUpdate("completed"))
+	s.True(ur.HasPendingUpdate())
+	s.ElementsMatch([]string{"completed", "failed"}, ur.GetPendingIDs())
+	s.assertChanState(false, completedCh, failedCh)
+
+	_, err = ur.GetTerminationState("completed")
+	s.Equal(errUpdateNotInTerminalState, err)
+
+	s.NoError(ur.SetTerminationState("completed", &TerminationState{
+		TerminationType: TerminationTypeCompleted,
+		Result:          []byte{1, 2, 3},
+	}))
+	s.assertChanState(true, completedCh)
+	s.assertChanState(false, failedCh)
+	s.Equal([]string{"failed"}, ur.GetPendingIDs())
+
+	state, err := ur.GetTerminationState("completed")
+	s.NoError(err)
+	s.Equal([]byte{1, 2, 3}, state.Result)
+	s.Equal(errUpdateNotExists, ur.SetTerminationState("completed", &TerminationState{
+		TerminationType: TerminationTypeCompleted,
+	}))
+
+	s.Equal(errTerminationStateInvalid, ur.SetTerminationState("failed", &TerminationState{
+		TerminationType: TerminationTypeFailed,
+	}))
+	s.NoError(ur.SetTerminationState("failed", &TerminationState{
+		TerminationType: TerminationTypeFailed,
+		Failure:         errors.New("workflow closed"),
+	}))
+	s.assertChanState(true, failedCh)
+	s.False(ur.HasPendingUpdate())
+
+	// the update was buffered by a second caller, so it is kept until both callers remove it
+	ur.RemoveUpdate("completed")
+	_, err = ur.GetTerminationState("completed")
+	s.NoError(err)
+	ur.RemoveUpdate("completed")
+	ur.RemoveUpdate("failed")
+	_, err = ur.GetUpdateTermCh("completed")
+	s.Equal(errUpdateNotExists, err)
+	_, err = ur.GetTerminationState("failed")
+	s.Equal(errUpdateNotExists, err)
+}
+
+func (s *UpdateRegistrySuite) assertChanState(expectedClosed bool, chans ...<-chan struct{}) {
+	for _, ch := range chans {
+		closed := false
+		select {
+		case <-ch:
+			closed = true
+		default:
+		}
+		s.Equal(expectedClosed, closed)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package update
+
+import (
+	"sync/atomic"
+
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// TerminationTypeCompleted means an update reaches its termination state because the workflow completed it
+	TerminationTypeCompleted TerminationType = iota
+	// TerminationTypeFailed means an update reaches its termination state because it could not be delivered
+	TerminationTypeFailed
+)
+
+var (
+	errTerminationStateInvalid  = &types.InternalServiceError{Message: "update termination state invalid"}
+	errAlreadyInTerminalState   = &types.InternalServiceError{Message: "update already in terminal state"}
+	errUpdateNotInTerminalState = &types.InternalServiceError{Message: "update not in terminal state"}
+)
+
+type (
+	// TerminationType is the type of an update's termination state
+	TerminationType int
+
+	// TerminationState describes an update's termination state
+	TerminationState struct {
+		TerminationType TerminationType
+		Result          []byte
+		ErrorMessage    string
+		Failure         error
+	}
+
+	update interface {
+		getUpdateID() string
+		getUpdateTermCh() <-chan struct{}
+		getTerminationState() (*TerminationState, error)
+		setTerminationState(*TerminationState) error
+	}
+
+	updateImpl struct {
+		id     string
+		termCh chan struct{}
+
+		terminationState atomic.Value
+	}
+)
+
+func newUpdate(id string) update {
+	return &updateImpl{
+		id:     id,
+		termCh: make(chan struct{}),
+	}
+}
+
+func (u *updateImpl) getUpdateID() string {
+	return u.id
+}
+
+func (u *updateImpl) getUpdateTermCh() <-chan struct{} {
+	return u.termCh
+}
+
+func (u *updateImpl) getTerminationState() (*TerminationState, error) {
+	ts := u.terminationState.Load()
+	if ts == nil {
+		return nil, errUpdateNotInTerminalState
+	}
+	return ts.(*TerminationState), nil
+}
+
+func (u *updateImpl) setTerminationState(terminationState *TerminationState) error {
+	if err := u.validateTerminationState(terminationState); err != nil {
+		return err
+	}
+	currTerminationState, _ := u.getTerminationState()
+	if currTerminationState != nil {
+		return errAlreadyInTerminalState
+	}
+	u.terminationState.Store(terminationState)
+	close(u.termCh)
+	return nil
+}
+
+func (u *updateImpl) validateTerminationState(
+	terminationState *TerminationState,
+) error {
+	if terminationState == nil {
+		return errTerminationStateInvalid
+	}
+	switch terminationState.TerminationType {
+	case TerminationTypeCompleted:
+		if terminationState.Failure != nil {
+			return errTerminationStateInvalid
+		}
+		return nil
+	case TerminationTypeFailed:
+		if terminationState.Failure == nil || terminationState.Result != nil {
+			return errTerminationStateInvalid
+		}
+		return nil
+	default:
+		return errTerminationStateInvalid
+	}
+}
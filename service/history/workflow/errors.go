@@ -51,12 +51,30 @@ var (
 	ErrSignalsLimitExceeded = &types.LimitExceededError{Message: "exceeded workflow execution limit for signal events"}
 	// ErrQueryEnteredInvalidState is error indicating query entered invalid state
 	ErrQueryEnteredInvalidState = &types.BadRequestError{Message: "query entered invalid state, this should be impossible"}
+	// ErrUpdateEnteredInvalidState is error indicating update entered invalid state
+	ErrUpdateEnteredInvalidState = &types.InternalServiceError{Message: "update entered invalid state, this should be impossible"}
 	// ErrQueryWorkflowBeforeFirstDecision is error indicating that query was attempted before first decision task completed
 	ErrQueryWorkflowBeforeFirstDecision = &types.QueryFailedError{Message: "workflow must handle at least one decision task before it can be queried"}
 	// ErrConsistentQueryNotEnabled is error indicating that consistent query was requested but either cluster or domain does not enable consistent query
 	ErrConsistentQueryNotEnabled = &types.BadRequestError{Message: "cluster or domain does not enable strongly consistent query but strongly consistent query was requested"}
 	// ErrConsistentQueryBufferExceeded is error indicating that too many consistent queries have been buffered and until buffered queries are finished new consistent queries cannot be buffered
 	ErrConsistentQueryBufferExceeded = &types.InternalServiceError{Message: "consistent query buffer is full, cannot accept new consistent queries"}
+	// ErrWorkflowPaused is the error to indicate workflow execution is paused and cannot dispatch tasks
+	ErrWorkflowPaused = &types.EntityNotExistsError{Message: "workflow execution is paused"}
+	// ErrWorkflowAlreadyPaused is the error to indicate workflow execution is already paused
+	ErrWorkflowAlreadyPaused = &types.BadRequestError{Message: "workflow execution is already paused"}
+	// ErrWorkflowNotPaused is the error to indicate workflow execution is not paused
+	ErrWorkflowNotPaused = &types.BadRequestError{Message: "workflow execution is not paused"}
+	// ErrActivityPaused is the error to indicate activity is paused and cannot be dispatched
+	ErrActivityPaused = &types.EntityNotExistsError{Message: "activity is paused"}
+	// ErrActivityAlreadyPaused is the error to indicate activity is already paused
+	ErrActivityAlreadyPaused = &types.BadRequestError{Message: "activity is already paused"}
+	// ErrActivityNotPaused is the error to indicate activity is not paused
+	ErrActivityNotPaused = &types.BadRequestError{Message: "activity is not paused"}
+	// ErrWorkflowStillRunning is the error to indicate workflow execution must be closed before it can be deleted
+	ErrWorkflowStillRunning = &types.BadRequestError{Message: "workflow execution is still running"}
+	// ErrHistoryArchivalNotEnabled is the error to indicate history archival is not enabled for the domain
+	ErrHistoryArchivalNotEnabled = &types.BadRequestError{Message: "history archival is not enabled for the domain"}
 	// ErrConcurrentStartRequest is error indicating there is an outstanding start workflow request. The incoming request fails to acquires the lock before the outstanding request finishes.
 	ErrConcurrentStartRequest = &types.ServiceBusyError{Message: "an outstanding start workflow request is in-progress. Failed to acquire the resource."}
 )
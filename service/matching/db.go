@@ -39,12 +39,14 @@ type (
 		taskType     int
 		rangeID      int64
 		ackLevel     int64
+		paused       bool
 		store        persistence.TaskManager
 		logger       log.Logger
 	}
 	taskListState struct {
 		rangeID  int64
 		ackLevel int64
+		paused   bool
 	}
 )
 
@@ -93,7 +95,8 @@ func (db *taskListDB) RenewLease() (taskListState, error) {
 	}
 	db.ackLevel = resp.TaskListInfo.AckLevel
 	db.rangeID = resp.TaskListInfo.RangeID
-	return taskListState{rangeID: db.rangeID, ackLevel: db.ackLevel}, nil
+	db.paused = resp.TaskListInfo.Paused
+	return taskListState{rangeID: db.rangeID, ackLevel: db.ackLevel, paused: db.paused}, nil
 }
 
 // UpdateState updates the taskList state with the given value
@@ -108,6 +111,7 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 			AckLevel: ackLevel,
 			RangeID:  db.rangeID,
 			Kind:     db.taskListKind,
+			Paused:   db.paused,
 		},
 	})
 	if err == nil {
@@ -116,6 +120,27 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 	return err
 }
 
+// UpdatePaused persists the paused state of the taskList
+func (db *taskListDB) UpdatePaused(paused bool) error {
+	db.Lock()
+	defer db.Unlock()
+	_, err := db.store.UpdateTaskList(context.Background(), &persistence.UpdateTaskListRequest{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID: db.domainID,
+			Name:     db.taskListName,
+			TaskType: db.taskType,
+			AckLevel: db.ackLevel,
+			RangeID:  db.rangeID,
+			Kind:     db.taskListKind,
+			Paused:   paused,
+		},
+	})
+	if err == nil {
+		db.paused = paused
+	}
+	return err
+}
+
 // CreateTasks creates a batch of given tasks for this task list
 func (db *taskListDB) CreateTasks(tasks []*persistence.CreateTaskInfo) (*persistence.CreateTasksResponse, error) {
 	db.Lock()
@@ -64,6 +64,21 @@ func (g grpcHandler) CancelOutstandingPoll(ctx context.Context, request *matchin
 	return &matchingv1.CancelOutstandingPollResponse{}, proto.FromError(err)
 }
 
+func (g grpcHandler) PauseTaskList(ctx context.Context, request *matchingv1.PauseTaskListRequest) (*matchingv1.PauseTaskListResponse, error) {
+	err := g.h.PauseTaskList(withGRPCTag(ctx), proto.ToMatchingPauseTaskListRequest(request))
+	return &matchingv1.PauseTaskListResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) ResumeTaskList(ctx context.Context, request *matchingv1.ResumeTaskListRequest) (*matchingv1.ResumeTaskListResponse, error) {
+	err := g.h.ResumeTaskList(withGRPCTag(ctx), proto.ToMatchingResumeTaskListRequest(request))
+	return &matchingv1.ResumeTaskListResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) MoveTaskListBacklog(ctx context.Context, request *matchingv1.MoveTaskListBacklogRequest) (*matchingv1.MoveTaskListBacklogResponse, error) {
+	response, err := g.h.MoveTaskListBacklog(withGRPCTag(ctx), proto.ToMatchingMoveTaskListBacklogRequest(request))
+	return proto.FromMatchingMoveTaskListBacklogResponse(response), proto.FromError(err)
+}
+
 func (g grpcHandler) DescribeTaskList(ctx context.Context, request *matchingv1.DescribeTaskListRequest) (*matchingv1.DescribeTaskListResponse, error) {
 	response, err := g.h.DescribeTaskList(withGRPCTag(ctx), proto.ToMatchingDescribeTaskListRequest(request))
 	return proto.FromMatchingDescribeTaskListResponse(response), proto.FromError(err)
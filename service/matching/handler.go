@@ -49,6 +49,9 @@ type (
 		DescribeTaskList(context.Context, *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error)
 		ListTaskListPartitions(context.Context, *types.MatchingListTaskListPartitionsRequest) (*types.ListTaskListPartitionsResponse, error)
 		GetTaskListsByDomain(context.Context, *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error)
+		PauseTaskList(context.Context, *types.MatchingPauseTaskListRequest) error
+		ResumeTaskList(context.Context, *types.MatchingResumeTaskListRequest) error
+		MoveTaskListBacklog(context.Context, *types.MatchingMoveTaskListBacklogRequest) (*types.MoveTaskListBacklogResponse, error)
 		PollForActivityTask(context.Context, *types.MatchingPollForActivityTaskRequest) (*types.PollForActivityTaskResponse, error)
 		PollForDecisionTask(context.Context, *types.MatchingPollForDecisionTaskRequest) (*types.MatchingPollForDecisionTaskResponse, error)
 		QueryWorkflow(context.Context, *types.MatchingQueryWorkflowRequest) (*types.QueryWorkflowResponse, error)
@@ -342,6 +345,72 @@ func (h *handlerImpl) CancelOutstandingPoll(ctx context.Context,
 	return hCtx.handleErr(err)
 }
 
+// PauseTaskList is used to pause dispatching of tasks from a task list
+func (h *handlerImpl) PauseTaskList(ctx context.Context,
+	request *types.MatchingPauseTaskListRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	hCtx := h.newHandlerContext(
+		ctx,
+		request.GetDomainUUID(),
+		request.GetTaskList(),
+		metrics.MatchingPauseTaskListScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	// Count the request in the RPS, but we still accept it even if RPS is exceeded
+	h.rateLimiter.Allow()
+
+	err := h.engine.PauseTaskList(hCtx, request)
+	return hCtx.handleErr(err)
+}
+
+// ResumeTaskList is used to resume dispatching of tasks from a task list
+func (h *handlerImpl) ResumeTaskList(ctx context.Context,
+	request *types.MatchingResumeTaskListRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	hCtx := h.newHandlerContext(
+		ctx,
+		request.GetDomainUUID(),
+		request.GetTaskList(),
+		metrics.MatchingResumeTaskListScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	// Count the request in the RPS, but we still accept it even if RPS is exceeded
+	h.rateLimiter.Allow()
+
+	err := h.engine.ResumeTaskList(hCtx, request)
+	return hCtx.handleErr(err)
+}
+
+// MoveTaskListBacklog is used to move a batch of backlog tasks from one task list to another
+func (h *handlerImpl) MoveTaskListBacklog(
+	ctx context.Context,
+	request *types.MatchingMoveTaskListBacklogRequest,
+) (resp *types.MoveTaskListBacklogResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	hCtx := h.newHandlerContext(
+		ctx,
+		request.GetDomainUUID(),
+		request.GetSourceTaskList(),
+		metrics.MatchingMoveTaskListBacklogScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.MoveTaskListBacklog(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
 // DescribeTaskList returns information about the target tasklist, right now this API returns the
 // pollers which polled this tasklist in last few minutes. If includeTaskListStatus field is true,
 // it will also return status of tasklist's ackManager (readLevel, ackLevel, backlogCountHint and taskIDBlock).
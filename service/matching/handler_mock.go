@@ -21,7 +21,7 @@
 // SOFTWARE.
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: handler.go
+// Source: service/matching/handler.go
 
 // Package matching is a generated GoMock package.
 package matching
@@ -184,6 +184,49 @@ func (mr *MockHandlerMockRecorder) GetTaskListsByDomain(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListsByDomain", reflect.TypeOf((*MockHandler)(nil).GetTaskListsByDomain), arg0, arg1)
 }
 
+// PauseTaskList mocks base method
+func (m *MockHandler) PauseTaskList(arg0 context.Context, arg1 *types.MatchingPauseTaskListRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseTaskList", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseTaskList indicates an expected call of PauseTaskList
+func (mr *MockHandlerMockRecorder) PauseTaskList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseTaskList", reflect.TypeOf((*MockHandler)(nil).PauseTaskList), arg0, arg1)
+}
+
+// ResumeTaskList mocks base method
+func (m *MockHandler) ResumeTaskList(arg0 context.Context, arg1 *types.MatchingResumeTaskListRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeTaskList", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeTaskList indicates an expected call of ResumeTaskList
+func (mr *MockHandlerMockRecorder) ResumeTaskList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTaskList", reflect.TypeOf((*MockHandler)(nil).ResumeTaskList), arg0, arg1)
+}
+
+// MoveTaskListBacklog mocks base method
+func (m *MockHandler) MoveTaskListBacklog(arg0 context.Context, arg1 *types.MatchingMoveTaskListBacklogRequest) (*types.MoveTaskListBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTaskListBacklog", arg0, arg1)
+	ret0, _ := ret[0].(*types.MoveTaskListBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskListBacklog indicates an expected call of MoveTaskListBacklog
+func (mr *MockHandlerMockRecorder) MoveTaskListBacklog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskListBacklog", reflect.TypeOf((*MockHandler)(nil).MoveTaskListBacklog), arg0, arg1)
+}
+
 // PollForActivityTask mocks base method
 func (m *MockHandler) PollForActivityTask(arg0 context.Context, arg1 *types.MatchingPollForActivityTaskRequest) (*types.PollForActivityTaskResponse, error) {
 	m.ctrl.T.Helper()
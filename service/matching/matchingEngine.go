@@ -83,6 +83,11 @@ type (
 	}
 )
 
+const (
+	defaultMoveBacklogBatchSize = 100
+	maxMoveBacklogBatchSize     = 1000
+)
+
 var (
 	// EmptyPollForDecisionTaskResponse is the response when there are no decision tasks to hand out
 	emptyPollForDecisionTaskResponse = &types.MatchingPollForDecisionTaskResponse{}
@@ -95,6 +100,8 @@ var (
 	ErrNoTasks    = errors.New("No tasks")
 	errPumpClosed = errors.New("Task list pump closed its channel")
 
+	errMoveToSameTaskList = &types.BadRequestError{Message: "Source and target task lists must be different."}
+
 	pollerIDKey pollerIDCtxKey = "pollerID"
 	identityKey identityCtxKey = "identity"
 )
@@ -625,6 +632,144 @@ func (e *matchingEngineImpl) CancelOutstandingPoll(
 	return nil
 }
 
+func (e *matchingEngineImpl) PauseTaskList(
+	hCtx *handlerContext,
+	request *types.MatchingPauseTaskListRequest,
+) error {
+	return e.setTaskListPaused(request.GetDomainUUID(), request.GetTaskList(), request.GetTaskListType(), true)
+}
+
+func (e *matchingEngineImpl) ResumeTaskList(
+	hCtx *handlerContext,
+	request *types.MatchingResumeTaskListRequest,
+) error {
+	return e.setTaskListPaused(request.GetDomainUUID(), request.GetTaskList(), request.GetTaskListType(), false)
+}
+
+func (e *matchingEngineImpl) setTaskListPaused(
+	domainID string,
+	taskList *types.TaskList,
+	taskListType types.TaskListType,
+	paused bool,
+) error {
+	persistenceTaskListType := persistence.TaskListTypeDecision
+	if taskListType == types.TaskListTypeActivity {
+		persistenceTaskListType = persistence.TaskListTypeActivity
+	}
+
+	taskListID, err := newTaskListID(domainID, taskList.GetName(), persistenceTaskListType)
+	if err != nil {
+		return err
+	}
+
+	tlMgr, err := e.getTaskListManager(taskListID, taskList.Kind)
+	if err != nil {
+		return err
+	}
+
+	return tlMgr.SetPaused(paused)
+}
+
+// MoveTaskListBacklog moves up to BatchSize persisted tasks from the source task list to the target
+// task list. Each task is re-added to the target through the matching client and only removed from
+// the source once the add succeeded, so an interrupted move can be resumed without losing tasks.
+// Tasks that already expired are left behind for the task reader to discard.
+func (e *matchingEngineImpl) MoveTaskListBacklog(
+	hCtx *handlerContext,
+	request *types.MatchingMoveTaskListBacklogRequest,
+) (*types.MoveTaskListBacklogResponse, error) {
+	domainID := request.GetDomainUUID()
+	sourceTaskList := request.GetSourceTaskList()
+	targetTaskList := request.GetTargetTaskList()
+	if sourceTaskList.GetName() == targetTaskList.GetName() {
+		return nil, errMoveToSameTaskList
+	}
+
+	taskListType := persistence.TaskListTypeDecision
+	if request.GetTaskListType() == types.TaskListTypeActivity {
+		taskListType = persistence.TaskListTypeActivity
+	}
+
+	taskListID, err := newTaskListID(domainID, sourceTaskList.GetName(), taskListType)
+	if err != nil {
+		return nil, err
+	}
+
+	tlMgr, err := e.getTaskListManager(taskListID, sourceTaskList.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	batchSize := int(request.GetBatchSize())
+	if batchSize <= 0 {
+		batchSize = defaultMoveBacklogBatchSize
+	} else if batchSize > maxMoveBacklogBatchSize {
+		batchSize = maxMoveBacklogBatchSize
+	}
+	tasks, err := tlMgr.ReadBacklog(request.GetAfterTaskID(), batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &types.MoveTaskListBacklogResponse{
+		LastTaskID: request.GetAfterTaskID(),
+		HasMore:    len(tasks) == batchSize,
+	}
+	now := time.Now()
+	for _, task := range tasks {
+		if task.Expiry.IsZero() || task.Expiry.After(now) {
+			if !request.GetDryRun() {
+				if err := e.moveTask(hCtx.Context, domainID, targetTaskList, taskListType, task, now); err != nil {
+					return response, err
+				}
+				if err := tlMgr.DeleteBacklogTask(task.TaskID); err != nil {
+					return response, err
+				}
+			}
+			response.Count++
+		}
+		response.LastTaskID = task.TaskID
+	}
+	return response, nil
+}
+
+func (e *matchingEngineImpl) moveTask(
+	ctx context.Context,
+	domainID string,
+	taskList *types.TaskList,
+	taskListType int,
+	task *persistence.TaskInfo,
+	now time.Time,
+) error {
+	var scheduleToStartTimeout int32
+	if !task.Expiry.IsZero() {
+		scheduleToStartTimeout = int32(math.Ceil(task.Expiry.Sub(now).Seconds()))
+	}
+	source := types.TaskSourceDbBacklog
+	execution := &types.WorkflowExecution{WorkflowID: task.WorkflowID, RunID: task.RunID}
+	targetTaskList := &types.TaskList{Name: taskList.GetName(), Kind: taskList.Kind}
+
+	if taskListType == persistence.TaskListTypeActivity {
+		return e.matchingClient.AddActivityTask(ctx, &types.AddActivityTaskRequest{
+			DomainUUID:                    domainID,
+			SourceDomainUUID:              task.DomainID,
+			Execution:                     execution,
+			TaskList:                      targetTaskList,
+			ScheduleID:                    task.ScheduleID,
+			ScheduleToStartTimeoutSeconds: &scheduleToStartTimeout,
+			Source:                        &source,
+		})
+	}
+	return e.matchingClient.AddDecisionTask(ctx, &types.AddDecisionTaskRequest{
+		DomainUUID:                    task.DomainID,
+		Execution:                     execution,
+		TaskList:                      targetTaskList,
+		ScheduleID:                    task.ScheduleID,
+		ScheduleToStartTimeoutSeconds: &scheduleToStartTimeout,
+		Source:                        &source,
+	})
+}
+
 func (e *matchingEngineImpl) DescribeTaskList(
 	hCtx *handlerContext,
 	request *types.MatchingDescribeTaskListRequest,
@@ -33,6 +33,9 @@ type (
 		QueryWorkflow(hCtx *handlerContext, request *types.MatchingQueryWorkflowRequest) (*types.QueryWorkflowResponse, error)
 		RespondQueryTaskCompleted(hCtx *handlerContext, request *types.MatchingRespondQueryTaskCompletedRequest) error
 		CancelOutstandingPoll(hCtx *handlerContext, request *types.CancelOutstandingPollRequest) error
+		PauseTaskList(hCtx *handlerContext, request *types.MatchingPauseTaskListRequest) error
+		ResumeTaskList(hCtx *handlerContext, request *types.MatchingResumeTaskListRequest) error
+		MoveTaskListBacklog(hCtx *handlerContext, request *types.MatchingMoveTaskListBacklogRequest) (*types.MoveTaskListBacklogResponse, error)
 		DescribeTaskList(hCtx *handlerContext, request *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error)
 		ListTaskListPartitions(hCtx *handlerContext, request *types.MatchingListTaskListPartitionsRequest) (*types.ListTaskListPartitionsResponse, error)
 		GetTaskListsByDomain(hCtx *handlerContext, request *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error)
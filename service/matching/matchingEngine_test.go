@@ -33,6 +33,7 @@ import (
 	"github.com/golang/mock/gomock"
 
 	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/dynamicconfig"
@@ -1384,6 +1385,87 @@ func (s *matchingEngineSuite) TestAddTaskAfterStartFailure() {
 	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
 }
 
+func (s *matchingEngineSuite) TestMoveTaskListBacklog() {
+	domainID := "domainId"
+	sourceTaskList := &types.TaskList{Name: "oldToast"}
+	targetTaskList := &types.TaskList{Name: "newToast"}
+	tlID := newTestTaskListID(domainID, sourceTaskList.GetName(), persistence.TaskListTypeActivity)
+	taskListType := types.TaskListTypeActivity
+
+	const taskCount = 3
+	for i := 0; i < taskCount; i++ {
+		addRequest := types.AddActivityTaskRequest{
+			SourceDomainUUID:              domainID,
+			DomainUUID:                    domainID,
+			Execution:                     &types.WorkflowExecution{RunID: "run1", WorkflowID: "workflow1"},
+			ScheduleID:                    int64(i),
+			TaskList:                      sourceTaskList,
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+		}
+		_, err := s.matchingEngine.AddActivityTask(s.handlerContext, &addRequest)
+		s.NoError(err)
+	}
+	s.EqualValues(taskCount, s.taskManager.getTaskCount(tlID))
+
+	mockMatchingClient := matching.NewMockClient(s.controller)
+	s.matchingEngine.matchingClient = mockMatchingClient
+
+	_, err := s.matchingEngine.MoveTaskListBacklog(s.handlerContext, &types.MatchingMoveTaskListBacklogRequest{
+		DomainUUID:     domainID,
+		SourceTaskList: sourceTaskList,
+		TargetTaskList: sourceTaskList,
+		TaskListType:   &taskListType,
+	})
+	s.Equal(errMoveToSameTaskList, err)
+
+	resp, err := s.matchingEngine.MoveTaskListBacklog(s.handlerContext, &types.MatchingMoveTaskListBacklogRequest{
+		DomainUUID:     domainID,
+		SourceTaskList: sourceTaskList,
+		TargetTaskList: targetTaskList,
+		TaskListType:   &taskListType,
+		DryRun:         true,
+	})
+	s.NoError(err)
+	s.EqualValues(taskCount, resp.Count)
+	s.False(resp.HasMore)
+	s.EqualValues(taskCount, s.taskManager.getTaskCount(tlID))
+
+	mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.AddActivityTaskRequest, _ ...interface{}) error {
+			s.Equal(targetTaskList.GetName(), request.TaskList.GetName())
+			s.Equal(domainID, request.GetDomainUUID())
+			s.Equal(types.TaskSourceDbBacklog, request.GetSource())
+			s.True(request.GetScheduleToStartTimeoutSeconds() > 0)
+			return nil
+		},
+	).Times(taskCount)
+
+	resp, err = s.matchingEngine.MoveTaskListBacklog(s.handlerContext, &types.MatchingMoveTaskListBacklogRequest{
+		DomainUUID:     domainID,
+		SourceTaskList: sourceTaskList,
+		TargetTaskList: targetTaskList,
+		TaskListType:   &taskListType,
+		BatchSize:      2,
+	})
+	s.NoError(err)
+	s.EqualValues(2, resp.Count)
+	s.True(resp.HasMore)
+	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
+
+	resp, err = s.matchingEngine.MoveTaskListBacklog(s.handlerContext, &types.MatchingMoveTaskListBacklogRequest{
+		DomainUUID:     domainID,
+		SourceTaskList: sourceTaskList,
+		TargetTaskList: targetTaskList,
+		TaskListType:   &taskListType,
+		BatchSize:      2,
+		AfterTaskID:    resp.LastTaskID,
+	})
+	s.NoError(err)
+	s.EqualValues(1, resp.Count)
+	s.False(resp.HasMore)
+	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
+}
+
 func (s *matchingEngineSuite) TestTaskListManagerGetTaskBatch() {
 	runID := "run1"
 	workflowID := "workflow1"
@@ -1697,6 +1779,7 @@ type testTaskListManager struct {
 	sync.Mutex
 	rangeID         int64
 	ackLevel        int64
+	paused          bool
 	createTaskCount int
 	tasks           *treemap.Map
 }
@@ -1745,6 +1828,7 @@ func (m *testTaskManager) LeaseTaskList(
 			TaskType: request.TaskType,
 			RangeID:  tlm.rangeID,
 			Kind:     request.TaskListKind,
+			Paused:   tlm.paused,
 		},
 	}, nil
 }
@@ -1767,6 +1851,7 @@ func (m *testTaskManager) UpdateTaskList(
 		}
 	}
 	tlm.ackLevel = tli.AckLevel
+	tlm.paused = tli.Paused
 	return &persistence.UpdateTaskListResponse{}, nil
 }
 
@@ -1905,6 +1990,9 @@ func (m *testTaskManager) GetTasks(
 		if taskID > *request.MaxReadLevel {
 			break
 		}
+		if request.BatchSize > 0 && len(tasks) >= request.BatchSize {
+			break
+		}
 		tasks = append(tasks, it.Value().(*persistence.TaskInfo))
 	}
 	return &persistence.GetTasksResponse{
@@ -79,6 +79,13 @@ type (
 		GetAllPollerInfo() []*types.PollerInfo
 		// DescribeTaskList returns information about the target tasklist
 		DescribeTaskList(includeTaskListStatus bool) *types.DescribeTaskListResponse
+		// SetPaused pauses or resumes dispatching of tasks to pollers. While paused, new tasks
+		// are persisted to the backlog and only query tasks are handed out to pollers
+		SetPaused(paused bool) error
+		// ReadBacklog returns up to batchSize persisted tasks with an ID greater than afterTaskID
+		ReadBacklog(afterTaskID int64, batchSize int) ([]*persistence.TaskInfo, error)
+		// DeleteBacklogTask removes a single persisted task from the backlog
+		DeleteBacklogTask(taskID int64) error
 		String() string
 		GetTaskListKind() types.TaskListKind
 	}
@@ -113,6 +120,7 @@ type (
 		shutdownCh chan struct{}  // Delivers stop to the pump that populates taskBuffer
 		startWG    sync.WaitGroup // ensures that background processes do not start until setup is ready
 		stopped    int32
+		paused     int32
 	}
 )
 
@@ -202,6 +210,7 @@ func (c *taskListManagerImpl) Start() error {
 	}
 
 	c.taskAckManager.SetAckLevel(state.ackLevel)
+	c.setPausedLocal(state.paused)
 	c.taskWriter.Start(c.rangeIDToTaskIDBlock(state.rangeID))
 	c.taskReader.Start()
 
@@ -242,6 +251,14 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 			return r, err
 		}
 
+		if c.isPaused() {
+			if params.forwardedFrom != "" {
+				// child partition will persist the task
+				return &persistence.CreateTasksResponse{}, errRemoteSyncMatchFailed
+			}
+			return c.taskWriter.appendTask(params.execution, params.taskInfo)
+		}
+
 		syncMatch, err = c.trySyncMatch(ctx, params)
 		if syncMatch {
 			return &persistence.CreateTasksResponse{}, err
@@ -344,7 +361,7 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 	// value. Last poller wins if different pollers provide different values
 	c.matcher.UpdateRatelimit(maxDispatchPerSecond)
 
-	if domainEntry.GetDomainNotActiveErr() != nil {
+	if domainEntry.GetDomainNotActiveErr() != nil || c.isPaused() {
 		return c.matcher.PollForQuery(childCtx)
 	}
 
@@ -386,11 +403,74 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 			StartID: taskIDBlock.start,
 			EndID:   taskIDBlock.end,
 		},
+		Paused: c.isPaused(),
 	}
 
 	return response
 }
 
+// SetPaused pauses or resumes dispatching of tasks from this task list. The paused state
+// is persisted so that it is honored by the next owner of the task list
+func (c *taskListManagerImpl) SetPaused(paused bool) error {
+	c.startWG.Wait()
+	if err := c.db.UpdatePaused(paused); err != nil {
+		return err
+	}
+	if c.setPausedLocal(paused) {
+		// unblock outstanding pollers so that they pick up the new state on their next poll
+		c.cancelOutstandingPolls()
+		c.logger.Info("Task list paused state changed", tag.Bool(paused))
+	}
+	return nil
+}
+
+func (c *taskListManagerImpl) setPausedLocal(paused bool) bool {
+	var value int32
+	if paused {
+		value = 1
+	}
+	return atomic.SwapInt32(&c.paused, value) != value
+}
+
+func (c *taskListManagerImpl) isPaused() bool {
+	return atomic.LoadInt32(&c.paused) == 1
+}
+
+func (c *taskListManagerImpl) cancelOutstandingPolls() {
+	c.outstandingPollsLock.Lock()
+	defer c.outstandingPollsLock.Unlock()
+	for _, cancel := range c.outstandingPollsMap {
+		cancel()
+	}
+}
+
+// ReadBacklog returns up to batchSize persisted tasks starting after the larger of afterTaskID
+// and the current ack level
+func (c *taskListManagerImpl) ReadBacklog(afterTaskID int64, batchSize int) ([]*persistence.TaskInfo, error) {
+	c.startWG.Wait()
+	readLevel := c.taskAckManager.GetAckLevel()
+	if afterTaskID > readLevel {
+		readLevel = afterTaskID
+	}
+	maxReadLevel := c.taskWriter.GetMaxReadLevel()
+	response, err := c.executeWithRetry(func() (interface{}, error) {
+		return c.db.GetTasks(readLevel, maxReadLevel, batchSize)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.(*persistence.GetTasksResponse).Tasks, nil
+}
+
+// DeleteBacklogTask removes a single persisted task from the backlog
+func (c *taskListManagerImpl) DeleteBacklogTask(taskID int64) error {
+	c.startWG.Wait()
+	_, err := c.executeWithRetry(func() (interface{}, error) {
+		return nil, c.db.CompleteTask(taskID)
+	})
+	return err
+}
+
 func (c *taskListManagerImpl) String() string {
 	buf := new(bytes.Buffer)
 	if c.taskListID.taskType == persistence.TaskListTypeActivity {
@@ -233,6 +233,51 @@ func TestDescribeTaskList(t *testing.T) {
 	require.Zero(t, taskListStatus.GetBacklogCountHint())
 }
 
+func TestSetPaused(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+	tm := tlm.engine.taskManager.(*testTaskManager)
+
+	require.NoError(t, tlm.SetPaused(true))
+	require.True(t, tlm.DescribeTaskList(true).GetTaskListStatus().GetPaused())
+	require.True(t, tm.getTaskListManager(tlm.taskListID).paused)
+
+	// tasks are persisted instead of being sync matched while paused
+	syncMatch, err := tlm.AddTask(context.Background(), addTaskParams{
+		execution: &types.WorkflowExecution{WorkflowID: "workflowID", RunID: "runID"},
+		taskInfo: &persistence.TaskInfo{
+			DomainID:   tlm.taskListID.domainID,
+			WorkflowID: "workflowID",
+			RunID:      "runID",
+			ScheduleID: 1,
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, syncMatch)
+	require.Equal(t, 1, tm.getCreateTaskCount(tlm.taskListID))
+
+	// pollers do not receive tasks while paused
+	ctx, cancel := context.WithTimeout(context.Background(), returnEmptyTaskTimeBudget+100*time.Millisecond)
+	defer cancel()
+	_, err = tlm.GetTask(ctx, nil)
+	require.Equal(t, ErrNoTasks, err)
+
+	require.NoError(t, tlm.SetPaused(false))
+	require.False(t, tlm.DescribeTaskList(true).GetTaskListStatus().GetPaused())
+	require.False(t, tm.getTaskListManager(tlm.taskListID).paused)
+
+	// backlog is dispatched once resumed
+	ctx, cancel = context.WithTimeout(context.Background(), returnEmptyTaskTimeBudget+time.Second)
+	defer cancel()
+	task, err := tlm.GetTask(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, "workflowID", task.event.WorkflowID)
+}
+
 func tlMgrStartWithoutNotifyEvent(tlm *taskListManagerImpl) {
 	// mimic tlm.Start() but avoid calling notifyEvent
 	tlm.startWG.Done()
@@ -73,6 +73,24 @@ func (t ThriftHandler) CancelOutstandingPoll(ctx context.Context, request *m.Can
 	return thrift.FromError(err)
 }
 
+// PauseTaskList forwards request to the underlying handler
+func (t ThriftHandler) PauseTaskList(ctx context.Context, request *m.PauseTaskListRequest) error {
+	err := t.h.PauseTaskList(withThriftTag(ctx), thrift.ToMatchingPauseTaskListRequest(request))
+	return thrift.FromError(err)
+}
+
+// ResumeTaskList forwards request to the underlying handler
+func (t ThriftHandler) ResumeTaskList(ctx context.Context, request *m.ResumeTaskListRequest) error {
+	err := t.h.ResumeTaskList(withThriftTag(ctx), thrift.ToMatchingResumeTaskListRequest(request))
+	return thrift.FromError(err)
+}
+
+// MoveTaskListBacklog forwards request to the underlying handler
+func (t ThriftHandler) MoveTaskListBacklog(ctx context.Context, request *m.MoveTaskListBacklogRequest) (*s.MoveTaskListBacklogResponse, error) {
+	response, err := t.h.MoveTaskListBacklog(withThriftTag(ctx), thrift.ToMatchingMoveTaskListBacklogRequest(request))
+	return thrift.FromMoveTaskListBacklogResponse(response), thrift.FromError(err)
+}
+
 // DescribeTaskList forwards request to the underlying handler
 func (t ThriftHandler) DescribeTaskList(ctx context.Context, request *m.DescribeTaskListRequest) (*s.DescribeTaskListResponse, error) {
 	response, err := t.h.DescribeTaskList(withThriftTag(ctx), thrift.ToMatchingDescribeTaskListRequest(request))
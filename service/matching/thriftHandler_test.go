@@ -67,6 +67,22 @@ func TestThriftHandler(t *testing.T) {
 		err := th.CancelOutstandingPoll(ctx, &m.CancelOutstandingPollRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("PauseTaskList", func(t *testing.T) {
+		h.EXPECT().PauseTaskList(taggedCtx, &types.MatchingPauseTaskListRequest{}).Return(internalErr).Times(1)
+		err := th.PauseTaskList(ctx, &m.PauseTaskListRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ResumeTaskList", func(t *testing.T) {
+		h.EXPECT().ResumeTaskList(taggedCtx, &types.MatchingResumeTaskListRequest{}).Return(internalErr).Times(1)
+		err := th.ResumeTaskList(ctx, &m.ResumeTaskListRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("MoveTaskListBacklog", func(t *testing.T) {
+		h.EXPECT().MoveTaskListBacklog(taggedCtx, &types.MatchingMoveTaskListBacklogRequest{}).Return(&types.MoveTaskListBacklogResponse{}, internalErr).Times(1)
+		resp, err := th.MoveTaskListBacklog(ctx, &m.MoveTaskListBacklogRequest{})
+		assert.Equal(t, s.MoveTaskListBacklogResponse{Count: common.Int64Ptr(0), LastTaskID: common.Int64Ptr(0), HasMore: common.BoolPtr(false)}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("DescribeTaskList", func(t *testing.T) {
 		h.EXPECT().DescribeTaskList(taggedCtx, &types.MatchingDescribeTaskListRequest{}).Return(&types.DescribeTaskListResponse{}, internalErr).Times(1)
 		resp, err := th.DescribeTaskList(ctx, &m.DescribeTaskListRequest{})
@@ -95,6 +111,9 @@ func TestThriftHandler(t *testing.T) {
 			StickyExecutionEnabled: common.BoolPtr(false),
 			BacklogCountHint:       common.Int64Ptr(0),
 			EventStoreVersion:      common.Int32Ptr(0),
+			HistorySize:            common.Int64Ptr(0),
+			HistoryCount:           common.Int64Ptr(0),
+			SuggestContinueAsNew:   common.BoolPtr(false),
 		}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
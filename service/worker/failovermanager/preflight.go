@@ -0,0 +1,173 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package failovermanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	defaultPreflightMaxReplicationLag = 1000
+)
+
+type (
+	// PreflightParams is the arg for preflight check
+	PreflightParams struct {
+		TargetCluster string
+		SourceCluster string
+		// Domains to check, all domains active in source cluster and replicated to target cluster are checked if empty
+		Domains []string
+		// MaxReplicationLag is the replication task lag above which failover of a domain is refused
+		MaxReplicationLag int64
+	}
+
+	// PreflightResult is the preflight check result of one domain
+	PreflightResult struct {
+		Domain string
+		// Errors are the reasons to refuse failover of the domain
+		Errors []string
+		// Warnings are the risks which do not block failover of the domain
+		Warnings []string
+	}
+)
+
+// Passed returns true if failover of the domain is not refused
+func (r *PreflightResult) Passed() bool {
+	return len(r.Errors) == 0
+}
+
+// CheckPreflight checks whether the domains can be safely failed over from source cluster to target cluster.
+// sourceStatus is the replication status towards target cluster described by source cluster, targetStatus is
+// the replication status towards source cluster described by target cluster and can be nil if not available.
+func CheckPreflight(
+	params *PreflightParams,
+	sourceStatus *types.DescribeReplicationStatusResponse,
+	targetStatus *types.DescribeReplicationStatusResponse,
+) []*PreflightResult {
+
+	maxReplicationLag := params.MaxReplicationLag
+	if maxReplicationLag <= 0 {
+		maxReplicationLag = defaultPreflightMaxReplicationLag
+	}
+	sourceDomains := getDomainReplicationStatus(sourceStatus, params.TargetCluster)
+	targetDomains := getDomainReplicationStatus(targetStatus, params.SourceCluster)
+
+	domains := params.Domains
+	if len(domains) == 0 {
+		for domain, status := range sourceDomains {
+			if status.ActiveClusterName == params.SourceCluster {
+				domains = append(domains, domain)
+			}
+		}
+		sort.Strings(domains)
+	}
+
+	results := make([]*PreflightResult, 0, len(domains))
+	for _, domain := range domains {
+		result := &PreflightResult{Domain: domain}
+		results = append(results, result)
+
+		source, ok := sourceDomains[domain]
+		if !ok {
+			result.Errors = append(result.Errors, fmt.Sprintf(
+				"domain is not replicated to cluster %v", params.TargetCluster))
+			continue
+		}
+		if source.GracefulFailoverEndTime != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf(
+				"graceful failover is pending until %v", time.Unix(0, *source.GracefulFailoverEndTime)))
+		}
+		if source.MaxOutboundTaskLag > maxReplicationLag {
+			result.Errors = append(result.Errors, fmt.Sprintf(
+				"replication lag %v to cluster %v exceeds %v", source.MaxOutboundTaskLag, params.TargetCluster, maxReplicationLag))
+		} else if source.MaxOutboundTaskLag > 0 {
+			result.Warnings = append(result.Warnings, fmt.Sprintf(
+				"replication lag %v to cluster %v", source.MaxOutboundTaskLag, params.TargetCluster))
+		}
+		if source.MaxCrossClusterTaskLag > 0 {
+			result.Warnings = append(result.Warnings, fmt.Sprintf(
+				"cross-cluster task lag %v to cluster %v", source.MaxCrossClusterTaskLag, params.TargetCluster))
+		}
+		if source.InboundDLQSize > 0 {
+			result.Warnings = append(result.Warnings, fmt.Sprintf(
+				"%v replication tasks from cluster %v are in replication DLQ of cluster %v",
+				source.InboundDLQSize, params.TargetCluster, params.SourceCluster))
+		}
+
+		if targetStatus == nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf(
+				"replication DLQ of cluster %v is not checked", params.TargetCluster))
+		} else if target, ok := targetDomains[domain]; ok && target.InboundDLQSize > 0 {
+			// replication DLQ is not partitioned by domain and counting the tasks of one domain requires
+			// reading the whole DLQ, so tasks of other domains are included and failover is not refused
+			result.Warnings = append(result.Warnings, fmt.Sprintf(
+				"%v replication tasks from cluster %v of this or other domains are in replication DLQ of cluster %v",
+				target.InboundDLQSize, params.SourceCluster, params.TargetCluster))
+		}
+	}
+	return results
+}
+
+func getDomainReplicationStatus(
+	response *types.DescribeReplicationStatusResponse,
+	clusterName string,
+) map[string]*types.ReplicationDomainStatus {
+
+	result := make(map[string]*types.ReplicationDomainStatus)
+	for _, status := range response.GetDomains() {
+		if status.ClusterName == clusterName {
+			result[status.Domain] = status
+		}
+	}
+	return result
+}
+
+// PreflightActivity activity def
+func PreflightActivity(ctx context.Context, params *PreflightParams) ([]*PreflightResult, error) {
+	if params == nil {
+		return nil, errors.New(errMsgParamsIsNil)
+	}
+	if err := validateTargetAndSourceCluster(params.TargetCluster, params.SourceCluster); err != nil {
+		return nil, err
+	}
+
+	manager := ctx.Value(failoverManagerContextKey).(*FailoverManager)
+	sourceStatus, err := manager.clientBean.GetRemoteAdminClient(params.SourceCluster).DescribeReplicationStatus(
+		ctx,
+		&types.DescribeReplicationStatusRequest{ClusterName: params.TargetCluster},
+	)
+	if err != nil {
+		return nil, err
+	}
+	targetStatus, err := manager.clientBean.GetRemoteAdminClient(params.TargetCluster).DescribeReplicationStatus(
+		ctx,
+		&types.DescribeReplicationStatusRequest{ClusterName: params.SourceCluster},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return CheckPreflight(params, sourceStatus, targetStatus), nil
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package failovermanager
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestCheckPreflight(t *testing.T) {
+	params := &PreflightParams{
+		TargetCluster:     "c2",
+		SourceCluster:     "c1",
+		MaxReplicationLag: 10,
+	}
+	sourceStatus := &types.DescribeReplicationStatusResponse{
+		Domains: []*types.ReplicationDomainStatus{
+			{Domain: "d1", ActiveClusterName: "c1", ClusterName: "c2"},
+			{Domain: "d2", ActiveClusterName: "c1", ClusterName: "c2", MaxOutboundTaskLag: 5, MaxCrossClusterTaskLag: 1},
+			{Domain: "d3", ActiveClusterName: "c1", ClusterName: "c2", MaxOutboundTaskLag: 11},
+			{Domain: "d4", ActiveClusterName: "c1", ClusterName: "c2", GracefulFailoverEndTime: common.Int64Ptr(1)},
+			{Domain: "d5", ActiveClusterName: "c2", ClusterName: "c2"},
+			{Domain: "d6", ActiveClusterName: "c1", ClusterName: "c3"},
+		},
+	}
+	targetStatus := &types.DescribeReplicationStatusResponse{
+		Domains: []*types.ReplicationDomainStatus{
+			{Domain: "d1", ActiveClusterName: "c1", ClusterName: "c1"},
+			{Domain: "d2", ActiveClusterName: "c1", ClusterName: "c1"},
+			{Domain: "d3", ActiveClusterName: "c1", ClusterName: "c1"},
+			{Domain: "d4", ActiveClusterName: "c1", ClusterName: "c1"},
+		},
+	}
+
+	results := CheckPreflight(params, sourceStatus, targetStatus)
+	assert.Len(t, results, 4)
+	assert.Equal(t, "d1", results[0].Domain)
+	assert.True(t, results[0].Passed())
+	assert.Empty(t, results[0].Warnings)
+	assert.Equal(t, "d2", results[1].Domain)
+	assert.True(t, results[1].Passed())
+	assert.Len(t, results[1].Warnings, 2)
+	assert.Equal(t, "d3", results[2].Domain)
+	assert.False(t, results[2].Passed())
+	assert.Equal(t, "d4", results[3].Domain)
+	assert.False(t, results[3].Passed())
+
+	params.Domains = []string{"d1", "d6"}
+	results = CheckPreflight(params, sourceStatus, nil)
+	assert.Len(t, results, 2)
+	assert.True(t, results[0].Passed())
+	assert.Len(t, results[0].Warnings, 1)
+	assert.False(t, results[1].Passed())
+
+	targetStatus.Domains[0].InboundDLQSize = 1
+	results = CheckPreflight(params, sourceStatus, targetStatus)
+	assert.True(t, results[0].Passed())
+	assert.Len(t, results[0].Warnings, 1)
+}
+
+func (s *failoverWorkflowTestSuite) TestPreflightActivity() {
+	env, mockResource, controller := s.prepareTestActivityEnv()
+	defer controller.Finish()
+	defer mockResource.Finish(s.T())
+
+	mockResource.RemoteAdminClient.EXPECT().DescribeReplicationStatus(gomock.Any(), &types.DescribeReplicationStatusRequest{
+		ClusterName: "c2",
+	}).Return(&types.DescribeReplicationStatusResponse{
+		Domains: []*types.ReplicationDomainStatus{
+			{Domain: "d1", ActiveClusterName: "c1", ClusterName: "c2"},
+		},
+	}, nil).Times(1)
+	mockResource.RemoteAdminClient.EXPECT().DescribeReplicationStatus(gomock.Any(), &types.DescribeReplicationStatusRequest{
+		ClusterName: "c1",
+	}).Return(&types.DescribeReplicationStatusResponse{
+		Domains: []*types.ReplicationDomainStatus{
+			{Domain: "d1", ActiveClusterName: "c1", ClusterName: "c1", InboundDLQSize: 1},
+		},
+	}, nil).Times(1)
+
+	params := &PreflightParams{
+		TargetCluster: "c2",
+		SourceCluster: "c1",
+		Domains:       []string{"d1"},
+	}
+	actResult, err := env.ExecuteActivity(preflightActivityName, params)
+	s.NoError(err)
+	var result []*PreflightResult
+	s.NoError(actResult.Get(&result))
+	s.Len(result, 1)
+	s.True(result[0].Passed())
+	s.Len(result[0].Warnings, 1)
+
+	_, err = env.ExecuteActivity(preflightActivityName, &PreflightParams{SourceCluster: "c1"})
+	s.Error(err)
+}
@@ -101,6 +101,7 @@ func (s *FailoverManager) Start() error {
 	failoverWorker.RegisterWorkflowWithOptions(RebalanceWorkflow, workflow.RegisterOptions{Name: RebalanceWorkflowTypeName})
 	failoverWorker.RegisterActivityWithOptions(FailoverActivity, activity.RegisterOptions{Name: failoverActivityName})
 	failoverWorker.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+	failoverWorker.RegisterActivityWithOptions(PreflightActivity, activity.RegisterOptions{Name: preflightActivityName})
 	failoverWorker.RegisterActivityWithOptions(GetDomainsForRebalanceActivity, activity.RegisterOptions{Name: getRebalanceDomainsActivityName})
 	s.worker = failoverWorker
 	return failoverWorker.Start()
@@ -30,6 +30,7 @@ import (
 	"go.uber.org/cadence"
 	"go.uber.org/cadence/activity"
 	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
@@ -55,6 +56,7 @@ const (
 	failoverActivityName            = "cadence-sys-failover-activity"
 	getDomainsActivityName          = "cadence-sys-getDomains-activity"
 	getRebalanceDomainsActivityName = "cadence-sys-getRebalanceDomains-activity"
+	preflightActivityName           = "cadence-sys-failoverPreflight-activity"
 
 	defaultBatchFailoverSize              = 20
 	defaultBatchFailoverWaitTimeInSeconds = 30
@@ -102,6 +104,10 @@ type (
 		Domains []string
 		// DrillWaitTime defines the wait time of a failover drill
 		DrillWaitTime time.Duration
+		// PreflightCheck skips failover of domains which fail the preflight check
+		PreflightCheck bool
+		// MaxReplicationLag is the replication task lag above which preflight check fails
+		MaxReplicationLag int64
 	}
 
 	// FailoverResult is workflow result
@@ -193,7 +199,13 @@ func FailoverWorkflow(ctx workflow.Context, params *FailoverParams) (*FailoverRe
 	if err != nil {
 		return nil, err
 	}
-	totalNumOfDomains = len(domains)
+	if params.PreflightCheck {
+		domains, failedDomains, err = preflightCheck(ctx, domains, params)
+		if err != nil {
+			return nil, err
+		}
+	}
+	totalNumOfDomains = len(domains) + len(failedDomains)
 
 	pauseCh := workflow.GetSignalChannel(ctx, PauseSignal)
 	resumeCh := workflow.GetSignalChannel(ctx, ResumeSignal)
@@ -210,7 +222,8 @@ func FailoverWorkflow(ctx workflow.Context, params *FailoverParams) (*FailoverRe
 	}
 
 	// failover in batch
-	successDomains, failedDomains = failoverDomainsByBatch(ctx, domains, params, checkPauseSignal, false)
+	successDomains, batchFailedDomains := failoverDomainsByBatch(ctx, domains, params, checkPauseSignal, false)
+	failedDomains = append(failedDomains, batchFailedDomains...)
 
 	if params.DrillWaitTime == 0 {
 		// This is a normal failover
@@ -234,6 +247,42 @@ func FailoverWorkflow(ctx workflow.Context, params *FailoverParams) (*FailoverRe
 	}, nil
 }
 
+func preflightCheck(
+	ctx workflow.Context,
+	domains []string,
+	params *FailoverParams,
+) (passedDomains []string, failedDomains []string, err error) {
+
+	if len(domains) == 0 {
+		return domains, nil, nil
+	}
+
+	ao := workflow.WithActivityOptions(ctx, getPreflightActivityOptions())
+	preflightParams := &PreflightParams{
+		TargetCluster:     params.TargetCluster,
+		SourceCluster:     params.SourceCluster,
+		Domains:           domains,
+		MaxReplicationLag: params.MaxReplicationLag,
+	}
+	var results []*PreflightResult
+	err = workflow.ExecuteActivity(ao, PreflightActivity, preflightParams).Get(ctx, &results)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logger := workflow.GetLogger(ctx)
+	for _, result := range results {
+		if result.Passed() {
+			passedDomains = append(passedDomains, result.Domain)
+		} else {
+			logger.Warn("Domain failed failover preflight check",
+				zap.String("domain", result.Domain), zap.Strings("errors", result.Errors))
+			failedDomains = append(failedDomains, result.Domain)
+		}
+	}
+	return passedDomains, failedDomains, nil
+}
+
 func failoverDomainsByBatch(
 	ctx workflow.Context,
 	domains []string,
@@ -310,6 +359,12 @@ func getGetDomainsActivityOptions() workflow.ActivityOptions {
 	}
 }
 
+func getPreflightActivityOptions() workflow.ActivityOptions {
+	options := getGetDomainsActivityOptions()
+	options.StartToCloseTimeout = time.Minute
+	return options
+}
+
 func getFailoverActivityOptions() workflow.ActivityOptions {
 	return workflow.ActivityOptions{
 		ScheduleToStartTimeout: 10 * time.Second,
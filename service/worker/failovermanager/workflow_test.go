@@ -67,8 +67,10 @@ func (s *failoverWorkflowTestSuite) SetupTest() {
 	s.workflowEnv.RegisterWorkflowWithOptions(FailoverWorkflow, workflow.RegisterOptions{Name: FailoverWorkflowTypeName})
 	s.workflowEnv.RegisterActivityWithOptions(FailoverActivity, activity.RegisterOptions{Name: failoverActivityName})
 	s.workflowEnv.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(PreflightActivity, activity.RegisterOptions{Name: preflightActivityName})
 	s.activityEnv.RegisterActivityWithOptions(FailoverActivity, activity.RegisterOptions{Name: failoverActivityName})
 	s.activityEnv.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+	s.activityEnv.RegisterActivityWithOptions(PreflightActivity, activity.RegisterOptions{Name: preflightActivityName})
 }
 
 func (s *failoverWorkflowTestSuite) TearDownTest() {
@@ -154,6 +156,45 @@ func (s *failoverWorkflowTestSuite) TestWorkflow_Success() {
 	s.Equal(unknownOperator, res.Operator)
 }
 
+func (s *failoverWorkflowTestSuite) TestWorkflow_PreflightCheck() {
+	domains := []string{"d1", "d2"}
+	mockPreflightResults := []*PreflightResult{
+		{Domain: "d1", Warnings: []string{"warning"}},
+		{Domain: "d2", Errors: []string{"error"}},
+	}
+	mockFailoverActivityResult := &FailoverActivityResult{
+		SuccessDomains: []string{"d1"},
+	}
+	s.workflowEnv.OnActivity(getDomainsActivityName, mock.Anything, mock.Anything).Return(domains, nil)
+	s.workflowEnv.OnActivity(preflightActivityName, mock.Anything, &PreflightParams{
+		TargetCluster:     "t",
+		SourceCluster:     "s",
+		Domains:           domains,
+		MaxReplicationLag: 10,
+	}).Return(mockPreflightResults, nil).Once()
+	s.workflowEnv.OnActivity(failoverActivityName, mock.Anything, &FailoverActivityParams{
+		Domains:       []string{"d1"},
+		TargetCluster: "t",
+	}).Return(mockFailoverActivityResult, nil).Once()
+	params := &FailoverParams{
+		TargetCluster:     "t",
+		SourceCluster:     "s",
+		PreflightCheck:    true,
+		MaxReplicationLag: 10,
+	}
+	s.workflowEnv.ExecuteWorkflow(FailoverWorkflowTypeName, params)
+	var result FailoverResult
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal([]string{"d1"}, result.SuccessDomains)
+	s.Equal([]string{"d2"}, result.FailedDomains)
+
+	queryResult, err := s.workflowEnv.QueryWorkflow(QueryType)
+	s.NoError(err)
+	var res QueryResult
+	s.NoError(queryResult.Get(&res))
+	s.Equal(2, res.TotalDomains)
+}
+
 func (s *failoverWorkflowTestSuite) TestWorkflow_Success_Batches() {
 	domains := []string{"d1", "d2", "d3"}
 	expectFailoverActivityParams1 := &FailoverActivityParams{
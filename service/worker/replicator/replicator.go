@@ -23,10 +23,12 @@
 package replicator
 
 import (
+	"sync"
 	"time"
 
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -34,19 +36,26 @@ import (
 	"github.com/uber/cadence/common/metrics"
 )
 
+const (
+	replicatorClusterChangeCallbackKey = "domainReplicator"
+)
+
 type (
 	// Replicator is the processor for replication tasks
 	Replicator struct {
 		clusterMetadata               cluster.Metadata
 		domainReplicationTaskExecutor domain.ReplicationTaskExecutor
 		clientBean                    client.Bean
-		domainProcessors              []*domainReplicationProcessor
 		logger                        log.Logger
 		metricsClient                 metrics.Client
 		hostInfo                      *membership.HostInfo
 		serviceResolver               membership.ServiceResolver
 		domainReplicationQueue        domain.ReplicationQueue
 		replicationMaxRetry           time.Duration
+
+		sync.Mutex
+		started          bool
+		domainProcessors map[string]*domainReplicationProcessor
 	}
 )
 
@@ -74,45 +83,78 @@ func NewReplicator(
 		metricsClient:                 metricsClient,
 		domainReplicationQueue:        domainReplicationQueue,
 		replicationMaxRetry:           replicationMaxRetry,
+		domainProcessors:              make(map[string]*domainReplicationProcessor),
 	}
 }
 
 // Start is called to start replicator
 func (r *Replicator) Start() error {
-	currentClusterName := r.clusterMetadata.GetCurrentClusterName()
+	r.Lock()
+	r.started = true
 	for clusterName, info := range r.clusterMetadata.GetAllClusterInfo() {
-		if !info.Enabled {
-			continue
-		}
-
-		if clusterName != currentClusterName {
-			processor := newDomainReplicationProcessor(
-				clusterName,
-				currentClusterName,
-				r.logger.WithTags(tag.ComponentReplicationTaskProcessor, tag.SourceCluster(clusterName)),
-				r.clientBean.GetRemoteAdminClient(clusterName),
-				r.metricsClient,
-				r.domainReplicationTaskExecutor,
-				r.hostInfo,
-				r.serviceResolver,
-				r.domainReplicationQueue,
-				r.replicationMaxRetry,
-			)
-			r.domainProcessors = append(r.domainProcessors, processor)
-		}
-	}
-
-	for _, domainProcessor := range r.domainProcessors {
-		domainProcessor.Start()
+		r.startDomainProcessorLocked(clusterName, info)
 	}
+	r.Unlock()
 
+	r.clusterMetadata.RegisterChangeCallback(replicatorClusterChangeCallbackKey, r.onClusterMetadataChange)
 	return nil
 }
 
 // Stop is called to stop replicator
 func (r *Replicator) Stop() {
+	r.clusterMetadata.UnregisterChangeCallback(replicatorClusterChangeCallbackKey)
 
-	for _, domainProcessor := range r.domainProcessors {
+	r.Lock()
+	defer r.Unlock()
+
+	r.started = false
+	for clusterName, domainProcessor := range r.domainProcessors {
 		domainProcessor.Stop()
+		delete(r.domainProcessors, clusterName)
 	}
 }
+
+// onClusterMetadataChange stops the domain processors of removed clusters and starts processors for added ones
+func (r *Replicator) onClusterMetadataChange(
+	added map[string]config.ClusterInformation,
+	removed map[string]config.ClusterInformation,
+) {
+
+	r.Lock()
+	defer r.Unlock()
+
+	if !r.started {
+		return
+	}
+	for clusterName := range removed {
+		if domainProcessor, ok := r.domainProcessors[clusterName]; ok {
+			domainProcessor.Stop()
+			delete(r.domainProcessors, clusterName)
+		}
+	}
+	for clusterName, info := range added {
+		r.startDomainProcessorLocked(clusterName, info)
+	}
+}
+
+func (r *Replicator) startDomainProcessorLocked(clusterName string, info config.ClusterInformation) {
+	currentClusterName := r.clusterMetadata.GetCurrentClusterName()
+	if !info.Enabled || clusterName == currentClusterName {
+		return
+	}
+
+	processor := newDomainReplicationProcessor(
+		clusterName,
+		currentClusterName,
+		r.logger.WithTags(tag.ComponentReplicationTaskProcessor, tag.SourceCluster(clusterName)),
+		r.clientBean.GetRemoteAdminClient(clusterName),
+		r.metricsClient,
+		r.domainReplicationTaskExecutor,
+		r.hostInfo,
+		r.serviceResolver,
+		r.domainReplicationQueue,
+		r.replicationMaxRetry,
+	)
+	processor.Start()
+	r.domainProcessors[clusterName] = processor
+}
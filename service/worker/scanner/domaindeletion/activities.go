@@ -0,0 +1,209 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domaindeletion
+
+import (
+	"context"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+// VerifyDomainActivity checks that the domain can be deleted, which requires it to be a deprecated
+// local domain without open workflows, and records on the domain that deletion started
+func VerifyDomainActivity(
+	activityCtx context.Context,
+	domainName string,
+) (*VerifyDomainResult, error) {
+
+	ctx, err := getContext(activityCtx)
+	if err != nil {
+		return nil, err
+	}
+	res := ctx.Resource
+
+	// fail before anything is purged, rather than leave a half deleted domain behind
+	if ctx.Persistence.DefaultStoreType() != config.StoreTypeSQL {
+		return nil, cadence.NewCustomError(ErrTaskListPurgeUnsupported)
+	}
+
+	resp, err := res.GetDomainManager().GetDomain(activityCtx, &persistence.GetDomainRequest{Name: domainName})
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsGlobalDomain {
+		return nil, cadence.NewCustomError(ErrDomainIsGlobal)
+	}
+	if resp.Info.Status != persistence.DomainStatusDeprecated {
+		return nil, cadence.NewCustomError(ErrDomainNotDeprecated)
+	}
+
+	openExecutions, err := res.GetVisibilityManager().ListOpenWorkflowExecutions(activityCtx, &persistence.ListWorkflowExecutionsRequest{
+		DomainUUID:   resp.Info.ID,
+		Domain:       domainName,
+		EarliestTime: 0,
+		LatestTime:   res.GetTimeSource().Now().UnixNano(),
+		PageSize:     1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(openExecutions.Executions) > 0 {
+		return nil, cadence.NewCustomError(ErrDomainHasOpenWorkflows)
+	}
+
+	if err := recordProgress(activityCtx, ctx, domainName, "started"); err != nil {
+		return nil, err
+	}
+
+	res.GetLogger().Info("Domain deletion started",
+		tag.WorkflowDomainName(domainName),
+		tag.WorkflowDomainID(resp.Info.ID),
+	)
+	return &VerifyDomainResult{
+		DomainID:  resp.Info.ID,
+		NumShards: ctx.Persistence.NumHistoryShards,
+	}, nil
+}
+
+// PurgeShardActivity deletes all executions of the domain in a shard, and returns the number of
+// deleted executions
+func PurgeShardActivity(
+	activityCtx context.Context,
+	params PurgeShardParams,
+) (int, error) {
+
+	ctx, err := getContext(activityCtx)
+	if err != nil {
+		return 0, err
+	}
+	res := ctx.Resource
+
+	execManager, err := res.GetExecutionManager(params.ShardID)
+	if err != nil {
+		return 0, err
+	}
+	purger := newShardPurger(
+		persistence.NewPersistenceRetryer(execManager, res.GetHistoryManager(), common.CreatePersistenceRetryPolicy()),
+		res.GetHistoryManager(),
+		res.GetVisibilityManager(),
+		params.DomainID,
+		params.PageSize,
+		func() { activity.RecordHeartbeat(activityCtx) },
+	)
+	purged, err := purger.purge(activityCtx)
+	if err != nil {
+		res.GetLogger().Error("Failed to purge domain executions from shard",
+			tag.WorkflowDomainID(params.DomainID),
+			tag.ShardID(params.ShardID),
+			tag.Error(err),
+		)
+		return 0, err
+	}
+	return purged, nil
+}
+
+// PurgeTaskListsActivity deletes all task lists of the domain, and returns the number of deleted
+// task lists. NoSQL stores do not support listing task lists, so they fail the deletion.
+func PurgeTaskListsActivity(
+	activityCtx context.Context,
+	domainID string,
+) (int, error) {
+
+	ctx, err := getContext(activityCtx)
+	if err != nil {
+		return 0, err
+	}
+	if ctx.Persistence.DefaultStoreType() != config.StoreTypeSQL {
+		return 0, cadence.NewCustomError(ErrTaskListPurgeUnsupported)
+	}
+	return purgeTaskLists(
+		activityCtx,
+		ctx.Resource.GetTaskManager(),
+		domainID,
+		DefaultPageSize,
+		func() { activity.RecordHeartbeat(activityCtx) },
+	)
+}
+
+// UpdateProgressActivity records the progress of domain deletion on the domain data
+func UpdateProgressActivity(
+	activityCtx context.Context,
+	params UpdateProgressParams,
+) error {
+
+	ctx, err := getContext(activityCtx)
+	if err != nil {
+		return err
+	}
+	return recordProgress(activityCtx, ctx, params.DomainName, params.Progress)
+}
+
+// DeleteDomainActivity removes the domain record
+func DeleteDomainActivity(
+	activityCtx context.Context,
+	domainName string,
+) error {
+
+	ctx, err := getContext(activityCtx)
+	if err != nil {
+		return err
+	}
+	res := ctx.Resource
+
+	resp, err := res.GetDomainManager().GetDomain(activityCtx, &persistence.GetDomainRequest{Name: domainName})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return nil
+		}
+		return err
+	}
+	if err := res.GetDomainManager().DeleteDomain(activityCtx, &persistence.DeleteDomainRequest{ID: resp.Info.ID}); err != nil {
+		return err
+	}
+
+	res.GetLogger().Info("Domain deleted",
+		tag.WorkflowDomainName(domainName),
+		tag.WorkflowDomainID(resp.Info.ID),
+	)
+	return nil
+}
+
+// recordProgress goes through the domain handler, so that the change is validated, replicated
+// and recorded in the domain audit log like any other domain update
+func recordProgress(
+	activityCtx context.Context,
+	ctx Context,
+	domainName string,
+	progress string,
+) error {
+	_, err := ctx.DomainHandler.UpdateDomain(activityCtx, &types.UpdateDomainRequest{
+		Name: domainName,
+		Data: map[string]string{common.DomainDataKeyForDeletionProgress: progress},
+	})
+	return err
+}
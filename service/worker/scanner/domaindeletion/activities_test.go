@@ -0,0 +1,106 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package domaindeletion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/types"
+)
+
+type activitiesSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	controller        *gomock.Controller
+	mockDomainHandler *domain.MockHandler
+}
+
+func TestActivitiesSuite(t *testing.T) {
+	suite.Run(t, new(activitiesSuite))
+}
+
+func (s *activitiesSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockDomainHandler = domain.NewMockHandler(s.controller)
+}
+
+func (s *activitiesSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *activitiesSuite) newActivityEnvironment(storeConfig config.DataStore) *testsuite.TestActivityEnvironment {
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: NewContext(context.Background(), Context{
+			Persistence: &config.Persistence{
+				DefaultStore: "default",
+				DataStores:   map[string]config.DataStore{"default": storeConfig},
+			},
+			DomainHandler: s.mockDomainHandler,
+		}),
+	})
+	return env
+}
+
+func (s *activitiesSuite) TestUpdateProgressActivity() {
+	env := s.newActivityEnvironment(config.DataStore{SQL: &config.SQL{}})
+	s.mockDomainHandler.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+		Name: "test-domain",
+		Data: map[string]string{common.DomainDataKeyForDeletionProgress: "deleting domain record"},
+	}).Return(&types.UpdateDomainResponse{}, nil).Times(1)
+
+	_, err := env.ExecuteActivity(updateProgressActivityName, UpdateProgressParams{
+		DomainName: "test-domain",
+		Progress:   "deleting domain record",
+	})
+	s.NoError(err)
+}
+
+func (s *activitiesSuite) TestVerifyDomainActivity_NoSQLStore() {
+	env := s.newActivityEnvironment(config.DataStore{NoSQL: &config.NoSQL{}})
+
+	_, err := env.ExecuteActivity(verifyDomainActivityName, "test-domain")
+	s.assertCustomError(ErrTaskListPurgeUnsupported, err)
+}
+
+func (s *activitiesSuite) TestPurgeTaskListsActivity_NoSQLStore() {
+	env := s.newActivityEnvironment(config.DataStore{NoSQL: &config.NoSQL{}})
+
+	_, err := env.ExecuteActivity(purgeTaskListsActivityName, testDomainID)
+	s.assertCustomError(ErrTaskListPurgeUnsupported, err)
+}
+
+func (s *activitiesSuite) assertCustomError(reason string, err error) {
+	s.Error(err)
+	customErr, ok := err.(*cadence.CustomError)
+	s.True(ok)
+	s.Equal(reason, customErr.Reason())
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package domaindeletion
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination client_mock.go -self_package github.com/uber/cadence/service/worker/scanner/domaindeletion
+
+const (
+	workflowTimeout = 30 * 24 * time.Hour
+	decisionTimeout = 10 * time.Second
+)
+
+type (
+	// Client is used by frontend to start domain deletion workflows in the local system domain
+	Client interface {
+		DeleteDomain(context.Context, *types.DeleteDomainRequest) error
+	}
+
+	clientImpl struct {
+		frontendClient frontend.Client
+	}
+)
+
+var _ Client = (*clientImpl)(nil)
+
+// NewClient creates a new Client
+func NewClient(frontendClient frontend.Client) Client {
+	return &clientImpl{
+		frontendClient: frontendClient,
+	}
+}
+
+// DeleteDomain starts the deletion workflow of the domain. The workflow ID is derived
+// from the domain name, so only one deletion runs for a domain at a time.
+func (c *clientImpl) DeleteDomain(
+	ctx context.Context,
+	request *types.DeleteDomainRequest,
+) error {
+	input, err := json.Marshal(Params{
+		DomainName:  request.GetName(),
+		Concurrency: int(request.GetConcurrency()),
+		PageSize:    int(request.GetPageSize()),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.frontendClient.StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{
+		Domain:                              common.SystemLocalDomainName,
+		WorkflowID:                          WorkflowID(request.GetName()),
+		WorkflowType:                        &types.WorkflowType{Name: WorkflowTypeName},
+		TaskList:                            &types.TaskList{Name: TaskListName},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(workflowTimeout.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(decisionTimeout.Seconds())),
+		RequestID:                           uuid.New(),
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+	})
+	return err
+}
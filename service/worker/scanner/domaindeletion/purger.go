@@ -0,0 +1,195 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domaindeletion
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// shardPurger deletes all executions of a domain from a single shard
+	shardPurger struct {
+		retryer           persistence.Retryer
+		historyManager    persistence.HistoryManager
+		visibilityManager persistence.VisibilityManager
+		domainID          string
+		pageSize          int
+		heartbeat         func()
+	}
+)
+
+func newShardPurger(
+	retryer persistence.Retryer,
+	historyManager persistence.HistoryManager,
+	visibilityManager persistence.VisibilityManager,
+	domainID string,
+	pageSize int,
+	heartbeat func(),
+) *shardPurger {
+	return &shardPurger{
+		retryer:           retryer,
+		historyManager:    historyManager,
+		visibilityManager: visibilityManager,
+		domainID:          domainID,
+		pageSize:          pageSize,
+		heartbeat:         heartbeat,
+	}
+}
+
+// purge scans all concrete executions of the shard and deletes the ones that belong to the domain
+// together with their history branches, current execution and visibility records
+func (p *shardPurger) purge(
+	ctx context.Context,
+) (int, error) {
+
+	purged := 0
+	var pageToken []byte
+	for {
+		resp, err := p.retryer.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			PageSize:  p.pageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return purged, err
+		}
+		for _, execution := range resp.Executions {
+			if execution.ExecutionInfo == nil || execution.ExecutionInfo.DomainID != p.domainID {
+				continue
+			}
+			if err := p.purgeExecution(ctx, execution); err != nil {
+				return purged, err
+			}
+			purged++
+		}
+		p.heartbeat()
+
+		if len(resp.PageToken) == 0 {
+			return purged, nil
+		}
+		pageToken = resp.PageToken
+	}
+}
+
+func (p *shardPurger) purgeExecution(
+	ctx context.Context,
+	execution *persistence.ListConcreteExecutionsEntity,
+) error {
+
+	info := execution.ExecutionInfo
+	shardID := p.retryer.GetShardID()
+	for _, branchToken := range getBranchTokens(execution) {
+		if err := p.historyManager.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+			BranchToken: branchToken,
+			ShardID:     common.IntPtr(shardID),
+		}); err != nil && !isNotExistsError(err) {
+			return err
+		}
+	}
+
+	if err := p.visibilityManager.DeleteWorkflowExecution(ctx, &persistence.VisibilityDeleteWorkflowExecutionRequest{
+		DomainID:   info.DomainID,
+		WorkflowID: info.WorkflowID,
+		RunID:      info.RunID,
+	}); err != nil && !isNotExistsError(err) {
+		return err
+	}
+
+	if err := p.retryer.DeleteCurrentWorkflowExecution(ctx, &persistence.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   info.DomainID,
+		WorkflowID: info.WorkflowID,
+		RunID:      info.RunID,
+	}); err != nil && !isNotExistsError(err) {
+		return err
+	}
+	// the concrete execution goes last, so that a retried purge still finds the execution
+	return p.retryer.DeleteWorkflowExecution(ctx, &persistence.DeleteWorkflowExecutionRequest{
+		DomainID:   info.DomainID,
+		WorkflowID: info.WorkflowID,
+		RunID:      info.RunID,
+	})
+}
+
+// purgeTaskLists deletes all task lists of the domain, and returns the number of deleted task lists
+func purgeTaskLists(
+	ctx context.Context,
+	taskManager persistence.TaskManager,
+	domainID string,
+	pageSize int,
+	heartbeat func(),
+) (int, error) {
+
+	purged := 0
+	var pageToken []byte
+	for {
+		resp, err := taskManager.ListTaskList(ctx, &persistence.ListTaskListRequest{
+			PageSize:  pageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return purged, err
+		}
+		for _, taskList := range resp.Items {
+			if taskList.DomainID != domainID {
+				continue
+			}
+			if err := taskManager.DeleteTaskList(ctx, &persistence.DeleteTaskListRequest{
+				DomainID:     taskList.DomainID,
+				TaskListName: taskList.Name,
+				TaskListType: taskList.TaskType,
+				RangeID:      taskList.RangeID,
+			}); err != nil && !isNotExistsError(err) {
+				return purged, err
+			}
+			purged++
+		}
+		heartbeat()
+
+		if len(resp.NextPageToken) == 0 {
+			return purged, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func getBranchTokens(
+	execution *persistence.ListConcreteExecutionsEntity,
+) [][]byte {
+	if execution.VersionHistories == nil || len(execution.VersionHistories.Histories) == 0 {
+		if len(execution.ExecutionInfo.BranchToken) == 0 {
+			return nil
+		}
+		return [][]byte{execution.ExecutionInfo.BranchToken}
+	}
+	var branchTokens [][]byte
+	for _, history := range execution.VersionHistories.Histories {
+		branchTokens = append(branchTokens, history.GetBranchToken())
+	}
+	return branchTokens
+}
+
+func isNotExistsError(err error) bool {
+	_, ok := err.(*types.EntityNotExistsError)
+	return ok
+}
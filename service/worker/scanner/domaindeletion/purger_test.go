@@ -0,0 +1,144 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domaindeletion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	testDomainID      = "deleted-domain-id"
+	testOtherDomainID = "other-domain-id"
+	testShardID       = 3
+)
+
+type purgerSuite struct {
+	suite.Suite
+
+	execManager       *mocks.ExecutionManager
+	historyManager    *mocks.HistoryV2Manager
+	visibilityManager *mocks.VisibilityManager
+	taskManager       *mocks.TaskManager
+}
+
+func TestPurgerSuite(t *testing.T) {
+	suite.Run(t, new(purgerSuite))
+}
+
+func (s *purgerSuite) SetupTest() {
+	s.execManager = &mocks.ExecutionManager{}
+	s.historyManager = &mocks.HistoryV2Manager{}
+	s.visibilityManager = &mocks.VisibilityManager{}
+	s.taskManager = &mocks.TaskManager{}
+	s.execManager.On("GetShardID").Return(testShardID).Maybe()
+}
+
+func (s *purgerSuite) TearDownTest() {
+	s.execManager.AssertExpectations(s.T())
+	s.historyManager.AssertExpectations(s.T())
+	s.visibilityManager.AssertExpectations(s.T())
+	s.taskManager.AssertExpectations(s.T())
+}
+
+func (s *purgerSuite) TestPurgeShard() {
+	branchToken := []byte("branch-token")
+	s.execManager.On("ListConcreteExecutions", mock.Anything, &persistence.ListConcreteExecutionsRequest{PageSize: 10}).Return(&persistence.ListConcreteExecutionsResponse{
+		Executions: []*persistence.ListConcreteExecutionsEntity{
+			{
+				ExecutionInfo: &persistence.WorkflowExecutionInfo{DomainID: testDomainID, WorkflowID: "wid", RunID: "rid", BranchToken: branchToken},
+			},
+			{
+				ExecutionInfo: &persistence.WorkflowExecutionInfo{DomainID: testOtherDomainID, WorkflowID: "wid", RunID: "other-rid"},
+			},
+		},
+		PageToken: []byte("next"),
+	}, nil).Once()
+	s.execManager.On("ListConcreteExecutions", mock.Anything, &persistence.ListConcreteExecutionsRequest{PageSize: 10, PageToken: []byte("next")}).Return(&persistence.ListConcreteExecutionsResponse{
+		Executions: []*persistence.ListConcreteExecutionsEntity{
+			{
+				ExecutionInfo: &persistence.WorkflowExecutionInfo{DomainID: testDomainID, WorkflowID: "wid2", RunID: "rid2"},
+				VersionHistories: &persistence.VersionHistories{
+					Histories: []*persistence.VersionHistory{
+						persistence.NewVersionHistory([]byte("branch-1"), nil),
+						persistence.NewVersionHistory([]byte("branch-2"), nil),
+					},
+				},
+			},
+		},
+	}, nil).Once()
+
+	for _, token := range [][]byte{branchToken, []byte("branch-1"), []byte("branch-2")} {
+		s.historyManager.On("DeleteHistoryBranch", mock.Anything, &persistence.DeleteHistoryBranchRequest{
+			BranchToken: token,
+			ShardID:     common.IntPtr(testShardID),
+		}).Return(nil).Once()
+	}
+	s.visibilityManager.On("DeleteWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.VisibilityDeleteWorkflowExecutionRequest) bool {
+		return request.DomainID == testDomainID
+	})).Return(nil).Twice()
+	s.execManager.On("DeleteCurrentWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.DeleteCurrentWorkflowExecutionRequest) bool {
+		return request.DomainID == testDomainID
+	})).Return(nil).Twice()
+	s.execManager.On("DeleteWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.DeleteWorkflowExecutionRequest) bool {
+		return request.DomainID == testDomainID
+	})).Return(nil).Twice()
+
+	heartbeats := 0
+	purger := newShardPurger(
+		persistence.NewPersistenceRetryer(s.execManager, s.historyManager, common.CreatePersistenceRetryPolicy()),
+		s.historyManager,
+		s.visibilityManager,
+		testDomainID,
+		10,
+		func() { heartbeats++ },
+	)
+	purged, err := purger.purge(context.Background())
+	s.NoError(err)
+	s.Equal(2, purged)
+	s.Equal(2, heartbeats)
+}
+
+func (s *purgerSuite) TestPurgeTaskLists() {
+	s.taskManager.On("ListTaskList", mock.Anything, &persistence.ListTaskListRequest{PageSize: 10}).Return(&persistence.ListTaskListResponse{
+		Items: []persistence.TaskListInfo{
+			{DomainID: testDomainID, Name: "tl", TaskType: persistence.TaskListTypeDecision, RangeID: 5},
+			{DomainID: testOtherDomainID, Name: "tl", TaskType: persistence.TaskListTypeDecision, RangeID: 7},
+		},
+	}, nil).Once()
+	s.taskManager.On("DeleteTaskList", mock.Anything, &persistence.DeleteTaskListRequest{
+		DomainID:     testDomainID,
+		TaskListName: "tl",
+		TaskListType: persistence.TaskListTypeDecision,
+		RangeID:      5,
+	}).Return(nil).Once()
+
+	purged, err := purgeTaskLists(context.Background(), s.taskManager, testDomainID, 10, func() {})
+	s.NoError(err)
+	s.Equal(1, purged)
+}
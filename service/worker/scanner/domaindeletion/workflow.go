@@ -0,0 +1,253 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domaindeletion
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/resource"
+)
+
+const (
+	// WorkflowTypeName is the workflow type name of domain deletion workflow
+	WorkflowTypeName = "cadence-sys-domain-deletion-workflow"
+	// TaskListName is the task list of domain deletion workflow
+	TaskListName = "cadence-sys-domain-deletion-tasklist-0"
+	// WorkflowIDPrefix is the prefix of domain deletion workflow ID, the domain name is appended
+	// so that only one deletion can run for a domain at a time
+	WorkflowIDPrefix = "cadence-sys-domain-deletion-"
+
+	verifyDomainActivityName   = "cadence-sys-domain-deletion-verify-activity"
+	purgeShardActivityName     = "cadence-sys-domain-deletion-purge-shard-activity"
+	purgeTaskListsActivityName = "cadence-sys-domain-deletion-purge-tasklists-activity"
+	updateProgressActivityName = "cadence-sys-domain-deletion-progress-activity"
+	deleteDomainActivityName   = "cadence-sys-domain-deletion-delete-activity"
+
+	// DefaultConcurrency is the default number of shards purged in parallel
+	DefaultConcurrency = 10
+	// DefaultPageSize is the default page size used when scanning a shard
+	DefaultPageSize = 500
+
+	// ErrDomainIsGlobal indicates deletion was requested for a global domain
+	ErrDomainIsGlobal = "global domains cannot be deleted"
+	// ErrDomainNotDeprecated indicates deletion was requested for a domain which is not deprecated
+	ErrDomainNotDeprecated = "domain must be deprecated before it can be deleted"
+	// ErrDomainHasOpenWorkflows indicates deletion was requested for a domain with open workflows
+	ErrDomainHasOpenWorkflows = "domain has open workflows"
+	// ErrTaskListPurgeUnsupported indicates the task lists of the domain cannot be listed by the store
+	ErrTaskListPurgeUnsupported = "domain deletion is only supported by SQL stores, which can list task lists"
+
+	infiniteDuration = 20 * 365 * 24 * time.Hour
+)
+
+type (
+	contextKey string
+
+	// Context is the resource that is available in activities under domain deletion context key
+	Context struct {
+		Resource resource.Resource
+		// Persistence contains the persistence configuration
+		Persistence *config.Persistence
+		// DomainHandler applies the progress updates to the domain
+		DomainHandler domain.Handler
+	}
+
+	// Params is the arg for domain deletion workflow
+	Params struct {
+		// DomainName is the name of the domain to delete
+		DomainName string
+		// Concurrency is the number of shards purged in parallel
+		Concurrency int
+		// PageSize is the page size used when scanning a shard
+		PageSize int
+	}
+
+	// Result is the result of domain deletion workflow
+	Result struct {
+		DomainID         string
+		PurgedExecutions int
+		PurgedTaskLists  int
+		PurgedShards     int
+		TotalShards      int
+		DomainDeleted    bool
+	}
+
+	// VerifyDomainResult is the result of verify domain activity
+	VerifyDomainResult struct {
+		DomainID  string
+		NumShards int
+	}
+
+	// PurgeShardParams is the arg for purge shard activity
+	PurgeShardParams struct {
+		DomainID string
+		ShardID  int
+		PageSize int
+	}
+
+	// UpdateProgressParams is the arg for update progress activity
+	UpdateProgressParams struct {
+		DomainName string
+		Progress   string
+	}
+)
+
+var (
+	activityRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:          10 * time.Second,
+		BackoffCoefficient:       1.7,
+		MaximumInterval:          5 * time.Minute,
+		ExpirationInterval:       infiniteDuration,
+		NonRetriableErrorReasons: []string{ErrDomainIsGlobal, ErrDomainNotDeprecated, ErrDomainHasOpenWorkflows, ErrTaskListPurgeUnsupported},
+	}
+	activityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    infiniteDuration,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy:            &activityRetryPolicy,
+	}
+)
+
+func init() {
+	activity.RegisterWithOptions(VerifyDomainActivity, activity.RegisterOptions{Name: verifyDomainActivityName})
+	activity.RegisterWithOptions(PurgeShardActivity, activity.RegisterOptions{Name: purgeShardActivityName})
+	activity.RegisterWithOptions(PurgeTaskListsActivity, activity.RegisterOptions{Name: purgeTaskListsActivityName})
+	activity.RegisterWithOptions(UpdateProgressActivity, activity.RegisterOptions{Name: updateProgressActivityName})
+	activity.RegisterWithOptions(DeleteDomainActivity, activity.RegisterOptions{Name: deleteDomainActivityName})
+}
+
+// WorkflowID returns the ID of the deletion workflow of the given domain
+func WorkflowID(domainName string) string {
+	return WorkflowIDPrefix + domainName
+}
+
+// Workflow deletes a deprecated domain along with all its data. The domain is verified to have
+// no open workflows, then executions, history and visibility records are purged
+// shard by shard, followed by task lists, and finally the domain record is removed.
+// Progress is recorded on the domain data so that it is visible through DescribeDomain.
+func Workflow(
+	ctx workflow.Context,
+	params Params,
+) (*Result, error) {
+
+	if params.Concurrency <= 0 {
+		params.Concurrency = DefaultConcurrency
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = DefaultPageSize
+	}
+	ctx = workflow.WithActivityOptions(ctx, activityOptions)
+
+	var domain VerifyDomainResult
+	if err := workflow.ExecuteActivity(ctx, verifyDomainActivityName, params.DomainName).Get(ctx, &domain); err != nil {
+		return nil, err
+	}
+	result := &Result{
+		DomainID:    domain.DomainID,
+		TotalShards: domain.NumShards,
+	}
+
+	for start := 0; start < domain.NumShards; start += params.Concurrency {
+		end := start + params.Concurrency
+		if end > domain.NumShards {
+			end = domain.NumShards
+		}
+		var futures []workflow.Future
+		for shardID := start; shardID < end; shardID++ {
+			futures = append(futures, workflow.ExecuteActivity(ctx, purgeShardActivityName, PurgeShardParams{
+				DomainID: domain.DomainID,
+				ShardID:  shardID,
+				PageSize: params.PageSize,
+			}))
+		}
+		for _, future := range futures {
+			var purged int
+			if err := future.Get(ctx, &purged); err != nil {
+				return nil, err
+			}
+			result.PurgedExecutions += purged
+		}
+		result.PurgedShards = end
+
+		if err := updateProgress(ctx, params.DomainName, fmt.Sprintf(
+			"purging executions: %v/%v shards", result.PurgedShards, result.TotalShards,
+		)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := workflow.ExecuteActivity(ctx, purgeTaskListsActivityName, domain.DomainID).Get(ctx, &result.PurgedTaskLists); err != nil {
+		return nil, err
+	}
+	if err := updateProgress(ctx, params.DomainName, "deleting domain record"); err != nil {
+		return nil, err
+	}
+
+	if err := workflow.ExecuteActivity(ctx, deleteDomainActivityName, params.DomainName).Get(ctx, nil); err != nil {
+		return nil, err
+	}
+	result.DomainDeleted = true
+
+	workflow.GetLogger(ctx).Info(fmt.Sprintf(
+		"domain %v deleted, %v executions and %v task lists purged",
+		params.DomainName, result.PurgedExecutions, result.PurgedTaskLists,
+	))
+	return result, nil
+}
+
+func updateProgress(
+	ctx workflow.Context,
+	domainName string,
+	progress string,
+) error {
+	return workflow.ExecuteActivity(ctx, updateProgressActivityName, UpdateProgressParams{
+		DomainName: domainName,
+		Progress:   progress,
+	}).Get(ctx, nil)
+}
+
+// NewContext provides context to be used as background activity context
+// it uses typed, private key to reduce access scope
+func NewContext(
+	ctx context.Context,
+	deletionContext Context,
+) context.Context {
+	return context.WithValue(ctx, contextKey(WorkflowTypeName), deletionContext)
+}
+
+// getContext extracts domain deletion context from activity context
+func getContext(
+	ctx context.Context,
+) (Context, error) {
+	val, ok := ctx.Value(contextKey(WorkflowTypeName)).(Context)
+	if !ok {
+		return Context{}, fmt.Errorf("context type is not %T for a key %q", val, WorkflowTypeName)
+	}
+	return val, nil
+}
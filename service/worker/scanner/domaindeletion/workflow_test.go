@@ -0,0 +1,109 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domaindeletion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/workflow"
+)
+
+type workflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	workflowEnv *testsuite.TestWorkflowEnvironment
+}
+
+func TestWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(workflowTestSuite))
+}
+
+func (s *workflowTestSuite) SetupTest() {
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(Workflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+}
+
+func (s *workflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *workflowTestSuite) TestWorkflow() {
+	s.workflowEnv.OnActivity(verifyDomainActivityName, mock.Anything, "test-domain").Return(&VerifyDomainResult{
+		DomainID:  testDomainID,
+		NumShards: 3,
+	}, nil).Once()
+	for shardID := 0; shardID < 3; shardID++ {
+		s.workflowEnv.OnActivity(purgeShardActivityName, mock.Anything, PurgeShardParams{
+			DomainID: testDomainID,
+			ShardID:  shardID,
+			PageSize: DefaultPageSize,
+		}).Return(shardID+1, nil).Once()
+	}
+	s.workflowEnv.OnActivity(updateProgressActivityName, mock.Anything, UpdateProgressParams{
+		DomainName: "test-domain",
+		Progress:   "purging executions: 2/3 shards",
+	}).Return(nil).Once()
+	s.workflowEnv.OnActivity(updateProgressActivityName, mock.Anything, UpdateProgressParams{
+		DomainName: "test-domain",
+		Progress:   "purging executions: 3/3 shards",
+	}).Return(nil).Once()
+	s.workflowEnv.OnActivity(purgeTaskListsActivityName, mock.Anything, testDomainID).Return(4, nil).Once()
+	s.workflowEnv.OnActivity(updateProgressActivityName, mock.Anything, UpdateProgressParams{
+		DomainName: "test-domain",
+		Progress:   "deleting domain record",
+	}).Return(nil).Once()
+	s.workflowEnv.OnActivity(deleteDomainActivityName, mock.Anything, "test-domain").Return(nil).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, Params{
+		DomainName:  "test-domain",
+		Concurrency: 2,
+	})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+
+	var result Result
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal(Result{
+		DomainID:         testDomainID,
+		PurgedExecutions: 6,
+		PurgedTaskLists:  4,
+		PurgedShards:     3,
+		TotalShards:      3,
+		DomainDeleted:    true,
+	}, result)
+}
+
+func (s *workflowTestSuite) TestWorkflow_OpenWorkflows() {
+	s.workflowEnv.OnActivity(verifyDomainActivityName, mock.Anything, "test-domain").
+		Return(nil, cadence.NewCustomError(ErrDomainHasOpenWorkflows)).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, Params{DomainName: "test-domain"})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	err := s.workflowEnv.GetWorkflowError()
+	s.Error(err)
+	customErr, ok := err.(*cadence.CustomError)
+	s.True(ok)
+	s.Equal(ErrDomainHasOpenWorkflows, customErr.Reason())
+}
@@ -37,9 +37,11 @@ import (
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/service/worker/scanner/domaindeletion"
 	"github.com/uber/cadence/service/worker/scanner/shardscanner"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 )
@@ -67,6 +69,10 @@ type (
 		ClusterMetadata cluster.Metadata
 		// HistoryScannerEnabled indicates if history scanner should be started as part of scanner
 		HistoryScannerEnabled dynamicconfig.BoolPropertyFn
+		// DomainDeletionEnabled indicates if domain deletion workflows should be processed as part of scanner
+		DomainDeletionEnabled dynamicconfig.BoolPropertyFn
+		// DomainConfig contains the config of the domain handler used by domain deletion
+		DomainConfig domain.Config
 		// ShardScanners is a list of shard scanner configs
 		ShardScanners              []*shardscanner.ScannerConfig
 		MaxWorkflowRetentionInDays dynamicconfig.IntPropertyFn
@@ -150,6 +156,27 @@ func (s *Scanner) Start() error {
 			historyScannerWFTypeName)
 		workerTaskListNames = append(workerTaskListNames, historyScannerTaskListName)
 	}
+	if s.context.cfg.DomainDeletionEnabled() {
+		// domain deletion workflows are started on demand, only the worker is needed here
+		res := s.context.resource
+		ctx = domaindeletion.NewContext(ctx, domaindeletion.Context{
+			Resource:    res,
+			Persistence: s.context.cfg.Persistence,
+			DomainHandler: domain.NewHandler(
+				s.context.cfg.DomainConfig,
+				res.GetLogger(),
+				res.GetMetricsClient(),
+				res.GetDomainManager(),
+				res.GetClusterMetadata(),
+				domain.NewDomainReplicator(res.GetDomainReplicationQueue(), res.GetLogger()),
+				res.GetDomainAuditLog(),
+				res.GetArchivalMetadata(),
+				res.GetArchiverProvider(),
+				res.GetTimeSource(),
+			),
+		})
+		workerTaskListNames = append(workerTaskListNames, domaindeletion.TaskListName)
+	}
 
 	workerOpts := worker.Options{
 		Logger:                                 s.zapLogger,
@@ -0,0 +1,76 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// StartWorkflowActivity starts a workflow run of a schedule. It returns a nil execution
+// if a run with the same workflow ID was already started.
+func StartWorkflowActivity(ctx context.Context, request *types.StartWorkflowExecutionRequest) (*types.WorkflowExecution, error) {
+	scheduler := ctx.Value(schedulerContextKey).(*Scheduler)
+	resp, err := scheduler.frontendClient.StartWorkflowExecution(ctx, request)
+	if err != nil {
+		if _, ok := err.(*types.WorkflowExecutionAlreadyStartedError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &types.WorkflowExecution{
+		WorkflowID: request.GetWorkflowID(),
+		RunID:      resp.GetRunID(),
+	}, nil
+}
+
+// DescribeWorkflowActivity returns whether a workflow run of a schedule is still running
+func DescribeWorkflowActivity(ctx context.Context, domain string, execution types.WorkflowExecution) (bool, error) {
+	scheduler := ctx.Value(schedulerContextKey).(*Scheduler)
+	resp, err := scheduler.frontendClient.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    domain,
+		Execution: &execution,
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return false, nil
+		}
+		return false, err
+	}
+	info := resp.GetWorkflowExecutionInfo()
+	return info != nil && info.CloseStatus == nil, nil
+}
+
+// CancelWorkflowActivity requests cancellation of a workflow run of a schedule
+func CancelWorkflowActivity(ctx context.Context, domain string, execution types.WorkflowExecution) error {
+	scheduler := ctx.Value(schedulerContextKey).(*Scheduler)
+	err := scheduler.frontendClient.RequestCancelWorkflowExecution(ctx, &types.RequestCancelWorkflowExecutionRequest{
+		Domain:            domain,
+		WorkflowExecution: &execution,
+		Identity:          WorkflowTypeName,
+	})
+	switch err.(type) {
+	case *types.EntityNotExistsError, *types.CancellationAlreadyRequestedError, *types.WorkflowExecutionAlreadyCompletedError:
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination client_mock.go -self_package github.com/uber/cadence/service/worker/scheduler
+
+type (
+	// Client is used by frontend to manage schedules. Every schedule is driven by a
+	// schedule workflow running in the local system domain.
+	Client interface {
+		CreateSchedule(context.Context, *types.CreateScheduleRequest) error
+		DescribeSchedule(context.Context, *types.DescribeScheduleRequest) (*types.DescribeScheduleResponse, error)
+		UpdateSchedule(context.Context, *types.UpdateScheduleRequest) error
+		PauseSchedule(context.Context, *types.PauseScheduleRequest) error
+		UnpauseSchedule(context.Context, *types.UnpauseScheduleRequest) error
+		BackfillSchedule(context.Context, *types.BackfillScheduleRequest) error
+		ListSchedules(context.Context, *types.ListSchedulesRequest) (*types.ListSchedulesResponse, error)
+	}
+
+	clientImpl struct {
+		frontendClient frontend.Client
+	}
+)
+
+var _ Client = (*clientImpl)(nil)
+
+// NewClient creates a new Client
+func NewClient(frontendClient frontend.Client) Client {
+	return &clientImpl{
+		frontendClient: frontendClient,
+	}
+}
+
+func (c *clientImpl) CreateSchedule(
+	ctx context.Context,
+	request *types.CreateScheduleRequest,
+) error {
+	params := Params{
+		Domain:     request.GetDomain(),
+		ScheduleID: request.GetScheduleID(),
+	}
+	if request.Spec != nil {
+		params.Spec = *request.Spec
+	}
+	if request.Action != nil {
+		params.Action = *request.Action
+	}
+	if request.Policies != nil {
+		params.Policies = *request.Policies
+	}
+	input, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.frontendClient.StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{
+		Domain:                              common.SystemLocalDomainName,
+		WorkflowID:                          workflowID(request.GetDomain(), request.GetScheduleID()),
+		WorkflowType:                        &types.WorkflowType{Name: WorkflowTypeName},
+		TaskList:                            &types.TaskList{Name: TaskListName},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(infiniteDuration.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(decisionTimeout.Seconds())),
+		Identity:                            request.GetIdentity(),
+		RequestID:                           request.GetRequestID(),
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+	})
+	return err
+}
+
+func (c *clientImpl) DescribeSchedule(
+	ctx context.Context,
+	request *types.DescribeScheduleRequest,
+) (*types.DescribeScheduleResponse, error) {
+	resp, err := c.frontendClient.QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+		Domain: common.SystemLocalDomainName,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID(request.GetDomain(), request.GetScheduleID()),
+		},
+		Query: &types.WorkflowQuery{
+			QueryType: describeQueryType,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result types.DescribeScheduleResponse
+	if err := json.Unmarshal(resp.GetQueryResult(), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *clientImpl) UpdateSchedule(
+	ctx context.Context,
+	request *types.UpdateScheduleRequest,
+) error {
+	return c.signal(ctx, request.GetDomain(), request.GetScheduleID(), updateSignalName, request, request.GetIdentity(), request.GetRequestID())
+}
+
+func (c *clientImpl) PauseSchedule(
+	ctx context.Context,
+	request *types.PauseScheduleRequest,
+) error {
+	return c.signal(ctx, request.GetDomain(), request.GetScheduleID(), pauseSignalName, request, request.GetIdentity(), request.GetRequestID())
+}
+
+func (c *clientImpl) UnpauseSchedule(
+	ctx context.Context,
+	request *types.UnpauseScheduleRequest,
+) error {
+	return c.signal(ctx, request.GetDomain(), request.GetScheduleID(), unpauseSignalName, request, request.GetIdentity(), request.GetRequestID())
+}
+
+func (c *clientImpl) BackfillSchedule(
+	ctx context.Context,
+	request *types.BackfillScheduleRequest,
+) error {
+	return c.signal(ctx, request.GetDomain(), request.GetScheduleID(), backfillSignalName, request, request.GetIdentity(), request.GetRequestID())
+}
+
+// ListSchedules lists the schedule workflows of the requested domain. Schedule workflows of all
+// domains share the system domain, so a page may contain fewer entries than the page size.
+func (c *clientImpl) ListSchedules(
+	ctx context.Context,
+	request *types.ListSchedulesRequest,
+) (*types.ListSchedulesResponse, error) {
+	resp, err := c.frontendClient.ListOpenWorkflowExecutions(ctx, &types.ListOpenWorkflowExecutionsRequest{
+		Domain:          common.SystemLocalDomainName,
+		MaximumPageSize: request.GetPageSize(),
+		NextPageToken:   request.NextPageToken,
+		StartTimeFilter: &types.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(0),
+			LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+		},
+		TypeFilter: &types.WorkflowTypeFilter{
+			Name: WorkflowTypeName,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := workflowID(request.GetDomain(), "")
+	schedules := []*types.ScheduleListEntry{}
+	for _, info := range resp.GetExecutions() {
+		id := info.GetExecution().GetWorkflowID()
+		if strings.HasPrefix(id, prefix) {
+			schedules = append(schedules, &types.ScheduleListEntry{
+				ScheduleID: strings.TrimPrefix(id, prefix),
+			})
+		}
+	}
+	return &types.ListSchedulesResponse{
+		Schedules:     schedules,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+func (c *clientImpl) signal(
+	ctx context.Context,
+	domain string,
+	scheduleID string,
+	signalName string,
+	payload interface{},
+	identity string,
+	requestID string,
+) error {
+	input, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return c.frontendClient.SignalWorkflowExecution(ctx, &types.SignalWorkflowExecutionRequest{
+		Domain: common.SystemLocalDomainName,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: workflowID(domain, scheduleID),
+		},
+		SignalName: signalName,
+		Input:      input,
+		Identity:   identity,
+		RequestID:  requestID,
+	})
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the scheduler sub-system
+	BootstrapParams struct {
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// FrontendClient is an instance of frontend.Client for talking to frontend service
+		FrontendClient frontend.Client
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+	}
+
+	// Scheduler is the background sub-system that runs the workflows driving schedules
+	Scheduler struct {
+		svcClient      workflowserviceclient.Interface
+		frontendClient frontend.Client
+		metricsClient  metrics.Client
+		tallyScope     tally.Scope
+		logger         log.Logger
+		worker         worker.Worker
+	}
+)
+
+// New returns a new instance of Scheduler
+func New(params *BootstrapParams) *Scheduler {
+	return &Scheduler{
+		svcClient:      params.ServiceClient,
+		frontendClient: params.FrontendClient,
+		metricsClient:  params.MetricsClient,
+		tallyScope:     params.TallyScope,
+		logger:         params.Logger.WithTags(tag.ComponentScheduler),
+	}
+}
+
+// Start starts the worker
+func (s *Scheduler) Start() error {
+	ctx := context.WithValue(context.Background(), schedulerContextKey, s)
+	workerOpts := worker.Options{
+		MetricsScope:              s.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	schedulerWorker := worker.New(s.svcClient, common.SystemLocalDomainName, TaskListName, workerOpts)
+	schedulerWorker.RegisterWorkflowWithOptions(ScheduleWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	schedulerWorker.RegisterActivityWithOptions(StartWorkflowActivity, activity.RegisterOptions{Name: startWorkflowActivityName})
+	schedulerWorker.RegisterActivityWithOptions(DescribeWorkflowActivity, activity.RegisterOptions{Name: describeWorkflowActivityName})
+	schedulerWorker.RegisterActivityWithOptions(CancelWorkflowActivity, activity.RegisterOptions{Name: cancelWorkflowActivityName})
+	s.worker = schedulerWorker
+	return schedulerWorker.Start()
+}
+
+// Stop stops the worker
+func (s *Scheduler) Stop() {
+	s.worker.Stop()
+}
@@ -0,0 +1,380 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robfig/cron"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	contextKey string
+)
+
+const (
+	schedulerContextKey contextKey = "schedulerContext"
+	// TaskListName is the tasklist of schedule workflows
+	TaskListName = "cadence-sys-scheduler-tasklist"
+	// WorkflowTypeName is the workflow type of schedule workflows
+	WorkflowTypeName = "cadence-sys-scheduler-workflow"
+
+	startWorkflowActivityName    = "cadence-sys-scheduler-start-workflow-activity"
+	describeWorkflowActivityName = "cadence-sys-scheduler-describe-workflow-activity"
+	cancelWorkflowActivityName   = "cadence-sys-scheduler-cancel-workflow-activity"
+
+	updateSignalName   = "cadence-sys-scheduler-update"
+	pauseSignalName    = "cadence-sys-scheduler-pause"
+	unpauseSignalName  = "cadence-sys-scheduler-unpause"
+	backfillSignalName = "cadence-sys-scheduler-backfill"
+	describeQueryType  = "cadence-sys-scheduler-describe"
+
+	workflowIDPrefix = "cadence-sys-schedule"
+	infiniteDuration = 20 * 365 * 24 * time.Hour
+	decisionTimeout  = time.Minute
+
+	// bufferCheckInterval is how often a schedule with buffered runs checks whether the previous run has closed
+	bufferCheckInterval = time.Minute
+	// maxActionsPerRun bounds the history size of a schedule workflow before it continues as new
+	maxActionsPerRun = 500
+	// maxBackfillRuns is the maximum number of runs a single backfill request will process
+	maxBackfillRuns = 500
+)
+
+type (
+	// Params is the input of the schedule workflow. It carries the complete schedule
+	// state so that the workflow can continue as new without losing anything.
+	Params struct {
+		Domain     string
+		ScheduleID string
+		Spec       types.ScheduleSpec
+		Action     types.ScheduleAction
+		Policies   types.SchedulePolicies
+		State      types.ScheduleState
+		Info       types.ScheduleInfo
+		// BufferedRuns are the nominal times of runs deferred by the buffer overlap policy
+		BufferedRuns []time.Time
+		// LastProcessedTime is the latest nominal time processed by the schedule
+		LastProcessedTime time.Time
+	}
+
+	scheduler struct {
+		ctx     workflow.Context
+		params  Params
+		logger  *zap.Logger
+		actions int
+
+		updateCh   workflow.Channel
+		pauseCh    workflow.Channel
+		unpauseCh  workflow.Channel
+		backfillCh workflow.Channel
+	}
+)
+
+var (
+	activityRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2,
+		MaximumInterval:    time.Minute,
+		ExpirationInterval: 10 * time.Minute,
+	}
+
+	activityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            &activityRetryPolicy,
+	}
+)
+
+// ScheduleWorkflow is the workflow that drives a single schedule. It sleeps until the
+// next run time of the schedule spec, applies the overlap policy and starts the workflow
+// described by the schedule action, while serving update, pause, unpause and backfill signals.
+func ScheduleWorkflow(ctx workflow.Context, params Params) error {
+	s := &scheduler{
+		ctx:        workflow.WithActivityOptions(ctx, activityOptions),
+		params:     params,
+		logger:     workflow.GetLogger(ctx).With(zap.String("schedule-id", params.ScheduleID)),
+		updateCh:   workflow.GetSignalChannel(ctx, updateSignalName),
+		pauseCh:    workflow.GetSignalChannel(ctx, pauseSignalName),
+		unpauseCh:  workflow.GetSignalChannel(ctx, unpauseSignalName),
+		backfillCh: workflow.GetSignalChannel(ctx, backfillSignalName),
+	}
+	if err := workflow.SetQueryHandler(ctx, describeQueryType, s.describe); err != nil {
+		return err
+	}
+
+	for s.actions < maxActionsPerRun && ctx.Err() == nil {
+		s.processBufferedRuns()
+		s.waitAndProcess()
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// signals received after the last select would be lost on continue as new
+	s.drainSignals()
+	return workflow.NewContinueAsNewError(ctx, WorkflowTypeName, s.params)
+}
+
+func (s *scheduler) waitAndProcess() {
+	now := workflow.Now(s.ctx)
+	after := now
+	if s.params.LastProcessedTime.After(after) {
+		after = s.params.LastProcessedTime
+	}
+	nextRunTime := getNextRunTime(s.params.Spec, after)
+
+	timerCtx, cancelTimer := workflow.WithCancel(s.ctx)
+	defer cancelTimer()
+
+	fired := false
+	selector := workflow.NewSelector(s.ctx)
+	if nextRunTime.IsZero() {
+		s.params.Info.NextRunTimestamp = nil
+	} else {
+		s.params.Info.NextRunTimestamp = common.Int64Ptr(nextRunTime.UnixNano())
+		selector.AddFuture(workflow.NewTimer(timerCtx, nextRunTime.Sub(now)), func(f workflow.Future) {
+			fired = f.Get(s.ctx, nil) == nil
+		})
+	}
+	if len(s.params.BufferedRuns) > 0 {
+		selector.AddFuture(workflow.NewTimer(timerCtx, bufferCheckInterval), func(f workflow.Future) {})
+	}
+
+	var backfillRequests []*types.BackfillScheduleRequest
+	selector.AddReceive(s.ctx.Done(), func(c workflow.Channel, more bool) {})
+	selector.AddReceive(s.updateCh, func(c workflow.Channel, more bool) {
+		var request types.UpdateScheduleRequest
+		c.Receive(s.ctx, &request)
+		s.update(&request)
+	})
+	selector.AddReceive(s.pauseCh, func(c workflow.Channel, more bool) {
+		var request types.PauseScheduleRequest
+		c.Receive(s.ctx, &request)
+		s.pause(&request)
+	})
+	selector.AddReceive(s.unpauseCh, func(c workflow.Channel, more bool) {
+		var request types.UnpauseScheduleRequest
+		c.Receive(s.ctx, &request)
+		s.unpause(&request)
+	})
+	selector.AddReceive(s.backfillCh, func(c workflow.Channel, more bool) {
+		var request types.BackfillScheduleRequest
+		c.Receive(s.ctx, &request)
+		backfillRequests = append(backfillRequests, &request)
+	})
+	selector.Select(s.ctx)
+
+	if fired {
+		s.params.LastProcessedTime = nextRunTime
+		s.processRun(nextRunTime, s.params.Policies.GetOverlapPolicy(), false)
+	}
+	for _, request := range backfillRequests {
+		s.backfill(request)
+	}
+}
+
+func (s *scheduler) drainSignals() {
+	for {
+		var update types.UpdateScheduleRequest
+		var pause types.PauseScheduleRequest
+		var unpause types.UnpauseScheduleRequest
+		var backfill types.BackfillScheduleRequest
+		switch {
+		case s.updateCh.ReceiveAsync(&update):
+			s.update(&update)
+		case s.pauseCh.ReceiveAsync(&pause):
+			s.pause(&pause)
+		case s.unpauseCh.ReceiveAsync(&unpause):
+			s.unpause(&unpause)
+		case s.backfillCh.ReceiveAsync(&backfill):
+			s.backfill(&backfill)
+		default:
+			return
+		}
+	}
+}
+
+func (s *scheduler) update(request *types.UpdateScheduleRequest) {
+	if request.Spec != nil {
+		s.params.Spec = *request.Spec
+	}
+	if request.Action != nil {
+		s.params.Action = *request.Action
+	}
+	if request.Policies != nil {
+		s.params.Policies = *request.Policies
+	}
+}
+
+func (s *scheduler) pause(request *types.PauseScheduleRequest) {
+	s.params.State.Paused = true
+	s.params.State.PauseReason = request.GetReason()
+}
+
+func (s *scheduler) unpause(request *types.UnpauseScheduleRequest) {
+	s.params.State.Paused = false
+	s.params.State.PauseReason = ""
+}
+
+// backfill processes every run the schedule spec would have started in the requested range.
+// Backfills are explicitly requested, so they are processed even if the schedule is paused.
+func (s *scheduler) backfill(request *types.BackfillScheduleRequest) {
+	policy := s.params.Policies.GetOverlapPolicy()
+	if request.OverlapPolicy != nil {
+		policy = *request.OverlapPolicy
+	}
+	endTime := time.Unix(0, request.GetEndTimestamp())
+	runTime := getNextRunTime(types.ScheduleSpec{CronExpression: s.params.Spec.CronExpression}, time.Unix(0, request.GetStartTimestamp()).Add(-time.Second))
+	for i := 0; i < maxBackfillRuns && !runTime.IsZero() && !runTime.After(endTime); i++ {
+		s.processRun(runTime, policy, true)
+		runTime = getNextRunTime(types.ScheduleSpec{CronExpression: s.params.Spec.CronExpression}, runTime)
+	}
+}
+
+func (s *scheduler) processRun(nominalTime time.Time, policy types.ScheduleOverlapPolicy, isBackfill bool) {
+	s.actions++
+	if s.params.State.Paused && !isBackfill {
+		s.params.Info.SkippedRuns++
+		return
+	}
+
+	if policy != types.ScheduleOverlapPolicyAllowAll && s.isLastRunRunning() {
+		switch policy {
+		case types.ScheduleOverlapPolicySkipNew:
+			s.params.Info.SkippedRuns++
+			return
+		case types.ScheduleOverlapPolicyBuffer:
+			s.params.BufferedRuns = append(s.params.BufferedRuns, nominalTime)
+			s.params.Info.BufferedRuns = int32(len(s.params.BufferedRuns))
+			return
+		case types.ScheduleOverlapPolicyCancelOther:
+			err := workflow.ExecuteActivity(s.ctx, cancelWorkflowActivityName, s.params.Domain, *s.params.Info.LastRunExecution).Get(s.ctx, nil)
+			if err != nil {
+				s.logger.Error("failed to cancel previous run of schedule", zap.Error(err))
+			}
+		}
+	}
+	s.startRun(nominalTime)
+}
+
+// processBufferedRuns starts buffered runs one at a time, as soon as the previous run has closed
+func (s *scheduler) processBufferedRuns() {
+	for len(s.params.BufferedRuns) > 0 && !s.isLastRunRunning() {
+		nominalTime := s.params.BufferedRuns[0]
+		s.params.BufferedRuns = s.params.BufferedRuns[1:]
+		s.params.Info.BufferedRuns = int32(len(s.params.BufferedRuns))
+		s.actions++
+		s.startRun(nominalTime)
+	}
+}
+
+func (s *scheduler) isLastRunRunning() bool {
+	if s.params.Info.LastRunExecution == nil {
+		return false
+	}
+	var running bool
+	err := workflow.ExecuteActivity(s.ctx, describeWorkflowActivityName, s.params.Domain, *s.params.Info.LastRunExecution).Get(s.ctx, &running)
+	if err != nil {
+		// be conservative and assume the previous run has not closed yet
+		s.logger.Error("failed to describe previous run of schedule", zap.Error(err))
+		return true
+	}
+	return running
+}
+
+func (s *scheduler) startRun(nominalTime time.Time) {
+	var requestID string
+	if err := workflow.SideEffect(s.ctx, func(ctx workflow.Context) interface{} {
+		return uuid.New().String()
+	}).Get(&requestID); err != nil {
+		s.logger.Error("failed to generate request id for schedule", zap.Error(err))
+		return
+	}
+
+	request := &types.StartWorkflowExecutionRequest{
+		Domain:                              s.params.Domain,
+		WorkflowID:                          fmt.Sprintf("%v-%v", s.params.ScheduleID, nominalTime.UTC().Format(time.RFC3339)),
+		WorkflowType:                        s.params.Action.WorkflowType,
+		TaskList:                            s.params.Action.TaskList,
+		Input:                               s.params.Action.Input,
+		ExecutionStartToCloseTimeoutSeconds: s.params.Action.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      s.params.Action.TaskStartToCloseTimeoutSeconds,
+		Identity:                            WorkflowTypeName,
+		RequestID:                           requestID,
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyRejectDuplicate.Ptr(),
+	}
+	var execution *types.WorkflowExecution
+	if err := workflow.ExecuteActivity(s.ctx, startWorkflowActivityName, request).Get(s.ctx, &execution); err != nil {
+		s.logger.Error("failed to start workflow for schedule", zap.Error(err))
+		s.params.Info.SkippedRuns++
+		return
+	}
+	if execution == nil {
+		// a run for this nominal time was already started, e.g. by an earlier backfill
+		s.params.Info.SkippedRuns++
+		return
+	}
+	s.params.Info.TotalRuns++
+	s.params.Info.LastRunTimestamp = common.Int64Ptr(nominalTime.UnixNano())
+	s.params.Info.LastRunExecution = execution
+}
+
+func (s *scheduler) describe() (*types.DescribeScheduleResponse, error) {
+	return &types.DescribeScheduleResponse{
+		Spec:     &s.params.Spec,
+		Action:   &s.params.Action,
+		Policies: &s.params.Policies,
+		State:    &s.params.State,
+		Info:     &s.params.Info,
+	}, nil
+}
+
+// getNextRunTime returns the first time after the given time matched by the schedule spec,
+// or the zero time if the spec will not match any more
+func getNextRunTime(spec types.ScheduleSpec, after time.Time) time.Time {
+	schedule, err := cron.ParseStandard(spec.CronExpression)
+	if err != nil {
+		return time.Time{}
+	}
+	if spec.StartTimestamp != nil {
+		if startTime := time.Unix(0, *spec.StartTimestamp).Add(-time.Second); startTime.After(after) {
+			after = startTime
+		}
+	}
+	next := schedule.Next(after.UTC())
+	if next.IsZero() || (spec.EndTimestamp != nil && next.After(time.Unix(0, *spec.EndTimestamp))) {
+		return time.Time{}
+	}
+	return next
+}
+
+func workflowID(domain string, scheduleID string) string {
+	return fmt.Sprintf("%v:%v:%v", workflowIDPrefix, domain, scheduleID)
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+type schedulerWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	workflowEnv *testsuite.TestWorkflowEnvironment
+	startTime   time.Time
+}
+
+func TestSchedulerWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(schedulerWorkflowTestSuite))
+}
+
+func (s *schedulerWorkflowTestSuite) SetupTest() {
+	s.startTime = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.SetStartTime(s.startTime)
+	s.workflowEnv.RegisterWorkflowWithOptions(ScheduleWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(StartWorkflowActivity, activity.RegisterOptions{Name: startWorkflowActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(DescribeWorkflowActivity, activity.RegisterOptions{Name: describeWorkflowActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(CancelWorkflowActivity, activity.RegisterOptions{Name: cancelWorkflowActivityName})
+}
+
+func (s *schedulerWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *schedulerWorkflowTestSuite) TestGetNextRunTime() {
+	after := s.startTime.Add(time.Second)
+	spec := types.ScheduleSpec{CronExpression: "0 * * * *"}
+	s.Equal(s.startTime.Add(time.Hour), getNextRunTime(spec, after))
+
+	spec.StartTimestamp = common.Int64Ptr(s.startTime.Add(3 * time.Hour).UnixNano())
+	s.Equal(s.startTime.Add(3*time.Hour), getNextRunTime(spec, after))
+
+	spec.EndTimestamp = common.Int64Ptr(s.startTime.Add(2 * time.Hour).UnixNano())
+	s.True(getNextRunTime(spec, after).IsZero())
+
+	s.True(getNextRunTime(types.ScheduleSpec{CronExpression: "invalid"}, after).IsZero())
+}
+
+func (s *schedulerWorkflowTestSuite) TestWorkflow_StartsRunsUntilEndTime() {
+	var requests []*types.StartWorkflowExecutionRequest
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, request *types.StartWorkflowExecutionRequest) (*types.WorkflowExecution, error) {
+			requests = append(requests, request)
+			return &types.WorkflowExecution{WorkflowID: request.WorkflowID, RunID: "rid"}, nil
+		}).Times(3)
+	s.workflowEnv.RegisterDelayedCallback(s.workflowEnv.CancelWorkflow, 24*time.Hour)
+
+	params := s.newParams(types.ScheduleOverlapPolicyAllowAll)
+	params.Spec.EndTimestamp = common.Int64Ptr(s.startTime.Add(3 * time.Hour).UnixNano())
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Len(requests, 3)
+	s.Equal("sid-2021-01-01T01:00:00Z", requests[0].WorkflowID)
+	s.Equal(params.Domain, requests[0].Domain)
+	s.Equal(types.WorkflowIDReusePolicyRejectDuplicate, requests[0].GetWorkflowIDReusePolicy())
+	info := s.describe().Info
+	s.Equal(int64(3), info.TotalRuns)
+	s.Equal(int64(0), info.SkippedRuns)
+	s.Equal(s.startTime.Add(3*time.Hour).UnixNano(), info.GetLastRunTimestamp())
+	s.Nil(info.NextRunTimestamp)
+}
+
+func (s *schedulerWorkflowTestSuite) TestWorkflow_SkipNewWhileLastRunIsRunning() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		&types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"}, nil).Once()
+	s.workflowEnv.OnActivity(describeWorkflowActivityName, mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Times(2)
+	s.workflowEnv.RegisterDelayedCallback(s.workflowEnv.CancelWorkflow, 24*time.Hour)
+
+	params := s.newParams(types.ScheduleOverlapPolicySkipNew)
+	params.Spec.EndTimestamp = common.Int64Ptr(s.startTime.Add(3 * time.Hour).UnixNano())
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	info := s.describe().Info
+	s.Equal(int64(1), info.TotalRuns)
+	s.Equal(int64(2), info.SkippedRuns)
+}
+
+func (s *schedulerWorkflowTestSuite) TestWorkflow_BufferWhileLastRunIsRunning() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		&types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"}, nil).Once()
+	s.workflowEnv.OnActivity(describeWorkflowActivityName, mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+	s.workflowEnv.RegisterDelayedCallback(s.workflowEnv.CancelWorkflow, 150*time.Minute)
+
+	params := s.newParams(types.ScheduleOverlapPolicyBuffer)
+	params.Spec.EndTimestamp = common.Int64Ptr(s.startTime.Add(3 * time.Hour).UnixNano())
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	info := s.describe().Info
+	s.Equal(int64(1), info.TotalRuns)
+	s.Equal(int32(1), info.BufferedRuns)
+}
+
+func (s *schedulerWorkflowTestSuite) TestWorkflow_PausedSchedule() {
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(pauseSignalName, &types.PauseScheduleRequest{Reason: "maintenance"})
+	}, 30*time.Minute)
+	s.workflowEnv.RegisterDelayedCallback(s.workflowEnv.CancelWorkflow, 24*time.Hour)
+
+	params := s.newParams(types.ScheduleOverlapPolicyAllowAll)
+	params.Spec.EndTimestamp = common.Int64Ptr(s.startTime.Add(3 * time.Hour).UnixNano())
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	resp := s.describe()
+	s.True(resp.State.Paused)
+	s.Equal("maintenance", resp.State.PauseReason)
+	s.Equal(int64(0), resp.Info.TotalRuns)
+	s.Equal(int64(3), resp.Info.SkippedRuns)
+}
+
+func (s *schedulerWorkflowTestSuite) TestWorkflow_BackfillPausedSchedule() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, request *types.StartWorkflowExecutionRequest) (*types.WorkflowExecution, error) {
+			return &types.WorkflowExecution{WorkflowID: request.WorkflowID, RunID: "rid"}, nil
+		}).Times(2)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(backfillSignalName, &types.BackfillScheduleRequest{
+			StartTimestamp: common.Int64Ptr(s.startTime.Add(-2 * time.Hour).UnixNano()),
+			EndTimestamp:   common.Int64Ptr(s.startTime.Add(-time.Hour).UnixNano()),
+		})
+	}, time.Minute)
+	s.workflowEnv.RegisterDelayedCallback(s.workflowEnv.CancelWorkflow, time.Hour)
+
+	params := s.newParams(types.ScheduleOverlapPolicyAllowAll)
+	params.State.Paused = true
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	info := s.describe().Info
+	s.Equal(int64(2), info.TotalRuns)
+	s.Equal(s.startTime.Add(-time.Hour).UnixNano(), info.GetLastRunTimestamp())
+}
+
+func (s *schedulerWorkflowTestSuite) newParams(policy types.ScheduleOverlapPolicy) Params {
+	return Params{
+		Domain:     "test-domain",
+		ScheduleID: "sid",
+		Spec:       types.ScheduleSpec{CronExpression: "0 * * * *"},
+		Action: types.ScheduleAction{
+			WorkflowType:                        &types.WorkflowType{Name: "wt"},
+			TaskList:                            &types.TaskList{Name: "tl"},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+		},
+		Policies: types.SchedulePolicies{OverlapPolicy: policy.Ptr()},
+	}
+}
+
+func (s *schedulerWorkflowTestSuite) describe() *types.DescribeScheduleResponse {
+	value, err := s.workflowEnv.QueryWorkflow(describeQueryType)
+	s.NoError(err)
+	var resp types.DescribeScheduleResponse
+	s.NoError(value.Get(&resp))
+	return &resp
+}
@@ -50,6 +50,7 @@ import (
 	"github.com/uber/cadence/service/worker/scanner/shardscanner"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"github.com/uber/cadence/service/worker/scanner/timers"
+	"github.com/uber/cadence/service/worker/scheduler"
 	"github.com/uber/cadence/service/worker/shadower"
 )
 
@@ -81,6 +82,7 @@ type (
 		EnableParentClosePolicyWorker     dynamicconfig.BoolPropertyFn
 		EnableFailoverManager             dynamicconfig.BoolPropertyFn
 		EnableWorkflowShadower            dynamicconfig.BoolPropertyFn
+		EnableScheduler                   dynamicconfig.BoolPropertyFn
 		DomainReplicationMaxRetryDuration dynamicconfig.DurationPropertyFn
 	}
 )
@@ -140,6 +142,13 @@ func NewConfig(params *service.BootstrapParams) *Config {
 			ClusterMetadata:        params.ClusterMetadata,
 			TaskListScannerEnabled: dc.GetBoolProperty(dynamicconfig.TaskListScannerEnabled, true),
 			HistoryScannerEnabled:  dc.GetBoolProperty(dynamicconfig.HistoryScannerEnabled, false),
+			DomainDeletionEnabled:  dc.GetBoolProperty(dynamicconfig.DomainDeletionEnabled, true),
+			DomainConfig: domain.Config{
+				MinRetentionDays:         dc.GetIntProperty(dynamicconfig.MinRetentionDays, domain.DefaultMinWorkflowRetentionInDays),
+				MaxBadBinaryCount:        dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendMaxBadBinaries, domain.MaxBadBinaries),
+				FailoverCoolDown:         dc.GetDurationPropertyFilteredByDomain(dynamicconfig.FrontendFailoverCoolDown, domain.FailoverCoolDown),
+				EnableAdvancedVisibility: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableReadVisibilityFromES, params.PersistenceConfig.IsAdvancedVisibilityConfigExist()),
+			},
 			ShardScanners: []*shardscanner.ScannerConfig{
 				executions.ConcreteExecutionScannerConfig(dc),
 				executions.CurrentExecutionScannerConfig(dc),
@@ -159,6 +168,7 @@ func NewConfig(params *service.BootstrapParams) *Config {
 		EnableParentClosePolicyWorker:     dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
 		EnableFailoverManager:             dc.GetBoolProperty(dynamicconfig.EnableFailoverManager, true),
 		EnableWorkflowShadower:            dc.GetBoolProperty(dynamicconfig.EnableWorkflowShadower, true),
+		EnableScheduler:                   dc.GetBoolProperty(dynamicconfig.EnableScheduler, true),
 		ThrottledLogRPS:                   dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
 		PersistenceGlobalMaxQPS:           dc.GetIntProperty(dynamicconfig.WorkerPersistenceGlobalMaxQPS, 0),
 		PersistenceMaxQPS:                 dc.GetIntProperty(dynamicconfig.WorkerPersistenceMaxQPS, 500),
@@ -218,6 +228,9 @@ func (s *Service) Start() {
 		s.ensureDomainExists(common.ShadowerLocalDomainName)
 		s.startWorkflowShadower()
 	}
+	if s.config.EnableScheduler() {
+		s.startScheduler()
+	}
 
 	logger.Info("worker started", tag.ComponentWorker)
 	<-s.stopC
@@ -278,7 +291,9 @@ func (s *Service) startScanner() {
 func (s *Service) startReplicator() {
 	domainReplicationTaskExecutor := domain.NewReplicationTaskExecutor(
 		s.Resource.GetDomainManager(),
+		s.Resource.GetDomainAuditLog(),
 		s.Resource.GetTimeSource(),
+		s.Resource.GetMetricsClient(),
 		s.Resource.GetLogger(),
 	)
 	msgReplicator := replicator.NewReplicator(
@@ -357,6 +372,20 @@ func (s *Service) startWorkflowShadower() {
 	}
 }
 
+func (s *Service) startScheduler() {
+	params := &scheduler.BootstrapParams{
+		ServiceClient:  s.params.PublicClient,
+		FrontendClient: s.GetFrontendClient(),
+		MetricsClient:  s.GetMetricsClient(),
+		Logger:         s.GetLogger(),
+		TallyScope:     s.params.MetricScope,
+	}
+	if err := scheduler.New(params).Start(); err != nil {
+		s.Stop()
+		s.GetLogger().Fatal("error starting scheduler", tag.Error(err))
+	}
+}
+
 func (s *Service) ensureDomainExists(domain string) {
 	_, err := s.GetDomainManager().GetDomain(context.Background(), &persistence.GetDomainRequest{Name: domain})
 	switch err.(type) {
@@ -30,6 +30,7 @@ import (
 
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/service/worker/scanner/domaindeletion"
 	"github.com/uber/cadence/service/worker/scanner/executions"
 	"github.com/uber/cadence/tools/common/flag"
 )
@@ -99,6 +100,32 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminRefreshWorkflowTasks(c)
 			},
 		},
+		{
+			Name:    "migrate",
+			Aliases: []string{"mig"},
+			Usage:   "Copy a running workflow from a source cluster into this cluster and close it in the source",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, the current run of the workflow is migrated if not set",
+				},
+				cli.StringFlag{
+					Name:  FlagSourceClusterWithAlias,
+					Usage: "Name of the cluster the workflow is migrated from",
+				},
+				cli.StringFlag{
+					Name:  FlagSourceDomain,
+					Usage: "Name of the domain in the source cluster, same as the domain in this cluster if not set",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminMigrateWorkflow(c)
+			},
+		},
 		{
 			Name:    "delete",
 			Aliases: []string{"del"},
@@ -375,6 +402,25 @@ func newAdminDomainCommands() []cli.Command {
 				newDomainCLI(c, true).DeprecateDomain(c)
 			},
 		},
+		{
+			Name:  "delete",
+			Usage: "Delete deprecated workflow domain with all its data, using a system workflow",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagConcurrency,
+					Value: domaindeletion.DefaultConcurrency,
+					Usage: "Number of shards purged in parallel",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: domaindeletion.DefaultPageSize,
+					Usage: "Page size used when scanning a shard",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDeleteDomain(c)
+			},
+		},
 		{
 			Name:    "describe",
 			Aliases: []string{"desc"},
@@ -664,6 +710,78 @@ func newAdminTaskListCommands() []cli.Command {
 				AdminDescribeTaskList(c)
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "Pause dispatching of tasks from all partitions of a tasklist",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Value: "decision",
+					Usage: "Optional TaskList type [decision|activity]",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminPauseTaskList(c)
+			},
+		},
+		{
+			Name:  "resume",
+			Usage: "Resume dispatching of tasks from all partitions of a paused tasklist",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Value: "decision",
+					Usage: "Optional TaskList type [decision|activity]",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminResumeTaskList(c)
+			},
+		},
+		{
+			Name:  "move",
+			Usage: "Move the persisted backlog of all partitions of a tasklist to another tasklist in the same domain",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "Source TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagTargetTaskListWithAlias,
+					Usage: "Target TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Value: "decision",
+					Usage: "Optional TaskList type [decision|activity]",
+				},
+				cli.IntFlag{
+					Name:  FlagBatchSizeWithAlias,
+					Value: 100,
+					Usage: "Optional number of tasks moved per request",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Value: 10,
+					Usage: "Optional number of move requests per second",
+				},
+				cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Only count the tasks that would be moved",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminMoveTaskListBacklog(c)
+			},
+		},
 	}
 }
 
@@ -681,7 +799,7 @@ func newAdminClusterCommands() []cli.Command {
 				cli.IntFlag{
 					Name:  FlagSearchAttributesType,
 					Value: -1,
-					Usage: "Search Attribute value type. [0:String, 1:Keyword, 2:Int, 3:Double, 4:Bool, 5:Datetime]",
+					Usage: "Search Attribute value type. [0:String, 1:Keyword, 2:Int, 3:Double, 4:Bool, 5:Datetime, 6:KeywordList]",
 				},
 				cli.StringFlag{
 					Name:  FlagSecurityTokenWithAlias,
@@ -720,6 +838,73 @@ func newAdminClusterCommands() []cli.Command {
 				newDomainCLI(c, false).FailoverDomains(c)
 			},
 		},
+		{
+			Name:    "replication-status",
+			Aliases: []string{"rs"},
+			Usage:   "Describe replication progress per shard and per domain against remote clusters",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagCluster,
+					Usage: "Only report replication status against this remote cluster",
+				},
+				cli.IntFlag{
+					Name:  FlagLowerShardBound,
+					Usage: "lower bound of shard to describe (inclusive)",
+				},
+				cli.IntFlag{
+					Name:  FlagUpperShardBound,
+					Usage: "upper bound of shard to describe (inclusive)",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeReplicationStatus(c)
+			},
+		},
+		{
+			Name:    "add-remote",
+			Aliases: []string{"ar"},
+			Usage:   "Add a remote cluster, or update an existing one, without restarting the current cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagCluster,
+					Usage: "Name of the remote cluster",
+				},
+				cli.Int64Flag{
+					Name:  FlagInitialFailoverVersion,
+					Usage: "Initial failover version of the remote cluster, must be unique across clusters",
+				},
+				cli.StringFlag{
+					Name:  FlagRPCName,
+					Value: "cadence-frontend",
+					Usage: "RPC service name of the remote cluster frontend",
+				},
+				cli.StringFlag{
+					Name:  FlagRPCAddress,
+					Usage: "RPC address (host:port) of the remote cluster frontend",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminAddRemoteCluster(c)
+			},
+		},
+		{
+			Name:    "remove-remote",
+			Aliases: []string{"rr"},
+			Usage:   "Remove a remote cluster without restarting the current cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagCluster,
+					Usage: "Name of the remote cluster",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRemoveRemoteCluster(c)
+			},
+		},
 		{
 			Name:        "rebalance",
 			Aliases:     []string{"rb"},
@@ -832,6 +1017,111 @@ func newAdminDLQCommands() []cli.Command {
 	}
 }
 
+func newAdminHistoryTaskDLQCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List tasks in the history task DLQ of a shard",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.Int64Flag{
+					Name:  FlagMinTaskID,
+					Usage: "The lower boundary of the task ids (exclusive)",
+				},
+				cli.Int64Flag{
+					Name:  FlagMaxTaskID,
+					Usage: "The upper boundary of the task ids (inclusive)",
+				},
+				cli.IntFlag{
+					Name:  FlagMaxMessageCountWithAlias,
+					Usage: "Max number of tasks to fetch",
+				},
+				cli.StringFlag{
+					Name:  FlagOutputFilenameWithAlias,
+					Usage: "Output file to write to, if not provided output is written to stdout",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListHistoryTaskDLQ(c)
+			},
+		},
+		{
+			Name:    "show",
+			Aliases: []string{"s"},
+			Usage:   "Show a task in the history task DLQ of a shard",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.Int64Flag{
+					Name:  FlagTaskID,
+					Usage: "Task ID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminShowHistoryTaskDLQ(c)
+			},
+		},
+		{
+			Name:    "retry",
+			Aliases: []string{"r"},
+			Usage:   "Resubmit tasks in the history task DLQ to the transfer and timer queue processors",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagLowerShardBound,
+					Usage: "lower bound of shard to retry (inclusive)",
+				},
+				cli.IntFlag{
+					Name:  FlagUpperShardBound,
+					Usage: "upper bound of shard to retry (inclusive)",
+				},
+				cli.Int64Flag{
+					Name:  FlagMinTaskID,
+					Usage: "The lower boundary of the task ids (exclusive)",
+				},
+				cli.Int64Flag{
+					Name:  FlagMaxTaskID,
+					Usage: "The upper boundary of the task ids (inclusive)",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRetryHistoryTaskDLQ(c)
+			},
+		},
+		{
+			Name:    "purge",
+			Aliases: []string{"p"},
+			Usage:   "Delete tasks in the history task DLQ",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagLowerShardBound,
+					Usage: "lower bound of shard to purge (inclusive)",
+				},
+				cli.IntFlag{
+					Name:  FlagUpperShardBound,
+					Usage: "upper bound of shard to purge (inclusive)",
+				},
+				cli.Int64Flag{
+					Name:  FlagMinTaskID,
+					Usage: "The lower boundary of the task ids (exclusive)",
+				},
+				cli.Int64Flag{
+					Name:  FlagMaxTaskID,
+					Usage: "The upper boundary of the task ids (inclusive)",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminPurgeHistoryTaskDLQ(c)
+			},
+		},
+	}
+}
+
 func newAdminQueueCommands() []cli.Command {
 	return []cli.Command{
 		{
@@ -1088,11 +1378,50 @@ func newAdminFailoverCommands() []cli.Command {
 					Usage: "Optional cron schedule on failover drill. Please specify failover drill wait time " +
 						"if this field is specific",
 				},
+				cli.BoolFlag{
+					Name:  FlagFailoverPreflightWithAlias,
+					Usage: "Optional to skip domains which fail the preflight check",
+				},
+				cli.Int64Flag{
+					Name:  FlagMaxReplicationLag,
+					Usage: "Optional replication task lag above which the preflight check fails",
+				},
 			},
 			Action: func(c *cli.Context) {
 				AdminFailoverStart(c)
 			},
 		},
+		{
+			Name:    "preflight",
+			Aliases: []string{"pf"},
+			Usage:   "check whether domains can be safely failed over, must be run against the source cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTargetClusterWithAlias,
+					Usage: "Target cluster name",
+				},
+				cli.StringFlag{
+					Name:  FlagSourceClusterWithAlias,
+					Usage: "Source cluster name",
+				},
+				cli.StringSliceFlag{
+					Name: FlagFailoverDomains,
+					Usage: "Optional domains to check, eg d1,d2..,dn. " +
+						"All domains active in source cluster are checked by default.",
+				},
+				cli.Int64Flag{
+					Name:  FlagMaxReplicationLag,
+					Usage: "Optional replication task lag above which the preflight check fails",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminFailoverPreflight(c)
+			},
+		},
 		{
 			Name:    "pause",
 			Aliases: []string{"p"},
@@ -22,9 +22,12 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"strconv"
 	"time"
 
 	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
 	cclient "go.uber.org/cadence/client"
 
@@ -68,6 +71,41 @@ func AdminAddSearchAttribute(c *cli.Context) {
 	fmt.Println("Success. Note that for a multil-node Cadence cluster, DynamicConfig MUST be updated separately to whitelist the new attributes.")
 }
 
+// AdminAddRemoteCluster adds a remote cluster to the cluster metadata of the current cluster
+func AdminAddRemoteCluster(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	request := &types.AddRemoteClusterRequest{
+		ClusterName:            getRequiredOption(c, FlagCluster),
+		InitialFailoverVersion: getRequiredInt64Option(c, FlagInitialFailoverVersion),
+		RPCName:                c.String(FlagRPCName),
+		RPCAddress:             getRequiredOption(c, FlagRPCAddress),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if err := adminClient.AddRemoteCluster(ctx, request); err != nil {
+		ErrorAndExit("Operation AddRemoteCluster failed.", err)
+	}
+	fmt.Printf("Remote cluster %v added.\n", request.ClusterName)
+}
+
+// AdminRemoveRemoteCluster removes a remote cluster from the cluster metadata of the current cluster
+func AdminRemoveRemoteCluster(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	request := &types.RemoveRemoteClusterRequest{
+		ClusterName: getRequiredOption(c, FlagCluster),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if err := adminClient.RemoveRemoteCluster(ctx, request); err != nil {
+		ErrorAndExit("Operation RemoveRemoteCluster failed.", err)
+	}
+	fmt.Printf("Remote cluster %v removed.\n", request.ClusterName)
+}
+
 // AdminDescribeCluster is used to dump information about the cluster
 func AdminDescribeCluster(c *cli.Context) {
 	adminClient := cFactory.ServerAdminClient(c)
@@ -82,6 +120,104 @@ func AdminDescribeCluster(c *cli.Context) {
 	prettyPrintJSONObject(response)
 }
 
+// AdminDescribeReplicationStatus is used to show the replication progress against remote clusters
+func AdminDescribeReplicationStatus(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	request := &types.DescribeReplicationStatusRequest{
+		ClusterName: c.String(FlagCluster),
+		Domain:      c.GlobalString(FlagDomain),
+	}
+	if c.IsSet(FlagLowerShardBound) || c.IsSet(FlagUpperShardBound) {
+		lowerShardBound := c.Int(FlagLowerShardBound)
+		upperShardBound := getRequiredIntOption(c, FlagUpperShardBound)
+		for shardID := lowerShardBound; shardID <= upperShardBound; shardID++ {
+			request.ShardIDs = append(request.ShardIDs, int32(shardID))
+		}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.DescribeReplicationStatus(ctx, request)
+	if err != nil {
+		ErrorAndExit("Operation DescribeReplicationStatus failed.", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(response)
+		return
+	}
+
+	shardTable := newReplicationStatusTable([]string{
+		"ShardID", "Cluster", "MaxTaskID", "OutboundAcked", "OutboundLag", "OutboundLastFetch",
+		"InboundFetched", "InboundAcked", "InboundLastFetch", "InboundDLQ", "CrossClusterLag",
+	})
+	for _, shard := range response.GetShards() {
+		shardTable.Append([]string{
+			strconv.Itoa(int(shard.ShardID)),
+			shard.ClusterName,
+			strconv.FormatInt(shard.MaxTaskID, 10),
+			strconv.FormatInt(shard.OutboundAckedTaskID, 10),
+			strconv.FormatInt(shard.MaxTaskID-shard.OutboundAckedTaskID, 10),
+			formatReplicationFetchTime(shard.OutboundLastFetchTime),
+			strconv.FormatInt(shard.InboundFetchedTaskID, 10),
+			strconv.FormatInt(shard.InboundAckedTaskID, 10),
+			formatReplicationFetchTime(shard.InboundLastFetchTime),
+			strconv.FormatInt(shard.InboundDLQSize, 10),
+			strconv.FormatInt(shard.CrossClusterTaskLag, 10),
+		})
+	}
+	shardTable.Render()
+	fmt.Println()
+
+	domainTable := newReplicationStatusTable([]string{
+		"Domain", "ActiveCluster", "Cluster", "MaxOutboundLag", "OldestOutboundFetch", "OldestInboundFetch",
+		"InboundDLQ", "MaxCrossClusterLag", "GracefulFailoverEnd",
+	})
+	for _, domain := range response.GetDomains() {
+		domainTable.Append([]string{
+			domain.Domain,
+			domain.ActiveClusterName,
+			domain.ClusterName,
+			strconv.FormatInt(domain.MaxOutboundTaskLag, 10),
+			formatReplicationFetchTime(domain.OldestOutboundFetchTime),
+			formatReplicationFetchTime(domain.OldestInboundFetchTime),
+			strconv.FormatInt(domain.InboundDLQSize, 10),
+			strconv.FormatInt(domain.MaxCrossClusterTaskLag, 10),
+			formatGracefulFailoverEndTime(domain.GracefulFailoverEndTime),
+		})
+	}
+	domainTable.Render()
+}
+
+func newReplicationStatusTable(header []string) *tablewriter.Table {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	headerColor := make([]tablewriter.Colors, len(header))
+	for i := range headerColor {
+		headerColor[i] = tableHeaderBlue
+	}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	table.SetHeaderLine(false)
+	return table
+}
+
+func formatReplicationFetchTime(unixNano *int64) string {
+	if unixNano == nil {
+		return "never"
+	}
+	return convertTime(*unixNano, false)
+}
+
+func formatGracefulFailoverEndTime(unixNano *int64) string {
+	if unixNano == nil {
+		return "-"
+	}
+	return convertTime(*unixNano, false)
+}
+
 func AdminRebalanceStart(c *cli.Context) {
 	client := getCadenceClient(c)
 	tcCtx, cancel := newContext(c)
@@ -130,11 +266,13 @@ func intValTypeToString(valType int) string {
 		return "Bool"
 	case 5:
 		return "Datetime"
+	case 6:
+		return "KeywordList"
 	default:
 		return ""
 	}
 }
 
 func isValueTypeValid(valType int) bool {
-	return valType >= 0 && valType <= 5
+	return valType >= 0 && valType <= 6
 }
@@ -57,8 +57,13 @@ func TestAdminAddSearchAttribute_isValueTypeValid(t *testing.T) {
 			expected: true,
 		},
 		{
-			name:     "unknown",
+			name:     "valid",
 			input:    6,
+			expected: true,
+		},
+		{
+			name:     "unknown",
+			input:    7,
 			expected: false,
 		},
 	}
@@ -47,6 +47,7 @@ import (
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
+	"github.com/uber/cadence/service/worker/scanner/domaindeletion"
 	"github.com/uber/cadence/tools/common/flag"
 )
 
@@ -389,6 +390,27 @@ func AdminGetDomainIDOrName(c *cli.Context) {
 	}
 }
 
+// AdminDeleteDomain starts the system workflow which deletes a deprecated domain with all its data
+func AdminDeleteDomain(c *cli.Context) {
+	domainName := getRequiredGlobalOption(c, FlagDomain)
+	prompt(fmt.Sprintf("Domain %v and all its workflow data will be permanently deleted, continue? Y/N", domainName))
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.DeleteDomain(ctx, &types.DeleteDomainRequest{
+		Name:        domainName,
+		Concurrency: int32(c.Int(FlagConcurrency)),
+		PageSize:    int32(c.Int(FlagPageSize)),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to start domain deletion workflow", err)
+	}
+	fmt.Println("Domain deletion workflow started")
+	fmt.Println("wid: " + domaindeletion.WorkflowID(domainName))
+	fmt.Printf("Progress is reported as %v in the domain data until the domain is removed\n", common.DomainDataKeyForDeletionProgress)
+}
+
 // AdminGetShardID get shardID
 func AdminGetShardID(c *cli.Context) {
 	wid := getRequiredOption(c, FlagWorkflowID)
@@ -615,6 +637,31 @@ func AdminRefreshWorkflowTasks(c *cli.Context) {
 	}
 }
 
+// AdminMigrateWorkflow copies a running workflow from a source cluster and closes it there
+func AdminMigrateWorkflow(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+
+	ctx, cancel := newContextForLongPoll(c)
+	defer cancel()
+
+	resp, err := adminClient.MigrateWorkflowExecution(ctx, &types.MigrateWorkflowExecutionRequest{
+		Domain:        domain,
+		WorkflowID:    wid,
+		RunID:         rid,
+		SourceCluster: sourceCluster,
+		SourceDomain:  c.String(FlagSourceDomain),
+	})
+	if err != nil {
+		ErrorAndExit("Migrate workflow failed", err)
+	}
+	fmt.Printf("Migrated workflow %v, run %v, next event ID %v.\n", wid, resp.GetRunID(), resp.GetNextEventID())
+}
+
 // AdminResetQueue resets task processing queue states
 func AdminResetQueue(c *cli.Context) {
 	adminClient := cFactory.ServerAdminClient(c)
@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 	"os/user"
+	"strings"
 	"time"
 
 	"go.uber.org/cadence/.gen/go/shared"
@@ -32,6 +33,7 @@ import (
 	cclient "go.uber.org/cadence/client"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/failovermanager"
 )
 
@@ -51,6 +53,8 @@ type startParams struct {
 	domains                        []string
 	drillWaitTime                  int
 	cron                           string
+	preflightCheck                 bool
+	maxReplicationLag              int64
 }
 
 // AdminFailoverStart start failover workflow
@@ -64,10 +68,61 @@ func AdminFailoverStart(c *cli.Context) {
 		domains:                        c.StringSlice(FlagFailoverDomains),
 		drillWaitTime:                  c.Int(FlagFailoverDrillWaitTime),
 		cron:                           c.String(FlagCronSchedule),
+		preflightCheck:                 c.Bool(FlagFailoverPreflight),
+		maxReplicationLag:              c.Int64(FlagMaxReplicationLag),
 	}
 	failoverStart(c, params)
 }
 
+// AdminFailoverPreflight check whether domains can be safely failed over
+func AdminFailoverPreflight(c *cli.Context) {
+	params := &failovermanager.PreflightParams{
+		TargetCluster:     getRequiredOption(c, FlagTargetCluster),
+		SourceCluster:     getRequiredOption(c, FlagSourceCluster),
+		Domains:           c.StringSlice(FlagFailoverDomains),
+		MaxReplicationLag: c.Int64(FlagMaxReplicationLag),
+	}
+	if params.TargetCluster == params.SourceCluster {
+		ErrorAndExit("targetCluster is same as sourceCluster", nil)
+	}
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	sourceStatus, err := adminClient.DescribeReplicationStatus(ctx, &types.DescribeReplicationStatusRequest{
+		ClusterName: params.TargetCluster,
+	})
+	if err != nil {
+		ErrorAndExit("Operation DescribeReplicationStatus failed.", err)
+	}
+
+	// only the source cluster is reachable from CLI, so DLQ of target cluster is reported as not checked
+	results := failovermanager.CheckPreflight(params, sourceStatus, nil)
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(results)
+	} else {
+		table := newReplicationStatusTable([]string{"Domain", "Result", "Details"})
+		table.SetAutoWrapText(false)
+		for _, result := range results {
+			state := "PASS"
+			if !result.Passed() {
+				state = "FAIL"
+			} else if len(result.Warnings) > 0 {
+				state = "WARN"
+			}
+			details := append(append([]string{}, result.Errors...), result.Warnings...)
+			table.Append([]string{result.Domain, state, strings.Join(details, "; ")})
+		}
+		table.Render()
+	}
+
+	for _, result := range results {
+		if !result.Passed() {
+			ErrorAndExit("Failover preflight check failed.", nil)
+		}
+	}
+}
+
 // AdminFailoverPause pause failover workflow
 func AdminFailoverPause(c *cli.Context) {
 	err := executePauseOrResume(c, getFailoverWorkflowID(c), true)
@@ -255,6 +310,8 @@ func failoverStart(c *cli.Context, params *startParams) {
 		BatchFailoverWaitTimeInSeconds: batchFailoverWaitTimeInSeconds,
 		Domains:                        domains,
 		DrillWaitTime:                  drillWaitTime,
+		PreflightCheck:                 params.preflightCheck,
+		MaxReplicationLag:              params.maxReplicationLag,
 	}
 	wf, err := client.StartWorkflow(tcCtx, options, failovermanager.FailoverWorkflowTypeName, foParams)
 	if err != nil {
@@ -0,0 +1,181 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/collection"
+	"github.com/uber/cadence/common/types"
+)
+
+// AdminListHistoryTaskDLQ lists transfer and timer tasks parked in the history task DLQ of a shard
+func AdminListHistoryTaskDLQ(c *cli.Context) {
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	adminClient := cFactory.ServerAdminClient(c)
+	shardID := getRequiredIntOption(c, FlagShardID)
+	exclusiveBeginTaskID, inclusiveEndTaskID := getHistoryTaskDLQRange(c)
+	outputFile := getOutputFile(c.String(FlagOutputFilename))
+	defer outputFile.Close()
+
+	remainingMessageCount := common.EndMessageID
+	if c.IsSet(FlagMaxMessageCount) {
+		remainingMessageCount = c.Int64(FlagMaxMessageCount)
+	}
+
+	paginationFunc := func(paginationToken []byte) ([]interface{}, []byte, error) {
+		resp, err := adminClient.ReadHistoryTaskDLQ(ctx, &types.ReadHistoryTaskDLQRequest{
+			ShardID:              int32(shardID),
+			ExclusiveBeginTaskID: exclusiveBeginTaskID,
+			InclusiveEndTaskID:   inclusiveEndTaskID,
+			MaximumPageSize:      defaultPageSize,
+			NextPageToken:        paginationToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		var paginateItems []interface{}
+		for _, item := range resp.GetEntries() {
+			paginateItems = append(paginateItems, item)
+		}
+		return paginateItems, resp.GetNextPageToken(), nil
+	}
+
+	iterator := collection.NewPagingIterator(paginationFunc)
+	var lastReadTaskID int64
+	for iterator.HasNext() && remainingMessageCount > 0 {
+		item, err := iterator.Next()
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("fail to read history task dlq. Last read task id: %v", lastReadTaskID), err)
+		}
+
+		entry := item.(*types.HistoryTaskDLQEntry)
+		str, err := json.Marshal(entry)
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("fail to encode history task dlq entry. Last read task id: %v", lastReadTaskID), err)
+		}
+
+		lastReadTaskID = entry.GetTaskID()
+		remainingMessageCount--
+		if _, err = outputFile.WriteString(fmt.Sprintf("%v\n", string(str))); err != nil {
+			ErrorAndExit("fail to print history task dlq entries.", err)
+		}
+	}
+}
+
+// AdminShowHistoryTaskDLQ shows a single task parked in the history task DLQ of a shard
+func AdminShowHistoryTaskDLQ(c *cli.Context) {
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	adminClient := cFactory.ServerAdminClient(c)
+	shardID := getRequiredIntOption(c, FlagShardID)
+	taskID := getRequiredInt64Option(c, FlagTaskID)
+
+	resp, err := adminClient.ReadHistoryTaskDLQ(ctx, &types.ReadHistoryTaskDLQRequest{
+		ShardID:              int32(shardID),
+		ExclusiveBeginTaskID: common.Int64Ptr(taskID - 1),
+		InclusiveEndTaskID:   common.Int64Ptr(taskID),
+		MaximumPageSize:      1,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to read history task DLQ.", err)
+	}
+	if len(resp.GetEntries()) == 0 {
+		ErrorAndExit(fmt.Sprintf("Task %v not found in history task DLQ of shard %v.", taskID, shardID), nil)
+	}
+	prettyPrintJSONObject(resp.GetEntries()[0])
+}
+
+// AdminRetryHistoryTaskDLQ resubmits tasks parked in the history task DLQ to the queue processors
+func AdminRetryHistoryTaskDLQ(c *cli.Context) {
+	lowerShardBound := c.Int(FlagLowerShardBound)
+	upperShardBound := c.Int(FlagUpperShardBound)
+	exclusiveBeginTaskID, inclusiveEndTaskID := getHistoryTaskDLQRange(c)
+
+	adminClient := cFactory.ServerAdminClient(c)
+ShardIDLoop:
+	for shardID := lowerShardBound; shardID <= upperShardBound; shardID++ {
+		request := &types.RetryHistoryTaskDLQRequest{
+			ShardID:              int32(shardID),
+			ExclusiveBeginTaskID: exclusiveBeginTaskID,
+			InclusiveEndTaskID:   inclusiveEndTaskID,
+			MaximumPageSize:      defaultPageSize,
+		}
+
+		for {
+			ctx, cancel := newContext(c)
+			response, err := adminClient.RetryHistoryTaskDLQ(ctx, request)
+			cancel()
+			if err != nil {
+				fmt.Printf("Failed to retry history task DLQ in shard %v with error: %v.\n", shardID, err)
+				continue ShardIDLoop
+			}
+
+			if len(response.NextPageToken) == 0 {
+				break
+			}
+
+			request.NextPageToken = response.NextPageToken
+		}
+		fmt.Printf("Successfully retried all history task DLQ tasks in shard %v.\n", shardID)
+	}
+}
+
+// AdminPurgeHistoryTaskDLQ deletes tasks parked in the history task DLQ
+func AdminPurgeHistoryTaskDLQ(c *cli.Context) {
+	lowerShardBound := c.Int(FlagLowerShardBound)
+	upperShardBound := c.Int(FlagUpperShardBound)
+	exclusiveBeginTaskID, inclusiveEndTaskID := getHistoryTaskDLQRange(c)
+
+	adminClient := cFactory.ServerAdminClient(c)
+	for shardID := lowerShardBound; shardID <= upperShardBound; shardID++ {
+		ctx, cancel := newContext(c)
+		err := adminClient.PurgeHistoryTaskDLQ(ctx, &types.PurgeHistoryTaskDLQRequest{
+			ShardID:              int32(shardID),
+			ExclusiveBeginTaskID: exclusiveBeginTaskID,
+			InclusiveEndTaskID:   inclusiveEndTaskID,
+		})
+		cancel()
+		if err != nil {
+			fmt.Printf("Failed to purge history task DLQ in shard %v with error: %v.\n", shardID, err)
+			continue
+		}
+		fmt.Printf("Successfully purged history task DLQ in shard %v.\n", shardID)
+	}
+}
+
+func getHistoryTaskDLQRange(c *cli.Context) (*int64, *int64) {
+	var exclusiveBeginTaskID, inclusiveEndTaskID *int64
+	if c.IsSet(FlagMinTaskID) {
+		exclusiveBeginTaskID = common.Int64Ptr(c.Int64(FlagMinTaskID))
+	}
+	if c.IsSet(FlagMaxTaskID) {
+		inclusiveEndTaskID = common.Int64Ptr(c.Int64(FlagMaxTaskID))
+	}
+	return exclusiveBeginTaskID, inclusiveEndTaskID
+}
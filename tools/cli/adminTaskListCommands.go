@@ -21,6 +21,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
@@ -29,6 +30,7 @@ import (
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
 
+	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -37,10 +39,7 @@ func AdminDescribeTaskList(c *cli.Context) {
 	frontendClient := cFactory.ServerFrontendClient(c)
 	domain := getRequiredGlobalOption(c, FlagDomain)
 	taskList := getRequiredOption(c, FlagTaskList)
-	taskListType := types.TaskListTypeDecision
-	if strings.ToLower(c.String(FlagTaskListType)) == "activity" {
-		taskListType = types.TaskListTypeActivity
-	}
+	taskListType := getTaskListType(c)
 
 	ctx, cancel := newContext(c)
 	defer cancel()
@@ -70,20 +69,142 @@ func AdminDescribeTaskList(c *cli.Context) {
 	printPollerInfo(pollers, taskListType)
 }
 
+// AdminPauseTaskList pauses dispatching of tasks from a task list
+func AdminPauseTaskList(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+	taskListType := getTaskListType(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.PauseTaskList(ctx, &types.PauseTaskListRequest{
+		Domain:       domain,
+		TaskList:     &types.TaskList{Name: taskList},
+		TaskListType: &taskListType,
+	})
+	if err != nil {
+		ErrorAndExit("Operation PauseTaskList failed.", err)
+	}
+	fmt.Printf("Tasklist %v paused\n", taskList)
+}
+
+// AdminResumeTaskList resumes dispatching of tasks from a paused task list
+func AdminResumeTaskList(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+	taskListType := getTaskListType(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.ResumeTaskList(ctx, &types.ResumeTaskListRequest{
+		Domain:       domain,
+		TaskList:     &types.TaskList{Name: taskList},
+		TaskListType: &taskListType,
+	})
+	if err != nil {
+		ErrorAndExit("Operation ResumeTaskList failed.", err)
+	}
+	fmt.Printf("Tasklist %v resumed\n", taskList)
+}
+
+// AdminMoveTaskListBacklog moves persisted tasks from every partition of a task list to another task list.
+// Tasks are removed from the source only after they were added to the target, so the command can simply
+// be run again if it was interrupted.
+func AdminMoveTaskListBacklog(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	sourceTaskList := getRequiredOption(c, FlagTaskList)
+	targetTaskList := getRequiredOption(c, FlagTargetTaskList)
+	taskListType := getTaskListType(c)
+	batchSize := c.Int(FlagBatchSize)
+	dryRun := c.Bool(FlagDryRun)
+	if sourceTaskList == targetTaskList {
+		ErrorAndExit("Source and target tasklists must be different.", nil)
+	}
+	if batchSize <= 0 {
+		ErrorAndExit(fmt.Sprintf("Invalid %v: %v.", FlagBatchSize, batchSize), nil)
+	}
+	rps := c.Int(FlagRPS)
+	if rps <= 0 {
+		ErrorAndExit(fmt.Sprintf("Invalid %v: %v.", FlagRPS, rps), nil)
+	}
+	rateLimiter := quotas.NewSimpleRateLimiter(rps)
+
+	ctx, cancel := newContext(c)
+	partitions, err := frontendClient.ListTaskListPartitions(ctx, &types.ListTaskListPartitionsRequest{
+		Domain:   domain,
+		TaskList: &types.TaskList{Name: sourceTaskList},
+	})
+	cancel()
+	if err != nil {
+		ErrorAndExit("Operation ListTaskListPartitions failed.", err)
+	}
+	partitionKeys := partitions.GetDecisionTaskListPartitions()
+	if taskListType == types.TaskListTypeActivity {
+		partitionKeys = partitions.GetActivityTaskListPartitions()
+	}
+
+	var total int64
+	for _, partition := range partitionKeys {
+		var count, afterTaskID int64
+		for hasMore := true; hasMore; {
+			if err := rateLimiter.Wait(context.Background()); err != nil {
+				ErrorAndExit("Failed to wait for rate limiter.", err)
+			}
+			ctx, cancel := newContext(c)
+			response, err := adminClient.MoveTaskListBacklog(ctx, &types.MoveTaskListBacklogRequest{
+				Domain:         domain,
+				SourceTaskList: &types.TaskList{Name: partition.GetKey()},
+				TargetTaskList: &types.TaskList{Name: targetTaskList},
+				TaskListType:   &taskListType,
+				BatchSize:      int32(batchSize),
+				AfterTaskID:    afterTaskID,
+				DryRun:         dryRun,
+			})
+			cancel()
+			if err != nil {
+				ErrorAndExit(fmt.Sprintf("Operation MoveTaskListBacklog failed for partition %v after moving %v tasks.", partition.GetKey(), total+count), err)
+			}
+			count += response.GetCount()
+			afterTaskID = response.GetLastTaskID()
+			hasMore = response.GetHasMore()
+		}
+		fmt.Printf("Partition %v: %v tasks\n", partition.GetKey(), count)
+		total += count
+	}
+
+	if dryRun {
+		fmt.Printf("%v tasks would be moved from tasklist %v to %v\n", total, sourceTaskList, targetTaskList)
+	} else {
+		fmt.Printf("Moved %v tasks from tasklist %v to %v\n", total, sourceTaskList, targetTaskList)
+	}
+}
+
+func getTaskListType(c *cli.Context) types.TaskListType {
+	if strings.ToLower(c.String(FlagTaskListType)) == "activity" {
+		return types.TaskListTypeActivity
+	}
+	return types.TaskListTypeDecision
+}
+
 func printTaskListStatus(taskListStatus *types.TaskListStatus) {
 	taskIDBlock := taskListStatus.GetTaskIDBlock()
 
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetBorder(false)
 	table.SetColumnSeparator("|")
-	table.SetHeader([]string{"Read Level", "Ack Level", "Backlog", "Lease Start TaskID", "Lease End TaskID"})
+	table.SetHeader([]string{"Read Level", "Ack Level", "Backlog", "Lease Start TaskID", "Lease End TaskID", "Paused"})
 	table.SetHeaderLine(false)
-	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
 	table.Append([]string{strconv.FormatInt(taskListStatus.GetReadLevel(), 10),
 		strconv.FormatInt(taskListStatus.GetAckLevel(), 10),
 		strconv.FormatInt(taskListStatus.GetBacklogCountHint(), 10),
 		strconv.FormatInt(taskIDBlock.GetStartID(), 10),
-		strconv.FormatInt(taskIDBlock.GetEndID(), 10)})
+		strconv.FormatInt(taskIDBlock.GetEndID(), 10),
+		strconv.FormatBool(taskListStatus.GetPaused())})
 	table.Render()
 }
 
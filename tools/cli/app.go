@@ -94,6 +94,12 @@ func NewCliApp() *cli.App {
 			Usage:       "Operate cadence tasklist",
 			Subcommands: newTaskListCommands(),
 		},
+		{
+			Name:        "schedule",
+			Aliases:     []string{"sch"},
+			Usage:       "Operate cadence schedule",
+			Subcommands: newScheduleCommands(),
+		},
 		{
 			Name:    "admin",
 			Aliases: []string{"adm"},
@@ -153,6 +159,12 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on DLQ",
 					Subcommands: newAdminDLQCommands(),
 				},
+				{
+					Name:        "task-dlq",
+					Aliases:     []string{"tdlq"},
+					Usage:       "Run admin operation on the history task DLQ of transfer and timer tasks",
+					Subcommands: newAdminHistoryTaskDLQCommands(),
+				},
 				{
 					Name:        "db",
 					Aliases:     []string{"db"},
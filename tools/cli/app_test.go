@@ -184,6 +184,14 @@ func (s *cliAppSuite) TestDomainUpdate() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestDomainUpdate_WithReason() {
+	resp := describeDomainResponseServer
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, nil)
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "update", "--rd", "1", "--reason", "reduce storage cost"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestDomainUpdate_DomainNotExist() {
 	resp := describeDomainResponseServer
 	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, nil)
@@ -206,6 +214,30 @@ func (s *cliAppSuite) TestDomainUpdate_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestDomainClone() {
+	s.serverAdminClient.EXPECT().CloneDomain(gomock.Any(), &types.CloneDomainRequest{
+		SourceName: "test-domain",
+		Name:       domainName,
+	}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "clone", "--source_domain", "test-domain"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainClone_DomainExist() {
+	s.serverAdminClient.EXPECT().CloneDomain(gomock.Any(), gomock.Any()).Return(&types.DomainAlreadyExistsError{})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "clone", "--source_domain", "test-domain"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestDomainRename() {
+	s.serverAdminClient.EXPECT().RenameDomain(gomock.Any(), &types.RenameDomainRequest{
+		Name:    domainName,
+		NewName: "new-domain",
+	}, gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "rename", "--new_domain", "new-domain", "--reason", "team rename"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestDomainDeprecate() {
 	s.clientFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Return(&shared.ListClosedWorkflowExecutionsResponse{}, nil)
 	s.clientFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Return(&shared.ListOpenWorkflowExecutionsResponse{}, nil)
@@ -268,6 +300,30 @@ func (s *cliAppSuite) TestDomainDescribe() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestDomainHistory() {
+	s.serverAdminClient.EXPECT().ListDomainChanges(gomock.Any(), &types.ListDomainChangesRequest{
+		Domain:   domainName,
+		PageSize: 10,
+	}).Return(&types.ListDomainChangesResponse{
+		Changes: []*types.DomainChange{{
+			ID:           1,
+			DomainName:   domainName,
+			Operation:    "UpdateDomain",
+			Actor:        "John Doe",
+			Reason:       "reduce storage cost",
+			FieldChanges: []*types.DomainFieldChange{{Field: "retentionDays", OldValue: "3", NewValue: "1"}},
+		}},
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "history"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainHistory_Failed() {
+	s.serverAdminClient.EXPECT().ListDomainChanges(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "history"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestDomainDescribe_DomainNotExist() {
 	resp := describeDomainResponseServer
 	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, &shared.EntityNotExistsError{})
@@ -402,6 +458,87 @@ func (s *cliAppSuite) TestTerminateWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestRestartWorkflow() {
+	s.serverFrontendClient.EXPECT().RestartWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.RestartWorkflowExecutionResponse{RunID: "new-rid"}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "restart", "-w", "wid", "-r", "rid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestRestartWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().RestartWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.BadRequestError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "restart", "-w", "wid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestDeleteWorkflow() {
+	s.serverFrontendClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), &types.DeleteWorkflowExecutionRequest{
+		Domain:            domainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+		Archive:           true,
+		Identity:          getCliIdentity(),
+	}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "delete", "-w", "wid", "-r", "rid", "--archive"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDeleteWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "delete", "-w", "wid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestPauseActivity() {
+	s.serverFrontendClient.EXPECT().PauseActivity(gomock.Any(), &types.PauseActivityRequest{
+		Domain:            domainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+		ActivityID:        "aid",
+		Identity:          getCliIdentity(),
+	}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "pause", "-w", "wid", "-r", "rid", "--aid", "aid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestPauseActivity_Failed() {
+	s.serverFrontendClient.EXPECT().PauseActivity(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "activity", "pause", "-w", "wid", "--aid", "aid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestUnpauseActivity() {
+	s.serverFrontendClient.EXPECT().UnpauseActivity(gomock.Any(), &types.UnpauseActivityRequest{
+		Domain:            domainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+		ActivityID:        "aid",
+		Identity:          getCliIdentity(),
+	}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "unpause", "-w", "wid", "-r", "rid", "--aid", "aid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUnpauseActivity_Failed() {
+	s.serverFrontendClient.EXPECT().UnpauseActivity(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "activity", "unpause", "-w", "wid", "--aid", "aid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestResetActivity() {
+	s.serverFrontendClient.EXPECT().ResetActivity(gomock.Any(), &types.ResetActivityRequest{
+		Domain:            domainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+		ActivityID:        "aid",
+		RetryNow:          true,
+		Identity:          getCliIdentity(),
+	}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "reset", "-w", "wid", "-r", "rid", "--aid", "aid", "--retry_now"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestResetActivity_Failed() {
+	s.serverFrontendClient.EXPECT().ResetActivity(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "activity", "reset", "-w", "wid", "--aid", "aid"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestCancelWorkflow() {
 	s.clientFrontendClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), gomock.Any(), callOptions...).Return(nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "cancel", "-w", "wid"})
@@ -588,11 +725,45 @@ func (s *cliAppSuite) TestAdminDescribeWorkflow() {
 }
 
 func (s *cliAppSuite) TestAdminDescribeWorkflow_Failed() {
-	s.serverAdminClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any(), callOptions...).Return(nil, &types.BadRequestError{"faked error"})
+	s.serverAdminClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any(), callOptions...).Return(nil, &types.BadRequestError{Message: "faked error"})
 	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "admin", "wf", "describe", "-w", "test-wf-id"})
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestAdminMigrateWorkflow() {
+	s.serverAdminClient.EXPECT().MigrateWorkflowExecution(gomock.Any(), &types.MigrateWorkflowExecutionRequest{
+		Domain:        domainName,
+		WorkflowID:    "test-wf-id",
+		SourceCluster: "active",
+	}).Return(&types.MigrateWorkflowExecutionResponse{RunID: "test-run-id", NextEventID: 10}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "wf", "migrate", "-w", "test-wf-id", "--sc", "active"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminMigrateWorkflow_Failed() {
+	s.serverAdminClient.EXPECT().MigrateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.BadRequestError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "admin", "wf", "migrate", "-w", "test-wf-id", "--sc", "active"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminAddRemoteCluster() {
+	s.serverAdminClient.EXPECT().AddRemoteCluster(gomock.Any(), &types.AddRemoteClusterRequest{
+		ClusterName:            "remote",
+		InitialFailoverVersion: 3,
+		RPCName:                "cadence-frontend",
+		RPCAddress:             "127.0.0.1:9104",
+	}).Return(nil)
+	err := s.app.Run([]string{"", "admin", "cl", "ar", "--cluster", "remote", "--initial_failover_version", "3", "--rpc_address", "127.0.0.1:9104"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminRemoveRemoteCluster_Failed() {
+	s.serverAdminClient.EXPECT().RemoveRemoteCluster(gomock.Any(), &types.RemoveRemoteClusterRequest{ClusterName: "remote"}).
+		Return(&types.EntityNotExistsError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "admin", "cl", "rr", "--cluster", "remote"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	var promptMsg string
 	promptFn = func(msg string) {
@@ -618,6 +789,30 @@ func (s *cliAppSuite) TestAdminFailover() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminFailoverPreflight() {
+	resp := &types.DescribeReplicationStatusResponse{
+		Domains: []*types.ReplicationDomainStatus{
+			{Domain: "d1", ActiveClusterName: "active", ClusterName: "standby"},
+		},
+	}
+	s.serverAdminClient.EXPECT().DescribeReplicationStatus(gomock.Any(), &types.DescribeReplicationStatusRequest{
+		ClusterName: "standby",
+	}).Return(resp, nil)
+	err := s.app.Run([]string{"", "admin", "cl", "fo", "preflight", "--tc", "standby", "--sc", "active"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminFailoverPreflight_Failed() {
+	resp := &types.DescribeReplicationStatusResponse{
+		Domains: []*types.ReplicationDomainStatus{
+			{Domain: "d1", ActiveClusterName: "active", ClusterName: "standby", MaxOutboundTaskLag: 100},
+		},
+	}
+	s.serverAdminClient.EXPECT().DescribeReplicationStatus(gomock.Any(), gomock.Any()).Return(resp, nil)
+	errorCode := s.RunErrorExitCode([]string{"", "admin", "cl", "fo", "preflight", "--tc", "standby", "--sc", "active", "--max_replication_lag", "10"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestDescribeTaskList() {
 	resp := describeTaskListResponse
 	s.clientFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
@@ -632,6 +827,38 @@ func (s *cliAppSuite) TestDescribeTaskList_Activity() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestCreateSchedule() {
+	s.serverFrontendClient.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "create", "-sch", "sid", "--cron", "*/5 * * * *", "-wt", "wt", "-tl", "tl", "-et", "60"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestCreateSchedule_Failed() {
+	s.serverFrontendClient.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "schedule", "create", "-sch", "sid", "--cron", "*/5 * * * *", "-wt", "wt", "-tl", "tl", "-et", "60"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestPauseSchedule() {
+	s.serverFrontendClient.EXPECT().PauseSchedule(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "pause", "-sch", "sid", "--reason", "maintenance"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestBackfillSchedule() {
+	s.serverFrontendClient.EXPECT().BackfillSchedule(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "backfill", "-sch", "sid", "--start_time", "2021-01-01T00:00:00Z", "--end_time", "2021-01-02T00:00:00Z"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestListSchedules() {
+	s.serverFrontendClient.EXPECT().ListSchedules(gomock.Any(), gomock.Any()).Return(&types.ListSchedulesResponse{
+		Schedules: []*types.ScheduleListEntry{{ScheduleID: "sid"}},
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "list"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestObserveWorkflow() {
 	history := getWorkflowExecutionHistoryResponse
 	s.clientFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any(), callOptions...).Return(history, nil).Times(2)
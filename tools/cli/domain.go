@@ -74,6 +74,22 @@ func newDomainCommands() []cli.Command {
 				newDomainCLI(c, false).DeprecateDomain(c)
 			},
 		},
+		{
+			Name:  "clone",
+			Usage: "Register a new workflow domain with the configuration of an existing one",
+			Flags: cloneDomainFlags,
+			Action: func(c *cli.Context) {
+				CloneDomain(c)
+			},
+		},
+		{
+			Name:  "rename",
+			Usage: "Rename existing workflow domain, keeping its domain ID",
+			Flags: renameDomainFlags,
+			Action: func(c *cli.Context) {
+				RenameDomain(c)
+			},
+		},
 		{
 			Name:    "describe",
 			Aliases: []string{"desc"},
@@ -83,5 +99,13 @@ func newDomainCommands() []cli.Command {
 				newDomainCLI(c, false).DescribeDomain(c)
 			},
 		},
+		{
+			Name:  "history",
+			Usage: "Show the changes made to a workflow domain, oldest first",
+			Flags: domainHistoryFlags,
+			Action: func(c *cli.Context) {
+				DomainHistory(c)
+			},
+		},
 	}
 }
@@ -34,6 +34,9 @@ import (
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
@@ -245,6 +248,27 @@ func (d *domainCLIImpl) UpdateDomain(c *cli.Context) {
 			badBinaryToDelete = common.StringPtr(c.String(FlagRemoveBadBinary))
 		}
 
+		var limits *types.DomainLimits
+		if c.IsSet(FlagRequestsPerSecond) || c.IsSet(FlagMaxConcurrentWorkflows) ||
+			c.IsSet(FlagMaxPendingActivities) || c.IsSet(FlagMaxHistorySize) {
+			limits = &types.DomainLimits{}
+			if current := resp.Configuration.GetLimits(); current != nil {
+				*limits = *current
+			}
+			if c.IsSet(FlagRequestsPerSecond) {
+				limits.RequestsPerSecond = int32(c.Int(FlagRequestsPerSecond))
+			}
+			if c.IsSet(FlagMaxConcurrentWorkflows) {
+				limits.MaxConcurrentWorkflows = int32(c.Int(FlagMaxConcurrentWorkflows))
+			}
+			if c.IsSet(FlagMaxPendingActivities) {
+				limits.MaxPendingActivities = int32(c.Int(FlagMaxPendingActivities))
+			}
+			if c.IsSet(FlagMaxHistorySize) {
+				limits.MaxHistorySize = c.Int64(FlagMaxHistorySize)
+			}
+		}
+
 		updateRequest = &types.UpdateDomainRequest{
 			Name:                                   domainName,
 			Description:                            common.StringPtr(description),
@@ -259,12 +283,13 @@ func (d *domainCLIImpl) UpdateDomain(c *cli.Context) {
 			BadBinaries:                            binBinaries,
 			Clusters:                               clusters,
 			DeleteBadBinary:                        badBinaryToDelete,
+			Limits:                                 limits,
 		}
 	}
 
 	securityToken := c.String(FlagSecurityToken)
 	updateRequest.SecurityToken = securityToken
-	_, err := d.updateDomain(ctx, updateRequest)
+	_, err := d.updateDomain(ctx, updateRequest, c.String(FlagReason))
 	if err != nil {
 		if _, ok := err.(*types.EntityNotExistsError); !ok {
 			ErrorAndExit("Operation UpdateDomain failed.", err)
@@ -380,7 +405,7 @@ func (d *domainCLIImpl) failover(c *cli.Context, domainName string, targetCluste
 	}
 	ctx, cancel := newContext(c)
 	defer cancel()
-	_, err := d.updateDomain(ctx, updateRequest)
+	_, err := d.updateDomain(ctx, updateRequest, "")
 	return err
 }
 
@@ -554,12 +579,28 @@ func (d *domainCLIImpl) registerDomain(
 func (d *domainCLIImpl) updateDomain(
 	ctx context.Context,
 	request *types.UpdateDomainRequest,
+	reason string,
 ) (*types.UpdateDomainResponse, error) {
 
 	if d.frontendClient != nil {
-		return d.frontendClient.UpdateDomain(ctx, request)
+		var opts []yarpc.CallOption
+		if reason != "" {
+			opts = append(opts, yarpc.WithHeader(common.DomainChangeReasonHeaderName, reason))
+		}
+		return d.frontendClient.UpdateDomain(ctx, request, opts...)
 	}
 
+	if reason != "" {
+		// the domain handler reads the reason from the request headers,
+		// so present it the same way as an RPC received by frontend would
+		ctx, call := encoding.NewInboundCall(ctx)
+		if err := call.ReadFromRequest(&transport.Request{
+			Headers: transport.NewHeaders().With(common.DomainChangeReasonHeaderName, reason),
+		}); err != nil {
+			return nil, err
+		}
+		return d.domainHandler.UpdateDomain(ctx, request)
+	}
 	return d.domainHandler.UpdateDomain(ctx, request)
 }
 
@@ -587,6 +628,137 @@ func (d *domainCLIImpl) describeDomain(
 	return d.domainHandler.DescribeDomain(ctx, request)
 }
 
+// DomainHistory prints the audit log of a domain
+func DomainHistory(c *cli.Context) {
+	domainName := getRequiredGlobalOption(c, FlagDomain)
+	pageSize := c.Int(FlagPageSize)
+	printJSON := c.Bool(FlagPrintJSON)
+
+	adminClient := cFactory.ServerAdminClient(c)
+	table := createTableForDomainHistory()
+	var nextPageToken []byte
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.ListDomainChanges(ctx, &types.ListDomainChangesRequest{
+			Domain:        domainName,
+			PageSize:      int32(pageSize),
+			NextPageToken: nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Operation ListDomainChanges failed.", err)
+		}
+
+		if printJSON {
+			for _, change := range resp.GetChanges() {
+				prettyPrintJSONObject(change)
+			}
+		} else {
+			for _, change := range resp.GetChanges() {
+				table.Append(domainChangeToRow(change))
+			}
+			table.Render()
+			table.ClearRows()
+		}
+
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 || (!printJSON && !showNextPage()) {
+			return
+		}
+	}
+}
+
+// CloneDomain registers a domain with the configuration of an existing one
+func CloneDomain(c *cli.Context) {
+	domainName := getRequiredGlobalOption(c, FlagDomain)
+	sourceDomainName := getRequiredOption(c, FlagSourceDomain)
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.CloneDomain(ctx, &types.CloneDomainRequest{
+		SourceName: sourceDomainName,
+		Name:       domainName,
+	}, domainChangeReasonOptions(c)...)
+	if err != nil {
+		switch err.(type) {
+		case *types.DomainAlreadyExistsError:
+			ErrorAndExit(fmt.Sprintf("Domain %s already registered.", domainName), err)
+		case *types.EntityNotExistsError:
+			ErrorAndExit(fmt.Sprintf("Domain %s does not exist.", sourceDomainName), err)
+		default:
+			ErrorAndExit("Operation CloneDomain failed.", err)
+		}
+	} else {
+		fmt.Printf("Domain %s successfully cloned from %s.\n", domainName, sourceDomainName)
+	}
+}
+
+// RenameDomain renames a domain, keeping its domain ID
+func RenameDomain(c *cli.Context) {
+	domainName := getRequiredGlobalOption(c, FlagDomain)
+	newDomainName := getRequiredOption(c, FlagNewDomain)
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.RenameDomain(ctx, &types.RenameDomainRequest{
+		Name:    domainName,
+		NewName: newDomainName,
+	}, domainChangeReasonOptions(c)...)
+	if err != nil {
+		switch err.(type) {
+		case *types.DomainAlreadyExistsError:
+			ErrorAndExit(fmt.Sprintf("Domain %s already registered.", newDomainName), err)
+		case *types.EntityNotExistsError:
+			ErrorAndExit(fmt.Sprintf("Domain %s does not exist.", domainName), err)
+		default:
+			ErrorAndExit("Operation RenameDomain failed.", err)
+		}
+	} else {
+		fmt.Printf("Domain %s successfully renamed to %s.\n", domainName, newDomainName)
+	}
+}
+
+func domainChangeReasonOptions(c *cli.Context) []yarpc.CallOption {
+	if reason := c.String(FlagReason); reason != "" {
+		return []yarpc.CallOption{yarpc.WithHeader(common.DomainChangeReasonHeaderName, reason)}
+	}
+	return nil
+}
+
+func createTableForDomainHistory() *tablewriter.Table {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	header := []string{"ID", "Time", "Operation", "Actor", "Reason", "Changes"}
+	headerColor := make([]tablewriter.Colors, len(header))
+	for i := range headerColor {
+		headerColor[i] = tableHeaderBlue
+	}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	table.SetHeaderLine(false)
+	table.SetAutoWrapText(false)
+
+	return table
+}
+
+func domainChangeToRow(change *types.DomainChange) []string {
+	var fieldChanges []string
+	for _, fieldChange := range change.GetFieldChanges() {
+		fieldChanges = append(fieldChanges, fmt.Sprintf("%v: %q -> %q", fieldChange.GetField(), fieldChange.GetOldValue(), fieldChange.GetNewValue()))
+	}
+	return []string{
+		strconv.FormatInt(change.GetID(), 10),
+		convertTime(change.GetTimestamp(), false),
+		change.GetOperation(),
+		change.GetActor(),
+		change.GetReason(),
+		strings.Join(fieldChanges, "\n"),
+	}
+}
+
 func createTableForListDomains(printAll, printFull bool) *tablewriter.Table {
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetBorder(false)
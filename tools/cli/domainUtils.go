@@ -164,9 +164,25 @@ var (
 			Name:  FlagRemoveBadBinary,
 			Usage: "Binary checksum to remove for resetting workflow",
 		},
+		cli.IntFlag{
+			Name:  FlagRequestsPerSecond,
+			Usage: "Cluster wide request rate limit of the domain, 0 to use the default",
+		},
+		cli.IntFlag{
+			Name:  FlagMaxConcurrentWorkflows,
+			Usage: "Maximum number of open workflows in the domain, 0 to use the default",
+		},
+		cli.IntFlag{
+			Name:  FlagMaxPendingActivities,
+			Usage: "Maximum number of pending activities per workflow in the domain, 0 to use the default",
+		},
+		cli.Int64Flag{
+			Name:  FlagMaxHistorySize,
+			Usage: "Maximum history size in bytes of workflows in the domain, 0 to use the default",
+		},
 		cli.StringFlag{
 			Name:  FlagReason,
-			Usage: "Reason for the operation",
+			Usage: "Reason for the operation, recorded in the domain history",
 		},
 		cli.StringFlag{
 			Name:  FlagFailoverTypeWithAlias,
@@ -190,6 +206,28 @@ var (
 		},
 	}
 
+	cloneDomainFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagSourceDomain,
+			Usage: "Name of the domain whose configuration is copied",
+		},
+		cli.StringFlag{
+			Name:  FlagReason,
+			Usage: "Reason for the operation, recorded in the domain history",
+		},
+	}
+
+	renameDomainFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagNewDomain,
+			Usage: "New name of the domain",
+		},
+		cli.StringFlag{
+			Name:  FlagReason,
+			Usage: "Reason for the operation, recorded in the domain history",
+		},
+	}
+
 	describeDomainFlags = []cli.Flag{
 		cli.StringFlag{
 			Name:  FlagDomainID,
@@ -197,6 +235,18 @@ var (
 		},
 	}
 
+	domainHistoryFlags = []cli.Flag{
+		cli.IntFlag{
+			Name:  FlagPageSizeWithAlias,
+			Value: 10,
+			Usage: "Result page size",
+		},
+		cli.BoolFlag{
+			Name:  FlagPrintJSONWithAlias,
+			Usage: "Print in raw json format",
+		},
+	}
+
 	adminDomainCommonFlags = []cli.Flag{
 		cli.StringFlag{
 			Name:  FlagServiceConfigDirWithAlias,
@@ -250,19 +300,24 @@ func initializeAdminDomainHandler(
 		configuration,
 		logger,
 	)
-	metadataMgr := initializeDomainMgr(
+	persistenceFactory := initializePersistenceFactory(
 		configuration,
 		clusterMetadata,
 		metricsClient,
 		logger,
 	)
+	metadataMgr := initializeDomainMgr(persistenceFactory)
+	auditLog := initializeDomainAuditLog(persistenceFactory)
 	dynamicConfig := initializeDynamicConfig(configuration, logger)
 	return initializeDomainHandler(
 		logger,
+		metricsClient,
 		metadataMgr,
+		auditLog,
 		clusterMetadata,
 		initializeArchivalMetadata(configuration, dynamicConfig),
 		initializeArchivalProvider(configuration, clusterMetadata, metricsClient, logger),
+		configuration.Persistence.IsAdvancedVisibilityConfigExist(),
 	)
 }
 
@@ -282,23 +337,29 @@ func loadConfig(
 
 func initializeDomainHandler(
 	logger log.Logger,
+	metricsClient metrics.Client,
 	domainManager persistence.DomainManager,
+	auditLog domain.AuditLog,
 	clusterMetadata cluster.Metadata,
 	archivalMetadata archiver.ArchivalMetadata,
 	archiverProvider provider.ArchiverProvider,
+	enableAdvancedVisibility bool,
 ) domain.Handler {
 
 	domainConfig := domain.Config{
-		MinRetentionDays:  dynamicconfig.GetIntPropertyFn(domain.DefaultMinWorkflowRetentionInDays),
-		MaxBadBinaryCount: dynamicconfig.GetIntPropertyFilteredByDomain(domain.MaxBadBinaries),
-		FailoverCoolDown:  dynamicconfig.GetDurationPropertyFnFilteredByDomain(domain.FailoverCoolDown),
+		MinRetentionDays:         dynamicconfig.GetIntPropertyFn(domain.DefaultMinWorkflowRetentionInDays),
+		MaxBadBinaryCount:        dynamicconfig.GetIntPropertyFilteredByDomain(domain.MaxBadBinaries),
+		FailoverCoolDown:         dynamicconfig.GetDurationPropertyFnFilteredByDomain(domain.FailoverCoolDown),
+		EnableAdvancedVisibility: dynamicconfig.GetBoolPropertyFnFilteredByDomain(enableAdvancedVisibility),
 	}
 	return domain.NewHandler(
 		domainConfig,
 		logger,
+		metricsClient,
 		domainManager,
 		clusterMetadata,
 		initializeDomainReplicator(logger),
+		auditLog,
 		archivalMetadata,
 		archiverProvider,
 		clock.NewRealTimeSource(),
@@ -315,21 +376,27 @@ func initializeLogger(
 	return loggerimpl.NewLogger(zapLogger)
 }
 
-func initializeDomainMgr(
+func initializePersistenceFactory(
 	serviceConfig *config.Config,
 	clusterMetadata cluster.Metadata,
 	metricsClient metrics.Client,
 	logger log.Logger,
-) persistence.DomainManager {
+) client.Factory {
 
 	pConfig := serviceConfig.Persistence
-	pFactory := client.NewFactory(
+	return client.NewFactory(
 		&pConfig,
 		dynamicconfig.GetIntPropertyFn(dependencyMaxQPS),
 		clusterMetadata.GetCurrentClusterName(),
 		metricsClient,
 		logger,
 	)
+}
+
+func initializeDomainMgr(
+	pFactory client.Factory,
+) persistence.DomainManager {
+
 	metadata, err := pFactory.NewDomainManager()
 	if err != nil {
 		ErrorAndExit("Unable to initialize metadata manager.", err)
@@ -337,6 +404,17 @@ func initializeDomainMgr(
 	return metadata
 }
 
+func initializeDomainAuditLog(
+	pFactory client.Factory,
+) domain.AuditLog {
+
+	auditManager, err := pFactory.NewDomainAuditManager()
+	if err != nil {
+		ErrorAndExit("Unable to initialize domain audit manager.", err)
+	}
+	return domain.NewAuditLog(auditManager)
+}
+
 func initializeClusterMetadata(
 	serviceConfig *config.Config,
 	logger log.Logger,
@@ -57,6 +57,8 @@ const (
 	FlagTargetClusterWithAlias            = FlagTargetCluster + ", tc"
 	FlagSourceCluster                     = "source_cluster"
 	FlagSourceClusterWithAlias            = FlagSourceCluster + ", sc"
+	FlagSourceDomain                      = "source_domain"
+	FlagNewDomain                         = "new_domain"
 	FlagMinEventID                        = "min_event_id"
 	FlagMaxEventID                        = "max_event_id"
 	FlagEndEventVersion                   = "end_event_version"
@@ -64,6 +66,8 @@ const (
 	FlagTaskListWithAlias                 = FlagTaskList + ", tl"
 	FlagTaskListType                      = "tasklisttype"
 	FlagTaskListTypeWithAlias             = FlagTaskListType + ", tlt"
+	FlagTargetTaskList                    = "target_tasklist"
+	FlagTargetTaskListWithAlias           = FlagTargetTaskList + ", ttl"
 	FlagWorkflowIDReusePolicy             = "workflowidreusepolicy"
 	FlagWorkflowIDReusePolicyAlias        = FlagWorkflowIDReusePolicy + ", wrp"
 	FlagCronSchedule                      = "cron"
@@ -204,6 +208,10 @@ const (
 	FlagSearchAttributesType              = "search_attr_type"
 	FlagAddBadBinary                      = "add_bad_binary"
 	FlagRemoveBadBinary                   = "remove_bad_binary"
+	FlagRequestsPerSecond                 = "requests_per_second"
+	FlagMaxConcurrentWorkflows            = "max_concurrent_workflows"
+	FlagMaxPendingActivities              = "max_pending_activities"
+	FlagMaxHistorySize                    = "max_history_size"
 	FlagResetType                         = "reset_type"
 	FlagResetPointsOnly                   = "reset_points_only"
 	FlagResetBadBinaryChecksum            = "reset_bad_binary_checksum"
@@ -246,6 +254,11 @@ const (
 	FlagReportRate                        = "report_rate"
 	FlagLowerShardBound                   = "lower_shard_bound"
 	FlagUpperShardBound                   = "upper_shard_bound"
+	FlagInitialFailoverVersion            = "initial_failover_version"
+	FlagRPCName                           = "rpc_name"
+	FlagRPCAddress                        = "rpc_address"
+	FlagMinTaskID                         = "min_task_id"
+	FlagMaxTaskID                         = "max_task_id"
 	FlagInputDirectory                    = "input_directory"
 	FlagSkipHistoryChecks                 = "skip_history_checks"
 	FlagFailoverType                      = "failover_type"
@@ -261,6 +274,9 @@ const (
 	FlagFailoverDrillWaitTimeWithAlias    = FlagFailoverDrillWaitTime + ", fdws"
 	FlagFailoverDrill                     = "failover_drill"
 	FlagFailoverDrillWithAlias            = FlagFailoverDrill + ", fd"
+	FlagFailoverPreflight                 = "failover_preflight"
+	FlagFailoverPreflightWithAlias        = FlagFailoverPreflight + ", fp"
+	FlagMaxReplicationLag                 = "max_replication_lag"
 	FlagRetryInterval                     = "retry_interval"
 	FlagRetryAttempts                     = "retry_attempts"
 	FlagRetryExpiration                   = "retry_expiration"
@@ -279,6 +295,14 @@ const (
 	FlagJWT                               = "jwt"
 	FlagJWTPrivateKey                     = "jwt-private-key"
 	FlagJWTPrivateKeyWithAlias            = FlagJWTPrivateKey + ", jwt-pk"
+	FlagScheduleID                        = "schedule_id"
+	FlagScheduleIDWithAlias               = FlagScheduleID + ", sch"
+	FlagOverlapPolicy                     = "overlap_policy"
+	FlagOverlapPolicyWithAlias            = FlagOverlapPolicy + ", olp"
+	FlagStartTime                         = "start_time"
+	FlagEndTime                           = "end_time"
+	FlagArchive                           = "archive"
+	FlagRetryNow                          = "retry_now"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -524,6 +548,27 @@ func getFlagsForTerminate() []cli.Flag {
 	})
 }
 
+func getFlagsForPause() []cli.Flag {
+	return append(flagsForExecution, cli.StringFlag{
+		Name:  FlagReasonWithAlias,
+		Usage: "The reason you want to pause the workflow",
+	})
+}
+
+func getFlagsForUnpause() []cli.Flag {
+	return append(flagsForExecution, cli.StringFlag{
+		Name:  FlagReasonWithAlias,
+		Usage: "The reason you want to unpause the workflow",
+	})
+}
+
+func getFlagsForDelete() []cli.Flag {
+	return append(flagsForExecution, cli.BoolFlag{
+		Name:  FlagArchive,
+		Usage: "Archive the workflow before deleting it, requires history archival to be enabled for the domain",
+	})
+}
+
 func getCommonFlagsForVisibility() []cli.Flag {
 	return []cli.Flag{
 		cli.BoolFlag{
@@ -0,0 +1,182 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import "github.com/urfave/cli"
+
+func newScheduleCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "create",
+			Aliases: []string{"c"},
+			Usage:   "Create a schedule that starts workflow executions on a cron spec",
+			Flags:   getFlagsForScheduleSpecAndAction(),
+			Action: func(c *cli.Context) {
+				CreateSchedule(c)
+			},
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe the spec, action, policies and state of a schedule",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DescribeSchedule(c)
+			},
+		},
+		{
+			Name:    "update",
+			Aliases: []string{"u"},
+			Usage:   "Replace the spec, action and policies of a schedule",
+			Flags:   getFlagsForScheduleSpecAndAction(),
+			Action: func(c *cli.Context) {
+				UpdateSchedule(c)
+			},
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause a schedule, no workflow execution will be started until it is unpaused",
+			Flags: getFlagsForSchedulePause("The reason you want to pause the schedule"),
+			Action: func(c *cli.Context) {
+				PauseSchedule(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "Unpause a paused schedule",
+			Flags: getFlagsForSchedulePause("The reason you want to unpause the schedule"),
+			Action: func(c *cli.Context) {
+				UnpauseSchedule(c)
+			},
+		},
+		{
+			Name:    "backfill",
+			Aliases: []string{"bf"},
+			Usage:   "Start the workflow executions a schedule would have started in a time range",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+				cli.StringFlag{
+					Name:  FlagStartTime,
+					Usage: "Start of the time range to backfill, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagEndTime,
+					Usage: "End of the time range to backfill, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagOverlapPolicyWithAlias,
+					Usage: "Optional overlap policy for the backfilled runs [SkipNew|Buffer|CancelOther|AllowAll], defaults to the schedule's policy",
+				},
+			},
+			Action: func(c *cli.Context) {
+				BackfillSchedule(c)
+			},
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the schedules of a domain",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 100,
+					Usage: "Result page size",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ListSchedules(c)
+			},
+		},
+	}
+}
+
+func getFlagsForScheduleSpecAndAction() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagScheduleIDWithAlias,
+			Usage: "ScheduleID",
+		},
+		cli.StringFlag{
+			Name:  FlagCronSchedule,
+			Usage: "Cron schedule on which workflow executions are started, in the same format as workflow cron schedules",
+		},
+		cli.StringFlag{
+			Name:  FlagStartTime,
+			Usage: "Optional time before which no workflow execution is started, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+		},
+		cli.StringFlag{
+			Name:  FlagEndTime,
+			Usage: "Optional time after which no workflow execution is started, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowTypeWithAlias,
+			Usage: "WorkflowTypeName",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskListWithAlias,
+			Usage: "TaskList",
+		},
+		cli.IntFlag{
+			Name:  FlagExecutionTimeoutWithAlias,
+			Usage: "Execution start to close timeout in seconds",
+		},
+		cli.IntFlag{
+			Name:  FlagDecisionTimeoutWithAlias,
+			Value: defaultDecisionTimeoutInSeconds,
+			Usage: "Decision task start to close timeout in seconds",
+		},
+		cli.StringFlag{
+			Name:  FlagInputWithAlias,
+			Usage: "Optional input for the workflow, in JSON format. If there are multiple parameters, concatenate them and separate by space.",
+		},
+		cli.StringFlag{
+			Name: FlagInputFileWithAlias,
+			Usage: "Optional input for the workflow from JSON file. If there are multiple JSON, concatenate them and separate by space or newline. " +
+				"Input from file will be overwrite by input from command line",
+		},
+		cli.StringFlag{
+			Name:  FlagOverlapPolicyWithAlias,
+			Value: "SkipNew",
+			Usage: "Optional policy applied when a run is due while the previous one is still running [SkipNew|Buffer|CancelOther|AllowAll]",
+		},
+	}
+}
+
+func getFlagsForSchedulePause(reasonUsage string) []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagScheduleIDWithAlias,
+			Usage: "ScheduleID",
+		},
+		cli.StringFlag{
+			Name:  FlagReasonWithAlias,
+			Usage: reasonUsage,
+		},
+	}
+}
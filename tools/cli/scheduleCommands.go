@@ -0,0 +1,279 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/pborman/uuid"
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+// CreateSchedule creates a schedule that starts workflow executions on a cron spec
+func CreateSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	cronSchedule := getRequiredOption(c, FlagCronSchedule)
+	workflowType := getRequiredOption(c, FlagWorkflowType)
+	taskList := getRequiredOption(c, FlagTaskList)
+	et := c.Int(FlagExecutionTimeout)
+	if et == 0 {
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagExecutionTimeout), nil)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.CreateSchedule(ctx, &types.CreateScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Spec:       constructScheduleSpec(c, cronSchedule),
+		Action: &types.ScheduleAction{
+			WorkflowType:                        &types.WorkflowType{Name: workflowType},
+			TaskList:                            &types.TaskList{Name: taskList},
+			Input:                               []byte(processJSONInput(c)),
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(et)),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(c.Int(FlagDecisionTimeout))),
+		},
+		Policies: &types.SchedulePolicies{
+			OverlapPolicy: getScheduleOverlapPolicy(c),
+		},
+		Identity:  getCliIdentity(),
+		RequestID: uuid.New(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Create schedule failed.", err)
+	} else {
+		fmt.Println("Create schedule succeeded.")
+	}
+}
+
+// DescribeSchedule shows the spec, action, policies and state of a schedule
+func DescribeSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := frontendClient.DescribeSchedule(ctx, &types.DescribeScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+	})
+	if err != nil {
+		ErrorAndExit("Describe schedule failed.", err)
+	}
+	prettyPrintJSONObject(resp)
+}
+
+// UpdateSchedule replaces the spec, action and policies of a schedule, only the parts set on the command line are changed
+func UpdateSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	request := &types.UpdateScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Identity:   getCliIdentity(),
+		RequestID:  uuid.New(),
+	}
+	if c.IsSet(FlagCronSchedule) {
+		request.Spec = constructScheduleSpec(c, c.String(FlagCronSchedule))
+	}
+	if c.IsSet(FlagWorkflowType) || c.IsSet(FlagTaskList) || c.IsSet(FlagExecutionTimeout) {
+		et := c.Int(FlagExecutionTimeout)
+		if et == 0 {
+			ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagExecutionTimeout), nil)
+		}
+		request.Action = &types.ScheduleAction{
+			WorkflowType:                        &types.WorkflowType{Name: getRequiredOption(c, FlagWorkflowType)},
+			TaskList:                            &types.TaskList{Name: getRequiredOption(c, FlagTaskList)},
+			Input:                               []byte(processJSONInput(c)),
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(et)),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(c.Int(FlagDecisionTimeout))),
+		}
+	}
+	if c.IsSet(FlagOverlapPolicy) {
+		request.Policies = &types.SchedulePolicies{
+			OverlapPolicy: getScheduleOverlapPolicy(c),
+		}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.UpdateSchedule(ctx, request)
+
+	if err != nil {
+		ErrorAndExit("Update schedule failed.", err)
+	} else {
+		fmt.Println("Update schedule succeeded.")
+	}
+}
+
+// PauseSchedule pauses a schedule
+func PauseSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.PauseSchedule(ctx, &types.PauseScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Reason:     reason,
+		Identity:   getCliIdentity(),
+		RequestID:  uuid.New(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Pause schedule failed.", err)
+	} else {
+		fmt.Println("Pause schedule succeeded.")
+	}
+}
+
+// UnpauseSchedule unpauses a paused schedule
+func UnpauseSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.UnpauseSchedule(ctx, &types.UnpauseScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Reason:     reason,
+		Identity:   getCliIdentity(),
+		RequestID:  uuid.New(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Unpause schedule failed.", err)
+	} else {
+		fmt.Println("Unpause schedule succeeded.")
+	}
+}
+
+// BackfillSchedule starts the workflow executions a schedule would have started in a time range
+func BackfillSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	startTime := parseTime(getRequiredOption(c, FlagStartTime), 0)
+	endTime := parseTime(getRequiredOption(c, FlagEndTime), 0)
+
+	request := &types.BackfillScheduleRequest{
+		Domain:         domain,
+		ScheduleID:     scheduleID,
+		StartTimestamp: common.Int64Ptr(startTime),
+		EndTimestamp:   common.Int64Ptr(endTime),
+		Identity:       getCliIdentity(),
+		RequestID:      uuid.New(),
+	}
+	if c.IsSet(FlagOverlapPolicy) {
+		request.OverlapPolicy = getScheduleOverlapPolicy(c)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.BackfillSchedule(ctx, request)
+
+	if err != nil {
+		ErrorAndExit("Backfill schedule failed.", err)
+	} else {
+		fmt.Println("Backfill schedule succeeded.")
+	}
+}
+
+// ListSchedules lists the schedules of a domain
+func ListSchedules(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	pageSize := c.Int(FlagPageSize)
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Schedule ID"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue)
+
+	var nextPageToken []byte
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := frontendClient.ListSchedules(ctx, &types.ListSchedulesRequest{
+			Domain:        domain,
+			PageSize:      int32(pageSize),
+			NextPageToken: nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("List schedules failed.", err)
+		}
+		for _, schedule := range resp.GetSchedules() {
+			table.Append([]string{schedule.GetScheduleID()})
+		}
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	table.Render()
+}
+
+func constructScheduleSpec(c *cli.Context, cronSchedule string) *types.ScheduleSpec {
+	spec := &types.ScheduleSpec{
+		CronExpression: cronSchedule,
+	}
+	if c.IsSet(FlagStartTime) {
+		spec.StartTimestamp = common.Int64Ptr(parseTime(c.String(FlagStartTime), 0))
+	}
+	if c.IsSet(FlagEndTime) {
+		spec.EndTimestamp = common.Int64Ptr(parseTime(c.String(FlagEndTime), 0))
+	}
+	return spec
+}
+
+func getScheduleOverlapPolicy(c *cli.Context) *types.ScheduleOverlapPolicy {
+	var policy types.ScheduleOverlapPolicy
+	if err := policy.UnmarshalText([]byte(c.String(FlagOverlapPolicy))); err != nil {
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagOverlapPolicy), err)
+	}
+	return &policy
+}
@@ -78,6 +78,38 @@ func newWorkflowCommands() []cli.Command {
 				CancelWorkflow(c)
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "pause a workflow execution, no decision, activity or timer will be dispatched until it is unpaused",
+			Flags: getFlagsForPause(),
+			Action: func(c *cli.Context) {
+				PauseWorkflow(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "unpause a paused workflow execution",
+			Flags: getFlagsForUnpause(),
+			Action: func(c *cli.Context) {
+				UnpauseWorkflow(c)
+			},
+		},
+		{
+			Name:  "restart",
+			Usage: "restart a closed workflow execution as a new run with the same inputs",
+			Flags: flagsForExecution,
+			Action: func(c *cli.Context) {
+				RestartWorkflow(c)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "delete a closed workflow execution, including its history and visibility record",
+			Flags: getFlagsForDelete(),
+			Action: func(c *cli.Context) {
+				DeleteWorkflow(c)
+			},
+		},
 		{
 			Name:    "signal",
 			Aliases: []string{"s"},
@@ -400,6 +432,73 @@ func newActivityCommands() []cli.Command {
 				FailActivity(c)
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "pause a pending activity, no retry of the activity will be dispatched until it is unpaused",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "The activityID to operate on",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseActivity(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "unpause a paused activity, the activity is dispatched immediately if no attempt is running",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "The activityID to operate on",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UnpauseActivity(c)
+			},
+		},
+		{
+			Name:  "reset",
+			Usage: "reset the attempt count and heartbeat details of a pending activity",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "The activityID to operate on",
+				},
+				cli.BoolFlag{
+					Name:  FlagRetryNow,
+					Usage: "Dispatch the next attempt immediately if the activity is waiting for a retry",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ResetActivity(c)
+			},
+		},
 	}
 }
 
@@ -496,6 +496,117 @@ func CancelWorkflow(c *cli.Context) {
 	}
 }
 
+// PauseWorkflow pauses a workflow execution
+func PauseWorkflow(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.PauseWorkflowExecution(ctx, &types.PauseWorkflowExecutionRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+		Reason:    reason,
+		Identity:  getCliIdentity(),
+		RequestID: uuid.New(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Pause workflow failed.", err)
+	} else {
+		fmt.Println("Pause workflow succeeded.")
+	}
+}
+
+// UnpauseWorkflow unpauses a paused workflow execution
+func UnpauseWorkflow(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.UnpauseWorkflowExecution(ctx, &types.UnpauseWorkflowExecutionRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+		Reason:    reason,
+		Identity:  getCliIdentity(),
+		RequestID: uuid.New(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Unpause workflow failed.", err)
+	} else {
+		fmt.Println("Unpause workflow succeeded.")
+	}
+}
+
+// RestartWorkflow starts a new run of a closed workflow execution
+func RestartWorkflow(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := frontendClient.RestartWorkflowExecution(ctx, &types.RestartWorkflowExecutionRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+		Identity: getCliIdentity(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Restart workflow failed.", err)
+	} else {
+		fmt.Printf("Restart workflow succeeded, new run ID: %s\n", resp.GetRunID())
+	}
+}
+
+// DeleteWorkflow deletes a closed workflow execution
+func DeleteWorkflow(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.DeleteWorkflowExecution(ctx, &types.DeleteWorkflowExecutionRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+		Archive:  c.Bool(FlagArchive),
+		Identity: getCliIdentity(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Delete workflow failed.", err)
+	} else {
+		fmt.Println("Delete workflow succeeded, the workflow will be deleted shortly.")
+	}
+}
+
 // SignalWorkflow signals a workflow execution
 func SignalWorkflow(c *cli.Context) {
 	serviceClient := cFactory.ClientFrontendClient(c)
@@ -2188,6 +2299,92 @@ func FailActivity(c *cli.Context) {
 	}
 }
 
+// PauseActivity pauses a pending activity
+func PauseActivity(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	activityID := getRequiredOption(c, FlagActivityID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.PauseActivity(ctx, &types.PauseActivityRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+		ActivityID: activityID,
+		Identity:   getCliIdentity(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Pause activity failed.", err)
+	} else {
+		fmt.Println("Pause activity succeeded.")
+	}
+}
+
+// UnpauseActivity unpauses a paused activity
+func UnpauseActivity(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	activityID := getRequiredOption(c, FlagActivityID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.UnpauseActivity(ctx, &types.UnpauseActivityRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+		ActivityID: activityID,
+		Identity:   getCliIdentity(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Unpause activity failed.", err)
+	} else {
+		fmt.Println("Unpause activity succeeded.")
+	}
+}
+
+// ResetActivity resets the attempt count and heartbeat details of a pending activity
+func ResetActivity(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	activityID := getRequiredOption(c, FlagActivityID)
+	retryNow := c.Bool(FlagRetryNow)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.ResetActivity(ctx, &types.ResetActivityRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+		ActivityID: activityID,
+		RetryNow:   retryNow,
+		Identity:   getCliIdentity(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Reset activity failed.", err)
+	} else {
+		fmt.Println("Reset activity succeeded.")
+	}
+}
+
 // ObserveHistoryWithID show the process of running workflow
 func ObserveHistoryWithID(c *cli.Context) {
 	if !c.Args().Present() {